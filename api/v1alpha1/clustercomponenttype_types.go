@@ -47,6 +47,11 @@ type ClusterComponentTypeSpec struct {
 	// +optional
 	AllowedTraits []ClusterTraitRef `json:"allowedTraits,omitempty"`
 
+	// Autoscaling sets the bounds within which Workloads of this type may configure autoscaling.
+	// If omitted, Workloads of this type cannot configure autoscaling.
+	// +optional
+	Autoscaling *AutoscalingLimits `json:"autoscaling,omitempty"`
+
 	// Validations are CEL-based rules evaluated during rendering.
 	//
 	// Deprecated: use PreRenderValidations. Retained for backward compatibility;
@@ -117,6 +122,7 @@ func (s *ClusterComponentTypeSpec) ToComponentTypeSpec() ComponentTypeSpec {
 		EnvironmentConfigs: s.EnvironmentConfigs,
 		Traits:             traits,
 		AllowedTraits:      allowedTraits,
+		Autoscaling:        s.Autoscaling,
 		//nolint:staticcheck // deprecated field still copied for backward compatibility
 		Validations:           s.Validations,
 		PreRenderValidations:  s.PreRenderValidations,
@@ -43,6 +43,11 @@ type ClusterWorkflowPlaneSpec struct {
 	// +optional
 	// +kubebuilder:validation:XValidation:rule="!has(self.kind) || self.kind == 'ClusterObservabilityPlane'",message="ClusterWorkflowPlane can only reference ClusterObservabilityPlane"
 	ObservabilityPlaneRef *ClusterObservabilityPlaneRef `json:"observabilityPlaneRef,omitempty"`
+
+	// BuildCache configures where builds running on this workflow plane keep their layer cache.
+	// Workflow templates read the configuration through ${workflowplane.buildCache.*}.
+	// +optional
+	BuildCache *BuildCacheConfig `json:"buildCache,omitempty"`
 }
 
 // ClusterWorkflowPlaneStatus defines the observed state of ClusterWorkflowPlane.
@@ -93,6 +93,11 @@ type ComponentTypeSpec struct {
 	// +optional
 	AllowedTraits []TraitRef `json:"allowedTraits,omitempty"`
 
+	// Autoscaling sets the bounds within which Workloads of this type may configure autoscaling.
+	// If omitted, Workloads of this type cannot configure autoscaling.
+	// +optional
+	Autoscaling *AutoscalingLimits `json:"autoscaling,omitempty"`
+
 	// Validations are CEL-based rules evaluated during rendering.
 	//
 	// Deprecated: use PreRenderValidations. Retained for backward compatibility;
@@ -131,6 +136,25 @@ func (s *ComponentTypeSpec) EffectivePreRenderValidations() []ValidationRule {
 	return s.Validations
 }
 
+// AutoscalingLimits bounds the autoscaling configuration of Workloads of a component type.
+type AutoscalingLimits struct {
+	// MinReplicas is the lowest minReplicas a Workload may configure. Defaults to 1,
+	// so scaling to zero must be allowed explicitly.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the highest maxReplicas a Workload may configure.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// AllowedTriggerTypes lists the KEDA trigger types Workloads may use.
+	// If empty, event-driven triggers are not allowed.
+	// +optional
+	AllowedTriggerTypes []string `json:"allowedTriggerTypes,omitempty"`
+}
+
 // ResourceTemplate defines a template for generating Kubernetes resources
 // +kubebuilder:validation:XValidation:rule="!has(self.forEach) || has(self.var)",message="var is required when forEach is specified"
 type ResourceTemplate struct {
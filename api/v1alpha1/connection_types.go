@@ -0,0 +1,142 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConnectionSpec defines the desired state of Connection.
+// Declares that a component consumes an endpoint of another component or the outputs
+// of a project-bound Resource, without changing the component's Workload.
+// +kubebuilder:validation:XValidation:rule="has(self.endpoint) != has(self.resource)",message="exactly one of endpoint or resource must be set"
+type ConnectionSpec struct {
+	// Owner identifies the consuming component and its project
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="owner is immutable"
+	Owner ConnectionOwner `json:"owner"`
+
+	// Environments limits the connection to the listed environments. When empty, the
+	// connection applies to every environment the component is deployed to.
+	// +optional
+	// +listType=set
+	Environments []string `json:"environments,omitempty"`
+
+	// Endpoint consumes an endpoint of another component. The resolved address is
+	// injected into the consuming container through the env bindings.
+	// +optional
+	Endpoint *WorkloadConnection `json:"endpoint,omitempty"`
+
+	// Resource consumes the outputs of a project-bound Resource, such as the host and
+	// credentials of a provisioned database. Outputs are injected as env vars and
+	// mounted as files.
+	// +optional
+	Resource *WorkloadResourceDependency `json:"resource,omitempty"`
+}
+
+// ConnectionOwner identifies the component and project a Connection belongs to.
+type ConnectionOwner struct {
+	// ProjectName is the name of the project that owns this component
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ProjectName string `json:"projectName"`
+
+	// ComponentName is the name of the component
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ComponentName string `json:"componentName"`
+}
+
+// ConnectionEnvironmentStatus reports the resolution of a Connection in one environment
+// the consuming component is deployed to.
+type ConnectionEnvironmentStatus struct {
+	// Environment is the name of the environment
+	Environment string `json:"environment"`
+
+	// ReleaseBinding is the name of the ReleaseBinding deploying the consumer to the environment
+	ReleaseBinding string `json:"releaseBinding"`
+
+	// Resolved indicates that the connection is resolved and injected into the consumer
+	Resolved bool `json:"resolved"`
+
+	// URL is the resolved address of the consumed endpoint
+	// +optional
+	URL *EndpointURL `json:"url,omitempty"`
+
+	// Message explains why the connection is not resolved
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ConnectionStatus defines the observed state of Connection.
+type ConnectionStatus struct {
+	// ObservedGeneration is the generation most recently observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the connection's state
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Environments reports the resolution of the connection per environment
+	// +optional
+	Environments []ConnectionEnvironmentStatus `json:"environments,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=conn;conns
+// +kubebuilder:printcolumn:name="Project",type=string,JSONPath=`.spec.owner.projectName`
+// +kubebuilder:printcolumn:name="Component",type=string,JSONPath=`.spec.owner.componentName`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// Connection is the Schema for the connections API.
+// Binds a consuming component to an endpoint of another component or to a project-bound
+// Resource. The ReleaseBinding controller resolves the connection at deploy time and
+// injects the resolved URLs and credentials into the consumer, the same way as the
+// dependencies declared in its Workload, which take precedence on conflicts.
+type Connection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConnectionSpec   `json:"spec,omitempty"`
+	Status ConnectionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ConnectionList contains a list of Connection.
+type ConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Connection `json:"items"`
+}
+
+// GetConditions returns the conditions from the status
+func (c *Connection) GetConditions() []metav1.Condition {
+	return c.Status.Conditions
+}
+
+// SetConditions sets the conditions in the status
+func (c *Connection) SetConditions(conditions []metav1.Condition) {
+	c.Status.Conditions = conditions
+}
+
+// AppliesTo reports whether the connection applies to the environment.
+func (c *Connection) AppliesTo(environment string) bool {
+	if len(c.Spec.Environments) == 0 {
+		return true
+	}
+	for _, env := range c.Spec.Environments {
+		if env == environment {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	SchemeBuilder.Register(&Connection{}, &ConnectionList{})
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CustomDomainSpec defines the desired state of CustomDomain.
+// Binds a domain owned by the team to an externally visible endpoint of a
+// component in one environment.
+type CustomDomainSpec struct {
+	// Owner identifies the component and project this CustomDomain belongs to
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="owner is immutable"
+	Owner CustomDomainOwner `json:"owner"`
+
+	// Environment is the environment the domain serves
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="environment is immutable"
+	Environment string `json:"environment"`
+
+	// Endpoint is the name of the workload endpoint the domain routes to. The endpoint
+	// must have external visibility.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Endpoint string `json:"endpoint"`
+
+	// Domain is the fully qualified host name to serve the endpoint on, e.g. api.example.com
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?\.)+[a-z]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="domain is immutable"
+	Domain string `json:"domain"`
+}
+
+// CustomDomainOwner identifies the component and project a CustomDomain belongs to.
+type CustomDomainOwner struct {
+	// ProjectName is the name of the project that owns this component
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ProjectName string `json:"projectName"`
+
+	// ComponentName is the name of the component
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ComponentName string `json:"componentName"`
+}
+
+// DNSRecord is a DNS record the domain owner has to create at their DNS provider.
+type DNSRecord struct {
+	// Type is the DNS record type, e.g. TXT or CNAME
+	Type string `json:"type"`
+
+	// Name is the fully qualified name of the record
+	Name string `json:"name"`
+
+	// Value is the content of the record
+	Value string `json:"value"`
+
+	// Purpose explains what the record is for
+	// +optional
+	Purpose string `json:"purpose,omitempty"`
+}
+
+// CustomDomainStatus defines the observed state of CustomDomain.
+type CustomDomainStatus struct {
+	// ObservedGeneration is the generation most recently observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the domain's state
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// DNSRecords lists the records to create at the DNS provider: a TXT record proving
+	// ownership of the domain and a CNAME record routing it to the endpoint's gateway host.
+	// +optional
+	DNSRecords []DNSRecord `json:"dnsRecords,omitempty"`
+
+	// VerifiedAt is when ownership of the domain was verified
+	// +optional
+	VerifiedAt *metav1.Time `json:"verifiedAt,omitempty"`
+
+	// Certificate reports the managed TLS certificate serving the domain, when the
+	// environment configures managed TLS
+	// +optional
+	Certificate *EndpointCertificateStatus `json:"certificate,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=cdom;cdoms
+// +kubebuilder:printcolumn:name="Domain",type=string,JSONPath=`.spec.domain`
+// +kubebuilder:printcolumn:name="Component",type=string,JSONPath=`.spec.owner.componentName`
+// +kubebuilder:printcolumn:name="Environment",type=string,JSONPath=`.spec.environment`
+// +kubebuilder:printcolumn:name="Endpoint",type=string,JSONPath=`.spec.endpoint`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// CustomDomain is the Schema for the customdomains API.
+// Serves an externally visible component endpoint on a domain owned by the team.
+// Once ownership of the domain is verified through a DNS TXT record, the domain
+// is added to the hosts of the endpoint's external gateway route and, when the
+// environment configures managed TLS, to the endpoint's certificate.
+type CustomDomain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CustomDomainSpec   `json:"spec,omitempty"`
+	Status CustomDomainStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CustomDomainList contains a list of CustomDomain.
+type CustomDomainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CustomDomain `json:"items"`
+}
+
+// GetConditions returns the conditions from the status
+func (c *CustomDomain) GetConditions() []metav1.Condition {
+	return c.Status.Conditions
+}
+
+// SetConditions sets the conditions in the status
+func (c *CustomDomain) SetConditions(conditions []metav1.Condition) {
+	c.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&CustomDomain{}, &CustomDomainList{})
+}
@@ -152,6 +152,26 @@ type AgentConnectionStatus struct {
 	Message string `json:"message,omitempty"`
 }
 
+// DataPlaneCapabilities records what was discovered in the data plane cluster
+// when the plane was registered through the API.
+type DataPlaneCapabilities struct {
+	// KubernetesVersion is the version reported by the data plane's API server
+	// +optional
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+
+	// GatewayAPI indicates that the Gateway API CRDs are installed
+	GatewayAPI bool `json:"gatewayAPI"`
+
+	// Cilium indicates that Cilium network policies are available
+	Cilium bool `json:"cilium"`
+
+	// ExternalSecrets indicates that the External Secrets Operator CRDs are installed
+	ExternalSecrets bool `json:"externalSecrets"`
+
+	// DiscoveredAt is when the capabilities were discovered
+	DiscoveredAt metav1.Time `json:"discoveredAt"`
+}
+
 // DataPlaneStatus defines the observed state of DataPlane.
 type DataPlaneStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
@@ -162,6 +182,10 @@ type DataPlaneStatus struct {
 	// AgentConnection tracks the status of cluster agent connections to this data plane
 	// +optional
 	AgentConnection *AgentConnectionStatus `json:"agentConnection,omitempty"`
+
+	// Capabilities lists the capabilities discovered when the data plane was registered
+	// +optional
+	Capabilities *DataPlaneCapabilities `json:"capabilities,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -21,6 +21,15 @@ type TargetEnvironmentRef struct {
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=63
 	Name string `json:"name"`
+	// RequiresApproval gates promotions into this environment behind a manual approval.
+	// Promotions along this path create a PromotionRequest that must be approved
+	// before the release is bound to the target environment.
+	// +optional
+	RequiresApproval bool `json:"requiresApproval,omitempty"`
+	// BlockCriticalVulnerabilities rejects promotions into this environment when the scan of
+	// the promoted image reports critical vulnerabilities. Images without a scan are not blocked.
+	// +optional
+	BlockCriticalVulnerabilities bool `json:"blockCriticalVulnerabilities,omitempty"`
 }
 
 // PromotionPath defines a path for promoting between environments
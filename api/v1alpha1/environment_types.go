@@ -19,6 +19,102 @@ type EnvironmentSpec struct {
 	DataPlaneRef *DataPlaneRef `json:"dataPlaneRef,omitempty"`
 	IsProduction bool          `json:"isProduction,omitempty"`
 	Gateway      GatewaySpec   `json:"gateway,omitempty"`
+
+	// TLS configures managed certificates for the endpoint hosts of components deployed
+	// to this environment. When set, a cert-manager Certificate is created for the hosts
+	// of every rendered endpoint route.
+	// +optional
+	TLS *EnvironmentTLSSpec `json:"tls,omitempty"`
+
+	// Sandbox marks this environment as a short-lived developer sandbox. A sandbox
+	// environment is deleted, together with its release bindings and data plane
+	// namespaces, once its TTL has elapsed.
+	// +optional
+	Sandbox *EnvironmentSandboxSpec `json:"sandbox,omitempty"`
+}
+
+// EnvironmentSandboxSpec configures an ephemeral sandbox environment.
+type EnvironmentSandboxSpec struct {
+	// TTL is how long the sandbox lives, counted from the creation of the environment.
+	// +kubebuilder:validation:Required
+	TTL metav1.Duration `json:"ttl"`
+
+	// Project is the project whose components are deployed to the sandbox.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Project string `json:"project"`
+
+	// SourceEnvironment is the environment the sandbox components were cloned from.
+	// +optional
+	SourceEnvironment string `json:"sourceEnvironment,omitempty"`
+
+	// Subdomain is prepended to the gateway hosts of the environment, so that the
+	// endpoints of the sandbox are exposed under <subdomain>.<gateway host>.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Subdomain string `json:"subdomain,omitempty"`
+
+	// PullRequest marks the sandbox as the preview environment of a pull request.
+	// Preview environments are created and deleted by the git webhook as the pull
+	// request is opened, updated and closed.
+	// +optional
+	PullRequest *SandboxPullRequest `json:"pullRequest,omitempty"`
+}
+
+// SandboxPullRequest identifies the pull request deployed to a preview environment.
+type SandboxPullRequest struct {
+	// Provider is the git provider hosting the repository.
+	// +kubebuilder:validation:Enum=github;gitlab
+	Provider string `json:"provider"`
+
+	// RepositoryURL is the URL of the repository the pull request targets.
+	// +kubebuilder:validation:MinLength=1
+	RepositoryURL string `json:"repositoryUrl"`
+
+	// Number is the number of the pull request within the repository.
+	// +kubebuilder:validation:Minimum=1
+	Number int64 `json:"number"`
+
+	// Branch is the head branch of the pull request.
+	// +optional
+	Branch string `json:"branch,omitempty"`
+
+	// Commit is the head commit of the pull request the preview is built from.
+	// +kubebuilder:validation:MinLength=1
+	Commit string `json:"commit"`
+}
+
+// EnvironmentTLSSpec configures the cert-manager certificates issued for endpoint hosts.
+type EnvironmentTLSSpec struct {
+	// IssuerRef references the cert-manager issuer in the data plane that signs endpoint certificates.
+	// +kubebuilder:validation:Required
+	IssuerRef CertificateIssuerRef `json:"issuerRef"`
+
+	// Duration is the requested lifetime of endpoint certificates.
+	// Defaults to the issuer's default lifetime.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// RenewBefore is how long before expiry an endpoint certificate is renewed.
+	// Defaults to a third of the certificate's lifetime.
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+}
+
+// CertificateIssuerRef references a cert-manager Issuer or ClusterIssuer.
+type CertificateIssuerRef struct {
+	// Name is the name of the issuer.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Kind is the kind of the issuer. A namespaced Issuer must exist in every
+	// data plane namespace that endpoint routes are rendered into.
+	// +optional
+	// +kubebuilder:default=ClusterIssuer
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	Kind string `json:"kind,omitempty"`
 }
 
 // EnvironmentStatus defines the observed state of Environment.
@@ -27,6 +123,15 @@ type EnvironmentStatus struct {
 	// Important: Run "make" to regenerate code after modifying this file
 	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
 	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+
+	// ExpiresAt is when a sandbox environment is deleted. Unset for regular environments.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// PreviewNotifiedCommit is the pull request commit whose preview URLs were last
+	// posted to the pull request. Unset for environments that are not previews.
+	// +optional
+	PreviewNotifiedCommit string `json:"previewNotifiedCommit,omitempty"`
 }
 
 // +kubebuilder:object:root=true
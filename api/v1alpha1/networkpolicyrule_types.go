@@ -0,0 +1,105 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NetworkPolicyRuleAction is the effect of a NetworkPolicyRule on matching traffic.
+// +kubebuilder:validation:Enum=Allow;Deny
+type NetworkPolicyRuleAction string
+
+const (
+	// NetworkPolicyRuleActionAllow permits traffic the endpoint visibility would not allow.
+	NetworkPolicyRuleActionAllow NetworkPolicyRuleAction = "Allow"
+	// NetworkPolicyRuleActionDeny blocks traffic the endpoint visibility or an Allow rule would allow.
+	NetworkPolicyRuleActionDeny NetworkPolicyRuleAction = "Deny"
+)
+
+// NetworkPolicyRuleSpec defines the desired state of NetworkPolicyRule.
+// Adjusts the ingress the cell-based network policies of components permit, on top of
+// what endpoint visibility grants. Deny rules take precedence over Allow rules.
+type NetworkPolicyRuleSpec struct {
+	// Action is Allow or Deny
+	// +kubebuilder:validation:Required
+	Action NetworkPolicyRuleAction `json:"action"`
+
+	// Source selects the calling components. An empty source matches every component
+	// of the namespace. Platform system components such as gateways are never matched.
+	// +optional
+	Source NetworkPolicyRulePeer `json:"source,omitempty"`
+
+	// Destination selects the called components
+	// +kubebuilder:validation:Required
+	Destination NetworkPolicyRuleDestination `json:"destination"`
+
+	// Environments restricts the rule to the listed environments. An empty list applies
+	// the rule in every environment.
+	// +optional
+	Environments []string `json:"environments,omitempty"`
+
+	// Description explains why the rule exists
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// NetworkPolicyRulePeer selects components by project and, optionally, by name.
+// +kubebuilder:validation:XValidation:rule="!has(self.component) || has(self.project)",message="component requires project"
+type NetworkPolicyRulePeer struct {
+	// Project is the name of the project. Empty matches every project.
+	// +optional
+	Project string `json:"project,omitempty"`
+
+	// Component is the name of the component. Empty matches every component of the project.
+	// +optional
+	Component string `json:"component,omitempty"`
+}
+
+// NetworkPolicyRuleDestination selects the called components and endpoints.
+type NetworkPolicyRuleDestination struct {
+	// Project is the name of the project the called components belong to
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Project string `json:"project"`
+
+	// Component is the name of the component. Empty matches every component of the project.
+	// +optional
+	Component string `json:"component,omitempty"`
+
+	// Endpoints restricts the rule to the named workload endpoints. An empty list matches
+	// every endpoint.
+	// +optional
+	Endpoints []string `json:"endpoints,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=npr;nprs
+// +kubebuilder:printcolumn:name="Action",type=string,JSONPath=`.spec.action`
+// +kubebuilder:printcolumn:name="Source",type=string,JSONPath=`.spec.source.project`
+// +kubebuilder:printcolumn:name="Destination",type=string,JSONPath=`.spec.destination.project`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NetworkPolicyRule is the Schema for the networkpolicyrules API.
+// An organization-level rule that allows or denies traffic between the components of the
+// namespace. The rules are applied to the network policies rendered for each component.
+type NetworkPolicyRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec NetworkPolicyRuleSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkPolicyRuleList contains a list of NetworkPolicyRule.
+type NetworkPolicyRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkPolicyRule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NetworkPolicyRule{}, &NetworkPolicyRuleList{})
+}
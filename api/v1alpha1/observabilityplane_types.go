@@ -42,6 +42,12 @@ type ObservabilityPlaneSpec struct {
 	// FinOpsAgentURL is the base URL of the FinOps Agent API in the observability plane cluster
 	// +optional
 	FinOpsAgentURL string `json:"finOpsAgentURL,omitempty"`
+
+	// DashboardURL is a URL template of the observability dashboard of a component.
+	// The placeholders {namespace}, {project}, {component} and {environment} are
+	// replaced with the names of the component and environment to view.
+	// +optional
+	DashboardURL string `json:"dashboardURL,omitempty"`
 }
 
 // ObservabilityPlaneStatus defines the observed state of ObservabilityPlane.
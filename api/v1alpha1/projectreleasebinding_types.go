@@ -4,6 +4,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -44,6 +45,37 @@ type ProjectReleaseBindingSpec struct {
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +kubebuilder:validation:Schemaless
 	EnvironmentConfigs *runtime.RawExtension `json:"environmentConfigs,omitempty"`
+
+	// Quota limits the compute resources and object counts of the project in
+	// this environment. It is applied to the cell namespace alongside the
+	// (Cluster)ProjectType resources.
+	// +optional
+	Quota *ProjectQuota `json:"quota,omitempty"`
+}
+
+// ProjectQuota limits the resources of a project in an environment. Hard is
+// materialized in the cell namespace as a ResourceQuota; the container bounds
+// as a LimitRange. When Hard limits requests or limits of a resource, pods
+// must set them, so set DefaultRequest and Default for that resource too.
+type ProjectQuota struct {
+	// Hard is the total amount of each resource the cell namespace may use,
+	// keyed as in a ResourceQuota, e.g. requests.cpu, limits.memory, pods or
+	// count/deployments.apps.
+	// +optional
+	Hard corev1.ResourceList `json:"hard,omitempty"`
+
+	// DefaultRequest is the resource request of containers that do not set
+	// one, keyed by cpu, memory or ephemeral-storage.
+	// +optional
+	DefaultRequest corev1.ResourceList `json:"defaultRequest,omitempty"`
+
+	// Default is the resource limit of containers that do not set one.
+	// +optional
+	Default corev1.ResourceList `json:"default,omitempty"`
+
+	// Max is the largest resource limit a container may set.
+	// +optional
+	Max corev1.ResourceList `json:"max,omitempty"`
 }
 
 // ProjectReleaseBindingOwner identifies the project this ProjectReleaseBinding belongs to.
@@ -73,6 +105,23 @@ type ProjectReleaseBindingStatus struct {
 	// debugging.
 	// +optional
 	Namespace string `json:"namespace,omitempty"`
+
+	// Quota is the usage of spec.quota as observed in the cell namespace.
+	// Unset when no quota is set or its usage has not been observed yet.
+	// +optional
+	Quota *ProjectQuotaStatus `json:"quota,omitempty"`
+}
+
+// ProjectQuotaStatus is the enforced quota of a project in an environment and
+// its current usage.
+type ProjectQuotaStatus struct {
+	// Hard is the quota enforced in the cell namespace.
+	// +optional
+	Hard corev1.ResourceList `json:"hard,omitempty"`
+
+	// Used is the current usage of each resource of Hard.
+	// +optional
+	Used corev1.ResourceList `json:"used,omitempty"`
 }
 
 // +kubebuilder:object:root=true
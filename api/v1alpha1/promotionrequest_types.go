@@ -0,0 +1,145 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PromotionRequestSpec defines the desired state of PromotionRequest.
+// Records a request to promote the component release bound in the source
+// environment to the target environment. The spec is immutable; the decision
+// and its outcome are recorded in the status.
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable"
+type PromotionRequestSpec struct {
+	// Owner identifies the component and project this PromotionRequest belongs to
+	// +kubebuilder:validation:Required
+	Owner PromotionRequestOwner `json:"owner"`
+
+	// SourceEnvironment is the environment the release is promoted from
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	SourceEnvironment string `json:"sourceEnvironment"`
+
+	// TargetEnvironment is the environment the release is promoted to
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	TargetEnvironment string `json:"targetEnvironment"`
+
+	// ReleaseName is the ComponentRelease bound in the source environment when the
+	// promotion was requested. This is the release bound to the target on approval.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ReleaseName string `json:"releaseName"`
+
+	// RequestedBy identifies the subject that requested the promotion
+	// +optional
+	RequestedBy string `json:"requestedBy,omitempty"`
+
+	// Comment is an optional note from the requester
+	// +optional
+	Comment string `json:"comment,omitempty"`
+}
+
+// PromotionRequestOwner identifies the component and project a PromotionRequest belongs to.
+type PromotionRequestOwner struct {
+	// ProjectName is the name of the project that owns this component
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ProjectName string `json:"projectName"`
+
+	// ComponentName is the name of the component
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ComponentName string `json:"componentName"`
+}
+
+// PromotionRequestPhase is the lifecycle phase of a PromotionRequest.
+// +kubebuilder:validation:Enum=PendingApproval;Promoted;Rejected
+type PromotionRequestPhase string
+
+const (
+	// PromotionRequestPhasePendingApproval means the promotion waits for an approver.
+	PromotionRequestPhasePendingApproval PromotionRequestPhase = "PendingApproval"
+	// PromotionRequestPhasePromoted means the release was bound to the target environment.
+	PromotionRequestPhasePromoted PromotionRequestPhase = "Promoted"
+	// PromotionRequestPhaseRejected means an approver rejected the promotion.
+	PromotionRequestPhaseRejected PromotionRequestPhase = "Rejected"
+)
+
+// PromotionDecision records who approved or rejected a promotion and when.
+type PromotionDecision struct {
+	// Approved is true when the promotion was approved and false when it was rejected
+	Approved bool `json:"approved"`
+
+	// DecidedBy identifies the subject that made the decision
+	// +optional
+	DecidedBy string `json:"decidedBy,omitempty"`
+
+	// DecidedAt is when the decision was made
+	DecidedAt metav1.Time `json:"decidedAt"`
+
+	// Comment is an optional note from the approver
+	// +optional
+	Comment string `json:"comment,omitempty"`
+}
+
+// PromotionRequestStatus defines the observed state of PromotionRequest.
+type PromotionRequestStatus struct {
+	// Phase is the lifecycle phase of the promotion
+	// +optional
+	Phase PromotionRequestPhase `json:"phase,omitempty"`
+
+	// ApprovalRequired reports whether the promotion path requires a manual approval
+	// +optional
+	ApprovalRequired bool `json:"approvalRequired,omitempty"`
+
+	// Decision records the approval or rejection, when one was made
+	// +optional
+	Decision *PromotionDecision `json:"decision,omitempty"`
+
+	// ReleaseBindingName is the target ReleaseBinding created or updated by the promotion
+	// +optional
+	ReleaseBindingName string `json:"releaseBindingName,omitempty"`
+
+	// PromotedAt is when the release was bound to the target environment
+	// +optional
+	PromotedAt *metav1.Time `json:"promotedAt,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=promreq;promreqs
+// +kubebuilder:printcolumn:name="Component",type=string,JSONPath=`.spec.owner.componentName`
+// +kubebuilder:printcolumn:name="Source",type=string,JSONPath=`.spec.sourceEnvironment`
+// +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.targetEnvironment`
+// +kubebuilder:printcolumn:name="Release",type=string,JSONPath=`.spec.releaseName`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// PromotionRequest is the Schema for the promotionrequests API.
+// Records a promotion of a component release from one environment to another
+// along a DeploymentPipeline promotion path, together with its approval
+// decision. Created and decided through the OpenChoreo API, which binds the
+// release to the target environment once the promotion is approved.
+type PromotionRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PromotionRequestSpec   `json:"spec,omitempty"`
+	Status PromotionRequestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PromotionRequestList contains a list of PromotionRequest.
+type PromotionRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PromotionRequest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PromotionRequest{}, &PromotionRequestList{})
+}
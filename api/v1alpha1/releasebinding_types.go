@@ -137,6 +137,11 @@ type PendingResourceDependency struct {
 
 // ContainerOverride represents a single container in the workload.
 type ContainerOverride struct {
+	// Image replaces the container image of the workload, e.g. with an image built
+	// from a pull request for a preview environment.
+	// +optional
+	Image string `json:"image,omitempty"`
+
 	// Explicit environment variables.
 	// +optional
 	Env []EnvVar `json:"env,omitempty"`
@@ -148,7 +153,7 @@ type ContainerOverride struct {
 
 // WorkloadOverrideTemplateSpec defines overrides for workload configuration.
 type WorkloadOverrideTemplateSpec struct {
-	// Container override for env and file configurations.
+	// Container override for the image, env and file configurations.
 	// +optional
 	Container *ContainerOverride `json:"container,omitempty"`
 }
@@ -195,6 +200,110 @@ type ReleaseBindingSpec struct {
 	// +kubebuilder:validation:Enum=Active;Undeploy
 	// +optional
 	State ReleaseState `json:"state,omitempty"`
+
+	// Strategy controls how a new release is rolled out to the environment.
+	// Without a strategy the workloads are updated in place.
+	// +optional
+	Strategy *RolloutStrategy `json:"strategy,omitempty"`
+}
+
+// RolloutStrategyType is the way a new release replaces the running one.
+// +kubebuilder:validation:Enum=RollingUpdate;Canary;BlueGreen
+type RolloutStrategyType string
+
+const (
+	// RolloutStrategyRollingUpdate updates the workloads of the running release in place.
+	RolloutStrategyRollingUpdate RolloutStrategyType = "RollingUpdate"
+	// RolloutStrategyCanary runs the new release next to the running one and shifts gateway
+	// traffic to it in steps.
+	RolloutStrategyCanary RolloutStrategyType = "Canary"
+	// RolloutStrategyBlueGreen runs the new release next to the running one without traffic
+	// and switches all traffic to it when the rollout is promoted.
+	RolloutStrategyBlueGreen RolloutStrategyType = "BlueGreen"
+)
+
+// RolloutStrategy defines how a new release is rolled out.
+// +kubebuilder:validation:XValidation:rule="self.type != 'Canary' || has(self.canary)",message="canary is required for the Canary strategy"
+type RolloutStrategy struct {
+	// Type is the rollout strategy
+	// +kubebuilder:default=RollingUpdate
+	// +optional
+	Type RolloutStrategyType `json:"type,omitempty"`
+
+	// Canary configures the traffic steps of a Canary rollout
+	// +optional
+	Canary *CanaryStrategy `json:"canary,omitempty"`
+}
+
+// CanaryStrategy defines the traffic steps of a canary rollout.
+type CanaryStrategy struct {
+	// Steps are applied in order. The rollout is promoted once the last step completes.
+	// +kubebuilder:validation:MinItems=1
+	Steps []CanaryStep `json:"steps"`
+}
+
+// CanaryStep routes a share of the traffic to the new release.
+type CanaryStep struct {
+	// Weight is the percentage of gateway traffic routed to the new release
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Weight int32 `json:"weight"`
+
+	// Pause is how long to stay at this step before moving to the next one.
+	// Without a pause the rollout waits at this step until it is resumed or promoted.
+	// +optional
+	Pause *metav1.Duration `json:"pause,omitempty"`
+}
+
+// RolloutPhase is the phase of a rollout.
+type RolloutPhase string
+
+const (
+	// RolloutPhaseProgressing means the rollout is moving through its steps.
+	RolloutPhaseProgressing RolloutPhase = "Progressing"
+	// RolloutPhasePaused means the rollout waits to be resumed, promoted or aborted.
+	RolloutPhasePaused RolloutPhase = "Paused"
+	// RolloutPhaseSucceeded means the new release receives all traffic.
+	RolloutPhaseSucceeded RolloutPhase = "Succeeded"
+	// RolloutPhaseAborted means the rollout was aborted and the stable release receives all traffic.
+	RolloutPhaseAborted RolloutPhase = "Aborted"
+)
+
+// RolloutStatus is the observed state of the rollout of a Canary or BlueGreen binding.
+type RolloutStatus struct {
+	// Strategy is the strategy the rollout uses
+	Strategy RolloutStrategyType `json:"strategy"`
+
+	// Phase is the phase of the rollout
+	Phase RolloutPhase `json:"phase"`
+
+	// StableRelease is the release serving traffic before the rollout
+	// +optional
+	StableRelease string `json:"stableRelease,omitempty"`
+
+	// CanaryRelease is the release being rolled out
+	// +optional
+	CanaryRelease string `json:"canaryRelease,omitempty"`
+
+	// Step is the index of the current canary step
+	// +optional
+	Step int32 `json:"step,omitempty"`
+
+	// CanaryWeight is the percentage of gateway traffic routed to the canary release
+	// +optional
+	CanaryWeight int32 `json:"canaryWeight,omitempty"`
+
+	// StepStartedAt is when the current step started
+	// +optional
+	StepStartedAt *metav1.Time `json:"stepStartedAt,omitempty"`
+
+	// ObservedAction is the last rollout action request handled by the controller
+	// +optional
+	ObservedAction string `json:"observedAction,omitempty"`
+
+	// Message describes the current state of the rollout
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // ReleaseBindingOwner identifies the component this ReleaseBinding belongs to
@@ -279,6 +388,46 @@ type EndpointURLStatus struct {
 	// ExternalURLs holds the resolved external gateway URLs.
 	// +optional
 	ExternalURLs *EndpointGatewayURLs `json:"externalURLs,omitempty"`
+
+	// CustomDomains lists the verified custom domains served by the endpoint's external route.
+	// +optional
+	CustomDomains []string `json:"customDomains,omitempty"`
+
+	// Certificates reports the managed TLS certificates issued for the endpoint's hosts.
+	// +optional
+	Certificates []EndpointCertificateStatus `json:"certificates,omitempty"`
+}
+
+// EndpointCertificateStatus reports a managed TLS certificate issued for the hosts of an endpoint route.
+type EndpointCertificateStatus struct {
+	// Name is the name of the cert-manager Certificate in the data plane.
+	Name string `json:"name"`
+
+	// SecretName is the name of the Secret that holds the issued certificate.
+	SecretName string `json:"secretName"`
+
+	// Visibility is the visibility of the endpoint route the certificate was issued for.
+	// +optional
+	Visibility EndpointVisibility `json:"visibility,omitempty"`
+
+	// DNSNames are the hosts the certificate is issued for.
+	// +optional
+	DNSNames []string `json:"dnsNames,omitempty"`
+
+	// Ready reports whether an up-to-date certificate has been issued.
+	Ready bool `json:"ready"`
+
+	// NotAfter is the expiry time of the issued certificate.
+	// +optional
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+
+	// RenewalTime is when the certificate is due to be renewed.
+	// +optional
+	RenewalTime *metav1.Time `json:"renewalTime,omitempty"`
+
+	// Message explains why the certificate is not ready.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // ReleaseBindingStatus defines the observed state of ReleaseBinding.
@@ -329,6 +478,67 @@ type ReleaseBindingStatus struct {
 	// Used as an index source for finding affected ReleaseBindings when a SecretReference changes.
 	// +optional
 	SecretReferenceNames []string `json:"secretReferenceNames,omitempty"`
+
+	// History records the releases bound to the environment, newest first.
+	// A new entry is added whenever the bound release or its environment configuration changes.
+	// Only the most recent entries are kept.
+	// +optional
+	History []ReleaseRecord `json:"history,omitempty"`
+
+	// Rollout is the state of the rollout of the bound release when the binding uses
+	// the Canary or BlueGreen strategy.
+	// +optional
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+}
+
+// ReleaseRecord is an entry in the release history of a ReleaseBinding.
+type ReleaseRecord struct {
+	// ReleaseName is the name of the ComponentRelease that was bound
+	ReleaseName string `json:"releaseName"`
+
+	// Image is the container image of the release's workload
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// ConfigChecksum is a checksum of the release and the binding's environment configuration
+	ConfigChecksum string `json:"configChecksum"`
+
+	// Trigger is what caused the release, e.g. deploy, promotion, auto-deploy, api or manual
+	// +optional
+	Trigger string `json:"trigger,omitempty"`
+
+	// TriggeredBy identifies who triggered the release, when known
+	// +optional
+	TriggeredBy string `json:"triggeredBy,omitempty"`
+
+	// DeployedAt is when the release was bound to the environment
+	DeployedAt metav1.Time `json:"deployedAt"`
+
+	// SupersededAt is when a newer release replaced this one
+	// +optional
+	SupersededAt *metav1.Time `json:"supersededAt,omitempty"`
+
+	// Snapshot is the binding's environment configuration at the time of the release,
+	// used to restore it on rollback
+	// +optional
+	Snapshot *ReleaseConfigSnapshot `json:"snapshot,omitempty"`
+}
+
+// ReleaseConfigSnapshot captures the environment-specific configuration of a ReleaseBinding.
+type ReleaseConfigSnapshot struct {
+	// ComponentTypeEnvironmentConfigs for ComponentType environmentConfigs parameters
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	ComponentTypeEnvironmentConfigs *runtime.RawExtension `json:"componentTypeEnvironmentConfigs,omitempty"`
+
+	// TraitEnvironmentConfigs provides environment-specific overrides for trait configurations
+	// +optional
+	TraitEnvironmentConfigs map[string]runtime.RawExtension `json:"traitEnvironmentConfigs,omitempty"`
+
+	// WorkloadOverrides provides environment-specific overrides for the entire workload spec
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	WorkloadOverrides *WorkloadOverrideTemplateSpec `json:"workloadOverrides,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -4,6 +4,7 @@
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -39,6 +40,91 @@ type WorkflowPlaneSpec struct {
 	// If not specified, defaults to an ObservabilityPlane named "default" in the same namespace.
 	// +optional
 	ObservabilityPlaneRef *ObservabilityPlaneRef `json:"observabilityPlaneRef,omitempty"`
+
+	// BuildCache configures where builds running on this workflow plane keep their layer cache.
+	// Workflow templates read the configuration through ${workflowplane.buildCache.*}.
+	// +optional
+	BuildCache *BuildCacheConfig `json:"buildCache,omitempty"`
+}
+
+// BuildCacheType identifies where the build cache is kept.
+// +kubebuilder:validation:Enum=Registry;PersistentVolume
+type BuildCacheType string
+
+const (
+	// BuildCacheTypeRegistry keeps cached layers as images in a container registry.
+	BuildCacheTypeRegistry BuildCacheType = "Registry"
+	// BuildCacheTypePersistentVolume keeps cached layers on a volume shared by the builds of a namespace.
+	BuildCacheTypePersistentVolume BuildCacheType = "PersistentVolume"
+)
+
+// BuildCacheConfig configures the build cache of a workflow plane.
+// +kubebuilder:validation:XValidation:rule="self.type != 'Registry' || has(self.registry)",message="registry is required when type is Registry"
+// +kubebuilder:validation:XValidation:rule="self.type != 'PersistentVolume' || has(self.persistentVolume)",message="persistentVolume is required when type is PersistentVolume"
+type BuildCacheConfig struct {
+	// Type selects where the cache is kept.
+	// +required
+	Type BuildCacheType `json:"type"`
+
+	// Registry configures a registry-based cache. Required when type is Registry.
+	// +optional
+	Registry *RegistryBuildCache `json:"registry,omitempty"`
+
+	// PersistentVolume configures a volume-based cache. Required when type is PersistentVolume.
+	// +optional
+	PersistentVolume *PersistentVolumeBuildCache `json:"persistentVolume,omitempty"`
+}
+
+// RegistryBuildCache keeps cached layers in a container registry. Each component gets its own
+// cache image under the repository.
+type RegistryBuildCache struct {
+	// Repository is the registry repository the cache images are pushed to, e.g. "registry.example.com/build-cache".
+	// Builds push with the credentials they use to publish images.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Repository string `json:"repository"`
+}
+
+// PersistentVolumeBuildCache keeps cached layers on a PersistentVolumeClaim created in the
+// workflow namespace of each namespace that runs builds.
+type PersistentVolumeBuildCache struct {
+	// Size is the requested capacity of the cache volume.
+	// +optional
+	// +kubebuilder:default="20Gi"
+	Size resource.Quantity `json:"size,omitempty"`
+
+	// StorageClassName is the storage class of the cache volume. The cluster default is used when empty.
+	// The storage class must support ReadWriteMany for builds of a namespace to run concurrently.
+	// +optional
+	StorageClassName string `json:"storageClassName,omitempty"`
+}
+
+// WorkflowPlaneCapacity is the workload and node headroom observed by the
+// periodic health probe of a workflow plane.
+type WorkflowPlaneCapacity struct {
+	// PendingWorkflows is the number of workflows that have not started yet
+	PendingWorkflows int32 `json:"pendingWorkflows"`
+
+	// RunningWorkflows is the number of workflows that are currently running
+	RunningWorkflows int32 `json:"runningWorkflows"`
+
+	// OldestPendingSince is the creation time of the longest waiting pending workflow
+	// +optional
+	OldestPendingSince *metav1.Time `json:"oldestPendingSince,omitempty"`
+
+	// ReadyNodes is the number of ready, schedulable nodes
+	ReadyNodes int32 `json:"readyNodes"`
+
+	// AvailableCPU is the allocatable CPU on ready nodes not yet requested by pods
+	// +optional
+	AvailableCPU *resource.Quantity `json:"availableCPU,omitempty"`
+
+	// AvailableMemory is the allocatable memory on ready nodes not yet requested by pods
+	// +optional
+	AvailableMemory *resource.Quantity `json:"availableMemory,omitempty"`
+
+	// LastProbeTime is when the capacity was last observed
+	LastProbeTime metav1.Time `json:"lastProbeTime"`
 }
 
 // WorkflowPlaneStatus defines the observed state of WorkflowPlane.
@@ -57,6 +143,10 @@ type WorkflowPlaneStatus struct {
 	// AgentConnection tracks the status of cluster agent connections to this workflow plane
 	// +optional
 	AgentConnection *AgentConnectionStatus `json:"agentConnection,omitempty"`
+
+	// Capacity reports pending and running workflows and node headroom in the workflow plane
+	// +optional
+	Capacity *WorkflowPlaneCapacity `json:"capacity,omitempty"`
 }
 
 // +kubebuilder:object:root=true
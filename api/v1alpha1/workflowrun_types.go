@@ -26,6 +26,11 @@ type WorkflowRunSpec struct {
 	// +optional
 	// +kubebuilder:validation:Pattern=`^(\d+d)?(\d+h)?(\d+m)?(\d+s)?$`
 	TTLAfterCompletion string `json:"ttlAfterCompletion,omitempty"`
+
+	// DisableBuildCache runs the build without the build cache of the workflow plane,
+	// e.g. to rule out a stale cache. Workflow templates read it as ${workflowplane.buildCache.enabled}.
+	// +optional
+	DisableBuildCache bool `json:"disableBuildCache,omitempty"`
 }
 
 // WorkflowRunConfig defines the workflow configuration for execution.
@@ -141,8 +146,131 @@ type WorkflowRunStatus struct {
 	// This is used together with TTLAfterCompletion to determine when to delete the workflow run.
 	// +optional
 	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+
+	// Image is the container image produced by this workflow run, including its digest when
+	// reported. It is read from the "image" output parameter of the workflow steps.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Scan holds the vulnerability scan results of the produced image. It is ingested from
+	// the Trivy or Grype JSON report in the "scan-report" output parameter of the workflow steps.
+	// +optional
+	Scan *ImageScanResult `json:"scan,omitempty"`
+
+	// SBOM references the software bill of materials generated for the produced image. It is
+	// read from the "sbom-ref" and "sbom-format" output parameters of the workflow steps.
+	// +optional
+	SBOM *SBOMReference `json:"sbom,omitempty"`
+
+	// Cache reports how much of the build was served from the build cache. It is read from the
+	// "cache-hits" and "cache-misses" output parameters of the workflow steps.
+	// +optional
+	Cache *BuildCacheStatistics `json:"cache,omitempty"`
+}
+
+// SBOMReference locates the software bill of materials of a container image.
+type SBOMReference struct {
+	// Format of the SBOM document.
+	// +kubebuilder:validation:Enum=SPDX;CycloneDX
+	// +optional
+	Format SBOMFormat `json:"format,omitempty"`
+
+	// Location is where the document is stored, such as the OCI reference of an artifact
+	// attached to the image or a URL.
+	// +kubebuilder:validation:MinLength=1
+	Location string `json:"location"`
+
+	// RecordedAt is the time the reference was recorded.
+	// +optional
+	RecordedAt *metav1.Time `json:"recordedAt,omitempty"`
+}
+
+// BuildCacheStatistics counts the cache lookups of a build, typically image layers.
+type BuildCacheStatistics struct {
+	// Hits is the number of lookups served from the cache
+	Hits int32 `json:"hits"`
+
+	// Misses is the number of lookups that had to be rebuilt
+	Misses int32 `json:"misses"`
 }
 
+// SBOMFormat is the document format of a software bill of materials.
+type SBOMFormat string
+
+const (
+	SBOMFormatSPDX      SBOMFormat = "SPDX"
+	SBOMFormatCycloneDX SBOMFormat = "CycloneDX"
+)
+
+// ImageScanResult summarizes a vulnerability scan of a container image.
+type ImageScanResult struct {
+	// Scanner is the tool that produced the report.
+	// +kubebuilder:validation:Enum=trivy;grype
+	Scanner string `json:"scanner"`
+
+	// ScannedAt is the time the report was ingested.
+	// +optional
+	ScannedAt *metav1.Time `json:"scannedAt,omitempty"`
+
+	// Summary counts the findings by severity.
+	Summary VulnerabilitySummary `json:"summary"`
+
+	// Vulnerabilities lists the findings, most severe first. The list is capped; Truncated
+	// reports whether findings were left out. The summary always counts every finding.
+	// +optional
+	// +kubebuilder:validation:MaxItems=200
+	Vulnerabilities []Vulnerability `json:"vulnerabilities,omitempty"`
+
+	// Truncated is true when the report had more findings than are listed.
+	// +optional
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// VulnerabilitySummary counts vulnerability findings by severity.
+type VulnerabilitySummary struct {
+	Critical int32 `json:"critical"`
+	High     int32 `json:"high"`
+	Medium   int32 `json:"medium"`
+	Low      int32 `json:"low"`
+	Unknown  int32 `json:"unknown"`
+}
+
+// Vulnerability is a single finding of an image scan.
+type Vulnerability struct {
+	// ID is the vulnerability identifier, e.g. a CVE or GHSA ID.
+	ID string `json:"id"`
+
+	// Severity of the finding.
+	// +kubebuilder:validation:Enum=Critical;High;Medium;Low;Unknown
+	Severity VulnerabilitySeverity `json:"severity"`
+
+	// Package is the name of the affected package.
+	Package string `json:"package"`
+
+	// InstalledVersion is the version of the package found in the image.
+	// +optional
+	InstalledVersion string `json:"installedVersion,omitempty"`
+
+	// FixedVersion is the version that fixes the vulnerability, when one is known.
+	// +optional
+	FixedVersion string `json:"fixedVersion,omitempty"`
+
+	// Title is a short description of the vulnerability.
+	// +optional
+	Title string `json:"title,omitempty"`
+}
+
+// VulnerabilitySeverity is the normalized severity of a vulnerability finding.
+type VulnerabilitySeverity string
+
+const (
+	VulnerabilitySeverityCritical VulnerabilitySeverity = "Critical"
+	VulnerabilitySeverityHigh     VulnerabilitySeverity = "High"
+	VulnerabilitySeverityMedium   VulnerabilitySeverity = "Medium"
+	VulnerabilitySeverityLow      VulnerabilitySeverity = "Low"
+	VulnerabilitySeverityUnknown  VulnerabilitySeverity = "Unknown"
+)
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 
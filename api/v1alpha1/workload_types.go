@@ -207,8 +207,8 @@ type ConnectionEnvBindings struct {
 	BasePath string `json:"basePath,omitempty"`
 }
 
-// WorkloadDependencies defines the dependencies of a workload on other components' endpoints
-// and on project-bound Resources.
+// WorkloadDependencies defines the dependencies of a workload on other components' endpoints,
+// on project-bound Resources and on services running outside OpenChoreo.
 type WorkloadDependencies struct {
 	// Endpoints define how this workload consumes endpoints from other components.
 	// +optional
@@ -223,6 +223,34 @@ type WorkloadDependencies struct {
 	// +listMapKey=ref
 	// +kubebuilder:validation:MaxItems=50
 	Resources []WorkloadResourceDependency `json:"resources,omitempty"`
+
+	// External define how this workload consumes services running outside OpenChoreo,
+	// such as SaaS APIs or databases managed elsewhere.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=50
+	External []WorkloadExternalConnection `json:"external,omitempty"`
+}
+
+// WorkloadExternalConnection represents a connection to a service running outside OpenChoreo.
+// The address is static, so the connection is always resolved and its parts are wired into
+// the consuming container through the same env bindings as component connections.
+type WorkloadExternalConnection struct {
+	// Name identifies the external service within the workload.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Address of the external service, either as a URL (scheme://host[:port][/path])
+	// or as host:port.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Address string `json:"address"`
+
+	// EnvBindings maps semantic URL components to environment variable names.
+	// +kubebuilder:validation:Required
+	EnvBindings ConnectionEnvBindings `json:"envBindings"`
 }
 
 // WorkloadResourceDependency represents a dependency on a project-bound Resource. Output names
@@ -266,6 +294,68 @@ type WorkloadTemplateSpec struct {
 	// Dependencies define the dependencies of this workload on other components.
 	// +optional
 	Dependencies *WorkloadDependencies `json:"dependencies,omitempty"`
+
+	// Autoscaling configures horizontal autoscaling of the workload. Resource targets are
+	// rendered as a HorizontalPodAutoscaler; event-driven triggers as a KEDA ScaledObject.
+	// +optional
+	Autoscaling *AutoscalingConfig `json:"autoscaling,omitempty"`
+}
+
+// AutoscalingConfig defines the horizontal autoscaling behavior of a workload.
+// +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || self.minReplicas <= self.maxReplicas",message="minReplicas must not exceed maxReplicas"
+// +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || self.minReplicas > 0 || (has(self.triggers) && size(self.triggers) > 0)",message="minReplicas can only be 0 when event-driven triggers are configured"
+type AutoscalingConfig struct {
+	// MinReplicas is the lower bound of the replica count. Defaults to 1.
+	// Scaling to zero requires at least one event-driven trigger.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the upper bound of the replica count.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// TargetCPUUtilizationPercentage is the average CPU utilization, relative to the
+	// container's CPU request, the autoscaler aims for.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+
+	// TargetMemoryUtilizationPercentage is the average memory utilization, relative to the
+	// container's memory request, the autoscaler aims for.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TargetMemoryUtilizationPercentage *int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+
+	// Triggers are KEDA event-driven scaling triggers, such as queue length or cron schedules.
+	// +optional
+	// +kubebuilder:validation:MaxItems=20
+	Triggers []AutoscalingTrigger `json:"triggers,omitempty"`
+}
+
+// GetMinReplicas returns the configured minimum replica count, defaulting to 1.
+func (a *AutoscalingConfig) GetMinReplicas() int32 {
+	if a.MinReplicas == nil {
+		return 1
+	}
+	return *a.MinReplicas
+}
+
+// AutoscalingTrigger defines a KEDA scaler trigger.
+type AutoscalingTrigger struct {
+	// Type is the KEDA scaler type (e.g., kafka, rabbitmq, prometheus, cron).
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Type string `json:"type"`
+
+	// Metadata holds the scaler-specific configuration.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// AuthenticationRef names a KEDA TriggerAuthentication in the workload's namespace.
+	// +optional
+	AuthenticationRef string `json:"authenticationRef,omitempty"`
 }
 
 // GetDependencyEndpoints returns the endpoint connections from dependencies, or nil if none.
@@ -284,6 +374,14 @@ func (w *WorkloadTemplateSpec) GetDependencyResources() []WorkloadResourceDepend
 	return w.Dependencies.Resources
 }
 
+// GetDependencyExternal returns the connections to external services, or nil if none.
+func (w *WorkloadTemplateSpec) GetDependencyExternal() []WorkloadExternalConnection {
+	if w.Dependencies == nil {
+		return nil
+	}
+	return w.Dependencies.External
+}
+
 type WorkloadOwner struct {
 	// +kubebuilder:validation:MinLength=1
 	ProjectName string `json:"projectName"`
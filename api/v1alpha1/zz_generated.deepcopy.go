@@ -8,6 +8,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -218,6 +219,187 @@ func (in *AuthzRoleSpec) DeepCopy() *AuthzRoleSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingConfig) DeepCopyInto(out *AutoscalingConfig) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetCPUUtilizationPercentage != nil {
+		in, out := &in.TargetCPUUtilizationPercentage, &out.TargetCPUUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetMemoryUtilizationPercentage != nil {
+		in, out := &in.TargetMemoryUtilizationPercentage, &out.TargetMemoryUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make([]AutoscalingTrigger, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingConfig.
+func (in *AutoscalingConfig) DeepCopy() *AutoscalingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingLimits) DeepCopyInto(out *AutoscalingLimits) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AllowedTriggerTypes != nil {
+		in, out := &in.AllowedTriggerTypes, &out.AllowedTriggerTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingLimits.
+func (in *AutoscalingLimits) DeepCopy() *AutoscalingLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingTrigger) DeepCopyInto(out *AutoscalingTrigger) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingTrigger.
+func (in *AutoscalingTrigger) DeepCopy() *AutoscalingTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildCacheConfig) DeepCopyInto(out *BuildCacheConfig) {
+	*out = *in
+	if in.Registry != nil {
+		in, out := &in.Registry, &out.Registry
+		*out = new(RegistryBuildCache)
+		**out = **in
+	}
+	if in.PersistentVolume != nil {
+		in, out := &in.PersistentVolume, &out.PersistentVolume
+		*out = new(PersistentVolumeBuildCache)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildCacheConfig.
+func (in *BuildCacheConfig) DeepCopy() *BuildCacheConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildCacheConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildCacheStatistics) DeepCopyInto(out *BuildCacheStatistics) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildCacheStatistics.
+func (in *BuildCacheStatistics) DeepCopy() *BuildCacheStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildCacheStatistics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryStep) DeepCopyInto(out *CanaryStep) {
+	*out = *in
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStep.
+func (in *CanaryStep) DeepCopy() *CanaryStep {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryStrategy) DeepCopyInto(out *CanaryStrategy) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]CanaryStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStrategy.
+func (in *CanaryStrategy) DeepCopy() *CanaryStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateIssuerRef) DeepCopyInto(out *CertificateIssuerRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateIssuerRef.
+func (in *CertificateIssuerRef) DeepCopy() *CertificateIssuerRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateIssuerRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterAgentConfig) DeepCopyInto(out *ClusterAgentConfig) {
 	*out = *in
@@ -482,6 +664,11 @@ func (in *ClusterComponentTypeSpec) DeepCopyInto(out *ClusterComponentTypeSpec)
 		*out = make([]ClusterTraitRef, len(*in))
 		copy(*out, *in)
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingLimits)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Validations != nil {
 		in, out := &in.Validations, &out.Validations
 		*out = make([]ValidationRule, len(*in))
@@ -1348,6 +1535,11 @@ func (in *ClusterWorkflowPlaneSpec) DeepCopyInto(out *ClusterWorkflowPlaneSpec)
 		*out = new(ClusterObservabilityPlaneRef)
 		**out = **in
 	}
+	if in.BuildCache != nil {
+		in, out := &in.BuildCache, &out.BuildCache
+		*out = new(BuildCacheConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowPlaneSpec.
@@ -1953,6 +2145,11 @@ func (in *ComponentTypeSpec) DeepCopyInto(out *ComponentTypeSpec) {
 		*out = make([]TraitRef, len(*in))
 		copy(*out, *in)
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingLimits)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Validations != nil {
 		in, out := &in.Validations, &out.Validations
 		*out = make([]ValidationRule, len(*in))
@@ -2064,6 +2261,33 @@ func (in *ConfigMapKeyRef) DeepCopy() *ConfigMapKeyRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Connection) DeepCopyInto(out *Connection) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Connection.
+func (in *Connection) DeepCopy() *Connection {
+	if in == nil {
+		return nil
+	}
+	out := new(Connection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Connection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConnectionEnvBindings) DeepCopyInto(out *ConnectionEnvBindings) {
 	*out = *in
@@ -2079,6 +2303,133 @@ func (in *ConnectionEnvBindings) DeepCopy() *ConnectionEnvBindings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionEnvironmentStatus) DeepCopyInto(out *ConnectionEnvironmentStatus) {
+	*out = *in
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(EndpointURL)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionEnvironmentStatus.
+func (in *ConnectionEnvironmentStatus) DeepCopy() *ConnectionEnvironmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionEnvironmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionList) DeepCopyInto(out *ConnectionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Connection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionList.
+func (in *ConnectionList) DeepCopy() *ConnectionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConnectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionOwner) DeepCopyInto(out *ConnectionOwner) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionOwner.
+func (in *ConnectionOwner) DeepCopy() *ConnectionOwner {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionOwner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionSpec) DeepCopyInto(out *ConnectionSpec) {
+	*out = *in
+	out.Owner = in.Owner
+	if in.Environments != nil {
+		in, out := &in.Environments, &out.Environments
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(WorkloadConnection)
+		**out = **in
+	}
+	if in.Resource != nil {
+		in, out := &in.Resource, &out.Resource
+		*out = new(WorkloadResourceDependency)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionSpec.
+func (in *ConnectionSpec) DeepCopy() *ConnectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionStatus) DeepCopyInto(out *ConnectionStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Environments != nil {
+		in, out := &in.Environments, &out.Environments
+		*out = make([]ConnectionEnvironmentStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionStatus.
+func (in *ConnectionStatus) DeepCopy() *ConnectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConnectionTarget) DeepCopyInto(out *ConnectionTarget) {
 	*out = *in
@@ -2183,26 +2534,26 @@ func (in *ContainerRegistry) DeepCopy() *ContainerRegistry {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataPlane) DeepCopyInto(out *DataPlane) {
+func (in *CustomDomain) DeepCopyInto(out *CustomDomain) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	out.Spec = in.Spec
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlane.
-func (in *DataPlane) DeepCopy() *DataPlane {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDomain.
+func (in *CustomDomain) DeepCopy() *CustomDomain {
 	if in == nil {
 		return nil
 	}
-	out := new(DataPlane)
+	out := new(CustomDomain)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DataPlane) DeepCopyObject() runtime.Object {
+func (in *CustomDomain) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2210,31 +2561,31 @@ func (in *DataPlane) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataPlaneList) DeepCopyInto(out *DataPlaneList) {
+func (in *CustomDomainList) DeepCopyInto(out *CustomDomainList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DataPlane, len(*in))
+		*out = make([]CustomDomain, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneList.
-func (in *DataPlaneList) DeepCopy() *DataPlaneList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDomainList.
+func (in *CustomDomainList) DeepCopy() *CustomDomainList {
 	if in == nil {
 		return nil
 	}
-	out := new(DataPlaneList)
+	out := new(CustomDomainList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DataPlaneList) DeepCopyObject() runtime.Object {
+func (in *CustomDomainList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2242,12 +2593,169 @@ func (in *DataPlaneList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataPlaneRef) DeepCopyInto(out *DataPlaneRef) {
+func (in *CustomDomainOwner) DeepCopyInto(out *CustomDomainOwner) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneRef.
-func (in *DataPlaneRef) DeepCopy() *DataPlaneRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDomainOwner.
+func (in *CustomDomainOwner) DeepCopy() *CustomDomainOwner {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomDomainOwner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomDomainSpec) DeepCopyInto(out *CustomDomainSpec) {
+	*out = *in
+	out.Owner = in.Owner
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDomainSpec.
+func (in *CustomDomainSpec) DeepCopy() *CustomDomainSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomDomainSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomDomainStatus) DeepCopyInto(out *CustomDomainStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DNSRecords != nil {
+		in, out := &in.DNSRecords, &out.DNSRecords
+		*out = make([]DNSRecord, len(*in))
+		copy(*out, *in)
+	}
+	if in.VerifiedAt != nil {
+		in, out := &in.VerifiedAt, &out.VerifiedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = new(EndpointCertificateStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDomainStatus.
+func (in *CustomDomainStatus) DeepCopy() *CustomDomainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomDomainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecord) DeepCopyInto(out *DNSRecord) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecord.
+func (in *DNSRecord) DeepCopy() *DNSRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlane) DeepCopyInto(out *DataPlane) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlane.
+func (in *DataPlane) DeepCopy() *DataPlane {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlane)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataPlane) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneCapabilities) DeepCopyInto(out *DataPlaneCapabilities) {
+	*out = *in
+	in.DiscoveredAt.DeepCopyInto(&out.DiscoveredAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneCapabilities.
+func (in *DataPlaneCapabilities) DeepCopy() *DataPlaneCapabilities {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneCapabilities)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneList) DeepCopyInto(out *DataPlaneList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DataPlane, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneList.
+func (in *DataPlaneList) DeepCopy() *DataPlaneList {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataPlaneList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneRef) DeepCopyInto(out *DataPlaneRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneRef.
+func (in *DataPlaneRef) DeepCopy() *DataPlaneRef {
 	if in == nil {
 		return nil
 	}
@@ -2298,6 +2806,11 @@ func (in *DataPlaneStatus) DeepCopyInto(out *DataPlaneStatus) {
 		*out = new(AgentConnectionStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = new(DataPlaneCapabilities)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneStatus.
@@ -2484,6 +2997,34 @@ func (in *EndpointAccess) DeepCopy() *EndpointAccess {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointCertificateStatus) DeepCopyInto(out *EndpointCertificateStatus) {
+	*out = *in
+	if in.DNSNames != nil {
+		in, out := &in.DNSNames, &out.DNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.RenewalTime != nil {
+		in, out := &in.RenewalTime, &out.RenewalTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointCertificateStatus.
+func (in *EndpointCertificateStatus) DeepCopy() *EndpointCertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointCertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EndpointGatewayURLs) DeepCopyInto(out *EndpointGatewayURLs) {
 	*out = *in
@@ -2577,6 +3118,18 @@ func (in *EndpointURLStatus) DeepCopyInto(out *EndpointURLStatus) {
 		*out = new(EndpointGatewayURLs)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CustomDomains != nil {
+		in, out := &in.CustomDomains, &out.CustomDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]EndpointCertificateStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointURLStatus.
@@ -2718,6 +3271,27 @@ func (in *EnvironmentRef) DeepCopy() *EnvironmentRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentSandboxSpec) DeepCopyInto(out *EnvironmentSandboxSpec) {
+	*out = *in
+	out.TTL = in.TTL
+	if in.PullRequest != nil {
+		in, out := &in.PullRequest, &out.PullRequest
+		*out = new(SandboxPullRequest)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentSandboxSpec.
+func (in *EnvironmentSandboxSpec) DeepCopy() *EnvironmentSandboxSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentSandboxSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvironmentSpec) DeepCopyInto(out *EnvironmentSpec) {
 	*out = *in
@@ -2727,6 +3301,16 @@ func (in *EnvironmentSpec) DeepCopyInto(out *EnvironmentSpec) {
 		**out = **in
 	}
 	in.Gateway.DeepCopyInto(&out.Gateway)
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(EnvironmentTLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sandbox != nil {
+		in, out := &in.Sandbox, &out.Sandbox
+		*out = new(EnvironmentSandboxSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentSpec.
@@ -2749,6 +3333,10 @@ func (in *EnvironmentStatus) DeepCopyInto(out *EnvironmentStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentStatus.
@@ -2761,6 +3349,32 @@ func (in *EnvironmentStatus) DeepCopy() *EnvironmentStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentTLSSpec) DeepCopyInto(out *EnvironmentTLSSpec) {
+	*out = *in
+	out.IssuerRef = in.IssuerRef
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentTLSSpec.
+func (in *EnvironmentTLSSpec) DeepCopy() *EnvironmentTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalRef) DeepCopyInto(out *ExternalRef) {
 	*out = *in
@@ -2922,6 +3536,31 @@ func (in *GitRepository) DeepCopy() *GitRepository {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageScanResult) DeepCopyInto(out *ImageScanResult) {
+	*out = *in
+	if in.ScannedAt != nil {
+		in, out := &in.ScannedAt, &out.ScannedAt
+		*out = (*in).DeepCopy()
+	}
+	out.Summary = in.Summary
+	if in.Vulnerabilities != nil {
+		in, out := &in.Vulnerabilities, &out.Vulnerabilities
+		*out = make([]Vulnerability, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageScanResult.
+func (in *ImageScanResult) DeepCopy() *ImageScanResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageScanResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JSONPatchOperation) DeepCopyInto(out *JSONPatchOperation) {
 	*out = *in
@@ -2987,6 +3626,121 @@ func (in *LatestResourceRelease) DeepCopy() *LatestResourceRelease {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyRule) DeepCopyInto(out *NetworkPolicyRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyRule.
+func (in *NetworkPolicyRule) DeepCopy() *NetworkPolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkPolicyRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyRuleDestination) DeepCopyInto(out *NetworkPolicyRuleDestination) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyRuleDestination.
+func (in *NetworkPolicyRuleDestination) DeepCopy() *NetworkPolicyRuleDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyRuleDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyRuleList) DeepCopyInto(out *NetworkPolicyRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkPolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyRuleList.
+func (in *NetworkPolicyRuleList) DeepCopy() *NetworkPolicyRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkPolicyRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyRulePeer) DeepCopyInto(out *NetworkPolicyRulePeer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyRulePeer.
+func (in *NetworkPolicyRulePeer) DeepCopy() *NetworkPolicyRulePeer {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyRulePeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyRuleSpec) DeepCopyInto(out *NetworkPolicyRuleSpec) {
+	*out = *in
+	out.Source = in.Source
+	in.Destination.DeepCopyInto(&out.Destination)
+	if in.Environments != nil {
+		in, out := &in.Environments, &out.Environments
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyRuleSpec.
+func (in *NetworkPolicyRuleSpec) DeepCopy() *NetworkPolicyRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NotificationChannelConfig) DeepCopyInto(out *NotificationChannelConfig) {
 	*out = *in
@@ -3480,6 +4234,22 @@ func (in *PendingResourceDependency) DeepCopy() *PendingResourceDependency {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeBuildCache) DeepCopyInto(out *PersistentVolumeBuildCache) {
+	*out = *in
+	out.Size = in.Size.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeBuildCache.
+func (in *PersistentVolumeBuildCache) DeepCopy() *PersistentVolumeBuildCache {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeBuildCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PostRenderTarget) DeepCopyInto(out *PostRenderTarget) {
 	*out = *in
@@ -3576,6 +4346,78 @@ func (in *ProjectList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectQuota) DeepCopyInto(out *ProjectQuota) {
+	*out = *in
+	if in.Hard != nil {
+		in, out := &in.Hard, &out.Hard
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.DefaultRequest != nil {
+		in, out := &in.DefaultRequest, &out.DefaultRequest
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectQuota.
+func (in *ProjectQuota) DeepCopy() *ProjectQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectQuotaStatus) DeepCopyInto(out *ProjectQuotaStatus) {
+	*out = *in
+	if in.Hard != nil {
+		in, out := &in.Hard, &out.Hard
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Used != nil {
+		in, out := &in.Used, &out.Used
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectQuotaStatus.
+func (in *ProjectQuotaStatus) DeepCopy() *ProjectQuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectQuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectRelease) DeepCopyInto(out *ProjectRelease) {
 	*out = *in
@@ -3686,6 +4528,11 @@ func (in *ProjectReleaseBindingSpec) DeepCopyInto(out *ProjectReleaseBindingSpec
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(ProjectQuota)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectReleaseBindingSpec.
@@ -3708,6 +4555,11 @@ func (in *ProjectReleaseBindingStatus) DeepCopyInto(out *ProjectReleaseBindingSt
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(ProjectQuotaStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectReleaseBindingStatus.
@@ -3859,37 +4711,200 @@ func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
-func (in *ProjectStatus) DeepCopy() *ProjectStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
+func (in *ProjectStatus) DeepCopy() *ProjectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectType) DeepCopyInto(out *ProjectType) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectType.
+func (in *ProjectType) DeepCopy() *ProjectType {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectType) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTypeList) DeepCopyInto(out *ProjectTypeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectType, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTypeList.
+func (in *ProjectTypeList) DeepCopy() *ProjectTypeList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectTypeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectTypeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTypeRef) DeepCopyInto(out *ProjectTypeRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTypeRef.
+func (in *ProjectTypeRef) DeepCopy() *ProjectTypeRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectTypeRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTypeSpec) DeepCopyInto(out *ProjectTypeSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = new(SchemaSection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnvironmentConfigs != nil {
+		in, out := &in.EnvironmentConfigs, &out.EnvironmentConfigs
+		*out = new(SchemaSection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Validations != nil {
+		in, out := &in.Validations, &out.Validations
+		*out = make([]ValidationRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTypeSpec.
+func (in *ProjectTypeSpec) DeepCopy() *ProjectTypeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectTypeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTypeStatus) DeepCopyInto(out *ProjectTypeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTypeStatus.
+func (in *ProjectTypeStatus) DeepCopy() *ProjectTypeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectTypeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionDecision) DeepCopyInto(out *PromotionDecision) {
+	*out = *in
+	in.DecidedAt.DeepCopyInto(&out.DecidedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionDecision.
+func (in *PromotionDecision) DeepCopy() *PromotionDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionPath) DeepCopyInto(out *PromotionPath) {
+	*out = *in
+	out.SourceEnvironmentRef = in.SourceEnvironmentRef
+	if in.TargetEnvironmentRefs != nil {
+		in, out := &in.TargetEnvironmentRefs, &out.TargetEnvironmentRefs
+		*out = make([]TargetEnvironmentRef, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionPath.
+func (in *PromotionPath) DeepCopy() *PromotionPath {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectStatus)
+	out := new(PromotionPath)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectType) DeepCopyInto(out *ProjectType) {
+func (in *PromotionRequest) DeepCopyInto(out *PromotionRequest) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectType.
-func (in *ProjectType) DeepCopy() *ProjectType {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionRequest.
+func (in *PromotionRequest) DeepCopy() *PromotionRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectType)
+	out := new(PromotionRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProjectType) DeepCopyObject() runtime.Object {
+func (in *PromotionRequest) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3897,31 +4912,31 @@ func (in *ProjectType) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectTypeList) DeepCopyInto(out *ProjectTypeList) {
+func (in *PromotionRequestList) DeepCopyInto(out *PromotionRequestList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ProjectType, len(*in))
+		*out = make([]PromotionRequest, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTypeList.
-func (in *ProjectTypeList) DeepCopy() *ProjectTypeList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionRequestList.
+func (in *PromotionRequestList) DeepCopy() *PromotionRequestList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectTypeList)
+	out := new(PromotionRequestList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProjectTypeList) DeepCopyObject() runtime.Object {
+func (in *PromotionRequestList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3929,104 +4944,86 @@ func (in *ProjectTypeList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectTypeRef) DeepCopyInto(out *ProjectTypeRef) {
+func (in *PromotionRequestOwner) DeepCopyInto(out *PromotionRequestOwner) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTypeRef.
-func (in *ProjectTypeRef) DeepCopy() *ProjectTypeRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionRequestOwner.
+func (in *PromotionRequestOwner) DeepCopy() *PromotionRequestOwner {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectTypeRef)
+	out := new(PromotionRequestOwner)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectTypeSpec) DeepCopyInto(out *ProjectTypeSpec) {
+func (in *PromotionRequestSpec) DeepCopyInto(out *PromotionRequestSpec) {
 	*out = *in
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = new(SchemaSection)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.EnvironmentConfigs != nil {
-		in, out := &in.EnvironmentConfigs, &out.EnvironmentConfigs
-		*out = new(SchemaSection)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Validations != nil {
-		in, out := &in.Validations, &out.Validations
-		*out = make([]ValidationRule, len(*in))
-		copy(*out, *in)
-	}
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = make([]ResourceTemplate, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	out.Owner = in.Owner
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTypeSpec.
-func (in *ProjectTypeSpec) DeepCopy() *ProjectTypeSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionRequestSpec.
+func (in *PromotionRequestSpec) DeepCopy() *PromotionRequestSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectTypeSpec)
+	out := new(PromotionRequestSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectTypeStatus) DeepCopyInto(out *ProjectTypeStatus) {
+func (in *PromotionRequestStatus) DeepCopyInto(out *PromotionRequestStatus) {
 	*out = *in
+	if in.Decision != nil {
+		in, out := &in.Decision, &out.Decision
+		*out = new(PromotionDecision)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PromotedAt != nil {
+		in, out := &in.PromotedAt, &out.PromotedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTypeStatus.
-func (in *ProjectTypeStatus) DeepCopy() *ProjectTypeStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionRequestStatus.
+func (in *PromotionRequestStatus) DeepCopy() *PromotionRequestStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectTypeStatus)
+	out := new(PromotionRequestStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PromotionPath) DeepCopyInto(out *PromotionPath) {
+func (in *RegistryAuthentication) DeepCopyInto(out *RegistryAuthentication) {
 	*out = *in
-	out.SourceEnvironmentRef = in.SourceEnvironmentRef
-	if in.TargetEnvironmentRefs != nil {
-		in, out := &in.TargetEnvironmentRefs, &out.TargetEnvironmentRefs
-		*out = make([]TargetEnvironmentRef, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionPath.
-func (in *PromotionPath) DeepCopy() *PromotionPath {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryAuthentication.
+func (in *RegistryAuthentication) DeepCopy() *RegistryAuthentication {
 	if in == nil {
 		return nil
 	}
-	out := new(PromotionPath)
+	out := new(RegistryAuthentication)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegistryAuthentication) DeepCopyInto(out *RegistryAuthentication) {
+func (in *RegistryBuildCache) DeepCopyInto(out *RegistryBuildCache) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryAuthentication.
-func (in *RegistryAuthentication) DeepCopy() *RegistryAuthentication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryBuildCache.
+func (in *RegistryBuildCache) DeepCopy() *RegistryBuildCache {
 	if in == nil {
 		return nil
 	}
-	out := new(RegistryAuthentication)
+	out := new(RegistryBuildCache)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -4126,6 +5123,11 @@ func (in *ReleaseBindingSpec) DeepCopyInto(out *ReleaseBindingSpec) {
 		*out = new(WorkloadOverrideTemplateSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseBindingSpec.
@@ -4189,6 +5191,18 @@ func (in *ReleaseBindingStatus) DeepCopyInto(out *ReleaseBindingStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]ReleaseRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseBindingStatus.
@@ -4201,6 +5215,63 @@ func (in *ReleaseBindingStatus) DeepCopy() *ReleaseBindingStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseConfigSnapshot) DeepCopyInto(out *ReleaseConfigSnapshot) {
+	*out = *in
+	if in.ComponentTypeEnvironmentConfigs != nil {
+		in, out := &in.ComponentTypeEnvironmentConfigs, &out.ComponentTypeEnvironmentConfigs
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TraitEnvironmentConfigs != nil {
+		in, out := &in.TraitEnvironmentConfigs, &out.TraitEnvironmentConfigs
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.WorkloadOverrides != nil {
+		in, out := &in.WorkloadOverrides, &out.WorkloadOverrides
+		*out = new(WorkloadOverrideTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseConfigSnapshot.
+func (in *ReleaseConfigSnapshot) DeepCopy() *ReleaseConfigSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseConfigSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseRecord) DeepCopyInto(out *ReleaseRecord) {
+	*out = *in
+	in.DeployedAt.DeepCopyInto(&out.DeployedAt)
+	if in.SupersededAt != nil {
+		in, out := &in.SupersededAt, &out.SupersededAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Snapshot != nil {
+		in, out := &in.Snapshot, &out.Snapshot
+		*out = new(ReleaseConfigSnapshot)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseRecord.
+func (in *ReleaseRecord) DeepCopy() *ReleaseRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteReference) DeepCopyInto(out *RemoteReference) {
 	*out = *in
@@ -5073,6 +6144,64 @@ func (in *RoleRef) DeepCopy() *RoleRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
+	*out = *in
+	if in.StepStartedAt != nil {
+		in, out := &in.StepStartedAt, &out.StepStartedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStrategy) DeepCopyInto(out *RolloutStrategy) {
+	*out = *in
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStrategy.
+func (in *RolloutStrategy) DeepCopy() *RolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SBOMReference) DeepCopyInto(out *SBOMReference) {
+	*out = *in
+	if in.RecordedAt != nil {
+		in, out := &in.RecordedAt, &out.RecordedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SBOMReference.
+func (in *SBOMReference) DeepCopy() *SBOMReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SBOMReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SMTPAuth) DeepCopyInto(out *SMTPAuth) {
 	*out = *in
@@ -5138,6 +6267,21 @@ func (in *SMTPTLSConfig) DeepCopy() *SMTPTLSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SandboxPullRequest) DeepCopyInto(out *SandboxPullRequest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SandboxPullRequest.
+func (in *SandboxPullRequest) DeepCopy() *SandboxPullRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(SandboxPullRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Schema) DeepCopyInto(out *Schema) {
 	*out = *in
@@ -5728,6 +6872,36 @@ func (in *ValueFrom) DeepCopy() *ValueFrom {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Vulnerability) DeepCopyInto(out *Vulnerability) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Vulnerability.
+func (in *Vulnerability) DeepCopy() *Vulnerability {
+	if in == nil {
+		return nil
+	}
+	out := new(Vulnerability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VulnerabilitySummary) DeepCopyInto(out *VulnerabilitySummary) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VulnerabilitySummary.
+func (in *VulnerabilitySummary) DeepCopy() *VulnerabilitySummary {
+	if in == nil {
+		return nil
+	}
+	out := new(VulnerabilitySummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookConfig) DeepCopyInto(out *WebhookConfig) {
 	*out = *in
@@ -5861,6 +7035,36 @@ func (in *WorkflowPlane) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowPlaneCapacity) DeepCopyInto(out *WorkflowPlaneCapacity) {
+	*out = *in
+	if in.OldestPendingSince != nil {
+		in, out := &in.OldestPendingSince, &out.OldestPendingSince
+		*out = (*in).DeepCopy()
+	}
+	if in.AvailableCPU != nil {
+		in, out := &in.AvailableCPU, &out.AvailableCPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.AvailableMemory != nil {
+		in, out := &in.AvailableMemory, &out.AvailableMemory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	in.LastProbeTime.DeepCopyInto(&out.LastProbeTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowPlaneCapacity.
+func (in *WorkflowPlaneCapacity) DeepCopy() *WorkflowPlaneCapacity {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowPlaneCapacity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowPlaneList) DeepCopyInto(out *WorkflowPlaneList) {
 	*out = *in
@@ -5922,6 +7126,11 @@ func (in *WorkflowPlaneSpec) DeepCopyInto(out *WorkflowPlaneSpec) {
 		*out = new(ObservabilityPlaneRef)
 		**out = **in
 	}
+	if in.BuildCache != nil {
+		in, out := &in.BuildCache, &out.BuildCache
+		*out = new(BuildCacheConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowPlaneSpec.
@@ -5949,6 +7158,11 @@ func (in *WorkflowPlaneStatus) DeepCopyInto(out *WorkflowPlaneStatus) {
 		*out = new(AgentConnectionStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = new(WorkflowPlaneCapacity)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowPlaneStatus.
@@ -6130,6 +7344,21 @@ func (in *WorkflowRunStatus) DeepCopyInto(out *WorkflowRunStatus) {
 		in, out := &in.CompletedAt, &out.CompletedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.Scan != nil {
+		in, out := &in.Scan, &out.Scan
+		*out = new(ImageScanResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SBOM != nil {
+		in, out := &in.SBOM, &out.SBOM
+		*out = new(SBOMReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(BuildCacheStatistics)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowRunStatus.
@@ -6287,6 +7516,11 @@ func (in *WorkloadDependencies) DeepCopyInto(out *WorkloadDependencies) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = make([]WorkloadExternalConnection, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadDependencies.
@@ -6324,6 +7558,22 @@ func (in *WorkloadEndpoint) DeepCopy() *WorkloadEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadExternalConnection) DeepCopyInto(out *WorkloadExternalConnection) {
+	*out = *in
+	out.EnvBindings = in.EnvBindings
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadExternalConnection.
+func (in *WorkloadExternalConnection) DeepCopy() *WorkloadExternalConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadExternalConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadList) DeepCopyInto(out *WorkloadList) {
 	*out = *in
@@ -6468,6 +7718,11 @@ func (in *WorkloadTemplateSpec) DeepCopyInto(out *WorkloadTemplateSpec) {
 		*out = new(WorkloadDependencies)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadTemplateSpec.
@@ -18,6 +18,7 @@ import (
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
@@ -39,6 +40,8 @@ import (
 	"github.com/openchoreo/openchoreo/internal/controller/component"
 	"github.com/openchoreo/openchoreo/internal/controller/componentrelease"
 	"github.com/openchoreo/openchoreo/internal/controller/componenttype"
+	"github.com/openchoreo/openchoreo/internal/controller/connection"
+	"github.com/openchoreo/openchoreo/internal/controller/customdomain"
 	"github.com/openchoreo/openchoreo/internal/controller/dataplane"
 	"github.com/openchoreo/openchoreo/internal/controller/deploymentpipeline"
 	"github.com/openchoreo/openchoreo/internal/controller/environment"
@@ -88,6 +91,11 @@ import (
 const (
 	deploymentPlaneControlPlane       = "controlplane"
 	deploymentPlaneObservabilityPlane = "observabilityplane"
+
+	// gitTokenSecretName is the Secret holding the git provider access tokens used to comment
+	// on pull requests deployed to preview environments, alongside the webhook secrets.
+	gitTokenSecretName      = "git-webhook-secrets"      // #nosec G101 -- This is a secret name, not a hardcoded credential
+	gitTokenSecretNamespace = "openchoreo-control-plane" // #nosec G101 -- This is a namespace name, not a credential
 )
 
 var (
@@ -118,6 +126,7 @@ func setupControlPlaneControllers(
 	k8sClientMgr *kubernetesClient.KubeMultiClientManager,
 	clusterGatewayURL string,
 	gwTLS gatewayClient.TLSConfig,
+	gitAPIURLs map[string]string,
 ) error {
 	// Create gateway client for plane lifecycle notifications
 	var gwClient *gatewayClient.Client
@@ -153,7 +162,13 @@ func setupControlPlaneControllers(
 	reconcilers := []controllerSetup{
 		&deploymentpipeline.Reconciler{Client: c, Scheme: s},
 		&workload.Reconciler{Client: c, Scheme: s},
-		&environment.Reconciler{Client: c, PlaneClientProvider: planeClientProvider, Scheme: s},
+		&environment.Reconciler{
+			Client:              c,
+			PlaneClientProvider: planeClientProvider,
+			Scheme:              s,
+			PreviewCommenter: environment.NewPullRequestCommenter(mgr.GetAPIReader(),
+				client.ObjectKey{Namespace: gitTokenSecretNamespace, Name: gitTokenSecretName}, gitAPIURLs),
+		},
 		&dataplane.Reconciler{
 			Client:        c,
 			Scheme:        s,
@@ -194,6 +209,8 @@ func setupControlPlaneControllers(
 		&resourcerelease.Reconciler{Client: c, Scheme: s},
 		&resourcereleasebinding.Reconciler{Client: c, Scheme: s},
 		&releasebinding.Reconciler{Client: c, Scheme: s, Pipeline: componentpipeline.NewPipeline()},
+		&customdomain.Reconciler{Client: c, Scheme: s},
+		&connection.Reconciler{Client: c, Scheme: s},
 		&renderedrelease.Reconciler{Client: c, PlaneClientProvider: planeClientProvider, Scheme: s},
 		&workflow.Reconciler{Client: c, Scheme: s},
 		&clusterworkflow.Reconciler{Client: c, Scheme: s},
@@ -204,11 +221,12 @@ func setupControlPlaneControllers(
 			Pipeline:            workflowpipeline.NewPipeline(),
 		},
 		&workflowplane.Reconciler{
-			Client:        c,
-			Scheme:        s,
-			ClientMgr:     k8sClientMgr,
-			GatewayClient: gwClient,
-			CacheVersion:  "v2",
+			Client:              c,
+			Scheme:              s,
+			ClientMgr:           k8sClientMgr,
+			GatewayClient:       gwClient,
+			CacheVersion:        "v2",
+			PlaneClientProvider: planeClientProvider,
 		},
 		&secretreference.Reconciler{Client: c, Scheme: s},
 		&observabilityplane.Reconciler{
@@ -277,6 +295,7 @@ func main() {
 	var clusterGatewayClientKey string
 	var clusterGatewayInsecure bool
 	var deploymentPlane string
+	var gitAPIURLs string
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -306,6 +325,10 @@ func main() {
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
 	flag.StringVar(&deploymentPlane, "deployment-plane", deploymentPlaneControlPlane,
 		"The deployment plane this manager should serve. Supported values: controlplane, observabilityplane")
+	flag.StringVar(&gitAPIURLs, "git-api-urls", getEnv("GIT_API_URLS", ""),
+		"Comma-separated HOST=API_URL pairs of self-hosted GitHub and GitLab instances that pull request "+
+			"previews may comment on, such as github.example.com=https://github.example.com/api/v3. "+
+			"github.com and gitlab.com are always allowed; the git access tokens are only sent to these hosts.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -318,6 +341,11 @@ func main() {
 		setupLog.Error(nil, "invalid deployment plane", "deploymentPlane", deploymentPlane)
 		os.Exit(1)
 	}
+	parsedGitAPIURLs, err := environment.ParseGitAPIURLs(gitAPIURLs)
+	if err != nil {
+		setupLog.Error(err, "invalid --git-api-urls")
+		os.Exit(1)
+	}
 
 	setupLog.Info("starting controller manager", append(version.GetLogKeyValues(), "deploymentPlane", deploymentPlane)...)
 
@@ -419,7 +447,7 @@ func main() {
 			ClientCertFile:     clusterGatewayClientCert,
 			ClientKeyFile:      clusterGatewayClientKey,
 			InsecureSkipVerify: clusterGatewayInsecure,
-		})
+		}, parsedGitAPIURLs)
 		if err != nil {
 			setupLog.Error(err, "unable to setup control plane controllers")
 			os.Exit(1)
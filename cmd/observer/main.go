@@ -259,10 +259,12 @@ func main() {
 
 	// ===== New API Routes (v1) =====
 	api.HandleFunc("POST /api/v1/logs/query", newAPIHandler.QueryLogs)
+	api.HandleFunc("GET /api/v1/logs/stream", newAPIHandler.StreamLogs)
 	api.HandleFunc("POST /api/v1/events/query", newAPIHandler.QueryEvents)
 	api.HandleFunc("POST /api/v1/metrics/query", newAPIHandler.QueryMetrics)
 
-	// ===== New API Routes (v1alpha1) Traces, Incidents & Runtime topology =====
+	// ===== New API Routes (v1alpha1) PromQL, Traces, Incidents & Runtime topology =====
+	api.HandleFunc("POST /api/v1alpha1/metrics/promql", newAPIHandler.QueryPromQL)
 	api.HandleFunc("POST /api/v1alpha1/metrics/runtime-topology", newAPIHandler.QueryRuntimeTopology)
 	api.HandleFunc("POST /api/v1alpha1/traces/query", newAPIHandler.QueryTraces)
 	api.HandleFunc("POST /api/v1alpha1/traces/{traceId}/spans/query", newAPIHandler.QuerySpansForTrace)
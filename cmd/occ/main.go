@@ -4,11 +4,15 @@
 package main
 
 import (
+	"errors"
+	"fmt"
 	"os"
+	"os/exec"
 
 	"github.com/spf13/cobra"
 
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/plugin"
 	"github.com/openchoreo/openchoreo/internal/occ/root"
 )
 
@@ -23,10 +27,28 @@ func main() {
 			return err
 		}
 
+		// Switch to the context given with --context for this command only
+		if err := config.ApplyContextOverride(cmd); err != nil {
+			return err
+		}
+
 		// Apply context defaults to command flags
 		return config.ApplyContextDefaults(cmd)
 	}
 
+	// Run an occ-NAME executable on PATH for commands occ does not have
+	if ran, err := plugin.Handle(rootCmd, os.Args[1:]); ran {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.ExitCode())
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Error:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	if err := rootCmd.Execute(); err != nil {
 		os.Exit(1)
 	}
@@ -8,13 +8,17 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
 	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -27,20 +31,41 @@ import (
 	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
 	coreconfig "github.com/openchoreo/openchoreo/internal/config"
 	"github.com/openchoreo/openchoreo/internal/logging"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/etag"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/fieldselect"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 	openapihandlers "github.com/openchoreo/openchoreo/internal/openchoreo-api/api/handlers"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/limits"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/versioning"
+	apiaudit "github.com/openchoreo/openchoreo/internal/openchoreo-api/audit"
 	k8s "github.com/openchoreo/openchoreo/internal/openchoreo-api/clients"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/config"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/grpcapi"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/mcphandlers"
 	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	apitokensvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/apitoken"
+	auditeventsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/auditevent"
 	autobuildsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/autobuild"
+	costsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/cost"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/gitopsexport"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	healthsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/health"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/outboundwebhook"
+	sandboxsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/sandbox"
+	watchsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/watch"
 	workflowrunsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowrun"
 	"github.com/openchoreo/openchoreo/internal/server"
 	"github.com/openchoreo/openchoreo/internal/server/middleware"
+	auditmiddleware "github.com/openchoreo/openchoreo/internal/server/middleware/audit"
 	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/compress"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/cors"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/idempotency"
 	apilogger "github.com/openchoreo/openchoreo/internal/server/middleware/logger"
 	mcpmiddleware "github.com/openchoreo/openchoreo/internal/server/middleware/mcp"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/problem"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/ratelimit"
+	"github.com/openchoreo/openchoreo/internal/tracing"
 	"github.com/openchoreo/openchoreo/internal/version"
 	"github.com/openchoreo/openchoreo/pkg/mcp"
 	"github.com/openchoreo/openchoreo/pkg/mcp/tools"
@@ -97,12 +122,46 @@ func main() {
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer cancel()
 
-	// Create a Kubernetes client for the service layer and PAP.
-	k8sClient, err := k8s.NewK8sClient()
+	// Set up tracing before any instrumented client is created
+	shutdownTracing, err := tracing.Setup(ctx, cfg.Tracing.ToTracingConfig(version.Get().Name, version.Get().Version))
 	if err != nil {
-		logger.Error("Failed to create Kubernetes client", slog.Any("error", err))
+		logger.Error("Failed to initialize tracing", slog.Any("error", err))
 		os.Exit(1)
 	}
+	defer func() {
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer shutdownCancel()
+		if err := shutdownTracing(shutdownCtx); err != nil {
+			logger.Error("Failed to flush traces", slog.Any("error", err))
+		}
+	}()
+	if cfg.Tracing.Enabled {
+		logger.Info("Tracing enabled", "endpoint", cfg.Tracing.Endpoint, "sampleRatio", cfg.Tracing.SampleRatio)
+	}
+
+	// Create a Kubernetes client for the service layer and PAP. With the read
+	// cache enabled, gets and lists are served from shared informers.
+	var k8sClient client.WithWatch
+	var readCache cache.Cache
+	if cfg.Cache.Enabled {
+		k8sClient, readCache, err = k8s.NewCachedK8sClient(cfg.Cache.ToReadCacheOptions())
+		if err != nil {
+			logger.Error("Failed to create Kubernetes client", slog.Any("error", err))
+			os.Exit(1)
+		}
+		go func() {
+			if err := readCache.Start(ctx); err != nil {
+				logger.Error("Kubernetes read cache stopped", slog.Any("error", err))
+			}
+		}()
+		logger.Info("Kubernetes read cache enabled", "resyncInterval", cfg.Cache.ResyncInterval)
+	} else {
+		k8sClient, err = k8s.NewK8sClient()
+		if err != nil {
+			logger.Error("Failed to create Kubernetes client", slog.Any("error", err))
+			os.Exit(1)
+		}
+	}
 
 	// Set up runtime
 	runtime, err := setupRuntime(ctx, &cfg, k8sClient, logger)
@@ -178,19 +237,76 @@ func main() {
 	)
 
 	// Create the webhook processor that finds affected components and triggers workflow runs.
-	webhookProcessor := autobuildsvc.NewWebhookProcessor(k8sClient, baseWfRunSvc, logger.With("service", "webhook"))
+	// Pull request events deploy the builds to preview sandboxes.
+	baseSandboxSvc := sandboxsvc.NewService(k8sClient, logger.With("service", "sandbox"))
+	webhookProcessor := autobuildsvc.NewWebhookProcessor(k8sClient, baseWfRunSvc, baseSandboxSvc, logger.With("service", "webhook"))
+
+	// Set up audit logging of state-modifying API operations
+	auditLogger, auditEventStore, closeAuditSinks, err := setupAudit(&cfg.Audit, logger.With("component", "audit"))
+	if err != nil {
+		logger.Error("Failed to initialize audit logging", slog.Any("error", err))
+		os.Exit(1)
+	}
+	defer closeAuditSinks()
+
+	// Set up the dependency checks behind /healthz, /readyz and /api/v1/diagnostics
+	healthService, err := setupHealth(&cfg.Health, k8sClient, readCache, runtime.cache, gwClient, logger.With("component", "health"))
+	if err != nil {
+		logger.Error("Failed to initialize health checks", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	// With impersonation enabled, the service layer acts on the cluster as the
+	// calling user and bypasses the read cache. Authorization, health checks
+	// and webhooks keep using the API server's own identity.
+	serviceK8sClient := k8sClient
+	if cfg.Impersonation.Enabled {
+		serviceK8sClient, err = k8s.NewImpersonatingK8sClient(cfg.Impersonation.ToImpersonationOptions())
+		if err != nil {
+			logger.Error("Failed to create impersonating Kubernetes client", slog.Any("error", err))
+			os.Exit(1)
+		}
+		logger.Info("Kubernetes impersonation enabled",
+			"usernamePrefix", cfg.Impersonation.UsernamePrefix,
+			"groupsPrefix", cfg.Impersonation.GroupsPrefix,
+			"groupsClaim", cfg.Impersonation.GroupsClaim)
+	}
+
+	// API tokens are stored with the API server's own identity. Requests
+	// authenticated with one are limited to its scope by the PDP wrapper.
+	var apiTokenOpts *apitokensvc.Options
+	if cfg.APITokens.Enabled {
+		apiTokenOpts = cfg.APITokens.ToOptions()
+		runtime.pdp = apitokensvc.NewScopedPDP(runtime.pdp)
+		logger.Info("API tokens enabled", "namespace", cfg.APITokens.Namespace, "maxTTL", cfg.APITokens.MaxTTL)
+	}
+	apiTokenService := apitokensvc.NewService(k8sClient, apiTokenOpts, logger.With("component", "apitoken-service"))
+
+	costService := costsvc.NewServiceWithAuthz(cfg.Cost.ToOptions(), runtime.pdp, logger.With("component", "cost-service"))
+	if cfg.Cost.OpenCostURL != "" {
+		logger.Info("Cost reports enabled", "opencostURL", cfg.Cost.OpenCostURL)
+	}
 
 	// Initialize all handler services
 	services := handlerservices.NewServices(
-		k8sClient, runtime.pap, runtime.pdp, planeClientProvider, logger, gwClient, webhookProcessor,
+		serviceK8sClient, runtime.pap, runtime.pdp, planeClientProvider, logger, gwClient, webhookProcessor, auditEventStore,
+		healthService, apiTokenService, costService,
 	)
 
 	// Initialize OpenAPI handlers
 	openapiHandler := openapihandlers.New(services, logger.With("component", "openapi-handlers"), &cfg)
-	strictHandler := gen.NewStrictHandler(openapiHandler, nil)
+	strictHandler := gen.NewStrictHandlerWithOptions(openapiHandler, nil, gen.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: limits.RequestErrorHandler,
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	})
 
 	// Initialize JWT middleware
 	jwtMiddleware := openapihandlers.InitJWTMiddleware(&cfg, logger)
+	if cfg.APITokens.Enabled {
+		jwtMiddleware = apitokensvc.Middleware(apiTokenService, jwtMiddleware, logger.With("component", "apitoken-auth"))
+	}
 
 	// Initialize middlewares for OpenAPI handler
 	loggerMiddleware := apilogger.LoggerMiddleware(logger.With("component", "openapi"))
@@ -201,40 +317,119 @@ func main() {
 	// routes, so they share the same mux without an extra wrapping layer.
 	baseMux := http.NewServeMux()
 
+	// Results of mutating REST requests and MCP tool calls made with an
+	// idempotency key. Nil when idempotency keys are disabled.
+	idempotencyStore := cfg.Server.Middleware.Idempotency.NewStore()
+
 	// MCP endpoint (only if enabled)
 	if cfg.MCP.Enabled {
 		mcpLogger := logger.With("component", "mcp")
 
 		// Build MCP toolsets from config
-		toolsets := buildMCPToolsets(&cfg, services, mcpLogger)
+		toolsets := buildMCPToolsets(&cfg, services, idempotencyStore, mcpLogger)
 
 		// MCP middleware chain: logger → auth401 interceptor → JWT auth → handler
 		mcpLoggerMw := apilogger.LoggerMiddleware(mcpLogger)
 		resourceMetadataURL := cfg.Server.PublicURL + "/.well-known/oauth-protected-resource"
 		mcpAuth401Mw := mcpmiddleware.Auth401Interceptor(resourceMetadataURL, cfg.Identity.MCPOAuthScopes)
 		mcpHandler := middleware.Chain(mcpLoggerMw, mcpAuth401Mw, jwtMiddleware)(mcp.NewHTTPServer(toolsets, runtime.pdp))
+		if maxBodySize := cfg.Server.Limits.MaxBodySize; maxBodySize > 0 {
+			mcpHandler = http.MaxBytesHandler(mcpHandler, maxBodySize)
+		}
 
 		baseMux.Handle("/mcp", mcpHandler)
 	}
 
-	// Create OpenAPI handler with middleware chain (order: logger → auth → webhookBody → handler)
+	// Body size limits and timeouts of the REST operations.
+	limitsMiddleware, err := limits.Middleware(cfg.Server.ToLimitsConfig())
+	if err != nil {
+		logger.Error("Failed to initialize request limits", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	// Create OpenAPI handler with middleware chain (order: logger → limits → auth → audit →
+	// compress → idempotency → etag → fields → convert → webhookBody → handler)
 	// Middlewares are applied last-to-first (last entry becomes the outermost wrapper).
-	// Execution order: loggerMiddleware → authMiddleware → webhookRawBodyMiddleware → handler.
+	// Execution order: loggerMiddleware → limitsMiddleware → authMiddleware → auditMiddleware →
+	// compress → idempotency → etag → selectFields → convertResponses → webhookRawBodyMiddleware →
+	// handler.
 	// loggerMiddleware must be outermost so it captures all responses, including 401s from auth.
+	// limitsMiddleware runs before auth so that oversized bodies are rejected before any work
+	// is done, and its timeout covers authentication and authorization.
+	// auditMiddleware runs after auth so that audit events carry the authenticated actor.
+	// compress runs outside idempotency so that stored responses are replayed uncompressed
+	// to clients that do not accept gzip.
+	// idempotency runs after auth so that keys are scoped to the caller, and stores
+	// responses in their final shape.
+	// etag runs outside selectFields and convertResponses so that it tags responses in
+	// their final shape.
 	// webhookRawBodyMiddleware must be innermost (before the strict handler decodes the body)
 	// so that HMAC signature validation can access the original raw bytes.
+	// convertResponses rewrites successful responses into the negotiated response version.
+	// selectFields runs outside convertResponses so that it reduces the response in its final shape.
 	// The generated routes are registered on the baseMux alongside /mcp.
+	middlewares := []gen.MiddlewareFunc{
+		openapihandlers.WebhookRawBodyMiddleware, versioning.ConvertResponses, fieldselect.Middleware, etag.Middleware,
+	}
+	if idempotencyStore != nil {
+		middlewares = append(middlewares, idempotency.Middleware(idempotencyStore))
+	}
+	middlewares = append(middlewares, compress.Middleware(cfg.Server.Middleware.Compression.ToCompressConfig()))
+	if auditLogger != nil {
+		auditResolver := auditmiddleware.NewActionResolver(apiaudit.GetActionDefinitions())
+		middlewares = append(middlewares, auditmiddleware.NewMiddleware(auditLogger, auditResolver).Handler)
+	}
+	middlewares = append(middlewares, authMiddleware, limitsMiddleware, loggerMiddleware)
 	handler := gen.HandlerWithOptions(strictHandler, gen.StdHTTPServerOptions{
 		BaseRouter:  baseMux,
-		Middlewares: []gen.MiddlewareFunc{openapihandlers.WebhookRawBodyMiddleware, authMiddleware, loggerMiddleware},
+		Middlewares: middlewares,
 	})
 
-	// Exec WebSocket endpoint is registered on a top-level mux that wraps the
-	// OpenAPI handler. This keeps exec outside the OpenAPI middleware chain whose
-	// ResponseWriter wrappers break http.Hijacker (required for WebSocket upgrade).
-	// The JWT middleware is applied directly to the exec handler for authentication.
-	// Authorization is enforced inside the handler via AuthzChecker (component:exec).
-	var topHandler http.Handler = handler
+	// Streaming endpoints (exec, port-forward, wirelogs and watch) are registered on a
+	// top-level mux that wraps the OpenAPI handler. This keeps them outside the
+	// OpenAPI middleware chain whose ResponseWriter wrappers break http.Hijacker
+	// (required for WebSocket upgrade) and http.Flusher (required for SSE).
+	// The JWT middleware is applied directly to each streaming handler for
+	// authentication; authorization is enforced by the handlers and services.
+	topMux := http.NewServeMux()
+	topMux.Handle("/", handler)
+
+	watchService := watchsvc.NewServiceWithAuthz(serviceK8sClient, runtime.pdp, logger.With("component", "watch-service"))
+	topMux.Handle("GET /api/v1/watch", jwtMiddleware(openapihandlers.NewWatchHandler(watchService, logger)))
+	logger.Info("Watch endpoint registered", "path", "/api/v1/watch")
+
+	const workflowRunLogsStreamPath = "/api/v1/namespaces/{namespace}/workflowruns/{runName}/logs/stream"
+	topMux.Handle("GET "+workflowRunLogsStreamPath,
+		jwtMiddleware(openapihandlers.NewWorkflowRunLogsHandler(services.WorkflowRunService, logger)))
+	logger.Info("Workflow run log stream endpoint registered", "path", workflowRunLogsStreamPath)
+
+	// Post resource events to the outbound webhook subscriptions. Events are
+	// read with the identity of the API server, not of any caller.
+	if len(cfg.OutboundWebhooks.Subscriptions) > 0 {
+		webhookOpts, err := cfg.OutboundWebhooks.ToOptions()
+		if err != nil {
+			logger.Error("Failed to initialize outbound webhooks", slog.Any("error", err))
+			os.Exit(1)
+		}
+		dispatcher := outboundwebhook.New(watchsvc.NewService(k8sClient, logger.With("component", "outbound-webhook-watch")),
+			webhookOpts, logger.With("component", "outbound-webhooks"))
+		go dispatcher.Run(ctx)
+		logger.Info("Outbound webhooks enabled", "subscriptions", len(webhookOpts.Subscriptions))
+	}
+
+	// Export resources to a Git repository on a schedule. Resources are read
+	// with the identity of the API server, not of any caller.
+	if cfg.GitOpsExport.Enabled {
+		exportOpts, err := cfg.GitOpsExport.ToOptions()
+		if err != nil {
+			logger.Error("Failed to initialize GitOps export", slog.Any("error", err))
+			os.Exit(1)
+		}
+		go gitopsexport.New(k8sClient, exportOpts, logger.With("component", "gitops-export")).Run(ctx)
+		logger.Info("GitOps export enabled", "namespaces", exportOpts.Scope.Namespaces,
+			"interval", exportOpts.Interval, "push", exportOpts.Repository != nil)
+	}
+
 	if cfg.ClusterGateway.Enabled && gatewayURL != "" {
 		execAuthzChecker := svcpkg.NewAuthzChecker(runtime.pdp, logger.With("component", "exec-authz"))
 		gwTLSConf, err := gatewayClient.BuildTLSConfig(&gatewayClient.TLSConfig{
@@ -250,6 +445,13 @@ func main() {
 		execHandler := openapihandlers.NewExecHandler(k8sClient, gwClient, gatewayURL, gwTLSConf, execAuthzChecker, logger)
 		authedExecHandler := jwtMiddleware(execHandler)
 
+		// Port-forward resolves pods like exec but is authorized with its own action.
+		portForwardAuthzChecker := svcpkg.NewAuthzChecker(runtime.pdp, logger.With("component", "portforward-authz"))
+		portForwardHandler := openapihandlers.NewPortForwardHandler(
+			k8sClient, gwClient, gatewayURL, gwTLSConf, portForwardAuthzChecker, logger,
+		)
+		authedPortForwardHandler := jwtMiddleware(portForwardHandler)
+
 		// Wirelogs handler shares the same gateway TLS config and authz checker
 		// (authz reuses logs:view at the component scope).
 		wirelogsAuthzChecker := svcpkg.NewAuthzChecker(runtime.pdp, logger.With("component", "wirelogs-authz"))
@@ -258,15 +460,52 @@ func main() {
 		)
 		authedWirelogsHandler := jwtMiddleware(wirelogsHandler)
 
-		topMux := http.NewServeMux()
 		topMux.Handle("/exec/", authedExecHandler)
+		topMux.Handle("/portforward/", authedPortForwardHandler)
 		topMux.Handle("GET /api/v1/namespaces/{namespace}/environments/{environment}/wirelogs", authedWirelogsHandler)
-		topMux.Handle("/", handler)
-		topHandler = topMux
 		logger.Info("Exec endpoint registered", "path", "/exec/namespaces/{ns}/components/{name}")
+		logger.Info("Port-forward endpoint registered", "path", "/portforward/namespaces/{ns}/components/{name}")
 		logger.Info("Wirelogs endpoint registered",
 			"path", "/api/v1/namespaces/{namespace}/environments/{environment}/wirelogs")
 	}
+	var topHandler http.Handler = topMux
+
+	// Select the response version of versioned operations, and route their
+	// /api/v1alpha1/ aliases to the /api/v1/ handlers.
+	topHandler = versioning.Negotiate(versioning.Version(cfg.Server.DefaultResponseVersion))(topHandler)
+
+	// Rate limit every REST, MCP and streaming request before it is
+	// authenticated, so excess requests never reach the Kubernetes API.
+	rateLimitCfg := cfg.Server.Middleware.RateLimit
+	if rateLimitCfg.Enabled {
+		topHandler = ratelimit.Middleware(rateLimitCfg.ToRateLimitConfig())(topHandler)
+		logger.Info("Request rate limiting enabled",
+			"globalRate", rateLimitCfg.GlobalRate, "globalBurst", rateLimitCfg.GlobalBurst,
+			"clientRate", rateLimitCfg.ClientRate, "clientBurst", rateLimitCfg.ClientBurst)
+	}
+
+	// Answer preflight requests of browsers before they are rate limited, and
+	// let browsers read the responses of allowed origins, including 429s.
+	if corsCfg := cfg.Server.CORS; len(corsCfg.AllowedOrigins) > 0 {
+		topHandler = cors.Middleware(corsCfg.ToCORSConfig())(topHandler)
+		logger.Info("CORS enabled", "allowedOrigins", corsCfg.AllowedOrigins)
+	}
+
+	// Rewrite every error response, including those of the rate limiter and the
+	// authentication middleware, into problem details.
+	topHandler = problem.Middleware(topHandler)
+
+	// Every REST, MCP and streaming request gets a server span that
+	// continues the caller's trace.
+	topHandler = tracing.NewHandler(topHandler, "openchoreo-api")
+
+	// Serve the gRPC resource and schema APIs next to the HTTP server
+	if cfg.GRPC.Enabled {
+		if err := startGRPCServer(ctx, &cfg, services, watchService, jwtMiddleware, logger); err != nil {
+			logger.Error("Failed to start gRPC server", slog.Any("error", err))
+			os.Exit(1)
+		}
+	}
 
 	// Create server from configuration
 	srv := server.New(cfg.Server.ToServerConfig(), topHandler, logger)
@@ -279,6 +518,47 @@ func main() {
 	logger.Info("Server stopped gracefully")
 }
 
+// startGRPCServer serves the gRPC API alongside the HTTP server until ctx is done.
+func startGRPCServer(
+	ctx context.Context,
+	cfg *config.Config,
+	services *handlerservices.Services,
+	watchService watchsvc.Service,
+	jwtMiddleware func(http.Handler) http.Handler,
+	logger *slog.Logger,
+) error {
+	grpcLogger := logger.With("component", "grpc")
+
+	var opts []grpc.ServerOption
+	if cfg.Server.TLS.Enabled {
+		creds, err := credentials.NewServerTLSFromFile(cfg.Server.TLS.CertFile, cfg.Server.TLS.KeyFile)
+		if err != nil {
+			return fmt.Errorf("failed to load TLS credentials: %w", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+
+	addr := net.JoinHostPort(cfg.Server.BindAddress, strconv.Itoa(cfg.GRPC.Port))
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	grpcServer := grpcapi.NewServer(services, watchService, jwtMiddleware, grpcLogger, opts...)
+	go func() {
+		grpcLogger.Info("gRPC server listening", "address", addr, "tls", cfg.Server.TLS.Enabled)
+		if err := grpcServer.Serve(lis); err != nil {
+			grpcLogger.Error("gRPC server error", slog.Any("error", err))
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		grpcServer.GracefulStop()
+		grpcLogger.Info("gRPC server stopped")
+	}()
+	return nil
+}
+
 // cliFlags holds direct command-line flags that control program behavior.
 type cliFlags struct {
 	configPath string // Path to config file
@@ -307,20 +587,133 @@ func setupFlags() (*pflag.FlagSet, *cliFlags) {
 type runtime struct {
 	pap authzcore.PAP
 	pdp authzcore.PDP
+	// cache is the informer cache of the authz CRDs. Nil when authz disabled.
+	cache cache.Cache
 	// start runs any background processes (manager, cache sync). No-op when authz disabled.
 	start func(context.Context) error
 }
 
+// setupAudit creates the audit logger and its sinks from the configuration.
+// The logger is nil when audit logging is disabled. The returned store backs the
+// audit events query endpoint and is nil when querying is disabled. The returned
+// function flushes and closes the sinks.
+func setupAudit(
+	cfg *config.AuditConfig, logger *slog.Logger,
+) (*auditmiddleware.Logger, auditeventsvc.EventStore, func(), error) {
+	noop := func() {}
+	if !cfg.Enabled {
+		logger.Info("Audit logging disabled")
+		return nil, nil, noop, nil
+	}
+
+	var sinks []auditmiddleware.Sink
+	var closers []func() error
+	if cfg.Sinks.Stdout {
+		sinks = append(sinks, auditmiddleware.NewJSONSink(os.Stdout))
+	}
+	if cfg.Sinks.File.Path != "" {
+		fileSink, err := auditmiddleware.NewFileSink(cfg.Sinks.File.Path)
+		if err != nil {
+			return nil, nil, noop, err
+		}
+		sinks = append(sinks, fileSink)
+		closers = append(closers, fileSink.Close)
+	}
+	if cfg.Sinks.Webhook.URL != "" {
+		webhookSink := auditmiddleware.NewWebhookSink(cfg.Sinks.Webhook.URL, cfg.Sinks.Webhook.Timeout, func(err error) {
+			logger.Warn("Failed to deliver audit event", slog.Any("error", err))
+		})
+		sinks = append(sinks, webhookSink)
+		closers = append(closers, webhookSink.Close)
+	}
+	var store auditeventsvc.EventStore
+	if cfg.QueryBufferSize > 0 {
+		memorySink := auditmiddleware.NewMemorySink(cfg.QueryBufferSize)
+		sinks = append(sinks, memorySink)
+		store = memorySink
+	}
+
+	logger.Info("Audit logging enabled",
+		"stdout", cfg.Sinks.Stdout,
+		"file", cfg.Sinks.File.Path != "",
+		"webhook", cfg.Sinks.Webhook.URL != "",
+		"queryBufferSize", cfg.QueryBufferSize)
+
+	closeSinks := func() {
+		for _, closeSink := range closers {
+			if err := closeSink(); err != nil {
+				logger.Warn("Failed to close audit sink", slog.Any("error", err))
+			}
+		}
+	}
+	return auditmiddleware.NewLogger(logger, version.Get().Name, sinks...), store, closeSinks, nil
+}
+
+// setupHealth creates the health service with the liveness, readiness and
+// diagnostic checks for the dependencies in use. Nil caches are not checked,
+// and the plane and gateway checks need the cluster gateway client. The data
+// plane check is part of readiness only when configured.
+func setupHealth(
+	cfg *config.HealthConfig,
+	k8sClient client.Client,
+	readCache, authzCache cache.Cache,
+	gwClient *gatewayClient.Client,
+	logger *slog.Logger,
+) (healthsvc.Service, error) {
+	discoveryClient, err := k8s.NewDiscoveryClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discovery client: %w", err)
+	}
+
+	checks := []healthsvc.Check{
+		healthsvc.KubernetesAPICheck(discoveryClient),
+		healthsvc.CRDCheck(discoveryClient, k8sClient.Scheme(), openchoreov1alpha1.GroupVersion),
+	}
+	if readCache != nil {
+		checks = append(checks, healthsvc.CacheSyncCheck("readCache", readCache))
+	}
+	if authzCache != nil {
+		checks = append(checks, healthsvc.CacheSyncCheck("authzCache", authzCache))
+	}
+	if gwClient != nil {
+		dataPlanes := healthsvc.DataPlaneCheck(k8sClient, gwClient)
+		dataPlanes.Diagnostic = !cfg.CheckDataPlanes
+		checks = append(checks,
+			dataPlanes,
+			healthsvc.WorkflowPlaneCheck(k8sClient, gwClient),
+			healthsvc.GatewayCheck(k8sClient, gwClient),
+		)
+	} else if cfg.CheckDataPlanes {
+		logger.Warn("Data plane health check requires the cluster gateway; skipping it")
+	}
+
+	admissionClient, err := k8s.NewAdmissionRegistrationClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create admission registration client: %w", err)
+	}
+	checks = append(checks, healthsvc.WebhookCertificateCheck(admissionClient, time.Now))
+	return healthsvc.NewService(checks, cfg.CheckTimeout, logger), nil
+}
+
 // buildMCPToolsets creates the MCP toolsets from the configuration.
 // Each enabled toolset is backed by the handler services layer.
-func buildMCPToolsets(cfg *config.Config, svc *handlerservices.Services, logger *slog.Logger) *tools.Toolsets {
+func buildMCPToolsets(
+	cfg *config.Config,
+	svc *handlerservices.Services,
+	idempotencyStore *idempotency.Store,
+	logger *slog.Logger,
+) *tools.Toolsets {
 	toolsetsMap := cfg.MCP.ParseToolsets()
 
 	logger.Info("Initializing MCP server", slog.Any("enabled_toolsets", cfg.MCP.Toolsets))
 
 	handler := mcphandlers.NewMCPHandler(svc)
 
-	toolsets := &tools.Toolsets{}
+	toolsets := &tools.Toolsets{
+		ErrorClassifier: mcphandlers.ClassifyServiceError,
+		RateLimit:       cfg.MCP.RateLimit.ToolsRateLimit(),
+		Idempotency:     idempotencyStore,
+	}
 	for toolsetType := range toolsetsMap {
 		switch toolsetType {
 		case tools.ToolsetNamespace:
@@ -344,6 +737,9 @@ func buildMCPToolsets(cfg *config.Config, svc *handlerservices.Services, logger
 		case tools.ToolsetResource:
 			toolsets.ResourceToolset = handler
 			logger.Debug("Enabled MCP toolset", slog.String("toolset", "resource"))
+		case tools.ToolsetBatch:
+			toolsets.Batch = true
+			logger.Debug("Enabled MCP toolset", slog.String("toolset", "batch"))
 		default:
 			logger.Warn("Unknown toolset type", slog.String("toolset", string(toolsetType)))
 		}
@@ -395,6 +791,7 @@ func setupRuntime(
 
 	rt := &runtime{pap: pap, pdp: pdp, start: func(context.Context) error { return nil }}
 	if mgr != nil {
+		rt.cache = mgr.GetCache()
 		rt.start = func(ctx context.Context) error {
 			go func() {
 				if err := mgr.Start(ctx); err != nil {
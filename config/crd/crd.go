@@ -7,10 +7,5 @@ package crd
 
 import "embed"
 
-//go:embed bases/openchoreo.dev_componenttypes.yaml
-//go:embed bases/openchoreo.dev_clustercomponenttypes.yaml
-//go:embed bases/openchoreo.dev_traits.yaml
-//go:embed bases/openchoreo.dev_clustertraits.yaml
-//go:embed bases/openchoreo.dev_workflows.yaml
-//go:embed bases/openchoreo.dev_clusterworkflows.yaml
+//go:embed bases/*.yaml
 var FS embed.FS
@@ -40,11 +40,12 @@ const (
 	ActionDeleteProject = "project:delete"
 
 	// Component actions
-	ActionCreateComponent = "component:create"
-	ActionViewComponent   = "component:view"
-	ActionUpdateComponent = "component:update"
-	ActionDeleteComponent = "component:delete"
-	ActionExecComponent   = "component:exec"
+	ActionCreateComponent      = "component:create"
+	ActionViewComponent        = "component:view"
+	ActionUpdateComponent      = "component:update"
+	ActionDeleteComponent      = "component:delete"
+	ActionExecComponent        = "component:exec"
+	ActionPortForwardComponent = "component:portforward"
 
 	// Resource actions
 	ActionCreateResource = "resource:create"
@@ -79,6 +80,35 @@ const (
 	ActionUpdateReleaseBinding = "releasebinding:update"
 	ActionDeleteReleaseBinding = "releasebinding:delete"
 
+	// PromotionRequest actions
+	ActionCreatePromotionRequest  = "promotionrequest:create"
+	ActionViewPromotionRequest    = "promotionrequest:view"
+	ActionApprovePromotionRequest = "promotionrequest:approve"
+
+	// CustomDomain actions
+	ActionCreateCustomDomain = "customdomain:create"
+	ActionViewCustomDomain   = "customdomain:view"
+	ActionDeleteCustomDomain = "customdomain:delete"
+
+	// Connection actions
+	ActionCreateConnection = "connection:create"
+	ActionViewConnection   = "connection:view"
+	ActionDeleteConnection = "connection:delete"
+
+	// NetworkPolicy actions
+	ActionViewNetworkPolicy = "networkpolicy:view"
+
+	// NetworkPolicyRule actions
+	ActionCreateNetworkPolicyRule = "networkpolicyrule:create"
+	ActionViewNetworkPolicyRule   = "networkpolicyrule:view"
+	ActionUpdateNetworkPolicyRule = "networkpolicyrule:update"
+	ActionDeleteNetworkPolicyRule = "networkpolicyrule:delete"
+
+	// Sandbox actions
+	ActionCreateSandbox = "sandbox:create"
+	ActionViewSandbox   = "sandbox:view"
+	ActionDeleteSandbox = "sandbox:delete"
+
 	// ResourceReleaseBinding actions
 	ActionCreateResourceReleaseBinding = "resourcereleasebinding:create"
 	ActionViewResourceReleaseBinding   = "resourcereleasebinding:view"
@@ -276,6 +306,9 @@ const (
 	// FinOps Report actions
 	ActionViewFinOpsReport   = "finopsreport:view"
 	ActionUpdateFinOpsReport = "finopsreport:update"
+
+	// Audit event actions
+	ActionViewAuditEvents = "auditevents:view"
 )
 
 // Action represents a system action with metadata
@@ -309,6 +342,7 @@ var systemActions = []Action{
 	{Name: ActionUpdateComponent, LowestScope: ScopeComponent, IsInternal: false},
 	{Name: ActionDeleteComponent, LowestScope: ScopeComponent, IsInternal: false},
 	{Name: ActionExecComponent, LowestScope: ScopeComponent, IsInternal: false},
+	{Name: ActionPortForwardComponent, LowestScope: ScopeComponent, IsInternal: false},
 
 	// Resource
 	{Name: ActionCreateResource, LowestScope: ScopeProject, IsInternal: false},
@@ -343,6 +377,35 @@ var systemActions = []Action{
 	{Name: ActionUpdateReleaseBinding, LowestScope: ScopeComponent, IsInternal: false},
 	{Name: ActionDeleteReleaseBinding, LowestScope: ScopeComponent, IsInternal: false},
 
+	// PromotionRequest
+	{Name: ActionViewPromotionRequest, LowestScope: ScopeComponent, IsInternal: false},
+	{Name: ActionCreatePromotionRequest, LowestScope: ScopeComponent, IsInternal: false},
+	{Name: ActionApprovePromotionRequest, LowestScope: ScopeComponent, IsInternal: false},
+
+	// CustomDomain
+	{Name: ActionViewCustomDomain, LowestScope: ScopeComponent, IsInternal: false},
+	{Name: ActionCreateCustomDomain, LowestScope: ScopeComponent, IsInternal: false},
+	{Name: ActionDeleteCustomDomain, LowestScope: ScopeComponent, IsInternal: false},
+
+	// Connection
+	{Name: ActionViewConnection, LowestScope: ScopeComponent, IsInternal: false},
+	{Name: ActionCreateConnection, LowestScope: ScopeComponent, IsInternal: false},
+	{Name: ActionDeleteConnection, LowestScope: ScopeComponent, IsInternal: false},
+
+	// NetworkPolicy
+	{Name: ActionViewNetworkPolicy, LowestScope: ScopeProject, IsInternal: false},
+
+	// NetworkPolicyRule
+	{Name: ActionViewNetworkPolicyRule, LowestScope: ScopeNamespace, IsInternal: false},
+	{Name: ActionCreateNetworkPolicyRule, LowestScope: ScopeNamespace, IsInternal: false},
+	{Name: ActionUpdateNetworkPolicyRule, LowestScope: ScopeNamespace, IsInternal: false},
+	{Name: ActionDeleteNetworkPolicyRule, LowestScope: ScopeNamespace, IsInternal: false},
+
+	// Sandbox
+	{Name: ActionViewSandbox, LowestScope: ScopeProject, IsInternal: false},
+	{Name: ActionCreateSandbox, LowestScope: ScopeProject, IsInternal: false},
+	{Name: ActionDeleteSandbox, LowestScope: ScopeProject, IsInternal: false},
+
 	// ResourceReleaseBinding
 	{Name: ActionViewResourceReleaseBinding, LowestScope: ScopeResource, IsInternal: false},
 	{Name: ActionCreateResourceReleaseBinding, LowestScope: ScopeResource, IsInternal: false},
@@ -540,6 +603,9 @@ var systemActions = []Action{
 	// FinOps Report
 	{Name: ActionViewFinOpsReport, LowestScope: ScopeProject, IsInternal: false},
 	{Name: ActionUpdateFinOpsReport, LowestScope: ScopeProject, IsInternal: false},
+
+	// Audit events
+	{Name: ActionViewAuditEvents, LowestScope: ScopeCluster, IsInternal: false},
 }
 
 // AllActions returns all system-defined actions
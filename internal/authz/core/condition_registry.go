@@ -79,6 +79,13 @@ var conditionRegistry = map[string][]AttributeSpec{
 	ActionViewReleaseBinding:           {AttrResourceEnvironment},
 	ActionUpdateReleaseBinding:         {AttrResourceEnvironment},
 	ActionDeleteReleaseBinding:         {AttrResourceEnvironment},
+	ActionCreatePromotionRequest:       {AttrResourceEnvironment},
+	ActionViewPromotionRequest:         {AttrResourceEnvironment},
+	ActionApprovePromotionRequest:      {AttrResourceEnvironment},
+	ActionCreateCustomDomain:           {AttrResourceEnvironment},
+	ActionViewCustomDomain:             {AttrResourceEnvironment},
+	ActionDeleteCustomDomain:           {AttrResourceEnvironment},
+	ActionViewNetworkPolicy:            {AttrResourceEnvironment},
 	ActionCreateResourceReleaseBinding: {AttrResourceEnvironment},
 	ActionViewResourceReleaseBinding:   {AttrResourceEnvironment},
 	ActionUpdateResourceReleaseBinding: {AttrResourceEnvironment},
@@ -88,6 +95,7 @@ var conditionRegistry = map[string][]AttributeSpec{
 	ActionUpdateProjectReleaseBinding:  {AttrResourceEnvironment},
 	ActionDeleteProjectReleaseBinding:  {AttrResourceEnvironment},
 	ActionExecComponent:                {AttrResourceEnvironment},
+	ActionPortForwardComponent:         {AttrResourceEnvironment},
 	ActionViewLogs:                     {AttrResourceEnvironment},
 	ActionViewWirelogs:                 {AttrResourceEnvironment},
 	ActionViewMetrics:                  {AttrResourceEnvironment},
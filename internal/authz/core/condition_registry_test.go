@@ -44,6 +44,12 @@ func TestLookupConditions(t *testing.T) {
 		require.Equal(t, AttrResourceEnvironment.Key, specs[0].Key)
 	})
 
+	t.Run("component:portforward supports resource.environment", func(t *testing.T) {
+		specs := LookupConditions(ActionPortForwardComponent)
+		require.Len(t, specs, 1)
+		require.Equal(t, AttrResourceEnvironment.Key, specs[0].Key)
+	})
+
 	t.Run("resourcereleasebinding actions support resource.environment", func(t *testing.T) {
 		for _, action := range []string{
 			ActionCreateResourceReleaseBinding,
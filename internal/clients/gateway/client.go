@@ -372,27 +372,7 @@ func (c *Client) GetPodLogsFromPlane(ctx context.Context, planeType, planeID, pl
 		return "", fmt.Errorf("pod reference is required and must have namespace and name")
 	}
 
-	// Build query parameters
-	queryParams := ""
-	if options != nil {
-		params := []string{}
-		if options.ContainerName != "" {
-			params = append(params, fmt.Sprintf("container=%s", options.ContainerName))
-		}
-		if options.IncludeTimestamps {
-			params = append(params, "timestamps=true")
-		}
-		if options.SinceSeconds != nil && *options.SinceSeconds > 0 {
-			params = append(params, fmt.Sprintf("sinceSeconds=%d", *options.SinceSeconds))
-		}
-		if len(params) > 0 {
-			queryParams = "?" + strings.Join(params, "&")
-		}
-	}
-
-	// Build the URL for the Kubernetes API request
-	k8sAPIPathForPodLogs := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/log%s", podReference.Namespace, podReference.Name, queryParams)
-	k8sProxyURLForPodLogs := fmt.Sprintf("%s/api/proxy/%s/%s/%s/%s/k8s%s", c.baseURL, planeType, planeID, planeNamespace, planeName, k8sAPIPathForPodLogs)
+	k8sProxyURLForPodLogs := c.podLogsProxyURL(planeType, planeID, planeNamespace, planeName, podReference, options, false)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", k8sProxyURLForPodLogs, nil)
 	if err != nil {
@@ -424,6 +404,68 @@ func (c *Client) GetPodLogsFromPlane(ctx context.Context, planeType, planeID, pl
 	return string(body), nil
 }
 
+// StreamPodLogsFromPlane follows the logs of a pod container through the gateway
+// proxy. The returned stream stays open until the container terminates, the
+// context is cancelled or the caller closes it. The caller must close it.
+func (c *Client) StreamPodLogsFromPlane(ctx context.Context, planeType, planeID, planeNamespace, planeName string, podReference *PodReference, options *PodLogsOptions) (io.ReadCloser, error) {
+	if podReference == nil || podReference.Namespace == "" || podReference.Name == "" {
+		return nil, fmt.Errorf("pod reference is required and must have namespace and name")
+	}
+
+	k8sProxyURLForPodLogs := c.podLogsProxyURL(planeType, planeID, planeNamespace, planeName, podReference, options, true)
+	req, err := http.NewRequestWithContext(ctx, "GET", k8sProxyURLForPodLogs, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.streamingHTTPClient().Do(req)
+	if err != nil {
+		return nil, &TransientError{
+			Message: "failed to send request",
+			Err:     err,
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, classifyHTTPError(resp.StatusCode)
+	}
+
+	return resp.Body, nil
+}
+
+// streamingHTTPClient returns a client that shares the transport of the gateway
+// client but has no overall timeout, for long-lived streaming responses. Streams
+// are bounded by the request context instead.
+func (c *Client) streamingHTTPClient() *http.Client {
+	return &http.Client{Transport: c.httpClient.Transport}
+}
+
+// podLogsProxyURL builds the gateway proxy URL of the Kubernetes pod log API.
+func (c *Client) podLogsProxyURL(planeType, planeID, planeNamespace, planeName string, podReference *PodReference, options *PodLogsOptions, follow bool) string {
+	params := []string{}
+	if options != nil {
+		if options.ContainerName != "" {
+			params = append(params, fmt.Sprintf("container=%s", options.ContainerName))
+		}
+		if options.IncludeTimestamps {
+			params = append(params, "timestamps=true")
+		}
+		if options.SinceSeconds != nil && *options.SinceSeconds > 0 {
+			params = append(params, fmt.Sprintf("sinceSeconds=%d", *options.SinceSeconds))
+		}
+	}
+	if follow {
+		params = append(params, "follow=true")
+	}
+	queryParams := ""
+	if len(params) > 0 {
+		queryParams = "?" + strings.Join(params, "&")
+	}
+
+	k8sAPIPathForPodLogs := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/log%s", podReference.Namespace, podReference.Name, queryParams)
+	return fmt.Sprintf("%s/api/proxy/%s/%s/%s/%s/k8s%s", c.baseURL, planeType, planeID, planeNamespace, planeName, k8sAPIPathForPodLogs)
+}
+
 // ProxyK8sRequest proxies a read-only K8s API request through the gateway.
 // Returns the raw HTTP response for the caller to stream back.
 // For cluster-scoped CRs, pass crNamespace as "_cluster".
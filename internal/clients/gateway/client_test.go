@@ -739,6 +739,52 @@ func TestGetPodLogsFromPlane(t *testing.T) {
 	})
 }
 
+func TestStreamPodLogsFromPlane(t *testing.T) {
+	podRef := &PodReference{Namespace: "default", Name: "my-pod"}
+
+	t.Run("follows the container logs", func(t *testing.T) {
+		var capturedURI string
+		c := newTestGatewayClient(t, func(w http.ResponseWriter, r *http.Request) {
+			capturedURI = r.URL.RequestURI()
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("line 1\nline 2\n"))
+		})
+
+		stream, err := c.StreamPodLogsFromPlane(context.Background(), "workflowplane", "default", "acme", "my-wp",
+			podRef, &PodLogsOptions{ContainerName: "main", IncludeTimestamps: true})
+		require.NoError(t, err)
+		defer stream.Close()
+
+		body, err := io.ReadAll(stream)
+		require.NoError(t, err)
+		assert.Equal(t, "line 1\nline 2\n", string(body))
+		assert.Equal(t, "/api/proxy/workflowplane/default/acme/my-wp/k8s/api/v1/namespaces/default/pods/my-pod/log"+
+			"?container=main&timestamps=true&follow=true", capturedURI)
+	})
+
+	t.Run("nil pod reference returns error", func(t *testing.T) {
+		c := newTestGatewayClient(t, func(w http.ResponseWriter, r *http.Request) {})
+		_, err := c.StreamPodLogsFromPlane(context.Background(), "workflowplane", "default", "acme", "my-wp", nil, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("404 returns PermanentError", func(t *testing.T) {
+		c := newTestGatewayClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})
+		_, err := c.StreamPodLogsFromPlane(context.Background(), "workflowplane", "default", "acme", "my-wp", podRef, nil)
+		require.Error(t, err)
+		assert.True(t, IsPermanentError(err))
+	})
+
+	t.Run("network error returns TransientError", func(t *testing.T) {
+		c := &Client{baseURL: "https://localhost:1", httpClient: http.DefaultClient}
+		_, err := c.StreamPodLogsFromPlane(context.Background(), "workflowplane", "default", "acme", "my-wp", podRef, nil)
+		require.Error(t, err)
+		assert.True(t, IsTransientError(err))
+	})
+}
+
 func TestGetPodEventsFromPlane(t *testing.T) {
 	podRef := &PodReference{Namespace: "default", Name: "my-pod"}
 
@@ -23,6 +23,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openchoreo/openchoreo/internal/tracing"
 )
 
 // ProxyClient is a Kubernetes client that communicates through the cluster gateway HTTP proxy
@@ -71,9 +73,9 @@ func NewProxyClient(gatewayURL, planeIdentifier string, crNamespace, crName stri
 		crNamespace: crNamespace,
 		crName:      crName,
 		httpClient: &http.Client{
-			Transport: &http.Transport{
+			Transport: tracing.WrapTransport("k8s proxy")(&http.Transport{
 				TLSClientConfig: tlsCfg,
-			},
+			}),
 		},
 		scheme: scheme.Scheme,
 	}, nil
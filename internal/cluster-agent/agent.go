@@ -49,6 +49,9 @@ type Agent struct {
 	// hubbleStreams tracks active hubble flow streaming sessions indexed by requestID
 	hubbleStreams   map[string]*hubbleSession
 	hubbleStreamsMu sync.Mutex
+	// portForwardStreams tracks active port-forward connections indexed by requestID
+	portForwardStreams   map[string]*portForwardSession
+	portForwardStreamsMu sync.Mutex
 }
 
 func New(cfg *Config, k8sClient client.Client, k8sConfig *rest.Config, logger *slog.Logger) (*Agent, error) {
@@ -93,16 +96,17 @@ func New(cfg *Config, k8sClient client.Client, k8sConfig *rest.Config, logger *s
 	}
 
 	return &Agent{
-		config:        cfg,
-		clientCert:    cert,
-		serverCA:      serverCertPool,
-		k8sClient:     k8sClient,
-		k8sConfig:     k8sConfig,
-		router:        router,
-		logger:        logger.With("component", "agent", "planeID", cfg.PlaneID),
-		stopChan:      make(chan struct{}),
-		activeStreams: make(map[string]*execSession),
-		hubbleStreams: make(map[string]*hubbleSession),
+		config:             cfg,
+		clientCert:         cert,
+		serverCA:           serverCertPool,
+		k8sClient:          k8sClient,
+		k8sConfig:          k8sConfig,
+		router:             router,
+		logger:             logger.With("component", "agent", "planeID", cfg.PlaneID),
+		stopChan:           make(chan struct{}),
+		activeStreams:      make(map[string]*execSession),
+		hubbleStreams:      make(map[string]*hubbleSession),
+		portForwardStreams: make(map[string]*portForwardSession),
 	}, nil
 }
 
@@ -241,23 +245,25 @@ func (a *Agent) handleConnection(ctx context.Context) {
 			return
 		}
 
-		// Try to parse as stream init (exec / hubble requests)
+		// Try to parse as stream init (exec / hubble / port-forward requests)
 		var streamInit messaging.HTTPTunnelStreamInit
 		if err := json.Unmarshal(message, &streamInit); err == nil && streamInit.IsUpgrade && streamInit.RequestID != "" {
 			switch streamInit.Target {
 			case "hubble":
 				go a.handleHubbleStreamInit(ctx, &streamInit)
+			case "portforward":
+				go a.handlePortForwardStreamInit(&streamInit)
 			default:
 				go a.handleHTTPTunnelStreamInit(&streamInit)
 			}
 			continue
 		}
 
-		// Try to parse as stream chunk (stdin data for active exec sessions, or
-		// the close signal for exec/hubble sessions).
+		// Try to parse as stream chunk (stdin data for active exec sessions, bytes
+		// for port-forward connections, or the close signal for any session).
 		var streamChunk messaging.HTTPTunnelStreamChunk
 		if err := json.Unmarshal(message, &streamChunk); err == nil && streamChunk.RequestID != "" && (streamChunk.Data != nil || streamChunk.IsClose) {
-			if !a.routeHubbleChunk(&streamChunk) {
+			if !a.routeHubbleChunk(&streamChunk) && !a.routePortForwardChunk(&streamChunk) {
 				a.routeStreamChunk(&streamChunk)
 			}
 			continue
@@ -0,0 +1,259 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusteragent
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+
+	"github.com/openchoreo/openchoreo/internal/cluster-agent/messaging"
+)
+
+// portForwardReadBufferSize bounds the payload of a single chunk sent back to the gateway.
+const portForwardReadBufferSize = 32 * 1024
+
+// portForwardConn is one forwarded TCP connection to a pod port.
+type portForwardConn interface {
+	io.ReadWriteCloser
+	// Err returns the error the kubelet reported for the connection, if any
+	// (e.g. nothing is listening on the port).
+	Err() error
+}
+
+// openPortForward opens a SPDY port-forward connection to a pod port through the
+// Kubernetes API server. It is a variable so tests can replace the kubelet side.
+var openPortForward = func(cfg *rest.Config, namespace, podName string, port int) (portForwardConn, error) {
+	transport, upgrader, err := spdy.RoundTripperFor(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SPDY round tripper: %w", err)
+	}
+
+	u, err := url.Parse(fmt.Sprintf("%s/api/v1/namespaces/%s/pods/%s/portforward", cfg.Host, namespace, podName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build port-forward URL: %w", err)
+	}
+
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, u)
+	conn, _, err := dialer.Dial(portforward.PortForwardProtocolV1Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial pod %s/%s: %w", namespace, podName, err)
+	}
+
+	// Each forwarded connection needs an error stream and a data stream that
+	// share a request ID, as in kubectl port-forward.
+	headers := http.Header{}
+	headers.Set(corev1.StreamType, corev1.StreamTypeError)
+	headers.Set(corev1.PortHeader, strconv.Itoa(port))
+	headers.Set(corev1.PortForwardRequestIDHeader, "0")
+	errorStream, err := conn.CreateStream(headers)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to create error stream: %w", err)
+	}
+	// The error stream is read-only for the client.
+	_ = errorStream.Close()
+
+	headers.Set(corev1.StreamType, corev1.StreamTypeData)
+	dataStream, err := conn.CreateStream(headers)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to create data stream: %w", err)
+	}
+
+	pf := &spdyPortForwardConn{conn: conn, data: dataStream}
+	go pf.watchErrors(errorStream)
+	return pf, nil
+}
+
+// spdyPortForwardConn adapts the data stream of a SPDY port-forward connection.
+type spdyPortForwardConn struct {
+	conn httpstream.Connection
+	data httpstream.Stream
+	mu   sync.Mutex
+	err  error
+}
+
+func (c *spdyPortForwardConn) Read(p []byte) (int, error)  { return c.data.Read(p) }
+func (c *spdyPortForwardConn) Write(p []byte) (int, error) { return c.data.Write(p) }
+func (c *spdyPortForwardConn) Close() error                { return c.conn.Close() }
+
+func (c *spdyPortForwardConn) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+// watchErrors records the first error reported by the kubelet and tears the
+// connection down, which unblocks the pending data stream read.
+func (c *spdyPortForwardConn) watchErrors(errorStream httpstream.Stream) {
+	msg, err := io.ReadAll(errorStream)
+	if err != nil || len(msg) == 0 {
+		return
+	}
+	c.mu.Lock()
+	c.err = errors.New(strings.TrimSpace(string(msg)))
+	c.mu.Unlock()
+	_ = c.conn.Close()
+}
+
+// portForwardSession represents an active port-forward connection in the agent.
+type portForwardSession struct {
+	requestID string
+	// toPod buffers the bytes received from the gateway so the agent's message
+	// loop never blocks on a slow pod.
+	toPod *stdinPipeReader
+	conn  portForwardConn
+	done  chan struct{}
+	once  sync.Once
+}
+
+func (s *portForwardSession) close() {
+	s.once.Do(func() {
+		close(s.done)
+		s.toPod.Close()
+		_ = s.conn.Close()
+	})
+}
+
+// handlePortForwardStreamInit forwards a single TCP connection to a pod port.
+// Bytes arrive from the gateway as raw stream chunks and the pod's responses are
+// sent back the same way, without the stream type framing used by exec.
+// Dispatched from the agent's message loop for Target == "portforward".
+func (a *Agent) handlePortForwardStreamInit(init *messaging.HTTPTunnelStreamInit) {
+	logger := a.logger.With("requestID", init.RequestID, "target", "portforward", "path", init.Path)
+	logger.Info("Received port-forward stream init")
+
+	podNamespace, podName, err := parsePortForwardPath(init.Path)
+	if err != nil {
+		logger.Warn("Failed to parse port-forward path", "error", err)
+		a.sendStreamClose(init.RequestID, fmt.Sprintf("invalid port-forward path: %v", err))
+		return
+	}
+
+	params, err := url.ParseQuery(init.Query)
+	if err != nil {
+		a.sendStreamClose(init.RequestID, fmt.Sprintf("invalid port-forward query: %v", err))
+		return
+	}
+	port, err := strconv.Atoi(params.Get("ports"))
+	if err != nil || port < 1 || port > 65535 {
+		a.sendStreamClose(init.RequestID, fmt.Sprintf("invalid port %q", params.Get("ports")))
+		return
+	}
+
+	logger = logger.With("pod", podName, "namespace", podNamespace, "port", port)
+
+	conn, err := openPortForward(a.k8sConfig, podNamespace, podName, port)
+	if err != nil {
+		logger.Warn("Failed to open port-forward", "error", err)
+		a.sendStreamClose(init.RequestID, err.Error())
+		return
+	}
+
+	session := &portForwardSession{
+		requestID: init.RequestID,
+		toPod:     newStdinPipeReader(),
+		conn:      conn,
+		done:      make(chan struct{}),
+	}
+
+	a.portForwardStreamsMu.Lock()
+	if _, exists := a.portForwardStreams[init.RequestID]; exists {
+		a.portForwardStreamsMu.Unlock()
+		session.close()
+		a.sendStreamClose(init.RequestID, "duplicate port-forward requestID")
+		return
+	}
+	a.portForwardStreams[init.RequestID] = session
+	a.portForwardStreamsMu.Unlock()
+
+	defer func() {
+		session.close()
+		a.portForwardStreamsMu.Lock()
+		delete(a.portForwardStreams, init.RequestID)
+		a.portForwardStreamsMu.Unlock()
+	}()
+
+	// Sentinel chunk so the gateway knows the connection is established
+	a.sendStreamChunkRaw(init.RequestID, []byte{}, 0)
+
+	logger.Info("Port-forward stream started")
+
+	// gateway → pod
+	go func() {
+		_, _ = io.Copy(conn, session.toPod)
+		session.close()
+	}()
+
+	// pod → gateway
+	buf := make([]byte, portForwardReadBufferSize)
+	for {
+		n, readErr := conn.Read(buf)
+		if n > 0 {
+			data := make([]byte, n)
+			copy(data, buf[:n])
+			if err := a.sendStreamChunk(&messaging.HTTPTunnelStreamChunk{RequestID: init.RequestID, Data: data}); err != nil {
+				logger.Warn("Failed to forward port-forward chunk; closing stream", "error", err)
+				return
+			}
+		}
+		if readErr != nil {
+			break
+		}
+	}
+
+	closeMsg := ""
+	if err := conn.Err(); err != nil {
+		logger.Warn("Port-forward ended with error", "error", err)
+		closeMsg = err.Error()
+	}
+	logger.Info("Port-forward stream completed")
+	a.sendStreamClose(init.RequestID, closeMsg)
+}
+
+// routePortForwardChunk delivers an inbound chunk to its port-forward session, if
+// one exists for the chunk's requestID.
+func (a *Agent) routePortForwardChunk(chunk *messaging.HTTPTunnelStreamChunk) bool {
+	a.portForwardStreamsMu.Lock()
+	session, ok := a.portForwardStreams[chunk.RequestID]
+	a.portForwardStreamsMu.Unlock()
+
+	if !ok {
+		return false
+	}
+
+	if chunk.IsClose {
+		session.close()
+		return true
+	}
+
+	if len(chunk.Data) > 0 {
+		session.toPod.Write(chunk.Data)
+	}
+	return true
+}
+
+// parsePortForwardPath extracts namespace and pod name from a K8s port-forward path.
+// Expected: /api/v1/namespaces/{namespace}/pods/{pod}/portforward
+func parsePortForwardPath(path string) (namespace, podName string, err error) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) != 7 || parts[0] != "api" || parts[1] != "v1" ||
+		parts[2] != "namespaces" || parts[4] != "pods" || parts[6] != "portforward" ||
+		parts[3] == "" || parts[5] == "" {
+		return "", "", fmt.Errorf("unexpected path format: %s", path)
+	}
+	return parts[3], parts[5], nil
+}
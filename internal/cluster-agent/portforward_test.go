@@ -0,0 +1,150 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusteragent
+
+import (
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+
+	"github.com/openchoreo/openchoreo/internal/cluster-agent/messaging"
+)
+
+// pipePortForwardConn is the agent end of an in-memory connection to a fake pod.
+type pipePortForwardConn struct {
+	net.Conn
+	err error
+}
+
+func (c *pipePortForwardConn) Err() error { return c.err }
+
+// stubOpenPortForward replaces the kubelet dial with conn and restores it on cleanup.
+func stubOpenPortForward(t *testing.T, conn portForwardConn, err error) {
+	t.Helper()
+	prev := openPortForward
+	openPortForward = func(_ *rest.Config, _, _ string, _ int) (portForwardConn, error) {
+		return conn, err
+	}
+	t.Cleanup(func() { openPortForward = prev })
+}
+
+func newPortForwardTestAgent(t *testing.T, mock *mockConnection) *Agent {
+	t.Helper()
+	agent := newTestAgent(t, "ws://unused", newTestRouter(t, map[string]*Route{}))
+	agent.conn = mock
+	agent.k8sConfig = &rest.Config{Host: "https://kube.test"}
+	agent.portForwardStreams = make(map[string]*portForwardSession)
+	return agent
+}
+
+func portForwardInit(query string) *messaging.HTTPTunnelStreamInit {
+	return &messaging.HTTPTunnelStreamInit{
+		RequestID:    "pf-req-1",
+		Target:       "portforward",
+		Method:       "POST",
+		Path:         "/api/v1/namespaces/dp-ns/pods/greeter-abc/portforward",
+		Query:        query,
+		IsUpgrade:    true,
+		UpgradeProto: "SPDY/3.1",
+	}
+}
+
+func TestParsePortForwardPath(t *testing.T) {
+	ns, pod, err := parsePortForwardPath("/api/v1/namespaces/dp-ns/pods/greeter-abc/portforward")
+	require.NoError(t, err)
+	assert.Equal(t, "dp-ns", ns)
+	assert.Equal(t, "greeter-abc", pod)
+
+	for _, path := range []string{
+		"/api/v1/namespaces/dp-ns/pods/greeter-abc/exec",
+		"/api/v1/namespaces/dp-ns/pods/greeter-abc",
+		"/api/v1/namespaces//pods/greeter-abc/portforward",
+		"/api/v1/secrets/dp-ns/pods/greeter-abc/portforward",
+	} {
+		_, _, err := parsePortForwardPath(path)
+		assert.Error(t, err, path)
+	}
+}
+
+func TestHandlePortForwardStreamInit_InvalidPort(t *testing.T) {
+	mock := &mockConnection{}
+	agent := newPortForwardTestAgent(t, mock)
+
+	agent.handlePortForwardStreamInit(portForwardInit("ports=0"))
+
+	chunks := decodeChunks(t, mock.getWrittenMessages())
+	require.Len(t, chunks, 1)
+	assert.True(t, chunks[0].IsClose)
+	assert.Contains(t, string(chunks[0].Data), `invalid port "0"`)
+}
+
+func TestHandlePortForwardStreamInit_DialError(t *testing.T) {
+	stubOpenPortForward(t, nil, errors.New("pods \"greeter-abc\" not found"))
+	mock := &mockConnection{}
+	agent := newPortForwardTestAgent(t, mock)
+
+	agent.handlePortForwardStreamInit(portForwardInit("ports=8080"))
+
+	chunks := decodeChunks(t, mock.getWrittenMessages())
+	require.Len(t, chunks, 1)
+	assert.True(t, chunks[0].IsClose)
+	assert.Contains(t, string(chunks[0].Data), "not found")
+	assert.Empty(t, agent.portForwardStreams)
+}
+
+func TestHandlePortForwardStreamInit_RelaysBytes(t *testing.T) {
+	agentEnd, podEnd := net.Pipe()
+	stubOpenPortForward(t, &pipePortForwardConn{Conn: agentEnd}, nil)
+	mock := &mockConnection{}
+	agent := newPortForwardTestAgent(t, mock)
+
+	done := make(chan struct{})
+	go func() {
+		agent.handlePortForwardStreamInit(portForwardInit("ports=8080"))
+		close(done)
+	}()
+
+	// Wait for the session, then play the gateway side.
+	require.Eventually(t, func() bool {
+		agent.portForwardStreamsMu.Lock()
+		defer agent.portForwardStreamsMu.Unlock()
+		return agent.portForwardStreams["pf-req-1"] != nil
+	}, time.Second, 10*time.Millisecond)
+	assert.True(t, agent.routePortForwardChunk(&messaging.HTTPTunnelStreamChunk{RequestID: "pf-req-1", Data: []byte("ping")}))
+
+	// The fake pod answers and closes the connection.
+	buf := make([]byte, 4)
+	_, err := io.ReadFull(podEnd, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "ping", string(buf))
+	_, err = podEnd.Write([]byte("pong"))
+	require.NoError(t, err)
+	require.NoError(t, podEnd.Close())
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("port-forward did not finish after the pod closed the connection")
+	}
+
+	chunks := decodeChunks(t, mock.getWrittenMessages())
+	require.Len(t, chunks, 3)
+	assert.NotNil(t, chunks[0].Data, "first chunk is the sentinel")
+	assert.Empty(t, chunks[0].Data)
+	assert.Equal(t, "pong", string(chunks[1].Data))
+	assert.True(t, chunks[2].IsClose)
+	assert.Empty(t, chunks[2].Data)
+	assert.Empty(t, agent.portForwardStreams)
+}
+
+func TestRoutePortForwardChunk_UnknownSession(t *testing.T) {
+	agent := newPortForwardTestAgent(t, &mockConnection{})
+	assert.False(t, agent.routePortForwardChunk(&messaging.HTTPTunnelStreamChunk{RequestID: "other", Data: []byte("x")}))
+}
@@ -0,0 +1,200 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clustergateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/openchoreo/openchoreo/internal/cluster-agent/messaging"
+)
+
+// getAgentConnectionForPortForward defers to the server's ConnectionManager.
+var getAgentConnectionForPortForward = func(s *Server, planeIdentifier, crKey string) (wirelogsAgentConn, error) {
+	return s.connMgr.GetForCR(planeIdentifier, crKey)
+}
+
+// handlePortForward handles the port-forward WebSocket endpoint. Each WebSocket
+// carries a single TCP connection to a pod port as raw binary messages.
+// URL: /api/portforward/{planeType}/{planeID}/{crNamespace}/{crName}?podNamespace=...&podName=...&port=...
+func (s *Server) handlePortForward(w http.ResponseWriter, r *http.Request) {
+	requestID := getOrGenerateRequestID(r)
+	logger := s.logger.With("requestId", requestID)
+
+	// Parse URL: /api/portforward/{planeType}/{planeID}/{crNamespace}/{crName}
+	path := strings.TrimPrefix(r.URL.Path, "/api/portforward/")
+	parts := strings.SplitN(path, "/", 4)
+	if len(parts) < 4 {
+		http.Error(w, "invalid port-forward URL: expected /api/portforward/{planeType}/{planeID}/{crNamespace}/{crName}", http.StatusBadRequest)
+		return
+	}
+	planeType := parts[0]
+	planeID := parts[1]
+	crNamespace := parts[2]
+	crName := parts[3]
+
+	query := r.URL.Query()
+	podNamespace := query.Get("podNamespace")
+	podName := query.Get("podName")
+	if podNamespace == "" || podName == "" {
+		http.Error(w, "podNamespace and podName query parameters are required", http.StatusBadRequest)
+		return
+	}
+	port, err := strconv.Atoi(query.Get("port"))
+	if err != nil || port < 1 || port > 65535 {
+		http.Error(w, "port query parameter must be between 1 and 65535", http.StatusBadRequest)
+		return
+	}
+
+	planeIdentifier := fmt.Sprintf("%s/%s", planeType, planeID)
+	if crNamespace == crNamespaceClusterPlaceholder {
+		crNamespace = ""
+	}
+	crKey := fmt.Sprintf("%s/%s", crNamespace, crName)
+
+	logger.Info("Port-forward request received",
+		"plane", planeIdentifier,
+		"cr", crKey,
+		"podNamespace", podNamespace,
+		"podName", podName,
+		"port", port,
+	)
+
+	conn, err := getAgentConnectionForPortForward(s, planeIdentifier, crKey)
+	if err != nil {
+		logger.Warn("No agent available for port-forward", "error", err)
+		http.Error(w, fmt.Sprintf("no agent available: %v", err), http.StatusServiceUnavailable)
+		return
+	}
+
+	session := &streamSession{
+		requestID: requestID,
+		fromAgent: make(chan *messaging.HTTPTunnelStreamChunk, 256),
+		done:      make(chan struct{}),
+	}
+
+	s.registerStreamSession(requestID, session)
+	defer s.unregisterStreamSession(requestID)
+
+	streamInit := &messaging.HTTPTunnelStreamInit{
+		RequestID:    requestID,
+		Target:       "portforward",
+		Method:       "POST",
+		Path:         fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/portforward", podNamespace, podName),
+		Query:        url.Values{"ports": {strconv.Itoa(port)}}.Encode(),
+		IsUpgrade:    true,
+		UpgradeProto: "SPDY/3.1",
+	}
+
+	initData, err := json.Marshal(streamInit)
+	if err != nil {
+		logger.Error("Failed to marshal stream init", "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	if err := conn.SendRawMessage(initData); err != nil {
+		logger.Error("Failed to send stream init to agent", "error", err)
+		http.Error(w, fmt.Sprintf("failed to start port-forward: %v", err), http.StatusBadGateway)
+		return
+	}
+
+	// Wait for the agent's sentinel before upgrading, so a pod that cannot be
+	// reached is reported to the caller as a plain HTTP error.
+	select {
+	case chunk := <-session.fromAgent:
+		if chunk == nil {
+			http.Error(w, "stream closed before start", http.StatusBadGateway)
+			return
+		}
+		if chunk.IsClose {
+			logger.Warn("Agent rejected port-forward", "data", string(chunk.Data))
+			http.Error(w, fmt.Sprintf("port-forward failed: %s", string(chunk.Data)), http.StatusBadGateway)
+			return
+		}
+	case <-time.After(30 * time.Second):
+		logger.Error("Timeout waiting for agent to start port-forward")
+		http.Error(w, "timeout waiting for agent", http.StatusGatewayTimeout)
+		return
+	case <-r.Context().Done():
+		return
+	case <-session.done:
+		http.Error(w, "stream closed before start", http.StatusBadGateway)
+		return
+	}
+
+	apiConn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		logger.Error("Failed to upgrade port-forward to WebSocket", "error", err)
+		closeChunk, _ := json.Marshal(&messaging.HTTPTunnelStreamChunk{RequestID: requestID, IsClose: true})
+		_ = conn.SendRawMessage(closeChunk)
+		return
+	}
+	defer apiConn.Close()
+
+	logger.Info("Port-forward stream established")
+
+	// API server → agent
+	go func() {
+		defer session.close()
+		for {
+			_, msg, err := apiConn.ReadMessage()
+			if err != nil {
+				// Client disconnected — notify the agent so it closes the pod connection.
+				closeChunk, _ := json.Marshal(&messaging.HTTPTunnelStreamChunk{
+					RequestID: requestID,
+					IsClose:   true,
+				})
+				_ = conn.SendRawMessage(closeChunk)
+				return
+			}
+			if len(msg) == 0 {
+				continue
+			}
+			chunkData, err := json.Marshal(&messaging.HTTPTunnelStreamChunk{
+				RequestID: requestID,
+				Data:      msg,
+			})
+			if err != nil {
+				return
+			}
+			if err := conn.SendRawMessage(chunkData); err != nil {
+				return
+			}
+		}
+	}()
+
+	// Agent → API server
+	for {
+		select {
+		case chunk, ok := <-session.fromAgent:
+			if !ok || chunk == nil {
+				return
+			}
+			if chunk.IsClose {
+				code := websocket.CloseNormalClosure
+				if len(chunk.Data) > 0 {
+					code = websocket.CloseInternalServerErr
+				}
+				_ = apiConn.WriteMessage(websocket.CloseMessage,
+					websocket.FormatCloseMessage(code, string(chunk.Data)))
+				return
+			}
+			if len(chunk.Data) > 0 {
+				if err := apiConn.WriteMessage(websocket.BinaryMessage, chunk.Data); err != nil {
+					return
+				}
+			}
+		case <-session.done:
+			return
+		}
+	}
+}
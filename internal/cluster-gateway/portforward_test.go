@@ -0,0 +1,156 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clustergateway
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/cluster-agent/messaging"
+)
+
+// stubGetAgentConnectionForPortForward replaces the package-level getter and
+// restores it on test cleanup.
+func stubGetAgentConnectionForPortForward(t *testing.T, conn wirelogsAgentConn, err error) {
+	t.Helper()
+	prev := getAgentConnectionForPortForward
+	getAgentConnectionForPortForward = func(_ *Server, _, _ string) (wirelogsAgentConn, error) {
+		if err != nil {
+			return nil, err
+		}
+		return conn, nil
+	}
+	t.Cleanup(func() { getAgentConnectionForPortForward = prev })
+}
+
+const portForwardTestQuery = "podNamespace=dp-ns&podName=greeter-abc&port=8080"
+
+func newPortForwardRequest(target, query string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, target+"?"+query, nil)
+	req.Header.Set("X-Request-ID", "test-pf-req")
+	return req
+}
+
+func TestHandlePortForward_InvalidURL(t *testing.T) {
+	s := newWirelogsTestServer()
+	rec := httptest.NewRecorder()
+
+	s.handlePortForward(rec, newPortForwardRequest("/api/portforward/dataplane/p1", portForwardTestQuery))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "invalid port-forward URL")
+}
+
+func TestHandlePortForward_InvalidQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		wantMsg string
+	}{
+		{"missing pod", "podNamespace=dp-ns&port=8080", "podNamespace and podName"},
+		{"missing port", "podNamespace=dp-ns&podName=greeter-abc", "port query parameter"},
+		{"port out of range", "podNamespace=dp-ns&podName=greeter-abc&port=70000", "port query parameter"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newWirelogsTestServer()
+			rec := httptest.NewRecorder()
+
+			s.handlePortForward(rec, newPortForwardRequest("/api/portforward/dataplane/p1/ns1/cr1", tt.query))
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.wantMsg)
+		})
+	}
+}
+
+func TestHandlePortForward_NoAgentAvailable(t *testing.T) {
+	stubGetAgentConnectionForPortForward(t, nil, errors.New("no connections registered"))
+	s := newWirelogsTestServer()
+	rec := httptest.NewRecorder()
+
+	s.handlePortForward(rec, newPortForwardRequest("/api/portforward/dataplane/p1/ns1/cr1", portForwardTestQuery))
+
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Contains(t, rec.Body.String(), "no connections registered")
+}
+
+func TestHandlePortForward_AgentRejects(t *testing.T) {
+	s := newWirelogsTestServer()
+	fake := &fakeAgentConn{
+		onInit: func(_ []byte) {
+			s.handleStreamChunk(&messaging.HTTPTunnelStreamChunk{
+				RequestID: "test-pf-req",
+				Data:      []byte(`pods "greeter-abc" not found`),
+				IsClose:   true,
+			})
+		},
+	}
+	stubGetAgentConnectionForPortForward(t, fake, nil)
+	rec := httptest.NewRecorder()
+
+	s.handlePortForward(rec, newPortForwardRequest("/api/portforward/dataplane/p1/_cluster/cr1", portForwardTestQuery))
+
+	assert.Equal(t, http.StatusBadGateway, rec.Code)
+	assert.Contains(t, rec.Body.String(), "not found")
+	assert.Empty(t, s.pendingStreamSessions, "session must be unregistered on failure")
+
+	var init messaging.HTTPTunnelStreamInit
+	require.NoError(t, json.Unmarshal(fake.sentMessages()[0], &init))
+	assert.Equal(t, "portforward", init.Target)
+	assert.Equal(t, "/api/v1/namespaces/dp-ns/pods/greeter-abc/portforward", init.Path)
+	assert.Equal(t, "ports=8080", init.Query)
+}
+
+func TestHandlePortForward_RelaysBytes(t *testing.T) {
+	s := newWirelogsTestServer()
+	fake := &fakeAgentConn{
+		onInit: func(_ []byte) {
+			go func() {
+				s.handleStreamChunk(&messaging.HTTPTunnelStreamChunk{RequestID: "test-pf-req", Data: []byte{}})
+				s.handleStreamChunk(&messaging.HTTPTunnelStreamChunk{RequestID: "test-pf-req", Data: []byte("pong")})
+			}()
+		},
+	}
+	stubGetAgentConnectionForPortForward(t, fake, nil)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Header.Set("X-Request-ID", "test-pf-req")
+		s.handlePortForward(w, r)
+	}))
+	defer srv.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(srv.URL, "http") + "/api/portforward/dataplane/p1/ns1/cr1?" + portForwardTestQuery
+	ws, resp, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	defer ws.Close()
+
+	require.NoError(t, ws.SetReadDeadline(time.Now().Add(3*time.Second)))
+	_, msg, err := ws.ReadMessage()
+	require.NoError(t, err)
+	assert.Equal(t, "pong", string(msg))
+
+	require.NoError(t, ws.WriteMessage(websocket.BinaryMessage, []byte("ping")))
+	require.Eventually(t, func() bool { return len(fake.sentMessages()) >= 2 }, 3*time.Second, 10*time.Millisecond)
+
+	var chunk messaging.HTTPTunnelStreamChunk
+	require.NoError(t, json.Unmarshal(fake.sentMessages()[1], &chunk))
+	assert.Equal(t, "test-pf-req", chunk.RequestID)
+	assert.Equal(t, "ping", string(chunk.Data))
+
+	// The agent closing the pod connection closes the WebSocket.
+	s.handleStreamChunk(&messaging.HTTPTunnelStreamChunk{RequestID: "test-pf-req", IsClose: true})
+	_, _, err = ws.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure), "unexpected error: %v", err)
+}
@@ -122,9 +122,10 @@ func (s *Server) Start() error {
 
 	// Internal listener: caller-facing /api/* for in-cluster components only.
 	internalMux := http.NewServeMux()
-	internalMux.HandleFunc("/api/proxy/", s.handleHTTPProxy)   // HTTP proxy to data plane services
-	internalMux.HandleFunc("/api/exec/", s.handleExec)         // WebSocket exec proxy to data plane pods
-	internalMux.HandleFunc("/api/wirelogs/", s.handleWirelogs) // WebSocket wirelogs (Cilium Hubble flow) stream
+	internalMux.HandleFunc("/api/proxy/", s.handleHTTPProxy)         // HTTP proxy to data plane services
+	internalMux.HandleFunc("/api/exec/", s.handleExec)               // WebSocket exec proxy to data plane pods
+	internalMux.HandleFunc("/api/portforward/", s.handlePortForward) // WebSocket port-forward to data plane pods
+	internalMux.HandleFunc("/api/wirelogs/", s.handleWirelogs)       // WebSocket wirelogs (Cilium Hubble flow) stream
 
 	// Register plane lifecycle API (for controller notifications and status queries)
 	planeAPI := NewPlaneAPI(s.connMgr, s, s.logger)
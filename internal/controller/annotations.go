@@ -7,7 +7,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -18,13 +20,44 @@ const (
 	AnnotationKeyDescription = "openchoreo.dev/description"
 
 	// AnnotationKeyRestartedAt is set on a ReleaseBinding to trigger a rolling
-	// restart of every Deployment deployed by the binding. The ReleaseBinding
-	// controller propagates the annotation to the dataplane RenderedRelease,
-	// and the rendered release controller sets the same annotation on each
-	// Deployment's pod template at apply time. The value is opaque; any change
+	// restart of every Deployment and StatefulSet deployed by the binding. The
+	// ReleaseBinding controller propagates the annotation to the dataplane
+	// RenderedRelease, and the rendered release controller sets the same
+	// annotation on each workload's pod template at apply time. The value is opaque; any change
 	// triggers a restart.
 	AnnotationKeyRestartedAt = "openchoreo.dev/restartedAt"
 
+	// AnnotationKeyReleaseTrigger is set on a ReleaseBinding by whatever changes its
+	// release, in the form "<source>:<releaseName>". The ReleaseBinding controller
+	// records the source in the release history only when the release name matches the
+	// bound release, so a stale annotation is never attributed to a later manual edit.
+	AnnotationKeyReleaseTrigger = "openchoreo.dev/release-trigger"
+
+	// AnnotationKeyReleaseTriggeredBy identifies who or what triggered the release named
+	// in AnnotationKeyReleaseTrigger (a user, a promotion request or a component).
+	AnnotationKeyReleaseTriggeredBy = "openchoreo.dev/release-triggered-by"
+
+	// Release trigger sources recorded in AnnotationKeyReleaseTrigger and the release history.
+	ReleaseTriggerDeploy     = "deploy"
+	ReleaseTriggerPromotion  = "promotion"
+	ReleaseTriggerAutoDeploy = "auto-deploy"
+	ReleaseTriggerAPI        = "api"
+	ReleaseTriggerRollback   = "rollback"
+	// ReleaseTriggerManual is recorded when the bound release changed without a matching
+	// trigger annotation, e.g. by editing the ReleaseBinding directly.
+	ReleaseTriggerManual = "manual"
+
+	// AnnotationKeyRolloutAction is set on a ReleaseBinding to pause, resume, promote or abort
+	// its in-progress Canary or BlueGreen rollout, in the form "<action>@<requestedAt>". The
+	// ReleaseBinding controller handles each value once and records it in the rollout status.
+	AnnotationKeyRolloutAction = "openchoreo.dev/rollout-action"
+
+	// Rollout actions requested through AnnotationKeyRolloutAction.
+	RolloutActionPause   = "pause"
+	RolloutActionResume  = "resume"
+	RolloutActionPromote = "promote"
+	RolloutActionAbort   = "abort"
+
 	// SchemaExtensionComponentParameterRepositoryPrefix is the common prefix for all openAPIV3Schema
 	// x- extension keys that mark component repository parameter fields (set to true on the property).
 	// The suffix after the prefix is used as the role key in the map returned by ExtractComponentRepositoryPaths
@@ -37,6 +70,53 @@ const (
 	SchemaExtensionComponentParameterRepositorySecretRef = SchemaExtensionComponentParameterRepositoryPrefix + "secret-ref"
 )
 
+// SetReleaseTrigger annotates obj with the source and actor that bound releaseName.
+func SetReleaseTrigger(obj metav1.Object, source, releaseName, triggeredBy string) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[AnnotationKeyReleaseTrigger] = source + ":" + releaseName
+	if triggeredBy != "" {
+		annotations[AnnotationKeyReleaseTriggeredBy] = triggeredBy
+	} else {
+		delete(annotations, AnnotationKeyReleaseTriggeredBy)
+	}
+	obj.SetAnnotations(annotations)
+}
+
+// GetReleaseTrigger returns the source and actor recorded by SetReleaseTrigger when they
+// refer to releaseName. ok is false if the annotation is missing or names another release.
+func GetReleaseTrigger(obj metav1.Object, releaseName string) (source, triggeredBy string, ok bool) {
+	annotations := obj.GetAnnotations()
+	source, release, found := strings.Cut(annotations[AnnotationKeyReleaseTrigger], ":")
+	if !found || source == "" || release != releaseName {
+		return "", "", false
+	}
+	return source, annotations[AnnotationKeyReleaseTriggeredBy], true
+}
+
+// SetRolloutAction annotates obj with a request to apply action to its rollout.
+func SetRolloutAction(obj metav1.Object, action string, requestedAt time.Time) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[AnnotationKeyRolloutAction] = action + "@" + requestedAt.UTC().Format(time.RFC3339Nano)
+	obj.SetAnnotations(annotations)
+}
+
+// GetRolloutAction returns the rollout action requested by SetRolloutAction and the full
+// annotation value identifying the request. ok is false if no action is requested.
+func GetRolloutAction(obj metav1.Object) (action, request string, ok bool) {
+	request = obj.GetAnnotations()[AnnotationKeyRolloutAction]
+	action, _, _ = strings.Cut(request, "@")
+	if action == "" {
+		return "", "", false
+	}
+	return action, request, true
+}
+
 // ExtractComponentRepositoryPaths scans an openAPIV3Schema RawExtension for boolean
 // x-openchoreo-component-parameter-repository-* extension keys
 // (e.g. "x-openchoreo-component-parameter-repository-url",
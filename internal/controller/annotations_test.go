@@ -0,0 +1,33 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestReleaseTrigger(t *testing.T) {
+	obj := &metav1.ObjectMeta{}
+
+	if _, _, ok := GetReleaseTrigger(obj, "r1"); ok {
+		t.Fatal("expected no trigger on an unannotated object")
+	}
+
+	SetReleaseTrigger(obj, "deploy", "r1", "alice")
+	source, by, ok := GetReleaseTrigger(obj, "r1")
+	if !ok || source != "deploy" || by != "alice" {
+		t.Errorf("got (%q, %q, %v), want (deploy, alice, true)", source, by, ok)
+	}
+	if _, _, ok := GetReleaseTrigger(obj, "r2"); ok {
+		t.Error("expected trigger for another release to be ignored")
+	}
+
+	SetReleaseTrigger(obj, "auto-deploy", "r2", "")
+	source, by, ok = GetReleaseTrigger(obj, "r2")
+	if !ok || source != "auto-deploy" || by != "" {
+		t.Errorf("got (%q, %q, %v), want (auto-deploy, \"\", true)", source, by, ok)
+	}
+}
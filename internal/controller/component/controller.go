@@ -778,6 +778,7 @@ func (r *Reconciler) ensureReleaseBinding(
 				// No overrides for initial auto-deploy
 			},
 		}
+		controller.SetReleaseTrigger(releaseBinding, controller.ReleaseTriggerAutoDeploy, releaseName, comp.Name)
 
 		if err := r.Create(ctx, releaseBinding); err != nil {
 			return fmt.Errorf("failed to create ReleaseBinding: %w", err)
@@ -797,6 +798,7 @@ func (r *Reconciler) ensureReleaseBinding(
 	// ReleaseBinding exists, patch the release name if different
 	if releaseBinding.Spec.ReleaseName != releaseName {
 		releaseBinding.Spec.ReleaseName = releaseName
+		controller.SetReleaseTrigger(&releaseBinding, controller.ReleaseTriggerAutoDeploy, releaseName, comp.Name)
 
 		if err := r.Update(ctx, &releaseBinding); err != nil {
 			return fmt.Errorf("failed to update ReleaseBinding: %w", err)
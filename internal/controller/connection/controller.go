@@ -0,0 +1,185 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package connection
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// Reconciler reconciles a Connection object
+type Reconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=connections,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=connections/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=connections/finalizers,verbs=update
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=releasebindings,verbs=get;list;watch
+
+// Reconcile reports whether the connection is resolved in each environment the consuming
+// component is deployed to. The ReleaseBinding controller resolves the connection and
+// injects it into the component; this controller reads the outcome from the status of the
+// component's ReleaseBindings.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, rErr error) {
+	logger := log.FromContext(ctx)
+
+	conn := &openchoreov1alpha1.Connection{}
+	if err := r.Get(ctx, req.NamespacedName, conn); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			logger.Error(err, "Failed to get Connection")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+	if !conn.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	old := conn.DeepCopy()
+	conn.Status.ObservedGeneration = conn.Generation
+
+	// Deferred status update
+	defer func() {
+		if apiequality.Semantic.DeepEqual(old.Status, conn.Status) {
+			return
+		}
+		if err := r.Status().Update(ctx, conn); err != nil {
+			logger.Error(err, "Failed to update Connection status")
+			rErr = kerrors.NewAggregate([]error{rErr, err})
+		}
+	}()
+
+	bindings, err := r.findReleaseBindings(ctx, conn)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	environments := make([]openchoreov1alpha1.ConnectionEnvironmentStatus, 0, len(bindings))
+	for i := range bindings {
+		environments = append(environments, environmentStatus(conn, &bindings[i]))
+	}
+	conn.Status.Environments = environments
+	setReadyCondition(conn)
+	return ctrl.Result{}, nil
+}
+
+// findReleaseBindings returns the ReleaseBindings of the consuming component in the
+// environments the connection applies to, sorted by environment.
+func (r *Reconciler) findReleaseBindings(ctx context.Context, conn *openchoreov1alpha1.Connection) ([]openchoreov1alpha1.ReleaseBinding, error) {
+	var bindingList openchoreov1alpha1.ReleaseBindingList
+	if err := r.List(ctx, &bindingList, client.InNamespace(conn.Namespace),
+		client.MatchingFields{controller.IndexKeyReleaseBindingOwnerComponentName: conn.Spec.Owner.ComponentName}); err != nil {
+		return nil, fmt.Errorf("failed to list ReleaseBindings: %w", err)
+	}
+
+	var bindings []openchoreov1alpha1.ReleaseBinding
+	for _, rb := range bindingList.Items {
+		if rb.Spec.Owner.ProjectName == conn.Spec.Owner.ProjectName && conn.AppliesTo(rb.Spec.Environment) {
+			bindings = append(bindings, rb)
+		}
+	}
+	sort.Slice(bindings, func(i, j int) bool {
+		return bindings[i].Spec.Environment < bindings[j].Spec.Environment
+	})
+	return bindings, nil
+}
+
+// environmentStatus reads the resolution of the connection from the status of the
+// ReleaseBinding deploying the consumer to one environment.
+func environmentStatus(conn *openchoreov1alpha1.Connection, rb *openchoreov1alpha1.ReleaseBinding) openchoreov1alpha1.ConnectionEnvironmentStatus {
+	status := openchoreov1alpha1.ConnectionEnvironmentStatus{
+		Environment:    rb.Spec.Environment,
+		ReleaseBinding: rb.Name,
+	}
+	if rb.Spec.State == openchoreov1alpha1.ReleaseStateUndeploy {
+		status.Message = "The component is undeployed"
+		return status
+	}
+
+	switch {
+	case conn.Spec.Endpoint != nil:
+		endpoint := conn.Spec.Endpoint
+		project := endpoint.Project
+		if project == "" {
+			project = conn.Spec.Owner.ProjectName
+		}
+		for _, rc := range rb.Status.ResolvedConnections {
+			if rc.Project == project && rc.Component == endpoint.Component &&
+				rc.Endpoint == endpoint.Name && string(rc.Visibility) == endpoint.Visibility {
+				url := rc.URL
+				status.Resolved = true
+				status.URL = &url
+				return status
+			}
+		}
+		for _, pc := range rb.Status.PendingConnections {
+			if pc.Project == project && pc.Component == endpoint.Component && pc.Endpoint == endpoint.Name {
+				status.Message = pc.Reason
+				return status
+			}
+		}
+	case conn.Spec.Resource != nil:
+		ref := conn.Spec.Resource.Ref
+		for _, pd := range rb.Status.PendingResourceDependencies {
+			if pd.ResourceName == ref {
+				status.Message = pd.Reason
+				return status
+			}
+		}
+		for _, target := range rb.Status.ResourceDependencyTargets {
+			if target.ResourceName == ref {
+				status.Resolved = true
+				return status
+			}
+		}
+	}
+	status.Message = "Waiting for the ReleaseBinding to resolve the connection"
+	return status
+}
+
+// setReadyCondition aggregates the per-environment resolution into Ready.
+func setReadyCondition(conn *openchoreov1alpha1.Connection) {
+	if len(conn.Status.Environments) == 0 {
+		controller.MarkFalseCondition(conn, ConditionReady, ReasonComponentNotDeployed,
+			fmt.Sprintf("Component %q is not deployed to an environment the connection applies to", conn.Spec.Owner.ComponentName))
+		return
+	}
+	for _, env := range conn.Status.Environments {
+		if !env.Resolved {
+			controller.MarkFalseCondition(conn, ConditionReady, ReasonPending,
+				fmt.Sprintf("Environment %q: %s", env.Environment, env.Message))
+			return
+		}
+	}
+	controller.MarkTrueCondition(conn, ConditionReady, ReasonResolved,
+		fmt.Sprintf("Connection resolved in %d environment(s)", len(conn.Status.Environments)))
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&openchoreov1alpha1.Connection{}).
+		Watches(
+			&openchoreov1alpha1.ReleaseBinding{},
+			handler.EnqueueRequestsFromMapFunc(r.findConnectionsForReleaseBinding),
+			builder.WithPredicates(connectionStatusChangedPredicate()),
+		).
+		Named("connection").
+		Complete(r)
+}
@@ -0,0 +1,28 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package connection
+
+import (
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// Constants for condition types
+
+const (
+	// ConditionReady indicates that the connection is resolved and injected into the consuming
+	// component in every environment it is deployed to.
+	ConditionReady controller.ConditionType = "Ready"
+)
+
+// Constants for condition reasons
+
+const (
+	// ReasonResolved indicates the connection is resolved in every environment
+	ReasonResolved controller.ConditionReason = "Resolved"
+	// ReasonPending indicates the connection is not resolved in at least one environment
+	ReasonPending controller.ConditionReason = "Pending"
+	// ReasonComponentNotDeployed indicates the consuming component is not deployed to any
+	// environment the connection applies to
+	ReasonComponentNotDeployed controller.ConditionReason = "ComponentNotDeployed"
+)
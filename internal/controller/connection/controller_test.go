@@ -0,0 +1,190 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package connection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+func newEndpointConnection(environments ...string) *openchoreov1alpha1.Connection {
+	return &openchoreov1alpha1.Connection{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-api", Namespace: "ns", Generation: 1},
+		Spec: openchoreov1alpha1.ConnectionSpec{
+			Owner:        openchoreov1alpha1.ConnectionOwner{ProjectName: "shop", ComponentName: "web"},
+			Environments: environments,
+			Endpoint: &openchoreov1alpha1.WorkloadConnection{
+				Component:   "api",
+				Name:        "http",
+				Visibility:  string(openchoreov1alpha1.EndpointVisibilityProject),
+				EnvBindings: openchoreov1alpha1.ConnectionEnvBindings{Address: "API_URL"},
+			},
+		},
+	}
+}
+
+func newResourceConnection() *openchoreov1alpha1.Connection {
+	return &openchoreov1alpha1.Connection{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-db", Namespace: "ns", Generation: 1},
+		Spec: openchoreov1alpha1.ConnectionSpec{
+			Owner:    openchoreov1alpha1.ConnectionOwner{ProjectName: "shop", ComponentName: "web"},
+			Resource: &openchoreov1alpha1.WorkloadResourceDependency{Ref: "orders-db"},
+		},
+	}
+}
+
+func newReleaseBinding(environment string) *openchoreov1alpha1.ReleaseBinding {
+	return &openchoreov1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-" + environment, Namespace: "ns"},
+		Spec: openchoreov1alpha1.ReleaseBindingSpec{
+			Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: "shop", ComponentName: "web"},
+			Environment: environment,
+		},
+	}
+}
+
+func newReconciler(t *testing.T, objs ...client.Object) *Reconciler {
+	t.Helper()
+	s := runtime.NewScheme()
+	require.NoError(t, openchoreov1alpha1.AddToScheme(s))
+	cli := fake.NewClientBuilder().WithScheme(s).
+		WithObjects(objs...).
+		WithStatusSubresource(&openchoreov1alpha1.Connection{}).
+		WithIndex(&openchoreov1alpha1.ReleaseBinding{}, controller.IndexKeyReleaseBindingOwnerComponentName, func(obj client.Object) []string {
+			return []string{obj.(*openchoreov1alpha1.ReleaseBinding).Spec.Owner.ComponentName}
+		}).
+		Build()
+	return &Reconciler{Client: cli, Scheme: s}
+}
+
+func reconcileConnection(t *testing.T, r *Reconciler, name string) *openchoreov1alpha1.Connection {
+	t.Helper()
+	key := types.NamespacedName{Namespace: "ns", Name: name}
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	conn := &openchoreov1alpha1.Connection{}
+	require.NoError(t, r.Get(context.Background(), key, conn))
+	return conn
+}
+
+func TestReconcileEndpointConnection(t *testing.T) {
+	url := openchoreov1alpha1.EndpointURL{Scheme: "http", Host: "api.shop.svc", Port: 8080}
+
+	t.Run("resolved in every environment marks the connection ready", func(t *testing.T) {
+		dev := newReleaseBinding("dev")
+		dev.Status.ResolvedConnections = []openchoreov1alpha1.ResolvedConnection{{
+			Namespace: "ns", Project: "shop", Component: "api", Endpoint: "http",
+			Visibility: openchoreov1alpha1.EndpointVisibilityProject, URL: url,
+		}}
+		r := newReconciler(t, newEndpointConnection(), dev)
+
+		conn := reconcileConnection(t, r, "web-api")
+		require.Len(t, conn.Status.Environments, 1)
+		assert.True(t, conn.Status.Environments[0].Resolved)
+		assert.Equal(t, "web-dev", conn.Status.Environments[0].ReleaseBinding)
+		assert.Equal(t, &url, conn.Status.Environments[0].URL)
+		assert.Equal(t, int64(1), conn.Status.ObservedGeneration)
+		assert.True(t, meta.IsStatusConditionTrue(conn.Status.Conditions, string(ConditionReady)))
+	})
+
+	t.Run("a pending environment keeps the connection not ready", func(t *testing.T) {
+		dev := newReleaseBinding("dev")
+		dev.Status.ResolvedConnections = []openchoreov1alpha1.ResolvedConnection{{
+			Namespace: "ns", Project: "shop", Component: "api", Endpoint: "http",
+			Visibility: openchoreov1alpha1.EndpointVisibilityProject, URL: url,
+		}}
+		prod := newReleaseBinding("prod")
+		prod.Status.PendingConnections = []openchoreov1alpha1.PendingConnection{{
+			Namespace: "ns", Project: "shop", Component: "api", Endpoint: "http",
+			Reason: "target component is not deployed to environment prod",
+		}}
+		r := newReconciler(t, newEndpointConnection(), dev, prod)
+
+		conn := reconcileConnection(t, r, "web-api")
+		require.Len(t, conn.Status.Environments, 2)
+		assert.Equal(t, "prod", conn.Status.Environments[1].Environment)
+		assert.False(t, conn.Status.Environments[1].Resolved)
+		assert.Equal(t, "target component is not deployed to environment prod", conn.Status.Environments[1].Message)
+		cond := meta.FindStatusCondition(conn.Status.Conditions, string(ConditionReady))
+		require.NotNil(t, cond)
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+		assert.Equal(t, string(ReasonPending), cond.Reason)
+	})
+
+	t.Run("environments outside the connection are ignored", func(t *testing.T) {
+		r := newReconciler(t, newEndpointConnection("prod"), newReleaseBinding("dev"))
+
+		conn := reconcileConnection(t, r, "web-api")
+		assert.Empty(t, conn.Status.Environments)
+		cond := meta.FindStatusCondition(conn.Status.Conditions, string(ConditionReady))
+		require.NotNil(t, cond)
+		assert.Equal(t, string(ReasonComponentNotDeployed), cond.Reason)
+	})
+}
+
+func TestReconcileResourceConnection(t *testing.T) {
+	t.Run("resolved when the binding targets the resource", func(t *testing.T) {
+		prod := newReleaseBinding("prod")
+		prod.Status.ResourceDependencyTargets = []openchoreov1alpha1.ResourceDependencyTarget{{
+			Namespace: "ns", Project: "shop", ResourceName: "orders-db", Environment: "prod",
+		}}
+		r := newReconciler(t, newResourceConnection(), prod)
+
+		conn := reconcileConnection(t, r, "web-db")
+		require.Len(t, conn.Status.Environments, 1)
+		assert.True(t, conn.Status.Environments[0].Resolved)
+		assert.Nil(t, conn.Status.Environments[0].URL)
+		assert.True(t, meta.IsStatusConditionTrue(conn.Status.Conditions, string(ConditionReady)))
+	})
+
+	t.Run("pending while the resource is not ready", func(t *testing.T) {
+		prod := newReleaseBinding("prod")
+		prod.Status.PendingResourceDependencies = []openchoreov1alpha1.PendingResourceDependency{{
+			Namespace: "ns", Project: "shop", ResourceName: "orders-db", Reason: "resource is not ready",
+		}}
+		r := newReconciler(t, newResourceConnection(), prod)
+
+		conn := reconcileConnection(t, r, "web-db")
+		require.Len(t, conn.Status.Environments, 1)
+		assert.False(t, conn.Status.Environments[0].Resolved)
+		assert.Equal(t, "resource is not ready", conn.Status.Environments[0].Message)
+	})
+
+	t.Run("undeployed bindings are reported", func(t *testing.T) {
+		prod := newReleaseBinding("prod")
+		prod.Spec.State = openchoreov1alpha1.ReleaseStateUndeploy
+		r := newReconciler(t, newResourceConnection(), prod)
+
+		conn := reconcileConnection(t, r, "web-db")
+		require.Len(t, conn.Status.Environments, 1)
+		assert.Equal(t, "The component is undeployed", conn.Status.Environments[0].Message)
+	})
+}
+
+func TestFindConnectionsForReleaseBinding(t *testing.T) {
+	other := newResourceConnection()
+	other.Name = "other-db"
+	other.Spec.Owner.ComponentName = "worker"
+	r := newReconciler(t, newEndpointConnection("prod"), newResourceConnection(), other)
+
+	requests := r.findConnectionsForReleaseBinding(context.Background(), newReleaseBinding("dev"))
+	require.Len(t, requests, 1)
+	assert.Equal(t, "web-db", requests[0].Name)
+
+	requests = r.findConnectionsForReleaseBinding(context.Background(), newReleaseBinding("prod"))
+	assert.Len(t, requests, 2)
+}
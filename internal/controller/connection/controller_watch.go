@@ -0,0 +1,78 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package connection
+
+import (
+	"context"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// findConnectionsForReleaseBinding maps a ReleaseBinding to the Connections of its component
+// that apply to its environment, so their status follows the resolution in the binding.
+func (r *Reconciler) findConnectionsForReleaseBinding(ctx context.Context, obj client.Object) []reconcile.Request {
+	releaseBinding, ok := obj.(*openchoreov1alpha1.ReleaseBinding)
+	if !ok {
+		return nil
+	}
+
+	var connections openchoreov1alpha1.ConnectionList
+	if err := r.List(ctx, &connections, client.InNamespace(releaseBinding.Namespace)); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list Connections for ReleaseBinding",
+			"releaseBinding", releaseBinding.Name)
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(connections.Items))
+	for _, conn := range connections.Items {
+		if conn.Spec.Owner.ProjectName != releaseBinding.Spec.Owner.ProjectName ||
+			conn.Spec.Owner.ComponentName != releaseBinding.Spec.Owner.ComponentName ||
+			!conn.AppliesTo(releaseBinding.Spec.Environment) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: conn.Namespace, Name: conn.Name},
+		})
+	}
+	return requests
+}
+
+// connectionStatusChangedPredicate passes ReleaseBinding events that may change the
+// resolution of the connections of its component.
+func connectionStatusChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool {
+			return true
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldRB, ok := e.ObjectOld.(*openchoreov1alpha1.ReleaseBinding)
+			if !ok {
+				return false
+			}
+			newRB, ok := e.ObjectNew.(*openchoreov1alpha1.ReleaseBinding)
+			if !ok {
+				return false
+			}
+			return oldRB.Spec.State != newRB.Spec.State ||
+				!apiequality.Semantic.DeepEqual(oldRB.Status.ResolvedConnections, newRB.Status.ResolvedConnections) ||
+				!apiequality.Semantic.DeepEqual(oldRB.Status.PendingConnections, newRB.Status.PendingConnections) ||
+				!apiequality.Semantic.DeepEqual(oldRB.Status.ResourceDependencyTargets, newRB.Status.ResourceDependencyTargets) ||
+				!apiequality.Semantic.DeepEqual(oldRB.Status.PendingResourceDependencies, newRB.Status.PendingResourceDependencies)
+		},
+		DeleteFunc: func(_ event.DeleteEvent) bool {
+			return true
+		},
+		GenericFunc: func(_ event.GenericEvent) bool {
+			return false
+		},
+	}
+}
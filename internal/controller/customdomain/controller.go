@@ -0,0 +1,347 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customdomain
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// verificationRequeueInterval is how often an unverified domain re-checks its TXT record.
+const verificationRequeueInterval = time.Minute
+
+// Resolver looks up DNS TXT records. *net.Resolver satisfies it.
+type Resolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// Reconciler reconciles a CustomDomain object
+type Reconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// Resolver verifies domain ownership. Defaults to net.DefaultResolver.
+	Resolver Resolver
+}
+
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=customdomains,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=customdomains/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=customdomains/finalizers,verbs=update
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=releasebindings,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=environments,verbs=get;list;watch
+
+// Reconcile verifies ownership of the domain, publishes the DNS records the owner has to
+// create and reports whether the endpoint's route and certificate serve the domain. The
+// ReleaseBinding controller adds verified domains to the endpoint's external route.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, rErr error) {
+	logger := log.FromContext(ctx)
+
+	customDomain := &openchoreov1alpha1.CustomDomain{}
+	if err := r.Get(ctx, req.NamespacedName, customDomain); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			logger.Error(err, "Failed to get CustomDomain")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+	if !customDomain.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	old := customDomain.DeepCopy()
+	customDomain.Status.ObservedGeneration = customDomain.Generation
+
+	// Deferred status update
+	defer func() {
+		setReadyCondition(customDomain)
+
+		if apiequality.Semantic.DeepEqual(old.Status, customDomain.Status) {
+			return
+		}
+		if err := r.Status().Update(ctx, customDomain); err != nil {
+			logger.Error(err, "Failed to update CustomDomain status")
+			rErr = kerrors.NewAggregate([]error{rErr, err})
+		}
+	}()
+
+	environment := &openchoreov1alpha1.Environment{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: customDomain.Namespace, Name: customDomain.Spec.Environment}, environment); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("failed to get Environment %q: %w", customDomain.Spec.Environment, err)
+		}
+		environment = nil
+	}
+
+	releaseBinding, err := r.findReleaseBinding(ctx, customDomain)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	endpoint := findEndpointStatus(releaseBinding, customDomain.Spec.Endpoint)
+	customDomain.Status.DNSRecords = dnsRecords(customDomain, endpoint)
+
+	verified, err := r.reconcileVerification(ctx, customDomain)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	setRoutedCondition(customDomain, environment, endpoint, verified)
+	setCertificateCondition(customDomain, environment, endpoint, verified)
+
+	if !verified {
+		return ctrl.Result{RequeueAfter: verificationRequeueInterval}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// reconcileVerification sets the DomainVerified condition and reports whether the domain may
+// be served. Verification sticks once the TXT record was found, so removing the record later
+// does not take the domain offline. A domain already verified by an older CustomDomain stays
+// unverified for every other claim.
+func (r *Reconciler) reconcileVerification(ctx context.Context, customDomain *openchoreov1alpha1.CustomDomain) (bool, error) {
+	if customDomain.Status.VerifiedAt == nil {
+		found, msg := r.lookupVerificationRecord(ctx, customDomain)
+		if !found {
+			controller.MarkFalseCondition(customDomain, ConditionDomainVerified, ReasonVerificationPending, msg)
+			return false, nil
+		}
+	}
+
+	holder, err := r.findDomainHolder(ctx, customDomain)
+	if err != nil {
+		return false, err
+	}
+	if holder != nil {
+		controller.MarkFalseCondition(customDomain, ConditionDomainVerified, ReasonDomainInUse,
+			fmt.Sprintf("Domain %q is already served by CustomDomain %s/%s", customDomain.Spec.Domain, holder.Namespace, holder.Name))
+		return false, nil
+	}
+
+	if customDomain.Status.VerifiedAt == nil {
+		now := metav1.Now()
+		customDomain.Status.VerifiedAt = &now
+	}
+	controller.MarkTrueCondition(customDomain, ConditionDomainVerified, ReasonDomainVerified,
+		fmt.Sprintf("Ownership of %q verified", customDomain.Spec.Domain))
+	return true, nil
+}
+
+// lookupVerificationRecord reports whether the verification TXT record of the domain carries
+// the expected token, with a message explaining what is missing when it does not.
+func (r *Reconciler) lookupVerificationRecord(ctx context.Context, customDomain *openchoreov1alpha1.CustomDomain) (bool, string) {
+	name := verificationRecordName(customDomain.Spec.Domain)
+	expected := verificationRecordValue(customDomain)
+
+	values, err := r.Resolver.LookupTXT(ctx, name)
+	if err != nil {
+		return false, fmt.Sprintf("TXT record %s not found: %v", name, err)
+	}
+	for _, value := range values {
+		if value == expected {
+			return true, ""
+		}
+	}
+	return false, fmt.Sprintf("TXT record %s does not contain %q", name, expected)
+}
+
+// findDomainHolder returns the CustomDomain that holds an earlier verified claim on the same
+// domain, or nil when this CustomDomain may serve it. Claims are ordered by verification time,
+// then by namespace and name.
+func (r *Reconciler) findDomainHolder(ctx context.Context, customDomain *openchoreov1alpha1.CustomDomain) (*openchoreov1alpha1.CustomDomain, error) {
+	var claims openchoreov1alpha1.CustomDomainList
+	if err := r.List(ctx, &claims, client.MatchingFields{controller.IndexKeyCustomDomainDomain: customDomain.Spec.Domain}); err != nil {
+		return nil, fmt.Errorf("failed to list CustomDomains for domain %q: %w", customDomain.Spec.Domain, err)
+	}
+
+	var holder *openchoreov1alpha1.CustomDomain
+	for i := range claims.Items {
+		claim := &claims.Items[i]
+		if claim.UID == customDomain.UID || !claim.DeletionTimestamp.IsZero() || claim.Status.VerifiedAt == nil {
+			continue
+		}
+		if !meta.IsStatusConditionTrue(claim.Status.Conditions, string(ConditionDomainVerified)) {
+			continue
+		}
+		if !claimPrecedes(claim, customDomain) {
+			continue
+		}
+		if holder == nil || claimPrecedes(claim, holder) {
+			holder = claim
+		}
+	}
+	return holder, nil
+}
+
+// claimPrecedes reports whether claim a takes precedence over claim b. An unverified claim
+// never precedes a verified one.
+func claimPrecedes(a, b *openchoreov1alpha1.CustomDomain) bool {
+	switch {
+	case b.Status.VerifiedAt == nil:
+		return true
+	case !a.Status.VerifiedAt.Equal(b.Status.VerifiedAt):
+		return a.Status.VerifiedAt.Before(b.Status.VerifiedAt)
+	case a.Namespace != b.Namespace:
+		return a.Namespace < b.Namespace
+	default:
+		return a.Name < b.Name
+	}
+}
+
+// findReleaseBinding returns the ReleaseBinding deploying the owning component to the
+// environment, or nil when the component is not deployed there.
+func (r *Reconciler) findReleaseBinding(ctx context.Context, customDomain *openchoreov1alpha1.CustomDomain) (*openchoreov1alpha1.ReleaseBinding, error) {
+	var bindings openchoreov1alpha1.ReleaseBindingList
+	key := controller.MakeReleaseBindingOwnerEnvKey(customDomain.Spec.Owner.ProjectName,
+		customDomain.Spec.Owner.ComponentName, customDomain.Spec.Environment)
+	if err := r.List(ctx, &bindings, client.InNamespace(customDomain.Namespace),
+		client.MatchingFields{controller.IndexKeyReleaseBindingOwnerEnv: key}); err != nil {
+		return nil, fmt.Errorf("failed to list ReleaseBindings: %w", err)
+	}
+	if len(bindings.Items) == 0 {
+		return nil, nil
+	}
+	return &bindings.Items[0], nil
+}
+
+// findEndpointStatus returns the status of the named endpoint in the ReleaseBinding, or nil.
+func findEndpointStatus(releaseBinding *openchoreov1alpha1.ReleaseBinding, name string) *openchoreov1alpha1.EndpointURLStatus {
+	if releaseBinding == nil {
+		return nil
+	}
+	for i := range releaseBinding.Status.Endpoints {
+		if releaseBinding.Status.Endpoints[i].Name == name {
+			return &releaseBinding.Status.Endpoints[i]
+		}
+	}
+	return nil
+}
+
+// setRoutedCondition sets the Routed condition from the endpoint status the ReleaseBinding
+// controller reports.
+func setRoutedCondition(
+	customDomain *openchoreov1alpha1.CustomDomain,
+	environment *openchoreov1alpha1.Environment,
+	endpoint *openchoreov1alpha1.EndpointURLStatus,
+	verified bool,
+) {
+	switch {
+	case !verified:
+		controller.MarkFalseCondition(customDomain, ConditionRouted, ReasonAwaitingVerification,
+			"The domain is routed once its ownership is verified")
+	case environment == nil:
+		controller.MarkFalseCondition(customDomain, ConditionRouted, ReasonEnvironmentNotFound,
+			fmt.Sprintf("Environment %q not found", customDomain.Spec.Environment))
+	case endpoint == nil:
+		controller.MarkFalseCondition(customDomain, ConditionRouted, ReasonEndpointNotDeployed,
+			fmt.Sprintf("Endpoint %q of component %q is not deployed to environment %q",
+				customDomain.Spec.Endpoint, customDomain.Spec.Owner.ComponentName, customDomain.Spec.Environment))
+	case endpoint.ExternalURLs == nil:
+		controller.MarkFalseCondition(customDomain, ConditionRouted, ReasonEndpointNotExternal,
+			fmt.Sprintf("Endpoint %q has no external route in environment %q", customDomain.Spec.Endpoint, customDomain.Spec.Environment))
+	case !containsString(endpoint.CustomDomains, customDomain.Spec.Domain):
+		controller.MarkFalseCondition(customDomain, ConditionRouted, ReasonRoutePending,
+			"Waiting for the domain to be added to the endpoint's route")
+	default:
+		controller.MarkTrueCondition(customDomain, ConditionRouted, ReasonRouted,
+			fmt.Sprintf("Domain routed to endpoint %q", customDomain.Spec.Endpoint))
+	}
+}
+
+// setCertificateCondition reports the managed certificate covering the domain. The condition
+// and the certificate status are removed when the environment does not configure managed TLS.
+func setCertificateCondition(
+	customDomain *openchoreov1alpha1.CustomDomain,
+	environment *openchoreov1alpha1.Environment,
+	endpoint *openchoreov1alpha1.EndpointURLStatus,
+	verified bool,
+) {
+	customDomain.Status.Certificate = nil
+	if !verified || environment == nil || environment.Spec.TLS == nil {
+		meta.RemoveStatusCondition(&customDomain.Status.Conditions, string(ConditionCertificateReady))
+		return
+	}
+
+	if endpoint != nil {
+		for i := range endpoint.Certificates {
+			if containsString(endpoint.Certificates[i].DNSNames, customDomain.Spec.Domain) {
+				customDomain.Status.Certificate = endpoint.Certificates[i].DeepCopy()
+				break
+			}
+		}
+	}
+
+	switch cert := customDomain.Status.Certificate; {
+	case cert == nil:
+		controller.MarkFalseCondition(customDomain, ConditionCertificateReady, ReasonCertificatePending,
+			"Waiting for a certificate to be requested for the domain")
+	case !cert.Ready:
+		controller.MarkFalseCondition(customDomain, ConditionCertificateReady, ReasonCertificatePending, cert.Message)
+	default:
+		controller.MarkTrueCondition(customDomain, ConditionCertificateReady, ReasonCertificateIssued,
+			fmt.Sprintf("Certificate %q issued", cert.Name))
+	}
+}
+
+// setReadyCondition aggregates the other conditions into Ready.
+func setReadyCondition(customDomain *openchoreov1alpha1.CustomDomain) {
+	for _, ct := range []controller.ConditionType{ConditionDomainVerified, ConditionRouted, ConditionCertificateReady} {
+		cond := meta.FindStatusCondition(customDomain.Status.Conditions, string(ct))
+		if cond == nil || cond.Status == metav1.ConditionTrue {
+			continue
+		}
+		controller.MarkFalseCondition(customDomain, ConditionReady, ReasonNotReady, cond.Message)
+		return
+	}
+	controller.MarkTrueCondition(customDomain, ConditionReady, ReasonReady,
+		fmt.Sprintf("Domain %q serves endpoint %q", customDomain.Spec.Domain, customDomain.Spec.Endpoint))
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Resolver == nil {
+		r.Resolver = net.DefaultResolver
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&openchoreov1alpha1.CustomDomain{}).
+		Watches(
+			&openchoreov1alpha1.ReleaseBinding{},
+			handler.EnqueueRequestsFromMapFunc(r.findCustomDomainsForReleaseBinding),
+			builder.WithPredicates(endpointStatusChangedPredicate()),
+		).
+		// Releasing a domain lets the next claim on it be verified.
+		Watches(
+			&openchoreov1alpha1.CustomDomain{},
+			handler.EnqueueRequestsFromMapFunc(r.findCompetingCustomDomains),
+			builder.WithPredicates(claimChangedPredicate()),
+		).
+		Named("customdomain").
+		Complete(r)
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customdomain
+
+import (
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// Constants for condition types
+
+const (
+	// ConditionDomainVerified indicates that ownership of the domain was proven through
+	// the DNS TXT record and that no other CustomDomain holds an earlier verified claim on it.
+	ConditionDomainVerified controller.ConditionType = "DomainVerified"
+
+	// ConditionRouted indicates that the domain is served by the endpoint's external gateway route.
+	ConditionRouted controller.ConditionType = "Routed"
+
+	// ConditionCertificateReady indicates that the managed TLS certificate covering the domain
+	// is issued. It is only set when the environment configures managed TLS.
+	ConditionCertificateReady controller.ConditionType = "CertificateReady"
+
+	// ConditionReady indicates that the domain is verified, routed and, when managed TLS is
+	// configured, served with a valid certificate.
+	ConditionReady controller.ConditionType = "Ready"
+)
+
+// Constants for condition reasons
+
+const (
+	// ReasonDomainVerified indicates the verification TXT record was found
+	ReasonDomainVerified controller.ConditionReason = "DomainVerified"
+	// ReasonVerificationPending indicates the verification TXT record was not found yet
+	ReasonVerificationPending controller.ConditionReason = "VerificationPending"
+	// ReasonDomainInUse indicates another CustomDomain holds an earlier verified claim on the domain
+	ReasonDomainInUse controller.ConditionReason = "DomainInUse"
+
+	// ReasonRouted indicates the domain was added to the endpoint's external route
+	ReasonRouted controller.ConditionReason = "Routed"
+	// ReasonAwaitingVerification indicates the domain is not routed until it is verified
+	ReasonAwaitingVerification controller.ConditionReason = "AwaitingVerification"
+	// ReasonEnvironmentNotFound indicates the referenced Environment doesn't exist
+	ReasonEnvironmentNotFound controller.ConditionReason = "EnvironmentNotFound"
+	// ReasonEndpointNotDeployed indicates the component is not deployed to the environment
+	// or does not expose the endpoint there
+	ReasonEndpointNotDeployed controller.ConditionReason = "EndpointNotDeployed"
+	// ReasonEndpointNotExternal indicates the endpoint has no external gateway route
+	ReasonEndpointNotExternal controller.ConditionReason = "EndpointNotExternal"
+	// ReasonRoutePending indicates the domain was not yet added to the endpoint's route
+	ReasonRoutePending controller.ConditionReason = "RoutePending"
+
+	// ReasonCertificateIssued indicates the certificate covering the domain is issued
+	ReasonCertificateIssued controller.ConditionReason = "CertificateIssued"
+	// ReasonCertificatePending indicates the certificate covering the domain is not issued yet
+	ReasonCertificatePending controller.ConditionReason = "CertificatePending"
+
+	// ReasonReady indicates the domain serves the endpoint
+	ReasonReady controller.ConditionReason = "Ready"
+	// ReasonNotReady indicates the domain does not serve the endpoint yet
+	ReasonNotReady controller.ConditionReason = "NotReady"
+)
@@ -0,0 +1,206 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customdomain
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+type fakeResolver map[string][]string
+
+func (f fakeResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	values, ok := f[name]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return values, nil
+}
+
+func newCustomDomain(name, uid string) *openchoreov1alpha1.CustomDomain {
+	return &openchoreov1alpha1.CustomDomain{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", UID: types.UID(uid)},
+		Spec: openchoreov1alpha1.CustomDomainSpec{
+			Owner:       openchoreov1alpha1.CustomDomainOwner{ProjectName: "shop", ComponentName: "web"},
+			Environment: "prod",
+			Endpoint:    "http",
+			Domain:      "shop.example.com",
+		},
+	}
+}
+
+func newReleaseBinding(endpoint openchoreov1alpha1.EndpointURLStatus) *openchoreov1alpha1.ReleaseBinding {
+	return &openchoreov1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-prod", Namespace: "ns"},
+		Spec: openchoreov1alpha1.ReleaseBindingSpec{
+			Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: "shop", ComponentName: "web"},
+			Environment: "prod",
+		},
+		Status: openchoreov1alpha1.ReleaseBindingStatus{Endpoints: []openchoreov1alpha1.EndpointURLStatus{endpoint}},
+	}
+}
+
+func newReconciler(t *testing.T, resolver Resolver, objs ...client.Object) *Reconciler {
+	t.Helper()
+	s := runtime.NewScheme()
+	require.NoError(t, openchoreov1alpha1.AddToScheme(s))
+	cli := fake.NewClientBuilder().WithScheme(s).
+		WithObjects(objs...).
+		WithStatusSubresource(&openchoreov1alpha1.CustomDomain{}).
+		WithIndex(&openchoreov1alpha1.CustomDomain{}, controller.IndexKeyCustomDomainDomain, controller.IndexCustomDomainDomain).
+		WithIndex(&openchoreov1alpha1.CustomDomain{}, controller.IndexKeyCustomDomainOwnerEnv, controller.IndexCustomDomainOwnerEnv).
+		WithIndex(&openchoreov1alpha1.ReleaseBinding{}, controller.IndexKeyReleaseBindingOwnerEnv, func(obj client.Object) []string {
+			rb := obj.(*openchoreov1alpha1.ReleaseBinding)
+			return []string{controller.MakeReleaseBindingOwnerEnvKey(rb.Spec.Owner.ProjectName, rb.Spec.Owner.ComponentName, rb.Spec.Environment)}
+		}).
+		Build()
+	return &Reconciler{Client: cli, Scheme: s, Resolver: resolver}
+}
+
+func reconcileCustomDomain(t *testing.T, r *Reconciler, name string) (ctrl.Result, *openchoreov1alpha1.CustomDomain) {
+	t.Helper()
+	key := types.NamespacedName{Namespace: "ns", Name: name}
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	cd := &openchoreov1alpha1.CustomDomain{}
+	require.NoError(t, r.Get(context.Background(), key, cd))
+	return result, cd
+}
+
+func conditionReason(cd *openchoreov1alpha1.CustomDomain, ct controller.ConditionType) string {
+	cond := meta.FindStatusCondition(cd.Status.Conditions, string(ct))
+	if cond == nil {
+		return ""
+	}
+	return cond.Reason
+}
+
+func TestReconcile(t *testing.T) {
+	environment := &openchoreov1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "ns"}}
+	exposed := openchoreov1alpha1.EndpointURLStatus{
+		Name: "http",
+		ExternalURLs: &openchoreov1alpha1.EndpointGatewayURLs{
+			HTTP:  &openchoreov1alpha1.EndpointURL{Scheme: "http", Host: "web-prod.apps.example.org"},
+			HTTPS: &openchoreov1alpha1.EndpointURL{Scheme: "https", Host: "web-prod-secure.apps.example.org"},
+		},
+	}
+	verifiedResolver := func(cd *openchoreov1alpha1.CustomDomain) fakeResolver {
+		return fakeResolver{verificationRecordName(cd.Spec.Domain): {"unrelated", verificationRecordValue(cd)}}
+	}
+
+	t.Run("unverified domains publish the DNS records and requeue", func(t *testing.T) {
+		cd := newCustomDomain("shop", "uid-1")
+		r := newReconciler(t, fakeResolver{}, cd, environment, newReleaseBinding(exposed))
+
+		result, got := reconcileCustomDomain(t, r, "shop")
+		assert.Equal(t, verificationRequeueInterval, result.RequeueAfter)
+		assert.Nil(t, got.Status.VerifiedAt)
+		assert.Equal(t, string(ReasonVerificationPending), conditionReason(got, ConditionDomainVerified))
+		assert.Equal(t, string(ReasonAwaitingVerification), conditionReason(got, ConditionRouted))
+		assert.Equal(t, string(ReasonNotReady), conditionReason(got, ConditionReady))
+
+		require.Len(t, got.Status.DNSRecords, 2)
+		assert.Equal(t, openchoreov1alpha1.DNSRecord{
+			Type: "TXT", Name: "_openchoreo-challenge.shop.example.com", Value: verificationRecordValue(cd),
+			Purpose: "Proves ownership of the domain",
+		}, got.Status.DNSRecords[0])
+		assert.Equal(t, "CNAME", got.Status.DNSRecords[1].Type)
+		assert.Equal(t, "web-prod-secure.apps.example.org", got.Status.DNSRecords[1].Value)
+	})
+
+	t.Run("verified domains wait for the route", func(t *testing.T) {
+		cd := newCustomDomain("shop", "uid-1")
+		r := newReconciler(t, verifiedResolver(cd), cd, environment, newReleaseBinding(exposed))
+
+		result, got := reconcileCustomDomain(t, r, "shop")
+		assert.Zero(t, result.RequeueAfter)
+		require.NotNil(t, got.Status.VerifiedAt)
+		assert.Equal(t, string(ReasonDomainVerified), conditionReason(got, ConditionDomainVerified))
+		assert.Equal(t, string(ReasonRoutePending), conditionReason(got, ConditionRouted))
+		assert.Nil(t, meta.FindStatusCondition(got.Status.Conditions, string(ConditionCertificateReady)))
+	})
+
+	t.Run("routed domains without managed TLS are ready", func(t *testing.T) {
+		cd := newCustomDomain("shop", "uid-1")
+		routed := *exposed.DeepCopy()
+		routed.CustomDomains = []string{"shop.example.com"}
+		r := newReconciler(t, verifiedResolver(cd), cd, environment, newReleaseBinding(routed))
+
+		_, got := reconcileCustomDomain(t, r, "shop")
+		assert.Equal(t, string(ReasonRouted), conditionReason(got, ConditionRouted))
+		assert.Equal(t, string(ReasonReady), conditionReason(got, ConditionReady))
+	})
+
+	t.Run("managed TLS reports the certificate covering the domain", func(t *testing.T) {
+		cd := newCustomDomain("shop", "uid-1")
+		tlsEnvironment := environment.DeepCopy()
+		tlsEnvironment.Spec.TLS = &openchoreov1alpha1.EnvironmentTLSSpec{
+			IssuerRef: openchoreov1alpha1.CertificateIssuerRef{Name: "letsencrypt"},
+		}
+		routed := *exposed.DeepCopy()
+		routed.CustomDomains = []string{"shop.example.com"}
+		routed.Certificates = []openchoreov1alpha1.EndpointCertificateStatus{{
+			Name: "web-http-external", DNSNames: []string{"web-prod.apps.example.org", "shop.example.com"},
+			Message: "Issuing certificate",
+		}}
+		r := newReconciler(t, verifiedResolver(cd), cd, tlsEnvironment, newReleaseBinding(routed))
+
+		_, got := reconcileCustomDomain(t, r, "shop")
+		require.NotNil(t, got.Status.Certificate)
+		assert.Equal(t, "web-http-external", got.Status.Certificate.Name)
+		assert.Equal(t, string(ReasonCertificatePending), conditionReason(got, ConditionCertificateReady))
+		assert.Equal(t, string(ReasonNotReady), conditionReason(got, ConditionReady))
+	})
+
+	t.Run("endpoints without an external route cannot be routed", func(t *testing.T) {
+		cd := newCustomDomain("shop", "uid-1")
+		r := newReconciler(t, verifiedResolver(cd), cd, environment,
+			newReleaseBinding(openchoreov1alpha1.EndpointURLStatus{Name: "http"}))
+
+		_, got := reconcileCustomDomain(t, r, "shop")
+		assert.Equal(t, string(ReasonEndpointNotExternal), conditionReason(got, ConditionRouted))
+		assert.Len(t, got.Status.DNSRecords, 1, "no CNAME target without an external route")
+	})
+
+	t.Run("a domain verified by an earlier claim is in use", func(t *testing.T) {
+		holder := newCustomDomain("shop-old", "uid-0")
+		holder.Namespace = "other"
+		verifiedAt := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		holder.Status.VerifiedAt = &verifiedAt
+		holder.Status.Conditions = []metav1.Condition{{
+			Type: string(ConditionDomainVerified), Status: metav1.ConditionTrue, Reason: string(ReasonDomainVerified),
+			LastTransitionTime: verifiedAt,
+		}}
+		cd := newCustomDomain("shop", "uid-1")
+		r := newReconciler(t, verifiedResolver(cd), holder, cd, environment, newReleaseBinding(exposed))
+
+		result, got := reconcileCustomDomain(t, r, "shop")
+		assert.Equal(t, verificationRequeueInterval, result.RequeueAfter)
+		assert.Nil(t, got.Status.VerifiedAt)
+		assert.Equal(t, string(ReasonDomainInUse), conditionReason(got, ConditionDomainVerified))
+		assert.Contains(t, meta.FindStatusCondition(got.Status.Conditions, string(ConditionDomainVerified)).Message, "other/shop-old")
+	})
+}
+
+func TestVerificationRecordValue(t *testing.T) {
+	a := verificationRecordValue(newCustomDomain("shop", "uid-1"))
+	assert.Equal(t, a, verificationRecordValue(newCustomDomain("renamed", "uid-1")))
+	assert.NotEqual(t, a, verificationRecordValue(newCustomDomain("shop", "uid-2")))
+	assert.Len(t, a, len(verificationValuePrefix)+verificationTokenLength)
+}
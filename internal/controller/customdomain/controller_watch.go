@@ -0,0 +1,123 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customdomain
+
+import (
+	"context"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// findCustomDomainsForReleaseBinding maps a ReleaseBinding to the CustomDomains of its
+// component in its environment, so route and certificate changes are reflected in their status.
+func (r *Reconciler) findCustomDomainsForReleaseBinding(ctx context.Context, obj client.Object) []reconcile.Request {
+	releaseBinding, ok := obj.(*openchoreov1alpha1.ReleaseBinding)
+	if !ok {
+		return nil
+	}
+
+	var customDomains openchoreov1alpha1.CustomDomainList
+	key := controller.MakeReleaseBindingOwnerEnvKey(releaseBinding.Spec.Owner.ProjectName,
+		releaseBinding.Spec.Owner.ComponentName, releaseBinding.Spec.Environment)
+	if err := r.List(ctx, &customDomains, client.InNamespace(releaseBinding.Namespace),
+		client.MatchingFields{controller.IndexKeyCustomDomainOwnerEnv: key}); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list CustomDomains for ReleaseBinding",
+			"releaseBinding", releaseBinding.Name)
+		return nil
+	}
+	return toRequests(customDomains.Items, "")
+}
+
+// findCompetingCustomDomains maps a CustomDomain to the other claims on the same domain, so
+// they are re-evaluated when it is verified or released.
+func (r *Reconciler) findCompetingCustomDomains(ctx context.Context, obj client.Object) []reconcile.Request {
+	customDomain, ok := obj.(*openchoreov1alpha1.CustomDomain)
+	if !ok {
+		return nil
+	}
+
+	var claims openchoreov1alpha1.CustomDomainList
+	if err := r.List(ctx, &claims, client.MatchingFields{controller.IndexKeyCustomDomainDomain: customDomain.Spec.Domain}); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list CustomDomains for domain", "domain", customDomain.Spec.Domain)
+		return nil
+	}
+	return toRequests(claims.Items, customDomain.UID)
+}
+
+func toRequests(customDomains []openchoreov1alpha1.CustomDomain, skip types.UID) []reconcile.Request {
+	requests := make([]reconcile.Request, 0, len(customDomains))
+	for _, cd := range customDomains {
+		if cd.UID == skip {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: cd.Namespace, Name: cd.Name},
+		})
+	}
+	return requests
+}
+
+// endpointStatusChangedPredicate passes ReleaseBinding events that may change the routes or
+// certificates serving a custom domain.
+func endpointStatusChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool {
+			return true
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldRB, ok := e.ObjectOld.(*openchoreov1alpha1.ReleaseBinding)
+			if !ok {
+				return false
+			}
+			newRB, ok := e.ObjectNew.(*openchoreov1alpha1.ReleaseBinding)
+			if !ok {
+				return false
+			}
+			return !apiequality.Semantic.DeepEqual(oldRB.Status.Endpoints, newRB.Status.Endpoints)
+		},
+		DeleteFunc: func(_ event.DeleteEvent) bool {
+			return true
+		},
+		GenericFunc: func(_ event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+// claimChangedPredicate passes CustomDomain events that may change which claim holds a domain.
+func claimChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldCD, ok := e.ObjectOld.(*openchoreov1alpha1.CustomDomain)
+			if !ok {
+				return false
+			}
+			newCD, ok := e.ObjectNew.(*openchoreov1alpha1.CustomDomain)
+			if !ok {
+				return false
+			}
+			return meta.IsStatusConditionTrue(oldCD.Status.Conditions, string(ConditionDomainVerified)) !=
+				meta.IsStatusConditionTrue(newCD.Status.Conditions, string(ConditionDomainVerified))
+		},
+		DeleteFunc: func(_ event.DeleteEvent) bool {
+			return true
+		},
+		GenericFunc: func(_ event.GenericEvent) bool {
+			return false
+		},
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customdomain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+const (
+	// verificationRecordPrefix is prepended to the domain to form the name of the TXT record
+	// proving ownership of the domain.
+	verificationRecordPrefix = "_openchoreo-challenge."
+	// verificationValuePrefix is prepended to the token in the TXT record value.
+	verificationValuePrefix = "openchoreo-verification="
+	// verificationTokenLength is the number of hex characters of the token.
+	verificationTokenLength = 32
+)
+
+// verificationRecordName returns the name of the TXT record proving ownership of the domain.
+func verificationRecordName(domain string) string {
+	return verificationRecordPrefix + domain
+}
+
+// verificationRecordValue returns the value the verification TXT record must carry. The token
+// is derived from the CustomDomain UID, so a recreated CustomDomain has to be verified again.
+func verificationRecordValue(customDomain *openchoreov1alpha1.CustomDomain) string {
+	sum := sha256.Sum256([]byte(customDomain.UID))
+	return verificationValuePrefix + hex.EncodeToString(sum[:])[:verificationTokenLength]
+}
+
+// dnsRecords returns the records the domain owner has to create: the verification TXT record
+// and, once the endpoint is exposed externally, a CNAME pointing the domain at the gateway host
+// generated for the endpoint.
+func dnsRecords(customDomain *openchoreov1alpha1.CustomDomain, endpoint *openchoreov1alpha1.EndpointURLStatus) []openchoreov1alpha1.DNSRecord {
+	records := []openchoreov1alpha1.DNSRecord{{
+		Type:    "TXT",
+		Name:    verificationRecordName(customDomain.Spec.Domain),
+		Value:   verificationRecordValue(customDomain),
+		Purpose: "Proves ownership of the domain",
+	}}
+	if target := gatewayHost(endpoint); target != "" {
+		records = append(records, openchoreov1alpha1.DNSRecord{
+			Type:    "CNAME",
+			Name:    customDomain.Spec.Domain,
+			Value:   target,
+			Purpose: "Routes the domain to the endpoint's gateway",
+		})
+	}
+	return records
+}
+
+// gatewayHost returns the host of the endpoint's external gateway URL, preferring the https
+// listener, or an empty string when the endpoint is not exposed externally.
+func gatewayHost(endpoint *openchoreov1alpha1.EndpointURLStatus) string {
+	if endpoint == nil || endpoint.ExternalURLs == nil {
+		return ""
+	}
+	for _, u := range []*openchoreov1alpha1.EndpointURL{
+		endpoint.ExternalURLs.HTTPS, endpoint.ExternalURLs.HTTP, endpoint.ExternalURLs.TLS,
+	} {
+		if u != nil && u.Host != "" {
+			return u.Host
+		}
+	}
+	return ""
+}
@@ -30,6 +30,9 @@ type Reconciler struct {
 	PlaneClientProvider kubernetesClient.DataPlaneClientProvider
 	Scheme              *runtime.Scheme
 	Recorder            record.EventRecorder
+	// PreviewCommenter posts the URLs of pull request previews to the pull requests.
+	// Previews are deployed without comments when nil.
+	PreviewCommenter PullRequestCommenter
 }
 
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=environments,verbs=get;list;watch;create;update;patch;delete
@@ -37,6 +40,9 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=environments/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;delete
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=workflowruns,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=releasebindings,verbs=get;list;watch;update
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -93,7 +99,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		r.Recorder.Event(environment, corev1.EventTypeNormal, "EnvironmentReady", "Environment is ready")
 	}
 
-	return ctrl.Result{}, nil
+	if err := r.reconcilePreview(ctx, environment); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Sandbox environments are deleted once their TTL elapses.
+	return r.reconcileSandbox(ctx, environment)
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -108,6 +119,14 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			&openchoreov1alpha1.DeploymentPipeline{},
 			handler.EnqueueRequestsFromMapFunc(r.findEnvironmentsForDeploymentPipeline),
 		).
+		Watches(
+			&openchoreov1alpha1.WorkflowRun{},
+			handler.EnqueueRequestsFromMapFunc(r.findEnvironmentForPreviewBuild),
+		).
+		Watches(
+			&openchoreov1alpha1.ReleaseBinding{},
+			handler.EnqueueRequestsFromMapFunc(r.findEnvironmentForReleaseBinding),
+		).
 		Named("environment").
 		Complete(r)
 }
@@ -0,0 +1,165 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package environment
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller/releasebinding"
+	"github.com/openchoreo/openchoreo/internal/controller/workflowrun"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// reconcilePreview deploys the images built from the head commit of a pull request to its preview
+// environment, and posts the preview URLs to the pull request once every build has completed and
+// the rebuilt components are ready. Environments that are not previews are left untouched.
+func (r *Reconciler) reconcilePreview(ctx context.Context, environment *openchoreov1alpha1.Environment) error {
+	if environment.Spec.Sandbox == nil || environment.Spec.Sandbox.PullRequest == nil {
+		return nil
+	}
+	pullRequest := environment.Spec.Sandbox.PullRequest
+	logger := log.FromContext(ctx).WithValues("environment", environment.Name, "commit", pullRequest.Commit)
+
+	runList := &openchoreov1alpha1.WorkflowRunList{}
+	if err := r.List(ctx, runList, client.InNamespace(environment.Namespace), client.MatchingLabels{
+		labels.LabelKeyPreviewEnvironment: environment.Name,
+		labels.LabelKeyPreviewCommit:      pullRequest.Commit,
+	}); err != nil {
+		return fmt.Errorf("failed to list preview builds: %w", err)
+	}
+	if len(runList.Items) == 0 {
+		return nil
+	}
+
+	images := make(map[string]string)
+	var failed []string
+	building := false
+	for i := range runList.Items {
+		run := &runList.Items[i]
+		componentName := run.Labels[labels.LabelKeyComponentName]
+		switch {
+		case !meta.IsStatusConditionTrue(run.Status.Conditions, workflowrun.ConditionWorkflowCompleted.String()):
+			building = true
+		case meta.IsStatusConditionTrue(run.Status.Conditions, workflowrun.ConditionWorkflowSucceeded.String()) && run.Status.Image != "":
+			images[componentName] = run.Status.Image
+		default:
+			failed = append(failed, componentName)
+		}
+	}
+
+	bindings, err := r.previewBindings(ctx, environment)
+	if err != nil {
+		return err
+	}
+
+	ready := true
+	for i := range bindings {
+		binding := &bindings[i]
+		image, ok := images[binding.Spec.Owner.ComponentName]
+		if !ok {
+			continue
+		}
+		if binding.Spec.WorkloadOverrides == nil || binding.Spec.WorkloadOverrides.Container == nil ||
+			binding.Spec.WorkloadOverrides.Container.Image != image {
+			if binding.Spec.WorkloadOverrides == nil {
+				binding.Spec.WorkloadOverrides = &openchoreov1alpha1.WorkloadOverrideTemplateSpec{}
+			}
+			if binding.Spec.WorkloadOverrides.Container == nil {
+				binding.Spec.WorkloadOverrides.Container = &openchoreov1alpha1.ContainerOverride{}
+			}
+			binding.Spec.WorkloadOverrides.Container.Image = image
+			if err := r.Update(ctx, binding); err != nil {
+				return fmt.Errorf("failed to deploy preview build to %s: %w", binding.Name, err)
+			}
+			logger.Info("Deploying preview build", "releaseBinding", binding.Name, "image", image)
+			r.Recorder.Eventf(environment, corev1.EventTypeNormal, "PreviewDeploying",
+				"Deploying %s to %s", image, binding.Spec.Owner.ComponentName)
+			ready = false
+			continue
+		}
+		readyCond := meta.FindStatusCondition(binding.Status.Conditions, releasebinding.ConditionReady.String())
+		if readyCond == nil || readyCond.Status != metav1.ConditionTrue || readyCond.ObservedGeneration != binding.Generation {
+			ready = false
+		}
+	}
+
+	if building || !ready || r.PreviewCommenter == nil || environment.Status.PreviewNotifiedCommit == pullRequest.Commit {
+		return nil
+	}
+
+	if err := r.PreviewCommenter.Comment(ctx, pullRequest, previewComment(environment, bindings, failed)); err != nil {
+		return fmt.Errorf("failed to post preview to pull request: %w", err)
+	}
+	patch := client.MergeFrom(environment.DeepCopy())
+	environment.Status.PreviewNotifiedCommit = pullRequest.Commit
+	if err := r.Status().Patch(ctx, environment, patch); err != nil {
+		return fmt.Errorf("failed to record posted preview: %w", err)
+	}
+	logger.Info("Posted preview to pull request", "pullRequest", pullRequest.Number)
+	r.Recorder.Eventf(environment, corev1.EventTypeNormal, "PreviewPosted",
+		"Posted preview of %s to pull request #%d", shortCommit(pullRequest.Commit), pullRequest.Number)
+	return nil
+}
+
+// previewBindings returns the release bindings of the environment sorted by component.
+func (r *Reconciler) previewBindings(ctx context.Context, environment *openchoreov1alpha1.Environment) ([]openchoreov1alpha1.ReleaseBinding, error) {
+	bindingList := &openchoreov1alpha1.ReleaseBindingList{}
+	if err := r.List(ctx, bindingList, client.InNamespace(environment.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+	var bindings []openchoreov1alpha1.ReleaseBinding
+	for _, binding := range bindingList.Items {
+		if binding.Spec.Environment == environment.Name {
+			bindings = append(bindings, binding)
+		}
+	}
+	sort.Slice(bindings, func(i, j int) bool {
+		return bindings[i].Spec.Owner.ComponentName < bindings[j].Spec.Owner.ComponentName
+	})
+	return bindings, nil
+}
+
+// previewComment renders the pull request comment listing the endpoint URLs of the preview
+// and the components whose build failed.
+func previewComment(environment *openchoreov1alpha1.Environment, bindings []openchoreov1alpha1.ReleaseBinding, failed []string) string {
+	pullRequest := environment.Spec.Sandbox.PullRequest
+	var b strings.Builder
+	fmt.Fprintf(&b, "Preview environment `%s` is deployed from %s.\n", environment.Name, shortCommit(pullRequest.Commit))
+	var urls []string
+	for _, binding := range bindings {
+		for _, endpoint := range binding.Status.Endpoints {
+			if endpoint.InvokeURL != "" {
+				urls = append(urls, fmt.Sprintf("- %s (%s): %s", binding.Spec.Owner.ComponentName, endpoint.Name, endpoint.InvokeURL))
+			}
+		}
+	}
+	if len(urls) > 0 {
+		b.WriteString("\n" + strings.Join(urls, "\n") + "\n")
+	}
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		fmt.Fprintf(&b, "\nThe build of %s failed; the preview runs the previous release of these components.\n", strings.Join(failed, ", "))
+	}
+	if environment.Status.ExpiresAt != nil {
+		fmt.Fprintf(&b, "\nThe preview expires at %s or when the pull request is closed.\n", environment.Status.ExpiresAt.UTC().Format("2006-01-02 15:04 MST"))
+	}
+	return b.String()
+}
+
+func shortCommit(commit string) string {
+	if len(commit) > 7 {
+		return commit[:7]
+	}
+	return commit
+}
@@ -0,0 +1,195 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package environment
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller/releasebinding"
+	"github.com/openchoreo/openchoreo/internal/controller/workflowrun"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+type fakeCommenter struct {
+	comments []string
+}
+
+func (f *fakeCommenter) Comment(_ context.Context, _ *openchoreov1alpha1.SandboxPullRequest, body string) error {
+	f.comments = append(f.comments, body)
+	return nil
+}
+
+func newPreviewEnv() *openchoreov1alpha1.Environment {
+	env := newSandboxEnv(time.Now(), time.Hour)
+	env.Name = "shop-pr-42"
+	env.Spec.Sandbox.PullRequest = &openchoreov1alpha1.SandboxPullRequest{
+		Provider:      "github",
+		RepositoryURL: "https://github.com/example/repo",
+		Number:        42,
+		Branch:        "feature/login",
+		Commit:        "abc1234def",
+	}
+	return env
+}
+
+func newPreviewRun(component string, completed, succeeded bool, image string) *openchoreov1alpha1.WorkflowRun {
+	run := &openchoreov1alpha1.WorkflowRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      component + "-run",
+			Namespace: "ns",
+			Labels: map[string]string{
+				labels.LabelKeyComponentName:      component,
+				labels.LabelKeyPreviewEnvironment: "shop-pr-42",
+				labels.LabelKeyPreviewCommit:      "abc1234def",
+			},
+		},
+	}
+	run.Status.Image = image
+	condition := func(conditionType string, value bool) metav1.Condition {
+		status := metav1.ConditionFalse
+		if value {
+			status = metav1.ConditionTrue
+		}
+		return metav1.Condition{Type: conditionType, Status: status, Reason: "Test", LastTransitionTime: metav1.Now()}
+	}
+	run.Status.Conditions = []metav1.Condition{
+		condition(workflowrun.ConditionWorkflowCompleted.String(), completed),
+		condition(workflowrun.ConditionWorkflowSucceeded.String(), succeeded),
+	}
+	return run
+}
+
+func newPreviewBinding(component string) *openchoreov1alpha1.ReleaseBinding {
+	binding := &openchoreov1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: component + "-shop-pr-42", Namespace: "ns"},
+		Spec: openchoreov1alpha1.ReleaseBindingSpec{
+			Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: "shop", ComponentName: component},
+			Environment: "shop-pr-42",
+			ReleaseName: component + "-r1",
+		},
+	}
+	binding.Status.Endpoints = []openchoreov1alpha1.EndpointURLStatus{
+		{Name: "http", InvokeURL: "https://" + component + ".shop-pr-42.example.com"},
+	}
+	return binding
+}
+
+func TestReconcilePreview(t *testing.T) {
+	s := prbTestScheme(t)
+	ctx := context.Background()
+
+	newReconciler := func(objs ...client.Object) (*Reconciler, client.Client, *fakeCommenter) {
+		cli := fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).
+			WithStatusSubresource(&openchoreov1alpha1.Environment{}, &openchoreov1alpha1.ReleaseBinding{}).Build()
+		commenter := &fakeCommenter{}
+		return &Reconciler{Client: cli, Scheme: s, Recorder: record.NewFakeRecorder(10), PreviewCommenter: commenter}, cli, commenter
+	}
+
+	markReady := func(t *testing.T, cli client.Client, name string) {
+		t.Helper()
+		binding := &openchoreov1alpha1.ReleaseBinding{}
+		if err := cli.Get(ctx, client.ObjectKey{Namespace: "ns", Name: name}, binding); err != nil {
+			t.Fatalf("get release binding: %v", err)
+		}
+		binding.Status.Conditions = []metav1.Condition{{
+			Type:               releasebinding.ConditionReady.String(),
+			Status:             metav1.ConditionTrue,
+			Reason:             "Ready",
+			ObservedGeneration: binding.Generation,
+			LastTransitionTime: metav1.Now(),
+		}}
+		if err := cli.Status().Update(ctx, binding); err != nil {
+			t.Fatalf("update release binding status: %v", err)
+		}
+	}
+
+	t.Run("waits for running builds", func(t *testing.T) {
+		env := newPreviewEnv()
+		r, cli, commenter := newReconciler(env, newPreviewRun("svc", false, false, ""), newPreviewBinding("svc"))
+
+		if err := r.reconcilePreview(ctx, env); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		binding := &openchoreov1alpha1.ReleaseBinding{}
+		if err := cli.Get(ctx, client.ObjectKey{Namespace: "ns", Name: "svc-shop-pr-42"}, binding); err != nil {
+			t.Fatalf("get release binding: %v", err)
+		}
+		if binding.Spec.WorkloadOverrides != nil {
+			t.Fatal("expected no image override while building")
+		}
+		if len(commenter.comments) != 0 {
+			t.Fatalf("expected no comment, got %d", len(commenter.comments))
+		}
+	})
+
+	t.Run("deploys the built image and comments once ready", func(t *testing.T) {
+		env := newPreviewEnv()
+		r, cli, commenter := newReconciler(env, newPreviewRun("svc", true, true, "registry/svc:abc1234"), newPreviewBinding("svc"))
+
+		if err := r.reconcilePreview(ctx, env); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		binding := &openchoreov1alpha1.ReleaseBinding{}
+		if err := cli.Get(ctx, client.ObjectKey{Namespace: "ns", Name: "svc-shop-pr-42"}, binding); err != nil {
+			t.Fatalf("get release binding: %v", err)
+		}
+		if binding.Spec.WorkloadOverrides == nil || binding.Spec.WorkloadOverrides.Container == nil ||
+			binding.Spec.WorkloadOverrides.Container.Image != "registry/svc:abc1234" {
+			t.Fatalf("expected the built image to be deployed, got %+v", binding.Spec.WorkloadOverrides)
+		}
+		if len(commenter.comments) != 0 {
+			t.Fatal("expected no comment before the binding is ready")
+		}
+
+		markReady(t, cli, "svc-shop-pr-42")
+		if err := r.reconcilePreview(ctx, env); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(commenter.comments) != 1 {
+			t.Fatalf("expected one comment, got %d", len(commenter.comments))
+		}
+		if !strings.Contains(commenter.comments[0], "https://svc.shop-pr-42.example.com") {
+			t.Fatalf("expected the endpoint URL in the comment, got %q", commenter.comments[0])
+		}
+
+		got := &openchoreov1alpha1.Environment{}
+		if err := cli.Get(ctx, client.ObjectKeyFromObject(env), got); err != nil {
+			t.Fatalf("get environment: %v", err)
+		}
+		if got.Status.PreviewNotifiedCommit != "abc1234def" {
+			t.Fatalf("expected the notified commit to be recorded, got %q", got.Status.PreviewNotifiedCommit)
+		}
+
+		if err := r.reconcilePreview(ctx, got); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(commenter.comments) != 1 {
+			t.Fatalf("expected the commit to be commented once, got %d", len(commenter.comments))
+		}
+	})
+
+	t.Run("reports failed builds", func(t *testing.T) {
+		env := newPreviewEnv()
+		r, _, commenter := newReconciler(env, newPreviewRun("svc", true, false, ""), newPreviewBinding("svc"))
+
+		if err := r.reconcilePreview(ctx, env); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(commenter.comments) != 1 {
+			t.Fatalf("expected one comment, got %d", len(commenter.comments))
+		}
+		if !strings.Contains(commenter.comments[0], "The build of svc failed") {
+			t.Fatalf("expected the failed build in the comment, got %q", commenter.comments[0])
+		}
+	})
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package environment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// sandboxExpiry returns when a sandbox environment expires, counted from its creation.
+func sandboxExpiry(environment *openchoreov1alpha1.Environment) time.Time {
+	return environment.CreationTimestamp.Add(environment.Spec.Sandbox.TTL.Duration)
+}
+
+// reconcileSandbox records the expiry of a sandbox environment and deletes the environment
+// once its TTL has elapsed. Deleting the environment runs the regular finalization, which
+// removes the sandbox release bindings and data plane namespaces.
+// Regular environments are left untouched.
+func (r *Reconciler) reconcileSandbox(ctx context.Context, environment *openchoreov1alpha1.Environment) (ctrl.Result, error) {
+	if environment.Spec.Sandbox == nil {
+		return ctrl.Result{}, nil
+	}
+	logger := log.FromContext(ctx).WithValues("environment", environment.Name)
+
+	expiresAt := metav1.NewTime(sandboxExpiry(environment))
+	if environment.Status.ExpiresAt == nil || !environment.Status.ExpiresAt.Equal(&expiresAt) {
+		patch := client.MergeFrom(environment.DeepCopy())
+		environment.Status.ExpiresAt = &expiresAt
+		if err := r.Status().Patch(ctx, environment, patch); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to record sandbox expiry: %w", err)
+		}
+	}
+
+	remaining := time.Until(expiresAt.Time)
+	if remaining > 0 {
+		return ctrl.Result{RequeueAfter: remaining}, nil
+	}
+
+	logger.Info("Sandbox TTL elapsed, deleting environment", "expiresAt", expiresAt)
+	if err := r.Delete(ctx, environment); err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, fmt.Errorf("failed to delete expired sandbox: %w", err)
+	}
+	r.Recorder.Event(environment, corev1.EventTypeNormal, "SandboxExpired", "Sandbox TTL elapsed, deleting environment")
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package environment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func newSandboxEnv(created time.Time, ttl time.Duration) *openchoreov1alpha1.Environment {
+	return &openchoreov1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "sbx",
+			Namespace:         "ns",
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: openchoreov1alpha1.EnvironmentSpec{
+			Sandbox: &openchoreov1alpha1.EnvironmentSandboxSpec{
+				TTL:     metav1.Duration{Duration: ttl},
+				Project: "p",
+			},
+		},
+	}
+}
+
+func TestReconcileSandbox(t *testing.T) {
+	s := prbTestScheme(t)
+	ctx := context.Background()
+
+	newReconciler := func(objs ...client.Object) (*Reconciler, client.Client) {
+		cli := fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).
+			WithStatusSubresource(&openchoreov1alpha1.Environment{}).Build()
+		return &Reconciler{Client: cli, Scheme: s, Recorder: record.NewFakeRecorder(10)}, cli
+	}
+
+	t.Run("ignores regular environments", func(t *testing.T) {
+		env := &openchoreov1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "ns"}}
+		r, _ := newReconciler(env)
+
+		result, err := r.reconcileSandbox(ctx, env)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.RequeueAfter != 0 {
+			t.Fatalf("expected no requeue, got %s", result.RequeueAfter)
+		}
+		if env.Status.ExpiresAt != nil {
+			t.Fatal("expected no expiry on a regular environment")
+		}
+	})
+
+	t.Run("records the expiry and requeues until it", func(t *testing.T) {
+		env := newSandboxEnv(time.Now().Add(-time.Hour), 2*time.Hour)
+		r, cli := newReconciler(env)
+
+		result, err := r.reconcileSandbox(ctx, env)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.RequeueAfter <= 0 || result.RequeueAfter > time.Hour {
+			t.Fatalf("expected a requeue within the remaining hour, got %s", result.RequeueAfter)
+		}
+
+		got := &openchoreov1alpha1.Environment{}
+		if err := cli.Get(ctx, client.ObjectKeyFromObject(env), got); err != nil {
+			t.Fatalf("get environment: %v", err)
+		}
+		if got.Status.ExpiresAt == nil {
+			t.Fatal("expected expiresAt to be recorded")
+		}
+		want := env.CreationTimestamp.Add(2 * time.Hour)
+		if !got.Status.ExpiresAt.Time.Equal(want.Truncate(time.Second)) {
+			t.Fatalf("expected expiresAt %s, got %s", want, got.Status.ExpiresAt)
+		}
+	})
+
+	t.Run("deletes an expired sandbox", func(t *testing.T) {
+		env := newSandboxEnv(time.Now().Add(-2*time.Hour), time.Hour)
+		r, cli := newReconciler(env)
+
+		result, err := r.reconcileSandbox(ctx, env)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.RequeueAfter != 0 {
+			t.Fatalf("expected no requeue, got %s", result.RequeueAfter)
+		}
+
+		err = cli.Get(ctx, client.ObjectKeyFromObject(env), &openchoreov1alpha1.Environment{})
+		if !apierrors.IsNotFound(err) {
+			t.Fatalf("expected the sandbox to be deleted, got %v", err)
+		}
+	})
+}
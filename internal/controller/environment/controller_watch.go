@@ -11,6 +11,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
 )
 
 // findEnvironmentsForDeploymentPipeline maps a DeploymentPipeline change to the Environments
@@ -77,3 +78,22 @@ func (r *Reconciler) findEnvironmentsForDeploymentPipeline(ctx context.Context,
 
 	return requests
 }
+
+// findEnvironmentForPreviewBuild maps a workflow run building a pull request to its preview environment.
+func (r *Reconciler) findEnvironmentForPreviewBuild(_ context.Context, obj client.Object) []reconcile.Request {
+	environmentName := obj.GetLabels()[labels.LabelKeyPreviewEnvironment]
+	if environmentName == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: client.ObjectKey{Namespace: obj.GetNamespace(), Name: environmentName}}}
+}
+
+// findEnvironmentForReleaseBinding maps a release binding to its environment, so that previews
+// are posted once the rebuilt components are ready.
+func (r *Reconciler) findEnvironmentForReleaseBinding(_ context.Context, obj client.Object) []reconcile.Request {
+	binding, ok := obj.(*openchoreov1alpha1.ReleaseBinding)
+	if !ok || binding.Spec.Environment == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: client.ObjectKey{Namespace: binding.Namespace, Name: binding.Spec.Environment}}}
+}
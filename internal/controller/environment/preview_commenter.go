@@ -0,0 +1,153 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package environment
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// PullRequestCommenter posts comments to the pull requests deployed to preview environments.
+type PullRequestCommenter interface {
+	Comment(ctx context.Context, pullRequest *openchoreov1alpha1.SandboxPullRequest, body string) error
+}
+
+// apiPullRequestCommenter posts comments through the REST APIs of GitHub and GitLab, authenticated
+// with the access token of the provider stored in a Secret under "<provider>-token".
+type apiPullRequestCommenter struct {
+	reader      client.Reader
+	tokenSecret client.ObjectKey
+	httpClient  *http.Client
+	// apiURLs maps each git host that may receive the access tokens to the base URL of its API,
+	// such as https://api.github.com for github.com. Pull requests on other hosts are not commented on.
+	apiURLs map[string]string
+}
+
+// defaultGitAPIURLs are the API base URLs of the public GitHub and GitLab hosts.
+var defaultGitAPIURLs = map[string]string{
+	"github.com": "https://api.github.com",
+	"gitlab.com": "https://gitlab.com/api/v4",
+}
+
+// NewPullRequestCommenter creates a commenter that reads the provider access tokens from the given Secret.
+// apiURLs maps the hosts of self-hosted GitHub and GitLab instances to their API base URLs, in addition
+// to github.com and gitlab.com. Tokens are only sent to the API URLs of these hosts.
+func NewPullRequestCommenter(reader client.Reader, tokenSecret client.ObjectKey, apiURLs map[string]string) PullRequestCommenter {
+	urls := make(map[string]string, len(defaultGitAPIURLs)+len(apiURLs))
+	for host, apiURL := range defaultGitAPIURLs {
+		urls[host] = apiURL
+	}
+	for host, apiURL := range apiURLs {
+		urls[strings.ToLower(host)] = strings.TrimSuffix(apiURL, "/")
+	}
+	return &apiPullRequestCommenter{
+		reader:      reader,
+		tokenSecret: tokenSecret,
+		httpClient:  &http.Client{Timeout: 30 * time.Second},
+		apiURLs:     urls,
+	}
+}
+
+// ParseGitAPIURLs parses a comma-separated list of HOST=API_URL pairs, such as
+// "github.example.com=https://github.example.com/api/v3".
+func ParseGitAPIURLs(value string) (map[string]string, error) {
+	urls := map[string]string{}
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		host, apiURL, ok := strings.Cut(pair, "=")
+		if !ok || host == "" {
+			return nil, fmt.Errorf("invalid git API URL %q: expected HOST=API_URL", pair)
+		}
+		u, err := url.Parse(apiURL)
+		if err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+			return nil, fmt.Errorf("invalid API URL %q for git host %s", apiURL, host)
+		}
+		urls[strings.ToLower(host)] = apiURL
+	}
+	return urls, nil
+}
+
+func (c *apiPullRequestCommenter) Comment(ctx context.Context, pullRequest *openchoreov1alpha1.SandboxPullRequest, body string) error {
+	repoURL, err := url.Parse(pullRequest.RepositoryURL)
+	if err != nil || repoURL.Host == "" {
+		return fmt.Errorf("invalid repository URL %q", pullRequest.RepositoryURL)
+	}
+	host := strings.ToLower(repoURL.Host)
+	repoPath := strings.TrimSuffix(strings.Trim(repoURL.Path, "/"), ".git")
+	// The repository URL comes from the Environment, so the access token is only sent to configured hosts.
+	apiURL, ok := c.apiURLs[host]
+	if !ok {
+		return fmt.Errorf("git host %s is not configured for pull request comments", host)
+	}
+
+	token, err := c.token(ctx, pullRequest.Provider)
+	if err != nil {
+		return err
+	}
+
+	var endpoint string
+	header := http.Header{}
+	switch pullRequest.Provider {
+	case "github":
+		endpoint = fmt.Sprintf("%s/repos/%s/issues/%d/comments", apiURL, repoPath, pullRequest.Number)
+		header.Set("Authorization", "Bearer "+token)
+		header.Set("Accept", "application/vnd.github+json")
+	case "gitlab":
+		endpoint = fmt.Sprintf("%s/projects/%s/merge_requests/%d/notes", apiURL, url.PathEscape(repoPath), pullRequest.Number)
+		header.Set("PRIVATE-TOKEN", token)
+	default:
+		return fmt.Errorf("unsupported git provider %q", pullRequest.Provider)
+	}
+
+	payload, err := json.Marshal(map[string]string{"body": body})
+	if err != nil {
+		return fmt.Errorf("failed to encode comment: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create comment request: %w", err)
+	}
+	req.Header = header
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post comment: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("posting comment failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// token returns the access token of the provider from the token Secret.
+func (c *apiPullRequestCommenter) token(ctx context.Context, provider string) (string, error) {
+	secret := &corev1.Secret{}
+	if err := c.reader.Get(ctx, c.tokenSecret, secret); err != nil {
+		return "", fmt.Errorf("failed to get git token secret %s: %w", c.tokenSecret, err)
+	}
+	key := provider + "-token"
+	token := secret.Data[key]
+	if len(token) == 0 {
+		return "", fmt.Errorf("git token secret %s has no %q key", c.tokenSecret, key)
+	}
+	return string(token), nil
+}
@@ -0,0 +1,180 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package environment
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestPullRequestCommenter(t *testing.T) {
+	ctx := context.Background()
+	secretKey := client.ObjectKey{Namespace: "openchoreo-control-plane", Name: "git-webhook-secrets"}
+	s := prbTestScheme(t)
+	if err := corev1.AddToScheme(s); err != nil {
+		t.Fatalf("add core scheme: %v", err)
+	}
+	reader := fake.NewClientBuilder().WithScheme(s).WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: secretKey.Namespace, Name: secretKey.Name},
+		Data: map[string][]byte{
+			"github-token": []byte("gh-token"),
+			"gitlab-token": []byte("gl-token"),
+		},
+	}).Build()
+
+	tests := []struct {
+		name       string
+		provider   string
+		repoURL    string
+		wantPath   string
+		wantHeader string
+		wantToken  string
+	}{
+		{
+			name:       "github",
+			provider:   "github",
+			repoURL:    "https://github.com/example/repo.git",
+			wantPath:   "/repos/example/repo/issues/42/comments",
+			wantHeader: "Authorization",
+			wantToken:  "Bearer gh-token",
+		},
+		{
+			name:       "gitlab",
+			provider:   "gitlab",
+			repoURL:    "https://gitlab.com/group/sub/repo",
+			wantPath:   "/projects/group%2Fsub%2Frepo/merge_requests/42/notes",
+			wantHeader: "PRIVATE-TOKEN",
+			wantToken:  "gl-token",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotPath, gotToken, gotBody string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotPath = r.URL.EscapedPath()
+				gotToken = r.Header.Get(tt.wantHeader)
+				var payload map[string]string
+				_ = json.NewDecoder(r.Body).Decode(&payload)
+				gotBody = payload["body"]
+				w.WriteHeader(http.StatusCreated)
+			}))
+			defer server.Close()
+
+			commenter := NewPullRequestCommenter(reader, secretKey, nil).(*apiPullRequestCommenter)
+			commenter.apiURLs = map[string]string{"github.com": server.URL, "gitlab.com": server.URL}
+
+			err := commenter.Comment(ctx, &openchoreov1alpha1.SandboxPullRequest{
+				Provider:      tt.provider,
+				RepositoryURL: tt.repoURL,
+				Number:        42,
+			}, "preview is ready")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gotPath != tt.wantPath {
+				t.Errorf("path = %q, want %q", gotPath, tt.wantPath)
+			}
+			if gotToken != tt.wantToken {
+				t.Errorf("token = %q, want %q", gotToken, tt.wantToken)
+			}
+			if gotBody != "preview is ready" {
+				t.Errorf("body = %q, want %q", gotBody, "preview is ready")
+			}
+		})
+	}
+
+	t.Run("fails on error responses", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, "forbidden", http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		commenter := NewPullRequestCommenter(reader, secretKey, nil).(*apiPullRequestCommenter)
+		commenter.apiURLs = map[string]string{"github.com": server.URL}
+		err := commenter.Comment(ctx, &openchoreov1alpha1.SandboxPullRequest{
+			Provider:      "github",
+			RepositoryURL: "https://github.com/example/repo",
+			Number:        42,
+		}, "preview is ready")
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+
+	t.Run("posts to configured self-hosted instances", func(t *testing.T) {
+		var gotPath string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotPath = r.URL.Path
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+
+		commenter := NewPullRequestCommenter(reader, secretKey, map[string]string{"GHE.example.com": server.URL + "/api/v3/"})
+		err := commenter.Comment(ctx, &openchoreov1alpha1.SandboxPullRequest{
+			Provider:      "github",
+			RepositoryURL: "https://ghe.example.com/example/repo",
+			Number:        42,
+		}, "preview is ready")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if gotPath != "/api/v3/repos/example/repo/issues/42/comments" {
+			t.Errorf("path = %q", gotPath)
+		}
+	})
+
+	t.Run("refuses hosts that are not configured", func(t *testing.T) {
+		called := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			called = true
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+
+		commenter := NewPullRequestCommenter(reader, secretKey, nil)
+		err := commenter.Comment(ctx, &openchoreov1alpha1.SandboxPullRequest{
+			Provider:      "github",
+			RepositoryURL: server.URL + "/example/repo",
+			Number:        42,
+		}, "preview is ready")
+		if err == nil || !strings.Contains(err.Error(), "is not configured for pull request comments") {
+			t.Fatalf("expected an unconfigured host error, got %v", err)
+		}
+		if called {
+			t.Error("the token was sent to an unconfigured host")
+		}
+	})
+}
+
+func TestParseGitAPIURLs(t *testing.T) {
+	urls, err := ParseGitAPIURLs(" GitHub.example.com=https://github.example.com/api/v3, gitlab.example.com=https://gitlab.example.com/api/v4 ,")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]string{
+		"github.example.com": "https://github.example.com/api/v3",
+		"gitlab.example.com": "https://gitlab.example.com/api/v4",
+	}
+	if !reflect.DeepEqual(urls, want) {
+		t.Errorf("urls = %v, want %v", urls, want)
+	}
+
+	for _, value := range []string{"github.example.com", "=https://x", "github.example.com=ftp://x", "github.example.com=not a url"} {
+		if _, err := ParseGitAPIURLs(value); err == nil {
+			t.Errorf("ParseGitAPIURLs(%q): expected an error", value)
+		}
+	}
+}
@@ -149,6 +149,7 @@ func (r *Reconciler) reconcile(ctx context.Context, binding *openchoreov1alpha1.
 	}
 
 	r.evaluateReadiness(binding, rr)
+	evaluateQuota(binding, rr)
 	return ctrl.Result{}, nil
 }
 
@@ -0,0 +1,127 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package projectreleasebinding
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	projectpipeline "github.com/openchoreo/openchoreo/internal/pipeline/project"
+)
+
+const (
+	// QuotaResourceID and LimitRangeResourceID are the RenderedRelease entry
+	// IDs of the objects materializing spec.quota. They are reserved: a
+	// (Cluster)ProjectType resource using either ID fails rendering.
+	QuotaResourceID      = "openchoreo-resource-quota"
+	LimitRangeResourceID = "openchoreo-limit-range"
+
+	quotaObjectName      = "openchoreo-quota"
+	limitRangeObjectName = "openchoreo-limits"
+)
+
+// buildQuotaEntries renders spec.quota into the cell namespace: a
+// ResourceQuota for the hard limits and a LimitRange for the container
+// defaults and maximums. Either is omitted when its part of the quota is
+// empty, so clearing spec.quota prunes both from the data plane.
+func buildQuotaEntries(
+	quota *openchoreov1alpha1.ProjectQuota,
+	metadataCtx projectpipeline.MetadataContext,
+) ([]projectpipeline.RenderedEntry, error) {
+	if quota == nil {
+		return nil, nil
+	}
+	objectMeta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metadataCtx.Namespace,
+			Labels:    metadataCtx.Labels,
+		}
+	}
+
+	type quotaObject struct {
+		id  string
+		obj runtime.Object
+	}
+	var objects []quotaObject
+	if len(quota.Hard) > 0 {
+		objects = append(objects, quotaObject{QuotaResourceID, &corev1.ResourceQuota{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ResourceQuota"},
+			ObjectMeta: objectMeta(quotaObjectName),
+			Spec:       corev1.ResourceQuotaSpec{Hard: quota.Hard},
+		}})
+	}
+	if len(quota.DefaultRequest) > 0 || len(quota.Default) > 0 || len(quota.Max) > 0 {
+		objects = append(objects, quotaObject{LimitRangeResourceID, &corev1.LimitRange{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "LimitRange"},
+			ObjectMeta: objectMeta(limitRangeObjectName),
+			Spec: corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{{
+				Type:           corev1.LimitTypeContainer,
+				DefaultRequest: quota.DefaultRequest,
+				Default:        quota.Default,
+				Max:            quota.Max,
+			}}},
+		}})
+	}
+
+	entries := make([]projectpipeline.RenderedEntry, 0, len(objects))
+	for _, o := range objects {
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o.obj)
+		if err != nil {
+			return nil, fmt.Errorf("convert %s to unstructured: %w", o.id, err)
+		}
+		// The converter keeps the zero creationTimestamp and an empty status;
+		// drop them so the manifest matches what a template would render.
+		if metadata, ok := obj["metadata"].(map[string]any); ok {
+			delete(metadata, "creationTimestamp")
+		}
+		delete(obj, "status")
+		entries = append(entries, projectpipeline.RenderedEntry{ID: o.id, Object: obj})
+	}
+	return entries, nil
+}
+
+// reservedQuotaID returns the first rendered entry ID that collides with an
+// ID reserved for spec.quota, or "" when there is none.
+func reservedQuotaID(entries []projectpipeline.RenderedEntry) string {
+	for i := range entries {
+		if entries[i].ID == QuotaResourceID || entries[i].ID == LimitRangeResourceID {
+			return entries[i].ID
+		}
+	}
+	return ""
+}
+
+// evaluateQuota surfaces the usage of spec.quota as observed by the
+// RenderedRelease on status.quota. It is cleared when no hard quota is set
+// or the ResourceQuota has not reported status yet.
+func evaluateQuota(
+	binding *openchoreov1alpha1.ProjectReleaseBinding,
+	rr *openchoreov1alpha1.RenderedRelease,
+) {
+	binding.Status.Quota = nil
+	if binding.Spec.Quota == nil || len(binding.Spec.Quota.Hard) == 0 {
+		return
+	}
+	for i := range rr.Status.Resources {
+		st := &rr.Status.Resources[i]
+		if st.ID != QuotaResourceID || st.Status == nil {
+			continue
+		}
+		var observed corev1.ResourceQuotaStatus
+		if err := json.Unmarshal(st.Status.Raw, &observed); err != nil || observed.Hard == nil {
+			return
+		}
+		binding.Status.Quota = &openchoreov1alpha1.ProjectQuotaStatus{
+			Hard: observed.Hard,
+			Used: observed.Used,
+		}
+		return
+	}
+}
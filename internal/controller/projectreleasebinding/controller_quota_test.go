@@ -0,0 +1,125 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package projectreleasebinding
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	projectpipeline "github.com/openchoreo/openchoreo/internal/pipeline/project"
+)
+
+var _ = Describe("ProjectReleaseBinding controller — quota", func() {
+	metadataCtx := projectpipeline.MetadataContext{
+		Namespace: "dp-default-p-dev-abc",
+		Labels:    map[string]string{"openchoreo.dev/project": "p"},
+	}
+
+	Context("buildQuotaEntries", func() {
+		It("renders nothing without a quota", func() {
+			entries, err := buildQuotaEntries(nil, metadataCtx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		})
+
+		It("renders a ResourceQuota and a LimitRange in the cell namespace", func() {
+			entries, err := buildQuotaEntries(&openchoreov1alpha1.ProjectQuota{
+				Hard: corev1.ResourceList{
+					corev1.ResourceRequestsCPU: resource.MustParse("2"),
+					corev1.ResourcePods:        resource.MustParse("10"),
+				},
+				DefaultRequest: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+				Max:            corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			}, metadataCtx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(2))
+
+			Expect(entries[0].ID).To(Equal(QuotaResourceID))
+			Expect(entries[0].Object).To(Equal(map[string]any{
+				"apiVersion": "v1",
+				"kind":       "ResourceQuota",
+				"metadata": map[string]any{
+					"name":      quotaObjectName,
+					"namespace": metadataCtx.Namespace,
+					"labels":    map[string]any{"openchoreo.dev/project": "p"},
+				},
+				"spec": map[string]any{
+					"hard": map[string]any{"requests.cpu": "2", "pods": "10"},
+				},
+			}))
+
+			Expect(entries[1].ID).To(Equal(LimitRangeResourceID))
+			Expect(entries[1].Object["spec"]).To(Equal(map[string]any{
+				"limits": []any{map[string]any{
+					"type":           "Container",
+					"defaultRequest": map[string]any{"cpu": "100m"},
+					"max":            map[string]any{"memory": "1Gi"},
+				}},
+			}))
+		})
+
+		It("omits the LimitRange when only hard limits are set", func() {
+			entries, err := buildQuotaEntries(&openchoreov1alpha1.ProjectQuota{
+				Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("5")},
+			}, metadataCtx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].ID).To(Equal(QuotaResourceID))
+		})
+	})
+
+	It("detects rendered entries using a reserved ID", func() {
+		Expect(reservedQuotaID([]projectpipeline.RenderedEntry{{ID: "namespace"}})).To(BeEmpty())
+		Expect(reservedQuotaID([]projectpipeline.RenderedEntry{{ID: "namespace"}, {ID: LimitRangeResourceID}})).
+			To(Equal(LimitRangeResourceID))
+	})
+
+	Context("evaluateQuota", func() {
+		newBinding := func(quota *openchoreov1alpha1.ProjectQuota) *openchoreov1alpha1.ProjectReleaseBinding {
+			return &openchoreov1alpha1.ProjectReleaseBinding{
+				Spec: openchoreov1alpha1.ProjectReleaseBindingSpec{Quota: quota},
+				Status: openchoreov1alpha1.ProjectReleaseBindingStatus{
+					Quota: &openchoreov1alpha1.ProjectQuotaStatus{},
+				},
+			}
+		}
+		renderedRelease := func(status string) *openchoreov1alpha1.RenderedRelease {
+			return &openchoreov1alpha1.RenderedRelease{
+				Status: openchoreov1alpha1.RenderedReleaseStatus{
+					Resources: []openchoreov1alpha1.RenderedManifestStatus{
+						{ID: "namespace", Kind: "Namespace"},
+						{ID: QuotaResourceID, Kind: "ResourceQuota", Status: &runtime.RawExtension{Raw: []byte(status)}},
+					},
+				},
+			}
+		}
+		quota := &openchoreov1alpha1.ProjectQuota{
+			Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")},
+		}
+
+		It("reports the observed hard limits and usage", func() {
+			binding := newBinding(quota)
+			evaluateQuota(binding, renderedRelease(`{"hard":{"pods":"10"},"used":{"pods":"3"}}`))
+			Expect(binding.Status.Quota).NotTo(BeNil())
+			Expect(binding.Status.Quota.Hard.Pods().String()).To(Equal("10"))
+			Expect(binding.Status.Quota.Used.Pods().String()).To(Equal("3"))
+		})
+
+		It("clears the status until the quota reports usage", func() {
+			binding := newBinding(quota)
+			evaluateQuota(binding, renderedRelease(`{}`))
+			Expect(binding.Status.Quota).To(BeNil())
+		})
+
+		It("clears the status when the quota is removed", func() {
+			binding := newBinding(nil)
+			evaluateQuota(binding, renderedRelease(`{"hard":{"pods":"10"},"used":{"pods":"3"}}`))
+			Expect(binding.Status.Quota).To(BeNil())
+		})
+	})
+})
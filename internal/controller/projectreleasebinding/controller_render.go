@@ -65,7 +65,19 @@ func (r *Reconciler) renderAndEmit(
 		return nil, nil
 	}
 
-	manifests, err := convertEntriesToManifests(output.Entries)
+	if id := reservedQuotaID(output.Entries); id != "" {
+		markSyncedFalse(binding, ReasonRenderingFailed,
+			fmt.Sprintf("Resource ID %q is reserved for spec.quota", id))
+		return nil, nil
+	}
+	quotaEntries, err := buildQuotaEntries(binding.Spec.Quota, metadataCtx)
+	if err != nil {
+		markSyncedFalse(binding, ReasonRenderingFailed,
+			fmt.Sprintf("Failed to render quota: %v", err))
+		return nil, nil
+	}
+
+	manifests, err := convertEntriesToManifests(append(output.Entries, quotaEntries...))
 	if err != nil {
 		markSyncedFalse(binding, ReasonRenderingFailed,
 			fmt.Sprintf("Failed to encode rendered manifests: %v", err))
@@ -382,6 +382,18 @@ func (r *WorkflowPlaneResult) GetSecretStoreName() string {
 	return ""
 }
 
+// GetBuildCache returns the build cache configuration of the workflow plane (either WorkflowPlane or ClusterWorkflowPlane).
+// Returns nil if no build cache is configured.
+func (r *WorkflowPlaneResult) GetBuildCache() *openchoreov1alpha1.BuildCacheConfig {
+	if r.WorkflowPlane != nil {
+		return r.WorkflowPlane.Spec.BuildCache
+	}
+	if r.ClusterWorkflowPlane != nil {
+		return r.ClusterWorkflowPlane.Spec.BuildCache
+	}
+	return nil
+}
+
 // GetObservabilityPlane resolves the observability plane for this workflow plane result.
 func (r *WorkflowPlaneResult) GetObservabilityPlane(ctx context.Context, c client.Client) (*ObservabilityPlaneResult, error) {
 	if r.WorkflowPlane != nil {
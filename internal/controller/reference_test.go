@@ -1064,6 +1064,21 @@ func TestWorkflowPlaneResult_GetSecretStoreName(t *testing.T) {
 	}
 }
 
+func TestWorkflowPlaneResult_GetBuildCache(t *testing.T) {
+	cache := &openchoreov1alpha1.BuildCacheConfig{
+		Type:     openchoreov1alpha1.BuildCacheTypeRegistry,
+		Registry: &openchoreov1alpha1.RegistryBuildCache{Repository: "registry.local/cache"},
+	}
+
+	assert.Same(t, cache, (&WorkflowPlaneResult{WorkflowPlane: &openchoreov1alpha1.WorkflowPlane{
+		Spec: openchoreov1alpha1.WorkflowPlaneSpec{BuildCache: cache},
+	}}).GetBuildCache())
+	assert.Same(t, cache, (&WorkflowPlaneResult{ClusterWorkflowPlane: &openchoreov1alpha1.ClusterWorkflowPlane{
+		Spec: openchoreov1alpha1.ClusterWorkflowPlaneSpec{BuildCache: cache},
+	}}).GetBuildCache())
+	assert.Nil(t, (&WorkflowPlaneResult{}).GetBuildCache())
+}
+
 func TestResolveWorkflow(t *testing.T) {
 	scheme := newScheme(t)
 	ctx := context.Background()
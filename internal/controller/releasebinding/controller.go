@@ -33,6 +33,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/networkpolicy"
 	componentpipeline "github.com/openchoreo/openchoreo/internal/pipeline/component"
 	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+	componentvalidation "github.com/openchoreo/openchoreo/internal/validation/component"
 )
 
 const (
@@ -107,6 +108,9 @@ func networkPolicyProviderFromDataPlane(dp *controller.DataPlaneResult) networkp
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=clusterobservabilityplanes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=renderedreleases,verbs=get;list;watch;create;update;patch;delete;deletecollection
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=secretreferences,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=customdomains,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=connections,verbs=get;list;watch
+// +kubebuilder:rbac:groups=openchoreo.dev,resources=networkpolicyrules,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop
@@ -188,6 +192,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		return ctrl.Result{}, nil
 	}
 
+	// Record the release in the binding's history
+	if releaseBinding.Spec.State != openchoreov1alpha1.ReleaseStateUndeploy {
+		if err := recordReleaseHistory(releaseBinding, componentRelease, metav1.Now()); err != nil {
+			logger.Error(err, "Failed to record release history")
+		}
+	}
+
 	// Fetch Environment object
 	environment := &openchoreov1alpha1.Environment{}
 	if err := r.Get(ctx, types.NamespacedName{
@@ -281,6 +292,13 @@ func (r *Reconciler) validateComponentRelease(componentRelease *openchoreov1alph
 			componentRelease.Spec.Owner.ProjectName, componentRelease.Spec.Owner.ComponentName)
 	}
 
+	// Check the workload's autoscaling configuration against the component type limits
+	componentTypeSpec := &componentRelease.Spec.ComponentType.Spec
+	if err := componentvalidation.ValidateAutoscaling(componentRelease.Spec.Workload.Autoscaling,
+		componentTypeSpec.WorkloadType, componentTypeSpec.Autoscaling); err != nil {
+		return fmt.Errorf("invalid autoscaling configuration: %w", err)
+	}
+
 	return nil
 }
 
@@ -456,6 +474,7 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 	// Handle undeploy state - delete Release resources if they exist
 	if releaseBinding.Spec.State == openchoreov1alpha1.ReleaseStateUndeploy {
 		releaseBinding.Status.Endpoints = nil
+		releaseBinding.Status.Rollout = nil
 		return r.handleUndeploy(ctx, releaseBinding, componentRelease)
 	}
 
@@ -486,6 +505,13 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 	snapshotTraits := buildTraitsFromRelease(componentRelease)
 	snapshotWorkload := buildWorkloadFromRelease(componentRelease)
 
+	// Add the dependencies declared by the component's Connections to those of its workload
+	connections, err := r.listConnections(ctx, releaseBinding)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	mergeConnections(snapshotWorkload, connections)
+
 	// Collect all SecretReferences needed for rendering (must be done after workload merge)
 	secretReferences, err := r.collectSecretReferences(ctx, snapshotWorkload, releaseBinding)
 	if err != nil {
@@ -512,6 +538,15 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 
 	// Pre-compute connection items with per-item env vars from resolved connections
 	dependencyItems := buildConnectionItems(releaseBinding, snapshotWorkload.Spec.GetDependencyEndpoints())
+	externalItems, err := buildExternalConnectionItems(snapshotWorkload.Spec.GetDependencyExternal())
+	if err != nil {
+		msg := fmt.Sprintf("Invalid external connection: %v", err)
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+			ReasonInvalidReleaseConfiguration, msg)
+		logger.Error(err, "External connection validation failed")
+		return ctrl.Result{}, nil
+	}
+	dependencyItems = append(dependencyItems, externalItems...)
 
 	// Resolve resource dependencies inline: build targets, resolve provider RRB outputs.
 	resourceDeps := snapshotWorkload.Spec.GetDependencyResources()
@@ -566,19 +601,55 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 		}
 	}
 
-	// Inject per-component network policies into dataplane resources.
+	// Inject per-component network policies into dataplane resources, adjusted by the
+	// org-level NetworkPolicyRules targeting the project.
 	// The provider is determined by the "openchoreo.dev/networkpolicyprovider" annotation on the DataPlane CR.
+	networkPolicyRules, err := r.listNetworkPolicyRules(ctx, releaseBinding)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
 	componentNetpols := networkpolicy.MakeComponentPolicies(networkpolicy.ComponentPolicyParams{
 		Namespace:     metadataContext.Namespace,
 		CPNamespace:   metadataContext.ComponentNamespace,
 		Environment:   metadataContext.EnvironmentName,
+		ProjectName:   metadataContext.ProjectName,
 		ComponentName: metadataContext.ComponentName,
 		PodSelectors:  metadataContext.PodSelectors,
 		Endpoints:     snapshotWorkload.Spec.Endpoints,
 		Provider:      networkPolicyProviderFromDataPlane(dataPlaneResult),
+		Rules:         networkPolicyRules,
 	})
 	dataPlaneResources = append(dataPlaneResources, componentNetpols...)
 
+	// Hand the workload's replica count over to an HPA or KEDA ScaledObject when autoscaling is configured
+	dataPlaneResources, err = injectAutoscaling(dataPlaneResources, snapshotWorkload.Spec.Autoscaling)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to configure autoscaling: %v", err)
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+			ReasonRenderingFailed, msg)
+		logger.Error(err, "Failed to configure autoscaling")
+		return ctrl.Result{}, fmt.Errorf("failed to configure autoscaling: %w", err)
+	}
+
+	// Serve the verified custom domains of each endpoint on its external routes
+	customDomains, err := r.listVerifiedCustomDomains(ctx, releaseBinding)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	appliedCustomDomains := injectCustomDomains(dataPlaneResources, customDomains)
+
+	// Issue managed TLS certificates for the endpoint hosts when the environment configures them
+	dataPlaneResources = injectCertificates(dataPlaneResources, environment.Spec.TLS)
+
+	// Attach pull secrets for the private registries the workload images come from
+	if dataPlane.Spec.SecretStoreRef != nil {
+		registryCredentials, err := r.listRegistryCredentials(ctx, releaseBinding, dataPlaneResult)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		dataPlaneResources = injectPullSecrets(dataPlaneResources, registryCredentials, dataPlane.Spec.SecretStoreRef.Name)
+	}
+
 	// Convert filtered dataplane resources to Release format
 	dataPlaneReleaseResources, err := r.convertToReleaseResources(dataPlaneResources)
 	if err != nil {
@@ -601,6 +672,24 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 
 	// Create or update dataplane Release
 	dpReleaseName := makeDataPlaneReleaseName(componentRelease, releaseBinding)
+
+	// Apply the binding's rollout strategy. During a Canary or BlueGreen rollout the stable
+	// workloads are taken from the current dataplane Release.
+	currentRelease := &openchoreov1alpha1.RenderedRelease{}
+	if err := r.Get(ctx, types.NamespacedName{Name: dpReleaseName, Namespace: releaseBinding.Namespace}, currentRelease); client.IgnoreNotFound(err) != nil {
+		logger.Error(err, "Failed to get dataplane Release", "release", dpReleaseName)
+		return ctrl.Result{}, err
+	}
+	rolloutRequeueAfter := advanceRollout(releaseBinding, hasStableWorkloads(currentRelease.Spec.Resources), metav1.Now())
+	dataPlaneManifests, err := r.applyRollout(releaseBinding.Status.Rollout, dataPlaneReleaseResources, currentRelease.Spec.Resources)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to apply rollout strategy: %v", err)
+		controller.MarkFalseCondition(releaseBinding, ConditionReleaseSynced,
+			ReasonRenderingFailed, msg)
+		logger.Error(err, "Failed to apply rollout strategy")
+		return ctrl.Result{}, fmt.Errorf("failed to apply rollout strategy: %w", err)
+	}
+
 	dataPlaneRelease := &openchoreov1alpha1.RenderedRelease{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      dpReleaseName,
@@ -643,7 +732,7 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 			},
 			EnvironmentName: releaseBinding.Spec.Environment,
 			TargetPlane:     openchoreov1alpha1.TargetPlaneDataPlane,
-			Resources:       dataPlaneReleaseResources,
+			Resources:       dataPlaneManifests,
 		}
 
 		return controllerutil.SetControllerReference(releaseBinding, dataPlaneRelease, r.Scheme)
@@ -694,6 +783,16 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 		releaseBinding.Status.Endpoints,
 	)
 
+	releaseBinding.Status.Endpoints = setEndpointCustomDomains(releaseBinding.Status.Endpoints, appliedCustomDomains)
+
+	// Report the managed certificates of each endpoint and alert on pending, overdue or expired ones.
+	releaseBinding.Status.Endpoints = resolveCertificateStatuses(
+		releaseBinding.Status.Endpoints,
+		dataPlaneReleaseResources,
+		dataPlaneRelease.Status.Resources,
+	)
+	certificateRequeueAfter := setCertificatesCondition(releaseBinding, metav1.Now())
+
 	// Connection stability guard: check after endpoint URL resolution so that
 	// this component's own endpoint URLs are always kept up to date (unblocking
 	// other components' connections), but requeue before marking ReleaseSynced
@@ -751,7 +850,8 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, releaseBinding *openc
 		return ctrl.Result{}, fmt.Errorf("failed to set resources ready status: %w", err)
 	}
 
-	return ctrl.Result{}, nil
+	// Requeue when the current step of a rollout ends or a certificate is due for renewal
+	return ctrl.Result{RequeueAfter: earliestRequeue(rolloutRequeueAfter, certificateRequeueAfter)}, nil
 }
 
 // handleUndeploy deletes the Release resources when ReleaseState is Undeploy.
@@ -1574,6 +1674,20 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.findReleaseBindingsForClusterDataPlane),
 			builder.WithPredicates(dataPlaneRenderInputsChangedPredicate()),
 		).
+		Watches(
+			&openchoreov1alpha1.CustomDomain{},
+			handler.EnqueueRequestsFromMapFunc(r.findReleaseBindingsForCustomDomain),
+			builder.WithPredicates(customDomainServingChangedPredicate()),
+		).
+		Watches(
+			&openchoreov1alpha1.Connection{},
+			handler.EnqueueRequestsFromMapFunc(r.findReleaseBindingsForConnection),
+			builder.WithPredicates(connectionSpecChangedPredicate()),
+		).
+		Watches(
+			&openchoreov1alpha1.NetworkPolicyRule{},
+			handler.EnqueueRequestsFromMapFunc(r.findReleaseBindingsForNetworkPolicyRule),
+		).
 		Named("releasebinding").
 		Complete(r)
 }
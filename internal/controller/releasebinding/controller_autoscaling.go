@@ -0,0 +1,157 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+const (
+	statefulSetKind = "StatefulSet"
+
+	horizontalPodAutoscalerAPIVersion = "autoscaling/v2"
+	horizontalPodAutoscalerKind       = "HorizontalPodAutoscaler"
+	scaledObjectAPIVersion            = "keda.sh/v1alpha1"
+	scaledObjectKind                  = "ScaledObject"
+)
+
+// injectAutoscaling hands the replica count of the component's Deployment or StatefulSet over to
+// an autoscaler: the workload's replicas are removed and a HorizontalPodAutoscaler, or a KEDA
+// ScaledObject when event-driven triggers are configured, is appended to the resources.
+func injectAutoscaling(resources []map[string]any, autoscaling *openchoreov1alpha1.AutoscalingConfig) ([]map[string]any, error) {
+	if autoscaling == nil {
+		return resources, nil
+	}
+
+	var target *unstructured.Unstructured
+	for _, resource := range resources {
+		obj := &unstructured.Unstructured{Object: resource}
+		if obj.GetAPIVersion() == "apps/v1" && (obj.GetKind() == deploymentKind || obj.GetKind() == statefulSetKind) {
+			target = obj
+			break
+		}
+	}
+	if target == nil {
+		return nil, fmt.Errorf("no Deployment or StatefulSet rendered to autoscale")
+	}
+	unstructured.RemoveNestedField(target.Object, "spec", "replicas")
+
+	scaleTargetRef := map[string]any{
+		"apiVersion": target.GetAPIVersion(),
+		"kind":       target.GetKind(),
+		"name":       target.GetName(),
+	}
+	var autoscaler map[string]any
+	if len(autoscaling.Triggers) > 0 {
+		autoscaler = makeScaledObject(autoscaling, scaleTargetRef)
+	} else {
+		autoscaler = makeHorizontalPodAutoscaler(autoscaling, scaleTargetRef)
+	}
+
+	metadata := map[string]any{
+		"name":      target.GetName(),
+		"namespace": target.GetNamespace(),
+	}
+	if workloadLabels := target.GetLabels(); len(workloadLabels) > 0 {
+		autoscalerLabels := make(map[string]any, len(workloadLabels))
+		for k, v := range workloadLabels {
+			autoscalerLabels[k] = v
+		}
+		metadata["labels"] = autoscalerLabels
+	}
+	autoscaler["metadata"] = metadata
+
+	return append(resources, autoscaler), nil
+}
+
+// makeHorizontalPodAutoscaler builds a HorizontalPodAutoscaler scaling on the configured
+// resource utilization targets.
+func makeHorizontalPodAutoscaler(autoscaling *openchoreov1alpha1.AutoscalingConfig, scaleTargetRef map[string]any) map[string]any {
+	spec := map[string]any{
+		"scaleTargetRef": scaleTargetRef,
+		"minReplicas":    int64(autoscaling.GetMinReplicas()),
+		"maxReplicas":    int64(autoscaling.MaxReplicas),
+	}
+	var metrics []any
+	for _, target := range resourceTargets(autoscaling) {
+		metrics = append(metrics, map[string]any{
+			"type": "Resource",
+			"resource": map[string]any{
+				"name": target.resource,
+				"target": map[string]any{
+					"type":               "Utilization",
+					"averageUtilization": int64(target.utilization),
+				},
+			},
+		})
+	}
+	if len(metrics) > 0 {
+		spec["metrics"] = metrics
+	}
+	return map[string]any{
+		"apiVersion": horizontalPodAutoscalerAPIVersion,
+		"kind":       horizontalPodAutoscalerKind,
+		"spec":       spec,
+	}
+}
+
+// makeScaledObject builds a KEDA ScaledObject scaling on the configured event-driven triggers
+// and resource utilization targets.
+func makeScaledObject(autoscaling *openchoreov1alpha1.AutoscalingConfig, scaleTargetRef map[string]any) map[string]any {
+	var triggers []any
+	for _, target := range resourceTargets(autoscaling) {
+		triggers = append(triggers, map[string]any{
+			"type":       target.resource,
+			"metricType": "Utilization",
+			"metadata":   map[string]any{"value": strconv.Itoa(int(target.utilization))},
+		})
+	}
+	for _, trigger := range autoscaling.Triggers {
+		metadata := make(map[string]any, len(trigger.Metadata))
+		for k, v := range trigger.Metadata {
+			metadata[k] = v
+		}
+		t := map[string]any{
+			"type":     trigger.Type,
+			"metadata": metadata,
+		}
+		if trigger.AuthenticationRef != "" {
+			t["authenticationRef"] = map[string]any{"name": trigger.AuthenticationRef}
+		}
+		triggers = append(triggers, t)
+	}
+	return map[string]any{
+		"apiVersion": scaledObjectAPIVersion,
+		"kind":       scaledObjectKind,
+		"spec": map[string]any{
+			"scaleTargetRef":  scaleTargetRef,
+			"minReplicaCount": int64(autoscaling.GetMinReplicas()),
+			"maxReplicaCount": int64(autoscaling.MaxReplicas),
+			"triggers":        triggers,
+		},
+	}
+}
+
+// resourceTarget is a utilization target for a container resource.
+type resourceTarget struct {
+	resource    string
+	utilization int32
+}
+
+// resourceTargets returns the CPU and memory utilization targets of an autoscaling configuration.
+func resourceTargets(autoscaling *openchoreov1alpha1.AutoscalingConfig) []resourceTarget {
+	var targets []resourceTarget
+	if autoscaling.TargetCPUUtilizationPercentage != nil {
+		targets = append(targets, resourceTarget{resource: "cpu", utilization: *autoscaling.TargetCPUUtilizationPercentage})
+	}
+	if autoscaling.TargetMemoryUtilizationPercentage != nil {
+		targets = append(targets, resourceTarget{resource: "memory", utilization: *autoscaling.TargetMemoryUtilizationPercentage})
+	}
+	return targets
+}
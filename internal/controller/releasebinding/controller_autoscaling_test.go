@@ -0,0 +1,120 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// autoscalingResources returns a rendered Deployment with three replicas and a Service.
+func autoscalingResources() []map[string]any {
+	return []map[string]any{
+		{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]any{
+				"name":      "api",
+				"namespace": "dp-ns",
+				"labels":    map[string]any{"openchoreo.dev/component": "api"},
+			},
+			"spec": map[string]any{"replicas": int64(3)},
+		},
+		{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata":   map[string]any{"name": "api", "namespace": "dp-ns"},
+		},
+	}
+}
+
+func TestInjectAutoscaling(t *testing.T) {
+	t.Run("without autoscaling the resources are unchanged", func(t *testing.T) {
+		out, err := injectAutoscaling(autoscalingResources(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, autoscalingResources(), out)
+	})
+
+	t.Run("resource targets render a HorizontalPodAutoscaler", func(t *testing.T) {
+		out, err := injectAutoscaling(autoscalingResources(), &openchoreov1alpha1.AutoscalingConfig{
+			MinReplicas:                    ptr.To[int32](2),
+			MaxReplicas:                    6,
+			TargetCPUUtilizationPercentage: ptr.To[int32](70),
+		})
+		require.NoError(t, err)
+		require.Len(t, out, 3)
+
+		_, found, _ := unstructured.NestedFieldNoCopy(out[0], "spec", "replicas")
+		assert.False(t, found, "the autoscaler owns the replica count")
+
+		hpa := &unstructured.Unstructured{Object: out[2]}
+		assert.Equal(t, "autoscaling/v2", hpa.GetAPIVersion())
+		assert.Equal(t, "HorizontalPodAutoscaler", hpa.GetKind())
+		assert.Equal(t, "api", hpa.GetName())
+		assert.Equal(t, "dp-ns", hpa.GetNamespace())
+		assert.Equal(t, map[string]string{"openchoreo.dev/component": "api"}, hpa.GetLabels())
+
+		targetName, _, _ := unstructured.NestedString(hpa.Object, "spec", "scaleTargetRef", "name")
+		assert.Equal(t, "api", targetName)
+		minReplicas, _, _ := unstructured.NestedInt64(hpa.Object, "spec", "minReplicas")
+		maxReplicas, _, _ := unstructured.NestedInt64(hpa.Object, "spec", "maxReplicas")
+		assert.Equal(t, int64(2), minReplicas)
+		assert.Equal(t, int64(6), maxReplicas)
+
+		metrics, _, _ := unstructured.NestedSlice(hpa.Object, "spec", "metrics")
+		require.Len(t, metrics, 1)
+		name, _, _ := unstructured.NestedString(metrics[0].(map[string]any), "resource", "name")
+		utilization, _, _ := unstructured.NestedInt64(metrics[0].(map[string]any), "resource", "target", "averageUtilization")
+		assert.Equal(t, "cpu", name)
+		assert.Equal(t, int64(70), utilization)
+	})
+
+	t.Run("event-driven triggers render a KEDA ScaledObject", func(t *testing.T) {
+		out, err := injectAutoscaling(autoscalingResources(), &openchoreov1alpha1.AutoscalingConfig{
+			MinReplicas:                       ptr.To[int32](0),
+			MaxReplicas:                       10,
+			TargetMemoryUtilizationPercentage: ptr.To[int32](80),
+			Triggers: []openchoreov1alpha1.AutoscalingTrigger{{
+				Type:              "kafka",
+				Metadata:          map[string]string{"topic": "orders", "lagThreshold": "50"},
+				AuthenticationRef: "kafka-auth",
+			}},
+		})
+		require.NoError(t, err)
+		require.Len(t, out, 3)
+
+		scaledObject := &unstructured.Unstructured{Object: out[2]}
+		assert.Equal(t, "keda.sh/v1alpha1", scaledObject.GetAPIVersion())
+		assert.Equal(t, "ScaledObject", scaledObject.GetKind())
+		minReplicas, _, _ := unstructured.NestedInt64(scaledObject.Object, "spec", "minReplicaCount")
+		maxReplicas, _, _ := unstructured.NestedInt64(scaledObject.Object, "spec", "maxReplicaCount")
+		assert.Equal(t, int64(0), minReplicas)
+		assert.Equal(t, int64(10), maxReplicas)
+
+		triggers, _, _ := unstructured.NestedSlice(scaledObject.Object, "spec", "triggers")
+		require.Len(t, triggers, 2)
+		assert.Equal(t, map[string]any{
+			"type":       "memory",
+			"metricType": "Utilization",
+			"metadata":   map[string]any{"value": "80"},
+		}, triggers[0])
+		assert.Equal(t, map[string]any{
+			"type":              "kafka",
+			"metadata":          map[string]any{"topic": "orders", "lagThreshold": "50"},
+			"authenticationRef": map[string]any{"name": "kafka-auth"},
+		}, triggers[1])
+	})
+
+	t.Run("fails without a workload to scale", func(t *testing.T) {
+		_, err := injectAutoscaling(autoscalingResources()[1:], &openchoreov1alpha1.AutoscalingConfig{MaxReplicas: 3})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no Deployment or StatefulSet")
+	})
+}
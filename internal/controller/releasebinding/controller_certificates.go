@@ -0,0 +1,256 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+const (
+	certManagerGroup      = "cert-manager.io"
+	certificateAPIVersion = "cert-manager.io/v1"
+	certificateKind       = "Certificate"
+
+	certificateSecretSuffix = "-tls"
+	defaultIssuerKind       = "ClusterIssuer"
+)
+
+// certificateRouteKinds lists the route kinds whose hosts get a managed certificate. TLSRoutes
+// are excluded as the application, not the gateway, terminates TLS for them.
+var certificateRouteKinds = map[string]bool{
+	httpRouteKind: true,
+	grpcRouteKind: true,
+}
+
+// injectCertificates appends a cert-manager Certificate for the hosts of every endpoint route
+// when the environment configures managed TLS. Each Certificate takes the name, namespace and
+// labels of its route and stores the issued certificate in the "<route>-tls" Secret.
+func injectCertificates(resources []map[string]any, tls *openchoreov1alpha1.EnvironmentTLSSpec) []map[string]any {
+	if tls == nil {
+		return resources
+	}
+
+	issuerKind := tls.IssuerRef.Kind
+	if issuerKind == "" {
+		issuerKind = defaultIssuerKind
+	}
+
+	var certificates []map[string]any
+	for _, resource := range resources {
+		obj := &unstructured.Unstructured{Object: resource}
+		if obj.GroupVersionKind().Group != gatewayAPIGroup || !certificateRouteKinds[obj.GetKind()] {
+			continue
+		}
+		if obj.GetLabels()[labels.LabelKeyEndpointName] == "" {
+			continue
+		}
+		hostnames, _, _ := unstructured.NestedStringSlice(obj.Object, "spec", "hostnames")
+		if len(hostnames) == 0 {
+			continue
+		}
+
+		dnsNames := make([]any, 0, len(hostnames))
+		for _, hostname := range hostnames {
+			dnsNames = append(dnsNames, hostname)
+		}
+		spec := map[string]any{
+			"secretName": obj.GetName() + certificateSecretSuffix,
+			"dnsNames":   dnsNames,
+			"issuerRef": map[string]any{
+				"name":  tls.IssuerRef.Name,
+				"kind":  issuerKind,
+				"group": certManagerGroup,
+			},
+		}
+		if tls.Duration != nil {
+			spec["duration"] = tls.Duration.Duration.String()
+		}
+		if tls.RenewBefore != nil {
+			spec["renewBefore"] = tls.RenewBefore.Duration.String()
+		}
+
+		metadata := map[string]any{
+			"name":      obj.GetName(),
+			"namespace": obj.GetNamespace(),
+		}
+		if routeLabels := obj.GetLabels(); len(routeLabels) > 0 {
+			certLabels := make(map[string]any, len(routeLabels))
+			for k, v := range routeLabels {
+				certLabels[k] = v
+			}
+			metadata["labels"] = certLabels
+		}
+
+		certificates = append(certificates, map[string]any{
+			"apiVersion": certificateAPIVersion,
+			"kind":       certificateKind,
+			"metadata":   metadata,
+			"spec":       spec,
+		})
+	}
+	return append(resources, certificates...)
+}
+
+// certificateObservedStatus is the subset of a cert-manager Certificate status the binding reports.
+type certificateObservedStatus struct {
+	Conditions  []metav1.Condition `json:"conditions,omitempty"`
+	NotAfter    *metav1.Time       `json:"notAfter,omitempty"`
+	RenewalTime *metav1.Time       `json:"renewalTime,omitempty"`
+}
+
+// resolveCertificateStatuses attaches the managed certificates in the release resources to the
+// endpoints they were issued for, reading readiness and expiry from the observed status of the
+// Certificates in the data plane.
+func resolveCertificateStatuses(
+	endpoints []openchoreov1alpha1.EndpointURLStatus,
+	resources []openchoreov1alpha1.RenderedManifest,
+	observed []openchoreov1alpha1.RenderedManifestStatus,
+) []openchoreov1alpha1.EndpointURLStatus {
+	for i := range endpoints {
+		endpoints[i].Certificates = nil
+	}
+
+	endpointIndex := make(map[string]int, len(endpoints))
+	for i, ep := range endpoints {
+		endpointIndex[ep.Name] = i
+	}
+
+	for _, res := range resources {
+		if res.Object == nil || len(res.Object.Raw) == 0 {
+			continue
+		}
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(res.Object.Raw); err != nil {
+			continue
+		}
+		if obj.GroupVersionKind().Group != certManagerGroup || obj.GetKind() != certificateKind {
+			continue
+		}
+		idx, ok := endpointIndex[obj.GetLabels()[labels.LabelKeyEndpointName]]
+		if !ok {
+			continue
+		}
+
+		secretName, _, _ := unstructured.NestedString(obj.Object, "spec", "secretName")
+		dnsNames, _, _ := unstructured.NestedStringSlice(obj.Object, "spec", "dnsNames")
+		status := openchoreov1alpha1.EndpointCertificateStatus{
+			Name:       obj.GetName(),
+			SecretName: secretName,
+			Visibility: openchoreov1alpha1.EndpointVisibility(obj.GetLabels()[labels.LabelKeyEndpointVisibility]),
+			DNSNames:   dnsNames,
+			Message:    "Certificate has not been issued yet",
+		}
+		if certStatus := findCertificateStatus(observed, obj.GetName(), obj.GetNamespace()); certStatus != nil {
+			status.NotAfter = certStatus.NotAfter
+			status.RenewalTime = certStatus.RenewalTime
+			if ready := meta.FindStatusCondition(certStatus.Conditions, "Ready"); ready != nil {
+				status.Ready = ready.Status == metav1.ConditionTrue
+				status.Message = ready.Message
+			}
+		}
+		if status.Ready {
+			status.Message = ""
+		}
+		endpoints[idx].Certificates = append(endpoints[idx].Certificates, status)
+	}
+
+	for i := range endpoints {
+		sort.Slice(endpoints[i].Certificates, func(a, b int) bool {
+			return endpoints[i].Certificates[a].Name < endpoints[i].Certificates[b].Name
+		})
+	}
+	return endpoints
+}
+
+// findCertificateStatus returns the observed status of the named Certificate, or nil when the
+// data plane has not reported it yet.
+func findCertificateStatus(observed []openchoreov1alpha1.RenderedManifestStatus, name, namespace string) *certificateObservedStatus {
+	for _, res := range observed {
+		if res.Group != certManagerGroup || res.Kind != certificateKind || res.Name != name || res.Namespace != namespace {
+			continue
+		}
+		if res.Status == nil || len(res.Status.Raw) == 0 {
+			return nil
+		}
+		status := &certificateObservedStatus{}
+		if err := json.Unmarshal(res.Status.Raw, status); err != nil {
+			return nil
+		}
+		return status
+	}
+	return nil
+}
+
+// setCertificatesCondition sets the CertificatesReady condition from the endpoint certificates,
+// alerting on expired certificates first, then on certificates not renewed by their renewal time
+// and finally on certificates not issued yet. The condition is removed when the binding has no
+// managed certificates. It returns how long until the next certificate is due for renewal or
+// expires, or zero when there is none.
+func setCertificatesCondition(releaseBinding *openchoreov1alpha1.ReleaseBinding, now metav1.Time) time.Duration {
+	var total int
+	var expired, overdue, pending []string
+	var next time.Duration
+	track := func(t *metav1.Time) {
+		if t == nil || !t.After(now.Time) {
+			return
+		}
+		if d := t.Sub(now.Time); next == 0 || d < next {
+			next = d
+		}
+	}
+
+	for _, ep := range releaseBinding.Status.Endpoints {
+		for _, cert := range ep.Certificates {
+			total++
+			track(cert.RenewalTime)
+			track(cert.NotAfter)
+			switch {
+			case cert.NotAfter != nil && !cert.NotAfter.After(now.Time):
+				expired = append(expired, cert.Name)
+			case cert.RenewalTime != nil && !cert.RenewalTime.After(now.Time):
+				overdue = append(overdue, cert.Name)
+			case !cert.Ready:
+				pending = append(pending, cert.Name)
+			}
+		}
+	}
+
+	switch {
+	case total == 0:
+		meta.RemoveStatusCondition(&releaseBinding.Status.Conditions, string(ConditionCertificatesReady))
+	case len(expired) > 0:
+		controller.MarkFalseCondition(releaseBinding, ConditionCertificatesReady, ReasonCertificateExpired,
+			fmt.Sprintf("Certificates expired: %s", strings.Join(expired, ", ")))
+	case len(overdue) > 0:
+		controller.MarkFalseCondition(releaseBinding, ConditionCertificatesReady, ReasonCertificateRenewalOverdue,
+			fmt.Sprintf("Certificates not renewed by their renewal time: %s", strings.Join(overdue, ", ")))
+	case len(pending) > 0:
+		controller.MarkFalseCondition(releaseBinding, ConditionCertificatesReady, ReasonCertificatesPending,
+			fmt.Sprintf("%d of %d certificates pending: %s", len(pending), total, strings.Join(pending, ", ")))
+	default:
+		controller.MarkTrueCondition(releaseBinding, ConditionCertificatesReady, ReasonAllCertificatesReady,
+			fmt.Sprintf("All %d certificates ready", total))
+	}
+	return next
+}
+
+// earliestRequeue returns the shorter of two requeue delays, where zero means no requeue.
+func earliestRequeue(a, b time.Duration) time.Duration {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,205 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// certificateRouteResources returns an external HTTPRoute and a TLSRoute for the "api" endpoint
+// and a Service.
+func certificateRouteResources() []map[string]any {
+	return []map[string]any{
+		{
+			"apiVersion": "gateway.networking.k8s.io/v1",
+			"kind":       "HTTPRoute",
+			"metadata": map[string]any{
+				"name":      "api-external",
+				"namespace": "dp-ns",
+				"labels": map[string]any{
+					"openchoreo.dev/endpoint-name":       "api",
+					"openchoreo.dev/endpoint-visibility": "external",
+				},
+			},
+			"spec": map[string]any{"hostnames": []any{"api.example.com"}},
+		},
+		{
+			"apiVersion": "gateway.networking.k8s.io/v1alpha2",
+			"kind":       "TLSRoute",
+			"metadata": map[string]any{
+				"name":      "api-tls",
+				"namespace": "dp-ns",
+				"labels":    map[string]any{"openchoreo.dev/endpoint-name": "api"},
+			},
+			"spec": map[string]any{"hostnames": []any{"tls.example.com"}},
+		},
+		{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata":   map[string]any{"name": "api", "namespace": "dp-ns"},
+		},
+	}
+}
+
+func TestInjectCertificates(t *testing.T) {
+	t.Run("without TLS configuration the resources are unchanged", func(t *testing.T) {
+		out := injectCertificates(certificateRouteResources(), nil)
+		assert.Equal(t, certificateRouteResources(), out)
+	})
+
+	t.Run("HTTP routes get a Certificate for their hosts", func(t *testing.T) {
+		out := injectCertificates(certificateRouteResources(), &openchoreov1alpha1.EnvironmentTLSSpec{
+			IssuerRef:   openchoreov1alpha1.CertificateIssuerRef{Name: "letsencrypt"},
+			Duration:    &metav1.Duration{Duration: 90 * 24 * time.Hour},
+			RenewBefore: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+		})
+		require.Len(t, out, 4, "TLSRoutes terminate TLS in the application and get no Certificate")
+
+		cert := &unstructured.Unstructured{Object: out[3]}
+		assert.Equal(t, "cert-manager.io/v1", cert.GetAPIVersion())
+		assert.Equal(t, "Certificate", cert.GetKind())
+		assert.Equal(t, "api-external", cert.GetName())
+		assert.Equal(t, "dp-ns", cert.GetNamespace())
+		assert.Equal(t, "api", cert.GetLabels()["openchoreo.dev/endpoint-name"])
+
+		secretName, _, _ := unstructured.NestedString(cert.Object, "spec", "secretName")
+		assert.Equal(t, "api-external-tls", secretName)
+		dnsNames, _, _ := unstructured.NestedStringSlice(cert.Object, "spec", "dnsNames")
+		assert.Equal(t, []string{"api.example.com"}, dnsNames)
+		issuerRef, _, _ := unstructured.NestedStringMap(cert.Object, "spec", "issuerRef")
+		assert.Equal(t, map[string]string{"name": "letsencrypt", "kind": "ClusterIssuer", "group": "cert-manager.io"}, issuerRef)
+		duration, _, _ := unstructured.NestedString(cert.Object, "spec", "duration")
+		assert.Equal(t, "2160h0m0s", duration)
+		renewBefore, _, _ := unstructured.NestedString(cert.Object, "spec", "renewBefore")
+		assert.Equal(t, "720h0m0s", renewBefore)
+	})
+
+	t.Run("routes without hosts get no Certificate", func(t *testing.T) {
+		resources := certificateRouteResources()
+		unstructured.RemoveNestedField(resources[0], "spec", "hostnames")
+		out := injectCertificates(resources, &openchoreov1alpha1.EnvironmentTLSSpec{
+			IssuerRef: openchoreov1alpha1.CertificateIssuerRef{Name: "ca", Kind: "Issuer"},
+		})
+		assert.Len(t, out, 3)
+	})
+}
+
+func TestResolveCertificateStatuses(t *testing.T) {
+	resources := injectCertificates(certificateRouteResources(), &openchoreov1alpha1.EnvironmentTLSSpec{
+		IssuerRef: openchoreov1alpha1.CertificateIssuerRef{Name: "letsencrypt"},
+	})
+	manifests := make([]openchoreov1alpha1.RenderedManifest, 0, len(resources))
+	for _, resource := range resources {
+		raw, err := json.Marshal(resource)
+		require.NoError(t, err)
+		manifests = append(manifests, openchoreov1alpha1.RenderedManifest{Object: &runtime.RawExtension{Raw: raw}})
+	}
+	endpoints := []openchoreov1alpha1.EndpointURLStatus{{Name: "api"}, {Name: "admin"}}
+
+	t.Run("certificates not reported by the data plane are pending", func(t *testing.T) {
+		out := resolveCertificateStatuses(endpoints, manifests, nil)
+		require.Len(t, out[0].Certificates, 1)
+		cert := out[0].Certificates[0]
+		assert.Equal(t, "api-external", cert.Name)
+		assert.Equal(t, "api-external-tls", cert.SecretName)
+		assert.Equal(t, openchoreov1alpha1.EndpointVisibilityExternal, cert.Visibility)
+		assert.Equal(t, []string{"api.example.com"}, cert.DNSNames)
+		assert.False(t, cert.Ready)
+		assert.NotEmpty(t, cert.Message)
+		assert.Empty(t, out[1].Certificates)
+	})
+
+	t.Run("issued certificates report their expiry and renewal time", func(t *testing.T) {
+		status, err := json.Marshal(map[string]any{
+			"conditions": []any{map[string]any{
+				"type": "Ready", "status": "True", "reason": "Ready",
+				"message": "Certificate is up to date", "lastTransitionTime": "2026-01-01T00:00:00Z",
+			}},
+			"notAfter":    "2026-04-01T00:00:00Z",
+			"renewalTime": "2026-03-02T00:00:00Z",
+		})
+		require.NoError(t, err)
+		observed := []openchoreov1alpha1.RenderedManifestStatus{{
+			Group: "cert-manager.io", Kind: "Certificate", Name: "api-external", Namespace: "dp-ns",
+			Status: &runtime.RawExtension{Raw: status},
+		}}
+
+		out := resolveCertificateStatuses(endpoints, manifests, observed)
+		require.Len(t, out[0].Certificates, 1)
+		cert := out[0].Certificates[0]
+		assert.True(t, cert.Ready)
+		assert.Empty(t, cert.Message)
+		require.NotNil(t, cert.NotAfter)
+		assert.Equal(t, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), cert.NotAfter.UTC())
+		require.NotNil(t, cert.RenewalTime)
+		assert.Equal(t, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), cert.RenewalTime.UTC())
+	})
+}
+
+func TestSetCertificatesCondition(t *testing.T) {
+	now := metav1.NewTime(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+	at := func(d time.Duration) *metav1.Time {
+		t := metav1.NewTime(now.Add(d))
+		return &t
+	}
+	bindingWith := func(certs ...openchoreov1alpha1.EndpointCertificateStatus) *openchoreov1alpha1.ReleaseBinding {
+		rb := &openchoreov1alpha1.ReleaseBinding{}
+		rb.Status.Endpoints = []openchoreov1alpha1.EndpointURLStatus{{Name: "api", Certificates: certs}}
+		return rb
+	}
+
+	t.Run("no certificates removes the condition", func(t *testing.T) {
+		rb := bindingWith()
+		rb.Status.Conditions = []metav1.Condition{{Type: string(ConditionCertificatesReady), Status: metav1.ConditionTrue}}
+		assert.Zero(t, setCertificatesCondition(rb, now))
+		assert.Nil(t, meta.FindStatusCondition(rb.Status.Conditions, string(ConditionCertificatesReady)))
+	})
+
+	t.Run("ready certificates requeue at the next renewal", func(t *testing.T) {
+		rb := bindingWith(openchoreov1alpha1.EndpointCertificateStatus{
+			Name: "api-external", Ready: true, RenewalTime: at(24 * time.Hour), NotAfter: at(30 * 24 * time.Hour),
+		})
+		assert.Equal(t, 24*time.Hour, setCertificatesCondition(rb, now))
+		cond := meta.FindStatusCondition(rb.Status.Conditions, string(ConditionCertificatesReady))
+		require.NotNil(t, cond)
+		assert.Equal(t, metav1.ConditionTrue, cond.Status)
+		assert.Equal(t, string(ReasonAllCertificatesReady), cond.Reason)
+	})
+
+	t.Run("expired certificates take precedence over overdue and pending ones", func(t *testing.T) {
+		rb := bindingWith(
+			openchoreov1alpha1.EndpointCertificateStatus{Name: "pending"},
+			openchoreov1alpha1.EndpointCertificateStatus{Name: "overdue", Ready: true, RenewalTime: at(-time.Hour), NotAfter: at(time.Hour)},
+			openchoreov1alpha1.EndpointCertificateStatus{Name: "expired", RenewalTime: at(-2 * time.Hour), NotAfter: at(-time.Hour)},
+		)
+		assert.Equal(t, time.Hour, setCertificatesCondition(rb, now))
+		cond := meta.FindStatusCondition(rb.Status.Conditions, string(ConditionCertificatesReady))
+		require.NotNil(t, cond)
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+		assert.Equal(t, string(ReasonCertificateExpired), cond.Reason)
+		assert.Contains(t, cond.Message, "expired")
+	})
+
+	t.Run("overdue renewals are reported before pending certificates", func(t *testing.T) {
+		rb := bindingWith(
+			openchoreov1alpha1.EndpointCertificateStatus{Name: "pending"},
+			openchoreov1alpha1.EndpointCertificateStatus{Name: "overdue", Ready: true, RenewalTime: at(-time.Hour), NotAfter: at(time.Hour)},
+		)
+		setCertificatesCondition(rb, now)
+		cond := meta.FindStatusCondition(rb.Status.Conditions, string(ConditionCertificatesReady))
+		require.NotNil(t, cond)
+		assert.Equal(t, string(ReasonCertificateRenewalOverdue), cond.Reason)
+	})
+}
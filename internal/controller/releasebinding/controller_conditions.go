@@ -31,6 +31,11 @@ const (
 	// matching ResourceReleaseBinding whose outputs are populated.
 	ConditionResourceDependenciesReady controller.ConditionType = "ResourceDependenciesReady"
 
+	// ConditionCertificatesReady indicates that the managed TLS certificates of the endpoint
+	// hosts are issued and not due for renewal. It is only set when the environment configures
+	// managed certificates and does not contribute to the Ready condition.
+	ConditionCertificatesReady controller.ConditionType = "CertificatesReady"
+
 	// ConditionFinalizing indicates that the ReleaseBinding is being finalized (deleted).
 	ConditionFinalizing controller.ConditionType = "Finalizing"
 )
@@ -114,6 +119,17 @@ const (
 	// ReasonNoResourceDependencies indicates there are no resource dependencies to resolve
 	ReasonNoResourceDependencies controller.ConditionReason = "NoResourceDependencies"
 
+	// Certificate condition reasons
+
+	// ReasonAllCertificatesReady indicates all endpoint certificates are issued
+	ReasonAllCertificatesReady controller.ConditionReason = "AllCertificatesReady"
+	// ReasonCertificatesPending indicates one or more endpoint certificates are not yet issued
+	ReasonCertificatesPending controller.ConditionReason = "CertificatesPending"
+	// ReasonCertificateRenewalOverdue indicates an endpoint certificate was not renewed by its renewal time
+	ReasonCertificateRenewalOverdue controller.ConditionReason = "CertificateRenewalOverdue"
+	// ReasonCertificateExpired indicates an endpoint certificate has expired
+	ReasonCertificateExpired controller.ConditionReason = "CertificateExpired"
+
 	// Ready condition reasons
 
 	// ReasonReady indicates the ReleaseBinding is fully ready
@@ -0,0 +1,158 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// listConnections returns the Connections of the binding's component that apply to its
+// environment, sorted by name.
+func (r *Reconciler) listConnections(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding) ([]openchoreov1alpha1.Connection, error) {
+	var connectionList openchoreov1alpha1.ConnectionList
+	if err := r.List(ctx, &connectionList, client.InNamespace(releaseBinding.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list Connections: %w", err)
+	}
+
+	var connections []openchoreov1alpha1.Connection
+	for _, conn := range connectionList.Items {
+		if conn.Spec.Owner.ProjectName != releaseBinding.Spec.Owner.ProjectName ||
+			conn.Spec.Owner.ComponentName != releaseBinding.Spec.Owner.ComponentName {
+			continue
+		}
+		if !conn.DeletionTimestamp.IsZero() || !conn.AppliesTo(releaseBinding.Spec.Environment) {
+			continue
+		}
+		connections = append(connections, conn)
+	}
+	sort.Slice(connections, func(i, j int) bool {
+		return connections[i].Name < connections[j].Name
+	})
+	return connections, nil
+}
+
+// mergeConnections adds the dependencies declared by Connections to the workload, so they are
+// resolved and injected like the dependencies declared in the Workload itself. Workload
+// dependencies take precedence: a Connection consuming an endpoint already consumed at the
+// same visibility, or a Resource already consumed, is ignored.
+func mergeConnections(workload *openchoreov1alpha1.Workload, connections []openchoreov1alpha1.Connection) {
+	if len(connections) == 0 {
+		return
+	}
+
+	// The workload spec is shared with the ComponentRelease, so the dependencies are copied
+	// before they are extended.
+	deps := &openchoreov1alpha1.WorkloadDependencies{}
+	if workload.Spec.Dependencies != nil {
+		deps = workload.Spec.Dependencies.DeepCopy()
+	}
+
+	endpoints := make(map[string]struct{}, len(deps.Endpoints))
+	for _, ep := range deps.Endpoints {
+		endpoints[endpointDependencyKey(workload.Spec.Owner.ProjectName, ep)] = struct{}{}
+	}
+	resources := make(map[string]struct{}, len(deps.Resources))
+	for _, res := range deps.Resources {
+		resources[res.Ref] = struct{}{}
+	}
+
+	for _, conn := range connections {
+		switch {
+		case conn.Spec.Endpoint != nil:
+			key := endpointDependencyKey(workload.Spec.Owner.ProjectName, *conn.Spec.Endpoint)
+			if _, ok := endpoints[key]; ok {
+				continue
+			}
+			endpoints[key] = struct{}{}
+			deps.Endpoints = append(deps.Endpoints, *conn.Spec.Endpoint.DeepCopy())
+		case conn.Spec.Resource != nil:
+			if _, ok := resources[conn.Spec.Resource.Ref]; ok {
+				continue
+			}
+			resources[conn.Spec.Resource.Ref] = struct{}{}
+			deps.Resources = append(deps.Resources, *conn.Spec.Resource.DeepCopy())
+		}
+	}
+	workload.Spec.Dependencies = deps
+}
+
+// endpointDependencyKey identifies the endpoint an endpoint dependency consumes and the
+// visibility it is consumed at.
+func endpointDependencyKey(ownerProject string, conn openchoreov1alpha1.WorkloadConnection) string {
+	project := conn.Project
+	if project == "" {
+		project = ownerProject
+	}
+	return project + "/" + conn.Component + "/" + conn.Name + "/" + conn.Visibility
+}
+
+// findReleaseBindingsForConnection maps a Connection to the ReleaseBindings of its consuming
+// component in the environments it applies to.
+func (r *Reconciler) findReleaseBindingsForConnection(ctx context.Context, obj client.Object) []reconcile.Request {
+	conn, ok := obj.(*openchoreov1alpha1.Connection)
+	if !ok {
+		return nil
+	}
+
+	var bindings openchoreov1alpha1.ReleaseBindingList
+	if err := r.List(ctx, &bindings, client.InNamespace(conn.Namespace),
+		client.MatchingFields{controller.IndexKeyReleaseBindingOwnerComponentName: conn.Spec.Owner.ComponentName}); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list ReleaseBindings for Connection", "connection", conn.Name)
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(bindings.Items))
+	for _, rb := range bindings.Items {
+		if rb.Spec.Owner.ProjectName != conn.Spec.Owner.ProjectName || !conn.AppliesTo(rb.Spec.Environment) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: rb.Namespace, Name: rb.Name},
+		})
+	}
+	return requests
+}
+
+// connectionSpecChangedPredicate passes Connection events that change the dependencies of the
+// consuming component. Status updates by the Connection controller are ignored. Narrowing the
+// environments of a Connection reaches the bindings it no longer applies to through the old
+// object of the update event.
+func connectionSpecChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool {
+			return true
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldConn, ok := e.ObjectOld.(*openchoreov1alpha1.Connection)
+			if !ok {
+				return false
+			}
+			newConn, ok := e.ObjectNew.(*openchoreov1alpha1.Connection)
+			if !ok {
+				return false
+			}
+			return !apiequality.Semantic.DeepEqual(oldConn.Spec, newConn.Spec) ||
+				oldConn.DeletionTimestamp.IsZero() != newConn.DeletionTimestamp.IsZero()
+		},
+		DeleteFunc: func(_ event.DeleteEvent) bool {
+			return true
+		},
+		GenericFunc: func(_ event.GenericEvent) bool {
+			return false
+		},
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func endpointConnection(name, component, visibility string) openchoreov1alpha1.Connection {
+	return openchoreov1alpha1.Connection{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+		Spec: openchoreov1alpha1.ConnectionSpec{
+			Owner: openchoreov1alpha1.ConnectionOwner{ProjectName: "shop", ComponentName: "web"},
+			Endpoint: &openchoreov1alpha1.WorkloadConnection{
+				Component:   component,
+				Name:        "http",
+				Visibility:  visibility,
+				EnvBindings: openchoreov1alpha1.ConnectionEnvBindings{Address: "CONNECTION_URL"},
+			},
+		},
+	}
+}
+
+func resourceConnection(name, ref string) openchoreov1alpha1.Connection {
+	return openchoreov1alpha1.Connection{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+		Spec: openchoreov1alpha1.ConnectionSpec{
+			Owner: openchoreov1alpha1.ConnectionOwner{ProjectName: "shop", ComponentName: "web"},
+			Resource: &openchoreov1alpha1.WorkloadResourceDependency{
+				Ref:          ref,
+				FileBindings: map[string]string{"password": "/etc/db/password"},
+			},
+		},
+	}
+}
+
+func TestMergeConnections(t *testing.T) {
+	newWorkload := func() *openchoreov1alpha1.Workload {
+		return &openchoreov1alpha1.Workload{
+			Spec: openchoreov1alpha1.WorkloadSpec{
+				Owner: openchoreov1alpha1.WorkloadOwner{ProjectName: "shop", ComponentName: "web"},
+				WorkloadTemplateSpec: openchoreov1alpha1.WorkloadTemplateSpec{
+					Dependencies: &openchoreov1alpha1.WorkloadDependencies{
+						Endpoints: []openchoreov1alpha1.WorkloadConnection{{
+							Project:     "shop",
+							Component:   "api",
+							Name:        "http",
+							Visibility:  "project",
+							EnvBindings: openchoreov1alpha1.ConnectionEnvBindings{Address: "API_URL"},
+						}},
+						Resources: []openchoreov1alpha1.WorkloadResourceDependency{{Ref: "cache"}},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("connections are appended to the workload dependencies", func(t *testing.T) {
+		workload := newWorkload()
+		original := workload.Spec.Dependencies
+		mergeConnections(workload, []openchoreov1alpha1.Connection{
+			endpointConnection("web-search", "search", "namespace"),
+			resourceConnection("web-db", "orders-db"),
+		})
+
+		deps := workload.Spec.Dependencies
+		require.Len(t, deps.Endpoints, 2)
+		assert.Equal(t, "search", deps.Endpoints[1].Component)
+		require.Len(t, deps.Resources, 2)
+		assert.Equal(t, "orders-db", deps.Resources[1].Ref)
+		assert.Equal(t, "/etc/db/password", deps.Resources[1].FileBindings["password"])
+		assert.Len(t, original.Endpoints, 1, "the dependencies shared with the release are not modified")
+		assert.Len(t, original.Resources, 1)
+	})
+
+	t.Run("workload dependencies take precedence", func(t *testing.T) {
+		workload := newWorkload()
+		mergeConnections(workload, []openchoreov1alpha1.Connection{
+			endpointConnection("web-api", "api", "project"),
+			resourceConnection("web-cache", "cache"),
+		})
+
+		deps := workload.Spec.Dependencies
+		require.Len(t, deps.Endpoints, 1)
+		assert.Equal(t, "API_URL", deps.Endpoints[0].EnvBindings.Address)
+		assert.Len(t, deps.Resources, 1)
+	})
+
+	t.Run("the same endpoint at another visibility is a separate dependency", func(t *testing.T) {
+		workload := newWorkload()
+		mergeConnections(workload, []openchoreov1alpha1.Connection{endpointConnection("web-api", "api", "namespace")})
+		assert.Len(t, workload.Spec.Dependencies.Endpoints, 2)
+	})
+
+	t.Run("workloads without dependencies", func(t *testing.T) {
+		workload := newWorkload()
+		workload.Spec.Dependencies = nil
+		mergeConnections(workload, nil)
+		assert.Nil(t, workload.Spec.Dependencies)
+
+		mergeConnections(workload, []openchoreov1alpha1.Connection{resourceConnection("web-db", "orders-db")})
+		require.NotNil(t, workload.Spec.Dependencies)
+		assert.Len(t, workload.Spec.Dependencies.Resources, 1)
+	})
+}
+
+func TestListConnections(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, openchoreov1alpha1.AddToScheme(s))
+
+	deleting := resourceConnection("deleting", "orders-db")
+	deleting.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	deleting.Finalizers = []string{"test/finalizer"}
+	devOnly := endpointConnection("dev-only", "api", "project")
+	devOnly.Spec.Environments = []string{"dev"}
+	otherComponent := endpointConnection("other", "api", "project")
+	otherComponent.Spec.Owner.ComponentName = "worker"
+	b := resourceConnection("b", "orders-db")
+	a := endpointConnection("a", "api", "project")
+	a.Spec.Environments = []string{"dev", "prod"}
+
+	cli := fake.NewClientBuilder().WithScheme(s).
+		WithObjects(&deleting, &devOnly, &otherComponent, &b, &a).
+		Build()
+	r := &Reconciler{Client: cli, Scheme: s}
+
+	rb := &openchoreov1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-prod", Namespace: "ns"},
+		Spec: openchoreov1alpha1.ReleaseBindingSpec{
+			Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: "shop", ComponentName: "web"},
+			Environment: "prod",
+		},
+	}
+	connections, err := r.listConnections(context.Background(), rb)
+	require.NoError(t, err)
+	names := make([]string, 0, len(connections))
+	for _, conn := range connections {
+		names = append(names, conn.Name)
+	}
+	assert.Equal(t, []string{"a", "b"}, names)
+}
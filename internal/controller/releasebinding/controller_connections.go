@@ -6,6 +6,8 @@ package releasebinding
 import (
 	"context"
 	"fmt"
+	"net"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -212,7 +214,7 @@ func buildConnectionItems(
 
 		key := connectionKey(releaseBinding.Namespace, project, conn.Component, conn.Name, conn.Visibility)
 		if rc, ok := resolved[key]; ok {
-			item.EnvVars = buildEnvVarsForConnection(conn, rc)
+			item.EnvVars = buildEnvVarsForConnection(conn.EnvBindings, rc.URL)
 		}
 
 		items = append(items, item)
@@ -220,42 +222,99 @@ func buildConnectionItems(
 	return items
 }
 
-// buildEnvVarsForConnection builds the env var list for a single resolved connection.
+// buildExternalConnectionItems builds a ConnectionItem for each connection to an external
+// service. External addresses are static, so every item carries its env vars; an address
+// that cannot be parsed is reported as an error instead.
+func buildExternalConnectionItems(
+	connections []openchoreov1alpha1.WorkloadExternalConnection,
+) ([]pipelinecontext.ConnectionItem, error) {
+	if len(connections) == 0 {
+		return nil, nil
+	}
+	items := make([]pipelinecontext.ConnectionItem, 0, len(connections))
+	for _, conn := range connections {
+		addr, err := parseExternalAddress(conn.Address)
+		if err != nil {
+			return nil, fmt.Errorf("external connection %q: %w", conn.Name, err)
+		}
+		items = append(items, pipelinecontext.ConnectionItem{
+			Endpoint: conn.Name,
+			External: true,
+			EnvVars:  buildEnvVarsForConnection(conn.EnvBindings, addr),
+		})
+	}
+	return items, nil
+}
+
+// parseExternalAddress parses the address of an external service, given either as a URL
+// (scheme://host[:port][/path]) or as host:port, into an EndpointURL.
+func parseExternalAddress(address string) (openchoreov1alpha1.EndpointURL, error) {
+	hostPort := address
+	var result openchoreov1alpha1.EndpointURL
+	if strings.Contains(address, "://") {
+		u, err := url.Parse(address)
+		if err != nil {
+			return result, fmt.Errorf("invalid address %q: %w", address, err)
+		}
+		result.Scheme = u.Scheme
+		result.Path = u.Path
+		hostPort = u.Host
+	}
+
+	host, port := hostPort, ""
+	if h, p, err := net.SplitHostPort(hostPort); err == nil {
+		host, port = h, p
+	}
+	if host == "" {
+		return result, fmt.Errorf("address %q has no host", address)
+	}
+	result.Host = host
+	if port != "" {
+		n, err := strconv.ParseInt(port, 10, 32)
+		if err != nil || n < 1 || n > 65535 {
+			return result, fmt.Errorf("address %q has an invalid port", address)
+		}
+		result.Port = int32(n)
+	}
+	return result, nil
+}
+
+// buildEnvVarsForConnection builds the env var list for a single resolved connection URL.
 func buildEnvVarsForConnection(
-	conn openchoreov1alpha1.WorkloadConnection,
-	rc openchoreov1alpha1.ResolvedConnection,
+	bindings openchoreov1alpha1.ConnectionEnvBindings,
+	endpointURL openchoreov1alpha1.EndpointURL,
 ) []pipelinecontext.EnvVarEntry {
 	envVars := make([]pipelinecontext.EnvVarEntry, 0, 4)
 
-	if conn.EnvBindings.Address != "" {
+	if bindings.Address != "" {
 		envVars = append(envVars, pipelinecontext.EnvVarEntry{
-			Name:  conn.EnvBindings.Address,
-			Value: formatEndpointAddress(rc.URL),
+			Name:  bindings.Address,
+			Value: formatEndpointAddress(endpointURL),
 		})
 	}
 
-	if conn.EnvBindings.Host != "" {
+	if bindings.Host != "" {
 		envVars = append(envVars, pipelinecontext.EnvVarEntry{
-			Name:  conn.EnvBindings.Host,
-			Value: rc.URL.Host,
+			Name:  bindings.Host,
+			Value: endpointURL.Host,
 		})
 	}
 
-	if conn.EnvBindings.Port != "" {
+	if bindings.Port != "" {
 		portStr := ""
-		if rc.URL.Port != 0 {
-			portStr = strconv.Itoa(int(rc.URL.Port))
+		if endpointURL.Port != 0 {
+			portStr = strconv.Itoa(int(endpointURL.Port))
 		}
 		envVars = append(envVars, pipelinecontext.EnvVarEntry{
-			Name:  conn.EnvBindings.Port,
+			Name:  bindings.Port,
 			Value: portStr,
 		})
 	}
 
-	if conn.EnvBindings.BasePath != "" {
+	if bindings.BasePath != "" {
 		envVars = append(envVars, pipelinecontext.EnvVarEntry{
-			Name:  conn.EnvBindings.BasePath,
-			Value: rc.URL.Path,
+			Name:  bindings.BasePath,
+			Value: endpointURL.Path,
 		})
 	}
 
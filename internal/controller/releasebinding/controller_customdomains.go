@@ -0,0 +1,159 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/controller/customdomain"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// listVerifiedCustomDomains returns the verified custom domains of the binding's component in
+// its environment, keyed by endpoint name.
+func (r *Reconciler) listVerifiedCustomDomains(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding) (map[string][]string, error) {
+	var customDomains openchoreov1alpha1.CustomDomainList
+	if err := r.List(ctx, &customDomains, client.InNamespace(releaseBinding.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list CustomDomains: %w", err)
+	}
+
+	domains := make(map[string][]string)
+	for _, cd := range customDomains.Items {
+		if cd.Spec.Owner.ProjectName != releaseBinding.Spec.Owner.ProjectName ||
+			cd.Spec.Owner.ComponentName != releaseBinding.Spec.Owner.ComponentName ||
+			cd.Spec.Environment != releaseBinding.Spec.Environment {
+			continue
+		}
+		if !cd.DeletionTimestamp.IsZero() ||
+			!meta.IsStatusConditionTrue(cd.Status.Conditions, string(customdomain.ConditionDomainVerified)) {
+			continue
+		}
+		domains[cd.Spec.Endpoint] = append(domains[cd.Spec.Endpoint], cd.Spec.Domain)
+	}
+	for endpoint := range domains {
+		sort.Strings(domains[endpoint])
+	}
+	return domains, nil
+}
+
+// injectCustomDomains adds the custom domains of each endpoint to the hosts of its external
+// gateway routes. The generated host stays first so the endpoint's URLs are unchanged, and
+// managed certificates derived from the route hosts cover the custom domains as well. It
+// returns the domains added per endpoint.
+func injectCustomDomains(resources []map[string]any, domains map[string][]string) map[string][]string {
+	applied := make(map[string][]string)
+	if len(domains) == 0 {
+		return applied
+	}
+
+	for _, resource := range resources {
+		obj := &unstructured.Unstructured{Object: resource}
+		if obj.GroupVersionKind().Group != gatewayAPIGroup || routeKindCompat[obj.GetKind()] == nil {
+			continue
+		}
+		routeLabels := obj.GetLabels()
+		if routeLabels[labels.LabelKeyEndpointVisibility] != string(openchoreov1alpha1.EndpointVisibilityExternal) {
+			continue
+		}
+		endpoint := routeLabels[labels.LabelKeyEndpointName]
+		if len(domains[endpoint]) == 0 {
+			continue
+		}
+
+		hostnames, _, _ := unstructured.NestedStringSlice(obj.Object, "spec", "hostnames")
+		existing := make(map[string]bool, len(hostnames))
+		for _, hostname := range hostnames {
+			existing[hostname] = true
+		}
+		for _, domain := range domains[endpoint] {
+			if !existing[domain] {
+				hostnames = append(hostnames, domain)
+				existing[domain] = true
+			}
+		}
+		if err := unstructured.SetNestedStringSlice(obj.Object, hostnames, "spec", "hostnames"); err != nil {
+			continue
+		}
+		applied[endpoint] = domains[endpoint]
+	}
+	return applied
+}
+
+// setEndpointCustomDomains reports the custom domains served by each endpoint.
+func setEndpointCustomDomains(endpoints []openchoreov1alpha1.EndpointURLStatus, applied map[string][]string) []openchoreov1alpha1.EndpointURLStatus {
+	for i := range endpoints {
+		endpoints[i].CustomDomains = applied[endpoints[i].Name]
+	}
+	return endpoints
+}
+
+// findReleaseBindingsForCustomDomain maps a CustomDomain to the ReleaseBinding deploying its
+// component to its environment, so the endpoint's routes follow the domain's verification.
+func (r *Reconciler) findReleaseBindingsForCustomDomain(ctx context.Context, obj client.Object) []reconcile.Request {
+	cd, ok := obj.(*openchoreov1alpha1.CustomDomain)
+	if !ok {
+		return nil
+	}
+
+	var bindings openchoreov1alpha1.ReleaseBindingList
+	key := controller.MakeReleaseBindingOwnerEnvKey(cd.Spec.Owner.ProjectName, cd.Spec.Owner.ComponentName, cd.Spec.Environment)
+	if err := r.List(ctx, &bindings, client.InNamespace(cd.Namespace),
+		client.MatchingFields{controller.IndexKeyReleaseBindingOwnerEnv: key}); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list ReleaseBindings for CustomDomain", "customDomain", cd.Name)
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(bindings.Items))
+	for _, rb := range bindings.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: rb.Namespace, Name: rb.Name},
+		})
+	}
+	return requests
+}
+
+// customDomainServingChangedPredicate passes CustomDomain events that change which domains an
+// endpoint serves: a domain being verified or losing its claim, moving to another endpoint or
+// being deleted.
+func customDomainServingChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldCD, ok := e.ObjectOld.(*openchoreov1alpha1.CustomDomain)
+			if !ok {
+				return false
+			}
+			newCD, ok := e.ObjectNew.(*openchoreov1alpha1.CustomDomain)
+			if !ok {
+				return false
+			}
+			if oldCD.Spec.Endpoint != newCD.Spec.Endpoint {
+				return true
+			}
+			return meta.IsStatusConditionTrue(oldCD.Status.Conditions, string(customdomain.ConditionDomainVerified)) !=
+				meta.IsStatusConditionTrue(newCD.Status.Conditions, string(customdomain.ConditionDomainVerified))
+		},
+		DeleteFunc: func(_ event.DeleteEvent) bool {
+			return true
+		},
+		GenericFunc: func(_ event.GenericEvent) bool {
+			return false
+		},
+	}
+}
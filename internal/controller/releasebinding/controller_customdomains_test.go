@@ -0,0 +1,103 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller/customdomain"
+)
+
+func TestInjectCustomDomains(t *testing.T) {
+	internalRoute := map[string]any{
+		"apiVersion": "gateway.networking.k8s.io/v1",
+		"kind":       "HTTPRoute",
+		"metadata": map[string]any{
+			"name": "api-internal",
+			"labels": map[string]any{
+				"openchoreo.dev/endpoint-name":       "api",
+				"openchoreo.dev/endpoint-visibility": "internal",
+			},
+		},
+		"spec": map[string]any{"hostnames": []any{"api.internal.example.com"}},
+	}
+
+	t.Run("domains are appended to the external routes of their endpoint", func(t *testing.T) {
+		resources := append(certificateRouteResources(), internalRoute)
+		applied := injectCustomDomains(resources, map[string][]string{
+			"api":   {"api.example.com", "shop.example.org"},
+			"admin": {"admin.example.org"},
+		})
+		assert.Equal(t, map[string][]string{"api": {"api.example.com", "shop.example.org"}}, applied)
+
+		hostnames, _, _ := unstructured.NestedStringSlice(resources[0], "spec", "hostnames")
+		assert.Equal(t, []string{"api.example.com", "shop.example.org"}, hostnames,
+			"the generated host stays first and duplicates are skipped")
+		tlsHostnames, _, _ := unstructured.NestedStringSlice(resources[1], "spec", "hostnames")
+		assert.Equal(t, []string{"tls.example.com"}, tlsHostnames, "routes without external visibility are unchanged")
+		internalHostnames, _, _ := unstructured.NestedStringSlice(resources[3], "spec", "hostnames")
+		assert.Equal(t, []string{"api.internal.example.com"}, internalHostnames)
+	})
+
+	t.Run("managed certificates cover the custom domains", func(t *testing.T) {
+		resources := certificateRouteResources()
+		injectCustomDomains(resources, map[string][]string{"api": {"shop.example.org"}})
+		out := injectCertificates(resources, &openchoreov1alpha1.EnvironmentTLSSpec{
+			IssuerRef: openchoreov1alpha1.CertificateIssuerRef{Name: "letsencrypt"},
+		})
+		dnsNames, _, _ := unstructured.NestedStringSlice(out[len(out)-1], "spec", "dnsNames")
+		assert.Equal(t, []string{"api.example.com", "shop.example.org"}, dnsNames)
+	})
+}
+
+func TestListVerifiedCustomDomains(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, openchoreov1alpha1.AddToScheme(s))
+
+	verified := []metav1.Condition{{
+		Type: string(customdomain.ConditionDomainVerified), Status: metav1.ConditionTrue,
+		Reason: string(customdomain.ReasonDomainVerified), LastTransitionTime: metav1.NewTime(time.Now()),
+	}}
+	newCustomDomain := func(name, component, domain string, conditions []metav1.Condition) *openchoreov1alpha1.CustomDomain {
+		return &openchoreov1alpha1.CustomDomain{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+			Spec: openchoreov1alpha1.CustomDomainSpec{
+				Owner:       openchoreov1alpha1.CustomDomainOwner{ProjectName: "shop", ComponentName: component},
+				Environment: "prod",
+				Endpoint:    "api",
+				Domain:      domain,
+			},
+			Status: openchoreov1alpha1.CustomDomainStatus{Conditions: conditions},
+		}
+	}
+
+	cli := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		newCustomDomain("b", "web", "b.example.com", verified),
+		newCustomDomain("a", "web", "a.example.com", verified),
+		newCustomDomain("unverified", "web", "c.example.com", nil),
+		newCustomDomain("other", "backend", "d.example.com", verified),
+	).Build()
+	r := &Reconciler{Client: cli, Scheme: s}
+
+	rb := &openchoreov1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-prod", Namespace: "ns"},
+		Spec: openchoreov1alpha1.ReleaseBindingSpec{
+			Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: "shop", ComponentName: "web"},
+			Environment: "prod",
+		},
+	}
+	domains, err := r.listVerifiedCustomDomains(context.Background(), rb)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"api": {"a.example.com", "b.example.com"}}, domains)
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// MaxReleaseHistory is the number of release records kept in a ReleaseBinding's status.
+const MaxReleaseHistory = 20
+
+// recordReleaseHistory prepends a record to the binding's release history when the bound
+// release or its environment configuration differs from the most recent record.
+func recordReleaseHistory(releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	componentRelease *openchoreov1alpha1.ComponentRelease, now metav1.Time) error {
+	checksum, err := releaseChecksum(releaseBinding, componentRelease)
+	if err != nil {
+		return err
+	}
+
+	history := releaseBinding.Status.History
+	if len(history) > 0 && history[0].ReleaseName == componentRelease.Name && history[0].ConfigChecksum == checksum {
+		return nil
+	}
+
+	record := openchoreov1alpha1.ReleaseRecord{
+		ReleaseName:    componentRelease.Name,
+		Image:          componentRelease.Spec.Workload.Container.Image,
+		ConfigChecksum: checksum,
+		Trigger:        controller.ReleaseTriggerManual,
+		DeployedAt:     now,
+		Snapshot: &openchoreov1alpha1.ReleaseConfigSnapshot{
+			ComponentTypeEnvironmentConfigs: releaseBinding.Spec.ComponentTypeEnvironmentConfigs.DeepCopy(),
+			TraitEnvironmentConfigs:         copyTraitEnvironmentConfigs(releaseBinding.Spec.TraitEnvironmentConfigs),
+			WorkloadOverrides:               releaseBinding.Spec.WorkloadOverrides.DeepCopy(),
+		},
+	}
+	if source, by, ok := controller.GetReleaseTrigger(releaseBinding, componentRelease.Name); ok {
+		record.Trigger = source
+		record.TriggeredBy = by
+	}
+
+	if len(history) > 0 {
+		history[0].SupersededAt = &now
+	}
+	history = append([]openchoreov1alpha1.ReleaseRecord{record}, history...)
+	if len(history) > MaxReleaseHistory {
+		history = history[:MaxReleaseHistory]
+	}
+	releaseBinding.Status.History = history
+	return nil
+}
+
+// releaseChecksum hashes everything that determines what is deployed: the release itself
+// and the binding's environment-specific configuration.
+func releaseChecksum(releaseBinding *openchoreov1alpha1.ReleaseBinding,
+	componentRelease *openchoreov1alpha1.ComponentRelease) (string, error) {
+	data, err := json.Marshal(struct {
+		Release                         openchoreov1alpha1.ComponentReleaseSpec          `json:"release"`
+		ComponentTypeEnvironmentConfigs *runtime.RawExtension                            `json:"componentTypeEnvironmentConfigs,omitempty"`
+		TraitEnvironmentConfigs         map[string]runtime.RawExtension                  `json:"traitEnvironmentConfigs,omitempty"`
+		WorkloadOverrides               *openchoreov1alpha1.WorkloadOverrideTemplateSpec `json:"workloadOverrides,omitempty"`
+	}{
+		Release:                         componentRelease.Spec,
+		ComponentTypeEnvironmentConfigs: releaseBinding.Spec.ComponentTypeEnvironmentConfigs,
+		TraitEnvironmentConfigs:         releaseBinding.Spec.TraitEnvironmentConfigs,
+		WorkloadOverrides:               releaseBinding.Spec.WorkloadOverrides,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal release configuration: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+func copyTraitEnvironmentConfigs(in map[string]runtime.RawExtension) map[string]runtime.RawExtension {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]runtime.RawExtension, len(in))
+	for k, v := range in {
+		out[k] = *v.DeepCopy()
+	}
+	return out
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+func historyRelease(name, image string) *openchoreov1alpha1.ComponentRelease {
+	cr := &openchoreov1alpha1.ComponentRelease{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace}}
+	cr.Spec.Workload.Container.Image = image
+	return cr
+}
+
+func TestRecordReleaseHistory(t *testing.T) {
+	t0 := metav1.NewTime(time.Now().Truncate(time.Second))
+	t1 := metav1.NewTime(t0.Add(time.Minute))
+
+	t.Run("first release without trigger is manual", func(t *testing.T) {
+		rb := &openchoreov1alpha1.ReleaseBinding{}
+		require.NoError(t, recordReleaseHistory(rb, historyRelease("r1", "app:v1"), t0))
+
+		require.Len(t, rb.Status.History, 1)
+		rec := rb.Status.History[0]
+		assert.Equal(t, "r1", rec.ReleaseName)
+		assert.Equal(t, "app:v1", rec.Image)
+		assert.Equal(t, controller.ReleaseTriggerManual, rec.Trigger)
+		assert.Empty(t, rec.TriggeredBy)
+		assert.Contains(t, rec.ConfigChecksum, "sha256:")
+		assert.Equal(t, t0, rec.DeployedAt)
+		assert.Nil(t, rec.SupersededAt)
+	})
+
+	t.Run("unchanged release is not recorded again", func(t *testing.T) {
+		rb := &openchoreov1alpha1.ReleaseBinding{}
+		require.NoError(t, recordReleaseHistory(rb, historyRelease("r1", "app:v1"), t0))
+		require.NoError(t, recordReleaseHistory(rb, historyRelease("r1", "app:v1"), t1))
+		assert.Len(t, rb.Status.History, 1)
+	})
+
+	t.Run("new release supersedes the previous one", func(t *testing.T) {
+		rb := &openchoreov1alpha1.ReleaseBinding{}
+		require.NoError(t, recordReleaseHistory(rb, historyRelease("r1", "app:v1"), t0))
+		controller.SetReleaseTrigger(rb, controller.ReleaseTriggerPromotion, "r2", "alice")
+		require.NoError(t, recordReleaseHistory(rb, historyRelease("r2", "app:v2"), t1))
+
+		require.Len(t, rb.Status.History, 2)
+		assert.Equal(t, "r2", rb.Status.History[0].ReleaseName)
+		assert.Equal(t, controller.ReleaseTriggerPromotion, rb.Status.History[0].Trigger)
+		assert.Equal(t, "alice", rb.Status.History[0].TriggeredBy)
+		require.NotNil(t, rb.Status.History[1].SupersededAt)
+		assert.Equal(t, t1, *rb.Status.History[1].SupersededAt)
+	})
+
+	t.Run("environment configuration change is recorded", func(t *testing.T) {
+		rb := &openchoreov1alpha1.ReleaseBinding{}
+		require.NoError(t, recordReleaseHistory(rb, historyRelease("r1", "app:v1"), t0))
+		rb.Spec.ComponentTypeEnvironmentConfigs = &runtime.RawExtension{Raw: []byte(`{"replicas":3}`)}
+		require.NoError(t, recordReleaseHistory(rb, historyRelease("r1", "app:v1"), t1))
+
+		require.Len(t, rb.Status.History, 2)
+		assert.NotEqual(t, rb.Status.History[0].ConfigChecksum, rb.Status.History[1].ConfigChecksum)
+		require.NotNil(t, rb.Status.History[0].Snapshot)
+		assert.JSONEq(t, `{"replicas":3}`, string(rb.Status.History[0].Snapshot.ComponentTypeEnvironmentConfigs.Raw))
+		assert.Nil(t, rb.Status.History[1].Snapshot.ComponentTypeEnvironmentConfigs)
+	})
+
+	t.Run("trigger for another release is ignored", func(t *testing.T) {
+		rb := &openchoreov1alpha1.ReleaseBinding{}
+		controller.SetReleaseTrigger(rb, controller.ReleaseTriggerDeploy, "r1", "alice")
+		require.NoError(t, recordReleaseHistory(rb, historyRelease("r2", "app:v2"), t0))
+		assert.Equal(t, controller.ReleaseTriggerManual, rb.Status.History[0].Trigger)
+	})
+
+	t.Run("history is bounded", func(t *testing.T) {
+		rb := &openchoreov1alpha1.ReleaseBinding{}
+		for i := range MaxReleaseHistory + 5 {
+			require.NoError(t, recordReleaseHistory(rb, historyRelease(fmt.Sprintf("r%d", i), "app"), t0))
+		}
+		require.Len(t, rb.Status.History, MaxReleaseHistory)
+		assert.Equal(t, fmt.Sprintf("r%d", MaxReleaseHistory+4), rb.Status.History[0].ReleaseName)
+	})
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// listNetworkPolicyRules returns the org-level network policy rules of the binding's namespace
+// that target the binding's project.
+func (r *Reconciler) listNetworkPolicyRules(ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding) ([]openchoreov1alpha1.NetworkPolicyRule, error) {
+	var ruleList openchoreov1alpha1.NetworkPolicyRuleList
+	if err := r.List(ctx, &ruleList, client.InNamespace(releaseBinding.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list NetworkPolicyRules: %w", err)
+	}
+
+	var rules []openchoreov1alpha1.NetworkPolicyRule
+	for _, rule := range ruleList.Items {
+		if rule.Spec.Destination.Project == releaseBinding.Spec.Owner.ProjectName && rule.DeletionTimestamp.IsZero() {
+			rules = append(rules, rule)
+		}
+	}
+	return rules, nil
+}
+
+// findReleaseBindingsForNetworkPolicyRule enqueues every ReleaseBinding in the rule's namespace.
+// An update may move the rule's destination to another project, and the map function only
+// sees the new object, so the bindings of all projects re-render their network policies.
+func (r *Reconciler) findReleaseBindingsForNetworkPolicyRule(ctx context.Context, obj client.Object) []reconcile.Request {
+	var bindings openchoreov1alpha1.ReleaseBindingList
+	if err := r.List(ctx, &bindings, client.InNamespace(obj.GetNamespace())); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list ReleaseBindings for NetworkPolicyRule", "networkPolicyRule", obj.GetName())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(bindings.Items))
+	for _, rb := range bindings.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: rb.Namespace, Name: rb.Name},
+		})
+	}
+	return requests
+}
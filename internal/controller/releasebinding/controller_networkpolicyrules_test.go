@@ -0,0 +1,62 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestListNetworkPolicyRules(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, openchoreov1alpha1.AddToScheme(s))
+
+	newRule := func(name, namespace, destinationProject string) *openchoreov1alpha1.NetworkPolicyRule {
+		return &openchoreov1alpha1.NetworkPolicyRule{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: openchoreov1alpha1.NetworkPolicyRuleSpec{
+				Action:      openchoreov1alpha1.NetworkPolicyRuleActionDeny,
+				Source:      openchoreov1alpha1.NetworkPolicyRulePeer{Project: "legacy"},
+				Destination: openchoreov1alpha1.NetworkPolicyRuleDestination{Project: destinationProject},
+			},
+		}
+	}
+
+	cli := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		newRule("deny-legacy", "ns", "shop"),
+		newRule("other-project", "ns", "billing"),
+		newRule("other-namespace", "other-ns", "shop"),
+		&openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-prod", Namespace: "ns"},
+		},
+		&openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-prod", Namespace: "other-ns"},
+		},
+	).Build()
+	r := &Reconciler{Client: cli, Scheme: s}
+
+	rb := &openchoreov1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-prod", Namespace: "ns"},
+		Spec: openchoreov1alpha1.ReleaseBindingSpec{
+			Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: "shop", ComponentName: "web"},
+			Environment: "prod",
+		},
+	}
+	rules, err := r.listNetworkPolicyRules(context.Background(), rb)
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+	assert.Equal(t, "deny-legacy", rules[0].Name)
+
+	requests := r.findReleaseBindingsForNetworkPolicyRule(context.Background(), newRule("deny-legacy", "ns", "shop"))
+	require.Len(t, requests, 1)
+	assert.Equal(t, "ns", requests[0].Namespace)
+}
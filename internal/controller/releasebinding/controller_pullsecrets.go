@@ -0,0 +1,250 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/imageref"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+const (
+	externalSecretAPIVersion = "external-secrets.io/v1"
+	externalSecretKind       = "ExternalSecret"
+	dockerConfigJSONType     = "kubernetes.io/dockerconfigjson"
+
+	defaultPullSecretRefreshInterval = "1h"
+)
+
+// podTemplatePaths locates the pod spec of each workload kind that pulls images.
+var podTemplatePaths = map[string][]string{
+	deploymentKind:  {"spec", "template", "spec"},
+	statefulSetKind: {"spec", "template", "spec"},
+	"DaemonSet":     {"spec", "template", "spec"},
+	"Job":           {"spec", "template", "spec"},
+	"CronJob":       {"spec", "jobTemplate", "spec", "template", "spec"},
+}
+
+// listRegistryCredentials returns the registry credentials of the binding's namespace that are
+// stored on its data plane, keyed by registry host. When several credentials authenticate to the
+// same registry, the first by name is used.
+func (r *Reconciler) listRegistryCredentials(
+	ctx context.Context, releaseBinding *openchoreov1alpha1.ReleaseBinding, dataPlaneResult *controller.DataPlaneResult,
+) (map[string]*openchoreov1alpha1.SecretReference, error) {
+	var refs openchoreov1alpha1.SecretReferenceList
+	if err := r.List(ctx, &refs,
+		client.InNamespace(releaseBinding.Namespace),
+		client.MatchingLabels{labels.LabelKeySecretType: labels.LabelValueRegistryCredentials},
+	); err != nil {
+		return nil, fmt.Errorf("failed to list registry credentials: %w", err)
+	}
+
+	planeKind := string(openchoreov1alpha1.DataPlaneRefKindDataPlane)
+	if dataPlaneResult.DataPlane == nil {
+		planeKind = string(openchoreov1alpha1.DataPlaneRefKindClusterDataPlane)
+	}
+
+	sort.Slice(refs.Items, func(i, j int) bool { return refs.Items[i].Name < refs.Items[j].Name })
+	credentials := make(map[string]*openchoreov1alpha1.SecretReference)
+	for i := range refs.Items {
+		ref := &refs.Items[i]
+		target := ref.Spec.TargetPlane
+		if !ref.DeletionTimestamp.IsZero() || target == nil || target.Kind != planeKind || target.Name != dataPlaneResult.GetName() {
+			continue
+		}
+		server := ref.Annotations[labels.AnnotationKeyRegistryServer]
+		if _, exists := credentials[server]; server == "" || exists {
+			continue
+		}
+		credentials[server] = ref
+	}
+	return credentials, nil
+}
+
+// releaseBindingsForRegistryCredential returns reconcile requests for the ReleaseBindings in the
+// credential's namespace whose Environment deploys to the data plane the credential is stored on.
+func (r *Reconciler) releaseBindingsForRegistryCredential(ctx context.Context, ref *openchoreov1alpha1.SecretReference) []reconcile.Request {
+	target := ref.Spec.TargetPlane
+	if target == nil {
+		return nil
+	}
+	switch kind := openchoreov1alpha1.DataPlaneRefKind(target.Kind); kind {
+	case openchoreov1alpha1.DataPlaneRefKindDataPlane, openchoreov1alpha1.DataPlaneRefKindClusterDataPlane:
+		return r.releaseBindingsForDataPlaneRef(ctx, ref.Namespace, kind, target.Name)
+	default:
+		return nil
+	}
+}
+
+// injectPullSecrets adds an image pull secret to every workload whose images are pulled from a
+// registry with stored credentials, and appends an ExternalSecret that syncs the credentials from
+// the data plane's secret store into each workload namespace. Nothing is injected when the data
+// plane has no secret store.
+func injectPullSecrets(resources []map[string]any, credentials map[string]*openchoreov1alpha1.SecretReference, secretStore string) []map[string]any {
+	if secretStore == "" || len(credentials) == 0 {
+		return resources
+	}
+
+	type pullSecret struct {
+		namespace string
+		ref       *openchoreov1alpha1.SecretReference
+		labels    map[string]string
+	}
+	pullSecrets := map[string]pullSecret{}
+
+	for _, resource := range resources {
+		obj := &unstructured.Unstructured{Object: resource}
+		group := obj.GroupVersionKind().Group
+		if group != "apps" && group != "batch" {
+			continue
+		}
+		path, ok := podTemplatePaths[obj.GetKind()]
+		if !ok {
+			continue
+		}
+		podSpec, ok, _ := unstructured.NestedFieldNoCopy(resource, path...)
+		if !ok {
+			continue
+		}
+		spec, ok := podSpec.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		var names []string
+		for _, ref := range registryCredentialsForPod(spec, credentials) {
+			names = append(names, ref.Name)
+			key := obj.GetNamespace() + "/" + ref.Name
+			if _, exists := pullSecrets[key]; !exists {
+				pullSecrets[key] = pullSecret{namespace: obj.GetNamespace(), ref: ref, labels: obj.GetLabels()}
+			}
+		}
+		addImagePullSecrets(spec, names)
+	}
+
+	keys := make([]string, 0, len(pullSecrets))
+	for key := range pullSecrets {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		ps := pullSecrets[key]
+		resources = append(resources, buildPullSecretExternalSecret(ps.ref, ps.namespace, secretStore, ps.labels))
+	}
+	return resources
+}
+
+// registryCredentialsForPod returns the credentials of the registries the pod's containers pull
+// from, sorted by name.
+func registryCredentialsForPod(podSpec map[string]any, credentials map[string]*openchoreov1alpha1.SecretReference) []*openchoreov1alpha1.SecretReference {
+	seen := map[string]bool{}
+	var refs []*openchoreov1alpha1.SecretReference
+	for _, field := range []string{"initContainers", "containers"} {
+		containers, _, _ := unstructured.NestedSlice(podSpec, field)
+		for _, c := range containers {
+			container, ok := c.(map[string]any)
+			if !ok {
+				continue
+			}
+			image, _ := container["image"].(string)
+			if image == "" {
+				continue
+			}
+			ref, ok := credentials[imageref.Registry(image)]
+			if !ok || seen[ref.Name] {
+				continue
+			}
+			seen[ref.Name] = true
+			refs = append(refs, ref)
+		}
+	}
+	sort.Slice(refs, func(i, j int) bool { return refs[i].Name < refs[j].Name })
+	return refs
+}
+
+// addImagePullSecrets appends the named secrets to the pod's imagePullSecrets, keeping the
+// secrets the template already declares.
+func addImagePullSecrets(podSpec map[string]any, names []string) {
+	if len(names) == 0 {
+		return
+	}
+	existing, _ := podSpec["imagePullSecrets"].([]any)
+	declared := map[string]bool{}
+	for _, s := range existing {
+		if secret, ok := s.(map[string]any); ok {
+			if name, ok := secret["name"].(string); ok {
+				declared[name] = true
+			}
+		}
+	}
+	for _, name := range names {
+		if !declared[name] {
+			existing = append(existing, map[string]any{"name": name})
+		}
+	}
+	podSpec["imagePullSecrets"] = existing
+}
+
+// buildPullSecretExternalSecret renders an ExternalSecret that syncs registry credentials into a
+// dockerconfigjson Secret of the same name in the workload namespace.
+func buildPullSecretExternalSecret(ref *openchoreov1alpha1.SecretReference, namespace, secretStore string, workloadLabels map[string]string) map[string]any {
+	data := make([]any, 0, len(ref.Spec.Data))
+	for _, source := range ref.Spec.Data {
+		remoteRef := map[string]any{"key": source.RemoteRef.Key}
+		if source.RemoteRef.Property != "" {
+			remoteRef["property"] = source.RemoteRef.Property
+		}
+		if source.RemoteRef.Version != "" {
+			remoteRef["version"] = source.RemoteRef.Version
+		}
+		data = append(data, map[string]any{"secretKey": source.SecretKey, "remoteRef": remoteRef})
+	}
+
+	metadata := map[string]any{
+		"name":      ref.Name,
+		"namespace": namespace,
+	}
+	if len(workloadLabels) > 0 {
+		esLabels := make(map[string]any, len(workloadLabels))
+		for k, v := range workloadLabels {
+			esLabels[k] = v
+		}
+		metadata["labels"] = esLabels
+	}
+
+	refreshInterval := defaultPullSecretRefreshInterval
+	if ref.Spec.RefreshInterval != nil {
+		refreshInterval = ref.Spec.RefreshInterval.Duration.String()
+	}
+
+	return map[string]any{
+		"apiVersion": externalSecretAPIVersion,
+		"kind":       externalSecretKind,
+		"metadata":   metadata,
+		"spec": map[string]any{
+			"refreshInterval": refreshInterval,
+			"secretStoreRef": map[string]any{
+				"name": secretStore,
+				"kind": "ClusterSecretStore",
+			},
+			"target": map[string]any{
+				"name":           ref.Name,
+				"creationPolicy": "Owner",
+				"template": map[string]any{
+					"type": dockerConfigJSONType,
+				},
+			},
+			"data": data,
+		},
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+func registryCredential(name, server, planeKind, planeName string) *openchoreov1alpha1.SecretReference {
+	return &openchoreov1alpha1.SecretReference{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "ns",
+			Labels:      map[string]string{labels.LabelKeySecretType: labels.LabelValueRegistryCredentials},
+			Annotations: map[string]string{labels.AnnotationKeyRegistryServer: server},
+		},
+		Spec: openchoreov1alpha1.SecretReferenceSpec{
+			TargetPlane: &openchoreov1alpha1.TargetPlaneRef{Kind: planeKind, Name: planeName},
+			Template:    openchoreov1alpha1.SecretTemplate{Type: "kubernetes.io/dockerconfigjson"},
+			Data: []openchoreov1alpha1.SecretDataSource{{
+				SecretKey: ".dockerconfigjson",
+				RemoteRef: openchoreov1alpha1.RemoteReference{Key: "secret/ns/registry/" + name, Property: ".dockerconfigjson"},
+			}},
+		},
+	}
+}
+
+func podWorkload(kind string, images ...string) map[string]any {
+	containers := make([]any, 0, len(images))
+	for _, image := range images {
+		containers = append(containers, map[string]any{"name": "main", "image": image})
+	}
+	podSpec := map[string]any{"containers": containers}
+	apiVersion, spec := "apps/v1", map[string]any{"template": map[string]any{"spec": podSpec}}
+	if kind == "CronJob" {
+		apiVersion = "batch/v1"
+		spec = map[string]any{"jobTemplate": map[string]any{"spec": spec}}
+	}
+	return map[string]any{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata": map[string]any{
+			"name":      "web",
+			"namespace": "dp-ns-shop-prod",
+			"labels":    map[string]any{labels.LabelKeyComponentName: "web"},
+		},
+		"spec": spec,
+	}
+}
+
+func TestInjectPullSecrets(t *testing.T) {
+	credentials := map[string]*openchoreov1alpha1.SecretReference{
+		"ghcr.io":   registryCredential("ghcr", "ghcr.io", "DataPlane", "default"),
+		"docker.io": registryCredential("hub", "docker.io", "DataPlane", "default"),
+	}
+
+	t.Run("workloads pulling from a private registry get a pull secret", func(t *testing.T) {
+		deployment := podWorkload(deploymentKind, "ghcr.io/acme/web:v1", "nginx:1.27")
+		resources := injectPullSecrets([]map[string]any{deployment}, credentials, "default")
+
+		pullSecrets, _, _ := unstructured.NestedSlice(deployment, "spec", "template", "spec", "imagePullSecrets")
+		assert.Equal(t, []any{map[string]any{"name": "ghcr"}, map[string]any{"name": "hub"}}, pullSecrets)
+
+		require.Len(t, resources, 3)
+		es := &unstructured.Unstructured{Object: resources[1]}
+		assert.Equal(t, externalSecretKind, es.GetKind())
+		assert.Equal(t, "ghcr", es.GetName())
+		assert.Equal(t, "dp-ns-shop-prod", es.GetNamespace())
+		assert.Equal(t, "web", es.GetLabels()[labels.LabelKeyComponentName])
+		store, _, _ := unstructured.NestedString(es.Object, "spec", "secretStoreRef", "name")
+		assert.Equal(t, "default", store)
+		secretType, _, _ := unstructured.NestedString(es.Object, "spec", "target", "template", "type")
+		assert.Equal(t, dockerConfigJSONType, secretType)
+		data, _, _ := unstructured.NestedSlice(es.Object, "spec", "data")
+		assert.Equal(t, []any{map[string]any{
+			"secretKey": ".dockerconfigjson",
+			"remoteRef": map[string]any{"key": "secret/ns/registry/ghcr", "property": ".dockerconfigjson"},
+		}}, data)
+	})
+
+	t.Run("declared pull secrets are kept", func(t *testing.T) {
+		cronJob := podWorkload("CronJob", "ghcr.io/acme/job:v1")
+		path := []string{"spec", "jobTemplate", "spec", "template", "spec", "imagePullSecrets"}
+		require.NoError(t, unstructured.SetNestedSlice(cronJob, []any{map[string]any{"name": "ghcr"}, map[string]any{"name": "custom"}}, path...))
+
+		resources := injectPullSecrets([]map[string]any{cronJob}, credentials, "default")
+		pullSecrets, _, _ := unstructured.NestedSlice(cronJob, path...)
+		assert.Len(t, pullSecrets, 2)
+		assert.Len(t, resources, 2)
+	})
+
+	t.Run("public images and missing secret stores are left alone", func(t *testing.T) {
+		deployment := podWorkload(deploymentKind, "quay.io/acme/web:v1")
+		assert.Len(t, injectPullSecrets([]map[string]any{deployment}, credentials, "default"), 1)
+
+		deployment = podWorkload(deploymentKind, "ghcr.io/acme/web:v1")
+		assert.Len(t, injectPullSecrets([]map[string]any{deployment}, credentials, ""), 1)
+		_, found, _ := unstructured.NestedSlice(deployment, "spec", "template", "spec", "imagePullSecrets")
+		assert.False(t, found)
+	})
+}
+
+func TestListRegistryCredentials(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, openchoreov1alpha1.AddToScheme(s))
+
+	plain := registryCredential("plain", "ghcr.io", "DataPlane", "default")
+	plain.Labels = nil
+	cli := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		registryCredential("b-ghcr", "ghcr.io", "DataPlane", "default"),
+		registryCredential("a-ghcr", "ghcr.io", "DataPlane", "default"),
+		registryCredential("other-plane", "quay.io", "DataPlane", "edge"),
+		registryCredential("build", "docker.io", "WorkflowPlane", "default"),
+		plain,
+	).Build()
+	r := &Reconciler{Client: cli, Scheme: s}
+
+	rb := &openchoreov1alpha1.ReleaseBinding{ObjectMeta: metav1.ObjectMeta{Name: "web-prod", Namespace: "ns"}}
+	dataPlane := &controller.DataPlaneResult{DataPlane: &openchoreov1alpha1.DataPlane{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "ns"}}}
+
+	credentials, err := r.listRegistryCredentials(context.Background(), rb, dataPlane)
+	require.NoError(t, err)
+	require.Len(t, credentials, 1)
+	assert.Equal(t, "a-ghcr", credentials["ghcr.io"].Name)
+}
@@ -0,0 +1,425 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	dpkubernetes "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+const (
+	deploymentKind = "Deployment"
+	serviceKind    = "Service"
+
+	// canarySuffix is appended to the names of the Deployments and Services of a canary release.
+	canarySuffix = "canary"
+)
+
+// rolloutStrategyType returns the rollout strategy of a binding, RollingUpdate when unset.
+func rolloutStrategyType(releaseBinding *openchoreov1alpha1.ReleaseBinding) openchoreov1alpha1.RolloutStrategyType {
+	if releaseBinding.Spec.Strategy == nil || releaseBinding.Spec.Strategy.Type == "" {
+		return openchoreov1alpha1.RolloutStrategyRollingUpdate
+	}
+	return releaseBinding.Spec.Strategy.Type
+}
+
+// rolloutSteps returns the traffic steps of a strategy. A BlueGreen rollout is a single step
+// without traffic that waits to be promoted.
+func rolloutSteps(strategy *openchoreov1alpha1.RolloutStrategy) []openchoreov1alpha1.CanaryStep {
+	if strategy.Type == openchoreov1alpha1.RolloutStrategyCanary && strategy.Canary != nil && len(strategy.Canary.Steps) > 0 {
+		return strategy.Canary.Steps
+	}
+	return []openchoreov1alpha1.CanaryStep{{Weight: 0}}
+}
+
+// advanceRollout updates the rollout status of a binding for its bound release: it starts a
+// rollout when the release changes, handles a requested rollout action and moves a progressing
+// rollout through its steps. hasStable reports whether the data plane runs a stable release to
+// roll out from. It returns how long until the current step ends, or zero when the rollout
+// waits for an action or is complete.
+func advanceRollout(releaseBinding *openchoreov1alpha1.ReleaseBinding, hasStable bool, now metav1.Time) time.Duration {
+	strategyType := rolloutStrategyType(releaseBinding)
+	if strategyType == openchoreov1alpha1.RolloutStrategyRollingUpdate {
+		releaseBinding.Status.Rollout = nil
+		return 0
+	}
+	release := releaseBinding.Spec.ReleaseName
+	_, actionRequest, _ := controller.GetRolloutAction(releaseBinding)
+
+	rollout := releaseBinding.Status.Rollout
+	switch {
+	case rollout == nil:
+		// Actions requested before the rollout status existed are not applied.
+		rollout = &openchoreov1alpha1.RolloutStatus{ObservedAction: actionRequest}
+		if stable := previousRelease(releaseBinding); stable != "" && hasStable {
+			startRollout(rollout, stable, release, now)
+		} else {
+			completeRollout(rollout, release)
+		}
+	case release == rollout.StableRelease:
+		if rollout.CanaryRelease != "" {
+			completeRollout(rollout, release)
+		}
+	case release != rollout.CanaryRelease:
+		if hasStable && rollout.StableRelease != "" {
+			startRollout(rollout, rollout.StableRelease, release, now)
+		} else {
+			completeRollout(rollout, release)
+		}
+	}
+	rollout.Strategy = strategyType
+	releaseBinding.Status.Rollout = rollout
+
+	if action, request, ok := controller.GetRolloutAction(releaseBinding); ok && request != rollout.ObservedAction {
+		rollout.ObservedAction = request
+		applyRolloutAction(rollout, rolloutSteps(releaseBinding.Spec.Strategy), action, now)
+	}
+	if rollout.Phase != openchoreov1alpha1.RolloutPhaseProgressing {
+		return 0
+	}
+	return progressRollout(rollout, rolloutSteps(releaseBinding.Spec.Strategy), now)
+}
+
+// previousRelease returns the release bound before the current one according to the binding's
+// release history, or "" when there is none.
+func previousRelease(releaseBinding *openchoreov1alpha1.ReleaseBinding) string {
+	history := releaseBinding.Status.History
+	if len(history) < 2 || history[0].ReleaseName != releaseBinding.Spec.ReleaseName ||
+		history[1].ReleaseName == releaseBinding.Spec.ReleaseName {
+		return ""
+	}
+	return history[1].ReleaseName
+}
+
+func startRollout(rollout *openchoreov1alpha1.RolloutStatus, stable, canary string, now metav1.Time) {
+	rollout.Phase = openchoreov1alpha1.RolloutPhaseProgressing
+	rollout.StableRelease = stable
+	rollout.CanaryRelease = canary
+	rollout.Step = 0
+	rollout.CanaryWeight = 0
+	rollout.StepStartedAt = &now
+	rollout.Message = fmt.Sprintf("Rolling out release %q", canary)
+}
+
+func completeRollout(rollout *openchoreov1alpha1.RolloutStatus, release string) {
+	rollout.Phase = openchoreov1alpha1.RolloutPhaseSucceeded
+	rollout.StableRelease = release
+	rollout.CanaryRelease = ""
+	rollout.Step = 0
+	rollout.CanaryWeight = 0
+	rollout.StepStartedAt = nil
+	rollout.Message = fmt.Sprintf("Release %q receives all traffic", release)
+}
+
+// applyRolloutAction applies a requested action to the rollout. Actions on a rollout that is
+// not in progress have no effect.
+func applyRolloutAction(rollout *openchoreov1alpha1.RolloutStatus, steps []openchoreov1alpha1.CanaryStep, action string, now metav1.Time) {
+	if rollout.Phase != openchoreov1alpha1.RolloutPhaseProgressing && rollout.Phase != openchoreov1alpha1.RolloutPhasePaused {
+		return
+	}
+	switch action {
+	case controller.RolloutActionPause:
+		rollout.Phase = openchoreov1alpha1.RolloutPhasePaused
+		rollout.Message = fmt.Sprintf("Rollout paused at step %d", rollout.Step+1)
+	case controller.RolloutActionResume:
+		if rollout.Phase != openchoreov1alpha1.RolloutPhasePaused {
+			return
+		}
+		rollout.Phase = openchoreov1alpha1.RolloutPhaseProgressing
+		if int(rollout.Step) < len(steps) && steps[rollout.Step].Pause == nil {
+			// The step waits for an action, so resuming moves on to the next one.
+			rollout.Step++
+		}
+		rollout.StepStartedAt = &now
+	case controller.RolloutActionPromote:
+		completeRollout(rollout, rollout.CanaryRelease)
+	case controller.RolloutActionAbort:
+		rollout.Phase = openchoreov1alpha1.RolloutPhaseAborted
+		rollout.CanaryWeight = 0
+		rollout.StepStartedAt = nil
+		rollout.Message = fmt.Sprintf("Rollout of release %q aborted, release %q receives all traffic",
+			rollout.CanaryRelease, rollout.StableRelease)
+	}
+}
+
+// progressRollout moves a progressing rollout through the steps whose pause has elapsed and
+// returns how long until the current step ends.
+func progressRollout(rollout *openchoreov1alpha1.RolloutStatus, steps []openchoreov1alpha1.CanaryStep, now metav1.Time) time.Duration {
+	for {
+		if int(rollout.Step) >= len(steps) {
+			completeRollout(rollout, rollout.CanaryRelease)
+			return 0
+		}
+		step := steps[rollout.Step]
+		rollout.CanaryWeight = step.Weight
+		if step.Pause == nil {
+			rollout.Phase = openchoreov1alpha1.RolloutPhasePaused
+			rollout.Message = fmt.Sprintf("Step %d of %d routes %d%% of traffic to release %q and waits to be resumed or promoted",
+				rollout.Step+1, len(steps), step.Weight, rollout.CanaryRelease)
+			return 0
+		}
+		if rollout.StepStartedAt == nil {
+			rollout.StepStartedAt = &now
+		}
+		if remaining := step.Pause.Duration - now.Sub(rollout.StepStartedAt.Time); remaining > 0 {
+			rollout.Message = fmt.Sprintf("Step %d of %d routes %d%% of traffic to release %q",
+				rollout.Step+1, len(steps), step.Weight, rollout.CanaryRelease)
+			return remaining
+		}
+		rollout.Step++
+		rollout.StepStartedAt = &now
+	}
+}
+
+// applyRollout returns the data plane resources for the rollout state of a binding. rendered
+// are the resources of the bound release and current those of the existing data plane release.
+//
+// While a rollout is in progress the Deployments and Services of the stable release are kept
+// from the current resources, the bound release's Deployments and Services run next to them
+// under a canary name, and the HTTPRoute and GRPCRoute backends are split between the two by
+// the canary weight. An aborted rollout keeps only the stable Deployments and Services. Pods of
+// every Canary or BlueGreen binding carry a rollout track label so that the stable Services
+// select only stable pods during a rollout.
+func (r *Reconciler) applyRollout(rollout *openchoreov1alpha1.RolloutStatus,
+	rendered, current []openchoreov1alpha1.RenderedManifest) ([]openchoreov1alpha1.RenderedManifest, error) {
+	if rollout == nil {
+		return rendered, nil
+	}
+	inProgress := rollout.Phase == openchoreov1alpha1.RolloutPhaseProgressing || rollout.Phase == openchoreov1alpha1.RolloutPhasePaused
+	if !inProgress && rollout.Phase != openchoreov1alpha1.RolloutPhaseAborted {
+		return mapManifests(rendered, func(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+			return obj, labelRolloutTrack(obj, labels.LabelValueRolloutTrackStable, false)
+		})
+	}
+
+	// Stable Deployments and Services from the current data plane release.
+	var stable []openchoreov1alpha1.RenderedManifest
+	for _, manifest := range current {
+		obj, err := manifestObject(manifest)
+		if err != nil {
+			return nil, err
+		}
+		if !isRolloutWorkload(obj) || obj.GetLabels()[labels.LabelKeyRolloutTrack] == labels.LabelValueRolloutTrackCanary {
+			continue
+		}
+		if err := labelRolloutTrack(obj, labels.LabelValueRolloutTrackStable, true); err != nil {
+			return nil, err
+		}
+		updated, err := toManifest(manifest.ID, obj)
+		if err != nil {
+			return nil, err
+		}
+		stable = append(stable, updated)
+	}
+
+	var result, canary, routes []openchoreov1alpha1.RenderedManifest
+	canaryServices := make(map[string]string)
+	for _, manifest := range rendered {
+		obj, err := manifestObject(manifest)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case isRolloutWorkload(obj):
+			if !inProgress {
+				continue
+			}
+			name := obj.GetName()
+			canaryName := canaryResourceName(name)
+			if obj.GetKind() == serviceKind {
+				canaryServices[name] = canaryName
+			}
+			obj.SetName(canaryName)
+			objLabels := obj.GetLabels()
+			if objLabels == nil {
+				objLabels = make(map[string]string)
+			}
+			objLabels[labels.LabelKeyRolloutTrack] = labels.LabelValueRolloutTrackCanary
+			obj.SetLabels(objLabels)
+			if err := labelRolloutTrack(obj, labels.LabelValueRolloutTrackCanary, true); err != nil {
+				return nil, err
+			}
+			updated, err := toManifest(r.generateResourceID(obj.Object, 0), obj)
+			if err != nil {
+				return nil, err
+			}
+			canary = append(canary, updated)
+		case obj.GetKind() == httpRouteKind || obj.GetKind() == grpcRouteKind:
+			routes = append(routes, manifest)
+		default:
+			result = append(result, manifest)
+		}
+	}
+
+	weightedRoutes, err := mapManifests(routes, func(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+		return obj, splitRouteBackends(obj, canaryServices, rollout.CanaryWeight)
+	})
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, stable...)
+	result = append(result, canary...)
+	return append(result, weightedRoutes...), nil
+}
+
+// hasStableWorkloads reports whether a data plane release runs Deployments of a stable release.
+func hasStableWorkloads(manifests []openchoreov1alpha1.RenderedManifest) bool {
+	for _, manifest := range manifests {
+		obj, err := manifestObject(manifest)
+		if err != nil {
+			continue
+		}
+		if obj.GetKind() == deploymentKind && obj.GetLabels()[labels.LabelKeyRolloutTrack] != labels.LabelValueRolloutTrackCanary {
+			return true
+		}
+	}
+	return false
+}
+
+func isRolloutWorkload(obj *unstructured.Unstructured) bool {
+	return obj.GetKind() == deploymentKind || obj.GetKind() == serviceKind
+}
+
+func canaryResourceName(name string) string {
+	canaryName := name + "-" + canarySuffix
+	if len(canaryName) > dpkubernetes.MaxLabelNameLength {
+		return dpkubernetes.GenerateK8sNameWithLengthLimit(dpkubernetes.MaxLabelNameLength, name, canarySuffix)
+	}
+	return canaryName
+}
+
+// labelRolloutTrack adds the rollout track label to the pod template of a Deployment and, when
+// selectors is set, to the selectors of the Deployment and of a Service. The selector of a
+// stable Deployment is immutable and is never changed.
+func labelRolloutTrack(obj *unstructured.Unstructured, track string, selectors bool) error {
+	var paths [][]string
+	switch obj.GetKind() {
+	case deploymentKind:
+		paths = append(paths, []string{"spec", "template", "metadata", "labels"})
+		if selectors && track == labels.LabelValueRolloutTrackCanary {
+			paths = append(paths, []string{"spec", "selector", "matchLabels"})
+		}
+	case serviceKind:
+		if !selectors {
+			return nil
+		}
+		selector, found, err := unstructured.NestedStringMap(obj.Object, "spec", "selector")
+		if err != nil {
+			return fmt.Errorf("failed to read selector of Service %q: %w", obj.GetName(), err)
+		}
+		if !found || len(selector) == 0 {
+			// Services without a selector do not route to the component's pods.
+			return nil
+		}
+		paths = append(paths, []string{"spec", "selector"})
+	default:
+		return nil
+	}
+	for _, path := range paths {
+		if err := unstructured.SetNestedField(obj.Object, track, append(path, labels.LabelKeyRolloutTrack)...); err != nil {
+			return fmt.Errorf("failed to label %s %q with its rollout track: %w", obj.GetKind(), obj.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// splitRouteBackends splits every Service backend of a route that has a canary Service between
+// the stable and the canary Service, giving the canary weight percent of the backend's traffic.
+func splitRouteBackends(obj *unstructured.Unstructured, canaryServices map[string]string, weight int32) error {
+	if len(canaryServices) == 0 {
+		return nil
+	}
+	rules, found, err := unstructured.NestedSlice(obj.Object, "spec", "rules")
+	if err != nil || !found {
+		return err
+	}
+	for i, rule := range rules {
+		ruleMap, ok := rule.(map[string]any)
+		if !ok {
+			continue
+		}
+		backendRefs, ok := ruleMap["backendRefs"].([]any)
+		if !ok {
+			continue
+		}
+		split := make([]any, 0, len(backendRefs)*2)
+		for _, ref := range backendRefs {
+			refMap, ok := ref.(map[string]any)
+			if !ok {
+				split = append(split, ref)
+				continue
+			}
+			name, _ := refMap["name"].(string)
+			kind, _ := refMap["kind"].(string)
+			canaryName, hasCanary := canaryServices[name]
+			if !hasCanary || (kind != "" && kind != serviceKind) {
+				split = append(split, ref)
+				continue
+			}
+			backendWeight := int64(1)
+			if w, found, _ := unstructured.NestedInt64(refMap, "weight"); found {
+				backendWeight = w
+			}
+			stableRef := runtime.DeepCopyJSON(refMap)
+			stableRef["weight"] = backendWeight * int64(100-weight)
+			canaryRef := runtime.DeepCopyJSON(refMap)
+			canaryRef["name"] = canaryName
+			canaryRef["weight"] = backendWeight * int64(weight)
+			split = append(split, stableRef, canaryRef)
+		}
+		ruleMap["backendRefs"] = split
+		rules[i] = ruleMap
+	}
+	return unstructured.SetNestedSlice(obj.Object, rules, "spec", "rules")
+}
+
+func manifestObject(manifest openchoreov1alpha1.RenderedManifest) (*unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+	if manifest.Object == nil {
+		return obj, nil
+	}
+	if err := obj.UnmarshalJSON(manifest.Object.Raw); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal resource %q: %w", manifest.ID, err)
+	}
+	return obj, nil
+}
+
+func toManifest(id string, obj *unstructured.Unstructured) (openchoreov1alpha1.RenderedManifest, error) {
+	raw, err := json.Marshal(obj.Object)
+	if err != nil {
+		return openchoreov1alpha1.RenderedManifest{}, fmt.Errorf("failed to marshal resource %q: %w", id, err)
+	}
+	return openchoreov1alpha1.RenderedManifest{ID: id, Object: &runtime.RawExtension{Raw: raw}}, nil
+}
+
+// mapManifests applies fn to the object of each manifest, keeping the manifest IDs.
+func mapManifests(manifests []openchoreov1alpha1.RenderedManifest,
+	fn func(*unstructured.Unstructured) (*unstructured.Unstructured, error)) ([]openchoreov1alpha1.RenderedManifest, error) {
+	result := make([]openchoreov1alpha1.RenderedManifest, 0, len(manifests))
+	for _, manifest := range manifests {
+		obj, err := manifestObject(manifest)
+		if err != nil {
+			return nil, err
+		}
+		if obj, err = fn(obj); err != nil {
+			return nil, err
+		}
+		updated, err := toManifest(manifest.ID, obj)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, updated)
+	}
+	return result, nil
+}
@@ -0,0 +1,254 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package releasebinding
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// rolloutBinding returns a binding of release r2 that replaced r1, using the given strategy.
+func rolloutBinding(strategy *openchoreov1alpha1.RolloutStrategy) *openchoreov1alpha1.ReleaseBinding {
+	rb := &openchoreov1alpha1.ReleaseBinding{}
+	rb.Spec.ReleaseName = "r2"
+	rb.Spec.Strategy = strategy
+	rb.Status.History = []openchoreov1alpha1.ReleaseRecord{{ReleaseName: "r2"}, {ReleaseName: "r1"}}
+	return rb
+}
+
+func canaryStrategy(steps ...openchoreov1alpha1.CanaryStep) *openchoreov1alpha1.RolloutStrategy {
+	return &openchoreov1alpha1.RolloutStrategy{
+		Type:   openchoreov1alpha1.RolloutStrategyCanary,
+		Canary: &openchoreov1alpha1.CanaryStrategy{Steps: steps},
+	}
+}
+
+func TestAdvanceRollout(t *testing.T) {
+	t0 := metav1.NewTime(time.Now().Truncate(time.Second))
+	minute := &metav1.Duration{Duration: time.Minute}
+
+	t.Run("rolling update has no rollout", func(t *testing.T) {
+		rb := rolloutBinding(nil)
+		rb.Status.Rollout = &openchoreov1alpha1.RolloutStatus{Phase: openchoreov1alpha1.RolloutPhasePaused}
+		assert.Zero(t, advanceRollout(rb, true, t0))
+		assert.Nil(t, rb.Status.Rollout)
+	})
+
+	t.Run("first release completes without a rollout", func(t *testing.T) {
+		rb := rolloutBinding(canaryStrategy(openchoreov1alpha1.CanaryStep{Weight: 10, Pause: minute}))
+		rb.Status.History = rb.Status.History[:1]
+		assert.Zero(t, advanceRollout(rb, false, t0))
+		assert.Equal(t, openchoreov1alpha1.RolloutPhaseSucceeded, rb.Status.Rollout.Phase)
+		assert.Equal(t, "r2", rb.Status.Rollout.StableRelease)
+	})
+
+	t.Run("canary moves through timed steps", func(t *testing.T) {
+		rb := rolloutBinding(canaryStrategy(
+			openchoreov1alpha1.CanaryStep{Weight: 10, Pause: minute},
+			openchoreov1alpha1.CanaryStep{Weight: 50, Pause: minute},
+		))
+		assert.Equal(t, time.Minute, advanceRollout(rb, true, t0))
+		rollout := rb.Status.Rollout
+		assert.Equal(t, openchoreov1alpha1.RolloutPhaseProgressing, rollout.Phase)
+		assert.Equal(t, openchoreov1alpha1.RolloutStrategyCanary, rollout.Strategy)
+		assert.Equal(t, "r1", rollout.StableRelease)
+		assert.Equal(t, "r2", rollout.CanaryRelease)
+		assert.Equal(t, int32(10), rollout.CanaryWeight)
+
+		t1 := metav1.NewTime(t0.Add(90 * time.Second))
+		assert.Equal(t, time.Minute, advanceRollout(rb, true, t1))
+		assert.Equal(t, int32(1), rollout.Step)
+		assert.Equal(t, int32(50), rollout.CanaryWeight)
+
+		assert.Zero(t, advanceRollout(rb, true, metav1.NewTime(t1.Add(time.Minute))))
+		assert.Equal(t, openchoreov1alpha1.RolloutPhaseSucceeded, rollout.Phase)
+		assert.Equal(t, "r2", rollout.StableRelease)
+		assert.Empty(t, rollout.CanaryRelease)
+	})
+
+	t.Run("step without pause waits to be resumed", func(t *testing.T) {
+		rb := rolloutBinding(canaryStrategy(
+			openchoreov1alpha1.CanaryStep{Weight: 20},
+			openchoreov1alpha1.CanaryStep{Weight: 60, Pause: minute},
+		))
+		assert.Zero(t, advanceRollout(rb, true, t0))
+		assert.Equal(t, openchoreov1alpha1.RolloutPhasePaused, rb.Status.Rollout.Phase)
+		assert.Equal(t, int32(20), rb.Status.Rollout.CanaryWeight)
+
+		controller.SetRolloutAction(rb, controller.RolloutActionResume, t0.Time)
+		assert.Equal(t, time.Minute, advanceRollout(rb, true, t0))
+		assert.Equal(t, openchoreov1alpha1.RolloutPhaseProgressing, rb.Status.Rollout.Phase)
+		assert.Equal(t, int32(60), rb.Status.Rollout.CanaryWeight)
+
+		// An action is handled only once.
+		controller.SetRolloutAction(rb, controller.RolloutActionPause, t0.Time)
+		advanceRollout(rb, true, t0)
+		rb.Status.Rollout.Phase = openchoreov1alpha1.RolloutPhaseProgressing
+		advanceRollout(rb, true, t0)
+		assert.Equal(t, openchoreov1alpha1.RolloutPhaseProgressing, rb.Status.Rollout.Phase)
+	})
+
+	t.Run("blue-green waits for promotion", func(t *testing.T) {
+		rb := rolloutBinding(&openchoreov1alpha1.RolloutStrategy{Type: openchoreov1alpha1.RolloutStrategyBlueGreen})
+		advanceRollout(rb, true, t0)
+		assert.Equal(t, openchoreov1alpha1.RolloutPhasePaused, rb.Status.Rollout.Phase)
+		assert.Zero(t, rb.Status.Rollout.CanaryWeight)
+
+		controller.SetRolloutAction(rb, controller.RolloutActionPromote, t0.Time)
+		advanceRollout(rb, true, t0)
+		assert.Equal(t, openchoreov1alpha1.RolloutPhaseSucceeded, rb.Status.Rollout.Phase)
+		assert.Equal(t, "r2", rb.Status.Rollout.StableRelease)
+	})
+
+	t.Run("abort keeps the stable release until the release changes", func(t *testing.T) {
+		rb := rolloutBinding(canaryStrategy(openchoreov1alpha1.CanaryStep{Weight: 30, Pause: minute}))
+		advanceRollout(rb, true, t0)
+		controller.SetRolloutAction(rb, controller.RolloutActionAbort, t0.Time)
+		assert.Zero(t, advanceRollout(rb, true, t0))
+		assert.Equal(t, openchoreov1alpha1.RolloutPhaseAborted, rb.Status.Rollout.Phase)
+		assert.Zero(t, rb.Status.Rollout.CanaryWeight)
+
+		advanceRollout(rb, true, metav1.NewTime(t0.Add(time.Hour)))
+		assert.Equal(t, openchoreov1alpha1.RolloutPhaseAborted, rb.Status.Rollout.Phase)
+
+		rb.Spec.ReleaseName = "r3"
+		advanceRollout(rb, true, t0)
+		assert.Equal(t, openchoreov1alpha1.RolloutPhaseProgressing, rb.Status.Rollout.Phase)
+		assert.Equal(t, "r1", rb.Status.Rollout.StableRelease)
+		assert.Equal(t, "r3", rb.Status.Rollout.CanaryRelease)
+
+		rb.Spec.ReleaseName = "r1"
+		advanceRollout(rb, true, t0)
+		assert.Equal(t, openchoreov1alpha1.RolloutPhaseSucceeded, rb.Status.Rollout.Phase)
+		assert.Equal(t, "r1", rb.Status.Rollout.StableRelease)
+	})
+}
+
+func manifest(t *testing.T, id string, obj map[string]any) openchoreov1alpha1.RenderedManifest {
+	t.Helper()
+	raw, err := json.Marshal(obj)
+	require.NoError(t, err)
+	return openchoreov1alpha1.RenderedManifest{ID: id, Object: &runtime.RawExtension{Raw: raw}}
+}
+
+func rolloutManifests(t *testing.T, image string) []openchoreov1alpha1.RenderedManifest {
+	return []openchoreov1alpha1.RenderedManifest{
+		manifest(t, "deployment-app", map[string]any{
+			"apiVersion": "apps/v1", "kind": "Deployment", "metadata": map[string]any{"name": "app"},
+			"spec": map[string]any{
+				"selector": map[string]any{"matchLabels": map[string]any{"app": "app"}},
+				"template": map[string]any{
+					"metadata": map[string]any{"labels": map[string]any{"app": "app"}},
+					"spec":     map[string]any{"containers": []any{map[string]any{"name": "main", "image": image}}},
+				},
+			},
+		}),
+		manifest(t, "service-app", map[string]any{
+			"apiVersion": "v1", "kind": "Service", "metadata": map[string]any{"name": "app"},
+			"spec": map[string]any{"selector": map[string]any{"app": "app"}},
+		}),
+		manifest(t, "httproute-app", map[string]any{
+			"apiVersion": "gateway.networking.k8s.io/v1", "kind": "HTTPRoute", "metadata": map[string]any{"name": "app"},
+			"spec": map[string]any{"rules": []any{map[string]any{
+				"backendRefs": []any{map[string]any{"name": "app", "port": int64(80)}},
+			}}},
+		}),
+		manifest(t, "configmap-app", map[string]any{
+			"apiVersion": "v1", "kind": "ConfigMap", "metadata": map[string]any{"name": "app"},
+		}),
+	}
+}
+
+func manifestsByID(t *testing.T, manifests []openchoreov1alpha1.RenderedManifest) map[string]*unstructured.Unstructured {
+	t.Helper()
+	objs := make(map[string]*unstructured.Unstructured, len(manifests))
+	for _, m := range manifests {
+		obj, err := manifestObject(m)
+		require.NoError(t, err)
+		objs[m.ID] = obj
+	}
+	return objs
+}
+
+func TestApplyRollout(t *testing.T) {
+	r := &Reconciler{}
+	stable := rolloutManifests(t, "app:v1")
+	rendered := rolloutManifests(t, "app:v2")
+
+	t.Run("without a rollout the rendered resources are used", func(t *testing.T) {
+		got, err := r.applyRollout(nil, rendered, stable)
+		require.NoError(t, err)
+		assert.Equal(t, rendered, got)
+	})
+
+	t.Run("completed rollout labels the stable pods", func(t *testing.T) {
+		got, err := r.applyRollout(&openchoreov1alpha1.RolloutStatus{Phase: openchoreov1alpha1.RolloutPhaseSucceeded}, rendered, stable)
+		require.NoError(t, err)
+		objs := manifestsByID(t, got)
+		require.Len(t, objs, 4)
+		track, _, _ := unstructured.NestedString(objs["deployment-app"].Object, "spec", "template", "metadata", "labels", labels.LabelKeyRolloutTrack)
+		assert.Equal(t, labels.LabelValueRolloutTrackStable, track)
+		_, found, _ := unstructured.NestedString(objs["service-app"].Object, "spec", "selector", labels.LabelKeyRolloutTrack)
+		assert.False(t, found)
+	})
+
+	t.Run("canary runs next to the stable release with split traffic", func(t *testing.T) {
+		rollout := &openchoreov1alpha1.RolloutStatus{Phase: openchoreov1alpha1.RolloutPhaseProgressing, CanaryWeight: 25}
+		got, err := r.applyRollout(rollout, rendered, stable)
+		require.NoError(t, err)
+		objs := manifestsByID(t, got)
+		require.Len(t, objs, 6)
+
+		image, _, _ := unstructured.NestedSlice(objs["deployment-app"].Object, "spec", "template", "spec", "containers")
+		assert.Equal(t, "app:v1", image[0].(map[string]any)["image"])
+		stableSelector, _, _ := unstructured.NestedStringMap(objs["service-app"].Object, "spec", "selector")
+		assert.Equal(t, labels.LabelValueRolloutTrackStable, stableSelector[labels.LabelKeyRolloutTrack])
+
+		canary := objs["deployment-app-canary"]
+		require.NotNil(t, canary)
+		assert.Equal(t, "app-canary", canary.GetName())
+		image, _, _ = unstructured.NestedSlice(canary.Object, "spec", "template", "spec", "containers")
+		assert.Equal(t, "app:v2", image[0].(map[string]any)["image"])
+		matchLabels, _, _ := unstructured.NestedStringMap(canary.Object, "spec", "selector", "matchLabels")
+		assert.Equal(t, labels.LabelValueRolloutTrackCanary, matchLabels[labels.LabelKeyRolloutTrack])
+		canarySelector, _, _ := unstructured.NestedStringMap(objs["service-app-canary"].Object, "spec", "selector")
+		assert.Equal(t, labels.LabelValueRolloutTrackCanary, canarySelector[labels.LabelKeyRolloutTrack])
+
+		rules, _, _ := unstructured.NestedSlice(objs["httproute-app"].Object, "spec", "rules")
+		backends := rules[0].(map[string]any)["backendRefs"].([]any)
+		require.Len(t, backends, 2)
+		assert.Equal(t, map[string]any{"name": "app", "port": int64(80), "weight": int64(75)}, backends[0])
+		assert.Equal(t, map[string]any{"name": "app-canary", "port": int64(80), "weight": int64(25)}, backends[1])
+
+		// The next reconcile keeps the stable workloads from the release with the canary.
+		again, err := r.applyRollout(rollout, rendered, got)
+		require.NoError(t, err)
+		assert.Len(t, manifestsByID(t, again), 6)
+		assert.True(t, hasStableWorkloads(got))
+	})
+
+	t.Run("aborted rollout drops the canary", func(t *testing.T) {
+		inProgress, err := r.applyRollout(&openchoreov1alpha1.RolloutStatus{Phase: openchoreov1alpha1.RolloutPhasePaused, CanaryWeight: 50}, rendered, stable)
+		require.NoError(t, err)
+		got, err := r.applyRollout(&openchoreov1alpha1.RolloutStatus{Phase: openchoreov1alpha1.RolloutPhaseAborted}, rendered, inProgress)
+		require.NoError(t, err)
+		objs := manifestsByID(t, got)
+		require.Len(t, objs, 4)
+		image, _, _ := unstructured.NestedSlice(objs["deployment-app"].Object, "spec", "template", "spec", "containers")
+		assert.Equal(t, "app:v1", image[0].(map[string]any)["image"])
+		rules, _, _ := unstructured.NestedSlice(objs["httproute-app"].Object, "spec", "rules")
+		assert.Len(t, rules[0].(map[string]any)["backendRefs"], 1)
+	})
+}
@@ -190,6 +190,20 @@ func TestValidateComponentRelease_OwnerMismatch_Component(t *testing.T) {
 	}
 }
 
+func TestValidateComponentRelease_AutoscalingNotAllowed(t *testing.T) {
+	r := newTestReconciler()
+	cr := makeValidComponentRelease("proj", "comp")
+	cr.Spec.Workload.Autoscaling = &openchoreov1alpha1.AutoscalingConfig{MaxReplicas: 5}
+	rb := makeValidReleaseBinding("proj", "comp")
+
+	err := r.validateComponentRelease(cr, rb)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid autoscaling configuration")
+
+	cr.Spec.ComponentType.Spec.Autoscaling = &openchoreov1alpha1.AutoscalingLimits{MaxReplicas: 10}
+	assert.NoError(t, r.validateComponentRelease(cr, rb))
+}
+
 // ---- NewReleaseBindingFinalizingCondition ----
 
 func TestNewReleaseBindingFinalizingCondition(t *testing.T) {
@@ -1998,6 +2012,67 @@ func TestBuildConnectionItems(t *testing.T) {
 	})
 }
 
+func TestBuildExternalConnectionItems(t *testing.T) {
+	bindings := openchoreov1alpha1.ConnectionEnvBindings{
+		Address:  "ADDR",
+		Host:     "HOST",
+		Port:     "PORT",
+		BasePath: "PATH",
+	}
+
+	tests := []struct {
+		name    string
+		address string
+		want    map[string]string
+	}{
+		{
+			name:    "url with port and path",
+			address: "https://api.stripe.com:443/v1",
+			want:    map[string]string{"ADDR": "https://api.stripe.com:443/v1", "HOST": "api.stripe.com", "PORT": "443", "PATH": "/v1"},
+		},
+		{
+			name:    "url without port",
+			address: "https://api.example.com",
+			want:    map[string]string{"ADDR": "https://api.example.com", "HOST": "api.example.com", "PORT": "", "PATH": ""},
+		},
+		{
+			name:    "host and port",
+			address: "db.example.com:5432",
+			want:    map[string]string{"ADDR": "db.example.com:5432", "HOST": "db.example.com", "PORT": "5432", "PATH": ""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, err := buildExternalConnectionItems([]openchoreov1alpha1.WorkloadExternalConnection{
+				{Name: "ext", Address: tt.address, EnvBindings: bindings},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(items) != 1 {
+				t.Fatalf("expected 1 item, got %d", len(items))
+			}
+			if !items[0].External || items[0].Endpoint != "ext" {
+				t.Errorf("expected external item named ext, got %+v", items[0])
+			}
+			got := make(map[string]string, len(items[0].EnvVars))
+			for _, ev := range items[0].EnvVars {
+				got[ev.Name] = ev.Value
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("invalid port", func(t *testing.T) {
+		_, err := buildExternalConnectionItems([]openchoreov1alpha1.WorkloadExternalConnection{
+			{Name: "ext", Address: "db.example.com:99999", EnvBindings: bindings},
+		})
+		if err == nil {
+			t.Fatal("expected error for invalid port")
+		}
+	})
+}
+
 func TestResolveURLForVisibility(t *testing.T) {
 	ep := openchoreov1alpha1.EndpointURLStatus{
 		Name: "api",
@@ -18,6 +18,7 @@ import (
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/labels"
 	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
 )
 
@@ -283,6 +284,12 @@ func (r *Reconciler) listReleaseBindingsForSecretReference(ctx context.Context,
 	secretRef := obj.(*openchoreov1alpha1.SecretReference)
 	logger := log.FromContext(ctx)
 
+	// Registry credentials are not referenced by workloads but attached to the workloads
+	// deployed on their data plane.
+	if secretRef.Labels[labels.LabelKeySecretType] == labels.LabelValueRegistryCredentials {
+		return r.releaseBindingsForRegistryCredential(ctx, secretRef)
+	}
+
 	logger.Info("SecretReference changed, finding affected ReleaseBindings",
 		"secretReference", secretRef.Name,
 		"namespace", secretRef.Namespace)
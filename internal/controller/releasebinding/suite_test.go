@@ -106,6 +106,8 @@ var _ = BeforeSuite(func() {
 				&openchoreodevv1alpha1.DeploymentPipeline{}:     {},
 				&openchoreodevv1alpha1.ResourceRelease{}:        {},
 				&openchoreodevv1alpha1.ResourceReleaseBinding{}: {},
+				&openchoreodevv1alpha1.CustomDomain{}:           {},
+				&openchoreodevv1alpha1.Connection{}:             {},
 			},
 		},
 	})
@@ -306,13 +306,13 @@ func (r *Reconciler) makeDesiredResources(release *openchoreov1alpha1.RenderedRe
 }
 
 // injectRestartedAt sets openchoreo.dev/restartedAt on the pod template of an
-// apps/v1 Deployment so a change to the value causes the data plane to perform
-// a rolling restart. It is a no-op for any other kind. Returns an error if the
+// apps/v1 Deployment or StatefulSet so a change to the value causes the data
+// plane to perform a rolling restart. It is a no-op for any other kind. Returns an error if the
 // manifest is malformed (e.g. annotations is not a string map), so the caller
 // can surface it instead of silently dropping the restart trigger.
 func injectRestartedAt(obj *unstructured.Unstructured, value string) error {
 	gvk := obj.GroupVersionKind()
-	if gvk.Group != appsAPIGroup || gvk.Kind != "Deployment" {
+	if gvk.Group != appsAPIGroup || (gvk.Kind != "Deployment" && gvk.Kind != "StatefulSet") {
 		return nil
 	}
 	annotations, _, err := unstructured.NestedStringMap(obj.Object, "spec", "template", "metadata", "annotations")
@@ -1092,6 +1092,45 @@ func TestMakeDesiredResources(t *testing.T) {
 // makeDesiredNamespaces
 // ─────────────────────────────────────────────────────────────
 
+// ─────────────────────────────────────────────────────────────
+// injectRestartedAt
+// ─────────────────────────────────────────────────────────────
+
+func TestInjectRestartedAt(t *testing.T) {
+	const value = "2026-01-02T03:04:05Z"
+	newObj := func(apiVersion, kind string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetName("app")
+		return obj
+	}
+
+	for _, kind := range []string{"Deployment", "StatefulSet"} {
+		t.Run(kind+" pod template is annotated", func(t *testing.T) {
+			obj := newObj("apps/v1", kind)
+			if err := injectRestartedAt(obj, value); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got, _, _ := unstructured.NestedString(obj.Object,
+				"spec", "template", "metadata", "annotations", "openchoreo.dev/restartedAt")
+			if got != value {
+				t.Errorf("expected restartedAt %q, got %q", value, got)
+			}
+		})
+	}
+
+	t.Run("other kinds are left untouched", func(t *testing.T) {
+		obj := newObj("batch/v1", "CronJob")
+		if err := injectRestartedAt(obj, value); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, found, _ := unstructured.NestedMap(obj.Object, "spec"); found {
+			t.Errorf("expected no spec to be set, got %v", obj.Object["spec"])
+		}
+	})
+}
+
 func TestMakeDesiredNamespaces(t *testing.T) {
 	r := &Reconciler{}
 
@@ -52,6 +52,15 @@ const (
 	// so the Project controller can list all bindings of a project regardless of author
 	// (labels are optional on externally authored bindings).
 	IndexKeyProjectReleaseBindingOwner = "projectreleasebinding.spec.owner.projectName"
+
+	// IndexKeyCustomDomainOwnerEnv indexes CustomDomain by the composite key
+	// (projectName, componentName, environment) so a ReleaseBinding can find the
+	// domains to serve its endpoints on.
+	IndexKeyCustomDomainOwnerEnv = "customdomain.spec.owner.projectName/componentName/environment"
+
+	// IndexKeyCustomDomainDomain indexes CustomDomain by domain so claims on the
+	// same domain can be detected across namespaces.
+	IndexKeyCustomDomainDomain = "customdomain.spec.domain"
 )
 
 // MakeReleaseBindingOwnerEnvKey creates the composite index key for ReleaseBinding lookups
@@ -172,6 +181,16 @@ func SetupSharedIndexes(ctx context.Context, mgr ctrl.Manager) error {
 		return fmt.Errorf("failed to setup ProjectReleaseBinding owner index: %w", err)
 	}
 
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &openchoreov1alpha1.CustomDomain{},
+		IndexKeyCustomDomainOwnerEnv, IndexCustomDomainOwnerEnv); err != nil {
+		return fmt.Errorf("failed to setup CustomDomain owner+env index: %w", err)
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &openchoreov1alpha1.CustomDomain{},
+		IndexKeyCustomDomainDomain, IndexCustomDomainDomain); err != nil {
+		return fmt.Errorf("failed to setup CustomDomain domain index: %w", err)
+	}
+
 	return nil
 }
 
@@ -223,6 +242,32 @@ func IndexProjectReleaseBindingOwner(obj client.Object) []string {
 	return []string{prb.Spec.Owner.ProjectName}
 }
 
+// IndexCustomDomainOwnerEnv extracts the composite (project, component, environment)
+// index key from a CustomDomain. The key has the same shape as the ReleaseBinding
+// owner+env key. Exported for fake-client tests so they can register the same
+// indexer the production setup uses.
+func IndexCustomDomainOwnerEnv(obj client.Object) []string {
+	cd := obj.(*openchoreov1alpha1.CustomDomain)
+	if cd.Spec.Owner.ProjectName == "" || cd.Spec.Owner.ComponentName == "" || cd.Spec.Environment == "" {
+		return nil
+	}
+	return []string{MakeReleaseBindingOwnerEnvKey(
+		cd.Spec.Owner.ProjectName,
+		cd.Spec.Owner.ComponentName,
+		cd.Spec.Environment,
+	)}
+}
+
+// IndexCustomDomainDomain extracts the domain from a CustomDomain. Exported for
+// fake-client tests so they can register the same indexer the production setup uses.
+func IndexCustomDomainDomain(obj client.Object) []string {
+	cd := obj.(*openchoreov1alpha1.CustomDomain)
+	if cd.Spec.Domain == "" {
+		return nil
+	}
+	return []string{cd.Spec.Domain}
+}
+
 // HierarchyWatchHandler is a function that creates a watch handler for a specific hierarchy.
 // It can be used to watch from parent object for child object updates.
 // The hierarchyFunc should return the target object that is being watched given the source object.
@@ -36,6 +36,8 @@ type Reconciler struct {
 	ClientMgr     *kubernetesClient.KubeMultiClientManager
 	GatewayClient *gatewayClient.Client // Client for notifying cluster-gateway
 	CacheVersion  string                // Cache key version prefix (e.g., "v2")
+	// PlaneClientProvider provides clients for probing workflow plane health and capacity
+	PlaneClientProvider kubernetesClient.WorkflowPlaneClientProvider
 }
 
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=workflowplanes,verbs=get;list;watch;create;update;patch;delete
@@ -110,6 +112,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 				logger.Error(err, "failed to get agent connection status")
 				// Don't fail reconciliation for status query errors
 			}
+			r.populateHealth(ctx, workflowPlane)
 		} else {
 			logger.Info("skipping immediate status poll after spec-change notification, agents may be reconnecting")
 		}
@@ -118,7 +121,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			logger.Error(err, "failed to update WorkflowPlane status")
 		}
 
-		// Requeue to refresh agent connection status
+		// Requeue to refresh agent connection status and capacity
 		return ctrl.Result{RequeueAfter: controller.StatusUpdateInterval}, nil
 	}
 
@@ -0,0 +1,215 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowplane
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	argoproj "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+)
+
+const (
+	// ConditionHealthy reports whether the workflow plane can accept and start workflows.
+	ConditionHealthy controller.ConditionType = "Healthy"
+)
+
+const (
+	ReasonHealthy            controller.ConditionReason = "Healthy"
+	ReasonAgentDisconnected  controller.ConditionReason = "AgentDisconnected"
+	ReasonProbeFailed        controller.ConditionReason = "ProbeFailed"
+	ReasonNoSchedulableNodes controller.ConditionReason = "NoSchedulableNodes"
+	ReasonWorkflowsQueued    controller.ConditionReason = "WorkflowsQueued"
+)
+
+// QueueStallThreshold is how long a workflow may stay pending before the plane is
+// reported as unhealthy.
+const QueueStallThreshold = 5 * time.Minute
+
+// populateHealth probes the workflow plane for workflow and node capacity and sets the
+// Capacity status and the Healthy condition (without persisting to API server).
+func (r *Reconciler) populateHealth(ctx context.Context, workflowPlane *openchoreov1alpha1.WorkflowPlane) {
+	logger := log.FromContext(ctx).WithValues("workflowplane", workflowPlane.Name)
+
+	// Skip if plane clients are not configured
+	if r.PlaneClientProvider == nil {
+		return
+	}
+
+	if conn := workflowPlane.Status.AgentConnection; conn != nil && !conn.Connected {
+		setHealthyCondition(workflowPlane, metav1.ConditionFalse, ReasonAgentDisconnected,
+			"No cluster agent is connected; workflows cannot be scheduled")
+		return
+	}
+
+	wpClient, err := r.PlaneClientProvider.WorkflowPlaneClient(workflowPlane)
+	if err != nil {
+		logger.Error(err, "failed to get workflow plane client")
+		setHealthyCondition(workflowPlane, metav1.ConditionFalse, ReasonProbeFailed, err.Error())
+		return
+	}
+
+	capacity, err := probeCapacity(ctx, wpClient)
+	if err != nil {
+		logger.Error(err, "failed to probe workflow plane capacity")
+		setHealthyCondition(workflowPlane, metav1.ConditionFalse, ReasonProbeFailed, err.Error())
+		return
+	}
+	workflowPlane.Status.Capacity = capacity
+
+	status, reason, message := evaluateCapacity(capacity, time.Now())
+	setHealthyCondition(workflowPlane, status, reason, message)
+
+	logger.Info("populated workflow plane capacity",
+		"pendingWorkflows", capacity.PendingWorkflows,
+		"runningWorkflows", capacity.RunningWorkflows,
+		"readyNodes", capacity.ReadyNodes,
+	)
+}
+
+// probeCapacity counts pending and running workflows and computes the CPU and memory
+// that remain unrequested on ready, schedulable nodes.
+func probeCapacity(ctx context.Context, c client.Client) (*openchoreov1alpha1.WorkflowPlaneCapacity, error) {
+	capacity := &openchoreov1alpha1.WorkflowPlaneCapacity{LastProbeTime: metav1.Now()}
+
+	var workflows argoproj.WorkflowList
+	if err := c.List(ctx, &workflows); err != nil {
+		return nil, fmt.Errorf("failed to list workflows: %w", err)
+	}
+	for i := range workflows.Items {
+		wf := &workflows.Items[i]
+		switch wf.Status.Phase {
+		case argoproj.WorkflowUnknown, argoproj.WorkflowPending:
+			capacity.PendingWorkflows++
+			created := wf.CreationTimestamp
+			if capacity.OldestPendingSince == nil || created.Before(capacity.OldestPendingSince) {
+				capacity.OldestPendingSince = &created
+			}
+		case argoproj.WorkflowRunning:
+			capacity.RunningWorkflows++
+		}
+	}
+
+	var nodes corev1.NodeList
+	if err := c.List(ctx, &nodes); err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	cpu := resource.Quantity{}
+	memory := resource.Quantity{}
+	readyNodes := make(map[string]bool, len(nodes.Items))
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		if !isNodeSchedulable(node) {
+			continue
+		}
+		readyNodes[node.Name] = true
+		cpu.Add(node.Status.Allocatable[corev1.ResourceCPU])
+		memory.Add(node.Status.Allocatable[corev1.ResourceMemory])
+	}
+	capacity.ReadyNodes = int32(len(readyNodes)) //nolint:gosec // node count cannot overflow int32
+
+	var pods corev1.PodList
+	if err := c.List(ctx, &pods); err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if !readyNodes[pod.Spec.NodeName] ||
+			pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		requests := podRequests(pod)
+		cpu.Sub(requests[corev1.ResourceCPU])
+		memory.Sub(requests[corev1.ResourceMemory])
+	}
+	if cpu.Sign() < 0 {
+		cpu = resource.Quantity{}
+	}
+	if memory.Sign() < 0 {
+		memory = resource.Quantity{}
+	}
+	capacity.AvailableCPU = &cpu
+	capacity.AvailableMemory = &memory
+
+	return capacity, nil
+}
+
+// isNodeSchedulable returns true if the node is Ready and not cordoned.
+func isNodeSchedulable(node *corev1.Node) bool {
+	if node.Spec.Unschedulable {
+		return false
+	}
+	for _, cond := range node.Status.Conditions {
+		if cond.Type == corev1.NodeReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// podRequests returns the effective resource requests of a pod: the larger of the
+// sum of its containers and the largest init container, per resource.
+func podRequests(pod *corev1.Pod) corev1.ResourceList {
+	requests := corev1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		for name, qty := range container.Resources.Requests {
+			total := requests[name]
+			total.Add(qty)
+			requests[name] = total
+		}
+	}
+	for _, container := range pod.Spec.InitContainers {
+		for name, qty := range container.Resources.Requests {
+			if current, ok := requests[name]; !ok || qty.Cmp(current) > 0 {
+				requests[name] = qty
+			}
+		}
+	}
+	return requests
+}
+
+// evaluateCapacity derives the Healthy condition from the observed capacity.
+func evaluateCapacity(
+	capacity *openchoreov1alpha1.WorkflowPlaneCapacity,
+	now time.Time,
+) (metav1.ConditionStatus, controller.ConditionReason, string) {
+	if capacity.ReadyNodes == 0 {
+		return metav1.ConditionFalse, ReasonNoSchedulableNodes, "No ready, schedulable nodes in the workflow plane"
+	}
+	if capacity.OldestPendingSince != nil {
+		waiting := now.Sub(capacity.OldestPendingSince.Time)
+		if waiting > QueueStallThreshold {
+			return metav1.ConditionFalse, ReasonWorkflowsQueued, fmt.Sprintf(
+				"%d workflows pending, oldest waiting for %s", capacity.PendingWorkflows, waiting.Round(time.Second))
+		}
+	}
+	return metav1.ConditionTrue, ReasonHealthy, fmt.Sprintf(
+		"%d workflows running, %d pending on %d ready nodes",
+		capacity.RunningWorkflows, capacity.PendingWorkflows, capacity.ReadyNodes)
+}
+
+func setHealthyCondition(
+	workflowPlane *openchoreov1alpha1.WorkflowPlane,
+	status metav1.ConditionStatus,
+	reason controller.ConditionReason,
+	message string,
+) {
+	meta.SetStatusCondition(&workflowPlane.Status.Conditions, metav1.Condition{
+		Type:               string(ConditionHealthy),
+		Status:             status,
+		Reason:             string(reason),
+		Message:            message,
+		ObservedGeneration: workflowPlane.Generation,
+	})
+}
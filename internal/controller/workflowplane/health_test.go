@@ -0,0 +1,232 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workflowplane
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	argoproj "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
+)
+
+type fakeWorkflowPlaneClientProvider struct {
+	client client.Client
+	err    error
+}
+
+func (p *fakeWorkflowPlaneClientProvider) WorkflowPlaneClient(*openchoreov1alpha1.WorkflowPlane) (client.Client, error) {
+	return p.client, p.err
+}
+
+func (p *fakeWorkflowPlaneClientProvider) ClusterWorkflowPlaneClient(*openchoreov1alpha1.ClusterWorkflowPlane) (client.Client, error) {
+	return p.client, p.err
+}
+
+func newPlaneClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := argoproj.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	return fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build()
+}
+
+func testNode(name string, ready, unschedulable bool) *corev1.Node {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       corev1.NodeSpec{Unschedulable: unschedulable},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}},
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("8Gi"),
+			},
+		},
+	}
+}
+
+func testPod(name, node string, phase corev1.PodPhase, cpu, memory string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "workflows"},
+		Spec: corev1.PodSpec{
+			NodeName: node,
+			Containers: []corev1.Container{{
+				Name: "main",
+				Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(cpu),
+					corev1.ResourceMemory: resource.MustParse(memory),
+				}},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+}
+
+func testWorkflow(name string, phase argoproj.WorkflowPhase, created time.Time) *argoproj.Workflow {
+	return &argoproj.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "workflows",
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Status: argoproj.WorkflowStatus{Phase: phase},
+	}
+}
+
+func TestProbeCapacity(t *testing.T) {
+	created := time.Now().Add(-time.Hour).Truncate(time.Second)
+	c := newPlaneClient(t,
+		testNode("ready", true, false),
+		testNode("not-ready", false, false),
+		testNode("cordoned", true, true),
+		testPod("build-1", "ready", corev1.PodRunning, "1500m", "2Gi"),
+		testPod("done", "ready", corev1.PodSucceeded, "2", "2Gi"),
+		testPod("elsewhere", "not-ready", corev1.PodRunning, "2", "2Gi"),
+		testWorkflow("wf-pending", argoproj.WorkflowPending, created),
+		testWorkflow("wf-new", argoproj.WorkflowUnknown, created.Add(time.Minute)),
+		testWorkflow("wf-running", argoproj.WorkflowRunning, created),
+		testWorkflow("wf-done", argoproj.WorkflowSucceeded, created),
+	)
+
+	capacity, err := probeCapacity(context.Background(), c)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if capacity.PendingWorkflows != 2 {
+		t.Errorf("PendingWorkflows: got %d, want 2", capacity.PendingWorkflows)
+	}
+	if capacity.RunningWorkflows != 1 {
+		t.Errorf("RunningWorkflows: got %d, want 1", capacity.RunningWorkflows)
+	}
+	if capacity.OldestPendingSince == nil || !capacity.OldestPendingSince.Time.Equal(created) {
+		t.Errorf("OldestPendingSince: got %v, want %v", capacity.OldestPendingSince, created)
+	}
+	if capacity.ReadyNodes != 1 {
+		t.Errorf("ReadyNodes: got %d, want 1", capacity.ReadyNodes)
+	}
+	if capacity.AvailableCPU.Cmp(resource.MustParse("2500m")) != 0 {
+		t.Errorf("AvailableCPU: got %s, want 2500m", capacity.AvailableCPU.String())
+	}
+	if capacity.AvailableMemory.Cmp(resource.MustParse("6Gi")) != 0 {
+		t.Errorf("AvailableMemory: got %s, want 6Gi", capacity.AvailableMemory.String())
+	}
+}
+
+func TestEvaluateCapacity(t *testing.T) {
+	now := time.Now()
+	recent := metav1.NewTime(now.Add(-time.Minute))
+	stale := metav1.NewTime(now.Add(-QueueStallThreshold - time.Minute))
+
+	tests := []struct {
+		name       string
+		capacity   openchoreov1alpha1.WorkflowPlaneCapacity
+		wantStatus metav1.ConditionStatus
+		wantReason string
+	}{
+		{
+			name:       "healthy",
+			capacity:   openchoreov1alpha1.WorkflowPlaneCapacity{ReadyNodes: 2, RunningWorkflows: 1},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: string(ReasonHealthy),
+		},
+		{
+			name:       "recently queued workflow is healthy",
+			capacity:   openchoreov1alpha1.WorkflowPlaneCapacity{ReadyNodes: 2, PendingWorkflows: 1, OldestPendingSince: &recent},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: string(ReasonHealthy),
+		},
+		{
+			name:       "stalled queue",
+			capacity:   openchoreov1alpha1.WorkflowPlaneCapacity{ReadyNodes: 2, PendingWorkflows: 3, OldestPendingSince: &stale},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: string(ReasonWorkflowsQueued),
+		},
+		{
+			name:       "no schedulable nodes",
+			capacity:   openchoreov1alpha1.WorkflowPlaneCapacity{},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: string(ReasonNoSchedulableNodes),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, reason, message := evaluateCapacity(&tt.capacity, now)
+			if status != tt.wantStatus {
+				t.Errorf("status: got %q, want %q", status, tt.wantStatus)
+			}
+			if string(reason) != tt.wantReason {
+				t.Errorf("reason: got %q, want %q", reason, tt.wantReason)
+			}
+			if message == "" {
+				t.Error("expected a message")
+			}
+		})
+	}
+}
+
+func TestPopulateHealth(t *testing.T) {
+	t.Run("nil provider leaves status untouched", func(t *testing.T) {
+		r := &Reconciler{}
+		wp := &openchoreov1alpha1.WorkflowPlane{}
+		r.populateHealth(context.Background(), wp)
+		if wp.Status.Capacity != nil || len(wp.Status.Conditions) != 0 {
+			t.Error("expected status to remain empty")
+		}
+	})
+
+	t.Run("disconnected agent", func(t *testing.T) {
+		r := &Reconciler{PlaneClientProvider: &fakeWorkflowPlaneClientProvider{}}
+		wp := &openchoreov1alpha1.WorkflowPlane{}
+		wp.Status.AgentConnection = &openchoreov1alpha1.AgentConnectionStatus{Connected: false}
+		r.populateHealth(context.Background(), wp)
+		cond := meta.FindStatusCondition(wp.Status.Conditions, string(ConditionHealthy))
+		if cond == nil || cond.Reason != string(ReasonAgentDisconnected) {
+			t.Errorf("expected AgentDisconnected condition, got %+v", cond)
+		}
+	})
+
+	t.Run("client error", func(t *testing.T) {
+		r := &Reconciler{PlaneClientProvider: &fakeWorkflowPlaneClientProvider{err: errors.New("no gateway")}}
+		wp := &openchoreov1alpha1.WorkflowPlane{}
+		r.populateHealth(context.Background(), wp)
+		cond := meta.FindStatusCondition(wp.Status.Conditions, string(ConditionHealthy))
+		if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != string(ReasonProbeFailed) {
+			t.Errorf("expected ProbeFailed condition, got %+v", cond)
+		}
+	})
+
+	t.Run("records capacity", func(t *testing.T) {
+		c := newPlaneClient(t, testNode("ready", true, false))
+		r := &Reconciler{PlaneClientProvider: &fakeWorkflowPlaneClientProvider{client: c}}
+		wp := &openchoreov1alpha1.WorkflowPlane{}
+		r.populateHealth(context.Background(), wp)
+		if wp.Status.Capacity == nil || wp.Status.Capacity.ReadyNodes != 1 {
+			t.Fatalf("expected capacity with 1 ready node, got %+v", wp.Status.Capacity)
+		}
+		cond := meta.FindStatusCondition(wp.Status.Conditions, string(ConditionHealthy))
+		if cond == nil || cond.Status != metav1.ConditionTrue {
+			t.Errorf("expected Healthy condition, got %+v", cond)
+		}
+	})
+}
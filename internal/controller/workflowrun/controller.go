@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/controller"
 	argoproj "github.com/openchoreo/openchoreo/internal/dataplane/kubernetes/types/argoproj.io/workflow/v1alpha1"
 	workflowpipeline "github.com/openchoreo/openchoreo/internal/pipeline/workflow"
+	"github.com/openchoreo/openchoreo/internal/scanreport"
 )
 
 // Reconciler reconciles a WorkflowRun object
@@ -181,7 +183,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		}, runResource)
 
 		if err == nil {
-			return r.syncWorkflowRunStatus(workflowRun, runResource), nil
+			return r.syncWorkflowRunStatus(ctx, workflowRun, runResource), nil
 		} else if !errors.IsNotFound(err) {
 			logger.Error(err, "failed to get run resource",
 				"runName", workflowRun.Status.RunReference.Name,
@@ -201,6 +203,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	buildCache := workflowPlaneResult.GetBuildCache()
+	if workflowRun.Spec.DisableBuildCache {
+		buildCache = nil
+	}
+
 	renderInput := &workflowpipeline.RenderInput{
 		WorkflowRun: workflowRun,
 		Workflow:    workflow,
@@ -210,6 +217,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 			Labels:          workflowRun.Labels,
 			WorkflowPlane: workflowpipeline.WorkflowPlaneData{
 				SecretStore: workflowPlaneResult.GetSecretStoreName(),
+				BuildCache:  resolveBuildCache(buildCache, workflowRun, workflow.Name),
 			},
 		},
 	}
@@ -248,7 +256,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		return ctrl.Result{Requeue: true}, nil
 	}
 
-	return r.ensureRunResource(ctx, workflowRun, output, runResNamespace, wpClient), nil
+	var volumeCache *openchoreodevv1alpha1.PersistentVolumeBuildCache
+	if buildCache != nil && buildCache.Type == openchoreodevv1alpha1.BuildCacheTypePersistentVolume {
+		volumeCache = buildCache.PersistentVolume
+	}
+
+	return r.ensureRunResource(ctx, workflowRun, output, runResNamespace, volumeCache, wpClient), nil
 }
 
 func (r *Reconciler) ensureRunResource(
@@ -256,6 +269,7 @@ func (r *Reconciler) ensureRunResource(
 	workflowRun *openchoreodevv1alpha1.WorkflowRun,
 	output *workflowpipeline.RenderOutput,
 	runResNamespace string,
+	volumeCache *openchoreodevv1alpha1.PersistentVolumeBuildCache,
 	wpClient client.Client,
 ) ctrl.Result {
 	logger := log.FromContext(ctx)
@@ -275,6 +289,15 @@ func (r *Reconciler) ensureRunResource(
 		return ctrl.Result{Requeue: true}
 	}
 
+	// The cache volume outlives the run so that later builds of the namespace can reuse it
+	if volumeCache != nil {
+		if err := ensureResource(ctx, wpClient, makeBuildCacheClaim(runResNamespace, volumeCache), "PersistentVolumeClaim", logger); err != nil {
+			logger.Error(err, "failed to ensure build cache volume",
+				"workflowrun", workflowRun.Name)
+			return ctrl.Result{Requeue: true}
+		}
+	}
+
 	// Apply additional resources (e.g., secrets, configmaps) before the main workflow
 	appliedResources, err := r.applyRenderedResources(ctx, workflowRun, output.Resources, wpClient)
 	if err != nil {
@@ -295,12 +318,19 @@ func (r *Reconciler) ensureRunResource(
 }
 
 func (r *Reconciler) syncWorkflowRunStatus(
+	ctx context.Context,
 	workflowRun *openchoreodevv1alpha1.WorkflowRun,
 	runResource *argoproj.Workflow,
 ) ctrl.Result {
 	// Extract and update tasks from argo workflow nodes
 	// This should be extended to support other workflow engines in the future
 	workflowRun.Status.Tasks = extractArgoTasksFromWorkflowNodes(runResource.Status.Nodes)
+	if image := extractArgoImageFromWorkflowNodes(runResource.Status.Nodes); image != "" {
+		workflowRun.Status.Image = image
+	}
+	syncArgoScanReport(ctx, workflowRun, runResource.Status.Nodes)
+	syncArgoSBOMReference(workflowRun, runResource.Status.Nodes)
+	syncArgoCacheStatistics(workflowRun, runResource.Status.Nodes)
 
 	switch runResource.Status.Phase {
 	case argoproj.WorkflowRunning:
@@ -669,6 +699,137 @@ func extractArgoTasksFromWorkflowNodes(nodes argoproj.Nodes) []openchoreodevv1al
 	return tasks
 }
 
+const (
+	// imageOutputParameterName is the step output parameter that carries the image built by a workflow.
+	imageOutputParameterName = "image"
+	// scanReportOutputParameterName is the step output parameter that carries the Trivy or Grype
+	// JSON report of the vulnerability scan of the built image.
+	scanReportOutputParameterName = "scan-report"
+	// sbomRefOutputParameterName and sbomFormatOutputParameterName are the step output parameters
+	// that locate the SBOM generated for the built image and name its format.
+	sbomRefOutputParameterName    = "sbom-ref"
+	sbomFormatOutputParameterName = "sbom-format"
+	// cacheHitsOutputParameterName and cacheMissesOutputParameterName are the step output
+	// parameters that count the build cache lookups of a step.
+	cacheHitsOutputParameterName   = "cache-hits"
+	cacheMissesOutputParameterName = "cache-misses"
+)
+
+// extractArgoImageFromWorkflowNodes returns the value of the "image" output parameter
+// reported by the Pod nodes of an Argo Workflow. When several steps report one, the
+// value from the last step in execution order wins. Returns "" if no step reports it.
+func extractArgoImageFromWorkflowNodes(nodes argoproj.Nodes) string {
+	return extractArgoOutputParameterFromWorkflowNodes(nodes, imageOutputParameterName)
+}
+
+// extractArgoOutputParameterFromWorkflowNodes returns the value of the named output parameter
+// reported by the Pod nodes of an Argo Workflow. When several steps report one, the value
+// from the last step in execution order wins. Returns "" if no step reports it.
+func extractArgoOutputParameterFromWorkflowNodes(nodes argoproj.Nodes, name string) string {
+	value := ""
+	valueOrder := -1
+	for _, node := range nodes {
+		if node.Type != argoproj.NodeTypePod || node.Outputs == nil {
+			continue
+		}
+		for _, param := range node.Outputs.Parameters {
+			if param.Name != name || param.Value == nil || *param.Value == "" {
+				continue
+			}
+			if order := extractArgoStepOrderFromNodeName(node.Name); value == "" || order > valueOrder {
+				value = string(*param.Value)
+				valueOrder = order
+			}
+		}
+	}
+	return value
+}
+
+// syncArgoScanReport ingests the vulnerability scan report of the built image once a
+// workflow step reports it. A report that cannot be parsed is logged and skipped.
+func syncArgoScanReport(ctx context.Context, workflowRun *openchoreodevv1alpha1.WorkflowRun, nodes argoproj.Nodes) {
+	if workflowRun.Status.Scan != nil {
+		return
+	}
+	report := extractArgoOutputParameterFromWorkflowNodes(nodes, scanReportOutputParameterName)
+	if report == "" {
+		return
+	}
+	scan, err := scanreport.Parse([]byte(report))
+	if err != nil {
+		log.FromContext(ctx).Info("Ignoring unreadable scan report", "workflowrun", workflowRun.Name, "error", err.Error())
+		return
+	}
+	now := metav1.Now()
+	scan.ScannedAt = &now
+	workflowRun.Status.Scan = scan
+}
+
+// syncArgoSBOMReference records where the SBOM of the built image is stored once a workflow
+// step reports it.
+func syncArgoSBOMReference(workflowRun *openchoreodevv1alpha1.WorkflowRun, nodes argoproj.Nodes) {
+	location := extractArgoOutputParameterFromWorkflowNodes(nodes, sbomRefOutputParameterName)
+	if location == "" || (workflowRun.Status.SBOM != nil && workflowRun.Status.SBOM.Location == location) {
+		return
+	}
+	now := metav1.Now()
+	workflowRun.Status.SBOM = &openchoreodevv1alpha1.SBOMReference{
+		Format:     parseSBOMFormat(extractArgoOutputParameterFromWorkflowNodes(nodes, sbomFormatOutputParameterName)),
+		Location:   location,
+		RecordedAt: &now,
+	}
+}
+
+// syncArgoCacheStatistics records the build cache hits and misses reported by the workflow
+// steps. The counts of all steps are added up.
+func syncArgoCacheStatistics(workflowRun *openchoreodevv1alpha1.WorkflowRun, nodes argoproj.Nodes) {
+	hits, hitsReported := sumArgoOutputParameterFromWorkflowNodes(nodes, cacheHitsOutputParameterName)
+	misses, missesReported := sumArgoOutputParameterFromWorkflowNodes(nodes, cacheMissesOutputParameterName)
+	if !hitsReported && !missesReported {
+		return
+	}
+	workflowRun.Status.Cache = &openchoreodevv1alpha1.BuildCacheStatistics{Hits: hits, Misses: misses}
+}
+
+// sumArgoOutputParameterFromWorkflowNodes adds up the integer values of the named output
+// parameter reported by the Pod nodes of an Argo Workflow. Values that are not non-negative
+// integers are ignored. Reports whether any step reported a value.
+func sumArgoOutputParameterFromWorkflowNodes(nodes argoproj.Nodes, name string) (int32, bool) {
+	var total int32
+	reported := false
+	for _, node := range nodes {
+		if node.Type != argoproj.NodeTypePod || node.Outputs == nil {
+			continue
+		}
+		for _, param := range node.Outputs.Parameters {
+			if param.Name != name || param.Value == nil {
+				continue
+			}
+			n, err := strconv.ParseInt(strings.TrimSpace(string(*param.Value)), 10, 32)
+			if err != nil || n < 0 {
+				continue
+			}
+			total += int32(n)
+			reported = true
+		}
+	}
+	return total, reported
+}
+
+// parseSBOMFormat maps format names such as "spdx-json" or "cyclonedx" onto an SBOM format.
+// Returns "" for unknown formats.
+func parseSBOMFormat(format string) openchoreodevv1alpha1.SBOMFormat {
+	format = strings.ToLower(format)
+	switch {
+	case strings.Contains(format, "spdx"):
+		return openchoreodevv1alpha1.SBOMFormatSPDX
+	case strings.Contains(format, "cyclonedx"), strings.HasPrefix(format, "cdx"):
+		return openchoreodevv1alpha1.SBOMFormatCycloneDX
+	default:
+		return ""
+	}
+}
+
 // extractArgoStepOrderFromNodeName extracts the step order from a node name.
 // Node names follow the pattern: "workflow-name[N].step-name" where N is the order.
 // Returns -1 if the order cannot be extracted.
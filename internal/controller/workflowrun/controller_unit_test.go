@@ -740,6 +740,240 @@ func TestExtractArgoTasksFromWorkflowNodes(t *testing.T) {
 	})
 }
 
+// ---------------------------------------------------------------------------
+// extractArgoImageFromWorkflowNodes
+// ---------------------------------------------------------------------------
+
+func TestExtractArgoImageFromWorkflowNodes(t *testing.T) {
+	imageOutputs := func(image string) *argoproj.Outputs {
+		value := argoproj.AnyString(image)
+		return &argoproj.Outputs{Parameters: []argoproj.Parameter{
+			{Name: "git-revision"},
+			{Name: "image", Value: &value},
+		}}
+	}
+
+	t.Run("no nodes returns empty", func(t *testing.T) {
+		if image := extractArgoImageFromWorkflowNodes(nil); image != "" {
+			t.Errorf("expected empty image, got %q", image)
+		}
+	})
+
+	t.Run("image is read from step outputs", func(t *testing.T) {
+		nodes := argoproj.Nodes{
+			"build": {Name: "wf[1].build", Type: argoproj.NodeTypePod},
+			"publish": {
+				Name:    "wf[2].publish-image",
+				Type:    argoproj.NodeTypePod,
+				Outputs: imageOutputs("registry.local/app@sha256:abc"),
+			},
+		}
+		if image := extractArgoImageFromWorkflowNodes(nodes); image != "registry.local/app@sha256:abc" {
+			t.Errorf("expected image from publish step, got %q", image)
+		}
+	})
+
+	t.Run("last step wins and non-Pod nodes are ignored", func(t *testing.T) {
+		nodes := argoproj.Nodes{
+			"push": {Name: "wf[2].push", Type: argoproj.NodeTypePod, Outputs: imageOutputs("app:v1")},
+			"sign": {Name: "wf[3].sign", Type: argoproj.NodeTypePod, Outputs: imageOutputs("app@sha256:def")},
+			"root": {Name: "wf", Type: argoproj.NodeTypeSteps, Outputs: imageOutputs("ignored")},
+		}
+		if image := extractArgoImageFromWorkflowNodes(nodes); image != "app@sha256:def" {
+			t.Errorf("expected image from last step, got %q", image)
+		}
+	})
+}
+
+// ---------------------------------------------------------------------------
+// syncArgoScanReport
+// ---------------------------------------------------------------------------
+
+func TestSyncArgoScanReport(t *testing.T) {
+	scanOutputs := func(report string) *argoproj.Outputs {
+		value := argoproj.AnyString(report)
+		return &argoproj.Outputs{Parameters: []argoproj.Parameter{{Name: "scan-report", Value: &value}}}
+	}
+	trivyReport := `{"SchemaVersion":2,"Results":[{"Vulnerabilities":[` +
+		`{"VulnerabilityID":"CVE-1","PkgName":"openssl","Severity":"CRITICAL"},` +
+		`{"VulnerabilityID":"CVE-2","PkgName":"zlib","Severity":"LOW"}]}]}`
+
+	t.Run("report is ingested from step outputs", func(t *testing.T) {
+		wfr := &openchoreodevv1alpha1.WorkflowRun{}
+		nodes := argoproj.Nodes{
+			"scan": {Name: "wf[2].scan", Type: argoproj.NodeTypePod, Outputs: scanOutputs(trivyReport)},
+		}
+		syncArgoScanReport(context.Background(), wfr, nodes)
+		if wfr.Status.Scan == nil {
+			t.Fatal("expected scan result")
+		}
+		if wfr.Status.Scan.Scanner != "trivy" || wfr.Status.Scan.Summary.Critical != 1 || wfr.Status.Scan.Summary.Low != 1 {
+			t.Errorf("unexpected scan result %+v", wfr.Status.Scan)
+		}
+		if wfr.Status.Scan.ScannedAt == nil {
+			t.Error("expected ScannedAt to be set")
+		}
+	})
+
+	t.Run("unreadable report is skipped", func(t *testing.T) {
+		wfr := &openchoreodevv1alpha1.WorkflowRun{}
+		nodes := argoproj.Nodes{
+			"scan": {Name: "wf[2].scan", Type: argoproj.NodeTypePod, Outputs: scanOutputs("not json")},
+		}
+		syncArgoScanReport(context.Background(), wfr, nodes)
+		if wfr.Status.Scan != nil {
+			t.Errorf("expected no scan result, got %+v", wfr.Status.Scan)
+		}
+	})
+
+	t.Run("existing result is kept", func(t *testing.T) {
+		existing := &openchoreodevv1alpha1.ImageScanResult{Scanner: "grype"}
+		wfr := &openchoreodevv1alpha1.WorkflowRun{}
+		wfr.Status.Scan = existing
+		nodes := argoproj.Nodes{
+			"scan": {Name: "wf[2].scan", Type: argoproj.NodeTypePod, Outputs: scanOutputs(trivyReport)},
+		}
+		syncArgoScanReport(context.Background(), wfr, nodes)
+		if wfr.Status.Scan != existing {
+			t.Errorf("expected existing scan result to be kept, got %+v", wfr.Status.Scan)
+		}
+	})
+}
+
+// ---------------------------------------------------------------------------
+// syncArgoSBOMReference
+// ---------------------------------------------------------------------------
+
+func TestSyncArgoSBOMReference(t *testing.T) {
+	sbomOutputs := func(ref, format string) *argoproj.Outputs {
+		refValue, formatValue := argoproj.AnyString(ref), argoproj.AnyString(format)
+		return &argoproj.Outputs{Parameters: []argoproj.Parameter{
+			{Name: "sbom-ref", Value: &refValue},
+			{Name: "sbom-format", Value: &formatValue},
+		}}
+	}
+
+	t.Run("reference is read from step outputs", func(t *testing.T) {
+		wfr := &openchoreodevv1alpha1.WorkflowRun{}
+		nodes := argoproj.Nodes{
+			"sbom": {Name: "wf[3].attach-sbom", Type: argoproj.NodeTypePod, Outputs: sbomOutputs("registry.local/app@sha256:123", "spdx-json")},
+		}
+		syncArgoSBOMReference(wfr, nodes)
+		if wfr.Status.SBOM == nil {
+			t.Fatal("expected SBOM reference")
+		}
+		if wfr.Status.SBOM.Location != "registry.local/app@sha256:123" || wfr.Status.SBOM.Format != openchoreodevv1alpha1.SBOMFormatSPDX {
+			t.Errorf("unexpected SBOM reference %+v", wfr.Status.SBOM)
+		}
+		if wfr.Status.SBOM.RecordedAt == nil {
+			t.Error("expected RecordedAt to be set")
+		}
+	})
+
+	t.Run("no reference leaves status untouched", func(t *testing.T) {
+		wfr := &openchoreodevv1alpha1.WorkflowRun{}
+		syncArgoSBOMReference(wfr, argoproj.Nodes{"build": {Name: "wf[1].build", Type: argoproj.NodeTypePod}})
+		if wfr.Status.SBOM != nil {
+			t.Errorf("expected no SBOM reference, got %+v", wfr.Status.SBOM)
+		}
+	})
+
+	t.Run("format names", func(t *testing.T) {
+		tests := map[string]openchoreodevv1alpha1.SBOMFormat{
+			"spdx-json":      openchoreodevv1alpha1.SBOMFormatSPDX,
+			"SPDX":           openchoreodevv1alpha1.SBOMFormatSPDX,
+			"cyclonedx-json": openchoreodevv1alpha1.SBOMFormatCycloneDX,
+			"cdx":            openchoreodevv1alpha1.SBOMFormatCycloneDX,
+			"syft-json":      "",
+		}
+		for in, want := range tests {
+			if got := parseSBOMFormat(in); got != want {
+				t.Errorf("parseSBOMFormat(%q) = %q, want %q", in, got, want)
+			}
+		}
+	})
+}
+
+func TestSyncArgoCacheStatistics(t *testing.T) {
+	cacheOutputs := func(hits, misses string) *argoproj.Outputs {
+		hitsValue, missesValue := argoproj.AnyString(hits), argoproj.AnyString(misses)
+		return &argoproj.Outputs{Parameters: []argoproj.Parameter{
+			{Name: "cache-hits", Value: &hitsValue},
+			{Name: "cache-misses", Value: &missesValue},
+		}}
+	}
+
+	t.Run("counts of all steps are added up", func(t *testing.T) {
+		wfr := &openchoreodevv1alpha1.WorkflowRun{}
+		nodes := argoproj.Nodes{
+			"build":  {Name: "wf[1].build", Type: argoproj.NodeTypePod, Outputs: cacheOutputs("12", "3")},
+			"deps":   {Name: "wf[0].deps", Type: argoproj.NodeTypePod, Outputs: cacheOutputs("4\n", "not-a-number")},
+			"parent": {Name: "wf", Type: argoproj.NodeTypeSteps, Outputs: cacheOutputs("100", "100")},
+		}
+		syncArgoCacheStatistics(wfr, nodes)
+		if wfr.Status.Cache == nil {
+			t.Fatal("expected cache statistics")
+		}
+		if wfr.Status.Cache.Hits != 16 || wfr.Status.Cache.Misses != 3 {
+			t.Errorf("unexpected cache statistics %+v", wfr.Status.Cache)
+		}
+	})
+
+	t.Run("no statistics leaves status untouched", func(t *testing.T) {
+		wfr := &openchoreodevv1alpha1.WorkflowRun{}
+		syncArgoCacheStatistics(wfr, argoproj.Nodes{"build": {Name: "wf[1].build", Type: argoproj.NodeTypePod}})
+		if wfr.Status.Cache != nil {
+			t.Errorf("expected no cache statistics, got %+v", wfr.Status.Cache)
+		}
+	})
+}
+
+func TestResolveBuildCache(t *testing.T) {
+	wfr := &openchoreodevv1alpha1.WorkflowRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "run-1", Namespace: "default"},
+	}
+
+	t.Run("no cache", func(t *testing.T) {
+		if got := resolveBuildCache(nil, wfr, "build-go"); got.Enabled {
+			t.Errorf("expected disabled cache, got %+v", got)
+		}
+	})
+
+	t.Run("registry cache image per workflow", func(t *testing.T) {
+		cache := &openchoreodevv1alpha1.BuildCacheConfig{
+			Type:     openchoreodevv1alpha1.BuildCacheTypeRegistry,
+			Registry: &openchoreodevv1alpha1.RegistryBuildCache{Repository: "registry.local/cache/"},
+		}
+		got := resolveBuildCache(cache, wfr, "build-go")
+		if !got.Enabled || got.Type != "Registry" || got.Image != "registry.local/cache/default/build-go" {
+			t.Errorf("unexpected build cache %+v", got)
+		}
+	})
+
+	t.Run("registry cache image per component", func(t *testing.T) {
+		withComponent := wfr.DeepCopy()
+		withComponent.Labels = map[string]string{"openchoreo.dev/component": "api"}
+		cache := &openchoreodevv1alpha1.BuildCacheConfig{
+			Type:     openchoreodevv1alpha1.BuildCacheTypeRegistry,
+			Registry: &openchoreodevv1alpha1.RegistryBuildCache{Repository: "registry.local/cache"},
+		}
+		if got := resolveBuildCache(cache, withComponent, "build-go"); got.Image != "registry.local/cache/default/api" {
+			t.Errorf("unexpected cache image %q", got.Image)
+		}
+	})
+
+	t.Run("volume cache", func(t *testing.T) {
+		cache := &openchoreodevv1alpha1.BuildCacheConfig{
+			Type:             openchoreodevv1alpha1.BuildCacheTypePersistentVolume,
+			PersistentVolume: &openchoreodevv1alpha1.PersistentVolumeBuildCache{},
+		}
+		got := resolveBuildCache(cache, wfr, "build-go")
+		if !got.Enabled || got.ClaimName != buildCacheClaimName || got.Image != "" {
+			t.Errorf("unexpected build cache %+v", got)
+		}
+	})
+}
+
 // ---------------------------------------------------------------------------
 // syncWorkflowRunStatus
 // ---------------------------------------------------------------------------
@@ -758,7 +992,7 @@ func TestSyncWorkflowRunStatus(t *testing.T) {
 		runResource := &argoproj.Workflow{}
 		runResource.Status.Phase = argoproj.WorkflowRunning
 
-		result := r.syncWorkflowRunStatus(wfr, runResource)
+		result := r.syncWorkflowRunStatus(context.Background(), wfr, runResource)
 		if result.RequeueAfter != 20*time.Second {
 			t.Errorf("expected RequeueAfter=20s, got %v", result.RequeueAfter)
 		}
@@ -770,7 +1004,7 @@ func TestSyncWorkflowRunStatus(t *testing.T) {
 		runResource := &argoproj.Workflow{}
 		runResource.Status.Phase = argoproj.WorkflowSucceeded
 
-		result := r.syncWorkflowRunStatus(wfr, runResource)
+		result := r.syncWorkflowRunStatus(context.Background(), wfr, runResource)
 		if !result.Requeue {
 			t.Error("expected Requeue=true")
 		}
@@ -783,7 +1017,7 @@ func TestSyncWorkflowRunStatus(t *testing.T) {
 		runResource := &argoproj.Workflow{}
 		runResource.Status.Phase = argoproj.WorkflowFailed
 
-		result := r.syncWorkflowRunStatus(wfr, runResource)
+		result := r.syncWorkflowRunStatus(context.Background(), wfr, runResource)
 		if result.Requeue || result.RequeueAfter > 0 {
 			t.Error("expected no requeue for failed workflow")
 		}
@@ -795,7 +1029,7 @@ func TestSyncWorkflowRunStatus(t *testing.T) {
 		runResource := &argoproj.Workflow{}
 		runResource.Status.Phase = argoproj.WorkflowError
 
-		result := r.syncWorkflowRunStatus(wfr, runResource)
+		result := r.syncWorkflowRunStatus(context.Background(), wfr, runResource)
 		if result.Requeue || result.RequeueAfter > 0 {
 			t.Error("expected no requeue for error workflow")
 		}
@@ -807,7 +1041,7 @@ func TestSyncWorkflowRunStatus(t *testing.T) {
 		runResource := &argoproj.Workflow{}
 		runResource.Status.Phase = "" // unknown
 
-		result := r.syncWorkflowRunStatus(wfr, runResource)
+		result := r.syncWorkflowRunStatus(context.Background(), wfr, runResource)
 		if !result.Requeue {
 			t.Error("expected Requeue=true for unknown phase")
 		}
@@ -826,7 +1060,7 @@ func TestSyncWorkflowRunStatus(t *testing.T) {
 			},
 		}
 
-		r.syncWorkflowRunStatus(wfr, runResource)
+		r.syncWorkflowRunStatus(context.Background(), wfr, runResource)
 		if len(wfr.Status.Tasks) != 1 {
 			t.Fatalf("expected 1 task, got %d", len(wfr.Status.Tasks))
 		}
@@ -2477,7 +2711,7 @@ func TestEnsureRunResourcePrerequisiteFailure(t *testing.T) {
 	wpClient := fake.NewClientBuilder().WithScheme(s).Build()
 	r := &Reconciler{Client: wpClient, Scheme: s}
 
-	result := r.ensureRunResource(context.Background(), wfr, output, "build-ns", wpClient)
+	result := r.ensureRunResource(context.Background(), wfr, output, "build-ns", nil, wpClient)
 	if !result.Requeue {
 		t.Error("expected Requeue=true when applyRenderedResources fails")
 	}
@@ -3370,7 +3604,7 @@ func TestEnsureRunResource(t *testing.T) {
 		fc := fake.NewClientBuilder().WithScheme(s).Build()
 		r := &Reconciler{Client: fc, Scheme: s}
 
-		result := r.ensureRunResource(context.Background(), wfr, output, "build-ns", fc)
+		result := r.ensureRunResource(context.Background(), wfr, output, "build-ns", nil, fc)
 		if !result.Requeue {
 			t.Error("expected Requeue=true after successful resource creation")
 		}
@@ -3390,6 +3624,54 @@ func TestEnsureRunResource(t *testing.T) {
 		}
 	})
 
+	t.Run("creates build cache volume", func(t *testing.T) {
+		wfr := &openchoreodevv1alpha1.WorkflowRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-wfr",
+				Namespace: "default",
+				UID:       "test-uid",
+			},
+			Spec: openchoreodevv1alpha1.WorkflowRunSpec{
+				Workflow: openchoreodevv1alpha1.WorkflowRunConfig{Name: "wf"},
+			},
+		}
+
+		output := &workflowpipeline.RenderOutput{
+			Resource: map[string]any{
+				"apiVersion": "argoproj.io/v1alpha1",
+				"kind":       "Workflow",
+				"metadata": map[string]any{
+					"name":      testRunResourceName,
+					"namespace": "build-ns",
+				},
+				"spec": map[string]any{
+					"entrypoint":         "main",
+					"serviceAccountName": "wf-sa",
+				},
+			},
+		}
+
+		fc := fake.NewClientBuilder().WithScheme(s).Build()
+		r := &Reconciler{Client: fc, Scheme: s}
+
+		volumeCache := &openchoreodevv1alpha1.PersistentVolumeBuildCache{StorageClassName: "nfs"}
+		r.ensureRunResource(context.Background(), wfr, output, "build-ns", volumeCache, fc)
+
+		claim := &corev1.PersistentVolumeClaim{}
+		if err := fc.Get(context.Background(), types.NamespacedName{Name: buildCacheClaimName, Namespace: "build-ns"}, claim); err != nil {
+			t.Fatalf("expected build cache claim to be created: %v", err)
+		}
+		if claim.Spec.StorageClassName == nil || *claim.Spec.StorageClassName != "nfs" {
+			t.Errorf("unexpected storage class %v", claim.Spec.StorageClassName)
+		}
+		if got := claim.Spec.Resources.Requests[corev1.ResourceStorage]; got.String() != "20Gi" {
+			t.Errorf("expected default size 20Gi, got %s", got.String())
+		}
+		if wfr.Status.Resources != nil && len(*wfr.Status.Resources) != 0 {
+			t.Errorf("build cache claim must not be tracked as a run resource, got %v", wfr.Status.Resources)
+		}
+	})
+
 	t.Run("creates additional resources from output", func(t *testing.T) {
 		wfr := &openchoreodevv1alpha1.WorkflowRun{
 			ObjectMeta: metav1.ObjectMeta{
@@ -3433,7 +3715,7 @@ func TestEnsureRunResource(t *testing.T) {
 		fc := fake.NewClientBuilder().WithScheme(s).Build()
 		r := &Reconciler{Client: fc, Scheme: s}
 
-		result := r.ensureRunResource(context.Background(), wfr, output, "build-ns", fc)
+		result := r.ensureRunResource(context.Background(), wfr, output, "build-ns", nil, fc)
 		if !result.Requeue {
 			t.Error("expected Requeue=true")
 		}
@@ -3474,7 +3756,7 @@ func TestEnsureRunResource(t *testing.T) {
 		fc := fake.NewClientBuilder().WithScheme(s).Build()
 		r := &Reconciler{Client: fc, Scheme: s}
 
-		result := r.ensureRunResource(context.Background(), wfr, output, "build-ns", fc)
+		result := r.ensureRunResource(context.Background(), wfr, output, "build-ns", nil, fc)
 		if !result.Requeue {
 			t.Error("expected Requeue=true when service account name is missing")
 		}
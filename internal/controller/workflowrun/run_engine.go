@@ -6,19 +6,29 @@ package workflowrun
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openchoreodevv1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	workflowpipeline "github.com/openchoreo/openchoreo/internal/pipeline/workflow"
 )
 
 const (
 	workflowRoleNameSuffix        = "role"
 	workflowRoleBindingNameSuffix = "role-binding"
+
+	// buildCacheClaimName is the PersistentVolumeClaim that holds the build cache of a
+	// workflow namespace when the workflow plane uses a volume cache.
+	buildCacheClaimName = "build-cache"
 )
 
 // ensurePrerequisites creates prerequisite resources in the workflow plane
@@ -115,3 +125,54 @@ func makeRoleBinding(namespace, serviceAccountName, roleName, roleBindingName st
 		},
 	}
 }
+
+// resolveBuildCache describes the build cache a run uses to its workflow templates. Registry caches
+// get one cache image per component, or per workflow for runs that do not build a component.
+func resolveBuildCache(
+	cache *openchoreodevv1alpha1.BuildCacheConfig, workflowRun *openchoreodevv1alpha1.WorkflowRun, workflowName string,
+) workflowpipeline.BuildCacheData {
+	if cache == nil {
+		return workflowpipeline.BuildCacheData{}
+	}
+	data := workflowpipeline.BuildCacheData{Enabled: true, Type: string(cache.Type)}
+	switch cache.Type {
+	case openchoreodevv1alpha1.BuildCacheTypeRegistry:
+		if cache.Registry == nil {
+			return workflowpipeline.BuildCacheData{}
+		}
+		name := workflowRun.Labels[labels.LabelKeyComponentName]
+		if name == "" {
+			name = workflowName
+		}
+		data.Image = fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(cache.Registry.Repository, "/"), workflowRun.Namespace, name)
+	case openchoreodevv1alpha1.BuildCacheTypePersistentVolume:
+		if cache.PersistentVolume == nil {
+			return workflowpipeline.BuildCacheData{}
+		}
+		data.ClaimName = buildCacheClaimName
+	}
+	return data
+}
+
+func makeBuildCacheClaim(namespace string, cache *openchoreodevv1alpha1.PersistentVolumeBuildCache) *corev1.PersistentVolumeClaim {
+	size := cache.Size
+	if size.IsZero() {
+		size = resource.MustParse("20Gi")
+	}
+	claim := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      buildCacheClaimName,
+			Namespace: namespace,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: size},
+			},
+		},
+	}
+	if cache.StorageClassName != "" {
+		claim.Spec.StorageClassName = &cache.StorageClassName
+	}
+	return claim
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package imageref resolves the registry that hosts a container image so that
+// registry credentials can be matched against the images of a workload.
+package imageref
+
+import "strings"
+
+// DockerHub is the canonical name of the Docker Hub registry. Images without a
+// registry host, such as "nginx" or "library/nginx", are pulled from it.
+const DockerHub = "docker.io"
+
+// dockerHubAliases are the hosts and config keys that refer to Docker Hub.
+var dockerHubAliases = map[string]struct{}{
+	"docker.io":               {},
+	"index.docker.io":         {},
+	"registry-1.docker.io":    {},
+	"registry.hub.docker.com": {},
+}
+
+// Registry returns the canonical registry host of an image reference.
+func Registry(image string) string {
+	name, _, found := strings.Cut(image, "/")
+	if !found || !isHost(name) {
+		return DockerHub
+	}
+	return NormalizeRegistry(name)
+}
+
+// NormalizeRegistry returns the canonical registry host of a registry server as
+// entered by users or found in docker config files, e.g.
+// "https://index.docker.io/v1/" becomes "docker.io" and "GHCR.io/" becomes "ghcr.io".
+func NormalizeRegistry(server string) string {
+	host := strings.TrimSpace(server)
+	if i := strings.Index(host, "://"); i >= 0 {
+		host = host[i+3:]
+	}
+	host, _, _ = strings.Cut(host, "/")
+	host = strings.ToLower(host)
+	if _, ok := dockerHubAliases[host]; ok {
+		return DockerHub
+	}
+	return host
+}
+
+// isHost reports whether the first path component of an image reference is a
+// registry host rather than a Docker Hub namespace.
+func isHost(component string) bool {
+	return strings.ContainsAny(component, ".:") || component == "localhost"
+}
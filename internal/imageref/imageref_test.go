@@ -0,0 +1,47 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package imageref
+
+import "testing"
+
+func TestRegistry(t *testing.T) {
+	tests := []struct {
+		image string
+		want  string
+	}{
+		{image: "nginx", want: "docker.io"},
+		{image: "nginx:1.27", want: "docker.io"},
+		{image: "library/nginx@sha256:abc", want: "docker.io"},
+		{image: "docker.io/library/nginx", want: "docker.io"},
+		{image: "index.docker.io/acme/app:v1", want: "docker.io"},
+		{image: "ghcr.io/acme/app:v1", want: "ghcr.io"},
+		{image: "registry.example.com:5000/team/app", want: "registry.example.com:5000"},
+		{image: "localhost/app", want: "localhost"},
+		{image: "localhost:5000/app", want: "localhost:5000"},
+		{image: "GHCR.io/acme/app", want: "ghcr.io"},
+	}
+	for _, tt := range tests {
+		if got := Registry(tt.image); got != tt.want {
+			t.Errorf("Registry(%q) = %q, want %q", tt.image, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeRegistry(t *testing.T) {
+	tests := []struct {
+		server string
+		want   string
+	}{
+		{server: "https://index.docker.io/v1/", want: "docker.io"},
+		{server: "registry-1.docker.io", want: "docker.io"},
+		{server: "ghcr.io", want: "ghcr.io"},
+		{server: " https://Registry.Example.com:5000/ ", want: "registry.example.com:5000"},
+		{server: "quay.io/acme", want: "quay.io"},
+	}
+	for _, tt := range tests {
+		if got := NormalizeRegistry(tt.server); got != tt.want {
+			t.Errorf("NormalizeRegistry(%q) = %q, want %q", tt.server, got, tt.want)
+		}
+	}
+}
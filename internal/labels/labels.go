@@ -75,6 +75,29 @@ const (
 	// network access to user workloads. Used in NetworkPolicy rules to allow ingress from system components.
 	LabelKeySystemComponent = "openchoreo.dev/system-component"
 
+	// LabelKeyRolloutTrack separates the pods of the stable and canary releases of a Canary or
+	// BlueGreen rollout so that each track's Service selects only its own pods.
+	LabelKeyRolloutTrack = "openchoreo.dev/rollout-track"
+
+	// LabelKeySandbox marks an Environment as an ephemeral developer sandbox created through the API.
+	LabelKeySandbox = "openchoreo.dev/sandbox"
+
+	// LabelKeyPullRequest carries the pull request number of a preview Environment.
+	LabelKeyPullRequest = "openchoreo.dev/pull-request"
+
+	// LabelKeyPreviewEnvironment and LabelKeyPreviewCommit tie a WorkflowRun that builds a pull
+	// request to the preview Environment it deploys to and the commit it builds.
+	LabelKeyPreviewEnvironment = "openchoreo.dev/preview-environment"
+	LabelKeyPreviewCommit      = "openchoreo.dev/preview-commit"
+
+	// LabelKeySecretType classifies the SecretReferences created by the API for a specific
+	// purpose, such as git or container registry credentials.
+	LabelKeySecretType = "openchoreo.dev/secret-type"
+
+	// AnnotationKeyRegistryServer records the canonical registry host (see the imageref package)
+	// that a registry credential SecretReference authenticates to.
+	AnnotationKeyRegistryServer = "openchoreo.dev/registry-server"
+
 	// AnnotationKeyDPResourceHash contains a hash of all dataplane resources (excluding the main workload)
 	// to trigger pod rollout when dependent ConfigMaps, Secrets, etc. change.
 	AnnotationKeyDPResourceHash = "openchoreo.dev/dp-resource-hash"
@@ -87,6 +110,11 @@ const (
 	AnnotationKeyEndpointBasePath = "openchoreo.dev/endpoint-base-path"
 
 	LabelValueManagedBy = "openchoreo-control-plane"
+	// LabelValueRolloutTrackStable and LabelValueRolloutTrackCanary are the values of LabelKeyRolloutTrack.
+	LabelValueRolloutTrackStable = "stable"
+	LabelValueRolloutTrackCanary = "canary"
+	// LabelValueRegistryCredentials is the LabelKeySecretType value of registry credentials.
+	LabelValueRegistryCredentials = "registry-credentials"
 	// LabelValueTrue is the standard "true" value for boolean labels
 	LabelValueTrue = "true"
 )
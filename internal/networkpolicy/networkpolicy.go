@@ -5,6 +5,7 @@ package networkpolicy
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strconv"
 
@@ -34,14 +35,16 @@ type ComponentPolicyParams struct {
 	Namespace     string                                         // data plane namespace name
 	CPNamespace   string                                         // control plane namespace name
 	Environment   string                                         // environment name (e.g., "development")
+	ProjectName   string                                         // project the component belongs to
 	ComponentName string                                         // for naming the policy
 	PodSelectors  map[string]string                              // platform pod selectors
 	Endpoints     map[string]openchoreov1alpha1.WorkloadEndpoint // from workload spec
 	Provider      Provider                                       // network policy provider
+	Rules         []openchoreov1alpha1.NetworkPolicyRule         // org-level rules of the CP namespace
 }
 
 // MakeComponentPolicies returns a policy for a component with ingress rules based on
-// declared endpoint visibility. Egress is unrestricted.
+// declared endpoint visibility, adjusted by the org-level rules. Egress is unrestricted.
 // When Provider is ProviderCilium a CiliumNetworkPolicy is returned with L7 HTTP rules
 // for HTTP-proxied endpoints; otherwise a standard Kubernetes NetworkPolicy is returned.
 func MakeComponentPolicies(params ComponentPolicyParams) []map[string]any {
@@ -90,114 +93,111 @@ func makeIngressRules(params ComponentPolicyParams) []any {
 		return nil
 	}
 
-	// Collect endpoints into a slice and sort by name to ensure deterministic
-	// output. Without this, map iteration order causes the serialized
-	// NetworkPolicy to differ between reconcile cycles, triggering unnecessary
-	// updates.
-	type epEntry struct {
-		name       string
-		port       map[string]any
-		visibility []openchoreov1alpha1.EndpointVisibility
-	}
-	entries := make([]epEntry, 0, len(params.Endpoints))
-	for name, ep := range params.Endpoints {
-		protocol := "TCP"
-		if ep.Type == openchoreov1alpha1.EndpointTypeUDP {
-			protocol = "UDP"
-		}
-		entries = append(entries, epEntry{
-			name: name,
-			port: map[string]any{
-				"protocol": protocol,
+	// Sort endpoints by name to ensure deterministic output. Without this, map
+	// iteration order causes the serialized NetworkPolicy to differ between
+	// reconcile cycles, triggering unnecessary updates.
+	names := sortedEndpointNames(params.Endpoints)
+
+	// Every endpoint implicitly has project visibility (intra-namespace). Ports are
+	// grouped by the peers allowed to reach them, one ingress rule per peer:
+	//   1. intra-namespace (project visibility) — all declared ports
+	//   2. cross-project, same CP namespace and same environment (namespace visibility)
+	//   3. sources permitted by Allow rules
+	//   4. system components (e.g., gateway) from any namespace (internal or external visibility)
+	// Deny rules narrow the peers of the first three rules.
+	groups := newPeerGroups[any]()
+	for _, kind := range peerKinds {
+		for _, name := range names {
+			ep := params.Endpoints[name]
+			port := map[string]any{
+				"protocol": endpointProtocol(ep),
 				"port":     int64(ep.Port),
-			},
-			visibility: ep.Visibility,
-		})
-	}
-	sort.Slice(entries, func(i, j int) bool {
-		return entries[i].name < entries[j].name
-	})
-
-	// Group ports by visibility level.
-	// Every endpoint implicitly has project visibility (intra-namespace).
-	// We collect additional visibility levels to build extra ingress rules.
-	projectPorts := make([]any, 0, len(entries))
-	var namespacePorts []any
-	var broadAccessPorts []any
-
-	for _, e := range entries {
-		projectPorts = append(projectPorts, e.port)
-
-		for _, vis := range e.visibility {
-			switch vis {
-			case openchoreov1alpha1.EndpointVisibilityNamespace:
-				namespacePorts = append(namespacePorts, e.port)
-			case openchoreov1alpha1.EndpointVisibilityInternal, openchoreov1alpha1.EndpointVisibilityExternal:
-				broadAccessPorts = append(broadAccessPorts, e.port)
+			}
+			for _, peer := range kubernetesPeers(params, kind, name, ep) {
+				groups.add(peer, port)
 			}
 		}
 	}
 
 	var ingressRules []any
-
-	// Rule 1: intra-namespace (project visibility) — all declared ports
-	if len(projectPorts) > 0 {
+	for _, g := range groups.list() {
 		ingressRules = append(ingressRules, map[string]any{
-			"from": []any{
-				map[string]any{
-					"podSelector": map[string]any{},
-				},
-			},
-			"ports": projectPorts,
+			"from":  []any{g.peer},
+			"ports": g.ports,
 		})
 	}
+	return ingressRules
+}
 
-	// Rule 2: cross-project, same CP namespace and same environment (namespace visibility)
-	if len(namespacePorts) > 0 {
-		ingressRules = append(ingressRules, map[string]any{
-			"from": []any{
-				map[string]any{
-					"namespaceSelector": map[string]any{
-						"matchLabels": map[string]any{
-							labels.LabelKeyNamespaceName:   params.CPNamespace,
-							labels.LabelKeyEnvironmentName: params.Environment,
-						},
+// kubernetesPeers returns the NetworkPolicy peers of the given kind allowed to reach an endpoint.
+func kubernetesPeers(params ComponentPolicyParams, kind peerKind, name string, ep openchoreov1alpha1.WorkloadEndpoint) []map[string]any {
+	rules := params.endpointRules(name)
+	switch kind {
+	case peerKindProject:
+		if rules.denies(params.ProjectName, "") {
+			return nil
+		}
+		return []map[string]any{{
+			"podSelector": selector(nil, notIn(labels.LabelKeyComponentName, rules.deniedComponents)),
+		}}
+	case peerKindNamespace:
+		if !hasVisibility(ep, openchoreov1alpha1.EndpointVisibilityNamespace) || rules.denyAll {
+			return nil
+		}
+		return []map[string]any{kubernetesNamespacePeer(params, openchoreov1alpha1.NetworkPolicyRulePeer{}, rules)}
+	case peerKindRule:
+		var peers []map[string]any
+		for _, src := range rules.allowedSources(params.ProjectName, ep) {
+			peers = append(peers, kubernetesNamespacePeer(params, src, rules))
+		}
+		return peers
+	case peerKindSystem:
+		if !hasBroadVisibility(ep) {
+			return nil
+		}
+		return []map[string]any{{
+			"namespaceSelector": map[string]any{},
+			"podSelector": map[string]any{
+				"matchExpressions": []any{
+					map[string]any{
+						"key":      labels.LabelKeySystemComponent,
+						"operator": "Exists",
 					},
 				},
 			},
-			"ports": namespacePorts,
-		})
+		}}
 	}
+	return nil
+}
 
-	// Rule 3: system components (e.g., gateway) from any namespace (internal or external visibility)
-	if len(broadAccessPorts) > 0 {
-		ingressRules = append(ingressRules, map[string]any{
-			"from": []any{
-				map[string]any{
-					"namespaceSelector": map[string]any{},
-					"podSelector": map[string]any{
-						"matchExpressions": []any{
-							map[string]any{
-								"key":      labels.LabelKeySystemComponent,
-								"operator": "Exists",
-							},
-						},
-					},
-				},
-			},
-			"ports": broadAccessPorts,
-		})
+// kubernetesNamespacePeer selects the pods of the source in the data-plane namespaces of the
+// same CP namespace and environment, minus the denied projects and components.
+func kubernetesNamespacePeer(params ComponentPolicyParams, src openchoreov1alpha1.NetworkPolicyRulePeer, rules endpointRules) map[string]any {
+	nsLabels := map[string]any{
+		labels.LabelKeyNamespaceName:   params.CPNamespace,
+		labels.LabelKeyEnvironmentName: params.Environment,
 	}
-
-	return ingressRules
+	if src.Project != "" {
+		nsLabels[labels.LabelKeyProjectName] = src.Project
+	}
+	peer := map[string]any{
+		"namespaceSelector": selector(nsLabels, notIn(labels.LabelKeyProjectName, rules.deniedProjects)),
+	}
+	var podLabels map[string]any
+	if src.Component != "" {
+		podLabels = map[string]any{labels.LabelKeyComponentName: src.Component}
+	}
+	if podLabels != nil || len(rules.deniedComponents) > 0 {
+		peer["podSelector"] = selector(podLabels, notIn(labels.LabelKeyComponentName, rules.deniedComponents))
+	}
+	return peer
 }
 
 // ciliumPortEntry holds per-endpoint data for Cilium CNP generation.
 type ciliumPortEntry struct {
-	port       string
-	proto      string
-	isL7       bool
-	visibility []openchoreov1alpha1.EndpointVisibility
+	port  string
+	proto string
+	isL7  bool
 }
 
 // makeCiliumComponentPolicies returns a CiliumNetworkPolicy for a component.
@@ -205,87 +205,47 @@ type ciliumPortEntry struct {
 // so Cilium redirects traffic through Envoy and Hubble emits flow metrics.
 // Non-proxied types (TCP, UDP) get L4-only rules.
 func makeCiliumComponentPolicies(params ComponentPolicyParams) []map[string]any {
-	entries := make([]ciliumPortEntry, 0, len(params.Endpoints))
-	for _, ep := range params.Endpoints {
-		proto := "TCP"
-		if ep.Type == openchoreov1alpha1.EndpointTypeUDP {
-			proto = "UDP"
-		}
-		entries = append(entries, ciliumPortEntry{
-			port:       strconv.Itoa(int(ep.Port)),
-			proto:      proto,
-			isL7:       isL7Proxied(ep.Type),
-			visibility: ep.Visibility,
+	type epEntry struct {
+		name     string
+		port     ciliumPortEntry
+		endpoint openchoreov1alpha1.WorkloadEndpoint
+	}
+	entries := make([]epEntry, 0, len(params.Endpoints))
+	for name, ep := range params.Endpoints {
+		entries = append(entries, epEntry{
+			name: name,
+			port: ciliumPortEntry{
+				port:  strconv.Itoa(int(ep.Port)),
+				proto: endpointProtocol(ep),
+				isL7:  isL7Proxied(ep.Type),
+			},
+			endpoint: ep,
 		})
 	}
 	// Sort by port for deterministic output.
 	sort.Slice(entries, func(i, j int) bool {
-		return entries[i].port < entries[j].port
+		if entries[i].port.port != entries[j].port.port {
+			return entries[i].port.port < entries[j].port.port
+		}
+		return entries[i].name < entries[j].name
 	})
 
-	projectPorts := make([]ciliumPortEntry, 0, len(entries))
-	var namespacePorts, broadPorts []ciliumPortEntry
-	for _, e := range entries {
-		projectPorts = append(projectPorts, e)
-		for _, vis := range e.visibility {
-			switch vis {
-			case openchoreov1alpha1.EndpointVisibilityNamespace:
-				namespacePorts = append(namespacePorts, e)
-			case openchoreov1alpha1.EndpointVisibilityInternal, openchoreov1alpha1.EndpointVisibilityExternal:
-				broadPorts = append(broadPorts, e)
+	// Ports are grouped by the peers allowed to reach them, in the same order as
+	// the Kubernetes NetworkPolicy rules.
+	groups := newPeerGroups[ciliumPortEntry]()
+	for _, kind := range peerKinds {
+		for _, e := range entries {
+			for _, peer := range ciliumPeers(params, kind, e.name, e.endpoint) {
+				groups.add(peer, e.port)
 			}
 		}
 	}
 
 	ingressRules := make([]any, 0)
-
-	// Rule 1: intra-namespace (project visibility) — all pods in the data-plane namespace
-	if len(projectPorts) > 0 {
-		ingressRules = append(ingressRules, map[string]any{
-			"fromEndpoints": []any{
-				map[string]any{},
-			},
-			"toPorts": ciliumToPorts(projectPorts),
-		})
-	}
-
-	// Rule 2: cross-project, same CP namespace and environment (namespace visibility)
-	if len(namespacePorts) > 0 {
-		ingressRules = append(ingressRules, map[string]any{
-			"fromEndpoints": []any{
-				map[string]any{
-					"matchLabels": map[string]any{
-						labels.LabelKeyNamespaceName:   params.CPNamespace,
-						labels.LabelKeyEnvironmentName: params.Environment,
-					},
-					"matchExpressions": []any{
-						map[string]any{ // Explicitly allow from any namespace
-							"key":      KubernetesNamespaceKey,
-							"operator": "Exists",
-						},
-					},
-				},
-			},
-			"toPorts": ciliumToPorts(namespacePorts),
-		})
-	}
-
-	// Rule 3: system components (e.g., gateway) from any namespace (internal or external visibility)
-	if len(broadPorts) > 0 {
+	for _, g := range groups.list() {
 		ingressRules = append(ingressRules, map[string]any{
-			"fromEndpoints": []any{
-				map[string]any{"matchExpressions": []any{
-					map[string]any{
-						"key":      labels.LabelKeySystemComponent,
-						"operator": "Exists",
-					},
-					map[string]any{ // Explicitly allow from any namespace
-						"key":      KubernetesNamespaceKey,
-						"operator": "Exists",
-					},
-				}},
-			},
-			"toPorts": ciliumToPorts(broadPorts),
+			"fromEndpoints": []any{g.peer},
+			"toPorts":       ciliumToPorts(g.ports),
 		})
 	}
 
@@ -315,6 +275,68 @@ func makeCiliumComponentPolicies(params ComponentPolicyParams) []map[string]any
 	}}
 }
 
+// ciliumPeers returns the CiliumNetworkPolicy endpoint selectors of the given kind allowed
+// to reach an endpoint.
+func ciliumPeers(params ComponentPolicyParams, kind peerKind, name string, ep openchoreov1alpha1.WorkloadEndpoint) []map[string]any {
+	rules := params.endpointRules(name)
+	// Explicitly allow from any namespace
+	anyNamespace := map[string]any{
+		"key":      KubernetesNamespaceKey,
+		"operator": "Exists",
+	}
+	switch kind {
+	case peerKindProject:
+		// An empty selector matches all pods in the data-plane namespace
+		if rules.denies(params.ProjectName, "") {
+			return nil
+		}
+		return []map[string]any{selector(nil, notIn(labels.LabelKeyComponentName, rules.deniedComponents))}
+	case peerKindNamespace:
+		if !hasVisibility(ep, openchoreov1alpha1.EndpointVisibilityNamespace) || rules.denyAll {
+			return nil
+		}
+		return []map[string]any{ciliumNamespacePeer(params, openchoreov1alpha1.NetworkPolicyRulePeer{}, rules, anyNamespace)}
+	case peerKindRule:
+		var peers []map[string]any
+		for _, src := range rules.allowedSources(params.ProjectName, ep) {
+			peers = append(peers, ciliumNamespacePeer(params, src, rules, anyNamespace))
+		}
+		return peers
+	case peerKindSystem:
+		if !hasBroadVisibility(ep) {
+			return nil
+		}
+		return []map[string]any{{"matchExpressions": []any{
+			map[string]any{
+				"key":      labels.LabelKeySystemComponent,
+				"operator": "Exists",
+			},
+			anyNamespace,
+		}}}
+	}
+	return nil
+}
+
+// ciliumNamespacePeer selects the pods of the source in the same CP namespace and environment,
+// minus the denied projects and components.
+func ciliumNamespacePeer(params ComponentPolicyParams, src openchoreov1alpha1.NetworkPolicyRulePeer, rules endpointRules, anyNamespace map[string]any) map[string]any {
+	matchLabels := map[string]any{
+		labels.LabelKeyNamespaceName:   params.CPNamespace,
+		labels.LabelKeyEnvironmentName: params.Environment,
+	}
+	if src.Project != "" {
+		matchLabels[labels.LabelKeyProjectName] = src.Project
+	}
+	if src.Component != "" {
+		matchLabels[labels.LabelKeyComponentName] = src.Component
+	}
+	return selector(matchLabels,
+		notIn(labels.LabelKeyProjectName, rules.deniedProjects),
+		notIn(labels.LabelKeyComponentName, rules.deniedComponents),
+		anyNamespace,
+	)
+}
+
 // ciliumToPorts builds the toPorts slice for a CNP ingress rule, grouping L7-proxied
 // ports (with rules.http) separately from L4-only ports.
 func ciliumToPorts(entries []ciliumPortEntry) []any {
@@ -354,6 +376,110 @@ func isL7Proxied(t openchoreov1alpha1.EndpointType) bool {
 	return false
 }
 
+// peerKind orders the ingress rules of a policy by the kind of peer they admit.
+type peerKind int
+
+const (
+	peerKindProject peerKind = iota
+	peerKindNamespace
+	peerKindRule
+	peerKindSystem
+)
+
+var peerKinds = []peerKind{peerKindProject, peerKindNamespace, peerKindRule, peerKindSystem}
+
+// peerGroup is a peer together with the ports it may reach.
+type peerGroup[P any] struct {
+	peer  map[string]any
+	ports []P
+}
+
+// peerGroups collects ports per distinct peer, in order of first appearance.
+type peerGroups[P any] struct {
+	order []*peerGroup[P]
+	byKey map[string]*peerGroup[P]
+}
+
+func newPeerGroups[P any]() *peerGroups[P] {
+	return &peerGroups[P]{byKey: map[string]*peerGroup[P]{}}
+}
+
+func (g *peerGroups[P]) add(peer map[string]any, port P) {
+	// fmt prints maps with sorted keys, so equal peers produce equal keys
+	key := fmt.Sprint(peer)
+	group, ok := g.byKey[key]
+	if !ok {
+		group = &peerGroup[P]{peer: peer}
+		g.byKey[key] = group
+		g.order = append(g.order, group)
+	}
+	group.ports = append(group.ports, port)
+}
+
+func (g *peerGroups[P]) list() []*peerGroup[P] {
+	return g.order
+}
+
+// selector builds a label selector from match labels and match expressions, skipping nil expressions.
+func selector(matchLabels map[string]any, exprs ...map[string]any) map[string]any {
+	sel := map[string]any{}
+	if len(matchLabels) > 0 {
+		sel["matchLabels"] = matchLabels
+	}
+	var matchExpressions []any
+	for _, e := range exprs {
+		if e != nil {
+			matchExpressions = append(matchExpressions, e)
+		}
+	}
+	if len(matchExpressions) > 0 {
+		sel["matchExpressions"] = matchExpressions
+	}
+	return sel
+}
+
+// notIn returns a NotIn match expression, or nil when there are no values to exclude.
+func notIn(key string, values []string) map[string]any {
+	if len(values) == 0 {
+		return nil
+	}
+	anyValues := make([]any, len(values))
+	for i, v := range values {
+		anyValues[i] = v
+	}
+	return map[string]any{
+		"key":      key,
+		"operator": "NotIn",
+		"values":   anyValues,
+	}
+}
+
+func sortedEndpointNames(endpoints map[string]openchoreov1alpha1.WorkloadEndpoint) []string {
+	names := make([]string, 0, len(endpoints))
+	for name := range endpoints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func endpointProtocol(ep openchoreov1alpha1.WorkloadEndpoint) string {
+	if ep.Type == openchoreov1alpha1.EndpointTypeUDP {
+		return "UDP"
+	}
+	return "TCP"
+}
+
+func hasVisibility(ep openchoreov1alpha1.WorkloadEndpoint, visibility openchoreov1alpha1.EndpointVisibility) bool {
+	return slices.Contains(ep.Visibility, visibility)
+}
+
+// hasBroadVisibility reports whether system components such as the gateway may reach the endpoint.
+func hasBroadVisibility(ep openchoreov1alpha1.WorkloadEndpoint) bool {
+	return hasVisibility(ep, openchoreov1alpha1.EndpointVisibilityInternal) ||
+		hasVisibility(ep, openchoreov1alpha1.EndpointVisibilityExternal)
+}
+
 // toAnyMap converts map[string]string to map[string]any for use in unstructured maps.
 func toAnyMap(m map[string]string) map[string]any {
 	result := make(map[string]any, len(m))
@@ -0,0 +1,170 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package networkpolicy
+
+import (
+	"slices"
+	"sort"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// Reasons explaining why a caller may or may not reach a component endpoint.
+const (
+	// ReasonProjectVisibility allows callers of the same project, which share the data-plane namespace.
+	ReasonProjectVisibility = "ProjectVisibility"
+	// ReasonNamespaceVisibility allows callers of other projects to endpoints with namespace visibility.
+	ReasonNamespaceVisibility = "NamespaceVisibility"
+	// ReasonSystemComponent allows platform system components to endpoints with internal or external visibility.
+	ReasonSystemComponent = "SystemComponent"
+	// ReasonAllowedByRule allows callers matched by an Allow rule.
+	ReasonAllowedByRule = "AllowedByRule"
+	// ReasonDeniedByRule denies callers matched by a Deny rule.
+	ReasonDeniedByRule = "DeniedByRule"
+	// ReasonNotVisible denies callers the endpoint visibility does not cover.
+	ReasonNotVisible = "NotVisible"
+)
+
+// Caller identifies a workload calling a component endpoint from the same CP namespace and environment.
+type Caller struct {
+	Project   string
+	Component string
+	// SystemComponent marks platform infrastructure such as gateways, which rules never match.
+	SystemComponent bool
+}
+
+// Verdict is the outcome of evaluating whether a caller may reach a component endpoint.
+type Verdict struct {
+	Allowed bool
+	Reason  string
+	// Rule is the name of the rule that decided the verdict, if any.
+	Rule string
+}
+
+// EvaluateIngress reports whether the caller may reach the named endpoint of the component
+// described by params, following the same rules MakeComponentPolicies renders.
+func EvaluateIngress(params ComponentPolicyParams, endpointName string, caller Caller) Verdict {
+	ep, ok := params.Endpoints[endpointName]
+	if !ok {
+		return Verdict{Reason: ReasonNotVisible}
+	}
+	if caller.SystemComponent {
+		if hasBroadVisibility(ep) {
+			return Verdict{Allowed: true, Reason: ReasonSystemComponent}
+		}
+		return Verdict{Reason: ReasonNotVisible}
+	}
+
+	matching := params.matchingRules(endpointName)
+	for _, rule := range matching {
+		if rule.Spec.Action == openchoreov1alpha1.NetworkPolicyRuleActionDeny && sourceMatches(rule.Spec.Source, caller) {
+			return Verdict{Reason: ReasonDeniedByRule, Rule: rule.Name}
+		}
+	}
+	if caller.Project == params.ProjectName {
+		return Verdict{Allowed: true, Reason: ReasonProjectVisibility}
+	}
+	if hasVisibility(ep, openchoreov1alpha1.EndpointVisibilityNamespace) {
+		return Verdict{Allowed: true, Reason: ReasonNamespaceVisibility}
+	}
+	for _, rule := range matching {
+		if rule.Spec.Action == openchoreov1alpha1.NetworkPolicyRuleActionAllow && sourceMatches(rule.Spec.Source, caller) {
+			return Verdict{Allowed: true, Reason: ReasonAllowedByRule, Rule: rule.Name}
+		}
+	}
+	return Verdict{Reason: ReasonNotVisible}
+}
+
+// endpointRules is the effect of the org-level rules on the peers of one endpoint.
+type endpointRules struct {
+	// denyAll is set when a Deny rule matches every component
+	denyAll          bool
+	deniedProjects   []string
+	deniedComponents []string
+	allows           []openchoreov1alpha1.NetworkPolicyRulePeer
+}
+
+// denies reports whether a Deny rule covers every component of the project, or the component.
+func (r endpointRules) denies(project, component string) bool {
+	return r.denyAll ||
+		(project != "" && slices.Contains(r.deniedProjects, project)) ||
+		(component != "" && slices.Contains(r.deniedComponents, component))
+}
+
+// allowedSources returns the Allow rule sources the endpoint visibility does not already
+// cover and no Deny rule cancels.
+func (r endpointRules) allowedSources(projectName string, ep openchoreov1alpha1.WorkloadEndpoint) []openchoreov1alpha1.NetworkPolicyRulePeer {
+	if hasVisibility(ep, openchoreov1alpha1.EndpointVisibilityNamespace) {
+		return nil
+	}
+	var sources []openchoreov1alpha1.NetworkPolicyRulePeer
+	for _, src := range r.allows {
+		if src.Project == projectName || r.denies(src.Project, src.Component) {
+			continue
+		}
+		if !slices.Contains(sources, src) {
+			sources = append(sources, src)
+		}
+	}
+	return sources
+}
+
+// endpointRules resolves the rules matching the named endpoint into peer adjustments.
+func (p ComponentPolicyParams) endpointRules(endpointName string) endpointRules {
+	var r endpointRules
+	for _, rule := range p.matchingRules(endpointName) {
+		src := rule.Spec.Source
+		switch rule.Spec.Action {
+		case openchoreov1alpha1.NetworkPolicyRuleActionDeny:
+			switch {
+			case src.Component != "":
+				r.deniedComponents = appendUnique(r.deniedComponents, src.Component)
+			case src.Project != "":
+				r.deniedProjects = appendUnique(r.deniedProjects, src.Project)
+			default:
+				r.denyAll = true
+			}
+		case openchoreov1alpha1.NetworkPolicyRuleActionAllow:
+			r.allows = append(r.allows, src)
+		}
+	}
+	sort.Strings(r.deniedProjects)
+	sort.Strings(r.deniedComponents)
+	return r
+}
+
+// matchingRules returns the rules whose destination covers the named endpoint in the
+// environment, sorted by name.
+func (p ComponentPolicyParams) matchingRules(endpointName string) []openchoreov1alpha1.NetworkPolicyRule {
+	var matching []openchoreov1alpha1.NetworkPolicyRule
+	for _, rule := range p.Rules {
+		spec := rule.Spec
+		if len(spec.Environments) > 0 && !slices.Contains(spec.Environments, p.Environment) {
+			continue
+		}
+		dst := spec.Destination
+		if dst.Project != p.ProjectName ||
+			(dst.Component != "" && dst.Component != p.ComponentName) ||
+			(len(dst.Endpoints) > 0 && !slices.Contains(dst.Endpoints, endpointName)) {
+			continue
+		}
+		matching = append(matching, rule)
+	}
+	sort.Slice(matching, func(i, j int) bool {
+		return matching[i].Name < matching[j].Name
+	})
+	return matching
+}
+
+func sourceMatches(src openchoreov1alpha1.NetworkPolicyRulePeer, caller Caller) bool {
+	return (src.Project == "" || src.Project == caller.Project) &&
+		(src.Component == "" || src.Component == caller.Component)
+}
+
+func appendUnique(values []string, v string) []string {
+	if slices.Contains(values, v) {
+		return values
+	}
+	return append(values, v)
+}
@@ -0,0 +1,265 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package networkpolicy
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func testRule(name string, action openchoreov1alpha1.NetworkPolicyRuleAction, src openchoreov1alpha1.NetworkPolicyRulePeer,
+	dst openchoreov1alpha1.NetworkPolicyRuleDestination, envs ...string) openchoreov1alpha1.NetworkPolicyRule {
+	return openchoreov1alpha1.NetworkPolicyRule{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "cp-ns"},
+		Spec: openchoreov1alpha1.NetworkPolicyRuleSpec{
+			Action:       action,
+			Source:       src,
+			Destination:  dst,
+			Environments: envs,
+		},
+	}
+}
+
+// rulesTestParams describes the orders component of the shop project with an external "api",
+// a project-only "admin" and a namespace-visible "events" endpoint.
+func rulesTestParams(provider Provider) ComponentPolicyParams {
+	return ComponentPolicyParams{
+		Namespace:     "dp-ns",
+		CPNamespace:   "cp-ns",
+		Environment:   "development",
+		ProjectName:   "shop",
+		ComponentName: "orders",
+		PodSelectors:  map[string]string{"app": "orders"},
+		Provider:      provider,
+		Endpoints: map[string]openchoreov1alpha1.WorkloadEndpoint{
+			"api": {
+				Type:       openchoreov1alpha1.EndpointTypeHTTP,
+				Port:       8080,
+				Visibility: []openchoreov1alpha1.EndpointVisibility{openchoreov1alpha1.EndpointVisibilityExternal},
+			},
+			"admin": {Type: openchoreov1alpha1.EndpointTypeHTTP, Port: 9090},
+			"events": {
+				Type:       openchoreov1alpha1.EndpointTypeTCP,
+				Port:       7000,
+				Visibility: []openchoreov1alpha1.EndpointVisibility{openchoreov1alpha1.EndpointVisibilityNamespace},
+			},
+		},
+		Rules: []openchoreov1alpha1.NetworkPolicyRule{
+			testRule("deny-legacy", openchoreov1alpha1.NetworkPolicyRuleActionDeny,
+				openchoreov1alpha1.NetworkPolicyRulePeer{Project: "legacy"},
+				openchoreov1alpha1.NetworkPolicyRuleDestination{Project: "shop"}),
+			testRule("allow-billing-admin", openchoreov1alpha1.NetworkPolicyRuleActionAllow,
+				openchoreov1alpha1.NetworkPolicyRulePeer{Project: "billing", Component: "invoicer"},
+				openchoreov1alpha1.NetworkPolicyRuleDestination{Project: "shop", Component: "orders", Endpoints: []string{"admin"}}),
+			testRule("deny-batch-admin", openchoreov1alpha1.NetworkPolicyRuleActionDeny,
+				openchoreov1alpha1.NetworkPolicyRulePeer{Project: "shop", Component: "batch"},
+				openchoreov1alpha1.NetworkPolicyRuleDestination{Project: "shop", Component: "orders", Endpoints: []string{"admin"}},
+				"development"),
+			testRule("lockdown-production", openchoreov1alpha1.NetworkPolicyRuleActionDeny,
+				openchoreov1alpha1.NetworkPolicyRulePeer{},
+				openchoreov1alpha1.NetworkPolicyRuleDestination{Project: "shop"},
+				"production"),
+			testRule("deny-other-project", openchoreov1alpha1.NetworkPolicyRuleActionDeny,
+				openchoreov1alpha1.NetworkPolicyRulePeer{},
+				openchoreov1alpha1.NetworkPolicyRuleDestination{Project: "billing"}),
+		},
+	}
+}
+
+func TestMakeComponentPolicies_Rules(t *testing.T) {
+	policies := MakeComponentPolicies(rulesTestParams(ProviderKubernetes))
+	if len(policies) != 1 {
+		t.Fatalf("expected 1 policy, got %d", len(policies))
+	}
+
+	assertYAMLEqual(t, "rules", policies[0], `
+apiVersion: networking.k8s.io/v1
+kind: NetworkPolicy
+metadata:
+  name: openchoreo-orders
+  namespace: dp-ns
+spec:
+  podSelector:
+    matchLabels:
+      app: orders
+  policyTypes:
+    - Ingress
+  ingress:
+    - from:
+        - podSelector:
+            matchExpressions:
+              - key: openchoreo.dev/component
+                operator: NotIn
+                values: [batch]
+      ports:
+        - protocol: TCP
+          port: 9090
+    - from:
+        - podSelector: {}
+      ports:
+        - protocol: TCP
+          port: 8080
+        - protocol: TCP
+          port: 7000
+    - from:
+        - namespaceSelector:
+            matchLabels:
+              openchoreo.dev/namespace: cp-ns
+              openchoreo.dev/environment: development
+            matchExpressions:
+              - key: openchoreo.dev/project
+                operator: NotIn
+                values: [legacy]
+      ports:
+        - protocol: TCP
+          port: 7000
+    - from:
+        - namespaceSelector:
+            matchLabels:
+              openchoreo.dev/namespace: cp-ns
+              openchoreo.dev/environment: development
+              openchoreo.dev/project: billing
+            matchExpressions:
+              - key: openchoreo.dev/project
+                operator: NotIn
+                values: [legacy]
+          podSelector:
+            matchLabels:
+              openchoreo.dev/component: invoicer
+            matchExpressions:
+              - key: openchoreo.dev/component
+                operator: NotIn
+                values: [batch]
+      ports:
+        - protocol: TCP
+          port: 9090
+    - from:
+        - namespaceSelector: {}
+          podSelector:
+            matchExpressions:
+              - key: openchoreo.dev/system-component
+                operator: Exists
+      ports:
+        - protocol: TCP
+          port: 8080
+`)
+}
+
+func TestMakeComponentPolicies_RulesDenyAll(t *testing.T) {
+	params := rulesTestParams(ProviderKubernetes)
+	params.Environment = "production"
+
+	assertYAMLEqual(t, "deny-all", MakeComponentPolicies(params)[0], `
+apiVersion: networking.k8s.io/v1
+kind: NetworkPolicy
+metadata:
+  name: openchoreo-orders
+  namespace: dp-ns
+spec:
+  podSelector:
+    matchLabels:
+      app: orders
+  policyTypes:
+    - Ingress
+  ingress:
+    - from:
+        - namespaceSelector: {}
+          podSelector:
+            matchExpressions:
+              - key: openchoreo.dev/system-component
+                operator: Exists
+      ports:
+        - protocol: TCP
+          port: 8080
+`)
+}
+
+func TestMakeComponentPolicies_Cilium_Rules(t *testing.T) {
+	params := rulesTestParams(ProviderCilium)
+	params.Endpoints = map[string]openchoreov1alpha1.WorkloadEndpoint{
+		"events": params.Endpoints["events"],
+	}
+
+	assertYAMLEqual(t, "cilium-rules", MakeComponentPolicies(params)[0], `
+apiVersion: cilium.io/v2
+kind: CiliumNetworkPolicy
+metadata:
+  name: openchoreo-orders
+  namespace: dp-ns
+spec:
+  endpointSelector:
+    matchLabels:
+      app: orders
+  ingress:
+    - fromEndpoints:
+        - {}
+      toPorts:
+        - ports:
+            - port: "7000"
+              protocol: TCP
+    - fromEndpoints:
+        - matchLabels:
+            openchoreo.dev/namespace: cp-ns
+            openchoreo.dev/environment: development
+          matchExpressions:
+            - key: openchoreo.dev/project
+              operator: NotIn
+              values: [legacy]
+            - key: k8s:io.kubernetes.pod.namespace
+              operator: Exists
+      toPorts:
+        - ports:
+            - port: "7000"
+              protocol: TCP
+`)
+}
+
+func TestEvaluateIngress(t *testing.T) {
+	params := rulesTestParams(ProviderKubernetes)
+	production := rulesTestParams(ProviderKubernetes)
+	production.Environment = "production"
+
+	tests := []struct {
+		name     string
+		params   ComponentPolicyParams
+		endpoint string
+		caller   Caller
+		want     Verdict
+	}{
+		{"same project", params, "admin", Caller{Project: "shop", Component: "web"},
+			Verdict{Allowed: true, Reason: ReasonProjectVisibility}},
+		{"denied component of the same project", params, "admin", Caller{Project: "shop", Component: "batch"},
+			Verdict{Reason: ReasonDeniedByRule, Rule: "deny-batch-admin"}},
+		{"deny limited to listed endpoints", params, "api", Caller{Project: "shop", Component: "batch"},
+			Verdict{Allowed: true, Reason: ReasonProjectVisibility}},
+		{"allowed by rule", params, "admin", Caller{Project: "billing", Component: "invoicer"},
+			Verdict{Allowed: true, Reason: ReasonAllowedByRule, Rule: "allow-billing-admin"}},
+		{"other component of allowed project", params, "admin", Caller{Project: "billing", Component: "reports"},
+			Verdict{Reason: ReasonNotVisible}},
+		{"namespace visibility", params, "events", Caller{Project: "billing", Component: "reports"},
+			Verdict{Allowed: true, Reason: ReasonNamespaceVisibility}},
+		{"deny beats namespace visibility", params, "events", Caller{Project: "legacy", Component: "app"},
+			Verdict{Reason: ReasonDeniedByRule, Rule: "deny-legacy"}},
+		{"gateway reaches external endpoint", params, "api", Caller{SystemComponent: true},
+			Verdict{Allowed: true, Reason: ReasonSystemComponent}},
+		{"gateway cannot reach project endpoint", params, "admin", Caller{SystemComponent: true},
+			Verdict{Reason: ReasonNotVisible}},
+		{"unknown endpoint", params, "metrics", Caller{Project: "shop", Component: "web"},
+			Verdict{Reason: ReasonNotVisible}},
+		{"environment scoped rule", production, "admin", Caller{Project: "shop", Component: "web"},
+			Verdict{Reason: ReasonDeniedByRule, Rule: "lockdown-production"}},
+		{"system components ignore rules", production, "api", Caller{SystemComponent: true},
+			Verdict{Allowed: true, Reason: ReasonSystemComponent}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EvaluateIngress(tt.params, tt.endpoint, tt.caller); got != tt.want {
+				t.Errorf("EvaluateIngress() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -140,6 +140,11 @@ type ClientInterface interface {
 
 	UpdateIncident(ctx context.Context, incidentId string, body UpdateIncidentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// QueryPromQLWithBody request with any body
+	QueryPromQLWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	QueryPromQL(ctx context.Context, body QueryPromQLJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// QueryRuntimeTopologyWithBody request with any body
 	QueryRuntimeTopologyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -402,6 +407,30 @@ func (c *Client) UpdateIncident(ctx context.Context, incidentId string, body Upd
 	return c.Client.Do(req)
 }
 
+func (c *Client) QueryPromQLWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryPromQLRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) QueryPromQL(ctx context.Context, body QueryPromQLJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryPromQLRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) QueryRuntimeTopologyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewQueryRuntimeTopologyRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -968,6 +997,46 @@ func NewUpdateIncidentRequestWithBody(server string, incidentId string, contentT
 	return req, nil
 }
 
+// NewQueryPromQLRequest calls the generic QueryPromQL builder with application/json body
+func NewQueryPromQLRequest(server string, body QueryPromQLJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewQueryPromQLRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewQueryPromQLRequestWithBody generates requests for QueryPromQL with any type of body
+func NewQueryPromQLRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1alpha1/metrics/promql")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewQueryRuntimeTopologyRequest calls the generic QueryRuntimeTopology builder with application/json body
 func NewQueryRuntimeTopologyRequest(server string, body QueryRuntimeTopologyJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -1257,6 +1326,11 @@ type ClientWithResponsesInterface interface {
 
 	UpdateIncidentWithResponse(ctx context.Context, incidentId string, body UpdateIncidentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateIncidentResp, error)
 
+	// QueryPromQLWithBodyWithResponse request with any body
+	QueryPromQLWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryPromQLResp, error)
+
+	QueryPromQLWithResponse(ctx context.Context, body QueryPromQLJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryPromQLResp, error)
+
 	// QueryRuntimeTopologyWithBodyWithResponse request with any body
 	QueryRuntimeTopologyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryRuntimeTopologyResp, error)
 
@@ -1560,6 +1634,32 @@ func (r UpdateIncidentResp) StatusCode() int {
 	return 0
 }
 
+type QueryPromQLResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PromQLQueryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryPromQLResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryPromQLResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type QueryRuntimeTopologyResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1863,6 +1963,23 @@ func (c *ClientWithResponses) UpdateIncidentWithResponse(ctx context.Context, in
 	return ParseUpdateIncidentResp(rsp)
 }
 
+// QueryPromQLWithBodyWithResponse request with arbitrary body returning *QueryPromQLResp
+func (c *ClientWithResponses) QueryPromQLWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryPromQLResp, error) {
+	rsp, err := c.QueryPromQLWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryPromQLResp(rsp)
+}
+
+func (c *ClientWithResponses) QueryPromQLWithResponse(ctx context.Context, body QueryPromQLJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryPromQLResp, error) {
+	rsp, err := c.QueryPromQL(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryPromQLResp(rsp)
+}
+
 // QueryRuntimeTopologyWithBodyWithResponse request with arbitrary body returning *QueryRuntimeTopologyResp
 func (c *ClientWithResponses) QueryRuntimeTopologyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryRuntimeTopologyResp, error) {
 	rsp, err := c.QueryRuntimeTopologyWithBody(ctx, contentType, body, reqEditors...)
@@ -2491,6 +2608,60 @@ func ParseUpdateIncidentResp(rsp *http.Response) (*UpdateIncidentResp, error) {
 	return response, nil
 }
 
+// ParseQueryPromQLResp parses an HTTP response from a QueryPromQLWithResponse call
+func ParseQueryPromQLResp(rsp *http.Response) (*QueryPromQLResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &QueryPromQLResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PromQLQueryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseQueryRuntimeTopologyResp parses an HTTP response from a QueryRuntimeTopologyWithResponse call
 func ParseQueryRuntimeTopologyResp(rsp *http.Response) (*QueryRuntimeTopologyResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -903,6 +903,40 @@ type MetricsTimeSeriesItem struct {
 	Value *float64 `json:"value,omitempty"`
 }
 
+// PromQLQueryRequest defines model for PromQLQueryRequest.
+type PromQLQueryRequest struct {
+	// EndTime The end time of the query range
+	EndTime time.Time `json:"endTime"`
+
+	// Query The PromQL expression to evaluate. Every vector selector in it is
+	// restricted to the search scope.
+	Query       string            `json:"query"`
+	SearchScope PromQLSearchScope `json:"searchScope"`
+
+	// StartTime The start time of the query range
+	StartTime time.Time `json:"startTime"`
+
+	// Step Query resolution step (e.g. "1m", "5m", "15m", "30m", "1h")
+	Step *string `json:"step,omitempty"`
+}
+
+// PromQLQueryResponse defines model for PromQLQueryResponse.
+type PromQLQueryResponse struct {
+	Result []PromQLSeries `json:"result"`
+}
+
+// PromQLSearchScope defines model for PromQLSearchScope.
+type PromQLSearchScope = ComponentSearchScope
+
+// PromQLSeries A time series of the result of a PromQL range query
+type PromQLSeries struct {
+	// Metric The labels of the series
+	Metric map[string]string `json:"metric"`
+
+	// Values The samples of the series
+	Values []MetricsTimeSeriesItem `json:"values"`
+}
+
 // ResourceMetricsTimeSeries defines model for ResourceMetricsTimeSeries.
 type ResourceMetricsTimeSeries struct {
 	CpuLimits      *[]MetricsTimeSeriesItem `json:"cpuLimits,omitempty"`
@@ -1257,6 +1291,9 @@ type QueryIncidentsJSONRequestBody = IncidentsQueryRequest
 // UpdateIncidentJSONRequestBody defines body for UpdateIncident for application/json ContentType.
 type UpdateIncidentJSONRequestBody = IncidentPutRequest
 
+// QueryPromQLJSONRequestBody defines body for QueryPromQL for application/json ContentType.
+type QueryPromQLJSONRequestBody = PromQLQueryRequest
+
 // QueryRuntimeTopologyJSONRequestBody defines body for QueryRuntimeTopology for application/json ContentType.
 type QueryRuntimeTopologyJSONRequestBody = RuntimeTopologyRequest
 
@@ -57,6 +57,9 @@ type ServerInterface interface {
 	// Update incident
 	// (PUT /api/v1alpha1/incidents/{incidentId})
 	UpdateIncident(w http.ResponseWriter, r *http.Request, incidentId string)
+	// Query metrics with PromQL
+	// (POST /api/v1alpha1/metrics/promql)
+	QueryPromQL(w http.ResponseWriter, r *http.Request)
 	// Query runtime topology
 	// (POST /api/v1alpha1/metrics/runtime-topology)
 	QueryRuntimeTopology(w http.ResponseWriter, r *http.Request)
@@ -379,6 +382,26 @@ func (siw *ServerInterfaceWrapper) UpdateIncident(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
+// QueryPromQL operation middleware
+func (siw *ServerInterfaceWrapper) QueryPromQL(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QueryPromQL(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // QueryRuntimeTopology operation middleware
 func (siw *ServerInterfaceWrapper) QueryRuntimeTopology(w http.ResponseWriter, r *http.Request) {
 
@@ -635,6 +658,7 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/alerts/webhook", wrapper.HandleAlertWebhook)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/incidents/query", wrapper.QueryIncidents)
 	m.HandleFunc("PUT "+options.BaseURL+"/api/v1alpha1/incidents/{incidentId}", wrapper.UpdateIncident)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/metrics/promql", wrapper.QueryPromQL)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/metrics/runtime-topology", wrapper.QueryRuntimeTopology)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/traces/query", wrapper.QueryTraces)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1alpha1/traces/{traceId}/spans/query", wrapper.QuerySpansForTrace)
@@ -1188,6 +1212,59 @@ func (response UpdateIncident500JSONResponse) VisitUpdateIncidentResponse(w http
 	return json.NewEncoder(w).Encode(response)
 }
 
+type QueryPromQLRequestObject struct {
+	Body *QueryPromQLJSONRequestBody
+}
+
+type QueryPromQLResponseObject interface {
+	VisitQueryPromQLResponse(w http.ResponseWriter) error
+}
+
+type QueryPromQL200JSONResponse PromQLQueryResponse
+
+func (response QueryPromQL200JSONResponse) VisitQueryPromQLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryPromQL400JSONResponse ErrorResponse
+
+func (response QueryPromQL400JSONResponse) VisitQueryPromQLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryPromQL401JSONResponse ErrorResponse
+
+func (response QueryPromQL401JSONResponse) VisitQueryPromQLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryPromQL403JSONResponse ErrorResponse
+
+func (response QueryPromQL403JSONResponse) VisitQueryPromQLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryPromQL500JSONResponse ErrorResponse
+
+func (response QueryPromQL500JSONResponse) VisitQueryPromQLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type QueryRuntimeTopologyRequestObject struct {
 	Body *QueryRuntimeTopologyJSONRequestBody
 }
@@ -1467,6 +1544,9 @@ type StrictServerInterface interface {
 	// Update incident
 	// (PUT /api/v1alpha1/incidents/{incidentId})
 	UpdateIncident(ctx context.Context, request UpdateIncidentRequestObject) (UpdateIncidentResponseObject, error)
+	// Query metrics with PromQL
+	// (POST /api/v1alpha1/metrics/promql)
+	QueryPromQL(ctx context.Context, request QueryPromQLRequestObject) (QueryPromQLResponseObject, error)
 	// Query runtime topology
 	// (POST /api/v1alpha1/metrics/runtime-topology)
 	QueryRuntimeTopology(ctx context.Context, request QueryRuntimeTopologyRequestObject) (QueryRuntimeTopologyResponseObject, error)
@@ -1853,6 +1933,37 @@ func (sh *strictHandler) UpdateIncident(w http.ResponseWriter, r *http.Request,
 	}
 }
 
+// QueryPromQL operation middleware
+func (sh *strictHandler) QueryPromQL(w http.ResponseWriter, r *http.Request) {
+	var request QueryPromQLRequestObject
+
+	var body QueryPromQLJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.QueryPromQL(ctx, request.(QueryPromQLRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QueryPromQL")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(QueryPromQLResponseObject); ok {
+		if err := validResponse.VisitQueryPromQLResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // QueryRuntimeTopology operation middleware
 func (sh *strictHandler) QueryRuntimeTopology(w http.ResponseWriter, r *http.Request) {
 	var request QueryRuntimeTopologyRequestObject
@@ -2002,112 +2113,119 @@ func (sh *strictHandler) Health(w http.ResponseWriter, r *http.Request) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+w97W7buJavQmgHaAMoTtpOF2gu9kcmzczk3k7aTdLbH+NgQ0u0zRuZVEnKqScIsA+x",
-	"T7hPsuCXRMmkLDl2mp3xnzaJqMPDw/PFw3OO7qOEznJKEBE8OrqPeDJFM6h+PM4QExdFhi7Q1wJxIf+W",
-	"M5ojJjBSIxJKUiwwJcuPEIGjDKXyxxTxhOFcj4u+TJGYIgbEFAEoZwCsyBDAHNhX4kgschQdRSNKMwRJ",
-	"9BBHmAjE5jBbhnc1RcA+BXQMBJ4hICj4WiC2AGPanKkCzwXDZCKhS8ShoMwP3T6VUAuO/DARKWbR0e/R",
-	"RERxNBHyT5lQ/6inX6M4IuhrdO2ZXUwZ4lOapf7py8dgDrMCtWJhYJNiNkJMwr7DJKV3fsD62Xo0e4gj",
-	"hr4WmMkt/j2qts5M6OyYQ153rRUl6OhfKBES2xkSMIUC+jjNMOlnHCDTxxyRkylliIJyMPh89r5cVxRH",
-	"Y8pmUERHUVHg1McIiMwxo2TWcSJneO+pCJwh/wTyidqVlXwrR/IcJi2A1ONlaODkwgcwZ1TuRZe1m6E9",
-	"193gG0UEdx01FJb2I67zgY+FOC2YpkedgWZIMJz4V6Wf1QXA/G0EOUo13bgj5Ule/FfB4UQiPEMzyhbl",
-	"r6MinSDhFXRNIy8KemJBAfqGkkJo8c7opInAsvJQf/DqjUVebryhSrWAjE4U6oooLUg39ks9XSZ7Y1Qp",
-	"xuV2xI6p8O2aY2p4TglHO1uzszUOD+4sxV/RUuyU+9aV+7Ii9+vmL2g0pfQ2eBJQa7jCM8QFnOUBnO3j",
-	"GpO5nJBCgfblMB8x1Oh/SrXkB681VgP0kpKSLH2+AYGycNYTqm7sXqd8yDDOEFfcGeB+9bCOwZ0G6VsW",
-	"F1AU3A9LPwuBsszHiyRBXMkTY5R1YjuzVEwm0ge4XJAkvFyYWCdgGUP9DAh4iwiQP4QsZ8IQFMr8F3lq",
-	"fyLJFJKJ+jlFGZJ/9Ql6BrmQKKL0WHRkdPkK4AuShDjpJ5jcIpKeBZTpSD8GZ+/BS6lFx4zOAB1x6YiM",
-	"cIbFwg7Z6869H+gEJzALzZnpx2pOyckdIfdloMbGcEVYqRMgzrwbEOQe/p9SzQY1FCKp1E9+zCRxlWNi",
-	"cFuyUa2aKcMzbFhhDItMREevDg9jnzTCb3hWzIBWR3IyLNCMS9PAkCgYkWpbj1EwDuNohon5tZxYeqAT",
-	"rc04giyZXiZU24kfGBpHR9G/HVQxnQMT0Dk4sX+6dN5RNpWJjyxFrLYAhXzkW4McD6h8oUksu4ewfNMr",
-	"P1xAbSqCTMLE2pvROIlUc8UlA9Spdr2KnYJ6SFttv+xgLiT2pWVX2xyAERJALR9n7zdtCysomCQ4RUSc",
-	"9j8/JZSM8aRgKJXMKxieTBADFiAHd1NEwFhtg++IFXbfoT0JrjgBLq+5fFwiB9VvPnVTB9x+4EOSmBpU",
-	"efZ7iQaTARhGr2bDKAbD6O1sGO31P+1JMYUMc4mlPfgV0iWUDmI1b/PIl7nnvo0f+aZQ2A3l7b5U24FP",
-	"CbA59cnVwMmEoYkmo6XeW0O9V1Mv9XyavjaRb17nL91PRo91BjmaI4ZFwP23T1sNHyZjGsXRHWREAo2j",
-	"hGEhDbBfh5YHIZ+Cls96C0GHM1TJmvr3/VXHl5VHohJgRif7mzkM6RVu+UiUwRHKeEvsodsJoxzuW+7q",
-	"OIb0BD2Q+oQuuuHpvLBuKMTBtQ6tU/RDnaK64eqGkkNBi26QzOB1gh/OaisoveMdPs4jVOAxTpRQn0wh",
-	"IYYPPUtxRoLEDK1JyQCcznKxAHgMtLctTbl6bTFwfZYVBPfMExbfCDIGF+r3LQYLfJRbmp/S2994i/HS",
-	"p8gyblTiwAEmYIazDHMkfQ5HWTmeuaAi5FCoR84ZoKnySii+ZZRu/Ac6OSVC69e6FsrQHGXBQx3Qj33H",
-	"GDoJv2WjDJ73XGfOazvU0/IsTCcAKcTj/trTG7pV3Kjclgki0ouSnKxnWk+xhgPEoUlWarGEEgExQSy8",
-	"tnJI3wV10ueBWPT6U60V9V6bfh2sgDNvFXnrub6cpuEJ/lGMECNIIA5ymq4Juk+8sDFhj7lW2TlPdL7v",
-	"ctaM/6/JAV6N3tOCuJpnXSvijaKE/UD5S5sQeZ/X7mpChPc889ytazC+GMcpY5SFoxsqdntC01C8TD4G",
-	"CU2RG4tEDMj/sPa8v8FZLo/x0cefLvf/+Wr/w/7r137zEYhf/1rMINlnCKZwlNk5KztUTfAb5hyTCbCr",
-	"B2OMspSDF2X45wWAJAUvTAjohdc/wSJrXa0zszlUjGBqw41xVBBYiCll+A8dv6RshNMUycM7oeJnWhCd",
-	"v0DGGVb+oAomEJhdKsqp/dBjz+SyJHd0jn+ezlUUx+sNtF4PIPni5my7Ardpu26xxBxAzmmClea4w2K6",
-	"ceveOtVmzlXtdrjfWh9tjR+33kfa5H5r1cz+D0wC67zFJPXYTf2aOxuZ02yOuAlCnTBK/k5He+Epux0W",
-	"u0zZPseajkGv2R7hF/TbrbW9g8dwpE8zMgR5KFTIp5SJGMxgMsUEVYZGv1OmpmiENLtcwjvpAajLwRDb",
-	"9HVLrNLsdtcUjoJpPFUsTCN7LgFmMfiiY4odI6zKluzu0iJK0MdxdPT7Wrdq7S99oex2nNG72jvXu7u4",
-	"61XsGPRW5zZ9PSAWXCGOUQpMesK4yLKFG+Rq2y/HvdpQIMkgteFA0gwKqcomBnwMEpjnKAVQACkAHSNM",
-	"vwqR/6YC5Vzu0aWkmyfWnUGBSLL49PZQXaF1oeMS1DOBZj6SWtjvtgn73eZhzxAkHzT8zQNnWhuf0EKf",
-	"GzcLvZKLi63OU5CnmcnH2WfmevpTIYKmbZ0LRnvt7XWSqfAAjs7ln5suzkpg3ZNrHCilKUgEnkuVC5Nb",
-	"Qu8ylOpcJ4a4dBjT1dnfZvrrVaQNp25VE6/OnVIpBO5awB3koIF8j9TBUJ5FdVej7w7dC3ATomrbk00z",
-	"jH22Gt0uUK70Oo7xCeXimMBswTEPJ3ocn4GEcgGgGalIXtHCusTLM9fS5xtTXySwdcaLk+N15tldwu4u",
-	"Ybd/CbthDW6V7brqr1TWXVXfU5uMOCrFeN01lgAeEae39mh3kN0lhW7mINrkqJCTU+ZAtqeGVsPC2aE7",
-	"d2nnLu3cpZ27tHOX/szuUs/rAmfejjcGz8Ef20TQtKou2HDc1LXFXSKkH+jkr+hXZnTyAc2NESl9Fsv8",
-	"709/+vxLFEdn5z9/jOLoy/HFeRRHpxcXHy/8fO9yRBwVBH8t0JmGKliBSkf205RB7s+F2V3ZPEdP2RGP",
-	"kJOc0QkP5poGL2uq/e0UKV5OlV1WRB1B2e0PQ7peU6mp9W7rHshmvGG07mWQibZ/N23XVpViyz6a5fWO",
-	"LEnLaypBpkLkfoHawHF5mzIpwaM8BBnlNZiSDCkSiM0w0WkbFVvkFEvjWer/ATgdTAbg1SwGb2cxeCX/",
-	"eXMof5qu1AxlJc16KqLOVpWW6KbBL8yuLt9SrlLj/rtNJbz+e6UlXu/rrqld5wqgsr+dN33esbqzdQJa",
-	"jHy9WnySHibq8pExLz5IL4Rv/nowyQujZrYD/LPNhdz0xe+MssW2iKKhb48uGv5WSOPltIJIpr2iOc3o",
-	"ZHGa+rJTj4lNKk6BYHA8xgmQFljnG0Nb5UhoqroJQSAgmyCh/jBYrvD1HHovhcr2Up4/HmPEqoyvdIIG",
-	"4ISSuXxEydGQ7Ffhiv1hcXj4BpW/H4GbH+45S0rj8HCkfr/UqdAPZvwP9ykXtTEpF3bMjZxhAgW6g4tl",
-	"+ADcmGdHP9ybn87hrAfoJvLom849PgLdkC/H/3A/pVxIoGFrvZJ3GgxgWMkEKQRNqMfP+YKZCkyox1b3",
-	"NTlk4Jj+sL0va2d74HhOU3SBxorBFaOt+37DjqqoSumjGNDXq4Xmt4rSDbkxddYI/Hp19clU6nJA56aM",
-	"32SRoNSt0B4MbdqKti0cQCbP28A4pOBlQgnHXKiQABZTcABzfDB/dWDgHyjnY2+g2KItT6iO7NtDMQU5",
-	"YomUs6xEDph3YgeFQRe71swcqs/2bnuzvfPM9m7TszWyi+rT/YYg2cAczSSjhvPROGgoFjOv8EoasQ4i",
-	"Gd7qNnFbXlAjklhO774DXhJK9l9/+7bXwKo/Mh1s1rm3MuZYm6MmHZh+FwjzcqxFCAtedkVAqZXUQbh8",
-	"whsCaYbcV8ampf72Qro1ufVljyQnPm+Mjqq60ZbAq1ofrf9tM4aN1UQ1494ryWMLmFaWVylyXXdjFan5",
-	"l7jlAo0RQyQx/otinQDHDIDOV4c5AinKkVTJlIAbicON8k7kT//huiQuX9yodPrsDi44yGleZDoAb4O9",
-	"KRRwSIB0EhA3/hVRUrRvzYfpLvU3B+6NbQuFORjjLEOphFECLSvCEqWXVAAcYDEokbUejfRuJCCFZFnB",
-	"ZVuE6CIpJHSjEEwEg+q3vQqQ48tAATIEuQCUqPPRjWT2G0CZi/dBnTYSaz6lRZaCkTxIib+BG8MzNwc3",
-	"Ffco/DBJsiJ1iadttwSiHgMIUjxWGyvsPYrPKtaEus4XJ/UCqJdqqvr+xnJJqkeMWTtIGOV839ZSaKT4",
-	"3qD/JV2oOmoAPpWco1hElH1BHPYoOBoX2ZBI3Lj2r8tgVEmyab2uT60Sc1AQOIc4k3/TFOusyhplg5QL",
-	"l2qWRn5qbEDr+TvI/KJfXtpEA9SPTUubzrJGyHRdSBHI8Fwe+smg5b5vGdAnt7DHQydVKlmxdpiv9wZ9",
-	"7xn9ZT+DLnvt6OXGwYCy24zCFCCSqghXWGx8CK+p1Z1YaFOraxdsRFPda/TTx8sr6y7DLJ/Cymk2an6/",
-	"VPND4gTOwKzgwmqc6o44tqSL1Ua5RX3/+9//Y03HkFigcv/MG/vNN/a5nCjV5oWqJUhdYuk1JKqQNgZ4",
-	"LBVjLPW4xFyoi9Ui08FEeVrmJg2EFslU/1gC8Wm//gFjUPaV7xY9M2Q7tXLrXs4IVqA41OmMlhQ366IH",
-	"St1VbgygheA4RfXj1JBYjn5Z18VUuqrj/TyDQqK+NwDvNSJcd04rjLbzZoZIRIwi4euswSgbYI7xjkpX",
-	"q/Pg4sWkR4i8IScbCZT32/zmHZaDgT9c3Uncqxj1MtpNd01Khxo+ANL741rsFDuZJh+AkmwB5MlQioTU",
-	"E0NyN8VSeEys6w46B6q0kEtrOb+DSwGF6nKsMRiSl3dWL2qHUR3uJwzmU+WxnX+8qpwZ5XUq70uj/TeA",
-	"hdY+IzQkYySSKUoBRzlkUKBsUTkAjkI//nTmFXW57M5RRV9o0BOwlNZvbaDq7OatgJnNoK6g78Pg5q0l",
-	"tjN/78BcjVYSMMvWvsW+DrWAqPyBev+Jaw86FR2aIQZT7a//PLIs6cqo4VHNR4qJJe6F0FlqgzZL0E2x",
-	"l71DdDZL56yeSu1s7B7cRcW3zVcMJugyh+Q9EhBnvKUwRgiGR4VJnIKp7mMJs0/OKJ/GVzozhwQ4AHxN",
-	"E00XwPPA3XTa6BKoQGICCCS0upIu6YaJ+PcfvXfUvey6nKWzPc+h9DwlMUOJnnqExt3fNNVeBh8/gtoW",
-	"xgqK8xZEWzCUj7q1ODDE80LolioYhNDXRPfax7b8On19L/mwnmknJxiAj/pIP4zorQ4GqK4s8kfKwDAq",
-	"CEdiGLk3ALotuemrop53bKdSCu6qNBaJ2Yo8bz0knOO9k/yd5K+U/E5yu5P8x0v+JtJUlcRvLaVLQV8z",
-	"mUvpte+Xy2XOG3UhLE+0Y5jxLkfahtYrQ+blkcs90iqg/jPtrj7rz5V1WmPukMFeR56FArw1gdbgO0h0",
-	"HOmh7f6GGRN0OPp6BAre9l0CNU1nTTKFXPXLaynNgmRRejPVOqaQA0hMQz9ta/zagVHq+BzLDoV5bE12",
-	"cMB56EJT4ha6Za9lbZbmxCWTR6n0FdB+FFejQ36Npq3fsVHPuvkljcV1t9O+EUsZ274c9LXaHX+PtqO+",
-	"8oOlBbXfkQvIb4PceGfgXxQhju3RXFSZuKRgWCwupR3T2P2EIEPsuBBT+dtI/fazJcffv1wt2a2/f7kC",
-	"gkp1rL4fUYgpIsI0Gh+AM+MOKMZRo4yIHJsunFqLTRGURg9y8EIjAFQkPFGv6KD4C6kBlMFVOkCNqnZF",
-	"pZE9PCj3ZUzNZ0gE1Bdr+ubPvda6QnC2VObabHn30d6NH386Azmjc5wiXt5fqXCwtj8mh5/HQ2LNBCRl",
-	"qogOw5Y7od+rnIjyoogv3RRJgJCDO5RlkjSqBkEBs3zAB0NyJtT3TiYMSjdLpazYEHDj21MzmhYZ0g4X",
-	"Eoku64OJKGCmkgvAHMMhkYtNYJbpuwY5IoW5oIxbEqRgpC2ugafDyRlOkLHlhtzHOUymCLweSCtZsMzs",
-	"Ej86OLi7uxtA9XhA2eTAvMsPPpydnJ5fnu6/HhwOpmKWOQ1fo8DGRHE0R4zrDXw1OBwcmu+5EJjj6Ch6",
-	"MzgcvInk+VRMFYPblDjdfOug/PhF7r2lVo6K28jRtAQrQ+uelrr6GzGYKqukIej2aFGZuPUTTReWSU12",
-	"AczzzIjNwb9MM0TtX3bqe1Y/LzzUFYEp1LLOt6LD68PD7WBgnDqFQp2Ypy093h7i6MdOGJX9hGvdj6PI",
-	"6VC8Xqdhw2dOt+CHuOv6a12aPSs/I3OY4dReC+nVvtrQai1wysDMLFzpTWdRta7Hm1vW5wbYHw/fbGhN",
-	"l4VumKrNwLfFH+ZmVHqGhIIcMbVUqg4Bc4zurGDSMahSMMaUxsAmUowgi0GVtTOCf0hbdOpczKdojjKa",
-	"22J0Q7uqRfTmCPezC/PtY/jedO0+3T98VSOgswBfB+tNsrbJR9HgQQn/7cYY3NEbKk+CUAFw1X3b2iPn",
-	"Y2fKVCrDpZ0EQ4lG1+7NEeGcClCD7F5UGiOCrA0QcMKlc2aMwrUcbK2SRLybTaq8ge5m6AOdbMsILRVb",
-	"P7EJWq5m9WzTh2DV6s787MzPo8yPEse/qPH5sP/63bMyPh7tm2nVZ3Wv0oQ1zVsrkVmlfGtHu+7616bQ",
-	"b0cF+4rAn1gLewuGPftmxj1SF39v7fhd1dj3UwZPLruzUmys+FpBciXYZO3qz7R1E2PzobieUnxsPwS3",
-	"DSH2fCP7iWXY91llz/bpYTsJ3klwBwmuvp1oBNjIUFh+TW3Mwb3+4WqRo4cDVmSm0QFkcIYEYlxlYAa/",
-	"rrv8yVn1ZeyXGZ3ERq2o9AT9odk99bmn6EgFCyNbMRJVGERNOXQdmd7fsL2OA8rphCEoEICk9jVv0k1F",
-	"6ZePy+9zb1FNSfi9lNSrzc6PyUSicLkgyUpNpYmYKOI8R2317unmd+gBM4ZgugDoG+aCP0sFYoWh9mXv",
-	"x2uRg3v5n2rPoAUwQ8Jz+fle/X1NUdQv10Vxm0a7vzzoZT9Hefjxu8gDoQKM1TcAn6MoWGZsFYU4Mm0v",
-	"GnWOSKzJxb8g8XQsrE1Kp71i0sKi+Y57/59wr+LAFaz7p/Dr4lUZNDUqeBCzlqkVLZ83WXgE/3Oeru9M",
-	"6pefpzP53Y1noYjz/NTPs5N8y4JruXB3aDSl9DYcyvkVklSltZSN1pthHWj21zZAWGJzDUKh8sVMt0VO",
-	"N1N8T2YvUVjF6Ib6YKootOP1EK+bRLro6Pdrl/PX4s3VolF2wu4W5qw6cveMdJ45Hbe3IQ7+z788sUAE",
-	"vhji3X9Lx13Ucxf1XB31rDWsN0JdiVSrXN9XH0x56BTwXP6AChDUuCh+L9P5JMtm/cwSgX5e5ln1xYRt",
-	"6hrnq5LfSdG4H19s0TLP1r/8iyqZJz3Vl0zwvM/0Rujdb8N00XOhzkphR+ZClfBxXamB56Ztqu3CUnZ0",
-	"USUIZdMq1Q9rgueIuFn1R0MCyzos1aIEvHTT8U0zHh5XbdvKnP89lbdVva7apgzJy7IFjIlP2EY+ptux",
-	"2xmZ78UAwWSqU/WXO0sOyUvbHzShBRGxKYMyv5ieoU7LUr5XNe9Y7h87JPUGsn5Hr9FbZEsqONCc64nV",
-	"cKhnkEcELpodg3Z+387vW+33NRtNOWqxKWge5ahLiLqd7EzRa89j3ZUtPt2GkHuq159YwH0lxp791MN2",
-	"Ir0T6Q4iXdZrW0E2MhSW33tTi/twoEqDu8mzriLWXowu1+0p2qodzs+UXZki3R7nRlvX6zkq2rLinufE",
-	"P7F68bQd8vCXGrXTMDsN00HDLIn+Y5TNve5vpEJHwXSAVPe7k/IPdROG9RTPL0g47fOehfKJ22czHZF8",
-	"d8K6mURvRbdtXdPsTRhQNuWe7nTOTuesSsZolf+Q9pkimOneEF69cjJFya1uH68GNjqFNXXJYPkqVsN/",
-	"pEw1+v+VPc3KOqZIo7fo0tfDI2oae4A5sHDUJr95BJK6D1oNR5oj02n8CCSUEJSoZhljiDOUtjdvq4AU",
-	"ZFNLrSC1XnnqfU8kIzhMZPb1+qHxbr3jyO/XUp2ad+6Xqzdt0pcbk6uUtyrqWtb9ze4N7UBMVe4yGHdh",
-	"vhfNCh+uH/4vAAD//9FdfCmtvQAA",
+	"H4sIAAAAAAAC/+w97XLbOJKvguJN1dhVtOwkk6uKt+6Hx/HMeDeTZG1n82PkmkBkS8KaAhgAtKNJueoe",
+	"4p7wnuQKXyRIgRIpS45vRn9sSQQbjUZ/odFofI0SNssZBSpFdPw1EskUZlh/PMmAy4sigwv4XICQ6rec",
+	"sxy4JKBbJIymRBJGFx8BxaMMUvUxBZFwkpt20ccpyClwJKeAsOoB8SIDRARyr8SRnOcQHUcjxjLANLqP",
+	"I0Il8FucLcK7mgJyTxEbI0lmgCRDnwvgczRmzZ4q8EJyQicKukIcS8bD0N1TBbUQEIYJtJhFx79FExnF",
+	"0USqnzKp/+inn6M4ovA5ug70LqccxJRlabj78jG6xVkBS7GwsGkxGwFXsO8ITdldGLB5th7N7uOIw+eC",
+	"cDXFv0XV1NkOvRnzyOuPtaIEG/0bEqmwnYHEKZY4xGmWST+QFjK9y4GeThkHhsrG6MP563JcURyNGZ9h",
+	"GR1HRUHSECMAvSWc0VnHjrzmvbuieAbhDtQTPSsr+Va1FDlOlgDSjxehodOLEMCcMzUXXcZum/Ycd4Nv",
+	"NBH8cdRQWJiPuM4HIRYSrOAJLDLQDCQnSXhU5lldAOxvIywgNXQTnpQnefF7IfBEITyDGePz8uuoSCcg",
+	"g4JuaBREwXQsGYIvkBTSiHfGJk0EFmCaH0Ig1RM38ZYq1QAyNtGoa6IsQboxX/rpItkbrUoxLqcj9kxF",
+	"aNY8UyNyRgXsbM3O1ng8uLMUf0VLsVPuW1fui4o8rJs/wmjK2E3rSkCP4YrMQEg8y1twdo9rTOZzQool",
+	"HKhmIWLo1v9SaikM3misBugFJaVY+u0GBMrBWU+ourF7nfJthnEGQnNnC/frh3UM7gzI0LCExLIQYVjm",
+	"WRsox3yiSBIQWp44Zzy67j5UQifKB7ic06R9uDhxTsAihuYZkvgGKFIf2ixnwgFLbf6LPHWfaDLFdKI/",
+	"p5CB+jUk6BkWUqEI6YnsyOjqFSTmNGnjpB9xcgM0PW9RpiPzGJ2/RntKi445myE2EsoRGZGMyLlrst+d",
+	"e9+wCUlw1tZnZh7rPhUnd4Tcl4EaEyM0YZVOwCSDtA/3iH8qNduqoYCmSj+FMVPE1Y6JxW3BRi3VTBmZ",
+	"EcsKY1xkMjp+dnQUh6QRfyGzYoaMOlKdEQkzoUwDB1lwGsWRbaNhHMXRjFD7teyYUAkTo80EYJ5MLxNm",
+	"7MR3HMbRcfQfh1VM59AGdA5P3U+X3jvapnL5jqfAawPQyEehMaj2iPHU4O8Ty80hLt8Myo+Q2JiKVibh",
+	"cu3JaKxEqr7ikgHqVLtexU6tekg3apEdIqTCvrTseppbYLQJoH6Izl9v2hZWUAhNSApUnvVfPyWMjsmk",
+	"4JAq5pWcTCbAkQMo0N0UKBrraQgtsdrdd+xWgitWgItjLh+XyGH9LaRu6oCXL/hAEdOAcg3RHgwmAzSM",
+	"ns2GUYyG0cvZMNrvv9pTYoo5EQpL21Ctt1LtIFb9Npd8mb/u2/iSb4qlm1Cx3JdatuDTAmwa6NHgyYTD",
+	"xJDRUe+lpd6zaZB6IU1f6yjUr/dL95XRQ51BAbfAiWxx/93TpYaP0DFT4VPMqQIaRwknUhngsA4tF0Ih",
+	"Ba2e9RaCDmuokjXN94NVy5eVS6ISYMYmB5tZDJkRbnlJlOERZGJJ7KHbCqNsHhru6jiG8gQDkPqELrrh",
+	"6b2wbijEw7UOrVP0Q6+iuuHqh5LbghbdINnG6wQ/vNFWUHrHO0KcR5kkY5JooT6dYkotHwaG4rVEiW1a",
+	"k5IBOpvlco7IGBlvW5ly/dp84PssKwge6KddfCPMOZ7r71sMFoQot9A/Yze/iiXGy6wiy7hRiYNAhKIZ",
+	"yTIiQPkcnrLyPHPJZJtDoR95a4CmyiuhhIZRuvFv2OSMSj5f1EIZ3ELWuqhD5nFoGcMm7W+5KEPgPd+Z",
+	"C9oO/bRcC7MJAo143F97BkO3mhu12zIBChxLSF1P6ynW9gBxWycrtVjCqMSEAm8fW9mk74A66fOWWPT6",
+	"Xa0V9V6bfh2sgNdv2brv+HKWtnfwj2IEnIIEgXKWrgm6T7yw0WGPvlbZuUB0vu9w1oz/r8kBQY3e04L4",
+	"mmddKxKMorT7gerLMiEKPq/t1bQRPvAssLduwIRiHGecM94e3dCx21OWtvCPfowSloIfiwSO1D9iPO8v",
+	"eJZnqtN3P14e/OvZwZuD58/D5qMlfv1LMcP0gANOVXjC9lnZoaqDX4kQhE6QGz0aE8hSgb4vwz/fI0xT",
+	"9L0NAX0fQkMSmS0drdezXVSMcOrCjXFUUFzIKePkDxO/ZHxE0hRopJ22n1hBTf4CHWdE+4M6mEBxdqkp",
+	"p+fDtD1Xw1Lc0Tn+eXarozhBb2Dp9gCoFzdn2zW4Tdt1hyURCAvBEqI1xx2R041b96VdbWZdtdwO9xvr",
+	"g63xw8b7QJvcb6yG2f9BaMs4bwhNA3bTvOb3Rm9ZdgvCBqFOOaN/Z6P99i67LRa7dLm8jzUdg169PcAv",
+	"6Ddba3sHD+HIkGbkgEVbqFBMGZcxmuFkSihUhsa8U6amGIQMu1ziO+UB6M3BNrbp65Y4pdltr6k9Cmbw",
+	"VM8tsm8VwCxGH01McT/qbkt2e2kRo/BuHB3/ttau2vKXPjJ+M87YXe2d691e3PUqdmz1Vm9d+nqLWAiN",
+	"OIEU2fSEcZFlcz/ItWy+PPdqQ4Eki9SGA0kzLJUqm1jwMUpwnkOKsERKADpGmH6RMv9VB8qFmqNL4JbK",
+	"jSgTlkCT+fuXR+pbJzouQD2XMAuR1MF+tU3YrzYPewaYvjHwNw+cG218ygoqNw+9kouLrfZT0MfpKcTZ",
+	"53Z7+n0hW03bOhuMbts76CQzGQAcvVU/N12clcC6J9d4UEpTkEhyC1Ec4eSGsrsMUpPrxEEohzFdnf1t",
+	"u79eRdr21K2q49W5UzqFwB8LusMCNZDvkTrYlmdR7dXoZrUNcEhrGIRgb5ph3LPV6HaBcmXGcUJOmZAn",
+	"FGdzQUR7osfJOUqYkAjblprkFS2cS7zYcy19vtH1RYKX9nhxerJOP7tN2N0m7PY3YTeswZ2yXVf9ufc7",
+	"q77HNhlxVIrxumMsATwgTu/s0W4hu0sK3cxCtMlRbU5OmQO5PDW0ataeHbpzl3bu0s5d2rlLO3fpz+wu",
+	"9dwu8PrtNqQn4Y9tImhanS7YcNzUt8VdIqRv2OSv6FdmbPJG5Q6KWqzOMf/rsx8//BzF0fnbn95FcfTx",
+	"5OJtFEdnFxfvLsJ873NEHBWUfC7g3ECVvIDSkX0/5ViEc2F2WzZP0VP2xKPNSc7YRLTmmrZu1lTz2ylS",
+	"vJgqu6iIOoJy098O6XpNpabHu619IJfxRmDdzSAbbf9m2m7ZqRR37KN5vN6TJQ7lSZCplHlYoDawXN6m",
+	"TCrwkLdBhrwGU5EhBQl8RqhJ26jYImeESk//D9CZShN4NovRy1mMnqk/L47Up+lKzVCepFlPRdTZqtIS",
+	"3TT4hZ3VxV3KVWo8vLephTe8r7TA633dNT3rQgPU9rfzpN92PN25tANWjEK1WkKS/p6z2T/fbFrQEcd0",
+	"AjWclg16yYkxgx+CLzkHIfQxf+bOSMIAnd2q3m4hkYwjAZn5QCgiEhExpBxUL4m0B1aV7GgeRUIx6WBI",
+	"axmlopjtcSxhr0zK/72sCvK71dK/a7X728vZ9f4+Gs3RXs7SYEJQDwVjBrkR7dKT8mEd808DSa1fCvWT",
+	"UTih06/q/zP34cVR42RnRdmXs5W6xSnH9VRLjY/b/A8OQvtpHc2/mxarYxa3mn30Lex23Bqp4zjL1vZa",
+	"r9tSvqs88WuvXx7a5I5OajrE8pAZhfqGnehphipNV9vpUZyaU9g4e19rsTwSaHw/HaJyGBh8ogAZtf5r",
+	"W6RrRlsEsuHcgqAttHiFZr7dZC0G5PLiDZkRKTaffJHkhdXt2wH+wWWabzqtZsb4fFtEMdC3RxcDfyuk",
+	"Cdnxi4Iqcb5iOcvYZH6WhnL/T6g7spEiyfF4TBKk1jfmNAd2Z8gpS3WtNowk5hOQ+ofBgvCHQoqXUufS",
+	"6rgKGRPgVT5tOoEBOmX0Vj1i9HhID6pg8MGwODp6AeX3Y/Tpu6+CJ6X+uz/W3y/NQZN72/67r6mQtTap",
+	"kK7NJ9XDBEu4w/NF+Ah9ss+Ov/tqP6loa3fQTeThiznZcYy6IV+2/+7rlAmpgLavhVbyToMBLCvZELBk",
+	"CQusIj8SDsg9drqzySEDb2HVvpoqKxP0wPEtS+ECxprBNaOt+35DM+uYdbkCtKCvVwvNrxWlG3Jjq1gA",
+	"+uXq6r2tgyAQu7VFUmyOHqR+/YvB0CUFGs9dIMxBuabWkUTKyxRESB1wVWHxQ5yTw9tnhxb+oba5+8ZJ",
+	"XZaFWUf25ZGcohx4AlSSrEQO2XdiD4VBl1VDMy+z3tur7fX2KtDbq0331sjdrHf3K2C6gT6aKZwN96UR",
+	"xtEsZl8RlTQSE6K3vNWt42VZl419mrJ7/x20Rxk9eP7ly34Dq/7IdLBZb4PnDk+MOWrSgZt3kbQvx0aE",
+	"iBRlzRlInaQO2g+nBX3U5obmyp0/pb+DkG7syaWyAp23+2mNjl4nGUsQVK0P1v+u1M3GTpw2dxVXkscd",
+	"D115eFWT67obqyjNv8AtFzAGDjSx/otmnRaOGSBzGgjngFLIQalkRtEnhcMn7Z2oT//luyQ+X3zSh5Wy",
+	"OzxXx7TzIjPbm24rLcUSDylSTgII619RLUUHznzY2n1/8+B+ckX3iEBjkmWQKhgl0PK8baL1kt5eREQO",
+	"SmSdR6O8GwVII+kIXC7gzRFUkGaxTqjkWH/brwB5vgyWKAMsJGJUhxo+KWb/hBj38T6s00ZhLaasyFI0",
+	"AiRA/g19sjzz6fBTxT0aP0KTrEh94hnbrYDoxwijlIz1xEq3Sx2yijWhrvPFaf146Z7uqj6/MWLGW3Vj",
+	"RwlnQhzYDi1SYn/QPwWi7ezpAL0vOUeziCyrLnnsUQgYF9mQKtyE8a/LUH9Jsmn91LQeJRGooPgWk0z9",
+	"ZijWWZU1DmUzIX2qORqFqbEBrReuz/WzeXlhEi3QMDZLiiCXJzBtTZsUUEbUMVFCB0uyKRYBvfePTQbo",
+	"pA+iV6zdztf7g75ZHOFDlYMuc+3p5cbCgPGbjOEUAU31/kG72IQQXlOrewHoplbXD9CIpaaS8/t3l1fO",
+	"XcZZPsWV02zV/EGp5ofUix2iWSGk0zhVBk7sSBfrifKPTP/vf/+PMx1D6oCq+bNvHDTfONCh5dSYF5ab",
+	"oFgl90OqyxTEqv6SABkjF6IWNvimt2ognYCwSXasSKbmYwkkpP3WiNKXt3Z0CxZbsp05ufW3viUvIG6r",
+	"I8lKittxsUOt7io3BrFCCpJCfTk1pI6j9+q6mClXdXyQZ1gq1PcH6LVBRBNP4TIY0mA+nEXEKhKxzhis",
+	"skF2Ge+pdD26AC5BTHrsDzTkZDMbBb0mv5kh4GEQjth3EvcqTr+IdtNdQ9w2VyecUxBG7DQ72d0axGg2",
+	"R0AlUSKh9MSQ3k1JMnWRDJ3WVC4k0kINbcn6HV1KLElSYjCke3dOLxqHUS/uJxznU+2xvX13VTkz2usk",
+	"okT7b4hIo31GMKRjkMkUUiQgxxxLyOaVA+Ap9JP350FRTyfmQ6eoYig0GAhYKuu3NlA1JeHzhbMZ5vOe",
+	"0C7tWwtsZ3/vwFyPu9tSr+5zHUCnokMzxGBrqZifR44lfRl1OzOajzQTK9wLaXKAB8ssQTfFXlZmOpHd",
+	"X6qpnY1lGfmohKb5iuMELnNMX4PEJBNLjh1KycmosGmp4f2pkMbXOjPHFHkAAoiktsbq25YdqbRRg1WD",
+	"JBRRTFmV8FPSjVD5nz8EM4B62XXVS2d7nmOueD3HtC2N3rQwuIdLUrtUm5MHUNvBWEFxsQTRJRiqR90K",
+	"yFjiBSF0S8RuhdDXRPeax2XZyyY5yuzh+3nMqoMBemeW9MOI3ZhgAHDOuPrIOBpGBRUqLuDvAJhLH2zV",
+	"Kv28Y7GqUnBXJQkqzFacojFN2k/Q7CR/J/krJb+T3O4k/+GSv4lDAFrit5Ywq6GvmSqr9dq3y5S16426",
+	"EJYr2jHORJclbUPrlSHzcsnlL2k10PCadnf69c+V019j7jaDvY48Sw14awJtwHeQ6DgyTZf7G7ZNq8PR",
+	"1yPQ8LbvEuhuOmuSKRa6GumSg6+YzktvphrHVB04prZcqrE1Ye3AGfN8jgUE3GNnslsbvG3b0FS4te2y",
+	"13LiS3PikymgVPoKaD+K69Ztfo2hbdix0c+6+SWNwXW306EWC+dhQid81iom/y2KOocOdy0MaPkeucTi",
+	"ppUb7yz8i6KNY3uUbtYmLik4kfNLZccMdj8C5sBPCjlV30b620+OHH//eLVgt/7+8QpJptSxvp2nkFOg",
+	"0l7jMEDn1h3QjKNbWRE5sTWOdTs0BayMHhboe4MA0pHwRL+iP8L3SgNog6t1gG5VzYpOI7u/1+7LmNlL",
+	"niQ2G2tm58/f1roCPAunDnuN3rm98ZP352pv5pakIMr9Kx0ONvbHnpAS8ZA6M6FCyW7bVYdhy5kw71VO",
+	"RLlRJBZ2ihRALNAdZJkijerCAHN8IAZDei6R1i8cSxAmZcWFgBs3+81YWmRgHC6QiTk0jRNZ4EwnF6Bb",
+	"godUDTbBWSbc8Qac4lwyLhwJUnVGQT2w8Ew4OSMJWFtuyX2S42QK6PlAWcmCZ3aWxPHh4d3d3QDrxwPG",
+	"J4f2XXH45vz07O3l2cHzwdFgKmeZV047apmYKI5ugQszgc8GR4Mje1sWxTmJjqMXg6PBi0itT+VUM7hL",
+	"iTOlDQ/LgyJ5cJfanFvwyuSa16rQeqBgubmBizBtlQwEU3wyKhO3fmTp3DGpzS7AeZ5ZsTn8ty01a/zL",
+	"TlUl6+uF+7oisMdgnfOt6fD86Gg7GJg+DAp1Yp4tqaB5H0c/dMKoPAFSqy0fRV799/XquFs+82qx38dd",
+	"x1+rgR8Y+Tm9xRlJEa8g/3D0bEOjdcAZRzM7cK03vUHVaspvblgfGmB/OHqxoTFdFqYctTEDX+Z/6A/G",
+	"M6QM5cBnpDy8dUvgzgkmG6MqBWPMWIxcIsUI8xhVWTsj/IeyRWfexnyqDqWz3JX6sLSrCvBvjnA/+TBf",
+	"PoTv7Z0IZwdHz2oE9AYQuh9gk6xtoCMDHpXwX26MwT29ofMkKJOIVHcbOHvkXSWpTaU2XMA9SjTuRNgc",
+	"Ed4yiWqQ/Y1Ka0TA2QCJJ0I5Z2ZY0bVq7KySQrybTaq8ge5mSB2o35IRWihl8cgmaLFWQGCa3rTWBNiZ",
+	"n535eZD50eL4FzU+bw6ev3pSxiegfTM28XWv1oQ1zVs7IrNK+daWdt31r0uh344KDpXYeGQtHCzHEJg3",
+	"2+6Buvhba8dvqsa+nTJ4dNmdlWLjxNcJki/BNmvXXILZTYxN275SfOKu2dyGENeujP8mMhy6tD4wfabZ",
+	"ToJ3EtxBgqubaa0AWxlql197Nubwq/lwNc/h/pCreKMWaszxDCRwoTMwW+8uX7zQW4FAexmbxFat6PQE",
+	"c423KjBCFAQVLIzciZGowiBqyqHvyPS+Ifw6blFOpxywBLUD5uFMaDcVZV7W9L0oMofyVtSUgt9LST3b",
+	"bP+EThQKl3OarNRUhoiJJs5T1FavHq9/jx4444DTOYIvREjxJBWIE4YS6c1okcOv6p8uz2AEMAMZ2Px8",
+	"rX9fUxTNy3VR3KbR7i8PZthPUR5++CbyQJlEY33D6lMUBceMS0UhjmzZi8Y5R5BrcvHPIB+PhY1J6TRX",
+	"HCQncLvj3v8n3Ks5cAXr/in8unhVBk2NCgHEnGVailbImywCgv8hT9d3Js3LT9OZ/ObGs9DEeXrq58lJ",
+	"vmPBtVy4OxhNGbtpD+X8gmmagVddfiGsg+38ugIIC2xuQGhUPtrutsjptotvyewlCqsY3VIfTTWFdrze",
+	"xus2kS46/u3a5/y1eHO1aJT3DHQLc5bN+0Y6z737DLYhDuHLtR5ZIFruYwrOv6PjLuq5i3qujnrWrgOx",
+	"Ql2J1FK5/lpdR3XfKeC5eD2V2po2LkrYy6x62LCfWSLQz8s8r+6j2aau8e7s/UaKxr/adomWebL+5V9U",
+	"yTzqqr5kgqe9prdC79+81UXPuVyLnLPZ56zdfTmzVflFsHY4whNMqJC1Gme6zrir6GaK+Q9poJp/VRHD",
+	"VFRZUtMfjWDMOCAih5SI8qqANFaJhzhNlfemz4kB1/X+ajex6UQv++OH89fuzGxVhwjTNDZF1ybkFmi8",
+	"cKtd81TAAF3acQhE6JBWI0kwRRRzzu78yuvjguujVqNCIgpqJjlgNTZbpt2USRrS5rBNXn/AKzQzsSU1",
+	"Hbg94pHVdKjuf0A8LD9+trmdlid2ynrnEa7OZDHHdEo56pDV0laLrl13XuhDz8KcbSO3ttC0q1vl3jeH",
+	"tsoyf7qCoNZDvsY5HlJcnlzVRZ3QXkWn2JUvE3FV6LI8JbWv1Vf1ui40NaR7ZdEsG9F1pc9sfXi/lrzY",
+	"j5HWWJpqi7V4h3TPMjxKWEFlbA+O2i+2yrJX5FnsV+WOFituD2m95HZYCTaqMW1JG7aUM3xkjdhWZS0g",
+	"ChfNGmu7lfJOL67Wi83SfJ5SbApaQDmaQ5fdYmGmbd9A2JU7rr8NIQ/U+3hkAQ8VZQjMp2m2E+mdSHcQ",
+	"6bLChRNkK0Pt8vvVVi+4P9TFFLrJs25qvRj9fl/R1gXEfmL8ypY16BFpc5UQAsE1O5S+kbU/sXoJFGoL",
+	"8JdutdMwOw3TQcMsiP5DlM1XUxFOB9tbE6hSUyHUBJnUC2sqnp9BegVHn4TyiZf3ZmvIBTozdOuv6Lat",
+	"a5rVXFuUTTmnO52z0zmr0teWyn+b9pkCzuS0Va+cTiG5MRdu6IaN2opNXTJYTF4x8B8oU42KqWUVyOrC",
+	"VYPevEslpICoGexVsN3B0ZP84gFImsqRNRxZDvZuhmOUMEohUZDQGJMM0uXlLisgBd3UUCtIS5NEzLwn",
+	"ihE8JjI/Kyaqv1uv0fTb9f11+c7XxfPuLk3Wj8lVylsfg13U/c16N8uB2DoGi2D8gYVetCO8v77/vwEA",
+	"0BuFcj3IAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -44,40 +44,32 @@ func (h *Handler) QueryLogs(w http.ResponseWriter, r *http.Request) {
 	}
 	result, err := h.logsService.QueryLogs(ctx, &req)
 	if err != nil {
-		if errors.Is(err, observerAuthz.ErrAuthzForbidden) {
-			h.writeErrorResponse(w, http.StatusForbidden, gen.Forbidden, "", "Access denied")
-			return
-		}
-		if errors.Is(err, observerAuthz.ErrAuthzUnauthorized) {
-			h.writeErrorResponse(w, http.StatusUnauthorized, gen.Unauthorized, "", "Unauthorized")
-			return
-		}
-		h.logger.Error("Failed to query logs", "error", err)
-		errorCode := types.ErrorCodeV1LogsInternalGeneric
-		switch {
-		case errors.Is(err, service.ErrScopeAuthFailed):
-			h.writeErrorResponse(
-				w,
-				http.StatusInternalServerError,
-				gen.InternalServerError,
-				types.ErrorCodeV1ScopeAuthFailed,
-				"",
-			)
-			return
-		case errors.Is(err, service.ErrLogsResolveSearchScope):
-			errorCode = types.ErrorCodeV1LogsResolverFailed
-		case errors.Is(err, service.ErrLogsRetrieval):
-			errorCode = types.ErrorCodeV1LogsRetrievalFailed
-		}
-		h.writeErrorResponse(
-			w,
-			http.StatusInternalServerError,
-			gen.InternalServerError,
-			errorCode,
-			"Failed to retrieve logs",
-		)
+		status, title, errorCode, message := h.logsQueryError(err)
+		h.writeErrorResponse(w, status, title, errorCode, message)
 		return
 	}
 
 	h.writeJSON(w, http.StatusOK, result)
 }
+
+// logsQueryError maps a logs service error to the HTTP status, error title,
+// error code and message returned to the client.
+func (h *Handler) logsQueryError(err error) (int, gen.ErrorResponseTitle, string, string) {
+	if errors.Is(err, observerAuthz.ErrAuthzForbidden) {
+		return http.StatusForbidden, gen.Forbidden, "", "Access denied"
+	}
+	if errors.Is(err, observerAuthz.ErrAuthzUnauthorized) {
+		return http.StatusUnauthorized, gen.Unauthorized, "", "Unauthorized"
+	}
+	h.logger.Error("Failed to query logs", "error", err)
+	errorCode := types.ErrorCodeV1LogsInternalGeneric
+	switch {
+	case errors.Is(err, service.ErrScopeAuthFailed):
+		return http.StatusInternalServerError, gen.InternalServerError, types.ErrorCodeV1ScopeAuthFailed, ""
+	case errors.Is(err, service.ErrLogsResolveSearchScope):
+		errorCode = types.ErrorCodeV1LogsResolverFailed
+	case errors.Is(err, service.ErrLogsRetrieval):
+		errorCode = types.ErrorCodeV1LogsRetrievalFailed
+	}
+	return http.StatusInternalServerError, gen.InternalServerError, errorCode, "Failed to retrieve logs"
+}
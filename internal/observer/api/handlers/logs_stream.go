@@ -0,0 +1,255 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/openchoreo/openchoreo/internal/observer/api/gen"
+	"github.com/openchoreo/openchoreo/internal/observer/config"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// Timing of the logs stream. Variables so tests can shorten them.
+var (
+	// logsStreamPollInterval is how often the log backend is queried for new entries.
+	logsStreamPollInterval = 2 * time.Second
+	// logsStreamHeartbeatInterval is how often a heartbeat is sent while no new
+	// entries arrive, so clients and proxies can tell an idle stream from a dead one.
+	logsStreamHeartbeatInterval = 15 * time.Second
+	// logsStreamSubscribeTimeout bounds the wait for the subscription message.
+	logsStreamSubscribeTimeout = 10 * time.Second
+)
+
+const logsStreamWriteTimeout = 10 * time.Second
+
+var logsStreamUpgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// logsCursor is the decoded form of a stream cursor. Log timestamps have second
+// precision, so the cursor records how many entries of the last delivered second
+// were already sent, to skip them when the next query starts at that second.
+type logsCursor struct {
+	Time time.Time `json:"t"`
+	Seen int       `json:"n"`
+}
+
+func (c logsCursor) encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeLogsCursor(s string) (logsCursor, error) {
+	var c logsCursor
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err == nil {
+		err = json.Unmarshal(data, &c)
+	}
+	if err != nil || c.Time.IsZero() || c.Seen < 0 {
+		return logsCursor{}, fmt.Errorf("cursor is invalid")
+	}
+	return c, nil
+}
+
+// advance returns the cursor after delivering entries, which are in ascending
+// time order and all at or after c.Time.
+func (c logsCursor) advance(entries []types.LogEntry) logsCursor {
+	for _, e := range entries {
+		ts, err := time.Parse(time.RFC3339, e.Timestamp)
+		if err != nil {
+			continue
+		}
+		if ts.Equal(c.Time) {
+			c.Seen++
+		} else if ts.After(c.Time) {
+			c = logsCursor{Time: ts, Seen: 1}
+		}
+	}
+	return c
+}
+
+// StreamLogs handles GET /api/v1/logs/stream.
+//
+// The connection is upgraded to a WebSocket and the client sends one
+// LogsStreamRequest. The server then tails the matching logs, sending new
+// entries in ascending time order as "logs" messages, and a "heartbeat" message
+// whenever the stream has been idle for logsStreamHeartbeatInterval. Every
+// message carries a cursor; a client that reconnects with the last cursor it
+// received resumes right after the last entry it was sent. Failures are sent
+// as an "error" message before the connection is closed.
+func (h *Handler) StreamLogs(w http.ResponseWriter, r *http.Request) {
+	if h.logsService == nil {
+		h.logger.Error("Logs service is not initialized")
+		h.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			types.ErrorCodeV1LogsServiceNotReady,
+			"Logs service is not initialized",
+		)
+		return
+	}
+
+	conn, err := logsStreamUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade has already written an HTTP error response.
+		h.logger.Debug("Failed to upgrade logs stream", "error", err)
+		return
+	}
+	defer conn.Close()
+
+	var req types.LogsStreamRequest
+	_ = conn.SetReadDeadline(time.Now().Add(logsStreamSubscribeTimeout))
+	if err := conn.ReadJSON(&req); err != nil {
+		h.closeLogsStream(conn, websocket.ClosePolicyViolation, "", "Invalid subscription message")
+		return
+	}
+	_ = conn.SetReadDeadline(time.Time{})
+
+	query, cursor, err := newLogsStreamQuery(&req, time.Now())
+	if err != nil {
+		h.closeLogsStream(conn, websocket.ClosePolicyViolation, "", err.Error())
+		return
+	}
+
+	// Drain client frames so close and control frames are processed, and stop
+	// streaming when the client goes away.
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	heartbeat := logsStreamHeartbeatInterval
+	poll := time.NewTicker(logsStreamPollInterval)
+	defer poll.Stop()
+	lastSent := time.Now()
+	for {
+		entries, next, err := h.pollLogs(ctx, query, cursor)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			_, _, errorCode, message := h.logsQueryError(err)
+			if message == "" {
+				message = "Failed to retrieve logs"
+			}
+			h.closeLogsStream(conn, websocket.CloseInternalServerErr, errorCode, message)
+			return
+		}
+		cursor = next
+
+		var msg *types.LogsStreamMessage
+		switch {
+		case len(entries) > 0:
+			msg = &types.LogsStreamMessage{Type: types.LogsStreamMessageLogs, Logs: entries, Cursor: cursor.encode()}
+		case time.Since(lastSent) >= heartbeat:
+			msg = &types.LogsStreamMessage{Type: types.LogsStreamMessageHeartbeat, Cursor: cursor.encode()}
+		}
+		if msg != nil {
+			_ = conn.SetWriteDeadline(time.Now().Add(logsStreamWriteTimeout))
+			if err := conn.WriteJSON(msg); err != nil {
+				return
+			}
+			lastSent = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-poll.C:
+		}
+	}
+}
+
+// newLogsStreamQuery validates req and returns the query template and the
+// starting cursor of the stream.
+func newLogsStreamQuery(req *types.LogsStreamRequest, now time.Time) (*types.LogsQueryRequest, logsCursor, error) {
+	cursor := logsCursor{Time: now.UTC().Truncate(time.Second)}
+	switch {
+	case req.Cursor != "":
+		c, err := decodeLogsCursor(req.Cursor)
+		if err != nil {
+			return nil, logsCursor{}, err
+		}
+		cursor = c
+	case req.StartTime != "":
+		t, err := time.Parse(time.RFC3339, req.StartTime)
+		if err != nil {
+			return nil, logsCursor{}, fmt.Errorf("startTime must be in RFC3339 format (e.g., 2024-01-01T00:00:00Z): %w", err)
+		}
+		cursor = logsCursor{Time: t.UTC()}
+	}
+
+	query := &types.LogsQueryRequest{
+		SearchScope:  req.SearchScope,
+		SearchPhrase: req.SearchPhrase,
+		LogLevels:    req.LogLevels,
+		StartTime:    cursor.Time.Format(time.RFC3339),
+		EndTime:      now.UTC().Format(time.RFC3339),
+		Limit:        config.MaxLimit,
+		SortOrder:    sortOrderAsc,
+	}
+	if err := ValidateLogsQueryRequest(query); err != nil {
+		return nil, logsCursor{}, err
+	}
+	return query, cursor, nil
+}
+
+// pollLogs queries the entries logged since cursor and returns those not yet
+// delivered together with the advanced cursor.
+func (h *Handler) pollLogs(
+	ctx context.Context,
+	template *types.LogsQueryRequest,
+	cursor logsCursor,
+) ([]types.LogEntry, logsCursor, error) {
+	query := *template
+	query.StartTime = cursor.Time.Format(time.RFC3339)
+	query.EndTime = time.Now().UTC().Format(time.RFC3339)
+	if query.EndTime < query.StartTime {
+		query.EndTime = query.StartTime
+	}
+
+	result, err := h.logsService.QueryLogs(ctx, &query)
+	if err != nil {
+		return nil, cursor, err
+	}
+
+	entries := result.Logs
+	skip := 0
+	for skip < len(entries) && skip < cursor.Seen {
+		ts, err := time.Parse(time.RFC3339, entries[skip].Timestamp)
+		if err != nil || !ts.Equal(cursor.Time) {
+			break
+		}
+		skip++
+	}
+	entries = entries[skip:]
+	return entries, cursor.advance(entries), nil
+}
+
+// closeLogsStream sends an error message and closes the stream with code.
+func (h *Handler) closeLogsStream(conn *websocket.Conn, code int, errorCode, message string) {
+	deadline := time.Now().Add(logsStreamWriteTimeout)
+	_ = conn.SetWriteDeadline(deadline)
+	_ = conn.WriteJSON(types.LogsStreamMessage{
+		Type:      types.LogsStreamMessageError,
+		ErrorCode: errorCode,
+		Message:   message,
+	})
+	_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, ""), deadline)
+}
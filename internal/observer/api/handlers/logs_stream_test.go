@@ -0,0 +1,190 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	observerAuthz "github.com/openchoreo/openchoreo/internal/observer/authz"
+	servicemocks "github.com/openchoreo/openchoreo/internal/observer/service/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+// useFastLogsStream shortens the stream intervals for the duration of the test.
+func useFastLogsStream(t *testing.T) {
+	t.Helper()
+	poll, heartbeat := logsStreamPollInterval, logsStreamHeartbeatInterval
+	logsStreamPollInterval = 10 * time.Millisecond
+	logsStreamHeartbeatInterval = 50 * time.Millisecond
+	t.Cleanup(func() {
+		logsStreamPollInterval, logsStreamHeartbeatInterval = poll, heartbeat
+	})
+}
+
+func dialLogsStream(t *testing.T, h *Handler, req any) *websocket.Conn {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(h.StreamLogs))
+	t.Cleanup(srv.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	require.NoError(t, conn.WriteJSON(req))
+	return conn
+}
+
+func readLogsStreamMessage(t *testing.T, conn *websocket.Conn) types.LogsStreamMessage {
+	t.Helper()
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	var msg types.LogsStreamMessage
+	require.NoError(t, conn.ReadJSON(&msg))
+	return msg
+}
+
+func validLogsStreamRequest(startTime string) map[string]any {
+	return map[string]any{
+		"startTime": startTime,
+		"searchScope": map[string]any{
+			"namespace": "test-ns",
+			"project":   "test-project",
+			"component": "test-component",
+		},
+	}
+}
+
+func TestStreamLogs_StreamsNewEntriesAndHeartbeats(t *testing.T) {
+	useFastLogsStream(t)
+
+	start := time.Now().UTC().Add(-time.Minute).Truncate(time.Second)
+	ts := start.Format(time.RFC3339)
+	svc := servicemocks.NewMockLogsQuerier(t)
+	svc.On("QueryLogs", mock.Anything, mock.MatchedBy(func(r *types.LogsQueryRequest) bool {
+		return r.StartTime == ts && r.SortOrder == sortOrderAsc
+	})).Return(&types.LogsQueryResponse{
+		Logs: []types.LogEntry{{Timestamp: ts, Log: "line-1"}, {Timestamp: ts, Log: "line-2"}},
+	}, nil)
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, logsService: svc}
+	conn := dialLogsStream(t, h, validLogsStreamRequest(ts))
+
+	msg := readLogsStreamMessage(t, conn)
+	require.Equal(t, types.LogsStreamMessageLogs, msg.Type)
+	require.Len(t, msg.Logs, 2)
+	assert.Equal(t, "line-1", msg.Logs[0].Log)
+	assert.NotEmpty(t, msg.Cursor)
+
+	// Later polls return the same entries, which were already delivered.
+	msg = readLogsStreamMessage(t, conn)
+	assert.Equal(t, types.LogsStreamMessageHeartbeat, msg.Type)
+	assert.Empty(t, msg.Logs)
+
+	cursor, err := decodeLogsCursor(msg.Cursor)
+	require.NoError(t, err)
+	assert.True(t, cursor.Time.Equal(start))
+	assert.Equal(t, 2, cursor.Seen)
+}
+
+func TestStreamLogs_ResumesFromCursor(t *testing.T) {
+	useFastLogsStream(t)
+
+	start := time.Now().UTC().Add(-time.Minute).Truncate(time.Second)
+	ts := start.Format(time.RFC3339)
+	later := start.Add(time.Second).Format(time.RFC3339)
+	svc := servicemocks.NewMockLogsQuerier(t)
+	svc.On("QueryLogs", mock.Anything, mock.MatchedBy(func(r *types.LogsQueryRequest) bool {
+		return r.StartTime == ts
+	})).Return(&types.LogsQueryResponse{
+		Logs: []types.LogEntry{
+			{Timestamp: ts, Log: "seen"},
+			{Timestamp: ts, Log: "new-1"},
+			{Timestamp: later, Log: "new-2"},
+		},
+	}, nil).Once()
+	svc.On("QueryLogs", mock.Anything, mock.Anything).Return(&types.LogsQueryResponse{
+		Logs: []types.LogEntry{{Timestamp: later, Log: "new-2"}},
+	}, nil).Maybe()
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, logsService: svc}
+	req := validLogsStreamRequest("")
+	req["cursor"] = logsCursor{Time: start, Seen: 1}.encode()
+	conn := dialLogsStream(t, h, req)
+
+	msg := readLogsStreamMessage(t, conn)
+	require.Equal(t, types.LogsStreamMessageLogs, msg.Type)
+	require.Len(t, msg.Logs, 2)
+	assert.Equal(t, "new-1", msg.Logs[0].Log)
+	assert.Equal(t, "new-2", msg.Logs[1].Log)
+
+	cursor, err := decodeLogsCursor(msg.Cursor)
+	require.NoError(t, err)
+	assert.Equal(t, later, cursor.Time.Format(time.RFC3339))
+	assert.Equal(t, 1, cursor.Seen)
+}
+
+func TestStreamLogs_InvalidRequest(t *testing.T) {
+	useFastLogsStream(t)
+
+	h := &Handler{
+		baseHandler: baseHandler{logger: noopLogger()},
+		logsService: servicemocks.NewMockLogsQuerier(t),
+	}
+	conn := dialLogsStream(t, h, map[string]any{"startTime": "2024-01-01T00:00:00Z"})
+
+	msg := readLogsStreamMessage(t, conn)
+	assert.Equal(t, types.LogsStreamMessageError, msg.Type)
+	assert.Contains(t, msg.Message, "searchScope is required")
+}
+
+func TestStreamLogs_InvalidCursor(t *testing.T) {
+	useFastLogsStream(t)
+
+	h := &Handler{
+		baseHandler: baseHandler{logger: noopLogger()},
+		logsService: servicemocks.NewMockLogsQuerier(t),
+	}
+	req := validLogsStreamRequest("")
+	req["cursor"] = "not-a-cursor"
+	conn := dialLogsStream(t, h, req)
+
+	msg := readLogsStreamMessage(t, conn)
+	assert.Equal(t, types.LogsStreamMessageError, msg.Type)
+	assert.Equal(t, "cursor is invalid", msg.Message)
+}
+
+func TestStreamLogs_Forbidden(t *testing.T) {
+	useFastLogsStream(t)
+
+	svc := servicemocks.NewMockLogsQuerier(t)
+	svc.On("QueryLogs", mock.Anything, mock.Anything).Return(nil, observerAuthz.ErrAuthzForbidden)
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}, logsService: svc}
+	conn := dialLogsStream(t, h, validLogsStreamRequest(""))
+
+	msg := readLogsStreamMessage(t, conn)
+	assert.Equal(t, types.LogsStreamMessageError, msg.Type)
+	assert.Equal(t, "Access denied", msg.Message)
+	assert.Empty(t, msg.ErrorCode)
+}
+
+func TestStreamLogs_ServiceNotInitialized(t *testing.T) {
+	t.Parallel()
+
+	h := &Handler{baseHandler: baseHandler{logger: noopLogger()}}
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/logs/stream", nil)
+	rr := httptest.NewRecorder()
+
+	h.StreamLogs(rr, req)
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.Contains(t, rr.Body.String(), types.ErrorCodeV1LogsServiceNotReady)
+}
@@ -88,6 +88,79 @@ func (h *Handler) QueryMetrics(w http.ResponseWriter, r *http.Request) {
 	h.writeJSON(w, http.StatusOK, result)
 }
 
+// QueryPromQL handles POST /api/v1alpha1/metrics/promql
+func (h *Handler) QueryPromQL(w http.ResponseWriter, r *http.Request) {
+	var req types.PromQLQueryRequest
+	if err := httputil.BindJSON(r, &req); err != nil {
+		h.logger.Error("Failed to bind PromQL query request", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", "Invalid request format")
+		return
+	}
+
+	if err := ValidatePromQLQueryRequest(&req); err != nil {
+		h.logger.Debug("PromQL query validation failed", "error", err)
+		h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, "", err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	// Guard against misconfigured deployments.
+	if h.metricsService == nil {
+		h.logger.Error("Metrics service is not initialized")
+		h.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			types.ErrorCodeV1MetricsServiceNotReady,
+			"Metrics service is not initialized",
+		)
+		return
+	}
+
+	result, err := h.metricsService.QueryPromQL(ctx, &req)
+	if err != nil {
+		if errors.Is(err, observerAuthz.ErrAuthzForbidden) {
+			h.writeErrorResponse(w, http.StatusForbidden, gen.Forbidden, "", "Access denied")
+			return
+		}
+		if errors.Is(err, observerAuthz.ErrAuthzUnauthorized) {
+			h.writeErrorResponse(w, http.StatusUnauthorized, gen.Unauthorized, "", "Unauthorized")
+			return
+		}
+		errorCode := types.ErrorCodeV1MetricsInternalGeneric
+		switch {
+		case errors.Is(err, service.ErrScopeAuthFailed):
+			h.writeErrorResponse(
+				w,
+				http.StatusInternalServerError,
+				gen.InternalServerError,
+				types.ErrorCodeV1ScopeAuthFailed,
+				"",
+			)
+			return
+		case errors.Is(err, service.ErrMetricsInvalidRequest):
+			h.logger.Debug("Invalid PromQL query", "error", err)
+			h.writeErrorResponse(w, http.StatusBadRequest, gen.BadRequest, errorCode, err.Error())
+			return
+		case errors.Is(err, service.ErrMetricsResolveSearchScope):
+			errorCode = types.ErrorCodeV1MetricsResolverFailed
+		case errors.Is(err, service.ErrMetricsRetrieval):
+			errorCode = types.ErrorCodeV1MetricsRetrievalFailed
+		}
+		h.logger.Error("Failed to query PromQL metrics", "error", err)
+		h.writeErrorResponse(
+			w,
+			http.StatusInternalServerError,
+			gen.InternalServerError,
+			errorCode,
+			"Failed to retrieve metrics",
+		)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, result)
+}
+
 // QueryRuntimeTopology handles POST /api/v1alpha1/metrics/runtime-topology.
 func (h *Handler) QueryRuntimeTopology(w http.ResponseWriter, r *http.Request) {
 	var req types.RuntimeTopologyRequest
@@ -403,3 +403,103 @@ func TestQueryRuntimeTopology_GenericError(t *testing.T) {
 	require.Equal(t, http.StatusInternalServerError, rr.Code)
 	assert.Contains(t, rr.Body.String(), types.ErrorCodeV1RuntimeTopologyInternalGeneric)
 }
+
+const validPromQLRequestBody = `{"query":"sum(rate(http_requests_total[5m]))",` +
+	`"startTime":"2024-01-01T00:00:00Z","endTime":"2024-01-01T01:00:00Z",` +
+	`"searchScope":{"namespace":"ns","project":"proj"}}`
+
+func TestQueryPromQL_Success(t *testing.T) {
+	t.Parallel()
+
+	svc := servicemocks.NewMockMetricsQuerier(t)
+	svc.On("QueryPromQL", mock.Anything, mock.MatchedBy(func(req *types.PromQLQueryRequest) bool {
+		return req.Query == "sum(rate(http_requests_total[5m]))" && req.SearchScope.Project == "proj"
+	})).Return(map[string]any{"result": []any{}}, nil)
+
+	h := &Handler{
+		baseHandler:    baseHandler{logger: noopLogger()},
+		metricsService: svc,
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/metrics/promql", strings.NewReader(validPromQLRequestBody))
+	rr := httptest.NewRecorder()
+
+	h.QueryPromQL(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"result"`)
+}
+
+func TestQueryPromQL_ValidationError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"invalid body":    "{bad json",
+		"missing query":   `{"startTime":"2024-01-01T00:00:00Z","endTime":"2024-01-01T01:00:00Z","searchScope":{"namespace":"ns","project":"proj"}}`,
+		"missing project": `{"query":"up","startTime":"2024-01-01T00:00:00Z","endTime":"2024-01-01T01:00:00Z","searchScope":{"namespace":"ns"}}`,
+		"invalid step":    `{"query":"up","startTime":"2024-01-01T00:00:00Z","endTime":"2024-01-01T01:00:00Z","step":"often","searchScope":{"namespace":"ns","project":"proj"}}`,
+	}
+	for name, body := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			h := &Handler{
+				baseHandler:    baseHandler{logger: noopLogger()},
+				metricsService: servicemocks.NewMockMetricsQuerier(t),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/metrics/promql", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			h.QueryPromQL(rr, req)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
+
+func TestQueryPromQL_ServiceErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{"forbidden", observerAuthz.ErrAuthzForbidden, http.StatusForbidden, ""},
+		{"unauthorized", observerAuthz.ErrAuthzUnauthorized, http.StatusUnauthorized, ""},
+		{"invalid query", fmt.Errorf("%w: unclosed '('", service.ErrMetricsInvalidRequest), http.StatusBadRequest, ""},
+		{
+			"resolve search scope", fmt.Errorf("%w: not found", service.ErrMetricsResolveSearchScope),
+			http.StatusInternalServerError, types.ErrorCodeV1MetricsResolverFailed,
+		},
+		{
+			"retrieval", fmt.Errorf("%w: backend down", service.ErrMetricsRetrieval),
+			http.StatusInternalServerError, types.ErrorCodeV1MetricsRetrievalFailed,
+		},
+		{"generic", errors.New("unexpected"), http.StatusInternalServerError, types.ErrorCodeV1MetricsInternalGeneric},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := servicemocks.NewMockMetricsQuerier(t)
+			svc.On("QueryPromQL", mock.Anything, mock.Anything).Return(nil, tt.err)
+
+			h := &Handler{
+				baseHandler:    baseHandler{logger: noopLogger()},
+				metricsService: svc,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/metrics/promql", strings.NewReader(validPromQLRequestBody))
+			rr := httptest.NewRecorder()
+
+			h.QueryPromQL(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.wantCode)
+		})
+	}
+}
@@ -264,6 +264,43 @@ func ValidateMetricsQueryRequest(req *types.MetricsQueryRequest) error {
 	return nil
 }
 
+// ValidatePromQLQueryRequest validates the request body for
+// POST /api/v1alpha1/metrics/promql. The query is scoped to a project, so the
+// project must be specified explicitly.
+func ValidatePromQLQueryRequest(req *types.PromQLQueryRequest) error {
+	if req == nil {
+		return fmt.Errorf("request must not be nil")
+	}
+
+	if strings.TrimSpace(req.Query) == "" {
+		return fmt.Errorf("query is required")
+	}
+
+	if err := ValidateTimeRange(req.StartTime, req.EndTime); err != nil {
+		return err
+	}
+
+	scope := req.SearchScope
+	if strings.TrimSpace(scope.Namespace) == "" {
+		return fmt.Errorf("searchScope.namespace is required")
+	}
+	if strings.TrimSpace(scope.Project) == "" {
+		return fmt.Errorf("searchScope.project is required")
+	}
+
+	if req.Step != nil && *req.Step != "" {
+		step, err := time.ParseDuration(*req.Step)
+		if err != nil {
+			return fmt.Errorf("step must be a valid duration (e.g. 1m, 5m, 15m, 30m, 1h): %w", err)
+		}
+		if step <= 0 {
+			return fmt.Errorf("step must be greater than 0")
+		}
+	}
+
+	return nil
+}
+
 // ValidateRuntimeTopologyRequest validates the request body for
 // POST /api/v1alpha1/metrics/runtime-topology. Runtime topology requires the
 // project + environment to be specified explicitly; namespace alone is not
@@ -402,6 +402,44 @@ func registerTools(s *mcpsdk.Server, handler *MCPHandler) {
 		)
 		return handleToolResult(result, err)
 	})
+
+	// Tool 10: get_resource_usage_summary
+	mcpsdk.AddTool(s, &mcpsdk.Tool{
+		Name:        "get_resource_usage_summary",
+		Description: "Summarize CPU and memory resource usage and estimated cost of a project in OpenChoreo over a time range. Returns average requests, limits and actual usage per component and environment plus project totals. When hourly rates are provided, an estimated cost is included. Useful for answering questions like 'what does this project cost us?' and spotting over-provisioned components.",
+		InputSchema: createSchema(map[string]any{
+			"namespace":            stringProperty("Organization namespace (required)"),
+			"project":              stringProperty("Project name to summarize (required)"),
+			"components":           arrayProperty("Component names to break the summary down by. Default: a single project-wide entry"),
+			"environments":         arrayProperty("Environment names to break the summary down by. Default: all environments combined"),
+			"start_time":           stringProperty("Start of time range in RFC3339 format (e.g., 2025-11-04T08:29:02.452Z)"),
+			"end_time":             stringProperty("End of time range in RFC3339 format (e.g., 2025-11-04T09:29:02.452Z)"),
+			"cpu_core_hour_cost":   numberProperty("Price of one CPU core per hour, used to estimate cost"),
+			"memory_gib_hour_cost": numberProperty("Price of one GiB of memory per hour, used to estimate cost"),
+		}, []string{"namespace", "project", "start_time", "end_time"}),
+	}, func(ctx context.Context, req *mcpsdk.CallToolRequest, args struct {
+		Namespace         string   `json:"namespace"`
+		Project           string   `json:"project"`
+		Components        []string `json:"components"`
+		Environments      []string `json:"environments"`
+		StartTime         string   `json:"start_time"`
+		EndTime           string   `json:"end_time"`
+		CPUCoreHourCost   float64  `json:"cpu_core_hour_cost"`
+		MemoryGiBHourCost float64  `json:"memory_gib_hour_cost"`
+	}) (*mcpsdk.CallToolResult, any, error) {
+		if err := validateComponentScope(args.Namespace, args.Project, ""); err != nil {
+			return nil, nil, err
+		}
+		var rates *ResourceUsageRates
+		if args.CPUCoreHourCost > 0 || args.MemoryGiBHourCost > 0 {
+			rates = &ResourceUsageRates{CPUCoreHour: args.CPUCoreHourCost, MemoryGiBHour: args.MemoryGiBHourCost}
+		}
+		result, err := handler.GetResourceUsageSummary(ctx,
+			args.Namespace, args.Project, args.Components, args.Environments,
+			args.StartTime, args.EndTime, rates,
+		)
+		return handleToolResult(result, err)
+	})
 }
 
 // Helper functions for schema creation
@@ -441,6 +479,13 @@ func limitProperty() map[string]any {
 	}
 }
 
+func numberProperty(description string) map[string]any {
+	return map[string]any{
+		"type":        "number",
+		"description": description,
+	}
+}
+
 func arrayProperty(description string) map[string]any {
 	return map[string]any{
 		"type":        "array",
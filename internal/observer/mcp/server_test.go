@@ -127,6 +127,10 @@ func (m *MockMetricsQuerier) QueryMetrics(_ context.Context, req *types.MetricsQ
 	return m.response, nil
 }
 
+func (m *MockMetricsQuerier) QueryPromQL(_ context.Context, _ *types.PromQLQueryRequest) (any, error) {
+	return m.response, m.err
+}
+
 func (m *MockMetricsQuerier) QueryRuntimeTopology(_ context.Context, _ *types.RuntimeTopologyRequest) (*types.RuntimeTopologyResponse, error) {
 	return &types.RuntimeTopologyResponse{}, nil
 }
@@ -710,6 +714,35 @@ var allToolSpecs = []toolTestSpec{
 			assert.Equal(t, sortOrderDesc, string(*req.SortOrder))
 		},
 	},
+	{
+		name:                "get_resource_usage_summary",
+		descriptionKeywords: []string{"usage", "cost"},
+		descriptionMinLen:   20,
+		requiredParams:      []string{"namespace", "project", "start_time", "end_time"},
+		optionalParams:      []string{"components", "environments", "cpu_core_hour_cost", "memory_gib_hour_cost"},
+		testArgs: map[string]any{
+			"namespace":            testNamespace,
+			"project":              testProject,
+			"components":           []string{testComponent},
+			"environments":         []string{testEnvironment},
+			"start_time":           testStartTime,
+			"end_time":             testEndTime,
+			"cpu_core_hour_cost":   0.04,
+			"memory_gib_hour_cost": 0.005,
+		},
+		validateCall: func(t *testing.T, svcs *testServices) {
+			t.Helper()
+			req := svcs.metrics.lastRequest()
+			require.NotNil(t, req, "Expected QueryMetrics to be called")
+			assert.Equal(t, types.MetricTypeResource, req.Metric)
+			assert.Equal(t, testNamespace, req.SearchScope.Namespace)
+			assert.Equal(t, testProject, req.SearchScope.Project)
+			assert.Equal(t, testComponent, req.SearchScope.Component)
+			assert.Equal(t, testEnvironment, req.SearchScope.Environment)
+			assert.Equal(t, testStartTime, req.StartTime)
+			assert.Equal(t, testEndTime, req.EndTime)
+		},
+	},
 }
 
 // ---- Tests ----
@@ -1524,6 +1557,16 @@ func TestSchemaPropertyTypes(t *testing.T) {
 			"trace_id": "string",
 			"span_id":  "string",
 		},
+		"get_resource_usage_summary": {
+			"namespace":            "string",
+			"project":              "string",
+			"components":           "array",
+			"environments":         "array",
+			"start_time":           "string",
+			"end_time":             "string",
+			"cpu_core_hour_cost":   "number",
+			"memory_gib_hour_cost": "number",
+		},
 	}
 
 	for _, tool := range toolsResult.Tools {
@@ -0,0 +1,179 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+const bytesPerGiB = 1 << 30
+
+// ResourceUsageRates holds the hourly prices used to estimate the cost of resource usage.
+type ResourceUsageRates struct {
+	CPUCoreHour   float64
+	MemoryGiBHour float64
+}
+
+// GetResourceUsageSummary aggregates CPU and memory requests, limits and actual usage of a
+// project over a time range, broken down per component and environment. When components or
+// environments are empty, a single project-wide or all-environment entry is reported. If rates
+// are given, an estimated cost is computed from the larger of the average request and the
+// average usage, since that is what the cluster reserves for the workload.
+func (h *MCPHandler) GetResourceUsageSummary(ctx context.Context, namespace, project string,
+	components, environments []string, startTime, endTime string, rates *ResourceUsageRates) (any, error) {
+	start, err := parseRFC3339Time(startTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start_time: %w", err)
+	}
+	end, err := parseRFC3339Time(endTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end_time: %w", err)
+	}
+	if !end.After(start) {
+		return nil, fmt.Errorf("end_time must be after start_time")
+	}
+	hours := end.Sub(start).Hours()
+
+	if len(components) == 0 {
+		components = []string{""}
+	}
+	if len(environments) == 0 {
+		environments = []string{""}
+	}
+
+	var totalCPUCost, totalMemoryCost float64
+	var totalCPURequested, totalCPUUsed, totalMemoryRequested, totalMemoryUsed float64
+	items := make([]map[string]any, 0, len(components)*len(environments))
+	for _, component := range components {
+		for _, environment := range environments {
+			req := &types.MetricsQueryRequest{
+				Metric:    types.MetricTypeResource,
+				StartTime: startTime,
+				EndTime:   endTime,
+				SearchScope: types.ComponentSearchScope{
+					Namespace:   namespace,
+					Project:     project,
+					Component:   component,
+					Environment: environment,
+				},
+			}
+			raw, err := h.metricsService.QueryMetrics(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			metrics, err := decodeResourceMetrics(raw)
+			if err != nil {
+				return nil, err
+			}
+
+			cpuRequested := averageOf(metrics.CPURequests)
+			cpuUsed := averageOf(metrics.CPUUsage)
+			memoryRequested := averageOf(metrics.MemoryRequests) / bytesPerGiB
+			memoryUsed := averageOf(metrics.MemoryUsage) / bytesPerGiB
+			totalCPURequested += cpuRequested
+			totalCPUUsed += cpuUsed
+			totalMemoryRequested += memoryRequested
+			totalMemoryUsed += memoryUsed
+
+			item := map[string]any{
+				"cpu": map[string]any{
+					"requestedCores": roundUsage(cpuRequested),
+					"usedCores":      roundUsage(cpuUsed),
+					"limitCores":     roundUsage(averageOf(metrics.CPULimits)),
+				},
+				"memory": map[string]any{
+					"requestedGiB": roundUsage(memoryRequested),
+					"usedGiB":      roundUsage(memoryUsed),
+					"limitGiB":     roundUsage(averageOf(metrics.MemoryLimits) / bytesPerGiB),
+				},
+			}
+			if component != "" {
+				item["component"] = component
+			}
+			if environment != "" {
+				item["environment"] = environment
+			}
+			if rates != nil {
+				cpuCost := math.Max(cpuRequested, cpuUsed) * hours * rates.CPUCoreHour
+				memoryCost := math.Max(memoryRequested, memoryUsed) * hours * rates.MemoryGiBHour
+				totalCPUCost += cpuCost
+				totalMemoryCost += memoryCost
+				item["estimatedCost"] = costSummary(cpuCost, memoryCost)
+			}
+			items = append(items, item)
+		}
+	}
+
+	totals := map[string]any{
+		"cpuRequestedCores":  roundUsage(totalCPURequested),
+		"cpuUsedCores":       roundUsage(totalCPUUsed),
+		"memoryRequestedGiB": roundUsage(totalMemoryRequested),
+		"memoryUsedGiB":      roundUsage(totalMemoryUsed),
+	}
+	if rates != nil {
+		totals["estimatedCost"] = costSummary(totalCPUCost, totalMemoryCost)
+	}
+
+	return map[string]any{
+		"namespace": namespace,
+		"project":   project,
+		"startTime": startTime,
+		"endTime":   endTime,
+		"hours":     roundUsage(hours),
+		"items":     items,
+		"totals":    totals,
+	}, nil
+}
+
+// decodeResourceMetrics converts the metrics service result, which may be raw JSON or an
+// already typed response depending on the backend, into a ResourceMetricsQueryResponse.
+func decodeResourceMetrics(raw any) (*types.ResourceMetricsQueryResponse, error) {
+	metrics := &types.ResourceMetricsQueryResponse{}
+	if raw == nil {
+		return metrics, nil
+	}
+	data, ok := raw.(json.RawMessage)
+	if !ok {
+		var err error
+		if data, err = json.Marshal(raw); err != nil {
+			return nil, fmt.Errorf("failed to encode resource metrics: %w", err)
+		}
+	}
+	if err := json.Unmarshal(data, metrics); err != nil {
+		return nil, fmt.Errorf("failed to decode resource metrics: %w", err)
+	}
+	return metrics, nil
+}
+
+func averageOf(series []types.MetricsTimeSeriesItem) float64 {
+	if len(series) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, item := range series {
+		sum += item.Value
+	}
+	return sum / float64(len(series))
+}
+
+func costSummary(cpuCost, memoryCost float64) map[string]any {
+	return map[string]any{
+		"cpu":    roundCost(cpuCost),
+		"memory": roundCost(memoryCost),
+		"total":  roundCost(cpuCost + memoryCost),
+	}
+}
+
+func roundUsage(v float64) float64 {
+	return math.Round(v*1e4) / 1e4
+}
+
+func roundCost(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/observer/service/mocks"
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+func TestGetResourceUsageSummary(t *testing.T) {
+	ctx := context.Background()
+	const start, end = "2025-01-01T00:00:00Z", "2025-01-01T10:00:00Z"
+
+	resourceMetrics := json.RawMessage(`{
+		"cpuRequests": [{"timestamp": "2025-01-01T00:00:00Z", "value": 0.5}],
+		"cpuUsage": [{"timestamp": "2025-01-01T00:00:00Z", "value": 0.2},
+			{"timestamp": "2025-01-01T05:00:00Z", "value": 0.4}],
+		"memoryRequests": [{"timestamp": "2025-01-01T00:00:00Z", "value": 536870912}],
+		"memoryUsage": [{"timestamp": "2025-01-01T00:00:00Z", "value": 1073741824}]
+	}`)
+
+	t.Run("breaks down per component and environment with estimated cost", func(t *testing.T) {
+		metricsSvc := mocks.NewMockMetricsQuerier(t)
+		metricsSvc.EXPECT().
+			QueryMetrics(mock.Anything, mock.MatchedBy(func(req *types.MetricsQueryRequest) bool {
+				return req.Metric == types.MetricTypeResource &&
+					req.SearchScope.Namespace == testNamespace &&
+					req.SearchScope.Project == testProject &&
+					req.SearchScope.Component == testComponent
+			})).
+			Return(resourceMetrics, nil).Times(2)
+
+		h := newTestMCPHandler(t, withMetricsService(metricsSvc))
+		result, err := h.GetResourceUsageSummary(ctx, testNamespace, testProject,
+			[]string{testComponent}, []string{"dev", "prod"}, start, end,
+			&ResourceUsageRates{CPUCoreHour: 0.1, MemoryGiBHour: 0.01})
+		require.NoError(t, err)
+
+		m := result.(map[string]any)
+		assert.Equal(t, 10.0, m["hours"])
+		items := m["items"].([]map[string]any)
+		require.Len(t, items, 2)
+		assert.Equal(t, "dev", items[0]["environment"])
+		assert.Equal(t, map[string]any{"requestedCores": 0.5, "usedCores": 0.3, "limitCores": 0.0}, items[0]["cpu"])
+		assert.Equal(t, map[string]any{"requestedGiB": 0.5, "usedGiB": 1.0, "limitGiB": 0.0}, items[0]["memory"])
+		// CPU is charged on the request (0.5 cores), memory on actual usage (1 GiB) as it exceeds the request.
+		assert.Equal(t, map[string]any{"cpu": 0.5, "memory": 0.1, "total": 0.6}, items[0]["estimatedCost"])
+
+		totals := m["totals"].(map[string]any)
+		assert.Equal(t, 1.0, totals["cpuRequestedCores"])
+		assert.Equal(t, map[string]any{"cpu": 1.0, "memory": 0.2, "total": 1.2}, totals["estimatedCost"])
+	})
+
+	t.Run("project-wide entry without rates", func(t *testing.T) {
+		metricsSvc := mocks.NewMockMetricsQuerier(t)
+		metricsSvc.EXPECT().
+			QueryMetrics(mock.Anything, mock.MatchedBy(func(req *types.MetricsQueryRequest) bool {
+				return req.SearchScope.Component == "" && req.SearchScope.Environment == ""
+			})).
+			Return(&types.ResourceMetricsQueryResponse{
+				CPUUsage: []types.MetricsTimeSeriesItem{{Value: 1.5}},
+			}, nil)
+
+		h := newTestMCPHandler(t, withMetricsService(metricsSvc))
+		result, err := h.GetResourceUsageSummary(ctx, testNamespace, testProject, nil, nil, start, end, nil)
+		require.NoError(t, err)
+
+		m := result.(map[string]any)
+		items := m["items"].([]map[string]any)
+		require.Len(t, items, 1)
+		assert.NotContains(t, items[0], "component")
+		assert.NotContains(t, items[0], "estimatedCost")
+		assert.Equal(t, 1.5, m["totals"].(map[string]any)["cpuUsedCores"])
+	})
+
+	t.Run("rejects inverted time range", func(t *testing.T) {
+		h := newTestMCPHandler(t)
+		_, err := h.GetResourceUsageSummary(ctx, testNamespace, testProject, nil, nil, end, start, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "end_time must be after start_time")
+	})
+}
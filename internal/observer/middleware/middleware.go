@@ -119,3 +119,9 @@ func (rw *responseWriter) WriteHeader(code int) {
 	rw.statusCode = code
 	rw.ResponseWriter.WriteHeader(code)
 }
+
+// Unwrap returns the underlying ResponseWriter so http.ResponseController can
+// reach optional interfaces such as http.Hijacker.
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
@@ -222,6 +222,51 @@ func TestMetricsAuthz_QueryMetrics_NilRequest(t *testing.T) {
 	assert.Contains(t, err.Error(), "metrics query request is required")
 }
 
+func TestMetricsAuthz_QueryPromQL_NilPDP(t *testing.T) {
+	inner := mocks.NewMockMetricsQuerier(t)
+	inner.EXPECT().QueryPromQL(mock.Anything, mock.Anything).Return("result", nil)
+
+	svc := NewMetricsServiceWithAuthz(inner, nil, testLogger())
+	req := &types.PromQLQueryRequest{
+		Query: "up",
+		SearchScope: types.ComponentSearchScope{
+			Namespace: "ns",
+			Project:   "proj",
+		},
+	}
+
+	resp, err := svc.QueryPromQL(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, "result", resp)
+}
+
+func TestMetricsAuthz_QueryPromQL_Denied(t *testing.T) {
+	inner := mocks.NewMockMetricsQuerier(t)
+
+	svc := NewMetricsServiceWithAuthz(inner, mockPDPDeny(t), testLogger())
+	req := &types.PromQLQueryRequest{
+		Query: "up",
+		SearchScope: types.ComponentSearchScope{
+			Namespace: "ns",
+			Project:   "proj",
+			Component: "comp",
+		},
+	}
+
+	_, err := svc.QueryPromQL(authedCtx(), req)
+	assert.ErrorIs(t, err, observerAuthz.ErrAuthzForbidden)
+}
+
+func TestMetricsAuthz_QueryPromQL_NilRequest(t *testing.T) {
+	inner := mocks.NewMockMetricsQuerier(t)
+
+	svc := NewMetricsServiceWithAuthz(inner, nil, testLogger())
+
+	_, err := svc.QueryPromQL(context.Background(), nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "promql query request is required")
+}
+
 // --- TracesQuerier Authz Tests ---
 
 func TestTracesAuthz_QueryTraces_NilPDP(t *testing.T) {
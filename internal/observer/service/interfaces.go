@@ -28,6 +28,7 @@ type EventsQuerier interface {
 // MetricsQuerier is the interface for querying metrics and runtime topology.
 type MetricsQuerier interface {
 	QueryMetrics(ctx context.Context, req *types.MetricsQueryRequest) (any, error)
+	QueryPromQL(ctx context.Context, req *types.PromQLQueryRequest) (any, error)
 	QueryRuntimeTopology(ctx context.Context, req *types.RuntimeTopologyRequest) (*types.RuntimeTopologyResponse, error)
 }
 
@@ -103,6 +103,15 @@ type metricsAdapterRequest struct {
 	SearchScope metricsAdapterSearchScope `json:"searchScope"`
 }
 
+// promQLAdapterRequest is the request payload sent to the adapter's PromQL endpoint.
+// The query already carries the label matchers of the search scope.
+type promQLAdapterRequest struct {
+	Query     string  `json:"query"`
+	StartTime string  `json:"startTime"`
+	EndTime   string  `json:"endTime"`
+	Step      *string `json:"step,omitempty"`
+}
+
 // metricsAdapterSearchScope matches the adapter's ComponentSearchScope schema.
 type metricsAdapterSearchScope struct {
 	Namespace      string  `json:"namespace"`
@@ -249,6 +258,102 @@ func (a *MetricsAdapter) QueryMetrics(ctx context.Context, req *types.MetricsQue
 	return result, nil
 }
 
+// QueryPromQL restricts every vector selector of the PromQL query to the
+// search scope and forwards the rewritten query to the adapter's
+// /api/v1alpha1/metrics/promql endpoint, returning the raw JSON response.
+// The scope is matched by UID, so a query can never reach series of another
+// project, component or environment that happens to share a name.
+func (a *MetricsAdapter) QueryPromQL(ctx context.Context, req *types.PromQLQueryRequest) (any, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w: request must not be nil", ErrMetricsInvalidRequest)
+	}
+
+	scope := &req.SearchScope
+	if scope.Namespace == "" {
+		return nil, fmt.Errorf("%w: searchScope.namespace is required", ErrMetricsInvalidRequest)
+	}
+	if scope.Project == "" {
+		return nil, fmt.Errorf("%w: searchScope.project is required", ErrMetricsInvalidRequest)
+	}
+
+	matchers := []promQLLabelMatcher{{name: PromQLLabelNamespace, value: scope.Namespace}}
+	projectUID, err := a.resolver.GetProjectUID(ctx, scope.Namespace, scope.Project)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to get project UID: %w", ErrMetricsResolveSearchScope, err)
+	}
+	matchers = append(matchers, promQLLabelMatcher{name: PromQLLabelProjectUID, value: projectUID})
+	if scope.Component != "" {
+		componentUID, err := a.resolver.GetComponentUID(ctx, scope.Namespace, scope.Project, scope.Component)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to get component UID: %w", ErrMetricsResolveSearchScope, err)
+		}
+		matchers = append(matchers, promQLLabelMatcher{name: PromQLLabelComponentUID, value: componentUID})
+	}
+	if scope.Environment != "" {
+		environmentUID, err := a.resolver.GetEnvironmentUID(ctx, scope.Namespace, scope.Environment)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to get environment UID: %w", ErrMetricsResolveSearchScope, err)
+		}
+		matchers = append(matchers, promQLLabelMatcher{name: PromQLLabelEnvironmentUID, value: environmentUID})
+	}
+
+	query, err := injectPromQLMatchers(req.Query, matchers)
+	if err != nil {
+		return nil, err
+	}
+
+	a.logger.Debug("Forwarding PromQL query to adapter",
+		"namespace", scope.Namespace,
+		"project", scope.Project,
+		"component", scope.Component,
+		"environment", scope.Environment,
+		"query", query,
+	)
+
+	body, err := json.Marshal(promQLAdapterRequest{
+		Query:     query,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+		Step:      req.Step,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal PromQL query request: %w", err)
+	}
+
+	url := a.baseURL + "/api/v1alpha1/metrics/promql"
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metrics adapter request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMetricsRetrieval, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to read metrics adapter response: %w", ErrMetricsRetrieval, err)
+	}
+
+	// The adapter rejects queries Prometheus cannot parse with HTTP 400.
+	if resp.StatusCode == http.StatusBadRequest {
+		return nil, fmt.Errorf("%w: %s", ErrMetricsInvalidRequest, string(respBody))
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("%w: metrics adapter returned HTTP %d: %s", ErrMetricsRetrieval, resp.StatusCode, string(respBody))
+	}
+
+	var result json.RawMessage
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("%w: failed to decode metrics adapter response: %w", ErrMetricsRetrieval, err)
+	}
+
+	return result, nil
+}
+
 // QueryRuntimeTopology resolves search scope names to UIDs and forwards the
 // runtime topology query to the metrics-adapter's
 // /api/v1alpha1/metrics/runtime-topology endpoint.
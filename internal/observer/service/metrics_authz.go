@@ -50,6 +50,25 @@ func (s *metricsServiceWithAuthz) QueryMetrics(ctx context.Context, req *types.M
 	return s.internal.QueryMetrics(ctx, req)
 }
 
+func (s *metricsServiceWithAuthz) QueryPromQL(ctx context.Context, req *types.PromQLQueryRequest) (any, error) {
+	if req == nil {
+		return nil, fmt.Errorf("promql query request is required")
+	}
+	scope := req.SearchScope
+	resourceType, resourceName, hierarchy := observerAuthz.ComponentScopeAuthz(scope.Namespace, scope.Project, scope.Component)
+	if err := observerAuthz.CheckAuthorization(
+		ctx, s.logger, s.pdp,
+		observerAuthz.ActionViewMetrics,
+		resourceType, resourceName, hierarchy,
+		authzcore.Context{Resource: authzcore.ResourceAttribute{
+			Environment: observerAuthz.FormatDualScopedResourceName(scope.Namespace, scope.Environment, false),
+		}},
+	); err != nil {
+		return nil, err
+	}
+	return s.internal.QueryPromQL(ctx, req)
+}
+
 func (s *metricsServiceWithAuthz) QueryRuntimeTopology(
 	ctx context.Context,
 	req *types.RuntimeTopologyRequest,
@@ -82,6 +82,65 @@ func (_c *MockMetricsQuerier_QueryMetrics_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// QueryPromQL provides a mock function with given fields: ctx, req
+func (_m *MockMetricsQuerier) QueryPromQL(ctx context.Context, req *types.PromQLQueryRequest) (interface{}, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryPromQL")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.PromQLQueryRequest) (interface{}, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.PromQLQueryRequest) interface{}); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.PromQLQueryRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMetricsQuerier_QueryPromQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryPromQL'
+type MockMetricsQuerier_QueryPromQL_Call struct {
+	*mock.Call
+}
+
+// QueryPromQL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *types.PromQLQueryRequest
+func (_e *MockMetricsQuerier_Expecter) QueryPromQL(ctx interface{}, req interface{}) *MockMetricsQuerier_QueryPromQL_Call {
+	return &MockMetricsQuerier_QueryPromQL_Call{Call: _e.mock.On("QueryPromQL", ctx, req)}
+}
+
+func (_c *MockMetricsQuerier_QueryPromQL_Call) Run(run func(ctx context.Context, req *types.PromQLQueryRequest)) *MockMetricsQuerier_QueryPromQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*types.PromQLQueryRequest))
+	})
+	return _c
+}
+
+func (_c *MockMetricsQuerier_QueryPromQL_Call) Return(_a0 interface{}, _a1 error) *MockMetricsQuerier_QueryPromQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMetricsQuerier_QueryPromQL_Call) RunAndReturn(run func(context.Context, *types.PromQLQueryRequest) (interface{}, error)) *MockMetricsQuerier_QueryPromQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // QueryRuntimeTopology provides a mock function with given fields: ctx, req
 func (_m *MockMetricsQuerier) QueryRuntimeTopology(ctx context.Context, req *types.RuntimeTopologyRequest) (*types.RuntimeTopologyResponse, error) {
 	ret := _m.Called(ctx, req)
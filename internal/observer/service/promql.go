@@ -0,0 +1,64 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// Prometheus label names that carry the OpenChoreo resource identity of a series.
+// They are the pod labels of the labels package (labels.NamespaceName,
+// labels.ProjectID, labels.ComponentID and labels.EnvironmentID) in Prometheus'
+// sanitized form, and the metrics adapter is expected to expose series with
+// these labels for PromQL queries.
+const (
+	PromQLLabelNamespace      = "openchoreo_dev_namespace"
+	PromQLLabelProjectUID     = "openchoreo_dev_project_uid"
+	PromQLLabelComponentUID   = "openchoreo_dev_component_uid"
+	PromQLLabelEnvironmentUID = "openchoreo_dev_environment_uid"
+)
+
+// promQLLabelMatcher is an equality matcher injected into every vector selector.
+type promQLLabelMatcher struct {
+	name  string
+	value string
+}
+
+// injectPromQLMatchers rewrites query so that every vector selector in it,
+// including the ones of range selectors and subqueries, also matches the given
+// labels. Because label matchers of a selector are ANDed, a selector in the
+// query can narrow the result further but never widen it past the injected
+// labels, including selectors that already match one of them. Queries that do
+// not parse are rejected.
+func injectPromQLMatchers(query string, matchers []promQLLabelMatcher) (string, error) {
+	if strings.TrimSpace(query) == "" {
+		return "", fmt.Errorf("%w: query is required", ErrMetricsInvalidRequest)
+	}
+
+	expr, err := parser.ParseExpr(query)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrMetricsInvalidRequest, err)
+	}
+
+	injected := make([]*labels.Matcher, 0, len(matchers))
+	for _, m := range matchers {
+		lm, err := labels.NewMatcher(labels.MatchEqual, m.name, m.value)
+		if err != nil {
+			return "", fmt.Errorf("failed to build matcher %s: %w", m.name, err)
+		}
+		injected = append(injected, lm)
+	}
+
+	parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+		if vs, ok := node.(*parser.VectorSelector); ok {
+			vs.LabelMatchers = append(vs.LabelMatchers, injected...)
+		}
+		return nil
+	})
+	return expr.String(), nil
+}
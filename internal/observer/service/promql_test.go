@@ -0,0 +1,252 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/observer/types"
+)
+
+func TestInjectPromQLMatchers(t *testing.T) {
+	t.Parallel()
+
+	matchers := []promQLLabelMatcher{
+		{name: PromQLLabelNamespace, value: "ns"},
+		{name: PromQLLabelProjectUID, value: "p-1"},
+	}
+	const scope = `openchoreo_dev_namespace="ns",openchoreo_dev_project_uid="p-1"`
+
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "bare metric",
+			query: "up",
+			want:  "up{" + scope + "}",
+		},
+		{
+			name:  "existing matchers",
+			query: `http_requests_total{code=~"5..", job="api"}`,
+			want:  `http_requests_total{code=~"5..",job="api",` + scope + `}`,
+		},
+		{
+			name:  "empty matchers",
+			query: "up{ }",
+			want:  "up{" + scope + "}",
+		},
+		{
+			name:  "selector without metric name",
+			query: `{__name__=~"http_.*"}`,
+			want:  `{__name__=~"http_.*",` + scope + `}`,
+		},
+		{
+			name:  "matcher overriding the scope is ANDed",
+			query: `up{openchoreo_dev_project_uid="p-2"}`,
+			want:  `up{` + scope + `,openchoreo_dev_project_uid="p-2"}`,
+		},
+		{
+			name:  "functions and ranges",
+			query: "rate(container_cpu_usage_seconds_total[5m])",
+			want:  "rate(container_cpu_usage_seconds_total{" + scope + "}[5m])",
+		},
+		{
+			name:  "aggregation with trailing grouping",
+			query: "sum(rate(http_requests_total[1m])) by (pod, code)",
+			want:  "sum by (pod, code) (rate(http_requests_total{" + scope + "}[1m]))",
+		},
+		{
+			name:  "aggregation with leading grouping",
+			query: "sum without (instance) (up)",
+			want:  "sum without (instance) (up{" + scope + "})",
+		},
+		{
+			name:  "binary operators and vector matching",
+			query: "a / on(pod) group_left(node) b > bool 0.5 and c unless d",
+			want: "a{" + scope + "} / on (pod) group_left (node) b{" + scope + "} > bool 0.5 and c{" + scope +
+				"} unless d{" + scope + "}",
+		},
+		{
+			name:  "histogram quantile",
+			query: "histogram_quantile(0.99, sum by (le) (rate(latency_bucket[5m])))",
+			want:  "histogram_quantile(0.99, sum by (le) (rate(latency_bucket{" + scope + "}[5m])))",
+		},
+		{
+			name:  "offset, subquery and numbers",
+			query: "max_over_time(up[1h:5m] offset 1d) * 1e-3 + Inf",
+			want:  "max_over_time(up{" + scope + "}[1h:5m] offset 1d) * 0.001 + +Inf",
+		},
+		{
+			name:  "recording rule names",
+			query: "job:http_requests:rate5m",
+			want:  "job:http_requests:rate5m{" + scope + "}",
+		},
+		{
+			name:  "string arguments",
+			query: `label_replace(up, "dst", "$1", "src", "(.*)}")`,
+			want:  `label_replace(up{` + scope + `}, "dst", "$1", "src", "(.*)}")`,
+		},
+		{
+			name:  "keyword as metric name in an aggregation",
+			query: "sum(by)",
+			want:  "sum(by{" + scope + "})",
+		},
+		{
+			name:  "keyword as metric name in a range selector",
+			query: "rate(and[5m])",
+			want:  "rate(and{" + scope + "}[5m])",
+		},
+		{
+			name:  "keyword as bare metric name",
+			query: "offset",
+			want:  "offset{" + scope + "}",
+		},
+		{
+			name:  "set operator keyword as metric name",
+			query: "sum(unless)",
+			want:  "sum(unless{" + scope + "})",
+		},
+		{
+			name:  "quoted values are escaped",
+			query: `up{job="a\"}"}`,
+			want:  `up{job="a\"}",` + scope + `}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := injectPromQLMatchers(tt.query, matchers)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestInjectPromQLMatchers_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, query := range []string{"", "  ", `up{job="a}`, "up{job=\"a\"", "rate(up[5m)", "up}", `"unterminated`} {
+		_, err := injectPromQLMatchers(query, []promQLLabelMatcher{{name: PromQLLabelNamespace, value: "ns"}})
+		require.ErrorIs(t, err, ErrMetricsInvalidRequest, "query %q", query)
+	}
+}
+
+func TestMetricsAdapter_QueryPromQL(t *testing.T) {
+	t.Parallel()
+
+	step := "1m"
+	var captured promQLAdapterRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v1alpha1/metrics/promql", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&captured))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"result":[]}`))
+	}))
+	defer server.Close()
+
+	resolver, cleanup := newMockUIDResolver("project-uid-123", "component-uid-456", "env-uid-789", nil)
+	defer cleanup()
+	adapter := NewMetricsAdapter(server.URL, 30*time.Second, resolver, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	result, err := adapter.QueryPromQL(context.Background(), &types.PromQLQueryRequest{
+		Query:     "sum(rate(http_requests_total[5m]))",
+		StartTime: "2026-01-01T00:00:00Z",
+		EndTime:   "2026-01-01T01:00:00Z",
+		Step:      &step,
+		SearchScope: types.ComponentSearchScope{
+			Namespace:   "test-ns",
+			Project:     "test-project",
+			Environment: "test-env",
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		`sum(rate(http_requests_total{openchoreo_dev_environment_uid="env-uid-789",openchoreo_dev_namespace="test-ns",`+
+			`openchoreo_dev_project_uid="project-uid-123"}[5m]))`,
+		captured.Query)
+	assert.Equal(t, "2026-01-01T00:00:00Z", captured.StartTime)
+	assert.Equal(t, "2026-01-01T01:00:00Z", captured.EndTime)
+	require.NotNil(t, captured.Step)
+	assert.Equal(t, "1m", *captured.Step)
+
+	resultJSON, err := json.Marshal(result)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"result":[]}`, string(resultJSON))
+}
+
+func TestMetricsAdapter_QueryPromQL_Errors(t *testing.T) {
+	t.Parallel()
+
+	validScope := types.ComponentSearchScope{Namespace: "test-ns", Project: "test-project"}
+	tests := []struct {
+		name        string
+		req         *types.PromQLQueryRequest
+		status      int
+		resolverErr func(path string) bool
+		wantErr     error
+	}{
+		{
+			name:    "nil request",
+			wantErr: ErrMetricsInvalidRequest,
+		},
+		{
+			name:    "missing project",
+			req:     &types.PromQLQueryRequest{Query: "up", SearchScope: types.ComponentSearchScope{Namespace: "test-ns"}},
+			wantErr: ErrMetricsInvalidRequest,
+		},
+		{
+			name:    "malformed query",
+			req:     &types.PromQLQueryRequest{Query: "rate(up[5m)", SearchScope: validScope},
+			wantErr: ErrMetricsInvalidRequest,
+		},
+		{
+			name:        "project resolution fails",
+			req:         &types.PromQLQueryRequest{Query: "up", SearchScope: validScope},
+			resolverErr: func(string) bool { return true },
+			wantErr:     ErrMetricsResolveSearchScope,
+		},
+		{
+			name:    "query rejected by the backend",
+			req:     &types.PromQLQueryRequest{Query: "up", SearchScope: validScope},
+			status:  http.StatusBadRequest,
+			wantErr: ErrMetricsInvalidRequest,
+		},
+		{
+			name:    "backend failure",
+			req:     &types.PromQLQueryRequest{Query: "up", SearchScope: validScope},
+			status:  http.StatusInternalServerError,
+			wantErr: ErrMetricsRetrieval,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(`{"error":"failed"}`))
+			}))
+			defer server.Close()
+			resolver, cleanup := newMockUIDResolver("project-uid-123", "", "", tt.resolverErr)
+			defer cleanup()
+			adapter := NewMetricsAdapter(server.URL, 30*time.Second, resolver, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+			_, err := adapter.QueryPromQL(context.Background(), tt.req)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -150,3 +150,40 @@ type LogsQueryResponse struct {
 	Total  int        `json:"total"`
 	TookMs int        `json:"tookMs"`
 }
+
+// LogsStreamRequest is the subscription message a client sends after opening
+// the GET /api/v1/logs/stream WebSocket.
+type LogsStreamRequest struct {
+	// SearchScope defines which logs to stream (component or workflow)
+	SearchScope *SearchScope `json:"searchScope"`
+
+	// Optional filters
+	SearchPhrase string   `json:"searchPhrase,omitempty"`
+	LogLevels    []string `json:"logLevels,omitempty"`
+
+	// StartTime is the RFC3339 time to stream from when no cursor is given.
+	// Defaults to the time the stream is opened.
+	StartTime string `json:"startTime,omitempty"`
+	// Cursor resumes a previous stream right after the last entry it delivered.
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// Message types sent by the server on the logs stream.
+const (
+	LogsStreamMessageLogs      = "logs"
+	LogsStreamMessageHeartbeat = "heartbeat"
+	LogsStreamMessageError     = "error"
+)
+
+// LogsStreamMessage is a message sent by the server on the logs stream.
+type LogsStreamMessage struct {
+	// Type is one of logs, heartbeat or error.
+	Type string `json:"type"`
+	// Logs holds new entries in ascending time order (logs messages only).
+	Logs []LogEntry `json:"logs,omitempty"`
+	// Cursor resumes the stream after the last delivered entry.
+	Cursor string `json:"cursor,omitempty"`
+	// ErrorCode and Message describe the failure (error messages only).
+	ErrorCode string `json:"errorCode,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
@@ -57,6 +57,25 @@ type HTTPMetricsQueryResponse struct {
 	LatencyP99               []MetricsTimeSeriesItem `json:"latencyP99,omitempty"`
 }
 
+// PromQLQueryRequest is the request body for POST /api/v1alpha1/metrics/promql.
+// Matches the OpenAPI PromQLQueryRequest schema.
+type PromQLQueryRequest struct {
+	// Query is the PromQL expression to evaluate. Every vector selector in it is
+	// restricted to the search scope before it is evaluated.
+	Query string `json:"query"`
+
+	// Time range for the query (RFC3339, required)
+	StartTime string `json:"startTime"`
+	EndTime   string `json:"endTime"`
+
+	// Step is the query resolution step (e.g. "1m", "5m", "15m", "30m", "1h")
+	Step *string `json:"step,omitempty"`
+
+	// SearchScope restricts the query to a project, and optionally to a
+	// component and environment of it. namespace and project are required.
+	SearchScope ComponentSearchScope `json:"searchScope"`
+}
+
 // RuntimeTopologyRequest is the request body for POST /api/v1alpha1/metrics/runtime-topology.
 // Matches the OpenAPI RuntimeTopologyRequest schema.
 type RuntimeTopologyRequest struct {
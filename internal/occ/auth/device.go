@@ -0,0 +1,166 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// deviceCodeGrantType is the grant type of the device authorization flow (RFC 8628)
+	deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+	// defaultDevicePollInterval is used when the IdP does not return an interval
+	defaultDevicePollInterval = 5 * time.Second
+)
+
+// DeviceAuth handles the OAuth2 device authorization flow, for logging in
+// from machines without a browser
+type DeviceAuth struct {
+	DeviceAuthorizationEndpoint string
+	TokenEndpoint               string
+	ClientID                    string
+	Scopes                      []string
+
+	// sleep waits between polls of the token endpoint
+	sleep func(time.Duration)
+}
+
+// DeviceCodeResponse represents the device authorization response
+type DeviceCodeResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete,omitempty"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval,omitempty"`
+}
+
+// deviceTokenError represents an error response of the token endpoint
+type deviceTokenError struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// NewDeviceAuth creates a new device auth handler using the OIDC config
+func NewDeviceAuth(oidcConfig *OIDCConfig) (*DeviceAuth, error) {
+	if oidcConfig.DeviceAuthorizationEndpoint == "" {
+		return nil, fmt.Errorf("the identity provider does not support the device authorization flow")
+	}
+	return &DeviceAuth{
+		DeviceAuthorizationEndpoint: oidcConfig.DeviceAuthorizationEndpoint,
+		TokenEndpoint:               oidcConfig.TokenEndpoint,
+		ClientID:                    oidcConfig.ClientID,
+		Scopes:                      oidcConfig.Scopes,
+		sleep:                       time.Sleep,
+	}, nil
+}
+
+// RequestDeviceCode starts the flow by requesting a device code and the user
+// code to enter at the verification URI
+func (d *DeviceAuth) RequestDeviceCode() (*DeviceCodeResponse, error) {
+	data := url.Values{
+		"client_id": {d.ClientID},
+		"scope":     {strings.Join(d.Scopes, " ")},
+	}
+
+	status, body, err := postForm(d.DeviceAuthorizationEndpoint, data)
+	if err != nil {
+		return nil, fmt.Errorf("device authorization request failed: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("device authorization request failed with status %d: %s", status, string(body))
+	}
+
+	var codeResp DeviceCodeResponse
+	if err := json.Unmarshal(body, &codeResp); err != nil {
+		return nil, fmt.Errorf("failed to parse device authorization response: %w", err)
+	}
+	if codeResp.DeviceCode == "" || codeResp.UserCode == "" || codeResp.VerificationURI == "" {
+		return nil, fmt.Errorf("device authorization response is missing device_code, user_code or verification_uri")
+	}
+	return &codeResp, nil
+}
+
+// PollForToken polls the token endpoint until the user approves or denies the
+// request, or the device code expires
+func (d *DeviceAuth) PollForToken(codeResp *DeviceCodeResponse) (*PKCETokenResponse, error) {
+	interval := time.Duration(codeResp.Interval) * time.Second
+	if interval <= 0 {
+		interval = defaultDevicePollInterval
+	}
+	deadline := time.Now().Add(time.Duration(codeResp.ExpiresIn) * time.Second)
+	if codeResp.ExpiresIn <= 0 {
+		deadline = time.Now().Add(AuthTimeout)
+	}
+
+	data := url.Values{
+		"grant_type":  {deviceCodeGrantType},
+		"client_id":   {d.ClientID},
+		"device_code": {codeResp.DeviceCode},
+	}
+
+	for time.Now().Before(deadline) {
+		d.sleep(interval)
+
+		status, body, err := postForm(d.TokenEndpoint, data)
+		if err != nil {
+			return nil, fmt.Errorf("token request failed: %w", err)
+		}
+		if status == http.StatusOK {
+			var tokenResp PKCETokenResponse
+			if err := json.Unmarshal(body, &tokenResp); err != nil {
+				return nil, fmt.Errorf("failed to parse token response: %w", err)
+			}
+			return &tokenResp, nil
+		}
+
+		var tokenErr deviceTokenError
+		if err := json.Unmarshal(body, &tokenErr); err != nil {
+			return nil, fmt.Errorf("token request failed with status %d: %s", status, string(body))
+		}
+		switch tokenErr.Error {
+		case "authorization_pending":
+		case "slow_down":
+			// RFC 8628 section 3.5: increase the interval by 5 seconds
+			interval += 5 * time.Second
+		case "access_denied":
+			return nil, fmt.Errorf("the login request was denied")
+		case "expired_token":
+			return nil, fmt.Errorf("the device code expired before the login was approved, please run 'occ login --device' again")
+		default:
+			return nil, fmt.Errorf("token request failed with status %d: %s", status, string(body))
+		}
+	}
+	return nil, fmt.Errorf("the device code expired before the login was approved, please run 'occ login --device' again")
+}
+
+// postForm posts form data and returns the status code and body of the response
+func postForm(endpoint string, data url.Values) (int, []byte, error) {
+	req, err := http.NewRequest(http.MethodPost, endpoint, strings.NewReader(data.Encode()))
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	return resp.StatusCode, body, nil
+}
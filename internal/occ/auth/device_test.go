@@ -0,0 +1,117 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+)
+
+func newTestDeviceAuth(t *testing.T) (*DeviceAuth, *[]time.Duration) {
+	t.Helper()
+	d, err := NewDeviceAuth(&OIDCConfig{
+		DeviceAuthorizationEndpoint: "http://idp/device",
+		TokenEndpoint:               "http://idp/token",
+		ClientID:                    "cli-id",
+		Scopes:                      []string{"openid", "offline_access"},
+	})
+	require.NoError(t, err)
+	var waits []time.Duration
+	d.sleep = func(d time.Duration) { waits = append(waits, d) }
+	return d, &waits
+}
+
+func TestNewDeviceAuth_Unsupported(t *testing.T) {
+	_, err := NewDeviceAuth(&OIDCConfig{TokenEndpoint: "http://idp/token"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not support the device authorization flow")
+}
+
+func TestRequestDeviceCode(t *testing.T) {
+	d, _ := newTestDeviceAuth(t)
+	testutil.SetTransport(t, testutil.RoundTripFunc(func(r *http.Request) (*http.Response, error) {
+		require.Equal(t, "/device", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "cli-id", r.PostForm.Get("client_id"))
+		assert.Equal(t, "openid offline_access", r.PostForm.Get("scope"))
+		return testutil.JSONResp(http.StatusOK, map[string]any{
+			"device_code":      "dev-code",
+			"user_code":        "ABCD-EFGH",
+			"verification_uri": "http://idp/activate",
+			"expires_in":       600,
+			"interval":         2,
+		}), nil
+	}))
+
+	resp, err := d.RequestDeviceCode()
+	require.NoError(t, err)
+	assert.Equal(t, "dev-code", resp.DeviceCode)
+	assert.Equal(t, "ABCD-EFGH", resp.UserCode)
+	assert.Equal(t, "http://idp/activate", resp.VerificationURI)
+	assert.Equal(t, 2, resp.Interval)
+}
+
+func TestRequestDeviceCode_Error(t *testing.T) {
+	d, _ := newTestDeviceAuth(t)
+	testutil.SetTransport(t, testutil.RoundTripFunc(func(r *http.Request) (*http.Response, error) {
+		return testutil.JSONResp(http.StatusBadRequest, map[string]any{"error": "invalid_client"}), nil
+	}))
+
+	_, err := d.RequestDeviceCode()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 400")
+}
+
+func TestPollForToken(t *testing.T) {
+	codeResp := &DeviceCodeResponse{DeviceCode: "dev-code", ExpiresIn: 600, Interval: 2}
+
+	t.Run("waits while pending and slows down when asked", func(t *testing.T) {
+		d, waits := newTestDeviceAuth(t)
+		replies := []map[string]any{
+			{"error": "authorization_pending"},
+			{"error": "slow_down"},
+			{"access_token": "access", "refresh_token": "refresh"},
+		}
+		testutil.SetTransport(t, testutil.RoundTripFunc(func(r *http.Request) (*http.Response, error) {
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, deviceCodeGrantType, r.PostForm.Get("grant_type"))
+			assert.Equal(t, "dev-code", r.PostForm.Get("device_code"))
+			reply := replies[0]
+			replies = replies[1:]
+			if _, ok := reply["error"]; ok {
+				return testutil.JSONResp(http.StatusBadRequest, reply), nil
+			}
+			return testutil.JSONResp(http.StatusOK, reply), nil
+		}))
+
+		tokenResp, err := d.PollForToken(codeResp)
+		require.NoError(t, err)
+		assert.Equal(t, "access", tokenResp.AccessToken)
+		assert.Equal(t, "refresh", tokenResp.RefreshToken)
+		assert.Equal(t, []time.Duration{2 * time.Second, 2 * time.Second, 7 * time.Second}, *waits)
+	})
+
+	for reply, want := range map[string]string{
+		"access_denied":  "denied",
+		"expired_token":  "expired",
+		"invalid_client": "status 400",
+	} {
+		t.Run(reply, func(t *testing.T) {
+			d, _ := newTestDeviceAuth(t)
+			testutil.SetTransport(t, testutil.RoundTripFunc(func(r *http.Request) (*http.Response, error) {
+				return testutil.JSONResp(http.StatusBadRequest, map[string]any{"error": reply}), nil
+			}))
+
+			_, err := d.PollForToken(codeResp)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), want)
+		})
+	}
+}
@@ -14,13 +14,14 @@ import (
 
 // OIDCConfig represents the client configuration response for CLI
 type OIDCConfig struct {
-	AuthorizationEndpoint string   `json:"authorization_endpoint"`
-	TokenEndpoint         string   `json:"token_endpoint"`
-	ClientID              string   `json:"client_id"`
-	Scopes                []string `json:"scopes"`
-	SecurityEnabled       bool     `json:"security_enabled"`
-	Issuer                string   `json:"issuer,omitempty"`
-	JwksURI               string   `json:"jwks_uri,omitempty"`
+	AuthorizationEndpoint       string   `json:"authorization_endpoint"`
+	TokenEndpoint               string   `json:"token_endpoint"`
+	DeviceAuthorizationEndpoint string   `json:"device_authorization_endpoint,omitempty"`
+	ClientID                    string   `json:"client_id"`
+	Scopes                      []string `json:"scopes"`
+	SecurityEnabled             bool     `json:"security_enabled"`
+	Issuer                      string   `json:"issuer,omitempty"`
+	JwksURI                     string   `json:"jwks_uri,omitempty"`
 }
 
 // protectedResourceResponse represents the extended /.well-known/oauth-protected-resource response
@@ -39,9 +40,10 @@ type clientInfo struct {
 
 // oidcProviderDiscovery holds the relevant fields from an OIDC provider's discovery document
 type oidcProviderDiscovery struct {
-	AuthorizationEndpoint string `json:"authorization_endpoint"`
-	TokenEndpoint         string `json:"token_endpoint"`
-	JwksURI               string `json:"jwks_uri,omitempty"`
+	AuthorizationEndpoint       string `json:"authorization_endpoint"`
+	TokenEndpoint               string `json:"token_endpoint"`
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint,omitempty"`
+	JwksURI                     string `json:"jwks_uri,omitempty"`
 }
 
 // FetchOIDCConfig fetches CLI client configuration using a two-step discovery process:
@@ -77,13 +79,14 @@ func FetchOIDCConfig(apiURL string) (*OIDCConfig, error) {
 	}
 
 	return &OIDCConfig{
-		AuthorizationEndpoint: provider.AuthorizationEndpoint,
-		TokenEndpoint:         provider.TokenEndpoint,
-		ClientID:              cliClient.ClientID,
-		Scopes:                cliClient.Scopes,
-		SecurityEnabled:       resource.OpenChoreoSecurityEnabled,
-		Issuer:                issuer,
-		JwksURI:               provider.JwksURI,
+		AuthorizationEndpoint:       provider.AuthorizationEndpoint,
+		TokenEndpoint:               provider.TokenEndpoint,
+		DeviceAuthorizationEndpoint: provider.DeviceAuthorizationEndpoint,
+		ClientID:                    cliClient.ClientID,
+		Scopes:                      cliClient.Scopes,
+		SecurityEnabled:             resource.OpenChoreoSecurityEnabled,
+		Issuer:                      issuer,
+		JwksURI:                     provider.JwksURI,
 	}, nil
 }
 
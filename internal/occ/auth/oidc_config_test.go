@@ -35,9 +35,10 @@ func TestFetchOIDCConfig(t *testing.T) {
 				}), nil
 			case mockIssuerOIDCDiscovery:
 				return testutil.JSONResp(http.StatusOK, oidcProviderDiscovery{
-					AuthorizationEndpoint: "https://auth.example.com/authorize",
-					TokenEndpoint:         "https://auth.example.com/token",
-					JwksURI:               "https://auth.example.com/jwks",
+					AuthorizationEndpoint:       "https://auth.example.com/authorize",
+					TokenEndpoint:               "https://auth.example.com/token",
+					DeviceAuthorizationEndpoint: "https://auth.example.com/device",
+					JwksURI:                     "https://auth.example.com/jwks",
 				}), nil
 			default:
 				return &http.Response{StatusCode: http.StatusNotFound, Body: http.NoBody, Header: http.Header{}}, nil
@@ -48,6 +49,7 @@ func TestFetchOIDCConfig(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "https://auth.example.com/authorize", cfg.AuthorizationEndpoint)
 		assert.Equal(t, "https://auth.example.com/token", cfg.TokenEndpoint)
+		assert.Equal(t, "https://auth.example.com/device", cfg.DeviceAuthorizationEndpoint)
 		assert.Equal(t, "cli-client-id", cfg.ClientID)
 		assert.Equal(t, []string{"openid", "profile"}, cfg.Scopes)
 		assert.True(t, cfg.SecurityEnabled)
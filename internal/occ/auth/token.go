@@ -70,8 +70,8 @@ func RefreshToken() (string, error) {
 	}
 
 	// Check auth method and use appropriate refresh strategy
-	if credential.AuthMethod == "authorization_code" && credential.RefreshToken != "" {
-		// Use PKCE refresh token grant
+	if (credential.AuthMethod == "authorization_code" || credential.AuthMethod == "device_code") && credential.RefreshToken != "" {
+		// Use the refresh token grant of interactive logins
 		tokenResp, err := RefreshAccessToken(
 			oidcConfig.TokenEndpoint,
 			credential.ClientID,
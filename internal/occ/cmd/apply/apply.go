@@ -10,14 +10,17 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"net/http"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 
 	"gopkg.in/yaml.v3"
 
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 )
@@ -30,16 +33,39 @@ type resourceInfo struct {
 	namespace  string
 }
 
-// Apply applies resources from the specified file or directory.
+// resourceDocument is a resource read from a manifest, with the file it came from.
+type resourceDocument struct {
+	source   string
+	resource map[string]interface{}
+}
+
+// Apply applies resources from the specified file, directory or glob pattern.
+// Resources of all files are applied together, ordered so that the resources
+// others depend on are applied first. With an apply set, the resources are
+// labelled with it, and prune deletes the resources of the set that are no
+// longer in the files once the deletions are confirmed.
 func Apply(c *client.Client, params Params) error {
+	return apply(c, params, utils.NewPrompter(os.Stdin, os.Stdout))
+}
+
+//nolint:gocyclo // sequential phases: load, preview, apply, prune
+func apply(c *client.Client, params Params, p *utils.Prompter) error {
 	if params.FilePath == "" {
 		return fmt.Errorf("file path is required")
 	}
+	if params.Prune && params.ApplySet == "" {
+		return fmt.Errorf("--prune requires --applyset to select the resources to prune")
+	}
+	if params.ApplySet != "" {
+		if err := validateApplySet(params.ApplySet); err != nil {
+			return err
+		}
+	}
 
 	genClient := c.GetClient()
 
 	// Discover all resource files to process
-	resourceFiles, err := discoverResourceFiles(params.FilePath)
+	resourceFiles, err := discoverResourceFiles(params.FilePath, params.Recursive)
 	if err != nil {
 		return fmt.Errorf("failed to discover resources: %w", err)
 	}
@@ -50,49 +76,122 @@ func Apply(c *client.Client, params Params) error {
 	registry := getResourceRegistry()
 
 	// Resolve default namespace from CLI context
-	defaultNamespace := resolveDefaultNamespace()
+	defaultNamespace := config.DefaultNamespace()
 
 	ctx := context.Background()
-	totalResources := 0
-	var errs []string
+	documents, errs := loadDocuments(ctx, resourceFiles)
+	sortByDependency(documents)
+	if params.ApplySet != "" {
+		labelDocuments(documents, params.ApplySet)
+	}
 
-	for _, filePath := range resourceFiles {
-		content, err := readResourceContent(ctx, filePath)
-		if err != nil {
-			errs = append(errs, fmt.Sprintf("failed to read %s: %v", filePath, err))
-			continue
+	var pruneTargets []pruneTarget
+	if params.Prune {
+		if len(errs) > 0 {
+			for _, e := range errs {
+				fmt.Printf("Error: %s\n", e)
+			}
+			return fmt.Errorf("not pruning: %d file(s) could not be read, so the apply set cannot be compared", len(errs))
 		}
-
-		resources, err := parseYAMLResources(content)
+		pruneTargets, err = findPruneTargets(ctx, c, registry, documents, defaultNamespace, params.ApplySet)
 		if err != nil {
-			errs = append(errs, fmt.Sprintf("failed to parse %s: %v", filePath, err))
-			continue
+			return err
+		}
+		if len(pruneTargets) > 0 {
+			fmt.Printf("The following resource(s) of apply set %q are not in the manifests and will be deleted:\n", params.ApplySet)
+			for _, t := range pruneTargets {
+				fmt.Printf("  %s\n", t)
+			}
+			if !params.Yes {
+				ok, err := p.Confirm(fmt.Sprintf("Delete %d resource(s)?", len(pruneTargets)), false)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					fmt.Println("Aborted, no changes were made")
+					return nil
+				}
+			}
+			fmt.Println()
 		}
+	}
 
-		totalResources += len(resources)
-		for _, resource := range resources {
-			if err := applyResource(ctx, genClient, registry, resource, defaultNamespace); err != nil {
-				errs = append(errs, err.Error())
+	applied := 0
+	for i := 0; i < len(documents); i++ {
+		// Resources are applied together through the batch API; they are
+		// contiguous once sorted.
+		if kind, _ := documents[i].resource["kind"].(string); kind == bundleKind {
+			j := i
+			for j < len(documents) && documents[j].resource["kind"] == bundleKind {
+				j++
 			}
+			n, bundleErrs := applyBundle(ctx, genClient, documents[i:j], defaultNamespace)
+			applied += n
+			errs = append(errs, bundleErrs...)
+			i = j - 1
+			continue
+		}
+		if err := applyResource(ctx, genClient, registry, documents[i].resource, defaultNamespace); err != nil {
+			errs = append(errs, err.Error())
+			continue
 		}
+		applied++
 	}
 
-	applied := totalResources - len(errs)
+	// Pruning after a failed apply could delete resources that were meant to
+	// be replaced, so it only runs when every resource was applied.
+	pruned := 0
+	if len(errs) == 0 && len(pruneTargets) > 0 {
+		var pruneErrs []string
+		pruned, pruneErrs = pruneResources(ctx, c, pruneTargets)
+		errs = append(errs, pruneErrs...)
+	} else if len(pruneTargets) > 0 {
+		fmt.Printf("Skipped pruning %d resource(s) because the apply had errors\n", len(pruneTargets))
+	}
 
 	for _, e := range errs {
 		fmt.Printf("Error: %s\n", e)
 	}
 
+	summary := fmt.Sprintf("Applied %d resource(s) from %d file(s)", applied, len(resourceFiles))
+	if params.Prune {
+		summary += fmt.Sprintf(", pruned %d resource(s)", pruned)
+	}
 	if len(errs) > 0 {
-		fmt.Printf("\nApplied %d resource(s) from %d file(s) with %d error(s)\n",
-			applied, len(resourceFiles), len(errs))
+		fmt.Printf("\n%s with %d error(s)\n", summary, len(errs))
 		return fmt.Errorf("apply completed with %d error(s)", len(errs))
 	}
 
-	fmt.Printf("\nApplied %d resource(s) from %d file(s)\n", applied, len(resourceFiles))
+	fmt.Printf("\n%s\n", summary)
 	return nil
 }
 
+// loadDocuments reads the resources of every file. Files that cannot be read
+// or parsed are reported as errors; the resources of the others are returned.
+func loadDocuments(ctx context.Context, resourceFiles []string) ([]resourceDocument, []string) {
+	var (
+		documents []resourceDocument
+		errs      []string
+	)
+	for _, filePath := range resourceFiles {
+		content, err := readResourceContent(ctx, filePath)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("failed to read %s: %v", filePath, err))
+			continue
+		}
+
+		resources, err := parseYAMLResources(content)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("failed to parse %s: %v", filePath, err))
+			continue
+		}
+		for _, resource := range resources {
+			documents = append(documents, resourceDocument{source: filePath, resource: resource})
+		}
+	}
+	return documents, errs
+}
+
 // extractResourceInfo extracts kind, apiVersion, name, and namespace from a resource map.
 func extractResourceInfo(resource map[string]interface{}) (resourceInfo, error) {
 	kind, _ := resource["kind"].(string)
@@ -157,12 +256,9 @@ func applyResource(
 	// Resolve namespace for namespaced resources
 	ns := info.namespace
 	if entry.scope == scopeNamespaced {
-		if ns == "" {
-			ns = defaultNamespace
-		}
-		// If the namespace is not in the YAML or CLI context, return an error since we don't want to accidentally apply to the wrong namespace
-		if ns == "" {
-			return fmt.Errorf("%s/%s: namespace is required (set in YAML metadata.namespace or via 'occ config set-context')", strings.ToLower(info.kind), info.name)
+		var err error
+		if ns, err = resolveNamespace(info, defaultNamespace); err != nil {
+			return err
 		}
 	}
 
@@ -173,14 +269,18 @@ func applyResource(
 	}
 
 	// Check if resource exists
-	statusCode, err := entry.get(ctx, c, ns, info.name)
+	statusCode, existing, err := entry.get(ctx, c, ns, info.name)
 	if err != nil {
 		return fmt.Errorf("%s/%s: failed to check existence: %w", strings.ToLower(info.kind), info.name, err)
 	}
 
 	switch statusCode {
 	case http.StatusOK:
-		// Resource exists — update (or error for create-only)
+		// Resource exists — update (or error for create-only), unless it already matches
+		if isUnchanged(existing, jsonBody) {
+			fmt.Printf("%s/%s unchanged\n", strings.ToLower(info.kind), info.name)
+			return nil
+		}
 		if entry.capability == capCreateOnly {
 			return fmt.Errorf("%s/%s: resource already exists and cannot be updated (create-only resource)", strings.ToLower(info.kind), info.name)
 		}
@@ -228,21 +328,95 @@ func parseErrorBody(body []byte) string {
 	return s
 }
 
-// resolveDefaultNamespace returns the namespace from the current CLI context, or empty string.
-func resolveDefaultNamespace() string {
-	ctx, err := config.GetCurrentContext()
-	if err != nil {
-		return ""
+// resolveNamespace returns the namespace of a namespaced resource: the one in its
+// metadata, or else the default namespace of the CLI context.
+func resolveNamespace(info resourceInfo, defaultNamespace string) (string, error) {
+	ns := info.namespace
+	if ns == "" {
+		ns = defaultNamespace
+	}
+	// If the namespace is not in the YAML or CLI context, return an error since we don't want to accidentally apply to the wrong namespace
+	if ns == "" {
+		return "", fmt.Errorf("%s/%s: namespace is required (set in YAML metadata.namespace or via 'occ config set-context')", strings.ToLower(info.kind), info.name)
+	}
+	return ns, nil
+}
+
+// isUnchanged reports whether the stored resource already matches the desired one:
+// every top-level field other than metadata and status, and the labels and
+// annotations, are equal. A stored resource that cannot be decoded counts as changed.
+func isUnchanged(existingBody, desiredBody []byte) bool {
+	var existing, desired map[string]interface{}
+	if json.Unmarshal(existingBody, &existing) != nil || json.Unmarshal(desiredBody, &desired) != nil {
+		return false
+	}
+	ignored := map[string]bool{"metadata": true, "status": true, "kind": true, "apiVersion": true}
+	for _, obj := range []map[string]interface{}{existing, desired} {
+		for key := range obj {
+			if !ignored[key] && !reflect.DeepEqual(existing[key], desired[key]) {
+				return false
+			}
+		}
+	}
+	for _, field := range []string{"labels", "annotations"} {
+		if !reflect.DeepEqual(metadataMap(existing, field), metadataMap(desired, field)) {
+			return false
+		}
+	}
+	return true
+}
+
+// metadataMap returns metadata.<field> of a decoded resource, empty when absent.
+func metadataMap(resource map[string]interface{}, field string) map[string]interface{} {
+	metadata, _ := resource["metadata"].(map[string]interface{})
+	m, _ := metadata[field].(map[string]interface{})
+	if m == nil {
+		return map[string]interface{}{}
 	}
-	return ctx.Namespace
+	return m
 }
 
-// discoverResourceFiles discovers all YAML files to process.
-func discoverResourceFiles(path string) ([]string, error) {
+// discoverResourceFiles discovers all YAML files to process. path is a file, a
+// directory, a glob pattern or an HTTP(S) URL. Directories are read one level deep
+// unless recursive is set.
+func discoverResourceFiles(path string, recursive bool) ([]string, error) {
 	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
 		return []string{path}, nil
 	}
 
+	if !isGlobPattern(path) {
+		return filesInPath(path, recursive, true)
+	}
+
+	matches, err := filepath.Glob(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %s: %w", path, err)
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no files match pattern %s", path)
+	}
+
+	seen := map[string]bool{}
+	var yamlFiles []string
+	for _, match := range matches {
+		// Files matched by a pattern are only applied when they are YAML.
+		files, err := filesInPath(match, recursive, false)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			if !seen[f] {
+				seen[f] = true
+				yamlFiles = append(yamlFiles, f)
+			}
+		}
+	}
+	return yamlFiles, nil
+}
+
+// filesInPath returns path itself when it is a file, or the YAML files of the
+// directory. A file given explicitly is returned whatever its extension.
+func filesInPath(path string, recursive, explicit bool) ([]string, error) {
 	info, err := os.Stat(path)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -252,19 +426,24 @@ func discoverResourceFiles(path string) ([]string, error) {
 	}
 
 	if !info.IsDir() {
-		return []string{path}, nil
+		if explicit || isYAMLFile(path) {
+			return []string{path}, nil
+		}
+		return nil, nil
 	}
 
 	var yamlFiles []string
-	err = filepath.Walk(path, func(filePath string, info os.FileInfo, err error) error {
+	err = filepath.WalkDir(path, func(filePath string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
-		if info.IsDir() {
+		if d.IsDir() {
+			if filePath != path && !recursive {
+				return filepath.SkipDir
+			}
 			return nil
 		}
-		ext := strings.ToLower(filepath.Ext(filePath))
-		if ext == ".yaml" || ext == ".yml" {
+		if isYAMLFile(filePath) {
 			yamlFiles = append(yamlFiles, filePath)
 		}
 		return nil
@@ -275,6 +454,15 @@ func discoverResourceFiles(path string) ([]string, error) {
 	return yamlFiles, nil
 }
 
+func isYAMLFile(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+func isGlobPattern(path string) bool {
+	return strings.ContainsAny(path, "*?[")
+}
+
 // readResourceContent reads resource content from file or URL.
 func readResourceContent(ctx context.Context, filePath string) ([]byte, error) {
 	if strings.HasPrefix(filePath, "http://") || strings.HasPrefix(filePath, "https://") {
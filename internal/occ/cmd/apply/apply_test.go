@@ -6,6 +6,7 @@ package apply
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -231,7 +232,7 @@ func TestDiscoverResourceFiles(t *testing.T) {
 		f := filepath.Join(dir, "resource.yaml")
 		require.NoError(t, os.WriteFile(f, []byte("kind: Project"), 0600))
 
-		files, err := discoverResourceFiles(f)
+		files, err := discoverResourceFiles(f, false)
 		require.NoError(t, err)
 		assert.Equal(t, []string{f}, files)
 	})
@@ -244,30 +245,140 @@ func TestDiscoverResourceFiles(t *testing.T) {
 			require.NoError(t, os.WriteFile(filepath.Join(dir, f.name), []byte(f.content), 0600))
 		}
 
-		files, err := discoverResourceFiles(dir)
+		files, err := discoverResourceFiles(dir, false)
 		require.NoError(t, err)
 		assert.Len(t, files, 2)
 	})
 
 	t.Run("http URL passthrough", func(t *testing.T) {
-		files, err := discoverResourceFiles("https://example.com/resource.yaml")
+		files, err := discoverResourceFiles("https://example.com/resource.yaml", false)
 		require.NoError(t, err)
 		assert.Equal(t, []string{"https://example.com/resource.yaml"}, files)
 	})
 
 	t.Run("nonexistent path", func(t *testing.T) {
 		dir := t.TempDir()
-		_, err := discoverResourceFiles(filepath.Join(dir, "no-such-subdir"))
+		_, err := discoverResourceFiles(filepath.Join(dir, "no-such-subdir"), false)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "does not exist")
 	})
 
 	t.Run("empty directory", func(t *testing.T) {
 		dir := t.TempDir()
-		files, err := discoverResourceFiles(dir)
+		files, err := discoverResourceFiles(dir, false)
 		require.NoError(t, err)
 		assert.Empty(t, files)
 	})
+
+	t.Run("subdirectories only with recursive", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "nested", "deeper"), 0o755))
+		top := filepath.Join(dir, "top.yaml")
+		nested := filepath.Join(dir, "nested", "a.yaml")
+		deeper := filepath.Join(dir, "nested", "deeper", "b.yml")
+		for _, f := range []string{top, nested, deeper} {
+			require.NoError(t, os.WriteFile(f, []byte("kind: A"), 0600))
+		}
+
+		files, err := discoverResourceFiles(dir, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{top}, files)
+
+		files, err = discoverResourceFiles(dir, true)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{top, nested, deeper}, files)
+	})
+
+	t.Run("glob pattern", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "svc-c"), 0o755))
+		for _, name := range []string{"svc-a.yaml", "svc-b.yml", "svc-notes.txt", "other.yaml", filepath.Join("svc-c", "c.yaml")} {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("kind: A"), 0600))
+		}
+
+		files, err := discoverResourceFiles(filepath.Join(dir, "svc-*"), false)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{
+			filepath.Join(dir, "svc-a.yaml"),
+			filepath.Join(dir, "svc-b.yml"),
+			filepath.Join(dir, "svc-c", "c.yaml"),
+		}, files)
+	})
+
+	t.Run("glob pattern without matches", func(t *testing.T) {
+		dir := t.TempDir()
+		_, err := discoverResourceFiles(filepath.Join(dir, "*.yaml"), false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no files match pattern")
+	})
+}
+
+func TestSortByDependency(t *testing.T) {
+	doc := func(kind, name string) resourceDocument {
+		return resourceDocument{resource: map[string]any{"kind": kind, "metadata": map[string]any{"name": name}}}
+	}
+	documents := []resourceDocument{
+		doc("ReleaseBinding", "rb"),
+		doc("Resource", "db"),
+		doc("Component", "api"),
+		doc("Unknown", "u"),
+		doc("Project", "p"),
+		doc("Component", "web"),
+		doc("Environment", "dev"),
+		doc("Namespace", "ns"),
+	}
+
+	sortByDependency(documents)
+
+	var got []string
+	for _, d := range documents {
+		got = append(got, d.resource["kind"].(string)+"/"+d.resource["metadata"].(map[string]any)["name"].(string))
+	}
+	assert.Equal(t, []string{
+		"Namespace/ns", "Environment/dev", "Project/p", "Component/api", "Component/web",
+		"Resource/db", "ReleaseBinding/rb", "Unknown/u",
+	}, got)
+}
+
+func TestIsUnchanged(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing string
+		desired  string
+		want     bool
+	}{
+		{
+			name:     "same spec and labels, server fields ignored",
+			existing: `{"metadata":{"name":"p","uid":"1","labels":{"a":"b"}},"spec":{"x":1},"status":{"ready":true}}`,
+			desired:  `{"metadata":{"name":"p","labels":{"a":"b"}},"spec":{"x":1}}`,
+			want:     true,
+		},
+		{
+			name:     "spec differs",
+			existing: `{"metadata":{"name":"p"},"spec":{"x":1}}`,
+			desired:  `{"metadata":{"name":"p"},"spec":{"x":2}}`,
+		},
+		{
+			name:     "field removed from spec",
+			existing: `{"metadata":{"name":"p"},"spec":{"x":1,"y":2}}`,
+			desired:  `{"metadata":{"name":"p"},"spec":{"x":1}}`,
+		},
+		{
+			name:     "annotation added",
+			existing: `{"metadata":{"name":"p"},"spec":{}}`,
+			desired:  `{"metadata":{"name":"p","annotations":{"k":"v"}},"spec":{}}`,
+		},
+		{
+			name:     "undecodable stored resource",
+			existing: `not json`,
+			desired:  `{"metadata":{"name":"p"}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isUnchanged([]byte(tt.existing), []byte(tt.desired)))
+		})
+	}
 }
 
 func TestReadResourceContent(t *testing.T) {
@@ -499,3 +610,114 @@ metadata:
 	})
 	assert.Contains(t, out, "namespace is required")
 }
+
+func TestApply_Unchanged(t *testing.T) {
+	cl := setupApplyTest(t, testutil.RoundTripFunc(func(r *http.Request) (*http.Response, error) {
+		if r.Method == http.MethodGet {
+			return testutil.JSONResp(http.StatusOK, map[string]any{
+				"metadata": map[string]any{"name": "ns1", "labels": map[string]any{"team": "a"}},
+			}), nil
+		}
+		t.Fatalf("unexpected %s %s", r.Method, r.URL.Path)
+		return nil, nil
+	}))
+
+	dir := t.TempDir()
+	yamlFile := filepath.Join(dir, "ns.yaml")
+	require.NoError(t, os.WriteFile(yamlFile, []byte("kind: Namespace\nmetadata:\n  name: ns1\n  labels:\n    team: a\n"), 0600))
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, Apply(cl, Params{FilePath: yamlFile}))
+	})
+	assert.Contains(t, out, "namespace/ns1 unchanged")
+	assert.Contains(t, out, "Applied 1 resource(s) from 1 file(s)")
+}
+
+func TestApply_DependencyOrderAcrossFiles(t *testing.T) {
+	var created []string
+	cl := setupApplyTest(t, testutil.RoundTripFunc(func(r *http.Request) (*http.Response, error) {
+		if r.Method == http.MethodGet {
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       io.NopCloser(bytes.NewReader([]byte(`{}`))),
+				Header:     http.Header{},
+			}, nil
+		}
+		created = append(created, r.URL.Path)
+		return testutil.JSONResp(http.StatusCreated, map[string]any{}), nil
+	}))
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "components"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "components", "api.yaml"), []byte(`kind: Component
+metadata:
+  name: api
+  namespace: team
+`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "setup.yaml"), []byte(`kind: Project
+metadata:
+  name: shop
+  namespace: team
+---
+kind: Namespace
+metadata:
+  name: team
+`), 0600))
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, Apply(cl, Params{FilePath: dir, Recursive: true}))
+	})
+	assert.Equal(t, []string{
+		"/api/v1/namespaces",
+		"/api/v1/namespaces/team/projects",
+		"/api/v1/namespaces/team/components",
+	}, created)
+	assert.Contains(t, out, "Applied 3 resource(s) from 2 file(s)")
+}
+
+func TestApply_ResourcesUseBatchAPI(t *testing.T) {
+	var batchBody map[string]any
+	cl := setupApplyTest(t, testutil.RoundTripFunc(func(r *http.Request) (*http.Response, error) {
+		if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/resources:batchApply") {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&batchBody))
+			return testutil.JSONResp(http.StatusOK, map[string]any{
+				"status": "PartiallySucceeded", "succeeded": 2, "failed": 1,
+				"items": []map[string]any{
+					{"index": 0, "namespace": "team", "name": "db", "status": "Succeeded", "operation": "created"},
+					{"index": 1, "namespace": "team", "name": "cache", "status": "Succeeded", "operation": "unchanged"},
+					{"index": 2, "namespace": "team", "name": "queue", "status": "Failed", "error": map[string]any{"error": "resource type not found"}},
+				},
+			}), nil
+		}
+		t.Fatalf("unexpected %s %s", r.Method, r.URL.Path)
+		return nil, nil
+	}))
+
+	dir := t.TempDir()
+	yamlFile := filepath.Join(dir, "resources.yaml")
+	require.NoError(t, os.WriteFile(yamlFile, []byte(`kind: Resource
+metadata:
+  name: db
+  namespace: team
+---
+kind: Resource
+metadata:
+  name: cache
+  namespace: team
+---
+kind: Resource
+metadata:
+  name: queue
+  namespace: team
+`), 0600))
+
+	out := testutil.CaptureStdout(t, func() {
+		require.Error(t, Apply(cl, Params{FilePath: yamlFile}))
+	})
+	items, _ := batchBody["items"].([]any)
+	assert.Len(t, items, 3)
+	assert.Contains(t, out, "resource/db created")
+	assert.Contains(t, out, "resource/cache unchanged")
+	assert.Contains(t, out, "resource/queue: apply failed: resource type not found")
+	assert.Contains(t, out, "Applied 2 resource(s) from 1 file(s) with 1 error(s)")
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package apply
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+// bundleKind is the kind applied through the server-side batch API rather than
+// one resource at a time.
+const bundleKind = "Resource"
+
+// applyBundle applies Resource documents in one batch request. The server
+// creates, replaces or leaves each item as it is, and reports the outcome of
+// every item. It returns the number of items applied and the error of every
+// item that was not.
+func applyBundle(ctx context.Context, c *gen.ClientWithResponses, documents []resourceDocument, defaultNamespace string) (int, []string) {
+	var (
+		errs  []string
+		infos []resourceInfo
+		items []map[string]interface{}
+	)
+	for _, doc := range documents {
+		info, err := extractResourceInfo(doc.resource)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		if info.apiVersion != "" && !strings.Contains(info.apiVersion, apiGroup) {
+			errs = append(errs, fmt.Sprintf("%s/%s: unsupported apiVersion %q (expected group %q)", strings.ToLower(info.kind), info.name, info.apiVersion, apiGroup))
+			continue
+		}
+		ns, err := resolveNamespace(info, defaultNamespace)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		metadata, _ := doc.resource["metadata"].(map[string]interface{})
+		metadata["namespace"] = ns
+		infos = append(infos, info)
+		items = append(items, doc.resource)
+	}
+	if len(items) == 0 {
+		return 0, errs
+	}
+
+	body, err := json.Marshal(map[string]interface{}{"items": items})
+	if err != nil {
+		return 0, append(errs, fmt.Sprintf("failed to marshal resources: %v", err))
+	}
+	resp, err := c.BatchApplyResourcesWithBodyWithResponse(ctx, contentTypeJSON, bytes.NewReader(body))
+	if err != nil {
+		return 0, append(errs, fmt.Sprintf("failed to apply resources: %v", err))
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return 0, append(errs, fmt.Sprintf("failed to apply resources: %s", parseErrorBody(resp.Body)))
+	}
+
+	applied := 0
+	for _, item := range resp.JSON200.Items {
+		if item.Index < 0 || item.Index >= len(infos) {
+			continue
+		}
+		ref := fmt.Sprintf("%s/%s", strings.ToLower(infos[item.Index].kind), item.Name)
+		if item.Status != gen.BatchItemResultStatusSucceeded {
+			msg := "unknown error"
+			if item.Error != nil && item.Error.Error != "" {
+				msg = item.Error.Error
+			}
+			errs = append(errs, fmt.Sprintf("%s: apply failed: %s", ref, msg))
+			continue
+		}
+		fmt.Printf("%s %s\n", ref, bundleOperation(item.Operation))
+		applied++
+	}
+	return applied, errs
+}
+
+// bundleOperation maps a batch operation to the words apply prints for
+// resources applied one at a time.
+func bundleOperation(op *gen.BatchItemResultOperation) string {
+	if op == nil {
+		return "configured"
+	}
+	switch *op {
+	case gen.BatchItemResultOperationCreated:
+		return "created"
+	case gen.BatchItemResultOperationUnchanged:
+		return "unchanged"
+	default:
+		return "configured"
+	}
+}
@@ -14,21 +14,127 @@ func NewApplyCmd(f client.NewClientFunc) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "apply",
 		Short: "Apply OpenChoreo resources by file name",
-		Long: `Apply a configuration file to create or update OpenChoreo resources.
+		Long: `Apply configuration files to create or update OpenChoreo resources.
+
+The file can be a single file, a directory, a glob pattern or a URL. Files may
+hold several YAML documents. Resources are applied in dependency order (for
+example namespaces and projects before components), and each is reported as
+created, configured or unchanged.
+
+Resources applied with --applyset are labelled with the name of the set. With
+--prune, the resources labelled with the set that are no longer in the files
+are listed and deleted once confirmed. Pruning is skipped when any resource
+fails to apply.
 
 Examples:
   # Apply a namespace configuration
-  occ apply -f namespace.yaml`,
+  occ apply -f namespace.yaml
+
+  # Apply every manifest under a directory and its subdirectories
+  occ apply -f ./manifests -R
+
+  # Apply the manifests matching a pattern
+  occ apply -f 'manifests/*-dev.yaml'
+
+  # Sync a project directory, deleting the resources removed from it since
+  # the last apply with the same apply set
+  occ apply -f ./online-store -R --applyset online-store --prune`,
 		PreRunE: auth.RequireLogin(),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			filePath, _ := cmd.Flags().GetString("file")
+			recursive, _ := cmd.Flags().GetBool("recursive")
+			applySet, _ := cmd.Flags().GetString("applyset")
+			prune, _ := cmd.Flags().GetBool("prune")
+			yes, _ := cmd.Flags().GetBool("yes")
 			cl, err := f()
 			if err != nil {
 				return err
 			}
-			return Apply(cl.(*client.Client), Params{FilePath: filePath})
+			return Apply(cl.(*client.Client), Params{
+				FilePath:  filePath,
+				Recursive: recursive,
+				ApplySet:  applySet,
+				Prune:     prune,
+				Yes:       yes,
+			})
+		},
+	}
+	cmd.Flags().StringP("file", "f", "", "Path to the configuration file, directory or glob pattern to apply (e.g., manifests/deployment.yaml)")
+	cmd.Flags().BoolP("recursive", "R", false, "Process the directory used in -f recursively")
+	cmd.Flags().String("applyset", "", "Name of the apply set to label the applied resources with (e.g., online-store)")
+	cmd.Flags().Bool("prune", false, "Delete the resources of the apply set that are not in the files, after confirmation")
+	cmd.Flags().BoolP("yes", "y", false, "Delete pruned resources without asking for confirmation")
+	return cmd
+}
+
+func NewDiffCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Diff OpenChoreo resources by file name against their live state",
+		Long: `Compare configuration files with the live state of the resources they
+describe, and print a unified diff for every resource that an apply would
+change. Resources that do not exist yet are shown as added.
+
+The same fields that apply compares are diffed: labels, annotations and the
+resource body, without status. Resource documents are compared by the server.
+
+The command exits with a non-zero status when any resource differs or cannot
+be compared, so it can gate a CI pipeline.
+
+Examples:
+  # Diff every manifest under a directory
+  occ diff -f manifests/
+
+  # Diff every manifest under a directory and its subdirectories
+  occ diff -f ./manifests -R`,
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filePath, _ := cmd.Flags().GetString("file")
+			recursive, _ := cmd.Flags().GetBool("recursive")
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return Diff(cl.(*client.Client), Params{FilePath: filePath, Recursive: recursive})
+		},
+	}
+	cmd.Flags().StringP("file", "f", "", "Path to the configuration file, directory or glob pattern to diff (e.g., manifests/)")
+	cmd.Flags().BoolP("recursive", "R", false, "Process the directory used in -f recursively")
+	return cmd
+}
+
+func NewValidateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate OpenChoreo resources by file name against the schemas of their kinds",
+		Long: `Validate configuration files against the schemas of the kinds they describe,
+without a connection to the control plane, so CI can lint manifests.
+
+Every resource is checked for a name, for values that do not match the schema
+of its kind, and for fields the schema does not declare. Resources of other
+API groups are skipped.
+
+The schemas cached by occ schema pull are used, or those of --schema-dir. When
+no schemas are cached, the schemas built into occ are used.
+
+The command exits with a non-zero status when any resource is invalid.
+
+Examples:
+  # Validate every manifest under a directory and its subdirectories
+  occ validate -f ./manifests -R
+
+  # Validate against schemas committed to the repository
+  occ validate -f ./manifests --schema-dir ./.choreo/schemas`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filePath, _ := cmd.Flags().GetString("file")
+			recursive, _ := cmd.Flags().GetBool("recursive")
+			schemaDir, _ := cmd.Flags().GetString("schema-dir")
+			return Validate(Params{FilePath: filePath, Recursive: recursive, SchemaDir: schemaDir})
 		},
 	}
-	cmd.Flags().StringP("file", "f", "", "Path to the configuration file to apply (e.g., manifests/deployment.yaml)")
+	cmd.Flags().StringP("file", "f", "", "Path to the configuration file, directory or glob pattern to validate (e.g., manifests/)")
+	cmd.Flags().BoolP("recursive", "R", false, "Process the directory used in -f recursively")
+	cmd.Flags().String("schema-dir", "", "Directory of the schemas pulled with occ schema pull --dir")
 	return cmd
 }
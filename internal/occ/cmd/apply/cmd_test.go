@@ -51,6 +51,49 @@ func TestNewApplyCmd_FileFlag(t *testing.T) {
 	assert.Equal(t, "", flag.DefValue)
 }
 
+func TestNewApplyCmd_RecursiveFlag(t *testing.T) {
+	f := func() (client.Interface, error) { return nil, fmt.Errorf("unused") }
+	cmd := NewApplyCmd(f)
+
+	flag := cmd.Flags().Lookup("recursive")
+	require.NotNil(t, flag, "expected --recursive flag")
+	assert.Equal(t, "R", flag.Shorthand)
+	assert.Equal(t, "false", flag.DefValue)
+}
+
+func TestNewApplyCmd_PruneFlags(t *testing.T) {
+	f := func() (client.Interface, error) { return nil, fmt.Errorf("unused") }
+	cmd := NewApplyCmd(f)
+
+	require.NotNil(t, cmd.Flags().Lookup("applyset"))
+	require.NotNil(t, cmd.Flags().Lookup("prune"))
+	yes := cmd.Flags().Lookup("yes")
+	require.NotNil(t, yes)
+	assert.Equal(t, "y", yes.Shorthand)
+}
+
+func TestNewDiffCmd_Structure(t *testing.T) {
+	f := func() (client.Interface, error) { return nil, fmt.Errorf("factory failed") }
+	cmd := NewDiffCmd(f)
+
+	assert.Equal(t, "diff", cmd.Use)
+	assert.NotNil(t, cmd.PreRunE)
+	require.NotNil(t, cmd.Flags().Lookup("file"))
+	require.NotNil(t, cmd.Flags().Lookup("recursive"))
+	assert.EqualError(t, cmd.RunE(cmd, nil), "factory failed")
+}
+
+func TestNewValidateCmd_Structure(t *testing.T) {
+	cmd := NewValidateCmd()
+
+	assert.Equal(t, "validate", cmd.Use)
+	assert.Nil(t, cmd.PreRunE, "validate works without logging in")
+	require.NotNil(t, cmd.Flags().Lookup("file"))
+	require.NotNil(t, cmd.Flags().Lookup("recursive"))
+	require.NotNil(t, cmd.Flags().Lookup("schema-dir"))
+	assert.EqualError(t, cmd.RunE(cmd, nil), "file path is required")
+}
+
 // --- RunE: factory error ---
 
 func TestNewApplyCmd_FactoryError(t *testing.T) {
@@ -169,6 +212,8 @@ func TestNewApplyCmd_RunE_UpdateNamespace(t *testing.T) {
 	require.NoError(t, os.WriteFile(yamlFile, []byte(`kind: Namespace
 metadata:
   name: upd-ns
+  labels:
+    team: platform
 `), 0600))
 
 	cmd := NewApplyCmd(newClientFactory())
@@ -0,0 +1,343 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package apply
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"golang.org/x/term"
+	"gopkg.in/yaml.v3"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+const (
+	ansiReset = "\033[0m"
+	ansiBold  = "\033[1m"
+	ansiRed   = "\033[31m"
+	ansiGreen = "\033[32m"
+	ansiCyan  = "\033[36m"
+)
+
+// Diff compares the resources of the specified file, directory or glob pattern
+// with their live state and prints a unified diff for every resource an apply
+// would change. It returns an error when any resource differs or cannot be
+// compared, so that the command exits non-zero.
+func Diff(c *client.Client, params Params) error {
+	return runDiff(context.Background(), c.GetClient(), params, os.Stdout, colorEnabled(os.Stdout))
+}
+
+func runDiff(ctx context.Context, c *gen.ClientWithResponses, params Params, out io.Writer, color bool) error {
+	if params.FilePath == "" {
+		return fmt.Errorf("file path is required")
+	}
+
+	resourceFiles, err := discoverResourceFiles(params.FilePath, params.Recursive)
+	if err != nil {
+		return fmt.Errorf("failed to discover resources: %w", err)
+	}
+	if len(resourceFiles) == 0 {
+		return fmt.Errorf("no YAML files found in: %s", params.FilePath)
+	}
+
+	registry := getResourceRegistry()
+	defaultNamespace := config.DefaultNamespace()
+
+	documents, errs := loadDocuments(ctx, resourceFiles)
+	sortByDependency(documents)
+
+	drifted := 0
+	for _, doc := range documents {
+		ref, live, desired, err := diffViews(ctx, c, registry, doc.resource, defaultNamespace)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		changed, err := writeUnifiedDiff(out, ref, live, desired, color)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", ref, err))
+			continue
+		}
+		if changed {
+			drifted++
+		}
+	}
+
+	for _, e := range errs {
+		fmt.Fprintf(out, "Error: %s\n", e)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("diff completed with %d error(s)", len(errs))
+	}
+	if drifted > 0 {
+		return fmt.Errorf("%d of %d resource(s) differ from the live state", drifted, len(documents))
+	}
+	fmt.Fprintf(out, "No differences found in %d resource(s)\n", len(documents))
+	return nil
+}
+
+// diffViews returns the reference of a resource and the fields of its live
+// state and of its manifest that apply compares. Resources are compared by the
+// server-side diff API, which returns only the fields that differ; other kinds
+// are fetched and compared here. The live view is nil when the resource does
+// not exist.
+func diffViews(
+	ctx context.Context,
+	c *gen.ClientWithResponses,
+	registry map[string]resourceEntry,
+	resource map[string]interface{},
+	defaultNamespace string,
+) (string, map[string]interface{}, map[string]interface{}, error) {
+	info, err := extractResourceInfo(resource)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	ref := fmt.Sprintf("%s/%s", strings.ToLower(info.kind), info.name)
+
+	if info.apiVersion != "" && !strings.Contains(info.apiVersion, apiGroup) {
+		return "", nil, nil, fmt.Errorf("%s: unsupported apiVersion %q (expected group %q)", ref, info.apiVersion, apiGroup)
+	}
+
+	if info.kind == bundleKind {
+		ns, err := resolveNamespace(info, defaultNamespace)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		live, desired, err := diffBundleResource(ctx, c, ns, info.name, resource)
+		if err != nil {
+			return "", nil, nil, fmt.Errorf("%s: %w", ref, err)
+		}
+		return ref, live, desired, nil
+	}
+
+	if readOnlyKinds[info.kind] {
+		return "", nil, nil, fmt.Errorf("%s: kind %q is not supported by diff (read-only resource)", ref, info.kind)
+	}
+	entry, ok := registry[info.kind]
+	if !ok {
+		return "", nil, nil, fmt.Errorf("%s: unsupported kind %q (supported: %s)", ref, info.kind, strings.Join(supportedKinds(), ", "))
+	}
+
+	ns := info.namespace
+	if entry.scope == scopeNamespaced {
+		if ns, err = resolveNamespace(info, defaultNamespace); err != nil {
+			return "", nil, nil, err
+		}
+	}
+
+	desiredBody, err := stripKindAndAPIVersion(resource)
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("%s: failed to marshal resource: %w", ref, err)
+	}
+	var desired map[string]interface{}
+	if err := json.Unmarshal(desiredBody, &desired); err != nil {
+		return "", nil, nil, fmt.Errorf("%s: failed to decode resource: %w", ref, err)
+	}
+
+	statusCode, existing, err := entry.get(ctx, c, ns, info.name)
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("%s: failed to get live state: %w", ref, err)
+	}
+	switch statusCode {
+	case http.StatusOK:
+		var live map[string]interface{}
+		if err := json.Unmarshal(existing, &live); err != nil {
+			return "", nil, nil, fmt.Errorf("%s: failed to decode live state: %w", ref, err)
+		}
+		return ref, comparedFields(live), comparedFields(desired), nil
+	case http.StatusNotFound:
+		return ref, nil, comparedFields(desired), nil
+	default:
+		return "", nil, nil, fmt.Errorf("%s: unexpected status %d when getting live state: %s", ref, statusCode, parseErrorBody(existing))
+	}
+}
+
+// diffBundleResource asks the server which fields an apply of a Resource would
+// change, and rebuilds the live and desired values of those fields.
+func diffBundleResource(
+	ctx context.Context,
+	c *gen.ClientWithResponses,
+	ns, name string,
+	resource map[string]interface{},
+) (map[string]interface{}, map[string]interface{}, error) {
+	metadata, _ := resource["metadata"].(map[string]interface{})
+	metadata["namespace"] = ns
+	body, err := json.Marshal(resource)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal resource: %w", err)
+	}
+	resp, err := c.DiffResourceWithBodyWithResponse(ctx, ns, name, contentTypeJSON, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, fmt.Errorf("diff failed: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return nil, nil, fmt.Errorf("diff failed: %s", parseErrorBody(resp.Body))
+	}
+
+	var live map[string]interface{}
+	if resp.JSON200.Exists {
+		live = map[string]interface{}{}
+	}
+	desired := map[string]interface{}{}
+	for _, change := range resp.JSON200.Changes {
+		path := splitFieldPath(change.Path)
+		if live != nil && change.Operation != "add" {
+			setField(live, path, change.Current)
+		}
+		if change.Operation != "remove" {
+			setField(desired, path, change.Desired)
+		}
+	}
+	return live, desired, nil
+}
+
+// comparedFields returns the fields of a decoded resource that apply compares:
+// every top-level field other than metadata and status, and the labels and
+// annotations.
+func comparedFields(resource map[string]interface{}) map[string]interface{} {
+	view := map[string]interface{}{}
+	for key, value := range resource {
+		switch key {
+		case "metadata", "status", "kind", "apiVersion":
+		default:
+			view[key] = value
+		}
+	}
+	metadata := map[string]interface{}{}
+	for _, field := range []string{"labels", "annotations"} {
+		if m := metadataMap(resource, field); len(m) > 0 {
+			metadata[field] = m
+		}
+	}
+	if len(metadata) > 0 {
+		view["metadata"] = metadata
+	}
+	return view
+}
+
+// splitFieldPath splits a dot-separated field path of the diff API. Label and
+// annotation keys may themselves hold dots, so they are kept whole.
+func splitFieldPath(path string) []string {
+	for _, prefix := range []string{"metadata.labels.", "metadata.annotations."} {
+		if key, ok := strings.CutPrefix(path, prefix); ok {
+			return append(strings.Split(strings.TrimSuffix(prefix, "."), "."), key)
+		}
+	}
+	return strings.Split(path, ".")
+}
+
+// setField sets the value at path in obj, creating the intermediate objects.
+func setField(obj map[string]interface{}, path []string, value interface{}) {
+	for _, key := range path[:len(path)-1] {
+		next, ok := obj[key].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			obj[key] = next
+		}
+		obj = next
+	}
+	obj[path[len(path)-1]] = value
+}
+
+// writeUnifiedDiff writes the unified diff between the YAML of the live and
+// desired views, and reports whether they differ. A nil live view is diffed as
+// an empty file.
+func writeUnifiedDiff(out io.Writer, ref string, live, desired map[string]interface{}, color bool) (bool, error) {
+	var liveYAML string
+	if live != nil {
+		var err error
+		if liveYAML, err = marshalYAML(live); err != nil {
+			return false, fmt.Errorf("failed to render live state: %w", err)
+		}
+	}
+	desiredYAML, err := marshalYAML(desired)
+	if err != nil {
+		return false, fmt.Errorf("failed to render manifest: %w", err)
+	}
+
+	text, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(liveYAML),
+		B:        splitLines(desiredYAML),
+		FromFile: ref + " (live)",
+		ToFile:   ref + " (manifest)",
+		Context:  3,
+	})
+	if err != nil {
+		return false, err
+	}
+	if text == "" {
+		return false, nil
+	}
+	if !color {
+		_, err = io.WriteString(out, text)
+		return true, err
+	}
+	for _, line := range strings.SplitAfter(text, "\n") {
+		if line == "" {
+			continue
+		}
+		if _, err := io.WriteString(out, colorizeDiffLine(line)); err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
+
+// marshalYAML renders v as YAML indented by two spaces, as manifests are.
+func marshalYAML(v interface{}) (string, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(v); err != nil {
+		return "", err
+	}
+	if err := enc.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// splitLines splits text into lines that keep their newline.
+func splitLines(text string) []string {
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// colorizeDiffLine wraps a line of a unified diff in the ANSI color of its kind.
+func colorizeDiffLine(line string) string {
+	body := strings.TrimSuffix(line, "\n")
+	var code string
+	switch {
+	case strings.HasPrefix(body, "---"), strings.HasPrefix(body, "+++"):
+		code = ansiBold
+	case strings.HasPrefix(body, "@@"):
+		code = ansiCyan
+	case strings.HasPrefix(body, "-"):
+		code = ansiRed
+	case strings.HasPrefix(body, "+"):
+		code = ansiGreen
+	default:
+		return line
+	}
+	return code + body + ansiReset + line[len(body):]
+}
+
+// colorEnabled reports whether output to f should be colorized: f is a
+// terminal and NO_COLOR is not set.
+func colorEnabled(f *os.File) bool {
+	return os.Getenv("NO_COLOR") == "" && term.IsTerminal(int(f.Fd()))
+}
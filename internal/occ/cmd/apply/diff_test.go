@@ -0,0 +1,164 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package apply
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+)
+
+func TestRunDiff_ReportsDrift(t *testing.T) {
+	cl := setupApplyTest(t, testutil.RoundTripFunc(func(r *http.Request) (*http.Response, error) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/namespaces/team":
+			return testutil.JSONResp(http.StatusOK, map[string]any{
+				"metadata": map[string]any{"name": "team", "labels": map[string]any{"tier": "gold"}},
+				"status":   map[string]any{"phase": "Active"},
+			}), nil
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/namespaces/team/projects/shop":
+			return testutil.JSONResp(http.StatusNotFound, map[string]any{"error": "not found"}), nil
+		}
+		t.Fatalf("unexpected %s %s", r.Method, r.URL.Path)
+		return nil, nil
+	}))
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "setup.yaml"), []byte(`kind: Project
+metadata:
+  name: shop
+  namespace: team
+spec:
+  deploymentPipelineRef: default
+---
+kind: Namespace
+metadata:
+  name: team
+  labels:
+    tier: silver
+`), 0600))
+
+	var out bytes.Buffer
+	err := runDiff(context.Background(), cl.GetClient(), Params{FilePath: dir}, &out, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "2 of 2 resource(s) differ")
+
+	assert.Equal(t, `--- namespace/team (live)
++++ namespace/team (manifest)
+@@ -1,3 +1,3 @@
+ metadata:
+   labels:
+-    tier: gold
++    tier: silver
+--- project/shop (live)
++++ project/shop (manifest)
+@@ -0,0 +1,2 @@
++spec:
++  deploymentPipelineRef: default
+`, out.String())
+}
+
+func TestRunDiff_NoDrift(t *testing.T) {
+	cl := setupApplyTest(t, testutil.RoundTripFunc(func(r *http.Request) (*http.Response, error) {
+		if r.Method == http.MethodGet {
+			return testutil.JSONResp(http.StatusOK, map[string]any{
+				"metadata": map[string]any{"name": "team", "uid": "abc"},
+			}), nil
+		}
+		t.Fatalf("unexpected %s %s", r.Method, r.URL.Path)
+		return nil, nil
+	}))
+
+	dir := t.TempDir()
+	yamlFile := filepath.Join(dir, "ns.yaml")
+	require.NoError(t, os.WriteFile(yamlFile, []byte("kind: Namespace\nmetadata:\n  name: team\n"), 0600))
+
+	var out bytes.Buffer
+	require.NoError(t, runDiff(context.Background(), cl.GetClient(), Params{FilePath: yamlFile}, &out, true))
+	assert.Equal(t, "No differences found in 1 resource(s)\n", out.String())
+}
+
+func TestRunDiff_ResourceUsesDiffAPI(t *testing.T) {
+	var diffBody map[string]any
+	cl := setupApplyTest(t, testutil.RoundTripFunc(func(r *http.Request) (*http.Response, error) {
+		if r.Method == http.MethodPost && r.URL.Path == "/api/v1/namespaces/team/resources/db/diff" {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&diffBody))
+			return testutil.JSONResp(http.StatusOK, map[string]any{
+				"exists": true,
+				"changes": []map[string]any{
+					{"path": "metadata.labels.app.kubernetes.io/name", "operation": "add", "desired": "db"},
+					{"path": "spec.parameters.size", "operation": "replace", "current": "small", "desired": "large"},
+				},
+			}), nil
+		}
+		t.Fatalf("unexpected %s %s", r.Method, r.URL.Path)
+		return nil, nil
+	}))
+
+	dir := t.TempDir()
+	yamlFile := filepath.Join(dir, "db.yaml")
+	require.NoError(t, os.WriteFile(yamlFile, []byte(`kind: Resource
+metadata:
+  name: db
+  namespace: team
+`), 0600))
+
+	var out bytes.Buffer
+	err := runDiff(context.Background(), cl.GetClient(), Params{FilePath: yamlFile}, &out, false)
+	require.Error(t, err)
+	assert.Equal(t, "Resource", diffBody["kind"])
+	assert.Contains(t, out.String(), "+  labels:\n+    app.kubernetes.io/name: db\n")
+	assert.Contains(t, out.String(), "-    size: small\n+    size: large\n")
+}
+
+func TestRunDiff_Errors(t *testing.T) {
+	cl := setupApplyTest(t, testutil.RoundTripFunc(func(r *http.Request) (*http.Response, error) {
+		return testutil.JSONResp(http.StatusForbidden, map[string]any{"error": "forbidden"}), nil
+	}))
+
+	dir := t.TempDir()
+	yamlFile := filepath.Join(dir, "bad.yaml")
+	require.NoError(t, os.WriteFile(yamlFile, []byte(`kind: FakeResource
+metadata:
+  name: fake
+---
+kind: Namespace
+metadata:
+  name: team
+`), 0600))
+
+	var out bytes.Buffer
+	err := runDiff(context.Background(), cl.GetClient(), Params{FilePath: yamlFile}, &out, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "2 error(s)")
+	assert.Contains(t, out.String(), "unsupported kind")
+	assert.Contains(t, out.String(), "namespace/team: unexpected status 403 when getting live state: forbidden")
+}
+
+func TestSplitFieldPath(t *testing.T) {
+	assert.Equal(t, []string{"spec", "parameters", "size"}, splitFieldPath("spec.parameters.size"))
+	assert.Equal(t, []string{"metadata", "labels", "app.kubernetes.io/name"},
+		splitFieldPath("metadata.labels.app.kubernetes.io/name"))
+	assert.Equal(t, []string{"metadata", "annotations", "openchoreo.dev/owner"},
+		splitFieldPath("metadata.annotations.openchoreo.dev/owner"))
+}
+
+func TestColorizeDiffLine(t *testing.T) {
+	assert.Equal(t, ansiRed+"-a: 1"+ansiReset+"\n", colorizeDiffLine("-a: 1\n"))
+	assert.Equal(t, ansiGreen+"+a: 2"+ansiReset+"\n", colorizeDiffLine("+a: 2\n"))
+	assert.Equal(t, ansiBold+"--- x (live)"+ansiReset+"\n", colorizeDiffLine("--- x (live)\n"))
+	assert.Equal(t, ansiCyan+"@@ -1 +1 @@"+ansiReset+"\n", colorizeDiffLine("@@ -1 +1 @@\n"))
+	assert.Equal(t, " a: 1\n", colorizeDiffLine(" a: 1\n"))
+	assert.False(t, strings.Contains(colorizeDiffLine(" b\n"), "\033"))
+}
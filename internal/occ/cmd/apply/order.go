@@ -0,0 +1,69 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package apply
+
+import "sort"
+
+// kindOrder lists kinds in the order they are applied, so that the resources a
+// kind refers to exist before it: namespaces and planes first, then
+// environments, pipelines and types, then projects, components and their
+// releases. Kinds in the same group do not depend on each other. Kinds that
+// are not listed are applied last.
+var kindOrder = [][]string{
+	{"Namespace"},
+	{"ClusterAuthzRole", "AuthzRole"},
+	{"ClusterAuthzRoleBinding", "AuthzRoleBinding"},
+	{"ClusterDataPlane", "ClusterWorkflowPlane", "ClusterObservabilityPlane"},
+	{"DataPlane", "WorkflowPlane", "ObservabilityPlane"},
+	{"SecretReference", "ObservabilityAlertsNotificationChannel"},
+	{"Environment"},
+	{"DeploymentPipeline"},
+	{
+		"ClusterComponentType", "ComponentType", "ClusterTrait", "Trait",
+		"ClusterWorkflow", "Workflow", "ClusterResourceType", "ResourceType",
+		"ClusterProjectType", "ProjectType",
+	},
+	{"Project"},
+	{"Component", "Resource"},
+	{"Workload"},
+	{"ComponentRelease", "ResourceRelease", "ProjectRelease"},
+	{"ReleaseBinding", "ResourceReleaseBinding", "ProjectReleaseBinding"},
+	{"WorkflowRun"},
+}
+
+var kindRank = func() map[string]int {
+	rank := make(map[string]int)
+	for i, group := range kindOrder {
+		for _, kind := range group {
+			rank[kind] = i
+		}
+	}
+	return rank
+}()
+
+// dependencyRank returns the position of a kind in kindOrder.
+func dependencyRank(kind string) int {
+	if rank, ok := kindRank[kind]; ok {
+		return rank
+	}
+	return len(kindOrder)
+}
+
+// sortByDependency orders documents by kind dependency. Documents of kinds in
+// the same group keep their order in the manifests, except that Resources are
+// gathered together so they can be applied as one bundle.
+func sortByDependency(documents []resourceDocument) {
+	key := func(d resourceDocument) (int, bool) {
+		kind, _ := d.resource["kind"].(string)
+		return dependencyRank(kind), kind == bundleKind
+	}
+	sort.SliceStable(documents, func(i, j int) bool {
+		ri, bi := key(documents[i])
+		rj, bj := key(documents[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return !bi && bj
+	})
+}
@@ -5,7 +5,16 @@ package apply
 
 // Params defines parameters for applying configuration files.
 type Params struct {
-	FilePath string
+	FilePath  string
+	Recursive bool
+	// ApplySet labels the applied resources as members of a named set.
+	ApplySet string
+	// Prune deletes the resources of ApplySet that are not in the files.
+	Prune bool
+	// Yes deletes pruned resources without asking for confirmation.
+	Yes bool
+	// SchemaDir is the directory of the schemas to validate against.
+	SchemaDir string
 }
 
 // GetFilePath returns the file path.
@@ -0,0 +1,163 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package apply
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+// applySetLabel is the label that records the apply set a resource was
+// applied under. Prune deletes the resources of a set that are no longer in
+// the manifests.
+const applySetLabel = "openchoreo.dev/applyset"
+
+// pruneTarget is a live resource of the apply set that is not in the manifests.
+type pruneTarget struct {
+	kind      string
+	namespace string
+	name      string
+	path      string
+}
+
+func (t pruneTarget) String() string {
+	ref := fmt.Sprintf("%s/%s", strings.ToLower(t.kind), t.name)
+	if t.namespace != "" {
+		ref += fmt.Sprintf(" (namespace %s)", t.namespace)
+	}
+	return ref
+}
+
+// validateApplySet checks the apply set name, which is stored as a label value.
+func validateApplySet(name string) error {
+	if errs := validation.IsValidLabelValue(name); len(errs) > 0 {
+		return fmt.Errorf("invalid apply set name %q: %s", name, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// labelDocuments adds the apply set label to every document, so the server
+// records which set each resource belongs to.
+func labelDocuments(documents []resourceDocument, applySet string) {
+	for _, doc := range documents {
+		metadata, _ := doc.resource["metadata"].(map[string]interface{})
+		if metadata == nil {
+			continue
+		}
+		labels, _ := metadata["labels"].(map[string]interface{})
+		if labels == nil {
+			labels = map[string]interface{}{}
+			metadata["labels"] = labels
+		}
+		labels[applySetLabel] = applySet
+	}
+}
+
+// findPruneTargets lists the resources labelled with the apply set that are
+// not described by documents. Every kind apply supports is searched, cluster
+// kinds once and namespaced kinds in each namespace the manifests touch. The
+// targets are ordered for deletion: dependents before the resources they
+// depend on.
+func findPruneTargets(
+	ctx context.Context,
+	c *client.Client,
+	registry map[string]resourceEntry,
+	documents []resourceDocument,
+	defaultNamespace, applySet string,
+) ([]pruneTarget, error) {
+	desired := map[string]bool{}
+	namespaces := map[string]bool{}
+	for _, doc := range documents {
+		info, err := extractResourceInfo(doc.resource)
+		if err != nil {
+			continue
+		}
+		ns := ""
+		if entry, ok := registry[info.kind]; ok && entry.scope == scopeNamespaced {
+			if ns, err = resolveNamespace(info, defaultNamespace); err != nil {
+				continue
+			}
+			namespaces[ns] = true
+		}
+		if info.kind == "Namespace" {
+			namespaces[info.name] = true
+		}
+		desired[info.kind+"/"+ns+"/"+info.name] = true
+	}
+	if len(namespaces) == 0 && defaultNamespace != "" {
+		namespaces[defaultNamespace] = true
+	}
+
+	var targets []pruneTarget
+	for _, kind := range supportedKinds() {
+		columns, err := c.GetPrinterColumns(ctx, kind)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up kind %s: %w", kind, err)
+		}
+		scopes := []string{""}
+		if columns.Namespaced {
+			scopes = sortedKeys(namespaces)
+		}
+		for _, ns := range scopes {
+			path := client.CollectionPath(columns, ns)
+			items, err := pagination.FetchAll(func(limit int, cursor string) ([]map[string]any, string, error) {
+				return c.ListObjects(ctx, path, limit, cursor)
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list %s: %w", columns.Plural, err)
+			}
+			for _, item := range items {
+				metadata, _ := item["metadata"].(map[string]any)
+				labels, _ := metadata["labels"].(map[string]any)
+				name, _ := metadata["name"].(string)
+				if labels[applySetLabel] != applySet || name == "" || desired[kind+"/"+ns+"/"+name] {
+					continue
+				}
+				targets = append(targets, pruneTarget{kind: kind, namespace: ns, name: name, path: path + "/" + url.PathEscape(name)})
+			}
+		}
+	}
+
+	sort.SliceStable(targets, func(i, j int) bool {
+		ri, rj := dependencyRank(targets[i].kind), dependencyRank(targets[j].kind)
+		if ri != rj {
+			return ri > rj
+		}
+		return targets[i].String() < targets[j].String()
+	})
+	return targets, nil
+}
+
+// pruneResources deletes the targets and returns the number deleted and the
+// error of every target that was not.
+func pruneResources(ctx context.Context, c *client.Client, targets []pruneTarget) (int, []string) {
+	var errs []string
+	pruned := 0
+	for _, t := range targets {
+		if err := c.DeleteObject(ctx, t.path); err != nil {
+			errs = append(errs, fmt.Sprintf("%s/%s: prune failed: %v", strings.ToLower(t.kind), t.name, err))
+			continue
+		}
+		fmt.Printf("%s/%s pruned\n", strings.ToLower(t.kind), t.name)
+		pruned++
+	}
+	return pruned, errs
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
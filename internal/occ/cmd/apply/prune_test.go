@@ -0,0 +1,149 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package apply
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+)
+
+// pruneServer fakes the API for prune tests. Components acme/orders and
+// acme/legacy belong to apply set "store", acme/manual to no set; every other
+// collection is empty. Creates answer createStatus.
+type pruneServer struct {
+	createStatus int
+
+	mu      sync.Mutex
+	created []map[string]any
+	deleted []string
+}
+
+func (s *pruneServer) handle(r *http.Request) (*http.Response, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := r.URL.Path
+	switch {
+	case r.Method == http.MethodGet && strings.HasPrefix(path, "/api/v1/kinds/"):
+		kind := strings.TrimSuffix(strings.TrimPrefix(path, "/api/v1/kinds/"), "/printercolumns")
+		namespaced := kind != "Namespace" && !strings.HasPrefix(kind, "Cluster")
+		return testutil.JSONResp(http.StatusOK, map[string]any{
+			"kind": kind, "plural": strings.ToLower(kind) + "s", "namespaced": namespaced, "columns": []any{},
+		}), nil
+	case r.Method == http.MethodGet && path == "/api/v1/namespaces/acme/components":
+		return testutil.JSONResp(http.StatusOK, map[string]any{"items": []any{
+			component("orders", "store"), component("legacy", "store"), component("manual", ""),
+		}}), nil
+	case r.Method == http.MethodGet && strings.Count(path, "/") == 6:
+		return testutil.JSONResp(http.StatusNotFound, map[string]any{"error": "not found"}), nil
+	case r.Method == http.MethodGet:
+		return testutil.JSONResp(http.StatusOK, map[string]any{"items": []any{}}), nil
+	case r.Method == http.MethodPost:
+		var body map[string]any
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, &body)
+		s.created = append(s.created, body)
+		return testutil.JSONResp(s.createStatus, map[string]any{"error": "create rejected"}), nil
+	case r.Method == http.MethodDelete:
+		s.deleted = append(s.deleted, path)
+		return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody, Header: http.Header{}}, nil
+	}
+	return testutil.JSONResp(http.StatusNotFound, map[string]any{"error": "not found"}), nil
+}
+
+func component(name, applySet string) map[string]any {
+	labels := map[string]any{}
+	if applySet != "" {
+		labels[applySetLabel] = applySet
+	}
+	return map[string]any{"metadata": map[string]any{"name": name, "namespace": "acme", "labels": labels}}
+}
+
+func writePruneManifest(t *testing.T) string {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), "store.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(`kind: Component
+metadata:
+  name: orders
+  namespace: acme
+spec:
+  owner:
+    projectName: store
+`), 0600))
+	return file
+}
+
+func TestApply_PruneDeletesRemovedResources(t *testing.T) {
+	srv := &pruneServer{createStatus: http.StatusCreated}
+	cl := setupApplyTest(t, srv.handle)
+	file := writePruneManifest(t)
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, Apply(cl, Params{FilePath: file, ApplySet: "store", Prune: true, Yes: true}))
+	})
+
+	assert.Contains(t, out, "component/legacy (namespace acme)")
+	assert.Contains(t, out, "component/orders created")
+	assert.Contains(t, out, "component/legacy pruned")
+	assert.Contains(t, out, "Applied 1 resource(s) from 1 file(s), pruned 1 resource(s)")
+	assert.Equal(t, []string{"/api/v1/namespaces/acme/components/legacy"}, srv.deleted)
+	require.Len(t, srv.created, 1)
+	labels := srv.created[0]["metadata"].(map[string]any)["labels"].(map[string]any)
+	assert.Equal(t, "store", labels[applySetLabel])
+}
+
+func TestApply_PruneDeclined(t *testing.T) {
+	srv := &pruneServer{createStatus: http.StatusCreated}
+	cl := setupApplyTest(t, srv.handle)
+	file := writePruneManifest(t)
+
+	out := testutil.CaptureStdout(t, func() {
+		p := utils.NewPrompter(strings.NewReader("n\n"), os.Stdout)
+		require.NoError(t, apply(cl, Params{FilePath: file, ApplySet: "store", Prune: true}, p))
+	})
+
+	assert.Contains(t, out, "Delete 1 resource(s)? [y/N]")
+	assert.Contains(t, out, "Aborted, no changes were made")
+	assert.Empty(t, srv.created)
+	assert.Empty(t, srv.deleted)
+}
+
+func TestApply_PruneSkippedOnApplyError(t *testing.T) {
+	srv := &pruneServer{createStatus: http.StatusBadRequest}
+	cl := setupApplyTest(t, srv.handle)
+	file := writePruneManifest(t)
+
+	out := testutil.CaptureStdout(t, func() {
+		err := Apply(cl, Params{FilePath: file, ApplySet: "store", Prune: true, Yes: true})
+		assert.EqualError(t, err, "apply completed with 1 error(s)")
+	})
+
+	assert.Contains(t, out, "Skipped pruning 1 resource(s) because the apply had errors")
+	assert.Empty(t, srv.deleted)
+}
+
+func TestApply_PruneValidation(t *testing.T) {
+	cl := setupApplyTest(t, testutil.RoundTripFunc(func(_ *http.Request) (*http.Response, error) {
+		t.Fatal("no HTTP call expected")
+		return nil, nil
+	}))
+
+	err := Apply(cl, Params{FilePath: "x.yaml", Prune: true})
+	assert.EqualError(t, err, "--prune requires --applyset to select the resources to prune")
+
+	err = Apply(cl, Params{FilePath: "x.yaml", ApplySet: "not a label"})
+	assert.ErrorContains(t, err, `invalid apply set name "not a label"`)
+}
@@ -35,8 +35,8 @@ var readOnlyKinds = map[string]bool{
 	"RenderedRelease": true,
 }
 
-// getFn checks if a resource exists. Returns the HTTP status code.
-type getFn func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error)
+// getFn fetches a resource. Returns the HTTP status code and response body.
+type getFn func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error)
 
 // createFn creates a resource. Returns status code and response body.
 type createFn func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error)
@@ -63,12 +63,12 @@ func getResourceRegistry() map[string]resourceEntry {
 func addClusterScopedResources(reg map[string]resourceEntry) {
 	reg["Namespace"] = resourceEntry{
 		scope: scopeCluster,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, error) {
-			r, err := c.GetNamespaceWithResponse(ctx, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
+			r, err := c.GetNamespaceWithResponse(ctx, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, _ string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateNamespaceWithBodyWithResponse(ctx, contentTypeJSON, body)
@@ -88,12 +88,12 @@ func addClusterScopedResources(reg map[string]resourceEntry) {
 
 	reg["ClusterComponentType"] = resourceEntry{
 		scope: scopeCluster,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, error) {
-			r, err := c.GetClusterComponentTypeWithResponse(ctx, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
+			r, err := c.GetClusterComponentTypeWithResponse(ctx, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, _ string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateClusterComponentTypeWithBodyWithResponse(ctx, contentTypeJSON, body)
@@ -113,12 +113,12 @@ func addClusterScopedResources(reg map[string]resourceEntry) {
 
 	reg["ClusterTrait"] = resourceEntry{
 		scope: scopeCluster,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, error) {
-			r, err := c.GetClusterTraitWithResponse(ctx, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
+			r, err := c.GetClusterTraitWithResponse(ctx, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, _ string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateClusterTraitWithBodyWithResponse(ctx, contentTypeJSON, body)
@@ -138,12 +138,12 @@ func addClusterScopedResources(reg map[string]resourceEntry) {
 
 	reg["ClusterWorkflowPlane"] = resourceEntry{
 		scope: scopeCluster,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, error) {
-			r, err := c.GetClusterWorkflowPlaneWithResponse(ctx, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
+			r, err := c.GetClusterWorkflowPlaneWithResponse(ctx, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, _ string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateClusterWorkflowPlaneWithBodyWithResponse(ctx, contentTypeJSON, body)
@@ -163,12 +163,12 @@ func addClusterScopedResources(reg map[string]resourceEntry) {
 
 	reg["ClusterWorkflow"] = resourceEntry{
 		scope: scopeCluster,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, error) {
-			r, err := c.GetClusterWorkflowWithResponse(ctx, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
+			r, err := c.GetClusterWorkflowWithResponse(ctx, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, _ string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateClusterWorkflowWithBodyWithResponse(ctx, contentTypeJSON, body)
@@ -188,12 +188,12 @@ func addClusterScopedResources(reg map[string]resourceEntry) {
 
 	reg["ClusterDataPlane"] = resourceEntry{
 		scope: scopeCluster,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, error) {
-			r, err := c.GetClusterDataPlaneWithResponse(ctx, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
+			r, err := c.GetClusterDataPlaneWithResponse(ctx, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, _ string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateClusterDataPlaneWithBodyWithResponse(ctx, contentTypeJSON, body)
@@ -213,12 +213,12 @@ func addClusterScopedResources(reg map[string]resourceEntry) {
 
 	reg["ClusterObservabilityPlane"] = resourceEntry{
 		scope: scopeCluster,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, error) {
-			r, err := c.GetClusterObservabilityPlaneWithResponse(ctx, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
+			r, err := c.GetClusterObservabilityPlaneWithResponse(ctx, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, _ string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateClusterObservabilityPlaneWithBodyWithResponse(ctx, contentTypeJSON, body)
@@ -238,12 +238,12 @@ func addClusterScopedResources(reg map[string]resourceEntry) {
 
 	reg["ClusterAuthzRole"] = resourceEntry{
 		scope: scopeCluster,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, error) {
-			r, err := c.GetClusterRoleWithResponse(ctx, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
+			r, err := c.GetClusterRoleWithResponse(ctx, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, _ string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateClusterRoleWithBodyWithResponse(ctx, contentTypeJSON, body)
@@ -263,12 +263,12 @@ func addClusterScopedResources(reg map[string]resourceEntry) {
 
 	reg["ClusterAuthzRoleBinding"] = resourceEntry{
 		scope: scopeCluster,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, error) {
-			r, err := c.GetClusterRoleBindingWithResponse(ctx, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
+			r, err := c.GetClusterRoleBindingWithResponse(ctx, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, _ string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateClusterRoleBindingWithBodyWithResponse(ctx, contentTypeJSON, body)
@@ -288,12 +288,12 @@ func addClusterScopedResources(reg map[string]resourceEntry) {
 
 	reg["ClusterResourceType"] = resourceEntry{
 		scope: scopeCluster,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, error) {
-			r, err := c.GetClusterResourceTypeWithResponse(ctx, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
+			r, err := c.GetClusterResourceTypeWithResponse(ctx, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, _ string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateClusterResourceTypeWithBodyWithResponse(ctx, contentTypeJSON, body)
@@ -313,12 +313,12 @@ func addClusterScopedResources(reg map[string]resourceEntry) {
 
 	reg["ClusterProjectType"] = resourceEntry{
 		scope: scopeCluster,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, error) {
-			r, err := c.GetClusterProjectTypeWithResponse(ctx, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, _, name string) (int, []byte, error) {
+			r, err := c.GetClusterProjectTypeWithResponse(ctx, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, _ string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateClusterProjectTypeWithBodyWithResponse(ctx, contentTypeJSON, body)
@@ -341,12 +341,12 @@ func addClusterScopedResources(reg map[string]resourceEntry) {
 func addNamespacedScopedResources(reg map[string]resourceEntry) {
 	reg["Project"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetProjectWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetProjectWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateProjectWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -366,12 +366,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 
 	reg["Component"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetComponentWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetComponentWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateComponentWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -391,12 +391,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 
 	reg["ComponentType"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetComponentTypeWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetComponentTypeWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateComponentTypeWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -416,12 +416,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 
 	reg["Environment"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetEnvironmentWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetEnvironmentWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateEnvironmentWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -441,12 +441,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 
 	reg["DataPlane"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetDataPlaneWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetDataPlaneWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateDataPlaneWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -466,12 +466,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 
 	reg["WorkflowPlane"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetWorkflowPlaneWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetWorkflowPlaneWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateWorkflowPlaneWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -491,12 +491,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 
 	reg["ObservabilityPlane"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetObservabilityPlaneWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetObservabilityPlaneWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateObservabilityPlaneWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -516,12 +516,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 
 	reg["DeploymentPipeline"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetDeploymentPipelineWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetDeploymentPipelineWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateDeploymentPipelineWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -541,12 +541,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 
 	reg["Trait"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetTraitWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetTraitWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateTraitWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -566,12 +566,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 
 	reg["SecretReference"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetSecretReferenceWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetSecretReferenceWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateSecretReferenceWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -591,12 +591,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 
 	reg["Workflow"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetWorkflowWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetWorkflowWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateWorkflowWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -616,12 +616,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 
 	reg["Workload"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetWorkloadWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetWorkloadWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateWorkloadWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -642,12 +642,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 	reg["ComponentRelease"] = resourceEntry{
 		scope:      scopeNamespaced,
 		capability: capCreateOnly,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetComponentReleaseWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetComponentReleaseWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateComponentReleaseWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -660,12 +660,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 
 	reg["ReleaseBinding"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetReleaseBindingWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetReleaseBindingWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateReleaseBindingWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -685,12 +685,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 
 	reg["ObservabilityAlertsNotificationChannel"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetObservabilityAlertsNotificationChannelWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetObservabilityAlertsNotificationChannelWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateObservabilityAlertsNotificationChannelWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -710,12 +710,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 
 	reg["AuthzRole"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetNamespaceRoleWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetNamespaceRoleWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateNamespaceRoleWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -735,12 +735,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 
 	reg["AuthzRoleBinding"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetNamespaceRoleBindingWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetNamespaceRoleBindingWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateNamespaceRoleBindingWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -760,12 +760,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 
 	reg["ResourceType"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetResourceTypeWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetResourceTypeWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateResourceTypeWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -785,12 +785,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 
 	reg["ProjectType"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetProjectTypeWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetProjectTypeWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateProjectTypeWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -810,12 +810,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 
 	reg["Resource"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetResourceWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetResourceWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateResourceWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -835,12 +835,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 
 	reg["ResourceReleaseBinding"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetResourceReleaseBindingWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetResourceReleaseBindingWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateResourceReleaseBindingWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -860,12 +860,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 
 	reg["ProjectReleaseBinding"] = resourceEntry{
 		scope: scopeNamespaced,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetProjectReleaseBindingWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetProjectReleaseBindingWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateProjectReleaseBindingWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -888,12 +888,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 	reg["WorkflowRun"] = resourceEntry{
 		scope:      scopeNamespaced,
 		capability: capCreateOnly,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetWorkflowRunWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetWorkflowRunWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateWorkflowRunWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -907,12 +907,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 	reg["ResourceRelease"] = resourceEntry{
 		scope:      scopeNamespaced,
 		capability: capCreateOnly,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetResourceReleaseWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetResourceReleaseWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateResourceReleaseWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
@@ -926,12 +926,12 @@ func addNamespacedScopedResources(reg map[string]resourceEntry) {
 	reg["ProjectRelease"] = resourceEntry{
 		scope:      scopeNamespaced,
 		capability: capCreateOnly,
-		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, error) {
-			r, err := c.GetProjectReleaseWithResponse(ctx, ns, name)
+		get: func(ctx context.Context, c *gen.ClientWithResponses, ns, name string) (int, []byte, error) {
+			r, err := c.GetProjectReleaseWithResponse(ctx, ns, name, nil)
 			if err != nil {
-				return 0, err
+				return 0, nil, err
 			}
-			return r.StatusCode(), nil
+			return r.StatusCode(), r.Body, nil
 		},
 		create: func(ctx context.Context, c *gen.ClientWithResponses, ns string, body io.Reader) (int, []byte, error) {
 			r, err := c.CreateProjectReleaseWithBodyWithResponse(ctx, ns, contentTypeJSON, body)
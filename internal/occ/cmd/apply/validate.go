@@ -0,0 +1,213 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/pruning"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/schema"
+)
+
+// kindValidator validates resources of one kind against its schema.
+type kindValidator struct {
+	validator  validation.SchemaValidator
+	structural *structuralschema.Structural
+}
+
+// Validate checks the resources of the specified file, directory or glob
+// pattern against the schemas of their kinds, without contacting the control
+// plane. It returns an error when any resource is invalid, so that the command
+// exits non-zero.
+func Validate(params Params) error {
+	return runValidate(context.Background(), params, os.Stdout)
+}
+
+func runValidate(ctx context.Context, params Params, out io.Writer) error {
+	if params.FilePath == "" {
+		return fmt.Errorf("file path is required")
+	}
+
+	schemas, err := loadSchemas(params.SchemaDir)
+	if err != nil {
+		return err
+	}
+
+	resourceFiles, err := discoverResourceFiles(params.FilePath, params.Recursive)
+	if err != nil {
+		return fmt.Errorf("failed to discover resources: %w", err)
+	}
+	if len(resourceFiles) == 0 {
+		return fmt.Errorf("no YAML files found in: %s", params.FilePath)
+	}
+
+	documents, errs := loadDocuments(ctx, resourceFiles)
+	validators := map[k8sschema.GroupVersionKind]*kindValidator{}
+	valid, skipped := 0, 0
+	for _, doc := range documents {
+		ref := resourceRef(doc.resource)
+		gvk, err := resourceGVK(doc.resource)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s: %v", doc.source, ref, err))
+			continue
+		}
+		props, ok := schemas[gvk]
+		if !ok {
+			if schemas.HasGroup(gvk.Group) {
+				errs = append(errs, fmt.Sprintf("%s: %s: unknown kind %s in %s", doc.source, ref, gvk.Kind, gvk.GroupVersion()))
+			} else {
+				skipped++
+			}
+			continue
+		}
+
+		v, ok := validators[gvk]
+		if !ok {
+			if v, err = newKindValidator(props); err != nil {
+				return fmt.Errorf("invalid schema of %s: %w", gvk.Kind, err)
+			}
+			validators[gvk] = v
+		}
+		problems, err := v.validate(doc.resource)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s: %v", doc.source, ref, err))
+			continue
+		}
+		for _, p := range problems {
+			errs = append(errs, fmt.Sprintf("%s: %s: %s", doc.source, ref, p))
+		}
+		if len(problems) == 0 {
+			valid++
+		}
+	}
+
+	for _, e := range errs {
+		fmt.Fprintf(out, "Error: %s\n", e)
+	}
+	summary := fmt.Sprintf("%d of %d resource(s) valid", valid, len(documents)-skipped)
+	if skipped > 0 {
+		summary += fmt.Sprintf(", %d resource(s) of other API groups skipped", skipped)
+	}
+	fmt.Fprintln(out, summary)
+	if len(errs) > 0 {
+		return fmt.Errorf("validation failed with %d error(s)", len(errs))
+	}
+	return nil
+}
+
+// loadSchemas returns the schemas cached in dir. Without a dir, the schemas
+// cached by occ schema pull are used, falling back to those built into occ
+// when none are cached.
+func loadSchemas(dir string) (schema.Set, error) {
+	if dir != "" {
+		schemas, err := schema.Load(dir)
+		if err != nil {
+			return nil, err
+		}
+		if len(schemas) == 0 {
+			return nil, fmt.Errorf("no schemas found in %s; run occ schema pull --dir %s", dir, dir)
+		}
+		return schemas, nil
+	}
+
+	if defaultDir, err := schema.DefaultDir(); err == nil {
+		schemas, err := schema.Load(defaultDir)
+		if err != nil {
+			return nil, err
+		}
+		if len(schemas) > 0 {
+			return schemas, nil
+		}
+	}
+	return schema.Embedded()
+}
+
+// resourceRef returns Kind/name of a resource for messages, with the parts
+// that are missing left out.
+func resourceRef(resource map[string]interface{}) string {
+	kind, _ := resource["kind"].(string)
+	metadata, _ := resource["metadata"].(map[string]interface{})
+	if name, _ := metadata["name"].(string); name != "" {
+		return kind + "/" + name
+	}
+	return kind
+}
+
+func resourceGVK(resource map[string]interface{}) (k8sschema.GroupVersionKind, error) {
+	kind, _ := resource["kind"].(string)
+	apiVersion, _ := resource["apiVersion"].(string)
+	if apiVersion == "" {
+		return k8sschema.GroupVersionKind{}, fmt.Errorf("resource is missing 'apiVersion'")
+	}
+	gv, err := k8sschema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return k8sschema.GroupVersionKind{}, fmt.Errorf("invalid apiVersion %q: %w", apiVersion, err)
+	}
+	return gv.WithKind(kind), nil
+}
+
+func newKindValidator(props *extv1.JSONSchemaProps) (*kindValidator, error) {
+	internal := &apiext.JSONSchemaProps{}
+	if err := extv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(props, internal, nil); err != nil {
+		return nil, err
+	}
+	validator, _, err := validation.NewSchemaValidator(internal)
+	if err != nil {
+		return nil, err
+	}
+	structural, err := structuralschema.NewStructural(internal)
+	if err != nil {
+		return nil, err
+	}
+	return &kindValidator{validator: validator, structural: structural}, nil
+}
+
+// validate returns the problems of a resource: a missing name, values that do
+// not match the schema, and fields the schema does not declare, which the
+// API server would drop.
+func (v *kindValidator) validate(resource map[string]interface{}) ([]string, error) {
+	obj, err := toJSONObject(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []string
+	metadata, _ := obj["metadata"].(map[string]interface{})
+	if name, _ := metadata["name"].(string); name == "" {
+		problems = append(problems, "metadata.name: Required value")
+	}
+	for _, e := range validation.ValidateCustomResource(nil, obj, v.validator) {
+		problems = append(problems, e.Error())
+	}
+	unknown := pruning.PruneWithOptions(obj, v.structural, true, structuralschema.UnknownFieldPathOptions{TrackUnknownFieldPaths: true})
+	for _, path := range unknown {
+		problems = append(problems, fmt.Sprintf("%s: unknown field", path))
+	}
+	return problems, nil
+}
+
+// toJSONObject copies a resource decoded from YAML into the types the schema
+// validator expects, with integers as int64 and other numbers as float64.
+func toJSONObject(resource map[string]interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert resource to JSON: %w", err)
+	}
+	var obj map[string]interface{}
+	if err := utiljson.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("failed to convert resource to JSON: %w", err)
+	}
+	return obj, nil
+}
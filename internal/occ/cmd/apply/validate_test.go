@@ -0,0 +1,178 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package apply
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+)
+
+const validProject = `apiVersion: openchoreo.dev/v1alpha1
+kind: Project
+metadata:
+  name: online-store
+  namespace: default
+spec:
+  deploymentPipelineRef:
+    name: default
+  type:
+    name: default
+`
+
+func writeManifest(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestRunValidate_EmbeddedSchemas(t *testing.T) {
+	testutil.SetupTestHome(t)
+
+	tests := []struct {
+		name     string
+		manifest string
+		wantErr  bool
+		want     []string
+	}{
+		{
+			name:     "valid resource",
+			manifest: validProject,
+			want:     []string{"1 of 1 resource(s) valid"},
+		},
+		{
+			name: "missing required field",
+			manifest: `apiVersion: openchoreo.dev/v1alpha1
+kind: Project
+metadata:
+  name: online-store
+spec:
+  type:
+    name: default
+`,
+			wantErr: true,
+			want:    []string{"Project/online-store: spec.deploymentPipelineRef: Required value", "0 of 1 resource(s) valid"},
+		},
+		{
+			name: "invalid value",
+			manifest: `apiVersion: openchoreo.dev/v1alpha1
+kind: Project
+metadata:
+  name: online-store
+spec:
+  deploymentPipelineRef:
+    name: default
+  type:
+    kind: Pipeline
+    name: default
+`,
+			wantErr: true,
+			want:    []string{`spec.type.kind: Unsupported value: "Pipeline"`},
+		},
+		{
+			name: "unknown field",
+			manifest: `apiVersion: openchoreo.dev/v1alpha1
+kind: Project
+metadata:
+  name: online-store
+spec:
+  deploymentPipelineRef:
+    name: default
+  type:
+    name: default
+  owner: team-a
+`,
+			wantErr: true,
+			want:    []string{"Project/online-store: spec.owner: unknown field"},
+		},
+		{
+			name: "missing name",
+			manifest: `apiVersion: openchoreo.dev/v1alpha1
+kind: Project
+spec:
+  deploymentPipelineRef:
+    name: default
+  type:
+    name: default
+`,
+			wantErr: true,
+			want:    []string{"Project: metadata.name: Required value"},
+		},
+		{
+			name:     "unknown kind",
+			manifest: "apiVersion: openchoreo.dev/v1alpha1\nkind: Widget\nmetadata:\n  name: w\n",
+			wantErr:  true,
+			want:     []string{"Widget/w: unknown kind Widget in openchoreo.dev/v1alpha1"},
+		},
+		{
+			name:     "other API group",
+			manifest: validProject + "---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: settings\n",
+			want:     []string{"1 of 1 resource(s) valid, 1 resource(s) of other API groups skipped"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeManifest(t, t.TempDir(), "project.yaml", tt.manifest)
+
+			var out bytes.Buffer
+			err := runValidate(context.Background(), Params{FilePath: path}, &out)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err, out.String())
+			}
+			for _, want := range tt.want {
+				assert.Contains(t, out.String(), want)
+			}
+		})
+	}
+}
+
+func TestRunValidate_SchemaDir(t *testing.T) {
+	testutil.SetupTestHome(t)
+	schemaDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(schemaDir, "openchoreo.dev"), 0o755))
+	writeManifest(t, filepath.Join(schemaDir, "openchoreo.dev"), "widget_v1alpha1.json", `{
+  "kind": "Widget", "group": "openchoreo.dev", "version": "v1alpha1",
+  "schema": {"type": "object", "properties": {"spec": {"type": "object", "required": ["size"],
+    "properties": {"size": {"type": "integer", "minimum": 1}}}}}
+}`)
+	dir := t.TempDir()
+	writeManifest(t, dir, "a.yaml", "apiVersion: openchoreo.dev/v1alpha1\nkind: Widget\nmetadata:\n  name: a\nspec:\n  size: 2\n")
+	writeManifest(t, dir, "b.yaml", "apiVersion: openchoreo.dev/v1alpha1\nkind: Widget\nmetadata:\n  name: b\nspec:\n  size: 0\n")
+
+	var out bytes.Buffer
+	err := runValidate(context.Background(), Params{FilePath: dir, SchemaDir: schemaDir}, &out)
+	require.EqualError(t, err, "validation failed with 1 error(s)")
+	assert.Contains(t, out.String(), "b.yaml: Widget/b: spec.size: Invalid value: 0: spec.size in body should be greater than or equal to 1")
+	assert.Contains(t, out.String(), "1 of 2 resource(s) valid")
+	assert.NotContains(t, out.String(), "a.yaml")
+
+	// Projects are unknown to the schemas of the directory.
+	path := writeManifest(t, t.TempDir(), "project.yaml", validProject)
+	out.Reset()
+	require.Error(t, runValidate(context.Background(), Params{FilePath: path, SchemaDir: schemaDir}, &out))
+	assert.Contains(t, out.String(), "unknown kind Project")
+}
+
+func TestRunValidate_EmptySchemaDir(t *testing.T) {
+	dir := t.TempDir()
+	path := writeManifest(t, dir, "project.yaml", validProject)
+
+	err := runValidate(context.Background(), Params{FilePath: path, SchemaDir: filepath.Join(dir, "schemas")}, &bytes.Buffer{})
+	require.ErrorContains(t, err, "no schemas found in")
+}
+
+func TestRunValidate_NoFile(t *testing.T) {
+	err := runValidate(context.Background(), Params{}, &bytes.Buffer{})
+	require.EqualError(t, err, "file path is required")
+}
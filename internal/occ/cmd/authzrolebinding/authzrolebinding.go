@@ -9,11 +9,10 @@ import (
 	"os"
 	"text/tabwriter"
 
-	"sigs.k8s.io/yaml"
-
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
 	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/printer"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 )
@@ -55,10 +54,13 @@ func (r *AuthzRoleBinding) List(params ListParams) error {
 	if err != nil {
 		return fmt.Errorf("failed to list authz role bindings: %w", err)
 	}
+	if params.Output != "" {
+		return printer.PrintList(os.Stdout, items, params.Output)
+	}
 	return printList(items)
 }
 
-// Get retrieves a single authz role binding and outputs it as YAML
+// Get retrieves a single authz role binding and prints it in the requested output format, YAML by default
 func (r *AuthzRoleBinding) Get(params GetParams) error {
 	if err := cmdutil.RequireFields("get", "authzrolebinding", map[string]string{"namespace": params.Namespace}); err != nil {
 		return err
@@ -71,13 +73,7 @@ func (r *AuthzRoleBinding) Get(params GetParams) error {
 		return fmt.Errorf("failed to get authz role binding: %w", err)
 	}
 
-	data, err := yaml.Marshal(result)
-	if err != nil {
-		return fmt.Errorf("failed to marshal authz role binding to YAML: %w", err)
-	}
-
-	fmt.Print(string(data))
-	return nil
+	return printer.Print(os.Stdout, result, params.Output)
 }
 
 // Delete deletes a single authz role binding
@@ -6,6 +6,7 @@ package authzrolebinding
 // ListParams defines parameters for listing authz role bindings
 type ListParams struct {
 	Namespace string
+	Output    string // --output format
 }
 
 // GetNamespace returns the namespace
@@ -15,6 +16,7 @@ func (p ListParams) GetNamespace() string { return p.Namespace }
 type GetParams struct {
 	Namespace string
 	Name      string
+	Output    string // --output format
 }
 
 // GetNamespace returns the namespace
@@ -0,0 +1,223 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/workflowrun"
+	"github.com/openchoreo/openchoreo/internal/occ/printer"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+// componentLabel identifies the workflow runs of a component.
+const componentLabel = "openchoreo.dev/component"
+
+// pollInterval is the time between status checks while waiting for a build.
+var pollInterval = 5 * time.Second
+
+type Build struct {
+	client client.Interface
+}
+
+func New(c client.Interface) *Build {
+	return &Build{client: c}
+}
+
+// Trigger starts a build of a component. With Wait set, it returns once the
+// build finishes, with an error unless the build succeeded.
+func (b *Build) Trigger(params TriggerParams) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	return b.trigger(ctx, os.Stdout, params)
+}
+
+func (b *Build) trigger(ctx context.Context, out io.Writer, params TriggerParams) error {
+	if params.Namespace == "" {
+		return fmt.Errorf("namespace is required")
+	}
+	if params.ComponentName == "" {
+		return fmt.Errorf("component name is required")
+	}
+
+	req := gen.TriggerComponentBuildRequest{}
+	if params.Ref != "" {
+		req.Commit = &params.Ref
+	}
+	build, err := b.client.TriggerComponentBuild(ctx, params.Namespace, params.ComponentName, req)
+	if err != nil {
+		return err
+	}
+	if build.Commit != nil {
+		fmt.Fprintf(out, "Build %s triggered for component %s at commit %s\n", build.Name, params.ComponentName, *build.Commit)
+	} else {
+		fmt.Fprintf(out, "Build %s triggered for component %s\n", build.Name, params.ComponentName)
+	}
+
+	if !params.Wait {
+		return nil
+	}
+	return b.wait(ctx, out, params.Namespace, build.Name, params.Timeout)
+}
+
+// List prints the builds of a component, newest first.
+func (b *Build) List(params ListParams) error {
+	if params.Namespace == "" {
+		return fmt.Errorf("namespace is required")
+	}
+	if params.ComponentName == "" {
+		return fmt.Errorf("component name is required")
+	}
+
+	ctx := context.Background()
+	selector := componentLabel + "=" + params.ComponentName
+	runs, err := pagination.FetchAll(func(limit int, cursor string) ([]gen.WorkflowRun, string, error) {
+		p := &gen.ListWorkflowRunsParams{Limit: &limit, LabelSelector: &selector}
+		if cursor != "" {
+			p.Cursor = &cursor
+		}
+		result, err := b.client.ListWorkflowRuns(ctx, params.Namespace, p)
+		if err != nil {
+			return nil, "", err
+		}
+		next := ""
+		if result.Pagination.NextCursor != nil {
+			next = *result.Pagination.NextCursor
+		}
+		return result.Items, next, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sort.SliceStable(runs, func(i, j int) bool {
+		ti, tj := runs[i].Metadata.CreationTimestamp, runs[j].Metadata.CreationTimestamp
+		if ti == nil || tj == nil {
+			return tj == nil && ti != nil
+		}
+		return ti.After(*tj)
+	})
+	if params.Output != "" {
+		return printer.PrintList(os.Stdout, runs, params.Output)
+	}
+	return workflowrun.PrintList(runs)
+}
+
+// Status prints the status of a build and its steps. With Wait set, it
+// returns once the build finishes, with an error unless the build succeeded.
+func (b *Build) Status(params StatusParams) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	return b.status(ctx, os.Stdout, params)
+}
+
+func (b *Build) status(ctx context.Context, out io.Writer, params StatusParams) error {
+	if params.Namespace == "" {
+		return fmt.Errorf("namespace is required")
+	}
+	if params.BuildName == "" {
+		return fmt.Errorf("build name is required")
+	}
+
+	if params.Wait {
+		return b.wait(ctx, out, params.Namespace, params.BuildName, params.Timeout)
+	}
+	st, err := b.client.GetWorkflowRunStatus(ctx, params.Namespace, params.BuildName)
+	if err != nil {
+		return err
+	}
+	return printStatus(out, params.BuildName, st)
+}
+
+// wait polls the status of a build until it finishes, printing each change
+// of its status, then the final status. A build that did not succeed is
+// returned as an error.
+func (b *Build) wait(ctx context.Context, out io.Writer, namespace, name string, timeout time.Duration) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	last := gen.WorkflowRunStatusResponseStatus("")
+	for {
+		st, err := b.client.GetWorkflowRunStatus(ctx, namespace, name)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return waitError(ctxErr, name, timeout)
+		}
+		if err != nil {
+			return err
+		}
+		if st.Status != last {
+			fmt.Fprintf(out, "%s  %s\n", time.Now().Format(time.TimeOnly), st.Status)
+			last = st.Status
+		}
+
+		switch st.Status {
+		case gen.WorkflowRunStatusResponseStatusSucceeded:
+			return printStatus(out, name, st)
+		case gen.WorkflowRunStatusResponseStatusFailed, gen.WorkflowRunStatusResponseStatusError:
+			if err := printStatus(out, name, st); err != nil {
+				return err
+			}
+			return fmt.Errorf("build %s %s", name, strings.ToLower(string(st.Status)))
+		}
+
+		select {
+		case <-ctx.Done():
+			return waitError(ctx.Err(), name, timeout)
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+func waitError(err error, name string, timeout time.Duration) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("timed out after %s waiting for build %s", timeout, name)
+	}
+	return err
+}
+
+func printStatus(out io.Writer, name string, st *gen.WorkflowRunStatusResponse) error {
+	fmt.Fprintf(out, "Build:  %s\n", name)
+	fmt.Fprintf(out, "Status: %s\n", st.Status)
+	if st.Image != nil && *st.Image != "" {
+		fmt.Fprintf(out, "Image:  %s\n", *st.Image)
+	}
+	if len(st.Steps) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(out)
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "STEP\tPHASE\tDURATION")
+	for _, step := range st.Steps {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", step.Name, step.Phase, stepDuration(step))
+	}
+	return w.Flush()
+}
+
+// stepDuration returns how long a step ran, or has been running.
+func stepDuration(step gen.WorkflowStepStatus) string {
+	if step.StartedAt == nil {
+		return "-"
+	}
+	end := time.Now()
+	if step.FinishedAt != nil {
+		end = *step.FinishedAt
+	}
+	return end.Sub(*step.StartedAt).Round(time.Second).String()
+}
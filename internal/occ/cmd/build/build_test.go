@@ -0,0 +1,122 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+func setPollInterval(t *testing.T, d time.Duration) {
+	t.Helper()
+	orig := pollInterval
+	pollInterval = d
+	t.Cleanup(func() { pollInterval = orig })
+}
+
+func runStatus(status gen.WorkflowRunStatusResponseStatus) *gen.WorkflowRunStatusResponse {
+	started := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &gen.WorkflowRunStatusResponse{
+		Status: status,
+		Steps: []gen.WorkflowStepStatus{
+			{Name: "checkout", Phase: "Succeeded", StartedAt: &started, FinishedAt: ptr.To(started.Add(12 * time.Second))},
+			{Name: "build", Phase: gen.WorkflowStepStatusPhase(status)},
+		},
+	}
+}
+
+func TestTrigger(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().TriggerComponentBuild(mock.Anything, "acme", "orders", gen.TriggerComponentBuildRequest{Commit: ptr.To("3f2a9c1")}).
+		Return(&gen.ComponentBuild{Name: "orders-run-1a2b3c4d", Commit: ptr.To("3f2a9c1")}, nil)
+
+	var out bytes.Buffer
+	require.NoError(t, New(mc).trigger(context.Background(), &out, TriggerParams{Namespace: "acme", ComponentName: "orders", Ref: "3f2a9c1"}))
+	assert.Equal(t, "Build orders-run-1a2b3c4d triggered for component orders at commit 3f2a9c1\n", out.String())
+}
+
+func TestTrigger_Wait(t *testing.T) {
+	setPollInterval(t, time.Millisecond)
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().TriggerComponentBuild(mock.Anything, "acme", "orders", gen.TriggerComponentBuildRequest{}).
+		Return(&gen.ComponentBuild{Name: "orders-run-1"}, nil)
+	mc.EXPECT().GetWorkflowRunStatus(mock.Anything, "acme", "orders-run-1").Return(runStatus("Running"), nil).Twice()
+	mc.EXPECT().GetWorkflowRunStatus(mock.Anything, "acme", "orders-run-1").Return(runStatus("Succeeded"), nil).Once()
+
+	var out bytes.Buffer
+	require.NoError(t, New(mc).trigger(context.Background(), &out, TriggerParams{Namespace: "acme", ComponentName: "orders", Wait: true}))
+	got := out.String()
+	assert.Equal(t, 1, strings.Count(got, "  Running\n"))
+	assert.Contains(t, got, "Status: Succeeded")
+	assert.Regexp(t, `checkout\s+Succeeded\s+12s`, got)
+}
+
+func TestStatus_WaitFailed(t *testing.T) {
+	setPollInterval(t, time.Millisecond)
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetWorkflowRunStatus(mock.Anything, "acme", "orders-run-1").Return(runStatus("Failed"), nil)
+
+	var out bytes.Buffer
+	err := New(mc).status(context.Background(), &out, StatusParams{Namespace: "acme", BuildName: "orders-run-1", Wait: true})
+	assert.EqualError(t, err, "build orders-run-1 failed")
+	assert.Regexp(t, `build\s+Failed\s+-`, out.String())
+}
+
+func TestStatus_WaitTimeout(t *testing.T) {
+	setPollInterval(t, time.Millisecond)
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetWorkflowRunStatus(mock.Anything, "acme", "orders-run-1").Return(runStatus("Pending"), nil)
+
+	err := New(mc).status(context.Background(), &bytes.Buffer{}, StatusParams{
+		Namespace: "acme", BuildName: "orders-run-1", Wait: true, Timeout: 20 * time.Millisecond,
+	})
+	assert.EqualError(t, err, "timed out after 20ms waiting for build orders-run-1")
+}
+
+func TestStatus(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	st := runStatus("Running")
+	st.Image = ptr.To("registry/orders:3f2a9c1")
+	mc.EXPECT().GetWorkflowRunStatus(mock.Anything, "acme", "orders-run-1").Return(st, nil)
+
+	var out bytes.Buffer
+	require.NoError(t, New(mc).status(context.Background(), &out, StatusParams{Namespace: "acme", BuildName: "orders-run-1"}))
+	assert.Contains(t, out.String(), "Build:  orders-run-1\nStatus: Running\nImage:  registry/orders:3f2a9c1\n")
+}
+
+func TestList(t *testing.T) {
+	older := time.Now().Add(-2 * time.Hour)
+	newer := time.Now().Add(-time.Hour)
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().ListWorkflowRuns(mock.Anything, "acme", mock.MatchedBy(func(p *gen.ListWorkflowRunsParams) bool {
+		return p.LabelSelector != nil && *p.LabelSelector == "openchoreo.dev/component=orders"
+	})).Return(&gen.WorkflowRunList{Items: []gen.WorkflowRun{
+		{Metadata: gen.ObjectMeta{Name: "orders-run-old", CreationTimestamp: &older}},
+		{Metadata: gen.ObjectMeta{Name: "orders-run-new", CreationTimestamp: &newer}},
+	}}, nil)
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).List(ListParams{Namespace: "acme", ComponentName: "orders"}))
+	})
+	assert.Less(t, strings.Index(out, "orders-run-new"), strings.Index(out, "orders-run-old"))
+}
+
+func TestValidation(t *testing.T) {
+	b := New(mocks.NewMockInterface(t))
+	assert.EqualError(t, b.trigger(context.Background(), &bytes.Buffer{}, TriggerParams{ComponentName: "orders"}), "namespace is required")
+	assert.EqualError(t, b.List(ListParams{Namespace: "acme"}), "component name is required")
+	assert.EqualError(t, b.status(context.Background(), &bytes.Buffer{}, StatusParams{Namespace: "acme"}), "build name is required")
+}
@@ -0,0 +1,142 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/internal/occ/auth"
+	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/completion"
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func NewBuildCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "build",
+		Aliases: []string{"builds"},
+		Short:   "Trigger and follow component builds",
+		Long: `Trigger and follow builds of components.
+
+A build is a run of the workflow configured on a component. These commands
+let CI systems drive builds through the OpenChoreo API: trigger a build of a
+commit, then wait for it with "occ build status --wait", which exits with an
+error unless the build succeeds.`,
+	}
+	cmd.AddCommand(
+		newTriggerCmd(f),
+		newListCmd(f),
+		newStatusCmd(f),
+	)
+	return cmd
+}
+
+func newTriggerCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trigger [COMPONENT_NAME]",
+		Short: "Trigger a build of a component",
+		Long: `Trigger a build of a component with its configured workflow.
+
+With --ref, the given commit of the repository is built instead of the revision
+configured on the component. The workflow must map a repository commit
+parameter for the commit to be used.`,
+		Example: `  # Build a component
+  occ build trigger orders --namespace acme-corp
+
+  # Build a commit and wait for the build to finish
+  occ build trigger orders --ref 3f2a9c1 --wait`,
+		Args:              cmdutil.ExactOneArgWithUsage(),
+		ValidArgsFunction: completion.Names(f, "component"),
+		PreRunE:           auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ref, _ := cmd.Flags().GetString("ref")
+			wait, _ := cmd.Flags().GetBool("wait")
+			timeout, _ := cmd.Flags().GetDuration("timeout")
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).Trigger(TriggerParams{
+				Namespace:     flags.GetNamespace(cmd),
+				ComponentName: args[0],
+				Ref:           ref,
+				Wait:          wait,
+				Timeout:       timeout,
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	cmd.Flags().String("ref", "", "Commit SHA to build (defaults to the revision configured on the component)")
+	addWaitFlags(cmd)
+	return cmd
+}
+
+func newListCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list [COMPONENT_NAME]",
+		Short: "List the builds of a component",
+		Long:  `List the builds of a component, newest first.`,
+		Example: `  # List the builds of a component
+  occ build list orders --namespace acme-corp`,
+		Args:              cmdutil.ExactOneArgWithUsage(),
+		ValidArgsFunction: completion.Names(f, "component"),
+		PreRunE:           auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).List(ListParams{
+				Namespace:     flags.GetNamespace(cmd),
+				ComponentName: args[0],
+				Output:        flags.GetOutputFormat(cmd),
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	flags.AddListOutputFormat(cmd)
+	return cmd
+}
+
+func newStatusCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [BUILD_NAME]",
+		Short: "Show the status of a build",
+		Long: `Show the status of a build and of each of its steps.
+
+With --wait, the status is followed until the build finishes, and the command
+exits with an error unless the build succeeded.`,
+		Example: `  # Show the status of a build
+  occ build status orders-run-1a2b3c4d --namespace acme-corp
+
+  # Wait up to 20 minutes for a build to finish
+  occ build status orders-run-1a2b3c4d --wait --timeout 20m`,
+		Args:              cmdutil.ExactOneArgWithUsage(),
+		ValidArgsFunction: completion.Names(f, "workflowrun"),
+		PreRunE:           auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			wait, _ := cmd.Flags().GetBool("wait")
+			timeout, _ := cmd.Flags().GetDuration("timeout")
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).Status(StatusParams{
+				Namespace: flags.GetNamespace(cmd),
+				BuildName: args[0],
+				Wait:      wait,
+				Timeout:   timeout,
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	addWaitFlags(cmd)
+	return cmd
+}
+
+func addWaitFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("wait", false, "Wait for the build to finish and fail unless it succeeds")
+	cmd.Flags().Duration("timeout", 0, "Maximum time to wait with --wait (0 waits without a limit)")
+}
@@ -0,0 +1,32 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func TestNewBuildCmd(t *testing.T) {
+	cmd := NewBuildCmd(func() (client.Interface, error) { return nil, fmt.Errorf("unused") })
+	assert.Equal(t, "build", cmd.Name())
+
+	trigger, _, err := cmd.Find([]string{"trigger"})
+	require.NoError(t, err)
+	assert.NotNil(t, trigger.Flags().Lookup("ref"))
+	assert.NotNil(t, trigger.Flags().Lookup("wait"))
+
+	status, _, err := cmd.Find([]string{"status"})
+	require.NoError(t, err)
+	assert.Equal(t, "0s", status.Flags().Lookup("timeout").DefValue)
+	assert.Error(t, status.Args(status, nil))
+
+	_, _, err = cmd.Find([]string{"list"})
+	assert.NoError(t, err)
+}
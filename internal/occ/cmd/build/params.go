@@ -0,0 +1,34 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import "time"
+
+// TriggerParams defines parameters for triggering a build of a component
+type TriggerParams struct {
+	Namespace     string
+	ComponentName string
+	// Ref is the commit SHA to build; empty builds the revision configured
+	// on the component.
+	Ref  string
+	Wait bool
+	// Timeout bounds the wait; zero waits until the build finishes.
+	Timeout time.Duration
+}
+
+// ListParams defines parameters for listing the builds of a component
+type ListParams struct {
+	Namespace     string
+	ComponentName string
+	Output        string // --output format
+}
+
+// StatusParams defines parameters for showing the status of a build
+type StatusParams struct {
+	Namespace string
+	BuildName string
+	Wait      bool
+	// Timeout bounds the wait; zero waits until the build finishes.
+	Timeout time.Duration
+}
@@ -9,10 +9,9 @@ import (
 	"os"
 	"text/tabwriter"
 
-	"sigs.k8s.io/yaml"
-
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
+	"github.com/openchoreo/openchoreo/internal/occ/printer"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 )
@@ -28,7 +27,7 @@ func New(c client.Interface) *ClusterAuthzRole {
 }
 
 // List lists all cluster-scoped authorization roles
-func (c *ClusterAuthzRole) List() error {
+func (c *ClusterAuthzRole) List(params ListParams) error {
 	ctx := context.Background()
 
 	items, err := pagination.FetchAll(func(limit int, cursor string) ([]gen.ClusterAuthzRole, string, error) {
@@ -50,10 +49,13 @@ func (c *ClusterAuthzRole) List() error {
 	if err != nil {
 		return fmt.Errorf("failed to list authz cluster roles: %w", err)
 	}
+	if params.Output != "" {
+		return printer.PrintList(os.Stdout, items, params.Output)
+	}
 	return printList(items)
 }
 
-// Get retrieves a single authz cluster role and outputs it as YAML
+// Get retrieves a single authz cluster role and prints it in the requested output format, YAML by default
 func (c *ClusterAuthzRole) Get(params GetParams) error {
 	ctx := context.Background()
 
@@ -62,13 +64,7 @@ func (c *ClusterAuthzRole) Get(params GetParams) error {
 		return fmt.Errorf("failed to get authz cluster role: %w", err)
 	}
 
-	data, err := yaml.Marshal(result)
-	if err != nil {
-		return fmt.Errorf("failed to marshal authz cluster role to YAML: %w", err)
-	}
-
-	fmt.Print(string(data))
-	return nil
+	return printer.Print(os.Stdout, result, params.Output)
 }
 
 // Delete deletes a single authz cluster role
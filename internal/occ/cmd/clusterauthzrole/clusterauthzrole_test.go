@@ -89,7 +89,7 @@ func TestList_APIError(t *testing.T) {
 	mc.EXPECT().ListClusterRoles(mock.Anything, mock.Anything).Return(nil, fmt.Errorf("server error"))
 
 	cr := New(mc)
-	err := cr.List()
+	err := cr.List(ListParams{})
 	assert.ErrorContains(t, err, "server error")
 }
 
@@ -102,7 +102,7 @@ func TestList_Success(t *testing.T) {
 
 	cr := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, cr.List())
+		require.NoError(t, cr.List(ListParams{}))
 	})
 
 	assert.Contains(t, out, "cluster-admin")
@@ -121,7 +121,7 @@ func TestList_MultipleItems(t *testing.T) {
 
 	cr := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, cr.List())
+		require.NoError(t, cr.List(ListParams{}))
 	})
 
 	assert.Contains(t, out, "NAME")
@@ -139,7 +139,7 @@ func TestList_Empty(t *testing.T) {
 
 	cr := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, cr.List())
+		require.NoError(t, cr.List(ListParams{}))
 	})
 
 	assert.Contains(t, out, "No authz cluster roles found")
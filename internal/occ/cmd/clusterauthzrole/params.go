@@ -3,9 +3,15 @@
 
 package clusterauthzrole
 
+// ListParams defines parameters for listing authz cluster roles
+type ListParams struct {
+	Output string // --output format
+}
+
 // GetParams defines parameters for getting a single authz cluster role
 type GetParams struct {
-	Name string
+	Name   string
+	Output string // --output format
 }
 
 // DeleteParams defines parameters for deleting a single authz cluster role
@@ -9,10 +9,9 @@ import (
 	"os"
 	"text/tabwriter"
 
-	"sigs.k8s.io/yaml"
-
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
+	"github.com/openchoreo/openchoreo/internal/occ/printer"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 )
@@ -28,7 +27,7 @@ func New(c client.Interface) *ClusterAuthzRoleBinding {
 }
 
 // List lists all cluster-scoped role bindings
-func (c *ClusterAuthzRoleBinding) List() error {
+func (c *ClusterAuthzRoleBinding) List(params ListParams) error {
 	ctx := context.Background()
 
 	items, err := pagination.FetchAll(func(limit int, cursor string) ([]gen.ClusterAuthzRoleBinding, string, error) {
@@ -50,10 +49,13 @@ func (c *ClusterAuthzRoleBinding) List() error {
 	if err != nil {
 		return fmt.Errorf("failed to list authz cluster role bindings: %w", err)
 	}
+	if params.Output != "" {
+		return printer.PrintList(os.Stdout, items, params.Output)
+	}
 	return printList(items)
 }
 
-// Get retrieves a single authz cluster role binding and outputs it as YAML
+// Get retrieves a single authz cluster role binding and prints it in the requested output format, YAML by default
 func (c *ClusterAuthzRoleBinding) Get(params GetParams) error {
 	ctx := context.Background()
 
@@ -62,13 +64,7 @@ func (c *ClusterAuthzRoleBinding) Get(params GetParams) error {
 		return fmt.Errorf("failed to get authz cluster role binding: %w", err)
 	}
 
-	data, err := yaml.Marshal(result)
-	if err != nil {
-		return fmt.Errorf("failed to marshal authz cluster role binding to YAML: %w", err)
-	}
-
-	fmt.Print(string(data))
-	return nil
+	return printer.Print(os.Stdout, result, params.Output)
 }
 
 // Delete deletes a single authz cluster role binding
@@ -83,7 +83,7 @@ func TestList_APIError(t *testing.T) {
 	mc.EXPECT().ListClusterRoleBindings(mock.Anything, mock.Anything).Return(nil, fmt.Errorf("server error"))
 
 	crb := New(mc)
-	err := crb.List()
+	err := crb.List(ListParams{})
 	assert.ErrorContains(t, err, "server error")
 }
 
@@ -96,7 +96,7 @@ func TestList_Success(t *testing.T) {
 
 	crb := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, crb.List())
+		require.NoError(t, crb.List(ListParams{}))
 	})
 
 	assert.Contains(t, out, "admin-cluster-binding")
@@ -115,7 +115,7 @@ func TestList_MultipleItems(t *testing.T) {
 
 	crb := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, crb.List())
+		require.NoError(t, crb.List(ListParams{}))
 	})
 
 	assert.Contains(t, out, "NAME")
@@ -133,7 +133,7 @@ func TestList_Empty(t *testing.T) {
 
 	crb := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, crb.List())
+		require.NoError(t, crb.List(ListParams{}))
 	})
 
 	assert.Contains(t, out, "No authz cluster role bindings found")
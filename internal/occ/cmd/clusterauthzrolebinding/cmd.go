@@ -8,6 +8,7 @@ import (
 
 	"github.com/openchoreo/openchoreo/internal/occ/auth"
 	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 )
 
@@ -27,7 +28,7 @@ func NewClusterAuthzRoleBindingCmd(f client.NewClientFunc) *cobra.Command {
 }
 
 func newListCmd(f client.NewClientFunc) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List cluster authz role bindings",
 		Long:  `List all cluster-scoped authorization role bindings.`,
@@ -39,16 +40,18 @@ func newListCmd(f client.NewClientFunc) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return New(cl).List()
+			return New(cl).List(ListParams{Output: flags.GetOutputFormat(cmd)})
 		},
 	}
+	flags.AddListOutputFormat(cmd)
+	return cmd
 }
 
 func newGetCmd(f client.NewClientFunc) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "get [CLUSTER_AUTHZ_ROLE_BINDING_NAME]",
 		Short: "Get a cluster authz role binding",
-		Long:  `Get a cluster authz role binding and display its details in YAML format.`,
+		Long:  `Get a cluster authz role binding and display its details in YAML, or in the format set by --output.`,
 		Example: `  # Get a cluster authz role binding
   occ clusterauthzrolebinding get my-binding`,
 		Args:    cmdutil.ExactOneArgWithUsage(),
@@ -58,9 +61,14 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return New(cl).Get(GetParams{Name: args[0]})
+			return New(cl).Get(GetParams{
+				Name:   args[0],
+				Output: flags.GetOutputFormat(cmd),
+			})
 		},
 	}
+	flags.AddGetOutputFormat(cmd)
+	return cmd
 }
 
 func newDeleteCmd(f client.NewClientFunc) *cobra.Command {
@@ -3,9 +3,15 @@
 
 package clusterauthzrolebinding
 
+// ListParams defines parameters for listing authz cluster role bindings
+type ListParams struct {
+	Output string // --output format
+}
+
 // GetParams defines parameters for getting a single authz cluster role binding
 type GetParams struct {
-	Name string
+	Name   string
+	Output string // --output format
 }
 
 // DeleteParams defines parameters for deleting a single authz cluster role binding
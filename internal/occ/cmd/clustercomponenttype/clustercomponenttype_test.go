@@ -89,7 +89,7 @@ func TestList_APIError(t *testing.T) {
 	mc.EXPECT().ListClusterComponentTypes(mock.Anything, mock.Anything).Return(nil, fmt.Errorf("server error"))
 
 	cct := New(mc)
-	assert.EqualError(t, cct.List(), "server error")
+	assert.EqualError(t, cct.List(ListParams{}), "server error")
 }
 
 func TestList_Success(t *testing.T) {
@@ -101,7 +101,7 @@ func TestList_Success(t *testing.T) {
 
 	cct := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, cct.List())
+		require.NoError(t, cct.List(ListParams{}))
 	})
 
 	assert.Contains(t, out, "web-app")
@@ -126,7 +126,7 @@ func TestList_MultipleItems(t *testing.T) {
 
 	cct := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, cct.List())
+		require.NoError(t, cct.List(ListParams{}))
 	})
 
 	assert.Contains(t, out, "NAME")
@@ -145,7 +145,7 @@ func TestList_Empty(t *testing.T) {
 
 	cct := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, cct.List())
+		require.NoError(t, cct.List(ListParams{}))
 	})
 
 	assert.Contains(t, out, "No cluster component types found")
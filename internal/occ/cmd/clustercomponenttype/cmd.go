@@ -8,6 +8,7 @@ import (
 
 	"github.com/openchoreo/openchoreo/internal/occ/auth"
 	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 )
 
@@ -27,7 +28,7 @@ func NewClusterComponentTypeCmd(f client.NewClientFunc) *cobra.Command {
 }
 
 func newListCmd(f client.NewClientFunc) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List cluster component types",
 		Long:  `List all cluster-scoped component types available across the cluster.`,
@@ -39,16 +40,18 @@ func newListCmd(f client.NewClientFunc) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return New(cl).List()
+			return New(cl).List(ListParams{Output: flags.GetOutputFormat(cmd)})
 		},
 	}
+	flags.AddListOutputFormat(cmd)
+	return cmd
 }
 
 func newGetCmd(f client.NewClientFunc) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "get [CLUSTER_COMPONENT_TYPE_NAME]",
 		Short: "Get a cluster component type",
-		Long:  `Get a cluster component type and display its details in YAML format.`,
+		Long:  `Get a cluster component type and display its details in YAML, or in the format set by --output.`,
 		Example: `  # Get a cluster component type
   occ clustercomponenttype get web-app`,
 		Args:    cmdutil.ExactOneArgWithUsage(),
@@ -58,9 +61,14 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return New(cl).Get(GetParams{ClusterComponentTypeName: args[0]})
+			return New(cl).Get(GetParams{
+				ClusterComponentTypeName: args[0],
+				Output:                   flags.GetOutputFormat(cmd),
+			})
 		},
 	}
+	flags.AddGetOutputFormat(cmd)
+	return cmd
 }
 
 func newDeleteCmd(f client.NewClientFunc) *cobra.Command {
@@ -3,9 +3,15 @@
 
 package clustercomponenttype
 
+// ListParams defines parameters for listing cluster component types
+type ListParams struct {
+	Output string // --output format
+}
+
 // GetParams defines parameters for getting a single cluster component type
 type GetParams struct {
 	ClusterComponentTypeName string
+	Output                   string // --output format
 }
 
 // DeleteParams defines parameters for deleting a single cluster component type
@@ -67,7 +67,7 @@ func TestList_APIError(t *testing.T) {
 	mc.EXPECT().ListClusterDataPlanes(mock.Anything, mock.Anything).Return(nil, fmt.Errorf("server error"))
 
 	cdp := New(mc)
-	assert.EqualError(t, cdp.List(), "server error")
+	assert.EqualError(t, cdp.List(ListParams{}), "server error")
 }
 
 func TestList_Success(t *testing.T) {
@@ -79,7 +79,7 @@ func TestList_Success(t *testing.T) {
 
 	cdp := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, cdp.List())
+		require.NoError(t, cdp.List(ListParams{}))
 	})
 	assert.Contains(t, out, "dp-prod")
 }
@@ -97,7 +97,7 @@ func TestList_MultipleItems(t *testing.T) {
 
 	cdp := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, cdp.List())
+		require.NoError(t, cdp.List(ListParams{}))
 	})
 	assert.Contains(t, out, "dp-prod")
 	assert.Contains(t, out, "dp-dev")
@@ -112,7 +112,7 @@ func TestList_Empty(t *testing.T) {
 
 	cdp := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, cdp.List())
+		require.NoError(t, cdp.List(ListParams{}))
 	})
 	assert.Contains(t, out, "No cluster data planes found")
 }
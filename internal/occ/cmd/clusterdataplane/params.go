@@ -3,9 +3,15 @@
 
 package clusterdataplane
 
+// ListParams defines parameters for listing cluster data planes
+type ListParams struct {
+	Output string // --output format
+}
+
 // GetParams defines parameters for getting a single cluster data plane
 type GetParams struct {
 	ClusterDataPlaneName string
+	Output               string // --output format
 }
 
 // DeleteParams defines parameters for deleting a single cluster data plane
@@ -9,10 +9,9 @@ import (
 	"os"
 	"text/tabwriter"
 
-	"sigs.k8s.io/yaml"
-
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
+	"github.com/openchoreo/openchoreo/internal/occ/printer"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 )
@@ -28,7 +27,7 @@ func New(c client.Interface) *ClusterObservabilityPlane {
 }
 
 // List lists all cluster-scoped observability planes
-func (c *ClusterObservabilityPlane) List() error {
+func (c *ClusterObservabilityPlane) List(params ListParams) error {
 	ctx := context.Background()
 
 	items, err := pagination.FetchAll(func(limit int, cursor string) ([]gen.ClusterObservabilityPlane, string, error) {
@@ -50,10 +49,13 @@ func (c *ClusterObservabilityPlane) List() error {
 	if err != nil {
 		return err
 	}
+	if params.Output != "" {
+		return printer.PrintList(os.Stdout, items, params.Output)
+	}
 	return printList(items)
 }
 
-// Get retrieves a single cluster observability plane and outputs it as YAML
+// Get retrieves a single cluster observability plane and prints it in the requested output format, YAML by default
 func (c *ClusterObservabilityPlane) Get(params GetParams) error {
 	ctx := context.Background()
 
@@ -62,13 +64,7 @@ func (c *ClusterObservabilityPlane) Get(params GetParams) error {
 		return err
 	}
 
-	data, err := yaml.Marshal(result)
-	if err != nil {
-		return fmt.Errorf("failed to marshal cluster observability plane to YAML: %w", err)
-	}
-
-	fmt.Print(string(data))
-	return nil
+	return printer.Print(os.Stdout, result, params.Output)
 }
 
 // Delete deletes a single cluster observability plane
@@ -67,7 +67,7 @@ func TestList_APIError(t *testing.T) {
 	mc.EXPECT().ListClusterObservabilityPlanes(mock.Anything, mock.Anything).Return(nil, fmt.Errorf("server error"))
 
 	cop := New(mc)
-	assert.EqualError(t, cop.List(), "server error")
+	assert.EqualError(t, cop.List(ListParams{}), "server error")
 }
 
 func TestList_Success(t *testing.T) {
@@ -79,7 +79,7 @@ func TestList_Success(t *testing.T) {
 
 	cop := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, cop.List())
+		require.NoError(t, cop.List(ListParams{}))
 	})
 	assert.Contains(t, out, "obs-prod")
 }
@@ -97,7 +97,7 @@ func TestList_MultipleItems(t *testing.T) {
 
 	cop := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, cop.List())
+		require.NoError(t, cop.List(ListParams{}))
 	})
 	assert.Contains(t, out, "obs-prod")
 	assert.Contains(t, out, "obs-dev")
@@ -112,7 +112,7 @@ func TestList_Empty(t *testing.T) {
 
 	cop := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, cop.List())
+		require.NoError(t, cop.List(ListParams{}))
 	})
 	assert.Contains(t, out, "No cluster observability planes found")
 }
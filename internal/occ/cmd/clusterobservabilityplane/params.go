@@ -3,9 +3,15 @@
 
 package clusterobservabilityplane
 
+// ListParams defines parameters for listing cluster observability planes
+type ListParams struct {
+	Output string // --output format
+}
+
 // GetParams defines parameters for getting a single cluster observability plane
 type GetParams struct {
 	ClusterObservabilityPlaneName string
+	Output                        string // --output format
 }
 
 // DeleteParams defines parameters for deleting a single cluster observability plane
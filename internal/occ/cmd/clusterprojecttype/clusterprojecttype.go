@@ -10,10 +10,9 @@ import (
 	"strconv"
 	"text/tabwriter"
 
-	"sigs.k8s.io/yaml"
-
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
+	"github.com/openchoreo/openchoreo/internal/occ/printer"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 )
@@ -29,7 +28,7 @@ func New(c client.Interface) *ClusterProjectType {
 }
 
 // List lists all cluster-scoped project types
-func (c *ClusterProjectType) List() error {
+func (c *ClusterProjectType) List(params ListParams) error {
 	ctx := context.Background()
 
 	items, err := pagination.FetchAll(func(limit int, cursor string) ([]gen.ClusterProjectType, string, error) {
@@ -51,10 +50,13 @@ func (c *ClusterProjectType) List() error {
 	if err != nil {
 		return err
 	}
+	if params.Output != "" {
+		return printer.PrintList(os.Stdout, items, params.Output)
+	}
 	return printList(items)
 }
 
-// Get retrieves a single cluster project type and outputs it as YAML
+// Get retrieves a single cluster project type and prints it in the requested output format, YAML by default
 func (c *ClusterProjectType) Get(params GetParams) error {
 	ctx := context.Background()
 
@@ -63,13 +65,7 @@ func (c *ClusterProjectType) Get(params GetParams) error {
 		return err
 	}
 
-	data, err := yaml.Marshal(result)
-	if err != nil {
-		return fmt.Errorf("failed to marshal cluster project type to YAML: %w", err)
-	}
-
-	fmt.Print(string(data))
-	return nil
+	return printer.Print(os.Stdout, result, params.Output)
 }
 
 // Delete deletes a single cluster project type
@@ -85,7 +85,7 @@ func TestList_APIError(t *testing.T) {
 	mc.EXPECT().ListClusterProjectTypes(mock.Anything, mock.Anything).Return(nil, fmt.Errorf("server error"))
 
 	cpt := New(mc)
-	assert.EqualError(t, cpt.List(), "server error")
+	assert.EqualError(t, cpt.List(ListParams{}), "server error")
 }
 
 func TestList_Success(t *testing.T) {
@@ -97,7 +97,7 @@ func TestList_Success(t *testing.T) {
 
 	cpt := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, cpt.List())
+		require.NoError(t, cpt.List(ListParams{}))
 	})
 
 	assert.Contains(t, out, "default")
@@ -112,7 +112,7 @@ func TestList_Empty(t *testing.T) {
 
 	cpt := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, cpt.List())
+		require.NoError(t, cpt.List(ListParams{}))
 	})
 
 	assert.Contains(t, out, "No cluster project types found")
@@ -3,9 +3,15 @@
 
 package clusterprojecttype
 
+// ListParams defines parameters for listing cluster project types
+type ListParams struct {
+	Output string // --output format
+}
+
 // GetParams defines parameters for getting a single cluster project type
 type GetParams struct {
 	ClusterProjectTypeName string
+	Output                 string // --output format
 }
 
 // DeleteParams defines parameters for deleting a single cluster project type
@@ -89,7 +89,7 @@ func TestList_APIError(t *testing.T) {
 	mc.EXPECT().ListClusterResourceTypes(mock.Anything, mock.Anything).Return(nil, fmt.Errorf("server error"))
 
 	crt := New(mc)
-	assert.EqualError(t, crt.List(), "server error")
+	assert.EqualError(t, crt.List(ListParams{}), "server error")
 }
 
 func TestList_Success(t *testing.T) {
@@ -101,7 +101,7 @@ func TestList_Success(t *testing.T) {
 
 	crt := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, crt.List())
+		require.NoError(t, crt.List(ListParams{}))
 	})
 
 	assert.Contains(t, out, "mysql")
@@ -126,7 +126,7 @@ func TestList_MultipleItems(t *testing.T) {
 
 	crt := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, crt.List())
+		require.NoError(t, crt.List(ListParams{}))
 	})
 
 	assert.Contains(t, out, "NAME")
@@ -145,7 +145,7 @@ func TestList_Empty(t *testing.T) {
 
 	crt := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, crt.List())
+		require.NoError(t, crt.List(ListParams{}))
 	})
 
 	assert.Contains(t, out, "No cluster resource types found")
@@ -3,9 +3,15 @@
 
 package clusterresourcetype
 
+// ListParams defines parameters for listing cluster resource types
+type ListParams struct {
+	Output string // --output format
+}
+
 // GetParams defines parameters for getting a single cluster resource type
 type GetParams struct {
 	ClusterResourceTypeName string
+	Output                  string // --output format
 }
 
 // DeleteParams defines parameters for deleting a single cluster resource type
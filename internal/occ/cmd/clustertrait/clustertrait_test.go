@@ -81,7 +81,7 @@ func TestList_APIError(t *testing.T) {
 	mc.EXPECT().ListClusterTraits(mock.Anything, mock.Anything).Return(nil, fmt.Errorf("server error"))
 
 	ct := New(mc)
-	assert.EqualError(t, ct.List(), "server error")
+	assert.EqualError(t, ct.List(ListParams{}), "server error")
 }
 
 func TestList_Success(t *testing.T) {
@@ -93,7 +93,7 @@ func TestList_Success(t *testing.T) {
 
 	ct := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, ct.List())
+		require.NoError(t, ct.List(ListParams{}))
 	})
 
 	assert.Contains(t, out, "ingress")
@@ -112,7 +112,7 @@ func TestList_MultipleItems(t *testing.T) {
 
 	ct := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, ct.List())
+		require.NoError(t, ct.List(ListParams{}))
 	})
 
 	assert.Contains(t, out, "NAME")
@@ -130,7 +130,7 @@ func TestList_Empty(t *testing.T) {
 
 	ct := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, ct.List())
+		require.NoError(t, ct.List(ListParams{}))
 	})
 
 	assert.Contains(t, out, "No cluster traits found")
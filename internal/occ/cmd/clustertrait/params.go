@@ -3,9 +3,15 @@
 
 package clustertrait
 
+// ListParams defines parameters for listing cluster traits
+type ListParams struct {
+	Output string // --output format
+}
+
 // GetParams defines parameters for getting a single cluster trait
 type GetParams struct {
 	ClusterTraitName string
+	Output           string // --output format
 }
 
 // DeleteParams defines parameters for deleting a single cluster trait
@@ -9,12 +9,11 @@ import (
 	"os"
 	"text/tabwriter"
 
-	"sigs.k8s.io/yaml"
-
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/workflow"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/workflowrun"
+	"github.com/openchoreo/openchoreo/internal/occ/printer"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 )
@@ -30,7 +29,7 @@ func New(c client.Interface) *ClusterWorkflow {
 }
 
 // List lists all cluster-scoped workflows
-func (c *ClusterWorkflow) List() error {
+func (c *ClusterWorkflow) List(params ListParams) error {
 	ctx := context.Background()
 
 	items, err := pagination.FetchAll(func(limit int, cursor string) ([]gen.ClusterWorkflow, string, error) {
@@ -52,10 +51,13 @@ func (c *ClusterWorkflow) List() error {
 	if err != nil {
 		return err
 	}
+	if params.Output != "" {
+		return printer.PrintList(os.Stdout, items, params.Output)
+	}
 	return printList(items)
 }
 
-// Get retrieves a single cluster workflow and outputs it as YAML
+// Get retrieves a single cluster workflow and prints it in the requested output format, YAML by default
 func (c *ClusterWorkflow) Get(params GetParams) error {
 	ctx := context.Background()
 
@@ -64,13 +66,7 @@ func (c *ClusterWorkflow) Get(params GetParams) error {
 		return err
 	}
 
-	data, err := yaml.Marshal(result)
-	if err != nil {
-		return fmt.Errorf("failed to marshal cluster workflow to YAML: %w", err)
-	}
-
-	fmt.Print(string(data))
-	return nil
+	return printer.Print(os.Stdout, result, params.Output)
 }
 
 // Delete deletes a single cluster workflow
@@ -81,7 +81,7 @@ func TestList_APIError(t *testing.T) {
 	mc.EXPECT().ListClusterWorkflows(mock.Anything, mock.Anything).Return(nil, fmt.Errorf("server error"))
 
 	cw := New(mc)
-	assert.EqualError(t, cw.List(), "server error")
+	assert.EqualError(t, cw.List(ListParams{}), "server error")
 }
 
 func TestList_Success(t *testing.T) {
@@ -93,7 +93,7 @@ func TestList_Success(t *testing.T) {
 
 	cw := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, cw.List())
+		require.NoError(t, cw.List(ListParams{}))
 	})
 	assert.Contains(t, out, "build-go")
 }
@@ -111,7 +111,7 @@ func TestList_MultipleItems(t *testing.T) {
 
 	cw := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, cw.List())
+		require.NoError(t, cw.List(ListParams{}))
 	})
 	assert.Contains(t, out, "build-go")
 	assert.Contains(t, out, "build-docker")
@@ -126,7 +126,7 @@ func TestList_Empty(t *testing.T) {
 
 	cw := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, cw.List())
+		require.NoError(t, cw.List(ListParams{}))
 	})
 	assert.Contains(t, out, "No cluster workflows found")
 }
@@ -314,7 +314,7 @@ func TestList_Pagination(t *testing.T) {
 
 	cw := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, cw.List())
+		require.NoError(t, cw.List(ListParams{}))
 	})
 	assert.Contains(t, out, "wf-1")
 	assert.Contains(t, out, "wf-2")
@@ -30,7 +30,7 @@ func NewClusterWorkflowCmd(f client.NewClientFunc) *cobra.Command {
 }
 
 func newListCmd(f client.NewClientFunc) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List cluster workflows",
 		Long:  `List all cluster-scoped workflows available across the cluster.`,
@@ -42,16 +42,18 @@ func newListCmd(f client.NewClientFunc) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return New(cl).List()
+			return New(cl).List(ListParams{Output: flags.GetOutputFormat(cmd)})
 		},
 	}
+	flags.AddListOutputFormat(cmd)
+	return cmd
 }
 
 func newGetCmd(f client.NewClientFunc) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "get [CLUSTER_WORKFLOW_NAME]",
 		Short: "Get a cluster workflow",
-		Long:  `Get a cluster workflow and display its details in YAML format.`,
+		Long:  `Get a cluster workflow and display its details in YAML, or in the format set by --output.`,
 		Example: `  # Get a cluster workflow
   occ clusterworkflow get build-go`,
 		Args:    cmdutil.ExactOneArgWithUsage(),
@@ -61,9 +63,14 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return New(cl).Get(GetParams{ClusterWorkflowName: args[0]})
+			return New(cl).Get(GetParams{
+				ClusterWorkflowName: args[0],
+				Output:              flags.GetOutputFormat(cmd),
+			})
 		},
 	}
+	flags.AddGetOutputFormat(cmd)
+	return cmd
 }
 
 func newDeleteCmd(f client.NewClientFunc) *cobra.Command {
@@ -3,9 +3,15 @@
 
 package clusterworkflow
 
+// ListParams defines parameters for listing cluster workflows
+type ListParams struct {
+	Output string // --output format
+}
+
 // GetParams defines parameters for getting a single cluster workflow
 type GetParams struct {
 	ClusterWorkflowName string
+	Output              string // --output format
 }
 
 // DeleteParams defines parameters for deleting a single cluster workflow
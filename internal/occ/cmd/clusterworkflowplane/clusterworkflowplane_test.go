@@ -67,7 +67,7 @@ func TestList_APIError(t *testing.T) {
 	mc.EXPECT().ListClusterWorkflowPlanes(mock.Anything, mock.Anything).Return(nil, fmt.Errorf("server error"))
 
 	cwp := New(mc)
-	assert.EqualError(t, cwp.List(), "server error")
+	assert.EqualError(t, cwp.List(ListParams{}), "server error")
 }
 
 func TestList_Success(t *testing.T) {
@@ -79,7 +79,7 @@ func TestList_Success(t *testing.T) {
 
 	cwp := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, cwp.List())
+		require.NoError(t, cwp.List(ListParams{}))
 	})
 	assert.Contains(t, out, "argo-prod")
 }
@@ -97,7 +97,7 @@ func TestList_MultipleItems(t *testing.T) {
 
 	cwp := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, cwp.List())
+		require.NoError(t, cwp.List(ListParams{}))
 	})
 	assert.Contains(t, out, "argo-prod")
 	assert.Contains(t, out, "argo-dev")
@@ -112,7 +112,7 @@ func TestList_Empty(t *testing.T) {
 
 	cwp := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, cwp.List())
+		require.NoError(t, cwp.List(ListParams{}))
 	})
 	assert.Contains(t, out, "No cluster workflow planes found")
 }
@@ -3,9 +3,15 @@
 
 package clusterworkflowplane
 
+// ListParams defines parameters for listing cluster workflow planes
+type ListParams struct {
+	Output string // --output format
+}
+
 // GetParams defines parameters for getting a single cluster workflow plane
 type GetParams struct {
 	ClusterWorkflowPlaneName string
+	Output                   string // --output format
 }
 
 // DeleteParams defines parameters for deleting a single cluster workflow plane
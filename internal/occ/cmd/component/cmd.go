@@ -25,8 +25,10 @@ func NewComponentCmd(f client.NewClientFunc) *cobra.Command {
 		newListCmd(f),
 		newGetCmd(f),
 		newDeleteCmd(f),
+		newCreateCmd(f),
 		newScaffoldCmd(f),
 		newDeployCmd(f),
+		newRollbackCmd(f),
 		newLogsCmd(f),
 		newExecCmd(f),
 		newWorkflowCmd(f),
@@ -51,11 +53,13 @@ func newListCmd(f client.NewClientFunc) *cobra.Command {
 			return New(cl).List(ListParams{
 				Namespace: flags.GetNamespace(cmd),
 				Project:   flags.GetProject(cmd),
+				Output:    flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
 	flags.AddProject(cmd)
+	flags.AddListOutputFormat(cmd)
 	return cmd
 }
 
@@ -63,7 +67,7 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get [COMPONENT_NAME]",
 		Short: "Get a component",
-		Long:  `Get a component and display its details in YAML format.`,
+		Long:  `Get a component and display its details in YAML, or in the format set by --output.`,
 		Example: `  # Get a component
   occ component get my-component --namespace acme-corp`,
 		Args:    cmdutil.ExactOneArgWithUsage(),
@@ -76,10 +80,12 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 			return New(cl).Get(GetParams{
 				Namespace:     flags.GetNamespace(cmd),
 				ComponentName: args[0],
+				Output:        flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
+	flags.AddGetOutputFormat(cmd)
 	return cmd
 }
 
@@ -107,6 +113,53 @@ func newDeleteCmd(f client.NewClientFunc) *cobra.Command {
 	return cmd
 }
 
+func newCreateCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [COMPONENT_NAME]",
+		Short: "Create a component interactively",
+		Long: `Create a component by answering a few questions.
+
+The command asks for the workload type, the component type, the build workflow
+and the source repository. The choices offered are the ComponentTypes and
+ClusterComponentTypes installed in the cluster and the workflows they allow;
+defaults and required parameters come from their schemas. The generated
+Component manifest is shown for review before it is created.
+
+Examples:
+  # Create a component, answering every question
+  occ component create --project my-project
+
+  # Preview the manifest without creating the component
+  occ component create my-app --project my-project --dry-run`,
+		Args:    cobra.MaximumNArgs(1),
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			var name string
+			if len(args) > 0 {
+				name = args[0]
+			}
+			dryRun, _ := cmd.Flags().GetBool("dry-run")
+			yes, _ := cmd.Flags().GetBool("yes")
+			return New(cl).Create(CreateParams{
+				ComponentName: name,
+				Namespace:     flags.GetNamespace(cmd),
+				ProjectName:   flags.GetProject(cmd),
+				DryRun:        dryRun,
+				Yes:           yes,
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	flags.AddProject(cmd)
+	cmd.Flags().Bool("dry-run", false, "Print the Component manifest without creating it")
+	cmd.Flags().BoolP("yes", "y", false, "Create the component without asking for confirmation")
+	return cmd
+}
+
 func newScaffoldCmd(f client.NewClientFunc) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "scaffold COMPONENT_NAME",
@@ -211,6 +264,39 @@ func newDeployCmd(f client.NewClientFunc) *cobra.Command {
 	return cmd
 }
 
+func newRollbackCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback [COMPONENT_NAME]",
+		Short: "Roll back a component to a previous release",
+		Long: `Roll back a component in an environment to a release from its release history.
+The release is bound again together with the environment configuration recorded with it.
+If --release is not specified, rolls back to the release before the current one.`,
+		Example: `  # Roll back to the previous release
+  occ component rollback api-service --namespace acme-corp --env production
+
+  # Roll back to a specific release
+  occ component rollback api-service --namespace acme-corp --env production --release api-service-20260101-1`,
+		Args:    cmdutil.ExactOneArgWithUsage(),
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).Rollback(RollbackParams{
+				ComponentName: args[0],
+				Namespace:     flags.GetNamespace(cmd),
+				Environment:   flags.GetEnvironment(cmd),
+				Release:       flags.GetRelease(cmd),
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	cmd.Flags().String("env", "", "Environment to roll back")
+	cmd.Flags().String("release", "", "Release to roll back to (defaults to the previous release)")
+	return cmd
+}
+
 func newLogsCmd(f client.NewClientFunc) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "logs COMPONENT_NAME",
@@ -224,7 +310,10 @@ If --env is not specified, uses the lowest environment from the deployment pipel
   occ component logs my-component --env dev
 
   # Follow logs in real-time
-  occ component logs my-component --env dev -f`,
+  occ component logs my-component --env dev -f
+
+  # Only show logs of one container
+  occ component logs my-component --env dev -c main`,
 		Args:    cmdutil.ExactOneArgWithUsage(),
 		PreRunE: auth.RequireLogin(),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -241,6 +330,7 @@ If --env is not specified, uses the lowest environment from the deployment pipel
 				Follow:      flags.GetFollow(cmd),
 				Since:       flags.GetSince(cmd),
 				Tail:        tail,
+				Container:   flags.GetContainer(cmd),
 			})
 		},
 	}
@@ -250,6 +340,7 @@ If --env is not specified, uses the lowest environment from the deployment pipel
 	flags.AddFollow(cmd)
 	flags.AddSince(cmd)
 	flags.AddTail(cmd)
+	flags.AddContainer(cmd)
 	return cmd
 }
 
@@ -48,8 +48,10 @@ func TestNewComponentCmd_Subcommands(t *testing.T) {
 	assert.Contains(t, names, "list")
 	assert.Contains(t, names, "get")
 	assert.Contains(t, names, "delete")
+	assert.Contains(t, names, "create")
 	assert.Contains(t, names, "scaffold")
 	assert.Contains(t, names, "deploy")
+	assert.Contains(t, names, "rollback")
 	assert.Contains(t, names, "logs")
 	assert.Contains(t, names, "workflow")
 	assert.Contains(t, names, "workflowrun")
@@ -140,6 +142,24 @@ func TestDeleteCmd_Success(t *testing.T) {
 	assert.Contains(t, out, "deleted")
 }
 
+// --- create ---
+
+func TestCreateCmd_Args(t *testing.T) {
+	cmd := newCreateCmd(errFactory("unused"))
+	assert.NoError(t, cmd.Args(cmd, []string{}))
+	assert.NoError(t, cmd.Args(cmd, []string{"my-component"}))
+	assert.Error(t, cmd.Args(cmd, []string{"a", "b"}))
+	for _, name := range []string{"namespace", "project", "dry-run", "yes"} {
+		assert.NotNil(t, cmd.Flags().Lookup(name), "expected flag %q", name)
+	}
+}
+
+func TestCreateCmd_FactoryError(t *testing.T) {
+	cmd := newCreateCmd(errFactory("factory failed"))
+	err := cmd.RunE(cmd, []string{"my-component"})
+	assert.EqualError(t, err, "factory failed")
+}
+
 // --- scaffold ---
 
 func TestScaffoldCmd_MissingArg(t *testing.T) {
@@ -13,7 +13,6 @@ import (
 	"time"
 
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
-	"sigs.k8s.io/yaml"
 
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/setoverride"
@@ -21,6 +20,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/workflow"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/workflowrun"
 	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/printer"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 	scaffold "github.com/openchoreo/openchoreo/internal/scaffold/component"
@@ -65,6 +65,9 @@ func (cp *Component) List(params ListParams) error {
 		return err
 	}
 
+	if params.Output != "" {
+		return printer.PrintList(os.Stdout, items, params.Output)
+	}
 	return printList(items, params.Project == "")
 }
 
@@ -137,7 +140,7 @@ func (cp *Component) ListWorkflowRuns(params ListWorkflowRunsParams) error {
 	return workflowrun.PrintList(filtered)
 }
 
-// Get retrieves a single component and outputs it as YAML
+// Get retrieves a single component and prints it in the requested output format, YAML by default
 func (cp *Component) Get(params GetParams) error {
 	if err := cmdutil.RequireFields("get", "component", map[string]string{"namespace": params.Namespace}); err != nil {
 		return err
@@ -150,13 +153,7 @@ func (cp *Component) Get(params GetParams) error {
 		return err
 	}
 
-	data, err := yaml.Marshal(result)
-	if err != nil {
-		return fmt.Errorf("failed to marshal component to YAML: %w", err)
-	}
-
-	fmt.Print(string(data))
-	return nil
+	return printer.Print(os.Stdout, result, params.Output)
 }
 
 // Delete deletes a single component
@@ -220,6 +217,30 @@ func (cp *Component) Deploy(params DeployParams) error {
 	return nil
 }
 
+// Rollback rolls a component in an environment back to a release from its history
+func (cp *Component) Rollback(params RollbackParams) error {
+	if err := cmdutil.RequireFields("rollback", "component", map[string]string{
+		"namespace": params.Namespace,
+		"env":       params.Environment,
+	}); err != nil {
+		return err
+	}
+
+	req := gen.RollbackComponentRequest{Environment: params.Environment}
+	if params.Release != "" {
+		req.Release = &params.Release
+	}
+	result, err := cp.client.RollbackComponent(context.Background(), params.Namespace, params.ComponentName, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Rolled back component '%s' in environment '%s'\n", params.ComponentName, params.Environment)
+	fmt.Printf("  Release: %s (was %s)\n", result.Release, result.PreviousRelease)
+	fmt.Printf("  Binding: %s\n", result.ReleaseBinding.Metadata.Name)
+	return nil
+}
+
 // deployComponent deploys a component to the lowest environment in the pipeline
 func (cp *Component) deployComponent(ctx context.Context, c client.Interface, params DeployParams) (*gen.ReleaseBinding, error) {
 	releaseName := params.Release
@@ -520,6 +520,35 @@ func TestDelete_Success(t *testing.T) {
 	assert.Contains(t, out, "Component 'my-comp' deleted")
 }
 
+// --- Rollback tests ---
+
+func TestRollback_MissingEnvironment(t *testing.T) {
+	cp := New(mocks.NewMockInterface(t))
+	assert.ErrorContains(t, cp.Rollback(RollbackParams{Namespace: "ns", ComponentName: "my-comp"}), "env")
+}
+
+func TestRollback_Success(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	release := "my-comp-1"
+	mc.EXPECT().RollbackComponent(mock.Anything, "ns", "my-comp", gen.RollbackComponentRequest{
+		Environment: "prod",
+		Release:     &release,
+	}).Return(&gen.RollbackComponentResponse{
+		Release:         "my-comp-1",
+		PreviousRelease: "my-comp-2",
+		ReleaseBinding:  gen.ReleaseBinding{Metadata: gen.ObjectMeta{Name: "my-comp-prod"}},
+	}, nil)
+
+	cp := New(mc)
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, cp.Rollback(RollbackParams{Namespace: "ns", ComponentName: "my-comp", Environment: "prod", Release: release}))
+	})
+
+	assert.Contains(t, out, "Rolled back component 'my-comp' in environment 'prod'")
+	assert.Contains(t, out, "my-comp-1 (was my-comp-2)")
+	assert.Contains(t, out, "my-comp-prod")
+}
+
 // --- StartWorkflow tests ---
 
 func TestStartWorkflow_MissingNamespace(t *testing.T) {
@@ -0,0 +1,549 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
+	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+const (
+	// repositoryExtensionPrefix marks the workflow parameters that carry the
+	// component's source repository (see controller.ExtractComponentRepositoryPaths).
+	repositoryExtensionPrefix = "x-openchoreo-component-parameter-repository-"
+
+	noBuildOption = "none (deploy a pre-built image)"
+)
+
+// componentTypeOption is a ComponentType or ClusterComponentType the component can use.
+type componentTypeOption struct {
+	kind         string
+	name         string
+	workloadType string
+	workflows    []workflowOption
+}
+
+func (o componentTypeOption) label() string {
+	return fmt.Sprintf("%s (%s)", o.name, o.kind)
+}
+
+// workflowOption is a build workflow allowed by a component type.
+type workflowOption struct {
+	kind string
+	name string
+}
+
+func (o workflowOption) label() string {
+	return fmt.Sprintf("%s (%s)", o.name, o.kind)
+}
+
+// Create runs the interactive component creation wizard on the terminal.
+func (cp *Component) Create(params CreateParams) error {
+	return cp.create(params, utils.NewPrompter(os.Stdin, os.Stdout), os.Stdout)
+}
+
+// create asks for the component type, build workflow and repository, using the
+// options and defaults published by the installed component types and workflows,
+// then previews the Component manifest and creates it.
+func (cp *Component) create(params CreateParams, p *utils.Prompter, out io.Writer) error {
+	if err := cmdutil.RequireFields("create", "component", map[string]string{
+		"namespace": params.Namespace,
+		"project":   params.ProjectName,
+	}); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	name := params.ComponentName
+	if name == "" {
+		var err error
+		if name, err = p.Input("Component name", "", validateComponentName); err != nil {
+			return err
+		}
+	} else if err := validateComponentName(name); err != nil {
+		return err
+	}
+
+	types, err := cp.listComponentTypeOptions(ctx, params.Namespace)
+	if err != nil {
+		return err
+	}
+	if len(types) == 0 {
+		return fmt.Errorf("no component types are available in namespace %q", params.Namespace)
+	}
+	ct, err := selectComponentType(p, types)
+	if err != nil {
+		return err
+	}
+
+	compParams, err := cp.promptComponentParameters(ctx, p, params.Namespace, ct)
+	if err != nil {
+		return err
+	}
+
+	apiVersion, kind := "openchoreo.dev/v1alpha1", "Component"
+	ctKind := gen.ComponentSpecComponentTypeKind(ct.kind)
+	comp := gen.Component{
+		ApiVersion: &apiVersion,
+		Kind:       &kind,
+		Metadata:   gen.ObjectMeta{Name: name, Namespace: &params.Namespace},
+		Spec:       &gen.ComponentSpec{},
+	}
+	comp.Spec.Owner.ProjectName = params.ProjectName
+	comp.Spec.ComponentType.Kind = &ctKind
+	comp.Spec.ComponentType.Name = ct.workloadType + "/" + ct.name
+	if len(compParams) > 0 {
+		comp.Spec.Parameters = &compParams
+	}
+
+	wf, err := selectWorkflow(p, ct)
+	if err != nil {
+		return err
+	}
+	if wf != nil {
+		wfParams, err := cp.promptWorkflowParameters(ctx, p, params.Namespace, *wf)
+		if err != nil {
+			return err
+		}
+		wfKind := gen.ComponentWorkflowConfigKind(wf.kind)
+		comp.Spec.Workflow = &gen.ComponentWorkflowConfig{
+			Kind: &wfKind,
+			Name: wf.name,
+		}
+		if len(wfParams) > 0 {
+			comp.Spec.Workflow.Parameters = &wfParams
+		}
+	}
+
+	manifest, err := yaml.Marshal(comp)
+	if err != nil {
+		return fmt.Errorf("failed to render component manifest: %w", err)
+	}
+	fmt.Fprintf(out, "\n---\n%s\n", manifest)
+
+	if params.DryRun {
+		return nil
+	}
+	if !params.Yes {
+		ok, err := p.Confirm(fmt.Sprintf("Create component %q in project %q?", name, params.ProjectName), true)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Fprintln(out, "Aborted, no changes were made")
+			return nil
+		}
+	}
+
+	// The API takes the object without apiVersion and kind.
+	comp.ApiVersion, comp.Kind = nil, nil
+	created, err := cp.client.CreateComponent(ctx, params.Namespace, comp)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Component '%s' created in project '%s'\n", created.Metadata.Name, params.ProjectName)
+	if wf != nil {
+		fmt.Fprintf(out, "Start a build with: occ component workflow run %s\n", created.Metadata.Name)
+	}
+	return nil
+}
+
+// listComponentTypeOptions returns the namespace's component types followed by
+// the cluster component types.
+func (cp *Component) listComponentTypeOptions(ctx context.Context, namespace string) ([]componentTypeOption, error) {
+	cts, err := pagination.FetchAll(func(limit int, cursor string) ([]gen.ComponentType, string, error) {
+		p := &gen.ListComponentTypesParams{Limit: &limit}
+		if cursor != "" {
+			p.Cursor = &cursor
+		}
+		result, err := cp.client.ListComponentTypes(ctx, namespace, p)
+		if err != nil {
+			return nil, "", err
+		}
+		return result.Items, nextCursor(result.Pagination), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	ccts, err := pagination.FetchAll(func(limit int, cursor string) ([]gen.ClusterComponentType, string, error) {
+		p := &gen.ListClusterComponentTypesParams{Limit: &limit}
+		if cursor != "" {
+			p.Cursor = &cursor
+		}
+		result, err := cp.client.ListClusterComponentTypes(ctx, p)
+		if err != nil {
+			return nil, "", err
+		}
+		return result.Items, nextCursor(result.Pagination), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	options := make([]componentTypeOption, 0, len(cts)+len(ccts))
+	for _, ct := range cts {
+		if ct.Spec == nil {
+			continue
+		}
+		opt := componentTypeOption{kind: "ComponentType", name: ct.Metadata.Name, workloadType: string(ct.Spec.WorkloadType)}
+		if ct.Spec.AllowedWorkflows != nil {
+			for _, wf := range *ct.Spec.AllowedWorkflows {
+				kind := string(gen.ComponentTypeSpecAllowedWorkflowsKindWorkflow)
+				if wf.Kind != nil {
+					kind = string(*wf.Kind)
+				}
+				opt.workflows = append(opt.workflows, workflowOption{kind: kind, name: wf.Name})
+			}
+		}
+		options = append(options, opt)
+	}
+	for _, cct := range ccts {
+		if cct.Spec == nil {
+			continue
+		}
+		opt := componentTypeOption{kind: "ClusterComponentType", name: cct.Metadata.Name, workloadType: string(cct.Spec.WorkloadType)}
+		if cct.Spec.AllowedWorkflows != nil {
+			for _, wf := range *cct.Spec.AllowedWorkflows {
+				opt.workflows = append(opt.workflows, workflowOption{kind: string(wf.Kind), name: wf.Name})
+			}
+		}
+		options = append(options, opt)
+	}
+	return options, nil
+}
+
+func nextCursor(p gen.Pagination) string {
+	if p.NextCursor != nil {
+		return *p.NextCursor
+	}
+	return ""
+}
+
+// selectComponentType asks for the workload type and then for one of the
+// component types of that workload type.
+func selectComponentType(p *utils.Prompter, types []componentTypeOption) (componentTypeOption, error) {
+	var workloadTypes []string
+	for _, t := range types {
+		if !slices.Contains(workloadTypes, t.workloadType) {
+			workloadTypes = append(workloadTypes, t.workloadType)
+		}
+	}
+	sort.Strings(workloadTypes)
+	def := ""
+	if slices.Contains(workloadTypes, "deployment") {
+		def = "deployment"
+	}
+	workloadType, err := p.Select("Workload type", workloadTypes, def)
+	if err != nil {
+		return componentTypeOption{}, err
+	}
+
+	var labels []string
+	byLabel := make(map[string]componentTypeOption)
+	for _, t := range types {
+		if t.workloadType == workloadType {
+			labels = append(labels, t.label())
+			byLabel[t.label()] = t
+		}
+	}
+	sort.Strings(labels)
+	label, err := p.Select("Component type", labels, labels[0])
+	if err != nil {
+		return componentTypeOption{}, err
+	}
+	return byLabel[label], nil
+}
+
+// selectWorkflow asks for one of the build workflows allowed by the component
+// type. It returns nil when the component is deployed from a pre-built image.
+func selectWorkflow(p *utils.Prompter, ct componentTypeOption) (*workflowOption, error) {
+	if len(ct.workflows) == 0 {
+		return nil, nil
+	}
+	labels := make([]string, 0, len(ct.workflows)+1)
+	for _, wf := range ct.workflows {
+		labels = append(labels, wf.label())
+	}
+	labels = append(labels, noBuildOption)
+	label, err := p.Select("Build workflow", labels, labels[0])
+	if err != nil {
+		return nil, err
+	}
+	for _, wf := range ct.workflows {
+		if wf.label() == label {
+			return &wf, nil
+		}
+	}
+	return nil, nil
+}
+
+// promptComponentParameters asks for the required component type parameters
+// that have no default.
+func (cp *Component) promptComponentParameters(ctx context.Context, p *utils.Prompter, namespace string, ct componentTypeOption) (map[string]any, error) {
+	var raw *json.RawMessage
+	var err error
+	if ct.kind == "ClusterComponentType" {
+		raw, err = cp.client.GetClusterComponentTypeSchema(ctx, ct.name)
+	} else {
+		raw, err = cp.client.GetComponentTypeSchema(ctx, namespace, ct.name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	schema, err := decodeRawSchema(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s schema: %w", ct.kind, err)
+	}
+
+	values := make(map[string]any)
+	if err := promptRequiredValues(p, schema, values, "", nil); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// promptWorkflowParameters asks for the source repository of the component,
+// located through the workflow's repository schema extensions, and for any other
+// required workflow parameters that have no default.
+func (cp *Component) promptWorkflowParameters(ctx context.Context, p *utils.Prompter, namespace string, wf workflowOption) (map[string]any, error) {
+	var raw *json.RawMessage
+	var err error
+	if wf.kind == "ClusterWorkflow" {
+		raw, err = cp.client.GetClusterWorkflowSchema(ctx, wf.name)
+	} else {
+		raw, err = cp.client.GetWorkflowSchema(ctx, namespace, wf.name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	schema, err := decodeRawSchema(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s schema: %w", wf.kind, err)
+	}
+
+	values := make(map[string]any)
+	repoPaths := make(map[string]string)
+	findRepositoryPaths(schema, "", repoPaths)
+	asked := make(map[string]bool)
+	for _, field := range []struct{ role, label string }{
+		{"url", "Repository URL"},
+		{"branch", "Branch"},
+		{"app-path", "Application path in the repository"},
+	} {
+		path, ok := repoPaths[field.role]
+		if !ok {
+			continue
+		}
+		def, _ := schemaAt(schema, path)["default"].(string)
+		answer, err := p.Input(field.label, def, requiredIf(field.role == "url", field.label))
+		if err != nil {
+			return nil, err
+		}
+		setPath(values, path, answer)
+		asked[path] = true
+	}
+
+	if err := promptRequiredValues(p, schema, values, "", asked); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// promptRequiredValues walks the required properties of an object schema and
+// asks for every scalar that has no default, descending into required objects.
+func promptRequiredValues(p *utils.Prompter, schema map[string]any, values map[string]any, prefix string, skip map[string]bool) error {
+	props, _ := schema["properties"].(map[string]any)
+	required, _ := schema["required"].([]any)
+	for _, r := range required {
+		name, _ := r.(string)
+		prop, ok := props[name].(map[string]any)
+		if !ok {
+			continue
+		}
+		path := joinPath(prefix, name)
+		if skip[path] {
+			continue
+		}
+		if _, hasDefault := prop["default"]; hasDefault {
+			continue
+		}
+
+		typ, _ := prop["type"].(string)
+		if typ == "object" {
+			if err := promptRequiredValues(p, prop, values, path, skip); err != nil {
+				return err
+			}
+			continue
+		}
+
+		value, ok, err := promptScalar(p, path, typ, prop)
+		if err != nil {
+			return err
+		}
+		if ok {
+			setPath(values, path, value)
+		}
+	}
+	return nil
+}
+
+// promptScalar asks for a string, number or boolean parameter. ok is false for
+// types that cannot be entered on a single line.
+func promptScalar(p *utils.Prompter, path, typ string, prop map[string]any) (value any, ok bool, err error) {
+	label := path
+	if desc, _ := prop["description"].(string); desc != "" {
+		label = fmt.Sprintf("%s (%s)", path, desc)
+	}
+
+	if enum, _ := prop["enum"].([]any); len(enum) > 0 {
+		options := make([]string, len(enum))
+		for i, e := range enum {
+			options[i] = fmt.Sprint(e)
+		}
+		answer, err := p.Select(label, options, "")
+		if err != nil {
+			return nil, false, err
+		}
+		return enum[slices.Index(options, answer)], true, nil
+	}
+
+	switch typ {
+	case "string":
+		answer, err := p.Input(label, "", requiredIf(true, path))
+		return answer, err == nil, err
+	case "integer":
+		answer, err := p.Input(label, "", func(s string) error {
+			_, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return fmt.Errorf("%s must be an integer", path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		n, _ := strconv.ParseInt(answer, 10, 64)
+		return n, true, nil
+	case "number":
+		answer, err := p.Input(label, "", func(s string) error {
+			_, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return fmt.Errorf("%s must be a number", path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		f, _ := strconv.ParseFloat(answer, 64)
+		return f, true, nil
+	case "boolean":
+		answer, err := p.Confirm(label, false)
+		return answer, err == nil, err
+	}
+	return nil, false, nil
+}
+
+// findRepositoryPaths maps each repository role (e.g. "url", "branch") to the
+// dotted path of the workflow parameter that carries it.
+func findRepositoryPaths(schema map[string]any, prefix string, result map[string]string) {
+	for key, val := range schema {
+		role, ok := strings.CutPrefix(key, repositoryExtensionPrefix)
+		if enabled, _ := val.(bool); ok && enabled && prefix != "" {
+			if _, exists := result[role]; !exists {
+				result[role] = prefix
+			}
+		}
+	}
+	props, _ := schema["properties"].(map[string]any)
+	for name, prop := range props {
+		if propSchema, ok := prop.(map[string]any); ok {
+			findRepositoryPaths(propSchema, joinPath(prefix, name), result)
+		}
+	}
+}
+
+// schemaAt returns the property schema at a dotted path, or nil.
+func schemaAt(schema map[string]any, path string) map[string]any {
+	current := schema
+	for _, part := range strings.Split(path, ".") {
+		props, _ := current["properties"].(map[string]any)
+		next, ok := props[part].(map[string]any)
+		if !ok {
+			return nil
+		}
+		current = next
+	}
+	return current
+}
+
+// setPath sets a value at a dotted path, creating intermediate objects.
+func setPath(values map[string]any, path string, value any) {
+	parts := strings.Split(path, ".")
+	current := values
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := current[part].(map[string]any)
+		if !ok {
+			next = make(map[string]any)
+			current[part] = next
+		}
+		current = next
+	}
+	current[parts[len(parts)-1]] = value
+}
+
+func joinPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+func decodeRawSchema(raw *json.RawMessage) (map[string]any, error) {
+	schema := make(map[string]any)
+	if raw == nil {
+		return schema, nil
+	}
+	if err := json.Unmarshal(*raw, &schema); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal schema: %w", err)
+	}
+	return schema, nil
+}
+
+func requiredIf(required bool, label string) func(string) error {
+	if !required {
+		return nil
+	}
+	return func(s string) error {
+		if s == "" {
+			return fmt.Errorf("%s is required", label)
+		}
+		return nil
+	}
+}
+
+func validateComponentName(name string) error {
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return fmt.Errorf("invalid component name %q: %s", name, strings.Join(errs, "; "))
+	}
+	return nil
+}
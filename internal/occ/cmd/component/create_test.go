@@ -0,0 +1,187 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+var (
+	createCTSchema = json.RawMessage(`{
+		"type": "object",
+		"required": ["port", "tier", "replicas"],
+		"properties": {
+			"port": {"type": "integer", "description": "Container port"},
+			"tier": {"type": "string", "enum": ["frontend", "backend"]},
+			"replicas": {"type": "integer", "default": 1}
+		}
+	}`)
+	createWFSchema = json.RawMessage(`{
+		"type": "object",
+		"required": ["repository"],
+		"properties": {
+			"repository": {
+				"type": "object",
+				"required": ["url"],
+				"properties": {
+					"url": {"type": "string", "x-openchoreo-component-parameter-repository-url": true},
+					"revision": {
+						"type": "object",
+						"properties": {
+							"branch": {"type": "string", "default": "main", "x-openchoreo-component-parameter-repository-branch": true}
+						}
+					},
+					"appPath": {"type": "string", "default": ".", "x-openchoreo-component-parameter-repository-app-path": true}
+				}
+			},
+			"docker": {"type": "object", "properties": {"filePath": {"type": "string", "default": "./Dockerfile"}}}
+		}
+	}`)
+)
+
+// expectComponentTypes registers a namespace-scoped service type that allows a
+// cluster build workflow, and a cluster-scoped cron job type without builds.
+func expectComponentTypes(mc *mocks.MockInterface) {
+	wfKind := gen.ComponentTypeSpecAllowedWorkflowsKindClusterWorkflow
+	service := gen.ComponentType{Metadata: gen.ObjectMeta{Name: "service"}, Spec: &gen.ComponentTypeSpec{
+		WorkloadType: gen.ComponentTypeSpecWorkloadTypeDeployment,
+	}}
+	service.Spec.AllowedWorkflows = &[]struct {
+		Kind *gen.ComponentTypeSpecAllowedWorkflowsKind `json:"kind,omitempty"`
+		Name string                                     `json:"name"`
+	}{{Kind: &wfKind, Name: "dockerfile-builder"}}
+	mc.EXPECT().ListComponentTypes(mock.Anything, "ns", mock.Anything).Return(&gen.ComponentTypeList{
+		Items: []gen.ComponentType{service},
+	}, nil)
+	mc.EXPECT().ListClusterComponentTypes(mock.Anything, mock.Anything).Return(&gen.ClusterComponentTypeList{
+		Items: []gen.ClusterComponentType{{
+			Metadata: gen.ObjectMeta{Name: "scheduled-task"},
+			Spec:     &gen.ClusterComponentTypeSpec{WorkloadType: gen.ClusterComponentTypeSpecWorkloadTypeCronjob},
+		}},
+	}, nil)
+}
+
+func runCreate(t *testing.T, mc *mocks.MockInterface, params CreateParams, answers ...string) (string, error) {
+	t.Helper()
+	var out bytes.Buffer
+	p := utils.NewPrompter(strings.NewReader(strings.Join(answers, "\n")+"\n"), &out)
+	err := New(mc).create(params, p, &out)
+	return out.String(), err
+}
+
+func TestCreate_FromSource(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	expectComponentTypes(mc)
+	mc.EXPECT().GetComponentTypeSchema(mock.Anything, "ns", "service").Return(&createCTSchema, nil)
+	mc.EXPECT().GetClusterWorkflowSchema(mock.Anything, "dockerfile-builder").Return(&createWFSchema, nil)
+
+	var created gen.Component
+	mc.EXPECT().CreateComponent(mock.Anything, "ns", mock.Anything).
+		Run(func(_ context.Context, _ string, comp gen.Component) { created = comp }).
+		Return(&gen.Component{Metadata: gen.ObjectMeta{Name: "orders"}}, nil)
+
+	out, err := runCreate(t, mc, CreateParams{Namespace: "ns", ProjectName: "shop"},
+		"Orders", // rejected: not a DNS label
+		"orders",
+		"",     // workload type: deployment (default)
+		"",     // component type: service (ComponentType)
+		"8080", // port
+		"2",    // tier: backend
+		"",     // build workflow: dockerfile-builder (default)
+		"https://github.com/acme/orders",
+		"",      // branch: main
+		"./svc", // app path
+		"",      // confirm
+	)
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "invalid component name")
+	assert.Contains(t, out, "Workload type:\n  1) cronjob\n  2) deployment\n")
+	assert.Contains(t, out, "Branch [main]: ")
+	assert.Contains(t, out, "kind: Component\n")
+	assert.Contains(t, out, "Component 'orders' created in project 'shop'")
+
+	assert.Nil(t, created.ApiVersion, "apiVersion is only part of the preview")
+	assert.Equal(t, "orders", created.Metadata.Name)
+	assert.Equal(t, "shop", created.Spec.Owner.ProjectName)
+	assert.Equal(t, "deployment/service", created.Spec.ComponentType.Name)
+	assert.Equal(t, gen.ComponentSpecComponentTypeKindComponentType, *created.Spec.ComponentType.Kind)
+	assert.Equal(t, map[string]any{"port": int64(8080), "tier": "backend"}, *created.Spec.Parameters)
+	require.NotNil(t, created.Spec.Workflow)
+	assert.Equal(t, "dockerfile-builder", created.Spec.Workflow.Name)
+	assert.Equal(t, gen.ComponentWorkflowConfigKindClusterWorkflow, *created.Spec.Workflow.Kind)
+	assert.Equal(t, map[string]any{
+		"repository": map[string]any{
+			"url":      "https://github.com/acme/orders",
+			"revision": map[string]any{"branch": "main"},
+			"appPath":  "./svc",
+		},
+	}, *created.Spec.Workflow.Parameters)
+}
+
+func TestCreate_PrebuiltImageDryRun(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	expectComponentTypes(mc)
+	mc.EXPECT().GetClusterComponentTypeSchema(mock.Anything, "scheduled-task").
+		Return(&minimalCTSchema, nil)
+
+	out, err := runCreate(t, mc, CreateParams{ComponentName: "cleanup", Namespace: "ns", ProjectName: "shop", DryRun: true},
+		"1", // workload type: cronjob
+		"",  // component type: scheduled-task (ClusterComponentType)
+	)
+	require.NoError(t, err)
+
+	assert.NotContains(t, out, "Build workflow")
+	assert.Contains(t, out, "name: cronjob/scheduled-task")
+	assert.Contains(t, out, "kind: ClusterComponentType")
+	assert.NotContains(t, out, "workflow:")
+	assert.NotContains(t, out, "created")
+}
+
+func TestCreate_Declined(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	expectComponentTypes(mc)
+	mc.EXPECT().GetComponentTypeSchema(mock.Anything, "ns", "service").Return(&minimalCTSchema, nil)
+
+	out, err := runCreate(t, mc, CreateParams{ComponentName: "orders", Namespace: "ns", ProjectName: "shop"},
+		"", "",
+		"2", // build workflow: none
+		"n",
+	)
+	require.NoError(t, err)
+	assert.Contains(t, out, "Aborted, no changes were made")
+}
+
+func TestCreate_RequiresProject(t *testing.T) {
+	_, err := runCreate(t, mocks.NewMockInterface(t), CreateParams{Namespace: "ns"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "project")
+}
+
+func TestFindRepositoryPaths(t *testing.T) {
+	schema, err := decodeRawSchema(&createWFSchema)
+	require.NoError(t, err)
+
+	paths := make(map[string]string)
+	findRepositoryPaths(schema, "", paths)
+	assert.Equal(t, map[string]string{
+		"url":      "repository.url",
+		"branch":   "repository.revision.branch",
+		"app-path": "repository.appPath",
+	}, paths)
+	assert.Equal(t, "main", schemaAt(schema, "repository.revision.branch")["default"])
+	assert.Nil(t, schemaAt(schema, "repository.missing"))
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 )
@@ -171,9 +172,11 @@ func (cp *Component) followLogs(
 	// Clear tail for subsequent polls — fetch all new logs in ascending order
 	params.Tail = 0
 
-	// Poll for new logs
+	// Poll for new logs, backing off between attempts while the observer is unreachable.
+	// startTime is only advanced on success, so no logs are lost across a reconnect.
 	ticker := time.NewTicker(2 * time.Second)
 	defer ticker.Stop()
+	reconnector := utils.NewReconnector(os.Stderr, "logs")
 
 	for {
 		select {
@@ -186,13 +189,12 @@ func (cp *Component) followLogs(
 			logs, err := cp.fetchLogs(ctx, observerURL, token, environmentID, params, startTime, endTime)
 			if err != nil {
 				// Check if context was cancelled
-				if ctx.Err() != nil {
+				if ctx.Err() != nil || !reconnector.Failed(ctx, err) {
 					return nil
 				}
-				// Log error but continue
-				fmt.Fprintf(os.Stderr, "Error fetching logs: %v\n", err)
 				continue
 			}
+			reconnector.Succeeded()
 
 			// Print new logs
 			for _, log := range logs {
@@ -250,7 +252,22 @@ func (cp *Component) fetchLogs(
 			params.Component, params.Project, params.Namespace, params.Environment, observerURL, err)
 	}
 
-	return logResponse.Logs, nil
+	return filterByContainer(logResponse.Logs, params.Container), nil
+}
+
+// filterByContainer keeps only the log entries produced by the given container.
+// The observer has no container scope, so the filter is applied client-side.
+func filterByContainer(logs []client.LogEntry, container string) []client.LogEntry {
+	if container == "" {
+		return logs
+	}
+	filtered := make([]client.LogEntry, 0, len(logs))
+	for _, log := range logs {
+		if log.Metadata != nil && log.Metadata.ContainerName == container {
+			filtered = append(filtered, log)
+		}
+	}
+	return filtered
 }
 
 // findRootEnvironment finds the lowest environment in a deployment pipeline.
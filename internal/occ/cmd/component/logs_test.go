@@ -435,3 +435,31 @@ func TestLogs_WithTail(t *testing.T) {
 	assert.Contains(t, out, "second")
 	assert.Contains(t, out, "third")
 }
+
+func TestLogs_WithContainer(t *testing.T) {
+	setupLogsConfig(t)
+
+	observerURL := observerTestURL
+	mc := mocks.NewMockInterface(t)
+	setupMockForLogs(t, mc, observerURL)
+
+	testutil.SetTransport(t, testutil.RoundTripFunc(func(r *http.Request) (*http.Response, error) {
+		return testutil.JSONResp(http.StatusOK, client.LogResponse{
+			Logs: []client.LogEntry{
+				{Timestamp: "2026-01-01T00:00:00Z", Log: "from main", Metadata: &client.LogEntryMetadata{ContainerName: "main"}},
+				{Timestamp: "2026-01-01T00:00:10Z", Log: "from sidecar", Metadata: &client.LogEntryMetadata{ContainerName: "proxy"}},
+				{Timestamp: "2026-01-01T00:00:20Z", Log: "without metadata"},
+			},
+		}), nil
+	}))
+
+	cp := New(mc)
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, cp.Logs(LogsParams{
+			Namespace: "ns", Project: "my-proj", Component: "my-comp", Container: "main",
+		}))
+	})
+	assert.Contains(t, out, "from main")
+	assert.NotContains(t, out, "from sidecar")
+	assert.NotContains(t, out, "without metadata")
+}
@@ -7,6 +7,7 @@ package component
 type ListParams struct {
 	Namespace string
 	Project   string
+	Output    string // --output format
 }
 
 func (p ListParams) GetNamespace() string { return p.Namespace }
@@ -27,6 +28,15 @@ type ScaffoldParams struct {
 	SkipOptional         bool // skip optional fields without defaults
 }
 
+// CreateParams defines parameters for creating a component interactively
+type CreateParams struct {
+	ComponentName string // prompted for when empty
+	Namespace     string
+	ProjectName   string
+	DryRun        bool // preview the manifest without creating it
+	Yes           bool // create without asking for confirmation
+}
+
 // DeployParams defines parameters for deploying or promoting a component
 type DeployParams struct {
 	ComponentName string
@@ -41,10 +51,22 @@ func (p DeployParams) GetNamespace() string     { return p.Namespace }
 func (p DeployParams) GetProject() string       { return p.Project }
 func (p DeployParams) GetComponentName() string { return p.ComponentName }
 
+// RollbackParams defines parameters for rolling back a component in an environment
+type RollbackParams struct {
+	ComponentName string
+	Namespace     string
+	Environment   string
+	Release       string // --release flag (optional, defaults to the previous release)
+}
+
+func (p RollbackParams) GetNamespace() string     { return p.Namespace }
+func (p RollbackParams) GetComponentName() string { return p.ComponentName }
+
 // GetParams defines parameters for getting a single component
 type GetParams struct {
 	Namespace     string
 	ComponentName string
+	Output        string // --output format
 }
 
 func (p GetParams) GetNamespace() string { return p.Namespace }
@@ -99,6 +121,7 @@ type LogsParams struct {
 	Follow      bool
 	Since       string // duration like "1h", "30m", "5m"
 	Tail        int    // number of lines to show from the end of logs (0 means no limit)
+	Container   string // optional container name; empty means all containers
 }
 
 // ExecParams defines parameters for exec-ing into a component's running pod
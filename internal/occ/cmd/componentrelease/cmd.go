@@ -117,12 +117,14 @@ func newListCmd(f client.NewClientFunc) *cobra.Command {
 				Namespace: flags.GetNamespace(cmd),
 				Project:   flags.GetProject(cmd),
 				Component: flags.GetComponent(cmd),
+				Output:    flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
 	flags.AddProject(cmd)
 	flags.AddComponent(cmd)
+	flags.AddListOutputFormat(cmd)
 	return cmd
 }
 
@@ -130,7 +132,7 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get [COMPONENT_RELEASE_NAME]",
 		Short: "Get a component release",
-		Long:  `Get a component release and display its details in YAML format.`,
+		Long:  `Get a component release and display its details in YAML, or in the format set by --output.`,
 		Example: `  # Get a component release
   occ componentrelease get my-release --namespace acme-corp`,
 		Args:    cmdutil.ExactOneArgWithUsage(),
@@ -143,10 +145,12 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 			return New(cl).Get(GetParams{
 				Namespace:            flags.GetNamespace(cmd),
 				ComponentReleaseName: args[0],
+				Output:               flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
+	flags.AddGetOutputFormat(cmd)
 	return cmd
 }
 
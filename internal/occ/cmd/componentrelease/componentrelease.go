@@ -22,6 +22,7 @@ import (
 	occonfig "github.com/openchoreo/openchoreo/internal/occ/fsmode/config"
 	"github.com/openchoreo/openchoreo/internal/occ/fsmode/generator"
 	"github.com/openchoreo/openchoreo/internal/occ/fsmode/output"
+	"github.com/openchoreo/openchoreo/internal/occ/printer"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 	"github.com/openchoreo/openchoreo/pkg/fsindex/cache"
@@ -70,6 +71,9 @@ func (cr *ComponentRelease) List(params ListParams) error {
 		return err
 	}
 
+	if params.Output != "" {
+		return printer.PrintList(os.Stdout, items, params.Output)
+	}
 	return printComponentReleases(items)
 }
 
@@ -193,7 +197,7 @@ func (cr *ComponentRelease) Delete(params DeleteParams) error {
 	return nil
 }
 
-// Get retrieves a single component release and outputs it as YAML
+// Get retrieves a single component release and prints it in the requested output format, YAML by default
 func (cr *ComponentRelease) Get(params GetParams) error {
 	if err := cmdutil.RequireFields("get", "componentrelease", map[string]string{"namespace": params.Namespace}); err != nil {
 		return err
@@ -206,13 +210,7 @@ func (cr *ComponentRelease) Get(params GetParams) error {
 		return err
 	}
 
-	data, err := yaml.Marshal(result)
-	if err != nil {
-		return fmt.Errorf("failed to marshal component release to YAML: %w", err)
-	}
-
-	fmt.Print(string(data))
-	return nil
+	return printer.Print(os.Stdout, result, params.Output)
 }
 
 // loadReleaseConfig loads the release-config.yaml file
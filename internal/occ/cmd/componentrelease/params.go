@@ -21,6 +21,7 @@ type ListParams struct {
 	Namespace string
 	Project   string
 	Component string
+	Output    string // --output format
 }
 
 func (p ListParams) GetNamespace() string { return p.Namespace }
@@ -31,6 +32,7 @@ func (p ListParams) GetComponent() string { return p.Component }
 type GetParams struct {
 	Namespace            string
 	ComponentReleaseName string
+	Output               string // --output format
 }
 
 func (p GetParams) GetNamespace() string { return p.Namespace }
@@ -42,10 +42,12 @@ func newListCmd(f client.NewClientFunc) *cobra.Command {
 			}
 			return New(cl).List(ListParams{
 				Namespace: flags.GetNamespace(cmd),
+				Output:    flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
+	flags.AddListOutputFormat(cmd)
 	return cmd
 }
 
@@ -53,7 +55,7 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get [COMPONENT_TYPE_NAME]",
 		Short: "Get a component type",
-		Long:  `Get a component type and display its details in YAML format.`,
+		Long:  `Get a component type and display its details in YAML, or in the format set by --output.`,
 		Example: `  # Get a component type
   occ componenttype get web-app --namespace acme-corp`,
 		Args:    cmdutil.ExactOneArgWithUsage(),
@@ -66,10 +68,12 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 			return New(cl).Get(GetParams{
 				Namespace:         flags.GetNamespace(cmd),
 				ComponentTypeName: args[0],
+				Output:            flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
+	flags.AddGetOutputFormat(cmd)
 	return cmd
 }
 
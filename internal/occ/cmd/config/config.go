@@ -9,6 +9,8 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
 )
 
 // Config implements context-related commands.
@@ -251,11 +253,40 @@ func (c *Config) UseContext(params UseContextParams) error {
 	return nil
 }
 
+// isConfigCommand reports whether cmd manages the stored configuration.
+func isConfigCommand(cmd *cobra.Command) bool {
+	return cmd.Parent() != nil && (cmd.Parent().Name() == "config" || cmd.Parent().Name() == "context" || cmd.Parent().Name() == "controlplane" || cmd.Parent().Name() == "credentials")
+}
+
+// ApplyContextOverride makes the context given with --context the current
+// context for this invocation only. The stored current context is left as is.
+func ApplyContextOverride(cmd *cobra.Command) error {
+	name := flags.GetContext(cmd)
+	if name == "" {
+		return nil
+	}
+	if isConfigCommand(cmd) {
+		return fmt.Errorf("--context cannot be used with config commands; use 'occ config context use %s' to switch contexts", name)
+	}
+
+	cfg, err := LoadStoredConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	for _, c := range cfg.Contexts {
+		if c.Name == name {
+			SetContextOverride(name)
+			return nil
+		}
+	}
+	return fmt.Errorf("context %q not found", name)
+}
+
 // ApplyContextDefaults loads the stored config and sets default flag values
 // from the current context, if not already provided.
 func ApplyContextDefaults(cmd *cobra.Command) error {
 	// Skip for config commands to avoid circular dependencies
-	if cmd.Parent() != nil && (cmd.Parent().Name() == "config" || cmd.Parent().Name() == "context" || cmd.Parent().Name() == "controlplane" || cmd.Parent().Name() == "credentials") {
+	if isConfigCommand(cmd) {
 		return nil
 	}
 
@@ -553,6 +584,16 @@ func GetCurrentContext() (*Context, error) {
 	return nil, fmt.Errorf("current context '%s' not found", cfg.CurrentContext)
 }
 
+// DefaultNamespace returns the namespace of the current context, or an empty
+// string if no context is set
+func DefaultNamespace() string {
+	ctx, err := GetCurrentContext()
+	if err != nil {
+		return ""
+	}
+	return ctx.Namespace
+}
+
 // GetCurrentCredential returns the credential for the current context
 func GetCurrentCredential() (*Credential, error) {
 	currentContext, err := GetCurrentContext()
@@ -661,6 +661,25 @@ func TestUseContext(t *testing.T) {
 	})
 }
 
+func TestDefaultNamespace(t *testing.T) {
+	t.Run("returns namespace of current context", func(t *testing.T) {
+		setupTestHome(t)
+		seedConfig(t, &StoredConfig{
+			CurrentContext: "ctx1",
+			Contexts:       []Context{{Name: "ctx1", Namespace: "acme"}},
+		})
+		assert.Equal(t, "acme", DefaultNamespace())
+	})
+
+	t.Run("returns empty without current context", func(t *testing.T) {
+		setupTestHome(t)
+		seedConfig(t, &StoredConfig{
+			Contexts: []Context{{Name: "ctx1", Namespace: "acme"}},
+		})
+		assert.Empty(t, DefaultNamespace())
+	})
+}
+
 func TestAddControlPlane(t *testing.T) {
 	c := New()
 
@@ -1070,6 +1089,65 @@ func TestApplyContextDefaults(t *testing.T) {
 	})
 }
 
+func TestApplyContextOverride(t *testing.T) {
+	newCmd := func(contextName string) *cobra.Command {
+		cmd := &cobra.Command{Use: "test"}
+		cmd.Flags().String("context", "", "")
+		if contextName != "" {
+			require.NoError(t, cmd.Flags().Set("context", contextName))
+		}
+		return cmd
+	}
+	t.Cleanup(func() { SetContextOverride("") })
+
+	t.Run("keeps the current context without the flag", func(t *testing.T) {
+		setupTestHome(t)
+		seedConfig(t, &StoredConfig{CurrentContext: "dev", Contexts: []Context{{Name: "dev"}}})
+		require.NoError(t, ApplyContextOverride(newCmd("")))
+
+		ctx, err := GetCurrentContext()
+		require.NoError(t, err)
+		assert.Equal(t, "dev", ctx.Name)
+	})
+
+	t.Run("switches the current context for the command", func(t *testing.T) {
+		setupTestHome(t)
+		seedConfig(t, &StoredConfig{
+			CurrentContext: "dev",
+			Contexts:       []Context{{Name: "dev"}, {Name: "prod", Namespace: "prod-ns"}},
+		})
+		require.NoError(t, ApplyContextOverride(newCmd("prod")))
+		t.Cleanup(func() { SetContextOverride("") })
+
+		ctx, err := GetCurrentContext()
+		require.NoError(t, err)
+		assert.Equal(t, "prod", ctx.Name)
+
+		cmd := newCmd("")
+		cmd.Flags().String("namespace", "", "")
+		require.NoError(t, ApplyContextDefaults(cmd))
+		assert.Equal(t, "prod-ns", cmd.Flags().Lookup("namespace").Value.String())
+	})
+
+	t.Run("returns error for unknown context", func(t *testing.T) {
+		setupTestHome(t)
+		seedConfig(t, &StoredConfig{CurrentContext: "dev", Contexts: []Context{{Name: "dev"}}})
+		err := ApplyContextOverride(newCmd("ghost"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `context "ghost" not found`)
+	})
+
+	t.Run("rejects config subcommands", func(t *testing.T) {
+		setupTestHome(t)
+		parent := &cobra.Command{Use: "context"}
+		cmd := newCmd("dev")
+		parent.AddCommand(cmd)
+		err := ApplyContextOverride(cmd)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot be used with config commands")
+	})
+}
+
 func TestUpdateContext_ComponentField(t *testing.T) {
 	c := New()
 
@@ -11,6 +11,17 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
+// contextOverride is the context selected with --context for the running
+// command. It replaces the current context of every loaded config, but is
+// never persisted.
+var contextOverride string
+
+// SetContextOverride makes the named context the current context for the
+// running command. An empty name removes the override.
+func SetContextOverride(name string) {
+	contextOverride = name
+}
+
 // IsConfigFileExists checks if the configuration file exists
 func IsConfigFileExists() bool {
 	configPath, err := getConfigFilePath()
@@ -39,9 +50,11 @@ func LoadStoredConfig() (*StoredConfig, error) {
 
 	data, err := os.ReadFile(configPath)
 	if os.IsNotExist(err) {
-		return &StoredConfig{
+		cfg := &StoredConfig{
 			Contexts: []Context{},
-		}, nil
+		}
+		applyContextOverride(cfg)
+		return cfg, nil
 	} else if err != nil {
 		return nil, fmt.Errorf("failed to read config file: %w", err)
 	}
@@ -51,9 +64,19 @@ func LoadStoredConfig() (*StoredConfig, error) {
 		return nil, fmt.Errorf("failed to parse config: %w", err)
 	}
 
+	applyContextOverride(&cfg)
 	return &cfg, nil
 }
 
+// applyContextOverride replaces the current context of cfg with the context
+// override, remembering the persisted one.
+func applyContextOverride(cfg *StoredConfig) {
+	cfg.persistedContext = cfg.CurrentContext
+	if contextOverride != "" {
+		cfg.CurrentContext = contextOverride
+	}
+}
+
 // SaveStoredConfig persists the configuration to disk
 func SaveStoredConfig(cfg *StoredConfig) error {
 	configPath, err := getConfigFilePath()
@@ -66,7 +89,13 @@ func SaveStoredConfig(cfg *StoredConfig) error {
 		return fmt.Errorf("failed to create config directory: %w", err)
 	}
 
-	data, err := yaml.Marshal(cfg)
+	// Keep the persisted current context when only the override selected it
+	stored := *cfg
+	if contextOverride != "" && cfg.CurrentContext == contextOverride {
+		stored.CurrentContext = cfg.persistedContext
+	}
+
+	data, err := yaml.Marshal(&stored)
 	if err != nil {
 		return fmt.Errorf("failed to serialize config: %w", err)
 	}
@@ -136,6 +136,31 @@ func TestSaveStoredConfig(t *testing.T) {
 	})
 }
 
+func TestContextOverride(t *testing.T) {
+	setupTestHome(t)
+	seedConfig(t, &StoredConfig{
+		CurrentContext: "dev",
+		Contexts:       []Context{{Name: "dev"}, {Name: "prod"}},
+	})
+
+	SetContextOverride("prod")
+	t.Cleanup(func() { SetContextOverride("") })
+
+	cfg, err := LoadStoredConfig()
+	require.NoError(t, err)
+	assert.Equal(t, "prod", cfg.CurrentContext)
+
+	// Saving while the override is set keeps the stored current context
+	cfg.Credentials = []Credential{{Name: "cred1"}}
+	require.NoError(t, SaveStoredConfig(cfg))
+
+	SetContextOverride("")
+	cfg, err = LoadStoredConfig()
+	require.NoError(t, err)
+	assert.Equal(t, "dev", cfg.CurrentContext)
+	require.Len(t, cfg.Credentials, 1)
+}
+
 func TestIsConfigFileExists(t *testing.T) {
 	t.Run("returns false when file does not exist", func(t *testing.T) {
 		setupTestHome(t)
@@ -9,6 +9,10 @@ type StoredConfig struct {
 	ControlPlanes  []ControlPlane `yaml:"controlplanes"`
 	Credentials    []Credential   `yaml:"credentials,omitempty"`
 	Contexts       []Context      `yaml:"contexts"`
+
+	// persistedContext is the current context stored on disk, which differs
+	// from CurrentContext while a context override is set.
+	persistedContext string
 }
 
 // ControlPlane defines OpenChoreo API server configuration
@@ -25,7 +29,7 @@ type Credential struct {
 	Scope        string `yaml:"scope,omitempty"`
 	Token        string `yaml:"token,omitempty"`
 	RefreshToken string `yaml:"refreshToken,omitempty"`
-	AuthMethod   string `yaml:"authMethod,omitempty"` // "pkce" or "client_credentials"
+	AuthMethod   string `yaml:"authMethod,omitempty"` // "authorization_code", "device_code" or "client_credentials"
 }
 
 // Context represents a single named configuration context.
@@ -42,10 +42,12 @@ func newListCmd(f client.NewClientFunc) *cobra.Command {
 			}
 			return New(cl).List(ListParams{
 				Namespace: flags.GetNamespace(cmd),
+				Output:    flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
+	flags.AddListOutputFormat(cmd)
 	return cmd
 }
 
@@ -53,7 +55,7 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get [DATAPLANE_NAME]",
 		Short: "Get a data plane",
-		Long:  `Get a data plane and display its details in YAML format.`,
+		Long:  `Get a data plane and display its details in YAML, or in the format set by --output.`,
 		Example: `  # Get a data plane
   occ dataplane get primary-dataplane --namespace acme-corp`,
 		Args:    cmdutil.ExactOneArgWithUsage(),
@@ -66,10 +68,12 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 			return New(cl).Get(GetParams{
 				Namespace:     flags.GetNamespace(cmd),
 				DataPlaneName: args[0],
+				Output:        flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
+	flags.AddGetOutputFormat(cmd)
 	return cmd
 }
 
@@ -9,11 +9,10 @@ import (
 	"os"
 	"text/tabwriter"
 
-	"sigs.k8s.io/yaml"
-
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
 	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/printer"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 )
@@ -56,10 +55,13 @@ func (d *DataPlane) List(params ListParams) error {
 		return err
 	}
 
+	if params.Output != "" {
+		return printer.PrintList(os.Stdout, items, params.Output)
+	}
 	return printList(items)
 }
 
-// Get retrieves a single data plane and outputs it as YAML
+// Get retrieves a single data plane and prints it in the requested output format, YAML by default
 func (d *DataPlane) Get(params GetParams) error {
 	if err := cmdutil.RequireFields("get", "dataplane", map[string]string{"namespace": params.Namespace}); err != nil {
 		return err
@@ -72,13 +74,7 @@ func (d *DataPlane) Get(params GetParams) error {
 		return err
 	}
 
-	data, err := yaml.Marshal(result)
-	if err != nil {
-		return fmt.Errorf("failed to marshal data plane to YAML: %w", err)
-	}
-
-	fmt.Print(string(data))
-	return nil
+	return printer.Print(os.Stdout, result, params.Output)
 }
 
 // Delete deletes a single data plane
@@ -42,10 +42,12 @@ func newListCmd(f client.NewClientFunc) *cobra.Command {
 			}
 			return New(cl).List(ListParams{
 				Namespace: flags.GetNamespace(cmd),
+				Output:    flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
+	flags.AddListOutputFormat(cmd)
 	return cmd
 }
 
@@ -53,7 +55,7 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get [DEPLOYMENT_PIPELINE_NAME]",
 		Short: "Get a deployment pipeline",
-		Long:  `Get a deployment pipeline and display its details in YAML format.`,
+		Long:  `Get a deployment pipeline and display its details in YAML, or in the format set by --output.`,
 		Example: `  # Get a deployment pipeline
   occ deploymentpipeline get my-pipeline --namespace acme-corp`,
 		Args:    cmdutil.ExactOneArgWithUsage(),
@@ -66,10 +68,12 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 			return New(cl).Get(GetParams{
 				Namespace:              flags.GetNamespace(cmd),
 				DeploymentPipelineName: args[0],
+				Output:                 flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
+	flags.AddGetOutputFormat(cmd)
 	return cmd
 }
 
@@ -6,6 +6,7 @@ package deploymentpipeline
 // ListParams defines parameters for listing deployment pipelines
 type ListParams struct {
 	Namespace string
+	Output    string // --output format
 }
 
 func (p ListParams) GetNamespace() string { return p.Namespace }
@@ -14,6 +15,7 @@ func (p ListParams) GetNamespace() string { return p.Namespace }
 type GetParams struct {
 	Namespace              string
 	DeploymentPipelineName string
+	Output                 string // --output format
 }
 
 func (p GetParams) GetNamespace() string { return p.Namespace }
@@ -0,0 +1,89 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package doctor
+
+// serverCheck describes a check run by the API server: how it is titled, how
+// severe its failure is and how to remediate it.
+type serverCheck struct {
+	name        string
+	title       string
+	severity    status
+	remediation string
+}
+
+// serverChecks lists the known checks of the API server diagnostics, most
+// fundamental first. A failure further down the list is often caused by one
+// above it, so remediation steps follow this order.
+var serverChecks = []serverCheck{
+	{
+		name:     "kubernetes",
+		title:    "Kubernetes API",
+		severity: statusFailed,
+		remediation: "The API server cannot reach the Kubernetes API server of the control plane cluster. Check the logs of openchoreo-api:\n" +
+			"  kubectl -n openchoreo-control-plane logs deploy/openchoreo-api",
+	},
+	{
+		name:     "crds",
+		title:    "CRDs",
+		severity: statusFailed,
+		remediation: "Upgrade the openchoreo-control-plane Helm release, which installs the CRDs of its version, and check the installed CRDs:\n" +
+			"  kubectl get crds | grep openchoreo.dev",
+	},
+	{
+		name:     "readCache",
+		title:    "Read cache",
+		severity: statusFailed,
+		remediation: "The informer cache of openchoreo-api has not synced. Restart it and check its logs for RBAC errors:\n" +
+			"  kubectl -n openchoreo-control-plane rollout restart deploy/openchoreo-api",
+	},
+	{
+		name:     "authzCache",
+		title:    "Authorization cache",
+		severity: statusFailed,
+		remediation: "The authorization cache of openchoreo-api has not synced. Restart it and check its logs for RBAC errors:\n" +
+			"  kubectl -n openchoreo-control-plane rollout restart deploy/openchoreo-api",
+	},
+	{
+		name:     "webhookCertificates",
+		title:    "Webhook certificates",
+		severity: statusFailed,
+		remediation: "Writes to the listed resources fail while the API server cannot verify the admission webhooks. Check that cert-manager is running and that the webhook certificate is ready:\n" +
+			"  kubectl -n cert-manager get pods\n" +
+			"  kubectl -n openchoreo-control-plane get certificate controller-manager-webhook-server-cert",
+	},
+	{
+		name:     "dataPlanes",
+		title:    "Data plane connectivity",
+		severity: statusFailed,
+		remediation: "Components are not deployed to a disconnected data plane. Check the cluster agent in each listed data plane, and that spec.clusterAgent.clientCA of the plane matches the agent's certificate:\n" +
+			"  kubectl -n openchoreo-data-plane logs -l app.kubernetes.io/component=cluster-agent --tail=50",
+	},
+	{
+		name:     "gateways",
+		title:    "Gateway readiness",
+		severity: statusFailed,
+		remediation: "Endpoints are not reachable through a gateway that is not programmed. Check the Gateway resources and the gateway controller in the data plane:\n" +
+			"  kubectl get gateways -A\n" +
+			"  kubectl -n <gateway-namespace> describe gateway <gateway-name>",
+	},
+	{
+		name:     "workflowPlanes",
+		title:    "Workflow plane connectivity",
+		severity: statusFailed,
+		remediation: "Builds do not run on a disconnected workflow plane. Check the cluster agent in each listed workflow plane:\n" +
+			"  kubectl -n openchoreo-workflow-plane logs -l app.kubernetes.io/component=cluster-agent --tail=50",
+	},
+}
+
+// Remediation steps of the checks run by occ itself.
+const (
+	controlPlaneRemediation = "Check the control plane URL of the current context and that openchoreo-api is running:\n" +
+		"  occ config controlplane list\n" +
+		"  kubectl -n openchoreo-control-plane get pods"
+	diagnosticsRemediation = "Check that you are logged in with occ login. Control planes older than occ do not serve diagnostics; upgrade\n" +
+		"the control plane for the full set of checks."
+	unknownCheckRemediation = "Check the logs of openchoreo-api:\n" +
+		"  kubectl -n openchoreo-control-plane logs deploy/openchoreo-api"
+	versionSkewRemediation = "Use the occ release that matches the control plane version, or upgrade the control plane."
+)
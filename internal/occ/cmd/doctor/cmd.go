@@ -0,0 +1,40 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package doctor
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/internal/occ/auth"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func NewDoctorCmd(f client.NewClientFunc) *cobra.Command {
+	return &cobra.Command{
+		Use:   "doctor",
+		Short: "Diagnose the OpenChoreo installation",
+		Long: `Check the health of the OpenChoreo installation and print the steps to
+remediate the problems found, most urgent first.
+
+The checks cover the reachability of the control plane, the versions of the
+control plane and its CRDs against those occ was built for, and the
+diagnostics run by the API server: Kubernetes API connectivity, installed
+CRDs, informer caches, admission webhook certificates, the connectivity of
+data and workflow plane agents, and the readiness of the data plane ingress
+gateways.
+
+The command fails when a check failed; warnings alone do not fail it.`,
+		Example: `  # Diagnose the installation of the current control plane
+  occ doctor`,
+		Args:    cobra.NoArgs,
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).Run()
+		},
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package doctor
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func TestNewDoctorCmd(t *testing.T) {
+	cmd := NewDoctorCmd(func() (client.Interface, error) { return nil, fmt.Errorf("unused") })
+
+	assert.Equal(t, "doctor", cmd.Name())
+	assert.NotNil(t, cmd.PreRunE)
+	assert.Error(t, cmd.Args(cmd, []string{"extra"}))
+}
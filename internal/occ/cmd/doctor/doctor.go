@@ -0,0 +1,293 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	utilversion "k8s.io/apimachinery/pkg/util/version"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/schema"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/version"
+)
+
+// status is the outcome of a check.
+type status string
+
+const (
+	statusOK      status = "ok"
+	statusWarning status = "warning"
+	statusFailed  status = "failed"
+)
+
+// maxListedKinds caps how many kinds a CRD version message names.
+const maxListedKinds = 5
+
+// result is the outcome of one check with the steps to remediate it.
+type result struct {
+	title       string
+	status      status
+	message     string
+	remediation string
+}
+
+type Doctor struct {
+	client        client.Interface
+	clientVersion string
+}
+
+func New(c client.Interface) *Doctor {
+	return &Doctor{client: c, clientVersion: version.Get().Version}
+}
+
+// Run checks the installation: that the control plane is reachable, that its
+// version and CRDs match those occ was built for, and the diagnostics of the
+// API server. It prints the outcome of every check followed by the steps to
+// remediate the problems found, most urgent first, and fails when a check
+// failed.
+func (d *Doctor) Run() error {
+	return d.run(context.Background(), os.Stdout)
+}
+
+func (d *Doctor) run(ctx context.Context, out io.Writer) error {
+	results := d.check(ctx)
+	if err := printResults(out, results); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, r := range results {
+		if r.status == statusFailed {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d check(s) failed", failed)
+	}
+	return nil
+}
+
+// check runs the checks in priority order. Nothing else is checked when the
+// control plane cannot be reached.
+func (d *Doctor) check(ctx context.Context) []result {
+	serverVersion, err := d.client.GetServerVersion(ctx)
+	if err != nil {
+		return []result{{
+			title:       "Control plane",
+			status:      statusFailed,
+			message:     err.Error(),
+			remediation: controlPlaneRemediation,
+		}}
+	}
+
+	results := []result{
+		{title: "Control plane", status: statusOK, message: "reachable"},
+		versionCheck(d.clientVersion, serverVersion.Version),
+		d.crdCheck(ctx),
+	}
+	return append(results, d.diagnostics(ctx)...)
+}
+
+// versionCheck warns when the minor versions of occ and the control plane
+// differ. Development builds are not compared.
+func versionCheck(clientVersion, serverVersion string) result {
+	r := result{title: "Version", status: statusOK, message: fmt.Sprintf("occ %s, control plane %s", clientVersion, serverVersion)}
+	cv, err := utilversion.ParseSemantic(clientVersion)
+	if err != nil {
+		return r
+	}
+	sv, err := utilversion.ParseSemantic(serverVersion)
+	if err != nil {
+		return r
+	}
+	if cv.Major() != sv.Major() || cv.Minor() != sv.Minor() {
+		r.status = statusWarning
+		r.message = fmt.Sprintf("occ %s does not match control plane %s", clientVersion, serverVersion)
+		r.remediation = versionSkewRemediation
+	}
+	return r
+}
+
+func (d *Doctor) crdCheck(ctx context.Context) result {
+	served, err := d.client.ListKindSchemas(ctx)
+	if err != nil {
+		return result{
+			title:       "CRD versions",
+			status:      statusWarning,
+			message:     err.Error(),
+			remediation: diagnosticsRemediation,
+		}
+	}
+	expected, err := schema.Embedded()
+	if err != nil {
+		return result{
+			title:   "CRD versions",
+			status:  statusWarning,
+			message: fmt.Sprintf("failed to load the CRDs built into occ: %v", err),
+		}
+	}
+	return compareCRDs(served, expected)
+}
+
+// compareCRDs compares the OpenChoreo kinds served by the control plane, at
+// their storage versions, with the kinds and versions occ was built for.
+func compareCRDs(served []gen.KindSchema, expected schema.Set) result {
+	group := openchoreov1alpha1.GroupVersion.Group
+	known := map[string][]string{}
+	for gvk := range expected {
+		if gvk.Group == group {
+			known[gvk.Kind] = append(known[gvk.Kind], gvk.Version)
+		}
+	}
+
+	servedKinds := map[string]bool{}
+	var unknownKinds, unknownVersions, missing []string
+	for _, ks := range served {
+		if ks.Group != group {
+			continue
+		}
+		servedKinds[ks.Kind] = true
+		versions, ok := known[ks.Kind]
+		switch {
+		case !ok:
+			unknownKinds = append(unknownKinds, ks.Kind)
+		case !slices.Contains(versions, ks.Version):
+			unknownVersions = append(unknownVersions, ks.Kind+" "+ks.Version)
+		}
+	}
+	for kind := range known {
+		if !servedKinds[kind] {
+			missing = append(missing, kind)
+		}
+	}
+
+	var problems []string
+	if len(missing) > 0 {
+		problems = append(problems, fmt.Sprintf("%d kind(s) known to occ are not served: %s", len(missing), listKinds(missing)))
+	}
+	if len(unknownVersions) > 0 {
+		problems = append(problems, fmt.Sprintf("%d kind(s) are served at versions unknown to occ: %s", len(unknownVersions), listKinds(unknownVersions)))
+	}
+	if len(unknownKinds) > 0 {
+		problems = append(problems, fmt.Sprintf("%d served kind(s) are unknown to occ: %s", len(unknownKinds), listKinds(unknownKinds)))
+	}
+	if len(problems) == 0 {
+		return result{title: "CRD versions", status: statusOK, message: fmt.Sprintf("%d kinds match", len(known))}
+	}
+	return result{
+		title:       "CRD versions",
+		status:      statusWarning,
+		message:     strings.Join(problems, "; "),
+		remediation: versionSkewRemediation,
+	}
+}
+
+// listKinds sorts and joins kinds, naming at most maxListedKinds of them.
+func listKinds(kinds []string) string {
+	slices.Sort(kinds)
+	if len(kinds) > maxListedKinds {
+		kinds = append(kinds[:maxListedKinds:maxListedKinds], "...")
+	}
+	return strings.Join(kinds, ", ")
+}
+
+// diagnostics returns the results of the checks run by the API server, known
+// checks in priority order followed by any others in name order.
+func (d *Doctor) diagnostics(ctx context.Context) []result {
+	report, err := d.client.GetDiagnostics(ctx)
+	if err != nil {
+		return []result{{
+			title:       "Diagnostics",
+			status:      statusWarning,
+			message:     err.Error(),
+			remediation: diagnosticsRemediation,
+		}}
+	}
+
+	var results []result
+	known := map[string]bool{}
+	for _, check := range serverChecks {
+		known[check.name] = true
+		if r, ok := report.Checks[check.name]; ok {
+			results = append(results, toResult(check, r))
+		}
+	}
+	var others []string
+	for name := range report.Checks {
+		if !known[name] {
+			others = append(others, name)
+		}
+	}
+	slices.Sort(others)
+	for _, name := range others {
+		check := serverCheck{name: name, title: name, severity: statusFailed, remediation: unknownCheckRemediation}
+		results = append(results, toResult(check, report.Checks[name]))
+	}
+	return results
+}
+
+func toResult(check serverCheck, r gen.HealthCheckResult) result {
+	if r.Status == gen.HealthCheckResultStatusOk {
+		return result{title: check.title, status: statusOK}
+	}
+	res := result{title: check.title, status: check.severity, remediation: check.remediation}
+	if r.Message != nil {
+		res.message = *r.Message
+	}
+	return res
+}
+
+// printResults prints a table of the results followed by the remediation
+// steps of the problems, failures before warnings.
+func printResults(out io.Writer, results []result) error {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "CHECK\tSTATUS\tDETAILS")
+	for _, r := range results {
+		message := r.message
+		if message == "" {
+			message = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", r.title, r.status, message)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	var problems []result
+	for _, r := range results {
+		if r.status == statusFailed {
+			problems = append(problems, r)
+		}
+	}
+	for _, r := range results {
+		if r.status == statusWarning {
+			problems = append(problems, r)
+		}
+	}
+	if len(problems) == 0 {
+		fmt.Fprintln(out, "\nNo problems found.")
+		return nil
+	}
+
+	fmt.Fprintf(out, "\n%d problem(s) found, most urgent first:\n", len(problems))
+	for i, r := range problems {
+		fmt.Fprintf(out, "\n%d. %s (%s)\n", i+1, r.title, r.status)
+		if r.remediation == "" {
+			continue
+		}
+		for _, line := range strings.Split(r.remediation, "\n") {
+			fmt.Fprintf(out, "   %s\n", line)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package doctor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	occschema "github.com/openchoreo/openchoreo/internal/occ/cmd/schema"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+// embeddedKinds returns the kinds built into occ as the control plane serves
+// them.
+func embeddedKinds(t *testing.T) []gen.KindSchema {
+	t.Helper()
+	set, err := occschema.Embedded()
+	require.NoError(t, err)
+	var kinds []gen.KindSchema
+	for gvk := range set {
+		kinds = append(kinds, gen.KindSchema{Kind: gvk.Kind, Group: gvk.Group, Version: gvk.Version})
+	}
+	return kinds
+}
+
+func newDoctor(mc *mocks.MockInterface) *Doctor {
+	d := New(mc)
+	d.clientVersion = "v1.2.0"
+	return d
+}
+
+func TestRun_Healthy(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetServerVersion(mock.Anything).Return(&gen.VersionResponse{Version: "v1.2.3"}, nil)
+	mc.EXPECT().ListKindSchemas(mock.Anything).Return(embeddedKinds(t), nil)
+	mc.EXPECT().GetDiagnostics(mock.Anything).Return(&gen.HealthReport{
+		Status: gen.HealthReportStatusOk,
+		Checks: map[string]gen.HealthCheckResult{
+			"kubernetes": {Status: gen.HealthCheckResultStatusOk},
+			"crds":       {Status: gen.HealthCheckResultStatusOk},
+		},
+	}, nil)
+
+	var out bytes.Buffer
+	require.NoError(t, newDoctor(mc).run(context.Background(), &out))
+	assert.Equal(t, `CHECK            STATUS   DETAILS
+Control plane    ok       reachable
+Version          ok       occ v1.2.0, control plane v1.2.3
+CRD versions     ok       `+fmt.Sprintf("%d kinds match", len(embeddedKinds(t)))+`
+Kubernetes API   ok       -
+CRDs             ok       -
+
+No problems found.
+`, out.String())
+}
+
+func TestRun_ProblemsInPriorityOrder(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetServerVersion(mock.Anything).Return(&gen.VersionResponse{Version: "v1.3.0"}, nil)
+	mc.EXPECT().ListKindSchemas(mock.Anything).Return(embeddedKinds(t), nil)
+	mc.EXPECT().GetDiagnostics(mock.Anything).Return(&gen.HealthReport{
+		Status: gen.HealthReportStatusDegraded,
+		Checks: map[string]gen.HealthCheckResult{
+			"kubernetes":          {Status: gen.HealthCheckResultStatusOk},
+			"gateways":            {Status: gen.HealthCheckResultStatusFailed, Message: ptr.To("1 of 1 gateways are not ready")},
+			"webhookCertificates": {Status: gen.HealthCheckResultStatusFailed, Message: ptr.To("no CA bundle")},
+			"newCheck":            {Status: gen.HealthCheckResultStatusFailed},
+		},
+	}, nil)
+
+	var out bytes.Buffer
+	err := newDoctor(mc).run(context.Background(), &out)
+	require.EqualError(t, err, "3 check(s) failed")
+
+	got := out.String()
+	assert.Contains(t, got, "4 problem(s) found, most urgent first:")
+	webhooks := strings.Index(got, "1. Webhook certificates (failed)")
+	gateways := strings.Index(got, "2. Gateway readiness (failed)")
+	unknown := strings.Index(got, "3. newCheck (failed)")
+	version := strings.Index(got, "4. Version (warning)")
+	assert.GreaterOrEqual(t, webhooks, 0, got)
+	assert.Less(t, webhooks, gateways)
+	assert.Less(t, gateways, unknown)
+	assert.Less(t, unknown, version)
+	assert.Contains(t, got, "   kubectl -n openchoreo-control-plane get certificate controller-manager-webhook-server-cert")
+}
+
+func TestRun_ControlPlaneUnreachable(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetServerVersion(mock.Anything).Return(nil, errors.New("connection refused"))
+
+	var out bytes.Buffer
+	err := newDoctor(mc).run(context.Background(), &out)
+	require.EqualError(t, err, "1 check(s) failed")
+	assert.Contains(t, out.String(), "Control plane   failed   connection refused\n")
+	assert.Contains(t, out.String(), "1. Control plane (failed)")
+}
+
+func TestRun_DiagnosticsUnavailable(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetServerVersion(mock.Anything).Return(&gen.VersionResponse{Version: "v1.2.0"}, nil)
+	mc.EXPECT().ListKindSchemas(mock.Anything).Return(embeddedKinds(t), nil)
+	mc.EXPECT().GetDiagnostics(mock.Anything).Return(nil, errors.New("not found"))
+
+	var out bytes.Buffer
+	require.NoError(t, newDoctor(mc).run(context.Background(), &out), "warnings do not fail the command")
+	assert.Contains(t, out.String(), "1. Diagnostics (warning)")
+}
+
+func TestVersionCheck(t *testing.T) {
+	tests := []struct {
+		name   string
+		client string
+		server string
+		want   status
+	}{
+		{name: "same minor", client: "v1.2.0", server: "v1.2.5", want: statusOK},
+		{name: "different minor", client: "v1.2.0", server: "v1.3.0", want: statusWarning},
+		{name: "different major", client: "v2.0.0", server: "v1.0.0", want: statusWarning},
+		{name: "development build", client: "not-set", server: "v1.3.0", want: statusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, versionCheck(tt.client, tt.server).status)
+		})
+	}
+}
+
+func TestCompareCRDs(t *testing.T) {
+	gvk := func(kind, version string) schema.GroupVersionKind {
+		return schema.GroupVersionKind{Group: "openchoreo.dev", Version: version, Kind: kind}
+	}
+	expected := occschema.Set{
+		gvk("Component", "v1alpha1"): nil,
+		gvk("Project", "v1alpha1"):   nil,
+		gvk("Trait", "v1alpha1"):     nil,
+	}
+
+	t.Run("matching kinds", func(t *testing.T) {
+		r := compareCRDs([]gen.KindSchema{
+			{Kind: "Component", Group: "openchoreo.dev", Version: "v1alpha1"},
+			{Kind: "Project", Group: "openchoreo.dev", Version: "v1alpha1"},
+			{Kind: "Trait", Group: "openchoreo.dev", Version: "v1alpha1"},
+			{Kind: "Gateway", Group: "gateway.networking.k8s.io", Version: "v1"},
+		}, expected)
+		assert.Equal(t, statusOK, r.status)
+		assert.Equal(t, "3 kinds match", r.message)
+	})
+
+	t.Run("skewed kinds", func(t *testing.T) {
+		r := compareCRDs([]gen.KindSchema{
+			{Kind: "Component", Group: "openchoreo.dev", Version: "v1beta1"},
+			{Kind: "Project", Group: "openchoreo.dev", Version: "v1alpha1"},
+			{Kind: "Sandbox", Group: "openchoreo.dev", Version: "v1alpha1"},
+		}, expected)
+		assert.Equal(t, statusWarning, r.status)
+		assert.Equal(t, "1 kind(s) known to occ are not served: Trait; "+
+			"1 kind(s) are served at versions unknown to occ: Component v1beta1; "+
+			"1 served kind(s) are unknown to occ: Sandbox", r.message)
+		assert.Equal(t, versionSkewRemediation, r.remediation)
+	})
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/openchoreo/openchoreo/internal/occ/auth"
 	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/completion"
 	"github.com/openchoreo/openchoreo/internal/occ/flags"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 )
@@ -23,6 +24,7 @@ func NewEnvironmentCmd(f client.NewClientFunc) *cobra.Command {
 		newListCmd(f),
 		newGetCmd(f),
 		newDeleteCmd(f),
+		newCompareCmd(f),
 	)
 	return cmd
 }
@@ -42,10 +44,12 @@ func newListCmd(f client.NewClientFunc) *cobra.Command {
 			}
 			return New(cl).List(ListParams{
 				Namespace: flags.GetNamespace(cmd),
+				Output:    flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
+	flags.AddListOutputFormat(cmd)
 	return cmd
 }
 
@@ -53,7 +57,7 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get [ENVIRONMENT_NAME]",
 		Short: "Get an environment",
-		Long:  `Get an environment and display its details in YAML format.`,
+		Long:  `Get an environment and display its details in YAML, or in the format set by --output.`,
 		Example: `  # Get an environment
   occ environment get dev --namespace acme-corp`,
 		Args:    cmdutil.ExactOneArgWithUsage(),
@@ -66,10 +70,12 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 			return New(cl).Get(GetParams{
 				Namespace:       flags.GetNamespace(cmd),
 				EnvironmentName: args[0],
+				Output:          flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
+	flags.AddGetOutputFormat(cmd)
 	return cmd
 }
 
@@ -96,3 +102,42 @@ func newDeleteCmd(f client.NewClientFunc) *cobra.Command {
 	flags.AddNamespace(cmd)
 	return cmd
 }
+
+func newCompareCmd(f client.NewClientFunc) *cobra.Command {
+	environments := completion.Names(f, "environment")
+	cmd := &cobra.Command{
+		Use:   "compare [BASE_ENVIRONMENT] [TARGET_ENVIRONMENT]",
+		Short: "Compare what is deployed to two environments",
+		Long: `Compare what is deployed to two environments of a project.
+
+For each component of the project, the image, configuration checksum and
+replicas (ready/desired) deployed to each environment are shown, followed by
+the ways the deployments differ. The configuration checksum covers the release
+and the environment-specific configuration of the component.`,
+		Example: `  # Compare the dev and prod environments of a project
+  occ environment compare dev prod --project online-store --namespace acme-corp`,
+		Args: cobra.ExactArgs(2),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+			if len(args) >= 2 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return environments(cmd, nil, toComplete)
+		},
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).Compare(CompareParams{
+				Namespace:         flags.GetNamespace(cmd),
+				Project:           flags.GetProject(cmd),
+				BaseEnvironment:   args[0],
+				TargetEnvironment: args[1],
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	flags.AddProject(cmd)
+	return cmd
+}
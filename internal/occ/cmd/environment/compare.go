@@ -0,0 +1,193 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package environment
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+// Kinds of drift between the deployments of a component in two environments.
+const (
+	driftImage    = "image"
+	driftConfig   = "config"
+	driftReplicas = "replicas"
+	driftMissing  = "missing"
+)
+
+// shortDigestLen is the number of hex digits shown of image digests and config checksums.
+const shortDigestLen = 12
+
+// Compare prints, for every component of a project, what is deployed to two
+// environments and how the deployments differ.
+func (e *Environment) Compare(params CompareParams) error {
+	return e.compare(os.Stdout, params)
+}
+
+func (e *Environment) compare(out io.Writer, params CompareParams) error {
+	if err := cmdutil.RequireFields("compare", "environment", map[string]string{
+		"namespace": params.Namespace,
+		"project":   params.Project,
+	}); err != nil {
+		return err
+	}
+	if params.BaseEnvironment == params.TargetEnvironment {
+		return fmt.Errorf("cannot compare environment %q with itself", params.BaseEnvironment)
+	}
+
+	health, err := e.client.GetProjectComponentHealth(context.Background(), params.Namespace, params.Project)
+	if err != nil {
+		return err
+	}
+	base := slices.Index(health.Environments, params.BaseEnvironment)
+	target := slices.Index(health.Environments, params.TargetEnvironment)
+	for _, env := range []struct {
+		name  string
+		index int
+	}{{params.BaseEnvironment, base}, {params.TargetEnvironment, target}} {
+		if env.index < 0 {
+			return fmt.Errorf("environment %q is not an environment of project %q (environments: %s)",
+				env.name, params.Project, strings.Join(health.Environments, ", "))
+		}
+	}
+
+	return printComparison(out, params.BaseEnvironment, params.TargetEnvironment, health.Components, base, target)
+}
+
+func printComparison(out io.Writer, baseEnv, targetEnv string, components []gen.ComponentHealth, base, target int) error {
+	if len(components) == 0 {
+		fmt.Fprintln(out, "No components found")
+		return nil
+	}
+
+	baseCol, targetCol := strings.ToUpper(baseEnv), strings.ToUpper(targetEnv)
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintf(w, "COMPONENT\t%[1]s IMAGE\t%[2]s IMAGE\t%[1]s CONFIG\t%[2]s CONFIG\t%[1]s REPLICAS\t%[2]s REPLICAS\tDRIFT\n",
+		baseCol, targetCol)
+
+	counts := map[string]int{}
+	drifted := 0
+	for _, comp := range components {
+		b, t := comp.Environments[base], comp.Environments[target]
+		kinds := drift(b, t)
+		for _, kind := range kinds {
+			counts[kind]++
+		}
+		summary := "-"
+		if len(kinds) > 0 {
+			drifted++
+			summary = strings.Join(kinds, ", ")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			comp.ComponentName,
+			shortImage(b), shortImage(t),
+			shortChecksum(b), shortChecksum(t),
+			replicas(b), replicas(t),
+			summary)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(out)
+	if drifted == 0 {
+		fmt.Fprintf(out, "No drift: all %d components match between %s and %s\n", len(components), baseEnv, targetEnv)
+		return nil
+	}
+	details := make([]string, 0, len(counts))
+	for _, kind := range []string{driftImage, driftConfig, driftReplicas, driftMissing} {
+		if counts[kind] > 0 {
+			details = append(details, fmt.Sprintf("%s: %d", kind, counts[kind]))
+		}
+	}
+	fmt.Fprintf(out, "Drift: %d of %d components differ between %s and %s (%s)\n",
+		drifted, len(components), baseEnv, targetEnv, strings.Join(details, ", "))
+	return nil
+}
+
+// drift returns how the deployments of a component in two environments differ.
+// A component deployed to only one of them is missing from the other. Details
+// that either environment does not report, such as those of environments the
+// caller may not view, are not compared.
+func drift(base, target gen.ComponentEnvironmentHealth) []string {
+	if base.Health == gen.ComponentEnvironmentHealthHealthUnknown || target.Health == gen.ComponentEnvironmentHealthHealthUnknown {
+		return nil
+	}
+	if deployed(base) != deployed(target) {
+		return []string{driftMissing}
+	}
+	if !deployed(base) {
+		return nil
+	}
+
+	var kinds []string
+	if differs(base.Image, target.Image) {
+		kinds = append(kinds, driftImage)
+	}
+	if differs(base.ConfigChecksum, target.ConfigChecksum) {
+		kinds = append(kinds, driftConfig)
+	}
+	if base.Replicas != nil && target.Replicas != nil && base.Replicas.Desired != target.Replicas.Desired {
+		kinds = append(kinds, driftReplicas)
+	}
+	return kinds
+}
+
+func deployed(eh gen.ComponentEnvironmentHealth) bool {
+	return eh.Health != gen.ComponentEnvironmentHealthHealthNotDeployed && eh.Health != gen.ComponentEnvironmentHealthHealthSuspended
+}
+
+func differs(a, b *string) bool {
+	return a != nil && b != nil && *a != "" && *b != "" && *a != *b
+}
+
+// shortImage returns the image without its registry and with a shortened
+// digest, e.g. orders@sha256:1a2b3c4d5e6f or orders:v1.
+func shortImage(eh gen.ComponentEnvironmentHealth) string {
+	if !deployed(eh) || eh.Image == nil || *eh.Image == "" {
+		return "-"
+	}
+	image := *eh.Image
+	name, digest, hasDigest := strings.Cut(image, "@")
+	name = name[strings.LastIndex(name, "/")+1:]
+	if !hasDigest {
+		return name
+	}
+	algorithm, hex, _ := strings.Cut(digest, ":")
+	return name + "@" + algorithm + ":" + truncate(hex)
+}
+
+func shortChecksum(eh gen.ComponentEnvironmentHealth) string {
+	if !deployed(eh) || eh.ConfigChecksum == nil || *eh.ConfigChecksum == "" {
+		return "-"
+	}
+	_, sum, found := strings.Cut(*eh.ConfigChecksum, ":")
+	if !found {
+		sum = *eh.ConfigChecksum
+	}
+	return truncate(sum)
+}
+
+// replicas returns the ready and desired replica counts, e.g. 2/3.
+func replicas(eh gen.ComponentEnvironmentHealth) string {
+	if !deployed(eh) || eh.Replicas == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%d/%d", eh.Replicas.Ready, eh.Replicas.Desired)
+}
+
+func truncate(s string) string {
+	if len(s) > shortDigestLen {
+		return s[:shortDigestLen]
+	}
+	return s
+}
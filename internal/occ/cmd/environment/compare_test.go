@@ -0,0 +1,116 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package environment
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+func deployedHealth(env, image, checksum string, ready, desired int32) gen.ComponentEnvironmentHealth {
+	return gen.ComponentEnvironmentHealth{
+		Environment:    env,
+		Health:         gen.ComponentEnvironmentHealthHealthHealthy,
+		Image:          ptr.To(image),
+		ConfigChecksum: ptr.To(checksum),
+		Replicas:       &gen.ReplicaStatus{Ready: ready, Desired: desired},
+	}
+}
+
+func TestCompare(t *testing.T) {
+	const digest = "registry.example.com/shop/orders@sha256:1a2b3c4d5e6f7a8b9c0d"
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetProjectComponentHealth(mock.Anything, "acme", "shop").Return(&gen.ProjectComponentHealth{
+		ProjectName:  "shop",
+		Environments: []string{"dev", "staging", "prod"},
+		Components: []gen.ComponentHealth{
+			{ComponentName: "cart", Environments: []gen.ComponentEnvironmentHealth{
+				deployedHealth("dev", "registry.example.com/cart:v2", "sha256:aaaaaaaaaaaaaaaa", 1, 1),
+				{Environment: "staging", Health: gen.ComponentEnvironmentHealthHealthNotDeployed},
+				deployedHealth("prod", "registry.example.com/cart:v1", "sha256:bbbbbbbbbbbbbbbb", 2, 3),
+			}},
+			{ComponentName: "orders", Environments: []gen.ComponentEnvironmentHealth{
+				deployedHealth("dev", digest, "sha256:cccccccccccccccc", 2, 2),
+				{Environment: "staging", Health: gen.ComponentEnvironmentHealthHealthNotDeployed},
+				deployedHealth("prod", digest, "sha256:cccccccccccccccc", 2, 2),
+			}},
+			{ComponentName: "search", Environments: []gen.ComponentEnvironmentHealth{
+				deployedHealth("dev", "search:v1", "sha256:dddd", 1, 1),
+				{Environment: "staging", Health: gen.ComponentEnvironmentHealthHealthNotDeployed},
+				{Environment: "prod", Health: gen.ComponentEnvironmentHealthHealthNotDeployed},
+			}},
+		},
+	}, nil)
+
+	var out bytes.Buffer
+	err := New(mc).compare(&out, CompareParams{Namespace: "acme", Project: "shop", BaseEnvironment: "dev", TargetEnvironment: "prod"})
+	require.NoError(t, err)
+
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	require.Len(t, lines, 6)
+	assert.Equal(t, []string{"COMPONENT", "DEV", "IMAGE", "PROD", "IMAGE", "DEV", "CONFIG", "PROD", "CONFIG",
+		"DEV", "REPLICAS", "PROD", "REPLICAS", "DRIFT"}, fields(lines[0]))
+	assert.Equal(t, []string{"cart", "cart:v2", "cart:v1", "aaaaaaaaaaaa", "bbbbbbbbbbbb", "1/1", "2/3",
+		"image,", "config,", "replicas"}, fields(lines[1]))
+	assert.Equal(t, []string{"orders", "orders@sha256:1a2b3c4d5e6f", "orders@sha256:1a2b3c4d5e6f",
+		"cccccccccccc", "cccccccccccc", "2/2", "2/2", "-"}, fields(lines[2]))
+	assert.Equal(t, []string{"search", "search:v1", "-", "dddd", "-", "1/1", "-", "missing"}, fields(lines[3]))
+	assert.Equal(t, "Drift: 2 of 3 components differ between dev and prod (image: 1, config: 1, replicas: 1, missing: 1)",
+		string(lines[5]))
+}
+
+func TestCompare_NoDrift(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetProjectComponentHealth(mock.Anything, "acme", "shop").Return(&gen.ProjectComponentHealth{
+		Environments: []string{"dev", "prod"},
+		Components: []gen.ComponentHealth{
+			{ComponentName: "cart", Environments: []gen.ComponentEnvironmentHealth{
+				deployedHealth("dev", "cart:v1", "sha256:aaaa", 1, 1),
+				{Environment: "prod", Health: gen.ComponentEnvironmentHealthHealthUnknown},
+			}},
+		},
+	}, nil)
+
+	var out bytes.Buffer
+	require.NoError(t, New(mc).compare(&out, CompareParams{Namespace: "acme", Project: "shop", BaseEnvironment: "dev", TargetEnvironment: "prod"}))
+	assert.Contains(t, out.String(), "No drift: all 1 components match between dev and prod")
+}
+
+func TestCompare_Errors(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	e := New(mc)
+
+	err := e.compare(&bytes.Buffer{}, CompareParams{Namespace: "acme", BaseEnvironment: "dev", TargetEnvironment: "prod"})
+	assert.ErrorContains(t, err, "Missing required parameter: --project")
+
+	err = e.compare(&bytes.Buffer{}, CompareParams{Namespace: "acme", Project: "shop", BaseEnvironment: "dev", TargetEnvironment: "dev"})
+	assert.EqualError(t, err, `cannot compare environment "dev" with itself`)
+
+	mc.EXPECT().GetProjectComponentHealth(mock.Anything, "acme", "shop").
+		Return(&gen.ProjectComponentHealth{Environments: []string{"dev", "prod"}}, nil).Once()
+	err = e.compare(&bytes.Buffer{}, CompareParams{Namespace: "acme", Project: "shop", BaseEnvironment: "dev", TargetEnvironment: "qa"})
+	assert.EqualError(t, err, `environment "qa" is not an environment of project "shop" (environments: dev, prod)`)
+
+	mc.EXPECT().GetProjectComponentHealth(mock.Anything, "acme", "shop").Return(nil, errors.New("project not found")).Once()
+	err = e.compare(&bytes.Buffer{}, CompareParams{Namespace: "acme", Project: "shop", BaseEnvironment: "dev", TargetEnvironment: "prod"})
+	assert.EqualError(t, err, "project not found")
+}
+
+func fields(line []byte) []string {
+	parts := bytes.Fields(line)
+	out := make([]string, len(parts))
+	for i, p := range parts {
+		out[i] = string(p)
+	}
+	return out
+}
@@ -6,6 +6,7 @@ package environment
 // ListParams defines parameters for listing environments
 type ListParams struct {
 	Namespace string
+	Output    string // --output format
 }
 
 func (p ListParams) GetNamespace() string { return p.Namespace }
@@ -14,6 +15,7 @@ func (p ListParams) GetNamespace() string { return p.Namespace }
 type GetParams struct {
 	Namespace       string
 	EnvironmentName string
+	Output          string // --output format
 }
 
 func (p GetParams) GetNamespace() string { return p.Namespace }
@@ -26,3 +28,13 @@ type DeleteParams struct {
 
 func (p DeleteParams) GetNamespace() string       { return p.Namespace }
 func (p DeleteParams) GetEnvironmentName() string { return p.EnvironmentName }
+
+// CompareParams defines parameters for comparing two environments of a project
+type CompareParams struct {
+	Namespace         string
+	Project           string
+	BaseEnvironment   string
+	TargetEnvironment string
+}
+
+func (p CompareParams) GetNamespace() string { return p.Namespace }
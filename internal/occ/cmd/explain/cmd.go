@@ -0,0 +1,47 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package explain
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/internal/occ/auth"
+	"github.com/openchoreo/openchoreo/internal/occ/completion"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func NewExplainCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "explain KIND[.FIELD...]",
+		Short: "Show the documentation of a kind and its fields",
+		Long: `Show the documentation of an OpenChoreo kind, or of one of its fields, and
+list the fields below it.
+
+The documentation comes from the CRD schemas served by the API, so it always
+matches the server. KIND is the kind, its plural or singular name, or one of
+its short names. Fields of array items and map values are addressed through
+the name of the array or map.`,
+		Example: `  # Describe the fields of a component
+  occ explain component
+
+  # Describe the workflow of a component and the fields below it
+  occ explain component.spec.workflow
+
+  # List every field of a component spec
+  occ explain component.spec --recursive`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.Kinds(f),
+		PreRunE:           auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			recursive, _ := cmd.Flags().GetBool("recursive")
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).Explain(Params{Path: args[0], Recursive: recursive})
+		},
+	}
+	cmd.Flags().Bool("recursive", false, "List the fields below the field at every depth, without their descriptions")
+	return cmd
+}
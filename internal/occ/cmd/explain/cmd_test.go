@@ -0,0 +1,23 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package explain
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func TestNewExplainCmd(t *testing.T) {
+	cmd := NewExplainCmd(func() (client.Interface, error) { return nil, fmt.Errorf("unused") })
+
+	assert.Equal(t, "explain", cmd.Name())
+	assert.NotNil(t, cmd.Flags().Lookup("recursive"))
+	assert.Error(t, cmd.Args(cmd, nil))
+	assert.NoError(t, cmd.Args(cmd, []string{"component.spec"}))
+	assert.Error(t, cmd.Args(cmd, []string{"component", "spec"}))
+}
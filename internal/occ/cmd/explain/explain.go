@@ -0,0 +1,159 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package explain
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+// indent is the indentation of descriptions and nested fields.
+const indent = "    "
+
+type Explainer struct {
+	client client.Interface
+}
+
+func New(c client.Interface) *Explainer {
+	return &Explainer{client: c}
+}
+
+// Explain prints the documentation of a kind or one of its fields, followed by
+// the fields below it
+func (e *Explainer) Explain(params Params) error {
+	return e.explain(os.Stdout, params)
+}
+
+func (e *Explainer) explain(out io.Writer, params Params) error {
+	kindName, fieldPath, _ := strings.Cut(params.Path, ".")
+	if kindName == "" {
+		return fmt.Errorf("a kind is required, such as component or component.spec")
+	}
+
+	kind, err := e.client.GetKindFields(context.Background(), kindName)
+	if err != nil {
+		return err
+	}
+
+	var field *gen.KindField
+	if fieldPath != "" {
+		for i := range kind.Fields {
+			if kind.Fields[i].Path == fieldPath {
+				field = &kind.Fields[i]
+				break
+			}
+		}
+		if field == nil {
+			return fmt.Errorf("field %q does not exist in %s", fieldPath, kind.Kind)
+		}
+	}
+
+	fmt.Fprintf(out, "GROUP:      %s\n", kind.Group)
+	fmt.Fprintf(out, "KIND:       %s\n", kind.Kind)
+	fmt.Fprintf(out, "VERSION:    %s\n\n", kind.Version)
+
+	description := deref(kind.Description)
+	if field != nil {
+		name := fieldPath[strings.LastIndex(fieldPath, ".")+1:]
+		fmt.Fprintf(out, "FIELD: %s <%s>%s\n\n", name, field.Type, requiredMarker(field))
+		description = deref(field.Description)
+	}
+	fmt.Fprintln(out, "DESCRIPTION:")
+	if description == "" {
+		description = "<empty>"
+	}
+	writeIndented(out, indent, description)
+
+	if field != nil {
+		if field.Enum != nil {
+			fmt.Fprintf(out, "\nENUM:\n")
+			for _, v := range *field.Enum {
+				fmt.Fprintf(out, "%s%s\n", indent, v)
+			}
+		}
+		if field.Default != nil {
+			fmt.Fprintf(out, "\nDEFAULT: %s\n", *field.Default)
+		}
+	}
+
+	children := childFields(kind.Fields, fieldPath, params.Recursive)
+	if len(children) == 0 {
+		return nil
+	}
+	fmt.Fprintln(out, "\nFIELDS:")
+	for _, child := range children {
+		rest := strings.TrimPrefix(child.Path, fieldPath)
+		rest = strings.TrimPrefix(rest, ".")
+		name := rest[strings.LastIndex(rest, ".")+1:]
+		if params.Recursive {
+			depth := strings.Count(rest, ".")
+			fmt.Fprintf(out, "  %s%s\t<%s>%s\n", strings.Repeat(indent, depth), name, child.Type, requiredMarker(&child))
+			continue
+		}
+		fmt.Fprintf(out, "  %s\t<%s>%s\n", name, child.Type, requiredMarker(&child))
+		if child.Enum != nil {
+			fmt.Fprintf(out, "%senum: %s\n", indent, strings.Join(*child.Enum, ", "))
+		}
+		if child.Default != nil {
+			fmt.Fprintf(out, "%sdefault: %s\n", indent, *child.Default)
+		}
+		if d := deref(child.Description); d != "" {
+			writeIndented(out, indent, d)
+		}
+		fmt.Fprintln(out)
+	}
+	return nil
+}
+
+// childFields returns the fields directly below parent, or every field below
+// it when recursive is set. An empty parent is the root of the kind.
+func childFields(fields []gen.KindField, parent string, recursive bool) []gen.KindField {
+	prefix := ""
+	if parent != "" {
+		prefix = parent + "."
+	}
+	var children []gen.KindField
+	for _, f := range fields {
+		rest, ok := strings.CutPrefix(f.Path, prefix)
+		if !ok || rest == "" {
+			continue
+		}
+		if !recursive && strings.Contains(rest, ".") {
+			continue
+		}
+		children = append(children, f)
+	}
+	return children
+}
+
+func requiredMarker(f *gen.KindField) string {
+	if f.Required {
+		return " -required-"
+	}
+	return ""
+}
+
+// writeIndented writes text with every line prefixed by prefix.
+func writeIndented(out io.Writer, prefix, text string) {
+	for _, line := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+		if line == "" {
+			fmt.Fprintln(out)
+			continue
+		}
+		fmt.Fprintf(out, "%s%s\n", prefix, line)
+	}
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
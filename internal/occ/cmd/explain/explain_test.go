@@ -0,0 +1,107 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package explain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+func widgetFields() *gen.KindFields {
+	return &gen.KindFields{
+		Kind: "Widget", Group: "openchoreo.dev", Version: "v1alpha1",
+		Description: ptr.To("Widget is a test kind."),
+		Fields: []gen.KindField{
+			{Path: "spec", Type: "Object", Description: ptr.To("Desired state of the widget.")},
+			{Path: "spec.mode", Type: "string", Enum: &[]string{"fast", "slow"}, Default: ptr.To(`"fast"`)},
+			{Path: "spec.parts", Type: "[]Object"},
+			{Path: "spec.parts.name", Type: "string", Required: true, Description: ptr.To("Name of the part.")},
+			{Path: "spec.size", Type: "integer", Required: true, Description: ptr.To("Size of the widget.")},
+			{Path: "status", Type: "Object"},
+		},
+	}
+}
+
+func TestExplain_Kind(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetKindFields(mock.Anything, "widget").Return(widgetFields(), nil)
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).Explain(Params{Path: "widget"}))
+	})
+
+	assert.Equal(t, `GROUP:      openchoreo.dev
+KIND:       Widget
+VERSION:    v1alpha1
+
+DESCRIPTION:
+    Widget is a test kind.
+
+FIELDS:
+  spec	<Object>
+    Desired state of the widget.
+
+  status	<Object>
+
+`, out)
+}
+
+func TestExplain_Field(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetKindFields(mock.Anything, "widget").Return(widgetFields(), nil)
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).Explain(Params{Path: "widget.spec"}))
+	})
+
+	assert.Contains(t, out, "FIELD: spec <Object>\n\nDESCRIPTION:\n    Desired state of the widget.\n")
+	assert.Contains(t, out, "  mode\t<string>\n    enum: fast, slow\n    default: \"fast\"\n")
+	assert.Contains(t, out, "  size\t<integer> -required-\n    Size of the widget.\n")
+	assert.NotContains(t, out, "name")
+}
+
+func TestExplain_LeafField(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetKindFields(mock.Anything, "widget").Return(widgetFields(), nil)
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).Explain(Params{Path: "widget.spec.mode"}))
+	})
+
+	assert.Contains(t, out, "FIELD: mode <string>\n\nDESCRIPTION:\n    <empty>\n\nENUM:\n    fast\n    slow\n\nDEFAULT: \"fast\"\n")
+	assert.NotContains(t, out, "FIELDS:")
+}
+
+func TestExplain_Recursive(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetKindFields(mock.Anything, "widget").Return(widgetFields(), nil)
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).Explain(Params{Path: "widget.spec", Recursive: true}))
+	})
+
+	assert.Contains(t, out, `FIELDS:
+  mode	<string>
+  parts	<[]Object>
+      name	<string> -required-
+  size	<integer> -required-
+`)
+}
+
+func TestExplain_UnknownField(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetKindFields(mock.Anything, "widget").Return(widgetFields(), nil)
+
+	err := New(mc).Explain(Params{Path: "widget.spec.color"})
+	require.Error(t, err)
+	assert.Equal(t, `field "spec.color" does not exist in Widget`, err.Error())
+}
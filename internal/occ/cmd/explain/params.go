@@ -0,0 +1,12 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package explain
+
+// Params defines parameters for explaining the fields of a kind
+type Params struct {
+	// Path is the kind followed by an optional dot-separated field path,
+	// such as component.spec.workflow.
+	Path      string
+	Recursive bool
+}
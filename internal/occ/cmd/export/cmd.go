@@ -0,0 +1,46 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package export
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/internal/occ/auth"
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func NewExportCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export a project as YAML for a GitOps repository",
+		Long: `Export the resources of a project as cleaned, deterministic YAML with
+kustomization files, ready to be committed to Git as a backup or adopted by
+Argo CD or Flux.
+
+Server-managed fields such as status, uid and resourceVersion are removed.
+Existing files in the output directory are overwritten, other files are left
+in place.`,
+		Example: `  # Export a project into ./gitops
+  occ export --namespace acme-corp --project online-store --output ./gitops`,
+		Args:    cobra.NoArgs,
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			output, _ := cmd.Flags().GetString("output")
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).Export(Params{
+				Namespace: flags.GetNamespace(cmd),
+				Project:   flags.GetProject(cmd),
+				Output:    output,
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	flags.AddProject(cmd)
+	cmd.Flags().StringP("output", "o", ".", "Directory to write the exported files to")
+	return cmd
+}
@@ -0,0 +1,23 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package export
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func TestNewExportCmd(t *testing.T) {
+	cmd := NewExportCmd(func() (client.Interface, error) { return nil, fmt.Errorf("unused") })
+
+	assert.Equal(t, "export", cmd.Name())
+	assert.NotNil(t, cmd.Flags().Lookup("namespace"))
+	assert.NotNil(t, cmd.Flags().Lookup("project"))
+	assert.Equal(t, ".", cmd.Flags().Lookup("output").DefValue)
+	assert.Error(t, cmd.Args(cmd, []string{"extra"}))
+}
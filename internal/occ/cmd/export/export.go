@@ -0,0 +1,72 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+type Exporter struct {
+	client client.Interface
+}
+
+func New(c client.Interface) *Exporter {
+	return &Exporter{client: c}
+}
+
+// Export writes the GitOps export of a project to the output directory
+func (e *Exporter) Export(params Params) error {
+	return e.export(os.Stdout, params)
+}
+
+func (e *Exporter) export(out io.Writer, params Params) error {
+	if params.Namespace == "" {
+		return fmt.Errorf("namespace is required")
+	}
+	if params.Project == "" {
+		return fmt.Errorf("project is required")
+	}
+	if params.Output == "" {
+		params.Output = "."
+	}
+
+	result, err := e.client.ExportProjectGitOps(context.Background(), params.Namespace, params.Project)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range result.Files {
+		target, err := targetPath(params.Output, file.Path)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return fmt.Errorf("failed to create directory for %s: %w", file.Path, err)
+		}
+		if err := os.WriteFile(target, []byte(file.Content), 0o644); err != nil { //nolint:gosec // exported manifests are meant to be committed and shared
+			return fmt.Errorf("failed to write %s: %w", file.Path, err)
+		}
+	}
+
+	fmt.Fprintf(out, "Exported %d files of project %s to %s\n", len(result.Files), params.Project, params.Output)
+	return nil
+}
+
+// targetPath resolves the slash-separated path of an exported file below dir,
+// rejecting paths that would escape it.
+func targetPath(dir, p string) (string, error) {
+	clean := path.Clean(p)
+	if p == "" || path.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, "../") {
+		return "", fmt.Errorf("export contains an invalid file path %q", p)
+	}
+	return filepath.Join(dir, filepath.FromSlash(clean)), nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+func TestExport_WritesFiles(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("keep"), 0o600))
+
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().ExportProjectGitOps(mock.Anything, "acme", "store").Return(&gen.GitOpsExport{
+		Files: []gen.GitOpsExportFile{
+			{Path: "kustomization.yaml", Content: "resources:\n- namespaces/acme\n"},
+			{Path: "namespaces/acme/projects/store/project.yaml", Content: "kind: Project\n"},
+		},
+	}, nil)
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).Export(Params{Namespace: "acme", Project: "store", Output: dir}))
+	})
+
+	assert.Equal(t, "Exported 2 files of project store to "+dir+"\n", out)
+	content, err := os.ReadFile(filepath.Join(dir, "namespaces", "acme", "projects", "store", "project.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "kind: Project\n", string(content))
+	content, err = os.ReadFile(filepath.Join(dir, "README.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "keep", string(content))
+}
+
+func TestExport_RejectsEscapingPath(t *testing.T) {
+	dir := t.TempDir()
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().ExportProjectGitOps(mock.Anything, "acme", "store").Return(&gen.GitOpsExport{
+		Files: []gen.GitOpsExportFile{{Path: "../evil.yaml", Content: "x"}},
+	}, nil)
+
+	err := New(mc).Export(Params{Namespace: "acme", Project: "store", Output: dir})
+	assert.ErrorContains(t, err, "invalid file path")
+	_, statErr := os.Stat(filepath.Join(filepath.Dir(dir), "evil.yaml"))
+	assert.True(t, os.IsNotExist(statErr))
+}
+
+func TestExport_RequiresProject(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	err := New(mc).Export(Params{Namespace: "acme"})
+	assert.EqualError(t, err, "project is required")
+}
@@ -0,0 +1,12 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package export
+
+// Params defines parameters for exporting a project as a GitOps tree
+type Params struct {
+	Namespace string
+	Project   string
+	// Output is the directory the files are written to.
+	Output string
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package get
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/internal/occ/auth"
+	"github.com/openchoreo/openchoreo/internal/occ/completion"
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func NewGetCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get KIND [NAME]",
+		Short: "Display resources of any kind",
+		Long: `Display one or many resources of any OpenChoreo kind as a table.
+
+The columns are the printer columns declared by the CRD of the kind, so new
+kinds are supported without a CLI update. KIND is the kind, its plural or
+singular name, or one of its short names. Namespaced kinds are read from
+--namespace, or the namespace of the current context.
+
+With -o jsonpath=TEMPLATE or -o go-template=TEMPLATE, the template is applied
+to the resource, or to a list whose items field holds the resources, so that
+scripts can extract fields without other tools.`,
+		Example: `  # List components in the namespace of the current context
+  occ get components
+
+  # List components of a project with the wide columns
+  occ get comp --namespace acme-corp --project online-store -o wide
+
+  # Show a cluster data plane as YAML
+  occ get clusterdataplane default -o yaml
+
+  # Print the name of every component, one per line
+  occ get components -o jsonpath='{range .items[*]}{.metadata.name}{"\n"}{end}'
+
+  # Print the project of a component
+  occ get component orders -o go-template='{{.spec.owner.projectName}}'`,
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: completion.KindAndName(f),
+		PreRunE:           auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			params := Params{
+				Kind:      args[0],
+				Namespace: flags.GetNamespace(cmd),
+				Project:   flags.GetProject(cmd),
+				Output:    flags.GetOutputFormat(cmd),
+			}
+			if len(args) == 2 {
+				params.Name = args[1]
+			}
+			return New(cl).Get(params)
+		},
+	}
+	flags.AddNamespace(cmd)
+	flags.AddProject(cmd)
+	flags.AddOutputFormat(cmd)
+	return cmd
+}
@@ -0,0 +1,26 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package get
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func TestNewGetCmd(t *testing.T) {
+	cmd := NewGetCmd(func() (client.Interface, error) { return nil, fmt.Errorf("unused") })
+
+	assert.Equal(t, "get", cmd.Name())
+	for _, name := range []string{"namespace", "project", "output"} {
+		assert.NotNil(t, cmd.Flags().Lookup(name), "missing flag %s", name)
+	}
+	assert.Equal(t, "o", cmd.Flags().Lookup("output").Shorthand)
+	assert.Error(t, cmd.Args(cmd, nil))
+	assert.NoError(t, cmd.Args(cmd, []string{"components", "orders"}))
+	assert.Error(t, cmd.Args(cmd, []string{"components", "orders", "extra"}))
+}
@@ -0,0 +1,201 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package get
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
+	"github.com/openchoreo/openchoreo/internal/occ/printer"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+const (
+	// projectLabel is the label carrying the project of resources without an
+	// owner reference in their spec.
+	projectLabel = "openchoreo.dev/project"
+
+	// noneValue is shown for columns whose JSONPath selects nothing.
+	noneValue = "<none>"
+)
+
+// defaultColumns are shown for kinds whose CRD declares no printer columns.
+var defaultColumns = []gen.PrinterColumn{
+	{Name: "Age", Type: "date", JsonPath: ".metadata.creationTimestamp"},
+}
+
+type Getter struct {
+	client client.Interface
+}
+
+func New(c client.Interface) *Getter {
+	return &Getter{client: c}
+}
+
+// Get prints the resources of a kind, or the named resource, in the
+// requested output format
+func (g *Getter) Get(params Params) error {
+	if params.Output != OutputWide {
+		if err := printer.Validate(params.Output); err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+
+	kind, err := g.client.GetPrinterColumns(ctx, params.Kind)
+	if err != nil {
+		return err
+	}
+
+	namespace := params.Namespace
+	if kind.Namespaced && namespace == "" {
+		namespace = config.DefaultNamespace()
+		if namespace == "" {
+			return fmt.Errorf("%s are namespaced: set --namespace or a namespace in the current context", kind.Plural)
+		}
+	}
+	if !kind.Namespaced && params.Project != "" {
+		return fmt.Errorf("%s are cluster-scoped and cannot be filtered by project", kind.Plural)
+	}
+
+	path := client.CollectionPath(kind, namespace)
+	var items []map[string]any
+	if params.Name != "" {
+		obj, err := g.client.GetObject(ctx, path+"/"+url.PathEscape(params.Name))
+		if err != nil {
+			return err
+		}
+		items = []map[string]any{obj}
+	} else {
+		items, err = pagination.FetchAll(func(limit int, cursor string) ([]map[string]any, string, error) {
+			return g.client.ListObjects(ctx, path, limit, cursor)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if params.Project != "" {
+		items = filterByProject(items, params.Project)
+	}
+
+	switch params.Output {
+	case "", OutputWide:
+	default:
+		if params.Name != "" {
+			return printer.Print(os.Stdout, items[0], params.Output)
+		}
+		return printer.PrintList(os.Stdout, items, params.Output)
+	}
+	if len(items) == 0 {
+		fmt.Printf("No %s found\n", kind.Plural)
+		return nil
+	}
+	return printTable(kind, items, params.Output == OutputWide)
+}
+
+// filterByProject keeps the resources owned by project, either through
+// spec.owner.projectName or the project label.
+func filterByProject(items []map[string]any, project string) []map[string]any {
+	var filtered []map[string]any
+	for _, item := range items {
+		spec, _ := item["spec"].(map[string]any)
+		owner, _ := spec["owner"].(map[string]any)
+		metadata, _ := item["metadata"].(map[string]any)
+		labels, _ := metadata["labels"].(map[string]any)
+		if owner["projectName"] == project || labels[projectLabel] == project {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+// printTable prints the resources with the printer columns of their kind.
+// Columns with a priority above 0 are only printed in wide output.
+func printTable(kind *gen.PrinterColumns, items []map[string]any, wide bool) error {
+	columns := kind.Columns
+	if len(columns) == 0 {
+		columns = defaultColumns
+	}
+	var shown []gen.PrinterColumn
+	for _, col := range columns {
+		if wide || col.Priority == nil || *col.Priority == 0 {
+			shown = append(shown, col)
+		}
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	header := []string{"NAME"}
+	for _, col := range shown {
+		header = append(header, strings.ToUpper(col.Name))
+	}
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+
+	for _, item := range items {
+		metadata, _ := item["metadata"].(map[string]any)
+		name, _ := metadata["name"].(string)
+		row := []string{name}
+		for _, col := range shown {
+			row = append(row, columnValue(item, col))
+		}
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return w.Flush()
+}
+
+// columnValue evaluates the JSONPath of a column against a resource.
+func columnValue(item map[string]any, col gen.PrinterColumn) string {
+	jp := jsonpath.New(col.Name).AllowMissingKeys(true)
+	if err := jp.Parse("{" + col.JsonPath + "}"); err != nil {
+		return noneValue
+	}
+	results, err := jp.FindResults(item)
+	if err != nil || len(results) == 0 || len(results[0]) == 0 {
+		return noneValue
+	}
+
+	values := make([]string, 0, len(results[0]))
+	for _, result := range results[0] {
+		values = append(values, formatValue(result.Interface(), col.Type))
+	}
+	return strings.Join(values, ",")
+}
+
+// formatValue renders a column value; dates are shown as their age.
+func formatValue(value any, columnType string) string {
+	switch v := value.(type) {
+	case nil:
+		return noneValue
+	case string:
+		if columnType == "date" {
+			if t, err := time.Parse(time.RFC3339, v); err == nil {
+				return utils.FormatAge(t)
+			}
+		}
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package get
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
+	"github.com/openchoreo/openchoreo/internal/occ/printer"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+func componentColumns() *gen.PrinterColumns {
+	return &gen.PrinterColumns{
+		Kind: "Component", Plural: "components", Namespaced: true,
+		Columns: []gen.PrinterColumn{
+			{Name: "Project", Type: "string", JsonPath: ".spec.owner.projectName"},
+			{Name: "Replicas", Type: "integer", JsonPath: ".spec.replicas"},
+			{Name: "Reason", Type: "string", JsonPath: ".status.reason", Priority: ptr.To(int32(1))},
+			{Name: "Age", Type: "date", JsonPath: ".metadata.creationTimestamp"},
+		},
+	}
+}
+
+func component(name, project string) map[string]any {
+	var obj map[string]any
+	data := `{"metadata":{"name":"` + name + `","creationTimestamp":"` +
+		time.Now().Add(-2*time.Hour).UTC().Format(time.RFC3339) + `"},` +
+		`"spec":{"owner":{"projectName":"` + project + `"},"replicas":2},"status":{"reason":"Ready"}}`
+	if err := json.Unmarshal([]byte(data), &obj); err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+func TestGet_Table(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetPrinterColumns(mock.Anything, "comp").Return(componentColumns(), nil)
+	mc.EXPECT().ListObjects(mock.Anything, "/api/v1/namespaces/acme/components", mock.Anything, "").
+		Return([]map[string]any{component("orders", "shop"), component("billing", "finance")}, "", nil)
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).Get(Params{Kind: "comp", Namespace: "acme"}))
+	})
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, []string{"NAME", "PROJECT", "REPLICAS", "AGE"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"orders", "shop", "2", "2h"}, strings.Fields(lines[1]))
+	assert.NotContains(t, out, "Ready")
+}
+
+func TestGet_WideAndProjectFilter(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetPrinterColumns(mock.Anything, "components").Return(componentColumns(), nil)
+	mc.EXPECT().ListObjects(mock.Anything, "/api/v1/namespaces/acme/components", mock.Anything, "").
+		Return([]map[string]any{component("orders", "shop"), component("billing", "finance")}, "", nil)
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).Get(Params{Kind: "components", Namespace: "acme", Project: "shop", Output: OutputWide}))
+	})
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, []string{"NAME", "PROJECT", "REPLICAS", "REASON", "AGE"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"orders", "shop", "2", "Ready", "2h"}, strings.Fields(lines[1]))
+}
+
+func TestGet_Pagination(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetPrinterColumns(mock.Anything, "components").Return(componentColumns(), nil)
+	mc.EXPECT().ListObjects(mock.Anything, "/api/v1/namespaces/acme/components", mock.Anything, "").
+		Return([]map[string]any{component("orders", "shop")}, "next", nil)
+	mc.EXPECT().ListObjects(mock.Anything, "/api/v1/namespaces/acme/components", mock.Anything, "next").
+		Return([]map[string]any{component("billing", "shop")}, "", nil)
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).Get(Params{Kind: "components", Namespace: "acme"}))
+	})
+
+	assert.Contains(t, out, "orders")
+	assert.Contains(t, out, "billing")
+}
+
+func TestGet_SingleResourceYAML(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetPrinterColumns(mock.Anything, "clusterdataplane").Return(&gen.PrinterColumns{
+		Kind: "ClusterDataPlane", Plural: "clusterdataplanes", Columns: []gen.PrinterColumn{},
+	}, nil)
+	mc.EXPECT().GetObject(mock.Anything, "/api/v1/clusterdataplanes/default").
+		Return(map[string]any{"metadata": map[string]any{"name": "default"}}, nil)
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).Get(Params{Kind: "clusterdataplane", Name: "default", Output: printer.FormatYAML}))
+	})
+
+	assert.Equal(t, "metadata:\n  name: default\n", out)
+}
+
+func TestGet_ListJSON(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetPrinterColumns(mock.Anything, "customdomains").Return(&gen.PrinterColumns{
+		Kind: "CustomDomain", Plural: "customdomains", Namespaced: true,
+	}, nil)
+	mc.EXPECT().ListObjects(mock.Anything, "/api/v1/namespaces/acme/custom-domains", mock.Anything, "").
+		Return([]map[string]any{{"metadata": map[string]any{"name": "shop-domain"}}}, "", nil)
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).Get(Params{Kind: "customdomains", Namespace: "acme", Output: printer.FormatJSON}))
+	})
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal([]byte(out), &got))
+	assert.Len(t, got["items"], 1)
+}
+
+func TestGet_Templates(t *testing.T) {
+	items := []map[string]any{
+		{"metadata": map[string]any{"name": "orders"}, "status": map[string]any{"image": "registry/orders@sha256:abc"}},
+		{"metadata": map[string]any{"name": "payments"}, "status": map[string]any{"image": "registry/payments@sha256:def"}},
+	}
+	tests := []struct {
+		name   string
+		params Params
+		want   string
+	}{
+		{
+			name:   "jsonpath over a list",
+			params: Params{Kind: "components", Namespace: "acme", Output: `jsonpath={range .items[*]}{.metadata.name}={.status.image}{"\n"}{end}`},
+			want:   "orders=registry/orders@sha256:abc\npayments=registry/payments@sha256:def\n",
+		},
+		{
+			name:   "go-template over a list",
+			params: Params{Kind: "components", Namespace: "acme", Output: `go-template={{range .items}}{{.metadata.name}} {{end}}`},
+			want:   "orders payments ",
+		},
+		{
+			name:   "jsonpath of a single resource",
+			params: Params{Kind: "components", Namespace: "acme", Name: "orders", Output: "jsonpath={.status.image}"},
+			want:   "registry/orders@sha256:abc",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := mocks.NewMockInterface(t)
+			mc.EXPECT().GetPrinterColumns(mock.Anything, "components").Return(componentColumns(), nil)
+			if tt.params.Name != "" {
+				mc.EXPECT().GetObject(mock.Anything, "/api/v1/namespaces/acme/components/orders").Return(items[0], nil)
+			} else {
+				mc.EXPECT().ListObjects(mock.Anything, "/api/v1/namespaces/acme/components", mock.Anything, "").Return(items, "", nil)
+			}
+
+			out := testutil.CaptureStdout(t, func() {
+				require.NoError(t, New(mc).Get(tt.params))
+			})
+			assert.Equal(t, tt.want, out)
+		})
+	}
+}
+
+func TestGet_DefaultColumns(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetPrinterColumns(mock.Anything, "clusterdataplanes").Return(&gen.PrinterColumns{
+		Kind: "ClusterDataPlane", Plural: "clusterdataplanes",
+	}, nil)
+	mc.EXPECT().ListObjects(mock.Anything, "/api/v1/clusterdataplanes", mock.Anything, "").
+		Return([]map[string]any{{"metadata": map[string]any{"name": "default"}}}, "", nil)
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).Get(Params{Kind: "clusterdataplanes"}))
+	})
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	assert.Equal(t, []string{"NAME", "AGE"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"default", "<none>"}, strings.Fields(lines[1]))
+}
+
+func TestGet_Empty(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetPrinterColumns(mock.Anything, "components").Return(componentColumns(), nil)
+	mc.EXPECT().ListObjects(mock.Anything, mock.Anything, mock.Anything, "").Return(nil, "", nil)
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).Get(Params{Kind: "components", Namespace: "acme"}))
+	})
+
+	assert.Equal(t, "No components found\n", out)
+}
+
+func TestGet_NamespaceFromContext(t *testing.T) {
+	home := testutil.SetupTestHome(t)
+	testutil.WriteOCConfig(t, home, config.StoredConfig{
+		CurrentContext: "test",
+		Contexts:       []config.Context{{Name: "test", Namespace: "from-context"}},
+	})
+
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetPrinterColumns(mock.Anything, "components").Return(componentColumns(), nil)
+	mc.EXPECT().ListObjects(mock.Anything, "/api/v1/namespaces/from-context/components", mock.Anything, "").Return(nil, "", nil)
+
+	testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).Get(Params{Kind: "components"}))
+	})
+}
+
+func TestGet_Errors(t *testing.T) {
+	t.Run("unsupported output", func(t *testing.T) {
+		err := New(mocks.NewMockInterface(t)).Get(Params{Kind: "components", Output: "table"})
+		assert.ErrorContains(t, err, `unsupported output format "table"`)
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		err := New(mocks.NewMockInterface(t)).Get(Params{Kind: "components", Output: "jsonpath={.metadata"})
+		assert.ErrorContains(t, err, "invalid jsonpath template")
+	})
+
+	t.Run("project on cluster-scoped kind", func(t *testing.T) {
+		mc := mocks.NewMockInterface(t)
+		mc.EXPECT().GetPrinterColumns(mock.Anything, "clusterdataplanes").Return(&gen.PrinterColumns{
+			Kind: "ClusterDataPlane", Plural: "clusterdataplanes",
+		}, nil)
+		err := New(mc).Get(Params{Kind: "clusterdataplanes", Project: "shop"})
+		assert.ErrorContains(t, err, "cluster-scoped")
+	})
+
+	t.Run("missing namespace", func(t *testing.T) {
+		testutil.SetupTestHome(t)
+		mc := mocks.NewMockInterface(t)
+		mc.EXPECT().GetPrinterColumns(mock.Anything, "components").Return(componentColumns(), nil)
+		err := New(mc).Get(Params{Kind: "components"})
+		assert.ErrorContains(t, err, "--namespace")
+	})
+}
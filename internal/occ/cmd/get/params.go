@@ -0,0 +1,17 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package get
+
+// OutputWide prints the table with every printer column. The other formats
+// accepted by --output are those of the printer package.
+const OutputWide = "wide"
+
+// Params defines parameters for getting resources of any kind
+type Params struct {
+	Kind      string
+	Name      string
+	Namespace string
+	Project   string
+	Output    string
+}
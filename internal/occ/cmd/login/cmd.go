@@ -10,6 +10,7 @@ import (
 // LoginParams defines parameters for the login command.
 type LoginParams struct {
 	ClientCredentials bool
+	Device            bool
 	ClientID          string
 	ClientSecret      string
 	Scope             string
@@ -23,12 +24,14 @@ func NewLoginCmd() *cobra.Command {
 		Long:  "Login to OpenChoreo CLI",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCreds, _ := cmd.Flags().GetBool("client-credentials")
+			device, _ := cmd.Flags().GetBool("device")
 			clientID, _ := cmd.Flags().GetString("client-id")
 			clientSecret, _ := cmd.Flags().GetString("client-secret")
 			scope, _ := cmd.Flags().GetString("scope")
 			credentialName, _ := cmd.Flags().GetString("credential")
 			return NewAuthImpl().Login(LoginParams{
 				ClientCredentials: clientCreds,
+				Device:            device,
 				ClientID:          clientID,
 				ClientSecret:      clientSecret,
 				Scope:             scope,
@@ -37,6 +40,8 @@ func NewLoginCmd() *cobra.Command {
 		},
 	}
 	cmd.Flags().Bool("client-credentials", false, "Use OAuth2 client credentials flow for authentication")
+	cmd.Flags().Bool("device", false, "Use OAuth2 device authorization flow, entering a code in a browser on another device")
+	cmd.MarkFlagsMutuallyExclusive("client-credentials", "device")
 	cmd.Flags().String("client-id", "", "OAuth2 client ID for service account authentication")
 	cmd.Flags().String("client-secret", "", "OAuth2 client secret for service account authentication")
 	cmd.Flags().String("scope", "", "OAuth2 scope to request in the token request (optional, client credentials flow only)")
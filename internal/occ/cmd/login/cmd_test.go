@@ -24,7 +24,7 @@ func TestNewLoginCmd_Structure(t *testing.T) {
 func TestNewLoginCmd_Flags(t *testing.T) {
 	cmd := NewLoginCmd()
 
-	for _, name := range []string{"client-credentials", "client-id", "client-secret", "credential"} {
+	for _, name := range []string{"client-credentials", "device", "client-id", "client-secret", "credential"} {
 		assert.NotNil(t, cmd.Flags().Lookup(name), "expected flag %q", name)
 	}
 }
@@ -56,3 +56,15 @@ func TestNewLoginCmd_RunE_ClientCredentials_MissingCreds(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "client ID and client secret are required")
 }
+
+func TestNewLoginCmd_DeviceAndClientCredentialsExclusive(t *testing.T) {
+	testutil.SetupTestHome(t)
+
+	cmd := NewLoginCmd()
+	cmd.SetArgs([]string{"--device", "--client-credentials"})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "none of the others can be")
+}
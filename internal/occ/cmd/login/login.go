@@ -22,6 +22,9 @@ func (i *AuthImpl) Login(params LoginParams) error {
 	if params.ClientCredentials {
 		return i.loginWithClientCredentials(params)
 	}
+	if params.Device {
+		return i.loginWithDevice(params)
+	}
 	// Default to PKCE flow for interactive login
 	return i.loginWithPKCE(params)
 }
@@ -198,13 +201,100 @@ func (i *AuthImpl) loginWithPKCE(params LoginParams) error {
 		return fmt.Errorf("failed to exchange auth code: %w", err)
 	}
 
+	currentContext.Credentials = credentialName
+
+	if err := saveUserCredential(cfg, credentialName, oidcConfig.ClientID, tokenResp, "authorization_code"); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n✓ Authentication successful!\n")
+	fmt.Printf("Credential '%s' saved and associated with context '%s'\n",
+		credentialName, cfg.CurrentContext)
+
+	return nil
+}
+
+func (i *AuthImpl) loginWithDevice(params LoginParams) error {
+	currentContext, err := config.GetCurrentContext()
+	if err != nil {
+		return fmt.Errorf("failed to get current context: %w", err)
+	}
+
+	// Use existing credential name if none specified
+	credentialName := params.CredentialName
+	if credentialName == "" {
+		credentialName = currentContext.Credentials
+	}
+	if credentialName == "" {
+		return fmt.Errorf("credential name must be specified when no existing credential is associated with the current context")
+	}
+
+	controlPlane, err := config.GetCurrentControlPlane()
+	if err != nil {
+		return fmt.Errorf("failed to get control plane: %w", err)
+	}
+
+	cfg, err := config.LoadStoredConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	fmt.Println("Fetching OIDC configuration...")
+	oidcConfig, err := auth.FetchOIDCConfig(controlPlane.URL)
+	if err != nil {
+		return fmt.Errorf("failed to fetch OIDC config: %w", err)
+	}
+
+	// Check if security is disabled on the server
+	if !oidcConfig.SecurityEnabled {
+		fmt.Println("Security is disabled on the server. Authentication is not required.")
+		return nil
+	}
+
+	deviceAuth, err := auth.NewDeviceAuth(oidcConfig)
+	if err != nil {
+		return fmt.Errorf("failed to initialize device auth: %w (use 'occ login' for browser-based login)", err)
+	}
+
+	codeResp, err := deviceAuth.RequestDeviceCode()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("\nTo authenticate, visit:\n%s\n\nand enter the code: %s\n\n", codeResp.VerificationURI, codeResp.UserCode)
+	if codeResp.VerificationURIComplete != "" {
+		fmt.Printf("Or open this URL, which includes the code:\n%s\n\n", codeResp.VerificationURIComplete)
+	}
+
+	fmt.Println("Waiting for the login to be approved...")
+	tokenResp, err := deviceAuth.PollForToken(codeResp)
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	currentContext.Credentials = credentialName
+
+	if err := saveUserCredential(cfg, credentialName, oidcConfig.ClientID, tokenResp, "device_code"); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n✓ Authentication successful!\n")
+	fmt.Printf("Credential '%s' saved and associated with context '%s'\n",
+		credentialName, cfg.CurrentContext)
+
+	return nil
+}
+
+// saveUserCredential stores the tokens of an interactive login under the named
+// credential, replacing any client credentials it held.
+func saveUserCredential(cfg *config.StoredConfig, credentialName, clientID string, tokenResp *auth.PKCETokenResponse, authMethod string) error {
 	credentialExists := false
 	for idx := range cfg.Credentials {
 		if cfg.Credentials[idx].Name == credentialName {
 			cfg.Credentials[idx].Token = tokenResp.AccessToken
 			cfg.Credentials[idx].RefreshToken = tokenResp.RefreshToken
-			cfg.Credentials[idx].AuthMethod = "authorization_code"
-			cfg.Credentials[idx].ClientID = oidcConfig.ClientID
+			cfg.Credentials[idx].AuthMethod = authMethod
+			cfg.Credentials[idx].ClientID = clientID
 			cfg.Credentials[idx].ClientSecret = ""
 			credentialExists = true
 			break
@@ -214,23 +304,16 @@ func (i *AuthImpl) loginWithPKCE(params LoginParams) error {
 	if !credentialExists {
 		cfg.Credentials = append(cfg.Credentials, config.Credential{
 			Name:         credentialName,
-			ClientID:     oidcConfig.ClientID,
+			ClientID:     clientID,
 			Token:        tokenResp.AccessToken,
 			RefreshToken: tokenResp.RefreshToken,
-			AuthMethod:   "authorization_code",
+			AuthMethod:   authMethod,
 		})
 	}
 
-	currentContext.Credentials = credentialName
-
 	if err := config.SaveStoredConfig(cfg); err != nil {
 		return fmt.Errorf("failed to save config: %w", err)
 	}
-
-	fmt.Printf("\n✓ Authentication successful!\n")
-	fmt.Printf("Credential '%s' saved and associated with context '%s'\n",
-		credentialName, cfg.CurrentContext)
-
 	return nil
 }
 
@@ -275,6 +358,9 @@ func (i *AuthImpl) GetLoginPrompt() string {
    Interactive login (browser-based):
    occ login
 
+   Interactive login on a machine without a browser:
+   occ login --device
+
    Client credentials (service accounts):
    occ login --client-credentials --client-id <client-id> --client-secret <client-secret>
 
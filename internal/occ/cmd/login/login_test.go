@@ -284,6 +284,37 @@ func TestLoginWithClientCredentials(t *testing.T) {
 	})
 }
 
+func TestLoginWithDevice(t *testing.T) {
+	writeConfig := func(t *testing.T, baseURL string) {
+		t.Helper()
+		testutil.WriteOCConfig(t, testutil.SetupTestHome(t), &config.StoredConfig{
+			CurrentContext: "ctx",
+			ControlPlanes:  []config.ControlPlane{{Name: "cp", URL: baseURL}},
+			Credentials:    []config.Credential{{Name: "cred"}},
+			Contexts:       []config.Context{{Name: "ctx", ControlPlane: "cp", Credentials: "cred"}},
+		})
+	}
+
+	t.Run("returns error when the IdP has no device authorization endpoint", func(t *testing.T) {
+		baseURL := mockOIDCTransport(t, true, http.StatusOK)
+		writeConfig(t, baseURL)
+
+		err := NewAuthImpl().Login(LoginParams{Device: true})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not support the device authorization flow")
+	})
+
+	t.Run("skips login when security is disabled", func(t *testing.T) {
+		baseURL := mockOIDCTransport(t, false, http.StatusOK)
+		writeConfig(t, baseURL)
+
+		out := testutil.CaptureStdout(t, func() {
+			require.NoError(t, NewAuthImpl().Login(LoginParams{Device: true}))
+		})
+		assert.Contains(t, out, "Security is disabled")
+	})
+}
+
 func TestIsLoggedIn(t *testing.T) {
 	t.Run("returns false when no config exists", func(t *testing.T) {
 		testutil.SetupTestHome(t)
@@ -0,0 +1,68 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/internal/occ/auth"
+	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func NewLogsCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs TYPE/NAME",
+		Short: "Print the logs of a component or a build",
+		Long: `Print the logs of a component or a build.
+
+TYPE is component (comp) or build (workflowrun, wr). Component logs come from
+the observer of the environment; if --env is not specified, the lowest
+environment of the deployment pipeline is used. Build logs are streamed from
+the workflow plane while the build runs, and read from the observer after it
+has completed.
+
+With --follow, new logs are printed as they arrive. If the log source becomes
+unreachable, occ keeps reconnecting with an increasing delay and resumes from
+where it stopped.`,
+		Example: `  # Follow the logs of a component in the dev environment
+  occ logs component/orders --env dev --follow
+
+  # Show the last 100 lines of a single container
+  occ logs component/orders --env dev -c main --tail 100
+
+  # Print the logs of a build
+  occ logs build/orders-build-1
+
+  # Follow a single task of a running build
+  occ logs build/orders-build-1 -c build-image -f`,
+		Args:    cmdutil.ExactOneArgWithUsage(),
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).Logs(Params{
+				Target:      args[0],
+				Namespace:   flags.GetNamespace(cmd),
+				Project:     flags.GetProject(cmd),
+				Environment: flags.GetEnvironment(cmd),
+				Container:   flags.GetContainer(cmd),
+				Follow:      flags.GetFollow(cmd),
+				Since:       flags.GetSince(cmd),
+				Tail:        flags.GetTail(cmd),
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	flags.AddProject(cmd)
+	flags.AddEnvironment(cmd)
+	flags.AddContainer(cmd)
+	flags.AddFollow(cmd)
+	flags.AddSince(cmd)
+	flags.AddTail(cmd)
+	return cmd
+}
@@ -0,0 +1,26 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func TestNewLogsCmd(t *testing.T) {
+	cmd := NewLogsCmd(func() (client.Interface, error) { return nil, fmt.Errorf("unused") })
+
+	assert.Equal(t, "logs", cmd.Name())
+	for _, name := range []string{"namespace", "project", "env", "container", "follow", "since", "tail"} {
+		assert.NotNil(t, cmd.Flags().Lookup(name), "missing flag %s", name)
+	}
+	assert.Equal(t, "c", cmd.Flags().Lookup("container").Shorthand)
+	assert.Equal(t, "f", cmd.Flags().Lookup("follow").Shorthand)
+	assert.Error(t, cmd.Args(cmd, nil))
+	assert.NoError(t, cmd.Args(cmd, []string{"component/orders"}))
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/component"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/workflowrun"
+	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+const (
+	targetComponent = "component"
+	targetBuild     = "build"
+)
+
+// targetTypes maps the accepted TYPE prefixes to the kind of logs they select
+var targetTypes = map[string]string{
+	"component":    targetComponent,
+	"components":   targetComponent,
+	"comp":         targetComponent,
+	"build":        targetBuild,
+	"builds":       targetBuild,
+	"workflowrun":  targetBuild,
+	"workflowruns": targetBuild,
+	"wr":           targetBuild,
+}
+
+// Logs implements the logs command
+type Logs struct {
+	client client.Interface
+}
+
+// New creates a new logs implementation
+func New(c client.Interface) *Logs {
+	return &Logs{client: c}
+}
+
+// Logs prints the logs of the target component or build
+func (l *Logs) Logs(params Params) error {
+	kind, name, err := parseTarget(params.Target)
+	if err != nil {
+		return err
+	}
+
+	switch kind {
+	case targetComponent:
+		if err := cmdutil.RequireFields("component", "logs", map[string]string{
+			"namespace": params.Namespace,
+			"project":   params.Project,
+		}); err != nil {
+			return err
+		}
+		return component.New(l.client).Logs(component.LogsParams{
+			Namespace:   params.Namespace,
+			Project:     params.Project,
+			Component:   name,
+			Environment: params.Environment,
+			Follow:      params.Follow,
+			Since:       params.Since,
+			Tail:        params.Tail,
+			Container:   params.Container,
+		})
+	default:
+		if params.Environment != "" {
+			return fmt.Errorf("--env is not supported for builds")
+		}
+		return workflowrun.New(l.client).Logs(workflowrun.LogsParams{
+			Namespace:       params.Namespace,
+			WorkflowRunName: name,
+			Follow:          params.Follow,
+			Since:           params.Since,
+			Tail:            params.Tail,
+			Task:            params.Container,
+		})
+	}
+}
+
+// parseTarget splits a TYPE/NAME argument into the kind of logs and the resource name
+func parseTarget(target string) (string, string, error) {
+	typ, name, ok := strings.Cut(target, "/")
+	if !ok || typ == "" || name == "" {
+		return "", "", fmt.Errorf("invalid target %q: expected TYPE/NAME, e.g. component/orders or build/orders-build-1", target)
+	}
+	kind, ok := targetTypes[strings.ToLower(typ)]
+	if !ok {
+		return "", "", fmt.Errorf("unsupported target type %q: must be component or build", typ)
+	}
+	return kind, name, nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+func TestParseTarget(t *testing.T) {
+	tests := []struct {
+		target   string
+		wantKind string
+		wantName string
+		wantErr  string
+	}{
+		{target: "component/orders", wantKind: targetComponent, wantName: "orders"},
+		{target: "comp/orders", wantKind: targetComponent, wantName: "orders"},
+		{target: "build/orders-build-1", wantKind: targetBuild, wantName: "orders-build-1"},
+		{target: "WR/orders-build-1", wantKind: targetBuild, wantName: "orders-build-1"},
+		{target: "orders", wantErr: "expected TYPE/NAME"},
+		{target: "component/", wantErr: "expected TYPE/NAME"},
+		{target: "project/shop", wantErr: "unsupported target type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			kind, name, err := parseTarget(tt.target)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantKind, kind)
+			assert.Equal(t, tt.wantName, name)
+		})
+	}
+}
+
+func TestLogs_ComponentRequiresProject(t *testing.T) {
+	err := New(nil).Logs(Params{Target: "component/orders", Namespace: "acme"})
+	assert.ErrorContains(t, err, "--project")
+}
+
+func TestLogs_BuildRejectsEnvironment(t *testing.T) {
+	err := New(nil).Logs(Params{Target: "build/orders-build-1", Namespace: "acme", Environment: "dev"})
+	assert.ErrorContains(t, err, "--env is not supported for builds")
+}
+
+func TestLogs_BuildUsesContainerAsTask(t *testing.T) {
+	now := time.Now()
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetWorkflowRunStatus(mock.Anything, "acme", "orders-build-1").Return(
+		&gen.WorkflowRunStatusResponse{HasLiveObservability: true}, nil)
+	mc.EXPECT().GetWorkflowRunLogs(mock.Anything, "acme", "orders-build-1", mock.MatchedBy(func(p *gen.GetWorkflowRunLogsParams) bool {
+		return p.Task != nil && *p.Task == "build-image"
+	})).Return([]gen.WorkflowRunLogEntry{{Timestamp: &now, Log: "pushing image"}}, nil)
+
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, New(mc).Logs(Params{
+			Target: "build/orders-build-1", Namespace: "acme", Container: "build-image",
+		}))
+	})
+	assert.Contains(t, out, "pushing image")
+}
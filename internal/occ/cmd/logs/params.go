@@ -0,0 +1,16 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+// Params defines parameters for getting the logs of a component or a build
+type Params struct {
+	Target      string // TYPE/NAME, e.g. component/orders or build/orders-build-1
+	Namespace   string
+	Project     string
+	Environment string
+	Container   string // container for components, task for builds
+	Follow      bool
+	Since       string
+	Tail        int
+}
@@ -9,10 +9,9 @@ import (
 	"os"
 	"text/tabwriter"
 
-	"sigs.k8s.io/yaml"
-
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
+	"github.com/openchoreo/openchoreo/internal/occ/printer"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 )
@@ -28,7 +27,7 @@ func New(c client.Interface) *Namespace {
 }
 
 // List lists all namespaces
-func (n *Namespace) List() error {
+func (n *Namespace) List(params ListParams) error {
 	ctx := context.Background()
 
 	items, err := pagination.FetchAll(func(limit int, cursor string) ([]gen.Namespace, string, error) {
@@ -51,25 +50,22 @@ func (n *Namespace) List() error {
 		return err
 	}
 
+	if params.Output != "" {
+		return printer.PrintList(os.Stdout, items, params.Output)
+	}
 	return printList(items)
 }
 
-// Get retrieves a single namespace and outputs it as YAML
-func (n *Namespace) Get(name string) error {
+// Get retrieves a single namespace and prints it in the requested output format, YAML by default
+func (n *Namespace) Get(params GetParams) error {
 	ctx := context.Background()
 
-	result, err := n.client.GetNamespace(ctx, name)
+	result, err := n.client.GetNamespace(ctx, params.Name)
 	if err != nil {
 		return err
 	}
 
-	data, err := yaml.Marshal(result)
-	if err != nil {
-		return fmt.Errorf("failed to marshal namespace to YAML: %w", err)
-	}
-
-	fmt.Print(string(data))
-	return nil
+	return printer.Print(os.Stdout, result, params.Output)
 }
 
 // Delete deletes a single namespace
@@ -55,7 +55,7 @@ func TestList_APIError(t *testing.T) {
 	mc.EXPECT().ListNamespaces(mock.Anything, mock.Anything).Return(nil, fmt.Errorf("server error"))
 
 	n := New(mc)
-	assert.EqualError(t, n.List(), "server error")
+	assert.EqualError(t, n.List(ListParams{}), "server error")
 }
 
 func TestList_Success(t *testing.T) {
@@ -67,7 +67,7 @@ func TestList_Success(t *testing.T) {
 
 	n := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, n.List())
+		require.NoError(t, n.List(ListParams{}))
 	})
 	assert.Contains(t, out, "org-a")
 }
@@ -85,7 +85,7 @@ func TestList_MultipleItems(t *testing.T) {
 
 	n := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, n.List())
+		require.NoError(t, n.List(ListParams{}))
 	})
 	assert.Contains(t, out, "org-a")
 	assert.Contains(t, out, "org-b")
@@ -100,7 +100,7 @@ func TestList_Empty(t *testing.T) {
 
 	n := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, n.List())
+		require.NoError(t, n.List(ListParams{}))
 	})
 	assert.Contains(t, out, "No namespaces found")
 }
@@ -112,7 +112,7 @@ func TestGet_APIError(t *testing.T) {
 	mc.EXPECT().GetNamespace(mock.Anything, "missing").Return(nil, fmt.Errorf("not found: missing"))
 
 	n := New(mc)
-	assert.EqualError(t, n.Get("missing"), "not found: missing")
+	assert.EqualError(t, n.Get(GetParams{Name: "missing"}), "not found: missing")
 }
 
 func TestGet_Success(t *testing.T) {
@@ -123,7 +123,7 @@ func TestGet_Success(t *testing.T) {
 
 	n := New(mc)
 	out := testutil.CaptureStdout(t, func() {
-		require.NoError(t, n.Get("org-a"))
+		require.NoError(t, n.Get(GetParams{Name: "org-a"}))
 	})
 	assert.Contains(t, out, "name: org-a")
 }
@@ -0,0 +1,15 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package namespace
+
+// ListParams defines parameters for listing namespaces
+type ListParams struct {
+	Output string // --output format
+}
+
+// GetParams defines parameters for getting a single namespace
+type GetParams struct {
+	Name   string
+	Output string // --output format
+}
@@ -42,10 +42,12 @@ func newListCmd(f client.NewClientFunc) *cobra.Command {
 			}
 			return New(cl).List(ListParams{
 				Namespace: flags.GetNamespace(cmd),
+				Output:    flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
+	flags.AddListOutputFormat(cmd)
 	return cmd
 }
 
@@ -53,7 +55,7 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get [CHANNEL_NAME]",
 		Short: "Get an observability alerts notification channel",
-		Long:  `Get an observability alerts notification channel and display its details in YAML format.`,
+		Long:  `Get an observability alerts notification channel and display its details in YAML, or in the format set by --output.`,
 		Example: `  # Get an observability alerts notification channel
   occ observabilityalertsnotificationchannel get my-channel --namespace acme-corp`,
 		Args:    cmdutil.ExactOneArgWithUsage(),
@@ -66,10 +68,12 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 			return New(cl).Get(GetParams{
 				Namespace:   flags.GetNamespace(cmd),
 				ChannelName: args[0],
+				Output:      flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
+	flags.AddGetOutputFormat(cmd)
 	return cmd
 }
 
@@ -9,11 +9,10 @@ import (
 	"os"
 	"text/tabwriter"
 
-	"sigs.k8s.io/yaml"
-
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
 	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/printer"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 )
@@ -54,10 +53,13 @@ func (o *ObservabilityAlertsNotificationChannel) List(params ListParams) error {
 	if err != nil {
 		return err
 	}
+	if params.Output != "" {
+		return printer.PrintList(os.Stdout, items, params.Output)
+	}
 	return printList(items)
 }
 
-// Get retrieves a single observability alerts notification channel and outputs it as YAML
+// Get retrieves a single observability alerts notification channel and prints it in the requested output format, YAML by default
 func (o *ObservabilityAlertsNotificationChannel) Get(params GetParams) error {
 	if err := cmdutil.RequireFields("get", "observabilityalertsnotificationchannel", map[string]string{"namespace": params.Namespace}); err != nil {
 		return err
@@ -69,13 +71,7 @@ func (o *ObservabilityAlertsNotificationChannel) Get(params GetParams) error {
 		return err
 	}
 
-	data, err := yaml.Marshal(result)
-	if err != nil {
-		return fmt.Errorf("failed to marshal observability alerts notification channel to YAML: %w", err)
-	}
-
-	fmt.Print(string(data))
-	return nil
+	return printer.Print(os.Stdout, result, params.Output)
 }
 
 // Delete deletes a single observability alerts notification channel
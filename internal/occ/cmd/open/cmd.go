@@ -0,0 +1,68 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package open
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/internal/occ/auth"
+	"github.com/openchoreo/openchoreo/internal/occ/completion"
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func NewOpenCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open component/NAME --env ENV",
+		Short: "Open the live endpoint or dashboard of a component in the browser",
+		Long: `Open a component in the default browser.
+
+By default, the gateway URL of the component's endpoint in the environment is
+opened, preferring the external gateway and HTTPS. A component with several
+endpoints needs --endpoint to choose one.
+
+With --dashboard, the observability dashboard of the component is opened
+instead. Its URL comes from the dashboardURL of the observability plane that
+serves the environment.`,
+		Example: `  # Open the endpoint of a component in the dev environment
+  occ open component/orders --env dev
+
+  # Open a specific endpoint
+  occ open component/orders --env dev --endpoint admin
+
+  # Open the observability dashboard of a component
+  occ open component/orders --env production --dashboard
+
+  # Print the URL instead of opening it
+  occ open component/orders --env dev --no-browser`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.KindReference(f, "component"),
+		PreRunE:           auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			endpoint, _ := cmd.Flags().GetString("endpoint")
+			dashboard, _ := cmd.Flags().GetBool("dashboard")
+			noBrowser, _ := cmd.Flags().GetBool("no-browser")
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).Open(Params{
+				Resource:    args[0],
+				Namespace:   flags.GetNamespace(cmd),
+				Environment: flags.GetEnvironment(cmd),
+				Endpoint:    endpoint,
+				Dashboard:   dashboard,
+				NoBrowser:   noBrowser,
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	flags.AddEnvironment(cmd)
+	_ = cmd.MarkFlagRequired("env")
+	cmd.Flags().String("endpoint", "", "Endpoint to open when the component exposes several")
+	cmd.Flags().Bool("dashboard", false, "Open the observability dashboard of the component")
+	cmd.Flags().Bool("no-browser", false, "Print the URL instead of opening it")
+	cmd.MarkFlagsMutuallyExclusive("endpoint", "dashboard")
+	return cmd
+}
@@ -0,0 +1,26 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package open
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func TestNewOpenCmd(t *testing.T) {
+	cmd := NewOpenCmd(func() (client.Interface, error) { return nil, fmt.Errorf("unused") })
+
+	assert.Equal(t, "open", cmd.Name())
+	for _, name := range []string{"namespace", "env", "endpoint", "dashboard", "no-browser"} {
+		assert.NotNil(t, cmd.Flags().Lookup(name), name)
+	}
+	assert.Equal(t, []string{"true"}, cmd.Flags().Lookup("env").Annotations[cobra.BashCompOneRequiredFlag])
+	assert.Error(t, cmd.Args(cmd, nil))
+	assert.NoError(t, cmd.Args(cmd, []string{"component/orders"}))
+}
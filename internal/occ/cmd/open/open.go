@@ -0,0 +1,281 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package open
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/openchoreo/openchoreo/internal/occ/browser"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+// defaultPlaneName is the observability plane used by data planes without an
+// observabilityPlaneRef.
+const defaultPlaneName = "default"
+
+// openBrowser opens a URL in the default browser. Tests replace it.
+var openBrowser = browser.Open
+
+type Opener struct {
+	client client.Interface
+}
+
+func New(c client.Interface) *Opener {
+	return &Opener{client: c}
+}
+
+// Open resolves the live endpoint URL or the observability dashboard of a
+// component in an environment and opens it in the default browser.
+func (o *Opener) Open(params Params) error {
+	return o.open(context.Background(), os.Stdout, params)
+}
+
+func (o *Opener) open(ctx context.Context, out io.Writer, params Params) error {
+	name, err := parseComponentRef(params.Resource)
+	if err != nil {
+		return err
+	}
+	namespace := params.Namespace
+	if namespace == "" {
+		namespace = config.DefaultNamespace()
+		if namespace == "" {
+			return fmt.Errorf("namespace is required: set --namespace or a namespace in the current context")
+		}
+	}
+	if params.Environment == "" {
+		return fmt.Errorf("environment is required: set --env")
+	}
+
+	var target string
+	if params.Dashboard {
+		target, err = o.dashboardURL(ctx, namespace, name, params.Environment)
+	} else {
+		target, err = o.endpointURL(ctx, namespace, name, params.Environment, params.Endpoint)
+	}
+	if err != nil {
+		return err
+	}
+
+	if params.NoBrowser {
+		fmt.Fprintln(out, target)
+		return nil
+	}
+	fmt.Fprintf(out, "Opening %s\n", target)
+	if err := openBrowser(target); err != nil {
+		return fmt.Errorf("failed to open a browser, open %s manually: %w", target, err)
+	}
+	return nil
+}
+
+// endpointURL returns the gateway URL of an endpoint of the component in the
+// environment. Without a named endpoint, the component must have exactly one
+// endpoint reachable through a gateway there.
+func (o *Opener) endpointURL(ctx context.Context, namespace, component, env, endpoint string) (string, error) {
+	list, err := o.client.ListEndpoints(ctx, namespace, &gen.ListEndpointsParams{
+		Component:   &component,
+		Environment: &env,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var names, reachable []string
+	urls := map[string]string{}
+	for _, ep := range list.Items {
+		if ep.ComponentName != component {
+			continue
+		}
+		names = append(names, ep.Name)
+		for _, e := range ep.Environments {
+			if e.Environment != env {
+				continue
+			}
+			if u := gatewayURL(e); u != "" {
+				urls[ep.Name] = u
+				reachable = append(reachable, ep.Name)
+			}
+		}
+	}
+
+	if endpoint != "" {
+		if u, ok := urls[endpoint]; ok {
+			return u, nil
+		}
+		for _, n := range names {
+			if n == endpoint {
+				return "", fmt.Errorf("endpoint %s of component %s is not reachable through a gateway in environment %s", endpoint, component, env)
+			}
+		}
+		return "", fmt.Errorf("component %s has no endpoint %q; its endpoints are: %s", component, endpoint, joinOrNone(names))
+	}
+
+	switch len(reachable) {
+	case 1:
+		return urls[reachable[0]], nil
+	case 0:
+		if len(names) == 0 {
+			return "", fmt.Errorf("component %s exposes no endpoints", component)
+		}
+		return "", fmt.Errorf("no endpoint of component %s is reachable through a gateway in environment %s; is it deployed there?", component, env)
+	default:
+		return "", fmt.Errorf("component %s has several endpoints in environment %s (%s); choose one with --endpoint",
+			component, env, strings.Join(reachable, ", "))
+	}
+}
+
+// gatewayURL returns the URL of an endpoint in an environment, preferring the
+// external gateway over the internal one and HTTPS over HTTP. The service URL
+// is only reachable from within the data plane and is not considered.
+func gatewayURL(e gen.EnvironmentEndpoint) string {
+	for _, urls := range []*gen.EndpointGatewayURLs{e.ExternalURLs, e.InternalURLs} {
+		if urls == nil {
+			continue
+		}
+		for _, u := range []*gen.EndpointURL{urls.Https, urls.Http} {
+			if u != nil && u.Host != "" {
+				return formatURL(u)
+			}
+		}
+	}
+	return ""
+}
+
+func formatURL(u *gen.EndpointURL) string {
+	scheme := "http"
+	if u.Scheme != nil && *u.Scheme != "" {
+		scheme = *u.Scheme
+	}
+	host := u.Host
+	if u.Port != nil && !(scheme == "http" && *u.Port == 80) && !(scheme == "https" && *u.Port == 443) {
+		host += ":" + strconv.Itoa(int(*u.Port))
+	}
+	path := ""
+	if u.Path != nil {
+		path = *u.Path
+	}
+	return scheme + "://" + host + path
+}
+
+// dashboardURL expands the dashboard URL template of the observability plane
+// that serves the environment for the component.
+func (o *Opener) dashboardURL(ctx context.Context, namespace, component, env string) (string, error) {
+	comp, err := o.client.GetComponent(ctx, namespace, component)
+	if err != nil {
+		return "", fmt.Errorf("failed to get component %s: %w", component, err)
+	}
+	template, plane, err := o.dashboardTemplate(ctx, namespace, env)
+	if err != nil {
+		return "", err
+	}
+	if template == "" {
+		return "", fmt.Errorf("observability plane %s has no dashboard URL: set spec.dashboardURL to open component dashboards", plane)
+	}
+	project := ""
+	if comp.Spec != nil {
+		project = comp.Spec.Owner.ProjectName
+	}
+	return strings.NewReplacer(
+		"{namespace}", namespace,
+		"{project}", project,
+		"{component}", component,
+		"{environment}", env,
+	).Replace(template), nil
+}
+
+// dashboardTemplate returns the dashboard URL template of the observability
+// plane of an environment, following Environment → DataPlane →
+// ObservabilityPlane, and the name of that plane. A data plane without an
+// observabilityPlaneRef uses the plane named "default" of its scope.
+func (o *Opener) dashboardTemplate(ctx context.Context, namespace, envName string) (string, string, error) {
+	env, err := o.client.GetEnvironment(ctx, namespace, envName)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get environment %s: %w", envName, err)
+	}
+	if env.Spec == nil || env.Spec.DataPlaneRef == nil {
+		return "", "", fmt.Errorf("environment %s has no data plane reference", envName)
+	}
+
+	ref := env.Spec.DataPlaneRef
+	switch ref.Kind {
+	case gen.EnvironmentSpecDataPlaneRefKindDataPlane:
+		dp, err := o.client.GetDataPlane(ctx, namespace, ref.Name)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to get data plane %s: %w", ref.Name, err)
+		}
+		if dp.Spec == nil || dp.Spec.ObservabilityPlaneRef == nil {
+			return o.namespacedDashboardTemplate(ctx, namespace, defaultPlaneName)
+		}
+		obsRef := dp.Spec.ObservabilityPlaneRef
+		switch obsRef.Kind {
+		case gen.ObservabilityPlaneRefKindObservabilityPlane:
+			return o.namespacedDashboardTemplate(ctx, namespace, obsRef.Name)
+		case gen.ObservabilityPlaneRefKindClusterObservabilityPlane:
+			return o.clusterDashboardTemplate(ctx, obsRef.Name)
+		default:
+			return "", "", fmt.Errorf("unsupported observabilityPlaneRef kind %q", obsRef.Kind)
+		}
+
+	case gen.EnvironmentSpecDataPlaneRefKindClusterDataPlane:
+		cdp, err := o.client.GetClusterDataPlane(ctx, ref.Name)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to get cluster data plane %s: %w", ref.Name, err)
+		}
+		planeName := defaultPlaneName
+		if cdp.Spec != nil && cdp.Spec.ObservabilityPlaneRef != nil {
+			planeName = cdp.Spec.ObservabilityPlaneRef.Name
+		}
+		return o.clusterDashboardTemplate(ctx, planeName)
+
+	default:
+		return "", "", fmt.Errorf("unsupported dataPlaneRef kind %q for environment %s", ref.Kind, envName)
+	}
+}
+
+func (o *Opener) namespacedDashboardTemplate(ctx context.Context, namespace, name string) (string, string, error) {
+	op, err := o.client.GetObservabilityPlane(ctx, namespace, name)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get observability plane %s: %w", name, err)
+	}
+	if op.Spec == nil || op.Spec.DashboardURL == nil {
+		return "", name, nil
+	}
+	return *op.Spec.DashboardURL, name, nil
+}
+
+func (o *Opener) clusterDashboardTemplate(ctx context.Context, name string) (string, string, error) {
+	cop, err := o.client.GetClusterObservabilityPlane(ctx, name)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get cluster observability plane %s: %w", name, err)
+	}
+	if cop.Spec == nil || cop.Spec.DashboardURL == nil {
+		return "", name, nil
+	}
+	return *cop.Spec.DashboardURL, name, nil
+}
+
+func joinOrNone(names []string) string {
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ", ")
+}
+
+func parseComponentRef(ref string) (string, error) {
+	kind, name, ok := strings.Cut(ref, "/")
+	if !ok || name == "" {
+		return "", fmt.Errorf("expected a resource as KIND/NAME, such as component/orders, got %q", ref)
+	}
+	switch strings.ToLower(kind) {
+	case "component", "components", "comp":
+		return name, nil
+	}
+	return "", fmt.Errorf("open is not supported for %q: only component/NAME is supported", kind)
+}
@@ -0,0 +1,250 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package open
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+// stubBrowser records the URLs opened instead of launching a browser.
+func stubBrowser(t *testing.T, err error) *[]string {
+	t.Helper()
+	var opened []string
+	orig := openBrowser
+	openBrowser = func(url string) error {
+		opened = append(opened, url)
+		return err
+	}
+	t.Cleanup(func() { openBrowser = orig })
+	return &opened
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func endpoint(name string, envs ...gen.EnvironmentEndpoint) gen.Endpoint {
+	return gen.Endpoint{Name: name, ComponentName: "orders", ProjectName: "shop", Environments: envs}
+}
+
+func externalHTTPS(env, host, path string) gen.EnvironmentEndpoint {
+	return gen.EnvironmentEndpoint{
+		Environment: env,
+		ExternalURLs: &gen.EndpointGatewayURLs{
+			Http:  &gen.EndpointURL{Scheme: ptr("http"), Host: host, Port: ptr(int32(80)), Path: ptr(path)},
+			Https: &gen.EndpointURL{Scheme: ptr("https"), Host: host, Port: ptr(int32(443)), Path: ptr(path)},
+		},
+	}
+}
+
+func expectEndpoints(mc *mocks.MockInterface, items ...gen.Endpoint) {
+	mc.EXPECT().ListEndpoints(mock.Anything, "acme", &gen.ListEndpointsParams{
+		Component:   ptr("orders"),
+		Environment: ptr("dev"),
+	}).Return(&gen.EndpointList{Items: items}, nil)
+}
+
+func params() Params {
+	return Params{Resource: "component/orders", Namespace: "acme", Environment: "dev"}
+}
+
+func TestOpen_Endpoint(t *testing.T) {
+	opened := stubBrowser(t, nil)
+	mc := mocks.NewMockInterface(t)
+	expectEndpoints(mc, endpoint("api",
+		externalHTTPS("dev", "orders-dev.apps.example.com", "/shop/orders"),
+		externalHTTPS("prod", "orders.apps.example.com", "/shop/orders"),
+	))
+
+	var out bytes.Buffer
+	require.NoError(t, New(mc).open(context.Background(), &out, params()))
+	assert.Equal(t, []string{"https://orders-dev.apps.example.com/shop/orders"}, *opened)
+	assert.Equal(t, "Opening https://orders-dev.apps.example.com/shop/orders\n", out.String())
+}
+
+func TestOpen_EndpointSelection(t *testing.T) {
+	internalOnly := gen.EnvironmentEndpoint{
+		Environment:  "dev",
+		InternalURLs: &gen.EndpointGatewayURLs{Http: &gen.EndpointURL{Host: "orders.internal.example.com", Port: ptr(int32(8080))}},
+	}
+	serviceOnly := gen.EnvironmentEndpoint{
+		Environment: "dev",
+		ServiceURL:  &gen.EndpointURL{Host: "orders.shop.svc", Port: ptr(int32(8080))},
+	}
+	tests := []struct {
+		name     string
+		items    []gen.Endpoint
+		endpoint string
+		want     string
+		wantErr  string
+	}{
+		{
+			name:  "falls back to the internal gateway",
+			items: []gen.Endpoint{endpoint("api", internalOnly)},
+			want:  "http://orders.internal.example.com:8080",
+		},
+		{
+			name:  "skips endpoints without a gateway URL",
+			items: []gen.Endpoint{endpoint("api", externalHTTPS("dev", "orders.example.com", "")), endpoint("metrics", serviceOnly)},
+			want:  "https://orders.example.com",
+		},
+		{
+			name:     "named endpoint",
+			items:    []gen.Endpoint{endpoint("api", externalHTTPS("dev", "api.example.com", "")), endpoint("admin", externalHTTPS("dev", "admin.example.com", ""))},
+			endpoint: "admin",
+			want:     "https://admin.example.com",
+		},
+		{
+			name:    "several endpoints",
+			items:   []gen.Endpoint{endpoint("api", externalHTTPS("dev", "api.example.com", "")), endpoint("admin", externalHTTPS("dev", "admin.example.com", ""))},
+			wantErr: "component orders has several endpoints in environment dev (api, admin); choose one with --endpoint",
+		},
+		{
+			name:     "unknown endpoint",
+			items:    []gen.Endpoint{endpoint("api", externalHTTPS("dev", "api.example.com", ""))},
+			endpoint: "admin",
+			wantErr:  `component orders has no endpoint "admin"; its endpoints are: api`,
+		},
+		{
+			name:     "named endpoint without a gateway URL",
+			items:    []gen.Endpoint{endpoint("metrics", serviceOnly)},
+			endpoint: "metrics",
+			wantErr:  "endpoint metrics of component orders is not reachable through a gateway in environment dev",
+		},
+		{
+			name:    "not deployed to the environment",
+			items:   []gen.Endpoint{endpoint("api", externalHTTPS("prod", "orders.example.com", ""))},
+			wantErr: "no endpoint of component orders is reachable through a gateway in environment dev; is it deployed there?",
+		},
+		{
+			name:    "no endpoints",
+			wantErr: "component orders exposes no endpoints",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := mocks.NewMockInterface(t)
+			expectEndpoints(mc, tt.items...)
+			p := params()
+			p.Endpoint = tt.endpoint
+			p.NoBrowser = true
+
+			var out bytes.Buffer
+			err := New(mc).open(context.Background(), &out, p)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want+"\n", out.String())
+		})
+	}
+}
+
+func TestOpen_BrowserFailure(t *testing.T) {
+	stubBrowser(t, errors.New("xdg-open not found"))
+	mc := mocks.NewMockInterface(t)
+	expectEndpoints(mc, endpoint("api", externalHTTPS("dev", "orders.example.com", "")))
+
+	err := New(mc).open(context.Background(), &bytes.Buffer{}, params())
+	require.EqualError(t, err, "failed to open a browser, open https://orders.example.com manually: xdg-open not found")
+}
+
+func expectComponent(mc *mocks.MockInterface) {
+	comp := &gen.Component{Spec: &gen.ComponentSpec{}}
+	comp.Spec.Owner.ProjectName = "shop"
+	mc.EXPECT().GetComponent(mock.Anything, "acme", "orders").Return(comp, nil)
+}
+
+func expectEnvironment(mc *mocks.MockInterface, kind gen.EnvironmentSpecDataPlaneRefKind, name string) {
+	mc.EXPECT().GetEnvironment(mock.Anything, "acme", "dev").Return(&gen.Environment{
+		Spec: &gen.EnvironmentSpec{
+			DataPlaneRef: &struct {
+				Kind gen.EnvironmentSpecDataPlaneRefKind `json:"kind"`
+				Name string                              `json:"name"`
+			}{Kind: kind, Name: name},
+		},
+	}, nil)
+}
+
+const dashboardTemplate = "https://grafana.example.com/d/component?var-ns={namespace}&var-project={project}&var-component={component}&var-env={environment}"
+
+func TestOpen_Dashboard(t *testing.T) {
+	const want = "https://grafana.example.com/d/component?var-ns=acme&var-project=shop&var-component=orders&var-env=dev"
+
+	t.Run("data plane with an observability plane reference", func(t *testing.T) {
+		opened := stubBrowser(t, nil)
+		mc := mocks.NewMockInterface(t)
+		expectComponent(mc)
+		expectEnvironment(mc, gen.EnvironmentSpecDataPlaneRefKindDataPlane, "dp")
+		mc.EXPECT().GetDataPlane(mock.Anything, "acme", "dp").Return(&gen.DataPlane{
+			Spec: &gen.DataPlaneSpec{
+				ObservabilityPlaneRef: &gen.ObservabilityPlaneRef{
+					Kind: gen.ObservabilityPlaneRefKindClusterObservabilityPlane,
+					Name: "shared",
+				},
+			},
+		}, nil)
+		mc.EXPECT().GetClusterObservabilityPlane(mock.Anything, "shared").Return(&gen.ClusterObservabilityPlane{
+			Spec: &gen.ClusterObservabilityPlaneSpec{DashboardURL: ptr(dashboardTemplate)},
+		}, nil)
+
+		p := params()
+		p.Dashboard = true
+		require.NoError(t, New(mc).open(context.Background(), &bytes.Buffer{}, p))
+		assert.Equal(t, []string{want}, *opened)
+	})
+
+	t.Run("cluster data plane uses the default plane", func(t *testing.T) {
+		mc := mocks.NewMockInterface(t)
+		expectComponent(mc)
+		expectEnvironment(mc, gen.EnvironmentSpecDataPlaneRefKindClusterDataPlane, "cdp")
+		mc.EXPECT().GetClusterDataPlane(mock.Anything, "cdp").Return(&gen.ClusterDataPlane{}, nil)
+		mc.EXPECT().GetClusterObservabilityPlane(mock.Anything, "default").Return(&gen.ClusterObservabilityPlane{
+			Spec: &gen.ClusterObservabilityPlaneSpec{DashboardURL: ptr(dashboardTemplate)},
+		}, nil)
+
+		p := params()
+		p.Dashboard = true
+		p.NoBrowser = true
+		var out bytes.Buffer
+		require.NoError(t, New(mc).open(context.Background(), &out, p))
+		assert.Equal(t, want+"\n", out.String())
+	})
+
+	t.Run("observability plane without a dashboard URL", func(t *testing.T) {
+		mc := mocks.NewMockInterface(t)
+		expectComponent(mc)
+		expectEnvironment(mc, gen.EnvironmentSpecDataPlaneRefKindDataPlane, "dp")
+		mc.EXPECT().GetDataPlane(mock.Anything, "acme", "dp").Return(&gen.DataPlane{}, nil)
+		mc.EXPECT().GetObservabilityPlane(mock.Anything, "acme", "default").Return(&gen.ObservabilityPlane{
+			Spec: &gen.ObservabilityPlaneSpec{ObserverURL: ptr("http://observer:8080")},
+		}, nil)
+
+		p := params()
+		p.Dashboard = true
+		err := New(mc).open(context.Background(), &bytes.Buffer{}, p)
+		require.EqualError(t, err, "observability plane default has no dashboard URL: set spec.dashboardURL to open component dashboards")
+	})
+}
+
+func TestOpen_InvalidParams(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	p := params()
+	p.Resource = "project/shop"
+	require.EqualError(t, New(mc).open(context.Background(), &bytes.Buffer{}, p),
+		`open is not supported for "project": only component/NAME is supported`)
+
+	p = params()
+	p.Environment = ""
+	require.EqualError(t, New(mc).open(context.Background(), &bytes.Buffer{}, p), "environment is required: set --env")
+}
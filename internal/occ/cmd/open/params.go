@@ -0,0 +1,18 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package open
+
+// Params defines parameters for opening a component in the browser
+type Params struct {
+	// Resource is the resource as KIND/NAME, such as component/orders.
+	Resource    string
+	Namespace   string
+	Environment string
+	// Endpoint is the endpoint to open when the component exposes several.
+	Endpoint string
+	// Dashboard opens the observability dashboard instead of an endpoint.
+	Dashboard bool
+	// NoBrowser prints the URL instead of opening it.
+	NoBrowser bool
+}
@@ -0,0 +1,40 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func NewPluginCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plugin",
+		Short: "Manage occ plugins",
+		Long: `Plugins extend occ with new commands without changing the CLI.
+
+A plugin is an executable on PATH whose name starts with "` + Prefix + `". The
+executable ` + Prefix + `foo-bar adds the command "occ foo bar"; the arguments
+after the command name and the standard streams are passed to the plugin.
+Built-in commands take precedence over plugins of the same name, and of two
+plugins of the same name, the one earlier on PATH runs.`,
+	}
+	cmd.AddCommand(newListCmd())
+	return cmd
+}
+
+func newListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the plugins on PATH",
+		Long:  `List the plugin executables on PATH, with warnings for plugins that never run.`,
+		Example: `  # List the available plugins
+  occ plugin list`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return List(cmd.Root(), os.Stdout, os.Getenv("PATH"))
+		},
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPluginCmd(t *testing.T) {
+	cmd := NewPluginCmd()
+	assert.Equal(t, "plugin", cmd.Use)
+
+	list, _, err := cmd.Find([]string{"list"})
+	assert.NoError(t, err)
+	assert.Equal(t, "list", list.Name())
+}
@@ -0,0 +1,164 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// Prefix is the prefix of the executables that extend occ. The executable
+// occ-foo-bar on PATH adds the command "occ foo bar".
+const Prefix = "occ-"
+
+// Plugin is a plugin executable found on PATH.
+type Plugin struct {
+	// Name is the command the plugin adds, with words joined by dashes.
+	Name string
+	Path string
+	// ShadowedBy is the path of a plugin of the same name earlier on PATH,
+	// which runs instead of this one.
+	ShadowedBy string
+}
+
+// Handle runs the plugin named by args when they do not name a built-in
+// command, passing on the remaining arguments and the standard streams.
+// ran reports whether a plugin was found; err is the error of the plugin,
+// an *exec.ExitError when it exited with a non-zero status.
+func Handle(root *cobra.Command, args []string) (ran bool, err error) {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return false, nil
+	}
+	switch args[0] {
+	case "help", "completion", cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd:
+		return false, nil
+	}
+	if _, _, err := root.Find(args); err == nil {
+		return false, nil
+	}
+
+	path, rest, ok := find(args)
+	if !ok {
+		return false, nil
+	}
+	cmd := exec.Command(path, rest...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = os.Environ()
+	return true, cmd.Run()
+}
+
+// find returns the plugin for the leading words of args, trying the longest
+// name first: "occ foo bar" runs occ-foo-bar, or else occ-foo with the
+// argument bar.
+func find(args []string) (string, []string, bool) {
+	words := 0
+	for words < len(args) && !strings.HasPrefix(args[words], "-") {
+		words++
+	}
+	for i := words; i > 0; i-- {
+		path, err := exec.LookPath(Prefix + strings.Join(args[:i], "-"))
+		if err == nil {
+			return path, args[i:], true
+		}
+	}
+	return "", nil, false
+}
+
+// Discover returns the plugins in the directories of pathList, a list in the
+// format of the PATH environment variable, sorted by name. Plugins that are
+// shadowed by an earlier one of the same name are included with ShadowedBy set.
+func Discover(pathList string) []Plugin {
+	var plugins []Plugin
+	first := map[string]string{}
+	seenDirs := map[string]bool{}
+	for _, dir := range filepath.SplitList(pathList) {
+		if dir == "" || seenDirs[dir] {
+			continue
+		}
+		seenDirs[dir] = true
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			name, ok := pluginName(entry)
+			if !ok {
+				continue
+			}
+			p := Plugin{Name: name, Path: filepath.Join(dir, entry.Name())}
+			if earlier, ok := first[name]; ok {
+				p.ShadowedBy = earlier
+			} else {
+				first[name] = p.Path
+			}
+			plugins = append(plugins, p)
+		}
+	}
+	sort.SliceStable(plugins, func(i, j int) bool { return plugins[i].Name < plugins[j].Name })
+	return plugins
+}
+
+// pluginName returns the command name of a plugin executable.
+func pluginName(entry os.DirEntry) (string, bool) {
+	if entry.IsDir() || !strings.HasPrefix(entry.Name(), Prefix) {
+		return "", false
+	}
+	info, err := entry.Info()
+	if err != nil {
+		return "", false
+	}
+	name := strings.TrimPrefix(entry.Name(), Prefix)
+	if runtime.GOOS == "windows" {
+		ext := strings.ToLower(filepath.Ext(name))
+		if ext != ".exe" && ext != ".bat" && ext != ".cmd" {
+			return "", false
+		}
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	} else if info.Mode().Perm()&0o111 == 0 {
+		return "", false
+	}
+	return name, name != ""
+}
+
+// List prints the plugins on PATH, with warnings for the plugins that never
+// run because a built-in command or an earlier plugin takes their name.
+func List(root *cobra.Command, out io.Writer, pathList string) error {
+	plugins := Discover(pathList)
+	if len(plugins) == 0 {
+		return fmt.Errorf("no plugins found in PATH: plugins are executables named %sNAME", Prefix)
+	}
+
+	fmt.Fprintln(out, "The following plugins are available:")
+	fmt.Fprintln(out)
+	for _, p := range plugins {
+		fmt.Fprintln(out, p.Path)
+		if p.ShadowedBy != "" {
+			fmt.Fprintf(out, "  - warning: shadowed by %s\n", p.ShadowedBy)
+		}
+		if builtin := builtinCommand(root, p.Name); builtin != "" {
+			fmt.Fprintf(out, "  - warning: overshadowed by the built-in command %q\n", builtin)
+		}
+	}
+	return nil
+}
+
+// builtinCommand returns the path of the built-in command that runs instead
+// of the plugin name, or "".
+func builtinCommand(root *cobra.Command, name string) string {
+	cmd, _, err := root.Find(strings.Split(name, "-"))
+	if err != nil || cmd == root {
+		return ""
+	}
+	return cmd.CommandPath()
+}
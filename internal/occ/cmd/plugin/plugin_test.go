@@ -0,0 +1,107 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writePlugin(t *testing.T, dir, name, script string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+script+"\n"), 0o755)) //nolint:gosec // test plugins must be executable
+	return path
+}
+
+func testRoot() *cobra.Command {
+	root := &cobra.Command{Use: "occ"}
+	get := &cobra.Command{Use: "get", Run: func(*cobra.Command, []string) {}}
+	root.AddCommand(get)
+	return root
+}
+
+func TestHandle(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins in this test are shell scripts")
+	}
+	dir := t.TempDir()
+	out := filepath.Join(dir, "args")
+	writePlugin(t, dir, "occ-foo", `echo "foo $*" > `+out)
+	writePlugin(t, dir, "occ-foo-bar", `echo "foo-bar $*" > `+out+`; exit 3`)
+	writePlugin(t, dir, "occ-get", `echo get > `+out)
+	t.Setenv("PATH", dir)
+
+	root := testRoot()
+	read := func() string {
+		data, err := os.ReadFile(out)
+		require.NoError(t, err)
+		return strings.TrimSpace(string(data))
+	}
+
+	ran, err := Handle(root, []string{"foo", "baz", "--flag"})
+	assert.True(t, ran)
+	require.NoError(t, err)
+	assert.Equal(t, "foo baz --flag", read())
+
+	ran, err = Handle(root, []string{"foo", "bar", "x"})
+	assert.True(t, ran)
+	var exitErr *exec.ExitError
+	require.True(t, errors.As(err, &exitErr))
+	assert.Equal(t, 3, exitErr.ExitCode())
+	assert.Equal(t, "foo-bar x", read())
+
+	for _, args := range [][]string{{"get"}, {"unknown"}, {"--help"}, {cobra.ShellCompRequestCmd, "foo"}, nil} {
+		ran, err := Handle(root, args)
+		assert.False(t, ran, "args %v", args)
+		assert.NoError(t, err)
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins in this test are shell scripts")
+	}
+	first, second := t.TempDir(), t.TempDir()
+	writePlugin(t, first, "occ-foo", "")
+	shadowed := writePlugin(t, second, "occ-foo", "")
+	writePlugin(t, second, "occ-bar", "")
+	require.NoError(t, os.WriteFile(filepath.Join(second, "occ-notexec"), nil, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(second, "other"), nil, 0o755)) //nolint:gosec // not a plugin
+
+	plugins := Discover(strings.Join([]string{first, second, first}, string(os.PathListSeparator)))
+	require.Len(t, plugins, 3)
+	assert.Equal(t, Plugin{Name: "bar", Path: filepath.Join(second, "occ-bar")}, plugins[0])
+	assert.Equal(t, Plugin{Name: "foo", Path: filepath.Join(first, "occ-foo")}, plugins[1])
+	assert.Equal(t, Plugin{Name: "foo", Path: shadowed, ShadowedBy: filepath.Join(first, "occ-foo")}, plugins[2])
+}
+
+func TestList(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins in this test are shell scripts")
+	}
+	dir := t.TempDir()
+	writePlugin(t, dir, "occ-get-all", "")
+	writePlugin(t, dir, "occ-hello", "")
+
+	var out bytes.Buffer
+	require.NoError(t, List(testRoot(), &out, dir))
+	assert.Equal(t, "The following plugins are available:\n\n"+
+		filepath.Join(dir, "occ-get-all")+"\n"+
+		"  - warning: overshadowed by the built-in command \"occ get\"\n"+
+		filepath.Join(dir, "occ-hello")+"\n", out.String())
+
+	err := List(testRoot(), &out, t.TempDir())
+	assert.EqualError(t, err, "no plugins found in PATH: plugins are executables named occ-NAME")
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package portforward
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/internal/occ/auth"
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
+)
+
+func NewPortForwardCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "port-forward TYPE/NAME [LOCAL_PORT:]REMOTE_PORT [...[LOCAL_PORT_N:]REMOTE_PORT_N]",
+		Short: "Forward local ports to a component's running pod",
+		Long: `Forward one or more local ports to a component's running pod.
+
+Connections are tunnelled through the OpenChoreo API server to the data plane,
+so services that are not exposed outside the cluster can be reached without
+access to the data plane's kubeconfig. TYPE must be component (comp).
+
+If --env is not specified, the lowest environment of the deployment pipeline is
+used. If --pod is not specified, any ready pod of the component is used.`,
+		Example: `  # Listen on local port 8080 and forward to port 80 in the dev environment
+  occ port-forward component/orders 8080:80 --env dev
+
+  # Listen on ports 5000 and 6000 locally, forwarding to the same ports in the pod
+  occ port-forward component/orders 5000 6000 --env dev
+
+  # Let occ pick a free local port
+  occ port-forward component/orders :8080 --env dev
+
+  # Listen on all addresses and forward to a specific pod
+  occ port-forward component/orders 8080:80 --env dev --pod orders-7c9f-abcde --address 0.0.0.0`,
+		Args:    cobra.MinimumNArgs(2),
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pod, _ := cmd.Flags().GetString("pod")
+			address, _ := cmd.Flags().GetString("address")
+			return New().PortForward(Params{
+				Target:      args[0],
+				Ports:       args[1:],
+				Namespace:   flags.GetNamespace(cmd),
+				Project:     flags.GetProject(cmd),
+				Environment: flags.GetEnvironment(cmd),
+				Pod:         pod,
+				Address:     address,
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	flags.AddProject(cmd)
+	flags.AddEnvironment(cmd)
+	cmd.Flags().String("pod", "", "Pod name to forward to (defaults to any ready pod)")
+	cmd.Flags().String("address", "localhost", "Local address to listen on")
+	return cmd
+}
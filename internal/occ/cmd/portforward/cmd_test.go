@@ -0,0 +1,28 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package portforward
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPortForwardCmd_Structure(t *testing.T) {
+	cmd := NewPortForwardCmd()
+	assert.Equal(t, "port-forward", cmd.Name())
+	assert.NotEmpty(t, cmd.Short)
+	assert.NotNil(t, cmd.RunE)
+
+	for _, name := range []string{"namespace", "project", "env", "pod", "address"} {
+		assert.NotNil(t, cmd.Flags().Lookup(name), "missing flag %q", name)
+	}
+	assert.Equal(t, "localhost", cmd.Flags().Lookup("address").DefValue)
+}
+
+func TestNewPortForwardCmd_RequiresTargetAndPort(t *testing.T) {
+	cmd := NewPortForwardCmd()
+	assert.Error(t, cmd.Args(cmd, []string{"component/orders"}))
+	assert.NoError(t, cmd.Args(cmd, []string{"component/orders", "8080:80"}))
+}
@@ -0,0 +1,15 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package portforward
+
+// Params defines parameters for forwarding local ports to a component's pod
+type Params struct {
+	Target      string   // TYPE/NAME, e.g. component/orders
+	Ports       []string // [LOCAL_PORT:]REMOTE_PORT
+	Namespace   string
+	Project     string
+	Environment string
+	Pod         string
+	Address     string // local address to listen on
+}
@@ -0,0 +1,320 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package portforward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/openchoreo/openchoreo/internal/occ/auth"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
+	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+)
+
+// readBufferSize bounds the payload of a single WebSocket message sent to the pod.
+const readBufferSize = 32 * 1024
+
+// targetTypes lists the accepted TYPE prefixes; only components can be forwarded to
+var targetTypes = map[string]bool{
+	"component":  true,
+	"components": true,
+	"comp":       true,
+}
+
+// portMapping is a parsed [LOCAL_PORT:]REMOTE_PORT argument. A zero local port
+// lets the operating system pick a free one.
+type portMapping struct {
+	local  int
+	remote int
+}
+
+// PortForward implements the port-forward command
+type PortForward struct {
+	out    io.Writer
+	errOut io.Writer
+}
+
+// New creates a new port-forward implementation
+func New() *PortForward {
+	return &PortForward{out: os.Stdout, errOut: os.Stderr}
+}
+
+// PortForward listens on the local ports and forwards each accepted connection
+// to the component's pod through the API server until interrupted.
+func (p *PortForward) PortForward(params Params) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	return p.run(ctx, params)
+}
+
+func (p *PortForward) run(ctx context.Context, params Params) error {
+	name, err := parseTarget(params.Target)
+	if err != nil {
+		return err
+	}
+	if err := cmdutil.RequireFields("component", "port-forward", map[string]string{
+		"namespace": params.Namespace,
+	}); err != nil {
+		return err
+	}
+	if len(params.Ports) == 0 {
+		return fmt.Errorf("at least one port must be specified, e.g. 8080:80")
+	}
+	mappings := make([]portMapping, 0, len(params.Ports))
+	for _, spec := range params.Ports {
+		m, err := parsePortMapping(spec)
+		if err != nil {
+			return err
+		}
+		mappings = append(mappings, m)
+	}
+	address := params.Address
+	if address == "" {
+		address = "localhost"
+	}
+
+	listeners := make([]net.Listener, 0, len(mappings))
+	defer func() {
+		for _, l := range listeners {
+			_ = l.Close()
+		}
+	}()
+	for _, m := range mappings {
+		l, err := net.Listen("tcp", net.JoinHostPort(address, strconv.Itoa(m.local)))
+		if err != nil {
+			return fmt.Errorf("unable to listen on port %d: %w", m.local, err)
+		}
+		listeners = append(listeners, l)
+		fmt.Fprintf(p.out, "Forwarding from %s -> %d\n", l.Addr(), m.remote)
+	}
+
+	var wg sync.WaitGroup
+	for i, l := range listeners {
+		wg.Add(1)
+		go func(l net.Listener, remote int) {
+			defer wg.Done()
+			p.acceptConnections(ctx, l, params, name, remote)
+		}(l, mappings[i].remote)
+	}
+
+	<-ctx.Done()
+	for _, l := range listeners {
+		_ = l.Close()
+	}
+	wg.Wait()
+	return nil
+}
+
+// acceptConnections forwards every connection accepted on l until l is closed.
+func (p *PortForward) acceptConnections(ctx context.Context, l net.Listener, params Params, component string, remote int) {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		local, err := l.Accept()
+		if err != nil {
+			return
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer local.Close()
+			fmt.Fprintf(p.out, "Handling connection for %d\n", remote)
+			if err := forwardConnection(ctx, local, params, component, remote); err != nil {
+				fmt.Fprintf(p.errOut, "error forwarding port %d: %v\n", remote, err)
+			}
+		}()
+	}
+}
+
+// forwardConnection opens a port-forward WebSocket for a single local connection
+// and copies bytes in both directions until either side closes.
+func forwardConnection(ctx context.Context, local net.Conn, params Params, component string, remote int) error {
+	conn, err := dialPortForwardWebSocket(ctx, params, component, remote)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// Close the local connection when the command is interrupted so both pumps exit.
+	stop := context.AfterFunc(ctx, func() { _ = local.Close() })
+	defer stop()
+
+	errc := make(chan error, 2)
+
+	// local → pod
+	go func() {
+		buf := make([]byte, readBufferSize)
+		for {
+			n, readErr := local.Read(buf)
+			if n > 0 {
+				if err := conn.WriteMessage(websocket.BinaryMessage, buf[:n]); err != nil {
+					errc <- nil
+					return
+				}
+			}
+			if readErr != nil {
+				_ = conn.WriteMessage(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+				errc <- nil
+				return
+			}
+		}
+	}()
+
+	// pod → local
+	go func() {
+		for {
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				var ce *websocket.CloseError
+				if errors.As(err, &ce) && ce.Code != websocket.CloseNormalClosure && ce.Text != "" {
+					errc <- errors.New(ce.Text)
+					return
+				}
+				errc <- nil
+				return
+			}
+			if _, err := local.Write(msg); err != nil {
+				errc <- nil
+				return
+			}
+		}
+	}()
+
+	return <-errc
+}
+
+// dialPortForwardWebSocket establishes a WebSocket connection to the port-forward endpoint.
+func dialPortForwardWebSocket(ctx context.Context, params Params, component string, remote int) (*websocket.Conn, error) {
+	controlPlane, err := config.GetCurrentControlPlane()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get control plane: %w", err)
+	}
+
+	credential, err := config.GetCurrentCredential()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credential: %w", err)
+	}
+
+	wsURL, err := buildWebSocketURL(controlPlane.URL, params, component, remote)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build port-forward URL: %w", err)
+	}
+
+	headers := http.Header{}
+	if credential != nil && credential.Token != "" {
+		currentToken := credential.Token
+		if auth.IsTokenExpired(currentToken) {
+			newToken, refreshErr := auth.RefreshToken()
+			if refreshErr != nil {
+				return nil, fmt.Errorf("failed to refresh token: %w", refreshErr)
+			}
+			currentToken = newToken
+		}
+		headers.Set("Authorization", "Bearer "+currentToken)
+	}
+
+	conn, resp, err := websocket.DefaultDialer.DialContext(ctx, wsURL, headers)
+	if err != nil {
+		if resp != nil {
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+			resp.Body.Close()
+			if msg := strings.TrimSpace(string(body)); msg != "" {
+				return nil, errors.New(msg)
+			}
+			return nil, fmt.Errorf("port-forward connection failed (HTTP %d): %w", resp.StatusCode, err)
+		}
+		return nil, fmt.Errorf("failed to connect to port-forward endpoint: %w", err)
+	}
+	return conn, nil
+}
+
+// buildWebSocketURL constructs the API server URL for forwarding to a single pod port.
+func buildWebSocketURL(controlPlaneURL string, params Params, component string, remote int) (string, error) {
+	u, err := url.Parse(controlPlaneURL)
+	if err != nil {
+		return "", err
+	}
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	case "http":
+		u.Scheme = "ws"
+	}
+
+	u.Path = fmt.Sprintf("/portforward/namespaces/%s/components/%s", params.Namespace, component)
+
+	q := u.Query()
+	if params.Project != "" {
+		q.Set("project", params.Project)
+	}
+	if params.Environment != "" {
+		q.Set("env", params.Environment)
+	}
+	if params.Pod != "" {
+		q.Set("pod", params.Pod)
+	}
+	q.Set("port", strconv.Itoa(remote))
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// parseTarget returns the component name of a TYPE/NAME argument
+func parseTarget(target string) (string, error) {
+	typ, name, ok := strings.Cut(target, "/")
+	if !ok || typ == "" || name == "" {
+		return "", fmt.Errorf("invalid target %q: expected TYPE/NAME, e.g. component/orders", target)
+	}
+	if !targetTypes[strings.ToLower(typ)] {
+		return "", fmt.Errorf("unsupported target type %q: must be component", typ)
+	}
+	return name, nil
+}
+
+// parsePortMapping parses a [LOCAL_PORT:]REMOTE_PORT argument. "80" forwards the
+// same local port and ":80" lets the operating system pick the local port.
+func parsePortMapping(spec string) (portMapping, error) {
+	localSpec, remoteSpec, hasLocal := strings.Cut(spec, ":")
+	if !hasLocal {
+		localSpec, remoteSpec = spec, spec
+	}
+
+	remote, err := parsePort(remoteSpec)
+	if err != nil || remote == 0 {
+		return portMapping{}, fmt.Errorf("invalid port mapping %q: remote port must be between 1 and 65535", spec)
+	}
+	if localSpec == "" {
+		return portMapping{remote: remote}, nil
+	}
+	local, err := parsePort(localSpec)
+	if err != nil {
+		return portMapping{}, fmt.Errorf("invalid port mapping %q: local port must be between 0 and 65535", spec)
+	}
+	return portMapping{local: local, remote: remote}, nil
+}
+
+func parsePort(s string) (int, error) {
+	port, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+	if port < 0 || port > 65535 {
+		return 0, fmt.Errorf("port %d out of range", port)
+	}
+	return port, nil
+}
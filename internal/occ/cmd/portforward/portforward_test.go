@@ -0,0 +1,192 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package portforward
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+)
+
+func TestParsePortMapping(t *testing.T) {
+	tests := []struct {
+		spec    string
+		want    portMapping
+		wantErr bool
+	}{
+		{spec: "8080:80", want: portMapping{local: 8080, remote: 80}},
+		{spec: "80", want: portMapping{local: 80, remote: 80}},
+		{spec: ":80", want: portMapping{local: 0, remote: 80}},
+		{spec: "0:80", want: portMapping{local: 0, remote: 80}},
+		{spec: "8080:", wantErr: true},
+		{spec: "8080:0", wantErr: true},
+		{spec: "0", wantErr: true},
+		{spec: "http", wantErr: true},
+		{spec: "70000:80", wantErr: true},
+		{spec: "8080:70000", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			got, err := parsePortMapping(tt.spec)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseTarget(t *testing.T) {
+	for _, target := range []string{"component/orders", "comp/orders", "Components/orders"} {
+		name, err := parseTarget(target)
+		require.NoError(t, err, target)
+		assert.Equal(t, "orders", name)
+	}
+
+	_, err := parseTarget("orders")
+	assert.ErrorContains(t, err, "expected TYPE/NAME")
+	_, err = parseTarget("build/orders-build-1")
+	assert.ErrorContains(t, err, "must be component")
+}
+
+func TestBuildWebSocketURL(t *testing.T) {
+	got, err := buildWebSocketURL("https://api.openchoreo.test",
+		Params{Namespace: "acme", Project: "shop", Environment: "dev", Pod: "orders-abc"}, "orders", 80)
+	require.NoError(t, err)
+	assert.Equal(t,
+		"wss://api.openchoreo.test/portforward/namespaces/acme/components/orders?env=dev&pod=orders-abc&port=80&project=shop",
+		got)
+
+	got, err = buildWebSocketURL("http://localhost:8080", Params{Namespace: "default"}, "orders", 8080)
+	require.NoError(t, err)
+	assert.Equal(t, "ws://localhost:8080/portforward/namespaces/default/components/orders?port=8080", got)
+}
+
+func TestRun_Validation(t *testing.T) {
+	p := &PortForward{out: io.Discard, errOut: io.Discard}
+
+	err := p.run(context.Background(), Params{Target: "component/orders", Ports: []string{"8080:80"}})
+	assert.ErrorContains(t, err, "namespace")
+
+	err = p.run(context.Background(), Params{Target: "component/orders", Namespace: "default", Ports: []string{"abc"}})
+	assert.ErrorContains(t, err, "invalid port mapping")
+}
+
+// syncBuffer is a bytes.Buffer that is safe for the concurrent writes made by
+// the accept loops.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestRun_ForwardsConnection(t *testing.T) {
+	var gotQuery string
+	var queryMu sync.Mutex
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queryMu.Lock()
+		gotQuery = r.URL.Path + "?" + r.URL.RawQuery
+		queryMu.Unlock()
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		// Echo every message back, like a pod running an echo server.
+		for {
+			mt, msg, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if err := conn.WriteMessage(mt, append([]byte("echo:"), msg...)); err != nil {
+				return
+			}
+		}
+	}))
+	defer srv.Close()
+
+	home := testutil.SetupTestHome(t)
+	testutil.WriteOCConfig(t, home, &config.StoredConfig{
+		CurrentContext: "ctx",
+		ControlPlanes:  []config.ControlPlane{{Name: "cp", URL: srv.URL}},
+		Credentials:    []config.Credential{{Name: "cred"}},
+		Contexts:       []config.Context{{Name: "ctx", ControlPlane: "cp", Credentials: "cred"}},
+	})
+
+	out := &syncBuffer{}
+	p := &PortForward{out: out, errOut: out}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- p.run(ctx, Params{
+			Target:      "component/orders",
+			Ports:       []string{":80"},
+			Namespace:   "default",
+			Environment: "dev",
+			Address:     "127.0.0.1",
+		})
+	}()
+
+	var localAddr string
+	re := regexp.MustCompile(`Forwarding from (\S+) -> 80`)
+	require.Eventually(t, func() bool {
+		m := re.FindStringSubmatch(out.String())
+		if m == nil {
+			return false
+		}
+		localAddr = m[1]
+		return true
+	}, 2*time.Second, 10*time.Millisecond)
+
+	local, err := net.Dial("tcp", localAddr)
+	require.NoError(t, err)
+	_, err = local.Write([]byte("ping"))
+	require.NoError(t, err)
+	require.NoError(t, local.SetReadDeadline(time.Now().Add(3*time.Second)))
+	buf := make([]byte, len("echo:ping"))
+	_, err = io.ReadFull(local, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "echo:ping", string(buf))
+	require.NoError(t, local.Close())
+
+	queryMu.Lock()
+	assert.Equal(t, "/portforward/namespaces/default/components/orders?env=dev&port=80", gotQuery)
+	queryMu.Unlock()
+
+	cancel()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(3 * time.Second):
+		t.Fatal("port-forward did not stop after the context was cancelled")
+	}
+}
@@ -44,10 +44,12 @@ func newListCmd(f client.NewClientFunc) *cobra.Command {
 			}
 			return New(cl).List(ListParams{
 				Namespace: flags.GetNamespace(cmd),
+				Output:    flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
+	flags.AddListOutputFormat(cmd)
 	return cmd
 }
 
@@ -55,7 +57,7 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get [PROJECT_NAME]",
 		Short: "Get a project",
-		Long:  `Get a project and display its details in YAML format.`,
+		Long:  `Get a project and display its details in YAML, or in the format set by --output.`,
 		Example: `  # Get a project
   occ project get my-project --namespace acme-corp`,
 		Args:    cmdutil.ExactOneArgWithUsage(),
@@ -68,10 +70,12 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 			return New(cl).Get(GetParams{
 				Namespace:   flags.GetNamespace(cmd),
 				ProjectName: args[0],
+				Output:      flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
+	flags.AddGetOutputFormat(cmd)
 	return cmd
 }
 
@@ -7,12 +7,14 @@ package projectrelease
 type ListParams struct {
 	Namespace string
 	Project   string
+	Output    string // --output format
 }
 
 // GetParams defines parameters for getting a single project release
 type GetParams struct {
 	Namespace          string
 	ProjectReleaseName string
+	Output             string // --output format
 }
 
 // DeleteParams defines parameters for deleting a single project release
@@ -7,12 +7,14 @@ package projectreleasebinding
 type ListParams struct {
 	Namespace string
 	Project   string
+	Output    string // --output format
 }
 
 // GetParams defines parameters for getting a single project release binding
 type GetParams struct {
 	Namespace                 string
 	ProjectReleaseBindingName string
+	Output                    string // --output format
 }
 
 // DeleteParams defines parameters for deleting a single project release binding
@@ -6,12 +6,14 @@ package projecttype
 // ListParams defines parameters for listing project types
 type ListParams struct {
 	Namespace string
+	Output    string // --output format
 }
 
 // GetParams defines parameters for getting a single project type
 type GetParams struct {
 	Namespace       string
 	ProjectTypeName string
+	Output          string // --output format
 }
 
 // DeleteParams defines parameters for deleting a single project type
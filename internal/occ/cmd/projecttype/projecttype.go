@@ -10,11 +10,10 @@ import (
 	"strconv"
 	"text/tabwriter"
 
-	"sigs.k8s.io/yaml"
-
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
 	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/printer"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 )
@@ -56,10 +55,13 @@ func (pt *ProjectType) List(params ListParams) error {
 	if err != nil {
 		return err
 	}
+	if params.Output != "" {
+		return printer.PrintList(os.Stdout, items, params.Output)
+	}
 	return printList(items)
 }
 
-// Get retrieves a single project type and outputs it as YAML
+// Get retrieves a single project type and prints it in the requested output format, YAML by default
 func (pt *ProjectType) Get(params GetParams) error {
 	if err := cmdutil.RequireFields("get", "projecttype", map[string]string{"namespace": params.Namespace}); err != nil {
 		return err
@@ -72,13 +74,7 @@ func (pt *ProjectType) Get(params GetParams) error {
 		return err
 	}
 
-	data, err := yaml.Marshal(result)
-	if err != nil {
-		return fmt.Errorf("failed to marshal project type to YAML: %w", err)
-	}
-
-	fmt.Print(string(data))
-	return nil
+	return printer.Print(os.Stdout, result, params.Output)
 }
 
 // Delete deletes a single project type
@@ -115,12 +115,14 @@ func newListCmd(f client.NewClientFunc) *cobra.Command {
 				Namespace: flags.GetNamespace(cmd),
 				Project:   flags.GetProject(cmd),
 				Component: flags.GetComponent(cmd),
+				Output:    flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
 	flags.AddProject(cmd)
 	flags.AddComponent(cmd)
+	flags.AddListOutputFormat(cmd)
 	return cmd
 }
 
@@ -128,7 +130,7 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get [RELEASE_BINDING_NAME]",
 		Short: "Get a release binding",
-		Long:  `Get a release binding and display its details in YAML format.`,
+		Long:  `Get a release binding and display its details in YAML, or in the format set by --output.`,
 		Example: `  # Get a release binding
   occ releasebinding get my-binding --namespace acme-corp`,
 		Args:    cmdutil.ExactOneArgWithUsage(),
@@ -141,10 +143,12 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 			return New(cl).Get(GetParams{
 				Namespace:          flags.GetNamespace(cmd),
 				ReleaseBindingName: args[0],
+				Output:             flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
+	flags.AddGetOutputFormat(cmd)
 	return cmd
 }
 
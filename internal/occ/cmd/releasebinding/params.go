@@ -22,6 +22,7 @@ type ListParams struct {
 	Namespace string
 	Project   string
 	Component string
+	Output    string // --output format
 }
 
 func (p ListParams) GetNamespace() string { return p.Namespace }
@@ -32,6 +33,7 @@ func (p ListParams) GetComponent() string { return p.Component }
 type GetParams struct {
 	Namespace          string
 	ReleaseBindingName string
+	Output             string // --output format
 }
 
 func (p GetParams) GetNamespace() string { return p.Namespace }
@@ -24,6 +24,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/occ/fsmode/generator"
 	"github.com/openchoreo/openchoreo/internal/occ/fsmode/output"
 	"github.com/openchoreo/openchoreo/internal/occ/fsmode/pipeline"
+	"github.com/openchoreo/openchoreo/internal/occ/printer"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 	"github.com/openchoreo/openchoreo/pkg/fsindex/cache"
@@ -76,6 +77,9 @@ func (r *ReleaseBinding) List(params ListParams) error {
 		return err
 	}
 
+	if params.Output != "" {
+		return printer.PrintList(os.Stdout, items, params.Output)
+	}
 	return printReleaseBindings(items)
 }
 
@@ -200,7 +204,7 @@ func (r *ReleaseBinding) Generate(params GenerateParams) error {
 	return nil
 }
 
-// Get retrieves a single release binding and outputs it as YAML
+// Get retrieves a single release binding and prints it in the requested output format, YAML by default
 func (r *ReleaseBinding) Get(params GetParams) error {
 	if err := cmdutil.RequireFields("get", "releasebinding", map[string]string{"namespace": params.Namespace}); err != nil {
 		return err
@@ -213,13 +217,7 @@ func (r *ReleaseBinding) Get(params GetParams) error {
 		return err
 	}
 
-	data, err := yaml.Marshal(result)
-	if err != nil {
-		return fmt.Errorf("failed to marshal release binding to YAML: %w", err)
-	}
-
-	fmt.Print(string(data))
-	return nil
+	return printer.Print(os.Stdout, result, params.Output)
 }
 
 // Delete deletes a single release binding
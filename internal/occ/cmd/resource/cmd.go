@@ -47,11 +47,13 @@ func newListCmd(f client.NewClientFunc) *cobra.Command {
 			return New(cl).List(ListParams{
 				Namespace: flags.GetNamespace(cmd),
 				Project:   flags.GetProject(cmd),
+				Output:    flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
 	flags.AddProject(cmd)
+	flags.AddListOutputFormat(cmd)
 	return cmd
 }
 
@@ -59,7 +61,7 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get [RESOURCE_NAME]",
 		Short: "Get a resource",
-		Long:  `Get a resource and display its details in YAML format.`,
+		Long:  `Get a resource and display its details in YAML, or in the format set by --output.`,
 		Example: `  # Get a resource
   occ resource get analytics-db --namespace acme-corp`,
 		Args:    cmdutil.ExactOneArgWithUsage(),
@@ -72,10 +74,12 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 			return New(cl).Get(GetParams{
 				Namespace:    flags.GetNamespace(cmd),
 				ResourceName: args[0],
+				Output:       flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
+	flags.AddGetOutputFormat(cmd)
 	return cmd
 }
 
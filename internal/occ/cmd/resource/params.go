@@ -7,12 +7,14 @@ package resource
 type ListParams struct {
 	Namespace string
 	Project   string
+	Output    string // --output format
 }
 
 // GetParams defines parameters for getting a single resource
 type GetParams struct {
 	Namespace    string
 	ResourceName string
+	Output       string // --output format
 }
 
 // DeleteParams defines parameters for deleting a single resource
@@ -46,11 +46,13 @@ func newListCmd(f client.NewClientFunc) *cobra.Command {
 			return New(cl).List(ListParams{
 				Namespace: flags.GetNamespace(cmd),
 				Resource:  flags.GetResource(cmd),
+				Output:    flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
 	flags.AddResource(cmd)
+	flags.AddListOutputFormat(cmd)
 	return cmd
 }
 
@@ -58,7 +60,7 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get [RESOURCE_RELEASE_NAME]",
 		Short: "Get a resource release",
-		Long:  `Get a resource release and display its details in YAML format.`,
+		Long:  `Get a resource release and display its details in YAML, or in the format set by --output.`,
 		Example: `  # Get a resource release
   occ resourcerelease get analytics-db-abc123 --namespace acme-corp`,
 		Args:    cmdutil.ExactOneArgWithUsage(),
@@ -71,10 +73,12 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 			return New(cl).Get(GetParams{
 				Namespace:           flags.GetNamespace(cmd),
 				ResourceReleaseName: args[0],
+				Output:              flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
+	flags.AddGetOutputFormat(cmd)
 	return cmd
 }
 
@@ -7,12 +7,14 @@ package resourcerelease
 type ListParams struct {
 	Namespace string
 	Resource  string
+	Output    string // --output format
 }
 
 // GetParams defines parameters for getting a single resource release
 type GetParams struct {
 	Namespace           string
 	ResourceReleaseName string
+	Output              string // --output format
 }
 
 // DeleteParams defines parameters for deleting a single resource release
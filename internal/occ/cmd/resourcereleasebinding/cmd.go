@@ -46,11 +46,13 @@ func newListCmd(f client.NewClientFunc) *cobra.Command {
 			return New(cl).List(ListParams{
 				Namespace: flags.GetNamespace(cmd),
 				Resource:  flags.GetResource(cmd),
+				Output:    flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
 	flags.AddResource(cmd)
+	flags.AddListOutputFormat(cmd)
 	return cmd
 }
 
@@ -58,7 +60,7 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get [RESOURCE_RELEASE_BINDING_NAME]",
 		Short: "Get a resource release binding",
-		Long:  `Get a resource release binding and display its details in YAML format.`,
+		Long:  `Get a resource release binding and display its details in YAML, or in the format set by --output.`,
 		Example: `  # Get a resource release binding
   occ resourcereleasebinding get analytics-db-dev --namespace acme-corp`,
 		Args:    cmdutil.ExactOneArgWithUsage(),
@@ -71,10 +73,12 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 			return New(cl).Get(GetParams{
 				Namespace:                  flags.GetNamespace(cmd),
 				ResourceReleaseBindingName: args[0],
+				Output:                     flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
+	flags.AddGetOutputFormat(cmd)
 	return cmd
 }
 
@@ -7,12 +7,14 @@ package resourcereleasebinding
 type ListParams struct {
 	Namespace string
 	Resource  string
+	Output    string // --output format
 }
 
 // GetParams defines parameters for getting a single resource release binding
 type GetParams struct {
 	Namespace                  string
 	ResourceReleaseBindingName string
+	Output                     string // --output format
 }
 
 // DeleteParams defines parameters for deleting a single resource release binding
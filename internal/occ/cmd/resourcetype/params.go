@@ -6,12 +6,14 @@ package resourcetype
 // ListParams defines parameters for listing resource types
 type ListParams struct {
 	Namespace string
+	Output    string // --output format
 }
 
 // GetParams defines parameters for getting a single resource type
 type GetParams struct {
 	Namespace        string
 	ResourceTypeName string
+	Output           string // --output format
 }
 
 // DeleteParams defines parameters for deleting a single resource type
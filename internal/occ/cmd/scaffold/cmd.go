@@ -0,0 +1,77 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scaffold
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/internal/occ/auth"
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func NewScaffoldCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scaffold",
+		Short: "Scaffold the descriptor and build files of a component in a repository",
+		Long: `Write the files a repository needs to be imported as a component.
+
+The component descriptor .choreo/component.yaml is written with the default
+parameters of the component type, read from the cluster. Services get an HTTP
+endpoint defined by .choreo/openapi.yaml, and web applications an external HTTP
+endpoint. A sample Dockerfile is written for the language, along with a
+Procfile for buildpacks that need one.
+
+The component type defaults to the ClusterComponentType named after --type.
+Existing files are left untouched unless --force is set.`,
+		Example: `  # Scaffold a Go service in the current repository
+  occ scaffold --type service --lang go
+
+  # Scaffold a Node.js web application with a namespace-scoped component type
+  occ scaffold --type web-application --lang nodejs --componenttype web-app --namespace acme-corp
+
+  # Scaffold a Python scheduled task in another directory
+  occ scaffold --type scheduled-task --lang python --dir ./jobs/cleanup --name cleanup`,
+		Args:    cobra.NoArgs,
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dir, _ := cmd.Flags().GetString("dir")
+			name, _ := cmd.Flags().GetString("name")
+			typ, _ := cmd.Flags().GetString("type")
+			lang, _ := cmd.Flags().GetString("lang")
+			port, _ := cmd.Flags().GetInt32("port")
+			componentType, _ := cmd.Flags().GetString("componenttype")
+			clusterComponentType, _ := cmd.Flags().GetString("clustercomponenttype")
+			force, _ := cmd.Flags().GetBool("force")
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).Scaffold(Params{
+				Dir:                  dir,
+				Name:                 name,
+				Type:                 typ,
+				Lang:                 lang,
+				Port:                 port,
+				ComponentType:        componentType,
+				ClusterComponentType: clusterComponentType,
+				Namespace:            flags.GetNamespace(cmd),
+				Force:                force,
+			})
+		},
+	}
+	cmd.Flags().String("dir", ".", "Root directory of the repository")
+	cmd.Flags().String("name", "", "Component name (defaults to the name of the directory)")
+	cmd.Flags().String("type", "service", "Component type: service, web-application or scheduled-task")
+	cmd.Flags().String("lang", "", "Language of the sample build files: go, nodejs or python")
+	cmd.Flags().Int32("port", defaultPort, "Port of the endpoint of services and web applications")
+	cmd.Flags().String("componenttype", "", "Namespace-scoped component type to use")
+	cmd.Flags().String("clustercomponenttype", "", "Cluster-scoped component type to use (defaults to the one named after --type)")
+	cmd.Flags().Bool("force", false, "Overwrite existing files")
+	flags.AddNamespace(cmd)
+	_ = cmd.MarkFlagRequired("lang")
+	_ = cmd.RegisterFlagCompletionFunc("type", cobra.FixedCompletions(sortedKeys(types), cobra.ShellCompDirectiveNoFileComp))
+	_ = cmd.RegisterFlagCompletionFunc("lang", cobra.FixedCompletions(sortedKeys(languages), cobra.ShellCompDirectiveNoFileComp))
+	return cmd
+}
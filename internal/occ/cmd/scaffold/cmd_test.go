@@ -0,0 +1,23 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scaffold
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func TestNewScaffoldCmd(t *testing.T) {
+	cmd := NewScaffoldCmd(func() (client.Interface, error) { return nil, errors.New("factory failed") })
+	assert.Equal(t, "scaffold", cmd.Use)
+	for _, name := range []string{"dir", "name", "type", "lang", "port", "componenttype", "clustercomponenttype", "force", "namespace"} {
+		assert.NotNil(t, cmd.Flags().Lookup(name), "missing flag %s", name)
+	}
+	assert.Equal(t, "service", cmd.Flags().Lookup("type").DefValue)
+	assert.EqualError(t, cmd.RunE(cmd, nil), "factory failed")
+}
@@ -0,0 +1,19 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scaffold
+
+// Params defines parameters for scaffolding the descriptors of a component in a repository
+type Params struct {
+	Dir                  string // repository directory to write to
+	Name                 string // component name, defaults to the name of Dir
+	Type                 string // service, web-application or scheduled-task
+	Lang                 string // language of the sample Dockerfile and Procfile
+	Port                 int32
+	ComponentType        string // namespace-scoped component class
+	ClusterComponentType string // cluster-scoped component class, defaults to the class named after Type
+	Namespace            string
+	Force                bool // overwrite existing files
+}
+
+func (p Params) GetNamespace() string { return p.Namespace }
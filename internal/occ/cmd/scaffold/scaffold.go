@@ -0,0 +1,284 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scaffold
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	synth "github.com/openchoreo/openchoreo/internal/occ/resources/workload"
+	componentscaffold "github.com/openchoreo/openchoreo/internal/scaffold/component"
+)
+
+const (
+	// descriptorPath is where component import and repository discovery look
+	// for the descriptor of a component first.
+	descriptorPath = ".choreo/component.yaml"
+	// openAPIPath is the endpoint definition of services, next to the
+	// descriptor that references it.
+	openAPIPath = ".choreo/openapi.yaml"
+
+	defaultPort int32 = 8080
+)
+
+// types maps the values of --type to the component type they scaffold. The
+// default class of each is the ClusterComponentType of the same name.
+var types = map[string]openchoreov1alpha1.WorkloadType{
+	"service":         openchoreov1alpha1.WorkloadTypeService,
+	"web-application": openchoreov1alpha1.WorkloadTypeWebApplication,
+	"scheduled-task":  openchoreov1alpha1.WorkloadTypeScheduledTask,
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+type Scaffolder struct {
+	client client.Interface
+}
+
+func New(c client.Interface) *Scaffolder {
+	return &Scaffolder{client: c}
+}
+
+// descriptor is the component descriptor: the component fields followed by
+// the workload descriptor sections.
+type descriptor struct {
+	Metadata struct {
+		Name string `yaml:"name"`
+	} `yaml:"metadata"`
+	Type           openchoreov1alpha1.WorkloadType       `yaml:"type"`
+	ComponentType  string                                `yaml:"componentType"`
+	Parameters     map[string]any                        `yaml:"parameters,omitempty"`
+	Endpoints      []synth.WorkloadDescriptorEndpoint    `yaml:"endpoints,omitempty"`
+	Configurations synth.WorkloadDescriptorConfiguration `yaml:"configurations,omitempty"`
+}
+
+// file is a file written to the repository, at a slash-separated path
+// relative to its root.
+type file struct {
+	path    string
+	content string
+}
+
+// Scaffold writes the component descriptor, endpoint definition and sample
+// build files of a component to a repository.
+func (s *Scaffolder) Scaffold(params Params) error {
+	return s.scaffold(os.Stdout, params)
+}
+
+func (s *Scaffolder) scaffold(out io.Writer, params Params) error {
+	if err := validate(&params); err != nil {
+		return err
+	}
+
+	files, err := s.files(params)
+	if err != nil {
+		return err
+	}
+	if !params.Force {
+		var existing []string
+		for _, f := range files {
+			if _, err := os.Stat(filepath.Join(params.Dir, filepath.FromSlash(f.path))); err == nil {
+				existing = append(existing, f.path)
+			}
+		}
+		if len(existing) > 0 {
+			return fmt.Errorf("files already exist in %s: %s; use --force to overwrite", params.Dir, strings.Join(existing, ", "))
+		}
+	}
+
+	for _, f := range files {
+		target := filepath.Join(params.Dir, filepath.FromSlash(f.path))
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return fmt.Errorf("failed to create directory for %s: %w", f.path, err)
+		}
+		if err := os.WriteFile(target, []byte(f.content), 0o644); err != nil { //nolint:gosec // scaffolded files are meant to be committed
+			return fmt.Errorf("failed to write %s: %w", f.path, err)
+		}
+		fmt.Fprintf(out, "Wrote %s\n", f.path)
+	}
+	return nil
+}
+
+// validate checks params and fills in the defaults of optional fields.
+func validate(params *Params) error {
+	if params.Type == "" {
+		params.Type = "service"
+	}
+	if _, ok := types[params.Type]; !ok {
+		return fmt.Errorf("unsupported type %q: must be one of %s", params.Type, strings.Join(sortedKeys(types), ", "))
+	}
+	if _, ok := languages[params.Lang]; !ok {
+		return fmt.Errorf("unsupported language %q: must be one of %s", params.Lang, strings.Join(sortedKeys(languages), ", "))
+	}
+	if params.ComponentType != "" && params.ClusterComponentType != "" {
+		return fmt.Errorf("--componenttype and --clustercomponenttype are mutually exclusive")
+	}
+	if params.ComponentType != "" && params.Namespace == "" {
+		return fmt.Errorf("namespace is required with --componenttype (--namespace or set via context)")
+	}
+	if params.Port < 0 || params.Port > 65535 {
+		return fmt.Errorf("invalid port %d", params.Port)
+	}
+	if params.Port == 0 {
+		params.Port = defaultPort
+	}
+	if params.Dir == "" {
+		params.Dir = "."
+	}
+	if params.Name == "" {
+		abs, err := filepath.Abs(params.Dir)
+		if err != nil {
+			return fmt.Errorf("failed to resolve %s: %w", params.Dir, err)
+		}
+		params.Name = strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(filepath.Base(abs)), "-"), "-")
+		if params.Name == "" {
+			return fmt.Errorf("cannot derive a component name from %s; set --name", abs)
+		}
+	}
+	return nil
+}
+
+// files returns the files scaffolded for params.
+func (s *Scaffolder) files(params Params) ([]file, error) {
+	workloadType := types[params.Type]
+	class := params.ComponentType
+	if class == "" {
+		class = params.ClusterComponentType
+	}
+	if class == "" {
+		class = params.Type
+	}
+	// Classes are referenced as workloadType/name elsewhere; the descriptor takes the name.
+	if _, name, found := strings.Cut(class, "/"); found {
+		class = name
+	}
+
+	parameters, err := s.classDefaults(params, class)
+	if err != nil {
+		return nil, err
+	}
+
+	d := descriptor{Type: workloadType, ComponentType: class, Parameters: parameters}
+	d.Metadata.Name = params.Name
+	port := fmt.Sprint(params.Port)
+	var files []file
+	switch workloadType {
+	case openchoreov1alpha1.WorkloadTypeService:
+		d.Endpoints = []synth.WorkloadDescriptorEndpoint{{
+			Name: "http", Port: params.Port, Type: string(openchoreov1alpha1.EndpointTypeHTTP),
+			SchemaFile: filepath.Base(openAPIPath),
+		}}
+		files = append(files, file{openAPIPath, strings.ReplaceAll(openAPITemplate, "{{NAME}}", params.Name)})
+	case openchoreov1alpha1.WorkloadTypeWebApplication:
+		d.Endpoints = []synth.WorkloadDescriptorEndpoint{{
+			Name: "http", Port: params.Port, Type: string(openchoreov1alpha1.EndpointTypeHTTP),
+			Visibility: []string{string(openchoreov1alpha1.EndpointVisibilityExternal)},
+		}}
+	}
+	if len(d.Endpoints) > 0 {
+		d.Configurations.Env = []synth.WorkloadDescriptorEnvVar{{Name: "PORT", Value: port}}
+	}
+
+	content, err := marshalDescriptor(d)
+	if err != nil {
+		return nil, err
+	}
+	files = append([]file{{descriptorPath, content}}, files...)
+
+	lang := languages[params.Lang]
+	files = append(files, file{"Dockerfile", strings.ReplaceAll(lang.Dockerfile, "{{PORT}}", port)})
+	if lang.Procfile != "" {
+		files = append(files, file{"Procfile", lang.Procfile})
+	}
+	return files, nil
+}
+
+// classDefaults returns the default parameters of a component class, as
+// given by its parameter schema.
+func (s *Scaffolder) classDefaults(params Params, class string) (map[string]any, error) {
+	ctx := context.Background()
+	var raw *json.RawMessage
+	var err error
+	if params.ComponentType != "" {
+		raw, err = s.client.GetComponentTypeSchema(ctx, params.Namespace, class)
+	} else {
+		raw, err = s.client.GetClusterComponentTypeSchema(ctx, class)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var schema extv1.JSONSchemaProps
+	if err := json.Unmarshal(*raw, &schema); err != nil {
+		return nil, fmt.Errorf("invalid schema of component type %q: %w", class, err)
+	}
+	_, defaults, err := componentscaffold.ApplyDefaultsToSchema(&schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply the defaults of component type %q: %w", class, err)
+	}
+	pruned, _ := prune(defaults).(map[string]any)
+	return pruned, nil
+}
+
+// prune drops the empty objects and arrays that defaulting leaves for fields
+// without defaults. It returns nil for values that are empty after pruning.
+func prune(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, item := range v {
+			if p := prune(item); p != nil {
+				out[k] = p
+			}
+		}
+		if len(out) == 0 {
+			return nil
+		}
+		return out
+	case []any:
+		if len(v) == 0 {
+			return nil
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func marshalDescriptor(d descriptor) (string, error) {
+	var buf bytes.Buffer
+	buf.WriteString("# Component descriptor, read when the repository is imported as a component.\n")
+	buf.WriteString("# Parameters default to those of the component type; edit them as needed.\n")
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(d); err != nil {
+		return "", fmt.Errorf("failed to marshal component descriptor: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return "", fmt.Errorf("failed to marshal component descriptor: %w", err)
+	}
+	return buf.String(), nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,153 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scaffold
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	synth "github.com/openchoreo/openchoreo/internal/occ/resources/workload"
+)
+
+const classSchema = `{
+  "type": "object",
+  "properties": {
+    "replicas": {"type": "integer", "default": 1},
+    "exposed": {"type": "boolean", "default": true},
+    "resources": {"type": "object", "properties": {"cpu": {"type": "string"}}}
+  }
+}`
+
+func rawSchema(s string) *json.RawMessage {
+	raw := json.RawMessage(s)
+	return &raw
+}
+
+func readFile(t *testing.T, dir, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(path)))
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestScaffold_Service(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "Orders_API")
+	require.NoError(t, os.Mkdir(dir, 0o755))
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetClusterComponentTypeSchema(mock.Anything, "service").Return(rawSchema(classSchema), nil)
+
+	var out bytes.Buffer
+	require.NoError(t, New(mc).scaffold(&out, Params{Dir: dir, Lang: "go"}))
+	assert.Equal(t, "Wrote .choreo/component.yaml\nWrote .choreo/openapi.yaml\nWrote Dockerfile\n", out.String())
+
+	assert.Equal(t, `# Component descriptor, read when the repository is imported as a component.
+# Parameters default to those of the component type; edit them as needed.
+metadata:
+  name: orders-api
+type: Service
+componentType: service
+parameters:
+  exposed: true
+  replicas: 1
+endpoints:
+  - name: http
+    port: 8080
+    type: HTTP
+    schemaFile: openapi.yaml
+configurations:
+  env:
+    - name: PORT
+      value: "8080"
+`, readFile(t, dir, descriptorPath))
+	assert.Contains(t, readFile(t, dir, openAPIPath), "title: orders-api")
+	assert.Contains(t, readFile(t, dir, "Dockerfile"), "EXPOSE 8080")
+	assert.NoFileExists(t, filepath.Join(dir, "Procfile"))
+
+	// The descriptor converts to a workload the way component import reads it.
+	data := []byte(readFile(t, dir, descriptorPath))
+	workload, err := synth.ConvertWorkloadDescriptor(data, descriptorPath, synth.CreateWorkloadParams{
+		NamespaceName: "acme", ProjectName: "shop", ComponentName: "orders-api", ImageURL: "orders:v1",
+	}, func(p string) ([]byte, error) { return os.ReadFile(filepath.Join(dir, p)) })
+	require.NoError(t, err)
+	require.Contains(t, workload.Spec.Endpoints, "http")
+	assert.NotNil(t, workload.Spec.Endpoints["http"].Schema)
+}
+
+func TestScaffold_WebApplicationWithComponentType(t *testing.T) {
+	dir := t.TempDir()
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetComponentTypeSchema(mock.Anything, "acme", "web-app").Return(rawSchema(`{"type":"object"}`), nil)
+
+	var out bytes.Buffer
+	require.NoError(t, New(mc).scaffold(&out, Params{
+		Dir: dir, Name: "storefront", Type: "web-application", Lang: "nodejs", Port: 3000,
+		ComponentType: "deployment/web-app", Namespace: "acme",
+	}))
+
+	descriptor := readFile(t, dir, descriptorPath)
+	assert.Contains(t, descriptor, "componentType: web-app\n")
+	assert.NotContains(t, descriptor, "parameters:")
+	assert.Contains(t, descriptor, "visibility:\n      - external\n")
+	assert.NoFileExists(t, filepath.Join(dir, openAPIPath))
+	assert.Contains(t, readFile(t, dir, "Dockerfile"), "EXPOSE 3000")
+	assert.Equal(t, "web: npm start\n", readFile(t, dir, "Procfile"))
+}
+
+func TestScaffold_ScheduledTask(t *testing.T) {
+	dir := t.TempDir()
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetClusterComponentTypeSchema(mock.Anything, "scheduled-task").Return(rawSchema(`{"type":"object"}`), nil)
+
+	require.NoError(t, New(mc).scaffold(&bytes.Buffer{}, Params{Dir: dir, Name: "cleanup", Type: "scheduled-task", Lang: "python"}))
+	descriptor := readFile(t, dir, descriptorPath)
+	assert.NotContains(t, descriptor, "endpoints:")
+	assert.NotContains(t, descriptor, "configurations:")
+	assert.Equal(t, "web: python main.py\n", readFile(t, dir, "Procfile"))
+}
+
+func TestScaffold_ExistingFiles(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Dockerfile"), []byte("FROM scratch\n"), 0o600))
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetClusterComponentTypeSchema(mock.Anything, "service").Return(rawSchema(`{"type":"object"}`), nil)
+
+	err := New(mc).scaffold(&bytes.Buffer{}, Params{Dir: dir, Name: "orders", Lang: "go"})
+	assert.EqualError(t, err, "files already exist in "+dir+": Dockerfile; use --force to overwrite")
+	assert.Equal(t, "FROM scratch\n", readFile(t, dir, "Dockerfile"))
+	assert.NoFileExists(t, filepath.Join(dir, descriptorPath))
+
+	require.NoError(t, New(mc).scaffold(&bytes.Buffer{}, Params{Dir: dir, Name: "orders", Lang: "go", Force: true}))
+	assert.Contains(t, readFile(t, dir, "Dockerfile"), "golang")
+}
+
+func TestScaffold_InvalidParams(t *testing.T) {
+	s := New(mocks.NewMockInterface(t))
+	tests := []struct {
+		name   string
+		params Params
+		want   string
+	}{
+		{"unknown type", Params{Type: "function", Lang: "go"},
+			`unsupported type "function": must be one of scheduled-task, service, web-application`},
+		{"unknown language", Params{Lang: "cobol"}, `unsupported language "cobol": must be one of go, nodejs, python`},
+		{"both classes", Params{Lang: "go", ComponentType: "a", ClusterComponentType: "b"},
+			"--componenttype and --clustercomponenttype are mutually exclusive"},
+		{"namespaced class without namespace", Params{Lang: "go", ComponentType: "a"},
+			"namespace is required with --componenttype (--namespace or set via context)"},
+		{"invalid port", Params{Lang: "go", Port: 70000}, "invalid port 70000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualError(t, s.scaffold(&bytes.Buffer{}, tt.params), tt.want)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scaffold
+
+// language holds the sample build files of a language. Procfile is empty for
+// languages whose buildpacks find the entrypoint without one.
+type language struct {
+	Dockerfile string
+	Procfile   string
+}
+
+// languages are the languages sample build files can be written for, by the
+// value of --lang. {{PORT}} is replaced with the endpoint port.
+var languages = map[string]language{
+	"go": {
+		Dockerfile: `FROM golang:1.24-alpine AS build
+WORKDIR /src
+COPY go.* ./
+RUN go mod download
+COPY . .
+RUN CGO_ENABLED=0 go build -o /out/app .
+
+FROM gcr.io/distroless/static-debian12:nonroot
+COPY --from=build /out/app /app
+ENV PORT={{PORT}}
+EXPOSE {{PORT}}
+ENTRYPOINT ["/app"]
+`,
+	},
+	"nodejs": {
+		Dockerfile: `FROM node:22-alpine
+WORKDIR /app
+COPY package*.json ./
+RUN npm ci --omit=dev
+COPY . .
+ENV PORT={{PORT}}
+EXPOSE {{PORT}}
+USER node
+CMD ["npm", "start"]
+`,
+		Procfile: "web: npm start\n",
+	},
+	"python": {
+		Dockerfile: `FROM python:3.13-slim
+WORKDIR /app
+COPY requirements.txt ./
+RUN pip install --no-cache-dir -r requirements.txt
+COPY . .
+ENV PORT={{PORT}}
+EXPOSE {{PORT}}
+USER nobody
+CMD ["python", "main.py"]
+`,
+		Procfile: "web: python main.py\n",
+	},
+}
+
+// openAPITemplate is the endpoint definition written for services. {{NAME}}
+// is replaced with the component name.
+const openAPITemplate = `openapi: 3.0.3
+info:
+  title: {{NAME}}
+  version: 0.1.0
+paths:
+  /healthz:
+    get:
+      summary: Report whether the service is healthy
+      responses:
+        '200':
+          description: The service is healthy
+`
@@ -0,0 +1,54 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/internal/occ/auth"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func NewSchemaCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Manage the locally cached schemas of resource kinds",
+		Long: `Manage the schemas of OpenChoreo kinds cached on this machine. The cached
+schemas are used by occ validate to check manifests without a connection to
+the control plane.`,
+	}
+	cmd.AddCommand(newPullCmd(f))
+	return cmd
+}
+
+func newPullCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pull",
+		Short: "Cache the schemas of the resource kinds served by the control plane",
+		Long: `Download the OpenAPI schema of every OpenChoreo kind from the control plane
+and cache it locally, replacing the schemas cached before. Run it again after
+upgrading the control plane.
+
+Schemas are cached in ~/.openchoreo/schemas unless --dir is set. A directory
+can be committed to a repository and passed to occ validate --schema-dir, so
+CI validates against the schemas of a known control plane.`,
+		Example: `  # Cache the schemas in the default directory
+  occ schema pull
+
+  # Cache the schemas in a directory of the repository
+  occ schema pull --dir ./.choreo/schemas`,
+		Args:    cobra.NoArgs,
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dir, _ := cmd.Flags().GetString("dir")
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).Pull(PullParams{Dir: dir})
+		},
+	}
+	cmd.Flags().String("dir", "", "Directory to cache the schemas in (defaults to ~/.openchoreo/schemas)")
+	return cmd
+}
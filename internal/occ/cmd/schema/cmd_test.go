@@ -0,0 +1,26 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func TestNewSchemaCmd(t *testing.T) {
+	cmd := NewSchemaCmd(func() (client.Interface, error) { return nil, fmt.Errorf("unused") })
+
+	assert.Equal(t, "schema", cmd.Name())
+	pull, _, err := cmd.Find([]string{"pull"})
+	require.NoError(t, err)
+	assert.Equal(t, "pull", pull.Name())
+	assert.NotNil(t, pull.PreRunE)
+	assert.NotNil(t, pull.Flags().Lookup("dir"))
+	assert.Error(t, pull.Args(pull, []string{"extra"}))
+}
@@ -0,0 +1,9 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+// PullParams defines parameters for pulling the schemas of the resource kinds
+type PullParams struct {
+	Dir string // cache directory, defaults to DefaultDir
+}
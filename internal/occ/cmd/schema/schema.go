@@ -0,0 +1,84 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+type Schema struct {
+	client client.Interface
+}
+
+func New(c client.Interface) *Schema {
+	return &Schema{client: c}
+}
+
+// Pull caches the schemas of the resource kinds served by the API, replacing
+// those cached before.
+func (s *Schema) Pull(params PullParams) error {
+	return s.pull(os.Stdout, params)
+}
+
+func (s *Schema) pull(out io.Writer, params PullParams) error {
+	dir := params.Dir
+	if dir == "" {
+		var err error
+		if dir, err = DefaultDir(); err != nil {
+			return fmt.Errorf("failed to resolve the schema directory: %w", err)
+		}
+	}
+
+	schemas, err := s.client.ListKindSchemas(context.Background())
+	if err != nil {
+		return err
+	}
+	if len(schemas) == 0 {
+		return fmt.Errorf("the control plane returned no schemas")
+	}
+
+	written := map[string]bool{}
+	for _, ks := range schemas {
+		rel := schemaFile(schema.GroupVersionKind{Group: ks.Group, Version: ks.Version, Kind: ks.Kind})
+		data, err := json.MarshalIndent(ks, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal the schema of %s: %w", ks.Kind, err)
+		}
+		target := filepath.Join(dir, rel)
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return fmt.Errorf("failed to create directory for %s: %w", rel, err)
+		}
+		if err := os.WriteFile(target, append(data, '\n'), 0o644); err != nil { //nolint:gosec // schemas are public API metadata
+			return fmt.Errorf("failed to write %s: %w", rel, err)
+		}
+		written[target] = true
+	}
+
+	// Drop the schemas of kinds the server no longer serves.
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".json" || written[path] {
+			return nil
+		}
+		return os.Remove(path)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove stale schemas from %s: %w", dir, err)
+	}
+
+	fmt.Fprintf(out, "Pulled %d schemas to %s\n", len(schemas), dir)
+	return nil
+}
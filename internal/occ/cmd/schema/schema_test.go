@@ -0,0 +1,110 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+var widgetGVK = schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "Widget"}
+
+func kindSchemas() []gen.KindSchema {
+	return []gen.KindSchema{{
+		Kind: "Widget", Group: "openchoreo.dev", Version: "v1alpha1",
+		Schema: map[string]interface{}{
+			"type":       "object",
+			"properties": map[string]interface{}{"spec": map[string]interface{}{"type": "object"}},
+		},
+	}}
+}
+
+func TestPull(t *testing.T) {
+	dir := t.TempDir()
+	stale := filepath.Join(dir, "openchoreo.dev", "gadget_v1alpha1.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(stale), 0o755))
+	require.NoError(t, os.WriteFile(stale, []byte("{}"), 0o600))
+
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().ListKindSchemas(mock.Anything).Return(kindSchemas(), nil)
+
+	var out bytes.Buffer
+	require.NoError(t, New(mc).pull(&out, PullParams{Dir: dir}))
+	assert.Equal(t, "Pulled 1 schemas to "+dir+"\n", out.String())
+	assert.FileExists(t, filepath.Join(dir, "openchoreo.dev", "widget_v1alpha1.json"))
+	assert.NoFileExists(t, stale)
+
+	set, err := Load(dir)
+	require.NoError(t, err)
+	require.Contains(t, set, widgetGVK)
+	assert.Equal(t, "object", set[widgetGVK].Properties["spec"].Type)
+}
+
+func TestPull_DefaultDir(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().ListKindSchemas(mock.Anything).Return(kindSchemas(), nil)
+
+	require.NoError(t, New(mc).pull(&bytes.Buffer{}, PullParams{}))
+	assert.FileExists(t, filepath.Join(home, ".openchoreo", "schemas", "openchoreo.dev", "widget_v1alpha1.json"))
+}
+
+func TestPull_Errors(t *testing.T) {
+	t.Run("api error", func(t *testing.T) {
+		mc := mocks.NewMockInterface(t)
+		mc.EXPECT().ListKindSchemas(mock.Anything).Return(nil, errors.New("unauthorized"))
+
+		err := New(mc).pull(&bytes.Buffer{}, PullParams{Dir: t.TempDir()})
+		require.EqualError(t, err, "unauthorized")
+	})
+
+	t.Run("no schemas", func(t *testing.T) {
+		mc := mocks.NewMockInterface(t)
+		mc.EXPECT().ListKindSchemas(mock.Anything).Return([]gen.KindSchema{}, nil)
+
+		err := New(mc).pull(&bytes.Buffer{}, PullParams{Dir: t.TempDir()})
+		require.EqualError(t, err, "the control plane returned no schemas")
+	})
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("missing directory", func(t *testing.T) {
+		set, err := Load(filepath.Join(t.TempDir(), "schemas"))
+		require.NoError(t, err)
+		assert.Empty(t, set)
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "widget.json"), []byte(`{"kind": "Widget"}`), 0o600))
+
+		_, err := Load(dir)
+		require.ErrorContains(t, err, "kind, version and schema are required")
+	})
+}
+
+func TestEmbedded(t *testing.T) {
+	set, err := Embedded()
+	require.NoError(t, err)
+
+	component := set[schema.GroupVersionKind{Group: "openchoreo.dev", Version: "v1alpha1", Kind: "Component"}]
+	require.NotNil(t, component)
+	assert.Contains(t, component.Properties, "spec")
+	assert.True(t, set.HasGroup("openchoreo.dev"))
+	assert.False(t, set.HasGroup("apps"))
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	crdefs "github.com/openchoreo/openchoreo/config/crd"
+)
+
+// Set holds the OpenAPI schemas of resource kinds by group, version and kind.
+type Set map[schema.GroupVersionKind]*extv1.JSONSchemaProps
+
+// HasGroup reports whether the set holds a schema of any kind of group.
+func (s Set) HasGroup(group string) bool {
+	for gvk := range s {
+		if gvk.Group == group {
+			return true
+		}
+	}
+	return false
+}
+
+// cachedSchema is the content of a schema file in the cache, matching the
+// kind schemas served by the API.
+type cachedSchema struct {
+	Kind    string                 `json:"kind"`
+	Group   string                 `json:"group"`
+	Version string                 `json:"version"`
+	Schema  *extv1.JSONSchemaProps `json:"schema"`
+}
+
+// DefaultDir returns the directory schemas are cached in, ~/.openchoreo/schemas.
+func DefaultDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, ".openchoreo", "schemas"), nil
+}
+
+// schemaFile returns the path of the cached schema of a kind, relative to the
+// cache directory.
+func schemaFile(gvk schema.GroupVersionKind) string {
+	return filepath.Join(gvk.Group, strings.ToLower(gvk.Kind)+"_"+gvk.Version+".json")
+}
+
+// Load reads the schemas cached in dir. It returns an empty set when dir does
+// not exist.
+func Load(dir string) (Set, error) {
+	set := Set{}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) && path == dir {
+				return fs.SkipAll
+			}
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".json" {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		var cached cachedSchema
+		if err := json.Unmarshal(data, &cached); err != nil {
+			return fmt.Errorf("invalid schema file %s: %w", path, err)
+		}
+		if cached.Kind == "" || cached.Version == "" || cached.Schema == nil {
+			return fmt.Errorf("invalid schema file %s: kind, version and schema are required", path)
+		}
+		set[schema.GroupVersionKind{Group: cached.Group, Version: cached.Version, Kind: cached.Kind}] = cached.Schema
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load schemas from %s: %w", dir, err)
+	}
+	return set, nil
+}
+
+// Embedded returns the schemas of the CRDs built into occ, for validating
+// without a cache. They match the version of occ rather than the server.
+func Embedded() (Set, error) {
+	paths, err := fs.Glob(crdefs.FS, "bases/*.yaml")
+	if err != nil {
+		return nil, err
+	}
+
+	set := Set{}
+	for _, path := range paths {
+		data, err := fs.ReadFile(crdefs.FS, path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		var crd extv1.CustomResourceDefinition
+		if err := yaml.Unmarshal(data, &crd); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		for _, version := range crd.Spec.Versions {
+			if !version.Served || version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+				continue
+			}
+			gvk := schema.GroupVersionKind{Group: crd.Spec.Group, Version: version.Name, Kind: crd.Spec.Names.Kind}
+			set[gvk] = version.Schema.OpenAPIV3Schema
+		}
+	}
+	return set, nil
+}
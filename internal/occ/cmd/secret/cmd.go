@@ -45,10 +45,12 @@ func newListCmd(f client.NewClientFunc) *cobra.Command {
 			}
 			return New(cl).List(ListParams{
 				Namespace: flags.GetNamespace(cmd),
+				Output:    flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
+	flags.AddListOutputFormat(cmd)
 	return cmd
 }
 
@@ -56,7 +58,7 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get [SECRET_NAME]",
 		Short: "Get a secret",
-		Long:  "Get a secret and display its details in YAML format.",
+		Long:  "Get a secret and display its details in YAML, or in the format set by --output.",
 		Example: `  # Get a secret
   occ secret get my-secret --namespace acme-corp`,
 		Args:    cmdutil.ExactOneArgWithUsage(),
@@ -69,10 +71,12 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 			return New(cl).Get(GetParams{
 				Namespace:  flags.GetNamespace(cmd),
 				SecretName: args[0],
+				Output:     flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
+	flags.AddGetOutputFormat(cmd)
 	return cmd
 }
 
@@ -6,6 +6,7 @@ package secret
 // ListParams defines parameters for listing secrets.
 type ListParams struct {
 	Namespace string
+	Output    string // --output format
 }
 
 func (p ListParams) GetNamespace() string { return p.Namespace }
@@ -14,6 +15,7 @@ func (p ListParams) GetNamespace() string { return p.Namespace }
 type GetParams struct {
 	Namespace  string
 	SecretName string
+	Output     string // --output format
 }
 
 func (p GetParams) GetNamespace() string  { return p.Namespace }
@@ -11,11 +11,10 @@ import (
 	"os"
 	"text/tabwriter"
 
-	"sigs.k8s.io/yaml"
-
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
 	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/printer"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 )
@@ -63,6 +62,10 @@ func (s *Secret) List(params ListParams) error {
 		return err
 	}
 
+	if params.Output != "" {
+		return printer.PrintList(os.Stdout, items, params.Output)
+	}
+
 	targets, err := s.listTargetPlanes(ctx, params.Namespace)
 	if err != nil {
 		return err
@@ -70,7 +73,8 @@ func (s *Secret) List(params ListParams) error {
 	return printList(items, targets)
 }
 
-// Get retrieves a single secret via the Secret API and outputs it as YAML.
+// Get retrieves a single secret via the Secret API and prints it in the
+// requested output format, YAML by default.
 // Values in `.data` are base64-encoded, matching kubectl's default shape.
 func (s *Secret) Get(params GetParams) error {
 	if err := cmdutil.RequireFields("get", "secret", map[string]string{
@@ -86,12 +90,7 @@ func (s *Secret) Get(params GetParams) error {
 		return err
 	}
 
-	data, err := yaml.Marshal(result)
-	if err != nil {
-		return fmt.Errorf("failed to marshal secret to YAML: %w", err)
-	}
-	fmt.Print(string(data))
-	return nil
+	return printer.Print(os.Stdout, result, params.Output)
 }
 
 // listTargetPlanes returns a map from secret name to "Kind/Name" target plane,
@@ -0,0 +1,50 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/internal/occ/auth"
+	"github.com/openchoreo/openchoreo/internal/occ/completion"
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func NewStatusCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status component/NAME",
+		Short: "Show the build, release and rollout progress of a component",
+		Long: `Show how a component progresses from build to release to rollout: its
+conditions, its latest workflow runs and component releases, and the release
+binding of each environment with its readiness.
+
+With --watch, the view is updated live from the watch stream of the API
+server until interrupted. On a terminal the view is redrawn in place;
+otherwise each new state is printed with a timestamp.`,
+		Example: `  # Show the status of a component
+  occ status component/orders --namespace acme-corp
+
+  # Follow a build through to its rollout
+  occ status component/orders --watch`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.KindReference(f, "component"),
+		PreRunE:           auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			watch, _ := cmd.Flags().GetBool("watch")
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl.(*client.Client)).Status(Params{
+				Resource:  args[0],
+				Namespace: flags.GetNamespace(cmd),
+				Watch:     watch,
+			})
+		},
+	}
+	flags.AddNamespace(cmd)
+	cmd.Flags().BoolP("watch", "w", false, "Update the view live as the component progresses")
+	return cmd
+}
@@ -0,0 +1,26 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func TestNewStatusCmd(t *testing.T) {
+	cmd := NewStatusCmd(func() (client.Interface, error) { return nil, fmt.Errorf("unused") })
+
+	assert.Equal(t, "status", cmd.Name())
+	assert.NotNil(t, cmd.Flags().Lookup("namespace"))
+	watch := cmd.Flags().Lookup("watch")
+	if assert.NotNil(t, watch) {
+		assert.Equal(t, "w", watch.Shorthand)
+	}
+	assert.Error(t, cmd.Args(cmd, nil))
+	assert.NoError(t, cmd.Args(cmd, []string{"component/orders"}))
+}
@@ -0,0 +1,12 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+// Params defines parameters for showing the status of a resource
+type Params struct {
+	// Resource is the resource as KIND/NAME, such as component/orders.
+	Resource  string
+	Namespace string
+	Watch     bool
+}
@@ -0,0 +1,236 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/term"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+// clearScreen moves the cursor home and clears the terminal before a redraw.
+const clearScreen = "\033[H\033[2J"
+
+// renderDelay batches the events that arrive together, such as the initial
+// state of a watch, into one redraw.
+const renderDelay = 100 * time.Millisecond
+
+// collections are the API collections listed for the view, by kind.
+var collections = map[string]string{
+	kindWorkflowRun:      "workflowruns",
+	kindComponentRelease: "componentreleases",
+	kindReleaseBinding:   "releasebindings",
+}
+
+type Status struct {
+	client *client.Client
+}
+
+func New(c *client.Client) *Status {
+	return &Status{client: c}
+}
+
+// Status prints the build, release and rollout progression of a component.
+// With Watch set, the view is updated live until interrupted.
+func (s *Status) Status(params Params) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	return s.run(ctx, params, os.Stdout, term.IsTerminal(int(os.Stdout.Fd())))
+}
+
+func (s *Status) run(ctx context.Context, params Params, out io.Writer, live bool) error {
+	name, err := parseComponentRef(params.Resource)
+	if err != nil {
+		return err
+	}
+	namespace := params.Namespace
+	if namespace == "" {
+		namespace = config.DefaultNamespace()
+		if namespace == "" {
+			return fmt.Errorf("namespace is required: set --namespace or a namespace in the current context")
+		}
+	}
+
+	raw, err := s.client.GetObject(ctx, "/api/v1/namespaces/"+url.PathEscape(namespace)+"/components/"+url.PathEscape(name))
+	if err != nil {
+		return err
+	}
+	comp, err := decodeObject(raw)
+	if err != nil {
+		return fmt.Errorf("failed to decode component %s: %w", name, err)
+	}
+	v := newView(namespace, comp.Spec.Owner.ProjectName, name)
+	v.set(kindComponent, comp)
+
+	if params.Watch {
+		return s.watch(ctx, v, out, live)
+	}
+	for _, kind := range []string{kindWorkflowRun, kindComponentRelease, kindReleaseBinding} {
+		path := "/api/v1/namespaces/" + url.PathEscape(namespace) + "/" + collections[kind]
+		items, err := pagination.FetchAll(func(limit int, cursor string) ([]map[string]any, string, error) {
+			return s.client.ListObjects(ctx, path, limit, cursor)
+		})
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			if obj, err := decodeObject(item); err == nil {
+				v.set(kind, obj)
+			}
+		}
+	}
+	return v.render(out)
+}
+
+// watch follows the changes to the component and its progression, redrawing
+// the view after each batch of changes. Lost streams are resumed from the last
+// event received.
+func (s *Status) watch(ctx context.Context, v *view, out io.Writer, live bool) error {
+	params := client.WatchParams{
+		Kinds:      []string{kindComponent, kindWorkflowRun, kindComponentRelease, kindReleaseBinding},
+		Namespaces: []string{v.namespace},
+		Project:    v.project,
+		Component:  v.component,
+	}
+	reconnector := utils.NewReconnector(os.Stderr, "status")
+	connected := false
+	var last string
+	for {
+		events, err := s.client.Watch(ctx, params)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			if !connected {
+				return fmt.Errorf("failed to watch component %s: %w", v.component, err)
+			}
+			if !reconnector.Failed(ctx, err) {
+				return nil
+			}
+			continue
+		}
+		connected = true
+		reconnector.Succeeded()
+		if params.Token == "" {
+			// A watch without a token sends every resource again.
+			v.reset()
+		}
+
+		err = s.follow(ctx, events, v, out, live, &params.Token, &last)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if !reconnector.Failed(ctx, err) {
+			return nil
+		}
+	}
+}
+
+// follow applies the events of one watch stream to the view until the stream
+// ends, and returns why it ended. token is advanced to the last event.
+func (s *Status) follow(
+	ctx context.Context,
+	events <-chan client.WatchEvent,
+	v *view,
+	out io.Writer,
+	live bool,
+	token, last *string,
+) error {
+	timer := time.NewTimer(renderDelay)
+	timer.Stop()
+	defer timer.Stop()
+	pending := false
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			pending = false
+			if err := draw(v, out, live, last); err != nil {
+				return err
+			}
+		case ev, ok := <-events:
+			if !ok {
+				if pending {
+					_ = draw(v, out, live, last)
+				}
+				return errors.New("watch stream ended")
+			}
+			if ev.ID != "" {
+				*token = ev.ID
+			}
+			switch ev.Type {
+			case client.WatchError:
+				if ev.Code == client.WatchErrorTokenExpired {
+					*token = ""
+				}
+				return errors.New(ev.Message)
+			case client.WatchDeleted:
+				v.remove(ev.Kind, ev.Name)
+			case client.WatchAdded, client.WatchModified:
+				obj, err := decodeObject(ev.Object)
+				if err != nil {
+					continue
+				}
+				v.set(ev.Kind, obj)
+			default:
+				continue
+			}
+			if !pending {
+				pending = true
+				timer.Reset(renderDelay)
+			}
+		}
+	}
+}
+
+// draw renders the view when it changed since the last draw. On a terminal
+// the screen is redrawn; otherwise each new state is appended.
+func draw(v *view, out io.Writer, live bool, last *string) error {
+	var buf bytes.Buffer
+	if err := v.render(&buf); err != nil {
+		return err
+	}
+	rendered := buf.String()
+	if rendered == *last {
+		return nil
+	}
+	*last = rendered
+
+	if live {
+		_, err := io.WriteString(out, clearScreen+rendered)
+		return err
+	}
+	_, err := fmt.Fprintf(out, "%s\n%s", time.Now().Format(time.TimeOnly), rendered)
+	return err
+}
+
+// parseComponentRef returns the name of a component/NAME reference.
+func parseComponentRef(ref string) (string, error) {
+	kind, name, ok := strings.Cut(ref, "/")
+	if !ok || name == "" {
+		return "", fmt.Errorf("expected a resource as KIND/NAME, such as component/orders, got %q", ref)
+	}
+	switch strings.ToLower(kind) {
+	case "component", "components", "comp":
+		return name, nil
+	}
+	return "", fmt.Errorf("status is not supported for %q: only component/NAME is supported", kind)
+}
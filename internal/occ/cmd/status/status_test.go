@@ -0,0 +1,161 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+)
+
+const (
+	componentJSON = `{"metadata":{"name":"orders","creationTimestamp":"2026-01-01T00:00:00Z"},
+		"spec":{"owner":{"projectName":"store"}},
+		"status":{"latestRelease":{"name":"orders-a1"},"conditions":[{"type":"Ready","status":"True","reason":"Reconciled","message":"Component is ready"}]}}`
+	runJSON = `{"metadata":{"name":"orders-build-1","creationTimestamp":"2026-01-01T00:01:00Z","labels":{"openchoreo.dev/component":"orders"}},
+		"status":{"conditions":[{"type":"WorkflowSucceeded","status":"True","reason":"Succeeded","message":"Build finished"}]}}`
+	releaseJSON = `{"metadata":{"name":"orders-a1","creationTimestamp":"2026-01-01T00:02:00Z"},
+		"spec":{"owner":{"projectName":"store","componentName":"orders"}}}`
+	bindingJSON = `{"metadata":{"name":"orders-development","creationTimestamp":"2026-01-01T00:03:00Z"},
+		"spec":{"owner":{"projectName":"store","componentName":"orders"},"environment":"development","releaseName":"orders-a1"},
+		"status":{"conditions":[{"type":"Ready","status":"%s","reason":"%s","message":"%s"}]}}`
+	otherBindingJSON = `{"metadata":{"name":"payments-development"},
+		"spec":{"owner":{"projectName":"store","componentName":"payments"},"environment":"development"}}`
+)
+
+func setupStatusTest(t *testing.T, handler testutil.RoundTripFunc) *client.Client {
+	t.Helper()
+	home := testutil.SetupTestHome(t)
+	testutil.WriteOCConfig(t, home, config.StoredConfig{
+		CurrentContext: "test",
+		ControlPlanes:  []config.ControlPlane{{Name: "cp", URL: "http://mock-api"}},
+		Credentials:    []config.Credential{{Name: "cred", Token: testutil.NonExpiredJWT}},
+		Contexts:       []config.Context{{Name: "test", ControlPlane: "cp", Credentials: "cred", Namespace: "acme"}},
+	})
+	testutil.SetTransport(t, handler)
+
+	cl, err := client.NewClient()
+	require.NoError(t, err)
+	return cl
+}
+
+func rawResp(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+	}
+}
+
+func list(items ...string) string {
+	return `{"items":[` + strings.Join(items, ",") + `],"pagination":{}}`
+}
+
+func TestStatus_Snapshot(t *testing.T) {
+	cl := setupStatusTest(t, func(r *http.Request) (*http.Response, error) {
+		switch r.URL.Path {
+		case "/api/v1/namespaces/acme/components/orders":
+			return rawResp(http.StatusOK, componentJSON), nil
+		case "/api/v1/namespaces/acme/workflowruns":
+			return rawResp(http.StatusOK, list(runJSON)), nil
+		case "/api/v1/namespaces/acme/componentreleases":
+			return rawResp(http.StatusOK, list(releaseJSON)), nil
+		case "/api/v1/namespaces/acme/releasebindings":
+			return rawResp(http.StatusOK, list(fmt.Sprintf(bindingJSON, "False", "Progressing", "Waiting for pods"), otherBindingJSON)), nil
+		}
+		return rawResp(http.StatusNotFound, `{"error":"not found"}`), nil
+	})
+
+	var out bytes.Buffer
+	require.NoError(t, New(cl).run(context.Background(), Params{Resource: "component/orders"}, &out, false))
+
+	got := out.String()
+	assert.Contains(t, got, "Component orders (project store, namespace acme)")
+	assert.Contains(t, got, "Component is ready")
+	assert.Regexp(t, `orders-build-1\s+Succeeded\s+\S+\s+Build finished`, got)
+	assert.Regexp(t, `orders-a1\s+\S+\s+\*`, got)
+	assert.Regexp(t, `development\s+orders-a1\s+False\s+Progressing\s+Waiting for pods`, got)
+	assert.NotContains(t, got, "payments")
+}
+
+func TestStatus_Watch(t *testing.T) {
+	stream, streamWriter := io.Pipe()
+	t.Cleanup(func() { _ = streamWriter.Close() })
+
+	cl := setupStatusTest(t, func(r *http.Request) (*http.Response, error) {
+		switch r.URL.Path {
+		case "/api/v1/namespaces/acme/components/orders":
+			return rawResp(http.StatusOK, componentJSON), nil
+		case "/api/v1/watch":
+			assert.Equal(t, "orders", r.URL.Query().Get("component"))
+			assert.Equal(t, "store", r.URL.Query().Get("project"))
+			return &http.Response{StatusCode: http.StatusOK, Body: stream, Header: http.Header{}}, nil
+		}
+		return rawResp(http.StatusNotFound, `{"error":"not found"}`), nil
+	})
+
+	out := &syncBuffer{}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- New(cl).run(ctx, Params{Resource: "component/orders", Watch: true}, out, false) }()
+
+	send := func(id, typ, kind, obj string) {
+		data := strings.Join(strings.Fields(obj), " ")
+		_, err := fmt.Fprintf(streamWriter, "id: %s\ndata: {\"type\":%q,\"kind\":%q,\"object\":%s}\n\n", id, typ, kind, data)
+		require.NoError(t, err)
+	}
+	send("1", "ADDED", "Component", componentJSON)
+	send("2", "ADDED", "ReleaseBinding", fmt.Sprintf(bindingJSON, "False", "Progressing", "Waiting for pods"))
+	assert.Eventually(t, func() bool { return strings.Contains(out.String(), "Waiting for pods") }, 5*time.Second, 10*time.Millisecond)
+
+	send("3", "MODIFIED", "ReleaseBinding", fmt.Sprintf(bindingJSON, "True", "Ready", "Deployed"))
+	assert.Eventually(t, func() bool { return strings.Contains(out.String(), "Deployed") }, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-done)
+	assert.Regexp(t, `development\s+orders-a1\s+True\s+Ready\s+Deployed`, out.String())
+}
+
+func TestParseComponentRef(t *testing.T) {
+	name, err := parseComponentRef("component/orders")
+	require.NoError(t, err)
+	assert.Equal(t, "orders", name)
+
+	_, err = parseComponentRef("orders")
+	assert.ErrorContains(t, err, "expected a resource as KIND/NAME")
+
+	_, err = parseComponentRef("project/store")
+	assert.EqualError(t, err, `status is not supported for "project": only component/NAME is supported`)
+}
+
+// syncBuffer is a bytes.Buffer safe for a writer and a reader goroutine.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
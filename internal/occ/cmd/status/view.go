@@ -0,0 +1,252 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/workflowrun"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+// Kinds shown in the view, in the order of the progression.
+const (
+	kindComponent        = "Component"
+	kindWorkflowRun      = "WorkflowRun"
+	kindComponentRelease = "ComponentRelease"
+	kindReleaseBinding   = "ReleaseBinding"
+)
+
+// componentLabel identifies the workflow runs of a component.
+const componentLabel = "openchoreo.dev/component"
+
+// maxHistory is the number of workflow runs and releases shown, newest first.
+const maxHistory = 3
+
+// object holds the fields of the resources the view shows. Objects from the
+// REST API and from the watch stream have the same shape for these fields.
+type object struct {
+	Metadata struct {
+		Name              string            `json:"name"`
+		CreationTimestamp time.Time         `json:"creationTimestamp"`
+		Labels            map[string]string `json:"labels"`
+	} `json:"metadata"`
+	Spec struct {
+		Owner struct {
+			ProjectName   string `json:"projectName"`
+			ComponentName string `json:"componentName"`
+		} `json:"owner"`
+		Environment string `json:"environment"`
+		ReleaseName string `json:"releaseName"`
+	} `json:"spec"`
+	Status struct {
+		Conditions    []condition `json:"conditions"`
+		LatestRelease *struct {
+			Name string `json:"name"`
+		} `json:"latestRelease"`
+	} `json:"status"`
+}
+
+type condition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+func decodeObject(raw map[string]any) (*object, error) {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	var obj object
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	return &obj, nil
+}
+
+// view is the state of a component and the resources of its progression:
+// workflow runs build it, component releases snapshot it and release bindings
+// roll it out to environments.
+type view struct {
+	namespace string
+	project   string
+	component string
+
+	comp     *object
+	runs     map[string]*object
+	releases map[string]*object
+	bindings map[string]*object
+}
+
+func newView(namespace, project, component string) *view {
+	v := &view{namespace: namespace, project: project, component: component}
+	v.reset()
+	return v
+}
+
+// reset forgets every resource, before they are all sent again.
+func (v *view) reset() {
+	v.comp = nil
+	v.runs = map[string]*object{}
+	v.releases = map[string]*object{}
+	v.bindings = map[string]*object{}
+}
+
+// set records the state of a resource. Resources of other components are
+// ignored.
+func (v *view) set(kind string, obj *object) {
+	switch kind {
+	case kindComponent:
+		if obj.Metadata.Name == v.component {
+			v.comp = obj
+		}
+	case kindWorkflowRun:
+		if obj.Metadata.Labels[componentLabel] == v.component {
+			v.runs[obj.Metadata.Name] = obj
+		}
+	case kindComponentRelease:
+		if obj.Spec.Owner.ComponentName == v.component {
+			v.releases[obj.Metadata.Name] = obj
+		}
+	case kindReleaseBinding:
+		if obj.Spec.Owner.ComponentName == v.component {
+			v.bindings[obj.Metadata.Name] = obj
+		}
+	}
+}
+
+// remove forgets a deleted resource.
+func (v *view) remove(kind, name string) {
+	switch kind {
+	case kindComponent:
+		if name == v.component {
+			v.comp = nil
+		}
+	case kindWorkflowRun:
+		delete(v.runs, name)
+	case kindComponentRelease:
+		delete(v.releases, name)
+	case kindReleaseBinding:
+		delete(v.bindings, name)
+	}
+}
+
+// render writes the view: the component conditions, then the latest builds,
+// releases and the rollout to each environment.
+func (v *view) render(out io.Writer) error {
+	fmt.Fprintf(out, "Component %s (project %s, namespace %s)\n", v.component, v.project, v.namespace)
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	if v.comp == nil {
+		fmt.Fprintln(w, "  Deleted")
+	} else {
+		for _, c := range v.comp.Status.Conditions {
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", c.Type, c.Status, c.Reason, c.Message)
+		}
+	}
+
+	fmt.Fprintln(w, "\nBUILD")
+	runs := newest(v.runs)
+	if len(runs) == 0 {
+		fmt.Fprintln(w, "  No workflow runs")
+	} else {
+		fmt.Fprintln(w, "  NAME\tSTATUS\tAGE\tMESSAGE")
+		for _, run := range runs {
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", run.Metadata.Name, runStatus(run), utils.FormatAge(run.Metadata.CreationTimestamp), runMessage(run))
+		}
+	}
+
+	fmt.Fprintln(w, "\nRELEASE")
+	releases := newest(v.releases)
+	if len(releases) == 0 {
+		fmt.Fprintln(w, "  No releases")
+	} else {
+		latest := ""
+		if v.comp != nil && v.comp.Status.LatestRelease != nil {
+			latest = v.comp.Status.LatestRelease.Name
+		}
+		fmt.Fprintln(w, "  NAME\tAGE\tLATEST")
+		for _, rel := range releases {
+			marker := ""
+			if rel.Metadata.Name == latest {
+				marker = "*"
+			}
+			fmt.Fprintf(w, "  %s\t%s\t%s\n", rel.Metadata.Name, utils.FormatAge(rel.Metadata.CreationTimestamp), marker)
+		}
+	}
+
+	fmt.Fprintln(w, "\nROLLOUT")
+	if len(v.bindings) == 0 {
+		fmt.Fprintln(w, "  Not deployed")
+	} else {
+		fmt.Fprintln(w, "  ENVIRONMENT\tRELEASE\tREADY\tREASON\tMESSAGE")
+		bindings := make([]*object, 0, len(v.bindings))
+		for _, b := range v.bindings {
+			bindings = append(bindings, b)
+		}
+		sort.Slice(bindings, func(i, j int) bool { return bindings[i].Spec.Environment < bindings[j].Spec.Environment })
+		for _, b := range bindings {
+			ready := findCondition(b.Status.Conditions, "Ready")
+			if ready == nil {
+				ready = &condition{Status: "Unknown"}
+			}
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\n", b.Spec.Environment, b.Spec.ReleaseName, ready.Status, ready.Reason, ready.Message)
+		}
+	}
+	return w.Flush()
+}
+
+// newest returns up to maxHistory objects, newest first.
+func newest(objects map[string]*object) []*object {
+	list := make([]*object, 0, len(objects))
+	for _, obj := range objects {
+		list = append(list, obj)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		ti, tj := list[i].Metadata.CreationTimestamp, list[j].Metadata.CreationTimestamp
+		if !ti.Equal(tj) {
+			return ti.After(tj)
+		}
+		return list[i].Metadata.Name > list[j].Metadata.Name
+	})
+	if len(list) > maxHistory {
+		list = list[:maxHistory]
+	}
+	return list
+}
+
+func runStatus(run *object) string {
+	conditions := make([]gen.Condition, 0, len(run.Status.Conditions))
+	for _, c := range run.Status.Conditions {
+		conditions = append(conditions, gen.Condition{Type: c.Type, Status: gen.ConditionStatus(c.Status), Reason: c.Reason})
+	}
+	return workflowrun.DeriveStatus(conditions)
+}
+
+// runMessage returns the message of the condition that decided the run status.
+func runMessage(run *object) string {
+	for _, t := range []string{"WorkflowFailed", "WorkflowSucceeded", "WorkflowRunning", "WorkflowCompleted"} {
+		if c := findCondition(run.Status.Conditions, t); c != nil && (c.Status == "True" || t == "WorkflowCompleted") {
+			return strings.TrimSpace(c.Message)
+		}
+	}
+	return ""
+}
+
+func findCondition(conditions []condition, conditionType string) *condition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// chart is an unpacked control plane chart.
+type chart struct {
+	dir        string
+	version    string
+	appVersion string
+	crds       []extv1.CustomResourceDefinition
+}
+
+// crdsDir returns the directory of the chart's CRDs, which Helm installs but
+// never upgrades.
+func (c *chart) crdsDir() string {
+	return filepath.Join(c.dir, "crds")
+}
+
+// loadChart reads the metadata and the CRDs of the chart unpacked in dir.
+func loadChart(dir string) (*chart, error) {
+	data, err := os.ReadFile(filepath.Join(dir, "Chart.yaml"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the chart: %w", err)
+	}
+	var meta struct {
+		Version    string `json:"version"`
+		AppVersion string `json:"appVersion"`
+	}
+	if err := yaml.Unmarshal(data, &meta); err != nil {
+		return nil, fmt.Errorf("failed to parse Chart.yaml: %w", err)
+	}
+	c := &chart{dir: dir, version: meta.Version, appVersion: meta.AppVersion}
+	if c.appVersion == "" {
+		c.appVersion = c.version
+	}
+
+	paths, err := filepath.Glob(filepath.Join(c.crdsDir(), "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("the chart in %s has no CRDs", dir)
+	}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		var crd extv1.CustomResourceDefinition
+		if err := yaml.Unmarshal(data, &crd); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		c.crds = append(c.crds, crd)
+	}
+	return c, nil
+}
+
+// helmVersion returns the chart version constraint for a requested version.
+// A MAJOR.MINOR version selects the latest patch release of that minor.
+func helmVersion(version string) string {
+	version = strings.TrimPrefix(version, "v")
+	if strings.Count(version, ".") == 1 {
+		return "~" + version + ".0"
+	}
+	return version
+}
+
+// matchesVersion reports whether a chart version is the requested version,
+// or a patch release of it when only MAJOR.MINOR is requested.
+func matchesVersion(chartVersion, requested string) bool {
+	requested = strings.TrimPrefix(requested, "v")
+	chartVersion = strings.TrimPrefix(chartVersion, "v")
+	if strings.Count(requested, ".") == 1 {
+		return strings.HasPrefix(chartVersion, requested+".")
+	}
+	return chartVersion == requested
+}
+
+// storedVersionProblems compares the versions at which the installed CRDs
+// store their objects with the versions the chart's CRDs serve. Objects
+// stored at a version the chart no longer serves would become unreadable, so
+// they block the upgrade; objects stored at a deprecated version only warn.
+func storedVersionProblems(installed []extv1.CustomResourceDefinition, c *chart) (blockers, warnings []string) {
+	target := make(map[string]*extv1.CustomResourceDefinition, len(c.crds))
+	for i := range c.crds {
+		target[c.crds[i].Name] = &c.crds[i]
+	}
+
+	for _, crd := range installed {
+		t, ok := target[crd.Name]
+		if !ok {
+			continue
+		}
+		for _, stored := range crd.Status.StoredVersions {
+			var version *extv1.CustomResourceDefinitionVersion
+			for i := range t.Spec.Versions {
+				if t.Spec.Versions[i].Name == stored {
+					version = &t.Spec.Versions[i]
+				}
+			}
+			switch {
+			case version == nil || !version.Served:
+				blockers = append(blockers, fmt.Sprintf("%s objects are stored at %s, which %s no longer serves; migrate them to a served version first",
+					crd.Spec.Names.Kind, stored, c.appVersion))
+			case version.Deprecated:
+				warnings = append(warnings, fmt.Sprintf("%s objects are stored at %s, which %s deprecates",
+					crd.Spec.Names.Kind, stored, c.appVersion))
+			}
+		}
+	}
+	return blockers, warnings
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/internal/occ/auth"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+const (
+	defaultChart            = "oci://ghcr.io/openchoreo/helm-charts/openchoreo-control-plane"
+	defaultRelease          = "openchoreo-control-plane"
+	defaultReleaseNamespace = "openchoreo-control-plane"
+)
+
+func NewUpgradeCmd(f client.NewClientFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade --version VERSION",
+		Short: "Upgrade the control plane and its CRDs",
+		Long: `Upgrade the OpenChoreo control plane to a chart version.
+
+The upgrade runs in three stages:
+
+  1. Pre-flight checks: the control plane must be reachable and pass its
+     diagnostics, the target must not be a downgrade, and no CRD may store
+     objects at a version the target chart no longer serves. CRDs storing
+     objects at deprecated versions are reported.
+  2. Upgrade: the CRDs of the chart are applied with kubectl, since Helm
+     does not upgrade CRDs, and the Helm release is upgraded, keeping its
+     values.
+  3. Verification: the control plane must report the new version and pass
+     its diagnostics within --timeout.
+
+helm and kubectl must be installed and have access to the control plane
+cluster, through the current kubeconfig context or --kube-context. A
+MAJOR.MINOR version selects the latest patch release of that minor version.
+--chart also accepts the path of an unpacked chart.`,
+		Example: `  # Upgrade to the latest patch release of 1.2
+  occ upgrade --version v1.2
+
+  # Run the pre-flight checks and print the upgrade commands
+  occ upgrade --version v1.2.3 --dry-run
+
+  # Upgrade the control plane of another cluster
+  occ upgrade --version v1.2.3 --kube-context prod-cp`,
+		Args:    cobra.NoArgs,
+		PreRunE: auth.RequireLogin(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			version, _ := cmd.Flags().GetString("version")
+			chart, _ := cmd.Flags().GetString("chart")
+			release, _ := cmd.Flags().GetString("release")
+			releaseNamespace, _ := cmd.Flags().GetString("release-namespace")
+			kubeContext, _ := cmd.Flags().GetString("kube-context")
+			dryRun, _ := cmd.Flags().GetBool("dry-run")
+			timeout, _ := cmd.Flags().GetDuration("timeout")
+			cl, err := f()
+			if err != nil {
+				return err
+			}
+			return New(cl).Upgrade(Params{
+				Version:          version,
+				Chart:            chart,
+				Release:          release,
+				ReleaseNamespace: releaseNamespace,
+				KubeContext:      kubeContext,
+				DryRun:           dryRun,
+				Timeout:          timeout,
+			})
+		},
+	}
+	cmd.Flags().String("version", "", "Chart version to upgrade to, MAJOR.MINOR or MAJOR.MINOR.PATCH")
+	cmd.Flags().String("chart", defaultChart, "Chart reference or path of an unpacked chart")
+	cmd.Flags().String("release", defaultRelease, "Name of the Helm release of the control plane")
+	cmd.Flags().String("release-namespace", defaultReleaseNamespace, "Namespace of the Helm release of the control plane")
+	cmd.Flags().String("kube-context", "", "Kubeconfig context of the control plane cluster (defaults to the current context)")
+	cmd.Flags().Bool("dry-run", false, "Run the pre-flight checks and print the upgrade commands without running them")
+	cmd.Flags().Duration("timeout", 10*time.Minute, "Maximum time to wait for the upgraded control plane")
+	_ = cmd.MarkFlagRequired("version")
+	return cmd
+}
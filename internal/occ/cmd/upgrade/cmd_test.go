@@ -0,0 +1,35 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+func TestNewUpgradeCmd(t *testing.T) {
+	cmd := NewUpgradeCmd(func() (client.Interface, error) { return nil, fmt.Errorf("unused") })
+
+	assert.Equal(t, "upgrade", cmd.Name())
+	assert.NotNil(t, cmd.PreRunE)
+	assert.Error(t, cmd.Args(cmd, []string{"extra"}))
+	for name, def := range map[string]string{
+		"chart":             defaultChart,
+		"release":           defaultRelease,
+		"release-namespace": defaultReleaseNamespace,
+		"timeout":           "10m0s",
+		"dry-run":           "false",
+	} {
+		f := cmd.Flags().Lookup(name)
+		if assert.NotNil(t, f, name) {
+			assert.Equal(t, def, f.DefValue, name)
+		}
+	}
+	assert.Equal(t, []string{"true"}, cmd.Flags().Lookup("version").Annotations[cobra.BashCompOneRequiredFlag])
+}
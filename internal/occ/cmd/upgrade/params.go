@@ -0,0 +1,23 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+import "time"
+
+// Params defines parameters for upgrading the control plane
+type Params struct {
+	// Version is the chart version to upgrade to. A MAJOR.MINOR version
+	// selects the latest patch release of that minor version.
+	Version string
+	// Chart is the chart reference, or the path of an unpacked chart.
+	Chart            string
+	Release          string
+	ReleaseNamespace string
+	// KubeContext is the kubeconfig context of the control plane cluster;
+	// empty uses the current context.
+	KubeContext string
+	DryRun      bool
+	// Timeout bounds the Helm upgrade and the post-upgrade verification.
+	Timeout time.Duration
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// commandRunner runs the external tools that perform the upgrade.
+type commandRunner interface {
+	// LookPath returns an error when the tool is not installed.
+	LookPath(name string) error
+	// Run runs the tool and returns its standard output.
+	Run(ctx context.Context, name string, args ...string) ([]byte, error)
+}
+
+type execRunner struct{}
+
+func (execRunner) LookPath(name string) error {
+	_, err := exec.LookPath(name)
+	return err
+}
+
+func (execRunner) Run(ctx context.Context, name string, args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%s %s failed: %s", name, args[0], msg)
+		}
+		return nil, fmt.Errorf("%s %s failed: %w", name, args[0], err)
+	}
+	return out, nil
+}
+
+// helmArgs appends the kubeconfig context flag of helm to args.
+func helmArgs(kubeContext string, args ...string) []string {
+	if kubeContext != "" {
+		args = append(args, "--kube-context", kubeContext)
+	}
+	return args
+}
+
+// kubectlArgs appends the kubeconfig context flag of kubectl to args.
+func kubectlArgs(kubeContext string, args ...string) []string {
+	if kubeContext != "" {
+		args = append(args, "--context", kubeContext)
+	}
+	return args
+}
@@ -0,0 +1,252 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	utilversion "k8s.io/apimachinery/pkg/util/version"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+// pollInterval is the time between version checks while waiting for the
+// upgraded control plane.
+var pollInterval = 5 * time.Second
+
+type Upgrader struct {
+	client client.Interface
+	runner commandRunner
+}
+
+func New(c client.Interface) *Upgrader {
+	return &Upgrader{client: c, runner: execRunner{}}
+}
+
+// Upgrade upgrades the control plane to a chart version. Pre-flight checks
+// stop the upgrade when the control plane is unhealthy, when it would be
+// downgraded, or when CRDs store objects at versions the chart no longer
+// serves. The chart's CRDs are applied before the Helm release is upgraded,
+// as Helm does not upgrade CRDs, and the upgrade is verified by waiting for
+// the control plane to report the new version and pass its diagnostics.
+func (u *Upgrader) Upgrade(params Params) error {
+	return u.upgrade(context.Background(), os.Stdout, params)
+}
+
+func (u *Upgrader) upgrade(ctx context.Context, out io.Writer, params Params) error {
+	if params.Version == "" {
+		return fmt.Errorf("version is required")
+	}
+	for _, tool := range []string{"helm", "kubectl"} {
+		if err := u.runner.LookPath(tool); err != nil {
+			return fmt.Errorf("%s is required to upgrade the control plane: %w", tool, err)
+		}
+	}
+
+	fmt.Fprintln(out, "Running pre-flight checks")
+	current, err := u.client.GetServerVersion(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to reach the control plane: %w", err)
+	}
+	fmt.Fprintf(out, "  control plane version: %s\n", current.Version)
+
+	dir, cleanup, err := u.fetchChart(ctx, params)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	c, err := loadChart(dir)
+	if err != nil {
+		return err
+	}
+	if !matchesVersion(c.version, params.Version) {
+		return fmt.Errorf("chart version %s does not match the requested version %s", c.version, params.Version)
+	}
+	fmt.Fprintf(out, "  target chart: %s, version %s\n", params.Chart, c.version)
+
+	upToDate, err := compareVersions(current.Version, c.appVersion)
+	if err != nil {
+		return err
+	}
+	if upToDate {
+		fmt.Fprintf(out, "The control plane is already at %s\n", current.Version)
+		return nil
+	}
+
+	var blockers []string
+	report, err := u.client.GetDiagnostics(ctx)
+	switch {
+	case err != nil:
+		fmt.Fprintf(out, "  warning: control plane diagnostics are unavailable: %v\n", err)
+	case report.Status == gen.HealthReportStatusFailed:
+		blockers = append(blockers, "the control plane fails its checks: "+strings.Join(failedChecks(report), ", ")+"; run occ doctor for remediation steps")
+	default:
+		fmt.Fprintf(out, "  control plane diagnostics: %s\n", report.Status)
+	}
+
+	installed, err := u.installedCRDs(ctx, params.KubeContext)
+	if err != nil {
+		return err
+	}
+	storedBlockers, warnings := storedVersionProblems(installed, c)
+	blockers = append(blockers, storedBlockers...)
+	for _, w := range warnings {
+		fmt.Fprintf(out, "  warning: %s\n", w)
+	}
+	if len(blockers) > 0 {
+		for _, b := range blockers {
+			fmt.Fprintf(out, "  blocked: %s\n", b)
+		}
+		return fmt.Errorf("pre-flight checks failed with %d problem(s)", len(blockers))
+	}
+	fmt.Fprintf(out, "  stored versions of %d CRDs are served by %s\n", len(installed), c.appVersion)
+
+	applyCRDs := kubectlArgs(params.KubeContext, "apply", "--server-side", "--force-conflicts", "-f", c.crdsDir())
+	upgradeRelease := helmArgs(params.KubeContext, "upgrade", params.Release, c.dir,
+		"--namespace", params.ReleaseNamespace, "--reset-then-reuse-values", "--wait", "--timeout", params.Timeout.String())
+	if params.DryRun {
+		fmt.Fprintf(out, "Dry run: the upgrade from %s to %s would run\n", current.Version, c.appVersion)
+		fmt.Fprintf(out, "  kubectl %s\n", strings.Join(applyCRDs, " "))
+		fmt.Fprintf(out, "  helm %s\n", strings.Join(upgradeRelease, " "))
+		return nil
+	}
+
+	fmt.Fprintf(out, "Upgrading the control plane from %s to %s\n", current.Version, c.appVersion)
+	fmt.Fprintf(out, "  applying %d CRDs\n", len(c.crds))
+	if _, err := u.runner.Run(ctx, "kubectl", applyCRDs...); err != nil {
+		return fmt.Errorf("failed to apply the CRDs: %w", err)
+	}
+	fmt.Fprintf(out, "  upgrading Helm release %s in namespace %s\n", params.Release, params.ReleaseNamespace)
+	if _, err := u.runner.Run(ctx, "helm", upgradeRelease...); err != nil {
+		return fmt.Errorf("failed to upgrade the Helm release: %w", err)
+	}
+
+	fmt.Fprintln(out, "Verifying the upgrade")
+	return u.verify(ctx, out, c.appVersion, params.Timeout)
+}
+
+// fetchChart returns the directory of the chart to upgrade to. A chart
+// reference is pulled into a temporary directory that cleanup removes.
+func (u *Upgrader) fetchChart(ctx context.Context, params Params) (dir string, cleanup func(), err error) {
+	if info, err := os.Stat(params.Chart); err == nil && info.IsDir() {
+		return params.Chart, func() {}, nil
+	}
+
+	tmp, err := os.MkdirTemp("", "occ-upgrade-")
+	if err != nil {
+		return "", nil, err
+	}
+	cleanup = func() { _ = os.RemoveAll(tmp) }
+	_, err = u.runner.Run(ctx, "helm", "pull", params.Chart, "--version", helmVersion(params.Version), "--untar", "--untardir", tmp)
+	if err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("failed to pull chart %s %s: %w", params.Chart, params.Version, err)
+	}
+	matches, err := filepath.Glob(filepath.Join(tmp, "*", "Chart.yaml"))
+	if err != nil || len(matches) != 1 {
+		cleanup()
+		return "", nil, fmt.Errorf("failed to find the pulled chart %s", params.Chart)
+	}
+	return filepath.Dir(matches[0]), cleanup, nil
+}
+
+// installedCRDs returns the OpenChoreo CRDs installed in the cluster.
+func (u *Upgrader) installedCRDs(ctx context.Context, kubeContext string) ([]extv1.CustomResourceDefinition, error) {
+	data, err := u.runner.Run(ctx, "kubectl", kubectlArgs(kubeContext, "get", "crds", "-o", "json")...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the installed CRDs: %w", err)
+	}
+	var list extv1.CustomResourceDefinitionList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("failed to parse the installed CRDs: %w", err)
+	}
+	var crds []extv1.CustomResourceDefinition
+	for _, crd := range list.Items {
+		if crd.Spec.Group == "openchoreo.dev" {
+			crds = append(crds, crd)
+		}
+	}
+	return crds, nil
+}
+
+// compareVersions reports whether the control plane is already at the target
+// version, and fails when the upgrade would be a downgrade. Versions that are
+// not semantic, such as those of development builds, are not compared.
+func compareVersions(current, target string) (bool, error) {
+	cv, err := utilversion.ParseSemantic(current)
+	if err != nil {
+		return false, nil
+	}
+	tv, err := utilversion.ParseSemantic(target)
+	if err != nil {
+		return false, nil
+	}
+	switch {
+	case tv.LessThan(cv):
+		return false, fmt.Errorf("refusing to downgrade the control plane from %s to %s", current, target)
+	case cv.LessThan(tv):
+		return false, nil
+	}
+	return true, nil
+}
+
+// verify waits for the control plane to report the target version and checks
+// its diagnostics.
+func (u *Upgrader) verify(ctx context.Context, out io.Writer, target string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		v, err := u.client.GetServerVersion(ctx)
+		if err == nil && strings.TrimPrefix(v.Version, "v") == strings.TrimPrefix(target, "v") {
+			fmt.Fprintf(out, "  control plane version: %s\n", v.Version)
+			break
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("the control plane did not report version %s within %s", target, timeout)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+
+	report, err := u.client.GetDiagnostics(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to run the control plane diagnostics: %w", err)
+	}
+	switch report.Status {
+	case gen.HealthReportStatusFailed:
+		return fmt.Errorf("the upgraded control plane fails its checks: %s; run occ doctor for remediation steps",
+			strings.Join(failedChecks(report), ", "))
+	case gen.HealthReportStatusDegraded:
+		fmt.Fprintf(out, "  warning: optional checks fail: %s; run occ doctor for remediation steps\n",
+			strings.Join(failedChecks(report), ", "))
+	default:
+		fmt.Fprintln(out, "  control plane diagnostics: ok")
+	}
+	fmt.Fprintf(out, "Upgraded the control plane to %s\n", target)
+	return nil
+}
+
+// failedChecks returns the names of the failed checks of a report, sorted.
+func failedChecks(report *gen.HealthReport) []string {
+	var names []string
+	for name, check := range report.Checks {
+		if check.Status == gen.HealthCheckResultStatusFailed {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,296 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+func setPollInterval(t *testing.T, d time.Duration) {
+	t.Helper()
+	orig := pollInterval
+	pollInterval = d
+	t.Cleanup(func() { pollInterval = orig })
+}
+
+const componentCRD = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: components.openchoreo.dev
+spec:
+  group: openchoreo.dev
+  names:
+    kind: Component
+    plural: components
+  scope: Namespaced
+  versions:
+  - name: v1alpha1
+    served: true
+    storage: false
+    deprecated: true
+  - name: v1beta1
+    served: true
+    storage: true
+`
+
+// writeChart writes a control plane chart of the version to dir.
+func writeChart(t *testing.T, dir, version string) {
+	t.Helper()
+	require.NoError(t, writeChartFiles(dir, version))
+}
+
+func writeChartFiles(dir, version string) error {
+	if err := os.MkdirAll(filepath.Join(dir, "crds"), 0o755); err != nil {
+		return err
+	}
+	chartYAML := fmt.Sprintf("apiVersion: v2\nname: openchoreo-control-plane\nversion: %s\nappVersion: v%s\n", version, version)
+	if err := os.WriteFile(filepath.Join(dir, "Chart.yaml"), []byte(chartYAML), 0o600); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "crds", "openchoreo.dev_components.yaml"), []byte(componentCRD), 0o600)
+}
+
+// installedCRDs returns the kubectl output of an installed Component CRD
+// that stores objects at the versions.
+func installedCRDs(storedVersions ...string) string {
+	return fmt.Sprintf(`{"items":[
+  {"metadata":{"name":"components.openchoreo.dev"},"spec":{"group":"openchoreo.dev","names":{"kind":"Component"}},"status":{"storedVersions":["%s"]}},
+  {"metadata":{"name":"gateways.gateway.networking.k8s.io"},"spec":{"group":"gateway.networking.k8s.io","names":{"kind":"Gateway"}},"status":{"storedVersions":["v0"]}}
+]}`, strings.Join(storedVersions, `","`))
+}
+
+// fakeRunner records the commands it runs. helm pull unpacks a chart of
+// pullVersion and kubectl get crds returns crds.
+type fakeRunner struct {
+	missing     string
+	pullVersion string
+	crds        string
+	commands    []string
+}
+
+func (r *fakeRunner) LookPath(name string) error {
+	if name == r.missing {
+		return errors.New("executable file not found in $PATH")
+	}
+	return nil
+}
+
+func (r *fakeRunner) Run(_ context.Context, name string, args ...string) ([]byte, error) {
+	r.commands = append(r.commands, name+" "+strings.Join(args, " "))
+	switch {
+	case name == "helm" && args[0] == "pull":
+		untarDir := args[len(args)-1]
+		return nil, writeChartFiles(filepath.Join(untarDir, "openchoreo-control-plane"), r.pullVersion)
+	case name == "kubectl" && args[0] == "get":
+		return []byte(r.crds), nil
+	}
+	return nil, nil
+}
+
+func okReport() *gen.HealthReport {
+	return &gen.HealthReport{
+		Status: gen.HealthReportStatusOk,
+		Checks: map[string]gen.HealthCheckResult{"kubernetes": {Status: gen.HealthCheckResultStatusOk}},
+	}
+}
+
+func failedReport(check string) *gen.HealthReport {
+	return &gen.HealthReport{
+		Status: gen.HealthReportStatusFailed,
+		Checks: map[string]gen.HealthCheckResult{
+			"kubernetes": {Status: gen.HealthCheckResultStatusOk},
+			check:        {Status: gen.HealthCheckResultStatusFailed},
+		},
+	}
+}
+
+func params(chart string) Params {
+	return Params{
+		Version:          "v1.2",
+		Chart:            chart,
+		Release:          defaultRelease,
+		ReleaseNamespace: defaultReleaseNamespace,
+		Timeout:          time.Minute,
+	}
+}
+
+func TestUpgrade(t *testing.T) {
+	setPollInterval(t, time.Millisecond)
+	chartDir := t.TempDir()
+	writeChart(t, chartDir, "1.2.3")
+
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetServerVersion(mock.Anything).Return(&gen.VersionResponse{Version: "v1.1.0"}, nil).Twice()
+	mc.EXPECT().GetServerVersion(mock.Anything).Return(&gen.VersionResponse{Version: "v1.2.3"}, nil).Once()
+	mc.EXPECT().GetDiagnostics(mock.Anything).Return(okReport(), nil).Twice()
+	runner := &fakeRunner{crds: installedCRDs("v1alpha1", "v1beta1")}
+
+	var out bytes.Buffer
+	u := &Upgrader{client: mc, runner: runner}
+	p := params(chartDir)
+	p.KubeContext = "prod-cp"
+	require.NoError(t, u.upgrade(context.Background(), &out, p))
+
+	assert.Equal(t, []string{
+		"kubectl get crds -o json --context prod-cp",
+		"kubectl apply --server-side --force-conflicts -f " + filepath.Join(chartDir, "crds") + " --context prod-cp",
+		"helm upgrade openchoreo-control-plane " + chartDir + " --namespace openchoreo-control-plane" +
+			" --reset-then-reuse-values --wait --timeout 1m0s --kube-context prod-cp",
+	}, runner.commands)
+	assert.Contains(t, out.String(), "  warning: Component objects are stored at v1alpha1, which v1.2.3 deprecates\n")
+	assert.Contains(t, out.String(), "Upgrading the control plane from v1.1.0 to v1.2.3\n")
+	assert.Contains(t, out.String(), "Upgraded the control plane to v1.2.3\n")
+}
+
+func TestUpgrade_PullsChartForDryRun(t *testing.T) {
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetServerVersion(mock.Anything).Return(&gen.VersionResponse{Version: "v1.1.0"}, nil)
+	mc.EXPECT().GetDiagnostics(mock.Anything).Return(okReport(), nil)
+	runner := &fakeRunner{pullVersion: "1.2.5", crds: installedCRDs("v1beta1")}
+
+	var out bytes.Buffer
+	p := params(defaultChart)
+	p.DryRun = true
+	require.NoError(t, (&Upgrader{client: mc, runner: runner}).upgrade(context.Background(), &out, p))
+
+	require.Len(t, runner.commands, 2, "nothing but the pull and the CRD listing runs")
+	assert.True(t, strings.HasPrefix(runner.commands[0], "helm pull "+defaultChart+" --version ~1.2.0 --untar --untardir "))
+	assert.Contains(t, out.String(), "  target chart: "+defaultChart+", version 1.2.5\n")
+	assert.Contains(t, out.String(), "Dry run: the upgrade from v1.1.0 to v1.2.5 would run\n")
+	assert.Contains(t, out.String(), "  helm upgrade openchoreo-control-plane ")
+}
+
+func TestUpgrade_PreflightFailures(t *testing.T) {
+	tests := []struct {
+		name          string
+		serverVersion string
+		report        *gen.HealthReport
+		crds          string
+		missing       string
+		wantErr       string
+		wantOut       string
+	}{
+		{
+			name:    "missing tool",
+			missing: "helm",
+			wantErr: "helm is required to upgrade the control plane: executable file not found in $PATH",
+		},
+		{
+			name:          "downgrade",
+			serverVersion: "v1.3.0",
+			wantErr:       "refusing to downgrade the control plane from v1.3.0 to v1.2.3",
+		},
+		{
+			name:          "stored version no longer served",
+			serverVersion: "v1.1.0",
+			report:        okReport(),
+			crds:          installedCRDs("v1alpha0", "v1beta1"),
+			wantErr:       "pre-flight checks failed with 1 problem(s)",
+			wantOut:       "  blocked: Component objects are stored at v1alpha0, which v1.2.3 no longer serves; migrate them to a served version first\n",
+		},
+		{
+			name:          "failing control plane",
+			serverVersion: "v1.1.0",
+			report:        failedReport("crds"),
+			crds:          installedCRDs("v1beta1"),
+			wantErr:       "pre-flight checks failed with 1 problem(s)",
+			wantOut:       "  blocked: the control plane fails its checks: crds; run occ doctor for remediation steps\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chartDir := t.TempDir()
+			writeChart(t, chartDir, "1.2.3")
+			mc := mocks.NewMockInterface(t)
+			if tt.serverVersion != "" {
+				mc.EXPECT().GetServerVersion(mock.Anything).Return(&gen.VersionResponse{Version: tt.serverVersion}, nil)
+			}
+			if tt.report != nil {
+				mc.EXPECT().GetDiagnostics(mock.Anything).Return(tt.report, nil)
+			}
+			runner := &fakeRunner{missing: tt.missing, crds: tt.crds}
+
+			var out bytes.Buffer
+			err := (&Upgrader{client: mc, runner: runner}).upgrade(context.Background(), &out, params(chartDir))
+			require.EqualError(t, err, tt.wantErr)
+			assert.Contains(t, out.String(), tt.wantOut)
+			for _, c := range runner.commands {
+				assert.False(t, strings.HasPrefix(c, "helm upgrade") || strings.HasPrefix(c, "kubectl apply"), "ran %q", c)
+			}
+		})
+	}
+}
+
+func TestUpgrade_AlreadyUpToDate(t *testing.T) {
+	chartDir := t.TempDir()
+	writeChart(t, chartDir, "1.2.3")
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetServerVersion(mock.Anything).Return(&gen.VersionResponse{Version: "v1.2.3"}, nil)
+	runner := &fakeRunner{}
+
+	var out bytes.Buffer
+	require.NoError(t, (&Upgrader{client: mc, runner: runner}).upgrade(context.Background(), &out, params(chartDir)))
+	assert.Contains(t, out.String(), "The control plane is already at v1.2.3\n")
+	assert.Empty(t, runner.commands)
+}
+
+func TestUpgrade_VerificationFails(t *testing.T) {
+	setPollInterval(t, time.Millisecond)
+	chartDir := t.TempDir()
+	writeChart(t, chartDir, "1.2.3")
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetServerVersion(mock.Anything).Return(&gen.VersionResponse{Version: "v1.1.0"}, nil).Once()
+	mc.EXPECT().GetServerVersion(mock.Anything).Return(nil, errors.New("connection refused")).Once()
+	mc.EXPECT().GetServerVersion(mock.Anything).Return(&gen.VersionResponse{Version: "v1.2.3"}, nil).Once()
+	mc.EXPECT().GetDiagnostics(mock.Anything).Return(okReport(), nil).Once()
+	mc.EXPECT().GetDiagnostics(mock.Anything).Return(failedReport("webhookCertificates"), nil).Once()
+	runner := &fakeRunner{crds: installedCRDs("v1beta1")}
+
+	var out bytes.Buffer
+	err := (&Upgrader{client: mc, runner: runner}).upgrade(context.Background(), &out, params(chartDir))
+	require.EqualError(t, err, "the upgraded control plane fails its checks: webhookCertificates; run occ doctor for remediation steps")
+}
+
+func TestUpgrade_VerificationTimesOut(t *testing.T) {
+	setPollInterval(t, time.Millisecond)
+	chartDir := t.TempDir()
+	writeChart(t, chartDir, "1.2.3")
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetServerVersion(mock.Anything).Return(&gen.VersionResponse{Version: "v1.1.0"}, nil)
+	mc.EXPECT().GetDiagnostics(mock.Anything).Return(okReport(), nil).Once()
+	runner := &fakeRunner{crds: installedCRDs("v1beta1")}
+
+	p := params(chartDir)
+	p.Timeout = 20 * time.Millisecond
+	err := (&Upgrader{client: mc, runner: runner}).upgrade(context.Background(), &bytes.Buffer{}, p)
+	require.EqualError(t, err, "the control plane did not report version v1.2.3 within 20ms")
+}
+
+func TestHelmVersion(t *testing.T) {
+	assert.Equal(t, "~1.2.0", helmVersion("v1.2"))
+	assert.Equal(t, "1.2.3", helmVersion("v1.2.3"))
+	assert.Equal(t, "1.2.3-rc.1", helmVersion("1.2.3-rc.1"))
+}
+
+func TestMatchesVersion(t *testing.T) {
+	assert.True(t, matchesVersion("1.2.5", "v1.2"))
+	assert.False(t, matchesVersion("1.20.0", "v1.2"))
+	assert.True(t, matchesVersion("1.2.3", "v1.2.3"))
+	assert.False(t, matchesVersion("1.2.4", "1.2.3"))
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ErrPromptAborted is returned when the input ends before a prompt is answered.
+var ErrPromptAborted = errors.New("prompt aborted: no more input")
+
+// Prompter asks questions on a line-based terminal. Invalid answers are
+// reported and the question is asked again.
+type Prompter struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+// NewPrompter returns a Prompter that reads answers from in and writes questions to out.
+func NewPrompter(in io.Reader, out io.Writer) *Prompter {
+	return &Prompter{in: bufio.NewReader(in), out: out}
+}
+
+// Input asks for a free-form value. An empty answer selects def; validate, if
+// set, rejects answers by returning an error.
+func (p *Prompter) Input(label, def string, validate func(string) error) (string, error) {
+	for {
+		if def != "" {
+			fmt.Fprintf(p.out, "%s [%s]: ", label, def)
+		} else {
+			fmt.Fprintf(p.out, "%s: ", label)
+		}
+		answer, err := p.readLine()
+		if err != nil {
+			return "", err
+		}
+		if answer == "" {
+			answer = def
+		}
+		if validate != nil {
+			if err := validate(answer); err != nil {
+				fmt.Fprintf(p.out, "  %v\n", err)
+				continue
+			}
+		}
+		return answer, nil
+	}
+}
+
+// Select asks for one of options, answered by number or by value. An empty
+// answer selects def, which must be one of options or empty.
+func (p *Prompter) Select(label string, options []string, def string) (string, error) {
+	if len(options) == 0 {
+		return "", fmt.Errorf("no options available for %s", strings.ToLower(label))
+	}
+	fmt.Fprintf(p.out, "%s:\n", label)
+	for i, opt := range options {
+		fmt.Fprintf(p.out, "  %d) %s\n", i+1, opt)
+	}
+	for {
+		if def != "" {
+			fmt.Fprintf(p.out, "Choose 1-%d [%s]: ", len(options), def)
+		} else {
+			fmt.Fprintf(p.out, "Choose 1-%d: ", len(options))
+		}
+		answer, err := p.readLine()
+		if err != nil {
+			return "", err
+		}
+		if answer == "" && def != "" {
+			return def, nil
+		}
+		if n, err := strconv.Atoi(answer); err == nil && n >= 1 && n <= len(options) {
+			return options[n-1], nil
+		}
+		for _, opt := range options {
+			if answer == opt {
+				return opt, nil
+			}
+		}
+		fmt.Fprintf(p.out, "  %q is not a valid choice\n", answer)
+	}
+}
+
+// Confirm asks a yes/no question. An empty answer selects def.
+func (p *Prompter) Confirm(label string, def bool) (bool, error) {
+	hint := "y/N"
+	if def {
+		hint = "Y/n"
+	}
+	for {
+		fmt.Fprintf(p.out, "%s [%s]: ", label, hint)
+		answer, err := p.readLine()
+		if err != nil {
+			return false, err
+		}
+		switch strings.ToLower(answer) {
+		case "":
+			return def, nil
+		case "y", "yes":
+			return true, nil
+		case "n", "no":
+			return false, nil
+		}
+		fmt.Fprintln(p.out, "  please answer yes or no")
+	}
+}
+
+// readLine returns the next trimmed line. A final line without a newline is
+// still returned; ErrPromptAborted is returned only once the input is exhausted.
+func (p *Prompter) readLine() (string, error) {
+	line, err := p.in.ReadString('\n')
+	if err != nil {
+		if errors.Is(err, io.EOF) && line != "" {
+			return strings.TrimSpace(line), nil
+		}
+		if errors.Is(err, io.EOF) {
+			fmt.Fprintln(p.out)
+			return "", ErrPromptAborted
+		}
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
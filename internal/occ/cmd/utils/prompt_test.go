@@ -0,0 +1,85 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrompter_Input(t *testing.T) {
+	var out bytes.Buffer
+	p := NewPrompter(strings.NewReader("\nBad Name\ngood-name\n"), &out)
+	validate := func(s string) error {
+		if s == "" || strings.Contains(s, " ") {
+			return errors.New("must be a non-empty name without spaces")
+		}
+		return nil
+	}
+
+	got, err := p.Input("Component name", "", validate)
+	require.NoError(t, err)
+	assert.Equal(t, "good-name", got)
+	assert.Equal(t, 2, strings.Count(out.String(), "must be a non-empty name"))
+
+	p = NewPrompter(strings.NewReader("\n"), &out)
+	got, err = p.Input("Branch", "main", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "main", got)
+}
+
+func TestPrompter_Select(t *testing.T) {
+	options := []string{"deployment", "statefulset", "cronjob"}
+
+	var out bytes.Buffer
+	p := NewPrompter(strings.NewReader("7\n2\n"), &out)
+	got, err := p.Select("Workload type", options, "")
+	require.NoError(t, err)
+	assert.Equal(t, "statefulset", got)
+	assert.Contains(t, out.String(), "  3) cronjob")
+	assert.Contains(t, out.String(), `"7" is not a valid choice`)
+
+	p = NewPrompter(strings.NewReader("cronjob\n"), &out)
+	got, err = p.Select("Workload type", options, "deployment")
+	require.NoError(t, err)
+	assert.Equal(t, "cronjob", got)
+
+	p = NewPrompter(strings.NewReader("\n"), &out)
+	got, err = p.Select("Workload type", options, "deployment")
+	require.NoError(t, err)
+	assert.Equal(t, "deployment", got)
+
+	_, err = p.Select("Workload type", nil, "")
+	assert.ErrorContains(t, err, "no options available for workload type")
+}
+
+func TestPrompter_Confirm(t *testing.T) {
+	var out bytes.Buffer
+	p := NewPrompter(strings.NewReader("maybe\nyes\n\n"), &out)
+
+	got, err := p.Confirm("Create component?", false)
+	require.NoError(t, err)
+	assert.True(t, got)
+	assert.Contains(t, out.String(), "please answer yes or no")
+
+	got, err = p.Confirm("Create component?", false)
+	require.NoError(t, err)
+	assert.False(t, got)
+}
+
+func TestPrompter_EndOfInput(t *testing.T) {
+	p := NewPrompter(strings.NewReader("last-line"), &bytes.Buffer{})
+
+	got, err := p.Input("Name", "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "last-line", got)
+
+	_, err = p.Input("Name", "", nil)
+	assert.ErrorIs(t, err, ErrPromptAborted)
+}
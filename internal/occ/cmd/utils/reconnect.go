@@ -0,0 +1,67 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	defaultReconnectDelay    = time.Second
+	defaultMaxReconnectDelay = 30 * time.Second
+)
+
+// Reconnector paces reconnection attempts while following a stream, such as
+// logs. Each consecutive failure doubles the delay up to a cap; a success
+// resets it.
+type Reconnector struct {
+	initial  time.Duration
+	max      time.Duration
+	failures int
+	out      io.Writer
+	// subject names what is followed in the progress messages.
+	subject string
+}
+
+// NewReconnector returns a Reconnector that reports its progress following
+// subject to out.
+func NewReconnector(out io.Writer, subject string) *Reconnector {
+	return &Reconnector{initial: defaultReconnectDelay, max: defaultMaxReconnectDelay, out: out, subject: subject}
+}
+
+// Failed reports err and waits before the next attempt. It returns false when
+// ctx is cancelled while waiting.
+func (r *Reconnector) Failed(ctx context.Context, err error) bool {
+	delay := r.nextDelay()
+	r.failures++
+	fmt.Fprintf(r.out, "Lost connection while following %s: %v (reconnecting in %s)\n", r.subject, err, delay)
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// Succeeded resets the delay, announcing the reconnection after a failure.
+func (r *Reconnector) Succeeded() {
+	if r.failures > 0 {
+		fmt.Fprintf(r.out, "Reconnected, resuming %s\n", r.subject)
+	}
+	r.failures = 0
+}
+
+func (r *Reconnector) nextDelay() time.Duration {
+	delay := r.initial
+	for i := 0; i < r.failures && delay < r.max; i++ {
+		delay *= 2
+	}
+	return min(delay, r.max)
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconnector_DelayDoublesUpToMax(t *testing.T) {
+	r := &Reconnector{initial: time.Second, max: 5 * time.Second}
+
+	var delays []time.Duration
+	for range 5 {
+		delays = append(delays, r.nextDelay())
+		r.failures++
+	}
+
+	assert.Equal(t, []time.Duration{
+		time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second,
+	}, delays)
+}
+
+func TestReconnector_FailedAndSucceeded(t *testing.T) {
+	var out bytes.Buffer
+	r := &Reconnector{initial: time.Millisecond, max: time.Millisecond, out: &out, subject: "logs"}
+
+	assert.True(t, r.Failed(context.Background(), errors.New("connection refused")))
+	assert.Contains(t, out.String(), "while following logs: connection refused")
+	assert.Contains(t, out.String(), "reconnecting in 1ms")
+
+	r.Succeeded()
+	assert.Contains(t, out.String(), "Reconnected, resuming logs")
+	assert.Equal(t, 0, r.failures)
+
+	out.Reset()
+	r.Succeeded()
+	assert.Empty(t, out.String())
+}
+
+func TestReconnector_FailedStopsOnCancel(t *testing.T) {
+	var out bytes.Buffer
+	r := &Reconnector{initial: time.Hour, max: time.Hour, out: &out}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.False(t, r.Failed(ctx, errors.New("timeout")))
+}
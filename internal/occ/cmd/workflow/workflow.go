@@ -11,13 +11,12 @@ import (
 	"text/tabwriter"
 	"time"
 
-	"sigs.k8s.io/yaml"
-
 	"github.com/openchoreo/openchoreo/internal/labels"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/setoverride"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
 	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/printer"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 )
@@ -44,6 +43,9 @@ func (w *Workflow) List(params ListParams) error {
 	}
 
 	// Show all workflows (no filtering)
+	if params.Output != "" {
+		return printer.PrintList(os.Stdout, items, params.Output)
+	}
 	return printList(items)
 }
 
@@ -69,7 +71,7 @@ func (w *Workflow) fetchWorkflows(namespace string) ([]gen.Workflow, error) {
 	})
 }
 
-// Get retrieves a single workflow and outputs it as YAML
+// Get retrieves a single workflow and prints it in the requested output format, YAML by default
 func (w *Workflow) Get(params GetParams) error {
 	if params.Namespace == "" {
 		return fmt.Errorf("namespace is required")
@@ -85,13 +87,7 @@ func (w *Workflow) Get(params GetParams) error {
 		return err
 	}
 
-	data, err := yaml.Marshal(result)
-	if err != nil {
-		return fmt.Errorf("failed to marshal workflow to YAML: %w", err)
-	}
-
-	fmt.Print(string(data))
-	return nil
+	return printer.Print(os.Stdout, result, params.Output)
 }
 
 // Delete deletes a single workflow
@@ -6,6 +6,7 @@ package workflowplane
 // ListParams defines parameters for listing workflow planes
 type ListParams struct {
 	Namespace string
+	Output    string // --output format
 }
 
 func (p ListParams) GetNamespace() string { return p.Namespace }
@@ -14,6 +15,7 @@ func (p ListParams) GetNamespace() string { return p.Namespace }
 type GetParams struct {
 	Namespace         string
 	WorkflowPlaneName string
+	Output            string // --output format
 }
 
 func (p GetParams) GetNamespace() string { return p.Namespace }
@@ -45,11 +45,13 @@ func newListCmd(f client.NewClientFunc) *cobra.Command {
 			return New(cl).List(ListParams{
 				Namespace: ns,
 				Workflow:  wf,
+				Output:    flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
 	cmd.Flags().String("workflow", "", "Namespace-scoped Workflow name")
+	flags.AddListOutputFormat(cmd)
 	return cmd
 }
 
@@ -57,7 +59,7 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get [WORKFLOW_RUN_NAME]",
 		Short: "Get a workflow run",
-		Long:  `Get a workflow run and display its details in YAML format.`,
+		Long:  `Get a workflow run and display its details in YAML, or in the format set by --output.`,
 		Example: `  # Get a workflow run
   occ workflowrun get my-run --namespace acme-corp`,
 		Args:    cmdutil.ExactOneArgWithUsage(),
@@ -70,10 +72,12 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 			return New(cl).Get(GetParams{
 				Namespace:       flags.GetNamespace(cmd),
 				WorkflowRunName: args[0],
+				Output:          flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
+	flags.AddGetOutputFormat(cmd)
 	return cmd
 }
 
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
 	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
@@ -61,35 +62,38 @@ func (w *WorkflowRun) fetchLiveLogs(ctx context.Context, apiClient client.Interf
 		return w.followLiveLogs(ctx, apiClient, params, sinceSeconds)
 	}
 
-	logParams := &gen.GetWorkflowRunLogsParams{}
-	if sinceSeconds > 0 {
-		logParams.SinceSeconds = &sinceSeconds
-	}
-
-	entries, err := apiClient.GetWorkflowRunLogs(ctx, params.Namespace, params.WorkflowRunName, logParams)
+	entries, err := apiClient.GetWorkflowRunLogs(ctx, params.Namespace, params.WorkflowRunName, liveLogsParams(params, sinceSeconds))
 	if err != nil {
 		return fmt.Errorf("failed to get live logs: %w", err)
 	}
 
-	printLogEntries(entries)
+	printLogEntries(tailEntries(entries, params.Tail))
 	return nil
 }
 
+// liveLogsParams builds the live log query for the given window and the optional task filter
+func liveLogsParams(params LogsParams, sinceSeconds int64) *gen.GetWorkflowRunLogsParams {
+	logParams := &gen.GetWorkflowRunLogsParams{}
+	if sinceSeconds > 0 {
+		logParams.SinceSeconds = &sinceSeconds
+	}
+	if params.Task != "" {
+		logParams.Task = &params.Task
+	}
+	return logParams
+}
+
 // followLiveLogs continuously polls for new live logs
 func (w *WorkflowRun) followLiveLogs(ctx context.Context, apiClient client.Interface, params LogsParams, sinceSeconds int64) error {
 	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
 	// Initial fetch
-	logParams := &gen.GetWorkflowRunLogsParams{}
-	if sinceSeconds > 0 {
-		logParams.SinceSeconds = &sinceSeconds
-	}
-
-	entries, err := apiClient.GetWorkflowRunLogs(ctx, params.Namespace, params.WorkflowRunName, logParams)
+	entries, err := apiClient.GetWorkflowRunLogs(ctx, params.Namespace, params.WorkflowRunName, liveLogsParams(params, sinceSeconds))
 	if err != nil {
 		return fmt.Errorf("failed to get live logs: %w", err)
 	}
+	entries = tailEntries(entries, params.Tail)
 	printLogEntries(entries)
 
 	// Track the last-seen timestamp to avoid printing duplicates
@@ -100,11 +104,15 @@ func (w *WorkflowRun) followLiveLogs(ctx context.Context, apiClient client.Inter
 		}
 	}
 
-	// Poll with a window large enough to not miss logs, deduplicate client-side
+	// Poll with a window large enough to not miss logs, deduplicate client-side.
+	// While the API is unreachable, attempts back off; the window is widened on
+	// reconnect to cover the time spent disconnected.
 	ticker := time.NewTicker(2 * time.Second)
 	defer ticker.Stop()
+	reconnector := utils.NewReconnector(os.Stderr, "logs")
 
 	pollInterval := int64(5)
+	lastPoll := time.Now()
 
 	for {
 		select {
@@ -115,10 +123,9 @@ func (w *WorkflowRun) followLiveLogs(ctx context.Context, apiClient client.Inter
 			// Check if the run still has live observability
 			status, err := apiClient.GetWorkflowRunStatus(ctx, params.Namespace, params.WorkflowRunName)
 			if err != nil {
-				if ctx.Err() != nil {
+				if ctx.Err() != nil || !reconnector.Failed(ctx, fmt.Errorf("failed to check workflow run status: %w", err)) {
 					return nil
 				}
-				fmt.Fprintf(os.Stderr, "Error checking workflow run status: %v\n", err)
 				continue
 			}
 
@@ -127,17 +134,16 @@ func (w *WorkflowRun) followLiveLogs(ctx context.Context, apiClient client.Inter
 				return nil
 			}
 
-			p := &gen.GetWorkflowRunLogsParams{
-				SinceSeconds: &pollInterval,
-			}
-			entries, err := apiClient.GetWorkflowRunLogs(ctx, params.Namespace, params.WorkflowRunName, p)
+			window := int64(time.Since(lastPoll).Seconds()) + pollInterval
+			entries, err := apiClient.GetWorkflowRunLogs(ctx, params.Namespace, params.WorkflowRunName, liveLogsParams(params, window))
 			if err != nil {
-				if ctx.Err() != nil {
+				if ctx.Err() != nil || !reconnector.Failed(ctx, err) {
 					return nil
 				}
-				fmt.Fprintf(os.Stderr, "Error fetching logs: %v\n", err)
 				continue
 			}
+			reconnector.Succeeded()
+			lastPoll = time.Now()
 
 			// Filter out entries already printed
 			newEntries := filterNewEntries(entries, lastSeen)
@@ -217,6 +223,7 @@ func (w *WorkflowRun) fetchArchivedLogs(ctx context.Context, apiClient client.In
 		StartTime:     startTime.Format(time.RFC3339),
 		EndTime:       endTime.Format(time.RFC3339),
 		SortOrder:     "asc",
+		TaskName:      params.Task,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to fetch archived logs from observer %s: %w", observerURL, err)
@@ -227,7 +234,7 @@ func (w *WorkflowRun) fetchArchivedLogs(ctx context.Context, apiClient client.In
 		return nil
 	}
 
-	for _, log := range logResponse.Logs {
+	for _, log := range tailEntries(logResponse.Logs, params.Tail) {
 		fmt.Printf("%s %s\n", log.Timestamp, log.Log)
 	}
 
@@ -323,6 +330,14 @@ func resolveObserverURLFromObsRef(ctx context.Context, apiClient client.Interfac
 	return "", fmt.Errorf("no observer URL configured: could not find an observability plane with a configured observer URL")
 }
 
+// tailEntries returns the last n entries, or all of them when n is not positive
+func tailEntries[T any](entries []T, n int) []T {
+	if n <= 0 || len(entries) <= n {
+		return entries
+	}
+	return entries[len(entries)-n:]
+}
+
 // printLogEntries prints workflow run log entries to stdout
 func printLogEntries(entries []gen.WorkflowRunLogEntry) {
 	for _, entry := range entries {
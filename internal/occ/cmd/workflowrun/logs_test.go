@@ -180,6 +180,35 @@ func TestLogs_LiveLogs_WithSince(t *testing.T) {
 	assert.Contains(t, out, "recent")
 }
 
+func TestLogs_LiveLogs_WithTaskAndTail(t *testing.T) {
+	now := time.Now()
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetWorkflowRunStatus(mock.Anything, "ns", "run-1").Return(
+		&gen.WorkflowRunStatusResponse{HasLiveObservability: true}, nil)
+	mc.EXPECT().GetWorkflowRunLogs(mock.Anything, "ns", "run-1", mock.MatchedBy(func(p *gen.GetWorkflowRunLogsParams) bool {
+		return p != nil && p.Task != nil && *p.Task == "build-image"
+	})).Return([]gen.WorkflowRunLogEntry{
+		{Timestamp: &now, Log: "line one"},
+		{Timestamp: &now, Log: "line two"},
+		{Timestamp: &now, Log: "line three"},
+	}, nil)
+
+	wr := New(mc)
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, wr.Logs(LogsParams{Namespace: "ns", WorkflowRunName: "run-1", Task: "build-image", Tail: 2}))
+	})
+	assert.NotContains(t, out, "line one")
+	assert.Contains(t, out, "line two")
+	assert.Contains(t, out, "line three")
+}
+
+func TestTailEntries(t *testing.T) {
+	entries := []string{"a", "b", "c"}
+	assert.Equal(t, entries, tailEntries(entries, 0))
+	assert.Equal(t, entries, tailEntries(entries, 5))
+	assert.Equal(t, []string{"b", "c"}, tailEntries(entries, 2))
+}
+
 // --- resolveObserverURL ---
 
 func TestResolveObserverURL_ViaWorkflowPlane(t *testing.T) {
@@ -451,6 +480,30 @@ func TestFollowLiveLogs_PollFetchError_ThenCancel(t *testing.T) {
 	require.NoError(t, wr.followLiveLogs(ctx, mc, LogsParams{Namespace: "ns", WorkflowRunName: "run-1"}, 0))
 }
 
+func TestFollowLiveLogs_ReconnectsAfterFetchError(t *testing.T) {
+	now := time.Now()
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetWorkflowRunLogs(mock.Anything, "ns", "run-1", mock.Anything).Return(
+		[]gen.WorkflowRunLogEntry{}, nil).Once()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	mc.EXPECT().GetWorkflowRunStatus(mock.Anything, "ns", "run-1").Return(
+		&gen.WorkflowRunStatusResponse{HasLiveObservability: true}, nil).Twice()
+	mc.EXPECT().GetWorkflowRunLogs(mock.Anything, "ns", "run-1", mock.Anything).Return(
+		nil, fmt.Errorf("connection reset")).Once()
+	mc.EXPECT().GetWorkflowRunLogs(mock.Anything, "ns", "run-1", mock.Anything).RunAndReturn(
+		func(_ context.Context, _, _ string, _ *gen.GetWorkflowRunLogsParams) ([]gen.WorkflowRunLogEntry, error) {
+			cancel()
+			return []gen.WorkflowRunLogEntry{{Timestamp: &now, Log: "after reconnect"}}, nil
+		}).Once()
+
+	wr := New(mc)
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, wr.followLiveLogs(ctx, mc, LogsParams{Namespace: "ns", WorkflowRunName: "run-1"}, 0))
+	})
+	assert.Contains(t, out, "after reconnect")
+}
+
 func TestParseSinceToSeconds(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -608,6 +661,34 @@ func TestFetchArchivedLogs_WithSince(t *testing.T) {
 	assert.Contains(t, out, "recent log")
 }
 
+func TestFetchArchivedLogs_WithTask(t *testing.T) {
+	setupArchivedLogsConfig(t)
+
+	observerURL := observerTestURL
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetWorkflowRunStatus(mock.Anything, "ns", "run-1").Return(
+		&gen.WorkflowRunStatusResponse{HasLiveObservability: false}, nil)
+	setupMockForArchivedLogs(t, mc, observerURL)
+
+	testutil.SetTransport(t, testutil.RoundTripFunc(func(r *http.Request) (*http.Response, error) {
+		var body map[string]any
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		scope, ok := body["searchScope"].(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, "build-image", scope["taskName"])
+		return testutil.JSONResp(http.StatusOK, client.LogResponse{
+			Logs: []client.LogEntry{{Timestamp: "2026-01-01T00:00:00Z", Log: "task log"}},
+		}), nil
+	}))
+
+	wr := New(mc)
+	out := testutil.CaptureStdout(t, func() {
+		require.NoError(t, wr.Logs(LogsParams{Namespace: "ns", WorkflowRunName: "run-1", Task: "build-image"}))
+	})
+	assert.Contains(t, out, "task log")
+}
+
 func TestFetchArchivedLogs_GetWorkflowRunError(t *testing.T) {
 	setupArchivedLogsConfig(t)
 
@@ -7,6 +7,7 @@ package workflowrun
 type ListParams struct {
 	Namespace string
 	Workflow  string
+	Output    string // --output format
 }
 
 func (p ListParams) GetNamespace() string { return p.Namespace }
@@ -15,6 +16,7 @@ func (p ListParams) GetNamespace() string { return p.Namespace }
 type GetParams struct {
 	Namespace       string
 	WorkflowRunName string
+	Output          string // --output format
 }
 
 func (p GetParams) GetNamespace() string { return p.Namespace }
@@ -25,6 +27,8 @@ type LogsParams struct {
 	WorkflowRunName string
 	Follow          bool
 	Since           string
+	Tail            int    // number of lines to show from the end of logs (0 means no limit)
+	Task            string // optional task (step) name; empty means all tasks
 }
 
 func (p LogsParams) GetNamespace() string { return p.Namespace }
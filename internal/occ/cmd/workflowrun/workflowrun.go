@@ -9,11 +9,10 @@ import (
 	"os"
 	"text/tabwriter"
 
-	"sigs.k8s.io/yaml"
-
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/utils"
 	"github.com/openchoreo/openchoreo/internal/occ/cmdutil"
+	"github.com/openchoreo/openchoreo/internal/occ/printer"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 )
@@ -46,6 +45,9 @@ func (w *WorkflowRun) List(params ListParams) error {
 		return err
 	}
 
+	if params.Output != "" {
+		return printer.PrintList(os.Stdout, items, params.Output)
+	}
 	return PrintList(items)
 }
 
@@ -105,7 +107,7 @@ func getComponentLabel(run gen.WorkflowRun) string {
 	return (*run.Metadata.Labels)[componentLabel]
 }
 
-// Get retrieves a single workflow run and outputs it as YAML
+// Get retrieves a single workflow run and prints it in the requested output format, YAML by default
 func (w *WorkflowRun) Get(params GetParams) error {
 	if err := cmdutil.RequireFields("get", "workflowrun", map[string]string{"namespace": params.Namespace}); err != nil {
 		return err
@@ -118,13 +120,7 @@ func (w *WorkflowRun) Get(params GetParams) error {
 		return err
 	}
 
-	data, err := yaml.Marshal(result)
-	if err != nil {
-		return fmt.Errorf("failed to marshal workflow run to YAML: %w", err)
-	}
-
-	fmt.Print(string(data))
-	return nil
+	return printer.Print(os.Stdout, result, params.Output)
 }
 
 func PrintList(items []gen.WorkflowRun) error {
@@ -147,7 +143,7 @@ func PrintList(items []gen.WorkflowRun) error {
 		}
 		status := "Pending"
 		if run.Status != nil && run.Status.Conditions != nil {
-			status = deriveStatus(*run.Status.Conditions)
+			status = DeriveStatus(*run.Status.Conditions)
 		}
 		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
 			run.Metadata.Name,
@@ -159,10 +155,10 @@ func PrintList(items []gen.WorkflowRun) error {
 	return w.Flush()
 }
 
-// deriveStatus maps WorkflowRun conditions to a human-readable status string.
+// DeriveStatus maps WorkflowRun conditions to a human-readable status string.
 // The controller sets WorkflowCompleted, WorkflowRunning, WorkflowSucceeded, and
 // WorkflowFailed conditions — there is no "Ready" condition.
-func deriveStatus(conditions []gen.Condition) string {
+func DeriveStatus(conditions []gen.Condition) string {
 	conds := make(map[string]gen.Condition, len(conditions))
 	for _, c := range conditions {
 		conds[c.Type] = c
@@ -137,7 +137,7 @@ func TestDeriveStatus(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.want, deriveStatus(tt.conditions))
+			assert.Equal(t, tt.want, DeriveStatus(tt.conditions))
 		})
 	}
 }
@@ -88,10 +88,12 @@ func newListCmd(f client.NewClientFunc) *cobra.Command {
 			}
 			return New(cl).List(ListParams{
 				Namespace: flags.GetNamespace(cmd),
+				Output:    flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
+	flags.AddListOutputFormat(cmd)
 	return cmd
 }
 
@@ -99,7 +101,7 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get [WORKLOAD_NAME]",
 		Short: "Get a workload",
-		Long:  `Get a workload and display its details in YAML format.`,
+		Long:  `Get a workload and display its details in YAML, or in the format set by --output.`,
 		Example: `  # Get a workload
   occ workload get my-workload --namespace acme-corp`,
 		Args:    cmdutil.ExactOneArgWithUsage(),
@@ -112,10 +114,12 @@ func newGetCmd(f client.NewClientFunc) *cobra.Command {
 			return New(cl).Get(GetParams{
 				Namespace:    flags.GetNamespace(cmd),
 				WorkloadName: args[0],
+				Output:       flags.GetOutputFormat(cmd),
 			})
 		},
 	}
 	flags.AddNamespace(cmd)
+	flags.AddGetOutputFormat(cmd)
 	return cmd
 }
 
@@ -21,6 +21,7 @@ func (p CreateParams) GetNamespace() string { return p.NamespaceName }
 // ListParams defines parameters for listing workloads
 type ListParams struct {
 	Namespace string
+	Output    string // --output format
 }
 
 func (p ListParams) GetNamespace() string { return p.Namespace }
@@ -29,6 +30,7 @@ func (p ListParams) GetNamespace() string { return p.Namespace }
 type GetParams struct {
 	Namespace    string
 	WorkloadName string
+	Output       string // --output format
 }
 
 func (p GetParams) GetNamespace() string { return p.Namespace }
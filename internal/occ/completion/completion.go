@@ -0,0 +1,250 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package completion provides shell completion functions that complete kinds
+// and resource names by querying the OpenChoreo API.
+package completion
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/pagination"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+)
+
+// Register adds completions to every command under root: resource names for
+// the --namespace, --project, --component and --env flags, and the name
+// argument of resource commands such as "occ environment get [ENVIRONMENT_NAME]",
+// whose kind is the name of the command group.
+func Register(root *cobra.Command, f client.NewClientFunc) {
+	walk(root, func(cmd *cobra.Command) {
+		registerFlag(cmd, "namespace", namespaces(f))
+		registerFlag(cmd, "project", namespaced(f, "projects", ""))
+		registerFlag(cmd, "component", namespaced(f, "components", "project"))
+		registerFlag(cmd, "env", namespaced(f, "environments", ""))
+
+		if cmd.ValidArgsFunction == nil && cmd.HasParent() && cmd.Parent().Parent() == root &&
+			nameArgument(cmd.Use) == strings.ToLower(cmd.Parent().Name()) {
+			cmd.ValidArgsFunction = Names(f, cmd.Parent().Name())
+		}
+	})
+}
+
+// Kinds completes the plural name of every kind served by the API.
+func Kinds(f client.NewClientFunc) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return kinds(cmd, f), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// KindAndName completes a kind as the first argument and the name of a
+// resource of that kind as the second.
+func KindAndName(f client.NewClientFunc) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		switch len(args) {
+		case 0:
+			return kinds(cmd, f), cobra.ShellCompDirectiveNoFileComp
+		case 1:
+			return names(cmd, f, args[0]), cobra.ShellCompDirectiveNoFileComp
+		}
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// Names completes the first argument with the names of the resources of kind.
+func Names(f client.NewClientFunc, kind string) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return names(cmd, f, kind), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// KindReference completes the first argument as KIND/NAME with the names of
+// the resources of kind, as used by "occ status component/NAME".
+func KindReference(f client.NewClientFunc, kind string) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		refs := names(cmd, f, kind)
+		for i, name := range refs {
+			refs[i] = kind + "/" + name
+		}
+		return refs, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+func walk(cmd *cobra.Command, fn func(*cobra.Command)) {
+	fn(cmd)
+	for _, sub := range cmd.Commands() {
+		walk(sub, fn)
+	}
+}
+
+func registerFlag(cmd *cobra.Command, name string, fn cobra.CompletionFunc) {
+	if cmd.Flags().Lookup(name) == nil {
+		return
+	}
+	if err := cmd.RegisterFlagCompletionFunc(name, fn); err != nil {
+		cobra.CompDebugln(err.Error(), true)
+	}
+}
+
+// nameArgument returns the kind named by the single [KIND_NAME] argument of a
+// usage line, lowercased without underscores, or "" without one.
+func nameArgument(use string) string {
+	fields := strings.Fields(use)
+	if len(fields) != 2 {
+		return ""
+	}
+	arg, ok := strings.CutSuffix(strings.Trim(fields[1], "[]"), "_NAME")
+	if !ok || arg == "" {
+		return ""
+	}
+	return strings.ToLower(strings.ReplaceAll(arg, "_", ""))
+}
+
+func kinds(cmd *cobra.Command, f client.NewClientFunc) []cobra.Completion {
+	ctx, cl, ok := connect(cmd, f)
+	if !ok {
+		return nil
+	}
+	list, err := cl.ListKinds(ctx)
+	if err != nil {
+		cobra.CompDebugln(err.Error(), true)
+		return nil
+	}
+	completions := make([]cobra.Completion, 0, len(list))
+	for _, k := range list {
+		completions = append(completions, k.Plural)
+	}
+	return completions
+}
+
+// names lists the names of the resources of kind, in the namespace of the
+// --namespace flag or of the current context for namespaced kinds.
+func names(cmd *cobra.Command, f client.NewClientFunc, kind string) []cobra.Completion {
+	ctx, cl, ok := connect(cmd, f)
+	if !ok {
+		return nil
+	}
+	pc, err := cl.GetPrinterColumns(ctx, kind)
+	if err != nil {
+		cobra.CompDebugln(err.Error(), true)
+		return nil
+	}
+	namespace := ""
+	if pc.Namespaced {
+		if namespace = currentNamespace(cmd); namespace == "" {
+			return nil
+		}
+	}
+	return list(ctx, cl, client.CollectionPath(pc, namespace), "")
+}
+
+func namespaces(f client.NewClientFunc) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		ctx, cl, ok := connect(cmd, f)
+		if !ok {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return list(ctx, cl, "/api/v1/namespaces", ""), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// namespaced completes the names of a collection in the current namespace.
+// With ownerFlag set, resources are limited to the project of that flag.
+func namespaced(f client.NewClientFunc, collection, ownerFlag string) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		ctx, cl, ok := connect(cmd, f)
+		if !ok {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		namespace := currentNamespace(cmd)
+		if namespace == "" {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		project := ""
+		if ownerFlag != "" {
+			project, _ = cmd.Flags().GetString(ownerFlag)
+		}
+		path := "/api/v1/namespaces/" + url.PathEscape(namespace) + "/" + collection
+		return list(ctx, cl, path, project), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// list returns the sorted names of the resources of the collection at path,
+// limited to those owned by project when set.
+func list(ctx context.Context, cl client.Interface, path, project string) []cobra.Completion {
+	items, err := pagination.FetchAll(func(limit int, cursor string) ([]map[string]any, string, error) {
+		return cl.ListObjects(ctx, path, limit, cursor)
+	})
+	if err != nil {
+		cobra.CompDebugln(err.Error(), true)
+		return nil
+	}
+	completions := make([]cobra.Completion, 0, len(items))
+	for _, item := range items {
+		if project != "" && stringAt(item, "spec", "owner", "projectName") != project {
+			continue
+		}
+		if name := stringAt(item, "metadata", "name"); name != "" {
+			completions = append(completions, name)
+		}
+	}
+	sort.Strings(completions)
+	return completions
+}
+
+// connect creates a client for the context given with --context, or the
+// current context. The persistent pre-run that applies --context does not run
+// for completion requests.
+func connect(cmd *cobra.Command, f client.NewClientFunc) (context.Context, client.Interface, bool) {
+	if err := config.ApplyContextOverride(cmd); err != nil {
+		cobra.CompDebugln(err.Error(), true)
+		return nil, nil, false
+	}
+	cl, err := f()
+	if err != nil {
+		cobra.CompDebugln(err.Error(), true)
+		return nil, nil, false
+	}
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return ctx, cl, true
+}
+
+// currentNamespace returns the --namespace flag, or the namespace of the
+// current context. Context defaults are not applied to completion requests.
+func currentNamespace(cmd *cobra.Command) string {
+	if ns, _ := cmd.Flags().GetString("namespace"); ns != "" {
+		return ns
+	}
+	return config.DefaultNamespace()
+}
+
+func stringAt(obj map[string]any, keys ...string) string {
+	var v any = obj
+	for _, key := range keys {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return ""
+		}
+		v = m[key]
+	}
+	s, _ := v.(string)
+	return s
+}
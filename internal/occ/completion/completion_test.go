@@ -0,0 +1,140 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package completion
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
+	"github.com/openchoreo/openchoreo/internal/occ/resources/client/mocks"
+	"github.com/openchoreo/openchoreo/internal/occ/testutil"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+func setupContext(t *testing.T) {
+	t.Helper()
+	home := testutil.SetupTestHome(t)
+	testutil.WriteOCConfig(t, home, config.StoredConfig{
+		CurrentContext: "test",
+		Contexts:       []config.Context{{Name: "test", Namespace: "acme"}},
+	})
+}
+
+func clientFunc(mc client.Interface) client.NewClientFunc {
+	return func() (client.Interface, error) { return mc, nil }
+}
+
+func object(name, project string) map[string]any {
+	return map[string]any{
+		"metadata": map[string]any{"name": name},
+		"spec":     map[string]any{"owner": map[string]any{"projectName": project}},
+	}
+}
+
+func TestRegister(t *testing.T) {
+	setupContext(t)
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetPrinterColumns(mock.Anything, "environment").
+		Return(&gen.PrinterColumns{Kind: "Environment", Plural: "environments", Namespaced: true}, nil)
+	mc.EXPECT().ListObjects(mock.Anything, "/api/v1/namespaces/acme/environments", mock.Anything, "").
+		Return([]map[string]any{object("staging", ""), object("dev", "")}, "", nil)
+
+	root := &cobra.Command{Use: "occ"}
+	group := &cobra.Command{Use: "environment"}
+	get := &cobra.Command{Use: "get [ENVIRONMENT_NAME]"}
+	list := &cobra.Command{Use: "list"}
+	flags.AddNamespace(list)
+	group.AddCommand(get, list)
+	root.AddCommand(group)
+
+	Register(root, clientFunc(mc))
+
+	assert.Nil(t, list.ValidArgsFunction)
+	names, directive := get.ValidArgsFunction(get, nil, "")
+	assert.Equal(t, []cobra.Completion{"dev", "staging"}, names)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+
+	_, ok := list.GetFlagCompletionFunc("namespace")
+	assert.True(t, ok)
+}
+
+func TestKindAndName(t *testing.T) {
+	setupContext(t)
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().ListKinds(mock.Anything).Return([]gen.PrinterColumns{
+		{Kind: "ClusterDataPlane", Plural: "clusterdataplanes"},
+		{Kind: "Component", Plural: "components", Namespaced: true},
+	}, nil)
+	mc.EXPECT().GetPrinterColumns(mock.Anything, "clusterdataplane").
+		Return(&gen.PrinterColumns{Kind: "ClusterDataPlane", Plural: "clusterdataplanes"}, nil)
+	mc.EXPECT().ListObjects(mock.Anything, "/api/v1/clusterdataplanes", mock.Anything, "").
+		Return([]map[string]any{object("default", "")}, "", nil)
+
+	fn := KindAndName(clientFunc(mc))
+	cmd := &cobra.Command{Use: "get"}
+
+	kinds, _ := fn(cmd, nil, "")
+	assert.Equal(t, []cobra.Completion{"clusterdataplanes", "components"}, kinds)
+	names, _ := fn(cmd, []string{"clusterdataplane"}, "")
+	assert.Equal(t, []cobra.Completion{"default"}, names)
+	none, _ := fn(cmd, []string{"clusterdataplane", "default"}, "")
+	assert.Empty(t, none)
+}
+
+func TestComponentFlag_FiltersByProject(t *testing.T) {
+	setupContext(t)
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().ListObjects(mock.Anything, "/api/v1/namespaces/shop/components", mock.Anything, "").
+		Return([]map[string]any{object("orders", "store"), object("billing", "finance")}, "", nil)
+
+	cmd := &cobra.Command{Use: "logs"}
+	flags.AddNamespace(cmd)
+	flags.AddProject(cmd)
+	flags.AddComponent(cmd)
+	Register(cmd, clientFunc(mc))
+	_ = cmd.Flags().Set("namespace", "shop")
+	_ = cmd.Flags().Set("project", "store")
+
+	fn, ok := cmd.GetFlagCompletionFunc("component")
+	assert.True(t, ok)
+	names, _ := fn(cmd, nil, "")
+	assert.Equal(t, []cobra.Completion{"orders"}, names)
+}
+
+func TestKindReference(t *testing.T) {
+	setupContext(t)
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetPrinterColumns(mock.Anything, "component").
+		Return(&gen.PrinterColumns{Kind: "Component", Plural: "components", Namespaced: true}, nil)
+	mc.EXPECT().ListObjects(mock.Anything, "/api/v1/namespaces/acme/components", mock.Anything, "").
+		Return([]map[string]any{object("orders", "store")}, "", nil)
+
+	refs, _ := KindReference(clientFunc(mc), "component")(&cobra.Command{Use: "status"}, nil, "")
+	assert.Equal(t, []cobra.Completion{"component/orders"}, refs)
+}
+
+func TestNames_APIError(t *testing.T) {
+	setupContext(t)
+	mc := mocks.NewMockInterface(t)
+	mc.EXPECT().GetPrinterColumns(mock.Anything, "secret").Return(nil, errors.New("not found"))
+
+	names, directive := Names(clientFunc(mc), "secret")(&cobra.Command{Use: "get"}, nil, "")
+	assert.Empty(t, names)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+}
+
+func TestNameArgument(t *testing.T) {
+	assert.Equal(t, "componentrelease", nameArgument("get [COMPONENT_RELEASE_NAME]"))
+	assert.Equal(t, "dataplane", nameArgument("delete [DATAPLANE_NAME]"))
+	assert.Empty(t, nameArgument("list"))
+	assert.Empty(t, nameArgument("get KIND [NAME]"))
+	assert.Empty(t, nameArgument("logs TYPE/NAME"))
+}
@@ -5,7 +5,16 @@
 // used CLI flags across occ subcommands.
 package flags
 
-import "github.com/spf13/cobra"
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openchoreo/openchoreo/internal/occ/printer"
+)
 
 // Mode constants for the --mode flag.
 const (
@@ -101,6 +110,17 @@ func GetTail(cmd *cobra.Command) int {
 	return val
 }
 
+// --- Container ---
+
+func AddContainer(cmd *cobra.Command) {
+	cmd.Flags().StringP("container", "c", "", "Only show logs of the named container (the task for builds)")
+}
+
+func GetContainer(cmd *cobra.Command) string {
+	val, _ := cmd.Flags().GetString("container")
+	return val
+}
+
 // --- Mode ---
 
 func AddMode(cmd *cobra.Command) {
@@ -211,6 +231,56 @@ func GetOutputFile(cmd *cobra.Command) string {
 	return val
 }
 
+// --- OutputFormat (-o shorthand) ---
+
+// outputFormat is the value of --output. It is checked when the flag is
+// parsed, so a malformed template fails before any request is made.
+type outputFormat struct {
+	value string
+	extra []string // formats of the command besides those of the printer package
+}
+
+func (f *outputFormat) String() string { return f.value }
+
+func (f *outputFormat) Set(value string) error {
+	if !slices.Contains(f.extra, value) {
+		if err := printer.Validate(value); err != nil {
+			if errors.Is(err, printer.ErrUnsupportedFormat) {
+				return fmt.Errorf("must be one of %s", strings.Join(append(slices.Clone(f.extra), printer.Formats...), ", "))
+			}
+			return err
+		}
+	}
+	f.value = value
+	return nil
+}
+
+// Type is string so that the flag is read with GetString.
+func (f *outputFormat) Type() string { return "string" }
+
+// AddOutputFormat adds --output to commands that print a table with a wide variant.
+func AddOutputFormat(cmd *cobra.Command) {
+	cmd.Flags().VarP(&outputFormat{extra: []string{"wide"}}, "output", "o",
+		"Output format: wide, json, yaml, jsonpath=TEMPLATE or go-template=TEMPLATE (defaults to a table)")
+}
+
+// AddListOutputFormat adds --output to list commands, which print a table by default.
+func AddListOutputFormat(cmd *cobra.Command) {
+	cmd.Flags().VarP(&outputFormat{}, "output", "o",
+		"Output format: json, yaml, jsonpath=TEMPLATE or go-template=TEMPLATE (defaults to a table)")
+}
+
+// AddGetOutputFormat adds --output to get commands, which print YAML by default.
+func AddGetOutputFormat(cmd *cobra.Command) {
+	cmd.Flags().VarP(&outputFormat{}, "output", "o",
+		"Output format: json, yaml, jsonpath=TEMPLATE or go-template=TEMPLATE (defaults to yaml)")
+}
+
+func GetOutputFormat(cmd *cobra.Command) string {
+	val, _ := cmd.Flags().GetString("output")
+	return val
+}
+
 // --- UsePipeline ---
 
 func AddUsePipeline(cmd *cobra.Command) {
@@ -244,6 +314,18 @@ func GetAll(cmd *cobra.Command) bool {
 	return val
 }
 
+// --- Context (global flag) ---
+
+// AddContext registers --context on cmd and all of its subcommands.
+func AddContext(cmd *cobra.Command) {
+	cmd.PersistentFlags().String("context", "", "Name of the config context to use for this command, instead of the current context")
+}
+
+func GetContext(cmd *cobra.Command) string {
+	val, _ := cmd.Flags().GetString("context")
+	return val
+}
+
 // --- ControlPlane (config context flag) ---
 
 func AddControlPlane(cmd *cobra.Command) {
@@ -8,6 +8,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func newTestCmd() *cobra.Command {
@@ -100,6 +101,24 @@ func TestSince_DefaultAndSet(t *testing.T) {
 	assert.Equal(t, "5m", GetSince(cmd))
 }
 
+func TestContainer_DefaultAndSet(t *testing.T) {
+	cmd := newTestCmd()
+	AddContainer(cmd)
+
+	assert.Equal(t, "", GetContainer(cmd))
+
+	_ = cmd.Flags().Set("container", "main")
+	assert.Equal(t, "main", GetContainer(cmd))
+}
+
+func TestContainer_HasShorthand(t *testing.T) {
+	cmd := newTestCmd()
+	AddContainer(cmd)
+
+	f := cmd.Flags().Lookup("container")
+	assert.Equal(t, "c", f.Shorthand)
+}
+
 func TestMode_DefaultAndSet(t *testing.T) {
 	cmd := newTestCmd()
 	AddMode(cmd)
@@ -204,6 +223,34 @@ func TestOutputFile_HasShorthand(t *testing.T) {
 	assert.Equal(t, "o", f.Shorthand)
 }
 
+func TestOutputFormat_DefaultAndSet(t *testing.T) {
+	cmd := newTestCmd()
+	AddOutputFormat(cmd)
+
+	assert.Equal(t, "", GetOutputFormat(cmd))
+	assert.Equal(t, "o", cmd.Flags().Lookup("output").Shorthand)
+
+	for _, format := range []string{"wide", "json", "jsonpath={.items[*].metadata.name}"} {
+		require.NoError(t, cmd.Flags().Set("output", format))
+		assert.Equal(t, format, GetOutputFormat(cmd))
+	}
+	assert.ErrorContains(t, cmd.Flags().Set("output", "xml"),
+		"must be one of wide, json, yaml, jsonpath=TEMPLATE, go-template=TEMPLATE")
+}
+
+func TestListAndGetOutputFormat_RejectInvalidFormats(t *testing.T) {
+	for _, add := range []func(*cobra.Command){AddListOutputFormat, AddGetOutputFormat} {
+		cmd := newTestCmd()
+		add(cmd)
+
+		require.NoError(t, cmd.Flags().Set("output", "go-template={{.metadata.name}}"))
+		assert.Equal(t, "go-template={{.metadata.name}}", GetOutputFormat(cmd))
+		assert.ErrorContains(t, cmd.Flags().Set("output", "wide"),
+			"must be one of json, yaml, jsonpath=TEMPLATE, go-template=TEMPLATE")
+		assert.ErrorContains(t, cmd.Flags().Set("output", "jsonpath={.metadata"), "invalid jsonpath template")
+	}
+}
+
 func TestUsePipeline_DefaultAndSet(t *testing.T) {
 	cmd := newTestCmd()
 	AddUsePipeline(cmd)
@@ -234,6 +281,17 @@ func TestControlPlane_DefaultAndSet(t *testing.T) {
 	assert.Equal(t, "cp-1", GetControlPlane(cmd))
 }
 
+func TestContext_InheritedBySubcommands(t *testing.T) {
+	root := newTestCmd()
+	AddContext(root)
+	child := &cobra.Command{Use: "child", Run: func(*cobra.Command, []string) {}}
+	root.AddCommand(child)
+
+	root.SetArgs([]string{"child", "--context", "prod"})
+	assert.NoError(t, root.Execute())
+	assert.Equal(t, "prod", GetContext(child))
+}
+
 func TestCredentials_DefaultAndSet(t *testing.T) {
 	cmd := newTestCmd()
 	AddCredentials(cmd)
@@ -0,0 +1,147 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package printer prints API objects in the machine-readable formats accepted
+// by the --output flag of get and list commands.
+package printer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/yaml"
+)
+
+// Output formats, with the prefixes of the formats that take a template.
+const (
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+
+	jsonPathPrefix   = "jsonpath="
+	goTemplatePrefix = "go-template="
+)
+
+// Formats are the formats Print accepts, as shown in help and error messages.
+var Formats = []string{FormatJSON, FormatYAML, "jsonpath=TEMPLATE", "go-template=TEMPLATE"}
+
+// ErrUnsupportedFormat is returned for formats that are none of Formats.
+var ErrUnsupportedFormat = errors.New("unsupported output format")
+
+// Validate checks that format is one of Formats and that its template, if
+// any, parses. The empty format is valid and selects the default output of
+// the command.
+func Validate(format string) error {
+	switch {
+	case format == "", format == FormatJSON, format == FormatYAML:
+		return nil
+	case strings.HasPrefix(format, jsonPathPrefix):
+		_, err := parseJSONPath(strings.TrimPrefix(format, jsonPathPrefix))
+		return err
+	case strings.HasPrefix(format, goTemplatePrefix):
+		_, err := parseGoTemplate(strings.TrimPrefix(format, goTemplatePrefix))
+		return err
+	}
+	return fmt.Errorf("%w %q: must be one of %s", ErrUnsupportedFormat, format, strings.Join(Formats, ", "))
+}
+
+// Print prints obj in format, or as YAML when format is empty. Templates see
+// obj as it is encoded in JSON, so fields are addressed by their JSON names,
+// such as {.metadata.name}.
+func Print(w io.Writer, obj any, format string) error {
+	data, err := toJSONValue(obj)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case format == "", format == FormatYAML:
+		out, err := yaml.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("failed to marshal to YAML: %w", err)
+		}
+		_, err = w.Write(out)
+		return err
+	case format == FormatJSON:
+		out, err := json.MarshalIndent(data, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal to JSON: %w", err)
+		}
+		_, err = fmt.Fprintln(w, string(out))
+		return err
+	case strings.HasPrefix(format, jsonPathPrefix):
+		jp, err := parseJSONPath(strings.TrimPrefix(format, jsonPathPrefix))
+		if err != nil {
+			return err
+		}
+		if err := jp.Execute(w, data); err != nil {
+			return fmt.Errorf("failed to execute jsonpath template: %w", err)
+		}
+		return nil
+	case strings.HasPrefix(format, goTemplatePrefix):
+		tmpl, err := parseGoTemplate(strings.TrimPrefix(format, goTemplatePrefix))
+		if err != nil {
+			return err
+		}
+		if err := tmpl.Execute(w, data); err != nil {
+			return fmt.Errorf("failed to execute go-template: %w", err)
+		}
+		return nil
+	}
+	return Validate(format)
+}
+
+// PrintList prints items wrapped in an items list, so that templates address
+// them as {.items[*]}.
+func PrintList[T any](w io.Writer, items []T, format string) error {
+	if items == nil {
+		items = []T{}
+	}
+	return Print(w, map[string]any{"items": items}, format)
+}
+
+// parseJSONPath parses a JSONPath template. As with kubectl, a template
+// without braces, such as .metadata.name, is taken as a single expression.
+func parseJSONPath(text string) (*jsonpath.JSONPath, error) {
+	if text == "" {
+		return nil, fmt.Errorf("jsonpath template is empty")
+	}
+	if !strings.Contains(text, "{") {
+		text = "{" + text + "}"
+	}
+	jp := jsonpath.New("output").AllowMissingKeys(true)
+	if err := jp.Parse(text); err != nil {
+		return nil, fmt.Errorf("invalid jsonpath template: %w", err)
+	}
+	return jp, nil
+}
+
+func parseGoTemplate(text string) (*template.Template, error) {
+	if text == "" {
+		return nil, fmt.Errorf("go-template is empty")
+	}
+	tmpl, err := template.New("output").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid go-template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// toJSONValue returns obj as decoded from its JSON encoding, with integers as
+// int64 so that templates print them without an exponent.
+func toJSONValue(obj any) (any, error) {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal to JSON: %w", err)
+	}
+	var data any
+	if err := utiljson.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("failed to decode JSON: %w", err)
+	}
+	return data, nil
+}
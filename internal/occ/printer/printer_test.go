@@ -0,0 +1,89 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package printer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type endpoint struct {
+	Name string `json:"name"`
+	Port int    `json:"port"`
+}
+
+type component struct {
+	Metadata struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+	Image     string     `json:"image,omitempty"`
+	Endpoints []endpoint `json:"endpoints"`
+}
+
+func newComponent(name, image string) component {
+	c := component{Image: image, Endpoints: []endpoint{{Name: "http", Port: 8080}}}
+	c.Metadata.Name = name
+	return c
+}
+
+func TestPrint(t *testing.T) {
+	obj := newComponent("orders", "registry/orders@sha256:abc")
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"", "endpoints:\n- name: http\n  port: 8080\nimage: registry/orders@sha256:abc\nmetadata:\n  name: orders\n"},
+		{"yaml", "endpoints:\n- name: http\n  port: 8080\nimage: registry/orders@sha256:abc\nmetadata:\n  name: orders\n"},
+		{"json", "{\n  \"endpoints\": [\n    {\n      \"name\": \"http\",\n      \"port\": 8080\n    }\n  ],\n  \"image\": \"registry/orders@sha256:abc\",\n  \"metadata\": {\n    \"name\": \"orders\"\n  }\n}\n"},
+		{"jsonpath={.image}", "registry/orders@sha256:abc"},
+		{"jsonpath=.endpoints[0].port", "8080"},
+		{"jsonpath={.missing}", ""},
+		{`go-template={{.metadata.name}} {{range .endpoints}}{{.port}}{{end}}`, "orders 8080"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var out bytes.Buffer
+			require.NoError(t, Print(&out, obj, tt.format))
+			assert.Equal(t, tt.want, out.String())
+		})
+	}
+}
+
+func TestPrintList(t *testing.T) {
+	items := []component{newComponent("orders", ""), newComponent("payments", "")}
+
+	var out bytes.Buffer
+	require.NoError(t, PrintList(&out, items, `jsonpath={range .items[*]}{.metadata.name}{"\n"}{end}`))
+	assert.Equal(t, "orders\npayments\n", out.String())
+
+	out.Reset()
+	require.NoError(t, PrintList[component](&out, nil, "json"))
+	assert.Equal(t, "{\n  \"items\": []\n}\n", out.String())
+}
+
+func TestValidate(t *testing.T) {
+	for _, format := range []string{"", "json", "yaml", "jsonpath={.metadata.name}", "go-template={{.metadata.name}}"} {
+		assert.NoError(t, Validate(format), format)
+	}
+
+	tests := []struct {
+		format  string
+		wantErr string
+	}{
+		{"xml", `unsupported output format "xml": must be one of json, yaml, jsonpath=TEMPLATE, go-template=TEMPLATE`},
+		{"jsonpath=", "jsonpath template is empty"},
+		{"jsonpath={.metadata", "invalid jsonpath template"},
+		{"go-template={{.metadata", "invalid go-template"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			assert.ErrorContains(t, Validate(tt.format), tt.wantErr)
+		})
+	}
+	assert.ErrorIs(t, Validate("xml"), ErrUnsupportedFormat)
+}
@@ -21,10 +21,14 @@ type Interface interface {
 	GetProject(ctx context.Context, namespaceName, projectName string) (*gen.Project, error)
 	DeleteProject(ctx context.Context, namespaceName, projectName string) error
 	GetProjectDeploymentPipeline(ctx context.Context, namespaceName, projectName string) (*gen.DeploymentPipeline, error)
+	ExportProjectGitOps(ctx context.Context, namespaceName, projectName string) (*gen.GitOpsExport, error)
+	GetProjectComponentHealth(ctx context.Context, namespaceName, projectName string) (*gen.ProjectComponentHealth, error)
 
 	ListComponents(ctx context.Context, namespaceName, projectName string, params *gen.ListComponentsParams) (*gen.ComponentList, error)
 	GetComponent(ctx context.Context, namespaceName, componentName string) (*gen.Component, error)
+	CreateComponent(ctx context.Context, namespaceName string, comp gen.Component) (*gen.Component, error)
 	DeleteComponent(ctx context.Context, namespaceName, componentName string) error
+	ListEndpoints(ctx context.Context, namespaceName string, params *gen.ListEndpointsParams) (*gen.EndpointList, error)
 
 	ListEnvironments(ctx context.Context, namespaceName string, params *gen.ListEnvironmentsParams) (*gen.EnvironmentList, error)
 	GetEnvironment(ctx context.Context, namespaceName, envName string) (*gen.Environment, error)
@@ -105,6 +109,8 @@ type Interface interface {
 	CreateReleaseBinding(ctx context.Context, namespaceName string, req gen.ReleaseBinding) (*gen.ReleaseBinding, error)
 	UpdateReleaseBinding(ctx context.Context, namespaceName, bindingName string, req gen.ReleaseBinding) (*gen.ReleaseBinding, error)
 	DeleteReleaseBinding(ctx context.Context, namespaceName, releaseBindingName string) error
+	RollbackComponent(ctx context.Context, namespaceName, componentName string, req gen.RollbackComponentRequest) (*gen.RollbackComponentResponse, error)
+	TriggerComponentBuild(ctx context.Context, namespaceName, componentName string, req gen.TriggerComponentBuildRequest) (*gen.ComponentBuild, error)
 
 	ListResourceTypes(ctx context.Context, namespaceName string, params *gen.ListResourceTypesParams) (*gen.ResourceTypeList, error)
 	GetResourceType(ctx context.Context, namespaceName, rtName string) (*gen.ResourceType, error)
@@ -199,6 +205,19 @@ type Interface interface {
 	ListNamespaceRoleBindings(ctx context.Context, namespaceName string, params *gen.ListNamespaceRoleBindingsParams) (*gen.AuthzRoleBindingList, error)
 	GetNamespaceRoleBinding(ctx context.Context, namespaceName, name string) (*gen.AuthzRoleBinding, error)
 	DeleteNamespaceRoleBinding(ctx context.Context, namespaceName, name string) error
+
+	ListKinds(ctx context.Context) ([]gen.PrinterColumns, error)
+	GetPrinterColumns(ctx context.Context, kind string) (*gen.PrinterColumns, error)
+	GetKindFields(ctx context.Context, kind string) (*gen.KindFields, error)
+	ListKindSchemas(ctx context.Context) ([]gen.KindSchema, error)
+	GetServerVersion(ctx context.Context) (*gen.VersionResponse, error)
+	GetDiagnostics(ctx context.Context) (*gen.HealthReport, error)
+	// ListObjects, GetObject and DeleteObject access resources of any kind as
+	// JSON objects. path is the API path of the collection or the resource,
+	// such as /api/v1/namespaces/acme/components.
+	ListObjects(ctx context.Context, path string, limit int, cursor string) ([]map[string]any, string, error)
+	GetObject(ctx context.Context, path string) (map[string]any, error)
+	DeleteObject(ctx context.Context, path string) error
 }
 
 // compile-time check that *Client satisfies Interface.
@@ -142,6 +142,66 @@ func (_c *MockInterface_CreateClusterResourceType_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// CreateComponent provides a mock function with given fields: ctx, namespaceName, comp
+func (_m *MockInterface) CreateComponent(ctx context.Context, namespaceName string, comp gen.Component) (*gen.Component, error) {
+	ret := _m.Called(ctx, namespaceName, comp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateComponent")
+	}
+
+	var r0 *gen.Component
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, gen.Component) (*gen.Component, error)); ok {
+		return rf(ctx, namespaceName, comp)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, gen.Component) *gen.Component); ok {
+		r0 = rf(ctx, namespaceName, comp)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.Component)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, gen.Component) error); ok {
+		r1 = rf(ctx, namespaceName, comp)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_CreateComponent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateComponent'
+type MockInterface_CreateComponent_Call struct {
+	*mock.Call
+}
+
+// CreateComponent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - comp gen.Component
+func (_e *MockInterface_Expecter) CreateComponent(ctx interface{}, namespaceName interface{}, comp interface{}) *MockInterface_CreateComponent_Call {
+	return &MockInterface_CreateComponent_Call{Call: _e.mock.On("CreateComponent", ctx, namespaceName, comp)}
+}
+
+func (_c *MockInterface_CreateComponent_Call) Run(run func(ctx context.Context, namespaceName string, comp gen.Component)) *MockInterface_CreateComponent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(gen.Component))
+	})
+	return _c
+}
+
+func (_c *MockInterface_CreateComponent_Call) Return(_a0 *gen.Component, _a1 error) *MockInterface_CreateComponent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_CreateComponent_Call) RunAndReturn(run func(context.Context, string, gen.Component) (*gen.Component, error)) *MockInterface_CreateComponent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateComponentRelease provides a mock function with given fields: ctx, namespaceName, cr
 func (_m *MockInterface) CreateComponentRelease(ctx context.Context, namespaceName string, cr gen.ComponentRelease) (*gen.ComponentRelease, error) {
 	ret := _m.Called(ctx, namespaceName, cr)
@@ -1823,6 +1883,53 @@ func (_c *MockInterface_DeleteNamespaceRoleBinding_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// DeleteObject provides a mock function with given fields: ctx, path
+func (_m *MockInterface) DeleteObject(ctx context.Context, path string) error {
+	ret := _m.Called(ctx, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteObject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, path)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockInterface_DeleteObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteObject'
+type MockInterface_DeleteObject_Call struct {
+	*mock.Call
+}
+
+// DeleteObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+func (_e *MockInterface_Expecter) DeleteObject(ctx interface{}, path interface{}) *MockInterface_DeleteObject_Call {
+	return &MockInterface_DeleteObject_Call{Call: _e.mock.On("DeleteObject", ctx, path)}
+}
+
+func (_c *MockInterface_DeleteObject_Call) Run(run func(ctx context.Context, path string)) *MockInterface_DeleteObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockInterface_DeleteObject_Call) Return(_a0 error) *MockInterface_DeleteObject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockInterface_DeleteObject_Call) RunAndReturn(run func(context.Context, string) error) *MockInterface_DeleteObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteObservabilityAlertsNotificationChannel provides a mock function with given fields: ctx, namespaceName, channelName
 func (_m *MockInterface) DeleteObservabilityAlertsNotificationChannel(ctx context.Context, namespaceName string, channelName string) error {
 	ret := _m.Called(ctx, namespaceName, channelName)
@@ -2639,6 +2746,66 @@ func (_c *MockInterface_DeleteWorkload_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ExportProjectGitOps provides a mock function with given fields: ctx, namespaceName, projectName
+func (_m *MockInterface) ExportProjectGitOps(ctx context.Context, namespaceName string, projectName string) (*gen.GitOpsExport, error) {
+	ret := _m.Called(ctx, namespaceName, projectName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportProjectGitOps")
+	}
+
+	var r0 *gen.GitOpsExport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*gen.GitOpsExport, error)); ok {
+		return rf(ctx, namespaceName, projectName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *gen.GitOpsExport); ok {
+		r0 = rf(ctx, namespaceName, projectName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.GitOpsExport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, projectName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_ExportProjectGitOps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportProjectGitOps'
+type MockInterface_ExportProjectGitOps_Call struct {
+	*mock.Call
+}
+
+// ExportProjectGitOps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - projectName string
+func (_e *MockInterface_Expecter) ExportProjectGitOps(ctx interface{}, namespaceName interface{}, projectName interface{}) *MockInterface_ExportProjectGitOps_Call {
+	return &MockInterface_ExportProjectGitOps_Call{Call: _e.mock.On("ExportProjectGitOps", ctx, namespaceName, projectName)}
+}
+
+func (_c *MockInterface_ExportProjectGitOps_Call) Run(run func(ctx context.Context, namespaceName string, projectName string)) *MockInterface_ExportProjectGitOps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockInterface_ExportProjectGitOps_Call) Return(_a0 *gen.GitOpsExport, _a1 error) *MockInterface_ExportProjectGitOps_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_ExportProjectGitOps_Call) RunAndReturn(run func(context.Context, string, string) (*gen.GitOpsExport, error)) *MockInterface_ExportProjectGitOps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GenerateRelease provides a mock function with given fields: ctx, namespaceName, componentName, req
 func (_m *MockInterface) GenerateRelease(ctx context.Context, namespaceName string, componentName string, req gen.GenerateReleaseRequest) (*gen.ComponentRelease, error) {
 	ret := _m.Called(ctx, namespaceName, componentName, req)
@@ -3945,6 +4112,64 @@ func (_c *MockInterface_GetDeploymentPipeline_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// GetDiagnostics provides a mock function with given fields: ctx
+func (_m *MockInterface) GetDiagnostics(ctx context.Context) (*gen.HealthReport, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDiagnostics")
+	}
+
+	var r0 *gen.HealthReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*gen.HealthReport, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *gen.HealthReport); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.HealthReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_GetDiagnostics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDiagnostics'
+type MockInterface_GetDiagnostics_Call struct {
+	*mock.Call
+}
+
+// GetDiagnostics is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockInterface_Expecter) GetDiagnostics(ctx interface{}) *MockInterface_GetDiagnostics_Call {
+	return &MockInterface_GetDiagnostics_Call{Call: _e.mock.On("GetDiagnostics", ctx)}
+}
+
+func (_c *MockInterface_GetDiagnostics_Call) Run(run func(ctx context.Context)) *MockInterface_GetDiagnostics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockInterface_GetDiagnostics_Call) Return(_a0 *gen.HealthReport, _a1 error) *MockInterface_GetDiagnostics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_GetDiagnostics_Call) RunAndReturn(run func(context.Context) (*gen.HealthReport, error)) *MockInterface_GetDiagnostics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetEnvironment provides a mock function with given fields: ctx, namespaceName, envName
 func (_m *MockInterface) GetEnvironment(ctx context.Context, namespaceName string, envName string) (*gen.Environment, error) {
 	ret := _m.Called(ctx, namespaceName, envName)
@@ -4005,6 +4230,65 @@ func (_c *MockInterface_GetEnvironment_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetKindFields provides a mock function with given fields: ctx, kind
+func (_m *MockInterface) GetKindFields(ctx context.Context, kind string) (*gen.KindFields, error) {
+	ret := _m.Called(ctx, kind)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetKindFields")
+	}
+
+	var r0 *gen.KindFields
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*gen.KindFields, error)); ok {
+		return rf(ctx, kind)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *gen.KindFields); ok {
+		r0 = rf(ctx, kind)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.KindFields)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_GetKindFields_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetKindFields'
+type MockInterface_GetKindFields_Call struct {
+	*mock.Call
+}
+
+// GetKindFields is a helper method to define mock.On call
+//   - ctx context.Context
+//   - kind string
+func (_e *MockInterface_Expecter) GetKindFields(ctx interface{}, kind interface{}) *MockInterface_GetKindFields_Call {
+	return &MockInterface_GetKindFields_Call{Call: _e.mock.On("GetKindFields", ctx, kind)}
+}
+
+func (_c *MockInterface_GetKindFields_Call) Run(run func(ctx context.Context, kind string)) *MockInterface_GetKindFields_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockInterface_GetKindFields_Call) Return(_a0 *gen.KindFields, _a1 error) *MockInterface_GetKindFields_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_GetKindFields_Call) RunAndReturn(run func(context.Context, string) (*gen.KindFields, error)) *MockInterface_GetKindFields_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetNamespace provides a mock function with given fields: ctx, namespaceName
 func (_m *MockInterface) GetNamespace(ctx context.Context, namespaceName string) (*gen.Namespace, error) {
 	ret := _m.Called(ctx, namespaceName)
@@ -4184,6 +4468,65 @@ func (_c *MockInterface_GetNamespaceRoleBinding_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// GetObject provides a mock function with given fields: ctx, path
+func (_m *MockInterface) GetObject(ctx context.Context, path string) (map[string]any, error) {
+	ret := _m.Called(ctx, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetObject")
+	}
+
+	var r0 map[string]any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (map[string]any, error)); ok {
+		return rf(ctx, path)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[string]any); ok {
+		r0 = rf(ctx, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_GetObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetObject'
+type MockInterface_GetObject_Call struct {
+	*mock.Call
+}
+
+// GetObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+func (_e *MockInterface_Expecter) GetObject(ctx interface{}, path interface{}) *MockInterface_GetObject_Call {
+	return &MockInterface_GetObject_Call{Call: _e.mock.On("GetObject", ctx, path)}
+}
+
+func (_c *MockInterface_GetObject_Call) Run(run func(ctx context.Context, path string)) *MockInterface_GetObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockInterface_GetObject_Call) Return(_a0 map[string]any, _a1 error) *MockInterface_GetObject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_GetObject_Call) RunAndReturn(run func(context.Context, string) (map[string]any, error)) *MockInterface_GetObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetObservabilityAlertsNotificationChannel provides a mock function with given fields: ctx, namespaceName, channelName
 func (_m *MockInterface) GetObservabilityAlertsNotificationChannel(ctx context.Context, namespaceName string, channelName string) (*gen.ObservabilityAlertsNotificationChannel, error) {
 	ret := _m.Called(ctx, namespaceName, channelName)
@@ -4304,29 +4647,29 @@ func (_c *MockInterface_GetObservabilityPlane_Call) RunAndReturn(run func(contex
 	return _c
 }
 
-// GetProject provides a mock function with given fields: ctx, namespaceName, projectName
-func (_m *MockInterface) GetProject(ctx context.Context, namespaceName string, projectName string) (*gen.Project, error) {
-	ret := _m.Called(ctx, namespaceName, projectName)
+// GetPrinterColumns provides a mock function with given fields: ctx, kind
+func (_m *MockInterface) GetPrinterColumns(ctx context.Context, kind string) (*gen.PrinterColumns, error) {
+	ret := _m.Called(ctx, kind)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetProject")
+		panic("no return value specified for GetPrinterColumns")
 	}
 
-	var r0 *gen.Project
+	var r0 *gen.PrinterColumns
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*gen.Project, error)); ok {
-		return rf(ctx, namespaceName, projectName)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*gen.PrinterColumns, error)); ok {
+		return rf(ctx, kind)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *gen.Project); ok {
-		r0 = rf(ctx, namespaceName, projectName)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *gen.PrinterColumns); ok {
+		r0 = rf(ctx, kind)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gen.Project)
+			r0 = ret.Get(0).(*gen.PrinterColumns)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, namespaceName, projectName)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, kind)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4334,22 +4677,81 @@ func (_m *MockInterface) GetProject(ctx context.Context, namespaceName string, p
 	return r0, r1
 }
 
-// MockInterface_GetProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProject'
-type MockInterface_GetProject_Call struct {
+// MockInterface_GetPrinterColumns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrinterColumns'
+type MockInterface_GetPrinterColumns_Call struct {
 	*mock.Call
 }
 
-// GetProject is a helper method to define mock.On call
+// GetPrinterColumns is a helper method to define mock.On call
 //   - ctx context.Context
-//   - namespaceName string
-//   - projectName string
-func (_e *MockInterface_Expecter) GetProject(ctx interface{}, namespaceName interface{}, projectName interface{}) *MockInterface_GetProject_Call {
-	return &MockInterface_GetProject_Call{Call: _e.mock.On("GetProject", ctx, namespaceName, projectName)}
+//   - kind string
+func (_e *MockInterface_Expecter) GetPrinterColumns(ctx interface{}, kind interface{}) *MockInterface_GetPrinterColumns_Call {
+	return &MockInterface_GetPrinterColumns_Call{Call: _e.mock.On("GetPrinterColumns", ctx, kind)}
 }
 
-func (_c *MockInterface_GetProject_Call) Run(run func(ctx context.Context, namespaceName string, projectName string)) *MockInterface_GetProject_Call {
+func (_c *MockInterface_GetPrinterColumns_Call) Run(run func(ctx context.Context, kind string)) *MockInterface_GetPrinterColumns_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockInterface_GetPrinterColumns_Call) Return(_a0 *gen.PrinterColumns, _a1 error) *MockInterface_GetPrinterColumns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_GetPrinterColumns_Call) RunAndReturn(run func(context.Context, string) (*gen.PrinterColumns, error)) *MockInterface_GetPrinterColumns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProject provides a mock function with given fields: ctx, namespaceName, projectName
+func (_m *MockInterface) GetProject(ctx context.Context, namespaceName string, projectName string) (*gen.Project, error) {
+	ret := _m.Called(ctx, namespaceName, projectName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProject")
+	}
+
+	var r0 *gen.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*gen.Project, error)); ok {
+		return rf(ctx, namespaceName, projectName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *gen.Project); ok {
+		r0 = rf(ctx, namespaceName, projectName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, projectName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_GetProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProject'
+type MockInterface_GetProject_Call struct {
+	*mock.Call
+}
+
+// GetProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - projectName string
+func (_e *MockInterface_Expecter) GetProject(ctx interface{}, namespaceName interface{}, projectName interface{}) *MockInterface_GetProject_Call {
+	return &MockInterface_GetProject_Call{Call: _e.mock.On("GetProject", ctx, namespaceName, projectName)}
+}
+
+func (_c *MockInterface_GetProject_Call) Run(run func(ctx context.Context, namespaceName string, projectName string)) *MockInterface_GetProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -4364,6 +4766,66 @@ func (_c *MockInterface_GetProject_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetProjectComponentHealth provides a mock function with given fields: ctx, namespaceName, projectName
+func (_m *MockInterface) GetProjectComponentHealth(ctx context.Context, namespaceName string, projectName string) (*gen.ProjectComponentHealth, error) {
+	ret := _m.Called(ctx, namespaceName, projectName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjectComponentHealth")
+	}
+
+	var r0 *gen.ProjectComponentHealth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*gen.ProjectComponentHealth, error)); ok {
+		return rf(ctx, namespaceName, projectName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *gen.ProjectComponentHealth); ok {
+		r0 = rf(ctx, namespaceName, projectName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ProjectComponentHealth)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, projectName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_GetProjectComponentHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectComponentHealth'
+type MockInterface_GetProjectComponentHealth_Call struct {
+	*mock.Call
+}
+
+// GetProjectComponentHealth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - projectName string
+func (_e *MockInterface_Expecter) GetProjectComponentHealth(ctx interface{}, namespaceName interface{}, projectName interface{}) *MockInterface_GetProjectComponentHealth_Call {
+	return &MockInterface_GetProjectComponentHealth_Call{Call: _e.mock.On("GetProjectComponentHealth", ctx, namespaceName, projectName)}
+}
+
+func (_c *MockInterface_GetProjectComponentHealth_Call) Run(run func(ctx context.Context, namespaceName string, projectName string)) *MockInterface_GetProjectComponentHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockInterface_GetProjectComponentHealth_Call) Return(_a0 *gen.ProjectComponentHealth, _a1 error) *MockInterface_GetProjectComponentHealth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_GetProjectComponentHealth_Call) RunAndReturn(run func(context.Context, string, string) (*gen.ProjectComponentHealth, error)) *MockInterface_GetProjectComponentHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetProjectDeploymentPipeline provides a mock function with given fields: ctx, namespaceName, projectName
 func (_m *MockInterface) GetProjectDeploymentPipeline(ctx context.Context, namespaceName string, projectName string) (*gen.DeploymentPipeline, error) {
 	ret := _m.Called(ctx, namespaceName, projectName)
@@ -5144,6 +5606,64 @@ func (_c *MockInterface_GetSecretReference_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetServerVersion provides a mock function with given fields: ctx
+func (_m *MockInterface) GetServerVersion(ctx context.Context) (*gen.VersionResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetServerVersion")
+	}
+
+	var r0 *gen.VersionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*gen.VersionResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *gen.VersionResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.VersionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_GetServerVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServerVersion'
+type MockInterface_GetServerVersion_Call struct {
+	*mock.Call
+}
+
+// GetServerVersion is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockInterface_Expecter) GetServerVersion(ctx interface{}) *MockInterface_GetServerVersion_Call {
+	return &MockInterface_GetServerVersion_Call{Call: _e.mock.On("GetServerVersion", ctx)}
+}
+
+func (_c *MockInterface_GetServerVersion_Call) Run(run func(ctx context.Context)) *MockInterface_GetServerVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockInterface_GetServerVersion_Call) Return(_a0 *gen.VersionResponse, _a1 error) *MockInterface_GetServerVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_GetServerVersion_Call) RunAndReturn(run func(context.Context) (*gen.VersionResponse, error)) *MockInterface_GetServerVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTrait provides a mock function with given fields: ctx, namespaceName, traitName
 func (_m *MockInterface) GetTrait(ctx context.Context, namespaceName string, traitName string) (*gen.Trait, error) {
 	ret := _m.Called(ctx, namespaceName, traitName)
@@ -6576,6 +7096,66 @@ func (_c *MockInterface_ListDeploymentPipelines_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// ListEndpoints provides a mock function with given fields: ctx, namespaceName, params
+func (_m *MockInterface) ListEndpoints(ctx context.Context, namespaceName string, params *gen.ListEndpointsParams) (*gen.EndpointList, error) {
+	ret := _m.Called(ctx, namespaceName, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEndpoints")
+	}
+
+	var r0 *gen.EndpointList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *gen.ListEndpointsParams) (*gen.EndpointList, error)); ok {
+		return rf(ctx, namespaceName, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *gen.ListEndpointsParams) *gen.EndpointList); ok {
+		r0 = rf(ctx, namespaceName, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.EndpointList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *gen.ListEndpointsParams) error); ok {
+		r1 = rf(ctx, namespaceName, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_ListEndpoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEndpoints'
+type MockInterface_ListEndpoints_Call struct {
+	*mock.Call
+}
+
+// ListEndpoints is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - params *gen.ListEndpointsParams
+func (_e *MockInterface_Expecter) ListEndpoints(ctx interface{}, namespaceName interface{}, params interface{}) *MockInterface_ListEndpoints_Call {
+	return &MockInterface_ListEndpoints_Call{Call: _e.mock.On("ListEndpoints", ctx, namespaceName, params)}
+}
+
+func (_c *MockInterface_ListEndpoints_Call) Run(run func(ctx context.Context, namespaceName string, params *gen.ListEndpointsParams)) *MockInterface_ListEndpoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*gen.ListEndpointsParams))
+	})
+	return _c
+}
+
+func (_c *MockInterface_ListEndpoints_Call) Return(_a0 *gen.EndpointList, _a1 error) *MockInterface_ListEndpoints_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_ListEndpoints_Call) RunAndReturn(run func(context.Context, string, *gen.ListEndpointsParams) (*gen.EndpointList, error)) *MockInterface_ListEndpoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListEnvironments provides a mock function with given fields: ctx, namespaceName, params
 func (_m *MockInterface) ListEnvironments(ctx context.Context, namespaceName string, params *gen.ListEnvironmentsParams) (*gen.EnvironmentList, error) {
 	ret := _m.Called(ctx, namespaceName, params)
@@ -6636,6 +7216,122 @@ func (_c *MockInterface_ListEnvironments_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// ListKindSchemas provides a mock function with given fields: ctx
+func (_m *MockInterface) ListKindSchemas(ctx context.Context) ([]gen.KindSchema, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListKindSchemas")
+	}
+
+	var r0 []gen.KindSchema
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]gen.KindSchema, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []gen.KindSchema); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]gen.KindSchema)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_ListKindSchemas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListKindSchemas'
+type MockInterface_ListKindSchemas_Call struct {
+	*mock.Call
+}
+
+// ListKindSchemas is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockInterface_Expecter) ListKindSchemas(ctx interface{}) *MockInterface_ListKindSchemas_Call {
+	return &MockInterface_ListKindSchemas_Call{Call: _e.mock.On("ListKindSchemas", ctx)}
+}
+
+func (_c *MockInterface_ListKindSchemas_Call) Run(run func(ctx context.Context)) *MockInterface_ListKindSchemas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockInterface_ListKindSchemas_Call) Return(_a0 []gen.KindSchema, _a1 error) *MockInterface_ListKindSchemas_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_ListKindSchemas_Call) RunAndReturn(run func(context.Context) ([]gen.KindSchema, error)) *MockInterface_ListKindSchemas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListKinds provides a mock function with given fields: ctx
+func (_m *MockInterface) ListKinds(ctx context.Context) ([]gen.PrinterColumns, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListKinds")
+	}
+
+	var r0 []gen.PrinterColumns
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]gen.PrinterColumns, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []gen.PrinterColumns); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]gen.PrinterColumns)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_ListKinds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListKinds'
+type MockInterface_ListKinds_Call struct {
+	*mock.Call
+}
+
+// ListKinds is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockInterface_Expecter) ListKinds(ctx interface{}) *MockInterface_ListKinds_Call {
+	return &MockInterface_ListKinds_Call{Call: _e.mock.On("ListKinds", ctx)}
+}
+
+func (_c *MockInterface_ListKinds_Call) Run(run func(ctx context.Context)) *MockInterface_ListKinds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockInterface_ListKinds_Call) Return(_a0 []gen.PrinterColumns, _a1 error) *MockInterface_ListKinds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_ListKinds_Call) RunAndReturn(run func(context.Context) ([]gen.PrinterColumns, error)) *MockInterface_ListKinds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListNamespaceRoleBindings provides a mock function with given fields: ctx, namespaceName, params
 func (_m *MockInterface) ListNamespaceRoleBindings(ctx context.Context, namespaceName string, params *gen.ListNamespaceRoleBindingsParams) (*gen.AuthzRoleBindingList, error) {
 	ret := _m.Called(ctx, namespaceName, params)
@@ -6815,6 +7511,74 @@ func (_c *MockInterface_ListNamespaces_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ListObjects provides a mock function with given fields: ctx, path, limit, cursor
+func (_m *MockInterface) ListObjects(ctx context.Context, path string, limit int, cursor string) ([]map[string]any, string, error) {
+	ret := _m.Called(ctx, path, limit, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListObjects")
+	}
+
+	var r0 []map[string]any
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) ([]map[string]any, string, error)); ok {
+		return rf(ctx, path, limit, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) []map[string]any); ok {
+		r0 = rf(ctx, path, limit, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]map[string]any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, string) string); ok {
+		r1 = rf(ctx, path, limit, cursor)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, int, string) error); ok {
+		r2 = rf(ctx, path, limit, cursor)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockInterface_ListObjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListObjects'
+type MockInterface_ListObjects_Call struct {
+	*mock.Call
+}
+
+// ListObjects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+//   - limit int
+//   - cursor string
+func (_e *MockInterface_Expecter) ListObjects(ctx interface{}, path interface{}, limit interface{}, cursor interface{}) *MockInterface_ListObjects_Call {
+	return &MockInterface_ListObjects_Call{Call: _e.mock.On("ListObjects", ctx, path, limit, cursor)}
+}
+
+func (_c *MockInterface_ListObjects_Call) Run(run func(ctx context.Context, path string, limit int, cursor string)) *MockInterface_ListObjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockInterface_ListObjects_Call) Return(_a0 []map[string]any, _a1 string, _a2 error) *MockInterface_ListObjects_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockInterface_ListObjects_Call) RunAndReturn(run func(context.Context, string, int, string) ([]map[string]any, string, error)) *MockInterface_ListObjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListObservabilityAlertsNotificationChannels provides a mock function with given fields: ctx, namespaceName, params
 func (_m *MockInterface) ListObservabilityAlertsNotificationChannels(ctx context.Context, namespaceName string, params *gen.ListObservabilityAlertsNotificationChannelsParams) (*gen.ObservabilityAlertsNotificationChannelList, error) {
 	ret := _m.Called(ctx, namespaceName, params)
@@ -7895,6 +8659,128 @@ func (_c *MockInterface_ListWorkloads_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// RollbackComponent provides a mock function with given fields: ctx, namespaceName, componentName, req
+func (_m *MockInterface) RollbackComponent(ctx context.Context, namespaceName string, componentName string, req gen.RollbackComponentRequest) (*gen.RollbackComponentResponse, error) {
+	ret := _m.Called(ctx, namespaceName, componentName, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollbackComponent")
+	}
+
+	var r0 *gen.RollbackComponentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, gen.RollbackComponentRequest) (*gen.RollbackComponentResponse, error)); ok {
+		return rf(ctx, namespaceName, componentName, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, gen.RollbackComponentRequest) *gen.RollbackComponentResponse); ok {
+		r0 = rf(ctx, namespaceName, componentName, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.RollbackComponentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, gen.RollbackComponentRequest) error); ok {
+		r1 = rf(ctx, namespaceName, componentName, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_RollbackComponent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackComponent'
+type MockInterface_RollbackComponent_Call struct {
+	*mock.Call
+}
+
+// RollbackComponent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - componentName string
+//   - req gen.RollbackComponentRequest
+func (_e *MockInterface_Expecter) RollbackComponent(ctx interface{}, namespaceName interface{}, componentName interface{}, req interface{}) *MockInterface_RollbackComponent_Call {
+	return &MockInterface_RollbackComponent_Call{Call: _e.mock.On("RollbackComponent", ctx, namespaceName, componentName, req)}
+}
+
+func (_c *MockInterface_RollbackComponent_Call) Run(run func(ctx context.Context, namespaceName string, componentName string, req gen.RollbackComponentRequest)) *MockInterface_RollbackComponent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(gen.RollbackComponentRequest))
+	})
+	return _c
+}
+
+func (_c *MockInterface_RollbackComponent_Call) Return(_a0 *gen.RollbackComponentResponse, _a1 error) *MockInterface_RollbackComponent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_RollbackComponent_Call) RunAndReturn(run func(context.Context, string, string, gen.RollbackComponentRequest) (*gen.RollbackComponentResponse, error)) *MockInterface_RollbackComponent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TriggerComponentBuild provides a mock function with given fields: ctx, namespaceName, componentName, req
+func (_m *MockInterface) TriggerComponentBuild(ctx context.Context, namespaceName string, componentName string, req gen.TriggerComponentBuildRequest) (*gen.ComponentBuild, error) {
+	ret := _m.Called(ctx, namespaceName, componentName, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TriggerComponentBuild")
+	}
+
+	var r0 *gen.ComponentBuild
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, gen.TriggerComponentBuildRequest) (*gen.ComponentBuild, error)); ok {
+		return rf(ctx, namespaceName, componentName, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, gen.TriggerComponentBuildRequest) *gen.ComponentBuild); ok {
+		r0 = rf(ctx, namespaceName, componentName, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gen.ComponentBuild)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, gen.TriggerComponentBuildRequest) error); ok {
+		r1 = rf(ctx, namespaceName, componentName, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInterface_TriggerComponentBuild_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TriggerComponentBuild'
+type MockInterface_TriggerComponentBuild_Call struct {
+	*mock.Call
+}
+
+// TriggerComponentBuild is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - componentName string
+//   - req gen.TriggerComponentBuildRequest
+func (_e *MockInterface_Expecter) TriggerComponentBuild(ctx interface{}, namespaceName interface{}, componentName interface{}, req interface{}) *MockInterface_TriggerComponentBuild_Call {
+	return &MockInterface_TriggerComponentBuild_Call{Call: _e.mock.On("TriggerComponentBuild", ctx, namespaceName, componentName, req)}
+}
+
+func (_c *MockInterface_TriggerComponentBuild_Call) Run(run func(ctx context.Context, namespaceName string, componentName string, req gen.TriggerComponentBuildRequest)) *MockInterface_TriggerComponentBuild_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(gen.TriggerComponentBuildRequest))
+	})
+	return _c
+}
+
+func (_c *MockInterface_TriggerComponentBuild_Call) Return(_a0 *gen.ComponentBuild, _a1 error) *MockInterface_TriggerComponentBuild_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInterface_TriggerComponentBuild_Call) RunAndReturn(run func(context.Context, string, string, gen.TriggerComponentBuildRequest) (*gen.ComponentBuild, error)) *MockInterface_TriggerComponentBuild_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateClusterProjectType provides a mock function with given fields: ctx, cptName, cpt
 func (_m *MockInterface) UpdateClusterProjectType(ctx context.Context, cptName string, cpt gen.ClusterProjectType) (*gen.ClusterProjectType, error) {
 	ret := _m.Called(ctx, cptName, cpt)
@@ -0,0 +1,152 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+// objectList is the paginated list envelope shared by all list endpoints.
+type objectList struct {
+	Items      []map[string]any `json:"items"`
+	Pagination struct {
+		NextCursor *string `json:"nextCursor,omitempty"`
+	} `json:"pagination"`
+}
+
+// collectionNames maps the CRD plurals whose API collection is named
+// differently.
+var collectionNames = map[string]string{
+	"customdomains":      "custom-domains",
+	"networkpolicyrules": "network-policy-rules",
+	"promotionrequests":  "promotion-requests",
+}
+
+// CollectionPath returns the API path of the collection of a kind.
+func CollectionPath(kind *gen.PrinterColumns, namespace string) string {
+	name := kind.Plural
+	if alias, ok := collectionNames[name]; ok {
+		name = alias
+	}
+	if kind.Namespaced {
+		return "/api/v1/namespaces/" + url.PathEscape(namespace) + "/" + name
+	}
+	return "/api/v1/" + name
+}
+
+// ListObjects retrieves one page of the resources of the collection at path
+func (c *Client) ListObjects(ctx context.Context, path string, limit int, cursor string) ([]map[string]any, string, error) {
+	query := url.Values{}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	if cursor != "" {
+		query.Set("cursor", cursor)
+	}
+
+	var list objectList
+	if err := c.getJSON(ctx, path, query, &list); err != nil {
+		return nil, "", fmt.Errorf("failed to list resources: %w", err)
+	}
+	next := ""
+	if list.Pagination.NextCursor != nil {
+		next = *list.Pagination.NextCursor
+	}
+	return list.Items, next, nil
+}
+
+// GetObject retrieves the resource at path
+func (c *Client) GetObject(ctx context.Context, path string) (map[string]any, error) {
+	var obj map[string]any
+	if err := c.getJSON(ctx, path, nil, &obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// DeleteObject deletes the resource at path
+func (c *Client) DeleteObject(ctx context.Context, path string) error {
+	status, body, err := c.do(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK && status != http.StatusNoContent {
+		return apiError(status, body)
+	}
+	return nil
+}
+
+// getJSON sends a GET request for path and decodes the JSON response into out.
+func (c *Client) getJSON(ctx context.Context, path string, query url.Values, out any) error {
+	status, body, err := c.do(ctx, http.MethodGet, path, query)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return apiError(status, body)
+	}
+	return json.Unmarshal(body, out)
+}
+
+// do sends a request for path through the generated client, so it carries the
+// same server URL and authentication as the typed methods, and returns the
+// status code and body of the response.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values) (int, []byte, error) {
+	gc, req, err := c.newRequest(ctx, method, path, query)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	resp, err := gc.Client.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, err
+	}
+	return resp.StatusCode, body, nil
+}
+
+// newRequest builds a request for path against the server of the generated
+// client, with its request editors applied.
+func (c *Client) newRequest(ctx context.Context, method, path string, query url.Values) (*gen.Client, *http.Request, error) {
+	gc, ok := c.GetClient().ClientInterface.(*gen.Client)
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported API client %T", c.GetClient().ClientInterface)
+	}
+
+	serverURL, err := url.Parse(gc.Server)
+	if err != nil {
+		return nil, nil, err
+	}
+	reqURL, err := serverURL.Parse("." + path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(query) > 0 {
+		reqURL.RawQuery = query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, edit := range gc.RequestEditors {
+		if err := edit(ctx, req); err != nil {
+			return nil, nil, err
+		}
+	}
+	return gc, req, nil
+}
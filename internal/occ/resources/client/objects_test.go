@@ -0,0 +1,86 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+func newTestServerClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	gc, err := gen.NewClientWithResponses(srv.URL, gen.WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer token")
+		return nil
+	}))
+	require.NoError(t, err)
+	return &Client{client: gc}
+}
+
+func TestListObjects(t *testing.T) {
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/namespaces/acme/components", r.URL.Path)
+		assert.Equal(t, "50", r.URL.Query().Get("limit"))
+		assert.Equal(t, "abc", r.URL.Query().Get("cursor"))
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"items":[{"metadata":{"name":"orders"}}],"pagination":{"nextCursor":"def"}}`))
+	})
+
+	items, next, err := c.ListObjects(context.Background(), "/api/v1/namespaces/acme/components", 50, "abc")
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "orders", items[0]["metadata"].(map[string]any)["name"])
+	assert.Equal(t, "def", next)
+}
+
+func TestGetObject(t *testing.T) {
+	t.Run("found", func(t *testing.T) {
+		c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v1/clusterdataplanes/default", r.URL.Path)
+			_, _ = w.Write([]byte(`{"metadata":{"name":"default"}}`))
+		})
+		obj, err := c.GetObject(context.Background(), "/api/v1/clusterdataplanes/default")
+		require.NoError(t, err)
+		assert.Equal(t, "default", obj["metadata"].(map[string]any)["name"])
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":"NOT_FOUND","error":"ClusterDataPlane not found"}`))
+		})
+		_, err := c.GetObject(context.Background(), "/api/v1/clusterdataplanes/missing")
+		assert.EqualError(t, err, "ClusterDataPlane not found")
+	})
+}
+
+func TestDeleteObject(t *testing.T) {
+	t.Run("deleted", func(t *testing.T) {
+		c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method)
+			assert.Equal(t, "/api/v1/namespaces/acme/components/orders", r.URL.Path)
+			assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+			w.WriteHeader(http.StatusNoContent)
+		})
+		require.NoError(t, c.DeleteObject(context.Background(), "/api/v1/namespaces/acme/components/orders"))
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"code":"FORBIDDEN","error":"Insufficient permissions"}`))
+		})
+		err := c.DeleteObject(context.Background(), "/api/v1/namespaces/acme/components/orders")
+		assert.EqualError(t, err, "Insufficient permissions")
+	})
+}
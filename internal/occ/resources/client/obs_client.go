@@ -21,10 +21,17 @@ type ObserverClient struct {
 
 // LogEntry represents a single log entry from observer
 type LogEntry struct {
-	Timestamp string `json:"timestamp"`
-	Log       string `json:"log"`
-	Level     string `json:"level,omitempty"`
-	Stream    string `json:"stream,omitempty"`
+	Timestamp string            `json:"timestamp"`
+	Log       string            `json:"log"`
+	Level     string            `json:"level,omitempty"`
+	Stream    string            `json:"stream,omitempty"`
+	Metadata  *LogEntryMetadata `json:"metadata,omitempty"`
+}
+
+// LogEntryMetadata identifies the workload that produced a log entry
+type LogEntryMetadata struct {
+	ContainerName string `json:"containerName,omitempty"`
+	PodName       string `json:"podName,omitempty"`
 }
 
 // LogResponse represents the response from the observer logs API
@@ -106,20 +113,25 @@ type WorkflowRunLogsRequest struct {
 	EndTime       string `json:"endTime"`
 	Limit         int    `json:"limit,omitempty"`
 	SortOrder     string `json:"sortOrder,omitempty"`
+	TaskName      string `json:"taskName,omitempty"`
 }
 
 // FetchWorkflowRunLogs fetches archived logs for a workflow run from the observer API
 func (c *ObserverClient) FetchWorkflowRunLogs(ctx context.Context, runName string, req WorkflowRunLogsRequest) (*LogResponse, error) {
 	// Build LogsQueryRequest payload for workflow search scope
+	searchScope := map[string]interface{}{
+		"namespace":       req.NamespaceName,
+		"workflowRunName": runName,
+	}
+	if req.TaskName != "" {
+		searchScope["taskName"] = req.TaskName
+	}
 	payload := map[string]interface{}{
-		"startTime": req.StartTime,
-		"endTime":   req.EndTime,
-		"limit":     req.Limit,
-		"sortOrder": req.SortOrder,
-		"searchScope": map[string]interface{}{
-			"namespace":       req.NamespaceName,
-			"workflowRunName": runName,
-		},
+		"startTime":   req.StartTime,
+		"endTime":     req.EndTime,
+		"limit":       req.Limit,
+		"sortOrder":   req.SortOrder,
+		"searchScope": searchScope,
 	}
 
 	path := "/api/v1/logs/query"
@@ -17,17 +17,15 @@ import (
 )
 
 // apiError extracts a human-readable error message from a raw API response body.
-// The OpenChoreo API returns structured ErrorResponse JSON on failures; this
-// function parses it and falls back to the raw body when parsing fails.
+// The OpenChoreo API returns problem details (ErrorResponse) on failures; this
+// function parses them and falls back to the raw body when parsing fails.
 func apiError(statusCode int, body []byte) error {
 	var errResp gen.ErrorResponse
 	if err := json.Unmarshal(body, &errResp); err == nil && errResp.Error != "" {
 		msg := errResp.Error
-		if errResp.Details != nil {
-			for _, d := range *errResp.Details {
-				if d.Field != nil && d.Message != nil {
-					msg += fmt.Sprintf("; %s: %s", *d.Field, *d.Message)
-				}
+		if errResp.FieldErrors != nil {
+			for _, f := range *errResp.FieldErrors {
+				msg += fmt.Sprintf("; %s: %s", f.Field, f.Message)
 			}
 		}
 		return fmt.Errorf("%s", msg)
@@ -149,7 +147,7 @@ func (c *Client) ListComponents(ctx context.Context, namespaceName, projectName
 
 // GetComponent retrieves a specific component
 func (c *Client) GetComponent(ctx context.Context, namespaceName, componentName string) (*gen.Component, error) {
-	resp, err := c.client.GetComponentWithResponse(ctx, namespaceName, componentName)
+	resp, err := c.client.GetComponentWithResponse(ctx, namespaceName, componentName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get component: %w", err)
 	}
@@ -159,6 +157,30 @@ func (c *Client) GetComponent(ctx context.Context, namespaceName, componentName
 	return resp.JSON200, nil
 }
 
+// ListEndpoints retrieves the endpoints of the components in a namespace
+func (c *Client) ListEndpoints(ctx context.Context, namespaceName string, params *gen.ListEndpointsParams) (*gen.EndpointList, error) {
+	resp, err := c.client.ListEndpointsWithResponse(ctx, namespaceName, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list endpoints: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// CreateComponent creates a new component
+func (c *Client) CreateComponent(ctx context.Context, namespaceName string, comp gen.Component) (*gen.Component, error) {
+	resp, err := c.client.CreateComponentWithResponse(ctx, namespaceName, comp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create component: %w", err)
+	}
+	if resp.JSON201 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON201, nil
+}
+
 // ListEnvironments retrieves all environments for a namespace
 func (c *Client) ListEnvironments(ctx context.Context, namespaceName string, params *gen.ListEnvironmentsParams) (*gen.EnvironmentList, error) {
 	resp, err := c.client.ListEnvironmentsWithResponse(ctx, namespaceName, params)
@@ -227,7 +249,7 @@ func (c *Client) ListComponentTypes(ctx context.Context, namespaceName string, p
 
 // GetComponentType retrieves a specific component type
 func (c *Client) GetComponentType(ctx context.Context, namespaceName, ctName string) (*gen.ComponentType, error) {
-	resp, err := c.client.GetComponentTypeWithResponse(ctx, namespaceName, ctName)
+	resp, err := c.client.GetComponentTypeWithResponse(ctx, namespaceName, ctName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get component type: %w", err)
 	}
@@ -332,7 +354,7 @@ func (c *Client) ListTraits(ctx context.Context, namespaceName string, params *g
 
 // GetTrait retrieves a specific trait
 func (c *Client) GetTrait(ctx context.Context, namespaceName, traitName string) (*gen.Trait, error) {
-	resp, err := c.client.GetTraitWithResponse(ctx, namespaceName, traitName)
+	resp, err := c.client.GetTraitWithResponse(ctx, namespaceName, traitName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get trait: %w", err)
 	}
@@ -392,7 +414,7 @@ func (c *Client) ListWorkflows(ctx context.Context, namespaceName string, params
 
 // GetWorkflow retrieves a specific workflow by name
 func (c *Client) GetWorkflow(ctx context.Context, namespaceName, workflowName string) (*gen.Workflow, error) {
-	resp, err := c.client.GetWorkflowWithResponse(ctx, namespaceName, workflowName)
+	resp, err := c.client.GetWorkflowWithResponse(ctx, namespaceName, workflowName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get workflow: %w", err)
 	}
@@ -498,6 +520,30 @@ func (c *Client) UpdateReleaseBinding(ctx context.Context, namespaceName, bindin
 	return resp.JSON200, nil
 }
 
+// RollbackComponent rolls a component in an environment back to a release from its history
+func (c *Client) RollbackComponent(ctx context.Context, namespaceName, componentName string, req gen.RollbackComponentRequest) (*gen.RollbackComponentResponse, error) {
+	resp, err := c.client.RollbackComponentWithResponse(ctx, namespaceName, componentName, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to roll back component: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// TriggerComponentBuild starts a run of the workflow configured on a component
+func (c *Client) TriggerComponentBuild(ctx context.Context, namespaceName, componentName string, req gen.TriggerComponentBuildRequest) (*gen.ComponentBuild, error) {
+	resp, err := c.client.TriggerComponentBuildWithResponse(ctx, namespaceName, componentName, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to trigger build: %w", err)
+	}
+	if resp.JSON201 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON201, nil
+}
+
 // CreateReleaseBinding creates a new release binding
 func (c *Client) CreateReleaseBinding(ctx context.Context, namespaceName string, req gen.ReleaseBinding) (*gen.ReleaseBinding, error) {
 	resp, err := c.client.CreateReleaseBindingWithResponse(ctx, namespaceName, req)
@@ -512,7 +558,7 @@ func (c *Client) CreateReleaseBinding(ctx context.Context, namespaceName string,
 
 // GetProject retrieves a project by name
 func (c *Client) GetProject(ctx context.Context, namespaceName, projectName string) (*gen.Project, error) {
-	resp, err := c.client.GetProjectWithResponse(ctx, namespaceName, projectName)
+	resp, err := c.client.GetProjectWithResponse(ctx, namespaceName, projectName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get project: %w", err)
 	}
@@ -535,7 +581,7 @@ func (c *Client) GetProjectDeploymentPipeline(ctx context.Context, namespaceName
 	}
 	pipelineName := strings.TrimSpace(project.Spec.DeploymentPipelineRef.Name)
 
-	resp, err := c.client.GetDeploymentPipelineWithResponse(ctx, namespaceName, pipelineName)
+	resp, err := c.client.GetDeploymentPipelineWithResponse(ctx, namespaceName, pipelineName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get deployment pipeline: %w", err)
 	}
@@ -545,6 +591,31 @@ func (c *Client) GetProjectDeploymentPipeline(ctx context.Context, namespaceName
 	return resp.JSON200, nil
 }
 
+// GetProjectComponentHealth retrieves the health, release, configuration and
+// replicas of every component of a project in each of its environments
+func (c *Client) GetProjectComponentHealth(ctx context.Context, namespaceName, projectName string) (*gen.ProjectComponentHealth, error) {
+	resp, err := c.client.GetProjectComponentHealthWithResponse(ctx, namespaceName, projectName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project component health: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// ExportProjectGitOps retrieves the files of the GitOps export of a project
+func (c *Client) ExportProjectGitOps(ctx context.Context, namespaceName, projectName string) (*gen.GitOpsExport, error) {
+	resp, err := c.client.ExportProjectGitOpsWithResponse(ctx, namespaceName, projectName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export project: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
 // CreateWorkflowRun creates a new workflow run
 func (c *Client) CreateWorkflowRun(
 	ctx context.Context,
@@ -565,7 +636,7 @@ func (c *Client) CreateWorkflowRun(
 
 // GetEnvironment retrieves an environment by name
 func (c *Client) GetEnvironment(ctx context.Context, namespaceName, envName string) (*gen.Environment, error) {
-	resp, err := c.client.GetEnvironmentWithResponse(ctx, namespaceName, envName)
+	resp, err := c.client.GetEnvironmentWithResponse(ctx, namespaceName, envName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get environment: %w", err)
 	}
@@ -577,7 +648,7 @@ func (c *Client) GetEnvironment(ctx context.Context, namespaceName, envName stri
 
 // GetNamespace retrieves a specific namespace
 func (c *Client) GetNamespace(ctx context.Context, namespaceName string) (*gen.Namespace, error) {
-	resp, err := c.client.GetNamespaceWithResponse(ctx, namespaceName)
+	resp, err := c.client.GetNamespaceWithResponse(ctx, namespaceName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get namespace: %w", err)
 	}
@@ -613,7 +684,7 @@ func (c *Client) DeleteEnvironment(ctx context.Context, namespaceName, envName s
 
 // GetDataPlane retrieves a specific data plane
 func (c *Client) GetDataPlane(ctx context.Context, namespaceName, dpName string) (*gen.DataPlane, error) {
-	resp, err := c.client.GetDataPlaneWithResponse(ctx, namespaceName, dpName)
+	resp, err := c.client.GetDataPlaneWithResponse(ctx, namespaceName, dpName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get data plane: %w", err)
 	}
@@ -640,7 +711,7 @@ func (c *Client) ListClusterDataPlanes(ctx context.Context, params *gen.ListClus
 
 // GetClusterDataPlane retrieves a specific cluster data plane
 func (c *Client) GetClusterDataPlane(ctx context.Context, cdpName string) (*gen.ClusterDataPlane, error) {
-	resp, err := c.client.GetClusterDataPlaneWithResponse(ctx, cdpName)
+	resp, err := c.client.GetClusterDataPlaneWithResponse(ctx, cdpName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get cluster data plane: %w", err)
 	}
@@ -676,7 +747,7 @@ func (c *Client) DeleteDataPlane(ctx context.Context, namespaceName, dpName stri
 
 // GetWorkflowPlane retrieves a specific workflow plane
 func (c *Client) GetWorkflowPlane(ctx context.Context, namespaceName, workflowPlaneName string) (*gen.WorkflowPlane, error) {
-	resp, err := c.client.GetWorkflowPlaneWithResponse(ctx, namespaceName, workflowPlaneName)
+	resp, err := c.client.GetWorkflowPlaneWithResponse(ctx, namespaceName, workflowPlaneName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get workflow plane: %w", err)
 	}
@@ -700,7 +771,7 @@ func (c *Client) DeleteWorkflowPlane(ctx context.Context, namespaceName, workflo
 
 // GetObservabilityPlane retrieves a specific observability plane
 func (c *Client) GetObservabilityPlane(ctx context.Context, namespaceName, observabilityPlaneName string) (*gen.ObservabilityPlane, error) {
-	resp, err := c.client.GetObservabilityPlaneWithResponse(ctx, namespaceName, observabilityPlaneName)
+	resp, err := c.client.GetObservabilityPlaneWithResponse(ctx, namespaceName, observabilityPlaneName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get observability plane: %w", err)
 	}
@@ -724,7 +795,7 @@ func (c *Client) DeleteObservabilityPlane(ctx context.Context, namespaceName, ob
 
 // GetClusterComponentType retrieves a specific cluster component type
 func (c *Client) GetClusterComponentType(ctx context.Context, cctName string) (*gen.ClusterComponentType, error) {
-	resp, err := c.client.GetClusterComponentTypeWithResponse(ctx, cctName)
+	resp, err := c.client.GetClusterComponentTypeWithResponse(ctx, cctName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get cluster component type: %w", err)
 	}
@@ -748,7 +819,7 @@ func (c *Client) DeleteClusterComponentType(ctx context.Context, cctName string)
 
 // GetClusterTrait retrieves a specific cluster trait
 func (c *Client) GetClusterTrait(ctx context.Context, clusterTraitName string) (*gen.ClusterTrait, error) {
-	resp, err := c.client.GetClusterTraitWithResponse(ctx, clusterTraitName)
+	resp, err := c.client.GetClusterTraitWithResponse(ctx, clusterTraitName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get cluster trait: %w", err)
 	}
@@ -787,7 +858,7 @@ func (c *Client) ListClusterWorkflows(ctx context.Context, params *gen.ListClust
 
 // GetClusterWorkflow retrieves a specific cluster workflow
 func (c *Client) GetClusterWorkflow(ctx context.Context, clusterWorkflowName string) (*gen.ClusterWorkflow, error) {
-	resp, err := c.client.GetClusterWorkflowWithResponse(ctx, clusterWorkflowName)
+	resp, err := c.client.GetClusterWorkflowWithResponse(ctx, clusterWorkflowName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get cluster workflow: %w", err)
 	}
@@ -811,7 +882,7 @@ func (c *Client) DeleteClusterWorkflow(ctx context.Context, clusterWorkflowName
 
 // GetSecretReference retrieves a specific secret reference
 func (c *Client) GetSecretReference(ctx context.Context, namespaceName, secretReferenceName string) (*gen.SecretReference, error) {
-	resp, err := c.client.GetSecretReferenceWithResponse(ctx, namespaceName, secretReferenceName)
+	resp, err := c.client.GetSecretReferenceWithResponse(ctx, namespaceName, secretReferenceName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get secret reference: %w", err)
 	}
@@ -847,7 +918,7 @@ func (c *Client) ListSecrets(ctx context.Context, namespaceName string, params *
 
 // GetSecret retrieves a single secret managed by the Secret API.
 func (c *Client) GetSecret(ctx context.Context, namespaceName, secretName string) (*gen.Secret, error) {
-	resp, err := c.client.GetSecretWithResponse(ctx, namespaceName, secretName)
+	resp, err := c.client.GetSecretWithResponse(ctx, namespaceName, secretName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get secret: %w", err)
 	}
@@ -895,7 +966,7 @@ func (c *Client) DeleteSecret(ctx context.Context, namespaceName, secretName str
 
 // GetWorkflowRun retrieves a specific workflow run
 func (c *Client) GetWorkflowRun(ctx context.Context, namespaceName, runName string) (*gen.WorkflowRun, error) {
-	resp, err := c.client.GetWorkflowRunWithResponse(ctx, namespaceName, runName)
+	resp, err := c.client.GetWorkflowRunWithResponse(ctx, namespaceName, runName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get workflow run: %w", err)
 	}
@@ -907,7 +978,7 @@ func (c *Client) GetWorkflowRun(ctx context.Context, namespaceName, runName stri
 
 // GetWorkload retrieves a specific workload
 func (c *Client) GetWorkload(ctx context.Context, namespaceName, workloadName string) (*gen.Workload, error) {
-	resp, err := c.client.GetWorkloadWithResponse(ctx, namespaceName, workloadName)
+	resp, err := c.client.GetWorkloadWithResponse(ctx, namespaceName, workloadName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get workload: %w", err)
 	}
@@ -946,7 +1017,7 @@ func (c *Client) DeleteWorkload(ctx context.Context, namespaceName, workloadName
 
 // GetDeploymentPipeline retrieves a specific deployment pipeline
 func (c *Client) GetDeploymentPipeline(ctx context.Context, namespaceName, deploymentPipelineName string) (*gen.DeploymentPipeline, error) {
-	resp, err := c.client.GetDeploymentPipelineWithResponse(ctx, namespaceName, deploymentPipelineName)
+	resp, err := c.client.GetDeploymentPipelineWithResponse(ctx, namespaceName, deploymentPipelineName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get deployment pipeline: %w", err)
 	}
@@ -985,7 +1056,7 @@ func (c *Client) DeleteDeploymentPipeline(ctx context.Context, namespaceName, de
 
 // GetReleaseBinding retrieves a specific release binding
 func (c *Client) GetReleaseBinding(ctx context.Context, namespaceName, releaseBindingName string) (*gen.ReleaseBinding, error) {
-	resp, err := c.client.GetReleaseBindingWithResponse(ctx, namespaceName, releaseBindingName)
+	resp, err := c.client.GetReleaseBindingWithResponse(ctx, namespaceName, releaseBindingName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get release binding: %w", err)
 	}
@@ -1012,7 +1083,7 @@ func (c *Client) DeleteReleaseBinding(ctx context.Context, namespaceName, releas
 
 // GetComponentRelease retrieves a specific component release
 func (c *Client) GetComponentRelease(ctx context.Context, namespaceName, componentReleaseName string) (*gen.ComponentRelease, error) {
-	resp, err := c.client.GetComponentReleaseWithResponse(ctx, namespaceName, componentReleaseName)
+	resp, err := c.client.GetComponentReleaseWithResponse(ctx, namespaceName, componentReleaseName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get component release: %w", err)
 	}
@@ -1063,7 +1134,7 @@ func (c *Client) ListObservabilityAlertsNotificationChannels(ctx context.Context
 
 // GetObservabilityAlertsNotificationChannel retrieves a specific observability alerts notification channel
 func (c *Client) GetObservabilityAlertsNotificationChannel(ctx context.Context, namespaceName, channelName string) (*gen.ObservabilityAlertsNotificationChannel, error) {
-	resp, err := c.client.GetObservabilityAlertsNotificationChannelWithResponse(ctx, namespaceName, channelName)
+	resp, err := c.client.GetObservabilityAlertsNotificationChannelWithResponse(ctx, namespaceName, channelName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get observability alerts notification channel: %w", err)
 	}
@@ -1102,7 +1173,7 @@ func (c *Client) ListClusterRoles(ctx context.Context, params *gen.ListClusterRo
 
 // GetClusterRole retrieves a specific cluster-scoped authorization role
 func (c *Client) GetClusterRole(ctx context.Context, name string) (*gen.ClusterAuthzRole, error) {
-	resp, err := c.client.GetClusterRoleWithResponse(ctx, name)
+	resp, err := c.client.GetClusterRoleWithResponse(ctx, name, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get cluster role: %w", err)
 	}
@@ -1129,7 +1200,7 @@ func (c *Client) ListClusterRoleBindings(ctx context.Context, params *gen.ListCl
 
 // GetClusterRoleBinding retrieves a specific cluster-scoped role binding
 func (c *Client) GetClusterRoleBinding(ctx context.Context, name string) (*gen.ClusterAuthzRoleBinding, error) {
-	resp, err := c.client.GetClusterRoleBindingWithResponse(ctx, name)
+	resp, err := c.client.GetClusterRoleBindingWithResponse(ctx, name, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get cluster role binding: %w", err)
 	}
@@ -1156,7 +1227,7 @@ func (c *Client) ListNamespaceRoles(ctx context.Context, namespaceName string, p
 
 // GetNamespaceRole retrieves a specific namespace-scoped authorization role
 func (c *Client) GetNamespaceRole(ctx context.Context, namespaceName, name string) (*gen.AuthzRole, error) {
-	resp, err := c.client.GetNamespaceRoleWithResponse(ctx, namespaceName, name)
+	resp, err := c.client.GetNamespaceRoleWithResponse(ctx, namespaceName, name, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get role: %w", err)
 	}
@@ -1183,7 +1254,7 @@ func (c *Client) ListNamespaceRoleBindings(ctx context.Context, namespaceName st
 
 // GetNamespaceRoleBinding retrieves a specific namespace-scoped role binding
 func (c *Client) GetNamespaceRoleBinding(ctx context.Context, namespaceName, name string) (*gen.AuthzRoleBinding, error) {
-	resp, err := c.client.GetNamespaceRoleBindingWithResponse(ctx, namespaceName, name)
+	resp, err := c.client.GetNamespaceRoleBindingWithResponse(ctx, namespaceName, name, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get role binding: %w", err)
 	}
@@ -1270,7 +1341,7 @@ func (c *Client) GetWorkflowRunStatus(ctx context.Context, namespaceName, runNam
 
 // GetClusterWorkflowPlane retrieves a specific cluster workflow plane
 func (c *Client) GetClusterWorkflowPlane(ctx context.Context, clusterWorkflowPlaneName string) (*gen.ClusterWorkflowPlane, error) {
-	resp, err := c.client.GetClusterWorkflowPlaneWithResponse(ctx, clusterWorkflowPlaneName)
+	resp, err := c.client.GetClusterWorkflowPlaneWithResponse(ctx, clusterWorkflowPlaneName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get cluster workflow plane: %w", err)
 	}
@@ -1324,7 +1395,7 @@ func (c *Client) ListClusterObservabilityPlanes(ctx context.Context, params *gen
 
 // GetClusterObservabilityPlane retrieves a specific cluster observability plane
 func (c *Client) GetClusterObservabilityPlane(ctx context.Context, clusterObservabilityPlaneName string) (*gen.ClusterObservabilityPlane, error) {
-	resp, err := c.client.GetClusterObservabilityPlaneWithResponse(ctx, clusterObservabilityPlaneName)
+	resp, err := c.client.GetClusterObservabilityPlaneWithResponse(ctx, clusterObservabilityPlaneName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get cluster observability plane: %w", err)
 	}
@@ -1453,7 +1524,7 @@ func (c *Client) ListResourceTypes(ctx context.Context, namespaceName string, pa
 
 // GetResourceType retrieves a specific resource type
 func (c *Client) GetResourceType(ctx context.Context, namespaceName, rtName string) (*gen.ResourceType, error) {
-	resp, err := c.client.GetResourceTypeWithResponse(ctx, namespaceName, rtName)
+	resp, err := c.client.GetResourceTypeWithResponse(ctx, namespaceName, rtName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get resource type: %w", err)
 	}
@@ -1531,7 +1602,7 @@ func (c *Client) ListClusterResourceTypes(ctx context.Context, params *gen.ListC
 
 // GetClusterResourceType retrieves a specific cluster resource type
 func (c *Client) GetClusterResourceType(ctx context.Context, crtName string) (*gen.ClusterResourceType, error) {
-	resp, err := c.client.GetClusterResourceTypeWithResponse(ctx, crtName)
+	resp, err := c.client.GetClusterResourceTypeWithResponse(ctx, crtName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get cluster resource type: %w", err)
 	}
@@ -1609,7 +1680,7 @@ func (c *Client) ListProjectTypes(ctx context.Context, namespaceName string, par
 
 // GetProjectType retrieves a specific project type
 func (c *Client) GetProjectType(ctx context.Context, namespaceName, ptName string) (*gen.ProjectType, error) {
-	resp, err := c.client.GetProjectTypeWithResponse(ctx, namespaceName, ptName)
+	resp, err := c.client.GetProjectTypeWithResponse(ctx, namespaceName, ptName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get project type: %w", err)
 	}
@@ -1687,7 +1758,7 @@ func (c *Client) ListClusterProjectTypes(ctx context.Context, params *gen.ListCl
 
 // GetClusterProjectType retrieves a specific cluster project type
 func (c *Client) GetClusterProjectType(ctx context.Context, cptName string) (*gen.ClusterProjectType, error) {
-	resp, err := c.client.GetClusterProjectTypeWithResponse(ctx, cptName)
+	resp, err := c.client.GetClusterProjectTypeWithResponse(ctx, cptName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get cluster project type: %w", err)
 	}
@@ -1765,7 +1836,7 @@ func (c *Client) ListProjectReleases(ctx context.Context, namespaceName string,
 
 // GetProjectRelease retrieves a specific project release
 func (c *Client) GetProjectRelease(ctx context.Context, namespaceName, projectReleaseName string) (*gen.ProjectRelease, error) {
-	resp, err := c.client.GetProjectReleaseWithResponse(ctx, namespaceName, projectReleaseName)
+	resp, err := c.client.GetProjectReleaseWithResponse(ctx, namespaceName, projectReleaseName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get project release: %w", err)
 	}
@@ -1816,7 +1887,7 @@ func (c *Client) ListProjectReleaseBindings(ctx context.Context, namespaceName s
 
 // GetProjectReleaseBinding retrieves a specific project release binding
 func (c *Client) GetProjectReleaseBinding(ctx context.Context, namespaceName, bindingName string) (*gen.ProjectReleaseBinding, error) {
-	resp, err := c.client.GetProjectReleaseBindingWithResponse(ctx, namespaceName, bindingName)
+	resp, err := c.client.GetProjectReleaseBindingWithResponse(ctx, namespaceName, bindingName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get project release binding: %w", err)
 	}
@@ -1879,7 +1950,7 @@ func (c *Client) ListResources(ctx context.Context, namespaceName string, params
 
 // GetResource retrieves a specific resource
 func (c *Client) GetResource(ctx context.Context, namespaceName, resourceName string) (*gen.ResourceInstance, error) {
-	resp, err := c.client.GetResourceWithResponse(ctx, namespaceName, resourceName)
+	resp, err := c.client.GetResourceWithResponse(ctx, namespaceName, resourceName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get resource: %w", err)
 	}
@@ -1942,7 +2013,7 @@ func (c *Client) ListResourceReleases(ctx context.Context, namespaceName string,
 
 // GetResourceRelease retrieves a specific resource release
 func (c *Client) GetResourceRelease(ctx context.Context, namespaceName, resourceReleaseName string) (*gen.ResourceRelease, error) {
-	resp, err := c.client.GetResourceReleaseWithResponse(ctx, namespaceName, resourceReleaseName)
+	resp, err := c.client.GetResourceReleaseWithResponse(ctx, namespaceName, resourceReleaseName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get resource release: %w", err)
 	}
@@ -1993,7 +2064,7 @@ func (c *Client) ListResourceReleaseBindings(ctx context.Context, namespaceName
 
 // GetResourceReleaseBinding retrieves a specific resource release binding
 func (c *Client) GetResourceReleaseBinding(ctx context.Context, namespaceName, bindingName string) (*gen.ResourceReleaseBinding, error) {
-	resp, err := c.client.GetResourceReleaseBindingWithResponse(ctx, namespaceName, bindingName)
+	resp, err := c.client.GetResourceReleaseBindingWithResponse(ctx, namespaceName, bindingName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get resource release binding: %w", err)
 	}
@@ -2039,6 +2110,84 @@ func (c *Client) DeleteResourceReleaseBinding(ctx context.Context, namespaceName
 	return nil
 }
 
+// GetPrinterColumns retrieves the table columns of a kind
+func (c *Client) GetPrinterColumns(ctx context.Context, kind string) (*gen.PrinterColumns, error) {
+	resp, err := c.client.GetKindPrinterColumnsWithResponse(ctx, kind)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get printer columns: %w", err)
+	}
+	if resp.JSON404 != nil {
+		return nil, fmt.Errorf("the server doesn't have a resource type %q", kind)
+	}
+	if resp.JSON200 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// ListKinds retrieves the resource kinds served by the API
+func (c *Client) ListKinds(ctx context.Context) ([]gen.PrinterColumns, error) {
+	resp, err := c.client.ListKindsWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list kinds: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200.Items, nil
+}
+
+// ListKindSchemas retrieves the OpenAPI schemas of the resource kinds
+func (c *Client) ListKindSchemas(ctx context.Context) ([]gen.KindSchema, error) {
+	resp, err := c.client.ListKindSchemasWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list kind schemas: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200.Items, nil
+}
+
+// GetServerVersion retrieves version information about the API server
+func (c *Client) GetServerVersion(ctx context.Context) (*gen.VersionResponse, error) {
+	resp, err := c.client.GetVersionWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server version: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// GetDiagnostics runs the health and diagnostic checks of the API server
+func (c *Client) GetDiagnostics(ctx context.Context) (*gen.HealthReport, error) {
+	resp, err := c.client.GetDiagnosticsWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get diagnostics: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// GetKindFields retrieves the field documentation of a kind
+func (c *Client) GetKindFields(ctx context.Context, kind string) (*gen.KindFields, error) {
+	resp, err := c.client.GetKindFieldsWithResponse(ctx, kind)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kind fields: %w", err)
+	}
+	if resp.JSON404 != nil {
+		return nil, fmt.Errorf("the server doesn't have a resource type %q", kind)
+	}
+	if resp.JSON200 == nil {
+		return nil, apiError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
 func schemaResponseToRaw(schema *gen.SchemaResponse) (*json.RawMessage, error) {
 	data, err := json.Marshal(schema)
 	if err != nil {
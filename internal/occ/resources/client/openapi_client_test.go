@@ -42,15 +42,15 @@ func TestApiError(t *testing.T) {
 			wantMsg:    `component "foo" not found`,
 		},
 		{
-			name:       "structured error with details",
+			name:       "structured error with field errors",
 			statusCode: 400,
-			body:       []byte(`{"code":"VALIDATION_ERROR","error":"validation failed","details":[{"field":"name","message":"must not be empty"}]}`),
+			body:       []byte(`{"code":"VALIDATION_ERROR","error":"validation failed","fieldErrors":[{"field":"name","message":"must not be empty"}]}`),
 			wantMsg:    `validation failed; name: must not be empty`,
 		},
 		{
-			name:       "structured error with multiple details",
+			name:       "structured error with multiple field errors",
 			statusCode: 400,
-			body:       []byte(`{"code":"VALIDATION_ERROR","error":"validation failed","details":[{"field":"name","message":"must not be empty"},{"field":"project","message":"is required"}]}`),
+			body:       []byte(`{"code":"VALIDATION_ERROR","error":"validation failed","fieldErrors":[{"field":"name","message":"must not be empty"},{"field":"project","message":"is required"}]}`),
 			wantMsg:    `validation failed; name: must not be empty; project: is required`,
 		},
 		{
@@ -3104,6 +3104,33 @@ func TestDeleteNamespaceRoleBinding_APIError(t *testing.T) {
 	require.ErrorContains(t, c.DeleteNamespaceRoleBinding(context.Background(), "org-a", "rb-1"), "forbidden")
 }
 
+// --- CreateComponent ---
+
+func TestCreateComponent_Success(t *testing.T) {
+	m := mocks.NewMockClientWithResponsesInterface(t)
+	m.EXPECT().CreateComponentWithResponse(mock.Anything, "org-a", mock.Anything, mock.Anything).Return(&gen.CreateComponentResp{
+		HTTPResponse: httpResp(http.StatusCreated),
+		JSON201:      &gen.Component{Metadata: gen.ObjectMeta{Name: "comp-1"}},
+	}, nil)
+
+	c := newMockClient(m)
+	result, err := c.CreateComponent(context.Background(), "org-a", gen.Component{})
+	require.NoError(t, err)
+	assert.Equal(t, "comp-1", result.Metadata.Name)
+}
+
+func TestCreateComponent_APIError(t *testing.T) {
+	m := mocks.NewMockClientWithResponsesInterface(t)
+	m.EXPECT().CreateComponentWithResponse(mock.Anything, "org-a", mock.Anything, mock.Anything).Return(&gen.CreateComponentResp{
+		HTTPResponse: httpResp(http.StatusConflict),
+		Body:         []byte(`{"error":"already exists"}`),
+	}, nil)
+
+	c := newMockClient(m)
+	_, err := c.CreateComponent(context.Background(), "org-a", gen.Component{})
+	require.ErrorContains(t, err, "already exists")
+}
+
 // --- CreateComponentType ---
 
 func TestCreateComponentType_Success(t *testing.T) {
@@ -3356,6 +3383,63 @@ func TestUpdateReleaseBinding_APIError(t *testing.T) {
 	require.ErrorContains(t, err, "not found")
 }
 
+// --- RollbackComponent ---
+
+func TestRollbackComponent_Success(t *testing.T) {
+	m := mocks.NewMockClientWithResponsesInterface(t)
+	m.EXPECT().RollbackComponentWithResponse(mock.Anything, "org-a", "comp-a", gen.RollbackComponentRequest{Environment: "prod"}).
+		Return(&gen.RollbackComponentResp{
+			HTTPResponse: httpResp(http.StatusOK),
+			JSON200:      &gen.RollbackComponentResponse{Release: "comp-a-1", PreviousRelease: "comp-a-2"},
+		}, nil)
+
+	c := newMockClient(m)
+	result, err := c.RollbackComponent(context.Background(), "org-a", "comp-a", gen.RollbackComponentRequest{Environment: "prod"})
+	require.NoError(t, err)
+	assert.Equal(t, "comp-a-1", result.Release)
+}
+
+func TestRollbackComponent_APIError(t *testing.T) {
+	m := mocks.NewMockClientWithResponsesInterface(t)
+	m.EXPECT().RollbackComponentWithResponse(mock.Anything, "org-a", "comp-a", mock.Anything).Return(&gen.RollbackComponentResp{
+		HTTPResponse: httpResp(http.StatusConflict),
+		Body:         []byte(`{"error":"no previous release to roll back to"}`),
+	}, nil)
+
+	c := newMockClient(m)
+	_, err := c.RollbackComponent(context.Background(), "org-a", "comp-a", gen.RollbackComponentRequest{Environment: "prod"})
+	require.ErrorContains(t, err, "no previous release")
+}
+
+// --- TriggerComponentBuild ---
+
+func TestTriggerComponentBuild_Success(t *testing.T) {
+	commit := "3f2a9c1"
+	m := mocks.NewMockClientWithResponsesInterface(t)
+	m.EXPECT().TriggerComponentBuildWithResponse(mock.Anything, "org-a", "comp-a", gen.TriggerComponentBuildRequest{Commit: &commit}).
+		Return(&gen.TriggerComponentBuildResp{
+			HTTPResponse: httpResp(http.StatusCreated),
+			JSON201:      &gen.ComponentBuild{Name: "comp-a-run-1", Component: "comp-a", Commit: &commit},
+		}, nil)
+
+	c := newMockClient(m)
+	result, err := c.TriggerComponentBuild(context.Background(), "org-a", "comp-a", gen.TriggerComponentBuildRequest{Commit: &commit})
+	require.NoError(t, err)
+	assert.Equal(t, "comp-a-run-1", result.Name)
+}
+
+func TestTriggerComponentBuild_APIError(t *testing.T) {
+	m := mocks.NewMockClientWithResponsesInterface(t)
+	m.EXPECT().TriggerComponentBuildWithResponse(mock.Anything, "org-a", "comp-a", mock.Anything).Return(&gen.TriggerComponentBuildResp{
+		HTTPResponse: httpResp(http.StatusConflict),
+		Body:         []byte(`{"error":"component does not have a workflow configured"}`),
+	}, nil)
+
+	c := newMockClient(m)
+	_, err := c.TriggerComponentBuild(context.Background(), "org-a", "comp-a", gen.TriggerComponentBuildRequest{})
+	require.ErrorContains(t, err, "workflow configured")
+}
+
 // --- CreateComponentRelease ---
 
 func TestCreateComponentRelease_Success(t *testing.T) {
@@ -3462,8 +3546,145 @@ func TestGetProjectDeploymentPipeline_GetPipelineError(t *testing.T) {
 	require.ErrorContains(t, err, "failed to get deployment pipeline")
 }
 
+// --- GetProjectComponentHealth ---
+
+func TestGetProjectComponentHealth_Success(t *testing.T) {
+	m := mocks.NewMockClientWithResponsesInterface(t)
+	m.EXPECT().GetProjectComponentHealthWithResponse(mock.Anything, "org-a", "proj-1").
+		Return(&gen.GetProjectComponentHealthResp{
+			HTTPResponse: httpResp(http.StatusOK),
+			JSON200:      &gen.ProjectComponentHealth{ProjectName: "proj-1", Environments: []string{"dev", "prod"}},
+		}, nil)
+
+	c := newMockClient(m)
+	result, err := c.GetProjectComponentHealth(context.Background(), "org-a", "proj-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dev", "prod"}, result.Environments)
+}
+
+func TestGetProjectComponentHealth_APIError(t *testing.T) {
+	m := mocks.NewMockClientWithResponsesInterface(t)
+	m.EXPECT().GetProjectComponentHealthWithResponse(mock.Anything, "org-a", "proj-1").Return(&gen.GetProjectComponentHealthResp{
+		HTTPResponse: httpResp(http.StatusNotFound),
+		Body:         []byte(`{"error":"project not found"}`),
+	}, nil)
+
+	c := newMockClient(m)
+	_, err := c.GetProjectComponentHealth(context.Background(), "org-a", "proj-1")
+	require.ErrorContains(t, err, "project not found")
+}
+
 // --- Schema methods ---
 
+func TestListKindSchemas_Success(t *testing.T) {
+	m := mocks.NewMockClientWithResponsesInterface(t)
+	m.EXPECT().ListKindSchemasWithResponse(mock.Anything).Return(&gen.ListKindSchemasResp{
+		HTTPResponse: httpResp(http.StatusOK),
+		JSON200: &gen.KindSchemaList{Items: []gen.KindSchema{
+			{Kind: "Component", Group: "openchoreo.dev", Version: "v1alpha1", Schema: map[string]interface{}{"type": "object"}},
+		}},
+	}, nil)
+
+	c := newMockClient(m)
+	result, err := c.ListKindSchemas(context.Background())
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "Component", result[0].Kind)
+}
+
+func TestListKindSchemas_APIError(t *testing.T) {
+	m := mocks.NewMockClientWithResponsesInterface(t)
+	m.EXPECT().ListKindSchemasWithResponse(mock.Anything).Return(&gen.ListKindSchemasResp{
+		HTTPResponse: httpResp(http.StatusInternalServerError),
+		Body:         []byte(`{"error":"internal server error"}`),
+	}, nil)
+
+	c := newMockClient(m)
+	_, err := c.ListKindSchemas(context.Background())
+	require.ErrorContains(t, err, "internal server error")
+}
+
+func TestGetServerVersion_Success(t *testing.T) {
+	m := mocks.NewMockClientWithResponsesInterface(t)
+	m.EXPECT().GetVersionWithResponse(mock.Anything).Return(&gen.GetVersionResp{
+		HTTPResponse: httpResp(http.StatusOK),
+		JSON200:      &gen.VersionResponse{Name: "openchoreo-api", Version: "v1.2.0"},
+	}, nil)
+
+	c := newMockClient(m)
+	result, err := c.GetServerVersion(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "v1.2.0", result.Version)
+}
+
+func TestGetServerVersion_APIError(t *testing.T) {
+	m := mocks.NewMockClientWithResponsesInterface(t)
+	m.EXPECT().GetVersionWithResponse(mock.Anything).Return(&gen.GetVersionResp{
+		HTTPResponse: httpResp(http.StatusBadGateway),
+		Body:         []byte(`{"error":"bad gateway"}`),
+	}, nil)
+
+	c := newMockClient(m)
+	_, err := c.GetServerVersion(context.Background())
+	require.ErrorContains(t, err, "bad gateway")
+}
+
+func TestGetDiagnostics_Success(t *testing.T) {
+	m := mocks.NewMockClientWithResponsesInterface(t)
+	m.EXPECT().GetDiagnosticsWithResponse(mock.Anything).Return(&gen.GetDiagnosticsResp{
+		HTTPResponse: httpResp(http.StatusOK),
+		JSON200: &gen.HealthReport{
+			Status: gen.HealthReportStatusDegraded,
+			Checks: map[string]gen.HealthCheckResult{"gateways": {Status: gen.HealthCheckResultStatusFailed}},
+		},
+	}, nil)
+
+	c := newMockClient(m)
+	result, err := c.GetDiagnostics(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, gen.HealthReportStatusDegraded, result.Status)
+	assert.Contains(t, result.Checks, "gateways")
+}
+
+func TestGetDiagnostics_APIError(t *testing.T) {
+	m := mocks.NewMockClientWithResponsesInterface(t)
+	m.EXPECT().GetDiagnosticsWithResponse(mock.Anything).Return(&gen.GetDiagnosticsResp{
+		HTTPResponse: httpResp(http.StatusUnauthorized),
+		Body:         []byte(`{"error":"unauthorized"}`),
+	}, nil)
+
+	c := newMockClient(m)
+	_, err := c.GetDiagnostics(context.Background())
+	require.ErrorContains(t, err, "unauthorized")
+}
+
+func TestListEndpoints_Success(t *testing.T) {
+	env := "dev"
+	m := mocks.NewMockClientWithResponsesInterface(t)
+	m.EXPECT().ListEndpointsWithResponse(mock.Anything, "org-a", &gen.ListEndpointsParams{Environment: &env}).Return(&gen.ListEndpointsResp{
+		HTTPResponse: httpResp(http.StatusOK),
+		JSON200:      &gen.EndpointList{Items: []gen.Endpoint{{Name: "api", ComponentName: "orders"}}},
+	}, nil)
+
+	c := newMockClient(m)
+	result, err := c.ListEndpoints(context.Background(), "org-a", &gen.ListEndpointsParams{Environment: &env})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, "api", result.Items[0].Name)
+}
+
+func TestListEndpoints_APIError(t *testing.T) {
+	m := mocks.NewMockClientWithResponsesInterface(t)
+	m.EXPECT().ListEndpointsWithResponse(mock.Anything, "org-a", mock.Anything).Return(&gen.ListEndpointsResp{
+		HTTPResponse: httpResp(http.StatusForbidden),
+		Body:         []byte(`{"error":"forbidden"}`),
+	}, nil)
+
+	c := newMockClient(m)
+	_, err := c.ListEndpoints(context.Background(), "org-a", nil)
+	require.ErrorContains(t, err, "forbidden")
+}
+
 func TestGetComponentTypeSchema_Success(t *testing.T) {
 	schema := gen.SchemaResponse{"type": "object"}
 	m := mocks.NewMockClientWithResponsesInterface(t)
@@ -0,0 +1,145 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Watch event types
+const (
+	WatchAdded    = "ADDED"
+	WatchModified = "MODIFIED"
+	WatchDeleted  = "DELETED"
+	WatchBookmark = "BOOKMARK"
+	WatchError    = "ERROR"
+)
+
+// WatchErrorTokenExpired is the code of the error event sent when a watch can
+// no longer be resumed from its token.
+const WatchErrorTokenExpired = "TOKEN_EXPIRED"
+
+// maxWatchEventSize bounds the size of one event, which carries a whole object.
+const maxWatchEventSize = 4 << 20
+
+// WatchParams selects the resources streamed by Watch
+type WatchParams struct {
+	Kinds      []string
+	Namespaces []string
+	// Project and Component limit the kinds owned by a project or component.
+	// Component requires Project.
+	Project   string
+	Component string
+	// Token resumes a watch after the event with this ID.
+	Token string
+}
+
+// WatchEvent is a change to a resource, or a bookmark or error, streamed by
+// the watch endpoint
+type WatchEvent struct {
+	// ID is the token that resumes the watch after this event.
+	ID        string         `json:"-"`
+	Type      string         `json:"type"`
+	Kind      string         `json:"kind,omitempty"`
+	Namespace string         `json:"namespace,omitempty"`
+	Name      string         `json:"name,omitempty"`
+	Object    map[string]any `json:"object,omitempty"`
+	Code      string         `json:"code,omitempty"`
+	Message   string         `json:"message,omitempty"`
+}
+
+// Watch opens a stream of the changes to the selected resources. A watch
+// without a token first sends every existing resource as added. The channel
+// is closed when the stream ends or ctx is cancelled.
+func (c *Client) Watch(ctx context.Context, params WatchParams) (<-chan WatchEvent, error) {
+	query := url.Values{}
+	query.Set("kind", strings.Join(params.Kinds, ","))
+	query.Set("namespace", strings.Join(params.Namespaces, ","))
+	if params.Project != "" {
+		query.Set("project", params.Project)
+	}
+	if params.Component != "" {
+		query.Set("component", params.Component)
+	}
+
+	gc, req, err := c.newRequest(ctx, http.MethodGet, "/api/v1/watch", query)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if params.Token != "" {
+		req.Header.Set("Last-Event-ID", params.Token)
+	}
+
+	// The stream stays open for as long as the caller watches, so the request
+	// timeout of the API client does not apply.
+	doer := gc.Client
+	if hc, ok := doer.(*http.Client); ok {
+		streamClient := *hc
+		streamClient.Timeout = 0
+		doer = &streamClient
+	}
+	resp, err := doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return nil, apiError(resp.StatusCode, []byte(strings.TrimSpace(string(body))))
+	}
+
+	events := make(chan WatchEvent)
+	go func() {
+		defer close(events)
+		defer resp.Body.Close()
+		readWatchEvents(ctx, resp.Body, events)
+	}()
+	return events, nil
+}
+
+// readWatchEvents decodes the Server-Sent Events of r and sends them on events
+// until r ends or ctx is cancelled. Events whose data cannot be decoded are
+// skipped.
+func readWatchEvents(ctx context.Context, r io.Reader, events chan<- WatchEvent) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxWatchEventSize)
+
+	var id, data string
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line != "" {
+			field, value, _ := strings.Cut(line, ":")
+			value = strings.TrimPrefix(value, " ")
+			switch field {
+			case "id":
+				id = value
+			case "data":
+				data += value
+			}
+			continue
+		}
+
+		// A blank line ends the event.
+		if data == "" {
+			continue
+		}
+		var ev WatchEvent
+		if err := json.Unmarshal([]byte(data), &ev); err == nil {
+			ev.ID = id
+			select {
+			case events <- ev:
+			case <-ctx.Done():
+				return
+			}
+		}
+		id, data = "", ""
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatch(t *testing.T) {
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/watch", r.URL.Path)
+		assert.Equal(t, "Component,ReleaseBinding", r.URL.Query().Get("kind"))
+		assert.Equal(t, "acme", r.URL.Query().Get("namespace"))
+		assert.Equal(t, "store", r.URL.Query().Get("project"))
+		assert.Equal(t, "orders", r.URL.Query().Get("component"))
+		assert.Equal(t, "tok-1", r.Header.Get("Last-Event-ID"))
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte("retry: 5000\n\n" +
+			"id: tok-2\nevent: added\ndata: {\"type\":\"ADDED\",\"kind\":\"Component\",\"name\":\"orders\",\"object\":{\"metadata\":{\"name\":\"orders\"}}}\n\n" +
+			"event: error\ndata: {\"type\":\"ERROR\",\"code\":\"TOKEN_EXPIRED\",\"message\":\"expired\"}\n\n"))
+	})
+
+	events, err := c.Watch(context.Background(), WatchParams{
+		Kinds: []string{"Component", "ReleaseBinding"}, Namespaces: []string{"acme"},
+		Project: "store", Component: "orders", Token: "tok-1",
+	})
+	require.NoError(t, err)
+
+	var got []WatchEvent
+	for ev := range events {
+		got = append(got, ev)
+	}
+	require.Len(t, got, 2)
+	assert.Equal(t, "tok-2", got[0].ID)
+	assert.Equal(t, WatchAdded, got[0].Type)
+	assert.Equal(t, "orders", got[0].Object["metadata"].(map[string]any)["name"])
+	assert.Equal(t, WatchError, got[1].Type)
+	assert.Equal(t, WatchErrorTokenExpired, got[1].Code)
+}
+
+func TestWatch_Rejected(t *testing.T) {
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "you do not have permission to watch these namespaces", http.StatusForbidden)
+	})
+
+	_, err := c.Watch(context.Background(), WatchParams{Kinds: []string{"Component"}, Namespaces: []string{"acme"}})
+	assert.EqualError(t, err, "unexpected response (HTTP 403): you do not have permission to watch these namespaces")
+}
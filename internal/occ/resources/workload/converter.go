@@ -4,6 +4,7 @@
 package synth
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"os"
@@ -47,6 +48,19 @@ type WorkloadDescriptorDependencies struct {
 	Endpoints []WorkloadDescriptorConnection `yaml:"endpoints,omitempty"`
 	// Resources define how this workload consumes outputs from project-bound Resources.
 	Resources []WorkloadDescriptorResourceDependency `yaml:"resources,omitempty"`
+	// External define how this workload consumes services running outside OpenChoreo.
+	External []WorkloadDescriptorExternalConnection `yaml:"external,omitempty"`
+}
+
+// WorkloadDescriptorExternalConnection represents a connection to a service running outside
+// OpenChoreo, addressed by a URL or host:port.
+type WorkloadDescriptorExternalConnection struct {
+	// Name identifies the external service within the workload.
+	Name string `yaml:"name"`
+	// Address is the URL (scheme://host[:port][/path]) or host:port of the service.
+	Address string `yaml:"address"`
+	// EnvBindings maps connection address components to env var names.
+	EnvBindings WorkloadDescriptorConnectionEnvBindings `yaml:"envBindings"`
 }
 
 // WorkloadDescriptorResourceDependency represents a dependency on a project-bound Resource.
@@ -137,7 +151,7 @@ func ConvertWorkloadDescriptorToWorkloadCR(descriptorPath string, params CreateW
 	}
 
 	// Convert descriptor to Workload CR with the base directory for resolving relative paths
-	workload, err := convertDescriptorToWorkload(descriptor, params, descriptorPath)
+	workload, err := convertDescriptorToWorkload(descriptor, params, descriptorPath, os.ReadFile)
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert descriptor to workload CR: %w", err)
 	}
@@ -145,8 +159,32 @@ func ConvertWorkloadDescriptorToWorkloadCR(descriptorPath string, params CreateW
 	return workload, nil
 }
 
-func readSchemaFile(path string) (string, error) {
-	content, err := os.ReadFile(path)
+// ConvertWorkloadDescriptor converts the content of a workload.yaml descriptor to a Workload CR.
+// Files the descriptor references, such as endpoint schemas and file configurations, are resolved
+// relative to descriptorPath and loaded with readFile, so they can come from somewhere other than
+// the local filesystem, e.g. a Git repository.
+func ConvertWorkloadDescriptor(
+	data []byte, descriptorPath string, params CreateWorkloadParams, readFile func(path string) ([]byte, error),
+) (*openchoreov1alpha1.Workload, error) {
+	descriptor, err := readWorkloadDescriptorFromReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read workload descriptor: %w", err)
+	}
+
+	if err := validateConversionParams(params); err != nil {
+		return nil, fmt.Errorf("invalid conversion parameters: %w", err)
+	}
+
+	workload, err := convertDescriptorToWorkload(descriptor, params, descriptorPath, readFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert descriptor to workload CR: %w", err)
+	}
+
+	return workload, nil
+}
+
+func readSchemaFile(readFile func(path string) ([]byte, error), path string) (string, error) {
+	content, err := readFile(path)
 	if err != nil {
 		return "", fmt.Errorf("failed to read schema file %s: %w", path, err)
 	}
@@ -219,7 +257,9 @@ func createBaseWorkload(workloadName string, params CreateWorkloadParams) *openc
 	return workload
 }
 
-func convertDescriptorToWorkload(descriptor *WorkloadDescriptor, params CreateWorkloadParams, descriptorPath string) (*openchoreov1alpha1.Workload, error) {
+func convertDescriptorToWorkload(
+	descriptor *WorkloadDescriptor, params CreateWorkloadParams, descriptorPath string, readFile func(path string) ([]byte, error),
+) (*openchoreov1alpha1.Workload, error) {
 	// Determine workload name
 	workloadName := params.ComponentName + "-workload"
 	if workloadName == "" {
@@ -230,7 +270,7 @@ func convertDescriptorToWorkload(descriptor *WorkloadDescriptor, params CreateWo
 	workload := createBaseWorkload(workloadName, params)
 
 	// Add endpoints from descriptor if present
-	if err := addEndpointsFromDescriptor(workload, descriptor, descriptorPath); err != nil {
+	if err := addEndpointsFromDescriptor(workload, descriptor, descriptorPath, readFile); err != nil {
 		return nil, fmt.Errorf("failed to add endpoints: %w", err)
 	}
 
@@ -240,7 +280,7 @@ func convertDescriptorToWorkload(descriptor *WorkloadDescriptor, params CreateWo
 	}
 
 	// Add configurations from descriptor if present
-	if err := addConfigurationsFromDescriptor(workload, descriptor, descriptorPath); err != nil {
+	if err := addConfigurationsFromDescriptor(workload, descriptor, descriptorPath, readFile); err != nil {
 		return nil, fmt.Errorf("failed to add configurations: %w", err)
 	}
 
@@ -248,7 +288,9 @@ func convertDescriptorToWorkload(descriptor *WorkloadDescriptor, params CreateWo
 }
 
 // addEndpointsFromDescriptor adds endpoints from the descriptor to the workload
-func addEndpointsFromDescriptor(workload *openchoreov1alpha1.Workload, descriptor *WorkloadDescriptor, descriptorPath string) error {
+func addEndpointsFromDescriptor(
+	workload *openchoreov1alpha1.Workload, descriptor *WorkloadDescriptor, descriptorPath string, readFile func(path string) ([]byte, error),
+) error {
 	if len(descriptor.Endpoints) == 0 {
 		return nil
 	}
@@ -281,7 +323,7 @@ func addEndpointsFromDescriptor(workload *openchoreov1alpha1.Workload, descripto
 			schemaFilePath := filepath.Join(baseDir, descriptorEndpoint.SchemaFile)
 
 			// Read schema file content and inline it
-			schemaContent, err := readSchemaFile(schemaFilePath)
+			schemaContent, err := readSchemaFile(readFile, schemaFilePath)
 			if err != nil {
 				return fmt.Errorf("failed to read schema file %s: %w", schemaFilePath, err)
 			}
@@ -315,7 +357,8 @@ var validDependencyVisibilities = map[openchoreov1alpha1.EndpointVisibility]bool
 // addDependenciesFromDescriptor adds dependencies from the descriptor to the workload
 func addDependenciesFromDescriptor(workload *openchoreov1alpha1.Workload, descriptor *WorkloadDescriptor) error {
 	if descriptor.Dependencies == nil ||
-		(len(descriptor.Dependencies.Endpoints) == 0 && len(descriptor.Dependencies.Resources) == 0) {
+		(len(descriptor.Dependencies.Endpoints) == 0 && len(descriptor.Dependencies.Resources) == 0 &&
+			len(descriptor.Dependencies.External) == 0) {
 		return nil
 	}
 
@@ -327,8 +370,12 @@ func addDependenciesFromDescriptor(workload *openchoreov1alpha1.Workload, descri
 	if err != nil {
 		return err
 	}
+	external, err := buildExternalConnections(descriptor.Dependencies.External)
+	if err != nil {
+		return err
+	}
 
-	if len(connections) == 0 && len(resources) == 0 {
+	if len(connections) == 0 && len(resources) == 0 && len(external) == 0 {
 		return nil
 	}
 
@@ -337,6 +384,7 @@ func addDependenciesFromDescriptor(workload *openchoreov1alpha1.Workload, descri
 	}
 	workload.Spec.Dependencies.Endpoints = connections
 	workload.Spec.Dependencies.Resources = resources
+	workload.Spec.Dependencies.External = external
 	return nil
 }
 
@@ -404,8 +452,41 @@ func buildResourceDependencies(entries []WorkloadDescriptorResourceDependency) (
 	return resources, nil
 }
 
+func buildExternalConnections(entries []WorkloadDescriptorExternalConnection) ([]openchoreov1alpha1.WorkloadExternalConnection, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	seen := make(map[string]bool, len(entries))
+	external := make([]openchoreov1alpha1.WorkloadExternalConnection, 0, len(entries))
+	for i, de := range entries {
+		if de.Name == "" {
+			return nil, fmt.Errorf("dependency external[%d]: name is required", i)
+		}
+		if seen[de.Name] {
+			return nil, fmt.Errorf("dependency external[%d]: duplicate name %q", i, de.Name)
+		}
+		seen[de.Name] = true
+		if de.Address == "" {
+			return nil, fmt.Errorf("dependency external[%d] (name %q): address is required", i, de.Name)
+		}
+		external = append(external, openchoreov1alpha1.WorkloadExternalConnection{
+			Name:    de.Name,
+			Address: de.Address,
+			EnvBindings: openchoreov1alpha1.ConnectionEnvBindings{
+				Address:  de.EnvBindings.Address,
+				Host:     de.EnvBindings.Host,
+				Port:     de.EnvBindings.Port,
+				BasePath: de.EnvBindings.BasePath,
+			},
+		})
+	}
+	return external, nil
+}
+
 // addConfigurationsFromDescriptor adds configurations (env vars and files) from the descriptor to the workload
-func addConfigurationsFromDescriptor(workload *openchoreov1alpha1.Workload, descriptor *WorkloadDescriptor, descriptorPath string) error {
+func addConfigurationsFromDescriptor(
+	workload *openchoreov1alpha1.Workload, descriptor *WorkloadDescriptor, descriptorPath string, readFile func(path string) ([]byte, error),
+) error {
 	// Add environment variables
 	if len(descriptor.Configurations.Env) > 0 {
 		workload.Spec.Container.Env = make([]openchoreov1alpha1.EnvVar, len(descriptor.Configurations.Env))
@@ -443,7 +524,7 @@ func addConfigurationsFromDescriptor(workload *openchoreov1alpha1.Workload, desc
 			} else if fileVar.ValueFrom != nil && fileVar.ValueFrom.Path != "" {
 				// Read file content from path
 				filePath := filepath.Join(baseDir, fileVar.ValueFrom.Path)
-				content, err := os.ReadFile(filePath)
+				content, err := readFile(filePath)
 				if err != nil {
 					return fmt.Errorf("failed to read file %s: %w", filePath, err)
 				}
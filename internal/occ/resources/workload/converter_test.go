@@ -4,6 +4,8 @@
 package synth
 
 import (
+	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -477,6 +479,63 @@ func TestAddDependenciesFromDescriptor_Resources(t *testing.T) {
 		})
 	}
 }
+func TestAddDependenciesFromDescriptor_External(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []WorkloadDescriptorExternalConnection
+		want    []openchoreov1alpha1.WorkloadExternalConnection
+		wantErr string
+	}{
+		{
+			name: "valid external connection",
+			entries: []WorkloadDescriptorExternalConnection{{
+				Name:        "payments",
+				Address:     "https://api.payments.example.com/v1",
+				EnvBindings: WorkloadDescriptorConnectionEnvBindings{Address: "PAYMENTS_URL", Host: "PAYMENTS_HOST"},
+			}},
+			want: []openchoreov1alpha1.WorkloadExternalConnection{{
+				Name:        "payments",
+				Address:     "https://api.payments.example.com/v1",
+				EnvBindings: openchoreov1alpha1.ConnectionEnvBindings{Address: "PAYMENTS_URL", Host: "PAYMENTS_HOST"},
+			}},
+		},
+		{
+			name:    "missing name",
+			entries: []WorkloadDescriptorExternalConnection{{Address: "db.example.com:5432"}},
+			wantErr: "name is required",
+		},
+		{
+			name:    "missing address",
+			entries: []WorkloadDescriptorExternalConnection{{Name: "db"}},
+			wantErr: "address is required",
+		},
+		{
+			name: "duplicate name",
+			entries: []WorkloadDescriptorExternalConnection{
+				{Name: "db", Address: "db1.example.com:5432"},
+				{Name: "db", Address: "db2.example.com:5432"},
+			},
+			wantErr: "duplicate name",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &openchoreov1alpha1.Workload{}
+			err := addDependenciesFromDescriptor(w, &WorkloadDescriptor{
+				Dependencies: &WorkloadDescriptorDependencies{External: tt.entries},
+			})
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, w.Spec.Dependencies)
+			assert.Equal(t, tt.want, w.Spec.Dependencies.External)
+		})
+	}
+}
+
 func TestReadWorkloadDescriptorDependencies(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -616,7 +675,7 @@ func TestAddEndpointsFromDescriptorVisibilityValidation(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			w := baseWorkload()
-			err := addEndpointsFromDescriptor(w, tt.desc, "/tmp/workload.yaml")
+			err := addEndpointsFromDescriptor(w, tt.desc, "/tmp/workload.yaml", os.ReadFile)
 			if tt.wantErr != "" {
 				require.Error(t, err)
 				assert.Contains(t, err.Error(), tt.wantErr)
@@ -743,12 +802,12 @@ func TestReadSchemaFile(t *testing.T) {
 	t.Run("reads schema content", func(t *testing.T) {
 		dir := t.TempDir()
 		testutil.WriteYAML(t, dir, "schema.json", `{"openapi":"3.0.0"}`)
-		content, err := readSchemaFile(filepath.Join(dir, "schema.json"))
+		content, err := readSchemaFile(os.ReadFile, filepath.Join(dir, "schema.json"))
 		require.NoError(t, err)
 		assert.Equal(t, `{"openapi":"3.0.0"}`, content)
 	})
 	t.Run("returns error for missing file", func(t *testing.T) {
-		_, err := readSchemaFile("/nonexistent/schema.json")
+		_, err := readSchemaFile(os.ReadFile, "/nonexistent/schema.json")
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to read schema file")
 	})
@@ -779,7 +838,7 @@ info:
 			},
 		},
 	}
-	err := addEndpointsFromDescriptor(w, desc, descriptorPath)
+	err := addEndpointsFromDescriptor(w, desc, descriptorPath, os.ReadFile)
 	require.NoError(t, err)
 	require.Contains(t, w.Spec.Endpoints, "api")
 	ep := w.Spec.Endpoints["api"]
@@ -806,7 +865,7 @@ func TestAddEndpointsFromDescriptorSchemaFileMissing(t *testing.T) {
 			},
 		},
 	}
-	err := addEndpointsFromDescriptor(w, desc, descriptorPath)
+	err := addEndpointsFromDescriptor(w, desc, descriptorPath, os.ReadFile)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to read schema file")
 }
@@ -970,7 +1029,7 @@ func TestAddConfigurationsFromDescriptor(t *testing.T) {
 					WorkloadTemplateSpec: openchoreov1alpha1.WorkloadTemplateSpec{},
 				},
 			}
-			err := addConfigurationsFromDescriptor(w, tt.descriptor, descriptorPath)
+			err := addConfigurationsFromDescriptor(w, tt.descriptor, descriptorPath, os.ReadFile)
 			if tt.wantErr != "" {
 				require.Error(t, err)
 				assert.Contains(t, err.Error(), tt.wantErr)
@@ -1229,3 +1288,61 @@ spec:
 		})
 	}
 }
+
+func TestConvertWorkloadDescriptor(t *testing.T) {
+	files := map[string]string{
+		"app/openapi.yaml": "openapi: 3.0.0",
+		"app/nginx.conf":   "worker_processes 1;",
+	}
+	readFile := func(path string) ([]byte, error) {
+		content, ok := files[path]
+		if !ok {
+			return nil, fmt.Errorf("%s: not found", path)
+		}
+		return []byte(content), nil
+	}
+	params := CreateWorkloadParams{
+		NamespaceName: "default",
+		ProjectName:   "shop",
+		ComponentName: "orders",
+		ImageURL:      "ghcr.io/acme/orders:v1",
+	}
+	descriptor := `apiVersion: openchoreo.dev/v1alpha1
+metadata:
+  name: orders
+endpoints:
+  - name: api
+    port: 8080
+    type: REST
+    schemaFile: openapi.yaml
+configurations:
+  files:
+    - name: nginx
+      mountPath: /etc/nginx/nginx.conf
+      valueFrom:
+        path: nginx.conf
+`
+
+	t.Run("resolves referenced files with readFile", func(t *testing.T) {
+		w, err := ConvertWorkloadDescriptor([]byte(descriptor), "app/workload.yaml", params, readFile)
+		require.NoError(t, err)
+		assert.Equal(t, "orders-workload", w.Name)
+		assert.Equal(t, "ghcr.io/acme/orders:v1", w.Spec.Container.Image)
+		require.NotNil(t, w.Spec.Endpoints["api"].Schema)
+		assert.Equal(t, "openapi: 3.0.0", w.Spec.Endpoints["api"].Schema.Content)
+		require.Len(t, w.Spec.Container.Files, 1)
+		assert.Equal(t, "worker_processes 1;", w.Spec.Container.Files[0].Value)
+	})
+
+	t.Run("missing referenced file", func(t *testing.T) {
+		_, err := ConvertWorkloadDescriptor([]byte(descriptor), "workload.yaml", params, readFile)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "openapi.yaml: not found")
+	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		_, err := ConvertWorkloadDescriptor([]byte(descriptor), "workload.yaml", CreateWorkloadParams{}, readFile)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid conversion parameters")
+	})
+}
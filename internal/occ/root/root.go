@@ -9,6 +9,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/apply"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/authzrole"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/authzrolebinding"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/build"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/clusterauthzrole"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/clusterauthzrolebinding"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/clustercomponenttype"
@@ -25,12 +26,20 @@ import (
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/config"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/dataplane"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/deploymentpipeline"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/doctor"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/environment"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/explain"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/export"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/get"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/login"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/logout"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/logs"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/namespace"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/observabilityalertsnotificationchannel"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/observabilityplane"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/open"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/plugin"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/portforward"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/project"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/projectrelease"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/projectreleasebinding"
@@ -40,14 +49,20 @@ import (
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/resourcerelease"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/resourcereleasebinding"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/resourcetype"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/scaffold"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/schema"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/secret"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/secretreference"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/status"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/trait"
+	"github.com/openchoreo/openchoreo/internal/occ/cmd/upgrade"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/version"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/workflow"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/workflowplane"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/workflowrun"
 	"github.com/openchoreo/openchoreo/internal/occ/cmd/workload"
+	"github.com/openchoreo/openchoreo/internal/occ/completion"
+	"github.com/openchoreo/openchoreo/internal/occ/flags"
 	"github.com/openchoreo/openchoreo/internal/occ/resources/client"
 )
 
@@ -59,14 +74,31 @@ func BuildRootCmd() *cobra.Command {
 		Long:  "occ is the command-line interface for OpenChoreo.",
 	}
 
+	flags.AddContext(rootCmd)
+
 	f := client.NewClientFunc(func() (client.Interface, error) {
 		return client.NewClient()
 	})
 
 	rootCmd.AddCommand(
 		apply.NewApplyCmd(f),
+		apply.NewDiffCmd(f),
+		apply.NewValidateCmd(),
+		build.NewBuildCmd(f),
+		doctor.NewDoctorCmd(f),
+		explain.NewExplainCmd(f),
+		export.NewExportCmd(f),
+		get.NewGetCmd(f),
 		login.NewLoginCmd(),
 		logout.NewLogoutCmd(),
+		logs.NewLogsCmd(f),
+		open.NewOpenCmd(f),
+		plugin.NewPluginCmd(),
+		portforward.NewPortForwardCmd(),
+		scaffold.NewScaffoldCmd(f),
+		schema.NewSchemaCmd(f),
+		status.NewStatusCmd(f),
+		upgrade.NewUpgradeCmd(f),
 		config.NewConfigCmd(),
 		version.NewVersionCmd(),
 		componentrelease.NewComponentReleaseCmd(f),
@@ -107,6 +139,7 @@ func BuildRootCmd() *cobra.Command {
 		deploymentpipeline.NewDeploymentPipelineCmd(f),
 		observabilityalertsnotificationchannel.NewObservabilityAlertsNotificationChannelCmd(f),
 	)
+	completion.Register(rootCmd, f)
 
 	return rootCmd
 }
@@ -15,6 +15,7 @@ func TestBuildRootCmd_Metadata(t *testing.T) {
 	assert.Equal(t, "occ", cmd.Use)
 	assert.Equal(t, "OpenChoreo CLI", cmd.Short)
 	assert.NotEmpty(t, cmd.Long)
+	assert.NotNil(t, cmd.PersistentFlags().Lookup("context"))
 }
 
 func TestBuildRootCmd_Subcommands(t *testing.T) {
@@ -22,8 +23,23 @@ func TestBuildRootCmd_Subcommands(t *testing.T) {
 
 	expected := []string{
 		"apply",
+		"diff",
+		"validate",
+		"build",
+		"doctor",
+		"explain",
+		"export",
+		"get",
 		"login",
 		"logout",
+		"logs",
+		"open",
+		"plugin",
+		"port-forward",
+		"scaffold",
+		"schema",
+		"status",
+		"upgrade",
 		"config",
 		"version",
 		"componentrelease",
@@ -0,0 +1,126 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package etag provides the OpenAPI middleware that tags GET responses with
+// ETags and answers conditional requests with 304 Not Modified.
+package etag
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/versioning"
+)
+
+// hashLength is the number of hex digits of the content hash used as the ETag
+// of responses without a resource version.
+const hashLength = 16
+
+// Middleware is an OpenAPI middleware that sets an ETag on successful JSON
+// responses to GET requests. Objects carrying metadata.resourceVersion get a
+// weak ETag derived from it, qualified by the response version; other
+// responses, such as lists and schemas, get a strong ETag hashing the body.
+// A request whose If-None-Match header matches the ETag gets 304 Not Modified
+// without a body.
+//
+// The middleware must run outside the middleware that select fields and
+// convert responses, so that the ETag describes the response as sent.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rec := &bufferedResponseWriter{header: w.Header(), statusCode: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		if rec.statusCode != http.StatusOK || !isJSON(w.Header().Get("Content-Type")) {
+			w.WriteHeader(rec.statusCode)
+			_, _ = w.Write(rec.body.Bytes())
+			return
+		}
+
+		tag := For(rec.body.Bytes(), w.Header().Get(versioning.HeaderAPIVersion))
+		w.Header().Set("ETag", tag)
+		if Match(r.Header.Get("If-None-Match"), tag) {
+			w.Header().Del("Content-Type")
+			w.Header().Del("Content-Length")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.WriteHeader(rec.statusCode)
+		_, _ = w.Write(rec.body.Bytes())
+	})
+}
+
+// For returns the ETag of a JSON response body served in the given response
+// version, which may be empty.
+func For(body []byte, version string) string {
+	var doc struct {
+		Metadata struct {
+			ResourceVersion string `json:"resourceVersion"`
+		} `json:"metadata"`
+	}
+	if err := json.Unmarshal(body, &doc); err == nil && doc.Metadata.ResourceVersion != "" {
+		// Field selection happens in the query, so it is part of the cache
+		// key already; the response version is negotiated with Accept.
+		if version != "" {
+			return `W/"` + doc.Metadata.ResourceVersion + "-" + version + `"`
+		}
+		return `W/"` + doc.Metadata.ResourceVersion + `"`
+	}
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:])[:hashLength] + `"`
+}
+
+// Match reports whether the If-None-Match header value matches tag. The
+// comparison is weak, as RFC 9110 requires for If-None-Match.
+func Match(ifNoneMatch, tag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	tag = strings.TrimPrefix(tag, "W/")
+	for candidate := range strings.SplitSeq(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"))
+}
+
+// bufferedResponseWriter holds a response so that its ETag can be computed
+// before it is written.
+type bufferedResponseWriter struct {
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+	written    bool
+}
+
+func (rw *bufferedResponseWriter) Header() http.Header {
+	return rw.header
+}
+
+func (rw *bufferedResponseWriter) WriteHeader(code int) {
+	if !rw.written {
+		rw.statusCode = code
+		rw.written = true
+	}
+}
+
+func (rw *bufferedResponseWriter) Write(b []byte) (int, error) {
+	rw.written = true
+	return rw.body.Write(b)
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package etag
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/versioning"
+)
+
+const resourceJSON = `{"metadata":{"name":"db","resourceVersion":"42"},"spec":{}}`
+
+func newTestHandler(status int, version, body string) http.Handler {
+	return Middleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if version != "" {
+			w.Header().Set(versioning.HeaderAPIVersion, version)
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+}
+
+func serve(handler http.Handler, method, ifNoneMatch string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/api/v1/namespaces/default/resources/db", nil)
+	if ifNoneMatch != "" {
+		req.Header.Set("If-None-Match", ifNoneMatch)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Run("objects are tagged by resource version", func(t *testing.T) {
+		rec := serve(newTestHandler(http.StatusOK, "", resourceJSON), http.MethodGet, "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `W/"42"`, rec.Header().Get("ETag"))
+		assert.Equal(t, resourceJSON, rec.Body.String())
+	})
+
+	t.Run("response version qualifies the tag", func(t *testing.T) {
+		rec := serve(newTestHandler(http.StatusOK, "v1", resourceJSON), http.MethodGet, "")
+		assert.Equal(t, `W/"42-v1"`, rec.Header().Get("ETag"))
+	})
+
+	t.Run("other responses are tagged by content", func(t *testing.T) {
+		first := serve(newTestHandler(http.StatusOK, "", `{"items":[1]}`), http.MethodGet, "")
+		second := serve(newTestHandler(http.StatusOK, "", `{"items":[2]}`), http.MethodGet, "")
+		assert.Regexp(t, `^"[0-9a-f]{16}"$`, first.Header().Get("ETag"))
+		assert.NotEqual(t, first.Header().Get("ETag"), second.Header().Get("ETag"))
+	})
+
+	t.Run("matching If-None-Match is not modified", func(t *testing.T) {
+		for _, header := range []string{`W/"42"`, `"42"`, `"other", W/"42"`, `*`} {
+			rec := serve(newTestHandler(http.StatusOK, "", resourceJSON), http.MethodGet, header)
+			assert.Equal(t, http.StatusNotModified, rec.Code, header)
+			assert.Empty(t, rec.Body.String(), header)
+			assert.Equal(t, `W/"42"`, rec.Header().Get("ETag"), header)
+		}
+	})
+
+	t.Run("stale If-None-Match gets the response", func(t *testing.T) {
+		rec := serve(newTestHandler(http.StatusOK, "", resourceJSON), http.MethodGet, `W/"41"`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, resourceJSON, rec.Body.String())
+	})
+
+	t.Run("errors and other methods are not tagged", func(t *testing.T) {
+		rec := serve(newTestHandler(http.StatusNotFound, "", `{"code":"NOT_FOUND"}`), http.MethodGet, "*")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Empty(t, rec.Header().Get("ETag"))
+
+		rec = serve(newTestHandler(http.StatusOK, "", resourceJSON), http.MethodPut, "*")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get("ETag"))
+	})
+}
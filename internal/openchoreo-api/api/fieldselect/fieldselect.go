@@ -0,0 +1,241 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fieldselect implements sparse fieldsets: callers name the fields of
+// a response they need, and everything else is dropped before the response is
+// sent.
+//
+// A field list is a comma-separated list of paths such as
+// "metadata.name,status.conditions". Path segments are separated by dots, and
+// keys that contain dots or other special characters are written in brackets,
+// as in metadata.annotations['openchoreo.dev/display-name']. A leading "$." is
+// accepted for JSONPath familiarity. Selecting a field selects everything
+// below it, and a path that crosses an array applies to every element, so
+// spec.endpoints.name selects the name of each endpoint. "[*]" may be written
+// to make that explicit.
+package fieldselect
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Selector selects fields of decoded JSON values.
+type Selector struct {
+	root *node
+}
+
+// node is a selected field. A node without children selects its whole value.
+type node struct {
+	children map[string]*node
+}
+
+// Parse parses a comma-separated field list. It returns nil for an empty list,
+// which selects everything.
+func Parse(expr string) (*Selector, error) {
+	if strings.TrimSpace(expr) == "" {
+		return nil, nil
+	}
+	paths, err := splitPaths(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	root := &node{children: map[string]*node{}}
+	for _, path := range paths {
+		segments, err := parsePath(path)
+		if err != nil {
+			return nil, err
+		}
+		root.add(segments)
+	}
+	return &Selector{root: root}, nil
+}
+
+// add merges a path into the tree. A shorter path selects the whole subtree,
+// so it wins over longer paths below it.
+func (n *node) add(segments []string) {
+	for i, segment := range segments {
+		child, ok := n.children[segment]
+		if !ok {
+			child = &node{children: map[string]*node{}}
+			n.children[segment] = child
+		} else if len(child.children) == 0 {
+			return
+		}
+		if i == len(segments)-1 {
+			child.children = map[string]*node{}
+		}
+		n = child
+	}
+}
+
+// Apply returns the selected fields of a decoded JSON value. Objects keep only
+// the selected keys that are present, and arrays keep every element with the
+// selection applied to it. A nil Selector returns v unchanged.
+func (s *Selector) Apply(v any) any {
+	if s == nil {
+		return v
+	}
+	selected, _ := s.root.apply(v)
+	return selected
+}
+
+// ApplyToItems applies the selection to each element of the array under
+// listKey in a list response, keeping the other members of the response, such
+// as pagination, as they are. Values that are not such a list are handled
+// like Apply.
+func (s *Selector) ApplyToItems(v any, listKey string) any {
+	if s == nil {
+		return v
+	}
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return s.Apply(v)
+	}
+	items, ok := obj[listKey].([]any)
+	if !ok {
+		return s.Apply(v)
+	}
+
+	selected := make(map[string]any, len(obj))
+	for key, value := range obj {
+		selected[key] = value
+	}
+	projected := make([]any, 0, len(items))
+	for _, item := range items {
+		projected = append(projected, s.Apply(item))
+	}
+	selected[listKey] = projected
+	return selected
+}
+
+func (n *node) apply(v any) (any, bool) {
+	if len(n.children) == 0 {
+		return v, true
+	}
+	switch value := v.(type) {
+	case map[string]any:
+		selected := make(map[string]any, len(n.children))
+		for key, child := range n.children {
+			field, ok := value[key]
+			if !ok {
+				continue
+			}
+			if projected, ok := child.apply(field); ok {
+				selected[key] = projected
+			}
+		}
+		return selected, true
+	case []any:
+		selected := make([]any, 0, len(value))
+		for _, element := range value {
+			if projected, ok := n.apply(element); ok {
+				selected = append(selected, projected)
+			}
+		}
+		return selected, true
+	default:
+		// A scalar has no fields to select.
+		return nil, false
+	}
+}
+
+// splitPaths splits a field list on the commas outside brackets.
+func splitPaths(expr string) ([]string, error) {
+	var paths []string
+	depth, start := 0, 0
+	var quote rune
+	for i, r := range expr {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '[':
+			depth++
+		case r == ']':
+			depth--
+		case r == ',' && depth == 0:
+			paths = append(paths, expr[start:i])
+			start = i + 1
+		}
+	}
+	if quote != 0 || depth != 0 {
+		return nil, fmt.Errorf("invalid fields %q: unbalanced brackets or quotes", expr)
+	}
+	return append(paths, expr[start:]), nil
+}
+
+// parsePath parses a single path into its keys. Wildcard array segments are
+// dropped, because paths already apply to every element of an array.
+func parsePath(path string) ([]string, error) {
+	p := strings.TrimSpace(path)
+	p = strings.TrimPrefix(p, "$")
+	if p == "" {
+		return nil, fmt.Errorf("invalid field %q: empty path", path)
+	}
+
+	var segments []string
+	expectKey := !strings.HasPrefix(p, ".") && !strings.HasPrefix(p, "[")
+	for p != "" {
+		switch {
+		case strings.HasPrefix(p, "["):
+			end := strings.Index(p, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("invalid field %q: missing ']'", path)
+			}
+			inner := strings.TrimSpace(p[1:end])
+			p = p[end+1:]
+			if inner == "*" {
+				continue
+			}
+			key, ok := unquote(inner)
+			if !ok || key == "" {
+				return nil, fmt.Errorf("invalid field %q: bracketed keys must be quoted strings", path)
+			}
+			segments = append(segments, key)
+		case strings.HasPrefix(p, "."):
+			if expectKey {
+				return nil, fmt.Errorf("invalid field %q: empty segment", path)
+			}
+			p = p[1:]
+			expectKey = true
+		case expectKey:
+			end := strings.IndexAny(p, ".[")
+			if end < 0 {
+				end = len(p)
+			}
+			key := p[:end]
+			if key == "" {
+				return nil, fmt.Errorf("invalid field %q: empty segment", path)
+			}
+			if key != "*" {
+				segments = append(segments, key)
+			}
+			p = p[end:]
+			expectKey = false
+		default:
+			return nil, fmt.Errorf("invalid field %q", path)
+		}
+	}
+	if expectKey {
+		return nil, fmt.Errorf("invalid field %q: empty segment", path)
+	}
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("invalid field %q: selects no field", path)
+	}
+	return segments, nil
+}
+
+func unquote(s string) (string, bool) {
+	if len(s) < 2 {
+		return "", false
+	}
+	if (s[0] == '\'' && s[len(s)-1] == '\'') || (s[0] == '"' && s[len(s)-1] == '"') {
+		return s[1 : len(s)-1], true
+	}
+	return "", false
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fieldselect
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const resourceJSON = `{
+  "metadata": {
+    "name": "db",
+    "namespace": "default",
+    "annotations": {"openchoreo.dev/display-name": "Database", "note": "x"}
+  },
+  "spec": {"type": {"kind": "ResourceType", "name": "postgres"}, "parameters": {"size": "small"}},
+  "status": {
+    "conditions": [
+      {"type": "Ready", "status": "True", "reason": "Deployed"},
+      {"type": "Synced", "status": "False", "reason": "Pending"}
+    ]
+  }
+}`
+
+func decode(t *testing.T, s string) any {
+	t.Helper()
+	var v any
+	require.NoError(t, json.Unmarshal([]byte(s), &v))
+	return v
+}
+
+func TestSelectorApply(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields string
+		want   string
+	}{
+		{
+			name:   "single field",
+			fields: "metadata.name",
+			want:   `{"metadata":{"name":"db"}}`,
+		},
+		{
+			name:   "several fields",
+			fields: "metadata.name, status.conditions",
+			want:   `{"metadata":{"name":"db"},"status":{"conditions":[{"type":"Ready","status":"True","reason":"Deployed"},{"type":"Synced","status":"False","reason":"Pending"}]}}`,
+		},
+		{
+			name:   "fields of array elements",
+			fields: "status.conditions.type",
+			want:   `{"status":{"conditions":[{"type":"Ready"},{"type":"Synced"}]}}`,
+		},
+		{
+			name:   "explicit wildcard and JSONPath root",
+			fields: "$.status.conditions[*].reason",
+			want:   `{"status":{"conditions":[{"reason":"Deployed"},{"reason":"Pending"}]}}`,
+		},
+		{
+			name:   "bracketed key with dots",
+			fields: "metadata.annotations['openchoreo.dev/display-name']",
+			want:   `{"metadata":{"annotations":{"openchoreo.dev/display-name":"Database"}}}`,
+		},
+		{
+			name:   "parent field wins over child field",
+			fields: "spec.type.name,spec",
+			want:   `{"spec":{"type":{"kind":"ResourceType","name":"postgres"},"parameters":{"size":"small"}}}`,
+		},
+		{
+			name:   "missing fields are skipped",
+			fields: "metadata.labels,metadata.name.first,spec.type.kind",
+			want:   `{"metadata":{},"spec":{"type":{"kind":"ResourceType"}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := Parse(tt.fields)
+			require.NoError(t, err)
+			got, err := json.Marshal(selector.Apply(decode(t, resourceJSON)))
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestSelectorApplyToItems(t *testing.T) {
+	selector, err := Parse("metadata.name")
+	require.NoError(t, err)
+
+	list := decode(t, `{"items":[`+resourceJSON+`,`+resourceJSON+`],"pagination":{"nextCursor":"abc"}}`)
+	got, err := json.Marshal(selector.ApplyToItems(list, "items"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"items":[{"metadata":{"name":"db"}},{"metadata":{"name":"db"}}],"pagination":{"nextCursor":"abc"}}`, string(got))
+
+	// A value that is not a list is selected as a whole.
+	got, err = json.Marshal(selector.ApplyToItems(decode(t, resourceJSON), "items"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"metadata":{"name":"db"}}`, string(got))
+}
+
+func TestParse(t *testing.T) {
+	selector, err := Parse("  ")
+	require.NoError(t, err)
+	assert.Nil(t, selector, "an empty field list selects everything")
+	assert.Equal(t, "unchanged", selector.Apply("unchanged"))
+
+	for _, fields := range []string{
+		"metadata..name",
+		"metadata.",
+		".",
+		"metadata,",
+		"metadata[name]",
+		"metadata['name'",
+		"metadata['name']x",
+		"[*]",
+	} {
+		t.Run(fields, func(t *testing.T) {
+			_, err := Parse(fields)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fieldselect
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	apilogger "github.com/openchoreo/openchoreo/internal/server/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/problem"
+)
+
+const (
+	// QueryParam is the query parameter that carries the field list.
+	QueryParam = "fields"
+	// listKey is the member that holds the items of REST list responses.
+	listKey = "items"
+)
+
+// Middleware is an OpenAPI middleware that reduces the successful JSON
+// responses of GET requests to the fields named by the fields query parameter.
+// List responses are reduced item by item and keep their pagination. An
+// invalid field list is rejected with 400 before the handler runs.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		expr := r.URL.Query().Get(QueryParam)
+		if r.Method != http.MethodGet || expr == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		selector, err := Parse(expr)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, gen.BADREQUEST, err.Error())
+			return
+		}
+
+		rec := &bufferedResponseWriter{header: w.Header(), statusCode: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		if rec.statusCode < 200 || rec.statusCode >= 300 || !isJSON(w.Header().Get("Content-Type")) {
+			w.WriteHeader(rec.statusCode)
+			_, _ = w.Write(rec.body.Bytes())
+			return
+		}
+
+		var doc any
+		err = json.Unmarshal(rec.body.Bytes(), &doc)
+		if err == nil {
+			var body []byte
+			if body, err = json.Marshal(selector.ApplyToItems(doc, listKey)); err == nil {
+				w.Header().Del("Content-Length")
+				w.WriteHeader(rec.statusCode)
+				_, _ = w.Write(append(body, '\n'))
+				return
+			}
+		}
+		apilogger.GetLogger(r.Context()).Error("Failed to select response fields",
+			"pattern", r.Pattern, "error", err)
+		writeError(w, http.StatusInternalServerError, gen.INTERNALERROR, "Internal server error")
+	})
+}
+
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"))
+}
+
+// bufferedResponseWriter holds a response so its fields can be selected before
+// it is written.
+type bufferedResponseWriter struct {
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+	written    bool
+}
+
+func (rw *bufferedResponseWriter) Header() http.Header {
+	return rw.header
+}
+
+func (rw *bufferedResponseWriter) WriteHeader(code int) {
+	if !rw.written {
+		rw.statusCode = code
+		rw.written = true
+	}
+}
+
+func (rw *bufferedResponseWriter) Write(b []byte) (int, error) {
+	rw.written = true
+	return rw.body.Write(b)
+}
+
+func writeError(w http.ResponseWriter, status int, code gen.ErrorResponseCode, msg string) {
+	problem.Write(w, problem.New(status, string(code), msg))
+}
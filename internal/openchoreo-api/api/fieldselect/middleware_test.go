@@ -0,0 +1,112 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fieldselect
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestHandler(status int, contentType, body string) http.Handler {
+	return Middleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+}
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		target      string
+		status      int
+		contentType string
+		body        string
+		wantStatus  int
+		wantBody    string
+	}{
+		{
+			name:        "selects fields of a single object",
+			method:      http.MethodGet,
+			target:      "/api/v1/namespaces/default/resources/db?fields=metadata.name",
+			status:      http.StatusOK,
+			contentType: "application/json",
+			body:        resourceJSON,
+			wantStatus:  http.StatusOK,
+			wantBody:    `{"metadata":{"name":"db"}}`,
+		},
+		{
+			name:        "selects fields of list items",
+			method:      http.MethodGet,
+			target:      "/api/v1/namespaces/default/resources?fields=status.conditions.type",
+			status:      http.StatusOK,
+			contentType: "application/json",
+			body:        `{"items":[` + resourceJSON + `],"pagination":{"nextCursor":"abc"}}`,
+			wantStatus:  http.StatusOK,
+			wantBody:    `{"items":[{"status":{"conditions":[{"type":"Ready"},{"type":"Synced"}]}}],"pagination":{"nextCursor":"abc"}}`,
+		},
+		{
+			name:        "without fields the response is untouched",
+			method:      http.MethodGet,
+			target:      "/api/v1/namespaces/default/resources/db",
+			status:      http.StatusOK,
+			contentType: "application/json",
+			body:        `{"a":1}`,
+			wantStatus:  http.StatusOK,
+			wantBody:    `{"a":1}`,
+		},
+		{
+			name:        "errors are passed through",
+			method:      http.MethodGet,
+			target:      "/api/v1/namespaces/default/resources/db?fields=metadata.name",
+			status:      http.StatusNotFound,
+			contentType: "application/json",
+			body:        `{"code":"NOT_FOUND","error":"resource not found"}`,
+			wantStatus:  http.StatusNotFound,
+			wantBody:    `{"code":"NOT_FOUND","error":"resource not found"}`,
+		},
+		{
+			name:        "non-GET requests are untouched",
+			method:      http.MethodPost,
+			target:      "/api/v1/namespaces/default/resources?fields=metadata.name",
+			status:      http.StatusCreated,
+			contentType: "application/json",
+			body:        `{"a":1}`,
+			wantStatus:  http.StatusCreated,
+			wantBody:    `{"a":1}`,
+		},
+		{
+			name:        "invalid fields are rejected",
+			method:      http.MethodGet,
+			target:      "/api/v1/namespaces/default/resources/db?fields=metadata..name",
+			status:      http.StatusOK,
+			contentType: "application/json",
+			body:        resourceJSON,
+			wantStatus:  http.StatusBadRequest,
+			wantBody: `{"type":"urn:openchoreo:problem:bad-request","title":"Bad Request","status":400,` +
+				`"detail":"invalid field \"metadata..name\": empty segment","code":"BAD_REQUEST",` +
+				`"error":"invalid field \"metadata..name\": empty segment"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			newTestHandler(tt.status, tt.contentType, tt.body).ServeHTTP(rec, httptest.NewRequest(tt.method, tt.target, nil))
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.JSONEq(t, tt.wantBody, rec.Body.String())
+		})
+	}
+
+	t.Run("non-JSON responses are untouched", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		newTestHandler(http.StatusOK, "text/plain", "plain logs").
+			ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/logs?fields=metadata.name", nil))
+		assert.Equal(t, "plain logs", rec.Body.String())
+	})
+}
@@ -15,6 +15,18 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for APITokenAccess.
+const (
+	Read  APITokenAccess = "read"
+	Write APITokenAccess = "write"
+)
+
+// Defines values for AcceptComponentProposalsRequestClassKind.
+const (
+	AcceptComponentProposalsRequestClassKindClusterComponentType AcceptComponentProposalsRequestClassKind = "ClusterComponentType"
+	AcceptComponentProposalsRequestClassKindComponentType        AcceptComponentProposalsRequestClassKind = "ComponentType"
+)
+
 // Defines values for ActionInfoLowestScope.
 const (
 	ActionInfoLowestScopeCluster   ActionInfoLowestScope = "cluster"
@@ -24,6 +36,12 @@ const (
 	ActionInfoLowestScopeResource  ActionInfoLowestScope = "resource"
 )
 
+// Defines values for ApplyResourceResultOperation.
+const (
+	ApplyResourceResultOperationCreated ApplyResourceResultOperation = "created"
+	ApplyResourceResultOperationUpdated ApplyResourceResultOperation = "updated"
+)
+
 // Defines values for AuthzRoleBindingSpecEffect.
 const (
 	AuthzRoleBindingSpecEffectAllow AuthzRoleBindingSpecEffect = "allow"
@@ -36,6 +54,33 @@ const (
 	AuthzRoleRefKindClusterAuthzRole AuthzRoleRefKind = "ClusterAuthzRole"
 )
 
+// Defines values for BatchItemResultOperation.
+const (
+	BatchItemResultOperationCreated   BatchItemResultOperation = "created"
+	BatchItemResultOperationDeleted   BatchItemResultOperation = "deleted"
+	BatchItemResultOperationUnchanged BatchItemResultOperation = "unchanged"
+	BatchItemResultOperationUpdated   BatchItemResultOperation = "updated"
+)
+
+// Defines values for BatchItemResultStatus.
+const (
+	BatchItemResultStatusFailed    BatchItemResultStatus = "Failed"
+	BatchItemResultStatusSucceeded BatchItemResultStatus = "Succeeded"
+)
+
+// Defines values for BatchResultStatus.
+const (
+	BatchResultStatusFailed             BatchResultStatus = "Failed"
+	BatchResultStatusPartiallySucceeded BatchResultStatus = "PartiallySucceeded"
+	BatchResultStatusSucceeded          BatchResultStatus = "Succeeded"
+)
+
+// Defines values for BuildCacheConfigType.
+const (
+	PersistentVolume BuildCacheConfigType = "PersistentVolume"
+	Registry         BuildCacheConfigType = "Registry"
+)
+
 // Defines values for ClusterAuthzRoleBindingSpecEffect.
 const (
 	ClusterAuthzRoleBindingSpecEffectAllow ClusterAuthzRoleBindingSpecEffect = "allow"
@@ -106,6 +151,29 @@ const (
 	ClusterWorkflowPlaneRefKindClusterWorkflowPlane ClusterWorkflowPlaneRefKind = "ClusterWorkflowPlane"
 )
 
+// Defines values for ComponentEnvironmentHealthHealth.
+const (
+	ComponentEnvironmentHealthHealthDegraded    ComponentEnvironmentHealthHealth = "Degraded"
+	ComponentEnvironmentHealthHealthHealthy     ComponentEnvironmentHealthHealth = "Healthy"
+	ComponentEnvironmentHealthHealthNotDeployed ComponentEnvironmentHealthHealth = "NotDeployed"
+	ComponentEnvironmentHealthHealthProgressing ComponentEnvironmentHealthHealth = "Progressing"
+	ComponentEnvironmentHealthHealthSuspended   ComponentEnvironmentHealthHealth = "Suspended"
+	ComponentEnvironmentHealthHealthUnknown     ComponentEnvironmentHealthHealth = "Unknown"
+)
+
+// Defines values for ComponentProposalBuild.
+const (
+	Buildpacks ComponentProposalBuild = "Buildpacks"
+	Docker     ComponentProposalBuild = "Docker"
+)
+
+// Defines values for ComponentProposalType.
+const (
+	ScheduledTask  ComponentProposalType = "ScheduledTask"
+	Service        ComponentProposalType = "Service"
+	WebApplication ComponentProposalType = "WebApplication"
+)
+
 // Defines values for ComponentSpecComponentTypeKind.
 const (
 	ComponentSpecComponentTypeKindClusterComponentType ComponentSpecComponentTypeKind = "ClusterComponentType"
@@ -176,6 +244,21 @@ const (
 	ConditionStatusUnknown ConditionStatus = "Unknown"
 )
 
+// Defines values for ControlComponentRolloutRequestAction.
+const (
+	Abort   ControlComponentRolloutRequestAction = "abort"
+	Pause   ControlComponentRolloutRequestAction = "pause"
+	Promote ControlComponentRolloutRequestAction = "promote"
+	Resume  ControlComponentRolloutRequestAction = "resume"
+)
+
+// Defines values for CostReportAggregate.
+const (
+	CostReportAggregateComponent   CostReportAggregate = "component"
+	CostReportAggregateEnvironment CostReportAggregate = "environment"
+	CostReportAggregateProject     CostReportAggregate = "project"
+)
+
 // Defines values for CreateGitSecretRequestSecretType.
 const (
 	BasicAuth CreateGitSecretRequestSecretType = "basic-auth"
@@ -188,6 +271,63 @@ const (
 	CreateGitSecretRequestWorkflowPlaneKindWorkflowPlane        CreateGitSecretRequestWorkflowPlaneKind = "WorkflowPlane"
 )
 
+// Defines values for DependencyGraphEdgeType.
+const (
+	DependencyGraphEdgeTypeEndpoint     DependencyGraphEdgeType = "endpoint"
+	DependencyGraphEdgeTypeExternal     DependencyGraphEdgeType = "external"
+	DependencyGraphEdgeTypeResource     DependencyGraphEdgeType = "resource"
+	DependencyGraphEdgeTypeSharedSecret DependencyGraphEdgeType = "sharedSecret"
+)
+
+// Defines values for DependencyGraphNodeKind.
+const (
+	DependencyGraphNodeKindComponent DependencyGraphNodeKind = "component"
+	DependencyGraphNodeKindExternal  DependencyGraphNodeKind = "external"
+	DependencyGraphNodeKindResource  DependencyGraphNodeKind = "resource"
+	DependencyGraphNodeKindSecret    DependencyGraphNodeKind = "secret"
+)
+
+// Defines values for EndpointType.
+const (
+	EndpointTypeGRPC      EndpointType = "gRPC"
+	EndpointTypeGraphQL   EndpointType = "GraphQL"
+	EndpointTypeHTTP      EndpointType = "HTTP"
+	EndpointTypeTCP       EndpointType = "TCP"
+	EndpointTypeUDP       EndpointType = "UDP"
+	EndpointTypeWebsocket EndpointType = "Websocket"
+)
+
+// Defines values for EndpointVisibility.
+const (
+	EndpointVisibilityExternal  EndpointVisibility = "external"
+	EndpointVisibilityInternal  EndpointVisibility = "internal"
+	EndpointVisibilityNamespace EndpointVisibility = "namespace"
+	EndpointVisibilityProject   EndpointVisibility = "project"
+)
+
+// Defines values for EndpointCertificateAlert.
+const (
+	Expired        EndpointCertificateAlert = "Expired"
+	NotIssued      EndpointCertificateAlert = "NotIssued"
+	RenewalOverdue EndpointCertificateAlert = "RenewalOverdue"
+)
+
+// Defines values for EndpointCertificateVisibility.
+const (
+	EndpointCertificateVisibilityExternal  EndpointCertificateVisibility = "external"
+	EndpointCertificateVisibilityInternal  EndpointCertificateVisibility = "internal"
+	EndpointCertificateVisibilityNamespace EndpointCertificateVisibility = "namespace"
+	EndpointCertificateVisibilityProject   EndpointCertificateVisibility = "project"
+)
+
+// Defines values for EndpointCertificateStatusVisibility.
+const (
+	EndpointCertificateStatusVisibilityExternal  EndpointCertificateStatusVisibility = "external"
+	EndpointCertificateStatusVisibilityInternal  EndpointCertificateStatusVisibility = "internal"
+	EndpointCertificateStatusVisibilityNamespace EndpointCertificateStatusVisibility = "namespace"
+	EndpointCertificateStatusVisibilityProject   EndpointCertificateStatusVisibility = "project"
+)
+
 // Defines values for EndpointURLStatusType.
 const (
 	EndpointURLStatusTypeGRPC      EndpointURLStatusType = "gRPC"
@@ -204,12 +344,19 @@ const (
 	EnvironmentSpecDataPlaneRefKindDataPlane        EnvironmentSpecDataPlaneRefKind = "DataPlane"
 )
 
+// Defines values for EnvironmentTLSIssuerRefKind.
+const (
+	ClusterIssuer EnvironmentTLSIssuerRefKind = "ClusterIssuer"
+	Issuer        EnvironmentTLSIssuerRefKind = "Issuer"
+)
+
 // Defines values for ErrorResponseCode.
 const (
 	BADREQUEST           ErrorResponseCode = "BAD_REQUEST"
 	CONFLICT             ErrorResponseCode = "CONFLICT"
 	FORBIDDEN            ErrorResponseCode = "FORBIDDEN"
 	INTERNALERROR        ErrorResponseCode = "INTERNAL_ERROR"
+	NOTACCEPTABLE        ErrorResponseCode = "NOT_ACCEPTABLE"
 	NOTFOUND             ErrorResponseCode = "NOT_FOUND"
 	NOTIMPLEMENTED       ErrorResponseCode = "NOT_IMPLEMENTED"
 	UNAUTHORIZED         ErrorResponseCode = "UNAUTHORIZED"
@@ -222,12 +369,31 @@ const (
 	ExternalRefKindSecretReference ExternalRefKind = "SecretReference"
 )
 
+// Defines values for HealthCheckResultStatus.
+const (
+	HealthCheckResultStatusFailed HealthCheckResultStatus = "failed"
+	HealthCheckResultStatusOk     HealthCheckResultStatus = "ok"
+)
+
+// Defines values for HealthReportStatus.
+const (
+	HealthReportStatusDegraded HealthReportStatus = "degraded"
+	HealthReportStatusFailed   HealthReportStatus = "failed"
+	HealthReportStatusOk       HealthReportStatus = "ok"
+)
+
 // Defines values for NamespaceStatusPhase.
 const (
 	NamespaceStatusPhaseActive      NamespaceStatusPhase = "Active"
 	NamespaceStatusPhaseTerminating NamespaceStatusPhase = "Terminating"
 )
 
+// Defines values for NetworkPolicyRuleSpecAction.
+const (
+	Allow NetworkPolicyRuleSpecAction = "Allow"
+	Deny  NetworkPolicyRuleSpecAction = "Deny"
+)
+
 // Defines values for ObservabilityAlertsNotificationChannelSpecType.
 const (
 	Email   ObservabilityAlertsNotificationChannelSpecType = "email"
@@ -280,6 +446,26 @@ const (
 	ReleaseBindingSpecStateUndeploy ReleaseBindingSpecState = "Undeploy"
 )
 
+// Defines values for ReleaseHistoryEntryTrigger.
+const (
+	ReleaseHistoryEntryTriggerApi        ReleaseHistoryEntryTrigger = "api"
+	ReleaseHistoryEntryTriggerAutoDeploy ReleaseHistoryEntryTrigger = "auto-deploy"
+	ReleaseHistoryEntryTriggerDeploy     ReleaseHistoryEntryTrigger = "deploy"
+	ReleaseHistoryEntryTriggerManual     ReleaseHistoryEntryTrigger = "manual"
+	ReleaseHistoryEntryTriggerPromotion  ReleaseHistoryEntryTrigger = "promotion"
+	ReleaseHistoryEntryTriggerRollback   ReleaseHistoryEntryTrigger = "rollback"
+)
+
+// Defines values for ReleaseRecordTrigger.
+const (
+	ReleaseRecordTriggerApi        ReleaseRecordTrigger = "api"
+	ReleaseRecordTriggerAutoDeploy ReleaseRecordTrigger = "auto-deploy"
+	ReleaseRecordTriggerDeploy     ReleaseRecordTrigger = "deploy"
+	ReleaseRecordTriggerManual     ReleaseRecordTrigger = "manual"
+	ReleaseRecordTriggerPromotion  ReleaseRecordTrigger = "promotion"
+	ReleaseRecordTriggerRollback   ReleaseRecordTrigger = "rollback"
+)
+
 // Defines values for ReleaseResourceTreeTargetPlane.
 const (
 	ReleaseResourceTreeTargetPlaneDataplane          ReleaseResourceTreeTargetPlane = "dataplane"
@@ -333,6 +519,28 @@ const (
 	ResourceTypeSpecRetainPolicyRetain ResourceTypeSpecRetainPolicy = "Retain"
 )
 
+// Defines values for RolloutStatusPhase.
+const (
+	RolloutStatusPhaseAborted     RolloutStatusPhase = "Aborted"
+	RolloutStatusPhasePaused      RolloutStatusPhase = "Paused"
+	RolloutStatusPhaseProgressing RolloutStatusPhase = "Progressing"
+	RolloutStatusPhaseSucceeded   RolloutStatusPhase = "Succeeded"
+)
+
+// Defines values for RolloutStatusStrategy.
+const (
+	RolloutStatusStrategyBlueGreen     RolloutStatusStrategy = "BlueGreen"
+	RolloutStatusStrategyCanary        RolloutStatusStrategy = "Canary"
+	RolloutStatusStrategyRollingUpdate RolloutStatusStrategy = "RollingUpdate"
+)
+
+// Defines values for RolloutStrategyType.
+const (
+	RolloutStrategyTypeBlueGreen     RolloutStrategyType = "BlueGreen"
+	RolloutStrategyTypeCanary        RolloutStrategyType = "Canary"
+	RolloutStrategyTypeRollingUpdate RolloutStrategyType = "RollingUpdate"
+)
+
 // Defines values for SecretTemplateType.
 const (
 	SecretTemplateTypeBootstrapKubernetesIotoken   SecretTemplateType = "bootstrap.kubernetes.io/token"
@@ -397,6 +605,23 @@ const (
 	Observabilityplane TraitSpecPatchesTargetPlane = "observabilityplane"
 )
 
+// Defines values for UpdateEndpointRequestVisibility.
+const (
+	UpdateEndpointRequestVisibilityExternal  UpdateEndpointRequestVisibility = "external"
+	UpdateEndpointRequestVisibilityInternal  UpdateEndpointRequestVisibility = "internal"
+	UpdateEndpointRequestVisibilityNamespace UpdateEndpointRequestVisibility = "namespace"
+	UpdateEndpointRequestVisibilityProject   UpdateEndpointRequestVisibility = "project"
+)
+
+// Defines values for VulnerabilitySeverity.
+const (
+	VulnerabilitySeverityCritical VulnerabilitySeverity = "Critical"
+	VulnerabilitySeverityHigh     VulnerabilitySeverity = "High"
+	VulnerabilitySeverityLow      VulnerabilitySeverity = "Low"
+	VulnerabilitySeverityMedium   VulnerabilitySeverity = "Medium"
+	VulnerabilitySeverityUnknown  VulnerabilitySeverity = "Unknown"
+)
+
 // Defines values for WorkflowPlaneRefKind.
 const (
 	WorkflowPlaneRefKindClusterWorkflowPlane WorkflowPlaneRefKind = "ClusterWorkflowPlane"
@@ -409,6 +634,12 @@ const (
 	WorkflowRunConfigKindWorkflow        WorkflowRunConfigKind = "Workflow"
 )
 
+// Defines values for WorkflowRunSBOMResponseFormat.
+const (
+	CycloneDX WorkflowRunSBOMResponseFormat = "CycloneDX"
+	SPDX      WorkflowRunSBOMResponseFormat = "SPDX"
+)
+
 // Defines values for WorkflowRunStatusResponseStatus.
 const (
 	WorkflowRunStatusResponseStatusError     WorkflowRunStatusResponseStatus = "Error"
@@ -418,6 +649,12 @@ const (
 	WorkflowRunStatusResponseStatusSucceeded WorkflowRunStatusResponseStatus = "Succeeded"
 )
 
+// Defines values for WorkflowRunVulnerabilitiesResponseScanner.
+const (
+	Grype WorkflowRunVulnerabilitiesResponseScanner = "grype"
+	Trivy WorkflowRunVulnerabilitiesResponseScanner = "trivy"
+)
+
 // Defines values for WorkflowStepStatusPhase.
 const (
 	WorkflowStepStatusPhaseError     WorkflowStepStatusPhase = "Error"
@@ -452,6 +689,81 @@ const (
 	WorkloadEndpointVisibilityProject   WorkloadEndpointVisibility = "project"
 )
 
+// Defines values for GetCostReportParamsAggregate.
+const (
+	GetCostReportParamsAggregateComponent   GetCostReportParamsAggregate = "component"
+	GetCostReportParamsAggregateEnvironment GetCostReportParamsAggregate = "environment"
+	GetCostReportParamsAggregateProject     GetCostReportParamsAggregate = "project"
+)
+
+// Defines values for GetWorkflowRunVulnerabilitiesParamsSeverity.
+const (
+	GetWorkflowRunVulnerabilitiesParamsSeverityCritical GetWorkflowRunVulnerabilitiesParamsSeverity = "Critical"
+	GetWorkflowRunVulnerabilitiesParamsSeverityHigh     GetWorkflowRunVulnerabilitiesParamsSeverity = "High"
+	GetWorkflowRunVulnerabilitiesParamsSeverityLow      GetWorkflowRunVulnerabilitiesParamsSeverity = "Low"
+	GetWorkflowRunVulnerabilitiesParamsSeverityMedium   GetWorkflowRunVulnerabilitiesParamsSeverity = "Medium"
+	GetWorkflowRunVulnerabilitiesParamsSeverityUnknown  GetWorkflowRunVulnerabilitiesParamsSeverity = "Unknown"
+)
+
+// APIToken An API token, without its secret.
+type APIToken struct {
+	// Access Access of the token. read allows only viewing; write allows every action
+	// the issuing user is allowed within the token scope.
+	Access    APITokenAccess `json:"access"`
+	CreatedAt time.Time      `json:"createdAt"`
+	ExpiresAt time.Time      `json:"expiresAt"`
+
+	// Id Token identifier
+	Id string `json:"id"`
+
+	// Name What the token is used for
+	Name string `json:"name"`
+
+	// Namespace Namespace the token is limited to
+	Namespace string `json:"namespace"`
+
+	// Project Project the token is limited to, if any
+	Project *string `json:"project,omitempty"`
+}
+
+// APITokenAccess Access of the token. read allows only viewing; write allows every action
+// the issuing user is allowed within the token scope.
+type APITokenAccess string
+
+// APITokenList defines model for APITokenList.
+type APITokenList struct {
+	Items []APIToken `json:"items"`
+}
+
+// AcceptComponentProposalsRequest defines model for AcceptComponentProposalsRequest.
+type AcceptComponentProposalsRequest struct {
+	AutoDeploy *bool `json:"autoDeploy,omitempty"`
+
+	// ClassKind Kind of the component class; a ComponentType in the namespace takes precedence when empty
+	ClassKind *AcceptComponentProposalsRequestClassKind `json:"classKind,omitempty"`
+
+	// ClassName Component class of the components whose descriptor does not name one
+	ClassName   *string             `json:"className,omitempty"`
+	ProjectName string              `json:"projectName"`
+	Proposals   []ComponentProposal `json:"proposals"`
+}
+
+// AcceptComponentProposalsRequestClassKind Kind of the component class; a ComponentType in the namespace takes precedence when empty
+type AcceptComponentProposalsRequestClassKind string
+
+// AcceptComponentProposalsResponse defines model for AcceptComponentProposalsResponse.
+type AcceptComponentProposalsResponse struct {
+	Results []struct {
+		// Component Component resource.
+		// Components group source code and deployment configuration within a project.
+		Component *Component `json:"component,omitempty"`
+
+		// Error Why the component could not be created
+		Error *string `json:"error,omitempty"`
+		Name  string  `json:"name"`
+	} `json:"results"`
+}
+
 // ActionCapability Capabilities for a specific action
 type ActionCapability struct {
 	// Allowed Resources where action is allowed
@@ -497,6 +809,72 @@ type AgentConnectionStatus struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// ApplyResourceResult Outcome of applying a resource (v1 response version of applyResource).
+type ApplyResourceResult struct {
+	// Operation Whether the apply created the resource or updated an existing one
+	Operation ApplyResourceResultOperation `json:"operation"`
+
+	// Resource Resource.
+	// Developer-facing intent for a managed-infrastructure dependency (database,
+	// queue, cache, object storage). References a ResourceType or ClusterResourceType template.
+	Resource ResourceInstance `json:"resource"`
+}
+
+// ApplyResourceResultOperation Whether the apply created the resource or updated an existing one
+type ApplyResourceResultOperation string
+
+// AuditActor defines model for AuditActor.
+type AuditActor struct {
+	// Id Actor identifier
+	Id string `json:"id"`
+
+	// Type Actor type (user, service_account or anonymous)
+	Type string `json:"type"`
+}
+
+// AuditEvent A state-modifying API operation recorded by the audit log.
+type AuditEvent struct {
+	// Action Semantic action name
+	Action string     `json:"action"`
+	Actor  AuditActor `json:"actor"`
+
+	// Category Action category (resource, auth or observability)
+	Category string `json:"category"`
+
+	// EventId Unique identifier of the event
+	EventId string `json:"eventId"`
+
+	// Metadata Additional context, such as a summary of the changed fields
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
+
+	// RequestId Correlation ID of the request
+	RequestId *string        `json:"requestId,omitempty"`
+	Resource  *AuditResource `json:"resource,omitempty"`
+
+	// Result Outcome of the operation (success, failure or denied)
+	Result string `json:"result"`
+
+	// Service Service that emitted the event
+	Service *string `json:"service,omitempty"`
+
+	// SourceIp Client IP address
+	SourceIp  *string   `json:"sourceIp,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// AuditEventList defines model for AuditEventList.
+type AuditEventList struct {
+	Items []AuditEvent `json:"items"`
+}
+
+// AuditResource defines model for AuditResource.
+type AuditResource struct {
+	Id        *string `json:"id,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	Namespace *string `json:"namespace,omitempty"`
+	Type      string  `json:"type"`
+}
+
 // AuthMechanismConfig Configuration for an authentication mechanism
 type AuthMechanismConfig struct {
 	// Entitlement Configuration for extracting entitlement claims from tokens
@@ -592,8 +970,9 @@ type AuthzRoleBinding struct {
 type AuthzRoleBindingList struct {
 	Items []AuthzRoleBinding `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -616,8 +995,9 @@ type AuthzRoleBindingSpecEffect string
 type AuthzRoleList struct {
 	Items []AuthzRole `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -663,6 +1043,117 @@ type AuthzScope struct {
 	Project *string `json:"project,omitempty"`
 }
 
+// AutoscalingLimits Bounds within which Workloads of a component type may configure autoscaling
+type AutoscalingLimits struct {
+	// AllowedTriggerTypes KEDA trigger types Workloads may use. If empty, event-driven triggers are not allowed.
+	AllowedTriggerTypes *[]string `json:"allowedTriggerTypes,omitempty"`
+
+	// MaxReplicas Highest maxReplicas a Workload may configure
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// MinReplicas Lowest minReplicas a Workload may configure. Defaults to 1.
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+}
+
+// BatchApplyResourcesRequest defines model for BatchApplyResourcesRequest.
+type BatchApplyResourcesRequest struct {
+	// Items Resources to apply. metadata.namespace and metadata.name are required on each.
+	Items []ResourceInstance `json:"items"`
+}
+
+// BatchDeleteResourcesRequest defines model for BatchDeleteResourcesRequest.
+type BatchDeleteResourcesRequest struct {
+	// Items Resources to delete.
+	Items []NamespacedName `json:"items"`
+}
+
+// BatchItemResult Outcome of one item of a batch operation.
+type BatchItemResult struct {
+	// Error Problem details (RFC 7807) of a failed request, served as `application/problem+json`.
+	// Besides the standard members it carries the OpenChoreo extensions `code`, `kind`,
+	// `name` and `fieldErrors`, and repeats `detail` as `error` for clients of the earlier
+	// error format. MCP tool errors carry the same members.
+	Error *ErrorResponse `json:"error,omitempty"`
+
+	// Index Position of the item in the request
+	Index     int    `json:"index"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+
+	// Operation What was done to the item, omitted when it failed. Applied items that already matched the stored resource are unchanged.
+	Operation *BatchItemResultOperation `json:"operation,omitempty"`
+	Status    BatchItemResultStatus     `json:"status"`
+}
+
+// BatchItemResultOperation What was done to the item, omitted when it failed. Applied items that already matched the stored resource are unchanged.
+type BatchItemResultOperation string
+
+// BatchItemResultStatus defines model for BatchItemResult.Status.
+type BatchItemResultStatus string
+
+// BatchResult Outcome of a batch operation.
+type BatchResult struct {
+	// Failed Number of items that failed
+	Failed int `json:"failed"`
+
+	// Items Outcome of every item, in the order of the request
+	Items []BatchItemResult `json:"items"`
+
+	// Status Succeeded when every item succeeded, Failed when every item failed, PartiallySucceeded otherwise
+	Status BatchResultStatus `json:"status"`
+
+	// Succeeded Number of items that succeeded
+	Succeeded int `json:"succeeded"`
+}
+
+// BatchResultStatus Succeeded when every item succeeded, Failed when every item failed, PartiallySucceeded otherwise
+type BatchResultStatus string
+
+// BuildCacheConfig Where builds running on a workflow plane keep their layer cache
+type BuildCacheConfig struct {
+	// PersistentVolume Volume-based cache shared by the builds of a namespace. Required when type is PersistentVolume.
+	PersistentVolume *struct {
+		// Size Requested capacity of the cache volume
+		Size *string `json:"size,omitempty"`
+
+		// StorageClassName Storage class of the cache volume; it must support ReadWriteMany
+		StorageClassName *string `json:"storageClassName,omitempty"`
+	} `json:"persistentVolume,omitempty"`
+
+	// Registry Registry-based cache. Required when type is Registry.
+	Registry *struct {
+		// Repository Repository the per-component cache images are pushed to
+		Repository string `json:"repository"`
+	} `json:"registry,omitempty"`
+
+	// Type Where the cache is kept
+	Type BuildCacheConfigType `json:"type"`
+}
+
+// BuildCacheConfigType Where the cache is kept
+type BuildCacheConfigType string
+
+// BuildCacheStatistics Build cache lookups of a workflow run, as reported by its steps
+type BuildCacheStatistics struct {
+	// HitRatio Share of lookups served from the cache, between 0 and 1. Omitted when there were no lookups.
+	HitRatio *float64 `json:"hitRatio,omitempty"`
+
+	// Hits Lookups served from the cache
+	Hits int32 `json:"hits"`
+
+	// Misses Lookups that had to be rebuilt
+	Misses int32 `json:"misses"`
+}
+
+// CanaryStep A share of the traffic routed to the new release
+type CanaryStep struct {
+	// Pause How long to stay at this step, e.g. 5m. Without a pause the rollout waits at this step until it is resumed or promoted.
+	Pause *string `json:"pause,omitempty"`
+
+	// Weight Percentage of gateway traffic routed to the new release
+	Weight int32 `json:"weight"`
+}
+
 // CapabilityConstraints CEL expressions constraining access for a given action and resource path. Multiple expressions are OR'd.
 type CapabilityConstraints struct {
 	// Expressions CEL expressions; access is granted if any one evaluates to true
@@ -722,8 +1213,9 @@ type ClusterAuthzRoleBinding struct {
 type ClusterAuthzRoleBindingList struct {
 	Items []ClusterAuthzRoleBinding `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -746,8 +1238,9 @@ type ClusterAuthzRoleBindingSpecEffect string
 type ClusterAuthzRoleList struct {
 	Items []ClusterAuthzRole `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -800,8 +1293,9 @@ type ClusterComponentType struct {
 type ClusterComponentTypeList struct {
 	Items []ClusterComponentType `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -825,6 +1319,9 @@ type ClusterComponentTypeSpec struct {
 		Name string `json:"name"`
 	} `json:"allowedWorkflows,omitempty"`
 
+	// Autoscaling Bounds within which Workloads of a component type may configure autoscaling
+	Autoscaling *AutoscalingLimits `json:"autoscaling,omitempty"`
+
 	// EnvironmentConfigs Schema section using openAPIV3Schema format
 	EnvironmentConfigs *SchemaSection `json:"environmentConfigs,omitempty"`
 
@@ -923,8 +1420,9 @@ type ClusterDataPlane struct {
 type ClusterDataPlaneList struct {
 	Items []ClusterDataPlane `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -981,8 +1479,9 @@ type ClusterObservabilityPlane struct {
 type ClusterObservabilityPlaneList struct {
 	Items []ClusterObservabilityPlane `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -1003,6 +1502,9 @@ type ClusterObservabilityPlaneSpec struct {
 	// ClusterAgent Configuration for cluster agent-based communication
 	ClusterAgent *ClusterAgentConfig `json:"clusterAgent,omitempty"`
 
+	// DashboardURL URL template of the observability dashboard of a component. The placeholders {namespace}, {project}, {component} and {environment} are replaced with the names of the component and environment to view.
+	DashboardURL *string `json:"dashboardURL,omitempty"`
+
 	// FinOpsAgentURL Base URL of the FinOps Agent API in the observability plane cluster
 	FinOpsAgentURL *string `json:"finOpsAgentURL,omitempty"`
 
@@ -1053,8 +1555,9 @@ type ClusterProjectType struct {
 type ClusterProjectTypeList struct {
 	Items []ClusterProjectType `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -1082,8 +1585,9 @@ type ClusterResourceType struct {
 type ClusterResourceTypeList struct {
 	Items []ClusterResourceType `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -1109,8 +1613,9 @@ type ClusterTrait struct {
 type ClusterTraitList struct {
 	Items []ClusterTrait `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -1226,8 +1731,9 @@ type ClusterWorkflow struct {
 type ClusterWorkflowList struct {
 	Items []ClusterWorkflow `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -1253,8 +1759,9 @@ type ClusterWorkflowPlane struct {
 type ClusterWorkflowPlaneList struct {
 	Items []ClusterWorkflowPlane `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -1272,6 +1779,9 @@ type ClusterWorkflowPlaneRefKind string
 
 // ClusterWorkflowPlaneSpec Desired state of a ClusterWorkflowPlane
 type ClusterWorkflowPlaneSpec struct {
+	// BuildCache Where builds running on a workflow plane keep their layer cache
+	BuildCache *BuildCacheConfig `json:"buildCache,omitempty"`
+
 	// ClusterAgent Configuration for cluster agent-based communication
 	ClusterAgent *ClusterAgentConfig `json:"clusterAgent,omitempty"`
 
@@ -1343,15 +1853,125 @@ type Component struct {
 	Status *ComponentStatus `json:"status,omitempty"`
 }
 
+// ComponentBuild Workflow run started to build a component
+type ComponentBuild struct {
+	// Commit Commit SHA being built, when one was requested
+	Commit    *string   `json:"commit,omitempty"`
+	Component string    `json:"component"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Name Name of the workflow run
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Project   string `json:"project"`
+}
+
+// ComponentEnvironmentHealth Health of a component in an environment
+type ComponentEnvironmentHealth struct {
+	// ConfigChecksum Checksum of the bound release and the environment configuration of the release binding. Environments with the same checksum run the same release with the same configuration.
+	ConfigChecksum *string `json:"configChecksum,omitempty"`
+	Environment    string  `json:"environment"`
+
+	// Health Worst of the rollout phase and the health the data plane reports for the deployed resources. Suspended means the component is undeployed from the environment.
+	Health ComponentEnvironmentHealthHealth `json:"health"`
+
+	// Image Container image of the bound release
+	Image *string `json:"image,omitempty"`
+
+	// LastDeployedAt When the release binding last changed, such as on a deploy or promotion
+	LastDeployedAt *time.Time `json:"lastDeployedAt,omitempty"`
+
+	// Message Message of the release binding's Ready condition
+	Message *string `json:"message,omitempty"`
+
+	// Phase Rollout phase derived from the release binding's Ready condition: Pending, Progressing, Ready or Failed
+	Phase              *string `json:"phase,omitempty"`
+	ReleaseBindingName *string `json:"releaseBindingName,omitempty"`
+
+	// ReleaseName Component release bound to the environment
+	ReleaseName *string `json:"releaseName,omitempty"`
+
+	// Replicas Replica status of the component's Deployment or StatefulSet
+	Replicas *ReplicaStatus `json:"replicas,omitempty"`
+}
+
+// ComponentEnvironmentHealthHealth Worst of the rollout phase and the health the data plane reports for the deployed resources. Suspended means the component is undeployed from the environment.
+type ComponentEnvironmentHealthHealth string
+
+// ComponentHealth defines model for ComponentHealth.
+type ComponentHealth struct {
+	ComponentName string `json:"componentName"`
+
+	// Environments One entry per environment of the project, in the same order
+	Environments []ComponentEnvironmentHealth `json:"environments"`
+}
+
 // ComponentList Paginated list of components
 type ComponentList struct {
 	Items []Component `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
+// ComponentNetworkPolicies defines model for ComponentNetworkPolicies.
+type ComponentNetworkPolicies struct {
+	ComponentName string `json:"componentName"`
+
+	// Policies NetworkPolicy or CiliumNetworkPolicy manifests sent to the data plane. Empty until the component's release is rendered.
+	Policies           []map[string]interface{} `json:"policies"`
+	ReleaseBindingName string                   `json:"releaseBindingName"`
+}
+
+// ComponentProposal A component proposed for a directory of a repository
+type ComponentProposal struct {
+	// AppPath Directory of the component in the repository
+	AppPath string `json:"appPath"`
+
+	// Build Build strategy; empty for proposals with a component descriptor, which are built as their class defines
+	Build *ComponentProposalBuild `json:"build,omitempty"`
+
+	// ClassName Component class named by the component descriptor
+	ClassName *string `json:"className,omitempty"`
+
+	// DescriptorPath Component descriptor the component is imported from
+	DescriptorPath *string `json:"descriptorPath,omitempty"`
+	DockerfilePath *string `json:"dockerfilePath,omitempty"`
+
+	// Evidence Files the proposal is based on
+	Evidence *[]string `json:"evidence,omitempty"`
+
+	// Language Detected language
+	Language *string `json:"language,omitempty"`
+
+	// Name Proposed component name, unique among the proposals
+	Name string `json:"name"`
+
+	// Port Detected port, 8080 when none is detected
+	Port *int32 `json:"port,omitempty"`
+
+	// Ref Branch the repository was scanned at
+	Ref           string `json:"ref"`
+	RepositoryUrl string `json:"repositoryUrl"`
+
+	// Type Workload type
+	Type *ComponentProposalType `json:"type,omitempty"`
+
+	// Workflow Suggested ClusterWorkflow that builds the component
+	Workflow *string `json:"workflow,omitempty"`
+
+	// WorkflowParameters Build workflow parameters besides the repository
+	WorkflowParameters *map[string]interface{} `json:"workflowParameters,omitempty"`
+}
+
+// ComponentProposalBuild Build strategy; empty for proposals with a component descriptor, which are built as their class defines
+type ComponentProposalBuild string
+
+// ComponentProposalType Workload type
+type ComponentProposalType string
+
 // ComponentRelease ComponentRelease resource.
 // Immutable snapshot of component state at release time.
 type ComponentRelease struct {
@@ -1376,8 +1996,9 @@ type ComponentRelease struct {
 type ComponentReleaseList struct {
 	Items []ComponentRelease `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -1523,8 +2144,9 @@ type ComponentType struct {
 type ComponentTypeList struct {
 	Items []ComponentType `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -1548,6 +2170,9 @@ type ComponentTypeSpec struct {
 		Name string `json:"name"`
 	} `json:"allowedWorkflows,omitempty"`
 
+	// Autoscaling Bounds within which Workloads of a component type may configure autoscaling
+	Autoscaling *AutoscalingLimits `json:"autoscaling,omitempty"`
+
 	// EnvironmentConfigs Schema section using openAPIV3Schema format
 	EnvironmentConfigs *SchemaSection `json:"environmentConfigs,omitempty"`
 
@@ -1687,6 +2312,24 @@ type ConditionAttribute struct {
 	Key string `json:"key"`
 }
 
+// Connection Connection resource.
+// Binds a consuming component to an endpoint of another component or to a Resource of its project.
+type Connection struct {
+	// ApiVersion API version of the resource
+	ApiVersion *string `json:"apiVersion,omitempty"`
+
+	// Kind Kind of the resource
+	Kind *string `json:"kind,omitempty"`
+
+	// Metadata Standard Kubernetes object metadata (without kind/apiVersion).
+	// Matches the structure of metav1.ObjectMeta for the fields exposed via the API.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec Desired state of a Connection. Exactly one of endpoint and resource is set.
+	Spec   *ConnectionSpec   `json:"spec,omitempty"`
+	Status *ConnectionStatus `json:"status,omitempty"`
+}
+
 // ConnectionEnvBindings Maps resolved connection address components to environment variable names
 type ConnectionEnvBindings struct {
 	// Address Env var name for the protocol-appropriate connection string.
@@ -1704,25 +2347,169 @@ type ConnectionEnvBindings struct {
 	Port *string `json:"port,omitempty"`
 }
 
-// ContainerOverride Container-level overrides
-type ContainerOverride struct {
-	Env   *[]EnvVar  `json:"env,omitempty"`
-	Files *[]FileVar `json:"files,omitempty"`
-}
-
-// CreateComponentRequest Request to create a new component
-type CreateComponentRequest struct {
-	// AutoDeploy Whether to automatically deploy to default environment
-	AutoDeploy *bool `json:"autoDeploy,omitempty"`
+// ConnectionEnvironmentStatus Resolution of a Connection in one environment the consuming component is deployed to
+type ConnectionEnvironmentStatus struct {
+	Environment string `json:"environment"`
 
-	// ComponentType Component type reference (format: {workloadType}/{componentTypeName})
-	ComponentType *string `json:"componentType,omitempty"`
+	// Message Why the connection is not resolved
+	Message *string `json:"message,omitempty"`
 
-	// Description Component description
-	Description *string `json:"description,omitempty"`
+	// ReleaseBinding ReleaseBinding deploying the consumer to the environment
+	ReleaseBinding string `json:"releaseBinding"`
 
-	// DisplayName Human-readable display name
-	DisplayName *string `json:"displayName,omitempty"`
+	// Resolved Whether the connection is resolved and injected into the consumer
+	Resolved bool `json:"resolved"`
+
+	// Url Structured URL with its components
+	Url *EndpointURL `json:"url,omitempty"`
+}
+
+// ConnectionList Paginated list of connections
+type ConnectionList struct {
+	Items []Connection `json:"items"`
+
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
+	Pagination Pagination `json:"pagination"`
+}
+
+// ConnectionSpec Desired state of a Connection. Exactly one of endpoint and resource is set.
+type ConnectionSpec struct {
+	// Endpoint A connection to another component's endpoint
+	Endpoint *WorkloadConnection `json:"endpoint,omitempty"`
+
+	// Environments Environments the connection applies to. When empty, it applies to every environment.
+	Environments *[]string `json:"environments,omitempty"`
+
+	// Owner Owner identifies the consuming component and its project
+	Owner struct {
+		ComponentName string `json:"componentName"`
+		ProjectName   string `json:"projectName"`
+	} `json:"owner"`
+
+	// Resource Dependency on a Resource. Output names declared on the referenced ResourceType are wired
+	// into the consuming container as env vars (envBindings) and file mounts (fileBindings).
+	// Outputs not listed in either map are ignored.
+	Resource *WorkloadResourceDependency `json:"resource,omitempty"`
+}
+
+// ConnectionStatus Observed state of a Connection
+type ConnectionStatus struct {
+	// Conditions Ready condition
+	Conditions *[]Condition `json:"conditions,omitempty"`
+
+	// Environments Resolution of the connection per environment
+	Environments       *[]ConnectionEnvironmentStatus `json:"environments,omitempty"`
+	ObservedGeneration *int64                         `json:"observedGeneration,omitempty"`
+}
+
+// ContainerOverride Container-level overrides
+type ContainerOverride struct {
+	Env   *[]EnvVar  `json:"env,omitempty"`
+	Files *[]FileVar `json:"files,omitempty"`
+
+	// Image Replaces the container image of the workload
+	Image *string `json:"image,omitempty"`
+}
+
+// ControlComponentRolloutRequest defines model for ControlComponentRolloutRequest.
+type ControlComponentRolloutRequest struct {
+	// Action pause holds the rollout at its current step, resume continues it, promote routes all traffic to the new release and abort routes all traffic back to the stable release.
+	Action ControlComponentRolloutRequestAction `json:"action"`
+
+	// Environment Environment of the rollout
+	Environment string `json:"environment"`
+}
+
+// ControlComponentRolloutRequestAction pause holds the rollout at its current step, resume continues it, promote routes all traffic to the new release and abort routes all traffic back to the stable release.
+type ControlComponentRolloutRequestAction string
+
+// ControlComponentRolloutResponse defines model for ControlComponentRolloutResponse.
+type ControlComponentRolloutResponse struct {
+	// Action Action requested on the rollout
+	Action string `json:"action"`
+
+	// ReleaseBinding ReleaseBinding resource.
+	// Binds a ComponentRelease to a specific environment.
+	ReleaseBinding ReleaseBinding `json:"releaseBinding"`
+}
+
+// CostEstimate Estimated monthly cost of a proposed deployment
+type CostEstimate struct {
+	Currency    string                 `json:"currency"`
+	Items       []WorkloadCostEstimate `json:"items"`
+	MonthlyCost float64                `json:"monthlyCost"`
+}
+
+// CostEstimateRequest defines model for CostEstimateRequest.
+type CostEstimateRequest struct {
+	Workloads []WorkloadCostSpec `json:"workloads"`
+}
+
+// CostItem Cost of the resources of one aggregate value. The dimensions the report
+// is not aggregated by are omitted, as are those of costs that could not
+// be attributed to a single value.
+type CostItem struct {
+	Component   *string `json:"component,omitempty"`
+	CpuCost     float64 `json:"cpuCost"`
+	Environment *string `json:"environment,omitempty"`
+	GpuCost     float64 `json:"gpuCost"`
+	MemoryCost  float64 `json:"memoryCost"`
+
+	// NetworkCost Network and load balancer cost
+	NetworkCost float64 `json:"networkCost"`
+	Project     *string `json:"project,omitempty"`
+	StorageCost float64 `json:"storageCost"`
+	TotalCost   float64 `json:"totalCost"`
+}
+
+// CostReport Actual cost of data plane resources over a window
+type CostReport struct {
+	Aggregate *CostReportAggregate `json:"aggregate,omitempty"`
+	Currency  string               `json:"currency"`
+	End       *time.Time           `json:"end,omitempty"`
+	Items     []CostItem           `json:"items"`
+	Start     *time.Time           `json:"start,omitempty"`
+	TotalCost float64              `json:"totalCost"`
+	Window    string               `json:"window"`
+}
+
+// CostReportAggregate defines model for CostReport.Aggregate.
+type CostReportAggregate string
+
+// CreateAPITokenRequest defines model for CreateAPITokenRequest.
+type CreateAPITokenRequest struct {
+	// Access Access of the token. read allows only viewing; write allows every action
+	// the issuing user is allowed within the token scope.
+	Access APITokenAccess `json:"access"`
+
+	// ExpiresAt When the token expires. Defaults to the server's default token lifetime.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Name What the token is used for
+	Name string `json:"name"`
+
+	// Namespace Namespace the token is limited to
+	Namespace string `json:"namespace"`
+
+	// Project Project of the namespace the token is limited to
+	Project *string `json:"project,omitempty"`
+}
+
+// CreateComponentRequest Request to create a new component
+type CreateComponentRequest struct {
+	// AutoDeploy Whether to automatically deploy to default environment
+	AutoDeploy *bool `json:"autoDeploy,omitempty"`
+
+	// ComponentType Component type reference (format: {workloadType}/{componentTypeName})
+	ComponentType *string `json:"componentType,omitempty"`
+
+	// Description Component description
+	Description *string `json:"description,omitempty"`
+
+	// DisplayName Human-readable display name
+	DisplayName *string `json:"displayName,omitempty"`
 
 	// Name Component name (must be unique within project)
 	Name string `json:"name"`
@@ -1741,6 +2528,36 @@ type CreateComponentRequest struct {
 	Workflow *ComponentWorkflowInput `json:"workflow,omitempty"`
 }
 
+// CreateConnectionRequest Request to connect a component to an endpoint or a Resource. Exactly one of endpoint and resource is required.
+type CreateConnectionRequest struct {
+	// ComponentName Consuming component
+	ComponentName string `json:"componentName"`
+
+	// Endpoint A connection to another component's endpoint
+	Endpoint *WorkloadConnection `json:"endpoint,omitempty"`
+
+	// Environments Environments the connection applies to. When omitted, it applies to every environment.
+	Environments *[]string `json:"environments,omitempty"`
+
+	// Name Name of the connection. Generated from the component name when omitted.
+	Name *string `json:"name,omitempty"`
+
+	// Resource Dependency on a Resource. Output names declared on the referenced ResourceType are wired
+	// into the consuming container as env vars (envBindings) and file mounts (fileBindings).
+	// Outputs not listed in either map are ignored.
+	Resource *WorkloadResourceDependency `json:"resource,omitempty"`
+}
+
+// CreateCustomDomainRequest Request to bind a custom domain to a component endpoint
+type CreateCustomDomainRequest struct {
+	ComponentName string `json:"componentName"`
+	Domain        string `json:"domain"`
+
+	// Endpoint Endpoint to serve on the domain. Must have external visibility.
+	Endpoint    string `json:"endpoint"`
+	Environment string `json:"environment"`
+}
+
 // CreateGitSecretRequest Request body for creating a git secret
 type CreateGitSecretRequest struct {
 	// SecretName Name of the git secret
@@ -1774,6 +2591,57 @@ type CreateGitSecretRequestSecretType string
 // CreateGitSecretRequestWorkflowPlaneKind Kind of the workflow plane resource
 type CreateGitSecretRequestWorkflowPlaneKind string
 
+// CreatePromotionRequestRequest Request to promote a component release between environments
+type CreatePromotionRequestRequest struct {
+	// Comment Note recorded with the request
+	Comment           *string `json:"comment,omitempty"`
+	ComponentName     string  `json:"componentName"`
+	SourceEnvironment string  `json:"sourceEnvironment"`
+	TargetEnvironment string  `json:"targetEnvironment"`
+}
+
+// CreateRegistryCredentialRequest Request body for storing container registry credentials
+type CreateRegistryCredentialRequest struct {
+	// Email Email recorded in the docker config (optional)
+	Email *string `json:"email,omitempty"`
+
+	// Name Name of the registry credentials
+	Name string `json:"name"`
+
+	// Password Registry password or access token
+	Password string `json:"password"`
+
+	// Server Registry host, optionally with a scheme or path such as https://index.docker.io/v1/
+	Server string `json:"server"`
+
+	// SkipValidation Store the credentials without authenticating to the registry, e.g. when it is not reachable from the control plane
+	SkipValidation *bool `json:"skipValidation,omitempty"`
+
+	// TargetPlane Reference to the plane that hosts the secret data.
+	TargetPlane TargetPlaneRef `json:"targetPlane"`
+
+	// Username Registry username
+	Username string `json:"username"`
+}
+
+// CreateSandboxRequest defines model for CreateSandboxRequest.
+type CreateSandboxRequest struct {
+	// Components Components of the project to clone. When omitted, every component deployed to the source environment is cloned.
+	Components *[]string `json:"components,omitempty"`
+
+	// Name Name of the sandbox environment
+	Name string `json:"name"`
+
+	// SourceEnvironment Environment whose deployed releases are cloned into the sandbox
+	SourceEnvironment string `json:"sourceEnvironment"`
+
+	// Subdomain Prefix of the gateway hosts the sandbox endpoints are exposed under. Defaults to the sandbox name.
+	Subdomain *string `json:"subdomain,omitempty"`
+
+	// Ttl How long the sandbox lives, as a duration. Defaults to 8h, at most 168h.
+	Ttl *string `json:"ttl,omitempty"`
+}
+
 // CreateSecretRequest Request body for creating a secret
 type CreateSecretRequest struct {
 	// Data Map of secret keys to plaintext values. Required keys depend on secretType.
@@ -1803,6 +2671,92 @@ type CreateWorkflowRunRequest struct {
 	WorkflowName string `json:"workflowName"`
 }
 
+// CreatedAPIToken defines model for CreatedAPIToken.
+type CreatedAPIToken struct {
+	// Secret The token secret. It is returned only once.
+	Secret string `json:"secret"`
+
+	// Token An API token, without its secret.
+	Token APIToken `json:"token"`
+}
+
+// CustomDomain CustomDomain resource.
+// Serves an externally visible component endpoint on a domain owned by the team.
+type CustomDomain struct {
+	// ApiVersion API version of the resource
+	ApiVersion *string `json:"apiVersion,omitempty"`
+
+	// Kind Kind of the resource
+	Kind *string `json:"kind,omitempty"`
+
+	// Metadata Standard Kubernetes object metadata (without kind/apiVersion).
+	// Matches the structure of metav1.ObjectMeta for the fields exposed via the API.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec Desired state of a CustomDomain
+	Spec   *CustomDomainSpec   `json:"spec,omitempty"`
+	Status *CustomDomainStatus `json:"status,omitempty"`
+}
+
+// CustomDomainList Paginated list of custom domains
+type CustomDomainList struct {
+	Items []CustomDomain `json:"items"`
+
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
+	Pagination Pagination `json:"pagination"`
+}
+
+// CustomDomainSpec Desired state of a CustomDomain
+type CustomDomainSpec struct {
+	// Domain Fully qualified host name to serve the endpoint on
+	Domain string `json:"domain"`
+
+	// Endpoint Workload endpoint the domain routes to
+	Endpoint string `json:"endpoint"`
+
+	// Environment Environment the domain serves
+	Environment string `json:"environment"`
+
+	// Owner Owner identifies the component and project this CustomDomain belongs to
+	Owner struct {
+		ComponentName string `json:"componentName"`
+		ProjectName   string `json:"projectName"`
+	} `json:"owner"`
+}
+
+// CustomDomainStatus Observed state of a CustomDomain
+type CustomDomainStatus struct {
+	// Certificate A managed TLS certificate issued for the hosts of an endpoint route
+	Certificate *EndpointCertificateStatus `json:"certificate,omitempty"`
+
+	// Conditions DomainVerified, Routed, CertificateReady and Ready conditions
+	Conditions *[]Condition `json:"conditions,omitempty"`
+
+	// DnsRecords Records to create at the DNS provider
+	DnsRecords         *[]DNSRecord `json:"dnsRecords,omitempty"`
+	ObservedGeneration *int64       `json:"observedGeneration,omitempty"`
+
+	// VerifiedAt When ownership of the domain was verified
+	VerifiedAt *time.Time `json:"verifiedAt,omitempty"`
+}
+
+// DNSRecord A DNS record the domain owner has to create at their DNS provider
+type DNSRecord struct {
+	// Name Fully qualified name of the record
+	Name string `json:"name"`
+
+	// Purpose What the record is for
+	Purpose *string `json:"purpose,omitempty"`
+
+	// Type DNS record type
+	Type string `json:"type"`
+
+	// Value Content of the record
+	Value string `json:"value"`
+}
+
 // DataPlane DataPlane resource.
 // Represents a Kubernetes cluster for workload deployment.
 type DataPlane struct {
@@ -1821,12 +2775,31 @@ type DataPlane struct {
 	Status *DataPlaneStatus `json:"status,omitempty"`
 }
 
+// DataPlaneCapabilities Capabilities discovered when the DataPlane was registered
+type DataPlaneCapabilities struct {
+	// Cilium Whether Cilium network policies are available
+	Cilium bool `json:"cilium"`
+
+	// DiscoveredAt When the capabilities were discovered
+	DiscoveredAt time.Time `json:"discoveredAt"`
+
+	// ExternalSecrets Whether External Secrets is installed
+	ExternalSecrets bool `json:"externalSecrets"`
+
+	// GatewayAPI Whether the Gateway API is installed
+	GatewayAPI bool `json:"gatewayAPI"`
+
+	// KubernetesVersion Kubernetes version reported by the plane
+	KubernetesVersion *string `json:"kubernetesVersion,omitempty"`
+}
+
 // DataPlaneList Paginated list of data planes
 type DataPlaneList struct {
 	Items []DataPlane `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -1854,6 +2827,9 @@ type DataPlaneStatus struct {
 	// AgentConnection Status of cluster agent connections
 	AgentConnection *AgentConnectionStatus `json:"agentConnection,omitempty"`
 
+	// Capabilities Capabilities discovered when the DataPlane was registered
+	Capabilities *DataPlaneCapabilities `json:"capabilities,omitempty"`
+
 	// Conditions Current state conditions of the DataPlane
 	Conditions *[]Condition `json:"conditions,omitempty"`
 
@@ -1873,6 +2849,128 @@ type Decision struct {
 	Decision bool `json:"decision"`
 }
 
+// DependencyGraph Connection graph between components. The focus fields (component, dependsOn, dependents, impacted) are only set when the graph was requested for a component.
+type DependencyGraph struct {
+	// Component ID of the component the graph is focused on
+	Component *string `json:"component,omitempty"`
+
+	// Dependents Edges pointing at the focused component
+	Dependents *[]DependencyGraphEdge `json:"dependents,omitempty"`
+
+	// DependsOn Edges leaving the focused component
+	DependsOn *[]DependencyGraphEdge `json:"dependsOn,omitempty"`
+	Edges     []DependencyGraphEdge  `json:"edges"`
+
+	// Impacted Components that directly or transitively consume the focused component
+	Impacted *[]DependencyGraphNode `json:"impacted,omitempty"`
+	Nodes    []DependencyGraphNode  `json:"nodes"`
+}
+
+// DependencyGraphEdge A dependency of a component on another node
+type DependencyGraphEdge struct {
+	// Address Address of the external service, for external edges
+	Address *string `json:"address,omitempty"`
+
+	// Endpoint Endpoint consumed, for endpoint edges
+	Endpoint *string `json:"endpoint,omitempty"`
+
+	// From ID of the depending component
+	From string `json:"from"`
+
+	// To ID of the node depended on
+	To   string                  `json:"to"`
+	Type DependencyGraphEdgeType `json:"type"`
+
+	// Visibility Visibility at which the endpoint is consumed, for endpoint edges
+	Visibility *string `json:"visibility,omitempty"`
+}
+
+// DependencyGraphEdgeType defines model for DependencyGraphEdge.Type.
+type DependencyGraphEdgeType string
+
+// DependencyGraphNode defines model for DependencyGraphNode.
+type DependencyGraphNode struct {
+	// Id Unique node ID of the form kind:project/name, or kind:name for nodes outside a project
+	Id   string                  `json:"id"`
+	Kind DependencyGraphNodeKind `json:"kind"`
+	Name string                  `json:"name"`
+
+	// Project Project of the node, empty for secrets and external services
+	Project *string `json:"project,omitempty"`
+}
+
+// DependencyGraphNodeKind defines model for DependencyGraphNode.Kind.
+type DependencyGraphNodeKind string
+
+// DeployComponentRequest Request to deploy a container image to an environment. Exactly one of image and buildName must be set.
+type DeployComponentRequest struct {
+	// BuildName Name of a succeeded workflow run of the component whose built image is deployed
+	BuildName *string `json:"buildName,omitempty"`
+
+	// Environment Target environment; must be part of the project's deployment pipeline
+	Environment string `json:"environment"`
+
+	// Image Container image to deploy
+	Image *string `json:"image,omitempty"`
+}
+
+// DeployComponentResponse Result of deploying a component to an environment
+type DeployComponentResponse struct {
+	// Created Whether the release binding was created rather than updated
+	Created bool `json:"created"`
+
+	// Image Container image that was deployed
+	Image string `json:"image"`
+
+	// ReleaseBinding ReleaseBinding resource.
+	// Binds a ComponentRelease to a specific environment.
+	ReleaseBinding ReleaseBinding `json:"releaseBinding"`
+
+	// ReleaseName Name of the component release generated for the deployment
+	ReleaseName string `json:"releaseName"`
+}
+
+// DeployedObject An object that a release of a resource applied to a data plane
+type DeployedObject struct {
+	// Cluster Name of the plane the object was applied to
+	Cluster string `json:"cluster"`
+
+	// ClusterKind Kind of the plane the object was applied to (DataPlane or ClusterDataPlane)
+	ClusterKind string `json:"clusterKind"`
+
+	// Environment Environment of the release that applied the object
+	Environment string `json:"environment"`
+
+	// Error Why the object could not be read
+	Error *string `json:"error,omitempty"`
+
+	// Group API group of the object
+	Group *string `json:"group,omitempty"`
+
+	// HealthStatus Health of the object as last observed by the control plane
+	HealthStatus *string `json:"healthStatus,omitempty"`
+
+	// Kind Kind of the object
+	Kind string `json:"kind"`
+
+	// Name Name of the object
+	Name string `json:"name"`
+
+	// Namespace Namespace of the object on the data plane
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Object Live object read from the data plane. Omitted when it could not be read.
+	Object *map[string]interface{} `json:"object,omitempty"`
+
+	// Version API version of the object
+	Version string `json:"version"`
+}
+
+// DeployedObjectList defines model for DeployedObjectList.
+type DeployedObjectList struct {
+	Items []DeployedObject `json:"items"`
+}
+
 // DeploymentPipeline DeploymentPipeline resource.
 // Defines promotion paths between environments for component deployments.
 type DeploymentPipeline struct {
@@ -1891,15 +2989,51 @@ type DeploymentPipeline struct {
 	Status *DeploymentPipelineStatus `json:"status,omitempty"`
 }
 
+// DeploymentPipelineEdge defines model for DeploymentPipelineEdge.
+type DeploymentPipelineEdge struct {
+	// RequiresApproval Whether promotions along this path wait for a manual approval
+	RequiresApproval bool   `json:"requiresApproval"`
+	Source           string `json:"source"`
+	Target           string `json:"target"`
+}
+
+// DeploymentPipelineGraph Environments and promotion paths of a deployment pipeline
+type DeploymentPipelineGraph struct {
+	// Edges Promotion paths of the pipeline
+	Edges []DeploymentPipelineEdge `json:"edges"`
+
+	// Issues Problems of the pipeline definition; empty for a valid pipeline
+	Issues []string `json:"issues"`
+
+	// Name Name of the deployment pipeline
+	Name string `json:"name"`
+
+	// Nodes Environments of the pipeline, ordered by stage
+	Nodes []DeploymentPipelineNode `json:"nodes"`
+}
+
 // DeploymentPipelineList Paginated list of deployment pipelines
 type DeploymentPipelineList struct {
 	Items []DeploymentPipeline `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
+// DeploymentPipelineNode defines model for DeploymentPipelineNode.
+type DeploymentPipelineNode struct {
+	Environment string `json:"environment"`
+
+	// Exists Whether the environment exists in the namespace
+	Exists       bool `json:"exists"`
+	IsProduction bool `json:"isProduction"`
+
+	// Stage Number of promotion hops from the first environment of the pipeline, or -1 for environments only reachable through a cycle
+	Stage int `json:"stage"`
+}
+
 // DeploymentPipelineSpec Desired state of a DeploymentPipeline
 type DeploymentPipelineSpec struct {
 	// PromotionPaths Promotion paths between environments
@@ -1912,6 +3046,127 @@ type DeploymentPipelineStatus struct {
 	Conditions *[]Condition `json:"conditions,omitempty"`
 }
 
+// DeploymentStatus Rollout progress of a component in an environment
+type DeploymentStatus struct {
+	ComponentName string               `json:"componentName"`
+	Conditions    *[]Condition         `json:"conditions,omitempty"`
+	Endpoints     *[]EndpointURLStatus `json:"endpoints,omitempty"`
+	Environment   string               `json:"environment"`
+
+	// Image Container image of the bound release
+	Image *string `json:"image,omitempty"`
+
+	// Message Message of the release binding's Ready condition
+	Message *string `json:"message,omitempty"`
+
+	// Phase Rollout phase derived from the release binding's Ready condition: Pending, Progressing, Ready or Failed
+	Phase              string `json:"phase"`
+	ReleaseBindingName string `json:"releaseBindingName"`
+
+	// ReleaseName Component release bound to the environment
+	ReleaseName *string `json:"releaseName,omitempty"`
+}
+
+// DiscoverComponentsRequest Repositories to discover components in. Exactly one of repositoryUrls and ownerUrl must be set.
+type DiscoverComponentsRequest struct {
+	// OwnerUrl User, organization or group whose repositories are scanned; archived repositories are skipped
+	OwnerUrl *string `json:"ownerUrl,omitempty"`
+
+	// ProjectName Project the components are proposed for
+	ProjectName string `json:"projectName"`
+
+	// Ref Branch to scan; defaults to the default branch of each repository
+	Ref *string `json:"ref,omitempty"`
+
+	// RepositoryUrls Git repositories to scan
+	RepositoryUrls *[]string `json:"repositoryUrls,omitempty"`
+
+	// Token Access token for private repositories; used for the scan only and not stored
+	Token *string `json:"token,omitempty"`
+}
+
+// DiscoverComponentsResponse defines model for DiscoverComponentsResponse.
+type DiscoverComponentsResponse struct {
+	Proposals []ComponentProposal `json:"proposals"`
+
+	// Skipped Repositories that were not scanned
+	Skipped []struct {
+		Reason        string `json:"reason"`
+		RepositoryUrl string `json:"repositoryUrl"`
+	} `json:"skipped"`
+}
+
+// Endpoint An endpoint exposed by a component and its addresses in each environment
+type Endpoint struct {
+	// BasePath Base path of the API exposed via the endpoint
+	BasePath      *string               `json:"basePath,omitempty"`
+	ComponentName string                `json:"componentName"`
+	Environments  []EnvironmentEndpoint `json:"environments"`
+
+	// Name Endpoint name as defined in the Workload spec
+	Name        string       `json:"name"`
+	Port        int32        `json:"port"`
+	ProjectName string       `json:"projectName"`
+	Type        EndpointType `json:"type"`
+
+	// Visibility Effective visibilities of the endpoint, including the implicit project visibility
+	Visibility   []EndpointVisibility `json:"visibility"`
+	WorkloadName string               `json:"workloadName"`
+}
+
+// EndpointType defines model for Endpoint.Type.
+type EndpointType string
+
+// EndpointVisibility defines model for Endpoint.Visibility.
+type EndpointVisibility string
+
+// EndpointCertificate A managed TLS certificate of an endpoint, with an alert when it needs attention
+type EndpointCertificate struct {
+	// Alert Set when the certificate is not issued yet (NotIssued), was not renewed by its renewal time (RenewalOverdue) or has expired (Expired)
+	Alert       *EndpointCertificateAlert      `json:"alert,omitempty"`
+	DnsNames    *[]string                      `json:"dnsNames,omitempty"`
+	Message     *string                        `json:"message,omitempty"`
+	Name        string                         `json:"name"`
+	NotAfter    *time.Time                     `json:"notAfter,omitempty"`
+	Ready       bool                           `json:"ready"`
+	RenewalTime *time.Time                     `json:"renewalTime,omitempty"`
+	SecretName  string                         `json:"secretName"`
+	Visibility  *EndpointCertificateVisibility `json:"visibility,omitempty"`
+}
+
+// EndpointCertificateAlert Set when the certificate is not issued yet (NotIssued), was not renewed by its renewal time (RenewalOverdue) or has expired (Expired)
+type EndpointCertificateAlert string
+
+// EndpointCertificateVisibility defines model for EndpointCertificate.Visibility.
+type EndpointCertificateVisibility string
+
+// EndpointCertificateStatus A managed TLS certificate issued for the hosts of an endpoint route
+type EndpointCertificateStatus struct {
+	DnsNames *[]string `json:"dnsNames,omitempty"`
+
+	// Message Why the certificate is not ready
+	Message *string `json:"message,omitempty"`
+
+	// Name Name of the cert-manager Certificate in the data plane
+	Name string `json:"name"`
+
+	// NotAfter Expiry time of the issued certificate
+	NotAfter *time.Time `json:"notAfter,omitempty"`
+
+	// Ready Whether an up-to-date certificate has been issued
+	Ready bool `json:"ready"`
+
+	// RenewalTime When the certificate is due to be renewed
+	RenewalTime *time.Time `json:"renewalTime,omitempty"`
+
+	// SecretName Secret that holds the issued certificate
+	SecretName string                               `json:"secretName"`
+	Visibility *EndpointCertificateStatusVisibility `json:"visibility,omitempty"`
+}
+
+// EndpointCertificateStatusVisibility defines model for EndpointCertificateStatus.Visibility.
+type EndpointCertificateStatusVisibility string
+
 // EndpointGatewayURLs Resolved gateway URLs for an endpoint
 type EndpointGatewayURLs struct {
 	// Http Structured URL with its components
@@ -1924,6 +3179,11 @@ type EndpointGatewayURLs struct {
 	Tls *EndpointURL `json:"tls,omitempty"`
 }
 
+// EndpointList defines model for EndpointList.
+type EndpointList struct {
+	Items []Endpoint `json:"items"`
+}
+
 // EndpointURL Structured URL with its components
 type EndpointURL struct {
 	// Host Hostname or IP address
@@ -1941,6 +3201,12 @@ type EndpointURL struct {
 
 // EndpointURLStatus Resolved URLs for a single named workload endpoint
 type EndpointURLStatus struct {
+	// Certificates Managed TLS certificates issued for the endpoint hosts
+	Certificates *[]EndpointCertificateStatus `json:"certificates,omitempty"`
+
+	// CustomDomains Verified custom domains served by the endpoint's external route
+	CustomDomains *[]string `json:"customDomains,omitempty"`
+
 	// ExternalURLs Resolved gateway URLs for an endpoint
 	ExternalURLs *EndpointGatewayURLs `json:"externalURLs,omitempty"`
 
@@ -2023,15 +3289,52 @@ type Environment struct {
 	Status *EnvironmentStatus `json:"status,omitempty"`
 }
 
+// EnvironmentEndpoint Resolved addresses of an endpoint in one environment
+type EnvironmentEndpoint struct {
+	Certificates *[]EndpointCertificate `json:"certificates,omitempty"`
+
+	// CustomDomains Verified custom domains served by the endpoint's external route
+	CustomDomains *[]string `json:"customDomains,omitempty"`
+	Environment   string    `json:"environment"`
+
+	// ExternalURLs Resolved gateway URLs for an endpoint
+	ExternalURLs *EndpointGatewayURLs `json:"externalURLs,omitempty"`
+
+	// InternalURLs Resolved gateway URLs for an endpoint
+	InternalURLs       *EndpointGatewayURLs `json:"internalURLs,omitempty"`
+	ReleaseBindingName string               `json:"releaseBindingName"`
+
+	// ServiceURL Structured URL with its components
+	ServiceURL *EndpointURL `json:"serviceURL,omitempty"`
+}
+
 // EnvironmentList Paginated list of environments
 type EnvironmentList struct {
 	Items []Environment `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
+// EnvironmentQuota Resource quota of a project in one environment.
+type EnvironmentQuota struct {
+	// BindingName Project release binding carrying the quota.
+	BindingName string `json:"bindingName"`
+	Environment string `json:"environment"`
+
+	// Quota Resource quota of a project in an environment. hard is enforced on the
+	// data-plane namespace as a ResourceQuota; the container defaults and
+	// maximum as a LimitRange. When hard limits the requests or limits of a
+	// resource, set defaultRequest and default for it so that containers
+	// that do not declare them are admitted.
+	Quota *ProjectQuota `json:"quota,omitempty"`
+
+	// Usage Quota enforced on the data plane and its current usage.
+	Usage *ProjectQuotaUsage `json:"usage,omitempty"`
+}
+
 // EnvironmentSpec Desired state of an Environment
 type EnvironmentSpec struct {
 	// DataPlaneRef Reference to the DataPlane or ClusterDataPlane for this environment.
@@ -2050,6 +3353,10 @@ type EnvironmentSpec struct {
 
 	// IsProduction Whether this is a production environment
 	IsProduction *bool `json:"isProduction,omitempty"`
+
+	// Tls Managed TLS for the endpoint hosts of components deployed to the environment.
+	// When set, a cert-manager Certificate is issued for the hosts of every endpoint route.
+	Tls *EnvironmentTLS `json:"tls,omitempty"`
 }
 
 // EnvironmentSpecDataPlaneRefKind Kind of data plane (DataPlane or ClusterDataPlane)
@@ -2064,22 +3371,59 @@ type EnvironmentStatus struct {
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
 }
 
-// ErrorResponse Standard error response format
+// EnvironmentTLS Managed TLS for the endpoint hosts of components deployed to the environment.
+// When set, a cert-manager Certificate is issued for the hosts of every endpoint route.
+type EnvironmentTLS struct {
+	// Duration Requested certificate lifetime; defaults to the issuer's default
+	Duration *string `json:"duration,omitempty"`
+
+	// IssuerRef cert-manager issuer in the data plane that signs endpoint certificates
+	IssuerRef struct {
+		Kind *EnvironmentTLSIssuerRefKind `json:"kind,omitempty"`
+		Name string                       `json:"name"`
+	} `json:"issuerRef"`
+
+	// RenewBefore How long before expiry a certificate is renewed; defaults to a third of its lifetime
+	RenewBefore *string `json:"renewBefore,omitempty"`
+}
+
+// EnvironmentTLSIssuerRefKind defines model for EnvironmentTLS.IssuerRef.Kind.
+type EnvironmentTLSIssuerRefKind string
+
+// ErrorResponse Problem details (RFC 7807) of a failed request, served as `application/problem+json`.
+// Besides the standard members it carries the OpenChoreo extensions `code`, `kind`,
+// `name` and `fieldErrors`, and repeats `detail` as `error` for clients of the earlier
+// error format. MCP tool errors carry the same members.
 type ErrorResponse struct {
 	// Code Machine-readable error code
 	Code ErrorResponseCode `json:"code"`
 
-	// Details Additional error details (e.g., validation errors)
-	Details *[]struct {
-		// Field Field that caused the error
-		Field *string `json:"field,omitempty"`
-
-		// Message Error message for this field
-		Message *string `json:"message,omitempty"`
-	} `json:"details,omitempty"`
+	// Detail Human-readable explanation of this occurrence of the problem
+	Detail *string `json:"detail,omitempty"`
 
-	// Error Human-readable error message
+	// Error Human-readable error message, the same as `detail`
 	Error string `json:"error"`
+
+	// FieldErrors Invalid fields of the request
+	FieldErrors *[]FieldError `json:"fieldErrors,omitempty"`
+
+	// Instance Path of the request that caused the problem
+	Instance *string `json:"instance,omitempty"`
+
+	// Kind Kind of the resource the problem concerns, when known
+	Kind *string `json:"kind,omitempty"`
+
+	// Name Name of the resource the problem concerns, when known
+	Name *string `json:"name,omitempty"`
+
+	// Status HTTP status code
+	Status int `json:"status"`
+
+	// Title Short summary of the problem type
+	Title string `json:"title"`
+
+	// Type URI identifying the problem type, derived from `code`
+	Type string `json:"type"`
 }
 
 // ErrorResponseCode Machine-readable error code
@@ -2118,6 +3462,18 @@ type ExternalRef struct {
 // ExternalRefKind Kind of the referenced resource.
 type ExternalRefKind string
 
+// FieldError An invalid field of a request
+type FieldError struct {
+	// Field Path of the field
+	Field string `json:"field"`
+
+	// Message What is wrong with the field
+	Message string `json:"message"`
+
+	// Reason Machine-readable cause, such as FieldValueRequired
+	Reason *string `json:"reason,omitempty"`
+}
+
 // FileVar File mount variable
 type FileVar struct {
 	// Key File key/name
@@ -2187,6 +3543,21 @@ type GenerateReleaseRequest struct {
 	ReleaseName *string `json:"releaseName,omitempty"`
 }
 
+// GitOpsExport Files of a GitOps export
+type GitOpsExport struct {
+	// Files Files of the export, sorted by path
+	Files []GitOpsExportFile `json:"files"`
+}
+
+// GitOpsExportFile File of a GitOps export
+type GitOpsExportFile struct {
+	// Content YAML content of the file
+	Content string `json:"content"`
+
+	// Path Slash-separated path of the file relative to the root of the export
+	Path string `json:"path"`
+}
+
 // GitSecretListResponse List of git secrets
 type GitSecretListResponse struct {
 	// Items List of git secrets
@@ -2217,6 +3588,19 @@ type GitSecretResponse struct {
 	WorkflowPlaneName *string `json:"workflowPlaneName,omitempty"`
 }
 
+// HealthCheckResult Outcome of a single health check
+type HealthCheckResult struct {
+	// DurationMs Time the check took in milliseconds
+	DurationMs int64 `json:"durationMs"`
+
+	// Message Reason the check failed
+	Message *string                 `json:"message,omitempty"`
+	Status  HealthCheckResultStatus `json:"status"`
+}
+
+// HealthCheckResultStatus defines model for HealthCheckResult.Status.
+type HealthCheckResultStatus string
+
 // HealthInfo Health status for a resource node
 type HealthInfo struct {
 	// Message Optional human-readable message explaining the health status
@@ -2226,19 +3610,81 @@ type HealthInfo struct {
 	Status string `json:"status"`
 }
 
+// HealthReport Outcome of the health checks of a liveness or readiness probe
+type HealthReport struct {
+	// Checks Check results keyed by check name
+	Checks map[string]HealthCheckResult `json:"checks"`
+
+	// Status Overall status. `degraded` means only optional checks failed.
+	Status HealthReportStatus `json:"status"`
+}
+
+// HealthReportStatus Overall status. `degraded` means only optional checks failed.
+type HealthReportStatus string
+
 // K8sResourceTreeResponse Response containing resource trees for all rendered releases owned by a release binding
 type K8sResourceTreeResponse struct {
 	// RenderedReleases Resource trees per rendered release (dataplane and/or observabilityplane)
 	RenderedReleases []ReleaseResourceTree `json:"renderedReleases"`
 }
 
+// KindField A field of a resource kind
+type KindField struct {
+	// Default JSON encoding of the default value
+	Default     *string   `json:"default,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	Enum        *[]string `json:"enum,omitempty"`
+
+	// Path Dot-separated path of the field. Fields of array items and map values are addressed through the path of the array or map.
+	Path string `json:"path"`
+
+	// Required Whether the parent object requires the field
+	Required bool `json:"required"`
+
+	// Type Type of the field, such as string, integer, Object, []Object or map[string]string
+	Type string `json:"type"`
+}
+
+// KindFields Fields of a resource kind, flattened from the OpenAPI schema of its CRD
+type KindFields struct {
+	Description *string `json:"description,omitempty"`
+
+	// Fields Every field of the kind in depth-first order, with the fields of an object sorted by name
+	Fields  []KindField `json:"fields"`
+	Group   string      `json:"group"`
+	Kind    string      `json:"kind"`
+	Version string      `json:"version"`
+}
+
+// KindList Resource kinds served by the API, sorted by kind
+type KindList struct {
+	Items []PrinterColumns `json:"items"`
+}
+
+// KindSchema OpenAPI v3 schema of the storage version of a resource kind
+type KindSchema struct {
+	Group string `json:"group"`
+	Kind  string `json:"kind"`
+
+	// Schema The openAPIV3Schema of the CRD version
+	Schema  map[string]interface{} `json:"schema"`
+	Version string                 `json:"version"`
+}
+
+// KindSchemaList defines model for KindSchemaList.
+type KindSchemaList struct {
+	// Items Schemas of the resource kinds, sorted by kind
+	Items []KindSchema `json:"items"`
+}
+
 // ListSecretsResponse Paginated list of secrets.
 type ListSecretsResponse struct {
 	// Items Page of secrets.
 	Items []Secret `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -2262,8 +3708,9 @@ type Namespace struct {
 type NamespaceList struct {
 	Items []Namespace `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -2276,6 +3723,101 @@ type NamespaceStatus struct {
 // NamespaceStatusPhase Namespace phase
 type NamespaceStatusPhase string
 
+// NamespacedName defines model for NamespacedName.
+type NamespacedName struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// NetworkAllowMatrix Reachability of the endpoints of a project's components in an environment
+type NetworkAllowMatrix struct {
+	Entries     []NetworkAllowMatrixEntry `json:"entries"`
+	Environment string                    `json:"environment"`
+	ProjectName string                    `json:"projectName"`
+}
+
+// NetworkAllowMatrixEntry defines model for NetworkAllowMatrixEntry.
+type NetworkAllowMatrixEntry struct {
+	Allowed     bool `json:"allowed"`
+	Destination struct {
+		Component string `json:"component"`
+		Endpoint  string `json:"endpoint"`
+	} `json:"destination"`
+
+	// Reason ProjectVisibility, NamespaceVisibility, SystemComponent or AllowedByRule when allowed; DeniedByRule or NotVisible otherwise
+	Reason string `json:"reason"`
+
+	// Rule NetworkPolicyRule that decided the entry, if any
+	Rule   *string `json:"rule,omitempty"`
+	Source struct {
+		Component *string `json:"component,omitempty"`
+		Project   *string `json:"project,omitempty"`
+
+		// SystemComponent The caller is a platform gateway rather than a component
+		SystemComponent bool `json:"systemComponent"`
+	} `json:"source"`
+}
+
+// NetworkPolicyRule NetworkPolicyRule resource.
+// Org-level rule that allows or denies traffic to the endpoints of a project,
+// on top of the cell-based isolation of endpoint visibility.
+type NetworkPolicyRule struct {
+	// ApiVersion API version of the resource
+	ApiVersion *string `json:"apiVersion,omitempty"`
+
+	// Kind Kind of the resource
+	Kind *string `json:"kind,omitempty"`
+
+	// Metadata Standard Kubernetes object metadata (without kind/apiVersion).
+	// Matches the structure of metav1.ObjectMeta for the fields exposed via the API.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec Desired state of a NetworkPolicyRule
+	Spec *NetworkPolicyRuleSpec `json:"spec,omitempty"`
+}
+
+// NetworkPolicyRuleList Paginated list of network policy rules
+type NetworkPolicyRuleList struct {
+	Items []NetworkPolicyRule `json:"items"`
+
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
+	Pagination Pagination `json:"pagination"`
+}
+
+// NetworkPolicyRuleSpec Desired state of a NetworkPolicyRule
+type NetworkPolicyRuleSpec struct {
+	// Action Allow opens the destination to the source. Deny blocks the source and takes precedence over visibility and Allow rules; platform gateways are never blocked.
+	Action NetworkPolicyRuleSpecAction `json:"action"`
+
+	// Description Why the rule exists
+	Description *string `json:"description,omitempty"`
+
+	// Destination Endpoints the rule applies to
+	Destination struct {
+		// Component Component of the project. Empty matches every component.
+		Component *string `json:"component,omitempty"`
+
+		// Endpoints Endpoint names. Empty matches every endpoint.
+		Endpoints *[]string `json:"endpoints,omitempty"`
+		Project   string    `json:"project"`
+	} `json:"destination"`
+
+	// Environments Environments the rule applies in. Empty applies in every environment.
+	Environments *[]string `json:"environments,omitempty"`
+
+	// Source Callers the rule applies to. Empty matches every component of the namespace.
+	Source *struct {
+		// Component Component name. Requires project.
+		Component *string `json:"component,omitempty"`
+		Project   *string `json:"project,omitempty"`
+	} `json:"source,omitempty"`
+}
+
+// NetworkPolicyRuleSpecAction Allow opens the destination to the source. Deny blocks the source and takes precedence over visibility and Allow rules; platform gateways are never blocked.
+type NetworkPolicyRuleSpecAction string
+
 // NotificationEmailConfig Configuration for email notification channels
 type NotificationEmailConfig struct {
 	// From Sender email address
@@ -2444,8 +3986,9 @@ type ObservabilityAlertsNotificationChannel struct {
 type ObservabilityAlertsNotificationChannelList struct {
 	Items []ObservabilityAlertsNotificationChannel `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -2506,8 +4049,9 @@ type ObservabilityPlane struct {
 type ObservabilityPlaneList struct {
 	Items []ObservabilityPlane `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -2528,6 +4072,9 @@ type ObservabilityPlaneSpec struct {
 	// ClusterAgent Configuration for cluster agent-based communication
 	ClusterAgent *ClusterAgentConfig `json:"clusterAgent,omitempty"`
 
+	// DashboardURL URL template of the observability dashboard of a component. The placeholders {namespace}, {project}, {component} and {environment} are replaced with the names of the component and environment to view.
+	DashboardURL *string `json:"dashboardURL,omitempty"`
+
 	// FinOpsAgentURL Base URL of the FinOps Agent API in the observability plane cluster
 	FinOpsAgentURL *string `json:"finOpsAgentURL,omitempty"`
 
@@ -2566,9 +4113,14 @@ type OpenChoreoClient struct {
 	Scopes []string `json:"scopes"`
 }
 
-// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-// for efficient pagination through large result sets.
+// Pagination List metadata shared by all list responses: cursor-based pagination
+// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+// the applied sort and the applied filters.
 type Pagination struct {
+	// AppliedFilters Filters the items were selected by, keyed by query parameter name
+	// (for example `project` or `labelSelector`).
+	AppliedFilters *map[string]string `json:"appliedFilters,omitempty"`
+
 	// NextCursor Opaque cursor for fetching the next page. Pass this value as the
 	// `cursor` query parameter in the next request. Absent when there
 	// are no more items.
@@ -2579,6 +4131,14 @@ type Pagination struct {
 	// Use for UI hints like "~50 more items". May be absent for
 	// filtered queries.
 	RemainingCount *int64 `json:"remainingCount,omitempty"`
+
+	// SortKey Sort the items are ordered by. Absent for unsorted lists.
+	SortKey *string `json:"sortKey,omitempty"`
+
+	// TotalCount Total number of items across all pages. Always present for sorted
+	// lists. For unsorted lists it is present on the first page when the
+	// total is known without listing every item.
+	TotalCount *int64 `json:"totalCount,omitempty"`
 }
 
 // PatchComponentRequest Request to patch a component. All fields are optional. Each field follows partial-update
@@ -2689,6 +4249,34 @@ type PostRenderValidation struct {
 // PostRenderValidationTargetPlane Plane to scope selection to; without it a rule matches resources of the same GVK across every plane
 type PostRenderValidationTargetPlane string
 
+// PrinterColumn A column of the table view of a resource kind
+type PrinterColumn struct {
+	Description *string `json:"description,omitempty"`
+	Format      *string `json:"format,omitempty"`
+
+	// JsonPath JSONPath selecting the column value from a resource
+	JsonPath string `json:"jsonPath"`
+	Name     string `json:"name"`
+
+	// Priority Columns with a priority above 0 are only shown in wide output
+	Priority *int32 `json:"priority,omitempty"`
+
+	// Type OpenAPI type of the column, one of string, integer, number, boolean or date
+	Type string `json:"type"`
+}
+
+// PrinterColumns Table layout of a resource kind, taken from the additional printer columns of its CRD
+type PrinterColumns struct {
+	Columns []PrinterColumn `json:"columns"`
+	Kind    string          `json:"kind"`
+
+	// Namespaced Whether resources of the kind belong to a namespace
+	Namespaced bool      `json:"namespaced"`
+	Plural     string    `json:"plural"`
+	ShortNames *[]string `json:"shortNames,omitempty"`
+	Singular   *string   `json:"singular,omitempty"`
+}
+
 // Project Project resource.
 // Projects group components within a namespace and reference a deployment pipeline.
 type Project struct {
@@ -2707,15 +4295,65 @@ type Project struct {
 	Status *ProjectStatus `json:"status,omitempty"`
 }
 
+// ProjectComponentHealth Health of every component of a project across its environments
+type ProjectComponentHealth struct {
+	Components []ComponentHealth `json:"components"`
+
+	// Environments Environments of the project in the order of their stage in the deployment pipeline, followed by any other environment a component is bound to
+	Environments []string `json:"environments"`
+	ProjectName  string   `json:"projectName"`
+}
+
 // ProjectList Paginated list of projects
 type ProjectList struct {
 	Items []Project `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
+// ProjectNetworkPolicies Network policies of the components of a project deployed to an environment
+type ProjectNetworkPolicies struct {
+	Components  []ComponentNetworkPolicies `json:"components"`
+	Environment string                     `json:"environment"`
+	ProjectName string                     `json:"projectName"`
+}
+
+// ProjectQuota Resource quota of a project in an environment. hard is enforced on the
+// data-plane namespace as a ResourceQuota; the container defaults and
+// maximum as a LimitRange. When hard limits the requests or limits of a
+// resource, set defaultRequest and default for it so that containers
+// that do not declare them are admitted.
+type ProjectQuota struct {
+	// Default Resource limit of containers that do not set one.
+	Default *ResourceQuantities `json:"default,omitempty"`
+
+	// DefaultRequest Resource request of containers that do not set one (cpu, memory or ephemeral-storage).
+	DefaultRequest *ResourceQuantities `json:"defaultRequest,omitempty"`
+
+	// Hard Total amount of each resource the namespace may use, keyed as in a ResourceQuota (requests.cpu, limits.memory, pods, count/deployments.apps, ...).
+	Hard *ResourceQuantities `json:"hard,omitempty"`
+
+	// Max Largest resource limit a container may set.
+	Max *ResourceQuantities `json:"max,omitempty"`
+}
+
+// ProjectQuotaList defines model for ProjectQuotaList.
+type ProjectQuotaList struct {
+	Items []EnvironmentQuota `json:"items"`
+}
+
+// ProjectQuotaUsage Quota enforced on the data plane and its current usage.
+type ProjectQuotaUsage struct {
+	// Hard Kubernetes resource quantities keyed by resource name.
+	Hard *ResourceQuantities `json:"hard,omitempty"`
+
+	// Used Kubernetes resource quantities keyed by resource name.
+	Used *ResourceQuantities `json:"used,omitempty"`
+}
+
 // ProjectRelease ProjectRelease resource.
 // Immutable snapshot of Project.spec and the referenced (Cluster)ProjectType.spec
 // at the time it was cut. Normally cut by the Project controller; the create
@@ -2766,8 +4404,9 @@ type ProjectReleaseBinding struct {
 type ProjectReleaseBindingList struct {
 	Items []ProjectReleaseBinding `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -2791,6 +4430,13 @@ type ProjectReleaseBindingSpec struct {
 
 	// ProjectRelease Pinned ProjectRelease name. Left unset, it is seeded once by the Project controller with the latest release; advanced manually afterwards (e.g. via `occ project promote`).
 	ProjectRelease *string `json:"projectRelease,omitempty"`
+
+	// Quota Resource quota of a project in an environment. hard is enforced on the
+	// data-plane namespace as a ResourceQuota; the container defaults and
+	// maximum as a LimitRange. When hard limits the requests or limits of a
+	// resource, set defaultRequest and default for it so that containers
+	// that do not declare them are admitted.
+	Quota *ProjectQuota `json:"quota,omitempty"`
 }
 
 // ProjectReleaseBindingStatus Observed state of a ProjectReleaseBinding.
@@ -2803,14 +4449,18 @@ type ProjectReleaseBindingStatus struct {
 
 	// ObservedGeneration Most recent generation observed by the controller.
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// Quota Quota enforced on the data plane and its current usage.
+	Quota *ProjectQuotaUsage `json:"quota,omitempty"`
 }
 
 // ProjectReleaseList Paginated list of project releases
 type ProjectReleaseList struct {
 	Items []ProjectRelease `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -2909,8 +4559,9 @@ type ProjectType struct {
 type ProjectTypeList struct {
 	Items []ProjectType `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -2964,6 +4615,12 @@ type ProjectTypeSpec struct {
 // ProjectTypeSpecResourcesTargetPlane Target plane for deployment.
 type ProjectTypeSpecResourcesTargetPlane string
 
+// PromotionDecisionRequest Approval or rejection of a promotion request
+type PromotionDecisionRequest struct {
+	// Comment Note recorded with the decision
+	Comment *string `json:"comment,omitempty"`
+}
+
 // PromotionPath Promotion path between environments
 type PromotionPath struct {
 	// SourceEnvironmentRef Reference to the source environment for this promotion path.
@@ -2982,6 +4639,111 @@ type PromotionPath struct {
 // PromotionPathSourceEnvironmentRefKind Kind of environment resource
 type PromotionPathSourceEnvironmentRefKind string
 
+// PromotionRequest PromotionRequest resource.
+// Records a promotion of a component release from one environment to another,
+// together with its approval decision.
+type PromotionRequest struct {
+	// ApiVersion API version of the resource
+	ApiVersion *string `json:"apiVersion,omitempty"`
+
+	// Kind Kind of the resource
+	Kind *string `json:"kind,omitempty"`
+
+	// Metadata Standard Kubernetes object metadata (without kind/apiVersion).
+	// Matches the structure of metav1.ObjectMeta for the fields exposed via the API.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec Desired state of a PromotionRequest
+	Spec   *PromotionRequestSpec   `json:"spec,omitempty"`
+	Status *PromotionRequestStatus `json:"status,omitempty"`
+}
+
+// PromotionRequestList Paginated list of promotion requests
+type PromotionRequestList struct {
+	Items []PromotionRequest `json:"items"`
+
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
+	Pagination Pagination `json:"pagination"`
+}
+
+// PromotionRequestSpec Desired state of a PromotionRequest
+type PromotionRequestSpec struct {
+	// Comment Note from the requester
+	Comment *string `json:"comment,omitempty"`
+
+	// Owner Owner identifies the component and project this PromotionRequest belongs to
+	Owner struct {
+		ComponentName string `json:"componentName"`
+		ProjectName   string `json:"projectName"`
+	} `json:"owner"`
+
+	// ReleaseName Component release bound in the source environment when the promotion was requested
+	ReleaseName string `json:"releaseName"`
+
+	// RequestedBy Subject that requested the promotion
+	RequestedBy *string `json:"requestedBy,omitempty"`
+
+	// SourceEnvironment Environment the release is promoted from
+	SourceEnvironment string `json:"sourceEnvironment"`
+
+	// TargetEnvironment Environment the release is promoted to
+	TargetEnvironment string `json:"targetEnvironment"`
+}
+
+// PromotionRequestStatus Observed state of a PromotionRequest
+type PromotionRequestStatus struct {
+	// ApprovalRequired Whether the promotion path requires a manual approval
+	ApprovalRequired *bool `json:"approvalRequired,omitempty"`
+
+	// Decision Approval or rejection of the promotion, when one was made
+	Decision *struct {
+		// Approved True when the promotion was approved, false when it was rejected
+		Approved bool `json:"approved"`
+
+		// Comment Note from the approver
+		Comment   *string   `json:"comment,omitempty"`
+		DecidedAt time.Time `json:"decidedAt"`
+
+		// DecidedBy Subject that made the decision
+		DecidedBy *string `json:"decidedBy,omitempty"`
+	} `json:"decision,omitempty"`
+
+	// Phase Lifecycle phase of the promotion. One of PendingApproval, Promoted or Rejected.
+	Phase *string `json:"phase,omitempty"`
+
+	// PromotedAt When the release was bound to the target environment
+	PromotedAt *time.Time `json:"promotedAt,omitempty"`
+
+	// ReleaseBindingName Target release binding created or updated by the promotion
+	ReleaseBindingName *string `json:"releaseBindingName,omitempty"`
+}
+
+// RegistryCredential Container registry credentials. The password is never returned.
+type RegistryCredential struct {
+	// Name Name of the registry credentials
+	Name string `json:"name"`
+
+	// Namespace Namespace of the registry credentials
+	Namespace string `json:"namespace"`
+
+	// Server Canonical registry host the credentials authenticate to
+	Server string `json:"server"`
+
+	// TargetPlane Reference to the plane that hosts the secret data.
+	TargetPlane TargetPlaneRef `json:"targetPlane"`
+
+	// Username Registry username
+	Username *string `json:"username,omitempty"`
+}
+
+// RegistryCredentialListResponse List of registry credentials
+type RegistryCredentialListResponse struct {
+	// Items List of registry credentials
+	Items []RegistryCredential `json:"items"`
+}
+
 // ReleaseBinding ReleaseBinding resource.
 // Binds a ComponentRelease to a specific environment.
 type ReleaseBinding struct {
@@ -3004,8 +4766,9 @@ type ReleaseBinding struct {
 type ReleaseBindingList struct {
 	Items []ReleaseBinding `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -3032,6 +4795,9 @@ type ReleaseBindingSpec struct {
 	// State Controls the state of the Release created by this binding
 	State *ReleaseBindingSpecState `json:"state,omitempty"`
 
+	// Strategy How a new release is rolled out to the environment
+	Strategy *RolloutStrategy `json:"strategy,omitempty"`
+
 	// TraitEnvironmentConfigs Environment-specific trait environment configs
 	TraitEnvironmentConfigs *map[string]interface{} `json:"traitEnvironmentConfigs,omitempty"`
 
@@ -3050,6 +4816,9 @@ type ReleaseBindingStatus struct {
 	// Endpoints Resolved invoke URLs for each named workload endpoint
 	Endpoints *[]EndpointURLStatus `json:"endpoints,omitempty"`
 
+	// History Releases bound to the environment, newest first
+	History *[]ReleaseRecord `json:"history,omitempty"`
+
 	// LastSpecUpdateTime Timestamp of the last spec change observed by the controller
 	LastSpecUpdateTime *time.Time `json:"lastSpecUpdateTime,omitempty"`
 
@@ -3061,8 +4830,75 @@ type ReleaseBindingStatus struct {
 
 	// ResolvedConnections Connections that have been successfully resolved
 	ResolvedConnections *[]ResolvedConnection `json:"resolvedConnections,omitempty"`
+
+	// Rollout State of the rollout of the bound release
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+}
+
+// ReleaseHistory Releases of a component to its environments, newest first
+type ReleaseHistory struct {
+	Items []ReleaseHistoryEntry `json:"items"`
+}
+
+// ReleaseHistoryEntry defines model for ReleaseHistoryEntry.
+type ReleaseHistoryEntry struct {
+	// ConfigChecksum Checksum of the release and the binding's environment configuration
+	ConfigChecksum string `json:"configChecksum"`
+
+	// Current Whether this release is currently bound to the environment
+	Current bool `json:"current"`
+
+	// DeployedAt When the release was bound to the environment
+	DeployedAt  time.Time `json:"deployedAt"`
+	Environment string    `json:"environment"`
+
+	// Image Container image of the release's workload
+	Image              *string `json:"image,omitempty"`
+	ReleaseBindingName string  `json:"releaseBindingName"`
+
+	// ReleaseName Component release that was bound
+	ReleaseName string `json:"releaseName"`
+
+	// SupersededAt When a newer release replaced this one
+	SupersededAt *time.Time `json:"supersededAt,omitempty"`
+
+	// Trigger What caused the release
+	Trigger *ReleaseHistoryEntryTrigger `json:"trigger,omitempty"`
+
+	// TriggeredBy Who triggered the release (a user, promotion request or component), when known
+	TriggeredBy *string `json:"triggeredBy,omitempty"`
+}
+
+// ReleaseHistoryEntryTrigger What caused the release
+type ReleaseHistoryEntryTrigger string
+
+// ReleaseRecord An entry in the release history of a ReleaseBinding
+type ReleaseRecord struct {
+	// ConfigChecksum Checksum of the release and the binding's environment configuration
+	ConfigChecksum string `json:"configChecksum"`
+
+	// DeployedAt When the release was bound to the environment
+	DeployedAt time.Time `json:"deployedAt"`
+
+	// Image Container image of the release's workload
+	Image *string `json:"image,omitempty"`
+
+	// ReleaseName Component release that was bound
+	ReleaseName string `json:"releaseName"`
+
+	// SupersededAt When a newer release replaced this one
+	SupersededAt *time.Time `json:"supersededAt,omitempty"`
+
+	// Trigger What caused the release
+	Trigger *ReleaseRecordTrigger `json:"trigger,omitempty"`
+
+	// TriggeredBy Who triggered the release (a user, promotion request or component), when known
+	TriggeredBy *string `json:"triggeredBy,omitempty"`
 }
 
+// ReleaseRecordTrigger What caused the release
+type ReleaseRecordTrigger string
+
 // ReleaseResourceTree Resource tree for a single release
 type ReleaseResourceTree struct {
 	// Name Name of the release
@@ -3183,6 +5019,14 @@ type RenderedReleaseStatus struct {
 // RenderedReleaseStatusResourcesHealthStatus Health status of the resource
 type RenderedReleaseStatusResourcesHealthStatus string
 
+// ReplicaStatus Replica status of the component's Deployment or StatefulSet
+type ReplicaStatus struct {
+	Available int32 `json:"available"`
+	Desired   int32 `json:"desired"`
+	Ready     int32 `json:"ready"`
+	Updated   int32 `json:"updated"`
+}
+
 // ResolvedConnection Holds the resolved URL for a single connection
 type ResolvedConnection struct {
 	// Component Name of the target component
@@ -3253,6 +5097,14 @@ type ResourceConfigMapKeyRef struct {
 	Name string `json:"name"`
 }
 
+// ResourceDiff Field-level differences between a stored resource and a desired one.
+type ResourceDiff struct {
+	Changes []ResourceFieldChange `json:"changes"`
+
+	// Exists Whether the resource exists. When false, every desired field is reported as added.
+	Exists bool `json:"exists"`
+}
+
 // ResourceEvent A Kubernetes event associated with a resource
 type ResourceEvent struct {
 	// Count Number of times this event has occurred
@@ -3283,6 +5135,21 @@ type ResourceEventsResponse struct {
 	Events []ResourceEvent `json:"events"`
 }
 
+// ResourceFieldChange A single changed field.
+type ResourceFieldChange struct {
+	// Current Stored value, omitted for added fields
+	Current interface{} `json:"current,omitempty"`
+
+	// Desired Desired value, omitted for removed fields
+	Desired interface{} `json:"desired,omitempty"`
+
+	// Operation How applying the desired resource changes the field (add, remove or replace)
+	Operation string `json:"operation"`
+
+	// Path Dot-separated path of the field
+	Path string `json:"path"`
+}
+
 // ResourceHierarchy Resource hierarchy scope. Authoritative validation lives on the
 // AuthzRoleBinding / ClusterAuthzRoleBinding CRD CEL rules; this schema
 // documents the same invariants for clients:
@@ -3328,8 +5195,9 @@ type ResourceInstance struct {
 type ResourceInstanceList struct {
 	Items []ResourceInstance `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -3408,6 +5276,9 @@ type ResourcePodLogsResponse struct {
 	LogEntries []PodLogEntry `json:"logEntries"`
 }
 
+// ResourceQuantities Kubernetes resource quantities keyed by resource name.
+type ResourceQuantities map[string]string
+
 // ResourceRef Reference to a parent resource in the resource tree
 type ResourceRef struct {
 	// Group API group of the resource
@@ -3483,8 +5354,9 @@ type ResourceReleaseBinding struct {
 type ResourceReleaseBindingList struct {
 	Items []ResourceReleaseBinding `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -3530,8 +5402,9 @@ type ResourceReleaseBindingStatus struct {
 type ResourceReleaseList struct {
 	Items []ResourceRelease `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -3598,8 +5471,9 @@ type ResourceType struct {
 type ResourceTypeList struct {
 	Items []ResourceType `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -3648,30 +5522,153 @@ type ResourceTypeRef struct {
 	Name string `json:"name"`
 }
 
-// ResourceTypeRefKind Resource type kind. Defaults to ResourceType (namespaced).
-type ResourceTypeRefKind string
+// ResourceTypeRefKind Resource type kind. Defaults to ResourceType (namespaced).
+type ResourceTypeRefKind string
+
+// ResourceTypeSpec Desired state of a (Cluster)ResourceType.
+type ResourceTypeSpec struct {
+	// EnvironmentConfigs Schema section using openAPIV3Schema format
+	EnvironmentConfigs *SchemaSection `json:"environmentConfigs,omitempty"`
+
+	// Outputs Outputs that workloads consume via dependencies.resources[].envBindings or fileBindings.
+	Outputs *[]ResourceTypeOutput `json:"outputs,omitempty"`
+
+	// Parameters Schema section using openAPIV3Schema format
+	Parameters *SchemaSection `json:"parameters,omitempty"`
+
+	// Resources Kubernetes manifests the (Cluster)ResourceType provisioner emits on the data plane.
+	Resources []ResourceTypeManifest `json:"resources"`
+
+	// RetainPolicy Default retention for ResourceReleaseBindings of this type. Per-env override available on the binding.
+	RetainPolicy *ResourceTypeSpecRetainPolicy `json:"retainPolicy,omitempty"`
+}
+
+// ResourceTypeSpecRetainPolicy Default retention for ResourceReleaseBindings of this type. Per-env override available on the binding.
+type ResourceTypeSpecRetainPolicy string
+
+// RollbackComponentRequest defines model for RollbackComponentRequest.
+type RollbackComponentRequest struct {
+	// Environment Environment to roll back
+	Environment string `json:"environment"`
+
+	// Release Release from the environment's history to roll back to; defaults to the previous release
+	Release *string `json:"release,omitempty"`
+}
+
+// RollbackComponentResponse defines model for RollbackComponentResponse.
+type RollbackComponentResponse struct {
+	// PreviousRelease Release that was bound before the rollback
+	PreviousRelease string `json:"previousRelease"`
+
+	// Release Release the environment was rolled back to
+	Release string `json:"release"`
+
+	// ReleaseBinding ReleaseBinding resource.
+	// Binds a ComponentRelease to a specific environment.
+	ReleaseBinding ReleaseBinding `json:"releaseBinding"`
+}
+
+// RolloutStatus State of the rollout of the bound release
+type RolloutStatus struct {
+	// CanaryRelease Release being rolled out
+	CanaryRelease *string `json:"canaryRelease,omitempty"`
+
+	// CanaryWeight Percentage of gateway traffic routed to the canary release
+	CanaryWeight *int32 `json:"canaryWeight,omitempty"`
+
+	// Message Current state of the rollout
+	Message *string            `json:"message,omitempty"`
+	Phase   RolloutStatusPhase `json:"phase"`
+
+	// StableRelease Release serving traffic before the rollout
+	StableRelease *string `json:"stableRelease,omitempty"`
+
+	// Step Index of the current canary step
+	Step *int32 `json:"step,omitempty"`
+
+	// StepStartedAt When the current step started
+	StepStartedAt *time.Time            `json:"stepStartedAt,omitempty"`
+	Strategy      RolloutStatusStrategy `json:"strategy"`
+}
+
+// RolloutStatusPhase defines model for RolloutStatus.Phase.
+type RolloutStatusPhase string
+
+// RolloutStatusStrategy defines model for RolloutStatus.Strategy.
+type RolloutStatusStrategy string
+
+// RolloutStrategy How a new release is rolled out to the environment
+type RolloutStrategy struct {
+	// Canary Traffic steps of a Canary rollout
+	Canary *struct {
+		Steps []CanaryStep `json:"steps"`
+	} `json:"canary,omitempty"`
+
+	// Type RollingUpdate updates the workloads in place. Canary runs the new release next to the running one and shifts gateway traffic to it in steps. BlueGreen runs the new release without traffic and switches all traffic to it when the rollout is promoted.
+	Type *RolloutStrategyType `json:"type,omitempty"`
+}
+
+// RolloutStrategyType RollingUpdate updates the workloads in place. Canary runs the new release next to the running one and shifts gateway traffic to it in steps. BlueGreen runs the new release without traffic and switches all traffic to it when the rollout is promoted.
+type RolloutStrategyType string
+
+// Sandbox Ephemeral environment with the components of a project deployed to it
+type Sandbox struct {
+	Components []SandboxComponent `json:"components"`
+	CreatedAt  *time.Time         `json:"createdAt,omitempty"`
+
+	// Deleting The sandbox is being deleted
+	Deleting *bool `json:"deleting,omitempty"`
+
+	// ExpiresAt When the sandbox is deleted
+	ExpiresAt         time.Time `json:"expiresAt"`
+	Name              string    `json:"name"`
+	ProjectName       string    `json:"projectName"`
+	SourceEnvironment string    `json:"sourceEnvironment"`
+	Subdomain         string    `json:"subdomain"`
+	Ttl               string    `json:"ttl"`
+}
+
+// SandboxComponent defines model for SandboxComponent.
+type SandboxComponent struct {
+	ComponentName string `json:"componentName"`
+
+	// Endpoints Invoke URLs of the component's endpoints in the sandbox
+	Endpoints          *[]SandboxEndpoint `json:"endpoints,omitempty"`
+	ReleaseBindingName string             `json:"releaseBindingName"`
+	ReleaseName        *string            `json:"releaseName,omitempty"`
+}
+
+// SandboxEndpoint defines model for SandboxEndpoint.
+type SandboxEndpoint struct {
+	Name string  `json:"name"`
+	Url  *string `json:"url,omitempty"`
+}
+
+// SandboxList Paginated list of sandboxes
+type SandboxList struct {
+	Items []Sandbox `json:"items"`
 
-// ResourceTypeSpec Desired state of a (Cluster)ResourceType.
-type ResourceTypeSpec struct {
-	// EnvironmentConfigs Schema section using openAPIV3Schema format
-	EnvironmentConfigs *SchemaSection `json:"environmentConfigs,omitempty"`
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
+	Pagination Pagination `json:"pagination"`
+}
 
-	// Outputs Outputs that workloads consume via dependencies.resources[].envBindings or fileBindings.
-	Outputs *[]ResourceTypeOutput `json:"outputs,omitempty"`
+// SchemaDetails Parameter schema together with the resource it belongs to (v1 response version of the schema endpoints).
+type SchemaDetails struct {
+	// Kind Kind of the resource that defines the schema
+	Kind string `json:"kind"`
 
-	// Parameters Schema section using openAPIV3Schema format
-	Parameters *SchemaSection `json:"parameters,omitempty"`
+	// Name Name of the resource that defines the schema
+	Name string `json:"name"`
 
-	// Resources Kubernetes manifests the (Cluster)ResourceType provisioner emits on the data plane.
-	Resources []ResourceTypeManifest `json:"resources"`
+	// Namespace Namespace of the resource, empty for cluster-scoped resources
+	Namespace *string `json:"namespace,omitempty"`
 
-	// RetainPolicy Default retention for ResourceReleaseBindings of this type. Per-env override available on the binding.
-	RetainPolicy *ResourceTypeSpecRetainPolicy `json:"retainPolicy,omitempty"`
+	// Schema JSON Schema response for component types, traits, or workflows
+	Schema SchemaResponse `json:"schema"`
 }
 
-// ResourceTypeSpecRetainPolicy Default retention for ResourceReleaseBindings of this type. Per-env override available on the binding.
-type ResourceTypeSpecRetainPolicy string
-
 // SchemaResponse JSON Schema response for component types, traits, or workflows
 type SchemaResponse map[string]interface{}
 
@@ -3745,8 +5742,9 @@ type SecretReference struct {
 type SecretReferenceList struct {
 	Items []SecretReference `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -3851,11 +5849,17 @@ type SubjectTypeConfig struct {
 
 // TargetEnvironmentRef Target environment reference
 type TargetEnvironmentRef struct {
+	// BlockCriticalVulnerabilities Whether promotions to this environment are rejected when the scan of the promoted image reports critical vulnerabilities
+	BlockCriticalVulnerabilities *bool `json:"blockCriticalVulnerabilities,omitempty"`
+
 	// Kind Kind of environment resource
 	Kind *TargetEnvironmentRefKind `json:"kind,omitempty"`
 
 	// Name Target environment name
 	Name string `json:"name"`
+
+	// RequiresApproval Whether promotions to this environment wait for a manual approval
+	RequiresApproval *bool `json:"requiresApproval,omitempty"`
 }
 
 // TargetEnvironmentRefKind Kind of environment resource
@@ -3895,8 +5899,9 @@ type Trait struct {
 type TraitList struct {
 	Items []Trait `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -4020,6 +6025,24 @@ type TraitSpecPatchesTargetPlane string
 // TraitStatus Observed state of a Trait
 type TraitStatus = map[string]interface{}
 
+// TriggerComponentBuildRequest defines model for TriggerComponentBuildRequest.
+type TriggerComponentBuildRequest struct {
+	// Commit Commit SHA to build; defaults to the revision configured on the component
+	Commit *string `json:"commit,omitempty"`
+}
+
+// UpdateEndpointRequest Endpoint settings to change; omitted fields are left unchanged
+type UpdateEndpointRequest struct {
+	// BasePath Base path of the API exposed via the endpoint; must start with '/'
+	BasePath *string `json:"basePath,omitempty"`
+
+	// Visibility Visibilities of the endpoint; project visibility is always kept
+	Visibility *[]UpdateEndpointRequestVisibility `json:"visibility,omitempty"`
+}
+
+// UpdateEndpointRequestVisibility defines model for UpdateEndpointRequest.Visibility.
+type UpdateEndpointRequestVisibility string
+
 // UpdateSecretRequest Request body for replacing a secret's data. The data map is the final
 // state; keys present in the existing secret but absent here are pruned.
 type UpdateSecretRequest struct {
@@ -4087,6 +6110,37 @@ type VersionResponse struct {
 	Version string `json:"version"`
 }
 
+// Vulnerability A vulnerability finding of an image scan
+type Vulnerability struct {
+	// FixedVersion Version that fixes the vulnerability, when known
+	FixedVersion *string `json:"fixedVersion,omitempty"`
+
+	// Id Vulnerability identifier
+	Id string `json:"id"`
+
+	// InstalledVersion Package version found in the image
+	InstalledVersion *string `json:"installedVersion,omitempty"`
+
+	// Package Affected package
+	Package  string                `json:"package"`
+	Severity VulnerabilitySeverity `json:"severity"`
+
+	// Title Short description of the vulnerability
+	Title *string `json:"title,omitempty"`
+}
+
+// VulnerabilitySeverity defines model for Vulnerability.Severity.
+type VulnerabilitySeverity string
+
+// VulnerabilitySummary Vulnerability findings counted by severity
+type VulnerabilitySummary struct {
+	Critical int32 `json:"critical"`
+	High     int32 `json:"high"`
+	Low      int32 `json:"low"`
+	Medium   int32 `json:"medium"`
+	Unknown  int32 `json:"unknown"`
+}
+
 // WebhookEventResponse Response after processing a webhook event
 type WebhookEventResponse struct {
 	// AffectedComponents List of components affected by this webhook
@@ -4123,8 +6177,9 @@ type Workflow struct {
 type WorkflowList struct {
 	Items []Workflow `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -4146,12 +6201,37 @@ type WorkflowPlane struct {
 	Status *WorkflowPlaneStatus `json:"status,omitempty"`
 }
 
+// WorkflowPlaneCapacity Workflow load and node headroom observed by the periodic health probe
+type WorkflowPlaneCapacity struct {
+	// AvailableCPU Allocatable CPU on ready nodes not yet requested by pods
+	AvailableCPU *string `json:"availableCPU,omitempty"`
+
+	// AvailableMemory Allocatable memory on ready nodes not yet requested by pods
+	AvailableMemory *string `json:"availableMemory,omitempty"`
+
+	// LastProbeTime When the capacity was last observed
+	LastProbeTime time.Time `json:"lastProbeTime"`
+
+	// OldestPendingSince Creation time of the longest waiting pending workflow
+	OldestPendingSince *time.Time `json:"oldestPendingSince,omitempty"`
+
+	// PendingWorkflows Number of workflows that have not started yet
+	PendingWorkflows int32 `json:"pendingWorkflows"`
+
+	// ReadyNodes Number of ready, schedulable nodes
+	ReadyNodes int32 `json:"readyNodes"`
+
+	// RunningWorkflows Number of workflows that are currently running
+	RunningWorkflows int32 `json:"runningWorkflows"`
+}
+
 // WorkflowPlaneList Paginated list of workflow planes
 type WorkflowPlaneList struct {
 	Items []WorkflowPlane `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -4169,6 +6249,9 @@ type WorkflowPlaneRefKind string
 
 // WorkflowPlaneSpec Desired state of a WorkflowPlane
 type WorkflowPlaneSpec struct {
+	// BuildCache Where builds running on a workflow plane keep their layer cache
+	BuildCache *BuildCacheConfig `json:"buildCache,omitempty"`
+
 	// ClusterAgent Configuration for cluster agent-based communication
 	ClusterAgent *ClusterAgentConfig `json:"clusterAgent,omitempty"`
 
@@ -4188,7 +6271,13 @@ type WorkflowPlaneStatus struct {
 	// AgentConnection Status of cluster agent connections
 	AgentConnection *AgentConnectionStatus `json:"agentConnection,omitempty"`
 
-	// Conditions Current state conditions of the WorkflowPlane
+	// Capacity Workflow load and node headroom observed by the periodic health probe
+	Capacity *WorkflowPlaneCapacity `json:"capacity,omitempty"`
+
+	// Conditions Current state conditions of the WorkflowPlane.
+	// The Healthy condition turns False when no agent is connected, the plane
+	// cannot be probed, no node is schedulable, or workflows have been pending
+	// for more than five minutes.
 	Conditions *[]Condition `json:"conditions,omitempty"`
 
 	// ObservedGeneration Generation of the most recently observed WorkflowPlane
@@ -4258,8 +6347,9 @@ type WorkflowRunEventEntry struct {
 type WorkflowRunList struct {
 	Items []WorkflowRun `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -4272,8 +6362,32 @@ type WorkflowRunLogEntry struct {
 	Timestamp *time.Time `json:"timestamp,omitempty"`
 }
 
+// WorkflowRunSBOMResponse Location of the software bill of materials of the image built by a workflow run
+type WorkflowRunSBOMResponse struct {
+	// Format Format of the SBOM document, if reported
+	Format *WorkflowRunSBOMResponseFormat `json:"format,omitempty"`
+
+	// Image Container image the SBOM describes
+	Image *string `json:"image,omitempty"`
+
+	// Location Where the SBOM is stored, typically an OCI artifact reference
+	Location string `json:"location"`
+
+	// RecordedAt When the reference was recorded
+	RecordedAt *time.Time `json:"recordedAt,omitempty"`
+
+	// RunName Workflow run that recorded the SBOM
+	RunName string `json:"runName"`
+}
+
+// WorkflowRunSBOMResponseFormat Format of the SBOM document, if reported
+type WorkflowRunSBOMResponseFormat string
+
 // WorkflowRunSpec Desired state of a WorkflowRun
 type WorkflowRunSpec struct {
+	// DisableBuildCache Run the build without the build cache of the workflow plane
+	DisableBuildCache *bool `json:"disableBuildCache,omitempty"`
+
 	// TtlAfterCompletion Time-to-live for this workflow run after completion (duration string like 10d1h30m).
 	TtlAfterCompletion *string `json:"ttlAfterCompletion,omitempty"`
 
@@ -4283,11 +6397,16 @@ type WorkflowRunSpec struct {
 
 // WorkflowRunStatus Observed state of a WorkflowRun
 type WorkflowRunStatus struct {
-	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	// Cache Build cache lookups of a workflow run, as reported by its steps
+	Cache       *BuildCacheStatistics `json:"cache,omitempty"`
+	CompletedAt *time.Time            `json:"completedAt,omitempty"`
 
 	// Conditions Kubernetes-style conditions
-	Conditions *[]Condition         `json:"conditions,omitempty"`
-	Resources  *[]ResourceReference `json:"resources,omitempty"`
+	Conditions *[]Condition `json:"conditions,omitempty"`
+
+	// Image Container image produced by the run, reported by its workflow steps
+	Image     *string              `json:"image,omitempty"`
+	Resources *[]ResourceReference `json:"resources,omitempty"`
 
 	// RunReference Reference to a Kubernetes resource applied during a workflow run
 	RunReference *ResourceReference `json:"runReference,omitempty"`
@@ -4297,9 +6416,15 @@ type WorkflowRunStatus struct {
 
 // WorkflowRunStatusResponse Status of a workflow run including per-step details
 type WorkflowRunStatusResponse struct {
+	// Cache Build cache lookups of a workflow run, as reported by its steps
+	Cache *BuildCacheStatistics `json:"cache,omitempty"`
+
 	// HasLiveObservability Whether live logs/events are available from the workflow plane
 	HasLiveObservability bool `json:"hasLiveObservability"`
 
+	// Image Container image produced by the run, if it has been reported
+	Image *string `json:"image,omitempty"`
+
 	// Status Overall workflow run status
 	Status WorkflowRunStatusResponseStatus `json:"status"`
 
@@ -4310,6 +6435,30 @@ type WorkflowRunStatusResponse struct {
 // WorkflowRunStatusResponseStatus Overall workflow run status
 type WorkflowRunStatusResponseStatus string
 
+// WorkflowRunVulnerabilitiesResponse Vulnerability scan result of the image built by a workflow run
+type WorkflowRunVulnerabilitiesResponse struct {
+	// Image Container image that was scanned
+	Image *string `json:"image,omitempty"`
+
+	// ScannedAt When the report was ingested
+	ScannedAt *time.Time `json:"scannedAt,omitempty"`
+
+	// Scanner Tool that produced the report
+	Scanner WorkflowRunVulnerabilitiesResponseScanner `json:"scanner"`
+
+	// Summary Vulnerability findings counted by severity
+	Summary VulnerabilitySummary `json:"summary"`
+
+	// Truncated Whether the scan result omits findings beyond its listing limit
+	Truncated bool `json:"truncated"`
+
+	// Vulnerabilities Findings, most severe first
+	Vulnerabilities []Vulnerability `json:"vulnerabilities"`
+}
+
+// WorkflowRunVulnerabilitiesResponseScanner Tool that produced the report
+type WorkflowRunVulnerabilitiesResponseScanner string
+
 // WorkflowSpec Desired state of a Workflow
 type WorkflowSpec struct {
 	// ExternalRefs External CR references resolved and injected into the CEL context under their id.
@@ -4384,6 +6533,34 @@ type Workload struct {
 	Status *WorkloadStatus `json:"status,omitempty"`
 }
 
+// WorkloadAutoscaling Horizontal autoscaling of the workload, bounded by the autoscaling limits of its component type.
+// Resource targets are rendered as a HorizontalPodAutoscaler; event-driven triggers as a KEDA ScaledObject.
+type WorkloadAutoscaling struct {
+	// MaxReplicas Upper bound of the replica count
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// MinReplicas Lower bound of the replica count. Defaults to 1; 0 requires event-driven triggers.
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// TargetCPUUtilizationPercentage Average CPU utilization target, relative to the container's CPU request
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+
+	// TargetMemoryUtilizationPercentage Average memory utilization target, relative to the container's memory request
+	TargetMemoryUtilizationPercentage *int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+
+	// Triggers KEDA event-driven scaling triggers
+	Triggers *[]struct {
+		// AuthenticationRef Name of a KEDA TriggerAuthentication in the workload's namespace
+		AuthenticationRef *string `json:"authenticationRef,omitempty"`
+
+		// Metadata Scaler-specific configuration
+		Metadata *map[string]string `json:"metadata,omitempty"`
+
+		// Type KEDA scaler type
+		Type string `json:"type"`
+	} `json:"triggers,omitempty"`
+}
+
 // WorkloadConnection A connection to another component's endpoint
 type WorkloadConnection struct {
 	// Component Target component name
@@ -4423,6 +6600,29 @@ type WorkloadContainer struct {
 	Image string `json:"image"`
 }
 
+// WorkloadCostEstimate defines model for WorkloadCostEstimate.
+type WorkloadCostEstimate struct {
+	CpuCost     float64 `json:"cpuCost"`
+	MemoryCost  float64 `json:"memoryCost"`
+	MonthlyCost float64 `json:"monthlyCost"`
+	Name        string  `json:"name"`
+	StorageCost float64 `json:"storageCost"`
+}
+
+// WorkloadCostSpec A proposed workload. Quantities are per replica.
+type WorkloadCostSpec struct {
+	// Cpu CPU requested by a replica, as a Kubernetes quantity
+	Cpu *string `json:"cpu,omitempty"`
+
+	// Memory Memory requested by a replica, as a Kubernetes quantity
+	Memory   *string `json:"memory,omitempty"`
+	Name     string  `json:"name"`
+	Replicas int32   `json:"replicas"`
+
+	// Storage Size of the persistent volumes of a replica, as a Kubernetes quantity
+	Storage *string `json:"storage,omitempty"`
+}
+
 // WorkloadEndpoint Network endpoint specification
 type WorkloadEndpoint struct {
 	// BasePath Base path of the API exposed via the endpoint
@@ -4456,12 +6656,25 @@ type WorkloadEndpointType string
 // WorkloadEndpointVisibility defines model for WorkloadEndpoint.Visibility.
 type WorkloadEndpointVisibility string
 
+// WorkloadExternalConnection A connection to a service running outside OpenChoreo
+type WorkloadExternalConnection struct {
+	// Address Address of the external service, as a URL (scheme://host[:port][/path]) or host:port
+	Address string `json:"address"`
+
+	// EnvBindings Maps resolved connection address components to environment variable names
+	EnvBindings ConnectionEnvBindings `json:"envBindings"`
+
+	// Name Name identifying the external service within the workload
+	Name string `json:"name"`
+}
+
 // WorkloadList Paginated list of workloads
 type WorkloadList struct {
 	Items []Workload `json:"items"`
 
-	// Pagination Cursor-based pagination metadata. Uses Kubernetes-native continuation tokens
-	// for efficient pagination through large result sets.
+	// Pagination List metadata shared by all list responses: cursor-based pagination
+	// (Kubernetes-native continuation tokens for unsorted lists), item counts,
+	// the applied sort and the applied filters.
 	Pagination Pagination `json:"pagination"`
 }
 
@@ -4491,14 +6704,21 @@ type WorkloadResourceDependency struct {
 
 // WorkloadSpec Desired state of a Workload
 type WorkloadSpec struct {
+	// Autoscaling Horizontal autoscaling of the workload, bounded by the autoscaling limits of its component type.
+	// Resource targets are rendered as a HorizontalPodAutoscaler; event-driven triggers as a KEDA ScaledObject.
+	Autoscaling *WorkloadAutoscaling `json:"autoscaling,omitempty"`
+
 	// Container Container specification
 	Container *WorkloadContainer `json:"container,omitempty"`
 
-	// Dependencies Dependencies on other components' endpoints and on Resources
+	// Dependencies Dependencies on other components' endpoints, on Resources and on external services
 	Dependencies *struct {
 		// Endpoints Endpoint connections to other components
 		Endpoints *[]WorkloadConnection `json:"endpoints,omitempty"`
 
+		// External Connections to services running outside OpenChoreo
+		External *[]WorkloadExternalConnection `json:"external,omitempty"`
+
 		// Resources Resource dependencies. Each entry references a Resource by name and wires named
 		// outputs of the resolved ResourceReleaseBinding into the container as env vars
 		// (envBindings) and file mounts (fileBindings).
@@ -4554,9 +6774,15 @@ type ComponentReleaseNameParam = string
 // ComponentTypeNameParam defines model for ComponentTypeNameParam.
 type ComponentTypeNameParam = string
 
+// ConnectionNameParam defines model for ConnectionNameParam.
+type ConnectionNameParam = string
+
 // CursorParam defines model for CursorParam.
 type CursorParam = string
 
+// CustomDomainNameParam defines model for CustomDomainNameParam.
+type CustomDomainNameParam = string
+
 // DataPlaneNameParam defines model for DataPlaneNameParam.
 type DataPlaneNameParam = string
 
@@ -4569,6 +6795,9 @@ type EnvironmentNameParam = string
 // EnvironmentQueryParam defines model for EnvironmentQueryParam.
 type EnvironmentQueryParam = string
 
+// FieldsParam defines model for FieldsParam.
+type FieldsParam = string
+
 // GitSecretNameParam defines model for GitSecretNameParam.
 type GitSecretNameParam = string
 
@@ -4584,6 +6813,9 @@ type MappingIdParam = int64
 // NamespaceNameParam defines model for NamespaceNameParam.
 type NamespaceNameParam = string
 
+// NetworkPolicyRuleNameParam defines model for NetworkPolicyRuleNameParam.
+type NetworkPolicyRuleNameParam = string
+
 // ObservabilityAlertsNotificationChannelNameParam defines model for ObservabilityAlertsNotificationChannelNameParam.
 type ObservabilityAlertsNotificationChannelNameParam = string
 
@@ -4605,6 +6837,12 @@ type ProjectReleaseNameParam = string
 // ProjectTypeNameParam defines model for ProjectTypeNameParam.
 type ProjectTypeNameParam = string
 
+// PromotionRequestNameParam defines model for PromotionRequestNameParam.
+type PromotionRequestNameParam = string
+
+// RegistryCredentialNameParam defines model for RegistryCredentialNameParam.
+type RegistryCredentialNameParam = string
+
 // ReleaseBindingNameParam defines model for ReleaseBindingNameParam.
 type ReleaseBindingNameParam = string
 
@@ -4626,12 +6864,18 @@ type ResourceTypeNameParam = string
 // RoleNameParam defines model for RoleNameParam.
 type RoleNameParam = string
 
+// SandboxNameParam defines model for SandboxNameParam.
+type SandboxNameParam = string
+
 // SecretNameParam defines model for SecretNameParam.
 type SecretNameParam = string
 
 // SecretReferenceNameParam defines model for SecretReferenceNameParam.
 type SecretReferenceNameParam = string
 
+// SortParam defines model for SortParam.
+type SortParam = string
+
 // TraitNameParam defines model for TraitNameParam.
 type TraitNameParam = string
 
@@ -4650,30 +6894,72 @@ type WorkflowRunNameParam = string
 // WorkloadNameParam defines model for WorkloadNameParam.
 type WorkloadNameParam = string
 
-// BadRequest Standard error response format
+// BadRequest Problem details (RFC 7807) of a failed request, served as `application/problem+json`.
+// Besides the standard members it carries the OpenChoreo extensions `code`, `kind`,
+// `name` and `fieldErrors`, and repeats `detail` as `error` for clients of the earlier
+// error format. MCP tool errors carry the same members.
 type BadRequest = ErrorResponse
 
-// Conflict Standard error response format
+// Conflict Problem details (RFC 7807) of a failed request, served as `application/problem+json`.
+// Besides the standard members it carries the OpenChoreo extensions `code`, `kind`,
+// `name` and `fieldErrors`, and repeats `detail` as `error` for clients of the earlier
+// error format. MCP tool errors carry the same members.
 type Conflict = ErrorResponse
 
-// Forbidden Standard error response format
+// Forbidden Problem details (RFC 7807) of a failed request, served as `application/problem+json`.
+// Besides the standard members it carries the OpenChoreo extensions `code`, `kind`,
+// `name` and `fieldErrors`, and repeats `detail` as `error` for clients of the earlier
+// error format. MCP tool errors carry the same members.
 type Forbidden = ErrorResponse
 
-// InternalError Standard error response format
+// InternalError Problem details (RFC 7807) of a failed request, served as `application/problem+json`.
+// Besides the standard members it carries the OpenChoreo extensions `code`, `kind`,
+// `name` and `fieldErrors`, and repeats `detail` as `error` for clients of the earlier
+// error format. MCP tool errors carry the same members.
 type InternalError = ErrorResponse
 
-// NotFound Standard error response format
+// NotFound Problem details (RFC 7807) of a failed request, served as `application/problem+json`.
+// Besides the standard members it carries the OpenChoreo extensions `code`, `kind`,
+// `name` and `fieldErrors`, and repeats `detail` as `error` for clients of the earlier
+// error format. MCP tool errors carry the same members.
 type NotFound = ErrorResponse
 
-// NotImplemented Standard error response format
+// NotImplemented Problem details (RFC 7807) of a failed request, served as `application/problem+json`.
+// Besides the standard members it carries the OpenChoreo extensions `code`, `kind`,
+// `name` and `fieldErrors`, and repeats `detail` as `error` for clients of the earlier
+// error format. MCP tool errors carry the same members.
 type NotImplemented = ErrorResponse
 
-// Unauthorized Standard error response format
+// Unauthorized Problem details (RFC 7807) of a failed request, served as `application/problem+json`.
+// Besides the standard members it carries the OpenChoreo extensions `code`, `kind`,
+// `name` and `fieldErrors`, and repeats `detail` as `error` for clients of the earlier
+// error format. MCP tool errors carry the same members.
 type Unauthorized = ErrorResponse
 
-// UnprocessableContent Standard error response format
+// UnprocessableContent Problem details (RFC 7807) of a failed request, served as `application/problem+json`.
+// Besides the standard members it carries the OpenChoreo extensions `code`, `kind`,
+// `name` and `fieldErrors`, and repeats `detail` as `error` for clients of the earlier
+// error format. MCP tool errors carry the same members.
 type UnprocessableContent = ErrorResponse
 
+// ListAuditEventsParams defines parameters for ListAuditEvents.
+type ListAuditEventsParams struct {
+	// Actor Only return events performed by this actor ID
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// ResourceType Only return events targeting resources of this type
+	ResourceType *string `form:"resourceType,omitempty" json:"resourceType,omitempty"`
+
+	// ResourceName Only return events targeting resources with this name
+	ResourceName *string `form:"resourceName,omitempty" json:"resourceName,omitempty"`
+
+	// Namespace Only return events targeting resources in this namespace
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// Limit Maximum number of events to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // EvaluatesJSONBody defines parameters for Evaluates.
 type EvaluatesJSONBody = []EvaluateRequest
 
@@ -4707,6 +6993,34 @@ type ListClusterRoleBindingsParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetClusterRoleBindingParams defines parameters for GetClusterRoleBinding.
+type GetClusterRoleBindingParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListClusterRolesParams defines parameters for ListClusterRoles.
@@ -4724,6 +7038,34 @@ type ListClusterRolesParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetClusterRoleParams defines parameters for GetClusterRole.
+type GetClusterRoleParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListClusterComponentTypesParams defines parameters for ListClusterComponentTypes.
@@ -4741,6 +7083,34 @@ type ListClusterComponentTypesParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetClusterComponentTypeParams defines parameters for GetClusterComponentType.
+type GetClusterComponentTypeParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListClusterDataPlanesParams defines parameters for ListClusterDataPlanes.
@@ -4758,6 +7128,34 @@ type ListClusterDataPlanesParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetClusterDataPlaneParams defines parameters for GetClusterDataPlane.
+type GetClusterDataPlaneParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListClusterObservabilityPlanesParams defines parameters for ListClusterObservabilityPlanes.
@@ -4775,6 +7173,34 @@ type ListClusterObservabilityPlanesParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetClusterObservabilityPlaneParams defines parameters for GetClusterObservabilityPlane.
+type GetClusterObservabilityPlaneParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListClusterProjectTypesParams defines parameters for ListClusterProjectTypes.
@@ -4792,6 +7218,34 @@ type ListClusterProjectTypesParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetClusterProjectTypeParams defines parameters for GetClusterProjectType.
+type GetClusterProjectTypeParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListClusterResourceTypesParams defines parameters for ListClusterResourceTypes.
@@ -4809,6 +7263,34 @@ type ListClusterResourceTypesParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetClusterResourceTypeParams defines parameters for GetClusterResourceType.
+type GetClusterResourceTypeParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListClusterTraitsParams defines parameters for ListClusterTraits.
@@ -4826,6 +7308,34 @@ type ListClusterTraitsParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetClusterTraitParams defines parameters for GetClusterTrait.
+type GetClusterTraitParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListClusterWorkflowPlanesParams defines parameters for ListClusterWorkflowPlanes.
@@ -4843,6 +7353,34 @@ type ListClusterWorkflowPlanesParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetClusterWorkflowPlaneParams defines parameters for GetClusterWorkflowPlane.
+type GetClusterWorkflowPlaneParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListClusterWorkflowsParams defines parameters for ListClusterWorkflows.
@@ -4860,6 +7398,34 @@ type ListClusterWorkflowsParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetClusterWorkflowParams defines parameters for GetClusterWorkflow.
+type GetClusterWorkflowParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListNamespacesParams defines parameters for ListNamespaces.
@@ -4877,6 +7443,34 @@ type ListNamespacesParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetNamespaceParams defines parameters for GetNamespace.
+type GetNamespaceParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListNamespaceRoleBindingsParams defines parameters for ListNamespaceRoleBindings.
@@ -4894,6 +7488,34 @@ type ListNamespaceRoleBindingsParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetNamespaceRoleBindingParams defines parameters for GetNamespaceRoleBinding.
+type GetNamespaceRoleBindingParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListNamespaceRolesParams defines parameters for ListNamespaceRoles.
@@ -4911,6 +7533,34 @@ type ListNamespaceRolesParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetNamespaceRoleParams defines parameters for GetNamespaceRole.
+type GetNamespaceRoleParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListComponentReleasesParams defines parameters for ListComponentReleases.
@@ -4931,6 +7581,34 @@ type ListComponentReleasesParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetComponentReleaseParams defines parameters for GetComponentRelease.
+type GetComponentReleaseParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListComponentsParams defines parameters for ListComponents.
@@ -4951,6 +7629,40 @@ type ListComponentsParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetComponentParams defines parameters for GetComponent.
+type GetComponentParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetReleaseHistoryParams defines parameters for GetReleaseHistory.
+type GetReleaseHistoryParams struct {
+	// Environment Filter resources by environment name
+	Environment *EnvironmentQueryParam `form:"environment,omitempty" json:"environment,omitempty"`
 }
 
 // ListComponentTypesParams defines parameters for ListComponentTypes.
@@ -4968,6 +7680,81 @@ type ListComponentTypesParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetComponentTypeParams defines parameters for GetComponentType.
+type GetComponentTypeParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// ListConnectionsParams defines parameters for ListConnections.
+type ListConnectionsParams struct {
+	// Component Filter resources by component name
+	Component *ComponentQueryParam `form:"component,omitempty" json:"component,omitempty"`
+
+	// Limit Maximum number of items to return per page
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque pagination cursor from a previous response.
+	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetCostReportParams defines parameters for GetCostReport.
+type GetCostReportParams struct {
+	// Project Only report the costs of this project
+	Project *string `form:"project,omitempty" json:"project,omitempty"`
+
+	// Component Only report the costs of this component. Requires project.
+	Component *string `form:"component,omitempty" json:"component,omitempty"`
+
+	// Environment Only report the costs in this environment
+	Environment *string `form:"environment,omitempty" json:"environment,omitempty"`
+
+	// Window Window of the report: a duration such as 24h or 7d, or one of today, yesterday, week, month, lastweek or lastmonth. Defaults to 7d.
+	Window *string `form:"window,omitempty" json:"window,omitempty"`
+
+	// Aggregate Dimension to break the costs down by. Omit for a single item.
+	Aggregate *GetCostReportParamsAggregate `form:"aggregate,omitempty" json:"aggregate,omitempty"`
+}
+
+// GetCostReportParamsAggregate defines parameters for GetCostReport.
+type GetCostReportParamsAggregate string
+
+// ListCustomDomainsParams defines parameters for ListCustomDomains.
+type ListCustomDomainsParams struct {
+	// Component Filter resources by component name
+	Component *ComponentQueryParam `form:"component,omitempty" json:"component,omitempty"`
+
+	// Limit Maximum number of items to return per page
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque pagination cursor from a previous response.
+	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
 // ListDataPlanesParams defines parameters for ListDataPlanes.
@@ -4985,6 +7772,43 @@ type ListDataPlanesParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetDataPlaneParams defines parameters for GetDataPlane.
+type GetDataPlaneParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetDependencyGraphParams defines parameters for GetDependencyGraph.
+type GetDependencyGraphParams struct {
+	// Project Only report nodes and edges touching this project
+	Project *string `form:"project,omitempty" json:"project,omitempty"`
+
+	// Component Focus the graph on this component
+	Component *string `form:"component,omitempty" json:"component,omitempty"`
 }
 
 // ListDeploymentPipelinesParams defines parameters for ListDeploymentPipelines.
@@ -5002,6 +7826,46 @@ type ListDeploymentPipelinesParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetDeploymentPipelineParams defines parameters for GetDeploymentPipeline.
+type GetDeploymentPipelineParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// ListEndpointsParams defines parameters for ListEndpoints.
+type ListEndpointsParams struct {
+	// Project Filter resources by project name
+	Project *ProjectQueryParam `form:"project,omitempty" json:"project,omitempty"`
+
+	// Component Filter resources by component name
+	Component *ComponentQueryParam `form:"component,omitempty" json:"component,omitempty"`
+
+	// Environment Filter resources by environment name
+	Environment *EnvironmentQueryParam `form:"environment,omitempty" json:"environment,omitempty"`
 }
 
 // ListEnvironmentsParams defines parameters for ListEnvironments.
@@ -5019,6 +7883,44 @@ type ListEnvironmentsParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetEnvironmentParams defines parameters for GetEnvironment.
+type GetEnvironmentParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// ListNetworkPolicyRulesParams defines parameters for ListNetworkPolicyRules.
+type ListNetworkPolicyRulesParams struct {
+	// Limit Maximum number of items to return per page
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque pagination cursor from a previous response.
+	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
 // ListObservabilityAlertsNotificationChannelsParams defines parameters for ListObservabilityAlertsNotificationChannels.
@@ -5036,6 +7938,34 @@ type ListObservabilityAlertsNotificationChannelsParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetObservabilityAlertsNotificationChannelParams defines parameters for GetObservabilityAlertsNotificationChannel.
+type GetObservabilityAlertsNotificationChannelParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListObservabilityPlanesParams defines parameters for ListObservabilityPlanes.
@@ -5053,6 +7983,34 @@ type ListObservabilityPlanesParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetObservabilityPlaneParams defines parameters for GetObservabilityPlane.
+type GetObservabilityPlaneParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListProjectReleaseBindingsParams defines parameters for ListProjectReleaseBindings.
@@ -5073,6 +8031,34 @@ type ListProjectReleaseBindingsParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetProjectReleaseBindingParams defines parameters for GetProjectReleaseBinding.
+type GetProjectReleaseBindingParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListProjectReleasesParams defines parameters for ListProjectReleases.
@@ -5093,6 +8079,34 @@ type ListProjectReleasesParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetProjectReleaseParams defines parameters for GetProjectRelease.
+type GetProjectReleaseParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListProjectsParams defines parameters for ListProjects.
@@ -5110,6 +8124,71 @@ type ListProjectsParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetProjectParams defines parameters for GetProject.
+type GetProjectParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetProjectNetworkPoliciesParams defines parameters for GetProjectNetworkPolicies.
+type GetProjectNetworkPoliciesParams struct {
+	// Environment Environment to report the network policies of
+	Environment string `form:"environment" json:"environment"`
+}
+
+// GetProjectNetworkAllowMatrixParams defines parameters for GetProjectNetworkAllowMatrix.
+type GetProjectNetworkAllowMatrixParams struct {
+	// Environment Environment to evaluate the network policies in
+	Environment string `form:"environment" json:"environment"`
+
+	// SourceProject Only report callers of this project
+	SourceProject *string `form:"sourceProject,omitempty" json:"sourceProject,omitempty"`
+
+	// SourceComponent Only report callers with this component name
+	SourceComponent *string `form:"sourceComponent,omitempty" json:"sourceComponent,omitempty"`
+
+	// DestinationComponent Only report the endpoints of this component of the project
+	DestinationComponent *string `form:"destinationComponent,omitempty" json:"destinationComponent,omitempty"`
+
+	// Endpoint Only report endpoints with this name
+	Endpoint *string `form:"endpoint,omitempty" json:"endpoint,omitempty"`
+
+	// Allowed Only report allowed (true) or denied (false) entries
+	Allowed *bool `form:"allowed,omitempty" json:"allowed,omitempty"`
+}
+
+// ListSandboxesParams defines parameters for ListSandboxes.
+type ListSandboxesParams struct {
+	// Limit Maximum number of items to return per page
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque pagination cursor from a previous response.
+	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
 // ListProjectTypesParams defines parameters for ListProjectTypes.
@@ -5127,6 +8206,47 @@ type ListProjectTypesParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetProjectTypeParams defines parameters for GetProjectType.
+type GetProjectTypeParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// ListPromotionRequestsParams defines parameters for ListPromotionRequests.
+type ListPromotionRequestsParams struct {
+	// Component Filter resources by component name
+	Component *ComponentQueryParam `form:"component,omitempty" json:"component,omitempty"`
+
+	// Limit Maximum number of items to return per page
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque pagination cursor from a previous response.
+	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
 // ListReleaseBindingsParams defines parameters for ListReleaseBindings.
@@ -5147,6 +8267,34 @@ type ListReleaseBindingsParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetReleaseBindingParams defines parameters for GetReleaseBinding.
+type GetReleaseBindingParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // GetReleaseBindingK8sResourceEventsParams defines parameters for GetReleaseBindingK8sResourceEvents.
@@ -5191,6 +8339,34 @@ type ListResourceReleaseBindingsParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetResourceReleaseBindingParams defines parameters for GetResourceReleaseBinding.
+type GetResourceReleaseBindingParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListResourceReleasesParams defines parameters for ListResourceReleases.
@@ -5211,6 +8387,34 @@ type ListResourceReleasesParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetResourceReleaseParams defines parameters for GetResourceRelease.
+type GetResourceReleaseParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListResourcesParams defines parameters for ListResources.
@@ -5231,6 +8435,43 @@ type ListResourcesParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetResourceParams defines parameters for GetResource.
+type GetResourceParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// PatchResourceApplicationMergePatchPlusJSONBody defines parameters for PatchResource.
+type PatchResourceApplicationMergePatchPlusJSONBody map[string]interface{}
+
+// ListResourceK8sResourcesParams defines parameters for ListResourceK8sResources.
+type ListResourceK8sResourcesParams struct {
+	// Cluster Only return objects on the DataPlane or ClusterDataPlane with this name
+	Cluster *string `form:"cluster,omitempty" json:"cluster,omitempty"`
 }
 
 // ListResourceTypesParams defines parameters for ListResourceTypes.
@@ -5248,6 +8489,34 @@ type ListResourceTypesParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetResourceTypeParams defines parameters for GetResourceType.
+type GetResourceTypeParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListSecretReferencesParams defines parameters for ListSecretReferences.
@@ -5265,6 +8534,34 @@ type ListSecretReferencesParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetSecretReferenceParams defines parameters for GetSecretReference.
+type GetSecretReferenceParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListTraitsParams defines parameters for ListTraits.
@@ -5282,6 +8579,34 @@ type ListTraitsParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetTraitParams defines parameters for GetTrait.
+type GetTraitParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListWorkflowPlanesParams defines parameters for ListWorkflowPlanes.
@@ -5299,6 +8624,34 @@ type ListWorkflowPlanesParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetWorkflowPlaneParams defines parameters for GetWorkflowPlane.
+type GetWorkflowPlaneParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListWorkflowRunsParams defines parameters for ListWorkflowRuns.
@@ -5319,6 +8672,34 @@ type ListWorkflowRunsParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetWorkflowRunParams defines parameters for GetWorkflowRun.
+type GetWorkflowRunParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // GetWorkflowRunEventsParams defines parameters for GetWorkflowRunEvents.
@@ -5336,6 +8717,15 @@ type GetWorkflowRunLogsParams struct {
 	SinceSeconds *int64 `form:"sinceSeconds,omitempty" json:"sinceSeconds,omitempty"`
 }
 
+// GetWorkflowRunVulnerabilitiesParams defines parameters for GetWorkflowRunVulnerabilities.
+type GetWorkflowRunVulnerabilitiesParams struct {
+	// Severity List only findings of this severity
+	Severity *GetWorkflowRunVulnerabilitiesParamsSeverity `form:"severity,omitempty" json:"severity,omitempty"`
+}
+
+// GetWorkflowRunVulnerabilitiesParamsSeverity defines parameters for GetWorkflowRunVulnerabilities.
+type GetWorkflowRunVulnerabilitiesParamsSeverity string
+
 // ListWorkflowsParams defines parameters for ListWorkflows.
 type ListWorkflowsParams struct {
 	// LabelSelector A label selector to filter resources using Kubernetes label selector syntax.
@@ -5351,6 +8741,34 @@ type ListWorkflowsParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetWorkflowParams defines parameters for GetWorkflow.
+type GetWorkflowParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // ListWorkloadsParams defines parameters for ListWorkloads.
@@ -5371,6 +8789,34 @@ type ListWorkloadsParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetWorkloadParams defines parameters for GetWorkload.
+type GetWorkloadParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // HandleAutoBuildJSONBody defines parameters for HandleAutoBuild.
@@ -5386,6 +8832,12 @@ type HandleAutoBuildParams struct {
 
 	// XEventKey Bitbucket webhook event-key header used to detect Bitbucket events.
 	XEventKey *string `json:"X-Event-Key,omitempty"`
+
+	// XGitHubEvent GitHub event type header used to detect pull request events.
+	XGitHubEvent *string `json:"X-GitHub-Event,omitempty"`
+
+	// XGitlabEvent GitLab event type header used to detect merge request events.
+	XGitlabEvent *string `json:"X-Gitlab-Event,omitempty"`
 }
 
 // ListSecretsParams defines parameters for ListSecrets.
@@ -5396,7 +8848,38 @@ type ListSecretsParams struct {
 	// Cursor Opaque pagination cursor from a previous response.
 	// Pass the `nextCursor` value from pagination metadata to fetch the next page.
 	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
-}
+
+	// Sort Sort the list by `name`, `creationTimestamp` or `status`. Prefix the
+	// field with `-` for descending order. Items that compare equal are
+	// ordered by namespace and name. `status` orders by the resource's
+	// phase, or by the reason of its Ready condition when it has no phase.
+	// Without this parameter items are returned in the server's natural
+	// order. A cursor returned for a sorted list is only valid with the same
+	// sort.
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetSecretParams defines parameters for GetSecret.
+type GetSecretParams struct {
+	// Fields Return only these fields of the response, as a comma-separated list of
+	// paths such as `metadata.name,status.conditions`. Keys containing dots
+	// are written in brackets, as in
+	// `metadata.annotations['openchoreo.dev/display-name']`. A path applies
+	// to every element of the arrays it crosses. List responses are reduced
+	// item by item and keep their pagination.
+	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// CreateAPITokenJSONRequestBody defines body for CreateAPIToken for application/json ContentType.
+type CreateAPITokenJSONRequestBody = CreateAPITokenRequest
 
 // EvaluatesJSONRequestBody defines body for Evaluates for application/json ContentType.
 type EvaluatesJSONRequestBody = EvaluatesJSONBody
@@ -5479,6 +8962,12 @@ type CreateNamespaceRoleJSONRequestBody = AuthzRole
 // UpdateNamespaceRoleJSONRequestBody defines body for UpdateNamespaceRole for application/json ContentType.
 type UpdateNamespaceRoleJSONRequestBody = AuthzRole
 
+// DiscoverComponentsJSONRequestBody defines body for DiscoverComponents for application/json ContentType.
+type DiscoverComponentsJSONRequestBody = DiscoverComponentsRequest
+
+// AcceptComponentProposalsJSONRequestBody defines body for AcceptComponentProposals for application/json ContentType.
+type AcceptComponentProposalsJSONRequestBody = AcceptComponentProposalsRequest
+
 // CreateComponentReleaseJSONRequestBody defines body for CreateComponentRelease for application/json ContentType.
 type CreateComponentReleaseJSONRequestBody = ComponentRelease
 
@@ -5488,21 +8977,48 @@ type CreateComponentJSONRequestBody = Component
 // UpdateComponentJSONRequestBody defines body for UpdateComponent for application/json ContentType.
 type UpdateComponentJSONRequestBody = Component
 
+// TriggerComponentBuildJSONRequestBody defines body for TriggerComponentBuild for application/json ContentType.
+type TriggerComponentBuildJSONRequestBody = TriggerComponentBuildRequest
+
+// DeployComponentJSONRequestBody defines body for DeployComponent for application/json ContentType.
+type DeployComponentJSONRequestBody = DeployComponentRequest
+
+// UpdateEndpointJSONRequestBody defines body for UpdateEndpoint for application/json ContentType.
+type UpdateEndpointJSONRequestBody = UpdateEndpointRequest
+
 // GenerateReleaseJSONRequestBody defines body for GenerateRelease for application/json ContentType.
 type GenerateReleaseJSONRequestBody = GenerateReleaseRequest
 
+// RollbackComponentJSONRequestBody defines body for RollbackComponent for application/json ContentType.
+type RollbackComponentJSONRequestBody = RollbackComponentRequest
+
+// ControlComponentRolloutJSONRequestBody defines body for ControlComponentRollout for application/json ContentType.
+type ControlComponentRolloutJSONRequestBody = ControlComponentRolloutRequest
+
 // CreateComponentTypeJSONRequestBody defines body for CreateComponentType for application/json ContentType.
 type CreateComponentTypeJSONRequestBody = ComponentType
 
 // UpdateComponentTypeJSONRequestBody defines body for UpdateComponentType for application/json ContentType.
 type UpdateComponentTypeJSONRequestBody = ComponentType
 
+// CreateConnectionJSONRequestBody defines body for CreateConnection for application/json ContentType.
+type CreateConnectionJSONRequestBody = CreateConnectionRequest
+
+// EstimateCostJSONRequestBody defines body for EstimateCost for application/json ContentType.
+type EstimateCostJSONRequestBody = CostEstimateRequest
+
+// CreateCustomDomainJSONRequestBody defines body for CreateCustomDomain for application/json ContentType.
+type CreateCustomDomainJSONRequestBody = CreateCustomDomainRequest
+
 // CreateDataPlaneJSONRequestBody defines body for CreateDataPlane for application/json ContentType.
 type CreateDataPlaneJSONRequestBody = DataPlane
 
 // UpdateDataPlaneJSONRequestBody defines body for UpdateDataPlane for application/json ContentType.
 type UpdateDataPlaneJSONRequestBody = DataPlane
 
+// RegisterDataPlaneJSONRequestBody defines body for RegisterDataPlane for application/json ContentType.
+type RegisterDataPlaneJSONRequestBody = DataPlane
+
 // CreateDeploymentPipelineJSONRequestBody defines body for CreateDeploymentPipeline for application/json ContentType.
 type CreateDeploymentPipelineJSONRequestBody = DeploymentPipeline
 
@@ -5515,6 +9031,12 @@ type CreateEnvironmentJSONRequestBody = Environment
 // UpdateEnvironmentJSONRequestBody defines body for UpdateEnvironment for application/json ContentType.
 type UpdateEnvironmentJSONRequestBody = Environment
 
+// CreateNetworkPolicyRuleJSONRequestBody defines body for CreateNetworkPolicyRule for application/json ContentType.
+type CreateNetworkPolicyRuleJSONRequestBody = NetworkPolicyRule
+
+// UpdateNetworkPolicyRuleJSONRequestBody defines body for UpdateNetworkPolicyRule for application/json ContentType.
+type UpdateNetworkPolicyRuleJSONRequestBody = NetworkPolicyRule
+
 // CreateObservabilityAlertsNotificationChannelJSONRequestBody defines body for CreateObservabilityAlertsNotificationChannel for application/json ContentType.
 type CreateObservabilityAlertsNotificationChannelJSONRequestBody = ObservabilityAlertsNotificationChannel
 
@@ -5542,12 +9064,27 @@ type CreateProjectJSONRequestBody = Project
 // UpdateProjectJSONRequestBody defines body for UpdateProject for application/json ContentType.
 type UpdateProjectJSONRequestBody = Project
 
+// SetProjectQuotaJSONRequestBody defines body for SetProjectQuota for application/json ContentType.
+type SetProjectQuotaJSONRequestBody = ProjectQuota
+
+// CreateSandboxJSONRequestBody defines body for CreateSandbox for application/json ContentType.
+type CreateSandboxJSONRequestBody = CreateSandboxRequest
+
 // CreateProjectTypeJSONRequestBody defines body for CreateProjectType for application/json ContentType.
 type CreateProjectTypeJSONRequestBody = ProjectType
 
 // UpdateProjectTypeJSONRequestBody defines body for UpdateProjectType for application/json ContentType.
 type UpdateProjectTypeJSONRequestBody = ProjectType
 
+// CreatePromotionRequestJSONRequestBody defines body for CreatePromotionRequest for application/json ContentType.
+type CreatePromotionRequestJSONRequestBody = CreatePromotionRequestRequest
+
+// ApprovePromotionRequestJSONRequestBody defines body for ApprovePromotionRequest for application/json ContentType.
+type ApprovePromotionRequestJSONRequestBody = PromotionDecisionRequest
+
+// RejectPromotionRequestJSONRequestBody defines body for RejectPromotionRequest for application/json ContentType.
+type RejectPromotionRequestJSONRequestBody = PromotionDecisionRequest
+
 // CreateReleaseBindingJSONRequestBody defines body for CreateReleaseBinding for application/json ContentType.
 type CreateReleaseBindingJSONRequestBody = ReleaseBinding
 
@@ -5566,9 +9103,18 @@ type CreateResourceReleaseJSONRequestBody = ResourceRelease
 // CreateResourceJSONRequestBody defines body for CreateResource for application/json ContentType.
 type CreateResourceJSONRequestBody = ResourceInstance
 
+// PatchResourceApplicationMergePatchPlusJSONRequestBody defines body for PatchResource for application/merge-patch+json ContentType.
+type PatchResourceApplicationMergePatchPlusJSONRequestBody PatchResourceApplicationMergePatchPlusJSONBody
+
 // UpdateResourceJSONRequestBody defines body for UpdateResource for application/json ContentType.
 type UpdateResourceJSONRequestBody = ResourceInstance
 
+// ApplyResourceJSONRequestBody defines body for ApplyResource for application/json ContentType.
+type ApplyResourceJSONRequestBody = ResourceInstance
+
+// DiffResourceJSONRequestBody defines body for DiffResource for application/json ContentType.
+type DiffResourceJSONRequestBody = ResourceInstance
+
 // CreateResourceTypeJSONRequestBody defines body for CreateResourceType for application/json ContentType.
 type CreateResourceTypeJSONRequestBody = ResourceType
 
@@ -5611,12 +9157,21 @@ type CreateWorkloadJSONRequestBody = Workload
 // UpdateWorkloadJSONRequestBody defines body for UpdateWorkload for application/json ContentType.
 type UpdateWorkloadJSONRequestBody = Workload
 
+// BatchApplyResourcesJSONRequestBody defines body for BatchApplyResources for application/json ContentType.
+type BatchApplyResourcesJSONRequestBody = BatchApplyResourcesRequest
+
+// BatchDeleteResourcesJSONRequestBody defines body for BatchDeleteResources for application/json ContentType.
+type BatchDeleteResourcesJSONRequestBody = BatchDeleteResourcesRequest
+
 // HandleAutoBuildJSONRequestBody defines body for HandleAutoBuild for application/json ContentType.
 type HandleAutoBuildJSONRequestBody HandleAutoBuildJSONBody
 
 // CreateGitSecretJSONRequestBody defines body for CreateGitSecret for application/json ContentType.
 type CreateGitSecretJSONRequestBody = CreateGitSecretRequest
 
+// CreateRegistryCredentialJSONRequestBody defines body for CreateRegistryCredential for application/json ContentType.
+type CreateRegistryCredentialJSONRequestBody = CreateRegistryCredentialRequest
+
 // CreateSecretJSONRequestBody defines body for CreateSecret for application/json ContentType.
 type CreateSecretJSONRequestBody = CreateSecretRequest
 
@@ -0,0 +1,116 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	apitokensvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/apitoken"
+)
+
+const apiTokensDisabledMessage = "API tokens are disabled on this server. Set api_tokens.enabled to enable them."
+
+// ListAPITokens returns the API tokens issued by the calling user.
+func (h *Handler) ListAPITokens(
+	ctx context.Context,
+	_ gen.ListAPITokensRequestObject,
+) (gen.ListAPITokensResponseObject, error) {
+	h.logger.Debug("ListAPITokens called")
+
+	tokens, err := h.services.APITokenService.ListTokens(ctx)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.ListAPITokens403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, apitokensvc.ErrAPITokensDisabled) {
+			return gen.ListAPITokens501JSONResponse{NotImplementedJSONResponse: notImplemented(apiTokensDisabledMessage)}, nil
+		}
+		h.logger.Error("Failed to list API tokens", "error", err)
+		return gen.ListAPITokens500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	items := make([]gen.APIToken, 0, len(tokens))
+	for i := range tokens {
+		items = append(items, toGenAPIToken(&tokens[i]))
+	}
+	return gen.ListAPITokens200JSONResponse{Items: items}, nil
+}
+
+// CreateAPIToken issues an API token for the calling user.
+func (h *Handler) CreateAPIToken(
+	ctx context.Context,
+	request gen.CreateAPITokenRequestObject,
+) (gen.CreateAPITokenResponseObject, error) {
+	h.logger.Debug("CreateAPIToken called")
+
+	if request.Body == nil {
+		return gen.CreateAPIToken400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	req := &apitokensvc.CreateTokenRequest{
+		Name:      request.Body.Name,
+		Namespace: request.Body.Namespace,
+		Project:   getStringValue(request.Body.Project),
+		Access:    string(request.Body.Access),
+	}
+	if request.Body.ExpiresAt != nil {
+		req.ExpiresAt = *request.Body.ExpiresAt
+	}
+
+	token, secret, err := h.services.APITokenService.CreateToken(ctx, req)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.CreateAPIToken403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, apitokensvc.ErrAPITokensDisabled) {
+			return gen.CreateAPIToken501JSONResponse{NotImplementedJSONResponse: notImplemented(apiTokensDisabledMessage)}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.CreateAPIToken400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to create API token", "error", err)
+		return gen.CreateAPIToken500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.CreateAPIToken201JSONResponse{Token: toGenAPIToken(token), Secret: secret}, nil
+}
+
+// RevokeAPIToken revokes an API token issued by the calling user.
+func (h *Handler) RevokeAPIToken(
+	ctx context.Context,
+	request gen.RevokeAPITokenRequestObject,
+) (gen.RevokeAPITokenResponseObject, error) {
+	h.logger.Debug("RevokeAPIToken called", "tokenId", request.TokenId)
+
+	if err := h.services.APITokenService.RevokeToken(ctx, request.TokenId); err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.RevokeAPIToken403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, apitokensvc.ErrAPITokensDisabled) {
+			return gen.RevokeAPIToken501JSONResponse{NotImplementedJSONResponse: notImplemented(apiTokensDisabledMessage)}, nil
+		}
+		if errors.Is(err, apitokensvc.ErrTokenNotFound) {
+			return gen.RevokeAPIToken404JSONResponse{NotFoundJSONResponse: notFound("API token")}, nil
+		}
+		h.logger.Error("Failed to revoke API token", "error", err)
+		return gen.RevokeAPIToken500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.RevokeAPIToken204Response{}, nil
+}
+
+func toGenAPIToken(token *apitokensvc.Token) gen.APIToken {
+	return gen.APIToken{
+		Id:        token.ID,
+		Name:      token.Name,
+		Namespace: token.Namespace,
+		Project:   optionalString(token.Project),
+		Access:    gen.APITokenAccess(token.Access),
+		CreatedAt: token.CreatedAt,
+		ExpiresAt: token.ExpiresAt,
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	apitokensvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/apitoken"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+)
+
+func newHandlerWithAPITokens(t *testing.T, opts *apitokensvc.Options) *Handler {
+	t.Helper()
+	scheme := newTestScheme(t)
+	require.NoError(t, corev1.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	return &Handler{
+		services: &handlerservices.Services{
+			APITokenService: apitokensvc.NewService(fakeClient, opts, slog.Default()),
+		},
+		logger: slog.Default(),
+	}
+}
+
+func TestAPITokenHandlers(t *testing.T) {
+	ctx := testContext()
+	h := newHandlerWithAPITokens(t, &apitokensvc.Options{
+		Namespace:  "openchoreo-control-plane",
+		DefaultTTL: time.Hour,
+		MaxTTL:     24 * time.Hour,
+	})
+
+	createResp, err := h.CreateAPIToken(ctx, gen.CreateAPITokenRequestObject{
+		Body: &gen.CreateAPITokenJSONRequestBody{Name: "ci", Namespace: testResourceNs, Access: gen.Write},
+	})
+	require.NoError(t, err)
+	created, ok := createResp.(gen.CreateAPIToken201JSONResponse)
+	require.True(t, ok, "expected 201 response, got %T", createResp)
+	assert.NotEmpty(t, created.Secret)
+	assert.Equal(t, gen.Write, created.Token.Access)
+	assert.Nil(t, created.Token.Project)
+
+	t.Run("list returns the token without its secret", func(t *testing.T) {
+		resp, err := h.ListAPITokens(ctx, gen.ListAPITokensRequestObject{})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ListAPITokens200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.Len(t, typed.Items, 1)
+		assert.Equal(t, created.Token.Id, typed.Items[0].Id)
+	})
+
+	t.Run("invalid request returns 400", func(t *testing.T) {
+		resp, err := h.CreateAPIToken(ctx, gen.CreateAPITokenRequestObject{
+			Body: &gen.CreateAPITokenJSONRequestBody{Name: "ci", Namespace: testResourceNs, Access: "admin"},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.CreateAPIToken400JSONResponse{}, resp)
+	})
+
+	t.Run("unauthenticated create returns 403", func(t *testing.T) {
+		resp, err := h.CreateAPIToken(context.Background(), gen.CreateAPITokenRequestObject{
+			Body: &gen.CreateAPITokenJSONRequestBody{Name: "ci", Namespace: testResourceNs, Access: gen.Read},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.CreateAPIToken403JSONResponse{}, resp)
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		resp, err := h.RevokeAPIToken(ctx, gen.RevokeAPITokenRequestObject{TokenId: created.Token.Id})
+		require.NoError(t, err)
+		assert.IsType(t, gen.RevokeAPIToken204Response{}, resp)
+
+		resp, err = h.RevokeAPIToken(ctx, gen.RevokeAPITokenRequestObject{TokenId: created.Token.Id})
+		require.NoError(t, err)
+		assert.IsType(t, gen.RevokeAPIToken404JSONResponse{}, resp)
+	})
+}
+
+func TestAPITokenHandlers_Disabled(t *testing.T) {
+	h := newHandlerWithAPITokens(t, nil)
+
+	resp, err := h.ListAPITokens(testContext(), gen.ListAPITokensRequestObject{})
+	require.NoError(t, err)
+	assert.IsType(t, gen.ListAPITokens501JSONResponse{}, resp)
+}
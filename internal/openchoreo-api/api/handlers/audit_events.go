@@ -0,0 +1,98 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	auditeventsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/auditevent"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+)
+
+const (
+	defaultAuditEventsLimit   = 100
+	maxAuditEventsLimit       = 1000
+	auditQueryDisabledMessage = "Audit event query is disabled on this server. " +
+		"Set audit.enabled and a non-zero audit.query_buffer_size to enable it."
+)
+
+// ListAuditEvents returns recent audit events, newest first.
+func (h *Handler) ListAuditEvents(
+	ctx context.Context,
+	request gen.ListAuditEventsRequestObject,
+) (gen.ListAuditEventsResponseObject, error) {
+	h.logger.Debug("ListAuditEvents called")
+
+	limit := defaultAuditEventsLimit
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+	if limit < 1 || limit > maxAuditEventsLimit {
+		return gen.ListAuditEvents400JSONResponse{BadRequestJSONResponse: badRequest("limit must be between 1 and 1000")}, nil
+	}
+
+	events, err := h.services.AuditEventService.ListAuditEvents(ctx, audit.Filter{
+		ActorID:      getStringValue(request.Params.Actor),
+		ResourceType: getStringValue(request.Params.ResourceType),
+		ResourceName: getStringValue(request.Params.ResourceName),
+		Namespace:    getStringValue(request.Params.Namespace),
+		Limit:        limit,
+	})
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.ListAuditEvents403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, auditeventsvc.ErrAuditQueryDisabled) {
+			return gen.ListAuditEvents501JSONResponse{NotImplementedJSONResponse: notImplemented(auditQueryDisabledMessage)}, nil
+		}
+		h.logger.Error("Failed to list audit events", "error", err)
+		return gen.ListAuditEvents500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	items := make([]gen.AuditEvent, 0, len(events))
+	for i := range events {
+		items = append(items, toGenAuditEvent(&events[i]))
+	}
+	return gen.ListAuditEvents200JSONResponse{Items: items}, nil
+}
+
+// toGenAuditEvent converts an audit event to its API representation. Actor
+// entitlements are not exposed.
+func toGenAuditEvent(event *audit.Event) gen.AuditEvent {
+	out := gen.AuditEvent{
+		EventId:   event.EventID,
+		Timestamp: event.Timestamp,
+		Actor:     gen.AuditActor{Type: event.Actor.Type, Id: event.Actor.ID},
+		Action:    event.Action,
+		Category:  string(event.Category),
+		Result:    string(event.Result),
+		RequestId: optionalString(event.RequestID),
+		SourceIp:  optionalString(event.SourceIP),
+		Service:   optionalString(event.Service),
+	}
+	if event.Resource != nil {
+		out.Resource = &gen.AuditResource{
+			Type:      event.Resource.Type,
+			Id:        optionalString(event.Resource.ID),
+			Name:      optionalString(event.Resource.Name),
+			Namespace: optionalString(event.Resource.Namespace),
+		}
+	}
+	if len(event.Metadata) > 0 {
+		metadata := event.Metadata
+		out.Metadata = &metadata
+	}
+	return out
+}
+
+// optionalString returns nil for an empty string and a pointer to s otherwise.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	apiaudit "github.com/openchoreo/openchoreo/internal/openchoreo-api/audit"
+	auditeventsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/auditevent"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+)
+
+func newHandlerWithAuditEventStore(store auditeventsvc.EventStore, pdp authzcore.PDP) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{
+			AuditEventService: auditeventsvc.NewServiceWithAuthz(store, pdp, slog.Default()),
+		},
+		logger: slog.Default(),
+	}
+}
+
+func TestListAuditEventsHandler(t *testing.T) {
+	ctx := testContext()
+	store := audit.NewMemorySink(10)
+	for _, e := range []audit.Event{
+		{EventID: "1", Actor: audit.Actor{Type: "user", ID: "alice"}, Action: "delete_resource", Result: audit.ResultSuccess,
+			Resource: &audit.Resource{Type: "resource", Name: "db", Namespace: testResourceNs}},
+		{EventID: "2", Actor: audit.Actor{Type: "user", ID: "bob"}, Action: "create_project", Result: audit.ResultDenied},
+	} {
+		require.NoError(t, store.Write(&e))
+	}
+
+	t.Run("success - filters by resource", func(t *testing.T) {
+		h := newHandlerWithAuditEventStore(store, &allowAllPDP{})
+		resp, err := h.ListAuditEvents(ctx, gen.ListAuditEventsRequestObject{
+			Params: gen.ListAuditEventsParams{ResourceName: ptr.To("db"), Namespace: ptr.To(testResourceNs)},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ListAuditEvents200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.Len(t, typed.Items, 1)
+		assert.Equal(t, "1", typed.Items[0].EventId)
+		assert.Equal(t, "alice", typed.Items[0].Actor.Id)
+		require.NotNil(t, typed.Items[0].Resource)
+		assert.Equal(t, testResourceNs, *typed.Items[0].Resource.Namespace)
+	})
+
+	t.Run("invalid limit returns 400", func(t *testing.T) {
+		h := newHandlerWithAuditEventStore(store, &allowAllPDP{})
+		resp, err := h.ListAuditEvents(ctx, gen.ListAuditEventsRequestObject{
+			Params: gen.ListAuditEventsParams{Limit: ptr.To(0)},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ListAuditEvents400JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		h := newHandlerWithAuditEventStore(store, &denyAllPDP{})
+		resp, err := h.ListAuditEvents(ctx, gen.ListAuditEventsRequestObject{})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ListAuditEvents403JSONResponse{}, resp)
+	})
+
+	t.Run("disabled query returns 501", func(t *testing.T) {
+		h := newHandlerWithAuditEventStore(nil, &allowAllPDP{})
+		resp, err := h.ListAuditEvents(ctx, gen.ListAuditEventsRequestObject{})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ListAuditEvents501JSONResponse{}, resp)
+	})
+}
+
+func TestResourceHandlersRecordAuditEvents(t *testing.T) {
+	store := audit.NewMemorySink(10)
+	auditMiddleware := audit.NewMiddleware(
+		audit.NewLogger(slog.New(slog.NewTextHandler(io.Discard, nil)), "test", store),
+		audit.NewActionResolver(apiaudit.GetActionDefinitions()),
+	)
+	svc := newResourceService(t, []client.Object{testResourceObj("r-1")}, &allowAllPDP{})
+	h := newHandlerWithResourceService(svc)
+
+	serve := func(method, path string, call func(r *http.Request) int) {
+		t.Helper()
+		handler := auditMiddleware.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(call(r))
+		}))
+		req := httptest.NewRequest(method, path, nil).WithContext(testContext())
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	serve(http.MethodPatch, "/api/v1/namespaces/"+testResourceNs+"/resources/r-1", func(r *http.Request) int {
+		body := gen.PatchResourceApplicationMergePatchPlusJSONRequestBody{
+			"metadata": map[string]any{"labels": map[string]any{"tier": "gold"}},
+		}
+		resp, err := h.PatchResource(r.Context(), gen.PatchResourceRequestObject{
+			NamespaceName: testResourceNs, ResourceName: "r-1", Body: &body,
+		})
+		require.NoError(t, err)
+		require.IsType(t, gen.PatchResource200JSONResponse{}, resp)
+		return http.StatusOK
+	})
+	serve(http.MethodDelete, "/api/v1/namespaces/"+testResourceNs+"/resources/r-1", func(r *http.Request) int {
+		resp, err := h.DeleteResource(r.Context(), gen.DeleteResourceRequestObject{
+			NamespaceName: testResourceNs, ResourceName: "r-1",
+		})
+		require.NoError(t, err)
+		require.IsType(t, gen.DeleteResource204Response{}, resp)
+		return http.StatusNoContent
+	})
+
+	events := store.Query(audit.Filter{ResourceType: "resource", ResourceName: "r-1", Namespace: testResourceNs})
+	require.Len(t, events, 2)
+
+	deleted, patched := events[0], events[1]
+	assert.Equal(t, "patch_resource", patched.Action)
+	assert.Equal(t, audit.ResultSuccess, patched.Result)
+	assert.Equal(t, []string{"add metadata.labels"}, patched.Metadata["changes"])
+
+	assert.Equal(t, "delete_resource", deleted.Action)
+	assert.Equal(t, []string{"remove metadata", "remove spec"}, deleted.Metadata["changes"])
+}
@@ -303,8 +303,10 @@ func (h *Handler) ListClusterRoles(
 ) (gen.ListClusterRolesResponseObject, error) {
 	h.logger.Debug("ListClusterRoles called")
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
-	result, err := h.services.AuthzService.ListClusterRoles(ctx, opts)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
+	result, err := svcpkg.SortedList(ctx, opts, func(ctx context.Context, opts svcpkg.ListOptions) (*svcpkg.ListResult[openchoreov1alpha1.ClusterAuthzRole], error) {
+		return h.services.AuthzService.ListClusterRoles(ctx, opts)
+	})
 	if err != nil {
 		if errors.Is(err, svcpkg.ErrForbidden) {
 			return gen.ListClusterRoles403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
@@ -480,8 +482,10 @@ func (h *Handler) ListClusterRoleBindings(
 ) (gen.ListClusterRoleBindingsResponseObject, error) {
 	h.logger.Debug("ListClusterRoleBindings called")
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
-	result, err := h.services.AuthzService.ListClusterRoleBindings(ctx, opts)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
+	result, err := svcpkg.SortedList(ctx, opts, func(ctx context.Context, opts svcpkg.ListOptions) (*svcpkg.ListResult[openchoreov1alpha1.ClusterAuthzRoleBinding], error) {
+		return h.services.AuthzService.ListClusterRoleBindings(ctx, opts)
+	})
 	if err != nil {
 		if errors.Is(err, svcpkg.ErrForbidden) {
 			return gen.ListClusterRoleBindings403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
@@ -657,8 +661,10 @@ func (h *Handler) ListNamespaceRoles(
 ) (gen.ListNamespaceRolesResponseObject, error) {
 	h.logger.Debug("ListNamespaceRoles called", "namespace", request.NamespaceName)
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
-	result, err := h.services.AuthzService.ListNamespaceRoles(ctx, request.NamespaceName, opts)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
+	result, err := svcpkg.SortedList(ctx, opts, func(ctx context.Context, opts svcpkg.ListOptions) (*svcpkg.ListResult[openchoreov1alpha1.AuthzRole], error) {
+		return h.services.AuthzService.ListNamespaceRoles(ctx, request.NamespaceName, opts)
+	})
 	if err != nil {
 		if errors.Is(err, svcpkg.ErrForbidden) {
 			return gen.ListNamespaceRoles403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
@@ -834,8 +840,10 @@ func (h *Handler) ListNamespaceRoleBindings(
 ) (gen.ListNamespaceRoleBindingsResponseObject, error) {
 	h.logger.Debug("ListNamespaceRoleBindings called", "namespace", request.NamespaceName)
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
-	result, err := h.services.AuthzService.ListNamespaceRoleBindings(ctx, request.NamespaceName, opts)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
+	result, err := svcpkg.SortedList(ctx, opts, func(ctx context.Context, opts svcpkg.ListOptions) (*svcpkg.ListResult[openchoreov1alpha1.AuthzRoleBinding], error) {
+		return h.services.AuthzService.ListNamespaceRoleBindings(ctx, request.NamespaceName, opts)
+	})
 	if err != nil {
 		if errors.Is(err, svcpkg.ErrForbidden) {
 			return gen.ListNamespaceRoleBindings403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
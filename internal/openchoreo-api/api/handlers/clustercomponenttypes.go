@@ -21,9 +21,11 @@ func (h *Handler) ListClusterComponentTypes(
 ) (gen.ListClusterComponentTypesResponseObject, error) {
 	h.logger.Debug("ListClusterComponentTypes called")
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.ClusterComponentTypeService.ListClusterComponentTypes(ctx, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ClusterComponentType], error) {
+		return h.services.ClusterComponentTypeService.ListClusterComponentTypes(ctx, opts)
+	})
 	if err != nil {
 		if errors.Is(err, services.ErrForbidden) {
 			return gen.ListClusterComponentTypes403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
@@ -21,9 +21,11 @@ func (h *Handler) ListClusterDataPlanes(
 ) (gen.ListClusterDataPlanesResponseObject, error) {
 	h.logger.Debug("ListClusterDataPlanes called")
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.ClusterDataPlaneService.ListClusterDataPlanes(ctx, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ClusterDataPlane], error) {
+		return h.services.ClusterDataPlaneService.ListClusterDataPlanes(ctx, opts)
+	})
 	if err != nil {
 		if errors.Is(err, services.ErrForbidden) {
 			return gen.ListClusterDataPlanes403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
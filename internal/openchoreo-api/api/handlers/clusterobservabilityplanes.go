@@ -21,9 +21,11 @@ func (h *Handler) ListClusterObservabilityPlanes(
 ) (gen.ListClusterObservabilityPlanesResponseObject, error) {
 	h.logger.Debug("ListClusterObservabilityPlanes called")
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.ClusterObservabilityPlaneService.ListClusterObservabilityPlanes(ctx, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ClusterObservabilityPlane], error) {
+		return h.services.ClusterObservabilityPlaneService.ListClusterObservabilityPlanes(ctx, opts)
+	})
 	if err != nil {
 		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
 			return gen.ListClusterObservabilityPlanes400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
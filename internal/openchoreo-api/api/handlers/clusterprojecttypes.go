@@ -20,9 +20,11 @@ func (h *Handler) ListClusterProjectTypes(
 ) (gen.ListClusterProjectTypesResponseObject, error) {
 	h.logger.Debug("ListClusterProjectTypes called")
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.ClusterProjectTypeService.ListClusterProjectTypes(ctx, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ClusterProjectType], error) {
+		return h.services.ClusterProjectTypeService.ListClusterProjectTypes(ctx, opts)
+	})
 	if err != nil {
 		if errors.Is(err, services.ErrForbidden) {
 			return gen.ListClusterProjectTypes403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
@@ -20,9 +20,11 @@ func (h *Handler) ListClusterResourceTypes(
 ) (gen.ListClusterResourceTypesResponseObject, error) {
 	h.logger.Debug("ListClusterResourceTypes called")
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.ClusterResourceTypeService.ListClusterResourceTypes(ctx, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ClusterResourceType], error) {
+		return h.services.ClusterResourceTypeService.ListClusterResourceTypes(ctx, opts)
+	})
 	if err != nil {
 		if errors.Is(err, services.ErrForbidden) {
 			return gen.ListClusterResourceTypes403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
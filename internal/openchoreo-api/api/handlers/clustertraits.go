@@ -21,9 +21,11 @@ func (h *Handler) ListClusterTraits(
 ) (gen.ListClusterTraitsResponseObject, error) {
 	h.logger.Debug("ListClusterTraits called")
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.ClusterTraitService.ListClusterTraits(ctx, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ClusterTrait], error) {
+		return h.services.ClusterTraitService.ListClusterTraits(ctx, opts)
+	})
 	if err != nil {
 		if errors.Is(err, services.ErrForbidden) {
 			return gen.ListClusterTraits403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
@@ -21,10 +21,15 @@ func (h *Handler) ListClusterWorkflowPlanes(
 ) (gen.ListClusterWorkflowPlanesResponseObject, error) {
 	h.logger.Debug("ListClusterWorkflowPlanes called")
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.ClusterWorkflowPlaneService.ListClusterWorkflowPlanes(ctx, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ClusterWorkflowPlane], error) {
+		return h.services.ClusterWorkflowPlaneService.ListClusterWorkflowPlanes(ctx, opts)
+	})
 	if err != nil {
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.ListClusterWorkflowPlanes400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
 		h.logger.Error("Failed to list cluster workflow planes", "error", err)
 		return gen.ListClusterWorkflowPlanes500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
 	}
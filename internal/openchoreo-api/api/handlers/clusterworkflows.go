@@ -21,9 +21,11 @@ func (h *Handler) ListClusterWorkflows(
 ) (gen.ListClusterWorkflowsResponseObject, error) {
 	h.logger.Debug("ListClusterWorkflows called")
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.ClusterWorkflowService.ListClusterWorkflows(ctx, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ClusterWorkflow], error) {
+		return h.services.ClusterWorkflowService.ListClusterWorkflows(ctx, opts)
+	})
 	if err != nil {
 		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
 			return gen.ListClusterWorkflows400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
@@ -0,0 +1,56 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcerrors "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	workflowrunsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowrun"
+)
+
+// TriggerComponentBuild starts a run of the workflow configured on a component.
+func (h *Handler) TriggerComponentBuild(
+	ctx context.Context,
+	request gen.TriggerComponentBuildRequestObject,
+) (gen.TriggerComponentBuildResponseObject, error) {
+	h.logger.Info("TriggerComponentBuild called",
+		"namespaceName", request.NamespaceName,
+		"componentName", request.ComponentName)
+
+	commit := ""
+	if request.Body != nil {
+		commit = ptr.Deref(request.Body.Commit, "")
+	}
+
+	run, err := h.services.WorkflowRunService.TriggerWorkflow(ctx, request.NamespaceName, "", request.ComponentName, commit)
+	if err != nil {
+		switch {
+		case errors.Is(err, svcerrors.ErrForbidden):
+			return gen.TriggerComponentBuild403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, componentsvc.ErrComponentNotFound):
+			return gen.TriggerComponentBuild404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		case errors.Is(err, workflowrunsvc.ErrInvalidCommitSHA):
+			return gen.TriggerComponentBuild400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		case errors.Is(err, workflowrunsvc.ErrWorkflowNotConfigured):
+			return gen.TriggerComponentBuild409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
+		h.logger.Error("Failed to trigger component build", "error", err)
+		return gen.TriggerComponentBuild500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.TriggerComponentBuild201JSONResponse{
+		Name:      run.Name,
+		Namespace: run.NamespaceName,
+		Project:   run.ProjectName,
+		Component: run.ComponentName,
+		Commit:    optionalString(run.Commit),
+		CreatedAt: run.CreatedAt,
+	}, nil
+}
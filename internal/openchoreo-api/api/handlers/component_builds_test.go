@@ -0,0 +1,92 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	workflowrunsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowrun"
+	workflowrunmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowrun/mocks"
+)
+
+func TestTriggerComponentBuildHandler(t *testing.T) {
+	ctx := testContext()
+	newHandler := func(svc workflowrunsvc.Service) *Handler {
+		return &Handler{
+			services: &handlerservices.Services{WorkflowRunService: svc},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+	}
+	request := gen.TriggerComponentBuildRequestObject{
+		NamespaceName: "acme",
+		ComponentName: "orders",
+		Body:          &gen.TriggerComponentBuildJSONRequestBody{Commit: ptr.To("3f2a9c1")},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		svc := workflowrunmocks.NewMockService(t)
+		svc.EXPECT().TriggerWorkflow(mock.Anything, "acme", "", "orders", "3f2a9c1").Return(&models.WorkflowRunTriggerResponse{
+			Name: "orders-run-1a2b3c4d", NamespaceName: "acme", ProjectName: "store", ComponentName: "orders",
+			Commit: "3f2a9c1", CreatedAt: created,
+		}, nil)
+
+		resp, err := newHandler(svc).TriggerComponentBuild(ctx, request)
+		require.NoError(t, err)
+		typed, ok := resp.(gen.TriggerComponentBuild201JSONResponse)
+		require.True(t, ok, "expected 201, got %T", resp)
+		assert.Equal(t, gen.TriggerComponentBuild201JSONResponse{
+			Name: "orders-run-1a2b3c4d", Namespace: "acme", Project: "store", Component: "orders",
+			Commit: ptr.To("3f2a9c1"), CreatedAt: created,
+		}, typed)
+	})
+
+	t.Run("without body", func(t *testing.T) {
+		svc := workflowrunmocks.NewMockService(t)
+		svc.EXPECT().TriggerWorkflow(mock.Anything, "acme", "", "orders", "").
+			Return(&models.WorkflowRunTriggerResponse{Name: "orders-run-1a2b3c4d"}, nil)
+
+		resp, err := newHandler(svc).TriggerComponentBuild(ctx, gen.TriggerComponentBuildRequestObject{
+			NamespaceName: "acme", ComponentName: "orders",
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.TriggerComponentBuild201JSONResponse{}, resp)
+	})
+
+	errCases := []struct {
+		name string
+		err  error
+		want any
+	}{
+		{"forbidden", svcpkg.ErrForbidden, gen.TriggerComponentBuild403JSONResponse{}},
+		{"component not found", componentsvc.ErrComponentNotFound, gen.TriggerComponentBuild404JSONResponse{}},
+		{"invalid commit", workflowrunsvc.ErrInvalidCommitSHA, gen.TriggerComponentBuild400JSONResponse{}},
+		{"no workflow", workflowrunsvc.ErrWorkflowNotConfigured, gen.TriggerComponentBuild409JSONResponse{}},
+		{"internal error", errors.New("boom"), gen.TriggerComponentBuild500JSONResponse{}},
+	}
+	for _, tt := range errCases {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := workflowrunmocks.NewMockService(t)
+			svc.EXPECT().TriggerWorkflow(mock.Anything, "acme", "", "orders", "3f2a9c1").Return(nil, tt.err)
+
+			resp, err := newHandler(svc).TriggerComponentBuild(ctx, request)
+			require.NoError(t, err)
+			assert.IsType(t, tt.want, resp)
+		})
+	}
+}
@@ -26,9 +26,11 @@ func (h *Handler) ListComponentReleases(
 		componentName = *request.Params.Component
 	}
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.ComponentReleaseService.ListComponentReleases(ctx, request.NamespaceName, componentName, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ComponentRelease], error) {
+		return h.services.ComponentReleaseService.ListComponentReleases(ctx, request.NamespaceName, componentName, opts)
+	})
 	if err != nil {
 		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
 			return gen.ListComponentReleases400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
@@ -46,7 +48,7 @@ func (h *Handler) ListComponentReleases(
 	resp := gen.ListComponentReleases200JSONResponse{
 		Items: items,
 	}
-	resp.Pagination = ToPagination(result)
+	resp.Pagination = ToPagination(result.WithFilter("component", componentName))
 
 	return resp, nil
 }
@@ -32,9 +32,11 @@ func (h *Handler) ListComponents(
 		projectName = *request.Params.Project
 	}
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.ComponentService.ListComponents(ctx, request.NamespaceName, projectName, opts)
+	result, err := svcerrors.SortedList(ctx, opts, func(ctx context.Context, opts svcerrors.ListOptions) (*svcerrors.ListResult[openchoreov1alpha1.Component], error) {
+		return h.services.ComponentService.ListComponents(ctx, request.NamespaceName, projectName, opts)
+	})
 	if err != nil {
 		if errors.Is(err, projectsvc.ErrProjectNotFound) {
 			return gen.ListComponents404JSONResponse{NotFoundJSONResponse: notFound("Project")}, nil
@@ -54,7 +56,7 @@ func (h *Handler) ListComponents(
 
 	return gen.ListComponents200JSONResponse{
 		Items:      items,
-		Pagination: ToPagination(result),
+		Pagination: ToPagination(result.WithFilter("project", projectName)),
 	}, nil
 }
 
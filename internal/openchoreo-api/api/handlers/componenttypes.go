@@ -21,9 +21,11 @@ func (h *Handler) ListComponentTypes(
 ) (gen.ListComponentTypesResponseObject, error) {
 	h.logger.Debug("ListComponentTypes called", "namespaceName", request.NamespaceName)
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.ComponentTypeService.ListComponentTypes(ctx, request.NamespaceName, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ComponentType], error) {
+		return h.services.ComponentTypeService.ListComponentTypes(ctx, request.NamespaceName, opts)
+	})
 	if err != nil {
 		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
 			return gen.ListComponentTypes400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
@@ -0,0 +1,170 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	connectionsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/connection"
+	environmentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment"
+)
+
+// ListConnections returns a paginated list of connections within a namespace.
+func (h *Handler) ListConnections(
+	ctx context.Context,
+	request gen.ListConnectionsRequestObject,
+) (gen.ListConnectionsResponseObject, error) {
+	h.logger.Debug("ListConnections called", "namespaceName", request.NamespaceName)
+
+	componentName := ptr.Deref(request.Params.Component, "")
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, nil, nil)
+
+	result, err := h.services.ConnectionService.ListConnections(ctx, request.NamespaceName, componentName, opts)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.ListConnections403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.ListConnections400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to list connections", "error", err)
+		return gen.ListConnections500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	items, err := convertList[openchoreov1alpha1.Connection, gen.Connection](result.Items)
+	if err != nil {
+		h.logger.Error("Failed to convert connections", "error", err)
+		return gen.ListConnections500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	resp := gen.ListConnections200JSONResponse{
+		Items: items,
+	}
+	resp.Pagination = ToPagination(result.WithFilter("component", componentName))
+
+	return resp, nil
+}
+
+// CreateConnection connects a component to an endpoint or a Resource.
+func (h *Handler) CreateConnection(
+	ctx context.Context,
+	request gen.CreateConnectionRequestObject,
+) (gen.CreateConnectionResponseObject, error) {
+	h.logger.Info("CreateConnection called", "namespaceName", request.NamespaceName)
+
+	if request.Body == nil {
+		return gen.CreateConnection400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	req := &connectionsvc.CreateConnectionRequest{
+		Name:          ptr.Deref(request.Body.Name, ""),
+		ComponentName: request.Body.ComponentName,
+		Environments:  ptr.Deref(request.Body.Environments, nil),
+	}
+	if request.Body.Endpoint != nil {
+		endpoint, err := convert[gen.WorkloadConnection, openchoreov1alpha1.WorkloadConnection](*request.Body.Endpoint)
+		if err != nil {
+			return gen.CreateConnection400JSONResponse{BadRequestJSONResponse: badRequest("Invalid endpoint")}, nil
+		}
+		req.Endpoint = &endpoint
+	}
+	if request.Body.Resource != nil {
+		resource, err := convert[gen.WorkloadResourceDependency, openchoreov1alpha1.WorkloadResourceDependency](*request.Body.Resource)
+		if err != nil {
+			return gen.CreateConnection400JSONResponse{BadRequestJSONResponse: badRequest("Invalid resource")}, nil
+		}
+		req.Resource = &resource
+	}
+
+	conn, err := h.services.ConnectionService.CreateConnection(ctx, request.NamespaceName, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrForbidden):
+			return gen.CreateConnection403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, componentsvc.ErrComponentNotFound):
+			return gen.CreateConnection404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		case errors.Is(err, environmentsvc.ErrEnvironmentNotFound):
+			return gen.CreateConnection404JSONResponse{NotFoundJSONResponse: notFound("Environment")}, nil
+		case errors.Is(err, connectionsvc.ErrResourceNotFound):
+			return gen.CreateConnection404JSONResponse{NotFoundJSONResponse: notFound("Resource")}, nil
+		case errors.Is(err, connectionsvc.ErrConnectionExists):
+			return gen.CreateConnection409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		case errors.Is(err, connectionsvc.ErrResourceNotInProject):
+			return gen.CreateConnection400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.CreateConnection400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to create connection", "error", err)
+		return gen.CreateConnection500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genConn, err := convert[openchoreov1alpha1.Connection, gen.Connection](*conn)
+	if err != nil {
+		h.logger.Error("Failed to convert connection", "error", err)
+		return gen.CreateConnection500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.CreateConnection201JSONResponse(genConn), nil
+}
+
+// GetConnection returns a connection.
+func (h *Handler) GetConnection(
+	ctx context.Context,
+	request gen.GetConnectionRequestObject,
+) (gen.GetConnectionResponseObject, error) {
+	h.logger.Debug("GetConnection called",
+		"namespaceName", request.NamespaceName,
+		"connectionName", request.ConnectionName)
+
+	conn, err := h.services.ConnectionService.GetConnection(ctx, request.NamespaceName, request.ConnectionName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.GetConnection403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, connectionsvc.ErrConnectionNotFound) {
+			return gen.GetConnection404JSONResponse{NotFoundJSONResponse: notFound("Connection")}, nil
+		}
+		h.logger.Error("Failed to get connection", "error", err)
+		return gen.GetConnection500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genConn, err := convert[openchoreov1alpha1.Connection, gen.Connection](*conn)
+	if err != nil {
+		h.logger.Error("Failed to convert connection", "error", err)
+		return gen.GetConnection500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.GetConnection200JSONResponse(genConn), nil
+}
+
+// DeleteConnection deletes a connection.
+func (h *Handler) DeleteConnection(
+	ctx context.Context,
+	request gen.DeleteConnectionRequestObject,
+) (gen.DeleteConnectionResponseObject, error) {
+	h.logger.Info("DeleteConnection called",
+		"namespaceName", request.NamespaceName,
+		"connectionName", request.ConnectionName)
+
+	err := h.services.ConnectionService.DeleteConnection(ctx, request.NamespaceName, request.ConnectionName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.DeleteConnection403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, connectionsvc.ErrConnectionNotFound) {
+			return gen.DeleteConnection404JSONResponse{NotFoundJSONResponse: notFound("Connection")}, nil
+		}
+		h.logger.Error("Failed to delete connection", "error", err)
+		return gen.DeleteConnection500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("Connection deleted successfully", "namespaceName", request.NamespaceName, "connection", request.ConnectionName)
+	return gen.DeleteConnection204Response{}, nil
+}
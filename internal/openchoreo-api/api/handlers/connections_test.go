@@ -0,0 +1,234 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	connectionsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/connection"
+	connectionsvcmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/connection/mocks"
+	environmentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+)
+
+func newHandlerWithConnectionService(svc connectionsvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{ConnectionService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func testConnection() *openchoreov1alpha1.Connection {
+	return &openchoreov1alpha1.Connection{
+		ObjectMeta: metav1.ObjectMeta{Name: "comp-a-orders-db", Namespace: "test-ns"},
+		Spec: openchoreov1alpha1.ConnectionSpec{
+			Owner:        openchoreov1alpha1.ConnectionOwner{ProjectName: "proj", ComponentName: "comp-a"},
+			Environments: []string{"prod"},
+			Resource: &openchoreov1alpha1.WorkloadResourceDependency{
+				Ref:          "orders-db",
+				EnvBindings:  map[string]string{"host": "DB_HOST"},
+				FileBindings: map[string]string{"password": "/etc/db/password"},
+			},
+		},
+		Status: openchoreov1alpha1.ConnectionStatus{
+			Environments: []openchoreov1alpha1.ConnectionEnvironmentStatus{
+				{Environment: "prod", ReleaseBinding: "comp-a-prod", Resolved: true},
+			},
+		},
+	}
+}
+
+func TestCreateConnectionHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("nil body returns 400", func(t *testing.T) {
+		h := newHandlerWithConnectionService(connectionsvcmocks.NewMockService(t))
+		resp, err := h.CreateConnection(ctx, gen.CreateConnectionRequestObject{NamespaceName: ns})
+		require.NoError(t, err)
+		assert.IsType(t, gen.CreateConnection400JSONResponse{}, resp)
+	})
+
+	t.Run("success returns created connection", func(t *testing.T) {
+		svc := connectionsvcmocks.NewMockService(t)
+		svc.EXPECT().CreateConnection(mock.Anything, ns, &connectionsvc.CreateConnectionRequest{
+			Name:          "comp-a-orders-db",
+			ComponentName: "comp-a",
+			Environments:  []string{"prod"},
+			Resource: &openchoreov1alpha1.WorkloadResourceDependency{
+				Ref:          "orders-db",
+				EnvBindings:  map[string]string{"host": "DB_HOST"},
+				FileBindings: map[string]string{"password": "/etc/db/password"},
+			},
+		}).Return(testConnection(), nil)
+
+		resp, err := newHandlerWithConnectionService(svc).CreateConnection(ctx, gen.CreateConnectionRequestObject{
+			NamespaceName: ns,
+			Body: &gen.CreateConnectionRequest{
+				Name:          ptr.To("comp-a-orders-db"),
+				ComponentName: "comp-a",
+				Environments:  &[]string{"prod"},
+				Resource: &gen.WorkloadResourceDependency{
+					Ref:          "orders-db",
+					EnvBindings:  &map[string]string{"host": "DB_HOST"},
+					FileBindings: &map[string]string{"password": "/etc/db/password"},
+				},
+			},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.CreateConnection201JSONResponse)
+		require.True(t, ok, "expected 201 response, got %T", resp)
+		assert.Equal(t, "comp-a-orders-db", typed.Metadata.Name)
+		assert.Equal(t, "orders-db", typed.Spec.Resource.Ref)
+		require.Len(t, *typed.Status.Environments, 1)
+		assert.True(t, (*typed.Status.Environments)[0].Resolved)
+	})
+
+	t.Run("endpoint connections are passed to the service", func(t *testing.T) {
+		svc := connectionsvcmocks.NewMockService(t)
+		svc.EXPECT().CreateConnection(mock.Anything, ns, mock.MatchedBy(func(req *connectionsvc.CreateConnectionRequest) bool {
+			return req.Resource == nil && req.Endpoint != nil &&
+				req.Endpoint.Component == "api" && req.Endpoint.EnvBindings.Address == "API_URL"
+		})).Return(testConnection(), nil)
+
+		resp, err := newHandlerWithConnectionService(svc).CreateConnection(ctx, gen.CreateConnectionRequestObject{
+			NamespaceName: ns,
+			Body: &gen.CreateConnectionRequest{
+				ComponentName: "comp-a",
+				Endpoint: &gen.WorkloadConnection{
+					Component:   "api",
+					Name:        "http",
+					Visibility:  gen.WorkloadConnectionVisibilityProject,
+					EnvBindings: gen.ConnectionEnvBindings{Address: ptr.To("API_URL")},
+				},
+			},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.CreateConnection201JSONResponse{}, resp)
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.CreateConnection403JSONResponse{}},
+		{"component not found -> 404", componentsvc.ErrComponentNotFound, gen.CreateConnection404JSONResponse{}},
+		{"environment not found -> 404", environmentsvc.ErrEnvironmentNotFound, gen.CreateConnection404JSONResponse{}},
+		{"resource not found -> 404", connectionsvc.ErrResourceNotFound, gen.CreateConnection404JSONResponse{}},
+		{"resource of another project -> 400", connectionsvc.ErrResourceNotInProject, gen.CreateConnection400JSONResponse{}},
+		{"validation -> 400", &svcpkg.ValidationError{Msg: "exactly one of endpoint or resource is required"}, gen.CreateConnection400JSONResponse{}},
+		{"already exists -> 409", connectionsvc.ErrConnectionExists, gen.CreateConnection409JSONResponse{}},
+		{"internal -> 500", errors.New("boom"), gen.CreateConnection500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := connectionsvcmocks.NewMockService(t)
+			svc.EXPECT().CreateConnection(mock.Anything, ns, mock.Anything).Return(nil, tt.svcErr)
+			resp, err := newHandlerWithConnectionService(svc).CreateConnection(ctx, gen.CreateConnectionRequestObject{
+				NamespaceName: ns,
+				Body: &gen.CreateConnectionRequest{
+					ComponentName: "comp-a",
+					Resource:      &gen.WorkloadResourceDependency{Ref: "orders-db"},
+				},
+			})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
+func TestListConnectionsHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	svc := connectionsvcmocks.NewMockService(t)
+	svc.EXPECT().ListConnections(mock.Anything, ns, "comp-a", mock.Anything).Return(
+		&svcpkg.ListResult[openchoreov1alpha1.Connection]{
+			Items: []openchoreov1alpha1.Connection{*testConnection()},
+		}, nil)
+
+	resp, err := newHandlerWithConnectionService(svc).ListConnections(ctx, gen.ListConnectionsRequestObject{
+		NamespaceName: ns,
+		Params:        gen.ListConnectionsParams{Component: ptr.To("comp-a")},
+	})
+	require.NoError(t, err)
+	typed, ok := resp.(gen.ListConnections200JSONResponse)
+	require.True(t, ok, "expected 200 response, got %T", resp)
+	require.Len(t, typed.Items, 1)
+	assert.Equal(t, "comp-a", typed.Items[0].Spec.Owner.ComponentName)
+}
+
+func TestGetAndDeleteConnectionHandlers(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+	const name = "comp-a-orders-db"
+
+	t.Run("get returns connection", func(t *testing.T) {
+		svc := connectionsvcmocks.NewMockService(t)
+		svc.EXPECT().GetConnection(mock.Anything, ns, name).Return(testConnection(), nil)
+
+		resp, err := newHandlerWithConnectionService(svc).GetConnection(ctx, gen.GetConnectionRequestObject{
+			NamespaceName: ns, ConnectionName: name,
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetConnection200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, []string{"prod"}, *typed.Spec.Environments)
+	})
+
+	t.Run("delete returns 204", func(t *testing.T) {
+		svc := connectionsvcmocks.NewMockService(t)
+		svc.EXPECT().DeleteConnection(mock.Anything, ns, name).Return(nil)
+
+		resp, err := newHandlerWithConnectionService(svc).DeleteConnection(ctx, gen.DeleteConnectionRequestObject{
+			NamespaceName: ns, ConnectionName: name,
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.DeleteConnection204Response{}, resp)
+	})
+
+	tests := []struct {
+		name       string
+		svcErr     error
+		wantGet    any
+		wantDelete any
+	}{
+		{"forbidden -> 403", svcpkg.ErrForbidden,
+			gen.GetConnection403JSONResponse{}, gen.DeleteConnection403JSONResponse{}},
+		{"not found -> 404", connectionsvc.ErrConnectionNotFound,
+			gen.GetConnection404JSONResponse{}, gen.DeleteConnection404JSONResponse{}},
+		{"internal -> 500", errors.New("boom"),
+			gen.GetConnection500JSONResponse{}, gen.DeleteConnection500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := connectionsvcmocks.NewMockService(t)
+			svc.EXPECT().GetConnection(mock.Anything, ns, name).Return(nil, tt.svcErr)
+			svc.EXPECT().DeleteConnection(mock.Anything, ns, name).Return(tt.svcErr)
+			h := newHandlerWithConnectionService(svc)
+
+			getResp, err := h.GetConnection(ctx, gen.GetConnectionRequestObject{NamespaceName: ns, ConnectionName: name})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantGet, getResp)
+
+			deleteResp, err := h.DeleteConnection(ctx, gen.DeleteConnectionRequestObject{NamespaceName: ns, ConnectionName: name})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantDelete, deleteResp)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcerrors "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	costsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/cost"
+)
+
+// GetCostReport returns the actual cost of the data plane resources of a namespace.
+func (h *Handler) GetCostReport(
+	ctx context.Context,
+	request gen.GetCostReportRequestObject,
+) (gen.GetCostReportResponseObject, error) {
+	h.logger.Debug("GetCostReport called", "namespaceName", request.NamespaceName)
+
+	report, err := h.services.CostService.GetCostReport(ctx, &costsvc.ReportRequest{
+		NamespaceName:   request.NamespaceName,
+		ProjectName:     ptr.Deref(request.Params.Project, ""),
+		ComponentName:   ptr.Deref(request.Params.Component, ""),
+		EnvironmentName: ptr.Deref(request.Params.Environment, ""),
+		Window:          ptr.Deref(request.Params.Window, ""),
+		Aggregate:       string(ptr.Deref(request.Params.Aggregate, "")),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, svcerrors.ErrForbidden):
+			return gen.GetCostReport403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, costsvc.ErrCostBackendNotConfigured):
+			return gen.GetCostReport501JSONResponse{NotImplementedJSONResponse: notImplemented("Cost reports are disabled on this server")}, nil
+		}
+		if validationErr, ok := errors.AsType[*svcerrors.ValidationError](err); ok {
+			return gen.GetCostReport400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to get cost report", "error", err)
+		return gen.GetCostReport500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.GetCostReport200JSONResponse(toGenCostReport(report)), nil
+}
+
+// EstimateCost returns the estimated monthly cost of a proposed deployment.
+func (h *Handler) EstimateCost(
+	ctx context.Context,
+	request gen.EstimateCostRequestObject,
+) (gen.EstimateCostResponseObject, error) {
+	h.logger.Debug("EstimateCost called", "namespaceName", request.NamespaceName)
+
+	if request.Body == nil {
+		return gen.EstimateCost400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	workloads, err := fromGenWorkloadCostSpecs(request.Body.Workloads)
+	if err != nil {
+		return gen.EstimateCost400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	estimate, err := h.services.CostService.EstimateCost(ctx, request.NamespaceName, workloads)
+	if err != nil {
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.EstimateCost403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if validationErr, ok := errors.AsType[*svcerrors.ValidationError](err); ok {
+			return gen.EstimateCost400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to estimate cost", "error", err)
+		return gen.EstimateCost500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	out := gen.CostEstimate{
+		Currency:    estimate.Currency,
+		Items:       make([]gen.WorkloadCostEstimate, 0, len(estimate.Items)),
+		MonthlyCost: estimate.MonthlyCost,
+	}
+	for _, item := range estimate.Items {
+		out.Items = append(out.Items, gen.WorkloadCostEstimate{
+			Name:        item.Name,
+			CpuCost:     item.CPUCost,
+			MemoryCost:  item.MemoryCost,
+			StorageCost: item.StorageCost,
+			MonthlyCost: item.MonthlyCost,
+		})
+	}
+	return gen.EstimateCost200JSONResponse(out), nil
+}
+
+func toGenCostReport(report *costsvc.CostReport) gen.CostReport {
+	out := gen.CostReport{
+		Window:    report.Window,
+		Currency:  report.Currency,
+		Items:     make([]gen.CostItem, 0, len(report.Items)),
+		TotalCost: report.TotalCost,
+	}
+	if !report.Start.IsZero() {
+		out.Start = &report.Start
+	}
+	if !report.End.IsZero() {
+		out.End = &report.End
+	}
+	if report.Aggregate != "" {
+		out.Aggregate = ptr.To(gen.CostReportAggregate(report.Aggregate))
+	}
+	for _, item := range report.Items {
+		out.Items = append(out.Items, gen.CostItem{
+			Project:     optionalString(item.ProjectName),
+			Component:   optionalString(item.ComponentName),
+			Environment: optionalString(item.EnvironmentName),
+			CpuCost:     item.CPUCost,
+			GpuCost:     item.GPUCost,
+			MemoryCost:  item.MemoryCost,
+			StorageCost: item.StorageCost,
+			NetworkCost: item.NetworkCost,
+			TotalCost:   item.TotalCost,
+		})
+	}
+	return out
+}
+
+// fromGenWorkloadCostSpecs parses the quantities of the workloads, reporting
+// the first one that is not a valid Kubernetes quantity.
+func fromGenWorkloadCostSpecs(specs []gen.WorkloadCostSpec) ([]costsvc.WorkloadSpec, error) {
+	workloads := make([]costsvc.WorkloadSpec, 0, len(specs))
+	for i, spec := range specs {
+		w := costsvc.WorkloadSpec{Name: spec.Name, Replicas: spec.Replicas}
+		for _, f := range []struct {
+			name string
+			src  *string
+			dst  *resource.Quantity
+		}{
+			{"cpu", spec.Cpu, &w.CPU},
+			{"memory", spec.Memory, &w.Memory},
+			{"storage", spec.Storage, &w.Storage},
+		} {
+			if f.src == nil || *f.src == "" {
+				continue
+			}
+			parsed, err := resource.ParseQuantity(*f.src)
+			if err != nil {
+				return nil, fmt.Errorf("workloads[%d].%s: invalid quantity %q", i, f.name, *f.src)
+			}
+			*f.dst = parsed
+		}
+		workloads = append(workloads, w)
+	}
+	return workloads, nil
+}
@@ -0,0 +1,167 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcerrors "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	costsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/cost"
+	costsvcmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/cost/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+)
+
+func newHandlerWithCostService(svc costsvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{CostService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func TestGetCostReportHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+	request := gen.GetCostReportRequestObject{
+		NamespaceName: ns,
+		Params: gen.GetCostReportParams{
+			Project:   ptr.To("shop"),
+			Window:    ptr.To("24h"),
+			Aggregate: ptr.To(gen.GetCostReportParamsAggregate("component")),
+		},
+	}
+	wantReq := &costsvc.ReportRequest{NamespaceName: ns, ProjectName: "shop", Window: "24h", Aggregate: costsvc.AggregateComponent}
+
+	t.Run("success", func(t *testing.T) {
+		start := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+		svc := costsvcmocks.NewMockService(t)
+		svc.EXPECT().GetCostReport(mock.Anything, wantReq).Return(&costsvc.CostReport{
+			Window:    "24h",
+			Start:     start,
+			Currency:  "USD",
+			Aggregate: costsvc.AggregateComponent,
+			Items: []costsvc.CostItem{
+				{ProjectName: "shop", ComponentName: "api", CPUCost: 1, TotalCost: 1.5},
+				{ProjectName: "shop", TotalCost: 0.5},
+			},
+			TotalCost: 2,
+		}, nil)
+
+		resp, err := newHandlerWithCostService(svc).GetCostReport(ctx, request)
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetCostReport200JSONResponse)
+		require.True(t, ok, "expected 200, got %T", resp)
+		assert.Equal(t, "24h", typed.Window)
+		assert.Equal(t, &start, typed.Start)
+		assert.Nil(t, typed.End)
+		assert.Equal(t, ptr.To(gen.CostReportAggregate("component")), typed.Aggregate)
+		require.Len(t, typed.Items, 2)
+		assert.Equal(t, ptr.To("api"), typed.Items[0].Component)
+		assert.Nil(t, typed.Items[0].Environment)
+		assert.Nil(t, typed.Items[1].Component)
+		assert.InDelta(t, 2.0, typed.TotalCost, 1e-9)
+	})
+
+	errCases := []struct {
+		name string
+		err  error
+		want any
+	}{
+		{"forbidden", svcerrors.ErrForbidden, gen.GetCostReport403JSONResponse{}},
+		{"validation", &svcerrors.ValidationError{Msg: "bad"}, gen.GetCostReport400JSONResponse{}},
+		{"not configured", costsvc.ErrCostBackendNotConfigured, gen.GetCostReport501JSONResponse{}},
+		{"backend unavailable", costsvc.ErrCostBackendUnavailable, gen.GetCostReport500JSONResponse{}},
+	}
+	for _, tc := range errCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := costsvcmocks.NewMockService(t)
+			svc.EXPECT().GetCostReport(mock.Anything, wantReq).Return(nil, tc.err)
+
+			resp, err := newHandlerWithCostService(svc).GetCostReport(ctx, request)
+			require.NoError(t, err)
+			assert.IsType(t, tc.want, resp)
+		})
+	}
+}
+
+func TestEstimateCostHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+	request := gen.EstimateCostRequestObject{
+		NamespaceName: ns,
+		Body: &gen.CostEstimateRequest{Workloads: []gen.WorkloadCostSpec{
+			{Name: "api", Replicas: 2, Cpu: ptr.To("250m"), Memory: ptr.To("512Mi")},
+		}},
+	}
+	isWantWorkloads := func(w []costsvc.WorkloadSpec) bool {
+		return len(w) == 1 && w[0].Name == "api" && w[0].Replicas == 2 &&
+			w[0].CPU.MilliValue() == 250 && w[0].Memory.String() == "512Mi" && w[0].Storage.IsZero()
+	}
+
+	t.Run("success", func(t *testing.T) {
+		svc := costsvcmocks.NewMockService(t)
+		svc.EXPECT().EstimateCost(mock.Anything, ns, mock.MatchedBy(isWantWorkloads)).Return(&costsvc.CostEstimate{
+			Currency:    "USD",
+			Items:       []costsvc.WorkloadEstimate{{Name: "api", CPUCost: 11.54, MemoryCost: 3.09, MonthlyCost: 14.63}},
+			MonthlyCost: 14.63,
+		}, nil)
+
+		resp, err := newHandlerWithCostService(svc).EstimateCost(ctx, request)
+		require.NoError(t, err)
+		typed, ok := resp.(gen.EstimateCost200JSONResponse)
+		require.True(t, ok, "expected 200, got %T", resp)
+		assert.Equal(t, "USD", typed.Currency)
+		require.Len(t, typed.Items, 1)
+		assert.InDelta(t, 14.63, typed.Items[0].MonthlyCost, 1e-9)
+	})
+
+	errCases := []struct {
+		name string
+		err  error
+		want any
+	}{
+		{"forbidden", svcerrors.ErrForbidden, gen.EstimateCost403JSONResponse{}},
+		{"validation", &svcerrors.ValidationError{Msg: "bad"}, gen.EstimateCost400JSONResponse{}},
+		{"internal", errors.New("boom"), gen.EstimateCost500JSONResponse{}},
+	}
+	for _, tc := range errCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := costsvcmocks.NewMockService(t)
+			svc.EXPECT().EstimateCost(mock.Anything, ns, mock.Anything).Return(nil, tc.err)
+
+			resp, err := newHandlerWithCostService(svc).EstimateCost(ctx, request)
+			require.NoError(t, err)
+			assert.IsType(t, tc.want, resp)
+		})
+	}
+
+	t.Run("invalid quantity", func(t *testing.T) {
+		resp, err := newHandlerWithCostService(costsvcmocks.NewMockService(t)).EstimateCost(ctx, gen.EstimateCostRequestObject{
+			NamespaceName: ns,
+			Body: &gen.CostEstimateRequest{Workloads: []gen.WorkloadCostSpec{
+				{Name: "api", Replicas: 1, Memory: ptr.To("lots")},
+			}},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.EstimateCost400JSONResponse)
+		require.True(t, ok, "expected 400, got %T", resp)
+		assert.Equal(t, `workloads[0].memory: invalid quantity "lots"`, typed.Error)
+	})
+
+	t.Run("missing body", func(t *testing.T) {
+		resp, err := newHandlerWithCostService(costsvcmocks.NewMockService(t)).
+			EstimateCost(ctx, gen.EstimateCostRequestObject{NamespaceName: ns})
+		require.NoError(t, err)
+		assert.IsType(t, gen.EstimateCost400JSONResponse{}, resp)
+	})
+}
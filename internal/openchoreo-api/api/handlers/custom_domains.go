@@ -0,0 +1,155 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	customdomainsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/customdomain"
+	environmentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment"
+)
+
+// ListCustomDomains returns a paginated list of custom domains within a namespace.
+func (h *Handler) ListCustomDomains(
+	ctx context.Context,
+	request gen.ListCustomDomainsRequestObject,
+) (gen.ListCustomDomainsResponseObject, error) {
+	h.logger.Debug("ListCustomDomains called", "namespaceName", request.NamespaceName)
+
+	componentName := ptr.Deref(request.Params.Component, "")
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, nil, nil)
+
+	result, err := h.services.CustomDomainService.ListCustomDomains(ctx, request.NamespaceName, componentName, opts)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.ListCustomDomains403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.ListCustomDomains400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to list custom domains", "error", err)
+		return gen.ListCustomDomains500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	items, err := convertList[openchoreov1alpha1.CustomDomain, gen.CustomDomain](result.Items)
+	if err != nil {
+		h.logger.Error("Failed to convert custom domains", "error", err)
+		return gen.ListCustomDomains500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	resp := gen.ListCustomDomains200JSONResponse{
+		Items: items,
+	}
+	resp.Pagination = ToPagination(result.WithFilter("component", componentName))
+
+	return resp, nil
+}
+
+// CreateCustomDomain binds a custom domain to a component endpoint.
+func (h *Handler) CreateCustomDomain(
+	ctx context.Context,
+	request gen.CreateCustomDomainRequestObject,
+) (gen.CreateCustomDomainResponseObject, error) {
+	h.logger.Info("CreateCustomDomain called", "namespaceName", request.NamespaceName)
+
+	if request.Body == nil {
+		return gen.CreateCustomDomain400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	cd, err := h.services.CustomDomainService.CreateCustomDomain(ctx, request.NamespaceName, &customdomainsvc.CreateCustomDomainRequest{
+		ComponentName: request.Body.ComponentName,
+		Environment:   request.Body.Environment,
+		Endpoint:      request.Body.Endpoint,
+		Domain:        request.Body.Domain,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrForbidden):
+			return gen.CreateCustomDomain403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, componentsvc.ErrComponentNotFound):
+			return gen.CreateCustomDomain404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		case errors.Is(err, environmentsvc.ErrEnvironmentNotFound):
+			return gen.CreateCustomDomain404JSONResponse{NotFoundJSONResponse: notFound("Environment")}, nil
+		case errors.Is(err, customdomainsvc.ErrDomainAlreadyBound):
+			return gen.CreateCustomDomain409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		case errors.Is(err, customdomainsvc.ErrWorkloadNotFound),
+			errors.Is(err, customdomainsvc.ErrEndpointNotFound),
+			errors.Is(err, customdomainsvc.ErrEndpointNotExternal):
+			return gen.CreateCustomDomain400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.CreateCustomDomain400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to create custom domain", "error", err)
+		return gen.CreateCustomDomain500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genCD, err := convert[openchoreov1alpha1.CustomDomain, gen.CustomDomain](*cd)
+	if err != nil {
+		h.logger.Error("Failed to convert custom domain", "error", err)
+		return gen.CreateCustomDomain500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.CreateCustomDomain201JSONResponse(genCD), nil
+}
+
+// GetCustomDomain returns a custom domain.
+func (h *Handler) GetCustomDomain(
+	ctx context.Context,
+	request gen.GetCustomDomainRequestObject,
+) (gen.GetCustomDomainResponseObject, error) {
+	h.logger.Debug("GetCustomDomain called",
+		"namespaceName", request.NamespaceName,
+		"customDomainName", request.CustomDomainName)
+
+	cd, err := h.services.CustomDomainService.GetCustomDomain(ctx, request.NamespaceName, request.CustomDomainName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.GetCustomDomain403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, customdomainsvc.ErrCustomDomainNotFound) {
+			return gen.GetCustomDomain404JSONResponse{NotFoundJSONResponse: notFound("CustomDomain")}, nil
+		}
+		h.logger.Error("Failed to get custom domain", "error", err)
+		return gen.GetCustomDomain500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genCD, err := convert[openchoreov1alpha1.CustomDomain, gen.CustomDomain](*cd)
+	if err != nil {
+		h.logger.Error("Failed to convert custom domain", "error", err)
+		return gen.GetCustomDomain500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.GetCustomDomain200JSONResponse(genCD), nil
+}
+
+// DeleteCustomDomain deletes a custom domain.
+func (h *Handler) DeleteCustomDomain(
+	ctx context.Context,
+	request gen.DeleteCustomDomainRequestObject,
+) (gen.DeleteCustomDomainResponseObject, error) {
+	h.logger.Info("DeleteCustomDomain called",
+		"namespaceName", request.NamespaceName,
+		"customDomainName", request.CustomDomainName)
+
+	err := h.services.CustomDomainService.DeleteCustomDomain(ctx, request.NamespaceName, request.CustomDomainName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.DeleteCustomDomain403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, customdomainsvc.ErrCustomDomainNotFound) {
+			return gen.DeleteCustomDomain404JSONResponse{NotFoundJSONResponse: notFound("CustomDomain")}, nil
+		}
+		h.logger.Error("Failed to delete custom domain", "error", err)
+		return gen.DeleteCustomDomain500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("CustomDomain deleted successfully", "namespaceName", request.NamespaceName, "customDomain", request.CustomDomainName)
+	return gen.DeleteCustomDomain204Response{}, nil
+}
@@ -0,0 +1,189 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	customdomainsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/customdomain"
+	customdomainsvcmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/customdomain/mocks"
+	environmentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+)
+
+func newHandlerWithCustomDomainService(svc customdomainsvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{CustomDomainService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func testCustomDomain() *openchoreov1alpha1.CustomDomain {
+	return &openchoreov1alpha1.CustomDomain{
+		ObjectMeta: metav1.ObjectMeta{Name: "comp-a-prod-abcde", Namespace: "test-ns"},
+		Spec: openchoreov1alpha1.CustomDomainSpec{
+			Owner:       openchoreov1alpha1.CustomDomainOwner{ProjectName: "proj", ComponentName: "comp-a"},
+			Environment: "prod",
+			Endpoint:    "web",
+			Domain:      "shop.example.com",
+		},
+		Status: openchoreov1alpha1.CustomDomainStatus{
+			DNSRecords: []openchoreov1alpha1.DNSRecord{
+				{Type: "TXT", Name: "_openchoreo-challenge.shop.example.com", Value: "openchoreo-verification=abc", Purpose: "Verification"},
+			},
+		},
+	}
+}
+
+func TestCreateCustomDomainHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("nil body returns 400", func(t *testing.T) {
+		h := newHandlerWithCustomDomainService(customdomainsvcmocks.NewMockService(t))
+		resp, err := h.CreateCustomDomain(ctx, gen.CreateCustomDomainRequestObject{NamespaceName: ns})
+		require.NoError(t, err)
+		assert.IsType(t, gen.CreateCustomDomain400JSONResponse{}, resp)
+	})
+
+	t.Run("success returns created domain", func(t *testing.T) {
+		svc := customdomainsvcmocks.NewMockService(t)
+		svc.EXPECT().CreateCustomDomain(mock.Anything, ns, &customdomainsvc.CreateCustomDomainRequest{
+			ComponentName: "comp-a", Environment: "prod", Endpoint: "web", Domain: "shop.example.com",
+		}).Return(testCustomDomain(), nil)
+
+		resp, err := newHandlerWithCustomDomainService(svc).CreateCustomDomain(ctx, gen.CreateCustomDomainRequestObject{
+			NamespaceName: ns,
+			Body: &gen.CreateCustomDomainRequest{
+				ComponentName: "comp-a", Environment: "prod", Endpoint: "web", Domain: "shop.example.com",
+			},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.CreateCustomDomain201JSONResponse)
+		require.True(t, ok, "expected 201 response, got %T", resp)
+		assert.Equal(t, "comp-a-prod-abcde", typed.Metadata.Name)
+		assert.Equal(t, "shop.example.com", typed.Spec.Domain)
+		require.Len(t, *typed.Status.DnsRecords, 1)
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.CreateCustomDomain403JSONResponse{}},
+		{"component not found -> 404", componentsvc.ErrComponentNotFound, gen.CreateCustomDomain404JSONResponse{}},
+		{"environment not found -> 404", environmentsvc.ErrEnvironmentNotFound, gen.CreateCustomDomain404JSONResponse{}},
+		{"endpoint not external -> 400", customdomainsvc.ErrEndpointNotExternal, gen.CreateCustomDomain400JSONResponse{}},
+		{"validation -> 400", &svcpkg.ValidationError{Msg: "invalid domain"}, gen.CreateCustomDomain400JSONResponse{}},
+		{"already bound -> 409", customdomainsvc.ErrDomainAlreadyBound, gen.CreateCustomDomain409JSONResponse{}},
+		{"internal -> 500", errors.New("boom"), gen.CreateCustomDomain500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := customdomainsvcmocks.NewMockService(t)
+			svc.EXPECT().CreateCustomDomain(mock.Anything, ns, mock.Anything).Return(nil, tt.svcErr)
+			resp, err := newHandlerWithCustomDomainService(svc).CreateCustomDomain(ctx, gen.CreateCustomDomainRequestObject{
+				NamespaceName: ns,
+				Body:          &gen.CreateCustomDomainRequest{ComponentName: "comp-a", Environment: "prod", Endpoint: "web", Domain: "shop.example.com"},
+			})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
+func TestListCustomDomainsHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	svc := customdomainsvcmocks.NewMockService(t)
+	svc.EXPECT().ListCustomDomains(mock.Anything, ns, "comp-a", mock.Anything).Return(
+		&svcpkg.ListResult[openchoreov1alpha1.CustomDomain]{
+			Items: []openchoreov1alpha1.CustomDomain{*testCustomDomain()},
+		}, nil)
+
+	resp, err := newHandlerWithCustomDomainService(svc).ListCustomDomains(ctx, gen.ListCustomDomainsRequestObject{
+		NamespaceName: ns,
+		Params:        gen.ListCustomDomainsParams{Component: ptr.To("comp-a")},
+	})
+	require.NoError(t, err)
+	typed, ok := resp.(gen.ListCustomDomains200JSONResponse)
+	require.True(t, ok, "expected 200 response, got %T", resp)
+	require.Len(t, typed.Items, 1)
+	assert.Equal(t, "web", typed.Items[0].Spec.Endpoint)
+}
+
+func TestGetAndDeleteCustomDomainHandlers(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+	const name = "comp-a-prod-abcde"
+
+	t.Run("get returns domain", func(t *testing.T) {
+		svc := customdomainsvcmocks.NewMockService(t)
+		svc.EXPECT().GetCustomDomain(mock.Anything, ns, name).Return(testCustomDomain(), nil)
+
+		resp, err := newHandlerWithCustomDomainService(svc).GetCustomDomain(ctx, gen.GetCustomDomainRequestObject{
+			NamespaceName: ns, CustomDomainName: name,
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetCustomDomain200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, "prod", typed.Spec.Environment)
+	})
+
+	t.Run("delete returns 204", func(t *testing.T) {
+		svc := customdomainsvcmocks.NewMockService(t)
+		svc.EXPECT().DeleteCustomDomain(mock.Anything, ns, name).Return(nil)
+
+		resp, err := newHandlerWithCustomDomainService(svc).DeleteCustomDomain(ctx, gen.DeleteCustomDomainRequestObject{
+			NamespaceName: ns, CustomDomainName: name,
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.DeleteCustomDomain204Response{}, resp)
+	})
+
+	tests := []struct {
+		name       string
+		svcErr     error
+		wantGet    any
+		wantDelete any
+	}{
+		{"forbidden -> 403", svcpkg.ErrForbidden,
+			gen.GetCustomDomain403JSONResponse{}, gen.DeleteCustomDomain403JSONResponse{}},
+		{"not found -> 404", customdomainsvc.ErrCustomDomainNotFound,
+			gen.GetCustomDomain404JSONResponse{}, gen.DeleteCustomDomain404JSONResponse{}},
+		{"internal -> 500", errors.New("boom"),
+			gen.GetCustomDomain500JSONResponse{}, gen.DeleteCustomDomain500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := customdomainsvcmocks.NewMockService(t)
+			svc.EXPECT().GetCustomDomain(mock.Anything, ns, name).Return(nil, tt.svcErr)
+			svc.EXPECT().DeleteCustomDomain(mock.Anything, ns, name).Return(tt.svcErr)
+			h := newHandlerWithCustomDomainService(svc)
+
+			getResp, err := h.GetCustomDomain(ctx, gen.GetCustomDomainRequestObject{NamespaceName: ns, CustomDomainName: name})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantGet, getResp)
+
+			deleteResp, err := h.DeleteCustomDomain(ctx, gen.DeleteCustomDomainRequestObject{NamespaceName: ns, CustomDomainName: name})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantDelete, deleteResp)
+		})
+	}
+}
@@ -22,9 +22,11 @@ func (h *Handler) ListDataPlanes(
 ) (gen.ListDataPlanesResponseObject, error) {
 	h.logger.Debug("ListDataPlanes called", "namespaceName", request.NamespaceName)
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.DataPlaneService.ListDataPlanes(ctx, request.NamespaceName, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.DataPlane], error) {
+		return h.services.DataPlaneService.ListDataPlanes(ctx, request.NamespaceName, opts)
+	})
 	if err != nil {
 		if errors.Is(err, services.ErrForbidden) {
 			return gen.ListDataPlanes403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
@@ -193,3 +195,56 @@ func (h *Handler) DeleteDataPlane(
 	h.logger.Info("DataPlane deleted successfully", "namespaceName", request.NamespaceName, "dataPlane", request.DpName)
 	return gen.DeleteDataPlane204Response{}, nil
 }
+
+// RegisterDataPlane creates a data plane and verifies its connectivity and capabilities.
+func (h *Handler) RegisterDataPlane(
+	ctx context.Context,
+	request gen.RegisterDataPlaneRequestObject,
+) (gen.RegisterDataPlaneResponseObject, error) {
+	h.logger.Info("RegisterDataPlane called", "namespaceName", request.NamespaceName, "dpName", request.DpName)
+
+	if request.Body == nil {
+		return gen.RegisterDataPlane400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	dpCR, err := convert[gen.DataPlane, openchoreov1alpha1.DataPlane](*request.Body)
+	if err != nil {
+		h.logger.Error("Failed to convert register request", "error", err)
+		return gen.RegisterDataPlane400JSONResponse{BadRequestJSONResponse: badRequest("Invalid request body")}, nil
+	}
+	// Ensure the name from the URL path is used
+	dpCR.Name = request.DpName
+
+	registered, err := h.services.DataPlaneService.RegisterDataPlane(ctx, request.NamespaceName, &dpCR)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.RegisterDataPlane403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, dataplanesvc.ErrDataPlaneAlreadyExists) {
+			return gen.RegisterDataPlane409JSONResponse{ConflictJSONResponse: conflict("DataPlane already exists")}, nil
+		}
+		if errors.Is(err, dataplanesvc.ErrPlaneGatewayUnavailable) {
+			return gen.RegisterDataPlane501JSONResponse{NotImplementedJSONResponse: notImplemented("Data plane registration requires the cluster gateway")}, nil
+		}
+		if errors.Is(err, dataplanesvc.ErrDataPlaneVerificationFailed) {
+			return gen.RegisterDataPlane422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(err.Error())}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			if validationErr.StatusCode == http.StatusUnprocessableEntity {
+				return gen.RegisterDataPlane422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(validationErr.Msg)}, nil
+			}
+			return gen.RegisterDataPlane400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to register data plane", "error", err)
+		return gen.RegisterDataPlane500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genDP, err := convert[openchoreov1alpha1.DataPlane, gen.DataPlane](*registered)
+	if err != nil {
+		h.logger.Error("Failed to convert registered data plane", "error", err)
+		return gen.RegisterDataPlane500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("DataPlane registered successfully", "namespaceName", request.NamespaceName, "dataPlane", registered.Name)
+	return gen.RegisterDataPlane201JSONResponse(genDP), nil
+}
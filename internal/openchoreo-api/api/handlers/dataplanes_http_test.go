@@ -41,7 +41,7 @@ func newDataPlaneBundle(t *testing.T, objects []client.Object, pdp authzcore.PDP
 		WithScheme(newTestScheme(t)).
 		WithObjects(objects...).
 		Build()
-	svc := dataplanesvc.NewServiceWithAuthz(fc, pdp, slog.Default())
+	svc := dataplanesvc.NewServiceWithAuthz(fc, nil, pdp, slog.Default())
 	services := &handlerservices.Services{DataPlaneService: svc}
 	return dataPlaneBundle{
 		handler:    newTestHTTPHandler(t, services),
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
@@ -18,6 +19,7 @@ import (
 	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 	dataplanesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/dataplane"
+	dataplanemocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/dataplane/mocks"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
 )
 
@@ -27,7 +29,7 @@ func newDataPlaneService(t *testing.T, objects []client.Object, pdp authzcore.PD
 		WithScheme(newTestScheme(t)).
 		WithObjects(objects...).
 		Build()
-	return dataplanesvc.NewServiceWithAuthz(fakeClient, pdp, slog.Default())
+	return dataplanesvc.NewServiceWithAuthz(fakeClient, nil, pdp, slog.Default())
 }
 
 func newHandlerWithDataPlaneService(svc dataplanesvc.Service) *Handler {
@@ -211,6 +213,76 @@ func TestCreateDataPlaneHandler(t *testing.T) {
 	})
 }
 
+// --- RegisterDataPlane Handler ---
+
+func TestRegisterDataPlaneHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	validBody := &gen.DataPlane{
+		Metadata: gen.ObjectMeta{Name: "new-dp"},
+	}
+
+	t.Run("nil body returns 400", func(t *testing.T) {
+		svc := newDataPlaneService(t, nil, &allowAllPDP{})
+		h := newHandlerWithDataPlaneService(svc)
+
+		resp, err := h.RegisterDataPlane(ctx, gen.RegisterDataPlaneRequestObject{
+			NamespaceName: ns,
+			DpName:        "new-dp",
+			Body:          nil,
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.RegisterDataPlane400JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := newDataPlaneService(t, nil, &denyAllPDP{})
+		h := newHandlerWithDataPlaneService(svc)
+
+		resp, err := h.RegisterDataPlane(ctx, gen.RegisterDataPlaneRequestObject{
+			NamespaceName: ns,
+			DpName:        "new-dp",
+			Body:          validBody,
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.RegisterDataPlane403JSONResponse{}, resp)
+	})
+
+	t.Run("cluster gateway disabled returns 501", func(t *testing.T) {
+		svc := newDataPlaneService(t, nil, &allowAllPDP{})
+		h := newHandlerWithDataPlaneService(svc)
+
+		resp, err := h.RegisterDataPlane(ctx, gen.RegisterDataPlaneRequestObject{
+			NamespaceName: ns,
+			DpName:        "new-dp",
+			Body:          validBody,
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.RegisterDataPlane501JSONResponse{}, resp)
+	})
+
+	t.Run("verification failure returns 422", func(t *testing.T) {
+		svc := dataplanemocks.NewMockService(t)
+		svc.On("RegisterDataPlane", mock.Anything, ns, mock.MatchedBy(func(dp *openchoreov1alpha1.DataPlane) bool {
+			return dp.Name == "new-dp"
+		})).Return(nil, &dataplanesvc.VerificationError{Checks: []dataplanesvc.VerificationCheck{
+			{Name: "agent-connection", Required: true, Message: "agent did not connect", Hint: "check the agent logs"},
+		}})
+		h := newHandlerWithDataPlaneService(svc)
+
+		resp, err := h.RegisterDataPlane(ctx, gen.RegisterDataPlaneRequestObject{
+			NamespaceName: ns,
+			DpName:        "new-dp",
+			Body:          validBody,
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.RegisterDataPlane422JSONResponse)
+		require.True(t, ok, "expected 422 response, got %T", resp)
+		assert.Contains(t, typed.Error, "check the agent logs")
+	})
+}
+
 // --- UpdateDataPlane Handler ---
 
 func TestUpdateDataPlaneHandler(t *testing.T) {
@@ -0,0 +1,85 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcerrors "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	dependencysvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/dependency"
+)
+
+// GetDependencyGraph returns the connection graph between the components of a namespace.
+func (h *Handler) GetDependencyGraph(
+	ctx context.Context,
+	request gen.GetDependencyGraphRequestObject,
+) (gen.GetDependencyGraphResponseObject, error) {
+	h.logger.Debug("GetDependencyGraph called", "namespaceName", request.NamespaceName)
+
+	filter := dependencysvc.GraphFilter{
+		ProjectName:   ptr.Deref(request.Params.Project, ""),
+		ComponentName: ptr.Deref(request.Params.Component, ""),
+	}
+	graph, err := h.services.DependencyService.GetDependencyGraph(ctx, request.NamespaceName, filter)
+	if err != nil {
+		switch {
+		case errors.Is(err, svcerrors.ErrForbidden):
+			return gen.GetDependencyGraph403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, dependencysvc.ErrComponentNotFound):
+			return gen.GetDependencyGraph404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		}
+		h.logger.Error("Failed to get dependency graph", "error", err)
+		return gen.GetDependencyGraph500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.GetDependencyGraph200JSONResponse(toGenDependencyGraph(graph)), nil
+}
+
+func toGenDependencyGraph(graph *dependencysvc.Graph) gen.DependencyGraph {
+	out := gen.DependencyGraph{
+		Nodes: toGenDependencyGraphNodes(graph.Nodes),
+		Edges: toGenDependencyGraphEdges(graph.Edges),
+	}
+	if graph.Component != "" {
+		dependsOn := toGenDependencyGraphEdges(graph.DependsOn)
+		dependents := toGenDependencyGraphEdges(graph.Dependents)
+		impacted := toGenDependencyGraphNodes(graph.Impacted)
+		out.Component = &graph.Component
+		out.DependsOn = &dependsOn
+		out.Dependents = &dependents
+		out.Impacted = &impacted
+	}
+	return out
+}
+
+func toGenDependencyGraphNodes(nodes []dependencysvc.Node) []gen.DependencyGraphNode {
+	out := make([]gen.DependencyGraphNode, 0, len(nodes))
+	for _, n := range nodes {
+		out = append(out, gen.DependencyGraphNode{
+			Id:      n.ID,
+			Kind:    gen.DependencyGraphNodeKind(n.Kind),
+			Project: optionalString(n.Project),
+			Name:    n.Name,
+		})
+	}
+	return out
+}
+
+func toGenDependencyGraphEdges(edges []dependencysvc.Edge) []gen.DependencyGraphEdge {
+	out := make([]gen.DependencyGraphEdge, 0, len(edges))
+	for _, e := range edges {
+		out = append(out, gen.DependencyGraphEdge{
+			From:       e.From,
+			To:         e.To,
+			Type:       gen.DependencyGraphEdgeType(e.Type),
+			Endpoint:   optionalString(e.Endpoint),
+			Visibility: optionalString(e.Visibility),
+			Address:    optionalString(e.Address),
+		})
+	}
+	return out
+}
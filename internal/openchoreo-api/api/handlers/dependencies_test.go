@@ -0,0 +1,115 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	dependencysvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/dependency"
+	dependencysvcmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/dependency/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+)
+
+func newHandlerWithDependencyService(svc dependencysvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{DependencyService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func TestGetDependencyGraphHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success maps focused graph", func(t *testing.T) {
+		edge := dependencysvc.Edge{
+			From: "component:shop/frontend", To: "component:shop/orders",
+			Type: dependencysvc.EdgeTypeEndpoint, Endpoint: "api", Visibility: "project",
+		}
+		frontend := dependencysvc.Node{ID: "component:shop/frontend", Kind: dependencysvc.NodeKindComponent, Project: "shop", Name: "frontend"}
+		svc := dependencysvcmocks.NewMockService(t)
+		svc.EXPECT().GetDependencyGraph(mock.Anything, ns, dependencysvc.GraphFilter{ComponentName: "orders"}).
+			Return(&dependencysvc.Graph{
+				Nodes: []dependencysvc.Node{
+					frontend,
+					{ID: "external:payments", Kind: dependencysvc.NodeKindExternal, Name: "payments"},
+				},
+				Edges:      []dependencysvc.Edge{edge},
+				Component:  "component:shop/orders",
+				DependsOn:  []dependencysvc.Edge{},
+				Dependents: []dependencysvc.Edge{edge},
+				Impacted:   []dependencysvc.Node{frontend},
+			}, nil)
+
+		resp, err := newHandlerWithDependencyService(svc).GetDependencyGraph(ctx, gen.GetDependencyGraphRequestObject{
+			NamespaceName: ns,
+			Params:        gen.GetDependencyGraphParams{Component: ptr.To("orders")},
+		})
+		require.NoError(t, err)
+		graph, ok := resp.(gen.GetDependencyGraph200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, graph.Nodes, 2)
+		assert.Equal(t, "shop", ptr.Deref(graph.Nodes[0].Project, ""))
+		assert.Nil(t, graph.Nodes[1].Project)
+		require.Len(t, graph.Edges, 1)
+		assert.Equal(t, gen.DependencyGraphEdgeType("endpoint"), graph.Edges[0].Type)
+		assert.Equal(t, "api", ptr.Deref(graph.Edges[0].Endpoint, ""))
+		assert.Nil(t, graph.Edges[0].Address)
+		assert.Equal(t, "component:shop/orders", ptr.Deref(graph.Component, ""))
+		require.NotNil(t, graph.DependsOn)
+		assert.Empty(t, *graph.DependsOn)
+		require.NotNil(t, graph.Impacted)
+		assert.Equal(t, "component:shop/frontend", (*graph.Impacted)[0].Id)
+	})
+
+	t.Run("unfocused graph omits focus fields", func(t *testing.T) {
+		svc := dependencysvcmocks.NewMockService(t)
+		svc.EXPECT().GetDependencyGraph(mock.Anything, ns, dependencysvc.GraphFilter{ProjectName: "shop"}).
+			Return(&dependencysvc.Graph{Nodes: []dependencysvc.Node{}, Edges: []dependencysvc.Edge{}}, nil)
+
+		resp, err := newHandlerWithDependencyService(svc).GetDependencyGraph(ctx, gen.GetDependencyGraphRequestObject{
+			NamespaceName: ns,
+			Params:        gen.GetDependencyGraphParams{Project: ptr.To("shop")},
+		})
+		require.NoError(t, err)
+		graph, ok := resp.(gen.GetDependencyGraph200JSONResponse)
+		require.True(t, ok)
+		assert.NotNil(t, graph.Nodes)
+		assert.Nil(t, graph.Component)
+		assert.Nil(t, graph.Impacted)
+	})
+
+	errCases := []struct {
+		name string
+		err  error
+		want any
+	}{
+		{"forbidden", svcpkg.ErrForbidden, gen.GetDependencyGraph403JSONResponse{}},
+		{"component not found", fmt.Errorf("%w: missing", dependencysvc.ErrComponentNotFound), gen.GetDependencyGraph404JSONResponse{}},
+		{"internal error", errors.New("boom"), gen.GetDependencyGraph500JSONResponse{}},
+	}
+	for _, tc := range errCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := dependencysvcmocks.NewMockService(t)
+			svc.EXPECT().GetDependencyGraph(mock.Anything, ns, mock.Anything).Return(nil, tc.err)
+
+			resp, err := newHandlerWithDependencyService(svc).GetDependencyGraph(ctx, gen.GetDependencyGraphRequestObject{
+				NamespaceName: ns,
+			})
+			require.NoError(t, err)
+			assert.IsType(t, tc.want, resp)
+		})
+	}
+}
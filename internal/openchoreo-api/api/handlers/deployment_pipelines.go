@@ -21,9 +21,11 @@ func (h *Handler) ListDeploymentPipelines(
 ) (gen.ListDeploymentPipelinesResponseObject, error) {
 	h.logger.Debug("ListDeploymentPipelines called", "namespaceName", request.NamespaceName)
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.DeploymentPipelineService.ListDeploymentPipelines(ctx, request.NamespaceName, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.DeploymentPipeline], error) {
+		return h.services.DeploymentPipelineService.ListDeploymentPipelines(ctx, request.NamespaceName, opts)
+	})
 	if err != nil {
 		if errors.Is(err, services.ErrForbidden) {
 			return gen.ListDeploymentPipelines403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
@@ -188,3 +190,46 @@ func (h *Handler) DeleteDeploymentPipeline(
 	h.logger.Info("Deployment pipeline deleted successfully", "namespaceName", request.NamespaceName, "deploymentPipeline", request.DeploymentPipelineName)
 	return gen.DeleteDeploymentPipeline204Response{}, nil
 }
+
+// GetDeploymentPipelineGraph returns the environments and promotion paths of a deployment pipeline as a graph.
+func (h *Handler) GetDeploymentPipelineGraph(
+	ctx context.Context,
+	request gen.GetDeploymentPipelineGraphRequestObject,
+) (gen.GetDeploymentPipelineGraphResponseObject, error) {
+	h.logger.Debug("GetDeploymentPipelineGraph called", "namespaceName", request.NamespaceName, "deploymentPipelineName", request.DeploymentPipelineName)
+
+	graph, err := h.services.DeploymentPipelineService.GetDeploymentPipelineGraph(ctx, request.NamespaceName, request.DeploymentPipelineName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.GetDeploymentPipelineGraph403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, deploymentpipelinesvc.ErrDeploymentPipelineNotFound) {
+			return gen.GetDeploymentPipelineGraph404JSONResponse{NotFoundJSONResponse: notFound("DeploymentPipeline")}, nil
+		}
+		h.logger.Error("Failed to get deployment pipeline graph", "error", err)
+		return gen.GetDeploymentPipelineGraph500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	resp := gen.GetDeploymentPipelineGraph200JSONResponse{
+		Name:   graph.Name,
+		Nodes:  make([]gen.DeploymentPipelineNode, 0, len(graph.Nodes)),
+		Edges:  make([]gen.DeploymentPipelineEdge, 0, len(graph.Edges)),
+		Issues: append([]string{}, graph.Issues...),
+	}
+	for _, node := range graph.Nodes {
+		resp.Nodes = append(resp.Nodes, gen.DeploymentPipelineNode{
+			Environment:  node.Environment,
+			Stage:        node.Stage,
+			IsProduction: node.IsProduction,
+			Exists:       node.Exists,
+		})
+	}
+	for _, edge := range graph.Edges {
+		resp.Edges = append(resp.Edges, gen.DeploymentPipelineEdge{
+			Source:           edge.Source,
+			Target:           edge.Target,
+			RequiresApproval: edge.RequiresApproval,
+		})
+	}
+	return resp, nil
+}
@@ -171,6 +171,29 @@ func TestCreateDeploymentPipelineHandler(t *testing.T) {
 		assert.IsType(t, gen.CreateDeploymentPipeline400JSONResponse{}, resp)
 	})
 
+	t.Run("promotion path to missing environment returns 400", func(t *testing.T) {
+		svc := newDeploymentPipelineService(t, nil, &allowAllPDP{})
+		h := newHandlerWithDeploymentPipelineService(svc)
+
+		resp, err := h.CreateDeploymentPipeline(ctx, gen.CreateDeploymentPipelineRequestObject{
+			NamespaceName: ns,
+			Body: &gen.DeploymentPipeline{
+				Metadata: gen.ObjectMeta{Name: "new-dp"},
+				Spec: &gen.DeploymentPipelineSpec{PromotionPaths: &[]gen.PromotionPath{{
+					SourceEnvironmentRef: struct {
+						Kind *gen.PromotionPathSourceEnvironmentRefKind `json:"kind,omitempty"`
+						Name string                                     `json:"name"`
+					}{Name: "dev"},
+					TargetEnvironmentRefs: []gen.TargetEnvironmentRef{{Name: "prod"}},
+				}}},
+			},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.CreateDeploymentPipeline400JSONResponse)
+		require.True(t, ok, "expected 400 response, got %T", resp)
+		assert.Contains(t, typed.Error, `environment "dev" does not exist`)
+	})
+
 	t.Run("already exists returns 409", func(t *testing.T) {
 		existing := testDeploymentPipelineObj("new-dp")
 		svc := newDeploymentPipelineService(t, []client.Object{existing}, &allowAllPDP{})
@@ -315,3 +338,60 @@ func TestDeleteDeploymentPipelineHandler(t *testing.T) {
 		assert.IsType(t, gen.DeleteDeploymentPipeline403JSONResponse{}, resp)
 	})
 }
+
+// --- GetDeploymentPipelineGraph Handler ---
+
+func TestGetDeploymentPipelineGraphHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	dp := testDeploymentPipelineObj("dp-1")
+	dp.Spec.PromotionPaths = []openchoreov1alpha1.PromotionPath{{
+		SourceEnvironmentRef:  openchoreov1alpha1.EnvironmentRef{Name: "dev"},
+		TargetEnvironmentRefs: []openchoreov1alpha1.TargetEnvironmentRef{{Name: "prod", RequiresApproval: true}},
+	}}
+	dev := &openchoreov1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: ns}}
+
+	t.Run("success", func(t *testing.T) {
+		svc := newDeploymentPipelineService(t, []client.Object{dp, dev}, &allowAllPDP{})
+		h := newHandlerWithDeploymentPipelineService(svc)
+
+		resp, err := h.GetDeploymentPipelineGraph(ctx, gen.GetDeploymentPipelineGraphRequestObject{
+			NamespaceName:          ns,
+			DeploymentPipelineName: "dp-1",
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetDeploymentPipelineGraph200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, []gen.DeploymentPipelineNode{
+			{Environment: "dev", Stage: 0, Exists: true},
+			{Environment: "prod", Stage: 1},
+		}, typed.Nodes)
+		assert.Equal(t, []gen.DeploymentPipelineEdge{{Source: "dev", Target: "prod", RequiresApproval: true}}, typed.Edges)
+		assert.Equal(t, []string{`environment "prod" does not exist`}, typed.Issues)
+	})
+
+	t.Run("not found returns 404", func(t *testing.T) {
+		svc := newDeploymentPipelineService(t, nil, &allowAllPDP{})
+		h := newHandlerWithDeploymentPipelineService(svc)
+
+		resp, err := h.GetDeploymentPipelineGraph(ctx, gen.GetDeploymentPipelineGraphRequestObject{
+			NamespaceName:          ns,
+			DeploymentPipelineName: "nonexistent",
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetDeploymentPipelineGraph404JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := newDeploymentPipelineService(t, []client.Object{dp}, &denyAllPDP{})
+		h := newHandlerWithDeploymentPipelineService(svc)
+
+		resp, err := h.GetDeploymentPipelineGraph(ctx, gen.GetDeploymentPipelineGraphRequestObject{
+			NamespaceName:          ns,
+			DeploymentPipelineName: "dp-1",
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetDeploymentPipelineGraph403JSONResponse{}, resp)
+	})
+}
@@ -0,0 +1,200 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcerrors "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	deploymentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/deployment"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+)
+
+// DeployComponent deploys an image, given directly or built by a workflow run, to an environment.
+func (h *Handler) DeployComponent(
+	ctx context.Context,
+	request gen.DeployComponentRequestObject,
+) (gen.DeployComponentResponseObject, error) {
+	h.logger.Info("DeployComponent called",
+		"namespaceName", request.NamespaceName,
+		"componentName", request.ComponentName)
+
+	if request.Body == nil {
+		return gen.DeployComponent400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	result, err := h.services.DeploymentService.Deploy(ctx, request.NamespaceName, &deploymentsvc.DeployRequest{
+		ComponentName: request.ComponentName,
+		Environment:   request.Body.Environment,
+		Image:         ptr.Deref(request.Body.Image, ""),
+		BuildName:     ptr.Deref(request.Body.BuildName, ""),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, svcerrors.ErrForbidden):
+			return gen.DeployComponent403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, componentsvc.ErrComponentNotFound):
+			return gen.DeployComponent404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		case errors.Is(err, componentsvc.ErrWorkloadNotFound):
+			return gen.DeployComponent404JSONResponse{NotFoundJSONResponse: notFound("Workload")}, nil
+		case errors.Is(err, componentsvc.ErrComponentTypeNotFound):
+			return gen.DeployComponent404JSONResponse{NotFoundJSONResponse: notFound("ComponentType")}, nil
+		case errors.Is(err, componentsvc.ErrTraitNotFound):
+			return gen.DeployComponent404JSONResponse{NotFoundJSONResponse: notFound("Trait")}, nil
+		case errors.Is(err, projectsvc.ErrProjectNotFound):
+			return gen.DeployComponent404JSONResponse{NotFoundJSONResponse: notFound("Project")}, nil
+		case errors.Is(err, projectsvc.ErrDeploymentPipelineNotFound):
+			return gen.DeployComponent404JSONResponse{NotFoundJSONResponse: notFound("DeploymentPipeline")}, nil
+		case errors.Is(err, deploymentsvc.ErrBuildNotFound):
+			return gen.DeployComponent404JSONResponse{NotFoundJSONResponse: notFound("WorkflowRun")}, nil
+		case errors.Is(err, deploymentsvc.ErrEnvironmentNotInPipeline),
+			errors.Is(err, componentsvc.ErrValidation):
+			return gen.DeployComponent400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		case errors.Is(err, deploymentsvc.ErrBuildNotReady):
+			return gen.DeployComponent422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(err.Error())}, nil
+		}
+		if validationErr, ok := errors.AsType[*svcerrors.ValidationError](err); ok {
+			if validationErr.StatusCode == http.StatusUnprocessableEntity {
+				return gen.DeployComponent422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(validationErr.Msg)}, nil
+			}
+			return gen.DeployComponent400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to deploy component", "error", err)
+		return gen.DeployComponent500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genRB, err := convert[openchoreov1alpha1.ReleaseBinding, gen.ReleaseBinding](*result.ReleaseBinding)
+	if err != nil {
+		h.logger.Error("Failed to convert release binding", "error", err)
+		return gen.DeployComponent500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.DeployComponent200JSONResponse{
+		Image:          result.Image,
+		ReleaseName:    result.Release.Name,
+		ReleaseBinding: genRB,
+		Created:        result.Created,
+	}, nil
+}
+
+// GetDeploymentStatus returns the rollout progress of a component in an environment.
+func (h *Handler) GetDeploymentStatus(
+	ctx context.Context,
+	request gen.GetDeploymentStatusRequestObject,
+) (gen.GetDeploymentStatusResponseObject, error) {
+	h.logger.Debug("GetDeploymentStatus called",
+		"namespaceName", request.NamespaceName,
+		"componentName", request.ComponentName,
+		"envName", request.EnvName)
+
+	status, err := h.services.DeploymentService.GetDeploymentStatus(ctx, request.NamespaceName, request.ComponentName, request.EnvName)
+	if err != nil {
+		switch {
+		case errors.Is(err, svcerrors.ErrForbidden):
+			return gen.GetDeploymentStatus403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, componentsvc.ErrComponentNotFound):
+			return gen.GetDeploymentStatus404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		case errors.Is(err, deploymentsvc.ErrDeploymentNotFound):
+			return gen.GetDeploymentStatus404JSONResponse{NotFoundJSONResponse: notFound("Deployment")}, nil
+		}
+		h.logger.Error("Failed to get deployment status", "error", err)
+		return gen.GetDeploymentStatus500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	conditions, err := convertList[metav1.Condition, gen.Condition](status.Conditions)
+	if err != nil {
+		h.logger.Error("Failed to convert deployment conditions", "error", err)
+		return gen.GetDeploymentStatus500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	endpoints, err := convertList[openchoreov1alpha1.EndpointURLStatus, gen.EndpointURLStatus](status.Endpoints)
+	if err != nil {
+		h.logger.Error("Failed to convert deployment endpoints", "error", err)
+		return gen.GetDeploymentStatus500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.GetDeploymentStatus200JSONResponse{
+		ComponentName:      status.ComponentName,
+		Environment:        status.Environment,
+		ReleaseBindingName: status.ReleaseBindingName,
+		ReleaseName:        optionalString(status.ReleaseName),
+		Image:              optionalString(status.Image),
+		Phase:              string(status.Phase),
+		Message:            optionalString(status.Message),
+		Conditions:         &conditions,
+		Endpoints:          &endpoints,
+	}, nil
+}
+
+// GetProjectComponentHealth returns the health of every component of a project across its environments.
+func (h *Handler) GetProjectComponentHealth(
+	ctx context.Context,
+	request gen.GetProjectComponentHealthRequestObject,
+) (gen.GetProjectComponentHealthResponseObject, error) {
+	h.logger.Debug("GetProjectComponentHealth called",
+		"namespaceName", request.NamespaceName,
+		"projectName", request.ProjectName)
+
+	health, err := h.services.DeploymentService.GetProjectHealth(ctx, request.NamespaceName, request.ProjectName)
+	if err != nil {
+		switch {
+		case errors.Is(err, svcerrors.ErrForbidden):
+			return gen.GetProjectComponentHealth403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, projectsvc.ErrProjectNotFound):
+			return gen.GetProjectComponentHealth404JSONResponse{NotFoundJSONResponse: notFound("Project")}, nil
+		}
+		h.logger.Error("Failed to get project component health", "error", err)
+		return gen.GetProjectComponentHealth500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	components := make([]gen.ComponentHealth, 0, len(health.Components))
+	for _, ch := range health.Components {
+		environments := make([]gen.ComponentEnvironmentHealth, 0, len(ch.Environments))
+		for _, eh := range ch.Environments {
+			environments = append(environments, toGenEnvironmentHealth(eh))
+		}
+		components = append(components, gen.ComponentHealth{
+			ComponentName: ch.ComponentName,
+			Environments:  environments,
+		})
+	}
+
+	return gen.GetProjectComponentHealth200JSONResponse{
+		ProjectName:  health.ProjectName,
+		Environments: health.Environments,
+		Components:   components,
+	}, nil
+}
+
+func toGenEnvironmentHealth(eh deploymentsvc.EnvironmentHealth) gen.ComponentEnvironmentHealth {
+	out := gen.ComponentEnvironmentHealth{
+		Environment:        eh.Environment,
+		Health:             gen.ComponentEnvironmentHealthHealth(eh.Health),
+		ReleaseBindingName: optionalString(eh.ReleaseBindingName),
+		ReleaseName:        optionalString(eh.ReleaseName),
+		Image:              optionalString(eh.Image),
+		ConfigChecksum:     optionalString(eh.ConfigChecksum),
+		Phase:              optionalString(string(eh.Phase)),
+		Message:            optionalString(eh.Message),
+	}
+	if !eh.LastDeployedAt.IsZero() {
+		out.LastDeployedAt = ptr.To(eh.LastDeployedAt)
+	}
+	if eh.Replicas != nil {
+		out.Replicas = &gen.ReplicaStatus{
+			Desired:   eh.Replicas.Desired,
+			Ready:     eh.Replicas.Ready,
+			Available: eh.Replicas.Available,
+			Updated:   eh.Replicas.Updated,
+		}
+	}
+	return out
+}
@@ -0,0 +1,235 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	deploymentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/deployment"
+	deploymentsvcmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/deployment/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+)
+
+func newHandlerWithDeploymentService(svc deploymentsvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{DeploymentService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func TestDeployComponentHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("nil body returns 400", func(t *testing.T) {
+		h := newHandlerWithDeploymentService(deploymentsvcmocks.NewMockService(t))
+		resp, err := h.DeployComponent(ctx, gen.DeployComponentRequestObject{NamespaceName: ns, ComponentName: "comp-a"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.DeployComponent400JSONResponse{}, resp)
+	})
+
+	t.Run("success forwards build and returns binding", func(t *testing.T) {
+		svc := deploymentsvcmocks.NewMockService(t)
+		svc.EXPECT().Deploy(mock.Anything, ns, mock.Anything).RunAndReturn(
+			func(_ context.Context, _ string, req *deploymentsvc.DeployRequest) (*deploymentsvc.DeployResult, error) {
+				assert.Equal(t, "comp-a", req.ComponentName)
+				assert.Equal(t, "dev", req.Environment)
+				assert.Equal(t, "build-1", req.BuildName)
+				assert.Empty(t, req.Image)
+				return &deploymentsvc.DeployResult{
+					Image:   "registry.example.com/app:v1",
+					Release: &openchoreov1alpha1.ComponentRelease{ObjectMeta: metav1.ObjectMeta{Name: "comp-a-20260101-1"}},
+					ReleaseBinding: &openchoreov1alpha1.ReleaseBinding{
+						ObjectMeta: metav1.ObjectMeta{Name: "comp-a-dev", Namespace: ns},
+						Spec:       openchoreov1alpha1.ReleaseBindingSpec{Environment: "dev", ReleaseName: "comp-a-20260101-1"},
+					},
+					Created: true,
+				}, nil
+			})
+
+		resp, err := newHandlerWithDeploymentService(svc).DeployComponent(ctx, gen.DeployComponentRequestObject{
+			NamespaceName: ns,
+			ComponentName: "comp-a",
+			Body:          &gen.DeployComponentRequest{Environment: "dev", BuildName: ptr.To("build-1")},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.DeployComponent200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, "registry.example.com/app:v1", typed.Image)
+		assert.Equal(t, "comp-a-20260101-1", typed.ReleaseName)
+		assert.Equal(t, "comp-a-dev", typed.ReleaseBinding.Metadata.Name)
+		assert.True(t, typed.Created)
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.DeployComponent403JSONResponse{}},
+		{"component not found -> 404", componentsvc.ErrComponentNotFound, gen.DeployComponent404JSONResponse{}},
+		{"build not found -> 404", deploymentsvc.ErrBuildNotFound, gen.DeployComponent404JSONResponse{}},
+		{"environment not in pipeline -> 400", deploymentsvc.ErrEnvironmentNotInPipeline, gen.DeployComponent400JSONResponse{}},
+		{"validation -> 400", &svcpkg.ValidationError{Msg: "exactly one"}, gen.DeployComponent400JSONResponse{}},
+		{"build not ready -> 422", deploymentsvc.ErrBuildNotReady, gen.DeployComponent422JSONResponse{}},
+		{"internal -> 500", errors.New("boom"), gen.DeployComponent500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := deploymentsvcmocks.NewMockService(t)
+			svc.EXPECT().Deploy(mock.Anything, ns, mock.Anything).Return(nil, tt.svcErr)
+			resp, err := newHandlerWithDeploymentService(svc).DeployComponent(ctx, gen.DeployComponentRequestObject{
+				NamespaceName: ns,
+				ComponentName: "comp-a",
+				Body:          &gen.DeployComponentRequest{Environment: "dev", Image: ptr.To("img")},
+			})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
+func TestGetDeploymentStatusHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success", func(t *testing.T) {
+		svc := deploymentsvcmocks.NewMockService(t)
+		svc.EXPECT().GetDeploymentStatus(mock.Anything, ns, "comp-a", "dev").Return(&deploymentsvc.Status{
+			ComponentName:      "comp-a",
+			Environment:        "dev",
+			ReleaseBindingName: "comp-a-dev",
+			ReleaseName:        "rel-1",
+			Image:              "registry.example.com/app:v1",
+			Phase:              deploymentsvc.PhaseProgressing,
+			Message:            "rolling out",
+			Conditions:         []metav1.Condition{{Type: "Ready", Status: metav1.ConditionFalse, Reason: "ResourcesProgressing"}},
+		}, nil)
+
+		resp, err := newHandlerWithDeploymentService(svc).GetDeploymentStatus(ctx, gen.GetDeploymentStatusRequestObject{
+			NamespaceName: ns, ComponentName: "comp-a", EnvName: "dev",
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetDeploymentStatus200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, "Progressing", typed.Phase)
+		assert.Equal(t, "rel-1", *typed.ReleaseName)
+		assert.Equal(t, "registry.example.com/app:v1", *typed.Image)
+		require.Len(t, *typed.Conditions, 1)
+		assert.Equal(t, "ResourcesProgressing", (*typed.Conditions)[0].Reason)
+		assert.Empty(t, *typed.Endpoints)
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.GetDeploymentStatus403JSONResponse{}},
+		{"component not found -> 404", componentsvc.ErrComponentNotFound, gen.GetDeploymentStatus404JSONResponse{}},
+		{"not deployed -> 404", deploymentsvc.ErrDeploymentNotFound, gen.GetDeploymentStatus404JSONResponse{}},
+		{"internal -> 500", errors.New("boom"), gen.GetDeploymentStatus500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := deploymentsvcmocks.NewMockService(t)
+			svc.EXPECT().GetDeploymentStatus(mock.Anything, ns, "comp-a", "dev").Return(nil, tt.svcErr)
+			resp, err := newHandlerWithDeploymentService(svc).GetDeploymentStatus(ctx, gen.GetDeploymentStatusRequestObject{
+				NamespaceName: ns, ComponentName: "comp-a", EnvName: "dev",
+			})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
+func TestGetProjectComponentHealthHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success", func(t *testing.T) {
+		svc := deploymentsvcmocks.NewMockService(t)
+		svc.EXPECT().GetProjectHealth(mock.Anything, ns, "proj-a").Return(&deploymentsvc.ProjectHealth{
+			ProjectName:  "proj-a",
+			Environments: []string{"dev", "prod"},
+			Components: []deploymentsvc.ComponentHealth{{
+				ComponentName: "comp-a",
+				Environments: []deploymentsvc.EnvironmentHealth{
+					{
+						Environment:        "dev",
+						ReleaseBindingName: "comp-a-dev",
+						ReleaseName:        "rel-1",
+						ConfigChecksum:     "sha256:abc",
+						Phase:              deploymentsvc.PhaseReady,
+						Health:             deploymentsvc.HealthHealthy,
+						Replicas:           &deploymentsvc.ReplicaStatus{Desired: 2, Ready: 2, Available: 2, Updated: 2},
+						LastDeployedAt:     time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+					},
+					{Environment: "prod", Health: deploymentsvc.HealthNotDeployed},
+				},
+			}},
+		}, nil)
+
+		resp, err := newHandlerWithDeploymentService(svc).GetProjectComponentHealth(ctx, gen.GetProjectComponentHealthRequestObject{
+			NamespaceName: ns, ProjectName: "proj-a",
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetProjectComponentHealth200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, []string{"dev", "prod"}, typed.Environments)
+		require.Len(t, typed.Components, 1)
+		require.Len(t, typed.Components[0].Environments, 2)
+		dev := typed.Components[0].Environments[0]
+		assert.Equal(t, gen.ComponentEnvironmentHealthHealth("Healthy"), dev.Health)
+		assert.Equal(t, "rel-1", *dev.ReleaseName)
+		assert.Equal(t, "sha256:abc", *dev.ConfigChecksum)
+		assert.Equal(t, "Ready", *dev.Phase)
+		assert.Equal(t, int32(2), dev.Replicas.Ready)
+		require.NotNil(t, dev.LastDeployedAt)
+		prod := typed.Components[0].Environments[1]
+		assert.Equal(t, gen.ComponentEnvironmentHealthHealth("NotDeployed"), prod.Health)
+		assert.Nil(t, prod.ReleaseName)
+		assert.Nil(t, prod.ConfigChecksum)
+		assert.Nil(t, prod.Phase)
+		assert.Nil(t, prod.Replicas)
+		assert.Nil(t, prod.LastDeployedAt)
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.GetProjectComponentHealth403JSONResponse{}},
+		{"project not found -> 404", projectsvc.ErrProjectNotFound, gen.GetProjectComponentHealth404JSONResponse{}},
+		{"internal -> 500", errors.New("boom"), gen.GetProjectComponentHealth500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := deploymentsvcmocks.NewMockService(t)
+			svc.EXPECT().GetProjectHealth(mock.Anything, ns, "proj-a").Return(nil, tt.svcErr)
+			resp, err := newHandlerWithDeploymentService(svc).GetProjectComponentHealth(ctx, gen.GetProjectComponentHealthRequestObject{
+				NamespaceName: ns, ProjectName: "proj-a",
+			})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcerrors "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	discoverysvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/discovery"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+)
+
+// DiscoverComponents scans Git repositories and proposes components for them.
+func (h *Handler) DiscoverComponents(
+	ctx context.Context,
+	request gen.DiscoverComponentsRequestObject,
+) (gen.DiscoverComponentsResponseObject, error) {
+	h.logger.Debug("DiscoverComponents called", "namespaceName", request.NamespaceName)
+
+	if request.Body == nil {
+		return gen.DiscoverComponents400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	result, err := h.services.DiscoveryService.DiscoverComponents(ctx, request.NamespaceName, &discoverysvc.DiscoverRequest{
+		ProjectName:    request.Body.ProjectName,
+		RepositoryURLs: ptr.Deref(request.Body.RepositoryUrls, nil),
+		OwnerURL:       ptr.Deref(request.Body.OwnerUrl, ""),
+		Ref:            ptr.Deref(request.Body.Ref, ""),
+		Token:          ptr.Deref(request.Body.Token, ""),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, svcerrors.ErrForbidden):
+			return gen.DiscoverComponents403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, projectsvc.ErrProjectNotFound):
+			return gen.DiscoverComponents404JSONResponse{NotFoundJSONResponse: notFound("Project")}, nil
+		}
+		if validationErr, ok := errors.AsType[*svcerrors.ValidationError](err); ok {
+			return gen.DiscoverComponents400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to discover components", "error", err)
+		return gen.DiscoverComponents500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	resp := gen.DiscoverComponents200JSONResponse{
+		Proposals: make([]gen.ComponentProposal, 0, len(result.Proposals)),
+		Skipped: make([]struct {
+			Reason        string `json:"reason"`
+			RepositoryUrl string `json:"repositoryUrl"`
+		}, 0, len(result.Skipped)),
+	}
+	for i := range result.Proposals {
+		resp.Proposals = append(resp.Proposals, toGenComponentProposal(&result.Proposals[i]))
+	}
+	for _, skipped := range result.Skipped {
+		resp.Skipped = append(resp.Skipped, struct {
+			Reason        string `json:"reason"`
+			RepositoryUrl string `json:"repositoryUrl"`
+		}{Reason: skipped.Reason, RepositoryUrl: skipped.RepositoryURL})
+	}
+	return resp, nil
+}
+
+// AcceptComponentProposals creates components from discovery proposals.
+func (h *Handler) AcceptComponentProposals(
+	ctx context.Context,
+	request gen.AcceptComponentProposalsRequestObject,
+) (gen.AcceptComponentProposalsResponseObject, error) {
+	h.logger.Info("AcceptComponentProposals called", "namespaceName", request.NamespaceName)
+
+	if request.Body == nil {
+		return gen.AcceptComponentProposals400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	req := &discoverysvc.AcceptRequest{
+		ProjectName: request.Body.ProjectName,
+		ClassName:   ptr.Deref(request.Body.ClassName, ""),
+		ClassKind:   openchoreov1alpha1.ComponentTypeRefKind(ptr.Deref(request.Body.ClassKind, "")),
+		AutoDeploy:  ptr.Deref(request.Body.AutoDeploy, false),
+		Proposals:   make([]discoverysvc.Proposal, 0, len(request.Body.Proposals)),
+	}
+	for i := range request.Body.Proposals {
+		req.Proposals = append(req.Proposals, fromGenComponentProposal(&request.Body.Proposals[i]))
+	}
+
+	results, err := h.services.DiscoveryService.AcceptProposals(ctx, request.NamespaceName, req)
+	if err != nil {
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.AcceptComponentProposals403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if validationErr, ok := errors.AsType[*svcerrors.ValidationError](err); ok {
+			return gen.AcceptComponentProposals400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to accept component proposals", "error", err)
+		return gen.AcceptComponentProposals500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	resp := gen.AcceptComponentProposals200JSONResponse{}
+	for _, result := range results {
+		item := struct {
+			Component *gen.Component `json:"component,omitempty"`
+			Error     *string        `json:"error,omitempty"`
+			Name      string         `json:"name"`
+		}{Name: result.Name}
+		switch {
+		case result.Error != nil:
+			item.Error = ptr.To(h.acceptErrorMessage(result.Name, result.Error))
+		case result.Component != nil:
+			genComponent, err := convert[openchoreov1alpha1.Component, gen.Component](*result.Component)
+			if err != nil {
+				h.logger.Error("Failed to convert component", "error", err)
+				return gen.AcceptComponentProposals500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+			}
+			item.Component = &genComponent
+		}
+		resp.Results = append(resp.Results, item)
+	}
+	return resp, nil
+}
+
+// acceptErrorMessage returns the message reported for a proposal that could
+// not be accepted. Unexpected errors are logged rather than exposed.
+func (h *Handler) acceptErrorMessage(name string, err error) string {
+	switch {
+	case errors.Is(err, svcerrors.ErrForbidden):
+		return "You do not have permission to create this component"
+	case errors.Is(err, componentsvc.ErrComponentAlreadyExists),
+		errors.Is(err, componentsvc.ErrComponentTypeNotFound),
+		errors.Is(err, componentsvc.ErrDescriptorNotFound),
+		errors.Is(err, componentsvc.ErrTraitNotFound),
+		errors.Is(err, componentsvc.ErrValidation),
+		errors.Is(err, projectsvc.ErrProjectNotFound):
+		return err.Error()
+	}
+	if validationErr, ok := errors.AsType[*svcerrors.ValidationError](err); ok {
+		return validationErr.Msg
+	}
+	h.logger.Error("Failed to create component from proposal", "component", name, "error", err)
+	return "Internal server error"
+}
+
+func toGenComponentProposal(p *discoverysvc.Proposal) gen.ComponentProposal {
+	out := gen.ComponentProposal{
+		Name:           p.Name,
+		RepositoryUrl:  p.RepositoryURL,
+		Ref:            p.Ref,
+		AppPath:        p.AppPath,
+		ClassName:      optionalString(p.ClassName),
+		Language:       optionalString(p.Language),
+		DockerfilePath: optionalString(p.DockerfilePath),
+		Workflow:       optionalString(p.Workflow),
+		DescriptorPath: optionalString(p.DescriptorPath),
+	}
+	if p.Type != "" {
+		out.Type = ptr.To(gen.ComponentProposalType(p.Type))
+	}
+	if p.Port != 0 {
+		out.Port = ptr.To(p.Port)
+	}
+	if p.Build != "" {
+		out.Build = ptr.To(gen.ComponentProposalBuild(p.Build))
+	}
+	if len(p.WorkflowParameters) > 0 {
+		out.WorkflowParameters = &p.WorkflowParameters
+	}
+	if len(p.Evidence) > 0 {
+		out.Evidence = &p.Evidence
+	}
+	return out
+}
+
+func fromGenComponentProposal(p *gen.ComponentProposal) discoverysvc.Proposal {
+	return discoverysvc.Proposal{
+		Name:               p.Name,
+		RepositoryURL:      p.RepositoryUrl,
+		Ref:                p.Ref,
+		AppPath:            p.AppPath,
+		Type:               openchoreov1alpha1.WorkloadType(ptr.Deref(p.Type, "")),
+		ClassName:          ptr.Deref(p.ClassName, ""),
+		Language:           ptr.Deref(p.Language, ""),
+		Port:               ptr.Deref(p.Port, 0),
+		Build:              discoverysvc.BuildStrategy(ptr.Deref(p.Build, "")),
+		DockerfilePath:     ptr.Deref(p.DockerfilePath, ""),
+		Workflow:           ptr.Deref(p.Workflow, ""),
+		WorkflowParameters: ptr.Deref(p.WorkflowParameters, nil),
+		DescriptorPath:     ptr.Deref(p.DescriptorPath, ""),
+		Evidence:           ptr.Deref(p.Evidence, nil),
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcerrors "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	discoverysvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/discovery"
+	discoverysvcmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/discovery/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+)
+
+func newHandlerWithDiscoveryService(svc discoverysvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{DiscoveryService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func TestDiscoverComponentsHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+	request := gen.DiscoverComponentsRequestObject{
+		NamespaceName: ns,
+		Body: &gen.DiscoverComponentsRequest{
+			ProjectName: "shop",
+			OwnerUrl:    ptr.To("https://github.com/acme"),
+			Token:       ptr.To("s3cret"),
+		},
+	}
+	wantReq := &discoverysvc.DiscoverRequest{ProjectName: "shop", OwnerURL: "https://github.com/acme", Token: "s3cret"}
+
+	t.Run("success", func(t *testing.T) {
+		svc := discoverysvcmocks.NewMockService(t)
+		svc.EXPECT().DiscoverComponents(mock.Anything, ns, wantReq).Return(&discoverysvc.DiscoverResult{
+			Proposals: []discoverysvc.Proposal{{
+				Name: "orders", RepositoryURL: "https://github.com/acme/shop.git", Ref: "main", AppPath: "/orders",
+				Type: openchoreov1alpha1.WorkloadTypeService, Language: "Go", Port: 9090,
+				Build: discoverysvc.BuildStrategyDocker, Workflow: "dockerfile-builder",
+				Evidence: []string{"orders/Dockerfile"},
+			}},
+			Skipped: []discoverysvc.SkippedRepository{{RepositoryURL: "https://github.com/acme/old.git", Reason: "repository is archived"}},
+		}, nil)
+
+		resp, err := newHandlerWithDiscoveryService(svc).DiscoverComponents(ctx, request)
+		require.NoError(t, err)
+		typed, ok := resp.(gen.DiscoverComponents200JSONResponse)
+		require.True(t, ok, "expected 200, got %T", resp)
+		require.Len(t, typed.Proposals, 1)
+		proposal := typed.Proposals[0]
+		assert.Equal(t, "orders", proposal.Name)
+		assert.Equal(t, ptr.To(gen.ComponentProposalBuild("Docker")), proposal.Build)
+		assert.Equal(t, ptr.To(int32(9090)), proposal.Port)
+		assert.Equal(t, ptr.To("Go"), proposal.Language)
+		assert.Nil(t, proposal.DescriptorPath)
+		require.Len(t, typed.Skipped, 1)
+		assert.Equal(t, "repository is archived", typed.Skipped[0].Reason)
+	})
+
+	errCases := []struct {
+		name string
+		err  error
+		want any
+	}{
+		{"forbidden", svcerrors.ErrForbidden, gen.DiscoverComponents403JSONResponse{}},
+		{"project not found", projectsvc.ErrProjectNotFound, gen.DiscoverComponents404JSONResponse{}},
+		{"validation", &svcerrors.ValidationError{Msg: "bad"}, gen.DiscoverComponents400JSONResponse{}},
+		{"internal", errors.New("boom"), gen.DiscoverComponents500JSONResponse{}},
+	}
+	for _, tc := range errCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := discoverysvcmocks.NewMockService(t)
+			svc.EXPECT().DiscoverComponents(mock.Anything, ns, wantReq).Return(nil, tc.err)
+
+			resp, err := newHandlerWithDiscoveryService(svc).DiscoverComponents(ctx, request)
+			require.NoError(t, err)
+			assert.IsType(t, tc.want, resp)
+		})
+	}
+
+	t.Run("missing body", func(t *testing.T) {
+		resp, err := newHandlerWithDiscoveryService(discoverysvcmocks.NewMockService(t)).
+			DiscoverComponents(ctx, gen.DiscoverComponentsRequestObject{NamespaceName: ns})
+		require.NoError(t, err)
+		assert.IsType(t, gen.DiscoverComponents400JSONResponse{}, resp)
+	})
+}
+
+func TestAcceptComponentProposalsHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+	request := gen.AcceptComponentProposalsRequestObject{
+		NamespaceName: ns,
+		Body: &gen.AcceptComponentProposalsRequest{
+			ProjectName: "shop",
+			ClassName:   ptr.To("service"),
+			Proposals: []gen.ComponentProposal{
+				{Name: "orders", RepositoryUrl: "https://github.com/acme/shop", Ref: "main", AppPath: "/orders",
+					Workflow: ptr.To("dockerfile-builder")},
+				{Name: "billing", RepositoryUrl: "https://github.com/acme/shop", Ref: "main", AppPath: "/billing"},
+				{Name: "cart", RepositoryUrl: "https://github.com/acme/shop", Ref: "main", AppPath: "/cart"},
+			},
+		},
+	}
+
+	created := &openchoreov1alpha1.Component{}
+	created.Name = "orders"
+	svc := discoverysvcmocks.NewMockService(t)
+	svc.EXPECT().AcceptProposals(mock.Anything, ns, mock.MatchedBy(func(req *discoverysvc.AcceptRequest) bool {
+		return req.ClassName == "service" && len(req.Proposals) == 3 && req.Proposals[0].Workflow == "dockerfile-builder"
+	})).Return([]discoverysvc.AcceptResult{
+		{Name: "orders", Component: created},
+		{Name: "billing", Error: componentsvc.ErrComponentAlreadyExists},
+		{Name: "cart", Error: errors.New("etcd unavailable")},
+	}, nil)
+
+	resp, err := newHandlerWithDiscoveryService(svc).AcceptComponentProposals(ctx, request)
+	require.NoError(t, err)
+	typed, ok := resp.(gen.AcceptComponentProposals200JSONResponse)
+	require.True(t, ok, "expected 200, got %T", resp)
+	require.Len(t, typed.Results, 3)
+	require.NotNil(t, typed.Results[0].Component)
+	assert.Equal(t, "orders", typed.Results[0].Component.Metadata.Name)
+	assert.Nil(t, typed.Results[0].Error)
+	assert.Equal(t, ptr.To("component already exists"), typed.Results[1].Error)
+	assert.Equal(t, ptr.To("Internal server error"), typed.Results[2].Error, "unexpected errors are not exposed")
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcerrors "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	endpointsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/endpoint"
+)
+
+// ListEndpoints returns the endpoints exposed by the components of a namespace.
+func (h *Handler) ListEndpoints(
+	ctx context.Context,
+	request gen.ListEndpointsRequestObject,
+) (gen.ListEndpointsResponseObject, error) {
+	h.logger.Debug("ListEndpoints called", "namespaceName", request.NamespaceName)
+
+	filter := endpointsvc.ListFilter{
+		ProjectName:   ptr.Deref(request.Params.Project, ""),
+		ComponentName: ptr.Deref(request.Params.Component, ""),
+		Environment:   ptr.Deref(request.Params.Environment, ""),
+	}
+	endpoints, err := h.services.EndpointService.ListEndpoints(ctx, request.NamespaceName, filter)
+	if err != nil {
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.ListEndpoints403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		h.logger.Error("Failed to list endpoints", "error", err)
+		return gen.ListEndpoints500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	items := make([]gen.Endpoint, 0, len(endpoints))
+	for _, ep := range endpoints {
+		genEP, err := toGenEndpoint(ep)
+		if err != nil {
+			h.logger.Error("Failed to convert endpoint", "error", err)
+			return gen.ListEndpoints500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+		}
+		items = append(items, genEP)
+	}
+	return gen.ListEndpoints200JSONResponse{Items: items}, nil
+}
+
+// UpdateEndpoint changes the visibility or base path of a component endpoint.
+func (h *Handler) UpdateEndpoint(
+	ctx context.Context,
+	request gen.UpdateEndpointRequestObject,
+) (gen.UpdateEndpointResponseObject, error) {
+	h.logger.Info("UpdateEndpoint called",
+		"namespaceName", request.NamespaceName,
+		"componentName", request.ComponentName,
+		"endpointName", request.EndpointName)
+
+	if request.Body == nil {
+		return gen.UpdateEndpoint400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	req := endpointsvc.UpdateRequest{BasePath: request.Body.BasePath}
+	if request.Body.Visibility != nil {
+		visibility := make([]openchoreov1alpha1.EndpointVisibility, 0, len(*request.Body.Visibility))
+		for _, v := range *request.Body.Visibility {
+			visibility = append(visibility, openchoreov1alpha1.EndpointVisibility(v))
+		}
+		req.Visibility = &visibility
+	}
+
+	ep, err := h.services.EndpointService.UpdateEndpoint(ctx, request.NamespaceName, request.ComponentName, request.EndpointName, req)
+	if err != nil {
+		var validationErr *svcerrors.ValidationError
+		switch {
+		case errors.Is(err, svcerrors.ErrForbidden):
+			return gen.UpdateEndpoint403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, componentsvc.ErrComponentNotFound):
+			return gen.UpdateEndpoint404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		case errors.Is(err, endpointsvc.ErrWorkloadNotFound):
+			return gen.UpdateEndpoint404JSONResponse{NotFoundJSONResponse: notFound("Workload")}, nil
+		case errors.Is(err, endpointsvc.ErrEndpointNotFound):
+			return gen.UpdateEndpoint404JSONResponse{NotFoundJSONResponse: notFound("Endpoint")}, nil
+		case errors.Is(err, endpointsvc.ErrNothingToUpdate),
+			errors.Is(err, endpointsvc.ErrInvalidVisibility),
+			errors.Is(err, endpointsvc.ErrInvalidBasePath):
+			return gen.UpdateEndpoint400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		case errors.As(err, &validationErr):
+			return gen.UpdateEndpoint422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to update endpoint", "error", err)
+		return gen.UpdateEndpoint500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genEP, err := toGenEndpoint(*ep)
+	if err != nil {
+		h.logger.Error("Failed to convert endpoint", "error", err)
+		return gen.UpdateEndpoint500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.UpdateEndpoint200JSONResponse(genEP), nil
+}
+
+func toGenEndpoint(ep endpointsvc.Endpoint) (gen.Endpoint, error) {
+	result := gen.Endpoint{
+		Name:          ep.Name,
+		ProjectName:   ep.ProjectName,
+		ComponentName: ep.ComponentName,
+		WorkloadName:  ep.WorkloadName,
+		Type:          gen.EndpointType(ep.Type),
+		Port:          ep.Port,
+		BasePath:      optionalString(ep.BasePath),
+		Visibility:    make([]gen.EndpointVisibility, 0, len(ep.Visibility)),
+		Environments:  make([]gen.EnvironmentEndpoint, 0, len(ep.Environments)),
+	}
+	for _, v := range ep.Visibility {
+		result.Visibility = append(result.Visibility, gen.EndpointVisibility(v))
+	}
+	for _, env := range ep.Environments {
+		serviceURL, err := convert[*openchoreov1alpha1.EndpointURL, *gen.EndpointURL](env.ServiceURL)
+		if err != nil {
+			return gen.Endpoint{}, err
+		}
+		internalURLs, err := convert[*openchoreov1alpha1.EndpointGatewayURLs, *gen.EndpointGatewayURLs](env.InternalURLs)
+		if err != nil {
+			return gen.Endpoint{}, err
+		}
+		externalURLs, err := convert[*openchoreov1alpha1.EndpointGatewayURLs, *gen.EndpointGatewayURLs](env.ExternalURLs)
+		if err != nil {
+			return gen.Endpoint{}, err
+		}
+		genEnv := gen.EnvironmentEndpoint{
+			Environment:        env.Environment,
+			ReleaseBindingName: env.ReleaseBindingName,
+			ServiceURL:         serviceURL,
+			InternalURLs:       internalURLs,
+			ExternalURLs:       externalURLs,
+		}
+		if len(env.CustomDomains) > 0 {
+			customDomains := append([]string(nil), env.CustomDomains...)
+			genEnv.CustomDomains = &customDomains
+		}
+		if len(env.Certificates) > 0 {
+			certificates := make([]gen.EndpointCertificate, 0, len(env.Certificates))
+			for _, cert := range env.Certificates {
+				genCert, err := convert[openchoreov1alpha1.EndpointCertificateStatus, gen.EndpointCertificate](cert.EndpointCertificateStatus)
+				if err != nil {
+					return gen.Endpoint{}, err
+				}
+				if cert.Alert != "" {
+					alert := gen.EndpointCertificateAlert(cert.Alert)
+					genCert.Alert = &alert
+				}
+				certificates = append(certificates, genCert)
+			}
+			genEnv.Certificates = &certificates
+		}
+		result.Environments = append(result.Environments, genEnv)
+	}
+	return result, nil
+}
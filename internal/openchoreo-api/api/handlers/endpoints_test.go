@@ -0,0 +1,147 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	endpointsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/endpoint"
+	endpointsvcmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/endpoint/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+)
+
+func newHandlerWithEndpointService(svc endpointsvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{EndpointService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func testEndpoint() *endpointsvc.Endpoint {
+	return &endpointsvc.Endpoint{
+		Name:          "api",
+		ProjectName:   "proj",
+		ComponentName: "comp-a",
+		WorkloadName:  "comp-a-workload",
+		Type:          openchoreov1alpha1.EndpointTypeHTTP,
+		Port:          8080,
+		BasePath:      "/api",
+		Visibility:    []openchoreov1alpha1.EndpointVisibility{"project", "external"},
+		Environments: []endpointsvc.EnvironmentEndpoint{{
+			Environment:        "dev",
+			ReleaseBindingName: "comp-a-dev",
+			ServiceURL:         &openchoreov1alpha1.EndpointURL{Scheme: "http", Host: "comp-a.svc", Port: 8080},
+			ExternalURLs: &openchoreov1alpha1.EndpointGatewayURLs{
+				HTTPS: &openchoreov1alpha1.EndpointURL{Scheme: "https", Host: "api.dev.example.com", Path: "/api"},
+			},
+		}},
+	}
+}
+
+func TestListEndpointsHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success maps endpoints", func(t *testing.T) {
+		svc := endpointsvcmocks.NewMockService(t)
+		svc.EXPECT().ListEndpoints(mock.Anything, ns, endpointsvc.ListFilter{ComponentName: "comp-a", Environment: "dev"}).
+			Return([]endpointsvc.Endpoint{*testEndpoint()}, nil)
+
+		resp, err := newHandlerWithEndpointService(svc).ListEndpoints(ctx, gen.ListEndpointsRequestObject{
+			NamespaceName: ns,
+			Params:        gen.ListEndpointsParams{Component: ptr.To("comp-a"), Environment: ptr.To("dev")},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ListEndpoints200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, typed.Items, 1)
+		item := typed.Items[0]
+		assert.Equal(t, "api", item.Name)
+		assert.Equal(t, "comp-a-workload", item.WorkloadName)
+		assert.Equal(t, "/api", ptr.Deref(item.BasePath, ""))
+		assert.Equal(t, []gen.EndpointVisibility{"project", "external"}, item.Visibility)
+		require.Len(t, item.Environments, 1)
+		env := item.Environments[0]
+		assert.Equal(t, "dev", env.Environment)
+		require.NotNil(t, env.ServiceURL)
+		assert.Equal(t, "comp-a.svc", env.ServiceURL.Host)
+		require.NotNil(t, env.ExternalURLs)
+		require.NotNil(t, env.ExternalURLs.Https)
+		assert.Equal(t, "api.dev.example.com", env.ExternalURLs.Https.Host)
+		assert.Nil(t, env.InternalURLs)
+	})
+
+	t.Run("service error returns 500", func(t *testing.T) {
+		svc := endpointsvcmocks.NewMockService(t)
+		svc.EXPECT().ListEndpoints(mock.Anything, ns, endpointsvc.ListFilter{}).Return(nil, errors.New("boom"))
+		resp, err := newHandlerWithEndpointService(svc).ListEndpoints(ctx, gen.ListEndpointsRequestObject{NamespaceName: ns})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ListEndpoints500JSONResponse{}, resp)
+	})
+}
+
+func TestUpdateEndpointHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+	request := func(body *gen.UpdateEndpointJSONRequestBody) gen.UpdateEndpointRequestObject {
+		return gen.UpdateEndpointRequestObject{NamespaceName: ns, ComponentName: "comp-a", EndpointName: "api", Body: body}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		svc := endpointsvcmocks.NewMockService(t)
+		svc.EXPECT().UpdateEndpoint(mock.Anything, ns, "comp-a", "api", endpointsvc.UpdateRequest{
+			Visibility: &[]openchoreov1alpha1.EndpointVisibility{"external"},
+			BasePath:   ptr.To("/v2"),
+		}).Return(testEndpoint(), nil)
+
+		resp, err := newHandlerWithEndpointService(svc).UpdateEndpoint(ctx, request(&gen.UpdateEndpointJSONRequestBody{
+			Visibility: &[]gen.UpdateEndpointRequestVisibility{"external"},
+			BasePath:   ptr.To("/v2"),
+		}))
+		require.NoError(t, err)
+		typed, ok := resp.(gen.UpdateEndpoint200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, "api", typed.Name)
+	})
+
+	t.Run("missing body returns 400", func(t *testing.T) {
+		resp, err := newHandlerWithEndpointService(endpointsvcmocks.NewMockService(t)).UpdateEndpoint(ctx, request(nil))
+		require.NoError(t, err)
+		assert.IsType(t, gen.UpdateEndpoint400JSONResponse{}, resp)
+	})
+
+	errorCases := []struct {
+		name string
+		err  error
+		want any
+	}{
+		{"forbidden", svcpkg.ErrForbidden, gen.UpdateEndpoint403JSONResponse{}},
+		{"component not found", componentsvc.ErrComponentNotFound, gen.UpdateEndpoint404JSONResponse{}},
+		{"endpoint not found", endpointsvc.ErrEndpointNotFound, gen.UpdateEndpoint404JSONResponse{}},
+		{"invalid visibility", endpointsvc.ErrInvalidVisibility, gen.UpdateEndpoint400JSONResponse{}},
+		{"validation", &svcpkg.ValidationError{Msg: "invalid"}, gen.UpdateEndpoint422JSONResponse{}},
+		{"internal", errors.New("boom"), gen.UpdateEndpoint500JSONResponse{}},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := endpointsvcmocks.NewMockService(t)
+			svc.EXPECT().UpdateEndpoint(mock.Anything, ns, "comp-a", "api", mock.Anything).Return(nil, tc.err)
+			resp, err := newHandlerWithEndpointService(svc).UpdateEndpoint(ctx, request(&gen.UpdateEndpointJSONRequestBody{BasePath: ptr.To("/v2")}))
+			require.NoError(t, err)
+			assert.IsType(t, tc.want, resp)
+		})
+	}
+}
@@ -22,9 +22,11 @@ func (h *Handler) ListEnvironments(
 ) (gen.ListEnvironmentsResponseObject, error) {
 	h.logger.Debug("ListEnvironments called", "namespaceName", request.NamespaceName)
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.EnvironmentService.ListEnvironments(ctx, request.NamespaceName, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.Environment], error) {
+		return h.services.EnvironmentService.ListEnvironments(ctx, request.NamespaceName, opts)
+	})
 	if err != nil {
 		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
 			return gen.ListEnvironments400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
@@ -186,6 +188,9 @@ func (h *Handler) DeleteEnvironment(
 		if errors.Is(err, environmentsvc.ErrEnvironmentNotFound) {
 			return gen.DeleteEnvironment404JSONResponse{NotFoundJSONResponse: notFound("Environment")}, nil
 		}
+		if errors.Is(err, environmentsvc.ErrEnvironmentInUse) {
+			return gen.DeleteEnvironment409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
 		h.logger.Error("Failed to delete environment", "error", err)
 		return gen.DeleteEnvironment500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
 	}
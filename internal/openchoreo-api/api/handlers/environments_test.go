@@ -357,6 +357,19 @@ func TestDeleteEnvironmentHandler(t *testing.T) {
 		assert.IsType(t, gen.DeleteEnvironment404JSONResponse{}, resp)
 	})
 
+	t.Run("active deployments return 409", func(t *testing.T) {
+		binding := &openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "comp-dev", Namespace: ns},
+			Spec:       openchoreov1alpha1.ReleaseBindingSpec{Environment: "dev"},
+		}
+		svc := newEnvironmentService(t, []client.Object{testEnvObj("dev"), binding}, &allowAllPDP{})
+		h := newHandlerWithEnvironmentService(svc)
+
+		resp, err := h.DeleteEnvironment(ctx, gen.DeleteEnvironmentRequestObject{NamespaceName: ns, EnvName: "dev"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.DeleteEnvironment409JSONResponse{}, resp)
+	})
+
 	t.Run("forbidden returns 403", func(t *testing.T) {
 		svc := newEnvironmentService(t, []client.Object{testEnvObj("dev")}, &denyAllPDP{})
 		h := newHandlerWithEnvironmentService(svc)
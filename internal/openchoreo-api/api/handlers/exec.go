@@ -187,7 +187,13 @@ func (h *ExecHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	logger.Info("Exec session established")
 
-	// Bidirectional bridge: client ↔ gateway
+	bridgeWebSockets(clientConn, gwConn)
+	logger.Info("Exec session ended")
+}
+
+// bridgeWebSockets copies messages between the client and gateway WebSockets
+// until either side closes, forwarding the gateway's close status to the client.
+func bridgeWebSockets(clientConn, gwConn *websocket.Conn) {
 	// Buffer of 2 so both goroutines can signal completion without blocking.
 	done := make(chan struct{}, 2)
 
@@ -230,7 +236,6 @@ func (h *ExecHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}()
 
 	<-done
-	logger.Info("Exec session ended")
 }
 
 type execPlaneInfo struct {
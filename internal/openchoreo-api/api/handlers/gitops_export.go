@@ -0,0 +1,41 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcerrors "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+)
+
+// ExportProjectGitOps returns the files of the GitOps export of a project.
+func (h *Handler) ExportProjectGitOps(
+	ctx context.Context,
+	request gen.ExportProjectGitOpsRequestObject,
+) (gen.ExportProjectGitOpsResponseObject, error) {
+	h.logger.Debug("ExportProjectGitOps called", "namespaceName", request.NamespaceName, "projectName", request.ProjectName)
+
+	files, err := h.services.GitOpsExportService.ExportProject(ctx, request.NamespaceName, request.ProjectName)
+	if err != nil {
+		switch {
+		case errors.Is(err, svcerrors.ErrForbidden):
+			return gen.ExportProjectGitOps403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, projectsvc.ErrProjectNotFound):
+			return gen.ExportProjectGitOps404JSONResponse{NotFoundJSONResponse: notFound("Project")}, nil
+		}
+		h.logger.Error("Failed to export project", "error", err)
+		return gen.ExportProjectGitOps500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	export := gen.GitOpsExport{Files: make([]gen.GitOpsExportFile, 0, len(files))}
+	for _, path := range slices.Sorted(maps.Keys(files)) {
+		export.Files = append(export.Files, gen.GitOpsExportFile{Path: path, Content: string(files[path])})
+	}
+	return gen.ExportProjectGitOps200JSONResponse(export), nil
+}
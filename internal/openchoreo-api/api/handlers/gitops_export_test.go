@@ -0,0 +1,71 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcerrors "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	gitopsexportsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/gitopsexport"
+	gitopsexportsvcmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/gitopsexport/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+)
+
+func newHandlerWithGitOpsExportService(svc gitopsexportsvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{GitOpsExportService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func TestExportProjectGitOpsHandler(t *testing.T) {
+	ctx := testContext()
+	const ns, project = "test-ns", "shop"
+	request := gen.ExportProjectGitOpsRequestObject{NamespaceName: ns, ProjectName: project}
+
+	t.Run("success", func(t *testing.T) {
+		svc := gitopsexportsvcmocks.NewMockService(t)
+		svc.EXPECT().ExportProject(mock.Anything, ns, project).Return(map[string][]byte{
+			"namespaces/test-ns/projects/shop/project.yaml": []byte("kind: Project\n"),
+			"kustomization.yaml":                            []byte("kind: Kustomization\n"),
+		}, nil)
+
+		resp, err := newHandlerWithGitOpsExportService(svc).ExportProjectGitOps(ctx, request)
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ExportProjectGitOps200JSONResponse)
+		require.True(t, ok, "expected 200, got %T", resp)
+		assert.Equal(t, []gen.GitOpsExportFile{
+			{Path: "kustomization.yaml", Content: "kind: Kustomization\n"},
+			{Path: "namespaces/test-ns/projects/shop/project.yaml", Content: "kind: Project\n"},
+		}, typed.Files)
+	})
+
+	errCases := []struct {
+		name string
+		err  error
+		want any
+	}{
+		{"forbidden", svcerrors.ErrForbidden, gen.ExportProjectGitOps403JSONResponse{}},
+		{"project not found", projectsvc.ErrProjectNotFound, gen.ExportProjectGitOps404JSONResponse{}},
+		{"internal error", assert.AnError, gen.ExportProjectGitOps500JSONResponse{}},
+	}
+	for _, tc := range errCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := gitopsexportsvcmocks.NewMockService(t)
+			svc.EXPECT().ExportProject(mock.Anything, ns, project).Return(nil, tc.err)
+
+			resp, err := newHandlerWithGitOpsExportService(svc).ExportProjectGitOps(ctx, request)
+			require.NoError(t, err)
+			assert.IsType(t, tc.want, resp)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcerrors "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	helmchartsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/helmchart"
+)
+
+// GetComponentHelmChart returns the deployment of a component to an environment as a Helm chart archive.
+func (h *Handler) GetComponentHelmChart(
+	ctx context.Context,
+	request gen.GetComponentHelmChartRequestObject,
+) (gen.GetComponentHelmChartResponseObject, error) {
+	h.logger.Debug("GetComponentHelmChart called",
+		"namespaceName", request.NamespaceName,
+		"componentName", request.ComponentName,
+		"envName", request.EnvName)
+
+	chart, err := h.services.HelmChartService.GenerateHelmChart(ctx, request.NamespaceName, request.ComponentName, request.EnvName)
+	if err != nil {
+		switch {
+		case errors.Is(err, svcerrors.ErrForbidden):
+			return gen.GetComponentHelmChart403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, componentsvc.ErrComponentNotFound):
+			return gen.GetComponentHelmChart404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		case errors.Is(err, helmchartsvc.ErrDeploymentNotFound):
+			return gen.GetComponentHelmChart404JSONResponse{NotFoundJSONResponse: notFound("Deployment")}, nil
+		case errors.Is(err, helmchartsvc.ErrNotRendered):
+			return gen.GetComponentHelmChart409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
+		h.logger.Error("Failed to generate Helm chart", "error", err)
+		return gen.GetComponentHelmChart500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.GetComponentHelmChart200ApplicationgzipResponse{
+		Body:          bytes.NewReader(chart.Archive),
+		ContentLength: int64(len(chart.Archive)),
+		Headers: gen.GetComponentHelmChart200ResponseHeaders{
+			ContentDisposition: fmt.Sprintf("attachment; filename=%q", chart.FileName),
+		},
+	}, nil
+}
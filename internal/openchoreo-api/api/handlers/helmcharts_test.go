@@ -0,0 +1,76 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcerrors "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	helmchartsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/helmchart"
+	helmchartsvcmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/helmchart/mocks"
+)
+
+func newHandlerWithHelmChartService(svc helmchartsvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{HelmChartService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func TestGetComponentHelmChartHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+	request := gen.GetComponentHelmChartRequestObject{NamespaceName: ns, ComponentName: "comp-a", EnvName: "dev"}
+
+	t.Run("success", func(t *testing.T) {
+		svc := helmchartsvcmocks.NewMockService(t)
+		svc.EXPECT().GenerateHelmChart(mock.Anything, ns, "comp-a", "dev").Return(&helmchartsvc.Chart{
+			Name:     "comp-a",
+			Version:  "0.1.0",
+			FileName: "comp-a-0.1.0.tgz",
+			Archive:  []byte("archive"),
+		}, nil)
+
+		resp, err := newHandlerWithHelmChartService(svc).GetComponentHelmChart(ctx, request)
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetComponentHelmChart200ApplicationgzipResponse)
+		require.True(t, ok, "expected 200, got %T", resp)
+		assert.Equal(t, `attachment; filename="comp-a-0.1.0.tgz"`, typed.Headers.ContentDisposition)
+		assert.EqualValues(t, 7, typed.ContentLength)
+		body, err := io.ReadAll(typed.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "archive", string(body))
+	})
+
+	errCases := []struct {
+		name string
+		err  error
+		want any
+	}{
+		{"forbidden", svcerrors.ErrForbidden, gen.GetComponentHelmChart403JSONResponse{}},
+		{"component not found", componentsvc.ErrComponentNotFound, gen.GetComponentHelmChart404JSONResponse{}},
+		{"not deployed", helmchartsvc.ErrDeploymentNotFound, gen.GetComponentHelmChart404JSONResponse{}},
+		{"not rendered", helmchartsvc.ErrNotRendered, gen.GetComponentHelmChart409JSONResponse{}},
+		{"internal error", assert.AnError, gen.GetComponentHelmChart500JSONResponse{}},
+	}
+	for _, tc := range errCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := helmchartsvcmocks.NewMockService(t)
+			svc.EXPECT().GenerateHelmChart(mock.Anything, ns, "comp-a", "dev").Return(nil, tc.err)
+
+			resp, err := newHandlerWithHelmChartService(svc).GetComponentHelmChart(ctx, request)
+			require.NoError(t, err)
+			assert.IsType(t, tc.want, resp)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	kindschemasvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/kindschema"
+	printercolumnsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/printercolumn"
+)
+
+// ListKinds returns every kind served by the API with its printer columns.
+func (h *Handler) ListKinds(
+	ctx context.Context,
+	_ gen.ListKindsRequestObject,
+) (gen.ListKindsResponseObject, error) {
+	h.logger.Debug("ListKinds called")
+
+	kinds, err := h.services.PrinterColumnService.ListKinds(ctx)
+	if err != nil {
+		h.logger.Error("Failed to list kinds", "error", err)
+		return gen.ListKinds500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	items := make([]gen.PrinterColumns, 0, len(kinds))
+	for _, k := range kinds {
+		items = append(items, toGenPrinterColumns(k))
+	}
+	return gen.ListKinds200JSONResponse{Items: items}, nil
+}
+
+// GetKindPrinterColumns returns the table columns declared by the CRD of a kind.
+func (h *Handler) GetKindPrinterColumns(
+	ctx context.Context,
+	request gen.GetKindPrinterColumnsRequestObject,
+) (gen.GetKindPrinterColumnsResponseObject, error) {
+	h.logger.Debug("GetKindPrinterColumns called", "kind", request.Kind)
+
+	pc, err := h.services.PrinterColumnService.GetPrinterColumns(ctx, request.Kind)
+	if err != nil {
+		if errors.Is(err, printercolumnsvc.ErrKindNotFound) {
+			return gen.GetKindPrinterColumns404JSONResponse{NotFoundJSONResponse: notFound("Kind")}, nil
+		}
+		h.logger.Error("Failed to get printer columns", "error", err)
+		return gen.GetKindPrinterColumns500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.GetKindPrinterColumns200JSONResponse(toGenPrinterColumns(pc)), nil
+}
+
+func toGenPrinterColumns(pc *printercolumnsvc.PrinterColumns) gen.PrinterColumns {
+	columns := make([]gen.PrinterColumn, 0, len(pc.Columns))
+	for _, col := range pc.Columns {
+		column := gen.PrinterColumn{
+			Name:        col.Name,
+			Type:        col.Type,
+			Format:      optionalString(col.Format),
+			JsonPath:    col.JSONPath,
+			Description: optionalString(col.Description),
+		}
+		if col.Priority != 0 {
+			column.Priority = &col.Priority
+		}
+		columns = append(columns, column)
+	}
+
+	result := gen.PrinterColumns{
+		Kind:       pc.Kind,
+		Plural:     pc.Plural,
+		Singular:   optionalString(pc.Singular),
+		Namespaced: pc.Namespaced,
+		Columns:    columns,
+	}
+	if len(pc.ShortNames) > 0 {
+		shortNames := append([]string(nil), pc.ShortNames...)
+		result.ShortNames = &shortNames
+	}
+	return result
+}
+
+// GetKindFields returns the fields of a kind, flattened from the schema of its CRD.
+func (h *Handler) GetKindFields(
+	ctx context.Context,
+	request gen.GetKindFieldsRequestObject,
+) (gen.GetKindFieldsResponseObject, error) {
+	h.logger.Debug("GetKindFields called", "kind", request.Kind)
+
+	kf, err := h.services.KindSchemaService.GetFields(ctx, request.Kind)
+	if err != nil {
+		if errors.Is(err, kindschemasvc.ErrKindNotFound) {
+			return gen.GetKindFields404JSONResponse{NotFoundJSONResponse: notFound("Kind")}, nil
+		}
+		h.logger.Error("Failed to get kind fields", "error", err)
+		return gen.GetKindFields500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.GetKindFields200JSONResponse(toGenKindFields(kf)), nil
+}
+
+func toGenKindFields(kf *kindschemasvc.KindFields) gen.KindFields {
+	fields := make([]gen.KindField, 0, len(kf.Fields))
+	for _, f := range kf.Fields {
+		field := gen.KindField{
+			Path:        f.Path,
+			Type:        f.Type,
+			Description: optionalString(f.Description),
+			Required:    f.Required,
+			Default:     optionalString(f.Default),
+		}
+		if len(f.Enum) > 0 {
+			enum := append([]string(nil), f.Enum...)
+			field.Enum = &enum
+		}
+		fields = append(fields, field)
+	}
+
+	return gen.KindFields{
+		Kind:        kf.Kind,
+		Group:       kf.Group,
+		Version:     kf.Version,
+		Description: optionalString(kf.Description),
+		Fields:      fields,
+	}
+}
+
+// ListKindSchemas returns the OpenAPI schema of every kind, as declared by its CRD.
+func (h *Handler) ListKindSchemas(
+	ctx context.Context,
+	_ gen.ListKindSchemasRequestObject,
+) (gen.ListKindSchemasResponseObject, error) {
+	h.logger.Debug("ListKindSchemas called")
+
+	schemas, err := h.services.KindSchemaService.ListSchemas(ctx)
+	if err != nil {
+		h.logger.Error("Failed to list kind schemas", "error", err)
+		return gen.ListKindSchemas500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	items := make([]gen.KindSchema, 0, len(schemas))
+	for _, ks := range schemas {
+		schema, err := convert[*extv1.JSONSchemaProps, map[string]interface{}](ks.Schema)
+		if err != nil {
+			h.logger.Error("Failed to convert kind schema", "kind", ks.Kind, "error", err)
+			return gen.ListKindSchemas500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+		}
+		items = append(items, gen.KindSchema{
+			Kind:    ks.Kind,
+			Group:   ks.Group,
+			Version: ks.Version,
+			Schema:  schema,
+		})
+	}
+	return gen.ListKindSchemas200JSONResponse{Items: items}, nil
+}
@@ -0,0 +1,202 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	kindschemasvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/kindschema"
+	kindschemasvcmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/kindschema/mocks"
+	printercolumnsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/printercolumn"
+	printercolumnsvcmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/printercolumn/mocks"
+)
+
+func newHandlerWithPrinterColumnService(svc printercolumnsvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{PrinterColumnService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func TestGetKindPrinterColumnsHandler(t *testing.T) {
+	ctx := testContext()
+	request := gen.GetKindPrinterColumnsRequestObject{Kind: "comp"}
+
+	t.Run("success", func(t *testing.T) {
+		svc := printercolumnsvcmocks.NewMockService(t)
+		svc.EXPECT().GetPrinterColumns(mock.Anything, "comp").Return(&printercolumnsvc.PrinterColumns{
+			Kind: "Component", Plural: "components", Singular: "component", ShortNames: []string{"comp"}, Namespaced: true,
+			Columns: []printercolumnsvc.Column{
+				{Name: "Project", Type: "string", JSONPath: ".spec.owner.projectName"},
+				{Name: "Reason", Type: "string", JSONPath: ".status.reason", Priority: 1},
+			},
+		}, nil)
+
+		resp, err := newHandlerWithPrinterColumnService(svc).GetKindPrinterColumns(ctx, request)
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetKindPrinterColumns200JSONResponse)
+		require.True(t, ok, "expected 200, got %T", resp)
+		assert.Equal(t, "Component", typed.Kind)
+		assert.True(t, typed.Namespaced)
+		assert.Equal(t, &[]string{"comp"}, typed.ShortNames)
+		require.Len(t, typed.Columns, 2)
+		assert.Equal(t, ".spec.owner.projectName", typed.Columns[0].JsonPath)
+		assert.Nil(t, typed.Columns[0].Priority)
+		assert.Equal(t, ptr.To(int32(1)), typed.Columns[1].Priority)
+	})
+
+	errCases := []struct {
+		name string
+		err  error
+		want any
+	}{
+		{"kind not found", printercolumnsvc.ErrKindNotFound, gen.GetKindPrinterColumns404JSONResponse{}},
+		{"internal error", errors.New("boom"), gen.GetKindPrinterColumns500JSONResponse{}},
+	}
+	for _, tt := range errCases {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := printercolumnsvcmocks.NewMockService(t)
+			svc.EXPECT().GetPrinterColumns(mock.Anything, "comp").Return(nil, tt.err)
+
+			resp, err := newHandlerWithPrinterColumnService(svc).GetKindPrinterColumns(ctx, request)
+			require.NoError(t, err)
+			assert.IsType(t, tt.want, resp)
+		})
+	}
+}
+
+func TestListKindsHandler(t *testing.T) {
+	ctx := testContext()
+
+	t.Run("success", func(t *testing.T) {
+		svc := printercolumnsvcmocks.NewMockService(t)
+		svc.EXPECT().ListKinds(mock.Anything).Return([]*printercolumnsvc.PrinterColumns{
+			{Kind: "ClusterDataPlane", Plural: "clusterdataplanes", ShortNames: []string{"cdp"}},
+			{Kind: "Component", Plural: "components", Namespaced: true},
+		}, nil)
+
+		resp, err := newHandlerWithPrinterColumnService(svc).ListKinds(ctx, gen.ListKindsRequestObject{})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ListKinds200JSONResponse)
+		require.True(t, ok, "expected 200, got %T", resp)
+		require.Len(t, typed.Items, 2)
+		assert.Equal(t, &[]string{"cdp"}, typed.Items[0].ShortNames)
+		assert.True(t, typed.Items[1].Namespaced)
+	})
+
+	t.Run("internal error", func(t *testing.T) {
+		svc := printercolumnsvcmocks.NewMockService(t)
+		svc.EXPECT().ListKinds(mock.Anything).Return(nil, errors.New("boom"))
+
+		resp, err := newHandlerWithPrinterColumnService(svc).ListKinds(ctx, gen.ListKindsRequestObject{})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ListKinds500JSONResponse{}, resp)
+	})
+}
+
+func TestGetKindFieldsHandler(t *testing.T) {
+	ctx := testContext()
+	request := gen.GetKindFieldsRequestObject{Kind: "comp"}
+	newHandler := func(svc kindschemasvc.Service) *Handler {
+		return &Handler{
+			services: &handlerservices.Services{KindSchemaService: svc},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		svc := kindschemasvcmocks.NewMockService(t)
+		svc.EXPECT().GetFields(mock.Anything, "comp").Return(&kindschemasvc.KindFields{
+			Kind: "Component", Group: "openchoreo.dev", Version: "v1alpha1",
+			Fields: []kindschemasvc.Field{
+				{Path: "spec", Type: "Object"},
+				{Path: "spec.autoDeploy", Type: "boolean", Description: "Deploy on build.", Default: "false"},
+				{Path: "spec.mode", Type: "string", Required: true, Enum: []string{"a", "b"}},
+			},
+		}, nil)
+
+		resp, err := newHandler(svc).GetKindFields(ctx, request)
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetKindFields200JSONResponse)
+		require.True(t, ok, "expected 200, got %T", resp)
+		assert.Equal(t, "Component", typed.Kind)
+		assert.Nil(t, typed.Description)
+		require.Len(t, typed.Fields, 3)
+		assert.Nil(t, typed.Fields[0].Description)
+		assert.Equal(t, ptr.To("Deploy on build."), typed.Fields[1].Description)
+		assert.Equal(t, ptr.To("false"), typed.Fields[1].Default)
+		assert.True(t, typed.Fields[2].Required)
+		assert.Equal(t, &[]string{"a", "b"}, typed.Fields[2].Enum)
+	})
+
+	errCases := []struct {
+		name string
+		err  error
+		want any
+	}{
+		{"kind not found", kindschemasvc.ErrKindNotFound, gen.GetKindFields404JSONResponse{}},
+		{"internal error", errors.New("boom"), gen.GetKindFields500JSONResponse{}},
+	}
+	for _, tt := range errCases {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := kindschemasvcmocks.NewMockService(t)
+			svc.EXPECT().GetFields(mock.Anything, "comp").Return(nil, tt.err)
+
+			resp, err := newHandler(svc).GetKindFields(ctx, request)
+			require.NoError(t, err)
+			assert.IsType(t, tt.want, resp)
+		})
+	}
+}
+
+func TestListKindSchemasHandler(t *testing.T) {
+	ctx := testContext()
+	newHandler := func(svc kindschemasvc.Service) *Handler {
+		return &Handler{
+			services: &handlerservices.Services{KindSchemaService: svc},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		svc := kindschemasvcmocks.NewMockService(t)
+		svc.EXPECT().ListSchemas(mock.Anything).Return([]*kindschemasvc.KindSchema{{
+			Kind: "Component", Group: "openchoreo.dev", Version: "v1alpha1",
+			Schema: &extv1.JSONSchemaProps{
+				Type:       "object",
+				Properties: map[string]extv1.JSONSchemaProps{"spec": {Type: "object"}},
+			},
+		}}, nil)
+
+		resp, err := newHandler(svc).ListKindSchemas(ctx, gen.ListKindSchemasRequestObject{})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ListKindSchemas200JSONResponse)
+		require.True(t, ok, "expected 200, got %T", resp)
+		require.Len(t, typed.Items, 1)
+		assert.Equal(t, "Component", typed.Items[0].Kind)
+		assert.Equal(t, "v1alpha1", typed.Items[0].Version)
+		assert.Equal(t, "object", typed.Items[0].Schema["type"])
+		assert.Equal(t, map[string]interface{}{"spec": map[string]interface{}{"type": "object"}}, typed.Items[0].Schema["properties"])
+	})
+
+	t.Run("internal error", func(t *testing.T) {
+		svc := kindschemasvcmocks.NewMockService(t)
+		svc.EXPECT().ListSchemas(mock.Anything).Return(nil, errors.New("boom"))
+
+		resp, err := newHandler(svc).ListKindSchemas(ctx, gen.ListKindSchemasRequestObject{})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ListKindSchemas500JSONResponse{}, resp)
+	})
+}
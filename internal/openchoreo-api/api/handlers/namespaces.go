@@ -22,9 +22,11 @@ func (h *Handler) ListNamespaces(
 ) (gen.ListNamespacesResponseObject, error) {
 	h.logger.Debug("ListNamespaces called")
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.NamespaceService.ListNamespaces(ctx, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[corev1.Namespace], error) {
+		return h.services.NamespaceService.ListNamespaces(ctx, opts)
+	})
 	if err != nil {
 		if errors.Is(err, services.ErrForbidden) {
 			return gen.ListNamespaces403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
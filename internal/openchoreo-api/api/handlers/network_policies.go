@@ -0,0 +1,294 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	environmentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment"
+	networkpolicysvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/networkpolicy"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+)
+
+// ListNetworkPolicyRules returns a paginated list of the network policy rules of a namespace.
+func (h *Handler) ListNetworkPolicyRules(
+	ctx context.Context,
+	request gen.ListNetworkPolicyRulesRequestObject,
+) (gen.ListNetworkPolicyRulesResponseObject, error) {
+	h.logger.Debug("ListNetworkPolicyRules called", "namespaceName", request.NamespaceName)
+
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, nil, nil)
+
+	result, err := h.services.NetworkPolicyService.ListNetworkPolicyRules(ctx, request.NamespaceName, opts)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.ListNetworkPolicyRules403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.ListNetworkPolicyRules400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to list network policy rules", "error", err)
+		return gen.ListNetworkPolicyRules500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	items, err := convertList[openchoreov1alpha1.NetworkPolicyRule, gen.NetworkPolicyRule](result.Items)
+	if err != nil {
+		h.logger.Error("Failed to convert network policy rules", "error", err)
+		return gen.ListNetworkPolicyRules500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.ListNetworkPolicyRules200JSONResponse{
+		Items:      items,
+		Pagination: ToPagination(result),
+	}, nil
+}
+
+// CreateNetworkPolicyRule creates a network policy rule within a namespace.
+func (h *Handler) CreateNetworkPolicyRule(
+	ctx context.Context,
+	request gen.CreateNetworkPolicyRuleRequestObject,
+) (gen.CreateNetworkPolicyRuleResponseObject, error) {
+	h.logger.Info("CreateNetworkPolicyRule called", "namespaceName", request.NamespaceName)
+
+	if request.Body == nil {
+		return gen.CreateNetworkPolicyRule400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	if strings.TrimSpace(request.Body.Metadata.Name) == "" {
+		return gen.CreateNetworkPolicyRule400JSONResponse{BadRequestJSONResponse: badRequest("metadata.name is required")}, nil
+	}
+
+	ruleCR, err := convert[gen.NetworkPolicyRule, openchoreov1alpha1.NetworkPolicyRule](*request.Body)
+	if err != nil {
+		h.logger.Error("Failed to convert create request", "error", err)
+		return gen.CreateNetworkPolicyRule400JSONResponse{BadRequestJSONResponse: badRequest("Invalid request body")}, nil
+	}
+
+	created, err := h.services.NetworkPolicyService.CreateNetworkPolicyRule(ctx, request.NamespaceName, &ruleCR)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.CreateNetworkPolicyRule403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, networkpolicysvc.ErrNetworkPolicyRuleAlreadyExists) {
+			return gen.CreateNetworkPolicyRule409JSONResponse{ConflictJSONResponse: conflict("Network policy rule already exists")}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.CreateNetworkPolicyRule400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to create network policy rule", "error", err)
+		return gen.CreateNetworkPolicyRule500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genRule, err := convert[openchoreov1alpha1.NetworkPolicyRule, gen.NetworkPolicyRule](*created)
+	if err != nil {
+		h.logger.Error("Failed to convert created network policy rule", "error", err)
+		return gen.CreateNetworkPolicyRule500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("Network policy rule created successfully", "namespaceName", request.NamespaceName, "networkPolicyRule", created.Name)
+	return gen.CreateNetworkPolicyRule201JSONResponse(genRule), nil
+}
+
+// GetNetworkPolicyRule returns a network policy rule.
+func (h *Handler) GetNetworkPolicyRule(
+	ctx context.Context,
+	request gen.GetNetworkPolicyRuleRequestObject,
+) (gen.GetNetworkPolicyRuleResponseObject, error) {
+	h.logger.Debug("GetNetworkPolicyRule called", "namespaceName", request.NamespaceName, "networkPolicyRuleName", request.NetworkPolicyRuleName)
+
+	rule, err := h.services.NetworkPolicyService.GetNetworkPolicyRule(ctx, request.NamespaceName, request.NetworkPolicyRuleName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.GetNetworkPolicyRule403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, networkpolicysvc.ErrNetworkPolicyRuleNotFound) {
+			return gen.GetNetworkPolicyRule404JSONResponse{NotFoundJSONResponse: notFound("NetworkPolicyRule")}, nil
+		}
+		h.logger.Error("Failed to get network policy rule", "error", err)
+		return gen.GetNetworkPolicyRule500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genRule, err := convert[openchoreov1alpha1.NetworkPolicyRule, gen.NetworkPolicyRule](*rule)
+	if err != nil {
+		h.logger.Error("Failed to convert network policy rule", "error", err)
+		return gen.GetNetworkPolicyRule500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.GetNetworkPolicyRule200JSONResponse(genRule), nil
+}
+
+// UpdateNetworkPolicyRule replaces an existing network policy rule (full update).
+func (h *Handler) UpdateNetworkPolicyRule(
+	ctx context.Context,
+	request gen.UpdateNetworkPolicyRuleRequestObject,
+) (gen.UpdateNetworkPolicyRuleResponseObject, error) {
+	h.logger.Info("UpdateNetworkPolicyRule called", "namespaceName", request.NamespaceName, "networkPolicyRuleName", request.NetworkPolicyRuleName)
+
+	if request.Body == nil {
+		return gen.UpdateNetworkPolicyRule400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	ruleCR, err := convert[gen.NetworkPolicyRule, openchoreov1alpha1.NetworkPolicyRule](*request.Body)
+	if err != nil {
+		h.logger.Error("Failed to convert update request", "error", err)
+		return gen.UpdateNetworkPolicyRule400JSONResponse{BadRequestJSONResponse: badRequest("Invalid request body")}, nil
+	}
+	// Ensure the name from the URL path is used
+	ruleCR.Name = request.NetworkPolicyRuleName
+
+	updated, err := h.services.NetworkPolicyService.UpdateNetworkPolicyRule(ctx, request.NamespaceName, &ruleCR)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.UpdateNetworkPolicyRule403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, networkpolicysvc.ErrNetworkPolicyRuleNotFound) {
+			return gen.UpdateNetworkPolicyRule404JSONResponse{NotFoundJSONResponse: notFound("NetworkPolicyRule")}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.UpdateNetworkPolicyRule400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to update network policy rule", "error", err)
+		return gen.UpdateNetworkPolicyRule500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genRule, err := convert[openchoreov1alpha1.NetworkPolicyRule, gen.NetworkPolicyRule](*updated)
+	if err != nil {
+		h.logger.Error("Failed to convert updated network policy rule", "error", err)
+		return gen.UpdateNetworkPolicyRule500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("Network policy rule updated successfully", "namespaceName", request.NamespaceName, "networkPolicyRule", updated.Name)
+	return gen.UpdateNetworkPolicyRule200JSONResponse(genRule), nil
+}
+
+// DeleteNetworkPolicyRule deletes a network policy rule by name.
+func (h *Handler) DeleteNetworkPolicyRule(
+	ctx context.Context,
+	request gen.DeleteNetworkPolicyRuleRequestObject,
+) (gen.DeleteNetworkPolicyRuleResponseObject, error) {
+	h.logger.Info("DeleteNetworkPolicyRule called", "namespaceName", request.NamespaceName, "networkPolicyRuleName", request.NetworkPolicyRuleName)
+
+	if err := h.services.NetworkPolicyService.DeleteNetworkPolicyRule(ctx, request.NamespaceName, request.NetworkPolicyRuleName); err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.DeleteNetworkPolicyRule403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, networkpolicysvc.ErrNetworkPolicyRuleNotFound) {
+			return gen.DeleteNetworkPolicyRule404JSONResponse{NotFoundJSONResponse: notFound("NetworkPolicyRule")}, nil
+		}
+		h.logger.Error("Failed to delete network policy rule", "error", err)
+		return gen.DeleteNetworkPolicyRule500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.logger.Info("Network policy rule deleted successfully", "namespaceName", request.NamespaceName, "networkPolicyRule", request.NetworkPolicyRuleName)
+	return gen.DeleteNetworkPolicyRule204Response{}, nil
+}
+
+// GetProjectNetworkPolicies returns the network policies rendered for the components of a project
+// in an environment.
+func (h *Handler) GetProjectNetworkPolicies(
+	ctx context.Context,
+	request gen.GetProjectNetworkPoliciesRequestObject,
+) (gen.GetProjectNetworkPoliciesResponseObject, error) {
+	h.logger.Debug("GetProjectNetworkPolicies called",
+		"namespaceName", request.NamespaceName,
+		"projectName", request.ProjectName,
+		"environment", request.Params.Environment)
+
+	if strings.TrimSpace(request.Params.Environment) == "" {
+		return gen.GetProjectNetworkPolicies400JSONResponse{BadRequestJSONResponse: badRequest("environment is required")}, nil
+	}
+
+	policies, err := h.services.NetworkPolicyService.GetProjectPolicies(ctx, request.NamespaceName, request.ProjectName, request.Params.Environment)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrForbidden):
+			return gen.GetProjectNetworkPolicies403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, projectsvc.ErrProjectNotFound):
+			return gen.GetProjectNetworkPolicies404JSONResponse{NotFoundJSONResponse: notFound("Project")}, nil
+		case errors.Is(err, environmentsvc.ErrEnvironmentNotFound):
+			return gen.GetProjectNetworkPolicies404JSONResponse{NotFoundJSONResponse: notFound("Environment")}, nil
+		}
+		h.logger.Error("Failed to get project network policies", "error", err)
+		return gen.GetProjectNetworkPolicies500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	components := make([]gen.ComponentNetworkPolicies, 0, len(policies.Components))
+	for _, cp := range policies.Components {
+		components = append(components, gen.ComponentNetworkPolicies{
+			ComponentName:      cp.ComponentName,
+			ReleaseBindingName: cp.ReleaseBindingName,
+			Policies:           cp.Policies,
+		})
+	}
+
+	return gen.GetProjectNetworkPolicies200JSONResponse{
+		ProjectName: policies.ProjectName,
+		Environment: policies.Environment,
+		Components:  components,
+	}, nil
+}
+
+// GetProjectNetworkAllowMatrix reports which callers may reach the component endpoints of a
+// project in an environment.
+func (h *Handler) GetProjectNetworkAllowMatrix(
+	ctx context.Context,
+	request gen.GetProjectNetworkAllowMatrixRequestObject,
+) (gen.GetProjectNetworkAllowMatrixResponseObject, error) {
+	h.logger.Debug("GetProjectNetworkAllowMatrix called",
+		"namespaceName", request.NamespaceName,
+		"projectName", request.ProjectName,
+		"environment", request.Params.Environment)
+
+	if strings.TrimSpace(request.Params.Environment) == "" {
+		return gen.GetProjectNetworkAllowMatrix400JSONResponse{BadRequestJSONResponse: badRequest("environment is required")}, nil
+	}
+
+	filter := networkpolicysvc.MatrixFilter{
+		SourceProject:        ptr.Deref(request.Params.SourceProject, ""),
+		SourceComponent:      ptr.Deref(request.Params.SourceComponent, ""),
+		DestinationComponent: ptr.Deref(request.Params.DestinationComponent, ""),
+		Endpoint:             ptr.Deref(request.Params.Endpoint, ""),
+		Allowed:              request.Params.Allowed,
+	}
+	matrix, err := h.services.NetworkPolicyService.GetAllowMatrix(ctx, request.NamespaceName, request.ProjectName, request.Params.Environment, filter)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrForbidden):
+			return gen.GetProjectNetworkAllowMatrix403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, projectsvc.ErrProjectNotFound):
+			return gen.GetProjectNetworkAllowMatrix404JSONResponse{NotFoundJSONResponse: notFound("Project")}, nil
+		case errors.Is(err, environmentsvc.ErrEnvironmentNotFound):
+			return gen.GetProjectNetworkAllowMatrix404JSONResponse{NotFoundJSONResponse: notFound("Environment")}, nil
+		}
+		h.logger.Error("Failed to get project network allow matrix", "error", err)
+		return gen.GetProjectNetworkAllowMatrix500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	entries := make([]gen.NetworkAllowMatrixEntry, 0, len(matrix.Entries))
+	for _, e := range matrix.Entries {
+		entry := gen.NetworkAllowMatrixEntry{
+			Allowed: e.Allowed,
+			Reason:  e.Reason,
+			Rule:    optionalString(e.Rule),
+		}
+		entry.Source.Project = optionalString(e.Source.Project)
+		entry.Source.Component = optionalString(e.Source.Component)
+		entry.Source.SystemComponent = e.Source.SystemComponent
+		entry.Destination.Component = e.Destination.Component
+		entry.Destination.Endpoint = e.Destination.Endpoint
+		entries = append(entries, entry)
+	}
+
+	return gen.GetProjectNetworkAllowMatrix200JSONResponse{
+		ProjectName: matrix.ProjectName,
+		Environment: matrix.Environment,
+		Entries:     entries,
+	}, nil
+}
@@ -0,0 +1,300 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	environmentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	networkpolicysvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/networkpolicy"
+	networkpolicysvcmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/networkpolicy/mocks"
+)
+
+func newHandlerWithNetworkPolicyService(svc networkpolicysvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{NetworkPolicyService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func testNetworkPolicyRule() *openchoreov1alpha1.NetworkPolicyRule {
+	return &openchoreov1alpha1.NetworkPolicyRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "deny-legacy", Namespace: "test-ns"},
+		Spec: openchoreov1alpha1.NetworkPolicyRuleSpec{
+			Action:      openchoreov1alpha1.NetworkPolicyRuleActionDeny,
+			Source:      openchoreov1alpha1.NetworkPolicyRulePeer{Project: "legacy"},
+			Destination: openchoreov1alpha1.NetworkPolicyRuleDestination{Project: "shop"},
+		},
+	}
+}
+
+func TestCreateNetworkPolicyRuleHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+	body := &gen.NetworkPolicyRule{
+		Metadata: gen.ObjectMeta{Name: "deny-legacy"},
+		Spec: &gen.NetworkPolicyRuleSpec{
+			Action: gen.Deny,
+		},
+	}
+	body.Spec.Destination.Project = "shop"
+
+	t.Run("nil body returns 400", func(t *testing.T) {
+		h := newHandlerWithNetworkPolicyService(networkpolicysvcmocks.NewMockService(t))
+		resp, err := h.CreateNetworkPolicyRule(ctx, gen.CreateNetworkPolicyRuleRequestObject{NamespaceName: ns})
+		require.NoError(t, err)
+		assert.IsType(t, gen.CreateNetworkPolicyRule400JSONResponse{}, resp)
+	})
+
+	t.Run("missing name returns 400", func(t *testing.T) {
+		h := newHandlerWithNetworkPolicyService(networkpolicysvcmocks.NewMockService(t))
+		resp, err := h.CreateNetworkPolicyRule(ctx, gen.CreateNetworkPolicyRuleRequestObject{
+			NamespaceName: ns,
+			Body:          &gen.NetworkPolicyRule{Metadata: gen.ObjectMeta{Name: " "}},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.CreateNetworkPolicyRule400JSONResponse{}, resp)
+	})
+
+	t.Run("success returns created rule", func(t *testing.T) {
+		svc := networkpolicysvcmocks.NewMockService(t)
+		svc.EXPECT().CreateNetworkPolicyRule(mock.Anything, ns, mock.MatchedBy(func(rule *openchoreov1alpha1.NetworkPolicyRule) bool {
+			return rule.Name == "deny-legacy" && rule.Spec.Action == openchoreov1alpha1.NetworkPolicyRuleActionDeny &&
+				rule.Spec.Destination.Project == "shop"
+		})).Return(testNetworkPolicyRule(), nil)
+
+		resp, err := newHandlerWithNetworkPolicyService(svc).CreateNetworkPolicyRule(ctx, gen.CreateNetworkPolicyRuleRequestObject{
+			NamespaceName: ns,
+			Body:          body,
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.CreateNetworkPolicyRule201JSONResponse)
+		require.True(t, ok, "expected 201 response, got %T", resp)
+		assert.Equal(t, "deny-legacy", typed.Metadata.Name)
+		assert.Equal(t, "legacy", ptr.Deref(typed.Spec.Source.Project, ""))
+	})
+
+	t.Run("already exists returns 409", func(t *testing.T) {
+		svc := networkpolicysvcmocks.NewMockService(t)
+		svc.EXPECT().CreateNetworkPolicyRule(mock.Anything, ns, mock.Anything).Return(nil, networkpolicysvc.ErrNetworkPolicyRuleAlreadyExists)
+
+		resp, err := newHandlerWithNetworkPolicyService(svc).CreateNetworkPolicyRule(ctx, gen.CreateNetworkPolicyRuleRequestObject{
+			NamespaceName: ns,
+			Body:          body,
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.CreateNetworkPolicyRule409JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := networkpolicysvcmocks.NewMockService(t)
+		svc.EXPECT().CreateNetworkPolicyRule(mock.Anything, ns, mock.Anything).Return(nil, svcpkg.ErrForbidden)
+
+		resp, err := newHandlerWithNetworkPolicyService(svc).CreateNetworkPolicyRule(ctx, gen.CreateNetworkPolicyRuleRequestObject{
+			NamespaceName: ns,
+			Body:          body,
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.CreateNetworkPolicyRule403JSONResponse{}, resp)
+	})
+}
+
+func TestUpdateNetworkPolicyRuleHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("uses the name from the path", func(t *testing.T) {
+		svc := networkpolicysvcmocks.NewMockService(t)
+		svc.EXPECT().UpdateNetworkPolicyRule(mock.Anything, ns, mock.MatchedBy(func(rule *openchoreov1alpha1.NetworkPolicyRule) bool {
+			return rule.Name == "deny-legacy"
+		})).Return(testNetworkPolicyRule(), nil)
+
+		resp, err := newHandlerWithNetworkPolicyService(svc).UpdateNetworkPolicyRule(ctx, gen.UpdateNetworkPolicyRuleRequestObject{
+			NamespaceName:         ns,
+			NetworkPolicyRuleName: "deny-legacy",
+			Body:                  &gen.NetworkPolicyRule{Metadata: gen.ObjectMeta{Name: "other"}},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.UpdateNetworkPolicyRule200JSONResponse{}, resp)
+	})
+
+	t.Run("not found returns 404", func(t *testing.T) {
+		svc := networkpolicysvcmocks.NewMockService(t)
+		svc.EXPECT().UpdateNetworkPolicyRule(mock.Anything, ns, mock.Anything).Return(nil, networkpolicysvc.ErrNetworkPolicyRuleNotFound)
+
+		resp, err := newHandlerWithNetworkPolicyService(svc).UpdateNetworkPolicyRule(ctx, gen.UpdateNetworkPolicyRuleRequestObject{
+			NamespaceName:         ns,
+			NetworkPolicyRuleName: "deny-legacy",
+			Body:                  &gen.NetworkPolicyRule{},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.UpdateNetworkPolicyRule404JSONResponse{}, resp)
+	})
+}
+
+func TestGetNetworkPolicyRuleHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("not found returns 404", func(t *testing.T) {
+		svc := networkpolicysvcmocks.NewMockService(t)
+		svc.EXPECT().GetNetworkPolicyRule(mock.Anything, ns, "missing").Return(nil, networkpolicysvc.ErrNetworkPolicyRuleNotFound)
+
+		resp, err := newHandlerWithNetworkPolicyService(svc).GetNetworkPolicyRule(ctx, gen.GetNetworkPolicyRuleRequestObject{
+			NamespaceName:         ns,
+			NetworkPolicyRuleName: "missing",
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetNetworkPolicyRule404JSONResponse{}, resp)
+	})
+}
+
+func TestDeleteNetworkPolicyRuleHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success returns 204", func(t *testing.T) {
+		svc := networkpolicysvcmocks.NewMockService(t)
+		svc.EXPECT().DeleteNetworkPolicyRule(mock.Anything, ns, "deny-legacy").Return(nil)
+
+		resp, err := newHandlerWithNetworkPolicyService(svc).DeleteNetworkPolicyRule(ctx, gen.DeleteNetworkPolicyRuleRequestObject{
+			NamespaceName:         ns,
+			NetworkPolicyRuleName: "deny-legacy",
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.DeleteNetworkPolicyRule204Response{}, resp)
+	})
+
+	t.Run("unexpected error returns 500", func(t *testing.T) {
+		svc := networkpolicysvcmocks.NewMockService(t)
+		svc.EXPECT().DeleteNetworkPolicyRule(mock.Anything, ns, "deny-legacy").Return(errors.New("boom"))
+
+		resp, err := newHandlerWithNetworkPolicyService(svc).DeleteNetworkPolicyRule(ctx, gen.DeleteNetworkPolicyRuleRequestObject{
+			NamespaceName:         ns,
+			NetworkPolicyRuleName: "deny-legacy",
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.DeleteNetworkPolicyRule500JSONResponse{}, resp)
+	})
+}
+
+func TestGetProjectNetworkPoliciesHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("missing environment returns 400", func(t *testing.T) {
+		h := newHandlerWithNetworkPolicyService(networkpolicysvcmocks.NewMockService(t))
+		resp, err := h.GetProjectNetworkPolicies(ctx, gen.GetProjectNetworkPoliciesRequestObject{NamespaceName: ns, ProjectName: "shop"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetProjectNetworkPolicies400JSONResponse{}, resp)
+	})
+
+	t.Run("success returns policies", func(t *testing.T) {
+		svc := networkpolicysvcmocks.NewMockService(t)
+		svc.EXPECT().GetProjectPolicies(mock.Anything, ns, "shop", "dev").Return(&networkpolicysvc.ProjectPolicies{
+			ProjectName: "shop",
+			Environment: "dev",
+			Components: []networkpolicysvc.ComponentPolicies{{
+				ComponentName:      "orders",
+				ReleaseBindingName: "orders-dev",
+				Policies:           []map[string]any{{"kind": "NetworkPolicy"}},
+			}},
+		}, nil)
+
+		resp, err := newHandlerWithNetworkPolicyService(svc).GetProjectNetworkPolicies(ctx, gen.GetProjectNetworkPoliciesRequestObject{
+			NamespaceName: ns,
+			ProjectName:   "shop",
+			Params:        gen.GetProjectNetworkPoliciesParams{Environment: "dev"},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetProjectNetworkPolicies200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.Len(t, typed.Components, 1)
+		assert.Equal(t, "orders-dev", typed.Components[0].ReleaseBindingName)
+		assert.Equal(t, "NetworkPolicy", typed.Components[0].Policies[0]["kind"])
+	})
+
+	t.Run("environment not found returns 404", func(t *testing.T) {
+		svc := networkpolicysvcmocks.NewMockService(t)
+		svc.EXPECT().GetProjectPolicies(mock.Anything, ns, "shop", "dev").Return(nil, environmentsvc.ErrEnvironmentNotFound)
+
+		resp, err := newHandlerWithNetworkPolicyService(svc).GetProjectNetworkPolicies(ctx, gen.GetProjectNetworkPoliciesRequestObject{
+			NamespaceName: ns,
+			ProjectName:   "shop",
+			Params:        gen.GetProjectNetworkPoliciesParams{Environment: "dev"},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetProjectNetworkPolicies404JSONResponse{}, resp)
+	})
+}
+
+func TestGetProjectNetworkAllowMatrixHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("passes the filter and converts entries", func(t *testing.T) {
+		svc := networkpolicysvcmocks.NewMockService(t)
+		svc.EXPECT().GetAllowMatrix(mock.Anything, ns, "shop", "dev", networkpolicysvc.MatrixFilter{
+			SourceProject: "legacy",
+			Endpoint:      "api",
+			Allowed:       ptr.To(false),
+		}).Return(&networkpolicysvc.AllowMatrix{
+			ProjectName: "shop",
+			Environment: "dev",
+			Entries: []networkpolicysvc.MatrixEntry{{
+				Source:      networkpolicysvc.Source{Project: "legacy", Component: "app"},
+				Destination: networkpolicysvc.Destination{Component: "orders", Endpoint: "api"},
+				Reason:      "DeniedByRule",
+				Rule:        "deny-legacy",
+			}},
+		}, nil)
+
+		resp, err := newHandlerWithNetworkPolicyService(svc).GetProjectNetworkAllowMatrix(ctx, gen.GetProjectNetworkAllowMatrixRequestObject{
+			NamespaceName: ns,
+			ProjectName:   "shop",
+			Params: gen.GetProjectNetworkAllowMatrixParams{
+				Environment:   "dev",
+				SourceProject: ptr.To("legacy"),
+				Endpoint:      ptr.To("api"),
+				Allowed:       ptr.To(false),
+			},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetProjectNetworkAllowMatrix200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.Len(t, typed.Entries, 1)
+		entry := typed.Entries[0]
+		assert.False(t, entry.Allowed)
+		assert.Equal(t, "legacy", ptr.Deref(entry.Source.Project, ""))
+		assert.False(t, entry.Source.SystemComponent)
+		assert.Equal(t, "orders", entry.Destination.Component)
+		assert.Equal(t, "deny-legacy", ptr.Deref(entry.Rule, ""))
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := networkpolicysvcmocks.NewMockService(t)
+		svc.EXPECT().GetAllowMatrix(mock.Anything, ns, "shop", "dev", networkpolicysvc.MatrixFilter{}).Return(nil, svcpkg.ErrForbidden)
+
+		resp, err := newHandlerWithNetworkPolicyService(svc).GetProjectNetworkAllowMatrix(ctx, gen.GetProjectNetworkAllowMatrixRequestObject{
+			NamespaceName: ns,
+			ProjectName:   "shop",
+			Params:        gen.GetProjectNetworkAllowMatrixParams{Environment: "dev"},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetProjectNetworkAllowMatrix403JSONResponse{}, resp)
+	})
+}
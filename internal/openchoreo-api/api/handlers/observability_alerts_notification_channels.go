@@ -21,9 +21,11 @@ func (h *Handler) ListObservabilityAlertsNotificationChannels(
 ) (gen.ListObservabilityAlertsNotificationChannelsResponseObject, error) {
 	h.logger.Debug("ListObservabilityAlertsNotificationChannels called", "namespaceName", request.NamespaceName)
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.ObservabilityAlertsNotificationChannelService.ListObservabilityAlertsNotificationChannels(ctx, request.NamespaceName, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ObservabilityAlertsNotificationChannel], error) {
+		return h.services.ObservabilityAlertsNotificationChannelService.ListObservabilityAlertsNotificationChannels(ctx, request.NamespaceName, opts)
+	})
 	if err != nil {
 		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
 			return gen.ListObservabilityAlertsNotificationChannels400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
@@ -21,9 +21,11 @@ func (h *Handler) ListObservabilityPlanes(
 ) (gen.ListObservabilityPlanesResponseObject, error) {
 	h.logger.Debug("ListObservabilityPlanes called", "namespaceName", request.NamespaceName)
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.ObservabilityPlaneService.ListObservabilityPlanes(ctx, request.NamespaceName, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ObservabilityPlane], error) {
+		return h.services.ObservabilityPlaneService.ListObservabilityPlanes(ctx, request.NamespaceName, opts)
+	})
 	if err != nil {
 		if errors.Is(err, services.ErrForbidden) {
 			return gen.ListObservabilityPlanes403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
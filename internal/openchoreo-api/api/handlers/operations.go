@@ -6,8 +6,10 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"strings"
 
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	healthsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/health"
 	"github.com/openchoreo/openchoreo/internal/version"
 )
 
@@ -63,6 +65,38 @@ func (h *Handler) GetOpenAPISpec(
 	return gen.GetOpenAPISpec200JSONResponse(spec), nil
 }
 
+// apiDocsPage is a Swagger UI page for the specification served at /openapi.json.
+// The URL is relative so the page also works when the API is served under a path prefix.
+const apiDocsPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>OpenChoreo API Reference</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5.17.14/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5.17.14/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.onload = () => {
+      window.ui = SwaggerUIBundle({ url: "openapi.json", dom_id: "#swagger-ui", deepLinking: true });
+    };
+  </script>
+</body>
+</html>
+`
+
+// GetAPIDocs serves a Swagger UI page for browsing the OpenAPI specification
+func (h *Handler) GetAPIDocs(
+	ctx context.Context,
+	request gen.GetAPIDocsRequestObject,
+) (gen.GetAPIDocsResponseObject, error) {
+	return gen.GetAPIDocs200TexthtmlResponse{
+		Body:          strings.NewReader(apiDocsPage),
+		ContentLength: int64(len(apiDocsPage)),
+	}, nil
+}
+
 // GetReady returns Ready if the server is ready to accept requests
 func (h *Handler) GetReady(
 	ctx context.Context,
@@ -70,3 +104,54 @@ func (h *Handler) GetReady(
 ) (gen.GetReadyResponseObject, error) {
 	return gen.GetReady200TextResponse("Ready"), nil
 }
+
+// GetHealthz returns the status of the liveness checks
+func (h *Handler) GetHealthz(
+	ctx context.Context,
+	request gen.GetHealthzRequestObject,
+) (gen.GetHealthzResponseObject, error) {
+	report := toHealthReport(h.services.HealthService.Liveness(ctx))
+	if report.Status == gen.HealthReportStatusFailed {
+		return gen.GetHealthz503JSONResponse(report), nil
+	}
+	return gen.GetHealthz200JSONResponse(report), nil
+}
+
+// GetReadyz returns the status of the readiness checks
+func (h *Handler) GetReadyz(
+	ctx context.Context,
+	request gen.GetReadyzRequestObject,
+) (gen.GetReadyzResponseObject, error) {
+	report := toHealthReport(h.services.HealthService.Readiness(ctx))
+	if report.Status == gen.HealthReportStatusFailed {
+		return gen.GetReadyz503JSONResponse(report), nil
+	}
+	return gen.GetReadyz200JSONResponse(report), nil
+}
+
+// GetDiagnostics returns the status of every health check, including the
+// diagnostic ones. The report is returned as is whatever its status.
+func (h *Handler) GetDiagnostics(
+	ctx context.Context,
+	request gen.GetDiagnosticsRequestObject,
+) (gen.GetDiagnosticsResponseObject, error) {
+	return gen.GetDiagnostics200JSONResponse(toHealthReport(h.services.HealthService.Diagnostics(ctx))), nil
+}
+
+func toHealthReport(report *healthsvc.Report) gen.HealthReport {
+	checks := make(map[string]gen.HealthCheckResult, len(report.Checks))
+	for name, result := range report.Checks {
+		check := gen.HealthCheckResult{
+			Status:     gen.HealthCheckResultStatus(result.Status),
+			DurationMs: result.Duration.Milliseconds(),
+		}
+		if result.Message != "" {
+			check.Message = &result.Message
+		}
+		checks[name] = check
+	}
+	return gen.HealthReport{
+		Status: gen.HealthReportStatus(report.Status),
+		Checks: checks,
+	}
+}
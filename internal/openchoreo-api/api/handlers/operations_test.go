@@ -5,13 +5,20 @@ package handlers
 
 import (
 	"context"
+	"errors"
+	"io"
 	"log/slog"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	healthsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/health"
 )
 
 func newMinimalHandler() *Handler {
@@ -36,6 +43,95 @@ func TestGetReady(t *testing.T) {
 	assert.Equal(t, "Ready", string(typed))
 }
 
+func newHealthHandler(checks ...healthsvc.Check) *Handler {
+	return &Handler{
+		logger: slog.Default(),
+		services: &handlerservices.Services{
+			HealthService: healthsvc.NewService(checks, time.Second, slog.New(slog.NewTextHandler(io.Discard, nil))),
+		},
+	}
+}
+
+func healthCheck(name string, err error, liveness, optional bool) healthsvc.Check {
+	return healthsvc.Check{
+		Name:     name,
+		Run:      func(context.Context) error { return err },
+		Liveness: liveness,
+		Optional: optional,
+	}
+}
+
+func TestGetHealthz(t *testing.T) {
+	t.Run("runs only liveness checks", func(t *testing.T) {
+		h := newHealthHandler(
+			healthCheck("kubernetes", nil, true, false),
+			healthCheck("crds", errors.New("missing"), false, false),
+		)
+		resp, err := h.GetHealthz(context.Background(), gen.GetHealthzRequestObject{})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetHealthz200JSONResponse)
+		require.True(t, ok, "expected 200 JSON response, got %T", resp)
+		assert.Equal(t, gen.HealthReportStatusOk, typed.Status)
+		assert.Len(t, typed.Checks, 1)
+		assert.Equal(t, gen.HealthCheckResultStatusOk, typed.Checks["kubernetes"].Status)
+	})
+
+	t.Run("failed liveness check returns 503", func(t *testing.T) {
+		h := newHealthHandler(healthCheck("kubernetes", errors.New("connection refused"), true, false))
+		resp, err := h.GetHealthz(context.Background(), gen.GetHealthzRequestObject{})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetHealthz503JSONResponse)
+		require.True(t, ok, "expected 503 JSON response, got %T", resp)
+		assert.Equal(t, gen.HealthReportStatusFailed, typed.Status)
+		require.NotNil(t, typed.Checks["kubernetes"].Message)
+		assert.Equal(t, "connection refused", *typed.Checks["kubernetes"].Message)
+	})
+}
+
+func TestGetReadyz(t *testing.T) {
+	t.Run("optional failure degrades the report", func(t *testing.T) {
+		h := newHealthHandler(
+			healthCheck("kubernetes", nil, true, false),
+			healthCheck("dataPlanes", errors.New("1 of 1 data planes are not connected"), false, true),
+		)
+		resp, err := h.GetReadyz(context.Background(), gen.GetReadyzRequestObject{})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetReadyz200JSONResponse)
+		require.True(t, ok, "expected 200 JSON response, got %T", resp)
+		assert.Equal(t, gen.HealthReportStatusDegraded, typed.Status)
+		assert.Equal(t, gen.HealthCheckResultStatusFailed, typed.Checks["dataPlanes"].Status)
+	})
+
+	t.Run("required failure returns 503", func(t *testing.T) {
+		h := newHealthHandler(
+			healthCheck("kubernetes", nil, true, false),
+			healthCheck("crds", errors.New("1 openchoreo.dev/v1alpha1 kinds are not served: Component"), false, false),
+		)
+		resp, err := h.GetReadyz(context.Background(), gen.GetReadyzRequestObject{})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetReadyz503JSONResponse)
+		require.True(t, ok, "expected 503 JSON response, got %T", resp)
+		assert.Equal(t, gen.HealthReportStatusFailed, typed.Status)
+		assert.Len(t, typed.Checks, 2)
+	})
+}
+
+func TestGetDiagnostics(t *testing.T) {
+	webhooks := healthCheck("webhookCertificates", errors.New("no CA bundle"), false, true)
+	webhooks.Diagnostic = true
+	h := newHealthHandler(
+		healthCheck("crds", errors.New("1 openchoreo.dev/v1alpha1 kinds are not served: Component"), false, false),
+		webhooks,
+	)
+	resp, err := h.GetDiagnostics(context.Background(), gen.GetDiagnosticsRequestObject{})
+	require.NoError(t, err)
+	typed, ok := resp.(gen.GetDiagnostics200JSONResponse)
+	require.True(t, ok, "expected 200 JSON response, got %T", resp)
+	assert.Equal(t, gen.HealthReportStatusFailed, typed.Status)
+	assert.Len(t, typed.Checks, 2)
+	assert.Equal(t, gen.HealthCheckResultStatusFailed, typed.Checks["webhookCertificates"].Status)
+}
+
 func TestGetVersion(t *testing.T) {
 	h := newMinimalHandler()
 	resp, err := h.GetVersion(context.Background(), gen.GetVersionRequestObject{})
@@ -52,3 +148,38 @@ func TestGetOpenAPISpec(t *testing.T) {
 	require.True(t, ok, "expected 200 JSON response, got %T", resp)
 	assert.NotEmpty(t, typed)
 }
+
+func TestGetAPIDocs(t *testing.T) {
+	h := newMinimalHandler()
+	resp, err := h.GetAPIDocs(context.Background(), gen.GetAPIDocsRequestObject{})
+	require.NoError(t, err)
+	typed, ok := resp.(gen.GetAPIDocs200TexthtmlResponse)
+	require.True(t, ok, "expected 200 HTML response, got %T", resp)
+	body, err := io.ReadAll(typed.Body)
+	require.NoError(t, err)
+	assert.EqualValues(t, len(body), typed.ContentLength)
+	assert.Contains(t, string(body), `url: "openapi.json"`)
+}
+
+// TestEmbeddedOpenAPISpecIsCurrent fails when openapi/openchoreo-api.yaml was
+// changed without regenerating the gen package (make openapi-codegen), so the
+// spec served at /openapi.json always matches the routes and handlers.
+func TestEmbeddedOpenAPISpecIsCurrent(t *testing.T) {
+	source, err := openapi3.NewLoader().LoadFromFile("../../../../openapi/openchoreo-api.yaml")
+	require.NoError(t, err)
+	embedded, err := gen.GetSwagger()
+	require.NoError(t, err)
+
+	// The generator exports operation IDs in the embedded copy.
+	for _, item := range source.Paths.Map() {
+		for _, op := range item.Operations() {
+			op.OperationID = strings.ToUpper(op.OperationID[:1]) + op.OperationID[1:]
+		}
+	}
+
+	want, err := source.MarshalJSON()
+	require.NoError(t, err)
+	got, err := embedded.MarshalJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, string(want), string(got), "generated code is out of date; run make openapi-codegen")
+}
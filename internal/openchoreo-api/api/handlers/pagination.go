@@ -13,8 +13,13 @@ const (
 	maxPageLimit     = 100
 )
 
-// NormalizeListOptions clamps the limit to [1, maxPageLimit] and extracts the cursor and label selector.
-func NormalizeListOptions(limit *gen.LimitParam, cursor *gen.CursorParam, labelSelector *gen.LabelSelectorParam) services.ListOptions {
+// NormalizeListOptions clamps the limit to [1, maxPageLimit] and extracts the cursor, label selector and sort.
+func NormalizeListOptions(
+	limit *gen.LimitParam,
+	cursor *gen.CursorParam,
+	labelSelector *gen.LabelSelectorParam,
+	sort *gen.SortParam,
+) services.ListOptions {
 	l := defaultPageLimit
 	if limit != nil {
 		l = *limit
@@ -35,10 +40,16 @@ func NormalizeListOptions(limit *gen.LimitParam, cursor *gen.CursorParam, labelS
 		ls = *labelSelector
 	}
 
+	var srt string
+	if sort != nil {
+		srt = string(*sort)
+	}
+
 	return services.ListOptions{
 		Limit:         l,
 		Cursor:        c,
 		LabelSelector: ls,
+		Sort:          srt,
 	}
 }
 
@@ -48,5 +59,13 @@ func ToPagination[T any](result *services.ListResult[T]) gen.Pagination {
 	if result.NextCursor != "" {
 		p.NextCursor = &result.NextCursor
 	}
+	p.RemainingCount = result.RemainingCount
+	p.TotalCount = result.TotalCount
+	if result.SortKey != "" {
+		p.SortKey = &result.SortKey
+	}
+	if len(result.AppliedFilters) > 0 {
+		p.AppliedFilters = &result.AppliedFilters
+	}
 	return p
 }
@@ -16,37 +16,40 @@ import (
 
 func TestNormalizeListOptions(t *testing.T) {
 	t.Run("defaults when all nil", func(t *testing.T) {
-		opts := NormalizeListOptions(nil, nil, nil)
+		opts := NormalizeListOptions(nil, nil, nil, nil)
 		assert.Equal(t, defaultPageLimit, opts.Limit)
 		assert.Empty(t, opts.Cursor)
 		assert.Empty(t, opts.LabelSelector)
+		assert.Empty(t, opts.Sort)
 	})
 
 	t.Run("uses provided values", func(t *testing.T) {
-		opts := NormalizeListOptions(ptr.To(gen.LimitParam(50)), ptr.To(gen.CursorParam("abc")), ptr.To(gen.LabelSelectorParam("app=test")))
+		opts := NormalizeListOptions(ptr.To(gen.LimitParam(50)), ptr.To(gen.CursorParam("abc")),
+			ptr.To(gen.LabelSelectorParam("app=test")), ptr.To(gen.SortParam("-creationTimestamp")))
 		assert.Equal(t, 50, opts.Limit)
 		assert.Equal(t, "abc", opts.Cursor)
 		assert.Equal(t, "app=test", opts.LabelSelector)
+		assert.Equal(t, "-creationTimestamp", opts.Sort)
 	})
 
 	t.Run("clamps limit below 1 to 1", func(t *testing.T) {
-		opts := NormalizeListOptions(ptr.To(gen.LimitParam(0)), nil, nil)
+		opts := NormalizeListOptions(ptr.To(gen.LimitParam(0)), nil, nil, nil)
 		assert.Equal(t, 1, opts.Limit)
 
-		opts = NormalizeListOptions(ptr.To(gen.LimitParam(-5)), nil, nil)
+		opts = NormalizeListOptions(ptr.To(gen.LimitParam(-5)), nil, nil, nil)
 		assert.Equal(t, 1, opts.Limit)
 	})
 
 	t.Run("clamps limit above max to max", func(t *testing.T) {
-		opts := NormalizeListOptions(ptr.To(gen.LimitParam(500)), nil, nil)
+		opts := NormalizeListOptions(ptr.To(gen.LimitParam(500)), nil, nil, nil)
 		assert.Equal(t, maxPageLimit, opts.Limit)
 	})
 
 	t.Run("limit at boundaries", func(t *testing.T) {
-		opts := NormalizeListOptions(ptr.To(gen.LimitParam(1)), nil, nil)
+		opts := NormalizeListOptions(ptr.To(gen.LimitParam(1)), nil, nil, nil)
 		assert.Equal(t, 1, opts.Limit)
 
-		opts = NormalizeListOptions(ptr.To(gen.LimitParam(maxPageLimit)), nil, nil)
+		opts = NormalizeListOptions(ptr.To(gen.LimitParam(maxPageLimit)), nil, nil, nil)
 		assert.Equal(t, maxPageLimit, opts.Limit)
 	})
 }
@@ -75,5 +78,24 @@ func TestToPagination(t *testing.T) {
 		result := &services.ListResult[string]{}
 		p := ToPagination(result)
 		assert.Nil(t, p.NextCursor)
+		assert.Nil(t, p.TotalCount)
+		assert.Nil(t, p.SortKey)
+		assert.Nil(t, p.AppliedFilters)
+	})
+
+	t.Run("sets counts, sort key and filters", func(t *testing.T) {
+		result := &services.ListResult[string]{
+			Items:          []string{"a"},
+			NextCursor:     "cursor-123",
+			RemainingCount: ptr.To(int64(4)),
+			TotalCount:     ptr.To(int64(5)),
+			SortKey:        "-name",
+		}
+		p := ToPagination(result.WithFilter("project", "proj-a").WithFilter("component", ""))
+		assert.Equal(t, ptr.To(int64(4)), p.RemainingCount)
+		assert.Equal(t, ptr.To(int64(5)), p.TotalCount)
+		assert.Equal(t, ptr.To("-name"), p.SortKey)
+		require.NotNil(t, p.AppliedFilters)
+		assert.Equal(t, map[string]string{"project": "proj-a"}, *p.AppliedFilters)
 	})
 }
@@ -0,0 +1,200 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	gatewayClient "github.com/openchoreo/openchoreo/internal/clients/gateway"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// PortForwardHandler handles WebSocket port-forward requests for component pods.
+// Each WebSocket carries a single TCP connection to one pod port.
+type PortForwardHandler struct {
+	// pods resolves the target environment and pod the same way exec does.
+	pods           *ExecHandler
+	gatewayURL     string
+	gatewayTLSConf *tls.Config
+	authzChecker   *svcpkg.AuthzChecker
+	logger         *slog.Logger
+}
+
+// NewPortForwardHandler creates a new port-forward handler.
+func NewPortForwardHandler(k8sClient client.Client, gwClient *gatewayClient.Client, gatewayURL string, gwTLSConf *tls.Config, authzChecker *svcpkg.AuthzChecker, logger *slog.Logger) *PortForwardHandler {
+	return &PortForwardHandler{
+		pods:           NewExecHandler(k8sClient, gwClient, gatewayURL, gwTLSConf, authzChecker, logger),
+		gatewayURL:     gatewayURL,
+		gatewayTLSConf: gwTLSConf,
+		authzChecker:   authzChecker,
+		logger:         logger.With("component", "portforward-handler"),
+	}
+}
+
+// ServeHTTP dials the gateway port-forward endpoint and, once the data plane has
+// accepted the connection, upgrades the client and bridges the two WebSockets.
+// URL: /portforward/namespaces/{namespace}/components/{component}?env=...&project=...&pod=...&port=...
+func (h *PortForwardHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Parse URL path: /portforward/namespaces/{namespace}/components/{component}
+	path := strings.TrimPrefix(r.URL.Path, "/portforward/namespaces/")
+	parts := strings.SplitN(path, "/components/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		http.Error(w, "invalid port-forward URL: expected /portforward/namespaces/{ns}/components/{name}", http.StatusBadRequest)
+		return
+	}
+	namespace := parts[0]
+	componentName := parts[1]
+
+	query := r.URL.Query()
+	project := query.Get("project")
+	envName := query.Get("env")
+	podName := query.Get("pod")
+	port, err := strconv.Atoi(query.Get("port"))
+	if err != nil || port < 1 || port > 65535 {
+		http.Error(w, "port query parameter must be between 1 and 65535", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	logger := h.logger.With("namespace", namespace, "component", componentName, "port", port)
+	logger.Info("Port-forward request received", "env", envName, "pod", podName)
+
+	effectiveEnv, err := h.pods.resolveEnvName(ctx, namespace, project, envName)
+	if err != nil {
+		status := http.StatusBadRequest
+		var infraErr *execInfraError
+		if errors.As(err, &infraErr) {
+			status = http.StatusServiceUnavailable
+		}
+		logger.Warn("Failed to resolve environment for port-forward", "error", err)
+		http.Error(w, fmt.Sprintf("failed to resolve environment: %v", err), status)
+		return
+	}
+
+	// Authorize: check that the caller has component:portforward permission for this environment.
+	if h.authzChecker == nil {
+		logger.Error("Authorization checker not configured")
+		http.Error(w, "authorization not configured", http.StatusInternalServerError)
+		return
+	}
+	if err := h.authzChecker.Check(ctx, svcpkg.CheckRequest{
+		Action:       authz.ActionPortForwardComponent,
+		ResourceType: "component",
+		ResourceID:   componentName,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespace,
+			Project:   project,
+		},
+		Context: authz.Context{
+			Resource: authz.ResourceAttribute{
+				Environment: svcpkg.FormatDualScopedResourceName(namespace, effectiveEnv, false),
+			},
+		},
+	}); err != nil {
+		if errors.Is(err, svcpkg.ErrForbidden) {
+			http.Error(w, "you do not have permission to port-forward to this component", http.StatusForbidden)
+			return
+		}
+		logger.Error("Authorization check failed", "error", err)
+		http.Error(w, "authorization check failed", http.StatusInternalServerError)
+		return
+	}
+
+	podInfo, err := h.pods.resolvePod(ctx, namespace, componentName, project, effectiveEnv, podName)
+	if err != nil {
+		status := http.StatusBadRequest
+		var infraErr *execInfraError
+		if errors.As(err, &infraErr) {
+			logger.Error("Infrastructure error resolving pod for port-forward", "error", err)
+			status = http.StatusServiceUnavailable
+		} else {
+			logger.Warn("Failed to resolve pod for port-forward", "error", err)
+		}
+		http.Error(w, fmt.Sprintf("failed to resolve pod: %v", err), status)
+		return
+	}
+
+	logger = logger.With("pod", podInfo.podName, "podNamespace", podInfo.podNamespace,
+		"planeType", podInfo.plane.planeType, "planeID", podInfo.plane.planeID)
+
+	gwURL, err := h.buildGatewayPortForwardURL(podInfo, port)
+	if err != nil {
+		logger.Error("Failed to build gateway port-forward URL", "error", err)
+		http.Error(w, fmt.Sprintf("internal error: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	// Dial the gateway before upgrading the client, so a pod that is not
+	// listening on the port is reported as a plain HTTP error.
+	gwDialer := websocket.Dialer{
+		TLSClientConfig: h.gatewayTLSConf,
+	}
+	gwConn, resp, err := gwDialer.DialContext(ctx, gwURL, nil)
+	if err != nil {
+		status := http.StatusBadGateway
+		msg := err.Error()
+		if resp != nil {
+			body, _ := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+			status = resp.StatusCode
+			if text := strings.TrimSpace(string(body)); text != "" {
+				msg = text
+			}
+		}
+		logger.Warn("Failed to connect to gateway port-forward endpoint", "error", err, "status", status)
+		http.Error(w, fmt.Sprintf("failed to connect to data plane: %s", msg), status)
+		return
+	}
+	defer gwConn.Close()
+
+	clientConn, err := execUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		logger.Error("Failed to upgrade to WebSocket", "error", err)
+		return
+	}
+	defer clientConn.Close()
+
+	logger.Info("Port-forward session established")
+	bridgeWebSockets(clientConn, gwConn)
+	logger.Info("Port-forward session ended")
+}
+
+// buildGatewayPortForwardURL constructs the WebSocket URL for the gateway port-forward endpoint.
+func (h *PortForwardHandler) buildGatewayPortForwardURL(podInfo *execPodInfo, port int) (string, error) {
+	u, err := url.Parse(h.gatewayURL)
+	if err != nil {
+		return "", err
+	}
+
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	case "http":
+		u.Scheme = "ws"
+	}
+
+	u.Path = fmt.Sprintf("/api/portforward/%s/%s/%s/%s",
+		podInfo.plane.planeType, podInfo.plane.planeID,
+		podInfo.plane.crNamespace, podInfo.plane.crName)
+
+	q := u.Query()
+	q.Set("podNamespace", podInfo.podNamespace)
+	q.Set("podName", podInfo.podName)
+	q.Set("port", strconv.Itoa(port))
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func newPortForwardHandler(t *testing.T, pdp *testutil.CapturingPDP, objs ...client.Object) *PortForwardHandler {
+	t.Helper()
+	return &PortForwardHandler{
+		pods:         newExecHandler(t, pdp, objs...),
+		gatewayURL:   "https://cluster-gateway.test:8443",
+		authzChecker: testutil.NewTestAuthzChecker(pdp),
+		logger:       slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func TestPortForwardHandler_AuthzEnvironmentContext(t *testing.T) {
+	pdp := testutil.AllowPDP()
+	h := newPortForwardHandler(t, pdp)
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/portforward/namespaces/default/components/greeter-service?env=development&project=default&port=8080",
+		nil).WithContext(testutil.AuthzContext())
+	h.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Len(t, pdp.Captured, 1, "authz check should run before pod resolution")
+	testutil.RequireEvalRequest(t, pdp.Captured[0],
+		authz.ActionPortForwardComponent, "component", "greeter-service",
+		authz.ResourceHierarchy{Namespace: "default", Project: "default"})
+	require.Equal(t,
+		services.FormatDualScopedResourceName("default", "development", false),
+		pdp.Captured[0].Context.Resource.Environment)
+}
+
+func TestPortForwardHandler_InvalidRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  string
+		wantMsg string
+	}{
+		{"invalid URL", "/portforward/namespaces/default?port=8080", "invalid port-forward URL"},
+		{"missing port", "/portforward/namespaces/default/components/greeter-service?env=development", "port query parameter"},
+		{"port out of range", "/portforward/namespaces/default/components/greeter-service?env=development&port=0", "port query parameter"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pdp := testutil.AllowPDP()
+			h := newPortForwardHandler(t, pdp)
+			rec := httptest.NewRecorder()
+
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.target, nil).WithContext(testutil.AuthzContext()))
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.wantMsg)
+			assert.Empty(t, pdp.Captured, "invalid requests must be rejected before the authz check")
+		})
+	}
+}
+
+func TestPortForwardHandler_BuildGatewayURL(t *testing.T) {
+	h := newPortForwardHandler(t, testutil.AllowPDP())
+	got, err := h.buildGatewayPortForwardURL(&execPodInfo{
+		podNamespace: "dp-default-dev",
+		podName:      "greeter-abc",
+		plane:        execPlaneInfo{planeType: "dataplane", planeID: "default", crNamespace: "_cluster", crName: "default"},
+	}, 8080)
+	require.NoError(t, err)
+	assert.Equal(t,
+		"wss://cluster-gateway.test:8443/api/portforward/dataplane/default/_cluster/default?podName=greeter-abc&podNamespace=dp-default-dev&port=8080",
+		got)
+}
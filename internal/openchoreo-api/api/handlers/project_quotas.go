@@ -0,0 +1,170 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcerrors "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	quotasvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/quota"
+)
+
+// ListProjectQuotas returns the quota of a project in each environment with its usage.
+func (h *Handler) ListProjectQuotas(
+	ctx context.Context,
+	request gen.ListProjectQuotasRequestObject,
+) (gen.ListProjectQuotasResponseObject, error) {
+	h.logger.Debug("ListProjectQuotas called", "namespaceName", request.NamespaceName, "projectName", request.ProjectName)
+
+	quotas, err := h.services.QuotaService.ListProjectQuotas(ctx, request.NamespaceName, request.ProjectName)
+	if err != nil {
+		switch {
+		case errors.Is(err, svcerrors.ErrForbidden):
+			return gen.ListProjectQuotas403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, quotasvc.ErrProjectNotFound):
+			return gen.ListProjectQuotas404JSONResponse{NotFoundJSONResponse: notFound("Project")}, nil
+		}
+		h.logger.Error("Failed to list project quotas", "error", err)
+		return gen.ListProjectQuotas500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	items := make([]gen.EnvironmentQuota, 0, len(quotas))
+	for i := range quotas {
+		items = append(items, toGenEnvironmentQuota(&quotas[i]))
+	}
+	return gen.ListProjectQuotas200JSONResponse{Items: items}, nil
+}
+
+// SetProjectQuota replaces the quota of a project in an environment.
+func (h *Handler) SetProjectQuota(
+	ctx context.Context,
+	request gen.SetProjectQuotaRequestObject,
+) (gen.SetProjectQuotaResponseObject, error) {
+	h.logger.Info("SetProjectQuota called",
+		"namespaceName", request.NamespaceName,
+		"projectName", request.ProjectName,
+		"envName", request.EnvName)
+
+	if request.Body == nil {
+		return gen.SetProjectQuota400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	quota, err := fromGenProjectQuota(request.Body)
+	if err != nil {
+		return gen.SetProjectQuota400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	result, err := h.services.QuotaService.SetProjectQuota(ctx, request.NamespaceName, request.ProjectName, request.EnvName, quota)
+	if err != nil {
+		switch {
+		case errors.Is(err, svcerrors.ErrForbidden):
+			return gen.SetProjectQuota403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, quotasvc.ErrProjectNotFound):
+			return gen.SetProjectQuota404JSONResponse{NotFoundJSONResponse: notFound("Project")}, nil
+		case errors.Is(err, quotasvc.ErrBindingNotFound):
+			return gen.SetProjectQuota404JSONResponse{NotFoundJSONResponse: notFound("ProjectReleaseBinding")}, nil
+		}
+		if validationErr, ok := errors.AsType[*svcerrors.ValidationError](err); ok {
+			return gen.SetProjectQuota400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to set project quota", "error", err)
+		return gen.SetProjectQuota500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.SetProjectQuota200JSONResponse(toGenEnvironmentQuota(result)), nil
+}
+
+// DeleteProjectQuota removes the quota of a project in an environment.
+func (h *Handler) DeleteProjectQuota(
+	ctx context.Context,
+	request gen.DeleteProjectQuotaRequestObject,
+) (gen.DeleteProjectQuotaResponseObject, error) {
+	h.logger.Info("DeleteProjectQuota called",
+		"namespaceName", request.NamespaceName,
+		"projectName", request.ProjectName,
+		"envName", request.EnvName)
+
+	if _, err := h.services.QuotaService.SetProjectQuota(ctx, request.NamespaceName, request.ProjectName, request.EnvName, nil); err != nil {
+		switch {
+		case errors.Is(err, svcerrors.ErrForbidden):
+			return gen.DeleteProjectQuota403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, quotasvc.ErrProjectNotFound):
+			return gen.DeleteProjectQuota404JSONResponse{NotFoundJSONResponse: notFound("Project")}, nil
+		case errors.Is(err, quotasvc.ErrBindingNotFound):
+			return gen.DeleteProjectQuota404JSONResponse{NotFoundJSONResponse: notFound("ProjectReleaseBinding")}, nil
+		}
+		h.logger.Error("Failed to delete project quota", "error", err)
+		return gen.DeleteProjectQuota500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.DeleteProjectQuota204Response{}, nil
+}
+
+func toGenEnvironmentQuota(q *quotasvc.EnvironmentQuota) gen.EnvironmentQuota {
+	out := gen.EnvironmentQuota{
+		Environment: q.Environment,
+		BindingName: q.BindingName,
+	}
+	if q.Quota != nil {
+		out.Quota = &gen.ProjectQuota{
+			Hard:           toGenQuantities(q.Quota.Hard),
+			DefaultRequest: toGenQuantities(q.Quota.DefaultRequest),
+			Default:        toGenQuantities(q.Quota.Default),
+			Max:            toGenQuantities(q.Quota.Max),
+		}
+	}
+	if q.Usage != nil {
+		out.Usage = &gen.ProjectQuotaUsage{
+			Hard: toGenQuantities(q.Usage.Hard),
+			Used: toGenQuantities(q.Usage.Used),
+		}
+	}
+	return out
+}
+
+func toGenQuantities(list corev1.ResourceList) *gen.ResourceQuantities {
+	if len(list) == 0 {
+		return nil
+	}
+	out := make(gen.ResourceQuantities, len(list))
+	for name, q := range list {
+		out[string(name)] = q.String()
+	}
+	return &out
+}
+
+// fromGenProjectQuota parses the quantities of a quota, reporting the first
+// one that is not a valid Kubernetes quantity.
+func fromGenProjectQuota(q *gen.ProjectQuota) (*openchoreov1alpha1.ProjectQuota, error) {
+	quota := &openchoreov1alpha1.ProjectQuota{}
+	for _, f := range []struct {
+		name string
+		src  *gen.ResourceQuantities
+		dst  *corev1.ResourceList
+	}{
+		{"hard", q.Hard, &quota.Hard},
+		{"defaultRequest", q.DefaultRequest, &quota.DefaultRequest},
+		{"default", q.Default, &quota.Default},
+		{"max", q.Max, &quota.Max},
+	} {
+		if f.src == nil || len(*f.src) == 0 {
+			continue
+		}
+		list := make(corev1.ResourceList, len(*f.src))
+		for name, value := range *f.src {
+			parsed, err := resource.ParseQuantity(value)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: invalid quantity %q", f.name, name, value)
+			}
+			list[corev1.ResourceName(name)] = parsed
+		}
+		*f.dst = list
+	}
+	return quota, nil
+}
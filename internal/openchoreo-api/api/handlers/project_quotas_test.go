@@ -0,0 +1,184 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcerrors "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	quotasvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/quota"
+	quotasvcmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/quota/mocks"
+)
+
+func newHandlerWithQuotaService(svc quotasvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{QuotaService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func TestListProjectQuotasHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+	request := gen.ListProjectQuotasRequestObject{NamespaceName: ns, ProjectName: "shop"}
+
+	t.Run("success", func(t *testing.T) {
+		svc := quotasvcmocks.NewMockService(t)
+		svc.EXPECT().ListProjectQuotas(mock.Anything, ns, "shop").Return([]quotasvc.EnvironmentQuota{
+			{
+				Environment: "dev",
+				BindingName: "shop-dev",
+				Quota: &openchoreov1alpha1.ProjectQuota{
+					Hard: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("2")},
+				},
+				Usage: &openchoreov1alpha1.ProjectQuotaStatus{
+					Hard: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("2")},
+					Used: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("500m")},
+				},
+			},
+			{Environment: "prod", BindingName: "shop-prod"},
+		}, nil)
+
+		resp, err := newHandlerWithQuotaService(svc).ListProjectQuotas(ctx, request)
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ListProjectQuotas200JSONResponse)
+		require.True(t, ok, "expected 200, got %T", resp)
+		require.Len(t, typed.Items, 2)
+		require.NotNil(t, typed.Items[0].Quota)
+		assert.Equal(t, &gen.ResourceQuantities{"requests.cpu": "2"}, typed.Items[0].Quota.Hard)
+		assert.Nil(t, typed.Items[0].Quota.Max)
+		require.NotNil(t, typed.Items[0].Usage)
+		assert.Equal(t, &gen.ResourceQuantities{"requests.cpu": "500m"}, typed.Items[0].Usage.Used)
+		assert.Nil(t, typed.Items[1].Quota)
+		assert.Nil(t, typed.Items[1].Usage)
+	})
+
+	errCases := []struct {
+		name string
+		err  error
+		want any
+	}{
+		{"forbidden", svcerrors.ErrForbidden, gen.ListProjectQuotas403JSONResponse{}},
+		{"project not found", quotasvc.ErrProjectNotFound, gen.ListProjectQuotas404JSONResponse{}},
+		{"internal", errors.New("boom"), gen.ListProjectQuotas500JSONResponse{}},
+	}
+	for _, tc := range errCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := quotasvcmocks.NewMockService(t)
+			svc.EXPECT().ListProjectQuotas(mock.Anything, ns, "shop").Return(nil, tc.err)
+
+			resp, err := newHandlerWithQuotaService(svc).ListProjectQuotas(ctx, request)
+			require.NoError(t, err)
+			assert.IsType(t, tc.want, resp)
+		})
+	}
+}
+
+func TestSetProjectQuotaHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+	request := gen.SetProjectQuotaRequestObject{
+		NamespaceName: ns,
+		ProjectName:   "shop",
+		EnvName:       "dev",
+		Body: &gen.ProjectQuota{
+			Hard:    &gen.ResourceQuantities{"limits.memory": "4Gi", "pods": "20"},
+			Default: &gen.ResourceQuantities{"memory": "256Mi"},
+		},
+	}
+	isWantQuota := func(q *openchoreov1alpha1.ProjectQuota) bool {
+		return q != nil && q.Hard.Pods().Value() == 20 && q.Default.Memory().String() == "256Mi" &&
+			q.DefaultRequest == nil && q.Max == nil
+	}
+
+	t.Run("success", func(t *testing.T) {
+		svc := quotasvcmocks.NewMockService(t)
+		svc.EXPECT().SetProjectQuota(mock.Anything, ns, "shop", "dev", mock.MatchedBy(isWantQuota)).
+			Return(&quotasvc.EnvironmentQuota{Environment: "dev", BindingName: "shop-dev"}, nil)
+
+		resp, err := newHandlerWithQuotaService(svc).SetProjectQuota(ctx, request)
+		require.NoError(t, err)
+		typed, ok := resp.(gen.SetProjectQuota200JSONResponse)
+		require.True(t, ok, "expected 200, got %T", resp)
+		assert.Equal(t, "shop-dev", typed.BindingName)
+	})
+
+	errCases := []struct {
+		name string
+		err  error
+		want any
+	}{
+		{"forbidden", svcerrors.ErrForbidden, gen.SetProjectQuota403JSONResponse{}},
+		{"project not found", quotasvc.ErrProjectNotFound, gen.SetProjectQuota404JSONResponse{}},
+		{"binding not found", quotasvc.ErrBindingNotFound, gen.SetProjectQuota404JSONResponse{}},
+		{"validation", &svcerrors.ValidationError{Msg: "bad"}, gen.SetProjectQuota400JSONResponse{}},
+		{"internal", errors.New("boom"), gen.SetProjectQuota500JSONResponse{}},
+	}
+	for _, tc := range errCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := quotasvcmocks.NewMockService(t)
+			svc.EXPECT().SetProjectQuota(mock.Anything, ns, "shop", "dev", mock.Anything).Return(nil, tc.err)
+
+			resp, err := newHandlerWithQuotaService(svc).SetProjectQuota(ctx, request)
+			require.NoError(t, err)
+			assert.IsType(t, tc.want, resp)
+		})
+	}
+
+	t.Run("invalid quantity", func(t *testing.T) {
+		resp, err := newHandlerWithQuotaService(quotasvcmocks.NewMockService(t)).SetProjectQuota(ctx, gen.SetProjectQuotaRequestObject{
+			NamespaceName: ns, ProjectName: "shop", EnvName: "dev",
+			Body: &gen.ProjectQuota{Hard: &gen.ResourceQuantities{"pods": "lots"}},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.SetProjectQuota400JSONResponse)
+		require.True(t, ok, "expected 400, got %T", resp)
+		assert.Equal(t, `hard.pods: invalid quantity "lots"`, typed.Error)
+	})
+
+	t.Run("missing body", func(t *testing.T) {
+		resp, err := newHandlerWithQuotaService(quotasvcmocks.NewMockService(t)).
+			SetProjectQuota(ctx, gen.SetProjectQuotaRequestObject{NamespaceName: ns, ProjectName: "shop", EnvName: "dev"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.SetProjectQuota400JSONResponse{}, resp)
+	})
+}
+
+func TestDeleteProjectQuotaHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+	request := gen.DeleteProjectQuotaRequestObject{NamespaceName: ns, ProjectName: "shop", EnvName: "dev"}
+
+	t.Run("success", func(t *testing.T) {
+		svc := quotasvcmocks.NewMockService(t)
+		svc.EXPECT().SetProjectQuota(mock.Anything, ns, "shop", "dev", (*openchoreov1alpha1.ProjectQuota)(nil)).
+			Return(&quotasvc.EnvironmentQuota{Environment: "dev", BindingName: "shop-dev"}, nil)
+
+		resp, err := newHandlerWithQuotaService(svc).DeleteProjectQuota(ctx, request)
+		require.NoError(t, err)
+		assert.IsType(t, gen.DeleteProjectQuota204Response{}, resp)
+	})
+
+	t.Run("binding not found", func(t *testing.T) {
+		svc := quotasvcmocks.NewMockService(t)
+		svc.EXPECT().SetProjectQuota(mock.Anything, ns, "shop", "dev", (*openchoreov1alpha1.ProjectQuota)(nil)).
+			Return(nil, quotasvc.ErrBindingNotFound)
+
+		resp, err := newHandlerWithQuotaService(svc).DeleteProjectQuota(ctx, request)
+		require.NoError(t, err)
+		assert.IsType(t, gen.DeleteProjectQuota404JSONResponse{}, resp)
+	})
+}
@@ -25,9 +25,11 @@ func (h *Handler) ListProjectReleaseBindings(
 		projectName = *request.Params.Project
 	}
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.ProjectReleaseBindingService.ListProjectReleaseBindings(ctx, request.NamespaceName, projectName, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ProjectReleaseBinding], error) {
+		return h.services.ProjectReleaseBindingService.ListProjectReleaseBindings(ctx, request.NamespaceName, projectName, opts)
+	})
 	if err != nil {
 		var validationErr *services.ValidationError
 		if errors.As(err, &validationErr) {
@@ -45,7 +47,7 @@ func (h *Handler) ListProjectReleaseBindings(
 
 	return gen.ListProjectReleaseBindings200JSONResponse{
 		Items:      items,
-		Pagination: ToPagination(result),
+		Pagination: ToPagination(result.WithFilter("project", projectName)),
 	}, nil
 }
 
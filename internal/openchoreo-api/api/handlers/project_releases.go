@@ -25,9 +25,11 @@ func (h *Handler) ListProjectReleases(
 		projectName = *request.Params.Project
 	}
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.ProjectReleaseService.ListProjectReleases(ctx, request.NamespaceName, projectName, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ProjectRelease], error) {
+		return h.services.ProjectReleaseService.ListProjectReleases(ctx, request.NamespaceName, projectName, opts)
+	})
 	if err != nil {
 		var validationErr *services.ValidationError
 		if errors.As(err, &validationErr) {
@@ -46,7 +48,7 @@ func (h *Handler) ListProjectReleases(
 	resp := gen.ListProjectReleases200JSONResponse{
 		Items: items,
 	}
-	resp.Pagination = ToPagination(result)
+	resp.Pagination = ToPagination(result.WithFilter("project", projectName))
 
 	return resp, nil
 }
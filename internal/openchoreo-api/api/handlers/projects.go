@@ -21,9 +21,11 @@ func (h *Handler) ListProjects(
 ) (gen.ListProjectsResponseObject, error) {
 	h.logger.Debug("ListProjects called", "namespaceName", request.NamespaceName)
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.ProjectService.ListProjects(ctx, request.NamespaceName, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.Project], error) {
+		return h.services.ProjectService.ListProjects(ctx, request.NamespaceName, opts)
+	})
 	if err != nil {
 		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
 			return gen.ListProjects400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
@@ -69,6 +71,12 @@ func (h *Handler) CreateProject(
 		if errors.Is(err, projectsvc.ErrProjectAlreadyExists) {
 			return gen.CreateProject409JSONResponse{ConflictJSONResponse: conflict("Project already exists")}, nil
 		}
+		if errors.Is(err, projectsvc.ErrNamespaceNotFound) {
+			return gen.CreateProject404JSONResponse{NotFoundJSONResponse: notFound("Namespace")}, nil
+		}
+		if errors.Is(err, projectsvc.ErrDeploymentPipelineNotFound) {
+			return gen.CreateProject400JSONResponse{BadRequestJSONResponse: badRequest("Referenced deployment pipeline not found")}, nil
+		}
 		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
 			if validationErr.StatusCode == http.StatusUnprocessableEntity {
 				return gen.CreateProject422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(validationErr.Msg)}, nil
@@ -12,6 +12,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -20,6 +21,7 @@ import (
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/labels"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
 	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
@@ -37,8 +39,8 @@ type projectBundle struct {
 func newProjectBundle(t *testing.T, objects []client.Object, pdp authzcore.PDP) projectBundle {
 	t.Helper()
 	fc := fake.NewClientBuilder().
-		WithScheme(newTestScheme(t)).
-		WithObjects(objects...).
+		WithScheme(newTestSchemeWithCoreV1(t)).
+		WithObjects(append(projectPrerequisites(), objects...)...).
 		Build()
 	svc := projectsvc.NewServiceWithAuthz(fc, pdp, slog.Default())
 	services := &handlerservices.Services{ProjectService: svc}
@@ -48,6 +50,18 @@ func newProjectBundle(t *testing.T, objects []client.Object, pdp authzcore.PDP)
 	}
 }
 
+// projectPrerequisites returns the OpenChoreo namespace and the default
+// deployment pipeline that projects are created with.
+func projectPrerequisites() []client.Object {
+	return []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   testNS,
+			Labels: map[string]string{labels.LabelKeyControlPlaneNamespace: labels.LabelValueTrue},
+		}},
+		&openchoreov1alpha1.DeploymentPipeline{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: testNS}},
+	}
+}
+
 // seedProject is a convenience constructor for an openchoreov1alpha1.Project object.
 // DeploymentPipelineRef and Type are set to satisfy the OpenAPI schema's minLength
 // constraints on spec.deploymentPipelineRef.name and spec.type.name.
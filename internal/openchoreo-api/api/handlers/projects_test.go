@@ -24,8 +24,8 @@ import (
 func newProjectService(t *testing.T, objects []client.Object, pdp authzcore.PDP) projectsvc.Service {
 	t.Helper()
 	fakeClient := fake.NewClientBuilder().
-		WithScheme(newTestScheme(t)).
-		WithObjects(objects...).
+		WithScheme(newTestSchemeWithCoreV1(t)).
+		WithObjects(append(projectPrerequisites(), objects...)...).
 		Build()
 	return projectsvc.NewServiceWithAuthz(fakeClient, pdp, slog.Default())
 }
@@ -186,6 +186,36 @@ func TestCreateProjectHandler(t *testing.T) {
 		assert.IsType(t, gen.CreateProject409JSONResponse{}, resp)
 	})
 
+	t.Run("unknown namespace returns 404", func(t *testing.T) {
+		svc := newProjectService(t, nil, &allowAllPDP{})
+		h := newHandlerWithProjectService(svc)
+
+		resp, err := h.CreateProject(ctx, gen.CreateProjectRequestObject{
+			NamespaceName: "other-ns",
+			Body:          validBody,
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.CreateProject404JSONResponse{}, resp)
+	})
+
+	t.Run("unknown deployment pipeline returns 400", func(t *testing.T) {
+		svc := newProjectService(t, nil, &allowAllPDP{})
+		h := newHandlerWithProjectService(svc)
+
+		resp, err := h.CreateProject(ctx, gen.CreateProjectRequestObject{
+			NamespaceName: ns,
+			Body: &gen.Project{
+				Metadata: gen.ObjectMeta{Name: "new-proj"},
+				Spec: &gen.ProjectSpec{DeploymentPipelineRef: &struct {
+					Kind *gen.ProjectSpecDeploymentPipelineRefKind `json:"kind,omitempty"`
+					Name string                                    `json:"name"`
+				}{Name: "missing"}},
+			},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.CreateProject400JSONResponse{}, resp)
+	})
+
 	t.Run("forbidden returns 403", func(t *testing.T) {
 		svc := newProjectService(t, nil, &denyAllPDP{})
 		h := newHandlerWithProjectService(svc)
@@ -20,9 +20,11 @@ func (h *Handler) ListProjectTypes(
 ) (gen.ListProjectTypesResponseObject, error) {
 	h.logger.Debug("ListProjectTypes called", "namespaceName", request.NamespaceName)
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.ProjectTypeService.ListProjectTypes(ctx, request.NamespaceName, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ProjectType], error) {
+		return h.services.ProjectTypeService.ListProjectTypes(ctx, request.NamespaceName, opts)
+	})
 	if err != nil {
 		var validationErr *services.ValidationError
 		if errors.As(err, &validationErr) {
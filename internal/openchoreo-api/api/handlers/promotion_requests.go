@@ -0,0 +1,203 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+	promotionsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/promotion"
+)
+
+// ListPromotionRequests returns a paginated list of promotion requests within a namespace.
+func (h *Handler) ListPromotionRequests(
+	ctx context.Context,
+	request gen.ListPromotionRequestsRequestObject,
+) (gen.ListPromotionRequestsResponseObject, error) {
+	h.logger.Debug("ListPromotionRequests called", "namespaceName", request.NamespaceName)
+
+	componentName := ptr.Deref(request.Params.Component, "")
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, nil, nil)
+
+	result, err := h.services.PromotionService.ListPromotionRequests(ctx, request.NamespaceName, componentName, opts)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.ListPromotionRequests403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.ListPromotionRequests400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to list promotion requests", "error", err)
+		return gen.ListPromotionRequests500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	items, err := convertList[openchoreov1alpha1.PromotionRequest, gen.PromotionRequest](result.Items)
+	if err != nil {
+		h.logger.Error("Failed to convert promotion requests", "error", err)
+		return gen.ListPromotionRequests500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	resp := gen.ListPromotionRequests200JSONResponse{
+		Items: items,
+	}
+	resp.Pagination = ToPagination(result.WithFilter("component", componentName))
+
+	return resp, nil
+}
+
+// CreatePromotionRequest requests the promotion of a component release to another environment.
+func (h *Handler) CreatePromotionRequest(
+	ctx context.Context,
+	request gen.CreatePromotionRequestRequestObject,
+) (gen.CreatePromotionRequestResponseObject, error) {
+	h.logger.Info("CreatePromotionRequest called", "namespaceName", request.NamespaceName)
+
+	if request.Body == nil {
+		return gen.CreatePromotionRequest400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	pr, err := h.services.PromotionService.RequestPromotion(ctx, request.NamespaceName, &promotionsvc.RequestPromotionRequest{
+		ComponentName:     request.Body.ComponentName,
+		SourceEnvironment: request.Body.SourceEnvironment,
+		TargetEnvironment: request.Body.TargetEnvironment,
+		Comment:           ptr.Deref(request.Body.Comment, ""),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrForbidden):
+			return gen.CreatePromotionRequest403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, componentsvc.ErrComponentNotFound):
+			return gen.CreatePromotionRequest404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		case errors.Is(err, projectsvc.ErrProjectNotFound):
+			return gen.CreatePromotionRequest404JSONResponse{NotFoundJSONResponse: notFound("Project")}, nil
+		case errors.Is(err, projectsvc.ErrDeploymentPipelineNotFound):
+			return gen.CreatePromotionRequest404JSONResponse{NotFoundJSONResponse: notFound("DeploymentPipeline")}, nil
+		case errors.Is(err, promotionsvc.ErrPromotionNotAllowed),
+			errors.Is(err, promotionsvc.ErrSourceNotDeployed),
+			errors.Is(err, promotionsvc.ErrCriticalVulnerabilities):
+			return gen.CreatePromotionRequest400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.CreatePromotionRequest400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to request promotion", "error", err)
+		return gen.CreatePromotionRequest500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genPR, err := convert[openchoreov1alpha1.PromotionRequest, gen.PromotionRequest](*pr)
+	if err != nil {
+		h.logger.Error("Failed to convert promotion request", "error", err)
+		return gen.CreatePromotionRequest500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.CreatePromotionRequest201JSONResponse(genPR), nil
+}
+
+// GetPromotionRequest returns a promotion request.
+func (h *Handler) GetPromotionRequest(
+	ctx context.Context,
+	request gen.GetPromotionRequestRequestObject,
+) (gen.GetPromotionRequestResponseObject, error) {
+	h.logger.Debug("GetPromotionRequest called",
+		"namespaceName", request.NamespaceName,
+		"promotionRequestName", request.PromotionRequestName)
+
+	pr, err := h.services.PromotionService.GetPromotionRequest(ctx, request.NamespaceName, request.PromotionRequestName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.GetPromotionRequest403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, promotionsvc.ErrPromotionRequestNotFound) {
+			return gen.GetPromotionRequest404JSONResponse{NotFoundJSONResponse: notFound("PromotionRequest")}, nil
+		}
+		h.logger.Error("Failed to get promotion request", "error", err)
+		return gen.GetPromotionRequest500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genPR, err := convert[openchoreov1alpha1.PromotionRequest, gen.PromotionRequest](*pr)
+	if err != nil {
+		h.logger.Error("Failed to convert promotion request", "error", err)
+		return gen.GetPromotionRequest500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.GetPromotionRequest200JSONResponse(genPR), nil
+}
+
+// ApprovePromotionRequest approves a pending promotion request.
+func (h *Handler) ApprovePromotionRequest(
+	ctx context.Context,
+	request gen.ApprovePromotionRequestRequestObject,
+) (gen.ApprovePromotionRequestResponseObject, error) {
+	h.logger.Info("ApprovePromotionRequest called",
+		"namespaceName", request.NamespaceName,
+		"promotionRequestName", request.PromotionRequestName)
+
+	comment := ""
+	if request.Body != nil {
+		comment = ptr.Deref(request.Body.Comment, "")
+	}
+
+	pr, err := h.services.PromotionService.ApprovePromotionRequest(ctx, request.NamespaceName, request.PromotionRequestName, comment)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrForbidden):
+			return gen.ApprovePromotionRequest403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, promotionsvc.ErrPromotionRequestNotFound):
+			return gen.ApprovePromotionRequest404JSONResponse{NotFoundJSONResponse: notFound("PromotionRequest")}, nil
+		case errors.Is(err, promotionsvc.ErrPromotionRequestNotPending),
+			errors.Is(err, promotionsvc.ErrCriticalVulnerabilities):
+			return gen.ApprovePromotionRequest409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
+		h.logger.Error("Failed to approve promotion request", "error", err)
+		return gen.ApprovePromotionRequest500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genPR, err := convert[openchoreov1alpha1.PromotionRequest, gen.PromotionRequest](*pr)
+	if err != nil {
+		h.logger.Error("Failed to convert promotion request", "error", err)
+		return gen.ApprovePromotionRequest500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.ApprovePromotionRequest200JSONResponse(genPR), nil
+}
+
+// RejectPromotionRequest rejects a pending promotion request.
+func (h *Handler) RejectPromotionRequest(
+	ctx context.Context,
+	request gen.RejectPromotionRequestRequestObject,
+) (gen.RejectPromotionRequestResponseObject, error) {
+	h.logger.Info("RejectPromotionRequest called",
+		"namespaceName", request.NamespaceName,
+		"promotionRequestName", request.PromotionRequestName)
+
+	comment := ""
+	if request.Body != nil {
+		comment = ptr.Deref(request.Body.Comment, "")
+	}
+
+	pr, err := h.services.PromotionService.RejectPromotionRequest(ctx, request.NamespaceName, request.PromotionRequestName, comment)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrForbidden):
+			return gen.RejectPromotionRequest403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, promotionsvc.ErrPromotionRequestNotFound):
+			return gen.RejectPromotionRequest404JSONResponse{NotFoundJSONResponse: notFound("PromotionRequest")}, nil
+		case errors.Is(err, promotionsvc.ErrPromotionRequestNotPending):
+			return gen.RejectPromotionRequest409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
+		h.logger.Error("Failed to reject promotion request", "error", err)
+		return gen.RejectPromotionRequest500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genPR, err := convert[openchoreov1alpha1.PromotionRequest, gen.PromotionRequest](*pr)
+	if err != nil {
+		h.logger.Error("Failed to convert promotion request", "error", err)
+		return gen.RejectPromotionRequest500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+	return gen.RejectPromotionRequest200JSONResponse(genPR), nil
+}
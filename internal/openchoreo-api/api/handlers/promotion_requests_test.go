@@ -0,0 +1,189 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	promotionsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/promotion"
+	promotionsvcmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/promotion/mocks"
+)
+
+func newHandlerWithPromotionService(svc promotionsvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{PromotionService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func testPromotionRequest(phase openchoreov1alpha1.PromotionRequestPhase) *openchoreov1alpha1.PromotionRequest {
+	return &openchoreov1alpha1.PromotionRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "comp-a-prod-abcde", Namespace: "test-ns"},
+		Spec: openchoreov1alpha1.PromotionRequestSpec{
+			Owner:             openchoreov1alpha1.PromotionRequestOwner{ProjectName: "proj", ComponentName: "comp-a"},
+			SourceEnvironment: "dev",
+			TargetEnvironment: "prod",
+			ReleaseName:       "rel-1",
+		},
+		Status: openchoreov1alpha1.PromotionRequestStatus{Phase: phase, ApprovalRequired: true},
+	}
+}
+
+func TestCreatePromotionRequestHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("nil body returns 400", func(t *testing.T) {
+		h := newHandlerWithPromotionService(promotionsvcmocks.NewMockService(t))
+		resp, err := h.CreatePromotionRequest(ctx, gen.CreatePromotionRequestRequestObject{NamespaceName: ns})
+		require.NoError(t, err)
+		assert.IsType(t, gen.CreatePromotionRequest400JSONResponse{}, resp)
+	})
+
+	t.Run("success returns pending request", func(t *testing.T) {
+		svc := promotionsvcmocks.NewMockService(t)
+		svc.EXPECT().RequestPromotion(mock.Anything, ns, &promotionsvc.RequestPromotionRequest{
+			ComponentName: "comp-a", SourceEnvironment: "dev", TargetEnvironment: "prod", Comment: "ship it",
+		}).Return(testPromotionRequest(openchoreov1alpha1.PromotionRequestPhasePendingApproval), nil)
+
+		resp, err := newHandlerWithPromotionService(svc).CreatePromotionRequest(ctx, gen.CreatePromotionRequestRequestObject{
+			NamespaceName: ns,
+			Body: &gen.CreatePromotionRequestRequest{
+				ComponentName: "comp-a", SourceEnvironment: "dev", TargetEnvironment: "prod", Comment: ptr.To("ship it"),
+			},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.CreatePromotionRequest201JSONResponse)
+		require.True(t, ok, "expected 201 response, got %T", resp)
+		assert.Equal(t, "comp-a-prod-abcde", typed.Metadata.Name)
+		assert.Equal(t, "PendingApproval", *typed.Status.Phase)
+		assert.True(t, *typed.Status.ApprovalRequired)
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.CreatePromotionRequest403JSONResponse{}},
+		{"component not found -> 404", componentsvc.ErrComponentNotFound, gen.CreatePromotionRequest404JSONResponse{}},
+		{"not allowed -> 400", promotionsvc.ErrPromotionNotAllowed, gen.CreatePromotionRequest400JSONResponse{}},
+		{"source not deployed -> 400", promotionsvc.ErrSourceNotDeployed, gen.CreatePromotionRequest400JSONResponse{}},
+		{"critical vulnerabilities -> 400", promotionsvc.ErrCriticalVulnerabilities, gen.CreatePromotionRequest400JSONResponse{}},
+		{"validation -> 400", &svcpkg.ValidationError{Msg: "must differ"}, gen.CreatePromotionRequest400JSONResponse{}},
+		{"internal -> 500", errors.New("boom"), gen.CreatePromotionRequest500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := promotionsvcmocks.NewMockService(t)
+			svc.EXPECT().RequestPromotion(mock.Anything, ns, mock.Anything).Return(nil, tt.svcErr)
+			resp, err := newHandlerWithPromotionService(svc).CreatePromotionRequest(ctx, gen.CreatePromotionRequestRequestObject{
+				NamespaceName: ns,
+				Body:          &gen.CreatePromotionRequestRequest{ComponentName: "comp-a", SourceEnvironment: "dev", TargetEnvironment: "prod"},
+			})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
+func TestListPromotionRequestsHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	svc := promotionsvcmocks.NewMockService(t)
+	svc.EXPECT().ListPromotionRequests(mock.Anything, ns, "comp-a", mock.Anything).Return(
+		&svcpkg.ListResult[openchoreov1alpha1.PromotionRequest]{
+			Items: []openchoreov1alpha1.PromotionRequest{*testPromotionRequest(openchoreov1alpha1.PromotionRequestPhasePromoted)},
+		}, nil)
+
+	resp, err := newHandlerWithPromotionService(svc).ListPromotionRequests(ctx, gen.ListPromotionRequestsRequestObject{
+		NamespaceName: ns,
+		Params:        gen.ListPromotionRequestsParams{Component: ptr.To("comp-a")},
+	})
+	require.NoError(t, err)
+	typed, ok := resp.(gen.ListPromotionRequests200JSONResponse)
+	require.True(t, ok, "expected 200 response, got %T", resp)
+	require.Len(t, typed.Items, 1)
+	assert.Equal(t, "Promoted", *typed.Items[0].Status.Phase)
+}
+
+func TestDecidePromotionRequestHandlers(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+	const name = "comp-a-prod-abcde"
+
+	t.Run("approve forwards comment", func(t *testing.T) {
+		approved := testPromotionRequest(openchoreov1alpha1.PromotionRequestPhasePromoted)
+		approved.Status.ReleaseBindingName = "comp-a-prod"
+		svc := promotionsvcmocks.NewMockService(t)
+		svc.EXPECT().ApprovePromotionRequest(mock.Anything, ns, name, "lgtm").Return(approved, nil)
+
+		resp, err := newHandlerWithPromotionService(svc).ApprovePromotionRequest(ctx, gen.ApprovePromotionRequestRequestObject{
+			NamespaceName: ns, PromotionRequestName: name, Body: &gen.PromotionDecisionRequest{Comment: ptr.To("lgtm")},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ApprovePromotionRequest200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, "comp-a-prod", *typed.Status.ReleaseBindingName)
+	})
+
+	t.Run("reject without body", func(t *testing.T) {
+		svc := promotionsvcmocks.NewMockService(t)
+		svc.EXPECT().RejectPromotionRequest(mock.Anything, ns, name, "").
+			Return(testPromotionRequest(openchoreov1alpha1.PromotionRequestPhaseRejected), nil)
+
+		resp, err := newHandlerWithPromotionService(svc).RejectPromotionRequest(ctx, gen.RejectPromotionRequestRequestObject{
+			NamespaceName: ns, PromotionRequestName: name,
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.RejectPromotionRequest200JSONResponse{}, resp)
+	})
+
+	tests := []struct {
+		name        string
+		svcErr      error
+		wantApprove any
+		wantReject  any
+	}{
+		{"forbidden -> 403", svcpkg.ErrForbidden,
+			gen.ApprovePromotionRequest403JSONResponse{}, gen.RejectPromotionRequest403JSONResponse{}},
+		{"not found -> 404", promotionsvc.ErrPromotionRequestNotFound,
+			gen.ApprovePromotionRequest404JSONResponse{}, gen.RejectPromotionRequest404JSONResponse{}},
+		{"not pending -> 409", promotionsvc.ErrPromotionRequestNotPending,
+			gen.ApprovePromotionRequest409JSONResponse{}, gen.RejectPromotionRequest409JSONResponse{}},
+		{"internal -> 500", errors.New("boom"),
+			gen.ApprovePromotionRequest500JSONResponse{}, gen.RejectPromotionRequest500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := promotionsvcmocks.NewMockService(t)
+			svc.EXPECT().ApprovePromotionRequest(mock.Anything, ns, name, "").Return(nil, tt.svcErr)
+			svc.EXPECT().RejectPromotionRequest(mock.Anything, ns, name, "").Return(nil, tt.svcErr)
+			h := newHandlerWithPromotionService(svc)
+
+			approveResp, err := h.ApprovePromotionRequest(ctx, gen.ApprovePromotionRequestRequestObject{NamespaceName: ns, PromotionRequestName: name})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantApprove, approveResp)
+
+			rejectResp, err := h.RejectPromotionRequest(ctx, gen.RejectPromotionRequestRequestObject{NamespaceName: ns, PromotionRequestName: name})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantReject, rejectResp)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	registrysvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/registry"
+	secretsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secret"
+)
+
+// ListRegistryCredentials returns all registry credentials in a namespace.
+func (h *Handler) ListRegistryCredentials(
+	ctx context.Context,
+	request gen.ListRegistryCredentialsRequestObject,
+) (gen.ListRegistryCredentialsResponseObject, error) {
+	h.logger.Debug("ListRegistryCredentials called", "namespaceName", request.NamespaceName)
+
+	items, err := h.services.RegistryService.ListRegistryCredentials(ctx, request.NamespaceName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.ListRegistryCredentials403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		h.logger.Error("Failed to list registry credentials", "error", err)
+		return gen.ListRegistryCredentials500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	respItems := make([]gen.RegistryCredential, 0, len(items))
+	for i := range items {
+		respItems = append(respItems, toGenRegistryCredential(&items[i]))
+	}
+	return gen.ListRegistryCredentials200JSONResponse{Items: respItems}, nil
+}
+
+// CreateRegistryCredential validates and stores registry credentials.
+func (h *Handler) CreateRegistryCredential(
+	ctx context.Context,
+	request gen.CreateRegistryCredentialRequestObject,
+) (gen.CreateRegistryCredentialResponseObject, error) {
+	h.logger.Info("CreateRegistryCredential called", "namespaceName", request.NamespaceName)
+
+	if request.Body == nil {
+		return gen.CreateRegistryCredential400JSONResponse{BadRequestJSONResponse: badRequest("request body is required")}, nil
+	}
+
+	params := &registrysvc.CreateRegistryCredentialParams{
+		Name:     request.Body.Name,
+		Server:   request.Body.Server,
+		Username: request.Body.Username,
+		Password: request.Body.Password,
+		Email:    ptr.Deref(request.Body.Email, ""),
+		TargetPlane: openchoreov1alpha1.TargetPlaneRef{
+			Kind: string(request.Body.TargetPlane.Kind),
+			Name: request.Body.TargetPlane.Name,
+		},
+		SkipValidation: ptr.Deref(request.Body.SkipValidation, false),
+	}
+
+	result, err := h.services.RegistryService.CreateRegistryCredential(ctx, request.NamespaceName, params)
+	if err != nil {
+		return mapCreateRegistryCredentialError(h, err)
+	}
+	return gen.CreateRegistryCredential201JSONResponse(toGenRegistryCredential(result)), nil
+}
+
+// DeleteRegistryCredential deletes registry credentials by name.
+func (h *Handler) DeleteRegistryCredential(
+	ctx context.Context,
+	request gen.DeleteRegistryCredentialRequestObject,
+) (gen.DeleteRegistryCredentialResponseObject, error) {
+	h.logger.Info("DeleteRegistryCredential called",
+		"namespaceName", request.NamespaceName,
+		"registryCredentialName", request.RegistryCredentialName)
+
+	err := h.services.RegistryService.DeleteRegistryCredential(ctx, request.NamespaceName, request.RegistryCredentialName)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrForbidden):
+			return gen.DeleteRegistryCredential403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, registrysvc.ErrRegistryCredentialNotFound):
+			return gen.DeleteRegistryCredential404JSONResponse{NotFoundJSONResponse: notFound("registry credential")}, nil
+		default:
+			h.logger.Error("Failed to delete registry credential", "error", err)
+			return gen.DeleteRegistryCredential500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+		}
+	}
+	return gen.DeleteRegistryCredential204Response{}, nil
+}
+
+func mapCreateRegistryCredentialError(h *Handler, err error) (gen.CreateRegistryCredentialResponseObject, error) {
+	var validationErr *services.ValidationError
+	switch {
+	case errors.Is(err, services.ErrForbidden):
+		return gen.CreateRegistryCredential403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+	case errors.Is(err, secretsvc.ErrSecretAlreadyExists):
+		return gen.CreateRegistryCredential409JSONResponse{ConflictJSONResponse: conflict("registry credential already exists")}, nil
+	case errors.Is(err, registrysvc.ErrInvalidRegistryCredentials), errors.Is(err, registrysvc.ErrRegistryUnreachable):
+		return gen.CreateRegistryCredential422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(err.Error())}, nil
+	case errors.Is(err, secretsvc.ErrPlaneNotFound):
+		return gen.CreateRegistryCredential422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent("target plane not found")}, nil
+	case errors.Is(err, secretsvc.ErrSecretStoreNotConfigured):
+		return gen.CreateRegistryCredential400JSONResponse{BadRequestJSONResponse: badRequest("secret store is not configured on the target plane")}, nil
+	case errors.As(err, &validationErr):
+		if validationErr.StatusCode == http.StatusUnprocessableEntity {
+			return gen.CreateRegistryCredential422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(validationErr.Msg)}, nil
+		}
+		return gen.CreateRegistryCredential400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+	default:
+		h.logger.Error("Failed to create registry credential", "error", err)
+		return gen.CreateRegistryCredential500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+}
+
+func toGenRegistryCredential(info *registrysvc.RegistryCredentialInfo) gen.RegistryCredential {
+	resp := gen.RegistryCredential{
+		Name:      info.Name,
+		Namespace: info.Namespace,
+		Server:    info.Server,
+		TargetPlane: gen.TargetPlaneRef{
+			Kind: gen.TargetPlaneRefKind(info.TargetPlane.Kind),
+			Name: info.TargetPlane.Name,
+		},
+	}
+	if info.Username != "" {
+		resp.Username = ptr.To(info.Username)
+	}
+	return resp
+}
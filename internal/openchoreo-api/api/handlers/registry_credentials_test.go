@@ -0,0 +1,106 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	registrysvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/registry"
+	registrymocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/registry/mocks"
+	secretsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secret"
+)
+
+func newRegistryHandler(svc registrysvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{RegistryService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func registryCredentialBody() *gen.CreateRegistryCredentialRequest {
+	return &gen.CreateRegistryCredentialRequest{
+		Name:           "ghcr",
+		Server:         "ghcr.io",
+		Username:       "bot",
+		Password:       "pw",
+		TargetPlane:    gen.TargetPlaneRef{Kind: gen.TargetPlaneRefKindDataPlane, Name: "default"},
+		SkipValidation: ptr.To(true),
+	}
+}
+
+func TestCreateRegistryCredentialHandler(t *testing.T) {
+	t.Run("forwards the request", func(t *testing.T) {
+		var captured *registrysvc.CreateRegistryCredentialParams
+		svc := registrymocks.NewMockService(t)
+		svc.EXPECT().CreateRegistryCredential(mock.Anything, "ns1", mock.Anything).
+			RunAndReturn(func(_ context.Context, namespace string, req *registrysvc.CreateRegistryCredentialParams) (*registrysvc.RegistryCredentialInfo, error) {
+				captured = req
+				return &registrysvc.RegistryCredentialInfo{
+					Name: req.Name, Namespace: namespace, Server: "ghcr.io", Username: req.Username, TargetPlane: req.TargetPlane,
+				}, nil
+			})
+
+		resp, err := newRegistryHandler(svc).CreateRegistryCredential(testContext(), gen.CreateRegistryCredentialRequestObject{
+			NamespaceName: "ns1",
+			Body:          registryCredentialBody(),
+		})
+		require.NoError(t, err)
+		created, ok := resp.(gen.CreateRegistryCredential201JSONResponse)
+		require.True(t, ok, "expected 201 response, got %T", resp)
+		assert.Equal(t, "ghcr.io", created.Server)
+		assert.Equal(t, gen.TargetPlaneRefKindDataPlane, created.TargetPlane.Kind)
+		require.NotNil(t, created.Username)
+
+		require.NotNil(t, captured)
+		assert.True(t, captured.SkipValidation)
+		assert.Equal(t, openchoreov1alpha1.TargetPlaneRef{Kind: "DataPlane", Name: "default"}, captured.TargetPlane)
+	})
+
+	tests := []struct {
+		name string
+		err  error
+		want any
+	}{
+		{name: "rejected credentials", err: registrysvc.ErrInvalidRegistryCredentials, want: gen.CreateRegistryCredential422JSONResponse{}},
+		{name: "unreachable registry", err: registrysvc.ErrRegistryUnreachable, want: gen.CreateRegistryCredential422JSONResponse{}},
+		{name: "already exists", err: secretsvc.ErrSecretAlreadyExists, want: gen.CreateRegistryCredential409JSONResponse{}},
+		{name: "no secret store", err: secretsvc.ErrSecretStoreNotConfigured, want: gen.CreateRegistryCredential400JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := registrymocks.NewMockService(t)
+			svc.EXPECT().CreateRegistryCredential(mock.Anything, "ns1", mock.Anything).Return(nil, tt.err)
+
+			resp, err := newRegistryHandler(svc).CreateRegistryCredential(testContext(), gen.CreateRegistryCredentialRequestObject{
+				NamespaceName: "ns1",
+				Body:          registryCredentialBody(),
+			})
+			require.NoError(t, err)
+			assert.IsType(t, tt.want, resp)
+		})
+	}
+}
+
+func TestDeleteRegistryCredentialHandler_NotFound(t *testing.T) {
+	svc := registrymocks.NewMockService(t)
+	svc.EXPECT().DeleteRegistryCredential(mock.Anything, "ns1", "ghcr").Return(registrysvc.ErrRegistryCredentialNotFound)
+
+	resp, err := newRegistryHandler(svc).DeleteRegistryCredential(testContext(), gen.DeleteRegistryCredentialRequestObject{
+		NamespaceName:          "ns1",
+		RegistryCredentialName: "ghcr",
+	})
+	require.NoError(t, err)
+	assert.IsType(t, gen.DeleteRegistryCredential404JSONResponse{}, resp)
+}
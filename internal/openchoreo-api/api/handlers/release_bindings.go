@@ -26,9 +26,11 @@ func (h *Handler) ListReleaseBindings(
 		componentName = *request.Params.Component
 	}
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.ReleaseBindingService.ListReleaseBindings(ctx, request.NamespaceName, componentName, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ReleaseBinding], error) {
+		return h.services.ReleaseBindingService.ListReleaseBindings(ctx, request.NamespaceName, componentName, opts)
+	})
 	if err != nil {
 		if errors.Is(err, services.ErrForbidden) {
 			return gen.ListReleaseBindings403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
@@ -52,7 +54,7 @@ func (h *Handler) ListReleaseBindings(
 	resp := gen.ListReleaseBindings200JSONResponse{
 		Items: items,
 	}
-	resp.Pagination = ToPagination(result)
+	resp.Pagination = ToPagination(result.WithFilter("component", componentName))
 
 	return resp, nil
 }
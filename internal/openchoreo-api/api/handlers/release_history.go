@@ -0,0 +1,170 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcerrors "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	releasesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/release"
+)
+
+// GetReleaseHistory returns the releases of a component to its environments, newest first.
+func (h *Handler) GetReleaseHistory(
+	ctx context.Context,
+	request gen.GetReleaseHistoryRequestObject,
+) (gen.GetReleaseHistoryResponseObject, error) {
+	h.logger.Debug("GetReleaseHistory called",
+		"namespaceName", request.NamespaceName,
+		"componentName", request.ComponentName)
+
+	environment := ""
+	if request.Params.Environment != nil {
+		environment = *request.Params.Environment
+	}
+
+	entries, err := h.services.ReleaseService.ListReleaseHistory(ctx, request.NamespaceName, request.ComponentName, environment)
+	if err != nil {
+		switch {
+		case errors.Is(err, svcerrors.ErrForbidden):
+			return gen.GetReleaseHistory403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, componentsvc.ErrComponentNotFound):
+			return gen.GetReleaseHistory404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		}
+		h.logger.Error("Failed to get release history", "error", err)
+		return gen.GetReleaseHistory500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	items := make([]gen.ReleaseHistoryEntry, 0, len(entries))
+	for _, entry := range entries {
+		items = append(items, toGenReleaseHistoryEntry(entry))
+	}
+	return gen.GetReleaseHistory200JSONResponse{Items: items}, nil
+}
+
+// RollbackComponent binds a release from the environment's history again.
+func (h *Handler) RollbackComponent(
+	ctx context.Context,
+	request gen.RollbackComponentRequestObject,
+) (gen.RollbackComponentResponseObject, error) {
+	h.logger.Info("RollbackComponent called",
+		"namespaceName", request.NamespaceName,
+		"componentName", request.ComponentName)
+
+	if request.Body == nil {
+		return gen.RollbackComponent400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	if request.Body.Environment == "" {
+		return gen.RollbackComponent400JSONResponse{BadRequestJSONResponse: badRequest("environment is required")}, nil
+	}
+
+	result, err := h.services.ReleaseService.RollbackRelease(ctx, request.NamespaceName, request.ComponentName,
+		request.Body.Environment, ptr.Deref(request.Body.Release, ""))
+	if err != nil {
+		switch {
+		case errors.Is(err, svcerrors.ErrForbidden):
+			return gen.RollbackComponent403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, componentsvc.ErrComponentNotFound):
+			return gen.RollbackComponent404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		case errors.Is(err, releasesvc.ErrNotDeployed):
+			return gen.RollbackComponent404JSONResponse{NotFoundJSONResponse: notFound("Deployment")}, nil
+		case errors.Is(err, releasesvc.ErrReleaseNotInHistory),
+			errors.Is(err, releasesvc.ErrReleaseNotFound):
+			return gen.RollbackComponent404JSONResponse{NotFoundJSONResponse: notFound("ComponentRelease")}, nil
+		case errors.Is(err, releasesvc.ErrNoPreviousRelease),
+			errors.Is(err, releasesvc.ErrReleaseAlreadyActive):
+			return gen.RollbackComponent409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
+		h.logger.Error("Failed to roll back component", "error", err)
+		return gen.RollbackComponent500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genRB, err := convert[openchoreov1alpha1.ReleaseBinding, gen.ReleaseBinding](*result.ReleaseBinding)
+	if err != nil {
+		h.logger.Error("Failed to convert release binding", "error", err)
+		return gen.RollbackComponent500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.RollbackComponent200JSONResponse{
+		Release:         result.Release,
+		PreviousRelease: result.PreviousRelease,
+		ReleaseBinding:  genRB,
+	}, nil
+}
+
+// ControlComponentRollout pauses, resumes, promotes or aborts the rollout of a component in
+// an environment.
+func (h *Handler) ControlComponentRollout(
+	ctx context.Context,
+	request gen.ControlComponentRolloutRequestObject,
+) (gen.ControlComponentRolloutResponseObject, error) {
+	h.logger.Info("ControlComponentRollout called",
+		"namespaceName", request.NamespaceName,
+		"componentName", request.ComponentName)
+
+	if request.Body == nil {
+		return gen.ControlComponentRollout400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	if request.Body.Environment == "" {
+		return gen.ControlComponentRollout400JSONResponse{BadRequestJSONResponse: badRequest("environment is required")}, nil
+	}
+
+	rb, err := h.services.ReleaseService.ControlRollout(ctx, request.NamespaceName, request.ComponentName,
+		request.Body.Environment, releasesvc.RolloutAction(request.Body.Action))
+	if err != nil {
+		var validationErr *svcerrors.ValidationError
+		switch {
+		case errors.Is(err, svcerrors.ErrForbidden):
+			return gen.ControlComponentRollout403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.As(err, &validationErr):
+			return gen.ControlComponentRollout400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		case errors.Is(err, componentsvc.ErrComponentNotFound):
+			return gen.ControlComponentRollout404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		case errors.Is(err, releasesvc.ErrNotDeployed):
+			return gen.ControlComponentRollout404JSONResponse{NotFoundJSONResponse: notFound("Deployment")}, nil
+		case errors.Is(err, releasesvc.ErrNoRolloutInProgress):
+			return gen.ControlComponentRollout409JSONResponse{ConflictJSONResponse: conflict(err.Error())}, nil
+		}
+		h.logger.Error("Failed to control rollout", "error", err)
+		return gen.ControlComponentRollout500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genRB, err := convert[openchoreov1alpha1.ReleaseBinding, gen.ReleaseBinding](*rb)
+	if err != nil {
+		h.logger.Error("Failed to convert release binding", "error", err)
+		return gen.ControlComponentRollout500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.ControlComponentRollout200JSONResponse{
+		Action:         string(request.Body.Action),
+		ReleaseBinding: genRB,
+	}, nil
+}
+
+func toGenReleaseHistoryEntry(entry releasesvc.HistoryEntry) gen.ReleaseHistoryEntry {
+	record := entry.Record
+	result := gen.ReleaseHistoryEntry{
+		Environment:        entry.Environment,
+		ReleaseBindingName: entry.ReleaseBindingName,
+		Current:            entry.Current,
+		ReleaseName:        record.ReleaseName,
+		Image:              optionalString(record.Image),
+		ConfigChecksum:     record.ConfigChecksum,
+		TriggeredBy:        optionalString(record.TriggeredBy),
+		DeployedAt:         record.DeployedAt.Time,
+	}
+	if record.Trigger != "" {
+		result.Trigger = ptr.To(gen.ReleaseHistoryEntryTrigger(record.Trigger))
+	}
+	if record.SupersededAt != nil {
+		result.SupersededAt = ptr.To(record.SupersededAt.Time)
+	}
+	return result
+}
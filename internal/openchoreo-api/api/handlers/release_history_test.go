@@ -0,0 +1,219 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	releasesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/release"
+	releasesvcmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/release/mocks"
+)
+
+func newHandlerWithReleaseService(svc releasesvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{ReleaseService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func TestGetReleaseHistoryHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("success maps entries", func(t *testing.T) {
+		deployedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		superseded := metav1.NewTime(deployedAt.Add(time.Hour))
+		svc := releasesvcmocks.NewMockService(t)
+		svc.EXPECT().ListReleaseHistory(mock.Anything, ns, "comp-a", "dev").Return([]releasesvc.HistoryEntry{{
+			Environment:        "dev",
+			ReleaseBindingName: "comp-a-dev",
+			Record: openchoreov1alpha1.ReleaseRecord{
+				ReleaseName:    "comp-a-1",
+				Image:          "app:v1",
+				ConfigChecksum: "sha256:abc",
+				Trigger:        "deploy",
+				TriggeredBy:    "alice",
+				DeployedAt:     metav1.NewTime(deployedAt),
+				SupersededAt:   &superseded,
+			},
+		}}, nil)
+
+		resp, err := newHandlerWithReleaseService(svc).GetReleaseHistory(ctx, gen.GetReleaseHistoryRequestObject{
+			NamespaceName: ns, ComponentName: "comp-a", Params: gen.GetReleaseHistoryParams{Environment: ptr.To("dev")},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetReleaseHistory200JSONResponse)
+		require.True(t, ok)
+		require.Len(t, typed.Items, 1)
+		item := typed.Items[0]
+		assert.Equal(t, "comp-a-1", item.ReleaseName)
+		assert.Equal(t, "app:v1", ptr.Deref(item.Image, ""))
+		assert.Equal(t, gen.ReleaseHistoryEntryTriggerDeploy, ptr.Deref(item.Trigger, ""))
+		assert.Equal(t, "alice", ptr.Deref(item.TriggeredBy, ""))
+		assert.Equal(t, deployedAt, item.DeployedAt)
+		require.NotNil(t, item.SupersededAt)
+		assert.False(t, item.Current)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := releasesvcmocks.NewMockService(t)
+		svc.EXPECT().ListReleaseHistory(mock.Anything, ns, "comp-a", "").Return(nil, svcpkg.ErrForbidden)
+		resp, err := newHandlerWithReleaseService(svc).GetReleaseHistory(ctx, gen.GetReleaseHistoryRequestObject{NamespaceName: ns, ComponentName: "comp-a"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetReleaseHistory403JSONResponse{}, resp)
+	})
+
+	t.Run("component not found returns 404", func(t *testing.T) {
+		svc := releasesvcmocks.NewMockService(t)
+		svc.EXPECT().ListReleaseHistory(mock.Anything, ns, "comp-a", "").Return(nil, componentsvc.ErrComponentNotFound)
+		resp, err := newHandlerWithReleaseService(svc).GetReleaseHistory(ctx, gen.GetReleaseHistoryRequestObject{NamespaceName: ns, ComponentName: "comp-a"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetReleaseHistory404JSONResponse{}, resp)
+	})
+
+	t.Run("unexpected error returns 500", func(t *testing.T) {
+		svc := releasesvcmocks.NewMockService(t)
+		svc.EXPECT().ListReleaseHistory(mock.Anything, ns, "comp-a", "").Return(nil, errors.New("boom"))
+		resp, err := newHandlerWithReleaseService(svc).GetReleaseHistory(ctx, gen.GetReleaseHistoryRequestObject{NamespaceName: ns, ComponentName: "comp-a"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetReleaseHistory500JSONResponse{}, resp)
+	})
+}
+
+func TestRollbackComponentHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("nil body returns 400", func(t *testing.T) {
+		h := newHandlerWithReleaseService(releasesvcmocks.NewMockService(t))
+		resp, err := h.RollbackComponent(ctx, gen.RollbackComponentRequestObject{NamespaceName: ns, ComponentName: "comp-a"})
+		require.NoError(t, err)
+		assert.IsType(t, gen.RollbackComponent400JSONResponse{}, resp)
+	})
+
+	t.Run("success returns binding", func(t *testing.T) {
+		svc := releasesvcmocks.NewMockService(t)
+		svc.EXPECT().RollbackRelease(mock.Anything, ns, "comp-a", "prod", "comp-a-1").Return(&releasesvc.RollbackResult{
+			Release:         "comp-a-1",
+			PreviousRelease: "comp-a-2",
+			ReleaseBinding: &openchoreov1alpha1.ReleaseBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "comp-a-prod", Namespace: ns},
+				Spec:       openchoreov1alpha1.ReleaseBindingSpec{Environment: "prod", ReleaseName: "comp-a-1"},
+			},
+		}, nil)
+
+		resp, err := newHandlerWithReleaseService(svc).RollbackComponent(ctx, gen.RollbackComponentRequestObject{
+			NamespaceName: ns, ComponentName: "comp-a",
+			Body: &gen.RollbackComponentRequest{Environment: "prod", Release: ptr.To("comp-a-1")},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.RollbackComponent200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, "comp-a-1", typed.Release)
+		assert.Equal(t, "comp-a-2", typed.PreviousRelease)
+		assert.Equal(t, "comp-a-prod", typed.ReleaseBinding.Metadata.Name)
+	})
+
+	errorCases := []struct {
+		name    string
+		err     error
+		expType any
+	}{
+		{"forbidden returns 403", svcpkg.ErrForbidden, gen.RollbackComponent403JSONResponse{}},
+		{"not deployed returns 404", releasesvc.ErrNotDeployed, gen.RollbackComponent404JSONResponse{}},
+		{"release not in history returns 404", releasesvc.ErrReleaseNotInHistory, gen.RollbackComponent404JSONResponse{}},
+		{"no previous release returns 409", releasesvc.ErrNoPreviousRelease, gen.RollbackComponent409JSONResponse{}},
+		{"already active returns 409", releasesvc.ErrReleaseAlreadyActive, gen.RollbackComponent409JSONResponse{}},
+		{"unexpected error returns 500", errors.New("boom"), gen.RollbackComponent500JSONResponse{}},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := releasesvcmocks.NewMockService(t)
+			svc.EXPECT().RollbackRelease(mock.Anything, ns, "comp-a", "prod", "").Return(nil, tc.err)
+			resp, err := newHandlerWithReleaseService(svc).RollbackComponent(ctx, gen.RollbackComponentRequestObject{
+				NamespaceName: ns, ComponentName: "comp-a", Body: &gen.RollbackComponentRequest{Environment: "prod"},
+			})
+			require.NoError(t, err)
+			assert.IsType(t, tc.expType, resp)
+		})
+	}
+}
+
+func TestControlComponentRolloutHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	t.Run("missing environment returns 400", func(t *testing.T) {
+		h := newHandlerWithReleaseService(releasesvcmocks.NewMockService(t))
+		resp, err := h.ControlComponentRollout(ctx, gen.ControlComponentRolloutRequestObject{
+			NamespaceName: ns, ComponentName: "comp-a", Body: &gen.ControlComponentRolloutRequest{Action: gen.Promote},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ControlComponentRollout400JSONResponse{}, resp)
+	})
+
+	t.Run("success returns binding", func(t *testing.T) {
+		svc := releasesvcmocks.NewMockService(t)
+		svc.EXPECT().ControlRollout(mock.Anything, ns, "comp-a", "prod", releasesvc.RolloutActionPromote).Return(&openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "comp-a-prod", Namespace: ns},
+			Spec:       openchoreov1alpha1.ReleaseBindingSpec{Environment: "prod", ReleaseName: "comp-a-2"},
+			Status: openchoreov1alpha1.ReleaseBindingStatus{Rollout: &openchoreov1alpha1.RolloutStatus{
+				Strategy: openchoreov1alpha1.RolloutStrategyCanary, Phase: openchoreov1alpha1.RolloutPhasePaused, CanaryWeight: 20,
+			}},
+		}, nil)
+
+		resp, err := newHandlerWithReleaseService(svc).ControlComponentRollout(ctx, gen.ControlComponentRolloutRequestObject{
+			NamespaceName: ns, ComponentName: "comp-a",
+			Body: &gen.ControlComponentRolloutRequest{Environment: "prod", Action: gen.Promote},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ControlComponentRollout200JSONResponse)
+		require.True(t, ok)
+		assert.Equal(t, "promote", typed.Action)
+		assert.Equal(t, "comp-a-prod", typed.ReleaseBinding.Metadata.Name)
+		require.NotNil(t, typed.ReleaseBinding.Status)
+		require.NotNil(t, typed.ReleaseBinding.Status.Rollout)
+		assert.Equal(t, ptr.To(int32(20)), typed.ReleaseBinding.Status.Rollout.CanaryWeight)
+	})
+
+	errorCases := []struct {
+		name    string
+		err     error
+		expType any
+	}{
+		{"forbidden returns 403", svcpkg.ErrForbidden, gen.ControlComponentRollout403JSONResponse{}},
+		{"invalid action returns 400", &svcpkg.ValidationError{Msg: "unknown rollout action"}, gen.ControlComponentRollout400JSONResponse{}},
+		{"component not found returns 404", componentsvc.ErrComponentNotFound, gen.ControlComponentRollout404JSONResponse{}},
+		{"not deployed returns 404", releasesvc.ErrNotDeployed, gen.ControlComponentRollout404JSONResponse{}},
+		{"no rollout returns 409", releasesvc.ErrNoRolloutInProgress, gen.ControlComponentRollout409JSONResponse{}},
+		{"unexpected error returns 500", errors.New("boom"), gen.ControlComponentRollout500JSONResponse{}},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := releasesvcmocks.NewMockService(t)
+			svc.EXPECT().ControlRollout(mock.Anything, ns, "comp-a", "prod", releasesvc.RolloutActionAbort).Return(nil, tc.err)
+			resp, err := newHandlerWithReleaseService(svc).ControlComponentRollout(ctx, gen.ControlComponentRolloutRequestObject{
+				NamespaceName: ns, ComponentName: "comp-a",
+				Body: &gen.ControlComponentRolloutRequest{Environment: "prod", Action: gen.Abort},
+			})
+			require.NoError(t, err)
+			assert.IsType(t, tc.expType, resp)
+		})
+	}
+}
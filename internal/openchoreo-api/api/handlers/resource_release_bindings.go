@@ -25,9 +25,11 @@ func (h *Handler) ListResourceReleaseBindings(
 		resourceName = *request.Params.Resource
 	}
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.ResourceReleaseBindingService.ListResourceReleaseBindings(ctx, request.NamespaceName, resourceName, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ResourceReleaseBinding], error) {
+		return h.services.ResourceReleaseBindingService.ListResourceReleaseBindings(ctx, request.NamespaceName, resourceName, opts)
+	})
 	if err != nil {
 		var validationErr *services.ValidationError
 		if errors.As(err, &validationErr) {
@@ -45,7 +47,7 @@ func (h *Handler) ListResourceReleaseBindings(
 
 	return gen.ListResourceReleaseBindings200JSONResponse{
 		Items:      items,
-		Pagination: ToPagination(result),
+		Pagination: ToPagination(result.WithFilter("resource", resourceName)),
 	}, nil
 }
 
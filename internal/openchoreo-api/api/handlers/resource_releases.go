@@ -25,9 +25,11 @@ func (h *Handler) ListResourceReleases(
 		resourceName = *request.Params.Resource
 	}
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.ResourceReleaseService.ListResourceReleases(ctx, request.NamespaceName, resourceName, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ResourceRelease], error) {
+		return h.services.ResourceReleaseService.ListResourceReleases(ctx, request.NamespaceName, resourceName, opts)
+	})
 	if err != nil {
 		var validationErr *services.ValidationError
 		if errors.As(err, &validationErr) {
@@ -46,7 +48,7 @@ func (h *Handler) ListResourceReleases(
 	resp := gen.ListResourceReleases200JSONResponse{
 		Items: items,
 	}
-	resp.Pagination = ToPagination(result)
+	resp.Pagination = ToPagination(result.WithFilter("resource", resourceName))
 
 	return resp, nil
 }
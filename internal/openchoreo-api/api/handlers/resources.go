@@ -5,13 +5,24 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
 	resourcesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/resource"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
 )
 
 // ListResources returns a paginated list of resources within a namespace.
@@ -26,9 +37,11 @@ func (h *Handler) ListResources(
 		projectName = *request.Params.Project
 	}
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.ResourceService.ListResources(ctx, request.NamespaceName, projectName, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.Resource], error) {
+		return h.services.ResourceService.ListResources(ctx, request.NamespaceName, projectName, opts)
+	})
 	if err != nil {
 		if errors.Is(err, projectsvc.ErrProjectNotFound) {
 			return gen.ListResources404JSONResponse{NotFoundJSONResponse: notFound("Project")}, nil
@@ -49,7 +62,7 @@ func (h *Handler) ListResources(
 
 	return gen.ListResources200JSONResponse{
 		Items:      items,
-		Pagination: ToPagination(result),
+		Pagination: ToPagination(result.WithFilter("project", projectName)),
 	}, nil
 }
 
@@ -70,6 +83,7 @@ func (h *Handler) CreateResource(
 		return gen.CreateResource400JSONResponse{BadRequestJSONResponse: badRequest("Invalid request body")}, nil
 	}
 
+	setResourceAuditTarget(ctx, request.NamespaceName, rCR.Name)
 	created, err := h.services.ResourceService.CreateResource(ctx, request.NamespaceName, &rCR)
 	if err != nil {
 		if errors.Is(err, services.ErrForbidden) {
@@ -95,6 +109,7 @@ func (h *Handler) CreateResource(
 		return gen.CreateResource500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
 	}
 
+	h.auditResourceChanges(ctx, nil, created)
 	h.logger.Info("Resource created successfully", "namespaceName", request.NamespaceName, "resource", created.Name)
 	return gen.CreateResource201JSONResponse(genR), nil
 }
@@ -146,6 +161,8 @@ func (h *Handler) UpdateResource(
 
 	rCR.Name = request.ResourceName
 
+	setResourceAuditTarget(ctx, request.NamespaceName, request.ResourceName)
+	previous := h.resourceForAudit(ctx, request.NamespaceName, request.ResourceName)
 	updated, err := h.services.ResourceService.UpdateResource(ctx, request.NamespaceName, &rCR)
 	if err != nil {
 		if errors.Is(err, services.ErrForbidden) {
@@ -168,6 +185,9 @@ func (h *Handler) UpdateResource(
 		return gen.UpdateResource500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
 	}
 
+	if previous != nil {
+		h.auditResourceChanges(ctx, previous, updated)
+	}
 	h.logger.Info("Resource updated successfully", "namespaceName", request.NamespaceName, "resource", updated.Name)
 	return gen.UpdateResource200JSONResponse(genR), nil
 }
@@ -179,6 +199,8 @@ func (h *Handler) DeleteResource(
 ) (gen.DeleteResourceResponseObject, error) {
 	h.logger.Info("DeleteResource called", "namespaceName", request.NamespaceName, "resourceName", request.ResourceName)
 
+	setResourceAuditTarget(ctx, request.NamespaceName, request.ResourceName)
+	previous := h.resourceForAudit(ctx, request.NamespaceName, request.ResourceName)
 	err := h.services.ResourceService.DeleteResource(ctx, request.NamespaceName, request.ResourceName)
 	if err != nil {
 		if errors.Is(err, services.ErrForbidden) {
@@ -191,6 +213,469 @@ func (h *Handler) DeleteResource(
 		return gen.DeleteResource500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
 	}
 
+	if previous != nil {
+		h.auditResourceChanges(ctx, previous, nil)
+	}
 	h.logger.Info("Resource deleted successfully", "namespaceName", request.NamespaceName, "resource", request.ResourceName)
 	return gen.DeleteResource204Response{}, nil
 }
+
+// PatchResource applies a JSON merge patch to an existing resource.
+func (h *Handler) PatchResource(
+	ctx context.Context,
+	request gen.PatchResourceRequestObject,
+) (gen.PatchResourceResponseObject, error) {
+	h.logger.Info("PatchResource called", "namespaceName", request.NamespaceName, "resourceName", request.ResourceName)
+
+	if request.Body == nil {
+		return gen.PatchResource400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	setResourceAuditTarget(ctx, request.NamespaceName, request.ResourceName)
+	existing, err := h.services.ResourceService.GetResource(ctx, request.NamespaceName, request.ResourceName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.PatchResource403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, resourcesvc.ErrResourceNotFound) {
+			return gen.PatchResource404JSONResponse{NotFoundJSONResponse: notFound("Resource")}, nil
+		}
+		h.logger.Error("Failed to get resource", "error", err)
+		return gen.PatchResource500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	patched, err := mergePatchResource(existing, *request.Body)
+	if err != nil {
+		h.logger.Debug("Failed to apply merge patch", "error", err)
+		return gen.PatchResource400JSONResponse{BadRequestJSONResponse: badRequest("Invalid merge patch")}, nil
+	}
+	patched.Name = request.ResourceName
+
+	updated, err := h.services.ResourceService.UpdateResource(ctx, request.NamespaceName, patched)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.PatchResource403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, resourcesvc.ErrResourceNotFound) {
+			return gen.PatchResource404JSONResponse{NotFoundJSONResponse: notFound("Resource")}, nil
+		}
+		var validationErr *services.ValidationError
+		if errors.As(err, &validationErr) {
+			return gen.PatchResource400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to patch resource", "error", err)
+		return gen.PatchResource500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genR, err := convert[openchoreov1alpha1.Resource, gen.ResourceInstance](*updated)
+	if err != nil {
+		h.logger.Error("Failed to convert patched resource", "error", err)
+		return gen.PatchResource500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.auditResourceChanges(ctx, existing, updated)
+	h.logger.Info("Resource patched successfully", "namespaceName", request.NamespaceName, "resource", updated.Name)
+	return gen.PatchResource200JSONResponse(genR), nil
+}
+
+// ApplyResource creates a resource or replaces it if it already exists.
+func (h *Handler) ApplyResource(
+	ctx context.Context,
+	request gen.ApplyResourceRequestObject,
+) (gen.ApplyResourceResponseObject, error) {
+	h.logger.Info("ApplyResource called", "namespaceName", request.NamespaceName, "resourceName", request.ResourceName)
+
+	if request.Body == nil {
+		return gen.ApplyResource400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	rCR, err := convert[gen.ResourceInstance, openchoreov1alpha1.Resource](*request.Body)
+	if err != nil {
+		h.logger.Error("Failed to convert apply request", "error", err)
+		return gen.ApplyResource400JSONResponse{BadRequestJSONResponse: badRequest("Invalid request body")}, nil
+	}
+	rCR.Name = request.ResourceName
+
+	setResourceAuditTarget(ctx, request.NamespaceName, request.ResourceName)
+	existing, err := h.services.ResourceService.GetResource(ctx, request.NamespaceName, request.ResourceName)
+	exists := err == nil
+	if err != nil && !errors.Is(err, resourcesvc.ErrResourceNotFound) {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.ApplyResource403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		h.logger.Error("Failed to get resource", "error", err)
+		return gen.ApplyResource500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	var applied *openchoreov1alpha1.Resource
+	if exists {
+		applied, err = h.services.ResourceService.UpdateResource(ctx, request.NamespaceName, &rCR)
+	} else {
+		applied, err = h.services.ResourceService.CreateResource(ctx, request.NamespaceName, &rCR)
+	}
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.ApplyResource403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, projectsvc.ErrProjectNotFound) {
+			return gen.ApplyResource400JSONResponse{BadRequestJSONResponse: badRequest("Referenced project not found")}, nil
+		}
+		if errors.Is(err, resourcesvc.ErrResourceAlreadyExists) || errors.Is(err, resourcesvc.ErrResourceNotFound) {
+			// The resource was created or deleted concurrently.
+			return gen.ApplyResource409JSONResponse{ConflictJSONResponse: conflict("Resource was modified concurrently, retry the request")}, nil
+		}
+		var validationErr *services.ValidationError
+		if errors.As(err, &validationErr) {
+			return gen.ApplyResource400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to apply resource", "error", err)
+		return gen.ApplyResource500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	genR, err := convert[openchoreov1alpha1.Resource, gen.ResourceInstance](*applied)
+	if err != nil {
+		h.logger.Error("Failed to convert applied resource", "error", err)
+		return gen.ApplyResource500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	h.auditResourceChanges(ctx, existing, applied)
+	h.logger.Info("Resource applied successfully", "namespaceName", request.NamespaceName,
+		"resource", applied.Name, "created", !exists)
+	if exists {
+		return gen.ApplyResource200JSONResponse(genR), nil
+	}
+	return gen.ApplyResource201JSONResponse(genR), nil
+}
+
+// DiffResource reports the fields an apply of the given resource would change.
+func (h *Handler) DiffResource(
+	ctx context.Context,
+	request gen.DiffResourceRequestObject,
+) (gen.DiffResourceResponseObject, error) {
+	h.logger.Debug("DiffResource called", "namespaceName", request.NamespaceName, "resourceName", request.ResourceName)
+
+	if request.Body == nil {
+		return gen.DiffResource400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+
+	desired, err := convert[gen.ResourceInstance, openchoreov1alpha1.Resource](*request.Body)
+	if err != nil {
+		h.logger.Error("Failed to convert diff request", "error", err)
+		return gen.DiffResource400JSONResponse{BadRequestJSONResponse: badRequest("Invalid request body")}, nil
+	}
+
+	current, err := h.services.ResourceService.GetResource(ctx, request.NamespaceName, request.ResourceName)
+	if err != nil && !errors.Is(err, resourcesvc.ErrResourceNotFound) {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.DiffResource403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		h.logger.Error("Failed to get resource", "error", err)
+		return gen.DiffResource500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	currentView := map[string]any{}
+	if current != nil {
+		if currentView, err = resourceDiffView(current); err != nil {
+			h.logger.Error("Failed to convert stored resource", "error", err)
+			return gen.DiffResource500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+		}
+	}
+	desiredView, err := resourceDiffView(normalizeDesiredResource(&desired))
+	if err != nil {
+		h.logger.Error("Failed to convert desired resource", "error", err)
+		return gen.DiffResource500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	changes := []gen.ResourceFieldChange{}
+	diffFields("", currentView, desiredView, &changes)
+	return gen.DiffResource200JSONResponse{Exists: current != nil, Changes: changes}, nil
+}
+
+// ListResourceK8sResources returns the live objects that the releases of a
+// resource applied to their data planes.
+func (h *Handler) ListResourceK8sResources(
+	ctx context.Context,
+	request gen.ListResourceK8sResourcesRequestObject,
+) (gen.ListResourceK8sResourcesResponseObject, error) {
+	h.logger.Debug("ListResourceK8sResources called", "namespaceName", request.NamespaceName, "resourceName", request.ResourceName)
+
+	objects, err := h.services.ResourceService.ListDeployedObjects(
+		ctx, request.NamespaceName, request.ResourceName, ptr.Deref(request.Params.Cluster, ""))
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.ListResourceK8sResources403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, resourcesvc.ErrResourceNotFound) {
+			return gen.ListResourceK8sResources404JSONResponse{NotFoundJSONResponse: notFound("Resource")}, nil
+		}
+		h.logger.Error("Failed to list deployed objects", "error", err)
+		return gen.ListResourceK8sResources500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	items, err := convertList[models.DeployedObject, gen.DeployedObject](objects)
+	if err != nil {
+		h.logger.Error("Failed to convert deployed objects", "error", err)
+		return gen.ListResourceK8sResources500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.ListResourceK8sResources200JSONResponse{Items: items}, nil
+}
+
+// maxBatchItems is the largest number of items accepted by a batch request.
+const maxBatchItems = 100
+
+// BatchApplyResources creates or replaces each resource of the request.
+func (h *Handler) BatchApplyResources(
+	ctx context.Context,
+	request gen.BatchApplyResourcesRequestObject,
+) (gen.BatchApplyResourcesResponseObject, error) {
+	h.logger.Info("BatchApplyResources called")
+
+	if request.Body == nil {
+		return gen.BatchApplyResources400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	if msg := validateBatchSize(len(request.Body.Items)); msg != "" {
+		return gen.BatchApplyResources400JSONResponse{BadRequestJSONResponse: badRequest(msg)}, nil
+	}
+
+	resources, err := convertList[gen.ResourceInstance, openchoreov1alpha1.Resource](request.Body.Items)
+	if err != nil {
+		h.logger.Debug("Failed to convert batch apply request", "error", err)
+		return gen.BatchApplyResources400JSONResponse{BadRequestJSONResponse: badRequest("Invalid request body")}, nil
+	}
+
+	results := h.services.ResourceService.ApplyBundle(ctx, resources)
+	result := h.batchResult(results, true)
+	audit.AddMetadataBatch(ctx, map[string]any{"succeeded": result.Succeeded, "failed": result.Failed})
+	h.logger.Info("Resource batch applied", "status", result.Status, "succeeded", result.Succeeded, "failed", result.Failed)
+	return gen.BatchApplyResources200JSONResponse(result), nil
+}
+
+// BatchDeleteResources deletes each resource named in the request.
+func (h *Handler) BatchDeleteResources(
+	ctx context.Context,
+	request gen.BatchDeleteResourcesRequestObject,
+) (gen.BatchDeleteResourcesResponseObject, error) {
+	h.logger.Info("BatchDeleteResources called")
+
+	if request.Body == nil {
+		return gen.BatchDeleteResources400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	if msg := validateBatchSize(len(request.Body.Items)); msg != "" {
+		return gen.BatchDeleteResources400JSONResponse{BadRequestJSONResponse: badRequest(msg)}, nil
+	}
+
+	refs := make([]models.NamespacedName, 0, len(request.Body.Items))
+	for _, item := range request.Body.Items {
+		refs = append(refs, models.NamespacedName{Namespace: item.Namespace, Name: item.Name})
+	}
+
+	results := h.services.ResourceService.DeleteResources(ctx, refs)
+	result := h.batchResult(results, false)
+	audit.AddMetadataBatch(ctx, map[string]any{"succeeded": result.Succeeded, "failed": result.Failed})
+	h.logger.Info("Resource batch deleted", "status", result.Status, "succeeded", result.Succeeded, "failed", result.Failed)
+	return gen.BatchDeleteResources200JSONResponse(result), nil
+}
+
+func validateBatchSize(n int) string {
+	switch {
+	case n == 0:
+		return "At least one item is required"
+	case n > maxBatchItems:
+		return fmt.Sprintf("At most %d items are allowed in a batch", maxBatchItems)
+	}
+	return ""
+}
+
+// batchResult converts the outcome of the items of a batch and derives the
+// overall status from them. apply tells whether the items were applied rather
+// than deleted.
+func (h *Handler) batchResult(results []models.BatchItemResult, apply bool) gen.BatchResult {
+	out := gen.BatchResult{Items: make([]gen.BatchItemResult, 0, len(results))}
+	for i, r := range results {
+		item := gen.BatchItemResult{Index: i, Namespace: r.Namespace, Name: r.Name}
+		if r.Err != nil {
+			item.Status = gen.BatchItemResultStatusFailed
+			item.Error = ptr.To(h.batchItemError(r, apply))
+			out.Failed++
+		} else {
+			item.Status = gen.BatchItemResultStatusSucceeded
+			item.Operation = ptr.To(gen.BatchItemResultOperation(r.Operation))
+			out.Succeeded++
+		}
+		out.Items = append(out.Items, item)
+	}
+
+	switch {
+	case out.Failed == 0:
+		out.Status = gen.BatchResultStatusSucceeded
+	case out.Succeeded == 0:
+		out.Status = gen.BatchResultStatusFailed
+	default:
+		out.Status = gen.BatchResultStatusPartiallySucceeded
+	}
+	return out
+}
+
+// batchItemError maps the error of a failed item to the error response the
+// single-item operation would return for it.
+func (h *Handler) batchItemError(r models.BatchItemResult, apply bool) gen.ErrorResponse {
+	var validationErr *services.ValidationError
+	switch {
+	case errors.Is(r.Err, services.ErrForbidden):
+		return gen.ErrorResponse(forbidden())
+	case errors.As(r.Err, &validationErr):
+		return gen.ErrorResponse(badRequest(validationErr.Msg))
+	case errors.Is(r.Err, projectsvc.ErrProjectNotFound):
+		return gen.ErrorResponse(badRequest("Referenced project not found"))
+	case !apply && errors.Is(r.Err, resourcesvc.ErrResourceNotFound):
+		return gen.ErrorResponse(notFound("Resource"))
+	case errors.Is(r.Err, resourcesvc.ErrResourceAlreadyExists) || errors.Is(r.Err, resourcesvc.ErrResourceNotFound):
+		// The resource was created or deleted concurrently.
+		return gen.ErrorResponse(conflict("Resource was modified concurrently, retry the request"))
+	}
+	h.logger.Error("Failed to process batch item", "namespaceName", r.Namespace, "resource", r.Name, "error", r.Err)
+	return gen.ErrorResponse(internalError())
+}
+
+// setResourceAuditTarget records the resource targeted by the request in its audit event.
+func setResourceAuditTarget(ctx context.Context, namespaceName, resourceName string) {
+	audit.SetResource(ctx, &audit.Resource{Type: "resource", Name: resourceName, Namespace: namespaceName})
+}
+
+// resourceForAudit returns the stored resource so that the changes made by an
+// operation can be audited, or nil if the request is not audited or the
+// resource cannot be read.
+func (h *Handler) resourceForAudit(ctx context.Context, namespaceName, resourceName string) *openchoreov1alpha1.Resource {
+	if !audit.IsEnabled(ctx) {
+		return nil
+	}
+	resource, err := h.services.ResourceService.GetResource(ctx, namespaceName, resourceName)
+	if err != nil {
+		return nil
+	}
+	return resource
+}
+
+// auditResourceChanges adds a summary of the fields changed between before and
+// after to the audit event of the request. A nil resource stands for one that
+// does not exist. Only field paths are recorded; values may be sensitive.
+func (h *Handler) auditResourceChanges(ctx context.Context, before, after *openchoreov1alpha1.Resource) {
+	if !audit.IsEnabled(ctx) {
+		return
+	}
+	views := [2]map[string]any{{}, {}}
+	for i, resource := range []*openchoreov1alpha1.Resource{before, after} {
+		if resource == nil {
+			continue
+		}
+		view, err := resourceDiffView(resource)
+		if err != nil {
+			h.logger.Warn("Failed to summarize resource changes for audit", "error", err)
+			return
+		}
+		views[i] = view
+	}
+
+	var changes []gen.ResourceFieldChange
+	diffFields("", views[0], views[1], &changes)
+	summary := make([]string, 0, len(changes))
+	for _, c := range changes {
+		summary = append(summary, c.Operation+" "+c.Path)
+	}
+	audit.AddMetadata(ctx, "changes", summary)
+}
+
+// mergePatchResource applies an RFC 7386 merge patch to a copy of resource.
+func mergePatchResource(resource *openchoreov1alpha1.Resource, patch map[string]any) (*openchoreov1alpha1.Resource, error) {
+	original, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	patchJSON, err := json.Marshal(patch)
+	if err != nil {
+		return nil, err
+	}
+	merged, err := jsonpatch.MergePatch(original, patchJSON)
+	if err != nil {
+		return nil, err
+	}
+	patched := &openchoreov1alpha1.Resource{}
+	if err := json.Unmarshal(merged, patched); err != nil {
+		return nil, err
+	}
+	return patched, nil
+}
+
+// normalizeDesiredResource fills in the values the API server and the resource
+// service set on write, so that they are not reported as differences.
+func normalizeDesiredResource(resource *openchoreov1alpha1.Resource) *openchoreov1alpha1.Resource {
+	if resource.Spec.Type.Kind == "" {
+		resource.Spec.Type.Kind = openchoreov1alpha1.ResourceTypeRefKindResourceType
+	}
+	if resource.Labels == nil {
+		resource.Labels = make(map[string]string)
+	}
+	resource.Labels[labels.LabelKeyProjectName] = resource.Spec.Owner.ProjectName
+	return resource
+}
+
+// resourceDiffView returns the user-mutable fields of a resource as generic JSON values.
+func resourceDiffView(resource *openchoreov1alpha1.Resource) (map[string]any, error) {
+	data, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"labels":      resource.Labels,
+			"annotations": resource.Annotations,
+		},
+		"spec": resource.Spec,
+	})
+	if err != nil {
+		return nil, err
+	}
+	view := map[string]any{}
+	if err := json.Unmarshal(data, &view); err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+// diffFields appends the changes between current and desired to changes, in
+// path order. Objects are compared field by field; any other values, including
+// arrays, are compared as a whole.
+func diffFields(path string, current, desired any, changes *[]gen.ResourceFieldChange) {
+	currentObj, currentIsObj := current.(map[string]any)
+	desiredObj, desiredIsObj := desired.(map[string]any)
+	if !currentIsObj || !desiredIsObj {
+		if !reflect.DeepEqual(current, desired) {
+			*changes = append(*changes, gen.ResourceFieldChange{
+				Path: path, Operation: "replace", Current: current, Desired: desired,
+			})
+		}
+		return
+	}
+
+	keys := sets.KeySet(currentObj).Union(sets.KeySet(desiredObj)).UnsortedList()
+	slices.Sort(keys)
+	for _, key := range keys {
+		fieldPath := key
+		if path != "" {
+			fieldPath = path + "." + key
+		}
+		currentValue, inCurrent := currentObj[key]
+		desiredValue, inDesired := desiredObj[key]
+		switch {
+		case !inDesired || desiredValue == nil:
+			if currentValue != nil {
+				*changes = append(*changes, gen.ResourceFieldChange{
+					Path: fieldPath, Operation: "remove", Current: currentValue,
+				})
+			}
+		case !inCurrent || currentValue == nil:
+			*changes = append(*changes, gen.ResourceFieldChange{
+				Path: fieldPath, Operation: "add", Desired: desiredValue,
+			})
+		default:
+			diffFields(fieldPath, currentValue, desiredValue, changes)
+		}
+	}
+}
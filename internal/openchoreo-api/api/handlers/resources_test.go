@@ -16,6 +16,7 @@ import (
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/labels"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
 	resourcesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/resource"
@@ -44,7 +45,7 @@ func newResourceService(t *testing.T, objects []client.Object, pdp authzcore.PDP
 		WithScheme(newTestScheme(t)).
 		WithObjects(bootstrap...).
 		Build()
-	return resourcesvc.NewServiceWithAuthz(fakeClient, pdp, slog.Default())
+	return resourcesvc.NewServiceWithAuthz(fakeClient, nil, pdp, slog.Default())
 }
 
 func newHandlerWithResourceService(svc resourcesvc.Service) *Handler {
@@ -406,3 +407,399 @@ func TestDeleteResourceHandler(t *testing.T) {
 		assert.IsType(t, gen.DeleteResource403JSONResponse{}, resp)
 	})
 }
+
+// --- PatchResource Handler ---
+
+func TestPatchResourceHandler(t *testing.T) {
+	ctx := testContext()
+
+	t.Run("success - merges labels and parameters", func(t *testing.T) {
+		svc := newResourceService(t, []client.Object{testResourceObj("r-1")}, &allowAllPDP{})
+		h := newHandlerWithResourceService(svc)
+
+		body := gen.PatchResourceApplicationMergePatchPlusJSONRequestBody{
+			"metadata": map[string]any{"labels": map[string]any{"tier": "gold"}},
+			"spec":     map[string]any{"parameters": map[string]any{"version": "8.0"}},
+		}
+		resp, err := h.PatchResource(ctx, gen.PatchResourceRequestObject{
+			NamespaceName: testResourceNs,
+			ResourceName:  "r-1",
+			Body:          &body,
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.PatchResource200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		require.NotNil(t, typed.Metadata.Labels)
+		assert.Equal(t, "gold", (*typed.Metadata.Labels)["tier"])
+		require.NotNil(t, typed.Spec.Parameters)
+		assert.Equal(t, "8.0", (*typed.Spec.Parameters)["version"])
+		assert.Equal(t, "mysql", typed.Spec.Type.Name)
+	})
+
+	t.Run("project reassignment returns 400", func(t *testing.T) {
+		svc := newResourceService(t, []client.Object{testResourceObj("r-1")}, &allowAllPDP{})
+		h := newHandlerWithResourceService(svc)
+
+		body := gen.PatchResourceApplicationMergePatchPlusJSONRequestBody{
+			"spec": map[string]any{"owner": map[string]any{"projectName": "other"}},
+		}
+		resp, err := h.PatchResource(ctx, gen.PatchResourceRequestObject{
+			NamespaceName: testResourceNs,
+			ResourceName:  "r-1",
+			Body:          &body,
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.PatchResource400JSONResponse{}, resp)
+	})
+
+	t.Run("not found returns 404", func(t *testing.T) {
+		svc := newResourceService(t, nil, &allowAllPDP{})
+		h := newHandlerWithResourceService(svc)
+
+		body := gen.PatchResourceApplicationMergePatchPlusJSONRequestBody{}
+		resp, err := h.PatchResource(ctx, gen.PatchResourceRequestObject{
+			NamespaceName: testResourceNs,
+			ResourceName:  "nonexistent",
+			Body:          &body,
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.PatchResource404JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := newResourceService(t, []client.Object{testResourceObj("r-1")}, &denyAllPDP{})
+		h := newHandlerWithResourceService(svc)
+
+		body := gen.PatchResourceApplicationMergePatchPlusJSONRequestBody{}
+		resp, err := h.PatchResource(ctx, gen.PatchResourceRequestObject{
+			NamespaceName: testResourceNs,
+			ResourceName:  "r-1",
+			Body:          &body,
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.PatchResource403JSONResponse{}, resp)
+	})
+}
+
+// --- ApplyResource Handler ---
+
+func testResourceBody(name string) gen.ResourceInstance {
+	return gen.ResourceInstance{
+		Metadata: gen.ObjectMeta{Name: name},
+		Spec: &gen.ResourceInstanceSpec{
+			Owner: struct {
+				ProjectName string `json:"projectName"`
+			}{ProjectName: testResourceProject},
+			Type: gen.ResourceTypeRef{Name: "mysql"},
+		},
+	}
+}
+
+func TestApplyResourceHandler(t *testing.T) {
+	ctx := testContext()
+
+	t.Run("creates missing resource", func(t *testing.T) {
+		svc := newResourceService(t, nil, &allowAllPDP{})
+		h := newHandlerWithResourceService(svc)
+
+		body := testResourceBody("r-new")
+		resp, err := h.ApplyResource(ctx, gen.ApplyResourceRequestObject{
+			NamespaceName: testResourceNs,
+			ResourceName:  "r-new",
+			Body:          &body,
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ApplyResource201JSONResponse)
+		require.True(t, ok, "expected 201 response, got %T", resp)
+		assert.Equal(t, "r-new", typed.Metadata.Name)
+	})
+
+	t.Run("updates existing resource", func(t *testing.T) {
+		svc := newResourceService(t, []client.Object{testResourceObj("r-1")}, &allowAllPDP{})
+		h := newHandlerWithResourceService(svc)
+
+		body := testResourceBody("ignored")
+		body.Spec.Parameters = &map[string]interface{}{"version": "8.0"}
+		resp, err := h.ApplyResource(ctx, gen.ApplyResourceRequestObject{
+			NamespaceName: testResourceNs,
+			ResourceName:  "r-1",
+			Body:          &body,
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ApplyResource200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, "r-1", typed.Metadata.Name)
+		require.NotNil(t, typed.Spec.Parameters)
+		assert.Equal(t, "8.0", (*typed.Spec.Parameters)["version"])
+	})
+
+	t.Run("nil body returns 400", func(t *testing.T) {
+		svc := newResourceService(t, nil, &allowAllPDP{})
+		h := newHandlerWithResourceService(svc)
+
+		resp, err := h.ApplyResource(ctx, gen.ApplyResourceRequestObject{
+			NamespaceName: testResourceNs,
+			ResourceName:  "r-1",
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ApplyResource400JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := newResourceService(t, []client.Object{testResourceObj("r-1")}, &denyAllPDP{})
+		h := newHandlerWithResourceService(svc)
+
+		body := testResourceBody("r-1")
+		resp, err := h.ApplyResource(ctx, gen.ApplyResourceRequestObject{
+			NamespaceName: testResourceNs,
+			ResourceName:  "r-1",
+			Body:          &body,
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ApplyResource403JSONResponse{}, resp)
+	})
+}
+
+// --- BatchApplyResources Handler ---
+
+func TestBatchApplyResourcesHandler(t *testing.T) {
+	ctx := testContext()
+
+	t.Run("partially succeeds", func(t *testing.T) {
+		svc := newResourceService(t, []client.Object{testResourceObj("r-1")}, &allowAllPDP{})
+		h := newHandlerWithResourceService(svc)
+
+		created := testResourceBody("r-new")
+		created.Metadata.Namespace = ptr.To(testResourceNs)
+		updated := testResourceBody("r-1")
+		updated.Metadata.Namespace = ptr.To(testResourceNs)
+		orphan := testResourceBody("r-orphan")
+		orphan.Metadata.Namespace = ptr.To(testResourceNs)
+		orphan.Spec.Owner.ProjectName = "missing-project"
+
+		resp, err := h.BatchApplyResources(ctx, gen.BatchApplyResourcesRequestObject{
+			Body: &gen.BatchApplyResourcesRequest{Items: []gen.ResourceInstance{created, updated, orphan}},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.BatchApplyResources200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, gen.BatchResultStatusPartiallySucceeded, typed.Status)
+		assert.Equal(t, 2, typed.Succeeded)
+		assert.Equal(t, 1, typed.Failed)
+		require.Len(t, typed.Items, 3)
+		assert.Equal(t, gen.BatchItemResultOperationCreated, *typed.Items[0].Operation)
+		assert.Equal(t, gen.BatchItemResultOperationUpdated, *typed.Items[1].Operation)
+		assert.Equal(t, 2, typed.Items[2].Index)
+		assert.Equal(t, gen.BatchItemResultStatusFailed, typed.Items[2].Status)
+		require.NotNil(t, typed.Items[2].Error)
+		assert.Equal(t, gen.BADREQUEST, typed.Items[2].Error.Code)
+	})
+
+	t.Run("forbidden items fail", func(t *testing.T) {
+		svc := newResourceService(t, nil, &denyAllPDP{})
+		h := newHandlerWithResourceService(svc)
+
+		body := testResourceBody("r-new")
+		body.Metadata.Namespace = ptr.To(testResourceNs)
+		resp, err := h.BatchApplyResources(ctx, gen.BatchApplyResourcesRequestObject{
+			Body: &gen.BatchApplyResourcesRequest{Items: []gen.ResourceInstance{body}},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.BatchApplyResources200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, gen.BatchResultStatusFailed, typed.Status)
+		assert.Equal(t, gen.FORBIDDEN, typed.Items[0].Error.Code)
+	})
+
+	t.Run("empty batch returns 400", func(t *testing.T) {
+		h := newHandlerWithResourceService(newResourceService(t, nil, &allowAllPDP{}))
+
+		resp, err := h.BatchApplyResources(ctx, gen.BatchApplyResourcesRequestObject{
+			Body: &gen.BatchApplyResourcesRequest{},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.BatchApplyResources400JSONResponse{}, resp)
+	})
+
+	t.Run("oversized batch returns 400", func(t *testing.T) {
+		h := newHandlerWithResourceService(newResourceService(t, nil, &allowAllPDP{}))
+
+		items := make([]gen.ResourceInstance, maxBatchItems+1)
+		resp, err := h.BatchApplyResources(ctx, gen.BatchApplyResourcesRequestObject{
+			Body: &gen.BatchApplyResourcesRequest{Items: items},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.BatchApplyResources400JSONResponse{}, resp)
+	})
+}
+
+// --- BatchDeleteResources Handler ---
+
+func TestBatchDeleteResourcesHandler(t *testing.T) {
+	ctx := testContext()
+
+	t.Run("reports every item", func(t *testing.T) {
+		svc := newResourceService(t, []client.Object{testResourceObj("r-1")}, &allowAllPDP{})
+		h := newHandlerWithResourceService(svc)
+
+		resp, err := h.BatchDeleteResources(ctx, gen.BatchDeleteResourcesRequestObject{
+			Body: &gen.BatchDeleteResourcesRequest{Items: []gen.NamespacedName{
+				{Namespace: testResourceNs, Name: "r-1"},
+				{Namespace: testResourceNs, Name: "nonexistent"},
+			}},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.BatchDeleteResources200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, gen.BatchResultStatusPartiallySucceeded, typed.Status)
+		require.Len(t, typed.Items, 2)
+		assert.Equal(t, gen.BatchItemResultOperationDeleted, *typed.Items[0].Operation)
+		assert.Equal(t, gen.NOTFOUND, typed.Items[1].Error.Code)
+	})
+
+	t.Run("all succeeded", func(t *testing.T) {
+		svc := newResourceService(t, []client.Object{testResourceObj("r-1")}, &allowAllPDP{})
+		h := newHandlerWithResourceService(svc)
+
+		resp, err := h.BatchDeleteResources(ctx, gen.BatchDeleteResourcesRequestObject{
+			Body: &gen.BatchDeleteResourcesRequest{Items: []gen.NamespacedName{{Namespace: testResourceNs, Name: "r-1"}}},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.BatchDeleteResources200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, gen.BatchResultStatusSucceeded, typed.Status)
+		assert.Equal(t, 1, typed.Succeeded)
+	})
+
+	t.Run("nil body returns 400", func(t *testing.T) {
+		h := newHandlerWithResourceService(newResourceService(t, nil, &allowAllPDP{}))
+
+		resp, err := h.BatchDeleteResources(ctx, gen.BatchDeleteResourcesRequestObject{})
+		require.NoError(t, err)
+		assert.IsType(t, gen.BatchDeleteResources400JSONResponse{}, resp)
+	})
+}
+
+// --- DiffResource Handler ---
+
+func TestDiffResourceHandler(t *testing.T) {
+	ctx := testContext()
+
+	t.Run("reports changed fields", func(t *testing.T) {
+		existing := testResourceObj("r-1")
+		existing.Labels = map[string]string{labels.LabelKeyProjectName: testResourceProject}
+		svc := newResourceService(t, []client.Object{existing}, &allowAllPDP{})
+		h := newHandlerWithResourceService(svc)
+
+		body := testResourceBody("r-1")
+		body.Spec.Parameters = &map[string]interface{}{"version": "8.0"}
+		resp, err := h.DiffResource(ctx, gen.DiffResourceRequestObject{
+			NamespaceName: testResourceNs,
+			ResourceName:  "r-1",
+			Body:          &body,
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.DiffResource200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.True(t, typed.Exists)
+		require.Len(t, typed.Changes, 1)
+		assert.Equal(t, "spec.parameters", typed.Changes[0].Path)
+		assert.Equal(t, "add", typed.Changes[0].Operation)
+	})
+
+	t.Run("identical resource has no changes", func(t *testing.T) {
+		existing := testResourceObj("r-1")
+		existing.Labels = map[string]string{labels.LabelKeyProjectName: testResourceProject}
+		svc := newResourceService(t, []client.Object{existing}, &allowAllPDP{})
+		h := newHandlerWithResourceService(svc)
+
+		body := testResourceBody("r-1")
+		resp, err := h.DiffResource(ctx, gen.DiffResourceRequestObject{
+			NamespaceName: testResourceNs,
+			ResourceName:  "r-1",
+			Body:          &body,
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.DiffResource200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Empty(t, typed.Changes)
+	})
+
+	t.Run("missing resource reports additions", func(t *testing.T) {
+		svc := newResourceService(t, nil, &allowAllPDP{})
+		h := newHandlerWithResourceService(svc)
+
+		body := testResourceBody("r-new")
+		resp, err := h.DiffResource(ctx, gen.DiffResourceRequestObject{
+			NamespaceName: testResourceNs,
+			ResourceName:  "r-new",
+			Body:          &body,
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.DiffResource200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.False(t, typed.Exists)
+		require.NotEmpty(t, typed.Changes)
+		for _, c := range typed.Changes {
+			assert.Equal(t, "add", c.Operation, "path %s", c.Path)
+		}
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := newResourceService(t, []client.Object{testResourceObj("r-1")}, &denyAllPDP{})
+		h := newHandlerWithResourceService(svc)
+
+		body := testResourceBody("r-1")
+		resp, err := h.DiffResource(ctx, gen.DiffResourceRequestObject{
+			NamespaceName: testResourceNs,
+			ResourceName:  "r-1",
+			Body:          &body,
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.DiffResource403JSONResponse{}, resp)
+	})
+}
+
+// --- ListResourceK8sResources Handler ---
+
+func TestListResourceK8sResourcesHandler(t *testing.T) {
+	ctx := testContext()
+
+	t.Run("resource without releases has no objects", func(t *testing.T) {
+		svc := newResourceService(t, []client.Object{testResourceObj("r-1")}, &allowAllPDP{})
+		h := newHandlerWithResourceService(svc)
+
+		resp, err := h.ListResourceK8sResources(ctx, gen.ListResourceK8sResourcesRequestObject{
+			NamespaceName: testResourceNs,
+			ResourceName:  "r-1",
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ListResourceK8sResources200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Empty(t, typed.Items)
+	})
+
+	t.Run("not found returns 404", func(t *testing.T) {
+		svc := newResourceService(t, nil, &allowAllPDP{})
+		h := newHandlerWithResourceService(svc)
+
+		resp, err := h.ListResourceK8sResources(ctx, gen.ListResourceK8sResourcesRequestObject{
+			NamespaceName: testResourceNs,
+			ResourceName:  "nonexistent",
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ListResourceK8sResources404JSONResponse{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := newResourceService(t, []client.Object{testResourceObj("r-1")}, &denyAllPDP{})
+		h := newHandlerWithResourceService(svc)
+
+		resp, err := h.ListResourceK8sResources(ctx, gen.ListResourceK8sResourcesRequestObject{
+			NamespaceName: testResourceNs,
+			ResourceName:  "r-1",
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.ListResourceK8sResources403JSONResponse{}, resp)
+	})
+}
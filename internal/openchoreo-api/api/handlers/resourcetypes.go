@@ -20,9 +20,11 @@ func (h *Handler) ListResourceTypes(
 ) (gen.ListResourceTypesResponseObject, error) {
 	h.logger.Debug("ListResourceTypes called", "namespaceName", request.NamespaceName)
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.ResourceTypeService.ListResourceTypes(ctx, request.NamespaceName, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ResourceType], error) {
+		return h.services.ResourceTypeService.ListResourceTypes(ctx, request.NamespaceName, opts)
+	})
 	if err != nil {
 		var validationErr *services.ValidationError
 		if errors.As(err, &validationErr) {
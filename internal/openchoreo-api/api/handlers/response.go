@@ -3,56 +3,74 @@
 
 package handlers
 
-import "github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+import (
+	"net/http"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/problem"
+)
 
 // Error response helpers - create the error response types
 
 func badRequest(message string) gen.BadRequestJSONResponse {
-	return gen.BadRequestJSONResponse{
-		Code:  gen.BADREQUEST,
-		Error: message,
-	}
+	return gen.BadRequestJSONResponse(errorResponse(http.StatusBadRequest, gen.BADREQUEST, message))
 }
 
 func forbidden() gen.ForbiddenJSONResponse {
-	return gen.ForbiddenJSONResponse{
-		Code:  gen.FORBIDDEN,
-		Error: "You do not have permission to perform this operation",
-	}
+	return gen.ForbiddenJSONResponse(errorResponse(http.StatusForbidden, gen.FORBIDDEN,
+		"You do not have permission to perform this operation"))
 }
 
 func notFound(resource string) gen.NotFoundJSONResponse {
-	return gen.NotFoundJSONResponse{
-		Code:  gen.NOTFOUND,
-		Error: resource + " not found",
-	}
+	resp := errorResponse(http.StatusNotFound, gen.NOTFOUND, resource+" not found")
+	resp.Kind = optionalString(resourceKind(resource))
+	return gen.NotFoundJSONResponse(resp)
 }
 
 func conflict(message string) gen.ConflictJSONResponse {
-	return gen.ConflictJSONResponse{
-		Code:  gen.CONFLICT,
-		Error: message,
-	}
+	return gen.ConflictJSONResponse(errorResponse(http.StatusConflict, gen.CONFLICT, message))
 }
 
 func unprocessableContent(message string) gen.UnprocessableContentJSONResponse {
-	return gen.UnprocessableContentJSONResponse{
-		Code:  gen.UNPROCESSABLECONTENT,
-		Error: message,
-	}
+	return gen.UnprocessableContentJSONResponse(
+		errorResponse(http.StatusUnprocessableEntity, gen.UNPROCESSABLECONTENT, message))
 }
 
 func internalError() gen.InternalErrorJSONResponse {
-	return gen.InternalErrorJSONResponse{
-		Code:  gen.INTERNALERROR,
-		Error: "Internal server error",
-	}
+	return gen.InternalErrorJSONResponse(
+		errorResponse(http.StatusInternalServerError, gen.INTERNALERROR, "Internal server error"))
 }
 
 //nolint:unparam // shared helper; message is supplied by callers
 func notImplemented(message string) gen.NotImplementedJSONResponse {
-	return gen.NotImplementedJSONResponse{
-		Code:  gen.NOTIMPLEMENTED,
-		Error: message,
+	return gen.NotImplementedJSONResponse(errorResponse(http.StatusNotImplemented, gen.NOTIMPLEMENTED, message))
+}
+
+// errorResponse returns the problem details of an error. The problem middleware
+// adds the request path as the instance.
+func errorResponse(status int, code gen.ErrorResponseCode, message string) gen.ErrorResponse {
+	p := problem.New(status, string(code), message)
+	return gen.ErrorResponse{
+		Type:   p.Type,
+		Title:  p.Title,
+		Status: p.Status,
+		Detail: optionalString(p.Detail),
+		Code:   code,
+		Error:  p.Error,
+	}
+}
+
+// resourceKind turns the resource name used in messages, such as
+// "Resource type", into a kind, such as "ResourceType".
+func resourceKind(resource string) string {
+	var b strings.Builder
+	for _, word := range strings.Fields(resource) {
+		r, size := utf8.DecodeRuneInString(word)
+		b.WriteRune(unicode.ToUpper(r))
+		b.WriteString(word[size:])
 	}
+	return b.String()
 }
@@ -0,0 +1,195 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	environmentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+	sandboxsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/sandbox"
+)
+
+// ListSandboxes returns a paginated list of the sandboxes of a project.
+func (h *Handler) ListSandboxes(
+	ctx context.Context,
+	request gen.ListSandboxesRequestObject,
+) (gen.ListSandboxesResponseObject, error) {
+	h.logger.Debug("ListSandboxes called", "namespaceName", request.NamespaceName, "projectName", request.ProjectName)
+
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, nil, nil)
+
+	result, err := h.services.SandboxService.ListSandboxes(ctx, request.NamespaceName, request.ProjectName, opts)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.ListSandboxes403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.ListSandboxes400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to list sandboxes", "error", err)
+		return gen.ListSandboxes500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	items := make([]gen.Sandbox, 0, len(result.Items))
+	for i := range result.Items {
+		items = append(items, toGenSandbox(&result.Items[i]))
+	}
+
+	return gen.ListSandboxes200JSONResponse{
+		Items:      items,
+		Pagination: ToPagination(result),
+	}, nil
+}
+
+// CreateSandbox clones the deployed components of a project into a new sandbox.
+func (h *Handler) CreateSandbox(
+	ctx context.Context,
+	request gen.CreateSandboxRequestObject,
+) (gen.CreateSandboxResponseObject, error) {
+	h.logger.Info("CreateSandbox called", "namespaceName", request.NamespaceName, "projectName", request.ProjectName)
+
+	if request.Body == nil {
+		return gen.CreateSandbox400JSONResponse{BadRequestJSONResponse: badRequest("Request body is required")}, nil
+	}
+	if strings.TrimSpace(request.Body.Name) == "" {
+		return gen.CreateSandbox400JSONResponse{BadRequestJSONResponse: badRequest("name is required")}, nil
+	}
+	if strings.TrimSpace(request.Body.SourceEnvironment) == "" {
+		return gen.CreateSandbox400JSONResponse{BadRequestJSONResponse: badRequest("sourceEnvironment is required")}, nil
+	}
+
+	req := &sandboxsvc.CreateSandboxRequest{
+		Name:              request.Body.Name,
+		SourceEnvironment: request.Body.SourceEnvironment,
+		Components:        ptr.Deref(request.Body.Components, nil),
+		Subdomain:         ptr.Deref(request.Body.Subdomain, ""),
+	}
+	if request.Body.Ttl != nil {
+		ttl, err := time.ParseDuration(*request.Body.Ttl)
+		if err != nil {
+			return gen.CreateSandbox400JSONResponse{BadRequestJSONResponse: badRequest("ttl must be a duration such as 4h")}, nil
+		}
+		req.TTL = ttl
+	}
+
+	sb, err := h.services.SandboxService.CreateSandbox(ctx, request.NamespaceName, request.ProjectName, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrForbidden):
+			return gen.CreateSandbox403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, projectsvc.ErrProjectNotFound):
+			return gen.CreateSandbox404JSONResponse{NotFoundJSONResponse: notFound("Project")}, nil
+		case errors.Is(err, environmentsvc.ErrEnvironmentNotFound):
+			return gen.CreateSandbox404JSONResponse{NotFoundJSONResponse: notFound("Environment")}, nil
+		case errors.Is(err, sandboxsvc.ErrSandboxAlreadyExists):
+			return gen.CreateSandbox409JSONResponse{ConflictJSONResponse: conflict("An environment with this name already exists")}, nil
+		case errors.Is(err, sandboxsvc.ErrComponentNotDeployed),
+			errors.Is(err, sandboxsvc.ErrNoComponentsToClone):
+			return gen.CreateSandbox400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		}
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.CreateSandbox400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to create sandbox", "error", err)
+		return gen.CreateSandbox500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.CreateSandbox201JSONResponse(toGenSandbox(sb)), nil
+}
+
+// GetSandbox returns a sandbox of a project.
+func (h *Handler) GetSandbox(
+	ctx context.Context,
+	request gen.GetSandboxRequestObject,
+) (gen.GetSandboxResponseObject, error) {
+	h.logger.Debug("GetSandbox called", "namespaceName", request.NamespaceName,
+		"projectName", request.ProjectName, "sandboxName", request.SandboxName)
+
+	sb, err := h.services.SandboxService.GetSandbox(ctx, request.NamespaceName, request.ProjectName, request.SandboxName)
+	if err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.GetSandbox403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, sandboxsvc.ErrSandboxNotFound) {
+			return gen.GetSandbox404JSONResponse{NotFoundJSONResponse: notFound("Sandbox")}, nil
+		}
+		h.logger.Error("Failed to get sandbox", "error", err)
+		return gen.GetSandbox500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.GetSandbox200JSONResponse(toGenSandbox(sb)), nil
+}
+
+// DeleteSandbox deletes a sandbox ahead of its expiry.
+func (h *Handler) DeleteSandbox(
+	ctx context.Context,
+	request gen.DeleteSandboxRequestObject,
+) (gen.DeleteSandboxResponseObject, error) {
+	h.logger.Info("DeleteSandbox called", "namespaceName", request.NamespaceName,
+		"projectName", request.ProjectName, "sandboxName", request.SandboxName)
+
+	if err := h.services.SandboxService.DeleteSandbox(ctx, request.NamespaceName, request.ProjectName, request.SandboxName); err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			return gen.DeleteSandbox403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if errors.Is(err, sandboxsvc.ErrSandboxNotFound) {
+			return gen.DeleteSandbox404JSONResponse{NotFoundJSONResponse: notFound("Sandbox")}, nil
+		}
+		h.logger.Error("Failed to delete sandbox", "error", err)
+		return gen.DeleteSandbox500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.DeleteSandbox204Response{}, nil
+}
+
+// toGenSandbox converts a sandbox environment and its release bindings to the API model.
+func toGenSandbox(sb *sandboxsvc.Sandbox) gen.Sandbox {
+	env := sb.Environment
+	spec := env.Spec.Sandbox
+	out := gen.Sandbox{
+		Name:       env.Name,
+		Components: make([]gen.SandboxComponent, 0, len(sb.ReleaseBindings)),
+	}
+	if spec != nil {
+		out.ProjectName = spec.Project
+		out.SourceEnvironment = spec.SourceEnvironment
+		out.Subdomain = spec.Subdomain
+		out.Ttl = spec.TTL.Duration.String()
+		out.ExpiresAt = env.CreationTimestamp.Add(spec.TTL.Duration)
+	}
+	if env.Status.ExpiresAt != nil {
+		out.ExpiresAt = env.Status.ExpiresAt.Time
+	}
+	if !env.CreationTimestamp.IsZero() {
+		out.CreatedAt = ptr.To(env.CreationTimestamp.Time)
+	}
+	if env.DeletionTimestamp != nil {
+		out.Deleting = ptr.To(true)
+	}
+
+	for _, rb := range sb.ReleaseBindings {
+		component := gen.SandboxComponent{
+			ComponentName:      rb.Spec.Owner.ComponentName,
+			ReleaseBindingName: rb.Name,
+			ReleaseName:        optionalString(rb.Spec.ReleaseName),
+		}
+		if len(rb.Status.Endpoints) > 0 {
+			endpoints := make([]gen.SandboxEndpoint, 0, len(rb.Status.Endpoints))
+			for _, ep := range rb.Status.Endpoints {
+				endpoints = append(endpoints, gen.SandboxEndpoint{Name: ep.Name, Url: optionalString(ep.InvokeURL)})
+			}
+			component.Endpoints = &endpoints
+		}
+		out.Components = append(out.Components, component)
+	}
+	return out
+}
@@ -0,0 +1,202 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+	sandboxsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/sandbox"
+	sandboxsvcmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/sandbox/mocks"
+)
+
+func newHandlerWithSandboxService(svc sandboxsvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{SandboxService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func testSandbox() *sandboxsvc.Sandbox {
+	created := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	return &sandboxsvc.Sandbox{
+		Environment: &openchoreov1alpha1.Environment{
+			ObjectMeta: metav1.ObjectMeta{Name: "alice", Namespace: "test-ns", CreationTimestamp: metav1.NewTime(created)},
+			Spec: openchoreov1alpha1.EnvironmentSpec{
+				Sandbox: &openchoreov1alpha1.EnvironmentSandboxSpec{
+					TTL:               metav1.Duration{Duration: 4 * time.Hour},
+					Project:           "shop",
+					SourceEnvironment: "development",
+					Subdomain:         "alice",
+				},
+			},
+		},
+		ReleaseBindings: []openchoreov1alpha1.ReleaseBinding{{
+			ObjectMeta: metav1.ObjectMeta{Name: "orders-alice", Namespace: "test-ns"},
+			Spec: openchoreov1alpha1.ReleaseBindingSpec{
+				Owner:       openchoreov1alpha1.ReleaseBindingOwner{ProjectName: "shop", ComponentName: "orders"},
+				Environment: "alice",
+				ReleaseName: "orders-r3",
+			},
+			Status: openchoreov1alpha1.ReleaseBindingStatus{
+				Endpoints: []openchoreov1alpha1.EndpointURLStatus{{Name: "api", InvokeURL: "https://orders-api.alice.apps.example.com"}},
+			},
+		}},
+	}
+}
+
+func TestCreateSandboxHandler(t *testing.T) {
+	ctx := testContext()
+	const ns, project = "test-ns", "shop"
+	body := &gen.CreateSandboxRequest{
+		Name:              "alice",
+		SourceEnvironment: "development",
+		Components:        &[]string{"orders"},
+		Ttl:               ptr.To("4h"),
+	}
+
+	t.Run("nil body returns 400", func(t *testing.T) {
+		h := newHandlerWithSandboxService(sandboxsvcmocks.NewMockService(t))
+		resp, err := h.CreateSandbox(ctx, gen.CreateSandboxRequestObject{NamespaceName: ns, ProjectName: project})
+		require.NoError(t, err)
+		assert.IsType(t, gen.CreateSandbox400JSONResponse{}, resp)
+	})
+
+	t.Run("invalid ttl returns 400", func(t *testing.T) {
+		h := newHandlerWithSandboxService(sandboxsvcmocks.NewMockService(t))
+		resp, err := h.CreateSandbox(ctx, gen.CreateSandboxRequestObject{
+			NamespaceName: ns,
+			ProjectName:   project,
+			Body:          &gen.CreateSandboxRequest{Name: "alice", SourceEnvironment: "development", Ttl: ptr.To("four hours")},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, gen.CreateSandbox400JSONResponse{}, resp)
+	})
+
+	t.Run("success returns created sandbox", func(t *testing.T) {
+		svc := sandboxsvcmocks.NewMockService(t)
+		svc.EXPECT().CreateSandbox(mock.Anything, ns, project, &sandboxsvc.CreateSandboxRequest{
+			Name:              "alice",
+			SourceEnvironment: "development",
+			Components:        []string{"orders"},
+			TTL:               4 * time.Hour,
+		}).Return(testSandbox(), nil)
+
+		resp, err := newHandlerWithSandboxService(svc).CreateSandbox(ctx, gen.CreateSandboxRequestObject{
+			NamespaceName: ns,
+			ProjectName:   project,
+			Body:          body,
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.CreateSandbox201JSONResponse)
+		require.True(t, ok, "expected 201 response, got %T", resp)
+		assert.Equal(t, "alice", typed.Name)
+		assert.Equal(t, "shop", typed.ProjectName)
+		assert.Equal(t, "4h0m0s", typed.Ttl)
+		assert.Equal(t, time.Date(2026, 3, 1, 14, 0, 0, 0, time.UTC), typed.ExpiresAt.UTC())
+		require.Len(t, typed.Components, 1)
+		assert.Equal(t, "orders-alice", typed.Components[0].ReleaseBindingName)
+		require.NotNil(t, typed.Components[0].Endpoints)
+		assert.Equal(t, "https://orders-api.alice.apps.example.com", ptr.Deref((*typed.Components[0].Endpoints)[0].Url, ""))
+	})
+
+	tests := []struct {
+		name     string
+		err      error
+		wantType any
+	}{
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.CreateSandbox403JSONResponse{}},
+		{"project not found -> 404", projectsvc.ErrProjectNotFound, gen.CreateSandbox404JSONResponse{}},
+		{"already exists -> 409", sandboxsvc.ErrSandboxAlreadyExists, gen.CreateSandbox409JSONResponse{}},
+		{"component not deployed -> 400", sandboxsvc.ErrComponentNotDeployed, gen.CreateSandbox400JSONResponse{}},
+		{"validation -> 400", &svcpkg.ValidationError{Msg: "ttl too long"}, gen.CreateSandbox400JSONResponse{}},
+		{"internal -> 500", errors.New("boom"), gen.CreateSandbox500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := sandboxsvcmocks.NewMockService(t)
+			svc.EXPECT().CreateSandbox(mock.Anything, ns, project, mock.Anything).Return(nil, tt.err)
+			resp, err := newHandlerWithSandboxService(svc).CreateSandbox(ctx, gen.CreateSandboxRequestObject{
+				NamespaceName: ns,
+				ProjectName:   project,
+				Body:          body,
+			})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantType, resp)
+		})
+	}
+}
+
+func TestListSandboxesHandler(t *testing.T) {
+	ctx := testContext()
+
+	svc := sandboxsvcmocks.NewMockService(t)
+	svc.EXPECT().ListSandboxes(mock.Anything, "test-ns", "shop", mock.Anything).
+		Return(&svcpkg.ListResult[sandboxsvc.Sandbox]{Items: []sandboxsvc.Sandbox{*testSandbox()}}, nil)
+
+	resp, err := newHandlerWithSandboxService(svc).ListSandboxes(ctx, gen.ListSandboxesRequestObject{NamespaceName: "test-ns", ProjectName: "shop"})
+	require.NoError(t, err)
+	typed, ok := resp.(gen.ListSandboxes200JSONResponse)
+	require.True(t, ok, "expected 200 response, got %T", resp)
+	require.Len(t, typed.Items, 1)
+	assert.Equal(t, "alice", typed.Items[0].Name)
+}
+
+func TestGetSandboxHandler(t *testing.T) {
+	ctx := testContext()
+	req := gen.GetSandboxRequestObject{NamespaceName: "test-ns", ProjectName: "shop", SandboxName: "alice"}
+
+	t.Run("success", func(t *testing.T) {
+		svc := sandboxsvcmocks.NewMockService(t)
+		svc.EXPECT().GetSandbox(mock.Anything, "test-ns", "shop", "alice").Return(testSandbox(), nil)
+		resp, err := newHandlerWithSandboxService(svc).GetSandbox(ctx, req)
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetSandbox200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, "development", typed.SourceEnvironment)
+	})
+
+	t.Run("not found returns 404", func(t *testing.T) {
+		svc := sandboxsvcmocks.NewMockService(t)
+		svc.EXPECT().GetSandbox(mock.Anything, "test-ns", "shop", "alice").Return(nil, sandboxsvc.ErrSandboxNotFound)
+		resp, err := newHandlerWithSandboxService(svc).GetSandbox(ctx, req)
+		require.NoError(t, err)
+		assert.IsType(t, gen.GetSandbox404JSONResponse{}, resp)
+	})
+}
+
+func TestDeleteSandboxHandler(t *testing.T) {
+	ctx := testContext()
+	req := gen.DeleteSandboxRequestObject{NamespaceName: "test-ns", ProjectName: "shop", SandboxName: "alice"}
+
+	t.Run("success returns 204", func(t *testing.T) {
+		svc := sandboxsvcmocks.NewMockService(t)
+		svc.EXPECT().DeleteSandbox(mock.Anything, "test-ns", "shop", "alice").Return(nil)
+		resp, err := newHandlerWithSandboxService(svc).DeleteSandbox(ctx, req)
+		require.NoError(t, err)
+		assert.IsType(t, gen.DeleteSandbox204Response{}, resp)
+	})
+
+	t.Run("forbidden returns 403", func(t *testing.T) {
+		svc := sandboxsvcmocks.NewMockService(t)
+		svc.EXPECT().DeleteSandbox(mock.Anything, "test-ns", "shop", "alice").Return(svcpkg.ErrForbidden)
+		resp, err := newHandlerWithSandboxService(svc).DeleteSandbox(ctx, req)
+		require.NoError(t, err)
+		assert.IsType(t, gen.DeleteSandbox403JSONResponse{}, resp)
+	})
+}
@@ -21,9 +21,11 @@ func (h *Handler) ListSecretReferences(
 ) (gen.ListSecretReferencesResponseObject, error) {
 	h.logger.Debug("ListSecretReferences called", "namespaceName", request.NamespaceName)
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.SecretReferenceService.ListSecretReferences(ctx, request.NamespaceName, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.SecretReference], error) {
+		return h.services.SecretReferenceService.ListSecretReferences(ctx, request.NamespaceName, opts)
+	})
 	if err != nil {
 		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
 			return gen.ListSecretReferences400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
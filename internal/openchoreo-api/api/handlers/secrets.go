@@ -42,9 +42,11 @@ func (h *Handler) ListSecrets(
 	}
 	h.logger.Debug("ListSecrets called", "namespaceName", request.NamespaceName)
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, nil)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, nil, request.Params.Sort)
 
-	result, err := h.services.SecretService.ListSecrets(ctx, request.NamespaceName, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[corev1.Secret], error) {
+		return h.services.SecretService.ListSecrets(ctx, request.NamespaceName, opts)
+	})
 	if err != nil {
 		return mapListSecretsError(h, err)
 	}
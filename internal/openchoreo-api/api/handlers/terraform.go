@@ -0,0 +1,47 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcerrors "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	namespacesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/namespace"
+)
+
+// ExportNamespaceTerraform returns the platform configuration of a namespace as Terraform configuration.
+func (h *Handler) ExportNamespaceTerraform(
+	ctx context.Context,
+	request gen.ExportNamespaceTerraformRequestObject,
+) (gen.ExportNamespaceTerraformResponseObject, error) {
+	h.logger.Debug("ExportNamespaceTerraform called", "namespaceName", request.NamespaceName)
+
+	export, err := h.services.TerraformExportService.ExportNamespace(ctx, request.NamespaceName)
+	if err != nil {
+		switch {
+		case errors.Is(err, svcerrors.ErrForbidden):
+			return gen.ExportNamespaceTerraform403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, namespacesvc.ErrNamespaceNotFound):
+			return gen.ExportNamespaceTerraform404JSONResponse{NotFoundJSONResponse: notFound("Namespace")}, nil
+		}
+		h.logger.Error("Failed to export namespace", "error", err)
+		return gen.ExportNamespaceTerraform500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	content, err := export.Render()
+	if err != nil {
+		h.logger.Error("Failed to render Terraform configuration", "error", err)
+		return gen.ExportNamespaceTerraform500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.ExportNamespaceTerraform200TextResponse{
+		Body: string(content),
+		Headers: gen.ExportNamespaceTerraform200ResponseHeaders{
+			ContentDisposition: fmt.Sprintf("attachment; filename=%q", export.FileName()),
+		},
+	}, nil
+}
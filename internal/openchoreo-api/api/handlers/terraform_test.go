@@ -0,0 +1,78 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	svcerrors "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	namespacesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/namespace"
+	terraformexportsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/terraformexport"
+	terraformexportsvcmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/terraformexport/mocks"
+)
+
+func newHandlerWithTerraformExportService(svc terraformexportsvc.Service) *Handler {
+	return &Handler{
+		services: &handlerservices.Services{TerraformExportService: svc},
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+func TestExportNamespaceTerraformHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+	request := gen.ExportNamespaceTerraformRequestObject{NamespaceName: ns}
+
+	t.Run("success", func(t *testing.T) {
+		svc := terraformexportsvcmocks.NewMockService(t)
+		nsObj := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Namespace",
+			"metadata":   map[string]any{"name": ns},
+		}}
+		svc.EXPECT().ExportNamespace(mock.Anything, ns).Return(&terraformexportsvc.Export{
+			Namespace: ns,
+			Resources: []terraformexportsvc.Resource{{
+				ResourceRef: terraformexportsvc.ResourceRef{Kind: "Namespace", Name: ns},
+				Object:      nsObj,
+			}},
+		}, nil)
+
+		resp, err := newHandlerWithTerraformExportService(svc).ExportNamespaceTerraform(ctx, request)
+		require.NoError(t, err)
+		typed, ok := resp.(gen.ExportNamespaceTerraform200TextResponse)
+		require.True(t, ok, "expected 200, got %T", resp)
+		assert.Equal(t, `attachment; filename="test-ns.tf"`, typed.Headers.ContentDisposition)
+		assert.Contains(t, typed.Body, `resource "kubernetes_manifest" "namespace_test-ns"`)
+	})
+
+	errCases := []struct {
+		name string
+		err  error
+		want any
+	}{
+		{"forbidden", svcerrors.ErrForbidden, gen.ExportNamespaceTerraform403JSONResponse{}},
+		{"namespace not found", namespacesvc.ErrNamespaceNotFound, gen.ExportNamespaceTerraform404JSONResponse{}},
+		{"internal error", assert.AnError, gen.ExportNamespaceTerraform500JSONResponse{}},
+	}
+	for _, tc := range errCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := terraformexportsvcmocks.NewMockService(t)
+			svc.EXPECT().ExportNamespace(mock.Anything, ns).Return(nil, tc.err)
+
+			resp, err := newHandlerWithTerraformExportService(svc).ExportNamespaceTerraform(ctx, request)
+			require.NoError(t, err)
+			assert.IsType(t, tc.want, resp)
+		})
+	}
+}
@@ -21,9 +21,11 @@ func (h *Handler) ListTraits(
 ) (gen.ListTraitsResponseObject, error) {
 	h.logger.Debug("ListTraits called", "namespaceName", request.NamespaceName)
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.TraitService.ListTraits(ctx, request.NamespaceName, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.Trait], error) {
+		return h.services.TraitService.ListTraits(ctx, request.NamespaceName, opts)
+	})
 	if err != nil {
 		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
 			return gen.ListTraits400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
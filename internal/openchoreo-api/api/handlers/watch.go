@@ -0,0 +1,169 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	watchsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/watch"
+)
+
+// Error codes sent in SSE "error" events.
+const (
+	watchErrorTokenExpired = "TOKEN_EXPIRED"
+	watchErrorFailed       = "WATCH_FAILED"
+)
+
+// watchRetryMillis is the reconnect delay suggested to EventSource clients.
+const watchRetryMillis = 5000
+
+// WatchHandler streams changes to OpenChoreo resources as a Server-Sent Events
+// response.
+type WatchHandler struct {
+	service watchsvc.Service
+	logger  *slog.Logger
+}
+
+// NewWatchHandler creates a new watch handler.
+func NewWatchHandler(service watchsvc.Service, logger *slog.Logger) *WatchHandler {
+	return &WatchHandler{
+		service: service,
+		logger:  logger.With("component", "watch-handler"),
+	}
+}
+
+// watchEventData is the data of an SSE event.
+type watchEventData struct {
+	Type      watchsvc.EventType `json:"type"`
+	Kind      string             `json:"kind,omitempty"`
+	Namespace string             `json:"namespace,omitempty"`
+	Name      string             `json:"name,omitempty"`
+	Object    any                `json:"object,omitempty"`
+	Code      string             `json:"code,omitempty"`
+	Message   string             `json:"message,omitempty"`
+}
+
+// ServeHTTP streams watch events for the selected kinds and namespaces.
+// URL: /api/v1/watch?kind=&namespace=&project=&component=&labelSelector=&token=
+//
+// kind and namespace may be repeated or comma-separated. Each event is sent with
+// the SSE event name added, modified, deleted, bookmark or error, and its id is
+// a reconnect token. Clients resume a watch by sending the last id they received
+// in the Last-Event-ID header (as EventSource does) or the token parameter.
+// Bookmark events are sent periodically so that idle watches stay resumable.
+func (h *WatchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := &watchsvc.Request{
+		Kinds:         splitQueryValues(query["kind"]),
+		Namespaces:    splitQueryValues(query["namespace"]),
+		Project:       query.Get("project"),
+		Component:     query.Get("component"),
+		LabelSelector: query.Get("labelSelector"),
+		Token:         r.Header.Get("Last-Event-ID"),
+	}
+	if req.Token == "" {
+		req.Token = query.Get("token")
+	}
+
+	ctx := r.Context()
+	events, err := h.service.Watch(ctx, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, watchsvc.ErrInvalidRequest), errors.Is(err, watchsvc.ErrInvalidToken):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case errors.Is(err, svcpkg.ErrForbidden):
+			http.Error(w, "you do not have permission to watch these namespaces", http.StatusForbidden)
+		default:
+			h.logger.Error("Failed to start watch", "error", err)
+			http.Error(w, "failed to start watch", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		h.logger.Error("ResponseWriter does not support flushing; cannot stream SSE")
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	// Clear the server's WriteTimeout for this long-lived stream only.
+	if err := http.NewResponseController(w).SetWriteDeadline(time.Time{}); err != nil {
+		h.logger.Warn("Failed to disable write deadline for SSE stream", "error", err)
+	}
+
+	hdr := w.Header()
+	hdr.Set("Content-Type", "text/event-stream")
+	hdr.Set("Cache-Control", "no-cache, no-transform")
+	hdr.Set("Connection", "keep-alive")
+	hdr.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", watchRetryMillis); err != nil {
+		return
+	}
+	flusher.Flush()
+
+	h.logger.Debug("Watch stream started", "kinds", req.Kinds, "namespaces", req.Namespaces)
+	for ev := range events {
+		if ev.Type == watchsvc.EventError && !errors.Is(ev.Err, watchsvc.ErrTokenExpired) {
+			h.logger.Error("Watch failed", "error", ev.Err)
+		}
+		if err := writeWatchEvent(w, ev); err != nil {
+			h.logger.Debug("Watch stream ended with error", "error", err)
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// writeWatchEvent writes ev as an SSE event.
+func writeWatchEvent(w http.ResponseWriter, ev watchsvc.Event) error {
+	data := watchEventData{Type: ev.Type}
+	switch {
+	case ev.Object != nil:
+		data.Kind = ev.Kind
+		data.Namespace = ev.Object.GetNamespace()
+		data.Name = ev.Object.GetName()
+		data.Object = ev.Object
+	case ev.Type == watchsvc.EventError:
+		data.Code = watchErrorFailed
+		data.Message = "watch failed"
+		if errors.Is(ev.Err, watchsvc.ErrTokenExpired) {
+			data.Code = watchErrorTokenExpired
+			data.Message = "watch token expired; reconnect without a token"
+		}
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	var b strings.Builder
+	if ev.Token != "" {
+		fmt.Fprintf(&b, "id: %s\n", ev.Token)
+	}
+	fmt.Fprintf(&b, "event: %s\ndata: %s\n\n", strings.ToLower(string(ev.Type)), payload)
+	_, err = io.WriteString(w, b.String())
+	return err
+}
+
+// splitQueryValues flattens repeated and comma-separated query values.
+func splitQueryValues(values []string) []string {
+	var out []string
+	for _, v := range values {
+		for _, part := range strings.Split(v, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				out = append(out, part)
+			}
+		}
+	}
+	return out
+}
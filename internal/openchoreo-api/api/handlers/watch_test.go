@@ -0,0 +1,106 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	watchsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/watch"
+)
+
+// stubWatchService records the request and returns fixed events or an error.
+type stubWatchService struct {
+	req    *watchsvc.Request
+	events []watchsvc.Event
+	err    error
+}
+
+func (s *stubWatchService) Watch(_ context.Context, req *watchsvc.Request) (<-chan watchsvc.Event, error) {
+	s.req = req
+	if s.err != nil {
+		return nil, s.err
+	}
+	ch := make(chan watchsvc.Event, len(s.events))
+	for _, ev := range s.events {
+		ch <- ev
+	}
+	close(ch)
+	return ch, nil
+}
+
+func newTestWatchHandler(svc watchsvc.Service) *WatchHandler {
+	return NewWatchHandler(svc, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestWatchHandler_StreamsEvents(t *testing.T) {
+	comp := &openchoreov1alpha1.Component{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "ns-1"}}
+	svc := &stubWatchService{events: []watchsvc.Event{
+		{Type: watchsvc.EventAdded, Kind: "Component", Object: comp, Token: "tok-1"},
+		{Type: watchsvc.EventBookmark, Token: "tok-2"},
+		{Type: watchsvc.EventError, Err: fmt.Errorf("%w: too old", watchsvc.ErrTokenExpired)},
+	}}
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/api/v1/watch?kind=Component,ReleaseBinding&kind=WorkflowRun&namespace=ns-1&project=proj&token=ignored", nil)
+	req.Header.Set("Last-Event-ID", "tok-0")
+	rec := httptest.NewRecorder()
+	newTestWatchHandler(svc).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+	assert.Equal(t, &watchsvc.Request{
+		Kinds:      []string{"Component", "ReleaseBinding", "WorkflowRun"},
+		Namespaces: []string{"ns-1"},
+		Project:    "proj",
+		Token:      "tok-0",
+	}, svc.req)
+
+	body := rec.Body.String()
+	assert.Contains(t, body, "retry: 5000\n\n")
+	assert.Contains(t, body, "id: tok-1\nevent: added\ndata: {\"type\":\"ADDED\",\"kind\":\"Component\",\"namespace\":\"ns-1\",\"name\":\"web\",\"object\":{")
+	assert.Contains(t, body, "id: tok-2\nevent: bookmark\ndata: {\"type\":\"BOOKMARK\"}\n\n")
+	assert.Contains(t, body, "event: error\ndata: {\"type\":\"ERROR\",\"code\":\"TOKEN_EXPIRED\"")
+}
+
+func TestWatchHandler_TokenQueryParameter(t *testing.T) {
+	svc := &stubWatchService{}
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/watch?kind=Component&namespace=ns-1&token=tok-9", nil)
+	newTestWatchHandler(svc).ServeHTTP(httptest.NewRecorder(), req)
+
+	require.NotNil(t, svc.req)
+	assert.Equal(t, "tok-9", svc.req.Token)
+}
+
+func TestWatchHandler_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"invalid request", fmt.Errorf("%w: unsupported kind", watchsvc.ErrInvalidRequest), http.StatusBadRequest},
+		{"invalid token", watchsvc.ErrInvalidToken, http.StatusBadRequest},
+		{"forbidden", svcpkg.ErrForbidden, http.StatusForbidden},
+		{"internal", fmt.Errorf("boom"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/watch?kind=Component&namespace=ns-1", nil)
+			rec := httptest.NewRecorder()
+			newTestWatchHandler(&stubWatchService{err: tt.err}).ServeHTTP(rec, req)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"io"
 	"net/http"
 
+	"k8s.io/utils/ptr"
+
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
 	autobuildsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/autobuild"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/git"
@@ -62,6 +64,18 @@ func detectGitProviderFromParams(params gen.HandleAutoBuildParams) (git.Provider
 	}
 }
 
+// eventTypeFromParams extracts the event type header of the git provider from the parsed header parameters.
+func eventTypeFromParams(params gen.HandleAutoBuildParams, providerType git.ProviderType) string {
+	switch providerType {
+	case git.ProviderGitHub:
+		return ptr.Deref(params.XGitHubEvent, "")
+	case git.ProviderGitLab:
+		return ptr.Deref(params.XGitlabEvent, "")
+	default:
+		return ""
+	}
+}
+
 // signatureFromParams extracts the signature value from the parsed header parameters.
 func signatureFromParams(params gen.HandleAutoBuildParams, signatureHeader string) string {
 	switch signatureHeader {
@@ -110,6 +124,7 @@ func (h *Handler) HandleAutoBuild(
 		SignatureHeader: signatureHeader,
 		Signature:       signatureFromParams(request.Params, signatureHeader),
 		SecretKey:       secretKey,
+		EventType:       eventTypeFromParams(request.Params, providerType),
 		Payload:         rawBody,
 	})
 	if err != nil {
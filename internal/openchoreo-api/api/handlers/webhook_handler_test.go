@@ -170,6 +170,7 @@ func TestSignatureFromParams(t *testing.T) {
 func TestHandleAutoBuild(t *testing.T) {
 	rawPayload := []byte(`{"ref":"refs/heads/main","after":"abc123","repository":{"clone_url":"https://github.com/test/repo.git"},"commits":[]}`)
 	sig := "sha256=validhmac"
+	pullRequestEvent := "pull_request"
 	ctxWithBody := context.WithValue(context.Background(), webhookRawBodyKey{}, rawPayload)
 
 	tests := []struct {
@@ -186,6 +187,7 @@ func TestHandleAutoBuild(t *testing.T) {
 		wantProvider  git.ProviderType
 		wantSigHeader string
 		wantSignature string
+		wantEventType string
 	}{
 		{
 			name:           "no provider header returns 400",
@@ -235,6 +237,19 @@ func TestHandleAutoBuild(t *testing.T) {
 			wantSigHeader:  "X-Hub-Signature-256",
 			wantSignature:  sig,
 		},
+		{
+			name:           "pull request event type is forwarded",
+			ctx:            ctxWithBody,
+			params:         gen.HandleAutoBuildParams{XHubSignature256: &sig, XGitHubEvent: &pullRequestEvent},
+			svcResult:      &autobuildsvc.WebhookResult{AffectedComponents: []string{"comp-a"}},
+			wantStatusCode: 200,
+			wantSuccess:    true,
+			wantTriggered:  1,
+			wantProvider:   git.ProviderGitHub,
+			wantSigHeader:  "X-Hub-Signature-256",
+			wantSignature:  sig,
+			wantEventType:  pullRequestEvent,
+		},
 	}
 
 	for _, tt := range tests {
@@ -292,6 +307,9 @@ func TestHandleAutoBuild(t *testing.T) {
 					if mock.capturedParams.Signature != tt.wantSignature {
 						t.Errorf("Signature = %q, want %q", mock.capturedParams.Signature, tt.wantSignature)
 					}
+					if mock.capturedParams.EventType != tt.wantEventType {
+						t.Errorf("EventType = %q, want %q", mock.capturedParams.EventType, tt.wantEventType)
+					}
 					if !bytes.Equal(mock.capturedParams.Payload, rawPayload) {
 						t.Errorf("Payload = %q, want %q", mock.capturedParams.Payload, rawPayload)
 					}
@@ -0,0 +1,152 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	workflowrunsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowrun"
+)
+
+// Error code sent in the SSE "error" event when log streaming fails mid-stream.
+const workflowRunLogsErrorFailed = "LOGS_FAILED"
+
+// WorkflowRunLogsHandler streams the step logs of a workflow run from its workflow
+// plane as a Server-Sent Events response.
+type WorkflowRunLogsHandler struct {
+	service workflowrunsvc.Service
+	logger  *slog.Logger
+}
+
+// NewWorkflowRunLogsHandler creates a new workflow run log streaming handler.
+func NewWorkflowRunLogsHandler(service workflowrunsvc.Service, logger *slog.Logger) *WorkflowRunLogsHandler {
+	return &WorkflowRunLogsHandler{
+		service: service,
+		logger:  logger.With("component", "workflowrun-logs-handler"),
+	}
+}
+
+// workflowRunLogEventData is the data of a "log" or "error" SSE event.
+type workflowRunLogEventData struct {
+	Task      string `json:"task,omitempty"`
+	Timestamp string `json:"timestamp,omitempty"`
+	Log       string `json:"log,omitempty"`
+	Code      string `json:"code,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
+
+// ServeHTTP follows the logs of a workflow run.
+// URL: /api/v1/namespaces/{namespace}/workflowruns/{runName}/logs/stream?task=&sinceSeconds=
+//
+// Each log line is sent as a "log" event naming the task that wrote it. Steps are
+// followed one after another in the order they started, and an "end" event is sent
+// once the followed steps have finished. Errors found before the first line are
+// returned as plain HTTP errors; later failures are sent as an "error" event.
+func (h *WorkflowRunLogsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	namespace := r.PathValue("namespace")
+	runName := r.PathValue("runName")
+	query := r.URL.Query()
+	taskName := query.Get("task")
+
+	var sinceSeconds *int64
+	if v := query.Get("sinceSeconds"); v != "" {
+		seconds, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || seconds < 0 {
+			http.Error(w, "sinceSeconds must be a non-negative integer", http.StatusBadRequest)
+			return
+		}
+		sinceSeconds = &seconds
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		h.logger.Error("ResponseWriter does not support flushing; cannot stream SSE")
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	logger := h.logger.With("namespace", namespace, "run", runName, "task", taskName)
+	started := false
+	startStream := func() {
+		if started {
+			return
+		}
+		started = true
+		// Clear the server's WriteTimeout for this long-lived stream only.
+		if err := http.NewResponseController(w).SetWriteDeadline(time.Time{}); err != nil {
+			logger.Warn("Failed to disable write deadline for SSE stream", "error", err)
+		}
+		hdr := w.Header()
+		hdr.Set("Content-Type", "text/event-stream")
+		hdr.Set("Cache-Control", "no-cache, no-transform")
+		hdr.Set("Connection", "keep-alive")
+		hdr.Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+	}
+
+	err := h.service.StreamWorkflowRunLogs(r.Context(), namespace, runName, taskName, sinceSeconds,
+		func(entry models.WorkflowRunLogEntry) error {
+			startStream()
+			if err := writeWorkflowRunLogEvent(w, "log", workflowRunLogEventData{
+				Task:      entry.Task,
+				Timestamp: entry.Timestamp,
+				Log:       entry.Log,
+			}); err != nil {
+				return err
+			}
+			flusher.Flush()
+			return nil
+		})
+
+	if err != nil && !started {
+		switch {
+		case errors.Is(err, workflowrunsvc.ErrWorkflowRunNotFound),
+			errors.Is(err, workflowrunsvc.ErrWorkflowRunReferenceNotFound):
+			http.Error(w, "workflow run not found", http.StatusNotFound)
+		case errors.Is(err, svcpkg.ErrForbidden):
+			http.Error(w, "you do not have permission to view this workflow run", http.StatusForbidden)
+		default:
+			logger.Error("Failed to stream workflow run logs", "error", err)
+			http.Error(w, "failed to stream workflow run logs", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	startStream()
+	if err != nil {
+		if r.Context().Err() != nil {
+			logger.Debug("Workflow run log stream closed by client")
+			return
+		}
+		logger.Error("Workflow run log stream failed", "error", err)
+		_ = writeWorkflowRunLogEvent(w, "error", workflowRunLogEventData{
+			Code:    workflowRunLogsErrorFailed,
+			Message: "failed to stream workflow run logs",
+		})
+		flusher.Flush()
+		return
+	}
+	if err := writeWorkflowRunLogEvent(w, "end", workflowRunLogEventData{}); err == nil {
+		flusher.Flush()
+	}
+}
+
+// writeWorkflowRunLogEvent writes data as an SSE event with the given name.
+func writeWorkflowRunLogEvent(w io.Writer, event string, data workflowRunLogEventData) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+	return err
+}
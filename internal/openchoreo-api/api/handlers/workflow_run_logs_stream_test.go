@@ -0,0 +1,116 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	workflowrunsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowrun"
+	workflowrunmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowrun/mocks"
+)
+
+// serveWorkflowRunLogs routes target through the stream route so path values are set.
+func serveWorkflowRunLogs(svc workflowrunsvc.Service, target string) *httptest.ResponseRecorder {
+	mux := http.NewServeMux()
+	mux.Handle("GET /api/v1/namespaces/{namespace}/workflowruns/{runName}/logs/stream",
+		NewWorkflowRunLogsHandler(svc, slog.New(slog.NewTextHandler(io.Discard, nil))))
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	return rec
+}
+
+// streamLogs returns a StreamWorkflowRunLogs implementation that sends entries and then returns err.
+func streamLogs(entries []models.WorkflowRunLogEntry, err error) func(context.Context, string, string, string, *int64,
+	func(models.WorkflowRunLogEntry) error) error {
+	return func(_ context.Context, _, _, _ string, _ *int64, send func(models.WorkflowRunLogEntry) error) error {
+		for _, entry := range entries {
+			if sendErr := send(entry); sendErr != nil {
+				return sendErr
+			}
+		}
+		return err
+	}
+}
+
+func TestWorkflowRunLogsHandler_StreamsLogs(t *testing.T) {
+	since := int64(60)
+	svc := workflowrunmocks.NewMockService(t)
+	svc.EXPECT().StreamWorkflowRunLogs(mock.Anything, "ns-1", "run-1", "build", &since, mock.Anything).
+		RunAndReturn(streamLogs([]models.WorkflowRunLogEntry{
+			{Task: "build", Timestamp: "2026-01-02T03:04:05Z", Log: "compiling"},
+			{Task: "build", Log: "done"},
+		}, nil))
+
+	rec := serveWorkflowRunLogs(svc, "/api/v1/namespaces/ns-1/workflowruns/run-1/logs/stream?task=build&sinceSeconds=60")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "event: log\ndata: {\"task\":\"build\",\"timestamp\":\"2026-01-02T03:04:05Z\",\"log\":\"compiling\"}\n\n"+
+		"event: log\ndata: {\"task\":\"build\",\"log\":\"done\"}\n\n"+
+		"event: end\ndata: {}\n\n", rec.Body.String())
+}
+
+func TestWorkflowRunLogsHandler_FailureAfterFirstLine(t *testing.T) {
+	svc := workflowrunmocks.NewMockService(t)
+	svc.EXPECT().StreamWorkflowRunLogs(mock.Anything, "ns-1", "run-1", "", (*int64)(nil), mock.Anything).
+		RunAndReturn(streamLogs([]models.WorkflowRunLogEntry{{Task: "build", Log: "compiling"}}, errors.New("gateway closed")))
+
+	rec := serveWorkflowRunLogs(svc, "/api/v1/namespaces/ns-1/workflowruns/run-1/logs/stream")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "event: error\ndata: {\"code\":\"LOGS_FAILED\"")
+	assert.NotContains(t, rec.Body.String(), "event: end")
+}
+
+func TestWorkflowRunLogsHandler_NoLinesEndsStream(t *testing.T) {
+	svc := workflowrunmocks.NewMockService(t)
+	svc.EXPECT().StreamWorkflowRunLogs(mock.Anything, "ns-1", "run-1", "", (*int64)(nil), mock.Anything).
+		RunAndReturn(streamLogs(nil, nil))
+
+	rec := serveWorkflowRunLogs(svc, "/api/v1/namespaces/ns-1/workflowruns/run-1/logs/stream")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "event: end\ndata: {}\n\n", rec.Body.String())
+}
+
+func TestWorkflowRunLogsHandler_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"not found", workflowrunsvc.ErrWorkflowRunNotFound, http.StatusNotFound},
+		{"not started", workflowrunsvc.ErrWorkflowRunReferenceNotFound, http.StatusNotFound},
+		{"forbidden", svcpkg.ErrForbidden, http.StatusForbidden},
+		{"internal", errors.New("boom"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := workflowrunmocks.NewMockService(t)
+			svc.EXPECT().StreamWorkflowRunLogs(mock.Anything, "ns-1", "run-1", "", (*int64)(nil), mock.Anything).
+				Return(tt.err)
+
+			rec := serveWorkflowRunLogs(svc, "/api/v1/namespaces/ns-1/workflowruns/run-1/logs/stream")
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+
+	t.Run("invalid sinceSeconds", func(t *testing.T) {
+		rec := serveWorkflowRunLogs(workflowrunmocks.NewMockService(t),
+			"/api/v1/namespaces/ns-1/workflowruns/run-1/logs/stream?sinceSeconds=-5")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -21,10 +21,15 @@ func (h *Handler) ListWorkflowPlanes(
 ) (gen.ListWorkflowPlanesResponseObject, error) {
 	h.logger.Debug("ListWorkflowPlanes called", "namespaceName", request.NamespaceName)
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.WorkflowPlaneService.ListWorkflowPlanes(ctx, request.NamespaceName, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.WorkflowPlane], error) {
+		return h.services.WorkflowPlaneService.ListWorkflowPlanes(ctx, request.NamespaceName, opts)
+	})
 	if err != nil {
+		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+			return gen.ListWorkflowPlanes400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
 		h.logger.Error("Failed to list workflow planes", "error", err)
 		return gen.ListWorkflowPlanes500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
 	}
@@ -11,7 +11,9 @@ import (
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	svcerrors "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
 	workflowsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflow"
 	workflowrunsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowrun"
 )
@@ -23,9 +25,11 @@ func (h *Handler) ListWorkflows(
 ) (gen.ListWorkflowsResponseObject, error) {
 	h.logger.Debug("ListWorkflows called", "namespaceName", request.NamespaceName)
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.WorkflowService.ListWorkflows(ctx, request.NamespaceName, opts)
+	result, err := svcerrors.SortedList(ctx, opts, func(ctx context.Context, opts svcerrors.ListOptions) (*svcerrors.ListResult[openchoreov1alpha1.Workflow], error) {
+		return h.services.WorkflowService.ListWorkflows(ctx, request.NamespaceName, opts)
+	})
 	if err != nil {
 		if validationErr, ok := errors.AsType[*svcerrors.ValidationError](err); ok {
 			return gen.ListWorkflows400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
@@ -222,9 +226,11 @@ func (h *Handler) ListWorkflowRuns(
 		workflowName = *request.Params.Workflow
 	}
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.WorkflowRunService.ListWorkflowRuns(ctx, request.NamespaceName, "", "", workflowName, opts)
+	result, err := svcerrors.SortedList(ctx, opts, func(ctx context.Context, opts svcerrors.ListOptions) (*svcerrors.ListResult[openchoreov1alpha1.WorkflowRun], error) {
+		return h.services.WorkflowRunService.ListWorkflowRuns(ctx, request.NamespaceName, "", "", workflowName, opts)
+	})
 	if err != nil {
 		if errors.Is(err, svcerrors.ErrForbidden) {
 			return gen.ListWorkflowRuns403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
@@ -244,7 +250,7 @@ func (h *Handler) ListWorkflowRuns(
 
 	return gen.ListWorkflowRuns200JSONResponse(gen.WorkflowRunList{
 		Items:      items,
-		Pagination: ToPagination(result),
+		Pagination: ToPagination(result.WithFilter("workflow", workflowName)),
 	}), nil
 }
 
@@ -430,13 +436,154 @@ func (h *Handler) GetWorkflowRunStatus(
 		steps = append(steps, step)
 	}
 
+	var cache *gen.BuildCacheStatistics
+	if status.Cache != nil {
+		cache = &gen.BuildCacheStatistics{
+			Hits:     status.Cache.Hits,
+			Misses:   status.Cache.Misses,
+			HitRatio: status.Cache.HitRatio,
+		}
+	}
+
 	return gen.GetWorkflowRunStatus200JSONResponse{
 		Status:               gen.WorkflowRunStatusResponseStatus(status.Status),
 		Steps:                steps,
 		HasLiveObservability: status.HasLiveObservability,
+		Image:                optionalString(status.Image),
+		Cache:                cache,
 	}, nil
 }
 
+// GetWorkflowRunVulnerabilities returns the vulnerability scan result of the image built by a workflow run
+func (h *Handler) GetWorkflowRunVulnerabilities(
+	ctx context.Context,
+	request gen.GetWorkflowRunVulnerabilitiesRequestObject,
+) (gen.GetWorkflowRunVulnerabilitiesResponseObject, error) {
+	h.logger.Info("GetWorkflowRunVulnerabilities called",
+		"namespace", request.NamespaceName,
+		"runName", request.RunName)
+
+	severity := ""
+	if request.Params.Severity != nil {
+		severity = string(*request.Params.Severity)
+	}
+
+	result, err := h.services.WorkflowRunService.GetWorkflowRunVulnerabilities(ctx, request.NamespaceName, request.RunName, severity)
+	if err != nil {
+		if errors.Is(err, workflowrunsvc.ErrWorkflowRunNotFound) {
+			return gen.GetWorkflowRunVulnerabilities404JSONResponse{NotFoundJSONResponse: notFound("WorkflowRun")}, nil
+		}
+		if errors.Is(err, workflowrunsvc.ErrScanResultNotFound) {
+			return gen.GetWorkflowRunVulnerabilities404JSONResponse{NotFoundJSONResponse: notFound("vulnerability scan result")}, nil
+		}
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.GetWorkflowRunVulnerabilities403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		if validationErr, ok := errors.AsType[*svcerrors.ValidationError](err); ok {
+			return gen.GetWorkflowRunVulnerabilities400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
+		}
+		h.logger.Error("Failed to get workflow run vulnerabilities", "error", err)
+		return gen.GetWorkflowRunVulnerabilities500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	vulnerabilities := make([]gen.Vulnerability, 0, len(result.Vulnerabilities))
+	for _, v := range result.Vulnerabilities {
+		vulnerabilities = append(vulnerabilities, gen.Vulnerability{
+			Id:               v.ID,
+			Severity:         gen.VulnerabilitySeverity(v.Severity),
+			Package:          v.Package,
+			InstalledVersion: optionalString(v.InstalledVersion),
+			FixedVersion:     optionalString(v.FixedVersion),
+			Title:            optionalString(v.Title),
+		})
+	}
+
+	return gen.GetWorkflowRunVulnerabilities200JSONResponse{
+		Image:     optionalString(result.Image),
+		Scanner:   gen.WorkflowRunVulnerabilitiesResponseScanner(result.Scanner),
+		ScannedAt: result.ScannedAt,
+		Summary: gen.VulnerabilitySummary{
+			Critical: result.Summary.Critical,
+			High:     result.Summary.High,
+			Medium:   result.Summary.Medium,
+			Low:      result.Summary.Low,
+			Unknown:  result.Summary.Unknown,
+		},
+		Vulnerabilities: vulnerabilities,
+		Truncated:       result.Truncated,
+	}, nil
+}
+
+// GetWorkflowRunSBOM returns where the SBOM of the image built by a workflow run is stored.
+func (h *Handler) GetWorkflowRunSBOM(
+	ctx context.Context,
+	request gen.GetWorkflowRunSBOMRequestObject,
+) (gen.GetWorkflowRunSBOMResponseObject, error) {
+	h.logger.Info("GetWorkflowRunSBOM called",
+		"namespace", request.NamespaceName,
+		"runName", request.RunName)
+
+	result, err := h.services.WorkflowRunService.GetWorkflowRunSBOM(ctx, request.NamespaceName, request.RunName)
+	if err != nil {
+		if errors.Is(err, workflowrunsvc.ErrWorkflowRunNotFound) {
+			return gen.GetWorkflowRunSBOM404JSONResponse{NotFoundJSONResponse: notFound("WorkflowRun")}, nil
+		}
+		if errors.Is(err, workflowrunsvc.ErrSBOMNotFound) {
+			return gen.GetWorkflowRunSBOM404JSONResponse{NotFoundJSONResponse: notFound("SBOM")}, nil
+		}
+		if errors.Is(err, svcerrors.ErrForbidden) {
+			return gen.GetWorkflowRunSBOM403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		}
+		h.logger.Error("Failed to get workflow run SBOM", "error", err)
+		return gen.GetWorkflowRunSBOM500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.GetWorkflowRunSBOM200JSONResponse(toGenWorkflowRunSBOM(result)), nil
+}
+
+// GetDeployedSBOM returns the SBOM of the image a component runs in an environment.
+func (h *Handler) GetDeployedSBOM(
+	ctx context.Context,
+	request gen.GetDeployedSBOMRequestObject,
+) (gen.GetDeployedSBOMResponseObject, error) {
+	h.logger.Info("GetDeployedSBOM called",
+		"namespace", request.NamespaceName,
+		"component", request.ComponentName,
+		"environment", request.EnvName)
+
+	result, err := h.services.WorkflowRunService.GetDeployedSBOM(ctx, request.NamespaceName, request.ComponentName, request.EnvName)
+	if err != nil {
+		switch {
+		case errors.Is(err, svcerrors.ErrForbidden):
+			return gen.GetDeployedSBOM403JSONResponse{ForbiddenJSONResponse: forbidden()}, nil
+		case errors.Is(err, componentsvc.ErrComponentNotFound):
+			return gen.GetDeployedSBOM404JSONResponse{NotFoundJSONResponse: notFound("Component")}, nil
+		case errors.Is(err, workflowrunsvc.ErrComponentNotDeployed):
+			return gen.GetDeployedSBOM404JSONResponse{NotFoundJSONResponse: notFound("Deployment")}, nil
+		case errors.Is(err, workflowrunsvc.ErrSBOMNotFound):
+			return gen.GetDeployedSBOM404JSONResponse{NotFoundJSONResponse: notFound("SBOM")}, nil
+		}
+		h.logger.Error("Failed to get deployed SBOM", "error", err)
+		return gen.GetDeployedSBOM500JSONResponse{InternalErrorJSONResponse: internalError()}, nil
+	}
+
+	return gen.GetDeployedSBOM200JSONResponse(toGenWorkflowRunSBOM(result)), nil
+}
+
+func toGenWorkflowRunSBOM(result *models.WorkflowRunSBOMResponse) gen.WorkflowRunSBOMResponse {
+	resp := gen.WorkflowRunSBOMResponse{
+		RunName:    result.RunName,
+		Image:      optionalString(result.Image),
+		Location:   result.Location,
+		RecordedAt: result.RecordedAt,
+	}
+	if result.Format != "" {
+		format := gen.WorkflowRunSBOMResponseFormat(result.Format)
+		resp.Format = &format
+	}
+	return resp
+}
+
 // GetWorkflowRunLogs returns logs for a specific workflow run
 func (h *Handler) GetWorkflowRunLogs(
 	ctx context.Context,
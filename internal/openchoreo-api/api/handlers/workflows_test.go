@@ -27,6 +27,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/config"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	svcpkg "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
 	workflowsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflow"
 	workflowmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflow/mocks"
@@ -788,6 +789,167 @@ func TestDeleteWorkflowRunHandler_InternalError(t *testing.T) {
 	assert.IsType(t, gen.DeleteWorkflowRun500JSONResponse{}, resp)
 }
 
+// --- GetWorkflowRunVulnerabilities Handler ---
+
+func TestGetWorkflowRunVulnerabilitiesHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	newHandler := func(svc *workflowrunmocks.MockService) *Handler {
+		return &Handler{
+			services: &handlerservices.Services{WorkflowRunService: svc},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		svc := workflowrunmocks.NewMockService(t)
+		svc.EXPECT().GetWorkflowRunVulnerabilities(mock.Anything, ns, "run-1", "Critical").Return(&models.WorkflowRunVulnerabilitiesResponse{
+			Image:   "registry.local/api@sha256:abc",
+			Scanner: "trivy",
+			Summary: openchoreov1alpha1.VulnerabilitySummary{Critical: 1, High: 4},
+			Vulnerabilities: []openchoreov1alpha1.Vulnerability{
+				{ID: "CVE-1", Severity: openchoreov1alpha1.VulnerabilitySeverityCritical, Package: "openssl", FixedVersion: "3.0.13"},
+			},
+		}, nil)
+		severity := gen.GetWorkflowRunVulnerabilitiesParamsSeverity("Critical")
+		resp, err := newHandler(svc).GetWorkflowRunVulnerabilities(ctx, gen.GetWorkflowRunVulnerabilitiesRequestObject{
+			NamespaceName: ns, RunName: "run-1", Params: gen.GetWorkflowRunVulnerabilitiesParams{Severity: &severity},
+		})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetWorkflowRunVulnerabilities200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, gen.WorkflowRunVulnerabilitiesResponseScanner("trivy"), typed.Scanner)
+		assert.Equal(t, int32(4), typed.Summary.High)
+		require.Len(t, typed.Vulnerabilities, 1)
+		assert.Equal(t, gen.VulnerabilitySeverity("Critical"), typed.Vulnerabilities[0].Severity)
+		require.NotNil(t, typed.Vulnerabilities[0].FixedVersion)
+		assert.Nil(t, typed.Vulnerabilities[0].InstalledVersion)
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"run not found -> 404", workflowrunsvc.ErrWorkflowRunNotFound, gen.GetWorkflowRunVulnerabilities404JSONResponse{}},
+		{"no scan -> 404", workflowrunsvc.ErrScanResultNotFound, gen.GetWorkflowRunVulnerabilities404JSONResponse{}},
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.GetWorkflowRunVulnerabilities403JSONResponse{}},
+		{"validation -> 400", &svcpkg.ValidationError{Msg: "invalid severity"}, gen.GetWorkflowRunVulnerabilities400JSONResponse{}},
+		{"internal -> 500", errors.New("internal server error"), gen.GetWorkflowRunVulnerabilities500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := workflowrunmocks.NewMockService(t)
+			svc.EXPECT().GetWorkflowRunVulnerabilities(mock.Anything, ns, "run-1", "").Return(nil, tt.svcErr)
+			resp, err := newHandler(svc).GetWorkflowRunVulnerabilities(ctx, gen.GetWorkflowRunVulnerabilitiesRequestObject{NamespaceName: ns, RunName: "run-1"})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
+// --- GetWorkflowRunSBOM / GetDeployedSBOM Handlers ---
+
+func TestGetWorkflowRunSBOMHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	newHandler := func(svc *workflowrunmocks.MockService) *Handler {
+		return &Handler{
+			services: &handlerservices.Services{WorkflowRunService: svc},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		svc := workflowrunmocks.NewMockService(t)
+		svc.EXPECT().GetWorkflowRunSBOM(mock.Anything, ns, "run-1").Return(&models.WorkflowRunSBOMResponse{
+			RunName:  "run-1",
+			Format:   "SPDX",
+			Location: "registry.local/api@sha256:def",
+		}, nil)
+		resp, err := newHandler(svc).GetWorkflowRunSBOM(ctx, gen.GetWorkflowRunSBOMRequestObject{NamespaceName: ns, RunName: "run-1"})
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetWorkflowRunSBOM200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, "registry.local/api@sha256:def", typed.Location)
+		require.NotNil(t, typed.Format)
+		assert.Equal(t, gen.WorkflowRunSBOMResponseFormat("SPDX"), *typed.Format)
+		assert.Nil(t, typed.Image)
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"run not found -> 404", workflowrunsvc.ErrWorkflowRunNotFound, gen.GetWorkflowRunSBOM404JSONResponse{}},
+		{"no SBOM -> 404", workflowrunsvc.ErrSBOMNotFound, gen.GetWorkflowRunSBOM404JSONResponse{}},
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.GetWorkflowRunSBOM403JSONResponse{}},
+		{"internal -> 500", errors.New("internal server error"), gen.GetWorkflowRunSBOM500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := workflowrunmocks.NewMockService(t)
+			svc.EXPECT().GetWorkflowRunSBOM(mock.Anything, ns, "run-1").Return(nil, tt.svcErr)
+			resp, err := newHandler(svc).GetWorkflowRunSBOM(ctx, gen.GetWorkflowRunSBOMRequestObject{NamespaceName: ns, RunName: "run-1"})
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
+func TestGetDeployedSBOMHandler(t *testing.T) {
+	ctx := testContext()
+	const ns = "test-ns"
+
+	newHandler := func(svc *workflowrunmocks.MockService) *Handler {
+		return &Handler{
+			services: &handlerservices.Services{WorkflowRunService: svc},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+	}
+	request := gen.GetDeployedSBOMRequestObject{NamespaceName: ns, ComponentName: "api", EnvName: "production"}
+
+	t.Run("success", func(t *testing.T) {
+		svc := workflowrunmocks.NewMockService(t)
+		svc.EXPECT().GetDeployedSBOM(mock.Anything, ns, "api", "production").Return(&models.WorkflowRunSBOMResponse{
+			RunName:  "run-1",
+			Image:    "registry.local/api@sha256:abc",
+			Location: "registry.local/api@sha256:def",
+		}, nil)
+		resp, err := newHandler(svc).GetDeployedSBOM(ctx, request)
+		require.NoError(t, err)
+		typed, ok := resp.(gen.GetDeployedSBOM200JSONResponse)
+		require.True(t, ok, "expected 200 response, got %T", resp)
+		assert.Equal(t, "run-1", typed.RunName)
+		require.NotNil(t, typed.Image)
+		assert.Nil(t, typed.Format)
+	})
+
+	tests := []struct {
+		name    string
+		svcErr  error
+		wantTyp any
+	}{
+		{"component not found -> 404", componentsvc.ErrComponentNotFound, gen.GetDeployedSBOM404JSONResponse{}},
+		{"not deployed -> 404", workflowrunsvc.ErrComponentNotDeployed, gen.GetDeployedSBOM404JSONResponse{}},
+		{"no SBOM -> 404", workflowrunsvc.ErrSBOMNotFound, gen.GetDeployedSBOM404JSONResponse{}},
+		{"forbidden -> 403", svcpkg.ErrForbidden, gen.GetDeployedSBOM403JSONResponse{}},
+		{"internal -> 500", errors.New("internal server error"), gen.GetDeployedSBOM500JSONResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := workflowrunmocks.NewMockService(t)
+			svc.EXPECT().GetDeployedSBOM(mock.Anything, ns, "api", "production").Return(nil, tt.svcErr)
+			resp, err := newHandler(svc).GetDeployedSBOM(ctx, request)
+			require.NoError(t, err)
+			assert.IsType(t, tt.wantTyp, resp)
+		})
+	}
+}
+
 // --- GetWorkflowRunStatus Handler ---
 
 func TestGetWorkflowRunStatusHandler(t *testing.T) {
@@ -800,6 +962,8 @@ func TestGetWorkflowRunStatusHandler(t *testing.T) {
 		svc.EXPECT().GetWorkflowRunStatus(mock.Anything, ns, "run-1").Return(&models.WorkflowRunStatusResponse{
 			Status:               "Succeeded",
 			HasLiveObservability: true,
+			Image:                "registry.local/api@sha256:abc",
+			Cache:                &models.BuildCacheStatistics{Hits: 8, Misses: 2},
 			Steps: []models.WorkflowStepStatus{
 				{Name: "build", Phase: "Succeeded", StartedAt: &started},
 				{Name: "test", Phase: "Omitted"},
@@ -817,6 +981,10 @@ func TestGetWorkflowRunStatusHandler(t *testing.T) {
 		typed, ok := resp.(gen.GetWorkflowRunStatus200JSONResponse)
 		require.True(t, ok, "expected 200 response, got %T", resp)
 		assert.True(t, typed.HasLiveObservability)
+		require.NotNil(t, typed.Image)
+		assert.Equal(t, "registry.local/api@sha256:abc", *typed.Image)
+		require.NotNil(t, typed.Cache)
+		assert.Equal(t, int32(8), typed.Cache.Hits)
 		require.Len(t, typed.Steps, 4)
 		assert.Equal(t, gen.WorkflowStepStatusPhaseSucceeded, typed.Steps[0].Phase)
 		assert.Equal(t, gen.WorkflowStepStatusPhaseSkipped, typed.Steps[1].Phase, "Omitted should map to Skipped")
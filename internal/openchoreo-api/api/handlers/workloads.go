@@ -26,9 +26,11 @@ func (h *Handler) ListWorkloads(
 		componentName = *request.Params.Component
 	}
 
-	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector)
+	opts := NormalizeListOptions(request.Params.Limit, request.Params.Cursor, request.Params.LabelSelector, request.Params.Sort)
 
-	result, err := h.services.WorkloadService.ListWorkloads(ctx, request.NamespaceName, componentName, opts)
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.Workload], error) {
+		return h.services.WorkloadService.ListWorkloads(ctx, request.NamespaceName, componentName, opts)
+	})
 	if err != nil {
 		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
 			return gen.ListWorkloads400JSONResponse{BadRequestJSONResponse: badRequest(validationErr.Msg)}, nil
@@ -45,7 +47,7 @@ func (h *Handler) ListWorkloads(
 
 	return gen.ListWorkloads200JSONResponse{
 		Items:      items,
-		Pagination: ToPagination(result),
+		Pagination: ToPagination(result.WithFilter("component", componentName)),
 	}, nil
 }
 
@@ -153,6 +155,9 @@ func (h *Handler) UpdateWorkload(
 		if errors.Is(err, workloadsvc.ErrWorkloadNotFound) {
 			return gen.UpdateWorkload404JSONResponse{NotFoundJSONResponse: notFound("Workload")}, nil
 		}
+		if errors.Is(err, workloadsvc.ErrComponentNotFound) {
+			return gen.UpdateWorkload400JSONResponse{BadRequestJSONResponse: badRequest("Referenced component not found")}, nil
+		}
 		if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
 			if validationErr.StatusCode == http.StatusUnprocessableEntity {
 				return gen.UpdateWorkload422JSONResponse{UnprocessableContentJSONResponse: unprocessableContent(validationErr.Msg)}, nil
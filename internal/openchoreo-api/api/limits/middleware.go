@@ -0,0 +1,145 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package limits provides the OpenAPI middleware that bounds the request body
+// size and the handling time of each REST operation.
+package limits
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+// ApplyOperation is the operation whose request body is a single resource
+// manifest, bounded by Config.MaxManifestSize.
+const ApplyOperation = "applyResource"
+
+// timeoutMessage is the body of responses to requests that timed out.
+const timeoutMessage = "request timed out"
+
+// Config defines the limits of REST operations.
+type Config struct {
+	// MaxBodySize is the maximum request body size in bytes. Zero means no limit.
+	MaxBodySize int64
+	// MaxManifestSize is the maximum size in bytes of the manifest sent to
+	// ApplyOperation. Zero means MaxBodySize applies.
+	MaxManifestSize int64
+	// Timeout is the maximum time to handle a request. Zero means no limit.
+	Timeout time.Duration
+	// OperationTimeouts overrides Timeout for the operations with the given
+	// operation IDs.
+	OperationTimeouts map[string]time.Duration
+}
+
+// Middleware returns an OpenAPI middleware that enforces cfg. Requests whose
+// Content-Length exceeds the body size limit of the operation are rejected
+// with 413 Request Entity Too Large before the body is read; other bodies are
+// cut off at the limit, which the handler reports with 413 as well (see
+// RequestErrorHandler). Requests that take longer than the timeout of the
+// operation get 503 Service Unavailable.
+//
+// The middleware must run outside the middleware that read the request body,
+// such as idempotency, and it buffers the response while a timeout applies.
+func Middleware(cfg Config) (func(http.Handler) http.Handler, error) {
+	patterns, err := operationPatterns()
+	if err != nil {
+		return nil, err
+	}
+	timeouts := make(map[string]time.Duration, len(cfg.OperationTimeouts))
+	for id, timeout := range cfg.OperationTimeouts {
+		pattern, ok := patterns[id]
+		if !ok {
+			return nil, fmt.Errorf("unknown operation %q", id)
+		}
+		timeouts[pattern] = timeout
+	}
+	bodySizes := map[string]int64{}
+	if cfg.MaxManifestSize > 0 {
+		bodySizes[patterns[ApplyOperation]] = cfg.MaxManifestSize
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			maxSize, ok := bodySizes[r.Pattern]
+			if !ok {
+				maxSize = cfg.MaxBodySize
+			}
+			if maxSize > 0 && r.Body != nil && r.Body != http.NoBody {
+				if r.ContentLength > maxSize {
+					writeTooLarge(w, maxSize)
+					return
+				}
+				r.Body = http.MaxBytesReader(w, r.Body, maxSize)
+			}
+
+			timeout, ok := timeouts[r.Pattern]
+			if !ok {
+				timeout = cfg.Timeout
+			}
+			if timeout <= 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+			http.TimeoutHandler(next, timeout, timeoutMessage).ServeHTTP(w, r)
+		})
+	}, nil
+}
+
+// RequestErrorHandler reports errors decoding request bodies. Bodies cut off
+// by the size limit get 413 Request Entity Too Large, other errors get 400 Bad
+// Request, as with the default handler of the generated server.
+func RequestErrorHandler(w http.ResponseWriter, _ *http.Request, err error) {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		writeTooLarge(w, maxBytesErr.Limit)
+		return
+	}
+	http.Error(w, err.Error(), http.StatusBadRequest)
+}
+
+// OperationIDs returns the sorted IDs of the REST operations.
+func OperationIDs() ([]string, error) {
+	patterns, err := operationPatterns()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(patterns))
+	for id := range patterns {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+func writeTooLarge(w http.ResponseWriter, limit int64) {
+	http.Error(w, fmt.Sprintf("request body exceeds the limit of %d bytes", limit),
+		http.StatusRequestEntityTooLarge)
+}
+
+// operationPatterns maps the ID of each REST operation to the ServeMux pattern
+// its handler is registered with, such as "GET /api/v1/namespaces". The
+// embedded spec carries the IDs with the first letter in upper case, as the
+// generated handler names; the IDs are keyed as written in openchoreo-api.yaml.
+var operationPatterns = sync.OnceValues(func() (map[string]string, error) {
+	swagger, err := gen.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load OpenAPI spec: %w", err)
+	}
+	patterns := make(map[string]string)
+	for path, item := range swagger.Paths.Map() {
+		for method, op := range item.Operations() {
+			if op.OperationID != "" {
+				id := strings.ToLower(op.OperationID[:1]) + op.OperationID[1:]
+				patterns[id] = strings.ToUpper(method) + " " + path
+			}
+		}
+	}
+	return patterns, nil
+})
@@ -0,0 +1,111 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package limits
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	applyPattern  = "POST /api/v1/namespaces/{namespaceName}/resources/{resourceName}/apply"
+	createPattern = "POST /api/v1/namespaces/{namespaceName}/projects"
+)
+
+// readBody reads the request body like the strict handler does, reporting
+// decode errors with RequestErrorHandler.
+var readBody = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	if _, err := io.ReadAll(r.Body); err != nil {
+		RequestErrorHandler(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+})
+
+func serve(t *testing.T, cfg Config, handler http.Handler, pattern string, body io.Reader) *httptest.ResponseRecorder {
+	t.Helper()
+	mw, err := Middleware(cfg)
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/", body)
+	req.Pattern = pattern
+	rec := httptest.NewRecorder()
+	mw(handler).ServeHTTP(rec, req)
+	return rec
+}
+
+// chunked hides the length of a body, as with chunked transfer encoding.
+type chunked struct{ io.Reader }
+
+func TestMiddleware_BodySize(t *testing.T) {
+	cfg := Config{MaxBodySize: 10, MaxManifestSize: 5}
+
+	tests := []struct {
+		name    string
+		pattern string
+		body    io.Reader
+		want    int
+	}{
+		{name: "within limit", pattern: createPattern, body: strings.NewReader("0123456789"), want: http.StatusOK},
+		{name: "content length over limit", pattern: createPattern, body: strings.NewReader("0123456789a"),
+			want: http.StatusRequestEntityTooLarge},
+		{name: "streamed body over limit", pattern: createPattern, body: chunked{strings.NewReader("0123456789a")},
+			want: http.StatusRequestEntityTooLarge},
+		{name: "manifest within limit", pattern: applyPattern, body: strings.NewReader("01234"), want: http.StatusOK},
+		{name: "manifest over limit", pattern: applyPattern, body: strings.NewReader("012345"),
+			want: http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(t, cfg, readBody, tt.pattern, tt.body)
+			assert.Equal(t, tt.want, rec.Code)
+		})
+	}
+
+	t.Run("no limit", func(t *testing.T) {
+		rec := serve(t, Config{}, readBody, createPattern, strings.NewReader(strings.Repeat("x", 1<<20)))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
+
+func TestMiddleware_Timeout(t *testing.T) {
+	slow := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+			w.WriteHeader(http.StatusOK)
+		}
+	})
+	cfg := Config{
+		Timeout:           10 * time.Millisecond,
+		OperationTimeouts: map[string]time.Duration{ApplyOperation: 5 * time.Second},
+	}
+
+	rec := serve(t, cfg, slow, createPattern, http.NoBody)
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Contains(t, rec.Body.String(), timeoutMessage)
+
+	rec = serve(t, cfg, slow, applyPattern, http.NoBody)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestMiddleware_UnknownOperation(t *testing.T) {
+	_, err := Middleware(Config{OperationTimeouts: map[string]time.Duration{"noSuchOperation": time.Second}})
+	assert.EqualError(t, err, `unknown operation "noSuchOperation"`)
+}
+
+func TestOperationIDs(t *testing.T) {
+	ids, err := OperationIDs()
+	require.NoError(t, err)
+	assert.Contains(t, ids, ApplyOperation)
+	assert.Contains(t, ids, "batchApplyResources")
+	assert.IsIncreasing(t, ids)
+}
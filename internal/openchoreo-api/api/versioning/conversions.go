@@ -0,0 +1,89 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package versioning
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+// Converter converts the body of a successful v1alpha1 response into the
+// response of a newer version. The request carries the path values of the
+// matched route.
+type Converter func(r *http.Request, status int, body []byte) (any, error)
+
+// v1Conversions maps the route patterns of the operations whose v1 response
+// differs from v1alpha1 to their conversion.
+var v1Conversions = map[string]Converter{
+	"POST /api/v1/namespaces/{namespaceName}/resources/{resourceName}/apply": applyResourceResultV1,
+
+	"GET /api/v1/clustercomponenttypes/{cctName}/schema":                       schemaDetailsV1("ClusterComponentType", "cctName"),
+	"GET /api/v1/clusterprojecttypes/{cptName}/schema":                         schemaDetailsV1("ClusterProjectType", "cptName"),
+	"GET /api/v1/clusterresourcetypes/{crtName}/schema":                        schemaDetailsV1("ClusterResourceType", "crtName"),
+	"GET /api/v1/clustertraits/{clusterTraitName}/schema":                      schemaDetailsV1("ClusterTrait", "clusterTraitName"),
+	"GET /api/v1/clusterworkflows/{clusterWorkflowName}/schema":                schemaDetailsV1("ClusterWorkflow", "clusterWorkflowName"),
+	"GET /api/v1/namespaces/{namespaceName}/components/{componentName}/schema": schemaDetailsV1("Component", "componentName"),
+	"GET /api/v1/namespaces/{namespaceName}/componenttypes/{ctName}/schema":    schemaDetailsV1("ComponentType", "ctName"),
+	"GET /api/v1/namespaces/{namespaceName}/projecttypes/{ptName}/schema":      schemaDetailsV1("ProjectType", "ptName"),
+	"GET /api/v1/namespaces/{namespaceName}/resourcetypes/{rtName}/schema":     schemaDetailsV1("ResourceType", "rtName"),
+	"GET /api/v1/namespaces/{namespaceName}/traits/{traitName}/schema":         schemaDetailsV1("Trait", "traitName"),
+	"GET /api/v1/namespaces/{namespaceName}/workflows/{workflowName}/schema":   schemaDetailsV1("Workflow", "workflowName"),
+}
+
+// versionedRoutes matches requests against the patterns in v1Conversions.
+var versionedRoutes = func() *http.ServeMux {
+	mux := http.NewServeMux()
+	for pattern := range v1Conversions {
+		mux.Handle(pattern, http.NotFoundHandler())
+	}
+	return mux
+}()
+
+// converterFor returns the conversion of the operation matched by pattern into
+// version v, or nil when the response is the same in both versions.
+func converterFor(pattern string, v Version) Converter {
+	if v != V1 {
+		return nil
+	}
+	return v1Conversions[pattern]
+}
+
+// applyResourceResultV1 wraps the applied resource in an ApplyResourceResult
+// that states whether the resource was created or updated.
+func applyResourceResultV1(_ *http.Request, status int, body []byte) (any, error) {
+	var resource gen.ResourceInstance
+	if err := json.Unmarshal(body, &resource); err != nil {
+		return nil, fmt.Errorf("failed to decode applied resource: %w", err)
+	}
+	operation := gen.ApplyResourceResultOperationUpdated
+	if status == http.StatusCreated {
+		operation = gen.ApplyResourceResultOperationCreated
+	}
+	return gen.ApplyResourceResult{Operation: operation, Resource: resource}, nil
+}
+
+// schemaDetailsV1 returns a conversion that wraps a raw parameter schema in
+// SchemaDetails, naming the resource identified by the nameParam path value.
+func schemaDetailsV1(kind, nameParam string) Converter {
+	return func(r *http.Request, _ int, body []byte) (any, error) {
+		var schema gen.SchemaResponse
+		if err := json.Unmarshal(body, &schema); err != nil {
+			return nil, fmt.Errorf("failed to decode %s schema: %w", kind, err)
+		}
+		details := gen.SchemaDetails{
+			Kind:   kind,
+			Name:   r.PathValue(nameParam),
+			Schema: schema,
+		}
+		if namespace := r.PathValue("namespaceName"); namespace != "" {
+			details.Namespace = ptr.To(namespace)
+		}
+		return details, nil
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package versioning
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	apilogger "github.com/openchoreo/openchoreo/internal/server/middleware/logger"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/problem"
+)
+
+const (
+	// pathPrefix is the prefix of the REST routes.
+	pathPrefix = "/api/v1/"
+	// v1alpha1PathPrefix serves versioned operations with v1alpha1 responses
+	// regardless of the default version.
+	v1alpha1PathPrefix = "/api/v1alpha1/"
+)
+
+// Negotiate returns a middleware that selects the response version of requests
+// to versioned operations and stores it in the request context. Requests to
+// other routes pass through untouched.
+//
+// A versioned operation called under /api/v1alpha1/ instead of /api/v1/ always
+// responds with v1alpha1. Otherwise the version comes from the Accept header,
+// falling back to defaultVersion. An unsupported version is rejected with 406.
+//
+// The middleware does not wrap the ResponseWriter, so it can run in front of
+// streaming handlers.
+func Negotiate(defaultVersion Version) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if rest, ok := strings.CutPrefix(r.URL.Path, v1alpha1PathPrefix); ok {
+				aliased := withPath(r, pathPrefix+rest)
+				if isVersionedRequest(aliased) {
+					next.ServeHTTP(w, aliased.WithContext(WithVersion(aliased.Context(), V1Alpha1)))
+					return
+				}
+			}
+			if !isVersionedRequest(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			v, ok, err := FromAccept(r.Header.Get("Accept"))
+			if err != nil {
+				writeError(w, http.StatusNotAcceptable, gen.NOTACCEPTABLE, err.Error())
+				return
+			}
+			if !ok {
+				v = defaultVersion
+			}
+			next.ServeHTTP(w, r.WithContext(WithVersion(r.Context(), v)))
+		})
+	}
+}
+
+// ConvertResponses is an OpenAPI middleware that converts the successful
+// responses of versioned operations to the version negotiated by Negotiate.
+// It relies on the route pattern, so it must run after routing.
+func ConvertResponses(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := v1Conversions[r.Pattern]; !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		v := FromContext(r.Context())
+		w.Header().Set(HeaderAPIVersion, string(v))
+		w.Header().Add("Vary", "Accept")
+
+		convert := converterFor(r.Pattern, v)
+		if convert == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rec := &bufferedResponseWriter{header: w.Header(), statusCode: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		if rec.statusCode < 200 || rec.statusCode >= 300 {
+			w.WriteHeader(rec.statusCode)
+			_, _ = w.Write(rec.body.Bytes())
+			return
+		}
+
+		converted, err := convert(r, rec.statusCode, rec.body.Bytes())
+		if err == nil {
+			var body []byte
+			if body, err = json.Marshal(converted); err == nil {
+				w.Header().Del("Content-Length")
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(rec.statusCode)
+				_, _ = w.Write(append(body, '\n'))
+				return
+			}
+		}
+		apilogger.GetLogger(r.Context()).Error("Failed to convert response",
+			"version", v, "pattern", r.Pattern, "error", err)
+		writeError(w, http.StatusInternalServerError, gen.INTERNALERROR, "Internal server error")
+	})
+}
+
+// bufferedResponseWriter holds a response so it can be converted before it is
+// written.
+type bufferedResponseWriter struct {
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+	written    bool
+}
+
+func (rw *bufferedResponseWriter) Header() http.Header {
+	return rw.header
+}
+
+func (rw *bufferedResponseWriter) WriteHeader(code int) {
+	if !rw.written {
+		rw.statusCode = code
+		rw.written = true
+	}
+}
+
+func (rw *bufferedResponseWriter) Write(b []byte) (int, error) {
+	rw.written = true
+	return rw.body.Write(b)
+}
+
+// withPath returns a shallow copy of r with its URL path replaced.
+func withPath(r *http.Request, path string) *http.Request {
+	r2 := new(http.Request)
+	*r2 = *r
+	r2.URL = new(url.URL)
+	*r2.URL = *r.URL
+	r2.URL.Path = path
+	r2.URL.RawPath = ""
+	return r2
+}
+
+func writeError(w http.ResponseWriter, status int, code gen.ErrorResponseCode, msg string) {
+	problem.Write(w, problem.New(status, string(code), msg))
+}
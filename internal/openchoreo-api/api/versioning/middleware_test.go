@@ -0,0 +1,151 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package versioning
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+)
+
+const (
+	applyPattern  = "POST /api/v1/namespaces/{namespaceName}/resources/{resourceName}/apply"
+	schemaPattern = "GET /api/v1/namespaces/{namespaceName}/componenttypes/{ctName}/schema"
+)
+
+// newTestServer routes the apply and component type schema operations the way
+// the generated OpenAPI router does, behind the negotiation middleware.
+func newTestServer(defaultVersion Version, applyStatus int) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(applyPattern, ConvertResponses(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(applyStatus)
+		if applyStatus >= 300 {
+			_ = json.NewEncoder(w).Encode(gen.ErrorResponse{Code: gen.CONFLICT, Error: "conflict"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(gen.ResourceInstance{
+			Metadata: gen.ObjectMeta{Name: r.PathValue("resourceName")},
+		})
+	})))
+	mux.Handle(schemaPattern, ConvertResponses(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"type":"object"}`))
+	})))
+	mux.HandleFunc("GET /api/v1alpha1/autobuild", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("autobuild"))
+	})
+	return Negotiate(defaultVersion)(mux)
+}
+
+func serve(t *testing.T, h http.Handler, method, path, accept string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader("{}"))
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestApplyResourceVersions(t *testing.T) {
+	const path = "/api/v1/namespaces/default/resources/db/apply"
+
+	t.Run("default version keeps the v1alpha1 shape", func(t *testing.T) {
+		rec := serve(t, newTestServer(V1Alpha1, http.StatusCreated), http.MethodPost, path, "")
+		require.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, "v1alpha1", rec.Header().Get(HeaderAPIVersion))
+
+		var got gen.ResourceInstance
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+		assert.Equal(t, "db", got.Metadata.Name)
+	})
+
+	t.Run("accept header selects v1", func(t *testing.T) {
+		for status, operation := range map[int]gen.ApplyResourceResultOperation{
+			http.StatusCreated: gen.ApplyResourceResultOperationCreated,
+			http.StatusOK:      gen.ApplyResourceResultOperationUpdated,
+		} {
+			rec := serve(t, newTestServer(V1Alpha1, status), http.MethodPost, path, "application/vnd.openchoreo.v1+json")
+			require.Equal(t, status, rec.Code)
+			assert.Equal(t, "v1", rec.Header().Get(HeaderAPIVersion))
+			assert.Equal(t, "Accept", rec.Header().Get("Vary"))
+
+			var got gen.ApplyResourceResult
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			assert.Equal(t, operation, got.Operation)
+			assert.Equal(t, "db", got.Resource.Metadata.Name)
+		}
+	})
+
+	t.Run("v1alpha1 path pins v1alpha1 over the default and accept header", func(t *testing.T) {
+		rec := serve(t, newTestServer(V1, http.StatusOK), http.MethodPost,
+			"/api/v1alpha1/namespaces/default/resources/db/apply", "application/json; version=v1")
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "v1alpha1", rec.Header().Get(HeaderAPIVersion))
+		assert.NotContains(t, rec.Body.String(), `"operation"`)
+	})
+
+	t.Run("error responses are not converted", func(t *testing.T) {
+		rec := serve(t, newTestServer(V1, http.StatusConflict), http.MethodPost, path, "")
+		require.Equal(t, http.StatusConflict, rec.Code)
+
+		var got gen.ErrorResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+		assert.Equal(t, gen.CONFLICT, got.Code)
+	})
+
+	t.Run("unsupported version is not acceptable", func(t *testing.T) {
+		rec := serve(t, newTestServer(V1Alpha1, http.StatusOK), http.MethodPost, path, "application/vnd.openchoreo.v3+json")
+		require.Equal(t, http.StatusNotAcceptable, rec.Code)
+
+		var got gen.ErrorResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+		assert.Equal(t, gen.NOTACCEPTABLE, got.Code)
+	})
+}
+
+func TestSchemaVersions(t *testing.T) {
+	rec := serve(t, newTestServer(V1, http.StatusOK), http.MethodGet,
+		"/api/v1/namespaces/default/componenttypes/web-service/schema", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var got gen.SchemaDetails
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	assert.Equal(t, "ComponentType", got.Kind)
+	assert.Equal(t, "web-service", got.Name)
+	require.NotNil(t, got.Namespace)
+	assert.Equal(t, "default", *got.Namespace)
+	assert.Equal(t, gen.SchemaResponse{"type": "object"}, got.Schema)
+}
+
+func TestNegotiate_IgnoresUnversionedRoutes(t *testing.T) {
+	h := newTestServer(V1, http.StatusOK)
+
+	rec := serve(t, h, http.MethodGet, "/api/v1alpha1/autobuild", "application/vnd.openchoreo.v3+json")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "autobuild", rec.Body.String())
+	assert.Empty(t, rec.Header().Get(HeaderAPIVersion))
+}
+
+func TestConversionPatternsMatchRoutes(t *testing.T) {
+	// Every conversion must be keyed by a route the generated router serves,
+	// otherwise it never runs.
+	mux := http.NewServeMux()
+	gen.HandlerFromMux(gen.NewStrictHandler(nil, nil), mux)
+	for pattern := range v1Conversions {
+		method, path, _ := strings.Cut(pattern, " ")
+		req := httptest.NewRequest(method, strings.NewReplacer("{", "", "}", "").Replace(path), nil)
+		_, matched := mux.Handler(req)
+		assert.Equal(t, pattern, matched)
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package versioning negotiates the response version of openchoreo-api REST
+// responses and converts responses between versions.
+//
+// Handlers always produce the v1alpha1 shape. Operations whose response shape
+// changed in a later version register a conversion from v1alpha1, so existing
+// clients keep receiving the shape they were written against while newer
+// clients opt in to the new one.
+package versioning
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// Version is a response version.
+type Version string
+
+const (
+	// V1Alpha1 is the original response version produced by the handlers.
+	V1Alpha1 Version = "v1alpha1"
+	// V1 is the current response version.
+	V1 Version = "v1"
+)
+
+// HeaderAPIVersion is the response header that reports the version of a
+// versioned response.
+const HeaderAPIVersion = "OpenChoreo-API-Version"
+
+// vendorMediaTypePrefix and vendorMediaTypeSuffix enclose the version in
+// vendor media types such as application/vnd.openchoreo.v1+json.
+const (
+	vendorMediaTypePrefix = "application/vnd.openchoreo."
+	vendorMediaTypeSuffix = "+json"
+)
+
+// ErrUnsupportedVersion is returned when a client asks for an unknown version.
+var ErrUnsupportedVersion = errors.New("unsupported API version")
+
+// Versions returns the supported response versions, oldest first.
+func Versions() []Version {
+	return []Version{V1Alpha1, V1}
+}
+
+// ParseVersion returns the version named by s.
+func ParseVersion(s string) (Version, error) {
+	for _, v := range Versions() {
+		if string(v) == s {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("%w %q", ErrUnsupportedVersion, s)
+}
+
+// FromAccept returns the version requested by an Accept header, either as a
+// vendor media type (application/vnd.openchoreo.v1+json) or as the version
+// parameter of a JSON media type (application/json; version=v1). It returns ok
+// false when the header does not request a version.
+func FromAccept(accept string) (v Version, ok bool, err error) {
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+		var requested string
+		switch {
+		case strings.HasPrefix(mediaType, vendorMediaTypePrefix) && strings.HasSuffix(mediaType, vendorMediaTypeSuffix):
+			requested = strings.TrimSuffix(strings.TrimPrefix(mediaType, vendorMediaTypePrefix), vendorMediaTypeSuffix)
+		case mediaType == "application/json" || mediaType == "*/*":
+			requested = params["version"]
+		}
+		if requested == "" {
+			continue
+		}
+		v, err := ParseVersion(requested)
+		if err != nil {
+			return "", false, err
+		}
+		return v, true, nil
+	}
+	return "", false, nil
+}
+
+type contextKey struct{}
+
+// WithVersion returns a copy of ctx that carries the negotiated version.
+func WithVersion(ctx context.Context, v Version) context.Context {
+	return context.WithValue(ctx, contextKey{}, v)
+}
+
+// FromContext returns the negotiated version, or V1Alpha1 when the request
+// did not pass through the negotiation middleware.
+func FromContext(ctx context.Context) Version {
+	if v, ok := ctx.Value(contextKey{}).(Version); ok {
+		return v
+	}
+	return V1Alpha1
+}
+
+// isVersionedRequest reports whether r targets an operation that has more than
+// one response version.
+func isVersionedRequest(r *http.Request) bool {
+	_, pattern := versionedRoutes.Handler(r)
+	return pattern != ""
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package versioning
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromAccept(t *testing.T) {
+	tests := []struct {
+		name    string
+		accept  string
+		want    Version
+		wantOK  bool
+		wantErr bool
+	}{
+		{name: "empty", accept: ""},
+		{name: "plain json", accept: "application/json"},
+		{name: "wildcard", accept: "*/*"},
+		{name: "vendor media type", accept: "application/vnd.openchoreo.v1+json", want: V1, wantOK: true},
+		{name: "version parameter", accept: "application/json; version=v1alpha1", want: V1Alpha1, wantOK: true},
+		{
+			name:   "first versioned media range wins",
+			accept: "text/html, application/vnd.openchoreo.v1alpha1+json;q=0.9, application/json;version=v1",
+			want:   V1Alpha1,
+			wantOK: true,
+		},
+		{name: "malformed media ranges are skipped", accept: ";;, application/vnd.openchoreo.v1+json", want: V1, wantOK: true},
+		{name: "unknown vendor version", accept: "application/vnd.openchoreo.v2+json", wantErr: true},
+		{name: "unknown version parameter", accept: "application/json; version=v9", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok, err := FromAccept(tt.accept)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrUnsupportedVersion)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -40,6 +40,12 @@ func GetActionDefinitions() []audit.ActionDefinition {
 			Action:   "create_dataplane",
 			Category: audit.CategoryResource,
 		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/dataplanes/{dpName}/register",
+			Action:   "register_dataplane",
+			Category: audit.CategoryResource,
+		},
 
 		// Environment operations
 		{
@@ -75,6 +81,20 @@ func GetActionDefinitions() []audit.ActionDefinition {
 			Category: audit.CategoryResource,
 		},
 
+		// Registry credential operations
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1alpha1/namespaces/{namespaceName}/registrycredentials",
+			Action:   "create_registry_credential",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "DELETE",
+			Pattern:  "/api/v1alpha1/namespaces/{namespaceName}/registrycredentials/{registryCredentialName}",
+			Action:   "delete_registry_credential",
+			Category: audit.CategoryResource,
+		},
+
 		// Apply/Delete operations (kubectl-like)
 		{
 			Method:   "POST",
@@ -104,6 +124,30 @@ func GetActionDefinitions() []audit.ActionDefinition {
 			Action:   "deploy_component",
 			Category: audit.CategoryResource,
 		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/components/{componentName}/deploy",
+			Action:   "deploy_component",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/components/{componentName}/rollback",
+			Action:   "rollback_component",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/components/{componentName}/rollout",
+			Action:   "control_component_rollout",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "PATCH",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/components/{componentName}/endpoints/{endpointName}",
+			Action:   "update_endpoint",
+			Category: audit.CategoryResource,
+		},
 		{
 			Method:   "POST",
 			Pattern:  "/api/v1/namespaces/{namespaceName}/projects/{projectName}/components/{componentName}/promote",
@@ -111,6 +155,88 @@ func GetActionDefinitions() []audit.ActionDefinition {
 			Category: audit.CategoryResource,
 		},
 
+		// Promotion request operations
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/promotion-requests",
+			Action:   "create_promotion_request",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/promotion-requests/{promotionRequestName}/approve",
+			Action:   "approve_promotion_request",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/promotion-requests/{promotionRequestName}/reject",
+			Action:   "reject_promotion_request",
+			Category: audit.CategoryResource,
+		},
+
+		// Custom domain operations
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/custom-domains",
+			Action:   "create_custom_domain",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "DELETE",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/custom-domains/{customDomainName}",
+			Action:   "delete_custom_domain",
+			Category: audit.CategoryResource,
+		},
+
+		// Connection operations
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/connections",
+			Action:   "create_connection",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "DELETE",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/connections/{connectionName}",
+			Action:   "delete_connection",
+			Category: audit.CategoryResource,
+		},
+
+		// Network policy rule operations
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/network-policy-rules",
+			Action:   "create_network_policy_rule",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "PUT",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/network-policy-rules/{networkPolicyRuleName}",
+			Action:   "update_network_policy_rule",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "DELETE",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/network-policy-rules/{networkPolicyRuleName}",
+			Action:   "delete_network_policy_rule",
+			Category: audit.CategoryResource,
+		},
+
+		// Sandbox operations
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/projects/{projectName}/sandboxes",
+			Action:   "create_sandbox",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "DELETE",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/projects/{projectName}/sandboxes/{sandboxName}",
+			Action:   "delete_sandbox",
+			Category: audit.CategoryResource,
+		},
+
 		// Trait operations
 		{
 			Method:   "PUT",
@@ -149,6 +275,50 @@ func GetActionDefinitions() []audit.ActionDefinition {
 			Category: audit.CategoryResource,
 		},
 
+		// Resource operations
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/resources",
+			Action:   "create_resource",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "PUT",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/resources/{resourceName}",
+			Action:   "update_resource",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "PATCH",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/resources/{resourceName}",
+			Action:   "patch_resource",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "DELETE",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/resources/{resourceName}",
+			Action:   "delete_resource",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/namespaces/{namespaceName}/resources/{resourceName}/apply",
+			Action:   "apply_resource",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/resources:batchApply",
+			Action:   "batch_apply_resources",
+			Category: audit.CategoryResource,
+		},
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/resources:batchDelete",
+			Action:   "batch_delete_resources",
+			Category: audit.CategoryResource,
+		},
+
 		// Authorization role operations
 		{
 			Method:   "POST",
@@ -188,5 +358,19 @@ func GetActionDefinitions() []audit.ActionDefinition {
 			Action:   "delete_authz_role_mapping",
 			Category: audit.CategoryAuth,
 		},
+
+		// API token operations
+		{
+			Method:   "POST",
+			Pattern:  "/api/v1/apitokens",
+			Action:   "create_api_token",
+			Category: audit.CategoryAuth,
+		},
+		{
+			Method:   "DELETE",
+			Pattern:  "/api/v1/apitokens/{tokenId}",
+			Action:   "revoke_api_token",
+			Category: audit.CategoryAuth,
+		},
 	}
 }
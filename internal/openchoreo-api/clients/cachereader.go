@@ -0,0 +1,84 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// paginatingReader serves reads from an informer cache and adds the limit and
+// continue support the cache lacks. Like the API server, it returns list items
+// ordered by namespace and name, and its continue token is the key of the last
+// item returned.
+type paginatingReader struct {
+	cache client.Reader
+}
+
+var _ client.Reader = (*paginatingReader)(nil)
+
+func (r *paginatingReader) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	return r.cache.Get(ctx, key, obj, opts...)
+}
+
+func (r *paginatingReader) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	listOpts := client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+
+	after := ""
+	if listOpts.Continue != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(listOpts.Continue)
+		if err != nil || len(decoded) == 0 {
+			return apierrors.NewBadRequest(fmt.Sprintf("invalid continue token %q", listOpts.Continue))
+		}
+		after = string(decoded)
+	}
+
+	// List everything that matches, then page through it in key order.
+	unpaged := listOpts
+	unpaged.Limit = 0
+	unpaged.Continue = ""
+	if err := r.cache.List(ctx, list, &unpaged); err != nil {
+		return err
+	}
+	items, err := apimeta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+	sort.Slice(items, func(i, j int) bool { return objectKey(items[i]) < objectKey(items[j]) })
+
+	start := sort.Search(len(items), func(i int) bool { return objectKey(items[i]) > after })
+	page := items[start:]
+	next := ""
+	var remaining *int64
+	if listOpts.Limit > 0 && int64(len(page)) > listOpts.Limit {
+		page = page[:listOpts.Limit]
+		next = base64.RawURLEncoding.EncodeToString([]byte(objectKey(page[len(page)-1])))
+		count := int64(len(items) - start - len(page))
+		remaining = &count
+	}
+
+	if err := apimeta.SetList(list, page); err != nil {
+		return err
+	}
+	list.SetContinue(next)
+	list.SetRemainingItemCount(remaining)
+	return nil
+}
+
+// objectKey returns the namespace/name key that list items are ordered by.
+func objectKey(obj runtime.Object) string {
+	accessor, err := apimeta.Accessor(obj)
+	if err != nil {
+		return ""
+	}
+	return accessor.GetNamespace() + "/" + accessor.GetName()
+}
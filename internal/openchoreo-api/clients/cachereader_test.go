@@ -0,0 +1,87 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func newTestReader(t *testing.T, names ...string) *paginatingReader {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, openchoreov1alpha1.AddToScheme(scheme))
+	builder := fake.NewClientBuilder().WithScheme(scheme)
+	for _, name := range names {
+		builder = builder.WithObjects(&openchoreov1alpha1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns-1", Labels: map[string]string{"team": name[:1]}},
+		})
+	}
+	return &paginatingReader{cache: builder.Build()}
+}
+
+func projectNames(list *openchoreov1alpha1.ProjectList) []string {
+	names := make([]string, 0, len(list.Items))
+	for _, p := range list.Items {
+		names = append(names, p.Name)
+	}
+	return names
+}
+
+func TestPaginatingReader_PagesInKeyOrder(t *testing.T) {
+	ctx := context.Background()
+	r := newTestReader(t, "echo", "alpha", "delta", "bravo", "charlie")
+
+	var got []string
+	cursor := ""
+	var remaining []int64
+	for {
+		list := &openchoreov1alpha1.ProjectList{}
+		require.NoError(t, r.List(ctx, list, client.InNamespace("ns-1"), client.Limit(2), client.Continue(cursor)))
+		got = append(got, projectNames(list)...)
+		if list.Continue == "" {
+			require.Nil(t, list.RemainingItemCount)
+			break
+		}
+		require.NotNil(t, list.RemainingItemCount)
+		remaining = append(remaining, *list.RemainingItemCount)
+		cursor = list.Continue
+	}
+
+	require.Equal(t, []string{"alpha", "bravo", "charlie", "delta", "echo"}, got)
+	require.Equal(t, []int64{3, 1}, remaining)
+}
+
+func TestPaginatingReader_UnpagedListAppliesSelectors(t *testing.T) {
+	r := newTestReader(t, "bravo", "alpha", "beta")
+
+	list := &openchoreov1alpha1.ProjectList{}
+	require.NoError(t, r.List(context.Background(), list, client.InNamespace("ns-1"), client.MatchingLabels{"team": "b"}))
+	require.Equal(t, []string{"beta", "bravo"}, projectNames(list))
+	require.Empty(t, list.Continue)
+}
+
+func TestPaginatingReader_InvalidContinue(t *testing.T) {
+	r := newTestReader(t, "alpha")
+
+	err := r.List(context.Background(), &openchoreov1alpha1.ProjectList{}, client.Continue("%%%"))
+	require.True(t, apierrors.IsBadRequest(err))
+}
+
+func TestPaginatingReader_Get(t *testing.T) {
+	r := newTestReader(t, "alpha")
+
+	project := &openchoreov1alpha1.Project{}
+	require.NoError(t, r.Get(context.Background(), client.ObjectKey{Namespace: "ns-1", Name: "alpha"}, project))
+	require.Equal(t, "alpha", project.Name)
+}
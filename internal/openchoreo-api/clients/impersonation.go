@@ -0,0 +1,48 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"net/http"
+
+	"k8s.io/client-go/transport"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+// ImpersonationOptions configures how NewImpersonatingK8sClient maps the
+// authenticated subject to a Kubernetes user.
+type ImpersonationOptions struct {
+	// UsernamePrefix is prepended to the subject ID.
+	UsernamePrefix string
+	// GroupsPrefix is prepended to each group.
+	GroupsPrefix string
+	// GroupsClaim is the entitlement claim whose values become groups.
+	GroupsClaim string
+}
+
+// impersonatingTransport adds impersonation headers for the subject stored in
+// the request context. Requests without a subject, such as those of informers
+// and background tasks, are sent as the API server's own service account.
+type impersonatingTransport struct {
+	opts ImpersonationOptions
+	next http.RoundTripper
+}
+
+func (t *impersonatingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	subject, ok := auth.GetSubjectContextFromContext(req.Context())
+	if !ok || subject == nil || subject.ID == "" {
+		return t.next.RoundTrip(req)
+	}
+
+	// RoundTrip must not modify the caller's request.
+	req = req.Clone(req.Context())
+	req.Header.Set(transport.ImpersonateUserHeader, t.opts.UsernamePrefix+subject.ID)
+	if subject.EntitlementClaim == t.opts.GroupsClaim {
+		for _, group := range subject.EntitlementValues {
+			req.Header.Add(transport.ImpersonateGroupHeader, t.opts.GroupsPrefix+group)
+		}
+	}
+	return t.next.RoundTrip(req)
+}
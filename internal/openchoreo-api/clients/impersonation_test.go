@@ -0,0 +1,133 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+type recordingTransport struct {
+	req *http.Request
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.req = req
+	return &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func TestImpersonatingTransport(t *testing.T) {
+	opts := ImpersonationOptions{UsernamePrefix: "oidc:", GroupsPrefix: "oidc:", GroupsClaim: "groups"}
+
+	tests := []struct {
+		name       string
+		subject    *auth.SubjectContext
+		wantUser   string
+		wantGroups []string
+	}{
+		{
+			name:       "user with groups",
+			subject:    &auth.SubjectContext{ID: "alice", EntitlementClaim: "groups", EntitlementValues: []string{"dev", "ops"}},
+			wantUser:   "oidc:alice",
+			wantGroups: []string{"oidc:dev", "oidc:ops"},
+		},
+		{
+			name:     "other entitlement claim",
+			subject:  &auth.SubjectContext{ID: "ci-bot", EntitlementClaim: "scopes", EntitlementValues: []string{"deploy"}},
+			wantUser: "oidc:ci-bot",
+		},
+		{
+			name: "no subject",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := &recordingTransport{}
+			rt := &impersonatingTransport{opts: opts, next: next}
+
+			ctx := context.Background()
+			if tt.subject != nil {
+				ctx = auth.SetSubjectContext(ctx, tt.subject)
+			}
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://kubernetes.default/api", nil)
+			require.NoError(t, err)
+
+			_, err = rt.RoundTrip(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantUser, next.req.Header.Get("Impersonate-User"))
+			assert.Equal(t, tt.wantGroups, next.req.Header.Values("Impersonate-Group"))
+			assert.Empty(t, req.Header.Get("Impersonate-User"), "the caller's request must not be modified")
+		})
+	}
+}
+
+// TestImpersonatingClient_ReadsReachAPIServer checks that gets are sent to the
+// API server as the subject, rather than served from a cache with the API
+// server's own identity.
+func TestImpersonatingClient_ReadsReachAPIServer(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		users []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var body any
+		switch r.URL.Path {
+		case "/api":
+			body = metav1.APIVersions{TypeMeta: metav1.TypeMeta{Kind: "APIVersions"}, Versions: []string{"v1"}}
+		case "/apis":
+			body = metav1.APIGroupList{TypeMeta: metav1.TypeMeta{Kind: "APIGroupList", APIVersion: "v1"}}
+		case "/api/v1":
+			body = metav1.APIResourceList{
+				TypeMeta:     metav1.TypeMeta{Kind: "APIResourceList", APIVersion: "v1"},
+				GroupVersion: "v1",
+				APIResources: []metav1.APIResource{{Name: "secrets", Namespaced: true, Kind: "Secret", Verbs: []string{"get", "list"}}},
+			}
+		case "/api/v1/namespaces/ns-1/secrets/db":
+			mu.Lock()
+			users = append(users, r.Header.Get("Impersonate-User"))
+			mu.Unlock()
+			body = corev1.Secret{
+				TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "ns-1"},
+			}
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	defer srv.Close()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	opts := ImpersonationOptions{UsernamePrefix: "oidc:", GroupsPrefix: "oidc:", GroupsClaim: "groups"}
+	c, err := newImpersonatingClient(&rest.Config{Host: srv.URL}, scheme, opts)
+	require.NoError(t, err)
+
+	ctx := auth.SetSubjectContext(context.Background(), &auth.SubjectContext{ID: "alice"})
+	for range 2 {
+		require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "db", Namespace: "ns-1"}, &corev1.Secret{}))
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"oidc:alice", "oidc:alice"}, users)
+}
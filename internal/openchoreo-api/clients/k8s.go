@@ -5,32 +5,146 @@ package k8s
 
 import (
 	"fmt"
+	"net/http"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery"
+	admissionv1client "k8s.io/client-go/kubernetes/typed/admissionregistration/v1"
+	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/tracing"
 )
 
-func NewK8sClient() (client.Client, error) {
+// ReadCacheOptions configures the informer cache of NewCachedK8sClient.
+type ReadCacheOptions struct {
+	// ResyncInterval is how often the informers resync. 0 uses the
+	// controller-runtime default.
+	ResyncInterval time.Duration
+}
+
+// NewK8sClient creates a Kubernetes client for the control plane cluster. The
+// client also supports watches, which back the resource watch endpoint.
+func NewK8sClient() (client.WithWatch, error) {
+	config, scheme, err := newConfigAndScheme()
+	if err != nil {
+		return nil, err
+	}
+	return client.NewWithWatch(config, client.Options{Scheme: scheme})
+}
+
+// NewCachedK8sClient creates a client like NewK8sClient whose get and list
+// calls are served from a shared informer cache, so read-heavy traffic does not
+// reach the API server. Cached lists still honor limit and continue options.
+// Writes and watches go to the API server directly, and Secrets, ConfigMaps and
+// Pods are never cached.
+//
+// An informer is started for a kind on its first read, which blocks until the
+// informer has synced. The returned cache must be started before the client is
+// used and stops when the context passed to Start is done.
+func NewCachedK8sClient(opts ReadCacheOptions) (client.WithWatch, cache.Cache, error) {
+	config, scheme, err := newConfigAndScheme()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cacheOpts := cache.Options{
+		Scheme:           scheme,
+		DefaultTransform: cache.TransformStripManagedFields(),
+	}
+	if opts.ResyncInterval > 0 {
+		cacheOpts.SyncPeriod = &opts.ResyncInterval
+	}
+	readCache, err := cache.New(config, cacheOpts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create informer cache: %w", err)
+	}
+
+	k8sClient, err := newCachedClient(config, scheme, readCache)
+	if err != nil {
+		return nil, nil, err
+	}
+	return k8sClient, readCache, nil
+}
+
+// NewImpersonatingK8sClient creates a client like NewK8sClient that sends each
+// request as the authenticated subject of its context, so that Kubernetes RBAC
+// constrains what the subject can do. The API server's service account needs
+// permission to impersonate users and groups.
+//
+// The client never reads from the informer cache, since cached reads would be
+// served with the API server's own identity rather than the subject's.
+func NewImpersonatingK8sClient(opts ImpersonationOptions) (client.WithWatch, error) {
+	config, scheme, err := newConfigAndScheme()
+	if err != nil {
+		return nil, err
+	}
+	return newImpersonatingClient(config, scheme, opts)
+}
+
+func newImpersonatingClient(config *rest.Config, scheme *runtime.Scheme, opts ImpersonationOptions) (client.WithWatch, error) {
+	config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &impersonatingTransport{opts: opts, next: rt}
+	})
+	return client.NewWithWatch(config, client.Options{Scheme: scheme})
+}
+
+func newCachedClient(config *rest.Config, scheme *runtime.Scheme, readCache cache.Cache) (client.WithWatch, error) {
+	return client.NewWithWatch(config, client.Options{
+		Scheme: scheme,
+		Cache: &client.CacheOptions{
+			Reader:     &paginatingReader{cache: readCache},
+			DisableFor: []client.Object{&corev1.Secret{}, &corev1.ConfigMap{}, &corev1.Pod{}},
+		},
+	})
+}
+
+// NewDiscoveryClient creates a discovery client for the control plane cluster,
+// used to check API server connectivity and which CRDs are installed.
+func NewDiscoveryClient() (discovery.DiscoveryInterface, error) {
+	config, _, err := newConfigAndScheme()
+	if err != nil {
+		return nil, err
+	}
+	return discovery.NewDiscoveryClientForConfig(config)
+}
+
+// NewAdmissionRegistrationClient creates a client for the admission webhook
+// configurations of the control plane cluster, used to check the certificates
+// of the OpenChoreo webhooks.
+func NewAdmissionRegistrationClient() (admissionv1client.AdmissionregistrationV1Interface, error) {
+	config, _, err := newConfigAndScheme()
+	if err != nil {
+		return nil, err
+	}
+	return admissionv1client.NewForConfig(config)
+}
+
+func newConfigAndScheme() (*rest.Config, *runtime.Scheme, error) {
 	config, err := ctrl.GetConfig()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create kubernetes config: %w", err)
+		return nil, nil, fmt.Errorf("failed to create kubernetes config: %w", err)
 	}
 
+	// Trace every request to the Kubernetes API server
+	config.Wrap(tracing.WrapTransport("k8s"))
+
 	scheme := runtime.NewScheme()
 
 	// Add core Kubernetes types (Secret, ConfigMap, etc.)
 	if err := corev1.AddToScheme(scheme); err != nil {
-		return nil, fmt.Errorf("failed to add core v1 scheme: %w", err)
+		return nil, nil, fmt.Errorf("failed to add core v1 scheme: %w", err)
 	}
 
 	// Add OpenChoreo custom types
 	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
-		return nil, fmt.Errorf("failed to add OpenChoreo scheme: %w", err)
+		return nil, nil, fmt.Errorf("failed to add OpenChoreo scheme: %w", err)
 	}
 
-	return client.New(config, client.Options{Scheme: scheme})
+	return config, scheme, nil
 }
@@ -0,0 +1,68 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/config"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/apitoken"
+)
+
+// APITokensConfig defines the scoped API tokens that non-interactive clients,
+// such as CI pipelines, authenticate with instead of OIDC.
+type APITokensConfig struct {
+	// Enabled allows authenticated users to issue API tokens and accepts them
+	// as bearer tokens. Requires security to be enabled.
+	Enabled bool `koanf:"enabled"`
+	// Namespace is the Kubernetes namespace the hashed tokens are stored in as
+	// Secrets.
+	Namespace string `koanf:"namespace"`
+	// DefaultTTL is the lifetime of tokens issued without an expiry.
+	DefaultTTL time.Duration `koanf:"default_ttl"`
+	// MaxTTL is the longest lifetime a token may be issued with.
+	MaxTTL time.Duration `koanf:"max_ttl"`
+}
+
+// APITokensDefaults returns the default API token configuration.
+func APITokensDefaults() APITokensConfig {
+	return APITokensConfig{
+		Enabled:    false,
+		DefaultTTL: 30 * 24 * time.Hour,
+		MaxTTL:     90 * 24 * time.Hour,
+	}
+}
+
+// Validate validates the API token configuration.
+func (c *APITokensConfig) Validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+
+	if !c.Enabled {
+		return errs
+	}
+
+	if err := config.MustNotBeEmpty(path.Child("namespace"), c.Namespace); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.MustBeGreaterThan(path.Child("default_ttl"), c.DefaultTTL, 0); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.MustBeGreaterThan(path.Child("max_ttl"), c.MaxTTL, 0); err != nil {
+		errs = append(errs, err)
+	}
+	if c.DefaultTTL > c.MaxTTL {
+		errs = append(errs, config.Invalid(path.Child("default_ttl"), "must not be greater than max_ttl"))
+	}
+
+	return errs
+}
+
+// ToOptions converts to the API token service options.
+func (c *APITokensConfig) ToOptions() *apitoken.Options {
+	return &apitoken.Options{
+		Namespace:  c.Namespace,
+		DefaultTTL: c.DefaultTTL,
+		MaxTTL:     c.MaxTTL,
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openchoreo/openchoreo/internal/config"
+)
+
+func TestAPITokensConfig_Validate(t *testing.T) {
+	enabled := func(mutate func(*APITokensConfig)) APITokensConfig {
+		cfg := APITokensDefaults()
+		cfg.Enabled = true
+		cfg.Namespace = "openchoreo-control-plane"
+		mutate(&cfg)
+		return cfg
+	}
+
+	tests := []struct {
+		name           string
+		cfg            APITokensConfig
+		expectedErrors config.ValidationErrors
+	}{
+		{
+			name:           "defaults are valid",
+			cfg:            APITokensDefaults(),
+			expectedErrors: nil,
+		},
+		{
+			name:           "enabled with namespace is valid",
+			cfg:            enabled(func(*APITokensConfig) {}),
+			expectedErrors: nil,
+		},
+		{
+			name: "missing namespace",
+			cfg:  enabled(func(c *APITokensConfig) { c.Namespace = "" }),
+			expectedErrors: config.ValidationErrors{
+				{Field: "api_tokens.namespace", Message: "must not be empty"},
+			},
+		},
+		{
+			name: "non-positive ttls",
+			cfg:  enabled(func(c *APITokensConfig) { c.DefaultTTL = 0; c.MaxTTL = 0 }),
+			expectedErrors: config.ValidationErrors{
+				{Field: "api_tokens.default_ttl", Message: "must be greater than 0s"},
+				{Field: "api_tokens.max_ttl", Message: "must be greater than 0s"},
+			},
+		},
+		{
+			name: "default ttl greater than max ttl",
+			cfg:  enabled(func(c *APITokensConfig) { c.DefaultTTL = 2 * time.Hour; c.MaxTTL = time.Hour }),
+			expectedErrors: config.ValidationErrors{
+				{Field: "api_tokens.default_ttl", Message: "must not be greater than max_ttl"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.cfg.Validate(config.NewPath("api_tokens"))
+			if diff := cmp.Diff(tt.expectedErrors, errs); diff != "" {
+				t.Errorf("validation errors mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/config"
+)
+
+// AuditConfig defines audit logging of state-modifying API operations.
+type AuditConfig struct {
+	// Enabled enables audit logging.
+	Enabled bool `koanf:"enabled"`
+	// Sinks defines additional destinations for audit events. Events are always
+	// emitted to the application log.
+	Sinks AuditSinksConfig `koanf:"sinks"`
+	// QueryBufferSize is the number of recent events kept in memory for the
+	// audit events query endpoint. 0 disables the endpoint.
+	QueryBufferSize int `koanf:"query_buffer_size"`
+}
+
+// AuditSinksConfig defines the destinations audit events are written to.
+type AuditSinksConfig struct {
+	// Stdout writes each event as a JSON line to standard output.
+	Stdout bool `koanf:"stdout"`
+	// File appends each event as a JSON line to a file.
+	File AuditFileSinkConfig `koanf:"file"`
+	// Webhook posts each event as JSON to an HTTP endpoint.
+	Webhook AuditWebhookSinkConfig `koanf:"webhook"`
+}
+
+// AuditFileSinkConfig defines the file audit sink.
+type AuditFileSinkConfig struct {
+	// Path is the file audit events are appended to. Empty disables the sink.
+	Path string `koanf:"path"`
+}
+
+// AuditWebhookSinkConfig defines the webhook audit sink.
+type AuditWebhookSinkConfig struct {
+	// URL is the endpoint audit events are posted to. Empty disables the sink.
+	URL string `koanf:"url"`
+	// Timeout is the maximum duration of a single delivery.
+	Timeout time.Duration `koanf:"timeout"`
+}
+
+// AuditDefaults returns the default audit configuration.
+func AuditDefaults() AuditConfig {
+	return AuditConfig{
+		Enabled: true,
+		Sinks: AuditSinksConfig{
+			Webhook: AuditWebhookSinkConfig{
+				Timeout: 5 * time.Second,
+			},
+		},
+		QueryBufferSize: 1000,
+	}
+}
+
+// Validate validates the audit configuration.
+func (c *AuditConfig) Validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+
+	if !c.Enabled {
+		return errs
+	}
+
+	if err := config.MustBeNonNegative(path.Child("query_buffer_size"), c.QueryBufferSize); err != nil {
+		errs = append(errs, err)
+	}
+
+	webhookPath := path.Child("sinks").Child("webhook")
+	if c.Sinks.Webhook.URL != "" {
+		if u, err := url.Parse(c.Sinks.Webhook.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, config.Invalid(webhookPath.Child("url"), "must be an absolute http or https URL"))
+		}
+		if err := config.MustBeGreaterThan(webhookPath.Child("timeout"), c.Sinks.Webhook.Timeout, 0); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openchoreo/openchoreo/internal/config"
+)
+
+func TestAuditConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name           string
+		cfg            AuditConfig
+		expectedErrors config.ValidationErrors
+	}{
+		{
+			name:           "defaults are valid",
+			cfg:            AuditDefaults(),
+			expectedErrors: nil,
+		},
+		{
+			name: "disabled config is not validated",
+			cfg: AuditConfig{
+				Enabled:         false,
+				QueryBufferSize: -1,
+				Sinks:           AuditSinksConfig{Webhook: AuditWebhookSinkConfig{URL: "not a url"}},
+			},
+			expectedErrors: nil,
+		},
+		{
+			name: "valid webhook sink",
+			cfg: AuditConfig{
+				Enabled: true,
+				Sinks:   AuditSinksConfig{Webhook: AuditWebhookSinkConfig{URL: "https://audit.example.com/events", Timeout: 1}},
+			},
+			expectedErrors: nil,
+		},
+		{
+			name: "invalid values",
+			cfg: AuditConfig{
+				Enabled:         true,
+				QueryBufferSize: -1,
+				Sinks:           AuditSinksConfig{Webhook: AuditWebhookSinkConfig{URL: "audit.example.com"}},
+			},
+			expectedErrors: config.ValidationErrors{
+				{Field: "audit.query_buffer_size", Message: "must be non-negative"},
+				{Field: "audit.sinks.webhook.url", Message: "must be an absolute http or https URL"},
+				{Field: "audit.sinks.webhook.timeout", Message: "must be greater than 0s"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.cfg.Validate(config.NewPath("audit"))
+			if diff := cmp.Diff(tt.expectedErrors, errs); diff != "" {
+				t.Errorf("validation errors mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/config"
+	k8s "github.com/openchoreo/openchoreo/internal/openchoreo-api/clients"
+)
+
+// CacheConfig defines the informer cache that serves Kubernetes reads.
+type CacheConfig struct {
+	// Enabled serves get and list calls for OpenChoreo resources and namespaces
+	// from a shared informer cache instead of the API server.
+	Enabled bool `koanf:"enabled"`
+	// ResyncInterval is how often the informers resync. 0 uses the
+	// controller-runtime default.
+	ResyncInterval time.Duration `koanf:"resync_interval"`
+}
+
+// CacheDefaults returns the default cache configuration.
+func CacheDefaults() CacheConfig {
+	return CacheConfig{
+		Enabled:        false,
+		ResyncInterval: 10 * time.Hour,
+	}
+}
+
+// Validate validates the cache configuration.
+func (c *CacheConfig) Validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+
+	if !c.Enabled {
+		return errs
+	}
+
+	if err := config.MustBeNonNegative(path.Child("resync_interval"), c.ResyncInterval); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// ToReadCacheOptions converts to the Kubernetes client cache options.
+func (c *CacheConfig) ToReadCacheOptions() k8s.ReadCacheOptions {
+	return k8s.ReadCacheOptions{
+		ResyncInterval: c.ResyncInterval,
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openchoreo/openchoreo/internal/config"
+)
+
+func TestCacheConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name           string
+		cfg            CacheConfig
+		expectedErrors config.ValidationErrors
+	}{
+		{
+			name:           "defaults are valid",
+			cfg:            CacheDefaults(),
+			expectedErrors: nil,
+		},
+		{
+			name:           "disabled config is not validated",
+			cfg:            CacheConfig{Enabled: false, ResyncInterval: -time.Minute},
+			expectedErrors: nil,
+		},
+		{
+			name:           "zero resync interval uses the default",
+			cfg:            CacheConfig{Enabled: true},
+			expectedErrors: nil,
+		},
+		{
+			name: "negative resync interval",
+			cfg:  CacheConfig{Enabled: true, ResyncInterval: -time.Minute},
+			expectedErrors: config.ValidationErrors{
+				{Field: "cache.resync_interval", Message: "must be non-negative"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.cfg.Validate(config.NewPath("cache"))
+			if diff := cmp.Diff(tt.expectedErrors, errs); diff != "" {
+				t.Errorf("validation errors mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -15,6 +15,8 @@ import (
 type Config struct {
 	// Server defines HTTP server settings.
 	Server ServerConfig `koanf:"server"`
+	// GRPC defines gRPC server settings.
+	GRPC GRPCConfig `koanf:"grpc"`
 	// Security defines authentication and authorization settings.
 	Security SecurityConfig `koanf:"security"`
 	// Identity defines identity provider settings.
@@ -27,18 +29,46 @@ type Config struct {
 	Logging LoggingConfig `koanf:"logging"`
 	// ClusterGateway defines cluster gateway connection settings.
 	ClusterGateway ClusterGatewayConfig `koanf:"cluster_gateway"`
+	// Audit defines audit logging settings.
+	Audit AuditConfig `koanf:"audit"`
+	// Tracing defines OpenTelemetry tracing settings.
+	Tracing TracingConfig `koanf:"tracing"`
+	// Cache defines the informer cache that serves Kubernetes reads.
+	Cache CacheConfig `koanf:"cache"`
+	// Health defines the dependency checks of the health endpoints.
+	Health HealthConfig `koanf:"health"`
+	// Impersonation defines Kubernetes impersonation of the calling user.
+	Impersonation ImpersonationConfig `koanf:"impersonation"`
+	// OutboundWebhooks defines the webhooks notified of resource events.
+	OutboundWebhooks OutboundWebhooksConfig `koanf:"outbound_webhooks"`
+	// GitOpsExport defines the scheduled export of resources to a Git repository.
+	GitOpsExport GitOpsExportConfig `koanf:"gitops_export"`
+	// APITokens defines the scoped API tokens of non-interactive clients.
+	APITokens APITokensConfig `koanf:"api_tokens"`
+	// Cost defines the cost reports and estimates of data plane resources.
+	Cost CostConfig `koanf:"cost"`
 }
 
 // Defaults returns the default configuration.
 func Defaults() Config {
 	return Config{
 		Server:           ServerDefaults(),
+		GRPC:             GRPCDefaults(),
 		Security:         SecurityDefaults(),
 		Identity:         IdentityDefaults(),
 		MCP:              MCPDefaults(),
 		SecretManagement: SecretManagementDefaults(),
 		Logging:          LoggingDefaults(),
 		ClusterGateway:   ClusterGatewayDefaults(),
+		Audit:            AuditDefaults(),
+		Tracing:          TracingDefaults(),
+		Cache:            CacheDefaults(),
+		Health:           HealthDefaults(),
+		Impersonation:    ImpersonationDefaults(),
+		OutboundWebhooks: OutboundWebhooksDefaults(),
+		GitOpsExport:     GitOpsExportDefaults(),
+		APITokens:        APITokensDefaults(),
+		Cost:             CostDefaults(),
 	}
 }
 
@@ -79,11 +109,30 @@ func (c *Config) Validate() error {
 	var errs coreconfig.ValidationErrors
 
 	errs = append(errs, c.Server.Validate(coreconfig.NewPath("server"))...)
+	errs = append(errs, c.GRPC.Validate(coreconfig.NewPath("grpc"))...)
 	errs = append(errs, c.Security.Validate(coreconfig.NewPath("security"))...)
 	errs = append(errs, c.Identity.Validate(coreconfig.NewPath("identity"))...)
 	errs = append(errs, c.MCP.ValidateMCPConfig(coreconfig.NewPath("mcp"))...)
 	errs = append(errs, c.Logging.Validate(coreconfig.NewPath("logging"))...)
 	errs = append(errs, c.ClusterGateway.Validate(coreconfig.NewPath("cluster_gateway"))...)
+	errs = append(errs, c.Audit.Validate(coreconfig.NewPath("audit"))...)
+	errs = append(errs, c.Tracing.Validate(coreconfig.NewPath("tracing"))...)
+	errs = append(errs, c.Cache.Validate(coreconfig.NewPath("cache"))...)
+	errs = append(errs, c.Health.Validate(coreconfig.NewPath("health"))...)
+	errs = append(errs, c.Impersonation.Validate(coreconfig.NewPath("impersonation"))...)
+	errs = append(errs, c.OutboundWebhooks.Validate(coreconfig.NewPath("outbound_webhooks"))...)
+	errs = append(errs, c.GitOpsExport.Validate(coreconfig.NewPath("gitops_export"))...)
+	errs = append(errs, c.APITokens.Validate(coreconfig.NewPath("api_tokens"))...)
+	errs = append(errs, c.Cost.Validate(coreconfig.NewPath("cost"))...)
+
+	// Impersonation needs an authenticated subject to impersonate.
+	if c.Impersonation.Enabled && !c.Security.Enabled {
+		errs = append(errs, coreconfig.Invalid(coreconfig.NewPath("impersonation").Child("enabled"), "requires security.enabled"))
+	}
+	// API tokens carry the identity of the user who issued them.
+	if c.APITokens.Enabled && !c.Security.Enabled {
+		errs = append(errs, coreconfig.Invalid(coreconfig.NewPath("api_tokens").Child("enabled"), "requires security.enabled"))
+	}
 
 	return errs.OrNil()
 }
@@ -0,0 +1,96 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/config"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/cost"
+)
+
+// CostConfig defines where actual data plane costs are read from and the
+// prices cost estimates are computed with.
+type CostConfig struct {
+	// OpenCostURL is the base URL of the OpenCost API, which computes actual
+	// costs from the Prometheus metrics of the data planes. Empty disables
+	// cost reports.
+	OpenCostURL string `koanf:"opencost_url"`
+	// Timeout bounds each OpenCost query.
+	Timeout time.Duration `koanf:"timeout"`
+	// Pricing defines the unit prices of estimates.
+	Pricing CostPricingConfig `koanf:"pricing"`
+}
+
+// CostPricingConfig defines the unit prices of cost estimates. They should
+// match the prices OpenCost is configured with.
+type CostPricingConfig struct {
+	// Currency is the ISO 4217 code costs are reported in.
+	Currency string `koanf:"currency"`
+	// CPUCoreHour is the price of one CPU core for an hour.
+	CPUCoreHour float64 `koanf:"cpu_core_hour"`
+	// MemoryGiBHour is the price of one GiB of memory for an hour.
+	MemoryGiBHour float64 `koanf:"memory_gib_hour"`
+	// StorageGiBMonth is the price of one GiB of persistent storage for a month.
+	StorageGiBMonth float64 `koanf:"storage_gib_month"`
+}
+
+// CostDefaults returns the default cost configuration. The prices are the
+// OpenCost defaults for clusters without cloud pricing.
+func CostDefaults() CostConfig {
+	return CostConfig{
+		Timeout: 30 * time.Second,
+		Pricing: CostPricingConfig{
+			Currency:        "USD",
+			CPUCoreHour:     0.031611,
+			MemoryGiBHour:   0.004237,
+			StorageGiBMonth: 0.04,
+		},
+	}
+}
+
+// Validate validates the cost configuration.
+func (c *CostConfig) Validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+
+	if c.OpenCostURL != "" {
+		if u, err := url.Parse(c.OpenCostURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, config.Invalid(path.Child("opencost_url"), "must be an absolute http or https URL"))
+		}
+		if err := config.MustBeGreaterThan(path.Child("timeout"), c.Timeout, 0); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	pricingPath := path.Child("pricing")
+	if err := config.MustNotBeEmpty(pricingPath.Child("currency"), c.Pricing.Currency); err != nil {
+		errs = append(errs, err)
+	}
+	if c.Pricing.CPUCoreHour < 0 {
+		errs = append(errs, config.Invalid(pricingPath.Child("cpu_core_hour"), "must not be negative"))
+	}
+	if c.Pricing.MemoryGiBHour < 0 {
+		errs = append(errs, config.Invalid(pricingPath.Child("memory_gib_hour"), "must not be negative"))
+	}
+	if c.Pricing.StorageGiBMonth < 0 {
+		errs = append(errs, config.Invalid(pricingPath.Child("storage_gib_month"), "must not be negative"))
+	}
+
+	return errs
+}
+
+// ToOptions converts to the cost service options.
+func (c *CostConfig) ToOptions() cost.Options {
+	return cost.Options{
+		OpenCostURL: c.OpenCostURL,
+		Timeout:     c.Timeout,
+		Pricing: cost.Pricing{
+			Currency:        c.Pricing.Currency,
+			CPUCoreHour:     c.Pricing.CPUCoreHour,
+			MemoryGiBHour:   c.Pricing.MemoryGiBHour,
+			StorageGiBMonth: c.Pricing.StorageGiBMonth,
+		},
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openchoreo/openchoreo/internal/config"
+)
+
+func TestCostConfig_Validate(t *testing.T) {
+	withOpenCost := func(url string) CostConfig {
+		cfg := CostDefaults()
+		cfg.OpenCostURL = url
+		return cfg
+	}
+
+	tests := []struct {
+		name           string
+		cfg            CostConfig
+		expectedErrors config.ValidationErrors
+	}{
+		{
+			name:           "defaults are valid",
+			cfg:            CostDefaults(),
+			expectedErrors: nil,
+		},
+		{
+			name:           "opencost url",
+			cfg:            withOpenCost("http://opencost.opencost:9003"),
+			expectedErrors: nil,
+		},
+		{
+			name: "relative opencost url",
+			cfg:  withOpenCost("opencost:9003"),
+			expectedErrors: config.ValidationErrors{
+				{Field: "cost.opencost_url", Message: "must be an absolute http or https URL"},
+			},
+		},
+		{
+			name: "zero timeout with opencost",
+			cfg: func() CostConfig {
+				cfg := withOpenCost("http://opencost:9003")
+				cfg.Timeout = 0
+				return cfg
+			}(),
+			expectedErrors: config.ValidationErrors{
+				{Field: "cost.timeout", Message: "must be greater than 0s"},
+			},
+		},
+		{
+			name: "invalid pricing",
+			cfg: CostConfig{
+				Pricing: CostPricingConfig{CPUCoreHour: -1, StorageGiBMonth: -0.5},
+			},
+			expectedErrors: config.ValidationErrors{
+				{Field: "cost.pricing.currency", Message: "must not be empty"},
+				{Field: "cost.pricing.cpu_core_hour", Message: "must not be negative"},
+				{Field: "cost.pricing.storage_gib_month", Message: "must not be negative"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.cfg.Validate(config.NewPath("cost"))
+			if diff := cmp.Diff(tt.expectedErrors, errs); diff != "" {
+				t.Errorf("validation errors mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/config"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/gitopsexport"
+)
+
+// GitOpsExportConfig defines the scheduled export of control plane resources
+// as a kustomize-compatible directory, optionally pushed to a Git repository.
+type GitOpsExportConfig struct {
+	// Enabled turns on the export.
+	Enabled bool `koanf:"enabled"`
+	// Interval is the time between exports.
+	Interval time.Duration `koanf:"interval"`
+	// Namespaces are the namespaces exported.
+	Namespaces []string `koanf:"namespaces"`
+	// Projects, when set, limits the export to these projects. Namespace-level
+	// platform resources are then left out.
+	Projects []string `koanf:"projects"`
+	// Directory is where the export is written, or the working copy of the
+	// repository when one is configured.
+	Directory string `koanf:"directory"`
+	// Repository is the Git repository the export is pushed to.
+	Repository GitOpsRepositoryConfig `koanf:"repository"`
+}
+
+// GitOpsRepositoryConfig defines the Git repository an export is pushed to.
+type GitOpsRepositoryConfig struct {
+	// URL is the remote the export is pushed to. Empty only writes the
+	// directory. Pushing needs the git CLI in the image.
+	URL string `koanf:"url"`
+	// Branch is the branch the export is committed to.
+	Branch string `koanf:"branch"`
+	// Path is the directory of the export within the repository.
+	Path string `koanf:"path"`
+	// AuthorName is the author name of export commits.
+	AuthorName string `koanf:"author_name"`
+	// AuthorEmail is the author email of export commits.
+	AuthorEmail string `koanf:"author_email"`
+	// TokenFile is a file the token for HTTPS remotes is read from.
+	TokenFile string `koanf:"token_file"`
+}
+
+// GitOpsExportDefaults returns the default GitOps export configuration.
+func GitOpsExportDefaults() GitOpsExportConfig {
+	return GitOpsExportConfig{
+		Enabled:   false,
+		Interval:  10 * time.Minute,
+		Directory: "/tmp/openchoreo-gitops-export",
+		Repository: GitOpsRepositoryConfig{
+			Branch:      "main",
+			AuthorName:  "OpenChoreo",
+			AuthorEmail: "gitops-export@openchoreo.dev",
+		},
+	}
+}
+
+// Validate validates the GitOps export configuration.
+func (c *GitOpsExportConfig) Validate(p *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+
+	if !c.Enabled {
+		return errs
+	}
+
+	if err := config.MustBeGreaterThan(p.Child("interval"), c.Interval, 0); err != nil {
+		errs = append(errs, err)
+	}
+	if len(c.Namespaces) == 0 {
+		errs = append(errs, config.Required(p.Child("namespaces")))
+	}
+	if err := config.MustNotBeEmpty(p.Child("directory"), c.Directory); err != nil {
+		errs = append(errs, err)
+	}
+
+	repo := &c.Repository
+	repoPath := p.Child("repository")
+	if repo.URL == "" {
+		return errs
+	}
+	if u, err := url.Parse(repo.URL); err != nil || u.Scheme == "" || u.Host == "" {
+		errs = append(errs, config.Invalid(repoPath.Child("url"), "must be an absolute URL"))
+	}
+	if err := config.MustNotBeEmpty(repoPath.Child("branch"), repo.Branch); err != nil {
+		errs = append(errs, err)
+	}
+	if repo.Path != "" && (path.IsAbs(repo.Path) || strings.HasPrefix(path.Clean(repo.Path), "..")) {
+		errs = append(errs, config.Invalid(repoPath.Child("path"), "must be a relative path within the repository"))
+	}
+	if err := config.MustNotBeEmpty(repoPath.Child("author_name"), repo.AuthorName); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.MustNotBeEmpty(repoPath.Child("author_email"), repo.AuthorEmail); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// ToOptions converts to the runner options, reading the repository token file.
+func (c *GitOpsExportConfig) ToOptions() (gitopsexport.Options, error) {
+	opts := gitopsexport.Options{
+		Scope: gitopsexport.Scope{
+			Namespaces: c.Namespaces,
+			Projects:   c.Projects,
+		},
+		Interval:  c.Interval,
+		Directory: c.Directory,
+	}
+	if c.Repository.URL == "" {
+		return opts, nil
+	}
+	repo := &gitopsexport.Repository{
+		URL:         c.Repository.URL,
+		Branch:      c.Repository.Branch,
+		Path:        path.Clean(c.Repository.Path),
+		AuthorName:  c.Repository.AuthorName,
+		AuthorEmail: c.Repository.AuthorEmail,
+	}
+	if repo.Path == "." {
+		repo.Path = ""
+	}
+	if c.Repository.TokenFile != "" {
+		data, err := os.ReadFile(c.Repository.TokenFile)
+		if err != nil {
+			return gitopsexport.Options{}, fmt.Errorf("failed to read GitOps repository token: %w", err)
+		}
+		repo.Token = strings.TrimSpace(string(data))
+	}
+	opts.Repository = repo
+	return opts, nil
+}
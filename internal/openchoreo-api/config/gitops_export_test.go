@@ -0,0 +1,133 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openchoreo/openchoreo/internal/config"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/gitopsexport"
+)
+
+func TestGitOpsExportConfig_Validate(t *testing.T) {
+	enabled := func() GitOpsExportConfig {
+		c := GitOpsExportDefaults()
+		c.Enabled = true
+		c.Namespaces = []string{"default"}
+		return c
+	}
+
+	tests := []struct {
+		name           string
+		cfg            GitOpsExportConfig
+		expectedErrors config.ValidationErrors
+	}{
+		{
+			name:           "defaults are valid",
+			cfg:            GitOpsExportDefaults(),
+			expectedErrors: nil,
+		},
+		{
+			name:           "disabled skips validation",
+			cfg:            GitOpsExportConfig{Interval: -1},
+			expectedErrors: nil,
+		},
+		{
+			name:           "directory only",
+			cfg:            enabled(),
+			expectedErrors: nil,
+		},
+		{
+			name: "valid repository",
+			cfg: func() GitOpsExportConfig {
+				c := enabled()
+				c.Repository.URL = "https://github.com/acme/platform.git"
+				c.Repository.Path = "clusters/prod"
+				return c
+			}(),
+			expectedErrors: nil,
+		},
+		{
+			name: "invalid export settings",
+			cfg:  GitOpsExportConfig{Enabled: true},
+			expectedErrors: config.ValidationErrors{
+				{Field: "gitops_export.interval", Message: "must be greater than 0s"},
+				{Field: "gitops_export.namespaces", Message: "is required"},
+				{Field: "gitops_export.directory", Message: "must not be empty"},
+			},
+		},
+		{
+			name: "invalid repository",
+			cfg: func() GitOpsExportConfig {
+				c := enabled()
+				c.Repository = GitOpsRepositoryConfig{URL: "platform.git", Path: "../outside"}
+				return c
+			}(),
+			expectedErrors: config.ValidationErrors{
+				{Field: "gitops_export.repository.url", Message: "must be an absolute URL"},
+				{Field: "gitops_export.repository.branch", Message: "must not be empty"},
+				{Field: "gitops_export.repository.path", Message: "must be a relative path within the repository"},
+				{Field: "gitops_export.repository.author_name", Message: "must not be empty"},
+				{Field: "gitops_export.repository.author_email", Message: "must not be empty"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.cfg.Validate(config.NewPath("gitops_export"))
+			if diff := cmp.Diff(tt.expectedErrors, errs); diff != "" {
+				t.Errorf("validation errors mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGitOpsExportConfig_ToOptions(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("s3cret\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	c := GitOpsExportDefaults()
+	c.Namespaces = []string{"default"}
+	c.Projects = []string{"shop"}
+	c.Repository.URL = "https://github.com/acme/platform.git"
+	c.Repository.Path = "./clusters/prod/"
+	c.Repository.TokenFile = tokenFile
+
+	opts, err := c.ToOptions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := gitopsexport.Options{
+		Scope:     gitopsexport.Scope{Namespaces: []string{"default"}, Projects: []string{"shop"}},
+		Interval:  10 * time.Minute,
+		Directory: "/tmp/openchoreo-gitops-export",
+		Repository: &gitopsexport.Repository{
+			URL:         "https://github.com/acme/platform.git",
+			Branch:      "main",
+			Path:        "clusters/prod",
+			AuthorName:  "OpenChoreo",
+			AuthorEmail: "gitops-export@openchoreo.dev",
+			Token:       "s3cret",
+		},
+	}
+	if diff := cmp.Diff(want, opts); diff != "" {
+		t.Errorf("options mismatch (-want +got):\n%s", diff)
+	}
+
+	c.Repository.URL = ""
+	opts, err = c.ToOptions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts.Repository != nil {
+		t.Errorf("expected no repository, got %+v", opts.Repository)
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"github.com/openchoreo/openchoreo/internal/config"
+)
+
+// GRPCConfig defines the gRPC server that serves the resource and schema APIs
+// alongside the REST API. It shares the bind address and TLS settings of the
+// HTTP server.
+type GRPCConfig struct {
+	// Enabled starts the gRPC server.
+	Enabled bool `koanf:"enabled"`
+	// Port is the gRPC server port.
+	Port int `koanf:"port"`
+}
+
+// GRPCDefaults returns the default gRPC server configuration.
+func GRPCDefaults() GRPCConfig {
+	return GRPCConfig{
+		Enabled: false,
+		Port:    9090,
+	}
+}
+
+// Validate validates the gRPC server configuration.
+func (c *GRPCConfig) Validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+
+	if !c.Enabled {
+		return errs
+	}
+
+	if err := config.MustBeInRange(path.Child("port"), c.Port, 1, 65535); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
@@ -0,0 +1,47 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openchoreo/openchoreo/internal/config"
+)
+
+func TestGRPCConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name           string
+		cfg            GRPCConfig
+		expectedErrors config.ValidationErrors
+	}{
+		{
+			name:           "defaults are valid",
+			cfg:            GRPCDefaults(),
+			expectedErrors: nil,
+		},
+		{
+			name:           "disabled skips validation",
+			cfg:            GRPCConfig{Enabled: false, Port: 0},
+			expectedErrors: nil,
+		},
+		{
+			name: "enabled with invalid port",
+			cfg:  GRPCConfig{Enabled: true, Port: 70000},
+			expectedErrors: config.ValidationErrors{
+				{Field: "grpc.port", Message: "must be between 1 and 65535"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.cfg.Validate(config.NewPath("grpc"))
+			if diff := cmp.Diff(tt.expectedErrors, errs); diff != "" {
+				t.Errorf("validation errors mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/config"
+)
+
+// HealthConfig defines the dependency checks behind the /healthz and /readyz endpoints.
+type HealthConfig struct {
+	// CheckTimeout bounds each dependency check.
+	CheckTimeout time.Duration `koanf:"check_timeout"`
+	// CheckDataPlanes adds an optional readiness check that every data plane
+	// agent is connected to the cluster gateway. The check is always part of
+	// diagnostics reports.
+	CheckDataPlanes bool `koanf:"check_data_planes"`
+}
+
+// HealthDefaults returns the default health check configuration.
+func HealthDefaults() HealthConfig {
+	return HealthConfig{
+		CheckTimeout:    3 * time.Second,
+		CheckDataPlanes: false,
+	}
+}
+
+// Validate validates the health check configuration.
+func (c *HealthConfig) Validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+
+	if err := config.MustBeGreaterThan(path.Child("check_timeout"), c.CheckTimeout, 0); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
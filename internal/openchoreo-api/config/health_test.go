@@ -0,0 +1,42 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openchoreo/openchoreo/internal/config"
+)
+
+func TestHealthConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name           string
+		cfg            HealthConfig
+		expectedErrors config.ValidationErrors
+	}{
+		{
+			name:           "defaults are valid",
+			cfg:            HealthDefaults(),
+			expectedErrors: nil,
+		},
+		{
+			name: "zero check timeout",
+			cfg:  HealthConfig{},
+			expectedErrors: config.ValidationErrors{
+				{Field: "health.check_timeout", Message: "must be greater than 0s"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.cfg.Validate(config.NewPath("health"))
+			if diff := cmp.Diff(tt.expectedErrors, errs); diff != "" {
+				t.Errorf("validation errors mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
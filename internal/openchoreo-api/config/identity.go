@@ -50,6 +50,8 @@ type OIDCConfig struct {
 	// Used for token validation and as the base for OAuth metadata.
 	Issuer string `koanf:"issuer"`
 	// JWKSURL is the URL to fetch JSON Web Key Set for token verification.
+	// If empty, it is discovered from the issuer's OpenID Connect discovery
+	// document (<issuer>/.well-known/openid-configuration).
 	JWKSURL string `koanf:"jwks_url"`
 	// AuthorizationEndpoint is the OAuth authorization endpoint URL.
 	AuthorizationEndpoint string `koanf:"authorization_endpoint"`
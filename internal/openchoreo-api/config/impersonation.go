@@ -0,0 +1,60 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"github.com/openchoreo/openchoreo/internal/config"
+	k8s "github.com/openchoreo/openchoreo/internal/openchoreo-api/clients"
+)
+
+// ImpersonationConfig defines Kubernetes impersonation of the calling user.
+type ImpersonationConfig struct {
+	// Enabled sends the service layer's Kubernetes requests with impersonation
+	// headers for the authenticated subject, so that cluster RBAC applies on top
+	// of the API's own authorization. Requires security to be enabled.
+	Enabled bool `koanf:"enabled"`
+	// UsernamePrefix is prepended to the subject ID to form the impersonated
+	// user name, like the API server's --oidc-username-prefix.
+	UsernamePrefix string `koanf:"username_prefix"`
+	// GroupsPrefix is prepended to each impersonated group, like the API
+	// server's --oidc-groups-prefix.
+	GroupsPrefix string `koanf:"groups_prefix"`
+	// GroupsClaim is the entitlement claim whose values are impersonated as
+	// groups. Subjects with a different entitlement claim get no groups.
+	GroupsClaim string `koanf:"groups_claim"`
+}
+
+// ImpersonationDefaults returns the default impersonation configuration.
+func ImpersonationDefaults() ImpersonationConfig {
+	return ImpersonationConfig{
+		Enabled:        false,
+		UsernamePrefix: "oidc:",
+		GroupsPrefix:   "oidc:",
+		GroupsClaim:    "groups",
+	}
+}
+
+// Validate validates the impersonation configuration.
+func (c *ImpersonationConfig) Validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+
+	if !c.Enabled {
+		return errs
+	}
+
+	if err := config.MustNotBeEmpty(path.Child("groups_claim"), c.GroupsClaim); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// ToImpersonationOptions converts to the Kubernetes client impersonation options.
+func (c *ImpersonationConfig) ToImpersonationOptions() k8s.ImpersonationOptions {
+	return k8s.ImpersonationOptions{
+		UsernamePrefix: c.UsernamePrefix,
+		GroupsPrefix:   c.GroupsPrefix,
+		GroupsClaim:    c.GroupsClaim,
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openchoreo/openchoreo/internal/config"
+)
+
+func TestImpersonationConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name           string
+		cfg            ImpersonationConfig
+		expectedErrors config.ValidationErrors
+	}{
+		{
+			name:           "defaults are valid",
+			cfg:            ImpersonationDefaults(),
+			expectedErrors: nil,
+		},
+		{
+			name:           "disabled config is not validated",
+			cfg:            ImpersonationConfig{Enabled: false},
+			expectedErrors: nil,
+		},
+		{
+			name:           "empty prefixes are allowed",
+			cfg:            ImpersonationConfig{Enabled: true, GroupsClaim: "groups"},
+			expectedErrors: nil,
+		},
+		{
+			name: "missing groups claim",
+			cfg:  ImpersonationConfig{Enabled: true},
+			expectedErrors: config.ValidationErrors{
+				{Field: "impersonation.groups_claim", Message: "must not be empty"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.cfg.Validate(config.NewPath("impersonation"))
+			if diff := cmp.Diff(tt.expectedErrors, errs); diff != "" {
+				t.Errorf("validation errors mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
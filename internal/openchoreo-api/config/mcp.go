@@ -18,6 +18,37 @@ type MCPConfig struct {
 	Enabled bool `koanf:"enabled"`
 	// Toolsets is the list of enabled MCP toolsets.
 	Toolsets []string `koanf:"toolsets"`
+	// RateLimit defines per-session tool call limits.
+	RateLimit MCPRateLimitConfig `koanf:"rate_limit"`
+}
+
+// MCPRateLimitConfig defines token bucket limits applied to tool calls of each
+// MCP session, so a runaway agent loop cannot flood the Kubernetes API.
+type MCPRateLimitConfig struct {
+	// Enabled enables rate limiting of tool calls.
+	Enabled bool `koanf:"enabled"`
+	// SessionRate is the sustained number of tool calls per second allowed per session.
+	SessionRate float64 `koanf:"session_rate"`
+	// SessionBurst is the number of tool calls a session may make at once.
+	SessionBurst int `koanf:"session_burst"`
+	// ToolRate is the sustained number of calls per second allowed per tool in a session.
+	ToolRate float64 `koanf:"tool_rate"`
+	// ToolBurst is the number of calls to a single tool a session may make at once.
+	ToolBurst int `koanf:"tool_burst"`
+	// MaxConcurrentMutations caps in-flight mutating tool calls per session. 0 disables the cap.
+	MaxConcurrentMutations int `koanf:"max_concurrent_mutations"`
+}
+
+// MCPRateLimitDefaults returns the default MCP rate limit configuration.
+func MCPRateLimitDefaults() MCPRateLimitConfig {
+	return MCPRateLimitConfig{
+		Enabled:                true,
+		SessionRate:            10,
+		SessionBurst:           30,
+		ToolRate:               5,
+		ToolBurst:              10,
+		MaxConcurrentMutations: 4,
+	}
 }
 
 // MCPDefaults returns the default MCP configuration.
@@ -32,6 +63,7 @@ func MCPDefaults() MCPConfig {
 			string(tools.ToolsetBuild),
 			string(tools.ToolsetResource),
 		},
+		RateLimit: MCPRateLimitDefaults(),
 	}
 }
 
@@ -44,6 +76,7 @@ var validToolsets = map[string]bool{
 	string(tools.ToolsetBuild):      true,
 	string(tools.ToolsetPE):         true,
 	string(tools.ToolsetResource):   true,
+	string(tools.ToolsetBatch):      true,
 }
 
 // ValidateMCPConfig validates the MCP configuration.
@@ -64,9 +97,46 @@ func (c *MCPConfig) ValidateMCPConfig(path *config.Path) config.ValidationErrors
 		}
 	}
 
+	errs = append(errs, c.RateLimit.Validate(path.Child("rate_limit"))...)
+
 	return errs
 }
 
+// Validate validates the MCP rate limit configuration.
+func (c *MCPRateLimitConfig) Validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+
+	if err := config.MustBeNonNegative(path.Child("session_rate"), c.SessionRate); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.MustBeNonNegative(path.Child("session_burst"), c.SessionBurst); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.MustBeNonNegative(path.Child("tool_rate"), c.ToolRate); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.MustBeNonNegative(path.Child("tool_burst"), c.ToolBurst); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.MustBeNonNegative(path.Child("max_concurrent_mutations"), c.MaxConcurrentMutations); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// ToolsRateLimit converts the configuration to the MCP server's rate limit settings.
+func (c *MCPRateLimitConfig) ToolsRateLimit() tools.RateLimitConfig {
+	return tools.RateLimitConfig{
+		Enabled:                c.Enabled,
+		SessionRate:            c.SessionRate,
+		SessionBurst:           c.SessionBurst,
+		ToolRate:               c.ToolRate,
+		ToolBurst:              c.ToolBurst,
+		MaxConcurrentMutations: c.MaxConcurrentMutations,
+	}
+}
+
 // ParseToolsets converts the toolset strings to a map of ToolsetType for lookup.
 func (c *MCPConfig) ParseToolsets() map[tools.ToolsetType]bool {
 	result := make(map[tools.ToolsetType]bool, len(c.Toolsets))
@@ -23,6 +23,10 @@ func TestNewMCPConfig_Defaults(t *testing.T) {
 	if diff := cmp.Diff(expectedToolsets, cfg.Toolsets); diff != "" {
 		t.Errorf("default toolsets mismatch (-want +got):\n%s", diff)
 	}
+
+	if diff := cmp.Diff(MCPRateLimitDefaults(), cfg.RateLimit); diff != "" {
+		t.Errorf("default rate limit mismatch (-want +got):\n%s", diff)
+	}
 }
 
 func TestNewMCPConfig_ValidateToolsets(t *testing.T) {
@@ -43,7 +47,7 @@ func TestNewMCPConfig_ValidateToolsets(t *testing.T) {
 			name: "all valid toolsets",
 			cfg: MCPConfig{
 				Enabled:  true,
-				Toolsets: []string{"namespace", "project", "component", "deployment", "build", "pe", "resource"},
+				Toolsets: []string{"namespace", "project", "component", "deployment", "build", "pe", "resource", "batch"},
 			},
 			expectedErrors: nil,
 		},
@@ -54,7 +58,7 @@ func TestNewMCPConfig_ValidateToolsets(t *testing.T) {
 				Toolsets: []string{"invalid"},
 			},
 			expectedErrors: config.ValidationErrors{
-				{Field: "mcp.toolsets[0]", Message: `unknown toolset "invalid"; valid toolsets: build, component, deployment, namespace, pe, project, resource`},
+				{Field: "mcp.toolsets[0]", Message: `unknown toolset "invalid"; valid toolsets: batch, build, component, deployment, namespace, pe, project, resource`},
 			},
 		},
 		{
@@ -64,7 +68,7 @@ func TestNewMCPConfig_ValidateToolsets(t *testing.T) {
 				Toolsets: []string{"namespace", "unknown", "component"},
 			},
 			expectedErrors: config.ValidationErrors{
-				{Field: "mcp.toolsets[1]", Message: `unknown toolset "unknown"; valid toolsets: build, component, deployment, namespace, pe, project, resource`},
+				{Field: "mcp.toolsets[1]", Message: `unknown toolset "unknown"; valid toolsets: batch, build, component, deployment, namespace, pe, project, resource`},
 			},
 		},
 	}
@@ -102,3 +106,41 @@ func TestNewMCPConfig_ParseToolsets(t *testing.T) {
 		t.Errorf("expected empty map for empty toolsets, got %v", empty)
 	}
 }
+
+func TestNewMCPConfig_ValidateRateLimit(t *testing.T) {
+	cfg := MCPConfig{
+		RateLimit: MCPRateLimitConfig{
+			Enabled:                true,
+			SessionRate:            -1,
+			SessionBurst:           30,
+			ToolRate:               5,
+			ToolBurst:              -2,
+			MaxConcurrentMutations: 0,
+		},
+	}
+
+	expected := config.ValidationErrors{
+		{Field: "mcp.rate_limit.session_rate", Message: "must be non-negative"},
+		{Field: "mcp.rate_limit.tool_burst", Message: "must be non-negative"},
+	}
+	errs := cfg.ValidateMCPConfig(config.NewPath("mcp"))
+	if diff := cmp.Diff(expected, errs); diff != "" {
+		t.Errorf("validation errors mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestMCPRateLimitConfig_ToolsRateLimit(t *testing.T) {
+	cfg := MCPRateLimitDefaults()
+
+	expected := tools.RateLimitConfig{
+		Enabled:                true,
+		SessionRate:            10,
+		SessionBurst:           30,
+		ToolRate:               5,
+		ToolBurst:              10,
+		MaxConcurrentMutations: 4,
+	}
+	if diff := cmp.Diff(expected, cfg.ToolsRateLimit()); diff != "" {
+		t.Errorf("ToolsRateLimit mismatch (-want +got):\n%s", diff)
+	}
+}
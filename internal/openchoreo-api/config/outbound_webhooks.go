@@ -0,0 +1,149 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/config"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/outboundwebhook"
+)
+
+// webhookEvents are the valid values of the events of a subscription.
+var webhookEvents = []string{
+	outboundwebhook.EventCreated,
+	outboundwebhook.EventUpdated,
+	outboundwebhook.EventDeleted,
+	outboundwebhook.EventHealthChanged,
+}
+
+// OutboundWebhooksConfig defines the webhooks that notify external systems of
+// changes to OpenChoreo resources.
+type OutboundWebhooksConfig struct {
+	// Subscriptions are the endpoints notified of resource events.
+	Subscriptions []WebhookSubscriptionConfig `koanf:"subscriptions"`
+	// Timeout is the maximum duration of a single delivery attempt.
+	Timeout time.Duration `koanf:"timeout"`
+	// MaxAttempts is the number of times a delivery is attempted before the
+	// event is dead-lettered to the log.
+	MaxAttempts int `koanf:"max_attempts"`
+	// RetryBackoff is the delay before the first retry. It doubles after each
+	// failed attempt.
+	RetryBackoff time.Duration `koanf:"retry_backoff"`
+}
+
+// WebhookSubscriptionConfig defines an endpoint and the events posted to it.
+type WebhookSubscriptionConfig struct {
+	// Name identifies the subscription in payloads and logs.
+	Name string `koanf:"name"`
+	// URL is the endpoint events are posted to.
+	URL string `koanf:"url"`
+	// Secret is the key of the HMAC-SHA256 signature of each payload.
+	// Empty sends payloads unsigned.
+	Secret string `koanf:"secret"`
+	// SecretFile is a file the secret is read from, as an alternative to Secret.
+	SecretFile string `koanf:"secret_file"`
+	// Kinds are the resource kinds whose events are posted.
+	Kinds []string `koanf:"kinds"`
+	// Namespaces are the namespaces whose events are posted.
+	Namespaces []string `koanf:"namespaces"`
+	// LabelSelector, when set, limits events to resources matching it.
+	LabelSelector string `koanf:"label_selector"`
+	// Events are the events posted: created, updated, deleted and
+	// health_changed. Empty posts all of them.
+	Events []string `koanf:"events"`
+}
+
+// OutboundWebhooksDefaults returns the default outbound webhook configuration.
+func OutboundWebhooksDefaults() OutboundWebhooksConfig {
+	return OutboundWebhooksConfig{
+		Timeout:      10 * time.Second,
+		MaxAttempts:  5,
+		RetryBackoff: time.Second,
+	}
+}
+
+// Validate validates the outbound webhook configuration.
+func (c *OutboundWebhooksConfig) Validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+
+	if len(c.Subscriptions) == 0 {
+		return errs
+	}
+
+	if err := config.MustBeGreaterThan(path.Child("timeout"), c.Timeout, 0); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.MustBeGreaterThan(path.Child("max_attempts"), c.MaxAttempts, 0); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.MustBeGreaterThan(path.Child("retry_backoff"), c.RetryBackoff, 0); err != nil {
+		errs = append(errs, err)
+	}
+
+	names := make(map[string]bool, len(c.Subscriptions))
+	for i := range c.Subscriptions {
+		sub := &c.Subscriptions[i]
+		subPath := path.Child("subscriptions").Index(i)
+		if err := config.MustNotBeEmpty(subPath.Child("name"), sub.Name); err != nil {
+			errs = append(errs, err)
+		} else if names[sub.Name] {
+			errs = append(errs, config.Invalid(subPath.Child("name"), "must be unique"))
+		}
+		names[sub.Name] = true
+		if u, err := url.Parse(sub.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, config.Invalid(subPath.Child("url"), "must be an absolute http or https URL"))
+		}
+		if sub.Secret != "" && sub.SecretFile != "" {
+			errs = append(errs, config.Invalid(subPath.Child("secret_file"), "cannot be set together with secret"))
+		}
+		if len(sub.Kinds) == 0 {
+			errs = append(errs, config.Required(subPath.Child("kinds")))
+		}
+		if len(sub.Namespaces) == 0 {
+			errs = append(errs, config.Required(subPath.Child("namespaces")))
+		}
+		for j, event := range sub.Events {
+			if err := config.MustBeOneOf(subPath.Child("events").Index(j), event, webhookEvents); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errs
+}
+
+// ToOptions converts to the dispatcher options, reading the secret files of
+// the subscriptions.
+func (c *OutboundWebhooksConfig) ToOptions() (outboundwebhook.Options, error) {
+	opts := outboundwebhook.Options{
+		Timeout:      c.Timeout,
+		MaxAttempts:  c.MaxAttempts,
+		RetryBackoff: c.RetryBackoff,
+	}
+	for _, sub := range c.Subscriptions {
+		secret := sub.Secret
+		if sub.SecretFile != "" {
+			data, err := os.ReadFile(sub.SecretFile)
+			if err != nil {
+				return outboundwebhook.Options{}, fmt.Errorf("failed to read secret of webhook subscription %q: %w", sub.Name, err)
+			}
+			secret = strings.TrimSpace(string(data))
+		}
+		opts.Subscriptions = append(opts.Subscriptions, outboundwebhook.Subscription{
+			Name:          sub.Name,
+			URL:           sub.URL,
+			Secret:        []byte(secret),
+			Kinds:         sub.Kinds,
+			Namespaces:    sub.Namespaces,
+			LabelSelector: sub.LabelSelector,
+			Events:        sub.Events,
+		})
+	}
+	return opts, nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openchoreo/openchoreo/internal/config"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/outboundwebhook"
+)
+
+func TestOutboundWebhooksConfig_Validate(t *testing.T) {
+	valid := WebhookSubscriptionConfig{
+		Name:       "chatops",
+		URL:        "https://hooks.example.com/openchoreo",
+		Kinds:      []string{"Component"},
+		Namespaces: []string{"default"},
+	}
+
+	tests := []struct {
+		name           string
+		cfg            OutboundWebhooksConfig
+		expectedErrors config.ValidationErrors
+	}{
+		{
+			name:           "defaults are valid",
+			cfg:            OutboundWebhooksDefaults(),
+			expectedErrors: nil,
+		},
+		{
+			name:           "no subscriptions skips validation",
+			cfg:            OutboundWebhooksConfig{MaxAttempts: -1},
+			expectedErrors: nil,
+		},
+		{
+			name: "valid subscription",
+			cfg: func() OutboundWebhooksConfig {
+				c := OutboundWebhooksDefaults()
+				sub := valid
+				sub.Events = []string{outboundwebhook.EventCreated, outboundwebhook.EventHealthChanged}
+				c.Subscriptions = []WebhookSubscriptionConfig{sub}
+				return c
+			}(),
+			expectedErrors: nil,
+		},
+		{
+			name: "invalid delivery settings",
+			cfg:  OutboundWebhooksConfig{Subscriptions: []WebhookSubscriptionConfig{valid}},
+			expectedErrors: config.ValidationErrors{
+				{Field: "outbound_webhooks.timeout", Message: "must be greater than 0s"},
+				{Field: "outbound_webhooks.max_attempts", Message: "must be greater than 0"},
+				{Field: "outbound_webhooks.retry_backoff", Message: "must be greater than 0s"},
+			},
+		},
+		{
+			name: "invalid subscriptions",
+			cfg: func() OutboundWebhooksConfig {
+				c := OutboundWebhooksDefaults()
+				c.Subscriptions = []WebhookSubscriptionConfig{
+					valid,
+					{
+						Name:       "chatops",
+						URL:        "hooks.example.com",
+						Secret:     "s3cret",
+						SecretFile: "/etc/webhook/secret",
+						Events:     []string{"renamed"},
+					},
+				}
+				return c
+			}(),
+			expectedErrors: config.ValidationErrors{
+				{Field: "outbound_webhooks.subscriptions[1].name", Message: "must be unique"},
+				{Field: "outbound_webhooks.subscriptions[1].url", Message: "must be an absolute http or https URL"},
+				{Field: "outbound_webhooks.subscriptions[1].secret_file", Message: "cannot be set together with secret"},
+				{Field: "outbound_webhooks.subscriptions[1].kinds", Message: "is required"},
+				{Field: "outbound_webhooks.subscriptions[1].namespaces", Message: "is required"},
+				{Field: "outbound_webhooks.subscriptions[1].events[0]", Message: "must be one of: created, updated, deleted, health_changed"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.cfg.Validate(config.NewPath("outbound_webhooks"))
+			if diff := cmp.Diff(tt.expectedErrors, errs); diff != "" {
+				t.Errorf("validation errors mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
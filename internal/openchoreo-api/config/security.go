@@ -134,6 +134,7 @@ func (c *JWTConfig) ToJWTMiddlewareConfig(oidc *OIDCConfig, logger *slog.Logger,
 	return jwt.Config{
 		Disabled:                     !securityEnabled,
 		JWKSURL:                      oidc.JWKSURL,
+		OIDCIssuerURL:                oidc.Issuer,
 		JWKSRefreshInterval:          c.JWKS.RefreshInterval,
 		JWKSURLTLSInsecureSkipVerify: c.JWKS.SkipTLSVerify,
 		ValidateIssuer:               oidc.Issuer,
@@ -145,7 +146,8 @@ func (c *JWTConfig) ToJWTMiddlewareConfig(oidc *OIDCConfig, logger *slog.Logger,
 }
 
 // JWKSConfig defines JWKS (JSON Web Key Set) operational settings.
-// Note: The JWKS URL comes from identity.oidc.jwks_url.
+// Note: The JWKS URL comes from identity.oidc.jwks_url, or is discovered from
+// identity.oidc.issuer when that is empty.
 type JWKSConfig struct {
 	// RefreshInterval is how often to refresh keys from the JWKS URL.
 	RefreshInterval time.Duration `koanf:"refresh_interval"`
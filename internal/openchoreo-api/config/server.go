@@ -5,10 +5,20 @@ package config
 
 import (
 	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
 	"time"
 
 	"github.com/openchoreo/openchoreo/internal/config"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/limits"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/versioning"
 	"github.com/openchoreo/openchoreo/internal/server"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/compress"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/cors"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/idempotency"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/ratelimit"
 )
 
 // ServerConfig defines HTTP server settings.
@@ -24,8 +34,15 @@ type ServerConfig struct {
 	Timeouts TimeoutsConfig `koanf:"timeouts"`
 	// TLS defines TLS/HTTPS settings.
 	TLS TLSConfig `koanf:"tls"`
+	// Limits defines the maximum request body sizes.
+	Limits LimitsConfig `koanf:"limits"`
+	// CORS defines the cross-origin requests browsers may make.
+	CORS CORSConfig `koanf:"cors"`
 	// Middleware defines middleware configurations.
 	Middleware MiddlewareConfig `koanf:"middleware"`
+	// DefaultResponseVersion is the response version served to clients that do
+	// not select one with the Accept header or the request path.
+	DefaultResponseVersion string `koanf:"default_response_version"`
 }
 
 // TimeoutsConfig defines HTTP server timeout settings.
@@ -38,6 +55,12 @@ type TimeoutsConfig struct {
 	Idle time.Duration `koanf:"idle"`
 	// Shutdown is the maximum duration to wait for active connections to close.
 	Shutdown time.Duration `koanf:"shutdown"`
+	// Request is the maximum duration to handle a REST request. Zero means no
+	// limit other than Write.
+	Request time.Duration `koanf:"request"`
+	// Operations overrides Request for the REST operations with the given
+	// operation IDs, such as batchApplyResources.
+	Operations map[string]time.Duration `koanf:"operations"`
 }
 
 // TimeoutsDefaults returns the default timeout configuration.
@@ -47,6 +70,47 @@ func TimeoutsDefaults() TimeoutsConfig {
 		Write:    15 * time.Second,
 		Idle:     60 * time.Second,
 		Shutdown: 30 * time.Second,
+		Request:  0,
+	}
+}
+
+// LimitsConfig defines the maximum sizes of REST request bodies. Larger
+// requests are rejected with 413 Request Entity Too Large.
+type LimitsConfig struct {
+	// MaxBodySize is the maximum request body size in bytes. Zero means no limit.
+	MaxBodySize int64 `koanf:"max_body_size"`
+	// MaxManifestSize is the maximum size in bytes of the manifest sent to the
+	// apply operation. Zero means MaxBodySize applies.
+	MaxManifestSize int64 `koanf:"max_manifest_size"`
+}
+
+// LimitsDefaults returns the default request limits. The manifest size
+// matches the largest object etcd stores by default.
+func LimitsDefaults() LimitsConfig {
+	return LimitsConfig{
+		MaxBodySize:     4 << 20,
+		MaxManifestSize: 1536 << 10,
+	}
+}
+
+// CORSConfig defines the cross-origin requests that browsers may make to the
+// REST API, such as from a console served on another origin.
+type CORSConfig struct {
+	// AllowedOrigins lists the origins that may call the API, such as
+	// https://console.example.com, or "*" for every origin. Empty disables CORS.
+	AllowedOrigins []string `koanf:"allowed_origins"`
+	// AllowCredentials lets browsers send cookies and Authorization headers.
+	AllowCredentials bool `koanf:"allow_credentials"`
+	// MaxAge is how long browsers may cache the result of a preflight request.
+	MaxAge time.Duration `koanf:"max_age"`
+}
+
+// CORSDefaults returns the default CORS configuration.
+func CORSDefaults() CORSConfig {
+	return CORSConfig{
+		AllowedOrigins:   []string{},
+		AllowCredentials: false,
+		MaxAge:           time.Hour,
 	}
 }
 
@@ -68,19 +132,181 @@ func TLSDefaults() TLSConfig {
 }
 
 // MiddlewareConfig defines server middleware configurations.
-// Placeholder for future middleware settings (e.g., request_id).
 type MiddlewareConfig struct {
-	// Future: RequestID config, rate limiting, etc.
+	// RateLimit defines request rate limits applied to every REST and MCP request.
+	RateLimit RateLimitConfig `koanf:"rate_limit"`
+	// Idempotency defines how results of mutating requests made with an
+	// idempotency key are kept for retries.
+	Idempotency IdempotencyConfig `koanf:"idempotency"`
+	// Compression defines the gzip compression of large REST responses.
+	Compression CompressionConfig `koanf:"compression"`
+}
+
+// RateLimitConfig defines token bucket limits that protect the API server and
+// the Kubernetes API behind it from clients sending too many requests.
+// Rates are in requests per second; a zero rate disables that bucket.
+type RateLimitConfig struct {
+	// Enabled enables request rate limiting.
+	Enabled bool `koanf:"enabled"`
+	// GlobalRate is the sustained request rate across all clients.
+	GlobalRate float64 `koanf:"global_rate"`
+	// GlobalBurst is the number of requests all clients may make at once.
+	GlobalBurst int `koanf:"global_burst"`
+	// ClientRate is the sustained request rate of each client IP address.
+	ClientRate float64 `koanf:"client_rate"`
+	// ClientBurst is the number of requests a single client may make at once.
+	ClientBurst int `koanf:"client_burst"`
+	// TrustForwardedFor identifies clients by the X-Forwarded-For header.
+	// Only enable it behind a proxy that sets the header.
+	TrustForwardedFor bool `koanf:"trust_forwarded_for"`
+}
+
+// IdempotencyConfig defines the idempotency keys that clients send with
+// mutating REST requests (Idempotency-Key header) and MCP tool calls
+// (idempotency_key argument) so that retries do not apply a change twice.
+// Results are kept in memory, per API server replica.
+type IdempotencyConfig struct {
+	// Enabled enables idempotency keys.
+	Enabled bool `koanf:"enabled"`
+	// TTL is how long the result of a request is replayed to retries.
+	TTL time.Duration `koanf:"ttl"`
+	// MaxEntries is the maximum number of results kept. The oldest results are
+	// dropped first.
+	MaxEntries int `koanf:"max_entries"`
+}
+
+// CompressionConfig defines the gzip compression of REST responses for clients
+// that accept it.
+type CompressionConfig struct {
+	// Enabled enables response compression.
+	Enabled bool `koanf:"enabled"`
+	// MinSize is the size in bytes from which responses are compressed.
+	MinSize int `koanf:"min_size"`
 }
 
 // MiddlewareDefaults returns the default middleware configuration.
 func MiddlewareDefaults() MiddlewareConfig {
-	return MiddlewareConfig{}
+	return MiddlewareConfig{
+		RateLimit:   RateLimitDefaults(),
+		Idempotency: IdempotencyDefaults(),
+		Compression: CompressionDefaults(),
+	}
+}
+
+// RateLimitDefaults returns the default rate limit configuration.
+func RateLimitDefaults() RateLimitConfig {
+	return RateLimitConfig{
+		Enabled:           true,
+		GlobalRate:        500,
+		GlobalBurst:       1000,
+		ClientRate:        100,
+		ClientBurst:       200,
+		TrustForwardedFor: false,
+	}
+}
+
+// IdempotencyDefaults returns the default idempotency configuration.
+func IdempotencyDefaults() IdempotencyConfig {
+	return IdempotencyConfig{
+		Enabled:    true,
+		TTL:        24 * time.Hour,
+		MaxEntries: 10000,
+	}
+}
+
+// CompressionDefaults returns the default compression configuration.
+func CompressionDefaults() CompressionConfig {
+	return CompressionConfig{
+		Enabled: true,
+		MinSize: 1024,
+	}
 }
 
 // Validate validates the middleware configuration.
 func (c *MiddlewareConfig) Validate(path *config.Path) config.ValidationErrors {
-	return nil // No validation needed for empty config
+	var errs config.ValidationErrors
+	errs = append(errs, c.RateLimit.Validate(path.Child("rate_limit"))...)
+	errs = append(errs, c.Idempotency.Validate(path.Child("idempotency"))...)
+	errs = append(errs, c.Compression.Validate(path.Child("compression"))...)
+	return errs
+}
+
+// Validate validates the idempotency configuration.
+func (c *IdempotencyConfig) Validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+
+	if !c.Enabled {
+		return errs
+	}
+
+	if err := config.MustBeGreaterThan(path.Child("ttl"), c.TTL, 0); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.MustBeGreaterThan(path.Child("max_entries"), c.MaxEntries, 0); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// Validate validates the compression configuration.
+func (c *CompressionConfig) Validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+
+	if err := config.MustBeNonNegative(path.Child("min_size"), c.MinSize); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// ToCompressConfig converts to the compression middleware config.
+func (c *CompressionConfig) ToCompressConfig() compress.Config {
+	return compress.Config{
+		Enabled: c.Enabled,
+		MinSize: c.MinSize,
+	}
+}
+
+// NewStore creates the store that keeps results for idempotency keys, or nil
+// when idempotency keys are disabled.
+func (c *IdempotencyConfig) NewStore() *idempotency.Store {
+	if !c.Enabled {
+		return nil
+	}
+	return idempotency.NewStore(c.TTL, c.MaxEntries)
+}
+
+// Validate validates the rate limit configuration.
+func (c *RateLimitConfig) Validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+
+	if err := config.MustBeNonNegative(path.Child("global_rate"), c.GlobalRate); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.MustBeNonNegative(path.Child("global_burst"), c.GlobalBurst); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.MustBeNonNegative(path.Child("client_rate"), c.ClientRate); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.MustBeNonNegative(path.Child("client_burst"), c.ClientBurst); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// ToRateLimitConfig converts to the rate limit middleware config.
+func (c *RateLimitConfig) ToRateLimitConfig() ratelimit.Config {
+	return ratelimit.Config{
+		Enabled:           c.Enabled,
+		GlobalRate:        c.GlobalRate,
+		GlobalBurst:       c.GlobalBurst,
+		ClientRate:        c.ClientRate,
+		ClientBurst:       c.ClientBurst,
+		TrustForwardedFor: c.TrustForwardedFor,
+	}
 }
 
 // ServerDefaults returns the default server configuration.
@@ -91,7 +317,11 @@ func ServerDefaults() ServerConfig {
 		PublicURL:   "http://localhost:8080",
 		Timeouts:    TimeoutsDefaults(),
 		TLS:         TLSDefaults(),
+		Limits:      LimitsDefaults(),
+		CORS:        CORSDefaults(),
 		Middleware:  MiddlewareDefaults(),
+
+		DefaultResponseVersion: string(versioning.V1Alpha1),
 	}
 }
 
@@ -105,8 +335,18 @@ func (c *ServerConfig) Validate(path *config.Path) config.ValidationErrors {
 
 	errs = append(errs, c.Timeouts.Validate(path.Child("timeouts"))...)
 	errs = append(errs, c.TLS.Validate(path.Child("tls"))...)
+	errs = append(errs, c.Limits.Validate(path.Child("limits"))...)
+	errs = append(errs, c.CORS.Validate(path.Child("cors"))...)
 	errs = append(errs, c.Middleware.Validate(path.Child("middleware"))...)
 
+	versions := make([]string, 0, len(versioning.Versions()))
+	for _, v := range versioning.Versions() {
+		versions = append(versions, string(v))
+	}
+	if err := config.MustBeOneOf(path.Child("default_response_version"), c.DefaultResponseVersion, versions); err != nil {
+		errs = append(errs, err)
+	}
+
 	return errs
 }
 
@@ -130,9 +370,114 @@ func (c *TimeoutsConfig) Validate(path *config.Path) config.ValidationErrors {
 		errs = append(errs, err)
 	}
 
+	if err := config.MustBeNonNegative(path.Child("request"), c.Request); err != nil {
+		errs = append(errs, err)
+	}
+	errs = append(errs, c.validateRequest(path.Child("request"), c.Request)...)
+
+	if len(c.Operations) > 0 {
+		ids, err := limits.OperationIDs()
+		if err != nil {
+			errs = append(errs, config.Invalid(path.Child("operations"), err.Error()))
+			return errs
+		}
+		for _, id := range slices.Sorted(maps.Keys(c.Operations)) {
+			opPath := path.Child("operations").Child(id)
+			if _, found := slices.BinarySearch(ids, id); !found {
+				errs = append(errs, config.Invalid(opPath, "unknown operation"))
+				continue
+			}
+			if err := config.MustBeGreaterThan(opPath, c.Operations[id], 0); err != nil {
+				errs = append(errs, err)
+			}
+			errs = append(errs, c.validateRequest(opPath, c.Operations[id])...)
+		}
+	}
+
 	return errs
 }
 
+// validateRequest validates a request timeout. The server closes connections
+// once the write timeout passes, so a request timeout cannot exceed it.
+func (c *TimeoutsConfig) validateRequest(path *config.Path, timeout time.Duration) config.ValidationErrors {
+	var errs config.ValidationErrors
+
+	if c.Write > 0 {
+		if err := config.MustBeLessThanOrEqual(path, timeout, c.Write); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// Validate validates the request limits.
+func (c *LimitsConfig) Validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+
+	if err := config.MustBeNonNegative(path.Child("max_body_size"), c.MaxBodySize); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.MustBeNonNegative(path.Child("max_manifest_size"), c.MaxManifestSize); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// Validate validates the CORS configuration.
+func (c *CORSConfig) Validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+
+	for i, origin := range c.AllowedOrigins {
+		if origin == cors.AnyOrigin {
+			if c.AllowCredentials {
+				errs = append(errs, config.Invalid(path.Child("allowed_origins").Index(i),
+					"must not be \"*\" when allow_credentials is enabled"))
+			}
+			continue
+		}
+		if u, err := url.Parse(origin); err != nil || u.Scheme == "" || u.Host == "" || u.Path != "" {
+			errs = append(errs, config.Invalid(path.Child("allowed_origins").Index(i),
+				"must be an origin such as https://console.example.com"))
+		}
+	}
+	if err := config.MustBeNonNegative(path.Child("max_age"), c.MaxAge); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// ToCORSConfig converts to the CORS middleware config. Browsers may send the
+// request headers and read the response headers of the REST API.
+func (c *CORSConfig) ToCORSConfig() cors.Config {
+	return cors.Config{
+		AllowedOrigins: c.AllowedOrigins,
+		AllowedMethods: []string{
+			http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodOptions,
+		},
+		AllowedHeaders: []string{
+			"Accept", "Authorization", "Content-Type", "Idempotency-Key", "If-None-Match", "X-Request-ID",
+		},
+		ExposedHeaders: []string{
+			"ETag", "Idempotent-Replayed", versioning.HeaderAPIVersion, "Retry-After",
+		},
+		AllowCredentials: c.AllowCredentials,
+		MaxAge:           c.MaxAge,
+	}
+}
+
+// ToLimitsConfig converts to the REST operation limits middleware config.
+func (c *ServerConfig) ToLimitsConfig() limits.Config {
+	return limits.Config{
+		MaxBodySize:       c.Limits.MaxBodySize,
+		MaxManifestSize:   c.Limits.MaxManifestSize,
+		Timeout:           c.Timeouts.Request,
+		OperationTimeouts: c.Timeouts.Operations,
+	}
+}
+
 // Validate validates the TLS configuration.
 func (c *TLSConfig) Validate(path *config.Path) config.ValidationErrors {
 	var errs config.ValidationErrors
@@ -5,6 +5,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -75,3 +76,268 @@ func TestTLSConfig_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestRateLimitConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name           string
+		cfg            RateLimitConfig
+		expectedErrors config.ValidationErrors
+	}{
+		{
+			name:           "defaults are valid",
+			cfg:            RateLimitDefaults(),
+			expectedErrors: nil,
+		},
+		{
+			name:           "zero rates disable buckets",
+			cfg:            RateLimitConfig{Enabled: true},
+			expectedErrors: nil,
+		},
+		{
+			name: "negative values are rejected",
+			cfg:  RateLimitConfig{Enabled: true, GlobalRate: -1, GlobalBurst: -1, ClientRate: -1, ClientBurst: -1},
+			expectedErrors: config.ValidationErrors{
+				{Field: "rate_limit.global_rate", Message: "must be non-negative"},
+				{Field: "rate_limit.global_burst", Message: "must be non-negative"},
+				{Field: "rate_limit.client_rate", Message: "must be non-negative"},
+				{Field: "rate_limit.client_burst", Message: "must be non-negative"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.cfg.Validate(config.NewPath("rate_limit"))
+			if diff := cmp.Diff(tt.expectedErrors, errs); diff != "" {
+				t.Errorf("validation errors mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIdempotencyConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name           string
+		cfg            IdempotencyConfig
+		expectedErrors config.ValidationErrors
+	}{
+		{
+			name:           "defaults are valid",
+			cfg:            IdempotencyDefaults(),
+			expectedErrors: nil,
+		},
+		{
+			name:           "disabled skips validation",
+			cfg:            IdempotencyConfig{Enabled: false},
+			expectedErrors: nil,
+		},
+		{
+			name: "enabled requires ttl and max entries",
+			cfg:  IdempotencyConfig{Enabled: true},
+			expectedErrors: config.ValidationErrors{
+				{Field: "idempotency.ttl", Message: "must be greater than 0s"},
+				{Field: "idempotency.max_entries", Message: "must be greater than 0"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.cfg.Validate(config.NewPath("idempotency"))
+			if diff := cmp.Diff(tt.expectedErrors, errs); diff != "" {
+				t.Errorf("validation errors mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCompressionConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name           string
+		cfg            CompressionConfig
+		expectedErrors config.ValidationErrors
+	}{
+		{
+			name:           "defaults are valid",
+			cfg:            CompressionDefaults(),
+			expectedErrors: nil,
+		},
+		{
+			name:           "zero min size compresses every response",
+			cfg:            CompressionConfig{Enabled: true},
+			expectedErrors: nil,
+		},
+		{
+			name: "negative min size is rejected",
+			cfg:  CompressionConfig{Enabled: true, MinSize: -1},
+			expectedErrors: config.ValidationErrors{
+				{Field: "compression.min_size", Message: "must be non-negative"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.cfg.Validate(config.NewPath("compression"))
+			if diff := cmp.Diff(tt.expectedErrors, errs); diff != "" {
+				t.Errorf("validation errors mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeoutsConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name           string
+		mutate         func(*TimeoutsConfig)
+		expectedErrors config.ValidationErrors
+	}{
+		{
+			name:           "defaults are valid",
+			mutate:         func(*TimeoutsConfig) {},
+			expectedErrors: nil,
+		},
+		{
+			name: "request and operation timeouts within the write timeout are valid",
+			mutate: func(c *TimeoutsConfig) {
+				c.Request = 10 * time.Second
+				c.Operations = map[string]time.Duration{"batchApplyResources": 15 * time.Second}
+			},
+			expectedErrors: nil,
+		},
+		{
+			name: "timeouts are unbounded without a write timeout",
+			mutate: func(c *TimeoutsConfig) {
+				c.Write = 0
+				c.Operations = map[string]time.Duration{"batchApplyResources": 2 * time.Minute}
+			},
+			expectedErrors: nil,
+		},
+		{
+			name: "invalid request timeouts are rejected",
+			mutate: func(c *TimeoutsConfig) {
+				c.Request = -time.Second
+				c.Operations = map[string]time.Duration{
+					"applyResource":       0,
+					"batchApplyResources": time.Minute,
+					"noSuchOperation":     time.Second,
+				}
+			},
+			expectedErrors: config.ValidationErrors{
+				{Field: "timeouts.request", Message: "must be non-negative"},
+				{Field: "timeouts.operations.applyResource", Message: "must be greater than 0s"},
+				{Field: "timeouts.operations.batchApplyResources", Message: "must be <= 15s"},
+				{Field: "timeouts.operations.noSuchOperation", Message: "unknown operation"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := TimeoutsDefaults()
+			tt.mutate(&cfg)
+			errs := cfg.Validate(config.NewPath("timeouts"))
+			if diff := cmp.Diff(tt.expectedErrors, errs); diff != "" {
+				t.Errorf("validation errors mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCORSConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name           string
+		cfg            CORSConfig
+		expectedErrors config.ValidationErrors
+	}{
+		{
+			name:           "defaults are valid",
+			cfg:            CORSDefaults(),
+			expectedErrors: nil,
+		},
+		{
+			name: "origins are valid",
+			cfg: CORSConfig{
+				AllowedOrigins:   []string{"https://console.example.com", "http://localhost:3000"},
+				AllowCredentials: true,
+			},
+			expectedErrors: nil,
+		},
+		{
+			name:           "any origin without credentials is valid",
+			cfg:            CORSConfig{AllowedOrigins: []string{"*"}},
+			expectedErrors: nil,
+		},
+		{
+			name: "invalid origins are rejected",
+			cfg: CORSConfig{
+				AllowedOrigins:   []string{"*", "console.example.com", "https://console.example.com/ui"},
+				AllowCredentials: true,
+				MaxAge:           -time.Second,
+			},
+			expectedErrors: config.ValidationErrors{
+				{Field: "cors.allowed_origins[0]", Message: `must not be "*" when allow_credentials is enabled`},
+				{Field: "cors.allowed_origins[1]", Message: "must be an origin such as https://console.example.com"},
+				{Field: "cors.allowed_origins[2]", Message: "must be an origin such as https://console.example.com"},
+				{Field: "cors.max_age", Message: "must be non-negative"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.cfg.Validate(config.NewPath("cors"))
+			if diff := cmp.Diff(tt.expectedErrors, errs); diff != "" {
+				t.Errorf("validation errors mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServerConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name           string
+		mutate         func(*ServerConfig)
+		expectedErrors config.ValidationErrors
+	}{
+		{
+			name:           "defaults are valid",
+			mutate:         func(*ServerConfig) {},
+			expectedErrors: nil,
+		},
+		{
+			name:           "v1 response version is valid",
+			mutate:         func(c *ServerConfig) { c.DefaultResponseVersion = "v1" },
+			expectedErrors: nil,
+		},
+		{
+			name:   "unknown response version is rejected",
+			mutate: func(c *ServerConfig) { c.DefaultResponseVersion = "v2" },
+			expectedErrors: config.ValidationErrors{
+				{Field: "server.default_response_version", Message: "must be one of: v1alpha1, v1"},
+			},
+		},
+		{
+			name: "negative body size limits are rejected",
+			mutate: func(c *ServerConfig) {
+				c.Limits.MaxBodySize = -1
+				c.Limits.MaxManifestSize = -1
+			},
+			expectedErrors: config.ValidationErrors{
+				{Field: "server.limits.max_body_size", Message: "must be non-negative"},
+				{Field: "server.limits.max_manifest_size", Message: "must be non-negative"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := ServerDefaults()
+			tt.mutate(&cfg)
+			errs := cfg.Validate(config.NewPath("server"))
+			if diff := cmp.Diff(tt.expectedErrors, errs); diff != "" {
+				t.Errorf("validation errors mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
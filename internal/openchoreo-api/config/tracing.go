@@ -0,0 +1,62 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"github.com/openchoreo/openchoreo/internal/config"
+	"github.com/openchoreo/openchoreo/internal/tracing"
+)
+
+// TracingConfig defines OpenTelemetry tracing settings.
+type TracingConfig struct {
+	// Enabled enables exporting traces over OTLP.
+	Enabled bool `koanf:"enabled"`
+	// Endpoint is the host:port of the OTLP gRPC collector.
+	Endpoint string `koanf:"endpoint"`
+	// Insecure disables TLS for the connection to the collector.
+	Insecure bool `koanf:"insecure"`
+	// SampleRatio is the fraction of new traces that are sampled (0 to 1).
+	SampleRatio float64 `koanf:"sample_ratio"`
+}
+
+// TracingDefaults returns the default tracing configuration.
+func TracingDefaults() TracingConfig {
+	return TracingConfig{
+		Enabled:     false,
+		Endpoint:    "localhost:4317",
+		Insecure:    false,
+		SampleRatio: 1.0,
+	}
+}
+
+// Validate validates the tracing configuration.
+func (c *TracingConfig) Validate(path *config.Path) config.ValidationErrors {
+	var errs config.ValidationErrors
+
+	if !c.Enabled {
+		return errs
+	}
+
+	if c.Endpoint == "" {
+		errs = append(errs, config.Required(path.Child("endpoint")))
+	}
+
+	if err := config.MustBeInRange(path.Child("sample_ratio"), c.SampleRatio, 0, 1); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// ToTracingConfig converts to the tracing library config.
+func (c *TracingConfig) ToTracingConfig(serviceName, serviceVersion string) tracing.Config {
+	return tracing.Config{
+		Enabled:        c.Enabled,
+		Endpoint:       c.Endpoint,
+		Insecure:       c.Insecure,
+		SampleRatio:    c.SampleRatio,
+		ServiceName:    serviceName,
+		ServiceVersion: serviceVersion,
+	}
+}
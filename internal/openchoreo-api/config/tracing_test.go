@@ -0,0 +1,53 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openchoreo/openchoreo/internal/config"
+)
+
+func TestTracingConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name           string
+		cfg            TracingConfig
+		expectedErrors config.ValidationErrors
+	}{
+		{
+			name:           "defaults are valid",
+			cfg:            TracingDefaults(),
+			expectedErrors: nil,
+		},
+		{
+			name:           "disabled config is not validated",
+			cfg:            TracingConfig{Enabled: false, SampleRatio: 2},
+			expectedErrors: nil,
+		},
+		{
+			name:           "valid enabled config",
+			cfg:            TracingConfig{Enabled: true, Endpoint: "otel-collector:4317", SampleRatio: 0.1},
+			expectedErrors: nil,
+		},
+		{
+			name: "invalid values",
+			cfg:  TracingConfig{Enabled: true, SampleRatio: 1.5},
+			expectedErrors: config.ValidationErrors{
+				{Field: "tracing.endpoint", Message: "is required"},
+				{Field: "tracing.sample_ratio", Message: "must be between 0 and 1"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.cfg.Validate(config.NewPath("tracing"))
+			if diff := cmp.Diff(tt.expectedErrors, errs); diff != "" {
+				t.Errorf("validation errors mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
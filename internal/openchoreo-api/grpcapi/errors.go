@@ -0,0 +1,86 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	clustercomponenttypesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/clustercomponenttype"
+	clusterprojecttypesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/clusterprojecttype"
+	clusterresourcetypesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/clusterresourcetype"
+	clustertraitsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/clustertrait"
+	clusterworkflowsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/clusterworkflow"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	componenttypesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/componenttype"
+	kindschemasvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/kindschema"
+	printercolumnsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/printercolumn"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+	projecttypesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/projecttype"
+	resourcesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/resource"
+	resourcetypesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/resourcetype"
+	traitsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/trait"
+	watchsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/watch"
+	workflowsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflow"
+	workflowrunsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowrun"
+)
+
+// notFoundErrors are the service errors reported as NotFound.
+var notFoundErrors = []error{
+	clustercomponenttypesvc.ErrClusterComponentTypeNotFound,
+	clusterprojecttypesvc.ErrClusterProjectTypeNotFound,
+	clusterresourcetypesvc.ErrClusterResourceTypeNotFound,
+	clustertraitsvc.ErrClusterTraitNotFound,
+	clusterworkflowsvc.ErrClusterWorkflowNotFound,
+	componentsvc.ErrComponentNotFound,
+	componentsvc.ErrComponentTypeNotFound,
+	componenttypesvc.ErrComponentTypeNotFound,
+	kindschemasvc.ErrKindNotFound,
+	printercolumnsvc.ErrKindNotFound,
+	projectsvc.ErrProjectNotFound,
+	projecttypesvc.ErrProjectTypeNotFound,
+	resourcesvc.ErrResourceNotFound,
+	resourcetypesvc.ErrResourceTypeNotFound,
+	traitsvc.ErrTraitNotFound,
+	workflowsvc.ErrWorkflowNotFound,
+	workflowrunsvc.ErrWorkflowRunNotFound,
+}
+
+func isNotFound(err error) bool {
+	for _, target := range notFoundErrors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// toStatus maps a service error to a gRPC status. Unexpected errors are
+// logged and reported as Internal without details.
+func toStatus(logger *slog.Logger, err error, action string) error {
+	var validationErr *services.ValidationError
+	switch {
+	case errors.Is(err, services.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.As(err, &validationErr):
+		return status.Error(codes.InvalidArgument, validationErr.Msg)
+	case errors.Is(err, watchsvc.ErrInvalidRequest), errors.Is(err, watchsvc.ErrInvalidToken):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, resourcesvc.ErrResourceAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, workflowrunsvc.ErrWorkflowRunReferenceNotFound):
+		return status.Error(codes.FailedPrecondition, "workflow run has not started yet")
+	case isNotFound(err):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	}
+	logger.Error("Failed to "+action, "error", err)
+	return status.Error(codes.Internal, "failed to "+action)
+}
@@ -0,0 +1,1157 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: openchoreo/v1alpha1/resource_service.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ApplyResourceResponse_Operation int32
+
+const (
+	ApplyResourceResponse_OPERATION_UNSPECIFIED ApplyResourceResponse_Operation = 0
+	ApplyResourceResponse_OPERATION_CREATED     ApplyResourceResponse_Operation = 1
+	ApplyResourceResponse_OPERATION_UPDATED     ApplyResourceResponse_Operation = 2
+)
+
+// Enum value maps for ApplyResourceResponse_Operation.
+var (
+	ApplyResourceResponse_Operation_name = map[int32]string{
+		0: "OPERATION_UNSPECIFIED",
+		1: "OPERATION_CREATED",
+		2: "OPERATION_UPDATED",
+	}
+	ApplyResourceResponse_Operation_value = map[string]int32{
+		"OPERATION_UNSPECIFIED": 0,
+		"OPERATION_CREATED":     1,
+		"OPERATION_UPDATED":     2,
+	}
+)
+
+func (x ApplyResourceResponse_Operation) Enum() *ApplyResourceResponse_Operation {
+	p := new(ApplyResourceResponse_Operation)
+	*p = x
+	return p
+}
+
+func (x ApplyResourceResponse_Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApplyResourceResponse_Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_openchoreo_v1alpha1_resource_service_proto_enumTypes[0].Descriptor()
+}
+
+func (ApplyResourceResponse_Operation) Type() protoreflect.EnumType {
+	return &file_openchoreo_v1alpha1_resource_service_proto_enumTypes[0]
+}
+
+func (x ApplyResourceResponse_Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApplyResourceResponse_Operation.Descriptor instead.
+func (ApplyResourceResponse_Operation) EnumDescriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_resource_service_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type WatchResourcesResponse_Type int32
+
+const (
+	WatchResourcesResponse_TYPE_UNSPECIFIED WatchResourcesResponse_Type = 0
+	WatchResourcesResponse_TYPE_ADDED       WatchResourcesResponse_Type = 1
+	WatchResourcesResponse_TYPE_MODIFIED    WatchResourcesResponse_Type = 2
+	WatchResourcesResponse_TYPE_DELETED     WatchResourcesResponse_Type = 3
+	// Carries only a token, so that idle watches can be resumed without
+	// replaying events.
+	WatchResourcesResponse_TYPE_BOOKMARK WatchResourcesResponse_Type = 4
+)
+
+// Enum value maps for WatchResourcesResponse_Type.
+var (
+	WatchResourcesResponse_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_ADDED",
+		2: "TYPE_MODIFIED",
+		3: "TYPE_DELETED",
+		4: "TYPE_BOOKMARK",
+	}
+	WatchResourcesResponse_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"TYPE_ADDED":       1,
+		"TYPE_MODIFIED":    2,
+		"TYPE_DELETED":     3,
+		"TYPE_BOOKMARK":    4,
+	}
+)
+
+func (x WatchResourcesResponse_Type) Enum() *WatchResourcesResponse_Type {
+	p := new(WatchResourcesResponse_Type)
+	*p = x
+	return p
+}
+
+func (x WatchResourcesResponse_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchResourcesResponse_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_openchoreo_v1alpha1_resource_service_proto_enumTypes[1].Descriptor()
+}
+
+func (WatchResourcesResponse_Type) Type() protoreflect.EnumType {
+	return &file_openchoreo_v1alpha1_resource_service_proto_enumTypes[1]
+}
+
+func (x WatchResourcesResponse_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchResourcesResponse_Type.Descriptor instead.
+func (WatchResourcesResponse_Type) EnumDescriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_resource_service_proto_rawDescGZIP(), []int{11, 0}
+}
+
+type GetResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceRequest) Reset() {
+	*x = GetResourceRequest{}
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceRequest) ProtoMessage() {}
+
+func (x *GetResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceRequest) Descriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_resource_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetResourceRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetResourceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      *structpb.Struct       `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceResponse) Reset() {
+	*x = GetResourceResponse{}
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceResponse) ProtoMessage() {}
+
+func (x *GetResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceResponse.ProtoReflect.Descriptor instead.
+func (*GetResourceResponse) Descriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_resource_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetResourceResponse) GetResource() *structpb.Struct {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+type ListResourcesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Only list Resources of this project.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// Kubernetes label selector.
+	LabelSelector string `protobuf:"bytes,3,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	// Maximum number of items to return. Zero uses the server default.
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Cursor returned by the previous page.
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Sort key: name, creationTimestamp or status, optionally prefixed with "-"
+	// for descending order.
+	Sort          string `protobuf:"bytes,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResourcesRequest) Reset() {
+	*x = ListResourcesRequest{}
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourcesRequest) ProtoMessage() {}
+
+func (x *ListResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_resource_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListResourcesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListResourcesRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ListResourcesRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+func (x *ListResourcesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListResourcesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListResourcesRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type ListResourcesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items []*structpb.Struct     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// Cursor of the next page. Empty on the last page.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// Number of items after this page, when known.
+	RemainingCount *int64 `protobuf:"varint,3,opt,name=remaining_count,json=remainingCount,proto3,oneof" json:"remaining_count,omitempty"`
+	// Number of items across all pages, when known.
+	TotalCount    *int64 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResourcesResponse) Reset() {
+	*x = ListResourcesResponse{}
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourcesResponse) ProtoMessage() {}
+
+func (x *ListResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourcesResponse.ProtoReflect.Descriptor instead.
+func (*ListResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_resource_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListResourcesResponse) GetItems() []*structpb.Struct {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListResourcesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListResourcesResponse) GetRemainingCount() int64 {
+	if x != nil && x.RemainingCount != nil {
+		return *x.RemainingCount
+	}
+	return 0
+}
+
+func (x *ListResourcesResponse) GetTotalCount() int64 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+type ApplyResourceRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The desired Resource. Its name is taken from metadata.name.
+	Resource      *structpb.Struct `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyResourceRequest) Reset() {
+	*x = ApplyResourceRequest{}
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyResourceRequest) ProtoMessage() {}
+
+func (x *ApplyResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyResourceRequest.ProtoReflect.Descriptor instead.
+func (*ApplyResourceRequest) Descriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_resource_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ApplyResourceRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ApplyResourceRequest) GetResource() *structpb.Struct {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+type ApplyResourceResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Operation     ApplyResourceResponse_Operation `protobuf:"varint,1,opt,name=operation,proto3,enum=openchoreo.v1alpha1.ApplyResourceResponse_Operation" json:"operation,omitempty"`
+	Resource      *structpb.Struct                `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyResourceResponse) Reset() {
+	*x = ApplyResourceResponse{}
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyResourceResponse) ProtoMessage() {}
+
+func (x *ApplyResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyResourceResponse.ProtoReflect.Descriptor instead.
+func (*ApplyResourceResponse) Descriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_resource_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApplyResourceResponse) GetOperation() ApplyResourceResponse_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return ApplyResourceResponse_OPERATION_UNSPECIFIED
+}
+
+func (x *ApplyResourceResponse) GetResource() *structpb.Struct {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+type DeleteResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResourceRequest) Reset() {
+	*x = DeleteResourceRequest{}
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResourceRequest) ProtoMessage() {}
+
+func (x *DeleteResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResourceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteResourceRequest) Descriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_resource_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteResourceRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DeleteResourceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResourceResponse) Reset() {
+	*x = DeleteResourceResponse{}
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResourceResponse) ProtoMessage() {}
+
+func (x *DeleteResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResourceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResourceResponse) Descriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_resource_service_proto_rawDescGZIP(), []int{7}
+}
+
+type ListDeployedObjectsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Name of the Resource.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Only return objects on the DataPlane or ClusterDataPlane with this name.
+	Cluster       string `protobuf:"bytes,3,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeployedObjectsRequest) Reset() {
+	*x = ListDeployedObjectsRequest{}
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeployedObjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeployedObjectsRequest) ProtoMessage() {}
+
+func (x *ListDeployedObjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeployedObjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeployedObjectsRequest) Descriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_resource_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListDeployedObjectsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListDeployedObjectsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListDeployedObjectsRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+type ListDeployedObjectsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The deployed objects, as the REST API returns them.
+	Items         []*structpb.Struct `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeployedObjectsResponse) Reset() {
+	*x = ListDeployedObjectsResponse{}
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeployedObjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeployedObjectsResponse) ProtoMessage() {}
+
+func (x *ListDeployedObjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeployedObjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeployedObjectsResponse) Descriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_resource_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListDeployedObjectsResponse) GetItems() []*structpb.Struct {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type WatchResourcesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Resource kinds to watch, such as Component or ReleaseBinding.
+	Kinds []string `protobuf:"bytes,1,rep,name=kinds,proto3" json:"kinds,omitempty"`
+	// Namespaces to watch.
+	Namespaces []string `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// Limit project- and component-owned kinds to this project.
+	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	// Limit component-owned kinds to this component. Requires project.
+	Component string `protobuf:"bytes,4,opt,name=component,proto3" json:"component,omitempty"`
+	// Kubernetes label selector applied to all kinds.
+	LabelSelector string `protobuf:"bytes,5,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	// Resume a previous watch right after the event that carried this token.
+	Token         string `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchResourcesRequest) Reset() {
+	*x = WatchResourcesRequest{}
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchResourcesRequest) ProtoMessage() {}
+
+func (x *WatchResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchResourcesRequest.ProtoReflect.Descriptor instead.
+func (*WatchResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_resource_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WatchResourcesRequest) GetKinds() []string {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *WatchResourcesRequest) GetNamespaces() []string {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *WatchResourcesRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *WatchResourcesRequest) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *WatchResourcesRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+func (x *WatchResourcesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type WatchResourcesResponse struct {
+	state protoimpl.MessageState      `protogen:"open.v1"`
+	Type  WatchResourcesResponse_Type `protobuf:"varint,1,opt,name=type,proto3,enum=openchoreo.v1alpha1.WatchResourcesResponse_Type" json:"type,omitempty"`
+	// Kind of the changed object. Empty for bookmarks.
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// The changed object. Empty for bookmarks.
+	Object *structpb.Struct `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
+	// Token that resumes the watch after this event.
+	Token         string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchResourcesResponse) Reset() {
+	*x = WatchResourcesResponse{}
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchResourcesResponse) ProtoMessage() {}
+
+func (x *WatchResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchResourcesResponse.ProtoReflect.Descriptor instead.
+func (*WatchResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_resource_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WatchResourcesResponse) GetType() WatchResourcesResponse_Type {
+	if x != nil {
+		return x.Type
+	}
+	return WatchResourcesResponse_TYPE_UNSPECIFIED
+}
+
+func (x *WatchResourcesResponse) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *WatchResourcesResponse) GetObject() *structpb.Struct {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *WatchResourcesResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type StreamWorkflowRunLogsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Name of the WorkflowRun.
+	Run string `protobuf:"bytes,2,opt,name=run,proto3" json:"run,omitempty"`
+	// Only stream the logs of this task.
+	Task string `protobuf:"bytes,3,opt,name=task,proto3" json:"task,omitempty"`
+	// Only stream logs newer than this many seconds. Zero streams all logs.
+	SinceSeconds int64 `protobuf:"varint,4,opt,name=since_seconds,json=sinceSeconds,proto3" json:"since_seconds,omitempty"`
+	// Keep the stream open and send new logs until the run completes.
+	Follow        bool `protobuf:"varint,5,opt,name=follow,proto3" json:"follow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamWorkflowRunLogsRequest) Reset() {
+	*x = StreamWorkflowRunLogsRequest{}
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamWorkflowRunLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamWorkflowRunLogsRequest) ProtoMessage() {}
+
+func (x *StreamWorkflowRunLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamWorkflowRunLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamWorkflowRunLogsRequest) Descriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_resource_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StreamWorkflowRunLogsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *StreamWorkflowRunLogsRequest) GetRun() string {
+	if x != nil {
+		return x.Run
+	}
+	return ""
+}
+
+func (x *StreamWorkflowRunLogsRequest) GetTask() string {
+	if x != nil {
+		return x.Task
+	}
+	return ""
+}
+
+func (x *StreamWorkflowRunLogsRequest) GetSinceSeconds() int64 {
+	if x != nil {
+		return x.SinceSeconds
+	}
+	return 0
+}
+
+func (x *StreamWorkflowRunLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+type StreamWorkflowRunLogsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RFC 3339 timestamp of the log line, when known.
+	Timestamp     string `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Log           string `protobuf:"bytes,2,opt,name=log,proto3" json:"log,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamWorkflowRunLogsResponse) Reset() {
+	*x = StreamWorkflowRunLogsResponse{}
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamWorkflowRunLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamWorkflowRunLogsResponse) ProtoMessage() {}
+
+func (x *StreamWorkflowRunLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_openchoreo_v1alpha1_resource_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamWorkflowRunLogsResponse.ProtoReflect.Descriptor instead.
+func (*StreamWorkflowRunLogsResponse) Descriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_resource_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StreamWorkflowRunLogsResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *StreamWorkflowRunLogsResponse) GetLog() string {
+	if x != nil {
+		return x.Log
+	}
+	return ""
+}
+
+var File_openchoreo_v1alpha1_resource_service_proto protoreflect.FileDescriptor
+
+const file_openchoreo_v1alpha1_resource_service_proto_rawDesc = "" +
+	"\n" +
+	"*openchoreo/v1alpha1/resource_service.proto\x12\x13openchoreo.v1alpha1\x1a\x1cgoogle/protobuf/struct.proto\"F\n" +
+	"\x12GetResourceRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"J\n" +
+	"\x13GetResourceResponse\x123\n" +
+	"\bresource\x18\x01 \x01(\v2\x17.google.protobuf.StructR\bresource\"\xb7\x01\n" +
+	"\x14ListResourcesRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x18\n" +
+	"\aproject\x18\x02 \x01(\tR\aproject\x12%\n" +
+	"\x0elabel_selector\x18\x03 \x01(\tR\rlabelSelector\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\x12\x12\n" +
+	"\x04sort\x18\x06 \x01(\tR\x04sort\"\xdf\x01\n" +
+	"\x15ListResourcesResponse\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.google.protobuf.StructR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12,\n" +
+	"\x0fremaining_count\x18\x03 \x01(\x03H\x00R\x0eremainingCount\x88\x01\x01\x12$\n" +
+	"\vtotal_count\x18\x04 \x01(\x03H\x01R\n" +
+	"totalCount\x88\x01\x01B\x12\n" +
+	"\x10_remaining_countB\x0e\n" +
+	"\f_total_count\"i\n" +
+	"\x14ApplyResourceRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x123\n" +
+	"\bresource\x18\x02 \x01(\v2\x17.google.protobuf.StructR\bresource\"\xf6\x01\n" +
+	"\x15ApplyResourceResponse\x12R\n" +
+	"\toperation\x18\x01 \x01(\x0e24.openchoreo.v1alpha1.ApplyResourceResponse.OperationR\toperation\x123\n" +
+	"\bresource\x18\x02 \x01(\v2\x17.google.protobuf.StructR\bresource\"T\n" +
+	"\tOperation\x12\x19\n" +
+	"\x15OPERATION_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11OPERATION_CREATED\x10\x01\x12\x15\n" +
+	"\x11OPERATION_UPDATED\x10\x02\"I\n" +
+	"\x15DeleteResourceRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x18\n" +
+	"\x16DeleteResourceResponse\"h\n" +
+	"\x1aListDeployedObjectsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\acluster\x18\x03 \x01(\tR\acluster\"L\n" +
+	"\x1bListDeployedObjectsResponse\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.google.protobuf.StructR\x05items\"\xc2\x01\n" +
+	"\x15WatchResourcesRequest\x12\x14\n" +
+	"\x05kinds\x18\x01 \x03(\tR\x05kinds\x12\x1e\n" +
+	"\n" +
+	"namespaces\x18\x02 \x03(\tR\n" +
+	"namespaces\x12\x18\n" +
+	"\aproject\x18\x03 \x01(\tR\aproject\x12\x1c\n" +
+	"\tcomponent\x18\x04 \x01(\tR\tcomponent\x12%\n" +
+	"\x0elabel_selector\x18\x05 \x01(\tR\rlabelSelector\x12\x14\n" +
+	"\x05token\x18\x06 \x01(\tR\x05token\"\x9f\x02\n" +
+	"\x16WatchResourcesResponse\x12D\n" +
+	"\x04type\x18\x01 \x01(\x0e20.openchoreo.v1alpha1.WatchResourcesResponse.TypeR\x04type\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12/\n" +
+	"\x06object\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x06object\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\"d\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"TYPE_ADDED\x10\x01\x12\x11\n" +
+	"\rTYPE_MODIFIED\x10\x02\x12\x10\n" +
+	"\fTYPE_DELETED\x10\x03\x12\x11\n" +
+	"\rTYPE_BOOKMARK\x10\x04\"\x9f\x01\n" +
+	"\x1cStreamWorkflowRunLogsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x10\n" +
+	"\x03run\x18\x02 \x01(\tR\x03run\x12\x12\n" +
+	"\x04task\x18\x03 \x01(\tR\x04task\x12#\n" +
+	"\rsince_seconds\x18\x04 \x01(\x03R\fsinceSeconds\x12\x16\n" +
+	"\x06follow\x18\x05 \x01(\bR\x06follow\"O\n" +
+	"\x1dStreamWorkflowRunLogsResponse\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\tR\ttimestamp\x12\x10\n" +
+	"\x03log\x18\x02 \x01(\tR\x03log2\x98\x06\n" +
+	"\x0fResourceService\x12`\n" +
+	"\vGetResource\x12'.openchoreo.v1alpha1.GetResourceRequest\x1a(.openchoreo.v1alpha1.GetResourceResponse\x12f\n" +
+	"\rListResources\x12).openchoreo.v1alpha1.ListResourcesRequest\x1a*.openchoreo.v1alpha1.ListResourcesResponse\x12f\n" +
+	"\rApplyResource\x12).openchoreo.v1alpha1.ApplyResourceRequest\x1a*.openchoreo.v1alpha1.ApplyResourceResponse\x12i\n" +
+	"\x0eDeleteResource\x12*.openchoreo.v1alpha1.DeleteResourceRequest\x1a+.openchoreo.v1alpha1.DeleteResourceResponse\x12x\n" +
+	"\x13ListDeployedObjects\x12/.openchoreo.v1alpha1.ListDeployedObjectsRequest\x1a0.openchoreo.v1alpha1.ListDeployedObjectsResponse\x12k\n" +
+	"\x0eWatchResources\x12*.openchoreo.v1alpha1.WatchResourcesRequest\x1a+.openchoreo.v1alpha1.WatchResourcesResponse0\x01\x12\x80\x01\n" +
+	"\x15StreamWorkflowRunLogs\x121.openchoreo.v1alpha1.StreamWorkflowRunLogsRequest\x1a2.openchoreo.v1alpha1.StreamWorkflowRunLogsResponse0\x01BHZFgithub.com/openchoreo/openchoreo/internal/openchoreo-api/grpcapi/pb;pbb\x06proto3"
+
+var (
+	file_openchoreo_v1alpha1_resource_service_proto_rawDescOnce sync.Once
+	file_openchoreo_v1alpha1_resource_service_proto_rawDescData []byte
+)
+
+func file_openchoreo_v1alpha1_resource_service_proto_rawDescGZIP() []byte {
+	file_openchoreo_v1alpha1_resource_service_proto_rawDescOnce.Do(func() {
+		file_openchoreo_v1alpha1_resource_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_openchoreo_v1alpha1_resource_service_proto_rawDesc), len(file_openchoreo_v1alpha1_resource_service_proto_rawDesc)))
+	})
+	return file_openchoreo_v1alpha1_resource_service_proto_rawDescData
+}
+
+var file_openchoreo_v1alpha1_resource_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_openchoreo_v1alpha1_resource_service_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_openchoreo_v1alpha1_resource_service_proto_goTypes = []any{
+	(ApplyResourceResponse_Operation)(0),  // 0: openchoreo.v1alpha1.ApplyResourceResponse.Operation
+	(WatchResourcesResponse_Type)(0),      // 1: openchoreo.v1alpha1.WatchResourcesResponse.Type
+	(*GetResourceRequest)(nil),            // 2: openchoreo.v1alpha1.GetResourceRequest
+	(*GetResourceResponse)(nil),           // 3: openchoreo.v1alpha1.GetResourceResponse
+	(*ListResourcesRequest)(nil),          // 4: openchoreo.v1alpha1.ListResourcesRequest
+	(*ListResourcesResponse)(nil),         // 5: openchoreo.v1alpha1.ListResourcesResponse
+	(*ApplyResourceRequest)(nil),          // 6: openchoreo.v1alpha1.ApplyResourceRequest
+	(*ApplyResourceResponse)(nil),         // 7: openchoreo.v1alpha1.ApplyResourceResponse
+	(*DeleteResourceRequest)(nil),         // 8: openchoreo.v1alpha1.DeleteResourceRequest
+	(*DeleteResourceResponse)(nil),        // 9: openchoreo.v1alpha1.DeleteResourceResponse
+	(*ListDeployedObjectsRequest)(nil),    // 10: openchoreo.v1alpha1.ListDeployedObjectsRequest
+	(*ListDeployedObjectsResponse)(nil),   // 11: openchoreo.v1alpha1.ListDeployedObjectsResponse
+	(*WatchResourcesRequest)(nil),         // 12: openchoreo.v1alpha1.WatchResourcesRequest
+	(*WatchResourcesResponse)(nil),        // 13: openchoreo.v1alpha1.WatchResourcesResponse
+	(*StreamWorkflowRunLogsRequest)(nil),  // 14: openchoreo.v1alpha1.StreamWorkflowRunLogsRequest
+	(*StreamWorkflowRunLogsResponse)(nil), // 15: openchoreo.v1alpha1.StreamWorkflowRunLogsResponse
+	(*structpb.Struct)(nil),               // 16: google.protobuf.Struct
+}
+var file_openchoreo_v1alpha1_resource_service_proto_depIdxs = []int32{
+	16, // 0: openchoreo.v1alpha1.GetResourceResponse.resource:type_name -> google.protobuf.Struct
+	16, // 1: openchoreo.v1alpha1.ListResourcesResponse.items:type_name -> google.protobuf.Struct
+	16, // 2: openchoreo.v1alpha1.ApplyResourceRequest.resource:type_name -> google.protobuf.Struct
+	0,  // 3: openchoreo.v1alpha1.ApplyResourceResponse.operation:type_name -> openchoreo.v1alpha1.ApplyResourceResponse.Operation
+	16, // 4: openchoreo.v1alpha1.ApplyResourceResponse.resource:type_name -> google.protobuf.Struct
+	16, // 5: openchoreo.v1alpha1.ListDeployedObjectsResponse.items:type_name -> google.protobuf.Struct
+	1,  // 6: openchoreo.v1alpha1.WatchResourcesResponse.type:type_name -> openchoreo.v1alpha1.WatchResourcesResponse.Type
+	16, // 7: openchoreo.v1alpha1.WatchResourcesResponse.object:type_name -> google.protobuf.Struct
+	2,  // 8: openchoreo.v1alpha1.ResourceService.GetResource:input_type -> openchoreo.v1alpha1.GetResourceRequest
+	4,  // 9: openchoreo.v1alpha1.ResourceService.ListResources:input_type -> openchoreo.v1alpha1.ListResourcesRequest
+	6,  // 10: openchoreo.v1alpha1.ResourceService.ApplyResource:input_type -> openchoreo.v1alpha1.ApplyResourceRequest
+	8,  // 11: openchoreo.v1alpha1.ResourceService.DeleteResource:input_type -> openchoreo.v1alpha1.DeleteResourceRequest
+	10, // 12: openchoreo.v1alpha1.ResourceService.ListDeployedObjects:input_type -> openchoreo.v1alpha1.ListDeployedObjectsRequest
+	12, // 13: openchoreo.v1alpha1.ResourceService.WatchResources:input_type -> openchoreo.v1alpha1.WatchResourcesRequest
+	14, // 14: openchoreo.v1alpha1.ResourceService.StreamWorkflowRunLogs:input_type -> openchoreo.v1alpha1.StreamWorkflowRunLogsRequest
+	3,  // 15: openchoreo.v1alpha1.ResourceService.GetResource:output_type -> openchoreo.v1alpha1.GetResourceResponse
+	5,  // 16: openchoreo.v1alpha1.ResourceService.ListResources:output_type -> openchoreo.v1alpha1.ListResourcesResponse
+	7,  // 17: openchoreo.v1alpha1.ResourceService.ApplyResource:output_type -> openchoreo.v1alpha1.ApplyResourceResponse
+	9,  // 18: openchoreo.v1alpha1.ResourceService.DeleteResource:output_type -> openchoreo.v1alpha1.DeleteResourceResponse
+	11, // 19: openchoreo.v1alpha1.ResourceService.ListDeployedObjects:output_type -> openchoreo.v1alpha1.ListDeployedObjectsResponse
+	13, // 20: openchoreo.v1alpha1.ResourceService.WatchResources:output_type -> openchoreo.v1alpha1.WatchResourcesResponse
+	15, // 21: openchoreo.v1alpha1.ResourceService.StreamWorkflowRunLogs:output_type -> openchoreo.v1alpha1.StreamWorkflowRunLogsResponse
+	15, // [15:22] is the sub-list for method output_type
+	8,  // [8:15] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_openchoreo_v1alpha1_resource_service_proto_init() }
+func file_openchoreo_v1alpha1_resource_service_proto_init() {
+	if File_openchoreo_v1alpha1_resource_service_proto != nil {
+		return
+	}
+	file_openchoreo_v1alpha1_resource_service_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_openchoreo_v1alpha1_resource_service_proto_rawDesc), len(file_openchoreo_v1alpha1_resource_service_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_openchoreo_v1alpha1_resource_service_proto_goTypes,
+		DependencyIndexes: file_openchoreo_v1alpha1_resource_service_proto_depIdxs,
+		EnumInfos:         file_openchoreo_v1alpha1_resource_service_proto_enumTypes,
+		MessageInfos:      file_openchoreo_v1alpha1_resource_service_proto_msgTypes,
+	}.Build()
+	File_openchoreo_v1alpha1_resource_service_proto = out.File
+	file_openchoreo_v1alpha1_resource_service_proto_goTypes = nil
+	file_openchoreo_v1alpha1_resource_service_proto_depIdxs = nil
+}
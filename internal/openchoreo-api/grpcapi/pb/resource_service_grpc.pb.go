@@ -0,0 +1,393 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: openchoreo/v1alpha1/resource_service.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ResourceService_GetResource_FullMethodName           = "/openchoreo.v1alpha1.ResourceService/GetResource"
+	ResourceService_ListResources_FullMethodName         = "/openchoreo.v1alpha1.ResourceService/ListResources"
+	ResourceService_ApplyResource_FullMethodName         = "/openchoreo.v1alpha1.ResourceService/ApplyResource"
+	ResourceService_DeleteResource_FullMethodName        = "/openchoreo.v1alpha1.ResourceService/DeleteResource"
+	ResourceService_ListDeployedObjects_FullMethodName   = "/openchoreo.v1alpha1.ResourceService/ListDeployedObjects"
+	ResourceService_WatchResources_FullMethodName        = "/openchoreo.v1alpha1.ResourceService/WatchResources"
+	ResourceService_StreamWorkflowRunLogs_FullMethodName = "/openchoreo.v1alpha1.ResourceService/StreamWorkflowRunLogs"
+)
+
+// ResourceServiceClient is the client API for ResourceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ResourceService manages Resources and streams changes and logs for internal
+// integrations such as the workflow plane and the CLI. It is backed by the
+// same services as the REST API and enforces the same authorization.
+//
+// Kubernetes objects are carried as google.protobuf.Struct values holding the
+// same JSON documents the REST API returns.
+type ResourceServiceClient interface {
+	// GetResource returns a Resource.
+	GetResource(ctx context.Context, in *GetResourceRequest, opts ...grpc.CallOption) (*GetResourceResponse, error)
+	// ListResources returns one page of the Resources in a namespace.
+	ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error)
+	// ApplyResource creates a Resource, or replaces it if it already exists.
+	ApplyResource(ctx context.Context, in *ApplyResourceRequest, opts ...grpc.CallOption) (*ApplyResourceResponse, error)
+	// DeleteResource deletes a Resource.
+	DeleteResource(ctx context.Context, in *DeleteResourceRequest, opts ...grpc.CallOption) (*DeleteResourceResponse, error)
+	// ListDeployedObjects returns the live objects the releases of a Resource
+	// applied to their data planes, read from each data plane cluster.
+	ListDeployedObjects(ctx context.Context, in *ListDeployedObjectsRequest, opts ...grpc.CallOption) (*ListDeployedObjectsResponse, error)
+	// WatchResources streams changes to OpenChoreo resources until the client
+	// cancels the call or the watch fails.
+	WatchResources(ctx context.Context, in *WatchResourcesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchResourcesResponse], error)
+	// StreamWorkflowRunLogs streams the logs of a workflow run. With follow set
+	// the stream stays open until the run completes.
+	StreamWorkflowRunLogs(ctx context.Context, in *StreamWorkflowRunLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamWorkflowRunLogsResponse], error)
+}
+
+type resourceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewResourceServiceClient(cc grpc.ClientConnInterface) ResourceServiceClient {
+	return &resourceServiceClient{cc}
+}
+
+func (c *resourceServiceClient) GetResource(ctx context.Context, in *GetResourceRequest, opts ...grpc.CallOption) (*GetResourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetResourceResponse)
+	err := c.cc.Invoke(ctx, ResourceService_GetResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResourcesResponse)
+	err := c.cc.Invoke(ctx, ResourceService_ListResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) ApplyResource(ctx context.Context, in *ApplyResourceRequest, opts ...grpc.CallOption) (*ApplyResourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyResourceResponse)
+	err := c.cc.Invoke(ctx, ResourceService_ApplyResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) DeleteResource(ctx context.Context, in *DeleteResourceRequest, opts ...grpc.CallOption) (*DeleteResourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteResourceResponse)
+	err := c.cc.Invoke(ctx, ResourceService_DeleteResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) ListDeployedObjects(ctx context.Context, in *ListDeployedObjectsRequest, opts ...grpc.CallOption) (*ListDeployedObjectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeployedObjectsResponse)
+	err := c.cc.Invoke(ctx, ResourceService_ListDeployedObjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) WatchResources(ctx context.Context, in *WatchResourcesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchResourcesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ResourceService_ServiceDesc.Streams[0], ResourceService_WatchResources_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchResourcesRequest, WatchResourcesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceService_WatchResourcesClient = grpc.ServerStreamingClient[WatchResourcesResponse]
+
+func (c *resourceServiceClient) StreamWorkflowRunLogs(ctx context.Context, in *StreamWorkflowRunLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamWorkflowRunLogsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ResourceService_ServiceDesc.Streams[1], ResourceService_StreamWorkflowRunLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamWorkflowRunLogsRequest, StreamWorkflowRunLogsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceService_StreamWorkflowRunLogsClient = grpc.ServerStreamingClient[StreamWorkflowRunLogsResponse]
+
+// ResourceServiceServer is the server API for ResourceService service.
+// All implementations must embed UnimplementedResourceServiceServer
+// for forward compatibility.
+//
+// ResourceService manages Resources and streams changes and logs for internal
+// integrations such as the workflow plane and the CLI. It is backed by the
+// same services as the REST API and enforces the same authorization.
+//
+// Kubernetes objects are carried as google.protobuf.Struct values holding the
+// same JSON documents the REST API returns.
+type ResourceServiceServer interface {
+	// GetResource returns a Resource.
+	GetResource(context.Context, *GetResourceRequest) (*GetResourceResponse, error)
+	// ListResources returns one page of the Resources in a namespace.
+	ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error)
+	// ApplyResource creates a Resource, or replaces it if it already exists.
+	ApplyResource(context.Context, *ApplyResourceRequest) (*ApplyResourceResponse, error)
+	// DeleteResource deletes a Resource.
+	DeleteResource(context.Context, *DeleteResourceRequest) (*DeleteResourceResponse, error)
+	// ListDeployedObjects returns the live objects the releases of a Resource
+	// applied to their data planes, read from each data plane cluster.
+	ListDeployedObjects(context.Context, *ListDeployedObjectsRequest) (*ListDeployedObjectsResponse, error)
+	// WatchResources streams changes to OpenChoreo resources until the client
+	// cancels the call or the watch fails.
+	WatchResources(*WatchResourcesRequest, grpc.ServerStreamingServer[WatchResourcesResponse]) error
+	// StreamWorkflowRunLogs streams the logs of a workflow run. With follow set
+	// the stream stays open until the run completes.
+	StreamWorkflowRunLogs(*StreamWorkflowRunLogsRequest, grpc.ServerStreamingServer[StreamWorkflowRunLogsResponse]) error
+	mustEmbedUnimplementedResourceServiceServer()
+}
+
+// UnimplementedResourceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedResourceServiceServer struct{}
+
+func (UnimplementedResourceServiceServer) GetResource(context.Context, *GetResourceRequest) (*GetResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResource not implemented")
+}
+func (UnimplementedResourceServiceServer) ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResources not implemented")
+}
+func (UnimplementedResourceServiceServer) ApplyResource(context.Context, *ApplyResourceRequest) (*ApplyResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyResource not implemented")
+}
+func (UnimplementedResourceServiceServer) DeleteResource(context.Context, *DeleteResourceRequest) (*DeleteResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteResource not implemented")
+}
+func (UnimplementedResourceServiceServer) ListDeployedObjects(context.Context, *ListDeployedObjectsRequest) (*ListDeployedObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeployedObjects not implemented")
+}
+func (UnimplementedResourceServiceServer) WatchResources(*WatchResourcesRequest, grpc.ServerStreamingServer[WatchResourcesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchResources not implemented")
+}
+func (UnimplementedResourceServiceServer) StreamWorkflowRunLogs(*StreamWorkflowRunLogsRequest, grpc.ServerStreamingServer[StreamWorkflowRunLogsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamWorkflowRunLogs not implemented")
+}
+func (UnimplementedResourceServiceServer) mustEmbedUnimplementedResourceServiceServer() {}
+func (UnimplementedResourceServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeResourceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ResourceServiceServer will
+// result in compilation errors.
+type UnsafeResourceServiceServer interface {
+	mustEmbedUnimplementedResourceServiceServer()
+}
+
+func RegisterResourceServiceServer(s grpc.ServiceRegistrar, srv ResourceServiceServer) {
+	// If the following call pancis, it indicates UnimplementedResourceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ResourceService_ServiceDesc, srv)
+}
+
+func _ResourceService_GetResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).GetResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_GetResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).GetResource(ctx, req.(*GetResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_ListResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).ListResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_ListResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).ListResources(ctx, req.(*ListResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_ApplyResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).ApplyResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_ApplyResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).ApplyResource(ctx, req.(*ApplyResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_DeleteResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).DeleteResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_DeleteResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).DeleteResource(ctx, req.(*DeleteResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_ListDeployedObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeployedObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).ListDeployedObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_ListDeployedObjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).ListDeployedObjects(ctx, req.(*ListDeployedObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_WatchResources_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchResourcesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResourceServiceServer).WatchResources(m, &grpc.GenericServerStream[WatchResourcesRequest, WatchResourcesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceService_WatchResourcesServer = grpc.ServerStreamingServer[WatchResourcesResponse]
+
+func _ResourceService_StreamWorkflowRunLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamWorkflowRunLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResourceServiceServer).StreamWorkflowRunLogs(m, &grpc.GenericServerStream[StreamWorkflowRunLogsRequest, StreamWorkflowRunLogsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResourceService_StreamWorkflowRunLogsServer = grpc.ServerStreamingServer[StreamWorkflowRunLogsResponse]
+
+// ResourceService_ServiceDesc is the grpc.ServiceDesc for ResourceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ResourceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openchoreo.v1alpha1.ResourceService",
+	HandlerType: (*ResourceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetResource",
+			Handler:    _ResourceService_GetResource_Handler,
+		},
+		{
+			MethodName: "ListResources",
+			Handler:    _ResourceService_ListResources_Handler,
+		},
+		{
+			MethodName: "ApplyResource",
+			Handler:    _ResourceService_ApplyResource_Handler,
+		},
+		{
+			MethodName: "DeleteResource",
+			Handler:    _ResourceService_DeleteResource_Handler,
+		},
+		{
+			MethodName: "ListDeployedObjects",
+			Handler:    _ResourceService_ListDeployedObjects_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchResources",
+			Handler:       _ResourceService_WatchResources_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamWorkflowRunLogs",
+			Handler:       _ResourceService_StreamWorkflowRunLogs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "openchoreo/v1alpha1/resource_service.proto",
+}
@@ -0,0 +1,710 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: openchoreo/v1alpha1/schema_service.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetSchemaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kind of the resource that defines the schema: ComponentType, Trait,
+	// Workflow, ResourceType, ProjectType, their Cluster* variants, or Component
+	// for the merged schema of a component's type and traits.
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Namespace of the resource. Must be empty for cluster-scoped kinds.
+	Namespace     string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSchemaRequest) Reset() {
+	*x = GetSchemaRequest{}
+	mi := &file_openchoreo_v1alpha1_schema_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchemaRequest) ProtoMessage() {}
+
+func (x *GetSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_openchoreo_v1alpha1_schema_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_schema_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetSchemaRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetSchemaRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetSchemaRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetSchemaResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Kind      string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Namespace string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// JSON Schema of the parameters.
+	Schema        *structpb.Struct `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSchemaResponse) Reset() {
+	*x = GetSchemaResponse{}
+	mi := &file_openchoreo_v1alpha1_schema_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchemaResponse) ProtoMessage() {}
+
+func (x *GetSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_openchoreo_v1alpha1_schema_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchemaResponse.ProtoReflect.Descriptor instead.
+func (*GetSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_schema_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetSchemaResponse) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetSchemaResponse) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetSchemaResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetSchemaResponse) GetSchema() *structpb.Struct {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+type GetPrinterColumnsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kind, plural, singular or short name of the kind, ignoring case.
+	Kind          string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrinterColumnsRequest) Reset() {
+	*x = GetPrinterColumnsRequest{}
+	mi := &file_openchoreo_v1alpha1_schema_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrinterColumnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrinterColumnsRequest) ProtoMessage() {}
+
+func (x *GetPrinterColumnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_openchoreo_v1alpha1_schema_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrinterColumnsRequest.ProtoReflect.Descriptor instead.
+func (*GetPrinterColumnsRequest) Descriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_schema_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetPrinterColumnsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type GetPrinterColumnsResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Kind       string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Plural     string                 `protobuf:"bytes,2,opt,name=plural,proto3" json:"plural,omitempty"`
+	Singular   string                 `protobuf:"bytes,3,opt,name=singular,proto3" json:"singular,omitempty"`
+	ShortNames []string               `protobuf:"bytes,4,rep,name=short_names,json=shortNames,proto3" json:"short_names,omitempty"`
+	// Whether resources of the kind belong to a namespace.
+	Namespaced    bool             `protobuf:"varint,5,opt,name=namespaced,proto3" json:"namespaced,omitempty"`
+	Columns       []*PrinterColumn `protobuf:"bytes,6,rep,name=columns,proto3" json:"columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrinterColumnsResponse) Reset() {
+	*x = GetPrinterColumnsResponse{}
+	mi := &file_openchoreo_v1alpha1_schema_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrinterColumnsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrinterColumnsResponse) ProtoMessage() {}
+
+func (x *GetPrinterColumnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_openchoreo_v1alpha1_schema_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrinterColumnsResponse.ProtoReflect.Descriptor instead.
+func (*GetPrinterColumnsResponse) Descriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_schema_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPrinterColumnsResponse) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetPrinterColumnsResponse) GetPlural() string {
+	if x != nil {
+		return x.Plural
+	}
+	return ""
+}
+
+func (x *GetPrinterColumnsResponse) GetSingular() string {
+	if x != nil {
+		return x.Singular
+	}
+	return ""
+}
+
+func (x *GetPrinterColumnsResponse) GetShortNames() []string {
+	if x != nil {
+		return x.ShortNames
+	}
+	return nil
+}
+
+func (x *GetPrinterColumnsResponse) GetNamespaced() bool {
+	if x != nil {
+		return x.Namespaced
+	}
+	return false
+}
+
+func (x *GetPrinterColumnsResponse) GetColumns() []*PrinterColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+// PrinterColumn is a column of the table view of a kind.
+type PrinterColumn struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// OpenAPI type of the column: string, integer, number, boolean or date.
+	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	// JSONPath selecting the column value from a resource.
+	JsonPath    string `protobuf:"bytes,4,opt,name=json_path,json=jsonPath,proto3" json:"json_path,omitempty"`
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// Columns with a priority above 0 are only shown in wide output.
+	Priority      int32 `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrinterColumn) Reset() {
+	*x = PrinterColumn{}
+	mi := &file_openchoreo_v1alpha1_schema_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrinterColumn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrinterColumn) ProtoMessage() {}
+
+func (x *PrinterColumn) ProtoReflect() protoreflect.Message {
+	mi := &file_openchoreo_v1alpha1_schema_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrinterColumn.ProtoReflect.Descriptor instead.
+func (*PrinterColumn) Descriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_schema_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PrinterColumn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PrinterColumn) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PrinterColumn) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *PrinterColumn) GetJsonPath() string {
+	if x != nil {
+		return x.JsonPath
+	}
+	return ""
+}
+
+func (x *PrinterColumn) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PrinterColumn) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type GetKindFieldsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kind, plural, singular or short name of the kind, ignoring case.
+	Kind          string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKindFieldsRequest) Reset() {
+	*x = GetKindFieldsRequest{}
+	mi := &file_openchoreo_v1alpha1_schema_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKindFieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKindFieldsRequest) ProtoMessage() {}
+
+func (x *GetKindFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_openchoreo_v1alpha1_schema_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKindFieldsRequest.ProtoReflect.Descriptor instead.
+func (*GetKindFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_schema_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetKindFieldsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type GetKindFieldsResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Kind        string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Group       string                 `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Version     string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Every field of the kind in depth-first order, with the fields of an
+	// object sorted by name.
+	Fields        []*KindField `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKindFieldsResponse) Reset() {
+	*x = GetKindFieldsResponse{}
+	mi := &file_openchoreo_v1alpha1_schema_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKindFieldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKindFieldsResponse) ProtoMessage() {}
+
+func (x *GetKindFieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_openchoreo_v1alpha1_schema_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKindFieldsResponse.ProtoReflect.Descriptor instead.
+func (*GetKindFieldsResponse) Descriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_schema_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetKindFieldsResponse) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetKindFieldsResponse) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *GetKindFieldsResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetKindFieldsResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GetKindFieldsResponse) GetFields() []*KindField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// KindField is a field of a kind.
+type KindField struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Dot-separated path of the field, such as spec.owner.projectName. Fields
+	// of array items and map values are addressed through the path of the
+	// array or map.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Type of the field, such as string, integer, Object, []Object or
+	// map[string]string.
+	Type        string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Whether the parent object requires the field.
+	Required bool     `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	Enum     []string `protobuf:"bytes,5,rep,name=enum,proto3" json:"enum,omitempty"`
+	// JSON encoding of the default value, empty when there is none.
+	Default       string `protobuf:"bytes,6,opt,name=default,proto3" json:"default,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KindField) Reset() {
+	*x = KindField{}
+	mi := &file_openchoreo_v1alpha1_schema_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KindField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KindField) ProtoMessage() {}
+
+func (x *KindField) ProtoReflect() protoreflect.Message {
+	mi := &file_openchoreo_v1alpha1_schema_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KindField.ProtoReflect.Descriptor instead.
+func (*KindField) Descriptor() ([]byte, []int) {
+	return file_openchoreo_v1alpha1_schema_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *KindField) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *KindField) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *KindField) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *KindField) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *KindField) GetEnum() []string {
+	if x != nil {
+		return x.Enum
+	}
+	return nil
+}
+
+func (x *KindField) GetDefault() string {
+	if x != nil {
+		return x.Default
+	}
+	return ""
+}
+
+var File_openchoreo_v1alpha1_schema_service_proto protoreflect.FileDescriptor
+
+const file_openchoreo_v1alpha1_schema_service_proto_rawDesc = "" +
+	"\n" +
+	"(openchoreo/v1alpha1/schema_service.proto\x12\x13openchoreo.v1alpha1\x1a\x1cgoogle/protobuf/struct.proto\"X\n" +
+	"\x10GetSchemaRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\x8a\x01\n" +
+	"\x11GetSchemaResponse\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12/\n" +
+	"\x06schema\x18\x04 \x01(\v2\x17.google.protobuf.StructR\x06schema\".\n" +
+	"\x18GetPrinterColumnsRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\"\xe2\x01\n" +
+	"\x19GetPrinterColumnsResponse\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06plural\x18\x02 \x01(\tR\x06plural\x12\x1a\n" +
+	"\bsingular\x18\x03 \x01(\tR\bsingular\x12\x1f\n" +
+	"\vshort_names\x18\x04 \x03(\tR\n" +
+	"shortNames\x12\x1e\n" +
+	"\n" +
+	"namespaced\x18\x05 \x01(\bR\n" +
+	"namespaced\x12<\n" +
+	"\acolumns\x18\x06 \x03(\v2\".openchoreo.v1alpha1.PrinterColumnR\acolumns\"\xaa\x01\n" +
+	"\rPrinterColumn\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x1b\n" +
+	"\tjson_path\x18\x04 \x01(\tR\bjsonPath\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bpriority\x18\x06 \x01(\x05R\bpriority\"*\n" +
+	"\x14GetKindFieldsRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\"\xb5\x01\n" +
+	"\x15GetKindFieldsResponse\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05group\x18\x02 \x01(\tR\x05group\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x126\n" +
+	"\x06fields\x18\x05 \x03(\v2\x1e.openchoreo.v1alpha1.KindFieldR\x06fields\"\x9f\x01\n" +
+	"\tKindField\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12\x12\n" +
+	"\x04enum\x18\x05 \x03(\tR\x04enum\x12\x18\n" +
+	"\adefault\x18\x06 \x01(\tR\adefault2\xc7\x02\n" +
+	"\rSchemaService\x12Z\n" +
+	"\tGetSchema\x12%.openchoreo.v1alpha1.GetSchemaRequest\x1a&.openchoreo.v1alpha1.GetSchemaResponse\x12r\n" +
+	"\x11GetPrinterColumns\x12-.openchoreo.v1alpha1.GetPrinterColumnsRequest\x1a..openchoreo.v1alpha1.GetPrinterColumnsResponse\x12f\n" +
+	"\rGetKindFields\x12).openchoreo.v1alpha1.GetKindFieldsRequest\x1a*.openchoreo.v1alpha1.GetKindFieldsResponseBHZFgithub.com/openchoreo/openchoreo/internal/openchoreo-api/grpcapi/pb;pbb\x06proto3"
+
+var (
+	file_openchoreo_v1alpha1_schema_service_proto_rawDescOnce sync.Once
+	file_openchoreo_v1alpha1_schema_service_proto_rawDescData []byte
+)
+
+func file_openchoreo_v1alpha1_schema_service_proto_rawDescGZIP() []byte {
+	file_openchoreo_v1alpha1_schema_service_proto_rawDescOnce.Do(func() {
+		file_openchoreo_v1alpha1_schema_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_openchoreo_v1alpha1_schema_service_proto_rawDesc), len(file_openchoreo_v1alpha1_schema_service_proto_rawDesc)))
+	})
+	return file_openchoreo_v1alpha1_schema_service_proto_rawDescData
+}
+
+var file_openchoreo_v1alpha1_schema_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_openchoreo_v1alpha1_schema_service_proto_goTypes = []any{
+	(*GetSchemaRequest)(nil),          // 0: openchoreo.v1alpha1.GetSchemaRequest
+	(*GetSchemaResponse)(nil),         // 1: openchoreo.v1alpha1.GetSchemaResponse
+	(*GetPrinterColumnsRequest)(nil),  // 2: openchoreo.v1alpha1.GetPrinterColumnsRequest
+	(*GetPrinterColumnsResponse)(nil), // 3: openchoreo.v1alpha1.GetPrinterColumnsResponse
+	(*PrinterColumn)(nil),             // 4: openchoreo.v1alpha1.PrinterColumn
+	(*GetKindFieldsRequest)(nil),      // 5: openchoreo.v1alpha1.GetKindFieldsRequest
+	(*GetKindFieldsResponse)(nil),     // 6: openchoreo.v1alpha1.GetKindFieldsResponse
+	(*KindField)(nil),                 // 7: openchoreo.v1alpha1.KindField
+	(*structpb.Struct)(nil),           // 8: google.protobuf.Struct
+}
+var file_openchoreo_v1alpha1_schema_service_proto_depIdxs = []int32{
+	8, // 0: openchoreo.v1alpha1.GetSchemaResponse.schema:type_name -> google.protobuf.Struct
+	4, // 1: openchoreo.v1alpha1.GetPrinterColumnsResponse.columns:type_name -> openchoreo.v1alpha1.PrinterColumn
+	7, // 2: openchoreo.v1alpha1.GetKindFieldsResponse.fields:type_name -> openchoreo.v1alpha1.KindField
+	0, // 3: openchoreo.v1alpha1.SchemaService.GetSchema:input_type -> openchoreo.v1alpha1.GetSchemaRequest
+	2, // 4: openchoreo.v1alpha1.SchemaService.GetPrinterColumns:input_type -> openchoreo.v1alpha1.GetPrinterColumnsRequest
+	5, // 5: openchoreo.v1alpha1.SchemaService.GetKindFields:input_type -> openchoreo.v1alpha1.GetKindFieldsRequest
+	1, // 6: openchoreo.v1alpha1.SchemaService.GetSchema:output_type -> openchoreo.v1alpha1.GetSchemaResponse
+	3, // 7: openchoreo.v1alpha1.SchemaService.GetPrinterColumns:output_type -> openchoreo.v1alpha1.GetPrinterColumnsResponse
+	6, // 8: openchoreo.v1alpha1.SchemaService.GetKindFields:output_type -> openchoreo.v1alpha1.GetKindFieldsResponse
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_openchoreo_v1alpha1_schema_service_proto_init() }
+func file_openchoreo_v1alpha1_schema_service_proto_init() {
+	if File_openchoreo_v1alpha1_schema_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_openchoreo_v1alpha1_schema_service_proto_rawDesc), len(file_openchoreo_v1alpha1_schema_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_openchoreo_v1alpha1_schema_service_proto_goTypes,
+		DependencyIndexes: file_openchoreo_v1alpha1_schema_service_proto_depIdxs,
+		MessageInfos:      file_openchoreo_v1alpha1_schema_service_proto_msgTypes,
+	}.Build()
+	File_openchoreo_v1alpha1_schema_service_proto = out.File
+	file_openchoreo_v1alpha1_schema_service_proto_goTypes = nil
+	file_openchoreo_v1alpha1_schema_service_proto_depIdxs = nil
+}
@@ -0,0 +1,216 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: openchoreo/v1alpha1/schema_service.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SchemaService_GetSchema_FullMethodName         = "/openchoreo.v1alpha1.SchemaService/GetSchema"
+	SchemaService_GetPrinterColumns_FullMethodName = "/openchoreo.v1alpha1.SchemaService/GetPrinterColumns"
+	SchemaService_GetKindFields_FullMethodName     = "/openchoreo.v1alpha1.SchemaService/GetKindFields"
+)
+
+// SchemaServiceClient is the client API for SchemaService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SchemaService returns the parameter schemas of OpenChoreo resources, and the
+// table layout and field documentation of OpenChoreo kinds.
+type SchemaServiceClient interface {
+	// GetSchema returns the parameter schema defined by a resource.
+	GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*GetSchemaResponse, error)
+	// GetPrinterColumns returns the additional printer columns declared by the
+	// CRD of a kind, used by clients to render resources of any kind as a table.
+	GetPrinterColumns(ctx context.Context, in *GetPrinterColumnsRequest, opts ...grpc.CallOption) (*GetPrinterColumnsResponse, error)
+	// GetKindFields returns every field of a kind, flattened from the schema of
+	// its CRD into dot-separated paths, used by clients to explain a kind.
+	GetKindFields(ctx context.Context, in *GetKindFieldsRequest, opts ...grpc.CallOption) (*GetKindFieldsResponse, error)
+}
+
+type schemaServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSchemaServiceClient(cc grpc.ClientConnInterface) SchemaServiceClient {
+	return &schemaServiceClient{cc}
+}
+
+func (c *schemaServiceClient) GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*GetSchemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSchemaResponse)
+	err := c.cc.Invoke(ctx, SchemaService_GetSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) GetPrinterColumns(ctx context.Context, in *GetPrinterColumnsRequest, opts ...grpc.CallOption) (*GetPrinterColumnsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPrinterColumnsResponse)
+	err := c.cc.Invoke(ctx, SchemaService_GetPrinterColumns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) GetKindFields(ctx context.Context, in *GetKindFieldsRequest, opts ...grpc.CallOption) (*GetKindFieldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKindFieldsResponse)
+	err := c.cc.Invoke(ctx, SchemaService_GetKindFields_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SchemaServiceServer is the server API for SchemaService service.
+// All implementations must embed UnimplementedSchemaServiceServer
+// for forward compatibility.
+//
+// SchemaService returns the parameter schemas of OpenChoreo resources, and the
+// table layout and field documentation of OpenChoreo kinds.
+type SchemaServiceServer interface {
+	// GetSchema returns the parameter schema defined by a resource.
+	GetSchema(context.Context, *GetSchemaRequest) (*GetSchemaResponse, error)
+	// GetPrinterColumns returns the additional printer columns declared by the
+	// CRD of a kind, used by clients to render resources of any kind as a table.
+	GetPrinterColumns(context.Context, *GetPrinterColumnsRequest) (*GetPrinterColumnsResponse, error)
+	// GetKindFields returns every field of a kind, flattened from the schema of
+	// its CRD into dot-separated paths, used by clients to explain a kind.
+	GetKindFields(context.Context, *GetKindFieldsRequest) (*GetKindFieldsResponse, error)
+	mustEmbedUnimplementedSchemaServiceServer()
+}
+
+// UnimplementedSchemaServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSchemaServiceServer struct{}
+
+func (UnimplementedSchemaServiceServer) GetSchema(context.Context, *GetSchemaRequest) (*GetSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchema not implemented")
+}
+func (UnimplementedSchemaServiceServer) GetPrinterColumns(context.Context, *GetPrinterColumnsRequest) (*GetPrinterColumnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrinterColumns not implemented")
+}
+func (UnimplementedSchemaServiceServer) GetKindFields(context.Context, *GetKindFieldsRequest) (*GetKindFieldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKindFields not implemented")
+}
+func (UnimplementedSchemaServiceServer) mustEmbedUnimplementedSchemaServiceServer() {}
+func (UnimplementedSchemaServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeSchemaServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SchemaServiceServer will
+// result in compilation errors.
+type UnsafeSchemaServiceServer interface {
+	mustEmbedUnimplementedSchemaServiceServer()
+}
+
+func RegisterSchemaServiceServer(s grpc.ServiceRegistrar, srv SchemaServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSchemaServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SchemaService_ServiceDesc, srv)
+}
+
+func _SchemaService_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).GetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchemaService_GetSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).GetSchema(ctx, req.(*GetSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_GetPrinterColumns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrinterColumnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).GetPrinterColumns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchemaService_GetPrinterColumns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).GetPrinterColumns(ctx, req.(*GetPrinterColumnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_GetKindFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKindFieldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).GetKindFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchemaService_GetKindFields_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).GetKindFields(ctx, req.(*GetKindFieldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SchemaService_ServiceDesc is the grpc.ServiceDesc for SchemaService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SchemaService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openchoreo.v1alpha1.SchemaService",
+	HandlerType: (*SchemaServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSchema",
+			Handler:    _SchemaService_GetSchema_Handler,
+		},
+		{
+			MethodName: "GetPrinterColumns",
+			Handler:    _SchemaService_GetPrinterColumns_Handler,
+		},
+		{
+			MethodName: "GetKindFields",
+			Handler:    _SchemaService_GetKindFields_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "openchoreo/v1alpha1/schema_service.proto",
+}
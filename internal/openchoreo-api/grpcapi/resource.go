@@ -0,0 +1,258 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/grpcapi/pb"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+	resourcesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/resource"
+	watchsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/watch"
+	workflowrunsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowrun"
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+// logPollInterval is how often a followed log stream checks for new logs.
+var logPollInterval = 2 * time.Second
+
+// resourceServer implements pb.ResourceServiceServer.
+type resourceServer struct {
+	pb.UnimplementedResourceServiceServer
+
+	services     *handlerservices.Services
+	watchService watchsvc.Service
+	logger       *slog.Logger
+}
+
+func (s *resourceServer) GetResource(ctx context.Context, req *pb.GetResourceRequest) (*pb.GetResourceResponse, error) {
+	resource, err := s.services.ResourceService.GetResource(ctx, req.GetNamespace(), req.GetName())
+	if err != nil {
+		return nil, s.toStatus(err, "get resource")
+	}
+	obj, err := resourceToStruct(resource)
+	if err != nil {
+		return nil, s.toStatus(err, "convert resource")
+	}
+	return &pb.GetResourceResponse{Resource: obj}, nil
+}
+
+func (s *resourceServer) ListResources(ctx context.Context, req *pb.ListResourcesRequest) (*pb.ListResourcesResponse, error) {
+	limit := int(req.GetLimit())
+	if limit < 1 {
+		limit = defaultPageLimit
+	}
+	opts := services.ListOptions{
+		Limit:         min(limit, maxPageLimit),
+		Cursor:        req.GetCursor(),
+		LabelSelector: req.GetLabelSelector(),
+		Sort:          req.GetSort(),
+	}
+	result, err := services.SortedList(ctx, opts, func(ctx context.Context, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.Resource], error) {
+		return s.services.ResourceService.ListResources(ctx, req.GetNamespace(), req.GetProject(), opts)
+	})
+	if err != nil {
+		return nil, s.toStatus(err, "list resources")
+	}
+
+	resp := &pb.ListResourcesResponse{
+		Items:          make([]*structpb.Struct, 0, len(result.Items)),
+		NextCursor:     result.NextCursor,
+		RemainingCount: result.RemainingCount,
+		TotalCount:     result.TotalCount,
+	}
+	for i := range result.Items {
+		obj, err := resourceToStruct(&result.Items[i])
+		if err != nil {
+			return nil, s.toStatus(err, "convert resource")
+		}
+		resp.Items = append(resp.Items, obj)
+	}
+	return resp, nil
+}
+
+func (s *resourceServer) ApplyResource(ctx context.Context, req *pb.ApplyResourceRequest) (*pb.ApplyResourceResponse, error) {
+	if req.GetResource() == nil {
+		return nil, status.Error(codes.InvalidArgument, "resource is required")
+	}
+	var desired openchoreov1alpha1.Resource
+	if err := fromStruct(req.GetResource(), &desired); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid resource: %v", err)
+	}
+	if desired.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "resource metadata.name is required")
+	}
+
+	_, err := s.services.ResourceService.GetResource(ctx, req.GetNamespace(), desired.Name)
+	if err != nil && !errors.Is(err, resourcesvc.ErrResourceNotFound) {
+		return nil, s.toStatus(err, "get resource")
+	}
+	operation := pb.ApplyResourceResponse_OPERATION_CREATED
+	var applied *openchoreov1alpha1.Resource
+	if err == nil {
+		operation = pb.ApplyResourceResponse_OPERATION_UPDATED
+		applied, err = s.services.ResourceService.UpdateResource(ctx, req.GetNamespace(), &desired)
+	} else {
+		applied, err = s.services.ResourceService.CreateResource(ctx, req.GetNamespace(), &desired)
+	}
+	if err != nil {
+		if errors.Is(err, resourcesvc.ErrResourceAlreadyExists) || errors.Is(err, resourcesvc.ErrResourceNotFound) {
+			// The resource was created or deleted concurrently.
+			return nil, status.Error(codes.Aborted, "resource was modified concurrently, retry the request")
+		}
+		if errors.Is(err, projectsvc.ErrProjectNotFound) {
+			return nil, status.Error(codes.InvalidArgument, "referenced project not found")
+		}
+		return nil, s.toStatus(err, "apply resource")
+	}
+
+	obj, err := resourceToStruct(applied)
+	if err != nil {
+		return nil, s.toStatus(err, "convert resource")
+	}
+	s.logger.Info("Resource applied", "namespace", req.GetNamespace(), "name", desired.Name, "operation", operation)
+	return &pb.ApplyResourceResponse{Operation: operation, Resource: obj}, nil
+}
+
+func (s *resourceServer) DeleteResource(ctx context.Context, req *pb.DeleteResourceRequest) (*pb.DeleteResourceResponse, error) {
+	if err := s.services.ResourceService.DeleteResource(ctx, req.GetNamespace(), req.GetName()); err != nil {
+		return nil, s.toStatus(err, "delete resource")
+	}
+	s.logger.Info("Resource deleted", "namespace", req.GetNamespace(), "name", req.GetName())
+	return &pb.DeleteResourceResponse{}, nil
+}
+
+func (s *resourceServer) ListDeployedObjects(ctx context.Context, req *pb.ListDeployedObjectsRequest) (*pb.ListDeployedObjectsResponse, error) {
+	objects, err := s.services.ResourceService.ListDeployedObjects(ctx, req.GetNamespace(), req.GetName(), req.GetCluster())
+	if err != nil {
+		return nil, s.toStatus(err, "list deployed objects")
+	}
+	resp := &pb.ListDeployedObjectsResponse{Items: make([]*structpb.Struct, 0, len(objects))}
+	for i := range objects {
+		obj, err := toStruct(&objects[i])
+		if err != nil {
+			return nil, s.toStatus(err, "convert deployed object")
+		}
+		resp.Items = append(resp.Items, obj)
+	}
+	return resp, nil
+}
+
+func (s *resourceServer) WatchResources(req *pb.WatchResourcesRequest, stream grpc.ServerStreamingServer[pb.WatchResourcesResponse]) error {
+	events, err := s.watchService.Watch(stream.Context(), &watchsvc.Request{
+		Kinds:         req.GetKinds(),
+		Namespaces:    req.GetNamespaces(),
+		Project:       req.GetProject(),
+		Component:     req.GetComponent(),
+		LabelSelector: req.GetLabelSelector(),
+		Token:         req.GetToken(),
+	})
+	if err != nil {
+		return s.toStatus(err, "start watch")
+	}
+
+	for ev := range events {
+		resp := &pb.WatchResourcesResponse{Kind: ev.Kind, Token: ev.Token}
+		switch ev.Type {
+		case watchsvc.EventAdded:
+			resp.Type = pb.WatchResourcesResponse_TYPE_ADDED
+		case watchsvc.EventModified:
+			resp.Type = pb.WatchResourcesResponse_TYPE_MODIFIED
+		case watchsvc.EventDeleted:
+			resp.Type = pb.WatchResourcesResponse_TYPE_DELETED
+		case watchsvc.EventBookmark:
+			resp.Type = pb.WatchResourcesResponse_TYPE_BOOKMARK
+		case watchsvc.EventError:
+			if errors.Is(ev.Err, watchsvc.ErrTokenExpired) {
+				return status.Error(codes.OutOfRange, "watch token expired; restart the watch without a token")
+			}
+			return s.toStatus(ev.Err, "watch")
+		}
+		if ev.Object != nil {
+			if resp.Object, err = toStruct(ev.Object); err != nil {
+				return s.toStatus(err, "convert watched object")
+			}
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	return stream.Context().Err()
+}
+
+func (s *resourceServer) StreamWorkflowRunLogs(
+	req *pb.StreamWorkflowRunLogsRequest,
+	stream grpc.ServerStreamingServer[pb.StreamWorkflowRunLogsResponse],
+) error {
+	ctx := stream.Context()
+	var sinceSeconds *int64
+	if req.GetSinceSeconds() > 0 {
+		sinceSeconds = ptr.To(req.GetSinceSeconds())
+	}
+
+	// Each poll returns every log line since the start of the window, so only
+	// the lines after those already sent are streamed.
+	sent := 0
+	for {
+		// Read the completion state before the logs, so the last poll of a
+		// completed run cannot miss lines written just before completion.
+		completed := true
+		if req.GetFollow() {
+			run, err := s.services.WorkflowRunService.GetWorkflowRun(ctx, req.GetNamespace(), req.GetRun())
+			if err != nil {
+				return s.toStatus(err, "get workflow run")
+			}
+			completed = run.Status.CompletedAt != nil
+		}
+
+		entries, err := s.services.WorkflowRunService.GetWorkflowRunLogs(ctx, req.GetNamespace(), req.GetRun(), req.GetTask(), sinceSeconds)
+		if err != nil && !(req.GetFollow() && errors.Is(err, workflowrunsvc.ErrWorkflowRunReferenceNotFound)) {
+			return s.toStatus(err, "get workflow run logs")
+		}
+		for _, entry := range entries[min(sent, len(entries)):] {
+			if err := stream.Send(&pb.StreamWorkflowRunLogsResponse{Timestamp: entry.Timestamp, Log: entry.Log}); err != nil {
+				return err
+			}
+		}
+		sent = max(sent, len(entries))
+
+		if completed {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(logPollInterval):
+		}
+	}
+}
+
+func (s *resourceServer) toStatus(err error, action string) error {
+	return toStatus(s.logger, err, action)
+}
+
+// resourceToStruct converts a Resource, setting the type metadata that
+// objects read through the Kubernetes client may lack.
+func resourceToStruct(resource *openchoreov1alpha1.Resource) (*structpb.Struct, error) {
+	r := resource.DeepCopy()
+	r.APIVersion = openchoreov1alpha1.GroupVersion.String()
+	r.Kind = "Resource"
+	return toStruct(r)
+}
@@ -0,0 +1,176 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package grpcapi
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/grpcapi/pb"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+)
+
+// schemaServer implements pb.SchemaServiceServer.
+type schemaServer struct {
+	pb.UnimplementedSchemaServiceServer
+
+	services *handlerservices.Services
+	logger   *slog.Logger
+}
+
+// clusterScopedKinds are the schema kinds addressed without a namespace.
+var clusterScopedKinds = map[string]bool{
+	"ClusterComponentType": true,
+	"ClusterProjectType":   true,
+	"ClusterResourceType":  true,
+	"ClusterTrait":         true,
+	"ClusterWorkflow":      true,
+}
+
+// namespacedSchemaKinds are the schema kinds addressed within a namespace.
+var namespacedSchemaKinds = map[string]bool{
+	"Component":     true,
+	"ComponentType": true,
+	"ProjectType":   true,
+	"ResourceType":  true,
+	"Trait":         true,
+	"Workflow":      true,
+}
+
+func (s *schemaServer) GetSchema(ctx context.Context, req *pb.GetSchemaRequest) (*pb.GetSchemaResponse, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	switch {
+	case clusterScopedKinds[req.GetKind()]:
+		if req.GetNamespace() != "" {
+			return nil, status.Errorf(codes.InvalidArgument, "kind %s is cluster-scoped and takes no namespace", req.GetKind())
+		}
+	case namespacedSchemaKinds[req.GetKind()]:
+		if req.GetNamespace() == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "namespace is required for kind %s", req.GetKind())
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported kind %q", req.GetKind())
+	}
+
+	schema, err := s.getSchema(ctx, req.GetKind(), req.GetNamespace(), req.GetName())
+	if err != nil {
+		return nil, toStatus(s.logger, err, "get schema")
+	}
+
+	obj, err := structpb.NewStruct(schema)
+	if err != nil {
+		return nil, toStatus(s.logger, err, "convert schema")
+	}
+	return &pb.GetSchemaResponse{
+		Kind:      req.GetKind(),
+		Namespace: req.GetNamespace(),
+		Name:      req.GetName(),
+		Schema:    obj,
+	}, nil
+}
+
+func (s *schemaServer) getSchema(ctx context.Context, kind, namespace, name string) (map[string]any, error) {
+	switch kind {
+	case "ClusterComponentType":
+		return s.services.ClusterComponentTypeService.GetClusterComponentTypeSchema(ctx, name)
+	case "ClusterProjectType":
+		return s.services.ClusterProjectTypeService.GetClusterProjectTypeSchema(ctx, name)
+	case "ClusterResourceType":
+		return s.services.ClusterResourceTypeService.GetClusterResourceTypeSchema(ctx, name)
+	case "ClusterTrait":
+		return s.services.ClusterTraitService.GetClusterTraitSchema(ctx, name)
+	case "ClusterWorkflow":
+		return s.services.ClusterWorkflowService.GetClusterWorkflowSchema(ctx, name)
+	case "ComponentType":
+		return s.services.ComponentTypeService.GetComponentTypeSchema(ctx, namespace, name)
+	case "ProjectType":
+		return s.services.ProjectTypeService.GetProjectTypeSchema(ctx, namespace, name)
+	case "ResourceType":
+		return s.services.ResourceTypeService.GetResourceTypeSchema(ctx, namespace, name)
+	case "Trait":
+		return s.services.TraitService.GetTraitSchema(ctx, namespace, name)
+	case "Workflow":
+		return s.services.WorkflowService.GetWorkflowSchema(ctx, namespace, name)
+	case "Component":
+		schema, err := s.services.ComponentService.GetComponentSchema(ctx, namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		obj, err := toStruct(schema)
+		if err != nil {
+			return nil, err
+		}
+		return obj.AsMap(), nil
+	}
+	return nil, fmt.Errorf("unsupported kind %q", kind)
+}
+
+func (s *schemaServer) GetPrinterColumns(ctx context.Context, req *pb.GetPrinterColumnsRequest) (*pb.GetPrinterColumnsResponse, error) {
+	if req.GetKind() == "" {
+		return nil, status.Error(codes.InvalidArgument, "kind is required")
+	}
+
+	pc, err := s.services.PrinterColumnService.GetPrinterColumns(ctx, req.GetKind())
+	if err != nil {
+		return nil, toStatus(s.logger, err, "get printer columns")
+	}
+
+	columns := make([]*pb.PrinterColumn, 0, len(pc.Columns))
+	for _, col := range pc.Columns {
+		columns = append(columns, &pb.PrinterColumn{
+			Name:        col.Name,
+			Type:        col.Type,
+			Format:      col.Format,
+			JsonPath:    col.JSONPath,
+			Description: col.Description,
+			Priority:    col.Priority,
+		})
+	}
+	return &pb.GetPrinterColumnsResponse{
+		Kind:       pc.Kind,
+		Plural:     pc.Plural,
+		Singular:   pc.Singular,
+		ShortNames: pc.ShortNames,
+		Namespaced: pc.Namespaced,
+		Columns:    columns,
+	}, nil
+}
+
+func (s *schemaServer) GetKindFields(ctx context.Context, req *pb.GetKindFieldsRequest) (*pb.GetKindFieldsResponse, error) {
+	if req.GetKind() == "" {
+		return nil, status.Error(codes.InvalidArgument, "kind is required")
+	}
+
+	kf, err := s.services.KindSchemaService.GetFields(ctx, req.GetKind())
+	if err != nil {
+		return nil, toStatus(s.logger, err, "get kind fields")
+	}
+
+	fields := make([]*pb.KindField, 0, len(kf.Fields))
+	for _, f := range kf.Fields {
+		fields = append(fields, &pb.KindField{
+			Path:        f.Path,
+			Type:        f.Type,
+			Description: f.Description,
+			Required:    f.Required,
+			Enum:        f.Enum,
+			Default:     f.Default,
+		})
+	}
+	return &pb.GetKindFieldsResponse{
+		Kind:        kf.Kind,
+		Group:       kf.Group,
+		Version:     kf.Version,
+		Description: kf.Description,
+		Fields:      fields,
+	}, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package grpcapi serves the gRPC ResourceService and SchemaService defined in
+// proto/openchoreo/v1alpha1. The services are thin adapters over the same
+// service layer as the REST and MCP handlers, so authorization and validation
+// behave identically across the three interfaces.
+package grpcapi
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/grpcapi/pb"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	watchsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/watch"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+// publicMethodPrefixes are the gRPC methods served without authentication.
+var publicMethodPrefixes = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.",
+}
+
+// NewServer creates a gRPC server with the OpenChoreo services, the standard
+// health service and server reflection registered. Every other call is
+// authenticated by authMiddleware, the JWT middleware of the REST API.
+func NewServer(
+	services *handlerservices.Services,
+	watchService watchsvc.Service,
+	authMiddleware func(http.Handler) http.Handler,
+	logger *slog.Logger,
+	opts ...grpc.ServerOption,
+) *grpc.Server {
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(auth.GRPCUnaryInterceptor(authMiddleware, publicMethodPrefixes...)),
+		grpc.ChainStreamInterceptor(auth.GRPCStreamInterceptor(authMiddleware, publicMethodPrefixes...)),
+	)
+	srv := grpc.NewServer(opts...)
+
+	pb.RegisterResourceServiceServer(srv, &resourceServer{
+		services:     services,
+		watchService: watchService,
+		logger:       logger.With("service", "ResourceService"),
+	})
+	pb.RegisterSchemaServiceServer(srv, &schemaServer{
+		services: services,
+		logger:   logger.With("service", "SchemaService"),
+	})
+
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(srv, healthServer)
+	reflection.Register(srv)
+
+	return srv
+}
+
+// toStruct converts a Kubernetes object, or any value with a JSON object
+// representation, into a protobuf Struct.
+func toStruct(obj any) (*structpb.Struct, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %T: %w", obj, err)
+	}
+	return structpb.NewStruct(content)
+}
+
+// fromStruct converts a protobuf Struct into the Kubernetes object obj.
+func fromStruct(s *structpb.Struct, obj any) error {
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(s.AsMap(), obj)
+}
@@ -0,0 +1,321 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package grpcapi
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/structpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/grpcapi/pb"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	clustertraitmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/clustertrait/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
+	kindschemasvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/kindschema"
+	kindschemamocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/kindschema/mocks"
+	printercolumnsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/printercolumn"
+	printercolumnmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/printercolumn/mocks"
+	resourcesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/resource"
+	resourcemocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/resource/mocks"
+	watchsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/watch"
+	workflowrunmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowrun/mocks"
+)
+
+// tokenMiddleware accepts requests carrying "Bearer good".
+func tokenMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer good" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+type fakeWatchService struct {
+	events []watchsvc.Event
+}
+
+func (f *fakeWatchService) Watch(_ context.Context, _ *watchsvc.Request) (<-chan watchsvc.Event, error) {
+	ch := make(chan watchsvc.Event, len(f.events))
+	for _, ev := range f.events {
+		ch <- ev
+	}
+	close(ch)
+	return ch, nil
+}
+
+// startServer serves svcs over an in-memory listener and returns a client
+// connection to it.
+func startServer(t *testing.T, svcs *handlerservices.Services, watchService watchsvc.Service) *grpc.ClientConn {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	srv := NewServer(svcs, watchService, tokenMiddleware, slog.New(slog.DiscardHandler))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func authorized() context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer good")
+}
+
+func newResource(name string) *openchoreov1alpha1.Resource {
+	return &openchoreov1alpha1.Resource{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+	}
+}
+
+func TestResourceService(t *testing.T) {
+	resources := resourcemocks.NewMockService(t)
+	conn := startServer(t, &handlerservices.Services{ResourceService: resources}, &fakeWatchService{})
+	client := pb.NewResourceServiceClient(conn)
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		_, err := client.GetResource(context.Background(), &pb.GetResourceRequest{Namespace: "ns", Name: "db"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("get", func(t *testing.T) {
+		resources.EXPECT().GetResource(mock.Anything, "ns", "db").Return(newResource("db"), nil).Once()
+		resp, err := client.GetResource(authorized(), &pb.GetResourceRequest{Namespace: "ns", Name: "db"})
+		require.NoError(t, err)
+		fields := resp.GetResource().AsMap()
+		assert.Equal(t, "Resource", fields["kind"])
+		assert.Equal(t, "db", fields["metadata"].(map[string]any)["name"])
+	})
+
+	t.Run("get not found", func(t *testing.T) {
+		resources.EXPECT().GetResource(mock.Anything, "ns", "missing").Return(nil, resourcesvc.ErrResourceNotFound).Once()
+		_, err := client.GetResource(authorized(), &pb.GetResourceRequest{Namespace: "ns", Name: "missing"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("get forbidden", func(t *testing.T) {
+		resources.EXPECT().GetResource(mock.Anything, "ns", "secret").Return(nil, services.ErrForbidden).Once()
+		_, err := client.GetResource(authorized(), &pb.GetResourceRequest{Namespace: "ns", Name: "secret"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("apply creates", func(t *testing.T) {
+		resources.EXPECT().GetResource(mock.Anything, "ns", "db").Return(nil, resourcesvc.ErrResourceNotFound).Once()
+		resources.EXPECT().CreateResource(mock.Anything, "ns", mock.Anything).Return(newResource("db"), nil).Once()
+		obj, err := structpb.NewStruct(map[string]any{"metadata": map[string]any{"name": "db"}})
+		require.NoError(t, err)
+		resp, err := client.ApplyResource(authorized(), &pb.ApplyResourceRequest{Namespace: "ns", Resource: obj})
+		require.NoError(t, err)
+		assert.Equal(t, pb.ApplyResourceResponse_OPERATION_CREATED, resp.GetOperation())
+	})
+
+	t.Run("apply updates", func(t *testing.T) {
+		resources.EXPECT().GetResource(mock.Anything, "ns", "db").Return(newResource("db"), nil).Once()
+		resources.EXPECT().UpdateResource(mock.Anything, "ns", mock.Anything).Return(newResource("db"), nil).Once()
+		obj, err := structpb.NewStruct(map[string]any{"metadata": map[string]any{"name": "db"}})
+		require.NoError(t, err)
+		resp, err := client.ApplyResource(authorized(), &pb.ApplyResourceRequest{Namespace: "ns", Resource: obj})
+		require.NoError(t, err)
+		assert.Equal(t, pb.ApplyResourceResponse_OPERATION_UPDATED, resp.GetOperation())
+	})
+
+	t.Run("list deployed objects", func(t *testing.T) {
+		resources.EXPECT().ListDeployedObjects(mock.Anything, "ns", "db", "dp-east").Return([]models.DeployedObject{
+			{Environment: "dev", Cluster: "dp-east", Kind: "StatefulSet", Name: "db", HealthStatus: "Healthy"},
+		}, nil).Once()
+		resp, err := client.ListDeployedObjects(authorized(), &pb.ListDeployedObjectsRequest{Namespace: "ns", Name: "db", Cluster: "dp-east"})
+		require.NoError(t, err)
+		require.Len(t, resp.GetItems(), 1)
+		fields := resp.GetItems()[0].AsMap()
+		assert.Equal(t, "StatefulSet", fields["kind"])
+		assert.Equal(t, "dp-east", fields["cluster"])
+	})
+
+	t.Run("apply without name", func(t *testing.T) {
+		obj, err := structpb.NewStruct(map[string]any{"metadata": map[string]any{}})
+		require.NoError(t, err)
+		_, err = client.ApplyResource(authorized(), &pb.ApplyResourceRequest{Namespace: "ns", Resource: obj})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestResourceService_WatchResources(t *testing.T) {
+	watch := &fakeWatchService{events: []watchsvc.Event{
+		{Type: watchsvc.EventAdded, Kind: "Resource", Object: newResource("db"), Token: "1"},
+		{Type: watchsvc.EventBookmark, Token: "2"},
+		{Type: watchsvc.EventError, Err: watchsvc.ErrTokenExpired},
+	}}
+	conn := startServer(t, &handlerservices.Services{}, watch)
+
+	stream, err := pb.NewResourceServiceClient(conn).WatchResources(authorized(), &pb.WatchResourcesRequest{})
+	require.NoError(t, err)
+
+	ev, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, pb.WatchResourcesResponse_TYPE_ADDED, ev.GetType())
+	assert.Equal(t, "1", ev.GetToken())
+	assert.NotNil(t, ev.GetObject())
+
+	ev, err = stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, pb.WatchResourcesResponse_TYPE_BOOKMARK, ev.GetType())
+
+	_, err = stream.Recv()
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+}
+
+func TestResourceService_StreamWorkflowRunLogs(t *testing.T) {
+	defer func(d time.Duration) { logPollInterval = d }(logPollInterval)
+	logPollInterval = time.Millisecond
+
+	runs := workflowrunmocks.NewMockService(t)
+	running := &openchoreov1alpha1.WorkflowRun{}
+	completed := &openchoreov1alpha1.WorkflowRun{}
+	completed.Status.CompletedAt = &metav1.Time{Time: time.Now()}
+	runs.EXPECT().GetWorkflowRun(mock.Anything, "ns", "run-1").Return(running, nil).Once()
+	runs.EXPECT().GetWorkflowRun(mock.Anything, "ns", "run-1").Return(completed, nil).Once()
+	runs.EXPECT().GetWorkflowRunLogs(mock.Anything, "ns", "run-1", "", (*int64)(nil)).
+		Return([]models.WorkflowRunLogEntry{{Log: "one"}}, nil).Once()
+	runs.EXPECT().GetWorkflowRunLogs(mock.Anything, "ns", "run-1", "", (*int64)(nil)).
+		Return([]models.WorkflowRunLogEntry{{Log: "one"}, {Log: "two"}}, nil).Once()
+	conn := startServer(t, &handlerservices.Services{WorkflowRunService: runs}, &fakeWatchService{})
+
+	stream, err := pb.NewResourceServiceClient(conn).StreamWorkflowRunLogs(authorized(),
+		&pb.StreamWorkflowRunLogsRequest{Namespace: "ns", Run: "run-1", Follow: true})
+	require.NoError(t, err)
+
+	var lines []string
+	for {
+		entry, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		lines = append(lines, entry.GetLog())
+	}
+	assert.Equal(t, []string{"one", "two"}, lines)
+}
+
+func TestSchemaService_GetSchema(t *testing.T) {
+	clusterTraits := clustertraitmocks.NewMockService(t)
+	conn := startServer(t, &handlerservices.Services{ClusterTraitService: clusterTraits}, &fakeWatchService{})
+	client := pb.NewSchemaServiceClient(conn)
+
+	t.Run("cluster kind", func(t *testing.T) {
+		clusterTraits.EXPECT().GetClusterTraitSchema(mock.Anything, "autoscale").
+			Return(map[string]any{"type": "object"}, nil).Once()
+		resp, err := client.GetSchema(authorized(), &pb.GetSchemaRequest{Kind: "ClusterTrait", Name: "autoscale"})
+		require.NoError(t, err)
+		assert.Equal(t, "object", resp.GetSchema().AsMap()["type"])
+		assert.Equal(t, "autoscale", resp.GetName())
+	})
+
+	tests := []struct {
+		name string
+		req  *pb.GetSchemaRequest
+	}{
+		{"unknown kind", &pb.GetSchemaRequest{Kind: "Unknown", Name: "x"}},
+		{"missing name", &pb.GetSchemaRequest{Kind: "ClusterTrait"}},
+		{"namespace on cluster kind", &pb.GetSchemaRequest{Kind: "ClusterTrait", Namespace: "ns", Name: "x"}},
+		{"missing namespace", &pb.GetSchemaRequest{Kind: "Trait", Name: "x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.GetSchema(authorized(), tt.req)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestSchemaService_GetPrinterColumns(t *testing.T) {
+	printerColumns := printercolumnmocks.NewMockService(t)
+	conn := startServer(t, &handlerservices.Services{PrinterColumnService: printerColumns}, &fakeWatchService{})
+	client := pb.NewSchemaServiceClient(conn)
+
+	t.Run("known kind", func(t *testing.T) {
+		printerColumns.EXPECT().GetPrinterColumns(mock.Anything, "comp").Return(&printercolumnsvc.PrinterColumns{
+			Kind: "Component", Plural: "components", Namespaced: true,
+			Columns: []printercolumnsvc.Column{{Name: "Project", Type: "string", JSONPath: ".spec.owner.projectName"}},
+		}, nil).Once()
+		resp, err := client.GetPrinterColumns(authorized(), &pb.GetPrinterColumnsRequest{Kind: "comp"})
+		require.NoError(t, err)
+		assert.Equal(t, "Component", resp.GetKind())
+		assert.True(t, resp.GetNamespaced())
+		require.Len(t, resp.GetColumns(), 1)
+		assert.Equal(t, ".spec.owner.projectName", resp.GetColumns()[0].GetJsonPath())
+	})
+
+	t.Run("unknown kind", func(t *testing.T) {
+		printerColumns.EXPECT().GetPrinterColumns(mock.Anything, "gadget").Return(nil, printercolumnsvc.ErrKindNotFound).Once()
+		_, err := client.GetPrinterColumns(authorized(), &pb.GetPrinterColumnsRequest{Kind: "gadget"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("missing kind", func(t *testing.T) {
+		_, err := client.GetPrinterColumns(authorized(), &pb.GetPrinterColumnsRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSchemaService_GetKindFields(t *testing.T) {
+	kindSchemas := kindschemamocks.NewMockService(t)
+	conn := startServer(t, &handlerservices.Services{KindSchemaService: kindSchemas}, &fakeWatchService{})
+	client := pb.NewSchemaServiceClient(conn)
+
+	t.Run("known kind", func(t *testing.T) {
+		kindSchemas.EXPECT().GetFields(mock.Anything, "comp").Return(&kindschemasvc.KindFields{
+			Kind: "Component", Group: "openchoreo.dev", Version: "v1alpha1",
+			Fields: []kindschemasvc.Field{{Path: "spec.owner.projectName", Type: "string", Required: true}},
+		}, nil).Once()
+		resp, err := client.GetKindFields(authorized(), &pb.GetKindFieldsRequest{Kind: "comp"})
+		require.NoError(t, err)
+		assert.Equal(t, "Component", resp.GetKind())
+		assert.Equal(t, "v1alpha1", resp.GetVersion())
+		require.Len(t, resp.GetFields(), 1)
+		assert.Equal(t, "spec.owner.projectName", resp.GetFields()[0].GetPath())
+		assert.True(t, resp.GetFields()[0].GetRequired())
+	})
+
+	t.Run("unknown kind", func(t *testing.T) {
+		kindSchemas.EXPECT().GetFields(mock.Anything, "gadget").Return(nil, kindschemasvc.ErrKindNotFound).Once()
+		_, err := client.GetKindFields(authorized(), &pb.GetKindFieldsRequest{Kind: "gadget"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("missing kind", func(t *testing.T) {
+		_, err := client.GetKindFields(authorized(), &pb.GetKindFieldsRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestHealthIsPublic(t *testing.T) {
+	conn := startServer(t, &handlerservices.Services{}, &fakeWatchService{})
+	resp, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/openchoreo/openchoreo/internal/controller"
 	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	clustercomponenttypesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/clustercomponenttype"
 	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
 	componenttypesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/componenttype"
@@ -93,6 +95,100 @@ func (h *MCPHandler) CreateComponent(
 	}), nil
 }
 
+func (h *MCPHandler) ScaffoldComponent(
+	ctx context.Context, namespaceName, projectName string, opts tools.ScaffoldComponentOpts,
+) (any, error) {
+	req := &componentsvc.ScaffoldComponentRequest{
+		Name:               opts.Name,
+		ProjectName:        projectName,
+		DisplayName:        opts.DisplayName,
+		Description:        opts.Description,
+		Type:               openchoreov1alpha1.WorkloadType(opts.Type),
+		ClassName:          opts.ClassName,
+		ClassKind:          openchoreov1alpha1.ComponentTypeRefKind(opts.ClassKind),
+		Parameters:         opts.Parameters,
+		AutoDeploy:         opts.AutoDeploy,
+		RepositoryURL:      opts.RepositoryURL,
+		Branch:             opts.Branch,
+		AppPath:            opts.AppPath,
+		WorkflowParameters: opts.WorkflowParameters,
+		Image:              opts.Image,
+		Port:               opts.Port,
+	}
+	if opts.WorkflowName != "" {
+		req.Workflow = &openchoreov1alpha1.WorkflowRef{
+			Kind: openchoreov1alpha1.WorkflowRefKind(opts.WorkflowKind),
+			Name: opts.WorkflowName,
+		}
+	}
+
+	result, err := h.services.ComponentService.ScaffoldComponent(ctx, namespaceName, req)
+	if err != nil {
+		return nil, err
+	}
+	extras := map[string]any{
+		"componentType": result.Component.Spec.ComponentType.Name,
+	}
+	if wf := result.Component.Spec.Workflow; wf != nil {
+		extras["workflow"] = wf.Name
+	}
+	if result.Workload != nil {
+		extras["workload"] = result.Workload.Name
+	}
+	return mutationResult(result.Component, "created", extras), nil
+}
+
+// ImportComponent generates a component, and its workload, from the component
+// descriptor of a Git repository and creates them, or returns them on a dry run.
+func (h *MCPHandler) ImportComponent(
+	ctx context.Context, namespaceName, projectName string, opts tools.ImportComponentOpts,
+) (any, error) {
+	generated, err := h.services.ComponentService.GenerateComponentFromRepository(ctx, namespaceName,
+		&componentsvc.ImportComponentRequest{
+			ProjectName:    projectName,
+			RepositoryURL:  opts.RepositoryURL,
+			Ref:            opts.Ref,
+			AppPath:        opts.AppPath,
+			DescriptorPath: opts.DescriptorPath,
+			Name:           opts.Name,
+			Type:           openchoreov1alpha1.WorkloadType(opts.Type),
+			ClassName:      opts.ClassName,
+			ClassKind:      openchoreov1alpha1.ComponentTypeRefKind(opts.ClassKind),
+			Image:          opts.Image,
+			AutoDeploy:     opts.AutoDeploy,
+		})
+	if err != nil {
+		return nil, err
+	}
+	if opts.DryRun {
+		preview := map[string]any{
+			"descriptorPath": generated.DescriptorPath,
+			"component":      componentDetail(generated.Component),
+		}
+		if generated.Workload != nil {
+			preview["workload"] = workloadDetail(generated.Workload)
+		}
+		return preview, nil
+	}
+
+	result, err := h.services.ComponentService.CreateComponentWithWorkload(ctx, namespaceName,
+		generated.Component, generated.Workload)
+	if err != nil {
+		return nil, err
+	}
+	extras := map[string]any{
+		"descriptorPath": generated.DescriptorPath,
+		"componentType":  result.Component.Spec.ComponentType.Name,
+	}
+	if wf := result.Component.Spec.Workflow; wf != nil {
+		extras["workflow"] = wf.Name
+	}
+	if result.Workload != nil {
+		extras["workload"] = result.Workload.Name
+	}
+	return mutationResult(result.Component, "created", extras), nil
+}
+
 func (h *MCPHandler) ListComponents(ctx context.Context, namespaceName, projectName string, opts tools.ListOpts) (any, error) {
 	result, err := h.services.ComponentService.ListComponents(ctx, namespaceName, projectName, toServiceListOptions(opts))
 	if err != nil {
@@ -183,6 +279,43 @@ func (h *MCPHandler) GetReleaseBinding(
 	return releaseBindingDetail(rb), nil
 }
 
+// GetComponentEndpoints resolves the URLs at which a component's endpoints can be
+// invoked in each environment it is deployed to, as reported on the status of its
+// release bindings. When environment is set only that environment is reported.
+func (h *MCPHandler) GetComponentEndpoints(
+	ctx context.Context, namespaceName, componentName, environment string,
+) (any, error) {
+	bindings, err := listAllPages(
+		func(opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ReleaseBinding], error) {
+			return h.services.ReleaseBindingService.ListReleaseBindings(ctx, namespaceName, componentName, opts)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	envs := make([]map[string]any, 0, len(bindings))
+	for i := range bindings {
+		rb := &bindings[i]
+		if environment != "" && rb.Spec.Environment != environment {
+			continue
+		}
+		m := map[string]any{
+			"environment": rb.Spec.Environment,
+			"endpoints":   componentEndpointsSummary(rb.Status.Endpoints),
+		}
+		setIfNotEmpty(m, "releaseName", rb.Spec.ReleaseName)
+		setIfNotEmpty(m, "status", readyStatus(rb.Status.Conditions))
+		envs = append(envs, m)
+	}
+	if environment != "" && len(envs) == 0 {
+		return nil, fmt.Errorf("component %q is not deployed to environment %q", componentName, environment)
+	}
+	return map[string]any{
+		"component":    componentName,
+		"environments": envs,
+	}, nil
+}
+
 func (h *MCPHandler) CreateReleaseBinding(
 	ctx context.Context, namespaceName string,
 	req *gen.ReleaseBindingSpec,
@@ -765,6 +898,63 @@ func (h *MCPHandler) TriggerWorkflowRun(
 	}), nil
 }
 
+// TriggerBuild builds a component from its configured workflow at the given commit
+// and/or branch. Unlike TriggerWorkflowRun, the commit and branch are injected at
+// the parameter paths the workflow schema maps as repository commit/branch, so the
+// build identifier returned here can be polled with GetWorkflowRunStatus.
+func (h *MCPHandler) TriggerBuild(
+	ctx context.Context, namespaceName, projectName, componentName, commit, branch string,
+) (any, error) {
+	resp, err := h.services.WorkflowRunService.TriggerWorkflowAtRef(
+		ctx, namespaceName, projectName, componentName, commit, branch)
+	if err != nil {
+		return nil, err
+	}
+	m := map[string]any{
+		"build_id":  resp.Name,
+		"component": resp.ComponentName,
+		"project":   resp.ProjectName,
+		"namespace": resp.NamespaceName,
+		"status":    resp.Status,
+		"action":    "triggered",
+	}
+	setIfNotEmpty(m, "commit", resp.Commit)
+	setIfNotEmpty(m, "branch", resp.Branch)
+	return m, nil
+}
+
+// GetBuildSBOM returns the SBOM reference of a build, or of the image a component currently runs
+// in an environment when no build is given.
+func (h *MCPHandler) GetBuildSBOM(
+	ctx context.Context, namespaceName, buildID, componentName, environmentName string,
+) (any, error) {
+	var (
+		sbom *models.WorkflowRunSBOMResponse
+		err  error
+	)
+	switch {
+	case buildID != "":
+		sbom, err = h.services.WorkflowRunService.GetWorkflowRunSBOM(ctx, namespaceName, buildID)
+	case componentName != "" && environmentName != "":
+		sbom, err = h.services.WorkflowRunService.GetDeployedSBOM(ctx, namespaceName, componentName, environmentName)
+	default:
+		return nil, fmt.Errorf("either build_id or both component_name and environment_name are required")
+	}
+	if err != nil {
+		return nil, err
+	}
+	m := map[string]any{
+		"build_id": sbom.RunName,
+		"location": sbom.Location,
+	}
+	setIfNotEmpty(m, "image", sbom.Image)
+	setIfNotEmpty(m, "format", sbom.Format)
+	if sbom.RecordedAt != nil {
+		m["recorded_at"] = sbom.RecordedAt
+	}
+	return m, nil
+}
+
 // parseComponentTypeFormat splits a "{workloadType}/{name}" component type string.
 func parseComponentTypeFormat(componentType string) (workloadType, name string, err error) {
 	parts := strings.SplitN(componentType, "/", 2)
@@ -18,15 +18,20 @@ import (
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/controller"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	clustercomponenttypesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/clustercomponenttype"
 	clustercomponenttypemocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/clustercomponenttype/mocks"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
 	componentmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component/mocks"
 	componentreleasemocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/componentrelease/mocks"
 	componenttypesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/componenttype"
 	componenttypemocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/componenttype/mocks"
 	releasebindingmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/releasebinding/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowrun"
 	workflowrunmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowrun/mocks"
 	workloadmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workload/mocks"
+	"github.com/openchoreo/openchoreo/pkg/mcp/tools"
 )
 
 const (
@@ -341,6 +346,110 @@ func TestCreateComponent(t *testing.T) {
 // PatchComponent
 // ---------------------------------------------------------------------------
 
+func TestScaffoldComponent(t *testing.T) {
+	ctx := context.Background()
+
+	compSvc := componentmocks.NewMockService(t)
+	compSvc.EXPECT().
+		ScaffoldComponent(mock.Anything, testNS, mock.MatchedBy(func(req *componentsvc.ScaffoldComponentRequest) bool {
+			return req.Name == "api" &&
+				req.ProjectName == testProject &&
+				req.Type == openchoreov1alpha1.WorkloadTypeService &&
+				req.Workflow != nil && req.Workflow.Name == "docker" &&
+				req.RepositoryURL == "https://github.com/example/api"
+		})).
+		Return(&componentsvc.ScaffoldComponentResult{
+			Component: &openchoreov1alpha1.Component{
+				ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: testNS},
+				Spec: openchoreov1alpha1.ComponentSpec{
+					ComponentType: openchoreov1alpha1.ComponentTypeRef{Name: "deployment/service"},
+					Workflow:      &openchoreov1alpha1.ComponentWorkflowConfig{Name: "docker"},
+				},
+			},
+		}, nil)
+
+	h := newTestHandler(withComponentService(compSvc))
+	result, err := h.ScaffoldComponent(ctx, testNS, testProject, tools.ScaffoldComponentOpts{
+		Name:          "api",
+		Type:          "Service",
+		ClassName:     "service",
+		RepositoryURL: "https://github.com/example/api",
+		WorkflowName:  "docker",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"name":          "api",
+		"namespace":     testNS,
+		"action":        "created",
+		"componentType": "deployment/service",
+		"workflow":      "docker",
+	}, result)
+}
+
+func TestImportComponent(t *testing.T) {
+	ctx := context.Background()
+	generated := func() *componentsvc.ImportComponentResult {
+		return &componentsvc.ImportComponentResult{
+			DescriptorPath: ".choreo/component.yaml",
+			Component: &openchoreov1alpha1.Component{
+				ObjectMeta: metav1.ObjectMeta{Name: "orders"},
+				Spec: openchoreov1alpha1.ComponentSpec{
+					Owner:         openchoreov1alpha1.ComponentOwner{ProjectName: testProject},
+					ComponentType: openchoreov1alpha1.ComponentTypeRef{Name: "deployment/service"},
+				},
+			},
+			Workload: &openchoreov1alpha1.Workload{ObjectMeta: metav1.ObjectMeta{Name: "orders-workload"}},
+		}
+	}
+	isImportRequest := mock.MatchedBy(func(req *componentsvc.ImportComponentRequest) bool {
+		return req.ProjectName == testProject &&
+			req.RepositoryURL == "https://github.com/acme/shop" &&
+			req.Ref == "v1" && req.AutoDeploy
+	})
+	opts := tools.ImportComponentOpts{RepositoryURL: "https://github.com/acme/shop", Ref: "v1", AutoDeploy: true}
+
+	t.Run("creates generated resources", func(t *testing.T) {
+		imported := generated()
+		compSvc := componentmocks.NewMockService(t)
+		compSvc.EXPECT().GenerateComponentFromRepository(mock.Anything, testNS, isImportRequest).Return(imported, nil)
+		compSvc.EXPECT().CreateComponentWithWorkload(mock.Anything, testNS, imported.Component, imported.Workload).
+			Return(&componentsvc.ScaffoldComponentResult{Component: imported.Component, Workload: imported.Workload}, nil)
+
+		result, err := newTestHandler(withComponentService(compSvc)).ImportComponent(ctx, testNS, testProject, opts)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"name":           "orders",
+			"action":         "created",
+			"descriptorPath": ".choreo/component.yaml",
+			"componentType":  "deployment/service",
+			"workload":       "orders-workload",
+		}, result)
+	})
+
+	t.Run("dry run creates nothing", func(t *testing.T) {
+		compSvc := componentmocks.NewMockService(t)
+		compSvc.EXPECT().GenerateComponentFromRepository(mock.Anything, testNS, isImportRequest).Return(generated(), nil)
+
+		dryRun := opts
+		dryRun.DryRun = true
+		result, err := newTestHandler(withComponentService(compSvc)).ImportComponent(ctx, testNS, testProject, dryRun)
+		require.NoError(t, err)
+		m := result.(map[string]any)
+		assert.Equal(t, ".choreo/component.yaml", m["descriptorPath"])
+		assert.Equal(t, "orders", m["component"].(map[string]any)["name"])
+		assert.Equal(t, "orders-workload", m["workload"].(map[string]any)["name"])
+	})
+
+	t.Run("generation error", func(t *testing.T) {
+		compSvc := componentmocks.NewMockService(t)
+		compSvc.EXPECT().GenerateComponentFromRepository(mock.Anything, testNS, mock.Anything).
+			Return(nil, componentsvc.ErrDescriptorNotFound)
+
+		_, err := newTestHandler(withComponentService(compSvc)).ImportComponent(ctx, testNS, testProject, opts)
+		require.ErrorIs(t, err, componentsvc.ErrDescriptorNotFound)
+	})
+}
+
 func TestPatchComponent(t *testing.T) {
 	ctx := context.Background()
 
@@ -1067,3 +1176,184 @@ func TestTriggerWorkflowRun(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+// ---------------------------------------------------------------------------
+// TriggerBuild
+// ---------------------------------------------------------------------------
+
+func TestTriggerBuild(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns build id and ref", func(t *testing.T) {
+		wfrSvc := workflowrunmocks.NewMockService(t)
+		wfrSvc.EXPECT().
+			TriggerWorkflowAtRef(mock.Anything, testNS, "", testComponent, "", "main").
+			Return(&models.WorkflowRunTriggerResponse{
+				Name:          testComponent + "-run-abc12345",
+				ComponentName: testComponent,
+				ProjectName:   testProject,
+				NamespaceName: testNS,
+				Branch:        "main",
+				Status:        "Pending",
+			}, nil)
+
+		h := newTestHandler(withWorkflowRunService(wfrSvc))
+		result, err := h.TriggerBuild(ctx, testNS, "", testComponent, "", "main")
+		require.NoError(t, err)
+		m := result.(map[string]any)
+		assert.Equal(t, testComponent+"-run-abc12345", m["build_id"])
+		assert.Equal(t, "main", m["branch"])
+		assert.Equal(t, "Pending", m["status"])
+		assert.NotContains(t, m, "commit")
+	})
+
+	t.Run("propagates service error", func(t *testing.T) {
+		wfrSvc := workflowrunmocks.NewMockService(t)
+		wfrSvc.EXPECT().
+			TriggerWorkflowAtRef(mock.Anything, testNS, testProject, testComponent, "abc1234", "").
+			Return(nil, workflowrun.ErrInvalidCommitSHA)
+
+		h := newTestHandler(withWorkflowRunService(wfrSvc))
+		_, err := h.TriggerBuild(ctx, testNS, testProject, testComponent, "abc1234", "")
+		require.ErrorIs(t, err, workflowrun.ErrInvalidCommitSHA)
+	})
+}
+
+// ---------------------------------------------------------------------------
+// GetBuildSBOM
+// ---------------------------------------------------------------------------
+
+func TestGetBuildSBOM(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("by build id", func(t *testing.T) {
+		wfrSvc := workflowrunmocks.NewMockService(t)
+		wfrSvc.EXPECT().
+			GetWorkflowRunSBOM(mock.Anything, testNS, "run-1").
+			Return(&models.WorkflowRunSBOMResponse{
+				RunName:  "run-1",
+				Format:   "SPDX",
+				Location: "registry.local/app@sha256:def",
+			}, nil)
+
+		h := newTestHandler(withWorkflowRunService(wfrSvc))
+		result, err := h.GetBuildSBOM(ctx, testNS, "run-1", "", "")
+		require.NoError(t, err)
+		m := result.(map[string]any)
+		assert.Equal(t, "run-1", m["build_id"])
+		assert.Equal(t, "SPDX", m["format"])
+		assert.Equal(t, "registry.local/app@sha256:def", m["location"])
+		assert.NotContains(t, m, "image")
+	})
+
+	t.Run("by deployed component", func(t *testing.T) {
+		wfrSvc := workflowrunmocks.NewMockService(t)
+		wfrSvc.EXPECT().
+			GetDeployedSBOM(mock.Anything, testNS, testComponent, "production").
+			Return(&models.WorkflowRunSBOMResponse{
+				RunName:  "run-2",
+				Image:    "registry.local/app@sha256:abc",
+				Location: "registry.local/app@sha256:def",
+			}, nil)
+
+		h := newTestHandler(withWorkflowRunService(wfrSvc))
+		result, err := h.GetBuildSBOM(ctx, testNS, "", testComponent, "production")
+		require.NoError(t, err)
+		m := result.(map[string]any)
+		assert.Equal(t, "run-2", m["build_id"])
+		assert.Equal(t, "registry.local/app@sha256:abc", m["image"])
+	})
+
+	t.Run("requires a build or a deployment", func(t *testing.T) {
+		h := newTestHandler()
+		_, err := h.GetBuildSBOM(ctx, testNS, "", testComponent, "")
+		require.Error(t, err)
+	})
+
+	t.Run("propagates service error", func(t *testing.T) {
+		wfrSvc := workflowrunmocks.NewMockService(t)
+		wfrSvc.EXPECT().
+			GetWorkflowRunSBOM(mock.Anything, testNS, "run-1").
+			Return(nil, workflowrun.ErrSBOMNotFound)
+
+		h := newTestHandler(withWorkflowRunService(wfrSvc))
+		_, err := h.GetBuildSBOM(ctx, testNS, "run-1", "", "")
+		require.ErrorIs(t, err, workflowrun.ErrSBOMNotFound)
+	})
+}
+
+// ---------------------------------------------------------------------------
+// GetComponentEndpoints
+// ---------------------------------------------------------------------------
+
+func TestGetComponentEndpoints(t *testing.T) {
+	ctx := context.Background()
+
+	bindings := []openchoreov1alpha1.ReleaseBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: testComponent + "-dev"},
+			Spec: openchoreov1alpha1.ReleaseBindingSpec{
+				Environment: "dev",
+				ReleaseName: "rel-1",
+			},
+			Status: openchoreov1alpha1.ReleaseBindingStatus{
+				Endpoints: []openchoreov1alpha1.EndpointURLStatus{
+					{
+						Name: "api",
+						Type: openchoreov1alpha1.EndpointTypeHTTP,
+						ExternalURLs: &openchoreov1alpha1.EndpointGatewayURLs{
+							HTTPS: &openchoreov1alpha1.EndpointURL{
+								Scheme: "https", Host: "dev.example.com", Port: 443, Path: "/orders",
+							},
+						},
+						InternalURLs: &openchoreov1alpha1.EndpointGatewayURLs{
+							HTTP: &openchoreov1alpha1.EndpointURL{Scheme: "http", Host: "internal.local", Port: 8080},
+						},
+						ServiceURL: &openchoreov1alpha1.EndpointURL{Scheme: "http", Host: "api.svc", Port: 9090},
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: testComponent + "-prod"},
+			Spec:       openchoreov1alpha1.ReleaseBindingSpec{Environment: "prod", ReleaseName: "rel-0"},
+		},
+	}
+
+	newHandler := func(t *testing.T) *MCPHandler {
+		rbSvc := releasebindingmocks.NewMockService(t)
+		rbSvc.EXPECT().ListReleaseBindings(mock.Anything, testNS, testComponent, services.ListOptions{}).
+			Return(&services.ListResult[openchoreov1alpha1.ReleaseBinding]{Items: bindings}, nil)
+		return newTestHandler(withReleaseBindingService(rbSvc))
+	}
+
+	t.Run("resolves urls per visibility", func(t *testing.T) {
+		result, err := newHandler(t).GetComponentEndpoints(ctx, testNS, testComponent, "dev")
+		require.NoError(t, err)
+
+		envs := result.(map[string]any)["environments"].([]map[string]any)
+		require.Len(t, envs, 1)
+		endpoints := envs[0]["endpoints"].([]map[string]any)
+		require.Len(t, endpoints, 1)
+		urls := endpoints[0]["urls"].([]map[string]any)
+		require.Len(t, urls, 3)
+		assert.Equal(t, "external", urls[0]["visibility"])
+		assert.Equal(t, "https://dev.example.com/orders", urls[0]["url"])
+		assert.Equal(t, "internal", urls[1]["visibility"])
+		assert.Equal(t, "http://internal.local:8080", urls[1]["url"])
+		assert.Equal(t, "cluster", urls[2]["visibility"])
+		assert.Equal(t, "http://api.svc:9090", urls[2]["url"])
+	})
+
+	t.Run("all environments", func(t *testing.T) {
+		result, err := newHandler(t).GetComponentEndpoints(ctx, testNS, testComponent, "")
+		require.NoError(t, err)
+		assert.Len(t, result.(map[string]any)["environments"], 2)
+	})
+
+	t.Run("not deployed to environment", func(t *testing.T) {
+		_, err := newHandler(t).GetComponentEndpoints(ctx, testNS, testComponent, "staging")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not deployed")
+	})
+}
@@ -0,0 +1,201 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	secretsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secret"
+	pipelinecontext "github.com/openchoreo/openchoreo/internal/pipeline/component/context"
+	"github.com/openchoreo/openchoreo/pkg/mcp/tools"
+)
+
+// GetComponentConfiguration returns the environment variables and files of a
+// component: the base values from its workload and, per environment, the overrides
+// held by the release binding together with the effective merged configuration.
+// When environment is set, only that environment is reported.
+func (h *MCPHandler) GetComponentConfiguration(
+	ctx context.Context, namespaceName, componentName, environment string,
+) (any, error) {
+	workloads, err := h.services.WorkloadService.ListWorkloads(ctx, namespaceName, componentName, services.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var workload *openchoreov1alpha1.Workload
+	if len(workloads.Items) > 0 {
+		workload = &workloads.Items[0]
+	}
+
+	bindings, err := listAllPages(
+		func(opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ReleaseBinding], error) {
+			return h.services.ReleaseBindingService.ListReleaseBindings(ctx, namespaceName, componentName, opts)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	envs := make([]map[string]any, 0, len(bindings))
+	for i := range bindings {
+		rb := &bindings[i]
+		if environment != "" && rb.Spec.Environment != environment {
+			continue
+		}
+		entry := map[string]any{"environment": rb.Spec.Environment}
+		if o := rb.Spec.WorkloadOverrides; o != nil && o.Container != nil {
+			if overrides := containerConfigSummary(o.Container.Env, o.Container.Files); len(overrides) > 0 {
+				entry["overrides"] = overrides
+			}
+		}
+		if workload != nil {
+			merged := pipelinecontext.MergeWorkloadOverrides(workload, rb.Spec.WorkloadOverrides)
+			entry["effective"] = containerConfigSummary(merged.Spec.Container.Env, merged.Spec.Container.Files)
+		}
+		envs = append(envs, entry)
+	}
+	if environment != "" && len(envs) == 0 {
+		return nil, fmt.Errorf("component %q is not deployed to environment %q", componentName, environment)
+	}
+
+	result := map[string]any{
+		"component":    componentName,
+		"environments": envs,
+	}
+	if workload != nil {
+		result["base"] = containerConfigSummary(workload.Spec.Container.Env, workload.Spec.Container.Files)
+	}
+	return result, nil
+}
+
+// SetComponentEnvVar sets a single environment variable override of a component in
+// one environment. Only the targeted key of the release binding's workload overrides
+// is touched; other overrides are preserved.
+func (h *MCPHandler) SetComponentEnvVar(
+	ctx context.Context, namespaceName, componentName, environment string, envVar tools.EnvVarOpts,
+) (any, error) {
+	if envVar.Key == "" {
+		return nil, fmt.Errorf("key is required")
+	}
+	hasSecretRef := envVar.SecretName != "" || envVar.SecretKey != ""
+	if hasSecretRef && (envVar.SecretName == "" || envVar.SecretKey == "") {
+		return nil, fmt.Errorf("secret_name and secret_key must be set together")
+	}
+	if hasSecretRef && envVar.Value != "" {
+		return nil, fmt.Errorf("value and secret reference are mutually exclusive")
+	}
+	if envVar.Secret && (hasSecretRef || envVar.Value == "") {
+		return nil, fmt.Errorf("secret requires a value and no secret reference")
+	}
+
+	rb, err := h.findReleaseBindingForEnvironment(ctx, namespaceName, componentName, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	if envVar.Secret {
+		secretName, err := h.storeEnvVarSecret(ctx, namespaceName, componentName, environment, envVar)
+		if err != nil {
+			return nil, err
+		}
+		envVar = tools.EnvVarOpts{Key: envVar.Key, SecretName: secretName, SecretKey: envVar.Key}
+		hasSecretRef = true
+	}
+
+	desired := openchoreov1alpha1.EnvVar{Key: envVar.Key, Value: envVar.Value}
+	if hasSecretRef {
+		desired.ValueFrom = &openchoreov1alpha1.EnvVarValueFrom{
+			SecretKeyRef: &openchoreov1alpha1.SecretKeyRef{Name: envVar.SecretName, Key: envVar.SecretKey},
+		}
+	}
+
+	if rb.Spec.WorkloadOverrides == nil {
+		rb.Spec.WorkloadOverrides = &openchoreov1alpha1.WorkloadOverrideTemplateSpec{}
+	}
+	if rb.Spec.WorkloadOverrides.Container == nil {
+		rb.Spec.WorkloadOverrides.Container = &openchoreov1alpha1.ContainerOverride{}
+	}
+	container := rb.Spec.WorkloadOverrides.Container
+	if i := slices.IndexFunc(container.Env, envVarKeyMatcher(envVar.Key)); i >= 0 {
+		container.Env[i] = desired
+	} else {
+		container.Env = append(container.Env, desired)
+	}
+
+	updated, err := h.services.ReleaseBindingService.UpdateReleaseBinding(ctx, namespaceName, rb)
+	if err != nil {
+		return nil, err
+	}
+	return mutationResult(updated, "updated", map[string]any{
+		"component":   componentName,
+		"environment": environment,
+		"key":         envVar.Key,
+	}), nil
+}
+
+// UnsetComponentEnvVar removes a single environment variable override of a
+// component in one environment, reverting the key to its workload value.
+func (h *MCPHandler) UnsetComponentEnvVar(
+	ctx context.Context, namespaceName, componentName, environment, key string,
+) (any, error) {
+	rb, err := h.findReleaseBindingForEnvironment(ctx, namespaceName, componentName, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	var container *openchoreov1alpha1.ContainerOverride
+	if rb.Spec.WorkloadOverrides != nil {
+		container = rb.Spec.WorkloadOverrides.Container
+	}
+	if container == nil || !slices.ContainsFunc(container.Env, envVarKeyMatcher(key)) {
+		return nil, fmt.Errorf("environment %q has no override for %q", environment, key)
+	}
+	container.Env = slices.DeleteFunc(container.Env, envVarKeyMatcher(key))
+
+	updated, err := h.services.ReleaseBindingService.UpdateReleaseBinding(ctx, namespaceName, rb)
+	if err != nil {
+		return nil, err
+	}
+	return mutationResult(updated, "updated", map[string]any{
+		"component":   componentName,
+		"environment": environment,
+		"key":         key,
+	}), nil
+}
+
+// storeEnvVarSecret writes a secret environment variable value to the external
+// secret store of the environment's data plane and returns the name of the secret
+// reference that resolves it. All secret values of a component in one environment
+// share a single secret, keyed by the variable name.
+func (h *MCPHandler) storeEnvVarSecret(
+	ctx context.Context, namespaceName, componentName, environment string, envVar tools.EnvVarOpts,
+) (string, error) {
+	env, err := h.services.EnvironmentService.GetEnvironment(ctx, namespaceName, environment)
+	if err != nil {
+		return "", err
+	}
+	if env.Spec.DataPlaneRef == nil || env.Spec.DataPlaneRef.Name == "" {
+		return "", fmt.Errorf("environment %q has no data plane to store secrets in", environment)
+	}
+
+	secretName := fmt.Sprintf("%s-%s-env", componentName, environment)
+	if _, err := h.services.SecretService.StoreSecretValue(ctx, namespaceName, &secretsvc.StoreSecretValueParams{
+		SecretName: secretName,
+		TargetPlane: openchoreov1alpha1.TargetPlaneRef{
+			Kind: string(env.Spec.DataPlaneRef.Kind),
+			Name: env.Spec.DataPlaneRef.Name,
+		},
+		Key:   envVar.Key,
+		Value: envVar.Value,
+	}); err != nil {
+		return "", err
+	}
+	return secretName, nil
+}
+
+func envVarKeyMatcher(key string) func(openchoreov1alpha1.EnvVar) bool {
+	return func(e openchoreov1alpha1.EnvVar) bool { return e.Key == key }
+}
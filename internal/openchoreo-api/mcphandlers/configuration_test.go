@@ -0,0 +1,201 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	environmentmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment/mocks"
+	releasebindingmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/releasebinding/mocks"
+	secretsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secret"
+	secretmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secret/mocks"
+	workloadmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workload/mocks"
+	"github.com/openchoreo/openchoreo/pkg/mcp/tools"
+)
+
+func configTestBindings() []openchoreov1alpha1.ReleaseBinding {
+	return []openchoreov1alpha1.ReleaseBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: testComponent + "-dev", Namespace: testNS},
+			Spec: openchoreov1alpha1.ReleaseBindingSpec{
+				Environment: "dev",
+				WorkloadOverrides: &openchoreov1alpha1.WorkloadOverrideTemplateSpec{
+					Container: &openchoreov1alpha1.ContainerOverride{
+						Env: []openchoreov1alpha1.EnvVar{
+							{Key: "LOG_LEVEL", Value: "debug"},
+							{Key: "FEATURE_X", Value: "on"},
+						},
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: testComponent + "-prod", Namespace: testNS},
+			Spec:       openchoreov1alpha1.ReleaseBindingSpec{Environment: "prod"},
+		},
+	}
+}
+
+func newConfigTestHandler(t *testing.T) (*MCPHandler, *releasebindingmocks.MockService) {
+	t.Helper()
+	rbSvc := releasebindingmocks.NewMockService(t)
+	rbSvc.EXPECT().ListReleaseBindings(mock.Anything, testNS, testComponent, services.ListOptions{}).
+		Return(&services.ListResult[openchoreov1alpha1.ReleaseBinding]{Items: configTestBindings()}, nil)
+	return newTestHandler(withReleaseBindingService(rbSvc)), rbSvc
+}
+
+func captureUpdatedBinding(rbSvc *releasebindingmocks.MockService, into **openchoreov1alpha1.ReleaseBinding) {
+	rbSvc.EXPECT().UpdateReleaseBinding(mock.Anything, testNS, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ string, rb *openchoreov1alpha1.ReleaseBinding) (
+			*openchoreov1alpha1.ReleaseBinding, error,
+		) {
+			*into = rb
+			return rb, nil
+		})
+}
+
+func TestGetComponentConfiguration(t *testing.T) {
+	ctx := context.Background()
+
+	rbSvc := releasebindingmocks.NewMockService(t)
+	rbSvc.EXPECT().ListReleaseBindings(mock.Anything, testNS, testComponent, services.ListOptions{}).
+		Return(&services.ListResult[openchoreov1alpha1.ReleaseBinding]{Items: configTestBindings()}, nil)
+	wlSvc := workloadmocks.NewMockService(t)
+	wlSvc.EXPECT().ListWorkloads(mock.Anything, testNS, testComponent, services.ListOptions{}).
+		Return(&services.ListResult[openchoreov1alpha1.Workload]{Items: []openchoreov1alpha1.Workload{{
+			Spec: openchoreov1alpha1.WorkloadSpec{
+				WorkloadTemplateSpec: openchoreov1alpha1.WorkloadTemplateSpec{
+					Container: openchoreov1alpha1.Container{
+						Env: []openchoreov1alpha1.EnvVar{
+							{Key: "LOG_LEVEL", Value: "info"},
+							{Key: "DB_PASSWORD", ValueFrom: &openchoreov1alpha1.EnvVarValueFrom{
+								SecretKeyRef: &openchoreov1alpha1.SecretKeyRef{Name: "db", Key: "password"},
+							}},
+						},
+					},
+				},
+			},
+		}}}, nil)
+	h := newTestHandler(withReleaseBindingService(rbSvc), withWorkloadService(wlSvc))
+
+	result, err := h.GetComponentConfiguration(ctx, testNS, testComponent, "dev")
+	require.NoError(t, err)
+	m := result.(map[string]any)
+	base := m["base"].(map[string]any)["env"].([]map[string]any)
+	assert.Equal(t, map[string]any{"name": "db", "key": "password"}, base[1]["secretKeyRef"])
+
+	envs := m["environments"].([]map[string]any)
+	require.Len(t, envs, 1)
+	effective := envs[0]["effective"].(map[string]any)["env"].([]map[string]any)
+	require.Len(t, effective, 3)
+	assert.Equal(t, "debug", effective[0]["value"])
+	assert.Equal(t, "FEATURE_X", effective[2]["key"])
+}
+
+func TestSetComponentEnvVar(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("replaces only the targeted key", func(t *testing.T) {
+		h, rbSvc := newConfigTestHandler(t)
+		var updated *openchoreov1alpha1.ReleaseBinding
+		captureUpdatedBinding(rbSvc, &updated)
+
+		_, err := h.SetComponentEnvVar(ctx, testNS, testComponent, "dev", tools.EnvVarOpts{Key: "LOG_LEVEL", Value: "warn"})
+		require.NoError(t, err)
+		assert.Equal(t, []openchoreov1alpha1.EnvVar{
+			{Key: "LOG_LEVEL", Value: "warn"},
+			{Key: "FEATURE_X", Value: "on"},
+		}, updated.Spec.WorkloadOverrides.Container.Env)
+	})
+
+	t.Run("adds a secret reference to an environment without overrides", func(t *testing.T) {
+		h, rbSvc := newConfigTestHandler(t)
+		var updated *openchoreov1alpha1.ReleaseBinding
+		captureUpdatedBinding(rbSvc, &updated)
+
+		_, err := h.SetComponentEnvVar(ctx, testNS, testComponent, "prod", tools.EnvVarOpts{
+			Key: "DB_PASSWORD", SecretName: "db-prod", SecretKey: "password",
+		})
+		require.NoError(t, err)
+		env := updated.Spec.WorkloadOverrides.Container.Env
+		require.Len(t, env, 1)
+		assert.Equal(t, "db-prod", env[0].ValueFrom.SecretKeyRef.Name)
+	})
+
+	t.Run("stores a secret value in the data plane secret store", func(t *testing.T) {
+		h, rbSvc := newConfigTestHandler(t)
+		var updated *openchoreov1alpha1.ReleaseBinding
+		captureUpdatedBinding(rbSvc, &updated)
+		envSvc := environmentmocks.NewMockService(t)
+		envSvc.EXPECT().GetEnvironment(mock.Anything, testNS, "prod").Return(&openchoreov1alpha1.Environment{
+			Spec: openchoreov1alpha1.EnvironmentSpec{DataPlaneRef: &openchoreov1alpha1.DataPlaneRef{
+				Kind: openchoreov1alpha1.DataPlaneRefKindClusterDataPlane, Name: "shared",
+			}},
+		}, nil)
+		secretSvc := secretmocks.NewMockService(t)
+		secretSvc.EXPECT().StoreSecretValue(mock.Anything, testNS, &secretsvc.StoreSecretValueParams{
+			SecretName:  testComponent + "-prod-env",
+			TargetPlane: openchoreov1alpha1.TargetPlaneRef{Kind: "ClusterDataPlane", Name: "shared"},
+			Key:         "DB_PASSWORD",
+			Value:       "s3cr3t",
+		}).Return(nil, nil)
+		h.services.EnvironmentService = envSvc
+		h.services.SecretService = secretSvc
+
+		_, err := h.SetComponentEnvVar(ctx, testNS, testComponent, "prod", tools.EnvVarOpts{
+			Key: "DB_PASSWORD", Value: "s3cr3t", Secret: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []openchoreov1alpha1.EnvVar{{
+			Key: "DB_PASSWORD",
+			ValueFrom: &openchoreov1alpha1.EnvVarValueFrom{
+				SecretKeyRef: &openchoreov1alpha1.SecretKeyRef{Name: testComponent + "-prod-env", Key: "DB_PASSWORD"},
+			},
+		}}, updated.Spec.WorkloadOverrides.Container.Env)
+	})
+
+	t.Run("secret requires a value", func(t *testing.T) {
+		_, err := newTestHandler().SetComponentEnvVar(ctx, testNS, testComponent, "dev", tools.EnvVarOpts{
+			Key: "A", Secret: true,
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("rejects value together with secret reference", func(t *testing.T) {
+		_, err := newTestHandler().SetComponentEnvVar(ctx, testNS, testComponent, "dev", tools.EnvVarOpts{
+			Key: "A", Value: "x", SecretName: "s", SecretKey: "k",
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestUnsetComponentEnvVar(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("removes the override", func(t *testing.T) {
+		h, rbSvc := newConfigTestHandler(t)
+		var updated *openchoreov1alpha1.ReleaseBinding
+		captureUpdatedBinding(rbSvc, &updated)
+
+		_, err := h.UnsetComponentEnvVar(ctx, testNS, testComponent, "dev", "LOG_LEVEL")
+		require.NoError(t, err)
+		assert.Equal(t, []openchoreov1alpha1.EnvVar{{Key: "FEATURE_X", Value: "on"}},
+			updated.Spec.WorkloadOverrides.Container.Env)
+	})
+
+	t.Run("missing override", func(t *testing.T) {
+		h, _ := newConfigTestHandler(t)
+		_, err := h.UnsetComponentEnvVar(ctx, testNS, testComponent, "prod", "LOG_LEVEL")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no override")
+	})
+}
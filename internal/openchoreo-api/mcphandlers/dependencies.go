@@ -0,0 +1,67 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"context"
+
+	dependencysvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/dependency"
+)
+
+// GetComponentDependencyGraph returns the connection graph between the components of a
+// namespace: endpoint connections to other components, dependencies on project-bound
+// resources, connections to external services, and secret references shared by more than
+// one component. When projectName is set, only nodes and edges touching that project are
+// reported, including connections to and from other projects. When componentName is set,
+// the response is focused on that component and also lists every component that
+// transitively depends on it, i.e. what breaks if it is removed.
+func (h *MCPHandler) GetComponentDependencyGraph(
+	ctx context.Context, namespaceName, projectName, componentName string,
+) (any, error) {
+	graph, err := h.services.DependencyService.GetDependencyGraph(ctx, namespaceName, dependencysvc.GraphFilter{
+		ProjectName:   projectName,
+		ComponentName: componentName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]map[string]any, 0, len(graph.Nodes))
+	for _, n := range graph.Nodes {
+		nodes = append(nodes, map[string]any{"id": n.ID, "kind": n.Kind, "project": n.Project, "name": n.Name})
+	}
+	result := map[string]any{
+		"namespace": namespaceName,
+		"nodes":     nodes,
+		"edges":     dependencyEdgesSummary(graph.Edges),
+	}
+	setIfNotEmpty(result, "project", projectName)
+	if graph.Component == "" {
+		return result, nil
+	}
+
+	impacted := make([]string, 0, len(graph.Impacted))
+	for _, n := range graph.Impacted {
+		impacted = append(impacted, n.ID)
+	}
+	result["component"] = graph.Component
+	result["dependsOn"] = dependencyEdgesSummary(graph.DependsOn)
+	result["dependents"] = dependencyEdgesSummary(graph.Dependents)
+	result["impacted"] = impacted
+	return result, nil
+}
+
+func dependencyEdgesSummary(edges []dependencysvc.Edge) []map[string]any {
+	out := make([]map[string]any, 0, len(edges))
+	for _, e := range edges {
+		m := map[string]any{"from": e.From, "to": e.To, "type": e.Type}
+		if e.Type == dependencysvc.EdgeTypeEndpoint {
+			m["endpoint"] = e.Endpoint
+			m["visibility"] = e.Visibility
+		}
+		setIfNotEmpty(m, "address", e.Address)
+		out = append(out, m)
+	}
+	return out
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	dependencysvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/dependency"
+	dependencymocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/dependency/mocks"
+)
+
+func TestGetComponentDependencyGraph(t *testing.T) {
+	ctx := context.Background()
+	frontend := dependencysvc.Node{ID: "component:shop/frontend", Kind: "component", Project: "shop", Name: "frontend"}
+	orders := dependencysvc.Node{ID: "component:shop/orders", Kind: "component", Project: "shop", Name: "orders"}
+	payments := dependencysvc.Node{ID: "external:payments", Kind: "external", Name: "payments"}
+	callsOrders := dependencysvc.Edge{
+		From: frontend.ID, To: orders.ID, Type: dependencysvc.EdgeTypeEndpoint, Endpoint: "api", Visibility: "project",
+	}
+	callsPayments := dependencysvc.Edge{
+		From: orders.ID, To: payments.ID, Type: dependencysvc.EdgeTypeExternal, Address: "https://pay.example.com",
+	}
+
+	t.Run("full graph", func(t *testing.T) {
+		svc := dependencymocks.NewMockService(t)
+		svc.EXPECT().GetDependencyGraph(mock.Anything, testNS, dependencysvc.GraphFilter{}).
+			Return(&dependencysvc.Graph{
+				Nodes: []dependencysvc.Node{frontend, orders, payments},
+				Edges: []dependencysvc.Edge{callsOrders, callsPayments},
+			}, nil)
+
+		result, err := newTestHandler(withDependencyService(svc)).GetComponentDependencyGraph(ctx, testNS, "", "")
+		require.NoError(t, err)
+		m := result.(map[string]any)
+
+		assert.Len(t, m["nodes"], 3)
+		edges := m["edges"].([]map[string]any)
+		require.Len(t, edges, 2)
+		assert.Equal(t, map[string]any{
+			"from": frontend.ID, "to": orders.ID, "type": "endpoint", "endpoint": "api", "visibility": "project",
+		}, edges[0])
+		assert.Equal(t, map[string]any{
+			"from": orders.ID, "to": payments.ID, "type": "external", "address": "https://pay.example.com",
+		}, edges[1])
+		assert.NotContains(t, m, "component")
+	})
+
+	t.Run("focused graph reports impact", func(t *testing.T) {
+		svc := dependencymocks.NewMockService(t)
+		svc.EXPECT().GetDependencyGraph(mock.Anything, testNS, dependencysvc.GraphFilter{ProjectName: "shop", ComponentName: "orders"}).
+			Return(&dependencysvc.Graph{
+				Nodes:      []dependencysvc.Node{frontend, orders, payments},
+				Edges:      []dependencysvc.Edge{callsOrders, callsPayments},
+				Component:  orders.ID,
+				DependsOn:  []dependencysvc.Edge{callsPayments},
+				Dependents: []dependencysvc.Edge{callsOrders},
+				Impacted:   []dependencysvc.Node{frontend},
+			}, nil)
+
+		result, err := newTestHandler(withDependencyService(svc)).GetComponentDependencyGraph(ctx, testNS, "shop", "orders")
+		require.NoError(t, err)
+		m := result.(map[string]any)
+
+		assert.Equal(t, "shop", m["project"])
+		assert.Equal(t, orders.ID, m["component"])
+		assert.Len(t, m["dependsOn"], 1)
+		assert.Len(t, m["dependents"], 1)
+		assert.Equal(t, []string{frontend.ID}, m["impacted"])
+	})
+
+	t.Run("unknown component", func(t *testing.T) {
+		svc := dependencymocks.NewMockService(t)
+		svc.EXPECT().GetDependencyGraph(mock.Anything, testNS, mock.Anything).
+			Return(nil, fmt.Errorf("%w: %q", dependencysvc.ErrComponentNotFound, "missing"))
+
+		_, err := newTestHandler(withDependencyService(svc)).GetComponentDependencyGraph(ctx, testNS, "", "missing")
+		require.ErrorIs(t, err, dependencysvc.ErrComponentNotFound)
+		assert.Contains(t, err.Error(), "has no workload")
+	})
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"context"
+
+	deploymentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/deployment"
+	releasesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/release"
+)
+
+// DeployComponent deploys an image to an environment, either given directly or
+// taken from a succeeded build of the component.
+func (h *MCPHandler) DeployComponent(
+	ctx context.Context, namespaceName, componentName, environment, image, buildName string,
+) (any, error) {
+	result, err := h.services.DeploymentService.Deploy(ctx, namespaceName, &deploymentsvc.DeployRequest{
+		ComponentName: componentName,
+		Environment:   environment,
+		Image:         image,
+		BuildName:     buildName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	action := "updated"
+	if result.Created {
+		action = "created"
+	}
+	extras := map[string]any{
+		"component":   componentName,
+		"environment": environment,
+		"image":       result.Image,
+		"release":     result.Release.Name,
+	}
+	setIfNotEmpty(extras, "build", buildName)
+	return mutationResult(result.ReleaseBinding, action, extras), nil
+}
+
+// GetDeploymentStatus reports the rollout progress of a component in an environment.
+func (h *MCPHandler) GetDeploymentStatus(
+	ctx context.Context, namespaceName, componentName, environment string,
+) (any, error) {
+	status, err := h.services.DeploymentService.GetDeploymentStatus(ctx, namespaceName, componentName, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	m := map[string]any{
+		"component":      status.ComponentName,
+		"environment":    status.Environment,
+		"releaseBinding": status.ReleaseBindingName,
+		"phase":          string(status.Phase),
+	}
+	setIfNotEmpty(m, "release", status.ReleaseName)
+	setIfNotEmpty(m, "image", status.Image)
+	setIfNotEmpty(m, "message", status.Message)
+	if len(status.Conditions) > 0 {
+		m["conditions"] = conditionsSummary(status.Conditions)
+	}
+	if len(status.Endpoints) > 0 {
+		m["endpoints"] = componentEndpointsSummary(status.Endpoints)
+	}
+	return m, nil
+}
+
+// GetReleaseHistory lists the releases of a component to its environments, newest first.
+func (h *MCPHandler) GetReleaseHistory(
+	ctx context.Context, namespaceName, componentName, environment string,
+) (any, error) {
+	entries, err := h.services.ReleaseService.ListReleaseHistory(ctx, namespaceName, componentName, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]map[string]any, 0, len(entries))
+	for i := range entries {
+		items = append(items, releaseHistoryEntrySummary(&entries[i]))
+	}
+	return map[string]any{"items": items}, nil
+}
+
+// RollbackComponent rolls a component in an environment back to a release from its history.
+func (h *MCPHandler) RollbackComponent(
+	ctx context.Context, namespaceName, componentName, environment, release string,
+) (any, error) {
+	result, err := h.services.ReleaseService.RollbackRelease(ctx, namespaceName, componentName, environment, release)
+	if err != nil {
+		return nil, err
+	}
+	return mutationResult(result.ReleaseBinding, "rolled back", map[string]any{
+		"component":       componentName,
+		"environment":     environment,
+		"release":         result.Release,
+		"previousRelease": result.PreviousRelease,
+	}), nil
+}
+
+func releaseHistoryEntrySummary(entry *releasesvc.HistoryEntry) map[string]any {
+	record := &entry.Record
+	m := map[string]any{
+		"environment":    entry.Environment,
+		"release":        record.ReleaseName,
+		"configChecksum": record.ConfigChecksum,
+		"deployedAt":     record.DeployedAt.UTC().Format("2006-01-02T15:04:05Z"),
+		"current":        entry.Current,
+	}
+	setIfNotEmpty(m, "image", record.Image)
+	setIfNotEmpty(m, "trigger", record.Trigger)
+	setIfNotEmpty(m, "triggeredBy", record.TriggeredBy)
+	if record.SupersededAt != nil {
+		m["supersededAt"] = record.SupersededAt.UTC().Format("2006-01-02T15:04:05Z")
+	}
+	return m
+}
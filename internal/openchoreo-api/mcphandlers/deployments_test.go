@@ -0,0 +1,140 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	deploymentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/deployment"
+	deploymentmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/deployment/mocks"
+	releasesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/release"
+	releasemocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/release/mocks"
+)
+
+func TestDeployComponent(t *testing.T) {
+	ctx := context.Background()
+	svc := deploymentmocks.NewMockService(t)
+	svc.EXPECT().Deploy(mock.Anything, testNS, &deploymentsvc.DeployRequest{
+		ComponentName: testComponent,
+		Environment:   "dev",
+		BuildName:     "build-1",
+	}).Return(&deploymentsvc.DeployResult{
+		Image:   "registry.example.com/app:v1",
+		Release: &openchoreov1alpha1.ComponentRelease{ObjectMeta: metav1.ObjectMeta{Name: "rel-1"}},
+		ReleaseBinding: &openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: testComponent + "-dev", Namespace: testNS},
+		},
+		Created: true,
+	}, nil)
+	h := newTestHandler(withDeploymentService(svc))
+
+	result, err := h.DeployComponent(ctx, testNS, testComponent, "dev", "", "build-1")
+	require.NoError(t, err)
+	m := result.(map[string]any)
+	assert.Equal(t, "created", m["action"])
+	assert.Equal(t, testComponent+"-dev", m["name"])
+	assert.Equal(t, "rel-1", m["release"])
+	assert.Equal(t, "registry.example.com/app:v1", m["image"])
+	assert.Equal(t, "build-1", m["build"])
+}
+
+func TestGetDeploymentStatus(t *testing.T) {
+	ctx := context.Background()
+	svc := deploymentmocks.NewMockService(t)
+	svc.EXPECT().GetDeploymentStatus(mock.Anything, testNS, testComponent, "dev").Return(&deploymentsvc.Status{
+		ComponentName:      testComponent,
+		Environment:        "dev",
+		ReleaseBindingName: testComponent + "-dev",
+		ReleaseName:        "rel-1",
+		Phase:              deploymentsvc.PhaseFailed,
+		Message:            "image pull failed",
+		Conditions: []metav1.Condition{
+			{Type: "Ready", Status: metav1.ConditionFalse, Reason: "ResourcesDegraded", Message: "image pull failed"},
+		},
+	}, nil)
+	h := newTestHandler(withDeploymentService(svc))
+
+	result, err := h.GetDeploymentStatus(ctx, testNS, testComponent, "dev")
+	require.NoError(t, err)
+	m := result.(map[string]any)
+	assert.Equal(t, "Failed", m["phase"])
+	assert.Equal(t, "image pull failed", m["message"])
+	assert.Equal(t, "rel-1", m["release"])
+	assert.NotContains(t, m, "image")
+	assert.Len(t, m["conditions"], 1)
+	assert.NotContains(t, m, "endpoints")
+}
+
+func TestGetReleaseHistory(t *testing.T) {
+	ctx := context.Background()
+	deployedAt := metav1.NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	svc := releasemocks.NewMockService(t)
+	svc.EXPECT().ListReleaseHistory(mock.Anything, testNS, testComponent, "").Return([]releasesvc.HistoryEntry{
+		{
+			Environment:        "dev",
+			ReleaseBindingName: testComponent + "-dev",
+			Record: openchoreov1alpha1.ReleaseRecord{
+				ReleaseName:    "rel-2",
+				Image:          "app:v2",
+				ConfigChecksum: "sha256:abc",
+				Trigger:        "deploy",
+				TriggeredBy:    "alice",
+				DeployedAt:     deployedAt,
+			},
+			Current: true,
+		},
+		{
+			Environment: "dev",
+			Record: openchoreov1alpha1.ReleaseRecord{
+				ReleaseName:  "rel-1",
+				Trigger:      "manual",
+				DeployedAt:   deployedAt,
+				SupersededAt: &deployedAt,
+			},
+		},
+	}, nil)
+	h := newTestHandler(withReleaseService(svc))
+
+	result, err := h.GetReleaseHistory(ctx, testNS, testComponent, "")
+	require.NoError(t, err)
+	items := result.(map[string]any)["items"].([]map[string]any)
+	require.Len(t, items, 2)
+	assert.Equal(t, "rel-2", items[0]["release"])
+	assert.Equal(t, "app:v2", items[0]["image"])
+	assert.Equal(t, "alice", items[0]["triggeredBy"])
+	assert.Equal(t, "2026-01-02T03:04:05Z", items[0]["deployedAt"])
+	assert.Equal(t, true, items[0]["current"])
+	assert.NotContains(t, items[0], "supersededAt")
+	assert.NotContains(t, items[1], "image")
+	assert.Equal(t, "2026-01-02T03:04:05Z", items[1]["supersededAt"])
+}
+
+func TestRollbackComponent(t *testing.T) {
+	ctx := context.Background()
+	svc := releasemocks.NewMockService(t)
+	svc.EXPECT().RollbackRelease(mock.Anything, testNS, testComponent, "prod", "").Return(&releasesvc.RollbackResult{
+		Release:         "rel-1",
+		PreviousRelease: "rel-2",
+		ReleaseBinding: &openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: testComponent + "-prod", Namespace: testNS},
+		},
+	}, nil)
+	h := newTestHandler(withReleaseService(svc))
+
+	result, err := h.RollbackComponent(ctx, testNS, testComponent, "prod", "")
+	require.NoError(t, err)
+	m := result.(map[string]any)
+	assert.Equal(t, "rolled back", m["action"])
+	assert.Equal(t, testComponent+"-prod", m["name"])
+	assert.Equal(t, "rel-1", m["release"])
+	assert.Equal(t, "rel-2", m["previousRelease"])
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"context"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	endpointsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/endpoint"
+	"github.com/openchoreo/openchoreo/pkg/mcp/tools"
+)
+
+// ListEndpoints lists the endpoints exposed by the components of a namespace with
+// their visibility and the addresses they resolved to in each environment.
+func (h *MCPHandler) ListEndpoints(
+	ctx context.Context, namespaceName, projectName, componentName, environment string,
+) (any, error) {
+	endpoints, err := h.services.EndpointService.ListEndpoints(ctx, namespaceName, endpointsvc.ListFilter{
+		ProjectName:   projectName,
+		ComponentName: componentName,
+		Environment:   environment,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]map[string]any, 0, len(endpoints))
+	for i := range endpoints {
+		items = append(items, endpointSummary(&endpoints[i]))
+	}
+	return map[string]any{"items": items}, nil
+}
+
+// UpdateEndpoint changes the visibility or base path of a component endpoint.
+func (h *MCPHandler) UpdateEndpoint(
+	ctx context.Context, namespaceName, componentName, endpointName string, opts tools.EndpointOpts,
+) (any, error) {
+	req := endpointsvc.UpdateRequest{BasePath: opts.BasePath}
+	if opts.Visibility != nil {
+		visibility := make([]openchoreov1alpha1.EndpointVisibility, 0, len(*opts.Visibility))
+		for _, v := range *opts.Visibility {
+			visibility = append(visibility, openchoreov1alpha1.EndpointVisibility(v))
+		}
+		req.Visibility = &visibility
+	}
+
+	ep, err := h.services.EndpointService.UpdateEndpoint(ctx, namespaceName, componentName, endpointName, req)
+	if err != nil {
+		return nil, err
+	}
+	m := endpointSummary(ep)
+	m["action"] = "updated"
+	return m, nil
+}
+
+func endpointSummary(ep *endpointsvc.Endpoint) map[string]any {
+	visibility := make([]string, 0, len(ep.Visibility))
+	for _, v := range ep.Visibility {
+		visibility = append(visibility, string(v))
+	}
+	envs := make([]map[string]any, 0, len(ep.Environments))
+	for _, env := range ep.Environments {
+		urls := make([]map[string]any, 0)
+		urls = appendGatewayURLs(urls, endpointVisibilityExternal, env.ExternalURLs)
+		urls = appendGatewayURLs(urls, endpointVisibilityInternal, env.InternalURLs)
+		m := map[string]any{"environment": env.Environment, "urls": urls}
+		if env.ServiceURL != nil {
+			m["service"] = endpointURLSummary(endpointVisibilityCluster, "", env.ServiceURL)
+		}
+		envs = append(envs, m)
+	}
+	m := map[string]any{
+		"name":         ep.Name,
+		"project":      ep.ProjectName,
+		"component":    ep.ComponentName,
+		"type":         string(ep.Type),
+		"port":         ep.Port,
+		"visibility":   visibility,
+		"environments": envs,
+	}
+	setIfNotEmpty(m, "basePath", ep.BasePath)
+	return m
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	endpointsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/endpoint"
+	endpointmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/endpoint/mocks"
+	"github.com/openchoreo/openchoreo/pkg/mcp/tools"
+)
+
+func testEndpoint() *endpointsvc.Endpoint {
+	return &endpointsvc.Endpoint{
+		Name:          "api",
+		ProjectName:   "proj",
+		ComponentName: testComponent,
+		Type:          openchoreov1alpha1.EndpointTypeHTTP,
+		Port:          8080,
+		BasePath:      "/api",
+		Visibility:    []openchoreov1alpha1.EndpointVisibility{"project", "external"},
+		Environments: []endpointsvc.EnvironmentEndpoint{{
+			Environment: "dev",
+			ServiceURL:  &openchoreov1alpha1.EndpointURL{Scheme: "http", Host: "api.svc", Port: 8080},
+			ExternalURLs: &openchoreov1alpha1.EndpointGatewayURLs{
+				HTTPS: &openchoreov1alpha1.EndpointURL{Scheme: "https", Host: "api.example.com", Path: "/api"},
+			},
+		}},
+	}
+}
+
+func TestListEndpoints(t *testing.T) {
+	ctx := context.Background()
+	svc := endpointmocks.NewMockService(t)
+	svc.EXPECT().ListEndpoints(mock.Anything, testNS, endpointsvc.ListFilter{ComponentName: testComponent}).
+		Return([]endpointsvc.Endpoint{*testEndpoint()}, nil)
+	h := newTestHandler(withEndpointService(svc))
+
+	result, err := h.ListEndpoints(ctx, testNS, "", testComponent, "")
+	require.NoError(t, err)
+	items := result.(map[string]any)["items"].([]map[string]any)
+	require.Len(t, items, 1)
+	item := items[0]
+	assert.Equal(t, "api", item["name"])
+	assert.Equal(t, "/api", item["basePath"])
+	assert.Equal(t, []string{"project", "external"}, item["visibility"])
+	envs := item["environments"].([]map[string]any)
+	require.Len(t, envs, 1)
+	assert.Equal(t, "dev", envs[0]["environment"])
+	assert.Equal(t, "api.svc", envs[0]["service"].(map[string]any)["host"])
+	urls := envs[0]["urls"].([]map[string]any)
+	require.Len(t, urls, 1)
+	assert.Equal(t, "https://api.example.com/api", urls[0]["url"])
+	assert.Equal(t, "external", urls[0]["visibility"])
+}
+
+func TestUpdateEndpoint(t *testing.T) {
+	ctx := context.Background()
+	svc := endpointmocks.NewMockService(t)
+	svc.EXPECT().UpdateEndpoint(mock.Anything, testNS, testComponent, "api", endpointsvc.UpdateRequest{
+		Visibility: &[]openchoreov1alpha1.EndpointVisibility{"external"},
+		BasePath:   ptr.To("/api"),
+	}).Return(testEndpoint(), nil)
+	h := newTestHandler(withEndpointService(svc))
+
+	result, err := h.UpdateEndpoint(ctx, testNS, testComponent, "api", tools.EndpointOpts{
+		Visibility: &[]string{"external"},
+		BasePath:   ptr.To("/api"),
+	})
+	require.NoError(t, err)
+	m := result.(map[string]any)
+	assert.Equal(t, "updated", m["action"])
+	assert.Equal(t, "api", m["name"])
+}
@@ -12,15 +12,128 @@ import (
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/controller"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	"github.com/openchoreo/openchoreo/pkg/mcp/tools"
 )
 
+// ListEnvironments lists environments together with their position in each
+// DeploymentPipeline of the namespace that references them.
 func (h *MCPHandler) ListEnvironments(ctx context.Context, namespaceName string, opts tools.ListOpts) (any, error) {
 	result, err := h.services.EnvironmentService.ListEnvironments(ctx, namespaceName, toServiceListOptions(opts))
 	if err != nil {
 		return nil, err
 	}
-	return wrapTransformedList("environments", result.Items, result.NextCursor, environmentSummary), nil
+	positions, err := h.services.EnvironmentService.ListPipelinePositions(ctx, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+	return wrapTransformedList("environments", result.Items, result.NextCursor,
+		func(env openchoreov1alpha1.Environment) map[string]any {
+			m := environmentSummary(env)
+			if envPositions := positions[env.Name]; len(envPositions) > 0 {
+				m["pipelines"] = envPositions
+			}
+			return m
+		}), nil
+}
+
+// GetEnvironmentOverview aggregates, for every environment in the namespace, its
+// data plane and gateway endpoints together with the components currently bound
+// to it and their health. When projectName is set only that project's components
+// are reported.
+func (h *MCPHandler) GetEnvironmentOverview(ctx context.Context, namespaceName, projectName string) (any, error) {
+	envs, err := listAllPages(
+		func(opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.Environment], error) {
+			return h.services.EnvironmentService.ListEnvironments(ctx, namespaceName, opts)
+		})
+	if err != nil {
+		return nil, err
+	}
+	bindings, err := listAllPages(
+		func(opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ReleaseBinding], error) {
+			return h.services.ReleaseBindingService.ListReleaseBindings(ctx, namespaceName, "", opts)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	deployed := make(map[string][]map[string]any, len(envs))
+	for _, rb := range bindings {
+		if projectName != "" && rb.Spec.Owner.ProjectName != projectName {
+			continue
+		}
+		deployed[rb.Spec.Environment] = append(deployed[rb.Spec.Environment], deployedComponentSummary(rb))
+	}
+
+	gateways := map[openchoreov1alpha1.DataPlaneRef]map[string]any{}
+	items := make([]map[string]any, 0, len(envs))
+	for _, env := range envs {
+		m := environmentSummary(env)
+		if ref := env.Spec.DataPlaneRef; ref != nil {
+			gw, ok := gateways[*ref]
+			if !ok {
+				gw = h.dataPlaneGatewayEndpoints(ctx, namespaceName, *ref)
+				gateways[*ref] = gw
+			}
+			if gw != nil {
+				m["gateway"] = gw
+			}
+		}
+		components := deployed[env.Name]
+		if components == nil {
+			components = []map[string]any{}
+		}
+		ready := 0
+		for _, c := range components {
+			if c["status"] == "Ready" {
+				ready++
+			}
+		}
+		m["components"] = components
+		m["deployedCount"] = len(components)
+		m["readyCount"] = ready
+		items = append(items, m)
+	}
+
+	result := map[string]any{"environments": items}
+	setIfNotEmpty(result, "projectName", projectName)
+	return result, nil
+}
+
+// dataPlaneGatewayEndpoints returns the ingress gateway endpoints of the referenced
+// (Cluster)DataPlane. Lookup failures are not fatal for the overview: the gateway
+// is simply omitted, e.g. when the caller may view environments but not planes.
+func (h *MCPHandler) dataPlaneGatewayEndpoints(
+	ctx context.Context, namespaceName string, ref openchoreov1alpha1.DataPlaneRef,
+) map[string]any {
+	var gateway openchoreov1alpha1.GatewaySpec
+	if ref.Kind == openchoreov1alpha1.DataPlaneRefKindClusterDataPlane {
+		cdp, err := h.services.ClusterDataPlaneService.GetClusterDataPlane(ctx, ref.Name)
+		if err != nil {
+			return nil
+		}
+		gateway = cdp.Spec.Gateway
+	} else {
+		dp, err := h.services.DataPlaneService.GetDataPlane(ctx, namespaceName, ref.Name)
+		if err != nil {
+			return nil
+		}
+		gateway = dp.Spec.Gateway
+	}
+	if gateway.Ingress == nil {
+		return nil
+	}
+	m := map[string]any{}
+	if ep := gatewayEndpointSummary(gateway.Ingress.External); ep != nil {
+		m["external"] = ep
+	}
+	if ep := gatewayEndpointSummary(gateway.Ingress.Internal); ep != nil {
+		m["internal"] = ep
+	}
+	if len(m) == 0 {
+		return nil
+	}
+	return m
 }
 
 func (h *MCPHandler) CreateEnvironment(ctx context.Context, namespaceName string, req *gen.CreateEnvironmentJSONRequestBody) (any, error) {
@@ -47,6 +160,13 @@ func (h *MCPHandler) CreateEnvironment(ctx context.Context, namespaceName string
 			Name: req.Spec.DataPlaneRef.Name,
 		}
 	}
+	if req.Spec != nil && req.Spec.Gateway != nil {
+		gateway, err := convertSpec[gen.GatewaySpec, openchoreov1alpha1.GatewaySpec](*req.Spec.Gateway)
+		if err != nil {
+			return nil, err
+		}
+		env.Spec.Gateway = gateway
+	}
 
 	if displayName, ok := env.Annotations[controller.AnnotationKeyDisplayName]; ok && displayName == "" {
 		delete(env.Annotations, controller.AnnotationKeyDisplayName)
@@ -15,7 +15,13 @@ import (
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	clusterdataplanemocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/clusterdataplane/mocks"
+	dataplanemocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/dataplane/mocks"
+	environmentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment"
 	environmentmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment/mocks"
+	releasebindingmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/releasebinding/mocks"
+	"github.com/openchoreo/openchoreo/pkg/mcp/tools"
 )
 
 // ---------------------------------------------------------------------------
@@ -124,6 +130,31 @@ func TestCreateEnvironment(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("gateway is converted", func(t *testing.T) {
+		envSvc := environmentmocks.NewMockService(t)
+		envSvc.EXPECT().
+			CreateEnvironment(mock.Anything, testNS, mock.MatchedBy(func(e *openchoreov1alpha1.Environment) bool {
+				ingress := e.Spec.Gateway.Ingress
+				return ingress != nil && ingress.External != nil && ingress.External.HTTP != nil &&
+					ingress.External.Name == "gateway-default" && ingress.External.HTTP.Host == "dev.example.com"
+			})).
+			Return(makeEnv(), nil)
+
+		host := "dev.example.com"
+		req := &gen.CreateEnvironmentJSONRequestBody{
+			Metadata: gen.ObjectMeta{Name: testEnvironmentName},
+			Spec: &gen.EnvironmentSpec{
+				Gateway: &gen.GatewaySpec{Ingress: &gen.GatewayNetworkSpec{External: &gen.GatewayEndpointSpec{
+					Name: "gateway-default", Namespace: "openchoreo-data-plane",
+					Http: &gen.GatewayListenerSpec{Host: &host},
+				}}},
+			},
+		}
+		h := newTestHandler(withEnvironmentService(envSvc))
+		_, err := h.CreateEnvironment(ctx, testNS, req)
+		require.NoError(t, err)
+	})
+
 	t.Run("service error propagated", func(t *testing.T) {
 		envSvc := environmentmocks.NewMockService(t)
 		envSvc.EXPECT().CreateEnvironment(mock.Anything, testNS, mock.Anything).Return(nil, errors.New("create failed"))
@@ -136,6 +167,46 @@ func TestCreateEnvironment(t *testing.T) {
 	})
 }
 
+// ---------------------------------------------------------------------------
+// ListEnvironments
+// ---------------------------------------------------------------------------
+
+func TestListEnvironments(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("includes pipeline positions", func(t *testing.T) {
+		envSvc := environmentmocks.NewMockService(t)
+		envSvc.EXPECT().ListEnvironments(mock.Anything, testNS, mock.Anything).
+			Return(&services.ListResult[openchoreov1alpha1.Environment]{Items: []openchoreov1alpha1.Environment{
+				{ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: testNS}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "sandbox", Namespace: testNS}},
+			}}, nil)
+		envSvc.EXPECT().ListPipelinePositions(mock.Anything, testNS).
+			Return(map[string][]environmentsvc.PipelinePosition{
+				"dev": {{PipelineName: "default", Stage: 0, PromotesTo: []string{"prod"}}},
+			}, nil)
+
+		result, err := newTestHandler(withEnvironmentService(envSvc)).ListEnvironments(ctx, testNS, tools.ListOpts{})
+		require.NoError(t, err)
+		items := result.(map[string]any)["environments"].([]map[string]any)
+		require.Len(t, items, 2)
+		assert.Equal(t, []environmentsvc.PipelinePosition{
+			{PipelineName: "default", Stage: 0, PromotesTo: []string{"prod"}},
+		}, items[0]["pipelines"])
+		assert.NotContains(t, items[1], "pipelines")
+	})
+
+	t.Run("position lookup error propagated", func(t *testing.T) {
+		envSvc := environmentmocks.NewMockService(t)
+		envSvc.EXPECT().ListEnvironments(mock.Anything, testNS, mock.Anything).
+			Return(&services.ListResult[openchoreov1alpha1.Environment]{}, nil)
+		envSvc.EXPECT().ListPipelinePositions(mock.Anything, testNS).Return(nil, errors.New("boom"))
+
+		_, err := newTestHandler(withEnvironmentService(envSvc)).ListEnvironments(ctx, testNS, tools.ListOpts{})
+		require.Error(t, err)
+	})
+}
+
 // ---------------------------------------------------------------------------
 // UpdateEnvironment
 // ---------------------------------------------------------------------------
@@ -242,3 +313,115 @@ func TestDeleteEnvironment(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+// ---------------------------------------------------------------------------
+// GetEnvironmentOverview
+// ---------------------------------------------------------------------------
+
+func TestGetEnvironmentOverview(t *testing.T) {
+	ctx := context.Background()
+
+	env := func(name string, ref *openchoreov1alpha1.DataPlaneRef) openchoreov1alpha1.Environment {
+		return openchoreov1alpha1.Environment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNS},
+			Spec:       openchoreov1alpha1.EnvironmentSpec{DataPlaneRef: ref},
+		}
+	}
+	binding := func(project, component, envName string, ready bool) openchoreov1alpha1.ReleaseBinding {
+		status := metav1.ConditionFalse
+		if ready {
+			status = metav1.ConditionTrue
+		}
+		return openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: component + "-" + envName, Namespace: testNS},
+			Spec: openchoreov1alpha1.ReleaseBindingSpec{
+				Owner: openchoreov1alpha1.ReleaseBindingOwner{
+					ProjectName:   project,
+					ComponentName: component,
+				},
+				Environment: envName,
+				ReleaseName: component + "-rel-1",
+			},
+			Status: openchoreov1alpha1.ReleaseBindingStatus{
+				Conditions: []metav1.Condition{{Type: "Ready", Status: status, Reason: "Progressing"}},
+			},
+		}
+	}
+
+	t.Run("aggregates gateway and deployed components per environment", func(t *testing.T) {
+		envSvc := environmentmocks.NewMockService(t)
+		rbSvc := releasebindingmocks.NewMockService(t)
+		dpSvc := dataplanemocks.NewMockService(t)
+		cdpSvc := clusterdataplanemocks.NewMockService(t)
+
+		dpRef := &openchoreov1alpha1.DataPlaneRef{Kind: openchoreov1alpha1.DataPlaneRefKindDataPlane, Name: "dp"}
+		cdpRef := &openchoreov1alpha1.DataPlaneRef{Kind: openchoreov1alpha1.DataPlaneRefKindClusterDataPlane, Name: "shared"}
+		envSvc.EXPECT().ListEnvironments(mock.Anything, testNS, services.ListOptions{}).
+			Return(&services.ListResult[openchoreov1alpha1.Environment]{
+				Items:      []openchoreov1alpha1.Environment{env("dev", dpRef)},
+				NextCursor: "page-2",
+			}, nil)
+		envSvc.EXPECT().ListEnvironments(mock.Anything, testNS, services.ListOptions{Cursor: "page-2"}).
+			Return(&services.ListResult[openchoreov1alpha1.Environment]{
+				Items: []openchoreov1alpha1.Environment{env("prod", cdpRef), env("qa", dpRef)},
+			}, nil)
+		rbSvc.EXPECT().ListReleaseBindings(mock.Anything, testNS, "", services.ListOptions{}).
+			Return(&services.ListResult[openchoreov1alpha1.ReleaseBinding]{
+				Items: []openchoreov1alpha1.ReleaseBinding{
+					binding(testProject, "api", "prod", true),
+					binding(testProject, "web", "prod", false),
+					binding("other", "batch", "prod", true),
+				},
+			}, nil)
+		// The namespace-scoped data plane is shared by dev and qa but looked up once.
+		dpSvc.EXPECT().GetDataPlane(mock.Anything, testNS, "dp").Return(nil, errors.New("forbidden")).Once()
+		cdpSvc.EXPECT().GetClusterDataPlane(mock.Anything, "shared").Return(&openchoreov1alpha1.ClusterDataPlane{
+			Spec: openchoreov1alpha1.ClusterDataPlaneSpec{
+				Gateway: openchoreov1alpha1.GatewaySpec{
+					Ingress: &openchoreov1alpha1.GatewayNetworkSpec{
+						External: &openchoreov1alpha1.GatewayEndpointSpec{
+							HTTPS: &openchoreov1alpha1.GatewayListenerSpec{Host: "apps.example.com", Port: 443},
+						},
+					},
+				},
+			},
+		}, nil)
+
+		h := newTestHandler(
+			withEnvironmentService(envSvc),
+			withReleaseBindingService(rbSvc),
+			withDataPlaneService(dpSvc),
+			withClusterDataPlaneService(cdpSvc),
+		)
+		result, err := h.GetEnvironmentOverview(ctx, testNS, testProject)
+		require.NoError(t, err)
+
+		items := result.(map[string]any)["environments"].([]map[string]any)
+		require.Len(t, items, 3)
+
+		dev := items[0]
+		assert.Equal(t, "dev", dev["name"])
+		assert.NotContains(t, dev, "gateway")
+		assert.Equal(t, 0, dev["deployedCount"])
+
+		prod := items[1]
+		assert.Equal(t, 2, prod["deployedCount"])
+		assert.Equal(t, 1, prod["readyCount"])
+		gw := prod["gateway"].(map[string]any)["external"].(map[string]any)["https"].(map[string]any)
+		assert.Equal(t, "apps.example.com", gw["host"])
+		components := prod["components"].([]map[string]any)
+		assert.Equal(t, "api-rel-1", components[0]["releaseName"])
+		assert.Equal(t, "Ready", components[0]["status"])
+		assert.Equal(t, "Progressing", components[1]["status"])
+	})
+
+	t.Run("list error is returned", func(t *testing.T) {
+		envSvc := environmentmocks.NewMockService(t)
+		envSvc.EXPECT().ListEnvironments(mock.Anything, testNS, services.ListOptions{}).
+			Return(nil, errors.New("boom"))
+
+		h := newTestHandler(withEnvironmentService(envSvc))
+		_, err := h.GetEnvironmentOverview(ctx, testNS, "")
+		require.Error(t, err)
+	})
+}
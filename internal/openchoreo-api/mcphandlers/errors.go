@@ -0,0 +1,60 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/pkg/mcp/tools"
+)
+
+// ClassifyServiceError maps errors returned by the service layer to MCP error codes.
+// Every service package declares its own sentinels (ErrComponentNotFound,
+// ErrProjectAlreadyExists, ...), all following the "<kind> not found" and
+// "<kind> already exists" wording, so the sentinels at the bottom of the error
+// chain are matched by that convention rather than listed one by one.
+// Unrecognized errors return nil and fall through to the generic classification.
+func ClassifyServiceError(err error) *tools.ToolError {
+	if errors.Is(err, services.ErrForbidden) {
+		return tools.NewToolError(tools.ErrorCodeForbidden, err, nil)
+	}
+	if validationErr, ok := errors.AsType[*services.ValidationError](err); ok {
+		var details map[string]any
+		if validationErr.StatusCode != 0 {
+			details = map[string]any{"statusCode": validationErr.StatusCode}
+		}
+		return tools.NewToolError(tools.ErrorCodeValidationError, err, details)
+	}
+	for _, leaf := range leafErrors(err) {
+		msg := leaf.Error()
+		switch {
+		case strings.HasSuffix(msg, " not found"):
+			return tools.NewToolError(tools.ErrorCodeNotFound, err, nil)
+		case strings.HasSuffix(msg, " already exists"):
+			return tools.NewToolError(tools.ErrorCodeAlreadyExists, err, nil)
+		case msg == "validation error":
+			return tools.NewToolError(tools.ErrorCodeValidationError, err, nil)
+		}
+	}
+	return nil
+}
+
+// leafErrors returns the errors at the bottom of err's wrap tree.
+func leafErrors(err error) []error {
+	switch e := err.(type) {
+	case interface{ Unwrap() error }:
+		if inner := e.Unwrap(); inner != nil {
+			return leafErrors(inner)
+		}
+	case interface{ Unwrap() []error }:
+		var leaves []error
+		for _, inner := range e.Unwrap() {
+			leaves = append(leaves, leafErrors(inner)...)
+		}
+		return leaves
+	}
+	return []error{err}
+}
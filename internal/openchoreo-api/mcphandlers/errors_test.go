@@ -0,0 +1,52 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+	"github.com/openchoreo/openchoreo/pkg/mcp/tools"
+)
+
+func TestClassifyServiceError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode tools.ErrorCode
+	}{
+		{"forbidden", fmt.Errorf("list components: %w", services.ErrForbidden), tools.ErrorCodeForbidden},
+		{"validation", &services.ValidationError{Msg: "spec.replicas: must be positive", StatusCode: 422},
+			tools.ErrorCodeValidationError},
+		{"not found sentinel", fmt.Errorf("get component: %w", componentsvc.ErrComponentNotFound), tools.ErrorCodeNotFound},
+		{"already exists sentinel", projectsvc.ErrProjectAlreadyExists, tools.ErrorCodeAlreadyExists},
+		{"joined errors", errors.Join(errors.New("retry failed"), componentsvc.ErrWorkloadNotFound),
+			tools.ErrorCodeNotFound},
+		{"validation sentinel", fmt.Errorf("%w: bad trait", componentsvc.ErrValidation), tools.ErrorCodeValidationError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ClassifyServiceError(tt.err)
+			require.NotNil(t, got)
+			assert.Equal(t, tt.wantCode, got.Code)
+			assert.Equal(t, tt.err.Error(), got.Message)
+		})
+	}
+
+	t.Run("validation status code in details", func(t *testing.T) {
+		got := ClassifyServiceError(&services.ValidationError{Msg: "invalid", StatusCode: 422})
+		assert.Equal(t, map[string]any{"statusCode": 422}, got.Details)
+	})
+
+	t.Run("unknown error is left to the generic classification", func(t *testing.T) {
+		assert.Nil(t, ClassifyServiceError(errors.New("connection reset")))
+	})
+}
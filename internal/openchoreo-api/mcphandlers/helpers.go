@@ -29,3 +29,22 @@ func toServiceListOptions(opts tools.ListOpts) services.ListOptions {
 		Cursor: opts.Cursor,
 	}
 }
+
+// listAllPages follows NextCursor until the list is exhausted and returns every
+// item. It is used by handlers that aggregate across a whole collection rather
+// than exposing pagination to the caller.
+func listAllPages[T any](list func(opts services.ListOptions) (*services.ListResult[T], error)) ([]T, error) {
+	var items []T
+	opts := services.ListOptions{}
+	for {
+		result, err := list(opts)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, result.Items...)
+		if result.NextCursor == "" {
+			return items, nil
+		}
+		opts.Cursor = result.NextCursor
+	}
+}
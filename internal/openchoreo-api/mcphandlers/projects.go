@@ -25,6 +25,14 @@ func (h *MCPHandler) ListProjects(ctx context.Context, namespaceName string, opt
 	return wrapTransformedList("projects", result.Items, result.NextCursor, projectSummary), nil
 }
 
+func (h *MCPHandler) GetProject(ctx context.Context, namespaceName, projectName string) (any, error) {
+	project, err := h.services.ProjectService.GetProject(ctx, namespaceName, projectName)
+	if err != nil {
+		return nil, err
+	}
+	return projectDetail(project), nil
+}
+
 // CreateProject creates the Project CR only. ProjectReleaseBindings, which bind
 // the project to an environment, are created separately via
 // CreateProjectReleaseBinding so this tool's required authz stays exactly
@@ -18,6 +18,46 @@ import (
 	projectmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project/mocks"
 )
 
+func TestGetProject(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns project detail", func(t *testing.T) {
+		projSvc := projectmocks.NewMockService(t)
+		projSvc.EXPECT().
+			GetProject(mock.Anything, testNS, "my-proj").
+			Return(&openchoreov1alpha1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-proj", Namespace: testNS},
+				Spec: openchoreov1alpha1.ProjectSpec{
+					DeploymentPipelineRef: openchoreov1alpha1.DeploymentPipelineRef{Name: "default"},
+				},
+				Status: openchoreov1alpha1.ProjectStatus{
+					Conditions: []metav1.Condition{{Type: "Ready", Status: metav1.ConditionTrue}},
+				},
+			}, nil)
+
+		h := newTestHandler(withProjectService(projSvc))
+		result, err := h.GetProject(ctx, testNS, "my-proj")
+		require.NoError(t, err)
+
+		typed, ok := result.(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, "my-proj", typed["name"])
+		assert.Equal(t, "default", typed["deploymentPipelineRef"])
+		assert.Len(t, typed["conditions"], 1)
+	})
+
+	t.Run("service error propagated", func(t *testing.T) {
+		projSvc := projectmocks.NewMockService(t)
+		projSvc.EXPECT().
+			GetProject(mock.Anything, testNS, "missing").
+			Return(nil, errors.New("project not found"))
+
+		h := newTestHandler(withProjectService(projSvc))
+		_, err := h.GetProject(ctx, testNS, "missing")
+		require.Error(t, err)
+	})
+}
+
 func TestCreateProject(t *testing.T) {
 	ctx := context.Background()
 
@@ -0,0 +1,73 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"context"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/promotion"
+	"github.com/openchoreo/openchoreo/pkg/mcp/tools"
+)
+
+// PromoteComponent requests the promotion of the component release currently bound
+// in the source environment to the target environment. The promotion must be allowed
+// by one of the promotion paths of the project's DeploymentPipeline. When the target
+// requires approval the request is left pending, otherwise the release is bound to
+// the target right away.
+func (h *MCPHandler) PromoteComponent(
+	ctx context.Context, namespaceName, componentName, sourceEnv, targetEnv, comment string,
+) (any, error) {
+	pr, err := h.services.PromotionService.RequestPromotion(ctx, namespaceName, &promotion.RequestPromotionRequest{
+		ComponentName:     componentName,
+		SourceEnvironment: sourceEnv,
+		TargetEnvironment: targetEnv,
+		Comment:           comment,
+	})
+	if err != nil {
+		return nil, err
+	}
+	action := "promoted"
+	if pr.Status.ApprovalRequired {
+		action = "pending_approval"
+	}
+	return mutationResult(pr, action, promotionRequestSummary(*pr)), nil
+}
+
+func (h *MCPHandler) ListPromotionRequests(
+	ctx context.Context, namespaceName, componentName string, opts tools.ListOpts,
+) (any, error) {
+	result, err := h.services.PromotionService.ListPromotionRequests(ctx, namespaceName, componentName, toServiceListOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return wrapTransformedList("promotion_requests", result.Items, result.NextCursor, promotionRequestSummary), nil
+}
+
+func (h *MCPHandler) GetPromotionRequest(ctx context.Context, namespaceName, promotionRequestName string) (any, error) {
+	pr, err := h.services.PromotionService.GetPromotionRequest(ctx, namespaceName, promotionRequestName)
+	if err != nil {
+		return nil, err
+	}
+	return promotionRequestSummary(*pr), nil
+}
+
+func (h *MCPHandler) ApprovePromotionRequest(
+	ctx context.Context, namespaceName, promotionRequestName, comment string,
+) (any, error) {
+	pr, err := h.services.PromotionService.ApprovePromotionRequest(ctx, namespaceName, promotionRequestName, comment)
+	if err != nil {
+		return nil, err
+	}
+	return mutationResult(pr, "approved", promotionRequestSummary(*pr)), nil
+}
+
+func (h *MCPHandler) RejectPromotionRequest(
+	ctx context.Context, namespaceName, promotionRequestName, comment string,
+) (any, error) {
+	pr, err := h.services.PromotionService.RejectPromotionRequest(ctx, namespaceName, promotionRequestName, comment)
+	if err != nil {
+		return nil, err
+	}
+	return mutationResult(pr, "rejected", promotionRequestSummary(*pr)), nil
+}
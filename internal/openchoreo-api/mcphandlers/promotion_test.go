@@ -0,0 +1,138 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	promotionsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/promotion"
+	promotionmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/promotion/mocks"
+	"github.com/openchoreo/openchoreo/pkg/mcp/tools"
+)
+
+func testPromotionRequest(phase openchoreov1alpha1.PromotionRequestPhase, approvalRequired bool) *openchoreov1alpha1.PromotionRequest {
+	return &openchoreov1alpha1.PromotionRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: testComponent + "-prod-abcde", Namespace: testNS},
+		Spec: openchoreov1alpha1.PromotionRequestSpec{
+			Owner:             openchoreov1alpha1.PromotionRequestOwner{ProjectName: testProject, ComponentName: testComponent},
+			SourceEnvironment: "staging",
+			TargetEnvironment: "prod",
+			ReleaseName:       "rel-2",
+			RequestedBy:       "user-1",
+		},
+		Status: openchoreov1alpha1.PromotionRequestStatus{Phase: phase, ApprovalRequired: approvalRequired},
+	}
+}
+
+func TestPromoteComponent(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("promotes when no approval is required", func(t *testing.T) {
+		pr := testPromotionRequest(openchoreov1alpha1.PromotionRequestPhasePromoted, false)
+		pr.Status.ReleaseBindingName = testComponent + "-prod"
+		svc := promotionmocks.NewMockService(t)
+		svc.EXPECT().RequestPromotion(mock.Anything, testNS, &promotionsvc.RequestPromotionRequest{
+			ComponentName: testComponent, SourceEnvironment: "staging", TargetEnvironment: "prod", Comment: "v2",
+		}).Return(pr, nil)
+
+		result, err := newTestHandler(withPromotionService(svc)).
+			PromoteComponent(ctx, testNS, testComponent, "staging", "prod", "v2")
+		require.NoError(t, err)
+		m := result.(map[string]any)
+		assert.Equal(t, "promoted", m["action"])
+		assert.Equal(t, "rel-2", m["releaseName"])
+		assert.Equal(t, testComponent+"-prod", m["releaseBindingName"])
+	})
+
+	t.Run("reports pending approval", func(t *testing.T) {
+		svc := promotionmocks.NewMockService(t)
+		svc.EXPECT().RequestPromotion(mock.Anything, testNS, mock.Anything).
+			Return(testPromotionRequest(openchoreov1alpha1.PromotionRequestPhasePendingApproval, true), nil)
+
+		result, err := newTestHandler(withPromotionService(svc)).
+			PromoteComponent(ctx, testNS, testComponent, "staging", "prod", "")
+		require.NoError(t, err)
+		m := result.(map[string]any)
+		assert.Equal(t, "pending_approval", m["action"])
+		assert.Equal(t, "PendingApproval", m["phase"])
+		assert.Equal(t, true, m["approvalRequired"])
+	})
+
+	t.Run("propagates service errors", func(t *testing.T) {
+		svc := promotionmocks.NewMockService(t)
+		svc.EXPECT().RequestPromotion(mock.Anything, testNS, mock.Anything).Return(nil, promotionsvc.ErrPromotionNotAllowed)
+
+		_, err := newTestHandler(withPromotionService(svc)).
+			PromoteComponent(ctx, testNS, testComponent, "prod", "staging", "")
+		require.ErrorIs(t, err, promotionsvc.ErrPromotionNotAllowed)
+	})
+}
+
+func TestListPromotionRequests(t *testing.T) {
+	svc := promotionmocks.NewMockService(t)
+	svc.EXPECT().ListPromotionRequests(mock.Anything, testNS, testComponent, mock.Anything).Return(
+		&services.ListResult[openchoreov1alpha1.PromotionRequest]{
+			Items: []openchoreov1alpha1.PromotionRequest{
+				*testPromotionRequest(openchoreov1alpha1.PromotionRequestPhasePendingApproval, true),
+			},
+		}, nil)
+
+	result, err := newTestHandler(withPromotionService(svc)).
+		ListPromotionRequests(context.Background(), testNS, testComponent, tools.ListOpts{})
+	require.NoError(t, err)
+	items := result.(map[string]any)["promotion_requests"].([]map[string]any)
+	require.Len(t, items, 1)
+	assert.Equal(t, "prod", items[0]["targetEnvironment"])
+	assert.Equal(t, "PendingApproval", items[0]["phase"])
+}
+
+func TestDecidePromotionRequest(t *testing.T) {
+	ctx := context.Background()
+	name := testComponent + "-prod-abcde"
+
+	t.Run("approve", func(t *testing.T) {
+		pr := testPromotionRequest(openchoreov1alpha1.PromotionRequestPhasePromoted, true)
+		pr.Status.Decision = &openchoreov1alpha1.PromotionDecision{
+			Approved: true, DecidedBy: "approver", DecidedAt: metav1.Now(), Comment: "lgtm",
+		}
+		svc := promotionmocks.NewMockService(t)
+		svc.EXPECT().ApprovePromotionRequest(mock.Anything, testNS, name, "lgtm").Return(pr, nil)
+
+		result, err := newTestHandler(withPromotionService(svc)).ApprovePromotionRequest(ctx, testNS, name, "lgtm")
+		require.NoError(t, err)
+		m := result.(map[string]any)
+		assert.Equal(t, "approved", m["action"])
+		decision := m["decision"].(map[string]any)
+		assert.Equal(t, true, decision["approved"])
+		assert.Equal(t, "approver", decision["decidedBy"])
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		svc := promotionmocks.NewMockService(t)
+		svc.EXPECT().RejectPromotionRequest(mock.Anything, testNS, name, "").
+			Return(testPromotionRequest(openchoreov1alpha1.PromotionRequestPhaseRejected, true), nil)
+
+		result, err := newTestHandler(withPromotionService(svc)).RejectPromotionRequest(ctx, testNS, name, "")
+		require.NoError(t, err)
+		assert.Equal(t, "rejected", result.(map[string]any)["action"])
+	})
+
+	t.Run("not pending", func(t *testing.T) {
+		svc := promotionmocks.NewMockService(t)
+		svc.EXPECT().ApprovePromotionRequest(mock.Anything, testNS, name, "").
+			Return(nil, promotionsvc.ErrPromotionRequestNotPending)
+
+		_, err := newTestHandler(withPromotionService(svc)).ApprovePromotionRequest(ctx, testNS, name, "")
+		require.True(t, errors.Is(err, promotionsvc.ErrPromotionRequestNotPending))
+	})
+}
@@ -40,6 +40,16 @@ func (h *MCPHandler) GetResource(ctx context.Context, namespaceName, resourceNam
 	return resourceDetail(r), nil
 }
 
+func (h *MCPHandler) ListResourceDeployedObjects(
+	ctx context.Context, namespaceName, resourceName, cluster string,
+) (any, error) {
+	objects, err := h.services.ResourceService.ListDeployedObjects(ctx, namespaceName, resourceName, cluster)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"objects": objects}, nil
+}
+
 func (h *MCPHandler) CreateResource(
 	ctx context.Context, namespaceName, projectName string,
 	req *gen.CreateResourceJSONRequestBody,
@@ -0,0 +1,42 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// RestartComponent triggers a rolling restart of the component's Deployments and
+// StatefulSets in the given environment. The restart is requested by stamping the
+// restartedAt annotation on the environment's release binding, which the controllers
+// propagate to the pod templates on the data plane.
+func (h *MCPHandler) RestartComponent(
+	ctx context.Context, namespaceName, componentName, environment string,
+) (any, error) {
+	rb, err := h.findReleaseBindingForEnvironment(ctx, namespaceName, componentName, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	restartedAt := time.Now().UTC().Format(time.RFC3339)
+	if rb.Annotations == nil {
+		rb.Annotations = map[string]string{}
+	}
+	rb.Annotations[controller.AnnotationKeyRestartedAt] = restartedAt
+
+	updated, err := h.services.ReleaseBindingService.UpdateReleaseBinding(ctx, namespaceName, rb)
+	if err != nil {
+		return nil, err
+	}
+	extras := map[string]any{
+		"component":   componentName,
+		"environment": environment,
+		"restartedAt": restartedAt,
+	}
+	setIfNotEmpty(extras, "status", readyStatus(updated.Status.Conditions))
+	return mutationResult(updated, "restarted", extras), nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	releasebindingmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/releasebinding/mocks"
+)
+
+func TestRestartComponent(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) (*MCPHandler, *releasebindingmocks.MockService) {
+		t.Helper()
+		rbSvc := releasebindingmocks.NewMockService(t)
+		rbSvc.EXPECT().ListReleaseBindings(mock.Anything, testNS, testComponent, services.ListOptions{}).
+			Return(&services.ListResult[openchoreov1alpha1.ReleaseBinding]{
+				Items: []openchoreov1alpha1.ReleaseBinding{{
+					ObjectMeta: metav1.ObjectMeta{Name: testComponent + "-dev", Namespace: testNS},
+					Spec:       openchoreov1alpha1.ReleaseBindingSpec{Environment: "dev"},
+				}},
+			}, nil)
+		return newTestHandler(withReleaseBindingService(rbSvc)), rbSvc
+	}
+
+	t.Run("stamps restartedAt on the binding", func(t *testing.T) {
+		h, rbSvc := setup(t)
+		rbSvc.EXPECT().
+			UpdateReleaseBinding(mock.Anything, testNS, mock.MatchedBy(func(rb *openchoreov1alpha1.ReleaseBinding) bool {
+				return rb.Annotations[controller.AnnotationKeyRestartedAt] != ""
+			})).
+			RunAndReturn(func(_ context.Context, _ string, rb *openchoreov1alpha1.ReleaseBinding) (
+				*openchoreov1alpha1.ReleaseBinding, error,
+			) {
+				rb.Status.Conditions = []metav1.Condition{{Type: "Ready", Status: metav1.ConditionTrue}}
+				return rb, nil
+			})
+
+		result, err := h.RestartComponent(ctx, testNS, testComponent, "dev")
+		require.NoError(t, err)
+		m := result.(map[string]any)
+		assert.Equal(t, "restarted", m["action"])
+		assert.Equal(t, "Ready", m["status"])
+		assert.NotEmpty(t, m["restartedAt"])
+	})
+
+	t.Run("environment without binding", func(t *testing.T) {
+		h, _ := setup(t)
+		_, err := h.RestartComponent(ctx, testNS, testComponent, "prod")
+		require.Error(t, err)
+	})
+}
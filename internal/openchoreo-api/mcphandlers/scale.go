@@ -0,0 +1,159 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/pkg/mcp/tools"
+)
+
+const (
+	envConfigReplicas    = "replicas"
+	envConfigAutoscaling = "autoscaling"
+	envConfigMinReplicas = "minReplicas"
+	envConfigMaxReplicas = "maxReplicas"
+)
+
+// ScaleComponent sets the replica count and/or autoscaling bounds of a component in
+// a single environment. The values are written to the componentTypeEnvironmentConfigs
+// of the environment's release binding, so the component type must expose them in its
+// environmentConfigs schema; any minimum/maximum declared there acts as a guardrail.
+func (h *MCPHandler) ScaleComponent(
+	ctx context.Context, namespaceName, componentName, environment string, opts tools.ScaleOpts,
+) (any, error) {
+	if opts.Replicas == nil && opts.MinReplicas == nil && opts.MaxReplicas == nil {
+		return nil, fmt.Errorf("at least one of replicas, min_replicas or max_replicas is required")
+	}
+
+	schema, err := h.services.ComponentService.GetComponentSchema(ctx, namespaceName, componentName)
+	if err != nil {
+		return nil, err
+	}
+	envSchema := schema.Properties["componentTypeEnvironmentConfigs"]
+	if opts.Replicas != nil {
+		if err := checkReplicaGuardrail(envSchema, envConfigReplicas, *opts.Replicas); err != nil {
+			return nil, err
+		}
+	}
+	autoscalingSchema := envSchema.Properties[envConfigAutoscaling]
+	if opts.MinReplicas != nil {
+		if err := checkReplicaGuardrail(autoscalingSchema, envConfigMinReplicas, *opts.MinReplicas); err != nil {
+			return nil, err
+		}
+	}
+	if opts.MaxReplicas != nil {
+		if err := checkReplicaGuardrail(autoscalingSchema, envConfigMaxReplicas, *opts.MaxReplicas); err != nil {
+			return nil, err
+		}
+	}
+
+	rb, err := h.findReleaseBindingForEnvironment(ctx, namespaceName, componentName, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	configs := map[string]any{}
+	if rb.Spec.ComponentTypeEnvironmentConfigs != nil && len(rb.Spec.ComponentTypeEnvironmentConfigs.Raw) > 0 {
+		if err := json.Unmarshal(rb.Spec.ComponentTypeEnvironmentConfigs.Raw, &configs); err != nil {
+			return nil, fmt.Errorf("failed to parse componentTypeEnvironmentConfigs of %q: %w", rb.Name, err)
+		}
+	}
+	extras := map[string]any{
+		"component":   componentName,
+		"environment": environment,
+	}
+	if opts.Replicas != nil {
+		configs[envConfigReplicas] = *opts.Replicas
+		extras[envConfigReplicas] = *opts.Replicas
+	}
+	if opts.MinReplicas != nil || opts.MaxReplicas != nil {
+		autoscaling, _ := configs[envConfigAutoscaling].(map[string]any)
+		if autoscaling == nil {
+			autoscaling = map[string]any{}
+		}
+		if opts.MinReplicas != nil {
+			autoscaling[envConfigMinReplicas] = *opts.MinReplicas
+		}
+		if opts.MaxReplicas != nil {
+			autoscaling[envConfigMaxReplicas] = *opts.MaxReplicas
+		}
+		minReplicas, hasMin := replicaValue(autoscaling[envConfigMinReplicas])
+		maxReplicas, hasMax := replicaValue(autoscaling[envConfigMaxReplicas])
+		if hasMin && hasMax && minReplicas > maxReplicas {
+			return nil, fmt.Errorf("minReplicas (%d) must not exceed maxReplicas (%d)", minReplicas, maxReplicas)
+		}
+		configs[envConfigAutoscaling] = autoscaling
+		extras[envConfigAutoscaling] = autoscaling
+	}
+
+	raw, err := json.Marshal(configs)
+	if err != nil {
+		return nil, err
+	}
+	rb.Spec.ComponentTypeEnvironmentConfigs = &runtime.RawExtension{Raw: raw}
+
+	updated, err := h.services.ReleaseBindingService.UpdateReleaseBinding(ctx, namespaceName, rb)
+	if err != nil {
+		return nil, err
+	}
+	return mutationResult(updated, "scaled", extras), nil
+}
+
+// findReleaseBindingForEnvironment returns the component's release binding for the
+// given environment, or an error when the component is not deployed there.
+func (h *MCPHandler) findReleaseBindingForEnvironment(
+	ctx context.Context, namespaceName, componentName, environment string,
+) (*openchoreov1alpha1.ReleaseBinding, error) {
+	bindings, err := listAllPages(
+		func(opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ReleaseBinding], error) {
+			return h.services.ReleaseBindingService.ListReleaseBindings(ctx, namespaceName, componentName, opts)
+		})
+	if err != nil {
+		return nil, err
+	}
+	for i := range bindings {
+		if bindings[i].Spec.Environment == environment {
+			return &bindings[i], nil
+		}
+	}
+	return nil, fmt.Errorf("component %q is not deployed to environment %q", componentName, environment)
+}
+
+// checkReplicaGuardrail verifies that the schema declares field and that value lies
+// within the minimum/maximum bounds it sets.
+func checkReplicaGuardrail(schema extv1.JSONSchemaProps, field string, value int32) error {
+	prop, ok := schema.Properties[field]
+	if !ok {
+		return fmt.Errorf("component type does not allow setting %s per environment", field)
+	}
+	v := float64(value)
+	if prop.Minimum != nil && (v < *prop.Minimum || (prop.ExclusiveMinimum && v == *prop.Minimum)) {
+		return fmt.Errorf("%s %d is below the minimum of %v allowed by the component type", field, value, *prop.Minimum)
+	}
+	if prop.Maximum != nil && (v > *prop.Maximum || (prop.ExclusiveMaximum && v == *prop.Maximum)) {
+		return fmt.Errorf("%s %d exceeds the maximum of %v allowed by the component type", field, value, *prop.Maximum)
+	}
+	return nil
+}
+
+// replicaValue reads a replica count that was either set by ScaleComponent or
+// decoded from JSON.
+func replicaValue(v any) (int64, bool) {
+	switch n := v.(type) {
+	case int32:
+		return int64(n), true
+	case float64:
+		return int64(n), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component/mocks"
+	releasebindingmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/releasebinding/mocks"
+	"github.com/openchoreo/openchoreo/pkg/mcp/tools"
+)
+
+func TestScaleComponent(t *testing.T) {
+	ctx := context.Background()
+	one, ten := 1.0, 10.0
+
+	componentSchema := &extv1.JSONSchemaProps{
+		Type: "object",
+		Properties: map[string]extv1.JSONSchemaProps{
+			"componentTypeEnvironmentConfigs": {
+				Type: "object",
+				Properties: map[string]extv1.JSONSchemaProps{
+					"replicas": {Type: "integer", Minimum: &one, Maximum: &ten},
+					"autoscaling": {
+						Type: "object",
+						Properties: map[string]extv1.JSONSchemaProps{
+							"minReplicas": {Type: "integer", Minimum: &one},
+							"maxReplicas": {Type: "integer", Minimum: &one},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	setup := func(t *testing.T, withBinding bool) (*MCPHandler, *releasebindingmocks.MockService) {
+		t.Helper()
+		compSvc := componentmocks.NewMockService(t)
+		rbSvc := releasebindingmocks.NewMockService(t)
+		compSvc.EXPECT().GetComponentSchema(mock.Anything, testNS, testComponent).Return(componentSchema, nil)
+		if withBinding {
+			rbSvc.EXPECT().ListReleaseBindings(mock.Anything, testNS, testComponent, services.ListOptions{}).
+				Return(&services.ListResult[openchoreov1alpha1.ReleaseBinding]{
+					Items: []openchoreov1alpha1.ReleaseBinding{{
+						ObjectMeta: metav1.ObjectMeta{Name: testComponent + "-dev", Namespace: testNS},
+						Spec: openchoreov1alpha1.ReleaseBindingSpec{
+							Environment: "dev",
+							ComponentTypeEnvironmentConfigs: &runtime.RawExtension{
+								Raw: []byte(`{"replicas":1,"imagePullPolicy":"Always","autoscaling":{"maxReplicas":4}}`),
+							},
+						},
+					}},
+				}, nil)
+		}
+		return newTestHandler(withComponentService(compSvc), withReleaseBindingService(rbSvc)), rbSvc
+	}
+
+	ptr := func(v int32) *int32 { return &v }
+
+	t.Run("merges replicas into environment configs", func(t *testing.T) {
+		h, rbSvc := setup(t, true)
+		var configs map[string]any
+		rbSvc.EXPECT().UpdateReleaseBinding(mock.Anything, testNS, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ string, rb *openchoreov1alpha1.ReleaseBinding) (
+				*openchoreov1alpha1.ReleaseBinding, error,
+			) {
+				require.NoError(t, json.Unmarshal(rb.Spec.ComponentTypeEnvironmentConfigs.Raw, &configs))
+				return rb, nil
+			})
+
+		result, err := h.ScaleComponent(ctx, testNS, testComponent, "dev", tools.ScaleOpts{
+			Replicas:    ptr(3),
+			MinReplicas: ptr(2),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "scaled", result.(map[string]any)["action"])
+		assert.Equal(t, 3.0, configs["replicas"])
+		assert.Equal(t, "Always", configs["imagePullPolicy"])
+		assert.Equal(t, map[string]any{"minReplicas": 2.0, "maxReplicas": 4.0}, configs["autoscaling"])
+	})
+
+	t.Run("rejects replicas above the component type maximum", func(t *testing.T) {
+		h, _ := setup(t, false)
+		_, err := h.ScaleComponent(ctx, testNS, testComponent, "dev", tools.ScaleOpts{Replicas: ptr(20)})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "exceeds the maximum")
+	})
+
+	t.Run("rejects min above existing max", func(t *testing.T) {
+		h, _ := setup(t, true)
+		_, err := h.ScaleComponent(ctx, testNS, testComponent, "dev", tools.ScaleOpts{MinReplicas: ptr(5)})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must not exceed")
+	})
+
+	t.Run("environment without binding", func(t *testing.T) {
+		h, _ := setup(t, true)
+		_, err := h.ScaleComponent(ctx, testNS, testComponent, "prod", tools.ScaleOpts{Replicas: ptr(2)})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not deployed")
+	})
+
+	t.Run("requires a setting", func(t *testing.T) {
+		_, err := newTestHandler().ScaleComponent(ctx, testNS, testComponent, "dev", tools.ScaleOpts{})
+		require.Error(t, err)
+	})
+}
+
+func TestCheckReplicaGuardrail(t *testing.T) {
+	two := 2.0
+	schema := extv1.JSONSchemaProps{Properties: map[string]extv1.JSONSchemaProps{
+		"replicas": {Type: "integer", Minimum: &two},
+	}}
+	require.NoError(t, checkReplicaGuardrail(schema, "replicas", 2))
+	assert.ErrorContains(t, checkReplicaGuardrail(schema, "replicas", 1), "below the minimum")
+	assert.ErrorContains(t, checkReplicaGuardrail(schema, "maxReplicas", 3), "does not allow")
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	secretreferencesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secretreference"
+)
+
+// ListComponentSecrets lists the secret references consumed by a component through
+// environment variables and files, both from its workload and from the
+// per-environment overrides. Only names and keys are reported; secret values are
+// never read. When environment is set, overrides of other environments are skipped.
+func (h *MCPHandler) ListComponentSecrets(
+	ctx context.Context, namespaceName, componentName, environment string,
+) (any, error) {
+	workloads, err := h.services.WorkloadService.ListWorkloads(ctx, namespaceName, componentName, services.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	bindings, err := listAllPages(
+		func(opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.ReleaseBinding], error) {
+			return h.services.ReleaseBindingService.ListReleaseBindings(ctx, namespaceName, componentName, opts)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	usages := map[string][]map[string]any{}
+	var order []string
+	collect := func(scope string, env []openchoreov1alpha1.EnvVar, files []openchoreov1alpha1.FileVar) {
+		add := func(kind, name string, from *openchoreov1alpha1.EnvVarValueFrom) {
+			if from == nil || from.SecretKeyRef == nil {
+				return
+			}
+			ref := from.SecretKeyRef
+			if _, seen := usages[ref.Name]; !seen {
+				order = append(order, ref.Name)
+			}
+			usages[ref.Name] = append(usages[ref.Name], map[string]any{
+				"scope":     scope,
+				"kind":      kind,
+				"name":      name,
+				"secretKey": ref.Key,
+			})
+		}
+		for _, e := range env {
+			add("env", e.Key, e.ValueFrom)
+		}
+		for _, f := range files {
+			add("file", f.Key, f.ValueFrom)
+		}
+	}
+
+	for i := range workloads.Items {
+		c := workloads.Items[i].Spec.Container
+		collect("workload", c.Env, c.Files)
+	}
+	for i := range bindings {
+		rb := &bindings[i]
+		if environment != "" && rb.Spec.Environment != environment {
+			continue
+		}
+		if o := rb.Spec.WorkloadOverrides; o != nil && o.Container != nil {
+			collect("environment:"+rb.Spec.Environment, o.Container.Env, o.Container.Files)
+		}
+	}
+
+	secrets := make([]map[string]any, 0, len(order))
+	for _, name := range order {
+		entry := map[string]any{
+			"name":   name,
+			"usedBy": usages[name],
+		}
+		sr, err := h.services.SecretReferenceService.GetSecretReference(ctx, namespaceName, name)
+		switch {
+		case errors.Is(err, secretreferencesvc.ErrSecretReferenceNotFound):
+			entry["status"] = "NotFound"
+		case err != nil:
+			return nil, err
+		default:
+			entry["keys"] = secretReferenceKeys(sr)
+			if sr.Spec.TargetPlane != nil {
+				entry["targetPlane"] = map[string]any{"kind": sr.Spec.TargetPlane.Kind, "name": sr.Spec.TargetPlane.Name}
+			}
+			setIfNotEmpty(entry, "status", readyStatus(sr.Status.Conditions))
+		}
+		secrets = append(secrets, entry)
+	}
+
+	return map[string]any{
+		"component": componentName,
+		"secrets":   secrets,
+	}, nil
+}
+
+// SetSecretValue writes a single key of a secret to the secret store configured for
+// the secret's target plane. The response only echoes the secret's key names so
+// values never travel back to the MCP client.
+func (h *MCPHandler) SetSecretValue(ctx context.Context, namespaceName, secretName, key, value string) (any, error) {
+	if key == "" || value == "" {
+		return nil, fmt.Errorf("key and value are required")
+	}
+	secret, err := h.services.SecretService.SetSecretValue(ctx, namespaceName, secretName, key, value)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(secret.Data))
+	for k := range secret.Data {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return mutationResult(secret, "updated", map[string]any{
+		"key":  key,
+		"keys": keys,
+	}), nil
+}
+
+func secretReferenceKeys(sr *openchoreov1alpha1.SecretReference) []string {
+	keys := make([]string, 0, len(sr.Spec.Data))
+	for _, d := range sr.Spec.Data {
+		keys = append(keys, d.SecretKey)
+	}
+	return keys
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mcphandlers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	releasebindingmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/releasebinding/mocks"
+	secretmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secret/mocks"
+	secretreferencesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secretreference"
+	secretreferencemocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secretreference/mocks"
+	workloadmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workload/mocks"
+)
+
+func TestListComponentSecrets(t *testing.T) {
+	ctx := context.Background()
+	secretRef := func(name, key string) *openchoreov1alpha1.EnvVarValueFrom {
+		return &openchoreov1alpha1.EnvVarValueFrom{SecretKeyRef: &openchoreov1alpha1.SecretKeyRef{Name: name, Key: key}}
+	}
+
+	wlSvc := workloadmocks.NewMockService(t)
+	wlSvc.EXPECT().ListWorkloads(mock.Anything, testNS, testComponent, services.ListOptions{}).
+		Return(&services.ListResult[openchoreov1alpha1.Workload]{Items: []openchoreov1alpha1.Workload{{
+			Spec: openchoreov1alpha1.WorkloadSpec{
+				WorkloadTemplateSpec: openchoreov1alpha1.WorkloadTemplateSpec{
+					Container: openchoreov1alpha1.Container{
+						Env: []openchoreov1alpha1.EnvVar{
+							{Key: "DB_PASSWORD", ValueFrom: secretRef("db", "password")},
+							{Key: "LOG_LEVEL", Value: "info"},
+						},
+					},
+				},
+			},
+		}}}, nil)
+	rbSvc := releasebindingmocks.NewMockService(t)
+	rbSvc.EXPECT().ListReleaseBindings(mock.Anything, testNS, testComponent, services.ListOptions{}).
+		Return(&services.ListResult[openchoreov1alpha1.ReleaseBinding]{Items: []openchoreov1alpha1.ReleaseBinding{{
+			Spec: openchoreov1alpha1.ReleaseBindingSpec{
+				Environment: "prod",
+				WorkloadOverrides: &openchoreov1alpha1.WorkloadOverrideTemplateSpec{
+					Container: &openchoreov1alpha1.ContainerOverride{
+						Files: []openchoreov1alpha1.FileVar{
+							{Key: "tls.key", MountPath: "/etc/tls", ValueFrom: secretRef("tls", "key")},
+						},
+					},
+				},
+			},
+		}}}, nil)
+	srSvc := secretreferencemocks.NewMockService(t)
+	srSvc.EXPECT().GetSecretReference(mock.Anything, testNS, "db").Return(&openchoreov1alpha1.SecretReference{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: testNS},
+		Spec: openchoreov1alpha1.SecretReferenceSpec{
+			Data: []openchoreov1alpha1.SecretDataSource{{SecretKey: "password"}},
+		},
+	}, nil)
+	srSvc.EXPECT().GetSecretReference(mock.Anything, testNS, "tls").
+		Return(nil, secretreferencesvc.ErrSecretReferenceNotFound)
+
+	h := newTestHandler(withWorkloadService(wlSvc), withReleaseBindingService(rbSvc), withSecretReferenceService(srSvc))
+	result, err := h.ListComponentSecrets(ctx, testNS, testComponent, "")
+	require.NoError(t, err)
+
+	secrets := result.(map[string]any)["secrets"].([]map[string]any)
+	require.Len(t, secrets, 2)
+	assert.Equal(t, "db", secrets[0]["name"])
+	assert.Equal(t, []string{"password"}, secrets[0]["keys"])
+	assert.Equal(t, "tls", secrets[1]["name"])
+	assert.Equal(t, "NotFound", secrets[1]["status"])
+	usedBy := secrets[1]["usedBy"].([]map[string]any)
+	assert.Equal(t, "environment:prod", usedBy[0]["scope"])
+	assert.Equal(t, "file", usedBy[0]["kind"])
+}
+
+func TestSetSecretValue(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("response never contains values", func(t *testing.T) {
+		secretSvc := secretmocks.NewMockService(t)
+		secretSvc.EXPECT().SetSecretValue(mock.Anything, testNS, "db", "password", "n3w-s3cret").
+			Return(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: testNS},
+				Data: map[string][]byte{
+					"username": []byte("admin"),
+					"password": []byte("n3w-s3cret"),
+				},
+			}, nil)
+
+		result, err := newTestHandler(withSecretService(secretSvc)).
+			SetSecretValue(ctx, testNS, "db", "password", "n3w-s3cret")
+		require.NoError(t, err)
+		m := result.(map[string]any)
+		assert.Equal(t, []string{"password", "username"}, m["keys"])
+
+		raw, err := json.Marshal(result)
+		require.NoError(t, err)
+		assert.NotContains(t, string(raw), "n3w-s3cret")
+		assert.NotContains(t, string(raw), "admin")
+	})
+
+	t.Run("requires key and value", func(t *testing.T) {
+		_, err := newTestHandler().SetSecretValue(ctx, testNS, "db", "password", "")
+		require.Error(t, err)
+	})
+}
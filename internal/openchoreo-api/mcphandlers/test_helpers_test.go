@@ -5,12 +5,17 @@ package mcphandlers
 
 import (
 	clustercomponenttypesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/clustercomponenttype"
+	clusterdataplanesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/clusterdataplane"
 	clusterprojecttypesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/clusterprojecttype"
 	clusterresourcetypesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/clusterresourcetype"
 	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
 	componentreleasesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/componentrelease"
 	componenttypesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/componenttype"
+	dataplanesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/dataplane"
+	dependencysvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/dependency"
+	deploymentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/deployment"
 	deploymentpipelinesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/deploymentpipeline"
+	endpointsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/endpoint"
 	environmentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/handlerservices"
 	namespacesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/namespace"
@@ -18,11 +23,14 @@ import (
 	projectreleasesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/projectrelease"
 	projectreleasebindingsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/projectreleasebinding"
 	projecttypesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/projecttype"
+	promotionsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/promotion"
+	releasesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/release"
 	releasebindingsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/releasebinding"
 	resourcesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/resource"
 	resourcereleasesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/resourcerelease"
 	resourcereleasebindingsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/resourcereleasebinding"
 	resourcetypesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/resourcetype"
+	secretsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secret"
 	secretreferencesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secretreference"
 	workflowrunsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowrun"
 	workloadsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workload"
@@ -49,6 +57,22 @@ func withClusterComponentTypeService(s clustercomponenttypesvc.Service) func(*ha
 	return func(svc *handlerservices.Services) { svc.ClusterComponentTypeService = s }
 }
 
+func withDataPlaneService(s dataplanesvc.Service) func(*handlerservices.Services) {
+	return func(svc *handlerservices.Services) { svc.DataPlaneService = s }
+}
+
+func withClusterDataPlaneService(s clusterdataplanesvc.Service) func(*handlerservices.Services) {
+	return func(svc *handlerservices.Services) { svc.ClusterDataPlaneService = s }
+}
+
+func withDependencyService(s dependencysvc.Service) func(*handlerservices.Services) {
+	return func(svc *handlerservices.Services) { svc.DependencyService = s }
+}
+
+func withEndpointService(s endpointsvc.Service) func(*handlerservices.Services) {
+	return func(svc *handlerservices.Services) { svc.EndpointService = s }
+}
+
 func withEnvironmentService(s environmentsvc.Service) func(*handlerservices.Services) {
 	return func(svc *handlerservices.Services) { svc.EnvironmentService = s }
 }
@@ -89,14 +113,30 @@ func withWorkflowRunService(s workflowrunsvc.Service) func(*handlerservices.Serv
 	return func(svc *handlerservices.Services) { svc.WorkflowRunService = s }
 }
 
+func withDeploymentService(s deploymentsvc.Service) func(*handlerservices.Services) {
+	return func(svc *handlerservices.Services) { svc.DeploymentService = s }
+}
+
+func withReleaseService(s releasesvc.Service) func(*handlerservices.Services) {
+	return func(svc *handlerservices.Services) { svc.ReleaseService = s }
+}
+
 func withDeploymentPipelineService(s deploymentpipelinesvc.Service) func(*handlerservices.Services) {
 	return func(svc *handlerservices.Services) { svc.DeploymentPipelineService = s }
 }
 
+func withPromotionService(s promotionsvc.Service) func(*handlerservices.Services) {
+	return func(svc *handlerservices.Services) { svc.PromotionService = s }
+}
+
 func withSecretReferenceService(s secretreferencesvc.Service) func(*handlerservices.Services) {
 	return func(svc *handlerservices.Services) { svc.SecretReferenceService = s }
 }
 
+func withSecretService(s secretsvc.Service) func(*handlerservices.Services) {
+	return func(svc *handlerservices.Services) { svc.SecretService = s }
+}
+
 func withComponentReleaseService(s componentreleasesvc.Service) func(*handlerservices.Services) {
 	return func(svc *handlerservices.Services) { svc.ComponentReleaseService = s }
 }
@@ -48,6 +48,20 @@ func readyStatus(conditions []metav1.Condition) string {
 	return ""
 }
 
+// healthStatus returns "Healthy" if the Healthy condition is True, the
+// condition's reason otherwise, or "" if there is no Healthy condition.
+func healthStatus(conditions []metav1.Condition) string {
+	for i := range conditions {
+		if conditions[i].Type == "Healthy" {
+			if conditions[i].Status == metav1.ConditionTrue {
+				return "Healthy"
+			}
+			return conditions[i].Reason
+		}
+	}
+	return ""
+}
+
 // conditionsSummary returns a compact representation of conditions for detail
 // views, stripping lastTransitionTime and observedGeneration.
 func conditionsSummary(conditions []metav1.Condition) []map[string]any {
@@ -5,6 +5,8 @@ package mcphandlers
 
 import (
 	"encoding/json"
+	"strconv"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -41,6 +43,17 @@ func projectSummary(p openchoreov1alpha1.Project) map[string]any {
 	return m
 }
 
+func projectDetail(p *openchoreov1alpha1.Project) map[string]any {
+	m := projectSummary(*p)
+	if p.Spec.Parameters != nil {
+		m["parameters"] = rawExtensionToAny(p.Spec.Parameters)
+	}
+	if conds := conditionsSummary(p.Status.Conditions); conds != nil {
+		m["conditions"] = conds
+	}
+	return m
+}
+
 // ---------------------------------------------------------------------------
 // ProjectType
 // ---------------------------------------------------------------------------
@@ -233,6 +246,42 @@ func workloadDetail(w *openchoreov1alpha1.Workload) map[string]any {
 	return m
 }
 
+// containerConfigSummary lists the environment variables and files of a workload
+// container or of its per-environment override.
+func containerConfigSummary(env []openchoreov1alpha1.EnvVar, files []openchoreov1alpha1.FileVar) map[string]any {
+	m := map[string]any{}
+	if len(env) > 0 {
+		vars := make([]map[string]any, 0, len(env))
+		for _, e := range env {
+			v := map[string]any{"key": e.Key}
+			setIfNotEmpty(v, "value", e.Value)
+			setSecretKeyRef(v, e.ValueFrom)
+			vars = append(vars, v)
+		}
+		m["env"] = vars
+	}
+	if len(files) > 0 {
+		fs := make([]map[string]any, 0, len(files))
+		for _, f := range files {
+			v := map[string]any{"key": f.Key, "mountPath": f.MountPath}
+			setIfNotEmpty(v, "value", f.Value)
+			setSecretKeyRef(v, f.ValueFrom)
+			fs = append(fs, v)
+		}
+		m["files"] = fs
+	}
+	return m
+}
+
+func setSecretKeyRef(m map[string]any, from *openchoreov1alpha1.EnvVarValueFrom) {
+	if from != nil && from.SecretKeyRef != nil {
+		m["secretKeyRef"] = map[string]any{
+			"name": from.SecretKeyRef.Name,
+			"key":  from.SecretKeyRef.Key,
+		}
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Environment
 // ---------------------------------------------------------------------------
@@ -250,6 +299,129 @@ func environmentSummary(e openchoreov1alpha1.Environment) map[string]any {
 	return m
 }
 
+// deployedComponentSummary is the per-component entry of an environment overview:
+// which release of which component is bound to the environment and whether it is healthy.
+func deployedComponentSummary(rb openchoreov1alpha1.ReleaseBinding) map[string]any {
+	m := map[string]any{
+		"componentName": rb.Spec.Owner.ComponentName,
+		"projectName":   rb.Spec.Owner.ProjectName,
+		"bindingName":   rb.Name,
+	}
+	setIfNotEmpty(m, "releaseName", rb.Spec.ReleaseName)
+	if rb.Spec.State != "" {
+		m["state"] = string(rb.Spec.State)
+	}
+	if len(rb.Status.Endpoints) > 0 {
+		m["endpoints"] = rb.Status.Endpoints
+	}
+	setIfNotEmpty(m, "status", readyStatus(rb.Status.Conditions))
+	return m
+}
+
+// Endpoint visibilities reported by componentEndpointsSummary.
+const (
+	endpointVisibilityExternal = "external"
+	endpointVisibilityInternal = "internal"
+	endpointVisibilityCluster  = "cluster"
+)
+
+// componentEndpointsSummary flattens the resolved endpoint URLs of a release
+// binding into one entry per reachable URL, labelled with its visibility:
+// external and internal gateway URLs, and the in-cluster service URL.
+func componentEndpointsSummary(endpoints []openchoreov1alpha1.EndpointURLStatus) []map[string]any {
+	result := make([]map[string]any, 0, len(endpoints))
+	for _, ep := range endpoints {
+		urls := make([]map[string]any, 0)
+		urls = appendGatewayURLs(urls, endpointVisibilityExternal, ep.ExternalURLs)
+		if ep.ExternalURLs == nil && ep.InvokeURL != "" {
+			urls = append(urls, map[string]any{"visibility": endpointVisibilityExternal, "url": ep.InvokeURL})
+		}
+		urls = appendGatewayURLs(urls, endpointVisibilityInternal, ep.InternalURLs)
+		if ep.ServiceURL != nil {
+			urls = append(urls, endpointURLSummary(endpointVisibilityCluster, "", ep.ServiceURL))
+		}
+		m := map[string]any{"name": ep.Name, "urls": urls}
+		if ep.Type != "" {
+			m["type"] = string(ep.Type)
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+func appendGatewayURLs(
+	urls []map[string]any, visibility string, gw *openchoreov1alpha1.EndpointGatewayURLs,
+) []map[string]any {
+	if gw == nil {
+		return urls
+	}
+	if gw.HTTPS != nil {
+		urls = append(urls, endpointURLSummary(visibility, "https", gw.HTTPS))
+	}
+	if gw.HTTP != nil {
+		urls = append(urls, endpointURLSummary(visibility, "http", gw.HTTP))
+	}
+	if gw.TLS != nil {
+		urls = append(urls, endpointURLSummary(visibility, "tls", gw.TLS))
+	}
+	return urls
+}
+
+func endpointURLSummary(visibility, listener string, u *openchoreov1alpha1.EndpointURL) map[string]any {
+	m := map[string]any{
+		"visibility": visibility,
+		"url":        formatEndpointURL(u),
+		"host":       u.Host,
+	}
+	setIfNotEmpty(m, "listener", listener)
+	setIfNotEmpty(m, "scheme", u.Scheme)
+	setIfNotEmpty(m, "path", u.Path)
+	if u.Port != 0 {
+		m["port"] = u.Port
+	}
+	return m
+}
+
+// formatEndpointURL renders an EndpointURL as scheme://host[:port][/path]. The
+// port is omitted when it is the default for http or https.
+func formatEndpointURL(u *openchoreov1alpha1.EndpointURL) string {
+	var b strings.Builder
+	if u.Scheme != "" {
+		b.WriteString(u.Scheme + "://")
+	}
+	b.WriteString(u.Host)
+	if u.Port != 0 && !(u.Scheme == "http" && u.Port == 80) && !(u.Scheme == "https" && u.Port == 443) {
+		b.WriteString(":" + strconv.Itoa(int(u.Port)))
+	}
+	if u.Path != "" {
+		if !strings.HasPrefix(u.Path, "/") {
+			b.WriteString("/")
+		}
+		b.WriteString(u.Path)
+	}
+	return b.String()
+}
+
+// gatewayEndpointSummary returns the host/port of each configured listener of a
+// gateway endpoint, or nil when the endpoint is not configured.
+func gatewayEndpointSummary(ep *openchoreov1alpha1.GatewayEndpointSpec) map[string]any {
+	if ep == nil {
+		return nil
+	}
+	m := map[string]any{}
+	for scheme, l := range map[string]*openchoreov1alpha1.GatewayListenerSpec{
+		"http": ep.HTTP, "https": ep.HTTPS, "tls": ep.TLS,
+	} {
+		if l != nil {
+			m[scheme] = map[string]any{"host": l.Host, "port": l.Port}
+		}
+	}
+	if len(m) == 0 {
+		return nil
+	}
+	return m
+}
+
 // ---------------------------------------------------------------------------
 // DataPlane
 // ---------------------------------------------------------------------------
@@ -427,13 +599,22 @@ func workflowRunSummary(wr openchoreov1alpha1.WorkflowRun) map[string]any {
 	m := extractCommonMeta(&wr)
 	m["workflowName"] = wr.Spec.Workflow.Name
 	setIfNotEmpty(m, "status", readyStatus(wr.Status.Conditions))
-	if wr.Status.StartedAt != nil {
-		m["startedAt"] = wr.Status.StartedAt.UTC().Format("2006-01-02T15:04:05Z")
+	setWorkflowRunOutcome(m, &wr.Status)
+	return m
+}
+
+// setWorkflowRunOutcome adds the run timings, duration and the image it produced.
+func setWorkflowRunOutcome(m map[string]any, status *openchoreov1alpha1.WorkflowRunStatus) {
+	if status.StartedAt != nil {
+		m["startedAt"] = status.StartedAt.UTC().Format("2006-01-02T15:04:05Z")
 	}
-	if wr.Status.CompletedAt != nil {
-		m["completedAt"] = wr.Status.CompletedAt.UTC().Format("2006-01-02T15:04:05Z")
+	if status.CompletedAt != nil {
+		m["completedAt"] = status.CompletedAt.UTC().Format("2006-01-02T15:04:05Z")
 	}
-	return m
+	if status.StartedAt != nil && status.CompletedAt != nil {
+		m["duration"] = status.CompletedAt.Sub(status.StartedAt.Time).String()
+	}
+	setIfNotEmpty(m, "image", status.Image)
 }
 
 func workflowRunDetail(wr *openchoreov1alpha1.WorkflowRun) map[string]any {
@@ -443,12 +624,7 @@ func workflowRunDetail(wr *openchoreov1alpha1.WorkflowRun) map[string]any {
 		m["parameters"] = rawExtensionToAny(wr.Spec.Workflow.Parameters)
 	}
 	setIfNotEmpty(m, "status", readyStatus(wr.Status.Conditions))
-	if wr.Status.StartedAt != nil {
-		m["startedAt"] = wr.Status.StartedAt.UTC().Format("2006-01-02T15:04:05Z")
-	}
-	if wr.Status.CompletedAt != nil {
-		m["completedAt"] = wr.Status.CompletedAt.UTC().Format("2006-01-02T15:04:05Z")
-	}
+	setWorkflowRunOutcome(m, &wr.Status)
 	if len(wr.Status.Tasks) > 0 {
 		tasks := make([]map[string]any, 0, len(wr.Status.Tasks))
 		for i := range wr.Status.Tasks {
@@ -461,6 +637,9 @@ func workflowRunDetail(wr *openchoreov1alpha1.WorkflowRun) map[string]any {
 			if wr.Status.Tasks[i].CompletedAt != nil {
 				t["completedAt"] = wr.Status.Tasks[i].CompletedAt.UTC().Format("2006-01-02T15:04:05Z")
 			}
+			if wr.Status.Tasks[i].StartedAt != nil && wr.Status.Tasks[i].CompletedAt != nil {
+				t["duration"] = wr.Status.Tasks[i].CompletedAt.Sub(wr.Status.Tasks[i].StartedAt.Time).String()
+			}
 			tasks = append(tasks, t)
 		}
 		m["tasks"] = tasks
@@ -543,6 +722,12 @@ func workflowPlaneSummary(wp openchoreov1alpha1.WorkflowPlane) map[string]any {
 		m["agentConnected"] = wp.Status.AgentConnection.Connected
 	}
 	setIfNotEmpty(m, "status", readyStatus(wp.Status.Conditions))
+	setIfNotEmpty(m, "health", healthStatus(wp.Status.Conditions))
+	if c := wp.Status.Capacity; c != nil {
+		m["pendingWorkflows"] = c.PendingWorkflows
+		m["runningWorkflows"] = c.RunningWorkflows
+		m["readyNodes"] = c.ReadyNodes
+	}
 	return m
 }
 
@@ -556,6 +741,11 @@ func workflowPlaneDetail(wp *openchoreov1alpha1.WorkflowPlane) map[string]any {
 			m["agentConnection"] = ac
 		}
 	}
+	if wp.Status.Capacity != nil {
+		if c := specToMap(wp.Status.Capacity); len(c) > 0 {
+			m["capacity"] = c
+		}
+	}
 	setIfNotEmpty(m, "status", readyStatus(wp.Status.Conditions))
 	if conds := conditionsSummary(wp.Status.Conditions); conds != nil {
 		m["conditions"] = conds
@@ -1045,3 +1235,30 @@ func specToMap(spec any) map[string]any {
 	}
 	return m
 }
+
+func promotionRequestSummary(pr openchoreov1alpha1.PromotionRequest) map[string]any {
+	m := extractCommonMeta(&pr)
+	m["projectName"] = pr.Spec.Owner.ProjectName
+	m["componentName"] = pr.Spec.Owner.ComponentName
+	m["sourceEnvironment"] = pr.Spec.SourceEnvironment
+	m["targetEnvironment"] = pr.Spec.TargetEnvironment
+	m["releaseName"] = pr.Spec.ReleaseName
+	setIfNotEmpty(m, "requestedBy", pr.Spec.RequestedBy)
+	setIfNotEmpty(m, "comment", pr.Spec.Comment)
+	setIfNotEmpty(m, "phase", string(pr.Status.Phase))
+	m["approvalRequired"] = pr.Status.ApprovalRequired
+	if d := pr.Status.Decision; d != nil {
+		decision := map[string]any{
+			"approved":  d.Approved,
+			"decidedAt": d.DecidedAt.UTC().Format("2006-01-02T15:04:05Z"),
+		}
+		setIfNotEmpty(decision, "decidedBy", d.DecidedBy)
+		setIfNotEmpty(decision, "comment", d.Comment)
+		m["decision"] = decision
+	}
+	setIfNotEmpty(m, "releaseBindingName", pr.Status.ReleaseBindingName)
+	if pr.Status.PromotedAt != nil {
+		m["promotedAt"] = pr.Status.PromotedAt.UTC().Format("2006-01-02T15:04:05Z")
+	}
+	return m
+}
@@ -969,6 +969,7 @@ func TestWorkflowRunSummary(t *testing.T) {
 			Conditions:  []metav1.Condition{{Type: "Ready", Status: metav1.ConditionTrue}},
 			StartedAt:   &started,
 			CompletedAt: &completed,
+			Image:       "registry.local/api@sha256:abc",
 		},
 	}
 
@@ -977,6 +978,8 @@ func TestWorkflowRunSummary(t *testing.T) {
 	assert.Equal(t, "Ready", m["status"])
 	assert.Equal(t, "2025-06-15T10:00:00Z", m["startedAt"])
 	assert.Equal(t, "2025-06-15T10:05:00Z", m["completedAt"])
+	assert.Equal(t, "5m0s", m["duration"])
+	assert.Equal(t, "registry.local/api@sha256:abc", m["image"])
 }
 
 func TestWorkflowRunDetail(t *testing.T) {
@@ -1017,6 +1020,7 @@ func TestWorkflowRunDetail(t *testing.T) {
 	assert.Equal(t, "main", params["branch"])
 	assert.Equal(t, "2025-06-15T10:00:00Z", m["startedAt"])
 	assert.Equal(t, "2025-06-15T10:05:00Z", m["completedAt"])
+	assert.Equal(t, "5m0s", m["duration"])
 
 	tasks, ok := m["tasks"].([]map[string]any)
 	require.True(t, ok, "expected tasks to be []map[string]any")
@@ -1026,7 +1030,9 @@ func TestWorkflowRunDetail(t *testing.T) {
 	assert.Equal(t, "done", tasks[0]["message"])
 	assert.Equal(t, "2025-06-15T10:01:00Z", tasks[0]["startedAt"])
 	assert.Equal(t, "2025-06-15T10:03:00Z", tasks[0]["completedAt"])
+	assert.Equal(t, "2m0s", tasks[0]["duration"])
 	assert.NotContains(t, tasks[1], "phase")
+	assert.NotContains(t, tasks[1], "duration")
 	assert.Len(t, m["conditions"], 1)
 }
 
@@ -1042,6 +1048,8 @@ func TestWorkflowRunDetail_Minimal(t *testing.T) {
 	assert.NotContains(t, m, "parameters")
 	assert.NotContains(t, m, "startedAt")
 	assert.NotContains(t, m, "completedAt")
+	assert.NotContains(t, m, "duration")
+	assert.NotContains(t, m, "image")
 	assert.NotContains(t, m, "tasks")
 	assert.NotContains(t, m, "conditions")
 }
@@ -1141,6 +1149,30 @@ func TestWorkflowPlaneSummary(t *testing.T) {
 	assert.Equal(t, "Ready", m["status"])
 }
 
+func TestWorkflowPlaneSummary_Capacity(t *testing.T) {
+	wp := openchoreov1alpha1.WorkflowPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "wp-1", Namespace: "org-ns"},
+		Status: openchoreov1alpha1.WorkflowPlaneStatus{
+			Conditions: []metav1.Condition{{Type: "Healthy", Status: metav1.ConditionFalse, Reason: "WorkflowsQueued"}},
+			Capacity: &openchoreov1alpha1.WorkflowPlaneCapacity{
+				PendingWorkflows: 4,
+				RunningWorkflows: 2,
+				ReadyNodes:       3,
+			},
+		},
+	}
+	m := workflowPlaneSummary(wp)
+	assert.Equal(t, "WorkflowsQueued", m["health"])
+	assert.Equal(t, int32(4), m["pendingWorkflows"])
+	assert.Equal(t, int32(2), m["runningWorkflows"])
+	assert.Equal(t, int32(3), m["readyNodes"])
+
+	detail := workflowPlaneDetail(&wp)
+	capacity, ok := detail["capacity"].(map[string]any)
+	require.True(t, ok, "expected capacity to be a map")
+	assert.EqualValues(t, 4, capacity["pendingWorkflows"])
+}
+
 func TestWorkflowPlaneDetail(t *testing.T) {
 	wp := &openchoreov1alpha1.WorkflowPlane{
 		ObjectMeta: metav1.ObjectMeta{Name: "wp-1", Namespace: "org-ns"},
@@ -1177,6 +1209,7 @@ func TestWorkflowPlaneDetail_Minimal(t *testing.T) {
 	m := workflowPlaneDetail(wp)
 	assert.Equal(t, "wp-bare", m["name"])
 	assert.NotContains(t, m, "agentConnection")
+	assert.NotContains(t, m, "capacity")
 	assert.NotContains(t, m, "conditions")
 }
 
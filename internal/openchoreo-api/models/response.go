@@ -234,6 +234,7 @@ type WorkflowRunTriggerResponse struct {
 	ProjectName   string                  `json:"projectName"`
 	ComponentName string                  `json:"componentName"`
 	Commit        string                  `json:"commit,omitempty"`
+	Branch        string                  `json:"branch,omitempty"`
 	Status        string                  `json:"status,omitempty"`
 	Image         string                  `json:"image,omitempty"`
 	Workflow      *WorkflowConfigResponse `json:"workflow,omitempty"`
@@ -402,6 +403,25 @@ type ResourceNode struct {
 	Health          *HealthInfo    `json:"health,omitempty"`
 }
 
+// DeployedObject is an object that a release of a resource applied to a data plane
+type DeployedObject struct {
+	Environment string `json:"environment"`
+	// ClusterKind and Cluster identify the DataPlane or ClusterDataPlane the
+	// object was applied to
+	ClusterKind  string `json:"clusterKind"`
+	Cluster      string `json:"cluster"`
+	Group        string `json:"group,omitempty"`
+	Version      string `json:"version"`
+	Kind         string `json:"kind"`
+	Namespace    string `json:"namespace,omitempty"`
+	Name         string `json:"name"`
+	HealthStatus string `json:"healthStatus,omitempty"`
+	// Object is the live object read from the data plane, without the data of
+	// Secrets. Nil when the object could not be read, with Error saying why
+	Object map[string]any `json:"object,omitempty"`
+	Error  string         `json:"error,omitempty"`
+}
+
 // HealthInfo carries health status for a resource node
 type HealthInfo struct {
 	Status  string `json:"status"`
@@ -560,9 +580,37 @@ type GitSecretResponse struct {
 
 // WorkflowRunStatusResponse represents the status of a workflow run
 type WorkflowRunStatusResponse struct {
-	Status               string               `json:"status"`               // Overall workflow status (Pending/Running/Completed/Failed)
-	Steps                []WorkflowStepStatus `json:"steps"`                // Array of step-level statuses
-	HasLiveObservability bool                 `json:"hasLiveObservability"` // Whether the workflow run has live observability (logs/events from workflow plane)
+	Status               string                `json:"status"`               // Overall workflow status (Pending/Running/Completed/Failed)
+	Steps                []WorkflowStepStatus  `json:"steps"`                // Array of step-level statuses
+	HasLiveObservability bool                  `json:"hasLiveObservability"` // Whether the workflow run has live observability (logs/events from workflow plane)
+	Image                string                `json:"image,omitempty"`      // Image produced by the workflow run, if reported
+	Cache                *BuildCacheStatistics `json:"cache,omitempty"`      // Build cache lookups, if reported
+}
+
+// BuildCacheStatistics counts the build cache lookups of a workflow run
+type BuildCacheStatistics struct {
+	Hits     int32    `json:"hits"`
+	Misses   int32    `json:"misses"`
+	HitRatio *float64 `json:"hitRatio,omitempty"` // Share of lookups served from the cache; nil without lookups
+}
+
+// WorkflowRunVulnerabilitiesResponse lists the vulnerability findings of the image built by a workflow run
+type WorkflowRunVulnerabilitiesResponse struct {
+	Image           string                                  `json:"image,omitempty"`
+	Scanner         string                                  `json:"scanner"`
+	ScannedAt       *time.Time                              `json:"scannedAt,omitempty"`
+	Summary         openchoreov1alpha1.VulnerabilitySummary `json:"summary"`         // Counts of all findings by severity
+	Vulnerabilities []openchoreov1alpha1.Vulnerability      `json:"vulnerabilities"` // Findings, most severe first, optionally filtered by severity
+	Truncated       bool                                    `json:"truncated"`       // Whether the scan result omits findings
+}
+
+// WorkflowRunSBOMResponse locates the software bill of materials of the image built by a workflow run
+type WorkflowRunSBOMResponse struct {
+	RunName    string     `json:"runName"`
+	Image      string     `json:"image,omitempty"`
+	Format     string     `json:"format,omitempty"` // SPDX or CycloneDX, when reported
+	Location   string     `json:"location"`         // Where the SBOM is stored, e.g. an OCI reference
+	RecordedAt *time.Time `json:"recordedAt,omitempty"`
 }
 
 // WorkflowStepStatus represents the status of an individual workflow step
@@ -577,6 +625,7 @@ type WorkflowStepStatus struct {
 type WorkflowRunLogEntry struct {
 	Timestamp string `json:"timestamp,omitempty"`
 	Log       string `json:"log"`
+	Task      string `json:"task,omitempty"` // Workflow task that logged the line
 }
 
 // WorkflowRunEventEntry represents an event entry for workflow run events
@@ -586,3 +635,30 @@ type WorkflowRunEventEntry struct {
 	Reason    string `json:"reason"`
 	Message   string `json:"message"`
 }
+
+// Operations reported for the items of a batch.
+const (
+	BatchOperationCreated = "created"
+	BatchOperationUpdated = "updated"
+	BatchOperationDeleted = "deleted"
+	// BatchOperationUnchanged is reported for an applied item that already
+	// matched the stored object, so no update was made.
+	BatchOperationUnchanged = "unchanged"
+)
+
+// NamespacedName names an object in a namespace.
+type NamespacedName struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// BatchItemResult is the outcome of one item of a batch operation.
+type BatchItemResult struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Operation is what was done to the item. Empty when the item failed.
+	Operation string `json:"operation,omitempty"`
+	// Err is the error the item failed with, for the caller to map to its own
+	// error format.
+	Err error `json:"-"`
+}
@@ -0,0 +1,12 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package apitoken
+
+import "errors"
+
+var (
+	ErrAPITokensDisabled = errors.New("API tokens are disabled")
+	ErrTokenNotFound     = errors.New("API token not found")
+	ErrInvalidToken      = errors.New("invalid or expired API token")
+)
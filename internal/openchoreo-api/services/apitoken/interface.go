@@ -0,0 +1,79 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package apitoken issues and verifies scoped API tokens, which let
+// non-interactive clients such as CI pipelines act on behalf of the user who
+// issued them within a namespace or project.
+package apitoken
+
+import (
+	"context"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+// Access levels of a token.
+const (
+	// AccessRead allows only view actions.
+	AccessRead = "read"
+	// AccessWrite allows every action the issuing user is allowed.
+	AccessWrite = "write"
+)
+
+// Service defines the API token service interface.
+type Service interface {
+	// CreateToken issues a token owned by the calling subject. The secret is
+	// returned only once; the server keeps only its hash.
+	CreateToken(ctx context.Context, req *CreateTokenRequest) (*Token, string, error)
+	// ListTokens lists the tokens owned by the calling subject.
+	ListTokens(ctx context.Context) ([]Token, error)
+	// RevokeToken deletes a token owned by the calling subject.
+	RevokeToken(ctx context.Context, id string) error
+	// Authenticate verifies a token secret and returns the subject it acts as
+	// and the scope it is limited to.
+	Authenticate(ctx context.Context, secret string) (*auth.SubjectContext, *Scope, error)
+}
+
+// CreateTokenRequest describes a token to issue.
+type CreateTokenRequest struct {
+	// Name describes what the token is used for.
+	Name string
+	// Namespace is the namespace the token is limited to.
+	Namespace string
+	// Project, when set, limits the token to a project of the namespace.
+	Project string
+	// Access is AccessRead or AccessWrite.
+	Access string
+	// ExpiresAt is when the token expires. Zero uses the default lifetime.
+	ExpiresAt time.Time
+}
+
+// Token describes an issued token, without its secret.
+type Token struct {
+	ID        string
+	Name      string
+	Namespace string
+	Project   string
+	Access    string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// Scope limits what a request authenticated with a token may do.
+type Scope struct {
+	TokenID   string
+	Namespace string
+	Project   string
+	Access    string
+}
+
+// Options configures the API token service.
+type Options struct {
+	// Namespace is the namespace the tokens are stored in.
+	Namespace string
+	// DefaultTTL is the lifetime of tokens issued without an expiry.
+	DefaultTTL time.Duration
+	// MaxTTL is the longest lifetime a token may be issued with.
+	MaxTTL time.Duration
+}
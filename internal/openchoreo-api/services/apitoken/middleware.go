@@ -0,0 +1,52 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package apitoken
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+// Middleware returns an authentication middleware that accepts API tokens as
+// bearer tokens and hands every other request to fallback, which
+// authenticates OIDC tokens.
+func Middleware(svc Service, fallback func(http.Handler) http.Handler, logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fallbackHandler := fallback(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			secret, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || !strings.HasPrefix(secret, Prefix) {
+				fallbackHandler.ServeHTTP(w, r)
+				return
+			}
+
+			subject, scope, err := svc.Authenticate(r.Context(), secret)
+			if err != nil {
+				if !errors.Is(err, ErrInvalidToken) && !errors.Is(err, ErrAPITokensDisabled) {
+					logger.Error("Failed to authenticate API token", "error", err)
+					writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Server error occurred while authenticating the user")
+					return
+				}
+				writeError(w, http.StatusUnauthorized, "INVALID_TOKEN", ErrInvalidToken.Error())
+				return
+			}
+
+			ctx := auth.SetSubjectContext(r.Context(), subject)
+			ctx = WithScope(ctx, scope)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// writeError writes an error in the format of the JWT middleware.
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": code, "message": message})
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package apitoken
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+func TestMiddleware(t *testing.T) {
+	svc, _ := newTestService(t)
+	token, secret, err := svc.CreateToken(userContext("alice"), &CreateTokenRequest{
+		Name: "ci", Namespace: "acme", Access: AccessRead,
+	})
+	require.NoError(t, err)
+
+	// fallback stands in for the JWT middleware.
+	fallback := func(http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusTeapot)
+		})
+	}
+	var gotSubject *auth.SubjectContext
+	var gotScope *Scope
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSubject, _ = auth.GetSubjectContext(r)
+		gotScope, _ = ScopeFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := Middleware(svc, fallback, svc.logger)(next)
+
+	serve := func(authorization string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/namespaces", nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("valid token", func(t *testing.T) {
+		rec := serve("Bearer " + secret)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		require.NotNil(t, gotSubject)
+		assert.Equal(t, "alice", gotSubject.ID)
+		require.NotNil(t, gotScope)
+		assert.Equal(t, token.ID, gotScope.TokenID)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		rec := serve("Bearer " + Prefix + "0123456789abcdef_wrong")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.JSONEq(t, `{"error":"INVALID_TOKEN","message":"invalid or expired API token"}`, rec.Body.String())
+	})
+
+	t.Run("other bearer tokens go to the fallback", func(t *testing.T) {
+		assert.Equal(t, http.StatusTeapot, serve("Bearer eyJhbGciOiJSUzI1NiJ9.e30.sig").Code)
+	})
+
+	t.Run("missing token goes to the fallback", func(t *testing.T) {
+		assert.Equal(t, http.StatusTeapot, serve("").Code)
+	})
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package apitoken
+
+import (
+	"context"
+	"strings"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+)
+
+type scopeContextKey struct{}
+
+// WithScope returns a copy of ctx that carries the scope of the token the
+// request was authenticated with.
+func WithScope(ctx context.Context, scope *Scope) context.Context {
+	return context.WithValue(ctx, scopeContextKey{}, scope)
+}
+
+// ScopeFromContext returns the scope of the token the request was
+// authenticated with. It reports false for requests not authenticated with a
+// token.
+func ScopeFromContext(ctx context.Context) (*Scope, bool) {
+	scope, ok := ctx.Value(scopeContextKey{}).(*Scope)
+	return scope, ok && scope != nil
+}
+
+// deny returns why the scope denies action on a resource with hierarchy h, or
+// an empty string when the scope allows it. Resources outside the namespace or
+// project of the scope may only be viewed when they are shared by it, such as
+// cluster-scoped resources or the namespace of a project-scoped token.
+func (s *Scope) deny(action string, h authz.ResourceHierarchy) string {
+	view := strings.HasSuffix(action, ":view")
+	switch {
+	case s.Access == AccessRead && !view:
+		return "API token has read-only access"
+	case h.Namespace == "":
+		if !view {
+			return "API token cannot modify cluster-scoped resources"
+		}
+	case h.Namespace != s.Namespace:
+		return "resource is outside the namespace of the API token"
+	case s.Project == "":
+	case h.Project == "":
+		if !view {
+			return "API token cannot modify resources outside its project"
+		}
+	case h.Project != s.Project:
+		return "resource is outside the project of the API token"
+	}
+	return ""
+}
+
+// scopedPDP limits the decisions of a PDP to the scope of the API token a
+// request was authenticated with. Requests without a token are passed through.
+type scopedPDP struct {
+	authz.PDP
+}
+
+var _ authz.PDP = (*scopedPDP)(nil)
+
+// NewScopedPDP wraps pdp so that requests authenticated with an API token are
+// only allowed what both pdp and the token scope allow.
+func NewScopedPDP(pdp authz.PDP) authz.PDP {
+	return &scopedPDP{PDP: pdp}
+}
+
+func (p *scopedPDP) Evaluate(ctx context.Context, request *authz.EvaluateRequest) (*authz.Decision, error) {
+	if scope, ok := ScopeFromContext(ctx); ok {
+		if reason := scope.deny(request.Action, request.Resource.Hierarchy); reason != "" {
+			return &authz.Decision{Decision: false, Context: &authz.DecisionContext{Reason: reason}}, nil
+		}
+	}
+	return p.PDP.Evaluate(ctx, request)
+}
+
+func (p *scopedPDP) BatchEvaluate(ctx context.Context, request *authz.BatchEvaluateRequest) (*authz.BatchEvaluateResponse, error) {
+	resp, err := p.PDP.BatchEvaluate(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	scope, ok := ScopeFromContext(ctx)
+	if !ok {
+		return resp, nil
+	}
+	for i := range resp.Decisions {
+		if i >= len(request.Requests) {
+			break
+		}
+		r := &request.Requests[i]
+		if reason := scope.deny(r.Action, r.Resource.Hierarchy); reason != "" {
+			resp.Decisions[i] = authz.Decision{Decision: false, Context: &authz.DecisionContext{Reason: reason}}
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package apitoken
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+)
+
+// allowAllPDP allows every request.
+type allowAllPDP struct{}
+
+func (allowAllPDP) Evaluate(context.Context, *authz.EvaluateRequest) (*authz.Decision, error) {
+	return &authz.Decision{Decision: true, Context: &authz.DecisionContext{}}, nil
+}
+
+func (allowAllPDP) BatchEvaluate(_ context.Context, req *authz.BatchEvaluateRequest) (*authz.BatchEvaluateResponse, error) {
+	resp := &authz.BatchEvaluateResponse{}
+	for range req.Requests {
+		resp.Decisions = append(resp.Decisions, authz.Decision{Decision: true, Context: &authz.DecisionContext{}})
+	}
+	return resp, nil
+}
+
+func (allowAllPDP) GetSubjectProfile(context.Context, *authz.ProfileRequest) (*authz.UserCapabilitiesResponse, error) {
+	return nil, nil
+}
+
+func TestScopedPDP_Evaluate(t *testing.T) {
+	namespaceScope := &Scope{Namespace: "acme", Access: AccessWrite}
+	projectScope := &Scope{Namespace: "acme", Project: "shop", Access: AccessWrite}
+	readScope := &Scope{Namespace: "acme", Access: AccessRead}
+
+	tests := []struct {
+		name      string
+		scope     *Scope
+		action    string
+		hierarchy authz.ResourceHierarchy
+		want      bool
+	}{
+		{"no token", nil, "component:delete", authz.ResourceHierarchy{Namespace: "other"}, true},
+		{"write in namespace", namespaceScope, "component:create", authz.ResourceHierarchy{Namespace: "acme", Project: "shop"}, true},
+		{"other namespace", namespaceScope, "component:view", authz.ResourceHierarchy{Namespace: "other"}, false},
+		{"view cluster-scoped", namespaceScope, "clustercomponenttype:view", authz.ResourceHierarchy{}, true},
+		{"modify cluster-scoped", namespaceScope, "clustercomponenttype:update", authz.ResourceHierarchy{}, false},
+		{"write in project", projectScope, "component:update", authz.ResourceHierarchy{Namespace: "acme", Project: "shop", Component: "api"}, true},
+		{"other project", projectScope, "component:view", authz.ResourceHierarchy{Namespace: "acme", Project: "billing"}, false},
+		{"view namespace of project", projectScope, "environment:view", authz.ResourceHierarchy{Namespace: "acme"}, true},
+		{"modify namespace of project", projectScope, "environment:update", authz.ResourceHierarchy{Namespace: "acme"}, false},
+		{"read-only view", readScope, "component:view", authz.ResourceHierarchy{Namespace: "acme", Project: "shop"}, true},
+		{"read-only write", readScope, "component:create", authz.ResourceHierarchy{Namespace: "acme", Project: "shop"}, false},
+	}
+
+	pdp := NewScopedPDP(allowAllPDP{})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.scope != nil {
+				ctx = WithScope(ctx, tt.scope)
+			}
+			decision, err := pdp.Evaluate(ctx, &authz.EvaluateRequest{
+				Action:   tt.action,
+				Resource: authz.Resource{Type: "component", Hierarchy: tt.hierarchy},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, decision.Decision)
+			require.NotNil(t, decision.Context)
+		})
+	}
+}
+
+func TestScopedPDP_BatchEvaluate(t *testing.T) {
+	pdp := NewScopedPDP(allowAllPDP{})
+	ctx := WithScope(context.Background(), &Scope{Namespace: "acme", Project: "shop", Access: AccessRead})
+
+	resp, err := pdp.BatchEvaluate(ctx, &authz.BatchEvaluateRequest{Requests: []authz.EvaluateRequest{
+		{Action: "component:view", Resource: authz.Resource{Hierarchy: authz.ResourceHierarchy{Namespace: "acme", Project: "shop"}}},
+		{Action: "component:view", Resource: authz.Resource{Hierarchy: authz.ResourceHierarchy{Namespace: "acme", Project: "billing"}}},
+		{Action: "component:delete", Resource: authz.Resource{Hierarchy: authz.ResourceHierarchy{Namespace: "acme", Project: "shop"}}},
+	}})
+	require.NoError(t, err)
+	require.Len(t, resp.Decisions, 3)
+	assert.True(t, resp.Decisions[0].Decision)
+	assert.False(t, resp.Decisions[1].Decision)
+	assert.False(t, resp.Decisions[2].Decision)
+}
@@ -0,0 +1,337 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package apitoken
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+const (
+	// Prefix starts every token secret, telling it apart from OIDC tokens.
+	Prefix = "oc_"
+
+	// labelAPIToken marks the Secrets that hold tokens.
+	labelAPIToken = "openchoreo.dev/api-token"
+	// secretNamePrefix is prepended to the token ID to name its Secret.
+	secretNamePrefix = "oc-token-"
+	// recordKey is the Secret data key of the token record.
+	recordKey = "token"
+
+	idBytes     = 8
+	secretBytes = 32
+	maxNameLen  = 253
+)
+
+// record is what is stored of a token. The secret itself is not stored.
+type record struct {
+	// Hash is the hex-encoded SHA-256 of the token secret.
+	Hash      string    `json:"hash"`
+	Name      string    `json:"name"`
+	Owner     owner     `json:"owner"`
+	Namespace string    `json:"namespace"`
+	Project   string    `json:"project,omitempty"`
+	Access    string    `json:"access"`
+	CreatedAt time.Time `json:"createdAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// owner is the subject that issued a token. Requests authenticated with the
+// token act as this subject, with the entitlements it had when the token was
+// issued.
+type owner struct {
+	ID                string   `json:"id"`
+	Type              string   `json:"type"`
+	EntitlementClaim  string   `json:"entitlementClaim"`
+	EntitlementValues []string `json:"entitlementValues"`
+}
+
+// apiTokenService stores tokens as Secrets. It checks ownership itself: every
+// authenticated subject may manage its own tokens.
+type apiTokenService struct {
+	k8sClient client.Client
+	opts      *Options
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+var _ Service = (*apiTokenService)(nil)
+
+// NewService creates a new API token service. Nil options disable tokens.
+func NewService(k8sClient client.Client, opts *Options, logger *slog.Logger) Service {
+	return &apiTokenService{
+		k8sClient: k8sClient,
+		opts:      opts,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+func (s *apiTokenService) CreateToken(ctx context.Context, req *CreateTokenRequest) (*Token, string, error) {
+	if s.opts == nil {
+		return nil, "", ErrAPITokensDisabled
+	}
+	subject, err := caller(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	// A token must not be able to extend its own lifetime or scope.
+	if _, ok := ScopeFromContext(ctx); ok {
+		return nil, "", services.ErrForbidden
+	}
+
+	now := s.now().UTC().Truncate(time.Second)
+	expiresAt := req.ExpiresAt
+	if expiresAt.IsZero() {
+		expiresAt = now.Add(s.opts.DefaultTTL)
+	}
+	if err := s.validate(req, now, expiresAt); err != nil {
+		return nil, "", err
+	}
+
+	id, err := randomString(idBytes, hex.EncodeToString)
+	if err != nil {
+		return nil, "", err
+	}
+	key, err := randomString(secretBytes, base64.RawURLEncoding.EncodeToString)
+	if err != nil {
+		return nil, "", err
+	}
+	secret := Prefix + id + "_" + key
+
+	rec := record{
+		Hash: hash(secret),
+		Name: req.Name,
+		Owner: owner{
+			ID:                subject.ID,
+			Type:              subject.Type,
+			EntitlementClaim:  subject.EntitlementClaim,
+			EntitlementValues: subject.EntitlementValues,
+		},
+		Namespace: req.Namespace,
+		Project:   req.Project,
+		Access:    req.Access,
+		CreatedAt: now,
+		ExpiresAt: expiresAt.UTC(),
+	}
+	data, err := json.Marshal(&rec)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to encode API token: %w", err)
+	}
+	if err := s.k8sClient.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretNamePrefix + id,
+			Namespace: s.opts.Namespace,
+			Labels:    map[string]string{labelAPIToken: "true"},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{recordKey: data},
+	}); err != nil {
+		return nil, "", fmt.Errorf("failed to store API token: %w", err)
+	}
+
+	s.logger.Info("API token created", "id", id, "owner", subject.ID, "namespace", req.Namespace,
+		"project", req.Project, "access", req.Access, "expiresAt", rec.ExpiresAt)
+	return toToken(id, &rec), secret, nil
+}
+
+func (s *apiTokenService) validate(req *CreateTokenRequest, now, expiresAt time.Time) error {
+	switch {
+	case req.Name == "":
+		return &services.ValidationError{Msg: "name is required"}
+	case len(req.Name) > maxNameLen:
+		return &services.ValidationError{Msg: fmt.Sprintf("name must be at most %d characters", maxNameLen)}
+	case req.Namespace == "":
+		return &services.ValidationError{Msg: "namespace is required"}
+	case req.Access != AccessRead && req.Access != AccessWrite:
+		return &services.ValidationError{Msg: fmt.Sprintf("access must be %q or %q", AccessRead, AccessWrite)}
+	case !expiresAt.After(now):
+		return &services.ValidationError{Msg: "expiresAt must be in the future"}
+	case expiresAt.After(now.Add(s.opts.MaxTTL)):
+		return &services.ValidationError{Msg: fmt.Sprintf("expiresAt must be within %s", s.opts.MaxTTL)}
+	}
+	return nil
+}
+
+func (s *apiTokenService) ListTokens(ctx context.Context) ([]Token, error) {
+	if s.opts == nil {
+		return nil, ErrAPITokensDisabled
+	}
+	subject, err := caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var list corev1.SecretList
+	if err := s.k8sClient.List(ctx, &list, client.InNamespace(s.opts.Namespace),
+		client.MatchingLabels{labelAPIToken: "true"}); err != nil {
+		return nil, fmt.Errorf("failed to list API tokens: %w", err)
+	}
+
+	tokens := make([]Token, 0, len(list.Items))
+	for i := range list.Items {
+		id, rec, err := decode(&list.Items[i])
+		if err != nil {
+			s.logger.Warn("Skipping malformed API token", "secret", list.Items[i].Name, "error", err)
+			continue
+		}
+		if rec.Owner.ID == subject.ID && rec.Owner.Type == subject.Type {
+			tokens = append(tokens, *toToken(id, rec))
+		}
+	}
+	slices.SortFunc(tokens, func(a, b Token) int { return a.CreatedAt.Compare(b.CreatedAt) })
+	return tokens, nil
+}
+
+func (s *apiTokenService) RevokeToken(ctx context.Context, id string) error {
+	if s.opts == nil {
+		return ErrAPITokensDisabled
+	}
+	subject, err := caller(ctx)
+	if err != nil {
+		return err
+	}
+
+	secret, rec, err := s.get(ctx, id)
+	if err != nil {
+		return err
+	}
+	// Tokens of other subjects are reported as missing so that their IDs
+	// cannot be probed.
+	if rec.Owner.ID != subject.ID || rec.Owner.Type != subject.Type {
+		return ErrTokenNotFound
+	}
+	if err := s.k8sClient.Delete(ctx, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ErrTokenNotFound
+		}
+		return fmt.Errorf("failed to delete API token: %w", err)
+	}
+
+	s.logger.Info("API token revoked", "id", id, "owner", subject.ID)
+	return nil
+}
+
+func (s *apiTokenService) Authenticate(ctx context.Context, secret string) (*auth.SubjectContext, *Scope, error) {
+	if s.opts == nil {
+		return nil, nil, ErrAPITokensDisabled
+	}
+	id, _, ok := strings.Cut(strings.TrimPrefix(secret, Prefix), "_")
+	if !strings.HasPrefix(secret, Prefix) || !ok {
+		return nil, nil, ErrInvalidToken
+	}
+
+	_, rec, err := s.get(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrTokenNotFound) {
+			return nil, nil, ErrInvalidToken
+		}
+		return nil, nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(hash(secret)), []byte(rec.Hash)) != 1 || !s.now().Before(rec.ExpiresAt) {
+		return nil, nil, ErrInvalidToken
+	}
+
+	subject := &auth.SubjectContext{
+		ID:                rec.Owner.ID,
+		Type:              rec.Owner.Type,
+		EntitlementClaim:  rec.Owner.EntitlementClaim,
+		EntitlementValues: rec.Owner.EntitlementValues,
+	}
+	scope := &Scope{TokenID: id, Namespace: rec.Namespace, Project: rec.Project, Access: rec.Access}
+	return subject, scope, nil
+}
+
+// get reads the Secret and record of the token with the given ID.
+func (s *apiTokenService) get(ctx context.Context, id string) (*corev1.Secret, *record, error) {
+	if !validID(id) {
+		return nil, nil, ErrTokenNotFound
+	}
+	var secret corev1.Secret
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: s.opts.Namespace, Name: secretNamePrefix + id}, &secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil, ErrTokenNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to get API token: %w", err)
+	}
+	if secret.Labels[labelAPIToken] != "true" {
+		return nil, nil, ErrTokenNotFound
+	}
+	_, rec, err := decode(&secret)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &secret, rec, nil
+}
+
+// decode returns the ID and record of the token held by secret.
+func decode(secret *corev1.Secret) (string, *record, error) {
+	var rec record
+	if err := json.Unmarshal(secret.Data[recordKey], &rec); err != nil {
+		return "", nil, fmt.Errorf("failed to decode API token %s: %w", secret.Name, err)
+	}
+	return strings.TrimPrefix(secret.Name, secretNamePrefix), &rec, nil
+}
+
+// caller returns the authenticated subject of ctx.
+func caller(ctx context.Context) (*auth.SubjectContext, error) {
+	subject, ok := auth.GetSubjectContextFromContext(ctx)
+	if !ok || subject == nil || subject.ID == "" {
+		return nil, services.ErrForbidden
+	}
+	return subject, nil
+}
+
+// validID tells whether id has the form of a generated token ID.
+func validID(id string) bool {
+	if len(id) != 2*idBytes {
+		return false
+	}
+	_, err := hex.DecodeString(id)
+	return err == nil && strings.ToLower(id) == id
+}
+
+func toToken(id string, rec *record) *Token {
+	return &Token{
+		ID:        id,
+		Name:      rec.Name,
+		Namespace: rec.Namespace,
+		Project:   rec.Project,
+		Access:    rec.Access,
+		CreatedAt: rec.CreatedAt,
+		ExpiresAt: rec.ExpiresAt,
+	}
+}
+
+func hash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomString(n int, encode func([]byte) string) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate API token: %w", err)
+	}
+	return encode(b), nil
+}
@@ -0,0 +1,204 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package apitoken
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+const testTokenNamespace = "openchoreo-control-plane"
+
+var testNow = time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestService(t *testing.T) (*apiTokenService, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	svc := NewService(k8sClient, &Options{
+		Namespace:  testTokenNamespace,
+		DefaultTTL: 24 * time.Hour,
+		MaxTTL:     72 * time.Hour,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil))).(*apiTokenService)
+	svc.now = func() time.Time { return testNow }
+	return svc, k8sClient
+}
+
+func userContext(id string) context.Context {
+	return auth.SetSubjectContext(context.Background(), &auth.SubjectContext{
+		ID:                id,
+		Type:              "user",
+		EntitlementClaim:  "groups",
+		EntitlementValues: []string{"developers"},
+	})
+}
+
+func TestCreateAndAuthenticate(t *testing.T) {
+	svc, k8sClient := newTestService(t)
+	ctx := userContext("alice")
+
+	token, secret, err := svc.CreateToken(ctx, &CreateTokenRequest{
+		Name: "ci", Namespace: "acme", Project: "shop", Access: AccessWrite,
+	})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(secret, Prefix+token.ID+"_"))
+	assert.Equal(t, testNow.Add(24*time.Hour), token.ExpiresAt)
+
+	// Only the hash of the secret is stored.
+	var stored corev1.Secret
+	require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: testTokenNamespace, Name: secretNamePrefix + token.ID}, &stored))
+	assert.NotContains(t, string(stored.Data[recordKey]), secret)
+
+	subject, scope, err := svc.Authenticate(context.Background(), secret)
+	require.NoError(t, err)
+	assert.Equal(t, &auth.SubjectContext{
+		ID: "alice", Type: "user", EntitlementClaim: "groups", EntitlementValues: []string{"developers"},
+	}, subject)
+	assert.Equal(t, &Scope{TokenID: token.ID, Namespace: "acme", Project: "shop", Access: AccessWrite}, scope)
+
+	t.Run("wrong secret", func(t *testing.T) {
+		_, _, err := svc.Authenticate(context.Background(), secret+"x")
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		_, _, err := svc.Authenticate(context.Background(), Prefix+"0123456789abcdef_secret")
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("malformed token", func(t *testing.T) {
+		_, _, err := svc.Authenticate(context.Background(), Prefix+"../secret")
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		svc.now = func() time.Time { return token.ExpiresAt }
+		defer func() { svc.now = func() time.Time { return testNow } }()
+		_, _, err := svc.Authenticate(context.Background(), secret)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+}
+
+func TestCreateToken_Validation(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := userContext("alice")
+
+	tests := []struct {
+		name string
+		req  CreateTokenRequest
+		msg  string
+	}{
+		{
+			name: "missing name",
+			req:  CreateTokenRequest{Namespace: "acme", Access: AccessRead},
+			msg:  "name is required",
+		},
+		{
+			name: "missing namespace",
+			req:  CreateTokenRequest{Name: "ci", Access: AccessRead},
+			msg:  "namespace is required",
+		},
+		{
+			name: "invalid access",
+			req:  CreateTokenRequest{Name: "ci", Namespace: "acme", Access: "admin"},
+			msg:  `access must be "read" or "write"`,
+		},
+		{
+			name: "expiry in the past",
+			req:  CreateTokenRequest{Name: "ci", Namespace: "acme", Access: AccessRead, ExpiresAt: testNow.Add(-time.Hour)},
+			msg:  "expiresAt must be in the future",
+		},
+		{
+			name: "expiry beyond max ttl",
+			req:  CreateTokenRequest{Name: "ci", Namespace: "acme", Access: AccessRead, ExpiresAt: testNow.Add(73 * time.Hour)},
+			msg:  "expiresAt must be within 72h0m0s",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := svc.CreateToken(ctx, &tt.req)
+			validationErr, ok := errors.AsType[*services.ValidationError](err)
+			require.True(t, ok, "expected validation error, got %v", err)
+			assert.Equal(t, tt.msg, validationErr.Msg)
+		})
+	}
+}
+
+func TestCreateToken_Forbidden(t *testing.T) {
+	svc, _ := newTestService(t)
+	req := &CreateTokenRequest{Name: "ci", Namespace: "acme", Access: AccessRead}
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		_, _, err := svc.CreateToken(context.Background(), req)
+		assert.ErrorIs(t, err, services.ErrForbidden)
+	})
+
+	t.Run("authenticated with a token", func(t *testing.T) {
+		ctx := WithScope(userContext("alice"), &Scope{Namespace: "acme", Access: AccessWrite})
+		_, _, err := svc.CreateToken(ctx, req)
+		assert.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestListAndRevokeTokens(t *testing.T) {
+	svc, _ := newTestService(t)
+	alice := userContext("alice")
+	bob := userContext("bob")
+
+	first, _, err := svc.CreateToken(alice, &CreateTokenRequest{Name: "first", Namespace: "acme", Access: AccessRead})
+	require.NoError(t, err)
+	svc.now = func() time.Time { return testNow.Add(time.Minute) }
+	second, secret, err := svc.CreateToken(alice, &CreateTokenRequest{Name: "second", Namespace: "acme", Access: AccessRead})
+	require.NoError(t, err)
+	_, _, err = svc.CreateToken(bob, &CreateTokenRequest{Name: "bobs", Namespace: "acme", Access: AccessRead})
+	require.NoError(t, err)
+
+	tokens, err := svc.ListTokens(alice)
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	assert.Equal(t, first.ID, tokens[0].ID)
+	assert.Equal(t, second.ID, tokens[1].ID)
+
+	// Tokens of other users cannot be revoked.
+	assert.ErrorIs(t, svc.RevokeToken(bob, second.ID), ErrTokenNotFound)
+
+	require.NoError(t, svc.RevokeToken(alice, second.ID))
+	_, _, err = svc.Authenticate(context.Background(), secret)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+	assert.ErrorIs(t, svc.RevokeToken(alice, second.ID), ErrTokenNotFound)
+
+	tokens, err = svc.ListTokens(alice)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, first.ID, tokens[0].ID)
+}
+
+func TestDisabled(t *testing.T) {
+	svc := NewService(nil, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := userContext("alice")
+
+	_, _, err := svc.CreateToken(ctx, &CreateTokenRequest{})
+	assert.ErrorIs(t, err, ErrAPITokensDisabled)
+	_, err = svc.ListTokens(ctx)
+	assert.ErrorIs(t, err, ErrAPITokensDisabled)
+	assert.ErrorIs(t, svc.RevokeToken(ctx, "0123456789abcdef"), ErrAPITokensDisabled)
+	_, _, err = svc.Authenticate(ctx, Prefix+"0123456789abcdef_secret")
+	assert.ErrorIs(t, err, ErrAPITokensDisabled)
+}
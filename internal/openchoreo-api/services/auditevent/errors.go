@@ -0,0 +1,10 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auditevent
+
+import "errors"
+
+var (
+	ErrAuditQueryDisabled = errors.New("audit event query is disabled")
+)
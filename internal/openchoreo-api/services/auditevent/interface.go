@@ -0,0 +1,21 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auditevent
+
+import (
+	"context"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+)
+
+// Service defines the audit event query service interface.
+// Both the core service (no authz) and the authz-wrapped service implement this.
+type Service interface {
+	ListAuditEvents(ctx context.Context, filter audit.Filter) ([]audit.Event, error)
+}
+
+// EventStore holds recent audit events that can be queried.
+type EventStore interface {
+	Query(filter audit.Filter) []audit.Event
+}
@@ -0,0 +1,37 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auditevent
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+)
+
+// auditEventService queries recorded audit events without authorization checks.
+type auditEventService struct {
+	store  EventStore
+	logger *slog.Logger
+}
+
+var _ Service = (*auditEventService)(nil)
+
+// NewService creates a new audit event service without authorization.
+// A nil store disables querying.
+func NewService(store EventStore, logger *slog.Logger) Service {
+	return &auditEventService{
+		store:  store,
+		logger: logger,
+	}
+}
+
+func (s *auditEventService) ListAuditEvents(_ context.Context, filter audit.Filter) ([]audit.Event, error) {
+	if s.store == nil {
+		return nil, ErrAuditQueryDisabled
+	}
+	s.logger.Debug("Listing audit events", "actor", filter.ActorID, "resourceType", filter.ResourceType,
+		"resourceName", filter.ResourceName, "namespace", filter.Namespace, "limit", filter.Limit)
+	return s.store.Query(filter), nil
+}
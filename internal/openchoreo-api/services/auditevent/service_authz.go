@@ -0,0 +1,45 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auditevent
+
+import (
+	"context"
+	"log/slog"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+)
+
+const (
+	resourceTypeAuditEvent = "auditEvent"
+)
+
+// auditEventServiceWithAuthz wraps a Service and adds authorization checks.
+// Handlers should use this.
+type auditEventServiceWithAuthz struct {
+	internal Service
+	authz    *services.AuthzChecker
+}
+
+var _ Service = (*auditEventServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates an audit event service with authorization checks.
+func NewServiceWithAuthz(store EventStore, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &auditEventServiceWithAuthz{
+		internal: NewService(store, logger),
+		authz:    services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+func (s *auditEventServiceWithAuthz) ListAuditEvents(ctx context.Context, filter audit.Filter) ([]audit.Event, error) {
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewAuditEvents,
+		ResourceType: resourceTypeAuditEvent,
+		Hierarchy:    authz.ResourceHierarchy{},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.ListAuditEvents(ctx, filter)
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auditevent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/audit"
+)
+
+func newTestStore(t *testing.T) *audit.MemorySink {
+	t.Helper()
+	store := audit.NewMemorySink(10)
+	for _, e := range []audit.Event{
+		{EventID: "1", Actor: audit.Actor{ID: "alice"}, Resource: &audit.Resource{Type: "resource", Name: "db", Namespace: "default"}},
+		{EventID: "2", Actor: audit.Actor{ID: "bob"}, Resource: &audit.Resource{Type: "resource", Name: "cache", Namespace: "default"}},
+	} {
+		require.NoError(t, store.Write(&e))
+	}
+	return store
+}
+
+func TestListAuditEvents(t *testing.T) {
+	svc := NewService(newTestStore(t), testutil.TestLogger())
+
+	events, err := svc.ListAuditEvents(testutil.AuthzContext(), audit.Filter{ActorID: "bob"})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "2", events[0].EventID)
+}
+
+func TestListAuditEvents_Disabled(t *testing.T) {
+	svc := NewService(nil, testutil.TestLogger())
+
+	_, err := svc.ListAuditEvents(testutil.AuthzContext(), audit.Filter{})
+	require.ErrorIs(t, err, ErrAuditQueryDisabled)
+}
+
+func TestListAuditEvents_AuthzCheck(t *testing.T) {
+	newSvc := func(pdp *testutil.CapturingPDP) Service {
+		return &auditEventServiceWithAuthz{
+			internal: NewService(newTestStore(t), testutil.TestLogger()),
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+	}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		events, err := newSvc(pdp).ListAuditEvents(testutil.AuthzContext(), audit.Filter{})
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "auditevents:view", "auditEvent", "", authzcore.ResourceHierarchy{})
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		_, err := newSvc(testutil.DenyPDP()).ListAuditEvents(testutil.AuthzContext(), audit.Filter{})
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
@@ -15,7 +15,9 @@ type ProcessWebhookParams struct {
 	SignatureHeader string
 	Signature       string
 	SecretKey       string
-	Payload         []byte
+	// EventType is the value of the event type header of the provider, such as X-GitHub-Event.
+	EventType string
+	Payload   []byte
 }
 
 // WebhookResult holds the result of a processed webhook event.
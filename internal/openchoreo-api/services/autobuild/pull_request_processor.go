@@ -0,0 +1,273 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package autobuild
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/git"
+	sandboxsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/sandbox"
+)
+
+// maxPreviewNameLength keeps preview environment names usable as the DNS label their
+// endpoints are exposed under.
+const maxPreviewNameLength = 63
+
+// ProcessPullRequest processes a pull request webhook payload. Opening or updating a pull request
+// builds its head commit for the affected components and deploys the builds to a preview
+// environment per project; closing it deletes the preview environments.
+func (s *webhookProcessor) ProcessPullRequest(ctx context.Context, provider git.PullRequestProvider, payload []byte) ([]string, error) {
+	event, err := provider.ParsePullRequestPayload(payload)
+	if err != nil {
+		s.logger.Error("Failed to parse pull request payload", "error", err)
+		return nil, fmt.Errorf("failed to parse pull request payload: %w", err)
+	}
+
+	s.logger.Info("Processing pull request event",
+		"provider", event.Provider,
+		"repository", event.RepositoryURL,
+		"pullRequest", event.Number,
+		"action", event.Action,
+		"commit", event.Commit)
+
+	switch event.Action {
+	case git.PullRequestClosed:
+		return nil, s.deletePreviews(ctx, event)
+	case git.PullRequestOpened, git.PullRequestUpdated:
+	default:
+		s.logger.Info("Ignoring pull request event that does not change the code", "pullRequest", event.Number)
+		return nil, nil
+	}
+
+	// Builds check out the configured repository, which does not contain the commits of a fork.
+	if event.FromFork {
+		s.logger.Info("Skipping preview of pull request from a fork", "pullRequest", event.Number)
+		return nil, nil
+	}
+
+	// Pull request payloads carry no modified paths, so every component built from the
+	// base branch of the repository is previewed.
+	affectedComponents, err := s.findAffectedComponents(ctx, &git.WebhookEvent{
+		Provider:      event.Provider,
+		RepositoryURL: event.RepositoryURL,
+		Branch:        event.BaseBranch,
+	})
+	if err != nil {
+		s.logger.Error("Failed to find affected components", "error", err)
+		return nil, fmt.Errorf("failed to find affected components: %w", err)
+	}
+
+	triggeredComponents := make([]string, 0)
+	previews := make(map[string]string)
+	for _, comp := range affectedComponents {
+		namespaceName := comp.Namespace
+		projectName := comp.Spec.Owner.ProjectName
+		componentName := comp.Name
+
+		projectKey := namespaceName + "/" + projectName
+		previewName, ok := previews[projectKey]
+		if !ok {
+			previewName, err = s.ensurePreview(ctx, namespaceName, projectName, event)
+			if err != nil {
+				// Log error but continue processing other projects
+				s.logger.Error("Failed to prepare preview environment",
+					"error", err,
+					"namespace", namespaceName,
+					"project", projectName)
+			}
+			previews[projectKey] = previewName
+		}
+		if previewName == "" {
+			continue
+		}
+
+		s.logger.Info("Triggering preview build for component",
+			"namespace", namespaceName,
+			"project", projectName,
+			"component", componentName,
+			"environment", previewName,
+			"commit", event.Commit)
+
+		run, err := s.workflowTrigger.TriggerWorkflowAtRef(ctx, namespaceName, projectName, componentName, event.Commit, event.Branch)
+		if err != nil {
+			s.logger.Error("Failed to trigger preview build for component",
+				"error", err,
+				"component", componentName)
+			continue
+		}
+		if err := s.labelPreviewRun(ctx, namespaceName, run.Name, previewName, event.Commit); err != nil {
+			s.logger.Error("Failed to label preview build",
+				"error", err,
+				"component", componentName,
+				"workflowRun", run.Name)
+			continue
+		}
+
+		triggeredComponents = append(triggeredComponents, fmt.Sprintf("%s/%s", namespaceName, componentName))
+	}
+
+	s.logger.Info("Pull request processing completed",
+		"pullRequest", event.Number,
+		"affectedComponents", len(affectedComponents),
+		"triggeredBuilds", len(triggeredComponents))
+
+	return triggeredComponents, nil
+}
+
+// ensurePreview returns the name of the preview environment of the pull request in the project,
+// creating it from the root environment of the project's deployment pipeline when missing and
+// pointing it at the head commit otherwise. An empty name is returned when the project has
+// nothing deployed to preview.
+func (s *webhookProcessor) ensurePreview(ctx context.Context, namespaceName, projectName string, event *git.PullRequestEvent) (string, error) {
+	name := previewEnvironmentName(projectName, event.RepositoryURL, event.Number)
+	pullRequest := &v1alpha1.SandboxPullRequest{
+		Provider:      event.Provider,
+		RepositoryURL: event.RepositoryURL,
+		Number:        event.Number,
+		Branch:        event.Branch,
+		Commit:        event.Commit,
+	}
+
+	env := &v1alpha1.Environment{}
+	err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: name}, env)
+	switch {
+	case err == nil:
+		if env.Spec.Sandbox == nil || env.Spec.Sandbox.PullRequest == nil {
+			return "", fmt.Errorf("environment %s exists and is not a pull request preview", name)
+		}
+		if !s.matchesRepository(env.Spec.Sandbox.PullRequest.RepositoryURL, event.RepositoryURL) {
+			return "", fmt.Errorf("preview environment %s belongs to repository %s", name, env.Spec.Sandbox.PullRequest.RepositoryURL)
+		}
+		if *env.Spec.Sandbox.PullRequest == *pullRequest {
+			return name, nil
+		}
+		env.Spec.Sandbox.PullRequest = pullRequest
+		if err := s.k8sClient.Update(ctx, env); err != nil {
+			return "", fmt.Errorf("failed to update preview environment %s: %w", name, err)
+		}
+		return name, nil
+	case !apierrors.IsNotFound(err):
+		return "", fmt.Errorf("failed to get preview environment %s: %w", name, err)
+	}
+
+	sourceEnvironment, err := s.rootEnvironment(ctx, namespaceName, projectName)
+	if err != nil {
+		return "", err
+	}
+	_, err = s.sandboxes.CreateSandbox(ctx, namespaceName, projectName, &sandboxsvc.CreateSandboxRequest{
+		Name:              name,
+		SourceEnvironment: sourceEnvironment,
+		TTL:               sandboxsvc.MaxTTL,
+		PullRequest:       pullRequest,
+	})
+	if errors.Is(err, sandboxsvc.ErrNoComponentsToClone) {
+		s.logger.Info("Skipping preview: project has no components deployed",
+			"project", projectName,
+			"environment", sourceEnvironment)
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to create preview environment %s: %w", name, err)
+	}
+	s.logger.Info("Created preview environment", "namespace", namespaceName, "environment", name, "source", sourceEnvironment)
+	return name, nil
+}
+
+// rootEnvironment returns the environment components of the project are first deployed to:
+// the source of the project's deployment pipeline that is never a promotion target.
+func (s *webhookProcessor) rootEnvironment(ctx context.Context, namespaceName, projectName string) (string, error) {
+	project := &v1alpha1.Project{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: projectName}, project); err != nil {
+		return "", fmt.Errorf("failed to get project %s: %w", projectName, err)
+	}
+	pipelineName := project.Spec.DeploymentPipelineRef.Name
+	if pipelineName == "" {
+		return "", fmt.Errorf("project %s has no deployment pipeline", projectName)
+	}
+	pipeline := &v1alpha1.DeploymentPipeline{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: pipelineName}, pipeline); err != nil {
+		return "", fmt.Errorf("failed to get deployment pipeline %s: %w", pipelineName, err)
+	}
+
+	targets := make(map[string]bool)
+	for _, path := range pipeline.Spec.PromotionPaths {
+		for _, target := range path.TargetEnvironmentRefs {
+			targets[target.Name] = true
+		}
+	}
+	for _, path := range pipeline.Spec.PromotionPaths {
+		if path.SourceEnvironmentRef.Name != "" && !targets[path.SourceEnvironmentRef.Name] {
+			return path.SourceEnvironmentRef.Name, nil
+		}
+	}
+	return "", fmt.Errorf("deployment pipeline %s has no root environment", pipelineName)
+}
+
+// labelPreviewRun ties a workflow run to the preview environment and commit it builds, so that
+// the environment controller deploys the built image once the run succeeds.
+func (s *webhookProcessor) labelPreviewRun(ctx context.Context, namespaceName, runName, previewName, commit string) error {
+	run := &v1alpha1.WorkflowRun{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: runName}, run); err != nil {
+		return fmt.Errorf("failed to get workflow run %s: %w", runName, err)
+	}
+	patch := client.MergeFrom(run.DeepCopy())
+	if run.Labels == nil {
+		run.Labels = make(map[string]string)
+	}
+	run.Labels[ocLabels.LabelKeyPreviewEnvironment] = previewName
+	run.Labels[ocLabels.LabelKeyPreviewCommit] = commit
+	if err := s.k8sClient.Patch(ctx, run, patch); err != nil {
+		return fmt.Errorf("failed to patch workflow run %s: %w", runName, err)
+	}
+	return nil
+}
+
+// deletePreviews deletes the preview environments of a closed pull request. Deleting an
+// environment removes its release bindings and data plane namespaces.
+func (s *webhookProcessor) deletePreviews(ctx context.Context, event *git.PullRequestEvent) error {
+	envList := &v1alpha1.EnvironmentList{}
+	if err := s.k8sClient.List(ctx, envList, client.MatchingLabels{
+		ocLabels.LabelKeySandbox:     ocLabels.LabelValueTrue,
+		ocLabels.LabelKeyPullRequest: strconv.FormatInt(event.Number, 10),
+	}); err != nil {
+		return fmt.Errorf("failed to list preview environments: %w", err)
+	}
+
+	for i := range envList.Items {
+		env := &envList.Items[i]
+		if env.Spec.Sandbox == nil || env.Spec.Sandbox.PullRequest == nil ||
+			!s.matchesRepository(env.Spec.Sandbox.PullRequest.RepositoryURL, event.RepositoryURL) {
+			continue
+		}
+		if err := s.k8sClient.Delete(ctx, env); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete preview environment %s: %w", env.Name, err)
+		}
+		s.logger.Info("Deleted preview environment", "namespace", env.Namespace, "environment", env.Name, "pullRequest", event.Number)
+	}
+	return nil
+}
+
+// previewEnvironmentName returns the name of the preview environment of a pull request in a
+// project, <project>-pr-<number>-<hash>. The short hash of the repository keeps pull requests
+// with the same number from different repositories of the project apart. The project part is
+// shortened to keep the name a valid DNS label.
+func previewEnvironmentName(projectName, repositoryURL string, number int64) string {
+	sum := sha256.Sum256([]byte(normalizeWebhookRepoURL(repositoryURL)))
+	suffix := "-pr-" + strconv.FormatInt(number, 10) + "-" + hex.EncodeToString(sum[:])[:6]
+	if len(projectName)+len(suffix) > maxPreviewNameLength {
+		projectName = strings.TrimRight(projectName[:maxPreviewNameLength-len(suffix)], "-")
+	}
+	return projectName + suffix
+}
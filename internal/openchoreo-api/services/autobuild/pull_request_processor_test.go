@@ -0,0 +1,219 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package autobuild
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/git"
+	sandboxsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/sandbox"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+const testRepoURL = "https://github.com/example/repo"
+
+// testPreviewName is the preview environment of pull request 42 of testRepoURL in project shop.
+const testPreviewName = "shop-pr-42-ffc8e3"
+
+// fakeWorkflowTrigger creates the workflow runs it is asked to trigger in the fake client.
+type fakeWorkflowTrigger struct {
+	k8sClient client.Client
+	branches  []string
+}
+
+func (f *fakeWorkflowTrigger) TriggerWorkflow(ctx context.Context, namespaceName, projectName, componentName, commit string) (*models.WorkflowRunTriggerResponse, error) {
+	return f.TriggerWorkflowAtRef(ctx, namespaceName, projectName, componentName, commit, "")
+}
+
+func (f *fakeWorkflowTrigger) TriggerWorkflowAtRef(ctx context.Context, namespaceName, projectName, componentName, commit, branch string) (*models.WorkflowRunTriggerResponse, error) {
+	f.branches = append(f.branches, branch)
+	run := &v1alpha1.WorkflowRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-run-%d", componentName, len(f.branches)),
+			Namespace: namespaceName,
+			Labels:    map[string]string{ocLabels.LabelKeyComponentName: componentName},
+		},
+	}
+	if err := f.k8sClient.Create(ctx, run); err != nil {
+		return nil, err
+	}
+	return &models.WorkflowRunTriggerResponse{Name: run.Name, ComponentName: componentName, Commit: commit, Branch: branch}, nil
+}
+
+// previewObjects describes the shop project whose svc component, built from the main branch of
+// the example repository, is deployed to the dev environment.
+func previewObjects(t *testing.T) []client.Object {
+	t.Helper()
+	makeRaw := func(v interface{}) *runtime.RawExtension {
+		b, _ := json.Marshal(v)
+		return &runtime.RawExtension{Raw: b}
+	}
+	comp := makeAutoBuildComponent("svc", "ns1", "wf1", testRepoURL, "main", makeRaw)
+	comp.Spec.Owner.ProjectName = "shop"
+	binding := testutil.NewReleaseBinding("ns1", "shop", "svc", "dev", "svc-dev")
+	binding.Spec.ReleaseName = "svc-r1"
+	return []client.Object{
+		comp,
+		makeWorkflowWithBranch("wf1", "ns1"),
+		testutil.NewProject("ns1", "shop"),
+		testutil.NewDeploymentPipeline("ns1", "default"),
+		testutil.NewEnvironment("ns1", "dev"),
+		binding,
+	}
+}
+
+func newPreviewProcessor(t *testing.T, objs ...client.Object) (*webhookProcessor, *fakeWorkflowTrigger) {
+	t.Helper()
+	cli := testutil.NewFakeClient(objs...)
+	trigger := &fakeWorkflowTrigger{k8sClient: cli}
+	return &webhookProcessor{
+		k8sClient:       cli,
+		workflowTrigger: trigger,
+		sandboxes:       sandboxsvc.NewService(cli, discardLogger()),
+		logger:          discardLogger(),
+	}, trigger
+}
+
+func githubPullRequestPayload(action, sha string) []byte {
+	return []byte(fmt.Sprintf(`{"action":%q,"number":42,
+		"pull_request":{"head":{"ref":"feature/login","sha":%q,"repo":{"clone_url":"%s.git"}},"base":{"ref":"main"}},
+		"repository":{"clone_url":"%s.git"}}`, action, sha, testRepoURL, testRepoURL))
+}
+
+func TestProcessPullRequest(t *testing.T) {
+	ctx := context.Background()
+	provider := git.NewGitHubProvider()
+
+	t.Run("opened builds the head commit into a preview", func(t *testing.T) {
+		processor, trigger := newPreviewProcessor(t, previewObjects(t)...)
+
+		triggered, err := processor.ProcessPullRequest(ctx, provider, githubPullRequestPayload("opened", "abc1234"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ns1/svc"}, triggered)
+		assert.Equal(t, []string{"feature/login"}, trigger.branches)
+
+		env := &v1alpha1.Environment{}
+		require.NoError(t, processor.k8sClient.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: testPreviewName}, env))
+		require.NotNil(t, env.Spec.Sandbox)
+		assert.Equal(t, "dev", env.Spec.Sandbox.SourceEnvironment)
+		assert.Equal(t, sandboxsvc.MaxTTL, env.Spec.Sandbox.TTL.Duration)
+		assert.Equal(t, &v1alpha1.SandboxPullRequest{
+			Provider:      "github",
+			RepositoryURL: testRepoURL,
+			Number:        42,
+			Branch:        "feature/login",
+			Commit:        "abc1234",
+		}, env.Spec.Sandbox.PullRequest)
+		assert.Equal(t, "42", env.Labels[ocLabels.LabelKeyPullRequest])
+
+		run := &v1alpha1.WorkflowRun{}
+		require.NoError(t, processor.k8sClient.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: "svc-run-1"}, run))
+		assert.Equal(t, testPreviewName, run.Labels[ocLabels.LabelKeyPreviewEnvironment])
+		assert.Equal(t, "abc1234", run.Labels[ocLabels.LabelKeyPreviewCommit])
+	})
+
+	t.Run("updated points the preview at the new head commit", func(t *testing.T) {
+		processor, _ := newPreviewProcessor(t, previewObjects(t)...)
+
+		_, err := processor.ProcessPullRequest(ctx, provider, githubPullRequestPayload("opened", "abc1234"))
+		require.NoError(t, err)
+		triggered, err := processor.ProcessPullRequest(ctx, provider, githubPullRequestPayload("synchronize", "def5678"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ns1/svc"}, triggered)
+
+		env := &v1alpha1.Environment{}
+		require.NoError(t, processor.k8sClient.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: testPreviewName}, env))
+		assert.Equal(t, "def5678", env.Spec.Sandbox.PullRequest.Commit)
+	})
+
+	t.Run("closed deletes the preview", func(t *testing.T) {
+		processor, _ := newPreviewProcessor(t, previewObjects(t)...)
+
+		_, err := processor.ProcessPullRequest(ctx, provider, githubPullRequestPayload("opened", "abc1234"))
+		require.NoError(t, err)
+		_, err = processor.ProcessPullRequest(ctx, provider, githubPullRequestPayload("closed", "abc1234"))
+		require.NoError(t, err)
+
+		err = processor.k8sClient.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: testPreviewName}, &v1alpha1.Environment{})
+		assert.True(t, apierrors.IsNotFound(err))
+	})
+
+	t.Run("other actions are ignored", func(t *testing.T) {
+		processor, trigger := newPreviewProcessor(t, previewObjects(t)...)
+
+		triggered, err := processor.ProcessPullRequest(ctx, provider, githubPullRequestPayload("labeled", "abc1234"))
+		require.NoError(t, err)
+		assert.Empty(t, triggered)
+		assert.Empty(t, trigger.branches)
+	})
+
+	t.Run("pull requests from forks are skipped", func(t *testing.T) {
+		processor, trigger := newPreviewProcessor(t, previewObjects(t)...)
+
+		payload := strings.Replace(string(githubPullRequestPayload("opened", "abc1234")),
+			`"repo":{"clone_url":"`+testRepoURL, `"repo":{"clone_url":"https://github.com/someone/repo`, 1)
+		triggered, err := processor.ProcessPullRequest(ctx, provider, []byte(payload))
+		require.NoError(t, err)
+		assert.Empty(t, triggered)
+		assert.Empty(t, trigger.branches)
+	})
+
+	t.Run("previews of another repository are not taken over", func(t *testing.T) {
+		other := testutil.NewEnvironment("ns1", testPreviewName)
+		other.Spec.Sandbox = &v1alpha1.EnvironmentSandboxSpec{
+			SourceEnvironment: "dev",
+			PullRequest: &v1alpha1.SandboxPullRequest{
+				Provider:      "github",
+				RepositoryURL: "https://github.com/example/other",
+				Number:        42,
+				Commit:        "0000000",
+			},
+		}
+		processor, trigger := newPreviewProcessor(t, append(previewObjects(t), other)...)
+
+		triggered, err := processor.ProcessPullRequest(ctx, provider, githubPullRequestPayload("opened", "abc1234"))
+		require.NoError(t, err)
+		assert.Empty(t, triggered)
+		assert.Empty(t, trigger.branches)
+
+		env := &v1alpha1.Environment{}
+		require.NoError(t, processor.k8sClient.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: testPreviewName}, env))
+		assert.Equal(t, "https://github.com/example/other", env.Spec.Sandbox.PullRequest.RepositoryURL)
+	})
+
+	t.Run("projects without deployed components are not previewed", func(t *testing.T) {
+		objs := previewObjects(t)
+		processor, trigger := newPreviewProcessor(t, objs[:len(objs)-1]...)
+
+		triggered, err := processor.ProcessPullRequest(ctx, provider, githubPullRequestPayload("opened", "abc1234"))
+		require.NoError(t, err)
+		assert.Empty(t, triggered)
+		assert.Empty(t, trigger.branches)
+	})
+}
+
+func TestPreviewEnvironmentName(t *testing.T) {
+	assert.Equal(t, "shop-pr-7-ffc8e3", previewEnvironmentName("shop", testRepoURL, 7))
+	assert.Equal(t, "shop-pr-7-ffc8e3", previewEnvironmentName("shop", "git@github.com:Example/repo.git", 7),
+		"equivalent repository URLs share a preview")
+	assert.Equal(t, "shop-pr-7-79d6b7", previewEnvironmentName("shop", "https://github.com/example/other", 7))
+
+	name := previewEnvironmentName(strings.Repeat("a", 58)+"-b", testRepoURL, 1234)
+	assert.Len(t, name, maxPreviewNameLength)
+	assert.True(t, strings.HasSuffix(name, "-pr-1234-ffc8e3"))
+}
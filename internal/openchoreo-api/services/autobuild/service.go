@@ -25,6 +25,7 @@ const (
 // triggering builds. webhookProcessor (in this package) satisfies this interface.
 type WebhookProcessor interface {
 	ProcessWebhook(ctx context.Context, provider git.Provider, payload []byte) ([]string, error)
+	ProcessPullRequest(ctx context.Context, provider git.PullRequestProvider, payload []byte) ([]string, error)
 }
 
 type autobuildService struct {
@@ -46,6 +47,7 @@ func NewService(k8sClient client.Client, processor WebhookProcessor, logger *slo
 
 // ProcessWebhook retrieves the appropriate git provider, validates the payload signature
 // against the stored Kubernetes secret, and delegates processing to the WebhookProcessor.
+// Pull request events are processed as previews, every other event as a push.
 func (s *autobuildService) ProcessWebhook(ctx context.Context, params *ProcessWebhookParams) (*WebhookResult, error) {
 	provider, err := git.GetProvider(params.ProviderType)
 	if err != nil {
@@ -65,7 +67,12 @@ func (s *autobuildService) ProcessWebhook(ctx context.Context, params *ProcessWe
 		return nil, ErrInvalidSignature
 	}
 
-	affectedComponents, err := s.processor.ProcessWebhook(ctx, provider, params.Payload)
+	var affectedComponents []string
+	if prProvider, ok := provider.(git.PullRequestProvider); ok && prProvider.IsPullRequestEvent(params.EventType) {
+		affectedComponents, err = s.processor.ProcessPullRequest(ctx, prProvider, params.Payload)
+	} else {
+		affectedComponents, err = s.processor.ProcessWebhook(ctx, provider, params.Payload)
+	}
 	if err != nil {
 		s.logger.Error("Failed to process webhook", "error", err, "provider", params.ProviderType)
 		return nil, fmt.Errorf("failed to process webhook: %w", err)
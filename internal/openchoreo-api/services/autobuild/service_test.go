@@ -22,12 +22,19 @@ import (
 type mockProcessor struct {
 	components []string
 	err        error
+	// pullRequests counts the payloads processed as pull request events.
+	pullRequests int
 }
 
 func (m *mockProcessor) ProcessWebhook(_ context.Context, _ git.Provider, _ []byte) ([]string, error) {
 	return m.components, m.err
 }
 
+func (m *mockProcessor) ProcessPullRequest(_ context.Context, _ git.PullRequestProvider, _ []byte) ([]string, error) {
+	m.pullRequests++
+	return m.components, m.err
+}
+
 func newService(t *testing.T, processor WebhookProcessor, objs ...client.Object) Service {
 	t.Helper()
 	return NewService(testutil.NewFakeClient(objs...), processor, testutil.TestLogger())
@@ -74,6 +81,25 @@ func TestProcessWebhook(t *testing.T) {
 		assert.Equal(t, []string{"comp-a", "comp-b"}, result.AffectedComponents)
 	})
 
+	t.Run("pull request events are processed as previews", func(t *testing.T) {
+		secret := newWebhookSecret("gitlab-secret", "my-token")
+		processor := &mockProcessor{components: []string{"comp-a"}}
+		svc := newService(t, processor, secret)
+
+		result, err := svc.ProcessWebhook(ctx, &ProcessWebhookParams{
+			ProviderType:    git.ProviderGitLab,
+			SignatureHeader: "X-Gitlab-Token",
+			Signature:       "my-token",
+			SecretKey:       "gitlab-secret",
+			EventType:       "Merge Request Hook",
+			Payload:         []byte(`{"object_kind":"merge_request"}`),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"comp-a"}, result.AffectedComponents)
+		assert.Equal(t, 1, processor.pullRequests)
+	})
+
 	t.Run("invalid provider type", func(t *testing.T) {
 		svc := newService(t, &mockProcessor{})
 
@@ -18,6 +18,7 @@ import (
 	"github.com/openchoreo/openchoreo/internal/controller"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/git"
+	sandboxsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/sandbox"
 )
 
 // WorkflowRunTrigger is implemented by a workflow run service to trigger a workflow
@@ -25,6 +26,7 @@ import (
 // authenticated via HMAC signature validation instead).
 type WorkflowRunTrigger interface {
 	TriggerWorkflow(ctx context.Context, namespaceName, projectName, componentName, commit string) (*models.WorkflowRunTriggerResponse, error)
+	TriggerWorkflowAtRef(ctx context.Context, namespaceName, projectName, componentName, commit, branch string) (*models.WorkflowRunTriggerResponse, error)
 }
 
 // webhookProcessor handles webhook processing for all git providers.
@@ -35,14 +37,17 @@ var sshGitURLRegex = regexp.MustCompile(`^git@([^:]+):(.+)$`)
 type webhookProcessor struct {
 	k8sClient       client.Client
 	workflowTrigger WorkflowRunTrigger
+	sandboxes       sandboxsvc.Service
 	logger          *slog.Logger
 }
 
 // NewWebhookProcessor creates a new webhookProcessor that implements WebhookProcessor.
-func NewWebhookProcessor(k8sClient client.Client, workflowTrigger WorkflowRunTrigger, logger *slog.Logger) WebhookProcessor {
+// Pull request previews are created through the sandboxes service.
+func NewWebhookProcessor(k8sClient client.Client, workflowTrigger WorkflowRunTrigger, sandboxes sandboxsvc.Service, logger *slog.Logger) WebhookProcessor {
 	return &webhookProcessor{
 		k8sClient:       k8sClient,
 		workflowTrigger: workflowTrigger,
+		sandboxes:       sandboxes,
 		logger:          logger,
 	}
 }
@@ -0,0 +1,202 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	occworkload "github.com/openchoreo/openchoreo/internal/occ/resources/workload"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/git"
+)
+
+// defaultImportRef is the ref descriptors are read at when none is given.
+const defaultImportRef = "main"
+
+// descriptorCandidates are the descriptor locations, relative to the app path,
+// tried in order when no descriptor path is given.
+var descriptorCandidates = []string{".choreo/component.yaml", "workload.yaml"}
+
+// ImportComponentRequest describes a repository to import as a component.
+// Name, Type, ClassName and Image override the values of the descriptor.
+type ImportComponentRequest struct {
+	ProjectName   string
+	RepositoryURL string
+	// Ref is the branch, tag or commit to read the descriptor at. Components
+	// built from source build this ref. Defaults to main.
+	Ref     string
+	AppPath string
+	// DescriptorPath is the descriptor location relative to AppPath. Defaults
+	// to .choreo/component.yaml, falling back to workload.yaml.
+	DescriptorPath string
+
+	Name string
+	// Type is Service, WebApplication or ScheduledTask. Defaults to Service.
+	Type      openchoreov1alpha1.WorkloadType
+	ClassName string
+	ClassKind openchoreov1alpha1.ComponentTypeRefKind
+	// Image is the prebuilt container image to run. When neither the request
+	// nor the descriptor sets one, the component is built from the repository.
+	Image      string
+	AutoDeploy bool
+}
+
+// ImportComponentResult holds the resources generated from a repository's
+// descriptor. Workload is nil for components built from source, whose build
+// generates the workload.
+type ImportComponentResult struct {
+	// DescriptorPath is the repository path of the descriptor that was read.
+	DescriptorPath string
+	Component      *openchoreov1alpha1.Component
+	Workload       *openchoreov1alpha1.Workload
+}
+
+// componentDescriptor holds the component fields of a component descriptor.
+// The rest of the file is a workload descriptor: endpoints, dependencies and
+// configurations.
+type componentDescriptor struct {
+	Metadata struct {
+		Name        string `json:"name"`
+		DisplayName string `json:"displayName,omitempty"`
+		Description string `json:"description,omitempty"`
+	} `json:"metadata"`
+	// Type is Service, WebApplication or ScheduledTask.
+	Type string `json:"type,omitempty"`
+	// ComponentType is the name of the component class.
+	ComponentType string         `json:"componentType,omitempty"`
+	Parameters    map[string]any `json:"parameters,omitempty"`
+	Image         string         `json:"image,omitempty"`
+}
+
+// GenerateComponentFromRepository reads the component descriptor of a Git
+// repository at a ref and returns the component and workload it describes,
+// without creating them. The workload's endpoints, dependencies and
+// configurations come from the descriptor; files it references are read from
+// the repository as well.
+func (s *componentService) GenerateComponentFromRepository(
+	ctx context.Context, namespaceName string, req *ImportComponentRequest,
+) (*ImportComponentResult, error) {
+	if req == nil {
+		return nil, fmt.Errorf("import request cannot be nil")
+	}
+	if req.ProjectName == "" || req.RepositoryURL == "" {
+		return nil, &services.ValidationError{Msg: "projectName and repositoryUrl are required"}
+	}
+	ref := req.Ref
+	if ref == "" {
+		ref = defaultImportRef
+	}
+	if _, err := git.RawFileURL(req.RepositoryURL, ref, descriptorCandidates[0]); err != nil {
+		return nil, &services.ValidationError{Msg: err.Error()}
+	}
+
+	s.logger.Debug("Generating component from repository", "namespace", namespaceName,
+		"repository", req.RepositoryURL, "ref", ref)
+
+	descriptorPath, data, err := s.fetchDescriptor(ctx, req, ref)
+	if err != nil {
+		return nil, err
+	}
+	var descriptor componentDescriptor
+	if err := yaml.Unmarshal(data, &descriptor); err != nil {
+		return nil, &services.ValidationError{Msg: fmt.Sprintf("invalid component descriptor %s: %v", descriptorPath, err)}
+	}
+
+	scaffoldReq := &ScaffoldComponentRequest{
+		Name:        firstNonEmpty(req.Name, descriptor.Metadata.Name),
+		ProjectName: req.ProjectName,
+		DisplayName: descriptor.Metadata.DisplayName,
+		Description: descriptor.Metadata.Description,
+		Type: openchoreov1alpha1.WorkloadType(firstNonEmpty(
+			string(req.Type), descriptor.Type, string(openchoreov1alpha1.WorkloadTypeService))),
+		ClassName:  firstNonEmpty(req.ClassName, descriptor.ComponentType),
+		ClassKind:  req.ClassKind,
+		Parameters: descriptor.Parameters,
+		AutoDeploy: req.AutoDeploy,
+		Image:      firstNonEmpty(req.Image, descriptor.Image),
+	}
+	if scaffoldReq.Name == "" {
+		return nil, &services.ValidationError{
+			Msg: fmt.Sprintf("component name must be set in the request or in metadata.name of %s", descriptorPath),
+		}
+	}
+	if scaffoldReq.ClassName == "" {
+		return nil, &services.ValidationError{
+			Msg: fmt.Sprintf("component type must be set in the request or in componentType of %s", descriptorPath),
+		}
+	}
+	if scaffoldReq.Image == "" {
+		scaffoldReq.RepositoryURL = req.RepositoryURL
+		scaffoldReq.Branch = ref
+		scaffoldReq.AppPath = req.AppPath
+	}
+
+	scaffolded, err := s.scaffoldResources(ctx, namespaceName, scaffoldReq)
+	if err != nil {
+		return nil, err
+	}
+	result := &ImportComponentResult{DescriptorPath: descriptorPath, Component: scaffolded.Component}
+	if scaffolded.Workload == nil {
+		return result, nil
+	}
+
+	params := occworkload.CreateWorkloadParams{
+		NamespaceName: namespaceName,
+		ProjectName:   req.ProjectName,
+		ComponentName: scaffoldReq.Name,
+		ImageURL:      scaffoldReq.Image,
+	}
+	readFile := func(p string) ([]byte, error) {
+		return s.repoFiles.FetchFile(ctx, req.RepositoryURL, ref, p)
+	}
+	workload, err := occworkload.ConvertWorkloadDescriptor(data, descriptorPath, params, readFile)
+	if err != nil {
+		return nil, &services.ValidationError{Msg: err.Error()}
+	}
+	// Descriptors without endpoints get the default endpoint of the type.
+	if len(workload.Spec.Endpoints) == 0 {
+		workload.Spec.Endpoints = scaffolded.Workload.Spec.Endpoints
+	}
+	result.Workload = workload
+	return result, nil
+}
+
+// fetchDescriptor returns the repository path and content of the descriptor
+// requested by req, or of the first descriptor candidate that exists.
+func (s *componentService) fetchDescriptor(ctx context.Context, req *ImportComponentRequest, ref string) (string, []byte, error) {
+	candidates := descriptorCandidates
+	if req.DescriptorPath != "" {
+		candidates = []string{req.DescriptorPath}
+	}
+	appPath := strings.Trim(req.AppPath, "/")
+	for _, candidate := range candidates {
+		descriptorPath := path.Join(appPath, candidate)
+		data, err := s.repoFiles.FetchFile(ctx, req.RepositoryURL, ref, descriptorPath)
+		if errors.Is(err, git.ErrFileNotFound) {
+			continue
+		}
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to read component descriptor: %w", err)
+		}
+		return descriptorPath, data, nil
+	}
+	return "", nil, fmt.Errorf("%w in %s at %s: looked for %s", ErrDescriptorNotFound,
+		req.RepositoryURL, ref, strings.Join(candidates, ", "))
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/git"
+)
+
+const importRepo = "https://github.com/acme/shop"
+
+// fakeRepo serves files of a single repository, keyed by ref and path.
+type fakeRepo map[string]string
+
+func (r fakeRepo) FetchFile(_ context.Context, repoURL, ref, path string) ([]byte, error) {
+	if repoURL != importRepo {
+		return nil, fmt.Errorf("unexpected repository %s", repoURL)
+	}
+	content, ok := r[ref+":"+path]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", path, git.ErrFileNotFound)
+	}
+	return []byte(content), nil
+}
+
+func newImportService(t *testing.T, repo fakeRepo, objs ...client.Object) Service {
+	t.Helper()
+	svc := newService(t, objs...)
+	svc.(*componentService).repoFiles = repo
+	return svc
+}
+
+const ordersDescriptor = `metadata:
+  name: orders
+  displayName: Orders
+type: Service
+componentType: service
+parameters:
+  exposed: true
+image: ghcr.io/acme/orders:v1
+endpoints:
+  - name: api
+    port: 9090
+    type: REST
+    schemaFile: openapi.yaml
+configurations:
+  env:
+    - name: LOG_LEVEL
+      value: info
+`
+
+func TestGenerateComponentFromRepository(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("prebuilt image from component descriptor", func(t *testing.T) {
+		repo := fakeRepo{
+			"v1:orders/.choreo/component.yaml": ordersDescriptor,
+			"v1:orders/.choreo/openapi.yaml":   "openapi: 3.0.0",
+		}
+		svc := newImportService(t, repo, testProject(), scaffoldClass(t, "service", "deployment"))
+
+		result, err := svc.GenerateComponentFromRepository(ctx, testNamespace, &ImportComponentRequest{
+			ProjectName:   testProjectName,
+			RepositoryURL: importRepo,
+			Ref:           "v1",
+			AppPath:       "/orders",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "orders/.choreo/component.yaml", result.DescriptorPath)
+
+		comp := result.Component
+		assert.Equal(t, "orders", comp.Name)
+		assert.Equal(t, "Orders", comp.Annotations["openchoreo.dev/display-name"])
+		assert.Equal(t, "deployment/service", comp.Spec.ComponentType.Name)
+		assert.Equal(t, map[string]any{"replicas": float64(2), "exposed": true},
+			unmarshalRaw(t, comp.Spec.Parameters.Raw))
+		assert.Nil(t, comp.Spec.Workflow)
+
+		w := result.Workload
+		require.NotNil(t, w)
+		assert.Equal(t, "orders-workload", w.Name)
+		assert.Equal(t, "ghcr.io/acme/orders:v1", w.Spec.Container.Image)
+		require.Contains(t, w.Spec.Endpoints, "api")
+		assert.Equal(t, int32(9090), w.Spec.Endpoints["api"].Port)
+		assert.Equal(t, "openapi: 3.0.0", w.Spec.Endpoints["api"].Schema.Content)
+		assert.Equal(t, []openchoreov1alpha1.EnvVar{{Key: "LOG_LEVEL", Value: "info"}}, w.Spec.Container.Env)
+
+		// Nothing is created.
+		_, err = svc.GetComponent(ctx, testNamespace, "orders")
+		require.ErrorIs(t, err, ErrComponentNotFound)
+	})
+
+	t.Run("falls back to workload descriptor and builds from source", func(t *testing.T) {
+		repo := fakeRepo{"main:workload.yaml": "metadata:\n  name: api\n"}
+		svc := newImportService(t, repo, testProject(), scaffoldClass(t, "service", "deployment"), scaffoldWorkflow(t))
+
+		result, err := svc.GenerateComponentFromRepository(ctx, testNamespace, &ImportComponentRequest{
+			ProjectName:   testProjectName,
+			RepositoryURL: importRepo,
+			ClassName:     "service",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "workload.yaml", result.DescriptorPath)
+		assert.Nil(t, result.Workload)
+		require.NotNil(t, result.Component.Spec.Workflow)
+		assert.Equal(t, map[string]any{
+			"repository": map[string]any{"url": importRepo, "branch": "main"},
+		}, unmarshalRaw(t, result.Component.Spec.Workflow.Parameters.Raw))
+	})
+
+	t.Run("request overrides descriptor and keeps default endpoint", func(t *testing.T) {
+		repo := fakeRepo{"main:deploy/component.yaml": "metadata:\n  name: api\ncomponentType: service\n"}
+		svc := newImportService(t, repo, testProject(), scaffoldClass(t, "service", "deployment"))
+
+		result, err := svc.GenerateComponentFromRepository(ctx, testNamespace, &ImportComponentRequest{
+			ProjectName:    testProjectName,
+			RepositoryURL:  importRepo,
+			DescriptorPath: "deploy/component.yaml",
+			Name:           "gateway",
+			Image:          "nginx:1.27",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "gateway", result.Component.Name)
+		require.NotNil(t, result.Workload)
+		assert.Equal(t, "gateway-workload", result.Workload.Name)
+		assert.Contains(t, result.Workload.Spec.Endpoints, "http")
+	})
+
+	errCases := []struct {
+		name    string
+		repo    fakeRepo
+		req     *ImportComponentRequest
+		wantErr error
+		wantMsg string
+	}{
+		{
+			name:    "missing repository",
+			req:     &ImportComponentRequest{ProjectName: testProjectName},
+			wantMsg: "projectName and repositoryUrl are required",
+		},
+		{
+			name:    "unsupported host",
+			req:     &ImportComponentRequest{ProjectName: testProjectName, RepositoryURL: "https://git.example.com/acme/shop"},
+			wantMsg: "unsupported git host",
+		},
+		{
+			name:    "no descriptor",
+			repo:    fakeRepo{},
+			req:     &ImportComponentRequest{ProjectName: testProjectName, RepositoryURL: importRepo},
+			wantErr: ErrDescriptorNotFound,
+		},
+		{
+			name:    "no component type",
+			repo:    fakeRepo{"main:workload.yaml": "metadata:\n  name: api\n"},
+			req:     &ImportComponentRequest{ProjectName: testProjectName, RepositoryURL: importRepo},
+			wantMsg: "component type must be set",
+		},
+		{
+			name:    "missing schema file",
+			repo:    fakeRepo{"main:.choreo/component.yaml": ordersDescriptor},
+			req:     &ImportComponentRequest{ProjectName: testProjectName, RepositoryURL: importRepo},
+			wantMsg: "openapi.yaml",
+		},
+	}
+	for _, tc := range errCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := newImportService(t, tc.repo, testProject(), scaffoldClass(t, "service", "deployment"))
+			_, err := svc.GenerateComponentFromRepository(ctx, testNamespace, tc.req)
+			require.Error(t, err)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			var validationErr *services.ValidationError
+			require.True(t, errors.As(err, &validationErr), "expected validation error, got %v", err)
+			assert.Contains(t, err.Error(), tc.wantMsg)
+		})
+	}
+}
+
+func TestCreateComponentWithWorkload(t *testing.T) {
+	ctx := context.Background()
+	repo := fakeRepo{"main:.choreo/component.yaml": "metadata:\n  name: api\ncomponentType: service\nimage: nginx:1.27\n"}
+	svc := newImportService(t, repo, testProject(), scaffoldClass(t, "service", "deployment"))
+
+	generated, err := svc.GenerateComponentFromRepository(ctx, testNamespace, &ImportComponentRequest{
+		ProjectName:   testProjectName,
+		RepositoryURL: importRepo,
+	})
+	require.NoError(t, err)
+
+	result, err := svc.CreateComponentWithWorkload(ctx, testNamespace, generated.Component, generated.Workload)
+	require.NoError(t, err)
+	assert.Equal(t, "api", result.Component.Name)
+	require.NotNil(t, result.Workload)
+	assert.Equal(t, "api-workload", result.Workload.Name)
+
+	_, err = svc.GetComponent(ctx, testNamespace, "api")
+	require.NoError(t, err)
+}
@@ -23,6 +23,9 @@ type GenerateReleaseRequest struct {
 // the K8s-native API design (discussion #1716).
 type Service interface {
 	CreateComponent(ctx context.Context, namespaceName string, component *openchoreov1alpha1.Component) (*openchoreov1alpha1.Component, error)
+	ScaffoldComponent(ctx context.Context, namespaceName string, req *ScaffoldComponentRequest) (*ScaffoldComponentResult, error)
+	GenerateComponentFromRepository(ctx context.Context, namespaceName string, req *ImportComponentRequest) (*ImportComponentResult, error)
+	CreateComponentWithWorkload(ctx context.Context, namespaceName string, component *openchoreov1alpha1.Component, workload *openchoreov1alpha1.Workload) (*ScaffoldComponentResult, error)
 	UpdateComponent(ctx context.Context, namespaceName string, component *openchoreov1alpha1.Component) (*openchoreov1alpha1.Component, error)
 	ListComponents(ctx context.Context, namespaceName, projectName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.Component], error)
 	GetComponent(ctx context.Context, namespaceName, componentName string) (*openchoreov1alpha1.Component, error)
@@ -89,6 +89,67 @@ func (_c *MockService_CreateComponent_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// CreateComponentWithWorkload provides a mock function with given fields: ctx, namespaceName, _a2, workload
+func (_m *MockService) CreateComponentWithWorkload(ctx context.Context, namespaceName string, _a2 *v1alpha1.Component, workload *v1alpha1.Workload) (*component.ScaffoldComponentResult, error) {
+	ret := _m.Called(ctx, namespaceName, _a2, workload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateComponentWithWorkload")
+	}
+
+	var r0 *component.ScaffoldComponentResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *v1alpha1.Component, *v1alpha1.Workload) (*component.ScaffoldComponentResult, error)); ok {
+		return rf(ctx, namespaceName, _a2, workload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *v1alpha1.Component, *v1alpha1.Workload) *component.ScaffoldComponentResult); ok {
+		r0 = rf(ctx, namespaceName, _a2, workload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*component.ScaffoldComponentResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *v1alpha1.Component, *v1alpha1.Workload) error); ok {
+		r1 = rf(ctx, namespaceName, _a2, workload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_CreateComponentWithWorkload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateComponentWithWorkload'
+type MockService_CreateComponentWithWorkload_Call struct {
+	*mock.Call
+}
+
+// CreateComponentWithWorkload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - _a2 *v1alpha1.Component
+//   - workload *v1alpha1.Workload
+func (_e *MockService_Expecter) CreateComponentWithWorkload(ctx interface{}, namespaceName interface{}, _a2 interface{}, workload interface{}) *MockService_CreateComponentWithWorkload_Call {
+	return &MockService_CreateComponentWithWorkload_Call{Call: _e.mock.On("CreateComponentWithWorkload", ctx, namespaceName, _a2, workload)}
+}
+
+func (_c *MockService_CreateComponentWithWorkload_Call) Run(run func(ctx context.Context, namespaceName string, _a2 *v1alpha1.Component, workload *v1alpha1.Workload)) *MockService_CreateComponentWithWorkload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*v1alpha1.Component), args[3].(*v1alpha1.Workload))
+	})
+	return _c
+}
+
+func (_c *MockService_CreateComponentWithWorkload_Call) Return(_a0 *component.ScaffoldComponentResult, _a1 error) *MockService_CreateComponentWithWorkload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_CreateComponentWithWorkload_Call) RunAndReturn(run func(context.Context, string, *v1alpha1.Component, *v1alpha1.Workload) (*component.ScaffoldComponentResult, error)) *MockService_CreateComponentWithWorkload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteComponent provides a mock function with given fields: ctx, namespaceName, componentName
 func (_m *MockService) DeleteComponent(ctx context.Context, namespaceName string, componentName string) error {
 	ret := _m.Called(ctx, namespaceName, componentName)
@@ -137,6 +198,66 @@ func (_c *MockService_DeleteComponent_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// GenerateComponentFromRepository provides a mock function with given fields: ctx, namespaceName, req
+func (_m *MockService) GenerateComponentFromRepository(ctx context.Context, namespaceName string, req *component.ImportComponentRequest) (*component.ImportComponentResult, error) {
+	ret := _m.Called(ctx, namespaceName, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateComponentFromRepository")
+	}
+
+	var r0 *component.ImportComponentResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *component.ImportComponentRequest) (*component.ImportComponentResult, error)); ok {
+		return rf(ctx, namespaceName, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *component.ImportComponentRequest) *component.ImportComponentResult); ok {
+		r0 = rf(ctx, namespaceName, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*component.ImportComponentResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *component.ImportComponentRequest) error); ok {
+		r1 = rf(ctx, namespaceName, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GenerateComponentFromRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateComponentFromRepository'
+type MockService_GenerateComponentFromRepository_Call struct {
+	*mock.Call
+}
+
+// GenerateComponentFromRepository is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - req *component.ImportComponentRequest
+func (_e *MockService_Expecter) GenerateComponentFromRepository(ctx interface{}, namespaceName interface{}, req interface{}) *MockService_GenerateComponentFromRepository_Call {
+	return &MockService_GenerateComponentFromRepository_Call{Call: _e.mock.On("GenerateComponentFromRepository", ctx, namespaceName, req)}
+}
+
+func (_c *MockService_GenerateComponentFromRepository_Call) Run(run func(ctx context.Context, namespaceName string, req *component.ImportComponentRequest)) *MockService_GenerateComponentFromRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*component.ImportComponentRequest))
+	})
+	return _c
+}
+
+func (_c *MockService_GenerateComponentFromRepository_Call) Return(_a0 *component.ImportComponentResult, _a1 error) *MockService_GenerateComponentFromRepository_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GenerateComponentFromRepository_Call) RunAndReturn(run func(context.Context, string, *component.ImportComponentRequest) (*component.ImportComponentResult, error)) *MockService_GenerateComponentFromRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GenerateRelease provides a mock function with given fields: ctx, namespaceName, componentName, req
 func (_m *MockService) GenerateRelease(ctx context.Context, namespaceName string, componentName string, req *component.GenerateReleaseRequest) (*v1alpha1.ComponentRelease, error) {
 	ret := _m.Called(ctx, namespaceName, componentName, req)
@@ -440,6 +561,66 @@ func (_c *MockService_ListComponents_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// ScaffoldComponent provides a mock function with given fields: ctx, namespaceName, req
+func (_m *MockService) ScaffoldComponent(ctx context.Context, namespaceName string, req *component.ScaffoldComponentRequest) (*component.ScaffoldComponentResult, error) {
+	ret := _m.Called(ctx, namespaceName, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScaffoldComponent")
+	}
+
+	var r0 *component.ScaffoldComponentResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *component.ScaffoldComponentRequest) (*component.ScaffoldComponentResult, error)); ok {
+		return rf(ctx, namespaceName, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *component.ScaffoldComponentRequest) *component.ScaffoldComponentResult); ok {
+		r0 = rf(ctx, namespaceName, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*component.ScaffoldComponentResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *component.ScaffoldComponentRequest) error); ok {
+		r1 = rf(ctx, namespaceName, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ScaffoldComponent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScaffoldComponent'
+type MockService_ScaffoldComponent_Call struct {
+	*mock.Call
+}
+
+// ScaffoldComponent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - req *component.ScaffoldComponentRequest
+func (_e *MockService_Expecter) ScaffoldComponent(ctx interface{}, namespaceName interface{}, req interface{}) *MockService_ScaffoldComponent_Call {
+	return &MockService_ScaffoldComponent_Call{Call: _e.mock.On("ScaffoldComponent", ctx, namespaceName, req)}
+}
+
+func (_c *MockService_ScaffoldComponent_Call) Run(run func(ctx context.Context, namespaceName string, req *component.ScaffoldComponentRequest)) *MockService_ScaffoldComponent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*component.ScaffoldComponentRequest))
+	})
+	return _c
+}
+
+func (_c *MockService_ScaffoldComponent_Call) Return(_a0 *component.ScaffoldComponentResult, _a1 error) *MockService_ScaffoldComponent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ScaffoldComponent_Call) RunAndReturn(run func(context.Context, string, *component.ScaffoldComponentRequest) (*component.ScaffoldComponentResult, error)) *MockService_ScaffoldComponent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateComponent provides a mock function with given fields: ctx, namespaceName, _a2
 func (_m *MockService) UpdateComponent(ctx context.Context, namespaceName string, _a2 *v1alpha1.Component) (*v1alpha1.Component, error) {
 	ret := _m.Called(ctx, namespaceName, _a2)
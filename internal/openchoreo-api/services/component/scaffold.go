@@ -0,0 +1,354 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	openchoreoschema "github.com/openchoreo/openchoreo/internal/schema"
+)
+
+// defaultScaffoldPort is the endpoint port of scaffolded services and web
+// applications that do not set one.
+const defaultScaffoldPort int32 = 8080
+
+// scaffoldWorkloadTypes maps each component type that can be scaffolded to the
+// workloadType its class must have.
+var scaffoldWorkloadTypes = map[openchoreov1alpha1.WorkloadType]string{
+	openchoreov1alpha1.WorkloadTypeService:        "deployment",
+	openchoreov1alpha1.WorkloadTypeWebApplication: "deployment",
+	openchoreov1alpha1.WorkloadTypeScheduledTask:  "cronjob",
+}
+
+// ScaffoldComponentRequest describes a component to create from a component
+// class, a ComponentType or ClusterComponentType.
+type ScaffoldComponentRequest struct {
+	Name        string
+	ProjectName string
+	DisplayName string
+	Description string
+
+	// Type is Service, WebApplication or ScheduledTask.
+	Type openchoreov1alpha1.WorkloadType
+	// ClassName is the name of the component class.
+	ClassName string
+	// ClassKind is the kind of the component class. When empty, a ComponentType
+	// in the namespace takes precedence over a ClusterComponentType.
+	ClassKind openchoreov1alpha1.ComponentTypeRefKind
+	// Parameters override the defaults of the class parameter schema.
+	Parameters map[string]any
+	AutoDeploy bool
+
+	// RepositoryURL is the Git repository to build the component from. Either
+	// RepositoryURL or Image must be set.
+	RepositoryURL string
+	Branch        string
+	AppPath       string
+	// Workflow is the build workflow. Defaults to the first workflow the class
+	// allows.
+	Workflow *openchoreov1alpha1.WorkflowRef
+	// WorkflowParameters are further parameters of the build workflow.
+	WorkflowParameters map[string]any
+
+	// Image is the prebuilt container image to run.
+	Image string
+	// Port is the endpoint port of services and web applications. Defaults to
+	// 8080.
+	Port int32
+}
+
+// ScaffoldComponentResult holds the resources created for a scaffolded
+// component. Workload is nil for components built from source, whose build
+// generates the workload.
+type ScaffoldComponentResult struct {
+	Component *openchoreov1alpha1.Component
+	Workload  *openchoreov1alpha1.Workload
+}
+
+// ScaffoldComponent creates a component of the given type from the defaults of
+// its class. The component is built from RepositoryURL with the class's build
+// workflow, or runs Image, in which case its workload is created as well, with
+// an HTTP endpoint for services and web applications. The resources specific to
+// the type are rendered from the class templates on deployment.
+func (s *componentService) ScaffoldComponent(ctx context.Context, namespaceName string, req *ScaffoldComponentRequest) (*ScaffoldComponentResult, error) {
+	if req == nil {
+		return nil, fmt.Errorf("scaffold request cannot be nil")
+	}
+
+	s.logger.Debug("Scaffolding component", "namespace", namespaceName, "component", req.Name,
+		"type", req.Type, "class", req.ClassName)
+
+	scaffolded, err := s.scaffoldResources(ctx, namespaceName, req)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.CreateComponentWithWorkload(ctx, namespaceName, scaffolded.Component, scaffolded.Workload)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Debug("Component scaffolded successfully", "namespace", namespaceName, "component", req.Name)
+	return result, nil
+}
+
+// scaffoldResources returns the component, and for components that run
+// req.Image the workload, described by req without creating them.
+func (s *componentService) scaffoldResources(ctx context.Context, namespaceName string, req *ScaffoldComponentRequest) (*ScaffoldComponentResult, error) {
+	workloadType, ok := scaffoldWorkloadTypes[req.Type]
+	if !ok {
+		return nil, &services.ValidationError{
+			Msg: fmt.Sprintf("type must be one of Service, WebApplication or ScheduledTask, got %q", req.Type),
+		}
+	}
+	if (req.RepositoryURL == "") == (req.Image == "") {
+		return nil, &services.ValidationError{Msg: "exactly one of repositoryUrl and image must be set"}
+	}
+
+	classKind, ctSpec, err := s.fetchComponentClass(ctx, namespaceName, req.ClassKind, req.ClassName)
+	if err != nil {
+		return nil, err
+	}
+	ctRef := openchoreov1alpha1.ComponentTypeRef{
+		Kind: classKind,
+		Name: workloadType + "/" + req.ClassName,
+	}
+	if ctSpec.WorkloadType != workloadType {
+		return nil, &services.ValidationError{
+			Msg: fmt.Sprintf("%s %q has workloadType %q, but %s components need %q",
+				classKind, req.ClassName, ctSpec.WorkloadType, req.Type, workloadType),
+		}
+	}
+
+	params, err := classParameters(ctSpec, req.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	component := &openchoreov1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        req.Name,
+			Annotations: map[string]string{},
+		},
+		Spec: openchoreov1alpha1.ComponentSpec{
+			Owner:         openchoreov1alpha1.ComponentOwner{ProjectName: req.ProjectName},
+			ComponentType: ctRef,
+			AutoDeploy:    req.AutoDeploy,
+			Parameters:    params,
+		},
+	}
+	if req.DisplayName != "" {
+		component.Annotations[controller.AnnotationKeyDisplayName] = req.DisplayName
+	}
+	if req.Description != "" {
+		component.Annotations[controller.AnnotationKeyDescription] = req.Description
+	}
+	if req.RepositoryURL != "" {
+		workflow, err := s.buildWorkflow(ctx, namespaceName, ctSpec, req)
+		if err != nil {
+			return nil, err
+		}
+		component.Spec.Workflow = workflow
+	}
+
+	if req.Image == "" {
+		return &ScaffoldComponentResult{Component: component}, nil
+	}
+	return &ScaffoldComponentResult{Component: component, Workload: scaffoldWorkload(req)}, nil
+}
+
+// CreateComponentWithWorkload creates the component and then, when given, its
+// workload. If the workload cannot be created, the component is deleted again.
+func (s *componentService) CreateComponentWithWorkload(
+	ctx context.Context, namespaceName string, component *openchoreov1alpha1.Component, workload *openchoreov1alpha1.Workload,
+) (*ScaffoldComponentResult, error) {
+	created, err := s.CreateComponent(ctx, namespaceName, component)
+	if err != nil {
+		return nil, err
+	}
+	if workload == nil {
+		return &ScaffoldComponentResult{Component: created}, nil
+	}
+
+	createdWorkload, err := s.workloadService.CreateWorkload(ctx, namespaceName, workload)
+	if err != nil {
+		// Leave no component behind that the caller would have to clean up
+		// before retrying.
+		if delErr := s.DeleteComponent(ctx, namespaceName, component.Name); delErr != nil {
+			s.logger.Error("Failed to delete component after workload creation failed",
+				"namespace", namespaceName, "component", component.Name, "error", delErr)
+		}
+		return nil, err
+	}
+	return &ScaffoldComponentResult{Component: created, Workload: createdWorkload}, nil
+}
+
+// fetchComponentClass returns the kind and spec of the named component class.
+// When kind is empty, a ComponentType in the namespace takes precedence over a
+// ClusterComponentType with the same name.
+func (s *componentService) fetchComponentClass(
+	ctx context.Context, namespaceName string, kind openchoreov1alpha1.ComponentTypeRefKind, name string,
+) (openchoreov1alpha1.ComponentTypeRefKind, *openchoreov1alpha1.ComponentTypeSpec, error) {
+	if kind != openchoreov1alpha1.ComponentTypeRefKindClusterComponentType {
+		ct := &openchoreov1alpha1.ComponentType{}
+		err := s.k8sClient.Get(ctx, client.ObjectKey{Name: name, Namespace: namespaceName}, ct)
+		if err == nil {
+			return openchoreov1alpha1.ComponentTypeRefKindComponentType, &ct.Spec, nil
+		}
+		if client.IgnoreNotFound(err) != nil {
+			return "", nil, fmt.Errorf("failed to get ComponentType: %w", err)
+		}
+		if kind != "" {
+			return "", nil, fmt.Errorf("ComponentType %q: %w", name, ErrComponentTypeNotFound)
+		}
+	}
+
+	cct := &openchoreov1alpha1.ClusterComponentType{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: name}, cct); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return "", nil, fmt.Errorf("ClusterComponentType %q: %w", name, ErrComponentTypeNotFound)
+		}
+		return "", nil, fmt.Errorf("failed to get ClusterComponentType: %w", err)
+	}
+	return openchoreov1alpha1.ComponentTypeRefKindClusterComponentType, clusterComponentTypeSpec(cct), nil
+}
+
+// classParameters returns the component parameters: the given values over the
+// defaults of the class parameter schema.
+func classParameters(ctSpec *openchoreov1alpha1.ComponentTypeSpec, values map[string]any) (*runtime.RawExtension, error) {
+	structural, err := openchoreoschema.ResolveSectionToStructural(ctSpec.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve component type parameter schema: %w", err)
+	}
+	params := make(map[string]any, len(values))
+	for k, v := range values {
+		params[k] = v
+	}
+	params = openchoreoschema.ApplyDefaults(params, structural)
+	if len(params) == 0 {
+		return nil, nil
+	}
+	raw, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal parameters: %w", err)
+	}
+	return &runtime.RawExtension{Raw: raw}, nil
+}
+
+// buildWorkflow returns the build configuration of a component built from
+// req.RepositoryURL. The repository fields go to the workflow parameters that
+// carry the x-openchoreo-component-parameter-repository-* extensions.
+func (s *componentService) buildWorkflow(
+	ctx context.Context, namespaceName string, ctSpec *openchoreov1alpha1.ComponentTypeSpec, req *ScaffoldComponentRequest,
+) (*openchoreov1alpha1.ComponentWorkflowConfig, error) {
+	var ref openchoreov1alpha1.WorkflowRef
+	switch {
+	case req.Workflow != nil:
+		ref = *req.Workflow
+	case len(ctSpec.AllowedWorkflows) > 0:
+		ref = ctSpec.AllowedWorkflows[0]
+	default:
+		return nil, &services.ValidationError{
+			Msg: fmt.Sprintf("component type %q allows no build workflows; set image instead", req.ClassName),
+		}
+	}
+	if ref.Kind == "" {
+		ref.Kind = openchoreov1alpha1.WorkflowRefKindClusterWorkflow
+	}
+
+	var paramsSchema *openchoreov1alpha1.SchemaSection
+	if ref.Kind == openchoreov1alpha1.WorkflowRefKindClusterWorkflow {
+		cwf := &openchoreov1alpha1.ClusterWorkflow{}
+		if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: ref.Name}, cwf); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				return nil, &services.ValidationError{Msg: fmt.Sprintf("ClusterWorkflow %q not found", ref.Name)}
+			}
+			return nil, fmt.Errorf("failed to get ClusterWorkflow: %w", err)
+		}
+		paramsSchema = cwf.Spec.Parameters
+	} else {
+		wf := &openchoreov1alpha1.Workflow{}
+		if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: namespaceName}, wf); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				return nil, &services.ValidationError{Msg: fmt.Sprintf("Workflow %q not found", ref.Name)}
+			}
+			return nil, fmt.Errorf("failed to get Workflow: %w", err)
+		}
+		paramsSchema = wf.Spec.Parameters
+	}
+
+	paths, err := controller.ExtractComponentRepositoryPaths(paramsSchema.GetRaw())
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract repository parameters of workflow %q: %w", ref.Name, err)
+	}
+	if _, ok := paths["url"]; !ok {
+		return nil, &services.ValidationError{
+			Msg: fmt.Sprintf("workflow %q does not take a repository URL; set image instead", ref.Name),
+		}
+	}
+
+	params := make(map[string]any, len(req.WorkflowParameters))
+	for k, v := range req.WorkflowParameters {
+		params[k] = v
+	}
+	for role, value := range map[string]string{"url": req.RepositoryURL, "branch": req.Branch, "app-path": req.AppPath} {
+		path, ok := paths[role]
+		if !ok || value == "" {
+			continue
+		}
+		fields := strings.Split(strings.TrimPrefix(path, "parameters."), ".")
+		if err := unstructured.SetNestedField(params, value, fields...); err != nil {
+			return nil, &services.ValidationError{Msg: fmt.Sprintf("workflow parameter %s: %v", path, err)}
+		}
+	}
+	raw, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal workflow parameters: %w", err)
+	}
+
+	return &openchoreov1alpha1.ComponentWorkflowConfig{
+		Kind:       ref.Kind,
+		Name:       ref.Name,
+		Parameters: &runtime.RawExtension{Raw: raw},
+	}, nil
+}
+
+// scaffoldWorkload returns the workload of a component that runs req.Image.
+func scaffoldWorkload(req *ScaffoldComponentRequest) *openchoreov1alpha1.Workload {
+	w := &openchoreov1alpha1.Workload{
+		ObjectMeta: metav1.ObjectMeta{Name: req.Name + "-workload"},
+		Spec: openchoreov1alpha1.WorkloadSpec{
+			Owner: openchoreov1alpha1.WorkloadOwner{
+				ProjectName:   req.ProjectName,
+				ComponentName: req.Name,
+			},
+			WorkloadTemplateSpec: openchoreov1alpha1.WorkloadTemplateSpec{
+				Container: openchoreov1alpha1.Container{Image: req.Image},
+			},
+		},
+	}
+	if req.Type == openchoreov1alpha1.WorkloadTypeScheduledTask {
+		return w
+	}
+
+	port := req.Port
+	if port == 0 {
+		port = defaultScaffoldPort
+	}
+	endpoint := openchoreov1alpha1.WorkloadEndpoint{Type: openchoreov1alpha1.EndpointTypeHTTP, Port: port}
+	if req.Type == openchoreov1alpha1.WorkloadTypeWebApplication {
+		endpoint.Visibility = []openchoreov1alpha1.EndpointVisibility{openchoreov1alpha1.EndpointVisibilityExternal}
+	}
+	w.Spec.Endpoints = map[string]openchoreov1alpha1.WorkloadEndpoint{"http": endpoint}
+	return w
+}
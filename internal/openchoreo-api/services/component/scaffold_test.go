@@ -0,0 +1,232 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// scaffoldClass returns a ClusterComponentType whose parameters default
+// replicas to 2 and which allows the "docker" ClusterWorkflow.
+func scaffoldClass(t *testing.T, name, workloadType string) *openchoreov1alpha1.ClusterComponentType {
+	t.Helper()
+	return &openchoreov1alpha1.ClusterComponentType{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: openchoreov1alpha1.ClusterComponentTypeSpec{
+			WorkloadType: workloadType,
+			AllowedWorkflows: []openchoreov1alpha1.ClusterWorkflowRef{
+				{Kind: openchoreov1alpha1.ClusterWorkflowRefKindClusterWorkflow, Name: "docker"},
+			},
+			Parameters: &openchoreov1alpha1.SchemaSection{
+				OpenAPIV3Schema: rawJSON(t, map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"replicas": map[string]any{"type": "integer", "default": 2},
+						"exposed":  map[string]any{"type": "boolean"},
+					},
+				}),
+			},
+		},
+	}
+}
+
+// scaffoldWorkflow returns a ClusterWorkflow that takes the repository URL and
+// branch at repository.url and repository.branch.
+func scaffoldWorkflow(t *testing.T) *openchoreov1alpha1.ClusterWorkflow {
+	t.Helper()
+	return &openchoreov1alpha1.ClusterWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "docker"},
+		Spec: openchoreov1alpha1.ClusterWorkflowSpec{
+			Parameters: &openchoreov1alpha1.SchemaSection{
+				OpenAPIV3Schema: rawJSON(t, map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"repository": map[string]any{
+							"type": "object",
+							"properties": map[string]any{
+								"url":    map[string]any{"type": "string", "x-openchoreo-component-parameter-repository-url": true},
+								"branch": map[string]any{"type": "string", "x-openchoreo-component-parameter-repository-branch": true},
+							},
+						},
+					},
+				}),
+			},
+		},
+	}
+}
+
+func unmarshalRaw(t *testing.T, data []byte) map[string]any {
+	t.Helper()
+	var m map[string]any
+	require.NoError(t, json.Unmarshal(data, &m))
+	return m
+}
+
+func TestScaffoldComponent(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("service built from source", func(t *testing.T) {
+		svc := newService(t, testProject(), scaffoldClass(t, "service", "deployment"), scaffoldWorkflow(t))
+
+		result, err := svc.ScaffoldComponent(ctx, testNamespace, &ScaffoldComponentRequest{
+			Name:          "api",
+			ProjectName:   testProjectName,
+			Type:          openchoreov1alpha1.WorkloadTypeService,
+			ClassName:     "service",
+			Parameters:    map[string]any{"exposed": true},
+			RepositoryURL: "https://github.com/example/api",
+			Branch:        "main",
+		})
+		require.NoError(t, err)
+		assert.Nil(t, result.Workload)
+
+		comp := result.Component
+		assert.Equal(t, openchoreov1alpha1.ComponentTypeRef{
+			Kind: openchoreov1alpha1.ComponentTypeRefKindClusterComponentType,
+			Name: "deployment/service",
+		}, comp.Spec.ComponentType)
+		assert.Equal(t, map[string]any{"replicas": float64(2), "exposed": true},
+			unmarshalRaw(t, comp.Spec.Parameters.Raw))
+		require.NotNil(t, comp.Spec.Workflow)
+		assert.Equal(t, "docker", comp.Spec.Workflow.Name)
+		assert.Equal(t, openchoreov1alpha1.WorkflowRefKindClusterWorkflow, comp.Spec.Workflow.Kind)
+		assert.Equal(t, map[string]any{
+			"repository": map[string]any{"url": "https://github.com/example/api", "branch": "main"},
+		}, unmarshalRaw(t, comp.Spec.Workflow.Parameters.Raw))
+	})
+
+	t.Run("web application from image", func(t *testing.T) {
+		svc := newService(t, testProject(), scaffoldClass(t, "web-app", "deployment"))
+
+		result, err := svc.ScaffoldComponent(ctx, testNamespace, &ScaffoldComponentRequest{
+			Name:        "frontend",
+			ProjectName: testProjectName,
+			Type:        openchoreov1alpha1.WorkloadTypeWebApplication,
+			ClassName:   "web-app",
+			Image:       "nginx:1.27",
+			Port:        80,
+		})
+		require.NoError(t, err)
+		assert.Nil(t, result.Component.Spec.Workflow)
+		require.NotNil(t, result.Workload)
+		assert.Equal(t, "frontend-workload", result.Workload.Name)
+		assert.Equal(t, "nginx:1.27", result.Workload.Spec.Container.Image)
+		assert.Equal(t, map[string]openchoreov1alpha1.WorkloadEndpoint{
+			"http": {
+				Type:       openchoreov1alpha1.EndpointTypeHTTP,
+				Port:       80,
+				Visibility: []openchoreov1alpha1.EndpointVisibility{openchoreov1alpha1.EndpointVisibilityExternal},
+			},
+		}, result.Workload.Spec.Endpoints)
+	})
+
+	t.Run("scheduled task has no endpoints", func(t *testing.T) {
+		svc := newService(t, testProject(), scaffoldClass(t, "scheduled-task", "cronjob"))
+
+		result, err := svc.ScaffoldComponent(ctx, testNamespace, &ScaffoldComponentRequest{
+			Name:        "report",
+			ProjectName: testProjectName,
+			Type:        openchoreov1alpha1.WorkloadTypeScheduledTask,
+			ClassName:   "scheduled-task",
+			Image:       "report:latest",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "cronjob/scheduled-task", result.Component.Spec.ComponentType.Name)
+		assert.Empty(t, result.Workload.Spec.Endpoints)
+	})
+
+	t.Run("namespace class takes precedence", func(t *testing.T) {
+		ct := &openchoreov1alpha1.ComponentType{
+			ObjectMeta: metav1.ObjectMeta{Name: "service", Namespace: testNamespace},
+			Spec:       openchoreov1alpha1.ComponentTypeSpec{WorkloadType: "deployment"},
+		}
+		svc := newService(t, testProject(), ct, scaffoldClass(t, "service", "deployment"))
+
+		result, err := svc.ScaffoldComponent(ctx, testNamespace, &ScaffoldComponentRequest{
+			Name:        "api",
+			ProjectName: testProjectName,
+			Type:        openchoreov1alpha1.WorkloadTypeService,
+			ClassName:   "service",
+			Image:       "api:latest",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, openchoreov1alpha1.ComponentTypeRefKindComponentType, result.Component.Spec.ComponentType.Kind)
+		assert.Nil(t, result.Component.Spec.Parameters)
+	})
+
+	t.Run("class with a different workload type", func(t *testing.T) {
+		svc := newService(t, testProject(), scaffoldClass(t, "service", "deployment"))
+
+		_, err := svc.ScaffoldComponent(ctx, testNamespace, &ScaffoldComponentRequest{
+			Name:        "report",
+			ProjectName: testProjectName,
+			Type:        openchoreov1alpha1.WorkloadTypeScheduledTask,
+			ClassName:   "service",
+			Image:       "report:latest",
+		})
+		var vErr *services.ValidationError
+		require.ErrorAs(t, err, &vErr)
+		assert.Contains(t, vErr.Msg, `workloadType "deployment"`)
+	})
+
+	t.Run("class not found", func(t *testing.T) {
+		svc := newService(t, testProject())
+
+		_, err := svc.ScaffoldComponent(ctx, testNamespace, &ScaffoldComponentRequest{
+			Name:        "api",
+			ProjectName: testProjectName,
+			Type:        openchoreov1alpha1.WorkloadTypeService,
+			ClassName:   "missing",
+			Image:       "api:latest",
+		})
+		require.ErrorIs(t, err, ErrComponentTypeNotFound)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		svc := newService(t, testProject(), scaffoldClass(t, "service", "deployment"))
+		tests := map[string]*ScaffoldComponentRequest{
+			"unknown type": {Name: "api", ProjectName: testProjectName, Type: "ManualTask", ClassName: "service", Image: "api"},
+			"no source":    {Name: "api", ProjectName: testProjectName, Type: "Service", ClassName: "service"},
+			"two sources": {Name: "api", ProjectName: testProjectName, Type: "Service", ClassName: "service",
+				Image: "api", RepositoryURL: "https://github.com/example/api"},
+			"workflow not found": {Name: "api", ProjectName: testProjectName, Type: "Service", ClassName: "service",
+				RepositoryURL: "https://github.com/example/api"},
+		}
+		for name, req := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := svc.ScaffoldComponent(ctx, testNamespace, req)
+				var vErr *services.ValidationError
+				require.ErrorAs(t, err, &vErr)
+			})
+		}
+	})
+
+	t.Run("component removed when workload creation fails", func(t *testing.T) {
+		existing := &openchoreov1alpha1.Workload{
+			ObjectMeta: metav1.ObjectMeta{Name: "api-workload", Namespace: testNamespace},
+		}
+		svc := newService(t, testProject(), scaffoldClass(t, "service", "deployment"), existing)
+
+		_, err := svc.ScaffoldComponent(ctx, testNamespace, &ScaffoldComponentRequest{
+			Name:        "api",
+			ProjectName: testProjectName,
+			Type:        openchoreov1alpha1.WorkloadTypeService,
+			ClassName:   "service",
+			Image:       "api:latest",
+		})
+		require.Error(t, err)
+
+		_, err = svc.GetComponent(ctx, testNamespace, "api")
+		require.ErrorIs(t, err, ErrComponentNotFound)
+	})
+}
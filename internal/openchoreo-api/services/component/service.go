@@ -18,7 +18,9 @@ import (
 	"github.com/openchoreo/openchoreo/internal/componentrelease"
 	"github.com/openchoreo/openchoreo/internal/labels"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/git"
 	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+	workloadsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workload"
 	openchoreoschema "github.com/openchoreo/openchoreo/internal/schema"
 	componentvalidation "github.com/openchoreo/openchoreo/internal/validation/component"
 )
@@ -36,21 +38,26 @@ var componentReleaseTypeMeta = metav1.TypeMeta{
 // componentService handles component-related business logic without authorization checks.
 // Other services within this layer should use this directly to avoid double authz.
 type componentService struct {
-	k8sClient      client.Client
-	projectService projectsvc.Service
-	logger         *slog.Logger
+	k8sClient       client.Client
+	projectService  projectsvc.Service
+	workloadService workloadsvc.Service
+	repoFiles       git.FileFetcher
+	logger          *slog.Logger
 }
 
 var _ Service = (*componentService)(nil)
 
 // NewService creates a new component service without authorization.
-// It internally creates an unwrapped project service for project validation,
-// avoiding double authz when used within the authz-wrapped component service.
+// It internally creates unwrapped project and workload services for project
+// validation and scaffolding, avoiding double authz when used within the
+// authz-wrapped component service.
 func NewService(k8sClient client.Client, logger *slog.Logger) Service {
 	return &componentService{
-		k8sClient:      k8sClient,
-		projectService: projectsvc.NewService(k8sClient, logger.With("component", "project-service-internal")),
-		logger:         logger,
+		k8sClient:       k8sClient,
+		projectService:  projectsvc.NewService(k8sClient, logger.With("component", "project-service-internal")),
+		workloadService: workloadsvc.NewService(k8sClient, logger.With("component", "workload-service-internal")),
+		repoFiles:       git.NewHTTPFileFetcher(),
+		logger:          logger,
 	}
 }
 
@@ -19,6 +19,7 @@ import (
 const (
 	resourceTypeComponent        = "component"
 	resourceTypeComponentRelease = "componentrelease"
+	resourceTypeWorkload         = "workload"
 )
 
 // componentServiceWithAuthz wraps a Service and adds authorization checks.
@@ -72,6 +73,101 @@ func (s *componentServiceWithAuthz) CreateComponent(ctx context.Context, namespa
 	return s.internal.CreateComponent(ctx, namespaceName, component)
 }
 
+// ScaffoldComponent requires component:create and, for components that run a
+// prebuilt image, workload:create as the workload is created as well.
+func (s *componentServiceWithAuthz) ScaffoldComponent(ctx context.Context, namespaceName string, req *ScaffoldComponentRequest) (*ScaffoldComponentResult, error) {
+	hierarchy := authz.ResourceHierarchy{
+		Namespace: namespaceName,
+		Project:   req.ProjectName,
+		Component: req.Name,
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionCreateComponent,
+		ResourceType: resourceTypeComponent,
+		ResourceID:   req.Name,
+		Hierarchy:    hierarchy,
+		Context: authz.Context{
+			Resource: authz.ResourceAttribute{
+				ComponentType: formatComponentTypeAttr(namespaceName, openchoreov1alpha1.ComponentTypeRef{
+					Kind: req.ClassKind,
+					Name: req.ClassName,
+				}),
+			},
+		},
+	}); err != nil {
+		return nil, err
+	}
+	if req.Image != "" {
+		if err := s.authz.Check(ctx, services.CheckRequest{
+			Action:       authz.ActionCreateWorkload,
+			ResourceType: resourceTypeWorkload,
+			ResourceID:   req.Name + "-workload",
+			Hierarchy:    hierarchy,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return s.internal.ScaffoldComponent(ctx, namespaceName, req)
+}
+
+// GenerateComponentFromRepository creates nothing, but only callers that may
+// create the generated component, and its workload, get to see it.
+func (s *componentServiceWithAuthz) GenerateComponentFromRepository(
+	ctx context.Context, namespaceName string, req *ImportComponentRequest,
+) (*ImportComponentResult, error) {
+	result, err := s.internal.GenerateComponentFromRepository(ctx, namespaceName, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCreateWithWorkload(ctx, namespaceName, result.Component, result.Workload); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateComponentWithWorkload requires component:create and, when a workload
+// is given, workload:create.
+func (s *componentServiceWithAuthz) CreateComponentWithWorkload(
+	ctx context.Context, namespaceName string, component *openchoreov1alpha1.Component, workload *openchoreov1alpha1.Workload,
+) (*ScaffoldComponentResult, error) {
+	if err := s.checkCreateWithWorkload(ctx, namespaceName, component, workload); err != nil {
+		return nil, err
+	}
+	return s.internal.CreateComponentWithWorkload(ctx, namespaceName, component, workload)
+}
+
+func (s *componentServiceWithAuthz) checkCreateWithWorkload(
+	ctx context.Context, namespaceName string, component *openchoreov1alpha1.Component, workload *openchoreov1alpha1.Workload,
+) error {
+	hierarchy := authz.ResourceHierarchy{
+		Namespace: namespaceName,
+		Project:   component.Spec.Owner.ProjectName,
+		Component: component.Name,
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionCreateComponent,
+		ResourceType: resourceTypeComponent,
+		ResourceID:   component.Name,
+		Hierarchy:    hierarchy,
+		Context: authz.Context{
+			Resource: authz.ResourceAttribute{
+				ComponentType: formatComponentTypeAttr(namespaceName, component.Spec.ComponentType),
+			},
+		},
+	}); err != nil {
+		return err
+	}
+	if workload == nil {
+		return nil
+	}
+	return s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionCreateWorkload,
+		ResourceType: resourceTypeWorkload,
+		ResourceID:   workload.Name,
+		Hierarchy:    hierarchy,
+	})
+}
+
 func (s *componentServiceWithAuthz) UpdateComponent(ctx context.Context, namespaceName string, component *openchoreov1alpha1.Component) (*openchoreov1alpha1.Component, error) {
 	if err := s.authz.Check(ctx, services.CheckRequest{
 		Action:       authz.ActionUpdateComponent,
@@ -38,6 +38,24 @@ func (m *mockService) CreateComponent(ctx context.Context, namespaceName string,
 	return res, args.Error(1)
 }
 
+func (m *mockService) ScaffoldComponent(ctx context.Context, namespaceName string, req *ScaffoldComponentRequest) (*ScaffoldComponentResult, error) {
+	args := m.Called(ctx, namespaceName, req)
+	res, _ := args.Get(0).(*ScaffoldComponentResult)
+	return res, args.Error(1)
+}
+
+func (m *mockService) GenerateComponentFromRepository(ctx context.Context, namespaceName string, req *ImportComponentRequest) (*ImportComponentResult, error) {
+	args := m.Called(ctx, namespaceName, req)
+	res, _ := args.Get(0).(*ImportComponentResult)
+	return res, args.Error(1)
+}
+
+func (m *mockService) CreateComponentWithWorkload(ctx context.Context, namespaceName string, component *openchoreov1alpha1.Component, workload *openchoreov1alpha1.Workload) (*ScaffoldComponentResult, error) {
+	args := m.Called(ctx, namespaceName, component, workload)
+	res, _ := args.Get(0).(*ScaffoldComponentResult)
+	return res, args.Error(1)
+}
+
 func (m *mockService) UpdateComponent(ctx context.Context, namespaceName string, component *openchoreov1alpha1.Component) (*openchoreov1alpha1.Component, error) {
 	args := m.Called(ctx, namespaceName, component)
 	res, _ := args.Get(0).(*openchoreov1alpha1.Component)
@@ -124,6 +142,122 @@ func TestCreateComponent_AuthzCheck(t *testing.T) {
 	})
 }
 
+// --- ScaffoldComponent ---
+
+func TestScaffoldComponent_AuthzCheck(t *testing.T) {
+	t.Run("built from source checks component:create", func(t *testing.T) {
+		req := &ScaffoldComponentRequest{
+			Name: "my-comp", ProjectName: "my-proj", ClassName: "web-app",
+			ClassKind: openchoreov1alpha1.ComponentTypeRefKindClusterComponentType,
+		}
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("ScaffoldComponent", mock.Anything, "ns-1", req).Return(&ScaffoldComponentResult{}, nil)
+		svc := &componentServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.ScaffoldComponent(testutil.AuthzContext(), "ns-1", req)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "component:create", "component", "my-comp", compHierarchy)
+		require.Equal(t, "web-app", pdp.Captured[0].Context.Resource.ComponentType)
+	})
+
+	t.Run("prebuilt image also checks workload:create", func(t *testing.T) {
+		req := &ScaffoldComponentRequest{Name: "my-comp", ProjectName: "my-proj", ClassName: "web-app", Image: "nginx"}
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("ScaffoldComponent", mock.Anything, "ns-1", req).Return(&ScaffoldComponentResult{}, nil)
+		svc := &componentServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.ScaffoldComponent(testutil.AuthzContext(), "ns-1", req)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 2)
+		testutil.RequireEvalRequest(t, pdp.Captured[1], "workload:create", "workload", "my-comp-workload", compHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := newMockService(t)
+		svc := &componentServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.ScaffoldComponent(testutil.AuthzContext(), "ns-1",
+			&ScaffoldComponentRequest{Name: "my-comp", ProjectName: "my-proj", ClassName: "web-app"})
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+// --- GenerateComponentFromRepository ---
+
+func TestGenerateComponentFromRepository_AuthzCheck(t *testing.T) {
+	req := &ImportComponentRequest{ProjectName: "my-proj", RepositoryURL: "https://github.com/acme/my-comp"}
+	workload := &openchoreov1alpha1.Workload{ObjectMeta: metav1.ObjectMeta{Name: "my-comp-workload"}}
+
+	t.Run("allowed checks the generated resources", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GenerateComponentFromRepository", mock.Anything, "ns-1", req).
+			Return(&ImportComponentResult{Component: testComp(), Workload: workload}, nil)
+		svc := &componentServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		result, err := svc.GenerateComponentFromRepository(testutil.AuthzContext(), "ns-1", req)
+		require.NoError(t, err)
+		require.Equal(t, workload, result.Workload)
+		require.Len(t, pdp.Captured, 2)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "component:create", "component", "my-comp", compHierarchy)
+		testutil.RequireEvalRequest(t, pdp.Captured[1], "workload:create", "workload", "my-comp-workload", compHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		mockSvc := newMockService(t)
+		mockSvc.On("GenerateComponentFromRepository", mock.Anything, "ns-1", req).
+			Return(&ImportComponentResult{Component: testComp()}, nil)
+		svc := &componentServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(testutil.DenyPDP()),
+		}
+		_, err := svc.GenerateComponentFromRepository(testutil.AuthzContext(), "ns-1", req)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+// --- CreateComponentWithWorkload ---
+
+func TestCreateComponentWithWorkload_AuthzCheck(t *testing.T) {
+	comp := testComp()
+
+	t.Run("without workload checks component:create", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("CreateComponentWithWorkload", mock.Anything, "ns-1", comp, (*openchoreov1alpha1.Workload)(nil)).
+			Return(&ScaffoldComponentResult{Component: comp}, nil)
+		svc := &componentServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.CreateComponentWithWorkload(testutil.AuthzContext(), "ns-1", comp, nil)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "component:create", "component", "my-comp", compHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		svc := &componentServiceWithAuthz{
+			internal: newMockService(t),
+			authz:    testutil.NewTestAuthzChecker(testutil.DenyPDP()),
+		}
+		_, err := svc.CreateComponentWithWorkload(testutil.AuthzContext(), "ns-1", comp, nil)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
 // --- UpdateComponent ---
 
 func TestUpdateComponent_AuthzCheck(t *testing.T) {
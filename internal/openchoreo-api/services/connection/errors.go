@@ -0,0 +1,13 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package connection
+
+import "errors"
+
+var (
+	ErrConnectionNotFound   = errors.New("connection not found")
+	ErrConnectionExists     = errors.New("connection already exists")
+	ErrResourceNotFound     = errors.New("resource not found")
+	ErrResourceNotInProject = errors.New("resource does not belong to the component's project")
+)
@@ -0,0 +1,36 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package connection
+
+import (
+	"context"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// CreateConnectionRequest contains the parameters for connecting a component to an endpoint of
+// another component or to a project-bound Resource. Exactly one of Endpoint and Resource is set.
+type CreateConnectionRequest struct {
+	// Name of the connection. When empty, a name is generated from the component name.
+	Name          string
+	ComponentName string
+	// Environments limits the connection to the listed environments. Empty means all.
+	Environments []string
+	Endpoint     *openchoreov1alpha1.WorkloadConnection
+	Resource     *openchoreov1alpha1.WorkloadResourceDependency
+}
+
+// Service defines the connection service interface.
+// Both the core service (no authz) and the authz-wrapped service implement this.
+type Service interface {
+	// CreateConnection declares that a component consumes the target of the request. The
+	// connection is injected into the component the next time its ReleaseBindings reconcile.
+	CreateConnection(ctx context.Context, namespaceName string, req *CreateConnectionRequest) (*openchoreov1alpha1.Connection, error)
+	// ListConnections lists connections, optionally filtered by the consuming component.
+	ListConnections(ctx context.Context, namespaceName, componentName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.Connection], error)
+	GetConnection(ctx context.Context, namespaceName, connectionName string) (*openchoreov1alpha1.Connection, error)
+	// DeleteConnection removes the connection and the injected configuration from the component.
+	DeleteConnection(ctx context.Context, namespaceName, connectionName string) error
+}
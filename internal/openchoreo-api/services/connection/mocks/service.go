@@ -0,0 +1,270 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	connection "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/connection"
+	mock "github.com/stretchr/testify/mock"
+
+	services "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+
+	v1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// CreateConnection provides a mock function with given fields: ctx, namespaceName, req
+func (_m *MockService) CreateConnection(ctx context.Context, namespaceName string, req *connection.CreateConnectionRequest) (*v1alpha1.Connection, error) {
+	ret := _m.Called(ctx, namespaceName, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateConnection")
+	}
+
+	var r0 *v1alpha1.Connection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *connection.CreateConnectionRequest) (*v1alpha1.Connection, error)); ok {
+		return rf(ctx, namespaceName, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *connection.CreateConnectionRequest) *v1alpha1.Connection); ok {
+		r0 = rf(ctx, namespaceName, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.Connection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *connection.CreateConnectionRequest) error); ok {
+		r1 = rf(ctx, namespaceName, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_CreateConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateConnection'
+type MockService_CreateConnection_Call struct {
+	*mock.Call
+}
+
+// CreateConnection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - req *connection.CreateConnectionRequest
+func (_e *MockService_Expecter) CreateConnection(ctx interface{}, namespaceName interface{}, req interface{}) *MockService_CreateConnection_Call {
+	return &MockService_CreateConnection_Call{Call: _e.mock.On("CreateConnection", ctx, namespaceName, req)}
+}
+
+func (_c *MockService_CreateConnection_Call) Run(run func(ctx context.Context, namespaceName string, req *connection.CreateConnectionRequest)) *MockService_CreateConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*connection.CreateConnectionRequest))
+	})
+	return _c
+}
+
+func (_c *MockService_CreateConnection_Call) Return(_a0 *v1alpha1.Connection, _a1 error) *MockService_CreateConnection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_CreateConnection_Call) RunAndReturn(run func(context.Context, string, *connection.CreateConnectionRequest) (*v1alpha1.Connection, error)) *MockService_CreateConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteConnection provides a mock function with given fields: ctx, namespaceName, connectionName
+func (_m *MockService) DeleteConnection(ctx context.Context, namespaceName string, connectionName string) error {
+	ret := _m.Called(ctx, namespaceName, connectionName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteConnection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, namespaceName, connectionName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_DeleteConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteConnection'
+type MockService_DeleteConnection_Call struct {
+	*mock.Call
+}
+
+// DeleteConnection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - connectionName string
+func (_e *MockService_Expecter) DeleteConnection(ctx interface{}, namespaceName interface{}, connectionName interface{}) *MockService_DeleteConnection_Call {
+	return &MockService_DeleteConnection_Call{Call: _e.mock.On("DeleteConnection", ctx, namespaceName, connectionName)}
+}
+
+func (_c *MockService_DeleteConnection_Call) Run(run func(ctx context.Context, namespaceName string, connectionName string)) *MockService_DeleteConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_DeleteConnection_Call) Return(_a0 error) *MockService_DeleteConnection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_DeleteConnection_Call) RunAndReturn(run func(context.Context, string, string) error) *MockService_DeleteConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConnection provides a mock function with given fields: ctx, namespaceName, connectionName
+func (_m *MockService) GetConnection(ctx context.Context, namespaceName string, connectionName string) (*v1alpha1.Connection, error) {
+	ret := _m.Called(ctx, namespaceName, connectionName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConnection")
+	}
+
+	var r0 *v1alpha1.Connection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*v1alpha1.Connection, error)); ok {
+		return rf(ctx, namespaceName, connectionName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *v1alpha1.Connection); ok {
+		r0 = rf(ctx, namespaceName, connectionName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.Connection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, connectionName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnection'
+type MockService_GetConnection_Call struct {
+	*mock.Call
+}
+
+// GetConnection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - connectionName string
+func (_e *MockService_Expecter) GetConnection(ctx interface{}, namespaceName interface{}, connectionName interface{}) *MockService_GetConnection_Call {
+	return &MockService_GetConnection_Call{Call: _e.mock.On("GetConnection", ctx, namespaceName, connectionName)}
+}
+
+func (_c *MockService_GetConnection_Call) Run(run func(ctx context.Context, namespaceName string, connectionName string)) *MockService_GetConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetConnection_Call) Return(_a0 *v1alpha1.Connection, _a1 error) *MockService_GetConnection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetConnection_Call) RunAndReturn(run func(context.Context, string, string) (*v1alpha1.Connection, error)) *MockService_GetConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListConnections provides a mock function with given fields: ctx, namespaceName, componentName, opts
+func (_m *MockService) ListConnections(ctx context.Context, namespaceName string, componentName string, opts services.ListOptions) (*services.ListResult[v1alpha1.Connection], error) {
+	ret := _m.Called(ctx, namespaceName, componentName, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListConnections")
+	}
+
+	var r0 *services.ListResult[v1alpha1.Connection]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, services.ListOptions) (*services.ListResult[v1alpha1.Connection], error)); ok {
+		return rf(ctx, namespaceName, componentName, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, services.ListOptions) *services.ListResult[v1alpha1.Connection]); ok {
+		r0 = rf(ctx, namespaceName, componentName, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.ListResult[v1alpha1.Connection])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, services.ListOptions) error); ok {
+		r1 = rf(ctx, namespaceName, componentName, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListConnections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConnections'
+type MockService_ListConnections_Call struct {
+	*mock.Call
+}
+
+// ListConnections is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - componentName string
+//   - opts services.ListOptions
+func (_e *MockService_Expecter) ListConnections(ctx interface{}, namespaceName interface{}, componentName interface{}, opts interface{}) *MockService_ListConnections_Call {
+	return &MockService_ListConnections_Call{Call: _e.mock.On("ListConnections", ctx, namespaceName, componentName, opts)}
+}
+
+func (_c *MockService_ListConnections_Call) Run(run func(ctx context.Context, namespaceName string, componentName string, opts services.ListOptions)) *MockService_ListConnections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(services.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockService_ListConnections_Call) Return(_a0 *services.ListResult[v1alpha1.Connection], _a1 error) *MockService_ListConnections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListConnections_Call) RunAndReturn(run func(context.Context, string, string, services.ListOptions) (*services.ListResult[v1alpha1.Connection], error)) *MockService_ListConnections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
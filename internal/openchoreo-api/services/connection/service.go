@@ -0,0 +1,251 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package connection
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	environmentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment"
+)
+
+var connectionTypeMeta = metav1.TypeMeta{
+	APIVersion: openchoreov1alpha1.GroupVersion.String(),
+	Kind:       "Connection",
+}
+
+// connectionService handles connection business logic without authorization checks.
+type connectionService struct {
+	k8sClient          client.Client
+	componentService   componentsvc.Service
+	environmentService environmentsvc.Service
+	logger             *slog.Logger
+}
+
+var _ Service = (*connectionService)(nil)
+
+// NewService creates a new connection service without authorization.
+func NewService(k8sClient client.Client, logger *slog.Logger) Service {
+	return &connectionService{
+		k8sClient:          k8sClient,
+		componentService:   componentsvc.NewService(k8sClient, logger.With("component", "component-service-internal")),
+		environmentService: environmentsvc.NewService(k8sClient, logger.With("component", "environment-service-internal")),
+		logger:             logger,
+	}
+}
+
+func (s *connectionService) CreateConnection(ctx context.Context, namespaceName string, req *CreateConnectionRequest) (*openchoreov1alpha1.Connection, error) {
+	if req == nil {
+		return nil, fmt.Errorf("connection request cannot be nil")
+	}
+	if (req.Endpoint == nil) == (req.Resource == nil) {
+		return nil, &services.ValidationError{Msg: "exactly one of endpoint or resource is required"}
+	}
+	if err := validateEndpoint(req.Endpoint); err != nil {
+		return nil, err
+	}
+	if req.Resource != nil && req.Resource.Ref == "" {
+		return nil, &services.ValidationError{Msg: "resource ref is required"}
+	}
+
+	s.logger.Debug("Creating connection", "namespace", namespaceName, "component", req.ComponentName, "name", req.Name)
+
+	component, err := s.componentService.GetComponent(ctx, namespaceName, req.ComponentName)
+	if err != nil {
+		return nil, err
+	}
+	projectName := component.Spec.Owner.ProjectName
+	for _, env := range req.Environments {
+		if _, err := s.environmentService.GetEnvironment(ctx, namespaceName, env); err != nil {
+			return nil, err
+		}
+	}
+	if req.Endpoint != nil {
+		project := req.Endpoint.Project
+		if project == "" {
+			project = projectName
+		}
+		if err := s.validateTargetComponent(ctx, namespaceName, project, req.Endpoint.Component); err != nil {
+			return nil, err
+		}
+	}
+	if req.Resource != nil {
+		if err := s.validateResource(ctx, namespaceName, projectName, req.Resource.Ref); err != nil {
+			return nil, err
+		}
+	}
+
+	conn := &openchoreov1alpha1.Connection{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      req.Name,
+			Namespace: namespaceName,
+			Labels: map[string]string{
+				ocLabels.LabelKeyProjectName:   projectName,
+				ocLabels.LabelKeyComponentName: req.ComponentName,
+			},
+		},
+		Spec: openchoreov1alpha1.ConnectionSpec{
+			Owner: openchoreov1alpha1.ConnectionOwner{
+				ProjectName:   projectName,
+				ComponentName: req.ComponentName,
+			},
+			Environments: req.Environments,
+			Endpoint:     req.Endpoint,
+			Resource:     req.Resource,
+		},
+	}
+	if req.Name == "" {
+		conn.GenerateName = req.ComponentName + "-"
+	}
+	if err := s.k8sClient.Create(ctx, conn); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			s.logger.Warn("Connection already exists", "namespace", namespaceName, "connection", req.Name)
+			return nil, ErrConnectionExists
+		}
+		s.logger.Error("Failed to create connection", "error", err)
+		return nil, fmt.Errorf("failed to create connection: %w", err)
+	}
+
+	s.logger.Debug("Connection created", "namespace", namespaceName, "connection", conn.Name)
+	conn.TypeMeta = connectionTypeMeta
+	return conn, nil
+}
+
+func (s *connectionService) ListConnections(ctx context.Context, namespaceName, componentName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.Connection], error) {
+	s.logger.Debug("Listing connections", "namespace", namespaceName, "component", componentName, "limit", opts.Limit, "cursor", opts.Cursor)
+
+	listFn := func(ctx context.Context, pageOpts services.ListOptions) (*services.ListResult[openchoreov1alpha1.Connection], error) {
+		commonOpts, err := services.BuildListOptions(pageOpts)
+		if err != nil {
+			return nil, err
+		}
+		listOpts := append([]client.ListOption{client.InNamespace(namespaceName)}, commonOpts...)
+
+		var connList openchoreov1alpha1.ConnectionList
+		if err := s.k8sClient.List(ctx, &connList, listOpts...); err != nil {
+			s.logger.Error("Failed to list connections", "error", err)
+			return nil, fmt.Errorf("failed to list connections: %w", err)
+		}
+
+		for i := range connList.Items {
+			connList.Items[i].TypeMeta = connectionTypeMeta
+		}
+
+		result := &services.ListResult[openchoreov1alpha1.Connection]{
+			Items:      connList.Items,
+			NextCursor: connList.Continue,
+		}
+		if connList.RemainingItemCount != nil {
+			remaining := *connList.RemainingItemCount
+			result.RemainingCount = &remaining
+		}
+		return result, nil
+	}
+
+	// Apply component filter if specified
+	if componentName != "" {
+		filteredFn := services.PreFilteredList(
+			listFn,
+			func(conn openchoreov1alpha1.Connection) bool {
+				return conn.Spec.Owner.ComponentName == componentName
+			},
+		)
+		return filteredFn(ctx, opts)
+	}
+
+	return listFn(ctx, opts)
+}
+
+func (s *connectionService) GetConnection(ctx context.Context, namespaceName, connectionName string) (*openchoreov1alpha1.Connection, error) {
+	s.logger.Debug("Getting connection", "namespace", namespaceName, "connection", connectionName)
+
+	conn := &openchoreov1alpha1.Connection{}
+	key := client.ObjectKey{Namespace: namespaceName, Name: connectionName}
+	if err := s.k8sClient.Get(ctx, key, conn); err != nil {
+		if apierrors.IsNotFound(err) {
+			s.logger.Warn("Connection not found", "namespace", namespaceName, "connection", connectionName)
+			return nil, ErrConnectionNotFound
+		}
+		s.logger.Error("Failed to get connection", "error", err)
+		return nil, fmt.Errorf("failed to get connection: %w", err)
+	}
+	conn.TypeMeta = connectionTypeMeta
+	return conn, nil
+}
+
+func (s *connectionService) DeleteConnection(ctx context.Context, namespaceName, connectionName string) error {
+	s.logger.Debug("Deleting connection", "namespace", namespaceName, "connection", connectionName)
+
+	conn := &openchoreov1alpha1.Connection{}
+	conn.Name = connectionName
+	conn.Namespace = namespaceName
+
+	if err := s.k8sClient.Delete(ctx, conn); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ErrConnectionNotFound
+		}
+		s.logger.Error("Failed to delete connection", "error", err)
+		return fmt.Errorf("failed to delete connection: %w", err)
+	}
+
+	s.logger.Debug("Connection deleted", "namespace", namespaceName, "connection", connectionName)
+	return nil
+}
+
+// validateTargetComponent checks that the component providing the consumed endpoint exists in
+// the target project. Whether it exposes the endpoint at the requested visibility is resolved
+// per environment by the ReleaseBinding controller.
+func (s *connectionService) validateTargetComponent(ctx context.Context, namespaceName, projectName, componentName string) error {
+	target, err := s.componentService.GetComponent(ctx, namespaceName, componentName)
+	if err != nil {
+		return err
+	}
+	if target.Spec.Owner.ProjectName != projectName {
+		return componentsvc.ErrComponentNotFound
+	}
+	return nil
+}
+
+// validateResource checks that the consumed Resource exists in the consumer's project, as
+// Resources cannot be consumed across projects.
+func (s *connectionService) validateResource(ctx context.Context, namespaceName, projectName, resourceName string) error {
+	resource := &openchoreov1alpha1.Resource{}
+	key := client.ObjectKey{Namespace: namespaceName, Name: resourceName}
+	if err := s.k8sClient.Get(ctx, key, resource); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ErrResourceNotFound
+		}
+		s.logger.Error("Failed to get resource", "error", err)
+		return fmt.Errorf("failed to get resource: %w", err)
+	}
+	if resource.Spec.Owner.ProjectName != projectName {
+		return ErrResourceNotInProject
+	}
+	return nil
+}
+
+// validateEndpoint checks the fields of an endpoint connection the CRD requires.
+func validateEndpoint(endpoint *openchoreov1alpha1.WorkloadConnection) error {
+	if endpoint == nil {
+		return nil
+	}
+	if endpoint.Component == "" || endpoint.Name == "" {
+		return &services.ValidationError{Msg: "endpoint component and name are required"}
+	}
+	switch endpoint.Visibility {
+	case string(openchoreov1alpha1.EndpointVisibilityProject), string(openchoreov1alpha1.EndpointVisibilityNamespace):
+		return nil
+	default:
+		return &services.ValidationError{Msg: fmt.Sprintf("endpoint visibility %q must be project or namespace", endpoint.Visibility)}
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package connection
+
+import (
+	"context"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+)
+
+const resourceTypeConnection = "connection"
+
+// connectionServiceWithAuthz wraps a Service and adds authorization checks.
+// Handlers should use this. Other services should use the unwrapped Service directly.
+type connectionServiceWithAuthz struct {
+	internal         Service
+	componentService componentsvc.Service
+	authz            *services.AuthzChecker
+}
+
+var _ Service = (*connectionServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates a connection service with authorization checks.
+func NewServiceWithAuthz(k8sClient client.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &connectionServiceWithAuthz{
+		internal:         NewService(k8sClient, logger),
+		componentService: componentsvc.NewService(k8sClient, logger.With("component", "component-service-internal")),
+		authz:            services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+// CreateConnection requires permission to create connections for the consuming component.
+func (s *connectionServiceWithAuthz) CreateConnection(ctx context.Context, namespaceName string, req *CreateConnectionRequest) (*openchoreov1alpha1.Connection, error) {
+	if req == nil {
+		return s.internal.CreateConnection(ctx, namespaceName, req)
+	}
+	// Fetch first to get the project for authz hierarchy
+	comp, err := s.componentService.GetComponent(ctx, namespaceName, req.ComponentName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionCreateConnection,
+		ResourceType: resourceTypeConnection,
+		ResourceID:   req.ComponentName,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   comp.Spec.Owner.ProjectName,
+			Component: req.ComponentName,
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.CreateConnection(ctx, namespaceName, req)
+}
+
+func (s *connectionServiceWithAuthz) ListConnections(ctx context.Context, namespaceName, componentName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.Connection], error) {
+	return services.FilteredList(ctx, opts, s.authz,
+		func(ctx context.Context, pageOpts services.ListOptions) (*services.ListResult[openchoreov1alpha1.Connection], error) {
+			return s.internal.ListConnections(ctx, namespaceName, componentName, pageOpts)
+		},
+		func(conn openchoreov1alpha1.Connection) services.CheckRequest {
+			return checkRequest(authz.ActionViewConnection, &conn)
+		},
+	)
+}
+
+func (s *connectionServiceWithAuthz) GetConnection(ctx context.Context, namespaceName, connectionName string) (*openchoreov1alpha1.Connection, error) {
+	conn, err := s.internal.GetConnection(ctx, namespaceName, connectionName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, checkRequest(authz.ActionViewConnection, conn)); err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+// DeleteConnection requires permission to delete connections of the consuming component.
+func (s *connectionServiceWithAuthz) DeleteConnection(ctx context.Context, namespaceName, connectionName string) error {
+	// Fetch first to get the component for authz
+	conn, err := s.internal.GetConnection(ctx, namespaceName, connectionName)
+	if err != nil {
+		return err
+	}
+	if err := s.authz.Check(ctx, checkRequest(authz.ActionDeleteConnection, conn)); err != nil {
+		return err
+	}
+	return s.internal.DeleteConnection(ctx, namespaceName, connectionName)
+}
+
+func checkRequest(action string, conn *openchoreov1alpha1.Connection) services.CheckRequest {
+	return services.CheckRequest{
+		Action:       action,
+		ResourceType: resourceTypeConnection,
+		ResourceID:   conn.Name,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: conn.Namespace,
+			Project:   conn.Spec.Owner.ProjectName,
+			Component: conn.Spec.Owner.ComponentName,
+		},
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package connection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+// mockService is a local testify mock for the Service interface.
+// It exists here instead of importing connection/mocks to avoid a cyclic import
+type mockService struct {
+	mock.Mock
+}
+
+func newMockService(t *testing.T) *mockService {
+	m := &mockService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *mockService) CreateConnection(ctx context.Context, namespaceName string, req *CreateConnectionRequest) (*openchoreov1alpha1.Connection, error) {
+	args := m.Called(ctx, namespaceName, req)
+	res, _ := args.Get(0).(*openchoreov1alpha1.Connection)
+	return res, args.Error(1)
+}
+
+func (m *mockService) ListConnections(ctx context.Context, namespaceName, componentName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.Connection], error) {
+	args := m.Called(ctx, namespaceName, componentName, opts)
+	res, _ := args.Get(0).(*services.ListResult[openchoreov1alpha1.Connection])
+	return res, args.Error(1)
+}
+
+func (m *mockService) GetConnection(ctx context.Context, namespaceName, connectionName string) (*openchoreov1alpha1.Connection, error) {
+	args := m.Called(ctx, namespaceName, connectionName)
+	res, _ := args.Get(0).(*openchoreov1alpha1.Connection)
+	return res, args.Error(1)
+}
+
+func (m *mockService) DeleteConnection(ctx context.Context, namespaceName, connectionName string) error {
+	args := m.Called(ctx, namespaceName, connectionName)
+	return args.Error(0)
+}
+
+var connectionHierarchy = authzcore.ResourceHierarchy{Namespace: testNamespace, Project: testProjectName, Component: testComponentName}
+
+func newAuthzService(internal Service, pdp authzcore.PDP) *connectionServiceWithAuthz {
+	k8sClient := testutil.NewFakeClient(testutil.NewComponent(testNamespace, testProjectName, testComponentName))
+	return &connectionServiceWithAuthz{
+		internal:         internal,
+		componentService: componentsvc.NewService(k8sClient, testutil.TestLogger()),
+		authz:            testutil.NewTestAuthzChecker(pdp),
+	}
+}
+
+func testConnection(name string) *openchoreov1alpha1.Connection {
+	return &openchoreov1alpha1.Connection{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Spec: openchoreov1alpha1.ConnectionSpec{
+			Owner:    openchoreov1alpha1.ConnectionOwner{ProjectName: testProjectName, ComponentName: testComponentName},
+			Resource: &openchoreov1alpha1.WorkloadResourceDependency{Ref: "orders-db"},
+		},
+	}
+}
+
+func TestCreateConnection_AuthzCheck(t *testing.T) {
+	req := resourceRequest("orders-db")
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("CreateConnection", mock.Anything, testNamespace, req).Return(testConnection("conn-1"), nil)
+
+		_, err := newAuthzService(mockSvc, pdp).CreateConnection(testutil.AuthzContext(), testNamespace, req)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "connection:create", "connection", testComponentName, connectionHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		_, err := newAuthzService(newMockService(t), testutil.DenyPDP()).CreateConnection(testutil.AuthzContext(), testNamespace, req)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestGetConnection_AuthzCheck(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetConnection", mock.Anything, testNamespace, "conn-1").Return(testConnection("conn-1"), nil)
+
+		_, err := newAuthzService(mockSvc, pdp).GetConnection(testutil.AuthzContext(), testNamespace, "conn-1")
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "connection:view", "connection", "conn-1", connectionHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		mockSvc := newMockService(t)
+		mockSvc.On("GetConnection", mock.Anything, testNamespace, "conn-1").Return(testConnection("conn-1"), nil)
+
+		_, err := newAuthzService(mockSvc, testutil.DenyPDP()).GetConnection(testutil.AuthzContext(), testNamespace, "conn-1")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestDeleteConnection_AuthzCheck(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetConnection", mock.Anything, testNamespace, "conn-1").Return(testConnection("conn-1"), nil)
+		mockSvc.On("DeleteConnection", mock.Anything, testNamespace, "conn-1").Return(nil)
+
+		err := newAuthzService(mockSvc, pdp).DeleteConnection(testutil.AuthzContext(), testNamespace, "conn-1")
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "connection:delete", "connection", "conn-1", connectionHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		mockSvc := newMockService(t)
+		mockSvc.On("GetConnection", mock.Anything, testNamespace, "conn-1").Return(testConnection("conn-1"), nil)
+
+		err := newAuthzService(mockSvc, testutil.DenyPDP()).DeleteConnection(testutil.AuthzContext(), testNamespace, "conn-1")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
@@ -0,0 +1,177 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package connection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	environmentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+const (
+	testNamespace     = "ns-1"
+	testProjectName   = "proj-1"
+	testComponentName = "comp-1"
+)
+
+func newService(objs ...client.Object) Service {
+	return NewService(testutil.NewFakeClient(objs...), testutil.TestLogger())
+}
+
+// seedObjects returns a consuming component, an API component it can connect to, a database
+// Resource in the same project, a Resource of another project and a prod environment.
+func seedObjects() []client.Object {
+	return []client.Object{
+		testutil.NewProject(testNamespace, testProjectName),
+		testutil.NewComponent(testNamespace, testProjectName, testComponentName),
+		testutil.NewComponent(testNamespace, testProjectName, "api"),
+		testutil.NewResource(testNamespace, testProjectName, "orders-db"),
+		testutil.NewResource(testNamespace, "proj-2", "billing-db"),
+		testutil.NewEnvironment(testNamespace, "prod"),
+	}
+}
+
+func endpointRequest() *CreateConnectionRequest {
+	return &CreateConnectionRequest{
+		ComponentName: testComponentName,
+		Environments:  []string{"prod"},
+		Endpoint: &openchoreov1alpha1.WorkloadConnection{
+			Component:   "api",
+			Name:        "http",
+			Visibility:  "project",
+			EnvBindings: openchoreov1alpha1.ConnectionEnvBindings{Address: "API_URL"},
+		},
+	}
+}
+
+func resourceRequest(ref string) *CreateConnectionRequest {
+	return &CreateConnectionRequest{
+		Name:          "orders-db",
+		ComponentName: testComponentName,
+		Resource: &openchoreov1alpha1.WorkloadResourceDependency{
+			Ref:          ref,
+			EnvBindings:  map[string]string{"host": "DB_HOST"},
+			FileBindings: map[string]string{"password": "/etc/db/password"},
+		},
+	}
+}
+
+func TestCreateConnection(t *testing.T) {
+	ctx := testutil.AuthzContext()
+
+	t.Run("connects the component to an endpoint", func(t *testing.T) {
+		svc := newService(seedObjects()...)
+
+		conn, err := svc.CreateConnection(ctx, testNamespace, endpointRequest())
+		require.NoError(t, err)
+		assert.Equal(t, "Connection", conn.Kind)
+		assert.NotEmpty(t, conn.Name)
+		assert.Equal(t, testProjectName, conn.Spec.Owner.ProjectName)
+		assert.Equal(t, testComponentName, conn.Spec.Owner.ComponentName)
+		assert.Equal(t, []string{"prod"}, conn.Spec.Environments)
+		assert.Equal(t, "api", conn.Spec.Endpoint.Component)
+
+		list, err := svc.ListConnections(ctx, testNamespace, testComponentName, services.ListOptions{})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+
+		list, err = svc.ListConnections(ctx, testNamespace, "api", services.ListOptions{})
+		require.NoError(t, err)
+		require.Empty(t, list.Items)
+	})
+
+	t.Run("connects the component to a resource", func(t *testing.T) {
+		conn, err := newService(seedObjects()...).CreateConnection(ctx, testNamespace, resourceRequest("orders-db"))
+		require.NoError(t, err)
+		assert.Equal(t, "orders-db", conn.Name)
+		assert.Equal(t, "/etc/db/password", conn.Spec.Resource.FileBindings["password"])
+	})
+
+	t.Run("requires exactly one target", func(t *testing.T) {
+		req := endpointRequest()
+		req.Resource = resourceRequest("orders-db").Resource
+		_, err := newService(seedObjects()...).CreateConnection(ctx, testNamespace, req)
+		var validationErr *services.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+
+		_, err = newService(seedObjects()...).CreateConnection(ctx, testNamespace, &CreateConnectionRequest{ComponentName: testComponentName})
+		require.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("rejects invalid endpoint visibility", func(t *testing.T) {
+		req := endpointRequest()
+		req.Endpoint.Visibility = "external"
+		_, err := newService(seedObjects()...).CreateConnection(ctx, testNamespace, req)
+		var validationErr *services.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("rejects unknown target components", func(t *testing.T) {
+		req := endpointRequest()
+		req.Endpoint.Component = "missing"
+		_, err := newService(seedObjects()...).CreateConnection(ctx, testNamespace, req)
+		require.ErrorIs(t, err, componentsvc.ErrComponentNotFound)
+
+		req = endpointRequest()
+		req.Endpoint.Project = "proj-2"
+		_, err = newService(seedObjects()...).CreateConnection(ctx, testNamespace, req)
+		require.ErrorIs(t, err, componentsvc.ErrComponentNotFound)
+	})
+
+	t.Run("rejects unknown components", func(t *testing.T) {
+		req := endpointRequest()
+		req.ComponentName = "missing"
+		_, err := newService(seedObjects()...).CreateConnection(ctx, testNamespace, req)
+		require.ErrorIs(t, err, componentsvc.ErrComponentNotFound)
+	})
+
+	t.Run("rejects unknown environments", func(t *testing.T) {
+		req := endpointRequest()
+		req.Environments = []string{"staging"}
+		_, err := newService(seedObjects()...).CreateConnection(ctx, testNamespace, req)
+		require.ErrorIs(t, err, environmentsvc.ErrEnvironmentNotFound)
+	})
+
+	t.Run("rejects resources outside the project", func(t *testing.T) {
+		_, err := newService(seedObjects()...).CreateConnection(ctx, testNamespace, resourceRequest("missing"))
+		require.ErrorIs(t, err, ErrResourceNotFound)
+
+		_, err = newService(seedObjects()...).CreateConnection(ctx, testNamespace, resourceRequest("billing-db"))
+		require.ErrorIs(t, err, ErrResourceNotInProject)
+	})
+
+	t.Run("rejects duplicate names", func(t *testing.T) {
+		svc := newService(seedObjects()...)
+		_, err := svc.CreateConnection(ctx, testNamespace, resourceRequest("orders-db"))
+		require.NoError(t, err)
+
+		_, err = svc.CreateConnection(ctx, testNamespace, resourceRequest("orders-db"))
+		require.ErrorIs(t, err, ErrConnectionExists)
+	})
+}
+
+func TestGetAndDeleteConnection(t *testing.T) {
+	ctx := testutil.AuthzContext()
+	svc := newService(seedObjects()...)
+
+	created, err := svc.CreateConnection(ctx, testNamespace, resourceRequest("orders-db"))
+	require.NoError(t, err)
+
+	got, err := svc.GetConnection(ctx, testNamespace, created.Name)
+	require.NoError(t, err)
+	assert.Equal(t, "orders-db", got.Spec.Resource.Ref)
+
+	require.NoError(t, svc.DeleteConnection(ctx, testNamespace, created.Name))
+	_, err = svc.GetConnection(ctx, testNamespace, created.Name)
+	require.ErrorIs(t, err, ErrConnectionNotFound)
+	require.ErrorIs(t, svc.DeleteConnection(ctx, testNamespace, created.Name), ErrConnectionNotFound)
+}
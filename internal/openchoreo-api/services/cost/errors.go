@@ -0,0 +1,11 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cost
+
+import "errors"
+
+var (
+	ErrCostBackendNotConfigured = errors.New("no cost backend is configured")
+	ErrCostBackendUnavailable   = errors.New("cost backend is unavailable")
+)
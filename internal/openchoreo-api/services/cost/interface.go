@@ -0,0 +1,100 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cost
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Dimensions a cost report is aggregated by.
+const (
+	AggregateProject     = "project"
+	AggregateComponent   = "component"
+	AggregateEnvironment = "environment"
+)
+
+// ReportRequest selects the data plane resources a cost report covers.
+// Resources are attributed through the OpenChoreo labels they carry.
+type ReportRequest struct {
+	NamespaceName string
+	// ProjectName, ComponentName and EnvironmentName narrow the report.
+	// Empty fields match every value.
+	ProjectName     string
+	ComponentName   string
+	EnvironmentName string
+	// Window is the OpenCost window, such as "24h", "7d" or "lastmonth".
+	// Empty reports the last 7 days.
+	Window string
+	// Aggregate is the dimension items are broken down by. Empty reports a
+	// single item for the whole selection.
+	Aggregate string
+}
+
+// CostItem is the cost of the resources of one aggregate value. The fields of
+// the dimensions the report is not aggregated by are empty, as are those of
+// costs that could not be attributed to a single value.
+type CostItem struct {
+	ProjectName     string
+	ComponentName   string
+	EnvironmentName string
+
+	CPUCost     float64
+	GPUCost     float64
+	MemoryCost  float64
+	StorageCost float64
+	NetworkCost float64
+	TotalCost   float64
+}
+
+// CostReport is the actual cost of the selected resources over a window.
+type CostReport struct {
+	Window    string
+	Start     time.Time
+	End       time.Time
+	Currency  string
+	Aggregate string
+	Items     []CostItem
+	TotalCost float64
+}
+
+// WorkloadSpec is a proposed workload to estimate. Quantities are per
+// replica.
+type WorkloadSpec struct {
+	Name     string
+	Replicas int32
+	CPU      resource.Quantity
+	Memory   resource.Quantity
+	// Storage is the size of the persistent volumes of a replica.
+	Storage resource.Quantity
+}
+
+// WorkloadEstimate is the estimated monthly cost of a workload.
+type WorkloadEstimate struct {
+	Name        string
+	CPUCost     float64
+	MemoryCost  float64
+	StorageCost float64
+	MonthlyCost float64
+}
+
+// CostEstimate is the estimated monthly cost of a proposed deployment.
+type CostEstimate struct {
+	Currency    string
+	Items       []WorkloadEstimate
+	MonthlyCost float64
+}
+
+// Service attributes data plane costs to namespaces, projects and components,
+// and estimates the cost of deployments before they are applied.
+type Service interface {
+	// GetCostReport returns the actual cost of the selected resources, as
+	// measured by OpenCost.
+	GetCostReport(ctx context.Context, req *ReportRequest) (*CostReport, error)
+	// EstimateCost returns the monthly cost of running the workloads with the
+	// configured prices.
+	EstimateCost(ctx context.Context, namespaceName string, workloads []WorkloadSpec) (*CostEstimate, error)
+}
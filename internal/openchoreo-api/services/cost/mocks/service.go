@@ -0,0 +1,157 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	cost "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/cost"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// EstimateCost provides a mock function with given fields: ctx, namespaceName, workloads
+func (_m *MockService) EstimateCost(ctx context.Context, namespaceName string, workloads []cost.WorkloadSpec) (*cost.CostEstimate, error) {
+	ret := _m.Called(ctx, namespaceName, workloads)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EstimateCost")
+	}
+
+	var r0 *cost.CostEstimate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []cost.WorkloadSpec) (*cost.CostEstimate, error)); ok {
+		return rf(ctx, namespaceName, workloads)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []cost.WorkloadSpec) *cost.CostEstimate); ok {
+		r0 = rf(ctx, namespaceName, workloads)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cost.CostEstimate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []cost.WorkloadSpec) error); ok {
+		r1 = rf(ctx, namespaceName, workloads)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_EstimateCost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EstimateCost'
+type MockService_EstimateCost_Call struct {
+	*mock.Call
+}
+
+// EstimateCost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - workloads []cost.WorkloadSpec
+func (_e *MockService_Expecter) EstimateCost(ctx interface{}, namespaceName interface{}, workloads interface{}) *MockService_EstimateCost_Call {
+	return &MockService_EstimateCost_Call{Call: _e.mock.On("EstimateCost", ctx, namespaceName, workloads)}
+}
+
+func (_c *MockService_EstimateCost_Call) Run(run func(ctx context.Context, namespaceName string, workloads []cost.WorkloadSpec)) *MockService_EstimateCost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]cost.WorkloadSpec))
+	})
+	return _c
+}
+
+func (_c *MockService_EstimateCost_Call) Return(_a0 *cost.CostEstimate, _a1 error) *MockService_EstimateCost_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_EstimateCost_Call) RunAndReturn(run func(context.Context, string, []cost.WorkloadSpec) (*cost.CostEstimate, error)) *MockService_EstimateCost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCostReport provides a mock function with given fields: ctx, req
+func (_m *MockService) GetCostReport(ctx context.Context, req *cost.ReportRequest) (*cost.CostReport, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCostReport")
+	}
+
+	var r0 *cost.CostReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cost.ReportRequest) (*cost.CostReport, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *cost.ReportRequest) *cost.CostReport); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cost.CostReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *cost.ReportRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetCostReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCostReport'
+type MockService_GetCostReport_Call struct {
+	*mock.Call
+}
+
+// GetCostReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *cost.ReportRequest
+func (_e *MockService_Expecter) GetCostReport(ctx interface{}, req interface{}) *MockService_GetCostReport_Call {
+	return &MockService_GetCostReport_Call{Call: _e.mock.On("GetCostReport", ctx, req)}
+}
+
+func (_c *MockService_GetCostReport_Call) Run(run func(ctx context.Context, req *cost.ReportRequest)) *MockService_GetCostReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*cost.ReportRequest))
+	})
+	return _c
+}
+
+func (_c *MockService_GetCostReport_Call) Return(_a0 *cost.CostReport, _a1 error) *MockService_GetCostReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetCostReport_Call) RunAndReturn(run func(context.Context, *cost.ReportRequest) (*cost.CostReport, error)) *MockService_GetCostReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
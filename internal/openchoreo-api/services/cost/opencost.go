@@ -0,0 +1,117 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cost
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Keys OpenCost reports costs under when they cannot be attributed.
+const (
+	unallocatedKey = "__unallocated__"
+	idleKey        = "__idle__"
+)
+
+// maxErrorBody caps how much of an error response is included in errors.
+const maxErrorBody = 512
+
+// allocation is the subset of an OpenCost allocation the service reports.
+type allocation struct {
+	Start            time.Time `json:"start"`
+	End              time.Time `json:"end"`
+	CPUCost          float64   `json:"cpuCost"`
+	GPUCost          float64   `json:"gpuCost"`
+	RAMCost          float64   `json:"ramCost"`
+	PVCost           float64   `json:"pvCost"`
+	NetworkCost      float64   `json:"networkCost"`
+	LoadBalancerCost float64   `json:"loadBalancerCost"`
+	TotalCost        float64   `json:"totalCost"`
+}
+
+type allocationResponse struct {
+	Code    int                     `json:"code"`
+	Message string                  `json:"message"`
+	Data    []map[string]allocation `json:"data"`
+}
+
+// openCostClient queries the allocation API of OpenCost.
+type openCostClient struct {
+	baseURL string
+	client  *http.Client
+}
+
+// allocations returns the costs of the window accumulated into a single set,
+// keyed by the aggregate labels joined with "/".
+func (c *openCostClient) allocations(ctx context.Context, window string, aggregate []string, filter string) (map[string]allocation, error) {
+	params := url.Values{}
+	params.Set("window", window)
+	params.Set("accumulate", "true")
+	params.Set("includeIdle", "false")
+	if len(aggregate) > 0 {
+		params.Set("aggregate", strings.Join(aggregate, ","))
+	}
+	if filter != "" {
+		params.Set("filter", filter)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/allocation/compute?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCostBackendUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return nil, fmt.Errorf("%w: OpenCost responded with status %d: %s",
+			ErrCostBackendUnavailable, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var decoded allocationResponse
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("%w: failed to decode OpenCost response: %w", ErrCostBackendUnavailable, err)
+	}
+	if decoded.Code != 0 && decoded.Code != http.StatusOK {
+		return nil, fmt.Errorf("%w: OpenCost returned code %d: %s", ErrCostBackendUnavailable, decoded.Code, decoded.Message)
+	}
+
+	result := make(map[string]allocation)
+	for _, set := range decoded.Data {
+		for key, a := range set {
+			if key == idleKey {
+				continue
+			}
+			result[key] = a
+		}
+	}
+	return result, nil
+}
+
+// labelName returns the name OpenCost exposes a Kubernetes label under, which
+// is the Prometheus-sanitized form of the label key.
+func labelName(key string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' {
+			return r
+		}
+		return '_'
+	}, key)
+}
+
+// labelFilter returns an OpenCost filter clause matching a label value.
+func labelFilter(key, value string) string {
+	return fmt.Sprintf("label[%s]:%q", labelName(key), value)
+}
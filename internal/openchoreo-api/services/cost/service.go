@@ -0,0 +1,270 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cost
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// hoursPerMonth is the average number of hours in a month, as used by cloud
+// providers to price on-demand resources.
+const hoursPerMonth = 730
+
+// defaultWindow is the window of reports requested without one.
+const defaultWindow = "7d"
+
+// windowPattern matches the relative windows and keywords OpenCost accepts.
+var windowPattern = regexp.MustCompile(`^([1-9][0-9]*[mhd]|today|yesterday|week|month|lastweek|lastmonth)$`)
+
+// Pricing holds the unit prices estimates are computed with. They should
+// match the prices OpenCost is configured with, so that estimates and actual
+// costs are comparable.
+type Pricing struct {
+	Currency        string
+	CPUCoreHour     float64
+	MemoryGiBHour   float64
+	StorageGiBMonth float64
+}
+
+// Options configures the cost service.
+type Options struct {
+	// OpenCostURL is the base URL of the OpenCost API. Empty disables cost
+	// reports; estimates only need the pricing.
+	OpenCostURL string
+	// Timeout bounds each OpenCost query.
+	Timeout time.Duration
+	Pricing Pricing
+}
+
+// costService reports actual costs from OpenCost and estimates costs from
+// the configured pricing.
+type costService struct {
+	openCost *openCostClient
+	pricing  Pricing
+	logger   *slog.Logger
+}
+
+var _ Service = (*costService)(nil)
+
+// NewService creates a new cost service.
+func NewService(opts Options, logger *slog.Logger) Service {
+	s := &costService{
+		pricing: opts.Pricing,
+		logger:  logger,
+	}
+	if opts.OpenCostURL != "" {
+		s.openCost = &openCostClient{
+			baseURL: strings.TrimSuffix(opts.OpenCostURL, "/"),
+			client:  &http.Client{Timeout: opts.Timeout},
+		}
+	}
+	return s
+}
+
+func (s *costService) GetCostReport(ctx context.Context, req *ReportRequest) (*CostReport, error) {
+	if s.openCost == nil {
+		return nil, ErrCostBackendNotConfigured
+	}
+	if err := validateReportRequest(req); err != nil {
+		return nil, err
+	}
+	window := req.Window
+	if window == "" {
+		window = defaultWindow
+	}
+
+	filter := []string{labelFilter(labels.LabelKeyNamespaceName, req.NamespaceName)}
+	if req.ProjectName != "" {
+		filter = append(filter, labelFilter(labels.LabelKeyProjectName, req.ProjectName))
+	}
+	if req.ComponentName != "" {
+		filter = append(filter, labelFilter(labels.LabelKeyComponentName, req.ComponentName))
+	}
+	if req.EnvironmentName != "" {
+		filter = append(filter, labelFilter(labels.LabelKeyEnvironmentName, req.EnvironmentName))
+	}
+
+	dimensions := aggregateLabels(req.Aggregate)
+	aggregate := make([]string, len(dimensions))
+	for i, key := range dimensions {
+		aggregate[i] = "label:" + labelName(key)
+	}
+
+	allocations, err := s.openCost.allocations(ctx, window, aggregate, strings.Join(filter, "+"))
+	if err != nil {
+		s.logger.Error("Failed to query OpenCost", "namespace", req.NamespaceName, "window", window, "error", err)
+		return nil, err
+	}
+
+	report := &CostReport{
+		Window:    window,
+		Currency:  s.pricing.Currency,
+		Aggregate: req.Aggregate,
+		Items:     make([]CostItem, 0, len(allocations)),
+	}
+	for key, a := range allocations {
+		item := CostItem{
+			CPUCost:     a.CPUCost,
+			GPUCost:     a.GPUCost,
+			MemoryCost:  a.RAMCost,
+			StorageCost: a.PVCost,
+			NetworkCost: a.NetworkCost + a.LoadBalancerCost,
+			TotalCost:   a.TotalCost,
+		}
+		if len(dimensions) > 0 {
+			setDimensions(&item, dimensions, strings.Split(key, "/"))
+		}
+		report.Items = append(report.Items, item)
+		report.TotalCost += a.TotalCost
+		if report.Start.IsZero() || a.Start.Before(report.Start) {
+			report.Start = a.Start
+		}
+		if a.End.After(report.End) {
+			report.End = a.End
+		}
+	}
+	sortItems(report.Items)
+	return report, nil
+}
+
+func (s *costService) EstimateCost(_ context.Context, _ string, workloads []WorkloadSpec) (*CostEstimate, error) {
+	if err := validateWorkloads(workloads); err != nil {
+		return nil, err
+	}
+
+	estimate := &CostEstimate{
+		Currency: s.pricing.Currency,
+		Items:    make([]WorkloadEstimate, 0, len(workloads)),
+	}
+	for _, w := range workloads {
+		replicas := float64(w.Replicas)
+		item := WorkloadEstimate{
+			Name:        w.Name,
+			CPUCost:     roundCents(replicas * w.CPU.AsApproximateFloat64() * s.pricing.CPUCoreHour * hoursPerMonth),
+			MemoryCost:  roundCents(replicas * gib(w.Memory.AsApproximateFloat64()) * s.pricing.MemoryGiBHour * hoursPerMonth),
+			StorageCost: roundCents(replicas * gib(w.Storage.AsApproximateFloat64()) * s.pricing.StorageGiBMonth),
+		}
+		item.MonthlyCost = roundCents(item.CPUCost + item.MemoryCost + item.StorageCost)
+		estimate.Items = append(estimate.Items, item)
+		estimate.MonthlyCost += item.MonthlyCost
+	}
+	estimate.MonthlyCost = roundCents(estimate.MonthlyCost)
+	return estimate, nil
+}
+
+// aggregateLabels returns the labels a report aggregate groups costs by.
+// Components are grouped with their project, as component names are only
+// meaningful within one.
+func aggregateLabels(aggregate string) []string {
+	switch aggregate {
+	case AggregateProject:
+		return []string{labels.LabelKeyProjectName}
+	case AggregateComponent:
+		return []string{labels.LabelKeyProjectName, labels.LabelKeyComponentName}
+	case AggregateEnvironment:
+		return []string{labels.LabelKeyEnvironmentName}
+	}
+	return nil
+}
+
+// setDimensions sets the fields of the aggregate labels from the parts of an
+// OpenCost allocation key. Unattributed parts are left empty.
+func setDimensions(item *CostItem, dimensions, values []string) {
+	for i, key := range dimensions {
+		if i >= len(values) || values[i] == unallocatedKey {
+			continue
+		}
+		switch key {
+		case labels.LabelKeyProjectName:
+			item.ProjectName = values[i]
+		case labels.LabelKeyComponentName:
+			item.ComponentName = values[i]
+		case labels.LabelKeyEnvironmentName:
+			item.EnvironmentName = values[i]
+		}
+	}
+}
+
+// sortItems orders items by their dimensions, with unattributed costs last.
+func sortItems(items []CostItem) {
+	key := func(item CostItem) []string {
+		return []string{item.ProjectName, item.ComponentName, item.EnvironmentName}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		a, b := key(items[i]), key(items[j])
+		for k := range a {
+			if a[k] == b[k] {
+				continue
+			}
+			if a[k] == "" || b[k] == "" {
+				return b[k] == ""
+			}
+			return a[k] < b[k]
+		}
+		return false
+	})
+}
+
+func validateReportRequest(req *ReportRequest) error {
+	if req.ComponentName != "" && req.ProjectName == "" {
+		return &services.ValidationError{Msg: "project is required to select a component"}
+	}
+	if req.Window != "" && !windowPattern.MatchString(req.Window) {
+		return &services.ValidationError{Msg: fmt.Sprintf(
+			"invalid window %q, must be a duration such as 24h or 7d, or one of today, yesterday, week, month, lastweek or lastmonth", req.Window)}
+	}
+	if req.Aggregate != "" && aggregateLabels(req.Aggregate) == nil {
+		return &services.ValidationError{Msg: fmt.Sprintf(
+			"invalid aggregate %q, must be one of %s, %s or %s", req.Aggregate, AggregateProject, AggregateComponent, AggregateEnvironment)}
+	}
+	return nil
+}
+
+func validateWorkloads(workloads []WorkloadSpec) error {
+	if len(workloads) == 0 {
+		return &services.ValidationError{Msg: "at least one workload is required"}
+	}
+	names := make(map[string]bool, len(workloads))
+	for i, w := range workloads {
+		if w.Name == "" {
+			return &services.ValidationError{Msg: fmt.Sprintf("workloads[%d]: name is required", i)}
+		}
+		if names[w.Name] {
+			return &services.ValidationError{Msg: fmt.Sprintf("workloads[%d]: duplicate name %q", i, w.Name)}
+		}
+		names[w.Name] = true
+		if w.Replicas < 0 {
+			return &services.ValidationError{Msg: fmt.Sprintf("workloads[%d]: replicas must not be negative", i)}
+		}
+		switch {
+		case w.CPU.Sign() < 0:
+			return &services.ValidationError{Msg: fmt.Sprintf("workloads[%d]: cpu must not be negative", i)}
+		case w.Memory.Sign() < 0:
+			return &services.ValidationError{Msg: fmt.Sprintf("workloads[%d]: memory must not be negative", i)}
+		case w.Storage.Sign() < 0:
+			return &services.ValidationError{Msg: fmt.Sprintf("workloads[%d]: storage must not be negative", i)}
+		}
+	}
+	return nil
+}
+
+// gib converts bytes to GiB.
+func gib(bytes float64) float64 {
+	return bytes / (1 << 30)
+}
+
+func roundCents(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cost
+
+import (
+	"context"
+	"log/slog"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+const (
+	resourceTypeNamespace   = "namespace"
+	resourceTypeProject     = "project"
+	resourceTypeComponent   = "component"
+	resourceTypeEnvironment = "environment"
+)
+
+// costServiceWithAuthz wraps a Service and adds authorization checks. Costs
+// are visible to those who may view the resources they are attributed to.
+type costServiceWithAuthz struct {
+	internal Service
+	authz    *services.AuthzChecker
+}
+
+var _ Service = (*costServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates a cost service with authorization checks.
+func NewServiceWithAuthz(opts Options, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &costServiceWithAuthz{
+		internal: NewService(opts, logger),
+		authz:    services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+// GetCostReport requires permission to view the selected namespace, project
+// or component. Items of projects, components or environments the caller may
+// not view are left out of the report.
+func (s *costServiceWithAuthz) GetCostReport(ctx context.Context, req *ReportRequest) (*CostReport, error) {
+	if err := s.authz.Check(ctx, scopeCheck(req)); err != nil {
+		return nil, err
+	}
+	report, err := s.internal.GetCostReport(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var requests []services.CheckRequest
+	var indexes []int
+	for i, item := range report.Items {
+		if check, ok := itemCheck(req, item); ok {
+			requests = append(requests, check)
+			indexes = append(indexes, i)
+		}
+	}
+	if len(requests) == 0 {
+		return report, nil
+	}
+	decisions, err := s.authz.BatchCheck(ctx, requests)
+	if err != nil {
+		return nil, err
+	}
+	denied := make(map[int]bool)
+	for j, i := range indexes {
+		if j >= len(decisions) || !decisions[j] {
+			denied[i] = true
+		}
+	}
+
+	allowed := make([]CostItem, 0, len(report.Items))
+	report.TotalCost = 0
+	for i, item := range report.Items {
+		if denied[i] {
+			continue
+		}
+		allowed = append(allowed, item)
+		report.TotalCost += item.TotalCost
+	}
+	report.Items = allowed
+	return report, nil
+}
+
+// EstimateCost requires permission to view the namespace the workloads would
+// be deployed to.
+func (s *costServiceWithAuthz) EstimateCost(ctx context.Context, namespaceName string, workloads []WorkloadSpec) (*CostEstimate, error) {
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewNamespace,
+		ResourceType: resourceTypeNamespace,
+		ResourceID:   namespaceName,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.EstimateCost(ctx, namespaceName, workloads)
+}
+
+// scopeCheck returns the check of the narrowest resource a report selects.
+func scopeCheck(req *ReportRequest) services.CheckRequest {
+	switch {
+	case req.ComponentName != "":
+		return services.CheckRequest{
+			Action:       authz.ActionViewComponent,
+			ResourceType: resourceTypeComponent,
+			ResourceID:   req.ComponentName,
+			Hierarchy: authz.ResourceHierarchy{
+				Namespace: req.NamespaceName,
+				Project:   req.ProjectName,
+				Component: req.ComponentName,
+			},
+		}
+	case req.ProjectName != "":
+		return services.CheckRequest{
+			Action:       authz.ActionViewProject,
+			ResourceType: resourceTypeProject,
+			ResourceID:   req.ProjectName,
+			Hierarchy:    authz.ResourceHierarchy{Namespace: req.NamespaceName, Project: req.ProjectName},
+		}
+	}
+	return services.CheckRequest{
+		Action:       authz.ActionViewNamespace,
+		ResourceType: resourceTypeNamespace,
+		ResourceID:   req.NamespaceName,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: req.NamespaceName},
+	}
+}
+
+// itemCheck returns the check of the resource an item is attributed to, if it
+// is narrower than the scope of the report. Unattributed costs are covered by
+// the scope check.
+func itemCheck(req *ReportRequest, item CostItem) (services.CheckRequest, bool) {
+	switch {
+	case item.ComponentName != "" && req.ComponentName == "":
+		return services.CheckRequest{
+			Action:       authz.ActionViewComponent,
+			ResourceType: resourceTypeComponent,
+			ResourceID:   item.ComponentName,
+			Hierarchy: authz.ResourceHierarchy{
+				Namespace: req.NamespaceName,
+				Project:   item.ProjectName,
+				Component: item.ComponentName,
+			},
+		}, true
+	case item.ProjectName != "" && req.ProjectName == "":
+		return services.CheckRequest{
+			Action:       authz.ActionViewProject,
+			ResourceType: resourceTypeProject,
+			ResourceID:   item.ProjectName,
+			Hierarchy:    authz.ResourceHierarchy{Namespace: req.NamespaceName, Project: item.ProjectName},
+		}, true
+	case item.EnvironmentName != "" && req.EnvironmentName == "":
+		return services.CheckRequest{
+			Action:       authz.ActionViewEnvironment,
+			ResourceType: resourceTypeEnvironment,
+			ResourceID:   item.EnvironmentName,
+			Hierarchy:    authz.ResourceHierarchy{Namespace: req.NamespaceName},
+		}, true
+	}
+	return services.CheckRequest{}, false
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cost
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+// denyComponentPDP allows every request except viewing the named component.
+type denyComponentPDP struct {
+	*testutil.CapturingPDP
+	component string
+}
+
+func (p *denyComponentPDP) BatchEvaluate(ctx context.Context, req *authzcore.BatchEvaluateRequest) (*authzcore.BatchEvaluateResponse, error) {
+	resp, err := p.CapturingPDP.BatchEvaluate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for i, r := range req.Requests {
+		if r.Resource.Type == "component" && r.Resource.ID == p.component {
+			resp.Decisions[i].Decision = false
+		}
+	}
+	return resp, nil
+}
+
+func newAuthzService(pdp authzcore.PDP, openCostURL string) Service {
+	return &costServiceWithAuthz{
+		internal: newService(openCostURL),
+		authz:    testutil.NewTestAuthzChecker(pdp),
+	}
+}
+
+func TestGetCostReport_AuthzCheck(t *testing.T) {
+	srv, _ := newOpenCost(t, http.StatusOK, componentAllocations)
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		report, err := newAuthzService(pdp, srv.URL).GetCostReport(testutil.AuthzContext(), &ReportRequest{
+			NamespaceName: testNamespace,
+			Aggregate:     AggregateComponent,
+		})
+		require.NoError(t, err)
+		assert.Len(t, report.Items, 3)
+		// The namespace scope, the two components and the project of the cost
+		// not attributed to a component.
+		require.Len(t, pdp.Captured, 4)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "namespace:view", "namespace", testNamespace,
+			authzcore.ResourceHierarchy{Namespace: testNamespace})
+		testutil.RequireEvalRequest(t, pdp.Captured[1], "component:view", "component", "worker",
+			authzcore.ResourceHierarchy{Namespace: testNamespace, Project: "reports", Component: "worker"})
+		testutil.RequireEvalRequest(t, pdp.Captured[3], "project:view", "project", "shop",
+			authzcore.ResourceHierarchy{Namespace: testNamespace, Project: "shop"})
+	})
+
+	t.Run("project scope", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		_, err := newAuthzService(pdp, srv.URL).GetCostReport(testutil.AuthzContext(), &ReportRequest{
+			NamespaceName: testNamespace,
+			ProjectName:   "shop",
+		})
+		require.NoError(t, err)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "project:view", "project", "shop",
+			authzcore.ResourceHierarchy{Namespace: testNamespace, Project: "shop"})
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		_, err := newAuthzService(testutil.DenyPDP(), srv.URL).GetCostReport(testutil.AuthzContext(), &ReportRequest{
+			NamespaceName: testNamespace,
+		})
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestFilterCostItems(t *testing.T) {
+	srv, _ := newOpenCost(t, http.StatusOK, componentAllocations)
+	pdp := &denyComponentPDP{CapturingPDP: testutil.AllowPDP(), component: "worker"}
+
+	report, err := newAuthzService(pdp, srv.URL).GetCostReport(testutil.AuthzContext(), &ReportRequest{
+		NamespaceName: testNamespace,
+		Aggregate:     AggregateComponent,
+	})
+	require.NoError(t, err)
+	require.Len(t, report.Items, 2)
+	assert.Equal(t, "api", report.Items[0].ComponentName)
+	assert.Empty(t, report.Items[1].ComponentName)
+	assert.InDelta(t, 3.0, report.TotalCost, 1e-9)
+}
+
+func TestEstimateCost_AuthzCheck(t *testing.T) {
+	workloads := []WorkloadSpec{{Name: "api", Replicas: 1}}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		_, err := newAuthzService(pdp, "").EstimateCost(testutil.AuthzContext(), testNamespace, workloads)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "namespace:view", "namespace", testNamespace,
+			authzcore.ResourceHierarchy{Namespace: testNamespace})
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		_, err := newAuthzService(testutil.DenyPDP(), "").EstimateCost(testutil.AuthzContext(), testNamespace, workloads)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
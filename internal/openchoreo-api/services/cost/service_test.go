@@ -0,0 +1,234 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cost
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+const testNamespace = "test-ns"
+
+var testPricing = Pricing{
+	Currency:        "USD",
+	CPUCoreHour:     0.04,
+	MemoryGiBHour:   0.005,
+	StorageGiBMonth: 0.1,
+}
+
+// componentAllocations is an OpenCost response aggregated by project and
+// component, with a cost of the shop project not attributed to a component.
+const componentAllocations = `{
+  "code": 200,
+  "data": [{
+    "shop/api": {
+      "name": "shop/api",
+      "start": "2026-10-10T00:00:00Z",
+      "end": "2026-10-17T00:00:00Z",
+      "cpuCost": 1.5, "gpuCost": 0, "ramCost": 0.5, "pvCost": 0.25,
+      "networkCost": 0.1, "loadBalancerCost": 0.4, "totalCost": 2.75
+    },
+    "shop/__unallocated__": {
+      "name": "shop/__unallocated__",
+      "start": "2026-10-10T00:00:00Z",
+      "end": "2026-10-17T00:00:00Z",
+      "cpuCost": 0.2, "ramCost": 0.05, "totalCost": 0.25
+    },
+    "reports/worker": {
+      "name": "reports/worker",
+      "start": "2026-10-10T00:00:00Z",
+      "end": "2026-10-17T00:00:00Z",
+      "cpuCost": 1, "ramCost": 1, "totalCost": 2
+    },
+    "__idle__": {
+      "name": "__idle__",
+      "cpuCost": 10, "totalCost": 10
+    }
+  }]
+}`
+
+// newOpenCost starts a fake OpenCost server that responds with body and
+// records the query of the last request.
+func newOpenCost(t *testing.T, status int, body string) (*httptest.Server, *url.Values) {
+	t.Helper()
+	var query url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/allocation/compute", r.URL.Path)
+		query = r.URL.Query()
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &query
+}
+
+func newService(openCostURL string) Service {
+	return NewService(Options{OpenCostURL: openCostURL, Timeout: 5 * time.Second, Pricing: testPricing}, testutil.TestLogger())
+}
+
+func TestGetCostReport(t *testing.T) {
+	srv, query := newOpenCost(t, http.StatusOK, componentAllocations)
+
+	report, err := newService(srv.URL+"/").GetCostReport(t.Context(), &ReportRequest{
+		NamespaceName:   testNamespace,
+		EnvironmentName: "prod",
+		Window:          "lastweek",
+		Aggregate:       AggregateComponent,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "lastweek", query.Get("window"))
+	assert.Equal(t, "true", query.Get("accumulate"))
+	assert.Equal(t, "label:openchoreo_dev_project,label:openchoreo_dev_component", query.Get("aggregate"))
+	assert.Equal(t, `label[openchoreo_dev_namespace]:"test-ns"+label[openchoreo_dev_environment]:"prod"`, query.Get("filter"))
+
+	assert.Equal(t, "USD", report.Currency)
+	assert.Equal(t, AggregateComponent, report.Aggregate)
+	assert.Equal(t, time.Date(2026, 10, 10, 0, 0, 0, 0, time.UTC), report.Start)
+	assert.Equal(t, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC), report.End)
+	assert.InDelta(t, 5.0, report.TotalCost, 1e-9)
+	assert.Equal(t, []CostItem{
+		{ProjectName: "reports", ComponentName: "worker", CPUCost: 1, MemoryCost: 1, TotalCost: 2},
+		{ProjectName: "shop", ComponentName: "api", CPUCost: 1.5, MemoryCost: 0.5, StorageCost: 0.25, NetworkCost: 0.5, TotalCost: 2.75},
+		{ProjectName: "shop", CPUCost: 0.2, MemoryCost: 0.05, TotalCost: 0.25},
+	}, report.Items)
+}
+
+func TestGetCostReport_DefaultsAndScope(t *testing.T) {
+	srv, query := newOpenCost(t, http.StatusOK, `{"code":200,"data":[{"__unallocated__":{"totalCost":3}}]}`)
+
+	report, err := newService(srv.URL).GetCostReport(t.Context(), &ReportRequest{
+		NamespaceName: testNamespace,
+		ProjectName:   "shop",
+		ComponentName: "api",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, defaultWindow, query.Get("window"))
+	assert.Empty(t, query.Get("aggregate"))
+	assert.Equal(t,
+		`label[openchoreo_dev_namespace]:"test-ns"+label[openchoreo_dev_project]:"shop"+label[openchoreo_dev_component]:"api"`,
+		query.Get("filter"))
+	require.Len(t, report.Items, 1)
+	assert.InDelta(t, 3.0, report.TotalCost, 1e-9)
+}
+
+func TestGetCostReport_Errors(t *testing.T) {
+	t.Run("not configured", func(t *testing.T) {
+		_, err := newService("").GetCostReport(t.Context(), &ReportRequest{NamespaceName: testNamespace})
+		require.ErrorIs(t, err, ErrCostBackendNotConfigured)
+	})
+
+	t.Run("backend error", func(t *testing.T) {
+		srv, _ := newOpenCost(t, http.StatusInternalServerError, "boom")
+		_, err := newService(srv.URL).GetCostReport(t.Context(), &ReportRequest{NamespaceName: testNamespace})
+		require.ErrorIs(t, err, ErrCostBackendUnavailable)
+		assert.Contains(t, err.Error(), "status 500: boom")
+	})
+
+	t.Run("backend error code", func(t *testing.T) {
+		srv, _ := newOpenCost(t, http.StatusOK, `{"code":400,"message":"bad window"}`)
+		_, err := newService(srv.URL).GetCostReport(t.Context(), &ReportRequest{NamespaceName: testNamespace})
+		require.ErrorIs(t, err, ErrCostBackendUnavailable)
+	})
+
+	validation := []struct {
+		name    string
+		req     ReportRequest
+		wantErr string
+	}{
+		{
+			name:    "component without project",
+			req:     ReportRequest{ComponentName: "api"},
+			wantErr: "project is required to select a component",
+		},
+		{
+			name:    "invalid window",
+			req:     ReportRequest{Window: "forever"},
+			wantErr: `invalid window "forever", must be a duration such as 24h or 7d, or one of today, yesterday, week, month, lastweek or lastmonth`,
+		},
+		{
+			name:    "invalid aggregate",
+			req:     ReportRequest{Aggregate: "pod"},
+			wantErr: `invalid aggregate "pod", must be one of project, component or environment`,
+		},
+	}
+	for _, tt := range validation {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.NamespaceName = testNamespace
+			_, err := newService("http://opencost.invalid").GetCostReport(t.Context(), &tt.req)
+			var validationErr *services.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.wantErr, validationErr.Msg)
+		})
+	}
+}
+
+func TestEstimateCost(t *testing.T) {
+	estimate, err := newService("").EstimateCost(t.Context(), testNamespace, []WorkloadSpec{
+		{
+			Name:     "api",
+			Replicas: 3,
+			CPU:      resource.MustParse("500m"),
+			Memory:   resource.MustParse("1Gi"),
+			Storage:  resource.MustParse("10Gi"),
+		},
+		{Name: "worker", Replicas: 1, CPU: resource.MustParse("2")},
+		{Name: "paused", Replicas: 0, CPU: resource.MustParse("4")},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "USD", estimate.Currency)
+	assert.Equal(t, []WorkloadEstimate{
+		// 1.5 cores * 0.04 * 730, 3 GiB * 0.005 * 730 and 30 GiB * 0.1.
+		{Name: "api", CPUCost: 43.8, MemoryCost: 10.95, StorageCost: 3, MonthlyCost: 57.75},
+		{Name: "worker", CPUCost: 58.4, MonthlyCost: 58.4},
+		{Name: "paused"},
+	}, estimate.Items)
+	assert.InDelta(t, 116.15, estimate.MonthlyCost, 1e-9)
+}
+
+func TestEstimateCost_Validation(t *testing.T) {
+	tests := []struct {
+		name      string
+		workloads []WorkloadSpec
+		wantErr   string
+	}{
+		{name: "empty", wantErr: "at least one workload is required"},
+		{name: "no name", workloads: []WorkloadSpec{{Replicas: 1}}, wantErr: "workloads[0]: name is required"},
+		{
+			name:      "duplicate",
+			workloads: []WorkloadSpec{{Name: "api"}, {Name: "api"}},
+			wantErr:   `workloads[1]: duplicate name "api"`,
+		},
+		{
+			name:      "negative replicas",
+			workloads: []WorkloadSpec{{Name: "api", Replicas: -1}},
+			wantErr:   "workloads[0]: replicas must not be negative",
+		},
+		{
+			name:      "negative memory",
+			workloads: []WorkloadSpec{{Name: "api", Memory: resource.MustParse("-1Gi")}},
+			wantErr:   "workloads[0]: memory must not be negative",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newService("").EstimateCost(t.Context(), testNamespace, tt.workloads)
+			var validationErr *services.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.wantErr, validationErr.Msg)
+		})
+	}
+}
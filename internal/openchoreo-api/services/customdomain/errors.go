@@ -0,0 +1,14 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customdomain
+
+import "errors"
+
+var (
+	ErrCustomDomainNotFound = errors.New("custom domain not found")
+	ErrWorkloadNotFound     = errors.New("component has no workload")
+	ErrEndpointNotFound     = errors.New("endpoint not found")
+	ErrEndpointNotExternal  = errors.New("endpoint does not have external visibility")
+	ErrDomainAlreadyBound   = errors.New("domain is already bound in this namespace")
+)
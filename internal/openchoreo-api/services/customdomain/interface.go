@@ -0,0 +1,33 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customdomain
+
+import (
+	"context"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// CreateCustomDomainRequest contains the parameters for binding a custom domain to an endpoint.
+type CreateCustomDomainRequest struct {
+	ComponentName string
+	Environment   string
+	Endpoint      string
+	Domain        string
+}
+
+// Service defines the custom domain service interface.
+// Both the core service (no authz) and the authz-wrapped service implement this.
+type Service interface {
+	// CreateCustomDomain binds a domain to an externally visible endpoint of a component in an
+	// environment. The returned CustomDomain reports the DNS records to create once the
+	// controller has reconciled it.
+	CreateCustomDomain(ctx context.Context, namespaceName string, req *CreateCustomDomainRequest) (*openchoreov1alpha1.CustomDomain, error)
+	// ListCustomDomains lists custom domains, optionally filtered by component.
+	ListCustomDomains(ctx context.Context, namespaceName, componentName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.CustomDomain], error)
+	GetCustomDomain(ctx context.Context, namespaceName, customDomainName string) (*openchoreov1alpha1.CustomDomain, error)
+	// DeleteCustomDomain removes the domain from the endpoint's routes and certificates.
+	DeleteCustomDomain(ctx context.Context, namespaceName, customDomainName string) error
+}
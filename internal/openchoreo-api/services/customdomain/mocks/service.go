@@ -0,0 +1,270 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	customdomain "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/customdomain"
+	mock "github.com/stretchr/testify/mock"
+
+	services "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+
+	v1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// CreateCustomDomain provides a mock function with given fields: ctx, namespaceName, req
+func (_m *MockService) CreateCustomDomain(ctx context.Context, namespaceName string, req *customdomain.CreateCustomDomainRequest) (*v1alpha1.CustomDomain, error) {
+	ret := _m.Called(ctx, namespaceName, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCustomDomain")
+	}
+
+	var r0 *v1alpha1.CustomDomain
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *customdomain.CreateCustomDomainRequest) (*v1alpha1.CustomDomain, error)); ok {
+		return rf(ctx, namespaceName, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *customdomain.CreateCustomDomainRequest) *v1alpha1.CustomDomain); ok {
+		r0 = rf(ctx, namespaceName, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.CustomDomain)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *customdomain.CreateCustomDomainRequest) error); ok {
+		r1 = rf(ctx, namespaceName, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_CreateCustomDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCustomDomain'
+type MockService_CreateCustomDomain_Call struct {
+	*mock.Call
+}
+
+// CreateCustomDomain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - req *customdomain.CreateCustomDomainRequest
+func (_e *MockService_Expecter) CreateCustomDomain(ctx interface{}, namespaceName interface{}, req interface{}) *MockService_CreateCustomDomain_Call {
+	return &MockService_CreateCustomDomain_Call{Call: _e.mock.On("CreateCustomDomain", ctx, namespaceName, req)}
+}
+
+func (_c *MockService_CreateCustomDomain_Call) Run(run func(ctx context.Context, namespaceName string, req *customdomain.CreateCustomDomainRequest)) *MockService_CreateCustomDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*customdomain.CreateCustomDomainRequest))
+	})
+	return _c
+}
+
+func (_c *MockService_CreateCustomDomain_Call) Return(_a0 *v1alpha1.CustomDomain, _a1 error) *MockService_CreateCustomDomain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_CreateCustomDomain_Call) RunAndReturn(run func(context.Context, string, *customdomain.CreateCustomDomainRequest) (*v1alpha1.CustomDomain, error)) *MockService_CreateCustomDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCustomDomain provides a mock function with given fields: ctx, namespaceName, customDomainName
+func (_m *MockService) DeleteCustomDomain(ctx context.Context, namespaceName string, customDomainName string) error {
+	ret := _m.Called(ctx, namespaceName, customDomainName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCustomDomain")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, namespaceName, customDomainName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_DeleteCustomDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCustomDomain'
+type MockService_DeleteCustomDomain_Call struct {
+	*mock.Call
+}
+
+// DeleteCustomDomain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - customDomainName string
+func (_e *MockService_Expecter) DeleteCustomDomain(ctx interface{}, namespaceName interface{}, customDomainName interface{}) *MockService_DeleteCustomDomain_Call {
+	return &MockService_DeleteCustomDomain_Call{Call: _e.mock.On("DeleteCustomDomain", ctx, namespaceName, customDomainName)}
+}
+
+func (_c *MockService_DeleteCustomDomain_Call) Run(run func(ctx context.Context, namespaceName string, customDomainName string)) *MockService_DeleteCustomDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_DeleteCustomDomain_Call) Return(_a0 error) *MockService_DeleteCustomDomain_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_DeleteCustomDomain_Call) RunAndReturn(run func(context.Context, string, string) error) *MockService_DeleteCustomDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCustomDomain provides a mock function with given fields: ctx, namespaceName, customDomainName
+func (_m *MockService) GetCustomDomain(ctx context.Context, namespaceName string, customDomainName string) (*v1alpha1.CustomDomain, error) {
+	ret := _m.Called(ctx, namespaceName, customDomainName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomDomain")
+	}
+
+	var r0 *v1alpha1.CustomDomain
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*v1alpha1.CustomDomain, error)); ok {
+		return rf(ctx, namespaceName, customDomainName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *v1alpha1.CustomDomain); ok {
+		r0 = rf(ctx, namespaceName, customDomainName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.CustomDomain)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, customDomainName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetCustomDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomDomain'
+type MockService_GetCustomDomain_Call struct {
+	*mock.Call
+}
+
+// GetCustomDomain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - customDomainName string
+func (_e *MockService_Expecter) GetCustomDomain(ctx interface{}, namespaceName interface{}, customDomainName interface{}) *MockService_GetCustomDomain_Call {
+	return &MockService_GetCustomDomain_Call{Call: _e.mock.On("GetCustomDomain", ctx, namespaceName, customDomainName)}
+}
+
+func (_c *MockService_GetCustomDomain_Call) Run(run func(ctx context.Context, namespaceName string, customDomainName string)) *MockService_GetCustomDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetCustomDomain_Call) Return(_a0 *v1alpha1.CustomDomain, _a1 error) *MockService_GetCustomDomain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetCustomDomain_Call) RunAndReturn(run func(context.Context, string, string) (*v1alpha1.CustomDomain, error)) *MockService_GetCustomDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCustomDomains provides a mock function with given fields: ctx, namespaceName, componentName, opts
+func (_m *MockService) ListCustomDomains(ctx context.Context, namespaceName string, componentName string, opts services.ListOptions) (*services.ListResult[v1alpha1.CustomDomain], error) {
+	ret := _m.Called(ctx, namespaceName, componentName, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCustomDomains")
+	}
+
+	var r0 *services.ListResult[v1alpha1.CustomDomain]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, services.ListOptions) (*services.ListResult[v1alpha1.CustomDomain], error)); ok {
+		return rf(ctx, namespaceName, componentName, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, services.ListOptions) *services.ListResult[v1alpha1.CustomDomain]); ok {
+		r0 = rf(ctx, namespaceName, componentName, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.ListResult[v1alpha1.CustomDomain])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, services.ListOptions) error); ok {
+		r1 = rf(ctx, namespaceName, componentName, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListCustomDomains_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCustomDomains'
+type MockService_ListCustomDomains_Call struct {
+	*mock.Call
+}
+
+// ListCustomDomains is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - componentName string
+//   - opts services.ListOptions
+func (_e *MockService_Expecter) ListCustomDomains(ctx interface{}, namespaceName interface{}, componentName interface{}, opts interface{}) *MockService_ListCustomDomains_Call {
+	return &MockService_ListCustomDomains_Call{Call: _e.mock.On("ListCustomDomains", ctx, namespaceName, componentName, opts)}
+}
+
+func (_c *MockService_ListCustomDomains_Call) Run(run func(ctx context.Context, namespaceName string, componentName string, opts services.ListOptions)) *MockService_ListCustomDomains_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(services.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockService_ListCustomDomains_Call) Return(_a0 *services.ListResult[v1alpha1.CustomDomain], _a1 error) *MockService_ListCustomDomains_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListCustomDomains_Call) RunAndReturn(run func(context.Context, string, string, services.ListOptions) (*services.ListResult[v1alpha1.CustomDomain], error)) *MockService_ListCustomDomains_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,249 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customdomain
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	environmentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment"
+)
+
+var customDomainTypeMeta = metav1.TypeMeta{
+	APIVersion: openchoreov1alpha1.GroupVersion.String(),
+	Kind:       "CustomDomain",
+}
+
+// domainPattern matches the fully qualified host names accepted by the CustomDomain CRD.
+var domainPattern = regexp.MustCompile(`^([a-z0-9]([-a-z0-9]*[a-z0-9])?\.)+[a-z]([-a-z0-9]*[a-z0-9])?$`)
+
+const maxDomainLength = 253
+
+// customDomainService handles custom domain business logic without authorization checks.
+type customDomainService struct {
+	k8sClient          client.Client
+	componentService   componentsvc.Service
+	environmentService environmentsvc.Service
+	logger             *slog.Logger
+}
+
+var _ Service = (*customDomainService)(nil)
+
+// NewService creates a new custom domain service without authorization.
+func NewService(k8sClient client.Client, logger *slog.Logger) Service {
+	return &customDomainService{
+		k8sClient:          k8sClient,
+		componentService:   componentsvc.NewService(k8sClient, logger.With("component", "component-service-internal")),
+		environmentService: environmentsvc.NewService(k8sClient, logger.With("component", "environment-service-internal")),
+		logger:             logger,
+	}
+}
+
+func (s *customDomainService) CreateCustomDomain(ctx context.Context, namespaceName string, req *CreateCustomDomainRequest) (*openchoreov1alpha1.CustomDomain, error) {
+	if req == nil {
+		return nil, fmt.Errorf("custom domain request cannot be nil")
+	}
+	if req.Environment == "" || req.Endpoint == "" {
+		return nil, &services.ValidationError{Msg: "environment and endpoint are required"}
+	}
+	domain, err := normalizeDomain(req.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Debug("Creating custom domain", "namespace", namespaceName, "component", req.ComponentName,
+		"environment", req.Environment, "endpoint", req.Endpoint, "domain", domain)
+
+	component, err := s.componentService.GetComponent(ctx, namespaceName, req.ComponentName)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.environmentService.GetEnvironment(ctx, namespaceName, req.Environment); err != nil {
+		return nil, err
+	}
+	if err := s.validateEndpoint(ctx, namespaceName, req.ComponentName, req.Endpoint); err != nil {
+		return nil, err
+	}
+	if err := s.ensureDomainUnbound(ctx, namespaceName, domain); err != nil {
+		return nil, err
+	}
+
+	projectName := component.Spec.Owner.ProjectName
+	cd := &openchoreov1alpha1.CustomDomain{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-%s-", req.ComponentName, req.Environment),
+			Namespace:    namespaceName,
+			Labels: map[string]string{
+				ocLabels.LabelKeyProjectName:     projectName,
+				ocLabels.LabelKeyComponentName:   req.ComponentName,
+				ocLabels.LabelKeyEnvironmentName: req.Environment,
+			},
+		},
+		Spec: openchoreov1alpha1.CustomDomainSpec{
+			Owner: openchoreov1alpha1.CustomDomainOwner{
+				ProjectName:   projectName,
+				ComponentName: req.ComponentName,
+			},
+			Environment: req.Environment,
+			Endpoint:    req.Endpoint,
+			Domain:      domain,
+		},
+	}
+	if err := s.k8sClient.Create(ctx, cd); err != nil {
+		s.logger.Error("Failed to create custom domain", "error", err)
+		return nil, fmt.Errorf("failed to create custom domain: %w", err)
+	}
+
+	s.logger.Debug("Custom domain created", "namespace", namespaceName, "customDomain", cd.Name)
+	cd.TypeMeta = customDomainTypeMeta
+	return cd, nil
+}
+
+func (s *customDomainService) ListCustomDomains(ctx context.Context, namespaceName, componentName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.CustomDomain], error) {
+	s.logger.Debug("Listing custom domains", "namespace", namespaceName, "component", componentName, "limit", opts.Limit, "cursor", opts.Cursor)
+
+	listFn := func(ctx context.Context, pageOpts services.ListOptions) (*services.ListResult[openchoreov1alpha1.CustomDomain], error) {
+		commonOpts, err := services.BuildListOptions(pageOpts)
+		if err != nil {
+			return nil, err
+		}
+		listOpts := append([]client.ListOption{client.InNamespace(namespaceName)}, commonOpts...)
+
+		var cdList openchoreov1alpha1.CustomDomainList
+		if err := s.k8sClient.List(ctx, &cdList, listOpts...); err != nil {
+			s.logger.Error("Failed to list custom domains", "error", err)
+			return nil, fmt.Errorf("failed to list custom domains: %w", err)
+		}
+
+		for i := range cdList.Items {
+			cdList.Items[i].TypeMeta = customDomainTypeMeta
+		}
+
+		result := &services.ListResult[openchoreov1alpha1.CustomDomain]{
+			Items:      cdList.Items,
+			NextCursor: cdList.Continue,
+		}
+		if cdList.RemainingItemCount != nil {
+			remaining := *cdList.RemainingItemCount
+			result.RemainingCount = &remaining
+		}
+		return result, nil
+	}
+
+	// Apply component filter if specified
+	if componentName != "" {
+		filteredFn := services.PreFilteredList(
+			listFn,
+			func(cd openchoreov1alpha1.CustomDomain) bool {
+				return cd.Spec.Owner.ComponentName == componentName
+			},
+		)
+		return filteredFn(ctx, opts)
+	}
+
+	return listFn(ctx, opts)
+}
+
+func (s *customDomainService) GetCustomDomain(ctx context.Context, namespaceName, customDomainName string) (*openchoreov1alpha1.CustomDomain, error) {
+	s.logger.Debug("Getting custom domain", "namespace", namespaceName, "customDomain", customDomainName)
+
+	cd := &openchoreov1alpha1.CustomDomain{}
+	key := client.ObjectKey{Namespace: namespaceName, Name: customDomainName}
+	if err := s.k8sClient.Get(ctx, key, cd); err != nil {
+		if apierrors.IsNotFound(err) {
+			s.logger.Warn("Custom domain not found", "namespace", namespaceName, "customDomain", customDomainName)
+			return nil, ErrCustomDomainNotFound
+		}
+		s.logger.Error("Failed to get custom domain", "error", err)
+		return nil, fmt.Errorf("failed to get custom domain: %w", err)
+	}
+	cd.TypeMeta = customDomainTypeMeta
+	return cd, nil
+}
+
+func (s *customDomainService) DeleteCustomDomain(ctx context.Context, namespaceName, customDomainName string) error {
+	s.logger.Debug("Deleting custom domain", "namespace", namespaceName, "customDomain", customDomainName)
+
+	cd := &openchoreov1alpha1.CustomDomain{}
+	cd.Name = customDomainName
+	cd.Namespace = namespaceName
+
+	if err := s.k8sClient.Delete(ctx, cd); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ErrCustomDomainNotFound
+		}
+		s.logger.Error("Failed to delete custom domain", "error", err)
+		return fmt.Errorf("failed to delete custom domain: %w", err)
+	}
+
+	s.logger.Debug("Custom domain deleted", "namespace", namespaceName, "customDomain", customDomainName)
+	return nil
+}
+
+// validateEndpoint checks that the component's workload declares the endpoint with external
+// visibility, as only external routes can serve a custom domain.
+func (s *customDomainService) validateEndpoint(ctx context.Context, namespaceName, componentName, endpointName string) error {
+	workloadList := &openchoreov1alpha1.WorkloadList{}
+	if err := s.k8sClient.List(ctx, workloadList, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list workloads", "error", err)
+		return fmt.Errorf("failed to list workloads: %w", err)
+	}
+	for i := range workloadList.Items {
+		w := &workloadList.Items[i]
+		if w.Spec.Owner.ComponentName != componentName {
+			continue
+		}
+		endpoint, ok := w.Spec.Endpoints[endpointName]
+		if !ok {
+			return ErrEndpointNotFound
+		}
+		for _, v := range endpoint.Visibility {
+			if v == openchoreov1alpha1.EndpointVisibilityExternal {
+				return nil
+			}
+		}
+		return ErrEndpointNotExternal
+	}
+	return ErrWorkloadNotFound
+}
+
+// ensureDomainUnbound rejects a domain already bound by another CustomDomain in the namespace.
+// Claims across namespaces are resolved by the controller through DNS verification.
+func (s *customDomainService) ensureDomainUnbound(ctx context.Context, namespaceName, domain string) error {
+	var cdList openchoreov1alpha1.CustomDomainList
+	if err := s.k8sClient.List(ctx, &cdList, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list custom domains", "error", err)
+		return fmt.Errorf("failed to list custom domains: %w", err)
+	}
+	for _, cd := range cdList.Items {
+		if cd.Spec.Domain == domain {
+			return ErrDomainAlreadyBound
+		}
+	}
+	return nil
+}
+
+// normalizeDomain lowercases the domain, drops a trailing root dot and validates it as a
+// fully qualified host name.
+func normalizeDomain(domain string) (string, error) {
+	normalized := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+	if normalized == "" {
+		return "", &services.ValidationError{Msg: "domain is required"}
+	}
+	if len(normalized) > maxDomainLength || !domainPattern.MatchString(normalized) {
+		return "", &services.ValidationError{Msg: fmt.Sprintf("domain %q is not a valid fully qualified host name", domain)}
+	}
+	return normalized, nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customdomain
+
+import (
+	"context"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+)
+
+const resourceTypeCustomDomain = "customdomain"
+
+// customDomainServiceWithAuthz wraps a Service and adds authorization checks.
+// Handlers should use this. Other services should use the unwrapped Service directly.
+type customDomainServiceWithAuthz struct {
+	internal         Service
+	componentService componentsvc.Service
+	authz            *services.AuthzChecker
+}
+
+var _ Service = (*customDomainServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates a custom domain service with authorization checks.
+func NewServiceWithAuthz(k8sClient client.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &customDomainServiceWithAuthz{
+		internal:         NewService(k8sClient, logger),
+		componentService: componentsvc.NewService(k8sClient, logger.With("component", "component-service-internal")),
+		authz:            services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+// CreateCustomDomain requires permission to create custom domains for the environment.
+func (s *customDomainServiceWithAuthz) CreateCustomDomain(ctx context.Context, namespaceName string, req *CreateCustomDomainRequest) (*openchoreov1alpha1.CustomDomain, error) {
+	if req == nil {
+		return s.internal.CreateCustomDomain(ctx, namespaceName, req)
+	}
+	// Fetch first to get the project for authz hierarchy
+	comp, err := s.componentService.GetComponent(ctx, namespaceName, req.ComponentName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionCreateCustomDomain,
+		ResourceType: resourceTypeCustomDomain,
+		ResourceID:   req.ComponentName,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   comp.Spec.Owner.ProjectName,
+			Component: req.ComponentName,
+		},
+		Context: environmentContext(namespaceName, req.Environment),
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.CreateCustomDomain(ctx, namespaceName, req)
+}
+
+func (s *customDomainServiceWithAuthz) ListCustomDomains(ctx context.Context, namespaceName, componentName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.CustomDomain], error) {
+	return services.FilteredList(ctx, opts, s.authz,
+		func(ctx context.Context, pageOpts services.ListOptions) (*services.ListResult[openchoreov1alpha1.CustomDomain], error) {
+			return s.internal.ListCustomDomains(ctx, namespaceName, componentName, pageOpts)
+		},
+		func(cd openchoreov1alpha1.CustomDomain) services.CheckRequest {
+			return checkRequest(authz.ActionViewCustomDomain, &cd)
+		},
+	)
+}
+
+func (s *customDomainServiceWithAuthz) GetCustomDomain(ctx context.Context, namespaceName, customDomainName string) (*openchoreov1alpha1.CustomDomain, error) {
+	cd, err := s.internal.GetCustomDomain(ctx, namespaceName, customDomainName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, checkRequest(authz.ActionViewCustomDomain, cd)); err != nil {
+		return nil, err
+	}
+	return cd, nil
+}
+
+// DeleteCustomDomain requires permission to delete custom domains for the environment.
+func (s *customDomainServiceWithAuthz) DeleteCustomDomain(ctx context.Context, namespaceName, customDomainName string) error {
+	// Fetch first to get the component and environment for authz
+	cd, err := s.internal.GetCustomDomain(ctx, namespaceName, customDomainName)
+	if err != nil {
+		return err
+	}
+	if err := s.authz.Check(ctx, checkRequest(authz.ActionDeleteCustomDomain, cd)); err != nil {
+		return err
+	}
+	return s.internal.DeleteCustomDomain(ctx, namespaceName, customDomainName)
+}
+
+func checkRequest(action string, cd *openchoreov1alpha1.CustomDomain) services.CheckRequest {
+	return services.CheckRequest{
+		Action:       action,
+		ResourceType: resourceTypeCustomDomain,
+		ResourceID:   cd.Name,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: cd.Namespace,
+			Project:   cd.Spec.Owner.ProjectName,
+			Component: cd.Spec.Owner.ComponentName,
+		},
+		Context: environmentContext(cd.Namespace, cd.Spec.Environment),
+	}
+}
+
+func environmentContext(namespaceName, environment string) authz.Context {
+	return authz.Context{
+		Resource: authz.ResourceAttribute{
+			Environment: services.FormatDualScopedResourceName(namespaceName, environment, false)},
+	}
+}
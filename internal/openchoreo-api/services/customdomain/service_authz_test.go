@@ -0,0 +1,143 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customdomain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+// mockService is a local testify mock for the Service interface.
+// It exists here instead of importing customdomain/mocks to avoid a cyclic import
+type mockService struct {
+	mock.Mock
+}
+
+func newMockService(t *testing.T) *mockService {
+	m := &mockService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *mockService) CreateCustomDomain(ctx context.Context, namespaceName string, req *CreateCustomDomainRequest) (*openchoreov1alpha1.CustomDomain, error) {
+	args := m.Called(ctx, namespaceName, req)
+	res, _ := args.Get(0).(*openchoreov1alpha1.CustomDomain)
+	return res, args.Error(1)
+}
+
+func (m *mockService) ListCustomDomains(ctx context.Context, namespaceName, componentName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.CustomDomain], error) {
+	args := m.Called(ctx, namespaceName, componentName, opts)
+	res, _ := args.Get(0).(*services.ListResult[openchoreov1alpha1.CustomDomain])
+	return res, args.Error(1)
+}
+
+func (m *mockService) GetCustomDomain(ctx context.Context, namespaceName, customDomainName string) (*openchoreov1alpha1.CustomDomain, error) {
+	args := m.Called(ctx, namespaceName, customDomainName)
+	res, _ := args.Get(0).(*openchoreov1alpha1.CustomDomain)
+	return res, args.Error(1)
+}
+
+func (m *mockService) DeleteCustomDomain(ctx context.Context, namespaceName, customDomainName string) error {
+	args := m.Called(ctx, namespaceName, customDomainName)
+	return args.Error(0)
+}
+
+var customDomainHierarchy = authzcore.ResourceHierarchy{Namespace: testNamespace, Project: testProjectName, Component: testComponentName}
+
+func newAuthzService(internal Service, pdp authzcore.PDP) *customDomainServiceWithAuthz {
+	k8sClient := testutil.NewFakeClient(testutil.NewComponent(testNamespace, testProjectName, testComponentName))
+	return &customDomainServiceWithAuthz{
+		internal:         internal,
+		componentService: componentsvc.NewService(k8sClient, testutil.TestLogger()),
+		authz:            testutil.NewTestAuthzChecker(pdp),
+	}
+}
+
+func testCustomDomain(name string) *openchoreov1alpha1.CustomDomain {
+	return &openchoreov1alpha1.CustomDomain{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Spec: openchoreov1alpha1.CustomDomainSpec{
+			Owner:       openchoreov1alpha1.CustomDomainOwner{ProjectName: testProjectName, ComponentName: testComponentName},
+			Environment: "prod",
+			Endpoint:    "web",
+			Domain:      "shop.example.com",
+		},
+	}
+}
+
+func TestCreateCustomDomain_AuthzCheck(t *testing.T) {
+	req := createRequest("web", "shop.example.com")
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("CreateCustomDomain", mock.Anything, testNamespace, req).Return(testCustomDomain("cd-1"), nil)
+
+		_, err := newAuthzService(mockSvc, pdp).CreateCustomDomain(testutil.AuthzContext(), testNamespace, req)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "customdomain:create", "customdomain", testComponentName, customDomainHierarchy)
+		require.Equal(t, "ns-1/prod", pdp.Captured[0].Context.Resource.Environment)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		_, err := newAuthzService(newMockService(t), testutil.DenyPDP()).CreateCustomDomain(testutil.AuthzContext(), testNamespace, req)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestGetCustomDomain_AuthzCheck(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetCustomDomain", mock.Anything, testNamespace, "cd-1").Return(testCustomDomain("cd-1"), nil)
+
+		_, err := newAuthzService(mockSvc, pdp).GetCustomDomain(testutil.AuthzContext(), testNamespace, "cd-1")
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "customdomain:view", "customdomain", "cd-1", customDomainHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		mockSvc := newMockService(t)
+		mockSvc.On("GetCustomDomain", mock.Anything, testNamespace, "cd-1").Return(testCustomDomain("cd-1"), nil)
+
+		_, err := newAuthzService(mockSvc, testutil.DenyPDP()).GetCustomDomain(testutil.AuthzContext(), testNamespace, "cd-1")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestDeleteCustomDomain_AuthzCheck(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetCustomDomain", mock.Anything, testNamespace, "cd-1").Return(testCustomDomain("cd-1"), nil)
+		mockSvc.On("DeleteCustomDomain", mock.Anything, testNamespace, "cd-1").Return(nil)
+
+		err := newAuthzService(mockSvc, pdp).DeleteCustomDomain(testutil.AuthzContext(), testNamespace, "cd-1")
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "customdomain:delete", "customdomain", "cd-1", customDomainHierarchy)
+		require.Equal(t, "ns-1/prod", pdp.Captured[0].Context.Resource.Environment)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		mockSvc := newMockService(t)
+		mockSvc.On("GetCustomDomain", mock.Anything, testNamespace, "cd-1").Return(testCustomDomain("cd-1"), nil)
+
+		err := newAuthzService(mockSvc, testutil.DenyPDP()).DeleteCustomDomain(testutil.AuthzContext(), testNamespace, "cd-1")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customdomain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	environmentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+const (
+	testNamespace     = "ns-1"
+	testProjectName   = "proj-1"
+	testComponentName = "comp-1"
+)
+
+func newService(objs ...client.Object) Service {
+	return NewService(testutil.NewFakeClient(objs...), testutil.TestLogger())
+}
+
+// seedObjects returns a component whose workload exposes "web" externally and "admin" only
+// within the project, and a prod environment.
+func seedObjects() []client.Object {
+	workload := testutil.NewWorkload(testNamespace, testProjectName, testComponentName, "comp-1-workload")
+	workload.Spec.Endpoints = map[string]openchoreov1alpha1.WorkloadEndpoint{
+		"web": {
+			Type:       openchoreov1alpha1.EndpointTypeHTTP,
+			Port:       8080,
+			Visibility: []openchoreov1alpha1.EndpointVisibility{openchoreov1alpha1.EndpointVisibilityExternal},
+		},
+		"admin": {Type: openchoreov1alpha1.EndpointTypeHTTP, Port: 9090},
+	}
+	return []client.Object{
+		testutil.NewProject(testNamespace, testProjectName),
+		testutil.NewComponent(testNamespace, testProjectName, testComponentName),
+		testutil.NewEnvironment(testNamespace, "prod"),
+		workload,
+	}
+}
+
+func createRequest(endpoint, domain string) *CreateCustomDomainRequest {
+	return &CreateCustomDomainRequest{
+		ComponentName: testComponentName,
+		Environment:   "prod",
+		Endpoint:      endpoint,
+		Domain:        domain,
+	}
+}
+
+func TestCreateCustomDomain(t *testing.T) {
+	ctx := testutil.AuthzContext()
+
+	t.Run("binds the domain to an external endpoint", func(t *testing.T) {
+		svc := newService(seedObjects()...)
+
+		cd, err := svc.CreateCustomDomain(ctx, testNamespace, createRequest("web", "Shop.Example.com."))
+		require.NoError(t, err)
+		assert.Equal(t, "CustomDomain", cd.Kind)
+		assert.Equal(t, "shop.example.com", cd.Spec.Domain)
+		assert.Equal(t, testProjectName, cd.Spec.Owner.ProjectName)
+		assert.Equal(t, "prod", cd.Spec.Environment)
+		assert.Equal(t, "web", cd.Spec.Endpoint)
+
+		list, err := svc.ListCustomDomains(ctx, testNamespace, testComponentName, services.ListOptions{})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+	})
+
+	t.Run("rejects endpoints without external visibility", func(t *testing.T) {
+		_, err := newService(seedObjects()...).CreateCustomDomain(ctx, testNamespace, createRequest("admin", "admin.example.com"))
+		require.ErrorIs(t, err, ErrEndpointNotExternal)
+	})
+
+	t.Run("rejects unknown endpoints", func(t *testing.T) {
+		_, err := newService(seedObjects()...).CreateCustomDomain(ctx, testNamespace, createRequest("grpc", "grpc.example.com"))
+		require.ErrorIs(t, err, ErrEndpointNotFound)
+	})
+
+	t.Run("rejects unknown environments", func(t *testing.T) {
+		req := createRequest("web", "shop.example.com")
+		req.Environment = "staging"
+		_, err := newService(seedObjects()...).CreateCustomDomain(ctx, testNamespace, req)
+		require.ErrorIs(t, err, environmentsvc.ErrEnvironmentNotFound)
+	})
+
+	t.Run("rejects unknown components", func(t *testing.T) {
+		req := createRequest("web", "shop.example.com")
+		req.ComponentName = "missing"
+		_, err := newService(seedObjects()...).CreateCustomDomain(ctx, testNamespace, req)
+		require.ErrorIs(t, err, componentsvc.ErrComponentNotFound)
+	})
+
+	t.Run("rejects invalid domains", func(t *testing.T) {
+		for _, domain := range []string{"", "localhost", "-bad.example.com", "shop_1.example.com", "*.example.com"} {
+			_, err := newService(seedObjects()...).CreateCustomDomain(ctx, testNamespace, createRequest("web", domain))
+			var validationErr *services.ValidationError
+			require.ErrorAs(t, err, &validationErr, "domain %q", domain)
+		}
+	})
+
+	t.Run("rejects domains already bound in the namespace", func(t *testing.T) {
+		svc := newService(seedObjects()...)
+		_, err := svc.CreateCustomDomain(ctx, testNamespace, createRequest("web", "shop.example.com"))
+		require.NoError(t, err)
+
+		_, err = svc.CreateCustomDomain(ctx, testNamespace, createRequest("web", "shop.example.com"))
+		require.ErrorIs(t, err, ErrDomainAlreadyBound)
+	})
+}
+
+func TestGetAndDeleteCustomDomain(t *testing.T) {
+	ctx := testutil.AuthzContext()
+	svc := newService(seedObjects()...)
+
+	created, err := svc.CreateCustomDomain(ctx, testNamespace, createRequest("web", "shop.example.com"))
+	require.NoError(t, err)
+
+	got, err := svc.GetCustomDomain(ctx, testNamespace, created.Name)
+	require.NoError(t, err)
+	assert.Equal(t, "shop.example.com", got.Spec.Domain)
+
+	require.NoError(t, svc.DeleteCustomDomain(ctx, testNamespace, created.Name))
+	_, err = svc.GetCustomDomain(ctx, testNamespace, created.Name)
+	require.ErrorIs(t, err, ErrCustomDomainNotFound)
+	require.ErrorIs(t, svc.DeleteCustomDomain(ctx, testNamespace, created.Name), ErrCustomDomainNotFound)
+}
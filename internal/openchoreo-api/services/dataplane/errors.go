@@ -6,7 +6,9 @@ package dataplane
 import "errors"
 
 var (
-	ErrDataPlaneNil           = errors.New("data plane is nil")
-	ErrDataPlaneNotFound      = errors.New("data plane not found")
-	ErrDataPlaneAlreadyExists = errors.New("data plane already exists")
+	ErrDataPlaneNil                = errors.New("data plane is nil")
+	ErrDataPlaneNotFound           = errors.New("data plane not found")
+	ErrDataPlaneAlreadyExists      = errors.New("data plane already exists")
+	ErrPlaneGatewayUnavailable     = errors.New("cluster gateway is not configured")
+	ErrDataPlaneVerificationFailed = errors.New("data plane verification failed")
 )
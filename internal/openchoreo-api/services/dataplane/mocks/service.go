@@ -253,6 +253,66 @@ func (_c *MockService_ListDataPlanes_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// RegisterDataPlane provides a mock function with given fields: ctx, namespaceName, dp
+func (_m *MockService) RegisterDataPlane(ctx context.Context, namespaceName string, dp *v1alpha1.DataPlane) (*v1alpha1.DataPlane, error) {
+	ret := _m.Called(ctx, namespaceName, dp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterDataPlane")
+	}
+
+	var r0 *v1alpha1.DataPlane
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *v1alpha1.DataPlane) (*v1alpha1.DataPlane, error)); ok {
+		return rf(ctx, namespaceName, dp)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *v1alpha1.DataPlane) *v1alpha1.DataPlane); ok {
+		r0 = rf(ctx, namespaceName, dp)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.DataPlane)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *v1alpha1.DataPlane) error); ok {
+		r1 = rf(ctx, namespaceName, dp)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_RegisterDataPlane_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterDataPlane'
+type MockService_RegisterDataPlane_Call struct {
+	*mock.Call
+}
+
+// RegisterDataPlane is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - dp *v1alpha1.DataPlane
+func (_e *MockService_Expecter) RegisterDataPlane(ctx interface{}, namespaceName interface{}, dp interface{}) *MockService_RegisterDataPlane_Call {
+	return &MockService_RegisterDataPlane_Call{Call: _e.mock.On("RegisterDataPlane", ctx, namespaceName, dp)}
+}
+
+func (_c *MockService_RegisterDataPlane_Call) Run(run func(ctx context.Context, namespaceName string, dp *v1alpha1.DataPlane)) *MockService_RegisterDataPlane_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*v1alpha1.DataPlane))
+	})
+	return _c
+}
+
+func (_c *MockService_RegisterDataPlane_Call) Return(_a0 *v1alpha1.DataPlane, _a1 error) *MockService_RegisterDataPlane_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_RegisterDataPlane_Call) RunAndReturn(run func(context.Context, string, *v1alpha1.DataPlane) (*v1alpha1.DataPlane, error)) *MockService_RegisterDataPlane_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateDataPlane provides a mock function with given fields: ctx, namespaceName, dp
 func (_m *MockService) UpdateDataPlane(ctx context.Context, namespaceName string, dp *v1alpha1.DataPlane) (*v1alpha1.DataPlane, error) {
 	ret := _m.Called(ctx, namespaceName, dp)
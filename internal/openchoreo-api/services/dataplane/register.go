@@ -0,0 +1,298 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataplane
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	gatewayClient "github.com/openchoreo/openchoreo/internal/clients/gateway"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+const (
+	planeTypeDataPlane = "dataplane"
+
+	defaultAgentConnectTimeout = 30 * time.Second
+	agentConnectPollInterval   = 2 * time.Second
+
+	checkAgentConnection = "agent-connection"
+	checkKubernetesAPI   = "kubernetes-api"
+	checkGatewayAPI      = "gateway-api"
+	checkIngressGateway  = "ingress-gateway"
+	checkCilium          = "cilium"
+	checkExternalSecrets = "external-secrets"
+)
+
+// PlaneProber reaches a data plane cluster through the cluster gateway.
+// It is implemented by the cluster gateway client.
+type PlaneProber interface {
+	GetPlaneStatus(ctx context.Context, planeType, planeID, namespace, name string) (*gatewayClient.PlaneConnectionStatus, error)
+	ProxyK8sRequest(ctx context.Context, planeType, planeID, crNamespace, crName, k8sPath, rawQuery string) (*http.Response, error)
+}
+
+// VerificationCheck is the outcome of one of the checks run when registering a data plane.
+type VerificationCheck struct {
+	Name     string `json:"name"`
+	Required bool   `json:"required"`
+	Passed   bool   `json:"passed"`
+	Message  string `json:"message,omitempty"`
+	// Hint tells the operator how to fix a failed check.
+	Hint string `json:"hint,omitempty"`
+}
+
+// VerificationError is returned by RegisterDataPlane when a required check fails.
+// The data plane is not kept in that case.
+type VerificationError struct {
+	Checks []VerificationCheck
+}
+
+func (e *VerificationError) Error() string {
+	var failed []string
+	for _, c := range e.Checks {
+		if c.Required && !c.Passed {
+			failed = append(failed, fmt.Sprintf("%s: %s (%s)", c.Name, c.Message, c.Hint))
+		}
+	}
+	return fmt.Sprintf("%s: %s", ErrDataPlaneVerificationFailed, strings.Join(failed, "; "))
+}
+
+func (e *VerificationError) Unwrap() error {
+	return ErrDataPlaneVerificationFailed
+}
+
+// RegisterDataPlane creates a data plane and verifies it before reporting success.
+// The cluster agent of the plane must connect to the cluster gateway with a client
+// certificate signed by spec.clusterAgent.clientCA; once it does, the plane's API
+// server is probed for the components OpenChoreo relies on. When a required check
+// fails the data plane is deleted again and a *VerificationError lists what to fix.
+// On success the discovered capabilities are recorded in status.capabilities.
+func (s *dataPlaneService) RegisterDataPlane(ctx context.Context, namespaceName string, dp *openchoreov1alpha1.DataPlane) (*openchoreov1alpha1.DataPlane, error) {
+	if dp == nil {
+		return nil, ErrDataPlaneNil
+	}
+	if s.prober == nil {
+		return nil, ErrPlaneGatewayUnavailable
+	}
+	if err := validateClientCA(dp.Spec.ClusterAgent.ClientCA); err != nil {
+		return nil, err
+	}
+
+	s.logger.Debug("Registering data plane", "namespace", namespaceName, "dataPlane", dp.Name)
+
+	created, err := s.CreateDataPlane(ctx, namespaceName, dp)
+	if err != nil {
+		return nil, err
+	}
+
+	checks, capabilities := s.verify(ctx, created)
+	for _, c := range checks {
+		if c.Required && !c.Passed {
+			s.logger.Warn("Data plane verification failed, removing it",
+				"namespace", namespaceName, "dataPlane", created.Name, "check", c.Name, "message", c.Message)
+			if err := s.k8sClient.Delete(ctx, created); client.IgnoreNotFound(err) != nil {
+				s.logger.Error("Failed to remove unverified data plane", "error", err)
+			}
+			return nil, &VerificationError{Checks: checks}
+		}
+	}
+
+	registered := &openchoreov1alpha1.DataPlane{}
+	key := client.ObjectKeyFromObject(created)
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := s.k8sClient.Get(ctx, key, registered); err != nil {
+			return err
+		}
+		registered.Status.Capabilities = capabilities
+		return s.k8sClient.Status().Update(ctx, registered)
+	}); err != nil {
+		s.logger.Error("Failed to record data plane capabilities", "error", err)
+		return nil, fmt.Errorf("failed to record data plane capabilities: %w", err)
+	}
+
+	s.logger.Debug("Data plane registered successfully", "namespace", namespaceName, "dataPlane", dp.Name)
+	registered.TypeMeta = dataPlaneTypeMeta
+	return registered, nil
+}
+
+// validateClientCA rejects an inline client CA that is not a PEM-encoded certificate,
+// which would otherwise only surface as agents failing to connect.
+func validateClientCA(ca openchoreov1alpha1.ValueFrom) error {
+	if ca.SecretKeyRef != nil {
+		return nil
+	}
+	if ca.Value == "" {
+		return &services.ValidationError{Msg: "spec.clusterAgent.clientCA must set value or secretKeyRef"}
+	}
+	block, _ := pem.Decode([]byte(ca.Value))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return &services.ValidationError{Msg: "spec.clusterAgent.clientCA.value must be a PEM-encoded certificate"}
+	}
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return &services.ValidationError{Msg: fmt.Sprintf("spec.clusterAgent.clientCA.value is not a valid certificate: %v", err)}
+	}
+	return nil
+}
+
+// verify runs the registration checks against a freshly created data plane.
+func (s *dataPlaneService) verify(ctx context.Context, dp *openchoreov1alpha1.DataPlane) ([]VerificationCheck, *openchoreov1alpha1.DataPlaneCapabilities) {
+	planeID := dp.Spec.PlaneID
+	if planeID == "" {
+		planeID = dp.Name
+	}
+	capabilities := &openchoreov1alpha1.DataPlaneCapabilities{DiscoveredAt: metav1.Now()}
+
+	connected := VerificationCheck{Name: checkAgentConnection, Required: true}
+	if err := s.waitForAgent(ctx, planeID, dp); err != nil {
+		connected.Message = fmt.Sprintf("no cluster agent connected for plane ID %q within %s", planeID, s.agentConnectTimeout)
+		connected.Hint = "install the cluster agent in the data plane cluster with this plane ID and a client " +
+			"certificate signed by spec.clusterAgent.clientCA, and check that it can reach the cluster gateway"
+		return []VerificationCheck{connected}, nil
+	}
+	connected.Passed = true
+	connected.Message = "cluster agent connected"
+	checks := []VerificationCheck{connected}
+
+	get := func(path string) (int, []byte, error) {
+		resp, err := s.prober.ProxyK8sRequest(ctx, planeTypeDataPlane, planeID, dp.Namespace, dp.Name, path, "")
+		if err != nil {
+			return 0, nil, err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		return resp.StatusCode, body, err
+	}
+	exists := func(path string) (bool, error) {
+		status, _, err := get(path)
+		if err != nil {
+			return false, err
+		}
+		switch status {
+		case http.StatusOK:
+			return true, nil
+		case http.StatusNotFound:
+			return false, nil
+		default:
+			return false, fmt.Errorf("unexpected status %d", status)
+		}
+	}
+
+	api := VerificationCheck{Name: checkKubernetesAPI, Required: true}
+	status, body, err := get("version")
+	var version struct {
+		GitVersion string `json:"gitVersion"`
+	}
+	if err == nil && status == http.StatusOK {
+		err = json.Unmarshal(body, &version)
+	} else if err == nil {
+		err = fmt.Errorf("unexpected status %d", status)
+	}
+	if err != nil {
+		api.Message = fmt.Sprintf("the Kubernetes API server is not reachable through the agent: %v", err)
+		api.Hint = "check the cluster agent's logs and that its service account can reach the API server"
+		return append(checks, api), nil
+	}
+	api.Passed = true
+	api.Message = "Kubernetes " + version.GitVersion
+	capabilities.KubernetesVersion = version.GitVersion
+	checks = append(checks, api)
+
+	crdCheck := func(name string, required bool, crd, hint string) (VerificationCheck, bool) {
+		c := VerificationCheck{Name: name, Required: required}
+		found, err := exists("apis/apiextensions.k8s.io/v1/customresourcedefinitions/" + crd)
+		switch {
+		case err != nil:
+			c.Message = fmt.Sprintf("failed to look up CRD %s: %v", crd, err)
+			c.Hint = "check the cluster agent's logs and RBAC permissions"
+		case !found:
+			c.Message = fmt.Sprintf("CRD %s is not installed", crd)
+			c.Hint = hint
+		default:
+			c.Passed = true
+			c.Message = fmt.Sprintf("CRD %s is installed", crd)
+		}
+		return c, c.Passed
+	}
+
+	gatewayAPI, ok := crdCheck(checkGatewayAPI, true, "gateways.gateway.networking.k8s.io",
+		"install the Gateway API CRDs and a Gateway controller in the data plane cluster")
+	capabilities.GatewayAPI = ok
+	checks = append(checks, gatewayAPI)
+	if ok && dp.Spec.Gateway.Ingress != nil {
+		for _, ep := range []*openchoreov1alpha1.GatewayEndpointSpec{dp.Spec.Gateway.Ingress.External, dp.Spec.Gateway.Ingress.Internal} {
+			if ep == nil {
+				continue
+			}
+			c := VerificationCheck{Name: checkIngressGateway, Required: true}
+			found, err := exists(fmt.Sprintf("apis/gateway.networking.k8s.io/v1/namespaces/%s/gateways/%s", ep.Namespace, ep.Name))
+			switch {
+			case err != nil:
+				c.Message = fmt.Sprintf("failed to look up Gateway %s/%s: %v", ep.Namespace, ep.Name, err)
+				c.Hint = "check the cluster agent's logs and RBAC permissions"
+			case !found:
+				c.Message = fmt.Sprintf("Gateway %s/%s does not exist", ep.Namespace, ep.Name)
+				c.Hint = "create the Gateway in the data plane cluster or correct spec.gateway.ingress"
+			default:
+				c.Passed = true
+				c.Message = fmt.Sprintf("Gateway %s/%s exists", ep.Namespace, ep.Name)
+			}
+			checks = append(checks, c)
+		}
+	}
+
+	cilium, ok := crdCheck(checkCilium, false, "ciliumnetworkpolicies.cilium.io",
+		"install Cilium to enforce network policies between components")
+	capabilities.Cilium = ok
+	checks = append(checks, cilium)
+
+	secretStore := dp.Spec.SecretStoreRef
+	externalSecrets, ok := crdCheck(checkExternalSecrets, secretStore != nil, "clustersecretstores.external-secrets.io",
+		"install the External Secrets Operator in the data plane cluster")
+	capabilities.ExternalSecrets = ok
+	if ok && secretStore != nil {
+		found, err := exists("apis/external-secrets.io/v1/clustersecretstores/" + secretStore.Name)
+		switch {
+		case err != nil:
+			externalSecrets.Passed = false
+			externalSecrets.Message = fmt.Sprintf("failed to look up ClusterSecretStore %q: %v", secretStore.Name, err)
+			externalSecrets.Hint = "check the cluster agent's logs and RBAC permissions"
+		case !found:
+			externalSecrets.Passed = false
+			externalSecrets.Message = fmt.Sprintf("ClusterSecretStore %q does not exist", secretStore.Name)
+			externalSecrets.Hint = "create the ClusterSecretStore in the data plane cluster or correct spec.secretStoreRef"
+		}
+	}
+	checks = append(checks, externalSecrets)
+
+	return checks, capabilities
+}
+
+// waitForAgent waits until a cluster agent of the plane is connected and authorized
+// for the data plane.
+func (s *dataPlaneService) waitForAgent(ctx context.Context, planeID string, dp *openchoreov1alpha1.DataPlane) error {
+	return wait.PollUntilContextTimeout(ctx, agentConnectPollInterval, s.agentConnectTimeout, true,
+		func(ctx context.Context) (bool, error) {
+			status, err := s.prober.GetPlaneStatus(ctx, planeTypeDataPlane, planeID, dp.Namespace, dp.Name)
+			if err != nil {
+				if gatewayClient.IsTransientError(err) {
+					return false, nil
+				}
+				return false, err
+			}
+			return status.Connected, nil
+		})
+}
@@ -0,0 +1,202 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataplane
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	gatewayClient "github.com/openchoreo/openchoreo/internal/clients/gateway"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+// fakeProber serves canned Kubernetes API responses for the proxied paths it knows
+// and 404 for everything else.
+type fakeProber struct {
+	connected bool
+	paths     map[string]string
+}
+
+func (p *fakeProber) GetPlaneStatus(_ context.Context, planeType, planeID, _, _ string) (*gatewayClient.PlaneConnectionStatus, error) {
+	return &gatewayClient.PlaneConnectionStatus{PlaneType: planeType, PlaneID: planeID, Connected: p.connected}, nil
+}
+
+func (p *fakeProber) ProxyK8sRequest(_ context.Context, _, _, _, _, k8sPath, _ string) (*http.Response, error) {
+	body, ok := p.paths[k8sPath]
+	status := http.StatusOK
+	if !ok {
+		status = http.StatusNotFound
+	}
+	return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body))}, nil
+}
+
+func healthyPlane() *fakeProber {
+	return &fakeProber{
+		connected: true,
+		paths: map[string]string{
+			"version": `{"gitVersion":"v1.32.1"}`,
+			"apis/apiextensions.k8s.io/v1/customresourcedefinitions/gateways.gateway.networking.k8s.io":      `{}`,
+			"apis/apiextensions.k8s.io/v1/customresourcedefinitions/clustersecretstores.external-secrets.io": `{}`,
+			"apis/gateway.networking.k8s.io/v1/namespaces/openchoreo-data-plane/gateways/gateway-default":    `{}`,
+			"apis/external-secrets.io/v1/clustersecretstores/default":                                        `{}`,
+		},
+	}
+}
+
+func newRegisterService(t *testing.T, prober PlaneProber, objs ...client.Object) (*dataPlaneService, client.Client) {
+	t.Helper()
+	k8sClient := testutil.NewFakeClient(objs...)
+	return &dataPlaneService{
+		k8sClient:           k8sClient,
+		prober:              prober,
+		agentConnectTimeout: 10 * time.Millisecond,
+		logger:              testutil.TestLogger(),
+	}, k8sClient
+}
+
+func testCAPEM(t *testing.T) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func registrationDataPlane(t *testing.T) *openchoreov1alpha1.DataPlane {
+	return &openchoreov1alpha1.DataPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: testDPName},
+		Spec: openchoreov1alpha1.DataPlaneSpec{
+			PlaneID:      "prod-cluster",
+			ClusterAgent: openchoreov1alpha1.ClusterAgentConfig{ClientCA: openchoreov1alpha1.ValueFrom{Value: testCAPEM(t)}},
+			Gateway: openchoreov1alpha1.GatewaySpec{Ingress: &openchoreov1alpha1.GatewayNetworkSpec{
+				External: &openchoreov1alpha1.GatewayEndpointSpec{Name: "gateway-default", Namespace: "openchoreo-data-plane"},
+			}},
+			SecretStoreRef: &openchoreov1alpha1.SecretStoreRef{Name: "default"},
+		},
+	}
+}
+
+func requireFailedCheck(t *testing.T, err error, name string) {
+	t.Helper()
+	require.ErrorIs(t, err, ErrDataPlaneVerificationFailed)
+	var vErr *VerificationError
+	require.ErrorAs(t, err, &vErr)
+	for _, c := range vErr.Checks {
+		if c.Name == name {
+			assert.False(t, c.Passed)
+			assert.NotEmpty(t, c.Hint)
+			return
+		}
+	}
+	t.Fatalf("check %q not reported in %v", name, vErr.Checks)
+}
+
+func TestRegisterDataPlane(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("records discovered capabilities", func(t *testing.T) {
+		svc, k8sClient := newRegisterService(t, healthyPlane())
+
+		result, err := svc.RegisterDataPlane(ctx, testNamespace, registrationDataPlane(t))
+		require.NoError(t, err)
+		assert.Equal(t, dataPlaneTypeMeta, result.TypeMeta)
+		require.NotNil(t, result.Status.Capabilities)
+		assert.Equal(t, "v1.32.1", result.Status.Capabilities.KubernetesVersion)
+		assert.True(t, result.Status.Capabilities.GatewayAPI)
+		assert.True(t, result.Status.Capabilities.ExternalSecrets)
+		assert.False(t, result.Status.Capabilities.Cilium)
+
+		stored := &openchoreov1alpha1.DataPlane{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: testDPName}, stored))
+		require.NotNil(t, stored.Status.Capabilities)
+		assert.Equal(t, "v1.32.1", stored.Status.Capabilities.KubernetesVersion)
+	})
+
+	t.Run("agent not connected removes the data plane", func(t *testing.T) {
+		svc, k8sClient := newRegisterService(t, &fakeProber{})
+
+		_, err := svc.RegisterDataPlane(ctx, testNamespace, registrationDataPlane(t))
+		requireFailedCheck(t, err, checkAgentConnection)
+		assert.Contains(t, err.Error(), "prod-cluster")
+
+		var list openchoreov1alpha1.DataPlaneList
+		require.NoError(t, k8sClient.List(ctx, &list))
+		assert.Empty(t, list.Items)
+	})
+
+	t.Run("missing gateway", func(t *testing.T) {
+		prober := healthyPlane()
+		delete(prober.paths, "apis/gateway.networking.k8s.io/v1/namespaces/openchoreo-data-plane/gateways/gateway-default")
+		svc, _ := newRegisterService(t, prober)
+
+		_, err := svc.RegisterDataPlane(ctx, testNamespace, registrationDataPlane(t))
+		requireFailedCheck(t, err, checkIngressGateway)
+	})
+
+	t.Run("missing secret store only fails when referenced", func(t *testing.T) {
+		prober := healthyPlane()
+		delete(prober.paths, "apis/external-secrets.io/v1/clustersecretstores/default")
+		svc, _ := newRegisterService(t, prober)
+
+		_, err := svc.RegisterDataPlane(ctx, testNamespace, registrationDataPlane(t))
+		requireFailedCheck(t, err, checkExternalSecrets)
+
+		svc, _ = newRegisterService(t, prober)
+		dp := registrationDataPlane(t)
+		dp.Spec.SecretStoreRef = nil
+		_, err = svc.RegisterDataPlane(ctx, testNamespace, dp)
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid client CA", func(t *testing.T) {
+		svc, _ := newRegisterService(t, healthyPlane())
+		dp := registrationDataPlane(t)
+		dp.Spec.ClusterAgent.ClientCA.Value = "not a certificate"
+
+		_, err := svc.RegisterDataPlane(ctx, testNamespace, dp)
+		var validationErr *services.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("gateway not configured", func(t *testing.T) {
+		svc, _ := newRegisterService(t, nil)
+
+		_, err := svc.RegisterDataPlane(ctx, testNamespace, registrationDataPlane(t))
+		require.ErrorIs(t, err, ErrPlaneGatewayUnavailable)
+	})
+
+	t.Run("already exists", func(t *testing.T) {
+		svc, _ := newRegisterService(t, healthyPlane(), testutil.NewDataPlane(testNamespace, testDPName))
+
+		_, err := svc.RegisterDataPlane(ctx, testNamespace, registrationDataPlane(t))
+		require.ErrorIs(t, err, ErrDataPlaneAlreadyExists)
+	})
+}
@@ -7,12 +7,14 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	gatewayClient "github.com/openchoreo/openchoreo/internal/clients/gateway"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
@@ -24,18 +26,27 @@ var dataPlaneTypeMeta = metav1.TypeMeta{
 // dataPlaneService handles data plane-related business logic without authorization checks.
 // Other services within this layer should use this directly to avoid double authz.
 type dataPlaneService struct {
-	k8sClient client.Client
-	logger    *slog.Logger
+	k8sClient           client.Client
+	prober              PlaneProber
+	agentConnectTimeout time.Duration
+	logger              *slog.Logger
 }
 
 var _ Service = (*dataPlaneService)(nil)
 
 // NewService creates a new data plane service without authorization.
-func NewService(k8sClient client.Client, logger *slog.Logger) Service {
-	return &dataPlaneService{
-		k8sClient: k8sClient,
-		logger:    logger,
-	}
+// gwClient is used to verify data planes on registration and may be nil when
+// the cluster gateway is not configured.
+func NewService(k8sClient client.Client, gwClient *gatewayClient.Client, logger *slog.Logger) Service {
+	s := &dataPlaneService{
+		k8sClient:           k8sClient,
+		agentConnectTimeout: defaultAgentConnectTimeout,
+		logger:              logger,
+	}
+	if gwClient != nil {
+		s.prober = gwClient
+	}
+	return s
 }
 
 func (s *dataPlaneService) ListDataPlanes(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.DataPlane], error) {
@@ -11,6 +11,7 @@ import (
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	gatewayClient "github.com/openchoreo/openchoreo/internal/clients/gateway"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
@@ -28,9 +29,9 @@ type dataPlaneServiceWithAuthz struct {
 var _ Service = (*dataPlaneServiceWithAuthz)(nil)
 
 // NewServiceWithAuthz creates a data plane service with authorization checks.
-func NewServiceWithAuthz(k8sClient client.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
+func NewServiceWithAuthz(k8sClient client.Client, gwClient *gatewayClient.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
 	return &dataPlaneServiceWithAuthz{
-		internal: NewService(k8sClient, logger),
+		internal: NewService(k8sClient, gwClient, logger),
 		authz:    services.NewAuthzChecker(authzPDP, logger),
 	}
 }
@@ -104,3 +105,19 @@ func (s *dataPlaneServiceWithAuthz) DeleteDataPlane(ctx context.Context, namespa
 	}
 	return s.internal.DeleteDataPlane(ctx, namespaceName, dpName)
 }
+
+// RegisterDataPlane requires permission to create the data plane.
+func (s *dataPlaneServiceWithAuthz) RegisterDataPlane(ctx context.Context, namespaceName string, dp *openchoreov1alpha1.DataPlane) (*openchoreov1alpha1.DataPlane, error) {
+	if dp == nil {
+		return nil, ErrDataPlaneNil
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionCreateDataPlane,
+		ResourceType: resourceTypeDataPlane,
+		ResourceID:   dp.Name,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.RegisterDataPlane(ctx, namespaceName, dp)
+}
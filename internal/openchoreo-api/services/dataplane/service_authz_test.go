@@ -59,6 +59,36 @@ func TestDataPlaneAuthz_CreateDataPlane(t *testing.T) {
 	})
 }
 
+func TestDataPlaneAuthz_RegisterDataPlane(t *testing.T) {
+	dp := &openchoreov1alpha1.DataPlane{ObjectMeta: metav1.ObjectMeta{Name: "dp-1"}}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("RegisterDataPlane", mock.Anything, "ns-1", dp).Return(dp, nil)
+		svc := &dataPlaneServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		result, err := svc.RegisterDataPlane(testutil.AuthzContext(), "ns-1", dp)
+		require.NoError(t, err)
+		require.Equal(t, dp, result)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "dataplane:create", "dataPlane", "dp-1", authzcore.ResourceHierarchy{Namespace: "ns-1"})
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := mocks.NewMockService(t)
+		svc := &dataPlaneServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		_, err := svc.RegisterDataPlane(testutil.AuthzContext(), "ns-1", dp)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
 func TestDataPlaneAuthz_UpdateDataPlane(t *testing.T) {
 	dp := &openchoreov1alpha1.DataPlane{ObjectMeta: metav1.ObjectMeta{Name: "dp-1", Namespace: "ns-1"}}
 
@@ -24,7 +24,7 @@ const (
 
 func newService(t *testing.T, objs ...client.Object) Service {
 	t.Helper()
-	return NewService(testutil.NewFakeClient(objs...), testutil.TestLogger())
+	return NewService(testutil.NewFakeClient(objs...), nil, testutil.TestLogger())
 }
 
 func TestCreateDataPlane(t *testing.T) {
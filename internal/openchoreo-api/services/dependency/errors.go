@@ -0,0 +1,10 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dependency
+
+import "errors"
+
+var (
+	ErrComponentNotFound = errors.New("component has no workload")
+)
@@ -0,0 +1,78 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dependency
+
+import (
+	"context"
+)
+
+// Kinds of the nodes of a dependency graph.
+const (
+	NodeKindComponent = "component"
+	NodeKindResource  = "resource"
+	NodeKindSecret    = "secret"
+	NodeKindExternal  = "external"
+)
+
+// Types of the edges of a dependency graph.
+const (
+	EdgeTypeEndpoint     = "endpoint"
+	EdgeTypeResource     = "resource"
+	EdgeTypeSharedSecret = "sharedSecret"
+	EdgeTypeExternal     = "external"
+)
+
+// Node is a component, resource, shared secret or external service in a dependency graph.
+// IDs are unique within a graph and have the form kind:project/name, or kind:name for
+// nodes that do not belong to a project.
+type Node struct {
+	ID      string
+	Kind    string
+	Project string
+	Name    string
+}
+
+// Edge points from a component to something it depends on. Endpoint and Visibility
+// are set for endpoint edges and Address for external edges.
+type Edge struct {
+	From       string
+	To         string
+	Type       string
+	Endpoint   string
+	Visibility string
+	Address    string
+}
+
+// Graph is the dependency graph of the components of a namespace. The focus fields
+// are set only when the graph was requested for a single component.
+type Graph struct {
+	Nodes []Node
+	Edges []Edge
+
+	// Component is the ID of the component the graph is focused on.
+	Component string
+	// DependsOn lists the edges leaving the focused component.
+	DependsOn []Edge
+	// Dependents lists the edges pointing at the focused component.
+	Dependents []Edge
+	// Impacted lists every component that directly or transitively consumes an endpoint
+	// of the focused component, i.e. what breaks if it is removed.
+	Impacted []Node
+}
+
+// GraphFilter narrows a dependency graph. When ProjectName is set, only nodes and edges
+// touching that project are reported, including connections to and from other projects.
+// When ComponentName is set, the graph is focused on that component.
+type GraphFilter struct {
+	ProjectName   string
+	ComponentName string
+}
+
+// Service defines the dependency graph service interface.
+// Both the core service (no authz) and the authz-wrapped service implement this.
+type Service interface {
+	// GetDependencyGraph builds the connection graph between the components of a
+	// namespace from their workloads.
+	GetDependencyGraph(ctx context.Context, namespaceName string, filter GraphFilter) (*Graph, error)
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dependency "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/dependency"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// GetDependencyGraph provides a mock function with given fields: ctx, namespaceName, filter
+func (_m *MockService) GetDependencyGraph(ctx context.Context, namespaceName string, filter dependency.GraphFilter) (*dependency.Graph, error) {
+	ret := _m.Called(ctx, namespaceName, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDependencyGraph")
+	}
+
+	var r0 *dependency.Graph
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, dependency.GraphFilter) (*dependency.Graph, error)); ok {
+		return rf(ctx, namespaceName, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, dependency.GraphFilter) *dependency.Graph); ok {
+		r0 = rf(ctx, namespaceName, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dependency.Graph)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, dependency.GraphFilter) error); ok {
+		r1 = rf(ctx, namespaceName, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetDependencyGraph_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDependencyGraph'
+type MockService_GetDependencyGraph_Call struct {
+	*mock.Call
+}
+
+// GetDependencyGraph is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - filter dependency.GraphFilter
+func (_e *MockService_Expecter) GetDependencyGraph(ctx interface{}, namespaceName interface{}, filter interface{}) *MockService_GetDependencyGraph_Call {
+	return &MockService_GetDependencyGraph_Call{Call: _e.mock.On("GetDependencyGraph", ctx, namespaceName, filter)}
+}
+
+func (_c *MockService_GetDependencyGraph_Call) Run(run func(ctx context.Context, namespaceName string, filter dependency.GraphFilter)) *MockService_GetDependencyGraph_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(dependency.GraphFilter))
+	})
+	return _c
+}
+
+func (_c *MockService_GetDependencyGraph_Call) Return(_a0 *dependency.Graph, _a1 error) *MockService_GetDependencyGraph_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetDependencyGraph_Call) RunAndReturn(run func(context.Context, string, dependency.GraphFilter) (*dependency.Graph, error)) *MockService_GetDependencyGraph_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,228 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dependency
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// dependencyService builds dependency graphs without authorization checks.
+type dependencyService struct {
+	k8sClient client.Client
+	logger    *slog.Logger
+}
+
+var _ Service = (*dependencyService)(nil)
+
+// NewService creates a new dependency graph service without authorization.
+func NewService(k8sClient client.Client, logger *slog.Logger) Service {
+	return &dependencyService{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+// GetDependencyGraph builds the graph from the workloads of the namespace: endpoint
+// connections to other components, dependencies on project-bound resources, connections
+// to external services, and secret references shared by more than one component.
+// Impact is computed on the full graph so that consumers in other projects are found.
+func (s *dependencyService) GetDependencyGraph(ctx context.Context, namespaceName string, filter GraphFilter) (*Graph, error) {
+	s.logger.Debug("Getting dependency graph", "namespace", namespaceName,
+		"project", filter.ProjectName, "component", filter.ComponentName)
+
+	workloadList := &openchoreov1alpha1.WorkloadList{}
+	if err := s.k8sClient.List(ctx, workloadList, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list workloads", "error", err)
+		return nil, fmt.Errorf("failed to list workloads: %w", err)
+	}
+	workloads := workloadList.Items
+	slices.SortFunc(workloads, func(a, b openchoreov1alpha1.Workload) int {
+		if c := cmp.Compare(a.Spec.Owner.ProjectName, b.Spec.Owner.ProjectName); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Spec.Owner.ComponentName, b.Spec.Owner.ComponentName)
+	})
+
+	b := newGraphBuilder()
+	secretUsers := map[string][]string{}
+	for i := range workloads {
+		w := &workloads[i]
+		owner := w.Spec.Owner
+		from := b.addNode(NodeKindComponent, owner.ProjectName, owner.ComponentName)
+
+		for _, conn := range w.Spec.GetDependencyEndpoints() {
+			project := conn.Project
+			if project == "" {
+				project = owner.ProjectName
+			}
+			b.edges = append(b.edges, Edge{
+				From:       from,
+				To:         b.addNode(NodeKindComponent, project, conn.Component),
+				Type:       EdgeTypeEndpoint,
+				Endpoint:   conn.Name,
+				Visibility: conn.Visibility,
+			})
+		}
+		for _, dep := range w.Spec.GetDependencyResources() {
+			b.edges = append(b.edges, Edge{
+				From: from,
+				To:   b.addNode(NodeKindResource, owner.ProjectName, dep.Ref),
+				Type: EdgeTypeResource,
+			})
+		}
+		for _, ext := range w.Spec.GetDependencyExternal() {
+			b.edges = append(b.edges, Edge{
+				From:    from,
+				To:      b.addNode(NodeKindExternal, "", ext.Name),
+				Type:    EdgeTypeExternal,
+				Address: ext.Address,
+			})
+		}
+		for _, secret := range workloadSecretNames(w) {
+			if !slices.Contains(secretUsers[secret], from) {
+				secretUsers[secret] = append(secretUsers[secret], from)
+			}
+		}
+	}
+
+	// Secrets only connect components when they are shared; a secret used by a single
+	// component carries no impact information.
+	for _, secret := range slices.Sorted(maps.Keys(secretUsers)) {
+		users := secretUsers[secret]
+		if len(users) < 2 {
+			continue
+		}
+		to := b.addNode(NodeKindSecret, "", secret)
+		for _, from := range users {
+			b.edges = append(b.edges, Edge{From: from, To: to, Type: EdgeTypeSharedSecret})
+		}
+	}
+
+	graph := b.scoped(filter.ProjectName)
+	if filter.ComponentName == "" {
+		return graph, nil
+	}
+
+	var focus string
+	for _, n := range graph.Nodes {
+		if n.Kind == NodeKindComponent && n.Name == filter.ComponentName &&
+			(filter.ProjectName == "" || n.Project == filter.ProjectName) {
+			focus = n.ID
+			break
+		}
+	}
+	if focus == "" {
+		return nil, fmt.Errorf("%w: %q in namespace %q", ErrComponentNotFound, filter.ComponentName, namespaceName)
+	}
+
+	graph.Component = focus
+	graph.DependsOn = []Edge{}
+	graph.Dependents = []Edge{}
+	for _, e := range graph.Edges {
+		switch {
+		case e.From == focus:
+			graph.DependsOn = append(graph.DependsOn, e)
+		case e.To == focus:
+			graph.Dependents = append(graph.Dependents, e)
+		}
+	}
+	graph.Impacted = []Node{}
+	for _, id := range impactedComponents(focus, b.edges) {
+		graph.Impacted = append(graph.Impacted, b.nodes[id])
+	}
+	return graph, nil
+}
+
+// graphBuilder collects the nodes and edges of a graph, keeping nodes in first-seen order.
+type graphBuilder struct {
+	nodes map[string]Node
+	order []string
+	edges []Edge
+}
+
+func newGraphBuilder() *graphBuilder {
+	return &graphBuilder{nodes: map[string]Node{}}
+}
+
+func (b *graphBuilder) addNode(kind, project, name string) string {
+	id := kind + ":" + name
+	if project != "" {
+		id = kind + ":" + project + "/" + name
+	}
+	if _, ok := b.nodes[id]; !ok {
+		b.nodes[id] = Node{ID: id, Kind: kind, Project: project, Name: name}
+		b.order = append(b.order, id)
+	}
+	return id
+}
+
+// scoped returns the part of the graph touching the given project, or the whole graph
+// when project is empty. Components of the project are kept even when unconnected.
+func (b *graphBuilder) scoped(project string) *Graph {
+	inScope := func(id string) bool {
+		return project == "" || b.nodes[id].Project == project
+	}
+	visible := map[string]bool{}
+	graph := &Graph{Nodes: []Node{}, Edges: []Edge{}}
+	for _, e := range b.edges {
+		if inScope(e.From) || inScope(e.To) {
+			graph.Edges = append(graph.Edges, e)
+			visible[e.From], visible[e.To] = true, true
+		}
+	}
+	for _, id := range b.order {
+		if visible[id] || (inScope(id) && b.nodes[id].Kind == NodeKindComponent) {
+			graph.Nodes = append(graph.Nodes, b.nodes[id])
+		}
+	}
+	return graph
+}
+
+// impactedComponents walks endpoint edges backwards from the given node and returns every
+// component that directly or transitively consumes it.
+func impactedComponents(start string, edges []Edge) []string {
+	impacted := []string{}
+	seen := map[string]bool{start: true}
+	queue := []string{start}
+	for len(queue) > 0 {
+		target := queue[0]
+		queue = queue[1:]
+		for _, e := range edges {
+			if e.Type != EdgeTypeEndpoint || e.To != target || seen[e.From] {
+				continue
+			}
+			seen[e.From] = true
+			impacted = append(impacted, e.From)
+			queue = append(queue, e.From)
+		}
+	}
+	return impacted
+}
+
+// workloadSecretNames returns the names of the secrets referenced by a workload's
+// environment variables and files, in first-seen order.
+func workloadSecretNames(w *openchoreov1alpha1.Workload) []string {
+	var names []string
+	add := func(from *openchoreov1alpha1.EnvVarValueFrom) {
+		if from != nil && from.SecretKeyRef != nil && !slices.Contains(names, from.SecretKeyRef.Name) {
+			names = append(names, from.SecretKeyRef.Name)
+		}
+	}
+	for _, e := range w.Spec.Container.Env {
+		add(e.ValueFrom)
+	}
+	for _, f := range w.Spec.Container.Files {
+		add(f.ValueFrom)
+	}
+	return names
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dependency
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+const resourceTypeWorkload = "workload"
+
+// dependencyServiceWithAuthz wraps a Service and adds authorization checks.
+// Handlers should use this. Other services should use the unwrapped Service directly.
+type dependencyServiceWithAuthz struct {
+	internal Service
+	authz    *services.AuthzChecker
+}
+
+var _ Service = (*dependencyServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates a dependency graph service with authorization checks.
+func NewServiceWithAuthz(k8sClient client.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &dependencyServiceWithAuthz{
+		internal: NewService(k8sClient, logger),
+		authz:    services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+// GetDependencyGraph drops the dependencies declared by components whose workloads the
+// caller is not allowed to view. Those components still appear as targets of the
+// dependencies of visible components. Focusing on a component requires permission to
+// view its workload.
+func (s *dependencyServiceWithAuthz) GetDependencyGraph(ctx context.Context, namespaceName string, filter GraphFilter) (*Graph, error) {
+	graph, err := s.internal.GetDependencyGraph(ctx, namespaceName, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Impacted components may lie outside the reported part of the graph, so they are
+	// checked as well.
+	var components []Node
+	seen := map[string]bool{}
+	for _, n := range slices.Concat(graph.Nodes, graph.Impacted) {
+		if n.Kind == NodeKindComponent && !seen[n.ID] {
+			seen[n.ID] = true
+			components = append(components, n)
+		}
+	}
+	if len(components) == 0 {
+		return graph, nil
+	}
+
+	requests := make([]services.CheckRequest, len(components))
+	for i, n := range components {
+		requests[i] = services.CheckRequest{
+			Action:       authz.ActionViewWorkload,
+			ResourceType: resourceTypeWorkload,
+			ResourceID:   n.Name,
+			Hierarchy: authz.ResourceHierarchy{
+				Namespace: namespaceName,
+				Project:   n.Project,
+				Component: n.Name,
+			},
+		}
+	}
+	decisions, err := s.authz.BatchCheck(ctx, requests)
+	if err != nil {
+		return nil, err
+	}
+	allowed := make(map[string]bool, len(components))
+	for i, n := range components {
+		allowed[n.ID] = i < len(decisions) && decisions[i]
+	}
+	if graph.Component != "" && !allowed[graph.Component] {
+		return nil, services.ErrForbidden
+	}
+	return filterGraph(graph, allowed), nil
+}
+
+// filterGraph keeps the edges leaving allowed components, the allowed components and
+// every node those edges point at.
+func filterGraph(graph *Graph, allowed map[string]bool) *Graph {
+	keepEdges := func(edges []Edge) []Edge {
+		if edges == nil {
+			return nil
+		}
+		kept := []Edge{}
+		for _, e := range edges {
+			if allowed[e.From] {
+				kept = append(kept, e)
+			}
+		}
+		return kept
+	}
+
+	filtered := &Graph{
+		Edges:      keepEdges(graph.Edges),
+		Component:  graph.Component,
+		DependsOn:  keepEdges(graph.DependsOn),
+		Dependents: keepEdges(graph.Dependents),
+	}
+	referenced := map[string]bool{}
+	for _, e := range filtered.Edges {
+		referenced[e.To] = true
+	}
+	filtered.Nodes = []Node{}
+	for _, n := range graph.Nodes {
+		if allowed[n.ID] || referenced[n.ID] {
+			filtered.Nodes = append(filtered.Nodes, n)
+		}
+	}
+	if graph.Impacted != nil {
+		filtered.Impacted = []Node{}
+		for _, n := range graph.Impacted {
+			if allowed[n.ID] {
+				filtered.Impacted = append(filtered.Impacted, n)
+			}
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dependency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func newAuthzService(pdp authzcore.PDP) Service {
+	return &dependencyServiceWithAuthz{
+		internal: newService(testWorkloads()...),
+		authz:    testutil.NewTestAuthzChecker(pdp),
+	}
+}
+
+func TestGetDependencyGraph_AuthzCheck(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		graph, err := newAuthzService(pdp).GetDependencyGraph(testutil.AuthzContext(), testNamespace, GraphFilter{})
+		require.NoError(t, err)
+		assert.Len(t, graph.Edges, 6)
+		require.Len(t, pdp.Captured, 3)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "workload:view", "workload", "nightly",
+			authzcore.ResourceHierarchy{Namespace: testNamespace, Project: "reports", Component: "nightly"})
+	})
+
+	t.Run("denied drops all dependencies", func(t *testing.T) {
+		graph, err := newAuthzService(testutil.DenyPDP()).GetDependencyGraph(testutil.AuthzContext(), testNamespace, GraphFilter{})
+		require.NoError(t, err)
+		assert.Empty(t, graph.Nodes)
+		assert.Empty(t, graph.Edges)
+	})
+
+	t.Run("denied focus", func(t *testing.T) {
+		_, err := newAuthzService(testutil.DenyPDP()).GetDependencyGraph(testutil.AuthzContext(), testNamespace,
+			GraphFilter{ComponentName: "orders"})
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestFilterGraph(t *testing.T) {
+	graph, err := newService(testWorkloads()...).GetDependencyGraph(t.Context(), testNamespace,
+		GraphFilter{ComponentName: "orders"})
+	require.NoError(t, err)
+
+	filtered := filterGraph(graph, map[string]bool{
+		"component:shop/orders":   true,
+		"component:shop/frontend": true,
+	})
+
+	assert.ElementsMatch(t, []string{
+		"component:shop/frontend", "component:shop/orders",
+		"resource:shop/orders-db", "external:payments", "secret:shared-db",
+	}, nodeIDs(filtered.Nodes))
+	for _, e := range filtered.Edges {
+		assert.NotEqual(t, "component:reports/nightly", e.From)
+	}
+	assert.Equal(t, []string{"component:shop/frontend"}, nodeIDs(filtered.Impacted))
+	assert.Len(t, filtered.Dependents, 1)
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dependency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+const testNamespace = "test-ns"
+
+func newWorkload(
+	project, component string, deps *openchoreov1alpha1.WorkloadDependencies, secrets ...string,
+) *openchoreov1alpha1.Workload {
+	w := testutil.NewWorkload(testNamespace, project, component, component+"-workload")
+	w.Spec.Dependencies = deps
+	for _, s := range secrets {
+		w.Spec.Container.Env = append(w.Spec.Container.Env, openchoreov1alpha1.EnvVar{
+			Key: "SECRET",
+			ValueFrom: &openchoreov1alpha1.EnvVarValueFrom{
+				SecretKeyRef: &openchoreov1alpha1.SecretKeyRef{Name: s, Key: "value"},
+			},
+		})
+	}
+	return w
+}
+
+func endpointDep(project, component string) *openchoreov1alpha1.WorkloadDependencies {
+	return &openchoreov1alpha1.WorkloadDependencies{Endpoints: []openchoreov1alpha1.WorkloadConnection{
+		{Project: project, Component: component, Name: "api", Visibility: "project"},
+	}}
+}
+
+// testWorkloads returns a namespace where shop/frontend calls shop/orders, reports/nightly
+// calls shop/frontend, shop/orders uses a resource and an external payment API, and
+// frontend and orders share a secret.
+func testWorkloads() []client.Object {
+	return []client.Object{
+		newWorkload("shop", "frontend", endpointDep("", "orders"), "shared-db"),
+		newWorkload("shop", "orders", &openchoreov1alpha1.WorkloadDependencies{
+			Resources: []openchoreov1alpha1.WorkloadResourceDependency{{Ref: "orders-db"}},
+			External: []openchoreov1alpha1.WorkloadExternalConnection{
+				{Name: "payments", Address: "https://api.payments.example.com"},
+			},
+		}, "shared-db", "orders-only"),
+		newWorkload("reports", "nightly", endpointDep("shop", "frontend")),
+	}
+}
+
+func newService(objects ...client.Object) Service {
+	return NewService(testutil.NewFakeClient(objects...), testutil.TestLogger())
+}
+
+func edgeTypes(edges []Edge) []string {
+	types := make([]string, 0, len(edges))
+	for _, e := range edges {
+		types = append(types, e.Type)
+	}
+	return types
+}
+
+func nodeIDs(nodes []Node) []string {
+	ids := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		ids = append(ids, n.ID)
+	}
+	return ids
+}
+
+func TestGetDependencyGraph(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("full graph", func(t *testing.T) {
+		graph, err := newService(testWorkloads()...).GetDependencyGraph(ctx, testNamespace, GraphFilter{})
+		require.NoError(t, err)
+
+		assert.ElementsMatch(t, []string{
+			EdgeTypeEndpoint, EdgeTypeResource, EdgeTypeExternal, EdgeTypeEndpoint,
+			EdgeTypeSharedSecret, EdgeTypeSharedSecret,
+		}, edgeTypes(graph.Edges))
+		assert.ElementsMatch(t, []string{
+			"component:reports/nightly", "component:shop/frontend", "component:shop/orders",
+			"resource:shop/orders-db", "external:payments", "secret:shared-db",
+		}, nodeIDs(graph.Nodes))
+		assert.Empty(t, graph.Component)
+		assert.Nil(t, graph.Impacted)
+	})
+
+	t.Run("external edge carries the address", func(t *testing.T) {
+		graph, err := newService(testWorkloads()...).GetDependencyGraph(ctx, testNamespace, GraphFilter{})
+		require.NoError(t, err)
+		for _, e := range graph.Edges {
+			if e.Type == EdgeTypeExternal {
+				assert.Equal(t, Edge{
+					From: "component:shop/orders", To: "external:payments",
+					Type: EdgeTypeExternal, Address: "https://api.payments.example.com",
+				}, e)
+				return
+			}
+		}
+		t.Fatal("expected an external edge")
+	})
+
+	t.Run("project scope keeps cross-project connections", func(t *testing.T) {
+		graph, err := newService(testWorkloads()...).GetDependencyGraph(ctx, testNamespace, GraphFilter{ProjectName: "reports"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"component:reports/nightly", "component:shop/frontend"}, nodeIDs(graph.Nodes))
+		assert.Equal(t, []string{EdgeTypeEndpoint}, edgeTypes(graph.Edges))
+	})
+
+	t.Run("impact of a component includes transitive consumers in other projects", func(t *testing.T) {
+		graph, err := newService(testWorkloads()...).GetDependencyGraph(ctx, testNamespace,
+			GraphFilter{ProjectName: "shop", ComponentName: "orders"})
+		require.NoError(t, err)
+
+		assert.Equal(t, "component:shop/orders", graph.Component)
+		assert.Len(t, graph.DependsOn, 3)
+		assert.Len(t, graph.Dependents, 1)
+		assert.Equal(t, []string{"component:shop/frontend", "component:reports/nightly"}, nodeIDs(graph.Impacted))
+	})
+
+	t.Run("unknown component", func(t *testing.T) {
+		_, err := newService(testWorkloads()...).GetDependencyGraph(ctx, testNamespace, GraphFilter{ComponentName: "missing"})
+		require.ErrorIs(t, err, ErrComponentNotFound)
+	})
+}
@@ -0,0 +1,13 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deployment
+
+import "errors"
+
+var (
+	ErrEnvironmentNotInPipeline = errors.New("environment is not in the project's deployment pipeline")
+	ErrBuildNotFound            = errors.New("build not found")
+	ErrBuildNotReady            = errors.New("build has not produced an image")
+	ErrDeploymentNotFound       = errors.New("component is not deployed to the environment")
+)
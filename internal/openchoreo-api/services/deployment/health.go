@@ -0,0 +1,293 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+)
+
+// healthRank orders health from best to worst, so that the health of a component in an
+// environment is the worst of its rollout phase and its resources.
+var healthRank = map[Health]int{
+	HealthHealthy:     0,
+	HealthSuspended:   1,
+	HealthUnknown:     2,
+	HealthProgressing: 3,
+	HealthDegraded:    4,
+}
+
+func (s *deploymentService) GetProjectHealth(ctx context.Context, namespaceName, projectName string) (*ProjectHealth, error) {
+	s.logger.Debug("Getting project health", "namespace", namespaceName, "project", projectName)
+
+	project := &openchoreov1alpha1.Project{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: projectName}, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, projectsvc.ErrProjectNotFound
+		}
+		s.logger.Error("Failed to get project", "error", err)
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	componentList := &openchoreov1alpha1.ComponentList{}
+	if err := s.k8sClient.List(ctx, componentList, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list components", "error", err)
+		return nil, fmt.Errorf("failed to list components: %w", err)
+	}
+	var componentNames []string
+	for i := range componentList.Items {
+		if componentList.Items[i].Spec.Owner.ProjectName == projectName {
+			componentNames = append(componentNames, componentList.Items[i].Name)
+		}
+	}
+	sort.Strings(componentNames)
+
+	bindingList := &openchoreov1alpha1.ReleaseBindingList{}
+	if err := s.k8sClient.List(ctx, bindingList, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list release bindings", "error", err)
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+	// bindings is keyed by component and then by environment.
+	bindings := make(map[string]map[string]*openchoreov1alpha1.ReleaseBinding)
+	var boundEnvironments []string
+	for i := range bindingList.Items {
+		rb := &bindingList.Items[i]
+		if rb.Spec.Owner.ProjectName != projectName {
+			continue
+		}
+		if bindings[rb.Spec.Owner.ComponentName] == nil {
+			bindings[rb.Spec.Owner.ComponentName] = make(map[string]*openchoreov1alpha1.ReleaseBinding)
+		}
+		bindings[rb.Spec.Owner.ComponentName][rb.Spec.Environment] = rb
+		boundEnvironments = append(boundEnvironments, rb.Spec.Environment)
+	}
+
+	environments, err := s.projectEnvironments(ctx, namespaceName, project.Spec.DeploymentPipelineRef.Name, boundEnvironments)
+	if err != nil {
+		return nil, err
+	}
+
+	renderedReleases, err := s.dataPlaneReleases(ctx, namespaceName, projectName)
+	if err != nil {
+		return nil, err
+	}
+	images, err := s.releaseImages(ctx, namespaceName, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	health := &ProjectHealth{
+		ProjectName:  projectName,
+		Environments: environments,
+		Components:   make([]ComponentHealth, 0, len(componentNames)),
+	}
+	for _, componentName := range componentNames {
+		ch := ComponentHealth{
+			ComponentName: componentName,
+			Environments:  make([]EnvironmentHealth, 0, len(environments)),
+		}
+		for _, env := range environments {
+			rb := bindings[componentName][env]
+			if rb == nil {
+				ch.Environments = append(ch.Environments, EnvironmentHealth{Environment: env, Health: HealthNotDeployed})
+				continue
+			}
+			ch.Environments = append(ch.Environments,
+				environmentHealth(rb, renderedReleases[componentName+"/"+env], images[rb.Spec.ReleaseName]))
+		}
+		health.Components = append(health.Components, ch)
+	}
+	return health, nil
+}
+
+// projectEnvironments returns the environments of a project in the order of their stage in the
+// project's deployment pipeline, followed by the other environments its components are bound to.
+func (s *deploymentService) projectEnvironments(ctx context.Context, namespaceName, pipelineName string, boundEnvironments []string) ([]string, error) {
+	positions, err := s.environmentService.ListPipelinePositions(ctx, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	stages := make(map[string]int)
+	for env, envPositions := range positions {
+		for _, pos := range envPositions {
+			if pos.PipelineName != pipelineName {
+				continue
+			}
+			stage := pos.Stage
+			if stage < 0 {
+				// Environments only reachable through a promotion cycle go after the others.
+				stage = len(positions)
+			}
+			stages[env] = stage
+		}
+	}
+	environments := make([]string, 0, len(stages))
+	for env := range stages {
+		environments = append(environments, env)
+	}
+	sort.Slice(environments, func(i, j int) bool {
+		if stages[environments[i]] != stages[environments[j]] {
+			return stages[environments[i]] < stages[environments[j]]
+		}
+		return environments[i] < environments[j]
+	})
+
+	var others []string
+	for _, env := range boundEnvironments {
+		if _, ok := stages[env]; !ok && !slices.Contains(others, env) {
+			others = append(others, env)
+		}
+	}
+	sort.Strings(others)
+	return append(environments, others...), nil
+}
+
+// dataPlaneReleases returns the data plane rendered releases of a project's components, keyed by
+// component and environment as "component/environment".
+func (s *deploymentService) dataPlaneReleases(ctx context.Context, namespaceName, projectName string) (map[string]*openchoreov1alpha1.RenderedRelease, error) {
+	releaseList := &openchoreov1alpha1.RenderedReleaseList{}
+	if err := s.k8sClient.List(ctx, releaseList, client.InNamespace(namespaceName),
+		client.MatchingLabels{ocLabels.LabelKeyProjectName: projectName}); err != nil {
+		s.logger.Error("Failed to list rendered releases", "error", err)
+		return nil, fmt.Errorf("failed to list rendered releases: %w", err)
+	}
+
+	releases := make(map[string]*openchoreov1alpha1.RenderedRelease)
+	for i := range releaseList.Items {
+		release := &releaseList.Items[i]
+		if release.Spec.TargetPlane != "" && release.Spec.TargetPlane != "dataplane" {
+			continue
+		}
+		releases[release.Spec.Owner.ComponentName+"/"+release.Spec.EnvironmentName] = release
+	}
+	return releases, nil
+}
+
+// releaseImages returns the container image of each component release of a project, keyed by
+// release name.
+func (s *deploymentService) releaseImages(ctx context.Context, namespaceName, projectName string) (map[string]string, error) {
+	releaseList := &openchoreov1alpha1.ComponentReleaseList{}
+	if err := s.k8sClient.List(ctx, releaseList, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list component releases", "error", err)
+		return nil, fmt.Errorf("failed to list component releases: %w", err)
+	}
+
+	images := make(map[string]string)
+	for i := range releaseList.Items {
+		release := &releaseList.Items[i]
+		if release.Spec.Owner.ProjectName == projectName {
+			images[release.Name] = release.Spec.Workload.Container.Image
+		}
+	}
+	return images, nil
+}
+
+// environmentHealth computes the health of a component in the environment of a release binding.
+// release is the binding's data plane rendered release and is nil until the controller creates it.
+func environmentHealth(rb *openchoreov1alpha1.ReleaseBinding, release *openchoreov1alpha1.RenderedRelease, image string) EnvironmentHealth {
+	eh := EnvironmentHealth{
+		Environment:        rb.Spec.Environment,
+		ReleaseBindingName: rb.Name,
+		ReleaseName:        rb.Spec.ReleaseName,
+		Image:              image,
+		LastDeployedAt:     rb.CreationTimestamp.Time,
+	}
+	if rb.Status.LastSpecUpdateTime != nil {
+		eh.LastDeployedAt = rb.Status.LastSpecUpdateTime.Time
+	}
+	if len(rb.Status.History) > 0 && rb.Status.History[0].ReleaseName == rb.Spec.ReleaseName {
+		eh.ConfigChecksum = rb.Status.History[0].ConfigChecksum
+	}
+	eh.Phase, eh.Message = computePhase(rb)
+
+	if rb.Spec.State == openchoreov1alpha1.ReleaseStateUndeploy {
+		eh.Health = HealthSuspended
+		return eh
+	}
+	switch eh.Phase {
+	case PhaseReady:
+		eh.Health = HealthHealthy
+	case PhaseFailed:
+		eh.Health = HealthDegraded
+	default:
+		eh.Health = HealthProgressing
+	}
+	if release == nil {
+		return eh
+	}
+
+	for _, rs := range release.Status.Resources {
+		resourceHealth := Health(rs.HealthStatus)
+		if _, ok := healthRank[resourceHealth]; !ok {
+			resourceHealth = HealthUnknown
+		}
+		if healthRank[resourceHealth] > healthRank[eh.Health] {
+			eh.Health = resourceHealth
+		}
+	}
+	eh.Replicas = replicaStatus(release)
+	return eh
+}
+
+// replicaStatus returns the replica status of the first Deployment or StatefulSet of a rendered
+// release, or nil when it has none or the data plane has not reported its status yet.
+func replicaStatus(release *openchoreov1alpha1.RenderedRelease) *ReplicaStatus {
+	for _, rs := range release.Status.Resources {
+		if rs.Group != "apps" || (rs.Kind != "Deployment" && rs.Kind != "StatefulSet") || rs.Status == nil {
+			continue
+		}
+		var status struct {
+			Replicas          int32 `json:"replicas"`
+			ReadyReplicas     int32 `json:"readyReplicas"`
+			AvailableReplicas int32 `json:"availableReplicas"`
+			UpdatedReplicas   int32 `json:"updatedReplicas"`
+		}
+		if err := json.Unmarshal(rs.Status.Raw, &status); err != nil {
+			continue
+		}
+		return &ReplicaStatus{
+			Desired:   desiredReplicas(release, rs.ID, status.Replicas),
+			Ready:     status.ReadyReplicas,
+			Available: status.AvailableReplicas,
+			Updated:   status.UpdatedReplicas,
+		}
+	}
+	return nil
+}
+
+// desiredReplicas returns spec.replicas of the rendered manifest with the given ID, which
+// defaults to 1 when unset. It falls back to the replica count reported in the status when
+// the manifest cannot be read.
+func desiredReplicas(release *openchoreov1alpha1.RenderedRelease, id string, observed int32) int32 {
+	for _, manifest := range release.Spec.Resources {
+		if manifest.ID != id || manifest.Object == nil {
+			continue
+		}
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(manifest.Object.Raw); err != nil {
+			return observed
+		}
+		replicas, found, err := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+		if err != nil {
+			return observed
+		}
+		if !found {
+			return 1
+		}
+		return int32(replicas) //nolint:gosec // replica counts fit in int32
+	}
+	return observed
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deployment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func readyBinding(componentName, env, releaseName string, ready metav1.ConditionStatus, reason string) *openchoreov1alpha1.ReleaseBinding {
+	rb := testutil.NewReleaseBinding(testNamespace, testProjectName, componentName, env, componentName+"-"+env)
+	rb.Generation = 1
+	rb.Spec.ReleaseName = releaseName
+	rb.Status.ObservedGeneration = 1
+	rb.Status.Conditions = []metav1.Condition{{Type: "Ready", Status: ready, Reason: reason, ObservedGeneration: 1}}
+	return rb
+}
+
+func dataPlaneRelease(componentName, env string, spec string, resources ...openchoreov1alpha1.RenderedManifestStatus) *openchoreov1alpha1.RenderedRelease {
+	return &openchoreov1alpha1.RenderedRelease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      componentName + "-" + env,
+			Namespace: testNamespace,
+			Labels:    map[string]string{ocLabels.LabelKeyProjectName: testProjectName},
+		},
+		Spec: openchoreov1alpha1.RenderedReleaseSpec{
+			Owner:           openchoreov1alpha1.RenderedReleaseOwner{ProjectName: testProjectName, ComponentName: componentName},
+			EnvironmentName: env,
+			Resources: []openchoreov1alpha1.RenderedManifest{
+				{ID: "deployment", Object: &runtime.RawExtension{Raw: []byte(spec)}},
+			},
+		},
+		Status: openchoreov1alpha1.RenderedReleaseStatus{Resources: resources},
+	}
+}
+
+func TestGetProjectHealth(t *testing.T) {
+	ctx := context.Background()
+	deployedAt := metav1.NewTime(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+
+	web := readyBinding(testComponentName, "dev", "rel-1", metav1.ConditionTrue, "Ready")
+	web.Status.LastSpecUpdateTime = &deployedAt
+	web.Status.History = []openchoreov1alpha1.ReleaseRecord{{ReleaseName: "rel-1", ConfigChecksum: "sha256:abc", DeployedAt: deployedAt}}
+	webRelease := dataPlaneRelease(testComponentName, "dev",
+		`{"apiVersion":"apps/v1","kind":"Deployment","spec":{"replicas":3}}`,
+		openchoreov1alpha1.RenderedManifestStatus{
+			ID: "deployment", Group: "apps", Version: "v1", Kind: "Deployment",
+			Status:       &runtime.RawExtension{Raw: []byte(`{"replicas":3,"readyReplicas":2,"availableReplicas":2,"updatedReplicas":3}`)},
+			HealthStatus: openchoreov1alpha1.HealthStatusHealthy,
+		},
+		openchoreov1alpha1.RenderedManifestStatus{ID: "service", Kind: "Service", HealthStatus: openchoreov1alpha1.HealthStatusHealthy},
+	)
+
+	worker := readyBinding("worker", "dev", "", metav1.ConditionTrue, "Ready")
+	workerRelease := dataPlaneRelease("worker", "dev", `{"apiVersion":"apps/v1","kind":"Deployment"}`,
+		openchoreov1alpha1.RenderedManifestStatus{
+			ID: "deployment", Group: "apps", Kind: "Deployment",
+			Status:       &runtime.RawExtension{Raw: []byte(`{"replicas":1}`)},
+			HealthStatus: openchoreov1alpha1.HealthStatusDegraded,
+		},
+	)
+	workerQA := readyBinding("worker", "qa", "", metav1.ConditionFalse, "ResourcesProgressing")
+	workerQA.Spec.State = openchoreov1alpha1.ReleaseStateUndeploy
+
+	objs := append(seedObjects(),
+		testutil.NewComponent(testNamespace, testProjectName, "worker"),
+		testutil.NewComponent(testNamespace, "other-project", "other"),
+		testutil.NewReleaseBinding(testNamespace, "other-project", "other", "staging", "other-staging"),
+		testutil.NewComponentRelease(testNamespace, testProjectName, testComponentName, "rel-1"),
+		web, webRelease, worker, workerRelease, workerQA,
+	)
+	svc := newService(objs...)
+
+	health, err := svc.GetProjectHealth(ctx, testNamespace, testProjectName)
+	require.NoError(t, err)
+	assert.Equal(t, testProjectName, health.ProjectName)
+	assert.Equal(t, []string{"dev", "prod", "qa"}, health.Environments)
+	require.Len(t, health.Components, 2)
+
+	comp := health.Components[0]
+	assert.Equal(t, testComponentName, comp.ComponentName)
+	require.Len(t, comp.Environments, 3)
+	dev := comp.Environments[0]
+	assert.Equal(t, HealthHealthy, dev.Health)
+	assert.Equal(t, PhaseReady, dev.Phase)
+	assert.Equal(t, "comp-1-dev", dev.ReleaseBindingName)
+	assert.Equal(t, "rel-1", dev.ReleaseName)
+	assert.NotEmpty(t, dev.Image)
+	assert.Equal(t, "sha256:abc", dev.ConfigChecksum)
+	assert.True(t, deployedAt.Time.Equal(dev.LastDeployedAt))
+	assert.Equal(t, &ReplicaStatus{Desired: 3, Ready: 2, Available: 2, Updated: 3}, dev.Replicas)
+	assert.Equal(t, EnvironmentHealth{Environment: "prod", Health: HealthNotDeployed}, comp.Environments[1])
+	assert.Equal(t, EnvironmentHealth{Environment: "qa", Health: HealthNotDeployed}, comp.Environments[2])
+
+	workerHealth := health.Components[1]
+	assert.Equal(t, "worker", workerHealth.ComponentName)
+	assert.Equal(t, HealthDegraded, workerHealth.Environments[0].Health)
+	assert.Equal(t, &ReplicaStatus{Desired: 1, Ready: 0, Available: 0, Updated: 0}, workerHealth.Environments[0].Replicas)
+	assert.Equal(t, HealthNotDeployed, workerHealth.Environments[1].Health)
+	assert.Equal(t, HealthSuspended, workerHealth.Environments[2].Health)
+	assert.Nil(t, workerHealth.Environments[2].Replicas)
+	assert.Empty(t, workerHealth.Environments[0].ConfigChecksum)
+
+	_, err = svc.GetProjectHealth(ctx, testNamespace, "missing")
+	require.ErrorIs(t, err, projectsvc.ErrProjectNotFound)
+}
+
+func TestEnvironmentHealth(t *testing.T) {
+	tests := []struct {
+		name      string
+		ready     metav1.ConditionStatus
+		reason    string
+		resources []openchoreov1alpha1.HealthStatus
+		want      Health
+	}{
+		{"ready without release", metav1.ConditionTrue, "Ready", nil, HealthHealthy},
+		{"progressing rollout", metav1.ConditionFalse, "ResourcesProgressing", nil, HealthProgressing},
+		{"failed rollout", metav1.ConditionFalse, "RenderingFailed", nil, HealthDegraded},
+		{"worst resource wins", metav1.ConditionTrue, "Ready",
+			[]openchoreov1alpha1.HealthStatus{openchoreov1alpha1.HealthStatusHealthy, openchoreov1alpha1.HealthStatusUnknown}, HealthUnknown},
+		{"phase worse than resources", metav1.ConditionFalse, "ResourcesProgressing",
+			[]openchoreov1alpha1.HealthStatus{openchoreov1alpha1.HealthStatusSuspended}, HealthProgressing},
+		{"unreported resource health", metav1.ConditionTrue, "Ready", []openchoreov1alpha1.HealthStatus{""}, HealthUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rb := readyBinding(testComponentName, "dev", "", tt.ready, tt.reason)
+			var release *openchoreov1alpha1.RenderedRelease
+			if tt.resources != nil {
+				release = dataPlaneRelease(testComponentName, "dev", `{}`)
+				for _, h := range tt.resources {
+					release.Status.Resources = append(release.Status.Resources, openchoreov1alpha1.RenderedManifestStatus{HealthStatus: h})
+				}
+			}
+			assert.Equal(t, tt.want, environmentHealth(rb, release, "").Health)
+		})
+	}
+}
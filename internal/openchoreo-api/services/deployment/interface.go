@@ -0,0 +1,138 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deployment
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// DeployRequest contains the parameters for deploying a component to an environment.
+// Exactly one of Image and BuildName must be set.
+type DeployRequest struct {
+	ComponentName string
+	Environment   string
+	// Image is the container image to deploy.
+	Image string
+	// BuildName is a succeeded WorkflowRun of the component whose built image is deployed.
+	BuildName string
+}
+
+// DeployResult is the outcome of a deployment.
+type DeployResult struct {
+	Image          string
+	Release        *openchoreov1alpha1.ComponentRelease
+	ReleaseBinding *openchoreov1alpha1.ReleaseBinding
+	// Created reports whether the release binding was created rather than updated.
+	Created bool
+}
+
+// Phase is the rollout phase of a component deployment.
+type Phase string
+
+const (
+	// PhasePending means the controller has not yet acted on the latest change.
+	PhasePending Phase = "Pending"
+	// PhaseProgressing means the release is being rolled out.
+	PhaseProgressing Phase = "Progressing"
+	// PhaseReady means the release is rolled out and ready.
+	PhaseReady Phase = "Ready"
+	// PhaseFailed means the rollout failed.
+	PhaseFailed Phase = "Failed"
+)
+
+// Status is the rollout progress of a component in an environment.
+type Status struct {
+	ComponentName      string
+	Environment        string
+	ReleaseBindingName string
+	ReleaseName        string
+	// Image is the container image of the bound release, when it can be read.
+	Image      string
+	Phase      Phase
+	Message    string
+	Conditions []metav1.Condition
+	Endpoints  []openchoreov1alpha1.EndpointURLStatus
+}
+
+// Health is the health of a component in an environment, combining the rollout
+// phase with the health the data plane reports for the deployed resources.
+type Health string
+
+const (
+	HealthHealthy     Health = "Healthy"
+	HealthProgressing Health = "Progressing"
+	HealthDegraded    Health = "Degraded"
+	// HealthSuspended means the component is bound to the environment but
+	// intentionally not running, such as when its release binding is undeployed.
+	HealthSuspended Health = "Suspended"
+	HealthUnknown   Health = "Unknown"
+	// HealthNotDeployed means the component has no release binding for the environment.
+	HealthNotDeployed Health = "NotDeployed"
+)
+
+// ReplicaStatus is the replica status of a component's Deployment or StatefulSet
+// as last observed on the data plane.
+type ReplicaStatus struct {
+	Desired   int32
+	Ready     int32
+	Available int32
+	Updated   int32
+}
+
+// EnvironmentHealth is the health of a component in one environment.
+type EnvironmentHealth struct {
+	Environment string
+	// The fields below are empty when the component is not deployed to the environment.
+	ReleaseBindingName string
+	ReleaseName        string
+	Image              string
+	// ConfigChecksum is the checksum of the bound release and the binding's
+	// environment configuration, or empty when the controller has not recorded it yet.
+	ConfigChecksum string
+	Phase          Phase
+	Health         Health
+	Message        string
+	// Replicas is nil when the component has no Deployment or StatefulSet, or the
+	// data plane has not reported its status yet.
+	Replicas *ReplicaStatus
+	// LastDeployedAt is when the release binding last changed, such as when a
+	// release was deployed or promoted to the environment.
+	LastDeployedAt time.Time
+}
+
+// ComponentHealth is the health of a component across the environments of its project.
+type ComponentHealth struct {
+	ComponentName string
+	// Environments holds one entry per environment of the project, in the
+	// order of ProjectHealth.Environments.
+	Environments []EnvironmentHealth
+}
+
+// ProjectHealth is the health of every component of a project across its environments.
+type ProjectHealth struct {
+	ProjectName string
+	// Environments are ordered by their stage in the project's deployment
+	// pipeline, followed by any other environment a component is bound to.
+	Environments []string
+	Components   []ComponentHealth
+}
+
+// Service defines the deployment service interface.
+// Both the core service (no authz) and the authz-wrapped service implement this.
+type Service interface {
+	// Deploy sets the component's workload image, generates a release from it and binds
+	// the release to the environment, creating the release binding when needed.
+	Deploy(ctx context.Context, namespaceName string, req *DeployRequest) (*DeployResult, error)
+	// GetDeploymentStatus reports the rollout progress of a component in an environment.
+	GetDeploymentStatus(ctx context.Context, namespaceName, componentName, environment string) (*Status, error)
+	// GetProjectHealth reports the health of every component of a project in each
+	// environment of the project, from the state of the control plane and the
+	// data plane status mirrored into it.
+	GetProjectHealth(ctx context.Context, namespaceName, projectName string) (*ProjectHealth, error)
+}
@@ -0,0 +1,218 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	deployment "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/deployment"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// Deploy provides a mock function with given fields: ctx, namespaceName, req
+func (_m *MockService) Deploy(ctx context.Context, namespaceName string, req *deployment.DeployRequest) (*deployment.DeployResult, error) {
+	ret := _m.Called(ctx, namespaceName, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Deploy")
+	}
+
+	var r0 *deployment.DeployResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *deployment.DeployRequest) (*deployment.DeployResult, error)); ok {
+		return rf(ctx, namespaceName, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *deployment.DeployRequest) *deployment.DeployResult); ok {
+		r0 = rf(ctx, namespaceName, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*deployment.DeployResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *deployment.DeployRequest) error); ok {
+		r1 = rf(ctx, namespaceName, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Deploy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Deploy'
+type MockService_Deploy_Call struct {
+	*mock.Call
+}
+
+// Deploy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - req *deployment.DeployRequest
+func (_e *MockService_Expecter) Deploy(ctx interface{}, namespaceName interface{}, req interface{}) *MockService_Deploy_Call {
+	return &MockService_Deploy_Call{Call: _e.mock.On("Deploy", ctx, namespaceName, req)}
+}
+
+func (_c *MockService_Deploy_Call) Run(run func(ctx context.Context, namespaceName string, req *deployment.DeployRequest)) *MockService_Deploy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*deployment.DeployRequest))
+	})
+	return _c
+}
+
+func (_c *MockService_Deploy_Call) Return(_a0 *deployment.DeployResult, _a1 error) *MockService_Deploy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Deploy_Call) RunAndReturn(run func(context.Context, string, *deployment.DeployRequest) (*deployment.DeployResult, error)) *MockService_Deploy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeploymentStatus provides a mock function with given fields: ctx, namespaceName, componentName, environment
+func (_m *MockService) GetDeploymentStatus(ctx context.Context, namespaceName string, componentName string, environment string) (*deployment.Status, error) {
+	ret := _m.Called(ctx, namespaceName, componentName, environment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeploymentStatus")
+	}
+
+	var r0 *deployment.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*deployment.Status, error)); ok {
+		return rf(ctx, namespaceName, componentName, environment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *deployment.Status); ok {
+		r0 = rf(ctx, namespaceName, componentName, environment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*deployment.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, componentName, environment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetDeploymentStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeploymentStatus'
+type MockService_GetDeploymentStatus_Call struct {
+	*mock.Call
+}
+
+// GetDeploymentStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - componentName string
+//   - environment string
+func (_e *MockService_Expecter) GetDeploymentStatus(ctx interface{}, namespaceName interface{}, componentName interface{}, environment interface{}) *MockService_GetDeploymentStatus_Call {
+	return &MockService_GetDeploymentStatus_Call{Call: _e.mock.On("GetDeploymentStatus", ctx, namespaceName, componentName, environment)}
+}
+
+func (_c *MockService_GetDeploymentStatus_Call) Run(run func(ctx context.Context, namespaceName string, componentName string, environment string)) *MockService_GetDeploymentStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetDeploymentStatus_Call) Return(_a0 *deployment.Status, _a1 error) *MockService_GetDeploymentStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetDeploymentStatus_Call) RunAndReturn(run func(context.Context, string, string, string) (*deployment.Status, error)) *MockService_GetDeploymentStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProjectHealth provides a mock function with given fields: ctx, namespaceName, projectName
+func (_m *MockService) GetProjectHealth(ctx context.Context, namespaceName string, projectName string) (*deployment.ProjectHealth, error) {
+	ret := _m.Called(ctx, namespaceName, projectName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjectHealth")
+	}
+
+	var r0 *deployment.ProjectHealth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*deployment.ProjectHealth, error)); ok {
+		return rf(ctx, namespaceName, projectName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *deployment.ProjectHealth); ok {
+		r0 = rf(ctx, namespaceName, projectName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*deployment.ProjectHealth)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, projectName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetProjectHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectHealth'
+type MockService_GetProjectHealth_Call struct {
+	*mock.Call
+}
+
+// GetProjectHealth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - projectName string
+func (_e *MockService_Expecter) GetProjectHealth(ctx interface{}, namespaceName interface{}, projectName interface{}) *MockService_GetProjectHealth_Call {
+	return &MockService_GetProjectHealth_Call{Call: _e.mock.On("GetProjectHealth", ctx, namespaceName, projectName)}
+}
+
+func (_c *MockService_GetProjectHealth_Call) Run(run func(ctx context.Context, namespaceName string, projectName string)) *MockService_GetProjectHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetProjectHealth_Call) Return(_a0 *deployment.ProjectHealth, _a1 error) *MockService_GetProjectHealth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetProjectHealth_Call) RunAndReturn(run func(context.Context, string, string) (*deployment.ProjectHealth, error)) *MockService_GetProjectHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
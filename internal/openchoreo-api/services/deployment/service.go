@@ -0,0 +1,295 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deployment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	environmentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+	releasebindingsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/releasebinding"
+	workloadsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workload"
+)
+
+// ReleaseBinding Ready condition reasons that mean the rollout is still in progress.
+// Mirrors the reasons set by the release binding controller.
+var progressingReasons = map[string]bool{
+	"ReleaseCreated":              true,
+	"ReleaseSynced":               true,
+	"ResourcesProgressing":        true,
+	"ResourcesNotReady":           true,
+	"ResourcesUnknown":            true,
+	"JobRunning":                  true,
+	"ConnectionsPending":          true,
+	"ResourceDependenciesPending": true,
+}
+
+// deploymentService handles deployment business logic without authorization checks.
+type deploymentService struct {
+	k8sClient             client.Client
+	componentService      componentsvc.Service
+	environmentService    environmentsvc.Service
+	workloadService       workloadsvc.Service
+	releaseBindingService releasebindingsvc.Service
+	logger                *slog.Logger
+}
+
+var _ Service = (*deploymentService)(nil)
+
+// NewService creates a new deployment service without authorization.
+func NewService(k8sClient client.Client, logger *slog.Logger) Service {
+	return &deploymentService{
+		k8sClient:             k8sClient,
+		componentService:      componentsvc.NewService(k8sClient, logger.With("component", "component-service-internal")),
+		environmentService:    environmentsvc.NewService(k8sClient, logger.With("component", "environment-service-internal")),
+		workloadService:       workloadsvc.NewService(k8sClient, logger.With("component", "workload-service-internal")),
+		releaseBindingService: releasebindingsvc.NewService(k8sClient, logger.With("component", "releasebinding-service-internal")),
+		logger:                logger,
+	}
+}
+
+func (s *deploymentService) Deploy(ctx context.Context, namespaceName string, req *DeployRequest) (*DeployResult, error) {
+	if req == nil {
+		return nil, fmt.Errorf("deploy request cannot be nil")
+	}
+	image := strings.TrimSpace(req.Image)
+	buildName := strings.TrimSpace(req.BuildName)
+	if (image == "") == (buildName == "") {
+		return nil, &services.ValidationError{Msg: "exactly one of image or buildName must be specified"}
+	}
+
+	s.logger.Debug("Deploying component", "namespace", namespaceName, "component", req.ComponentName,
+		"environment", req.Environment, "image", image, "build", buildName)
+
+	component, err := s.componentService.GetComponent(ctx, namespaceName, req.ComponentName)
+	if err != nil {
+		return nil, err
+	}
+	projectName := component.Spec.Owner.ProjectName
+
+	if err := s.validateEnvironmentInPipeline(ctx, namespaceName, projectName, req.Environment); err != nil {
+		return nil, err
+	}
+
+	if buildName != "" {
+		image, err = s.builtImage(ctx, namespaceName, req.ComponentName, buildName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	workload, err := s.findWorkload(ctx, namespaceName, projectName, req.ComponentName)
+	if err != nil {
+		return nil, err
+	}
+	if workload.Spec.Container.Image != image {
+		workload.Spec.Container.Image = image
+		if _, err := s.workloadService.UpdateWorkload(ctx, namespaceName, workload); err != nil {
+			return nil, fmt.Errorf("failed to update workload image: %w", err)
+		}
+	}
+
+	release, err := s.componentService.GenerateRelease(ctx, namespaceName, req.ComponentName, &componentsvc.GenerateReleaseRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	binding, err := s.findReleaseBinding(ctx, namespaceName, req.ComponentName, req.Environment)
+	if err != nil && !errors.Is(err, ErrDeploymentNotFound) {
+		return nil, err
+	}
+
+	result := &DeployResult{Image: image, Release: release}
+	if binding == nil {
+		binding = &openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%s", req.ComponentName, req.Environment),
+				Namespace: namespaceName,
+			},
+			Spec: openchoreov1alpha1.ReleaseBindingSpec{
+				Owner: openchoreov1alpha1.ReleaseBindingOwner{
+					ProjectName:   projectName,
+					ComponentName: req.ComponentName,
+				},
+				Environment: req.Environment,
+				ReleaseName: release.Name,
+			},
+		}
+		controller.SetReleaseTrigger(binding, controller.ReleaseTriggerDeploy, release.Name, services.SubjectID(ctx))
+		binding, err = s.releaseBindingService.CreateReleaseBinding(ctx, namespaceName, binding)
+		if err != nil {
+			return nil, err
+		}
+		result.Created = true
+	} else {
+		binding.Spec.ReleaseName = release.Name
+		controller.SetReleaseTrigger(binding, controller.ReleaseTriggerDeploy, release.Name, services.SubjectID(ctx))
+		binding, err = s.releaseBindingService.UpdateReleaseBinding(ctx, namespaceName, binding)
+		if err != nil {
+			return nil, err
+		}
+	}
+	result.ReleaseBinding = binding
+
+	s.logger.Debug("Component deployed", "namespace", namespaceName, "component", req.ComponentName,
+		"environment", req.Environment, "release", release.Name, "releaseBinding", binding.Name)
+	return result, nil
+}
+
+func (s *deploymentService) GetDeploymentStatus(ctx context.Context, namespaceName, componentName, environment string) (*Status, error) {
+	s.logger.Debug("Getting deployment status", "namespace", namespaceName, "component", componentName, "environment", environment)
+
+	if _, err := s.componentService.GetComponent(ctx, namespaceName, componentName); err != nil {
+		return nil, err
+	}
+
+	binding, err := s.findReleaseBinding(ctx, namespaceName, componentName, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &Status{
+		ComponentName:      componentName,
+		Environment:        environment,
+		ReleaseBindingName: binding.Name,
+		ReleaseName:        binding.Spec.ReleaseName,
+		Conditions:         binding.Status.Conditions,
+		Endpoints:          binding.Status.Endpoints,
+	}
+	status.Phase, status.Message = computePhase(binding)
+
+	if binding.Spec.ReleaseName != "" {
+		release := &openchoreov1alpha1.ComponentRelease{}
+		key := client.ObjectKey{Namespace: namespaceName, Name: binding.Spec.ReleaseName}
+		if err := s.k8sClient.Get(ctx, key, release); err != nil {
+			if !apierrors.IsNotFound(err) {
+				s.logger.Error("Failed to get component release", "error", err)
+				return nil, fmt.Errorf("failed to get component release: %w", err)
+			}
+		} else {
+			status.Image = release.Spec.Workload.Container.Image
+		}
+	}
+
+	return status, nil
+}
+
+// computePhase derives the rollout phase and message from the release binding's Ready condition.
+func computePhase(binding *openchoreov1alpha1.ReleaseBinding) (Phase, string) {
+	ready := meta.FindStatusCondition(binding.Status.Conditions, "Ready")
+	if binding.Status.ObservedGeneration < binding.Generation || ready == nil || ready.ObservedGeneration < binding.Generation {
+		return PhasePending, "waiting for the controller to reconcile the latest release"
+	}
+	switch {
+	case ready.Status == metav1.ConditionTrue:
+		return PhaseReady, ready.Message
+	case progressingReasons[ready.Reason]:
+		return PhaseProgressing, ready.Message
+	default:
+		return PhaseFailed, ready.Message
+	}
+}
+
+// validateEnvironmentInPipeline checks that the environment is part of the project's deployment pipeline.
+func (s *deploymentService) validateEnvironmentInPipeline(ctx context.Context, namespaceName, projectName, environment string) error {
+	project := &openchoreov1alpha1.Project{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: projectName}, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			return projectsvc.ErrProjectNotFound
+		}
+		s.logger.Error("Failed to get project", "error", err)
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	pipeline := &openchoreov1alpha1.DeploymentPipeline{}
+	key := client.ObjectKey{Namespace: namespaceName, Name: project.Spec.DeploymentPipelineRef.Name}
+	if err := s.k8sClient.Get(ctx, key, pipeline); err != nil {
+		if apierrors.IsNotFound(err) {
+			return projectsvc.ErrDeploymentPipelineNotFound
+		}
+		s.logger.Error("Failed to get deployment pipeline", "error", err)
+		return fmt.Errorf("failed to get deployment pipeline: %w", err)
+	}
+
+	for _, path := range pipeline.Spec.PromotionPaths {
+		if path.SourceEnvironmentRef.Name == environment {
+			return nil
+		}
+		for _, target := range path.TargetEnvironmentRefs {
+			if target.Name == environment {
+				return nil
+			}
+		}
+	}
+	return ErrEnvironmentNotInPipeline
+}
+
+// builtImage returns the image produced by a succeeded workflow run of the component.
+func (s *deploymentService) builtImage(ctx context.Context, namespaceName, componentName, buildName string) (string, error) {
+	run := &openchoreov1alpha1.WorkflowRun{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: buildName}, run); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", ErrBuildNotFound
+		}
+		s.logger.Error("Failed to get workflow run", "error", err)
+		return "", fmt.Errorf("failed to get workflow run: %w", err)
+	}
+	if run.Labels[ocLabels.LabelKeyComponentName] != componentName {
+		return "", ErrBuildNotFound
+	}
+	if !meta.IsStatusConditionTrue(run.Status.Conditions, "WorkflowSucceeded") || run.Status.Image == "" {
+		return "", ErrBuildNotReady
+	}
+	return run.Status.Image, nil
+}
+
+// findWorkload finds the workload for a given component within a namespace.
+func (s *deploymentService) findWorkload(ctx context.Context, namespaceName, projectName, componentName string) (*openchoreov1alpha1.Workload, error) {
+	workloadList := &openchoreov1alpha1.WorkloadList{}
+	if err := s.k8sClient.List(ctx, workloadList, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list workloads", "error", err)
+		return nil, fmt.Errorf("failed to list workloads: %w", err)
+	}
+
+	for i := range workloadList.Items {
+		w := &workloadList.Items[i]
+		if w.Spec.Owner.ComponentName == componentName && w.Spec.Owner.ProjectName == projectName {
+			return w, nil
+		}
+	}
+
+	return nil, componentsvc.ErrWorkloadNotFound
+}
+
+// findReleaseBinding finds the release binding of a component for an environment.
+func (s *deploymentService) findReleaseBinding(ctx context.Context, namespaceName, componentName, environment string) (*openchoreov1alpha1.ReleaseBinding, error) {
+	bindingList := &openchoreov1alpha1.ReleaseBindingList{}
+	if err := s.k8sClient.List(ctx, bindingList, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list release bindings", "error", err)
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+
+	for i := range bindingList.Items {
+		rb := &bindingList.Items[i]
+		if rb.Spec.Owner.ComponentName == componentName && rb.Spec.Environment == environment {
+			return rb, nil
+		}
+	}
+
+	return nil, ErrDeploymentNotFound
+}
@@ -0,0 +1,199 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deployment
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+)
+
+const (
+	resourceTypeProject          = "project"
+	resourceTypeComponent        = "component"
+	resourceTypeWorkload         = "workload"
+	resourceTypeComponentRelease = "componentrelease"
+	resourceTypeReleaseBinding   = "releasebinding"
+)
+
+// deploymentServiceWithAuthz wraps a Service and adds authorization checks.
+// Handlers should use this. Other services should use the unwrapped Service directly.
+type deploymentServiceWithAuthz struct {
+	internal         Service
+	componentService componentsvc.Service
+	authz            *services.AuthzChecker
+}
+
+var _ Service = (*deploymentServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates a deployment service with authorization checks.
+func NewServiceWithAuthz(k8sClient client.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &deploymentServiceWithAuthz{
+		internal:         NewService(k8sClient, logger),
+		componentService: componentsvc.NewService(k8sClient, logger.With("component", "component-service-internal")),
+		authz:            services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+// Deploy requires permission to update the component's workload, create a release and
+// create or update the release binding for the target environment.
+func (s *deploymentServiceWithAuthz) Deploy(ctx context.Context, namespaceName string, req *DeployRequest) (*DeployResult, error) {
+	if req == nil {
+		return s.internal.Deploy(ctx, namespaceName, req)
+	}
+	// Fetch first to get the project for authz hierarchy
+	comp, err := s.componentService.GetComponent(ctx, namespaceName, req.ComponentName)
+	if err != nil {
+		return nil, err
+	}
+	hierarchy := authz.ResourceHierarchy{
+		Namespace: namespaceName,
+		Project:   comp.Spec.Owner.ProjectName,
+		Component: req.ComponentName,
+	}
+
+	bindingAction := authz.ActionUpdateReleaseBinding
+	if _, err := s.internal.GetDeploymentStatus(ctx, namespaceName, req.ComponentName, req.Environment); err != nil {
+		if !errors.Is(err, ErrDeploymentNotFound) {
+			return nil, err
+		}
+		bindingAction = authz.ActionCreateReleaseBinding
+	}
+
+	checks := []services.CheckRequest{
+		{
+			Action:       authz.ActionUpdateWorkload,
+			ResourceType: resourceTypeWorkload,
+			ResourceID:   req.ComponentName,
+			Hierarchy:    hierarchy,
+		},
+		{
+			Action:       authz.ActionCreateComponentRelease,
+			ResourceType: resourceTypeComponentRelease,
+			ResourceID:   req.ComponentName,
+			Hierarchy:    hierarchy,
+		},
+		{
+			Action:       bindingAction,
+			ResourceType: resourceTypeReleaseBinding,
+			ResourceID:   req.ComponentName,
+			Hierarchy:    hierarchy,
+			Context: authz.Context{
+				Resource: authz.ResourceAttribute{
+					Environment: services.FormatDualScopedResourceName(namespaceName, req.Environment, false)},
+			},
+		},
+	}
+	for _, check := range checks {
+		if err := s.authz.Check(ctx, check); err != nil {
+			return nil, err
+		}
+	}
+	return s.internal.Deploy(ctx, namespaceName, req)
+}
+
+func (s *deploymentServiceWithAuthz) GetDeploymentStatus(ctx context.Context, namespaceName, componentName, environment string) (*Status, error) {
+	// Fetch first to get the project for authz hierarchy
+	comp, err := s.componentService.GetComponent(ctx, namespaceName, componentName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewReleaseBinding,
+		ResourceType: resourceTypeReleaseBinding,
+		ResourceID:   componentName,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   comp.Spec.Owner.ProjectName,
+			Component: componentName,
+		},
+		Context: authz.Context{
+			Resource: authz.ResourceAttribute{
+				Environment: services.FormatDualScopedResourceName(namespaceName, environment, false)},
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.GetDeploymentStatus(ctx, namespaceName, componentName, environment)
+}
+
+// GetProjectHealth requires permission to view the project. Components the caller may not
+// view are left out, and the health of a component in an environment whose release binding
+// the caller may not view is reported as Unknown without any detail.
+func (s *deploymentServiceWithAuthz) GetProjectHealth(ctx context.Context, namespaceName, projectName string) (*ProjectHealth, error) {
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewProject,
+		ResourceType: resourceTypeProject,
+		ResourceID:   projectName,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName, Project: projectName},
+	}); err != nil {
+		return nil, err
+	}
+	health, err := s.internal.GetProjectHealth(ctx, namespaceName, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check each component, followed by the release bindings of the environments it is deployed to.
+	var requests []services.CheckRequest
+	for _, ch := range health.Components {
+		hierarchy := authz.ResourceHierarchy{Namespace: namespaceName, Project: projectName, Component: ch.ComponentName}
+		requests = append(requests, services.CheckRequest{
+			Action:       authz.ActionViewComponent,
+			ResourceType: resourceTypeComponent,
+			ResourceID:   ch.ComponentName,
+			Hierarchy:    hierarchy,
+		})
+		for _, eh := range ch.Environments {
+			if eh.Health == HealthNotDeployed {
+				continue
+			}
+			requests = append(requests, services.CheckRequest{
+				Action:       authz.ActionViewReleaseBinding,
+				ResourceType: resourceTypeReleaseBinding,
+				ResourceID:   ch.ComponentName,
+				Hierarchy:    hierarchy,
+				Context: authz.Context{
+					Resource: authz.ResourceAttribute{
+						Environment: services.FormatDualScopedResourceName(namespaceName, eh.Environment, false)},
+				},
+			})
+		}
+	}
+	if len(requests) == 0 {
+		return health, nil
+	}
+	decisions, err := s.authz.BatchCheck(ctx, requests)
+	if err != nil {
+		return nil, err
+	}
+	allowed := func(i int) bool { return i < len(decisions) && decisions[i] }
+
+	components := make([]ComponentHealth, 0, len(health.Components))
+	next := 0
+	for _, ch := range health.Components {
+		componentAllowed := allowed(next)
+		next++
+		for i, eh := range ch.Environments {
+			if eh.Health == HealthNotDeployed {
+				continue
+			}
+			if !allowed(next) {
+				ch.Environments[i] = EnvironmentHealth{Environment: eh.Environment, Health: HealthUnknown}
+			}
+			next++
+		}
+		if componentAllowed {
+			components = append(components, ch)
+		}
+	}
+	health.Components = components
+	return health, nil
+}
@@ -0,0 +1,202 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deployment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+// mockService is a local testify mock for the Service interface.
+// It exists here instead of importing deployment/mocks to avoid a cyclic import
+type mockService struct {
+	mock.Mock
+}
+
+func newMockService(t *testing.T) *mockService {
+	m := &mockService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *mockService) Deploy(ctx context.Context, namespaceName string, req *DeployRequest) (*DeployResult, error) {
+	args := m.Called(ctx, namespaceName, req)
+	res, _ := args.Get(0).(*DeployResult)
+	return res, args.Error(1)
+}
+
+func (m *mockService) GetDeploymentStatus(ctx context.Context, namespaceName, componentName, environment string) (*Status, error) {
+	args := m.Called(ctx, namespaceName, componentName, environment)
+	res, _ := args.Get(0).(*Status)
+	return res, args.Error(1)
+}
+
+func (m *mockService) GetProjectHealth(ctx context.Context, namespaceName, projectName string) (*ProjectHealth, error) {
+	args := m.Called(ctx, namespaceName, projectName)
+	res, _ := args.Get(0).(*ProjectHealth)
+	return res, args.Error(1)
+}
+
+var deployHierarchy = authzcore.ResourceHierarchy{Namespace: testNamespace, Project: testProjectName, Component: testComponentName}
+
+func newAuthzService(internal Service, pdp authzcore.PDP) *deploymentServiceWithAuthz {
+	k8sClient := testutil.NewFakeClient(testutil.NewComponent(testNamespace, testProjectName, testComponentName))
+	return &deploymentServiceWithAuthz{
+		internal:         internal,
+		componentService: componentsvc.NewService(k8sClient, testutil.TestLogger()),
+		authz:            testutil.NewTestAuthzChecker(pdp),
+	}
+}
+
+func TestDeploy_AuthzCheck(t *testing.T) {
+	req := &DeployRequest{ComponentName: testComponentName, Environment: "dev", Image: "img"}
+
+	t.Run("allowed creating binding", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetDeploymentStatus", mock.Anything, testNamespace, testComponentName, "dev").Return(nil, ErrDeploymentNotFound)
+		mockSvc.On("Deploy", mock.Anything, testNamespace, req).Return(&DeployResult{Created: true}, nil)
+
+		result, err := newAuthzService(mockSvc, pdp).Deploy(testutil.AuthzContext(), testNamespace, req)
+		require.NoError(t, err)
+		require.True(t, result.Created)
+		require.Len(t, pdp.Captured, 3)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "workload:update", "workload", testComponentName, deployHierarchy)
+		testutil.RequireEvalRequest(t, pdp.Captured[1], "componentrelease:create", "componentrelease", testComponentName, deployHierarchy)
+		testutil.RequireEvalRequest(t, pdp.Captured[2], "releasebinding:create", "releasebinding", testComponentName, deployHierarchy)
+	})
+
+	t.Run("allowed updating binding", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetDeploymentStatus", mock.Anything, testNamespace, testComponentName, "dev").Return(&Status{}, nil)
+		mockSvc.On("Deploy", mock.Anything, testNamespace, req).Return(&DeployResult{}, nil)
+
+		_, err := newAuthzService(mockSvc, pdp).Deploy(testutil.AuthzContext(), testNamespace, req)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 3)
+		testutil.RequireEvalRequest(t, pdp.Captured[2], "releasebinding:update", "releasebinding", testComponentName, deployHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		mockSvc := newMockService(t)
+		mockSvc.On("GetDeploymentStatus", mock.Anything, testNamespace, testComponentName, "dev").Return(nil, ErrDeploymentNotFound)
+
+		_, err := newAuthzService(mockSvc, testutil.DenyPDP()).Deploy(testutil.AuthzContext(), testNamespace, req)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestGetDeploymentStatus_AuthzCheck(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetDeploymentStatus", mock.Anything, testNamespace, testComponentName, "dev").Return(&Status{Phase: PhaseReady}, nil)
+
+		status, err := newAuthzService(mockSvc, pdp).GetDeploymentStatus(testutil.AuthzContext(), testNamespace, testComponentName, "dev")
+		require.NoError(t, err)
+		require.Equal(t, PhaseReady, status.Phase)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "releasebinding:view", "releasebinding", testComponentName, deployHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		_, err := newAuthzService(newMockService(t), testutil.DenyPDP()).
+			GetDeploymentStatus(testutil.AuthzContext(), testNamespace, testComponentName, "dev")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+// denyComponentPDP denies every check on one component and allows the rest.
+type denyComponentPDP struct {
+	*testutil.CapturingPDP
+	component string
+	action    string
+}
+
+func (p *denyComponentPDP) BatchEvaluate(ctx context.Context, req *authzcore.BatchEvaluateRequest) (*authzcore.BatchEvaluateResponse, error) {
+	resp, err := p.CapturingPDP.BatchEvaluate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for i, r := range req.Requests {
+		if r.Resource.Hierarchy.Component == p.component && r.Action == p.action {
+			resp.Decisions[i].Decision = false
+		}
+	}
+	return resp, nil
+}
+
+func TestGetProjectHealth_AuthzCheck(t *testing.T) {
+	projectHealth := func() *ProjectHealth {
+		return &ProjectHealth{
+			ProjectName:  testProjectName,
+			Environments: []string{"dev", "prod"},
+			Components: []ComponentHealth{
+				{ComponentName: testComponentName, Environments: []EnvironmentHealth{
+					{Environment: "dev", Health: HealthHealthy, ReleaseName: "rel-1"},
+					{Environment: "prod", Health: HealthNotDeployed},
+				}},
+				{ComponentName: "worker", Environments: []EnvironmentHealth{
+					{Environment: "dev", Health: HealthHealthy},
+					{Environment: "prod", Health: HealthDegraded, ReleaseName: "rel-2"},
+				}},
+			},
+		}
+	}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetProjectHealth", mock.Anything, testNamespace, testProjectName).Return(projectHealth(), nil)
+
+		health, err := newAuthzService(mockSvc, pdp).GetProjectHealth(testutil.AuthzContext(), testNamespace, testProjectName)
+		require.NoError(t, err)
+		require.Equal(t, projectHealth(), health)
+		// project, then each component followed by its deployed environments
+		require.Len(t, pdp.Captured, 6)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "project:view", "project", testProjectName,
+			authzcore.ResourceHierarchy{Namespace: testNamespace, Project: testProjectName})
+		testutil.RequireEvalRequest(t, pdp.Captured[1], "component:view", "component", testComponentName, deployHierarchy)
+		testutil.RequireEvalRequest(t, pdp.Captured[2], "releasebinding:view", "releasebinding", testComponentName, deployHierarchy)
+	})
+
+	t.Run("filters components and environments", func(t *testing.T) {
+		pdp := &denyComponentPDP{CapturingPDP: testutil.AllowPDP(), component: "worker", action: "releasebinding:view"}
+		mockSvc := newMockService(t)
+		mockSvc.On("GetProjectHealth", mock.Anything, testNamespace, testProjectName).Return(projectHealth(), nil)
+
+		health, err := newAuthzService(mockSvc, pdp).GetProjectHealth(testutil.AuthzContext(), testNamespace, testProjectName)
+		require.NoError(t, err)
+		require.Len(t, health.Components, 2)
+		require.Equal(t, []EnvironmentHealth{
+			{Environment: "dev", Health: HealthUnknown},
+			{Environment: "prod", Health: HealthUnknown},
+		}, health.Components[1].Environments)
+
+		pdp = &denyComponentPDP{CapturingPDP: testutil.AllowPDP(), component: "worker", action: "component:view"}
+		mockSvc = newMockService(t)
+		mockSvc.On("GetProjectHealth", mock.Anything, testNamespace, testProjectName).Return(projectHealth(), nil)
+
+		health, err = newAuthzService(mockSvc, pdp).GetProjectHealth(testutil.AuthzContext(), testNamespace, testProjectName)
+		require.NoError(t, err)
+		require.Len(t, health.Components, 1)
+		require.Equal(t, testComponentName, health.Components[0].ComponentName)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		_, err := newAuthzService(newMockService(t), testutil.DenyPDP()).
+			GetProjectHealth(testutil.AuthzContext(), testNamespace, testProjectName)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
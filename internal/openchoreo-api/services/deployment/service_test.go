@@ -0,0 +1,182 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deployment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+const (
+	testNamespace     = "ns-1"
+	testProjectName   = "proj-1"
+	testComponentName = "comp-1"
+)
+
+func newService(objs ...client.Object) Service {
+	return NewService(testutil.NewFakeClient(objs...), testutil.TestLogger())
+}
+
+func seedObjects() []client.Object {
+	return []client.Object{
+		testutil.NewProject(testNamespace, testProjectName),
+		testutil.NewDeploymentPipeline(testNamespace, "default"),
+		testutil.NewComponent(testNamespace, testProjectName, testComponentName),
+		testutil.NewComponentType(testNamespace, "web-app"),
+		testutil.NewWorkload(testNamespace, testProjectName, testComponentName, testComponentName+"-workload"),
+	}
+}
+
+func succeededBuild(name, componentName, image string) *openchoreov1alpha1.WorkflowRun {
+	run := testutil.NewWorkflowRun(testNamespace, "docker", name)
+	run.Labels = map[string]string{ocLabels.LabelKeyComponentName: componentName}
+	run.Status.Conditions = []metav1.Condition{{Type: "WorkflowSucceeded", Status: metav1.ConditionTrue}}
+	run.Status.Image = image
+	return run
+}
+
+func TestDeploy(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("creates release binding from image", func(t *testing.T) {
+		svc := newService(seedObjects()...)
+
+		result, err := svc.Deploy(ctx, testNamespace, &DeployRequest{
+			ComponentName: testComponentName,
+			Environment:   "dev",
+			Image:         "registry.example.com/app:v2",
+		})
+		require.NoError(t, err)
+		assert.True(t, result.Created)
+		assert.Equal(t, "registry.example.com/app:v2", result.Image)
+		assert.Equal(t, "registry.example.com/app:v2", result.Release.Spec.Workload.Container.Image)
+		assert.Equal(t, "comp-1-dev", result.ReleaseBinding.Name)
+		assert.Equal(t, result.Release.Name, result.ReleaseBinding.Spec.ReleaseName)
+		assert.Equal(t, testProjectName, result.ReleaseBinding.Spec.Owner.ProjectName)
+	})
+
+	t.Run("updates existing release binding from build", func(t *testing.T) {
+		rb := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, "prod", "existing-prod")
+		rb.Spec.ReleaseName = "old-release"
+		objs := append(seedObjects(), rb, succeededBuild("build-1", testComponentName, "registry.example.com/app:built"))
+		svc := newService(objs...)
+
+		result, err := svc.Deploy(ctx, testNamespace, &DeployRequest{
+			ComponentName: testComponentName,
+			Environment:   "prod",
+			BuildName:     "build-1",
+		})
+		require.NoError(t, err)
+		assert.False(t, result.Created)
+		assert.Equal(t, "registry.example.com/app:built", result.Image)
+		assert.Equal(t, "existing-prod", result.ReleaseBinding.Name)
+		assert.Equal(t, result.Release.Name, result.ReleaseBinding.Spec.ReleaseName)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		notSucceeded := testutil.NewWorkflowRun(testNamespace, "docker", "build-running")
+		notSucceeded.Labels = map[string]string{ocLabels.LabelKeyComponentName: testComponentName}
+		objs := append(seedObjects(), notSucceeded, succeededBuild("build-other", "other-comp", "img"))
+
+		tests := []struct {
+			name    string
+			req     *DeployRequest
+			wantErr error
+		}{
+			{"component not found", &DeployRequest{ComponentName: "missing", Environment: "dev", Image: "img"},
+				componentsvc.ErrComponentNotFound},
+			{"environment not in pipeline", &DeployRequest{ComponentName: testComponentName, Environment: "staging", Image: "img"},
+				ErrEnvironmentNotInPipeline},
+			{"build not found", &DeployRequest{ComponentName: testComponentName, Environment: "dev", BuildName: "missing"},
+				ErrBuildNotFound},
+			{"build of another component", &DeployRequest{ComponentName: testComponentName, Environment: "dev", BuildName: "build-other"},
+				ErrBuildNotFound},
+			{"build not succeeded", &DeployRequest{ComponentName: testComponentName, Environment: "dev", BuildName: "build-running"},
+				ErrBuildNotReady},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := newService(objs...).Deploy(ctx, testNamespace, tt.req)
+				require.ErrorIs(t, err, tt.wantErr)
+			})
+		}
+	})
+
+	t.Run("requires exactly one of image and build", func(t *testing.T) {
+		svc := newService(seedObjects()...)
+		for _, req := range []*DeployRequest{
+			{ComponentName: testComponentName, Environment: "dev"},
+			{ComponentName: testComponentName, Environment: "dev", Image: "img", BuildName: "build-1"},
+		} {
+			_, err := svc.Deploy(ctx, testNamespace, req)
+			var vErr *services.ValidationError
+			require.True(t, errors.As(err, &vErr))
+		}
+	})
+}
+
+func TestGetDeploymentStatus(t *testing.T) {
+	ctx := context.Background()
+
+	release := testutil.NewComponentRelease(testNamespace, testProjectName, testComponentName, "rel-1")
+	rb := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, "dev", "comp-1-dev")
+	rb.Generation = 2
+	rb.Spec.ReleaseName = "rel-1"
+	rb.Status.ObservedGeneration = 2
+	rb.Status.Conditions = []metav1.Condition{
+		{Type: "Ready", Status: metav1.ConditionFalse, Reason: "ResourcesProgressing", Message: "rolling out", ObservedGeneration: 2},
+	}
+	svc := newService(append(seedObjects(), release, rb)...)
+
+	status, err := svc.GetDeploymentStatus(ctx, testNamespace, testComponentName, "dev")
+	require.NoError(t, err)
+	assert.Equal(t, PhaseProgressing, status.Phase)
+	assert.Equal(t, "rolling out", status.Message)
+	assert.Equal(t, "comp-1-dev", status.ReleaseBindingName)
+	assert.Equal(t, "rel-1", status.ReleaseName)
+	assert.Equal(t, release.Spec.Workload.Container.Image, status.Image)
+
+	_, err = svc.GetDeploymentStatus(ctx, testNamespace, testComponentName, "prod")
+	require.ErrorIs(t, err, ErrDeploymentNotFound)
+}
+
+func TestComputePhase(t *testing.T) {
+	ready := func(status metav1.ConditionStatus, reason string, gen int64) []metav1.Condition {
+		return []metav1.Condition{{Type: "Ready", Status: status, Reason: reason, ObservedGeneration: gen}}
+	}
+	tests := []struct {
+		name       string
+		observed   int64
+		conditions []metav1.Condition
+		want       Phase
+	}{
+		{"not yet reconciled", 1, ready(metav1.ConditionTrue, "Ready", 1), PhasePending},
+		{"no ready condition", 2, nil, PhasePending},
+		{"ready", 2, ready(metav1.ConditionTrue, "Ready", 2), PhaseReady},
+		{"progressing", 2, ready(metav1.ConditionFalse, "ResourcesProgressing", 2), PhaseProgressing},
+		{"failed", 2, ready(metav1.ConditionFalse, "ResourcesDegraded", 2), PhaseFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rb := &openchoreov1alpha1.ReleaseBinding{}
+			rb.Generation = 2
+			rb.Status.ObservedGeneration = tt.observed
+			rb.Status.Conditions = tt.conditions
+			got, _ := computePhase(rb)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
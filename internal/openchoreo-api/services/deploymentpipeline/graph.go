@@ -0,0 +1,66 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deploymentpipeline
+
+import (
+	"context"
+	"sort"
+
+	environmentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment"
+)
+
+func (s *deploymentPipelineService) GetDeploymentPipelineGraph(ctx context.Context, namespaceName, deploymentPipelineName string) (*PipelineGraph, error) {
+	dp, err := s.GetDeploymentPipeline(ctx, namespaceName, deploymentPipelineName)
+	if err != nil {
+		return nil, err
+	}
+	environments, err := s.listEnvironments(ctx, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	positions := environmentsvc.PipelinePositions(dp)
+	graph := &PipelineGraph{
+		Name:   dp.Name,
+		Nodes:  make([]PipelineNode, 0, len(positions)),
+		Edges:  []PipelineEdge{},
+		Issues: pipelineIssues(dp, environments),
+	}
+	for name, pos := range positions {
+		if name == "" {
+			continue
+		}
+		node := PipelineNode{Environment: name, Stage: pos.Stage}
+		if env, ok := environments[name]; ok {
+			node.Exists = true
+			node.IsProduction = env.Spec.IsProduction
+		}
+		graph.Nodes = append(graph.Nodes, node)
+	}
+	// Order nodes by stage, with environments only reachable through a cycle last.
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		a, b := graph.Nodes[i], graph.Nodes[j]
+		if (a.Stage < 0) != (b.Stage < 0) {
+			return b.Stage < 0
+		}
+		if a.Stage != b.Stage {
+			return a.Stage < b.Stage
+		}
+		return a.Environment < b.Environment
+	})
+
+	for _, path := range dp.Spec.PromotionPaths {
+		if path.SourceEnvironmentRef.Name == "" {
+			continue
+		}
+		for _, target := range path.TargetEnvironmentRefs {
+			graph.Edges = append(graph.Edges, PipelineEdge{
+				Source:           path.SourceEnvironmentRef.Name,
+				Target:           target.Name,
+				RequiresApproval: target.RequiresApproval,
+			})
+		}
+	}
+	return graph, nil
+}
@@ -17,4 +17,34 @@ type Service interface {
 	ListDeploymentPipelines(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.DeploymentPipeline], error)
 	GetDeploymentPipeline(ctx context.Context, namespaceName, deploymentPipelineName string) (*openchoreov1alpha1.DeploymentPipeline, error)
 	DeleteDeploymentPipeline(ctx context.Context, namespaceName, deploymentPipelineName string) error
+	GetDeploymentPipelineGraph(ctx context.Context, namespaceName, deploymentPipelineName string) (*PipelineGraph, error)
+}
+
+// PipelineGraph is the graph of environments and promotion paths of a deployment pipeline.
+type PipelineGraph struct {
+	Name  string
+	Nodes []PipelineNode
+	Edges []PipelineEdge
+	// Issues lists the problems of the pipeline definition, such as promotion paths to
+	// environments that were deleted after the pipeline was written.
+	Issues []string
+}
+
+// PipelineNode is an environment of a deployment pipeline.
+type PipelineNode struct {
+	Environment string
+	// Stage is the number of promotion hops from the first environment of the pipeline,
+	// or -1 for environments only reachable through a promotion cycle.
+	Stage        int
+	IsProduction bool
+	// Exists is false when the environment does not exist in the namespace.
+	Exists bool
+}
+
+// PipelineEdge is a promotion path from one environment to another.
+type PipelineEdge struct {
+	Source string
+	Target string
+	// RequiresApproval is true when promotions along the edge wait for a manual approval.
+	RequiresApproval bool
 }
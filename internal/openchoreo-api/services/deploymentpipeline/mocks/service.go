@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	deploymentpipeline "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/deploymentpipeline"
+
 	mock "github.com/stretchr/testify/mock"
 
 	services "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
@@ -193,6 +195,66 @@ func (_c *MockService_GetDeploymentPipeline_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// GetDeploymentPipelineGraph provides a mock function with given fields: ctx, namespaceName, deploymentPipelineName
+func (_m *MockService) GetDeploymentPipelineGraph(ctx context.Context, namespaceName string, deploymentPipelineName string) (*deploymentpipeline.PipelineGraph, error) {
+	ret := _m.Called(ctx, namespaceName, deploymentPipelineName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeploymentPipelineGraph")
+	}
+
+	var r0 *deploymentpipeline.PipelineGraph
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*deploymentpipeline.PipelineGraph, error)); ok {
+		return rf(ctx, namespaceName, deploymentPipelineName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *deploymentpipeline.PipelineGraph); ok {
+		r0 = rf(ctx, namespaceName, deploymentPipelineName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*deploymentpipeline.PipelineGraph)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, deploymentPipelineName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetDeploymentPipelineGraph_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeploymentPipelineGraph'
+type MockService_GetDeploymentPipelineGraph_Call struct {
+	*mock.Call
+}
+
+// GetDeploymentPipelineGraph is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - deploymentPipelineName string
+func (_e *MockService_Expecter) GetDeploymentPipelineGraph(ctx interface{}, namespaceName interface{}, deploymentPipelineName interface{}) *MockService_GetDeploymentPipelineGraph_Call {
+	return &MockService_GetDeploymentPipelineGraph_Call{Call: _e.mock.On("GetDeploymentPipelineGraph", ctx, namespaceName, deploymentPipelineName)}
+}
+
+func (_c *MockService_GetDeploymentPipelineGraph_Call) Run(run func(ctx context.Context, namespaceName string, deploymentPipelineName string)) *MockService_GetDeploymentPipelineGraph_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetDeploymentPipelineGraph_Call) Return(_a0 *deploymentpipeline.PipelineGraph, _a1 error) *MockService_GetDeploymentPipelineGraph_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetDeploymentPipelineGraph_Call) RunAndReturn(run func(context.Context, string, string) (*deploymentpipeline.PipelineGraph, error)) *MockService_GetDeploymentPipelineGraph_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListDeploymentPipelines provides a mock function with given fields: ctx, namespaceName, opts
 func (_m *MockService) ListDeploymentPipelines(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[v1alpha1.DeploymentPipeline], error) {
 	ret := _m.Called(ctx, namespaceName, opts)
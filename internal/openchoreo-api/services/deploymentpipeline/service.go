@@ -55,6 +55,10 @@ func (s *deploymentPipelineService) CreateDeploymentPipeline(ctx context.Context
 		return nil, ErrDeploymentPipelineAlreadyExists
 	}
 
+	if err := s.validateDeploymentPipeline(ctx, namespaceName, dp); err != nil {
+		return nil, err
+	}
+
 	// Set defaults
 	dp.Status = openchoreov1alpha1.DeploymentPipelineStatus{}
 	dp.Namespace = namespaceName
@@ -92,6 +96,10 @@ func (s *deploymentPipelineService) UpdateDeploymentPipeline(ctx context.Context
 		return nil, fmt.Errorf("failed to get deployment pipeline: %w", err)
 	}
 
+	if err := s.validateDeploymentPipeline(ctx, namespaceName, dp); err != nil {
+		return nil, err
+	}
+
 	// Clear status from user input — status is server-managed
 	dp.Status = openchoreov1alpha1.DeploymentPipelineStatus{}
 
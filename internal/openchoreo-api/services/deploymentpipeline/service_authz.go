@@ -98,3 +98,15 @@ func (s *deploymentPipelineServiceWithAuthz) DeleteDeploymentPipeline(ctx contex
 	}
 	return s.internal.DeleteDeploymentPipeline(ctx, namespaceName, deploymentPipelineName)
 }
+
+func (s *deploymentPipelineServiceWithAuthz) GetDeploymentPipelineGraph(ctx context.Context, namespaceName, deploymentPipelineName string) (*PipelineGraph, error) {
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewDeploymentPipeline,
+		ResourceType: resourceTypeDeploymentPipeline,
+		ResourceID:   deploymentPipelineName,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.GetDeploymentPipelineGraph(ctx, namespaceName, deploymentPipelineName)
+}
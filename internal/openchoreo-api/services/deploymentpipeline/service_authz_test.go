@@ -4,6 +4,7 @@
 package deploymentpipeline
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
@@ -13,16 +14,63 @@ import (
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
-	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/deploymentpipeline/mocks"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
 )
 
+// mockService is a local testify mock for the Service interface.
+// It exists here instead of importing deploymentpipeline/mocks to avoid a cyclic import
+type mockService struct {
+	mock.Mock
+}
+
+func newMockService(t *testing.T) *mockService {
+	m := &mockService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *mockService) CreateDeploymentPipeline(ctx context.Context, namespaceName string, dp *openchoreov1alpha1.DeploymentPipeline) (*openchoreov1alpha1.DeploymentPipeline, error) {
+	args := m.Called(ctx, namespaceName, dp)
+	res, _ := args.Get(0).(*openchoreov1alpha1.DeploymentPipeline)
+	return res, args.Error(1)
+}
+
+func (m *mockService) UpdateDeploymentPipeline(ctx context.Context, namespaceName string, dp *openchoreov1alpha1.DeploymentPipeline) (*openchoreov1alpha1.DeploymentPipeline, error) {
+	args := m.Called(ctx, namespaceName, dp)
+	res, _ := args.Get(0).(*openchoreov1alpha1.DeploymentPipeline)
+	return res, args.Error(1)
+}
+
+func (m *mockService) ListDeploymentPipelines(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.DeploymentPipeline], error) {
+	args := m.Called(ctx, namespaceName, opts)
+	res, _ := args.Get(0).(*services.ListResult[openchoreov1alpha1.DeploymentPipeline])
+	return res, args.Error(1)
+}
+
+func (m *mockService) GetDeploymentPipeline(ctx context.Context, namespaceName, deploymentPipelineName string) (*openchoreov1alpha1.DeploymentPipeline, error) {
+	args := m.Called(ctx, namespaceName, deploymentPipelineName)
+	res, _ := args.Get(0).(*openchoreov1alpha1.DeploymentPipeline)
+	return res, args.Error(1)
+}
+
+func (m *mockService) DeleteDeploymentPipeline(ctx context.Context, namespaceName, deploymentPipelineName string) error {
+	args := m.Called(ctx, namespaceName, deploymentPipelineName)
+	return args.Error(0)
+}
+
+func (m *mockService) GetDeploymentPipelineGraph(ctx context.Context, namespaceName, deploymentPipelineName string) (*PipelineGraph, error) {
+	args := m.Called(ctx, namespaceName, deploymentPipelineName)
+	res, _ := args.Get(0).(*PipelineGraph)
+	return res, args.Error(1)
+}
+
 func TestDeploymentPipelineAuthz_CreateDeploymentPipeline(t *testing.T) {
 	dp := &openchoreov1alpha1.DeploymentPipeline{ObjectMeta: metav1.ObjectMeta{Name: "dp-1", Namespace: "ns-1"}}
 
 	t.Run("allowed", func(t *testing.T) {
 		pdp := testutil.AllowPDP()
-		mockSvc := mocks.NewMockService(t)
+		mockSvc := newMockService(t)
 		mockSvc.On("CreateDeploymentPipeline", mock.Anything, "ns-1", dp).Return(dp, nil)
 		svc := &deploymentPipelineServiceWithAuthz{
 			internal: mockSvc,
@@ -37,7 +85,7 @@ func TestDeploymentPipelineAuthz_CreateDeploymentPipeline(t *testing.T) {
 
 	t.Run("denied", func(t *testing.T) {
 		pdp := testutil.DenyPDP()
-		mockSvc := mocks.NewMockService(t)
+		mockSvc := newMockService(t)
 		svc := &deploymentPipelineServiceWithAuthz{
 			internal: mockSvc,
 			authz:    testutil.NewTestAuthzChecker(pdp),
@@ -52,7 +100,7 @@ func TestDeploymentPipelineAuthz_UpdateDeploymentPipeline(t *testing.T) {
 
 	t.Run("allowed", func(t *testing.T) {
 		pdp := testutil.AllowPDP()
-		mockSvc := mocks.NewMockService(t)
+		mockSvc := newMockService(t)
 		mockSvc.On("UpdateDeploymentPipeline", mock.Anything, "ns-1", dp).Return(dp, nil)
 		svc := &deploymentPipelineServiceWithAuthz{
 			internal: mockSvc,
@@ -67,7 +115,7 @@ func TestDeploymentPipelineAuthz_UpdateDeploymentPipeline(t *testing.T) {
 
 	t.Run("denied", func(t *testing.T) {
 		pdp := testutil.DenyPDP()
-		mockSvc := mocks.NewMockService(t)
+		mockSvc := newMockService(t)
 		svc := &deploymentPipelineServiceWithAuthz{
 			internal: mockSvc,
 			authz:    testutil.NewTestAuthzChecker(pdp),
@@ -82,7 +130,7 @@ func TestDeploymentPipelineAuthz_GetDeploymentPipeline(t *testing.T) {
 
 	t.Run("allowed", func(t *testing.T) {
 		pdp := testutil.AllowPDP()
-		mockSvc := mocks.NewMockService(t)
+		mockSvc := newMockService(t)
 		mockSvc.On("GetDeploymentPipeline", mock.Anything, "ns-1", "dp-1").Return(dp, nil)
 		svc := &deploymentPipelineServiceWithAuthz{
 			internal: mockSvc,
@@ -97,7 +145,7 @@ func TestDeploymentPipelineAuthz_GetDeploymentPipeline(t *testing.T) {
 
 	t.Run("denied", func(t *testing.T) {
 		pdp := testutil.DenyPDP()
-		mockSvc := mocks.NewMockService(t)
+		mockSvc := newMockService(t)
 		svc := &deploymentPipelineServiceWithAuthz{
 			internal: mockSvc,
 			authz:    testutil.NewTestAuthzChecker(pdp),
@@ -110,7 +158,7 @@ func TestDeploymentPipelineAuthz_GetDeploymentPipeline(t *testing.T) {
 func TestDeploymentPipelineAuthz_DeleteDeploymentPipeline(t *testing.T) {
 	t.Run("allowed", func(t *testing.T) {
 		pdp := testutil.AllowPDP()
-		mockSvc := mocks.NewMockService(t)
+		mockSvc := newMockService(t)
 		mockSvc.On("DeleteDeploymentPipeline", mock.Anything, "ns-1", "dp-1").Return(nil)
 		svc := &deploymentPipelineServiceWithAuthz{
 			internal: mockSvc,
@@ -124,7 +172,7 @@ func TestDeploymentPipelineAuthz_DeleteDeploymentPipeline(t *testing.T) {
 
 	t.Run("denied", func(t *testing.T) {
 		pdp := testutil.DenyPDP()
-		mockSvc := mocks.NewMockService(t)
+		mockSvc := newMockService(t)
 		svc := &deploymentPipelineServiceWithAuthz{
 			internal: mockSvc,
 			authz:    testutil.NewTestAuthzChecker(pdp),
@@ -142,7 +190,7 @@ func TestDeploymentPipelineAuthz_ListDeploymentPipelines(t *testing.T) {
 
 	t.Run("all allowed — per-item check request fields", func(t *testing.T) {
 		pdp := testutil.AllowPDP()
-		mockSvc := mocks.NewMockService(t)
+		mockSvc := newMockService(t)
 		mockSvc.On("ListDeploymentPipelines", mock.Anything, "ns-1", mock.Anything).Return(&services.ListResult[openchoreov1alpha1.DeploymentPipeline]{Items: items}, nil)
 		svc := &deploymentPipelineServiceWithAuthz{
 			internal: mockSvc,
@@ -158,7 +206,7 @@ func TestDeploymentPipelineAuthz_ListDeploymentPipelines(t *testing.T) {
 
 	t.Run("all denied — empty result", func(t *testing.T) {
 		pdp := testutil.DenyPDP()
-		mockSvc := mocks.NewMockService(t)
+		mockSvc := newMockService(t)
 		mockSvc.On("ListDeploymentPipelines", mock.Anything, "ns-1", mock.Anything).Return(&services.ListResult[openchoreov1alpha1.DeploymentPipeline]{Items: items}, nil)
 		svc := &deploymentPipelineServiceWithAuthz{
 			internal: mockSvc,
@@ -169,3 +217,30 @@ func TestDeploymentPipelineAuthz_ListDeploymentPipelines(t *testing.T) {
 		require.Empty(t, result.Items)
 	})
 }
+
+func TestDeploymentPipelineAuthz_GetDeploymentPipelineGraph(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		graph := &PipelineGraph{Name: "dp-1"}
+		mockSvc.On("GetDeploymentPipelineGraph", mock.Anything, "ns-1", "dp-1").Return(graph, nil)
+		svc := &deploymentPipelineServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		result, err := svc.GetDeploymentPipelineGraph(testutil.AuthzContext(), "ns-1", "dp-1")
+		require.NoError(t, err)
+		require.Equal(t, graph, result)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "deploymentpipeline:view", "deploymentPipeline", "dp-1", authzcore.ResourceHierarchy{Namespace: "ns-1"})
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		svc := &deploymentPipelineServiceWithAuthz{
+			internal: newMockService(t),
+			authz:    testutil.NewTestAuthzChecker(testutil.DenyPDP()),
+		}
+		_, err := svc.GetDeploymentPipelineGraph(testutil.AuthzContext(), "ns-1", "dp-1")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
@@ -24,11 +24,19 @@ func newService(t *testing.T, objs ...client.Object) Service {
 	return NewService(testutil.NewFakeClient(objs...), testutil.TestLogger())
 }
 
+// pipelineEnvironments returns the environments the deployment pipeline fixture promotes between.
+func pipelineEnvironments() []client.Object {
+	return []client.Object{
+		testutil.NewEnvironment(testNamespace, "dev"),
+		testutil.NewEnvironment(testNamespace, "prod"),
+	}
+}
+
 func TestCreateDeploymentPipeline(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("success", func(t *testing.T) {
-		svc := newService(t)
+		svc := newService(t, pipelineEnvironments()...)
 		dp := testutil.NewDeploymentPipeline(testNamespace, "default")
 
 		result, err := svc.CreateDeploymentPipeline(ctx, testNamespace, dp)
@@ -60,7 +68,7 @@ func TestCreateDeploymentPipeline(t *testing.T) {
 
 	t.Run("same name in other namespace succeeds", func(t *testing.T) {
 		existing := testutil.NewDeploymentPipeline("other-ns", "default")
-		svc := newService(t, existing)
+		svc := newService(t, append(pipelineEnvironments(), existing)...)
 		dp := testutil.NewDeploymentPipeline(testNamespace, "default")
 
 		result, err := svc.CreateDeploymentPipeline(ctx, testNamespace, dp)
@@ -74,7 +82,7 @@ func TestUpdateDeploymentPipeline(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		existing := testutil.NewDeploymentPipeline(testNamespace, "default")
-		svc := newService(t, existing)
+		svc := newService(t, append(pipelineEnvironments(), existing)...)
 
 		update := &openchoreov1alpha1.DeploymentPipeline{
 			ObjectMeta: metav1.ObjectMeta{
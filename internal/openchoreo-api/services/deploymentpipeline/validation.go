@@ -0,0 +1,148 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deploymentpipeline
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// validateDeploymentPipeline rejects a deployment pipeline whose promotion paths reference
+// environments that do not exist in the namespace, are inconsistent or form a cycle.
+func (s *deploymentPipelineService) validateDeploymentPipeline(ctx context.Context, namespaceName string, dp *openchoreov1alpha1.DeploymentPipeline) error {
+	environments, err := s.listEnvironments(ctx, namespaceName)
+	if err != nil {
+		return err
+	}
+	if issues := pipelineIssues(dp, environments); len(issues) > 0 {
+		return &services.ValidationError{Msg: "invalid deployment pipeline: " + strings.Join(issues, "; ")}
+	}
+	return nil
+}
+
+// listEnvironments returns the environments of a namespace by name.
+func (s *deploymentPipelineService) listEnvironments(ctx context.Context, namespaceName string) (map[string]*openchoreov1alpha1.Environment, error) {
+	var envList openchoreov1alpha1.EnvironmentList
+	if err := s.k8sClient.List(ctx, &envList, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list environments", "error", err)
+		return nil, fmt.Errorf("failed to list environments: %w", err)
+	}
+	environments := make(map[string]*openchoreov1alpha1.Environment, len(envList.Items))
+	for i := range envList.Items {
+		environments[envList.Items[i].Name] = &envList.Items[i]
+	}
+	return environments, nil
+}
+
+// pipelineIssues returns the problems of a deployment pipeline definition, given the
+// environments of its namespace. A pipeline without problems returns no issues.
+func pipelineIssues(dp *openchoreov1alpha1.DeploymentPipeline, environments map[string]*openchoreov1alpha1.Environment) []string {
+	var issues []string
+	var missing []string
+	checkExists := func(env string) {
+		if _, ok := environments[env]; !ok && !slices.Contains(missing, env) {
+			missing = append(missing, env)
+		}
+	}
+
+	sources := make(map[string]bool)
+	for i, path := range dp.Spec.PromotionPaths {
+		source := path.SourceEnvironmentRef.Name
+		if source == "" {
+			issues = append(issues, fmt.Sprintf("promotion path %d has no source environment", i))
+			continue
+		}
+		checkExists(source)
+		if sources[source] {
+			issues = append(issues, fmt.Sprintf("environment %q is the source of more than one promotion path", source))
+		}
+		sources[source] = true
+		if len(path.TargetEnvironmentRefs) == 0 {
+			issues = append(issues, fmt.Sprintf("promotion path from %q has no target environments", source))
+		}
+
+		targets := make(map[string]bool)
+		for _, target := range path.TargetEnvironmentRefs {
+			switch {
+			case target.Name == source:
+				issues = append(issues, fmt.Sprintf("environment %q promotes to itself", source))
+			case targets[target.Name]:
+				issues = append(issues, fmt.Sprintf("promotion path from %q lists %q more than once", source, target.Name))
+			}
+			targets[target.Name] = true
+			checkExists(target.Name)
+		}
+	}
+
+	sort.Strings(missing)
+	for _, env := range missing {
+		issues = append(issues, fmt.Sprintf("environment %q does not exist", env))
+	}
+	if cycle := promotionCycle(dp); cycle != nil {
+		issues = append(issues, "promotion paths form a cycle: "+strings.Join(cycle, " -> "))
+	}
+	return issues
+}
+
+// promotionCycle returns the environments of a promotion cycle of the pipeline, starting and
+// ending with the same environment, or nil when the promotion paths are acyclic. Environments
+// promoting to themselves are reported separately and ignored here.
+func promotionCycle(dp *openchoreov1alpha1.DeploymentPipeline) []string {
+	edges := make(map[string][]string)
+	var order []string
+	for _, path := range dp.Spec.PromotionPaths {
+		source := path.SourceEnvironmentRef.Name
+		if _, ok := edges[source]; !ok {
+			order = append(order, source)
+		}
+		for _, target := range path.TargetEnvironmentRefs {
+			if target.Name != source {
+				edges[source] = append(edges[source], target.Name)
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int)
+	var stack []string
+	var visit func(env string) []string
+	visit = func(env string) []string {
+		state[env] = visiting
+		stack = append(stack, env)
+		for _, next := range edges[env] {
+			switch state[next] {
+			case visiting:
+				start := slices.Index(stack, next)
+				return append(slices.Clone(stack[start:]), next)
+			case unvisited:
+				if cycle := visit(next); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[env] = visited
+		return nil
+	}
+	for _, env := range order {
+		if state[env] == unvisited {
+			if cycle := visit(env); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
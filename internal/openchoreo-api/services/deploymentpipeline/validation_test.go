@@ -0,0 +1,115 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deploymentpipeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+// pipeline builds a deployment pipeline from source -> targets promotion paths.
+func pipeline(paths map[string][]string, order ...string) *openchoreov1alpha1.DeploymentPipeline {
+	dp := &openchoreov1alpha1.DeploymentPipeline{ObjectMeta: metav1.ObjectMeta{Name: "dp-1", Namespace: testNamespace}}
+	for _, source := range order {
+		path := openchoreov1alpha1.PromotionPath{SourceEnvironmentRef: openchoreov1alpha1.EnvironmentRef{Name: source}}
+		for _, target := range paths[source] {
+			path.TargetEnvironmentRefs = append(path.TargetEnvironmentRefs, openchoreov1alpha1.TargetEnvironmentRef{Name: target})
+		}
+		dp.Spec.PromotionPaths = append(dp.Spec.PromotionPaths, path)
+	}
+	return dp
+}
+
+func TestPipelineIssues(t *testing.T) {
+	environments := map[string]*openchoreov1alpha1.Environment{
+		"dev": {}, "staging": {}, "prod": {},
+	}
+	tests := []struct {
+		name string
+		dp   *openchoreov1alpha1.DeploymentPipeline
+		want []string
+	}{
+		{
+			name: "valid fan out",
+			dp:   pipeline(map[string][]string{"dev": {"staging", "prod"}, "staging": {"prod"}}, "dev", "staging"),
+		},
+		{
+			name: "missing environments",
+			dp:   pipeline(map[string][]string{"dev": {"qa", "uat"}, "qa": {"prod"}}, "dev", "qa"),
+			want: []string{`environment "qa" does not exist`, `environment "uat" does not exist`},
+		},
+		{
+			name: "cycle",
+			dp:   pipeline(map[string][]string{"dev": {"staging"}, "staging": {"prod"}, "prod": {"staging"}}, "dev", "staging", "prod"),
+			want: []string{"promotion paths form a cycle: staging -> prod -> staging"},
+		},
+		{
+			name: "inconsistent paths",
+			dp: func() *openchoreov1alpha1.DeploymentPipeline {
+				dp := pipeline(map[string][]string{"dev": {"dev", "prod", "prod"}, "staging": nil}, "dev", "staging")
+				dp.Spec.PromotionPaths = append(dp.Spec.PromotionPaths, openchoreov1alpha1.PromotionPath{
+					SourceEnvironmentRef:  openchoreov1alpha1.EnvironmentRef{Name: "dev"},
+					TargetEnvironmentRefs: []openchoreov1alpha1.TargetEnvironmentRef{{Name: "staging"}},
+				})
+				return dp
+			}(),
+			want: []string{
+				`environment "dev" promotes to itself`,
+				`promotion path from "dev" lists "prod" more than once`,
+				`promotion path from "staging" has no target environments`,
+				`environment "dev" is the source of more than one promotion path`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, pipelineIssues(tt.dp, environments))
+		})
+	}
+}
+
+func TestCreateDeploymentPipeline_Validation(t *testing.T) {
+	svc := newService(t, pipelineEnvironments()...)
+	dp := pipeline(map[string][]string{"dev": {"prod"}, "prod": {"dev"}}, "dev", "prod")
+
+	_, err := svc.CreateDeploymentPipeline(context.Background(), testNamespace, dp)
+	var vErr *services.ValidationError
+	require.ErrorAs(t, err, &vErr)
+	assert.Contains(t, vErr.Msg, "promotion paths form a cycle: dev -> prod -> dev")
+}
+
+func TestGetDeploymentPipelineGraph(t *testing.T) {
+	ctx := context.Background()
+
+	prod := testutil.NewEnvironment(testNamespace, "prod")
+	prod.Spec.IsProduction = true
+	dp := pipeline(map[string][]string{"dev": {"staging"}, "staging": {"prod"}}, "dev", "staging")
+	dp.Spec.PromotionPaths[1].TargetEnvironmentRefs[0].RequiresApproval = true
+	svc := newService(t, testutil.NewEnvironment(testNamespace, "dev"), prod, dp)
+
+	graph, err := svc.GetDeploymentPipelineGraph(ctx, testNamespace, "dp-1")
+	require.NoError(t, err)
+	assert.Equal(t, "dp-1", graph.Name)
+	assert.Equal(t, []PipelineNode{
+		{Environment: "dev", Stage: 0, Exists: true},
+		{Environment: "staging", Stage: 1},
+		{Environment: "prod", Stage: 2, Exists: true, IsProduction: true},
+	}, graph.Nodes)
+	assert.Equal(t, []PipelineEdge{
+		{Source: "dev", Target: "staging"},
+		{Source: "staging", Target: "prod", RequiresApproval: true},
+	}, graph.Edges)
+	assert.Equal(t, []string{`environment "staging" does not exist`}, graph.Issues)
+
+	_, err = svc.GetDeploymentPipelineGraph(ctx, testNamespace, "missing")
+	require.ErrorIs(t, err, ErrDeploymentPipelineNotFound)
+}
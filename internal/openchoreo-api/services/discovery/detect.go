@@ -0,0 +1,277 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"bufio"
+	"bytes"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+const (
+	// defaultPort is the port proposed when none is detected.
+	defaultPort int32 = 8080
+
+	dockerWorkflow    = "dockerfile-builder"
+	buildpackWorkflow = "paketo-buildpacks-builder"
+	ballerinaWorkflow = "ballerina-buildpack-builder"
+)
+
+// descriptorFiles are the component descriptor locations relative to a
+// directory, in order of precedence.
+var descriptorFiles = []string{".choreo/component.yaml", "workload.yaml"}
+
+// dockerfiles are the container build files, in order of precedence.
+var dockerfiles = []string{"Dockerfile", "Containerfile"}
+
+// buildpackHints maps the files that buildpacks detect to the language they
+// indicate. project.toml configures buildpacks without naming a language.
+var buildpackHints = map[string]string{
+	"go.mod":           "Go",
+	"package.json":     "Node.js",
+	"pom.xml":          "Java",
+	"build.gradle":     "Java",
+	"build.gradle.kts": "Java",
+	"requirements.txt": "Python",
+	"pyproject.toml":   "Python",
+	"Pipfile":          "Python",
+	"Gemfile":          "Ruby",
+	"composer.json":    "PHP",
+	"Ballerina.toml":   "Ballerina",
+	"project.toml":     "",
+}
+
+// skippedDirs are directories that hold dependencies, fixtures or build
+// output rather than components.
+var skippedDirs = map[string]bool{
+	".git":         true,
+	"node_modules": true,
+	"vendor":       true,
+	"testdata":     true,
+	"third_party":  true,
+	"dist":         true,
+	"target":       true,
+}
+
+// directory collects the files of a repository directory that indicate a
+// component.
+type directory struct {
+	descriptor string
+	dockerfile string
+	hints      []string
+}
+
+// descriptor holds the fields of a component descriptor used in proposals.
+type descriptor struct {
+	Metadata struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+	Type          string `json:"type,omitempty"`
+	ComponentType string `json:"componentType,omitempty"`
+	Endpoints     []struct {
+		Port int32 `json:"port"`
+	} `json:"endpoints,omitempty"`
+}
+
+// detect returns the proposals for the files of a repository. The repository
+// fields of the proposals are left to the caller; proposals for the
+// repository root are named rootName.
+func detect(files []string, rootName string, readFile func(string) ([]byte, error)) []Proposal {
+	dirs := map[string]*directory{}
+	get := func(dir string) *directory {
+		if dirs[dir] == nil {
+			dirs[dir] = &directory{}
+		}
+		return dirs[dir]
+	}
+	sorted := append([]string(nil), files...)
+	sort.Strings(sorted)
+	for _, file := range sorted {
+		if isSkipped(file) {
+			continue
+		}
+		dir, base := path.Split(file)
+		dir = path.Clean(dir)
+		switch {
+		case base == "component.yaml" && path.Base(dir) == ".choreo":
+			get(path.Dir(dir)).descriptor = file
+		case base == "workload.yaml":
+			if d := get(dir); d.descriptor == "" {
+				d.descriptor = file
+			}
+		case base == dockerfiles[0] || base == dockerfiles[1]:
+			if d := get(dir); d.dockerfile == "" || base == dockerfiles[0] {
+				d.dockerfile = file
+			}
+		default:
+			if _, ok := buildpackHints[base]; ok || strings.HasSuffix(base, ".csproj") {
+				get(dir).hints = append(get(dir).hints, file)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(dirs))
+	for dir := range dirs {
+		names = append(names, dir)
+	}
+	sort.Strings(names)
+
+	var proposals []Proposal
+	for _, dir := range names {
+		d := dirs[dir]
+		if d.descriptor == "" && d.dockerfile == "" && hasDescendant(dir, names) {
+			// Buildpack files of a directory holding other components, such
+			// as the parent pom.xml of a multi-module build, are not a
+			// component of their own.
+			continue
+		}
+		proposals = append(proposals, propose(dir, d, rootName, readFile))
+	}
+	return proposals
+}
+
+// propose returns the proposal for a directory.
+func propose(dir string, d *directory, rootName string, readFile func(string) ([]byte, error)) Proposal {
+	p := Proposal{
+		Name:     path.Base(dir),
+		AppPath:  "/" + strings.TrimPrefix(dir, "."),
+		Type:     openchoreov1alpha1.WorkloadTypeService,
+		Language: language(d.hints),
+		Port:     defaultPort,
+		Evidence: append([]string(nil), d.hints...),
+	}
+	if dir == "." {
+		p.Name = rootName
+	}
+	if d.dockerfile != "" {
+		p.Evidence = append([]string{d.dockerfile}, p.Evidence...)
+	}
+
+	if d.descriptor != "" {
+		p.DescriptorPath = d.descriptor
+		p.Evidence = append([]string{d.descriptor}, p.Evidence...)
+		var desc descriptor
+		if data, err := readFile(d.descriptor); err == nil && yaml.Unmarshal(data, &desc) == nil {
+			if desc.Metadata.Name != "" {
+				p.Name = desc.Metadata.Name
+			}
+			if desc.Type != "" {
+				p.Type = openchoreov1alpha1.WorkloadType(desc.Type)
+			}
+			p.ClassName = desc.ComponentType
+			if len(desc.Endpoints) > 0 && desc.Endpoints[0].Port > 0 {
+				p.Port = desc.Endpoints[0].Port
+			}
+		}
+		return p
+	}
+
+	if d.dockerfile != "" {
+		p.Build = BuildStrategyDocker
+		p.DockerfilePath = d.dockerfile
+		p.Workflow = dockerWorkflow
+		p.WorkflowParameters = map[string]any{
+			"docker": map[string]any{"context": p.AppPath, "filePath": "/" + d.dockerfile},
+		}
+		if data, err := readFile(d.dockerfile); err == nil {
+			if port, ok := exposedPort(data); ok {
+				p.Port = port
+			}
+		}
+		return p
+	}
+
+	p.Build = BuildStrategyBuildpacks
+	p.Workflow = buildpackWorkflow
+	if p.Language == "Ballerina" {
+		p.Workflow = ballerinaWorkflow
+	}
+	return p
+}
+
+// language returns the language indicated by the first buildpack file that
+// names one.
+func language(hints []string) string {
+	for _, hint := range hints {
+		base := path.Base(hint)
+		if strings.HasSuffix(base, ".csproj") {
+			return ".NET"
+		}
+		if lang := buildpackHints[base]; lang != "" {
+			return lang
+		}
+	}
+	return ""
+}
+
+// exposedPort returns the first port of the EXPOSE instructions of a
+// Dockerfile. Ports given by build arguments are not resolved.
+func exposedPort(dockerfile []byte) (int32, bool) {
+	scanner := bufio.NewScanner(bytes.NewReader(dockerfile))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || !strings.EqualFold(fields[0], "EXPOSE") {
+			continue
+		}
+		for _, field := range fields[1:] {
+			port, _, _ := strings.Cut(field, "/")
+			if n, err := strconv.ParseInt(port, 10, 32); err == nil && n > 0 && n <= 65535 {
+				return int32(n), true
+			}
+		}
+	}
+	return 0, false
+}
+
+// isSkipped reports whether a file is in a directory that holds no components.
+func isSkipped(file string) bool {
+	segments := strings.Split(file, "/")
+	for _, segment := range segments[:len(segments)-1] {
+		if skippedDirs[segment] {
+			return true
+		}
+	}
+	return false
+}
+
+// hasDescendant reports whether any of dirs is below dir.
+func hasDescendant(dir string, dirs []string) bool {
+	for _, other := range dirs {
+		if other != dir && (dir == "." || strings.HasPrefix(other, dir+"/")) {
+			return true
+		}
+	}
+	return false
+}
+
+// componentName returns name as a DNS label.
+func componentName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('-')
+		}
+	}
+	out := strings.Trim(b.String(), "-")
+	for strings.Contains(out, "--") {
+		out = strings.ReplaceAll(out, "--", "-")
+	}
+	if len(out) > 63 {
+		out = strings.TrimRight(out[:63], "-")
+	}
+	if out == "" {
+		return "component"
+	}
+	return out
+}
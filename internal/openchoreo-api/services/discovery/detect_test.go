@@ -0,0 +1,122 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/git"
+)
+
+// readFrom returns a readFile function over the given file contents.
+func readFrom(contents map[string]string) func(string) ([]byte, error) {
+	return func(p string) ([]byte, error) {
+		content, ok := contents[p]
+		if !ok {
+			return nil, git.ErrFileNotFound
+		}
+		return []byte(content), nil
+	}
+}
+
+func TestDetect(t *testing.T) {
+	contents := map[string]string{
+		"orders/Dockerfile": "FROM golang\nEXPOSE 9090/tcp 9091\n",
+		"billing/.choreo/component.yaml": `metadata:
+  name: billing-api
+type: WebApplication
+componentType: web-app
+endpoints:
+  - name: http
+    port: 3000
+`,
+	}
+	files := []string{
+		"pom.xml",
+		"README.md",
+		"orders/Dockerfile",
+		"orders/go.mod",
+		"billing/.choreo/component.yaml",
+		"billing/package.json",
+		"billing/node_modules/left-pad/package.json",
+		"inventory/pom.xml",
+		"greeter/Ballerina.toml",
+		"payments/Payments.csproj",
+	}
+
+	proposals := detect(files, "shop", readFrom(contents))
+	require.Len(t, proposals, 5)
+	byName := map[string]Proposal{}
+	for _, p := range proposals {
+		byName[p.Name] = p
+	}
+
+	assert.Equal(t, Proposal{
+		Name:           "billing-api",
+		AppPath:        "/billing",
+		Type:           openchoreov1alpha1.WorkloadTypeWebApplication,
+		ClassName:      "web-app",
+		Language:       "Node.js",
+		Port:           3000,
+		DescriptorPath: "billing/.choreo/component.yaml",
+		Evidence:       []string{"billing/.choreo/component.yaml", "billing/package.json"},
+	}, byName["billing-api"])
+
+	assert.Equal(t, Proposal{
+		Name:           "orders",
+		AppPath:        "/orders",
+		Type:           openchoreov1alpha1.WorkloadTypeService,
+		Language:       "Go",
+		Port:           9090,
+		Build:          BuildStrategyDocker,
+		DockerfilePath: "orders/Dockerfile",
+		Workflow:       "dockerfile-builder",
+		WorkflowParameters: map[string]any{
+			"docker": map[string]any{"context": "/orders", "filePath": "/orders/Dockerfile"},
+		},
+		Evidence: []string{"orders/Dockerfile", "orders/go.mod"},
+	}, byName["orders"])
+
+	assert.Equal(t, BuildStrategyBuildpacks, byName["inventory"].Build)
+	assert.Equal(t, "Java", byName["inventory"].Language)
+	assert.Equal(t, "paketo-buildpacks-builder", byName["inventory"].Workflow)
+	assert.Equal(t, defaultPort, byName["inventory"].Port)
+	assert.Equal(t, "ballerina-buildpack-builder", byName["greeter"].Workflow)
+	assert.Equal(t, ".NET", byName["payments"].Language)
+	assert.NotContains(t, byName, "shop", "the parent pom.xml of the modules is not a component")
+}
+
+func TestDetect_Root(t *testing.T) {
+	proposals := detect([]string{"Containerfile", "requirements.txt"}, "hello", readFrom(nil))
+	require.Len(t, proposals, 1)
+	assert.Equal(t, "hello", proposals[0].Name)
+	assert.Equal(t, "/", proposals[0].AppPath)
+	assert.Equal(t, "Containerfile", proposals[0].DockerfilePath)
+	assert.Equal(t, "Python", proposals[0].Language)
+	assert.Equal(t, defaultPort, proposals[0].Port)
+}
+
+func TestExposedPort(t *testing.T) {
+	port, ok := exposedPort([]byte("FROM nginx\nexpose $PORT 80\n"))
+	assert.True(t, ok)
+	assert.Equal(t, int32(80), port)
+
+	_, ok = exposedPort([]byte("FROM nginx\nEXPOSE ${PORT}\n"))
+	assert.False(t, ok)
+}
+
+func TestComponentName(t *testing.T) {
+	assert.Equal(t, "my-app", componentName("My_App"))
+	assert.Equal(t, "a-b", componentName("--a..b--"))
+	assert.Equal(t, "component", componentName("_"))
+
+	taken := map[string]bool{}
+	assert.Equal(t, "api", uniqueName("api", taken))
+	assert.Equal(t, "api-2", uniqueName("api", taken))
+	assert.Equal(t, "api-3", uniqueName("api", taken))
+}
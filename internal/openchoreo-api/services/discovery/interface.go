@@ -0,0 +1,102 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"context"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// BuildStrategy is how a discovered component is built from source.
+type BuildStrategy string
+
+const (
+	// BuildStrategyDocker builds the component from a Dockerfile or Containerfile.
+	BuildStrategyDocker BuildStrategy = "Docker"
+	// BuildStrategyBuildpacks builds the component with Cloud Native Buildpacks.
+	BuildStrategyBuildpacks BuildStrategy = "Buildpacks"
+)
+
+// DiscoverRequest selects the repositories to scan. Either RepositoryURLs or
+// OwnerURL must be set.
+type DiscoverRequest struct {
+	ProjectName    string
+	RepositoryURLs []string
+	// OwnerURL is a user, organization or group whose repositories are
+	// scanned, such as https://github.com/acme. Archived repositories are
+	// skipped.
+	OwnerURL string
+	// Ref is the branch scanned. Defaults to the default branch of each
+	// repository.
+	Ref string
+	// Token is an access token for private repositories. It is only used to
+	// read the repositories and is not stored.
+	Token string
+}
+
+// Proposal is a component proposed for a directory of a repository.
+type Proposal struct {
+	Name          string
+	RepositoryURL string
+	Ref           string
+	// AppPath is the directory of the component in the repository, "/" for
+	// the repository root.
+	AppPath string
+	Type    openchoreov1alpha1.WorkloadType
+	// ClassName is the component class named by the component descriptor.
+	ClassName string
+	// Language is the detected language, empty when it is not known.
+	Language string
+	Port     int32
+	// Build is empty for proposals with a component descriptor, which are
+	// built as their component class defines.
+	Build          BuildStrategy
+	DockerfilePath string
+	// Workflow is the suggested ClusterWorkflow that builds the component.
+	Workflow           string
+	WorkflowParameters map[string]any
+	// DescriptorPath is the component descriptor of the directory, relative to
+	// the repository root. Components with a descriptor are imported from it.
+	DescriptorPath string
+	// Evidence lists the files the proposal is based on.
+	Evidence []string
+}
+
+// SkippedRepository is a repository that was not scanned.
+type SkippedRepository struct {
+	RepositoryURL string
+	Reason        string
+}
+
+// DiscoverResult holds the proposals for the scanned repositories.
+type DiscoverResult struct {
+	Proposals []Proposal
+	Skipped   []SkippedRepository
+}
+
+// AcceptRequest selects the proposals to create components from.
+type AcceptRequest struct {
+	ProjectName string
+	// ClassName is the component class of the components whose descriptor
+	// does not name one.
+	ClassName  string
+	ClassKind  openchoreov1alpha1.ComponentTypeRefKind
+	AutoDeploy bool
+	Proposals  []Proposal
+}
+
+// AcceptResult is the outcome of accepting one proposal. Error is set when
+// the component could not be created.
+type AcceptResult struct {
+	Name      string
+	Component *openchoreov1alpha1.Component
+	Error     error
+}
+
+// Service defines the component discovery operations.
+type Service interface {
+	DiscoverComponents(ctx context.Context, namespaceName string, req *DiscoverRequest) (*DiscoverResult, error)
+	AcceptProposals(ctx context.Context, namespaceName string, req *AcceptRequest) ([]AcceptResult, error)
+}
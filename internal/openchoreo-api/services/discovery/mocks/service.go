@@ -0,0 +1,157 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	discovery "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/discovery"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// AcceptProposals provides a mock function with given fields: ctx, namespaceName, req
+func (_m *MockService) AcceptProposals(ctx context.Context, namespaceName string, req *discovery.AcceptRequest) ([]discovery.AcceptResult, error) {
+	ret := _m.Called(ctx, namespaceName, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptProposals")
+	}
+
+	var r0 []discovery.AcceptResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *discovery.AcceptRequest) ([]discovery.AcceptResult, error)); ok {
+		return rf(ctx, namespaceName, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *discovery.AcceptRequest) []discovery.AcceptResult); ok {
+		r0 = rf(ctx, namespaceName, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]discovery.AcceptResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *discovery.AcceptRequest) error); ok {
+		r1 = rf(ctx, namespaceName, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_AcceptProposals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptProposals'
+type MockService_AcceptProposals_Call struct {
+	*mock.Call
+}
+
+// AcceptProposals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - req *discovery.AcceptRequest
+func (_e *MockService_Expecter) AcceptProposals(ctx interface{}, namespaceName interface{}, req interface{}) *MockService_AcceptProposals_Call {
+	return &MockService_AcceptProposals_Call{Call: _e.mock.On("AcceptProposals", ctx, namespaceName, req)}
+}
+
+func (_c *MockService_AcceptProposals_Call) Run(run func(ctx context.Context, namespaceName string, req *discovery.AcceptRequest)) *MockService_AcceptProposals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*discovery.AcceptRequest))
+	})
+	return _c
+}
+
+func (_c *MockService_AcceptProposals_Call) Return(_a0 []discovery.AcceptResult, _a1 error) *MockService_AcceptProposals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_AcceptProposals_Call) RunAndReturn(run func(context.Context, string, *discovery.AcceptRequest) ([]discovery.AcceptResult, error)) *MockService_AcceptProposals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DiscoverComponents provides a mock function with given fields: ctx, namespaceName, req
+func (_m *MockService) DiscoverComponents(ctx context.Context, namespaceName string, req *discovery.DiscoverRequest) (*discovery.DiscoverResult, error) {
+	ret := _m.Called(ctx, namespaceName, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiscoverComponents")
+	}
+
+	var r0 *discovery.DiscoverResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *discovery.DiscoverRequest) (*discovery.DiscoverResult, error)); ok {
+		return rf(ctx, namespaceName, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *discovery.DiscoverRequest) *discovery.DiscoverResult); ok {
+		r0 = rf(ctx, namespaceName, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*discovery.DiscoverResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *discovery.DiscoverRequest) error); ok {
+		r1 = rf(ctx, namespaceName, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_DiscoverComponents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiscoverComponents'
+type MockService_DiscoverComponents_Call struct {
+	*mock.Call
+}
+
+// DiscoverComponents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - req *discovery.DiscoverRequest
+func (_e *MockService_Expecter) DiscoverComponents(ctx interface{}, namespaceName interface{}, req interface{}) *MockService_DiscoverComponents_Call {
+	return &MockService_DiscoverComponents_Call{Call: _e.mock.On("DiscoverComponents", ctx, namespaceName, req)}
+}
+
+func (_c *MockService_DiscoverComponents_Call) Run(run func(ctx context.Context, namespaceName string, req *discovery.DiscoverRequest)) *MockService_DiscoverComponents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*discovery.DiscoverRequest))
+	})
+	return _c
+}
+
+func (_c *MockService_DiscoverComponents_Call) Return(_a0 *discovery.DiscoverResult, _a1 error) *MockService_DiscoverComponents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_DiscoverComponents_Call) RunAndReturn(run func(context.Context, string, *discovery.DiscoverRequest) (*discovery.DiscoverResult, error)) *MockService_DiscoverComponents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
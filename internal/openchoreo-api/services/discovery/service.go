@@ -0,0 +1,276 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/git"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+)
+
+// maxRepositories bounds the repositories scanned by one request.
+const maxRepositories = 50
+
+// discoveryService handles component discovery without authorization checks.
+type discoveryService struct {
+	projectService   projectsvc.Service
+	componentService componentsvc.Service
+	browser          git.RepositoryBrowser
+	logger           *slog.Logger
+}
+
+var _ Service = (*discoveryService)(nil)
+
+// NewService creates a new discovery service without authorization.
+func NewService(k8sClient client.Client, logger *slog.Logger) Service {
+	return newService(k8sClient,
+		componentsvc.NewService(k8sClient, logger.With("component", "component-service-internal")), logger)
+}
+
+// newService creates a discovery service that creates accepted components
+// with componentService.
+func newService(k8sClient client.Client, componentService componentsvc.Service, logger *slog.Logger) *discoveryService {
+	return &discoveryService{
+		projectService:   projectsvc.NewService(k8sClient, logger.With("component", "project-service-internal")),
+		componentService: componentService,
+		browser:          git.NewAPIRepositoryBrowser(),
+		logger:           logger,
+	}
+}
+
+// DiscoverComponents scans repositories for component descriptors,
+// Dockerfiles and buildpack files and proposes a component for each directory
+// they are found in. Repositories that cannot be read are reported as skipped.
+func (s *discoveryService) DiscoverComponents(ctx context.Context, namespaceName string, req *DiscoverRequest) (*DiscoverResult, error) {
+	if req == nil {
+		return nil, fmt.Errorf("discover request cannot be nil")
+	}
+	if req.ProjectName == "" {
+		return nil, &services.ValidationError{Msg: "projectName is required"}
+	}
+	if (len(req.RepositoryURLs) == 0) == (req.OwnerURL == "") {
+		return nil, &services.ValidationError{Msg: "exactly one of repositoryUrls and ownerUrl is required"}
+	}
+	if _, err := s.projectService.GetProject(ctx, namespaceName, req.ProjectName); err != nil {
+		return nil, err
+	}
+
+	s.logger.Debug("Discovering components", "namespace", namespaceName, "project", req.ProjectName,
+		"owner", req.OwnerURL, "repositories", len(req.RepositoryURLs))
+
+	result := &DiscoverResult{}
+	var repos []git.Repository
+	if req.OwnerURL != "" {
+		listed, err := s.browser.ListRepositories(ctx, req.OwnerURL, req.Token)
+		if err != nil {
+			if errors.Is(err, git.ErrRepositoryNotFound) || errors.Is(err, git.ErrBrowseUnsupported) {
+				return nil, &services.ValidationError{Msg: fmt.Sprintf("cannot list repositories of %s: %v", req.OwnerURL, err)}
+			}
+			return nil, fmt.Errorf("failed to list repositories: %w", err)
+		}
+		for _, repo := range listed {
+			if repo.Archived {
+				result.Skipped = append(result.Skipped, SkippedRepository{RepositoryURL: repo.URL, Reason: "repository is archived"})
+				continue
+			}
+			repos = append(repos, repo)
+		}
+	} else {
+		for _, u := range req.RepositoryURLs {
+			repos = append(repos, git.Repository{URL: u})
+		}
+	}
+
+	names := map[string]bool{}
+	for i, repo := range repos {
+		if i >= maxRepositories {
+			result.Skipped = append(result.Skipped, SkippedRepository{
+				RepositoryURL: repo.URL,
+				Reason:        fmt.Sprintf("only %d repositories are scanned per request", maxRepositories),
+			})
+			continue
+		}
+		proposals, err := s.scan(ctx, repo, req)
+		if err != nil {
+			s.logger.Debug("Skipping repository", "repository", repo.URL, "error", err)
+			result.Skipped = append(result.Skipped, SkippedRepository{RepositoryURL: repo.URL, Reason: err.Error()})
+			continue
+		}
+		for _, p := range proposals {
+			p.Name = uniqueName(componentName(p.Name), names)
+			result.Proposals = append(result.Proposals, p)
+		}
+	}
+	return result, nil
+}
+
+// scan returns the proposals for one repository.
+func (s *discoveryService) scan(ctx context.Context, repo git.Repository, req *DiscoverRequest) ([]Proposal, error) {
+	ref := req.Ref
+	if ref == "" {
+		ref = repo.DefaultBranch
+	}
+	if ref == "" {
+		branch, err := s.browser.DefaultBranch(ctx, repo.URL, req.Token)
+		if err != nil {
+			return nil, err
+		}
+		ref = branch
+	}
+	files, err := s.browser.ListFiles(ctx, repo.URL, ref, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	readFile := func(p string) ([]byte, error) {
+		return s.browser.ReadFile(ctx, repo.URL, ref, p, req.Token)
+	}
+	rootName := strings.TrimSuffix(path.Base(strings.TrimRight(repo.URL, "/")), ".git")
+
+	proposals := detect(files, rootName, readFile)
+	for i := range proposals {
+		proposals[i].RepositoryURL = repo.URL
+		proposals[i].Ref = ref
+	}
+	return proposals, nil
+}
+
+// uniqueName returns name, suffixed with a number when it is already taken,
+// and marks it taken.
+func uniqueName(name string, taken map[string]bool) string {
+	unique := name
+	for n := 2; taken[unique]; n++ {
+		suffix := "-" + strconv.Itoa(n)
+		unique = strings.TrimRight(name[:min(len(name), 63-len(suffix))], "-") + suffix
+	}
+	taken[unique] = true
+	return unique
+}
+
+// AcceptProposals creates a component for each proposal. Proposals with a
+// component descriptor are imported from it; the others are built with their
+// proposed workflow. A failure to create one component does not stop the
+// others and is reported in its result.
+func (s *discoveryService) AcceptProposals(ctx context.Context, namespaceName string, req *AcceptRequest) ([]AcceptResult, error) {
+	if req == nil {
+		return nil, fmt.Errorf("accept request cannot be nil")
+	}
+	if req.ProjectName == "" {
+		return nil, &services.ValidationError{Msg: "projectName is required"}
+	}
+	if len(req.Proposals) == 0 {
+		return nil, &services.ValidationError{Msg: "at least one proposal is required"}
+	}
+	for _, p := range req.Proposals {
+		if p.Name == "" || p.RepositoryURL == "" {
+			return nil, &services.ValidationError{Msg: "each proposal requires a name and repositoryUrl"}
+		}
+		if p.DescriptorPath == "" && req.ClassName == "" {
+			return nil, &services.ValidationError{
+				Msg: fmt.Sprintf("className is required to accept proposal %q, which has no component descriptor", p.Name),
+			}
+		}
+	}
+
+	s.logger.Debug("Accepting component proposals", "namespace", namespaceName, "project", req.ProjectName,
+		"proposals", len(req.Proposals))
+
+	results := make([]AcceptResult, 0, len(req.Proposals))
+	for i := range req.Proposals {
+		p := &req.Proposals[i]
+		component, err := s.accept(ctx, namespaceName, req, p)
+		if err != nil {
+			s.logger.Debug("Failed to accept proposal", "component", p.Name, "error", err)
+		}
+		results = append(results, AcceptResult{Name: p.Name, Component: component, Error: err})
+	}
+	return results, nil
+}
+
+func (s *discoveryService) accept(ctx context.Context, namespaceName string, req *AcceptRequest, p *Proposal) (*openchoreov1alpha1.Component, error) {
+	if p.DescriptorPath != "" {
+		appPath := strings.Trim(p.AppPath, "/")
+		descriptorPath, err := relativePath(p.DescriptorPath, appPath)
+		if err != nil {
+			return nil, err
+		}
+		generated, err := s.componentService.GenerateComponentFromRepository(ctx, namespaceName, &componentsvc.ImportComponentRequest{
+			ProjectName:    req.ProjectName,
+			RepositoryURL:  p.RepositoryURL,
+			Ref:            p.Ref,
+			AppPath:        p.AppPath,
+			DescriptorPath: descriptorPath,
+			Name:           p.Name,
+			ClassName:      firstNonEmpty(p.ClassName, req.ClassName),
+			ClassKind:      req.ClassKind,
+			AutoDeploy:     req.AutoDeploy,
+		})
+		if err != nil {
+			return nil, err
+		}
+		created, err := s.componentService.CreateComponentWithWorkload(ctx, namespaceName, generated.Component, generated.Workload)
+		if err != nil {
+			return nil, err
+		}
+		return created.Component, nil
+	}
+
+	scaffoldReq := &componentsvc.ScaffoldComponentRequest{
+		Name:               p.Name,
+		ProjectName:        req.ProjectName,
+		Type:               openchoreov1alpha1.WorkloadType(firstNonEmpty(string(p.Type), string(openchoreov1alpha1.WorkloadTypeService))),
+		ClassName:          req.ClassName,
+		ClassKind:          req.ClassKind,
+		AutoDeploy:         req.AutoDeploy,
+		RepositoryURL:      p.RepositoryURL,
+		Branch:             p.Ref,
+		AppPath:            p.AppPath,
+		WorkflowParameters: p.WorkflowParameters,
+		Port:               p.Port,
+	}
+	if p.Workflow != "" {
+		scaffoldReq.Workflow = &openchoreov1alpha1.WorkflowRef{
+			Kind: openchoreov1alpha1.WorkflowRefKindClusterWorkflow,
+			Name: p.Workflow,
+		}
+	}
+	created, err := s.componentService.ScaffoldComponent(ctx, namespaceName, scaffoldReq)
+	if err != nil {
+		return nil, err
+	}
+	return created.Component, nil
+}
+
+// relativePath returns file relative to the directory dir of a repository.
+func relativePath(file, dir string) (string, error) {
+	file = strings.Trim(file, "/")
+	if dir == "" {
+		return file, nil
+	}
+	rel, ok := strings.CutPrefix(file, dir+"/")
+	if !ok {
+		return "", &services.ValidationError{Msg: fmt.Sprintf("descriptor %s is not under appPath /%s", file, dir)}
+	}
+	return rel, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"context"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+)
+
+const resourceTypeProject = "project"
+
+// discoveryServiceWithAuthz wraps a Service and adds authorization checks.
+// Handlers should use this. Other services should use the unwrapped Service directly.
+type discoveryServiceWithAuthz struct {
+	internal Service
+	authz    *services.AuthzChecker
+}
+
+var _ Service = (*discoveryServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates a discovery service with authorization checks.
+// Accepted components are created through the component service with
+// authorization, so that each creation is checked like a direct one.
+func NewServiceWithAuthz(k8sClient client.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &discoveryServiceWithAuthz{
+		internal: newService(k8sClient,
+			componentsvc.NewServiceWithAuthz(k8sClient, authzPDP, logger.With("component", "component-service")), logger),
+		authz: services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+// DiscoverComponents requires permission to view the project the components
+// are proposed for.
+func (s *discoveryServiceWithAuthz) DiscoverComponents(ctx context.Context, namespaceName string, req *DiscoverRequest) (*DiscoverResult, error) {
+	if req != nil {
+		if err := s.authz.Check(ctx, services.CheckRequest{
+			Action:       authz.ActionViewProject,
+			ResourceType: resourceTypeProject,
+			ResourceID:   req.ProjectName,
+			Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName, Project: req.ProjectName},
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return s.internal.DiscoverComponents(ctx, namespaceName, req)
+}
+
+// AcceptProposals is checked per component by the component service.
+func (s *discoveryServiceWithAuthz) AcceptProposals(ctx context.Context, namespaceName string, req *AcceptRequest) ([]AcceptResult, error) {
+	return s.internal.AcceptProposals(ctx, namespaceName, req)
+}
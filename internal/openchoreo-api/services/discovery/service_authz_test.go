@@ -0,0 +1,39 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func newAuthzService(t *testing.T, pdp authzcore.PDP) *discoveryServiceWithAuthz {
+	return &discoveryServiceWithAuthz{
+		internal: newTestService(t, &fakeBrowser{repos: map[string]map[string]string{"https://github.com/acme/api": {}}}, nil),
+		authz:    testutil.NewTestAuthzChecker(pdp),
+	}
+}
+
+func TestDiscoverComponents_AuthzCheck(t *testing.T) {
+	req := &DiscoverRequest{ProjectName: testProject, RepositoryURLs: []string{"https://github.com/acme/api"}}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		_, err := newAuthzService(t, pdp).DiscoverComponents(testutil.AuthzContext(), testNamespace, req)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "project:view", "project", testProject,
+			authzcore.ResourceHierarchy{Namespace: testNamespace, Project: testProject})
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		_, err := newAuthzService(t, testutil.DenyPDP()).DiscoverComponents(testutil.AuthzContext(), testNamespace, req)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
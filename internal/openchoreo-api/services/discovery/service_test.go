@@ -0,0 +1,213 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	componentmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/git"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+const (
+	testNamespace = "test-ns"
+	testProject   = "shop"
+)
+
+// fakeBrowser serves the files of repositories by URL.
+type fakeBrowser struct {
+	owners map[string][]git.Repository
+	repos  map[string]map[string]string
+}
+
+func (b *fakeBrowser) ListRepositories(_ context.Context, ownerURL, _ string) ([]git.Repository, error) {
+	repos, ok := b.owners[ownerURL]
+	if !ok {
+		return nil, git.ErrRepositoryNotFound
+	}
+	return repos, nil
+}
+
+func (b *fakeBrowser) DefaultBranch(_ context.Context, repoURL, _ string) (string, error) {
+	if _, ok := b.repos[repoURL]; !ok {
+		return "", git.ErrRepositoryNotFound
+	}
+	return "main", nil
+}
+
+func (b *fakeBrowser) ListFiles(_ context.Context, repoURL, _, _ string) ([]string, error) {
+	files, ok := b.repos[repoURL]
+	if !ok {
+		return nil, git.ErrRepositoryNotFound
+	}
+	paths := make([]string, 0, len(files))
+	for p := range files {
+		paths = append(paths, p)
+	}
+	return paths, nil
+}
+
+func (b *fakeBrowser) ReadFile(_ context.Context, repoURL, _, path, _ string) ([]byte, error) {
+	return readFrom(b.repos[repoURL])(path)
+}
+
+func newTestService(t *testing.T, browser git.RepositoryBrowser, componentService componentsvc.Service) *discoveryService {
+	t.Helper()
+	k8sClient := testutil.NewFakeClient(testutil.NewProject(testNamespace, testProject))
+	return &discoveryService{
+		projectService:   projectsvc.NewService(k8sClient, testutil.TestLogger()),
+		componentService: componentService,
+		browser:          browser,
+		logger:           testutil.TestLogger(),
+	}
+}
+
+func TestDiscoverComponents(t *testing.T) {
+	browser := &fakeBrowser{
+		owners: map[string][]git.Repository{
+			"https://github.com/acme": {
+				{URL: "https://github.com/acme/api.git", DefaultBranch: "develop"},
+				{URL: "https://github.com/acme/web.git", DefaultBranch: "main"},
+				{URL: "https://github.com/acme/legacy.git", DefaultBranch: "main", Archived: true},
+				{URL: "https://github.com/acme/gone.git", DefaultBranch: "main"},
+			},
+		},
+		repos: map[string]map[string]string{
+			"https://github.com/acme/api.git": {"Dockerfile": "FROM scratch\n", "docs/README.md": ""},
+			"https://github.com/acme/web.git": {"package.json": "{}", "api/go.mod": "", "worker/go.mod": ""},
+		},
+	}
+	svc := newTestService(t, browser, nil)
+
+	t.Run("owner", func(t *testing.T) {
+		result, err := svc.DiscoverComponents(t.Context(), testNamespace, &DiscoverRequest{
+			ProjectName: testProject,
+			OwnerURL:    "https://github.com/acme",
+		})
+		require.NoError(t, err)
+
+		var names, refs []string
+		for _, p := range result.Proposals {
+			names = append(names, p.Name)
+			refs = append(refs, p.Ref)
+		}
+		assert.Equal(t, []string{"api", "api-2", "worker"}, names, "names are unique across repositories")
+		assert.Equal(t, []string{"develop", "main", "main"}, refs)
+		assert.Equal(t, "https://github.com/acme/web.git", result.Proposals[1].RepositoryURL)
+
+		require.Len(t, result.Skipped, 2)
+		assert.Equal(t, SkippedRepository{RepositoryURL: "https://github.com/acme/legacy.git", Reason: "repository is archived"}, result.Skipped[0])
+		assert.Equal(t, "https://github.com/acme/gone.git", result.Skipped[1].RepositoryURL)
+	})
+
+	t.Run("repositories", func(t *testing.T) {
+		result, err := svc.DiscoverComponents(t.Context(), testNamespace, &DiscoverRequest{
+			ProjectName:    testProject,
+			RepositoryURLs: []string{"https://github.com/acme/api.git"},
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Proposals, 1)
+		assert.Equal(t, "main", result.Proposals[0].Ref, "the default branch is looked up")
+		assert.Empty(t, result.Skipped)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		var validationErr *services.ValidationError
+		_, err := svc.DiscoverComponents(t.Context(), testNamespace, &DiscoverRequest{ProjectName: testProject})
+		require.ErrorAs(t, err, &validationErr)
+
+		_, err = svc.DiscoverComponents(t.Context(), testNamespace, &DiscoverRequest{
+			ProjectName: testProject, OwnerURL: "https://github.com/nobody",
+		})
+		require.ErrorAs(t, err, &validationErr)
+
+		_, err = svc.DiscoverComponents(t.Context(), testNamespace, &DiscoverRequest{
+			ProjectName: "missing", OwnerURL: "https://github.com/acme",
+		})
+		require.ErrorIs(t, err, projectsvc.ErrProjectNotFound)
+	})
+}
+
+func TestAcceptProposals(t *testing.T) {
+	componentService := componentmocks.NewMockService(t)
+	svc := newTestService(t, &fakeBrowser{}, componentService)
+
+	billing := &openchoreov1alpha1.Component{}
+	billing.Name = "billing"
+	componentService.EXPECT().GenerateComponentFromRepository(mock.Anything, testNamespace, &componentsvc.ImportComponentRequest{
+		ProjectName:    testProject,
+		RepositoryURL:  "https://github.com/acme/shop",
+		Ref:            "main",
+		AppPath:        "/billing",
+		DescriptorPath: ".choreo/component.yaml",
+		Name:           "billing",
+		ClassName:      "service",
+		AutoDeploy:     true,
+	}).Return(&componentsvc.ImportComponentResult{Component: billing}, nil)
+	componentService.EXPECT().CreateComponentWithWorkload(mock.Anything, testNamespace, billing, (*openchoreov1alpha1.Workload)(nil)).
+		Return(&componentsvc.ScaffoldComponentResult{Component: billing}, nil)
+
+	params := map[string]any{"docker": map[string]any{"context": "/orders"}}
+	componentService.EXPECT().ScaffoldComponent(mock.Anything, testNamespace, &componentsvc.ScaffoldComponentRequest{
+		Name:               "orders",
+		ProjectName:        testProject,
+		Type:               openchoreov1alpha1.WorkloadTypeService,
+		ClassName:          "service",
+		AutoDeploy:         true,
+		RepositoryURL:      "https://github.com/acme/shop",
+		Branch:             "main",
+		AppPath:            "/orders",
+		Workflow:           &openchoreov1alpha1.WorkflowRef{Kind: openchoreov1alpha1.WorkflowRefKindClusterWorkflow, Name: "dockerfile-builder"},
+		WorkflowParameters: params,
+		Port:               9090,
+	}).Return(nil, errors.New("already exists"))
+
+	results, err := svc.AcceptProposals(t.Context(), testNamespace, &AcceptRequest{
+		ProjectName: testProject,
+		ClassName:   "service",
+		AutoDeploy:  true,
+		Proposals: []Proposal{
+			{
+				Name: "billing", RepositoryURL: "https://github.com/acme/shop", Ref: "main", AppPath: "/billing",
+				DescriptorPath: "billing/.choreo/component.yaml",
+			},
+			{
+				Name: "orders", RepositoryURL: "https://github.com/acme/shop", Ref: "main", AppPath: "/orders",
+				Type: openchoreov1alpha1.WorkloadTypeService, Port: 9090, Build: BuildStrategyDocker,
+				Workflow: "dockerfile-builder", WorkflowParameters: params,
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, AcceptResult{Name: "billing", Component: billing}, results[0])
+	assert.Equal(t, "orders", results[1].Name)
+	assert.EqualError(t, results[1].Error, "already exists")
+}
+
+func TestAcceptProposals_Validation(t *testing.T) {
+	svc := newTestService(t, &fakeBrowser{}, nil)
+	var validationErr *services.ValidationError
+
+	_, err := svc.AcceptProposals(t.Context(), testNamespace, &AcceptRequest{ProjectName: testProject})
+	require.ErrorAs(t, err, &validationErr)
+
+	_, err = svc.AcceptProposals(t.Context(), testNamespace, &AcceptRequest{
+		ProjectName: testProject,
+		Proposals:   []Proposal{{Name: "orders", RepositoryURL: "https://github.com/acme/shop"}},
+	})
+	require.ErrorAs(t, err, &validationErr)
+	assert.Contains(t, err.Error(), "className is required")
+}
@@ -0,0 +1,14 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package endpoint
+
+import "errors"
+
+var (
+	ErrWorkloadNotFound  = errors.New("workload not found for component")
+	ErrEndpointNotFound  = errors.New("endpoint not found")
+	ErrInvalidVisibility = errors.New("invalid endpoint visibility")
+	ErrInvalidBasePath   = errors.New("base path must start with '/'")
+	ErrNothingToUpdate   = errors.New("at least one of visibility or basePath must be set")
+)
@@ -0,0 +1,83 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package endpoint
+
+import (
+	"context"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// Endpoint is an endpoint exposed by a component's workload, together with the
+// addresses it resolved to in each environment the component is deployed to.
+type Endpoint struct {
+	Name          string
+	ProjectName   string
+	ComponentName string
+	WorkloadName  string
+	Type          openchoreov1alpha1.EndpointType
+	Port          int32
+	BasePath      string
+	// Visibility lists the effective visibilities of the endpoint. Project visibility
+	// is implicit for every endpoint and is always listed first.
+	Visibility   []openchoreov1alpha1.EndpointVisibility
+	Environments []EnvironmentEndpoint
+}
+
+// EnvironmentEndpoint holds the resolved addresses of an endpoint in one environment.
+type EnvironmentEndpoint struct {
+	Environment        string
+	ReleaseBindingName string
+	// ServiceURL is the in-cluster address of the service backing the endpoint.
+	ServiceURL   *openchoreov1alpha1.EndpointURL
+	InternalURLs *openchoreov1alpha1.EndpointGatewayURLs
+	ExternalURLs *openchoreov1alpha1.EndpointGatewayURLs
+	// CustomDomains lists the verified custom domains served by the endpoint's external route.
+	CustomDomains []string
+	// Certificates lists the managed TLS certificates issued for the endpoint hosts.
+	Certificates []Certificate
+}
+
+// CertificateAlert flags a managed certificate that needs attention.
+type CertificateAlert string
+
+const (
+	// CertificateAlertNotIssued means the certificate has not been issued yet.
+	CertificateAlertNotIssued CertificateAlert = "NotIssued"
+	// CertificateAlertRenewalOverdue means the certificate was not renewed by its renewal time.
+	CertificateAlertRenewalOverdue CertificateAlert = "RenewalOverdue"
+	// CertificateAlertExpired means the certificate has expired.
+	CertificateAlertExpired CertificateAlert = "Expired"
+)
+
+// Certificate is a managed TLS certificate issued for the hosts of an endpoint.
+type Certificate struct {
+	openchoreov1alpha1.EndpointCertificateStatus
+	// Alert is set when the certificate is not issued yet, overdue for renewal or expired.
+	Alert CertificateAlert
+}
+
+// ListFilter narrows the endpoints returned by ListEndpoints. Empty fields match everything.
+type ListFilter struct {
+	ProjectName   string
+	ComponentName string
+	Environment   string
+}
+
+// UpdateRequest holds the endpoint settings to change. Nil fields are left unchanged.
+type UpdateRequest struct {
+	Visibility *[]openchoreov1alpha1.EndpointVisibility
+	BasePath   *string
+}
+
+// Service defines the endpoint management service interface.
+// Both the core service (no authz) and the authz-wrapped service implement this.
+type Service interface {
+	// ListEndpoints returns the endpoints exposed by the components of a namespace,
+	// ordered by project, component and endpoint name.
+	ListEndpoints(ctx context.Context, namespaceName string, filter ListFilter) ([]Endpoint, error)
+	// UpdateEndpoint changes the visibility or base path of an endpoint on the
+	// component's workload.
+	UpdateEndpoint(ctx context.Context, namespaceName, componentName, endpointName string, req UpdateRequest) (*Endpoint, error)
+}
@@ -0,0 +1,159 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	endpoint "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/endpoint"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// ListEndpoints provides a mock function with given fields: ctx, namespaceName, filter
+func (_m *MockService) ListEndpoints(ctx context.Context, namespaceName string, filter endpoint.ListFilter) ([]endpoint.Endpoint, error) {
+	ret := _m.Called(ctx, namespaceName, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEndpoints")
+	}
+
+	var r0 []endpoint.Endpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, endpoint.ListFilter) ([]endpoint.Endpoint, error)); ok {
+		return rf(ctx, namespaceName, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, endpoint.ListFilter) []endpoint.Endpoint); ok {
+		r0 = rf(ctx, namespaceName, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]endpoint.Endpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, endpoint.ListFilter) error); ok {
+		r1 = rf(ctx, namespaceName, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListEndpoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEndpoints'
+type MockService_ListEndpoints_Call struct {
+	*mock.Call
+}
+
+// ListEndpoints is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - filter endpoint.ListFilter
+func (_e *MockService_Expecter) ListEndpoints(ctx interface{}, namespaceName interface{}, filter interface{}) *MockService_ListEndpoints_Call {
+	return &MockService_ListEndpoints_Call{Call: _e.mock.On("ListEndpoints", ctx, namespaceName, filter)}
+}
+
+func (_c *MockService_ListEndpoints_Call) Run(run func(ctx context.Context, namespaceName string, filter endpoint.ListFilter)) *MockService_ListEndpoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(endpoint.ListFilter))
+	})
+	return _c
+}
+
+func (_c *MockService_ListEndpoints_Call) Return(_a0 []endpoint.Endpoint, _a1 error) *MockService_ListEndpoints_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListEndpoints_Call) RunAndReturn(run func(context.Context, string, endpoint.ListFilter) ([]endpoint.Endpoint, error)) *MockService_ListEndpoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEndpoint provides a mock function with given fields: ctx, namespaceName, componentName, endpointName, req
+func (_m *MockService) UpdateEndpoint(ctx context.Context, namespaceName string, componentName string, endpointName string, req endpoint.UpdateRequest) (*endpoint.Endpoint, error) {
+	ret := _m.Called(ctx, namespaceName, componentName, endpointName, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEndpoint")
+	}
+
+	var r0 *endpoint.Endpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, endpoint.UpdateRequest) (*endpoint.Endpoint, error)); ok {
+		return rf(ctx, namespaceName, componentName, endpointName, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, endpoint.UpdateRequest) *endpoint.Endpoint); ok {
+		r0 = rf(ctx, namespaceName, componentName, endpointName, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*endpoint.Endpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, endpoint.UpdateRequest) error); ok {
+		r1 = rf(ctx, namespaceName, componentName, endpointName, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_UpdateEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEndpoint'
+type MockService_UpdateEndpoint_Call struct {
+	*mock.Call
+}
+
+// UpdateEndpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - componentName string
+//   - endpointName string
+//   - req endpoint.UpdateRequest
+func (_e *MockService_Expecter) UpdateEndpoint(ctx interface{}, namespaceName interface{}, componentName interface{}, endpointName interface{}, req interface{}) *MockService_UpdateEndpoint_Call {
+	return &MockService_UpdateEndpoint_Call{Call: _e.mock.On("UpdateEndpoint", ctx, namespaceName, componentName, endpointName, req)}
+}
+
+func (_c *MockService_UpdateEndpoint_Call) Run(run func(ctx context.Context, namespaceName string, componentName string, endpointName string, req endpoint.UpdateRequest)) *MockService_UpdateEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(endpoint.UpdateRequest))
+	})
+	return _c
+}
+
+func (_c *MockService_UpdateEndpoint_Call) Return(_a0 *endpoint.Endpoint, _a1 error) *MockService_UpdateEndpoint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_UpdateEndpoint_Call) RunAndReturn(run func(context.Context, string, string, string, endpoint.UpdateRequest) (*endpoint.Endpoint, error)) *MockService_UpdateEndpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,269 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package endpoint
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	workloadsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workload"
+)
+
+// visibilityOrder is the canonical order of the visibilities stored on a workload
+// endpoint, from the narrowest to the widest scope.
+var visibilityOrder = []openchoreov1alpha1.EndpointVisibility{
+	openchoreov1alpha1.EndpointVisibilityNamespace,
+	openchoreov1alpha1.EndpointVisibilityInternal,
+	openchoreov1alpha1.EndpointVisibilityExternal,
+}
+
+// endpointService manages workload endpoints without authorization checks.
+type endpointService struct {
+	k8sClient        client.Client
+	componentService componentsvc.Service
+	workloadService  workloadsvc.Service
+	logger           *slog.Logger
+}
+
+var _ Service = (*endpointService)(nil)
+
+// NewService creates a new endpoint service without authorization.
+func NewService(k8sClient client.Client, logger *slog.Logger) Service {
+	return &endpointService{
+		k8sClient:        k8sClient,
+		componentService: componentsvc.NewService(k8sClient, logger.With("component", "component-service-internal")),
+		workloadService:  workloadsvc.NewService(k8sClient, logger.With("component", "workload-service-internal")),
+		logger:           logger,
+	}
+}
+
+// ListEndpoints joins the endpoints declared on each workload with the addresses
+// reported on the status of the component's release bindings.
+func (s *endpointService) ListEndpoints(ctx context.Context, namespaceName string, filter ListFilter) ([]Endpoint, error) {
+	s.logger.Debug("Listing endpoints", "namespace", namespaceName, "project", filter.ProjectName,
+		"component", filter.ComponentName, "environment", filter.Environment)
+
+	workloadList := &openchoreov1alpha1.WorkloadList{}
+	if err := s.k8sClient.List(ctx, workloadList, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list workloads", "error", err)
+		return nil, fmt.Errorf("failed to list workloads: %w", err)
+	}
+	bindings, err := s.listReleaseBindings(ctx, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := make([]Endpoint, 0)
+	for i := range workloadList.Items {
+		w := &workloadList.Items[i]
+		if filter.ProjectName != "" && w.Spec.Owner.ProjectName != filter.ProjectName {
+			continue
+		}
+		if filter.ComponentName != "" && w.Spec.Owner.ComponentName != filter.ComponentName {
+			continue
+		}
+		for name := range w.Spec.Endpoints {
+			endpoints = append(endpoints, buildEndpoint(w, name, bindings, filter.Environment))
+		}
+	}
+
+	sort.Slice(endpoints, func(i, j int) bool {
+		a, b := endpoints[i], endpoints[j]
+		if a.ProjectName != b.ProjectName {
+			return a.ProjectName < b.ProjectName
+		}
+		if a.ComponentName != b.ComponentName {
+			return a.ComponentName < b.ComponentName
+		}
+		return a.Name < b.Name
+	})
+	return endpoints, nil
+}
+
+// UpdateEndpoint rewrites the endpoint on the component's workload. The component
+// controller picks up the workload change and rolls it out like any other edit.
+func (s *endpointService) UpdateEndpoint(ctx context.Context, namespaceName, componentName, endpointName string, req UpdateRequest) (*Endpoint, error) {
+	s.logger.Debug("Updating endpoint", "namespace", namespaceName, "component", componentName, "endpoint", endpointName)
+
+	if req.Visibility == nil && req.BasePath == nil {
+		return nil, ErrNothingToUpdate
+	}
+	var visibility []openchoreov1alpha1.EndpointVisibility
+	if req.Visibility != nil {
+		var err error
+		if visibility, err = normalizeVisibility(*req.Visibility); err != nil {
+			return nil, err
+		}
+	}
+	if req.BasePath != nil && *req.BasePath != "" && !strings.HasPrefix(*req.BasePath, "/") {
+		return nil, ErrInvalidBasePath
+	}
+
+	if _, err := s.componentService.GetComponent(ctx, namespaceName, componentName); err != nil {
+		return nil, err
+	}
+	w, err := s.findWorkload(ctx, namespaceName, componentName)
+	if err != nil {
+		return nil, err
+	}
+	ep, ok := w.Spec.Endpoints[endpointName]
+	if !ok {
+		return nil, ErrEndpointNotFound
+	}
+	if req.Visibility != nil {
+		ep.Visibility = visibility
+	}
+	if req.BasePath != nil {
+		ep.BasePath = *req.BasePath
+	}
+	w.Spec.Endpoints[endpointName] = ep
+
+	updated, err := s.workloadService.UpdateWorkload(ctx, namespaceName, w)
+	if err != nil {
+		return nil, err
+	}
+
+	bindings, err := s.listReleaseBindings(ctx, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Debug("Endpoint updated", "namespace", namespaceName, "component", componentName, "endpoint", endpointName)
+	result := buildEndpoint(updated, endpointName, bindings, "")
+	return &result, nil
+}
+
+// findWorkload returns the workload owned by the component. Workloads are matched on
+// their owner rather than their name, as the component controller does.
+func (s *endpointService) findWorkload(ctx context.Context, namespaceName, componentName string) (*openchoreov1alpha1.Workload, error) {
+	workloadList := &openchoreov1alpha1.WorkloadList{}
+	if err := s.k8sClient.List(ctx, workloadList, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list workloads", "error", err)
+		return nil, fmt.Errorf("failed to list workloads: %w", err)
+	}
+	for i := range workloadList.Items {
+		if workloadList.Items[i].Spec.Owner.ComponentName == componentName {
+			return &workloadList.Items[i], nil
+		}
+	}
+	return nil, ErrWorkloadNotFound
+}
+
+// listReleaseBindings returns the release bindings of the namespace grouped by component.
+func (s *endpointService) listReleaseBindings(ctx context.Context, namespaceName string) (map[string][]openchoreov1alpha1.ReleaseBinding, error) {
+	bindingList := &openchoreov1alpha1.ReleaseBindingList{}
+	if err := s.k8sClient.List(ctx, bindingList, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list release bindings", "error", err)
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+	byComponent := make(map[string][]openchoreov1alpha1.ReleaseBinding)
+	for _, rb := range bindingList.Items {
+		byComponent[rb.Spec.Owner.ComponentName] = append(byComponent[rb.Spec.Owner.ComponentName], rb)
+	}
+	for _, rbs := range byComponent {
+		sort.Slice(rbs, func(i, j int) bool { return rbs[i].Spec.Environment < rbs[j].Spec.Environment })
+	}
+	return byComponent, nil
+}
+
+func buildEndpoint(
+	w *openchoreov1alpha1.Workload, name string,
+	bindings map[string][]openchoreov1alpha1.ReleaseBinding, environment string,
+) Endpoint {
+	spec := w.Spec.Endpoints[name]
+	ep := Endpoint{
+		Name:          name,
+		ProjectName:   w.Spec.Owner.ProjectName,
+		ComponentName: w.Spec.Owner.ComponentName,
+		WorkloadName:  w.Name,
+		Type:          spec.Type,
+		Port:          spec.Port,
+		BasePath:      spec.BasePath,
+		Visibility:    effectiveVisibility(spec.Visibility),
+		Environments:  make([]EnvironmentEndpoint, 0),
+	}
+	for _, rb := range bindings[w.Spec.Owner.ComponentName] {
+		if environment != "" && rb.Spec.Environment != environment {
+			continue
+		}
+		for _, status := range rb.Status.Endpoints {
+			if status.Name != name {
+				continue
+			}
+			ep.Environments = append(ep.Environments, EnvironmentEndpoint{
+				Environment:        rb.Spec.Environment,
+				ReleaseBindingName: rb.Name,
+				ServiceURL:         status.ServiceURL,
+				InternalURLs:       status.InternalURLs,
+				ExternalURLs:       status.ExternalURLs,
+				CustomDomains:      status.CustomDomains,
+				Certificates:       buildCertificates(status.Certificates, time.Now()),
+			})
+		}
+	}
+	return ep
+}
+
+// buildCertificates reports the certificates of an endpoint with an alert for those that are
+// expired, overdue for renewal or not issued yet.
+func buildCertificates(statuses []openchoreov1alpha1.EndpointCertificateStatus, now time.Time) []Certificate {
+	certificates := make([]Certificate, 0, len(statuses))
+	for _, status := range statuses {
+		cert := Certificate{EndpointCertificateStatus: status}
+		switch {
+		case status.NotAfter != nil && !status.NotAfter.After(now):
+			cert.Alert = CertificateAlertExpired
+		case status.RenewalTime != nil && !status.RenewalTime.After(now):
+			cert.Alert = CertificateAlertRenewalOverdue
+		case !status.Ready:
+			cert.Alert = CertificateAlertNotIssued
+		}
+		certificates = append(certificates, cert)
+	}
+	return certificates
+}
+
+// effectiveVisibility prepends the implicit project visibility to the visibilities
+// stored on a workload endpoint.
+func effectiveVisibility(stored []openchoreov1alpha1.EndpointVisibility) []openchoreov1alpha1.EndpointVisibility {
+	result := []openchoreov1alpha1.EndpointVisibility{openchoreov1alpha1.EndpointVisibilityProject}
+	for _, v := range stored {
+		if v != openchoreov1alpha1.EndpointVisibilityProject {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// normalizeVisibility validates requested visibilities and converts them to the form
+// stored on a workload endpoint: deduplicated, in canonical order and without the
+// implicit project visibility.
+func normalizeVisibility(requested []openchoreov1alpha1.EndpointVisibility) ([]openchoreov1alpha1.EndpointVisibility, error) {
+	wanted := make(map[openchoreov1alpha1.EndpointVisibility]bool, len(requested))
+	for _, v := range requested {
+		switch v {
+		case openchoreov1alpha1.EndpointVisibilityProject:
+		case openchoreov1alpha1.EndpointVisibilityNamespace,
+			openchoreov1alpha1.EndpointVisibilityInternal,
+			openchoreov1alpha1.EndpointVisibilityExternal:
+			wanted[v] = true
+		default:
+			return nil, fmt.Errorf("%w: %q", ErrInvalidVisibility, v)
+		}
+	}
+	var result []openchoreov1alpha1.EndpointVisibility
+	for _, v := range visibilityOrder {
+		if wanted[v] {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package endpoint
+
+import (
+	"context"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+)
+
+const resourceTypeWorkload = "workload"
+
+// endpointServiceWithAuthz wraps a Service and adds authorization checks.
+// Handlers should use this. Other services should use the unwrapped Service directly.
+type endpointServiceWithAuthz struct {
+	internal         Service
+	componentService componentsvc.Service
+	authz            *services.AuthzChecker
+}
+
+var _ Service = (*endpointServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates an endpoint service with authorization checks.
+func NewServiceWithAuthz(k8sClient client.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &endpointServiceWithAuthz{
+		internal:         NewService(k8sClient, logger),
+		componentService: componentsvc.NewService(k8sClient, logger.With("component", "component-service-internal")),
+		authz:            services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+// ListEndpoints drops the endpoints of components whose workloads the caller is not
+// allowed to view.
+func (s *endpointServiceWithAuthz) ListEndpoints(ctx context.Context, namespaceName string, filter ListFilter) ([]Endpoint, error) {
+	endpoints, err := s.internal.ListEndpoints(ctx, namespaceName, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	type owner struct{ project, component string }
+	var owners []owner
+	index := make(map[owner]int)
+	for _, ep := range endpoints {
+		o := owner{ep.ProjectName, ep.ComponentName}
+		if _, ok := index[o]; !ok {
+			index[o] = len(owners)
+			owners = append(owners, o)
+		}
+	}
+	if len(owners) == 0 {
+		return endpoints, nil
+	}
+
+	requests := make([]services.CheckRequest, len(owners))
+	for i, o := range owners {
+		requests[i] = services.CheckRequest{
+			Action:       authz.ActionViewWorkload,
+			ResourceType: resourceTypeWorkload,
+			ResourceID:   o.component,
+			Hierarchy: authz.ResourceHierarchy{
+				Namespace: namespaceName,
+				Project:   o.project,
+				Component: o.component,
+			},
+		}
+	}
+	decisions, err := s.authz.BatchCheck(ctx, requests)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]Endpoint, 0, len(endpoints))
+	for _, ep := range endpoints {
+		i := index[owner{ep.ProjectName, ep.ComponentName}]
+		if i < len(decisions) && decisions[i] {
+			filtered = append(filtered, ep)
+		}
+	}
+	return filtered, nil
+}
+
+// UpdateEndpoint requires permission to update the component's workload.
+func (s *endpointServiceWithAuthz) UpdateEndpoint(ctx context.Context, namespaceName, componentName, endpointName string, req UpdateRequest) (*Endpoint, error) {
+	// Fetch first to get the project for authz hierarchy
+	comp, err := s.componentService.GetComponent(ctx, namespaceName, componentName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionUpdateWorkload,
+		ResourceType: resourceTypeWorkload,
+		ResourceID:   componentName,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   comp.Spec.Owner.ProjectName,
+			Component: componentName,
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.UpdateEndpoint(ctx, namespaceName, componentName, endpointName, req)
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package endpoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func newAuthzService(pdp authzcore.PDP) Service {
+	comp := testutil.NewComponent(testNamespace, testProjectName, testComponentName)
+	return &endpointServiceWithAuthz{
+		internal: newService(
+			comp,
+			newWorkload(testProjectName, testComponentName, map[string]openchoreov1alpha1.WorkloadEndpoint{"api": apiEndpoint()}),
+			newWorkload("other-project", "worker", map[string]openchoreov1alpha1.WorkloadEndpoint{
+				"grpc": {Type: openchoreov1alpha1.EndpointTypeGRPC, Port: 9000},
+			}),
+		),
+		componentService: componentsvc.NewService(testutil.NewFakeClient(comp), testutil.TestLogger()),
+		authz:            testutil.NewTestAuthzChecker(pdp),
+	}
+}
+
+func TestListEndpoints_AuthzCheck(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		endpoints, err := newAuthzService(pdp).ListEndpoints(testutil.AuthzContext(), testNamespace, ListFilter{})
+		require.NoError(t, err)
+		assert.Len(t, endpoints, 2)
+		require.Len(t, pdp.Captured, 2)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "workload:view", "workload", "worker",
+			authzcore.ResourceHierarchy{Namespace: testNamespace, Project: "other-project", Component: "worker"})
+		testutil.RequireEvalRequest(t, pdp.Captured[1], "workload:view", "workload", testComponentName,
+			authzcore.ResourceHierarchy{Namespace: testNamespace, Project: testProjectName, Component: testComponentName})
+	})
+
+	t.Run("denied filters all endpoints", func(t *testing.T) {
+		endpoints, err := newAuthzService(testutil.DenyPDP()).ListEndpoints(testutil.AuthzContext(), testNamespace, ListFilter{})
+		require.NoError(t, err)
+		assert.Empty(t, endpoints)
+	})
+}
+
+func TestUpdateEndpoint_AuthzCheck(t *testing.T) {
+	hierarchy := authzcore.ResourceHierarchy{Namespace: testNamespace, Project: testProjectName, Component: testComponentName}
+	req := UpdateRequest{BasePath: ptr.To("/v2")}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		ep, err := newAuthzService(pdp).UpdateEndpoint(testutil.AuthzContext(), testNamespace, testComponentName, "api", req)
+		require.NoError(t, err)
+		assert.Equal(t, "/v2", ep.BasePath)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "workload:update", "workload", testComponentName, hierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		_, err := newAuthzService(testutil.DenyPDP()).UpdateEndpoint(testutil.AuthzContext(), testNamespace, testComponentName, "api", req)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
@@ -0,0 +1,225 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package endpoint
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+	workloadsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workload"
+)
+
+const (
+	testNamespace     = "test-ns"
+	testProjectName   = "test-project"
+	testComponentName = "test-comp"
+)
+
+func newWorkload(project, component string, endpoints map[string]openchoreov1alpha1.WorkloadEndpoint) *openchoreov1alpha1.Workload {
+	w := testutil.NewWorkload(testNamespace, project, component, component+"-workload")
+	w.Spec.Endpoints = endpoints
+	return w
+}
+
+func newBinding(component, env string, statuses ...openchoreov1alpha1.EndpointURLStatus) *openchoreov1alpha1.ReleaseBinding {
+	rb := testutil.NewReleaseBinding(testNamespace, testProjectName, component, env, component+"-"+env)
+	rb.Status.Endpoints = statuses
+	return rb
+}
+
+func apiEndpoint() openchoreov1alpha1.WorkloadEndpoint {
+	return openchoreov1alpha1.WorkloadEndpoint{
+		Type:       openchoreov1alpha1.EndpointTypeHTTP,
+		Port:       8080,
+		BasePath:   "/api",
+		Visibility: []openchoreov1alpha1.EndpointVisibility{openchoreov1alpha1.EndpointVisibilityExternal},
+	}
+}
+
+func apiStatus(host string) openchoreov1alpha1.EndpointURLStatus {
+	return openchoreov1alpha1.EndpointURLStatus{
+		Name:       "api",
+		Type:       openchoreov1alpha1.EndpointTypeHTTP,
+		ServiceURL: &openchoreov1alpha1.EndpointURL{Scheme: "http", Host: "test-comp.svc", Port: 8080},
+		ExternalURLs: &openchoreov1alpha1.EndpointGatewayURLs{
+			HTTPS: &openchoreov1alpha1.EndpointURL{Scheme: "https", Host: host, Path: "/api"},
+		},
+	}
+}
+
+func newService(objs ...client.Object) Service {
+	k8sClient := testutil.NewFakeClient(objs...)
+	return &endpointService{
+		k8sClient:        k8sClient,
+		componentService: componentsvc.NewService(k8sClient, testutil.TestLogger()),
+		workloadService:  workloadsvc.NewService(k8sClient, testutil.TestLogger()),
+		logger:           testutil.TestLogger(),
+	}
+}
+
+func TestListEndpoints(t *testing.T) {
+	ctx := context.Background()
+	objs := []client.Object{
+		newWorkload(testProjectName, testComponentName, map[string]openchoreov1alpha1.WorkloadEndpoint{
+			"api":     apiEndpoint(),
+			"metrics": {Type: openchoreov1alpha1.EndpointTypeHTTP, Port: 9090},
+		}),
+		newWorkload("other-project", "worker", map[string]openchoreov1alpha1.WorkloadEndpoint{
+			"grpc": {Type: openchoreov1alpha1.EndpointTypeGRPC, Port: 9000},
+		}),
+		newBinding(testComponentName, "prod", apiStatus("api.prod.example.com")),
+		newBinding(testComponentName, "dev", apiStatus("api.dev.example.com")),
+	}
+
+	t.Run("lists endpoints of all components with their addresses", func(t *testing.T) {
+		endpoints, err := newService(objs...).ListEndpoints(ctx, testNamespace, ListFilter{})
+		require.NoError(t, err)
+		require.Len(t, endpoints, 3)
+
+		assert.Equal(t, "worker", endpoints[0].ComponentName)
+		assert.Equal(t, "grpc", endpoints[0].Name)
+		assert.Empty(t, endpoints[0].Environments)
+
+		api := endpoints[1]
+		assert.Equal(t, "api", api.Name)
+		assert.Equal(t, testProjectName, api.ProjectName)
+		assert.Equal(t, "test-comp-workload", api.WorkloadName)
+		assert.Equal(t, "/api", api.BasePath)
+		assert.Equal(t, int32(8080), api.Port)
+		assert.Equal(t, []openchoreov1alpha1.EndpointVisibility{"project", "external"}, api.Visibility)
+		require.Len(t, api.Environments, 2)
+		assert.Equal(t, "dev", api.Environments[0].Environment)
+		assert.Equal(t, "api.dev.example.com", api.Environments[0].ExternalURLs.HTTPS.Host)
+		assert.Equal(t, "test-comp.svc", api.Environments[0].ServiceURL.Host)
+		assert.Equal(t, "prod", api.Environments[1].Environment)
+
+		assert.Equal(t, "metrics", endpoints[2].Name)
+		assert.Equal(t, []openchoreov1alpha1.EndpointVisibility{"project"}, endpoints[2].Visibility)
+	})
+
+	t.Run("filters by component and environment", func(t *testing.T) {
+		endpoints, err := newService(objs...).ListEndpoints(ctx, testNamespace,
+			ListFilter{ComponentName: testComponentName, Environment: "prod"})
+		require.NoError(t, err)
+		require.Len(t, endpoints, 2)
+		require.Len(t, endpoints[0].Environments, 1)
+		assert.Equal(t, "prod", endpoints[0].Environments[0].Environment)
+	})
+
+	t.Run("filters by project", func(t *testing.T) {
+		endpoints, err := newService(objs...).ListEndpoints(ctx, testNamespace, ListFilter{ProjectName: "other-project"})
+		require.NoError(t, err)
+		require.Len(t, endpoints, 1)
+		assert.Equal(t, "grpc", endpoints[0].Name)
+	})
+}
+
+func TestBuildCertificates(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *metav1.Time {
+		t := metav1.NewTime(now.Add(d))
+		return &t
+	}
+
+	certificates := buildCertificates([]openchoreov1alpha1.EndpointCertificateStatus{
+		{Name: "healthy", Ready: true, RenewalTime: at(time.Hour), NotAfter: at(2 * time.Hour)},
+		{Name: "pending", Message: "Issuing certificate"},
+		{Name: "overdue", Ready: true, RenewalTime: at(-time.Hour), NotAfter: at(time.Hour)},
+		{Name: "expired", RenewalTime: at(-2 * time.Hour), NotAfter: at(-time.Hour)},
+	}, now)
+
+	require.Len(t, certificates, 4)
+	assert.Empty(t, certificates[0].Alert)
+	assert.Equal(t, CertificateAlertNotIssued, certificates[1].Alert)
+	assert.Equal(t, "Issuing certificate", certificates[1].Message)
+	assert.Equal(t, CertificateAlertRenewalOverdue, certificates[2].Alert)
+	assert.Equal(t, CertificateAlertExpired, certificates[3].Alert)
+}
+
+func TestUpdateEndpoint(t *testing.T) {
+	ctx := context.Background()
+	comp := testutil.NewComponent(testNamespace, testProjectName, testComponentName)
+	newObjs := func() []client.Object {
+		return []client.Object{
+			comp.DeepCopy(),
+			newWorkload(testProjectName, testComponentName, map[string]openchoreov1alpha1.WorkloadEndpoint{"api": apiEndpoint()}),
+			newBinding(testComponentName, "dev", apiStatus("api.dev.example.com")),
+		}
+	}
+
+	t.Run("changes visibility", func(t *testing.T) {
+		k8sClient := testutil.NewFakeClient(newObjs()...)
+		svc := &endpointService{
+			k8sClient:        k8sClient,
+			componentService: componentsvc.NewService(k8sClient, testutil.TestLogger()),
+			workloadService:  workloadsvc.NewService(k8sClient, testutil.TestLogger()),
+			logger:           testutil.TestLogger(),
+		}
+		ep, err := svc.UpdateEndpoint(ctx, testNamespace, testComponentName, "api", UpdateRequest{
+			Visibility: &[]openchoreov1alpha1.EndpointVisibility{"external", "project", "namespace", "external"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []openchoreov1alpha1.EndpointVisibility{"project", "namespace", "external"}, ep.Visibility)
+		assert.Equal(t, "/api", ep.BasePath)
+		require.Len(t, ep.Environments, 1)
+
+		w := &openchoreov1alpha1.Workload{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "test-comp-workload"}, w))
+		assert.Equal(t, []openchoreov1alpha1.EndpointVisibility{"namespace", "external"}, w.Spec.Endpoints["api"].Visibility)
+	})
+
+	t.Run("project only clears extra visibilities", func(t *testing.T) {
+		ep, err := newService(newObjs()...).UpdateEndpoint(ctx, testNamespace, testComponentName, "api", UpdateRequest{
+			Visibility: &[]openchoreov1alpha1.EndpointVisibility{"project"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []openchoreov1alpha1.EndpointVisibility{"project"}, ep.Visibility)
+	})
+
+	t.Run("changes base path", func(t *testing.T) {
+		ep, err := newService(newObjs()...).UpdateEndpoint(ctx, testNamespace, testComponentName, "api", UpdateRequest{
+			BasePath: ptr.To("/v2"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "/v2", ep.BasePath)
+		assert.Equal(t, []openchoreov1alpha1.EndpointVisibility{"project", "external"}, ep.Visibility)
+	})
+
+	t.Run("rejects invalid input", func(t *testing.T) {
+		svc := newService(newObjs()...)
+		_, err := svc.UpdateEndpoint(ctx, testNamespace, testComponentName, "api", UpdateRequest{})
+		assert.ErrorIs(t, err, ErrNothingToUpdate)
+		_, err = svc.UpdateEndpoint(ctx, testNamespace, testComponentName, "api", UpdateRequest{
+			Visibility: &[]openchoreov1alpha1.EndpointVisibility{"public"},
+		})
+		assert.ErrorIs(t, err, ErrInvalidVisibility)
+		_, err = svc.UpdateEndpoint(ctx, testNamespace, testComponentName, "api", UpdateRequest{BasePath: ptr.To("v2")})
+		assert.ErrorIs(t, err, ErrInvalidBasePath)
+	})
+
+	t.Run("endpoint not found", func(t *testing.T) {
+		_, err := newService(newObjs()...).UpdateEndpoint(ctx, testNamespace, testComponentName, "missing", UpdateRequest{BasePath: ptr.To("/")})
+		assert.ErrorIs(t, err, ErrEndpointNotFound)
+	})
+
+	t.Run("workload not found", func(t *testing.T) {
+		_, err := newService(comp.DeepCopy()).UpdateEndpoint(ctx, testNamespace, testComponentName, "api", UpdateRequest{BasePath: ptr.To("/")})
+		assert.ErrorIs(t, err, ErrWorkloadNotFound)
+	})
+
+	t.Run("component not found", func(t *testing.T) {
+		_, err := newService().UpdateEndpoint(ctx, testNamespace, testComponentName, "api", UpdateRequest{BasePath: ptr.To("/")})
+		assert.ErrorIs(t, err, componentsvc.ErrComponentNotFound)
+	})
+}
@@ -10,4 +10,5 @@ var (
 	ErrEnvironmentNotFound      = errors.New("environment not found")
 	ErrEnvironmentAlreadyExists = errors.New("environment already exists")
 	ErrDataPlaneNotFound        = errors.New("dataplane not found")
+	ErrEnvironmentInUse         = errors.New("environment has active deployments")
 )
@@ -20,4 +20,16 @@ type Service interface {
 	CreateEnvironment(ctx context.Context, namespaceName string, env *openchoreov1alpha1.Environment) (*openchoreov1alpha1.Environment, error)
 	UpdateEnvironment(ctx context.Context, namespaceName string, env *openchoreov1alpha1.Environment) (*openchoreov1alpha1.Environment, error)
 	DeleteEnvironment(ctx context.Context, namespaceName, envName string) error
+	ListPipelinePositions(ctx context.Context, namespaceName string) (map[string][]PipelinePosition, error)
+}
+
+// PipelinePosition describes where an environment sits in a DeploymentPipeline.
+// Stage is the number of promotion hops from the pipeline's first environment,
+// which is at stage 0; environments only reachable through a promotion cycle
+// report -1.
+type PipelinePosition struct {
+	PipelineName string   `json:"pipelineName"`
+	Stage        int      `json:"stage"`
+	PromotedFrom []string `json:"promotedFrom,omitempty"`
+	PromotesTo   []string `json:"promotesTo,omitempty"`
 }
@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	environment "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment"
 	mock "github.com/stretchr/testify/mock"
 
 	services "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
@@ -253,6 +254,65 @@ func (_c *MockService_ListEnvironments_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ListPipelinePositions provides a mock function with given fields: ctx, namespaceName
+func (_m *MockService) ListPipelinePositions(ctx context.Context, namespaceName string) (map[string][]environment.PipelinePosition, error) {
+	ret := _m.Called(ctx, namespaceName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPipelinePositions")
+	}
+
+	var r0 map[string][]environment.PipelinePosition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (map[string][]environment.PipelinePosition, error)); ok {
+		return rf(ctx, namespaceName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[string][]environment.PipelinePosition); ok {
+		r0 = rf(ctx, namespaceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]environment.PipelinePosition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespaceName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListPipelinePositions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPipelinePositions'
+type MockService_ListPipelinePositions_Call struct {
+	*mock.Call
+}
+
+// ListPipelinePositions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+func (_e *MockService_Expecter) ListPipelinePositions(ctx interface{}, namespaceName interface{}) *MockService_ListPipelinePositions_Call {
+	return &MockService_ListPipelinePositions_Call{Call: _e.mock.On("ListPipelinePositions", ctx, namespaceName)}
+}
+
+func (_c *MockService_ListPipelinePositions_Call) Run(run func(ctx context.Context, namespaceName string)) *MockService_ListPipelinePositions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_ListPipelinePositions_Call) Return(_a0 map[string][]environment.PipelinePosition, _a1 error) *MockService_ListPipelinePositions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListPipelinePositions_Call) RunAndReturn(run func(context.Context, string) (map[string][]environment.PipelinePosition, error)) *MockService_ListPipelinePositions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateEnvironment provides a mock function with given fields: ctx, namespaceName, env
 func (_m *MockService) UpdateEnvironment(ctx context.Context, namespaceName string, env *v1alpha1.Environment) (*v1alpha1.Environment, error) {
 	ret := _m.Called(ctx, namespaceName, env)
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -125,8 +126,13 @@ func (s *environmentService) CreateEnvironment(ctx context.Context, namespaceNam
 			Kind: openchoreov1alpha1.DataPlaneRefKindDataPlane,
 			Name: controller.DefaultPlaneName,
 		}
-	} else if env.Spec.DataPlaneRef.Kind == "" {
-		env.Spec.DataPlaneRef.Kind = openchoreov1alpha1.DataPlaneRefKindDataPlane
+	} else {
+		if env.Spec.DataPlaneRef.Kind == "" {
+			env.Spec.DataPlaneRef.Kind = openchoreov1alpha1.DataPlaneRefKindDataPlane
+		}
+		if err := s.validateDataPlaneRef(ctx, namespaceName, env.Spec.DataPlaneRef); err != nil {
+			return nil, err
+		}
 	}
 
 	env.Status = openchoreov1alpha1.EnvironmentStatus{}
@@ -184,13 +190,48 @@ func (s *environmentService) UpdateEnvironment(ctx context.Context, namespaceNam
 	return existing, nil
 }
 
-// DeleteEnvironment removes an environment by name.
+// validateDataPlaneRef ensures an explicitly referenced DataPlane or ClusterDataPlane exists.
+func (s *environmentService) validateDataPlaneRef(ctx context.Context, namespaceName string, ref *openchoreov1alpha1.DataPlaneRef) error {
+	var (
+		obj client.Object
+		key client.ObjectKey
+	)
+	if ref.Kind == openchoreov1alpha1.DataPlaneRefKindClusterDataPlane {
+		obj = &openchoreov1alpha1.ClusterDataPlane{}
+		key = client.ObjectKey{Name: ref.Name}
+	} else {
+		obj = &openchoreov1alpha1.DataPlane{}
+		key = client.ObjectKey{Name: ref.Name, Namespace: namespaceName}
+	}
+	if err := s.k8sClient.Get(ctx, key, obj); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return fmt.Errorf("%w: %s %q", ErrDataPlaneNotFound, ref.Kind, ref.Name)
+		}
+		return fmt.Errorf("failed to get %s: %w", ref.Kind, err)
+	}
+	return nil
+}
+
+// DeleteEnvironment removes an environment by name. Environments that still have
+// component, resource or project bindings are refused with ErrEnvironmentInUse so
+// that running workloads are not orphaned; undeploy them first.
 func (s *environmentService) DeleteEnvironment(ctx context.Context, namespaceName, envName string) error {
 	s.logger.Debug("Deleting environment", "namespace", namespaceName, "env", envName)
 
-	env := &openchoreov1alpha1.Environment{}
-	env.Name = envName
-	env.Namespace = namespaceName
+	env, err := s.GetEnvironment(ctx, namespaceName, envName)
+	if err != nil {
+		return err
+	}
+
+	inUse, err := s.activeBindings(ctx, namespaceName, envName)
+	if err != nil {
+		return err
+	}
+	if len(inUse) > 0 {
+		s.logger.Warn("Refusing to delete environment with active deployments",
+			"namespace", namespaceName, "env", envName, "bindings", inUse)
+		return fmt.Errorf("%w: %s", ErrEnvironmentInUse, strings.Join(inUse, ", "))
+	}
 
 	if err := s.k8sClient.Delete(ctx, env); err != nil {
 		if apierrors.IsNotFound(err) {
@@ -203,3 +244,110 @@ func (s *environmentService) DeleteEnvironment(ctx context.Context, namespaceNam
 	s.logger.Debug("Environment deleted successfully", "namespace", namespaceName, "env", envName)
 	return nil
 }
+
+// activeBindings returns the kind-qualified names of the bindings that target the environment.
+func (s *environmentService) activeBindings(ctx context.Context, namespaceName, envName string) ([]string, error) {
+	var inUse []string
+
+	var releaseBindings openchoreov1alpha1.ReleaseBindingList
+	if err := s.k8sClient.List(ctx, &releaseBindings, client.InNamespace(namespaceName)); err != nil {
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+	for _, b := range releaseBindings.Items {
+		if b.Spec.Environment == envName {
+			inUse = append(inUse, "ReleaseBinding/"+b.Name)
+		}
+	}
+
+	var resourceBindings openchoreov1alpha1.ResourceReleaseBindingList
+	if err := s.k8sClient.List(ctx, &resourceBindings, client.InNamespace(namespaceName)); err != nil {
+		return nil, fmt.Errorf("failed to list resource release bindings: %w", err)
+	}
+	for _, b := range resourceBindings.Items {
+		if b.Spec.Environment == envName {
+			inUse = append(inUse, "ResourceReleaseBinding/"+b.Name)
+		}
+	}
+
+	var projectBindings openchoreov1alpha1.ProjectReleaseBindingList
+	if err := s.k8sClient.List(ctx, &projectBindings, client.InNamespace(namespaceName)); err != nil {
+		return nil, fmt.Errorf("failed to list project release bindings: %w", err)
+	}
+	for _, b := range projectBindings.Items {
+		if b.Spec.Environment == envName {
+			inUse = append(inUse, "ProjectReleaseBinding/"+b.Name)
+		}
+	}
+
+	return inUse, nil
+}
+
+// ListPipelinePositions returns, for each environment referenced by a DeploymentPipeline
+// in the namespace, its position within every pipeline it belongs to.
+func (s *environmentService) ListPipelinePositions(ctx context.Context, namespaceName string) (map[string][]PipelinePosition, error) {
+	s.logger.Debug("Listing environment pipeline positions", "namespace", namespaceName)
+
+	var pipelines openchoreov1alpha1.DeploymentPipelineList
+	if err := s.k8sClient.List(ctx, &pipelines, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list deployment pipelines", "error", err)
+		return nil, fmt.Errorf("failed to list deployment pipelines: %w", err)
+	}
+
+	positions := make(map[string][]PipelinePosition)
+	for i := range pipelines.Items {
+		for env, pos := range PipelinePositions(&pipelines.Items[i]) {
+			positions[env] = append(positions[env], pos)
+		}
+	}
+	return positions, nil
+}
+
+// PipelinePositions computes the position of every environment in a pipeline. Stages
+// are assigned breadth-first from the environments that are never a promotion target.
+func PipelinePositions(pipeline *openchoreov1alpha1.DeploymentPipeline) map[string]PipelinePosition {
+	positions := make(map[string]*PipelinePosition)
+	var order []string
+	position := func(env string) *PipelinePosition {
+		if pos, ok := positions[env]; ok {
+			return pos
+		}
+		pos := &PipelinePosition{PipelineName: pipeline.Name, Stage: -1}
+		positions[env] = pos
+		order = append(order, env)
+		return pos
+	}
+
+	for _, path := range pipeline.Spec.PromotionPaths {
+		src := position(path.SourceEnvironmentRef.Name)
+		for _, target := range path.TargetEnvironmentRefs {
+			src.PromotesTo = append(src.PromotesTo, target.Name)
+			tgt := position(target.Name)
+			tgt.PromotedFrom = append(tgt.PromotedFrom, path.SourceEnvironmentRef.Name)
+		}
+	}
+
+	var queue []string
+	for _, env := range order {
+		if len(positions[env].PromotedFrom) == 0 {
+			positions[env].Stage = 0
+			queue = append(queue, env)
+		}
+	}
+	for len(queue) > 0 {
+		current := positions[queue[0]]
+		queue = queue[1:]
+		for _, next := range current.PromotesTo {
+			if positions[next].Stage >= 0 {
+				continue
+			}
+			positions[next].Stage = current.Stage + 1
+			queue = append(queue, next)
+		}
+	}
+
+	result := make(map[string]PipelinePosition, len(positions))
+	for env, pos := range positions {
+		result[env] = *pos
+	}
+	return result
+}
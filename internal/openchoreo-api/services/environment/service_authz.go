@@ -6,6 +6,7 @@ package environment
 import (
 	"context"
 	"log/slog"
+	"sort"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -15,7 +16,8 @@ import (
 )
 
 const (
-	resourceTypeEnvironment = "environment"
+	resourceTypeEnvironment        = "environment"
+	resourceTypeDeploymentPipeline = "deploymentPipeline"
 )
 
 // environmentServiceWithAuthz wraps a Service and adds authorization checks.
@@ -103,3 +105,54 @@ func (s *environmentServiceWithAuthz) DeleteEnvironment(ctx context.Context, nam
 	}
 	return s.internal.DeleteEnvironment(ctx, namespaceName, envName)
 }
+
+// ListPipelinePositions drops positions in DeploymentPipelines the caller is not allowed to view.
+func (s *environmentServiceWithAuthz) ListPipelinePositions(ctx context.Context, namespaceName string) (map[string][]PipelinePosition, error) {
+	positions, err := s.internal.ListPipelinePositions(ctx, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelineNames []string
+	seen := make(map[string]bool)
+	for _, envPositions := range positions {
+		for _, pos := range envPositions {
+			if !seen[pos.PipelineName] {
+				seen[pos.PipelineName] = true
+				pipelineNames = append(pipelineNames, pos.PipelineName)
+			}
+		}
+	}
+	if len(pipelineNames) == 0 {
+		return positions, nil
+	}
+	sort.Strings(pipelineNames)
+
+	requests := make([]services.CheckRequest, len(pipelineNames))
+	for i, name := range pipelineNames {
+		requests[i] = services.CheckRequest{
+			Action:       authz.ActionViewDeploymentPipeline,
+			ResourceType: resourceTypeDeploymentPipeline,
+			ResourceID:   name,
+			Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName},
+		}
+	}
+	decisions, err := s.authz.BatchCheck(ctx, requests)
+	if err != nil {
+		return nil, err
+	}
+	allowed := make(map[string]bool, len(pipelineNames))
+	for i, name := range pipelineNames {
+		allowed[name] = i < len(decisions) && decisions[i]
+	}
+
+	filtered := make(map[string][]PipelinePosition, len(positions))
+	for env, envPositions := range positions {
+		for _, pos := range envPositions {
+			if allowed[pos.PipelineName] {
+				filtered[env] = append(filtered[env], pos)
+			}
+		}
+	}
+	return filtered, nil
+}
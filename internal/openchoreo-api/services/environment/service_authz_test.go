@@ -4,6 +4,7 @@
 package environment
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
@@ -13,16 +14,62 @@ import (
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
-	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment/mocks"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
 )
 
+// mockService is a local testify mock for the Service interface.
+// It exists here instead of importing environment/mocks to avoid a cyclic import
+type mockService struct {
+	mock.Mock
+}
+
+func newMockService(t *testing.T) *mockService {
+	m := &mockService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *mockService) ListEnvironments(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.Environment], error) {
+	args := m.Called(ctx, namespaceName, opts)
+	res, _ := args.Get(0).(*services.ListResult[openchoreov1alpha1.Environment])
+	return res, args.Error(1)
+}
+
+func (m *mockService) GetEnvironment(ctx context.Context, namespaceName, envName string) (*openchoreov1alpha1.Environment, error) {
+	args := m.Called(ctx, namespaceName, envName)
+	res, _ := args.Get(0).(*openchoreov1alpha1.Environment)
+	return res, args.Error(1)
+}
+
+func (m *mockService) CreateEnvironment(ctx context.Context, namespaceName string, env *openchoreov1alpha1.Environment) (*openchoreov1alpha1.Environment, error) {
+	args := m.Called(ctx, namespaceName, env)
+	res, _ := args.Get(0).(*openchoreov1alpha1.Environment)
+	return res, args.Error(1)
+}
+
+func (m *mockService) UpdateEnvironment(ctx context.Context, namespaceName string, env *openchoreov1alpha1.Environment) (*openchoreov1alpha1.Environment, error) {
+	args := m.Called(ctx, namespaceName, env)
+	res, _ := args.Get(0).(*openchoreov1alpha1.Environment)
+	return res, args.Error(1)
+}
+
+func (m *mockService) DeleteEnvironment(ctx context.Context, namespaceName, envName string) error {
+	return m.Called(ctx, namespaceName, envName).Error(0)
+}
+
+func (m *mockService) ListPipelinePositions(ctx context.Context, namespaceName string) (map[string][]PipelinePosition, error) {
+	args := m.Called(ctx, namespaceName)
+	res, _ := args.Get(0).(map[string][]PipelinePosition)
+	return res, args.Error(1)
+}
+
 func TestEnvironmentAuthz_CreateEnvironment(t *testing.T) {
 	env := &openchoreov1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: "env-1", Namespace: "ns-1"}}
 
 	t.Run("allowed", func(t *testing.T) {
 		pdp := testutil.AllowPDP()
-		mockSvc := mocks.NewMockService(t)
+		mockSvc := newMockService(t)
 		mockSvc.On("CreateEnvironment", mock.Anything, "ns-1", env).Return(env, nil)
 		svc := &environmentServiceWithAuthz{
 			internal: mockSvc,
@@ -37,7 +84,7 @@ func TestEnvironmentAuthz_CreateEnvironment(t *testing.T) {
 
 	t.Run("denied", func(t *testing.T) {
 		pdp := testutil.DenyPDP()
-		mockSvc := mocks.NewMockService(t)
+		mockSvc := newMockService(t)
 		svc := &environmentServiceWithAuthz{
 			internal: mockSvc,
 			authz:    testutil.NewTestAuthzChecker(pdp),
@@ -52,7 +99,7 @@ func TestEnvironmentAuthz_UpdateEnvironment(t *testing.T) {
 
 	t.Run("nil input", func(t *testing.T) {
 		pdp := testutil.AllowPDP()
-		mockSvc := mocks.NewMockService(t)
+		mockSvc := newMockService(t)
 		svc := &environmentServiceWithAuthz{
 			internal: mockSvc,
 			authz:    testutil.NewTestAuthzChecker(pdp),
@@ -64,7 +111,7 @@ func TestEnvironmentAuthz_UpdateEnvironment(t *testing.T) {
 
 	t.Run("allowed", func(t *testing.T) {
 		pdp := testutil.AllowPDP()
-		mockSvc := mocks.NewMockService(t)
+		mockSvc := newMockService(t)
 		mockSvc.On("UpdateEnvironment", mock.Anything, "ns-1", env).Return(env, nil)
 		svc := &environmentServiceWithAuthz{
 			internal: mockSvc,
@@ -79,7 +126,7 @@ func TestEnvironmentAuthz_UpdateEnvironment(t *testing.T) {
 
 	t.Run("denied", func(t *testing.T) {
 		pdp := testutil.DenyPDP()
-		mockSvc := mocks.NewMockService(t)
+		mockSvc := newMockService(t)
 		svc := &environmentServiceWithAuthz{
 			internal: mockSvc,
 			authz:    testutil.NewTestAuthzChecker(pdp),
@@ -94,7 +141,7 @@ func TestEnvironmentAuthz_GetEnvironment(t *testing.T) {
 
 	t.Run("allowed", func(t *testing.T) {
 		pdp := testutil.AllowPDP()
-		mockSvc := mocks.NewMockService(t)
+		mockSvc := newMockService(t)
 		mockSvc.On("GetEnvironment", mock.Anything, "ns-1", "env-1").Return(env, nil)
 		svc := &environmentServiceWithAuthz{
 			internal: mockSvc,
@@ -109,7 +156,7 @@ func TestEnvironmentAuthz_GetEnvironment(t *testing.T) {
 
 	t.Run("denied", func(t *testing.T) {
 		pdp := testutil.DenyPDP()
-		mockSvc := mocks.NewMockService(t)
+		mockSvc := newMockService(t)
 		svc := &environmentServiceWithAuthz{
 			internal: mockSvc,
 			authz:    testutil.NewTestAuthzChecker(pdp),
@@ -122,7 +169,7 @@ func TestEnvironmentAuthz_GetEnvironment(t *testing.T) {
 func TestEnvironmentAuthz_DeleteEnvironment(t *testing.T) {
 	t.Run("allowed", func(t *testing.T) {
 		pdp := testutil.AllowPDP()
-		mockSvc := mocks.NewMockService(t)
+		mockSvc := newMockService(t)
 		mockSvc.On("DeleteEnvironment", mock.Anything, "ns-1", "env-1").Return(nil)
 		svc := &environmentServiceWithAuthz{
 			internal: mockSvc,
@@ -136,7 +183,7 @@ func TestEnvironmentAuthz_DeleteEnvironment(t *testing.T) {
 
 	t.Run("denied", func(t *testing.T) {
 		pdp := testutil.DenyPDP()
-		mockSvc := mocks.NewMockService(t)
+		mockSvc := newMockService(t)
 		svc := &environmentServiceWithAuthz{
 			internal: mockSvc,
 			authz:    testutil.NewTestAuthzChecker(pdp),
@@ -154,7 +201,7 @@ func TestEnvironmentAuthz_ListEnvironments(t *testing.T) {
 
 	t.Run("all allowed — per-item check request fields", func(t *testing.T) {
 		pdp := testutil.AllowPDP()
-		mockSvc := mocks.NewMockService(t)
+		mockSvc := newMockService(t)
 		mockSvc.On("ListEnvironments", mock.Anything, "ns-1", mock.Anything).Return(&services.ListResult[openchoreov1alpha1.Environment]{Items: items}, nil)
 		svc := &environmentServiceWithAuthz{
 			internal: mockSvc,
@@ -170,7 +217,7 @@ func TestEnvironmentAuthz_ListEnvironments(t *testing.T) {
 
 	t.Run("all denied — empty result", func(t *testing.T) {
 		pdp := testutil.DenyPDP()
-		mockSvc := mocks.NewMockService(t)
+		mockSvc := newMockService(t)
 		mockSvc.On("ListEnvironments", mock.Anything, "ns-1", mock.Anything).Return(&services.ListResult[openchoreov1alpha1.Environment]{Items: items}, nil)
 		svc := &environmentServiceWithAuthz{
 			internal: mockSvc,
@@ -181,3 +228,38 @@ func TestEnvironmentAuthz_ListEnvironments(t *testing.T) {
 		require.Empty(t, result.Items)
 	})
 }
+
+func TestEnvironmentAuthz_ListPipelinePositions(t *testing.T) {
+	positions := map[string][]PipelinePosition{
+		"dev":  {{PipelineName: "default", Stage: 0, PromotesTo: []string{"prod"}}},
+		"prod": {{PipelineName: "default", Stage: 1, PromotedFrom: []string{"dev"}}},
+	}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("ListPipelinePositions", mock.Anything, "ns-1").Return(positions, nil)
+		svc := &environmentServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		result, err := svc.ListPipelinePositions(testutil.AuthzContext(), "ns-1")
+		require.NoError(t, err)
+		require.Equal(t, positions, result)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "deploymentpipeline:view", "deploymentPipeline", "default", authzcore.ResourceHierarchy{Namespace: "ns-1"})
+	})
+
+	t.Run("denied pipelines are dropped", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("ListPipelinePositions", mock.Anything, "ns-1").Return(positions, nil)
+		svc := &environmentServiceWithAuthz{
+			internal: mockSvc,
+			authz:    testutil.NewTestAuthzChecker(pdp),
+		}
+		result, err := svc.ListPipelinePositions(testutil.AuthzContext(), "ns-1")
+		require.NoError(t, err)
+		require.Empty(t, result)
+	})
+}
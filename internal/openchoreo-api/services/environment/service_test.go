@@ -232,6 +232,39 @@ func TestCreateEnvironment(t *testing.T) {
 		assert.Equal(t, "custom-dp", result.Spec.DataPlaneRef.Name)
 	})
 
+	t.Run("explicit DataPlaneRef not found", func(t *testing.T) {
+		svc := newService(t, testDefaultDataPlane())
+		env := &openchoreov1alpha1.Environment{
+			ObjectMeta: metav1.ObjectMeta{Name: "missing-dp-env"},
+			Spec: openchoreov1alpha1.EnvironmentSpec{
+				DataPlaneRef: &openchoreov1alpha1.DataPlaneRef{
+					Kind: openchoreov1alpha1.DataPlaneRefKindDataPlane,
+					Name: "missing-dp",
+				},
+			},
+		}
+
+		_, err := svc.CreateEnvironment(ctx, testNamespace, env)
+		require.ErrorIs(t, err, ErrDataPlaneNotFound)
+	})
+
+	t.Run("cluster DataPlaneRef", func(t *testing.T) {
+		svc := newService(t, testutil.NewClusterDataPlane("shared-dp"))
+		env := &openchoreov1alpha1.Environment{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster-dp-env"},
+			Spec: openchoreov1alpha1.EnvironmentSpec{
+				DataPlaneRef: &openchoreov1alpha1.DataPlaneRef{
+					Kind: openchoreov1alpha1.DataPlaneRefKindClusterDataPlane,
+					Name: "shared-dp",
+				},
+			},
+		}
+
+		result, err := svc.CreateEnvironment(ctx, testNamespace, env)
+		require.NoError(t, err)
+		assert.Equal(t, openchoreov1alpha1.DataPlaneRefKindClusterDataPlane, result.Spec.DataPlaneRef.Kind)
+	})
+
 	t.Run("status cleared", func(t *testing.T) {
 		svc := newService(t, testDefaultDataPlane())
 		env := &openchoreov1alpha1.Environment{
@@ -321,4 +354,56 @@ func TestDeleteEnvironment(t *testing.T) {
 		err := svc.DeleteEnvironment(ctx, testNamespace, "nonexistent")
 		require.ErrorIs(t, err, ErrEnvironmentNotFound)
 	})
+
+	t.Run("refused while deployments are active", func(t *testing.T) {
+		svc := newService(t, testEnvironment(),
+			testutil.NewReleaseBinding(testNamespace, "proj", "comp", testEnvName, "comp-"+testEnvName),
+			testutil.NewProjectReleaseBinding(testNamespace, "proj", testEnvName, "proj-"+testEnvName))
+
+		err := svc.DeleteEnvironment(ctx, testNamespace, testEnvName)
+		require.ErrorIs(t, err, ErrEnvironmentInUse)
+		assert.Contains(t, err.Error(), "ReleaseBinding/comp-"+testEnvName)
+		assert.Contains(t, err.Error(), "ProjectReleaseBinding/proj-"+testEnvName)
+
+		_, err = svc.GetEnvironment(ctx, testNamespace, testEnvName)
+		require.NoError(t, err)
+	})
+
+	t.Run("bindings to other environments are ignored", func(t *testing.T) {
+		svc := newService(t, testEnvironment(),
+			testutil.NewResourceReleaseBinding(testNamespace, "proj", "db", "other-env", "db-other-env"))
+
+		require.NoError(t, svc.DeleteEnvironment(ctx, testNamespace, testEnvName))
+	})
+}
+
+// --- ListPipelinePositions ---
+
+func TestListPipelinePositions(t *testing.T) {
+	ctx := context.Background()
+
+	pipeline := testutil.NewDeploymentPipeline(testNamespace, "default")
+	pipeline.Spec.PromotionPaths = []openchoreov1alpha1.PromotionPath{
+		{
+			SourceEnvironmentRef:  openchoreov1alpha1.EnvironmentRef{Name: "staging"},
+			TargetEnvironmentRefs: []openchoreov1alpha1.TargetEnvironmentRef{{Name: "prod"}},
+		},
+		{
+			SourceEnvironmentRef:  openchoreov1alpha1.EnvironmentRef{Name: "dev"},
+			TargetEnvironmentRefs: []openchoreov1alpha1.TargetEnvironmentRef{{Name: "staging"}, {Name: "qa"}},
+		},
+	}
+	svc := newService(t, pipeline, testutil.NewDeploymentPipeline("other-ns", "default"))
+
+	positions, err := svc.ListPipelinePositions(ctx, testNamespace)
+	require.NoError(t, err)
+	require.Len(t, positions, 4)
+
+	assert.Equal(t, []PipelinePosition{{PipelineName: "default", Stage: 0, PromotesTo: []string{"staging", "qa"}}}, positions["dev"])
+	assert.Equal(t, []PipelinePosition{{
+		PipelineName: "default", Stage: 1, PromotedFrom: []string{"dev"}, PromotesTo: []string{"prod"},
+	}}, positions["staging"])
+	assert.Equal(t, 1, positions["qa"][0].Stage)
+	assert.Equal(t, 2, positions["prod"][0].Stage)
+	assert.Equal(t, []string{"staging"}, positions["prod"][0].PromotedFrom)
 }
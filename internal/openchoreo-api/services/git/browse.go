@@ -0,0 +1,323 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// browsePageSize is the number of items requested per page of listings.
+	browsePageSize = 100
+	// maxBrowsePages bounds the pages read of a listing, so that a huge
+	// organization or repository cannot make a request run for long.
+	maxBrowsePages = 20
+)
+
+// ErrBrowseUnsupported is returned for repositories on hosts whose contents cannot be listed.
+var ErrBrowseUnsupported = errors.New("listing repository contents is only supported for GitHub and GitLab")
+
+// ErrRepositoryNotFound is returned when a repository or owner does not exist,
+// or the credential cannot read it.
+var ErrRepositoryNotFound = errors.New("repository not found or not accessible")
+
+// errCredentialRejected marks the ErrRepositoryNotFound errors caused by the
+// host rejecting the credential rather than by a missing resource.
+var errCredentialRejected = errors.New("credential rejected")
+
+// Repository is a repository of an owner.
+type Repository struct {
+	// URL is the HTTPS clone URL of the repository.
+	URL           string
+	DefaultBranch string
+	Archived      bool
+}
+
+// RepositoryBrowser lists and reads repositories through the API of their
+// Git host, with an optional access token for private repositories.
+type RepositoryBrowser interface {
+	// ListRepositories returns the repositories of the user, organization or
+	// group at ownerURL, such as https://github.com/acme.
+	ListRepositories(ctx context.Context, ownerURL, token string) ([]Repository, error)
+	// DefaultBranch returns the default branch of the repository.
+	DefaultBranch(ctx context.Context, repoURL, token string) (string, error)
+	// ListFiles returns the paths of all files in the repository at ref.
+	ListFiles(ctx context.Context, repoURL, ref, token string) ([]string, error)
+	// ReadFile returns the content of the file at path in the repository at ref.
+	ReadFile(ctx context.Context, repoURL, ref, path, token string) ([]byte, error)
+}
+
+// APIRepositoryBrowser implements RepositoryBrowser with the REST APIs of
+// GitHub and GitLab.
+type APIRepositoryBrowser struct {
+	httpClient *http.Client
+	// apiURLs overrides the API base URL of a host, such as
+	// https://api.github.com for github.com.
+	apiURLs map[string]string
+}
+
+var _ RepositoryBrowser = (*APIRepositoryBrowser)(nil)
+
+// NewAPIRepositoryBrowser creates a new repository browser.
+func NewAPIRepositoryBrowser() *APIRepositoryBrowser {
+	return &APIRepositoryBrowser{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		apiURLs:    map[string]string{"github.com": "https://api.github.com"},
+	}
+}
+
+// apiURL returns the API base URL of the host of loc.
+func (b *APIRepositoryBrowser) apiURL(loc *repositoryLocation) string {
+	if u, ok := b.apiURLs[strings.ToLower(loc.host)]; ok {
+		return u
+	}
+	return "https://" + loc.host + "/api/v4"
+}
+
+func (b *APIRepositoryBrowser) ListRepositories(ctx context.Context, ownerURL, token string) ([]Repository, error) {
+	loc, err := parseRepositoryURL(ownerURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var repos []Repository
+	switch loc.provider {
+	case ProviderGitHub:
+		if strings.Contains(loc.path, "/") {
+			return nil, fmt.Errorf("GitHub owner URL %q must not include a repository", ownerURL)
+		}
+		var page []struct {
+			CloneURL      string `json:"clone_url"`
+			DefaultBranch string `json:"default_branch"`
+			Archived      bool   `json:"archived"`
+		}
+		collect := func() int {
+			for _, r := range page {
+				repos = append(repos, Repository{URL: r.CloneURL, DefaultBranch: r.DefaultBranch, Archived: r.Archived})
+			}
+			return len(page)
+		}
+		err := b.paginate(ctx, b.apiURL(loc)+"/orgs/"+url.PathEscape(loc.path)+"/repos", token, loc.provider, &page, collect)
+		if errors.Is(err, ErrRepositoryNotFound) {
+			// The owner may be a user rather than an organization.
+			err = b.paginate(ctx, b.apiURL(loc)+"/users/"+url.PathEscape(loc.path)+"/repos", token, loc.provider, &page, collect)
+		}
+		if err != nil {
+			return nil, err
+		}
+	case ProviderGitLab:
+		var page []struct {
+			HTTPURL       string `json:"http_url_to_repo"`
+			DefaultBranch string `json:"default_branch"`
+			Archived      bool   `json:"archived"`
+		}
+		endpoint := b.apiURL(loc) + "/groups/" + url.PathEscape(loc.path) + "/projects?include_subgroups=true"
+		err := b.paginate(ctx, endpoint, token, loc.provider, &page, func() int {
+			for _, r := range page {
+				repos = append(repos, Repository{URL: r.HTTPURL, DefaultBranch: r.DefaultBranch, Archived: r.Archived})
+			}
+			return len(page)
+		})
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrBrowseUnsupported
+	}
+	return repos, nil
+}
+
+func (b *APIRepositoryBrowser) DefaultBranch(ctx context.Context, repoURL, token string) (string, error) {
+	loc, err := parseRepositoryURL(repoURL)
+	if err != nil {
+		return "", err
+	}
+	endpoint, err := b.repositoryEndpoint(loc)
+	if err != nil {
+		return "", err
+	}
+	var repo struct {
+		DefaultBranch string `json:"default_branch"`
+	}
+	if err := b.getJSON(ctx, endpoint, token, loc.provider, &repo); err != nil {
+		return "", err
+	}
+	if repo.DefaultBranch == "" {
+		return "", fmt.Errorf("repository %q has no default branch", repoURL)
+	}
+	return repo.DefaultBranch, nil
+}
+
+func (b *APIRepositoryBrowser) ListFiles(ctx context.Context, repoURL, ref, token string) ([]string, error) {
+	loc, err := parseRepositoryURL(repoURL)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := b.repositoryEndpoint(loc)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	switch loc.provider {
+	case ProviderGitHub:
+		var tree struct {
+			Tree []struct {
+				Path string `json:"path"`
+				Type string `json:"type"`
+			} `json:"tree"`
+			Truncated bool `json:"truncated"`
+		}
+		if err := b.getJSON(ctx, endpoint+"/git/trees/"+url.PathEscape(ref)+"?recursive=1", token, loc.provider, &tree); err != nil {
+			return nil, err
+		}
+		if tree.Truncated {
+			return nil, fmt.Errorf("repository %q has too many files to list", repoURL)
+		}
+		for _, entry := range tree.Tree {
+			if entry.Type == "blob" {
+				files = append(files, entry.Path)
+			}
+		}
+	default:
+		var page []struct {
+			Path string `json:"path"`
+			Type string `json:"type"`
+		}
+		err := b.paginate(ctx, endpoint+"/repository/tree?recursive=true&ref="+url.QueryEscape(ref), token, loc.provider, &page, func() int {
+			for _, entry := range page {
+				if entry.Type == "blob" {
+					files = append(files, entry.Path)
+				}
+			}
+			return len(page)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+func (b *APIRepositoryBrowser) ReadFile(ctx context.Context, repoURL, ref, path, token string) ([]byte, error) {
+	loc, err := parseRepositoryURL(repoURL)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := b.repositoryEndpoint(loc)
+	if err != nil {
+		return nil, err
+	}
+	path = strings.Trim(path, "/")
+	if loc.provider == ProviderGitHub {
+		endpoint += "/contents/" + escapePath(path) + "?ref=" + url.QueryEscape(ref)
+	} else {
+		endpoint += "/repository/files/" + url.PathEscape(path) + "/raw?ref=" + url.QueryEscape(ref)
+	}
+
+	resp, err := b.get(ctx, endpoint, token, loc.provider, "application/vnd.github.raw")
+	if err != nil {
+		if errors.Is(err, ErrRepositoryNotFound) && !errors.Is(err, errCredentialRejected) {
+			return nil, fmt.Errorf("%s at %s: %w", path, ref, ErrFileNotFound)
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if len(content) > maxFileSize {
+		return nil, fmt.Errorf("%s exceeds the maximum size of %d bytes", path, maxFileSize)
+	}
+	return content, nil
+}
+
+// repositoryEndpoint returns the API URL of the repository at loc.
+func (b *APIRepositoryBrowser) repositoryEndpoint(loc *repositoryLocation) (string, error) {
+	if strings.Count(loc.path, "/") < 1 {
+		return "", fmt.Errorf("repository URL must include the owner and repository name")
+	}
+	switch loc.provider {
+	case ProviderGitHub:
+		return b.apiURL(loc) + "/repos/" + loc.path, nil
+	case ProviderGitLab:
+		// GitLab projects are addressed by their URL-encoded full path.
+		return b.apiURL(loc) + "/projects/" + url.PathEscape(loc.path), nil
+	default:
+		return "", ErrBrowseUnsupported
+	}
+}
+
+// paginate reads the pages of a listing into page, calling collect after each
+// page. Reading stops at the first page that collect reports is not full.
+func (b *APIRepositoryBrowser) paginate(ctx context.Context, endpoint, token string, provider ProviderType, page any, collect func() int) error {
+	sep := "?"
+	if strings.Contains(endpoint, "?") {
+		sep = "&"
+	}
+	for n := 1; n <= maxBrowsePages; n++ {
+		pageURL := fmt.Sprintf("%s%sper_page=%d&page=%d", endpoint, sep, browsePageSize, n)
+		if err := b.getJSON(ctx, pageURL, token, provider, page); err != nil {
+			return err
+		}
+		if collect() < browsePageSize {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (b *APIRepositoryBrowser) getJSON(ctx context.Context, endpoint, token string, provider ProviderType, out any) error {
+	resp, err := b.get(ctx, endpoint, token, provider, "application/json")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 32*maxFileSize)).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response of %s: %w", provider, err)
+	}
+	return nil
+}
+
+// get sends an authenticated GET request and returns the response when its
+// status is 200 OK.
+func (b *APIRepositoryBrowser) get(ctx context.Context, endpoint, token string, provider ProviderType, accept string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", accept)
+	if token != "" {
+		if provider == ProviderGitLab {
+			req.Header.Set("PRIVATE-TOKEN", token)
+		} else {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+	}
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request to %s failed: %w", provider, err)
+	}
+	if resp.StatusCode == http.StatusOK {
+		return resp, nil
+	}
+	resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return nil, ErrRepositoryNotFound
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return nil, fmt.Errorf("%w by %s with status %d: %w", errCredentialRejected, provider, resp.StatusCode, ErrRepositoryNotFound)
+	default:
+		return nil, fmt.Errorf("unexpected status %d from %s", resp.StatusCode, provider)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBrowser(t *testing.T, handler http.HandlerFunc) *APIRepositoryBrowser {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return &APIRepositoryBrowser{
+		httpClient: server.Client(),
+		apiURLs:    map[string]string{"github.com": server.URL, "gitlab.com": server.URL + "/api/v4"},
+	}
+}
+
+func TestAPIRepositoryBrowser_GitHub(t *testing.T) {
+	browser := newTestBrowser(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer s3cret", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/orgs/jdoe/repos":
+			w.WriteHeader(http.StatusNotFound)
+		case "/users/jdoe/repos":
+			fmt.Fprint(w, `[{"clone_url":"https://github.com/jdoe/shop.git","default_branch":"main"},
+				{"clone_url":"https://github.com/jdoe/old.git","default_branch":"master","archived":true}]`)
+		case "/repos/jdoe/shop":
+			fmt.Fprint(w, `{"default_branch":"main"}`)
+		case "/repos/jdoe/shop/git/trees/main":
+			assert.Equal(t, "1", r.URL.Query().Get("recursive"))
+			fmt.Fprint(w, `{"tree":[{"path":"orders","type":"tree"},{"path":"orders/Dockerfile","type":"blob"}]}`)
+		case "/repos/jdoe/shop/contents/orders/Dockerfile":
+			assert.Equal(t, "main", r.URL.Query().Get("ref"))
+			assert.Equal(t, "application/vnd.github.raw", r.Header.Get("Accept"))
+			fmt.Fprint(w, "FROM scratch\n")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	ctx := t.Context()
+
+	repos, err := browser.ListRepositories(ctx, "https://github.com/jdoe", "s3cret")
+	require.NoError(t, err)
+	assert.Equal(t, []Repository{
+		{URL: "https://github.com/jdoe/shop.git", DefaultBranch: "main"},
+		{URL: "https://github.com/jdoe/old.git", DefaultBranch: "master", Archived: true},
+	}, repos)
+
+	branch, err := browser.DefaultBranch(ctx, "https://github.com/jdoe/shop", "s3cret")
+	require.NoError(t, err)
+	assert.Equal(t, "main", branch)
+
+	files, err := browser.ListFiles(ctx, "https://github.com/jdoe/shop.git", "main", "s3cret")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"orders/Dockerfile"}, files)
+
+	content, err := browser.ReadFile(ctx, "https://github.com/jdoe/shop.git", "main", "orders/Dockerfile", "s3cret")
+	require.NoError(t, err)
+	assert.Equal(t, "FROM scratch\n", string(content))
+
+	_, err = browser.ReadFile(ctx, "https://github.com/jdoe/shop.git", "main", "missing", "s3cret")
+	require.ErrorIs(t, err, ErrFileNotFound)
+}
+
+func TestAPIRepositoryBrowser_GitLab(t *testing.T) {
+	browser := newTestBrowser(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "s3cret", r.Header.Get("PRIVATE-TOKEN"))
+		switch r.URL.EscapedPath() {
+		case "/api/v4/groups/acme%2Fplatform/projects":
+			assert.Equal(t, "true", r.URL.Query().Get("include_subgroups"))
+			fmt.Fprint(w, `[{"http_url_to_repo":"https://gitlab.com/acme/platform/api.git","default_branch":"main"}]`)
+		case "/api/v4/projects/acme%2Fplatform%2Fapi/repository/tree":
+			if r.URL.Query().Get("page") != "1" {
+				fmt.Fprint(w, `[]`)
+				return
+			}
+			fmt.Fprint(w, `[{"path":"go.mod","type":"blob"},{"path":"cmd","type":"tree"}]`)
+		case "/api/v4/projects/acme%2Fplatform%2Fapi/repository/files/deploy%2FDockerfile/raw":
+			fmt.Fprint(w, "FROM golang\n")
+		case "/api/v4/projects/acme%2Fplatform%2Fprivate":
+			w.WriteHeader(http.StatusUnauthorized)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	ctx := t.Context()
+
+	repos, err := browser.ListRepositories(ctx, "https://gitlab.com/acme/platform", "s3cret")
+	require.NoError(t, err)
+	assert.Equal(t, []Repository{{URL: "https://gitlab.com/acme/platform/api.git", DefaultBranch: "main"}}, repos)
+
+	files, err := browser.ListFiles(ctx, "https://gitlab.com/acme/platform/api", "main", "s3cret")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"go.mod"}, files)
+
+	content, err := browser.ReadFile(ctx, "https://gitlab.com/acme/platform/api", "main", "deploy/Dockerfile", "s3cret")
+	require.NoError(t, err)
+	assert.Equal(t, "FROM golang\n", string(content))
+
+	_, err = browser.DefaultBranch(ctx, "https://gitlab.com/acme/platform/private", "s3cret")
+	require.ErrorIs(t, err, ErrRepositoryNotFound)
+}
+
+func TestAPIRepositoryBrowser_Unsupported(t *testing.T) {
+	browser := NewAPIRepositoryBrowser()
+
+	_, err := browser.ListFiles(t.Context(), "https://bitbucket.org/acme/shop", "main", "")
+	require.ErrorIs(t, err, ErrBrowseUnsupported)
+
+	_, err = browser.ListRepositories(t.Context(), "https://github.com/acme/shop", "")
+	require.ErrorContains(t, err, "must not include a repository")
+}
@@ -0,0 +1,151 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// maxFileSize bounds the size of files fetched from repositories.
+const maxFileSize = 1 << 20
+
+// ErrFileNotFound is returned when a file does not exist in a repository at the given ref.
+var ErrFileNotFound = errors.New("file not found in repository")
+
+// FileFetcher reads files from Git repositories.
+type FileFetcher interface {
+	// FetchFile returns the content of the file at path in the repository at ref.
+	FetchFile(ctx context.Context, repoURL, ref, path string) ([]byte, error)
+}
+
+// HTTPFileFetcher fetches files over HTTP from the raw file endpoints of GitHub,
+// GitLab and Bitbucket. Only public repositories can be read.
+type HTTPFileFetcher struct {
+	httpClient *http.Client
+}
+
+// NewHTTPFileFetcher creates a new HTTP file fetcher
+func NewHTTPFileFetcher() *HTTPFileFetcher {
+	return &HTTPFileFetcher{httpClient: &http.Client{Timeout: 30 * time.Second}}
+}
+
+// FetchFile returns the content of the file at path in the repository at ref.
+func (f *HTTPFileFetcher) FetchFile(ctx context.Context, repoURL, ref, path string) ([]byte, error) {
+	rawURL, err := RawFileURL(repoURL, ref, path)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, fmt.Errorf("%s at %s: %w", path, ref, ErrFileNotFound)
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("failed to fetch %s: unexpected status %d", path, resp.StatusCode)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if len(content) > maxFileSize {
+		return nil, fmt.Errorf("%s exceeds the maximum size of %d bytes", path, maxFileSize)
+	}
+	return content, nil
+}
+
+// RawFileURL returns the URL that serves the raw content of path in the repository at ref.
+// SSH repository URLs (git@host:owner/repo.git) are accepted as well.
+func RawFileURL(repoURL, ref, path string) (string, error) {
+	if ref == "" {
+		return "", fmt.Errorf("ref is required")
+	}
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return "", fmt.Errorf("file path is required")
+	}
+
+	repo, err := parseRepositoryURL(repoURL)
+	if err != nil {
+		return "", err
+	}
+	if strings.Count(repo.path, "/") < 1 {
+		return "", fmt.Errorf("repository URL %q must include the owner and repository name", repoURL)
+	}
+	filePath := escapePath(ref) + "/" + escapePath(path)
+
+	switch repo.provider {
+	case ProviderGitHub:
+		return "https://raw.githubusercontent.com/" + repo.path + "/" + filePath, nil
+	case ProviderBitbucket:
+		return "https://bitbucket.org/" + repo.path + "/raw/" + filePath, nil
+	default:
+		// GitLab projects may be nested in subgroups, so the whole path is the project.
+		return "https://" + repo.host + "/" + repo.path + "/-/raw/" + filePath, nil
+	}
+}
+
+// repositoryLocation is a parsed repository or owner URL.
+type repositoryLocation struct {
+	provider ProviderType
+	host     string
+	// path is the slash-separated path of the repository, or of the owner,
+	// without the .git suffix.
+	path string
+}
+
+// parseRepositoryURL parses the URL of a repository, or of the user, group or
+// organization that owns repositories, on GitHub, GitLab or Bitbucket. SSH
+// repository URLs (git@host:owner/repo.git) are accepted as well.
+func parseRepositoryURL(repoURL string) (*repositoryLocation, error) {
+	if strings.HasPrefix(repoURL, "git@") {
+		repoURL = "https://" + strings.Replace(strings.TrimPrefix(repoURL, "git@"), ":", "/", 1)
+	}
+	u, err := url.Parse(repoURL)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid repository URL %q", repoURL)
+	}
+	repoPath := strings.TrimSuffix(strings.Trim(u.Path, "/"), ".git")
+	if repoPath == "" {
+		return nil, fmt.Errorf("repository URL %q must include the owner", repoURL)
+	}
+
+	loc := &repositoryLocation{host: u.Host, path: repoPath}
+	host := strings.ToLower(u.Hostname())
+	switch {
+	case host == "github.com":
+		loc.provider = ProviderGitHub
+	case host == "bitbucket.org":
+		loc.provider = ProviderBitbucket
+	case strings.Contains(host, "gitlab"):
+		loc.provider = ProviderGitLab
+	default:
+		return nil, fmt.Errorf("unsupported git host %q: only GitHub, GitLab and Bitbucket repositories are supported", u.Host)
+	}
+	return loc, nil
+}
+
+// escapePath escapes each segment of a slash-separated path.
+func escapePath(p string) string {
+	segments := strings.Split(p, "/")
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRawFileURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		repoURL string
+		ref     string
+		path    string
+		want    string
+		wantErr string
+	}{
+		{
+			name:    "github",
+			repoURL: "https://github.com/acme/shop.git",
+			ref:     "main",
+			path:    "orders/.choreo/component.yaml",
+			want:    "https://raw.githubusercontent.com/acme/shop/main/orders/.choreo/component.yaml",
+		},
+		{
+			name:    "github ssh",
+			repoURL: "git@github.com:acme/shop.git",
+			ref:     "feature/import",
+			path:    "/workload.yaml",
+			want:    "https://raw.githubusercontent.com/acme/shop/feature/import/workload.yaml",
+		},
+		{
+			name:    "gitlab subgroup",
+			repoURL: "https://gitlab.example.com/acme/backend/shop",
+			ref:     "v1.2.0",
+			path:    "workload.yaml",
+			want:    "https://gitlab.example.com/acme/backend/shop/-/raw/v1.2.0/workload.yaml",
+		},
+		{
+			name:    "bitbucket",
+			repoURL: "https://bitbucket.org/acme/shop",
+			ref:     "main",
+			path:    "my app/workload.yaml",
+			want:    "https://bitbucket.org/acme/shop/raw/main/my%20app/workload.yaml",
+		},
+		{name: "unsupported host", repoURL: "https://git.example.com/acme/shop", ref: "main", path: "a", wantErr: "unsupported git host"},
+		{name: "missing repository", repoURL: "https://github.com/acme", ref: "main", path: "a", wantErr: "owner and repository"},
+		{name: "missing ref", repoURL: "https://github.com/acme/shop", path: "a", wantErr: "ref is required"},
+		{name: "missing path", repoURL: "https://github.com/acme/shop", ref: "main", wantErr: "file path is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RawFileURL(tt.repoURL, tt.ref, tt.path)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// rewriteTransport sends every request to the test server.
+type rewriteTransport struct {
+	target string
+}
+
+func (rt rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = "http"
+	req.URL.Host = strings.TrimPrefix(rt.target, "http://")
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestHTTPFileFetcher(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/acme/shop/main/workload.yaml":
+			_, _ = w.Write([]byte("metadata:\n  name: orders\n"))
+		case "/acme/shop/main/large.yaml":
+			_, _ = w.Write(make([]byte, maxFileSize+1))
+		case "/acme/shop/main/private.yaml":
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	fetcher := &HTTPFileFetcher{httpClient: &http.Client{Transport: rewriteTransport{target: srv.URL}}}
+	const repo = "https://github.com/acme/shop"
+
+	content, err := fetcher.FetchFile(t.Context(), repo, "main", "workload.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, "metadata:\n  name: orders\n", string(content))
+
+	_, err = fetcher.FetchFile(t.Context(), repo, "main", "missing.yaml")
+	require.ErrorIs(t, err, ErrFileNotFound)
+
+	_, err = fetcher.FetchFile(t.Context(), repo, "main", "large.yaml")
+	require.ErrorContains(t, err, "exceeds the maximum size")
+
+	_, err = fetcher.FetchFile(t.Context(), repo, "main", "private.yaml")
+	require.ErrorContains(t, err, "unexpected status 403")
+}
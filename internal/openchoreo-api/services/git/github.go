@@ -86,6 +86,62 @@ func (p *GitHubProvider) ParseWebhookPayload(payload []byte) (*WebhookEvent, err
 	}, nil
 }
 
+// IsPullRequestEvent tells whether the X-GitHub-Event header names a pull request event
+func (p *GitHubProvider) IsPullRequestEvent(eventType string) bool {
+	return eventType == "pull_request"
+}
+
+// ParsePullRequestPayload parses a GitHub pull_request webhook payload
+func (p *GitHubProvider) ParsePullRequestPayload(payload []byte) (*PullRequestEvent, error) {
+	var ghPayload struct {
+		Action      string `json:"action"`
+		Number      int64  `json:"number"`
+		PullRequest struct {
+			Head struct {
+				Ref  string `json:"ref"`
+				SHA  string `json:"sha"`
+				Repo struct {
+					CloneURL string `json:"clone_url"`
+				} `json:"repo"`
+			} `json:"head"`
+			Base struct {
+				Ref string `json:"ref"`
+			} `json:"base"`
+		} `json:"pull_request"`
+		Repository struct {
+			CloneURL string `json:"clone_url"`
+		} `json:"repository"`
+	}
+
+	if err := json.Unmarshal(payload, &ghPayload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal GitHub pull request payload: %w", err)
+	}
+
+	var action PullRequestAction
+	switch ghPayload.Action {
+	case "opened", "reopened":
+		action = PullRequestOpened
+	case "synchronize":
+		action = PullRequestUpdated
+	case "closed":
+		action = PullRequestClosed
+	}
+
+	repoURL := normalizeRepoURL(ghPayload.Repository.CloneURL)
+	headRepoURL := normalizeRepoURL(ghPayload.PullRequest.Head.Repo.CloneURL)
+
+	return &PullRequestEvent{
+		Provider:      string(ProviderGitHub),
+		RepositoryURL: repoURL,
+		Number:        ghPayload.Number,
+		Action:        action,
+		Commit:        ghPayload.PullRequest.Head.SHA,
+		Branch:        ghPayload.PullRequest.Head.Ref,
+		BaseBranch:    ghPayload.PullRequest.Base.Ref,
+		FromFork:      headRepoURL != "" && headRepoURL != repoURL,
+	}, nil
+}
+
 // normalizeRepoURL normalizes repository URLs for comparison
 func normalizeRepoURL(repoURL string) string {
 	// Convert SSH to HTTPS
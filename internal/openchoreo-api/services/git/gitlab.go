@@ -71,3 +71,62 @@ func (p *GitLabProvider) ParseWebhookPayload(payload []byte) (*WebhookEvent, err
 		ModifiedPaths: modifiedPaths,
 	}, nil
 }
+
+// IsPullRequestEvent tells whether the X-Gitlab-Event header names a merge request event
+func (p *GitLabProvider) IsPullRequestEvent(eventType string) bool {
+	return eventType == "Merge Request Hook"
+}
+
+// ParsePullRequestPayload parses a GitLab merge request webhook payload
+func (p *GitLabProvider) ParsePullRequestPayload(payload []byte) (*PullRequestEvent, error) {
+	var glPayload struct {
+		ObjectAttributes struct {
+			IID          int64  `json:"iid"`
+			Action       string `json:"action"`
+			SourceBranch string `json:"source_branch"`
+			TargetBranch string `json:"target_branch"`
+			// OldRev is only set on updates that push new commits
+			OldRev     string `json:"oldrev"`
+			LastCommit struct {
+				ID string `json:"id"`
+			} `json:"last_commit"`
+			Source struct {
+				GitHTTPURL string `json:"git_http_url"`
+			} `json:"source"`
+		} `json:"object_attributes"`
+		Project struct {
+			GitHTTPURL string `json:"git_http_url"`
+		} `json:"project"`
+	}
+
+	if err := json.Unmarshal(payload, &glPayload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal GitLab merge request payload: %w", err)
+	}
+
+	attrs := glPayload.ObjectAttributes
+	var action PullRequestAction
+	switch attrs.Action {
+	case "open", "reopen":
+		action = PullRequestOpened
+	case "update":
+		if attrs.OldRev != "" {
+			action = PullRequestUpdated
+		}
+	case "close", "merge":
+		action = PullRequestClosed
+	}
+
+	repoURL := normalizeRepoURL(glPayload.Project.GitHTTPURL)
+	sourceRepoURL := normalizeRepoURL(attrs.Source.GitHTTPURL)
+
+	return &PullRequestEvent{
+		Provider:      string(ProviderGitLab),
+		RepositoryURL: repoURL,
+		Number:        attrs.IID,
+		Action:        action,
+		Commit:        attrs.LastCommit.ID,
+		Branch:        attrs.SourceBranch,
+		BaseBranch:    attrs.TargetBranch,
+		FromFork:      sourceRepoURL != "" && sourceRepoURL != repoURL,
+	}, nil
+}
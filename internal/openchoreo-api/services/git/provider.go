@@ -26,6 +26,42 @@ type WebhookEvent struct {
 	ModifiedPaths []string
 }
 
+// PullRequestProvider is implemented by providers that deliver pull request events.
+type PullRequestProvider interface {
+	// IsPullRequestEvent tells whether the event type header of a webhook names a pull request event
+	IsPullRequestEvent(eventType string) bool
+
+	// ParsePullRequestPayload parses a pull request webhook payload
+	ParsePullRequestPayload(payload []byte) (*PullRequestEvent, error)
+}
+
+// PullRequestAction is the change a pull request event reports
+type PullRequestAction string
+
+const (
+	// PullRequestOpened is reported when a pull request is opened or reopened
+	PullRequestOpened PullRequestAction = "opened"
+	// PullRequestUpdated is reported when commits are pushed to a pull request
+	PullRequestUpdated PullRequestAction = "updated"
+	// PullRequestClosed is reported when a pull request is closed or merged
+	PullRequestClosed PullRequestAction = "closed"
+)
+
+// PullRequestEvent represents a normalized pull request event.
+// Action is empty for changes that do not affect the code of the pull request, such as edits
+// of its title or labels.
+type PullRequestEvent struct {
+	Provider      string
+	RepositoryURL string
+	Number        int64
+	Action        PullRequestAction
+	Commit        string
+	Branch        string
+	BaseBranch    string
+	// FromFork tells whether the head branch lives in a different repository
+	FromFork bool
+}
+
 // ProviderType represents the git provider type
 type ProviderType string
 
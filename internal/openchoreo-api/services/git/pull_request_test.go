@@ -0,0 +1,92 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitHubProvider_ParsePullRequestPayload(t *testing.T) {
+	provider := NewGitHubProvider()
+	assert.True(t, provider.IsPullRequestEvent("pull_request"))
+	assert.False(t, provider.IsPullRequestEvent("push"))
+
+	payload := func(action, headRepo string) []byte {
+		return []byte(`{"action":"` + action + `","number":42,
+			"pull_request":{"head":{"ref":"feature/login","sha":"abc1234","repo":{"clone_url":"` + headRepo + `"}},"base":{"ref":"main"}},
+			"repository":{"clone_url":"https://github.com/Acme/Shop.git"}}`)
+	}
+
+	event, err := provider.ParsePullRequestPayload(payload("opened", "https://github.com/acme/shop.git"))
+	require.NoError(t, err)
+	assert.Equal(t, &PullRequestEvent{
+		Provider:      "github",
+		RepositoryURL: "https://github.com/acme/shop",
+		Number:        42,
+		Action:        PullRequestOpened,
+		Commit:        "abc1234",
+		Branch:        "feature/login",
+		BaseBranch:    "main",
+	}, event)
+
+	for action, want := range map[string]PullRequestAction{
+		"reopened":    PullRequestOpened,
+		"synchronize": PullRequestUpdated,
+		"closed":      PullRequestClosed,
+		"labeled":     "",
+	} {
+		event, err := provider.ParsePullRequestPayload(payload(action, "https://github.com/acme/shop.git"))
+		require.NoError(t, err)
+		assert.Equal(t, want, event.Action, action)
+	}
+
+	event, err = provider.ParsePullRequestPayload(payload("opened", "https://github.com/someone/shop.git"))
+	require.NoError(t, err)
+	assert.True(t, event.FromFork)
+
+	_, err = provider.ParsePullRequestPayload([]byte(`not-json`))
+	require.Error(t, err)
+}
+
+func TestGitLabProvider_ParsePullRequestPayload(t *testing.T) {
+	provider := NewGitLabProvider()
+	assert.True(t, provider.IsPullRequestEvent("Merge Request Hook"))
+	assert.False(t, provider.IsPullRequestEvent("Push Hook"))
+
+	payload := func(action, oldRev string) []byte {
+		return []byte(`{"object_kind":"merge_request",
+			"object_attributes":{"iid":7,"action":"` + action + `","oldrev":"` + oldRev + `",
+				"source_branch":"feature/login","target_branch":"main","last_commit":{"id":"abc1234"},
+				"source":{"git_http_url":"https://gitlab.com/acme/shop.git"}},
+			"project":{"git_http_url":"https://gitlab.com/acme/shop.git"}}`)
+	}
+
+	event, err := provider.ParsePullRequestPayload(payload("open", ""))
+	require.NoError(t, err)
+	assert.Equal(t, &PullRequestEvent{
+		Provider:      "gitlab",
+		RepositoryURL: "https://gitlab.com/acme/shop",
+		Number:        7,
+		Action:        PullRequestOpened,
+		Commit:        "abc1234",
+		Branch:        "feature/login",
+		BaseBranch:    "main",
+	}, event)
+
+	event, err = provider.ParsePullRequestPayload(payload("update", "0ld"))
+	require.NoError(t, err)
+	assert.Equal(t, PullRequestUpdated, event.Action)
+
+	// Updates without new commits, such as title edits, do not change the preview.
+	event, err = provider.ParsePullRequestPayload(payload("update", ""))
+	require.NoError(t, err)
+	assert.Empty(t, event.Action)
+
+	event, err = provider.ParsePullRequestPayload(payload("merge", ""))
+	require.NoError(t, err)
+	assert.Equal(t, PullRequestClosed, event.Action)
+}
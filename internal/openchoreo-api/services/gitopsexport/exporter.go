@@ -0,0 +1,293 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package gitopsexport renders the OpenChoreo resources of control plane
+// namespaces to a directory of cleaned YAML files that kustomize can apply, and
+// optionally commits and pushes it to a Git repository, so that the repository
+// can become the source of truth of the control plane.
+package gitopsexport
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path"
+	"slices"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// kustomizationFile is the name of the kustomization of each directory.
+const kustomizationFile = "kustomization.yaml"
+
+// exportKind is a kind of resource exported, with the directory its resources
+// are written to.
+type exportKind struct {
+	kind string
+	dir  string
+}
+
+// platformKinds are the namespace-scoped kinds that belong to the namespace
+// rather than to a project.
+var platformKinds = []exportKind{
+	{kind: "DeploymentPipeline", dir: "deployment-pipelines"},
+	{kind: "Environment", dir: "environments"},
+	{kind: "DataPlane", dir: "data-planes"},
+	{kind: "WorkflowPlane", dir: "workflow-planes"},
+	{kind: "ObservabilityPlane", dir: "observability-planes"},
+	{kind: "ObservabilityAlertsNotificationChannel", dir: "notification-channels"},
+	{kind: "ProjectType", dir: "project-types"},
+	{kind: "ComponentType", dir: "component-types"},
+	{kind: "ResourceType", dir: "resource-types"},
+	{kind: "Trait", dir: "traits"},
+	{kind: "Workflow", dir: "workflows"},
+	{kind: "SecretReference", dir: "secret-references"},
+	{kind: "AuthzRole", dir: "authz-roles"},
+	{kind: "AuthzRoleBinding", dir: "authz-role-bindings"},
+}
+
+// projectKinds are the kinds owned by a project through spec.owner.projectName.
+// Resources generated by controllers, such as RenderedReleases and
+// WorkflowRuns, are not exported.
+var projectKinds = []exportKind{
+	{kind: "Component", dir: "components"},
+	{kind: "Workload", dir: "workloads"},
+	{kind: "ComponentRelease", dir: "component-releases"},
+	{kind: "ReleaseBinding", dir: "release-bindings"},
+	{kind: "Resource", dir: "resources"},
+	{kind: "ResourceRelease", dir: "resource-releases"},
+	{kind: "ResourceReleaseBinding", dir: "resource-release-bindings"},
+	{kind: "ProjectRelease", dir: "project-releases"},
+	{kind: "ProjectReleaseBinding", dir: "project-release-bindings"},
+}
+
+// Scope selects the resources exported.
+type Scope struct {
+	// Namespaces are the control plane namespaces exported.
+	Namespaces []string
+	// Projects, when set, limits the export to these projects and the
+	// resources they own. The platform resources of the namespaces are then
+	// not exported.
+	Projects []string
+	// Filter, when set, is called with the resources of each kind listed in
+	// a namespace and returns those to export. Namespaces themselves are not
+	// filtered.
+	Filter func(ctx context.Context, kind string, items []unstructured.Unstructured) ([]unstructured.Unstructured, error)
+}
+
+// Exporter renders the resources of a scope to files.
+type Exporter struct {
+	k8sClient client.Reader
+	logger    *slog.Logger
+}
+
+// NewExporter creates an Exporter that reads resources with k8sClient.
+func NewExporter(k8sClient client.Reader, logger *slog.Logger) *Exporter {
+	return &Exporter{k8sClient: k8sClient, logger: logger}
+}
+
+// Render returns the files of the export, keyed by slash-separated path. The
+// layout is
+//
+//	kustomization.yaml
+//	namespaces/<namespace>/kustomization.yaml
+//	namespaces/<namespace>/namespace.yaml
+//	namespaces/<namespace>/<kind>/<name>.yaml
+//	namespaces/<namespace>/projects/<project>/kustomization.yaml
+//	namespaces/<namespace>/projects/<project>/project.yaml
+//	namespaces/<namespace>/projects/<project>/<kind>/<name>.yaml
+//
+// Rendering the same resources always yields the same files.
+func (e *Exporter) Render(ctx context.Context, scope Scope) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	namespaces := slices.Sorted(slices.Values(scope.Namespaces))
+	namespaces = slices.Compact(namespaces)
+
+	for _, ns := range namespaces {
+		if err := e.renderNamespace(ctx, files, ns, scope); err != nil {
+			return nil, err
+		}
+	}
+
+	nsDirs := make([]string, 0, len(namespaces))
+	for _, ns := range namespaces {
+		nsDirs = append(nsDirs, path.Join("namespaces", ns))
+	}
+	if err := addKustomization(files, "", nsDirs); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func (e *Exporter) renderNamespace(ctx context.Context, files map[string][]byte, ns string, scope Scope) error {
+	projects := scope.Projects
+	nsDir := path.Join("namespaces", ns)
+	var entries []string
+	// projectEntries are the files of each project directory.
+	projectEntries := map[string][]string{}
+
+	if len(projects) == 0 {
+		namespace := &corev1.Namespace{}
+		if err := e.k8sClient.Get(ctx, client.ObjectKey{Name: ns}, namespace); err != nil {
+			return fmt.Errorf("failed to get namespace %q: %w", ns, err)
+		}
+		obj, err := toUnstructured(namespace, "v1", "Namespace")
+		if err != nil {
+			return err
+		}
+		if err := addObject(files, path.Join(nsDir, "namespace.yaml"), obj); err != nil {
+			return err
+		}
+		entries = append(entries, "namespace.yaml")
+
+		for _, k := range platformKinds {
+			items, err := e.list(ctx, ns, k.kind, scope.Filter)
+			if err != nil {
+				return err
+			}
+			for i := range items {
+				file := path.Join(k.dir, items[i].GetName()+".yaml")
+				if err := addObject(files, path.Join(nsDir, file), &items[i]); err != nil {
+					return err
+				}
+				entries = append(entries, file)
+			}
+		}
+	}
+
+	selected := func(project string) bool {
+		return len(projects) == 0 || slices.Contains(projects, project)
+	}
+	projectItems, err := e.list(ctx, ns, "Project", scope.Filter)
+	if err != nil {
+		return err
+	}
+	for i := range projectItems {
+		name := projectItems[i].GetName()
+		if !selected(name) {
+			continue
+		}
+		if err := addObject(files, path.Join(nsDir, "projects", name, "project.yaml"), &projectItems[i]); err != nil {
+			return err
+		}
+		projectEntries[name] = []string{"project.yaml"}
+	}
+
+	for _, k := range projectKinds {
+		items, err := e.list(ctx, ns, k.kind, scope.Filter)
+		if err != nil {
+			return err
+		}
+		for i := range items {
+			project, _, _ := unstructured.NestedString(items[i].Object, "spec", "owner", "projectName")
+			if project == "" || !selected(project) {
+				continue
+			}
+			file := path.Join(k.dir, items[i].GetName()+".yaml")
+			if err := addObject(files, path.Join(nsDir, "projects", project, file), &items[i]); err != nil {
+				return err
+			}
+			projectEntries[project] = append(projectEntries[project], file)
+		}
+	}
+	for project, projectFiles := range projectEntries {
+		if err := addKustomization(files, path.Join(nsDir, "projects", project), projectFiles); err != nil {
+			return err
+		}
+		entries = append(entries, path.Join("projects", project))
+	}
+
+	e.logger.Debug("Rendered namespace for export", "namespace", ns, "entries", len(entries))
+	return addKustomization(files, nsDir, entries)
+}
+
+// list returns the resources of kind in the namespace that pass filter, sorted
+// by name. Resources that are being deleted are left out.
+func (e *Exporter) list(
+	ctx context.Context,
+	ns, kind string,
+	filter func(context.Context, string, []unstructured.Unstructured) ([]unstructured.Unstructured, error),
+) ([]unstructured.Unstructured, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(openchoreov1alpha1.GroupVersion.WithKind(kind + "List"))
+	if err := e.k8sClient.List(ctx, list, client.InNamespace(ns)); err != nil {
+		return nil, fmt.Errorf("failed to list %s resources in namespace %q: %w", kind, ns, err)
+	}
+	items := slices.DeleteFunc(list.Items, func(item unstructured.Unstructured) bool {
+		return item.GetDeletionTimestamp() != nil
+	})
+	sort.Slice(items, func(i, j int) bool { return items[i].GetName() < items[j].GetName() })
+	for i := range items {
+		items[i].SetAPIVersion(openchoreov1alpha1.GroupVersion.String())
+		items[i].SetKind(kind)
+	}
+	if filter != nil {
+		return filter(ctx, kind, items)
+	}
+	return items, nil
+}
+
+func toUnstructured(obj any, apiVersion, kind string) (*unstructured.Unstructured, error) {
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s: %w", kind, err)
+	}
+	u := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal(data, &u.Object); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s: %w", kind, err)
+	}
+	u.SetAPIVersion(apiVersion)
+	u.SetKind(kind)
+	return u, nil
+}
+
+// Clean removes the fields of obj that the API server sets and that would make
+// the exported resource differ between clusters or between exports.
+func Clean(obj *unstructured.Unstructured) {
+	for _, field := range []string{
+		"uid", "resourceVersion", "generation", "creationTimestamp", "deletionTimestamp",
+		"deletionGracePeriodSeconds", "managedFields", "ownerReferences", "finalizers", "selfLink",
+	} {
+		unstructured.RemoveNestedField(obj.Object, "metadata", field)
+	}
+	unstructured.RemoveNestedField(obj.Object, "metadata", "annotations", corev1.LastAppliedConfigAnnotation)
+	if len(obj.GetAnnotations()) == 0 {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "annotations")
+	}
+	unstructured.RemoveNestedField(obj.Object, "status")
+	if obj.GetKind() == "Namespace" {
+		unstructured.RemoveNestedField(obj.Object, "spec")
+	}
+}
+
+func addObject(files map[string][]byte, file string, obj *unstructured.Unstructured) error {
+	Clean(obj)
+	data, err := yaml.Marshal(obj.Object)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s %q: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	files[file] = data
+	return nil
+}
+
+// addKustomization adds the kustomization of dir, listing the given files and
+// directories relative to dir.
+func addKustomization(files map[string][]byte, dir string, resources []string) error {
+	resources = slices.Sorted(slices.Values(resources))
+	data, err := yaml.Marshal(map[string]any{
+		"apiVersion": "kustomize.config.k8s.io/v1beta1",
+		"kind":       "Kustomization",
+		"resources":  resources,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal kustomization of %q: %w", dir, err)
+	}
+	files[path.Join(dir, kustomizationFile)] = data
+	return nil
+}
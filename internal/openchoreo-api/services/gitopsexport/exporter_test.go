@@ -0,0 +1,136 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitopsexport
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+const testNamespace = "acme"
+
+func testObjects() []client.Object {
+	return []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   testNamespace,
+			Labels: map[string]string{"openchoreo.dev/control-plane": "true"},
+		}},
+		&openchoreov1alpha1.Environment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "dev",
+				Namespace:   testNamespace,
+				Annotations: map[string]string{corev1.LastAppliedConfigAnnotation: "{}"},
+			},
+			Status: openchoreov1alpha1.EnvironmentStatus{ObservedGeneration: 3},
+		},
+		&openchoreov1alpha1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "shop", Namespace: testNamespace},
+		},
+		&openchoreov1alpha1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "reports", Namespace: testNamespace},
+		},
+		&openchoreov1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "orders",
+				Namespace:  testNamespace,
+				Finalizers: []string{"openchoreo.dev/component-cleanup"},
+			},
+			Spec: openchoreov1alpha1.ComponentSpec{
+				Owner:         openchoreov1alpha1.ComponentOwner{ProjectName: "shop"},
+				ComponentType: openchoreov1alpha1.ComponentTypeRef{Name: "deployment/service"},
+			},
+		},
+		testutil.NewWorkload(testNamespace, "shop", "orders", "orders-workload"),
+		testutil.NewWorkload(testNamespace, "reports", "nightly", "nightly-workload"),
+	}
+}
+
+func TestRender(t *testing.T) {
+	exporter := NewExporter(testutil.NewFakeClient(testObjects()...), testutil.TestLogger())
+
+	files, err := exporter.Render(t.Context(), Scope{Namespaces: []string{testNamespace}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"kustomization.yaml",
+		"namespaces/acme/environments/dev.yaml",
+		"namespaces/acme/kustomization.yaml",
+		"namespaces/acme/namespace.yaml",
+		"namespaces/acme/projects/reports/kustomization.yaml",
+		"namespaces/acme/projects/reports/project.yaml",
+		"namespaces/acme/projects/reports/workloads/nightly-workload.yaml",
+		"namespaces/acme/projects/shop/components/orders.yaml",
+		"namespaces/acme/projects/shop/kustomization.yaml",
+		"namespaces/acme/projects/shop/project.yaml",
+		"namespaces/acme/projects/shop/workloads/orders-workload.yaml",
+	}, slices.Sorted(maps.Keys(files)))
+
+	assert.Equal(t, `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+- environments/dev.yaml
+- namespace.yaml
+- projects/reports
+- projects/shop
+`, string(files["namespaces/acme/kustomization.yaml"]))
+	assert.Equal(t, `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+- namespaces/acme
+`, string(files["kustomization.yaml"]))
+
+	assert.Equal(t, `apiVersion: openchoreo.dev/v1alpha1
+kind: Environment
+metadata:
+  name: dev
+  namespace: acme
+spec:
+  gateway: {}
+`, string(files["namespaces/acme/environments/dev.yaml"]))
+	assert.Equal(t, `apiVersion: v1
+kind: Namespace
+metadata:
+  labels:
+    openchoreo.dev/control-plane: "true"
+  name: acme
+`, string(files["namespaces/acme/namespace.yaml"]))
+	assert.NotContains(t, string(files["namespaces/acme/projects/shop/components/orders.yaml"]), "finalizers")
+
+	again, err := exporter.Render(t.Context(), Scope{Namespaces: []string{testNamespace}})
+	require.NoError(t, err)
+	assert.Equal(t, files, again)
+}
+
+func TestRender_Projects(t *testing.T) {
+	exporter := NewExporter(testutil.NewFakeClient(testObjects()...), testutil.TestLogger())
+
+	files, err := exporter.Render(t.Context(), Scope{Namespaces: []string{testNamespace}, Projects: []string{"shop"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"kustomization.yaml",
+		"namespaces/acme/kustomization.yaml",
+		"namespaces/acme/projects/shop/components/orders.yaml",
+		"namespaces/acme/projects/shop/kustomization.yaml",
+		"namespaces/acme/projects/shop/project.yaml",
+		"namespaces/acme/projects/shop/workloads/orders-workload.yaml",
+	}, slices.Sorted(maps.Keys(files)))
+}
+
+func TestRender_MissingNamespace(t *testing.T) {
+	exporter := NewExporter(testutil.NewFakeClient(), testutil.TestLogger())
+
+	_, err := exporter.Render(t.Context(), Scope{Namespaces: []string{"missing"}})
+	require.ErrorContains(t, err, `failed to get namespace "missing"`)
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitopsexport
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Repository is the Git repository an export is committed and pushed to.
+type Repository struct {
+	// URL is the remote the export is pushed to.
+	URL string
+	// Branch is the branch the export is committed to. It is created when it
+	// does not exist.
+	Branch string
+	// Path is the directory of the export within the repository. Files under
+	// it that are not part of the export are removed. Empty is the repository
+	// root.
+	Path        string
+	AuthorName  string
+	AuthorEmail string
+	// Token authenticates to HTTPS remotes. Empty uses no credentials.
+	Token string
+}
+
+// gitRepo is a working copy of a Repository, driven by the git CLI.
+type gitRepo struct {
+	Repository
+	dir string
+}
+
+// sync makes the working copy match the remote branch, creating the working
+// copy on first use. When the branch does not exist on the remote, the
+// working copy is left as is and the branch is created by the next push.
+func (r *gitRepo) sync(ctx context.Context) error {
+	if _, err := os.Stat(filepath.Join(r.dir, ".git")); errors.Is(err, fs.ErrNotExist) {
+		if err := os.MkdirAll(r.dir, 0o755); err != nil {
+			return fmt.Errorf("failed to create working copy directory: %w", err)
+		}
+		if _, err := r.git(ctx, "init", "-q"); err != nil {
+			return err
+		}
+		if _, err := r.git(ctx, "remote", "add", "origin", r.URL); err != nil {
+			return err
+		}
+	}
+
+	heads, err := r.git(ctx, "ls-remote", "--heads", "origin", r.Branch)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(heads) == "" {
+		_, err := r.git(ctx, "symbolic-ref", "HEAD", "refs/heads/"+r.Branch)
+		return err
+	}
+	if _, err := r.git(ctx, "fetch", "-q", "origin", r.Branch); err != nil {
+		return err
+	}
+	_, err = r.git(ctx, "checkout", "-q", "-f", "-B", r.Branch, "FETCH_HEAD")
+	return err
+}
+
+// exportDir is the directory of the export in the working copy.
+func (r *gitRepo) exportDir() string {
+	return filepath.Join(r.dir, filepath.FromSlash(r.Path))
+}
+
+// commitAndPush commits the changes under the export path and pushes them.
+// It reports whether there was anything to commit.
+func (r *gitRepo) commitAndPush(ctx context.Context, message string) (bool, error) {
+	pathspec := r.Path
+	if pathspec == "" {
+		pathspec = "."
+	}
+	if _, err := r.git(ctx, "add", "-A", "--", pathspec); err != nil {
+		return false, err
+	}
+	status, err := r.git(ctx, "status", "--porcelain", "--", pathspec)
+	if err != nil {
+		return false, err
+	}
+	if strings.TrimSpace(status) == "" {
+		return false, nil
+	}
+	if _, err := r.git(ctx, "-c", "user.name="+r.AuthorName, "-c", "user.email="+r.AuthorEmail,
+		"commit", "-q", "-m", message); err != nil {
+		return false, err
+	}
+	if _, err := r.git(ctx, "push", "-q", "origin", "HEAD:refs/heads/"+r.Branch); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// git runs a git command in the working copy and returns its output. The
+// token is passed through the environment so that it does not show up in the
+// process list or in errors.
+func (r *gitRepo) git(ctx context.Context, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = r.dir
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	if r.Token != "" {
+		credentials := base64.StdEncoding.EncodeToString([]byte("x-access-token:" + r.Token))
+		cmd.Env = append(cmd.Env,
+			"GIT_CONFIG_COUNT=1",
+			"GIT_CONFIG_KEY_0=http.extraHeader",
+			"GIT_CONFIG_VALUE_0=Authorization: Basic "+credentials,
+		)
+	}
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("git %s failed: %w: %s", args[0], err, strings.TrimSpace(string(out)))
+	}
+	return string(out), nil
+}
@@ -0,0 +1,15 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitopsexport
+
+import "context"
+
+// Service defines the GitOps export service interface.
+// Both the core service (no authz) and the authz-wrapped service implement this.
+type Service interface {
+	// ExportProject returns the files of the GitOps export of a project and
+	// the resources it owns, keyed by slash-separated path, in the layout of
+	// Exporter.Render.
+	ExportProject(ctx context.Context, namespaceName, projectName string) (map[string][]byte, error)
+}
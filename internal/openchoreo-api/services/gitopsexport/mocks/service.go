@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// ExportProject provides a mock function with given fields: ctx, namespaceName, projectName
+func (_m *MockService) ExportProject(ctx context.Context, namespaceName string, projectName string) (map[string][]byte, error) {
+	ret := _m.Called(ctx, namespaceName, projectName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportProject")
+	}
+
+	var r0 map[string][]byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (map[string][]byte, error)); ok {
+		return rf(ctx, namespaceName, projectName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) map[string][]byte); ok {
+		r0 = rf(ctx, namespaceName, projectName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, projectName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ExportProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportProject'
+type MockService_ExportProject_Call struct {
+	*mock.Call
+}
+
+// ExportProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - projectName string
+func (_e *MockService_Expecter) ExportProject(ctx interface{}, namespaceName interface{}, projectName interface{}) *MockService_ExportProject_Call {
+	return &MockService_ExportProject_Call{Call: _e.mock.On("ExportProject", ctx, namespaceName, projectName)}
+}
+
+func (_c *MockService_ExportProject_Call) Run(run func(ctx context.Context, namespaceName string, projectName string)) *MockService_ExportProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_ExportProject_Call) Return(_a0 map[string][]byte, _a1 error) *MockService_ExportProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ExportProject_Call) RunAndReturn(run func(context.Context, string, string) (map[string][]byte, error)) *MockService_ExportProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
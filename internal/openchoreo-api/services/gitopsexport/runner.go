@@ -0,0 +1,101 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitopsexport
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Options configures a Runner.
+type Options struct {
+	Scope Scope
+	// Interval is the time between exports.
+	Interval time.Duration
+	// Directory is where the export is written, or the working copy of
+	// Repository when it is set.
+	Directory string
+	// Repository, when set, is where each export is committed and pushed.
+	// Pushing needs the git CLI.
+	Repository *Repository
+}
+
+// Runner exports a scope on a schedule.
+type Runner struct {
+	exporter *Exporter
+	opts     Options
+	repo     *gitRepo
+	logger   *slog.Logger
+}
+
+// New creates a Runner that reads resources with k8sClient. The client must
+// not check authorization: resources are read with the identity of the API
+// server.
+func New(k8sClient client.Reader, opts Options, logger *slog.Logger) *Runner {
+	r := &Runner{
+		exporter: NewExporter(k8sClient, logger),
+		opts:     opts,
+		logger:   logger,
+	}
+	if opts.Repository != nil {
+		r.repo = &gitRepo{Repository: *opts.Repository, dir: opts.Directory}
+	}
+	return r
+}
+
+// Run exports the scope right away and then every interval until ctx is done.
+// Failed exports are logged and retried at the next interval.
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.opts.Interval)
+	defer ticker.Stop()
+	for {
+		if err := r.Export(ctx); err != nil && ctx.Err() == nil {
+			r.logger.Error("GitOps export failed", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Export renders the scope and writes it to the directory, then commits and
+// pushes it when a repository is configured.
+func (r *Runner) Export(ctx context.Context) error {
+	files, err := r.exporter.Render(ctx, r.opts.Scope)
+	if err != nil {
+		return err
+	}
+
+	dir := r.opts.Directory
+	if r.repo != nil {
+		if err := r.repo.sync(ctx); err != nil {
+			return fmt.Errorf("failed to sync repository: %w", err)
+		}
+		dir = r.repo.exportDir()
+	}
+	changed, err := WriteTree(dir, files)
+	if err != nil {
+		return err
+	}
+	if r.repo == nil {
+		r.logger.Info("GitOps export written", "directory", dir, "files", len(files), "changed", changed)
+		return nil
+	}
+
+	message := "Export OpenChoreo resources of " + strings.Join(r.opts.Scope.Namespaces, ", ")
+	pushed, err := r.repo.commitAndPush(ctx, message)
+	if err != nil {
+		return fmt.Errorf("failed to push export: %w", err)
+	}
+	r.logger.Info("GitOps export completed", "repository", r.repo.URL, "branch", r.repo.Branch,
+		"files", len(files), "pushed", pushed)
+	return nil
+}
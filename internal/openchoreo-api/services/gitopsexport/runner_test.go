@@ -0,0 +1,94 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitopsexport
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func TestRunner_ExportToDirectory(t *testing.T) {
+	dir := t.TempDir()
+	runner := New(testutil.NewFakeClient(testObjects()...), Options{
+		Scope:     Scope{Namespaces: []string{testNamespace}},
+		Interval:  time.Minute,
+		Directory: dir,
+	}, testutil.TestLogger())
+
+	require.NoError(t, runner.Export(t.Context()))
+	assert.FileExists(t, filepath.Join(dir, "namespaces", testNamespace, "projects", "shop", "components", "orders.yaml"))
+}
+
+func runGit(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+	return strings.TrimSpace(string(out))
+}
+
+func TestRunner_ExportToRepository(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	remote := filepath.Join(t.TempDir(), "platform.git")
+	runGit(t, t.TempDir(), "init", "-q", "--bare", remote)
+
+	objects := testObjects()
+	k8sClient := testutil.NewFakeClient(objects...)
+	newRunner := func(dir string) *Runner {
+		return New(k8sClient, Options{
+			Scope:     Scope{Namespaces: []string{testNamespace}},
+			Interval:  time.Minute,
+			Directory: dir,
+			Repository: &Repository{
+				URL:         remote,
+				Branch:      "gitops",
+				Path:        "clusters/prod",
+				AuthorName:  "OpenChoreo",
+				AuthorEmail: "gitops@openchoreo.dev",
+			},
+		}, testutil.TestLogger())
+	}
+
+	workDir := filepath.Join(t.TempDir(), "work")
+	runner := newRunner(workDir)
+	require.NoError(t, runner.Export(t.Context()))
+
+	log := runGit(t, remote, "log", "--format=%an %s", "gitops")
+	assert.Equal(t, "OpenChoreo Export OpenChoreo resources of acme", log)
+	files := runGit(t, remote, "ls-tree", "-r", "--name-only", "gitops")
+	assert.Contains(t, files, "clusters/prod/namespaces/acme/projects/shop/components/orders.yaml")
+
+	// Nothing changed: no new commit.
+	require.NoError(t, runner.Export(t.Context()))
+	assert.Equal(t, "1", runGit(t, remote, "rev-list", "--count", "gitops"))
+
+	// A fresh working copy picks up the remote branch and commits removals.
+	var orders client.Object
+	for _, obj := range objects {
+		if obj.GetName() == "orders" {
+			orders = obj
+		}
+	}
+	require.NoError(t, k8sClient.Delete(t.Context(), orders))
+	require.NoError(t, newRunner(filepath.Join(t.TempDir(), "fresh")).Export(t.Context()))
+	assert.Equal(t, "2", runGit(t, remote, "rev-list", "--count", "gitops"))
+	files = runGit(t, remote, "ls-tree", "-r", "--name-only", "gitops")
+	assert.NotContains(t, files, "components/orders.yaml")
+
+	_, err := os.Stat(filepath.Join(workDir, ".git"))
+	require.NoError(t, err)
+}
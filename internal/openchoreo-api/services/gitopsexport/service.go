@@ -0,0 +1,57 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitopsexport
+
+import (
+	"context"
+	"log/slog"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+)
+
+// filterFunc selects the resources of a kind to export, see Scope.Filter.
+type filterFunc func(ctx context.Context, kind string, items []unstructured.Unstructured) ([]unstructured.Unstructured, error)
+
+// gitopsExportService handles GitOps exports without authorization checks.
+type gitopsExportService struct {
+	exporter       *Exporter
+	projectService projectsvc.Service
+	logger         *slog.Logger
+}
+
+var _ Service = (*gitopsExportService)(nil)
+
+// NewService creates a new GitOps export service without authorization.
+func NewService(k8sClient client.Client, logger *slog.Logger) Service {
+	return newService(k8sClient, logger)
+}
+
+func newService(k8sClient client.Client, logger *slog.Logger) *gitopsExportService {
+	return &gitopsExportService{
+		exporter:       NewExporter(k8sClient, logger),
+		projectService: projectsvc.NewService(k8sClient, logger.With("component", "project-service-internal")),
+		logger:         logger,
+	}
+}
+
+func (s *gitopsExportService) ExportProject(ctx context.Context, namespaceName, projectName string) (map[string][]byte, error) {
+	return s.exportProject(ctx, namespaceName, projectName, nil)
+}
+
+// exportProject exports a project, leaving out the resources filter drops.
+func (s *gitopsExportService) exportProject(ctx context.Context, namespaceName, projectName string, filter filterFunc) (map[string][]byte, error) {
+	s.logger.Debug("Exporting project for GitOps", "namespace", namespaceName, "project", projectName)
+
+	if _, err := s.projectService.GetProject(ctx, namespaceName, projectName); err != nil {
+		return nil, err
+	}
+	return s.exporter.Render(ctx, Scope{
+		Namespaces: []string{namespaceName},
+		Projects:   []string{projectName},
+		Filter:     filter,
+	})
+}
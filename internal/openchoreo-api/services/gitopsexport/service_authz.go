@@ -0,0 +1,144 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitopsexport
+
+import (
+	"context"
+	"log/slog"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+const (
+	resourceTypeProject                = "project"
+	resourceTypeComponent              = "component"
+	resourceTypeWorkload               = "workload"
+	resourceTypeComponentRelease       = "componentrelease"
+	resourceTypeReleaseBinding         = "releasebinding"
+	resourceTypeResource               = "resource"
+	resourceTypeResourceRelease        = "resourcerelease"
+	resourceTypeResourceReleaseBinding = "resourcereleasebinding"
+	resourceTypeProjectRelease         = "projectrelease"
+	resourceTypeProjectReleaseBinding  = "projectreleasebinding"
+)
+
+// gitopsExportServiceWithAuthz wraps the service and adds authorization checks.
+// Handlers should use this. Other services should use the unwrapped Service directly.
+type gitopsExportServiceWithAuthz struct {
+	internal *gitopsExportService
+	authz    *services.AuthzChecker
+}
+
+var _ Service = (*gitopsExportServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates a GitOps export service with authorization checks.
+func NewServiceWithAuthz(k8sClient client.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &gitopsExportServiceWithAuthz{
+		internal: newService(k8sClient, logger),
+		authz:    services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+// ExportProject requires permission to view the project. Resources of the
+// project the caller may not view are left out of the export.
+func (s *gitopsExportServiceWithAuthz) ExportProject(ctx context.Context, namespaceName, projectName string) (map[string][]byte, error) {
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewProject,
+		ResourceType: resourceTypeProject,
+		ResourceID:   projectName,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName, Project: projectName},
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.internal.exportProject(ctx, namespaceName, projectName,
+		func(ctx context.Context, kind string, items []unstructured.Unstructured) ([]unstructured.Unstructured, error) {
+			return s.filterViewable(ctx, namespaceName, projectName, kind, items)
+		})
+}
+
+// filterViewable returns the items of the project the caller may view. Items
+// of other projects are dropped without a check, as they are not exported.
+func (s *gitopsExportServiceWithAuthz) filterViewable(
+	ctx context.Context,
+	namespaceName, projectName, kind string,
+	items []unstructured.Unstructured,
+) ([]unstructured.Unstructured, error) {
+	if kind == "Project" {
+		// The project was checked before the export
+		return items, nil
+	}
+
+	var owned []unstructured.Unstructured
+	var requests []services.CheckRequest
+	for i := range items {
+		project, _, _ := unstructured.NestedString(items[i].Object, "spec", "owner", "projectName")
+		if project != projectName {
+			continue
+		}
+		req, ok := checkRequest(namespaceName, projectName, kind, &items[i])
+		if !ok {
+			continue
+		}
+		owned = append(owned, items[i])
+		requests = append(requests, req)
+	}
+
+	decisions, err := s.authz.BatchCheck(ctx, requests)
+	if err != nil {
+		return nil, err
+	}
+	viewable := make([]unstructured.Unstructured, 0, len(owned))
+	for i := range owned {
+		if i < len(decisions) && decisions[i] {
+			viewable = append(viewable, owned[i])
+		}
+	}
+	return viewable, nil
+}
+
+// checkRequest returns the check of permission to view a resource of a
+// project. It reports false for kinds that are not exported with projects.
+func checkRequest(namespaceName, projectName, kind string, item *unstructured.Unstructured) (services.CheckRequest, bool) {
+	component, _, _ := unstructured.NestedString(item.Object, "spec", "owner", "componentName")
+	resource, _, _ := unstructured.NestedString(item.Object, "spec", "owner", "resourceName")
+	req := services.CheckRequest{
+		ResourceID: item.GetName(),
+		Hierarchy:  authz.ResourceHierarchy{Namespace: namespaceName, Project: projectName},
+	}
+	switch kind {
+	case "Component":
+		req.Action, req.ResourceType = authz.ActionViewComponent, resourceTypeComponent
+		req.Hierarchy.Component = item.GetName()
+	case "Workload":
+		req.Action, req.ResourceType = authz.ActionViewWorkload, resourceTypeWorkload
+		req.Hierarchy.Component = component
+	case "ComponentRelease":
+		req.Action, req.ResourceType = authz.ActionViewComponentRelease, resourceTypeComponentRelease
+		req.Hierarchy.Component = component
+	case "ReleaseBinding":
+		req.Action, req.ResourceType = authz.ActionViewReleaseBinding, resourceTypeReleaseBinding
+		req.Hierarchy.Component = component
+	case "Resource":
+		req.Action, req.ResourceType = authz.ActionViewResource, resourceTypeResource
+		req.Hierarchy.Resource = item.GetName()
+	case "ResourceRelease":
+		req.Action, req.ResourceType = authz.ActionViewResourceRelease, resourceTypeResourceRelease
+		req.Hierarchy.Resource = resource
+	case "ResourceReleaseBinding":
+		req.Action, req.ResourceType = authz.ActionViewResourceReleaseBinding, resourceTypeResourceReleaseBinding
+		req.Hierarchy.Resource = resource
+	case "ProjectRelease":
+		req.Action, req.ResourceType = authz.ActionViewProjectRelease, resourceTypeProjectRelease
+	case "ProjectReleaseBinding":
+		req.Action, req.ResourceType = authz.ActionViewProjectReleaseBinding, resourceTypeProjectReleaseBinding
+	default:
+		return services.CheckRequest{}, false
+	}
+	return req, true
+}
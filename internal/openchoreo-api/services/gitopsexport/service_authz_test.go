@@ -0,0 +1,65 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitopsexport
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func newAuthzService(pdp authzcore.PDP) *gitopsExportServiceWithAuthz {
+	return &gitopsExportServiceWithAuthz{
+		internal: newService(testutil.NewFakeClient(testObjects()...), testutil.TestLogger()),
+		authz:    testutil.NewTestAuthzChecker(pdp),
+	}
+}
+
+func TestExportProject_AuthzCheck(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		files, err := newAuthzService(pdp).ExportProject(testutil.AuthzContext(), testNamespace, "shop")
+		require.NoError(t, err)
+		assert.Contains(t, files, "namespaces/acme/projects/shop/components/orders.yaml")
+		assert.Contains(t, files, "namespaces/acme/projects/shop/workloads/orders-workload.yaml")
+		require.NotEmpty(t, pdp.Captured)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "project:view", "project", "shop",
+			authzcore.ResourceHierarchy{Namespace: testNamespace, Project: "shop"})
+	})
+
+	t.Run("project denied", func(t *testing.T) {
+		_, err := newAuthzService(testutil.DenyPDP()).ExportProject(testutil.AuthzContext(), testNamespace, "shop")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+
+	t.Run("resources filtered", func(t *testing.T) {
+		pdp := &batchDenyPDP{CapturingPDP: *testutil.AllowPDP()}
+		files, err := newAuthzService(pdp).ExportProject(testutil.AuthzContext(), testNamespace, "shop")
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"kustomization.yaml",
+			"namespaces/acme/kustomization.yaml",
+			"namespaces/acme/projects/shop/kustomization.yaml",
+			"namespaces/acme/projects/shop/project.yaml",
+		}, slices.Sorted(maps.Keys(files)))
+	})
+}
+
+// batchDenyPDP allows single checks and denies batch checks, so that the
+// project may be viewed but none of its resources.
+type batchDenyPDP struct {
+	testutil.CapturingPDP
+}
+
+func (p *batchDenyPDP) BatchEvaluate(_ context.Context, req *authzcore.BatchEvaluateRequest) (*authzcore.BatchEvaluateResponse, error) {
+	return &authzcore.BatchEvaluateResponse{Decisions: make([]authzcore.Decision, len(req.Requests))}, nil
+}
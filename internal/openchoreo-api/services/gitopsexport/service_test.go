@@ -0,0 +1,38 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitopsexport
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func TestExportProject(t *testing.T) {
+	svc := NewService(testutil.NewFakeClient(testObjects()...), testutil.TestLogger())
+
+	t.Run("exports the project", func(t *testing.T) {
+		files, err := svc.ExportProject(t.Context(), testNamespace, "shop")
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"kustomization.yaml",
+			"namespaces/acme/kustomization.yaml",
+			"namespaces/acme/projects/shop/components/orders.yaml",
+			"namespaces/acme/projects/shop/kustomization.yaml",
+			"namespaces/acme/projects/shop/project.yaml",
+			"namespaces/acme/projects/shop/workloads/orders-workload.yaml",
+		}, slices.Sorted(maps.Keys(files)))
+	})
+
+	t.Run("project not found", func(t *testing.T) {
+		_, err := svc.ExportProject(t.Context(), testNamespace, "missing")
+		require.ErrorIs(t, err, projectsvc.ErrProjectNotFound)
+	})
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitopsexport
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// WriteTree makes dir hold exactly the given files, keyed by slash-separated
+// path relative to dir: files that are missing or differ are written, and
+// other files and the directories left empty are removed. A .git directory
+// is left alone. It reports whether anything changed.
+func WriteTree(dir string, files map[string][]byte) (bool, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return false, fmt.Errorf("failed to create export directory: %w", err)
+	}
+	changed := false
+
+	var emptyDirCandidates []string
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if p == dir {
+			return nil
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			emptyDirCandidates = append(emptyDirCandidates, p)
+			return nil
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		if _, ok := files[filepath.ToSlash(rel)]; ok {
+			return nil
+		}
+		changed = true
+		return os.Remove(p)
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to remove stale files: %w", err)
+	}
+
+	for _, file := range slices.Sorted(maps.Keys(files)) {
+		p := filepath.Join(dir, filepath.FromSlash(file))
+		current, err := os.ReadFile(p)
+		if err == nil && bytes.Equal(current, files[file]) {
+			continue
+		}
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return false, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			return false, fmt.Errorf("failed to create directory of %s: %w", file, err)
+		}
+		if err := os.WriteFile(p, files[file], 0o644); err != nil {
+			return false, fmt.Errorf("failed to write %s: %w", file, err)
+		}
+		changed = true
+	}
+
+	// Remove directories left empty, deepest first.
+	slices.Reverse(emptyDirCandidates)
+	for _, d := range emptyDirCandidates {
+		entries, err := os.ReadDir(d)
+		if err != nil {
+			return false, fmt.Errorf("failed to read directory %s: %w", d, err)
+		}
+		if len(entries) == 0 {
+			if err := os.Remove(d); err != nil {
+				return false, fmt.Errorf("failed to remove directory %s: %w", d, err)
+			}
+		}
+	}
+	return changed, nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitopsexport
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteTree(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".git"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".git", "HEAD"), []byte("ref"), 0o644))
+
+	changed, err := WriteTree(dir, map[string][]byte{
+		"kustomization.yaml":         []byte("a"),
+		"projects/shop/project.yaml": []byte("b"),
+		"projects/old/project.yaml":  []byte("c"),
+	})
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	changed, err = WriteTree(dir, map[string][]byte{
+		"kustomization.yaml":         []byte("a"),
+		"projects/shop/project.yaml": []byte("b"),
+		"projects/old/project.yaml":  []byte("c"),
+	})
+	require.NoError(t, err)
+	assert.False(t, changed, "rewriting the same files changes nothing")
+
+	changed, err = WriteTree(dir, map[string][]byte{
+		"kustomization.yaml":         []byte("a2"),
+		"projects/shop/project.yaml": []byte("b"),
+	})
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	content, err := os.ReadFile(filepath.Join(dir, "kustomization.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "a2", string(content))
+	assert.NoDirExists(t, filepath.Join(dir, "projects", "old"))
+	assert.FileExists(t, filepath.Join(dir, ".git", "HEAD"))
+}
@@ -11,6 +11,8 @@ import (
 	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
 	gatewayClient "github.com/openchoreo/openchoreo/internal/clients/gateway"
 	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	apitokensvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/apitoken"
+	auditeventsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/auditevent"
 	authzsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/authz"
 	autobuildsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/autobuild"
 	clustercomponenttypesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/clustercomponenttype"
@@ -24,25 +26,44 @@ import (
 	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
 	componentreleasesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/componentrelease"
 	componenttypesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/componenttype"
+	connectionsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/connection"
+	costsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/cost"
+	customdomainsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/customdomain"
 	dataplanesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/dataplane"
+	dependencysvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/dependency"
+	deploymentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/deployment"
 	deploymentpipelinesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/deploymentpipeline"
+	discoverysvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/discovery"
+	endpointsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/endpoint"
 	environmentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment"
+	gitopsexportsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/gitopsexport"
 	gitsecretsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/gitsecret"
+	healthsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/health"
+	helmchartsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/helmchart"
 	k8sresourcessvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/k8sresources"
+	kindschemasvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/kindschema"
 	namespacesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/namespace"
+	networkpolicysvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/networkpolicy"
 	observabilityalertsnotificationchannelsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/observabilityalertsnotificationchannel"
 	observabilityplanesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/observabilityplane"
+	printercolumnsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/printercolumn"
 	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
 	projectreleasesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/projectrelease"
 	projectreleasebindingsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/projectreleasebinding"
 	projecttypesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/projecttype"
+	promotionsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/promotion"
+	quotasvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/quota"
+	registrysvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/registry"
+	releasesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/release"
 	releasebindingsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/releasebinding"
 	resourcesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/resource"
 	resourcereleasesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/resourcerelease"
 	resourcereleasebindingsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/resourcereleasebinding"
 	resourcetypesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/resourcetype"
+	sandboxsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/sandbox"
 	secretsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secret"
 	secretreferencesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secretreference"
+	terraformexportsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/terraformexport"
 	traitsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/trait"
 	workflowsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflow"
 	workflowplanesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/workflowplane"
@@ -52,12 +73,18 @@ import (
 
 // Services aggregates all K8s-native API service interfaces.
 type Services struct {
+	APITokenService                               apitokensvc.Service
+	AuditEventService                             auditeventsvc.Service
 	AutoBuildService                              autobuildsvc.Service
 	AuthzService                                  authzsvc.Service
+	KindSchemaService                             kindschemasvc.Service
+	PrinterColumnService                          printercolumnsvc.Service
 	ProjectService                                projectsvc.Service
 	ProjectTypeService                            projecttypesvc.Service
+	PromotionService                              promotionsvc.Service
 	ProjectReleaseService                         projectreleasesvc.Service
 	ProjectReleaseBindingService                  projectreleasebindingsvc.Service
+	QuotaService                                  quotasvc.Service
 	WorkflowPlaneService                          workflowplanesvc.Service
 	ClusterWorkflowPlaneService                   clusterworkflowplanesvc.Service
 	ClusterComponentTypeService                   clustercomponenttypesvc.Service
@@ -68,23 +95,38 @@ type Services struct {
 	ClusterTraitService                           clustertraitsvc.Service
 	ClusterWorkflowService                        clusterworkflowsvc.Service
 	DataPlaneService                              dataplanesvc.Service
+	DependencyService                             dependencysvc.Service
+	DeploymentService                             deploymentsvc.Service
 	DeploymentPipelineService                     deploymentpipelinesvc.Service
+	DiscoveryService                              discoverysvc.Service
 	NamespaceService                              namespacesvc.Service
+	NetworkPolicyService                          networkpolicysvc.Service
 	ComponentService                              componentsvc.Service
 	ComponentReleaseService                       componentreleasesvc.Service
 	ComponentTypeService                          componenttypesvc.Service
+	ConnectionService                             connectionsvc.Service
+	CostService                                   costsvc.Service
+	CustomDomainService                           customdomainsvc.Service
+	EndpointService                               endpointsvc.Service
 	EnvironmentService                            environmentsvc.Service
+	GitOpsExportService                           gitopsexportsvc.Service
 	GitSecretService                              gitsecretsvc.Service
+	HealthService                                 healthsvc.Service
+	HelmChartService                              helmchartsvc.Service
 	ObservabilityAlertsNotificationChannelService observabilityalertsnotificationchannelsvc.Service
 	ObservabilityPlaneService                     observabilityplanesvc.Service
 	K8sResourcesService                           k8sresourcessvc.Service
+	RegistryService                               registrysvc.Service
+	ReleaseService                                releasesvc.Service
 	ReleaseBindingService                         releasebindingsvc.Service
 	ResourceService                               resourcesvc.Service
 	ResourceReleaseService                        resourcereleasesvc.Service
 	ResourceReleaseBindingService                 resourcereleasebindingsvc.Service
 	ResourceTypeService                           resourcetypesvc.Service
+	SandboxService                                sandboxsvc.Service
 	SecretService                                 secretsvc.Service
 	SecretReferenceService                        secretreferencesvc.Service
+	TerraformExportService                        terraformexportsvc.Service
 	TraitService                                  traitsvc.Service
 	WorkflowService                               workflowsvc.Service
 	WorkflowRunService                            workflowrunsvc.Service
@@ -92,14 +134,20 @@ type Services struct {
 }
 
 // NewServices creates all K8s-native API services with authorization wrappers.
-func NewServices(k8sClient client.Client, pap authzcore.PAP, pdp authzcore.PDP, planeClientProvider kubernetesClient.PlaneClientProvider, logger *slog.Logger, gwClient *gatewayClient.Client, webhookProcessor autobuildsvc.WebhookProcessor) *Services {
+func NewServices(k8sClient client.Client, pap authzcore.PAP, pdp authzcore.PDP, planeClientProvider kubernetesClient.PlaneClientProvider, logger *slog.Logger, gwClient *gatewayClient.Client, webhookProcessor autobuildsvc.WebhookProcessor, auditEventStore auditeventsvc.EventStore, healthService healthsvc.Service, apiTokenService apitokensvc.Service, costService costsvc.Service) *Services {
 	return &Services{
+		APITokenService:                               apiTokenService,
+		AuditEventService:                             auditeventsvc.NewServiceWithAuthz(auditEventStore, pdp, logger.With("component", "auditevent-service")),
 		AutoBuildService:                              autobuildsvc.NewService(k8sClient, webhookProcessor, logger.With("component", "autobuild-service")),
 		AuthzService:                                  authzsvc.NewServiceWithAuthz(pap, pdp, logger.With("component", "authz-service")),
+		KindSchemaService:                             kindschemasvc.NewService(logger.With("component", "kindschema-service")),
+		PrinterColumnService:                          printercolumnsvc.NewService(logger.With("component", "printercolumn-service")),
 		ProjectService:                                projectsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "project-service")),
 		ProjectTypeService:                            projecttypesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "projecttype-service")),
+		PromotionService:                              promotionsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "promotion-service")),
 		ProjectReleaseService:                         projectreleasesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "projectrelease-service")),
 		ProjectReleaseBindingService:                  projectreleasebindingsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "projectreleasebinding-service")),
+		QuotaService:                                  quotasvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "quota-service")),
 		WorkflowPlaneService:                          workflowplanesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "workflowplane-service")),
 		ClusterWorkflowPlaneService:                   clusterworkflowplanesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "clusterworkflowplane-service")),
 		ClusterComponentTypeService:                   clustercomponenttypesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "clustercomponenttype-service")),
@@ -109,24 +157,39 @@ func NewServices(k8sClient client.Client, pap authzcore.PAP, pdp authzcore.PDP,
 		ClusterResourceTypeService:                    clusterresourcetypesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "clusterresourcetype-service")),
 		ClusterTraitService:                           clustertraitsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "clustertrait-service")),
 		ClusterWorkflowService:                        clusterworkflowsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "clusterworkflow-service")),
-		DataPlaneService:                              dataplanesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "dataplane-service")),
+		DataPlaneService:                              dataplanesvc.NewServiceWithAuthz(k8sClient, gwClient, pdp, logger.With("component", "dataplane-service")),
+		DependencyService:                             dependencysvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "dependency-service")),
+		DeploymentService:                             deploymentsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "deployment-service")),
 		DeploymentPipelineService:                     deploymentpipelinesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "deploymentpipeline-service")),
+		DiscoveryService:                              discoverysvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "discovery-service")),
 		NamespaceService:                              namespacesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "namespace-service")),
+		NetworkPolicyService:                          networkpolicysvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "networkpolicy-service")),
 		ComponentService:                              componentsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "component-service")),
 		ComponentReleaseService:                       componentreleasesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "componentrelease-service")),
 		ComponentTypeService:                          componenttypesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "componenttype-service")),
+		ConnectionService:                             connectionsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "connection-service")),
+		CostService:                                   costService,
+		CustomDomainService:                           customdomainsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "customdomain-service")),
+		EndpointService:                               endpointsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "endpoint-service")),
 		EnvironmentService:                            environmentsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "environment-service")),
+		GitOpsExportService:                           gitopsexportsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "gitopsexport-service")),
 		GitSecretService:                              gitsecretsvc.NewServiceWithAuthz(k8sClient, planeClientProvider, pdp, logger.With("component", "gitsecret-service")),
+		HealthService:                                 healthService,
+		HelmChartService:                              helmchartsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "helmchart-service")),
 		ObservabilityAlertsNotificationChannelService: observabilityalertsnotificationchannelsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "observabilityalertsnotificationchannel-service")),
 		ObservabilityPlaneService:                     observabilityplanesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "observabilityplane-service")),
 		K8sResourcesService:                           k8sresourcessvc.NewServiceWithAuthz(k8sClient, gwClient, pdp, logger.With("component", "k8sresources-service")),
+		RegistryService:                               registrysvc.NewServiceWithAuthz(k8sClient, planeClientProvider, pdp, logger.With("component", "registry-service")),
+		ReleaseService:                                releasesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "release-service")),
 		ReleaseBindingService:                         releasebindingsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "releasebinding-service")),
-		ResourceService:                               resourcesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "resource-service")),
+		ResourceService:                               resourcesvc.NewServiceWithAuthz(k8sClient, planeClientProvider, pdp, logger.With("component", "resource-service")),
 		ResourceReleaseService:                        resourcereleasesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "resourcerelease-service")),
 		ResourceReleaseBindingService:                 resourcereleasebindingsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "resourcereleasebinding-service")),
 		ResourceTypeService:                           resourcetypesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "resourcetype-service")),
+		SandboxService:                                sandboxsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "sandbox-service")),
 		SecretService:                                 secretsvc.NewServiceWithAuthz(k8sClient, planeClientProvider, pdp, logger.With("component", "secret-service")),
 		SecretReferenceService:                        secretreferencesvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "secretreference-service")),
+		TerraformExportService:                        terraformexportsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "terraformexport-service")),
 		TraitService:                                  traitsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "trait-service")),
 		WorkflowService:                               workflowsvc.NewServiceWithAuthz(k8sClient, pdp, logger.With("component", "workflow-service")),
 		WorkflowRunService:                            workflowrunsvc.NewServiceWithAuthz(k8sClient, planeClientProvider, gwClient, pdp, logger.With("component", "workflowrun-service")),
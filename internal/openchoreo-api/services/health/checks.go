@@ -0,0 +1,443 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	admissionv1client "k8s.io/client-go/kubernetes/typed/admissionregistration/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	gatewayClient "github.com/openchoreo/openchoreo/internal/clients/gateway"
+)
+
+// Check names used in reports.
+const (
+	CheckKubernetes          = "kubernetes"
+	CheckCRDs                = "crds"
+	CheckDataPlanes          = "dataPlanes"
+	CheckWorkflowPlanes      = "workflowPlanes"
+	CheckGateways            = "gateways"
+	CheckWebhookCertificates = "webhookCertificates"
+)
+
+// maxListedFailures caps how many failing items a check message names.
+const maxListedFailures = 5
+
+// KubernetesAPICheck verifies that the Kubernetes API server is reachable.
+// It is part of both liveness and readiness reports.
+func KubernetesAPICheck(d discovery.ServerVersionInterface) Check {
+	return Check{
+		Name:     CheckKubernetes,
+		Liveness: true,
+		Run: func(context.Context) error {
+			if _, err := d.ServerVersion(); err != nil {
+				return fmt.Errorf("kubernetes API server unreachable: %w", err)
+			}
+			return nil
+		},
+	}
+}
+
+// CRDCheck verifies that the API server serves every kind the scheme registers
+// for the group version, i.e. that the OpenChoreo CRDs are installed.
+func CRDCheck(d discovery.ServerResourcesInterface, scheme *runtime.Scheme, gv schema.GroupVersion) Check {
+	objectType := reflect.TypeFor[metav1.Object]()
+	var kinds []string
+	for kind, t := range scheme.KnownTypes(gv) {
+		if strings.HasSuffix(kind, "List") || !reflect.PointerTo(t).Implements(objectType) {
+			continue
+		}
+		kinds = append(kinds, kind)
+	}
+	slices.Sort(kinds)
+
+	return Check{
+		Name: CheckCRDs,
+		Run: func(context.Context) error {
+			resources, err := d.ServerResourcesForGroupVersion(gv.String())
+			if err != nil {
+				return fmt.Errorf("failed to discover %s resources: %w", gv, err)
+			}
+			served := make(map[string]bool, len(resources.APIResources))
+			for _, r := range resources.APIResources {
+				served[r.Kind] = true
+			}
+			var missing []string
+			for _, kind := range kinds {
+				if !served[kind] {
+					missing = append(missing, kind)
+				}
+			}
+			if len(missing) > 0 {
+				return fmt.Errorf("%d %s kinds are not served: %s", len(missing), gv, strings.Join(missing, ", "))
+			}
+			return nil
+		},
+	}
+}
+
+// CacheSyncer is implemented by informer caches.
+type CacheSyncer interface {
+	WaitForCacheSync(ctx context.Context) bool
+}
+
+// CacheSyncCheck verifies that every informer of an informer cache has synced.
+func CacheSyncCheck(name string, c CacheSyncer) Check {
+	return Check{
+		Name: name,
+		Run: func(ctx context.Context) error {
+			if !c.WaitForCacheSync(ctx) {
+				return errors.New("informer cache has not synced")
+			}
+			return nil
+		},
+	}
+}
+
+// PlaneStatusGetter reports plane agent connections. It is implemented by the
+// cluster gateway client.
+type PlaneStatusGetter interface {
+	GetPlaneStatus(ctx context.Context, planeType, planeID, namespace, name string) (*gatewayClient.PlaneConnectionStatus, error)
+}
+
+// K8sProxy reads Kubernetes resources of a plane cluster through its agent. It
+// is implemented by the cluster gateway client.
+type K8sProxy interface {
+	ProxyK8sRequest(ctx context.Context, planeType, planeID, crNamespace, crName, k8sPath, rawQuery string) (*http.Response, error)
+}
+
+// plane identifies a plane CR and the agent it connects through. Namespace is
+// empty for cluster-scoped planes.
+type plane struct {
+	planeID   string
+	namespace string
+	name      string
+}
+
+func (p plane) String() string {
+	return strings.TrimPrefix(p.namespace+"/"+p.name, "/")
+}
+
+// dataPlane is a DataPlane or ClusterDataPlane with its gateway configuration.
+type dataPlane struct {
+	plane
+	gateway openchoreov1alpha1.GatewaySpec
+}
+
+func listDataPlanes(ctx context.Context, k8sClient client.Reader) ([]dataPlane, error) {
+	var dataPlanes openchoreov1alpha1.DataPlaneList
+	if err := k8sClient.List(ctx, &dataPlanes); err != nil {
+		return nil, fmt.Errorf("failed to list data planes: %w", err)
+	}
+	var clusterDataPlanes openchoreov1alpha1.ClusterDataPlaneList
+	if err := k8sClient.List(ctx, &clusterDataPlanes); err != nil {
+		return nil, fmt.Errorf("failed to list cluster data planes: %w", err)
+	}
+
+	planes := make([]dataPlane, 0, len(dataPlanes.Items)+len(clusterDataPlanes.Items))
+	for i := range dataPlanes.Items {
+		dp := &dataPlanes.Items[i]
+		planeID := dp.Spec.PlaneID
+		if planeID == "" {
+			planeID = dp.Name
+		}
+		planes = append(planes, dataPlane{plane{planeID, dp.Namespace, dp.Name}, dp.Spec.Gateway})
+	}
+	for i := range clusterDataPlanes.Items {
+		cdp := &clusterDataPlanes.Items[i]
+		planes = append(planes, dataPlane{plane{cdp.Spec.PlaneID, "", cdp.Name}, cdp.Spec.Gateway})
+	}
+	return planes, nil
+}
+
+func listWorkflowPlanes(ctx context.Context, k8sClient client.Reader) ([]plane, error) {
+	var workflowPlanes openchoreov1alpha1.WorkflowPlaneList
+	if err := k8sClient.List(ctx, &workflowPlanes); err != nil {
+		return nil, fmt.Errorf("failed to list workflow planes: %w", err)
+	}
+	var clusterWorkflowPlanes openchoreov1alpha1.ClusterWorkflowPlaneList
+	if err := k8sClient.List(ctx, &clusterWorkflowPlanes); err != nil {
+		return nil, fmt.Errorf("failed to list cluster workflow planes: %w", err)
+	}
+
+	planes := make([]plane, 0, len(workflowPlanes.Items)+len(clusterWorkflowPlanes.Items))
+	for i := range workflowPlanes.Items {
+		wp := &workflowPlanes.Items[i]
+		planeID := wp.Spec.PlaneID
+		if planeID == "" {
+			planeID = wp.Name
+		}
+		planes = append(planes, plane{planeID, wp.Namespace, wp.Name})
+	}
+	for i := range clusterWorkflowPlanes.Items {
+		cwp := &clusterWorkflowPlanes.Items[i]
+		planes = append(planes, plane{cwp.Spec.PlaneID, "", cwp.Name})
+	}
+	return planes, nil
+}
+
+// checkConnected returns an error naming the planes whose agent is not
+// connected to the cluster gateway.
+func checkConnected(ctx context.Context, gw PlaneStatusGetter, planeType, noun string, planes []plane) error {
+	var disconnected []string
+	for _, p := range planes {
+		status, err := gw.GetPlaneStatus(ctx, planeType, p.planeID, p.namespace, p.name)
+		if err != nil || !status.Connected {
+			disconnected = append(disconnected, p.String())
+		}
+	}
+	if len(disconnected) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d of %d %s are not connected: %s", len(disconnected), len(planes), noun, listFailures(disconnected))
+}
+
+// listFailures joins the names of failing items, naming at most
+// maxListedFailures of them.
+func listFailures(names []string) string {
+	if len(names) > maxListedFailures {
+		names = append(names[:maxListedFailures:maxListedFailures], "...")
+	}
+	return strings.Join(names, ", ")
+}
+
+// DataPlaneCheck verifies that the agent of every DataPlane and ClusterDataPlane
+// is connected to the cluster gateway. The check is optional: disconnected data
+// planes degrade the report without failing readiness.
+func DataPlaneCheck(k8sClient client.Reader, gw PlaneStatusGetter) Check {
+	return Check{
+		Name:     CheckDataPlanes,
+		Optional: true,
+		Run: func(ctx context.Context) error {
+			dataPlanes, err := listDataPlanes(ctx, k8sClient)
+			if err != nil {
+				return err
+			}
+			planes := make([]plane, len(dataPlanes))
+			for i, dp := range dataPlanes {
+				planes[i] = dp.plane
+			}
+			return checkConnected(ctx, gw, "dataplane", "data planes", planes)
+		},
+	}
+}
+
+// WorkflowPlaneCheck verifies that the agent of every WorkflowPlane and
+// ClusterWorkflowPlane is connected to the cluster gateway. Builds cannot run
+// on a disconnected workflow plane.
+func WorkflowPlaneCheck(k8sClient client.Reader, gw PlaneStatusGetter) Check {
+	return Check{
+		Name:       CheckWorkflowPlanes,
+		Optional:   true,
+		Diagnostic: true,
+		Run: func(ctx context.Context) error {
+			planes, err := listWorkflowPlanes(ctx, k8sClient)
+			if err != nil {
+				return err
+			}
+			return checkConnected(ctx, gw, "workflowplane", "workflow planes", planes)
+		},
+	}
+}
+
+// GatewayCheck verifies that the ingress gateways configured on every DataPlane
+// and ClusterDataPlane are programmed, reading each Gateway resource from its
+// data plane cluster through the cluster gateway.
+func GatewayCheck(k8sClient client.Reader, proxy K8sProxy) Check {
+	return Check{
+		Name:       CheckGateways,
+		Optional:   true,
+		Diagnostic: true,
+		Run: func(ctx context.Context) error {
+			dataPlanes, err := listDataPlanes(ctx, k8sClient)
+			if err != nil {
+				return err
+			}
+			total := 0
+			var notReady []string
+			for _, dp := range dataPlanes {
+				if dp.gateway.Ingress == nil {
+					continue
+				}
+				for _, ep := range []*openchoreov1alpha1.GatewayEndpointSpec{dp.gateway.Ingress.External, dp.gateway.Ingress.Internal} {
+					if ep == nil {
+						continue
+					}
+					total++
+					if err := gatewayProgrammed(ctx, proxy, dp.plane, ep); err != nil {
+						notReady = append(notReady, fmt.Sprintf("%s/%s on %s (%v)", ep.Namespace, ep.Name, dp, err))
+					}
+				}
+			}
+			if len(notReady) == 0 {
+				return nil
+			}
+			return fmt.Errorf("%d of %d gateways are not ready: %s", len(notReady), total, listFailures(notReady))
+		},
+	}
+}
+
+// gatewayProgrammed returns an error unless the Gateway has a true Programmed
+// condition.
+func gatewayProgrammed(ctx context.Context, proxy K8sProxy, p plane, ep *openchoreov1alpha1.GatewayEndpointSpec) error {
+	crNamespace := p.namespace
+	if crNamespace == "" {
+		crNamespace = "_cluster"
+	}
+	path := fmt.Sprintf("apis/gateway.networking.k8s.io/v1/namespaces/%s/gateways/%s", ep.Namespace, ep.Name)
+	resp, err := proxy.ProxyK8sRequest(ctx, "dataplane", p.planeID, crNamespace, p.name, path, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return errors.New("not found")
+	default:
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	var gateway struct {
+		Status struct {
+			Conditions []metav1.Condition `json:"conditions"`
+		} `json:"status"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&gateway); err != nil {
+		return fmt.Errorf("failed to decode gateway: %w", err)
+	}
+	programmed := apimeta.FindStatusCondition(gateway.Status.Conditions, "Programmed")
+	switch {
+	case programmed == nil:
+		return errors.New("not programmed")
+	case programmed.Status != metav1.ConditionTrue:
+		return fmt.Errorf("not programmed: %s", programmed.Reason)
+	}
+	return nil
+}
+
+// WebhookConfigurationsGetter reads admission webhook configurations. It is
+// implemented by the admissionregistration/v1 client of client-go.
+type WebhookConfigurationsGetter interface {
+	admissionv1client.ValidatingWebhookConfigurationsGetter
+	admissionv1client.MutatingWebhookConfigurationsGetter
+}
+
+// webhookCertificateRenewalWindow is how long before expiry a webhook CA
+// certificate is reported. cert-manager renews well before that.
+const webhookCertificateRenewalWindow = 7 * 24 * time.Hour
+
+// WebhookCertificateCheck verifies that every admission webhook for OpenChoreo
+// resources has a CA bundle whose certificates are valid and not about to
+// expire. A webhook that the API server cannot verify rejects every write to
+// the resources it covers.
+func WebhookCertificateCheck(webhooks WebhookConfigurationsGetter, now func() time.Time) Check {
+	return Check{
+		Name:       CheckWebhookCertificates,
+		Optional:   true,
+		Diagnostic: true,
+		Run: func(ctx context.Context) error {
+			validating, err := webhooks.ValidatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to list validating webhook configurations: %w", err)
+			}
+			mutating, err := webhooks.MutatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to list mutating webhook configurations: %w", err)
+			}
+
+			total := 0
+			var problems []string
+			check := func(name string, rules []admissionregistrationv1.RuleWithOperations, caBundle []byte) {
+				if !coversOpenChoreo(rules) {
+					return
+				}
+				total++
+				if err := checkCABundle(caBundle, now()); err != nil {
+					problems = append(problems, fmt.Sprintf("%s (%v)", name, err))
+				}
+			}
+			for _, config := range validating.Items {
+				for _, w := range config.Webhooks {
+					check(config.Name+"/"+w.Name, w.Rules, w.ClientConfig.CABundle)
+				}
+			}
+			for _, config := range mutating.Items {
+				for _, w := range config.Webhooks {
+					check(config.Name+"/"+w.Name, w.Rules, w.ClientConfig.CABundle)
+				}
+			}
+
+			switch {
+			case total == 0:
+				return errors.New("no admission webhooks are registered for OpenChoreo resources")
+			case len(problems) > 0:
+				return fmt.Errorf("%d of %d webhooks have certificate problems: %s", len(problems), total, listFailures(problems))
+			}
+			return nil
+		},
+	}
+}
+
+func coversOpenChoreo(rules []admissionregistrationv1.RuleWithOperations) bool {
+	for _, rule := range rules {
+		if slices.Contains(rule.APIGroups, openchoreov1alpha1.GroupVersion.Group) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkCABundle returns an error when the PEM bundle has no certificate or
+// one of its certificates is expired or expires within the renewal window.
+func checkCABundle(caBundle []byte, now time.Time) error {
+	var certs []*x509.Certificate
+	for rest := caBundle; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return fmt.Errorf("invalid CA bundle: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return errors.New("no CA bundle")
+	}
+	for _, cert := range certs {
+		switch {
+		case now.After(cert.NotAfter):
+			return fmt.Errorf("CA certificate expired on %s", cert.NotAfter.UTC().Format(time.DateOnly))
+		case now.Add(webhookCertificateRenewalWindow).After(cert.NotAfter):
+			return fmt.Errorf("CA certificate expires on %s", cert.NotAfter.UTC().Format(time.DateOnly))
+		case now.Before(cert.NotBefore):
+			return fmt.Errorf("CA certificate is not valid before %s", cert.NotBefore.UTC().Format(time.DateOnly))
+		}
+	}
+	return nil
+}
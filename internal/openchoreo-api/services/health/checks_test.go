@@ -0,0 +1,304 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	gatewayClient "github.com/openchoreo/openchoreo/internal/clients/gateway"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func TestKubernetesAPICheck(t *testing.T) {
+	d := &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{}}
+	check := KubernetesAPICheck(d)
+	assert.True(t, check.Liveness)
+	require.NoError(t, check.Run(context.Background()))
+}
+
+func TestCRDCheck(t *testing.T) {
+	gv := openchoreov1alpha1.GroupVersion
+	d := &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{}}
+	check := CRDCheck(d, testutil.NewScheme(), gv)
+
+	err := check.Run(context.Background())
+	require.Error(t, err, "no resources are served")
+
+	var served []metav1.APIResource
+	for kind := range testutil.NewScheme().KnownTypes(gv) {
+		served = append(served, metav1.APIResource{Kind: kind})
+	}
+	d.Resources = []*metav1.APIResourceList{{GroupVersion: gv.String(), APIResources: served}}
+	require.NoError(t, check.Run(context.Background()))
+
+	d.Resources[0].APIResources = served[:0]
+	for _, r := range served {
+		if r.Kind != "Component" {
+			d.Resources[0].APIResources = append(d.Resources[0].APIResources, r)
+		}
+	}
+	err = check.Run(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, "1 openchoreo.dev/v1alpha1 kinds are not served: Component", err.Error())
+}
+
+type stubSyncer bool
+
+func (s stubSyncer) WaitForCacheSync(context.Context) bool { return bool(s) }
+
+func TestCacheSyncCheck(t *testing.T) {
+	require.NoError(t, CacheSyncCheck("readCache", stubSyncer(true)).Run(context.Background()))
+	require.EqualError(t, CacheSyncCheck("readCache", stubSyncer(false)).Run(context.Background()),
+		"informer cache has not synced")
+}
+
+// stubGateway reports the planes in connected as connected and fails for "broken".
+type stubGateway struct {
+	connected map[string]bool
+	calls     []string
+}
+
+func (g *stubGateway) GetPlaneStatus(_ context.Context, planeType, planeID, namespace, name string) (*gatewayClient.PlaneConnectionStatus, error) {
+	g.calls = append(g.calls, planeType+":"+planeID+":"+namespace+"/"+name)
+	if planeID == "broken" {
+		return nil, errors.New("gateway unavailable")
+	}
+	return &gatewayClient.PlaneConnectionStatus{Connected: g.connected[planeID]}, nil
+}
+
+func TestDataPlaneCheck(t *testing.T) {
+	k8sClient := fake.NewClientBuilder().WithScheme(testutil.NewScheme()).WithObjects(
+		&openchoreov1alpha1.DataPlane{ObjectMeta: metav1.ObjectMeta{Name: "dp-a", Namespace: "ns-1"}},
+		&openchoreov1alpha1.DataPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "dp-b", Namespace: "ns-1"},
+			Spec:       openchoreov1alpha1.DataPlaneSpec{PlaneID: "shared"},
+		},
+		&openchoreov1alpha1.ClusterDataPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "cdp"},
+			Spec:       openchoreov1alpha1.ClusterDataPlaneSpec{PlaneID: "broken"},
+		},
+	).Build()
+
+	gw := &stubGateway{connected: map[string]bool{"dp-a": true, "shared": true}}
+	check := DataPlaneCheck(k8sClient, gw)
+	assert.True(t, check.Optional)
+
+	err := check.Run(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, "1 of 3 data planes are not connected: cdp", err.Error())
+	assert.ElementsMatch(t, []string{
+		"dataplane:dp-a:ns-1/dp-a",
+		"dataplane:shared:ns-1/dp-b",
+		"dataplane:broken:/cdp",
+	}, gw.calls)
+
+	gw.connected["broken"] = true
+	gw.calls = nil
+	k8sClient = fake.NewClientBuilder().WithScheme(testutil.NewScheme()).Build()
+	require.NoError(t, DataPlaneCheck(k8sClient, gw).Run(context.Background()))
+}
+
+func TestWorkflowPlaneCheck(t *testing.T) {
+	k8sClient := fake.NewClientBuilder().WithScheme(testutil.NewScheme()).WithObjects(
+		&openchoreov1alpha1.WorkflowPlane{ObjectMeta: metav1.ObjectMeta{Name: "wp", Namespace: "ns-1"}},
+		&openchoreov1alpha1.ClusterWorkflowPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "cwp"},
+			Spec:       openchoreov1alpha1.ClusterWorkflowPlaneSpec{PlaneID: "ci"},
+		},
+	).Build()
+	gw := &stubGateway{connected: map[string]bool{"wp": true}}
+
+	check := WorkflowPlaneCheck(k8sClient, gw)
+	assert.True(t, check.Optional)
+	assert.True(t, check.Diagnostic)
+	err := check.Run(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, "1 of 2 workflow planes are not connected: cwp", err.Error())
+	assert.ElementsMatch(t, []string{"workflowplane:wp:ns-1/wp", "workflowplane:ci:/cwp"}, gw.calls)
+
+	gw.connected["ci"] = true
+	require.NoError(t, check.Run(context.Background()))
+}
+
+// stubProxy serves Gateway resources keyed by "namespace/name".
+type stubProxy struct {
+	gateways map[string]string
+	calls    []string
+}
+
+func (p *stubProxy) ProxyK8sRequest(_ context.Context, planeType, planeID, crNamespace, crName, k8sPath, _ string) (*http.Response, error) {
+	p.calls = append(p.calls, fmt.Sprintf("%s:%s:%s/%s", planeType, planeID, crNamespace, crName))
+	name := strings.TrimPrefix(k8sPath, "apis/gateway.networking.k8s.io/v1/namespaces/")
+	name = strings.Replace(name, "/gateways/", "/", 1)
+	body, ok := p.gateways[name]
+	if !ok {
+		return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+}
+
+func gatewayWithProgrammed(status metav1.ConditionStatus, reason string) string {
+	return fmt.Sprintf(`{"status":{"conditions":[{"type":"Programmed","status":%q,"reason":%q}]}}`, status, reason)
+}
+
+func TestGatewayCheck(t *testing.T) {
+	endpoint := func(name string) *openchoreov1alpha1.GatewayEndpointSpec {
+		return &openchoreov1alpha1.GatewayEndpointSpec{Name: name, Namespace: "gw"}
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(testutil.NewScheme()).WithObjects(
+		&openchoreov1alpha1.DataPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "dp", Namespace: "ns-1"},
+			Spec: openchoreov1alpha1.DataPlaneSpec{Gateway: openchoreov1alpha1.GatewaySpec{
+				Ingress: &openchoreov1alpha1.GatewayNetworkSpec{External: endpoint("external"), Internal: endpoint("internal")},
+			}},
+		},
+		&openchoreov1alpha1.ClusterDataPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "cdp"},
+			Spec: openchoreov1alpha1.ClusterDataPlaneSpec{PlaneID: "shared", Gateway: openchoreov1alpha1.GatewaySpec{
+				Ingress: &openchoreov1alpha1.GatewayNetworkSpec{External: endpoint("missing")},
+			}},
+		},
+		&openchoreov1alpha1.DataPlane{ObjectMeta: metav1.ObjectMeta{Name: "no-gateway", Namespace: "ns-1"}},
+	).Build()
+	proxy := &stubProxy{gateways: map[string]string{
+		"gw/external": gatewayWithProgrammed(metav1.ConditionTrue, "Programmed"),
+		"gw/internal": gatewayWithProgrammed(metav1.ConditionFalse, "AddressNotAssigned"),
+	}}
+
+	check := GatewayCheck(k8sClient, proxy)
+	assert.True(t, check.Optional)
+	assert.True(t, check.Diagnostic)
+	err := check.Run(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, "2 of 3 gateways are not ready: "+
+		"gw/internal on ns-1/dp (not programmed: AddressNotAssigned), gw/missing on cdp (not found)", err.Error())
+	assert.ElementsMatch(t, []string{"dataplane:dp:ns-1/dp", "dataplane:dp:ns-1/dp", "dataplane:shared:_cluster/cdp"}, proxy.calls)
+
+	proxy.gateways["gw/internal"] = gatewayWithProgrammed(metav1.ConditionTrue, "Programmed")
+	proxy.gateways["gw/missing"] = gatewayWithProgrammed(metav1.ConditionTrue, "Programmed")
+	require.NoError(t, check.Run(context.Background()))
+}
+
+func TestWebhookCertificateCheck(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	openchoreoRules := []admissionregistrationv1.RuleWithOperations{
+		{Rule: admissionregistrationv1.Rule{APIGroups: []string{"openchoreo.dev"}}},
+	}
+	validating := func(name string, caBundle []byte) *admissionregistrationv1.ValidatingWebhookConfiguration {
+		return &admissionregistrationv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+				Name:         "vcomponent.openchoreo.dev",
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{CABundle: caBundle},
+				Rules:        openchoreoRules,
+			}},
+		}
+	}
+	mutating := &admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "mutating"},
+		Webhooks: []admissionregistrationv1.MutatingWebhook{{
+			Name:         "mcomponent.openchoreo.dev",
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{CABundle: testCertificate(t, now.Add(90*24*time.Hour))},
+			Rules:        openchoreoRules,
+		}},
+	}
+	other := &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "other"},
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+			Name:  "vpod.example.com",
+			Rules: []admissionregistrationv1.RuleWithOperations{{Rule: admissionregistrationv1.Rule{APIGroups: []string{""}}}},
+		}},
+	}
+
+	tests := []struct {
+		name    string
+		objects []runtime.Object
+		wantErr string
+	}{
+		{
+			name:    "valid certificates",
+			objects: []runtime.Object{validating("validating", testCertificate(t, now.Add(90*24*time.Hour))), mutating, other},
+		},
+		{
+			name:    "expiring certificate",
+			objects: []runtime.Object{validating("validating", testCertificate(t, now.Add(48*time.Hour))), mutating},
+			wantErr: "1 of 2 webhooks have certificate problems: " +
+				"validating/vcomponent.openchoreo.dev (CA certificate expires on 2026-06-03)",
+		},
+		{
+			name:    "expired certificate",
+			objects: []runtime.Object{validating("validating", testCertificate(t, now.Add(-time.Hour)))},
+			wantErr: "1 of 1 webhooks have certificate problems: " +
+				"validating/vcomponent.openchoreo.dev (CA certificate expired on 2026-05-31)",
+		},
+		{
+			name:    "missing CA bundle",
+			objects: []runtime.Object{validating("validating", nil)},
+			wantErr: "1 of 1 webhooks have certificate problems: validating/vcomponent.openchoreo.dev (no CA bundle)",
+		},
+		{
+			name:    "no OpenChoreo webhooks",
+			objects: []runtime.Object{other},
+			wantErr: "no admission webhooks are registered for OpenChoreo resources",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientset := k8sfake.NewClientset(tt.objects...)
+			check := WebhookCertificateCheck(clientset.AdmissionregistrationV1(), func() time.Time { return now })
+			assert.True(t, check.Diagnostic)
+
+			err := check.Run(context.Background())
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+// testCertificate returns a PEM encoded self-signed certificate that expires
+// at notAfter.
+func testCertificate(t *testing.T, notAfter time.Time) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "webhook-ca"},
+		NotBefore:             notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"context"
+	"time"
+)
+
+// Status is the outcome of a check or of a whole report.
+type Status string
+
+const (
+	// StatusOK means the dependency is healthy.
+	StatusOK Status = "ok"
+	// StatusDegraded means only optional checks failed. A degraded server is
+	// still ready to serve requests.
+	StatusDegraded Status = "degraded"
+	// StatusFailed means a required check failed.
+	StatusFailed Status = "failed"
+)
+
+// Check verifies a single dependency.
+type Check struct {
+	// Name identifies the dependency in reports.
+	Name string
+	// Run returns an error when the dependency is unhealthy.
+	Run func(ctx context.Context) error
+	// Liveness includes the check in liveness reports. Every check is part of
+	// readiness reports.
+	Liveness bool
+	// Optional makes a failure degrade the report instead of failing it.
+	Optional bool
+	// Diagnostic limits the check to diagnostics reports. Such checks are too
+	// slow or too far removed from serving requests to gate readiness.
+	Diagnostic bool
+}
+
+// CheckResult is the outcome of one check.
+type CheckResult struct {
+	Status   Status
+	Message  string
+	Duration time.Duration
+}
+
+// Report is the outcome of a set of checks.
+type Report struct {
+	Status Status
+	Checks map[string]CheckResult
+}
+
+// Service runs the health checks behind the liveness and readiness endpoints.
+type Service interface {
+	// Liveness runs the checks marked for liveness.
+	Liveness(ctx context.Context) *Report
+	// Readiness runs every check that is not diagnostic.
+	Readiness(ctx context.Context) *Report
+	// Diagnostics runs every check, to troubleshoot an installation.
+	Diagnostics(ctx context.Context) *Report
+}
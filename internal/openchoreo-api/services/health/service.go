@@ -0,0 +1,115 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// healthService runs checks concurrently, each bounded by a timeout.
+type healthService struct {
+	checks  []Check
+	timeout time.Duration
+	logger  *slog.Logger
+}
+
+var _ Service = (*healthService)(nil)
+
+// NewService creates a health service for the given checks. Each check run is
+// cancelled after timeout.
+func NewService(checks []Check, timeout time.Duration, logger *slog.Logger) Service {
+	return &healthService{
+		checks:  checks,
+		timeout: timeout,
+		logger:  logger,
+	}
+}
+
+func (s *healthService) Liveness(ctx context.Context) *Report {
+	var checks []Check
+	for _, check := range s.checks {
+		if check.Liveness {
+			checks = append(checks, check)
+		}
+	}
+	return s.run(ctx, checks)
+}
+
+func (s *healthService) Readiness(ctx context.Context) *Report {
+	var checks []Check
+	for _, check := range s.checks {
+		if !check.Diagnostic {
+			checks = append(checks, check)
+		}
+	}
+	return s.run(ctx, checks)
+}
+
+func (s *healthService) Diagnostics(ctx context.Context) *Report {
+	return s.run(ctx, s.checks)
+}
+
+func (s *healthService) run(ctx context.Context, checks []Check) *Report {
+	results := make([]CheckResult, len(checks))
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = s.runCheck(ctx, check)
+		}()
+	}
+	wg.Wait()
+
+	report := &Report{Status: StatusOK, Checks: make(map[string]CheckResult, len(checks))}
+	for i, check := range checks {
+		result := results[i]
+		report.Checks[check.Name] = result
+		if result.Status == StatusOK {
+			continue
+		}
+		s.logger.Warn("Health check failed", "check", check.Name, "optional", check.Optional, "message", result.Message)
+		switch {
+		case !check.Optional:
+			report.Status = StatusFailed
+		case report.Status == StatusOK:
+			report.Status = StatusDegraded
+		}
+	}
+	return report
+}
+
+func (s *healthService) runCheck(ctx context.Context, check Check) CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	start := time.Now()
+	errCh := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				errCh <- fmt.Errorf("check panicked: %v", r)
+			}
+		}()
+		errCh <- check.Run(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-errCh:
+	case <-ctx.Done():
+		err = fmt.Errorf("timed out after %s", s.timeout)
+	}
+
+	result := CheckResult{Status: StatusOK, Duration: time.Since(start)}
+	if err != nil {
+		result.Status = StatusFailed
+		result.Message = err.Error()
+	}
+	return result
+}
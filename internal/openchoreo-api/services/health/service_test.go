@@ -0,0 +1,106 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func staticCheck(name string, err error) Check {
+	return Check{Name: name, Run: func(context.Context) error { return err }}
+}
+
+func TestService_Readiness(t *testing.T) {
+	optionalFailure := staticCheck("optional", errors.New("unreachable"))
+	optionalFailure.Optional = true
+
+	tests := []struct {
+		name       string
+		checks     []Check
+		wantStatus Status
+	}{
+		{
+			name:       "no checks",
+			wantStatus: StatusOK,
+		},
+		{
+			name:       "all checks pass",
+			checks:     []Check{staticCheck("a", nil), staticCheck("b", nil)},
+			wantStatus: StatusOK,
+		},
+		{
+			name:       "optional check fails",
+			checks:     []Check{staticCheck("a", nil), optionalFailure},
+			wantStatus: StatusDegraded,
+		},
+		{
+			name:       "required check fails",
+			checks:     []Check{optionalFailure, staticCheck("a", errors.New("down"))},
+			wantStatus: StatusFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := NewService(tt.checks, time.Second, testutil.TestLogger()).Readiness(context.Background())
+			assert.Equal(t, tt.wantStatus, report.Status)
+			assert.Len(t, report.Checks, len(tt.checks))
+		})
+	}
+}
+
+func TestService_LivenessRunsLivenessChecks(t *testing.T) {
+	live := staticCheck("live", nil)
+	live.Liveness = true
+	svc := NewService([]Check{live, staticCheck("ready-only", errors.New("down"))}, time.Second, testutil.TestLogger())
+
+	report := svc.Liveness(context.Background())
+	assert.Equal(t, StatusOK, report.Status)
+	assert.Equal(t, []string{"live"}, checkNames(report))
+}
+
+func TestService_DiagnosticChecksOnlyInDiagnostics(t *testing.T) {
+	diagnostic := staticCheck("diagnostic", errors.New("expiring"))
+	diagnostic.Diagnostic = true
+	diagnostic.Optional = true
+	svc := NewService([]Check{staticCheck("ready", nil), diagnostic}, time.Second, testutil.TestLogger())
+
+	report := svc.Readiness(context.Background())
+	assert.Equal(t, StatusOK, report.Status)
+	assert.Equal(t, []string{"ready"}, checkNames(report))
+
+	report = svc.Diagnostics(context.Background())
+	assert.Equal(t, StatusDegraded, report.Status)
+	assert.ElementsMatch(t, []string{"ready", "diagnostic"}, checkNames(report))
+	assert.Equal(t, "expiring", report.Checks["diagnostic"].Message)
+}
+
+func TestService_CheckTimeoutAndPanic(t *testing.T) {
+	slow := Check{Name: "slow", Run: func(context.Context) error {
+		time.Sleep(time.Second)
+		return nil
+	}}
+	panicking := Check{Name: "panicking", Run: func(context.Context) error { panic("boom") }}
+	svc := NewService([]Check{slow, panicking}, 20*time.Millisecond, testutil.TestLogger())
+
+	report := svc.Readiness(context.Background())
+	require.Equal(t, StatusFailed, report.Status)
+	assert.Equal(t, "timed out after 20ms", report.Checks["slow"].Message)
+	assert.Equal(t, "check panicked: boom", report.Checks["panicking"].Message)
+}
+
+func checkNames(report *Report) []string {
+	var names []string
+	for name := range report.Checks {
+		names = append(names, name)
+	}
+	return names
+}
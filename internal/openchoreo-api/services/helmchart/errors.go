@@ -0,0 +1,11 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package helmchart
+
+import "errors"
+
+var (
+	ErrDeploymentNotFound = errors.New("component is not deployed to the environment")
+	ErrNotRendered        = errors.New("deployment has no rendered resources yet")
+)
@@ -0,0 +1,31 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package helmchart
+
+import (
+	"context"
+)
+
+// Chart is a packaged Helm chart of a component's deployment to an environment.
+type Chart struct {
+	// Name is the chart name, which is the component name.
+	Name string
+	// Version is the chart version.
+	Version string
+	// AppVersion is the component release the chart was generated from.
+	AppVersion string
+	// FileName is the conventional name of the archive, <name>-<version>.tgz.
+	FileName string
+	// Archive is the gzipped tar of the chart directory.
+	Archive []byte
+}
+
+// Service defines the Helm chart generation service interface.
+// Both the core service (no authz) and the authz-wrapped service implement this.
+type Service interface {
+	// GenerateHelmChart packages the resources rendered for a component in an
+	// environment as a standalone Helm chart. The namespace of the resources
+	// is a chart value, so that the chart can be installed outside OpenChoreo.
+	GenerateHelmChart(ctx context.Context, namespaceName, componentName, environment string) (*Chart, error)
+}
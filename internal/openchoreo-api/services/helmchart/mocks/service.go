@@ -0,0 +1,98 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	helmchart "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/helmchart"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// GenerateHelmChart provides a mock function with given fields: ctx, namespaceName, componentName, environment
+func (_m *MockService) GenerateHelmChart(ctx context.Context, namespaceName string, componentName string, environment string) (*helmchart.Chart, error) {
+	ret := _m.Called(ctx, namespaceName, componentName, environment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateHelmChart")
+	}
+
+	var r0 *helmchart.Chart
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*helmchart.Chart, error)); ok {
+		return rf(ctx, namespaceName, componentName, environment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *helmchart.Chart); ok {
+		r0 = rf(ctx, namespaceName, componentName, environment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*helmchart.Chart)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, componentName, environment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GenerateHelmChart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateHelmChart'
+type MockService_GenerateHelmChart_Call struct {
+	*mock.Call
+}
+
+// GenerateHelmChart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - componentName string
+//   - environment string
+func (_e *MockService_Expecter) GenerateHelmChart(ctx interface{}, namespaceName interface{}, componentName interface{}, environment interface{}) *MockService_GenerateHelmChart_Call {
+	return &MockService_GenerateHelmChart_Call{Call: _e.mock.On("GenerateHelmChart", ctx, namespaceName, componentName, environment)}
+}
+
+func (_c *MockService_GenerateHelmChart_Call) Run(run func(ctx context.Context, namespaceName string, componentName string, environment string)) *MockService_GenerateHelmChart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GenerateHelmChart_Call) Return(_a0 *helmchart.Chart, _a1 error) *MockService_GenerateHelmChart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GenerateHelmChart_Call) RunAndReturn(run func(context.Context, string, string, string) (*helmchart.Chart, error)) *MockService_GenerateHelmChart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,273 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package helmchart
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"log/slog"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+)
+
+const (
+	// chartVersion is the version of generated charts. The component release
+	// is recorded as the app version.
+	chartVersion = "0.1.0"
+	// namespacePlaceholder marks the namespace fields replaced with the
+	// namespace value of the chart.
+	namespacePlaceholder = "__openchoreo_chart_namespace__"
+)
+
+// helmChartService handles Helm chart generation without authorization checks.
+type helmChartService struct {
+	k8sClient        client.Client
+	componentService componentsvc.Service
+	logger           *slog.Logger
+}
+
+var _ Service = (*helmChartService)(nil)
+
+// NewService creates a new Helm chart service without authorization.
+func NewService(k8sClient client.Client, logger *slog.Logger) Service {
+	return &helmChartService{
+		k8sClient:        k8sClient,
+		componentService: componentsvc.NewService(k8sClient, logger.With("component", "component-service-internal")),
+		logger:           logger,
+	}
+}
+
+func (s *helmChartService) GenerateHelmChart(ctx context.Context, namespaceName, componentName, environment string) (*Chart, error) {
+	s.logger.Debug("Generating Helm chart", "namespace", namespaceName, "component", componentName, "environment", environment)
+
+	comp, err := s.componentService.GetComponent(ctx, namespaceName, componentName)
+	if err != nil {
+		return nil, err
+	}
+
+	binding, err := s.findReleaseBinding(ctx, namespaceName, componentName, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	objects, err := s.renderedObjects(ctx, binding)
+	if err != nil {
+		return nil, err
+	}
+	if len(objects) == 0 {
+		return nil, ErrNotRendered
+	}
+
+	files, err := buildChart(comp, binding, objects)
+	if err != nil {
+		return nil, err
+	}
+	archive, err := archiveChart(comp.Name, files)
+	if err != nil {
+		return nil, fmt.Errorf("failed to package chart: %w", err)
+	}
+
+	return &Chart{
+		Name:       comp.Name,
+		Version:    chartVersion,
+		AppVersion: binding.Spec.ReleaseName,
+		FileName:   fmt.Sprintf("%s-%s.tgz", comp.Name, chartVersion),
+		Archive:    archive,
+	}, nil
+}
+
+// findReleaseBinding finds the release binding of a component for an environment.
+func (s *helmChartService) findReleaseBinding(ctx context.Context, namespaceName, componentName, environment string) (*openchoreov1alpha1.ReleaseBinding, error) {
+	bindingList := &openchoreov1alpha1.ReleaseBindingList{}
+	if err := s.k8sClient.List(ctx, bindingList, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list release bindings", "error", err)
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+
+	for i := range bindingList.Items {
+		rb := &bindingList.Items[i]
+		if rb.Spec.Owner.ComponentName == componentName && rb.Spec.Environment == environment {
+			return rb, nil
+		}
+	}
+
+	return nil, ErrDeploymentNotFound
+}
+
+// renderedObjects returns the data plane resources rendered for the release
+// binding, ordered by rendered release and resource ID. Resources of other
+// planes, such as alert rules for the observability plane, are left out.
+func (s *helmChartService) renderedObjects(ctx context.Context, binding *openchoreov1alpha1.ReleaseBinding) ([]*unstructured.Unstructured, error) {
+	var releaseList openchoreov1alpha1.RenderedReleaseList
+	if err := s.k8sClient.List(ctx, &releaseList, client.InNamespace(binding.Namespace)); err != nil {
+		s.logger.Error("Failed to list rendered releases", "error", err)
+		return nil, fmt.Errorf("failed to list rendered releases: %w", err)
+	}
+
+	releases := make([]*openchoreov1alpha1.RenderedRelease, 0, len(releaseList.Items))
+	for i := range releaseList.Items {
+		release := &releaseList.Items[i]
+		if !metav1.IsControlledBy(release, binding) {
+			continue
+		}
+		if plane := release.Spec.TargetPlane; plane != "" && plane != openchoreov1alpha1.TargetPlaneDataPlane {
+			s.logger.Debug("Skipping rendered release of another plane", "release", release.Name, "targetPlane", plane)
+			continue
+		}
+		releases = append(releases, release)
+	}
+	sort.Slice(releases, func(i, j int) bool { return releases[i].Name < releases[j].Name })
+
+	var objects []*unstructured.Unstructured
+	for _, release := range releases {
+		resources := release.Spec.Resources
+		sort.SliceStable(resources, func(i, j int) bool { return resources[i].ID < resources[j].ID })
+		for _, resource := range resources {
+			if resource.Object == nil || len(resource.Object.Raw) == 0 {
+				continue
+			}
+			obj := &unstructured.Unstructured{}
+			if err := obj.UnmarshalJSON(resource.Object.Raw); err != nil {
+				return nil, fmt.Errorf("failed to decode resource %q of rendered release %q: %w", resource.ID, release.Name, err)
+			}
+			objects = append(objects, obj)
+		}
+	}
+	return objects, nil
+}
+
+// buildChart returns the files of the chart directory, keyed by their path
+// relative to it.
+func buildChart(comp *openchoreov1alpha1.Component, binding *openchoreov1alpha1.ReleaseBinding,
+	objects []*unstructured.Unstructured) (map[string][]byte, error) {
+	namespace := chartNamespace(objects)
+
+	chartYAML, err := yaml.Marshal(map[string]any{
+		"apiVersion":  "v2",
+		"name":        comp.Name,
+		"description": fmt.Sprintf("%s deployed to %s, exported from OpenChoreo", comp.Name, binding.Spec.Environment),
+		"type":        "application",
+		"version":     chartVersion,
+		"appVersion":  binding.Spec.ReleaseName,
+		"annotations": map[string]string{
+			"openchoreo.dev/namespace":   comp.Namespace,
+			"openchoreo.dev/project":     comp.Spec.Owner.ProjectName,
+			"openchoreo.dev/component":   comp.Name,
+			"openchoreo.dev/environment": binding.Spec.Environment,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Chart.yaml: %w", err)
+	}
+	valuesYAML, err := yaml.Marshal(map[string]any{"namespace": namespace})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal values.yaml: %w", err)
+	}
+
+	files := map[string][]byte{
+		"Chart.yaml": chartYAML,
+		"values.yaml": append([]byte("# Namespace the resources are installed to. Defaults to the namespace they\n"+
+			"# were deployed to by OpenChoreo.\n"), valuesYAML...),
+	}
+	for _, obj := range objects {
+		template, err := renderTemplate(obj, namespace)
+		if err != nil {
+			return nil, err
+		}
+		base := strings.ToLower(obj.GetKind() + "-" + obj.GetName())
+		name := path.Join("templates", base+".yaml")
+		for i := 2; files[name] != nil; i++ {
+			name = path.Join("templates", fmt.Sprintf("%s-%d.yaml", base, i))
+		}
+		files[name] = template
+	}
+	return files, nil
+}
+
+// chartNamespace returns the namespace most of the resources were deployed to.
+func chartNamespace(objects []*unstructured.Unstructured) string {
+	counts := map[string]int{}
+	best := ""
+	for _, obj := range objects {
+		ns := obj.GetNamespace()
+		if ns == "" {
+			continue
+		}
+		counts[ns]++
+		if counts[ns] > counts[best] || (counts[ns] == counts[best] && ns < best) {
+			best = ns
+		}
+	}
+	return best
+}
+
+// renderTemplate returns the manifest of obj as a Helm template. Template
+// delimiters in the manifest are escaped, and its namespace is replaced with
+// the namespace value when it is the chart namespace.
+func renderTemplate(obj *unstructured.Unstructured, namespace string) ([]byte, error) {
+	obj = obj.DeepCopy()
+	templated := namespace != "" && obj.GetNamespace() == namespace
+	if templated {
+		obj.SetNamespace(namespacePlaceholder)
+	}
+	data, err := yaml.Marshal(obj.Object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s %q: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	out := strings.ReplaceAll(string(data), "{{", `{{ "{{" }}`)
+	if templated {
+		out = strings.Replace(out, "namespace: "+namespacePlaceholder, "namespace: {{ .Values.namespace | quote }}", 1)
+	}
+	return []byte(out), nil
+}
+
+// archiveChart packages the chart files as a gzipped tar with the files under
+// a directory named after the chart, as helm package does. Entries are sorted
+// and carry no timestamps, so the same chart always yields the same archive.
+func archiveChart(name string, files map[string][]byte) ([]byte, error) {
+	paths := make([]string, 0, len(files))
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, p := range paths {
+		content := files[p]
+		if err := tw.WriteHeader(&tar.Header{
+			Name:     path.Join(name, p),
+			Mode:     0o644,
+			Size:     int64(len(content)),
+			ModTime:  time.Unix(0, 0),
+			Typeflag: tar.TypeReg,
+		}); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write(content); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package helmchart
+
+import (
+	"context"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+)
+
+const resourceTypeReleaseBinding = "releasebinding"
+
+// helmChartServiceWithAuthz wraps a Service and adds authorization checks.
+// Handlers should use this. Other services should use the unwrapped Service directly.
+type helmChartServiceWithAuthz struct {
+	internal         Service
+	componentService componentsvc.Service
+	authz            *services.AuthzChecker
+}
+
+var _ Service = (*helmChartServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates a Helm chart service with authorization checks.
+func NewServiceWithAuthz(k8sClient client.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &helmChartServiceWithAuthz{
+		internal:         NewService(k8sClient, logger),
+		componentService: componentsvc.NewService(k8sClient, logger.With("component", "component-service-internal")),
+		authz:            services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+// GenerateHelmChart requires permission to view the release binding of the
+// environment, which holds the resources the chart is generated from.
+func (s *helmChartServiceWithAuthz) GenerateHelmChart(ctx context.Context, namespaceName, componentName, environment string) (*Chart, error) {
+	// Fetch first to get the project for authz hierarchy
+	comp, err := s.componentService.GetComponent(ctx, namespaceName, componentName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewReleaseBinding,
+		ResourceType: resourceTypeReleaseBinding,
+		ResourceID:   componentName,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   comp.Spec.Owner.ProjectName,
+			Component: componentName,
+		},
+		Context: authz.Context{
+			Resource: authz.ResourceAttribute{
+				Environment: services.FormatDualScopedResourceName(namespaceName, environment, false)},
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.GenerateHelmChart(ctx, namespaceName, componentName, environment)
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package helmchart
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+// mockService is a local testify mock for the Service interface.
+// It exists here instead of importing helmchart/mocks to avoid a cyclic import
+type mockService struct {
+	mock.Mock
+}
+
+func newMockService(t *testing.T) *mockService {
+	m := &mockService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *mockService) GenerateHelmChart(ctx context.Context, namespaceName, componentName, environment string) (*Chart, error) {
+	args := m.Called(ctx, namespaceName, componentName, environment)
+	res, _ := args.Get(0).(*Chart)
+	return res, args.Error(1)
+}
+
+func newAuthzService(internal Service, pdp authzcore.PDP) *helmChartServiceWithAuthz {
+	k8sClient := testutil.NewFakeClient(testutil.NewComponent(testNamespace, testProjectName, testComponentName))
+	return &helmChartServiceWithAuthz{
+		internal:         internal,
+		componentService: componentsvc.NewService(k8sClient, testutil.TestLogger()),
+		authz:            testutil.NewTestAuthzChecker(pdp),
+	}
+}
+
+func TestGenerateHelmChart_AuthzCheck(t *testing.T) {
+	hierarchy := authzcore.ResourceHierarchy{Namespace: testNamespace, Project: testProjectName, Component: testComponentName}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GenerateHelmChart", mock.Anything, testNamespace, testComponentName, "dev").Return(&Chart{Name: testComponentName}, nil)
+
+		chart, err := newAuthzService(mockSvc, pdp).GenerateHelmChart(testutil.AuthzContext(), testNamespace, testComponentName, "dev")
+		require.NoError(t, err)
+		require.Equal(t, testComponentName, chart.Name)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "releasebinding:view", "releasebinding", testComponentName, hierarchy)
+		require.Equal(t, "test-ns/dev", pdp.Captured[0].Context.Resource.Environment)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		mockSvc := newMockService(t)
+		_, err := newAuthzService(mockSvc, testutil.DenyPDP()).GenerateHelmChart(testutil.AuthzContext(), testNamespace, testComponentName, "dev")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+
+	t.Run("component not found", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		_, err := newAuthzService(mockSvc, pdp).GenerateHelmChart(testutil.AuthzContext(), testNamespace, "missing", "dev")
+		require.ErrorIs(t, err, componentsvc.ErrComponentNotFound)
+		require.Empty(t, pdp.Captured)
+	})
+}
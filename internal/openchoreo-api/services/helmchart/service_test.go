@@ -0,0 +1,170 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package helmchart
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+const (
+	testNamespace     = "test-ns"
+	testProjectName   = "test-project"
+	testComponentName = "orders"
+	testDataPlaneNS   = "dp-test-ns-test-project-dev-1a2b3c"
+)
+
+func newBinding() *openchoreov1alpha1.ReleaseBinding {
+	rb := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, "dev", "orders-dev")
+	rb.UID = types.UID("binding-uid")
+	rb.Spec.ReleaseName = "orders-5d7f9c"
+	return rb
+}
+
+func newRenderedRelease(rb *openchoreov1alpha1.ReleaseBinding, name, targetPlane string, objects ...string) *openchoreov1alpha1.RenderedRelease {
+	release := &openchoreov1alpha1.RenderedRelease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespace,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: openchoreov1alpha1.GroupVersion.String(),
+				Kind:       "ReleaseBinding",
+				Name:       rb.Name,
+				UID:        rb.UID,
+				Controller: ptr.To(true),
+			}},
+		},
+		Spec: openchoreov1alpha1.RenderedReleaseSpec{TargetPlane: targetPlane, EnvironmentName: "dev"},
+	}
+	for i, obj := range objects {
+		release.Spec.Resources = append(release.Spec.Resources, openchoreov1alpha1.RenderedManifest{
+			ID:     string(rune('a' + i)),
+			Object: &runtime.RawExtension{Raw: []byte(obj)},
+		})
+	}
+	return release
+}
+
+const (
+	deploymentJSON = `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"orders","namespace":"` + testDataPlaneNS +
+		`","annotations":{"note":"{{ not a template }}"}},"spec":{"replicas":2}}`
+	serviceJSON = `{"apiVersion":"v1","kind":"Service","metadata":{"name":"orders","namespace":"` + testDataPlaneNS +
+		`"},"spec":{"ports":[{"port":8080}]}}`
+	routeJSON = `{"apiVersion":"gateway.networking.k8s.io/v1","kind":"HTTPRoute","metadata":{"name":"orders","namespace":"` +
+		testDataPlaneNS + `"},"spec":{"parentRefs":[{"name":"gateway-default","namespace":"openchoreo-data-plane"}]}}`
+	alertJSON = `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"alert-rules","namespace":"openchoreo-observability-plane"}}`
+)
+
+func newService(objects ...client.Object) *helmChartService {
+	k8sClient := testutil.NewFakeClient(objects...)
+	return &helmChartService{
+		k8sClient:        k8sClient,
+		componentService: componentsvc.NewService(k8sClient, testutil.TestLogger()),
+		logger:           testutil.TestLogger(),
+	}
+}
+
+func readArchive(t *testing.T, archive []byte) map[string]string {
+	t.Helper()
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+	files := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[hdr.Name] = string(content)
+	}
+	return files
+}
+
+func TestGenerateHelmChart(t *testing.T) {
+	rb := newBinding()
+	svc := newService(
+		testutil.NewComponent(testNamespace, testProjectName, testComponentName),
+		rb,
+		newRenderedRelease(rb, "orders-dev", "", deploymentJSON, serviceJSON, routeJSON),
+		newRenderedRelease(rb, "orders-dev-observability", openchoreov1alpha1.TargetPlaneObservabilityPlane, alertJSON),
+	)
+
+	chart, err := svc.GenerateHelmChart(t.Context(), testNamespace, testComponentName, "dev")
+	require.NoError(t, err)
+	assert.Equal(t, "orders", chart.Name)
+	assert.Equal(t, "0.1.0", chart.Version)
+	assert.Equal(t, "orders-5d7f9c", chart.AppVersion)
+	assert.Equal(t, "orders-0.1.0.tgz", chart.FileName)
+
+	files := readArchive(t, chart.Archive)
+	assert.ElementsMatch(t, []string{
+		"orders/Chart.yaml",
+		"orders/values.yaml",
+		"orders/templates/deployment-orders.yaml",
+		"orders/templates/service-orders.yaml",
+		"orders/templates/httproute-orders.yaml",
+	}, slices.Collect(maps.Keys(files)))
+
+	assert.Contains(t, files["orders/Chart.yaml"], "apiVersion: v2\n")
+	assert.Contains(t, files["orders/Chart.yaml"], "appVersion: orders-5d7f9c\n")
+	assert.Contains(t, files["orders/Chart.yaml"], "openchoreo.dev/environment: dev\n")
+	assert.Contains(t, files["orders/values.yaml"], "namespace: "+testDataPlaneNS+"\n")
+
+	assert.Equal(t, `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  annotations:
+    note: '{{ "{{" }} not a template }}'
+  name: orders
+  namespace: {{ .Values.namespace | quote }}
+spec:
+  replicas: 2
+`, files["orders/templates/deployment-orders.yaml"])
+	assert.Contains(t, files["orders/templates/httproute-orders.yaml"], "namespace: openchoreo-data-plane\n")
+
+	again, err := svc.GenerateHelmChart(t.Context(), testNamespace, testComponentName, "dev")
+	require.NoError(t, err)
+	assert.Equal(t, chart.Archive, again.Archive, "generation is deterministic")
+}
+
+func TestGenerateHelmChart_Errors(t *testing.T) {
+	rb := newBinding()
+
+	t.Run("component not found", func(t *testing.T) {
+		_, err := newService().GenerateHelmChart(t.Context(), testNamespace, testComponentName, "dev")
+		require.ErrorIs(t, err, componentsvc.ErrComponentNotFound)
+	})
+
+	t.Run("not deployed", func(t *testing.T) {
+		svc := newService(testutil.NewComponent(testNamespace, testProjectName, testComponentName), rb)
+		_, err := svc.GenerateHelmChart(t.Context(), testNamespace, testComponentName, "prod")
+		require.ErrorIs(t, err, ErrDeploymentNotFound)
+	})
+
+	t.Run("not rendered", func(t *testing.T) {
+		svc := newService(testutil.NewComponent(testNamespace, testProjectName, testComponentName), rb)
+		_, err := svc.GenerateHelmChart(t.Context(), testNamespace, testComponentName, "dev")
+		require.ErrorIs(t, err, ErrNotRendered)
+	})
+}
@@ -0,0 +1,8 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kindschema
+
+import "errors"
+
+var ErrKindNotFound = errors.New("kind not found")
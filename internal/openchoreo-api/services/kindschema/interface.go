@@ -0,0 +1,56 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kindschema
+
+import (
+	"context"
+
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// Field is a field of a kind, flattened from the OpenAPI schema of its CRD.
+type Field struct {
+	// Path is the dot-separated path of the field from the root of an object,
+	// such as spec.owner.projectName. The fields of array items and map values
+	// are addressed through the path of the array or map.
+	Path string
+	// Type is the type of the field: string, integer, number, boolean,
+	// Object, IntOrString, or an array ([]T) or map (map[string]T) of those.
+	Type        string
+	Description string
+	// Required reports whether the parent object requires the field.
+	Required bool
+	Enum     []string
+	// Default is the JSON encoding of the default value, empty when there is none.
+	Default string
+}
+
+// KindFields describes the fields of a kind.
+type KindFields struct {
+	Kind        string
+	Group       string
+	Version     string
+	Description string
+	// Fields holds every field of the kind in depth-first order, with the
+	// fields of an object sorted by name.
+	Fields []Field
+}
+
+// KindSchema is the OpenAPI schema of the storage version of a kind, as
+// declared by its CRD.
+type KindSchema struct {
+	Kind    string
+	Group   string
+	Version string
+	Schema  *extv1.JSONSchemaProps
+}
+
+// Service resolves the field documentation and schemas of OpenChoreo kinds.
+type Service interface {
+	// GetFields returns the fields of a kind. kind matches the kind, plural,
+	// singular or a short name of a CRD, ignoring case.
+	GetFields(ctx context.Context, kind string) (*KindFields, error)
+	// ListSchemas returns the schema of every kind, sorted by kind.
+	ListSchemas(ctx context.Context) ([]*KindSchema, error)
+}
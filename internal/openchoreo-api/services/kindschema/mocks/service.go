@@ -0,0 +1,154 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	kindschema "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/kindschema"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// GetFields provides a mock function with given fields: ctx, kind
+func (_m *MockService) GetFields(ctx context.Context, kind string) (*kindschema.KindFields, error) {
+	ret := _m.Called(ctx, kind)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFields")
+	}
+
+	var r0 *kindschema.KindFields
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*kindschema.KindFields, error)); ok {
+		return rf(ctx, kind)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *kindschema.KindFields); ok {
+		r0 = rf(ctx, kind)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kindschema.KindFields)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetFields_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFields'
+type MockService_GetFields_Call struct {
+	*mock.Call
+}
+
+// GetFields is a helper method to define mock.On call
+//   - ctx context.Context
+//   - kind string
+func (_e *MockService_Expecter) GetFields(ctx interface{}, kind interface{}) *MockService_GetFields_Call {
+	return &MockService_GetFields_Call{Call: _e.mock.On("GetFields", ctx, kind)}
+}
+
+func (_c *MockService_GetFields_Call) Run(run func(ctx context.Context, kind string)) *MockService_GetFields_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetFields_Call) Return(_a0 *kindschema.KindFields, _a1 error) *MockService_GetFields_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetFields_Call) RunAndReturn(run func(context.Context, string) (*kindschema.KindFields, error)) *MockService_GetFields_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSchemas provides a mock function with given fields: ctx
+func (_m *MockService) ListSchemas(ctx context.Context) ([]*kindschema.KindSchema, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSchemas")
+	}
+
+	var r0 []*kindschema.KindSchema
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*kindschema.KindSchema, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*kindschema.KindSchema); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*kindschema.KindSchema)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListSchemas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSchemas'
+type MockService_ListSchemas_Call struct {
+	*mock.Call
+}
+
+// ListSchemas is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockService_Expecter) ListSchemas(ctx interface{}) *MockService_ListSchemas_Call {
+	return &MockService_ListSchemas_Call{Call: _e.mock.On("ListSchemas", ctx)}
+}
+
+func (_c *MockService_ListSchemas_Call) Run(run func(ctx context.Context)) *MockService_ListSchemas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockService_ListSchemas_Call) Return(_a0 []*kindschema.KindSchema, _a1 error) *MockService_ListSchemas_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListSchemas_Call) RunAndReturn(run func(context.Context) ([]*kindschema.KindSchema, error)) *MockService_ListSchemas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
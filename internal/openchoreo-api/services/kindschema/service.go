@@ -0,0 +1,253 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kindschema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/yaml"
+
+	crdefs "github.com/openchoreo/openchoreo/config/crd"
+)
+
+// kindSchemaService reads the field schemas from the CRDs embedded in the
+// binary, so the documentation always matches the API version being served.
+type kindSchemaService struct {
+	crds   fs.FS
+	logger *slog.Logger
+
+	once  sync.Once
+	kinds []*kindEntry
+	err   error
+}
+
+// kindEntry is a kind with the names it can be referred to by.
+type kindEntry struct {
+	names  extv1.CustomResourceDefinitionNames
+	fields *KindFields
+	schema *KindSchema
+}
+
+var _ Service = (*kindSchemaService)(nil)
+
+// NewService creates a kind schema service for the embedded OpenChoreo CRDs.
+// CRD schemas are public API metadata and need no authorization.
+func NewService(logger *slog.Logger) Service {
+	return newService(crdefs.FS, logger)
+}
+
+func newService(crds fs.FS, logger *slog.Logger) *kindSchemaService {
+	return &kindSchemaService{crds: crds, logger: logger}
+}
+
+func (s *kindSchemaService) GetFields(_ context.Context, kind string) (*KindFields, error) {
+	kinds, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range kinds {
+		if matchesKind(k.names, kind) {
+			return k.fields, nil
+		}
+	}
+	return nil, ErrKindNotFound
+}
+
+func (s *kindSchemaService) ListSchemas(_ context.Context) ([]*KindSchema, error) {
+	kinds, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	schemas := make([]*KindSchema, 0, len(kinds))
+	for _, k := range kinds {
+		if k.schema != nil {
+			schemas = append(schemas, k.schema)
+		}
+	}
+	slices.SortFunc(schemas, func(a, b *KindSchema) int { return strings.Compare(a.Kind, b.Kind) })
+	return schemas, nil
+}
+
+// load parses the CRDs once and returns the kinds they define.
+func (s *kindSchemaService) load() ([]*kindEntry, error) {
+	s.once.Do(func() {
+		s.kinds, s.err = loadKinds(s.crds)
+	})
+	if s.err != nil {
+		s.logger.Error("Failed to load CRDs", "error", s.err)
+		return nil, s.err
+	}
+	return s.kinds, nil
+}
+
+// matchesKind reports whether name refers to the kind with the given names.
+func matchesKind(names extv1.CustomResourceDefinitionNames, name string) bool {
+	if strings.EqualFold(names.Kind, name) || strings.EqualFold(names.Plural, name) || strings.EqualFold(names.Singular, name) {
+		return true
+	}
+	for _, short := range names.ShortNames {
+		if strings.EqualFold(short, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// loadKinds parses every CRD under bases/ of crds.
+func loadKinds(crds fs.FS) ([]*kindEntry, error) {
+	paths, err := fs.Glob(crds, "bases/*.yaml")
+	if err != nil {
+		return nil, err
+	}
+
+	kinds := make([]*kindEntry, 0, len(paths))
+	for _, path := range paths {
+		data, err := fs.ReadFile(crds, path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		var crd extv1.CustomResourceDefinition
+		if err := yaml.Unmarshal(data, &crd); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		kinds = append(kinds, &kindEntry{names: crd.Spec.Names, fields: toKindFields(&crd), schema: toKindSchema(&crd)})
+	}
+	return kinds, nil
+}
+
+// toKindFields flattens the schema of the storage version of crd.
+func toKindFields(crd *extv1.CustomResourceDefinition) *KindFields {
+	kf := &KindFields{
+		Kind:   crd.Spec.Names.Kind,
+		Group:  crd.Spec.Group,
+		Fields: []Field{},
+	}
+	for _, version := range crd.Spec.Versions {
+		if !version.Storage {
+			continue
+		}
+		kf.Version = version.Name
+		if version.Schema != nil && version.Schema.OpenAPIV3Schema != nil {
+			kf.Description = version.Schema.OpenAPIV3Schema.Description
+			flattenFields("", version.Schema.OpenAPIV3Schema, &kf.Fields)
+		}
+	}
+	return kf
+}
+
+// toKindSchema returns the schema of the storage version of crd, or nil when
+// the version declares none.
+func toKindSchema(crd *extv1.CustomResourceDefinition) *KindSchema {
+	for _, version := range crd.Spec.Versions {
+		if version.Storage && version.Schema != nil && version.Schema.OpenAPIV3Schema != nil {
+			return &KindSchema{
+				Kind:    crd.Spec.Names.Kind,
+				Group:   crd.Spec.Group,
+				Version: version.Name,
+				Schema:  version.Schema.OpenAPIV3Schema,
+			}
+		}
+	}
+	return nil
+}
+
+// flattenFields appends the fields of the object schema to fields, each
+// followed by its own fields.
+func flattenFields(prefix string, schema *extv1.JSONSchemaProps, fields *[]Field) {
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		prop := schema.Properties[name]
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+		*fields = append(*fields, Field{
+			Path:        path,
+			Type:        typeName(&prop),
+			Description: prop.Description,
+			Required:    slices.Contains(schema.Required, name),
+			Enum:        enumValues(prop.Enum),
+			Default:     defaultValue(prop.Default),
+		})
+		if nested := nestedObject(&prop); nested != nil {
+			flattenFields(path, nested, fields)
+		}
+	}
+}
+
+// nestedObject returns the schema whose properties are the fields under prop:
+// prop itself, the items of an array or the values of a map. It returns nil
+// when there are no such fields.
+func nestedObject(prop *extv1.JSONSchemaProps) *extv1.JSONSchemaProps {
+	switch {
+	case len(prop.Properties) > 0:
+		return prop
+	case prop.Type == "array" && prop.Items != nil && prop.Items.Schema != nil:
+		return nestedObject(prop.Items.Schema)
+	case prop.AdditionalProperties != nil && prop.AdditionalProperties.Schema != nil:
+		return nestedObject(prop.AdditionalProperties.Schema)
+	}
+	return nil
+}
+
+// typeName returns the type of prop in the notation of kubectl explain.
+func typeName(prop *extv1.JSONSchemaProps) string {
+	switch prop.Type {
+	case "array":
+		if prop.Items != nil && prop.Items.Schema != nil {
+			return "[]" + typeName(prop.Items.Schema)
+		}
+		return "[]Object"
+	case "object":
+		if len(prop.Properties) == 0 && prop.AdditionalProperties != nil && prop.AdditionalProperties.Schema != nil {
+			return "map[string]" + typeName(prop.AdditionalProperties.Schema)
+		}
+		return "Object"
+	case "":
+		if prop.XIntOrString {
+			return "IntOrString"
+		}
+		return "Object"
+	}
+	return prop.Type
+}
+
+// enumValues returns the allowed values of a field, with strings unquoted.
+func enumValues(enum []extv1.JSON) []string {
+	if len(enum) == 0 {
+		return nil
+	}
+	values := make([]string, 0, len(enum))
+	for _, v := range enum {
+		var s string
+		if err := json.Unmarshal(v.Raw, &s); err == nil {
+			values = append(values, s)
+			continue
+		}
+		values = append(values, string(v.Raw))
+	}
+	return values
+}
+
+func defaultValue(def *extv1.JSON) string {
+	if def == nil {
+		return ""
+	}
+	return string(def.Raw)
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kindschema
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const widgetCRD = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: widgets.openchoreo.dev
+spec:
+  group: openchoreo.dev
+  names:
+    kind: Widget
+    plural: widgets
+    singular: widget
+    shortNames: [wdg]
+  scope: Namespaced
+  versions:
+  - name: v1alpha1
+    served: true
+    storage: true
+    schema:
+      openAPIV3Schema:
+        description: Widget is a test kind.
+        type: object
+        properties:
+          spec:
+            type: object
+            required: [size]
+            properties:
+              size:
+                type: integer
+                description: Size of the widget.
+              mode:
+                type: string
+                enum: [fast, slow]
+                default: fast
+              port:
+                x-kubernetes-int-or-string: true
+              labels:
+                type: object
+                additionalProperties:
+                  type: string
+              parts:
+                type: array
+                items:
+                  type: object
+                  required: [name]
+                  properties:
+                    name:
+                      type: string
+`
+
+func TestGetFields(t *testing.T) {
+	svc := newService(fstest.MapFS{"bases/widgets.yaml": {Data: []byte(widgetCRD)}}, slog.Default())
+
+	for _, name := range []string{"Widget", "widgets", "WDG"} {
+		t.Run(name, func(t *testing.T) {
+			got, err := svc.GetFields(context.Background(), name)
+			require.NoError(t, err)
+			assert.Equal(t, "Widget", got.Kind)
+			assert.Equal(t, "openchoreo.dev", got.Group)
+			assert.Equal(t, "v1alpha1", got.Version)
+			assert.Equal(t, "Widget is a test kind.", got.Description)
+			assert.Equal(t, []Field{
+				{Path: "spec", Type: "Object"},
+				{Path: "spec.labels", Type: "map[string]string"},
+				{Path: "spec.mode", Type: "string", Enum: []string{"fast", "slow"}, Default: `"fast"`},
+				{Path: "spec.parts", Type: "[]Object"},
+				{Path: "spec.parts.name", Type: "string", Required: true},
+				{Path: "spec.port", Type: "IntOrString"},
+				{Path: "spec.size", Type: "integer", Description: "Size of the widget.", Required: true},
+			}, got.Fields)
+		})
+	}
+
+	t.Run("unknown kind", func(t *testing.T) {
+		_, err := svc.GetFields(context.Background(), "gadget")
+		assert.ErrorIs(t, err, ErrKindNotFound)
+	})
+}
+
+func TestGetFields_EmbeddedCRDs(t *testing.T) {
+	svc := NewService(slog.Default())
+
+	component, err := svc.GetFields(context.Background(), "component")
+	require.NoError(t, err)
+	assert.Equal(t, "Component", component.Kind)
+
+	paths := map[string]Field{}
+	for _, f := range component.Fields {
+		paths[f.Path] = f
+	}
+	require.Contains(t, paths, "spec.owner.projectName")
+	assert.True(t, paths["spec.owner.projectName"].Required)
+	assert.NotEmpty(t, paths["spec.workflow"].Description)
+}
+
+func TestListSchemas(t *testing.T) {
+	svc := newService(fstest.MapFS{"bases/widgets.yaml": {Data: []byte(widgetCRD)}}, slog.Default())
+
+	got, err := svc.ListSchemas(context.Background())
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "Widget", got[0].Kind)
+	assert.Equal(t, "openchoreo.dev", got[0].Group)
+	assert.Equal(t, "v1alpha1", got[0].Version)
+	require.NotNil(t, got[0].Schema)
+	assert.Equal(t, []string{"size"}, got[0].Schema.Properties["spec"].Required)
+}
@@ -0,0 +1,12 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package networkpolicy
+
+import "errors"
+
+var (
+	ErrNetworkPolicyRuleNil           = errors.New("network policy rule is nil")
+	ErrNetworkPolicyRuleNotFound      = errors.New("network policy rule not found")
+	ErrNetworkPolicyRuleAlreadyExists = errors.New("network policy rule already exists")
+)
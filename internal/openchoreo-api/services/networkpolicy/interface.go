@@ -0,0 +1,86 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package networkpolicy
+
+import (
+	"context"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// ComponentPolicies are the network policies rendered for a component in an environment.
+type ComponentPolicies struct {
+	ComponentName      string
+	ReleaseBindingName string
+	// Policies are the NetworkPolicy or CiliumNetworkPolicy manifests sent to the data plane.
+	// They are empty until the controller renders the component's release.
+	Policies []map[string]any
+}
+
+// ProjectPolicies are the network policies of every component of a project deployed to an environment.
+type ProjectPolicies struct {
+	ProjectName string
+	Environment string
+	Components  []ComponentPolicies
+}
+
+// Source is a caller of the allow matrix.
+type Source struct {
+	Project   string
+	Component string
+	// SystemComponent marks the platform gateways, which have no project or component.
+	SystemComponent bool
+}
+
+// Destination is a component endpoint of the allow matrix.
+type Destination struct {
+	Component string
+	Endpoint  string
+}
+
+// MatrixEntry tells whether a source may reach a destination, and why.
+type MatrixEntry struct {
+	Source      Source
+	Destination Destination
+	Allowed     bool
+	// Reason is one of the networkpolicy package Reason constants.
+	Reason string
+	// Rule is the NetworkPolicyRule that decided the entry, if any.
+	Rule string
+}
+
+// AllowMatrix is the reachability of a project's component endpoints in an environment from
+// every component deployed to that environment in the namespace, and from the platform gateways.
+type AllowMatrix struct {
+	ProjectName string
+	Environment string
+	Entries     []MatrixEntry
+}
+
+// MatrixFilter narrows the allow matrix. Empty fields match everything.
+type MatrixFilter struct {
+	SourceProject        string
+	SourceComponent      string
+	DestinationComponent string
+	Endpoint             string
+	// Allowed keeps only allowed or only denied entries when set.
+	Allowed *bool
+}
+
+// Service defines the network policy service interface.
+// Both the core service (no authz) and the authz-wrapped service implement this.
+type Service interface {
+	ListNetworkPolicyRules(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.NetworkPolicyRule], error)
+	GetNetworkPolicyRule(ctx context.Context, namespaceName, ruleName string) (*openchoreov1alpha1.NetworkPolicyRule, error)
+	CreateNetworkPolicyRule(ctx context.Context, namespaceName string, rule *openchoreov1alpha1.NetworkPolicyRule) (*openchoreov1alpha1.NetworkPolicyRule, error)
+	UpdateNetworkPolicyRule(ctx context.Context, namespaceName string, rule *openchoreov1alpha1.NetworkPolicyRule) (*openchoreov1alpha1.NetworkPolicyRule, error)
+	DeleteNetworkPolicyRule(ctx context.Context, namespaceName, ruleName string) error
+	// GetProjectPolicies returns the network policies rendered for the components of a project
+	// deployed to the environment.
+	GetProjectPolicies(ctx context.Context, namespaceName, projectName, environment string) (*ProjectPolicies, error)
+	// GetAllowMatrix evaluates the cell-based isolation and the NetworkPolicyRules of the
+	// namespace for every source and component endpoint of the project in the environment.
+	GetAllowMatrix(ctx context.Context, namespaceName, projectName, environment string, filter MatrixFilter) (*AllowMatrix, error)
+}
@@ -0,0 +1,453 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	networkpolicy "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/networkpolicy"
+
+	services "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+
+	v1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// CreateNetworkPolicyRule provides a mock function with given fields: ctx, namespaceName, rule
+func (_m *MockService) CreateNetworkPolicyRule(ctx context.Context, namespaceName string, rule *v1alpha1.NetworkPolicyRule) (*v1alpha1.NetworkPolicyRule, error) {
+	ret := _m.Called(ctx, namespaceName, rule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNetworkPolicyRule")
+	}
+
+	var r0 *v1alpha1.NetworkPolicyRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *v1alpha1.NetworkPolicyRule) (*v1alpha1.NetworkPolicyRule, error)); ok {
+		return rf(ctx, namespaceName, rule)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *v1alpha1.NetworkPolicyRule) *v1alpha1.NetworkPolicyRule); ok {
+		r0 = rf(ctx, namespaceName, rule)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.NetworkPolicyRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *v1alpha1.NetworkPolicyRule) error); ok {
+		r1 = rf(ctx, namespaceName, rule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_CreateNetworkPolicyRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNetworkPolicyRule'
+type MockService_CreateNetworkPolicyRule_Call struct {
+	*mock.Call
+}
+
+// CreateNetworkPolicyRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - rule *v1alpha1.NetworkPolicyRule
+func (_e *MockService_Expecter) CreateNetworkPolicyRule(ctx interface{}, namespaceName interface{}, rule interface{}) *MockService_CreateNetworkPolicyRule_Call {
+	return &MockService_CreateNetworkPolicyRule_Call{Call: _e.mock.On("CreateNetworkPolicyRule", ctx, namespaceName, rule)}
+}
+
+func (_c *MockService_CreateNetworkPolicyRule_Call) Run(run func(ctx context.Context, namespaceName string, rule *v1alpha1.NetworkPolicyRule)) *MockService_CreateNetworkPolicyRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*v1alpha1.NetworkPolicyRule))
+	})
+	return _c
+}
+
+func (_c *MockService_CreateNetworkPolicyRule_Call) Return(_a0 *v1alpha1.NetworkPolicyRule, _a1 error) *MockService_CreateNetworkPolicyRule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_CreateNetworkPolicyRule_Call) RunAndReturn(run func(context.Context, string, *v1alpha1.NetworkPolicyRule) (*v1alpha1.NetworkPolicyRule, error)) *MockService_CreateNetworkPolicyRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteNetworkPolicyRule provides a mock function with given fields: ctx, namespaceName, ruleName
+func (_m *MockService) DeleteNetworkPolicyRule(ctx context.Context, namespaceName string, ruleName string) error {
+	ret := _m.Called(ctx, namespaceName, ruleName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteNetworkPolicyRule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, namespaceName, ruleName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_DeleteNetworkPolicyRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteNetworkPolicyRule'
+type MockService_DeleteNetworkPolicyRule_Call struct {
+	*mock.Call
+}
+
+// DeleteNetworkPolicyRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - ruleName string
+func (_e *MockService_Expecter) DeleteNetworkPolicyRule(ctx interface{}, namespaceName interface{}, ruleName interface{}) *MockService_DeleteNetworkPolicyRule_Call {
+	return &MockService_DeleteNetworkPolicyRule_Call{Call: _e.mock.On("DeleteNetworkPolicyRule", ctx, namespaceName, ruleName)}
+}
+
+func (_c *MockService_DeleteNetworkPolicyRule_Call) Run(run func(ctx context.Context, namespaceName string, ruleName string)) *MockService_DeleteNetworkPolicyRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_DeleteNetworkPolicyRule_Call) Return(_a0 error) *MockService_DeleteNetworkPolicyRule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_DeleteNetworkPolicyRule_Call) RunAndReturn(run func(context.Context, string, string) error) *MockService_DeleteNetworkPolicyRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllowMatrix provides a mock function with given fields: ctx, namespaceName, projectName, environment, filter
+func (_m *MockService) GetAllowMatrix(ctx context.Context, namespaceName string, projectName string, environment string, filter networkpolicy.MatrixFilter) (*networkpolicy.AllowMatrix, error) {
+	ret := _m.Called(ctx, namespaceName, projectName, environment, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllowMatrix")
+	}
+
+	var r0 *networkpolicy.AllowMatrix
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, networkpolicy.MatrixFilter) (*networkpolicy.AllowMatrix, error)); ok {
+		return rf(ctx, namespaceName, projectName, environment, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, networkpolicy.MatrixFilter) *networkpolicy.AllowMatrix); ok {
+		r0 = rf(ctx, namespaceName, projectName, environment, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*networkpolicy.AllowMatrix)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, networkpolicy.MatrixFilter) error); ok {
+		r1 = rf(ctx, namespaceName, projectName, environment, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetAllowMatrix_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllowMatrix'
+type MockService_GetAllowMatrix_Call struct {
+	*mock.Call
+}
+
+// GetAllowMatrix is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - projectName string
+//   - environment string
+//   - filter networkpolicy.MatrixFilter
+func (_e *MockService_Expecter) GetAllowMatrix(ctx interface{}, namespaceName interface{}, projectName interface{}, environment interface{}, filter interface{}) *MockService_GetAllowMatrix_Call {
+	return &MockService_GetAllowMatrix_Call{Call: _e.mock.On("GetAllowMatrix", ctx, namespaceName, projectName, environment, filter)}
+}
+
+func (_c *MockService_GetAllowMatrix_Call) Run(run func(ctx context.Context, namespaceName string, projectName string, environment string, filter networkpolicy.MatrixFilter)) *MockService_GetAllowMatrix_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(networkpolicy.MatrixFilter))
+	})
+	return _c
+}
+
+func (_c *MockService_GetAllowMatrix_Call) Return(_a0 *networkpolicy.AllowMatrix, _a1 error) *MockService_GetAllowMatrix_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetAllowMatrix_Call) RunAndReturn(run func(context.Context, string, string, string, networkpolicy.MatrixFilter) (*networkpolicy.AllowMatrix, error)) *MockService_GetAllowMatrix_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNetworkPolicyRule provides a mock function with given fields: ctx, namespaceName, ruleName
+func (_m *MockService) GetNetworkPolicyRule(ctx context.Context, namespaceName string, ruleName string) (*v1alpha1.NetworkPolicyRule, error) {
+	ret := _m.Called(ctx, namespaceName, ruleName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNetworkPolicyRule")
+	}
+
+	var r0 *v1alpha1.NetworkPolicyRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*v1alpha1.NetworkPolicyRule, error)); ok {
+		return rf(ctx, namespaceName, ruleName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *v1alpha1.NetworkPolicyRule); ok {
+		r0 = rf(ctx, namespaceName, ruleName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.NetworkPolicyRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, ruleName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetNetworkPolicyRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNetworkPolicyRule'
+type MockService_GetNetworkPolicyRule_Call struct {
+	*mock.Call
+}
+
+// GetNetworkPolicyRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - ruleName string
+func (_e *MockService_Expecter) GetNetworkPolicyRule(ctx interface{}, namespaceName interface{}, ruleName interface{}) *MockService_GetNetworkPolicyRule_Call {
+	return &MockService_GetNetworkPolicyRule_Call{Call: _e.mock.On("GetNetworkPolicyRule", ctx, namespaceName, ruleName)}
+}
+
+func (_c *MockService_GetNetworkPolicyRule_Call) Run(run func(ctx context.Context, namespaceName string, ruleName string)) *MockService_GetNetworkPolicyRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetNetworkPolicyRule_Call) Return(_a0 *v1alpha1.NetworkPolicyRule, _a1 error) *MockService_GetNetworkPolicyRule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetNetworkPolicyRule_Call) RunAndReturn(run func(context.Context, string, string) (*v1alpha1.NetworkPolicyRule, error)) *MockService_GetNetworkPolicyRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProjectPolicies provides a mock function with given fields: ctx, namespaceName, projectName, environment
+func (_m *MockService) GetProjectPolicies(ctx context.Context, namespaceName string, projectName string, environment string) (*networkpolicy.ProjectPolicies, error) {
+	ret := _m.Called(ctx, namespaceName, projectName, environment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjectPolicies")
+	}
+
+	var r0 *networkpolicy.ProjectPolicies
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*networkpolicy.ProjectPolicies, error)); ok {
+		return rf(ctx, namespaceName, projectName, environment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *networkpolicy.ProjectPolicies); ok {
+		r0 = rf(ctx, namespaceName, projectName, environment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*networkpolicy.ProjectPolicies)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, projectName, environment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetProjectPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectPolicies'
+type MockService_GetProjectPolicies_Call struct {
+	*mock.Call
+}
+
+// GetProjectPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - projectName string
+//   - environment string
+func (_e *MockService_Expecter) GetProjectPolicies(ctx interface{}, namespaceName interface{}, projectName interface{}, environment interface{}) *MockService_GetProjectPolicies_Call {
+	return &MockService_GetProjectPolicies_Call{Call: _e.mock.On("GetProjectPolicies", ctx, namespaceName, projectName, environment)}
+}
+
+func (_c *MockService_GetProjectPolicies_Call) Run(run func(ctx context.Context, namespaceName string, projectName string, environment string)) *MockService_GetProjectPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetProjectPolicies_Call) Return(_a0 *networkpolicy.ProjectPolicies, _a1 error) *MockService_GetProjectPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetProjectPolicies_Call) RunAndReturn(run func(context.Context, string, string, string) (*networkpolicy.ProjectPolicies, error)) *MockService_GetProjectPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNetworkPolicyRules provides a mock function with given fields: ctx, namespaceName, opts
+func (_m *MockService) ListNetworkPolicyRules(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[v1alpha1.NetworkPolicyRule], error) {
+	ret := _m.Called(ctx, namespaceName, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNetworkPolicyRules")
+	}
+
+	var r0 *services.ListResult[v1alpha1.NetworkPolicyRule]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, services.ListOptions) (*services.ListResult[v1alpha1.NetworkPolicyRule], error)); ok {
+		return rf(ctx, namespaceName, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, services.ListOptions) *services.ListResult[v1alpha1.NetworkPolicyRule]); ok {
+		r0 = rf(ctx, namespaceName, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.ListResult[v1alpha1.NetworkPolicyRule])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, services.ListOptions) error); ok {
+		r1 = rf(ctx, namespaceName, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListNetworkPolicyRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNetworkPolicyRules'
+type MockService_ListNetworkPolicyRules_Call struct {
+	*mock.Call
+}
+
+// ListNetworkPolicyRules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - opts services.ListOptions
+func (_e *MockService_Expecter) ListNetworkPolicyRules(ctx interface{}, namespaceName interface{}, opts interface{}) *MockService_ListNetworkPolicyRules_Call {
+	return &MockService_ListNetworkPolicyRules_Call{Call: _e.mock.On("ListNetworkPolicyRules", ctx, namespaceName, opts)}
+}
+
+func (_c *MockService_ListNetworkPolicyRules_Call) Run(run func(ctx context.Context, namespaceName string, opts services.ListOptions)) *MockService_ListNetworkPolicyRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(services.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockService_ListNetworkPolicyRules_Call) Return(_a0 *services.ListResult[v1alpha1.NetworkPolicyRule], _a1 error) *MockService_ListNetworkPolicyRules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListNetworkPolicyRules_Call) RunAndReturn(run func(context.Context, string, services.ListOptions) (*services.ListResult[v1alpha1.NetworkPolicyRule], error)) *MockService_ListNetworkPolicyRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateNetworkPolicyRule provides a mock function with given fields: ctx, namespaceName, rule
+func (_m *MockService) UpdateNetworkPolicyRule(ctx context.Context, namespaceName string, rule *v1alpha1.NetworkPolicyRule) (*v1alpha1.NetworkPolicyRule, error) {
+	ret := _m.Called(ctx, namespaceName, rule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNetworkPolicyRule")
+	}
+
+	var r0 *v1alpha1.NetworkPolicyRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *v1alpha1.NetworkPolicyRule) (*v1alpha1.NetworkPolicyRule, error)); ok {
+		return rf(ctx, namespaceName, rule)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *v1alpha1.NetworkPolicyRule) *v1alpha1.NetworkPolicyRule); ok {
+		r0 = rf(ctx, namespaceName, rule)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.NetworkPolicyRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *v1alpha1.NetworkPolicyRule) error); ok {
+		r1 = rf(ctx, namespaceName, rule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_UpdateNetworkPolicyRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNetworkPolicyRule'
+type MockService_UpdateNetworkPolicyRule_Call struct {
+	*mock.Call
+}
+
+// UpdateNetworkPolicyRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - rule *v1alpha1.NetworkPolicyRule
+func (_e *MockService_Expecter) UpdateNetworkPolicyRule(ctx interface{}, namespaceName interface{}, rule interface{}) *MockService_UpdateNetworkPolicyRule_Call {
+	return &MockService_UpdateNetworkPolicyRule_Call{Call: _e.mock.On("UpdateNetworkPolicyRule", ctx, namespaceName, rule)}
+}
+
+func (_c *MockService_UpdateNetworkPolicyRule_Call) Run(run func(ctx context.Context, namespaceName string, rule *v1alpha1.NetworkPolicyRule)) *MockService_UpdateNetworkPolicyRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*v1alpha1.NetworkPolicyRule))
+	})
+	return _c
+}
+
+func (_c *MockService_UpdateNetworkPolicyRule_Call) Return(_a0 *v1alpha1.NetworkPolicyRule, _a1 error) *MockService_UpdateNetworkPolicyRule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_UpdateNetworkPolicyRule_Call) RunAndReturn(run func(context.Context, string, *v1alpha1.NetworkPolicyRule) (*v1alpha1.NetworkPolicyRule, error)) *MockService_UpdateNetworkPolicyRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,396 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package networkpolicy
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
+	netpol "github.com/openchoreo/openchoreo/internal/networkpolicy"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	environmentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+)
+
+var networkPolicyRuleTypeMeta = metav1.TypeMeta{
+	APIVersion: openchoreov1alpha1.GroupVersion.String(),
+	Kind:       "NetworkPolicyRule",
+}
+
+// networkPolicyService handles network policy business logic without authorization checks.
+// Other services within this layer should use this directly to avoid double authz.
+type networkPolicyService struct {
+	k8sClient client.Client
+	logger    *slog.Logger
+}
+
+var _ Service = (*networkPolicyService)(nil)
+
+// NewService creates a new network policy service without authorization.
+func NewService(k8sClient client.Client, logger *slog.Logger) Service {
+	return &networkPolicyService{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+func (s *networkPolicyService) ListNetworkPolicyRules(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.NetworkPolicyRule], error) {
+	s.logger.Debug("Listing network policy rules", "namespace", namespaceName, "limit", opts.Limit, "cursor", opts.Cursor)
+
+	commonOpts, err := services.BuildListOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	listOpts := append([]client.ListOption{client.InNamespace(namespaceName)}, commonOpts...)
+
+	var ruleList openchoreov1alpha1.NetworkPolicyRuleList
+	if err := s.k8sClient.List(ctx, &ruleList, listOpts...); err != nil {
+		s.logger.Error("Failed to list network policy rules", "error", err)
+		return nil, fmt.Errorf("failed to list network policy rules: %w", err)
+	}
+
+	for i := range ruleList.Items {
+		ruleList.Items[i].TypeMeta = networkPolicyRuleTypeMeta
+	}
+
+	result := &services.ListResult[openchoreov1alpha1.NetworkPolicyRule]{
+		Items:      ruleList.Items,
+		NextCursor: ruleList.Continue,
+	}
+	if ruleList.RemainingItemCount != nil {
+		remaining := *ruleList.RemainingItemCount
+		result.RemainingCount = &remaining
+	}
+
+	s.logger.Debug("Listed network policy rules", "namespace", namespaceName, "count", len(ruleList.Items))
+	return result, nil
+}
+
+func (s *networkPolicyService) GetNetworkPolicyRule(ctx context.Context, namespaceName, ruleName string) (*openchoreov1alpha1.NetworkPolicyRule, error) {
+	s.logger.Debug("Getting network policy rule", "namespace", namespaceName, "rule", ruleName)
+
+	rule := &openchoreov1alpha1.NetworkPolicyRule{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: ruleName, Namespace: namespaceName}, rule); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Network policy rule not found", "namespace", namespaceName, "rule", ruleName)
+			return nil, ErrNetworkPolicyRuleNotFound
+		}
+		s.logger.Error("Failed to get network policy rule", "error", err)
+		return nil, fmt.Errorf("failed to get network policy rule: %w", err)
+	}
+
+	rule.TypeMeta = networkPolicyRuleTypeMeta
+	return rule, nil
+}
+
+func (s *networkPolicyService) CreateNetworkPolicyRule(ctx context.Context, namespaceName string, rule *openchoreov1alpha1.NetworkPolicyRule) (*openchoreov1alpha1.NetworkPolicyRule, error) {
+	if rule == nil {
+		return nil, ErrNetworkPolicyRuleNil
+	}
+
+	s.logger.Debug("Creating network policy rule", "namespace", namespaceName, "rule", rule.Name)
+
+	existing := &openchoreov1alpha1.NetworkPolicyRule{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: rule.Name, Namespace: namespaceName}, existing); err == nil {
+		return nil, ErrNetworkPolicyRuleAlreadyExists
+	} else if client.IgnoreNotFound(err) != nil {
+		return nil, fmt.Errorf("failed to check network policy rule existence: %w", err)
+	}
+
+	rule.Namespace = namespaceName
+	if err := s.k8sClient.Create(ctx, rule); err != nil {
+		if vErr := services.ExtractValidationError(err); vErr != nil {
+			return nil, vErr
+		}
+		s.logger.Error("Failed to create network policy rule CR", "error", err)
+		return nil, fmt.Errorf("failed to create network policy rule: %w", err)
+	}
+
+	s.logger.Debug("Network policy rule created successfully", "namespace", namespaceName, "rule", rule.Name)
+	rule.TypeMeta = networkPolicyRuleTypeMeta
+	return rule, nil
+}
+
+// UpdateNetworkPolicyRule replaces an existing network policy rule with the provided state.
+func (s *networkPolicyService) UpdateNetworkPolicyRule(ctx context.Context, namespaceName string, rule *openchoreov1alpha1.NetworkPolicyRule) (*openchoreov1alpha1.NetworkPolicyRule, error) {
+	if rule == nil {
+		return nil, ErrNetworkPolicyRuleNil
+	}
+
+	s.logger.Debug("Updating network policy rule", "namespace", namespaceName, "rule", rule.Name)
+
+	existing := &openchoreov1alpha1.NetworkPolicyRule{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: rule.Name, Namespace: namespaceName}, existing); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, ErrNetworkPolicyRuleNotFound
+		}
+		s.logger.Error("Failed to get network policy rule", "error", err)
+		return nil, fmt.Errorf("failed to get network policy rule: %w", err)
+	}
+
+	// Only apply user-mutable fields to the existing object, preserving server-managed fields
+	existing.Spec = rule.Spec
+	existing.Labels = rule.Labels
+	existing.Annotations = rule.Annotations
+
+	if err := s.k8sClient.Update(ctx, existing); err != nil {
+		if vErr := services.ExtractValidationError(err); vErr != nil {
+			s.logger.Error("Network policy rule update rejected by validation", "error", err)
+			return nil, vErr
+		}
+		s.logger.Error("Failed to update network policy rule CR", "error", err)
+		return nil, fmt.Errorf("failed to update network policy rule: %w", err)
+	}
+
+	s.logger.Debug("Network policy rule updated successfully", "namespace", namespaceName, "rule", rule.Name)
+	existing.TypeMeta = networkPolicyRuleTypeMeta
+	return existing, nil
+}
+
+func (s *networkPolicyService) DeleteNetworkPolicyRule(ctx context.Context, namespaceName, ruleName string) error {
+	s.logger.Debug("Deleting network policy rule", "namespace", namespaceName, "rule", ruleName)
+
+	rule := &openchoreov1alpha1.NetworkPolicyRule{
+		ObjectMeta: metav1.ObjectMeta{Name: ruleName, Namespace: namespaceName},
+	}
+	if err := s.k8sClient.Delete(ctx, rule); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ErrNetworkPolicyRuleNotFound
+		}
+		s.logger.Error("Failed to delete network policy rule CR", "error", err)
+		return fmt.Errorf("failed to delete network policy rule: %w", err)
+	}
+
+	s.logger.Debug("Network policy rule deleted successfully", "namespace", namespaceName, "rule", ruleName)
+	return nil
+}
+
+func (s *networkPolicyService) GetProjectPolicies(ctx context.Context, namespaceName, projectName, environment string) (*ProjectPolicies, error) {
+	s.logger.Debug("Getting project network policies", "namespace", namespaceName, "project", projectName, "environment", environment)
+
+	if err := s.checkProjectAndEnvironment(ctx, namespaceName, projectName, environment); err != nil {
+		return nil, err
+	}
+	bindings, err := s.deployedBindings(ctx, namespaceName, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	releaseList := &openchoreov1alpha1.RenderedReleaseList{}
+	if err := s.k8sClient.List(ctx, releaseList, client.InNamespace(namespaceName),
+		client.MatchingLabels{ocLabels.LabelKeyProjectName: projectName}); err != nil {
+		s.logger.Error("Failed to list rendered releases", "error", err)
+		return nil, fmt.Errorf("failed to list rendered releases: %w", err)
+	}
+	releases := make(map[string]*openchoreov1alpha1.RenderedRelease)
+	for i := range releaseList.Items {
+		release := &releaseList.Items[i]
+		if release.Spec.EnvironmentName != environment ||
+			(release.Spec.TargetPlane != "" && release.Spec.TargetPlane != openchoreov1alpha1.TargetPlaneDataPlane) {
+			continue
+		}
+		releases[release.Spec.Owner.ComponentName] = release
+	}
+
+	result := &ProjectPolicies{
+		ProjectName: projectName,
+		Environment: environment,
+		Components:  []ComponentPolicies{},
+	}
+	for i := range bindings {
+		rb := &bindings[i]
+		if rb.Spec.Owner.ProjectName != projectName {
+			continue
+		}
+		result.Components = append(result.Components, ComponentPolicies{
+			ComponentName:      rb.Spec.Owner.ComponentName,
+			ReleaseBindingName: rb.Name,
+			Policies:           networkPolicyManifests(releases[rb.Spec.Owner.ComponentName]),
+		})
+	}
+	return result, nil
+}
+
+func (s *networkPolicyService) GetAllowMatrix(ctx context.Context, namespaceName, projectName, environment string, filter MatrixFilter) (*AllowMatrix, error) {
+	s.logger.Debug("Getting network allow matrix", "namespace", namespaceName, "project", projectName, "environment", environment)
+
+	if err := s.checkProjectAndEnvironment(ctx, namespaceName, projectName, environment); err != nil {
+		return nil, err
+	}
+	bindings, err := s.deployedBindings(ctx, namespaceName, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	var ruleList openchoreov1alpha1.NetworkPolicyRuleList
+	if err := s.k8sClient.List(ctx, &ruleList, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list network policy rules", "error", err)
+		return nil, fmt.Errorf("failed to list network policy rules: %w", err)
+	}
+	var rules []openchoreov1alpha1.NetworkPolicyRule
+	for _, rule := range ruleList.Items {
+		if rule.Spec.Destination.Project == projectName && rule.DeletionTimestamp.IsZero() {
+			rules = append(rules, rule)
+		}
+	}
+
+	sources := []Source{{SystemComponent: true}}
+	for _, rb := range bindings {
+		sources = append(sources, Source{Project: rb.Spec.Owner.ProjectName, Component: rb.Spec.Owner.ComponentName})
+	}
+
+	matrix := &AllowMatrix{
+		ProjectName: projectName,
+		Environment: environment,
+		Entries:     []MatrixEntry{},
+	}
+	for _, rb := range bindings {
+		if rb.Spec.Owner.ProjectName != projectName ||
+			(filter.DestinationComponent != "" && rb.Spec.Owner.ComponentName != filter.DestinationComponent) {
+			continue
+		}
+		endpoints, err := s.releaseEndpoints(ctx, namespaceName, rb.Spec.ReleaseName)
+		if err != nil {
+			return nil, err
+		}
+		params := netpol.ComponentPolicyParams{
+			Environment:   environment,
+			ProjectName:   projectName,
+			ComponentName: rb.Spec.Owner.ComponentName,
+			Endpoints:     endpoints,
+			Rules:         rules,
+		}
+		for _, endpointName := range sortedKeys(endpoints) {
+			if filter.Endpoint != "" && endpointName != filter.Endpoint {
+				continue
+			}
+			for _, src := range sources {
+				if !filter.matchesSource(src) {
+					continue
+				}
+				verdict := netpol.EvaluateIngress(params, endpointName, netpol.Caller{
+					Project:         src.Project,
+					Component:       src.Component,
+					SystemComponent: src.SystemComponent,
+				})
+				if filter.Allowed != nil && *filter.Allowed != verdict.Allowed {
+					continue
+				}
+				matrix.Entries = append(matrix.Entries, MatrixEntry{
+					Source:      src,
+					Destination: Destination{Component: rb.Spec.Owner.ComponentName, Endpoint: endpointName},
+					Allowed:     verdict.Allowed,
+					Reason:      verdict.Reason,
+					Rule:        verdict.Rule,
+				})
+			}
+		}
+	}
+	return matrix, nil
+}
+
+// checkProjectAndEnvironment returns the not found error of the project or environment when
+// either does not exist.
+func (s *networkPolicyService) checkProjectAndEnvironment(ctx context.Context, namespaceName, projectName, environment string) error {
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: projectName}, &openchoreov1alpha1.Project{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return projectsvc.ErrProjectNotFound
+		}
+		s.logger.Error("Failed to get project", "error", err)
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: environment}, &openchoreov1alpha1.Environment{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return environmentsvc.ErrEnvironmentNotFound
+		}
+		s.logger.Error("Failed to get environment", "error", err)
+		return fmt.Errorf("failed to get environment: %w", err)
+	}
+	return nil
+}
+
+// deployedBindings returns the release bindings of the namespace that deploy a component to the
+// environment, sorted by project and component.
+func (s *networkPolicyService) deployedBindings(ctx context.Context, namespaceName, environment string) ([]openchoreov1alpha1.ReleaseBinding, error) {
+	bindingList := &openchoreov1alpha1.ReleaseBindingList{}
+	if err := s.k8sClient.List(ctx, bindingList, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list release bindings", "error", err)
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+
+	var bindings []openchoreov1alpha1.ReleaseBinding
+	for _, rb := range bindingList.Items {
+		if rb.Spec.Environment == environment && rb.Spec.ReleaseName != "" &&
+			rb.Spec.State != openchoreov1alpha1.ReleaseStateUndeploy {
+			bindings = append(bindings, rb)
+		}
+	}
+	sort.Slice(bindings, func(i, j int) bool {
+		a, b := bindings[i].Spec.Owner, bindings[j].Spec.Owner
+		if a.ProjectName != b.ProjectName {
+			return a.ProjectName < b.ProjectName
+		}
+		return a.ComponentName < b.ComponentName
+	})
+	return bindings, nil
+}
+
+// releaseEndpoints returns the workload endpoints of a component release, which the controller
+// renders the component's network policies from.
+func (s *networkPolicyService) releaseEndpoints(ctx context.Context, namespaceName, releaseName string) (map[string]openchoreov1alpha1.WorkloadEndpoint, error) {
+	release := &openchoreov1alpha1.ComponentRelease{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: releaseName}, release); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		s.logger.Error("Failed to get component release", "error", err)
+		return nil, fmt.Errorf("failed to get component release: %w", err)
+	}
+	return release.Spec.Workload.Endpoints, nil
+}
+
+// networkPolicyManifests returns the NetworkPolicy and CiliumNetworkPolicy manifests of a rendered
+// release.
+func networkPolicyManifests(release *openchoreov1alpha1.RenderedRelease) []map[string]any {
+	policies := []map[string]any{}
+	if release == nil {
+		return policies
+	}
+	for _, resource := range release.Spec.Resources {
+		if resource.Object == nil {
+			continue
+		}
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(resource.Object.Raw); err != nil {
+			continue
+		}
+		if obj.GetKind() == "NetworkPolicy" || obj.GetKind() == "CiliumNetworkPolicy" {
+			policies = append(policies, obj.Object)
+		}
+	}
+	return policies
+}
+
+func (f MatrixFilter) matchesSource(src Source) bool {
+	return (f.SourceProject == "" || f.SourceProject == src.Project) &&
+		(f.SourceComponent == "" || f.SourceComponent == src.Component)
+}
+
+func sortedKeys(endpoints map[string]openchoreov1alpha1.WorkloadEndpoint) []string {
+	names := make([]string, 0, len(endpoints))
+	for name := range endpoints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
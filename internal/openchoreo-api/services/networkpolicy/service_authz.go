@@ -0,0 +1,119 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package networkpolicy
+
+import (
+	"context"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+const (
+	resourceTypeNetworkPolicy     = "networkPolicy"
+	resourceTypeNetworkPolicyRule = "networkPolicyRule"
+)
+
+// networkPolicyServiceWithAuthz wraps a Service and adds authorization checks.
+// Handlers should use this. Other services should use the unwrapped Service directly.
+type networkPolicyServiceWithAuthz struct {
+	internal Service
+	authz    *services.AuthzChecker
+}
+
+var _ Service = (*networkPolicyServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates a network policy service with authorization checks.
+func NewServiceWithAuthz(k8sClient client.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &networkPolicyServiceWithAuthz{
+		internal: NewService(k8sClient, logger),
+		authz:    services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+func (s *networkPolicyServiceWithAuthz) ListNetworkPolicyRules(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.NetworkPolicyRule], error) {
+	return services.FilteredList(ctx, opts, s.authz,
+		func(ctx context.Context, pageOpts services.ListOptions) (*services.ListResult[openchoreov1alpha1.NetworkPolicyRule], error) {
+			return s.internal.ListNetworkPolicyRules(ctx, namespaceName, pageOpts)
+		},
+		func(rule openchoreov1alpha1.NetworkPolicyRule) services.CheckRequest {
+			return ruleCheckRequest(authz.ActionViewNetworkPolicyRule, namespaceName, rule.Name)
+		},
+	)
+}
+
+func (s *networkPolicyServiceWithAuthz) GetNetworkPolicyRule(ctx context.Context, namespaceName, ruleName string) (*openchoreov1alpha1.NetworkPolicyRule, error) {
+	if err := s.authz.Check(ctx, ruleCheckRequest(authz.ActionViewNetworkPolicyRule, namespaceName, ruleName)); err != nil {
+		return nil, err
+	}
+	return s.internal.GetNetworkPolicyRule(ctx, namespaceName, ruleName)
+}
+
+func (s *networkPolicyServiceWithAuthz) CreateNetworkPolicyRule(ctx context.Context, namespaceName string, rule *openchoreov1alpha1.NetworkPolicyRule) (*openchoreov1alpha1.NetworkPolicyRule, error) {
+	if rule == nil {
+		return nil, ErrNetworkPolicyRuleNil
+	}
+	if err := s.authz.Check(ctx, ruleCheckRequest(authz.ActionCreateNetworkPolicyRule, namespaceName, rule.Name)); err != nil {
+		return nil, err
+	}
+	return s.internal.CreateNetworkPolicyRule(ctx, namespaceName, rule)
+}
+
+func (s *networkPolicyServiceWithAuthz) UpdateNetworkPolicyRule(ctx context.Context, namespaceName string, rule *openchoreov1alpha1.NetworkPolicyRule) (*openchoreov1alpha1.NetworkPolicyRule, error) {
+	if rule == nil {
+		return nil, ErrNetworkPolicyRuleNil
+	}
+	if err := s.authz.Check(ctx, ruleCheckRequest(authz.ActionUpdateNetworkPolicyRule, namespaceName, rule.Name)); err != nil {
+		return nil, err
+	}
+	return s.internal.UpdateNetworkPolicyRule(ctx, namespaceName, rule)
+}
+
+func (s *networkPolicyServiceWithAuthz) DeleteNetworkPolicyRule(ctx context.Context, namespaceName, ruleName string) error {
+	if err := s.authz.Check(ctx, ruleCheckRequest(authz.ActionDeleteNetworkPolicyRule, namespaceName, ruleName)); err != nil {
+		return err
+	}
+	return s.internal.DeleteNetworkPolicyRule(ctx, namespaceName, ruleName)
+}
+
+func (s *networkPolicyServiceWithAuthz) GetProjectPolicies(ctx context.Context, namespaceName, projectName, environment string) (*ProjectPolicies, error) {
+	if err := s.authz.Check(ctx, projectCheckRequest(namespaceName, projectName, environment)); err != nil {
+		return nil, err
+	}
+	return s.internal.GetProjectPolicies(ctx, namespaceName, projectName, environment)
+}
+
+func (s *networkPolicyServiceWithAuthz) GetAllowMatrix(ctx context.Context, namespaceName, projectName, environment string, filter MatrixFilter) (*AllowMatrix, error) {
+	if err := s.authz.Check(ctx, projectCheckRequest(namespaceName, projectName, environment)); err != nil {
+		return nil, err
+	}
+	return s.internal.GetAllowMatrix(ctx, namespaceName, projectName, environment, filter)
+}
+
+func ruleCheckRequest(action, namespaceName, ruleName string) services.CheckRequest {
+	return services.CheckRequest{
+		Action:       action,
+		ResourceType: resourceTypeNetworkPolicyRule,
+		ResourceID:   ruleName,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName},
+	}
+}
+
+// projectCheckRequest checks permission to view the network policies of a project in an environment.
+func projectCheckRequest(namespaceName, projectName, environment string) services.CheckRequest {
+	return services.CheckRequest{
+		Action:       authz.ActionViewNetworkPolicy,
+		ResourceType: resourceTypeNetworkPolicy,
+		ResourceID:   projectName,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName, Project: projectName},
+		Context: authz.Context{
+			Resource: authz.ResourceAttribute{
+				Environment: services.FormatDualScopedResourceName(namespaceName, environment, false)},
+		},
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package networkpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+// mockService is a local testify mock for the Service interface.
+// It exists here instead of importing networkpolicy/mocks to avoid a cyclic import
+type mockService struct {
+	mock.Mock
+}
+
+func newMockService(t *testing.T) *mockService {
+	m := &mockService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *mockService) ListNetworkPolicyRules(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.NetworkPolicyRule], error) {
+	args := m.Called(ctx, namespaceName, opts)
+	res, _ := args.Get(0).(*services.ListResult[openchoreov1alpha1.NetworkPolicyRule])
+	return res, args.Error(1)
+}
+
+func (m *mockService) GetNetworkPolicyRule(ctx context.Context, namespaceName, ruleName string) (*openchoreov1alpha1.NetworkPolicyRule, error) {
+	args := m.Called(ctx, namespaceName, ruleName)
+	res, _ := args.Get(0).(*openchoreov1alpha1.NetworkPolicyRule)
+	return res, args.Error(1)
+}
+
+func (m *mockService) CreateNetworkPolicyRule(ctx context.Context, namespaceName string, rule *openchoreov1alpha1.NetworkPolicyRule) (*openchoreov1alpha1.NetworkPolicyRule, error) {
+	args := m.Called(ctx, namespaceName, rule)
+	res, _ := args.Get(0).(*openchoreov1alpha1.NetworkPolicyRule)
+	return res, args.Error(1)
+}
+
+func (m *mockService) UpdateNetworkPolicyRule(ctx context.Context, namespaceName string, rule *openchoreov1alpha1.NetworkPolicyRule) (*openchoreov1alpha1.NetworkPolicyRule, error) {
+	args := m.Called(ctx, namespaceName, rule)
+	res, _ := args.Get(0).(*openchoreov1alpha1.NetworkPolicyRule)
+	return res, args.Error(1)
+}
+
+func (m *mockService) DeleteNetworkPolicyRule(ctx context.Context, namespaceName, ruleName string) error {
+	return m.Called(ctx, namespaceName, ruleName).Error(0)
+}
+
+func (m *mockService) GetProjectPolicies(ctx context.Context, namespaceName, projectName, environment string) (*ProjectPolicies, error) {
+	args := m.Called(ctx, namespaceName, projectName, environment)
+	res, _ := args.Get(0).(*ProjectPolicies)
+	return res, args.Error(1)
+}
+
+func (m *mockService) GetAllowMatrix(ctx context.Context, namespaceName, projectName, environment string, filter MatrixFilter) (*AllowMatrix, error) {
+	args := m.Called(ctx, namespaceName, projectName, environment, filter)
+	res, _ := args.Get(0).(*AllowMatrix)
+	return res, args.Error(1)
+}
+
+func newAuthzService(mockSvc *mockService, pdp *testutil.CapturingPDP) *networkPolicyServiceWithAuthz {
+	return &networkPolicyServiceWithAuthz{
+		internal: mockSvc,
+		authz:    testutil.NewTestAuthzChecker(pdp),
+	}
+}
+
+func TestNetworkPolicyAuthz_CreateNetworkPolicyRule(t *testing.T) {
+	rule := &openchoreov1alpha1.NetworkPolicyRule{ObjectMeta: metav1.ObjectMeta{Name: "rule-1", Namespace: "ns-1"}}
+
+	t.Run("nil input", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		_, err := newAuthzService(newMockService(t), pdp).CreateNetworkPolicyRule(testutil.AuthzContext(), "ns-1", nil)
+		require.ErrorIs(t, err, ErrNetworkPolicyRuleNil)
+		require.Empty(t, pdp.Captured, "authz should not be called on nil input")
+	})
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("CreateNetworkPolicyRule", mock.Anything, "ns-1", rule).Return(rule, nil)
+		result, err := newAuthzService(mockSvc, pdp).CreateNetworkPolicyRule(testutil.AuthzContext(), "ns-1", rule)
+		require.NoError(t, err)
+		require.Equal(t, rule, result)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "networkpolicyrule:create", "networkPolicyRule", "rule-1", authzcore.ResourceHierarchy{Namespace: "ns-1"})
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		_, err := newAuthzService(newMockService(t), testutil.DenyPDP()).CreateNetworkPolicyRule(testutil.AuthzContext(), "ns-1", rule)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestNetworkPolicyAuthz_UpdateNetworkPolicyRule(t *testing.T) {
+	rule := &openchoreov1alpha1.NetworkPolicyRule{ObjectMeta: metav1.ObjectMeta{Name: "rule-1", Namespace: "ns-1"}}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("UpdateNetworkPolicyRule", mock.Anything, "ns-1", rule).Return(rule, nil)
+		_, err := newAuthzService(mockSvc, pdp).UpdateNetworkPolicyRule(testutil.AuthzContext(), "ns-1", rule)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "networkpolicyrule:update", "networkPolicyRule", "rule-1", authzcore.ResourceHierarchy{Namespace: "ns-1"})
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		_, err := newAuthzService(newMockService(t), testutil.DenyPDP()).UpdateNetworkPolicyRule(testutil.AuthzContext(), "ns-1", rule)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestNetworkPolicyAuthz_GetNetworkPolicyRule(t *testing.T) {
+	rule := &openchoreov1alpha1.NetworkPolicyRule{ObjectMeta: metav1.ObjectMeta{Name: "rule-1", Namespace: "ns-1"}}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetNetworkPolicyRule", mock.Anything, "ns-1", "rule-1").Return(rule, nil)
+		result, err := newAuthzService(mockSvc, pdp).GetNetworkPolicyRule(testutil.AuthzContext(), "ns-1", "rule-1")
+		require.NoError(t, err)
+		require.Equal(t, rule, result)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "networkpolicyrule:view", "networkPolicyRule", "rule-1", authzcore.ResourceHierarchy{Namespace: "ns-1"})
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		_, err := newAuthzService(newMockService(t), testutil.DenyPDP()).GetNetworkPolicyRule(testutil.AuthzContext(), "ns-1", "rule-1")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestNetworkPolicyAuthz_DeleteNetworkPolicyRule(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("DeleteNetworkPolicyRule", mock.Anything, "ns-1", "rule-1").Return(nil)
+		require.NoError(t, newAuthzService(mockSvc, pdp).DeleteNetworkPolicyRule(testutil.AuthzContext(), "ns-1", "rule-1"))
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "networkpolicyrule:delete", "networkPolicyRule", "rule-1", authzcore.ResourceHierarchy{Namespace: "ns-1"})
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		err := newAuthzService(newMockService(t), testutil.DenyPDP()).DeleteNetworkPolicyRule(testutil.AuthzContext(), "ns-1", "rule-1")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestNetworkPolicyAuthz_ListNetworkPolicyRules(t *testing.T) {
+	items := []openchoreov1alpha1.NetworkPolicyRule{
+		{ObjectMeta: metav1.ObjectMeta{Name: "rule-1", Namespace: "ns-1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "rule-2", Namespace: "ns-1"}},
+	}
+
+	t.Run("denied filters all items", func(t *testing.T) {
+		mockSvc := newMockService(t)
+		mockSvc.On("ListNetworkPolicyRules", mock.Anything, "ns-1", mock.Anything).
+			Return(&services.ListResult[openchoreov1alpha1.NetworkPolicyRule]{Items: items}, nil)
+		result, err := newAuthzService(mockSvc, testutil.DenyPDP()).ListNetworkPolicyRules(testutil.AuthzContext(), "ns-1", services.ListOptions{Limit: 10})
+		require.NoError(t, err)
+		require.Empty(t, result.Items)
+	})
+
+	t.Run("allowed returns all items", func(t *testing.T) {
+		mockSvc := newMockService(t)
+		mockSvc.On("ListNetworkPolicyRules", mock.Anything, "ns-1", mock.Anything).
+			Return(&services.ListResult[openchoreov1alpha1.NetworkPolicyRule]{Items: items}, nil)
+		result, err := newAuthzService(mockSvc, testutil.AllowPDP()).ListNetworkPolicyRules(testutil.AuthzContext(), "ns-1", services.ListOptions{Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, result.Items, 2)
+	})
+}
+
+func TestNetworkPolicyAuthz_GetProjectPolicies(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetProjectPolicies", mock.Anything, "ns-1", "proj-1", "dev").Return(&ProjectPolicies{}, nil)
+		_, err := newAuthzService(mockSvc, pdp).GetProjectPolicies(testutil.AuthzContext(), "ns-1", "proj-1", "dev")
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "networkpolicy:view", "networkPolicy", "proj-1",
+			authzcore.ResourceHierarchy{Namespace: "ns-1", Project: "proj-1"})
+		require.Equal(t, "ns-1/dev", pdp.Captured[0].Context.Resource.Environment)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		_, err := newAuthzService(newMockService(t), testutil.DenyPDP()).GetProjectPolicies(testutil.AuthzContext(), "ns-1", "proj-1", "dev")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestNetworkPolicyAuthz_GetAllowMatrix(t *testing.T) {
+	filter := MatrixFilter{SourceProject: "billing"}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetAllowMatrix", mock.Anything, "ns-1", "proj-1", "dev", filter).Return(&AllowMatrix{}, nil)
+		_, err := newAuthzService(mockSvc, pdp).GetAllowMatrix(testutil.AuthzContext(), "ns-1", "proj-1", "dev", filter)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "networkpolicy:view", "networkPolicy", "proj-1",
+			authzcore.ResourceHierarchy{Namespace: "ns-1", Project: "proj-1"})
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		_, err := newAuthzService(newMockService(t), testutil.DenyPDP()).GetAllowMatrix(testutil.AuthzContext(), "ns-1", "proj-1", "dev", filter)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
@@ -0,0 +1,267 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package networkpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
+	netpol "github.com/openchoreo/openchoreo/internal/networkpolicy"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	environmentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+// --- Test helpers ---
+
+func newService(t *testing.T, objs ...client.Object) Service {
+	t.Helper()
+	return NewService(testutil.NewFakeClient(objs...), testutil.TestLogger())
+}
+
+const (
+	testNamespace = "test-ns"
+	testProject   = "shop"
+	testEnv       = "dev"
+)
+
+func testRule(name string, action openchoreov1alpha1.NetworkPolicyRuleAction, src openchoreov1alpha1.NetworkPolicyRulePeer,
+	dst openchoreov1alpha1.NetworkPolicyRuleDestination) *openchoreov1alpha1.NetworkPolicyRule {
+	return &openchoreov1alpha1.NetworkPolicyRule{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Spec: openchoreov1alpha1.NetworkPolicyRuleSpec{
+			Action:      action,
+			Source:      src,
+			Destination: dst,
+		},
+	}
+}
+
+// deployed returns a release binding of the component to the test environment and the component
+// release it binds, with the given endpoints.
+func deployed(projectName, componentName string, endpoints map[string]openchoreov1alpha1.WorkloadEndpoint) []client.Object {
+	release := testutil.NewComponentRelease(testNamespace, projectName, componentName, componentName+"-r1")
+	release.Spec.Workload.Endpoints = endpoints
+	rb := testutil.NewReleaseBinding(testNamespace, projectName, componentName, testEnv, componentName+"-"+testEnv)
+	rb.Spec.ReleaseName = release.Name
+	return []client.Object{release, rb}
+}
+
+// allowMatrixObjects describes the orders and web components of the shop project, and the
+// invoicer component of the billing project, all deployed to the test environment.
+func allowMatrixObjects() []client.Object {
+	objs := []client.Object{
+		testutil.NewProject(testNamespace, testProject),
+		testutil.NewEnvironment(testNamespace, testEnv),
+		testRule("allow-invoicer", openchoreov1alpha1.NetworkPolicyRuleActionAllow,
+			openchoreov1alpha1.NetworkPolicyRulePeer{Project: "billing", Component: "invoicer"},
+			openchoreov1alpha1.NetworkPolicyRuleDestination{Project: testProject, Component: "orders"}),
+	}
+	objs = append(objs, deployed(testProject, "orders", map[string]openchoreov1alpha1.WorkloadEndpoint{
+		"api": {
+			Type:       openchoreov1alpha1.EndpointTypeHTTP,
+			Port:       8080,
+			Visibility: []openchoreov1alpha1.EndpointVisibility{openchoreov1alpha1.EndpointVisibilityExternal},
+		},
+	})...)
+	objs = append(objs, deployed(testProject, "web", nil)...)
+	objs = append(objs, deployed("billing", "invoicer", nil)...)
+	return objs
+}
+
+// --- Network policy rules ---
+
+func TestCreateNetworkPolicyRule(t *testing.T) {
+	ctx := context.Background()
+	rule := func() *openchoreov1alpha1.NetworkPolicyRule {
+		return testRule("deny-legacy", openchoreov1alpha1.NetworkPolicyRuleActionDeny,
+			openchoreov1alpha1.NetworkPolicyRulePeer{Project: "legacy"},
+			openchoreov1alpha1.NetworkPolicyRuleDestination{Project: testProject})
+	}
+
+	t.Run("success", func(t *testing.T) {
+		svc := newService(t)
+		result, err := svc.CreateNetworkPolicyRule(ctx, testNamespace, rule())
+		require.NoError(t, err)
+		assert.Equal(t, networkPolicyRuleTypeMeta, result.TypeMeta)
+		assert.Equal(t, testNamespace, result.Namespace)
+
+		got, err := svc.GetNetworkPolicyRule(ctx, testNamespace, "deny-legacy")
+		require.NoError(t, err)
+		assert.Equal(t, "legacy", got.Spec.Source.Project)
+	})
+
+	t.Run("already exists", func(t *testing.T) {
+		svc := newService(t, rule())
+		_, err := svc.CreateNetworkPolicyRule(ctx, testNamespace, rule())
+		require.ErrorIs(t, err, ErrNetworkPolicyRuleAlreadyExists)
+	})
+
+	t.Run("nil rule", func(t *testing.T) {
+		svc := newService(t)
+		_, err := svc.CreateNetworkPolicyRule(ctx, testNamespace, nil)
+		require.ErrorIs(t, err, ErrNetworkPolicyRuleNil)
+	})
+}
+
+func TestUpdateNetworkPolicyRule(t *testing.T) {
+	ctx := context.Background()
+	existing := testRule("deny-legacy", openchoreov1alpha1.NetworkPolicyRuleActionDeny,
+		openchoreov1alpha1.NetworkPolicyRulePeer{Project: "legacy"},
+		openchoreov1alpha1.NetworkPolicyRuleDestination{Project: testProject})
+
+	t.Run("success", func(t *testing.T) {
+		svc := newService(t, existing.DeepCopy())
+		update := existing.DeepCopy()
+		update.Spec.Environments = []string{"prod"}
+
+		result, err := svc.UpdateNetworkPolicyRule(ctx, testNamespace, update)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"prod"}, result.Spec.Environments)
+		assert.Equal(t, networkPolicyRuleTypeMeta, result.TypeMeta)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc := newService(t)
+		_, err := svc.UpdateNetworkPolicyRule(ctx, testNamespace, existing.DeepCopy())
+		require.ErrorIs(t, err, ErrNetworkPolicyRuleNotFound)
+	})
+}
+
+func TestDeleteNetworkPolicyRule(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		svc := newService(t, testRule("deny-legacy", openchoreov1alpha1.NetworkPolicyRuleActionDeny,
+			openchoreov1alpha1.NetworkPolicyRulePeer{Project: "legacy"},
+			openchoreov1alpha1.NetworkPolicyRuleDestination{Project: testProject}))
+		require.NoError(t, svc.DeleteNetworkPolicyRule(ctx, testNamespace, "deny-legacy"))
+
+		_, err := svc.GetNetworkPolicyRule(ctx, testNamespace, "deny-legacy")
+		require.ErrorIs(t, err, ErrNetworkPolicyRuleNotFound)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc := newService(t)
+		require.ErrorIs(t, svc.DeleteNetworkPolicyRule(ctx, testNamespace, "missing"), ErrNetworkPolicyRuleNotFound)
+	})
+}
+
+func TestListNetworkPolicyRules(t *testing.T) {
+	svc := newService(t,
+		testRule("rule-a", openchoreov1alpha1.NetworkPolicyRuleActionDeny,
+			openchoreov1alpha1.NetworkPolicyRulePeer{}, openchoreov1alpha1.NetworkPolicyRuleDestination{Project: testProject}),
+		testRule("rule-b", openchoreov1alpha1.NetworkPolicyRuleActionDeny,
+			openchoreov1alpha1.NetworkPolicyRulePeer{}, openchoreov1alpha1.NetworkPolicyRuleDestination{Project: testProject}),
+	)
+
+	result, err := svc.ListNetworkPolicyRules(context.Background(), testNamespace, services.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 2)
+	for _, item := range result.Items {
+		assert.Equal(t, networkPolicyRuleTypeMeta, item.TypeMeta)
+	}
+}
+
+// --- GetProjectPolicies ---
+
+func TestGetProjectPolicies(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns the rendered network policies", func(t *testing.T) {
+		objs := allowMatrixObjects()
+		objs = append(objs, &openchoreov1alpha1.RenderedRelease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "orders-dev",
+				Namespace: testNamespace,
+				Labels:    map[string]string{ocLabels.LabelKeyProjectName: testProject},
+			},
+			Spec: openchoreov1alpha1.RenderedReleaseSpec{
+				Owner:           openchoreov1alpha1.RenderedReleaseOwner{ProjectName: testProject, ComponentName: "orders"},
+				EnvironmentName: testEnv,
+				Resources: []openchoreov1alpha1.RenderedManifest{
+					{ID: "deployment", Object: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"orders"}}`)}},
+					{ID: "netpol", Object: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"networking.k8s.io/v1","kind":"NetworkPolicy","metadata":{"name":"openchoreo-orders"}}`)}},
+				},
+			},
+		})
+		svc := newService(t, objs...)
+
+		result, err := svc.GetProjectPolicies(ctx, testNamespace, testProject, testEnv)
+		require.NoError(t, err)
+		require.Len(t, result.Components, 2)
+		assert.Equal(t, "orders", result.Components[0].ComponentName)
+		assert.Equal(t, "orders-dev", result.Components[0].ReleaseBindingName)
+		require.Len(t, result.Components[0].Policies, 1)
+		assert.Equal(t, "NetworkPolicy", result.Components[0].Policies[0]["kind"])
+		assert.Equal(t, "web", result.Components[1].ComponentName)
+		assert.Empty(t, result.Components[1].Policies)
+	})
+
+	t.Run("project not found", func(t *testing.T) {
+		svc := newService(t, testutil.NewEnvironment(testNamespace, testEnv))
+		_, err := svc.GetProjectPolicies(ctx, testNamespace, testProject, testEnv)
+		require.ErrorIs(t, err, projectsvc.ErrProjectNotFound)
+	})
+
+	t.Run("environment not found", func(t *testing.T) {
+		svc := newService(t, testutil.NewProject(testNamespace, testProject))
+		_, err := svc.GetProjectPolicies(ctx, testNamespace, testProject, testEnv)
+		require.ErrorIs(t, err, environmentsvc.ErrEnvironmentNotFound)
+	})
+}
+
+// --- GetAllowMatrix ---
+
+func TestGetAllowMatrix(t *testing.T) {
+	ctx := context.Background()
+	svc := newService(t, allowMatrixObjects()...)
+
+	t.Run("evaluates every source", func(t *testing.T) {
+		matrix, err := svc.GetAllowMatrix(ctx, testNamespace, testProject, testEnv, MatrixFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, testProject, matrix.ProjectName)
+		assert.Equal(t, testEnv, matrix.Environment)
+
+		api := Destination{Component: "orders", Endpoint: "api"}
+		assert.Equal(t, []MatrixEntry{
+			{Source: Source{SystemComponent: true}, Destination: api, Allowed: true, Reason: netpol.ReasonSystemComponent},
+			{Source: Source{Project: "billing", Component: "invoicer"}, Destination: api, Allowed: true,
+				Reason: netpol.ReasonAllowedByRule, Rule: "allow-invoicer"},
+			{Source: Source{Project: testProject, Component: "orders"}, Destination: api, Allowed: true, Reason: netpol.ReasonProjectVisibility},
+			{Source: Source{Project: testProject, Component: "web"}, Destination: api, Allowed: true, Reason: netpol.ReasonProjectVisibility},
+		}, matrix.Entries)
+	})
+
+	t.Run("filters entries", func(t *testing.T) {
+		allowed := true
+		matrix, err := svc.GetAllowMatrix(ctx, testNamespace, testProject, testEnv, MatrixFilter{
+			SourceProject: "billing",
+			Endpoint:      "api",
+			Allowed:       &allowed,
+		})
+		require.NoError(t, err)
+		require.Len(t, matrix.Entries, 1)
+		assert.Equal(t, "invoicer", matrix.Entries[0].Source.Component)
+
+		denied := false
+		matrix, err = svc.GetAllowMatrix(ctx, testNamespace, testProject, testEnv, MatrixFilter{Allowed: &denied})
+		require.NoError(t, err)
+		assert.Empty(t, matrix.Entries)
+	})
+
+	t.Run("project not found", func(t *testing.T) {
+		_, err := svc.GetAllowMatrix(ctx, testNamespace, "missing", testEnv, MatrixFilter{})
+		require.ErrorIs(t, err, projectsvc.ErrProjectNotFound)
+	})
+}
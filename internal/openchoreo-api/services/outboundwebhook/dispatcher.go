@@ -0,0 +1,329 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package outboundwebhook posts signed JSON payloads to external endpoints when
+// OpenChoreo resources are created, updated, deleted or change health.
+package outboundwebhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	watchsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/watch"
+)
+
+// Events a subscription can select.
+const (
+	EventCreated       = "created"
+	EventUpdated       = "updated"
+	EventDeleted       = "deleted"
+	EventHealthChanged = "health_changed"
+)
+
+// Health of a resource, derived from its Ready condition.
+const (
+	HealthHealthy   = "Healthy"
+	HealthUnhealthy = "Unhealthy"
+	HealthUnknown   = "Unknown"
+)
+
+const (
+	// queueSize is the number of payloads buffered for delivery per subscription.
+	queueSize = 1024
+	// watchRestartDelay is the delay before a failed watch is restarted.
+	watchRestartDelay = 5 * time.Second
+)
+
+// Options configures a Dispatcher.
+type Options struct {
+	// Subscriptions are the endpoints notified of resource events.
+	Subscriptions []Subscription
+	// Timeout is the maximum duration of a single delivery attempt.
+	Timeout time.Duration
+	// MaxAttempts is the number of times a delivery is attempted before the
+	// payload is dead-lettered to the log.
+	MaxAttempts int
+	// RetryBackoff is the delay before the first retry. It doubles after each
+	// failed attempt, up to maxRetryBackoff.
+	RetryBackoff time.Duration
+}
+
+// Subscription selects the events posted to an endpoint.
+type Subscription struct {
+	Name string
+	URL  string
+	// Secret is the key of the HMAC-SHA256 signature of each payload. Empty
+	// sends payloads unsigned.
+	Secret        []byte
+	Kinds         []string
+	Namespaces    []string
+	LabelSelector string
+	// Events are the events posted. Empty posts all of them.
+	Events []string
+}
+
+// Payload is the JSON body posted for an event.
+type Payload struct {
+	// ID identifies the event. Retried deliveries carry the same ID.
+	ID           string    `json:"id"`
+	Event        string    `json:"event"`
+	Subscription string    `json:"subscription"`
+	Timestamp    time.Time `json:"timestamp"`
+	Kind         string    `json:"kind"`
+	Namespace    string    `json:"namespace"`
+	Name         string    `json:"name"`
+	// Health is set for health_changed events.
+	Health *HealthChange `json:"health,omitempty"`
+	// Object is the resource as of the event.
+	Object map[string]any `json:"object"`
+}
+
+// HealthChange is the transition reported by a health_changed event.
+type HealthChange struct {
+	Previous string `json:"previous"`
+	Current  string `json:"current"`
+}
+
+// Dispatcher watches the resources selected by each subscription and posts
+// their events to the subscription's endpoint.
+type Dispatcher struct {
+	watchService  watchsvc.Service
+	subscriptions []*subscription
+	logger        *slog.Logger
+	now           func() time.Time
+}
+
+// subscription is a Subscription with its delivery state.
+type subscription struct {
+	Subscription
+	sender *sender
+	queue  chan *Payload
+	// objects is the last state seen of each watched resource, owned by the
+	// watch goroutine of the subscription.
+	objects map[types.UID]objectState
+}
+
+// objectState is what the dispatcher remembers of a resource to tell which
+// events a change to it raises.
+type objectState struct {
+	generation int64
+	health     string
+}
+
+// New creates a Dispatcher that reads resource events from watchService. The
+// watch service must not check authorization: events are read with the
+// identity of the API server.
+func New(watchService watchsvc.Service, opts Options, logger *slog.Logger) *Dispatcher {
+	d := &Dispatcher{watchService: watchService, logger: logger, now: time.Now}
+	httpClient := &http.Client{Timeout: opts.Timeout}
+	for _, sub := range opts.Subscriptions {
+		d.subscriptions = append(d.subscriptions, &subscription{
+			Subscription: sub,
+			sender: &sender{
+				client:       httpClient,
+				maxAttempts:  opts.MaxAttempts,
+				retryBackoff: opts.RetryBackoff,
+				logger:       logger.With("subscription", sub.Name),
+			},
+			queue:   make(chan *Payload, queueSize),
+			objects: make(map[types.UID]objectState),
+		})
+	}
+	return d
+}
+
+// Run watches and delivers the events of every subscription until ctx is done.
+func (d *Dispatcher) Run(ctx context.Context) {
+	// Resources created before the dispatcher started are already known to the
+	// subscribers; the watch reports them as added only to seed their state.
+	since := d.now().Truncate(time.Second)
+
+	var wg sync.WaitGroup
+	for _, sub := range d.subscriptions {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			d.watch(ctx, sub, since)
+		}()
+		go func() {
+			defer wg.Done()
+			d.deliver(ctx, sub)
+		}()
+	}
+	wg.Wait()
+}
+
+// watch turns the watch events of sub into payloads, restarting the watch when
+// it fails. A restarted watch resumes after the last event seen, or replays the
+// current state when the token expired.
+func (d *Dispatcher) watch(ctx context.Context, sub *subscription, since time.Time) {
+	logger := d.logger.With("subscription", sub.Name)
+	token := ""
+	for ctx.Err() == nil {
+		events, err := d.watchService.Watch(ctx, &watchsvc.Request{
+			Kinds:         sub.Kinds,
+			Namespaces:    sub.Namespaces,
+			LabelSelector: sub.LabelSelector,
+			Token:         token,
+		})
+		if err != nil {
+			logger.Error("Failed to watch resources for webhook subscription", "error", err)
+			sleep(ctx, watchRestartDelay)
+			continue
+		}
+
+		for ev := range events {
+			switch ev.Type {
+			case watchsvc.EventError:
+				if errors.Is(ev.Err, watchsvc.ErrTokenExpired) {
+					token = ""
+				}
+				logger.Warn("Webhook subscription watch ended, restarting", "error", ev.Err)
+			case watchsvc.EventBookmark:
+				token = ev.Token
+			default:
+				token = ev.Token
+				for _, p := range d.payloads(sub, ev, since) {
+					d.enqueue(sub, p)
+				}
+			}
+		}
+		sleep(ctx, watchRestartDelay)
+	}
+}
+
+// payloads returns the payloads the event raises for sub, and records the new
+// state of the resource.
+func (d *Dispatcher) payloads(sub *subscription, ev watchsvc.Event, since time.Time) []*Payload {
+	obj := ev.Object
+	health := healthOf(obj)
+	previous, known := sub.objects[obj.GetUID()]
+
+	var events []string
+	var healthChange *HealthChange
+	switch {
+	case ev.Type == watchsvc.EventDeleted:
+		delete(sub.objects, obj.GetUID())
+		events = append(events, EventDeleted)
+	case !known:
+		sub.objects[obj.GetUID()] = objectState{generation: obj.GetGeneration(), health: health}
+		if ev.Type == watchsvc.EventAdded && obj.GetCreationTimestamp().Time.Before(since) {
+			return nil
+		}
+		events = append(events, EventCreated)
+	default:
+		sub.objects[obj.GetUID()] = objectState{generation: obj.GetGeneration(), health: health}
+		if obj.GetGeneration() != previous.generation && obj.GetDeletionTimestamp() == nil {
+			events = append(events, EventUpdated)
+		}
+		if health != previous.health {
+			events = append(events, EventHealthChanged)
+			healthChange = &HealthChange{Previous: previous.health, Current: health}
+		}
+	}
+
+	var payloads []*Payload
+	for _, event := range events {
+		if len(sub.Events) > 0 && !slices.Contains(sub.Events, event) {
+			continue
+		}
+		object, err := toMap(ev.Kind, obj)
+		if err != nil {
+			d.logger.Error("Failed to convert resource for webhook payload", "subscription", sub.Name,
+				"kind", ev.Kind, "namespace", obj.GetNamespace(), "name", obj.GetName(), "error", err)
+			return nil
+		}
+		p := &Payload{
+			ID:           uuid.NewString(),
+			Event:        event,
+			Subscription: sub.Name,
+			Timestamp:    d.now().UTC(),
+			Kind:         ev.Kind,
+			Namespace:    obj.GetNamespace(),
+			Name:         obj.GetName(),
+			Object:       object,
+		}
+		if event == EventHealthChanged {
+			p.Health = healthChange
+		}
+		payloads = append(payloads, p)
+	}
+	return payloads
+}
+
+// enqueue queues p for delivery, dead-lettering it when the queue is full.
+func (d *Dispatcher) enqueue(sub *subscription, p *Payload) {
+	select {
+	case sub.queue <- p:
+	default:
+		sub.sender.deadLetter(p, 0, errors.New("delivery queue is full"))
+	}
+}
+
+// deliver posts the queued payloads of sub in order until ctx is done.
+func (d *Dispatcher) deliver(ctx context.Context, sub *subscription) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case p := <-sub.queue:
+			sub.sender.send(ctx, sub.URL, sub.Secret, p)
+		}
+	}
+}
+
+// healthOf derives the health of obj from its Ready condition.
+func healthOf(obj client.Object) string {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return HealthUnknown
+	}
+	status, _ := content["status"].(map[string]any)
+	conditions, _ := status["conditions"].([]any)
+	for _, c := range conditions {
+		condition, _ := c.(map[string]any)
+		if condition["type"] != "Ready" {
+			continue
+		}
+		switch condition["status"] {
+		case string(metav1.ConditionTrue):
+			return HealthHealthy
+		case string(metav1.ConditionFalse):
+			return HealthUnhealthy
+		}
+	}
+	return HealthUnknown
+}
+
+// toMap converts obj into its JSON object representation, setting the type
+// metadata that objects read through the Kubernetes client may lack.
+func toMap(kind string, obj client.Object) (map[string]any, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %s: %w", kind, err)
+	}
+	content["apiVersion"] = openchoreov1alpha1.GroupVersion.String()
+	content["kind"] = kind
+	return content, nil
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(d):
+	}
+}
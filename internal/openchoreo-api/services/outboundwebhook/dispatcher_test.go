@@ -0,0 +1,188 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package outboundwebhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	watchsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/watch"
+)
+
+var testStart = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// fakeWatchService forwards the events sent on its channel to the watch,
+// closing the watch when its context is done like the watch service does.
+type fakeWatchService struct {
+	events chan watchsvc.Event
+	req    atomic.Pointer[watchsvc.Request]
+}
+
+func (f *fakeWatchService) Watch(ctx context.Context, req *watchsvc.Request) (<-chan watchsvc.Event, error) {
+	f.req.Store(req)
+	out := make(chan watchsvc.Event)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case ev := <-f.events:
+				select {
+				case out <- ev:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+func newComponent(generation int64, created time.Time, ready metav1.ConditionStatus) *openchoreov1alpha1.Component {
+	comp := &openchoreov1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "api",
+			Namespace:         "default",
+			UID:               types.UID("uid-1"),
+			Generation:        generation,
+			CreationTimestamp: metav1.NewTime(created),
+		},
+	}
+	if ready != "" {
+		comp.Status.Conditions = []metav1.Condition{{Type: "Ready", Status: ready}}
+	}
+	return comp
+}
+
+func newTestDispatcher(sub Subscription) (*Dispatcher, *subscription) {
+	d := New(&fakeWatchService{}, Options{Subscriptions: []Subscription{sub}, MaxAttempts: 1, RetryBackoff: time.Millisecond},
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	d.now = func() time.Time { return testStart }
+	return d, d.subscriptions[0]
+}
+
+func eventsOf(payloads []*Payload) []string {
+	events := make([]string, 0, len(payloads))
+	for _, p := range payloads {
+		events = append(events, p.Event)
+	}
+	return events
+}
+
+func TestPayloads(t *testing.T) {
+	before := testStart.Add(-time.Hour)
+	after := testStart.Add(time.Minute)
+
+	t.Run("existing resources only seed state", func(t *testing.T) {
+		d, sub := newTestDispatcher(Subscription{Name: "s"})
+		comp := newComponent(1, before, metav1.ConditionTrue)
+		assert.Empty(t, d.payloads(sub, watchsvc.Event{Type: watchsvc.EventAdded, Kind: "Component", Object: comp}, testStart))
+
+		// A later status change is compared with the seeded state.
+		unhealthy := newComponent(1, before, metav1.ConditionFalse)
+		payloads := d.payloads(sub, watchsvc.Event{Type: watchsvc.EventModified, Kind: "Component", Object: unhealthy}, testStart)
+		require.Equal(t, []string{EventHealthChanged}, eventsOf(payloads))
+		assert.Equal(t, &HealthChange{Previous: HealthHealthy, Current: HealthUnhealthy}, payloads[0].Health)
+	})
+
+	t.Run("lifecycle of a new resource", func(t *testing.T) {
+		d, sub := newTestDispatcher(Subscription{Name: "s"})
+
+		created := d.payloads(sub, watchsvc.Event{Type: watchsvc.EventAdded, Kind: "Component", Object: newComponent(1, after, "")}, testStart)
+		require.Equal(t, []string{EventCreated}, eventsOf(created))
+		p := created[0]
+		assert.Equal(t, "s", p.Subscription)
+		assert.Equal(t, "Component", p.Kind)
+		assert.Equal(t, "default", p.Namespace)
+		assert.Equal(t, "api", p.Name)
+		assert.Equal(t, "Component", p.Object["kind"])
+		assert.Equal(t, openchoreov1alpha1.GroupVersion.String(), p.Object["apiVersion"])
+		assert.Nil(t, p.Health)
+
+		// Status updates without a health change raise nothing.
+		assert.Empty(t, d.payloads(sub, watchsvc.Event{Type: watchsvc.EventModified, Kind: "Component", Object: newComponent(1, after, metav1.ConditionUnknown)}, testStart))
+
+		updated := d.payloads(sub, watchsvc.Event{Type: watchsvc.EventModified, Kind: "Component", Object: newComponent(2, after, metav1.ConditionTrue)}, testStart)
+		assert.Equal(t, []string{EventUpdated, EventHealthChanged}, eventsOf(updated))
+
+		deleted := d.payloads(sub, watchsvc.Event{Type: watchsvc.EventDeleted, Kind: "Component", Object: newComponent(2, after, metav1.ConditionTrue)}, testStart)
+		assert.Equal(t, []string{EventDeleted}, eventsOf(deleted))
+		assert.Empty(t, sub.objects)
+	})
+
+	t.Run("only selected events are posted", func(t *testing.T) {
+		d, sub := newTestDispatcher(Subscription{Name: "s", Events: []string{EventHealthChanged}})
+		assert.Empty(t, d.payloads(sub, watchsvc.Event{Type: watchsvc.EventAdded, Kind: "Component", Object: newComponent(1, after, "")}, testStart))
+		payloads := d.payloads(sub, watchsvc.Event{Type: watchsvc.EventModified, Kind: "Component", Object: newComponent(2, after, metav1.ConditionFalse)}, testStart)
+		assert.Equal(t, []string{EventHealthChanged}, eventsOf(payloads))
+	})
+}
+
+func TestDispatcherRun(t *testing.T) {
+	received := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- r
+		bodies <- body
+	}))
+	defer srv.Close()
+
+	watch := &fakeWatchService{events: make(chan watchsvc.Event, 1)}
+	d := New(watch, Options{
+		Subscriptions: []Subscription{{
+			Name:       "cmdb",
+			URL:        srv.URL,
+			Secret:     []byte("s3cret"),
+			Kinds:      []string{"Component"},
+			Namespaces: []string{"default"},
+		}},
+		Timeout:      time.Second,
+		MaxAttempts:  1,
+		RetryBackoff: time.Millisecond,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		d.Run(ctx)
+		close(done)
+	}()
+
+	watch.events <- watchsvc.Event{Type: watchsvc.EventAdded, Kind: "Component", Object: newComponent(1, time.Now().Add(time.Hour), ""), Token: "t1"}
+
+	select {
+	case r := <-received:
+		body := <-bodies
+		assert.Equal(t, EventCreated, r.Header.Get(EventHeader))
+		assert.Equal(t, Sign([]byte("s3cret"), body), r.Header.Get(SignatureHeader))
+		var p Payload
+		require.NoError(t, json.Unmarshal(body, &p))
+		assert.Equal(t, p.ID, r.Header.Get(DeliveryHeader))
+		assert.Equal(t, "cmdb", p.Subscription)
+		assert.Equal(t, "api", p.Name)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no webhook delivered")
+	}
+	req := watch.req.Load()
+	assert.Equal(t, []string{"Component"}, req.Kinds)
+	assert.Equal(t, []string{"default"}, req.Namespaces)
+
+	cancel()
+	<-done
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package outboundwebhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// Headers set on every delivery.
+const (
+	// EventHeader carries the event of the payload.
+	EventHeader = "X-OpenChoreo-Event"
+	// DeliveryHeader carries the ID of the payload.
+	DeliveryHeader = "X-OpenChoreo-Delivery"
+	// SignatureHeader carries "sha256=" followed by the hex-encoded
+	// HMAC-SHA256 of the body, keyed with the subscription secret. It is only
+	// set for subscriptions with a secret.
+	SignatureHeader = "X-OpenChoreo-Signature"
+)
+
+// maxRetryBackoff caps the delay between delivery attempts.
+const maxRetryBackoff = time.Minute
+
+// sender posts payloads to an endpoint, retrying failed deliveries.
+type sender struct {
+	client       *http.Client
+	maxAttempts  int
+	retryBackoff time.Duration
+	logger       *slog.Logger
+}
+
+// deliveryError is a failed delivery attempt.
+type deliveryError struct {
+	err error
+	// retryable tells whether another attempt may succeed.
+	retryable bool
+}
+
+func (e *deliveryError) Error() string { return e.err.Error() }
+
+// send posts p to url until an attempt succeeds, the attempt fails with an
+// error a retry cannot fix, or the attempts run out. Payloads that cannot be
+// delivered are dead-lettered to the log.
+func (s *sender) send(ctx context.Context, url string, secret []byte, p *Payload) {
+	body, err := json.Marshal(p)
+	if err != nil {
+		s.deadLetter(p, 0, fmt.Errorf("failed to encode payload: %w", err))
+		return
+	}
+
+	backoff := s.retryBackoff
+	for attempt := 1; ; attempt++ {
+		derr := s.post(ctx, url, secret, p, body)
+		if derr == nil {
+			s.logger.Debug("Delivered webhook event", "delivery", p.ID, "event", p.Event, "attempts", attempt)
+			return
+		}
+		if !derr.retryable || attempt >= s.maxAttempts || ctx.Err() != nil {
+			s.deadLetter(p, attempt, derr)
+			return
+		}
+		s.logger.Warn("Webhook delivery failed, retrying", "delivery", p.ID, "attempt", attempt, "retryIn", backoff, "error", derr)
+		sleep(ctx, backoff)
+		backoff = min(2*backoff, maxRetryBackoff)
+	}
+}
+
+func (s *sender) post(ctx context.Context, url string, secret []byte, p *Payload, body []byte) *deliveryError {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return &deliveryError{err: fmt.Errorf("failed to create request: %w", err)}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, p.Event)
+	req.Header.Set(DeliveryHeader, p.ID)
+	if len(secret) > 0 {
+		req.Header.Set(SignatureHeader, Sign(secret, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return &deliveryError{err: err, retryable: true}
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return &deliveryError{
+			err:       fmt.Errorf("endpoint responded with status %d", resp.StatusCode),
+			retryable: resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError,
+		}
+	}
+	return nil
+}
+
+// deadLetter logs a payload that could not be delivered, with its body so
+// that it can be replayed.
+func (s *sender) deadLetter(p *Payload, attempts int, err error) {
+	body, _ := json.Marshal(p)
+	s.logger.Error("Dead-lettered webhook event",
+		"delivery", p.ID, "event", p.Event, "kind", p.Kind, "namespace", p.Namespace, "name", p.Name,
+		"attempts", attempts, "error", err, "payload", string(body))
+}
+
+// Sign returns the value of the SignatureHeader for body.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package outboundwebhook
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSend(t *testing.T) {
+	tests := []struct {
+		name           string
+		statuses       []int
+		wantAttempts   int32
+		wantDeadLetter bool
+	}{
+		{name: "delivered", statuses: []int{http.StatusOK}, wantAttempts: 1},
+		{name: "retried until delivered", statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusNoContent}, wantAttempts: 3},
+		{name: "dead-lettered after the last attempt", statuses: []int{http.StatusInternalServerError}, wantAttempts: 3, wantDeadLetter: true},
+		{name: "client errors are not retried", statuses: []int{http.StatusBadRequest}, wantAttempts: 1, wantDeadLetter: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				n := int(attempts.Add(1))
+				w.WriteHeader(tt.statuses[min(n, len(tt.statuses))-1])
+			}))
+			defer srv.Close()
+
+			var logs bytes.Buffer
+			s := &sender{
+				client:       srv.Client(),
+				maxAttempts:  3,
+				retryBackoff: time.Millisecond,
+				logger:       slog.New(slog.NewTextHandler(&logs, nil)),
+			}
+			s.send(context.Background(), srv.URL, nil, &Payload{ID: "d-1", Event: EventCreated, Kind: "Component", Name: "api"})
+
+			assert.Equal(t, tt.wantAttempts, attempts.Load())
+			assert.Equal(t, tt.wantDeadLetter, bytes.Contains(logs.Bytes(), []byte("Dead-lettered webhook event")))
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	// echo -n '{"id":"1"}' | openssl dgst -sha256 -hmac s3cret
+	assert.Equal(t, "sha256=06988fa1cf02b8383043f7f2735f723f7bb350950d9214409cde13490d6a6373", Sign([]byte("s3cret"), []byte(`{"id":"1"}`)))
+}
@@ -24,6 +24,9 @@ type ListOptions struct {
 	// LabelSelector is an optional label selector string to filter resources server-side
 	// (e.g., "app=frontend,tier=backend").
 	LabelSelector string
+	// Sort is an optional sort key such as "name" or "-creationTimestamp".
+	// It is handled by SortedList and not passed on to the data source.
+	Sort string
 }
 
 // BuildListOptions converts ListOptions into controller-runtime client.ListOption slice
@@ -57,6 +60,28 @@ type ListResult[T any] struct {
 	// RemainingCount is an approximate count of items remaining after this page.
 	// Nil when the count is unknown (e.g. authz-filtered queries).
 	RemainingCount *int64
+	// TotalCount is the number of items across all pages.
+	// Nil when the count is unknown without listing every item.
+	TotalCount *int64
+	// SortKey is the sort key the items are ordered by. Empty for the
+	// data source's natural order.
+	SortKey string
+	// AppliedFilters holds the filters the items were selected by, keyed by
+	// query parameter name.
+	AppliedFilters map[string]string
+}
+
+// WithFilter records a filter the items were selected by and returns the
+// result. Empty values are ignored.
+func (r *ListResult[T]) WithFilter(name, value string) *ListResult[T] {
+	if value == "" {
+		return r
+	}
+	if r.AppliedFilters == nil {
+		r.AppliedFilters = map[string]string{}
+	}
+	r.AppliedFilters[name] = value
+	return r
 }
 
 // paginationCursor is the internal structure encoded as the opaque cursor.
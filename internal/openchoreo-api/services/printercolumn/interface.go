@@ -0,0 +1,40 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package printercolumn
+
+import "context"
+
+// Column is an additional printer column of a kind, as declared by its CRD.
+type Column struct {
+	Name string
+	// Type is the OpenAPI type of the column: string, integer, number,
+	// boolean or date.
+	Type   string
+	Format string
+	// JSONPath selects the value of the column from an object of the kind.
+	JSONPath    string
+	Description string
+	// Priority 0 columns are shown in the standard view, higher priorities
+	// only in the wide view.
+	Priority int32
+}
+
+// PrinterColumns describes how objects of a kind are shown in tables.
+type PrinterColumns struct {
+	Kind       string
+	Plural     string
+	Singular   string
+	ShortNames []string
+	Namespaced bool
+	Columns    []Column
+}
+
+// Service resolves the printer columns of OpenChoreo kinds.
+type Service interface {
+	// GetPrinterColumns returns the printer columns of a kind. kind matches
+	// the kind, plural, singular or a short name of a CRD, ignoring case.
+	GetPrinterColumns(ctx context.Context, kind string) (*PrinterColumns, error)
+	// ListKinds returns the printer columns of every kind, sorted by kind.
+	ListKinds(ctx context.Context) ([]*PrinterColumns, error)
+}
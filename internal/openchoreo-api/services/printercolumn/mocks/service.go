@@ -0,0 +1,154 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	printercolumn "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/printercolumn"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// GetPrinterColumns provides a mock function with given fields: ctx, kind
+func (_m *MockService) GetPrinterColumns(ctx context.Context, kind string) (*printercolumn.PrinterColumns, error) {
+	ret := _m.Called(ctx, kind)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrinterColumns")
+	}
+
+	var r0 *printercolumn.PrinterColumns
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*printercolumn.PrinterColumns, error)); ok {
+		return rf(ctx, kind)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *printercolumn.PrinterColumns); ok {
+		r0 = rf(ctx, kind)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*printercolumn.PrinterColumns)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetPrinterColumns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrinterColumns'
+type MockService_GetPrinterColumns_Call struct {
+	*mock.Call
+}
+
+// GetPrinterColumns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - kind string
+func (_e *MockService_Expecter) GetPrinterColumns(ctx interface{}, kind interface{}) *MockService_GetPrinterColumns_Call {
+	return &MockService_GetPrinterColumns_Call{Call: _e.mock.On("GetPrinterColumns", ctx, kind)}
+}
+
+func (_c *MockService_GetPrinterColumns_Call) Run(run func(ctx context.Context, kind string)) *MockService_GetPrinterColumns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetPrinterColumns_Call) Return(_a0 *printercolumn.PrinterColumns, _a1 error) *MockService_GetPrinterColumns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetPrinterColumns_Call) RunAndReturn(run func(context.Context, string) (*printercolumn.PrinterColumns, error)) *MockService_GetPrinterColumns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListKinds provides a mock function with given fields: ctx
+func (_m *MockService) ListKinds(ctx context.Context) ([]*printercolumn.PrinterColumns, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListKinds")
+	}
+
+	var r0 []*printercolumn.PrinterColumns
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*printercolumn.PrinterColumns, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*printercolumn.PrinterColumns); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*printercolumn.PrinterColumns)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListKinds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListKinds'
+type MockService_ListKinds_Call struct {
+	*mock.Call
+}
+
+// ListKinds is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockService_Expecter) ListKinds(ctx interface{}) *MockService_ListKinds_Call {
+	return &MockService_ListKinds_Call{Call: _e.mock.On("ListKinds", ctx)}
+}
+
+func (_c *MockService_ListKinds_Call) Run(run func(ctx context.Context)) *MockService_ListKinds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockService_ListKinds_Call) Return(_a0 []*printercolumn.PrinterColumns, _a1 error) *MockService_ListKinds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListKinds_Call) RunAndReturn(run func(context.Context) ([]*printercolumn.PrinterColumns, error)) *MockService_ListKinds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
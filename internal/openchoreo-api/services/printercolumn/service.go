@@ -0,0 +1,141 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package printercolumn
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/yaml"
+
+	crdefs "github.com/openchoreo/openchoreo/config/crd"
+)
+
+// printerColumnService reads the printer columns from the CRDs embedded in
+// the binary, so the columns always match the API version being served.
+type printerColumnService struct {
+	crds   fs.FS
+	logger *slog.Logger
+
+	once  sync.Once
+	kinds []*PrinterColumns
+	err   error
+}
+
+var _ Service = (*printerColumnService)(nil)
+
+// NewService creates a printer column service for the embedded OpenChoreo
+// CRDs. Printer columns are public API metadata and need no authorization.
+func NewService(logger *slog.Logger) Service {
+	return newService(crdefs.FS, logger)
+}
+
+func newService(crds fs.FS, logger *slog.Logger) *printerColumnService {
+	return &printerColumnService{crds: crds, logger: logger}
+}
+
+func (s *printerColumnService) GetPrinterColumns(_ context.Context, kind string) (*PrinterColumns, error) {
+	kinds, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range kinds {
+		if matchesKind(k, kind) {
+			return k, nil
+		}
+	}
+	return nil, ErrKindNotFound
+}
+
+func (s *printerColumnService) ListKinds(_ context.Context) ([]*PrinterColumns, error) {
+	kinds, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	sorted := append([]*PrinterColumns(nil), kinds...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Kind < sorted[j].Kind })
+	return sorted, nil
+}
+
+// load parses the CRDs on first use.
+func (s *printerColumnService) load() ([]*PrinterColumns, error) {
+	s.once.Do(func() {
+		s.kinds, s.err = loadPrinterColumns(s.crds)
+	})
+	if s.err != nil {
+		s.logger.Error("Failed to load CRDs", "error", s.err)
+		return nil, s.err
+	}
+	return s.kinds, nil
+}
+
+// matchesKind reports whether name refers to the kind k.
+func matchesKind(k *PrinterColumns, name string) bool {
+	if strings.EqualFold(k.Kind, name) || strings.EqualFold(k.Plural, name) || strings.EqualFold(k.Singular, name) {
+		return true
+	}
+	for _, short := range k.ShortNames {
+		if strings.EqualFold(short, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// loadPrinterColumns parses every CRD under bases/ of crds.
+func loadPrinterColumns(crds fs.FS) ([]*PrinterColumns, error) {
+	paths, err := fs.Glob(crds, "bases/*.yaml")
+	if err != nil {
+		return nil, err
+	}
+
+	kinds := make([]*PrinterColumns, 0, len(paths))
+	for _, path := range paths {
+		data, err := fs.ReadFile(crds, path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		var crd extv1.CustomResourceDefinition
+		if err := yaml.Unmarshal(data, &crd); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		kinds = append(kinds, toPrinterColumns(&crd))
+	}
+	return kinds, nil
+}
+
+// toPrinterColumns returns the printer columns of the storage version of crd.
+func toPrinterColumns(crd *extv1.CustomResourceDefinition) *PrinterColumns {
+	pc := &PrinterColumns{
+		Kind:       crd.Spec.Names.Kind,
+		Plural:     crd.Spec.Names.Plural,
+		Singular:   crd.Spec.Names.Singular,
+		ShortNames: crd.Spec.Names.ShortNames,
+		Namespaced: crd.Spec.Scope == extv1.NamespaceScoped,
+		Columns:    []Column{},
+	}
+	for _, version := range crd.Spec.Versions {
+		if !version.Storage {
+			continue
+		}
+		for _, col := range version.AdditionalPrinterColumns {
+			pc.Columns = append(pc.Columns, Column{
+				Name:        col.Name,
+				Type:        col.Type,
+				Format:      col.Format,
+				JSONPath:    col.JSONPath,
+				Description: col.Description,
+				Priority:    col.Priority,
+			})
+		}
+	}
+	return pc
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package printercolumn
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const widgetCRD = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: widgets.openchoreo.dev
+spec:
+  group: openchoreo.dev
+  names:
+    kind: Widget
+    plural: widgets
+    singular: widget
+    shortNames: [wdg]
+  scope: Namespaced
+  versions:
+  - name: v1alpha1
+    served: true
+    storage: true
+    additionalPrinterColumns:
+    - jsonPath: .spec.size
+      name: Size
+      type: integer
+    - jsonPath: .status.message
+      name: Message
+      type: string
+      priority: 1
+    - jsonPath: .metadata.creationTimestamp
+      name: Age
+      type: date
+`
+
+func TestGetPrinterColumns(t *testing.T) {
+	svc := newService(fstest.MapFS{"bases/widgets.yaml": {Data: []byte(widgetCRD)}}, slog.Default())
+
+	for _, name := range []string{"Widget", "widget", "widgets", "WDG"} {
+		t.Run(name, func(t *testing.T) {
+			got, err := svc.GetPrinterColumns(context.Background(), name)
+			require.NoError(t, err)
+			assert.Equal(t, "Widget", got.Kind)
+			assert.Equal(t, "widgets", got.Plural)
+			assert.True(t, got.Namespaced)
+			assert.Equal(t, []Column{
+				{Name: "Size", Type: "integer", JSONPath: ".spec.size"},
+				{Name: "Message", Type: "string", JSONPath: ".status.message", Priority: 1},
+				{Name: "Age", Type: "date", JSONPath: ".metadata.creationTimestamp"},
+			}, got.Columns)
+		})
+	}
+
+	t.Run("unknown kind", func(t *testing.T) {
+		_, err := svc.GetPrinterColumns(context.Background(), "gadget")
+		assert.ErrorIs(t, err, ErrKindNotFound)
+	})
+}
+
+func TestGetPrinterColumns_EmbeddedCRDs(t *testing.T) {
+	svc := NewService(slog.Default())
+
+	component, err := svc.GetPrinterColumns(context.Background(), "comp")
+	require.NoError(t, err)
+	assert.Equal(t, "Component", component.Kind)
+	assert.True(t, component.Namespaced)
+	assert.NotEmpty(t, component.Columns)
+
+	dataPlane, err := svc.GetPrinterColumns(context.Background(), "clusterdataplanes")
+	require.NoError(t, err)
+	assert.False(t, dataPlane.Namespaced)
+}
+
+func TestListKinds_EmbeddedCRDs(t *testing.T) {
+	kinds, err := NewService(slog.Default()).ListKinds(context.Background())
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(kinds))
+	for _, k := range kinds {
+		names = append(names, k.Kind)
+	}
+	assert.IsIncreasing(t, names)
+	assert.Contains(t, names, "Component")
+	assert.Contains(t, names, "ClusterDataPlane")
+}
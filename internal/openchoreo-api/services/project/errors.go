@@ -6,6 +6,8 @@ package project
 import "errors"
 
 var (
-	ErrProjectNotFound      = errors.New("project not found")
-	ErrProjectAlreadyExists = errors.New("project already exists")
+	ErrProjectNotFound            = errors.New("project not found")
+	ErrProjectAlreadyExists       = errors.New("project already exists")
+	ErrNamespaceNotFound          = errors.New("namespace not found")
+	ErrDeploymentPipelineNotFound = errors.New("deployment pipeline not found")
 )
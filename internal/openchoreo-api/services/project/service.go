@@ -8,11 +8,13 @@ import (
 	"fmt"
 	"log/slog"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
@@ -47,6 +49,11 @@ func NewService(k8sClient client.Client, logger *slog.Logger) Service {
 	}
 }
 
+// CreateProject creates a project with the platform defaults: it must be
+// created in an OpenChoreo namespace, its name must be unique within the
+// namespace, it uses the default deployment pipeline and project type unless
+// others are given, the deployment pipeline must exist, and it is labeled with
+// its name like the components that belong to it.
 func (s *projectService) CreateProject(ctx context.Context, namespaceName string, project *openchoreov1alpha1.Project) (*openchoreov1alpha1.Project, error) {
 	if project == nil {
 		return nil, fmt.Errorf("project cannot be nil")
@@ -63,6 +70,9 @@ func (s *projectService) CreateProject(ctx context.Context, namespaceName string
 		s.logger.Warn("Project already exists", "namespace", namespaceName, "project", project.Name)
 		return nil, ErrProjectAlreadyExists
 	}
+	if err := s.ensureControlPlaneNamespace(ctx, namespaceName); err != nil {
+		return nil, err
+	}
 
 	project.Namespace = namespaceName
 	project.Status = openchoreov1alpha1.ProjectStatus{}
@@ -78,6 +88,13 @@ func (s *projectService) CreateProject(ctx context.Context, namespaceName string
 			Name: defaultProjectType,
 		}
 	}
+	if err := s.ensureDeploymentPipeline(ctx, namespaceName, project.Spec.DeploymentPipelineRef.Name); err != nil {
+		return nil, err
+	}
+	if project.Labels == nil {
+		project.Labels = make(map[string]string)
+	}
+	project.Labels[labels.LabelKeyProjectName] = project.Name
 
 	if err := s.k8sClient.Create(ctx, project); err != nil {
 		if apierrors.IsAlreadyExists(err) {
@@ -120,6 +137,10 @@ func (s *projectService) UpdateProject(ctx context.Context, namespaceName string
 	existing.Spec = project.Spec
 	existing.Labels = project.Labels
 	existing.Annotations = project.Annotations
+	if existing.Labels == nil {
+		existing.Labels = make(map[string]string)
+	}
+	existing.Labels[labels.LabelKeyProjectName] = existing.Name
 
 	if err := s.k8sClient.Update(ctx, existing); err != nil {
 		if vErr := services.ExtractValidationError(err); vErr != nil {
@@ -223,3 +244,37 @@ func (s *projectService) projectExists(ctx context.Context, namespaceName, proje
 	}
 	return true, nil
 }
+
+// ensureControlPlaneNamespace returns ErrNamespaceNotFound unless the namespace
+// exists and is an OpenChoreo control plane namespace.
+func (s *projectService) ensureControlPlaneNamespace(ctx context.Context, namespaceName string) error {
+	ns := &corev1.Namespace{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: namespaceName}, ns); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Namespace not found", "namespace", namespaceName)
+			return ErrNamespaceNotFound
+		}
+		s.logger.Error("Failed to get namespace", "error", err)
+		return fmt.Errorf("failed to get namespace: %w", err)
+	}
+	if ns.Labels[labels.LabelKeyControlPlaneNamespace] != labels.LabelValueTrue {
+		s.logger.Warn("Namespace is not a control plane namespace", "namespace", namespaceName)
+		return ErrNamespaceNotFound
+	}
+	return nil
+}
+
+// ensureDeploymentPipeline returns ErrDeploymentPipelineNotFound unless the
+// deployment pipeline exists in the namespace.
+func (s *projectService) ensureDeploymentPipeline(ctx context.Context, namespaceName, pipelineName string) error {
+	pipeline := &openchoreov1alpha1.DeploymentPipeline{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: pipelineName, Namespace: namespaceName}, pipeline); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			s.logger.Warn("Deployment pipeline not found", "namespace", namespaceName, "pipeline", pipelineName)
+			return ErrDeploymentPipelineNotFound
+		}
+		s.logger.Error("Failed to get deployment pipeline", "error", err)
+		return fmt.Errorf("failed to get deployment pipeline: %w", err)
+	}
+	return nil
+}
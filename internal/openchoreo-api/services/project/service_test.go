@@ -13,6 +13,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
 )
@@ -27,11 +28,25 @@ func newService(t *testing.T, objs ...client.Object) Service {
 	return NewService(testutil.NewFakeClient(objs...), testutil.TestLogger())
 }
 
+// newCreateService returns a service whose fake client holds the control plane
+// namespace and the deployment pipelines the create tests reference.
+func newCreateService(t *testing.T, objs ...client.Object) Service {
+	t.Helper()
+	ns := testutil.NewNamespace(testNamespace)
+	ns.Labels = map[string]string{labels.LabelKeyControlPlaneNamespace: labels.LabelValueTrue}
+	return newService(t, append([]client.Object{
+		ns,
+		testutil.NewDeploymentPipeline(testNamespace, defaultPipeline),
+		testutil.NewDeploymentPipeline(testNamespace, "my-pipeline"),
+		testutil.NewDeploymentPipeline(testNamespace, "custom-pipeline"),
+	}, objs...)...)
+}
+
 func TestCreateProject(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("success", func(t *testing.T) {
-		svc := newService(t)
+		svc := newCreateService(t)
 		proj := &openchoreov1alpha1.Project{
 			ObjectMeta: metav1.ObjectMeta{Name: testProjectName},
 			Spec: openchoreov1alpha1.ProjectSpec{
@@ -51,7 +66,7 @@ func TestCreateProject(t *testing.T) {
 	})
 
 	t.Run("nil input", func(t *testing.T) {
-		svc := newService(t)
+		svc := newCreateService(t)
 
 		_, err := svc.CreateProject(ctx, testNamespace, nil)
 		require.Error(t, err)
@@ -60,7 +75,7 @@ func TestCreateProject(t *testing.T) {
 
 	t.Run("already exists", func(t *testing.T) {
 		existing := testutil.NewProject(testNamespace, testProjectName)
-		svc := newService(t, existing)
+		svc := newCreateService(t, existing)
 		proj := &openchoreov1alpha1.Project{
 			ObjectMeta: metav1.ObjectMeta{Name: testProjectName},
 			Spec: openchoreov1alpha1.ProjectSpec{
@@ -73,7 +88,7 @@ func TestCreateProject(t *testing.T) {
 	})
 
 	t.Run("default pipeline ref when empty", func(t *testing.T) {
-		svc := newService(t)
+		svc := newCreateService(t)
 		proj := &openchoreov1alpha1.Project{
 			ObjectMeta: metav1.ObjectMeta{Name: "proj-no-pipeline"},
 			Spec:       openchoreov1alpha1.ProjectSpec{},
@@ -86,7 +101,7 @@ func TestCreateProject(t *testing.T) {
 	})
 
 	t.Run("explicit pipeline ref preserved", func(t *testing.T) {
-		svc := newService(t)
+		svc := newCreateService(t)
 		proj := &openchoreov1alpha1.Project{
 			ObjectMeta: metav1.ObjectMeta{Name: "proj-explicit"},
 			Spec: openchoreov1alpha1.ProjectSpec{
@@ -103,7 +118,7 @@ func TestCreateProject(t *testing.T) {
 	})
 
 	t.Run("default project type when empty", func(t *testing.T) {
-		svc := newService(t)
+		svc := newCreateService(t)
 		proj := &openchoreov1alpha1.Project{
 			ObjectMeta: metav1.ObjectMeta{Name: "proj-no-type"},
 			Spec:       openchoreov1alpha1.ProjectSpec{},
@@ -116,7 +131,7 @@ func TestCreateProject(t *testing.T) {
 	})
 
 	t.Run("explicit project type preserved", func(t *testing.T) {
-		svc := newService(t)
+		svc := newCreateService(t)
 		proj := &openchoreov1alpha1.Project{
 			ObjectMeta: metav1.ObjectMeta{Name: "proj-explicit-type"},
 			Spec: openchoreov1alpha1.ProjectSpec{
@@ -132,6 +147,44 @@ func TestCreateProject(t *testing.T) {
 		assert.Equal(t, "custom-type", result.Spec.Type.Name)
 		assert.Equal(t, openchoreov1alpha1.ProjectTypeRefKindProjectType, result.Spec.Type.Kind)
 	})
+	t.Run("sets project label", func(t *testing.T) {
+		svc := newCreateService(t)
+		proj := &openchoreov1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: "proj-labelled"}}
+
+		result, err := svc.CreateProject(ctx, testNamespace, proj)
+		require.NoError(t, err)
+		assert.Equal(t, "proj-labelled", result.Labels[labels.LabelKeyProjectName])
+	})
+
+	t.Run("namespace not found", func(t *testing.T) {
+		svc := newCreateService(t)
+		proj := &openchoreov1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: testProjectName}}
+
+		_, err := svc.CreateProject(ctx, "other-ns", proj)
+		require.ErrorIs(t, err, ErrNamespaceNotFound)
+	})
+
+	t.Run("namespace without control plane label", func(t *testing.T) {
+		svc := newService(t, testutil.NewNamespace(testNamespace),
+			testutil.NewDeploymentPipeline(testNamespace, defaultPipeline))
+		proj := &openchoreov1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: testProjectName}}
+
+		_, err := svc.CreateProject(ctx, testNamespace, proj)
+		require.ErrorIs(t, err, ErrNamespaceNotFound)
+	})
+
+	t.Run("deployment pipeline not found", func(t *testing.T) {
+		svc := newCreateService(t)
+		proj := &openchoreov1alpha1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: testProjectName},
+			Spec: openchoreov1alpha1.ProjectSpec{
+				DeploymentPipelineRef: openchoreov1alpha1.DeploymentPipelineRef{Name: "missing"},
+			},
+		}
+
+		_, err := svc.CreateProject(ctx, testNamespace, proj)
+		require.ErrorIs(t, err, ErrDeploymentPipelineNotFound)
+	})
 }
 
 func TestUpdateProject(t *testing.T) {
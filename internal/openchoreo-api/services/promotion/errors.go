@@ -0,0 +1,14 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package promotion
+
+import "errors"
+
+var (
+	ErrPromotionRequestNotFound   = errors.New("promotion request not found")
+	ErrPromotionNotAllowed        = errors.New("deployment pipeline does not allow this promotion")
+	ErrSourceNotDeployed          = errors.New("component has no release deployed to the source environment")
+	ErrPromotionRequestNotPending = errors.New("promotion request is not pending approval")
+	ErrCriticalVulnerabilities    = errors.New("image has critical vulnerabilities")
+)
@@ -0,0 +1,35 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package promotion
+
+import (
+	"context"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// RequestPromotionRequest contains the parameters for requesting a promotion.
+type RequestPromotionRequest struct {
+	ComponentName     string
+	SourceEnvironment string
+	TargetEnvironment string
+	Comment           string
+}
+
+// Service defines the promotion service interface.
+// Both the core service (no authz) and the authz-wrapped service implement this.
+type Service interface {
+	// RequestPromotion records a promotion of the release bound in the source environment to
+	// the target environment. When the promotion path does not require approval the release is
+	// bound to the target right away, otherwise the request waits for an approver.
+	RequestPromotion(ctx context.Context, namespaceName string, req *RequestPromotionRequest) (*openchoreov1alpha1.PromotionRequest, error)
+	// ListPromotionRequests lists promotion requests, optionally filtered by component.
+	ListPromotionRequests(ctx context.Context, namespaceName, componentName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.PromotionRequest], error)
+	GetPromotionRequest(ctx context.Context, namespaceName, promotionRequestName string) (*openchoreov1alpha1.PromotionRequest, error)
+	// ApprovePromotionRequest approves a pending promotion and binds its release to the target environment.
+	ApprovePromotionRequest(ctx context.Context, namespaceName, promotionRequestName, comment string) (*openchoreov1alpha1.PromotionRequest, error)
+	// RejectPromotionRequest rejects a pending promotion.
+	RejectPromotionRequest(ctx context.Context, namespaceName, promotionRequestName, comment string) (*openchoreov1alpha1.PromotionRequest, error)
+}
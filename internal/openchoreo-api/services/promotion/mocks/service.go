@@ -0,0 +1,343 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	services "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	promotion "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/promotion"
+	mock "github.com/stretchr/testify/mock"
+
+	v1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// ApprovePromotionRequest provides a mock function with given fields: ctx, namespaceName, promotionRequestName, comment
+func (_m *MockService) ApprovePromotionRequest(ctx context.Context, namespaceName string, promotionRequestName string, comment string) (*v1alpha1.PromotionRequest, error) {
+	ret := _m.Called(ctx, namespaceName, promotionRequestName, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApprovePromotionRequest")
+	}
+
+	var r0 *v1alpha1.PromotionRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*v1alpha1.PromotionRequest, error)); ok {
+		return rf(ctx, namespaceName, promotionRequestName, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *v1alpha1.PromotionRequest); ok {
+		r0 = rf(ctx, namespaceName, promotionRequestName, comment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.PromotionRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, promotionRequestName, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ApprovePromotionRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApprovePromotionRequest'
+type MockService_ApprovePromotionRequest_Call struct {
+	*mock.Call
+}
+
+// ApprovePromotionRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - promotionRequestName string
+//   - comment string
+func (_e *MockService_Expecter) ApprovePromotionRequest(ctx interface{}, namespaceName interface{}, promotionRequestName interface{}, comment interface{}) *MockService_ApprovePromotionRequest_Call {
+	return &MockService_ApprovePromotionRequest_Call{Call: _e.mock.On("ApprovePromotionRequest", ctx, namespaceName, promotionRequestName, comment)}
+}
+
+func (_c *MockService_ApprovePromotionRequest_Call) Run(run func(ctx context.Context, namespaceName string, promotionRequestName string, comment string)) *MockService_ApprovePromotionRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_ApprovePromotionRequest_Call) Return(_a0 *v1alpha1.PromotionRequest, _a1 error) *MockService_ApprovePromotionRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ApprovePromotionRequest_Call) RunAndReturn(run func(context.Context, string, string, string) (*v1alpha1.PromotionRequest, error)) *MockService_ApprovePromotionRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPromotionRequest provides a mock function with given fields: ctx, namespaceName, promotionRequestName
+func (_m *MockService) GetPromotionRequest(ctx context.Context, namespaceName string, promotionRequestName string) (*v1alpha1.PromotionRequest, error) {
+	ret := _m.Called(ctx, namespaceName, promotionRequestName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPromotionRequest")
+	}
+
+	var r0 *v1alpha1.PromotionRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*v1alpha1.PromotionRequest, error)); ok {
+		return rf(ctx, namespaceName, promotionRequestName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *v1alpha1.PromotionRequest); ok {
+		r0 = rf(ctx, namespaceName, promotionRequestName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.PromotionRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, promotionRequestName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetPromotionRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPromotionRequest'
+type MockService_GetPromotionRequest_Call struct {
+	*mock.Call
+}
+
+// GetPromotionRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - promotionRequestName string
+func (_e *MockService_Expecter) GetPromotionRequest(ctx interface{}, namespaceName interface{}, promotionRequestName interface{}) *MockService_GetPromotionRequest_Call {
+	return &MockService_GetPromotionRequest_Call{Call: _e.mock.On("GetPromotionRequest", ctx, namespaceName, promotionRequestName)}
+}
+
+func (_c *MockService_GetPromotionRequest_Call) Run(run func(ctx context.Context, namespaceName string, promotionRequestName string)) *MockService_GetPromotionRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetPromotionRequest_Call) Return(_a0 *v1alpha1.PromotionRequest, _a1 error) *MockService_GetPromotionRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetPromotionRequest_Call) RunAndReturn(run func(context.Context, string, string) (*v1alpha1.PromotionRequest, error)) *MockService_GetPromotionRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPromotionRequests provides a mock function with given fields: ctx, namespaceName, componentName, opts
+func (_m *MockService) ListPromotionRequests(ctx context.Context, namespaceName string, componentName string, opts services.ListOptions) (*services.ListResult[v1alpha1.PromotionRequest], error) {
+	ret := _m.Called(ctx, namespaceName, componentName, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPromotionRequests")
+	}
+
+	var r0 *services.ListResult[v1alpha1.PromotionRequest]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, services.ListOptions) (*services.ListResult[v1alpha1.PromotionRequest], error)); ok {
+		return rf(ctx, namespaceName, componentName, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, services.ListOptions) *services.ListResult[v1alpha1.PromotionRequest]); ok {
+		r0 = rf(ctx, namespaceName, componentName, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.ListResult[v1alpha1.PromotionRequest])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, services.ListOptions) error); ok {
+		r1 = rf(ctx, namespaceName, componentName, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListPromotionRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPromotionRequests'
+type MockService_ListPromotionRequests_Call struct {
+	*mock.Call
+}
+
+// ListPromotionRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - componentName string
+//   - opts services.ListOptions
+func (_e *MockService_Expecter) ListPromotionRequests(ctx interface{}, namespaceName interface{}, componentName interface{}, opts interface{}) *MockService_ListPromotionRequests_Call {
+	return &MockService_ListPromotionRequests_Call{Call: _e.mock.On("ListPromotionRequests", ctx, namespaceName, componentName, opts)}
+}
+
+func (_c *MockService_ListPromotionRequests_Call) Run(run func(ctx context.Context, namespaceName string, componentName string, opts services.ListOptions)) *MockService_ListPromotionRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(services.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockService_ListPromotionRequests_Call) Return(_a0 *services.ListResult[v1alpha1.PromotionRequest], _a1 error) *MockService_ListPromotionRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListPromotionRequests_Call) RunAndReturn(run func(context.Context, string, string, services.ListOptions) (*services.ListResult[v1alpha1.PromotionRequest], error)) *MockService_ListPromotionRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RejectPromotionRequest provides a mock function with given fields: ctx, namespaceName, promotionRequestName, comment
+func (_m *MockService) RejectPromotionRequest(ctx context.Context, namespaceName string, promotionRequestName string, comment string) (*v1alpha1.PromotionRequest, error) {
+	ret := _m.Called(ctx, namespaceName, promotionRequestName, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectPromotionRequest")
+	}
+
+	var r0 *v1alpha1.PromotionRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*v1alpha1.PromotionRequest, error)); ok {
+		return rf(ctx, namespaceName, promotionRequestName, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *v1alpha1.PromotionRequest); ok {
+		r0 = rf(ctx, namespaceName, promotionRequestName, comment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.PromotionRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, promotionRequestName, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_RejectPromotionRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectPromotionRequest'
+type MockService_RejectPromotionRequest_Call struct {
+	*mock.Call
+}
+
+// RejectPromotionRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - promotionRequestName string
+//   - comment string
+func (_e *MockService_Expecter) RejectPromotionRequest(ctx interface{}, namespaceName interface{}, promotionRequestName interface{}, comment interface{}) *MockService_RejectPromotionRequest_Call {
+	return &MockService_RejectPromotionRequest_Call{Call: _e.mock.On("RejectPromotionRequest", ctx, namespaceName, promotionRequestName, comment)}
+}
+
+func (_c *MockService_RejectPromotionRequest_Call) Run(run func(ctx context.Context, namespaceName string, promotionRequestName string, comment string)) *MockService_RejectPromotionRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_RejectPromotionRequest_Call) Return(_a0 *v1alpha1.PromotionRequest, _a1 error) *MockService_RejectPromotionRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_RejectPromotionRequest_Call) RunAndReturn(run func(context.Context, string, string, string) (*v1alpha1.PromotionRequest, error)) *MockService_RejectPromotionRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestPromotion provides a mock function with given fields: ctx, namespaceName, req
+func (_m *MockService) RequestPromotion(ctx context.Context, namespaceName string, req *promotion.RequestPromotionRequest) (*v1alpha1.PromotionRequest, error) {
+	ret := _m.Called(ctx, namespaceName, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestPromotion")
+	}
+
+	var r0 *v1alpha1.PromotionRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *promotion.RequestPromotionRequest) (*v1alpha1.PromotionRequest, error)); ok {
+		return rf(ctx, namespaceName, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *promotion.RequestPromotionRequest) *v1alpha1.PromotionRequest); ok {
+		r0 = rf(ctx, namespaceName, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.PromotionRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *promotion.RequestPromotionRequest) error); ok {
+		r1 = rf(ctx, namespaceName, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_RequestPromotion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestPromotion'
+type MockService_RequestPromotion_Call struct {
+	*mock.Call
+}
+
+// RequestPromotion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - req *promotion.RequestPromotionRequest
+func (_e *MockService_Expecter) RequestPromotion(ctx interface{}, namespaceName interface{}, req interface{}) *MockService_RequestPromotion_Call {
+	return &MockService_RequestPromotion_Call{Call: _e.mock.On("RequestPromotion", ctx, namespaceName, req)}
+}
+
+func (_c *MockService_RequestPromotion_Call) Run(run func(ctx context.Context, namespaceName string, req *promotion.RequestPromotionRequest)) *MockService_RequestPromotion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*promotion.RequestPromotionRequest))
+	})
+	return _c
+}
+
+func (_c *MockService_RequestPromotion_Call) Return(_a0 *v1alpha1.PromotionRequest, _a1 error) *MockService_RequestPromotion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_RequestPromotion_Call) RunAndReturn(run func(context.Context, string, *promotion.RequestPromotionRequest) (*v1alpha1.PromotionRequest, error)) *MockService_RequestPromotion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,393 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package promotion
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+	releasebindingsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/releasebinding"
+)
+
+var promotionRequestTypeMeta = metav1.TypeMeta{
+	APIVersion: openchoreov1alpha1.GroupVersion.String(),
+	Kind:       "PromotionRequest",
+}
+
+// promotionService handles promotion business logic without authorization checks.
+type promotionService struct {
+	k8sClient             client.Client
+	componentService      componentsvc.Service
+	releaseBindingService releasebindingsvc.Service
+	logger                *slog.Logger
+}
+
+var _ Service = (*promotionService)(nil)
+
+// NewService creates a new promotion service without authorization.
+func NewService(k8sClient client.Client, logger *slog.Logger) Service {
+	return &promotionService{
+		k8sClient:             k8sClient,
+		componentService:      componentsvc.NewService(k8sClient, logger.With("component", "component-service-internal")),
+		releaseBindingService: releasebindingsvc.NewService(k8sClient, logger.With("component", "releasebinding-service-internal")),
+		logger:                logger,
+	}
+}
+
+func (s *promotionService) RequestPromotion(ctx context.Context, namespaceName string, req *RequestPromotionRequest) (*openchoreov1alpha1.PromotionRequest, error) {
+	if req == nil {
+		return nil, fmt.Errorf("promotion request cannot be nil")
+	}
+	if req.SourceEnvironment == "" || req.TargetEnvironment == "" {
+		return nil, &services.ValidationError{Msg: "source and target environments are required"}
+	}
+	if req.SourceEnvironment == req.TargetEnvironment {
+		return nil, &services.ValidationError{Msg: "source and target environments must differ"}
+	}
+
+	s.logger.Debug("Requesting promotion", "namespace", namespaceName, "component", req.ComponentName,
+		"source", req.SourceEnvironment, "target", req.TargetEnvironment)
+
+	component, err := s.componentService.GetComponent(ctx, namespaceName, req.ComponentName)
+	if err != nil {
+		return nil, err
+	}
+	projectName := component.Spec.Owner.ProjectName
+
+	target, err := s.findPromotionTarget(ctx, namespaceName, projectName, req.SourceEnvironment, req.TargetEnvironment)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := s.findReleaseBinding(ctx, namespaceName, req.ComponentName, req.SourceEnvironment)
+	if err != nil {
+		return nil, err
+	}
+	if source == nil || source.Spec.ReleaseName == "" {
+		return nil, ErrSourceNotDeployed
+	}
+	if target.BlockCriticalVulnerabilities {
+		if err := s.checkCriticalVulnerabilities(ctx, namespaceName, req.ComponentName, source.Spec.ReleaseName); err != nil {
+			return nil, err
+		}
+	}
+
+	pr := &openchoreov1alpha1.PromotionRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-%s-", req.ComponentName, req.TargetEnvironment),
+			Namespace:    namespaceName,
+			Labels: map[string]string{
+				ocLabels.LabelKeyProjectName:   projectName,
+				ocLabels.LabelKeyComponentName: req.ComponentName,
+			},
+		},
+		Spec: openchoreov1alpha1.PromotionRequestSpec{
+			Owner: openchoreov1alpha1.PromotionRequestOwner{
+				ProjectName:   projectName,
+				ComponentName: req.ComponentName,
+			},
+			SourceEnvironment: req.SourceEnvironment,
+			TargetEnvironment: req.TargetEnvironment,
+			ReleaseName:       source.Spec.ReleaseName,
+			RequestedBy:       services.SubjectID(ctx),
+			Comment:           req.Comment,
+		},
+	}
+	if err := s.k8sClient.Create(ctx, pr); err != nil {
+		s.logger.Error("Failed to create promotion request", "error", err)
+		return nil, fmt.Errorf("failed to create promotion request: %w", err)
+	}
+
+	pr.Status.ApprovalRequired = target.RequiresApproval
+	if target.RequiresApproval {
+		pr.Status.Phase = openchoreov1alpha1.PromotionRequestPhasePendingApproval
+		if err := s.updateStatus(ctx, pr); err != nil {
+			return nil, err
+		}
+		s.logger.Debug("Promotion awaits approval", "namespace", namespaceName, "promotionRequest", pr.Name)
+		return pr, nil
+	}
+
+	if err := s.promote(ctx, pr); err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+func (s *promotionService) ListPromotionRequests(ctx context.Context, namespaceName, componentName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.PromotionRequest], error) {
+	s.logger.Debug("Listing promotion requests", "namespace", namespaceName, "component", componentName, "limit", opts.Limit, "cursor", opts.Cursor)
+
+	listFn := func(ctx context.Context, pageOpts services.ListOptions) (*services.ListResult[openchoreov1alpha1.PromotionRequest], error) {
+		commonOpts, err := services.BuildListOptions(pageOpts)
+		if err != nil {
+			return nil, err
+		}
+		listOpts := append([]client.ListOption{client.InNamespace(namespaceName)}, commonOpts...)
+
+		var prList openchoreov1alpha1.PromotionRequestList
+		if err := s.k8sClient.List(ctx, &prList, listOpts...); err != nil {
+			s.logger.Error("Failed to list promotion requests", "error", err)
+			return nil, fmt.Errorf("failed to list promotion requests: %w", err)
+		}
+
+		for i := range prList.Items {
+			prList.Items[i].TypeMeta = promotionRequestTypeMeta
+		}
+
+		result := &services.ListResult[openchoreov1alpha1.PromotionRequest]{
+			Items:      prList.Items,
+			NextCursor: prList.Continue,
+		}
+		if prList.RemainingItemCount != nil {
+			remaining := *prList.RemainingItemCount
+			result.RemainingCount = &remaining
+		}
+		return result, nil
+	}
+
+	// Apply component filter if specified
+	if componentName != "" {
+		filteredFn := services.PreFilteredList(
+			listFn,
+			func(pr openchoreov1alpha1.PromotionRequest) bool {
+				return pr.Spec.Owner.ComponentName == componentName
+			},
+		)
+		return filteredFn(ctx, opts)
+	}
+
+	return listFn(ctx, opts)
+}
+
+func (s *promotionService) GetPromotionRequest(ctx context.Context, namespaceName, promotionRequestName string) (*openchoreov1alpha1.PromotionRequest, error) {
+	s.logger.Debug("Getting promotion request", "namespace", namespaceName, "promotionRequest", promotionRequestName)
+
+	pr := &openchoreov1alpha1.PromotionRequest{}
+	key := client.ObjectKey{Namespace: namespaceName, Name: promotionRequestName}
+	if err := s.k8sClient.Get(ctx, key, pr); err != nil {
+		if apierrors.IsNotFound(err) {
+			s.logger.Warn("Promotion request not found", "namespace", namespaceName, "promotionRequest", promotionRequestName)
+			return nil, ErrPromotionRequestNotFound
+		}
+		s.logger.Error("Failed to get promotion request", "error", err)
+		return nil, fmt.Errorf("failed to get promotion request: %w", err)
+	}
+	pr.TypeMeta = promotionRequestTypeMeta
+	return pr, nil
+}
+
+func (s *promotionService) ApprovePromotionRequest(ctx context.Context, namespaceName, promotionRequestName, comment string) (*openchoreov1alpha1.PromotionRequest, error) {
+	pr, err := s.getPending(ctx, namespaceName, promotionRequestName)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Debug("Approving promotion request", "namespace", namespaceName, "promotionRequest", promotionRequestName)
+
+	// The scan of the image may have completed since the promotion was requested.
+	target, err := s.findPromotionTarget(ctx, namespaceName, pr.Spec.Owner.ProjectName, pr.Spec.SourceEnvironment, pr.Spec.TargetEnvironment)
+	if err != nil {
+		return nil, err
+	}
+	if target.BlockCriticalVulnerabilities {
+		if err := s.checkCriticalVulnerabilities(ctx, namespaceName, pr.Spec.Owner.ComponentName, pr.Spec.ReleaseName); err != nil {
+			return nil, err
+		}
+	}
+
+	pr.Status.Decision = &openchoreov1alpha1.PromotionDecision{
+		Approved:  true,
+		DecidedBy: services.SubjectID(ctx),
+		DecidedAt: metav1.Now(),
+		Comment:   comment,
+	}
+	if err := s.promote(ctx, pr); err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+func (s *promotionService) RejectPromotionRequest(ctx context.Context, namespaceName, promotionRequestName, comment string) (*openchoreov1alpha1.PromotionRequest, error) {
+	pr, err := s.getPending(ctx, namespaceName, promotionRequestName)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Debug("Rejecting promotion request", "namespace", namespaceName, "promotionRequest", promotionRequestName)
+
+	pr.Status.Phase = openchoreov1alpha1.PromotionRequestPhaseRejected
+	pr.Status.Decision = &openchoreov1alpha1.PromotionDecision{
+		Approved:  false,
+		DecidedBy: services.SubjectID(ctx),
+		DecidedAt: metav1.Now(),
+		Comment:   comment,
+	}
+	if err := s.updateStatus(ctx, pr); err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+// getPending fetches a promotion request and checks that it still awaits a decision.
+func (s *promotionService) getPending(ctx context.Context, namespaceName, promotionRequestName string) (*openchoreov1alpha1.PromotionRequest, error) {
+	pr, err := s.GetPromotionRequest(ctx, namespaceName, promotionRequestName)
+	if err != nil {
+		return nil, err
+	}
+	if pr.Status.Phase != openchoreov1alpha1.PromotionRequestPhasePendingApproval {
+		return nil, ErrPromotionRequestNotPending
+	}
+	return pr, nil
+}
+
+// promote binds the requested release to the target environment, creating the target
+// release binding when missing, and records the outcome in the request status.
+func (s *promotionService) promote(ctx context.Context, pr *openchoreov1alpha1.PromotionRequest) error {
+	namespaceName := pr.Namespace
+	componentName := pr.Spec.Owner.ComponentName
+	targetEnv := pr.Spec.TargetEnvironment
+
+	binding, err := s.findReleaseBinding(ctx, namespaceName, componentName, targetEnv)
+	if err != nil {
+		return err
+	}
+	if binding == nil {
+		binding = &openchoreov1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%s", componentName, targetEnv),
+				Namespace: namespaceName,
+			},
+			Spec: openchoreov1alpha1.ReleaseBindingSpec{
+				Owner: openchoreov1alpha1.ReleaseBindingOwner{
+					ProjectName:   pr.Spec.Owner.ProjectName,
+					ComponentName: componentName,
+				},
+				Environment: targetEnv,
+				ReleaseName: pr.Spec.ReleaseName,
+			},
+		}
+		controller.SetReleaseTrigger(binding, controller.ReleaseTriggerPromotion, pr.Spec.ReleaseName, pr.Name)
+		binding, err = s.releaseBindingService.CreateReleaseBinding(ctx, namespaceName, binding)
+	} else {
+		binding.Spec.ReleaseName = pr.Spec.ReleaseName
+		controller.SetReleaseTrigger(binding, controller.ReleaseTriggerPromotion, pr.Spec.ReleaseName, pr.Name)
+		binding, err = s.releaseBindingService.UpdateReleaseBinding(ctx, namespaceName, binding)
+	}
+	if err != nil {
+		return err
+	}
+
+	now := metav1.Now()
+	pr.Status.Phase = openchoreov1alpha1.PromotionRequestPhasePromoted
+	pr.Status.ReleaseBindingName = binding.Name
+	pr.Status.PromotedAt = &now
+	if err := s.updateStatus(ctx, pr); err != nil {
+		return err
+	}
+
+	s.logger.Debug("Release promoted", "namespace", namespaceName, "component", componentName,
+		"release", pr.Spec.ReleaseName, "environment", targetEnv, "releaseBinding", binding.Name)
+	return nil
+}
+
+func (s *promotionService) updateStatus(ctx context.Context, pr *openchoreov1alpha1.PromotionRequest) error {
+	if err := s.k8sClient.Status().Update(ctx, pr); err != nil {
+		s.logger.Error("Failed to update promotion request status", "error", err)
+		return fmt.Errorf("failed to update promotion request status: %w", err)
+	}
+	pr.TypeMeta = promotionRequestTypeMeta
+	return nil
+}
+
+// findPromotionTarget returns the target reference of the project's deployment pipeline
+// promotion path from sourceEnv to targetEnv.
+func (s *promotionService) findPromotionTarget(ctx context.Context, namespaceName, projectName, sourceEnv, targetEnv string) (*openchoreov1alpha1.TargetEnvironmentRef, error) {
+	project := &openchoreov1alpha1.Project{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: projectName}, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, projectsvc.ErrProjectNotFound
+		}
+		s.logger.Error("Failed to get project", "error", err)
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	pipeline := &openchoreov1alpha1.DeploymentPipeline{}
+	key := client.ObjectKey{Namespace: namespaceName, Name: project.Spec.DeploymentPipelineRef.Name}
+	if err := s.k8sClient.Get(ctx, key, pipeline); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, projectsvc.ErrDeploymentPipelineNotFound
+		}
+		s.logger.Error("Failed to get deployment pipeline", "error", err)
+		return nil, fmt.Errorf("failed to get deployment pipeline: %w", err)
+	}
+
+	for _, path := range pipeline.Spec.PromotionPaths {
+		if path.SourceEnvironmentRef.Name != sourceEnv {
+			continue
+		}
+		for i := range path.TargetEnvironmentRefs {
+			if path.TargetEnvironmentRefs[i].Name == targetEnv {
+				return &path.TargetEnvironmentRefs[i], nil
+			}
+		}
+	}
+	return nil, ErrPromotionNotAllowed
+}
+
+// checkCriticalVulnerabilities returns ErrCriticalVulnerabilities when a build of the component
+// that produced the image of the release reports critical vulnerabilities. Images without a
+// scan result pass.
+func (s *promotionService) checkCriticalVulnerabilities(ctx context.Context, namespaceName, componentName, releaseName string) error {
+	release := &openchoreov1alpha1.ComponentRelease{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: releaseName}, release); err != nil {
+		s.logger.Error("Failed to get component release", "error", err)
+		return fmt.Errorf("failed to get component release: %w", err)
+	}
+	image := release.Spec.Workload.Container.Image
+
+	runList := &openchoreov1alpha1.WorkflowRunList{}
+	if err := s.k8sClient.List(ctx, runList, client.InNamespace(namespaceName),
+		client.MatchingLabels{ocLabels.LabelKeyComponentName: componentName}); err != nil {
+		s.logger.Error("Failed to list workflow runs", "error", err)
+		return fmt.Errorf("failed to list workflow runs: %w", err)
+	}
+	for i := range runList.Items {
+		run := &runList.Items[i]
+		if run.Status.Image != image || run.Status.Scan == nil {
+			continue
+		}
+		if critical := run.Status.Scan.Summary.Critical; critical > 0 {
+			return fmt.Errorf("%w: %s reports %d critical vulnerabilities in %s", ErrCriticalVulnerabilities, run.Name, critical, image)
+		}
+	}
+	return nil
+}
+
+// findReleaseBinding returns the release binding of a component for an environment, or nil when none exists.
+func (s *promotionService) findReleaseBinding(ctx context.Context, namespaceName, componentName, environment string) (*openchoreov1alpha1.ReleaseBinding, error) {
+	bindingList := &openchoreov1alpha1.ReleaseBindingList{}
+	if err := s.k8sClient.List(ctx, bindingList, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list release bindings", "error", err)
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+
+	for i := range bindingList.Items {
+		rb := &bindingList.Items[i]
+		if rb.Spec.Owner.ComponentName == componentName && rb.Spec.Environment == environment {
+			return rb, nil
+		}
+	}
+	return nil, nil
+}
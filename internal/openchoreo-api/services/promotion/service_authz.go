@@ -0,0 +1,141 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package promotion
+
+import (
+	"context"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+)
+
+const resourceTypePromotionRequest = "promotionrequest"
+
+// promotionServiceWithAuthz wraps a Service and adds authorization checks.
+// Handlers should use this. Other services should use the unwrapped Service directly.
+type promotionServiceWithAuthz struct {
+	internal         Service
+	componentService componentsvc.Service
+	authz            *services.AuthzChecker
+}
+
+var _ Service = (*promotionServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates a promotion service with authorization checks.
+func NewServiceWithAuthz(k8sClient client.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &promotionServiceWithAuthz{
+		internal:         NewService(k8sClient, logger),
+		componentService: componentsvc.NewService(k8sClient, logger.With("component", "component-service-internal")),
+		authz:            services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+// RequestPromotion requires permission to create promotion requests for the target environment.
+func (s *promotionServiceWithAuthz) RequestPromotion(ctx context.Context, namespaceName string, req *RequestPromotionRequest) (*openchoreov1alpha1.PromotionRequest, error) {
+	if req == nil {
+		return s.internal.RequestPromotion(ctx, namespaceName, req)
+	}
+	// Fetch first to get the project for authz hierarchy
+	comp, err := s.componentService.GetComponent(ctx, namespaceName, req.ComponentName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionCreatePromotionRequest,
+		ResourceType: resourceTypePromotionRequest,
+		ResourceID:   req.ComponentName,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   comp.Spec.Owner.ProjectName,
+			Component: req.ComponentName,
+		},
+		Context: environmentContext(namespaceName, req.TargetEnvironment),
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.RequestPromotion(ctx, namespaceName, req)
+}
+
+func (s *promotionServiceWithAuthz) ListPromotionRequests(ctx context.Context, namespaceName, componentName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.PromotionRequest], error) {
+	return services.FilteredList(ctx, opts, s.authz,
+		func(ctx context.Context, pageOpts services.ListOptions) (*services.ListResult[openchoreov1alpha1.PromotionRequest], error) {
+			return s.internal.ListPromotionRequests(ctx, namespaceName, componentName, pageOpts)
+		},
+		func(pr openchoreov1alpha1.PromotionRequest) services.CheckRequest {
+			return viewCheck(&pr)
+		},
+	)
+}
+
+func (s *promotionServiceWithAuthz) GetPromotionRequest(ctx context.Context, namespaceName, promotionRequestName string) (*openchoreov1alpha1.PromotionRequest, error) {
+	pr, err := s.internal.GetPromotionRequest(ctx, namespaceName, promotionRequestName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, viewCheck(pr)); err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+// ApprovePromotionRequest requires the approve permission for the target environment.
+func (s *promotionServiceWithAuthz) ApprovePromotionRequest(ctx context.Context, namespaceName, promotionRequestName, comment string) (*openchoreov1alpha1.PromotionRequest, error) {
+	if err := s.checkApprove(ctx, namespaceName, promotionRequestName); err != nil {
+		return nil, err
+	}
+	return s.internal.ApprovePromotionRequest(ctx, namespaceName, promotionRequestName, comment)
+}
+
+// RejectPromotionRequest requires the approve permission for the target environment.
+func (s *promotionServiceWithAuthz) RejectPromotionRequest(ctx context.Context, namespaceName, promotionRequestName, comment string) (*openchoreov1alpha1.PromotionRequest, error) {
+	if err := s.checkApprove(ctx, namespaceName, promotionRequestName); err != nil {
+		return nil, err
+	}
+	return s.internal.RejectPromotionRequest(ctx, namespaceName, promotionRequestName, comment)
+}
+
+func (s *promotionServiceWithAuthz) checkApprove(ctx context.Context, namespaceName, promotionRequestName string) error {
+	// Fetch first to get the component and target environment for authz
+	pr, err := s.internal.GetPromotionRequest(ctx, namespaceName, promotionRequestName)
+	if err != nil {
+		return err
+	}
+	return s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionApprovePromotionRequest,
+		ResourceType: resourceTypePromotionRequest,
+		ResourceID:   pr.Name,
+		Hierarchy:    hierarchyOf(pr),
+		Context:      environmentContext(namespaceName, pr.Spec.TargetEnvironment),
+	})
+}
+
+func viewCheck(pr *openchoreov1alpha1.PromotionRequest) services.CheckRequest {
+	return services.CheckRequest{
+		Action:       authz.ActionViewPromotionRequest,
+		ResourceType: resourceTypePromotionRequest,
+		ResourceID:   pr.Name,
+		Hierarchy:    hierarchyOf(pr),
+		Context:      environmentContext(pr.Namespace, pr.Spec.TargetEnvironment),
+	}
+}
+
+func hierarchyOf(pr *openchoreov1alpha1.PromotionRequest) authz.ResourceHierarchy {
+	return authz.ResourceHierarchy{
+		Namespace: pr.Namespace,
+		Project:   pr.Spec.Owner.ProjectName,
+		Component: pr.Spec.Owner.ComponentName,
+	}
+}
+
+func environmentContext(namespaceName, environment string) authz.Context {
+	return authz.Context{
+		Resource: authz.ResourceAttribute{
+			Environment: services.FormatDualScopedResourceName(namespaceName, environment, false)},
+	}
+}
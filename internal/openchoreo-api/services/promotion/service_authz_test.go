@@ -0,0 +1,163 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package promotion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+// mockService is a local testify mock for the Service interface.
+// It exists here instead of importing promotion/mocks to avoid a cyclic import
+type mockService struct {
+	mock.Mock
+}
+
+func newMockService(t *testing.T) *mockService {
+	m := &mockService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *mockService) RequestPromotion(ctx context.Context, namespaceName string, req *RequestPromotionRequest) (*openchoreov1alpha1.PromotionRequest, error) {
+	args := m.Called(ctx, namespaceName, req)
+	res, _ := args.Get(0).(*openchoreov1alpha1.PromotionRequest)
+	return res, args.Error(1)
+}
+
+func (m *mockService) ListPromotionRequests(ctx context.Context, namespaceName, componentName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.PromotionRequest], error) {
+	args := m.Called(ctx, namespaceName, componentName, opts)
+	res, _ := args.Get(0).(*services.ListResult[openchoreov1alpha1.PromotionRequest])
+	return res, args.Error(1)
+}
+
+func (m *mockService) GetPromotionRequest(ctx context.Context, namespaceName, promotionRequestName string) (*openchoreov1alpha1.PromotionRequest, error) {
+	args := m.Called(ctx, namespaceName, promotionRequestName)
+	res, _ := args.Get(0).(*openchoreov1alpha1.PromotionRequest)
+	return res, args.Error(1)
+}
+
+func (m *mockService) ApprovePromotionRequest(ctx context.Context, namespaceName, promotionRequestName, comment string) (*openchoreov1alpha1.PromotionRequest, error) {
+	args := m.Called(ctx, namespaceName, promotionRequestName, comment)
+	res, _ := args.Get(0).(*openchoreov1alpha1.PromotionRequest)
+	return res, args.Error(1)
+}
+
+func (m *mockService) RejectPromotionRequest(ctx context.Context, namespaceName, promotionRequestName, comment string) (*openchoreov1alpha1.PromotionRequest, error) {
+	args := m.Called(ctx, namespaceName, promotionRequestName, comment)
+	res, _ := args.Get(0).(*openchoreov1alpha1.PromotionRequest)
+	return res, args.Error(1)
+}
+
+var promotionHierarchy = authzcore.ResourceHierarchy{Namespace: testNamespace, Project: testProjectName, Component: testComponentName}
+
+func newAuthzService(internal Service, pdp authzcore.PDP) *promotionServiceWithAuthz {
+	k8sClient := testutil.NewFakeClient(testutil.NewComponent(testNamespace, testProjectName, testComponentName))
+	return &promotionServiceWithAuthz{
+		internal:         internal,
+		componentService: componentsvc.NewService(k8sClient, testutil.TestLogger()),
+		authz:            testutil.NewTestAuthzChecker(pdp),
+	}
+}
+
+func pendingRequest(name string) *openchoreov1alpha1.PromotionRequest {
+	return &openchoreov1alpha1.PromotionRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Spec: openchoreov1alpha1.PromotionRequestSpec{
+			Owner:             openchoreov1alpha1.PromotionRequestOwner{ProjectName: testProjectName, ComponentName: testComponentName},
+			SourceEnvironment: "dev",
+			TargetEnvironment: "prod",
+			ReleaseName:       "rel-1",
+		},
+		Status: openchoreov1alpha1.PromotionRequestStatus{Phase: openchoreov1alpha1.PromotionRequestPhasePendingApproval},
+	}
+}
+
+func TestRequestPromotion_AuthzCheck(t *testing.T) {
+	req := &RequestPromotionRequest{ComponentName: testComponentName, SourceEnvironment: "dev", TargetEnvironment: "prod"}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("RequestPromotion", mock.Anything, testNamespace, req).Return(pendingRequest("pr-1"), nil)
+
+		_, err := newAuthzService(mockSvc, pdp).RequestPromotion(testutil.AuthzContext(), testNamespace, req)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "promotionrequest:create", "promotionrequest", testComponentName, promotionHierarchy)
+		require.Equal(t, "ns-1/prod", pdp.Captured[0].Context.Resource.Environment)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		_, err := newAuthzService(newMockService(t), testutil.DenyPDP()).RequestPromotion(testutil.AuthzContext(), testNamespace, req)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestGetPromotionRequest_AuthzCheck(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetPromotionRequest", mock.Anything, testNamespace, "pr-1").Return(pendingRequest("pr-1"), nil)
+
+		_, err := newAuthzService(mockSvc, pdp).GetPromotionRequest(testutil.AuthzContext(), testNamespace, "pr-1")
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "promotionrequest:view", "promotionrequest", "pr-1", promotionHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		mockSvc := newMockService(t)
+		mockSvc.On("GetPromotionRequest", mock.Anything, testNamespace, "pr-1").Return(pendingRequest("pr-1"), nil)
+
+		_, err := newAuthzService(mockSvc, testutil.DenyPDP()).GetPromotionRequest(testutil.AuthzContext(), testNamespace, "pr-1")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestDecidePromotionRequest_AuthzCheck(t *testing.T) {
+	t.Run("approve allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetPromotionRequest", mock.Anything, testNamespace, "pr-1").Return(pendingRequest("pr-1"), nil)
+		mockSvc.On("ApprovePromotionRequest", mock.Anything, testNamespace, "pr-1", "lgtm").Return(pendingRequest("pr-1"), nil)
+
+		_, err := newAuthzService(mockSvc, pdp).ApprovePromotionRequest(testutil.AuthzContext(), testNamespace, "pr-1", "lgtm")
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "promotionrequest:approve", "promotionrequest", "pr-1", promotionHierarchy)
+		require.Equal(t, "ns-1/prod", pdp.Captured[0].Context.Resource.Environment)
+	})
+
+	t.Run("reject requires approve permission", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("GetPromotionRequest", mock.Anything, testNamespace, "pr-1").Return(pendingRequest("pr-1"), nil)
+		mockSvc.On("RejectPromotionRequest", mock.Anything, testNamespace, "pr-1", "").Return(pendingRequest("pr-1"), nil)
+
+		_, err := newAuthzService(mockSvc, pdp).RejectPromotionRequest(testutil.AuthzContext(), testNamespace, "pr-1", "")
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "promotionrequest:approve", "promotionrequest", "pr-1", promotionHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		mockSvc := newMockService(t)
+		mockSvc.On("GetPromotionRequest", mock.Anything, testNamespace, "pr-1").Return(pendingRequest("pr-1"), nil)
+
+		_, err := newAuthzService(mockSvc, testutil.DenyPDP()).ApprovePromotionRequest(testutil.AuthzContext(), testNamespace, "pr-1", "")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
@@ -0,0 +1,271 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package promotion
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+const (
+	testNamespace     = "ns-1"
+	testProjectName   = "proj-1"
+	testComponentName = "comp-1"
+)
+
+func newService(objs ...client.Object) Service {
+	return NewService(testutil.NewFakeClient(objs...), testutil.TestLogger())
+}
+
+// seedObjects returns a component deployed to dev whose pipeline promotes dev to
+// staging freely and dev to prod with approval.
+func seedObjects() []client.Object {
+	pipeline := testutil.NewDeploymentPipeline(testNamespace, "default")
+	pipeline.Spec.PromotionPaths[0].TargetEnvironmentRefs = []openchoreov1alpha1.TargetEnvironmentRef{
+		{Name: "staging"},
+		{Name: "prod", RequiresApproval: true},
+	}
+	rb := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, "dev", "comp-1-dev")
+	rb.Spec.ReleaseName = "rel-1"
+	return []client.Object{
+		testutil.NewProject(testNamespace, testProjectName),
+		pipeline,
+		testutil.NewComponent(testNamespace, testProjectName, testComponentName),
+		rb,
+	}
+}
+
+func findBinding(t *testing.T, svc Service, environment string) *openchoreov1alpha1.ReleaseBinding {
+	t.Helper()
+	binding, err := svc.(*promotionService).findReleaseBinding(context.Background(), testNamespace, testComponentName, environment)
+	require.NoError(t, err)
+	return binding
+}
+
+func TestRequestPromotion(t *testing.T) {
+	ctx := testutil.AuthzContext()
+
+	t.Run("promotes immediately when no approval is required", func(t *testing.T) {
+		svc := newService(seedObjects()...)
+
+		pr, err := svc.RequestPromotion(ctx, testNamespace, &RequestPromotionRequest{
+			ComponentName:     testComponentName,
+			SourceEnvironment: "dev",
+			TargetEnvironment: "staging",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, openchoreov1alpha1.PromotionRequestPhasePromoted, pr.Status.Phase)
+		assert.False(t, pr.Status.ApprovalRequired)
+		assert.Equal(t, "rel-1", pr.Spec.ReleaseName)
+		assert.Equal(t, "user-1", pr.Spec.RequestedBy)
+		assert.Equal(t, "comp-1-staging", pr.Status.ReleaseBindingName)
+		assert.NotNil(t, pr.Status.PromotedAt)
+
+		binding := findBinding(t, svc, "staging")
+		require.NotNil(t, binding)
+		assert.Equal(t, "rel-1", binding.Spec.ReleaseName)
+	})
+
+	t.Run("waits for approval when required", func(t *testing.T) {
+		svc := newService(seedObjects()...)
+
+		pr, err := svc.RequestPromotion(ctx, testNamespace, &RequestPromotionRequest{
+			ComponentName:     testComponentName,
+			SourceEnvironment: "dev",
+			TargetEnvironment: "prod",
+			Comment:           "release 1",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, openchoreov1alpha1.PromotionRequestPhasePendingApproval, pr.Status.Phase)
+		assert.True(t, pr.Status.ApprovalRequired)
+		assert.Equal(t, "release 1", pr.Spec.Comment)
+		assert.Nil(t, findBinding(t, svc, "prod"))
+
+		got, err := svc.GetPromotionRequest(ctx, testNamespace, pr.Name)
+		require.NoError(t, err)
+		assert.Equal(t, openchoreov1alpha1.PromotionRequestPhasePendingApproval, got.Status.Phase)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			req     *RequestPromotionRequest
+			wantErr error
+		}{
+			{"component not found", &RequestPromotionRequest{ComponentName: "missing", SourceEnvironment: "dev", TargetEnvironment: "prod"},
+				componentsvc.ErrComponentNotFound},
+			{"no promotion path", &RequestPromotionRequest{ComponentName: testComponentName, SourceEnvironment: "prod", TargetEnvironment: "dev"},
+				ErrPromotionNotAllowed},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := newService(seedObjects()...).RequestPromotion(ctx, testNamespace, tt.req)
+				require.ErrorIs(t, err, tt.wantErr)
+			})
+		}
+	})
+
+	t.Run("source not deployed", func(t *testing.T) {
+		objs := seedObjects()
+		svc := newService(objs[:len(objs)-1]...)
+		_, err := svc.RequestPromotion(ctx, testNamespace, &RequestPromotionRequest{
+			ComponentName: testComponentName, SourceEnvironment: "dev", TargetEnvironment: "prod",
+		})
+		require.ErrorIs(t, err, ErrSourceNotDeployed)
+	})
+
+	t.Run("same source and target", func(t *testing.T) {
+		_, err := newService(seedObjects()...).RequestPromotion(ctx, testNamespace, &RequestPromotionRequest{
+			ComponentName: testComponentName, SourceEnvironment: "dev", TargetEnvironment: "dev",
+		})
+		var vErr *services.ValidationError
+		require.True(t, errors.As(err, &vErr))
+	})
+}
+
+func TestApproveAndRejectPromotionRequest(t *testing.T) {
+	ctx := testutil.AuthzContext()
+
+	requestProd := func(t *testing.T, svc Service) *openchoreov1alpha1.PromotionRequest {
+		t.Helper()
+		pr, err := svc.RequestPromotion(ctx, testNamespace, &RequestPromotionRequest{
+			ComponentName: testComponentName, SourceEnvironment: "dev", TargetEnvironment: "prod",
+		})
+		require.NoError(t, err)
+		return pr
+	}
+
+	t.Run("approve binds the release", func(t *testing.T) {
+		svc := newService(seedObjects()...)
+		pr := requestProd(t, svc)
+
+		approved, err := svc.ApprovePromotionRequest(ctx, testNamespace, pr.Name, "lgtm")
+		require.NoError(t, err)
+		assert.Equal(t, openchoreov1alpha1.PromotionRequestPhasePromoted, approved.Status.Phase)
+		require.NotNil(t, approved.Status.Decision)
+		assert.True(t, approved.Status.Decision.Approved)
+		assert.Equal(t, "user-1", approved.Status.Decision.DecidedBy)
+		assert.Equal(t, "lgtm", approved.Status.Decision.Comment)
+		assert.Equal(t, "comp-1-prod", approved.Status.ReleaseBindingName)
+
+		binding := findBinding(t, svc, "prod")
+		require.NotNil(t, binding)
+		assert.Equal(t, "rel-1", binding.Spec.ReleaseName)
+
+		_, err = svc.RejectPromotionRequest(ctx, testNamespace, pr.Name, "")
+		require.ErrorIs(t, err, ErrPromotionRequestNotPending)
+	})
+
+	t.Run("reject leaves the target untouched", func(t *testing.T) {
+		svc := newService(seedObjects()...)
+		pr := requestProd(t, svc)
+
+		rejected, err := svc.RejectPromotionRequest(ctx, testNamespace, pr.Name, "not yet")
+		require.NoError(t, err)
+		assert.Equal(t, openchoreov1alpha1.PromotionRequestPhaseRejected, rejected.Status.Phase)
+		require.NotNil(t, rejected.Status.Decision)
+		assert.False(t, rejected.Status.Decision.Approved)
+		assert.Nil(t, findBinding(t, svc, "prod"))
+
+		_, err = svc.ApprovePromotionRequest(ctx, testNamespace, pr.Name, "")
+		require.ErrorIs(t, err, ErrPromotionRequestNotPending)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := newService(seedObjects()...).ApprovePromotionRequest(ctx, testNamespace, "missing", "")
+		require.ErrorIs(t, err, ErrPromotionRequestNotFound)
+	})
+}
+
+func TestListPromotionRequests(t *testing.T) {
+	ctx := testutil.AuthzContext()
+	svc := newService(seedObjects()...)
+	_, err := svc.RequestPromotion(ctx, testNamespace, &RequestPromotionRequest{
+		ComponentName: testComponentName, SourceEnvironment: "dev", TargetEnvironment: "prod",
+	})
+	require.NoError(t, err)
+
+	result, err := svc.ListPromotionRequests(ctx, testNamespace, testComponentName, services.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, "PromotionRequest", result.Items[0].Kind)
+
+	result, err = svc.ListPromotionRequests(ctx, testNamespace, "other", services.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, result.Items)
+}
+
+func TestCriticalVulnerabilityPolicy(t *testing.T) {
+	ctx := testutil.AuthzContext()
+
+	// seedScanned blocks criticals on the dev to prod path and records a build of
+	// the released image whose scan reports the given number of criticals.
+	seedScanned := func(critical int32) []client.Object {
+		objs := seedObjects()
+		pipeline := objs[1].(*openchoreov1alpha1.DeploymentPipeline)
+		pipeline.Spec.PromotionPaths[0].TargetEnvironmentRefs[1].BlockCriticalVulnerabilities = true
+
+		release := testutil.NewComponentRelease(testNamespace, testProjectName, testComponentName, "rel-1")
+		run := testutil.NewWorkflowRun(testNamespace, "build", "build-1")
+		run.Labels = map[string]string{ocLabels.LabelKeyComponentName: testComponentName}
+		run.Status.Image = release.Spec.Workload.Container.Image
+		run.Status.Scan = &openchoreov1alpha1.ImageScanResult{
+			Scanner: "trivy",
+			Summary: openchoreov1alpha1.VulnerabilitySummary{Critical: critical, High: 2},
+		}
+		return append(objs, release, run)
+	}
+
+	t.Run("blocks a protected environment when criticals exist", func(t *testing.T) {
+		_, err := newService(seedScanned(1)...).RequestPromotion(ctx, testNamespace, &RequestPromotionRequest{
+			ComponentName: testComponentName, SourceEnvironment: "dev", TargetEnvironment: "prod",
+		})
+		require.ErrorIs(t, err, ErrCriticalVulnerabilities)
+	})
+
+	t.Run("allows a protected environment without criticals", func(t *testing.T) {
+		pr, err := newService(seedScanned(0)...).RequestPromotion(ctx, testNamespace, &RequestPromotionRequest{
+			ComponentName: testComponentName, SourceEnvironment: "dev", TargetEnvironment: "prod",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, openchoreov1alpha1.PromotionRequestPhasePendingApproval, pr.Status.Phase)
+	})
+
+	t.Run("ignores criticals for unprotected environments", func(t *testing.T) {
+		_, err := newService(seedScanned(3)...).RequestPromotion(ctx, testNamespace, &RequestPromotionRequest{
+			ComponentName: testComponentName, SourceEnvironment: "dev", TargetEnvironment: "staging",
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("blocks approval once the scan reports criticals", func(t *testing.T) {
+		objs := seedScanned(0)
+		fakeClient := testutil.NewFakeClient(objs...)
+		svc := NewService(fakeClient, testutil.TestLogger())
+		pr, err := svc.RequestPromotion(ctx, testNamespace, &RequestPromotionRequest{
+			ComponentName: testComponentName, SourceEnvironment: "dev", TargetEnvironment: "prod",
+		})
+		require.NoError(t, err)
+
+		run := &openchoreov1alpha1.WorkflowRun{}
+		require.NoError(t, fakeClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "build-1"}, run))
+		run.Status.Scan.Summary.Critical = 2
+		require.NoError(t, fakeClient.Status().Update(ctx, run))
+
+		_, err = svc.ApprovePromotionRequest(ctx, testNamespace, pr.Name, "")
+		require.ErrorIs(t, err, ErrCriticalVulnerabilities)
+		assert.Nil(t, findBinding(t, svc, "prod"))
+	})
+}
@@ -0,0 +1,11 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import "errors"
+
+var (
+	ErrProjectNotFound = errors.New("project not found")
+	ErrBindingNotFound = errors.New("project is not bound to the environment")
+)
@@ -0,0 +1,34 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"context"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// EnvironmentQuota is the quota of a project in one environment and its
+// usage as observed on the data plane.
+type EnvironmentQuota struct {
+	Environment string
+	// BindingName is the ProjectReleaseBinding carrying the quota.
+	BindingName string
+	// Quota is nil when the project has no quota in the environment.
+	Quota *openchoreov1alpha1.ProjectQuota
+	// Usage is nil until the data plane has reported usage of the quota.
+	Usage *openchoreov1alpha1.ProjectQuotaStatus
+}
+
+// Service defines the per-project quota operations. Quotas are stored on the
+// project's ProjectReleaseBinding of each environment, whose controller
+// materializes them in the cell namespace.
+type Service interface {
+	// ListProjectQuotas returns the quota of the project in every environment
+	// it is bound to, ordered by environment.
+	ListProjectQuotas(ctx context.Context, namespaceName, projectName string) ([]EnvironmentQuota, error)
+	// SetProjectQuota replaces the quota of the project in an environment. A nil
+	// quota removes it.
+	SetProjectQuota(ctx context.Context, namespaceName, projectName, environmentName string, quota *openchoreov1alpha1.ProjectQuota) (*EnvironmentQuota, error)
+}
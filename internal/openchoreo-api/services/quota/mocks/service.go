@@ -0,0 +1,162 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	quota "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/quota"
+
+	v1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// ListProjectQuotas provides a mock function with given fields: ctx, namespaceName, projectName
+func (_m *MockService) ListProjectQuotas(ctx context.Context, namespaceName string, projectName string) ([]quota.EnvironmentQuota, error) {
+	ret := _m.Called(ctx, namespaceName, projectName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjectQuotas")
+	}
+
+	var r0 []quota.EnvironmentQuota
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]quota.EnvironmentQuota, error)); ok {
+		return rf(ctx, namespaceName, projectName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []quota.EnvironmentQuota); ok {
+		r0 = rf(ctx, namespaceName, projectName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]quota.EnvironmentQuota)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, projectName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListProjectQuotas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjectQuotas'
+type MockService_ListProjectQuotas_Call struct {
+	*mock.Call
+}
+
+// ListProjectQuotas is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - projectName string
+func (_e *MockService_Expecter) ListProjectQuotas(ctx interface{}, namespaceName interface{}, projectName interface{}) *MockService_ListProjectQuotas_Call {
+	return &MockService_ListProjectQuotas_Call{Call: _e.mock.On("ListProjectQuotas", ctx, namespaceName, projectName)}
+}
+
+func (_c *MockService_ListProjectQuotas_Call) Run(run func(ctx context.Context, namespaceName string, projectName string)) *MockService_ListProjectQuotas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_ListProjectQuotas_Call) Return(_a0 []quota.EnvironmentQuota, _a1 error) *MockService_ListProjectQuotas_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListProjectQuotas_Call) RunAndReturn(run func(context.Context, string, string) ([]quota.EnvironmentQuota, error)) *MockService_ListProjectQuotas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetProjectQuota provides a mock function with given fields: ctx, namespaceName, projectName, environmentName, _a4
+func (_m *MockService) SetProjectQuota(ctx context.Context, namespaceName string, projectName string, environmentName string, _a4 *v1alpha1.ProjectQuota) (*quota.EnvironmentQuota, error) {
+	ret := _m.Called(ctx, namespaceName, projectName, environmentName, _a4)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetProjectQuota")
+	}
+
+	var r0 *quota.EnvironmentQuota
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, *v1alpha1.ProjectQuota) (*quota.EnvironmentQuota, error)); ok {
+		return rf(ctx, namespaceName, projectName, environmentName, _a4)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, *v1alpha1.ProjectQuota) *quota.EnvironmentQuota); ok {
+		r0 = rf(ctx, namespaceName, projectName, environmentName, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*quota.EnvironmentQuota)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, *v1alpha1.ProjectQuota) error); ok {
+		r1 = rf(ctx, namespaceName, projectName, environmentName, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_SetProjectQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetProjectQuota'
+type MockService_SetProjectQuota_Call struct {
+	*mock.Call
+}
+
+// SetProjectQuota is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - projectName string
+//   - environmentName string
+//   - _a4 *v1alpha1.ProjectQuota
+func (_e *MockService_Expecter) SetProjectQuota(ctx interface{}, namespaceName interface{}, projectName interface{}, environmentName interface{}, _a4 interface{}) *MockService_SetProjectQuota_Call {
+	return &MockService_SetProjectQuota_Call{Call: _e.mock.On("SetProjectQuota", ctx, namespaceName, projectName, environmentName, _a4)}
+}
+
+func (_c *MockService_SetProjectQuota_Call) Run(run func(ctx context.Context, namespaceName string, projectName string, environmentName string, _a4 *v1alpha1.ProjectQuota)) *MockService_SetProjectQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(*v1alpha1.ProjectQuota))
+	})
+	return _c
+}
+
+func (_c *MockService_SetProjectQuota_Call) Return(_a0 *quota.EnvironmentQuota, _a1 error) *MockService_SetProjectQuota_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_SetProjectQuota_Call) RunAndReturn(run func(context.Context, string, string, string, *v1alpha1.ProjectQuota) (*quota.EnvironmentQuota, error)) *MockService_SetProjectQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,205 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// quotaService handles project quotas without authorization checks.
+type quotaService struct {
+	k8sClient client.Client
+	logger    *slog.Logger
+}
+
+var _ Service = (*quotaService)(nil)
+
+// NewService creates a new quota service without authorization.
+func NewService(k8sClient client.Client, logger *slog.Logger) Service {
+	return &quotaService{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+func (s *quotaService) ListProjectQuotas(ctx context.Context, namespaceName, projectName string) ([]EnvironmentQuota, error) {
+	s.logger.Debug("Listing project quotas", "namespace", namespaceName, "project", projectName)
+
+	bindings, err := s.listBindings(ctx, namespaceName, projectName)
+	if err != nil {
+		return nil, err
+	}
+	quotas := make([]EnvironmentQuota, 0, len(bindings))
+	for i := range bindings {
+		quotas = append(quotas, toEnvironmentQuota(&bindings[i]))
+	}
+	slices.SortFunc(quotas, func(a, b EnvironmentQuota) int { return cmp.Compare(a.Environment, b.Environment) })
+	return quotas, nil
+}
+
+func (s *quotaService) SetProjectQuota(ctx context.Context, namespaceName, projectName, environmentName string, quota *openchoreov1alpha1.ProjectQuota) (*EnvironmentQuota, error) {
+	s.logger.Debug("Setting project quota", "namespace", namespaceName, "project", projectName, "environment", environmentName)
+
+	if err := validateQuota(quota); err != nil {
+		return nil, err
+	}
+	if quota != nil && len(quota.Hard) == 0 && len(quota.DefaultRequest) == 0 &&
+		len(quota.Default) == 0 && len(quota.Max) == 0 {
+		quota = nil
+	}
+
+	// Retry on conflict because the controller updates the binding status,
+	// which bumps resourceVersion.
+	var binding *openchoreov1alpha1.ProjectReleaseBinding
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		bindings, err := s.listBindings(ctx, namespaceName, projectName)
+		if err != nil {
+			return err
+		}
+		idx := slices.IndexFunc(bindings, func(b openchoreov1alpha1.ProjectReleaseBinding) bool {
+			return b.Spec.Environment == environmentName
+		})
+		if idx < 0 {
+			return ErrBindingNotFound
+		}
+		binding = &bindings[idx]
+		binding.Spec.Quota = quota
+		return s.k8sClient.Update(ctx, binding)
+	})
+	if err != nil {
+		if errors.Is(err, ErrProjectNotFound) || errors.Is(err, ErrBindingNotFound) {
+			return nil, err
+		}
+		if apierrors.IsInvalid(err) {
+			return nil, &services.ValidationError{Msg: services.ExtractValidationMessage(err)}
+		}
+		s.logger.Error("Failed to update project release binding quota", "error", err)
+		return nil, fmt.Errorf("failed to set project quota: %w", err)
+	}
+
+	s.logger.Debug("Project quota set", "namespace", namespaceName, "project", projectName,
+		"environment", environmentName, "projectReleaseBinding", binding.Name)
+	result := toEnvironmentQuota(binding)
+	return &result, nil
+}
+
+// listBindings returns the ProjectReleaseBindings owned by the project.
+func (s *quotaService) listBindings(ctx context.Context, namespaceName, projectName string) ([]openchoreov1alpha1.ProjectReleaseBinding, error) {
+	project := &openchoreov1alpha1.Project{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: projectName}, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, ErrProjectNotFound
+		}
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	var list openchoreov1alpha1.ProjectReleaseBindingList
+	if err := s.k8sClient.List(ctx, &list, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list project release bindings", "error", err)
+		return nil, fmt.Errorf("failed to list project release bindings: %w", err)
+	}
+	return slices.DeleteFunc(list.Items, func(b openchoreov1alpha1.ProjectReleaseBinding) bool {
+		return b.Spec.Owner.ProjectName != projectName
+	}), nil
+}
+
+func toEnvironmentQuota(binding *openchoreov1alpha1.ProjectReleaseBinding) EnvironmentQuota {
+	return EnvironmentQuota{
+		Environment: binding.Spec.Environment,
+		BindingName: binding.Name,
+		Quota:       binding.Spec.Quota,
+		Usage:       binding.Status.Quota,
+	}
+}
+
+// containerResources are the resources a LimitRange bounds per container.
+var containerResources = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory, corev1.ResourceEphemeralStorage}
+
+// validateQuota rejects resource names a ResourceQuota or container
+// LimitRange does not support and negative or inconsistent amounts, so that
+// mistakes surface here rather than as an apply failure on the data plane.
+func validateQuota(quota *openchoreov1alpha1.ProjectQuota) error {
+	if quota == nil {
+		return nil
+	}
+	for name, q := range quota.Hard {
+		if !isQuotaResource(name) {
+			return &services.ValidationError{Msg: fmt.Sprintf("hard: unsupported resource %q", name)}
+		}
+		if q.Sign() < 0 {
+			return &services.ValidationError{Msg: fmt.Sprintf("hard: %s must not be negative", name)}
+		}
+	}
+	for field, list := range map[string]corev1.ResourceList{
+		"defaultRequest": quota.DefaultRequest,
+		"default":        quota.Default,
+		"max":            quota.Max,
+	} {
+		for name, q := range list {
+			if !slices.Contains(containerResources, name) {
+				return &services.ValidationError{Msg: fmt.Sprintf("%s: unsupported resource %q, must be one of cpu, memory or ephemeral-storage", field, name)}
+			}
+			if q.Sign() < 0 {
+				return &services.ValidationError{Msg: fmt.Sprintf("%s: %s must not be negative", field, name)}
+			}
+		}
+	}
+	for _, name := range containerResources {
+		request, hasRequest := quota.DefaultRequest[name]
+		limit, hasLimit := quota.Default[name]
+		maxLimit, hasMax := quota.Max[name]
+		if hasRequest && hasLimit && request.Cmp(limit) > 0 {
+			return &services.ValidationError{Msg: fmt.Sprintf("defaultRequest: %s must not exceed default", name)}
+		}
+		if hasMax && hasLimit && limit.Cmp(maxLimit) > 0 {
+			return &services.ValidationError{Msg: fmt.Sprintf("default: %s must not exceed max", name)}
+		}
+		if hasMax && hasRequest && !hasLimit && request.Cmp(maxLimit) > 0 {
+			return &services.ValidationError{Msg: fmt.Sprintf("defaultRequest: %s must not exceed max", name)}
+		}
+	}
+	return nil
+}
+
+// isQuotaResource reports whether a ResourceQuota can limit the resource:
+// compute resources, optionally qualified by requests. or limits., storage,
+// object counts and hugepage requests.
+func isQuotaResource(name corev1.ResourceName) bool {
+	s := string(name)
+	switch name {
+	case corev1.ResourcePods, corev1.ResourceServices, corev1.ResourceReplicationControllers,
+		corev1.ResourceQuotas, corev1.ResourceSecrets, corev1.ResourceConfigMaps,
+		corev1.ResourcePersistentVolumeClaims, corev1.ResourceServicesNodePorts,
+		corev1.ResourceServicesLoadBalancers, corev1.ResourceRequestsStorage:
+		return true
+	}
+	if strings.HasPrefix(s, "count/") {
+		return len(s) > len("count/")
+	}
+	for _, prefix := range []string{"requests.", "limits.", ""} {
+		rest, ok := strings.CutPrefix(s, prefix)
+		if !ok {
+			continue
+		}
+		if slices.Contains(containerResources, corev1.ResourceName(rest)) ||
+			(prefix != "limits." && strings.HasPrefix(rest, corev1.ResourceHugePagesPrefix)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"context"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+const resourceTypeProjectReleaseBinding = "projectreleasebinding"
+
+// quotaServiceWithAuthz wraps a Service and adds authorization checks.
+// Quotas are part of the ProjectReleaseBinding of each environment, so they
+// are checked like the binding itself.
+type quotaServiceWithAuthz struct {
+	internal Service
+	authz    *services.AuthzChecker
+}
+
+var _ Service = (*quotaServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates a quota service with authorization checks.
+func NewServiceWithAuthz(k8sClient client.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &quotaServiceWithAuthz{
+		internal: NewService(k8sClient, logger),
+		authz:    services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+// ListProjectQuotas returns the quotas of the environments whose binding the
+// caller may view.
+func (s *quotaServiceWithAuthz) ListProjectQuotas(ctx context.Context, namespaceName, projectName string) ([]EnvironmentQuota, error) {
+	quotas, err := s.internal.ListProjectQuotas(ctx, namespaceName, projectName)
+	if err != nil || len(quotas) == 0 {
+		return quotas, err
+	}
+
+	requests := make([]services.CheckRequest, len(quotas))
+	for i, q := range quotas {
+		requests[i] = s.bindingCheck(authz.ActionViewProjectReleaseBinding, namespaceName, projectName, q)
+	}
+	decisions, err := s.authz.BatchCheck(ctx, requests)
+	if err != nil {
+		return nil, err
+	}
+	allowed := make([]EnvironmentQuota, 0, len(quotas))
+	for i, q := range quotas {
+		if i < len(decisions) && decisions[i] {
+			allowed = append(allowed, q)
+		}
+	}
+	return allowed, nil
+}
+
+// SetProjectQuota requires permission to update the binding of the
+// environment.
+func (s *quotaServiceWithAuthz) SetProjectQuota(ctx context.Context, namespaceName, projectName, environmentName string, quota *openchoreov1alpha1.ProjectQuota) (*EnvironmentQuota, error) {
+	quotas, err := s.internal.ListProjectQuotas(ctx, namespaceName, projectName)
+	if err != nil {
+		return nil, err
+	}
+	for _, q := range quotas {
+		if q.Environment != environmentName {
+			continue
+		}
+		if err := s.authz.Check(ctx, s.bindingCheck(authz.ActionUpdateProjectReleaseBinding, namespaceName, projectName, q)); err != nil {
+			return nil, err
+		}
+		return s.internal.SetProjectQuota(ctx, namespaceName, projectName, environmentName, quota)
+	}
+	return nil, ErrBindingNotFound
+}
+
+func (s *quotaServiceWithAuthz) bindingCheck(action, namespaceName, projectName string, q EnvironmentQuota) services.CheckRequest {
+	return services.CheckRequest{
+		Action:       action,
+		ResourceType: resourceTypeProjectReleaseBinding,
+		ResourceID:   q.BindingName,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   projectName,
+		},
+		Context: authz.Context{
+			Resource: authz.ResourceAttribute{Environment: services.FormatDualScopedResourceName(namespaceName, q.Environment, false)},
+		},
+	}
+}
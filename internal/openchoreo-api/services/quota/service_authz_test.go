@@ -0,0 +1,63 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func newAuthzService(pdp authzcore.PDP) Service {
+	return &quotaServiceWithAuthz{
+		internal: newService(testObjects()...),
+		authz:    testutil.NewTestAuthzChecker(pdp),
+	}
+}
+
+func TestListProjectQuotas_AuthzCheck(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		quotas, err := newAuthzService(pdp).ListProjectQuotas(testutil.AuthzContext(), testNamespace, testProject)
+		require.NoError(t, err)
+		assert.Len(t, quotas, 2)
+		require.Len(t, pdp.Captured, 2)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "projectreleasebinding:view", "projectreleasebinding", "shop-dev",
+			authzcore.ResourceHierarchy{Namespace: testNamespace, Project: testProject})
+	})
+
+	t.Run("denied filters environments", func(t *testing.T) {
+		quotas, err := newAuthzService(testutil.DenyPDP()).ListProjectQuotas(testutil.AuthzContext(), testNamespace, testProject)
+		require.NoError(t, err)
+		assert.Empty(t, quotas)
+	})
+}
+
+func TestSetProjectQuota_AuthzCheck(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		_, err := newAuthzService(pdp).SetProjectQuota(testutil.AuthzContext(), testNamespace, testProject, "prod", nil)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "projectreleasebinding:update", "projectreleasebinding", "shop-prod",
+			authzcore.ResourceHierarchy{Namespace: testNamespace, Project: testProject})
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		_, err := newAuthzService(testutil.DenyPDP()).SetProjectQuota(testutil.AuthzContext(), testNamespace, testProject, "prod", nil)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+
+	t.Run("unbound environment", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		_, err := newAuthzService(pdp).SetProjectQuota(testutil.AuthzContext(), testNamespace, testProject, "staging", nil)
+		require.ErrorIs(t, err, ErrBindingNotFound)
+		assert.Empty(t, pdp.Captured)
+	})
+}
@@ -0,0 +1,184 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+const (
+	testNamespace = "test-ns"
+	testProject   = "shop"
+)
+
+// testObjects returns the shop project bound to dev, with a quota and its
+// usage, and to prod, plus a binding of another project.
+func testObjects() []client.Object {
+	dev := testutil.NewProjectReleaseBinding(testNamespace, testProject, "dev", "shop-dev")
+	dev.Spec.Quota = &openchoreov1alpha1.ProjectQuota{
+		Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")},
+	}
+	dev.Status.Quota = &openchoreov1alpha1.ProjectQuotaStatus{
+		Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")},
+		Used: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("4")},
+	}
+	return []client.Object{
+		testutil.NewProject(testNamespace, testProject),
+		testutil.NewProject(testNamespace, "reports"),
+		testutil.NewProjectReleaseBinding(testNamespace, testProject, "prod", "shop-prod"),
+		dev,
+		testutil.NewProjectReleaseBinding(testNamespace, "reports", "dev", "reports-dev"),
+	}
+}
+
+func newService(objects ...client.Object) Service {
+	return NewService(testutil.NewFakeClient(objects...), testutil.TestLogger())
+}
+
+func TestListProjectQuotas(t *testing.T) {
+	quotas, err := newService(testObjects()...).ListProjectQuotas(t.Context(), testNamespace, testProject)
+	require.NoError(t, err)
+	require.Len(t, quotas, 2)
+
+	assert.Equal(t, "dev", quotas[0].Environment)
+	assert.Equal(t, "shop-dev", quotas[0].BindingName)
+	require.NotNil(t, quotas[0].Quota)
+	require.NotNil(t, quotas[0].Usage)
+	assert.Equal(t, "4", quotas[0].Usage.Used.Pods().String())
+
+	assert.Equal(t, "prod", quotas[1].Environment)
+	assert.Nil(t, quotas[1].Quota)
+	assert.Nil(t, quotas[1].Usage)
+
+	_, err = newService(testObjects()...).ListProjectQuotas(t.Context(), testNamespace, "missing")
+	require.ErrorIs(t, err, ErrProjectNotFound)
+}
+
+func TestSetProjectQuota(t *testing.T) {
+	k8sClient := testutil.NewFakeClient(testObjects()...)
+	svc := NewService(k8sClient, testutil.TestLogger())
+	quota := &openchoreov1alpha1.ProjectQuota{
+		Hard:           corev1.ResourceList{corev1.ResourceLimitsMemory: resource.MustParse("4Gi")},
+		DefaultRequest: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+		Default:        corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+	}
+
+	result, err := svc.SetProjectQuota(t.Context(), testNamespace, testProject, "prod", quota)
+	require.NoError(t, err)
+	assert.Equal(t, "shop-prod", result.BindingName)
+	assert.Equal(t, quota, result.Quota)
+
+	stored := &openchoreov1alpha1.ProjectReleaseBinding{}
+	require.NoError(t, k8sClient.Get(t.Context(), client.ObjectKey{Namespace: testNamespace, Name: "shop-prod"}, stored))
+	require.NotNil(t, stored.Spec.Quota)
+	assert.Equal(t, "4Gi", stored.Spec.Quota.Hard.Name(corev1.ResourceLimitsMemory, resource.BinarySI).String())
+
+	t.Run("empty quota removes it", func(t *testing.T) {
+		result, err := svc.SetProjectQuota(t.Context(), testNamespace, testProject, "dev", &openchoreov1alpha1.ProjectQuota{})
+		require.NoError(t, err)
+		assert.Nil(t, result.Quota)
+
+		stored := &openchoreov1alpha1.ProjectReleaseBinding{}
+		require.NoError(t, k8sClient.Get(t.Context(), client.ObjectKey{Namespace: testNamespace, Name: "shop-dev"}, stored))
+		assert.Nil(t, stored.Spec.Quota)
+	})
+
+	t.Run("unbound environment", func(t *testing.T) {
+		_, err := svc.SetProjectQuota(t.Context(), testNamespace, testProject, "staging", quota)
+		require.ErrorIs(t, err, ErrBindingNotFound)
+	})
+
+	t.Run("another project's binding", func(t *testing.T) {
+		_, err := svc.SetProjectQuota(t.Context(), testNamespace, "reports", "prod", quota)
+		require.ErrorIs(t, err, ErrBindingNotFound)
+	})
+}
+
+func TestValidateQuota(t *testing.T) {
+	tests := []struct {
+		name    string
+		quota   *openchoreov1alpha1.ProjectQuota
+		wantErr string
+	}{
+		{name: "nil"},
+		{
+			name: "valid",
+			quota: &openchoreov1alpha1.ProjectQuota{
+				Hard: corev1.ResourceList{
+					"requests.cpu":             resource.MustParse("2"),
+					"limits.memory":            resource.MustParse("4Gi"),
+					"pods":                     resource.MustParse("20"),
+					"count/deployments.apps":   resource.MustParse("5"),
+					"requests.hugepages-2Mi":   resource.MustParse("1Gi"),
+					"services.loadbalancers":   resource.MustParse("0"),
+					"persistentvolumeclaims":   resource.MustParse("3"),
+					"requests.storage":         resource.MustParse("10Gi"),
+					"ephemeral-storage":        resource.MustParse("1Gi"),
+					"limits.ephemeral-storage": resource.MustParse("2Gi"),
+				},
+				DefaultRequest: corev1.ResourceList{"cpu": resource.MustParse("100m")},
+				Default:        corev1.ResourceList{"cpu": resource.MustParse("500m")},
+				Max:            corev1.ResourceList{"cpu": resource.MustParse("1")},
+			},
+		},
+		{
+			name:    "unknown hard resource",
+			quota:   &openchoreov1alpha1.ProjectQuota{Hard: corev1.ResourceList{"limits.pods": resource.MustParse("1")}},
+			wantErr: `hard: unsupported resource "limits.pods"`,
+		},
+		{
+			name:    "hugepages limit",
+			quota:   &openchoreov1alpha1.ProjectQuota{Hard: corev1.ResourceList{"limits.hugepages-2Mi": resource.MustParse("1Gi")}},
+			wantErr: `hard: unsupported resource "limits.hugepages-2Mi"`,
+		},
+		{
+			name:    "negative",
+			quota:   &openchoreov1alpha1.ProjectQuota{Hard: corev1.ResourceList{"pods": resource.MustParse("-1")}},
+			wantErr: "hard: pods must not be negative",
+		},
+		{
+			name:    "qualified container resource",
+			quota:   &openchoreov1alpha1.ProjectQuota{Max: corev1.ResourceList{"limits.cpu": resource.MustParse("1")}},
+			wantErr: `max: unsupported resource "limits.cpu", must be one of cpu, memory or ephemeral-storage`,
+		},
+		{
+			name: "default request above default",
+			quota: &openchoreov1alpha1.ProjectQuota{
+				DefaultRequest: corev1.ResourceList{"memory": resource.MustParse("1Gi")},
+				Default:        corev1.ResourceList{"memory": resource.MustParse("512Mi")},
+			},
+			wantErr: "defaultRequest: memory must not exceed default",
+		},
+		{
+			name: "default above max",
+			quota: &openchoreov1alpha1.ProjectQuota{
+				Default: corev1.ResourceList{"memory": resource.MustParse("2Gi")},
+				Max:     corev1.ResourceList{"memory": resource.MustParse("1Gi")},
+			},
+			wantErr: "default: memory must not exceed max",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateQuota(tt.quota)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			var validationErr *services.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.wantErr, validationErr.Msg)
+		})
+	}
+}
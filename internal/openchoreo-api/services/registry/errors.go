@@ -0,0 +1,12 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import "errors"
+
+var (
+	ErrRegistryCredentialNotFound = errors.New("registry credential not found")
+	ErrInvalidRegistryCredentials = errors.New("registry rejected the credentials")
+	ErrRegistryUnreachable        = errors.New("registry is unreachable")
+)
@@ -0,0 +1,45 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"context"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// RegistryCredentialInfo represents the credentials of a container registry.
+// The password is never returned.
+type RegistryCredentialInfo struct {
+	Name        string
+	Namespace   string
+	Server      string
+	Username    string
+	TargetPlane openchoreov1alpha1.TargetPlaneRef
+}
+
+// CreateRegistryCredentialParams holds the parameters for storing registry credentials.
+type CreateRegistryCredentialParams struct {
+	Name     string
+	Server   string
+	Username string
+	Password string
+	Email    string
+	// TargetPlane is the workflow plane that pushes images with the credentials
+	// or the data plane that pulls them.
+	TargetPlane openchoreov1alpha1.TargetPlaneRef
+	// SkipValidation stores the credentials without probing the registry, e.g.
+	// for registries that are not reachable from the control plane.
+	SkipValidation bool
+}
+
+// Service defines the registry credential operations.
+type Service interface {
+	// ListRegistryCredentials returns all registry credentials in a namespace.
+	ListRegistryCredentials(ctx context.Context, namespaceName string) ([]RegistryCredentialInfo, error)
+	// CreateRegistryCredential validates the credentials against the registry and stores them.
+	CreateRegistryCredential(ctx context.Context, namespaceName string, req *CreateRegistryCredentialParams) (*RegistryCredentialInfo, error)
+	// DeleteRegistryCredential deletes registry credentials by name.
+	DeleteRegistryCredential(ctx context.Context, namespaceName, name string) error
+}
@@ -0,0 +1,204 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	registry "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/registry"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// CreateRegistryCredential provides a mock function with given fields: ctx, namespaceName, req
+func (_m *MockService) CreateRegistryCredential(ctx context.Context, namespaceName string, req *registry.CreateRegistryCredentialParams) (*registry.RegistryCredentialInfo, error) {
+	ret := _m.Called(ctx, namespaceName, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRegistryCredential")
+	}
+
+	var r0 *registry.RegistryCredentialInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *registry.CreateRegistryCredentialParams) (*registry.RegistryCredentialInfo, error)); ok {
+		return rf(ctx, namespaceName, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *registry.CreateRegistryCredentialParams) *registry.RegistryCredentialInfo); ok {
+		r0 = rf(ctx, namespaceName, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*registry.RegistryCredentialInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *registry.CreateRegistryCredentialParams) error); ok {
+		r1 = rf(ctx, namespaceName, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_CreateRegistryCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRegistryCredential'
+type MockService_CreateRegistryCredential_Call struct {
+	*mock.Call
+}
+
+// CreateRegistryCredential is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - req *registry.CreateRegistryCredentialParams
+func (_e *MockService_Expecter) CreateRegistryCredential(ctx interface{}, namespaceName interface{}, req interface{}) *MockService_CreateRegistryCredential_Call {
+	return &MockService_CreateRegistryCredential_Call{Call: _e.mock.On("CreateRegistryCredential", ctx, namespaceName, req)}
+}
+
+func (_c *MockService_CreateRegistryCredential_Call) Run(run func(ctx context.Context, namespaceName string, req *registry.CreateRegistryCredentialParams)) *MockService_CreateRegistryCredential_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*registry.CreateRegistryCredentialParams))
+	})
+	return _c
+}
+
+func (_c *MockService_CreateRegistryCredential_Call) Return(_a0 *registry.RegistryCredentialInfo, _a1 error) *MockService_CreateRegistryCredential_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_CreateRegistryCredential_Call) RunAndReturn(run func(context.Context, string, *registry.CreateRegistryCredentialParams) (*registry.RegistryCredentialInfo, error)) *MockService_CreateRegistryCredential_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRegistryCredential provides a mock function with given fields: ctx, namespaceName, name
+func (_m *MockService) DeleteRegistryCredential(ctx context.Context, namespaceName string, name string) error {
+	ret := _m.Called(ctx, namespaceName, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRegistryCredential")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, namespaceName, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_DeleteRegistryCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRegistryCredential'
+type MockService_DeleteRegistryCredential_Call struct {
+	*mock.Call
+}
+
+// DeleteRegistryCredential is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - name string
+func (_e *MockService_Expecter) DeleteRegistryCredential(ctx interface{}, namespaceName interface{}, name interface{}) *MockService_DeleteRegistryCredential_Call {
+	return &MockService_DeleteRegistryCredential_Call{Call: _e.mock.On("DeleteRegistryCredential", ctx, namespaceName, name)}
+}
+
+func (_c *MockService_DeleteRegistryCredential_Call) Run(run func(ctx context.Context, namespaceName string, name string)) *MockService_DeleteRegistryCredential_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_DeleteRegistryCredential_Call) Return(_a0 error) *MockService_DeleteRegistryCredential_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_DeleteRegistryCredential_Call) RunAndReturn(run func(context.Context, string, string) error) *MockService_DeleteRegistryCredential_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRegistryCredentials provides a mock function with given fields: ctx, namespaceName
+func (_m *MockService) ListRegistryCredentials(ctx context.Context, namespaceName string) ([]registry.RegistryCredentialInfo, error) {
+	ret := _m.Called(ctx, namespaceName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRegistryCredentials")
+	}
+
+	var r0 []registry.RegistryCredentialInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]registry.RegistryCredentialInfo, error)); ok {
+		return rf(ctx, namespaceName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []registry.RegistryCredentialInfo); ok {
+		r0 = rf(ctx, namespaceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]registry.RegistryCredentialInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespaceName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListRegistryCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRegistryCredentials'
+type MockService_ListRegistryCredentials_Call struct {
+	*mock.Call
+}
+
+// ListRegistryCredentials is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+func (_e *MockService_Expecter) ListRegistryCredentials(ctx interface{}, namespaceName interface{}) *MockService_ListRegistryCredentials_Call {
+	return &MockService_ListRegistryCredentials_Call{Call: _e.mock.On("ListRegistryCredentials", ctx, namespaceName)}
+}
+
+func (_c *MockService_ListRegistryCredentials_Call) Run(run func(ctx context.Context, namespaceName string)) *MockService_ListRegistryCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_ListRegistryCredentials_Call) Return(_a0 []registry.RegistryCredentialInfo, _a1 error) *MockService_ListRegistryCredentials_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListRegistryCredentials_Call) RunAndReturn(run func(context.Context, string) ([]registry.RegistryCredentialInfo, error)) *MockService_ListRegistryCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
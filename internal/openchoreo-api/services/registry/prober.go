@@ -0,0 +1,147 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/openchoreo/internal/imageref"
+)
+
+const (
+	probeTimeout = 15 * time.Second
+	// dockerHubAPIHost serves the registry API of Docker Hub.
+	dockerHubAPIHost = "registry-1.docker.io"
+)
+
+// Prober checks credentials against a container registry.
+type Prober interface {
+	// Probe returns ErrInvalidRegistryCredentials when the registry rejects the
+	// credentials and ErrRegistryUnreachable when it cannot be queried.
+	Probe(ctx context.Context, server, username, password string) error
+}
+
+// httpProber authenticates against the registry API (GET /v2/), following the
+// Docker token authentication flow when the registry issues a Bearer challenge.
+type httpProber struct {
+	client *http.Client
+}
+
+// NewProber creates a Prober that queries registries over HTTPS.
+func NewProber() Prober {
+	return &httpProber{client: &http.Client{Timeout: probeTimeout}}
+}
+
+func (p *httpProber) Probe(ctx context.Context, server, username, password string) error {
+	host := imageref.NormalizeRegistry(server)
+	if host == imageref.DockerHub {
+		host = dockerHubAPIHost
+	}
+
+	resp, err := p.get(ctx, "https://"+host+"/v2/", username, password)
+	if err != nil {
+		return err
+	}
+	defer drain(resp)
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusUnauthorized:
+		scheme, params := parseChallenge(resp.Header.Get("WWW-Authenticate"))
+		if !strings.EqualFold(scheme, "bearer") || params["realm"] == "" {
+			return ErrInvalidRegistryCredentials
+		}
+		return p.fetchToken(ctx, params, username, password)
+	case http.StatusForbidden:
+		return ErrInvalidRegistryCredentials
+	default:
+		return fmt.Errorf("%w: unexpected status %d from %s", ErrRegistryUnreachable, resp.StatusCode, host)
+	}
+}
+
+// fetchToken requests a token from the realm of a Bearer challenge, which only
+// succeeds when the token server accepts the credentials.
+func (p *httpProber) fetchToken(ctx context.Context, challenge map[string]string, username, password string) error {
+	realm, err := url.Parse(challenge["realm"])
+	if err != nil || (realm.Scheme != "https" && realm.Scheme != "http") {
+		return fmt.Errorf("%w: invalid token realm %q", ErrRegistryUnreachable, challenge["realm"])
+	}
+	query := realm.Query()
+	for _, key := range []string{"service", "scope"} {
+		if v := challenge[key]; v != "" {
+			query.Set(key, v)
+		}
+	}
+	realm.RawQuery = query.Encode()
+
+	resp, err := p.get(ctx, realm.String(), username, password)
+	if err != nil {
+		return err
+	}
+	defer drain(resp)
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return nil
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return ErrInvalidRegistryCredentials
+	default:
+		return fmt.Errorf("%w: unexpected status %d from token server", ErrRegistryUnreachable, resp.StatusCode)
+	}
+}
+
+func (p *httpProber) get(ctx context.Context, target, username, password string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRegistryUnreachable, err)
+	}
+	req.SetBasicAuth(username, password)
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRegistryUnreachable, err)
+	}
+	return resp, nil
+}
+
+func drain(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	_ = resp.Body.Close()
+}
+
+// parseChallenge parses a WWW-Authenticate header such as
+// `Bearer realm="https://auth.example.com/token",service="registry",scope="repository:a:pull,push"`
+// into its scheme and parameters. Quoted values may contain commas.
+func parseChallenge(header string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(header), " ")
+	params := map[string]string{}
+	for rest = strings.TrimSpace(rest); rest != ""; rest = strings.TrimSpace(rest) {
+		key, value, found := strings.Cut(rest, "=")
+		if !found {
+			break
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+		if strings.HasPrefix(value, `"`) {
+			end := strings.Index(value[1:], `"`)
+			if end < 0 {
+				params[key] = value[1:]
+				break
+			}
+			params[key] = value[1 : end+1]
+			rest = strings.TrimPrefix(strings.TrimSpace(value[end+2:]), ",")
+			continue
+		}
+		v, next, _ := strings.Cut(value, ",")
+		params[key] = strings.TrimSpace(v)
+		rest = next
+	}
+	return scheme, params
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRegistry starts a TLS registry that accepts bot:pw, either directly with
+// basic auth or through a token server when bearer is set.
+func newRegistry(t *testing.T, bearer bool) *httptest.Server {
+	t.Helper()
+	var srv *httptest.Server
+	srv = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		valid := ok && user == "bot" && pass == "pw"
+		switch r.URL.Path {
+		case "/v2/":
+			if bearer {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="`+srv.URL+`/token",service="registry.test",scope="repository:a/b:pull,push"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if !valid {
+				w.Header().Set("WWW-Authenticate", `Basic realm="registry"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		case "/token":
+			if r.URL.Query().Get("service") != "registry.test" || r.URL.Query().Get("scope") != "repository:a/b:pull,push" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if !valid {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"token":"t"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestHTTPProber(t *testing.T) {
+	for _, bearer := range []bool{false, true} {
+		srv := newRegistry(t, bearer)
+		prober := &httpProber{client: srv.Client()}
+
+		require.NoError(t, prober.Probe(context.Background(), srv.URL, "bot", "pw"), "bearer=%v", bearer)
+		require.ErrorIs(t, prober.Probe(context.Background(), srv.URL, "bot", "wrong"), ErrInvalidRegistryCredentials, "bearer=%v", bearer)
+	}
+
+	t.Run("unreachable registry", func(t *testing.T) {
+		srv := newRegistry(t, false)
+		prober := &httpProber{client: srv.Client()}
+		url := srv.URL
+		srv.Close()
+
+		require.ErrorIs(t, prober.Probe(context.Background(), url, "bot", "pw"), ErrRegistryUnreachable)
+	})
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.docker.io/token",service="registry.docker.io",scope="repository:a:pull,push"`)
+	assert.Equal(t, "Bearer", scheme)
+	assert.Equal(t, map[string]string{
+		"realm":   "https://auth.docker.io/token",
+		"service": "registry.docker.io",
+		"scope":   "repository:a:pull,push",
+	}, params)
+
+	scheme, params = parseChallenge(`Basic realm=registry, charset="UTF-8"`)
+	assert.Equal(t, "Basic", scheme)
+	assert.Equal(t, map[string]string{"realm": "registry", "charset": "UTF-8"}, params)
+}
@@ -0,0 +1,181 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/imageref"
+	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	secretsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secret"
+)
+
+const (
+	// annotationRegistryUsername records the username so it can be listed without
+	// reading the credentials back from the secret store.
+	annotationRegistryUsername = "openchoreo.dev/registry-username"
+	// dockerHubConfigKey is the key under which docker stores Docker Hub credentials.
+	dockerHubConfigKey = "https://index.docker.io/v1/"
+)
+
+// registryService stores registry credentials as dockerconfigjson secrets.
+// The ReleaseBinding controller turns the credentials stored on a data plane
+// into image pull secrets of the workloads that pull from the registry.
+type registryService struct {
+	k8sClient     client.Client
+	secretService secretsvc.Service
+	prober        Prober
+	logger        *slog.Logger
+}
+
+var _ Service = (*registryService)(nil)
+
+// NewService creates a new registry credential service without authorization.
+func NewService(k8sClient client.Client, planeClientProvider kubernetesClient.PlaneClientProvider, logger *slog.Logger) Service {
+	return &registryService{
+		k8sClient:     k8sClient,
+		secretService: secretsvc.NewService(k8sClient, planeClientProvider, logger.With("component", "secret-service-internal")),
+		prober:        NewProber(),
+		logger:        logger,
+	}
+}
+
+// ListRegistryCredentials returns all registry credentials in a namespace.
+func (s *registryService) ListRegistryCredentials(ctx context.Context, namespaceName string) ([]RegistryCredentialInfo, error) {
+	var refs openchoreov1alpha1.SecretReferenceList
+	if err := s.k8sClient.List(ctx, &refs,
+		client.InNamespace(namespaceName),
+		client.MatchingLabels{ocLabels.LabelKeySecretType: ocLabels.LabelValueRegistryCredentials},
+	); err != nil {
+		return nil, fmt.Errorf("failed to list registry credentials: %w", err)
+	}
+
+	items := make([]RegistryCredentialInfo, 0, len(refs.Items))
+	for i := range refs.Items {
+		items = append(items, toInfo(&refs.Items[i]))
+	}
+	return items, nil
+}
+
+// CreateRegistryCredential validates the credentials against the registry and
+// stores them in the secret store of the target plane.
+func (s *registryService) CreateRegistryCredential(ctx context.Context, namespaceName string, req *CreateRegistryCredentialParams) (*RegistryCredentialInfo, error) {
+	if req == nil {
+		return nil, fmt.Errorf("registry credential request cannot be nil")
+	}
+	server := imageref.NormalizeRegistry(req.Server)
+	if server == "" {
+		return nil, &services.ValidationError{Msg: "server is required"}
+	}
+	if req.Username == "" || req.Password == "" {
+		return nil, &services.ValidationError{Msg: "username and password are required"}
+	}
+
+	if !req.SkipValidation {
+		if err := s.prober.Probe(ctx, server, req.Username, req.Password); err != nil {
+			s.logger.Warn("Registry credential validation failed", "namespace", namespaceName, "server", server, "error", err)
+			return nil, err
+		}
+	}
+
+	dockerConfig, err := buildDockerConfigJSON(server, req.Username, req.Password, req.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := s.secretService.CreateSecret(ctx, namespaceName, &secretsvc.CreateSecretParams{
+		SecretName:  req.Name,
+		SecretType:  corev1.SecretTypeDockerConfigJson,
+		TargetPlane: req.TargetPlane,
+		Data:        map[string]string{corev1.DockerConfigJsonKey: dockerConfig},
+		Labels:      map[string]string{ocLabels.LabelKeySecretType: ocLabels.LabelValueRegistryCredentials},
+		Annotations: map[string]string{
+			ocLabels.AnnotationKeyRegistryServer: server,
+			annotationRegistryUsername:           req.Username,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Created registry credential", "namespace", namespaceName, "name", secret.Name, "server", server)
+	return &RegistryCredentialInfo{
+		Name:        secret.Name,
+		Namespace:   namespaceName,
+		Server:      server,
+		Username:    req.Username,
+		TargetPlane: req.TargetPlane,
+	}, nil
+}
+
+// DeleteRegistryCredential deletes registry credentials from the control plane
+// and the secret store.
+func (s *registryService) DeleteRegistryCredential(ctx context.Context, namespaceName, name string) error {
+	ref := &openchoreov1alpha1.SecretReference{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: name}, ref); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return ErrRegistryCredentialNotFound
+		}
+		return fmt.Errorf("failed to get registry credential: %w", err)
+	}
+	if ref.Labels[ocLabels.LabelKeySecretType] != ocLabels.LabelValueRegistryCredentials {
+		return ErrRegistryCredentialNotFound
+	}
+
+	if err := s.secretService.DeleteSecret(ctx, namespaceName, name); err != nil {
+		if errors.Is(err, secretsvc.ErrSecretNotFound) {
+			return ErrRegistryCredentialNotFound
+		}
+		return err
+	}
+
+	s.logger.Info("Deleted registry credential", "namespace", namespaceName, "name", name)
+	return nil
+}
+
+func toInfo(ref *openchoreov1alpha1.SecretReference) RegistryCredentialInfo {
+	info := RegistryCredentialInfo{
+		Name:      ref.Name,
+		Namespace: ref.Namespace,
+		Server:    ref.Annotations[ocLabels.AnnotationKeyRegistryServer],
+		Username:  ref.Annotations[annotationRegistryUsername],
+	}
+	if ref.Spec.TargetPlane != nil {
+		info.TargetPlane = *ref.Spec.TargetPlane
+	}
+	return info
+}
+
+// buildDockerConfigJSON returns the ".dockerconfigjson" payload that authenticates
+// to server. Docker Hub credentials use the key docker itself writes.
+func buildDockerConfigJSON(server, username, password, email string) (string, error) {
+	key := server
+	if server == imageref.DockerHub {
+		key = dockerHubConfigKey
+	}
+	entry := map[string]string{
+		"username": username,
+		"password": password,
+		"auth":     base64.StdEncoding.EncodeToString([]byte(username + ":" + password)),
+	}
+	if email != "" {
+		entry["email"] = email
+	}
+	b, err := json.Marshal(map[string]map[string]map[string]string{"auths": {key: entry}})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode docker config: %w", err)
+	}
+	return string(b), nil
+}
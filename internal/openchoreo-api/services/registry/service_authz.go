@@ -0,0 +1,83 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// Registry credentials are SecretReferences, so they are authorized with the
+// secret reference actions.
+const resourceTypeSecretReference = "secretReference"
+
+type registryServiceWithAuthz struct {
+	internal Service
+	authz    *services.AuthzChecker
+}
+
+var _ Service = (*registryServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates a new registry credential service with authorization.
+func NewServiceWithAuthz(k8sClient client.Client, planeClientProvider kubernetesClient.PlaneClientProvider, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &registryServiceWithAuthz{
+		internal: NewService(k8sClient, planeClientProvider, logger),
+		authz:    services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+// ListRegistryCredentials returns the registry credentials the caller is authorized to view.
+func (s *registryServiceWithAuthz) ListRegistryCredentials(ctx context.Context, namespaceName string) ([]RegistryCredentialInfo, error) {
+	items, err := s.internal.ListRegistryCredentials(ctx, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	authorized := make([]RegistryCredentialInfo, 0, len(items))
+	for _, item := range items {
+		if err := s.authz.Check(ctx, checkRequest(authz.ActionViewSecretReference, namespaceName, item.Name)); err != nil {
+			if errors.Is(err, services.ErrForbidden) {
+				continue
+			}
+			return nil, err
+		}
+		authorized = append(authorized, item)
+	}
+	return authorized, nil
+}
+
+// CreateRegistryCredential stores registry credentials after checking authorization.
+func (s *registryServiceWithAuthz) CreateRegistryCredential(ctx context.Context, namespaceName string, req *CreateRegistryCredentialParams) (*RegistryCredentialInfo, error) {
+	if req == nil {
+		return s.internal.CreateRegistryCredential(ctx, namespaceName, req)
+	}
+	if err := s.authz.Check(ctx, checkRequest(authz.ActionCreateSecretReference, namespaceName, req.Name)); err != nil {
+		return nil, err
+	}
+	return s.internal.CreateRegistryCredential(ctx, namespaceName, req)
+}
+
+// DeleteRegistryCredential deletes registry credentials after checking authorization.
+func (s *registryServiceWithAuthz) DeleteRegistryCredential(ctx context.Context, namespaceName, name string) error {
+	if err := s.authz.Check(ctx, checkRequest(authz.ActionDeleteSecretReference, namespaceName, name)); err != nil {
+		return err
+	}
+	return s.internal.DeleteRegistryCredential(ctx, namespaceName, name)
+}
+
+func checkRequest(action, namespaceName, name string) services.CheckRequest {
+	return services.CheckRequest{
+		Action:       action,
+		ResourceType: resourceTypeSecretReference,
+		ResourceID:   name,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName},
+	}
+}
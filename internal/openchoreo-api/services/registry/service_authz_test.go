@@ -0,0 +1,112 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+// mockService is a local testify mock for the Service interface.
+// It exists here instead of importing registry/mocks to avoid a cyclic import
+type mockService struct {
+	mock.Mock
+}
+
+func newMockService(t *testing.T) *mockService {
+	m := &mockService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *mockService) ListRegistryCredentials(ctx context.Context, namespaceName string) ([]RegistryCredentialInfo, error) {
+	args := m.Called(ctx, namespaceName)
+	res, _ := args.Get(0).([]RegistryCredentialInfo)
+	return res, args.Error(1)
+}
+
+func (m *mockService) CreateRegistryCredential(ctx context.Context, namespaceName string, req *CreateRegistryCredentialParams) (*RegistryCredentialInfo, error) {
+	args := m.Called(ctx, namespaceName, req)
+	res, _ := args.Get(0).(*RegistryCredentialInfo)
+	return res, args.Error(1)
+}
+
+func (m *mockService) DeleteRegistryCredential(ctx context.Context, namespaceName, name string) error {
+	args := m.Called(ctx, namespaceName, name)
+	return args.Error(0)
+}
+
+var namespaceHierarchy = authzcore.ResourceHierarchy{Namespace: testNamespace}
+
+func newAuthzService(internal Service, pdp authzcore.PDP) *registryServiceWithAuthz {
+	return &registryServiceWithAuthz{internal: internal, authz: testutil.NewTestAuthzChecker(pdp)}
+}
+
+func TestListRegistryCredentials_AuthzCheck(t *testing.T) {
+	items := []RegistryCredentialInfo{{Name: "ghcr", Namespace: testNamespace}}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("ListRegistryCredentials", mock.Anything, testNamespace).Return(items, nil)
+
+		result, err := newAuthzService(mockSvc, pdp).ListRegistryCredentials(testutil.AuthzContext(), testNamespace)
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "secretreference:view", "secretReference", "ghcr", namespaceHierarchy)
+	})
+
+	t.Run("denied items are filtered", func(t *testing.T) {
+		mockSvc := newMockService(t)
+		mockSvc.On("ListRegistryCredentials", mock.Anything, testNamespace).Return(items, nil)
+
+		result, err := newAuthzService(mockSvc, testutil.DenyPDP()).ListRegistryCredentials(testutil.AuthzContext(), testNamespace)
+		require.NoError(t, err)
+		require.Empty(t, result)
+	})
+}
+
+func TestCreateRegistryCredential_AuthzCheck(t *testing.T) {
+	req := &CreateRegistryCredentialParams{Name: "ghcr", Server: "ghcr.io", Username: "bot", Password: "pw"}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("CreateRegistryCredential", mock.Anything, testNamespace, req).Return(&RegistryCredentialInfo{Name: "ghcr"}, nil)
+
+		_, err := newAuthzService(mockSvc, pdp).CreateRegistryCredential(testutil.AuthzContext(), testNamespace, req)
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "secretreference:create", "secretReference", "ghcr", namespaceHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		_, err := newAuthzService(newMockService(t), testutil.DenyPDP()).CreateRegistryCredential(testutil.AuthzContext(), testNamespace, req)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestDeleteRegistryCredential_AuthzCheck(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("DeleteRegistryCredential", mock.Anything, testNamespace, "ghcr").Return(nil)
+
+		require.NoError(t, newAuthzService(mockSvc, pdp).DeleteRegistryCredential(testutil.AuthzContext(), testNamespace, "ghcr"))
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "secretreference:delete", "secretReference", "ghcr", namespaceHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		err := newAuthzService(newMockService(t), testutil.DenyPDP()).DeleteRegistryCredential(testutil.AuthzContext(), testNamespace, "ghcr")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
@@ -0,0 +1,185 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	secretsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secret"
+	secretmocks "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/secret/mocks"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+const testNamespace = "ns1"
+
+var dataPlaneRef = openchoreov1alpha1.TargetPlaneRef{Kind: "DataPlane", Name: "default"}
+
+// fakeProber records the servers it probes and returns a fixed result.
+type fakeProber struct {
+	err    error
+	probed []string
+}
+
+func (p *fakeProber) Probe(_ context.Context, server, _, _ string) error {
+	p.probed = append(p.probed, server)
+	return p.err
+}
+
+func newTestService(t *testing.T, prober Prober, objs ...client.Object) (*registryService, *secretmocks.MockService) {
+	t.Helper()
+	secretSvc := secretmocks.NewMockService(t)
+	return &registryService{
+		k8sClient:     testutil.NewFakeClient(objs...),
+		secretService: secretSvc,
+		prober:        prober,
+		logger:        testutil.TestLogger(),
+	}, secretSvc
+}
+
+func registrySecretReference(name, server string) *openchoreov1alpha1.SecretReference {
+	return &openchoreov1alpha1.SecretReference{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   testNamespace,
+			Labels:      map[string]string{ocLabels.LabelKeySecretType: ocLabels.LabelValueRegistryCredentials},
+			Annotations: map[string]string{ocLabels.AnnotationKeyRegistryServer: server, annotationRegistryUsername: "bot"},
+		},
+		Spec: openchoreov1alpha1.SecretReferenceSpec{TargetPlane: &dataPlaneRef},
+	}
+}
+
+func TestCreateRegistryCredential(t *testing.T) {
+	t.Run("stores validated credentials as a dockerconfigjson secret", func(t *testing.T) {
+		prober := &fakeProber{}
+		svc, secretSvc := newTestService(t, prober)
+
+		var captured *secretsvc.CreateSecretParams
+		secretSvc.EXPECT().CreateSecret(mock.Anything, testNamespace, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ string, req *secretsvc.CreateSecretParams) (*corev1.Secret, error) {
+				captured = req
+				return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: req.SecretName, Namespace: testNamespace}}, nil
+			})
+
+		info, err := svc.CreateRegistryCredential(context.Background(), testNamespace, &CreateRegistryCredentialParams{
+			Name:        "ghcr",
+			Server:      "https://GHCR.io/",
+			Username:    "bot",
+			Password:    "s3cret",
+			TargetPlane: dataPlaneRef,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ghcr.io"}, prober.probed)
+		assert.Equal(t, "ghcr.io", info.Server)
+		assert.Equal(t, "bot", info.Username)
+		assert.Equal(t, dataPlaneRef, info.TargetPlane)
+
+		require.NotNil(t, captured)
+		assert.Equal(t, corev1.SecretTypeDockerConfigJson, captured.SecretType)
+		assert.Equal(t, ocLabels.LabelValueRegistryCredentials, captured.Labels[ocLabels.LabelKeySecretType])
+		assert.Equal(t, "ghcr.io", captured.Annotations[ocLabels.AnnotationKeyRegistryServer])
+
+		var cfg struct {
+			Auths map[string]map[string]string `json:"auths"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(captured.Data[corev1.DockerConfigJsonKey]), &cfg))
+		assert.Equal(t, "Ym90OnMzY3JldA==", cfg.Auths["ghcr.io"]["auth"])
+	})
+
+	t.Run("docker hub credentials use the docker config key", func(t *testing.T) {
+		cfg, err := buildDockerConfigJSON("docker.io", "bot", "pw", "bot@example.com")
+		require.NoError(t, err)
+		assert.Contains(t, cfg, `"https://index.docker.io/v1/"`)
+		assert.Contains(t, cfg, `"email":"bot@example.com"`)
+	})
+
+	t.Run("rejected credentials are not stored", func(t *testing.T) {
+		svc, _ := newTestService(t, &fakeProber{err: ErrInvalidRegistryCredentials})
+
+		_, err := svc.CreateRegistryCredential(context.Background(), testNamespace, &CreateRegistryCredentialParams{
+			Name: "ghcr", Server: "ghcr.io", Username: "bot", Password: "wrong", TargetPlane: dataPlaneRef,
+		})
+		require.ErrorIs(t, err, ErrInvalidRegistryCredentials)
+	})
+
+	t.Run("validation can be skipped", func(t *testing.T) {
+		prober := &fakeProber{err: ErrRegistryUnreachable}
+		svc, secretSvc := newTestService(t, prober)
+		secretSvc.EXPECT().CreateSecret(mock.Anything, testNamespace, mock.Anything).
+			Return(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "internal"}}, nil)
+
+		_, err := svc.CreateRegistryCredential(context.Background(), testNamespace, &CreateRegistryCredentialParams{
+			Name: "internal", Server: "registry.internal:5000", Username: "bot", Password: "pw",
+			TargetPlane: dataPlaneRef, SkipValidation: true,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, prober.probed)
+	})
+
+	t.Run("missing fields", func(t *testing.T) {
+		svc, _ := newTestService(t, &fakeProber{})
+
+		_, err := svc.CreateRegistryCredential(context.Background(), testNamespace, &CreateRegistryCredentialParams{
+			Name: "ghcr", Username: "bot", Password: "pw",
+		})
+		var validationErr *services.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+
+		_, err = svc.CreateRegistryCredential(context.Background(), testNamespace, &CreateRegistryCredentialParams{
+			Name: "ghcr", Server: "ghcr.io", Username: "bot",
+		})
+		require.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func TestListRegistryCredentials(t *testing.T) {
+	other := registrySecretReference("plain", "")
+	other.Labels = nil
+	svc, _ := newTestService(t, &fakeProber{},
+		registrySecretReference("ghcr", "ghcr.io"),
+		registrySecretReference("hub", "docker.io"),
+		other,
+	)
+
+	items, err := svc.ListRegistryCredentials(context.Background(), testNamespace)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, "ghcr", items[0].Name)
+	assert.Equal(t, "ghcr.io", items[0].Server)
+	assert.Equal(t, "bot", items[0].Username)
+	assert.Equal(t, dataPlaneRef, items[0].TargetPlane)
+}
+
+func TestDeleteRegistryCredential(t *testing.T) {
+	plain := registrySecretReference("plain", "")
+	plain.Labels = nil
+
+	t.Run("deletes registry credentials", func(t *testing.T) {
+		svc, secretSvc := newTestService(t, &fakeProber{}, registrySecretReference("ghcr", "ghcr.io"))
+		secretSvc.EXPECT().DeleteSecret(mock.Anything, testNamespace, "ghcr").Return(nil)
+
+		require.NoError(t, svc.DeleteRegistryCredential(context.Background(), testNamespace, "ghcr"))
+	})
+
+	t.Run("other secrets are not registry credentials", func(t *testing.T) {
+		svc, _ := newTestService(t, &fakeProber{}, plain)
+		require.ErrorIs(t, svc.DeleteRegistryCredential(context.Background(), testNamespace, "plain"), ErrRegistryCredentialNotFound)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc, _ := newTestService(t, &fakeProber{})
+		require.ErrorIs(t, svc.DeleteRegistryCredential(context.Background(), testNamespace, "missing"), ErrRegistryCredentialNotFound)
+	})
+}
@@ -0,0 +1,15 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import "errors"
+
+var (
+	ErrNotDeployed          = errors.New("component is not deployed to the environment")
+	ErrNoPreviousRelease    = errors.New("no previous release to roll back to")
+	ErrReleaseNotInHistory  = errors.New("release is not in the environment's release history")
+	ErrReleaseAlreadyActive = errors.New("release is already active in the environment")
+	ErrReleaseNotFound      = errors.New("component release no longer exists")
+	ErrNoRolloutInProgress  = errors.New("no rollout is in progress in the environment")
+)
@@ -0,0 +1,54 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"context"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+)
+
+// HistoryEntry is a release of a component to an environment.
+type HistoryEntry struct {
+	Environment        string
+	ReleaseBindingName string
+	Record             openchoreov1alpha1.ReleaseRecord
+	// Current reports whether the release is the one currently bound to the environment.
+	Current bool
+}
+
+// RollbackResult is the outcome of a rollback.
+type RollbackResult struct {
+	ReleaseBinding *openchoreov1alpha1.ReleaseBinding
+	// Release is the release the environment was rolled back to.
+	Release string
+	// PreviousRelease is the release that was bound before the rollback.
+	PreviousRelease string
+}
+
+// RolloutAction is an action on the in-progress rollout of a release.
+type RolloutAction string
+
+const (
+	RolloutActionPause   RolloutAction = controller.RolloutActionPause
+	RolloutActionResume  RolloutAction = controller.RolloutActionResume
+	RolloutActionPromote RolloutAction = controller.RolloutActionPromote
+	RolloutActionAbort   RolloutAction = controller.RolloutActionAbort
+)
+
+// Service defines the release history service interface.
+// Both the core service (no authz) and the authz-wrapped service implement this.
+type Service interface {
+	// ListReleaseHistory returns the releases of a component, newest first. When
+	// environment is set only releases to that environment are returned.
+	ListReleaseHistory(ctx context.Context, namespaceName, componentName, environment string) ([]HistoryEntry, error)
+	// RollbackRelease binds a release from the environment's history again, restoring the
+	// environment configuration recorded with it. When targetRelease is empty the release
+	// before the current one is used.
+	RollbackRelease(ctx context.Context, namespaceName, componentName, environment, targetRelease string) (*RollbackResult, error)
+	// ControlRollout requests an action on the in-progress Canary or BlueGreen rollout of the
+	// component in the environment. The release controller applies it asynchronously.
+	ControlRollout(ctx context.Context, namespaceName, componentName, environment string, action RolloutAction) (*openchoreov1alpha1.ReleaseBinding, error)
+}
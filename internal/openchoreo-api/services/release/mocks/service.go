@@ -0,0 +1,224 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	release "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/release"
+	mock "github.com/stretchr/testify/mock"
+
+	v1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// ControlRollout provides a mock function with given fields: ctx, namespaceName, componentName, environment, action
+func (_m *MockService) ControlRollout(ctx context.Context, namespaceName string, componentName string, environment string, action release.RolloutAction) (*v1alpha1.ReleaseBinding, error) {
+	ret := _m.Called(ctx, namespaceName, componentName, environment, action)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ControlRollout")
+	}
+
+	var r0 *v1alpha1.ReleaseBinding
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, release.RolloutAction) (*v1alpha1.ReleaseBinding, error)); ok {
+		return rf(ctx, namespaceName, componentName, environment, action)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, release.RolloutAction) *v1alpha1.ReleaseBinding); ok {
+		r0 = rf(ctx, namespaceName, componentName, environment, action)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ReleaseBinding)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, release.RolloutAction) error); ok {
+		r1 = rf(ctx, namespaceName, componentName, environment, action)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ControlRollout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ControlRollout'
+type MockService_ControlRollout_Call struct {
+	*mock.Call
+}
+
+// ControlRollout is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - componentName string
+//   - environment string
+//   - action release.RolloutAction
+func (_e *MockService_Expecter) ControlRollout(ctx interface{}, namespaceName interface{}, componentName interface{}, environment interface{}, action interface{}) *MockService_ControlRollout_Call {
+	return &MockService_ControlRollout_Call{Call: _e.mock.On("ControlRollout", ctx, namespaceName, componentName, environment, action)}
+}
+
+func (_c *MockService_ControlRollout_Call) Run(run func(ctx context.Context, namespaceName string, componentName string, environment string, action release.RolloutAction)) *MockService_ControlRollout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(release.RolloutAction))
+	})
+	return _c
+}
+
+func (_c *MockService_ControlRollout_Call) Return(_a0 *v1alpha1.ReleaseBinding, _a1 error) *MockService_ControlRollout_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ControlRollout_Call) RunAndReturn(run func(context.Context, string, string, string, release.RolloutAction) (*v1alpha1.ReleaseBinding, error)) *MockService_ControlRollout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReleaseHistory provides a mock function with given fields: ctx, namespaceName, componentName, environment
+func (_m *MockService) ListReleaseHistory(ctx context.Context, namespaceName string, componentName string, environment string) ([]release.HistoryEntry, error) {
+	ret := _m.Called(ctx, namespaceName, componentName, environment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReleaseHistory")
+	}
+
+	var r0 []release.HistoryEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) ([]release.HistoryEntry, error)); ok {
+		return rf(ctx, namespaceName, componentName, environment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) []release.HistoryEntry); ok {
+		r0 = rf(ctx, namespaceName, componentName, environment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]release.HistoryEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, componentName, environment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListReleaseHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReleaseHistory'
+type MockService_ListReleaseHistory_Call struct {
+	*mock.Call
+}
+
+// ListReleaseHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - componentName string
+//   - environment string
+func (_e *MockService_Expecter) ListReleaseHistory(ctx interface{}, namespaceName interface{}, componentName interface{}, environment interface{}) *MockService_ListReleaseHistory_Call {
+	return &MockService_ListReleaseHistory_Call{Call: _e.mock.On("ListReleaseHistory", ctx, namespaceName, componentName, environment)}
+}
+
+func (_c *MockService_ListReleaseHistory_Call) Run(run func(ctx context.Context, namespaceName string, componentName string, environment string)) *MockService_ListReleaseHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_ListReleaseHistory_Call) Return(_a0 []release.HistoryEntry, _a1 error) *MockService_ListReleaseHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListReleaseHistory_Call) RunAndReturn(run func(context.Context, string, string, string) ([]release.HistoryEntry, error)) *MockService_ListReleaseHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RollbackRelease provides a mock function with given fields: ctx, namespaceName, componentName, environment, targetRelease
+func (_m *MockService) RollbackRelease(ctx context.Context, namespaceName string, componentName string, environment string, targetRelease string) (*release.RollbackResult, error) {
+	ret := _m.Called(ctx, namespaceName, componentName, environment, targetRelease)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollbackRelease")
+	}
+
+	var r0 *release.RollbackResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (*release.RollbackResult, error)); ok {
+		return rf(ctx, namespaceName, componentName, environment, targetRelease)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) *release.RollbackResult); ok {
+		r0 = rf(ctx, namespaceName, componentName, environment, targetRelease)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*release.RollbackResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, componentName, environment, targetRelease)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_RollbackRelease_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackRelease'
+type MockService_RollbackRelease_Call struct {
+	*mock.Call
+}
+
+// RollbackRelease is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - componentName string
+//   - environment string
+//   - targetRelease string
+func (_e *MockService_Expecter) RollbackRelease(ctx interface{}, namespaceName interface{}, componentName interface{}, environment interface{}, targetRelease interface{}) *MockService_RollbackRelease_Call {
+	return &MockService_RollbackRelease_Call{Call: _e.mock.On("RollbackRelease", ctx, namespaceName, componentName, environment, targetRelease)}
+}
+
+func (_c *MockService_RollbackRelease_Call) Run(run func(ctx context.Context, namespaceName string, componentName string, environment string, targetRelease string)) *MockService_RollbackRelease_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_RollbackRelease_Call) Return(_a0 *release.RollbackResult, _a1 error) *MockService_RollbackRelease_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_RollbackRelease_Call) RunAndReturn(run func(context.Context, string, string, string, string) (*release.RollbackResult, error)) *MockService_RollbackRelease_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// ControlRollout annotates the environment's release binding with the requested rollout action,
+// which the release binding controller applies and records in the rollout status.
+func (s *releaseService) ControlRollout(ctx context.Context, namespaceName, componentName, environment string, action RolloutAction) (*openchoreov1alpha1.ReleaseBinding, error) {
+	s.logger.Debug("Controlling rollout", "namespace", namespaceName, "component", componentName,
+		"environment", environment, "action", action)
+
+	switch action {
+	case RolloutActionPause, RolloutActionResume, RolloutActionPromote, RolloutActionAbort:
+	default:
+		return nil, &services.ValidationError{Msg: fmt.Sprintf("unknown rollout action %q", action)}
+	}
+
+	if _, err := s.componentService.GetComponent(ctx, namespaceName, componentName); err != nil {
+		return nil, err
+	}
+
+	binding, err := s.findReleaseBinding(ctx, namespaceName, componentName, environment)
+	if err != nil {
+		return nil, err
+	}
+	if !rolloutInProgress(binding) {
+		return nil, ErrNoRolloutInProgress
+	}
+
+	controller.SetRolloutAction(binding, string(action), time.Now())
+	updated, err := s.releaseBindingService.UpdateReleaseBinding(ctx, namespaceName, binding)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Debug("Rollout action requested", "namespace", namespaceName, "component", componentName,
+		"environment", environment, "action", action)
+	return updated, nil
+}
+
+// rolloutInProgress reports whether the binding's current release is being rolled out.
+func rolloutInProgress(binding *openchoreov1alpha1.ReleaseBinding) bool {
+	rollout := binding.Status.Rollout
+	if rollout == nil || rollout.CanaryRelease != binding.Spec.ReleaseName {
+		return false
+	}
+	return rollout.Phase == openchoreov1alpha1.RolloutPhaseProgressing || rollout.Phase == openchoreov1alpha1.RolloutPhasePaused
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func TestControlRollout(t *testing.T) {
+	ctx := context.Background()
+	comp := testutil.NewComponent(testNamespace, testProjectName, testComponentName)
+
+	rollingOut := func(phase openchoreov1alpha1.RolloutPhase) *openchoreov1alpha1.ReleaseBinding {
+		rb := bindingWithHistory("prod", "r2", record("r2", 10), record("r1", 0))
+		rb.Status.Rollout = &openchoreov1alpha1.RolloutStatus{
+			Strategy:      openchoreov1alpha1.RolloutStrategyCanary,
+			Phase:         phase,
+			StableRelease: "r1",
+			CanaryRelease: "r2",
+		}
+		return rb
+	}
+
+	t.Run("requests the action on the release binding", func(t *testing.T) {
+		rb, err := newService(comp, rollingOut(openchoreov1alpha1.RolloutPhasePaused)).
+			ControlRollout(ctx, testNamespace, testComponentName, "prod", RolloutActionPromote)
+		require.NoError(t, err)
+		action, _, ok := controller.GetRolloutAction(rb)
+		require.True(t, ok)
+		assert.Equal(t, controller.RolloutActionPromote, action)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			objs    []client.Object
+			action  RolloutAction
+			wantErr error
+		}{
+			{"component not found", nil, RolloutActionPause, componentsvc.ErrComponentNotFound},
+			{"not deployed", []client.Object{comp}, RolloutActionPause, ErrNotDeployed},
+			{"no rollout", []client.Object{comp, bindingWithHistory("prod", "r1", record("r1", 0))}, RolloutActionPause, ErrNoRolloutInProgress},
+			{"rollout complete", []client.Object{comp, rollingOut(openchoreov1alpha1.RolloutPhaseSucceeded)}, RolloutActionAbort, ErrNoRolloutInProgress},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := newService(tt.objs...).ControlRollout(ctx, testNamespace, testComponentName, "prod", tt.action)
+				require.ErrorIs(t, err, tt.wantErr)
+			})
+		}
+
+		_, err := newService(comp, rollingOut(openchoreov1alpha1.RolloutPhaseProgressing)).
+			ControlRollout(ctx, testNamespace, testComponentName, "prod", "restart")
+		var validationErr *services.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+}
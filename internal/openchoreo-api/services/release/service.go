@@ -0,0 +1,166 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	releasebindingsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/releasebinding"
+)
+
+// releaseService reads release history without authorization checks.
+type releaseService struct {
+	k8sClient             client.Client
+	componentService      componentsvc.Service
+	releaseBindingService releasebindingsvc.Service
+	logger                *slog.Logger
+}
+
+var _ Service = (*releaseService)(nil)
+
+// NewService creates a new release service without authorization.
+func NewService(k8sClient client.Client, logger *slog.Logger) Service {
+	return &releaseService{
+		k8sClient:             k8sClient,
+		componentService:      componentsvc.NewService(k8sClient, logger.With("component", "component-service-internal")),
+		releaseBindingService: releasebindingsvc.NewService(k8sClient, logger.With("component", "releasebinding-service-internal")),
+		logger:                logger,
+	}
+}
+
+// ListReleaseHistory merges the release history recorded on each of the component's
+// release bindings.
+func (s *releaseService) ListReleaseHistory(ctx context.Context, namespaceName, componentName, environment string) ([]HistoryEntry, error) {
+	s.logger.Debug("Listing release history", "namespace", namespaceName, "component", componentName, "environment", environment)
+
+	if _, err := s.componentService.GetComponent(ctx, namespaceName, componentName); err != nil {
+		return nil, err
+	}
+
+	bindingList := &openchoreov1alpha1.ReleaseBindingList{}
+	if err := s.k8sClient.List(ctx, bindingList, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list release bindings", "error", err)
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+
+	entries := make([]HistoryEntry, 0)
+	for i := range bindingList.Items {
+		rb := &bindingList.Items[i]
+		if rb.Spec.Owner.ComponentName != componentName {
+			continue
+		}
+		if environment != "" && rb.Spec.Environment != environment {
+			continue
+		}
+		deployed := rb.Spec.State != openchoreov1alpha1.ReleaseStateUndeploy
+		for j, record := range rb.Status.History {
+			entries = append(entries, HistoryEntry{
+				Environment:        rb.Spec.Environment,
+				ReleaseBindingName: rb.Name,
+				Record:             record,
+				Current:            j == 0 && deployed && record.ReleaseName == rb.Spec.ReleaseName,
+			})
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[j].Record.DeployedAt.Before(&entries[i].Record.DeployedAt)
+	})
+	return entries, nil
+}
+
+// RollbackRelease rebinds a release from the binding's history and marks it as a rollback
+// so the controller records it as such.
+func (s *releaseService) RollbackRelease(ctx context.Context, namespaceName, componentName, environment, targetRelease string) (*RollbackResult, error) {
+	s.logger.Debug("Rolling back release", "namespace", namespaceName, "component", componentName,
+		"environment", environment, "release", targetRelease)
+
+	if _, err := s.componentService.GetComponent(ctx, namespaceName, componentName); err != nil {
+		return nil, err
+	}
+
+	binding, err := s.findReleaseBinding(ctx, namespaceName, componentName, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := rollbackTarget(binding, targetRelease)
+	if err != nil {
+		return nil, err
+	}
+
+	release := &openchoreov1alpha1.ComponentRelease{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: record.ReleaseName}, release); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, ErrReleaseNotFound
+		}
+		s.logger.Error("Failed to get component release", "error", err)
+		return nil, fmt.Errorf("failed to get component release: %w", err)
+	}
+
+	previous := binding.Spec.ReleaseName
+	binding.Spec.ReleaseName = record.ReleaseName
+	if snapshot := record.Snapshot; snapshot != nil {
+		binding.Spec.ComponentTypeEnvironmentConfigs = snapshot.ComponentTypeEnvironmentConfigs
+		binding.Spec.TraitEnvironmentConfigs = snapshot.TraitEnvironmentConfigs
+		binding.Spec.WorkloadOverrides = snapshot.WorkloadOverrides
+	}
+	controller.SetReleaseTrigger(binding, controller.ReleaseTriggerRollback, record.ReleaseName, services.SubjectID(ctx))
+
+	updated, err := s.releaseBindingService.UpdateReleaseBinding(ctx, namespaceName, binding)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Debug("Release rolled back", "namespace", namespaceName, "component", componentName,
+		"environment", environment, "from", previous, "to", record.ReleaseName)
+	return &RollbackResult{ReleaseBinding: updated, Release: record.ReleaseName, PreviousRelease: previous}, nil
+}
+
+// rollbackTarget picks the history record to roll back to: the named release, or the
+// record before the current one when targetRelease is empty.
+func rollbackTarget(binding *openchoreov1alpha1.ReleaseBinding, targetRelease string) (*openchoreov1alpha1.ReleaseRecord, error) {
+	history := binding.Status.History
+	if targetRelease == "" {
+		if len(history) < 2 {
+			return nil, ErrNoPreviousRelease
+		}
+		return history[1].DeepCopy(), nil
+	}
+	for i := range history {
+		if history[i].ReleaseName != targetRelease {
+			continue
+		}
+		if i == 0 && binding.Spec.ReleaseName == targetRelease {
+			return nil, ErrReleaseAlreadyActive
+		}
+		return history[i].DeepCopy(), nil
+	}
+	return nil, ErrReleaseNotInHistory
+}
+
+func (s *releaseService) findReleaseBinding(ctx context.Context, namespaceName, componentName, environment string) (*openchoreov1alpha1.ReleaseBinding, error) {
+	bindingList := &openchoreov1alpha1.ReleaseBindingList{}
+	if err := s.k8sClient.List(ctx, bindingList, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list release bindings", "error", err)
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+	for i := range bindingList.Items {
+		rb := &bindingList.Items[i]
+		if rb.Spec.Owner.ComponentName == componentName && rb.Spec.Environment == environment {
+			return rb, nil
+		}
+	}
+	return nil, ErrNotDeployed
+}
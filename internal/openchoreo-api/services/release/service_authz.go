@@ -0,0 +1,151 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+)
+
+const resourceTypeReleaseBinding = "releasebinding"
+
+// releaseServiceWithAuthz wraps a Service and adds authorization checks.
+// Handlers should use this. Other services should use the unwrapped Service directly.
+type releaseServiceWithAuthz struct {
+	internal         Service
+	componentService componentsvc.Service
+	authz            *services.AuthzChecker
+}
+
+var _ Service = (*releaseServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates a release service with authorization checks.
+func NewServiceWithAuthz(k8sClient client.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &releaseServiceWithAuthz{
+		internal:         NewService(k8sClient, logger),
+		componentService: componentsvc.NewService(k8sClient, logger.With("component", "component-service-internal")),
+		authz:            services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+// ListReleaseHistory drops releases to environments whose release bindings the caller
+// is not allowed to view.
+func (s *releaseServiceWithAuthz) ListReleaseHistory(ctx context.Context, namespaceName, componentName, environment string) ([]HistoryEntry, error) {
+	// Fetch first to get the project for authz hierarchy
+	comp, err := s.componentService.GetComponent(ctx, namespaceName, componentName)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := s.internal.ListReleaseHistory(ctx, namespaceName, componentName, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	var envNames []string
+	seen := make(map[string]bool)
+	for _, entry := range entries {
+		if !seen[entry.Environment] {
+			seen[entry.Environment] = true
+			envNames = append(envNames, entry.Environment)
+		}
+	}
+	if len(envNames) == 0 {
+		return entries, nil
+	}
+	sort.Strings(envNames)
+
+	hierarchy := authz.ResourceHierarchy{
+		Namespace: namespaceName,
+		Project:   comp.Spec.Owner.ProjectName,
+		Component: componentName,
+	}
+	requests := make([]services.CheckRequest, len(envNames))
+	for i, env := range envNames {
+		requests[i] = services.CheckRequest{
+			Action:       authz.ActionViewReleaseBinding,
+			ResourceType: resourceTypeReleaseBinding,
+			ResourceID:   componentName,
+			Hierarchy:    hierarchy,
+			Context: authz.Context{
+				Resource: authz.ResourceAttribute{
+					Environment: services.FormatDualScopedResourceName(namespaceName, env, false)},
+			},
+		}
+	}
+	decisions, err := s.authz.BatchCheck(ctx, requests)
+	if err != nil {
+		return nil, err
+	}
+	allowed := make(map[string]bool, len(envNames))
+	for i, env := range envNames {
+		allowed[env] = i < len(decisions) && decisions[i]
+	}
+
+	filtered := make([]HistoryEntry, 0, len(entries))
+	for _, entry := range entries {
+		if allowed[entry.Environment] {
+			filtered = append(filtered, entry)
+		}
+	}
+	return filtered, nil
+}
+
+// RollbackRelease requires permission to update the release binding of the environment.
+func (s *releaseServiceWithAuthz) RollbackRelease(ctx context.Context, namespaceName, componentName, environment, targetRelease string) (*RollbackResult, error) {
+	// Fetch first to get the project for authz hierarchy
+	comp, err := s.componentService.GetComponent(ctx, namespaceName, componentName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionUpdateReleaseBinding,
+		ResourceType: resourceTypeReleaseBinding,
+		ResourceID:   componentName,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   comp.Spec.Owner.ProjectName,
+			Component: componentName,
+		},
+		Context: authz.Context{
+			Resource: authz.ResourceAttribute{
+				Environment: services.FormatDualScopedResourceName(namespaceName, environment, false)},
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.RollbackRelease(ctx, namespaceName, componentName, environment, targetRelease)
+}
+
+// ControlRollout requires permission to update the release binding of the environment.
+func (s *releaseServiceWithAuthz) ControlRollout(ctx context.Context, namespaceName, componentName, environment string, action RolloutAction) (*openchoreov1alpha1.ReleaseBinding, error) {
+	comp, err := s.componentService.GetComponent(ctx, namespaceName, componentName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionUpdateReleaseBinding,
+		ResourceType: resourceTypeReleaseBinding,
+		ResourceID:   componentName,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   comp.Spec.Owner.ProjectName,
+			Component: componentName,
+		},
+		Context: authz.Context{
+			Resource: authz.ResourceAttribute{
+				Environment: services.FormatDualScopedResourceName(namespaceName, environment, false)},
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.ControlRollout(ctx, namespaceName, componentName, environment, action)
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func newAuthzService(pdp authzcore.PDP) Service {
+	comp := testutil.NewComponent(testNamespace, testProjectName, testComponentName)
+	return &releaseServiceWithAuthz{
+		internal: newService(
+			comp,
+			testutil.NewComponentRelease(testNamespace, testProjectName, testComponentName, "r1"),
+			bindingWithHistory("dev", "r2", record("r2", 10), record("r1", 0)),
+			bindingWithHistory("prod", "r1", record("r1", 5)),
+		),
+		componentService: componentsvc.NewService(testutil.NewFakeClient(comp), testutil.TestLogger()),
+		authz:            testutil.NewTestAuthzChecker(pdp),
+	}
+}
+
+func TestListReleaseHistory_AuthzCheck(t *testing.T) {
+	hierarchy := authzcore.ResourceHierarchy{Namespace: testNamespace, Project: testProjectName, Component: testComponentName}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		entries, err := newAuthzService(pdp).ListReleaseHistory(testutil.AuthzContext(), testNamespace, testComponentName, "")
+		require.NoError(t, err)
+		assert.Len(t, entries, 3)
+		require.Len(t, pdp.Captured, 2)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "releasebinding:view", "releasebinding", testComponentName, hierarchy)
+		assert.Equal(t, services.FormatDualScopedResourceName(testNamespace, "dev", false), pdp.Captured[0].Context.Resource.Environment)
+		assert.Equal(t, services.FormatDualScopedResourceName(testNamespace, "prod", false), pdp.Captured[1].Context.Resource.Environment)
+	})
+
+	t.Run("denied filters all entries", func(t *testing.T) {
+		entries, err := newAuthzService(testutil.DenyPDP()).ListReleaseHistory(testutil.AuthzContext(), testNamespace, testComponentName, "")
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+}
+
+func TestRollbackRelease_AuthzCheck(t *testing.T) {
+	hierarchy := authzcore.ResourceHierarchy{Namespace: testNamespace, Project: testProjectName, Component: testComponentName}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		result, err := newAuthzService(pdp).RollbackRelease(testutil.AuthzContext(), testNamespace, testComponentName, "dev", "")
+		require.NoError(t, err)
+		assert.Equal(t, "r1", result.Release)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "releasebinding:update", "releasebinding", testComponentName, hierarchy)
+		assert.Equal(t, services.FormatDualScopedResourceName(testNamespace, "dev", false), pdp.Captured[0].Context.Resource.Environment)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		_, err := newAuthzService(testutil.DenyPDP()).RollbackRelease(testutil.AuthzContext(), testNamespace, testComponentName, "dev", "")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestControlRollout_AuthzCheck(t *testing.T) {
+	hierarchy := authzcore.ResourceHierarchy{Namespace: testNamespace, Project: testProjectName, Component: testComponentName}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		_, err := newAuthzService(pdp).ControlRollout(testutil.AuthzContext(), testNamespace, testComponentName, "dev", RolloutActionPromote)
+		require.ErrorIs(t, err, ErrNoRolloutInProgress)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "releasebinding:update", "releasebinding", testComponentName, hierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		_, err := newAuthzService(testutil.DenyPDP()).ControlRollout(testutil.AuthzContext(), testNamespace, testComponentName, "dev", RolloutActionPromote)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
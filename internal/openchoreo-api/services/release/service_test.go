@@ -0,0 +1,160 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	componentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/component"
+	releasebindingsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/releasebinding"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+const (
+	testNamespace     = "test-ns"
+	testProjectName   = "test-project"
+	testComponentName = "test-comp"
+)
+
+var baseTime = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func record(name string, minutes int) openchoreov1alpha1.ReleaseRecord {
+	return openchoreov1alpha1.ReleaseRecord{
+		ReleaseName:    name,
+		Image:          "app:" + name,
+		ConfigChecksum: "sha256:" + name,
+		DeployedAt:     metav1.NewTime(baseTime.Add(time.Duration(minutes) * time.Minute)),
+	}
+}
+
+func bindingWithHistory(env, releaseName string, history ...openchoreov1alpha1.ReleaseRecord) *openchoreov1alpha1.ReleaseBinding {
+	rb := testutil.NewReleaseBinding(testNamespace, testProjectName, testComponentName, env, testComponentName+"-"+env)
+	rb.Spec.ReleaseName = releaseName
+	rb.Status.History = history
+	return rb
+}
+
+func newService(objs ...client.Object) Service {
+	k8sClient := testutil.NewFakeClient(objs...)
+	return &releaseService{
+		k8sClient:             k8sClient,
+		componentService:      componentsvc.NewService(k8sClient, testutil.TestLogger()),
+		releaseBindingService: releasebindingsvc.NewService(k8sClient, testutil.TestLogger()),
+		logger:                testutil.TestLogger(),
+	}
+}
+
+func TestListReleaseHistory(t *testing.T) {
+	ctx := context.Background()
+	comp := testutil.NewComponent(testNamespace, testProjectName, testComponentName)
+	dev := bindingWithHistory("dev", "r2", record("r2", 10), record("r1", 0))
+	prod := bindingWithHistory("prod", "r1", record("r1", 5))
+	other := testutil.NewReleaseBinding(testNamespace, testProjectName, "other", "dev", "other-dev")
+	other.Status.History = []openchoreov1alpha1.ReleaseRecord{record("x", 20)}
+
+	t.Run("merges environments newest first", func(t *testing.T) {
+		entries, err := newService(comp, dev, prod, other).ListReleaseHistory(ctx, testNamespace, testComponentName, "")
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+		assert.Equal(t, "r2", entries[0].Record.ReleaseName)
+		assert.Equal(t, "dev", entries[0].Environment)
+		assert.True(t, entries[0].Current)
+		assert.Equal(t, "prod", entries[1].Environment)
+		assert.True(t, entries[1].Current)
+		assert.Equal(t, "dev", entries[2].Environment)
+		assert.False(t, entries[2].Current)
+	})
+
+	t.Run("filters by environment", func(t *testing.T) {
+		entries, err := newService(comp, dev, prod).ListReleaseHistory(ctx, testNamespace, testComponentName, "prod")
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, testComponentName+"-prod", entries[0].ReleaseBindingName)
+	})
+
+	t.Run("undeployed release is not current", func(t *testing.T) {
+		undeployed := bindingWithHistory("dev", "r1", record("r1", 0))
+		undeployed.Spec.State = openchoreov1alpha1.ReleaseStateUndeploy
+		entries, err := newService(comp, undeployed).ListReleaseHistory(ctx, testNamespace, testComponentName, "")
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.False(t, entries[0].Current)
+	})
+
+	t.Run("component not found", func(t *testing.T) {
+		_, err := newService().ListReleaseHistory(ctx, testNamespace, testComponentName, "")
+		require.ErrorIs(t, err, componentsvc.ErrComponentNotFound)
+	})
+}
+
+func TestRollbackRelease(t *testing.T) {
+	ctx := context.Background()
+	comp := testutil.NewComponent(testNamespace, testProjectName, testComponentName)
+	r1 := testutil.NewComponentRelease(testNamespace, testProjectName, testComponentName, "r1")
+	r2 := testutil.NewComponentRelease(testNamespace, testProjectName, testComponentName, "r2")
+
+	deployed := func() *openchoreov1alpha1.ReleaseBinding {
+		previous := record("r1", 0)
+		previous.Snapshot = &openchoreov1alpha1.ReleaseConfigSnapshot{
+			ComponentTypeEnvironmentConfigs: &runtime.RawExtension{Raw: []byte(`{"replicas":1}`)},
+		}
+		rb := bindingWithHistory("dev", "r2", record("r2", 10), previous)
+		rb.Spec.ComponentTypeEnvironmentConfigs = &runtime.RawExtension{Raw: []byte(`{"replicas":3}`)}
+		return rb
+	}
+
+	t.Run("rolls back to the previous release and restores its configuration", func(t *testing.T) {
+		svc := newService(comp, r1, r2, deployed())
+		result, err := svc.RollbackRelease(ctx, testNamespace, testComponentName, "dev", "")
+		require.NoError(t, err)
+		assert.Equal(t, "r1", result.Release)
+		assert.Equal(t, "r2", result.PreviousRelease)
+
+		rb := result.ReleaseBinding
+		assert.Equal(t, "r1", rb.Spec.ReleaseName)
+		assert.JSONEq(t, `{"replicas":1}`, string(rb.Spec.ComponentTypeEnvironmentConfigs.Raw))
+		source, _, ok := controller.GetReleaseTrigger(rb, "r1")
+		require.True(t, ok)
+		assert.Equal(t, controller.ReleaseTriggerRollback, source)
+	})
+
+	t.Run("rolls back to a named release", func(t *testing.T) {
+		result, err := newService(comp, r1, r2, deployed()).RollbackRelease(ctx, testNamespace, testComponentName, "dev", "r1")
+		require.NoError(t, err)
+		assert.Equal(t, "r1", result.ReleaseBinding.Spec.ReleaseName)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			objs    []client.Object
+			env     string
+			target  string
+			wantErr error
+		}{
+			{"component not found", nil, "dev", "", componentsvc.ErrComponentNotFound},
+			{"not deployed", []client.Object{comp}, "dev", "", ErrNotDeployed},
+			{"no previous release", []client.Object{comp, bindingWithHistory("dev", "r1", record("r1", 0))}, "dev", "", ErrNoPreviousRelease},
+			{"release not in history", []client.Object{comp, deployed()}, "dev", "r9", ErrReleaseNotInHistory},
+			{"release already active", []client.Object{comp, deployed()}, "dev", "r2", ErrReleaseAlreadyActive},
+			{"release deleted", []client.Object{comp, r2, deployed()}, "dev", "r1", ErrReleaseNotFound},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := newService(tt.objs...).RollbackRelease(ctx, testNamespace, testComponentName, tt.env, tt.target)
+				require.ErrorIs(t, err, tt.wantErr)
+			})
+		}
+	})
+}
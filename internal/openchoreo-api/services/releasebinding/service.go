@@ -13,6 +13,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
 	"github.com/openchoreo/openchoreo/internal/labels"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
@@ -64,6 +65,7 @@ func (s *releaseBindingService) CreateReleaseBinding(ctx context.Context, namesp
 	// Set defaults
 	rb.Namespace = namespaceName
 	rb.Status = openchoreov1alpha1.ReleaseBindingStatus{}
+	setAPIReleaseTrigger(ctx, rb)
 	if rb.Labels == nil {
 		rb.Labels = make(map[string]string)
 	}
@@ -111,6 +113,7 @@ func (s *releaseBindingService) UpdateReleaseBinding(ctx context.Context, namesp
 	existing.Spec = rb.Spec
 	existing.Labels = rb.Labels
 	existing.Annotations = rb.Annotations
+	setAPIReleaseTrigger(ctx, existing)
 
 	// Preserve special labels
 	if existing.Labels == nil {
@@ -251,3 +254,15 @@ func (s *releaseBindingService) validateComponentExists(ctx context.Context, nam
 	}
 	return nil
 }
+
+// setAPIReleaseTrigger attributes the bound release to the API caller unless another
+// path (deploy, promotion) already recorded a trigger for it.
+func setAPIReleaseTrigger(ctx context.Context, rb *openchoreov1alpha1.ReleaseBinding) {
+	if rb.Spec.ReleaseName == "" {
+		return
+	}
+	if _, _, ok := controller.GetReleaseTrigger(rb, rb.Spec.ReleaseName); ok {
+		return
+	}
+	controller.SetReleaseTrigger(rb, controller.ReleaseTriggerAPI, rb.Spec.ReleaseName, services.SubjectID(ctx))
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource
+
+import (
+	"context"
+	"errors"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// applyBundle applies each resource through svc, so that a service with
+// authorization checks authorizes every item on its own.
+func applyBundle(ctx context.Context, svc Service, resources []openchoreov1alpha1.Resource) []models.BatchItemResult {
+	results := make([]models.BatchItemResult, 0, len(resources))
+	for i := range resources {
+		resource := resources[i].DeepCopy()
+		result := models.BatchItemResult{Namespace: resource.Namespace, Name: resource.Name}
+		result.Operation, result.Err = applyOne(ctx, svc, resource)
+		results = append(results, result)
+	}
+	return results
+}
+
+func applyOne(ctx context.Context, svc Service, resource *openchoreov1alpha1.Resource) (string, error) {
+	if err := validateRef(resource.Namespace, resource.Name); err != nil {
+		return "", err
+	}
+	existing, err := svc.GetResource(ctx, resource.Namespace, resource.Name)
+	switch {
+	case err == nil:
+		if isUnchanged(existing, resource) {
+			return models.BatchOperationUnchanged, nil
+		}
+		if _, err := svc.UpdateResource(ctx, resource.Namespace, resource); err != nil {
+			return "", err
+		}
+		return models.BatchOperationUpdated, nil
+	case errors.Is(err, ErrResourceNotFound):
+		if _, err := svc.CreateResource(ctx, resource.Namespace, resource); err != nil {
+			return "", err
+		}
+		return models.BatchOperationCreated, nil
+	default:
+		return "", err
+	}
+}
+
+// isUnchanged reports whether applying desired would leave the stored resource
+// as it is: its spec, labels and annotations already match.
+func isUnchanged(existing, desired *openchoreov1alpha1.Resource) bool {
+	return equality.Semantic.DeepEqual(existing.Spec, desired.Spec) &&
+		sameStringMap(existing.Labels, desired.Labels) &&
+		sameStringMap(existing.Annotations, desired.Annotations)
+}
+
+func sameStringMap(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || w != v {
+			return false
+		}
+	}
+	return true
+}
+
+// deleteResources deletes each resource through svc, so that a service with
+// authorization checks authorizes every item on its own.
+func deleteResources(ctx context.Context, svc Service, refs []models.NamespacedName) []models.BatchItemResult {
+	results := make([]models.BatchItemResult, 0, len(refs))
+	for _, ref := range refs {
+		result := models.BatchItemResult{Namespace: ref.Namespace, Name: ref.Name}
+		result.Err = validateRef(ref.Namespace, ref.Name)
+		if result.Err == nil {
+			result.Err = svc.DeleteResource(ctx, ref.Namespace, ref.Name)
+		}
+		if result.Err == nil {
+			result.Operation = models.BatchOperationDeleted
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func validateRef(namespaceName, resourceName string) error {
+	if namespaceName == "" {
+		return &services.ValidationError{Msg: "namespace is required"}
+	}
+	if resourceName == "" {
+		return &services.ValidationError{Msg: "name is required"}
+	}
+	return nil
+}
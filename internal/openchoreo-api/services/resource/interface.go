@@ -7,6 +7,7 @@ import (
 	"context"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
@@ -20,4 +21,16 @@ type Service interface {
 	ListResources(ctx context.Context, namespaceName, projectName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.Resource], error)
 	GetResource(ctx context.Context, namespaceName, resourceName string) (*openchoreov1alpha1.Resource, error)
 	DeleteResource(ctx context.Context, namespaceName, resourceName string) error
+	// ListDeployedObjects returns the live objects that the releases of a
+	// resource applied to their data planes. A non-empty cluster restricts the
+	// result to the DataPlane or ClusterDataPlane with that name.
+	ListDeployedObjects(ctx context.Context, namespaceName, resourceName, cluster string) ([]models.DeployedObject, error)
+	// ApplyBundle creates or replaces each resource in the namespace of its
+	// metadata, in order. A failed item does not stop the others; the outcome
+	// of every item is returned in the order of the input.
+	ApplyBundle(ctx context.Context, resources []openchoreov1alpha1.Resource) []models.BatchItemResult
+	// DeleteResources deletes each named resource, in order. A failed item does
+	// not stop the others; the outcome of every item is returned in the order
+	// of the input.
+	DeleteResources(ctx context.Context, refs []models.NamespacedName) []models.BatchItemResult
 }
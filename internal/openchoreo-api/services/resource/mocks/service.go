@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	models "github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	mock "github.com/stretchr/testify/mock"
 
 	services "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
@@ -25,6 +26,55 @@ func (_m *MockService) EXPECT() *MockService_Expecter {
 	return &MockService_Expecter{mock: &_m.Mock}
 }
 
+// ApplyBundle provides a mock function with given fields: ctx, resources
+func (_m *MockService) ApplyBundle(ctx context.Context, resources []v1alpha1.Resource) []models.BatchItemResult {
+	ret := _m.Called(ctx, resources)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyBundle")
+	}
+
+	var r0 []models.BatchItemResult
+	if rf, ok := ret.Get(0).(func(context.Context, []v1alpha1.Resource) []models.BatchItemResult); ok {
+		r0 = rf(ctx, resources)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.BatchItemResult)
+		}
+	}
+
+	return r0
+}
+
+// MockService_ApplyBundle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyBundle'
+type MockService_ApplyBundle_Call struct {
+	*mock.Call
+}
+
+// ApplyBundle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resources []v1alpha1.Resource
+func (_e *MockService_Expecter) ApplyBundle(ctx interface{}, resources interface{}) *MockService_ApplyBundle_Call {
+	return &MockService_ApplyBundle_Call{Call: _e.mock.On("ApplyBundle", ctx, resources)}
+}
+
+func (_c *MockService_ApplyBundle_Call) Run(run func(ctx context.Context, resources []v1alpha1.Resource)) *MockService_ApplyBundle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]v1alpha1.Resource))
+	})
+	return _c
+}
+
+func (_c *MockService_ApplyBundle_Call) Return(_a0 []models.BatchItemResult) *MockService_ApplyBundle_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_ApplyBundle_Call) RunAndReturn(run func(context.Context, []v1alpha1.Resource) []models.BatchItemResult) *MockService_ApplyBundle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateResource provides a mock function with given fields: ctx, namespaceName, _a2
 func (_m *MockService) CreateResource(ctx context.Context, namespaceName string, _a2 *v1alpha1.Resource) (*v1alpha1.Resource, error) {
 	ret := _m.Called(ctx, namespaceName, _a2)
@@ -133,6 +183,55 @@ func (_c *MockService_DeleteResource_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// DeleteResources provides a mock function with given fields: ctx, refs
+func (_m *MockService) DeleteResources(ctx context.Context, refs []models.NamespacedName) []models.BatchItemResult {
+	ret := _m.Called(ctx, refs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteResources")
+	}
+
+	var r0 []models.BatchItemResult
+	if rf, ok := ret.Get(0).(func(context.Context, []models.NamespacedName) []models.BatchItemResult); ok {
+		r0 = rf(ctx, refs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.BatchItemResult)
+		}
+	}
+
+	return r0
+}
+
+// MockService_DeleteResources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteResources'
+type MockService_DeleteResources_Call struct {
+	*mock.Call
+}
+
+// DeleteResources is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refs []models.NamespacedName
+func (_e *MockService_Expecter) DeleteResources(ctx interface{}, refs interface{}) *MockService_DeleteResources_Call {
+	return &MockService_DeleteResources_Call{Call: _e.mock.On("DeleteResources", ctx, refs)}
+}
+
+func (_c *MockService_DeleteResources_Call) Run(run func(ctx context.Context, refs []models.NamespacedName)) *MockService_DeleteResources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]models.NamespacedName))
+	})
+	return _c
+}
+
+func (_c *MockService_DeleteResources_Call) Return(_a0 []models.BatchItemResult) *MockService_DeleteResources_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_DeleteResources_Call) RunAndReturn(run func(context.Context, []models.NamespacedName) []models.BatchItemResult) *MockService_DeleteResources_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetResource provides a mock function with given fields: ctx, namespaceName, resourceName
 func (_m *MockService) GetResource(ctx context.Context, namespaceName string, resourceName string) (*v1alpha1.Resource, error) {
 	ret := _m.Called(ctx, namespaceName, resourceName)
@@ -193,6 +292,67 @@ func (_c *MockService_GetResource_Call) RunAndReturn(run func(context.Context, s
 	return _c
 }
 
+// ListDeployedObjects provides a mock function with given fields: ctx, namespaceName, resourceName, cluster
+func (_m *MockService) ListDeployedObjects(ctx context.Context, namespaceName string, resourceName string, cluster string) ([]models.DeployedObject, error) {
+	ret := _m.Called(ctx, namespaceName, resourceName, cluster)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeployedObjects")
+	}
+
+	var r0 []models.DeployedObject
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) ([]models.DeployedObject, error)); ok {
+		return rf(ctx, namespaceName, resourceName, cluster)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) []models.DeployedObject); ok {
+		r0 = rf(ctx, namespaceName, resourceName, cluster)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeployedObject)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, resourceName, cluster)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListDeployedObjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeployedObjects'
+type MockService_ListDeployedObjects_Call struct {
+	*mock.Call
+}
+
+// ListDeployedObjects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - resourceName string
+//   - cluster string
+func (_e *MockService_Expecter) ListDeployedObjects(ctx interface{}, namespaceName interface{}, resourceName interface{}, cluster interface{}) *MockService_ListDeployedObjects_Call {
+	return &MockService_ListDeployedObjects_Call{Call: _e.mock.On("ListDeployedObjects", ctx, namespaceName, resourceName, cluster)}
+}
+
+func (_c *MockService_ListDeployedObjects_Call) Run(run func(ctx context.Context, namespaceName string, resourceName string, cluster string)) *MockService_ListDeployedObjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_ListDeployedObjects_Call) Return(_a0 []models.DeployedObject, _a1 error) *MockService_ListDeployedObjects_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListDeployedObjects_Call) RunAndReturn(run func(context.Context, string, string, string) ([]models.DeployedObject, error)) *MockService_ListDeployedObjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListResources provides a mock function with given fields: ctx, namespaceName, projectName, opts
 func (_m *MockService) ListResources(ctx context.Context, namespaceName string, projectName string, opts services.ListOptions) (*services.ListResult[v1alpha1.Resource], error) {
 	ret := _m.Called(ctx, namespaceName, projectName, opts)
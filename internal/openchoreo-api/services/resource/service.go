@@ -10,10 +10,15 @@ import (
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/controller"
 	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
 )
@@ -26,9 +31,10 @@ var resourceTypeMeta = metav1.TypeMeta{
 // resourceService handles resource-related business logic without authorization checks.
 // Other services within this layer should use this directly to avoid double authz.
 type resourceService struct {
-	k8sClient      client.Client
-	projectService projectsvc.Service
-	logger         *slog.Logger
+	k8sClient           client.Client
+	planeClientProvider kubernetesClient.DataPlaneClientProvider
+	projectService      projectsvc.Service
+	logger              *slog.Logger
 }
 
 var _ Service = (*resourceService)(nil)
@@ -36,11 +42,14 @@ var _ Service = (*resourceService)(nil)
 // NewService creates a new resource service without authorization.
 // It internally creates an unwrapped project service for project validation,
 // avoiding double authz when used within the authz-wrapped resource service.
-func NewService(k8sClient client.Client, logger *slog.Logger) Service {
+// The plane client provider supplies the cached clients that read deployed
+// objects from data planes.
+func NewService(k8sClient client.Client, planeClientProvider kubernetesClient.DataPlaneClientProvider, logger *slog.Logger) Service {
 	return &resourceService{
-		k8sClient:      k8sClient,
-		projectService: projectsvc.NewService(k8sClient, logger.With("component", "project-service-internal")),
-		logger:         logger,
+		k8sClient:           k8sClient,
+		planeClientProvider: planeClientProvider,
+		projectService:      projectsvc.NewService(k8sClient, logger.With("component", "project-service-internal")),
+		logger:              logger,
 	}
 }
 
@@ -239,6 +248,133 @@ func (s *resourceService) DeleteResource(ctx context.Context, namespaceName, res
 	return nil
 }
 
+func (s *resourceService) ApplyBundle(ctx context.Context, resources []openchoreov1alpha1.Resource) []models.BatchItemResult {
+	s.logger.Debug("Applying resource bundle", "count", len(resources))
+	return applyBundle(ctx, s, resources)
+}
+
+func (s *resourceService) DeleteResources(ctx context.Context, refs []models.NamespacedName) []models.BatchItemResult {
+	s.logger.Debug("Deleting resources", "count", len(refs))
+	return deleteResources(ctx, s, refs)
+}
+
+func (s *resourceService) ListDeployedObjects(ctx context.Context, namespaceName, resourceName, cluster string) ([]models.DeployedObject, error) {
+	s.logger.Debug("Listing deployed objects", "namespace", namespaceName, "resource", resourceName, "cluster", cluster)
+
+	if _, err := s.GetResource(ctx, namespaceName, resourceName); err != nil {
+		return nil, err
+	}
+
+	var releases openchoreov1alpha1.RenderedReleaseList
+	if err := s.k8sClient.List(ctx, &releases,
+		client.InNamespace(namespaceName),
+		client.MatchingLabels{labels.LabelKeyResourceName: resourceName},
+	); err != nil {
+		s.logger.Error("Failed to list rendered releases", "error", err)
+		return nil, fmt.Errorf("failed to list rendered releases: %w", err)
+	}
+
+	objects := []models.DeployedObject{}
+	for i := range releases.Items {
+		release := &releases.Items[i]
+		if release.Spec.TargetPlane != "" && release.Spec.TargetPlane != openchoreov1alpha1.TargetPlaneDataPlane {
+			continue
+		}
+
+		plane, err := s.resolveDataPlane(ctx, namespaceName, release.Spec.EnvironmentName)
+		if err != nil {
+			s.logger.Warn("Failed to resolve data plane of release, skipping",
+				"release", release.Name, "environment", release.Spec.EnvironmentName, "error", err)
+			continue
+		}
+		if cluster != "" && plane.name != cluster {
+			continue
+		}
+
+		for _, rs := range release.Status.Resources {
+			objects = append(objects, s.readDeployedObject(ctx, plane, release.Spec.EnvironmentName, rs))
+		}
+	}
+	return objects, nil
+}
+
+// dataPlane is a resolved data plane with a client for its cluster.
+type dataPlane struct {
+	kind   string
+	name   string
+	client client.Client
+	// err is set instead of client when the plane cannot be reached.
+	err error
+}
+
+// resolveDataPlane resolves the data plane of an environment. Plane clients
+// are cached by the provider, so repeated calls reuse their connections.
+func (s *resourceService) resolveDataPlane(ctx context.Context, namespaceName, environmentName string) (*dataPlane, error) {
+	env := &openchoreov1alpha1.Environment{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: environmentName}, env); err != nil {
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+
+	dpResult, err := controller.GetDataPlaneFromRef(ctx, s.k8sClient, env.Namespace, env.Spec.DataPlaneRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve data plane: %w", err)
+	}
+
+	switch {
+	case dpResult.DataPlane != nil:
+		c, err := s.planeClientProvider.DataPlaneClient(dpResult.DataPlane)
+		return &dataPlane{kind: "DataPlane", name: dpResult.DataPlane.Name, client: c, err: err}, nil
+	case dpResult.ClusterDataPlane != nil:
+		c, err := s.planeClientProvider.ClusterDataPlaneClient(dpResult.ClusterDataPlane)
+		return &dataPlane{kind: "ClusterDataPlane", name: dpResult.ClusterDataPlane.Name, client: c, err: err}, nil
+	}
+	return nil, fmt.Errorf("environment %q has no data plane", environmentName)
+}
+
+// readDeployedObject reads the live state of an applied object from its data plane.
+func (s *resourceService) readDeployedObject(
+	ctx context.Context,
+	plane *dataPlane,
+	environmentName string,
+	rs openchoreov1alpha1.RenderedManifestStatus,
+) models.DeployedObject {
+	obj := models.DeployedObject{
+		Environment:  environmentName,
+		ClusterKind:  plane.kind,
+		Cluster:      plane.name,
+		Group:        rs.Group,
+		Version:      rs.Version,
+		Kind:         rs.Kind,
+		Namespace:    rs.Namespace,
+		Name:         rs.Name,
+		HealthStatus: string(rs.HealthStatus),
+	}
+	if plane.err != nil {
+		obj.Error = "data plane is not reachable"
+		return obj
+	}
+
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(schema.GroupVersionKind{Group: rs.Group, Version: rs.Version, Kind: rs.Kind})
+	if err := plane.client.Get(ctx, client.ObjectKey{Namespace: rs.Namespace, Name: rs.Name}, live); err != nil {
+		if apierrors.IsNotFound(err) {
+			obj.Error = "object not found on the data plane"
+			return obj
+		}
+		s.logger.Warn("Failed to read deployed object",
+			"cluster", plane.name, "kind", rs.Kind, "namespace", rs.Namespace, "name", rs.Name, "error", err)
+		obj.Error = "failed to read object from the data plane"
+		return obj
+	}
+
+	if rs.Group == "" && rs.Kind == "Secret" {
+		unstructured.RemoveNestedField(live.Object, "data")
+		unstructured.RemoveNestedField(live.Object, "stringData")
+	}
+	obj.Object = live.Object
+	return obj
+}
+
 func (s *resourceService) resourceExists(ctx context.Context, namespaceName, resourceName string) (bool, error) {
 	resource := &openchoreov1alpha1.Resource{}
 	key := client.ObjectKey{
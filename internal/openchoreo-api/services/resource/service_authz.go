@@ -11,6 +11,8 @@ import (
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
@@ -28,9 +30,9 @@ type resourceServiceWithAuthz struct {
 var _ Service = (*resourceServiceWithAuthz)(nil)
 
 // NewServiceWithAuthz creates a resource service with authorization checks.
-func NewServiceWithAuthz(k8sClient client.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
+func NewServiceWithAuthz(k8sClient client.Client, planeClientProvider kubernetesClient.DataPlaneClientProvider, authzPDP authz.PDP, logger *slog.Logger) Service {
 	return &resourceServiceWithAuthz{
-		internal: NewService(k8sClient, logger),
+		internal: NewService(k8sClient, planeClientProvider, logger),
 		authz:    services.NewAuthzChecker(authzPDP, logger),
 	}
 }
@@ -151,3 +153,34 @@ func (s *resourceServiceWithAuthz) DeleteResource(ctx context.Context, namespace
 	}
 	return s.internal.DeleteResource(ctx, namespaceName, resourceName)
 }
+
+// ApplyBundle authorizes each item on its own, as the single-item operations do.
+func (s *resourceServiceWithAuthz) ApplyBundle(ctx context.Context, resources []openchoreov1alpha1.Resource) []models.BatchItemResult {
+	return applyBundle(ctx, s, resources)
+}
+
+// DeleteResources authorizes each item on its own, as DeleteResource does.
+func (s *resourceServiceWithAuthz) DeleteResources(ctx context.Context, refs []models.NamespacedName) []models.BatchItemResult {
+	return deleteResources(ctx, s, refs)
+}
+
+func (s *resourceServiceWithAuthz) ListDeployedObjects(ctx context.Context, namespaceName, resourceName, cluster string) ([]models.DeployedObject, error) {
+	// Fetch first to get the project for authz hierarchy
+	r, err := s.internal.GetResource(ctx, namespaceName, resourceName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewResource,
+		ResourceType: resourceTypeResource,
+		ResourceID:   resourceName,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   r.Spec.Owner.ProjectName,
+			Resource:  resourceName,
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.ListDeployedObjects(ctx, namespaceName, resourceName, cluster)
+}
@@ -12,6 +12,7 @@ import (
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/resource/mocks"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
@@ -171,6 +172,54 @@ func TestDeleteResource_AuthzCheck(t *testing.T) {
 	})
 }
 
+func TestApplyBundle_AuthzCheck(t *testing.T) {
+	first := newResourceFixture("r-1")
+	second := newResourceFixture("r-2")
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetResource", mock.Anything, authzNamespace, "r-1").Return(nil, ErrResourceNotFound)
+		mockSvc.On("CreateResource", mock.Anything, authzNamespace, first).Return(first, nil)
+		svc := newAuthzSvc(pdp, mockSvc)
+		results := svc.ApplyBundle(testutil.AuthzContext(), []openchoreov1alpha1.Resource{*first})
+		require.Len(t, results, 1)
+		require.NoError(t, results[0].Err)
+		require.Equal(t, models.BatchOperationCreated, results[0].Operation)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "resource:create", "resource", "r-1", projectHierarchy("r-1"))
+	})
+
+	t.Run("denied items are checked one by one", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetResource", mock.Anything, authzNamespace, "r-1").Return(nil, ErrResourceNotFound)
+		mockSvc.On("GetResource", mock.Anything, authzNamespace, "r-2").Return(nil, ErrResourceNotFound)
+		svc := newAuthzSvc(pdp, mockSvc)
+		results := svc.ApplyBundle(testutil.AuthzContext(), []openchoreov1alpha1.Resource{*first, *second})
+		require.Len(t, results, 2)
+		require.ErrorIs(t, results[0].Err, services.ErrForbidden)
+		require.ErrorIs(t, results[1].Err, services.ErrForbidden)
+		require.Len(t, pdp.Captured, 2)
+		testutil.RequireEvalRequest(t, pdp.Captured[1], "resource:create", "resource", "r-2", projectHierarchy("r-2"))
+	})
+}
+
+func TestDeleteResources_AuthzCheck(t *testing.T) {
+	resource := newResourceFixture("my-r")
+
+	pdp := testutil.DenyPDP()
+	mockSvc := mocks.NewMockService(t)
+	mockSvc.On("GetResource", mock.Anything, authzNamespace, "my-r").Return(resource, nil)
+	svc := newAuthzSvc(pdp, mockSvc)
+
+	results := svc.DeleteResources(testutil.AuthzContext(), []models.NamespacedName{{Namespace: authzNamespace, Name: "my-r"}})
+	require.Len(t, results, 1)
+	require.ErrorIs(t, results[0].Err, services.ErrForbidden)
+	require.Len(t, pdp.Captured, 1)
+	testutil.RequireEvalRequest(t, pdp.Captured[0], "resource:delete", "resource", "my-r", projectHierarchy("my-r"))
+}
+
 func TestListResources_AuthzCheck(t *testing.T) {
 	items := []openchoreov1alpha1.Resource{
 		*newResourceFixture("r-1"),
@@ -234,3 +283,28 @@ func TestFormatResourceTypeAttr(t *testing.T) {
 		})
 	}
 }
+
+func TestListDeployedObjects_AuthzCheck(t *testing.T) {
+	resource := newResourceFixture("my-r")
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetResource", mock.Anything, authzNamespace, "my-r").Return(resource, nil)
+		mockSvc.On("ListDeployedObjects", mock.Anything, authzNamespace, "my-r", "dp-1").Return([]models.DeployedObject{}, nil)
+		svc := newAuthzSvc(pdp, mockSvc)
+		_, err := svc.ListDeployedObjects(testutil.AuthzContext(), authzNamespace, "my-r", "dp-1")
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "resource:view", "resource", "my-r", projectHierarchy("my-r"))
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		pdp := testutil.DenyPDP()
+		mockSvc := mocks.NewMockService(t)
+		mockSvc.On("GetResource", mock.Anything, authzNamespace, "my-r").Return(resource, nil)
+		svc := newAuthzSvc(pdp, mockSvc)
+		_, err := svc.ListDeployedObjects(testutil.AuthzContext(), authzNamespace, "my-r", "")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
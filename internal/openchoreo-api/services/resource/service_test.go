@@ -8,10 +8,16 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	k8smocks "github.com/openchoreo/openchoreo/internal/clients/kubernetes/mocks"
 	"github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
@@ -29,7 +35,7 @@ func newService(t *testing.T, extra ...client.Object) Service {
 	objs := make([]client.Object, 0, 1+len(extra))
 	objs = append(objs, testutil.NewProject(testNamespace, testProject))
 	objs = append(objs, extra...)
-	return NewService(testutil.NewFakeClient(objs...), testutil.TestLogger())
+	return NewService(testutil.NewFakeClient(objs...), nil, testutil.TestLogger())
 }
 
 func TestCreateResource(t *testing.T) {
@@ -56,7 +62,7 @@ func TestCreateResource(t *testing.T) {
 	})
 
 	t.Run("project not found", func(t *testing.T) {
-		svc := NewService(testutil.NewFakeClient(), testutil.TestLogger())
+		svc := NewService(testutil.NewFakeClient(), nil, testutil.TestLogger())
 		r := testutil.NewResource(testNamespace, "missing-project", "test-r")
 
 		_, err := svc.CreateResource(ctx, testNamespace, r)
@@ -225,3 +231,122 @@ func TestDeleteResource(t *testing.T) {
 		require.ErrorIs(t, err, ErrResourceNotFound)
 	})
 }
+
+func TestApplyBundle(t *testing.T) {
+	ctx := context.Background()
+	existing := testutil.NewResource(testNamespace, testProject, "existing")
+	unchanged := testutil.NewResource(testNamespace, testProject, "unchanged")
+	svc := newService(t, existing, unchanged.DeepCopy())
+
+	update := testutil.NewResource(testNamespace, testProject, "existing")
+	update.Labels = map[string]string{"env": "prod"}
+	results := svc.ApplyBundle(ctx, []openchoreov1alpha1.Resource{
+		*testutil.NewResource(testNamespace, testProject, "new"),
+		*testutil.NewResource("", testProject, "no-namespace"),
+		*update,
+		*testutil.NewResource(testNamespace, "missing-project", "orphan"),
+		*unchanged,
+	})
+
+	require.Len(t, results, 5)
+	assert.Equal(t, models.BatchItemResult{Namespace: testNamespace, Name: "new", Operation: models.BatchOperationCreated}, results[0])
+	var validationErr *services.ValidationError
+	require.ErrorAs(t, results[1].Err, &validationErr)
+	assert.Equal(t, "namespace is required", validationErr.Msg)
+	assert.Empty(t, results[1].Operation)
+	assert.Equal(t, models.BatchItemResult{Namespace: testNamespace, Name: "existing", Operation: models.BatchOperationUpdated}, results[2])
+	require.ErrorIs(t, results[3].Err, projectsvc.ErrProjectNotFound)
+	assert.Equal(t, models.BatchItemResult{Namespace: testNamespace, Name: "unchanged", Operation: models.BatchOperationUnchanged}, results[4])
+
+	got, err := svc.GetResource(ctx, testNamespace, "existing")
+	require.NoError(t, err)
+	assert.Equal(t, "prod", got.Labels["env"])
+	_, err = svc.GetResource(ctx, testNamespace, "new")
+	require.NoError(t, err)
+}
+
+func TestDeleteResources(t *testing.T) {
+	ctx := context.Background()
+	svc := newService(t, testutil.NewResource(testNamespace, testProject, "test-r"))
+
+	results := svc.DeleteResources(ctx, []models.NamespacedName{
+		{Namespace: testNamespace, Name: "test-r"},
+		{Namespace: testNamespace, Name: "nonexistent"},
+		{Namespace: testNamespace},
+	})
+
+	require.Len(t, results, 3)
+	assert.Equal(t, models.BatchItemResult{Namespace: testNamespace, Name: "test-r", Operation: models.BatchOperationDeleted}, results[0])
+	require.ErrorIs(t, results[1].Err, ErrResourceNotFound)
+	var validationErr *services.ValidationError
+	require.ErrorAs(t, results[2].Err, &validationErr)
+
+	_, err := svc.GetResource(ctx, testNamespace, "test-r")
+	require.ErrorIs(t, err, ErrResourceNotFound)
+}
+
+func TestListDeployedObjects(t *testing.T) {
+	ctx := context.Background()
+
+	release := &openchoreov1alpha1.RenderedRelease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-r-dev",
+			Namespace: testNamespace,
+			Labels:    map[string]string{labels.LabelKeyResourceName: "test-r"},
+		},
+		Spec: openchoreov1alpha1.RenderedReleaseSpec{EnvironmentName: "dev"},
+		Status: openchoreov1alpha1.RenderedReleaseStatus{
+			Resources: []openchoreov1alpha1.RenderedManifestStatus{
+				{Version: "v1", Kind: "Secret", Namespace: "dp-ns", Name: "creds", HealthStatus: openchoreov1alpha1.HealthStatusHealthy},
+				{Version: "v1", Kind: "ConfigMap", Namespace: "dp-ns", Name: "missing"},
+			},
+		},
+	}
+	dataPlane := testutil.NewDataPlane(testNamespace, "default")
+	planeClient := testutil.NewFakeClient(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "dp-ns"},
+		Data:       map[string][]byte{"password": []byte("secret")},
+	})
+
+	newDeployedService := func(t *testing.T) Service {
+		provider := k8smocks.NewMockDataPlaneClientProvider(t)
+		provider.EXPECT().DataPlaneClient(mock.Anything).Return(planeClient, nil).Maybe()
+		k8sClient := testutil.NewFakeClient(
+			testutil.NewProject(testNamespace, testProject),
+			testutil.NewResource(testNamespace, testProject, "test-r"),
+			testutil.NewEnvironment(testNamespace, "dev"),
+			dataPlane,
+			release,
+		)
+		return NewService(k8sClient, provider, testutil.TestLogger())
+	}
+
+	t.Run("reads objects from the data plane", func(t *testing.T) {
+		objects, err := newDeployedService(t).ListDeployedObjects(ctx, testNamespace, "test-r", "")
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+
+		secret := objects[0]
+		assert.Equal(t, "dev", secret.Environment)
+		assert.Equal(t, "DataPlane", secret.ClusterKind)
+		assert.Equal(t, "default", secret.Cluster)
+		assert.Equal(t, "Healthy", secret.HealthStatus)
+		require.NotNil(t, secret.Object)
+		assert.NotContains(t, secret.Object, "data", "secret data must not leave the data plane")
+
+		missing := objects[1]
+		assert.Nil(t, missing.Object)
+		assert.Equal(t, "object not found on the data plane", missing.Error)
+	})
+
+	t.Run("filters by cluster", func(t *testing.T) {
+		objects, err := newDeployedService(t).ListDeployedObjects(ctx, testNamespace, "test-r", "other")
+		require.NoError(t, err)
+		assert.Empty(t, objects)
+	})
+
+	t.Run("resource not found", func(t *testing.T) {
+		_, err := newDeployedService(t).ListDeployedObjects(ctx, testNamespace, "nonexistent", "")
+		require.ErrorIs(t, err, ErrResourceNotFound)
+	})
+}
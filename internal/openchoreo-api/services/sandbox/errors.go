@@ -0,0 +1,13 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sandbox
+
+import "errors"
+
+var (
+	ErrSandboxNotFound      = errors.New("sandbox not found")
+	ErrSandboxAlreadyExists = errors.New("sandbox already exists")
+	ErrComponentNotDeployed = errors.New("component has no release deployed to the source environment")
+	ErrNoComponentsToClone  = errors.New("no components of the project are deployed to the source environment")
+)
@@ -0,0 +1,55 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sandbox
+
+import (
+	"context"
+	"time"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+const (
+	// DefaultTTL is the lifetime of a sandbox created without an explicit TTL.
+	DefaultTTL = 8 * time.Hour
+	// MaxTTL is the longest lifetime a sandbox can be created with.
+	MaxTTL = 7 * 24 * time.Hour
+)
+
+// CreateSandboxRequest contains the parameters for creating a sandbox.
+type CreateSandboxRequest struct {
+	// Name is the name of the sandbox environment.
+	Name string
+	// SourceEnvironment is the environment whose deployed releases are cloned into the sandbox.
+	SourceEnvironment string
+	// Components limits the sandbox to a subset of the project's components. When empty,
+	// every component deployed to the source environment is cloned.
+	Components []string
+	// TTL is how long the sandbox lives. Defaults to DefaultTTL.
+	TTL time.Duration
+	// Subdomain is the gateway host prefix the sandbox endpoints are exposed under.
+	// Defaults to the sandbox name.
+	Subdomain string
+	// PullRequest marks the sandbox as the preview environment of a pull request.
+	PullRequest *openchoreov1alpha1.SandboxPullRequest
+}
+
+// Sandbox is an ephemeral environment together with the release bindings deployed to it.
+type Sandbox struct {
+	Environment     *openchoreov1alpha1.Environment
+	ReleaseBindings []openchoreov1alpha1.ReleaseBinding
+}
+
+// Service defines the sandbox service interface.
+// Both the core service (no authz) and the authz-wrapped service implement this.
+type Service interface {
+	// CreateSandbox creates an ephemeral environment for a project and binds the releases deployed
+	// to the source environment to it. The sandbox is deleted once its TTL elapses.
+	CreateSandbox(ctx context.Context, namespaceName, projectName string, req *CreateSandboxRequest) (*Sandbox, error)
+	ListSandboxes(ctx context.Context, namespaceName, projectName string, opts services.ListOptions) (*services.ListResult[Sandbox], error)
+	GetSandbox(ctx context.Context, namespaceName, projectName, sandboxName string) (*Sandbox, error)
+	// DeleteSandbox deletes a sandbox ahead of its expiry.
+	DeleteSandbox(ctx context.Context, namespaceName, projectName, sandboxName string) error
+}
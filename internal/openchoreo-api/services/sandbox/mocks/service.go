@@ -0,0 +1,272 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	sandbox "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/sandbox"
+
+	services "github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// CreateSandbox provides a mock function with given fields: ctx, namespaceName, projectName, req
+func (_m *MockService) CreateSandbox(ctx context.Context, namespaceName string, projectName string, req *sandbox.CreateSandboxRequest) (*sandbox.Sandbox, error) {
+	ret := _m.Called(ctx, namespaceName, projectName, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSandbox")
+	}
+
+	var r0 *sandbox.Sandbox
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *sandbox.CreateSandboxRequest) (*sandbox.Sandbox, error)); ok {
+		return rf(ctx, namespaceName, projectName, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *sandbox.CreateSandboxRequest) *sandbox.Sandbox); ok {
+		r0 = rf(ctx, namespaceName, projectName, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sandbox.Sandbox)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *sandbox.CreateSandboxRequest) error); ok {
+		r1 = rf(ctx, namespaceName, projectName, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_CreateSandbox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSandbox'
+type MockService_CreateSandbox_Call struct {
+	*mock.Call
+}
+
+// CreateSandbox is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - projectName string
+//   - req *sandbox.CreateSandboxRequest
+func (_e *MockService_Expecter) CreateSandbox(ctx interface{}, namespaceName interface{}, projectName interface{}, req interface{}) *MockService_CreateSandbox_Call {
+	return &MockService_CreateSandbox_Call{Call: _e.mock.On("CreateSandbox", ctx, namespaceName, projectName, req)}
+}
+
+func (_c *MockService_CreateSandbox_Call) Run(run func(ctx context.Context, namespaceName string, projectName string, req *sandbox.CreateSandboxRequest)) *MockService_CreateSandbox_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*sandbox.CreateSandboxRequest))
+	})
+	return _c
+}
+
+func (_c *MockService_CreateSandbox_Call) Return(_a0 *sandbox.Sandbox, _a1 error) *MockService_CreateSandbox_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_CreateSandbox_Call) RunAndReturn(run func(context.Context, string, string, *sandbox.CreateSandboxRequest) (*sandbox.Sandbox, error)) *MockService_CreateSandbox_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSandbox provides a mock function with given fields: ctx, namespaceName, projectName, sandboxName
+func (_m *MockService) DeleteSandbox(ctx context.Context, namespaceName string, projectName string, sandboxName string) error {
+	ret := _m.Called(ctx, namespaceName, projectName, sandboxName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSandbox")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, namespaceName, projectName, sandboxName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_DeleteSandbox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSandbox'
+type MockService_DeleteSandbox_Call struct {
+	*mock.Call
+}
+
+// DeleteSandbox is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - projectName string
+//   - sandboxName string
+func (_e *MockService_Expecter) DeleteSandbox(ctx interface{}, namespaceName interface{}, projectName interface{}, sandboxName interface{}) *MockService_DeleteSandbox_Call {
+	return &MockService_DeleteSandbox_Call{Call: _e.mock.On("DeleteSandbox", ctx, namespaceName, projectName, sandboxName)}
+}
+
+func (_c *MockService_DeleteSandbox_Call) Run(run func(ctx context.Context, namespaceName string, projectName string, sandboxName string)) *MockService_DeleteSandbox_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_DeleteSandbox_Call) Return(_a0 error) *MockService_DeleteSandbox_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_DeleteSandbox_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockService_DeleteSandbox_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSandbox provides a mock function with given fields: ctx, namespaceName, projectName, sandboxName
+func (_m *MockService) GetSandbox(ctx context.Context, namespaceName string, projectName string, sandboxName string) (*sandbox.Sandbox, error) {
+	ret := _m.Called(ctx, namespaceName, projectName, sandboxName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSandbox")
+	}
+
+	var r0 *sandbox.Sandbox
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*sandbox.Sandbox, error)); ok {
+		return rf(ctx, namespaceName, projectName, sandboxName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *sandbox.Sandbox); ok {
+		r0 = rf(ctx, namespaceName, projectName, sandboxName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sandbox.Sandbox)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, projectName, sandboxName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetSandbox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSandbox'
+type MockService_GetSandbox_Call struct {
+	*mock.Call
+}
+
+// GetSandbox is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - projectName string
+//   - sandboxName string
+func (_e *MockService_Expecter) GetSandbox(ctx interface{}, namespaceName interface{}, projectName interface{}, sandboxName interface{}) *MockService_GetSandbox_Call {
+	return &MockService_GetSandbox_Call{Call: _e.mock.On("GetSandbox", ctx, namespaceName, projectName, sandboxName)}
+}
+
+func (_c *MockService_GetSandbox_Call) Run(run func(ctx context.Context, namespaceName string, projectName string, sandboxName string)) *MockService_GetSandbox_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetSandbox_Call) Return(_a0 *sandbox.Sandbox, _a1 error) *MockService_GetSandbox_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetSandbox_Call) RunAndReturn(run func(context.Context, string, string, string) (*sandbox.Sandbox, error)) *MockService_GetSandbox_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSandboxes provides a mock function with given fields: ctx, namespaceName, projectName, opts
+func (_m *MockService) ListSandboxes(ctx context.Context, namespaceName string, projectName string, opts services.ListOptions) (*services.ListResult[sandbox.Sandbox], error) {
+	ret := _m.Called(ctx, namespaceName, projectName, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSandboxes")
+	}
+
+	var r0 *services.ListResult[sandbox.Sandbox]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, services.ListOptions) (*services.ListResult[sandbox.Sandbox], error)); ok {
+		return rf(ctx, namespaceName, projectName, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, services.ListOptions) *services.ListResult[sandbox.Sandbox]); ok {
+		r0 = rf(ctx, namespaceName, projectName, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.ListResult[sandbox.Sandbox])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, services.ListOptions) error); ok {
+		r1 = rf(ctx, namespaceName, projectName, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListSandboxes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSandboxes'
+type MockService_ListSandboxes_Call struct {
+	*mock.Call
+}
+
+// ListSandboxes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - projectName string
+//   - opts services.ListOptions
+func (_e *MockService_Expecter) ListSandboxes(ctx interface{}, namespaceName interface{}, projectName interface{}, opts interface{}) *MockService_ListSandboxes_Call {
+	return &MockService_ListSandboxes_Call{Call: _e.mock.On("ListSandboxes", ctx, namespaceName, projectName, opts)}
+}
+
+func (_c *MockService_ListSandboxes_Call) Run(run func(ctx context.Context, namespaceName string, projectName string, opts services.ListOptions)) *MockService_ListSandboxes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(services.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockService_ListSandboxes_Call) Return(_a0 *services.ListResult[sandbox.Sandbox], _a1 error) *MockService_ListSandboxes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListSandboxes_Call) RunAndReturn(run func(context.Context, string, string, services.ListOptions) (*services.ListResult[sandbox.Sandbox], error)) *MockService_ListSandboxes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,352 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sandbox
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller"
+	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	environmentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+)
+
+var (
+	environmentTypeMeta = metav1.TypeMeta{
+		APIVersion: openchoreov1alpha1.GroupVersion.String(),
+		Kind:       "Environment",
+	}
+	releaseBindingTypeMeta = metav1.TypeMeta{
+		APIVersion: openchoreov1alpha1.GroupVersion.String(),
+		Kind:       "ReleaseBinding",
+	}
+)
+
+// sandboxService handles sandbox business logic without authorization checks.
+type sandboxService struct {
+	k8sClient client.Client
+	logger    *slog.Logger
+}
+
+var _ Service = (*sandboxService)(nil)
+
+// NewService creates a new sandbox service without authorization.
+func NewService(k8sClient client.Client, logger *slog.Logger) Service {
+	return &sandboxService{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+func (s *sandboxService) CreateSandbox(ctx context.Context, namespaceName, projectName string, req *CreateSandboxRequest) (*Sandbox, error) {
+	if req == nil {
+		return nil, fmt.Errorf("sandbox request cannot be nil")
+	}
+	if req.Name == "" || req.SourceEnvironment == "" {
+		return nil, &services.ValidationError{Msg: "sandbox name and source environment are required"}
+	}
+	ttl := req.TTL
+	if ttl == 0 {
+		ttl = DefaultTTL
+	}
+	if ttl < 0 || ttl > MaxTTL {
+		return nil, &services.ValidationError{Msg: fmt.Sprintf("sandbox ttl must be positive and at most %s", MaxTTL)}
+	}
+	subdomain := req.Subdomain
+	if subdomain == "" {
+		subdomain = req.Name
+	}
+
+	s.logger.Debug("Creating sandbox", "namespace", namespaceName, "project", projectName,
+		"sandbox", req.Name, "source", req.SourceEnvironment, "ttl", ttl)
+
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: projectName}, &openchoreov1alpha1.Project{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, projectsvc.ErrProjectNotFound
+		}
+		s.logger.Error("Failed to get project", "error", err)
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	source := &openchoreov1alpha1.Environment{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: req.SourceEnvironment}, source); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, environmentsvc.ErrEnvironmentNotFound
+		}
+		s.logger.Error("Failed to get environment", "error", err)
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+
+	sourceBindings, err := s.sourceBindings(ctx, namespaceName, projectName, req.SourceEnvironment, req.Components)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: req.Name}, &openchoreov1alpha1.Environment{}); err == nil {
+		return nil, ErrSandboxAlreadyExists
+	} else if !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to check environment existence: %w", err)
+	}
+
+	env := &openchoreov1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      req.Name,
+			Namespace: namespaceName,
+			Labels: map[string]string{
+				ocLabels.LabelKeyProjectName: projectName,
+				ocLabels.LabelKeySandbox:     ocLabels.LabelValueTrue,
+			},
+		},
+		Spec: openchoreov1alpha1.EnvironmentSpec{
+			DataPlaneRef: source.Spec.DataPlaneRef.DeepCopy(),
+			Gateway:      *source.Spec.Gateway.DeepCopy(),
+			TLS:          source.Spec.TLS.DeepCopy(),
+			Sandbox: &openchoreov1alpha1.EnvironmentSandboxSpec{
+				TTL:               metav1.Duration{Duration: ttl},
+				Project:           projectName,
+				SourceEnvironment: req.SourceEnvironment,
+				Subdomain:         subdomain,
+				PullRequest:       req.PullRequest.DeepCopy(),
+			},
+		},
+	}
+	if req.PullRequest != nil {
+		env.Labels[ocLabels.LabelKeyPullRequest] = strconv.FormatInt(req.PullRequest.Number, 10)
+	}
+	if err := s.k8sClient.Create(ctx, env); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return nil, ErrSandboxAlreadyExists
+		}
+		if vErr := services.ExtractValidationError(err); vErr != nil {
+			return nil, vErr
+		}
+		s.logger.Error("Failed to create sandbox environment", "error", err)
+		return nil, fmt.Errorf("failed to create sandbox environment: %w", err)
+	}
+	env.TypeMeta = environmentTypeMeta
+
+	bindings := make([]openchoreov1alpha1.ReleaseBinding, 0, len(sourceBindings))
+	for i := range sourceBindings {
+		binding := cloneBinding(&sourceBindings[i], env.Name)
+		controller.SetReleaseTrigger(binding, controller.ReleaseTriggerDeploy, binding.Spec.ReleaseName, services.SubjectID(ctx))
+		if err := s.k8sClient.Create(ctx, binding); err != nil {
+			s.logger.Error("Failed to create sandbox release binding", "error", err, "releaseBinding", binding.Name)
+			// Deleting the environment also removes the bindings created so far.
+			if delErr := s.k8sClient.Delete(ctx, env); delErr != nil && !apierrors.IsNotFound(delErr) {
+				s.logger.Error("Failed to roll back sandbox environment", "error", delErr)
+			}
+			return nil, fmt.Errorf("failed to create sandbox release binding: %w", err)
+		}
+		binding.TypeMeta = releaseBindingTypeMeta
+		bindings = append(bindings, *binding)
+	}
+
+	s.logger.Debug("Sandbox created", "namespace", namespaceName, "sandbox", env.Name, "components", len(bindings))
+	return &Sandbox{Environment: env, ReleaseBindings: bindings}, nil
+}
+
+func (s *sandboxService) ListSandboxes(ctx context.Context, namespaceName, projectName string, opts services.ListOptions) (*services.ListResult[Sandbox], error) {
+	s.logger.Debug("Listing sandboxes", "namespace", namespaceName, "project", projectName, "limit", opts.Limit, "cursor", opts.Cursor)
+
+	commonOpts, err := services.BuildListOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	listOpts := append([]client.ListOption{
+		client.InNamespace(namespaceName),
+		client.MatchingLabels{
+			ocLabels.LabelKeyProjectName: projectName,
+			ocLabels.LabelKeySandbox:     ocLabels.LabelValueTrue,
+		},
+	}, commonOpts...)
+
+	var envList openchoreov1alpha1.EnvironmentList
+	if err := s.k8sClient.List(ctx, &envList, listOpts...); err != nil {
+		s.logger.Error("Failed to list sandbox environments", "error", err)
+		return nil, fmt.Errorf("failed to list sandbox environments: %w", err)
+	}
+
+	bindingsByEnv, err := s.bindingsByEnvironment(ctx, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]Sandbox, 0, len(envList.Items))
+	for i := range envList.Items {
+		env := &envList.Items[i]
+		if env.Spec.Sandbox == nil {
+			continue
+		}
+		env.TypeMeta = environmentTypeMeta
+		items = append(items, Sandbox{Environment: env, ReleaseBindings: bindingsByEnv[env.Name]})
+	}
+
+	result := &services.ListResult[Sandbox]{
+		Items:      items,
+		NextCursor: envList.Continue,
+	}
+	if envList.RemainingItemCount != nil {
+		remaining := *envList.RemainingItemCount
+		result.RemainingCount = &remaining
+	}
+
+	s.logger.Debug("Listed sandboxes", "namespace", namespaceName, "project", projectName, "count", len(items))
+	return result, nil
+}
+
+func (s *sandboxService) GetSandbox(ctx context.Context, namespaceName, projectName, sandboxName string) (*Sandbox, error) {
+	s.logger.Debug("Getting sandbox", "namespace", namespaceName, "project", projectName, "sandbox", sandboxName)
+
+	env, err := s.getSandboxEnvironment(ctx, namespaceName, projectName, sandboxName)
+	if err != nil {
+		return nil, err
+	}
+	bindingsByEnv, err := s.bindingsByEnvironment(ctx, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+	return &Sandbox{Environment: env, ReleaseBindings: bindingsByEnv[env.Name]}, nil
+}
+
+func (s *sandboxService) DeleteSandbox(ctx context.Context, namespaceName, projectName, sandboxName string) error {
+	s.logger.Debug("Deleting sandbox", "namespace", namespaceName, "project", projectName, "sandbox", sandboxName)
+
+	env, err := s.getSandboxEnvironment(ctx, namespaceName, projectName, sandboxName)
+	if err != nil {
+		return err
+	}
+	// The environment finalizer removes the sandbox release bindings and data plane namespaces.
+	if err := s.k8sClient.Delete(ctx, env); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ErrSandboxNotFound
+		}
+		s.logger.Error("Failed to delete sandbox environment", "error", err)
+		return fmt.Errorf("failed to delete sandbox environment: %w", err)
+	}
+
+	s.logger.Debug("Sandbox deleted", "namespace", namespaceName, "sandbox", sandboxName)
+	return nil
+}
+
+// getSandboxEnvironment returns the environment of a sandbox of the project. Regular environments
+// and the sandboxes of other projects are reported as not found.
+func (s *sandboxService) getSandboxEnvironment(ctx context.Context, namespaceName, projectName, sandboxName string) (*openchoreov1alpha1.Environment, error) {
+	env := &openchoreov1alpha1.Environment{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: sandboxName}, env); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, ErrSandboxNotFound
+		}
+		s.logger.Error("Failed to get sandbox environment", "error", err)
+		return nil, fmt.Errorf("failed to get sandbox environment: %w", err)
+	}
+	if env.Spec.Sandbox == nil || env.Spec.Sandbox.Project != projectName {
+		return nil, ErrSandboxNotFound
+	}
+	env.TypeMeta = environmentTypeMeta
+	return env, nil
+}
+
+// sourceBindings returns the release bindings of the project that deploy a release to the source
+// environment, limited to the requested components when any are given.
+func (s *sandboxService) sourceBindings(ctx context.Context, namespaceName, projectName, environment string, components []string) ([]openchoreov1alpha1.ReleaseBinding, error) {
+	bindingList := &openchoreov1alpha1.ReleaseBindingList{}
+	if err := s.k8sClient.List(ctx, bindingList, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list release bindings", "error", err)
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+
+	deployed := make(map[string]openchoreov1alpha1.ReleaseBinding)
+	for _, rb := range bindingList.Items {
+		if rb.Spec.Owner.ProjectName == projectName && rb.Spec.Environment == environment &&
+			rb.Spec.ReleaseName != "" && rb.Spec.State != openchoreov1alpha1.ReleaseStateUndeploy {
+			deployed[rb.Spec.Owner.ComponentName] = rb
+		}
+	}
+
+	var bindings []openchoreov1alpha1.ReleaseBinding
+	if len(components) == 0 {
+		for _, rb := range deployed {
+			bindings = append(bindings, rb)
+		}
+		if len(bindings) == 0 {
+			return nil, ErrNoComponentsToClone
+		}
+	} else {
+		seen := make(map[string]bool, len(components))
+		for _, name := range components {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			rb, ok := deployed[name]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", ErrComponentNotDeployed, name)
+			}
+			bindings = append(bindings, rb)
+		}
+	}
+	sort.Slice(bindings, func(i, j int) bool {
+		return bindings[i].Spec.Owner.ComponentName < bindings[j].Spec.Owner.ComponentName
+	})
+	return bindings, nil
+}
+
+// bindingsByEnvironment returns the release bindings of the namespace grouped by environment and
+// sorted by component.
+func (s *sandboxService) bindingsByEnvironment(ctx context.Context, namespaceName string) (map[string][]openchoreov1alpha1.ReleaseBinding, error) {
+	bindingList := &openchoreov1alpha1.ReleaseBindingList{}
+	if err := s.k8sClient.List(ctx, bindingList, client.InNamespace(namespaceName)); err != nil {
+		s.logger.Error("Failed to list release bindings", "error", err)
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+
+	byEnv := make(map[string][]openchoreov1alpha1.ReleaseBinding)
+	for _, rb := range bindingList.Items {
+		rb.TypeMeta = releaseBindingTypeMeta
+		byEnv[rb.Spec.Environment] = append(byEnv[rb.Spec.Environment], rb)
+	}
+	for _, bindings := range byEnv {
+		sort.Slice(bindings, func(i, j int) bool {
+			return bindings[i].Spec.Owner.ComponentName < bindings[j].Spec.Owner.ComponentName
+		})
+	}
+	return byEnv, nil
+}
+
+// cloneBinding copies the release and environment overrides of a source binding into a binding
+// for the sandbox. The rollout strategy is not cloned; sandboxes always roll out directly.
+func cloneBinding(source *openchoreov1alpha1.ReleaseBinding, sandboxName string) *openchoreov1alpha1.ReleaseBinding {
+	componentName := source.Spec.Owner.ComponentName
+	binding := &openchoreov1alpha1.ReleaseBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", componentName, sandboxName),
+			Namespace: source.Namespace,
+			Labels: map[string]string{
+				ocLabels.LabelKeyProjectName:     source.Spec.Owner.ProjectName,
+				ocLabels.LabelKeyComponentName:   componentName,
+				ocLabels.LabelKeyEnvironmentName: sandboxName,
+			},
+		},
+		Spec: openchoreov1alpha1.ReleaseBindingSpec{
+			Owner:       source.Spec.Owner,
+			Environment: sandboxName,
+			ReleaseName: source.Spec.ReleaseName,
+		},
+	}
+	spec := source.Spec.DeepCopy()
+	binding.Spec.ComponentTypeEnvironmentConfigs = spec.ComponentTypeEnvironmentConfigs
+	binding.Spec.TraitEnvironmentConfigs = spec.TraitEnvironmentConfigs
+	binding.Spec.WorkloadOverrides = spec.WorkloadOverrides
+	return binding
+}
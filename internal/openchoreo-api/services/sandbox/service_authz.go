@@ -0,0 +1,78 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sandbox
+
+import (
+	"context"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+const resourceTypeSandbox = "sandbox"
+
+// sandboxServiceWithAuthz wraps a Service and adds authorization checks.
+// Handlers should use this. Other services should use the unwrapped Service directly.
+type sandboxServiceWithAuthz struct {
+	internal Service
+	authz    *services.AuthzChecker
+}
+
+var _ Service = (*sandboxServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates a sandbox service with authorization checks.
+func NewServiceWithAuthz(k8sClient client.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &sandboxServiceWithAuthz{
+		internal: NewService(k8sClient, logger),
+		authz:    services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+func (s *sandboxServiceWithAuthz) CreateSandbox(ctx context.Context, namespaceName, projectName string, req *CreateSandboxRequest) (*Sandbox, error) {
+	sandboxName := ""
+	if req != nil {
+		sandboxName = req.Name
+	}
+	if err := s.authz.Check(ctx, checkRequest(authz.ActionCreateSandbox, namespaceName, projectName, sandboxName)); err != nil {
+		return nil, err
+	}
+	return s.internal.CreateSandbox(ctx, namespaceName, projectName, req)
+}
+
+func (s *sandboxServiceWithAuthz) ListSandboxes(ctx context.Context, namespaceName, projectName string, opts services.ListOptions) (*services.ListResult[Sandbox], error) {
+	return services.FilteredList(ctx, opts, s.authz,
+		func(ctx context.Context, pageOpts services.ListOptions) (*services.ListResult[Sandbox], error) {
+			return s.internal.ListSandboxes(ctx, namespaceName, projectName, pageOpts)
+		},
+		func(sb Sandbox) services.CheckRequest {
+			return checkRequest(authz.ActionViewSandbox, namespaceName, projectName, sb.Environment.Name)
+		},
+	)
+}
+
+func (s *sandboxServiceWithAuthz) GetSandbox(ctx context.Context, namespaceName, projectName, sandboxName string) (*Sandbox, error) {
+	if err := s.authz.Check(ctx, checkRequest(authz.ActionViewSandbox, namespaceName, projectName, sandboxName)); err != nil {
+		return nil, err
+	}
+	return s.internal.GetSandbox(ctx, namespaceName, projectName, sandboxName)
+}
+
+func (s *sandboxServiceWithAuthz) DeleteSandbox(ctx context.Context, namespaceName, projectName, sandboxName string) error {
+	if err := s.authz.Check(ctx, checkRequest(authz.ActionDeleteSandbox, namespaceName, projectName, sandboxName)); err != nil {
+		return err
+	}
+	return s.internal.DeleteSandbox(ctx, namespaceName, projectName, sandboxName)
+}
+
+func checkRequest(action, namespaceName, projectName, sandboxName string) services.CheckRequest {
+	return services.CheckRequest{
+		Action:       action,
+		ResourceType: resourceTypeSandbox,
+		ResourceID:   sandboxName,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName, Project: projectName},
+	}
+}
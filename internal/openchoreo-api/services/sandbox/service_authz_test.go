@@ -0,0 +1,143 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sandbox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+// mockService is a local testify mock for the Service interface.
+// It exists here instead of importing sandbox/mocks to avoid a cyclic import
+type mockService struct {
+	mock.Mock
+}
+
+func newMockService(t *testing.T) *mockService {
+	m := &mockService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *mockService) CreateSandbox(ctx context.Context, namespaceName, projectName string, req *CreateSandboxRequest) (*Sandbox, error) {
+	args := m.Called(ctx, namespaceName, projectName, req)
+	res, _ := args.Get(0).(*Sandbox)
+	return res, args.Error(1)
+}
+
+func (m *mockService) ListSandboxes(ctx context.Context, namespaceName, projectName string, opts services.ListOptions) (*services.ListResult[Sandbox], error) {
+	args := m.Called(ctx, namespaceName, projectName, opts)
+	res, _ := args.Get(0).(*services.ListResult[Sandbox])
+	return res, args.Error(1)
+}
+
+func (m *mockService) GetSandbox(ctx context.Context, namespaceName, projectName, sandboxName string) (*Sandbox, error) {
+	args := m.Called(ctx, namespaceName, projectName, sandboxName)
+	res, _ := args.Get(0).(*Sandbox)
+	return res, args.Error(1)
+}
+
+func (m *mockService) DeleteSandbox(ctx context.Context, namespaceName, projectName, sandboxName string) error {
+	return m.Called(ctx, namespaceName, projectName, sandboxName).Error(0)
+}
+
+func newAuthzService(mockSvc *mockService, pdp *testutil.CapturingPDP) *sandboxServiceWithAuthz {
+	return &sandboxServiceWithAuthz{
+		internal: mockSvc,
+		authz:    testutil.NewTestAuthzChecker(pdp),
+	}
+}
+
+var testHierarchy = authzcore.ResourceHierarchy{Namespace: "ns-1", Project: "proj-1"}
+
+func testSandbox(name string) Sandbox {
+	return Sandbox{Environment: &openchoreov1alpha1.Environment{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns-1"}}}
+}
+
+func TestSandboxAuthz_CreateSandbox(t *testing.T) {
+	req := &CreateSandboxRequest{Name: "sbx-1", SourceEnvironment: "dev"}
+
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		sb := testSandbox("sbx-1")
+		mockSvc.On("CreateSandbox", mock.Anything, "ns-1", "proj-1", req).Return(&sb, nil)
+		result, err := newAuthzService(mockSvc, pdp).CreateSandbox(testutil.AuthzContext(), "ns-1", "proj-1", req)
+		require.NoError(t, err)
+		require.Equal(t, &sb, result)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "sandbox:create", "sandbox", "sbx-1", testHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		_, err := newAuthzService(newMockService(t), testutil.DenyPDP()).CreateSandbox(testutil.AuthzContext(), "ns-1", "proj-1", req)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestSandboxAuthz_GetSandbox(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		sb := testSandbox("sbx-1")
+		mockSvc.On("GetSandbox", mock.Anything, "ns-1", "proj-1", "sbx-1").Return(&sb, nil)
+		_, err := newAuthzService(mockSvc, pdp).GetSandbox(testutil.AuthzContext(), "ns-1", "proj-1", "sbx-1")
+		require.NoError(t, err)
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "sandbox:view", "sandbox", "sbx-1", testHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		_, err := newAuthzService(newMockService(t), testutil.DenyPDP()).GetSandbox(testutil.AuthzContext(), "ns-1", "proj-1", "sbx-1")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestSandboxAuthz_DeleteSandbox(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		mockSvc := newMockService(t)
+		mockSvc.On("DeleteSandbox", mock.Anything, "ns-1", "proj-1", "sbx-1").Return(nil)
+		require.NoError(t, newAuthzService(mockSvc, pdp).DeleteSandbox(testutil.AuthzContext(), "ns-1", "proj-1", "sbx-1"))
+		require.Len(t, pdp.Captured, 1)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "sandbox:delete", "sandbox", "sbx-1", testHierarchy)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		err := newAuthzService(newMockService(t), testutil.DenyPDP()).DeleteSandbox(testutil.AuthzContext(), "ns-1", "proj-1", "sbx-1")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+}
+
+func TestSandboxAuthz_ListSandboxes(t *testing.T) {
+	items := []Sandbox{testSandbox("sbx-1"), testSandbox("sbx-2")}
+
+	t.Run("denied filters all items", func(t *testing.T) {
+		mockSvc := newMockService(t)
+		mockSvc.On("ListSandboxes", mock.Anything, "ns-1", "proj-1", mock.Anything).
+			Return(&services.ListResult[Sandbox]{Items: items}, nil)
+		result, err := newAuthzService(mockSvc, testutil.DenyPDP()).ListSandboxes(testutil.AuthzContext(), "ns-1", "proj-1", services.ListOptions{Limit: 10})
+		require.NoError(t, err)
+		require.Empty(t, result.Items)
+	})
+
+	t.Run("allowed returns all items", func(t *testing.T) {
+		mockSvc := newMockService(t)
+		mockSvc.On("ListSandboxes", mock.Anything, "ns-1", "proj-1", mock.Anything).
+			Return(&services.ListResult[Sandbox]{Items: items}, nil)
+		result, err := newAuthzService(mockSvc, testutil.AllowPDP()).ListSandboxes(testutil.AuthzContext(), "ns-1", "proj-1", services.ListOptions{Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, result.Items, 2)
+	})
+}
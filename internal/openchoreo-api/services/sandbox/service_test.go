@@ -0,0 +1,224 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sandbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	environmentsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/environment"
+	projectsvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/project"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+const (
+	testNamespace = "test-ns"
+	testProject   = "shop"
+	testEnv       = "dev"
+)
+
+func newTestService(t *testing.T, objs ...client.Object) (Service, client.Client) {
+	t.Helper()
+	cli := testutil.NewFakeClient(objs...)
+	return NewService(cli, testutil.TestLogger()), cli
+}
+
+func deployedBinding(componentName, releaseName string) *openchoreov1alpha1.ReleaseBinding {
+	rb := testutil.NewReleaseBinding(testNamespace, testProject, componentName, testEnv, componentName+"-"+testEnv)
+	rb.Spec.ReleaseName = releaseName
+	return rb
+}
+
+// sandboxObjects describes the shop project with the orders and web components deployed to the
+// dev environment, and the undeployed worker component.
+func sandboxObjects() []client.Object {
+	env := testutil.NewEnvironment(testNamespace, testEnv)
+	env.Spec.DataPlaneRef = &openchoreov1alpha1.DataPlaneRef{Kind: openchoreov1alpha1.DataPlaneRefKindDataPlane, Name: "default"}
+	worker := deployedBinding("worker", "worker-r1")
+	worker.Spec.State = openchoreov1alpha1.ReleaseStateUndeploy
+	return []client.Object{
+		testutil.NewProject(testNamespace, testProject),
+		env,
+		deployedBinding("orders", "orders-r3"),
+		deployedBinding("web", "web-r1"),
+		worker,
+	}
+}
+
+func TestCreateSandbox(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("clones every deployed component", func(t *testing.T) {
+		svc, cli := newTestService(t, sandboxObjects()...)
+
+		sb, err := svc.CreateSandbox(ctx, testNamespace, testProject, &CreateSandboxRequest{
+			Name:              "alice",
+			SourceEnvironment: testEnv,
+		})
+		require.NoError(t, err)
+
+		env := sb.Environment
+		require.NotNil(t, env.Spec.Sandbox)
+		assert.Equal(t, DefaultTTL, env.Spec.Sandbox.TTL.Duration)
+		assert.Equal(t, testProject, env.Spec.Sandbox.Project)
+		assert.Equal(t, testEnv, env.Spec.Sandbox.SourceEnvironment)
+		assert.Equal(t, "alice", env.Spec.Sandbox.Subdomain)
+		assert.Equal(t, "default", env.Spec.DataPlaneRef.Name)
+		assert.False(t, env.Spec.IsProduction)
+		assert.Equal(t, ocLabels.LabelValueTrue, env.Labels[ocLabels.LabelKeySandbox])
+
+		require.Len(t, sb.ReleaseBindings, 2)
+		assert.Equal(t, "orders-alice", sb.ReleaseBindings[0].Name)
+		assert.Equal(t, "alice", sb.ReleaseBindings[0].Spec.Environment)
+		assert.Equal(t, "orders-r3", sb.ReleaseBindings[0].Spec.ReleaseName)
+		assert.Equal(t, "web-alice", sb.ReleaseBindings[1].Name)
+
+		stored := &openchoreov1alpha1.ReleaseBinding{}
+		require.NoError(t, cli.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "orders-alice"}, stored))
+		assert.Equal(t, "orders-r3", stored.Spec.ReleaseName)
+	})
+
+	t.Run("clones the requested components", func(t *testing.T) {
+		svc, _ := newTestService(t, sandboxObjects()...)
+
+		sb, err := svc.CreateSandbox(ctx, testNamespace, testProject, &CreateSandboxRequest{
+			Name:              "bob",
+			SourceEnvironment: testEnv,
+			Components:        []string{"web"},
+			TTL:               2 * time.Hour,
+			Subdomain:         "bob-web",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2*time.Hour, sb.Environment.Spec.Sandbox.TTL.Duration)
+		assert.Equal(t, "bob-web", sb.Environment.Spec.Sandbox.Subdomain)
+		require.Len(t, sb.ReleaseBindings, 1)
+		assert.Equal(t, "web-bob", sb.ReleaseBindings[0].Name)
+	})
+
+	t.Run("preview of a pull request", func(t *testing.T) {
+		svc, _ := newTestService(t, sandboxObjects()...)
+
+		pr := &openchoreov1alpha1.SandboxPullRequest{
+			Provider:      "github",
+			RepositoryURL: "https://github.com/acme/shop",
+			Number:        42,
+			Commit:        "abc1234",
+		}
+		sb, err := svc.CreateSandbox(ctx, testNamespace, testProject, &CreateSandboxRequest{
+			Name:              "shop-pr-42",
+			SourceEnvironment: testEnv,
+			PullRequest:       pr,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, pr, sb.Environment.Spec.Sandbox.PullRequest)
+		assert.Equal(t, "42", sb.Environment.Labels[ocLabels.LabelKeyPullRequest])
+	})
+
+	t.Run("component not deployed", func(t *testing.T) {
+		svc, _ := newTestService(t, sandboxObjects()...)
+		_, err := svc.CreateSandbox(ctx, testNamespace, testProject, &CreateSandboxRequest{
+			Name: "bob", SourceEnvironment: testEnv, Components: []string{"worker"},
+		})
+		require.ErrorIs(t, err, ErrComponentNotDeployed)
+	})
+
+	t.Run("nothing deployed", func(t *testing.T) {
+		svc, _ := newTestService(t, testutil.NewProject(testNamespace, testProject), testutil.NewEnvironment(testNamespace, testEnv))
+		_, err := svc.CreateSandbox(ctx, testNamespace, testProject, &CreateSandboxRequest{Name: "bob", SourceEnvironment: testEnv})
+		require.ErrorIs(t, err, ErrNoComponentsToClone)
+	})
+
+	t.Run("name taken", func(t *testing.T) {
+		svc, _ := newTestService(t, sandboxObjects()...)
+		_, err := svc.CreateSandbox(ctx, testNamespace, testProject, &CreateSandboxRequest{Name: testEnv, SourceEnvironment: testEnv})
+		require.ErrorIs(t, err, ErrSandboxAlreadyExists)
+	})
+
+	t.Run("ttl out of range", func(t *testing.T) {
+		svc, _ := newTestService(t, sandboxObjects()...)
+		_, err := svc.CreateSandbox(ctx, testNamespace, testProject, &CreateSandboxRequest{
+			Name: "bob", SourceEnvironment: testEnv, TTL: MaxTTL + time.Hour,
+		})
+		var vErr *services.ValidationError
+		require.ErrorAs(t, err, &vErr)
+	})
+
+	t.Run("project not found", func(t *testing.T) {
+		svc, _ := newTestService(t)
+		_, err := svc.CreateSandbox(ctx, testNamespace, testProject, &CreateSandboxRequest{Name: "bob", SourceEnvironment: testEnv})
+		require.ErrorIs(t, err, projectsvc.ErrProjectNotFound)
+	})
+
+	t.Run("source environment not found", func(t *testing.T) {
+		svc, _ := newTestService(t, testutil.NewProject(testNamespace, testProject))
+		_, err := svc.CreateSandbox(ctx, testNamespace, testProject, &CreateSandboxRequest{Name: "bob", SourceEnvironment: testEnv})
+		require.ErrorIs(t, err, environmentsvc.ErrEnvironmentNotFound)
+	})
+}
+
+func TestGetListDeleteSandbox(t *testing.T) {
+	ctx := context.Background()
+	svc, cli := newTestService(t, sandboxObjects()...)
+
+	_, err := svc.CreateSandbox(ctx, testNamespace, testProject, &CreateSandboxRequest{Name: "alice", SourceEnvironment: testEnv})
+	require.NoError(t, err)
+
+	t.Run("get", func(t *testing.T) {
+		sb, err := svc.GetSandbox(ctx, testNamespace, testProject, "alice")
+		require.NoError(t, err)
+		assert.Equal(t, "alice", sb.Environment.Name)
+		assert.Len(t, sb.ReleaseBindings, 2)
+	})
+
+	t.Run("regular environments are not sandboxes", func(t *testing.T) {
+		_, err := svc.GetSandbox(ctx, testNamespace, testProject, testEnv)
+		require.ErrorIs(t, err, ErrSandboxNotFound)
+	})
+
+	t.Run("sandboxes of other projects are not found", func(t *testing.T) {
+		_, err := svc.GetSandbox(ctx, testNamespace, "billing", "alice")
+		require.ErrorIs(t, err, ErrSandboxNotFound)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		result, err := svc.ListSandboxes(ctx, testNamespace, testProject, services.ListOptions{})
+		require.NoError(t, err)
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, "alice", result.Items[0].Environment.Name)
+		assert.Len(t, result.Items[0].ReleaseBindings, 2)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, svc.DeleteSandbox(ctx, testNamespace, testProject, "alice"))
+		err := cli.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "alice"}, &openchoreov1alpha1.Environment{})
+		require.True(t, apierrors.IsNotFound(err), "expected the sandbox environment to be deleted")
+
+		require.ErrorIs(t, svc.DeleteSandbox(ctx, testNamespace, testProject, "alice"), ErrSandboxNotFound)
+	})
+}
+
+func TestCloneBinding(t *testing.T) {
+	source := deployedBinding("orders", "orders-r3")
+	source.Spec.Strategy = &openchoreov1alpha1.RolloutStrategy{Type: openchoreov1alpha1.RolloutStrategyCanary}
+	source.Spec.WorkloadOverrides = &openchoreov1alpha1.WorkloadOverrideTemplateSpec{}
+	source.CreationTimestamp = metav1.Now()
+
+	binding := cloneBinding(source, "alice")
+	assert.Equal(t, "orders-alice", binding.Name)
+	assert.Equal(t, "alice", binding.Spec.Environment)
+	assert.Equal(t, source.Spec.Owner, binding.Spec.Owner)
+	assert.NotNil(t, binding.Spec.WorkloadOverrides)
+	assert.NotSame(t, source.Spec.WorkloadOverrides, binding.Spec.WorkloadOverrides)
+	assert.Nil(t, binding.Spec.Strategy)
+}
@@ -10,4 +10,5 @@ var (
 	ErrSecretNotFound           = errors.New("secret not found")
 	ErrPlaneNotFound            = errors.New("target plane not found")
 	ErrSecretStoreNotConfigured = errors.New("secret store not configured")
+	ErrTargetPlaneMismatch      = errors.New("secret is stored on a different target plane")
 )
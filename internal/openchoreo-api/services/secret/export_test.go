@@ -6,15 +6,19 @@ package secret
 import (
 	"log/slog"
 
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
 	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 )
 
 // NewAuthzServiceForTest builds a secretServiceWithAuthz with the given
-// Service and PDP, allowing external tests to inject mocks for both.
-func NewAuthzServiceForTest(internal Service, pdp authzcore.PDP, logger *slog.Logger) Service {
+// Service, control-plane client and PDP, allowing external tests to inject
+// mocks for all of them.
+func NewAuthzServiceForTest(internal Service, k8sClient client.Client, pdp authzcore.PDP, logger *slog.Logger) Service {
 	return &secretServiceWithAuthz{
-		internal: internal,
-		authz:    services.NewAuthzChecker(pdp, logger),
+		internal:  internal,
+		k8sClient: k8sClient,
+		authz:     services.NewAuthzChecker(pdp, logger),
 	}
 }
@@ -21,6 +21,9 @@ type CreateSecretParams struct {
 	// Labels are applied as Kubernetes labels on the underlying
 	// SecretReference.
 	Labels map[string]string
+	// Annotations are applied as Kubernetes annotations on the underlying
+	// SecretReference.
+	Annotations map[string]string
 }
 
 // UpdateSecretParams holds the parameters for replacing a secret's data.
@@ -32,10 +35,23 @@ type UpdateSecretParams struct {
 	Labels map[string]string
 }
 
+// StoreSecretValueParams holds the parameters for storing a single value in a
+// secret. The secret is created on the target plane when it does not exist.
+type StoreSecretValueParams struct {
+	SecretName  string
+	TargetPlane openchoreov1alpha1.TargetPlaneRef
+	Key         string
+	Value       string
+}
+
 // Service defines the secret operations exposed by the Secret API.
 type Service interface {
 	CreateSecret(ctx context.Context, namespaceName string, req *CreateSecretParams) (*corev1.Secret, error)
 	UpdateSecret(ctx context.Context, namespaceName, secretName string, req *UpdateSecretParams) (*corev1.Secret, error)
+	SetSecretValue(ctx context.Context, namespaceName, secretName, key, value string) (*corev1.Secret, error)
+	// StoreSecretValue sets a single key of a secret, creating the secret in the
+	// external secret store of the target plane when it does not exist yet.
+	StoreSecretValue(ctx context.Context, namespaceName string, req *StoreSecretValueParams) (*corev1.Secret, error)
 	GetSecret(ctx context.Context, namespaceName, secretName string) (*corev1.Secret, error)
 	ListSecrets(ctx context.Context, namespaceName string, opts services.ListOptions) (*services.ListResult[corev1.Secret], error)
 	DeleteSecret(ctx context.Context, namespaceName, secretName string) error
@@ -253,6 +253,128 @@ func (_c *MockService_ListSecrets_Call) RunAndReturn(run func(context.Context, s
 	return _c
 }
 
+// SetSecretValue provides a mock function with given fields: ctx, namespaceName, secretName, key, value
+func (_m *MockService) SetSecretValue(ctx context.Context, namespaceName string, secretName string, key string, value string) (*v1.Secret, error) {
+	ret := _m.Called(ctx, namespaceName, secretName, key, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSecretValue")
+	}
+
+	var r0 *v1.Secret
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (*v1.Secret, error)); ok {
+		return rf(ctx, namespaceName, secretName, key, value)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) *v1.Secret); ok {
+		r0 = rf(ctx, namespaceName, secretName, key, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.Secret)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, secretName, key, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_SetSecretValue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSecretValue'
+type MockService_SetSecretValue_Call struct {
+	*mock.Call
+}
+
+// SetSecretValue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - secretName string
+//   - key string
+//   - value string
+func (_e *MockService_Expecter) SetSecretValue(ctx interface{}, namespaceName interface{}, secretName interface{}, key interface{}, value interface{}) *MockService_SetSecretValue_Call {
+	return &MockService_SetSecretValue_Call{Call: _e.mock.On("SetSecretValue", ctx, namespaceName, secretName, key, value)}
+}
+
+func (_c *MockService_SetSecretValue_Call) Run(run func(ctx context.Context, namespaceName string, secretName string, key string, value string)) *MockService_SetSecretValue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_SetSecretValue_Call) Return(_a0 *v1.Secret, _a1 error) *MockService_SetSecretValue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_SetSecretValue_Call) RunAndReturn(run func(context.Context, string, string, string, string) (*v1.Secret, error)) *MockService_SetSecretValue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StoreSecretValue provides a mock function with given fields: ctx, namespaceName, req
+func (_m *MockService) StoreSecretValue(ctx context.Context, namespaceName string, req *secret.StoreSecretValueParams) (*v1.Secret, error) {
+	ret := _m.Called(ctx, namespaceName, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoreSecretValue")
+	}
+
+	var r0 *v1.Secret
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *secret.StoreSecretValueParams) (*v1.Secret, error)); ok {
+		return rf(ctx, namespaceName, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *secret.StoreSecretValueParams) *v1.Secret); ok {
+		r0 = rf(ctx, namespaceName, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.Secret)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *secret.StoreSecretValueParams) error); ok {
+		r1 = rf(ctx, namespaceName, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_StoreSecretValue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreSecretValue'
+type MockService_StoreSecretValue_Call struct {
+	*mock.Call
+}
+
+// StoreSecretValue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - req *secret.StoreSecretValueParams
+func (_e *MockService_Expecter) StoreSecretValue(ctx interface{}, namespaceName interface{}, req interface{}) *MockService_StoreSecretValue_Call {
+	return &MockService_StoreSecretValue_Call{Call: _e.mock.On("StoreSecretValue", ctx, namespaceName, req)}
+}
+
+func (_c *MockService_StoreSecretValue_Call) Run(run func(ctx context.Context, namespaceName string, req *secret.StoreSecretValueParams)) *MockService_StoreSecretValue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*secret.StoreSecretValueParams))
+	})
+	return _c
+}
+
+func (_c *MockService_StoreSecretValue_Call) Return(_a0 *v1.Secret, _a1 error) *MockService_StoreSecretValue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_StoreSecretValue_Call) RunAndReturn(run func(context.Context, string, *secret.StoreSecretValueParams) (*v1.Secret, error)) *MockService_StoreSecretValue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateSecret provides a mock function with given fields: ctx, namespaceName, secretName, req
 func (_m *MockService) UpdateSecret(ctx context.Context, namespaceName string, secretName string, req *secret.UpdateSecretParams) (*v1.Secret, error) {
 	ret := _m.Called(ctx, namespaceName, secretName, req)
@@ -15,6 +15,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
@@ -147,7 +148,7 @@ func (s *secretService) CreateSecret(ctx context.Context, namespaceName string,
 		return nil, fmt.Errorf("failed to apply push secret in target plane: %w", err)
 	}
 
-	secretRef := buildSecretReference(namespaceName, req.SecretName, req.SecretType, req.TargetPlane, sortedKeys(req.Data), req.Labels)
+	secretRef := buildSecretReference(namespaceName, req.SecretName, req.SecretType, req.TargetPlane, sortedKeys(req.Data), req.Labels, req.Annotations)
 	if err := s.k8sClient.Create(ctx, secretRef); err != nil {
 		return nil, fmt.Errorf("failed to create secret reference: %w", err)
 	}
@@ -161,7 +162,13 @@ func (s *secretService) CreateSecret(ctx context.Context, namespaceName string,
 // from the K8s Secret, PushSecret, and SecretReference.
 func (s *secretService) UpdateSecret(ctx context.Context, namespaceName, secretName string, req *UpdateSecretParams) (*corev1.Secret, error) {
 	s.logger.Debug("Updating secret", "namespace", namespaceName, "secret", secretName)
+	return s.updateSecret(ctx, namespaceName, secretName, req, "")
+}
 
+// updateSecret applies req as the secret's final state. A non-empty
+// resourceVersion is sent with the K8s Secret apply, so the write fails with a
+// conflict if the target-plane Secret changed since it was read.
+func (s *secretService) updateSecret(ctx context.Context, namespaceName, secretName string, req *UpdateSecretParams, resourceVersion string) (*corev1.Secret, error) {
 	secretRef := &openchoreov1alpha1.SecretReference{}
 	key := client.ObjectKey{Name: secretName, Namespace: namespaceName}
 	if err := s.k8sClient.Get(ctx, key, secretRef); err != nil {
@@ -191,6 +198,7 @@ func (s *secretService) UpdateSecret(ctx context.Context, namespaceName, secretN
 	newKeys := sortedKeys(req.Data)
 
 	k8sSecret := buildK8sSecret(secretName, targetNs, secretType, req.Data)
+	k8sSecret.ResourceVersion = resourceVersion
 	if err := planeInfo.k8sClient.Patch(ctx, k8sSecret, client.Apply, client.ForceOwnership, client.FieldOwner(fieldOwner)); err != nil {
 		return nil, fmt.Errorf("failed to apply k8s secret in target plane: %w", err)
 	}
@@ -220,6 +228,92 @@ func (s *secretService) UpdateSecret(ctx context.Context, namespaceName, secretN
 	return buildResponseSecret(secretRef, secretType, stringMapToBytes(req.Data)), nil
 }
 
+// SetSecretValue sets or rotates a single key of a secret managed by this API.
+// The remaining keys and the user-set labels are carried over from the current
+// target-plane Secret, so callers never need to read the other values. The
+// write is guarded by the Secret's resourceVersion and retried on conflict, so
+// concurrent rotations of different keys do not drop each other's values.
+func (s *secretService) SetSecretValue(ctx context.Context, namespaceName, secretName, key, value string) (*corev1.Secret, error) {
+	s.logger.Debug("Setting secret value", "namespace", namespaceName, "secret", secretName, "key", key)
+
+	var result *corev1.Secret
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secretRef := &openchoreov1alpha1.SecretReference{}
+		if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: secretName, Namespace: namespaceName}, secretRef); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				return ErrSecretNotFound
+			}
+			return fmt.Errorf("failed to get secret reference: %w", err)
+		}
+		if !isManagedSecret(secretRef) {
+			return ErrSecretNotFound
+		}
+
+		planeInfo, err := s.resolvePlane(ctx, namespaceName, secretRef.Spec.TargetPlane.Kind, secretRef.Spec.TargetPlane.Name)
+		if err != nil {
+			return err
+		}
+		planeSecret, err := s.fetchSecret(ctx, planeInfo.k8sClient, kvNamespace(namespaceName), secretName)
+		if err != nil {
+			return err
+		}
+
+		data := make(map[string]string, len(planeSecret.Data)+1)
+		for k, v := range planeSecret.Data {
+			data[k] = string(v)
+		}
+		data[key] = value
+
+		userLabels := make(map[string]string, len(secretRef.Labels))
+		for k, v := range secretRef.Labels {
+			if k != managedByLabel {
+				userLabels[k] = v
+			}
+		}
+
+		result, err = s.updateSecret(ctx, namespaceName, secretName,
+			&UpdateSecretParams{Data: data, Labels: userLabels}, planeSecret.ResourceVersion)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// StoreSecretValue writes a single value to a secret managed by this API. A
+// missing secret is created as an Opaque secret on the requested plane; an
+// existing one must already live on that plane, as its value is only pushed to
+// that plane's external secret store.
+func (s *secretService) StoreSecretValue(ctx context.Context, namespaceName string, req *StoreSecretValueParams) (*corev1.Secret, error) {
+	s.logger.Debug("Storing secret value", "namespace", namespaceName, "secret", req.SecretName, "key", req.Key,
+		"plane", req.TargetPlane.Kind+"/"+req.TargetPlane.Name)
+
+	if req.Key == "" {
+		return nil, &services.ValidationError{Msg: "key is required"}
+	}
+
+	existing := &openchoreov1alpha1.SecretReference{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: req.SecretName, Namespace: namespaceName}, existing); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return nil, fmt.Errorf("failed to get secret reference: %w", err)
+		}
+		return s.CreateSecret(ctx, namespaceName, &CreateSecretParams{
+			SecretName:  req.SecretName,
+			SecretType:  corev1.SecretTypeOpaque,
+			TargetPlane: req.TargetPlane,
+			Data:        map[string]string{req.Key: req.Value},
+		})
+	}
+	if !isManagedSecret(existing) {
+		return nil, ErrSecretAlreadyExists
+	}
+	if *existing.Spec.TargetPlane != req.TargetPlane {
+		return nil, ErrTargetPlaneMismatch
+	}
+	return s.SetSecretValue(ctx, namespaceName, req.SecretName, req.Key, req.Value)
+}
+
 // GetSecret returns a secret managed by this API, including its data from
 // the target plane. Returns ErrSecretNotFound if either the SecretReference
 // or the target-plane K8s Secret is missing.
@@ -607,16 +701,17 @@ func buildPushSecret(name, ownerNamespace, targetNamespace, secretStoreName stri
 	return ps
 }
 
-func buildSecretReference(ownerNamespace, name string, secretType corev1.SecretType, target openchoreov1alpha1.TargetPlaneRef, keys []string, userLabels map[string]string) *openchoreov1alpha1.SecretReference {
+func buildSecretReference(ownerNamespace, name string, secretType corev1.SecretType, target openchoreov1alpha1.TargetPlaneRef, keys []string, userLabels, annotations map[string]string) *openchoreov1alpha1.SecretReference {
 	return &openchoreov1alpha1.SecretReference{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: openchoreov1alpha1.GroupVersion.String(),
 			Kind:       "SecretReference",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: ownerNamespace,
-			Labels:    mergeManagedLabels(userLabels),
+			Name:        name,
+			Namespace:   ownerNamespace,
+			Labels:      mergeManagedLabels(userLabels),
+			Annotations: annotations,
 		},
 		Spec: openchoreov1alpha1.SecretReferenceSpec{
 			TargetPlane: &openchoreov1alpha1.TargetPlaneRef{Kind: target.Kind, Name: target.Name},
@@ -5,11 +5,14 @@ package secret
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	authz "github.com/openchoreo/openchoreo/internal/authz/core"
 	kubernetesClient "github.com/openchoreo/openchoreo/internal/clients/kubernetes"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
@@ -18,15 +21,17 @@ import (
 const resourceTypeSecret = "secret"
 
 type secretServiceWithAuthz struct {
-	internal Service
-	authz    *services.AuthzChecker
+	internal  Service
+	k8sClient client.Client
+	authz     *services.AuthzChecker
 }
 
 // NewServiceWithAuthz creates a new secret service with authorization checks.
 func NewServiceWithAuthz(k8sClient client.Client, planeClientProvider kubernetesClient.PlaneClientProvider, authzPDP authz.PDP, logger *slog.Logger) Service {
 	return &secretServiceWithAuthz{
-		internal: NewService(k8sClient, planeClientProvider, logger),
-		authz:    services.NewAuthzChecker(authzPDP, logger),
+		internal:  NewService(k8sClient, planeClientProvider, logger),
+		k8sClient: k8sClient,
+		authz:     services.NewAuthzChecker(authzPDP, logger),
 	}
 }
 
@@ -54,6 +59,51 @@ func (s *secretServiceWithAuthz) UpdateSecret(ctx context.Context, namespaceName
 	return s.internal.UpdateSecret(ctx, namespaceName, secretName, req)
 }
 
+func (s *secretServiceWithAuthz) SetSecretValue(ctx context.Context, namespaceName, secretName, key, value string) (*corev1.Secret, error) {
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionUpdateSecret,
+		ResourceType: resourceTypeSecret,
+		ResourceID:   secretName,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.SetSecretValue(ctx, namespaceName, secretName, key, value)
+}
+
+// StoreSecretValue requires permission to create the secret when its
+// SecretReference does not exist yet, and to update it otherwise.
+func (s *secretServiceWithAuthz) StoreSecretValue(ctx context.Context, namespaceName string, req *StoreSecretValueParams) (*corev1.Secret, error) {
+	action, err := s.storeSecretValueAction(ctx, namespaceName, req.SecretName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       action,
+		ResourceType: resourceTypeSecret,
+		ResourceID:   req.SecretName,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.StoreSecretValue(ctx, namespaceName, req)
+}
+
+// storeSecretValueAction picks the action StoreSecretValue is authorized
+// against. It only reads the metadata of the SecretReference, so the secret
+// and its data are not read before the caller is authorized.
+func (s *secretServiceWithAuthz) storeSecretValueAction(ctx context.Context, namespaceName, secretName string) (string, error) {
+	ref := &metav1.PartialObjectMetadata{}
+	ref.SetGroupVersionKind(openchoreov1alpha1.GroupVersion.WithKind("SecretReference"))
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: secretName, Namespace: namespaceName}, ref); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return "", fmt.Errorf("failed to get secret reference: %w", err)
+		}
+		return authz.ActionCreateSecret, nil
+	}
+	return authz.ActionUpdateSecret, nil
+}
+
 func (s *secretServiceWithAuthz) GetSecret(ctx context.Context, namespaceName, secretName string) (*corev1.Secret, error) {
 	if err := s.authz.Check(ctx, services.CheckRequest{
 		Action:       authz.ActionViewSecret,
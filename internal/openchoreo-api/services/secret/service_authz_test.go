@@ -13,6 +13,9 @@ import (
 	"github.com/stretchr/testify/mock"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
@@ -60,9 +63,25 @@ func newErrorPDP(t *testing.T, err error) *authzmocks.MockPDP {
 
 func newAuthzService(t *testing.T, svcSetup func(*secretmocks.MockService), pdp authzcore.PDP) secret.Service {
 	t.Helper()
+	return newAuthzServiceWithObjects(t, svcSetup, pdp)
+}
+
+// newAuthzServiceWithObjects builds the authz service with a control-plane
+// client that holds objs.
+func newAuthzServiceWithObjects(t *testing.T, svcSetup func(*secretmocks.MockService), pdp authzcore.PDP, objs ...client.Object) secret.Service {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := openchoreov1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add openchoreo scheme: %v", err)
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
 	mockSvc := secretmocks.NewMockService(t)
 	svcSetup(mockSvc)
-	return secret.NewAuthzServiceForTest(mockSvc, pdp, newTestLogger())
+	return secret.NewAuthzServiceForTest(mockSvc, k8sClient, pdp, newTestLogger())
+}
+
+func sampleSecretReference() *openchoreov1alpha1.SecretReference {
+	return &openchoreov1alpha1.SecretReference{ObjectMeta: metav1.ObjectMeta{Name: testSecret, Namespace: testNamespace}}
 }
 
 func sampleCreateParams() *secret.CreateSecretParams {
@@ -202,6 +221,92 @@ func TestAuthzUpdateSecret_Denied(t *testing.T) {
 	}
 }
 
+// --- SetSecretValue ---
+
+func TestAuthzSetSecretValue_Allowed(t *testing.T) {
+	expected := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: testSecret, Namespace: testNamespace}}
+	svc := newAuthzService(t, func(m *secretmocks.MockService) {
+		m.EXPECT().SetSecretValue(mock.Anything, testNamespace, testSecret, "k", "v").Return(expected, nil)
+	}, newAllowAllPDP(t))
+
+	got, err := svc.SetSecretValue(context.Background(), testNamespace, testSecret, "k", "v")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Name != testSecret {
+		t.Errorf("got Name = %q", got.Name)
+	}
+}
+
+func TestAuthzSetSecretValue_Denied(t *testing.T) {
+	svc := newAuthzService(t, func(_ *secretmocks.MockService) {}, newDenyAllPDP(t))
+
+	_, err := svc.SetSecretValue(context.Background(), testNamespace, testSecret, "k", "v")
+	if !errors.Is(err, services.ErrForbidden) {
+		t.Errorf("expected ErrForbidden, got %v", err)
+	}
+}
+
+// --- StoreSecretValue ---
+
+func newActionPDP(t *testing.T, action string) *authzmocks.MockPDP {
+	t.Helper()
+	pdp := authzmocks.NewMockPDP(t)
+	pdp.EXPECT().Evaluate(mock.Anything, mock.MatchedBy(func(req *authzcore.EvaluateRequest) bool {
+		return req.Action == action
+	})).Return(&authzcore.Decision{Decision: true, Context: &authzcore.DecisionContext{}}, nil).Once()
+	return pdp
+}
+
+func TestAuthzStoreSecretValue_ChecksCreateForNewSecret(t *testing.T) {
+	req := &secret.StoreSecretValueParams{SecretName: testSecret, Key: "k", Value: "v"}
+	expected := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: testSecret, Namespace: testNamespace}}
+	svc := newAuthzService(t, func(m *secretmocks.MockService) {
+		m.EXPECT().StoreSecretValue(mock.Anything, testNamespace, req).Return(expected, nil)
+	}, newActionPDP(t, authzcore.ActionCreateSecret))
+
+	if _, err := svc.StoreSecretValue(context.Background(), testNamespace, req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestAuthzStoreSecretValue_ChecksUpdateForExistingSecret(t *testing.T) {
+	req := &secret.StoreSecretValueParams{SecretName: testSecret, Key: "k", Value: "v"}
+	expected := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: testSecret, Namespace: testNamespace}}
+	svc := newAuthzServiceWithObjects(t, func(m *secretmocks.MockService) {
+		m.EXPECT().StoreSecretValue(mock.Anything, testNamespace, req).Return(expected, nil)
+	}, newActionPDP(t, authzcore.ActionUpdateSecret), sampleSecretReference())
+
+	if _, err := svc.StoreSecretValue(context.Background(), testNamespace, req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// A denied caller must not learn whether the secret exists, and the inner
+// service, which reads the target-plane secret, must never be called.
+func TestAuthzStoreSecretValue_Denied(t *testing.T) {
+	tests := []struct {
+		name string
+		objs []client.Object
+	}{
+		{name: "secret does not exist"},
+		{name: "secret exists", objs: []client.Object{sampleSecretReference()}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newAuthzServiceWithObjects(t, func(_ *secretmocks.MockService) {
+				// Inner service should not be called.
+			}, newDenyAllPDP(t), tt.objs...)
+
+			_, err := svc.StoreSecretValue(context.Background(), testNamespace,
+				&secret.StoreSecretValueParams{SecretName: testSecret, Key: "k", Value: "v"})
+			if !errors.Is(err, services.ErrForbidden) {
+				t.Errorf("expected ErrForbidden, got %v", err)
+			}
+		})
+	}
+}
+
 // --- GetSecret ---
 
 func TestAuthzGetSecret_Allowed(t *testing.T) {
@@ -304,7 +304,8 @@ func TestBuildSecretReference(t *testing.T) {
 	ref := buildSecretReference(testNamespace, testSecretName, corev1.SecretTypeBasicAuth,
 		openchoreov1alpha1.TargetPlaneRef{Kind: planeKindWorkflowPlane, Name: "wp1"},
 		[]string{"password", "username"},
-		map[string]string{"team": testLabelValue})
+		map[string]string{"team": testLabelValue},
+		map[string]string{"example.com/note": "kept"})
 
 	if ref.Name != testSecretName || ref.Namespace != testNamespace {
 		t.Errorf("metadata = %s/%s", ref.Namespace, ref.Name)
@@ -333,6 +334,9 @@ func TestBuildSecretReference(t *testing.T) {
 	if ref.Labels["team"] != testLabelValue {
 		t.Errorf("user label team = %q, want payments", ref.Labels["team"])
 	}
+	if ref.Annotations["example.com/note"] != "kept" {
+		t.Errorf("annotations = %v", ref.Annotations)
+	}
 }
 
 // --- resolvePlane ---
@@ -1001,6 +1005,226 @@ func TestUpdateSecret_Success_KeysChanged(t *testing.T) {
 	}
 }
 
+// --- SetSecretValue ---
+
+func TestSetSecretValue_RotatesKeyAndPreservesOthers(t *testing.T) {
+	scheme := newTestScheme(t)
+	wp := newWorkflowPlane()
+	ref := managedRef(testSecretName, corev1.SecretTypeOpaque, []string{"a", "b"})
+	ref.Labels["team"] = "payments"
+	cpClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(wp, ref).Build()
+
+	planeSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: testSecretName, Namespace: kvNamespace(testNamespace)},
+		Type:       corev1.SecretTypeOpaque,
+		Data:       map[string][]byte{"a": []byte("1"), "b": []byte("2")},
+	}
+	rec := &patchRec{}
+	targetClient := newTargetPlaneClient(t, scheme, []client.Object{planeSecret}, rec)
+
+	mockProvider := k8sMocks.NewMockPlaneClientProvider(t)
+	mockProvider.EXPECT().WorkflowPlaneClient(wp).Return(targetClient, nil).Twice()
+
+	svc := &secretService{k8sClient: cpClient, planeClientProvider: mockProvider, logger: newTestLogger()}
+
+	got, err := svc.SetSecretValue(context.Background(), testNamespace, testSecretName, "b", "rotated")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rec.count != 2 {
+		t.Errorf("expected 2 SSA patches, got %d", rec.count)
+	}
+	if string(got.Data["a"]) != "1" || string(got.Data["b"]) != "rotated" {
+		t.Errorf("unexpected data: %v", got.Data)
+	}
+
+	updated := &openchoreov1alpha1.SecretReference{}
+	if err := cpClient.Get(context.Background(),
+		client.ObjectKey{Name: testSecretName, Namespace: testNamespace}, updated); err != nil {
+		t.Fatalf("get SecretReference: %v", err)
+	}
+	if updated.Labels["team"] != "payments" || updated.Labels[managedByLabel] != managedByOpenchoreoAPI {
+		t.Errorf("labels not preserved: %v", updated.Labels)
+	}
+}
+
+func TestSetSecretValue_RetriesOnConflict(t *testing.T) {
+	scheme := newTestScheme(t)
+	wp := newWorkflowPlane()
+	ref := managedRef(testSecretName, corev1.SecretTypeOpaque, []string{"a", "b"})
+	cpClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(wp, ref).Build()
+
+	planeSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: testSecretName, Namespace: kvNamespace(testNamespace)},
+		Type:       corev1.SecretTypeOpaque,
+		Data:       map[string][]byte{"a": []byte("1"), "b": []byte("2")},
+	}
+	var secretApplies []string
+	targetClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(planeSecret).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Patch: func(_ context.Context, _ client.WithWatch, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+				if _, ok := obj.(*corev1.Secret); !ok {
+					return nil
+				}
+				secretApplies = append(secretApplies, obj.GetResourceVersion())
+				if len(secretApplies) == 1 {
+					return apierrors.NewConflict(schema.GroupResource{Resource: "secrets"}, obj.GetName(), errors.New("modified"))
+				}
+				return nil
+			},
+		}).
+		Build()
+
+	mockProvider := k8sMocks.NewMockPlaneClientProvider(t)
+	mockProvider.EXPECT().WorkflowPlaneClient(wp).Return(targetClient, nil).Times(4)
+
+	svc := &secretService{k8sClient: cpClient, planeClientProvider: mockProvider, logger: newTestLogger()}
+
+	got, err := svc.SetSecretValue(context.Background(), testNamespace, testSecretName, "b", "rotated")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(secretApplies) != 2 {
+		t.Fatalf("expected 2 secret applies, got %d", len(secretApplies))
+	}
+	for i, rv := range secretApplies {
+		if rv == "" {
+			t.Errorf("apply %d was sent without a resourceVersion", i)
+		}
+	}
+	if string(got.Data["a"]) != "1" || string(got.Data["b"]) != "rotated" {
+		t.Errorf("unexpected data: %v", got.Data)
+	}
+}
+
+func TestSetSecretValue_NotFound(t *testing.T) {
+	scheme := newTestScheme(t)
+	cpClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	svc := &secretService{k8sClient: cpClient, logger: newTestLogger()}
+
+	_, err := svc.SetSecretValue(context.Background(), testNamespace, testSecretName, "a", "1")
+	if !errors.Is(err, ErrSecretNotFound) {
+		t.Errorf("expected ErrSecretNotFound, got %v", err)
+	}
+}
+
+func TestStoreSecretValue_CreatesMissingSecret(t *testing.T) {
+	scheme := newTestScheme(t)
+	wp := newWorkflowPlane()
+	cpClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(wp).Build()
+
+	rec := &patchRec{}
+	targetClient := newTargetPlaneClient(t, scheme, nil, rec)
+	mockProvider := k8sMocks.NewMockPlaneClientProvider(t)
+	mockProvider.EXPECT().WorkflowPlaneClient(wp).Return(targetClient, nil).Once()
+
+	svc := &secretService{k8sClient: cpClient, planeClientProvider: mockProvider, logger: newTestLogger()}
+
+	got, err := svc.StoreSecretValue(context.Background(), testNamespace, &StoreSecretValueParams{
+		SecretName:  testSecretName,
+		TargetPlane: openchoreov1alpha1.TargetPlaneRef{Kind: planeKindWorkflowPlane, Name: "wp1"},
+		Key:         "DB_PASSWORD",
+		Value:       "s3cret",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(got.Data["DB_PASSWORD"]) != "s3cret" {
+		t.Errorf("unexpected data: %v", got.Data)
+	}
+	if rec.count != 2 {
+		t.Errorf("expected 2 SSA patches (Secret + PushSecret), got %d", rec.count)
+	}
+
+	ref := &openchoreov1alpha1.SecretReference{}
+	if err := cpClient.Get(context.Background(),
+		client.ObjectKey{Name: testSecretName, Namespace: testNamespace}, ref); err != nil {
+		t.Fatalf("SecretReference not created: %v", err)
+	}
+	if ref.Spec.Template.Type != corev1.SecretTypeOpaque {
+		t.Errorf("template type = %q, want Opaque", ref.Spec.Template.Type)
+	}
+	if keys := dataSourceKeys(ref.Spec.Data); !sameStringSlice(keys, []string{"DB_PASSWORD"}) {
+		t.Errorf("data source keys = %v", keys)
+	}
+}
+
+func TestStoreSecretValue_UpdatesExistingSecret(t *testing.T) {
+	scheme := newTestScheme(t)
+	wp := newWorkflowPlane()
+	ref := managedRef(testSecretName, corev1.SecretTypeOpaque, []string{"a"})
+	cpClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(wp, ref).Build()
+
+	planeSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: testSecretName, Namespace: kvNamespace(testNamespace)},
+		Type:       corev1.SecretTypeOpaque,
+		Data:       map[string][]byte{"a": []byte("1")},
+	}
+	targetClient := newTargetPlaneClient(t, scheme, []client.Object{planeSecret}, &patchRec{})
+	mockProvider := k8sMocks.NewMockPlaneClientProvider(t)
+	mockProvider.EXPECT().WorkflowPlaneClient(wp).Return(targetClient, nil).Twice()
+
+	svc := &secretService{k8sClient: cpClient, planeClientProvider: mockProvider, logger: newTestLogger()}
+
+	got, err := svc.StoreSecretValue(context.Background(), testNamespace, &StoreSecretValueParams{
+		SecretName:  testSecretName,
+		TargetPlane: openchoreov1alpha1.TargetPlaneRef{Kind: planeKindWorkflowPlane, Name: "wp1"},
+		Key:         "b",
+		Value:       "2",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(got.Data["a"]) != "1" || string(got.Data["b"]) != "2" {
+		t.Errorf("unexpected data: %v", got.Data)
+	}
+}
+
+func TestStoreSecretValue_Rejected(t *testing.T) {
+	scheme := newTestScheme(t)
+	unmanaged := managedRef("unmanaged", corev1.SecretTypeOpaque, []string{"a"})
+	delete(unmanaged.Labels, managedByLabel)
+	cpClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(managedRef(testSecretName, corev1.SecretTypeOpaque, []string{"a"}), unmanaged).Build()
+	svc := &secretService{k8sClient: cpClient, logger: newTestLogger()}
+
+	tests := []struct {
+		name    string
+		req     *StoreSecretValueParams
+		wantErr error
+	}{
+		{
+			name: "different target plane",
+			req: &StoreSecretValueParams{SecretName: testSecretName, Key: "a", Value: "1",
+				TargetPlane: openchoreov1alpha1.TargetPlaneRef{Kind: planeKindDataPlane, Name: "dp1"}},
+			wantErr: ErrTargetPlaneMismatch,
+		},
+		{
+			name: "secret not managed by the API",
+			req: &StoreSecretValueParams{SecretName: "unmanaged", Key: "a", Value: "1",
+				TargetPlane: openchoreov1alpha1.TargetPlaneRef{Kind: planeKindWorkflowPlane, Name: "wp1"}},
+			wantErr: ErrSecretAlreadyExists,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.StoreSecretValue(context.Background(), testNamespace, tt.req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	t.Run("missing key", func(t *testing.T) {
+		_, err := svc.StoreSecretValue(context.Background(), testNamespace, &StoreSecretValueParams{SecretName: testSecretName})
+		if !isValidationError(err) {
+			t.Errorf("expected validation error, got %v", err)
+		}
+	})
+}
+
 func TestUpdateSecret_LabelsApplied(t *testing.T) {
 	scheme := newTestScheme(t)
 	wp := newWorkflowPlane()
@@ -0,0 +1,226 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+// Sort fields accepted by SortedList. Prefix a field with "-" to sort in
+// descending order.
+const (
+	SortByName              = "name"
+	SortByCreationTimestamp = "creationTimestamp"
+	SortByStatus            = "status"
+)
+
+// sortCursor is the opaque cursor of a sorted list.
+type sortCursor struct {
+	// Sort is the sort key the cursor was issued for.
+	Sort string `json:"sort"`
+	// Offset is the index of the next item in the sorted list.
+	Offset int `json:"o"`
+}
+
+// sortEntry pairs a list item with the values it is sorted by.
+type sortEntry[T any] struct {
+	item      T
+	namespace string
+	name      string
+	created   time.Time
+	status    string
+}
+
+// SortedList lists one page of items from listResource and fills in the list
+// metadata of the result.
+//
+// Without a sort key the page comes straight from listResource in its natural
+// order, and TotalCount is only set when it is known from the first page.
+// With a sort key every matching item is listed, sorted and paged in memory,
+// so TotalCount is always set and the returned cursor only applies to the same
+// sort key. Items that compare equal are ordered by namespace and name.
+//
+// The "status" key orders by status.phase when a resource has one, and by the
+// reason of its Ready condition otherwise.
+func SortedList[T any](ctx context.Context, opts ListOptions, listResource ListResource[T]) (*ListResult[T], error) {
+	if opts.Sort == "" {
+		result, err := listResource(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		if opts.Cursor == "" {
+			switch {
+			case result.NextCursor == "":
+				result.TotalCount = ptr.To(int64(len(result.Items)))
+			case result.RemainingCount != nil:
+				result.TotalCount = ptr.To(int64(len(result.Items)) + *result.RemainingCount)
+			}
+		}
+		return result.WithFilter("labelSelector", opts.LabelSelector), nil
+	}
+
+	field, desc, err := parseSort(opts.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := 0
+	if opts.Cursor != "" {
+		cur, err := decodeSortCursor(opts.Cursor)
+		if err != nil || cur.Sort != opts.Sort || cur.Offset < 0 {
+			return nil, &ValidationError{Msg: "invalid cursor for sort " + opts.Sort}
+		}
+		offset = cur.Offset
+	}
+
+	var all []T
+	pageOpts := ListOptions{LabelSelector: opts.LabelSelector}
+	for {
+		page, err := listResource(ctx, pageOpts)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page.Items...)
+		if page.NextCursor == "" {
+			break
+		}
+		pageOpts.Cursor = page.NextCursor
+	}
+
+	entries := make([]sortEntry[T], len(all))
+	for i := range all {
+		entry, err := newSortEntry(&all[i], field)
+		if err != nil {
+			return nil, err
+		}
+		entries[i] = entry
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		c := compareSortEntries(entries[i], entries[j], field)
+		if c != 0 {
+			if desc {
+				return c > 0
+			}
+			return c < 0
+		}
+		if entries[i].namespace != entries[j].namespace {
+			return entries[i].namespace < entries[j].namespace
+		}
+		return entries[i].name < entries[j].name
+	})
+
+	start := min(offset, len(entries))
+	end := len(entries)
+	if opts.Limit > 0 && start+opts.Limit < end {
+		end = start + opts.Limit
+	}
+	items := make([]T, 0, end-start)
+	for _, entry := range entries[start:end] {
+		items = append(items, entry.item)
+	}
+
+	result := &ListResult[T]{
+		Items:      items,
+		TotalCount: ptr.To(int64(len(entries))),
+		SortKey:    opts.Sort,
+	}
+	if end < len(entries) {
+		result.NextCursor = encodeSortCursor(sortCursor{Sort: opts.Sort, Offset: end})
+		result.RemainingCount = ptr.To(int64(len(entries) - end))
+	}
+	return result.WithFilter("labelSelector", opts.LabelSelector), nil
+}
+
+// parseSort splits a sort key into its field and direction.
+func parseSort(s string) (field string, desc bool, err error) {
+	field, desc = strings.CutPrefix(s, "-")
+	switch field {
+	case SortByName, SortByCreationTimestamp, SortByStatus:
+		return field, desc, nil
+	}
+	return "", false, &ValidationError{Msg: fmt.Sprintf(
+		"invalid sort %q: must be one of %s, %s or %s, optionally prefixed with '-' for descending order",
+		s, SortByName, SortByCreationTimestamp, SortByStatus)}
+}
+
+func newSortEntry[T any](item *T, field string) (sortEntry[T], error) {
+	obj, ok := any(item).(metav1.Object)
+	if !ok {
+		return sortEntry[T]{}, fmt.Errorf("sorting is not supported for %T", *item)
+	}
+	entry := sortEntry[T]{
+		item:      *item,
+		namespace: obj.GetNamespace(),
+		name:      obj.GetName(),
+		created:   obj.GetCreationTimestamp().Time,
+	}
+	if field == SortByStatus {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(item)
+		if err != nil {
+			return sortEntry[T]{}, fmt.Errorf("failed to read status of %s: %w", entry.name, err)
+		}
+		entry.status = statusSortValue(content)
+	}
+	return entry, nil
+}
+
+func compareSortEntries[T any](a, b sortEntry[T], field string) int {
+	switch field {
+	case SortByCreationTimestamp:
+		return a.created.Compare(b.created)
+	case SortByStatus:
+		return strings.Compare(a.status, b.status)
+	default:
+		return strings.Compare(a.name, b.name)
+	}
+}
+
+// statusSortValue returns the value a resource is ordered by for the "status"
+// sort key.
+func statusSortValue(content map[string]any) string {
+	if phase, _, _ := unstructured.NestedString(content, "status", "phase"); phase != "" {
+		return phase
+	}
+	conditions, _, _ := unstructured.NestedSlice(content, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]any)
+		if !ok || condition["type"] != "Ready" {
+			continue
+		}
+		if reason, _ := condition["reason"].(string); reason != "" {
+			return reason
+		}
+		status, _ := condition["status"].(string)
+		return status
+	}
+	return ""
+}
+
+func encodeSortCursor(c sortCursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeSortCursor(s string) (sortCursor, error) {
+	var c sortCursor
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, fmt.Errorf("invalid cursor: %w", err)
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, fmt.Errorf("invalid cursor: %w", err)
+	}
+	return c, nil
+}
@@ -0,0 +1,200 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func testNamespace(name string, created int, phase corev1.NamespacePhase) corev1.Namespace {
+	return corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(time.Unix(int64(created), 0)),
+		},
+		Status: corev1.NamespaceStatus{Phase: phase},
+	}
+}
+
+// pagedNamespaces serves items in fixed-size pages using the item index as the
+// cursor, and records the options of each call.
+func pagedNamespaces(items []corev1.Namespace, pageSize int, calls *[]ListOptions) ListResource[corev1.Namespace] {
+	return func(_ context.Context, opts ListOptions) (*ListResult[corev1.Namespace], error) {
+		*calls = append(*calls, opts)
+		start := 0
+		if opts.Cursor != "" {
+			start, _ = strconv.Atoi(opts.Cursor)
+		}
+		end := min(start+pageSize, len(items))
+		result := &ListResult[corev1.Namespace]{Items: items[start:end]}
+		if end < len(items) {
+			result.NextCursor = strconv.Itoa(end)
+			result.RemainingCount = ptr.To(int64(len(items) - end))
+		}
+		return result, nil
+	}
+}
+
+func namespaceNames(items []corev1.Namespace) []string {
+	names := make([]string, len(items))
+	for i := range items {
+		names[i] = items[i].Name
+	}
+	return names
+}
+
+func TestSortedList_Unsorted(t *testing.T) {
+	items := []corev1.Namespace{
+		testNamespace("a", 3, corev1.NamespaceActive),
+		testNamespace("b", 2, corev1.NamespaceActive),
+		testNamespace("c", 1, corev1.NamespaceActive),
+	}
+
+	t.Run("passes options through and counts the first page", func(t *testing.T) {
+		var calls []ListOptions
+		opts := ListOptions{Limit: 2, LabelSelector: "app=web"}
+		result, err := SortedList(context.Background(), opts, pagedNamespaces(items, 2, &calls))
+		require.NoError(t, err)
+		assert.Equal(t, []ListOptions{opts}, calls)
+		assert.Equal(t, []string{"a", "b"}, namespaceNames(result.Items))
+		assert.Equal(t, ptr.To(int64(3)), result.TotalCount)
+		assert.Empty(t, result.SortKey)
+		assert.Equal(t, map[string]string{"labelSelector": "app=web"}, result.AppliedFilters)
+	})
+
+	t.Run("single page", func(t *testing.T) {
+		var calls []ListOptions
+		result, err := SortedList(context.Background(), ListOptions{Limit: 10}, pagedNamespaces(items, 10, &calls))
+		require.NoError(t, err)
+		assert.Equal(t, ptr.To(int64(3)), result.TotalCount)
+		assert.Nil(t, result.AppliedFilters)
+	})
+
+	t.Run("no total count after the first page", func(t *testing.T) {
+		var calls []ListOptions
+		result, err := SortedList(context.Background(), ListOptions{Limit: 2, Cursor: "2"}, pagedNamespaces(items, 2, &calls))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"c"}, namespaceNames(result.Items))
+		assert.Nil(t, result.TotalCount)
+	})
+}
+
+func TestSortedList_Sorted(t *testing.T) {
+	items := []corev1.Namespace{
+		testNamespace("alpha", 30, corev1.NamespaceActive),
+		testNamespace("bravo", 10, corev1.NamespaceTerminating),
+		testNamespace("charlie", 20, corev1.NamespaceActive),
+		testNamespace("delta", 20, ""),
+		testNamespace("echo", 50, corev1.NamespaceTerminating),
+	}
+
+	tests := []struct {
+		sort string
+		want []string
+	}{
+		{sort: "name", want: []string{"alpha", "bravo", "charlie", "delta", "echo"}},
+		{sort: "-name", want: []string{"echo", "delta", "charlie", "bravo", "alpha"}},
+		{sort: "creationTimestamp", want: []string{"bravo", "charlie", "delta", "alpha", "echo"}},
+		{sort: "-creationTimestamp", want: []string{"echo", "alpha", "charlie", "delta", "bravo"}},
+		{sort: "status", want: []string{"delta", "alpha", "charlie", "bravo", "echo"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sort, func(t *testing.T) {
+			var calls []ListOptions
+			list := pagedNamespaces(items, 2, &calls)
+			opts := ListOptions{Limit: 2, Sort: tt.sort, LabelSelector: "team=a"}
+
+			var got []string
+			for page := 0; ; page++ {
+				require.Less(t, page, 5, "too many pages")
+				result, err := SortedList(context.Background(), opts, list)
+				require.NoError(t, err)
+				assert.Equal(t, ptr.To(int64(len(items))), result.TotalCount)
+				assert.Equal(t, tt.sort, result.SortKey)
+				assert.Equal(t, map[string]string{"labelSelector": "team=a"}, result.AppliedFilters)
+				got = append(got, namespaceNames(result.Items)...)
+				if result.NextCursor == "" {
+					assert.Nil(t, result.RemainingCount)
+					break
+				}
+				assert.Equal(t, ptr.To(int64(len(items)-len(got))), result.RemainingCount)
+				opts.Cursor = result.NextCursor
+			}
+			assert.Equal(t, tt.want, got)
+
+			// Each page lists every item without the caller's limit, cursor or sort.
+			for _, call := range calls {
+				assert.Zero(t, call.Limit)
+				assert.Empty(t, call.Sort)
+				assert.Equal(t, "team=a", call.LabelSelector)
+			}
+		})
+	}
+}
+
+func TestSortedList_InvalidRequests(t *testing.T) {
+	var calls []ListOptions
+	list := pagedNamespaces([]corev1.Namespace{testNamespace("a", 1, "")}, 10, &calls)
+
+	_, err := SortedList(context.Background(), ListOptions{Sort: "size"}, list)
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Contains(t, validationErr.Msg, `invalid sort "size"`)
+
+	_, err = SortedList(context.Background(), ListOptions{Sort: "name", Cursor: "%%%"}, list)
+	require.ErrorAs(t, err, &validationErr)
+
+	cursor := encodeSortCursor(sortCursor{Sort: "-name", Offset: 1})
+	_, err = SortedList(context.Background(), ListOptions{Sort: "name", Cursor: cursor}, list)
+	require.ErrorAs(t, err, &validationErr)
+	assert.Empty(t, calls)
+}
+
+func TestStatusSortValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		content map[string]any
+		want    string
+	}{
+		{
+			name:    "phase",
+			content: map[string]any{"status": map[string]any{"phase": "Running"}},
+			want:    "Running",
+		},
+		{
+			name: "ready condition reason",
+			content: map[string]any{"status": map[string]any{"conditions": []any{
+				map[string]any{"type": "Synced", "status": "True", "reason": "Synced"},
+				map[string]any{"type": "Ready", "status": "False", "reason": "DeploymentFailed"},
+			}}},
+			want: "DeploymentFailed",
+		},
+		{
+			name: "ready condition without reason",
+			content: map[string]any{"status": map[string]any{"conditions": []any{
+				map[string]any{"type": "Ready", "status": "True"},
+			}}},
+			want: "True",
+		},
+		{
+			name:    "no status",
+			content: map[string]any{},
+			want:    "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, statusSortValue(tt.content))
+		})
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"context"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+// SubjectID returns the ID of the authenticated caller, or an empty string when unknown.
+func SubjectID(ctx context.Context) string {
+	if subject, ok := auth.GetSubjectContextFromContext(ctx); ok && subject != nil {
+		return subject.ID
+	}
+	return ""
+}
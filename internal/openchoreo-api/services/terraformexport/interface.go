@@ -0,0 +1,43 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package terraformexport
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ResourceRef identifies a resource of an export by kind and name.
+type ResourceRef struct {
+	Kind string
+	Name string
+}
+
+// Resource is a resource of the platform configuration of a namespace.
+type Resource struct {
+	ResourceRef
+	// Object is the manifest of the resource, without the fields set by the
+	// API server.
+	Object *unstructured.Unstructured
+	// DependsOn are the resources of the namespace the resource refers to,
+	// which must be applied before it.
+	DependsOn []ResourceRef
+}
+
+// Export is the platform configuration of a namespace: the namespace itself
+// and its data planes, environments, deployment pipelines and projects, in
+// the order they are applied.
+type Export struct {
+	Namespace string
+	Resources []Resource
+}
+
+// Service defines the Terraform export service interface.
+// Both the core service (no authz) and the authz-wrapped service implement this.
+type Service interface {
+	// ExportNamespace returns the platform configuration of a namespace.
+	// Render turns it into Terraform configuration.
+	ExportNamespace(ctx context.Context, namespaceName string) (*Export, error)
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	terraformexport "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/terraformexport"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// ExportNamespace provides a mock function with given fields: ctx, namespaceName
+func (_m *MockService) ExportNamespace(ctx context.Context, namespaceName string) (*terraformexport.Export, error) {
+	ret := _m.Called(ctx, namespaceName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportNamespace")
+	}
+
+	var r0 *terraformexport.Export
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*terraformexport.Export, error)); ok {
+		return rf(ctx, namespaceName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *terraformexport.Export); ok {
+		r0 = rf(ctx, namespaceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*terraformexport.Export)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespaceName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ExportNamespace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportNamespace'
+type MockService_ExportNamespace_Call struct {
+	*mock.Call
+}
+
+// ExportNamespace is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+func (_e *MockService_Expecter) ExportNamespace(ctx interface{}, namespaceName interface{}) *MockService_ExportNamespace_Call {
+	return &MockService_ExportNamespace_Call{Call: _e.mock.On("ExportNamespace", ctx, namespaceName)}
+}
+
+func (_c *MockService_ExportNamespace_Call) Run(run func(ctx context.Context, namespaceName string)) *MockService_ExportNamespace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_ExportNamespace_Call) Return(_a0 *terraformexport.Export, _a1 error) *MockService_ExportNamespace_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ExportNamespace_Call) RunAndReturn(run func(context.Context, string) (*terraformexport.Export, error)) *MockService_ExportNamespace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
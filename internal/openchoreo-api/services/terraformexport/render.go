@@ -0,0 +1,127 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package terraformexport
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"sigs.k8s.io/yaml"
+)
+
+// providerVersion is the oldest hashicorp/kubernetes provider version with
+// the kubernetes_manifest resource.
+const providerVersion = ">= 2.7.0"
+
+var invalidIdentifierChars = regexp.MustCompile(`[^A-Za-z0-9_-]`)
+
+// FileName returns the conventional name of the rendered configuration,
+// <namespace>.tf.
+func (e *Export) FileName() string {
+	return e.Namespace + ".tf"
+}
+
+// Render returns the export as Terraform configuration, with a
+// kubernetes_manifest resource of the hashicorp/kubernetes provider for each
+// resource. Manifests are embedded as YAML, so that they read the same as
+// the resources applied with kubectl. Dependencies on resources that are not
+// part of the export are left out. The configuration works with Terraform
+// and OpenTofu alike.
+func (e *Export) Render() ([]byte, error) {
+	addresses := make(map[ResourceRef]string, len(e.Resources))
+	taken := map[string]bool{}
+	for _, r := range e.Resources {
+		base := resourceName(r.ResourceRef)
+		name := base
+		for i := 2; taken[name]; i++ {
+			name = fmt.Sprintf("%s_%d", base, i)
+		}
+		taken[name] = true
+		addresses[r.ResourceRef] = name
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# Platform configuration of the OpenChoreo namespace %q.\n", e.Namespace)
+	buf.WriteString("# Configure the kubernetes provider for the control plane cluster to apply it.\n\n")
+	buf.WriteString("terraform {\n")
+	buf.WriteString("  required_providers {\n")
+	buf.WriteString("    kubernetes = {\n")
+	buf.WriteString("      source  = \"hashicorp/kubernetes\"\n")
+	fmt.Fprintf(&buf, "      version = %q\n", providerVersion)
+	buf.WriteString("    }\n")
+	buf.WriteString("  }\n")
+	buf.WriteString("}\n")
+
+	for _, r := range e.Resources {
+		manifest, err := yaml.Marshal(r.Object.Object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal %s %q: %w", r.Kind, r.Name, err)
+		}
+		marker := heredocMarker(manifest)
+
+		fmt.Fprintf(&buf, "\nresource \"kubernetes_manifest\" %q {\n", addresses[r.ResourceRef])
+		fmt.Fprintf(&buf, "  manifest = yamldecode(<<-%s\n", marker)
+		for _, line := range strings.Split(strings.TrimSuffix(string(manifest), "\n"), "\n") {
+			if line == "" {
+				buf.WriteString("\n")
+				continue
+			}
+			buf.WriteString("    " + escapeTemplate(line) + "\n")
+		}
+		fmt.Fprintf(&buf, "  %s\n", marker)
+		buf.WriteString("  )\n")
+
+		var deps []string
+		for _, dep := range r.DependsOn {
+			if address, ok := addresses[dep]; ok {
+				deps = append(deps, "kubernetes_manifest."+address)
+			}
+		}
+		if len(deps) > 0 {
+			fmt.Fprintf(&buf, "\n  depends_on = [%s]\n", strings.Join(deps, ", "))
+		}
+		buf.WriteString("}\n")
+	}
+	return buf.Bytes(), nil
+}
+
+// resourceName returns the Terraform name of a resource, its kind in snake
+// case followed by its name, such as environment_dev.
+func resourceName(ref ResourceRef) string {
+	var kind strings.Builder
+	for i, r := range ref.Kind {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				kind.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		kind.WriteRune(r)
+	}
+	return kind.String() + "_" + invalidIdentifierChars.ReplaceAllString(ref.Name, "_")
+}
+
+// heredocMarker returns a heredoc delimiter that does not occur as a line of
+// the manifest.
+func heredocMarker(manifest []byte) string {
+	lines := map[string]bool{}
+	for _, line := range strings.Split(string(manifest), "\n") {
+		lines[strings.TrimSpace(line)] = true
+	}
+	marker := "EOT"
+	for i := 2; lines[marker]; i++ {
+		marker = fmt.Sprintf("EOT%d", i)
+	}
+	return marker
+}
+
+// escapeTemplate escapes the template sequences of a heredoc line, so that
+// values such as ${VAR} are kept as they are.
+func escapeTemplate(line string) string {
+	line = strings.ReplaceAll(line, "${", "$${")
+	return strings.ReplaceAll(line, "%{", "%%{")
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package terraformexport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestRender(t *testing.T) {
+	nsRef := ResourceRef{Kind: "Namespace", Name: "acme"}
+	export := &Export{
+		Namespace: "acme",
+		Resources: []Resource{
+			{
+				ResourceRef: nsRef,
+				Object: &unstructured.Unstructured{Object: map[string]any{
+					"apiVersion": "v1",
+					"kind":       "Namespace",
+					"metadata":   map[string]any{"name": "acme"},
+				}},
+			},
+			{
+				ResourceRef: ResourceRef{Kind: "DeploymentPipeline", Name: "default.v2"},
+				Object: &unstructured.Unstructured{Object: map[string]any{
+					"apiVersion": "openchoreo.dev/v1alpha1",
+					"kind":       "DeploymentPipeline",
+					"metadata":   map[string]any{"name": "default.v2", "namespace": "acme"},
+					"spec":       map[string]any{"note": "uses ${HOME} and %{x}\n\nEOT"},
+				}},
+				DependsOn: []ResourceRef{nsRef, {Kind: "Environment", Name: "hidden"}},
+			},
+		},
+	}
+
+	out, err := export.Render()
+	require.NoError(t, err)
+	assert.Equal(t, `# Platform configuration of the OpenChoreo namespace "acme".
+# Configure the kubernetes provider for the control plane cluster to apply it.
+
+terraform {
+  required_providers {
+    kubernetes = {
+      source  = "hashicorp/kubernetes"
+      version = ">= 2.7.0"
+    }
+  }
+}
+
+resource "kubernetes_manifest" "namespace_acme" {
+  manifest = yamldecode(<<-EOT
+    apiVersion: v1
+    kind: Namespace
+    metadata:
+      name: acme
+  EOT
+  )
+}
+
+resource "kubernetes_manifest" "deployment_pipeline_default_v2" {
+  manifest = yamldecode(<<-EOT2
+    apiVersion: openchoreo.dev/v1alpha1
+    kind: DeploymentPipeline
+    metadata:
+      name: default.v2
+      namespace: acme
+    spec:
+      note: |-
+        uses $${HOME} and %%{x}
+
+        EOT
+  EOT2
+  )
+
+  depends_on = [kubernetes_manifest.namespace_acme]
+}
+`, string(out))
+	assert.Equal(t, "acme.tf", export.FileName())
+}
+
+func TestResourceName_Collisions(t *testing.T) {
+	export := &Export{Resources: []Resource{
+		{ResourceRef: ResourceRef{Kind: "Project", Name: "a.b"}, Object: &unstructured.Unstructured{Object: map[string]any{}}},
+		{ResourceRef: ResourceRef{Kind: "Project", Name: "a_b"}, Object: &unstructured.Unstructured{Object: map[string]any{}}},
+	}}
+
+	out, err := export.Render()
+	require.NoError(t, err)
+	assert.Contains(t, string(out), `resource "kubernetes_manifest" "project_a_b" {`)
+	assert.Contains(t, string(out), `resource "kubernetes_manifest" "project_a_b_2" {`)
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package terraformexport exports the platform configuration of a control
+// plane namespace as Terraform configuration, so that it can be managed with
+// Terraform or OpenTofu alongside other infrastructure.
+package terraformexport
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/gitopsexport"
+	namespacesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/namespace"
+)
+
+const (
+	kindNamespace          = "Namespace"
+	kindDataPlane          = "DataPlane"
+	kindEnvironment        = "Environment"
+	kindDeploymentPipeline = "DeploymentPipeline"
+	kindProject            = "Project"
+
+	// defaultDataPlaneName is the data plane of environments without a data
+	// plane reference.
+	defaultDataPlaneName = "default"
+)
+
+// terraformExportService handles Terraform exports without authorization checks.
+type terraformExportService struct {
+	k8sClient        client.Client
+	namespaceService namespacesvc.Service
+	logger           *slog.Logger
+}
+
+var _ Service = (*terraformExportService)(nil)
+
+// NewService creates a new Terraform export service without authorization.
+func NewService(k8sClient client.Client, logger *slog.Logger) Service {
+	return &terraformExportService{
+		k8sClient:        k8sClient,
+		namespaceService: namespacesvc.NewService(k8sClient, logger.With("component", "namespace-service-internal")),
+		logger:           logger,
+	}
+}
+
+func (s *terraformExportService) ExportNamespace(ctx context.Context, namespaceName string) (*Export, error) {
+	s.logger.Debug("Exporting namespace as Terraform configuration", "namespace", namespaceName)
+
+	ns, err := s.namespaceService.GetNamespace(ctx, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+	nsRef := ResourceRef{Kind: kindNamespace, Name: namespaceName}
+	export := &Export{Namespace: namespaceName}
+	if err := export.add(ns, "v1", nsRef); err != nil {
+		return nil, err
+	}
+	inNamespace := client.InNamespace(namespaceName)
+
+	var dataPlanes openchoreov1alpha1.DataPlaneList
+	if err := s.k8sClient.List(ctx, &dataPlanes, inNamespace); err != nil {
+		s.logger.Error("Failed to list data planes", "error", err)
+		return nil, fmt.Errorf("failed to list data planes: %w", err)
+	}
+	sort.Slice(dataPlanes.Items, func(i, j int) bool { return dataPlanes.Items[i].Name < dataPlanes.Items[j].Name })
+	for i := range dataPlanes.Items {
+		dp := &dataPlanes.Items[i]
+		if err := export.add(dp, openchoreov1alpha1.GroupVersion.String(), ResourceRef{Kind: kindDataPlane, Name: dp.Name}, nsRef); err != nil {
+			return nil, err
+		}
+	}
+
+	var environments openchoreov1alpha1.EnvironmentList
+	if err := s.k8sClient.List(ctx, &environments, inNamespace); err != nil {
+		s.logger.Error("Failed to list environments", "error", err)
+		return nil, fmt.Errorf("failed to list environments: %w", err)
+	}
+	sort.Slice(environments.Items, func(i, j int) bool { return environments.Items[i].Name < environments.Items[j].Name })
+	for i := range environments.Items {
+		env := &environments.Items[i]
+		deps := []ResourceRef{nsRef}
+		switch ref := env.Spec.DataPlaneRef; {
+		case ref == nil:
+			deps = append(deps, ResourceRef{Kind: kindDataPlane, Name: defaultDataPlaneName})
+		case ref.Kind == openchoreov1alpha1.DataPlaneRefKindDataPlane:
+			deps = append(deps, ResourceRef{Kind: kindDataPlane, Name: ref.Name})
+		}
+		if err := export.add(env, openchoreov1alpha1.GroupVersion.String(), ResourceRef{Kind: kindEnvironment, Name: env.Name}, deps...); err != nil {
+			return nil, err
+		}
+	}
+
+	var pipelines openchoreov1alpha1.DeploymentPipelineList
+	if err := s.k8sClient.List(ctx, &pipelines, inNamespace); err != nil {
+		s.logger.Error("Failed to list deployment pipelines", "error", err)
+		return nil, fmt.Errorf("failed to list deployment pipelines: %w", err)
+	}
+	sort.Slice(pipelines.Items, func(i, j int) bool { return pipelines.Items[i].Name < pipelines.Items[j].Name })
+	for i := range pipelines.Items {
+		pipeline := &pipelines.Items[i]
+		var envNames []string
+		for _, path := range pipeline.Spec.PromotionPaths {
+			envNames = append(envNames, path.SourceEnvironmentRef.Name)
+			for _, target := range path.TargetEnvironmentRefs {
+				envNames = append(envNames, target.Name)
+			}
+		}
+		slices.Sort(envNames)
+		deps := []ResourceRef{nsRef}
+		for _, name := range slices.Compact(envNames) {
+			deps = append(deps, ResourceRef{Kind: kindEnvironment, Name: name})
+		}
+		if err := export.add(pipeline, openchoreov1alpha1.GroupVersion.String(), ResourceRef{Kind: kindDeploymentPipeline, Name: pipeline.Name}, deps...); err != nil {
+			return nil, err
+		}
+	}
+
+	var projects openchoreov1alpha1.ProjectList
+	if err := s.k8sClient.List(ctx, &projects, inNamespace); err != nil {
+		s.logger.Error("Failed to list projects", "error", err)
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	sort.Slice(projects.Items, func(i, j int) bool { return projects.Items[i].Name < projects.Items[j].Name })
+	for i := range projects.Items {
+		project := &projects.Items[i]
+		deps := []ResourceRef{nsRef}
+		if name := project.Spec.DeploymentPipelineRef.Name; name != "" {
+			deps = append(deps, ResourceRef{Kind: kindDeploymentPipeline, Name: name})
+		}
+		if err := export.add(project, openchoreov1alpha1.GroupVersion.String(), ResourceRef{Kind: kindProject, Name: project.Name}, deps...); err != nil {
+			return nil, err
+		}
+	}
+
+	return export, nil
+}
+
+// add appends obj to the export, unless it is being deleted.
+func (e *Export) add(obj client.Object, apiVersion string, ref ResourceRef, dependsOn ...ResourceRef) error {
+	if obj.GetDeletionTimestamp() != nil {
+		return nil
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return fmt.Errorf("failed to convert %s %q: %w", ref.Kind, ref.Name, err)
+	}
+	u := &unstructured.Unstructured{Object: content}
+	u.SetAPIVersion(apiVersion)
+	u.SetKind(ref.Kind)
+	gitopsexport.Clean(u)
+	e.Resources = append(e.Resources, Resource{ResourceRef: ref, Object: u, DependsOn: dependsOn})
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package terraformexport
+
+import (
+	"context"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+const (
+	resourceTypeNamespace          = "namespace"
+	resourceTypeDataPlane          = "dataPlane"
+	resourceTypeEnvironment        = "environment"
+	resourceTypeDeploymentPipeline = "deploymentPipeline"
+	resourceTypeProject            = "project"
+)
+
+// terraformExportServiceWithAuthz wraps a Service and adds authorization checks.
+// Handlers should use this. Other services should use the unwrapped Service directly.
+type terraformExportServiceWithAuthz struct {
+	internal Service
+	authz    *services.AuthzChecker
+}
+
+var _ Service = (*terraformExportServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates a Terraform export service with authorization checks.
+func NewServiceWithAuthz(k8sClient client.Client, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &terraformExportServiceWithAuthz{
+		internal: NewService(k8sClient, logger),
+		authz:    services.NewAuthzChecker(authzPDP, logger),
+	}
+}
+
+// ExportNamespace requires permission to view the namespace. Resources of the
+// namespace the caller may not view are left out of the export.
+func (s *terraformExportServiceWithAuthz) ExportNamespace(ctx context.Context, namespaceName string) (*Export, error) {
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewNamespace,
+		ResourceType: resourceTypeNamespace,
+		ResourceID:   namespaceName,
+		Hierarchy:    authz.ResourceHierarchy{Namespace: namespaceName},
+	}); err != nil {
+		return nil, err
+	}
+
+	export, err := s.internal.ExportNamespace(ctx, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	var requests []services.CheckRequest
+	for _, r := range export.Resources {
+		if req, ok := checkRequest(namespaceName, r.ResourceRef); ok {
+			requests = append(requests, req)
+		}
+	}
+	decisions, err := s.authz.BatchCheck(ctx, requests)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := &Export{Namespace: export.Namespace}
+	next := 0
+	for _, r := range export.Resources {
+		if _, ok := checkRequest(namespaceName, r.ResourceRef); ok {
+			allowed := next < len(decisions) && decisions[next]
+			next++
+			if !allowed {
+				continue
+			}
+		}
+		filtered.Resources = append(filtered.Resources, r)
+	}
+	return filtered, nil
+}
+
+// checkRequest returns the check of permission to view a resource of the
+// export. It reports false for the namespace, which is checked separately.
+func checkRequest(namespaceName string, ref ResourceRef) (services.CheckRequest, bool) {
+	req := services.CheckRequest{
+		ResourceID: ref.Name,
+		Hierarchy:  authz.ResourceHierarchy{Namespace: namespaceName},
+	}
+	switch ref.Kind {
+	case kindDataPlane:
+		req.Action, req.ResourceType = authz.ActionViewDataPlane, resourceTypeDataPlane
+	case kindEnvironment:
+		req.Action, req.ResourceType = authz.ActionViewEnvironment, resourceTypeEnvironment
+	case kindDeploymentPipeline:
+		req.Action, req.ResourceType = authz.ActionViewDeploymentPipeline, resourceTypeDeploymentPipeline
+	case kindProject:
+		req.Action, req.ResourceType = authz.ActionViewProject, resourceTypeProject
+		req.Hierarchy.Project = ref.Name
+	default:
+		return services.CheckRequest{}, false
+	}
+	return req, true
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package terraformexport
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func newAuthzService(pdp authzcore.PDP) *terraformExportServiceWithAuthz {
+	return &terraformExportServiceWithAuthz{
+		internal: NewService(testutil.NewFakeClient(testObjects()...), testutil.TestLogger()),
+		authz:    testutil.NewTestAuthzChecker(pdp),
+	}
+}
+
+func TestExportNamespace_AuthzCheck(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		pdp := testutil.AllowPDP()
+		export, err := newAuthzService(pdp).ExportNamespace(testutil.AuthzContext(), testNamespace)
+		require.NoError(t, err)
+		assert.Len(t, export.Resources, 6)
+		require.NotEmpty(t, pdp.Captured)
+		testutil.RequireEvalRequest(t, pdp.Captured[0], "namespace:view", "namespace", testNamespace,
+			authzcore.ResourceHierarchy{Namespace: testNamespace})
+	})
+
+	t.Run("namespace denied", func(t *testing.T) {
+		_, err := newAuthzService(testutil.DenyPDP()).ExportNamespace(testutil.AuthzContext(), testNamespace)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+
+	t.Run("resources filtered", func(t *testing.T) {
+		pdp := &batchDenyPDP{CapturingPDP: *testutil.AllowPDP()}
+		export, err := newAuthzService(pdp).ExportNamespace(testutil.AuthzContext(), testNamespace)
+		require.NoError(t, err)
+		assert.Equal(t, []ResourceRef{{Kind: "Namespace", Name: testNamespace}}, refs(export))
+	})
+}
+
+// batchDenyPDP allows single checks and denies batch checks, so that the
+// namespace may be viewed but none of its resources.
+type batchDenyPDP struct {
+	testutil.CapturingPDP
+}
+
+func (p *batchDenyPDP) BatchEvaluate(_ context.Context, req *authzcore.BatchEvaluateRequest) (*authzcore.BatchEvaluateResponse, error) {
+	return &authzcore.BatchEvaluateResponse{Decisions: make([]authzcore.Decision, len(req.Requests))}, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package terraformexport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
+	namespacesvc "github.com/openchoreo/openchoreo/internal/openchoreo-api/services/namespace"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+const testNamespace = "test-ns"
+
+func testObjects() []client.Object {
+	prod := testutil.NewEnvironment(testNamespace, "prod")
+	prod.Spec.DataPlaneRef = &openchoreov1alpha1.DataPlaneRef{Kind: openchoreov1alpha1.DataPlaneRefKindClusterDataPlane, Name: "shared"}
+	return []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   testNamespace,
+			Labels: map[string]string{labels.LabelKeyControlPlaneNamespace: labels.LabelValueTrue},
+		}},
+		testutil.NewDataPlane(testNamespace, "default"),
+		testutil.NewEnvironment(testNamespace, "dev"),
+		prod,
+		testutil.NewDeploymentPipeline(testNamespace, "default"),
+		testutil.NewProject(testNamespace, "shop"),
+		testutil.NewProject("other-ns", "elsewhere"),
+	}
+}
+
+func refs(export *Export) []ResourceRef {
+	out := make([]ResourceRef, 0, len(export.Resources))
+	for _, r := range export.Resources {
+		out = append(out, r.ResourceRef)
+	}
+	return out
+}
+
+func TestExportNamespace(t *testing.T) {
+	svc := NewService(testutil.NewFakeClient(testObjects()...), testutil.TestLogger())
+
+	export, err := svc.ExportNamespace(t.Context(), testNamespace)
+	require.NoError(t, err)
+
+	nsRef := ResourceRef{Kind: "Namespace", Name: testNamespace}
+	assert.Equal(t, []ResourceRef{
+		nsRef,
+		{Kind: "DataPlane", Name: "default"},
+		{Kind: "Environment", Name: "dev"},
+		{Kind: "Environment", Name: "prod"},
+		{Kind: "DeploymentPipeline", Name: "default"},
+		{Kind: "Project", Name: "shop"},
+	}, refs(export))
+
+	deps := map[ResourceRef][]ResourceRef{}
+	for _, r := range export.Resources {
+		deps[r.ResourceRef] = r.DependsOn
+	}
+	assert.Empty(t, deps[nsRef])
+	assert.Equal(t, []ResourceRef{nsRef, {Kind: "DataPlane", Name: "default"}}, deps[ResourceRef{Kind: "Environment", Name: "dev"}],
+		"environments without a data plane reference use the default data plane")
+	assert.Equal(t, []ResourceRef{nsRef}, deps[ResourceRef{Kind: "Environment", Name: "prod"}],
+		"cluster data planes are not part of the export")
+	assert.Equal(t, []ResourceRef{nsRef, {Kind: "Environment", Name: "dev"}, {Kind: "Environment", Name: "prod"}},
+		deps[ResourceRef{Kind: "DeploymentPipeline", Name: "default"}])
+	assert.Equal(t, []ResourceRef{nsRef, {Kind: "DeploymentPipeline", Name: "default"}}, deps[ResourceRef{Kind: "Project", Name: "shop"}])
+
+	project := export.Resources[len(export.Resources)-1].Object
+	assert.Equal(t, "openchoreo.dev/v1alpha1", project.GetAPIVersion())
+	assert.Equal(t, "Project", project.GetKind())
+	assert.Empty(t, project.GetResourceVersion())
+	assert.NotContains(t, project.Object, "status")
+}
+
+func TestExportNamespace_NotFound(t *testing.T) {
+	svc := NewService(testutil.NewFakeClient(testutil.NewNamespace("plain")), testutil.TestLogger())
+
+	_, err := svc.ExportNamespace(t.Context(), "missing")
+	require.ErrorIs(t, err, namespacesvc.ErrNamespaceNotFound)
+
+	_, err = svc.ExportNamespace(t.Context(), "plain")
+	require.ErrorIs(t, err, namespacesvc.ErrNamespaceNotFound, "namespaces that are not control plane namespaces are not exported")
+}
@@ -56,12 +56,15 @@ func statusSubresourceObjects() []client.Object {
 		&openchoreov1alpha1.Component{},
 		&openchoreov1alpha1.ComponentRelease{},
 		&openchoreov1alpha1.ComponentType{},
+		&openchoreov1alpha1.Connection{},
+		&openchoreov1alpha1.CustomDomain{},
 		&openchoreov1alpha1.DataPlane{},
 		&openchoreov1alpha1.DeploymentPipeline{},
 		&openchoreov1alpha1.Environment{},
 		&openchoreov1alpha1.ObservabilityAlertsNotificationChannel{},
 		&openchoreov1alpha1.ObservabilityPlane{},
 		&openchoreov1alpha1.Project{},
+		&openchoreov1alpha1.PromotionRequest{},
 		&openchoreov1alpha1.ReleaseBinding{},
 		&openchoreov1alpha1.Resource{},
 		&openchoreov1alpha1.ResourceRelease{},
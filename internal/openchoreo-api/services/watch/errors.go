@@ -0,0 +1,12 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package watch
+
+import "errors"
+
+var (
+	ErrInvalidRequest = errors.New("invalid watch request")
+	ErrInvalidToken   = errors.New("invalid watch token")
+	ErrTokenExpired   = errors.New("watch token expired")
+)
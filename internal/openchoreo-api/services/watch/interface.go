@@ -0,0 +1,66 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package watch
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// EventType identifies the kind of change a watch Event reports.
+type EventType string
+
+const (
+	// EventAdded reports a resource that was created, or that existed when a
+	// watch without a token started.
+	EventAdded EventType = "ADDED"
+	// EventModified reports a change to a resource's spec, metadata or status.
+	EventModified EventType = "MODIFIED"
+	// EventDeleted reports a resource that was deleted.
+	EventDeleted EventType = "DELETED"
+	// EventBookmark carries only a token. It is sent periodically so that idle
+	// watches can be resumed without replaying events.
+	EventBookmark EventType = "BOOKMARK"
+	// EventError reports a failure that ended the watch.
+	EventError EventType = "ERROR"
+)
+
+// Request selects the resources to watch.
+type Request struct {
+	// Kinds are the resource kinds to watch (see SupportedKinds).
+	Kinds []string
+	// Namespaces are the namespaces to watch.
+	Namespaces []string
+	// Project, when set, limits project- and component-owned kinds to that project.
+	Project string
+	// Component, when set, limits component-owned kinds to that component.
+	// It requires Project.
+	Component string
+	// LabelSelector is a Kubernetes label selector applied to all kinds.
+	LabelSelector string
+	// Token resumes a previous watch right after the last event it delivered.
+	Token string
+}
+
+// Event is a change to a watched resource.
+type Event struct {
+	Type EventType
+	// Kind and Object are set for ADDED, MODIFIED and DELETED events.
+	Kind   string
+	Object client.Object
+	// Token resumes the watch after this event. Empty for ERROR events.
+	Token string
+	// Err is set for ERROR events.
+	Err error
+}
+
+// Service defines the watch service interface.
+// Both the core service (no authz) and the authz-wrapped service implement this.
+type Service interface {
+	// Watch starts watching the resources selected by req. Events are delivered
+	// on the returned channel, which is closed when ctx is done or after an
+	// ERROR event.
+	Watch(ctx context.Context, req *Request) (<-chan Event, error)
+}
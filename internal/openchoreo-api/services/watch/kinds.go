@@ -0,0 +1,154 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package watch
+
+import (
+	"sort"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+// kindInfo describes a watchable kind.
+type kindInfo struct {
+	newList func() client.ObjectList
+	// projectScoped and componentScoped tell whether the Project and Component
+	// filters apply to the kind.
+	projectScoped   bool
+	componentScoped bool
+	// checkRequest returns the authz check for viewing obj.
+	checkRequest func(namespace string, obj client.Object) services.CheckRequest
+}
+
+var kinds = map[string]kindInfo{
+	"Project": {
+		newList:       func() client.ObjectList { return &openchoreov1alpha1.ProjectList{} },
+		projectScoped: true,
+		checkRequest: func(namespace string, obj client.Object) services.CheckRequest {
+			return services.CheckRequest{
+				Action:       authz.ActionViewProject,
+				ResourceType: "project",
+				ResourceID:   obj.GetName(),
+				Hierarchy:    authz.ResourceHierarchy{Namespace: namespace, Project: obj.GetName()},
+			}
+		},
+	},
+	"Component": {
+		newList:         func() client.ObjectList { return &openchoreov1alpha1.ComponentList{} },
+		projectScoped:   true,
+		componentScoped: true,
+		checkRequest: func(namespace string, obj client.Object) services.CheckRequest {
+			comp := obj.(*openchoreov1alpha1.Component)
+			return services.CheckRequest{
+				Action:       authz.ActionViewComponent,
+				ResourceType: "component",
+				ResourceID:   comp.Name,
+				Hierarchy: authz.ResourceHierarchy{
+					Namespace: namespace,
+					Project:   comp.Spec.Owner.ProjectName,
+					Component: comp.Name,
+				},
+			}
+		},
+	},
+	"ComponentRelease": {
+		newList:         func() client.ObjectList { return &openchoreov1alpha1.ComponentReleaseList{} },
+		projectScoped:   true,
+		componentScoped: true,
+		checkRequest: func(namespace string, obj client.Object) services.CheckRequest {
+			cr := obj.(*openchoreov1alpha1.ComponentRelease)
+			return services.CheckRequest{
+				Action:       authz.ActionViewComponentRelease,
+				ResourceType: "componentrelease",
+				ResourceID:   cr.Name,
+				Hierarchy: authz.ResourceHierarchy{
+					Namespace: namespace,
+					Project:   cr.Spec.Owner.ProjectName,
+					Component: cr.Spec.Owner.ComponentName,
+				},
+			}
+		},
+	},
+	"ReleaseBinding": {
+		newList:         func() client.ObjectList { return &openchoreov1alpha1.ReleaseBindingList{} },
+		projectScoped:   true,
+		componentScoped: true,
+		checkRequest: func(namespace string, obj client.Object) services.CheckRequest {
+			rb := obj.(*openchoreov1alpha1.ReleaseBinding)
+			return services.CheckRequest{
+				Action:       authz.ActionViewReleaseBinding,
+				ResourceType: "releasebinding",
+				ResourceID:   rb.Name,
+				Hierarchy: authz.ResourceHierarchy{
+					Namespace: namespace,
+					Project:   rb.Spec.Owner.ProjectName,
+					Component: rb.Spec.Owner.ComponentName,
+				},
+				Context: authz.Context{
+					Resource: authz.ResourceAttribute{
+						Environment: services.FormatDualScopedResourceName(namespace, rb.Spec.Environment, false),
+					},
+				},
+			}
+		},
+	},
+	"WorkflowRun": {
+		newList:         func() client.ObjectList { return &openchoreov1alpha1.WorkflowRunList{} },
+		projectScoped:   true,
+		componentScoped: true,
+		checkRequest: func(namespace string, obj client.Object) services.CheckRequest {
+			// Component workflow runs are labeled with their owner; other runs are namespace-scoped.
+			hierarchy := authz.ResourceHierarchy{Namespace: namespace}
+			project := obj.GetLabels()[ocLabels.LabelKeyProjectName]
+			component := obj.GetLabels()[ocLabels.LabelKeyComponentName]
+			if project != "" && component != "" {
+				hierarchy.Project = project
+				hierarchy.Component = component
+			}
+			return services.CheckRequest{
+				Action:       authz.ActionViewWorkflowRun,
+				ResourceType: "workflowrun",
+				ResourceID:   obj.GetName(),
+				Hierarchy:    hierarchy,
+			}
+		},
+	},
+	"Environment": {
+		newList: func() client.ObjectList { return &openchoreov1alpha1.EnvironmentList{} },
+		checkRequest: func(namespace string, obj client.Object) services.CheckRequest {
+			return services.CheckRequest{
+				Action:       authz.ActionViewEnvironment,
+				ResourceType: "environment",
+				ResourceID:   obj.GetName(),
+				Hierarchy:    authz.ResourceHierarchy{Namespace: namespace},
+			}
+		},
+	},
+}
+
+// SupportedKinds returns the kinds that can be watched, sorted by name.
+func SupportedKinds() []string {
+	names := make([]string, 0, len(kinds))
+	for name := range kinds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// matches reports whether obj of kind ki passes the project and component filters of req.
+func (ki kindInfo) matches(req *Request, obj client.Object) bool {
+	if req.Project == "" || !ki.projectScoped {
+		return true
+	}
+	h := ki.checkRequest(obj.GetNamespace(), obj).Hierarchy
+	if h.Project != req.Project {
+		return false
+	}
+	return req.Component == "" || !ki.componentScoped || h.Component == req.Component
+}
@@ -0,0 +1,286 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package watch
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	k8swatch "k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// defaultBookmarkInterval is how often a BOOKMARK event is sent.
+const defaultBookmarkInterval = 30 * time.Second
+
+// watchService handles watch business logic without authorization checks.
+type watchService struct {
+	k8sClient        client.WithWatch
+	logger           *slog.Logger
+	bookmarkInterval time.Duration
+}
+
+var _ Service = (*watchService)(nil)
+
+// NewService creates a new watch service without authorization.
+func NewService(k8sClient client.WithWatch, logger *slog.Logger) Service {
+	return &watchService{
+		k8sClient:        k8sClient,
+		logger:           logger,
+		bookmarkInterval: defaultBookmarkInterval,
+	}
+}
+
+// stream is the watch of one kind in one namespace.
+type stream struct {
+	key       string
+	kind      string
+	info      kindInfo
+	namespace string
+	// resourceVersion is the last resource version seen, owned by the stream goroutine.
+	resourceVersion string
+}
+
+// streamUpdate is sent by a stream goroutine for every watch event. obj is nil
+// when the event only advances the resource version.
+type streamUpdate struct {
+	key             string
+	resourceVersion string
+	eventType       EventType
+	kind            string
+	obj             client.Object
+	err             error
+}
+
+func (s *watchService) Watch(ctx context.Context, req *Request) (<-chan Event, error) {
+	if len(req.Kinds) == 0 {
+		return nil, fmt.Errorf("%w: at least one kind is required", ErrInvalidRequest)
+	}
+	if len(req.Namespaces) == 0 {
+		return nil, fmt.Errorf("%w: at least one namespace is required", ErrInvalidRequest)
+	}
+	if req.Component != "" && req.Project == "" {
+		return nil, fmt.Errorf("%w: component filter requires project filter", ErrInvalidRequest)
+	}
+	selector := labels.Everything()
+	if req.LabelSelector != "" {
+		var err error
+		if selector, err = labels.Parse(req.LabelSelector); err != nil {
+			return nil, fmt.Errorf("%w: invalid label selector: %w", ErrInvalidRequest, err)
+		}
+	}
+	tokens, err := decodeToken(req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	var streams []*stream
+	seen := make(map[string]bool)
+	for _, kind := range req.Kinds {
+		info, ok := kinds[kind]
+		if !ok {
+			return nil, fmt.Errorf("%w: unsupported kind %q", ErrInvalidRequest, kind)
+		}
+		for _, ns := range req.Namespaces {
+			key := kind + "/" + ns
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			streams = append(streams, &stream{
+				key: key, kind: kind, info: info, namespace: ns, resourceVersion: tokens[key],
+			})
+		}
+	}
+
+	// Start every watch before returning so that failures such as missing
+	// permissions are reported to the caller instead of on the channel.
+	ctx, cancel := context.WithCancel(ctx)
+	watchers := make([]k8swatch.Interface, len(streams))
+	for i, st := range streams {
+		w, err := s.startWatch(ctx, st, selector)
+		if err != nil {
+			cancel()
+			for _, started := range watchers[:i] {
+				started.Stop()
+			}
+			return nil, fmt.Errorf("failed to watch %s in namespace %s: %w", st.kind, st.namespace, err)
+		}
+		watchers[i] = w
+	}
+
+	updates := make(chan streamUpdate)
+	for i, st := range streams {
+		go s.runStream(ctx, req, st, selector, watchers[i], updates)
+	}
+
+	events := make(chan Event)
+	go func() {
+		defer cancel()
+		defer close(events)
+		s.pump(ctx, tokens, updates, events)
+	}()
+	return events, nil
+}
+
+// pump merges the stream updates into events, keeping the token current.
+func (s *watchService) pump(ctx context.Context, tokens map[string]string, updates <-chan streamUpdate, events chan<- Event) {
+	send := func(ev Event) bool {
+		select {
+		case events <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	bookmarks := time.NewTicker(s.bookmarkInterval)
+	defer bookmarks.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-bookmarks.C:
+			if !send(Event{Type: EventBookmark, Token: encodeToken(tokens)}) {
+				return
+			}
+		case u := <-updates:
+			if u.err != nil {
+				send(Event{Type: EventError, Err: u.err})
+				return
+			}
+			tokens[u.key] = u.resourceVersion
+			if u.obj != nil && !send(Event{Type: u.eventType, Kind: u.kind, Object: u.obj, Token: encodeToken(tokens)}) {
+				return
+			}
+		}
+	}
+}
+
+func (s *watchService) startWatch(ctx context.Context, st *stream, selector labels.Selector) (k8swatch.Interface, error) {
+	return s.k8sClient.Watch(ctx, st.info.newList(),
+		client.InNamespace(st.namespace),
+		client.MatchingLabelsSelector{Selector: selector},
+		&client.ListOptions{Raw: &metav1.ListOptions{
+			ResourceVersion:     st.resourceVersion,
+			AllowWatchBookmarks: true,
+		}},
+	)
+}
+
+// runStream forwards the events of one watch as updates. The API server ends
+// watches after a timeout, in which case the watch is restarted from the last
+// resource version seen.
+func (s *watchService) runStream(
+	ctx context.Context,
+	req *Request,
+	st *stream,
+	selector labels.Selector,
+	w k8swatch.Interface,
+	updates chan<- streamUpdate,
+) {
+	send := func(u streamUpdate) bool {
+		u.key = st.key
+		select {
+		case updates <- u:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	defer func() { w.Stop() }()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev, open := <-w.ResultChan():
+			if !open {
+				s.logger.Debug("Restarting watch", "kind", st.kind, "namespace", st.namespace, "resourceVersion", st.resourceVersion)
+				restarted, err := s.startWatch(ctx, st, selector)
+				if err != nil {
+					if ctx.Err() == nil {
+						send(streamUpdate{err: fmt.Errorf("failed to restart watch of %s in namespace %s: %w", st.kind, st.namespace, err)})
+					}
+					return
+				}
+				w.Stop()
+				w = restarted
+				continue
+			}
+			u, ok := s.toUpdate(req, st, ev)
+			if !ok {
+				continue
+			}
+			if !send(u) || u.err != nil {
+				return
+			}
+			st.resourceVersion = u.resourceVersion
+		}
+	}
+}
+
+// toUpdate converts a Kubernetes watch event. It returns false for events that
+// carry nothing to forward.
+func (s *watchService) toUpdate(req *Request, st *stream, ev k8swatch.Event) (streamUpdate, bool) {
+	if ev.Type == k8swatch.Error {
+		err := apierrors.FromObject(ev.Object)
+		if apierrors.IsResourceExpired(err) || apierrors.IsGone(err) {
+			return streamUpdate{err: fmt.Errorf("%w: %w", ErrTokenExpired, err)}, true
+		}
+		return streamUpdate{err: fmt.Errorf("watch of %s in namespace %s failed: %w", st.kind, st.namespace, err)}, true
+	}
+
+	obj, ok := ev.Object.(client.Object)
+	if !ok {
+		return streamUpdate{}, false
+	}
+	u := streamUpdate{resourceVersion: obj.GetResourceVersion()}
+	if ev.Type == k8swatch.Bookmark || !st.info.matches(req, obj) {
+		return u, true
+	}
+
+	switch ev.Type {
+	case k8swatch.Added:
+		u.eventType = EventAdded
+	case k8swatch.Modified:
+		u.eventType = EventModified
+	case k8swatch.Deleted:
+		u.eventType = EventDeleted
+	default:
+		return u, true
+	}
+	u.kind = st.kind
+	u.obj = obj
+	return u, true
+}
+
+// encodeToken encodes the last resource version seen for each kind and namespace.
+func encodeToken(tokens map[string]string) string {
+	data, _ := json.Marshal(tokens)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeToken decodes a token created by encodeToken. An empty token yields an empty map.
+func decodeToken(token string) (map[string]string, error) {
+	tokens := make(map[string]string)
+	if token == "" {
+		return tokens, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	if err := json.Unmarshal(data, &tokens); err != nil {
+		return nil, ErrInvalidToken
+	}
+	return tokens, nil
+}
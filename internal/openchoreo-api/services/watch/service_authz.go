@@ -0,0 +1,88 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package watch
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	authz "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+)
+
+const resourceTypeNamespace = "namespace"
+
+// watchServiceWithAuthz wraps a Service and adds authorization checks.
+// Handlers should use this. Other services should use the unwrapped Service directly.
+type watchServiceWithAuthz struct {
+	internal Service
+	authz    *services.AuthzChecker
+	logger   *slog.Logger
+}
+
+var _ Service = (*watchServiceWithAuthz)(nil)
+
+// NewServiceWithAuthz creates a watch service with authorization checks.
+func NewServiceWithAuthz(k8sClient client.WithWatch, authzPDP authz.PDP, logger *slog.Logger) Service {
+	return &watchServiceWithAuthz{
+		internal: NewService(k8sClient, logger),
+		authz:    services.NewAuthzChecker(authzPDP, logger),
+		logger:   logger,
+	}
+}
+
+// Watch requires view access to every watched namespace, and then only
+// delivers events for resources the caller is allowed to view.
+func (s *watchServiceWithAuthz) Watch(ctx context.Context, req *Request) (<-chan Event, error) {
+	for _, ns := range req.Namespaces {
+		if err := s.authz.Check(ctx, services.CheckRequest{
+			Action:       authz.ActionViewNamespace,
+			ResourceType: resourceTypeNamespace,
+			ResourceID:   ns,
+			Hierarchy:    authz.ResourceHierarchy{Namespace: ns},
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	in, err := s.internal.Watch(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan Event)
+	go func() {
+		defer close(out)
+		for ev := range in {
+			if ev.Object != nil && !s.canView(ctx, ev) {
+				continue
+			}
+			select {
+			case out <- ev:
+			case <-ctx.Done():
+				// Drain so the internal watch can observe ctx and exit.
+				for range in {
+				}
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+func (s *watchServiceWithAuthz) canView(ctx context.Context, ev Event) bool {
+	info, ok := kinds[ev.Kind]
+	if !ok {
+		return false
+	}
+	err := s.authz.Check(ctx, info.checkRequest(ev.Object.GetNamespace(), ev.Object))
+	if err != nil && !errors.Is(err, services.ErrForbidden) {
+		s.logger.Warn("Dropping watch event after failed authorization check",
+			"kind", ev.Kind, "namespace", ev.Object.GetNamespace(), "name", ev.Object.GetName(), "error", err)
+	}
+	return err == nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package watch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+// stubService returns a fixed event channel from Watch.
+type stubService struct {
+	events chan Event
+	called bool
+}
+
+func (s *stubService) Watch(_ context.Context, _ *Request) (<-chan Event, error) {
+	s.called = true
+	return s.events, nil
+}
+
+// denyComponentPDP allows every request except viewing the named component.
+type denyComponentPDP struct {
+	*testutil.CapturingPDP
+	component string
+}
+
+func (p *denyComponentPDP) Evaluate(ctx context.Context, req *authzcore.EvaluateRequest) (*authzcore.Decision, error) {
+	decision, err := p.CapturingPDP.Evaluate(ctx, req)
+	if err == nil && req.Resource.Type == "component" && req.Resource.ID == p.component {
+		decision.Decision = false
+	}
+	return decision, err
+}
+
+func TestWatchWithAuthz_RequiresNamespaceView(t *testing.T) {
+	pdp := testutil.DenyPDP()
+	internal := &stubService{}
+	svc := &watchServiceWithAuthz{internal: internal, authz: testutil.NewTestAuthzChecker(pdp), logger: testutil.TestLogger()}
+
+	_, err := svc.Watch(testutil.AuthzContext(), &Request{Kinds: []string{"Component"}, Namespaces: []string{"ns-1"}})
+	require.ErrorIs(t, err, services.ErrForbidden)
+	require.False(t, internal.called)
+	require.Len(t, pdp.Captured, 1)
+	testutil.RequireEvalRequest(t, pdp.Captured[0], "namespace:view", "namespace", "ns-1",
+		authzcore.ResourceHierarchy{Namespace: "ns-1"})
+}
+
+func TestWatchWithAuthz_FiltersEvents(t *testing.T) {
+	pdp := &denyComponentPDP{CapturingPDP: testutil.AllowPDP(), component: "hidden"}
+	internal := &stubService{events: make(chan Event, 3)}
+	internal.events <- Event{Type: EventAdded, Kind: "Component", Object: testComponent("ns-1", "proj-a", "hidden"), Token: "t1"}
+	internal.events <- Event{Type: EventAdded, Kind: "Component", Object: testComponent("ns-1", "proj-a", "visible"), Token: "t2"}
+	internal.events <- Event{Type: EventBookmark, Token: "t3"}
+	close(internal.events)
+	svc := &watchServiceWithAuthz{internal: internal, authz: testutil.NewTestAuthzChecker(pdp), logger: testutil.TestLogger()}
+
+	events, err := svc.Watch(testutil.AuthzContext(), &Request{Kinds: []string{"Component"}, Namespaces: []string{"ns-1"}})
+	require.NoError(t, err)
+
+	var got []Event
+	for ev := range events {
+		got = append(got, ev)
+	}
+	require.Len(t, got, 2)
+	require.Equal(t, "visible", got[0].Object.GetName())
+	require.Equal(t, "t2", got[0].Token)
+	require.Equal(t, EventBookmark, got[1].Type)
+
+	// One namespace check, then one check per resource event.
+	require.Len(t, pdp.Captured, 3)
+	testutil.RequireEvalRequest(t, pdp.Captured[2], "component:view", "component", "visible",
+		authzcore.ResourceHierarchy{Namespace: "ns-1", Project: "proj-a", Component: "visible"})
+}
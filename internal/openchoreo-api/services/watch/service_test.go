@@ -0,0 +1,176 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package watch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func newTestService(objects ...client.Object) (*watchService, client.WithWatch) {
+	k8sClient := fake.NewClientBuilder().WithScheme(testutil.NewScheme()).WithObjects(objects...).Build()
+	return &watchService{
+		k8sClient:        k8sClient,
+		logger:           testutil.TestLogger(),
+		bookmarkInterval: time.Hour,
+	}, k8sClient
+}
+
+func testComponent(namespace, project, name string) *openchoreov1alpha1.Component {
+	return &openchoreov1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: openchoreov1alpha1.ComponentSpec{
+			Owner:         openchoreov1alpha1.ComponentOwner{ProjectName: project},
+			ComponentType: openchoreov1alpha1.ComponentTypeRef{Name: "deployment/web-app"},
+		},
+	}
+}
+
+func nextEvent(t *testing.T, events <-chan Event) Event {
+	t.Helper()
+	select {
+	case ev, ok := <-events:
+		require.True(t, ok, "event channel closed")
+		return ev
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for watch event")
+		return Event{}
+	}
+}
+
+func TestWatch_InvalidRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     Request
+		wantErr error
+	}{
+		{
+			name:    "no kinds",
+			req:     Request{Namespaces: []string{"ns-1"}},
+			wantErr: ErrInvalidRequest,
+		},
+		{
+			name:    "no namespaces",
+			req:     Request{Kinds: []string{"Component"}},
+			wantErr: ErrInvalidRequest,
+		},
+		{
+			name:    "unsupported kind",
+			req:     Request{Kinds: []string{"Secret"}, Namespaces: []string{"ns-1"}},
+			wantErr: ErrInvalidRequest,
+		},
+		{
+			name:    "component without project",
+			req:     Request{Kinds: []string{"Component"}, Namespaces: []string{"ns-1"}, Component: "c"},
+			wantErr: ErrInvalidRequest,
+		},
+		{
+			name:    "invalid label selector",
+			req:     Request{Kinds: []string{"Component"}, Namespaces: []string{"ns-1"}, LabelSelector: "a=(b"},
+			wantErr: ErrInvalidRequest,
+		},
+		{
+			name:    "invalid token",
+			req:     Request{Kinds: []string{"Component"}, Namespaces: []string{"ns-1"}, Token: "%%%"},
+			wantErr: ErrInvalidToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _ := newTestService()
+			_, err := svc.Watch(context.Background(), &tt.req)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestWatch_DeliversFilteredEvents(t *testing.T) {
+	svc, k8sClient := newTestService()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := svc.Watch(ctx, &Request{
+		Kinds:      []string{"Component", "Component"},
+		Namespaces: []string{"ns-1"},
+		Project:    "proj-a",
+	})
+	require.NoError(t, err)
+
+	// Components of other projects and namespaces are filtered out.
+	require.NoError(t, k8sClient.Create(ctx, testComponent("ns-1", "proj-b", "other-project")))
+	require.NoError(t, k8sClient.Create(ctx, testComponent("ns-2", "proj-a", "other-namespace")))
+	comp := testComponent("ns-1", "proj-a", "web")
+	require.NoError(t, k8sClient.Create(ctx, comp))
+
+	ev := nextEvent(t, events)
+	require.Equal(t, EventAdded, ev.Type)
+	require.Equal(t, "Component", ev.Kind)
+	require.Equal(t, "web", ev.Object.GetName())
+	tokens, err := decodeToken(ev.Token)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"Component/ns-1": comp.ResourceVersion}, tokens)
+
+	comp.Labels = map[string]string{"tier": "frontend"}
+	require.NoError(t, k8sClient.Update(ctx, comp))
+	ev = nextEvent(t, events)
+	require.Equal(t, EventModified, ev.Type)
+	require.Equal(t, "frontend", ev.Object.GetLabels()["tier"])
+
+	require.NoError(t, k8sClient.Delete(ctx, comp))
+	ev = nextEvent(t, events)
+	require.Equal(t, EventDeleted, ev.Type)
+	require.Equal(t, "web", ev.Object.GetName())
+}
+
+func TestWatch_SendsBookmarks(t *testing.T) {
+	svc, _ := newTestService()
+	svc.bookmarkInterval = 10 * time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	token := encodeToken(map[string]string{"Project/ns-1": "42"})
+	events, err := svc.Watch(ctx, &Request{Kinds: []string{"Project"}, Namespaces: []string{"ns-1"}, Token: token})
+	require.NoError(t, err)
+
+	ev := nextEvent(t, events)
+	require.Equal(t, EventBookmark, ev.Type)
+	require.Nil(t, ev.Object)
+	require.Equal(t, token, ev.Token)
+}
+
+func TestWatch_ClosesChannelOnCancel(t *testing.T) {
+	svc, _ := newTestService()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	events, err := svc.Watch(ctx, &Request{Kinds: []string{"Environment"}, Namespaces: []string{"ns-1"}})
+	require.NoError(t, err)
+	cancel()
+
+	select {
+	case _, ok := <-events:
+		require.False(t, ok)
+	case <-time.After(5 * time.Second):
+		t.Fatal("event channel was not closed")
+	}
+}
+
+func TestToken_RoundTrip(t *testing.T) {
+	tokens := map[string]string{"Component/ns-1": "10", "WorkflowRun/ns-2": "20"}
+	decoded, err := decodeToken(encodeToken(tokens))
+	require.NoError(t, err)
+	require.Equal(t, tokens, decoded)
+
+	empty, err := decodeToken("")
+	require.NoError(t, err)
+	require.Empty(t, empty)
+}
@@ -11,4 +11,9 @@ var (
 	ErrWorkflowNotFound             = errors.New("workflow not found")
 	ErrWorkflowRunReferenceNotFound = errors.New("workflow run reference not found")
 	ErrInvalidCommitSHA             = errors.New("invalid commit SHA format")
+	ErrWorkflowNotConfigured        = errors.New("component does not have a workflow configured")
+	ErrBranchOverrideNotSupported   = errors.New("workflow does not map a repository branch parameter")
+	ErrScanResultNotFound           = errors.New("workflow run has no vulnerability scan result")
+	ErrSBOMNotFound                 = errors.New("no SBOM was recorded for the image")
+	ErrComponentNotDeployed         = errors.New("component is not deployed to the environment")
 )
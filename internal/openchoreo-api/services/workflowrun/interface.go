@@ -18,10 +18,25 @@ type Service interface {
 	ListWorkflowRuns(ctx context.Context, namespaceName, projectName, componentName, workflowName string, opts services.ListOptions) (*services.ListResult[openchoreov1alpha1.WorkflowRun], error)
 	GetWorkflowRun(ctx context.Context, namespaceName, runName string) (*openchoreov1alpha1.WorkflowRun, error)
 	GetWorkflowRunStatus(ctx context.Context, namespaceName, runName string) (*models.WorkflowRunStatusResponse, error)
+	// GetWorkflowRunVulnerabilities returns the vulnerability scan result of the image built by a
+	// workflow run, listing only the findings of the given severity when one is set.
+	GetWorkflowRunVulnerabilities(ctx context.Context, namespaceName, runName, severity string) (*models.WorkflowRunVulnerabilitiesResponse, error)
+	// GetWorkflowRunSBOM returns where the SBOM of the image built by a workflow run is stored.
+	GetWorkflowRunSBOM(ctx context.Context, namespaceName, runName string) (*models.WorkflowRunSBOMResponse, error)
+	// GetDeployedSBOM returns the SBOM of the image a component runs in an environment, as
+	// recorded by the workflow run that built the image.
+	GetDeployedSBOM(ctx context.Context, namespaceName, componentName, environmentName string) (*models.WorkflowRunSBOMResponse, error)
 	GetWorkflowRunLogs(ctx context.Context, namespaceName, runName, taskName string, sinceSeconds *int64) ([]models.WorkflowRunLogEntry, error)
+	// StreamWorkflowRunLogs follows the step logs of a workflow run on its workflow plane and
+	// calls send for each line until the steps finish, send fails or ctx is done.
+	StreamWorkflowRunLogs(ctx context.Context, namespaceName, runName, taskName string, sinceSeconds *int64, send func(models.WorkflowRunLogEntry) error) error
 	GetWorkflowRunEvents(ctx context.Context, namespaceName, runName, taskName string) ([]models.WorkflowRunEventEntry, error)
 	DeleteWorkflowRun(ctx context.Context, namespaceName, runName string) error
 	// TriggerWorkflow creates a WorkflowRun from a component's workflow configuration.
 	// The authorized version is used by API handlers; the unauthz version is used by webhook processing.
 	TriggerWorkflow(ctx context.Context, namespaceName, projectName, componentName, commit string) (*models.WorkflowRunTriggerResponse, error)
+	// TriggerWorkflowAtRef is TriggerWorkflow with an optional branch override. The branch is
+	// injected at the workflow's repository-branch parameter; ErrBranchOverrideNotSupported is
+	// returned when the workflow schema does not map one.
+	TriggerWorkflowAtRef(ctx context.Context, namespaceName, projectName, componentName, commit, branch string) (*models.WorkflowRunTriggerResponse, error)
 }
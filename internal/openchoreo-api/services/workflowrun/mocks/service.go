@@ -134,6 +134,67 @@ func (_c *MockService_DeleteWorkflowRun_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetDeployedSBOM provides a mock function with given fields: ctx, namespaceName, componentName, environmentName
+func (_m *MockService) GetDeployedSBOM(ctx context.Context, namespaceName string, componentName string, environmentName string) (*models.WorkflowRunSBOMResponse, error) {
+	ret := _m.Called(ctx, namespaceName, componentName, environmentName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeployedSBOM")
+	}
+
+	var r0 *models.WorkflowRunSBOMResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*models.WorkflowRunSBOMResponse, error)); ok {
+		return rf(ctx, namespaceName, componentName, environmentName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *models.WorkflowRunSBOMResponse); ok {
+		r0 = rf(ctx, namespaceName, componentName, environmentName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WorkflowRunSBOMResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, componentName, environmentName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetDeployedSBOM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeployedSBOM'
+type MockService_GetDeployedSBOM_Call struct {
+	*mock.Call
+}
+
+// GetDeployedSBOM is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - componentName string
+//   - environmentName string
+func (_e *MockService_Expecter) GetDeployedSBOM(ctx interface{}, namespaceName interface{}, componentName interface{}, environmentName interface{}) *MockService_GetDeployedSBOM_Call {
+	return &MockService_GetDeployedSBOM_Call{Call: _e.mock.On("GetDeployedSBOM", ctx, namespaceName, componentName, environmentName)}
+}
+
+func (_c *MockService_GetDeployedSBOM_Call) Run(run func(ctx context.Context, namespaceName string, componentName string, environmentName string)) *MockService_GetDeployedSBOM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetDeployedSBOM_Call) Return(_a0 *models.WorkflowRunSBOMResponse, _a1 error) *MockService_GetDeployedSBOM_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetDeployedSBOM_Call) RunAndReturn(run func(context.Context, string, string, string) (*models.WorkflowRunSBOMResponse, error)) *MockService_GetDeployedSBOM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWorkflowRun provides a mock function with given fields: ctx, namespaceName, runName
 func (_m *MockService) GetWorkflowRun(ctx context.Context, namespaceName string, runName string) (*v1alpha1.WorkflowRun, error) {
 	ret := _m.Called(ctx, namespaceName, runName)
@@ -317,6 +378,66 @@ func (_c *MockService_GetWorkflowRunLogs_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// GetWorkflowRunSBOM provides a mock function with given fields: ctx, namespaceName, runName
+func (_m *MockService) GetWorkflowRunSBOM(ctx context.Context, namespaceName string, runName string) (*models.WorkflowRunSBOMResponse, error) {
+	ret := _m.Called(ctx, namespaceName, runName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkflowRunSBOM")
+	}
+
+	var r0 *models.WorkflowRunSBOMResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.WorkflowRunSBOMResponse, error)); ok {
+		return rf(ctx, namespaceName, runName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.WorkflowRunSBOMResponse); ok {
+		r0 = rf(ctx, namespaceName, runName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WorkflowRunSBOMResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, runName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetWorkflowRunSBOM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkflowRunSBOM'
+type MockService_GetWorkflowRunSBOM_Call struct {
+	*mock.Call
+}
+
+// GetWorkflowRunSBOM is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - runName string
+func (_e *MockService_Expecter) GetWorkflowRunSBOM(ctx interface{}, namespaceName interface{}, runName interface{}) *MockService_GetWorkflowRunSBOM_Call {
+	return &MockService_GetWorkflowRunSBOM_Call{Call: _e.mock.On("GetWorkflowRunSBOM", ctx, namespaceName, runName)}
+}
+
+func (_c *MockService_GetWorkflowRunSBOM_Call) Run(run func(ctx context.Context, namespaceName string, runName string)) *MockService_GetWorkflowRunSBOM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetWorkflowRunSBOM_Call) Return(_a0 *models.WorkflowRunSBOMResponse, _a1 error) *MockService_GetWorkflowRunSBOM_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetWorkflowRunSBOM_Call) RunAndReturn(run func(context.Context, string, string) (*models.WorkflowRunSBOMResponse, error)) *MockService_GetWorkflowRunSBOM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWorkflowRunStatus provides a mock function with given fields: ctx, namespaceName, runName
 func (_m *MockService) GetWorkflowRunStatus(ctx context.Context, namespaceName string, runName string) (*models.WorkflowRunStatusResponse, error) {
 	ret := _m.Called(ctx, namespaceName, runName)
@@ -377,6 +498,67 @@ func (_c *MockService_GetWorkflowRunStatus_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetWorkflowRunVulnerabilities provides a mock function with given fields: ctx, namespaceName, runName, severity
+func (_m *MockService) GetWorkflowRunVulnerabilities(ctx context.Context, namespaceName string, runName string, severity string) (*models.WorkflowRunVulnerabilitiesResponse, error) {
+	ret := _m.Called(ctx, namespaceName, runName, severity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkflowRunVulnerabilities")
+	}
+
+	var r0 *models.WorkflowRunVulnerabilitiesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*models.WorkflowRunVulnerabilitiesResponse, error)); ok {
+		return rf(ctx, namespaceName, runName, severity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *models.WorkflowRunVulnerabilitiesResponse); ok {
+		r0 = rf(ctx, namespaceName, runName, severity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WorkflowRunVulnerabilitiesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, runName, severity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetWorkflowRunVulnerabilities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkflowRunVulnerabilities'
+type MockService_GetWorkflowRunVulnerabilities_Call struct {
+	*mock.Call
+}
+
+// GetWorkflowRunVulnerabilities is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - runName string
+//   - severity string
+func (_e *MockService_Expecter) GetWorkflowRunVulnerabilities(ctx interface{}, namespaceName interface{}, runName interface{}, severity interface{}) *MockService_GetWorkflowRunVulnerabilities_Call {
+	return &MockService_GetWorkflowRunVulnerabilities_Call{Call: _e.mock.On("GetWorkflowRunVulnerabilities", ctx, namespaceName, runName, severity)}
+}
+
+func (_c *MockService_GetWorkflowRunVulnerabilities_Call) Run(run func(ctx context.Context, namespaceName string, runName string, severity string)) *MockService_GetWorkflowRunVulnerabilities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetWorkflowRunVulnerabilities_Call) Return(_a0 *models.WorkflowRunVulnerabilitiesResponse, _a1 error) *MockService_GetWorkflowRunVulnerabilities_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetWorkflowRunVulnerabilities_Call) RunAndReturn(run func(context.Context, string, string, string) (*models.WorkflowRunVulnerabilitiesResponse, error)) *MockService_GetWorkflowRunVulnerabilities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListWorkflowRuns provides a mock function with given fields: ctx, namespaceName, projectName, componentName, workflowName, opts
 func (_m *MockService) ListWorkflowRuns(ctx context.Context, namespaceName string, projectName string, componentName string, workflowName string, opts services.ListOptions) (*services.ListResult[v1alpha1.WorkflowRun], error) {
 	ret := _m.Called(ctx, namespaceName, projectName, componentName, workflowName, opts)
@@ -440,6 +622,57 @@ func (_c *MockService_ListWorkflowRuns_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// StreamWorkflowRunLogs provides a mock function with given fields: ctx, namespaceName, runName, taskName, sinceSeconds, send
+func (_m *MockService) StreamWorkflowRunLogs(ctx context.Context, namespaceName string, runName string, taskName string, sinceSeconds *int64, send func(models.WorkflowRunLogEntry) error) error {
+	ret := _m.Called(ctx, namespaceName, runName, taskName, sinceSeconds, send)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamWorkflowRunLogs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, *int64, func(models.WorkflowRunLogEntry) error) error); ok {
+		r0 = rf(ctx, namespaceName, runName, taskName, sinceSeconds, send)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_StreamWorkflowRunLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamWorkflowRunLogs'
+type MockService_StreamWorkflowRunLogs_Call struct {
+	*mock.Call
+}
+
+// StreamWorkflowRunLogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - runName string
+//   - taskName string
+//   - sinceSeconds *int64
+//   - send func(models.WorkflowRunLogEntry) error
+func (_e *MockService_Expecter) StreamWorkflowRunLogs(ctx interface{}, namespaceName interface{}, runName interface{}, taskName interface{}, sinceSeconds interface{}, send interface{}) *MockService_StreamWorkflowRunLogs_Call {
+	return &MockService_StreamWorkflowRunLogs_Call{Call: _e.mock.On("StreamWorkflowRunLogs", ctx, namespaceName, runName, taskName, sinceSeconds, send)}
+}
+
+func (_c *MockService_StreamWorkflowRunLogs_Call) Run(run func(ctx context.Context, namespaceName string, runName string, taskName string, sinceSeconds *int64, send func(models.WorkflowRunLogEntry) error)) *MockService_StreamWorkflowRunLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(*int64), args[5].(func(models.WorkflowRunLogEntry) error))
+	})
+	return _c
+}
+
+func (_c *MockService_StreamWorkflowRunLogs_Call) Return(_a0 error) *MockService_StreamWorkflowRunLogs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_StreamWorkflowRunLogs_Call) RunAndReturn(run func(context.Context, string, string, string, *int64, func(models.WorkflowRunLogEntry) error) error) *MockService_StreamWorkflowRunLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TriggerWorkflow provides a mock function with given fields: ctx, namespaceName, projectName, componentName, commit
 func (_m *MockService) TriggerWorkflow(ctx context.Context, namespaceName string, projectName string, componentName string, commit string) (*models.WorkflowRunTriggerResponse, error) {
 	ret := _m.Called(ctx, namespaceName, projectName, componentName, commit)
@@ -502,6 +735,69 @@ func (_c *MockService_TriggerWorkflow_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// TriggerWorkflowAtRef provides a mock function with given fields: ctx, namespaceName, projectName, componentName, commit, branch
+func (_m *MockService) TriggerWorkflowAtRef(ctx context.Context, namespaceName string, projectName string, componentName string, commit string, branch string) (*models.WorkflowRunTriggerResponse, error) {
+	ret := _m.Called(ctx, namespaceName, projectName, componentName, commit, branch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TriggerWorkflowAtRef")
+	}
+
+	var r0 *models.WorkflowRunTriggerResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string) (*models.WorkflowRunTriggerResponse, error)); ok {
+		return rf(ctx, namespaceName, projectName, componentName, commit, branch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string) *models.WorkflowRunTriggerResponse); ok {
+		r0 = rf(ctx, namespaceName, projectName, componentName, commit, branch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WorkflowRunTriggerResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, string) error); ok {
+		r1 = rf(ctx, namespaceName, projectName, componentName, commit, branch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_TriggerWorkflowAtRef_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TriggerWorkflowAtRef'
+type MockService_TriggerWorkflowAtRef_Call struct {
+	*mock.Call
+}
+
+// TriggerWorkflowAtRef is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceName string
+//   - projectName string
+//   - componentName string
+//   - commit string
+//   - branch string
+func (_e *MockService_Expecter) TriggerWorkflowAtRef(ctx interface{}, namespaceName interface{}, projectName interface{}, componentName interface{}, commit interface{}, branch interface{}) *MockService_TriggerWorkflowAtRef_Call {
+	return &MockService_TriggerWorkflowAtRef_Call{Call: _e.mock.On("TriggerWorkflowAtRef", ctx, namespaceName, projectName, componentName, commit, branch)}
+}
+
+func (_c *MockService_TriggerWorkflowAtRef_Call) Run(run func(ctx context.Context, namespaceName string, projectName string, componentName string, commit string, branch string)) *MockService_TriggerWorkflowAtRef_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_TriggerWorkflowAtRef_Call) Return(_a0 *models.WorkflowRunTriggerResponse, _a1 error) *MockService_TriggerWorkflowAtRef_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_TriggerWorkflowAtRef_Call) RunAndReturn(run func(context.Context, string, string, string, string, string) (*models.WorkflowRunTriggerResponse, error)) *MockService_TriggerWorkflowAtRef_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateWorkflowRun provides a mock function with given fields: ctx, namespaceName, wfRun
 func (_m *MockService) UpdateWorkflowRun(ctx context.Context, namespaceName string, wfRun *v1alpha1.WorkflowRun) (*v1alpha1.WorkflowRun, error) {
 	ret := _m.Called(ctx, namespaceName, wfRun)
@@ -4,6 +4,7 @@
 package workflowrun
 
 import (
+	"bufio"
 	"context"
 	"crypto/rand"
 	"encoding/hex"
@@ -33,6 +34,7 @@ import (
 	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/scanreport"
 )
 
 // workflowRunService handles workflow run business logic without authorization checks.
@@ -266,6 +268,121 @@ func (s *workflowRunService) GetWorkflowRunLogs(ctx context.Context, namespaceNa
 	logger := s.logger.With("namespace", namespaceName, "run", runName, "task", taskName, "sinceSeconds", sinceSeconds)
 	logger.Debug("Getting workflow run logs")
 
+	target, err := s.resolveWorkflowRunLogTarget(ctx, namespaceName, runName, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get logs from pods and convert to structured format
+	allLogEntries := make([]models.WorkflowRunLogEntry, 0)
+	for _, pod := range target.pods {
+		podLogs, err := s.getArgoWorkflowPodLogs(ctx, target.workflowPlane, &pod, sinceSeconds)
+		if err != nil {
+			logger.Warn("Failed to get logs from pod", "pod", pod.Name, "error", err)
+			return nil, fmt.Errorf("failed to get logs from pod: %w", err)
+		}
+
+		// Parse log string into individual lines and create log entries
+		task := taskNameFromArgoPod(&pod)
+		lines := strings.Split(podLogs, "\n")
+		for _, line := range lines {
+			if entry, ok := parseWorkflowRunLogLine(line); ok {
+				entry.Task = task
+				allLogEntries = append(allLogEntries, entry)
+			}
+		}
+	}
+
+	return allLogEntries, nil
+}
+
+// StreamWorkflowRunLogs follows the logs of the step pods of a workflow run, one pod
+// at a time in the order the steps started, and calls send for each log line.
+func (s *workflowRunService) StreamWorkflowRunLogs(
+	ctx context.Context,
+	namespaceName, runName, taskName string,
+	sinceSeconds *int64,
+	send func(models.WorkflowRunLogEntry) error,
+) error {
+	logger := s.logger.With("namespace", namespaceName, "run", runName, "task", taskName, "sinceSeconds", sinceSeconds)
+	logger.Debug("Streaming workflow run logs")
+
+	target, err := s.resolveWorkflowRunLogTarget(ctx, namespaceName, runName, taskName)
+	if err != nil {
+		return err
+	}
+	if s.gwClient == nil {
+		return fmt.Errorf("gateway client is not configured")
+	}
+
+	pods := target.pods
+	sort.SliceStable(pods, func(i, j int) bool {
+		return pods[i].CreationTimestamp.Before(&pods[j].CreationTimestamp)
+	})
+
+	for i := range pods {
+		task := taskNameFromArgoPod(&pods[i])
+		for _, containerName := range logContainerNames(&pods[i]) {
+			if err := s.streamArgoWorkflowPodLogs(ctx, target.workflowPlane, &pods[i], containerName, task, sinceSeconds, send); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// streamArgoWorkflowPodLogs follows the logs of one container of a workflow pod until it terminates.
+func (s *workflowRunService) streamArgoWorkflowPodLogs(
+	ctx context.Context,
+	workflowPlane *openchoreov1alpha1.WorkflowPlane,
+	pod *corev1.Pod,
+	containerName, task string,
+	sinceSeconds *int64,
+	send func(models.WorkflowRunLogEntry) error,
+) error {
+	stream, err := s.gwClient.StreamPodLogsFromPlane(ctx, "workflowplane", workflowPlane.Spec.PlaneID, workflowPlane.Namespace, workflowPlane.Name,
+		&gatewayClient.PodReference{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+		}, &gatewayClient.PodLogsOptions{
+			ContainerName:     containerName,
+			IncludeTimestamps: true,
+			SinceSeconds:      sinceSeconds,
+		})
+	if err != nil {
+		return fmt.Errorf("failed to stream logs from pod %s: %w", pod.Name, err)
+	}
+	defer stream.Close()
+
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxStreamedLogLineBytes)
+	for scanner.Scan() {
+		entry, ok := parseWorkflowRunLogLine(scanner.Text())
+		if !ok {
+			continue
+		}
+		entry.Task = task
+		if err := send(entry); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil && ctx.Err() == nil {
+		return fmt.Errorf("failed to read logs from pod %s: %w", pod.Name, err)
+	}
+	return ctx.Err()
+}
+
+// workflowRunLogTarget holds the workflow plane and the step pods whose logs are read.
+type workflowRunLogTarget struct {
+	workflowPlane *openchoreov1alpha1.WorkflowPlane
+	pods          []corev1.Pod
+}
+
+// resolveWorkflowRunLogTarget finds the Argo Workflow of a workflow run on its workflow
+// plane and the pods of its steps, optionally limited to one task.
+func (s *workflowRunService) resolveWorkflowRunLogTarget(ctx context.Context, namespaceName, runName, taskName string) (*workflowRunLogTarget, error) {
+	logger := s.logger.With("namespace", namespaceName, "run", runName, "task", taskName)
+
 	// Get WorkflowRun
 	var workflowRun openchoreov1alpha1.WorkflowRun
 	if err := s.k8sClient.Get(ctx, client.ObjectKey{
@@ -281,7 +398,8 @@ func (s *workflowRunService) GetWorkflowRunLogs(ctx context.Context, namespaceNa
 	}
 
 	// Check if RunReference exists
-	if workflowRun.Status.RunReference == nil || workflowRun.Status.RunReference.Name == "" || workflowRun.Status.RunReference.Namespace == "" {
+	runReference := workflowRun.Status.RunReference
+	if runReference == nil || runReference.Name == "" || runReference.Namespace == "" {
 		logger.Error("Workflow run reference not found", "run", runName)
 		return nil, fmt.Errorf("%w: %s", ErrWorkflowRunReferenceNotFound, runName)
 	}
@@ -293,21 +411,6 @@ func (s *workflowRunService) GetWorkflowRunLogs(ctx context.Context, namespaceNa
 		return nil, fmt.Errorf("failed to resolve workflow plane ref: %w", err)
 	}
 
-	return s.getArgoWorkflowRunLogs(ctx, namespaceName, workflowRun.Status.RunReference, workflowPlaneRef, taskName, sinceSeconds)
-}
-
-// getArgoWorkflowRunLogs retrieves logs from an Argo Workflow run.
-func (s *workflowRunService) getArgoWorkflowRunLogs(
-	ctx context.Context,
-	namespaceName string,
-	runReference *openchoreov1alpha1.ResourceReference,
-	workflowPlaneRef *openchoreov1alpha1.WorkflowPlaneRef,
-	taskName string,
-	sinceSeconds *int64,
-) ([]models.WorkflowRunLogEntry, error) {
-	logger := s.logger.With("namespace", namespaceName, "runReference", runReference, "task", taskName, "sinceSeconds", sinceSeconds)
-	logger.Debug("Getting Argo workflow run logs")
-
 	// Get workflow plane client
 	wpClient, err := s.getWorkflowPlaneClient(ctx, namespaceName, workflowPlaneRef)
 	if err != nil {
@@ -342,51 +445,43 @@ func (s *workflowRunService) getArgoWorkflowRunLogs(
 		return nil, fmt.Errorf("failed to get workflow plane: %w", err)
 	}
 
-	// Get logs from pods and convert to structured format
-	allLogEntries := make([]models.WorkflowRunLogEntry, 0)
-	for _, pod := range pods {
-		podLogs, err := s.getArgoWorkflowPodLogs(ctx, workflowPlane, &pod, sinceSeconds)
-		if err != nil {
-			logger.Warn("Failed to get logs from pod", "pod", pod.Name, "error", err)
-			return nil, fmt.Errorf("failed to get logs from pod: %w", err)
-		}
-
-		// Parse log string into individual lines and create log entries
-		lines := strings.Split(podLogs, "\n")
-		for _, line := range lines {
-			trimmedLine := strings.TrimSpace(line)
-			if trimmedLine == "" || trimmedLine == "---" {
-				continue
-			}
+	return &workflowRunLogTarget{workflowPlane: workflowPlane, pods: pods}, nil
+}
 
-			// Extract timestamp and log message
-			spaceIndex := strings.Index(trimmedLine, " ")
-			if spaceIndex > 0 {
-				timestampCandidate := trimmedLine[:spaceIndex]
-				if _, err := time.Parse(time.RFC3339, timestampCandidate); err == nil {
-					allLogEntries = append(allLogEntries, models.WorkflowRunLogEntry{
-						Timestamp: timestampCandidate,
-						Log:       trimmedLine[spaceIndex+1:],
-					})
-					continue
-				}
-				if _, err := time.Parse(time.RFC3339Nano, timestampCandidate); err == nil {
-					allLogEntries = append(allLogEntries, models.WorkflowRunLogEntry{
-						Timestamp: timestampCandidate,
-						Log:       trimmedLine[spaceIndex+1:],
-					})
-					continue
-				}
-			}
+// parseWorkflowRunLogLine converts a pod log line, optionally prefixed with an RFC 3339
+// timestamp, into a log entry. Blank lines and container separators are skipped.
+func parseWorkflowRunLogLine(line string) (models.WorkflowRunLogEntry, bool) {
+	trimmedLine := strings.TrimSpace(line)
+	if trimmedLine == "" || trimmedLine == "---" {
+		return models.WorkflowRunLogEntry{}, false
+	}
 
-			allLogEntries = append(allLogEntries, models.WorkflowRunLogEntry{
-				Timestamp: "",
-				Log:       trimmedLine,
-			})
+	// Extract timestamp and log message
+	spaceIndex := strings.Index(trimmedLine, " ")
+	if spaceIndex > 0 {
+		timestampCandidate := trimmedLine[:spaceIndex]
+		if _, err := time.Parse(time.RFC3339, timestampCandidate); err == nil {
+			return models.WorkflowRunLogEntry{Timestamp: timestampCandidate, Log: trimmedLine[spaceIndex+1:]}, true
+		}
+		if _, err := time.Parse(time.RFC3339Nano, timestampCandidate); err == nil {
+			return models.WorkflowRunLogEntry{Timestamp: timestampCandidate, Log: trimmedLine[spaceIndex+1:]}, true
 		}
 	}
 
-	return allLogEntries, nil
+	return models.WorkflowRunLogEntry{Log: trimmedLine}, true
+}
+
+// taskNameFromArgoPod returns the workflow task a pod runs, read from its Argo
+// node-name annotation, or the pod name when the annotation is absent.
+func taskNameFromArgoPod(pod *corev1.Pod) string {
+	nodeName := pod.Annotations["workflows.argoproj.io/node-name"]
+	if nodeName == "" {
+		return pod.Name
+	}
+	if dotIdx := strings.LastIndex(nodeName, "."); dotIdx >= 0 {
+		return nodeName[dotIdx+1:]
+	}
+	return nodeName
 }
 
 // resolveWorkflowPlane resolves the workflow plane using the workflow's workflowPlaneRef.
@@ -506,23 +601,33 @@ func (s *workflowRunService) getArgoWorkflowPodsForLogs(ctx context.Context, wpC
 	return s.listAndFilterWorkflowPods(ctx, wpClient, workflow, taskName, true)
 }
 
-// getArgoWorkflowPodLogs retrieves logs from an Argo Workflow pod using the gateway client.
-func (s *workflowRunService) getArgoWorkflowPodLogs(ctx context.Context, workflowPlane *openchoreov1alpha1.WorkflowPlane, pod *corev1.Pod, sinceSeconds *int64) (string, error) {
-	if s.gwClient == nil {
-		return "", fmt.Errorf("gateway client is not configured")
-	}
+// excludedContainersForLogs are the Argo executor containers whose logs are not shown.
+var excludedContainersForLogs = map[string]bool{
+	"wait": true,
+	"init": true,
+}
 
-	excludedContainersForLogs := map[string]bool{
-		"wait": true,
-		"init": true,
-	}
+// maxStreamedLogLineBytes is the longest log line read from a followed pod log.
+const maxStreamedLogLineBytes = 1024 * 1024
 
+// logContainerNames returns the containers of a workflow pod whose logs are shown.
+func logContainerNames(pod *corev1.Pod) []string {
 	containerNames := make([]string, 0, len(pod.Spec.Containers))
 	for _, container := range pod.Spec.Containers {
 		if !excludedContainersForLogs[container.Name] {
 			containerNames = append(containerNames, container.Name)
 		}
 	}
+	return containerNames
+}
+
+// getArgoWorkflowPodLogs retrieves logs from an Argo Workflow pod using the gateway client.
+func (s *workflowRunService) getArgoWorkflowPodLogs(ctx context.Context, workflowPlane *openchoreov1alpha1.WorkflowPlane, pod *corev1.Pod, sinceSeconds *int64) (string, error) {
+	if s.gwClient == nil {
+		return "", fmt.Errorf("gateway client is not configured")
+	}
+
+	containerNames := logContainerNames(pod)
 	if len(containerNames) == 0 {
 		return "", fmt.Errorf("no containers to fetch logs from in pod")
 	}
@@ -753,9 +858,155 @@ func (s *workflowRunService) GetWorkflowRunStatus(ctx context.Context, namespace
 		Status:               overallStatus,
 		Steps:                steps,
 		HasLiveObservability: hasLiveObservability,
+		Image:                wfRun.Status.Image,
+		Cache:                toBuildCacheStatistics(wfRun.Status.Cache),
 	}, nil
 }
 
+func toBuildCacheStatistics(cache *openchoreov1alpha1.BuildCacheStatistics) *models.BuildCacheStatistics {
+	if cache == nil {
+		return nil
+	}
+	stats := &models.BuildCacheStatistics{Hits: cache.Hits, Misses: cache.Misses}
+	if lookups := cache.Hits + cache.Misses; lookups > 0 {
+		ratio := float64(cache.Hits) / float64(lookups)
+		stats.HitRatio = &ratio
+	}
+	return stats
+}
+
+func (s *workflowRunService) GetWorkflowRunVulnerabilities(ctx context.Context, namespaceName, runName, severity string) (*models.WorkflowRunVulnerabilitiesResponse, error) {
+	logger := s.logger.With("namespace", namespaceName, "run", runName)
+	logger.Debug("Getting workflow run vulnerabilities", "severity", severity)
+
+	var filter openchoreov1alpha1.VulnerabilitySeverity
+	if severity != "" {
+		filter = scanreport.NormalizeSeverity(severity)
+		if !strings.EqualFold(string(filter), severity) {
+			return nil, &services.ValidationError{Msg: fmt.Sprintf("invalid severity %q", severity)}
+		}
+	}
+
+	wfRun := &openchoreov1alpha1.WorkflowRun{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: runName, Namespace: namespaceName}, wfRun); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, ErrWorkflowRunNotFound
+		}
+		logger.Error("Failed to get WorkflowRun", "error", err)
+		return nil, fmt.Errorf("failed to get WorkflowRun: %w", err)
+	}
+	scan := wfRun.Status.Scan
+	if scan == nil {
+		return nil, ErrScanResultNotFound
+	}
+
+	resp := &models.WorkflowRunVulnerabilitiesResponse{
+		Image:           wfRun.Status.Image,
+		Scanner:         scan.Scanner,
+		Summary:         scan.Summary,
+		Vulnerabilities: make([]openchoreov1alpha1.Vulnerability, 0, len(scan.Vulnerabilities)),
+		Truncated:       scan.Truncated,
+	}
+	if scan.ScannedAt != nil {
+		scannedAt := scan.ScannedAt.Time
+		resp.ScannedAt = &scannedAt
+	}
+	for _, v := range scan.Vulnerabilities {
+		if filter == "" || v.Severity == filter {
+			resp.Vulnerabilities = append(resp.Vulnerabilities, v)
+		}
+	}
+	return resp, nil
+}
+
+func (s *workflowRunService) GetWorkflowRunSBOM(ctx context.Context, namespaceName, runName string) (*models.WorkflowRunSBOMResponse, error) {
+	logger := s.logger.With("namespace", namespaceName, "run", runName)
+	logger.Debug("Getting workflow run SBOM")
+
+	wfRun := &openchoreov1alpha1.WorkflowRun{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: runName, Namespace: namespaceName}, wfRun); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, ErrWorkflowRunNotFound
+		}
+		logger.Error("Failed to get WorkflowRun", "error", err)
+		return nil, fmt.Errorf("failed to get WorkflowRun: %w", err)
+	}
+	if wfRun.Status.SBOM == nil {
+		return nil, ErrSBOMNotFound
+	}
+	return toSBOMResponse(wfRun), nil
+}
+
+func (s *workflowRunService) GetDeployedSBOM(ctx context.Context, namespaceName, componentName, environmentName string) (*models.WorkflowRunSBOMResponse, error) {
+	logger := s.logger.With("namespace", namespaceName, "component", componentName, "environment", environmentName)
+	logger.Debug("Getting deployed SBOM")
+
+	bindings := &openchoreov1alpha1.ReleaseBindingList{}
+	if err := s.k8sClient.List(ctx, bindings, client.InNamespace(namespaceName)); err != nil {
+		logger.Error("Failed to list release bindings", "error", err)
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+	releaseName := ""
+	for i := range bindings.Items {
+		rb := &bindings.Items[i]
+		if rb.Spec.Owner.ComponentName == componentName && rb.Spec.Environment == environmentName {
+			releaseName = rb.Spec.ReleaseName
+			break
+		}
+	}
+	if releaseName == "" {
+		return nil, ErrComponentNotDeployed
+	}
+
+	release := &openchoreov1alpha1.ComponentRelease{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: releaseName, Namespace: namespaceName}, release); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, ErrComponentNotDeployed
+		}
+		logger.Error("Failed to get ComponentRelease", "error", err)
+		return nil, fmt.Errorf("failed to get ComponentRelease: %w", err)
+	}
+	image := release.Spec.Workload.Container.Image
+
+	// Several runs can report the same image, e.g. rebuilds of an unchanged digest;
+	// the most recent one that recorded an SBOM is used.
+	runs := &openchoreov1alpha1.WorkflowRunList{}
+	if err := s.k8sClient.List(ctx, runs, client.InNamespace(namespaceName),
+		client.MatchingLabels{ocLabels.LabelKeyComponentName: componentName}); err != nil {
+		logger.Error("Failed to list WorkflowRuns", "error", err)
+		return nil, fmt.Errorf("failed to list WorkflowRuns: %w", err)
+	}
+	var latest *openchoreov1alpha1.WorkflowRun
+	for i := range runs.Items {
+		run := &runs.Items[i]
+		if run.Status.Image != image || run.Status.SBOM == nil {
+			continue
+		}
+		if latest == nil || latest.CreationTimestamp.Before(&run.CreationTimestamp) {
+			latest = run
+		}
+	}
+	if latest == nil {
+		return nil, ErrSBOMNotFound
+	}
+	return toSBOMResponse(latest), nil
+}
+
+func toSBOMResponse(wfRun *openchoreov1alpha1.WorkflowRun) *models.WorkflowRunSBOMResponse {
+	sbom := wfRun.Status.SBOM
+	resp := &models.WorkflowRunSBOMResponse{
+		RunName:  wfRun.Name,
+		Image:    wfRun.Status.Image,
+		Format:   string(sbom.Format),
+		Location: sbom.Location,
+	}
+	if sbom.RecordedAt != nil {
+		recordedAt := sbom.RecordedAt.Time
+		resp.RecordedAt = &recordedAt
+	}
+	return resp
+}
+
 // argoWorkflowExists checks whether the Argo Workflow referenced by the given WorkflowRun
 // still exists on the workflow plane. Returns true if it exists.
 func (s *workflowRunService) argoWorkflowExists(ctx context.Context, namespaceName string, wfRun *openchoreov1alpha1.WorkflowRun) bool {
@@ -821,7 +1072,11 @@ func computeWorkflowRunStatus(conditions []metav1.Condition) string {
 // TriggerWorkflow creates a new WorkflowRun from a component's workflow configuration.
 // This is used by both the authorized API handler path and the webhook path (unauthz).
 func (s *workflowRunService) TriggerWorkflow(ctx context.Context, namespaceName, projectName, componentName, commit string) (*models.WorkflowRunTriggerResponse, error) {
-	s.logger.Debug("Triggering component workflow", "namespace", namespaceName, "project", projectName, "component", componentName, "commit", commit)
+	return s.TriggerWorkflowAtRef(ctx, namespaceName, projectName, componentName, commit, "")
+}
+
+func (s *workflowRunService) TriggerWorkflowAtRef(ctx context.Context, namespaceName, projectName, componentName, commit, branch string) (*models.WorkflowRunTriggerResponse, error) {
+	s.logger.Debug("Triggering component workflow", "namespace", namespaceName, "project", projectName, "component", componentName, "commit", commit, "branch", branch)
 
 	// Retrieve component
 	var component openchoreov1alpha1.Component
@@ -843,7 +1098,7 @@ func (s *workflowRunService) TriggerWorkflow(ctx context.Context, namespaceName,
 	// Check if component has workflow configuration
 	if component.Spec.Workflow == nil || component.Spec.Workflow.Name == "" {
 		s.logger.Error("Component does not have a workflow configured", "component", componentName)
-		return nil, fmt.Errorf("component %s does not have a workflow configured", componentName)
+		return nil, ErrWorkflowNotConfigured
 	}
 
 	// Fetch the Workflow or ClusterWorkflow CR to get the schema
@@ -911,6 +1166,20 @@ func (s *workflowRunService) TriggerWorkflow(ctx context.Context, namespaceName,
 		}
 	}
 
+	// Inject the branch override at the mapped path; unlike the commit, a branch
+	// cannot be silently dropped since the build would run against a different ref.
+	if branch != "" {
+		branchPath, ok := paramMap["branch"]
+		if !ok {
+			return nil, ErrBranchOverrideNotSupported
+		}
+		updatedParams, err := setNestedStringInParams(parameters, branchPath, branch)
+		if err != nil {
+			return nil, fmt.Errorf("failed to inject branch into workflow parameters: %w", err)
+		}
+		parameters = updatedParams
+	}
+
 	// Generate a unique workflow run name
 	workflowRunName, err := generateWorkflowRunName(componentName)
 	if err != nil {
@@ -953,7 +1222,7 @@ func (s *workflowRunService) TriggerWorkflow(ctx context.Context, namespaceName,
 		return nil, fmt.Errorf("failed to create workflow run: %w", err)
 	}
 
-	s.logger.Info("Workflow run created successfully", "workflow", workflowRunName, "component", componentName, "commit", commit)
+	s.logger.Info("Workflow run created successfully", "workflow", workflowRunName, "component", componentName, "commit", commit, "branch", branch)
 
 	return &models.WorkflowRunTriggerResponse{
 		Name:          workflowRun.Name,
@@ -962,6 +1231,7 @@ func (s *workflowRunService) TriggerWorkflow(ctx context.Context, namespaceName,
 		ProjectName:   projectName,
 		NamespaceName: namespaceName,
 		Commit:        commit,
+		Branch:        branch,
 		Status:        workflowRunStatusPending,
 		CreatedAt:     workflowRun.CreationTimestamp.Time,
 	}, nil
@@ -173,6 +173,27 @@ func (s *workflowRunServiceWithAuthz) GetWorkflowRunLogs(ctx context.Context, na
 	return s.internal.GetWorkflowRunLogs(ctx, namespaceName, runName, taskName, sinceSeconds)
 }
 
+func (s *workflowRunServiceWithAuthz) StreamWorkflowRunLogs(
+	ctx context.Context,
+	namespaceName, runName, taskName string,
+	sinceSeconds *int64,
+	send func(models.WorkflowRunLogEntry) error,
+) error {
+	wr, err := s.internal.GetWorkflowRun(ctx, namespaceName, runName)
+	if err != nil {
+		return err
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewWorkflowRun,
+		ResourceType: resourceTypeWorkflowRun,
+		ResourceID:   runName,
+		Hierarchy:    constructHierarchyForAuthzCheck(namespaceName, wr.Labels),
+	}); err != nil {
+		return err
+	}
+	return s.internal.StreamWorkflowRunLogs(ctx, namespaceName, runName, taskName, sinceSeconds, send)
+}
+
 func (s *workflowRunServiceWithAuthz) GetWorkflowRunEvents(ctx context.Context, namespaceName, runName, taskName string) ([]models.WorkflowRunEventEntry, error) {
 	wr, err := s.internal.GetWorkflowRun(ctx, namespaceName, runName)
 	if err != nil {
@@ -205,21 +226,94 @@ func (s *workflowRunServiceWithAuthz) GetWorkflowRunStatus(ctx context.Context,
 	return s.internal.GetWorkflowRunStatus(ctx, namespaceName, runName)
 }
 
+func (s *workflowRunServiceWithAuthz) GetWorkflowRunVulnerabilities(ctx context.Context, namespaceName, runName, severity string) (*models.WorkflowRunVulnerabilitiesResponse, error) {
+	wr, err := s.internal.GetWorkflowRun(ctx, namespaceName, runName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewWorkflowRun,
+		ResourceType: resourceTypeWorkflowRun,
+		ResourceID:   runName,
+		Hierarchy:    constructHierarchyForAuthzCheck(namespaceName, wr.Labels),
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.GetWorkflowRunVulnerabilities(ctx, namespaceName, runName, severity)
+}
+
+func (s *workflowRunServiceWithAuthz) GetWorkflowRunSBOM(ctx context.Context, namespaceName, runName string) (*models.WorkflowRunSBOMResponse, error) {
+	wr, err := s.internal.GetWorkflowRun(ctx, namespaceName, runName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewWorkflowRun,
+		ResourceType: resourceTypeWorkflowRun,
+		ResourceID:   runName,
+		Hierarchy:    constructHierarchyForAuthzCheck(namespaceName, wr.Labels),
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.GetWorkflowRunSBOM(ctx, namespaceName, runName)
+}
+
+func (s *workflowRunServiceWithAuthz) GetDeployedSBOM(ctx context.Context, namespaceName, componentName, environmentName string) (*models.WorkflowRunSBOMResponse, error) {
+	var comp openchoreov1alpha1.Component
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: componentName, Namespace: namespaceName}, &comp); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, component.ErrComponentNotFound
+		}
+		return nil, fmt.Errorf("failed to resolve component %s/%s for authz check: %w", namespaceName, componentName, err)
+	}
+	if err := s.authz.Check(ctx, services.CheckRequest{
+		Action:       authz.ActionViewWorkflowRun,
+		ResourceType: resourceTypeWorkflowRun,
+		ResourceID:   componentName,
+		Hierarchy: authz.ResourceHierarchy{
+			Namespace: namespaceName,
+			Project:   comp.Spec.Owner.ProjectName,
+			Component: componentName,
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return s.internal.GetDeployedSBOM(ctx, namespaceName, componentName, environmentName)
+}
+
 func (s *workflowRunServiceWithAuthz) TriggerWorkflow(ctx context.Context, namespaceName, projectName, componentName, commit string) (*models.WorkflowRunTriggerResponse, error) {
+	if err := s.checkTriggerWorkflow(ctx, namespaceName, projectName, componentName); err != nil {
+		return nil, err
+	}
+	return s.internal.TriggerWorkflow(ctx, namespaceName, projectName, componentName, commit)
+}
+
+func (s *workflowRunServiceWithAuthz) TriggerWorkflowAtRef(ctx context.Context, namespaceName, projectName, componentName, commit, branch string) (*models.WorkflowRunTriggerResponse, error) {
+	if err := s.checkTriggerWorkflow(ctx, namespaceName, projectName, componentName); err != nil {
+		return nil, err
+	}
+	return s.internal.TriggerWorkflowAtRef(ctx, namespaceName, projectName, componentName, commit, branch)
+}
+
+// checkTriggerWorkflow authorizes creating a WorkflowRun from the component's workflow configuration.
+func (s *workflowRunServiceWithAuthz) checkTriggerWorkflow(ctx context.Context, namespaceName, projectName, componentName string) error {
 	// Resolve the component's workflow reference for the authz check
 	var workflowAttr string
 	var comp openchoreov1alpha1.Component
 	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: componentName, Namespace: namespaceName}, &comp); err != nil {
 		if apierrors.IsNotFound(err) {
-			return nil, component.ErrComponentNotFound
+			return component.ErrComponentNotFound
 		}
-		return nil, fmt.Errorf("failed to resolve component %s/%s for authz check: %w", namespaceName, componentName, err)
+		return fmt.Errorf("failed to resolve component %s/%s for authz check: %w", namespaceName, componentName, err)
 	}
 	if comp.Spec.Workflow != nil {
 		workflowAttr = formatWorkflowAttr(namespaceName, comp.Spec.Workflow.Kind, comp.Spec.Workflow.Name)
 	}
+	if projectName == "" {
+		projectName = comp.Spec.Owner.ProjectName
+	}
 
-	if err := s.authz.Check(ctx, services.CheckRequest{
+	return s.authz.Check(ctx, services.CheckRequest{
 		Action:       authz.ActionCreateWorkflowRun,
 		ResourceType: resourceTypeWorkflowRun,
 		ResourceID:   componentName,
@@ -233,8 +327,5 @@ func (s *workflowRunServiceWithAuthz) TriggerWorkflow(ctx context.Context, names
 				Workflow: workflowAttr,
 			},
 		},
-	}); err != nil {
-		return nil, err
-	}
-	return s.internal.TriggerWorkflow(ctx, namespaceName, projectName, componentName, commit)
+	})
 }
@@ -468,6 +468,40 @@ func TestGetWorkflowRunLogs_Authz(t *testing.T) {
 	})
 }
 
+// ---------------------------------------------------------------------------
+// StreamWorkflowRunLogs authz tests
+// ---------------------------------------------------------------------------
+
+func TestStreamWorkflowRunLogs_Authz(t *testing.T) {
+	run := newWorkflowRun(testRunName, testProjectName, testComponentName)
+	send := func(models.WorkflowRunLogEntry) error { return nil }
+
+	t.Run("denied before streaming logs", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		mockSvc.EXPECT().GetWorkflowRun(mock.Anything, testNamespace, testRunName).Return(run, nil)
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.Anything).Return(denyDecision(), nil)
+
+		svc := newAuthzService(t, mockSvc, mockPDP)
+		err := svc.StreamWorkflowRunLogs(ctxWithSubject(), testNamespace, testRunName, "", nil, send)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+
+	t.Run("allowed delegates to internal service", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		mockSvc.EXPECT().GetWorkflowRun(mock.Anything, testNamespace, testRunName).Return(run, nil)
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.Anything).Return(allowDecision(), nil)
+		mockSvc.EXPECT().StreamWorkflowRunLogs(mock.Anything, testNamespace, testRunName, "task-1", (*int64)(nil), mock.Anything).
+			Return(nil)
+
+		svc := newAuthzService(t, mockSvc, mockPDP)
+		require.NoError(t, svc.StreamWorkflowRunLogs(ctxWithSubject(), testNamespace, testRunName, "task-1", nil, send))
+	})
+}
+
 // ---------------------------------------------------------------------------
 // GetWorkflowRunEvents authz tests
 // ---------------------------------------------------------------------------
@@ -564,6 +598,119 @@ func TestGetWorkflowRunStatus_Authz(t *testing.T) {
 	})
 }
 
+// ---------------------------------------------------------------------------
+// GetWorkflowRunVulnerabilities authz tests
+// ---------------------------------------------------------------------------
+
+func TestGetWorkflowRunVulnerabilities_Authz(t *testing.T) {
+	run := newWorkflowRun(testRunName, testProjectName, testComponentName)
+
+	t.Run("denied before fetching findings", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		mockSvc.EXPECT().GetWorkflowRun(mock.Anything, testNamespace, testRunName).Return(run, nil)
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.Anything).Return(denyDecision(), nil)
+
+		svc := newAuthzService(t, mockSvc, mockPDP)
+		_, err := svc.GetWorkflowRunVulnerabilities(ctxWithSubject(), testNamespace, testRunName, "")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+
+	t.Run("allowed delegates to internal service", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		resp := &models.WorkflowRunVulnerabilitiesResponse{Scanner: "trivy"}
+		mockSvc.EXPECT().GetWorkflowRun(mock.Anything, testNamespace, testRunName).Return(run, nil)
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.Anything).Return(allowDecision(), nil)
+		mockSvc.EXPECT().GetWorkflowRunVulnerabilities(mock.Anything, testNamespace, testRunName, "High").Return(resp, nil)
+
+		svc := newAuthzService(t, mockSvc, mockPDP)
+		result, err := svc.GetWorkflowRunVulnerabilities(ctxWithSubject(), testNamespace, testRunName, "High")
+		require.NoError(t, err)
+		assert.Equal(t, "trivy", result.Scanner)
+	})
+}
+
+// ---------------------------------------------------------------------------
+// GetWorkflowRunSBOM / GetDeployedSBOM authz tests
+// ---------------------------------------------------------------------------
+
+func TestGetWorkflowRunSBOM_Authz(t *testing.T) {
+	run := newWorkflowRun(testRunName, testProjectName, testComponentName)
+
+	t.Run("denied before returning the SBOM", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		mockSvc.EXPECT().GetWorkflowRun(mock.Anything, testNamespace, testRunName).Return(run, nil)
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.Anything).Return(denyDecision(), nil)
+
+		svc := newAuthzService(t, mockSvc, mockPDP)
+		_, err := svc.GetWorkflowRunSBOM(ctxWithSubject(), testNamespace, testRunName)
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+
+	t.Run("allowed delegates to internal service", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		resp := &models.WorkflowRunSBOMResponse{RunName: testRunName, Location: "registry.local/app:sbom"}
+		mockSvc.EXPECT().GetWorkflowRun(mock.Anything, testNamespace, testRunName).Return(run, nil)
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.Anything).Return(allowDecision(), nil)
+		mockSvc.EXPECT().GetWorkflowRunSBOM(mock.Anything, testNamespace, testRunName).Return(resp, nil)
+
+		svc := newAuthzService(t, mockSvc, mockPDP)
+		result, err := svc.GetWorkflowRunSBOM(ctxWithSubject(), testNamespace, testRunName)
+		require.NoError(t, err)
+		assert.Equal(t, "registry.local/app:sbom", result.Location)
+	})
+}
+
+func TestGetDeployedSBOM_Authz(t *testing.T) {
+	comp := &openchoreov1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: testComponentName, Namespace: testNamespace},
+		Spec: openchoreov1alpha1.ComponentSpec{
+			Owner: openchoreov1alpha1.ComponentOwner{ProjectName: testProjectName},
+		},
+	}
+
+	t.Run("denied returns forbidden without delegating", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.Anything).Return(denyDecision(), nil)
+
+		svc := workflowrun.NewTestServiceWithAuthz(mockSvc, testutil.NewFakeClient(comp), mockPDP, testutil.TestLogger())
+		_, err := svc.GetDeployedSBOM(ctxWithSubject(), testNamespace, testComponentName, "production")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+
+	t.Run("allowed delegates to internal service", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		resp := &models.WorkflowRunSBOMResponse{RunName: testRunName, Location: "registry.local/app:sbom"}
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.Anything).Return(allowDecision(), nil)
+		mockSvc.EXPECT().GetDeployedSBOM(mock.Anything, testNamespace, testComponentName, "production").Return(resp, nil)
+
+		svc := workflowrun.NewTestServiceWithAuthz(mockSvc, testutil.NewFakeClient(comp), mockPDP, testutil.TestLogger())
+		result, err := svc.GetDeployedSBOM(ctxWithSubject(), testNamespace, testComponentName, "production")
+		require.NoError(t, err)
+		assert.Equal(t, testRunName, result.RunName)
+	})
+
+	t.Run("unknown component", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		svc := workflowrun.NewTestServiceWithAuthz(mockSvc, testutil.NewFakeClient(), mockPDP, testutil.TestLogger())
+		_, err := svc.GetDeployedSBOM(ctxWithSubject(), testNamespace, "missing", "production")
+		require.Error(t, err)
+	})
+}
+
 // ---------------------------------------------------------------------------
 // TriggerWorkflow authz tests
 // ---------------------------------------------------------------------------
@@ -610,6 +757,21 @@ func TestTriggerWorkflow_Authz(t *testing.T) {
 		_, _ = svc.TriggerWorkflow(ctxWithSubject(), testNamespace, "proj", "my-comp", "abc1234f")
 	})
 
+	t.Run("without a project checks the hierarchy of the component owner", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		owned := triggerComp.DeepCopy()
+		owned.Spec.Owner.ProjectName = "owner-proj"
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.MatchedBy(func(req *authz.EvaluateRequest) bool {
+			return req.Resource.Hierarchy.Project == "owner-proj"
+		})).Return(denyDecision(), nil)
+
+		svc := workflowrun.NewTestServiceWithAuthz(mockSvc, testutil.NewFakeClient(owned), mockPDP, testutil.TestLogger())
+		_, err := svc.TriggerWorkflow(ctxWithSubject(), testNamespace, "", "my-comp", "")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+
 	t.Run("allowed delegates to internal service", func(t *testing.T) {
 		mockSvc := wfrmocks.NewMockService(t)
 		mockPDP := authzmocks.NewMockPDP(t)
@@ -664,6 +826,33 @@ func TestTriggerWorkflow_Authz(t *testing.T) {
 		svc := workflowrun.NewTestServiceWithAuthz(mockSvc, fakeClient, mockPDP, testutil.TestLogger())
 		_, _ = svc.TriggerWorkflow(ctxWithSubject(), testNamespace, "proj", "my-comp", "abc1234f")
 	})
+
+	t.Run("at ref denied returns forbidden without delegating", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.MatchedBy(func(req *authz.EvaluateRequest) bool {
+			return req.Action == authz.ActionCreateWorkflowRun && req.Resource.ID == "my-comp"
+		})).Return(denyDecision(), nil)
+
+		svc := workflowrun.NewTestServiceWithAuthz(mockSvc, testutil.NewFakeClient(triggerComp), mockPDP, testutil.TestLogger())
+		_, err := svc.TriggerWorkflowAtRef(ctxWithSubject(), testNamespace, "proj", "my-comp", "", "main")
+		require.ErrorIs(t, err, services.ErrForbidden)
+	})
+
+	t.Run("at ref allowed delegates to internal service", func(t *testing.T) {
+		mockSvc := wfrmocks.NewMockService(t)
+		mockPDP := authzmocks.NewMockPDP(t)
+
+		mockPDP.EXPECT().Evaluate(mock.Anything, mock.Anything).Return(allowDecision(), nil)
+		mockSvc.EXPECT().TriggerWorkflowAtRef(mock.Anything, testNamespace, "proj", "my-comp", "", "main").
+			Return(&models.WorkflowRunTriggerResponse{Name: "my-comp-run-abc12345", Branch: "main"}, nil)
+
+		svc := workflowrun.NewTestServiceWithAuthz(mockSvc, testutil.NewFakeClient(triggerComp), mockPDP, testutil.TestLogger())
+		result, err := svc.TriggerWorkflowAtRef(ctxWithSubject(), testNamespace, "proj", "my-comp", "", "main")
+		require.NoError(t, err)
+		assert.Equal(t, "main", result.Branch)
+	})
 }
 
 func TestFormatWorkflowAttr(t *testing.T) {
@@ -11,12 +11,14 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
 	ocLabels "github.com/openchoreo/openchoreo/internal/labels"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/models"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
 )
@@ -603,6 +605,142 @@ func TestTriggerWorkflow(t *testing.T) {
 	})
 }
 
+func TestGetWorkflowRunVulnerabilities(t *testing.T) {
+	ctx := context.Background()
+
+	scanned := func() *openchoreov1alpha1.WorkflowRun {
+		run := testutil.NewWorkflowRun(testNamespace, testWorkflowName, testRunName)
+		run.Status.Image = "registry.local/app@sha256:abc"
+		run.Status.Scan = &openchoreov1alpha1.ImageScanResult{
+			Scanner:   "grype",
+			ScannedAt: &metav1.Time{Time: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+			Summary:   openchoreov1alpha1.VulnerabilitySummary{Critical: 1, Low: 1},
+			Vulnerabilities: []openchoreov1alpha1.Vulnerability{
+				{ID: "CVE-1", Severity: openchoreov1alpha1.VulnerabilitySeverityCritical, Package: "openssl"},
+				{ID: "CVE-2", Severity: openchoreov1alpha1.VulnerabilitySeverityLow, Package: "zlib"},
+			},
+		}
+		return run
+	}
+
+	t.Run("lists all findings", func(t *testing.T) {
+		result, err := newService(t, scanned()).GetWorkflowRunVulnerabilities(ctx, testNamespace, testRunName, "")
+		require.NoError(t, err)
+		assert.Equal(t, "registry.local/app@sha256:abc", result.Image)
+		assert.Equal(t, "grype", result.Scanner)
+		require.NotNil(t, result.ScannedAt)
+		assert.Equal(t, int32(1), result.Summary.Critical)
+		assert.Len(t, result.Vulnerabilities, 2)
+	})
+
+	t.Run("filters findings by severity", func(t *testing.T) {
+		result, err := newService(t, scanned()).GetWorkflowRunVulnerabilities(ctx, testNamespace, testRunName, "critical")
+		require.NoError(t, err)
+		require.Len(t, result.Vulnerabilities, 1)
+		assert.Equal(t, "CVE-1", result.Vulnerabilities[0].ID)
+		assert.Equal(t, int32(1), result.Summary.Low, "summary counts every finding")
+	})
+
+	t.Run("invalid severity", func(t *testing.T) {
+		_, err := newService(t, scanned()).GetWorkflowRunVulnerabilities(ctx, testNamespace, testRunName, "severe")
+		var vErr *services.ValidationError
+		require.ErrorAs(t, err, &vErr)
+	})
+
+	t.Run("run without scan result", func(t *testing.T) {
+		run := testutil.NewWorkflowRun(testNamespace, testWorkflowName, testRunName)
+		_, err := newService(t, run).GetWorkflowRunVulnerabilities(ctx, testNamespace, testRunName, "")
+		require.ErrorIs(t, err, ErrScanResultNotFound)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := newService(t).GetWorkflowRunVulnerabilities(ctx, testNamespace, "nonexistent", "")
+		require.ErrorIs(t, err, ErrWorkflowRunNotFound)
+	})
+}
+
+func TestGetWorkflowRunSBOM(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns the recorded SBOM", func(t *testing.T) {
+		run := testutil.NewWorkflowRun(testNamespace, testWorkflowName, testRunName)
+		run.Status.Image = "registry.local/app@sha256:abc"
+		run.Status.SBOM = &openchoreov1alpha1.SBOMReference{
+			Format:     openchoreov1alpha1.SBOMFormatSPDX,
+			Location:   "registry.local/app@sha256:def",
+			RecordedAt: &metav1.Time{Time: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		}
+
+		result, err := newService(t, run).GetWorkflowRunSBOM(ctx, testNamespace, testRunName)
+		require.NoError(t, err)
+		assert.Equal(t, testRunName, result.RunName)
+		assert.Equal(t, "registry.local/app@sha256:abc", result.Image)
+		assert.Equal(t, "SPDX", result.Format)
+		assert.Equal(t, "registry.local/app@sha256:def", result.Location)
+		require.NotNil(t, result.RecordedAt)
+	})
+
+	t.Run("run without SBOM", func(t *testing.T) {
+		run := testutil.NewWorkflowRun(testNamespace, testWorkflowName, testRunName)
+		_, err := newService(t, run).GetWorkflowRunSBOM(ctx, testNamespace, testRunName)
+		require.ErrorIs(t, err, ErrSBOMNotFound)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := newService(t).GetWorkflowRunSBOM(ctx, testNamespace, "nonexistent")
+		require.ErrorIs(t, err, ErrWorkflowRunNotFound)
+	})
+}
+
+func TestGetDeployedSBOM(t *testing.T) {
+	ctx := context.Background()
+	const image = "registry.local/app@sha256:abc"
+
+	deployed := func() []client.Object {
+		release := testutil.NewComponentRelease(testNamespace, "proj", "comp", "comp-release-1")
+		release.Spec.Workload.Container.Image = image
+		binding := testutil.NewReleaseBinding(testNamespace, "proj", "comp", "production", "comp-production")
+		binding.Spec.ReleaseName = release.Name
+		return []client.Object{release, binding}
+	}
+	runFor := func(name, runImage string, created time.Time, location string) *openchoreov1alpha1.WorkflowRun {
+		run := testutil.NewWorkflowRun(testNamespace, testWorkflowName, name)
+		run.Labels = map[string]string{ocLabels.LabelKeyComponentName: "comp"}
+		run.CreationTimestamp = metav1.Time{Time: created}
+		run.Status.Image = runImage
+		if location != "" {
+			run.Status.SBOM = &openchoreov1alpha1.SBOMReference{Location: location}
+		}
+		return run
+	}
+
+	t.Run("returns the SBOM of the latest run that built the deployed image", func(t *testing.T) {
+		objs := append(deployed(),
+			runFor("run-1", image, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), "registry.local/app:sbom-1"),
+			runFor("run-2", image, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), "registry.local/app:sbom-2"),
+			runFor("run-3", image, time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC), ""),
+			runFor("run-4", "registry.local/app@sha256:other", time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC), "registry.local/app:sbom-4"),
+		)
+
+		result, err := newService(t, objs...).GetDeployedSBOM(ctx, testNamespace, "comp", "production")
+		require.NoError(t, err)
+		assert.Equal(t, "run-2", result.RunName)
+		assert.Equal(t, "registry.local/app:sbom-2", result.Location)
+		assert.Equal(t, image, result.Image)
+	})
+
+	t.Run("no SBOM for the deployed image", func(t *testing.T) {
+		objs := append(deployed(), runFor("run-1", image, time.Now(), ""))
+		_, err := newService(t, objs...).GetDeployedSBOM(ctx, testNamespace, "comp", "production")
+		require.ErrorIs(t, err, ErrSBOMNotFound)
+	})
+
+	t.Run("component not deployed to environment", func(t *testing.T) {
+		_, err := newService(t, deployed()...).GetDeployedSBOM(ctx, testNamespace, "comp", "staging")
+		require.ErrorIs(t, err, ErrComponentNotDeployed)
+	})
+}
+
 func TestGetWorkflowRunStatus(t *testing.T) {
 	ctx := context.Background()
 
@@ -621,6 +759,8 @@ func TestGetWorkflowRunStatus(t *testing.T) {
 		assert.Equal(t, workflowRunStatusPending, result.Status)
 		assert.Empty(t, result.Steps)
 		assert.False(t, result.HasLiveObservability)
+		assert.Empty(t, result.Image)
+		assert.Nil(t, result.Cache)
 	})
 
 	t.Run("running status with conditions", func(t *testing.T) {
@@ -658,12 +798,19 @@ func TestGetWorkflowRunStatus(t *testing.T) {
 		run.Status.Conditions = []metav1.Condition{
 			{Type: "WorkflowSucceeded", Status: metav1.ConditionTrue, LastTransitionTime: metav1.Now()},
 		}
+		run.Status.Image = "registry.local/api@sha256:abc"
+		run.Status.Cache = &openchoreov1alpha1.BuildCacheStatistics{Hits: 3, Misses: 1}
 		require.NoError(t, fakeClient.Status().Update(ctx, run))
 		svc := NewService(fakeClient, nil, nil, testutil.TestLogger())
 
 		result, err := svc.GetWorkflowRunStatus(ctx, testNamespace, "run-succeeded")
 		require.NoError(t, err)
 		assert.Equal(t, workflowRunStatusSucceeded, result.Status)
+		assert.Equal(t, "registry.local/api@sha256:abc", result.Image)
+		require.NotNil(t, result.Cache)
+		assert.Equal(t, int32(3), result.Cache.Hits)
+		require.NotNil(t, result.Cache.HitRatio)
+		assert.InDelta(t, 0.75, *result.Cache.HitRatio, 1e-9)
 	})
 
 	t.Run("maps tasks to steps with timestamps", func(t *testing.T) {
@@ -763,6 +910,61 @@ func TestGetWorkflowRunLogs(t *testing.T) {
 	})
 }
 
+func TestStreamWorkflowRunLogs(t *testing.T) {
+	ctx := context.Background()
+	send := func(models.WorkflowRunLogEntry) error {
+		t.Fatal("send must not be called")
+		return nil
+	}
+
+	t.Run("not found", func(t *testing.T) {
+		svc := newService(t)
+		err := svc.StreamWorkflowRunLogs(ctx, testNamespace, "nonexistent", "", nil, send)
+		require.ErrorIs(t, err, ErrWorkflowRunNotFound)
+	})
+
+	t.Run("missing run reference", func(t *testing.T) {
+		run := testutil.NewWorkflowRun(testNamespace, testWorkflowName, testRunName)
+		svc := newService(t, run)
+		err := svc.StreamWorkflowRunLogs(ctx, testNamespace, testRunName, "build", nil, send)
+		require.ErrorIs(t, err, ErrWorkflowRunReferenceNotFound)
+	})
+}
+
+func TestParseWorkflowRunLogLine(t *testing.T) {
+	tests := []struct {
+		name  string
+		line  string
+		want  models.WorkflowRunLogEntry
+		valid bool
+	}{
+		{"timestamped", "2026-01-15T10:00:00Z compiling", models.WorkflowRunLogEntry{Timestamp: "2026-01-15T10:00:00Z", Log: "compiling"}, true},
+		{"nanosecond timestamp", "2026-01-15T10:00:00.123456789Z step done  ",
+			models.WorkflowRunLogEntry{Timestamp: "2026-01-15T10:00:00.123456789Z", Log: "step done"}, true},
+		{"no timestamp", "plain output", models.WorkflowRunLogEntry{Log: "plain output"}, true},
+		{"blank", "   ", models.WorkflowRunLogEntry{}, false},
+		{"container separator", "---", models.WorkflowRunLogEntry{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseWorkflowRunLogLine(tt.line)
+			assert.Equal(t, tt.valid, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTaskNameFromArgoPod(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:        "build-01-build-123",
+		Annotations: map[string]string{"workflows.argoproj.io/node-name": "build-01[1].build-image"},
+	}}
+	assert.Equal(t, "build-image", taskNameFromArgoPod(pod))
+
+	pod.Annotations = nil
+	assert.Equal(t, "build-01-build-123", taskNameFromArgoPod(pod))
+}
+
 func TestGetWorkflowRunEvents(t *testing.T) {
 	ctx := context.Background()
 
@@ -1228,6 +1430,66 @@ func TestTriggerWorkflowAdditional(t *testing.T) {
 	})
 }
 
+func TestTriggerWorkflowAtRef(t *testing.T) {
+	ctx := context.Background()
+
+	buildWorkflowSchema := func(withBranch bool) *runtime.RawExtension {
+		props := map[string]any{
+			"repoUrl": map[string]any{
+				"type": "string",
+				"x-openchoreo-component-parameter-repository-url": true,
+			},
+		}
+		if withBranch {
+			props["branch"] = map[string]any{
+				"type": "string",
+				"x-openchoreo-component-parameter-repository-branch": true,
+			}
+		}
+		b, _ := json.Marshal(map[string]any{"type": "object", "properties": props})
+		return &runtime.RawExtension{Raw: b}
+	}
+
+	buildComponent := func() *openchoreov1alpha1.Component {
+		params, _ := json.Marshal(map[string]any{
+			"repoUrl": "https://github.com/example/repo",
+			"branch":  "main",
+		})
+		comp := testutil.NewComponent(testNamespace, "proj", "my-comp")
+		comp.Spec.Workflow = &openchoreov1alpha1.ComponentWorkflowConfig{
+			Kind:       openchoreov1alpha1.WorkflowRefKindWorkflow,
+			Name:       testWorkflowName,
+			Parameters: &runtime.RawExtension{Raw: params},
+		}
+		return comp
+	}
+
+	t.Run("branch injected at mapped path", func(t *testing.T) {
+		wf := testutil.NewWorkflow(testNamespace, testWorkflowName)
+		wf.Spec.Parameters = &openchoreov1alpha1.SchemaSection{OpenAPIV3Schema: buildWorkflowSchema(true)}
+		svc := newService(t, wf, buildComponent())
+
+		result, err := svc.TriggerWorkflowAtRef(ctx, testNamespace, "proj", "my-comp", "", "release-1.2")
+		require.NoError(t, err)
+		assert.Equal(t, "release-1.2", result.Branch)
+
+		run, err := svc.GetWorkflowRun(ctx, testNamespace, result.Name)
+		require.NoError(t, err)
+		branch, err := getNestedStringInParams(run.Spec.Workflow.Parameters, "branch")
+		require.NoError(t, err)
+		assert.Equal(t, "release-1.2", branch)
+	})
+
+	t.Run("branch without mapping rejected", func(t *testing.T) {
+		wf := testutil.NewWorkflow(testNamespace, testWorkflowName)
+		wf.Spec.Parameters = &openchoreov1alpha1.SchemaSection{OpenAPIV3Schema: buildWorkflowSchema(false)}
+		svc := newService(t, wf, buildComponent())
+
+		_, err := svc.TriggerWorkflowAtRef(ctx, testNamespace, "proj", "my-comp", "", "release-1.2")
+		require.ErrorIs(t, err, ErrBranchOverrideNotSupported)
+	})
+}
+
 func TestCreateWorkflowRunPreservesNamespace(t *testing.T) {
 	ctx := context.Background()
 
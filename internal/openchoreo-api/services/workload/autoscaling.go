@@ -0,0 +1,74 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workload
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	componentvalidation "github.com/openchoreo/openchoreo/internal/validation/component"
+)
+
+// validateAutoscaling checks the workload's autoscaling configuration against the autoscaling
+// limits of its component's ComponentType or ClusterComponentType.
+func (s *workloadService) validateAutoscaling(ctx context.Context, namespaceName string, w *openchoreov1alpha1.Workload) error {
+	if w.Spec.Autoscaling == nil {
+		return nil
+	}
+
+	component := &openchoreov1alpha1.Component{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: w.Spec.Owner.ComponentName, Namespace: namespaceName}, component); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return ErrComponentNotFound
+		}
+		return fmt.Errorf("failed to get component: %w", err)
+	}
+
+	ctSpec, err := s.fetchComponentTypeSpec(ctx, component.Spec.ComponentType, namespaceName)
+	if err != nil {
+		return err
+	}
+	if err := componentvalidation.ValidateAutoscaling(w.Spec.Autoscaling, ctSpec.WorkloadType, ctSpec.Autoscaling); err != nil {
+		return &services.ValidationError{Msg: fmt.Sprintf("invalid autoscaling configuration: %v", err)}
+	}
+	return nil
+}
+
+// fetchComponentTypeSpec returns the spec of the ComponentType or ClusterComponentType a component
+// references. References without a kind fall back to a ClusterComponentType of the same name.
+func (s *workloadService) fetchComponentTypeSpec(ctx context.Context, ctRef openchoreov1alpha1.ComponentTypeRef, namespaceName string) (*openchoreov1alpha1.ComponentTypeSpec, error) {
+	_, componentTypeName, found := strings.Cut(ctRef.Name, "/")
+	if !found || componentTypeName == "" {
+		return nil, fmt.Errorf("invalid component type format: %s", ctRef.Name)
+	}
+
+	if ctRef.Kind != openchoreov1alpha1.ComponentTypeRefKindClusterComponentType {
+		ct := &openchoreov1alpha1.ComponentType{}
+		err := s.k8sClient.Get(ctx, client.ObjectKey{Name: componentTypeName, Namespace: namespaceName}, ct)
+		if err == nil {
+			return &ct.Spec, nil
+		}
+		if client.IgnoreNotFound(err) != nil {
+			return nil, fmt.Errorf("failed to get ComponentType: %w", err)
+		}
+		if ctRef.Kind != "" {
+			return nil, &services.ValidationError{Msg: fmt.Sprintf("ComponentType %q not found in namespace %q", componentTypeName, namespaceName)}
+		}
+	}
+
+	cct := &openchoreov1alpha1.ClusterComponentType{}
+	if err := s.k8sClient.Get(ctx, client.ObjectKey{Name: componentTypeName}, cct); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, &services.ValidationError{Msg: fmt.Sprintf("component type %q not found", componentTypeName)}
+		}
+		return nil, fmt.Errorf("failed to get ClusterComponentType: %w", err)
+	}
+	spec := cct.Spec.ToComponentTypeSpec()
+	return &spec, nil
+}
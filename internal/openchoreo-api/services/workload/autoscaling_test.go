@@ -0,0 +1,116 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package workload
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services"
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/services/testutil"
+)
+
+func TestWorkloadAutoscalingValidation(t *testing.T) {
+	ctx := context.Background()
+
+	componentType := testutil.NewComponentType(testNamespace, "web-app")
+	componentType.Spec.Autoscaling = &openchoreov1alpha1.AutoscalingLimits{
+		MaxReplicas:         10,
+		AllowedTriggerTypes: []string{"kafka"},
+	}
+	newAutoscaledWorkload := func(autoscaling *openchoreov1alpha1.AutoscalingConfig) *openchoreov1alpha1.Workload {
+		w := testutil.NewWorkload(testNamespace, testProjectName, testComponentName, testWorkloadName)
+		w.Spec.Autoscaling = autoscaling
+		return w
+	}
+
+	t.Run("create within the component type limits", func(t *testing.T) {
+		svc := newService(t, testutil.NewComponent(testNamespace, testProjectName, testComponentName), componentType.DeepCopy())
+		w := newAutoscaledWorkload(&openchoreov1alpha1.AutoscalingConfig{
+			MinReplicas:                    ptr.To[int32](2),
+			MaxReplicas:                    8,
+			TargetCPUUtilizationPercentage: ptr.To[int32](75),
+		})
+
+		result, err := svc.CreateWorkload(ctx, testNamespace, w)
+		require.NoError(t, err)
+		assert.Equal(t, int32(8), result.Spec.Autoscaling.MaxReplicas)
+	})
+
+	t.Run("create above the component type maximum", func(t *testing.T) {
+		svc := newService(t, testutil.NewComponent(testNamespace, testProjectName, testComponentName), componentType.DeepCopy())
+		w := newAutoscaledWorkload(&openchoreov1alpha1.AutoscalingConfig{MaxReplicas: 20})
+
+		_, err := svc.CreateWorkload(ctx, testNamespace, w)
+		var validationErr *services.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr.Msg, "maxReplicas 20 exceeds the component type maximum of 10")
+	})
+
+	t.Run("create with a trigger type the cluster component type does not allow", func(t *testing.T) {
+		comp := testutil.NewComponent(testNamespace, testProjectName, testComponentName)
+		comp.Spec.ComponentType = openchoreov1alpha1.ComponentTypeRef{
+			Kind: openchoreov1alpha1.ComponentTypeRefKindClusterComponentType,
+			Name: "deployment/shared-service",
+		}
+		cct := testutil.NewClusterComponentType("shared-service")
+		cct.Spec.Autoscaling = &openchoreov1alpha1.AutoscalingLimits{MaxReplicas: 5, AllowedTriggerTypes: []string{"cron"}}
+		svc := newService(t, comp, cct)
+		w := newAutoscaledWorkload(&openchoreov1alpha1.AutoscalingConfig{
+			MaxReplicas: 5,
+			Triggers:    []openchoreov1alpha1.AutoscalingTrigger{{Type: "kafka"}},
+		})
+
+		_, err := svc.CreateWorkload(ctx, testNamespace, w)
+		var validationErr *services.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr.Msg, "trigger types [kafka] are not in the allowed list [cron]")
+	})
+
+	t.Run("create when the component type does not allow autoscaling", func(t *testing.T) {
+		svc := newService(t, testutil.NewComponent(testNamespace, testProjectName, testComponentName), testutil.NewComponentType(testNamespace, "web-app"))
+		w := newAutoscaledWorkload(&openchoreov1alpha1.AutoscalingConfig{MaxReplicas: 3})
+
+		_, err := svc.CreateWorkload(ctx, testNamespace, w)
+		var validationErr *services.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr.Msg, "not allowed by the component type")
+	})
+
+	t.Run("create when the component type is missing", func(t *testing.T) {
+		svc := newService(t, testutil.NewComponent(testNamespace, testProjectName, testComponentName))
+		w := newAutoscaledWorkload(&openchoreov1alpha1.AutoscalingConfig{MaxReplicas: 3})
+
+		_, err := svc.CreateWorkload(ctx, testNamespace, w)
+		var validationErr *services.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr.Msg, `ComponentType "web-app" not found`)
+	})
+
+	t.Run("update is validated against the component type limits", func(t *testing.T) {
+		existing := testutil.NewWorkload(testNamespace, testProjectName, testComponentName, testWorkloadName)
+		svc := newService(t, existing, testutil.NewComponent(testNamespace, testProjectName, testComponentName), componentType.DeepCopy())
+
+		_, err := svc.UpdateWorkload(ctx, testNamespace, newAutoscaledWorkload(&openchoreov1alpha1.AutoscalingConfig{MaxReplicas: 11}))
+		var validationErr *services.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+
+		result, err := svc.UpdateWorkload(ctx, testNamespace, newAutoscaledWorkload(&openchoreov1alpha1.AutoscalingConfig{MaxReplicas: 10}))
+		require.NoError(t, err)
+		assert.Equal(t, int32(10), result.Spec.Autoscaling.MaxReplicas)
+	})
+
+	t.Run("update of a workload whose component is missing", func(t *testing.T) {
+		existing := testutil.NewWorkload(testNamespace, testProjectName, testComponentName, testWorkloadName)
+		svc := newService(t, existing)
+
+		_, err := svc.UpdateWorkload(ctx, testNamespace, newAutoscaledWorkload(&openchoreov1alpha1.AutoscalingConfig{MaxReplicas: 2}))
+		require.ErrorIs(t, err, ErrComponentNotFound)
+	})
+}
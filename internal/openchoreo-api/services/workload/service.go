@@ -51,6 +51,9 @@ func (s *workloadService) CreateWorkload(ctx context.Context, namespaceName stri
 	if err := s.validateComponentExists(ctx, namespaceName, w.Spec.Owner.ComponentName); err != nil {
 		return nil, err
 	}
+	if err := s.validateAutoscaling(ctx, namespaceName, w); err != nil {
+		return nil, err
+	}
 
 	exists, err := s.workloadExists(ctx, namespaceName, w.Name)
 	if err != nil {
@@ -108,6 +111,10 @@ func (s *workloadService) UpdateWorkload(ctx context.Context, namespaceName stri
 	// Clear status from user input — status is server-managed
 	w.Status = openchoreov1alpha1.WorkloadStatus{}
 
+	if err := s.validateAutoscaling(ctx, namespaceName, w); err != nil {
+		return nil, err
+	}
+
 	// Only apply user-mutable fields to the existing object, preserving server-managed fields
 	existing.Spec = w.Spec
 	existing.Labels = w.Labels
@@ -379,6 +386,29 @@ func workloadSpecSchema() *extv1.JSONSchemaProps {
 		},
 	}
 
+	envBindingsSchema := extv1.JSONSchemaProps{
+		Type:        objectType,
+		Description: "Maps resolved connection address components to environment variable names.",
+		Properties: map[string]extv1.JSONSchemaProps{
+			"address": {
+				Type:        stringType,
+				Description: "Env var name for the protocol-appropriate connection string.",
+			},
+			"basePath": {
+				Type:        stringType,
+				Description: "Env var name for just the base path.",
+			},
+			"host": {
+				Type:        stringType,
+				Description: "Env var name for just the hostname.",
+			},
+			"port": {
+				Type:        stringType,
+				Description: "Env var name for just the port number.",
+			},
+		},
+	}
+
 	connectionSchema := extv1.JSONSchemaProps{
 		Type:        objectType,
 		Description: "Connection to another component's endpoint.",
@@ -401,28 +431,24 @@ func workloadSpecSchema() *extv1.JSONSchemaProps {
 				Type:        stringType,
 				Description: "Target component's project name. If empty, defaults to the same project as the consumer.",
 			},
-			"envBindings": {
-				Type:        objectType,
-				Description: "Maps resolved connection address components to environment variable names.",
-				Properties: map[string]extv1.JSONSchemaProps{
-					"address": {
-						Type:        stringType,
-						Description: "Env var name for the protocol-appropriate connection string.",
-					},
-					"basePath": {
-						Type:        stringType,
-						Description: "Env var name for just the base path.",
-					},
-					"host": {
-						Type:        stringType,
-						Description: "Env var name for just the hostname.",
-					},
-					"port": {
-						Type:        stringType,
-						Description: "Env var name for just the port number.",
-					},
-				},
+			"envBindings": envBindingsSchema,
+		},
+	}
+
+	externalConnectionSchema := extv1.JSONSchemaProps{
+		Type:        objectType,
+		Description: "Connection to a service running outside OpenChoreo.",
+		Required:    []string{"name", "address"},
+		Properties: map[string]extv1.JSONSchemaProps{
+			"name": {
+				Type:        stringType,
+				Description: "Name identifying the external service within the workload.",
 			},
+			"address": {
+				Type:        stringType,
+				Description: "Address of the external service, as a URL (scheme://host[:port][/path]) or host:port.",
+			},
+			"envBindings": envBindingsSchema,
 		},
 	}
 
@@ -448,6 +474,53 @@ func workloadSpecSchema() *extv1.JSONSchemaProps {
 		},
 	}
 
+	autoscalingSchema := extv1.JSONSchemaProps{
+		Type:        objectType,
+		Description: "Horizontal autoscaling, bounded by the autoscaling limits of the component type.",
+		Required:    []string{"maxReplicas"},
+		Properties: map[string]extv1.JSONSchemaProps{
+			"minReplicas": {
+				Type:        intType,
+				Description: "Lower bound of the replica count. Defaults to 1; 0 requires event-driven triggers.",
+			},
+			"maxReplicas": {
+				Type:        intType,
+				Description: "Upper bound of the replica count.",
+			},
+			"targetCPUUtilizationPercentage": {
+				Type:        intType,
+				Description: "Average CPU utilization target, relative to the container's CPU request.",
+			},
+			"targetMemoryUtilizationPercentage": {
+				Type:        intType,
+				Description: "Average memory utilization target, relative to the container's memory request.",
+			},
+			"triggers": {
+				Type:        arrayType,
+				Description: "KEDA event-driven scaling triggers.",
+				Items: &extv1.JSONSchemaPropsOrArray{Schema: &extv1.JSONSchemaProps{
+					Type:     objectType,
+					Required: []string{"type"},
+					Properties: map[string]extv1.JSONSchemaProps{
+						"type": {
+							Type:        stringType,
+							Description: "KEDA scaler type (e.g., kafka, rabbitmq, prometheus, cron).",
+						},
+						"metadata": {
+							Type:                 objectType,
+							Description:          "Scaler-specific configuration.",
+							AdditionalProperties: &extv1.JSONSchemaPropsOrBool{Schema: &extv1.JSONSchemaProps{Type: stringType}},
+						},
+						"authenticationRef": {
+							Type:        stringType,
+							Description: "Name of a KEDA TriggerAuthentication in the workload's namespace.",
+						},
+					},
+				}},
+			},
+		},
+	}
+
 	return &extv1.JSONSchemaProps{
 		Type:        objectType,
 		Description: "Workload specification defining the runtime configuration for a component.",
@@ -491,7 +564,7 @@ func workloadSpecSchema() *extv1.JSONSchemaProps {
 			},
 			"dependencies": {
 				Type:        objectType,
-				Description: "Dependencies on other components' endpoints and on project-bound Resources.",
+				Description: "Dependencies on other components' endpoints, on project-bound Resources and on external services.",
 				Properties: map[string]extv1.JSONSchemaProps{
 					"endpoints": {
 						Type:        "array",
@@ -503,8 +576,14 @@ func workloadSpecSchema() *extv1.JSONSchemaProps {
 						Description: "Resource dependencies on project-bound Resources.",
 						Items:       &extv1.JSONSchemaPropsOrArray{Schema: &resourceDependencySchema},
 					},
+					"external": {
+						Type:        "array",
+						Description: "Connections to services running outside OpenChoreo.",
+						Items:       &extv1.JSONSchemaPropsOrArray{Schema: &externalConnectionSchema},
+					},
 				},
 			},
+			"autoscaling": autoscalingSchema,
 		},
 	}
 }
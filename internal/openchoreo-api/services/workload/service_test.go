@@ -273,6 +273,18 @@ func TestGetWorkloadSchema(t *testing.T) {
 		assert.Contains(t, container.Required, "image")
 	})
 
+	t.Run("exposes autoscaling", func(t *testing.T) {
+		svc := newService(t)
+
+		schema, err := svc.GetWorkloadSchema(ctx)
+		require.NoError(t, err)
+
+		autoscaling, ok := schema.Properties["autoscaling"]
+		require.True(t, ok, "schema must define autoscaling")
+		assert.Contains(t, autoscaling.Required, "maxReplicas")
+		assert.Contains(t, autoscaling.Properties, "triggers")
+	})
+
 	t.Run("exposes endpoint and resource dependencies", func(t *testing.T) {
 		svc := newService(t)
 
@@ -254,13 +254,40 @@ func toGatewayEndpointData(e *v1alpha1.GatewayEndpointSpec) *GatewayEndpointData
 // extractEnvironmentData extracts EnvironmentData from Environment and DataPlane resources.
 // Gateway configuration is merged at each dimension: ingress/egress and external/internal.
 // Environment-level values take precedence; missing values fall back to the DataPlane level.
+// Sandbox environments expose their endpoints under the sandbox subdomain of every gateway host.
 func extractEnvironmentData(env *v1alpha1.Environment, dp *v1alpha1.DataPlane, defaultNotificationChannel string) EnvironmentData {
+	gateway := mergeGatewayData(&env.Spec.Gateway, &dp.Spec.Gateway)
+	if env.Spec.Sandbox != nil && env.Spec.Sandbox.Subdomain != "" {
+		applySandboxSubdomain(gateway, env.Spec.Sandbox.Subdomain)
+	}
 	return EnvironmentData{
-		Gateway:                    mergeGatewayData(&env.Spec.Gateway, &dp.Spec.Gateway),
+		Gateway:                    gateway,
 		DefaultNotificationChannel: defaultNotificationChannel,
 	}
 }
 
+// applySandboxSubdomain prefixes the listener hosts of the gateway with a sandbox subdomain.
+func applySandboxSubdomain(gw *GatewayData, subdomain string) {
+	if gw == nil {
+		return
+	}
+	for _, network := range []*GatewayNetworkData{gw.Ingress, gw.Egress} {
+		if network == nil {
+			continue
+		}
+		for _, endpoint := range []*GatewayEndpointData{network.External, network.Internal} {
+			if endpoint == nil {
+				continue
+			}
+			for _, listener := range []*GatewayListenerData{endpoint.HTTP, endpoint.HTTPS, endpoint.TLS} {
+				if listener != nil && listener.Host != "" {
+					listener.Host = subdomain + "." + listener.Host
+				}
+			}
+		}
+	}
+}
+
 // mergeGatewayData merges environment-level and dataplane-level gateway specs.
 // Ingress and egress are merged independently, so specifying only one at the environment
 // level still falls back to the dataplane value for the other.
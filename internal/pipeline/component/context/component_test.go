@@ -635,6 +635,37 @@ func TestExtractEnvironmentData_GatewayMerge(t *testing.T) {
 	assert.Equal(t, "slack-channel", data.DefaultNotificationChannel)
 }
 
+func TestExtractEnvironmentData_SandboxSubdomain(t *testing.T) {
+	dp := &v1alpha1.DataPlane{
+		Spec: v1alpha1.DataPlaneSpec{
+			Gateway: v1alpha1.GatewaySpec{
+				Ingress: &v1alpha1.GatewayNetworkSpec{
+					External: &v1alpha1.GatewayEndpointSpec{
+						Name:      "dp-ext-gw",
+						Namespace: "dp-ns",
+						HTTP:      &v1alpha1.GatewayListenerSpec{ListenerName: "http", Port: 80, Host: "apps.example.com"},
+						HTTPS:     &v1alpha1.GatewayListenerSpec{ListenerName: "https", Port: 443, Host: "apps.example.com"},
+					},
+				},
+			},
+		},
+	}
+	env := &v1alpha1.Environment{
+		Spec: v1alpha1.EnvironmentSpec{
+			Sandbox: &v1alpha1.EnvironmentSandboxSpec{Project: "p", Subdomain: "alice-sbx"},
+		},
+	}
+
+	data := extractEnvironmentData(env, dp, "")
+	require.NotNil(t, data.Gateway)
+	require.NotNil(t, data.Gateway.Ingress.External)
+	assert.Equal(t, "alice-sbx.apps.example.com", data.Gateway.Ingress.External.HTTP.Host)
+	assert.Equal(t, "alice-sbx.apps.example.com", data.Gateway.Ingress.External.HTTPS.Host)
+
+	// The data plane spec itself is left untouched.
+	assert.Equal(t, "apps.example.com", dp.Spec.Gateway.Ingress.External.HTTP.Host)
+}
+
 func TestMergeGatewayData_BothNil(t *testing.T) {
 	data := mergeGatewayData(nil, nil)
 	assert.Nil(t, data)
@@ -377,12 +377,15 @@ type RemoteRefData struct {
 }
 
 // ConnectionItem represents a single connection with its target metadata and resolved env vars.
+// For connections to services outside OpenChoreo, External is true, Endpoint holds the
+// connection name and the namespace, project, component and visibility are empty.
 type ConnectionItem struct {
 	Namespace  string        `json:"namespace"`
 	Project    string        `json:"project"`
 	Component  string        `json:"component"`
 	Endpoint   string        `json:"endpoint"`
 	Visibility string        `json:"visibility"`
+	External   bool          `json:"external"`
 	EnvVars    []EnvVarEntry `json:"envVars"`
 }
 
@@ -8,7 +8,7 @@ import (
 )
 
 // MergeWorkloadOverrides merges workload overrides into the base workload.
-// Supports merging container env and file configurations.
+// Supports replacing the container image and merging container env and file configurations.
 func MergeWorkloadOverrides(baseWorkload *openchoreov1alpha1.Workload, overrides *openchoreov1alpha1.WorkloadOverrideTemplateSpec) *openchoreov1alpha1.Workload {
 	if baseWorkload == nil {
 		return nil
@@ -19,6 +19,9 @@ func MergeWorkloadOverrides(baseWorkload *openchoreov1alpha1.Workload, overrides
 	}
 
 	merged := baseWorkload.DeepCopy()
+	if overrides.Container.Image != "" {
+		merged.Spec.Container.Image = overrides.Container.Image
+	}
 	merged.Spec.Container.Env = mergeEnvConfigs(merged.Spec.Container.Env, overrides.Container.Env)
 	merged.Spec.Container.Files = mergeFileConfigs(merged.Spec.Container.Files, overrides.Container.Files)
 
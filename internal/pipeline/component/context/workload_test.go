@@ -530,6 +530,46 @@ func TestMergeWorkloadOverrides(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "image override",
+			baseWorkload: &v1alpha1.Workload{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-workload",
+					Namespace: "test-ns",
+				},
+				Spec: v1alpha1.WorkloadSpec{
+					WorkloadTemplateSpec: v1alpha1.WorkloadTemplateSpec{
+						Container: v1alpha1.Container{
+							Image: "test:v1",
+							Env: []v1alpha1.EnvVar{
+								{Key: "ENV1", Value: "value1"},
+							},
+						},
+					},
+				},
+			},
+			overrides: &v1alpha1.WorkloadOverrideTemplateSpec{
+				Container: &v1alpha1.ContainerOverride{
+					Image: "test:pr-42",
+				},
+			},
+			want: &v1alpha1.Workload{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-workload",
+					Namespace: "test-ns",
+				},
+				Spec: v1alpha1.WorkloadSpec{
+					WorkloadTemplateSpec: v1alpha1.WorkloadTemplateSpec{
+						Container: v1alpha1.Container{
+							Image: "test:pr-42",
+							Env: []v1alpha1.EnvVar{
+								{Key: "ENV1", Value: "value1"},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
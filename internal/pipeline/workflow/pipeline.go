@@ -229,8 +229,15 @@ func (p *Pipeline) BuildCELContext(input *RenderInput) (map[string]any, error) {
 		return nil, fmt.Errorf("failed to build parameters: %w", err)
 	}
 
+	buildCache := input.Context.WorkflowPlane.BuildCache
 	workflowplane := map[string]any{
 		"secretStore": input.Context.WorkflowPlane.SecretStore,
+		"buildCache": map[string]any{
+			"enabled":   buildCache.Enabled,
+			"type":      buildCache.Type,
+			"image":     buildCache.Image,
+			"claimName": buildCache.ClaimName,
+		},
 	}
 
 	celContext := map[string]any{
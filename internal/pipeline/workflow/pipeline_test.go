@@ -1429,6 +1429,65 @@ func TestPipeline_Render_WorkflowPlaneVariables(t *testing.T) {
 	})
 }
 
+func TestPipeline_Render_BuildCacheVariables(t *testing.T) {
+	render := func(t *testing.T, cache BuildCacheData) map[string]interface{} {
+		t.Helper()
+		input := &RenderInput{
+			WorkflowRun: &v1alpha1.WorkflowRun{
+				Spec: v1alpha1.WorkflowRunSpec{
+					Workflow: v1alpha1.WorkflowRunConfig{
+						Name: "test-workflow",
+					},
+				},
+			},
+			Workflow: &v1alpha1.Workflow{
+				Spec: v1alpha1.WorkflowSpec{
+					RunTemplate: mustRawExtension(t, map[string]interface{}{
+						"apiVersion": "v1",
+						"kind":       "ConfigMap",
+						"metadata": map[string]interface{}{
+							"name": "build-cache-test",
+						},
+						"data": map[string]interface{}{
+							"enabled":   "${workflowplane.buildCache.enabled}",
+							"cacheArgs": "${workflowplane.buildCache.enabled && workflowplane.buildCache.type == 'Registry' ? '--cache-image=' + workflowplane.buildCache.image : ''}",
+							"claimName": "${workflowplane.buildCache.claimName}",
+						},
+					}),
+				},
+			},
+			Context: WorkflowContext{
+				NamespaceName:   "test-namespace",
+				WorkflowRunName: "test-run",
+				WorkflowPlane:   WorkflowPlaneData{BuildCache: cache},
+			},
+		}
+
+		output, err := NewPipeline().Render(input)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return output.Resource["data"].(map[string]interface{})
+	}
+
+	t.Run("registry cache", func(t *testing.T) {
+		data := render(t, BuildCacheData{Enabled: true, Type: "Registry", Image: "registry.local/cache/default/api"})
+		if data["enabled"] != true {
+			t.Errorf("expected enabled true, got %v", data["enabled"])
+		}
+		if data["cacheArgs"] != "--cache-image=registry.local/cache/default/api" {
+			t.Errorf("unexpected cacheArgs %v", data["cacheArgs"])
+		}
+	})
+
+	t.Run("no cache configured", func(t *testing.T) {
+		data := render(t, BuildCacheData{})
+		if data["enabled"] != false || data["cacheArgs"] != "" || data["claimName"] != "" {
+			t.Errorf("expected disabled cache, got %v", data)
+		}
+	})
+}
+
 func TestPipeline_Render_EdgeCases(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -81,4 +81,24 @@ type WorkflowPlaneData struct {
 	// SecretStore is the name of the ESO ClusterSecretStore configured on the workflow plane.
 	// Exposed as ${workflowplane.secretStore}.
 	SecretStore string
+
+	// BuildCache is the build cache the run uses. Exposed as ${workflowplane.buildCache.*}.
+	BuildCache BuildCacheData
+}
+
+// BuildCacheData describes the build cache available to a workflow run.
+type BuildCacheData struct {
+	// Enabled reports whether the run should use the cache. It is false when the workflow
+	// plane has no cache or the run disables it. Exposed as ${workflowplane.buildCache.enabled}.
+	Enabled bool
+
+	// Type is the cache type, Registry or PersistentVolume. Exposed as ${workflowplane.buildCache.type}.
+	Type string
+
+	// Image is the cache image of a registry cache. Exposed as ${workflowplane.buildCache.image}.
+	Image string
+
+	// ClaimName is the PersistentVolumeClaim of a volume cache in the run namespace.
+	// Exposed as ${workflowplane.buildCache.claimName}.
+	ClaimName string
 }
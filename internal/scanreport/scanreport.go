@@ -0,0 +1,202 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package scanreport parses the JSON reports of container image vulnerability
+// scanners into the scan results recorded on workflow runs.
+package scanreport
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+const (
+	ScannerTrivy = "trivy"
+	ScannerGrype = "grype"
+
+	// MaxVulnerabilities is the number of findings kept in a scan result.
+	MaxVulnerabilities = 200
+)
+
+// ErrUnknownFormat is returned when a report is neither a Trivy nor a Grype JSON report.
+var ErrUnknownFormat = errors.New("unrecognized scan report format")
+
+// trivyReport is the subset of `trivy image --format json` output that is ingested.
+type trivyReport struct {
+	Results []struct {
+		Vulnerabilities []struct {
+			VulnerabilityID  string `json:"VulnerabilityID"`
+			PkgName          string `json:"PkgName"`
+			InstalledVersion string `json:"InstalledVersion"`
+			FixedVersion     string `json:"FixedVersion"`
+			Severity         string `json:"Severity"`
+			Title            string `json:"Title"`
+		} `json:"Vulnerabilities"`
+	} `json:"Results"`
+}
+
+// grypeReport is the subset of `grype -o json` output that is ingested.
+type grypeReport struct {
+	Matches []struct {
+		Vulnerability struct {
+			ID          string `json:"id"`
+			Severity    string `json:"severity"`
+			Description string `json:"description"`
+			Fix         struct {
+				Versions []string `json:"versions"`
+			} `json:"fix"`
+		} `json:"vulnerability"`
+		Artifact struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+		} `json:"artifact"`
+	} `json:"matches"`
+}
+
+// Parse detects the format of a Trivy or Grype JSON report and returns its
+// findings, deduplicated and ordered by severity. At most MaxVulnerabilities
+// findings are listed; the summary counts all of them.
+func Parse(data []byte) (*openchoreov1alpha1.ImageScanResult, error) {
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, fmt.Errorf("failed to decode scan report: %w", err)
+	}
+
+	var (
+		scanner  string
+		findings []openchoreov1alpha1.Vulnerability
+	)
+	switch {
+	case keys["Results"] != nil || keys["SchemaVersion"] != nil:
+		var report trivyReport
+		if err := json.Unmarshal(data, &report); err != nil {
+			return nil, fmt.Errorf("failed to decode trivy report: %w", err)
+		}
+		scanner = ScannerTrivy
+		for _, result := range report.Results {
+			for _, v := range result.Vulnerabilities {
+				findings = append(findings, openchoreov1alpha1.Vulnerability{
+					ID:               v.VulnerabilityID,
+					Severity:         NormalizeSeverity(v.Severity),
+					Package:          v.PkgName,
+					InstalledVersion: v.InstalledVersion,
+					FixedVersion:     v.FixedVersion,
+					Title:            v.Title,
+				})
+			}
+		}
+	case keys["matches"] != nil:
+		var report grypeReport
+		if err := json.Unmarshal(data, &report); err != nil {
+			return nil, fmt.Errorf("failed to decode grype report: %w", err)
+		}
+		scanner = ScannerGrype
+		for _, m := range report.Matches {
+			findings = append(findings, openchoreov1alpha1.Vulnerability{
+				ID:               m.Vulnerability.ID,
+				Severity:         NormalizeSeverity(m.Vulnerability.Severity),
+				Package:          m.Artifact.Name,
+				InstalledVersion: m.Artifact.Version,
+				FixedVersion:     strings.Join(m.Vulnerability.Fix.Versions, ", "),
+				Title:            truncate(m.Vulnerability.Description, 200),
+			})
+		}
+	default:
+		return nil, ErrUnknownFormat
+	}
+
+	return summarize(scanner, findings), nil
+}
+
+// NormalizeSeverity maps scanner severities (CRITICAL, High, Negligible, ...)
+// onto the severities of a scan result. Negligible findings count as low.
+func NormalizeSeverity(severity string) openchoreov1alpha1.VulnerabilitySeverity {
+	switch strings.ToLower(severity) {
+	case "critical":
+		return openchoreov1alpha1.VulnerabilitySeverityCritical
+	case "high":
+		return openchoreov1alpha1.VulnerabilitySeverityHigh
+	case "medium":
+		return openchoreov1alpha1.VulnerabilitySeverityMedium
+	case "low", "negligible":
+		return openchoreov1alpha1.VulnerabilitySeverityLow
+	default:
+		return openchoreov1alpha1.VulnerabilitySeverityUnknown
+	}
+}
+
+func summarize(scanner string, findings []openchoreov1alpha1.Vulnerability) *openchoreov1alpha1.ImageScanResult {
+	type key struct{ id, pkg, version string }
+	seen := make(map[key]bool, len(findings))
+	unique := findings[:0]
+	for _, f := range findings {
+		k := key{f.ID, f.Package, f.InstalledVersion}
+		if f.ID == "" || seen[k] {
+			continue
+		}
+		seen[k] = true
+		unique = append(unique, f)
+	}
+
+	sort.SliceStable(unique, func(i, j int) bool {
+		if ri, rj := severityRank(unique[i].Severity), severityRank(unique[j].Severity); ri != rj {
+			return ri < rj
+		}
+		if unique[i].ID != unique[j].ID {
+			return unique[i].ID < unique[j].ID
+		}
+		return unique[i].Package < unique[j].Package
+	})
+
+	result := &openchoreov1alpha1.ImageScanResult{Scanner: scanner}
+	for _, f := range unique {
+		switch f.Severity {
+		case openchoreov1alpha1.VulnerabilitySeverityCritical:
+			result.Summary.Critical++
+		case openchoreov1alpha1.VulnerabilitySeverityHigh:
+			result.Summary.High++
+		case openchoreov1alpha1.VulnerabilitySeverityMedium:
+			result.Summary.Medium++
+		case openchoreov1alpha1.VulnerabilitySeverityLow:
+			result.Summary.Low++
+		default:
+			result.Summary.Unknown++
+		}
+	}
+	if len(unique) > MaxVulnerabilities {
+		unique = unique[:MaxVulnerabilities]
+		result.Truncated = true
+	}
+	if len(unique) > 0 {
+		result.Vulnerabilities = append([]openchoreov1alpha1.Vulnerability(nil), unique...)
+	}
+	return result
+}
+
+func severityRank(severity openchoreov1alpha1.VulnerabilitySeverity) int {
+	switch severity {
+	case openchoreov1alpha1.VulnerabilitySeverityCritical:
+		return 0
+	case openchoreov1alpha1.VulnerabilitySeverityHigh:
+		return 1
+	case openchoreov1alpha1.VulnerabilitySeverityMedium:
+		return 2
+	case openchoreov1alpha1.VulnerabilitySeverityLow:
+		return 3
+	default:
+		return 4
+	}
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return strings.TrimSpace(string(r[:n])) + "..."
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scanreport
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+const trivyJSON = `{
+  "SchemaVersion": 2,
+  "ArtifactName": "ghcr.io/acme/app:v1",
+  "Results": [
+    {
+      "Target": "ghcr.io/acme/app:v1 (debian 12.5)",
+      "Vulnerabilities": [
+        {"VulnerabilityID": "CVE-2024-0002", "PkgName": "libc6", "InstalledVersion": "2.36-9", "FixedVersion": "2.36-10", "Severity": "HIGH", "Title": "glibc overflow"},
+        {"VulnerabilityID": "CVE-2024-0001", "PkgName": "openssl", "InstalledVersion": "3.0.11", "FixedVersion": "3.0.13", "Severity": "CRITICAL", "Title": "openssl issue"},
+        {"VulnerabilityID": "CVE-2024-0003", "PkgName": "zlib", "InstalledVersion": "1.2.13", "Severity": "LOW"}
+      ]
+    },
+    {
+      "Target": "app/go.mod",
+      "Vulnerabilities": [
+        {"VulnerabilityID": "CVE-2024-0001", "PkgName": "openssl", "InstalledVersion": "3.0.11", "Severity": "CRITICAL"},
+        {"VulnerabilityID": "GHSA-xxxx", "PkgName": "golang.org/x/net", "InstalledVersion": "v0.1.0", "Severity": "MEDIUM"}
+      ]
+    }
+  ]
+}`
+
+const grypeJSON = `{
+  "matches": [
+    {
+      "vulnerability": {"id": "CVE-2024-1000", "severity": "Negligible", "description": "minor", "fix": {"versions": []}},
+      "artifact": {"name": "bash", "version": "5.2"}
+    },
+    {
+      "vulnerability": {"id": "CVE-2024-2000", "severity": "Critical", "description": "remote code execution", "fix": {"versions": ["1.2.4", "1.3.1"]}},
+      "artifact": {"name": "libfoo", "version": "1.2.3"}
+    }
+  ],
+  "descriptor": {"name": "grype"}
+}`
+
+func TestParseTrivy(t *testing.T) {
+	result, err := Parse([]byte(trivyJSON))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if result.Scanner != ScannerTrivy {
+		t.Errorf("Scanner = %q, want %q", result.Scanner, ScannerTrivy)
+	}
+	want := openchoreov1alpha1.VulnerabilitySummary{Critical: 1, High: 1, Medium: 1, Low: 1}
+	if result.Summary != want {
+		t.Errorf("Summary = %+v, want %+v", result.Summary, want)
+	}
+	var ids []string
+	for _, v := range result.Vulnerabilities {
+		ids = append(ids, v.ID)
+	}
+	if got := strings.Join(ids, ","); got != "CVE-2024-0001,CVE-2024-0002,GHSA-xxxx,CVE-2024-0003" {
+		t.Errorf("Vulnerabilities order = %s", got)
+	}
+	if first := result.Vulnerabilities[0]; first.Package != "openssl" || first.FixedVersion != "3.0.13" {
+		t.Errorf("first finding = %+v", first)
+	}
+}
+
+func TestParseGrype(t *testing.T) {
+	result, err := Parse([]byte(grypeJSON))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if result.Scanner != ScannerGrype {
+		t.Errorf("Scanner = %q, want %q", result.Scanner, ScannerGrype)
+	}
+	want := openchoreov1alpha1.VulnerabilitySummary{Critical: 1, Low: 1}
+	if result.Summary != want {
+		t.Errorf("Summary = %+v, want %+v", result.Summary, want)
+	}
+	first := result.Vulnerabilities[0]
+	if first.ID != "CVE-2024-2000" || first.Package != "libfoo" || first.InstalledVersion != "1.2.3" ||
+		first.FixedVersion != "1.2.4, 1.3.1" || first.Severity != openchoreov1alpha1.VulnerabilitySeverityCritical {
+		t.Errorf("first finding = %+v", first)
+	}
+}
+
+func TestParseTruncates(t *testing.T) {
+	var b strings.Builder
+	b.WriteString(`{"matches":[`)
+	for i := range MaxVulnerabilities + 5 {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		fmt.Fprintf(&b, `{"vulnerability":{"id":"CVE-%d","severity":"High"},"artifact":{"name":"pkg","version":"1"}}`, i)
+	}
+	b.WriteString(`]}`)
+
+	result, err := Parse([]byte(b.String()))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if !result.Truncated || len(result.Vulnerabilities) != MaxVulnerabilities {
+		t.Errorf("Truncated = %v, len = %d", result.Truncated, len(result.Vulnerabilities))
+	}
+	if result.Summary.High != MaxVulnerabilities+5 {
+		t.Errorf("Summary.High = %d, want %d", result.Summary.High, MaxVulnerabilities+5)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	if _, err := Parse([]byte(`{"foo": 1}`)); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("Parse(unknown) error = %v, want ErrUnknownFormat", err)
+	}
+	if _, err := Parse([]byte(`not json`)); err == nil {
+		t.Error("Parse(invalid) expected an error")
+	}
+}
+
+func TestNormalizeSeverity(t *testing.T) {
+	tests := map[string]openchoreov1alpha1.VulnerabilitySeverity{
+		"CRITICAL":   openchoreov1alpha1.VulnerabilitySeverityCritical,
+		"High":       openchoreov1alpha1.VulnerabilitySeverityHigh,
+		"medium":     openchoreov1alpha1.VulnerabilitySeverityMedium,
+		"Negligible": openchoreov1alpha1.VulnerabilitySeverityLow,
+		"":           openchoreov1alpha1.VulnerabilitySeverityUnknown,
+	}
+	for in, want := range tests {
+		if got := NormalizeSeverity(in); got != want {
+			t.Errorf("NormalizeSeverity(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -72,3 +72,8 @@ func GetMetadata(ctx context.Context) map[string]any {
 	}
 	return nil
 }
+
+// IsEnabled reports whether the request in ctx is being audited
+func IsEnabled(ctx context.Context) bool {
+	return getAuditData(ctx) != nil
+}
@@ -14,13 +14,15 @@ import (
 type Logger struct {
 	slogger     *slog.Logger
 	serviceName string
+	sinks       []Sink
 }
 
-// NewLogger creates a new audit logger
-func NewLogger(slogger *slog.Logger, serviceName string) *Logger {
+// NewLogger creates a new audit logger. Every event is also written to the given sinks.
+func NewLogger(slogger *slog.Logger, serviceName string, sinks ...Sink) *Logger {
 	return &Logger{
 		slogger:     slogger,
 		serviceName: serviceName,
+		sinks:       sinks,
 	}
 }
 
@@ -88,6 +90,9 @@ func (l *Logger) LogEvent(event *Event) {
 		if event.Resource.Name != "" {
 			resourceAttrs = append(resourceAttrs, slog.String("name", event.Resource.Name))
 		}
+		if event.Resource.Namespace != "" {
+			resourceAttrs = append(resourceAttrs, slog.String("namespace", event.Resource.Namespace))
+		}
 		attrs = append(attrs, slog.Group("resource", resourceAttrs...))
 	}
 
@@ -102,4 +107,12 @@ func (l *Logger) LogEvent(event *Event) {
 
 	// Emit the audit log
 	l.slogger.Info("AUDIT-LOG", attrs...)
+
+	// Forward the event to the configured sinks
+	for _, sink := range l.sinks {
+		if err := sink.Write(event); err != nil {
+			l.slogger.Error("Failed to write audit event to sink",
+				slog.String("event_id", event.EventID), slog.Any("error", err))
+		}
+	}
 }
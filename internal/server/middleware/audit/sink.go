@@ -0,0 +1,221 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// Sink receives every audit event emitted by a Logger
+type Sink interface {
+	// Write records a single event. Implementations must be safe for concurrent use.
+	Write(event *Event) error
+}
+
+// JSONSink writes each event as a single line of JSON to an io.Writer
+type JSONSink struct {
+	mu  sync.Mutex
+	w   io.Writer
+	enc *json.Encoder
+}
+
+// NewJSONSink creates a sink that writes JSON lines to w
+func NewJSONSink(w io.Writer) *JSONSink {
+	return &JSONSink{w: w, enc: json.NewEncoder(w)}
+}
+
+// NewFileSink creates a sink that appends JSON lines to the file at path,
+// creating it if needed
+func NewFileSink(path string) (*JSONSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log file: %w", err)
+	}
+	return NewJSONSink(f), nil
+}
+
+// Write encodes the event as a JSON line
+func (s *JSONSink) Write(event *Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.enc.Encode(event)
+}
+
+// Close closes the underlying writer if it is closable
+func (s *JSONSink) Close() error {
+	if c, ok := s.w.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// ErrWebhookQueueFull is returned when the webhook sink cannot keep up and drops an event
+var ErrWebhookQueueFull = errors.New("audit webhook queue is full, event dropped")
+
+// webhookQueueSize is the number of events buffered for delivery by a WebhookSink
+const webhookQueueSize = 1024
+
+// WebhookSink POSTs each event as JSON to an HTTP endpoint. Events are delivered
+// asynchronously so that a slow or unavailable receiver does not delay API requests;
+// when the delivery queue is full, new events are dropped.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+	queue  chan *Event
+	onErr  func(error)
+	done   chan struct{}
+	closer sync.Once
+}
+
+// NewWebhookSink creates a sink that delivers events to url. onErr, if not nil,
+// is called with delivery failures.
+func NewWebhookSink(url string, timeout time.Duration, onErr func(error)) *WebhookSink {
+	s := &WebhookSink{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+		queue:  make(chan *Event, webhookQueueSize),
+		onErr:  onErr,
+		done:   make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// Write queues the event for delivery
+func (s *WebhookSink) Write(event *Event) error {
+	select {
+	case s.queue <- event:
+		return nil
+	default:
+		return ErrWebhookQueueFull
+	}
+}
+
+// Close stops accepting events and waits until the queued events are delivered
+func (s *WebhookSink) Close() error {
+	s.closer.Do(func() { close(s.queue) })
+	<-s.done
+	return nil
+}
+
+func (s *WebhookSink) run() {
+	defer close(s.done)
+	for event := range s.queue {
+		if err := s.deliver(event); err != nil && s.onErr != nil {
+			s.onErr(err)
+		}
+	}
+}
+
+func (s *WebhookSink) deliver(event *Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit event: %w", err)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create audit webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to deliver audit event %s: %w", event.EventID, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("audit webhook rejected event %s with status %d", event.EventID, resp.StatusCode)
+	}
+	return nil
+}
+
+// Filter selects audit events. Empty fields match any value.
+type Filter struct {
+	// ActorID matches the ID of the actor that performed the action
+	ActorID string
+	// ResourceType matches the type of the target resource
+	ResourceType string
+	// ResourceName matches the name of the target resource
+	ResourceName string
+	// Namespace matches the namespace of the target resource
+	Namespace string
+	// Limit caps the number of returned events. 0 returns all matching events.
+	Limit int
+}
+
+// Matches reports whether the event satisfies the filter
+func (f Filter) Matches(event *Event) bool {
+	if f.ActorID != "" && event.Actor.ID != f.ActorID {
+		return false
+	}
+	if f.ResourceType == "" && f.ResourceName == "" && f.Namespace == "" {
+		return true
+	}
+	if event.Resource == nil {
+		return false
+	}
+	return (f.ResourceType == "" || event.Resource.Type == f.ResourceType) &&
+		(f.ResourceName == "" || event.Resource.Name == f.ResourceName) &&
+		(f.Namespace == "" || event.Resource.Namespace == f.Namespace)
+}
+
+// MemorySink keeps the most recent events in memory so they can be queried
+type MemorySink struct {
+	mu     sync.RWMutex
+	events []Event
+	next   int
+	full   bool
+}
+
+// NewMemorySink creates a sink that retains up to capacity events
+func NewMemorySink(capacity int) *MemorySink {
+	return &MemorySink{events: make([]Event, capacity)}
+}
+
+// Write stores a copy of the event, evicting the oldest one when the sink is full
+func (s *MemorySink) Write(event *Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.events) == 0 {
+		return nil
+	}
+	s.events[s.next] = *event
+	s.next = (s.next + 1) % len(s.events)
+	if s.next == 0 {
+		s.full = true
+	}
+	return nil
+}
+
+// Query returns the events matching filter, newest first
+func (s *MemorySink) Query(filter Filter) []Event {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	count := s.next
+	if s.full {
+		count = len(s.events)
+	}
+	result := make([]Event, 0)
+	for i := range count {
+		idx := (s.next - 1 - i + len(s.events)) % len(s.events)
+		if !filter.Matches(&s.events[idx]) {
+			continue
+		}
+		result = append(result, s.events[idx])
+		if filter.Limit > 0 && len(result) == filter.Limit {
+			break
+		}
+	}
+	return result
+}
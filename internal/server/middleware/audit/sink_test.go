@@ -0,0 +1,142 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLoggerWritesToSinks(t *testing.T) {
+	var buf bytes.Buffer
+	memory := NewMemorySink(10)
+	logger := NewLogger(slog.New(slog.NewJSONHandler(io.Discard, nil)), "test-service", NewJSONSink(&buf), memory)
+
+	logger.LogEvent(&Event{
+		Actor:    Actor{Type: "user", ID: "alice"},
+		Action:   "delete_resource",
+		Resource: &Resource{Type: "resource", Name: "db", Namespace: "default"},
+		Result:   ResultSuccess,
+	})
+
+	var written Event
+	if err := json.Unmarshal(buf.Bytes(), &written); err != nil {
+		t.Fatalf("JSON sink output is not an event: %v", err)
+	}
+	if written.EventID == "" || written.Service != "test-service" || written.Resource.Namespace != "default" {
+		t.Errorf("written event = %+v, want event ID, service and resource namespace set", written)
+	}
+	if events := memory.Query(Filter{}); len(events) != 1 || events[0].EventID != written.EventID {
+		t.Errorf("memory sink events = %+v, want the logged event", events)
+	}
+}
+
+func TestFileSinkAppends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	for _, id := range []string{"1", "2"} {
+		sink, err := NewFileSink(path)
+		if err != nil {
+			t.Fatalf("NewFileSink() error = %v", err)
+		}
+		if err := sink.Write(&Event{EventID: id}); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+		if err := sink.Close(); err != nil {
+			t.Fatalf("Close() error = %v", err)
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Split(strings.TrimSpace(string(data)), "\n"); len(lines) != 2 {
+		t.Errorf("file has %d lines, want 2:\n%s", len(lines), data)
+	}
+}
+
+func TestMemorySinkQuery(t *testing.T) {
+	sink := NewMemorySink(3)
+	events := []Event{
+		{EventID: "1", Actor: Actor{ID: "alice"}, Resource: &Resource{Type: "resource", Name: "db", Namespace: "a"}},
+		{EventID: "2", Actor: Actor{ID: "bob"}, Resource: &Resource{Type: "resource", Name: "db", Namespace: "b"}},
+		{EventID: "3", Actor: Actor{ID: "alice"}},
+		{EventID: "4", Actor: Actor{ID: "alice"}, Resource: &Resource{Type: "project", Name: "db", Namespace: "a"}},
+	}
+	for i := range events {
+		if err := sink.Write(&events[i]); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   []string
+	}{
+		{"oldest event is evicted and results are newest first", Filter{}, []string{"4", "3", "2"}},
+		{"by actor", Filter{ActorID: "alice"}, []string{"4", "3"}},
+		{"by resource", Filter{ResourceType: "resource", ResourceName: "db"}, []string{"2"}},
+		{"by namespace", Filter{Namespace: "a"}, []string{"4"}},
+		{"limit", Filter{Limit: 2}, []string{"4", "3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, e := range sink.Query(tt.filter) {
+				got = append(got, e.EventID)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("Query() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookSinkDelivers(t *testing.T) {
+	var mu sync.Mutex
+	var received []Event
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var e Event
+		if err := json.NewDecoder(r.Body).Decode(&e); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		received = append(received, e)
+		mu.Unlock()
+		if e.EventID == "rejected" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	var errs []error
+	sink := NewWebhookSink(server.URL, time.Second, func(err error) { errs = append(errs, err) })
+	for _, id := range []string{"1", "rejected"} {
+		if err := sink.Write(&Event{EventID: id}); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	if len(received) != 2 || received[0].EventID != "1" {
+		t.Errorf("received = %+v, want both events in order", received)
+	}
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "status 500") {
+		t.Errorf("delivery errors = %v, want one rejection", errs)
+	}
+}
@@ -25,9 +25,10 @@ const (
 
 // Resource represents the target resource of an action
 type Resource struct {
-	Type string `json:"type"`           // e.g., "project", "component", "environment"
-	ID   string `json:"id,omitempty"`   // Resource identifier
-	Name string `json:"name,omitempty"` // Resource name (if different from ID)
+	Type      string `json:"type"`                // e.g., "project", "component", "environment"
+	ID        string `json:"id,omitempty"`        // Resource identifier
+	Name      string `json:"name,omitempty"`      // Resource name (if different from ID)
+	Namespace string `json:"namespace,omitempty"` // Namespace of namespace-scoped resources
 }
 
 // Result represents the outcome of an action
@@ -0,0 +1,139 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCUnaryInterceptor adapts an HTTP authentication middleware (e.g., jwt.Middleware)
+// to unary gRPC calls, so gRPC and REST requests are authenticated by the same code.
+// The authorization metadata of the call is presented to the middleware as the
+// Authorization header, and the context the middleware produces (carrying the
+// token, claims and SubjectContext) becomes the context of the call.
+//
+// Methods whose full name starts with one of publicMethodPrefixes
+// (e.g., "/grpc.health.v1.Health/") skip authentication.
+func GRPCUnaryInterceptor(
+	authMiddleware func(http.Handler) http.Handler,
+	publicMethodPrefixes ...string,
+) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if isPublicMethod(info.FullMethod, publicMethodPrefixes) {
+			return handler(ctx, req)
+		}
+		authCtx, err := authenticateGRPC(ctx, info.FullMethod, authMiddleware)
+		if err != nil {
+			return nil, err
+		}
+		return handler(authCtx, req)
+	}
+}
+
+// GRPCStreamInterceptor is the streaming counterpart of GRPCUnaryInterceptor.
+func GRPCStreamInterceptor(
+	authMiddleware func(http.Handler) http.Handler,
+	publicMethodPrefixes ...string,
+) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if isPublicMethod(info.FullMethod, publicMethodPrefixes) {
+			return handler(srv, ss)
+		}
+		authCtx, err := authenticateGRPC(ss.Context(), info.FullMethod, authMiddleware)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: authCtx})
+	}
+}
+
+// authenticatedStream replaces the context of a server stream.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+func isPublicMethod(fullMethod string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// authenticateGRPC runs authMiddleware on a request built from the call's
+// metadata and returns the context it passed on. A rejected request is mapped
+// to Unauthenticated, or to Internal when the middleware failed for another reason.
+func authenticateGRPC(
+	ctx context.Context,
+	fullMethod string,
+	authMiddleware func(http.Handler) http.Handler,
+) (context.Context, error) {
+	req := (&http.Request{
+		Method: http.MethodPost,
+		URL:    &url.URL{Path: fullMethod},
+		Header: http.Header{},
+	}).WithContext(ctx)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, v := range md.Get("authorization") {
+			req.Header.Add("Authorization", v)
+		}
+	}
+
+	var authCtx context.Context
+	rec := &authResponseRecorder{header: http.Header{}, statusCode: http.StatusOK}
+	authMiddleware(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		authCtx = r.Context()
+	})).ServeHTTP(rec, req)
+	if authCtx != nil {
+		return authCtx, nil
+	}
+
+	var body struct {
+		Message string `json:"message"`
+	}
+	_ = json.Unmarshal(rec.body.Bytes(), &body)
+	if rec.statusCode == http.StatusUnauthorized {
+		msg := body.Message
+		if msg == "" {
+			msg = "authentication required"
+		}
+		return nil, status.Error(codes.Unauthenticated, msg)
+	}
+	return nil, status.Error(codes.Internal, "server error occurred while authenticating the request")
+}
+
+// authResponseRecorder captures the response of a rejected authentication.
+type authResponseRecorder struct {
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (r *authResponseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *authResponseRecorder) WriteHeader(code int) {
+	r.statusCode = code
+}
+
+func (r *authResponseRecorder) Write(b []byte) (int, error) {
+	return r.body.Write(b)
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// tokenMiddleware accepts requests whose Authorization header is "Bearer good"
+// and stores a SubjectContext for them.
+func tokenMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("Authorization") {
+		case "Bearer good":
+			ctx := SetSubjectContext(r.Context(), &SubjectContext{ID: "alice"})
+			next.ServeHTTP(w, r.WithContext(ctx))
+		case "Bearer broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "INVALID_TOKEN", "message": "invalid token"})
+		}
+	})
+}
+
+func incomingContext(authorization string) context.Context {
+	md := metadata.MD{}
+	if authorization != "" {
+		md.Set("authorization", authorization)
+	}
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestGRPCUnaryInterceptor(t *testing.T) {
+	interceptor := GRPCUnaryInterceptor(tokenMiddleware, "/grpc.health.v1.Health/")
+	var subject *SubjectContext
+	handler := func(ctx context.Context, _ any) (any, error) {
+		subject, _ = GetSubjectContextFromContext(ctx)
+		return "ok", nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/openchoreo.v1alpha1.ResourceService/GetResource"}
+
+	t.Run("valid token", func(t *testing.T) {
+		resp, err := interceptor(incomingContext("Bearer good"), nil, info, handler)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+		require.NotNil(t, subject)
+		assert.Equal(t, "alice", subject.ID)
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		_, err := interceptor(incomingContext(""), nil, info, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Equal(t, "invalid token", status.Convert(err).Message())
+	})
+
+	t.Run("middleware failure", func(t *testing.T) {
+		_, err := interceptor(incomingContext("Bearer broken"), nil, info, handler)
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("public method", func(t *testing.T) {
+		_, err := interceptor(incomingContext(""), nil, &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}, handler)
+		require.NoError(t, err)
+	})
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestGRPCStreamInterceptor(t *testing.T) {
+	interceptor := GRPCStreamInterceptor(tokenMiddleware)
+	info := &grpc.StreamServerInfo{FullMethod: "/openchoreo.v1alpha1.ResourceService/WatchResources"}
+
+	var subject *SubjectContext
+	handler := func(_ any, ss grpc.ServerStream) error {
+		subject, _ = GetSubjectContextFromContext(ss.Context())
+		return nil
+	}
+
+	require.NoError(t, interceptor(nil, &fakeServerStream{ctx: incomingContext("Bearer good")}, info, handler))
+	require.NotNil(t, subject)
+	assert.Equal(t, "alice", subject.ID)
+
+	err := interceptor(nil, &fakeServerStream{ctx: incomingContext("Bearer bad")}, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
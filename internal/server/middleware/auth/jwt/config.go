@@ -25,6 +25,11 @@ type Config struct {
 	// This is the primary method for key management in production environments
 	JWKSURL string
 
+	// OIDCIssuerURL enables OpenID Connect discovery of the JWKS URL
+	// If set and JWKSURL is empty, the JWKS URL is read from the jwks_uri field of
+	// the issuer's /.well-known/openid-configuration document when keys are first needed
+	OIDCIssuerURL string
+
 	// JWKSRefreshInterval defines how often to refresh the JWKS from the URL
 	// Default: 1 hour
 	JWKSRefreshInterval time.Duration
@@ -32,7 +37,7 @@ type Config struct {
 	// SigningKey is an alternative to JWKS for simpler scenarios
 	// For HMAC algorithms (HS256, HS384, HS512), this should be a []byte
 	// For RSA algorithms (RS256, RS384, RS512), this should be a *rsa.PublicKey
-	// Note: If JWKSURL is provided, this field is ignored
+	// Note: If JWKSURL or OIDCIssuerURL is provided, this field is ignored
 	SigningKey interface{}
 
 	// TokenLookup defines where to extract the JWT token from the request
@@ -120,9 +125,9 @@ func (c *Config) validate() error {
 		return nil
 	}
 
-	// Either JWKS URL or signing key must be provided
-	if c.JWKSURL == "" && c.SigningKey == nil {
-		return fmt.Errorf("configuration error: either JWKSURL or SigningKey must be provided")
+	// A JWKS URL, an issuer to discover it from, or a signing key must be provided
+	if c.JWKSURL == "" && c.OIDCIssuerURL == "" && c.SigningKey == nil {
+		return fmt.Errorf("configuration error: one of JWKSURL, OIDCIssuerURL or SigningKey must be provided")
 	}
 
 	return nil
@@ -4,6 +4,9 @@
 package jwt
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rsa"
 	"encoding/json"
 	"errors"
@@ -11,6 +14,7 @@ import (
 	"log/slog"
 	"math/big"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -24,6 +28,9 @@ type JWK struct {
 	Use string   `json:"use"`
 	N   string   `json:"n"`
 	E   string   `json:"e"`
+	Crv string   `json:"crv,omitempty"`
+	X   string   `json:"x,omitempty"`
+	Y   string   `json:"y,omitempty"`
 	Alg string   `json:"alg"`
 	X5c []string `json:"x5c,omitempty"`
 }
@@ -33,10 +40,19 @@ type JWKS struct {
 	Keys []JWK `json:"keys"`
 }
 
-// cachedJWK holds a JWK and its parsed RSA public key
+// unknownKeyRefreshInterval is the minimum time between JWKS refreshes triggered
+// by tokens signed with a key that is not in the cache. It lets rotated keys be
+// picked up without waiting for the regular refresh interval, while preventing
+// tokens with made-up key IDs from hammering the JWKS endpoint.
+const unknownKeyRefreshInterval = time.Minute
+
+// oidcDiscoveryPath is the OpenID Connect discovery document path, relative to the issuer.
+const oidcDiscoveryPath = "/.well-known/openid-configuration"
+
+// cachedJWK holds a JWK and its parsed public key (*rsa.PublicKey or *ecdsa.PublicKey)
 type cachedJWK struct {
 	JWK       JWK
-	PublicKey *rsa.PublicKey
+	PublicKey crypto.PublicKey
 }
 
 // jwksCache holds the cached JWKS and related metadata
@@ -45,13 +61,14 @@ type jwksCache struct {
 	keys            map[string]*cachedJWK
 	lastRefresh     time.Time
 	jwksURL         string
+	issuerURL       string
 	refreshInterval time.Duration
 	httpClient      *http.Client
 	logger          *slog.Logger
 }
 
 // getKey retrieves a public key from the cache by key ID and validates the algorithm
-func (c *jwksCache) getKey(kid string, tokenAlg string) (*rsa.PublicKey, error) {
+func (c *jwksCache) getKey(kid string, tokenAlg string) (crypto.PublicKey, error) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
@@ -68,16 +85,41 @@ func (c *jwksCache) getKey(kid string, tokenAlg string) (*rsa.PublicKey, error)
 	return cached.PublicKey, nil
 }
 
+// hasKey reports whether a key with the given key ID is cached
+func (c *jwksCache) hasKey(kid string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	_, exists := c.keys[kid]
+	return exists
+}
+
 // refresh fetches the JWKS from the URL and updates the cache
 func (c *jwksCache) refresh() error {
+	return c.refreshIfOlderThan(c.refreshInterval)
+}
+
+// refreshIfOlderThan refreshes the cache if the last successful refresh is older than maxAge
+func (c *jwksCache) refreshIfOlderThan(maxAge time.Duration) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	// Check if we need to refresh
-	if time.Since(c.lastRefresh) < c.refreshInterval {
+	if time.Since(c.lastRefresh) < maxAge {
 		return nil
 	}
 
+	// Discover the JWKS URL on first use, so the identity provider does not
+	// have to be reachable when the server starts
+	if c.jwksURL == "" {
+		jwksURL, err := discoverJWKSURL(c.httpClient, c.issuerURL)
+		if err != nil {
+			return err
+		}
+		c.logger.Info("Discovered JWKS URL from OIDC issuer", "issuer", c.issuerURL, "url", jwksURL)
+		c.jwksURL = jwksURL
+	}
+
 	c.logger.Debug("Refreshing JWKS", "url", c.jwksURL)
 
 	resp, err := c.httpClient.Get(c.jwksURL)
@@ -98,12 +140,17 @@ func (c *jwksCache) refresh() error {
 	// Parse and store keys
 	newKeys := make(map[string]*cachedJWK)
 	for _, jwk := range jwks.Keys {
-		if jwk.Kty != "RSA" {
-			c.logger.Debug("Skipping non-RSA key", "kid", jwk.Kid, "kty", jwk.Kty)
+		var key crypto.PublicKey
+		var err error
+		switch jwk.Kty {
+		case "RSA":
+			key, err = parseRSAPublicKeyFromJWK(&jwk)
+		case "EC":
+			key, err = parseECPublicKeyFromJWK(&jwk)
+		default:
+			c.logger.Debug("Skipping unsupported key type", "kid", jwk.Kid, "kty", jwk.Kty)
 			continue
 		}
-
-		key, err := parseRSAPublicKeyFromJWK(&jwk)
 		if err != nil {
 			c.logger.Warn("Failed to parse JWK", "kid", jwk.Kid, "error", err)
 			continue
@@ -116,7 +163,7 @@ func (c *jwksCache) refresh() error {
 	}
 
 	if len(newKeys) == 0 {
-		return errors.New("no valid RSA keys found in JWKS")
+		return errors.New("no valid RSA or EC keys found in JWKS")
 	}
 
 	c.keys = newKeys
@@ -169,3 +216,73 @@ func parseRSAPublicKeyFromJWK(jwk *JWK) (*rsa.PublicKey, error) {
 		E: e,
 	}, nil
 }
+
+// parseECPublicKeyFromJWK parses an ECDSA public key from a JWK
+func parseECPublicKeyFromJWK(jwk *JWK) (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch jwk.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve '%s'", jwk.Crv)
+	}
+
+	xBytes, err := jwt.NewParser().DecodeSegment(jwk.X)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode x coordinate: %w", err)
+	}
+	yBytes, err := jwt.NewParser().DecodeSegment(jwk.Y)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode y coordinate: %w", err)
+	}
+
+	// Build the uncompressed point encoding (0x04 || X || Y) so the point is
+	// checked to be on the curve while parsing
+	size := (curve.Params().BitSize + 7) / 8
+	if len(xBytes) > size || len(yBytes) > size {
+		return nil, errors.New("coordinate too long for curve")
+	}
+	point := make([]byte, 1+2*size)
+	point[0] = 4
+	copy(point[1+size-len(xBytes):1+size], xBytes)
+	copy(point[1+2*size-len(yBytes):], yBytes)
+
+	return ecdsa.ParseUncompressedPublicKey(curve, point)
+}
+
+// discoverJWKSURL reads the jwks_uri of an OpenID Connect provider from its discovery document
+func discoverJWKSURL(httpClient *http.Client, issuer string) (string, error) {
+	discoveryURL := strings.TrimSuffix(issuer, "/") + oidcDiscoveryPath
+
+	resp, err := httpClient.Get(discoveryURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch OIDC discovery document: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("OIDC discovery endpoint returned status %d", resp.StatusCode)
+	}
+
+	var doc struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return "", fmt.Errorf("failed to decode OIDC discovery document: %w", err)
+	}
+
+	// OpenID Connect Discovery 1.0 §4.3: the issuer in the document must match
+	if strings.TrimSuffix(doc.Issuer, "/") != strings.TrimSuffix(issuer, "/") {
+		return "", fmt.Errorf("OIDC discovery document issuer '%s' does not match '%s'", doc.Issuer, issuer)
+	}
+	if doc.JWKSURI == "" {
+		return "", errors.New("OIDC discovery document has no jwks_uri")
+	}
+
+	return doc.JWKSURI, nil
+}
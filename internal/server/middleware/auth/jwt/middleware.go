@@ -72,12 +72,13 @@ func Middleware(config Config) func(http.Handler) http.Handler {
 		}
 	}
 
-	// Initialize JWKS cache if JWKS URL is provided
+	// Initialize JWKS cache if a JWKS URL is provided or can be discovered
 	var cache *jwksCache
-	if config.JWKSURL != "" {
+	if config.JWKSURL != "" || config.OIDCIssuerURL != "" {
 		cache = &jwksCache{
 			keys:            make(map[string]*cachedJWK),
 			jwksURL:         config.JWKSURL,
+			issuerURL:       config.OIDCIssuerURL,
 			refreshInterval: config.JWKSRefreshInterval,
 			httpClient:      config.HTTPClient,
 			logger:          config.Logger,
@@ -125,6 +126,13 @@ func Middleware(config Config) func(http.Handler) http.Handler {
 						config.Logger.Warn("Failed to refresh JWKS cache", "error", err)
 					}
 
+					// The signing key may have been rotated since the last refresh
+					if !cache.hasKey(kid) {
+						if err := cache.refreshIfOlderThan(unknownKeyRefreshInterval); err != nil {
+							config.Logger.Warn("Failed to refresh JWKS cache", "error", err)
+						}
+					}
+
 					return cache.getKey(kid, alg)
 				}
 
@@ -4,10 +4,15 @@
 package jwt
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -575,3 +580,206 @@ func TestMiddleware_DisabledWithInvalidToken(t *testing.T) {
 		t.Errorf("Expected status 200 when middleware is disabled (even with invalid token), got %d", w.Code)
 	}
 }
+
+// newJWKSServer serves an OIDC discovery document and a JWKS containing the
+// keys returned by keys. It counts the JWKS fetches in fetches.
+func newJWKSServer(t *testing.T, keys func() []JWK, fetches *atomic.Int32) *httptest.Server {
+	t.Helper()
+	var server *httptest.Server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   server.URL,
+			"jwks_uri": server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		_ = json.NewEncoder(w).Encode(JWKS{Keys: keys()})
+	})
+	server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func ecJWK(t *testing.T, kid string, key *ecdsa.PrivateKey) JWK {
+	t.Helper()
+	pub, err := key.PublicKey.Bytes()
+	if err != nil {
+		t.Fatalf("encode EC public key: %v", err)
+	}
+	size := (len(pub) - 1) / 2
+	return JWK{
+		Kid: kid,
+		Kty: "EC",
+		Alg: "ES256",
+		Crv: "P-256",
+		X:   base64.RawURLEncoding.EncodeToString(pub[1 : 1+size]),
+		Y:   base64.RawURLEncoding.EncodeToString(pub[1+size:]),
+	}
+}
+
+func signES256(t *testing.T, kid string, key *ecdsa.PrivateKey, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return signed
+}
+
+func serveWithToken(handler http.Handler, token string) int {
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	return w.Code
+}
+
+func TestMiddleware_OIDCDiscoveryWithECKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	var fetches atomic.Int32
+	server := newJWKSServer(t, func() []JWK { return []JWK{ecJWK(t, "k1", key)} }, &fetches)
+
+	handler := Middleware(Config{
+		OIDCIssuerURL:  server.URL,
+		ValidateIssuer: server.URL,
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	token := signES256(t, "k1", key, jwt.MapClaims{
+		"sub": "user123",
+		"iss": server.URL,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	if code := serveWithToken(handler, token); code != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", code)
+	}
+	if fetches.Load() != 1 {
+		t.Errorf("Expected 1 JWKS fetch, got %d", fetches.Load())
+	}
+}
+
+func TestMiddleware_OIDCDiscoveryIssuerMismatch(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	var fetches atomic.Int32
+	server := newJWKSServer(t, func() []JWK { return []JWK{ecJWK(t, "k1", key)} }, &fetches)
+
+	handler := Middleware(Config{
+		OIDCIssuerURL: server.URL + "/other",
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	token := signES256(t, "k1", key, jwt.MapClaims{"sub": "user123", "exp": time.Now().Add(time.Hour).Unix()})
+	if code := serveWithToken(handler, token); code != http.StatusUnauthorized {
+		t.Errorf("Expected status 401, got %d", code)
+	}
+	if fetches.Load() != 0 {
+		t.Errorf("Expected no JWKS fetch after a failed discovery, got %d", fetches.Load())
+	}
+}
+
+func TestMiddleware_UnknownKeyRefreshIsThrottled(t *testing.T) {
+	oldKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	newKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	var rotated atomic.Bool
+	var fetches atomic.Int32
+	server := newJWKSServer(t, func() []JWK {
+		if rotated.Load() {
+			return []JWK{ecJWK(t, "new", newKey)}
+		}
+		return []JWK{ecJWK(t, "old", oldKey)}
+	}, &fetches)
+
+	handler := Middleware(Config{JWKSURL: server.URL + "/keys"})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	claims := jwt.MapClaims{"sub": "user123", "exp": time.Now().Add(time.Hour).Unix()}
+
+	if code := serveWithToken(handler, signES256(t, "old", oldKey, claims)); code != http.StatusOK {
+		t.Fatalf("Expected status 200 for the initial key, got %d", code)
+	}
+
+	// Tokens with an unknown key ID do not refresh again within the minimum interval.
+	rotated.Store(true)
+	if code := serveWithToken(handler, signES256(t, "new", newKey, claims)); code != http.StatusUnauthorized {
+		t.Errorf("Expected status 401 before the refresh interval elapsed, got %d", code)
+	}
+	if fetches.Load() != 1 {
+		t.Errorf("Expected 1 JWKS fetch, got %d", fetches.Load())
+	}
+}
+
+func TestJWKSCache_RefreshPicksUpRotatedKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	var rotated atomic.Bool
+	var fetches atomic.Int32
+	server := newJWKSServer(t, func() []JWK {
+		if rotated.Load() {
+			return []JWK{ecJWK(t, "new", key)}
+		}
+		return []JWK{ecJWK(t, "old", key)}
+	}, &fetches)
+
+	config := Config{OIDCIssuerURL: server.URL}
+	config.setDefaults()
+	cache := &jwksCache{
+		keys:            make(map[string]*cachedJWK),
+		issuerURL:       server.URL,
+		refreshInterval: config.JWKSRefreshInterval,
+		httpClient:      config.HTTPClient,
+		logger:          config.Logger,
+	}
+	if err := cache.refresh(); err != nil {
+		t.Fatalf("refresh() error = %v", err)
+	}
+
+	rotated.Store(true)
+	cache.lastRefresh = time.Now().Add(-2 * unknownKeyRefreshInterval)
+	if err := cache.refreshIfOlderThan(unknownKeyRefreshInterval); err != nil {
+		t.Fatalf("refreshIfOlderThan() error = %v", err)
+	}
+	if !cache.hasKey("new") || cache.hasKey("old") {
+		t.Error("expected the cache to hold only the rotated key")
+	}
+}
+
+func TestParseECPublicKeyFromJWK(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	jwk := ecJWK(t, "k1", key)
+
+	parsed, err := parseECPublicKeyFromJWK(&jwk)
+	if err != nil {
+		t.Fatalf("parseECPublicKeyFromJWK() error = %v", err)
+	}
+	if !parsed.Equal(&key.PublicKey) {
+		t.Error("parsed key does not match the original key")
+	}
+
+	jwk.Crv = "P-192"
+	if _, err := parseECPublicKeyFromJWK(&jwk); err == nil {
+		t.Error("expected an error for an unsupported curve")
+	}
+}
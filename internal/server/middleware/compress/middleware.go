@@ -0,0 +1,128 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package compress provides an HTTP middleware that gzip-compresses large
+// responses for clients that accept it.
+package compress
+
+import (
+	"bytes"
+	"compress/gzip"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Config defines which responses are compressed.
+type Config struct {
+	// Enabled turns the middleware on. When false the middleware is a no-op.
+	Enabled bool
+	// MinSize is the size in bytes from which responses are compressed.
+	MinSize int
+}
+
+// Middleware returns a middleware that gzip-compresses successful text and
+// JSON responses of at least cfg.MinSize bytes when the request accepts gzip.
+// Responses are buffered, so the middleware must not run in front of
+// streaming handlers.
+//
+// Strong ETags of compressed responses are made weak, since the compressed
+// bytes differ from the representation the ETag was computed for.
+func Middleware(cfg Config) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !cfg.Enabled {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Add("Vary", "Accept-Encoding")
+			if r.Method == http.MethodHead || !acceptsGzip(r.Header.Get("Accept-Encoding")) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			rec := &bufferedResponseWriter{header: w.Header(), statusCode: http.StatusOK}
+			next.ServeHTTP(rec, r)
+
+			body := rec.body.Bytes()
+			if !compressible(rec.statusCode, w.Header(), len(body), cfg.MinSize) {
+				w.WriteHeader(rec.statusCode)
+				_, _ = w.Write(body)
+				return
+			}
+
+			var compressed bytes.Buffer
+			zw := gzip.NewWriter(&compressed)
+			if _, err := zw.Write(body); err != nil || zw.Close() != nil {
+				w.WriteHeader(rec.statusCode)
+				_, _ = w.Write(body)
+				return
+			}
+			w.Header().Set("Content-Encoding", "gzip")
+			w.Header().Set("Content-Length", strconv.Itoa(compressed.Len()))
+			if tag := w.Header().Get("ETag"); tag != "" && !strings.HasPrefix(tag, "W/") {
+				w.Header().Set("ETag", "W/"+tag)
+			}
+			w.WriteHeader(rec.statusCode)
+			_, _ = w.Write(compressed.Bytes())
+		})
+	}
+}
+
+func compressible(status int, header http.Header, size, minSize int) bool {
+	if status < 200 || status >= 300 || status == http.StatusNoContent || size < minSize {
+		return false
+	}
+	if header.Get("Content-Encoding") != "" {
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+	return strings.HasPrefix(mediaType, "text/") || mediaType == "application/json" ||
+		strings.HasSuffix(mediaType, "+json") || mediaType == "application/yaml"
+}
+
+// acceptsGzip reports whether the Accept-Encoding header value allows gzip.
+func acceptsGzip(acceptEncoding string) bool {
+	for coding := range strings.SplitSeq(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(coding, ";")
+		name = strings.TrimSpace(name)
+		if name != "gzip" && name != "*" {
+			continue
+		}
+		if q, ok := strings.CutPrefix(strings.ReplaceAll(params, " ", ""), "q="); ok {
+			if v, err := strconv.ParseFloat(q, 64); err == nil && v == 0 {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// bufferedResponseWriter holds a response so that it can be compressed before
+// it is written.
+type bufferedResponseWriter struct {
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+	written    bool
+}
+
+func (rw *bufferedResponseWriter) Header() http.Header {
+	return rw.header
+}
+
+func (rw *bufferedResponseWriter) WriteHeader(code int) {
+	if !rw.written {
+		rw.statusCode = code
+		rw.written = true
+	}
+}
+
+func (rw *bufferedResponseWriter) Write(b []byte) (int, error) {
+	rw.written = true
+	return rw.body.Write(b)
+}
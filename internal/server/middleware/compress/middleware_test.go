@@ -0,0 +1,78 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package compress
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHandler(cfg Config, status int, contentType, body string) http.Handler {
+	return Middleware(cfg)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("ETag", `"abc"`)
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, body)
+	}))
+}
+
+func serve(handler http.Handler, acceptEncoding string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/namespaces/default/componenttypes/web/schema", nil)
+	if acceptEncoding != "" {
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMiddleware(t *testing.T) {
+	cfg := Config{Enabled: true, MinSize: 64}
+	large := `{"schema":"` + strings.Repeat("x", 100) + `"}`
+
+	t.Run("large responses are compressed", func(t *testing.T) {
+		rec := serve(newTestHandler(cfg, http.StatusOK, "application/json", large), "br, gzip")
+		assert.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+		assert.Equal(t, "Accept-Encoding", rec.Header().Get("Vary"))
+		assert.Equal(t, `W/"abc"`, rec.Header().Get("ETag"))
+
+		zr, err := gzip.NewReader(rec.Body)
+		require.NoError(t, err)
+		body, err := io.ReadAll(zr)
+		require.NoError(t, err)
+		assert.Equal(t, large, string(body))
+	})
+
+	tests := []struct {
+		name           string
+		cfg            Config
+		status         int
+		contentType    string
+		body           string
+		acceptEncoding string
+	}{
+		{name: "gzip not accepted", cfg: cfg, status: http.StatusOK, contentType: "application/json", body: large},
+		{name: "gzip refused", cfg: cfg, status: http.StatusOK, contentType: "application/json", body: large, acceptEncoding: "gzip;q=0"},
+		{name: "small response", cfg: cfg, status: http.StatusOK, contentType: "application/json", body: `{}`, acceptEncoding: "gzip"},
+		{name: "error response", cfg: cfg, status: http.StatusNotFound, contentType: "application/json", body: large, acceptEncoding: "gzip"},
+		{name: "binary response", cfg: cfg, status: http.StatusOK, contentType: "application/octet-stream", body: large, acceptEncoding: "gzip"},
+		{name: "disabled", cfg: Config{MinSize: 64}, status: http.StatusOK, contentType: "application/json", body: large, acceptEncoding: "gzip"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+" is not compressed", func(t *testing.T) {
+			rec := serve(newTestHandler(tt.cfg, tt.status, tt.contentType, tt.body), tt.acceptEncoding)
+			assert.Equal(t, tt.status, rec.Code)
+			assert.Empty(t, rec.Header().Get("Content-Encoding"))
+			assert.Equal(t, `"abc"`, rec.Header().Get("ETag"))
+			assert.Equal(t, tt.body, rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cors provides an HTTP middleware that handles Cross-Origin Resource
+// Sharing for browser clients served from other origins.
+package cors
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AnyOrigin is the allowed origin that matches every origin.
+const AnyOrigin = "*"
+
+// Config defines which cross-origin requests browsers may make.
+type Config struct {
+	// AllowedOrigins lists the origins, such as https://console.example.com,
+	// that may call the server. AnyOrigin allows every origin. When empty the
+	// middleware is a no-op.
+	AllowedOrigins []string
+	// AllowedMethods lists the methods cross-origin requests may use.
+	AllowedMethods []string
+	// AllowedHeaders lists the request headers cross-origin requests may send.
+	AllowedHeaders []string
+	// ExposedHeaders lists the response headers browsers expose to scripts.
+	ExposedHeaders []string
+	// AllowCredentials lets browsers send cookies and Authorization headers.
+	// Browsers ignore it for AnyOrigin.
+	AllowCredentials bool
+	// MaxAge is how long browsers may cache the result of a preflight request.
+	MaxAge time.Duration
+}
+
+// Middleware returns a middleware that sets the CORS headers of requests from
+// allowed origins and answers their preflight requests with 204 No Content.
+// Requests from other origins pass through without CORS headers, so browsers
+// block them.
+func Middleware(cfg Config) func(http.Handler) http.Handler {
+	anyOrigin := slices.Contains(cfg.AllowedOrigins, AnyOrigin)
+	allowed := make(map[string]bool, len(cfg.AllowedOrigins))
+	for _, o := range cfg.AllowedOrigins {
+		allowed[strings.TrimSuffix(o, "/")] = true
+	}
+	methods := strings.Join(cfg.AllowedMethods, ", ")
+	headers := strings.Join(cfg.AllowedHeaders, ", ")
+	exposed := strings.Join(cfg.ExposedHeaders, ", ")
+	maxAge := strconv.Itoa(int(cfg.MaxAge.Seconds()))
+
+	return func(next http.Handler) http.Handler {
+		if len(allowed) == 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Add("Vary", "Origin")
+			origin := r.Header.Get("Origin")
+			if origin == "" || (!anyOrigin && !allowed[origin]) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			h := w.Header()
+			if anyOrigin {
+				h.Set("Access-Control-Allow-Origin", AnyOrigin)
+			} else {
+				h.Set("Access-Control-Allow-Origin", origin)
+			}
+			if cfg.AllowCredentials {
+				h.Set("Access-Control-Allow-Credentials", "true")
+			}
+
+			// Handle CORS preflight requests only when both Origin and
+			// Access-Control-Request-Method headers are present.
+			if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+				h.Add("Vary", "Access-Control-Request-Method")
+				h.Add("Vary", "Access-Control-Request-Headers")
+				h.Set("Access-Control-Allow-Methods", methods)
+				h.Set("Access-Control-Allow-Headers", headers)
+				if cfg.MaxAge > 0 {
+					h.Set("Access-Control-Max-Age", maxAge)
+				}
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			if exposed != "" {
+				h.Set("Access-Control-Expose-Headers", exposed)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
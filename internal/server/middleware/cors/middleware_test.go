@@ -0,0 +1,83 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cors
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var okHandler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+})
+
+func serve(cfg Config, method, origin string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/api/v1/namespaces", nil)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if origin != "" {
+		req.Header.Set("Origin", origin)
+	}
+	rec := httptest.NewRecorder()
+	Middleware(cfg)(okHandler).ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMiddleware(t *testing.T) {
+	cfg := Config{
+		AllowedOrigins:   []string{"https://console.example.com"},
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   []string{"Authorization", "Content-Type"},
+		ExposedHeaders:   []string{"ETag"},
+		AllowCredentials: true,
+		MaxAge:           time.Hour,
+	}
+
+	t.Run("allowed origin", func(t *testing.T) {
+		rec := serve(cfg, http.MethodGet, "https://console.example.com", nil)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "https://console.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+		assert.Equal(t, "true", rec.Header().Get("Access-Control-Allow-Credentials"))
+		assert.Equal(t, "ETag", rec.Header().Get("Access-Control-Expose-Headers"))
+		assert.Equal(t, "Origin", rec.Header().Get("Vary"))
+	})
+
+	t.Run("other origin", func(t *testing.T) {
+		rec := serve(cfg, http.MethodGet, "https://evil.example.com", nil)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+	})
+
+	t.Run("preflight", func(t *testing.T) {
+		rec := serve(cfg, http.MethodOptions, "https://console.example.com",
+			http.Header{"Access-Control-Request-Method": {"POST"}})
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, "GET, POST", rec.Header().Get("Access-Control-Allow-Methods"))
+		assert.Equal(t, "Authorization, Content-Type", rec.Header().Get("Access-Control-Allow-Headers"))
+		assert.Equal(t, "3600", rec.Header().Get("Access-Control-Max-Age"))
+	})
+
+	t.Run("preflight from other origin passes through", func(t *testing.T) {
+		rec := serve(cfg, http.MethodOptions, "https://evil.example.com",
+			http.Header{"Access-Control-Request-Method": {"POST"}})
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get("Access-Control-Allow-Methods"))
+	})
+}
+
+func TestMiddleware_AnyOrigin(t *testing.T) {
+	rec := serve(Config{AllowedOrigins: []string{AnyOrigin}}, http.MethodGet, "https://app.example.com", nil)
+	assert.Equal(t, "*", rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestMiddleware_Disabled(t *testing.T) {
+	rec := serve(Config{}, http.MethodGet, "https://console.example.com", nil)
+	assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+	assert.Empty(t, rec.Header().Get("Vary"))
+}
@@ -0,0 +1,180 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+const (
+	// HeaderKey is the request header that carries the idempotency key.
+	HeaderKey = "Idempotency-Key"
+	// HeaderReplayed is set on responses replayed from the store.
+	HeaderReplayed = "Idempotent-Replayed"
+	// MaxKeyLength is the longest accepted idempotency key.
+	MaxKeyLength = 255
+
+	// maxStoredBodyBytes is the largest response body that is stored. Requests
+	// with larger responses can be retried, but run again.
+	maxStoredBodyBytes = 1 << 20
+)
+
+// response is a stored HTTP response.
+type response struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+// errorResponse is the JSON body of a rejected request.
+type errorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// Middleware returns an HTTP middleware that runs POST, PUT, PATCH and DELETE
+// requests carrying an Idempotency-Key header at most once per key and
+// subject. Successful responses are stored and replayed to retries; failed
+// requests release their key so that they can be retried. It must run after
+// authentication, so that keys of different subjects do not collide.
+func Middleware(store *Store) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(HeaderKey)
+			if key == "" || !mutating(r.Method) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > MaxKeyLength {
+				writeError(w, http.StatusBadRequest, "INVALID_IDEMPOTENCY_KEY",
+					"Idempotency-Key must be at most 255 characters")
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "BAD_REQUEST", "failed to read request body")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			value, reservation, err := store.Begin(ScopedKey(r.Context(), "http", key), fingerprint(r, body))
+			switch {
+			case errors.Is(err, ErrInProgress):
+				writeError(w, http.StatusConflict, "IDEMPOTENCY_KEY_IN_USE", err.Error())
+				return
+			case errors.Is(err, ErrKeyReused):
+				writeError(w, http.StatusUnprocessableEntity, "IDEMPOTENCY_KEY_REUSED", err.Error())
+				return
+			case value != nil:
+				replay(w, value.(*response))
+				return
+			}
+
+			// The key is released when the handler panics, too.
+			rec := &recorder{ResponseWriter: w, status: http.StatusOK}
+			served := false
+			defer func() {
+				if served && rec.status >= 200 && rec.status < 300 && !rec.overflow {
+					if !rec.wroteHeader {
+						rec.header = w.Header().Clone()
+					}
+					reservation.Complete(&response{status: rec.status, header: rec.header, body: rec.body.Bytes()})
+				} else {
+					reservation.Release()
+				}
+			}()
+			next.ServeHTTP(rec, r)
+			served = true
+		})
+	}
+}
+
+// ScopedKey returns the store key of an idempotency key sent by the subject of
+// ctx through the given transport.
+func ScopedKey(ctx context.Context, transport, key string) string {
+	subject := "anonymous"
+	if sc, ok := auth.GetSubjectContextFromContext(ctx); ok && sc != nil {
+		subject = sc.Type + ":" + sc.ID
+	}
+	return transport + "\x00" + subject + "\x00" + key
+}
+
+func mutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// fingerprint identifies the request a key was used with.
+func fingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	for _, part := range []string{r.Method, r.URL.Path, r.URL.RawQuery} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func replay(w http.ResponseWriter, resp *response) {
+	for k, v := range resp.header {
+		w.Header()[k] = v
+	}
+	w.Header().Set(HeaderReplayed, "true")
+	w.WriteHeader(resp.status)
+	_, _ = w.Write(resp.body)
+}
+
+// recorder writes a response through while keeping a copy of it.
+type recorder struct {
+	http.ResponseWriter
+	status      int
+	header      http.Header
+	wroteHeader bool
+	body        bytes.Buffer
+	overflow    bool
+}
+
+func (r *recorder) WriteHeader(status int) {
+	if r.wroteHeader {
+		return
+	}
+	r.wroteHeader = true
+	r.status = status
+	r.header = r.ResponseWriter.Header().Clone()
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	if !r.wroteHeader {
+		r.WriteHeader(http.StatusOK)
+	}
+	if !r.overflow {
+		if r.body.Len()+len(b) > maxStoredBodyBytes {
+			r.overflow = true
+			r.body.Reset()
+		} else {
+			r.body.Write(b)
+		}
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(errorResponse{Error: code, Message: message})
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package idempotency
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/auth"
+)
+
+func TestMiddleware(t *testing.T) {
+	var calls atomic.Int32
+	status := http.StatusCreated
+	handler := Middleware(NewStore(time.Hour, 100))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = fmt.Fprintf(w, `{"call":%d,"body":%q}`, n, body)
+	}))
+
+	serve := func(method, path, key, subject, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if key != "" {
+			req.Header.Set(HeaderKey, key)
+		}
+		if subject != "" {
+			req = req.WithContext(auth.SetSubjectContext(context.Background(), &auth.SubjectContext{ID: subject, Type: "user"}))
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	first := serve(http.MethodPost, "/api/v1/resources", "k1", "alice", "x")
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Empty(t, first.Header().Get(HeaderReplayed))
+
+	t.Run("retry is replayed", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/resources", "k1", "alice", "x")
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, first.Body.String(), rec.Body.String())
+		assert.Equal(t, "true", rec.Header().Get(HeaderReplayed))
+		assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("key reused with a different body", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/resources", "k1", "alice", "y")
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		assert.Contains(t, rec.Body.String(), "IDEMPOTENCY_KEY_REUSED")
+	})
+
+	t.Run("keys are scoped to the subject", func(t *testing.T) {
+		before := calls.Load()
+		rec := serve(http.MethodPost, "/api/v1/resources", "k1", "bob", "x")
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, before+1, calls.Load())
+	})
+
+	t.Run("requests without a key always run", func(t *testing.T) {
+		before := calls.Load()
+		serve(http.MethodPost, "/api/v1/resources", "", "alice", "x")
+		serve(http.MethodPost, "/api/v1/resources", "", "alice", "x")
+		assert.Equal(t, before+2, calls.Load())
+	})
+
+	t.Run("reads are not deduplicated", func(t *testing.T) {
+		before := calls.Load()
+		serve(http.MethodGet, "/api/v1/resources", "k2", "alice", "")
+		serve(http.MethodGet, "/api/v1/resources", "k2", "alice", "")
+		assert.Equal(t, before+2, calls.Load())
+	})
+
+	t.Run("failed requests can be retried", func(t *testing.T) {
+		status = http.StatusInternalServerError
+		before := calls.Load()
+		serve(http.MethodDelete, "/api/v1/resources/db", "k3", "alice", "")
+		status = http.StatusNoContent
+		rec := serve(http.MethodDelete, "/api/v1/resources/db", "k3", "alice", "")
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, before+2, calls.Load())
+	})
+
+	t.Run("key too long", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/resources", strings.Repeat("k", MaxKeyLength+1), "alice", "x")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestMiddleware_InProgress(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handler := Middleware(NewStore(time.Hour, 100))(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		close(started)
+		<-release
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/workflow-runs", nil)
+		req.Header.Set(HeaderKey, "run-1")
+		return req
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.ServeHTTP(httptest.NewRecorder(), newRequest())
+	}()
+	<-started
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newRequest())
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Contains(t, rec.Body.String(), "IDEMPOTENCY_KEY_IN_USE")
+
+	close(release)
+	<-done
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, newRequest())
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, "true", rec.Header().Get(HeaderReplayed))
+}
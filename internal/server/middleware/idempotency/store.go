@@ -0,0 +1,148 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package idempotency lets clients retry mutating requests safely. A request
+// that carries an idempotency key runs once; retries with the same key get the
+// stored result of the first request instead of running again.
+package idempotency
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrInProgress is returned when a request with the same key is still
+	// running.
+	ErrInProgress = errors.New("a request with this idempotency key is still in progress")
+	// ErrKeyReused is returned when the key was used with a different request.
+	ErrKeyReused = errors.New("idempotency key was already used with a different request")
+)
+
+// Store holds the results of requests by idempotency key for a fixed time.
+// It is safe for concurrent use.
+type Store struct {
+	ttl        time.Duration
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	// order holds the entries oldest first.
+	order *list.List
+	now   func() time.Time
+}
+
+type entry struct {
+	key         string
+	fingerprint string
+	// storedAt is when the entry was reserved, or completed once done.
+	storedAt time.Time
+	done     bool
+	value    any
+}
+
+// Reservation is a key reserved by Begin for a request that is running. It
+// must be completed with the result of the request, or released when the
+// result should not be stored.
+type Reservation struct {
+	store *Store
+	el    *list.Element
+}
+
+// NewStore creates a Store that keeps results for ttl and at most maxEntries
+// keys, dropping the oldest first.
+func NewStore(ttl time.Duration, maxEntries int) *Store {
+	return &Store{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    map[string]*list.Element{},
+		order:      list.New(),
+		now:        time.Now,
+	}
+}
+
+// Begin looks up key for a request identified by fingerprint. It returns the
+// stored result when the key completed with the same fingerprint, and a
+// reservation when the key is new. It fails with ErrInProgress while the key
+// is reserved, and with ErrKeyReused when its fingerprint differs.
+func (s *Store) Begin(key, fingerprint string) (any, *Reservation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.expire(now)
+	if el, ok := s.entries[key]; ok {
+		e := el.Value.(*entry)
+		switch {
+		case e.fingerprint != fingerprint:
+			return nil, nil, ErrKeyReused
+		case !e.done:
+			return nil, nil, ErrInProgress
+		}
+		return e.value, nil, nil
+	}
+
+	for s.order.Len() >= s.maxEntries && s.order.Len() > 0 {
+		s.remove(s.order.Front())
+	}
+	el := s.order.PushBack(&entry{key: key, fingerprint: fingerprint, storedAt: now})
+	s.entries[key] = el
+	return nil, &Reservation{store: s, el: el}, nil
+}
+
+// Complete stores value as the result of the reserved key.
+func (r *Reservation) Complete(value any) {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.owns(r.el) {
+		// The entry was evicted while the request ran.
+		return
+	}
+	e := r.el.Value.(*entry)
+	e.done = true
+	e.value = value
+	e.storedAt = s.now()
+	s.order.MoveToBack(r.el)
+}
+
+// Release drops the reserved key so that the request can be retried.
+func (r *Reservation) Release() {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.owns(r.el) {
+		s.remove(r.el)
+	}
+}
+
+// expire drops the entries older than the TTL. Reserved entries are dropped
+// too, so that a request that never completes cannot hold its key forever. It
+// must be called with s.mu held.
+func (s *Store) expire(now time.Time) {
+	for el := s.order.Front(); el != nil; {
+		next := el.Next()
+		e := el.Value.(*entry)
+		if now.Sub(e.storedAt) >= s.ttl {
+			s.remove(el)
+		} else if e.done {
+			// Completed entries are ordered by storedAt, so the rest are newer.
+			return
+		}
+		el = next
+	}
+}
+
+func (s *Store) owns(el *list.Element) bool {
+	e := el.Value.(*entry)
+	return s.entries[e.key] == el
+}
+
+func (s *Store) remove(el *list.Element) {
+	delete(s.entries, el.Value.(*entry).key)
+	s.order.Remove(el)
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package idempotency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStore(ttl time.Duration, maxEntries int) (*Store, *time.Time) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	s := NewStore(ttl, maxEntries)
+	s.now = func() time.Time { return now }
+	return s, &now
+}
+
+func TestStore(t *testing.T) {
+	s, now := newTestStore(time.Hour, 10)
+
+	value, res, err := s.Begin("k", "a")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+	require.NotNil(t, res)
+
+	_, _, err = s.Begin("k", "a")
+	assert.ErrorIs(t, err, ErrInProgress)
+
+	res.Complete("result")
+	value, res2, err := s.Begin("k", "a")
+	require.NoError(t, err)
+	assert.Nil(t, res2)
+	assert.Equal(t, "result", value)
+
+	_, _, err = s.Begin("k", "b")
+	assert.ErrorIs(t, err, ErrKeyReused)
+
+	*now = now.Add(time.Hour)
+	value, res, err = s.Begin("k", "b")
+	require.NoError(t, err)
+	assert.Nil(t, value, "expired entries are dropped")
+	require.NotNil(t, res)
+}
+
+func TestStore_Release(t *testing.T) {
+	s, _ := newTestStore(time.Hour, 10)
+
+	_, res, err := s.Begin("k", "a")
+	require.NoError(t, err)
+	res.Release()
+
+	value, res, err := s.Begin("k", "a")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+	assert.NotNil(t, res)
+}
+
+func TestStore_MaxEntries(t *testing.T) {
+	s, now := newTestStore(time.Hour, 2)
+
+	for _, key := range []string{"a", "b", "c"} {
+		_, res, err := s.Begin(key, key)
+		require.NoError(t, err)
+		res.Complete(key)
+		*now = now.Add(time.Second)
+	}
+
+	value, res, err := s.Begin("a", "a")
+	require.NoError(t, err)
+	assert.Nil(t, value, "the oldest entry is evicted")
+	assert.NotNil(t, res)
+}
+
+func TestStore_EvictedReservation(t *testing.T) {
+	s, now := newTestStore(time.Minute, 10)
+
+	_, stale, err := s.Begin("k", "a")
+	require.NoError(t, err)
+
+	// A reservation that outlives the TTL is dropped, and completing it does
+	// not overwrite the entry of a newer request.
+	*now = now.Add(time.Minute)
+	_, res, err := s.Begin("k", "a")
+	require.NoError(t, err)
+	stale.Complete("stale")
+	res.Complete("fresh")
+
+	value, _, err := s.Begin("k", "a")
+	require.NoError(t, err)
+	assert.Equal(t, "fresh", value)
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package problem
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"mime"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// errorBody holds the members of the JSON error bodies written by handlers
+// ({"error": message, "code": code}) and by middleware ({"error": code,
+// "message": message}).
+type errorBody struct {
+	Error       string       `json:"error"`
+	Code        string       `json:"code"`
+	Message     string       `json:"message"`
+	Kind        string       `json:"kind"`
+	Name        string       `json:"name"`
+	FieldErrors []FieldError `json:"fieldErrors"`
+}
+
+// Middleware rewrites every error response (status 400 and above) into
+// problem details. JSON error bodies keep their code, message, kind, name and
+// field errors; plain text bodies become the detail. Problem details written by
+// handlers pass through, with the request path added as their instance.
+//
+// Successful responses are not buffered, and the ResponseWriter keeps
+// supporting http.Flusher and http.Hijacker, so the middleware can run in front
+// of streaming handlers.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pw := &problemWriter{ResponseWriter: w}
+		next.ServeHTTP(pw, r)
+		if pw.body == nil {
+			return
+		}
+
+		mediaType, _, _ := mime.ParseMediaType(w.Header().Get("Content-Type"))
+		if mediaType == ContentType {
+			writeWithInstance(w, r, pw.status, pw.body.Bytes())
+			return
+		}
+		Write(w, fromBody(r, pw.status, mediaType, pw.body.Bytes()))
+	})
+}
+
+// writeWithInstance writes problem details written by the handler, adding the
+// request path as the instance if the handler left it out.
+func writeWithInstance(w http.ResponseWriter, r *http.Request, status int, body []byte) {
+	var members map[string]any
+	if err := json.Unmarshal(body, &members); err == nil {
+		if _, ok := members["instance"]; !ok {
+			members["instance"] = r.URL.Path
+			if encoded, err := json.Marshal(members); err == nil {
+				body = append(encoded, '\n')
+				w.Header().Del("Content-Length")
+			}
+		}
+	}
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
+
+func fromBody(r *http.Request, status int, mediaType string, body []byte) *Details {
+	var code, detail string
+	var parsed errorBody
+	isJSON := mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+	switch {
+	case isJSON && json.Unmarshal(body, &parsed) == nil:
+		switch {
+		case parsed.Code != "":
+			code, detail = parsed.Code, parsed.Error
+		case parsed.Message != "":
+			code, detail = parsed.Error, parsed.Message
+		default:
+			detail = parsed.Error
+		}
+	default:
+		detail = strings.TrimSpace(string(body))
+	}
+
+	d := New(status, code, detail)
+	d.Instance = r.URL.Path
+	d.Kind = parsed.Kind
+	d.Name = parsed.Name
+	d.FieldErrors = parsed.FieldErrors
+	return d
+}
+
+// problemWriter holds back error responses so they can be rewritten, and
+// passes everything else through.
+type problemWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	// body holds the error response; nil unless the status is an error.
+	body *bytes.Buffer
+}
+
+func (pw *problemWriter) WriteHeader(code int) {
+	if pw.wroteHeader {
+		return
+	}
+	if code >= http.StatusBadRequest {
+		pw.wroteHeader = true
+		pw.status = code
+		pw.body = &bytes.Buffer{}
+		return
+	}
+	// Informational responses may precede the final one.
+	pw.wroteHeader = code >= http.StatusOK
+	pw.ResponseWriter.WriteHeader(code)
+}
+
+func (pw *problemWriter) Write(b []byte) (int, error) {
+	if !pw.wroteHeader {
+		pw.WriteHeader(http.StatusOK)
+	}
+	if pw.body != nil {
+		return pw.body.Write(b)
+	}
+	return pw.ResponseWriter.Write(b)
+}
+
+// Flush flushes successful responses; error responses are written in full
+// once the handler returns.
+func (pw *problemWriter) Flush() {
+	if pw.body == nil {
+		_ = http.NewResponseController(pw.ResponseWriter).Flush()
+	}
+}
+
+func (pw *problemWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return http.NewResponseController(pw.ResponseWriter).Hijack()
+}
+
+func (pw *problemWriter) Unwrap() http.ResponseWriter {
+	return pw.ResponseWriter
+}
@@ -0,0 +1,171 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package problem
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serve(t *testing.T, handler http.HandlerFunc) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	Middleware(handler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/namespaces/acme/projects/shop", nil))
+	return rec
+}
+
+func decode(t *testing.T, rec *httptest.ResponseRecorder) *Details {
+	t.Helper()
+	assert.Equal(t, ContentType, rec.Header().Get("Content-Type"))
+	var d Details
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &d))
+	return &d
+}
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		want    *Details
+	}{
+		{
+			name: "handler error response",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = io.WriteString(w, `{"code":"NOT_FOUND","error":"Project not found","kind":"Project"}`)
+			},
+			want: &Details{
+				Type:     "urn:openchoreo:problem:not-found",
+				Title:    "Not Found",
+				Status:   http.StatusNotFound,
+				Detail:   "Project not found",
+				Instance: "/api/v1/namespaces/acme/projects/shop",
+				Code:     "NOT_FOUND",
+				Error:    "Project not found",
+				Kind:     "Project",
+			},
+		},
+		{
+			name: "field errors are kept",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = io.WriteString(w, `{"code":"BAD_REQUEST","error":"invalid request",`+
+					`"fieldErrors":[{"field":"spec.type","message":"is required"}]}`)
+			},
+			want: &Details{
+				Type:        "urn:openchoreo:problem:bad-request",
+				Title:       "Bad Request",
+				Status:      http.StatusBadRequest,
+				Detail:      "invalid request",
+				Instance:    "/api/v1/namespaces/acme/projects/shop",
+				Code:        "BAD_REQUEST",
+				Error:       "invalid request",
+				FieldErrors: []FieldError{{Field: "spec.type", Message: "is required"}},
+			},
+		},
+		{
+			name: "middleware error response",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusTooManyRequests)
+				_, _ = io.WriteString(w, `{"error":"RATE_LIMITED","message":"Too many requests, retry after 1s"}`)
+			},
+			want: &Details{
+				Type:     "urn:openchoreo:problem:rate-limited",
+				Title:    "Too Many Requests",
+				Status:   http.StatusTooManyRequests,
+				Detail:   "Too many requests, retry after 1s",
+				Instance: "/api/v1/namespaces/acme/projects/shop",
+				Code:     "RATE_LIMITED",
+				Error:    "Too many requests, retry after 1s",
+			},
+		},
+		{
+			name: "plain text error",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				http.Error(w, "failed to start watch", http.StatusInternalServerError)
+			},
+			want: &Details{
+				Type:     "urn:openchoreo:problem:internal-error",
+				Title:    "Internal Server Error",
+				Status:   http.StatusInternalServerError,
+				Detail:   "failed to start watch",
+				Instance: "/api/v1/namespaces/acme/projects/shop",
+				Code:     "INTERNAL_ERROR",
+				Error:    "failed to start watch",
+			},
+		},
+		{
+			name: "empty error",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			},
+			want: &Details{
+				Type:     "urn:openchoreo:problem:method-not-allowed",
+				Title:    "Method Not Allowed",
+				Status:   http.StatusMethodNotAllowed,
+				Instance: "/api/v1/namespaces/acme/projects/shop",
+				Code:     "METHOD_NOT_ALLOWED",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(t, tt.handler)
+			assert.Equal(t, tt.want.Status, rec.Code)
+			assert.Equal(t, tt.want, decode(t, rec))
+		})
+	}
+}
+
+func TestMiddleware_KeepsHeaders(t *testing.T) {
+	rec := serve(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Retry-After", "3")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = io.WriteString(w, `{"error":"RATE_LIMITED","message":"slow down"}`)
+	})
+	assert.Equal(t, "3", rec.Header().Get("Retry-After"))
+	assert.Equal(t, "RATE_LIMITED", decode(t, rec).Code)
+}
+
+func TestMiddleware_PassesThrough(t *testing.T) {
+	t.Run("successful response", func(t *testing.T) {
+		rec := serve(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = io.WriteString(w, `{"ok":true}`)
+			w.(http.Flusher).Flush()
+		})
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `{"ok":true}`, rec.Body.String())
+		assert.True(t, rec.Flushed)
+	})
+
+	t.Run("problem details", func(t *testing.T) {
+		rec := serve(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", ContentType)
+			w.WriteHeader(http.StatusConflict)
+			_, _ = io.WriteString(w, `{"type":"urn:example:custom","title":"Custom","status":409,"extra":true}`)
+		})
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		assert.JSONEq(t, `{"type":"urn:example:custom","title":"Custom","status":409,"extra":true,`+
+			`"instance":"/api/v1/namespaces/acme/projects/shop"}`, rec.Body.String())
+	})
+}
+
+func TestCodeForStatus(t *testing.T) {
+	assert.Equal(t, "BAD_REQUEST", CodeForStatus(http.StatusBadRequest))
+	assert.Equal(t, "UNPROCESSABLE_CONTENT", CodeForStatus(http.StatusUnprocessableEntity))
+	assert.Equal(t, "INTERNAL_ERROR", CodeForStatus(http.StatusInternalServerError))
+	assert.Equal(t, "REQUEST_ENTITY_TOO_LARGE", CodeForStatus(http.StatusRequestEntityTooLarge))
+}
@@ -0,0 +1,109 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package problem implements problem details (RFC 7807, updated by RFC 9457)
+// for HTTP error responses, and the OpenChoreo problem types shared by the
+// REST API and the MCP error envelope.
+package problem
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+const (
+	// ContentType is the media type of problem details.
+	ContentType = "application/problem+json"
+
+	// typePrefix starts the URI of every OpenChoreo problem type.
+	typePrefix = "urn:openchoreo:problem:"
+)
+
+// Details is a problem details object. Besides the standard members it carries
+// the OpenChoreo extensions code, kind, name and fieldErrors, and the legacy
+// error member that earlier clients read the message from.
+type Details struct {
+	// Type is a URI identifying the problem type, derived from Code.
+	Type string `json:"type"`
+	// Title is a short summary of the problem type.
+	Title string `json:"title"`
+	// Status is the HTTP status code.
+	Status int `json:"status"`
+	// Detail explains this occurrence of the problem.
+	Detail string `json:"detail,omitempty"`
+	// Instance is the path of the request that caused the problem.
+	Instance string `json:"instance,omitempty"`
+
+	// Code is the machine-readable error code, such as NOT_FOUND.
+	Code string `json:"code"`
+	// Error repeats Detail for clients of the earlier error format.
+	Error string `json:"error"`
+	// Kind is the kind of the resource the problem concerns, when known.
+	Kind string `json:"kind,omitempty"`
+	// Name is the name of the resource the problem concerns, when known.
+	Name string `json:"name,omitempty"`
+	// FieldErrors lists the invalid fields of the request.
+	FieldErrors []FieldError `json:"fieldErrors,omitempty"`
+}
+
+// FieldError describes an invalid field of a request.
+type FieldError struct {
+	// Field is the path of the field.
+	Field string `json:"field"`
+	// Reason is the machine-readable cause, such as FieldValueRequired.
+	Reason string `json:"reason,omitempty"`
+	// Message explains what is wrong with the field.
+	Message string `json:"message"`
+}
+
+// New returns the problem details of an error with the given status, code and
+// message. An empty code is derived from the status.
+func New(status int, code, detail string) *Details {
+	if code == "" {
+		code = CodeForStatus(status)
+	}
+	return &Details{
+		Type:   TypeURI(code),
+		Title:  Title(status),
+		Status: status,
+		Detail: detail,
+		Code:   code,
+		Error:  detail,
+	}
+}
+
+// TypeURI returns the URI of the problem type with the given error code, such
+// as urn:openchoreo:problem:not-found for NOT_FOUND.
+func TypeURI(code string) string {
+	return typePrefix + strings.ReplaceAll(strings.ToLower(code), "_", "-")
+}
+
+// Title returns the title of problems with the given status.
+func Title(status int) string {
+	if text := http.StatusText(status); text != "" {
+		return text
+	}
+	return "Error"
+}
+
+// CodeForStatus returns the error code of responses with the given status
+// that carry no code of their own.
+func CodeForStatus(status int) string {
+	switch status {
+	case http.StatusInternalServerError:
+		return "INTERNAL_ERROR"
+	case http.StatusUnprocessableEntity:
+		return "UNPROCESSABLE_CONTENT"
+	}
+	title := Title(status)
+	return strings.ToUpper(strings.NewReplacer(" ", "_", "-", "_", "'", "").Replace(title))
+}
+
+// Write writes d as the response, with d.Status as the status code.
+func Write(w http.ResponseWriter, d *Details) {
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Del("Content-Length")
+	w.WriteHeader(d.Status)
+	_ = json.NewEncoder(w).Encode(d)
+}
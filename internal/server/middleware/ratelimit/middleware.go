@@ -0,0 +1,176 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ratelimit provides an HTTP middleware that applies global and
+// per-client token bucket rate limits, rejecting excess requests with
+// 429 Too Many Requests and a Retry-After header.
+package ratelimit
+
+import (
+	"encoding/json"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// clientIdleTTL is how long the limiter state of a client is kept after its
+// last request.
+const clientIdleTTL = 10 * time.Minute
+
+// Config defines the rate limits. Rates are token buckets in requests per
+// second; a zero rate disables that bucket.
+type Config struct {
+	// Enabled turns the middleware on. When false the middleware is a no-op.
+	Enabled bool
+	// GlobalRate is the sustained request rate across all clients.
+	GlobalRate float64
+	// GlobalBurst is the number of requests all clients may make at once.
+	GlobalBurst int
+	// ClientRate is the sustained request rate of each client.
+	ClientRate float64
+	// ClientBurst is the number of requests a single client may make at once.
+	ClientBurst int
+	// TrustForwardedFor identifies clients by the first address in the
+	// X-Forwarded-For header instead of the connection's remote address. Only
+	// enable it behind a proxy that sets the header.
+	TrustForwardedFor bool
+}
+
+// errorResponse is the JSON body of a rejected request.
+type errorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+type limiter struct {
+	cfg    Config
+	global *rate.Limiter
+
+	mu        sync.Mutex
+	clients   map[string]*clientLimiter
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// Middleware returns an HTTP middleware that applies cfg to every request.
+func Middleware(cfg Config) func(http.Handler) http.Handler {
+	return newLimiter(cfg, time.Now).middleware
+}
+
+func newLimiter(cfg Config, now func() time.Time) *limiter {
+	l := &limiter{
+		cfg:     cfg,
+		clients: map[string]*clientLimiter{},
+		now:     now,
+	}
+	if cfg.GlobalRate > 0 {
+		l.global = rate.NewLimiter(rate.Limit(cfg.GlobalRate), max(cfg.GlobalBurst, 1))
+	}
+	return l
+}
+
+func (l *limiter) middleware(next http.Handler) http.Handler {
+	if !l.cfg.Enabled {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if delay := l.reserve(l.clientKey(r)); delay > 0 {
+			writeRateLimited(w, delay)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// reserve takes a token from the global and client buckets. It returns the
+// delay after which the request would have been allowed when either bucket is
+// empty.
+func (l *limiter) reserve(client string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	var limiters []*rate.Limiter
+	if l.global != nil {
+		limiters = append(limiters, l.global)
+	}
+	if l.cfg.ClientRate > 0 {
+		state, ok := l.clients[client]
+		if !ok {
+			state = &clientLimiter{limiter: rate.NewLimiter(rate.Limit(l.cfg.ClientRate), max(l.cfg.ClientBurst, 1))}
+			l.clients[client] = state
+		}
+		state.lastSeen = now
+		limiters = append(limiters, state.limiter)
+	}
+
+	// Reserve from every bucket first so that a rejected request does not
+	// consume a token from a bucket that had one to spare.
+	reservations := make([]*rate.Reservation, 0, len(limiters))
+	var delay time.Duration
+	for _, lim := range limiters {
+		r := lim.ReserveN(now, 1)
+		reservations = append(reservations, r)
+		delay = max(delay, r.DelayFrom(now))
+	}
+	if delay > 0 {
+		for _, r := range reservations {
+			r.CancelAt(now)
+		}
+	}
+	return delay
+}
+
+// sweep drops the state of clients idle for longer than clientIdleTTL. It runs
+// at most once per TTL and must be called with l.mu held.
+func (l *limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < clientIdleTTL {
+		return
+	}
+	l.lastSweep = now
+	for client, state := range l.clients {
+		if now.Sub(state.lastSeen) > clientIdleTTL {
+			delete(l.clients, client)
+		}
+	}
+}
+
+// clientKey identifies the client of r by IP address.
+func (l *limiter) clientKey(r *http.Request) string {
+	if l.cfg.TrustForwardedFor {
+		if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
+			first, _, _ := strings.Cut(xff, ",")
+			if ip := strings.TrimSpace(first); ip != "" {
+				return ip
+			}
+		}
+	}
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
+
+func writeRateLimited(w http.ResponseWriter, retryAfter time.Duration) {
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+	_ = json.NewEncoder(w).Encode(errorResponse{
+		Error:   "RATE_LIMITED",
+		Message: "Too many requests, retry after " + strconv.Itoa(seconds) + "s",
+	})
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func serve(h http.Handler, remoteAddr, forwardedFor string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/namespaces", nil)
+	req.RemoteAddr = remoteAddr
+	if forwardedFor != "" {
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func newHandler(cfg Config, now func() time.Time) http.Handler {
+	return newLimiter(cfg, now).middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func TestMiddlewareClientLimit(t *testing.T) {
+	now := time.Unix(0, 0)
+	h := newHandler(Config{Enabled: true, ClientRate: 1, ClientBurst: 2}, func() time.Time { return now })
+
+	for i := range 2 {
+		if rec := serve(h, "10.0.0.1:1234", ""); rec.Code != http.StatusOK {
+			t.Fatalf("request %d: status = %d, want 200 within burst", i, rec.Code)
+		}
+	}
+	rec := serve(h, "10.0.0.1:5678", "")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("status = %d, want 429 over burst", rec.Code)
+	}
+	if got := rec.Header().Get("Retry-After"); got != "1" {
+		t.Errorf("Retry-After = %q, want 1", got)
+	}
+	if rec := serve(h, "10.0.0.2:1234", ""); rec.Code != http.StatusOK {
+		t.Errorf("other client: status = %d, want 200", rec.Code)
+	}
+
+	now = now.Add(time.Second)
+	if rec := serve(h, "10.0.0.1:1234", ""); rec.Code != http.StatusOK {
+		t.Errorf("after refill: status = %d, want 200", rec.Code)
+	}
+}
+
+func TestMiddlewareGlobalLimit(t *testing.T) {
+	now := time.Unix(0, 0)
+	h := newHandler(Config{Enabled: true, GlobalRate: 1, GlobalBurst: 1, ClientRate: 10, ClientBurst: 10},
+		func() time.Time { return now })
+
+	if rec := serve(h, "10.0.0.1:1234", ""); rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	if rec := serve(h, "10.0.0.2:1234", ""); rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("status = %d, want 429 from the global bucket", rec.Code)
+	}
+
+	// The rejected request must not have consumed the second client's token.
+	now = now.Add(time.Second)
+	if rec := serve(h, "10.0.0.2:1234", ""); rec.Code != http.StatusOK {
+		t.Errorf("after refill: status = %d, want 200", rec.Code)
+	}
+}
+
+func TestMiddlewareForwardedFor(t *testing.T) {
+	now := time.Unix(0, 0)
+	cfg := Config{Enabled: true, ClientRate: 1, ClientBurst: 1, TrustForwardedFor: true}
+	h := newHandler(cfg, func() time.Time { return now })
+
+	if rec := serve(h, "10.0.0.100:1234", "203.0.113.1, 10.0.0.100"); rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	if rec := serve(h, "10.0.0.100:1234", "203.0.113.2"); rec.Code != http.StatusOK {
+		t.Errorf("different forwarded client behind the same proxy: status = %d, want 200", rec.Code)
+	}
+	if rec := serve(h, "10.0.0.101:1234", "203.0.113.1"); rec.Code != http.StatusTooManyRequests {
+		t.Errorf("same forwarded client: status = %d, want 429", rec.Code)
+	}
+}
+
+func TestMiddlewareDisabled(t *testing.T) {
+	h := newHandler(Config{Enabled: false, GlobalRate: 1, GlobalBurst: 1}, time.Now)
+	for i := range 3 {
+		if rec := serve(h, "10.0.0.1:1234", ""); rec.Code != http.StatusOK {
+			t.Fatalf("request %d: status = %d, want 200 when disabled", i, rec.Code)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tracing sets up OpenTelemetry tracing for OpenChoreo components and
+// provides helpers for creating spans.
+//
+// Until Setup is called, the global no-op tracer provider is in effect and
+// creating spans costs next to nothing, so instrumented code does not need to
+// check whether tracing is enabled.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName identifies the spans created through this package.
+const instrumentationName = "github.com/openchoreo/openchoreo"
+
+// Config defines tracing settings.
+type Config struct {
+	// Enabled enables exporting traces.
+	Enabled bool
+	// Endpoint is the host:port of the OTLP gRPC collector.
+	Endpoint string
+	// Insecure disables TLS for the connection to the collector.
+	Insecure bool
+	// SampleRatio is the fraction of new traces that are sampled (0 to 1).
+	// Requests that carry a sampled parent trace are always sampled.
+	SampleRatio float64
+	// ServiceName is reported as the service.name resource attribute.
+	ServiceName string
+	// ServiceVersion is reported as the service.version resource attribute.
+	ServiceVersion string
+}
+
+// Setup installs the global tracer provider and the W3C trace context and
+// baggage propagators. When tracing is disabled only the propagators are
+// installed, so trace context is still forwarded to downstream services.
+// The returned function flushes pending spans and must be called on shutdown.
+func Setup(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{},
+	))
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(cfg.ServiceName),
+		semconv.ServiceVersion(cfg.ServiceVersion),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Start creates a span named name as a child of the span in ctx.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records err, if any, on span and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// NewHandler wraps an HTTP handler so that each request gets a server span
+// that continues the trace propagated by the caller. Spans are named after the
+// matched http.ServeMux pattern, or operation when no pattern matched.
+func NewHandler(handler http.Handler, operation string) http.Handler {
+	return otelhttp.NewHandler(handler, operation, otelhttp.WithSpanNameFormatter(
+		func(operation string, r *http.Request) string {
+			if r.Pattern != "" {
+				return r.Pattern
+			}
+			return operation
+		},
+	))
+}
+
+// WrapTransport wraps an HTTP client transport so that each request gets a
+// client span named after prefix and the method, and carries the trace context
+// to the server. The request URL is recorded as a span attribute rather than in
+// the name to keep span names low-cardinality. It matches the signature of
+// rest.Config.Wrap.
+func WrapTransport(prefix string) func(http.RoundTripper) http.RoundTripper {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return otelhttp.NewTransport(rt, otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return prefix + " " + r.Method
+		}))
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// newRecorder installs a tracer provider that records spans in memory.
+func newRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+	return recorder
+}
+
+func TestSetupDisabled(t *testing.T) {
+	shutdown, err := Setup(context.Background(), Config{Enabled: false})
+	if err != nil {
+		t.Fatalf("Setup() error = %v", err)
+	}
+	if err := shutdown(context.Background()); err != nil {
+		t.Errorf("shutdown() error = %v", err)
+	}
+}
+
+func TestEndRecordsError(t *testing.T) {
+	recorder := newRecorder(t)
+
+	_, span := Start(context.Background(), "op")
+	End(span, errors.New("boom"))
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("ended spans = %d, want 1", len(spans))
+	}
+	if spans[0].Status().Code != codes.Error || spans[0].Status().Description != "boom" {
+		t.Errorf("status = %+v, want error boom", spans[0].Status())
+	}
+}
+
+func TestHandlerAndTransportPropagateTrace(t *testing.T) {
+	recorder := newRecorder(t)
+
+	var traceparent string
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+	}))
+	defer backend.Close()
+	client := &http.Client{Transport: WrapTransport("k8s")(http.DefaultTransport)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /items/{name}", func(w http.ResponseWriter, r *http.Request) {
+		req, _ := http.NewRequestWithContext(r.Context(), http.MethodGet, backend.URL, nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Errorf("backend request failed: %v", err)
+			return
+		}
+		resp.Body.Close()
+	})
+	NewHandler(mux, "api").ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/items/a", nil))
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("ended spans = %d, want 2", len(spans))
+	}
+	clientSpan, serverSpan := spans[0], spans[1]
+	if serverSpan.Name() != "GET /items/{name}" {
+		t.Errorf("server span name = %q, want the route pattern", serverSpan.Name())
+	}
+	if clientSpan.Name() != "k8s GET" {
+		t.Errorf("client span name = %q, want %q", clientSpan.Name(), "k8s GET")
+	}
+	if clientSpan.Parent().SpanID() != serverSpan.SpanContext().SpanID() {
+		t.Error("client span is not a child of the server span")
+	}
+	if traceparent == "" {
+		t.Error("trace context was not propagated to the backend")
+	}
+}
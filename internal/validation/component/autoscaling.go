@@ -0,0 +1,60 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+// ValidateAutoscaling checks a workload's autoscaling configuration against the limits of its
+// ComponentType. Autoscaling is only supported for deployment and statefulset workload types,
+// and is not allowed at all when the ComponentType sets no autoscaling limits.
+func ValidateAutoscaling(config *v1alpha1.AutoscalingConfig, workloadType string, limits *v1alpha1.AutoscalingLimits) error {
+	if config == nil {
+		return nil
+	}
+
+	if workloadType != "deployment" && workloadType != "statefulset" {
+		return fmt.Errorf("autoscaling is not supported for workload type %q", workloadType)
+	}
+	if limits == nil {
+		return fmt.Errorf("autoscaling is not allowed by the component type")
+	}
+
+	minReplicas := config.GetMinReplicas()
+	if minReplicas > config.MaxReplicas {
+		return fmt.Errorf("minReplicas %d exceeds maxReplicas %d", minReplicas, config.MaxReplicas)
+	}
+	if minReplicas == 0 && len(config.Triggers) == 0 {
+		return fmt.Errorf("minReplicas can only be 0 when event-driven triggers are configured")
+	}
+
+	lowest := int32(1)
+	if limits.MinReplicas != nil {
+		lowest = *limits.MinReplicas
+	}
+	if minReplicas < lowest {
+		return fmt.Errorf("minReplicas %d is below the component type minimum of %d", minReplicas, lowest)
+	}
+	if config.MaxReplicas > limits.MaxReplicas {
+		return fmt.Errorf("maxReplicas %d exceeds the component type maximum of %d", config.MaxReplicas, limits.MaxReplicas)
+	}
+
+	var disallowed []string
+	for _, trigger := range config.Triggers {
+		if !slices.Contains(limits.AllowedTriggerTypes, trigger.Type) && !slices.Contains(disallowed, trigger.Type) {
+			disallowed = append(disallowed, trigger.Type)
+		}
+	}
+	if len(disallowed) > 0 {
+		if len(limits.AllowedTriggerTypes) == 0 {
+			return fmt.Errorf("trigger types %v are not allowed: the component type allows no event-driven triggers", disallowed)
+		}
+		return fmt.Errorf("trigger types %v are not in the allowed list %v", disallowed, limits.AllowedTriggerTypes)
+	}
+	return nil
+}
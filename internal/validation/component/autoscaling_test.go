@@ -0,0 +1,115 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+)
+
+func TestValidateAutoscaling(t *testing.T) {
+	limits := &v1alpha1.AutoscalingLimits{
+		MinReplicas:         ptr.To[int32](0),
+		MaxReplicas:         10,
+		AllowedTriggerTypes: []string{"kafka", "cron"},
+	}
+	kafka := []v1alpha1.AutoscalingTrigger{{Type: "kafka", Metadata: map[string]string{"topic": "orders"}}}
+
+	tests := []struct {
+		name         string
+		config       *v1alpha1.AutoscalingConfig
+		workloadType string
+		limits       *v1alpha1.AutoscalingLimits
+		errContains  string
+	}{
+		{
+			name:         "no autoscaling is always valid",
+			workloadType: "cronjob",
+		},
+		{
+			name:         "resource based autoscaling within limits",
+			config:       &v1alpha1.AutoscalingConfig{MinReplicas: ptr.To[int32](2), MaxReplicas: 5, TargetCPUUtilizationPercentage: ptr.To[int32](70)},
+			workloadType: "deployment",
+			limits:       limits,
+		},
+		{
+			name:         "scale to zero with an allowed trigger",
+			config:       &v1alpha1.AutoscalingConfig{MinReplicas: ptr.To[int32](0), MaxReplicas: 10, Triggers: kafka},
+			workloadType: "statefulset",
+			limits:       limits,
+		},
+		{
+			name:         "unsupported workload type",
+			config:       &v1alpha1.AutoscalingConfig{MaxReplicas: 5},
+			workloadType: "cronjob",
+			limits:       limits,
+			errContains:  `not supported for workload type "cronjob"`,
+		},
+		{
+			name:         "component type without limits",
+			config:       &v1alpha1.AutoscalingConfig{MaxReplicas: 5},
+			workloadType: "deployment",
+			errContains:  "not allowed by the component type",
+		},
+		{
+			name:         "minReplicas exceeds maxReplicas",
+			config:       &v1alpha1.AutoscalingConfig{MinReplicas: ptr.To[int32](6), MaxReplicas: 5},
+			workloadType: "deployment",
+			limits:       limits,
+			errContains:  "minReplicas 6 exceeds maxReplicas 5",
+		},
+		{
+			name:         "scale to zero without triggers",
+			config:       &v1alpha1.AutoscalingConfig{MinReplicas: ptr.To[int32](0), MaxReplicas: 5},
+			workloadType: "deployment",
+			limits:       limits,
+			errContains:  "only be 0 when event-driven triggers",
+		},
+		{
+			name:         "scale to zero not allowed by default",
+			config:       &v1alpha1.AutoscalingConfig{MinReplicas: ptr.To[int32](0), MaxReplicas: 5, Triggers: kafka},
+			workloadType: "deployment",
+			limits:       &v1alpha1.AutoscalingLimits{MaxReplicas: 10, AllowedTriggerTypes: []string{"kafka"}},
+			errContains:  "below the component type minimum of 1",
+		},
+		{
+			name:         "maxReplicas above the limit",
+			config:       &v1alpha1.AutoscalingConfig{MaxReplicas: 20},
+			workloadType: "deployment",
+			limits:       limits,
+			errContains:  "maxReplicas 20 exceeds the component type maximum of 10",
+		},
+		{
+			name:         "trigger type not allowed",
+			config:       &v1alpha1.AutoscalingConfig{MaxReplicas: 5, Triggers: []v1alpha1.AutoscalingTrigger{{Type: "rabbitmq"}}},
+			workloadType: "deployment",
+			limits:       limits,
+			errContains:  "trigger types [rabbitmq] are not in the allowed list [kafka cron]",
+		},
+		{
+			name:         "triggers not allowed when none are listed",
+			config:       &v1alpha1.AutoscalingConfig{MaxReplicas: 5, Triggers: kafka},
+			workloadType: "deployment",
+			limits:       &v1alpha1.AutoscalingLimits{MaxReplicas: 10},
+			errContains:  "allows no event-driven triggers",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAutoscaling(tt.config, tt.workloadType, tt.limits)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errContains)
+		})
+	}
+}
@@ -199,6 +199,15 @@ openapi-codegen: oapi-codegen ## Generate Go server and client code from OpenAPI
 	@$(call log, "Generating Observer Logs Adapter API client")
 	$(OAPI_CODEGEN) -config internal/observer/api/cfg-logs-adapter-client.yaml openapi/observability-logs-adapter-api.yaml
 
+#-----------------------------------------------------------------------------
+# Protobuf Code Generation
+#-----------------------------------------------------------------------------
+
+.PHONY: proto-codegen
+proto-codegen: buf protoc-gen-go protoc-gen-go-grpc ## Generate Go gRPC code from the protobuf definitions.
+	@$(call log, "Generating OpenChoreo API gRPC code")
+	cd proto && PATH="$(TOOL_BIN):$$PATH" $(BUF) generate
+
 .PHONY: mockery-gen
 mockery-gen: mockery ## Regenerate mockery mocks.
 	@$(call log, "Generating openchoreo-api service mocks")
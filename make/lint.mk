@@ -96,6 +96,8 @@ GETTING_STARTED_FILES := \
 	$(GETTING_STARTED_DIR)/ci-workflows/gcp-buildpacks-builder.yaml \
 	$(GETTING_STARTED_DIR)/ci-workflows/ballerina-buildpack-builder.yaml \
 	$(GETTING_STARTED_DIR)/ci-workflows/dockerfile-builder.yaml \
+	$(GETTING_STARTED_DIR)/ci-workflows/ko-builder.yaml \
+	$(GETTING_STARTED_DIR)/ci-workflows/buildkit-builder.yaml \
 	$(GETTING_STARTED_DIR)/component-traits/alert-rule-trait.yaml
 
 .PHONY: samples-gen
@@ -127,7 +129,9 @@ WORKFLOW_TEMPLATE_FILES := \
 	$(WORKFLOW_TEMPLATE_DIR)/paketo-buildpacks-build.yaml \
 	$(WORKFLOW_TEMPLATE_DIR)/gcp-buildpacks-build.yaml \
 	$(WORKFLOW_TEMPLATE_DIR)/ballerina-buildpack-build.yaml \
-	$(WORKFLOW_TEMPLATE_DIR)/containerfile-build.yaml
+	$(WORKFLOW_TEMPLATE_DIR)/containerfile-build.yaml \
+	$(WORKFLOW_TEMPLATE_DIR)/ko-build.yaml \
+	$(WORKFLOW_TEMPLATE_DIR)/buildkit-build.yaml
 
 .PHONY: workflow-templates-gen
 workflow-templates-gen: ## Generate samples/getting-started/workflow-templates.yaml from individual files
@@ -138,8 +142,8 @@ workflow-templates-gen: ## Generate samples/getting-started/workflow-templates.y
 	printf '# This file is auto-generated by "make workflow-templates-gen". DO NOT EDIT.\n'; \
 	printf '#\n'; \
 	printf '# Contains the coordinator ClusterWorkflowTemplates (docker-build-step,\n'; \
-	printf '# pack-build-paketo, pack-build-gcp,\n'; \
-	printf '# pack-build-ballerina) and the generate-workload-build-step\n'; \
+	printf '# pack-build-paketo, pack-build-gcp, pack-build-ballerina,\n'; \
+	printf '# ko-build, buildkit-build) and the generate-workload-build-step\n'; \
 	printf '# template. checkout-source-build-step and publish-image-build-step are\n'; \
 	printf '# applied separately so they can be replaced independently.\n'; \
 	printf '#\n'; \
@@ -156,7 +160,7 @@ workflow-templates-gen: ## Generate samples/getting-started/workflow-templates.y
 	@echo "✓ Generated $(GETTING_STARTED_DIR)/workflow-templates.yaml"
 
 .PHONY: code.gen
-code.gen: manifests generate openapi-codegen go.mod.lint helm-generate samples-gen workflow-templates-gen mockery-gen ## Generate code and fix the code with linter
+code.gen: manifests generate openapi-codegen proto-codegen go.mod.lint helm-generate samples-gen workflow-templates-gen mockery-gen ## Generate code and fix the code with linter
 
 .PHONY: code.gen-check
 code.gen-check: code.gen ## Verify the clean Git status after code generation
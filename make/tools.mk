@@ -38,6 +38,9 @@ OAPI_CODEGEN ?= $(TOOL_BIN)/oapi-codegen
 HELM_SCHEMA ?= $(TOOL_BIN)/helm-schema
 KUBEBUILDER_HELM_GEN ?= go run $(PROJECT_DIR)/tools/helm-gen
 MOCKERY ?= $(TOOL_BIN)/mockery
+BUF ?= $(TOOL_BIN)/buf
+PROTOC_GEN_GO ?= $(TOOL_BIN)/protoc-gen-go
+PROTOC_GEN_GO_GRPC ?= $(TOOL_BIN)/protoc-gen-go-grpc
 
 ## Tool Versions
 KUSTOMIZE_VERSION ?= v5.5.0
@@ -49,6 +52,9 @@ YQ_VERSION ?= v4.45.1
 OAPI_CODEGEN_VERSION ?= v2.5.1
 HELM_SCHEMA_VERSION ?= 0.20.0-1
 MOCKERY_VERSION ?= v2.53.6
+BUF_VERSION ?= v1.50.0
+PROTOC_GEN_GO_VERSION ?= v1.36.11
+PROTOC_GEN_GO_GRPC_VERSION ?= v1.5.1
 
 .PHONY: kustomize
 kustomize: $(KUSTOMIZE) ## Download kustomize locally if necessary.
@@ -95,3 +101,17 @@ mockery: $(MOCKERY) ## Download mockery locally if necessary.
 $(MOCKERY): $(TOOL_BIN)
 	$(call go_install_tool,$(MOCKERY),github.com/vektra/mockery/v2,$(MOCKERY_VERSION))
 
+.PHONY: buf
+buf: $(BUF) ## Download buf locally if necessary.
+$(BUF): $(TOOL_BIN)
+	$(call go_install_tool,$(BUF),github.com/bufbuild/buf/cmd/buf,$(BUF_VERSION))
+
+.PHONY: protoc-gen-go
+protoc-gen-go: $(PROTOC_GEN_GO) ## Download protoc-gen-go locally if necessary.
+$(PROTOC_GEN_GO): $(TOOL_BIN)
+	$(call go_install_tool,$(PROTOC_GEN_GO),google.golang.org/protobuf/cmd/protoc-gen-go,$(PROTOC_GEN_GO_VERSION))
+
+.PHONY: protoc-gen-go-grpc
+protoc-gen-go-grpc: $(PROTOC_GEN_GO_GRPC) ## Download protoc-gen-go-grpc locally if necessary.
+$(PROTOC_GEN_GO_GRPC): $(TOOL_BIN)
+	$(call go_install_tool,$(PROTOC_GEN_GO_GRPC),google.golang.org/grpc/cmd/protoc-gen-go-grpc,$(PROTOC_GEN_GO_GRPC_VERSION))
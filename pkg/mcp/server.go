@@ -53,13 +53,39 @@ const (
 // nil (authz disabled) all registered tools are visible and callable — the
 // service layer still enforces authz independently. The toolset filter is
 // always applied when the client requests it, regardless of pdp.
+//
+// When toolsets.RateLimit is enabled, tools/call requests are additionally rate
+// limited per session and per tool, and mutating tools are capped in concurrency,
+// before any authz evaluation takes place.
+//
+// When toolsets.Idempotency is set, authorized calls to mutating tools that carry
+// an idempotency_key argument run at most once per key.
+//
+// When toolsets.Batch is enabled, calls to the batch tool are expanded by the
+// outermost middleware, so each of their operations is traced, rate limited and
+// authorized like an individual call.
 func NewHTTPServer(toolsets *tools.Toolsets, pdp authzcore.PDP) http.Handler {
 	server := mcp.NewServer(&mcp.Implementation{
 		Name:    "openchoreo-api",
 		Version: "1.0.0",
 	}, nil)
 	perms, toolToToolsets := toolsets.Register(server)
-	server.AddReceivingMiddleware(tools.NewToolFilterMiddleware(pdp, perms, toolToToolsets))
+	var middleware []mcp.Middleware
+	if toolsets.Batch {
+		middleware = append(middleware, tools.NewBatchMiddleware())
+	}
+	middleware = append(middleware,
+		tools.NewTracingMiddleware(),
+		tools.NewErrorEnvelopeMiddleware(toolsets.ErrorClassifier),
+	)
+	if toolsets.RateLimit.Enabled {
+		middleware = append(middleware, tools.NewRateLimitMiddleware(toolsets.RateLimit, perms))
+	}
+	middleware = append(middleware, tools.NewToolFilterMiddleware(pdp, perms, toolToToolsets))
+	if toolsets.Idempotency != nil {
+		middleware = append(middleware, tools.NewIdempotencyMiddleware(toolsets.Idempotency, perms))
+	}
+	server.AddReceivingMiddleware(middleware...)
 	streamable := mcp.NewStreamableHTTPHandler(func(r *http.Request) *mcp.Server {
 		return server
 	}, nil)
@@ -76,6 +102,12 @@ func NewSTDIO(toolsets *tools.Toolsets) *mcp.Server {
 		Version: "1.0.0",
 	}, nil)
 	toolsets.Register(server)
+	var middleware []mcp.Middleware
+	if toolsets.Batch {
+		middleware = append(middleware, tools.NewBatchMiddleware())
+	}
+	middleware = append(middleware, tools.NewErrorEnvelopeMiddleware(toolsets.ErrorClassifier))
+	server.AddReceivingMiddleware(middleware...)
 	return server
 }
 
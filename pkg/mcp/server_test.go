@@ -4,11 +4,14 @@
 package mcp
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
 	"testing"
 
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
 	"github.com/openchoreo/openchoreo/pkg/mcp/tools"
 )
 
@@ -160,3 +163,25 @@ func TestWithSessionQueryParamsCombined(t *testing.T) {
 		t.Errorf("requested toolsets = %v, want %v", cap.requestedToolsets, want)
 	}
 }
+
+func TestNewSTDIOWithoutBatchToolsetRejectsBatchCalls(t *testing.T) {
+	ctx := context.Background()
+	server := NewSTDIO(&tools.Toolsets{})
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	if _, err := server.Connect(ctx, serverTransport, nil); err != nil {
+		t.Fatalf("server connect: %v", err)
+	}
+	cs, err := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil).Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("client connect: %v", err)
+	}
+	defer cs.Close()
+
+	res, err := cs.CallTool(ctx, &mcp.CallToolParams{
+		Name:      tools.BatchToolName,
+		Arguments: map[string]any{"operations": []any{map[string]any{"tool": "list_namespaces"}}},
+	})
+	if err == nil {
+		t.Fatalf("expected the batch tool to be unknown, got result %+v", res)
+	}
+}
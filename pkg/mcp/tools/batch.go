@@ -0,0 +1,326 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	// BatchToolName is the name of the tool that runs several tool calls in one request.
+	BatchToolName = "batch_tool_calls"
+
+	// BatchStrategySequential runs the operations one after another in the given order.
+	BatchStrategySequential = "sequential"
+	// BatchStrategyParallel runs the operations concurrently.
+	BatchStrategyParallel = "parallel"
+
+	maxBatchOperations      = 20
+	defaultBatchConcurrency = 4
+	maxBatchConcurrency     = 10
+)
+
+// Per-operation statuses reported in a batch result.
+const (
+	BatchStatusSucceeded = "succeeded"
+	BatchStatusFailed    = "failed"
+	BatchStatusSkipped   = "skipped"
+)
+
+// BatchOperation is a single tool call within a batch.
+type BatchOperation struct {
+	// ID is an optional caller-chosen identifier echoed back in the result.
+	ID string `json:"id,omitempty"`
+	// Tool is the name of the tool to call.
+	Tool string `json:"tool"`
+	// Arguments are the tool arguments, exactly as they would be passed to tools/call.
+	Arguments json.RawMessage `json:"arguments,omitempty"`
+}
+
+// BatchOperationResult is the outcome of one operation of a batch.
+type BatchOperationResult struct {
+	Index  int        `json:"index"`
+	ID     string     `json:"id,omitempty"`
+	Tool   string     `json:"tool"`
+	Status string     `json:"status"`
+	Result any        `json:"result,omitempty"`
+	Error  *ToolError `json:"error,omitempty"`
+}
+
+// BatchResult is the structured result of a batch tool call.
+type BatchResult struct {
+	Strategy  string                 `json:"strategy"`
+	Succeeded int                    `json:"succeeded"`
+	Failed    int                    `json:"failed"`
+	Skipped   int                    `json:"skipped"`
+	Results   []BatchOperationResult `json:"results"`
+}
+
+type batchArgs struct {
+	Operations     []BatchOperation `json:"operations"`
+	Strategy       string           `json:"strategy"`
+	MaxConcurrency int              `json:"max_concurrency"`
+	StopOnError    *bool            `json:"stop_on_error"`
+}
+
+// RegisterBatchToolCalls registers the batch tool. The tool is executed by the
+// middleware returned by NewBatchMiddleware, which must be installed as the
+// outermost receiving middleware so that every operation passes through the
+// remaining middleware (authz filtering, rate limiting, error envelopes) exactly
+// like an individual tools/call request. The tool declares no authz action of its
+// own; each operation is authorized separately.
+func (t *Toolsets) RegisterBatchToolCalls(s *mcp.Server, perms map[string]ToolPermission) {
+	perms[BatchToolName] = ToolPermission{ToolName: BatchToolName}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: BatchToolName,
+		Description: "Run several tool calls in a single request and return per-operation results. " +
+			"Use it to create, update, get or delete several resources at once instead of one round-trip each. " +
+			"With strategy=\"sequential\" (default) operations run in the given order and, unless " +
+			"stop_on_error is false, the remaining operations are skipped after the first failure. With " +
+			"strategy=\"parallel\" independent operations run concurrently, up to max_concurrency at a time. " +
+			"Each operation is authorized and rate limited like an individual tool call.",
+		InputSchema: createSchema(map[string]any{
+			"operations": map[string]any{
+				"type":        "array",
+				"description": fmt.Sprintf("Tool calls to run (at most %d)", maxBatchOperations),
+				"minItems":    1,
+				"maxItems":    maxBatchOperations,
+				"items": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"id":   stringProperty("Optional identifier echoed back in the operation result"),
+						"tool": stringProperty("Name of the tool to call"),
+						"arguments": map[string]any{
+							"type":        "object",
+							"description": "Arguments of the tool call",
+						},
+					},
+					"required": []string{"tool"},
+				},
+			},
+			"strategy": map[string]any{
+				"type":        "string",
+				"description": "Execution strategy",
+				"enum":        []string{BatchStrategySequential, BatchStrategyParallel},
+				"default":     BatchStrategySequential,
+			},
+			"max_concurrency": map[string]any{
+				"type": "integer",
+				"description": fmt.Sprintf("Maximum operations in flight for the parallel strategy "+
+					"(default %d, max %d)", defaultBatchConcurrency, maxBatchConcurrency),
+			},
+			"stop_on_error": map[string]any{
+				"type":        "boolean",
+				"description": "Skip the remaining operations after a failure (sequential strategy only, default true)",
+			},
+		}, []string{"operations"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, raw json.RawMessage) (*mcp.CallToolResult, any, error) {
+		return handleToolResult(nil, fmt.Errorf("%s is not available on this server", BatchToolName))
+	})
+}
+
+// NewBatchMiddleware returns an MCP receiving middleware that executes calls to the
+// batch tool by dispatching each operation as a tools/call request to the next
+// handler. Install it first so the operations go through every other middleware.
+func NewBatchMiddleware() mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if method != methodCallTool || callToolName(req) != BatchToolName {
+				return next(ctx, method, req)
+			}
+			callReq, ok := req.(*mcp.CallToolRequest)
+			if !ok {
+				return next(ctx, method, req)
+			}
+			var args batchArgs
+			if err := unmarshalArgs(callReq.Params.Arguments, &args); err != nil {
+				return batchErrorResult(err), nil
+			}
+			if err := args.validate(); err != nil {
+				return batchErrorResult(err), nil
+			}
+			result := runBatch(ctx, next, callReq, &args)
+			text, err := json.Marshal(result)
+			if err != nil {
+				return nil, err
+			}
+			return &mcp.CallToolResult{
+				Content:           []mcp.Content{&mcp.TextContent{Text: string(text)}},
+				StructuredContent: result,
+			}, nil
+		}
+	}
+}
+
+func (a *batchArgs) validate() error {
+	if len(a.Operations) == 0 {
+		return errors.New("operations must contain at least one operation")
+	}
+	if len(a.Operations) > maxBatchOperations {
+		return fmt.Errorf("operations must contain at most %d operations, got %d", maxBatchOperations, len(a.Operations))
+	}
+	for i, op := range a.Operations {
+		switch op.Tool {
+		case "":
+			return fmt.Errorf("operations[%d].tool is required", i)
+		case BatchToolName:
+			return fmt.Errorf("operations[%d]: %s cannot be nested", i, BatchToolName)
+		}
+	}
+	switch a.Strategy {
+	case "":
+		a.Strategy = BatchStrategySequential
+	case BatchStrategySequential, BatchStrategyParallel:
+	default:
+		return fmt.Errorf("unknown strategy %q; valid strategies: %s, %s",
+			a.Strategy, BatchStrategySequential, BatchStrategyParallel)
+	}
+	switch {
+	case a.MaxConcurrency == 0:
+		a.MaxConcurrency = defaultBatchConcurrency
+	case a.MaxConcurrency < 0 || a.MaxConcurrency > maxBatchConcurrency:
+		return fmt.Errorf("max_concurrency must be between 1 and %d", maxBatchConcurrency)
+	}
+	return nil
+}
+
+func runBatch(ctx context.Context, next mcp.MethodHandler, parent *mcp.CallToolRequest, args *batchArgs) *BatchResult {
+	results := make([]BatchOperationResult, len(args.Operations))
+	run := func(i int) {
+		results[i] = runBatchOperation(ctx, next, parent, i, args.Operations[i])
+	}
+
+	if args.Strategy == BatchStrategyParallel {
+		sem := make(chan struct{}, args.MaxConcurrency)
+		var wg sync.WaitGroup
+		for i := range args.Operations {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func() {
+				defer func() { <-sem; wg.Done() }()
+				run(i)
+			}()
+		}
+		wg.Wait()
+	} else {
+		stopOnError := args.StopOnError == nil || *args.StopOnError
+		failed := false
+		for i, op := range args.Operations {
+			if failed && stopOnError {
+				results[i] = BatchOperationResult{Index: i, ID: op.ID, Tool: op.Tool, Status: BatchStatusSkipped}
+				continue
+			}
+			run(i)
+			failed = failed || results[i].Status == BatchStatusFailed
+		}
+	}
+
+	out := &BatchResult{Strategy: args.Strategy, Results: results}
+	for _, r := range results {
+		switch r.Status {
+		case BatchStatusSucceeded:
+			out.Succeeded++
+		case BatchStatusFailed:
+			out.Failed++
+		case BatchStatusSkipped:
+			out.Skipped++
+		}
+	}
+	return out
+}
+
+// runBatchOperation dispatches one operation as a tools/call request in the
+// session of the batch call and converts its outcome into an operation result.
+func runBatchOperation(
+	ctx context.Context, next mcp.MethodHandler, parent *mcp.CallToolRequest, index int, op BatchOperation,
+) BatchOperationResult {
+	out := BatchOperationResult{Index: index, ID: op.ID, Tool: op.Tool}
+	if ctx.Err() != nil {
+		out.Status = BatchStatusSkipped
+		return out
+	}
+	arguments := op.Arguments
+	if len(arguments) == 0 {
+		arguments = json.RawMessage("{}")
+	}
+	req := &mcp.CallToolRequest{
+		Session: parent.Session,
+		Params:  &mcp.CallToolParamsRaw{Name: op.Tool, Arguments: arguments},
+		Extra:   parent.Extra,
+	}
+
+	result, err := next(ctx, methodCallTool, req)
+	if err != nil {
+		out.Status = BatchStatusFailed
+		out.Error = ClassifyError(err, nil)
+		return out
+	}
+	res, ok := result.(*mcp.CallToolResult)
+	if !ok || res == nil {
+		out.Status = BatchStatusFailed
+		out.Error = NewToolError(ErrorCodeInternal, fmt.Errorf("tool %q returned an unexpected result", op.Tool), nil)
+		return out
+	}
+	if res.IsError {
+		out.Status = BatchStatusFailed
+		out.Error = batchOperationError(res)
+		return out
+	}
+	out.Status = BatchStatusSucceeded
+	out.Result = batchOperationOutput(res)
+	return out
+}
+
+// batchOperationError extracts the ToolError of a failed operation. Results that
+// went through the error envelope middleware carry it in their structured content.
+func batchOperationError(res *mcp.CallToolResult) *ToolError {
+	if envelope, ok := res.StructuredContent.(map[string]any); ok {
+		if te, ok := envelope["error"].(*ToolError); ok {
+			return te
+		}
+	}
+	if err := res.GetError(); err != nil {
+		return ClassifyError(err, nil)
+	}
+	return NewToolError(ErrorCodeInternal, errors.New(firstTextContent(res)), nil)
+}
+
+// batchOperationOutput returns the structured output of a successful operation,
+// falling back to its decoded or raw text content.
+func batchOperationOutput(res *mcp.CallToolResult) any {
+	if res.StructuredContent != nil {
+		return res.StructuredContent
+	}
+	text := firstTextContent(res)
+	var decoded any
+	if err := json.Unmarshal([]byte(text), &decoded); err == nil {
+		return decoded
+	}
+	return text
+}
+
+func firstTextContent(res *mcp.CallToolResult) string {
+	for _, c := range res.Content {
+		if tc, ok := c.(*mcp.TextContent); ok {
+			return tc.Text
+		}
+	}
+	return ""
+}
+
+// batchErrorResult reports invalid batch arguments. The batch middleware runs
+// outside the error envelope middleware, so the envelope is set here.
+func batchErrorResult(err error) *mcp.CallToolResult {
+	te := NewToolError(ErrorCodeValidationError, err, nil)
+	res := &mcp.CallToolResult{}
+	res.SetError(te)
+	setErrorEnvelope(res, te)
+	return res
+}
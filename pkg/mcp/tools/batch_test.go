@@ -0,0 +1,212 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// connectBatchServer installs the batch, error envelope and tool filter middleware
+// in the same order as the HTTP server and connects a client to server.
+func connectBatchServer(t *testing.T, server *mcp.Server, perms map[string]ToolPermission, pdp *mockPDP) *mcp.ClientSession {
+	t.Helper()
+	middleware := []mcp.Middleware{NewBatchMiddleware(), NewErrorEnvelopeMiddleware(nil)}
+	if pdp != nil {
+		middleware = append(middleware, NewToolFilterMiddleware(pdp, perms, nil))
+	}
+	server.AddReceivingMiddleware(middleware...)
+
+	ctx := ctxWithSubject(context.Background())
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	if _, err := server.Connect(ctx, serverTransport, nil); err != nil {
+		t.Fatalf("server connect: %v", err)
+	}
+	cs, err := mcp.NewClient(&mcp.Implementation{Name: "batch-test-client"}, nil).Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("client connect: %v", err)
+	}
+	t.Cleanup(func() { cs.Close() })
+	return cs
+}
+
+func callBatch(t *testing.T, cs *mcp.ClientSession, args map[string]any) BatchResult {
+	t.Helper()
+	res, err := cs.CallTool(context.Background(), &mcp.CallToolParams{Name: BatchToolName, Arguments: args})
+	if err != nil {
+		t.Fatalf("CallTool returned protocol error: %v", err)
+	}
+	if res.IsError {
+		t.Fatalf("batch call failed: %s", firstText(res))
+	}
+	var out BatchResult
+	if err := json.Unmarshal([]byte(firstText(res)), &out); err != nil {
+		t.Fatalf("decode batch result: %v", err)
+	}
+	return out
+}
+
+func TestBatchSequentialStopsOnError(t *testing.T) {
+	mock := NewMockCoreToolsetHandler()
+	toolsets := &Toolsets{NamespaceToolset: mock, ProjectToolset: mock, Batch: true}
+	server := mcp.NewServer(&mcp.Implementation{Name: "batch-test"}, nil)
+	perms, _ := toolsets.Register(server)
+	cs := connectBatchServer(t, server, perms, nil)
+
+	out := callBatch(t, cs, map[string]any{
+		"operations": []any{
+			map[string]any{"id": "create", "tool": "create_project", "arguments": map[string]any{
+				"namespace_name": testNamespaceName, "name": "web",
+			}},
+			map[string]any{"tool": "create_project", "arguments": map[string]any{}},
+			map[string]any{"tool": "list_projects", "arguments": map[string]any{"namespace_name": testNamespaceName}},
+		},
+	})
+
+	if out.Strategy != BatchStrategySequential {
+		t.Errorf("strategy = %q, want %q", out.Strategy, BatchStrategySequential)
+	}
+	wantStatus := []string{BatchStatusSucceeded, BatchStatusFailed, BatchStatusSkipped}
+	for i, r := range out.Results {
+		if r.Index != i || r.Status != wantStatus[i] {
+			t.Errorf("results[%d] = {index %d, status %q}, want status %q", i, r.Index, r.Status, wantStatus[i])
+		}
+	}
+	if out.Results[0].ID != "create" {
+		t.Errorf("results[0].id = %q, want create", out.Results[0].ID)
+	}
+	if out.Results[1].Error == nil {
+		t.Error("expected an error for the operation with missing arguments")
+	}
+	if out.Succeeded != 1 || out.Failed != 1 || out.Skipped != 1 {
+		t.Errorf("counts = %d/%d/%d, want 1/1/1", out.Succeeded, out.Failed, out.Skipped)
+	}
+	if len(mock.calls["CreateProject"]) != 1 || len(mock.calls["ListProjects"]) != 0 {
+		t.Errorf("unexpected handler calls: %v", mock.calls)
+	}
+}
+
+func TestBatchSequentialContinueOnError(t *testing.T) {
+	mock := NewMockCoreToolsetHandler()
+	toolsets := &Toolsets{ProjectToolset: mock, Batch: true}
+	server := mcp.NewServer(&mcp.Implementation{Name: "batch-test"}, nil)
+	perms, _ := toolsets.Register(server)
+	cs := connectBatchServer(t, server, perms, nil)
+
+	out := callBatch(t, cs, map[string]any{
+		"stop_on_error": false,
+		"operations": []any{
+			map[string]any{"tool": "no_such_tool"},
+			map[string]any{"tool": "list_projects", "arguments": map[string]any{"namespace_name": testNamespaceName}},
+		},
+	})
+	if out.Failed != 1 || out.Succeeded != 1 {
+		t.Errorf("counts = %d succeeded/%d failed, want 1/1", out.Succeeded, out.Failed)
+	}
+	if out.Results[1].Result == nil {
+		t.Error("expected the list_projects output in the operation result")
+	}
+}
+
+func TestBatchParallel(t *testing.T) {
+	server := mcp.NewServer(&mcp.Implementation{Name: "batch-test"}, nil)
+	perms := map[string]ToolPermission{}
+	(&Toolsets{}).RegisterBatchToolCalls(server, perms)
+
+	var inFlight, peak atomic.Int32
+	mcp.AddTool(server, &mcp.Tool{Name: "slow", InputSchema: createSchema(map[string]any{}, nil)},
+		func(context.Context, *mcp.CallToolRequest, struct{}) (*mcp.CallToolResult, any, error) {
+			n := inFlight.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			inFlight.Add(-1)
+			return handleToolResult(map[string]any{"ok": true}, nil)
+		})
+	cs := connectBatchServer(t, server, perms, nil)
+
+	ops := make([]any, 6)
+	for i := range ops {
+		ops[i] = map[string]any{"tool": "slow"}
+	}
+	out := callBatch(t, cs, map[string]any{"strategy": "parallel", "max_concurrency": 2, "operations": ops})
+
+	if out.Succeeded != len(ops) {
+		t.Errorf("succeeded = %d, want %d", out.Succeeded, len(ops))
+	}
+	for i, r := range out.Results {
+		if r.Index != i {
+			t.Errorf("results[%d].index = %d; results must keep the operation order", i, r.Index)
+		}
+	}
+	if got := peak.Load(); got > 2 {
+		t.Errorf("peak concurrency = %d, want at most 2", got)
+	}
+}
+
+func TestBatchOperationsAreAuthorizedIndividually(t *testing.T) {
+	mock := NewMockCoreToolsetHandler()
+	toolsets := &Toolsets{ProjectToolset: mock, Batch: true}
+	server := mcp.NewServer(&mcp.Implementation{Name: "batch-test"}, nil)
+	perms, _ := toolsets.Register(server)
+	pdp := &mockPDP{profile: allowAllProfile("project:view")}
+	cs := connectBatchServer(t, server, perms, pdp)
+
+	out := callBatch(t, cs, map[string]any{
+		"stop_on_error": false,
+		"operations": []any{
+			map[string]any{"tool": "create_project", "arguments": map[string]any{
+				"namespace_name": testNamespaceName, "name": "web",
+			}},
+			map[string]any{"tool": "list_projects", "arguments": map[string]any{"namespace_name": testNamespaceName}},
+		},
+	})
+	if out.Results[0].Status != BatchStatusFailed || out.Results[0].Error.Code != ErrorCodeForbidden {
+		t.Errorf("create_project result = %+v, want a Forbidden failure", out.Results[0])
+	}
+	if out.Results[1].Status != BatchStatusSucceeded {
+		t.Errorf("list_projects status = %q, want %q", out.Results[1].Status, BatchStatusSucceeded)
+	}
+	if len(mock.calls["CreateProject"]) != 0 {
+		t.Error("unauthorized operation must not reach the handler")
+	}
+}
+
+func TestBatchInvalidArguments(t *testing.T) {
+	server := mcp.NewServer(&mcp.Implementation{Name: "batch-test"}, nil)
+	perms := map[string]ToolPermission{}
+	(&Toolsets{}).RegisterBatchToolCalls(server, perms)
+	cs := connectBatchServer(t, server, perms, nil)
+
+	tests := []struct {
+		name string
+		args map[string]any
+	}{
+		{"nested batch", map[string]any{"operations": []any{map[string]any{"tool": BatchToolName}}}},
+		{"unknown strategy", map[string]any{"strategy": "random", "operations": []any{map[string]any{"tool": "x"}}}},
+		{"concurrency too high", map[string]any{
+			"strategy": "parallel", "max_concurrency": 50, "operations": []any{map[string]any{"tool": "x"}},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := cs.CallTool(context.Background(), &mcp.CallToolParams{Name: BatchToolName, Arguments: tt.args})
+			if err != nil {
+				t.Fatalf("CallTool returned protocol error: %v", err)
+			}
+			if code := decodeToolError(t, res).Code; code != ErrorCodeValidationError {
+				t.Errorf("code = %q, want %q", code, ErrorCodeValidationError)
+			}
+		})
+	}
+}
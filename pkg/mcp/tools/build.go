@@ -0,0 +1,89 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	authzcore "github.com/openchoreo/openchoreo/internal/authz/core"
+)
+
+func (t *Toolsets) RegisterTriggerBuild(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "trigger_build"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionCreateWorkflowRun}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Trigger a build of a component from source using the component's configured workflow. " +
+			"Optionally build a specific commit SHA and/or branch; both are injected at the repository " +
+			"parameters the workflow schema maps. Returns a build_id that can be polled with get_build_status.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"component_name": stringProperty("Use list_components to discover valid names"),
+			"project_name": stringProperty(
+				"Optional: owning project. Derived from the component when omitted."),
+			"commit": stringProperty("Optional: Git commit SHA (7-40 hex characters) to build"),
+			"branch": stringProperty(
+				"Optional: Git branch to build. Fails if the workflow does not map a repository branch parameter."),
+		}, []string{"namespace_name", "component_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string `json:"namespace_name"`
+		ComponentName string `json:"component_name"`
+		ProjectName   string `json:"project_name"`
+		Commit        string `json:"commit"`
+		Branch        string `json:"branch"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.BuildToolset.TriggerBuild(
+			ctx, args.NamespaceName, args.ProjectName, args.ComponentName, args.Commit, args.Branch)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterGetBuildStatus(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "get_build_status"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionViewWorkflowRun}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Get the status of a build started with trigger_build. Returns the overall phase " +
+			"(Pending, Running, Succeeded, Failed) and per-step progress. Poll until the phase is terminal; " +
+			"use get_workflow_run_logs with the same build_id to inspect failures.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"build_id":       stringProperty("Build identifier returned by trigger_build"),
+		}, []string{"namespace_name", "build_id"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string `json:"namespace_name"`
+		BuildID       string `json:"build_id"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.BuildToolset.GetWorkflowRunStatus(ctx, args.NamespaceName, args.BuildID)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterGetBuildSBOM(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "get_build_sbom"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionViewWorkflowRun}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Get the software bill of materials (SBOM) reference of a build's image. Pass build_id " +
+			"for a specific build, or component_name and environment_name for the image currently deployed " +
+			"there. Returns the SBOM format (SPDX or CycloneDX) and the OCI reference it is stored at.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name":   defaultStringProperty(),
+			"build_id":         stringProperty("Build identifier returned by trigger_build"),
+			"component_name":   stringProperty("Component whose deployed image to look up. Use with environment_name"),
+			"environment_name": stringProperty("Environment the component is deployed to. Use with component_name"),
+		}, []string{"namespace_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName   string `json:"namespace_name"`
+		BuildID         string `json:"build_id"`
+		ComponentName   string `json:"component_name"`
+		EnvironmentName string `json:"environment_name"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.BuildToolset.GetBuildSBOM(
+			ctx, args.NamespaceName, args.BuildID, args.ComponentName, args.EnvironmentName)
+		return handleToolResult(result, err)
+	})
+}
@@ -7,7 +7,7 @@ import "testing"
 
 // buildToolSpecs returns test specs for build toolset
 func buildToolSpecs() []toolTestSpec {
-	specs := make([]toolTestSpec, 0, 12)
+	specs := make([]toolTestSpec, 0, 14)
 	specs = append(specs, buildWorkflowRunSpecs()...)
 	specs = append(specs, buildWorkflowSpecs()...)
 	specs = append(specs, buildClusterWorkflowSpecs()...)
@@ -38,6 +38,64 @@ func buildWorkflowRunSpecs() []toolTestSpec {
 				}
 			},
 		},
+		{
+			name:                "trigger_build",
+			toolset:             "build",
+			descriptionKeywords: []string{"build", "commit", "branch"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "component_name"},
+			optionalParams:      []string{"project_name", "commit", "branch"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"component_name": testComponentName,
+				"branch":         "release-1.2",
+			},
+			expectedMethod: "TriggerBuild",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != "" || args[2] != testComponentName ||
+					args[3] != "" || args[4] != "release-1.2" {
+					t.Errorf("Expected (%s, \"\", %s, \"\", release-1.2), got %v",
+						testNamespaceName, testComponentName, args)
+				}
+			},
+		},
+		{
+			name:                "get_build_status",
+			toolset:             "build",
+			descriptionKeywords: []string{"build", "status"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "build_id"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"build_id":       "my-component-run-abc12345",
+			},
+			expectedMethod: "GetWorkflowRunStatus",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != "my-component-run-abc12345" {
+					t.Errorf("Expected (%s, my-component-run-abc12345), got (%v, %v)",
+						testNamespaceName, args[0], args[1])
+				}
+			},
+		},
+		{
+			name:                "get_build_sbom",
+			toolset:             "build",
+			descriptionKeywords: []string{"sbom", "build"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name"},
+			optionalParams:      []string{"build_id", "component_name", "environment_name"},
+			testArgs: map[string]any{
+				"namespace_name":   testNamespaceName,
+				"component_name":   testComponentName,
+				"environment_name": "production",
+			},
+			expectedMethod: "GetBuildSBOM",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != "" || args[2] != testComponentName || args[3] != "production" {
+					t.Errorf("Expected (%s, \"\", %s, production), got %v", testNamespaceName, testComponentName, args)
+				}
+			},
+		},
 		{
 			name:                "create_workflow_run",
 			toolset:             "build",
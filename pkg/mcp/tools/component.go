@@ -219,6 +219,168 @@ func (t *Toolsets) RegisterCreateComponent(s *mcp.Server, perms map[string]ToolP
 	})
 }
 
+func (t *Toolsets) RegisterScaffoldComponent(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "scaffold_component"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionCreateComponent}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Create a ready-to-deploy component in one call from a component class (a ComponentType " +
+			"or ClusterComponentType). The component takes the class's parameter defaults. Either pass " +
+			"repository_url to build from source with the class's build workflow, or pass image to run a " +
+			"prebuilt image, in which case the workload is created as well, with an HTTP endpoint for services " +
+			"and web applications. Use create_component and create_workload instead for full control.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"project_name":   defaultStringProperty(),
+			"name":           stringProperty("DNS-compatible identifier (lowercase, alphanumeric, hyphens only, max 63 chars)"),
+			"display_name":   stringProperty("Human-readable display name"),
+			"description":    stringProperty("Human-readable description"),
+			"type": map[string]any{
+				"type": "string",
+				"enum": []string{"Service", "WebApplication", "ScheduledTask"},
+				"description": "Kind of component. Services and web applications need a deployment class, " +
+					"scheduled tasks a cronjob class.",
+			},
+			"class_name": stringProperty("Name of the (Cluster)ComponentType. " +
+				"Use list_component_types or list_cluster_component_types to discover valid names."),
+			"class_kind": stringProperty("Optional: \"ComponentType\" or \"ClusterComponentType\". " +
+				"Defaults to the namespace-scoped ComponentType when one exists."),
+			"parameters": map[string]any{
+				"type":        "object",
+				"description": "Optional: parameters overriding the class defaults",
+			},
+			"auto_deploy": map[string]any{
+				"type":        "boolean",
+				"description": "Optional: deploy the component automatically when it changes. Defaults to true.",
+			},
+			"repository_url": stringProperty("Git repository to build the component from"),
+			"branch":         stringProperty("Optional: branch to build"),
+			"app_path":       stringProperty("Optional: path of the application within the repository"),
+			"workflow_name": stringProperty("Optional: build workflow. Defaults to the first workflow " +
+				"the class allows."),
+			"workflow_kind": stringProperty("Optional: \"ClusterWorkflow\" (default) or \"Workflow\""),
+			"workflow_parameters": map[string]any{
+				"type":        "object",
+				"description": "Optional: further parameters of the build workflow",
+			},
+			"image": stringProperty("Prebuilt container image to run"),
+			"port":  intProperty("Optional: endpoint port of services and web applications. Defaults to 8080."),
+		}, []string{"namespace_name", "project_name", "name", "type", "class_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName      string         `json:"namespace_name"`
+		ProjectName        string         `json:"project_name"`
+		Name               string         `json:"name"`
+		DisplayName        string         `json:"display_name"`
+		Description        string         `json:"description"`
+		Type               string         `json:"type"`
+		ClassName          string         `json:"class_name"`
+		ClassKind          string         `json:"class_kind"`
+		Parameters         map[string]any `json:"parameters"`
+		AutoDeploy         *bool          `json:"auto_deploy,omitempty"`
+		RepositoryURL      string         `json:"repository_url"`
+		Branch             string         `json:"branch"`
+		AppPath            string         `json:"app_path"`
+		WorkflowName       string         `json:"workflow_name"`
+		WorkflowKind       string         `json:"workflow_kind"`
+		WorkflowParameters map[string]any `json:"workflow_parameters"`
+		Image              string         `json:"image"`
+		Port               int32          `json:"port"`
+	}) (*mcp.CallToolResult, any, error) {
+		opts := ScaffoldComponentOpts{
+			Name:               args.Name,
+			DisplayName:        args.DisplayName,
+			Description:        args.Description,
+			Type:               args.Type,
+			ClassName:          args.ClassName,
+			ClassKind:          args.ClassKind,
+			Parameters:         args.Parameters,
+			AutoDeploy:         args.AutoDeploy == nil || *args.AutoDeploy,
+			RepositoryURL:      args.RepositoryURL,
+			Branch:             args.Branch,
+			AppPath:            args.AppPath,
+			WorkflowName:       args.WorkflowName,
+			WorkflowKind:       args.WorkflowKind,
+			WorkflowParameters: args.WorkflowParameters,
+			Image:              args.Image,
+			Port:               args.Port,
+		}
+		result, err := t.ComponentToolset.ScaffoldComponent(ctx, args.NamespaceName, args.ProjectName, opts)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterImportComponent(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "import_component"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionCreateComponent}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Import a Git repository as a component in one step. Reads the component descriptor " +
+			"(.choreo/component.yaml, falling back to workload.yaml) at a ref and creates the component " +
+			"and, for components that run a prebuilt image, its workload with the endpoints, dependencies " +
+			"and configurations of the descriptor. Components without an image are built from the repository. " +
+			"Only public GitHub, GitLab and Bitbucket repositories are supported. Use dry_run to preview " +
+			"the generated resources.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name":  defaultStringProperty(),
+			"project_name":    defaultStringProperty(),
+			"repository_url":  stringProperty("Git repository to import"),
+			"ref":             stringProperty("Optional: branch, tag or commit to read the descriptor at. Defaults to main."),
+			"app_path":        stringProperty("Optional: path of the application within the repository"),
+			"descriptor_path": stringProperty("Optional: descriptor location relative to app_path"),
+			"name":            stringProperty("Optional: component name. Defaults to metadata.name of the descriptor."),
+			"type": map[string]any{
+				"type": "string",
+				"enum": []string{"Service", "WebApplication", "ScheduledTask"},
+				"description": "Optional: kind of component. Defaults to the type of the descriptor, " +
+					"or Service.",
+			},
+			"class_name": stringProperty("Optional: name of the (Cluster)ComponentType. " +
+				"Defaults to componentType of the descriptor."),
+			"class_kind": stringProperty("Optional: \"ComponentType\" or \"ClusterComponentType\". " +
+				"Defaults to the namespace-scoped ComponentType when one exists."),
+			"image": stringProperty("Optional: prebuilt container image to run. Defaults to image of the descriptor."),
+			"auto_deploy": map[string]any{
+				"type":        "boolean",
+				"description": "Optional: deploy the component automatically when it changes. Defaults to true.",
+			},
+			"dry_run": map[string]any{
+				"type":        "boolean",
+				"description": "Optional: return the generated component and workload without creating them",
+			},
+		}, []string{"namespace_name", "project_name", "repository_url"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName  string `json:"namespace_name"`
+		ProjectName    string `json:"project_name"`
+		RepositoryURL  string `json:"repository_url"`
+		Ref            string `json:"ref"`
+		AppPath        string `json:"app_path"`
+		DescriptorPath string `json:"descriptor_path"`
+		Name           string `json:"name"`
+		Type           string `json:"type"`
+		ClassName      string `json:"class_name"`
+		ClassKind      string `json:"class_kind"`
+		Image          string `json:"image"`
+		AutoDeploy     *bool  `json:"auto_deploy,omitempty"`
+		DryRun         bool   `json:"dry_run"`
+	}) (*mcp.CallToolResult, any, error) {
+		opts := ImportComponentOpts{
+			RepositoryURL:  args.RepositoryURL,
+			Ref:            args.Ref,
+			AppPath:        args.AppPath,
+			DescriptorPath: args.DescriptorPath,
+			Name:           args.Name,
+			Type:           args.Type,
+			ClassName:      args.ClassName,
+			ClassKind:      args.ClassKind,
+			Image:          args.Image,
+			AutoDeploy:     args.AutoDeploy == nil || *args.AutoDeploy,
+			DryRun:         args.DryRun,
+		}
+		result, err := t.ComponentToolset.ImportComponent(ctx, args.NamespaceName, args.ProjectName, opts)
+		return handleToolResult(result, err)
+	})
+}
+
 //nolint:dupl // paginated list handlers share similar structure
 func (t *Toolsets) RegisterListReleaseBindings(s *mcp.Server, perms map[string]ToolPermission) {
 	const name = "list_release_bindings"
@@ -610,6 +772,33 @@ func (t *Toolsets) RegisterGetComponentSchema(s *mcp.Server, perms map[string]To
 	})
 }
 
+func (t *Toolsets) RegisterGetComponentDependencyGraph(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "get_component_dependency_graph"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionViewWorkload}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Get the dependency graph between components, built from their workloads. Nodes are " +
+			"components, project-bound resources, external services and secrets shared by several components; " +
+			"edges are endpoint connections, resource dependencies, external service connections and shared " +
+			"secret references. Pass component_name " +
+			"to also get its direct dependencies, direct dependents and every component that transitively " +
+			"depends on it, to assess the impact of changing or deleting it.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"project_name":   stringProperty("Optional: only report nodes and edges touching this project"),
+			"component_name": stringProperty("Optional: component to analyze the impact of"),
+		}, []string{"namespace_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string `json:"namespace_name"`
+		ProjectName   string `json:"project_name"`
+		ComponentName string `json:"component_name"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.ComponentToolset.GetComponentDependencyGraph(
+			ctx, args.NamespaceName, args.ProjectName, args.ComponentName)
+		return handleToolResult(result, err)
+	})
+}
+
 func (t *Toolsets) RegisterTriggerWorkflowRun(s *mcp.Server, perms map[string]ToolPermission) {
 	const name = "trigger_workflow_run"
 	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionCreateWorkflowRun}
@@ -784,6 +973,531 @@ func (t *Toolsets) RegisterDeleteReleaseBinding(s *mcp.Server, perms map[string]
 	})
 }
 
+func (t *Toolsets) RegisterGetComponentEndpoints(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "get_component_endpoints"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionViewReleaseBinding}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Get the invokable URLs of a component's endpoints in each environment it is deployed to. " +
+			"Each URL is labelled with its visibility (external or internal gateway, or in-cluster service) " +
+			"and broken down into scheme, host, port and path, ready to use with curl. Optionally restrict " +
+			"to a single environment.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"component_name": stringProperty("Use list_components to discover valid names"),
+			"environment":    stringProperty("Optional: only report endpoints in this environment"),
+		}, []string{"namespace_name", "component_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string `json:"namespace_name"`
+		ComponentName string `json:"component_name"`
+		Environment   string `json:"environment"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.GetComponentEndpoints(
+			ctx, args.NamespaceName, args.ComponentName, args.Environment)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterListEndpoints(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "list_endpoints"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionViewWorkload}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "List the endpoints exposed by the components of a namespace. Each endpoint reports its " +
+			"type, port, base path and visibility (project, namespace, internal or external), and for each " +
+			"environment the component is deployed to, the in-cluster service backing it and its internal " +
+			"and external gateway hosts. Optionally filter by project, component or environment.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"project_name":   stringProperty("Optional: only list endpoints of components in this project"),
+			"component_name": stringProperty("Optional: only list endpoints of this component"),
+			"environment":    stringProperty("Optional: only report addresses in this environment"),
+		}, []string{"namespace_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string `json:"namespace_name"`
+		ProjectName   string `json:"project_name"`
+		ComponentName string `json:"component_name"`
+		Environment   string `json:"environment"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.ListEndpoints(
+			ctx, args.NamespaceName, args.ProjectName, args.ComponentName, args.Environment)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterUpdateEndpoint(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "update_endpoint"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionUpdateWorkload}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Change the visibility or base path of a component endpoint. The change is made on the " +
+			"component's workload and rolled out like any other workload change. Project visibility is " +
+			"implicit and always kept; external visibility exposes the endpoint on the public gateway. " +
+			"Use list_endpoints to discover endpoint names.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"component_name": stringProperty("Use list_components to discover valid names"),
+			"endpoint_name":  stringProperty("Use list_endpoints to discover valid names"),
+			"visibility": map[string]any{
+				"type": "array",
+				"description": "Optional: replace the visibilities of the endpoint. " +
+					"Pass [\"project\"] to restrict it to its own project.",
+				"items": map[string]any{
+					"type": "string",
+					"enum": []string{"project", "namespace", "internal", "external"},
+				},
+			},
+			"base_path": stringProperty("Optional: base path of the API, starting with '/'"),
+		}, []string{"namespace_name", "component_name", "endpoint_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string    `json:"namespace_name"`
+		ComponentName string    `json:"component_name"`
+		EndpointName  string    `json:"endpoint_name"`
+		Visibility    *[]string `json:"visibility"`
+		BasePath      *string   `json:"base_path"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.UpdateEndpoint(
+			ctx, args.NamespaceName, args.ComponentName, args.EndpointName, EndpointOpts{
+				Visibility: args.Visibility,
+				BasePath:   args.BasePath,
+			})
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterScaleComponent(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "scale_component"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionUpdateReleaseBinding}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Scale a component in a specific environment by setting a fixed replica count and/or " +
+			"autoscaling bounds on its release binding. Values are validated against the environment " +
+			"configuration schema of the component's type: the type must expose replicas (or autoscaling " +
+			"minReplicas/maxReplicas) and any minimum/maximum limits it declares are enforced.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"component_name": stringProperty("Use list_components to discover valid names"),
+			"environment":    stringProperty("Environment to scale the component in, e.g. development or production"),
+			"replicas":       intProperty("Optional: fixed number of replicas"),
+			"min_replicas":   intProperty("Optional: lower autoscaling bound"),
+			"max_replicas":   intProperty("Optional: upper autoscaling bound"),
+		}, []string{"namespace_name", "component_name", "environment"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string `json:"namespace_name"`
+		ComponentName string `json:"component_name"`
+		Environment   string `json:"environment"`
+		Replicas      *int32 `json:"replicas"`
+		MinReplicas   *int32 `json:"min_replicas"`
+		MaxReplicas   *int32 `json:"max_replicas"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.ScaleComponent(
+			ctx, args.NamespaceName, args.ComponentName, args.Environment, ScaleOpts{
+				Replicas:    args.Replicas,
+				MinReplicas: args.MinReplicas,
+				MaxReplicas: args.MaxReplicas,
+			})
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterRestartComponent(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "restart_component"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionUpdateReleaseBinding}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Perform a rolling restart of a component's Deployments and StatefulSets in a specific " +
+			"environment without changing its release. Useful to pick up rotated secrets or recover from a " +
+			"bad runtime state. Returns the release binding's current status; use get_resource_tree to " +
+			"follow the rollout.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"component_name": stringProperty("Use list_components to discover valid names"),
+			"environment":    stringProperty("Environment to restart the component in"),
+		}, []string{"namespace_name", "component_name", "environment"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string `json:"namespace_name"`
+		ComponentName string `json:"component_name"`
+		Environment   string `json:"environment"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.RestartComponent(ctx, args.NamespaceName, args.ComponentName, args.Environment)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterGetComponentConfiguration(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "get_component_configuration"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionViewReleaseBinding}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Get the configuration (environment variables and files) of a component. Returns the " +
+			"base values from the workload and, for each environment, the per-environment overrides and the " +
+			"effective merged configuration. Secret-backed values are shown as secret references.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"component_name": stringProperty("Use list_components to discover valid names"),
+			"environment":    stringProperty("Optional: only report this environment"),
+		}, []string{"namespace_name", "component_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string `json:"namespace_name"`
+		ComponentName string `json:"component_name"`
+		Environment   string `json:"environment"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.GetComponentConfiguration(
+			ctx, args.NamespaceName, args.ComponentName, args.Environment)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterSetComponentEnvVar(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "set_component_env_var"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionUpdateReleaseBinding}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Set a single environment variable override for a component in one environment. Only " +
+			"the given key is changed; all other overrides are preserved. Provide either a literal value or " +
+			"a secret reference (secret_name and secret_key). Set secret to true to store the value in the " +
+			"environment's external secret store and reference it instead of keeping it in plain text.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"component_name": stringProperty("Use list_components to discover valid names"),
+			"environment":    stringProperty("Environment to set the variable in"),
+			"key":            stringProperty("Environment variable name"),
+			"value":          stringProperty("Optional: literal value"),
+			"secret_name":    stringProperty("Optional: name of the secret reference holding the value"),
+			"secret_key":     stringProperty("Optional: key within the secret reference"),
+			"secret": map[string]any{
+				"type":        "boolean",
+				"description": "Optional: store value in the external secret store instead of inline",
+			},
+		}, []string{"namespace_name", "component_name", "environment", "key"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string `json:"namespace_name"`
+		ComponentName string `json:"component_name"`
+		Environment   string `json:"environment"`
+		Key           string `json:"key"`
+		Value         string `json:"value"`
+		SecretName    string `json:"secret_name"`
+		SecretKey     string `json:"secret_key"`
+		Secret        bool   `json:"secret"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.SetComponentEnvVar(
+			ctx, args.NamespaceName, args.ComponentName, args.Environment, EnvVarOpts{
+				Key:        args.Key,
+				Value:      args.Value,
+				SecretName: args.SecretName,
+				SecretKey:  args.SecretKey,
+				Secret:     args.Secret,
+			})
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterUnsetComponentEnvVar(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "unset_component_env_var"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionUpdateReleaseBinding}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Remove a single environment variable override of a component in one environment, " +
+			"reverting the variable to the value defined in the workload (if any).",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"component_name": stringProperty("Use list_components to discover valid names"),
+			"environment":    stringProperty("Environment to remove the override from"),
+			"key":            stringProperty("Environment variable name"),
+		}, []string{"namespace_name", "component_name", "environment", "key"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string `json:"namespace_name"`
+		ComponentName string `json:"component_name"`
+		Environment   string `json:"environment"`
+		Key           string `json:"key"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.UnsetComponentEnvVar(
+			ctx, args.NamespaceName, args.ComponentName, args.Environment, args.Key)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterListComponentSecrets(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "list_component_secrets"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionViewSecretReference}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "List the secret references consumed by a component through environment variables and " +
+			"files, from its workload and per-environment overrides. Reports secret names, declared keys and " +
+			"where each is used. Secret values are never returned.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"component_name": stringProperty("Use list_components to discover valid names"),
+			"environment":    stringProperty("Optional: only include overrides of this environment"),
+		}, []string{"namespace_name", "component_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string `json:"namespace_name"`
+		ComponentName string `json:"component_name"`
+		Environment   string `json:"environment"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.ListComponentSecrets(
+			ctx, args.NamespaceName, args.ComponentName, args.Environment)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterSetSecretValue(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "set_secret_value"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionUpdateSecret}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Set or rotate the value of a single key of a secret by writing it to the secret store " +
+			"of the secret's target plane. Other keys are preserved. Write-only: the response lists key names " +
+			"but never secret values. Use list_component_secrets to discover secret names.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"secret_name":    stringProperty("Name of the secret"),
+			"key":            stringProperty("Key within the secret to set"),
+			"value":          stringProperty("New value for the key"),
+		}, []string{"namespace_name", "secret_name", "key", "value"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string `json:"namespace_name"`
+		SecretName    string `json:"secret_name"`
+		Key           string `json:"key"`
+		Value         string `json:"value"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.SetSecretValue(
+			ctx, args.NamespaceName, args.SecretName, args.Key, args.Value)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterPromoteComponent(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "promote_component"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionCreatePromotionRequest}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Promote a component from a source environment to a target environment by requesting " +
+			"a promotion of the component release currently bound in the source environment. Fails unless " +
+			"the project's deployment pipeline has a promotion path from source to target; use " +
+			"get_deployment_pipeline to inspect valid paths. When the target environment requires approval " +
+			"the promotion request stays pending until approve_promotion_request or reject_promotion_request " +
+			"is called; otherwise the release is bound to the target right away.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name":     defaultStringProperty(),
+			"component_name":     stringProperty("Use list_components to discover valid names"),
+			"source_environment": stringProperty("Environment to promote the current release from"),
+			"target_environment": stringProperty("Environment to promote the release to"),
+			"comment":            stringProperty("Optional: note for the approvers"),
+		}, []string{"namespace_name", "component_name", "source_environment", "target_environment"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName     string `json:"namespace_name"`
+		ComponentName     string `json:"component_name"`
+		SourceEnvironment string `json:"source_environment"`
+		TargetEnvironment string `json:"target_environment"`
+		Comment           string `json:"comment"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.PromoteComponent(
+			ctx, args.NamespaceName, args.ComponentName, args.SourceEnvironment, args.TargetEnvironment, args.Comment)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterListPromotionRequests(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "list_promotion_requests"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionViewPromotionRequest}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "List promotion requests, optionally for a single component. Each request shows the " +
+			"source and target environments, the release being promoted and its phase (PendingApproval, " +
+			"Promoted, Rejected). Supports pagination via limit and cursor.",
+		InputSchema: createSchema(addPaginationProperties(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"component_name": stringProperty("Optional: only list promotion requests of this component"),
+		}), []string{"namespace_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string `json:"namespace_name"`
+		ComponentName string `json:"component_name"`
+		Limit         int    `json:"limit,omitempty"`
+		Cursor        string `json:"cursor,omitempty"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.ListPromotionRequests(
+			ctx, args.NamespaceName, args.ComponentName, ListOpts{Limit: args.Limit, Cursor: args.Cursor})
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterGetPromotionRequest(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "get_promotion_request"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionViewPromotionRequest}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Get a promotion request with its phase, whether approval is required, the approval " +
+			"decision when one was made and the target release binding once promoted.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name":         defaultStringProperty(),
+			"promotion_request_name": stringProperty("Use list_promotion_requests to discover valid names"),
+		}, []string{"namespace_name", "promotion_request_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName        string `json:"namespace_name"`
+		PromotionRequestName string `json:"promotion_request_name"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.GetPromotionRequest(ctx, args.NamespaceName, args.PromotionRequestName)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterApprovePromotionRequest(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "approve_promotion_request"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionApprovePromotionRequest}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Approve a pending promotion request and bind its release to the target environment. " +
+			"Requires the promotion approver permission for the target environment. Fails if the request " +
+			"is not pending approval.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name":         defaultStringProperty(),
+			"promotion_request_name": stringProperty("Use list_promotion_requests to discover valid names"),
+			"comment":                stringProperty("Optional: note recorded with the approval"),
+		}, []string{"namespace_name", "promotion_request_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName        string `json:"namespace_name"`
+		PromotionRequestName string `json:"promotion_request_name"`
+		Comment              string `json:"comment"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.ApprovePromotionRequest(
+			ctx, args.NamespaceName, args.PromotionRequestName, args.Comment)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterRejectPromotionRequest(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "reject_promotion_request"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionApprovePromotionRequest}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Reject a pending promotion request. The target environment is left unchanged. " +
+			"Requires the promotion approver permission for the target environment.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name":         defaultStringProperty(),
+			"promotion_request_name": stringProperty("Use list_promotion_requests to discover valid names"),
+			"comment":                stringProperty("Optional: reason for the rejection"),
+		}, []string{"namespace_name", "promotion_request_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName        string `json:"namespace_name"`
+		PromotionRequestName string `json:"promotion_request_name"`
+		Comment              string `json:"comment"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.RejectPromotionRequest(
+			ctx, args.NamespaceName, args.PromotionRequestName, args.Comment)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterDeployComponent(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "deploy_component"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionCreateComponentRelease}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Deploy a container image to an environment. Give either an image reference or the " +
+			"build_id of a succeeded trigger_build run to deploy the image it built. Updates the component's " +
+			"workload image, generates a new component release and binds it to the environment, creating the " +
+			"release binding when needed. The environment must be part of the project's deployment pipeline. " +
+			"Poll get_deployment_status to follow the rollout.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"component_name": stringProperty("Use list_components to discover valid names"),
+			"environment":    stringProperty("Environment to deploy to, e.g. development"),
+			"image":          stringProperty("Optional: container image to deploy. Mutually exclusive with build_id."),
+			"build_id": stringProperty(
+				"Optional: build identifier returned by trigger_build. Mutually exclusive with image."),
+		}, []string{"namespace_name", "component_name", "environment"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string `json:"namespace_name"`
+		ComponentName string `json:"component_name"`
+		Environment   string `json:"environment"`
+		Image         string `json:"image"`
+		BuildID       string `json:"build_id"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.DeployComponent(
+			ctx, args.NamespaceName, args.ComponentName, args.Environment, args.Image, args.BuildID)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterGetDeploymentStatus(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "get_deployment_status"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionViewReleaseBinding}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Get the rollout progress of a component in an environment. Returns the phase " +
+			"(Pending, Progressing, Ready, Failed) with the reason message, the bound release and its image, " +
+			"the release binding conditions and, once ready, the endpoint URLs. Poll after deploy_component " +
+			"or promote_component until the phase is Ready or Failed.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"component_name": stringProperty("Use list_components to discover valid names"),
+			"environment":    stringProperty("Environment to report on, e.g. development"),
+		}, []string{"namespace_name", "component_name", "environment"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string `json:"namespace_name"`
+		ComponentName string `json:"component_name"`
+		Environment   string `json:"environment"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.GetDeploymentStatus(
+			ctx, args.NamespaceName, args.ComponentName, args.Environment)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterGetReleaseHistory(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "get_release_history"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionViewReleaseBinding}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Get the release history of a component, newest first. Each entry gives the environment, " +
+			"the release and its image, a configuration checksum, what triggered it (deploy, promotion, " +
+			"auto-deploy, api, manual) and by whom, when it was deployed and when it was superseded. " +
+			"Use it to find the previous release when rolling back or to audit what ran where.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"component_name": stringProperty("Use list_components to discover valid names"),
+			"environment":    stringProperty("Optional: only return releases to this environment"),
+		}, []string{"namespace_name", "component_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string `json:"namespace_name"`
+		ComponentName string `json:"component_name"`
+		Environment   string `json:"environment"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.GetReleaseHistory(
+			ctx, args.NamespaceName, args.ComponentName, args.Environment)
+		return handleToolResult(result, err)
+	})
+}
+
+func (t *Toolsets) RegisterRollbackComponent(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "rollback_component"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionUpdateReleaseBinding}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Roll back a component in an environment to a release from its release history. " +
+			"Rebinds the release and restores the environment configuration recorded with it. Without a " +
+			"release, rolls back to the release before the current one. Use get_release_history to pick a " +
+			"release and get_deployment_status to follow the rollout.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"component_name": stringProperty("Use list_components to discover valid names"),
+			"environment":    stringProperty("Environment to roll back, e.g. production"),
+			"release": stringProperty(
+				"Optional: release to roll back to. Use get_release_history to discover valid names"),
+		}, []string{"namespace_name", "component_name", "environment"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string `json:"namespace_name"`
+		ComponentName string `json:"component_name"`
+		Environment   string `json:"environment"`
+		Release       string `json:"release"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.RollbackComponent(
+			ctx, args.NamespaceName, args.ComponentName, args.Environment, args.Release)
+		return handleToolResult(result, err)
+	})
+}
+
 func (t *Toolsets) RegisterDeleteComponentRelease(s *mcp.Server, perms map[string]ToolPermission) {
 	const name = "delete_component_release"
 	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionDeleteComponentRelease}
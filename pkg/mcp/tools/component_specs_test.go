@@ -126,6 +126,59 @@ func componentBasicSpecs() []toolTestSpec {
 				}
 			},
 		},
+		{
+			name:                "scaffold_component",
+			toolset:             "component",
+			descriptionKeywords: []string{"component", "class"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "project_name", "name", "type", "class_name"},
+			optionalParams:      []string{"repository_url", "branch", "workflow_name", "image", "port", "auto_deploy"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"project_name":   testProjectName,
+				"name":           "new-component",
+				"type":           "Service",
+				"class_name":     "service",
+				"image":          "nginx:latest",
+			},
+			expectedMethod: "ScaffoldComponent",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != testProjectName {
+					t.Errorf("Expected (%s, %s), got (%v, %v)", testNamespaceName, testProjectName, args[0], args[1])
+				}
+				opts, ok := args[2].(ScaffoldComponentOpts)
+				if !ok || opts.Type != "Service" || opts.Image != "nginx:latest" || !opts.AutoDeploy {
+					t.Errorf("Unexpected scaffold options %+v", args[2])
+				}
+			},
+		},
+		{
+			name:                "import_component",
+			toolset:             "component",
+			descriptionKeywords: []string{"repository", "component", "descriptor"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "project_name", "repository_url"},
+			optionalParams: []string{
+				"ref", "app_path", "descriptor_path", "name", "type", "class_name", "image", "auto_deploy", "dry_run",
+			},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"project_name":   testProjectName,
+				"repository_url": "https://github.com/acme/shop",
+				"ref":            "v1",
+				"dry_run":        true,
+			},
+			expectedMethod: "ImportComponent",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != testProjectName {
+					t.Errorf("Expected (%s, %s), got (%v, %v)", testNamespaceName, testProjectName, args[0], args[1])
+				}
+				opts, ok := args[2].(ImportComponentOpts)
+				if !ok || opts.Ref != "v1" || !opts.DryRun || !opts.AutoDeploy {
+					t.Errorf("Unexpected import options %+v", args[2])
+				}
+			},
+		},
 		{
 			name:                "patch_component",
 			toolset:             "component",
@@ -481,5 +534,25 @@ func componentSchemaSpecs() []toolTestSpec {
 				}
 			},
 		},
+		{
+			name:                "get_component_dependency_graph",
+			toolset:             "component",
+			descriptionKeywords: []string{"dependency", "graph", "impact"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name"},
+			optionalParams:      []string{"project_name", "component_name"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"project_name":   testProjectName,
+				"component_name": testComponentName,
+			},
+			expectedMethod: "GetComponentDependencyGraph",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != testProjectName || args[2] != testComponentName {
+					t.Errorf("Expected (%s, %s, %s), got %v",
+						testNamespaceName, testProjectName, testComponentName, args)
+				}
+			},
+		},
 	}
 }
@@ -125,6 +125,392 @@ func deploymentBindingSpecs() []toolTestSpec {
 				}
 			},
 		},
+		{
+			name:                "promote_component",
+			toolset:             "deployment",
+			descriptionKeywords: []string{"promote", "environment", "pipeline"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "component_name", "source_environment", "target_environment"},
+			testArgs: map[string]any{
+				"namespace_name":     testNamespaceName,
+				"component_name":     testComponentName,
+				"source_environment": "development",
+				"target_environment": "staging",
+				"comment":            "release 2",
+			},
+			expectedMethod: "PromoteComponent",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != testComponentName ||
+					args[2] != "development" || args[3] != "staging" || args[4] != "release 2" {
+					t.Errorf("Expected (%s, %s, development, staging, release 2), got %v",
+						testNamespaceName, testComponentName, args)
+				}
+			},
+		},
+		{
+			name:                "list_promotion_requests",
+			toolset:             "deployment",
+			descriptionKeywords: []string{"promotion", "request"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name"},
+			optionalParams:      []string{"component_name", "limit", "cursor"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"component_name": testComponentName,
+			},
+			expectedMethod: "ListPromotionRequests",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != testComponentName {
+					t.Errorf("Expected (%s, %s), got (%v, %v)", testNamespaceName, testComponentName, args[0], args[1])
+				}
+			},
+		},
+		{
+			name:                "get_promotion_request",
+			toolset:             "deployment",
+			descriptionKeywords: []string{"promotion", "approval"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "promotion_request_name"},
+			testArgs: map[string]any{
+				"namespace_name":         testNamespaceName,
+				"promotion_request_name": "promo-1",
+			},
+			expectedMethod: "GetPromotionRequest",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != "promo-1" {
+					t.Errorf("Expected (%s, promo-1), got (%v, %v)", testNamespaceName, args[0], args[1])
+				}
+			},
+		},
+		{
+			name:                "approve_promotion_request",
+			toolset:             "deployment",
+			descriptionKeywords: []string{"approve", "promotion"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "promotion_request_name"},
+			optionalParams:      []string{"comment"},
+			testArgs: map[string]any{
+				"namespace_name":         testNamespaceName,
+				"promotion_request_name": "promo-1",
+				"comment":                "lgtm",
+			},
+			expectedMethod: "ApprovePromotionRequest",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != "promo-1" || args[2] != "lgtm" {
+					t.Errorf("Expected (%s, promo-1, lgtm), got %v", testNamespaceName, args)
+				}
+			},
+		},
+		{
+			name:                "reject_promotion_request",
+			toolset:             "deployment",
+			descriptionKeywords: []string{"reject", "promotion"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "promotion_request_name"},
+			optionalParams:      []string{"comment"},
+			testArgs: map[string]any{
+				"namespace_name":         testNamespaceName,
+				"promotion_request_name": "promo-1",
+			},
+			expectedMethod: "RejectPromotionRequest",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != "promo-1" || args[2] != "" {
+					t.Errorf("Expected (%s, promo-1, \"\"), got %v", testNamespaceName, args)
+				}
+			},
+		},
+		{
+			name:                "deploy_component",
+			toolset:             "deployment",
+			descriptionKeywords: []string{"deploy", "image", "build", "environment"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "component_name", "environment"},
+			optionalParams:      []string{"image", "build_id"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"component_name": testComponentName,
+				"environment":    "development",
+				"build_id":       "build-1",
+			},
+			expectedMethod: "DeployComponent",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != testComponentName || args[2] != "development" ||
+					args[3] != "" || args[4] != "build-1" {
+					t.Errorf("Expected (%s, %s, development, \"\", build-1), got %v",
+						testNamespaceName, testComponentName, args)
+				}
+			},
+		},
+		{
+			name:                "get_deployment_status",
+			toolset:             "deployment",
+			descriptionKeywords: []string{"rollout", "phase", "environment"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "component_name", "environment"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"component_name": testComponentName,
+				"environment":    "development",
+			},
+			expectedMethod: "GetDeploymentStatus",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != testComponentName || args[2] != "development" {
+					t.Errorf("Expected (%s, %s, development), got %v", testNamespaceName, testComponentName, args)
+				}
+			},
+		},
+		{
+			name:                "get_release_history",
+			toolset:             "deployment",
+			descriptionKeywords: []string{"release", "history", "environment"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "component_name"},
+			optionalParams:      []string{"environment"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"component_name": testComponentName,
+				"environment":    "development",
+			},
+			expectedMethod: "GetReleaseHistory",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != testComponentName || args[2] != "development" {
+					t.Errorf("Expected (%s, %s, development), got %v", testNamespaceName, testComponentName, args)
+				}
+			},
+		},
+		{
+			name:                "rollback_component",
+			toolset:             "deployment",
+			descriptionKeywords: []string{"roll back", "release", "environment"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "component_name", "environment"},
+			optionalParams:      []string{"release"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"component_name": testComponentName,
+				"environment":    "production",
+				"release":        "my-component-1",
+			},
+			expectedMethod: "RollbackComponent",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != testComponentName || args[2] != "production" ||
+					args[3] != "my-component-1" {
+					t.Errorf("Expected (%s, %s, production, my-component-1), got %v",
+						testNamespaceName, testComponentName, args)
+				}
+			},
+		},
+		{
+			name:                "get_component_endpoints",
+			toolset:             "deployment",
+			descriptionKeywords: []string{"endpoint", "url", "environment"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "component_name"},
+			optionalParams:      []string{"environment"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"component_name": testComponentName,
+				"environment":    "production",
+			},
+			expectedMethod: "GetComponentEndpoints",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != testComponentName || args[2] != "production" {
+					t.Errorf("Expected (%s, %s, production), got %v", testNamespaceName, testComponentName, args)
+				}
+			},
+		},
+		{
+			name:                "list_endpoints",
+			toolset:             "deployment",
+			descriptionKeywords: []string{"endpoint", "visibility", "gateway"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name"},
+			optionalParams:      []string{"project_name", "component_name", "environment"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"project_name":   testProjectName,
+				"component_name": testComponentName,
+				"environment":    "production",
+			},
+			expectedMethod: "ListEndpoints",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != testProjectName || args[2] != testComponentName ||
+					args[3] != "production" {
+					t.Errorf("Expected (%s, %s, %s, production), got %v",
+						testNamespaceName, testProjectName, testComponentName, args)
+				}
+			},
+		},
+		{
+			name:                "update_endpoint",
+			toolset:             "deployment",
+			descriptionKeywords: []string{"visibility", "base path", "endpoint"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "component_name", "endpoint_name"},
+			optionalParams:      []string{"visibility", "base_path"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"component_name": testComponentName,
+				"endpoint_name":  "api",
+				"visibility":     []any{"project", "external"},
+				"base_path":      "/v2",
+			},
+			expectedMethod: "UpdateEndpoint",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != testComponentName || args[2] != "api" {
+					t.Errorf("Expected (%s, %s, api), got %v", testNamespaceName, testComponentName, args)
+				}
+				opts, ok := args[3].(EndpointOpts)
+				if !ok || opts.BasePath == nil || *opts.BasePath != "/v2" ||
+					opts.Visibility == nil || len(*opts.Visibility) != 2 || (*opts.Visibility)[1] != "external" {
+					t.Errorf("Expected visibility [project external] and base path /v2, got %+v", args[3])
+				}
+			},
+		},
+		{
+			name:                "scale_component",
+			toolset:             "deployment",
+			descriptionKeywords: []string{"scale", "replica", "environment"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "component_name", "environment"},
+			optionalParams:      []string{"replicas", "min_replicas", "max_replicas"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"component_name": testComponentName,
+				"environment":    "production",
+				"min_replicas":   2,
+				"max_replicas":   5,
+			},
+			expectedMethod: "ScaleComponent",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != testComponentName || args[2] != "production" {
+					t.Errorf("Expected (%s, %s, production), got %v", testNamespaceName, testComponentName, args)
+				}
+				opts, ok := args[3].(ScaleOpts)
+				if !ok {
+					t.Fatalf("Expected ScaleOpts, got %T", args[3])
+				}
+				if opts.Replicas != nil || opts.MinReplicas == nil || *opts.MinReplicas != 2 ||
+					opts.MaxReplicas == nil || *opts.MaxReplicas != 5 {
+					t.Errorf("Unexpected scale options %+v", opts)
+				}
+			},
+		},
+		{
+			name:                "restart_component",
+			toolset:             "deployment",
+			descriptionKeywords: []string{"restart", "environment"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "component_name", "environment"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"component_name": testComponentName,
+				"environment":    "production",
+			},
+			expectedMethod: "RestartComponent",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != testComponentName || args[2] != "production" {
+					t.Errorf("Expected (%s, %s, production), got %v", testNamespaceName, testComponentName, args)
+				}
+			},
+		},
+		{
+			name:                "get_component_configuration",
+			toolset:             "deployment",
+			descriptionKeywords: []string{"configuration", "environment"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "component_name"},
+			optionalParams:      []string{"environment"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"component_name": testComponentName,
+			},
+			expectedMethod: "GetComponentConfiguration",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != testComponentName || args[2] != "" {
+					t.Errorf("Expected (%s, %s, \"\"), got %v", testNamespaceName, testComponentName, args)
+				}
+			},
+		},
+		{
+			name:                "set_component_env_var",
+			toolset:             "deployment",
+			descriptionKeywords: []string{"environment variable", "secret"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "component_name", "environment", "key"},
+			optionalParams:      []string{"value", "secret_name", "secret_key", "secret"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"component_name": testComponentName,
+				"environment":    "production",
+				"key":            "LOG_LEVEL",
+				"value":          "debug",
+			},
+			expectedMethod: "SetComponentEnvVar",
+			validateCall: func(t *testing.T, args []interface{}) {
+				want := EnvVarOpts{Key: "LOG_LEVEL", Value: "debug"}
+				if args[2] != "production" || args[3] != want {
+					t.Errorf("Expected (production, %+v), got %v", want, args)
+				}
+			},
+		},
+		{
+			name:                "unset_component_env_var",
+			toolset:             "deployment",
+			descriptionKeywords: []string{"remove", "environment variable"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "component_name", "environment", "key"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"component_name": testComponentName,
+				"environment":    "production",
+				"key":            "LOG_LEVEL",
+			},
+			expectedMethod: "UnsetComponentEnvVar",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[2] != "production" || args[3] != "LOG_LEVEL" {
+					t.Errorf("Expected (production, LOG_LEVEL), got %v", args)
+				}
+			},
+		},
+		{
+			name:                "list_component_secrets",
+			toolset:             "deployment",
+			descriptionKeywords: []string{"secret", "never"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "component_name"},
+			optionalParams:      []string{"environment"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"component_name": testComponentName,
+				"environment":    "production",
+			},
+			expectedMethod: "ListComponentSecrets",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != testComponentName || args[2] != "production" {
+					t.Errorf("Expected (%s, %s, production), got %v", testNamespaceName, testComponentName, args)
+				}
+			},
+		},
+		{
+			name:                "set_secret_value",
+			toolset:             "deployment",
+			descriptionKeywords: []string{"secret", "rotate", "write-only"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "secret_name", "key", "value"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"secret_name":    "db-credentials",
+				"key":            "password",
+				"value":          "n3w",
+			},
+			expectedMethod: "SetSecretValue",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[1] != "db-credentials" || args[2] != "password" || args[3] != "n3w" {
+					t.Errorf("Expected (db-credentials, password, n3w), got %v", args)
+				}
+			},
+		},
 		{
 			name:                "delete_component_release",
 			toolset:             "deployment",
@@ -172,5 +558,23 @@ func deploymentEnvironmentSpecs() []toolTestSpec {
 				}
 			},
 		},
+		{
+			name:                "get_environment_overview",
+			toolset:             "deployment",
+			descriptionKeywords: []string{"environment", "deployed", "health"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name"},
+			optionalParams:      []string{"project_name"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"project_name":   testProjectName,
+			},
+			expectedMethod: "GetEnvironmentOverview",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != testProjectName {
+					t.Errorf("Expected (%s, %s), got (%v, %v)", testNamespaceName, testProjectName, args[0], args[1])
+				}
+			},
+		},
 	}
 }
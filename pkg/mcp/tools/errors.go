@@ -0,0 +1,243 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/problem"
+)
+
+// ErrorCode is a machine-readable category of a failed tool call. Clients branch
+// on the code instead of parsing the error message.
+type ErrorCode string
+
+const (
+	ErrorCodeNotFound        ErrorCode = "NotFound"
+	ErrorCodeAlreadyExists   ErrorCode = "AlreadyExists"
+	ErrorCodeForbidden       ErrorCode = "Forbidden"
+	ErrorCodeValidationError ErrorCode = "ValidationError"
+	ErrorCodeConflict        ErrorCode = "Conflict"
+	ErrorCodeUnavailable     ErrorCode = "Unavailable"
+	ErrorCodeInternal        ErrorCode = "Internal"
+)
+
+// problemTypes maps error codes to the HTTP status and REST error code of the
+// same problem, so that tool errors carry the problem details members of the
+// equivalent REST error response.
+var problemTypes = map[ErrorCode]struct {
+	status int
+	code   string
+}{
+	ErrorCodeNotFound:        {http.StatusNotFound, "NOT_FOUND"},
+	ErrorCodeAlreadyExists:   {http.StatusConflict, "ALREADY_EXISTS"},
+	ErrorCodeForbidden:       {http.StatusForbidden, "FORBIDDEN"},
+	ErrorCodeValidationError: {http.StatusBadRequest, "BAD_REQUEST"},
+	ErrorCodeConflict:        {http.StatusConflict, "CONFLICT"},
+	ErrorCodeUnavailable:     {http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE"},
+	ErrorCodeInternal:        {http.StatusInternalServerError, "INTERNAL_ERROR"},
+}
+
+// ToolError is the error envelope returned to MCP clients when a tool call fails.
+// It is serialized as {"error": {...}} in both the text and the structured content
+// of the tool result.
+type ToolError struct {
+	// Code is the machine-readable failure category.
+	Code ErrorCode `json:"code"`
+	// Message is the human-readable error message.
+	Message string `json:"message"`
+	// Retriable reports whether the same call may succeed if retried later.
+	Retriable bool `json:"retriable"`
+	// Details carries code-specific data such as invalid field paths.
+	Details map[string]any `json:"details,omitempty"`
+
+	// Type, Title and Status are the problem details members of the equivalent
+	// REST error response.
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	// Kind and Name identify the resource the error concerns, when known.
+	Kind string `json:"kind,omitempty"`
+	Name string `json:"name,omitempty"`
+	// FieldErrors lists the invalid fields of the request.
+	FieldErrors []problem.FieldError `json:"fieldErrors,omitempty"`
+
+	err error
+}
+
+// NewToolError wraps err in a ToolError with the given code. Retriable is derived
+// from the code; details may be nil.
+func NewToolError(code ErrorCode, err error, details map[string]any) *ToolError {
+	pt, ok := problemTypes[code]
+	if !ok {
+		pt = problemTypes[ErrorCodeInternal]
+	}
+	p := problem.New(pt.status, pt.code, err.Error())
+	return &ToolError{
+		Code:      code,
+		Message:   err.Error(),
+		Retriable: code == ErrorCodeConflict || code == ErrorCodeUnavailable,
+		Details:   details,
+		Type:      p.Type,
+		Title:     p.Title,
+		Status:    p.Status,
+		err:       err,
+	}
+}
+
+func (e *ToolError) Error() string { return e.Message }
+
+func (e *ToolError) Unwrap() error { return e.err }
+
+// ErrToolNotAuthorized is wrapped by the errors the tool filter middleware returns
+// for tool calls the caller lacks permission for.
+var ErrToolNotAuthorized = errors.New("not authorized to call tool")
+
+// ErrorClassifier maps an error to a ToolError. It returns nil when it does not
+// recognize the error, leaving it to the built-in classification.
+type ErrorClassifier func(err error) *ToolError
+
+// ClassifyError converts err into a ToolError. Errors that already are ToolErrors
+// are returned as is; otherwise classifier is consulted first, then Kubernetes API
+// errors and context errors are recognized. Anything else is reported as Internal.
+func ClassifyError(err error, classifier ErrorClassifier) *ToolError {
+	if te, ok := errors.AsType[*ToolError](err); ok {
+		return te
+	}
+	if errors.Is(err, ErrToolNotAuthorized) {
+		return NewToolError(ErrorCodeForbidden, err, nil)
+	}
+	if classifier != nil {
+		if te := classifier(err); te != nil {
+			return te
+		}
+	}
+	if te := classifyKubernetesError(err); te != nil {
+		return te
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return NewToolError(ErrorCodeUnavailable, err, nil)
+	}
+	return NewToolError(ErrorCodeInternal, err, nil)
+}
+
+func classifyKubernetesError(err error) *ToolError {
+	var status apierrors.APIStatus
+	if !errors.As(err, &status) {
+		return nil
+	}
+	te := classifyKubernetesStatus(err, status)
+	if te != nil {
+		setResourceDetails(te, status.Status().Details)
+	}
+	return te
+}
+
+func classifyKubernetesStatus(err error, status apierrors.APIStatus) *ToolError {
+	switch {
+	case apierrors.IsNotFound(err):
+		return NewToolError(ErrorCodeNotFound, err, nil)
+	case apierrors.IsAlreadyExists(err):
+		return NewToolError(ErrorCodeAlreadyExists, err, nil)
+	case apierrors.IsConflict(err):
+		return NewToolError(ErrorCodeConflict, err, nil)
+	case apierrors.IsForbidden(err), apierrors.IsUnauthorized(err):
+		return NewToolError(ErrorCodeForbidden, err, nil)
+	case apierrors.IsInvalid(err), apierrors.IsBadRequest(err):
+		return NewToolError(ErrorCodeValidationError, err, statusCauseDetails(status.Status()))
+	case apierrors.IsTimeout(err), apierrors.IsServerTimeout(err),
+		apierrors.IsTooManyRequests(err), apierrors.IsServiceUnavailable(err):
+		te := NewToolError(ErrorCodeUnavailable, err, nil)
+		if seconds, ok := apierrors.SuggestsClientDelay(err); ok {
+			te.Details = map[string]any{"retryAfterSeconds": seconds}
+		}
+		return te
+	default:
+		return nil
+	}
+}
+
+// setResourceDetails copies the resource name and invalid fields of a
+// Kubernetes error to te. Kubernetes reports the resource of some errors, such
+// as NotFound, instead of its kind, so only kinds are copied.
+func setResourceDetails(te *ToolError, details *metav1.StatusDetails) {
+	if details == nil {
+		return
+	}
+	te.Name = details.Name
+	if r, _ := utf8.DecodeRuneInString(details.Kind); unicode.IsUpper(r) {
+		te.Kind = details.Kind
+	}
+	for _, c := range details.Causes {
+		if c.Type == metav1.CauseTypeUnexpectedServerResponse {
+			continue
+		}
+		te.FieldErrors = append(te.FieldErrors, problem.FieldError{
+			Field:   c.Field,
+			Reason:  string(c.Type),
+			Message: c.Message,
+		})
+	}
+}
+
+// statusCauseDetails extracts the offending field paths of a Kubernetes Invalid error.
+func statusCauseDetails(status metav1.Status) map[string]any {
+	details := status.Details
+	if details == nil || len(details.Causes) == 0 {
+		return nil
+	}
+	fields := make([]map[string]any, 0, len(details.Causes))
+	for _, c := range details.Causes {
+		if c.Type == metav1.CauseTypeUnexpectedServerResponse {
+			continue
+		}
+		fields = append(fields, map[string]any{
+			"field":   c.Field,
+			"reason":  string(c.Type),
+			"message": c.Message,
+		})
+	}
+	return map[string]any{"fields": fields}
+}
+
+// NewErrorEnvelopeMiddleware returns an MCP receiving middleware that rewrites
+// failed tools/call results into the ToolError envelope, so clients receive a
+// machine-readable code, retriable flag and details instead of a bare message.
+func NewErrorEnvelopeMiddleware(classifier ErrorClassifier) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			result, err := next(ctx, method, req)
+			if method != methodCallTool || err != nil {
+				return result, err
+			}
+			res, ok := result.(*mcp.CallToolResult)
+			if !ok || !res.IsError || res.GetError() == nil {
+				return result, nil
+			}
+			setErrorEnvelope(res, ClassifyError(res.GetError(), classifier))
+			return res, nil
+		}
+	}
+}
+
+// setErrorEnvelope replaces the content of a failed tool result with the JSON
+// envelope of te. The result is left unchanged if te cannot be serialized.
+func setErrorEnvelope(res *mcp.CallToolResult, te *ToolError) {
+	envelope := map[string]any{"error": te}
+	text, err := json.Marshal(envelope)
+	if err != nil {
+		return
+	}
+	res.Content = []mcp.Content{&mcp.TextContent{Text: string(text)}}
+	res.StructuredContent = envelope
+}
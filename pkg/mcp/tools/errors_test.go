@@ -0,0 +1,176 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestClassifyError(t *testing.T) {
+	gr := schema.GroupResource{Group: "openchoreo.dev", Resource: "components"}
+	errBoom := errors.New("boom")
+
+	tests := []struct {
+		name          string
+		err           error
+		classifier    ErrorClassifier
+		wantCode      ErrorCode
+		wantRetriable bool
+	}{
+		{
+			name:     "wrapped kubernetes not found",
+			err:      fmt.Errorf("failed to get component: %w", apierrors.NewNotFound(gr, "web")),
+			wantCode: ErrorCodeNotFound,
+		},
+		{
+			name:          "kubernetes conflict is retriable",
+			err:           apierrors.NewConflict(gr, "web", errBoom),
+			wantCode:      ErrorCodeConflict,
+			wantRetriable: true,
+		},
+		{
+			name:          "kubernetes too many requests is retriable",
+			err:           apierrors.NewTooManyRequests("slow down", 5),
+			wantCode:      ErrorCodeUnavailable,
+			wantRetriable: true,
+		},
+		{
+			name:          "context deadline",
+			err:           fmt.Errorf("list: %w", context.DeadlineExceeded),
+			wantCode:      ErrorCodeUnavailable,
+			wantRetriable: true,
+		},
+		{
+			name:     "existing tool error is kept",
+			err:      fmt.Errorf("wrapped: %w", NewToolError(ErrorCodeForbidden, errBoom, nil)),
+			wantCode: ErrorCodeForbidden,
+		},
+		{
+			name: "classifier takes precedence",
+			err:  errBoom,
+			classifier: func(err error) *ToolError {
+				return NewToolError(ErrorCodeAlreadyExists, err, nil)
+			},
+			wantCode: ErrorCodeAlreadyExists,
+		},
+		{
+			name:     "tool filter denial is forbidden",
+			err:      fmt.Errorf("%w %q: missing permission %q", ErrToolNotAuthorized, "create_project", "project:create"),
+			wantCode: ErrorCodeForbidden,
+		},
+		{
+			name:     "unknown error is internal",
+			err:      errBoom,
+			wantCode: ErrorCodeInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ClassifyError(tt.err, tt.classifier)
+			if got.Code != tt.wantCode {
+				t.Errorf("Code = %q, want %q", got.Code, tt.wantCode)
+			}
+			if got.Retriable != tt.wantRetriable {
+				t.Errorf("Retriable = %v, want %v", got.Retriable, tt.wantRetriable)
+			}
+		})
+	}
+}
+
+func TestClassifyErrorInvalidFieldPaths(t *testing.T) {
+	err := apierrors.NewInvalid(schema.GroupKind{Group: "openchoreo.dev", Kind: "Component"}, "web", field.ErrorList{
+		field.Required(field.NewPath("spec", "componentType"), "must be set"),
+	})
+
+	got := ClassifyError(err, nil)
+	if got.Code != ErrorCodeValidationError {
+		t.Fatalf("Code = %q, want %q", got.Code, ErrorCodeValidationError)
+	}
+	fields, _ := got.Details["fields"].([]map[string]any)
+	if len(fields) != 1 || fields[0]["field"] != "spec.componentType" {
+		t.Errorf("Details = %v, want the spec.componentType field path", got.Details)
+	}
+}
+
+func TestClassifyErrorProblemDetails(t *testing.T) {
+	err := apierrors.NewInvalid(schema.GroupKind{Group: "openchoreo.dev", Kind: "Component"}, "web", field.ErrorList{
+		field.Required(field.NewPath("spec", "componentType"), "must be set"),
+	})
+
+	got := ClassifyError(err, nil)
+	if got.Type != "urn:openchoreo:problem:bad-request" || got.Title != "Bad Request" || got.Status != 400 {
+		t.Errorf("Type, Title, Status = %q, %q, %d, want the bad request problem", got.Type, got.Title, got.Status)
+	}
+	if got.Kind != "Component" || got.Name != "web" {
+		t.Errorf("Kind, Name = %q, %q, want Component, web", got.Kind, got.Name)
+	}
+	if len(got.FieldErrors) != 1 || got.FieldErrors[0].Field != "spec.componentType" ||
+		got.FieldErrors[0].Reason != string(field.ErrorTypeRequired) {
+		t.Errorf("FieldErrors = %+v, want the required spec.componentType field", got.FieldErrors)
+	}
+
+	// Not found errors report the resource instead of the kind.
+	got = ClassifyError(apierrors.NewNotFound(schema.GroupResource{Group: "openchoreo.dev", Resource: "components"}, "web"), nil)
+	if got.Status != 404 || got.Kind != "" || got.Name != "web" {
+		t.Errorf("Status, Kind, Name = %d, %q, %q, want 404, \"\", web", got.Status, got.Kind, got.Name)
+	}
+}
+
+func TestErrorEnvelopeMiddleware(t *testing.T) {
+	ctx := context.Background()
+	server := mcp.NewServer(&mcp.Implementation{Name: "errors-test"}, nil)
+	mcp.AddTool(server, &mcp.Tool{Name: "fail", InputSchema: createSchema(map[string]any{}, nil)},
+		func(context.Context, *mcp.CallToolRequest, struct{}) (*mcp.CallToolResult, any, error) {
+			return handleToolResult(nil, fmt.Errorf("get component: %w", errors.New("component not found")))
+		})
+	server.AddReceivingMiddleware(NewErrorEnvelopeMiddleware(func(err error) *ToolError {
+		return NewToolError(ErrorCodeNotFound, err, map[string]any{"kind": "Component"})
+	}))
+
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	if _, err := server.Connect(ctx, serverTransport, nil); err != nil {
+		t.Fatalf("server connect: %v", err)
+	}
+	cs, err := mcp.NewClient(&mcp.Implementation{Name: "errors-test-client"}, nil).Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("client connect: %v", err)
+	}
+	defer cs.Close()
+
+	res, err := cs.CallTool(ctx, &mcp.CallToolParams{Name: "fail"})
+	if err != nil {
+		t.Fatalf("CallTool returned protocol error: %v", err)
+	}
+	if !res.IsError {
+		t.Fatal("expected a tool error result")
+	}
+
+	var envelope struct {
+		Error ToolError `json:"error"`
+	}
+	if err := json.Unmarshal([]byte(firstText(res)), &envelope); err != nil {
+		t.Fatalf("error text is not a JSON envelope: %v", err)
+	}
+	if envelope.Error.Code != ErrorCodeNotFound {
+		t.Errorf("code = %q, want %q", envelope.Error.Code, ErrorCodeNotFound)
+	}
+	if envelope.Error.Message != "get component: component not found" {
+		t.Errorf("message = %q", envelope.Error.Message)
+	}
+	if envelope.Error.Details["kind"] != "Component" {
+		t.Errorf("details = %v, want kind Component", envelope.Error.Details)
+	}
+	if res.StructuredContent == nil {
+		t.Error("expected the envelope in structured content")
+	}
+}
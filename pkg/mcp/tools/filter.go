@@ -152,7 +152,7 @@ func filterCallTool(
 
 	subjectCtx, _ := auth.GetSubjectContextFromContext(ctx)
 	if subjectCtx == nil {
-		return nil, fmt.Errorf("not authorized to call tool %q: no authenticated user", toolName)
+		return nil, fmt.Errorf("%w %q: no authenticated user", ErrToolNotAuthorized, toolName)
 	}
 
 	requiredAction := perm.ActionForScope(callToolScopeArg(req))
@@ -167,11 +167,11 @@ func filterCallTool(
 		Scope:          callToolScope(req),
 	})
 	if err != nil {
-		return nil, fmt.Errorf("not authorized to call tool %q: could not evaluate permissions", toolName)
+		return nil, fmt.Errorf("%w %q: could not evaluate permissions", ErrToolNotAuthorized, toolName)
 	}
 
 	if !hasActionCapability(requiredAction, profile) {
-		return nil, fmt.Errorf("not authorized to call tool %q: missing permission %q", toolName, requiredAction)
+		return nil, fmt.Errorf("%w %q: missing permission %q", ErrToolNotAuthorized, toolName, requiredAction)
 	}
 
 	return next(ctx, method, req)
@@ -8,6 +8,8 @@ import (
 	"fmt"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/fieldselect"
 )
 
 // Scope values used by scope-collapsed MCP tools. A scope-collapsed tool replaces
@@ -94,6 +96,30 @@ func handleToolResult(result any, err error) (*mcp.CallToolResult, any, error) {
 	}, result, nil
 }
 
+// handleFieldsToolResult is handleToolResult for tools that take the fields
+// argument: it first reduces the result to the named fields. For list results,
+// listKey names the member holding the items, which are reduced one by one
+// while the other members, such as next_cursor, are kept.
+func handleFieldsToolResult(result any, err error, fields, listKey string) (*mcp.CallToolResult, any, error) {
+	if err != nil || fields == "" {
+		return handleToolResult(result, err)
+	}
+	selector, err := fieldselect.Parse(fields)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Round-trip through JSON so the selection sees the result as it is sent.
+	data, err := json.Marshal(result)
+	if err != nil {
+		return nil, nil, err
+	}
+	var doc any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, nil, err
+	}
+	return handleToolResult(selector.ApplyToItems(doc, listKey), nil)
+}
+
 // deprecationWarning builds the standard deprecation message for a deprecated
 // cluster-prefixed alias tool, pointing the caller at the canonical scope-collapsed
 // tool.
@@ -136,6 +162,14 @@ func addPaginationProperties(properties map[string]any) map[string]any {
 	return properties
 }
 
+// fieldsProperty is the optional argument of tools that can return a subset of
+// their result's fields.
+func fieldsProperty() map[string]any {
+	return stringProperty("Optional: comma-separated fields to return, e.g. \"name,conditions\" or " +
+		"\"spec.schema\". Nested fields are separated by dots and everything else is dropped. " +
+		"Use it to keep large results small.")
+}
+
 func createSchema(properties map[string]any, required []string) map[string]any {
 	schema := map[string]any{
 		"type":       "object",
@@ -0,0 +1,82 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestHandleFieldsToolResult(t *testing.T) {
+	detail := map[string]any{
+		"name":       "db",
+		"spec":       map[string]any{"schema": map[string]any{"type": "object"}, "workloadType": "deployment"},
+		"conditions": []any{map[string]any{"type": "Ready", "status": "True", "message": "ok"}},
+	}
+	list := map[string]any{
+		"resources":   []any{map[string]any{"name": "db", "type": "postgres"}, map[string]any{"name": "cache", "type": "redis"}},
+		"next_cursor": "abc",
+	}
+
+	tests := []struct {
+		name    string
+		result  any
+		fields  string
+		listKey string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "no fields returns the full result",
+			result: map[string]any{"name": "db"},
+			want:   `{"name":"db"}`,
+		},
+		{
+			name:   "selects nested fields",
+			result: detail,
+			fields: "spec.schema,conditions.type",
+			want:   `{"conditions":[{"type":"Ready"}],"spec":{"schema":{"type":"object"}}}`,
+		},
+		{
+			name:    "reduces list items and keeps the cursor",
+			result:  list,
+			fields:  "name",
+			listKey: "resources",
+			want:    `{"next_cursor":"abc","resources":[{"name":"db"},{"name":"cache"}]}`,
+		},
+		{
+			name:    "invalid fields",
+			result:  detail,
+			fields:  "spec..schema",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, _, err := handleFieldsToolResult(tt.result, nil, tt.fields, tt.listKey)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := res.Content[0].(*mcp.TextContent).Text; got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("handler error", func(t *testing.T) {
+		wantErr := errors.New("boom")
+		if _, _, err := handleFieldsToolResult(nil, wantErr, "name", ""); !errors.Is(err, wantErr) {
+			t.Errorf("got %v, want %v", err, wantErr)
+		}
+	})
+}
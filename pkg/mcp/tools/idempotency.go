@@ -0,0 +1,145 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/idempotency"
+)
+
+// argIdempotencyKey is the optional argument of mutating tools that makes
+// retried calls run once.
+const argIdempotencyKey = "idempotency_key"
+
+// NewIdempotencyMiddleware returns an MCP receiving middleware that runs calls
+// to mutating tools carrying an idempotency_key argument at most once per key
+// and subject. Successful results are stored and returned to retries with the
+// same arguments; failed calls release their key so that they can be retried.
+// A retry while the first call runs fails with a retriable Conflict tool error,
+// and reusing a key with different arguments fails with a ValidationError.
+//
+// The argument is added to the input schema of every mutating tool in
+// tools/list results.
+func NewIdempotencyMiddleware(store *idempotency.Store, perms map[string]ToolPermission) mcp.Middleware {
+	mutating := make(map[string]bool, len(perms))
+	for name, perm := range perms {
+		mutating[name] = perm.Mutating()
+	}
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			switch method {
+			case methodListTools:
+				result, err := next(ctx, method, req)
+				if listResult, ok := result.(*mcp.ListToolsResult); ok && err == nil {
+					addIdempotencyKeyProperty(listResult, mutating)
+				}
+				return result, err
+			case methodCallTool:
+				if mutating[callToolName(req)] {
+					return callIdempotent(ctx, store, next, method, req)
+				}
+			}
+			return next(ctx, method, req)
+		}
+	}
+}
+
+func callIdempotent(
+	ctx context.Context,
+	store *idempotency.Store,
+	next mcp.MethodHandler,
+	method string,
+	req mcp.Request,
+) (mcp.Result, error) {
+	params, ok := req.GetParams().(*mcp.CallToolParamsRaw)
+	if !ok || params == nil || len(params.Arguments) == 0 {
+		return next(ctx, method, req)
+	}
+	var args map[string]any
+	if err := json.Unmarshal(params.Arguments, &args); err != nil {
+		return next(ctx, method, req)
+	}
+	key, _ := args[argIdempotencyKey].(string)
+	if key == "" {
+		return next(ctx, method, req)
+	}
+	if len(key) > idempotency.MaxKeyLength {
+		return idempotencyErrorResult(ErrorCodeValidationError, fmt.Errorf(
+			"%s must be at most %d characters", argIdempotencyKey, idempotency.MaxKeyLength)), nil
+	}
+
+	// The fingerprint covers the other arguments, re-encoded so that it does
+	// not depend on their formatting.
+	delete(args, argIdempotencyKey)
+	canonical, err := json.Marshal(args)
+	if err != nil {
+		return next(ctx, method, req)
+	}
+	sum := sha256.Sum256(append([]byte(params.Name+"\x00"), canonical...))
+
+	value, reservation, err := store.Begin(idempotency.ScopedKey(ctx, "mcp", key), hex.EncodeToString(sum[:]))
+	switch {
+	case errors.Is(err, idempotency.ErrInProgress):
+		return idempotencyErrorResult(ErrorCodeConflict, err), nil
+	case errors.Is(err, idempotency.ErrKeyReused):
+		return idempotencyErrorResult(ErrorCodeValidationError, err), nil
+	case value != nil:
+		// Later middleware may modify the result, so each replay gets a copy.
+		stored := *value.(*mcp.CallToolResult)
+		stored.Content = slices.Clone(stored.Content)
+		return &stored, nil
+	}
+
+	result, err := next(ctx, method, req)
+	res, ok := result.(*mcp.CallToolResult)
+	if err != nil || !ok || res == nil || res.IsError {
+		reservation.Release()
+		return result, err
+	}
+	stored := *res
+	stored.Content = slices.Clone(res.Content)
+	reservation.Complete(&stored)
+	return result, nil
+}
+
+// addIdempotencyKeyProperty adds the idempotency_key argument to the input
+// schema of the mutating tools of listResult. The schemas are copied, since
+// they are shared with the registered tools.
+func addIdempotencyKeyProperty(listResult *mcp.ListToolsResult, mutating map[string]bool) {
+	for i, tool := range listResult.Tools {
+		schema, ok := tool.InputSchema.(map[string]any)
+		if !mutating[tool.Name] || !ok {
+			continue
+		}
+		properties, _ := schema["properties"].(map[string]any)
+		properties = maps.Clone(properties)
+		if properties == nil {
+			properties = map[string]any{}
+		}
+		properties[argIdempotencyKey] = stringProperty("Optional: a unique key, such as a UUID, that makes " +
+			"retries of this call safe. A retry with the same key and arguments returns the result of the " +
+			"first successful call instead of running again.")
+		schema = maps.Clone(schema)
+		schema["properties"] = properties
+		copied := *tool
+		copied.InputSchema = schema
+		listResult.Tools[i] = &copied
+	}
+}
+
+func idempotencyErrorResult(code ErrorCode, err error) *mcp.CallToolResult {
+	res := &mcp.CallToolResult{}
+	res.SetError(NewToolError(code, err, nil))
+	return res
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/openchoreo/openchoreo/internal/server/middleware/idempotency"
+)
+
+// newIdempotentSession connects a client to a server exposing a "read" and a
+// "write" tool behind the idempotency and error envelope middleware. The write
+// tool counts its runs and fails when called with fail set.
+func newIdempotentSession(t *testing.T, runs *atomic.Int32) *mcp.ClientSession {
+	t.Helper()
+	ctx := context.Background()
+	server := mcp.NewServer(&mcp.Implementation{Name: "idempotency-test"}, nil)
+	schema := createSchema(map[string]any{
+		"name": stringProperty("Name"),
+		"fail": map[string]any{"type": "boolean"},
+	}, nil)
+	mcp.AddTool(server, &mcp.Tool{Name: "read", InputSchema: schema},
+		func(context.Context, *mcp.CallToolRequest, struct{}) (*mcp.CallToolResult, any, error) {
+			return handleToolResult(map[string]any{"ok": true}, nil)
+		})
+	mcp.AddTool(server, &mcp.Tool{Name: "write", InputSchema: schema},
+		func(_ context.Context, _ *mcp.CallToolRequest, args struct {
+			Name string `json:"name"`
+			Fail bool   `json:"fail"`
+		}) (*mcp.CallToolResult, any, error) {
+			n := runs.Add(1)
+			if args.Fail {
+				return nil, nil, fmt.Errorf("run %d failed", n)
+			}
+			return handleToolResult(map[string]any{"name": args.Name, "run": n}, nil)
+		})
+	perms := map[string]ToolPermission{
+		"read":  {ToolName: "read", Action: "component:view"},
+		"write": {ToolName: "write", Action: "component:create"},
+	}
+	server.AddReceivingMiddleware(NewErrorEnvelopeMiddleware(nil),
+		NewIdempotencyMiddleware(idempotency.NewStore(time.Hour, 10), perms))
+
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	if _, err := server.Connect(ctx, serverTransport, nil); err != nil {
+		t.Fatalf("server connect: %v", err)
+	}
+	cs, err := mcp.NewClient(&mcp.Implementation{Name: "idempotency-test-client"}, nil).Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("client connect: %v", err)
+	}
+	t.Cleanup(func() { cs.Close() })
+	return cs
+}
+
+func callTool(t *testing.T, cs *mcp.ClientSession, name string, args map[string]any) *mcp.CallToolResult {
+	t.Helper()
+	res, err := cs.CallTool(context.Background(), &mcp.CallToolParams{Name: name, Arguments: args})
+	if err != nil {
+		t.Fatalf("call %s: %v", name, err)
+	}
+	return res
+}
+
+func TestIdempotencyMiddlewareReplaysResult(t *testing.T) {
+	var runs atomic.Int32
+	cs := newIdempotentSession(t, &runs)
+
+	args := map[string]any{"name": "a", "idempotency_key": "k1"}
+	first := callTool(t, cs, "write", args)
+	second := callTool(t, cs, "write", args)
+	if first.IsError || second.IsError {
+		t.Fatalf("unexpected error results: %q, %q", firstText(first), firstText(second))
+	}
+	if firstText(first) != firstText(second) {
+		t.Errorf("replayed result = %q, want %q", firstText(second), firstText(first))
+	}
+	if got := runs.Load(); got != 1 {
+		t.Errorf("tool ran %d times, want 1", got)
+	}
+
+	// Calls without a key or with another key run again.
+	callTool(t, cs, "write", map[string]any{"name": "a"})
+	callTool(t, cs, "write", map[string]any{"name": "a", "idempotency_key": "k2"})
+	if got := runs.Load(); got != 3 {
+		t.Errorf("tool ran %d times, want 3", got)
+	}
+}
+
+func TestIdempotencyMiddlewareRejectsReusedKey(t *testing.T) {
+	var runs atomic.Int32
+	cs := newIdempotentSession(t, &runs)
+
+	callTool(t, cs, "write", map[string]any{"name": "a", "idempotency_key": "k1"})
+	res := callTool(t, cs, "write", map[string]any{"name": "b", "idempotency_key": "k1"})
+	toolErr := decodeToolError(t, res)
+	if toolErr.Code != ErrorCodeValidationError {
+		t.Errorf("code = %q, want %q", toolErr.Code, ErrorCodeValidationError)
+	}
+	if got := runs.Load(); got != 1 {
+		t.Errorf("tool ran %d times, want 1", got)
+	}
+}
+
+func TestIdempotencyMiddlewareRetriesFailures(t *testing.T) {
+	var runs atomic.Int32
+	cs := newIdempotentSession(t, &runs)
+
+	args := map[string]any{"name": "a", "fail": true, "idempotency_key": "k1"}
+	if res := callTool(t, cs, "write", args); !res.IsError {
+		t.Fatalf("expected a failure, got %q", firstText(res))
+	}
+	if res := callTool(t, cs, "write", args); !res.IsError {
+		t.Fatalf("expected a failure, got %q", firstText(res))
+	}
+	if got := runs.Load(); got != 2 {
+		t.Errorf("tool ran %d times, want 2", got)
+	}
+}
+
+func TestIdempotencyMiddlewareListTools(t *testing.T) {
+	var runs atomic.Int32
+	cs := newIdempotentSession(t, &runs)
+
+	res, err := cs.ListTools(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("list tools: %v", err)
+	}
+	for _, tool := range res.Tools {
+		schema, ok := tool.InputSchema.(map[string]any)
+		if !ok {
+			t.Fatalf("tool %s: unexpected schema type %T", tool.Name, tool.InputSchema)
+		}
+		properties, _ := schema["properties"].(map[string]any)
+		_, has := properties[argIdempotencyKey]
+		if want := tool.Name == "write"; has != want {
+			t.Errorf("tool %s: has %s = %v, want %v", tool.Name, argIdempotencyKey, has, want)
+		}
+	}
+}
@@ -107,6 +107,139 @@ func (m *MockCoreToolsetHandler) DeleteReleaseBinding(
 	return deletedResponse, nil
 }
 
+func (m *MockCoreToolsetHandler) PromoteComponent(
+	ctx context.Context, namespaceName, componentName, sourceEnv, targetEnv, comment string,
+) (any, error) {
+	m.recordCall("PromoteComponent", namespaceName, componentName, sourceEnv, targetEnv, comment)
+	return `{"name":"my-component-staging-x7k2p","action":"pending_approval"}`, nil
+}
+
+func (m *MockCoreToolsetHandler) ListPromotionRequests(
+	ctx context.Context, namespaceName, componentName string, opts ListOpts,
+) (any, error) {
+	m.recordCall("ListPromotionRequests", namespaceName, componentName, opts)
+	return `{"promotion_requests":[]}`, nil
+}
+
+func (m *MockCoreToolsetHandler) GetPromotionRequest(
+	ctx context.Context, namespaceName, promotionRequestName string,
+) (any, error) {
+	m.recordCall("GetPromotionRequest", namespaceName, promotionRequestName)
+	return `{"name":"my-component-staging-x7k2p","phase":"PendingApproval"}`, nil
+}
+
+func (m *MockCoreToolsetHandler) ApprovePromotionRequest(
+	ctx context.Context, namespaceName, promotionRequestName, comment string,
+) (any, error) {
+	m.recordCall("ApprovePromotionRequest", namespaceName, promotionRequestName, comment)
+	return `{"name":"my-component-staging-x7k2p","action":"approved"}`, nil
+}
+
+func (m *MockCoreToolsetHandler) RejectPromotionRequest(
+	ctx context.Context, namespaceName, promotionRequestName, comment string,
+) (any, error) {
+	m.recordCall("RejectPromotionRequest", namespaceName, promotionRequestName, comment)
+	return `{"name":"my-component-staging-x7k2p","action":"rejected"}`, nil
+}
+
+func (m *MockCoreToolsetHandler) DeployComponent(
+	ctx context.Context, namespaceName, componentName, environment, image, buildName string,
+) (any, error) {
+	m.recordCall("DeployComponent", namespaceName, componentName, environment, image, buildName)
+	return `{"name":"my-component-development","action":"created"}`, nil
+}
+
+func (m *MockCoreToolsetHandler) GetDeploymentStatus(
+	ctx context.Context, namespaceName, componentName, environment string,
+) (any, error) {
+	m.recordCall("GetDeploymentStatus", namespaceName, componentName, environment)
+	return `{"component":"my-component","environment":"development","phase":"Ready"}`, nil
+}
+
+func (m *MockCoreToolsetHandler) GetReleaseHistory(
+	ctx context.Context, namespaceName, componentName, environment string,
+) (any, error) {
+	m.recordCall("GetReleaseHistory", namespaceName, componentName, environment)
+	return `{"items":[{"environment":"development","releaseName":"my-component-1","current":true}]}`, nil
+}
+
+func (m *MockCoreToolsetHandler) RollbackComponent(
+	ctx context.Context, namespaceName, componentName, environment, release string,
+) (any, error) {
+	m.recordCall("RollbackComponent", namespaceName, componentName, environment, release)
+	return `{"name":"my-component-production","action":"rolled back"}`, nil
+}
+
+func (m *MockCoreToolsetHandler) GetComponentEndpoints(
+	ctx context.Context, namespaceName, componentName, environment string,
+) (any, error) {
+	m.recordCall("GetComponentEndpoints", namespaceName, componentName, environment)
+	return `{"component":"my-component","environments":[]}`, nil
+}
+
+func (m *MockCoreToolsetHandler) ListEndpoints(
+	ctx context.Context, namespaceName, projectName, componentName, environment string,
+) (any, error) {
+	m.recordCall("ListEndpoints", namespaceName, projectName, componentName, environment)
+	return `{"items":[]}`, nil
+}
+
+func (m *MockCoreToolsetHandler) UpdateEndpoint(
+	ctx context.Context, namespaceName, componentName, endpointName string, opts EndpointOpts,
+) (any, error) {
+	m.recordCall("UpdateEndpoint", namespaceName, componentName, endpointName, opts)
+	return `{"name":"api","action":"updated"}`, nil
+}
+
+func (m *MockCoreToolsetHandler) ScaleComponent(
+	ctx context.Context, namespaceName, componentName, environment string, opts ScaleOpts,
+) (any, error) {
+	m.recordCall("ScaleComponent", namespaceName, componentName, environment, opts)
+	return `{"name":"my-component-production","action":"scaled"}`, nil
+}
+
+func (m *MockCoreToolsetHandler) RestartComponent(
+	ctx context.Context, namespaceName, componentName, environment string,
+) (any, error) {
+	m.recordCall("RestartComponent", namespaceName, componentName, environment)
+	return `{"name":"my-component-production","action":"restarted"}`, nil
+}
+
+func (m *MockCoreToolsetHandler) GetComponentConfiguration(
+	ctx context.Context, namespaceName, componentName, environment string,
+) (any, error) {
+	m.recordCall("GetComponentConfiguration", namespaceName, componentName, environment)
+	return `{"component":"my-component","environments":[]}`, nil
+}
+
+func (m *MockCoreToolsetHandler) SetComponentEnvVar(
+	ctx context.Context, namespaceName, componentName, environment string, envVar EnvVarOpts,
+) (any, error) {
+	m.recordCall("SetComponentEnvVar", namespaceName, componentName, environment, envVar)
+	return `{"name":"my-component-production","action":"updated"}`, nil
+}
+
+func (m *MockCoreToolsetHandler) UnsetComponentEnvVar(
+	ctx context.Context, namespaceName, componentName, environment, key string,
+) (any, error) {
+	m.recordCall("UnsetComponentEnvVar", namespaceName, componentName, environment, key)
+	return `{"name":"my-component-production","action":"updated"}`, nil
+}
+
+func (m *MockCoreToolsetHandler) ListComponentSecrets(
+	ctx context.Context, namespaceName, componentName, environment string,
+) (any, error) {
+	m.recordCall("ListComponentSecrets", namespaceName, componentName, environment)
+	return `{"component":"my-component","secrets":[]}`, nil
+}
+
+func (m *MockCoreToolsetHandler) SetSecretValue(
+	ctx context.Context, namespaceName, secretName, key, value string,
+) (any, error) {
+	m.recordCall("SetSecretValue", namespaceName, secretName, key, value)
+	return `{"name":"db-credentials","action":"updated","keys":["password"]}`, nil
+}
+
 func (m *MockCoreToolsetHandler) DeleteComponentRelease(
 	ctx context.Context, namespaceName, componentReleaseName string,
 ) (any, error) {
@@ -121,6 +254,13 @@ func (m *MockCoreToolsetHandler) ListProjects(ctx context.Context, namespaceName
 	return `[{"name":"project1"}]`, nil
 }
 
+func (m *MockCoreToolsetHandler) GetProject(
+	ctx context.Context, namespaceName, projectName string,
+) (any, error) {
+	m.recordCall("GetProject", namespaceName, projectName)
+	return `{"name":"project1"}`, nil
+}
+
 func (m *MockCoreToolsetHandler) CreateProject(
 	ctx context.Context, namespaceName string, req *gen.CreateProjectJSONRequestBody,
 ) (any, error) {
@@ -137,6 +277,20 @@ func (m *MockCoreToolsetHandler) UpdateProject(
 
 // ComponentToolsetHandler methods
 
+func (m *MockCoreToolsetHandler) ScaffoldComponent(
+	ctx context.Context, namespaceName, projectName string, opts ScaffoldComponentOpts,
+) (any, error) {
+	m.recordCall("ScaffoldComponent", namespaceName, projectName, opts)
+	return `{"name":"new-component","action":"created"}`, nil
+}
+
+func (m *MockCoreToolsetHandler) ImportComponent(
+	ctx context.Context, namespaceName, projectName string, opts ImportComponentOpts,
+) (any, error) {
+	m.recordCall("ImportComponent", namespaceName, projectName, opts)
+	return `{"name":"new-component","action":"created"}`, nil
+}
+
 func (m *MockCoreToolsetHandler) CreateComponent(
 	ctx context.Context, namespaceName, projectName string, req *gen.CreateComponentRequest,
 ) (any, error) {
@@ -251,6 +405,13 @@ func (m *MockCoreToolsetHandler) GetComponentSchema(
 	return emptyObjectSchema, nil
 }
 
+func (m *MockCoreToolsetHandler) GetComponentDependencyGraph(
+	ctx context.Context, namespaceName, projectName, componentName string,
+) (any, error) {
+	m.recordCall("GetComponentDependencyGraph", namespaceName, projectName, componentName)
+	return `{"nodes":[],"edges":[]}`, nil
+}
+
 func (m *MockCoreToolsetHandler) PatchComponent(
 	ctx context.Context, namespaceName, componentName string, req *gen.PatchComponentRequest,
 ) (any, error) {
@@ -272,6 +433,13 @@ func (m *MockCoreToolsetHandler) TriggerWorkflowRun(
 	return `{"name":"my-component-workflow-run","status":"Running"}`, nil
 }
 
+func (m *MockCoreToolsetHandler) TriggerBuild(
+	ctx context.Context, namespaceName, projectName, componentName, commit, branch string,
+) (any, error) {
+	m.recordCall("TriggerBuild", namespaceName, projectName, componentName, commit, branch)
+	return `{"build_id":"my-component-run-abc12345","status":"Pending"}`, nil
+}
+
 func (m *MockCoreToolsetHandler) ListComponentTypes(
 	ctx context.Context, namespaceName string, opts ListOpts,
 ) (any, error) {
@@ -334,6 +502,13 @@ func (m *MockCoreToolsetHandler) GetWorkflowRunStatus(ctx context.Context, names
 	return `{"status":"Running","steps":[]}`, nil
 }
 
+func (m *MockCoreToolsetHandler) GetBuildSBOM(
+	ctx context.Context, namespaceName, buildID, componentName, environmentName string,
+) (any, error) {
+	m.recordCall("GetBuildSBOM", namespaceName, buildID, componentName, environmentName)
+	return `{"build_id":"run-1","format":"SPDX","location":"registry.local/app@sha256:def"}`, nil
+}
+
 func (m *MockCoreToolsetHandler) GetWorkflowRunLogs(
 	ctx context.Context, namespaceName, runName, taskName string, sinceSeconds *int64,
 ) (any, error) {
@@ -404,6 +579,13 @@ func (m *MockCoreToolsetHandler) ListEnvironments(
 	return `[{"name":"dev"}]`, nil
 }
 
+func (m *MockCoreToolsetHandler) GetEnvironmentOverview(
+	ctx context.Context, namespaceName, projectName string,
+) (any, error) {
+	m.recordCall("GetEnvironmentOverview", namespaceName, projectName)
+	return `{"environments":[{"name":"production","components":[]}]}`, nil
+}
+
 func (m *MockCoreToolsetHandler) CreateEnvironment(
 	ctx context.Context, namespaceName string, req *gen.CreateEnvironmentJSONRequestBody,
 ) (any, error) {
@@ -879,6 +1061,13 @@ func (m *MockCoreToolsetHandler) DeleteResource(
 	return deletedResponse, nil
 }
 
+func (m *MockCoreToolsetHandler) ListResourceDeployedObjects(
+	ctx context.Context, namespaceName, resourceName, cluster string,
+) (any, error) {
+	m.recordCall("ListResourceDeployedObjects", namespaceName, resourceName, cluster)
+	return `{"objects":[{"kind":"StatefulSet","name":"db"}]}`, nil
+}
+
 // Resource type methods (namespace-scoped)
 
 func (m *MockCoreToolsetHandler) ListResourceTypes(
@@ -88,7 +88,9 @@ func (t *Toolsets) RegisterPEListEnvironments(s *mcp.Server, perms map[string]To
 	mcp.AddTool(s, &mcp.Tool{
 		Name: name,
 		Description: "List all environments in a namespace. Environments are deployment targets representing " +
-			"pipeline stages (dev, staging, production) or isolated tenants. Supports pagination via limit and cursor.",
+			"pipeline stages (dev, staging, production) or isolated tenants. Each environment reports its " +
+			"position (stage, promotedFrom, promotesTo) in the deployment pipelines that reference it. " +
+			"Supports pagination via limit and cursor.",
 		InputSchema: createSchema(addPaginationProperties(map[string]any{
 			"namespace_name": defaultStringProperty(),
 		}), []string{"namespace_name"}),
@@ -109,7 +111,8 @@ func (t *Toolsets) RegisterCreateEnvironment(s *mcp.Server, perms map[string]Too
 	mcp.AddTool(s, &mcp.Tool{
 		Name: name,
 		Description: "Create a new environment in a namespace. Environments are deployment targets representing " +
-			"pipeline stages (dev, qa, prod) or isolated tenants.",
+			"pipeline stages (dev, qa, prod) or isolated tenants. The referenced data plane must exist. " +
+			"Optionally override the data plane's gateway (hosts and listeners) for this environment.",
 		InputSchema: createSchema(map[string]any{
 			"namespace_name": defaultStringProperty(),
 			"name":           stringProperty("DNS-compatible identifier (lowercase, alphanumeric, hyphens only, max 63 chars)"),
@@ -131,15 +134,23 @@ func (t *Toolsets) RegisterCreateEnvironment(s *mcp.Server, perms map[string]Too
 				"type":        "boolean",
 				"description": "Whether this is a production environment",
 			},
+			"gateway": map[string]any{
+				"type": "object",
+				"description": "Optional: gateway configuration for the environment. Shape: " +
+					"{\"ingress\": {\"external\": {\"name\", \"namespace\", \"http\": {\"host\", \"port\", " +
+					"\"listenerName\"}, \"https\": {...}}, \"internal\": {...}}, \"egress\": {...}}. " +
+					"Hosts are the DNS names under which the environment's endpoints are exposed",
+			},
 		}, []string{"namespace_name", "name", "data_plane_ref"}),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
-		NamespaceName    string `json:"namespace_name"`
-		Name             string `json:"name"`
-		DisplayName      string `json:"display_name"`
-		Description      string `json:"description"`
-		DataPlaneRef     string `json:"data_plane_ref"`
-		DataPlaneRefKind string `json:"data_plane_ref_kind"`
-		IsProduction     bool   `json:"is_production"`
+		NamespaceName    string           `json:"namespace_name"`
+		Name             string           `json:"name"`
+		DisplayName      string           `json:"display_name"`
+		Description      string           `json:"description"`
+		DataPlaneRef     string           `json:"data_plane_ref"`
+		DataPlaneRefKind string           `json:"data_plane_ref_kind"`
+		IsProduction     bool             `json:"is_production"`
+		Gateway          *gen.GatewaySpec `json:"gateway"`
 	}) (*mcp.CallToolResult, any, error) {
 		annotations := map[string]string{}
 		if args.DisplayName != "" {
@@ -156,6 +167,7 @@ func (t *Toolsets) RegisterCreateEnvironment(s *mcp.Server, perms map[string]Too
 			},
 			Spec: &gen.EnvironmentSpec{
 				IsProduction: &args.IsProduction,
+				Gateway:      args.Gateway,
 			},
 		}
 		if args.DataPlaneRef != "" {
@@ -228,7 +240,8 @@ func (t *Toolsets) RegisterDeleteEnvironment(s *mcp.Server, perms map[string]Too
 	mcp.AddTool(s, &mcp.Tool{
 		Name: name,
 		Description: "Delete an environment from a namespace. " +
-			"This will remove the deployment target and any associated resources.",
+			"This will remove the deployment target and any associated resources. " +
+			"Environments that still have active deployments (release bindings) are refused; undeploy them first.",
 		InputSchema: createSchema(map[string]any{
 			"namespace_name": defaultStringProperty(),
 			"name":           stringProperty("Name of the environment to delete. Use list_environments to discover valid names"),
@@ -556,7 +569,9 @@ func (t *Toolsets) RegisterListEnvironments(s *mcp.Server, perms map[string]Tool
 	mcp.AddTool(s, &mcp.Tool{
 		Name: name,
 		Description: "List all environments in a namespace. Environments are deployment targets representing " +
-			"pipeline stages (dev, staging, production) or isolated tenants. Supports pagination via limit and cursor.",
+			"pipeline stages (dev, staging, production) or isolated tenants. Each environment reports its " +
+			"position (stage, promotedFrom, promotesTo) in the deployment pipelines that reference it. " +
+			"Supports pagination via limit and cursor.",
 		InputSchema: createSchema(addPaginationProperties(map[string]any{
 			"namespace_name": defaultStringProperty(),
 		}), []string{"namespace_name"}),
@@ -571,6 +586,28 @@ func (t *Toolsets) RegisterListEnvironments(s *mcp.Server, perms map[string]Tool
 	})
 }
 
+func (t *Toolsets) RegisterGetEnvironmentOverview(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "get_environment_overview"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionViewEnvironment}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Get every environment in a namespace with its data plane, ingress gateway endpoints, " +
+			"and the components currently deployed to it: release name, endpoints and health status, plus " +
+			"deployed and ready counts. Answers questions like \"what's running in production?\" in one call. " +
+			"Optionally restrict the deployed components to a single project.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"project_name":   stringProperty("Optional: only report components of this project"),
+		}, []string{"namespace_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string `json:"namespace_name"`
+		ProjectName   string `json:"project_name"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.DeploymentToolset.GetEnvironmentOverview(ctx, args.NamespaceName, args.ProjectName)
+		return handleToolResult(result, err)
+	})
+}
+
 // ---------------------------------------------------------------------------
 // Build Toolset — WorkflowRun operations
 // ---------------------------------------------------------------------------
@@ -606,7 +643,7 @@ func (t *Toolsets) RegisterListWorkflowRuns(s *mcp.Server, perms map[string]Tool
 	mcp.AddTool(s, &mcp.Tool{
 		Name: name,
 		Description: "List workflow runs in a namespace, optionally filtered by project and component. " +
-			"Shows execution history including status, timestamps, and workflow references. " +
+			"Shows execution history including status, timestamps, duration, the built image, and workflow references. " +
 			"Supports pagination via limit and cursor.",
 		InputSchema: createSchema(addPaginationProperties(map[string]any{
 			"namespace_name": defaultStringProperty(),
@@ -632,7 +669,7 @@ func (t *Toolsets) RegisterGetWorkflowRun(s *mcp.Server, perms map[string]ToolPe
 	mcp.AddTool(s, &mcp.Tool{
 		Name: name,
 		Description: "Get detailed information about a specific workflow run including its status, tasks, " +
-			"timestamps, and referenced resources.",
+			"timestamps and durations, the image it produced (with digest when reported), and referenced resources.",
 		InputSchema: createSchema(map[string]any{
 			"namespace_name": defaultStringProperty(),
 			"run_name":       stringProperty("Use list_workflow_runs to discover valid names"),
@@ -652,7 +689,8 @@ func (t *Toolsets) RegisterGetWorkflowRunStatus(s *mcp.Server, perms map[string]
 	mcp.AddTool(s, &mcp.Tool{
 		Name: name,
 		Description: "Get the overall status and per-step status of a specific workflow run. " +
-			"Returns the run-level phase and a breakdown of each task with its phase and start/finish timestamps. " +
+			"Returns the run-level phase, the image it produced once reported, and a breakdown of each task " +
+			"with its phase and start/finish timestamps. " +
 			"Useful for monitoring CI/CD pipeline progress without fetching full logs.",
 		InputSchema: createSchema(map[string]any{
 			"namespace_name": defaultStringProperty(),
@@ -675,6 +713,7 @@ func (t *Toolsets) RegisterGetWorkflowRunLogs(s *mcp.Server, perms map[string]To
 		Description: "Get live logs for a specific workflow run from the workflow plane. " +
 			"Useful for debugging CI/CD pipeline failures and inspecting task output. " +
 			"Logs are fetched live; no archived logs are returned for completed runs. " +
+			"Each log line names the task that wrote it. " +
 			"Optionally filter by task name and limit to recent activity via since_seconds.",
 		InputSchema: createSchema(map[string]any{
 			"namespace_name": defaultStringProperty(),
@@ -140,7 +140,7 @@ func peEnvironmentSpecs() []toolTestSpec {
 			descriptionMinLen:   10,
 			requiredParams:      []string{"namespace_name", "name", "data_plane_ref"},
 			optionalParams: []string{
-				"display_name", "description", "data_plane_ref_kind", "is_production",
+				"display_name", "description", "data_plane_ref_kind", "is_production", "gateway",
 			},
 			testArgs: map[string]any{
 				"namespace_name":      testNamespaceName,
@@ -150,12 +150,28 @@ func peEnvironmentSpecs() []toolTestSpec {
 				"data_plane_ref":      "dp1",
 				"data_plane_ref_kind": "DataPlane",
 				"is_production":       false,
+				"gateway": map[string]any{
+					"ingress": map[string]any{
+						"external": map[string]any{
+							"name": "gateway-default", "namespace": "openchoreo-data-plane",
+							"http": map[string]any{"host": "new-env.example.com", "port": 80},
+						},
+					},
+				},
 			},
 			expectedMethod: "CreateEnvironment",
 			validateCall: func(t *testing.T, args []interface{}) {
 				if args[0] != testNamespaceName {
 					t.Errorf("Expected namespace %q, got %v", testNamespaceName, args[0])
 				}
+				req, ok := args[1].(*gen.CreateEnvironmentJSONRequestBody)
+				if !ok || req.Spec == nil || req.Spec.Gateway == nil || req.Spec.Gateway.Ingress == nil ||
+					req.Spec.Gateway.Ingress.External == nil || req.Spec.Gateway.Ingress.External.Http == nil {
+					t.Fatalf("Expected gateway ingress in request, got %#v", args[1])
+				}
+				if host := *req.Spec.Gateway.Ingress.External.Http.Host; host != "new-env.example.com" {
+					t.Errorf("Expected gateway host %q, got %q", "new-env.example.com", host)
+				}
 			},
 		},
 		{
@@ -594,7 +610,9 @@ func peClusterPlatformStandardsSpecs() []toolTestSpec {
 			optionalParams:      []string{"display_name", "description"},
 			testArgs: map[string]any{
 				"name": testGoServiceName,
-				"spec": map[string]any{"workloadType": "deployment", "resources": []any{}},
+				"spec": map[string]any{"workloadType": "deployment", "resources": []any{
+					map[string]any{"id": "deployment", "template": map[string]any{}},
+				}},
 			},
 			expectedMethod: "CreateClusterComponentType",
 			validateCall:   func(t *testing.T, args []interface{}) {},
@@ -608,7 +626,9 @@ func peClusterPlatformStandardsSpecs() []toolTestSpec {
 			optionalParams:      []string{"display_name", "description"},
 			testArgs: map[string]any{
 				"name": testGoServiceName,
-				"spec": map[string]any{"workloadType": "deployment", "resources": []any{}},
+				"spec": map[string]any{"workloadType": "deployment", "resources": []any{
+					map[string]any{"id": "deployment", "template": map[string]any{}},
+				}},
 			},
 			expectedMethod: "UpdateClusterComponentType",
 			validateCall:   func(t *testing.T, args []interface{}) {},
@@ -744,7 +764,7 @@ func pePlatformStandardsSpecs() []toolTestSpec {
 			toolset:             "pe",
 			descriptionKeywords: []string{"component", "type", "spec"},
 			descriptionMinLen:   10,
-			optionalParams:      []string{"scope", "namespace_name"},
+			optionalParams:      []string{"scope", "namespace_name", "fields"},
 			requiredParams:      []string{"name"},
 			testArgs: map[string]any{
 				"namespace_name": testNamespaceName,
@@ -938,7 +958,9 @@ func pePlatformStandardsSpecs() []toolTestSpec {
 			testArgs: map[string]any{
 				"namespace_name": testNamespaceName,
 				"name":           "my-component-type",
-				"spec":           map[string]any{"workloadType": "deployment", "resources": []any{}},
+				"spec": map[string]any{"workloadType": "deployment", "resources": []any{
+					map[string]any{"id": "deployment", "template": map[string]any{}},
+				}},
 			},
 			expectedMethod: "CreateComponentType",
 			validateCall: func(t *testing.T, args []interface{}) {
@@ -957,7 +979,9 @@ func pePlatformStandardsSpecs() []toolTestSpec {
 			testArgs: map[string]any{
 				"namespace_name": testNamespaceName,
 				"name":           "my-component-type",
-				"spec":           map[string]any{"workloadType": "deployment", "resources": []any{}},
+				"spec": map[string]any{"workloadType": "deployment", "resources": []any{
+					map[string]any{"id": "deployment", "template": map[string]any{}},
+				}},
 			},
 			expectedMethod: "UpdateComponentType",
 			validateCall: func(t *testing.T, args []interface{}) {
@@ -42,6 +42,26 @@ func (t *Toolsets) RegisterListProjects(s *mcp.Server, perms map[string]ToolPerm
 	})
 }
 
+func (t *Toolsets) RegisterGetProject(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "get_project"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionViewProject}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "Get detailed information about a project including its deployment pipeline, " +
+			"project type, parameters, latest release and status conditions.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"project_name":   stringProperty("Use list_projects to discover valid names"),
+		}, []string{"namespace_name", "project_name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string `json:"namespace_name"`
+		ProjectName   string `json:"project_name"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.ProjectToolset.GetProject(ctx, args.NamespaceName, args.ProjectName)
+		return handleToolResult(result, err)
+	})
+}
+
 func (t *Toolsets) RegisterCreateProject(s *mcp.Server, perms map[string]ToolPermission) {
 	const name = "create_project"
 	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionCreateProject}
@@ -57,14 +77,17 @@ func (t *Toolsets) RegisterCreateProject(s *mcp.Server, perms map[string]ToolPer
 			"ProjectReleaseBinding per target environment with create_project_release_binding. " +
 			"Create bindings right away with the release pin left empty: they stay pending and the " +
 			"controller fills the pin once the project's first ProjectRelease is cut, so a not-yet-existing " +
-			"release is not a blocker. Use get_deployment_pipeline to list the pipeline's environments.",
+			"release is not a blocker. Use get_deployment_pipeline to list the pipeline's environments. " +
+			"The namespace must be an OpenChoreo control plane namespace and the deployment pipeline " +
+			"must already exist in it.",
 		InputSchema: createSchema(map[string]any{
 			"namespace_name": defaultStringProperty(),
 			"name": stringProperty(
 				"DNS-compatible identifier (lowercase, alphanumeric, hyphens only, max 63 chars)"),
 			"description": stringProperty("Human-readable description"),
 			"deployment_pipeline": stringProperty(
-				"Name of the DeploymentPipeline to use. Defaults to \"default\" if not specified."),
+				"Name of an existing DeploymentPipeline to use. Defaults to \"default\" if not specified. " +
+					"Use list_deployment_pipelines to discover valid names."),
 			"type_name": stringProperty(
 				"Optional: name of the (Cluster)ProjectType to reference. Defaults to \"default\". " +
 					"Use list_project_types to discover names."),
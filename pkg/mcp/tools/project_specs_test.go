@@ -34,6 +34,23 @@ func projectCoreToolSpecs() []toolTestSpec {
 				}
 			},
 		},
+		{
+			name:                "get_project",
+			toolset:             "project",
+			descriptionKeywords: []string{"project"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "project_name"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"project_name":   testProjectName,
+			},
+			expectedMethod: "GetProject",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != testProjectName {
+					t.Errorf("Expected (%q, %q), got (%v, %v)", testNamespaceName, testProjectName, args[0], args[1])
+				}
+			},
+		},
 		{
 			name:                "create_project",
 			toolset:             "project",
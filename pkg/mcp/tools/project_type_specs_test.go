@@ -39,7 +39,7 @@ func peProjectTypeSpecs() []toolTestSpec {
 			testArgs: map[string]any{
 				"namespace_name": testNamespaceName,
 				"name":           "my-project-type",
-				"spec":           map[string]any{"resources": []any{}},
+				"spec":           map[string]any{"resources": []any{map[string]any{"id": "config", "template": map[string]any{}}}},
 			},
 			expectedMethod: "CreateProjectType",
 			validateCall: func(t *testing.T, args []interface{}) {
@@ -58,7 +58,7 @@ func peProjectTypeSpecs() []toolTestSpec {
 			testArgs: map[string]any{
 				"namespace_name": testNamespaceName,
 				"name":           "my-project-type",
-				"spec":           map[string]any{"resources": []any{}},
+				"spec":           map[string]any{"resources": []any{map[string]any{"id": "config", "template": map[string]any{}}}},
 			},
 			expectedMethod: "UpdateProjectType",
 			validateCall: func(t *testing.T, args []interface{}) {
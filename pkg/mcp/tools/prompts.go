@@ -0,0 +1,166 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const promptScaffoldComponent = "scaffold_component"
+
+// registerComponentPrompts registers the MCP prompts that guide an AI client
+// through component scaffolding. Prompts are backed by the component toolset:
+// the installed (Cluster)ComponentTypes are looked up at prompt time so the
+// generated guidance reflects the platform's actual classes and defaults.
+func (t *Toolsets) registerComponentPrompts(s *mcp.Server) {
+	s.AddPrompt(&mcp.Prompt{
+		Name:  promptScaffoldComponent,
+		Title: "Scaffold a component",
+		Description: "Guided creation of a new component (e.g. \"create a service component for a Go REST API\"). " +
+			"Lists the component types installed on the platform, pulls the parameter schema and defaults of " +
+			"the selected type, and points at example manifests and CRD schemas exposed as MCP resources.",
+		Arguments: []*mcp.PromptArgument{
+			{Name: "namespace_name", Description: "Namespace to create the component in", Required: true},
+			{Name: "project_name", Description: "Project that will own the component", Required: true},
+			{Name: "description", Description: "What the component does, e.g. \"Go REST API for orders\""},
+			{Name: "component_name", Description: "Optional: DNS-compatible component name"},
+			{
+				Name: "component_type",
+				Description: "Optional: component type in {workloadType}/{componentTypeName} format, " +
+					"e.g. deployment/service. When omitted the assistant picks one from the installed types.",
+			},
+			{
+				Name: "scope",
+				Description: fmt.Sprintf("Optional: %q (default) when component_type is a ClusterComponentType, "+
+					"%q when it is a namespace-scoped ComponentType", ScopeCluster, ScopeNamespace),
+			},
+			{Name: "language", Description: "Optional: implementation language, e.g. go, java, nodejs"},
+			{Name: "repository_url", Description: "Optional: Git repository to build from source"},
+		},
+	}, t.scaffoldComponentPrompt)
+}
+
+func (t *Toolsets) scaffoldComponentPrompt(
+	ctx context.Context, req *mcp.GetPromptRequest,
+) (*mcp.GetPromptResult, error) {
+	args := req.Params.Arguments
+	namespaceName := args["namespace_name"]
+	projectName := args["project_name"]
+	if namespaceName == "" || projectName == "" {
+		return nil, fmt.Errorf("namespace_name and project_name are required")
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Create a new OpenChoreo component in project %q of namespace %q.\n", projectName, namespaceName)
+	if d := args["description"]; d != "" {
+		fmt.Fprintf(&b, "The component is: %s.\n", d)
+	}
+	if lang := args["language"]; lang != "" {
+		fmt.Fprintf(&b, "It is implemented in %s.\n", lang)
+	}
+	if name := args["component_name"]; name != "" {
+		fmt.Fprintf(&b, "Name the component %q.\n", name)
+	}
+
+	componentType := args["component_type"]
+	if componentType == "" {
+		b.WriteString("\nPick the most suitable component type from the types installed on the platform:\n")
+		if err := t.appendInstalledComponentTypes(ctx, &b, namespaceName); err != nil {
+			return nil, err
+		}
+	} else {
+		scope := args["scope"]
+		if scope == "" {
+			scope = ScopeCluster
+		}
+		if _, err := resolveScope(scope); err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&b, "\nUse component type %q (scope %q). Its parameter schema, including defaults, is:\n",
+			componentType, scope)
+		if err := t.appendComponentTypeSchema(ctx, &b, namespaceName, componentType, scope); err != nil {
+			return nil, err
+		}
+	}
+
+	b.WriteString("\nSteps:\n")
+	b.WriteString("1. Only set parameters whose defaults do not fit the component; omit the rest.\n")
+	if repo := args["repository_url"]; repo != "" {
+		fmt.Fprintf(&b, "2. Build from source: configure a workflow that builds %s. Use list_workflows to "+
+			"discover build workflows and get_workflow_schema for their parameters. The Workload is generated "+
+			"by the build; see resource %s.\n", repo, exampleResourceURI("component-from-source"))
+	} else {
+		fmt.Fprintf(&b, "2. Deploy a pre-built image: after create_component, call create_workload with the "+
+			"container image and endpoints; see resource %s.\n", exampleResourceURI("component-from-image"))
+	}
+	b.WriteString("3. Call create_component with the chosen component_type and parameters.\n")
+	fmt.Fprintf(&b, "Field-level documentation is available in resources %s and %s.\n",
+		schemaResourceURI("component"), schemaResourceURI("workload"))
+
+	return &mcp.GetPromptResult{
+		Description: "Scaffold a component in project " + projectName,
+		Messages: []*mcp.PromptMessage{
+			{Role: "user", Content: &mcp.TextContent{Text: b.String()}},
+		},
+	}, nil
+}
+
+// appendInstalledComponentTypes writes the namespace-scoped and cluster-scoped
+// component types visible to the caller as JSON blocks.
+func (t *Toolsets) appendInstalledComponentTypes(ctx context.Context, b *strings.Builder, namespaceName string) error {
+	nsTypes, err := t.ComponentToolset.ListComponentTypes(ctx, namespaceName, ListOpts{})
+	if err != nil {
+		return fmt.Errorf("list component types: %w", err)
+	}
+	clusterTypes, err := t.ComponentToolset.ListClusterComponentTypes(ctx, ListOpts{})
+	if err != nil {
+		return fmt.Errorf("list cluster component types: %w", err)
+	}
+	if err := appendJSONBlock(b, "Namespace-scoped component types (scope \"namespace\")", nsTypes); err != nil {
+		return err
+	}
+	return appendJSONBlock(b, "Cluster-scoped component types (scope \"cluster\")", clusterTypes)
+}
+
+// appendComponentTypeSchema writes the parameter schema of the named component
+// type. componentType may be given either as {workloadType}/{name} or as the
+// bare type name.
+func (t *Toolsets) appendComponentTypeSchema(
+	ctx context.Context, b *strings.Builder, namespaceName, componentType, scope string,
+) error {
+	typeName := componentType
+	if i := strings.LastIndex(componentType, "/"); i >= 0 {
+		typeName = componentType[i+1:]
+	}
+	var schema any
+	var err error
+	if scope == ScopeCluster {
+		schema, err = t.ComponentToolset.GetClusterComponentTypeSchema(ctx, typeName)
+	} else {
+		schema, err = t.ComponentToolset.GetComponentTypeSchema(ctx, namespaceName, typeName)
+	}
+	if err != nil {
+		return fmt.Errorf("get schema for component type %q: %w", componentType, err)
+	}
+	return appendJSONBlock(b, "", schema)
+}
+
+func appendJSONBlock(b *strings.Builder, heading string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	if heading != "" {
+		b.WriteString(heading + ":\n")
+	}
+	b.WriteString("```json\n")
+	b.Write(data)
+	b.WriteString("\n```\n")
+	return nil
+}
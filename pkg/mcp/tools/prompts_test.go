@@ -0,0 +1,195 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestScaffoldingPromptRegistered(t *testing.T) {
+	clientSession, _ := setupTestServer(t)
+	defer clientSession.Close()
+
+	res, err := clientSession.ListPrompts(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ListPrompts() error: %v", err)
+	}
+	if len(res.Prompts) != 1 || res.Prompts[0].Name != promptScaffoldComponent {
+		t.Fatalf("expected only %q prompt, got %+v", promptScaffoldComponent, res.Prompts)
+	}
+	required := map[string]bool{}
+	for _, a := range res.Prompts[0].Arguments {
+		if a.Required {
+			required[a.Name] = true
+		}
+	}
+	if !required["namespace_name"] || !required["project_name"] || len(required) != 2 {
+		t.Errorf("unexpected required arguments: %v", required)
+	}
+}
+
+func TestScaffoldComponentPromptListsInstalledTypes(t *testing.T) {
+	clientSession, mockHandler := setupTestServer(t)
+	defer clientSession.Close()
+
+	res, err := clientSession.GetPrompt(context.Background(), &mcp.GetPromptParams{
+		Name: promptScaffoldComponent,
+		Arguments: map[string]string{
+			"namespace_name": testNamespaceName,
+			"project_name":   testProjectName,
+			"description":    "Go REST API",
+		},
+	})
+	if err != nil {
+		t.Fatalf("GetPrompt() error: %v", err)
+	}
+	text := promptText(t, res)
+	for _, want := range []string{"Go REST API", "WebApplication", "go-service", "create_workload",
+		exampleResourceURI("component-from-image")} {
+		if !strings.Contains(text, want) {
+			t.Errorf("prompt text missing %q:\n%s", want, text)
+		}
+	}
+	if calls := mockHandler.calls["ListComponentTypes"]; len(calls) != 1 {
+		t.Errorf("expected ListComponentTypes to be called once, got %d", len(calls))
+	}
+	if calls := mockHandler.calls["ListClusterComponentTypes"]; len(calls) != 1 {
+		t.Errorf("expected ListClusterComponentTypes to be called once, got %d", len(calls))
+	}
+}
+
+func TestScaffoldComponentPromptUsesSelectedTypeSchema(t *testing.T) {
+	tests := []struct {
+		name       string
+		scope      string
+		wantMethod string
+		wantArgs   []interface{}
+	}{
+		{
+			name:       "defaults to cluster scope",
+			wantMethod: "GetClusterComponentTypeSchema",
+			wantArgs:   []interface{}{"service"},
+		},
+		{
+			name:       "namespace scope",
+			scope:      ScopeNamespace,
+			wantMethod: "GetComponentTypeSchema",
+			wantArgs:   []interface{}{testNamespaceName, "service"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientSession, mockHandler := setupTestServer(t)
+			defer clientSession.Close()
+
+			res, err := clientSession.GetPrompt(context.Background(), &mcp.GetPromptParams{
+				Name: promptScaffoldComponent,
+				Arguments: map[string]string{
+					"namespace_name": testNamespaceName,
+					"project_name":   testProjectName,
+					"component_type": "deployment/service",
+					"scope":          tt.scope,
+					"repository_url": "https://github.com/example/repo",
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetPrompt() error: %v", err)
+			}
+			text := promptText(t, res)
+			if !strings.Contains(text, exampleResourceURI("component-from-source")) {
+				t.Errorf("expected from-source guidance when repository_url is set:\n%s", text)
+			}
+			calls := mockHandler.calls[tt.wantMethod]
+			if len(calls) != 1 {
+				t.Fatalf("expected %s to be called once, got %d", tt.wantMethod, len(calls))
+			}
+			got := calls[0].([]interface{})
+			for i, want := range tt.wantArgs {
+				if got[i] != want {
+					t.Errorf("%s arg %d = %v, want %v", tt.wantMethod, i, got[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestScaffoldComponentPromptRequiresNamespaceAndProject(t *testing.T) {
+	clientSession, _ := setupTestServer(t)
+	defer clientSession.Close()
+
+	_, err := clientSession.GetPrompt(context.Background(), &mcp.GetPromptParams{
+		Name:      promptScaffoldComponent,
+		Arguments: map[string]string{"namespace_name": testNamespaceName},
+	})
+	if err == nil {
+		t.Fatal("expected error when project_name is missing")
+	}
+}
+
+func TestScaffoldingResources(t *testing.T) {
+	clientSession, _ := setupTestServer(t)
+	defer clientSession.Close()
+	ctx := context.Background()
+
+	list, err := clientSession.ListResources(ctx, nil)
+	if err != nil {
+		t.Fatalf("ListResources() error: %v", err)
+	}
+	if want := len(schemaResources) + len(exampleResources); len(list.Resources) != want {
+		t.Fatalf("expected %d resources, got %d", want, len(list.Resources))
+	}
+
+	for _, r := range schemaResources {
+		res, err := clientSession.ReadResource(ctx, &mcp.ReadResourceParams{URI: schemaResourceURI(r.slug)})
+		if err != nil {
+			t.Fatalf("ReadResource(%s) error: %v", r.slug, err)
+		}
+		var schema map[string]any
+		if err := json.Unmarshal([]byte(res.Contents[0].Text), &schema); err != nil {
+			t.Fatalf("%s schema is not valid JSON: %v", r.slug, err)
+		}
+		if _, ok := schema["properties"]; !ok {
+			t.Errorf("%s schema missing properties", r.slug)
+		}
+	}
+
+	for _, r := range exampleResources {
+		res, err := clientSession.ReadResource(ctx, &mcp.ReadResourceParams{URI: exampleResourceURI(r.slug)})
+		if err != nil {
+			t.Fatalf("ReadResource(%s) error: %v", r.slug, err)
+		}
+		if !strings.Contains(res.Contents[0].Text, "kind: Component") {
+			t.Errorf("%s example does not contain a Component manifest", r.slug)
+		}
+	}
+}
+
+func TestScaffoldingNotRegisteredWithoutComponentToolset(t *testing.T) {
+	clientSession := setupTestServerWithToolset(t, &Toolsets{NamespaceToolset: NewMockCoreToolsetHandler()})
+	defer clientSession.Close()
+
+	// A server with no prompts does not advertise the prompts capability.
+	if _, err := clientSession.GetPrompt(context.Background(), &mcp.GetPromptParams{
+		Name: promptScaffoldComponent,
+	}); err == nil {
+		t.Error("expected scaffold prompt to be unavailable without the component toolset")
+	}
+}
+
+func promptText(t *testing.T, res *mcp.GetPromptResult) string {
+	t.Helper()
+	if len(res.Messages) != 1 {
+		t.Fatalf("expected 1 prompt message, got %d", len(res.Messages))
+	}
+	tc, ok := res.Messages[0].Content.(*mcp.TextContent)
+	if !ok {
+		t.Fatalf("expected text content, got %T", res.Messages[0].Content)
+	}
+	return tc.Text
+}
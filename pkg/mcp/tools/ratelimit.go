@@ -0,0 +1,169 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"golang.org/x/time/rate"
+)
+
+// rateLimitIdleTTL is how long the limiter state of a session is kept after its
+// last tool call. Sessions have no close hook visible to middleware, so idle
+// state is swept lazily instead.
+const rateLimitIdleTTL = 10 * time.Minute
+
+// RateLimitConfig bounds how fast a single MCP session may call tools. Rates are
+// token buckets in calls per second; a zero rate disables that bucket.
+type RateLimitConfig struct {
+	// Enabled turns the rate limiting middleware on.
+	Enabled bool
+	// SessionRate is the sustained tools/call rate across all tools of a session.
+	SessionRate float64
+	// SessionBurst is the number of calls a session may make at once.
+	SessionBurst int
+	// ToolRate is the sustained tools/call rate of each individual tool in a session.
+	ToolRate float64
+	// ToolBurst is the number of calls to a single tool a session may make at once.
+	ToolBurst int
+	// MaxConcurrentMutations caps the number of mutating tool calls a session may
+	// have in flight. Zero disables the cap.
+	MaxConcurrentMutations int
+}
+
+// sessionLimiter holds the rate limiting state of one MCP session.
+type sessionLimiter struct {
+	session   *rate.Limiter
+	tools     map[string]*rate.Limiter
+	mutations chan struct{}
+	lastSeen  time.Time
+}
+
+type rateLimiter struct {
+	cfg      RateLimitConfig
+	mutating map[string]bool
+
+	mu        sync.Mutex
+	sessions  map[mcp.Session]*sessionLimiter
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewRateLimitMiddleware returns an MCP receiving middleware that applies cfg to
+// tools/call requests. Calls over the limit fail with a retriable Unavailable tool
+// error carrying the suggested retry delay. A tool is considered mutating when any
+// of the authz actions in its permission entry is not a view action.
+func NewRateLimitMiddleware(cfg RateLimitConfig, perms map[string]ToolPermission) mcp.Middleware {
+	rl := &rateLimiter{
+		cfg:      cfg,
+		mutating: make(map[string]bool, len(perms)),
+		sessions: map[mcp.Session]*sessionLimiter{},
+		now:      time.Now,
+	}
+	for name, perm := range perms {
+		rl.mutating[name] = perm.Mutating()
+	}
+	return rl.middleware
+}
+
+func (rl *rateLimiter) middleware(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		if method != methodCallTool || req == nil || req.GetSession() == nil {
+			return next(ctx, method, req)
+		}
+		toolName := callToolName(req)
+		state, delay := rl.reserve(req.GetSession(), toolName)
+		if delay > 0 {
+			return rateLimitedResult(toolName, "rate limit exceeded", delay), nil
+		}
+		if state.mutations != nil && rl.mutating[toolName] {
+			select {
+			case state.mutations <- struct{}{}:
+				defer func() { <-state.mutations }()
+			default:
+				return rateLimitedResult(toolName, fmt.Sprintf(
+					"too many concurrent mutating tool calls (max %d)", rl.cfg.MaxConcurrentMutations), time.Second), nil
+			}
+		}
+		return next(ctx, method, req)
+	}
+}
+
+// reserve takes a token from the session and tool buckets. It returns the delay
+// after which the call would have been allowed when either bucket is empty.
+func (rl *rateLimiter) reserve(session mcp.Session, toolName string) (*sessionLimiter, time.Duration) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := rl.now()
+	rl.sweep(now)
+	state, ok := rl.sessions[session]
+	if !ok {
+		state = &sessionLimiter{tools: map[string]*rate.Limiter{}}
+		if rl.cfg.SessionRate > 0 {
+			state.session = rate.NewLimiter(rate.Limit(rl.cfg.SessionRate), max(rl.cfg.SessionBurst, 1))
+		}
+		if rl.cfg.MaxConcurrentMutations > 0 {
+			state.mutations = make(chan struct{}, rl.cfg.MaxConcurrentMutations)
+		}
+		rl.sessions[session] = state
+	}
+	state.lastSeen = now
+
+	var limiters []*rate.Limiter
+	if state.session != nil {
+		limiters = append(limiters, state.session)
+	}
+	if rl.cfg.ToolRate > 0 {
+		toolLimiter, ok := state.tools[toolName]
+		if !ok {
+			toolLimiter = rate.NewLimiter(rate.Limit(rl.cfg.ToolRate), max(rl.cfg.ToolBurst, 1))
+			state.tools[toolName] = toolLimiter
+		}
+		limiters = append(limiters, toolLimiter)
+	}
+
+	// Reserve from every bucket first so that a rejected call does not consume a
+	// token from a bucket that had one to spare.
+	reservations := make([]*rate.Reservation, 0, len(limiters))
+	var delay time.Duration
+	for _, l := range limiters {
+		r := l.ReserveN(now, 1)
+		reservations = append(reservations, r)
+		delay = max(delay, r.DelayFrom(now))
+	}
+	if delay > 0 {
+		for _, r := range reservations {
+			r.CancelAt(now)
+		}
+	}
+	return state, delay
+}
+
+// sweep drops the state of sessions idle for longer than rateLimitIdleTTL. It runs
+// at most once per TTL and must be called with rl.mu held.
+func (rl *rateLimiter) sweep(now time.Time) {
+	if now.Sub(rl.lastSweep) < rateLimitIdleTTL {
+		return
+	}
+	rl.lastSweep = now
+	for session, state := range rl.sessions {
+		if now.Sub(state.lastSeen) > rateLimitIdleTTL && len(state.mutations) == 0 {
+			delete(rl.sessions, session)
+		}
+	}
+}
+
+func rateLimitedResult(toolName, reason string, retryAfter time.Duration) *mcp.CallToolResult {
+	res := &mcp.CallToolResult{}
+	res.SetError(NewToolError(ErrorCodeUnavailable, fmt.Errorf("tool %q: %s", toolName, reason), map[string]any{
+		"retryAfterSeconds": int(math.Ceil(retryAfter.Seconds())),
+	}))
+	return res
+}
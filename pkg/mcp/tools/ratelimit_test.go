@@ -0,0 +1,170 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// newRateLimitedSession connects a client to a server exposing a "read" and a
+// "write" tool behind the rate limit and error envelope middleware. The write
+// tool blocks until release is closed once it has signalled on started.
+func newRateLimitedSession(t *testing.T, cfg RateLimitConfig, started chan<- struct{}, release <-chan struct{}) *mcp.ClientSession {
+	t.Helper()
+	ctx := context.Background()
+	server := mcp.NewServer(&mcp.Implementation{Name: "ratelimit-test"}, nil)
+	mcp.AddTool(server, &mcp.Tool{Name: "read", InputSchema: createSchema(map[string]any{}, nil)},
+		func(context.Context, *mcp.CallToolRequest, struct{}) (*mcp.CallToolResult, any, error) {
+			return handleToolResult(map[string]any{"ok": true}, nil)
+		})
+	mcp.AddTool(server, &mcp.Tool{Name: "write", InputSchema: createSchema(map[string]any{}, nil)},
+		func(context.Context, *mcp.CallToolRequest, struct{}) (*mcp.CallToolResult, any, error) {
+			if started != nil {
+				started <- struct{}{}
+				<-release
+			}
+			return handleToolResult(map[string]any{"ok": true}, nil)
+		})
+	perms := map[string]ToolPermission{
+		"read":  {ToolName: "read", Action: "component:view"},
+		"write": {ToolName: "write", Action: "component:create"},
+	}
+	server.AddReceivingMiddleware(NewErrorEnvelopeMiddleware(nil), NewRateLimitMiddleware(cfg, perms))
+
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	if _, err := server.Connect(ctx, serverTransport, nil); err != nil {
+		t.Fatalf("server connect: %v", err)
+	}
+	cs, err := mcp.NewClient(&mcp.Implementation{Name: "ratelimit-test-client"}, nil).Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("client connect: %v", err)
+	}
+	t.Cleanup(func() { cs.Close() })
+	return cs
+}
+
+func decodeToolError(t *testing.T, res *mcp.CallToolResult) ToolError {
+	t.Helper()
+	if !res.IsError {
+		t.Fatalf("expected a tool error result, got %q", firstText(res))
+	}
+	var envelope struct {
+		Error ToolError `json:"error"`
+	}
+	if err := json.Unmarshal([]byte(firstText(res)), &envelope); err != nil {
+		t.Fatalf("error text is not a JSON envelope: %v", err)
+	}
+	return envelope.Error
+}
+
+func TestRateLimitMiddlewareSessionBurst(t *testing.T) {
+	ctx := context.Background()
+	cs := newRateLimitedSession(t, RateLimitConfig{Enabled: true, SessionRate: 0.01, SessionBurst: 2}, nil, nil)
+
+	for i := range 2 {
+		res, err := cs.CallTool(ctx, &mcp.CallToolParams{Name: "read"})
+		if err != nil {
+			t.Fatalf("call %d: %v", i, err)
+		}
+		if res.IsError {
+			t.Fatalf("call %d within burst failed: %s", i, firstText(res))
+		}
+	}
+
+	res, err := cs.CallTool(ctx, &mcp.CallToolParams{Name: "write"})
+	if err != nil {
+		t.Fatalf("CallTool returned protocol error: %v", err)
+	}
+	toolErr := decodeToolError(t, res)
+	if toolErr.Code != ErrorCodeUnavailable || !toolErr.Retriable {
+		t.Errorf("error = %+v, want retriable %s", toolErr, ErrorCodeUnavailable)
+	}
+	if retryAfter, _ := toolErr.Details["retryAfterSeconds"].(float64); retryAfter < 1 {
+		t.Errorf("retryAfterSeconds = %v, want a positive delay", toolErr.Details["retryAfterSeconds"])
+	}
+}
+
+func TestRateLimitMiddlewarePerToolBucket(t *testing.T) {
+	ctx := context.Background()
+	cs := newRateLimitedSession(t, RateLimitConfig{Enabled: true, ToolRate: 0.01, ToolBurst: 1}, nil, nil)
+
+	if res, _ := cs.CallTool(ctx, &mcp.CallToolParams{Name: "read"}); res == nil || res.IsError {
+		t.Fatal("first read call should succeed")
+	}
+	res, err := cs.CallTool(ctx, &mcp.CallToolParams{Name: "read"})
+	if err != nil {
+		t.Fatalf("CallTool returned protocol error: %v", err)
+	}
+	if toolErr := decodeToolError(t, res); toolErr.Code != ErrorCodeUnavailable {
+		t.Errorf("code = %q, want %q", toolErr.Code, ErrorCodeUnavailable)
+	}
+
+	// Other tools have their own bucket.
+	if res, _ := cs.CallTool(ctx, &mcp.CallToolParams{Name: "write"}); res == nil || res.IsError {
+		t.Error("write call should not be limited by the read bucket")
+	}
+}
+
+func TestRateLimitMiddlewareConcurrentMutations(t *testing.T) {
+	ctx := context.Background()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	cs := newRateLimitedSession(t, RateLimitConfig{Enabled: true, MaxConcurrentMutations: 1}, started, release)
+
+	done := make(chan *mcp.CallToolResult, 1)
+	go func() {
+		res, _ := cs.CallTool(ctx, &mcp.CallToolParams{Name: "write"})
+		done <- res
+	}()
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("first write call did not start")
+	}
+
+	res, err := cs.CallTool(ctx, &mcp.CallToolParams{Name: "write"})
+	if err != nil {
+		t.Fatalf("CallTool returned protocol error: %v", err)
+	}
+	if toolErr := decodeToolError(t, res); toolErr.Code != ErrorCodeUnavailable {
+		t.Errorf("code = %q, want %q", toolErr.Code, ErrorCodeUnavailable)
+	}
+
+	// Read-only tools are not subject to the mutation cap.
+	if res, _ := cs.CallTool(ctx, &mcp.CallToolParams{Name: "read"}); res == nil || res.IsError {
+		t.Error("read call should not be limited by the mutation cap")
+	}
+
+	close(release)
+	if res := <-done; res == nil || res.IsError {
+		t.Error("first write call should succeed")
+	}
+}
+
+func TestToolPermissionMutating(t *testing.T) {
+	tests := []struct {
+		name string
+		perm ToolPermission
+		want bool
+	}{
+		{"view action", ToolPermission{Action: "component:view"}, false},
+		{"create action", ToolPermission{Action: "component:create"}, true},
+		{"scoped view actions", ToolPermission{ScopedActions: map[string]string{
+			ScopeNamespace: "componenttype:view", ScopeCluster: "clustercomponenttype:view"}}, false},
+		{"scoped create actions", ToolPermission{ScopedActions: map[string]string{
+			ScopeNamespace: "componenttype:create", ScopeCluster: "clustercomponenttype:create"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.perm.Mutating(); got != tt.want {
+				t.Errorf("Mutating() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
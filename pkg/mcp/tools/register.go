@@ -71,6 +71,7 @@ func (t *Toolsets) namespaceToolRegistrations() []RegisterFunc {
 func (t *Toolsets) projectToolRegistrations() []RegisterFunc {
 	return []RegisterFunc{
 		t.RegisterListProjects,
+		t.RegisterGetProject,
 		t.RegisterCreateProject,
 		t.RegisterUpdateProject,
 		t.RegisterDeleteProject,
@@ -84,6 +85,8 @@ func (t *Toolsets) projectToolRegistrations() []RegisterFunc {
 func (t *Toolsets) componentToolRegistrations() []RegisterFunc {
 	return []RegisterFunc{
 		t.RegisterCreateComponent,
+		t.RegisterScaffoldComponent,
+		t.RegisterImportComponent,
 		t.RegisterListComponents,
 		t.RegisterGetComponent,
 		t.RegisterPatchComponent,
@@ -95,6 +98,7 @@ func (t *Toolsets) componentToolRegistrations() []RegisterFunc {
 		t.RegisterDeleteWorkload,
 		t.RegisterGetWorkloadSchema,
 		t.RegisterGetComponentSchema,
+		t.RegisterGetComponentDependencyGraph,
 		// Platform standards (read-only). These are scope-collapsed: pass scope="cluster"
 		// to operate on the platform-wide cluster-scoped resource.
 		t.RegisterListComponentTypes,
@@ -121,6 +125,25 @@ func (t *Toolsets) deploymentToolRegistrations() []RegisterFunc {
 		t.RegisterCreateReleaseBinding,
 		t.RegisterUpdateReleaseBinding,
 		t.RegisterDeleteReleaseBinding,
+		t.RegisterPromoteComponent,
+		t.RegisterListPromotionRequests,
+		t.RegisterGetPromotionRequest,
+		t.RegisterApprovePromotionRequest,
+		t.RegisterRejectPromotionRequest,
+		t.RegisterDeployComponent,
+		t.RegisterGetDeploymentStatus,
+		t.RegisterGetReleaseHistory,
+		t.RegisterRollbackComponent,
+		t.RegisterGetComponentEndpoints,
+		t.RegisterListEndpoints,
+		t.RegisterUpdateEndpoint,
+		t.RegisterScaleComponent,
+		t.RegisterRestartComponent,
+		t.RegisterGetComponentConfiguration,
+		t.RegisterSetComponentEnvVar,
+		t.RegisterUnsetComponentEnvVar,
+		t.RegisterListComponentSecrets,
+		t.RegisterSetSecretValue,
 		t.RegisterDeleteComponentRelease,
 		t.RegisterDeleteResourceRelease,
 		t.RegisterDeleteProjectRelease,
@@ -137,6 +160,7 @@ func (t *Toolsets) deploymentToolRegistrations() []RegisterFunc {
 		t.RegisterListDeploymentPipelines,
 		t.RegisterGetDeploymentPipeline,
 		t.RegisterListEnvironments,
+		t.RegisterGetEnvironmentOverview,
 	}
 }
 
@@ -144,6 +168,9 @@ func (t *Toolsets) deploymentToolRegistrations() []RegisterFunc {
 func (t *Toolsets) buildToolRegistrations() []RegisterFunc {
 	return []RegisterFunc{
 		t.RegisterTriggerWorkflowRun,
+		t.RegisterTriggerBuild,
+		t.RegisterGetBuildStatus,
+		t.RegisterGetBuildSBOM,
 		t.RegisterCreateWorkflowRun,
 		t.RegisterListWorkflowRuns,
 		t.RegisterGetWorkflowRun,
@@ -315,6 +342,9 @@ func (t *Toolsets) resourceToolRegistrations() []RegisterFunc {
 		t.RegisterUpdateResource,
 		t.RegisterDeleteResource,
 
+		// Live objects the resource's releases applied to their data planes.
+		t.RegisterListResourceDeployedObjects,
+
 		// Resource types (read-only, scope-collapsed: pass scope="cluster" for ClusterResourceType).
 		t.RegisterListResourceTypes,
 		t.RegisterGetResourceType,
@@ -382,5 +412,18 @@ func (t *Toolsets) Register(s *mcp.Server) (
 		registerGroup(ToolsetResource, t.resourceToolRegistrations())
 	}
 
+	if t.Batch {
+		registerGroup(ToolsetBatch, []RegisterFunc{t.RegisterBatchToolCalls})
+	}
+
+	// Scaffolding prompts and resources ride along with the component toolset.
+	// They are not tools, so they carry no ToolPermission and are not narrowed
+	// by the tool filter middleware; the prompt handlers read component types
+	// through the component toolset, whose service layer enforces authz.
+	if t.ComponentToolset != nil {
+		t.registerComponentPrompts(s)
+		registerScaffoldingResources(s)
+	}
+
 	return perms, toolToToolsets
 }
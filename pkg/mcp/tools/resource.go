@@ -25,16 +25,18 @@ func (t *Toolsets) RegisterListResources(s *mcp.Server, perms map[string]ToolPer
 		InputSchema: createSchema(addPaginationProperties(map[string]any{
 			"namespace_name": defaultStringProperty(),
 			"project_name":   defaultStringProperty(),
+			"fields":         fieldsProperty(),
 		}), []string{"namespace_name", "project_name"}),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		NamespaceName string `json:"namespace_name"`
 		ProjectName   string `json:"project_name"`
 		Limit         int    `json:"limit,omitempty"`
 		Cursor        string `json:"cursor,omitempty"`
+		Fields        string `json:"fields,omitempty"`
 	}) (*mcp.CallToolResult, any, error) {
 		result, err := t.ResourceToolset.ListResources(
 			ctx, args.NamespaceName, args.ProjectName, ListOpts{Limit: args.Limit, Cursor: args.Cursor})
-		return handleToolResult(result, err)
+		return handleFieldsToolResult(result, err, args.Fields, "resources")
 	})
 }
 
@@ -48,13 +50,15 @@ func (t *Toolsets) RegisterGetResource(s *mcp.Server, perms map[string]ToolPermi
 		InputSchema: createSchema(map[string]any{
 			"namespace_name": defaultStringProperty(),
 			"name":           stringProperty("Resource name. Use list_resources to discover valid names"),
+			"fields":         fieldsProperty(),
 		}, []string{"namespace_name", "name"}),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
 		NamespaceName string `json:"namespace_name"`
 		Name          string `json:"name"`
+		Fields        string `json:"fields,omitempty"`
 	}) (*mcp.CallToolResult, any, error) {
 		result, err := t.ResourceToolset.GetResource(ctx, args.NamespaceName, args.Name)
-		return handleToolResult(result, err)
+		return handleFieldsToolResult(result, err, args.Fields, "")
 	})
 }
 
@@ -176,6 +180,31 @@ func (t *Toolsets) RegisterDeleteResource(s *mcp.Server, perms map[string]ToolPe
 	})
 }
 
+func (t *Toolsets) RegisterListResourceDeployedObjects(s *mcp.Server, perms map[string]ToolPermission) {
+	const name = "list_resource_deployed_objects"
+	perms[name] = ToolPermission{ToolName: name, Action: authzcore.ActionViewResource}
+	mcp.AddTool(s, &mcp.Tool{
+		Name: name,
+		Description: "List the live Kubernetes objects a resource's releases applied to their data planes, " +
+			"read directly from each data plane cluster. Use it to inspect what was actually deployed " +
+			"(e.g. the database StatefulSet and its status). Objects that could not be read carry an " +
+			"error instead of their state; Secret data is never returned.",
+		InputSchema: createSchema(map[string]any{
+			"namespace_name": defaultStringProperty(),
+			"name":           stringProperty("Resource name. Use list_resources to discover valid names"),
+			"cluster": stringProperty(
+				"Optional: only return objects on the DataPlane or ClusterDataPlane with this name"),
+		}, []string{"namespace_name", "name"}),
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args struct {
+		NamespaceName string `json:"namespace_name"`
+		Name          string `json:"name"`
+		Cluster       string `json:"cluster,omitempty"`
+	}) (*mcp.CallToolResult, any, error) {
+		result, err := t.ResourceToolset.ListResourceDeployedObjects(ctx, args.NamespaceName, args.Name, args.Cluster)
+		return handleToolResult(result, err)
+	})
+}
+
 // annotationsFromDisplayDesc builds an annotations map with display name and description entries when set.
 // Returns an empty map (not nil) so JSON serialization of the request body is consistent.
 func annotationsFromDisplayDesc(displayName, description string) map[string]string {
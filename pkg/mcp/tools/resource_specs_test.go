@@ -13,7 +13,7 @@ const testResourceName = "analytics-shared-db"
 // plus the scope-collapsed read tools over (Cluster)ResourceType. Mirrors the
 // component toolset's mix of primary-CRD CRUD + type reads.
 func resourceToolSpecs() []toolTestSpec {
-	specs := make([]toolTestSpec, 0, 8)
+	specs := make([]toolTestSpec, 0, 9)
 	specs = append(specs, resourceCRUDSpecs()...)
 	specs = append(specs, resourceResourceTypeSpecs()...)
 	return specs
@@ -28,7 +28,7 @@ func resourceCRUDSpecs() []toolTestSpec {
 			descriptionKeywords: []string{"list", "resource"},
 			descriptionMinLen:   10,
 			requiredParams:      []string{"namespace_name", "project_name"},
-			optionalParams:      []string{"limit", "cursor"},
+			optionalParams:      []string{"limit", "cursor", "fields"},
 			testArgs: map[string]any{
 				"namespace_name": testNamespaceName,
 				"project_name":   testProjectName,
@@ -47,9 +47,11 @@ func resourceCRUDSpecs() []toolTestSpec {
 			descriptionKeywords: []string{"resource"},
 			descriptionMinLen:   10,
 			requiredParams:      []string{"namespace_name", "name"},
+			optionalParams:      []string{"fields"},
 			testArgs: map[string]any{
 				"namespace_name": testNamespaceName,
 				"name":           testResourceName,
+				"fields":         "name,conditions",
 			},
 			expectedMethod: "GetResource",
 			validateCall: func(t *testing.T, args []interface{}) {
@@ -117,5 +119,25 @@ func resourceCRUDSpecs() []toolTestSpec {
 				}
 			},
 		},
+		{
+			name:                "list_resource_deployed_objects",
+			toolset:             "resource",
+			descriptionKeywords: []string{"resource", "data plane"},
+			descriptionMinLen:   10,
+			requiredParams:      []string{"namespace_name", "name"},
+			optionalParams:      []string{"cluster"},
+			testArgs: map[string]any{
+				"namespace_name": testNamespaceName,
+				"name":           testResourceName,
+				"cluster":        "dp-east",
+			},
+			expectedMethod: "ListResourceDeployedObjects",
+			validateCall: func(t *testing.T, args []interface{}) {
+				if args[0] != testNamespaceName || args[1] != testResourceName || args[2] != "dp-east" {
+					t.Errorf("Expected (%s, %s, dp-east), got (%v, %v, %v)",
+						testNamespaceName, testResourceName, args[0], args[1], args[2])
+				}
+			},
+		},
 	}
 }
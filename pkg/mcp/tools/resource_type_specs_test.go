@@ -39,7 +39,7 @@ func peResourceTypeSpecs() []toolTestSpec {
 			testArgs: map[string]any{
 				"namespace_name": testNamespaceName,
 				"name":           "my-resource-type",
-				"spec":           map[string]any{"resources": []any{}},
+				"spec":           map[string]any{"resources": []any{map[string]any{"id": "config", "template": map[string]any{}}}},
 			},
 			expectedMethod: "CreateResourceType",
 			validateCall: func(t *testing.T, args []interface{}) {
@@ -58,7 +58,7 @@ func peResourceTypeSpecs() []toolTestSpec {
 			testArgs: map[string]any{
 				"namespace_name": testNamespaceName,
 				"name":           "my-resource-type",
-				"spec":           map[string]any{"resources": []any{}},
+				"spec":           map[string]any{"resources": []any{map[string]any{"id": "config", "template": map[string]any{}}}},
 			},
 			expectedMethod: "UpdateResourceType",
 			validateCall: func(t *testing.T, args []interface{}) {
@@ -0,0 +1,139 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// resourceURIPrefix is the URI scheme prefix shared by all MCP resources served
+// by the OpenChoreo MCP server.
+const resourceURIPrefix = "openchoreo://"
+
+const (
+	mimeTypeJSON = "application/json"
+	mimeTypeYAML = "application/yaml"
+)
+
+//go:embed examples/*.yaml
+var exampleManifests embed.FS
+
+// schemaResource describes a CRD spec schema exposed as an MCP resource.
+type schemaResource struct {
+	// slug is the last URI segment, e.g. "component" for openchoreo://schemas/component.
+	slug string
+	kind string
+	// crdPath is the path of the CRD YAML inside the embedded config/crd FS.
+	crdPath string
+}
+
+// schemaResources lists the CRD spec schemas an AI client can read while
+// scaffolding a component.
+var schemaResources = []schemaResource{
+	{slug: "component", kind: "Component", crdPath: "bases/openchoreo.dev_components.yaml"},
+	{slug: "workload", kind: "Workload", crdPath: "bases/openchoreo.dev_workloads.yaml"},
+	{slug: "component-type", kind: "ComponentType", crdPath: "bases/openchoreo.dev_componenttypes.yaml"},
+	{
+		slug: "cluster-component-type", kind: "ClusterComponentType",
+		crdPath: "bases/openchoreo.dev_clustercomponenttypes.yaml",
+	},
+	{slug: "trait", kind: "Trait", crdPath: "bases/openchoreo.dev_traits.yaml"},
+	{slug: "cluster-trait", kind: "ClusterTrait", crdPath: "bases/openchoreo.dev_clustertraits.yaml"},
+	{slug: "workflow", kind: "Workflow", crdPath: "bases/openchoreo.dev_workflows.yaml"},
+	{slug: "cluster-workflow", kind: "ClusterWorkflow", crdPath: "bases/openchoreo.dev_clusterworkflows.yaml"},
+}
+
+// exampleResource describes an example manifest exposed as an MCP resource.
+type exampleResource struct {
+	slug        string
+	file        string
+	description string
+}
+
+var exampleResources = []exampleResource{
+	{
+		slug: "component-from-image",
+		file: "examples/component-from-image.yaml",
+		description: "Example Component and Workload that deploy a pre-built container image " +
+			"(from-image approach, no build workflow).",
+	},
+	{
+		slug: "component-from-source",
+		file: "examples/component-from-source.yaml",
+		description: "Example Component built from source by a ClusterWorkflow. The Workload is " +
+			"generated by the build from the repository's workload descriptor.",
+	},
+}
+
+// schemaResourceURI returns the MCP resource URI for a CRD spec schema.
+func schemaResourceURI(slug string) string {
+	return resourceURIPrefix + "schemas/" + slug
+}
+
+// exampleResourceURI returns the MCP resource URI for an example manifest.
+func exampleResourceURI(slug string) string {
+	return resourceURIPrefix + "examples/" + slug
+}
+
+// registerScaffoldingResources registers the read-only MCP resources that back
+// component scaffolding: the spec schemas of the component-family CRDs and a set
+// of example manifests. The content is served from embedded files, so these
+// resources require no cluster access and no authz action.
+func registerScaffoldingResources(s *mcp.Server) {
+	for _, r := range schemaResources {
+		s.AddResource(&mcp.Resource{
+			URI:         schemaResourceURI(r.slug),
+			Name:        r.slug + "-schema",
+			Title:       r.kind + " spec schema",
+			Description: fmt.Sprintf("OpenAPI v3 schema of the %s spec, extracted from the installed CRD.", r.kind),
+			MIMEType:    mimeTypeJSON,
+		}, schemaResourceHandler(r))
+	}
+	for _, r := range exampleResources {
+		s.AddResource(&mcp.Resource{
+			URI:         exampleResourceURI(r.slug),
+			Name:        r.slug + "-example",
+			Title:       r.slug + " example",
+			Description: r.description,
+			MIMEType:    mimeTypeYAML,
+		}, exampleResourceHandler(r))
+	}
+}
+
+func schemaResourceHandler(r schemaResource) mcp.ResourceHandler {
+	return func(_ context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		schema, err := extractSpecSchema(r.crdPath)
+		if err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal(schema)
+		if err != nil {
+			return nil, fmt.Errorf("marshal %s schema: %w", r.kind, err)
+		}
+		return &mcp.ReadResourceResult{
+			Contents: []*mcp.ResourceContents{
+				{URI: req.Params.URI, MIMEType: mimeTypeJSON, Text: string(data)},
+			},
+		}, nil
+	}
+}
+
+func exampleResourceHandler(r exampleResource) mcp.ResourceHandler {
+	return func(_ context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		data, err := exampleManifests.ReadFile(r.file)
+		if err != nil {
+			return nil, fmt.Errorf("reading embedded example %s: %w", r.file, err)
+		}
+		return &mcp.ReadResourceResult{
+			Contents: []*mcp.ResourceContents{
+				{URI: req.Params.URI, MIMEType: mimeTypeYAML, Text: string(data)},
+			},
+		}, nil
+	}
+}
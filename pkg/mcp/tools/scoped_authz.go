@@ -55,15 +55,19 @@ func (t *Toolsets) RegisterGetAuthzRole(s *mcp.Server, perms map[string]ToolPerm
 		})
 }
 
+// authzRoleSpecSchemas provides the authz role spec schemas, used both by the creation-schema
+// tool and to validate the spec argument of the create/update tools.
+var authzRoleSpecSchemas = scopedSchemaProviders{
+	namespace: func() (any, error) { return AuthzRoleCreationSchema() },
+	cluster:   func() (any, error) { return ClusterAuthzRoleCreationSchema() },
+}
+
 func (t *Toolsets) RegisterGetAuthzRoleCreationSchema(s *mcp.Server, perms map[string]ToolPermission) {
 	registerScopedSchemaTool(s, perms, "get_authz_role_creation_schema", scopedAuthzRoleNoun,
 		"Get the JSON schema for the AuthzRole spec. Use scope=\"cluster\" for the ClusterAuthzRole "+
 			"variant. Use list_authz_actions to discover valid values for spec.actions[].",
 		authzcore.ActionViewAuthzRole, authzcore.ActionViewClusterAuthzRole,
-		scopedSchemaProviders{
-			namespace: func() (any, error) { return AuthzRoleCreationSchema() },
-			cluster:   func() (any, error) { return ClusterAuthzRoleCreationSchema() },
-		})
+		authzRoleSpecSchemas)
 }
 
 //nolint:dupl // create/update register helpers share a near-identical shape per resource
@@ -77,6 +81,7 @@ func (t *Toolsets) RegisterCreateAuthzRole(s *mcp.Server, perms map[string]ToolP
 		"DNS-compatible identifier (lowercase, alphanumeric, hyphens only, max 63 chars)",
 		"AuthzRoleSpec — must include actions[] (min 1). Optional: description.",
 		scopedWriteHandlers{
+			schemas: authzRoleSpecSchemas,
 			namespace: func(ctx context.Context, ns, name string, anns map[string]string, specRaw map[string]any) (any, error) {
 				spec, err := buildSpec[gen.AuthzRoleSpec](specRaw)
 				if err != nil {
@@ -107,6 +112,7 @@ func (t *Toolsets) RegisterUpdateAuthzRole(s *mcp.Server, perms map[string]ToolP
 		"Name of the authz role to update. Use list_authz_roles to discover valid names",
 		"Full AuthzRoleSpec to replace the existing one. Use get_authz_role to retrieve the current spec first.",
 		scopedWriteHandlers{
+			schemas: authzRoleSpecSchemas,
 			namespace: func(ctx context.Context, ns, name string, anns map[string]string, specRaw map[string]any) (any, error) {
 				spec, err := buildSpec[gen.AuthzRoleSpec](specRaw)
 				if err != nil {
@@ -171,6 +177,13 @@ func (t *Toolsets) RegisterGetAuthzRoleBinding(s *mcp.Server, perms map[string]T
 		})
 }
 
+// authzRoleBindingSpecSchemas provides the authz role binding spec schemas, used both by the creation-schema
+// tool and to validate the spec argument of the create/update tools.
+var authzRoleBindingSpecSchemas = scopedSchemaProviders{
+	namespace: func() (any, error) { return AuthzRoleBindingCreationSchema() },
+	cluster:   func() (any, error) { return ClusterAuthzRoleBindingCreationSchema() },
+}
+
 func (t *Toolsets) RegisterGetAuthzRoleBindingCreationSchema(s *mcp.Server, perms map[string]ToolPermission) {
 	registerScopedSchemaTool(s, perms, "get_authz_role_binding_creation_schema", scopedAuthzRoleBindingNoun,
 		"Get the JSON schema for the role binding spec. The schema differs between scopes: cluster "+
@@ -180,10 +193,7 @@ func (t *Toolsets) RegisterGetAuthzRoleBindingCreationSchema(s *mcp.Server, perm
 			"sub-scopes under project and are mutually exclusive. Optional conditions[] applies "+
 			"CEL-based ABAC restrictions to specific actions.",
 		authzcore.ActionViewAuthzRoleBinding, authzcore.ActionViewClusterAuthzRoleBinding,
-		scopedSchemaProviders{
-			namespace: func() (any, error) { return AuthzRoleBindingCreationSchema() },
-			cluster:   func() (any, error) { return ClusterAuthzRoleBindingCreationSchema() },
-		})
+		authzRoleBindingSpecSchemas)
 }
 
 //nolint:dupl // create/update register helpers share a near-identical shape per resource
@@ -197,6 +207,7 @@ func (t *Toolsets) RegisterCreateAuthzRoleBinding(s *mcp.Server, perms map[strin
 		"DNS-compatible identifier (lowercase, alphanumeric, hyphens only, max 63 chars)",
 		"Binding spec — must include entitlement and roleMappings[]. Optional: effect (allow|deny, default allow).",
 		scopedWriteHandlers{
+			schemas: authzRoleBindingSpecSchemas,
 			namespace: func(ctx context.Context, ns, name string, anns map[string]string, specRaw map[string]any) (any, error) {
 				spec, err := buildSpec[gen.AuthzRoleBindingSpec](specRaw)
 				if err != nil {
@@ -227,6 +238,7 @@ func (t *Toolsets) RegisterUpdateAuthzRoleBinding(s *mcp.Server, perms map[strin
 		"Name of the role binding to update. Use list_authz_role_bindings to discover valid names",
 		"Full binding spec to replace the existing one. Use get_authz_role_binding to retrieve the current spec first.",
 		scopedWriteHandlers{
+			schemas: authzRoleBindingSpecSchemas,
 			namespace: func(ctx context.Context, ns, name string, anns map[string]string, specRaw map[string]any) (any, error) {
 				spec, err := buildSpec[gen.AuthzRoleBindingSpec](specRaw)
 				if err != nil {
@@ -51,6 +51,7 @@ func (t *Toolsets) RegisterPEGetComponentType(s *mcp.Server, perms map[string]To
 		scopedSingleResourceHandlers{
 			namespace: t.PEToolset.GetComponentType,
 			cluster:   t.PEToolset.GetClusterComponentType,
+			fields:    true,
 		})
 }
 
@@ -63,6 +64,7 @@ func (t *Toolsets) RegisterGetComponentType(s *mcp.Server, perms map[string]Tool
 		scopedSingleResourceHandlers{
 			namespace: t.ComponentToolset.GetComponentType,
 			cluster:   t.ComponentToolset.GetClusterComponentType,
+			fields:    true,
 		})
 }
 
@@ -90,16 +92,20 @@ func (t *Toolsets) RegisterGetComponentTypeSchema(s *mcp.Server, perms map[strin
 		})
 }
 
+// componentTypeSpecSchemas provides the component type spec schemas, used both by the creation-schema
+// tool and to validate the spec argument of the create/update tools.
+var componentTypeSpecSchemas = scopedSchemaProviders{
+	namespace: func() (any, error) { return ComponentTypeCreationSchema() },
+	cluster:   func() (any, error) { return ClusterComponentTypeCreationSchema() },
+}
+
 func (t *Toolsets) RegisterGetComponentTypeCreationSchema(s *mcp.Server, perms map[string]ToolPermission) {
 	registerScopedSchemaTool(s, perms, "get_component_type_creation_schema", "component type",
 		"Get the spec schema for creating a component type. Use scope=\"namespace\" (default) for a "+
 			"namespace-scoped ComponentType or scope=\"cluster\" for a platform-wide ClusterComponentType. "+
 			"Call this before create_component_type to understand the spec structure.",
 		authzcore.ActionCreateComponentType, authzcore.ActionCreateClusterComponentType,
-		scopedSchemaProviders{
-			namespace: func() (any, error) { return ComponentTypeCreationSchema() },
-			cluster:   func() (any, error) { return ClusterComponentTypeCreationSchema() },
-		})
+		componentTypeSpecSchemas)
 }
 
 //nolint:dupl // create/update register helpers share a near-identical shape per resource
@@ -112,6 +118,7 @@ func (t *Toolsets) RegisterCreateComponentType(s *mcp.Server, perms map[string]T
 		"DNS-compatible identifier (lowercase, alphanumeric, hyphens only, max 63 chars)",
 		"Use get_component_type_creation_schema (with the matching scope) to check the schema",
 		scopedWriteHandlers{
+			schemas: componentTypeSpecSchemas,
 			namespace: func(ctx context.Context, ns, name string, anns map[string]string, specRaw map[string]any) (any, error) {
 				spec, err := buildSpec[gen.ComponentTypeSpec](specRaw)
 				if err != nil {
@@ -142,6 +149,7 @@ func (t *Toolsets) RegisterUpdateComponentType(s *mcp.Server, perms map[string]T
 		"Name of the component type to update. Use list_component_types to discover valid names",
 		"Full component type spec to replace the existing one. Use get_component_type to retrieve the current spec first.",
 		scopedWriteHandlers{
+			schemas: componentTypeSpecSchemas,
 			namespace: func(ctx context.Context, ns, name string, anns map[string]string, specRaw map[string]any) (any, error) {
 				spec, err := buildSpec[gen.ComponentTypeSpec](specRaw)
 				if err != nil {
@@ -237,6 +245,7 @@ func (t *Toolsets) RegisterCreateClusterComponentType(s *mcp.Server, perms map[s
 		authzcore.ActionCreateClusterComponentType,
 		"DNS-compatible identifier (lowercase, alphanumeric, hyphens only, max 63 chars)",
 		"Use get_cluster_component_type_creation_schema to check the schema",
+		func() (any, error) { return ClusterComponentTypeCreationSchema() },
 		func(ctx context.Context, name string, anns map[string]string, specRaw map[string]any) (any, error) {
 			spec, err := buildSpec[gen.ClusterComponentTypeSpec](specRaw)
 			if err != nil {
@@ -256,6 +265,7 @@ func (t *Toolsets) RegisterUpdateClusterComponentType(s *mcp.Server, perms map[s
 		"Name of the cluster component type to update. Use list_cluster_component_types to discover valid names",
 		"Full cluster component type spec to replace the existing one. "+
 			"Use get_cluster_component_type to retrieve the current spec first.",
+		func() (any, error) { return ClusterComponentTypeCreationSchema() },
 		func(ctx context.Context, name string, anns map[string]string, specRaw map[string]any) (any, error) {
 			spec, err := buildSpec[gen.ClusterComponentTypeSpec](specRaw)
 			if err != nil {
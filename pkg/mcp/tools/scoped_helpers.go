@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
@@ -107,6 +108,9 @@ func registerScopedListTool(
 type scopedSingleResourceHandlers struct {
 	namespace func(ctx context.Context, namespaceName, resourceName string) (any, error)
 	cluster   func(ctx context.Context, resourceName string) (any, error)
+	// fields adds the optional fields argument, which reduces the result to
+	// the named fields.
+	fields bool
 }
 
 // registerScopedSingleResourceTool registers a scope-collapsed tool that takes a
@@ -120,15 +124,19 @@ func registerScopedSingleResourceTool(
 	name, resourceNoun, description, nsAction, clusterAction, nameParam, nameParamDesc string,
 	h scopedSingleResourceHandlers,
 ) {
+	properties := map[string]any{
+		"scope":          scopeProperty(resourceNoun),
+		"namespace_name": scopedNamespaceProperty(),
+		nameParam:        stringProperty(nameParamDesc),
+	}
+	if h.fields {
+		properties["fields"] = fieldsProperty()
+	}
 	perms[name] = ToolPermission{ToolName: name, ScopedActions: scopedActions(nsAction, clusterAction)}
 	mcp.AddTool(s, &mcp.Tool{
 		Name:        name,
 		Description: description,
-		InputSchema: createSchema(map[string]any{
-			"scope":          scopeProperty(resourceNoun),
-			"namespace_name": scopedNamespaceProperty(),
-			nameParam:        stringProperty(nameParamDesc),
-		}, []string{nameParam}),
+		InputSchema: createSchema(properties, []string{nameParam}),
 	}, func(ctx context.Context, req *mcp.CallToolRequest, raw json.RawMessage) (*mcp.CallToolResult, any, error) {
 		var args map[string]any
 		if err := unmarshalArgs(raw, &args); err != nil {
@@ -143,10 +151,16 @@ func registerScopedSingleResourceTool(
 			return handleToolResult(nil, err)
 		}
 		resourceName := stringArg(args, nameParam)
+		var result any
 		if scope == ScopeCluster {
-			return handleToolResult(h.cluster(ctx, resourceName))
+			result, err = h.cluster(ctx, resourceName)
+		} else {
+			result, err = h.namespace(ctx, namespaceName, resourceName)
 		}
-		return handleToolResult(h.namespace(ctx, namespaceName, resourceName))
+		if h.fields {
+			return handleFieldsToolResult(result, err, stringArg(args, "fields"), "")
+		}
+		return handleToolResult(result, err)
 	})
 }
 
@@ -192,8 +206,10 @@ func registerScopedSchemaTool(
 // scopedWriteHandlers carries the per-scope implementations of a scope-collapsed
 // create/update tool. The implementation receives the resource name, the assembled
 // metadata annotations, and the raw spec object; it is responsible for decoding the
-// spec into the scope-appropriate type and calling the service layer.
+// spec into the scope-appropriate type and calling the service layer. When schemas
+// is set, the spec is validated against the scope's schema before the handler runs.
 type scopedWriteHandlers struct {
+	schemas   scopedSchemaProviders
 	namespace func(
 		ctx context.Context, namespaceName, name string,
 		annotations map[string]string, specRaw map[string]any,
@@ -211,6 +227,8 @@ func registerScopedWriteTool(
 	h scopedWriteHandlers,
 ) {
 	perms[name] = ToolPermission{ToolName: name, ScopedActions: scopedActions(nsAction, clusterAction)}
+	nsValidator := newSpecValidator(resourceNoun, h.schemas.namespace)
+	clusterValidator := newSpecValidator("cluster "+resourceNoun, h.schemas.cluster)
 	mcp.AddTool(s, &mcp.Tool{
 		Name:        name,
 		Description: description,
@@ -246,8 +264,14 @@ func registerScopedWriteTool(
 		}
 		annotations := buildAnnotations(args.DisplayName, args.Description)
 		if scope == ScopeCluster {
+			if err := clusterValidator.validate(ctx, args.Spec); err != nil {
+				return handleToolResult(nil, err)
+			}
 			return handleToolResult(h.cluster(ctx, args.Name, annotations, args.Spec))
 		}
+		if err := nsValidator.validate(ctx, args.Spec); err != nil {
+			return handleToolResult(nil, err)
+		}
 		return handleToolResult(h.namespace(ctx, args.NamespaceName, args.Name, annotations, args.Spec))
 	})
 }
@@ -380,9 +404,11 @@ func registerDeprecatedClusterSchemaTool(
 func registerDeprecatedClusterWriteTool(
 	s *mcp.Server, perms map[string]ToolPermission,
 	name, canonicalName, descriptionBody, action, nameParamDesc, specDesc string,
+	schema func() (any, error),
 	apply func(ctx context.Context, name string, annotations map[string]string, specRaw map[string]any) (any, error),
 ) {
 	perms[name] = ToolPermission{ToolName: name, Action: action}
+	validator := newSpecValidator(deprecatedWriteToolNoun(name), schema)
 	mcp.AddTool(s, &mcp.Tool{
 		Name:        name,
 		Description: deprecatedDescription(canonicalName, descriptionBody),
@@ -402,8 +428,18 @@ func registerDeprecatedClusterWriteTool(
 		Description string                 `json:"description"`
 		Spec        map[string]interface{} `json:"spec"`
 	}) (*mcp.CallToolResult, any, error) {
+		if err := validator.validate(ctx, args.Spec); err != nil {
+			return handleDeprecatedToolResult(name, canonicalName, nil, err)
+		}
 		annotations := buildAnnotations(args.DisplayName, args.Description)
 		result, err := apply(ctx, args.Name, annotations, args.Spec)
 		return handleDeprecatedToolResult(name, canonicalName, result, err)
 	})
 }
+
+// deprecatedWriteToolNoun derives the resource noun of a deprecated write alias
+// from its name, e.g. "create_cluster_trait" becomes "cluster trait".
+func deprecatedWriteToolNoun(name string) string {
+	noun := strings.TrimPrefix(strings.TrimPrefix(name, "create_"), "update_")
+	return strings.ReplaceAll(noun, "_", " ")
+}
@@ -44,7 +44,8 @@ func (t *Toolsets) RegisterListWorkflowPlanes(s *mcp.Server, perms map[string]To
 		"List workflow planes. With scope=\"namespace\" (default) lists a namespace's workflow planes (requires "+
 			"namespace_name); with scope=\"cluster\" lists cluster-scoped workflow planes shared by platform admins. "+
 			"Workflow planes handle continuous integration and container image building. "+
-			"Supports pagination via limit and cursor.",
+			"Namespace-scoped planes report health and pending/running workflow counts; a health other than "+
+			"\"Healthy\" means new workflow runs may queue. Supports pagination via limit and cursor.",
 		authzcore.ActionViewWorkflowPlane, authzcore.ActionViewClusterWorkflowPlane,
 		scopedListHandlers{
 			namespace: t.PEToolset.ListWorkflowPlanes,
@@ -54,8 +55,9 @@ func (t *Toolsets) RegisterListWorkflowPlanes(s *mcp.Server, perms map[string]To
 
 func (t *Toolsets) RegisterGetWorkflowPlane(s *mcp.Server, perms map[string]ToolPermission) {
 	registerScopedSingleResourceTool(s, perms, "get_workflowplane", "workflow plane",
-		"Get detailed information about a workflow plane including cluster details, health status, and agent "+
-			"connection state. Use scope=\"cluster\" for a cluster-scoped workflow plane.",
+		"Get detailed information about a workflow plane including cluster details, health status, agent "+
+			"connection state, and capacity (pending and running workflows, node headroom). "+
+			"Use scope=\"cluster\" for a cluster-scoped workflow plane.",
 		authzcore.ActionViewWorkflowPlane, authzcore.ActionViewClusterWorkflowPlane,
 		"name", "Workflow plane name. Use list_workflowplanes to discover valid names",
 		scopedSingleResourceHandlers{
@@ -92,16 +92,20 @@ func (t *Toolsets) RegisterGetProjectTypeSchema(s *mcp.Server, perms map[string]
 		})
 }
 
+// projectTypeSpecSchemas provides the project type spec schemas, used both by the creation-schema
+// tool and to validate the spec argument of the create/update tools.
+var projectTypeSpecSchemas = scopedSchemaProviders{
+	namespace: func() (any, error) { return ProjectTypeCreationSchema() },
+	cluster:   func() (any, error) { return ClusterProjectTypeCreationSchema() },
+}
+
 func (t *Toolsets) RegisterGetProjectTypeCreationSchema(s *mcp.Server, perms map[string]ToolPermission) {
 	registerScopedSchemaTool(s, perms, "get_project_type_creation_schema", "project type",
 		"Get the spec schema for creating a project type. Use scope=\"namespace\" (default) for a "+
 			"namespace-scoped ProjectType or scope=\"cluster\" for a platform-wide ClusterProjectType. "+
 			"Call this before create_project_type to understand the spec structure.",
 		authzcore.ActionCreateProjectType, authzcore.ActionCreateClusterProjectType,
-		scopedSchemaProviders{
-			namespace: func() (any, error) { return ProjectTypeCreationSchema() },
-			cluster:   func() (any, error) { return ClusterProjectTypeCreationSchema() },
-		})
+		projectTypeSpecSchemas)
 }
 
 //nolint:dupl // create/update register helpers share a near-identical shape per resource
@@ -115,6 +119,7 @@ func (t *Toolsets) RegisterCreateProjectType(s *mcp.Server, perms map[string]Too
 		"DNS-compatible identifier (lowercase, alphanumeric, hyphens only, max 63 chars)",
 		"Use get_project_type_creation_schema (with the matching scope) to check the schema",
 		scopedWriteHandlers{
+			schemas: projectTypeSpecSchemas,
 			namespace: func(ctx context.Context, ns, name string, anns map[string]string, specRaw map[string]any) (any, error) {
 				spec, err := buildSpec[gen.ProjectTypeSpec](specRaw)
 				if err != nil {
@@ -145,6 +150,7 @@ func (t *Toolsets) RegisterUpdateProjectType(s *mcp.Server, perms map[string]Too
 		"Name of the project type to update. Use list_project_types to discover valid names",
 		"Full project type spec to replace the existing one. Use get_project_type to retrieve the current spec first.",
 		scopedWriteHandlers{
+			schemas: projectTypeSpecSchemas,
 			namespace: func(ctx context.Context, ns, name string, anns map[string]string, specRaw map[string]any) (any, error) {
 				spec, err := buildSpec[gen.ProjectTypeSpec](specRaw)
 				if err != nil {
@@ -92,16 +92,20 @@ func (t *Toolsets) RegisterGetResourceTypeSchema(s *mcp.Server, perms map[string
 		})
 }
 
+// resourceTypeSpecSchemas provides the resource type spec schemas, used both by the creation-schema
+// tool and to validate the spec argument of the create/update tools.
+var resourceTypeSpecSchemas = scopedSchemaProviders{
+	namespace: func() (any, error) { return ResourceTypeCreationSchema() },
+	cluster:   func() (any, error) { return ClusterResourceTypeCreationSchema() },
+}
+
 func (t *Toolsets) RegisterGetResourceTypeCreationSchema(s *mcp.Server, perms map[string]ToolPermission) {
 	registerScopedSchemaTool(s, perms, "get_resource_type_creation_schema", "resource type",
 		"Get the spec schema for creating a resource type. Use scope=\"namespace\" (default) for a "+
 			"namespace-scoped ResourceType or scope=\"cluster\" for a platform-wide ClusterResourceType. "+
 			"Call this before create_resource_type to understand the spec structure.",
 		authzcore.ActionCreateResourceType, authzcore.ActionCreateClusterResourceType,
-		scopedSchemaProviders{
-			namespace: func() (any, error) { return ResourceTypeCreationSchema() },
-			cluster:   func() (any, error) { return ClusterResourceTypeCreationSchema() },
-		})
+		resourceTypeSpecSchemas)
 }
 
 //nolint:dupl // create/update register helpers share a near-identical shape per resource
@@ -115,6 +119,7 @@ func (t *Toolsets) RegisterCreateResourceType(s *mcp.Server, perms map[string]To
 		"DNS-compatible identifier (lowercase, alphanumeric, hyphens only, max 63 chars)",
 		"Use get_resource_type_creation_schema (with the matching scope) to check the schema",
 		scopedWriteHandlers{
+			schemas: resourceTypeSpecSchemas,
 			namespace: func(ctx context.Context, ns, name string, anns map[string]string, specRaw map[string]any) (any, error) {
 				spec, err := buildSpec[gen.ResourceTypeSpec](specRaw)
 				if err != nil {
@@ -145,6 +150,7 @@ func (t *Toolsets) RegisterUpdateResourceType(s *mcp.Server, perms map[string]To
 		"Name of the resource type to update. Use list_resource_types to discover valid names",
 		"Full resource type spec to replace the existing one. Use get_resource_type to retrieve the current spec first.",
 		scopedWriteHandlers{
+			schemas: resourceTypeSpecSchemas,
 			namespace: func(ctx context.Context, ns, name string, anns map[string]string, specRaw map[string]any) (any, error) {
 				spec, err := buildSpec[gen.ResourceTypeSpec](specRaw)
 				if err != nil {
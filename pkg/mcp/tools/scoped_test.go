@@ -350,7 +350,7 @@ func TestScopedWriteToolDispatch(t *testing.T) {
 			"name":           "web-app",
 			"display_name":   "Web App",
 			"description":    "a web app component type",
-			"spec":           map[string]any{},
+			"spec":           minimalComponentTypeSpec(),
 		},
 	})
 	if err != nil {
@@ -374,7 +374,7 @@ func TestScopedWriteToolDispatch(t *testing.T) {
 		Arguments: map[string]any{
 			"scope": "cluster",
 			"name":  "platform-web-app",
-			"spec":  map[string]any{},
+			"spec":  minimalComponentTypeSpec(),
 		},
 	}); err != nil {
 		t.Fatalf("create_component_type (scope=cluster): %v", err)
@@ -391,7 +391,7 @@ func TestScopedWriteToolDispatch(t *testing.T) {
 		Arguments: map[string]any{
 			"scope": "cluster",
 			"name":  "platform-web-app",
-			"spec":  map[string]any{},
+			"spec":  minimalComponentTypeSpec(),
 		},
 	}); err != nil {
 		t.Fatalf("update_component_type (scope=cluster): %v", err)
@@ -409,18 +409,23 @@ func TestScopedWriteToolsRouteByScope(t *testing.T) {
 		nsMethod   string
 		clMethod   string
 		nsExtraArg map[string]any
+		spec       map[string]any
 	}{
-		{"create_trait", "CreateTrait", "CreateClusterTrait", map[string]any{"namespace_name": testNamespaceName}},
-		{"update_trait", "UpdateTrait", "UpdateClusterTrait", map[string]any{"namespace_name": testNamespaceName}},
-		{"create_workflow", "CreateWorkflow", "CreateClusterWorkflow", map[string]any{"namespace_name": testNamespaceName}},
-		{"update_workflow", "UpdateWorkflow", "UpdateClusterWorkflow", map[string]any{"namespace_name": testNamespaceName}},
+		{"create_trait", "CreateTrait", "CreateClusterTrait", map[string]any{"namespace_name": testNamespaceName},
+			map[string]any{}},
+		{"update_trait", "UpdateTrait", "UpdateClusterTrait", map[string]any{"namespace_name": testNamespaceName},
+			map[string]any{}},
+		{"create_workflow", "CreateWorkflow", "CreateClusterWorkflow", map[string]any{"namespace_name": testNamespaceName},
+			map[string]any{"runTemplate": map[string]any{}}},
+		{"update_workflow", "UpdateWorkflow", "UpdateClusterWorkflow", map[string]any{"namespace_name": testNamespaceName},
+			map[string]any{"runTemplate": map[string]any{}}},
 	}
 	for _, tc := range cases {
 		t.Run(tc.tool, func(t *testing.T) {
 			cs, mock := setupScopedTestServer(t, context.Background(), nil)
 			ctx := context.Background()
 
-			nsArgs := map[string]any{"name": "x", "spec": map[string]any{}}
+			nsArgs := map[string]any{"name": "x", "spec": tc.spec}
 			for k, v := range tc.nsExtraArg {
 				nsArgs[k] = v
 			}
@@ -435,7 +440,7 @@ func TestScopedWriteToolsRouteByScope(t *testing.T) {
 
 			if res, err := cs.CallTool(ctx, &mcp.CallToolParams{
 				Name:      tc.tool,
-				Arguments: map[string]any{"scope": "cluster", "name": "x", "spec": map[string]any{}},
+				Arguments: map[string]any{"scope": "cluster", "name": "x", "spec": tc.spec},
 			}); err != nil {
 				t.Fatalf("%s (scope=cluster): %v", tc.tool, err)
 			} else if res.IsError {
@@ -492,6 +497,42 @@ func TestScopedWriteToolInvalidSpec(t *testing.T) {
 	}
 }
 
+func TestScopedWriteToolSchemaValidation(t *testing.T) {
+	cs, mock := setupScopedTestServer(t, context.Background(), nil)
+	res, err := cs.CallTool(context.Background(), &mcp.CallToolParams{
+		Name: "create_component_type",
+		Arguments: map[string]any{
+			"scope": "cluster",
+			"name":  "web-app",
+			"spec":  map[string]any{"workloadType": "daemonset", "resources": []any{}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CallTool returned protocol error: %v", err)
+	}
+	if !res.IsError {
+		t.Fatal("expected a tool error when the spec does not match the CRD schema")
+	}
+	text := firstText(res)
+	for _, want := range []string{"spec.workloadType", "spec.resources"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("error text = %q, want it to name %s", text, want)
+		}
+	}
+	if len(mock.calls["CreateClusterComponentType"]) != 0 {
+		t.Errorf("service handler must not run when schema validation fails")
+	}
+}
+
+// minimalComponentTypeSpec returns the smallest spec accepted by the ComponentType
+// and ClusterComponentType CRD schemas.
+func minimalComponentTypeSpec() map[string]any {
+	return map[string]any{
+		"workloadType": "deployment",
+		"resources":    []any{map[string]any{"id": "deployment", "template": map[string]any{}}},
+	}
+}
+
 func TestScopedSchemaToolDispatch(t *testing.T) {
 	cs, _ := setupScopedTestServer(t, context.Background(), nil)
 	ctx := context.Background()
@@ -88,16 +88,20 @@ func (t *Toolsets) RegisterGetTraitSchema(s *mcp.Server, perms map[string]ToolPe
 		})
 }
 
+// traitSpecSchemas provides the trait spec schemas, used both by the creation-schema
+// tool and to validate the spec argument of the create/update tools.
+var traitSpecSchemas = scopedSchemaProviders{
+	namespace: func() (any, error) { return TraitCreationSchema() },
+	cluster:   func() (any, error) { return ClusterTraitCreationSchema() },
+}
+
 func (t *Toolsets) RegisterGetTraitCreationSchema(s *mcp.Server, perms map[string]ToolPermission) {
 	registerScopedSchemaTool(s, perms, "get_trait_creation_schema", "trait",
 		"Get the spec schema for creating a trait. Use scope=\"namespace\" (default) for a namespace-scoped "+
 			"Trait or scope=\"cluster\" for a platform-wide ClusterTrait. Call this before create_trait to "+
 			"understand the spec structure.",
 		authzcore.ActionCreateTrait, authzcore.ActionCreateClusterTrait,
-		scopedSchemaProviders{
-			namespace: func() (any, error) { return TraitCreationSchema() },
-			cluster:   func() (any, error) { return ClusterTraitCreationSchema() },
-		})
+		traitSpecSchemas)
 }
 
 //nolint:dupl // create/update register helpers share a near-identical shape per resource
@@ -111,6 +115,7 @@ func (t *Toolsets) RegisterCreateTrait(s *mcp.Server, perms map[string]ToolPermi
 		"DNS-compatible identifier (lowercase, alphanumeric, hyphens only, max 63 chars)",
 		"Use get_trait_creation_schema (with the matching scope) to check the schema",
 		scopedWriteHandlers{
+			schemas: traitSpecSchemas,
 			namespace: func(ctx context.Context, ns, name string, anns map[string]string, specRaw map[string]any) (any, error) {
 				spec, err := buildSpec[gen.TraitSpec](specRaw)
 				if err != nil {
@@ -141,6 +146,7 @@ func (t *Toolsets) RegisterUpdateTrait(s *mcp.Server, perms map[string]ToolPermi
 		"Name of the trait to update. Use list_traits to discover valid names",
 		"Full trait spec to replace the existing one. Use get_trait to retrieve the current spec first.",
 		scopedWriteHandlers{
+			schemas: traitSpecSchemas,
 			namespace: func(ctx context.Context, ns, name string, anns map[string]string, specRaw map[string]any) (any, error) {
 				spec, err := buildSpec[gen.TraitSpec](specRaw)
 				if err != nil {
@@ -237,6 +243,7 @@ func (t *Toolsets) RegisterCreateClusterTrait(s *mcp.Server, perms map[string]To
 		"DNS-compatible identifier (lowercase, alphanumeric, hyphens only, max 63 chars)",
 		"Cluster trait specification defining what resources the trait creates or patches. "+
 			"Use get_cluster_trait_schema on an existing trait to see the full structure.",
+		func() (any, error) { return ClusterTraitCreationSchema() },
 		func(ctx context.Context, name string, anns map[string]string, specRaw map[string]any) (any, error) {
 			spec, err := buildSpec[gen.ClusterTraitSpec](specRaw)
 			if err != nil {
@@ -256,6 +263,7 @@ func (t *Toolsets) RegisterUpdateClusterTrait(s *mcp.Server, perms map[string]To
 		authzcore.ActionUpdateClusterTrait,
 		"Name of the cluster trait to update. Use list_cluster_traits to discover valid names",
 		"Full cluster trait spec to replace the existing one. Use get_cluster_trait to retrieve the current spec first.",
+		func() (any, error) { return ClusterTraitCreationSchema() },
 		func(ctx context.Context, name string, anns map[string]string, specRaw map[string]any) (any, error) {
 			spec, err := buildSpec[gen.ClusterTraitSpec](specRaw)
 			if err != nil {
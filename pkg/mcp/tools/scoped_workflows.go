@@ -92,16 +92,20 @@ func (t *Toolsets) RegisterGetWorkflowSchema(s *mcp.Server, perms map[string]Too
 		})
 }
 
+// workflowSpecSchemas provides the workflow spec schemas, used both by the creation-schema
+// tool and to validate the spec argument of the create/update tools.
+var workflowSpecSchemas = scopedSchemaProviders{
+	namespace: func() (any, error) { return WorkflowCreationSchema() },
+	cluster:   func() (any, error) { return ClusterWorkflowCreationSchema() },
+}
+
 func (t *Toolsets) RegisterGetWorkflowCreationSchema(s *mcp.Server, perms map[string]ToolPermission) {
 	registerScopedSchemaTool(s, perms, "get_workflow_creation_schema", "workflow",
 		"Get the spec schema for creating a workflow (runTemplate, parameters definition, repository defaults, "+
 			"etc.). Use scope=\"namespace\" (default) for a namespace-scoped Workflow or scope=\"cluster\" for a "+
 			"platform-wide ClusterWorkflow. Call this before create_workflow to understand the spec structure.",
 		authzcore.ActionCreateWorkflow, authzcore.ActionCreateClusterWorkflow,
-		scopedSchemaProviders{
-			namespace: func() (any, error) { return WorkflowCreationSchema() },
-			cluster:   func() (any, error) { return ClusterWorkflowCreationSchema() },
-		})
+		workflowSpecSchemas)
 }
 
 //nolint:dupl // create/update register helpers share a near-identical shape per resource
@@ -115,6 +119,7 @@ func (t *Toolsets) RegisterPECreateWorkflow(s *mcp.Server, perms map[string]Tool
 		"Workflow specification. Required field: runTemplate (Argo Workflow template definition). "+
 			"Use get_workflow_creation_schema (with the matching scope) to check the schema.",
 		scopedWriteHandlers{
+			schemas: workflowSpecSchemas,
 			namespace: func(ctx context.Context, ns, name string, anns map[string]string, specRaw map[string]any) (any, error) {
 				spec, err := buildSpec[gen.WorkflowSpec](specRaw)
 				if err != nil {
@@ -145,6 +150,7 @@ func (t *Toolsets) RegisterPEUpdateWorkflow(s *mcp.Server, perms map[string]Tool
 		"Name of the workflow to update. Use list_workflows to discover valid names",
 		"Full workflow spec to replace the existing one. Use get_workflow to retrieve the current spec first.",
 		scopedWriteHandlers{
+			schemas: workflowSpecSchemas,
 			namespace: func(ctx context.Context, ns, name string, anns map[string]string, specRaw map[string]any) (any, error) {
 				spec, err := buildSpec[gen.WorkflowSpec](specRaw)
 				if err != nil {
@@ -241,6 +247,7 @@ func (t *Toolsets) RegisterCreateClusterWorkflow(s *mcp.Server, perms map[string
 		"DNS-compatible identifier (lowercase, alphanumeric, hyphens only, max 63 chars)",
 		"Cluster workflow specification. Required field: runTemplate (Argo Workflow template definition). "+
 			"Use get_cluster_workflow_schema on an existing workflow to see the full structure.",
+		func() (any, error) { return ClusterWorkflowCreationSchema() },
 		func(ctx context.Context, name string, anns map[string]string, specRaw map[string]any) (any, error) {
 			spec, err := buildSpec[gen.ClusterWorkflowSpec](specRaw)
 			if err != nil {
@@ -261,6 +268,7 @@ func (t *Toolsets) RegisterUpdateClusterWorkflow(s *mcp.Server, perms map[string
 		"Name of the cluster workflow to update. Use list_cluster_workflows to discover valid names",
 		"Full cluster workflow spec to replace the existing one. "+
 			"Use get_cluster_workflow to retrieve the current spec first.",
+		func() (any, error) { return ClusterWorkflowCreationSchema() },
 		func(ctx context.Context, name string, anns map[string]string, specRaw map[string]any) (any, error) {
 			spec, err := buildSpec[gen.ClusterWorkflowSpec](specRaw)
 			if err != nil {
@@ -0,0 +1,104 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/openchoreo/openchoreo/internal/tracing"
+)
+
+// specValidator validates the spec argument of a create/update tool against the
+// spec schema of the target CRD before the request reaches the API server, so
+// agents get every offending field path in one round-trip instead of retrying
+// blindly on the first error the server reports.
+//
+// The validator is compiled lazily on first use. If the schema cannot be loaded
+// or compiled, validation is skipped and the API server remains the authority.
+type specValidator struct {
+	kind     string
+	provider func() (any, error)
+
+	once      sync.Once
+	validator validation.SchemaValidator
+}
+
+// newSpecValidator returns a validator for the schema returned by provider, or nil
+// when provider is nil. kind names the resource in error messages.
+func newSpecValidator(kind string, provider func() (any, error)) *specValidator {
+	if provider == nil {
+		return nil
+	}
+	return &specValidator{kind: kind, provider: provider}
+}
+
+// validate checks spec against the schema. It returns a ValidationError ToolError
+// listing each offending field under the "spec" argument, or nil when spec is valid.
+func (v *specValidator) validate(ctx context.Context, spec map[string]any) error {
+	if v == nil {
+		return nil
+	}
+	ctx, span := tracing.Start(ctx, "mcp.validate_spec", attribute.String("openchoreo.kind", v.kind))
+	defer span.End()
+
+	v.once.Do(func() { v.compile(ctx) })
+	if v.validator == nil {
+		return nil
+	}
+
+	errs := validation.ValidateCustomResource(field.NewPath("spec"), spec, v.validator)
+	if len(errs) == 0 {
+		return nil
+	}
+	span.SetAttributes(attribute.Int("openchoreo.validation.errors", len(errs)))
+	fields := make([]map[string]any, 0, len(errs))
+	msgs := make([]string, 0, len(errs))
+	for _, e := range errs {
+		fields = append(fields, map[string]any{
+			"field":   e.Field,
+			"reason":  string(e.Type),
+			"message": e.ErrorBody(),
+		})
+		msgs = append(msgs, e.Error())
+	}
+	return NewToolError(ErrorCodeValidationError,
+		fmt.Errorf("spec is not a valid %s spec: %s", v.kind, strings.Join(msgs, "; ")),
+		map[string]any{"fields": fields})
+}
+
+func (v *specValidator) compile(ctx context.Context) {
+	_, span := tracing.Start(ctx, "mcp.schema_lookup", attribute.String("openchoreo.kind", v.kind))
+	raw, err := v.provider()
+	tracing.End(span, err)
+	if err != nil {
+		return
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return
+	}
+	var props extv1.JSONSchemaProps
+	if err := json.Unmarshal(data, &props); err != nil {
+		return
+	}
+	internal := new(apiext.JSONSchemaProps)
+	if err := extv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(&props, internal, nil); err != nil {
+		return
+	}
+	validator, _, err := validation.NewSchemaValidator(internal)
+	if err != nil {
+		return
+	}
+	v.validator = validator
+}
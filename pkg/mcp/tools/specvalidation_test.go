@@ -0,0 +1,55 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestSpecValidatorFieldDetails(t *testing.T) {
+	v := newSpecValidator("component type", func() (any, error) { return ComponentTypeCreationSchema() })
+
+	err := v.validate(context.Background(), map[string]any{"workloadType": "daemonset"})
+	te, ok := errors.AsType[*ToolError](err)
+	if !ok {
+		t.Fatalf("validate() = %v, want a *ToolError", err)
+	}
+	if te.Code != ErrorCodeValidationError {
+		t.Errorf("Code = %q, want %q", te.Code, ErrorCodeValidationError)
+	}
+
+	got := map[string]string{}
+	fields, _ := te.Details["fields"].([]map[string]any)
+	for _, f := range fields {
+		got[f["field"].(string)] = f["reason"].(string)
+	}
+	want := map[string]string{
+		"spec.workloadType": "FieldValueNotSupported",
+		"spec.resources":    "FieldValueRequired",
+	}
+	for field, reason := range want {
+		if got[field] != reason {
+			t.Errorf("field %s reason = %q, want %q (all fields: %v)", field, got[field], reason, got)
+		}
+	}
+}
+
+func TestSpecValidatorAcceptsValidSpec(t *testing.T) {
+	v := newSpecValidator("component type", func() (any, error) { return ComponentTypeCreationSchema() })
+	if err := v.validate(context.Background(), minimalComponentTypeSpec()); err != nil {
+		t.Errorf("validate() = %v, want nil", err)
+	}
+}
+
+func TestSpecValidatorSkipsUnavailableSchema(t *testing.T) {
+	if err := newSpecValidator("trait", nil).validate(context.Background(), map[string]any{"bogus": 1}); err != nil {
+		t.Errorf("nil validator: validate() = %v, want nil", err)
+	}
+	v := newSpecValidator("trait", func() (any, error) { return nil, errors.New("schema unavailable") })
+	if err := v.validate(context.Background(), map[string]any{"bogus": 1}); err != nil {
+		t.Errorf("validate() with a failing schema provider = %v, want nil", err)
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/openchoreo/openchoreo/internal/tracing"
+)
+
+// NewTracingMiddleware returns an MCP receiving middleware that wraps each
+// tools/call in a span named after the tool. Service and Kubernetes client
+// spans created while the tool runs become its children. Failed tool results
+// mark the span as errored.
+func NewTracingMiddleware() mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if method != methodCallTool {
+				return next(ctx, method, req)
+			}
+			name := callToolName(req)
+			ctx, span := tracing.Start(ctx, "mcp.tool "+name, attribute.String("mcp.tool.name", name))
+			result, err := next(ctx, method, req)
+			spanErr := err
+			if res, ok := result.(*mcp.CallToolResult); ok && spanErr == nil && res.IsError {
+				if spanErr = res.GetError(); spanErr == nil {
+					spanErr = errors.New("tool call failed")
+				}
+			}
+			tracing.End(span, spanErr)
+			return result, err
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracingMiddleware(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	ctx := context.Background()
+	server := mcp.NewServer(&mcp.Implementation{Name: "tracing-test"}, nil)
+	mcp.AddTool(server, &mcp.Tool{Name: "ok", InputSchema: createSchema(map[string]any{}, nil)},
+		func(context.Context, *mcp.CallToolRequest, struct{}) (*mcp.CallToolResult, any, error) {
+			return handleToolResult(map[string]any{"ok": true}, nil)
+		})
+	mcp.AddTool(server, &mcp.Tool{Name: "fail", InputSchema: createSchema(map[string]any{}, nil)},
+		func(context.Context, *mcp.CallToolRequest, struct{}) (*mcp.CallToolResult, any, error) {
+			return handleToolResult(nil, errors.New("component not found"))
+		})
+	server.AddReceivingMiddleware(NewTracingMiddleware())
+
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	if _, err := server.Connect(ctx, serverTransport, nil); err != nil {
+		t.Fatalf("server connect: %v", err)
+	}
+	cs, err := mcp.NewClient(&mcp.Implementation{Name: "tracing-test-client"}, nil).Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("client connect: %v", err)
+	}
+	defer cs.Close()
+
+	for _, name := range []string{"ok", "fail"} {
+		if _, err := cs.CallTool(ctx, &mcp.CallToolParams{Name: name}); err != nil {
+			t.Fatalf("CallTool(%s) returned protocol error: %v", name, err)
+		}
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("ended spans = %d, want one per tool call", len(spans))
+	}
+	if spans[0].Name() != "mcp.tool ok" || spans[0].Status().Code == codes.Error {
+		t.Errorf("span = %q %+v, want successful mcp.tool ok", spans[0].Name(), spans[0].Status())
+	}
+	if spans[1].Name() != "mcp.tool fail" || spans[1].Status().Code != codes.Error {
+		t.Errorf("span = %q %+v, want errored mcp.tool fail", spans[1].Name(), spans[1].Status())
+	}
+}
@@ -5,10 +5,12 @@ package tools
 
 import (
 	"context"
+	"strings"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
 	"github.com/openchoreo/openchoreo/internal/openchoreo-api/api/gen"
+	"github.com/openchoreo/openchoreo/internal/server/middleware/idempotency"
 )
 
 // ToolsetType represents a type of toolset that can be enabled
@@ -22,6 +24,7 @@ const (
 	ToolsetBuild      ToolsetType = "build"
 	ToolsetPE         ToolsetType = "pe"
 	ToolsetResource   ToolsetType = "resource"
+	ToolsetBatch      ToolsetType = "batch"
 )
 
 // requestedToolsetsCtxKey is the context key used to carry the set of toolsets
@@ -112,6 +115,84 @@ func (o ListOpts) EffectiveLimit() int {
 	return o.Limit
 }
 
+// ScaleOpts holds the replica settings applied by a scale operation. Nil fields
+// are left unchanged on the target release binding.
+type ScaleOpts struct {
+	// Replicas is the fixed replica count.
+	Replicas *int32
+	// MinReplicas is the lower autoscaling bound.
+	MinReplicas *int32
+	// MaxReplicas is the upper autoscaling bound.
+	MaxReplicas *int32
+}
+
+// EndpointOpts holds the endpoint settings applied by an endpoint update. Nil
+// fields are left unchanged on the component's workload.
+type EndpointOpts struct {
+	// Visibility lists the visibilities of the endpoint (project, namespace, internal, external).
+	Visibility *[]string
+	// BasePath is the base path of the API exposed via the endpoint.
+	BasePath *string
+}
+
+// EnvVarOpts describes an environment variable override. Exactly one of Value or
+// the SecretName/SecretKey pair is expected to be set. When Secret is true, Value is
+// written to the environment's external secret store and referenced instead of
+// being stored inline.
+type EnvVarOpts struct {
+	Key        string
+	Value      string
+	SecretName string
+	SecretKey  string
+	Secret     bool
+}
+
+// ScaffoldComponentOpts describes a component to scaffold from a component
+// class. Exactly one of RepositoryURL or Image is expected to be set.
+type ScaffoldComponentOpts struct {
+	Name        string
+	DisplayName string
+	Description string
+	// Type is Service, WebApplication or ScheduledTask.
+	Type string
+	// ClassName and ClassKind identify the (Cluster)ComponentType.
+	ClassName  string
+	ClassKind  string
+	Parameters map[string]any
+	AutoDeploy bool
+	// RepositoryURL, Branch and AppPath configure a build from source with
+	// Workflow, which defaults to the first workflow the class allows.
+	RepositoryURL      string
+	Branch             string
+	AppPath            string
+	WorkflowName       string
+	WorkflowKind       string
+	WorkflowParameters map[string]any
+	// Image and Port configure a prebuilt image.
+	Image string
+	Port  int32
+}
+
+// ImportComponentOpts describes a Git repository to import as a component from
+// its component descriptor. Name, Type, ClassName and Image override the
+// values of the descriptor.
+type ImportComponentOpts struct {
+	RepositoryURL string
+	// Ref is the branch, tag or commit to read the descriptor at.
+	Ref     string
+	AppPath string
+	// DescriptorPath is the descriptor location relative to AppPath.
+	DescriptorPath string
+	Name           string
+	Type           string
+	ClassName      string
+	ClassKind      string
+	Image          string
+	AutoDeploy     bool
+	// DryRun returns the generated resources without creating them.
+	DryRun bool
+}
+
 type Toolsets struct {
 	NamespaceToolset  NamespaceToolsetHandler
 	ProjectToolset    ProjectToolsetHandler
@@ -120,6 +201,22 @@ type Toolsets struct {
 	BuildToolset      BuildToolsetHandler
 	PEToolset         PEToolsetHandler
 	ResourceToolset   ResourceToolsetHandler
+
+	// Batch registers the batch tool, which runs several calls to the other
+	// registered tools in a single request.
+	Batch bool
+
+	// ErrorClassifier maps handler errors to ToolError codes before the built-in
+	// Kubernetes and context classification runs. Optional.
+	ErrorClassifier ErrorClassifier
+
+	// RateLimit bounds tool calls per session on the HTTP server. Optional.
+	RateLimit RateLimitConfig
+
+	// Idempotency stores the results of mutating tool calls made with an
+	// idempotency_key argument on the HTTP server, so that retries do not run
+	// them again. Nil disables idempotency keys.
+	Idempotency *idempotency.Store
 }
 
 // PEToolsetHandler handles platform engineering operations on openchoreo
@@ -368,6 +465,7 @@ type NamespaceToolsetHandler interface {
 type ProjectToolsetHandler interface {
 	// Project operations
 	ListProjects(ctx context.Context, namespaceName string, opts ListOpts) (any, error)
+	GetProject(ctx context.Context, namespaceName, projectName string) (any, error)
 	CreateProject(ctx context.Context, namespaceName string, req *gen.CreateProjectJSONRequestBody) (any, error)
 	UpdateProject(ctx context.Context, namespaceName, projectName string, req *gen.PatchProjectRequest) (any, error)
 	DeleteProject(ctx context.Context, namespaceName, projectName string) (any, error)
@@ -388,6 +486,8 @@ type ComponentToolsetHandler interface {
 	CreateComponent(
 		ctx context.Context, namespaceName, projectName string, req *gen.CreateComponentRequest,
 	) (any, error)
+	ScaffoldComponent(ctx context.Context, namespaceName, projectName string, opts ScaffoldComponentOpts) (any, error)
+	ImportComponent(ctx context.Context, namespaceName, projectName string, opts ImportComponentOpts) (any, error)
 	ListComponents(ctx context.Context, namespaceName, projectName string, opts ListOpts) (any, error)
 	GetComponent(ctx context.Context, namespaceName, componentName string) (any, error)
 	PatchComponent(
@@ -405,6 +505,7 @@ type ComponentToolsetHandler interface {
 	DeleteWorkload(ctx context.Context, namespaceName, workloadName string) (any, error)
 	GetWorkloadSchema(ctx context.Context) (any, error)
 	GetComponentSchema(ctx context.Context, namespaceName, componentName string) (any, error)
+	GetComponentDependencyGraph(ctx context.Context, namespaceName, projectName, componentName string) (any, error)
 
 	// Platform standards (read-only, namespace-scoped)
 	ListComponentTypes(ctx context.Context, namespaceName string, opts ListOpts) (any, error)
@@ -442,10 +543,40 @@ type DeploymentToolsetHandler interface {
 		req *gen.ReleaseBindingSpec,
 	) (any, error)
 	DeleteReleaseBinding(ctx context.Context, namespaceName, bindingName string) (any, error)
+	PromoteComponent(
+		ctx context.Context, namespaceName, componentName, sourceEnv, targetEnv, comment string,
+	) (any, error)
+	ListPromotionRequests(ctx context.Context, namespaceName, componentName string, opts ListOpts) (any, error)
+	GetPromotionRequest(ctx context.Context, namespaceName, promotionRequestName string) (any, error)
+	ApprovePromotionRequest(ctx context.Context, namespaceName, promotionRequestName, comment string) (any, error)
+	RejectPromotionRequest(ctx context.Context, namespaceName, promotionRequestName, comment string) (any, error)
+	DeployComponent(
+		ctx context.Context, namespaceName, componentName, environment, image, buildName string,
+	) (any, error)
+	GetDeploymentStatus(ctx context.Context, namespaceName, componentName, environment string) (any, error)
+	GetReleaseHistory(ctx context.Context, namespaceName, componentName, environment string) (any, error)
+	RollbackComponent(ctx context.Context, namespaceName, componentName, environment, release string) (any, error)
+	GetComponentEndpoints(ctx context.Context, namespaceName, componentName, environment string) (any, error)
+	ListEndpoints(ctx context.Context, namespaceName, projectName, componentName, environment string) (any, error)
+	UpdateEndpoint(
+		ctx context.Context, namespaceName, componentName, endpointName string, opts EndpointOpts,
+	) (any, error)
+	ScaleComponent(
+		ctx context.Context, namespaceName, componentName, environment string, opts ScaleOpts,
+	) (any, error)
+	RestartComponent(ctx context.Context, namespaceName, componentName, environment string) (any, error)
+	GetComponentConfiguration(ctx context.Context, namespaceName, componentName, environment string) (any, error)
+	SetComponentEnvVar(
+		ctx context.Context, namespaceName, componentName, environment string, envVar EnvVarOpts,
+	) (any, error)
+	UnsetComponentEnvVar(ctx context.Context, namespaceName, componentName, environment, key string) (any, error)
+	ListComponentSecrets(ctx context.Context, namespaceName, componentName, environment string) (any, error)
+	SetSecretValue(ctx context.Context, namespaceName, secretName, key, value string) (any, error)
 	DeleteComponentRelease(ctx context.Context, namespaceName, componentReleaseName string) (any, error)
 	ListDeploymentPipelines(ctx context.Context, namespaceName string, opts ListOpts) (any, error)
 	GetDeploymentPipeline(ctx context.Context, namespaceName, pipelineName string) (any, error)
 	ListEnvironments(ctx context.Context, namespaceName string, opts ListOpts) (any, error)
+	GetEnvironmentOverview(ctx context.Context, namespaceName, projectName string) (any, error)
 
 	// Resource release delete (dev-side cleanup; mirrors DeleteComponentRelease).
 	DeleteResourceRelease(ctx context.Context, namespaceName, resourceReleaseName string) (any, error)
@@ -489,6 +620,9 @@ type BuildToolsetHandler interface {
 	TriggerWorkflowRun(
 		ctx context.Context, namespaceName, projectName, componentName, commit string,
 	) (any, error)
+	TriggerBuild(
+		ctx context.Context, namespaceName, projectName, componentName, commit, branch string,
+	) (any, error)
 	CreateWorkflowRun(
 		ctx context.Context, namespaceName, workflowName string,
 		parameters map[string]any,
@@ -499,6 +633,9 @@ type BuildToolsetHandler interface {
 	) (any, error)
 	GetWorkflowRun(ctx context.Context, namespaceName, runName string) (any, error)
 	GetWorkflowRunStatus(ctx context.Context, namespaceName, runName string) (any, error)
+	GetBuildSBOM(
+		ctx context.Context, namespaceName, buildID, componentName, environmentName string,
+	) (any, error)
 	GetWorkflowRunLogs(
 		ctx context.Context, namespaceName, runName, taskName string, sinceSeconds *int64,
 	) (any, error)
@@ -527,6 +664,7 @@ type ResourceToolsetHandler interface {
 		ctx context.Context, namespaceName string, req *gen.UpdateResourceJSONRequestBody,
 	) (any, error)
 	DeleteResource(ctx context.Context, namespaceName, resourceName string) (any, error)
+	ListResourceDeployedObjects(ctx context.Context, namespaceName, resourceName, cluster string) (any, error)
 
 	// Resource types (read-only, namespace-scoped)
 	ListResourceTypes(ctx context.Context, namespaceName string, opts ListOpts) (any, error)
@@ -579,6 +717,17 @@ func (p ToolPermission) Actions() []string {
 	return []string{p.Action}
 }
 
+// Mutating reports whether the tool may change state, i.e. whether any of its
+// authz actions is something other than a view action.
+func (p ToolPermission) Mutating() bool {
+	for _, a := range p.Actions() {
+		if !strings.HasSuffix(a, ":view") {
+			return true
+		}
+	}
+	return false
+}
+
 // ActionForScope returns the authz action required for the given scope value. For
 // a plain tool it returns Action regardless of scope. For a scope-collapsed tool
 // an empty scope is treated as ScopeNamespace.
@@ -173,7 +173,7 @@ metadata:
 		}, 30*time.Second, 2*time.Second).Should(Succeed())
 
 		By("verifying project is returned by API GET")
-		getResp, err := client.GetProjectWithResponse(ctx, nsName, projectName)
+		getResp, err := client.GetProjectWithResponse(ctx, nsName, projectName, nil)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(getResp.StatusCode()).To(Equal(http.StatusOK))
 		Expect(getResp.JSON200).NotTo(BeNil())
@@ -286,7 +286,7 @@ metadata:
 		}, 5*time.Minute, framework.DefaultPolling).Should(Succeed())
 
 		By("getting component via API")
-		getResp, err := client.GetComponentWithResponse(ctx, nsName, compName)
+		getResp, err := client.GetComponentWithResponse(ctx, nsName, compName, nil)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(getResp.StatusCode()).To(Equal(http.StatusOK))
 		Expect(getResp.JSON200).NotTo(BeNil())
@@ -43,6 +43,20 @@ var ciWorkflowContracts = []ciWorkflowContract{
 		metadataName:  "paketo-buildpacks-builder",
 		buildTemplate: "paketo-buildpacks-build",
 	},
+	{
+		file:          "ko-builder.yaml",
+		metadataName:  "ko-builder",
+		buildTemplate: "ko-build",
+		buildEnvArg:   true,
+	},
+	{
+		file:          "buildkit-builder.yaml",
+		metadataName:  "buildkit-builder",
+		buildTemplate: "buildkit-build",
+		buildEnvArg:   true,
+		buildArgsArg:  true,
+		dockerParams:  true,
+	},
 }
 
 func TestCIWorkflows_ParseAndShape(t *testing.T) {
@@ -22,6 +22,8 @@ var allTemplates = map[string]templateContract{
 	"ballerina-buildpack-build.yaml": {"ballerina-buildpack-build", "build-image"},
 	"gcp-buildpacks-build.yaml":      {"gcp-buildpacks-build", "build-image"},
 	"paketo-buildpacks-build.yaml":   {"paketo-buildpacks-build", "build-image"},
+	"ko-build.yaml":                  {"ko-build", "build-image"},
+	"buildkit-build.yaml":            {"buildkit-build", "build-image"},
 	"publish-image.yaml":             {"publish-image", "publish-image"},
 	"publish-image-k3d.yaml":         {"publish-image", "publish-image"},
 	"generate-workload.yaml":         {"generate-workload", "generate-workload-cr"},
@@ -37,6 +39,13 @@ var buildTemplates = []string{
 	"paketo-buildpacks-build.yaml",
 }
 
+// daemonlessBuildTemplates write app-image.tar directly from the build tool
+// instead of saving it from podman storage.
+var daemonlessBuildTemplates = []string{
+	"ko-build.yaml",
+	"buildkit-build.yaml",
+}
+
 var buildpackTemplates = []string{
 	"ballerina-buildpack-build.yaml",
 	"gcp-buildpacks-build.yaml",
@@ -235,6 +244,46 @@ func TestContainerfileBuild_Specifics(t *testing.T) {
 	)
 }
 
+func TestDaemonlessBuildTemplates_SharedContract(t *testing.T) {
+	for _, file := range daemonlessBuildTemplates {
+		t.Run(file, func(t *testing.T) {
+			s := scriptForTemplate(t, file, "build-image")
+			// Output handoff to publish-image, tagged so podman resolves the short name.
+			requireContains(t, s, "/mnt/vol/app-image.tar", "localhost/")
+			// Path validation guard.
+			requireContains(t, s, "exit 1")
+			// build-env JSON is consumed, with empty/[] skipped.
+			requireContains(t, s, "build-env", `!= "[]"`)
+		})
+	}
+}
+
+func TestKoBuild_Specifics(t *testing.T) {
+	s := scriptForTemplate(t, "ko-build.yaml", "build-image")
+	requireContains(t, s,
+		"ko build",
+		"ko-import-path",
+		"KO_DEFAULTBASEIMAGE",
+		"--push=false",
+		"--tarball /mnt/vol/app-image.tar",
+		"go.mod",
+		"app-path",
+	)
+}
+
+func TestBuildKitBuild_Specifics(t *testing.T) {
+	s := scriptForTemplate(t, "buildkit-build.yaml", "build-image")
+	requireContains(t, s,
+		"buildctl-daemonless.sh build",
+		"--frontend dockerfile.v0",
+		"dockerfile-path",
+		"docker-context",
+		"build-target",
+		"--opt build-arg:",
+		"--output type=docker",
+	)
+}
+
 func TestBuildpackTemplates_Specifics(t *testing.T) {
 	for _, file := range buildpackTemplates {
 		t.Run(file, func(t *testing.T) {